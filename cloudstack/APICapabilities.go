@@ -0,0 +1,385 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// UnknownParamPolicy defines what happens when an API call contains a param that
+// is not known to the server
+type UnknownParamPolicy int
+
+const (
+	// UnknownParamIgnore sends the API call without any further notice
+	UnknownParamIgnore UnknownParamPolicy = iota
+	// UnknownParamWarn passes an *UnknownParamError to the handler set using
+	// WithUnknownParamHandler, if any, and sends the API call
+	UnknownParamWarn
+	// UnknownParamFail returns an *UnknownParamError without sending the API call
+	UnknownParamFail
+)
+
+// UnsupportedCommandError is returned when an API command is not available on the server
+type UnsupportedCommandError struct {
+	Command string
+}
+
+func (e *UnsupportedCommandError) Error() string {
+	return fmt.Sprintf("API command %s is not supported by the server", e.Command)
+}
+
+// UnknownParamError is returned when an API call contains params that are not known
+// to the server and the UnknownParamFail policy is used
+type UnknownParamError struct {
+	Command string
+	Params  []string
+}
+
+func (e *UnknownParamError) Error() string {
+	return fmt.Sprintf("API command %s does not support the param(s): %s", e.Command, strings.Join(e.Params, ", "))
+}
+
+// APICapabilities holds the commands and params available on the server, as
+// returned by the listApis API call
+type APICapabilities struct {
+	apis map[string]*apiSchema
+}
+
+type apiSchema struct {
+	api    *Api
+	params map[string]*ApiParams
+}
+
+// NewAPICapabilities returns the capabilities described by a listApis response
+func NewAPICapabilities(r *ListApisResponse) *APICapabilities {
+	c := &APICapabilities{apis: make(map[string]*apiSchema)}
+	for _, api := range r.Apis {
+		schema := &apiSchema{api: api, params: make(map[string]*ApiParams, len(api.Params))}
+		for i := range api.Params {
+			schema.params[strings.ToLower(api.Params[i].Name)] = &api.Params[i]
+		}
+		c.apis[strings.ToLower(api.Name)] = schema
+	}
+	return c
+}
+
+// Supports returns true if the server supports the given command and, if not
+// empty, the given param of that command
+func (c *APICapabilities) Supports(command, param string) bool {
+	schema, ok := c.apis[strings.ToLower(command)]
+	if !ok {
+		return false
+	}
+	if param == "" {
+		return true
+	}
+	_, ok = schema.params[strings.ToLower(paramBaseName(param))]
+	return ok
+}
+
+// API returns the listApis metadata of the given command
+func (c *APICapabilities) API(command string) (*Api, bool) {
+	schema, ok := c.apis[strings.ToLower(command)]
+	if !ok {
+		return nil, false
+	}
+	return schema.api, true
+}
+
+// Commands returns the sorted names of all commands available on the server
+func (c *APICapabilities) Commands() []string {
+	commands := make([]string, 0, len(c.apis))
+	for _, schema := range c.apis {
+		commands = append(commands, schema.api.Name)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+// Validate verifies the params of an API call against the metadata of the command.
+// It returns an *UnsupportedCommandError for unknown commands, an *UnknownParamError
+// for unknown params and an error for missing required params or values that do not
+// match the type of the param.
+func (c *APICapabilities) Validate(command string, params url.Values) error {
+	schema, ok := c.apis[strings.ToLower(command)]
+	if !ok {
+		return &UnsupportedCommandError{Command: command}
+	}
+
+	if unknown := c.unknownParams(command, params); len(unknown) > 0 {
+		return &UnknownParamError{Command: command, Params: unknown}
+	}
+
+	present := make(map[string]bool, len(params))
+	for k := range params {
+		present[strings.ToLower(paramBaseName(k))] = true
+	}
+
+	for _, ap := range schema.api.Params {
+		if ap.Required && !present[strings.ToLower(ap.Name)] {
+			return fmt.Errorf("API command %s requires the param %s", command, ap.Name)
+		}
+	}
+
+	for k, v := range params {
+		ap, ok := schema.params[strings.ToLower(k)]
+		if !ok {
+			// Indexed map and list params are only checked by name
+			continue
+		}
+		if err := validateParamValue(ap, v); err != nil {
+			return fmt.Errorf("API command %s has an invalid value for param %s: %v", command, k, err)
+		}
+	}
+
+	return nil
+}
+
+func validateParamValue(ap *ApiParams, values []string) error {
+	for _, v := range values {
+		var err error
+		switch ap.Type {
+		case "boolean":
+			_, err = strconv.ParseBool(v)
+		case "integer", "short":
+			_, err = strconv.ParseInt(v, 10, 32)
+		case "long":
+			_, err = strconv.ParseInt(v, 10, 64)
+		case "float", "double":
+			_, err = strconv.ParseFloat(v, 64)
+		}
+		if err != nil {
+			return err
+		}
+		if ap.Length > 0 && len(v) > ap.Length {
+			return fmt.Errorf("value exceeds the max length of %d", ap.Length)
+		}
+	}
+	return nil
+}
+
+// unknownParams returns the sorted names of the params that are not known for the command
+func (c *APICapabilities) unknownParams(command string, params url.Values) []string {
+	var known map[string]*ApiParams
+	if schema, ok := c.apis[strings.ToLower(command)]; ok {
+		known = schema.params
+	}
+
+	seen := make(map[string]bool)
+	var unknown []string
+	for k := range params {
+		name := paramBaseName(k)
+		if _, ok := known[strings.ToLower(name)]; ok || requestParamNames[strings.ToLower(name)] || seen[name] {
+			continue
+		}
+		seen[name] = true
+		unknown = append(unknown, name)
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// paramBaseName strips the index and key of map and list params, for example
+// `details[0].key` becomes `details`
+func paramBaseName(name string) string {
+	if i := strings.IndexByte(name, '['); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// apiDiscovery caches the capabilities of the server. It is shared between a client
+// and the scoped clients created from it, so the listApis call is only done once.
+type apiDiscovery struct {
+	mu      sync.Mutex
+	check   bool
+	policy  UnknownParamPolicy
+	handler func(*UnknownParamError)
+	caps    *APICapabilities
+	fetch   callGroup
+}
+
+// callGroup makes concurrent callers share a single call of a function, like
+// golang.org/x/sync/singleflight does for a single key. No lock is held while the
+// function runs, so it can make API calls itself.
+type callGroup struct {
+	mu   sync.Mutex
+	call *groupCall
+}
+
+type groupCall struct {
+	done chan struct{}
+	err  error
+}
+
+// do runs fn, or waits for the call of fn that is already running, and returns its error
+func (g *callGroup) do(fn func() error) error {
+	g.mu.Lock()
+	if c := g.call; c != nil {
+		g.mu.Unlock()
+		<-c.done
+		return c.err
+	}
+	c := &groupCall{done: make(chan struct{})}
+	g.call = c
+	g.mu.Unlock()
+
+	c.err = fn()
+
+	g.mu.Lock()
+	g.call = nil
+	g.mu.Unlock()
+	close(c.done)
+
+	return c.err
+}
+
+// isBootstrapCommand returns true for the commands used to discover the capabilities and
+// the version of the server, which are never checked against them
+func isBootstrapCommand(api string) bool {
+	return api == "listApis" || api == "listCapabilities"
+}
+
+// WithAPIDiscovery enables checking API calls against the capabilities of the server.
+// The first API call calls listApis once and caches the result. From then on, API calls
+// for commands that are not available on the server return an *UnsupportedCommandError
+// before anything is sent, and unknown params are handled according to the given policy.
+func WithAPIDiscovery(policy UnknownParamPolicy) ClientOption {
+	return func(cs *CloudStackClient) {
+		if cs.discovery == nil {
+			cs.discovery = &apiDiscovery{}
+		}
+		cs.discovery.check = true
+		cs.discovery.policy = policy
+	}
+}
+
+// WithUnknownParamHandler sets the function that is called for API calls with unknown
+// params when the UnknownParamWarn policy is used, for example to log a warning. The
+// client never logs anything itself.
+func WithUnknownParamHandler(handler func(*UnknownParamError)) ClientOption {
+	return func(cs *CloudStackClient) {
+		if cs.discovery == nil {
+			cs.discovery = &apiDiscovery{}
+		}
+		cs.discovery.handler = handler
+	}
+}
+
+// DiscoverAPIs calls listApis and caches the returned capabilities, replacing any
+// previously cached capabilities
+func (cs *CloudStackClient) DiscoverAPIs() (*APICapabilities, error) {
+	if cs.discovery == nil {
+		cs.discovery = &apiDiscovery{}
+	}
+	return cs.discoverAPIs()
+}
+
+// discoverAPIs calls listApis, or waits for the listApis call that is already running
+func (cs *CloudStackClient) discoverAPIs() (*APICapabilities, error) {
+	d := cs.discovery
+	err := d.fetch.do(func() error {
+		r, err := cs.APIDiscovery.ListApis(cs.APIDiscovery.NewListApisParams())
+		if err != nil {
+			return fmt.Errorf("failed to discover the API capabilities: %v", err)
+		}
+
+		d.mu.Lock()
+		d.caps = NewAPICapabilities(r)
+		d.mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.caps, nil
+}
+
+// APICapabilities returns the cached capabilities of the server, calling listApis
+// if they have not been discovered yet
+func (cs *CloudStackClient) APICapabilities() (*APICapabilities, error) {
+	if cs.discovery == nil {
+		return cs.DiscoverAPIs()
+	}
+
+	cs.discovery.mu.Lock()
+	caps := cs.discovery.caps
+	cs.discovery.mu.Unlock()
+
+	if caps != nil {
+		return caps, nil
+	}
+	return cs.discoverAPIs()
+}
+
+// Supports returns true if the server supports the given command and, if not empty,
+// the given param of that command. It returns false if the capabilities could not be
+// discovered.
+func (cs *CloudStackClient) Supports(command, param string) bool {
+	caps, err := cs.APICapabilities()
+	if err != nil {
+		return false
+	}
+	return caps.Supports(command, param)
+}
+
+// checkCapabilities verifies the API call against the cached capabilities of the server
+func (cs *CloudStackClient) checkCapabilities(api string, params url.Values) error {
+	// The bootstrap calls are always allowed, as they are used for the discovery itself
+	if cs.discovery == nil || !cs.discovery.check || isBootstrapCommand(api) {
+		return nil
+	}
+
+	caps, err := cs.APICapabilities()
+	if err != nil {
+		return err
+	}
+
+	if !caps.Supports(api, "") {
+		return &UnsupportedCommandError{Command: api}
+	}
+
+	if cs.discovery.policy == UnknownParamIgnore {
+		return nil
+	}
+
+	unknown := caps.unknownParams(api, params)
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	if cs.discovery.policy == UnknownParamFail {
+		return &UnknownParamError{Command: api, Params: unknown}
+	}
+
+	if cs.discovery.handler != nil {
+		cs.discovery.handler(&UnknownParamError{Command: api, Params: unknown})
+	}
+	return nil
+}
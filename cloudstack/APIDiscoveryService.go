@@ -25,57 +25,134 @@ import (
 )
 
 type APIDiscoveryServiceIface interface {
-	ListApis(p *ListApisParams) (*ListApisResponse, error)
+	ListApis(p *ListApisParams, opts ...CallOption) (*ListApisResponse, error)
 	NewListApisParams() *ListApisParams
 }
 
 type ListApisParams struct {
-	p map[string]interface{}
+	name optString
 }
 
-func (p *ListApisParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListApisParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
 	return u
 }
 
-func (p *ListApisParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListApisParams parses url.Values, for example taken from a raw API request,
+// into a new ListApisParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListApisParams(u url.Values) (*ListApisParams, error) {
+	p := &ListApisParams{}
+	if err := checkParamNames("listApis", u, "name"); err != nil {
+		return nil, err
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["name"] = v
+	return p, nil
 }
 
+// SetName sets the name param.
+func (p *ListApisParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
 func (p *ListApisParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListApisParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.name.v, p.name.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListApisParams) Clone() *ListApisParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListApisParams) Equal(o *ListApisParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.name == o.name
+}
+
+// serializedListApisParams is used to (un)marshal ListApisParams using the API param names
+type serializedListApisParams struct {
+	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (p *ListApisParams) toSerialized() *serializedListApisParams {
+	s := &serializedListApisParams{}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	return s
+}
+
+func (p *ListApisParams) fromSerialized(s *serializedListApisParams) {
+	*p = ListApisParams{}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListApisParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListApisParams) UnmarshalJSON(b []byte) error {
+	var s serializedListApisParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListApisParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListApisParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListApisParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListApisParams instance,
 // as then you are sure you have configured all required params
 func (s *APIDiscoveryService) NewListApisParams() *ListApisParams {
 	p := &ListApisParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// lists all available apis on the server, provided by the Api Discovery plugin
-func (s *APIDiscoveryService) ListApis(p *ListApisParams) (*ListApisResponse, error) {
-	resp, err := s.cs.newRequest("listApis", p.toURLValues())
+// lists all available apis on the server, provided by the Api Discovery plugin.
+func (s *APIDiscoveryService) ListApis(p *ListApisParams, opts ...CallOption) (*ListApisResponse, error) {
+	resp, err := s.cs.newRequest("listApis", s.cs.encodeParams("listApis", p), opts...)
 	if err != nil {
 		return nil, err
 	}
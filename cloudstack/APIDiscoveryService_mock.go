@@ -53,18 +53,23 @@ func (m *MockAPIDiscoveryServiceIface) EXPECT() *MockAPIDiscoveryServiceIfaceMoc
 }
 
 // ListApis mocks base method.
-func (m *MockAPIDiscoveryServiceIface) ListApis(p *ListApisParams) (*ListApisResponse, error) {
+func (m *MockAPIDiscoveryServiceIface) ListApis(p *ListApisParams, opts ...CallOption) (*ListApisResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListApis", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListApis", varargs...)
 	ret0, _ := ret[0].(*ListApisResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListApis indicates an expected call of ListApis.
-func (mr *MockAPIDiscoveryServiceIfaceMockRecorder) ListApis(p interface{}) *gomock.Call {
+func (mr *MockAPIDiscoveryServiceIfaceMockRecorder) ListApis(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApis", reflect.TypeOf((*MockAPIDiscoveryServiceIface)(nil).ListApis), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApis", reflect.TypeOf((*MockAPIDiscoveryServiceIface)(nil).ListApis), varargs...)
 }
 
 // NewListApisParams mocks base method.
@@ -28,400 +28,563 @@ import (
 )
 
 type AccountServiceIface interface {
-	CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error)
+	CreateAccount(p *CreateAccountParams, opts ...CallOption) (*CreateAccountResponse, error)
 	NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) *CreateAccountParams
-	DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccount(p *DeleteAccountParams, opts ...CallOption) (*DeleteAccountResponse, error)
 	NewDeleteAccountParams(id string) *DeleteAccountParams
-	DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccount(p *DisableAccountParams, opts ...CallOption) (*DisableAccountResponse, error)
 	NewDisableAccountParams(lock bool) *DisableAccountParams
-	EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error)
+	EnableAccount(p *EnableAccountParams, opts ...CallOption) (*EnableAccountResponse, error)
 	NewEnableAccountParams() *EnableAccountParams
-	GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error)
+	GetSolidFireAccountId(p *GetSolidFireAccountIdParams, opts ...CallOption) (*GetSolidFireAccountIdResponse, error)
 	NewGetSolidFireAccountIdParams(accountid string, storageid string) *GetSolidFireAccountIdParams
-	ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAccounts(p *ListAccountsParams, opts ...CallOption) (*ListAccountsResponse, error)
 	NewListAccountsParams() *ListAccountsParams
 	GetAccountID(name string, opts ...OptionFunc) (string, int, error)
 	GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error)
 	GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error)
-	ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	ListProjectAccounts(p *ListProjectAccountsParams, opts ...CallOption) (*ListProjectAccountsResponse, error)
 	NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams
 	GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error)
-	LockAccount(p *LockAccountParams) (*LockAccountResponse, error)
+	LockAccount(p *LockAccountParams, opts ...CallOption) (*LockAccountResponse, error)
 	NewLockAccountParams(account string, domainid string) *LockAccountParams
-	MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams, opts ...CallOption) (*MarkDefaultZoneForAccountResponse, error)
 	NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams
-	UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error)
+	UpdateAccount(p *UpdateAccountParams, opts ...CallOption) (*UpdateAccountResponse, error)
 	NewUpdateAccountParams() *UpdateAccountParams
 }
 
 type CreateAccountParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateAccountParams) toURLValues() url.Values {
+	account        optString
+	accountdetails optStringMap
+	accountid      optString
+	accounttype    optInt
+	domainid       optString
+	email          optString
+	firstname      optString
+	lastname       optString
+	networkdomain  optString
+	password       optString
+	roleid         optString
+	timezone       optString
+	userid         optString
+	username       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accountdetails"]; found {
-		m := v.(map[string]string)
+	if p.accountdetails.ok {
+		m := p.accountdetails.v
 		for _, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[0].%s", k), m[k])
 		}
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("accounttype", vv)
+	if p.accounttype.ok {
+		u.Set("accounttype", strconv.Itoa(p.accounttype.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["email"]; found {
-		u.Set("email", v.(string))
+	if p.email.ok {
+		u.Set("email", p.email.v)
 	}
-	if v, found := p.p["firstname"]; found {
-		u.Set("firstname", v.(string))
+	if p.firstname.ok {
+		u.Set("firstname", p.firstname.v)
 	}
-	if v, found := p.p["lastname"]; found {
-		u.Set("lastname", v.(string))
+	if p.lastname.ok {
+		u.Set("lastname", p.lastname.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *CreateAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateAccountParams parses url.Values, for example taken from a raw API request,
+// into a new CreateAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateAccountParams(u url.Values) (*CreateAccountParams, error) {
+	p := &CreateAccountParams{}
+	if err := checkParamNames("createAccount", u, "account", "accountdetails[]", "accountid", "accounttype", "domainid", "email", "firstname", "lastname", "networkdomain", "password", "roleid", "timezone", "userid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if m, err := parseIndexedMap(u, "accountdetails", true); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetAccountdetails(m)
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["accounttype"]; found {
+		v, err := strconv.Atoi(u.Get("accounttype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accounttype: %v", err)
+		}
+		p.SetAccounttype(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["email"]; found {
+		p.SetEmail(u.Get("email"))
+	}
+	if _, found := u["firstname"]; found {
+		p.SetFirstname(u.Get("firstname"))
+	}
+	if _, found := u["lastname"]; found {
+		p.SetLastname(u.Get("lastname"))
+	}
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateAccountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateAccountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateAccountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccountdetails sets the accountdetails param.
 func (p *CreateAccountParams) SetAccountdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountdetails"] = v
+	p.accountdetails = optStringMap{v: v, ok: true}
 }
 
+// ResetAccountdetails unsets the accountdetails param
 func (p *CreateAccountParams) ResetAccountdetails() {
-	if p.p != nil && p.p["accountdetails"] != nil {
-		delete(p.p, "accountdetails")
-	}
+	p.accountdetails = optStringMap{}
 }
 
+// GetAccountdetails returns the accountdetails param and if it is set
 func (p *CreateAccountParams) GetAccountdetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountdetails"].(map[string]string)
-	return value, ok
+	return p.accountdetails.v, p.accountdetails.ok
 }
 
+// SetAccountid sets the accountid param.
 func (p *CreateAccountParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
+	p.accountid = optString{v: v, ok: true}
 }
 
+// ResetAccountid unsets the accountid param
 func (p *CreateAccountParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *CreateAccountParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetAccounttype sets the accounttype param.
 func (p *CreateAccountParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounttype"] = v
+	p.accounttype = optInt{v: v, ok: true}
 }
 
+// ResetAccounttype unsets the accounttype param
 func (p *CreateAccountParams) ResetAccounttype() {
-	if p.p != nil && p.p["accounttype"] != nil {
-		delete(p.p, "accounttype")
-	}
+	p.accounttype = optInt{}
 }
 
+// GetAccounttype returns the accounttype param and if it is set
 func (p *CreateAccountParams) GetAccounttype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounttype"].(int)
-	return value, ok
+	return p.accounttype.v, p.accounttype.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateAccountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateAccountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEmail sets the email param. This param is required.
 func (p *CreateAccountParams) SetEmail(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["email"] = v
+	p.email = optString{v: v, ok: true}
 }
 
+// ResetEmail unsets the email param
 func (p *CreateAccountParams) ResetEmail() {
-	if p.p != nil && p.p["email"] != nil {
-		delete(p.p, "email")
-	}
+	p.email = optString{}
 }
 
+// GetEmail returns the email param and if it is set
 func (p *CreateAccountParams) GetEmail() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["email"].(string)
-	return value, ok
+	return p.email.v, p.email.ok
 }
 
+// SetFirstname sets the firstname param. This param is required.
 func (p *CreateAccountParams) SetFirstname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["firstname"] = v
+	p.firstname = optString{v: v, ok: true}
 }
 
+// ResetFirstname unsets the firstname param
 func (p *CreateAccountParams) ResetFirstname() {
-	if p.p != nil && p.p["firstname"] != nil {
-		delete(p.p, "firstname")
-	}
+	p.firstname = optString{}
 }
 
+// GetFirstname returns the firstname param and if it is set
 func (p *CreateAccountParams) GetFirstname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["firstname"].(string)
-	return value, ok
+	return p.firstname.v, p.firstname.ok
 }
 
+// SetLastname sets the lastname param. This param is required.
 func (p *CreateAccountParams) SetLastname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lastname"] = v
+	p.lastname = optString{v: v, ok: true}
 }
 
+// ResetLastname unsets the lastname param
 func (p *CreateAccountParams) ResetLastname() {
-	if p.p != nil && p.p["lastname"] != nil {
-		delete(p.p, "lastname")
-	}
+	p.lastname = optString{}
 }
 
+// GetLastname returns the lastname param and if it is set
 func (p *CreateAccountParams) GetLastname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lastname"].(string)
-	return value, ok
+	return p.lastname.v, p.lastname.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *CreateAccountParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *CreateAccountParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *CreateAccountParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+	return p.networkdomain.v, p.networkdomain.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *CreateAccountParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *CreateAccountParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *CreateAccountParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetRoleid sets the roleid param.
 func (p *CreateAccountParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roleid"] = v
+	p.roleid = optString{v: v, ok: true}
 }
 
+// ResetRoleid unsets the roleid param
 func (p *CreateAccountParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *CreateAccountParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	return p.roleid.v, p.roleid.ok
 }
 
+// SetTimezone sets the timezone param.
 func (p *CreateAccountParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timezone"] = v
+	p.timezone = optString{v: v, ok: true}
 }
 
+// ResetTimezone unsets the timezone param
 func (p *CreateAccountParams) ResetTimezone() {
-	if p.p != nil && p.p["timezone"] != nil {
-		delete(p.p, "timezone")
-	}
+	p.timezone = optString{}
 }
 
+// GetTimezone returns the timezone param and if it is set
 func (p *CreateAccountParams) GetTimezone() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["timezone"].(string)
-	return value, ok
+	return p.timezone.v, p.timezone.ok
 }
 
+// SetUserid sets the userid param.
 func (p *CreateAccountParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *CreateAccountParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *CreateAccountParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	return p.userid.v, p.userid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *CreateAccountParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *CreateAccountParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *CreateAccountParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateAccountParams) Clone() *CreateAccountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.accountdetails = p.accountdetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateAccountParams) Equal(o *CreateAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.accountdetails.equal(o.accountdetails) &&
+		p.accountid == o.accountid &&
+		p.accounttype == o.accounttype &&
+		p.domainid == o.domainid &&
+		p.email == o.email &&
+		p.firstname == o.firstname &&
+		p.lastname == o.lastname &&
+		p.networkdomain == o.networkdomain &&
+		p.password == o.password &&
+		p.roleid == o.roleid &&
+		p.timezone == o.timezone &&
+		p.userid == o.userid &&
+		p.username == o.username
+}
+
+// serializedCreateAccountParams is used to (un)marshal CreateAccountParams using the API param names
+type serializedCreateAccountParams struct {
+	Account        *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails *map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Accountid      *string            `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Accounttype    *int               `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid       *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Email          *string            `json:"email,omitempty" yaml:"email,omitempty"`
+	Firstname      *string            `json:"firstname,omitempty" yaml:"firstname,omitempty"`
+	Lastname       *string            `json:"lastname,omitempty" yaml:"lastname,omitempty"`
+	Networkdomain  *string            `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Password       *string            `json:"password,omitempty" yaml:"password,omitempty"`
+	Roleid         *string            `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+	Timezone       *string            `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userid         *string            `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Username       *string            `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *CreateAccountParams) toSerialized() *serializedCreateAccountParams {
+	s := &serializedCreateAccountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accountdetails.ok {
+		s.Accountdetails = &p.accountdetails.v
+	}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.accounttype.ok {
+		s.Accounttype = &p.accounttype.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.email.ok {
+		s.Email = &p.email.v
+	}
+	if p.firstname.ok {
+		s.Firstname = &p.firstname.v
+	}
+	if p.lastname.ok {
+		s.Lastname = &p.lastname.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *CreateAccountParams) fromSerialized(s *serializedCreateAccountParams) {
+	*p = CreateAccountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountdetails != nil {
+		p.SetAccountdetails(*s.Accountdetails)
+	}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Accounttype != nil {
+		p.SetAccounttype(*s.Accounttype)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Email != nil {
+		p.SetEmail(*s.Email)
+	}
+	if s.Firstname != nil {
+		p.SetFirstname(*s.Firstname)
+	}
+	if s.Lastname != nil {
+		p.SetLastname(*s.Lastname)
+	}
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) *CreateAccountParams {
 	p := &CreateAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["email"] = email
-	p.p["firstname"] = firstname
-	p.p["lastname"] = lastname
-	p.p["password"] = password
-	p.p["username"] = username
+	p.SetEmail(email)
+	p.SetFirstname(firstname)
+	p.SetLastname(lastname)
+	p.SetPassword(password)
+	p.SetUsername(username)
 	return p
 }
 
-// Creates an account
-func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("createAccount", p.toURLValues())
+// Creates an account.
+//
+// Required params: email, firstname, lastname, password, username.
+func (s *AccountService) CreateAccount(p *CreateAccountParams, opts ...CallOption) (*CreateAccountResponse, error) {
+	resp, err := s.cs.newRequest("createAccount", s.cs.encodeParams("createAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -530,53 +693,133 @@ type CreateAccountResponseUser struct {
 }
 
 type DeleteAccountParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteAccountParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteAccountParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteAccountParams(u url.Values) (*DeleteAccountParams, error) {
+	p := &DeleteAccountParams{}
+	if err := checkParamNames("deleteAccount", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteAccountParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteAccountParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteAccountParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteAccountParams) Clone() *DeleteAccountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteAccountParams) Equal(o *DeleteAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteAccountParams is used to (un)marshal DeleteAccountParams using the API param names
+type serializedDeleteAccountParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteAccountParams) toSerialized() *serializedDeleteAccountParams {
+	s := &serializedDeleteAccountParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteAccountParams) fromSerialized(s *serializedDeleteAccountParams) {
+	*p = DeleteAccountParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewDeleteAccountParams(id string) *DeleteAccountParams {
 	p := &DeleteAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a account, and all users associated with this account
-func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccount", p.toURLValues())
+// Deletes a account, and all users associated with this account.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AccountService) DeleteAccount(p *DeleteAccountParams, opts ...CallOption) (*DeleteAccountResponse, error) {
+	resp, err := s.cs.newRequest("deleteAccount", s.cs.encodeParams("deleteAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -587,8 +830,8 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -612,126 +855,227 @@ type DeleteAccountResponse struct {
 }
 
 type DisableAccountParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
+	id       optString
+	lock     optBool
 }
 
-func (p *DisableAccountParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["lock"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("lock", vv)
+	if p.lock.ok {
+		u.Set("lock", strconv.FormatBool(p.lock.v))
 	}
 	return u
 }
 
-func (p *DisableAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableAccountParams parses url.Values, for example taken from a raw API request,
+// into a new DisableAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableAccountParams(u url.Values) (*DisableAccountParams, error) {
+	p := &DisableAccountParams{}
+	if err := checkParamNames("disableAccount", u, "account", "domainid", "id", "lock"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["account"] = v
+	if _, found := u["lock"]; found {
+		v, err := strconv.ParseBool(u.Get("lock"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param lock: %v", err)
+		}
+		p.SetLock(v)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *DisableAccountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *DisableAccountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DisableAccountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *DisableAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DisableAccountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DisableAccountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *DisableAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DisableAccountParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DisableAccountParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetLock sets the lock param. This param is required.
 func (p *DisableAccountParams) SetLock(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lock"] = v
+	p.lock = optBool{v: v, ok: true}
 }
 
+// ResetLock unsets the lock param
 func (p *DisableAccountParams) ResetLock() {
-	if p.p != nil && p.p["lock"] != nil {
-		delete(p.p, "lock")
-	}
+	p.lock = optBool{}
 }
 
+// GetLock returns the lock param and if it is set
 func (p *DisableAccountParams) GetLock() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.lock.v, p.lock.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableAccountParams) Clone() *DisableAccountParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["lock"].(bool)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableAccountParams) Equal(o *DisableAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.lock == o.lock
+}
+
+// serializedDisableAccountParams is used to (un)marshal DisableAccountParams using the API param names
+type serializedDisableAccountParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Lock     *bool   `json:"lock,omitempty" yaml:"lock,omitempty"`
+}
+
+func (p *DisableAccountParams) toSerialized() *serializedDisableAccountParams {
+	s := &serializedDisableAccountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.lock.ok {
+		s.Lock = &p.lock.v
+	}
+	return s
+}
+
+func (p *DisableAccountParams) fromSerialized(s *serializedDisableAccountParams) {
+	*p = DisableAccountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Lock != nil {
+		p.SetLock(*s.Lock)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewDisableAccountParams(lock bool) *DisableAccountParams {
 	p := &DisableAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["lock"] = lock
+	p.SetLock(lock)
 	return p
 }
 
-// Disables an account
-func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
-	resp, err := s.cs.newRequest("disableAccount", p.toURLValues())
+// Disables an account.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: lock.
+func (s *AccountService) DisableAccount(p *DisableAccountParams, opts ...CallOption) (*DisableAccountResponse, error) {
+	resp, err := s.cs.newRequest("disableAccount", s.cs.encodeParams("disableAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -742,8 +1086,8 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -856,100 +1200,189 @@ type DisableAccountResponseUser struct {
 }
 
 type EnableAccountParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
+	id       optString
 }
 
-func (p *EnableAccountParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *EnableAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableAccountParams parses url.Values, for example taken from a raw API request,
+// into a new EnableAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableAccountParams(u url.Values) (*EnableAccountParams, error) {
+	p := &EnableAccountParams{}
+	if err := checkParamNames("enableAccount", u, "account", "domainid", "id"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *EnableAccountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *EnableAccountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *EnableAccountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *EnableAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *EnableAccountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *EnableAccountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *EnableAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *EnableAccountParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *EnableAccountParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableAccountParams) Clone() *EnableAccountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableAccountParams) Equal(o *EnableAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id
+}
+
+// serializedEnableAccountParams is used to (un)marshal EnableAccountParams using the API param names
+type serializedEnableAccountParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *EnableAccountParams) toSerialized() *serializedEnableAccountParams {
+	s := &serializedEnableAccountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *EnableAccountParams) fromSerialized(s *serializedEnableAccountParams) {
+	*p = EnableAccountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewEnableAccountParams() *EnableAccountParams {
 	p := &EnableAccountParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Enables an account
-func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
-	resp, err := s.cs.newRequest("enableAccount", p.toURLValues())
+// Enables an account.
+func (s *AccountService) EnableAccount(p *EnableAccountParams, opts ...CallOption) (*EnableAccountResponse, error) {
+	resp, err := s.cs.newRequest("enableAccount", s.cs.encodeParams("enableAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1054,78 +1487,163 @@ type EnableAccountResponseUser struct {
 }
 
 type GetSolidFireAccountIdParams struct {
-	p map[string]interface{}
+	accountid optString
+	storageid optString
 }
 
-func (p *GetSolidFireAccountIdParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetSolidFireAccountIdParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
 	return u
 }
 
-func (p *GetSolidFireAccountIdParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetSolidFireAccountIdParams parses url.Values, for example taken from a raw API request,
+// into a new GetSolidFireAccountIdParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetSolidFireAccountIdParams(u url.Values) (*GetSolidFireAccountIdParams, error) {
+	p := &GetSolidFireAccountIdParams{}
+	if err := checkParamNames("getSolidFireAccountId", u, "accountid", "storageid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
 	}
-	p.p["accountid"] = v
+	return p, nil
+}
+
+// SetAccountid sets the accountid param. This param is required.
+func (p *GetSolidFireAccountIdParams) SetAccountid(v string) {
+	p.accountid = optString{v: v, ok: true}
 }
 
+// ResetAccountid unsets the accountid param
 func (p *GetSolidFireAccountIdParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *GetSolidFireAccountIdParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetStorageid sets the storageid param. This param is required.
 func (p *GetSolidFireAccountIdParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *GetSolidFireAccountIdParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *GetSolidFireAccountIdParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.storageid.v, p.storageid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetSolidFireAccountIdParams) Clone() *GetSolidFireAccountIdParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetSolidFireAccountIdParams) Equal(o *GetSolidFireAccountIdParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accountid == o.accountid &&
+		p.storageid == o.storageid
+}
+
+// serializedGetSolidFireAccountIdParams is used to (un)marshal GetSolidFireAccountIdParams using the API param names
+type serializedGetSolidFireAccountIdParams struct {
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Storageid *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+}
+
+func (p *GetSolidFireAccountIdParams) toSerialized() *serializedGetSolidFireAccountIdParams {
+	s := &serializedGetSolidFireAccountIdParams{}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	return s
+}
+
+func (p *GetSolidFireAccountIdParams) fromSerialized(s *serializedGetSolidFireAccountIdParams) {
+	*p = GetSolidFireAccountIdParams{}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
 	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetSolidFireAccountIdParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetSolidFireAccountIdParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetSolidFireAccountIdParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetSolidFireAccountIdParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetSolidFireAccountIdParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetSolidFireAccountIdParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetSolidFireAccountIdParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewGetSolidFireAccountIdParams(accountid string, storageid string) *GetSolidFireAccountIdParams {
 	p := &GetSolidFireAccountIdParams{}
-	p.p = make(map[string]interface{})
-	p.p["accountid"] = accountid
-	p.p["storageid"] = storageid
+	p.SetAccountid(accountid)
+	p.SetStorageid(storageid)
 	return p
 }
 
-// Get SolidFire Account ID
-func (s *AccountService) GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireAccountId", p.toURLValues())
+// Get SolidFire Account ID.
+//
+// Required params: accountid, storageid.
+func (s *AccountService) GetSolidFireAccountId(p *GetSolidFireAccountIdParams, opts ...CallOption) (*GetSolidFireAccountIdResponse, error) {
+	resp, err := s.cs.newRequest("getSolidFireAccountId", s.cs.encodeParams("getSolidFireAccountId", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1145,351 +1663,520 @@ type GetSolidFireAccountIdResponse struct {
 }
 
 type ListAccountsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAccountsParams) toURLValues() url.Values {
+	accounttype       optInt
+	details           optStrings
+	domainid          optString
+	id                optString
+	iscleanuprequired optBool
+	isrecursive       optBool
+	keyword           optString
+	listall           optBool
+	name              optString
+	page              optInt
+	pagesize          optInt
+	showicon          optBool
+	state             optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAccountsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("accounttype", vv)
+	if p.accounttype.ok {
+		u.Set("accounttype", strconv.Itoa(p.accounttype.v))
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["iscleanuprequired"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("iscleanuprequired", vv)
+	if p.iscleanuprequired.ok {
+		u.Set("iscleanuprequired", strconv.FormatBool(p.iscleanuprequired.v))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
 	return u
 }
 
-func (p *ListAccountsParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAccountsParams parses url.Values, for example taken from a raw API request,
+// into a new ListAccountsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAccountsParams(u url.Values) (*ListAccountsParams, error) {
+	p := &ListAccountsParams{}
+	if err := checkParamNames("listAccounts", u, "accounttype", "details", "domainid", "id", "iscleanuprequired", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "showicon", "state"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accounttype"]; found {
+		v, err := strconv.Atoi(u.Get("accounttype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accounttype: %v", err)
+		}
+		p.SetAccounttype(v)
+	}
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["iscleanuprequired"]; found {
+		v, err := strconv.ParseBool(u.Get("iscleanuprequired"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iscleanuprequired: %v", err)
+		}
+		p.SetIscleanuprequired(v)
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["accounttype"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	return p, nil
+}
+
+// SetAccounttype sets the accounttype param.
+func (p *ListAccountsParams) SetAccounttype(v int) {
+	p.accounttype = optInt{v: v, ok: true}
 }
 
+// ResetAccounttype unsets the accounttype param
 func (p *ListAccountsParams) ResetAccounttype() {
-	if p.p != nil && p.p["accounttype"] != nil {
-		delete(p.p, "accounttype")
-	}
+	p.accounttype = optInt{}
 }
 
+// GetAccounttype returns the accounttype param and if it is set
 func (p *ListAccountsParams) GetAccounttype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounttype"].(int)
-	return value, ok
+	return p.accounttype.v, p.accounttype.ok
 }
 
+// SetDetails sets the details param.
 func (p *ListAccountsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStrings{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ListAccountsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListAccountsParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListAccountsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListAccountsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListAccountsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListAccountsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListAccountsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListAccountsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIscleanuprequired sets the iscleanuprequired param.
 func (p *ListAccountsParams) SetIscleanuprequired(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iscleanuprequired"] = v
+	p.iscleanuprequired = optBool{v: v, ok: true}
 }
 
+// ResetIscleanuprequired unsets the iscleanuprequired param
 func (p *ListAccountsParams) ResetIscleanuprequired() {
-	if p.p != nil && p.p["iscleanuprequired"] != nil {
-		delete(p.p, "iscleanuprequired")
-	}
+	p.iscleanuprequired = optBool{}
 }
 
+// GetIscleanuprequired returns the iscleanuprequired param and if it is set
 func (p *ListAccountsParams) GetIscleanuprequired() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iscleanuprequired"].(bool)
-	return value, ok
+	return p.iscleanuprequired.v, p.iscleanuprequired.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListAccountsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListAccountsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListAccountsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAccountsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAccountsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAccountsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListAccountsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListAccountsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListAccountsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListAccountsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListAccountsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListAccountsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAccountsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAccountsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAccountsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAccountsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListAccountsParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListAccountsParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetShowicon sets the showicon param.
+func (p *ListAccountsParams) SetShowicon(v bool) {
+	p.showicon = optBool{v: v, ok: true}
+}
+
+// ResetShowicon unsets the showicon param
+func (p *ListAccountsParams) ResetShowicon() {
+	p.showicon = optBool{}
+}
+
+// GetShowicon returns the showicon param and if it is set
+func (p *ListAccountsParams) GetShowicon() (bool, bool) {
+	return p.showicon.v, p.showicon.ok
+}
+
+// SetState sets the state param.
+func (p *ListAccountsParams) SetState(v string) {
+	p.state = optString{v: v, ok: true}
+}
+
+// ResetState unsets the state param
+func (p *ListAccountsParams) ResetState() {
+	p.state = optString{}
+}
+
+// GetState returns the state param and if it is set
+func (p *ListAccountsParams) GetState() (string, bool) {
+	return p.state.v, p.state.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAccountsParams) Clone() *ListAccountsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAccountsParams) Equal(o *ListAccountsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accounttype == o.accounttype &&
+		p.details.equal(o.details) &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.iscleanuprequired == o.iscleanuprequired &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showicon == o.showicon &&
+		p.state == o.state
+}
+
+// serializedListAccountsParams is used to (un)marshal ListAccountsParams using the API param names
+type serializedListAccountsParams struct {
+	Accounttype       *int      `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Details           *[]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Domainid          *string   `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id                *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Iscleanuprequired *bool     `json:"iscleanuprequired,omitempty" yaml:"iscleanuprequired,omitempty"`
+	Isrecursive       *bool     `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool     `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name              *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showicon          *bool     `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	State             *string   `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+func (p *ListAccountsParams) toSerialized() *serializedListAccountsParams {
+	s := &serializedListAccountsParams{}
+	if p.accounttype.ok {
+		s.Accounttype = &p.accounttype.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.iscleanuprequired.ok {
+		s.Iscleanuprequired = &p.iscleanuprequired.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
 	}
-	p.p["pagesize"] = v
-}
-
-func (p *ListAccountsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
+	if p.state.ok {
+		s.State = &p.state.v
 	}
+	return s
 }
 
-func (p *ListAccountsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) fromSerialized(s *serializedListAccountsParams) {
+	*p = ListAccountsParams{}
+	if s.Accounttype != nil {
+		p.SetAccounttype(*s.Accounttype)
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
-}
-
-func (p *ListAccountsParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
 	}
-	p.p["showicon"] = v
-}
-
-func (p *ListAccountsParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Iscleanuprequired != nil {
+		p.SetIscleanuprequired(*s.Iscleanuprequired)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
 	}
 }
 
-func (p *ListAccountsParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAccountsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListAccountsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAccountsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAccountsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["state"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListAccountsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAccountsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListAccountsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAccountsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAccountsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAccountsParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewListAccountsParams() *ListAccountsParams {
 	p := &ListAccountsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAccountsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1537,9 +2224,8 @@ func (s *AccountService) GetAccountByName(name string, opts ...OptionFunc) (*Acc
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error) {
 	p := &ListAccountsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1567,9 +2253,9 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 	return nil, l.Count, fmt.Errorf("There is more then one result for Account UUID: %s!", id)
 }
 
-// Lists accounts and provides detailed account information for listed accounts
-func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listAccounts", p.toURLValues())
+// Lists accounts and provides detailed account information for listed accounts.
+func (s *AccountService) ListAccounts(p *ListAccountsParams, opts ...CallOption) (*ListAccountsResponse, error) {
+	resp, err := s.cs.newRequest("listAccounts", s.cs.encodeParams("listAccounts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1679,227 +2365,351 @@ type AccountUser struct {
 }
 
 type ListProjectAccountsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListProjectAccountsParams) toURLValues() url.Values {
+	account       optString
+	keyword       optString
+	page          optInt
+	pagesize      optInt
+	projectid     optString
+	projectroleid optString
+	role          optString
+	userid        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListProjectAccountsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["projectroleid"]; found {
-		u.Set("projectroleid", v.(string))
+	if p.projectroleid.ok {
+		u.Set("projectroleid", p.projectroleid.v)
 	}
-	if v, found := p.p["role"]; found {
-		u.Set("role", v.(string))
+	if p.role.ok {
+		u.Set("role", p.role.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *ListProjectAccountsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListProjectAccountsParams parses url.Values, for example taken from a raw API request,
+// into a new ListProjectAccountsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListProjectAccountsParams(u url.Values) (*ListProjectAccountsParams, error) {
+	p := &ListProjectAccountsParams{}
+	if err := checkParamNames("listProjectAccounts", u, "account", "keyword", "page", "pagesize", "projectid", "projectroleid", "role", "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["projectroleid"]; found {
+		p.SetProjectroleid(u.Get("projectroleid"))
+	}
+	if _, found := u["role"]; found {
+		p.SetRole(u.Get("role"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListProjectAccountsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListProjectAccountsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListProjectAccountsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListProjectAccountsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListProjectAccountsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListProjectAccountsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListProjectAccountsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListProjectAccountsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListProjectAccountsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListProjectAccountsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListProjectAccountsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListProjectAccountsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *ListProjectAccountsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListProjectAccountsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListProjectAccountsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProjectroleid sets the projectroleid param.
 func (p *ListProjectAccountsParams) SetProjectroleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectroleid"] = v
+	p.projectroleid = optString{v: v, ok: true}
 }
 
+// ResetProjectroleid unsets the projectroleid param
 func (p *ListProjectAccountsParams) ResetProjectroleid() {
-	if p.p != nil && p.p["projectroleid"] != nil {
-		delete(p.p, "projectroleid")
-	}
+	p.projectroleid = optString{}
 }
 
+// GetProjectroleid returns the projectroleid param and if it is set
 func (p *ListProjectAccountsParams) GetProjectroleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectroleid"].(string)
-	return value, ok
+	return p.projectroleid.v, p.projectroleid.ok
 }
 
+// SetRole sets the role param.
 func (p *ListProjectAccountsParams) SetRole(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["role"] = v
+	p.role = optString{v: v, ok: true}
 }
 
+// ResetRole unsets the role param
 func (p *ListProjectAccountsParams) ResetRole() {
-	if p.p != nil && p.p["role"] != nil {
-		delete(p.p, "role")
-	}
+	p.role = optString{}
 }
 
+// GetRole returns the role param and if it is set
 func (p *ListProjectAccountsParams) GetRole() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["role"].(string)
-	return value, ok
+	return p.role.v, p.role.ok
 }
 
+// SetUserid sets the userid param.
 func (p *ListProjectAccountsParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *ListProjectAccountsParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *ListProjectAccountsParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListProjectAccountsParams) Clone() *ListProjectAccountsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListProjectAccountsParams) Equal(o *ListProjectAccountsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.projectroleid == o.projectroleid &&
+		p.role == o.role &&
+		p.userid == o.userid
+}
+
+// serializedListProjectAccountsParams is used to (un)marshal ListProjectAccountsParams using the API param names
+type serializedListProjectAccountsParams struct {
+	Account       *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Keyword       *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page          *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize      *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid     *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Projectroleid *string `json:"projectroleid,omitempty" yaml:"projectroleid,omitempty"`
+	Role          *string `json:"role,omitempty" yaml:"role,omitempty"`
+	Userid        *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *ListProjectAccountsParams) toSerialized() *serializedListProjectAccountsParams {
+	s := &serializedListProjectAccountsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.projectroleid.ok {
+		s.Projectroleid = &p.projectroleid.v
+	}
+	if p.role.ok {
+		s.Role = &p.role.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *ListProjectAccountsParams) fromSerialized(s *serializedListProjectAccountsParams) {
+	*p = ListProjectAccountsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Projectroleid != nil {
+		p.SetProjectroleid(*s.Projectroleid)
+	}
+	if s.Role != nil {
+		p.SetRole(*s.Role)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListProjectAccountsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListProjectAccountsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListProjectAccountsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListProjectAccountsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListProjectAccountsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListProjectAccountsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListProjectAccountsParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams {
 	p := &ListProjectAccountsParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
+	p.SetProjectid(projectid)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectAccountsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["projectid"] = projectid
+	p.SetKeyword(keyword)
+	p.SetProjectid(projectid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1930,9 +2740,11 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// Lists project's accounts
-func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectAccounts", p.toURLValues())
+// Lists project's accounts.
+//
+// Required params: projectid.
+func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams, opts ...CallOption) (*ListProjectAccountsResponse, error) {
+	resp, err := s.cs.newRequest("listProjectAccounts", s.cs.encodeParams("listProjectAccounts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2015,78 +2827,163 @@ type Tags struct {
 }
 
 type LockAccountParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
 }
 
-func (p *LockAccountParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LockAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
 	return u
 }
 
-func (p *LockAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseLockAccountParams parses url.Values, for example taken from a raw API request,
+// into a new LockAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLockAccountParams(u url.Values) (*LockAccountParams, error) {
+	p := &LockAccountParams{}
+	if err := checkParamNames("lockAccount", u, "account", "domainid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param. This param is required.
+func (p *LockAccountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *LockAccountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *LockAccountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *LockAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *LockAccountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *LockAccountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.domainid.v, p.domainid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *LockAccountParams) Clone() *LockAccountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LockAccountParams) Equal(o *LockAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid
+}
+
+// serializedLockAccountParams is used to (un)marshal LockAccountParams using the API param names
+type serializedLockAccountParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+}
+
+func (p *LockAccountParams) toSerialized() *serializedLockAccountParams {
+	s := &serializedLockAccountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
 	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	return s
+}
+
+func (p *LockAccountParams) fromSerialized(s *serializedLockAccountParams) {
+	*p = LockAccountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LockAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LockAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedLockAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LockAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LockAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLockAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new LockAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewLockAccountParams(account string, domainid string) *LockAccountParams {
 	p := &LockAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["domainid"] = domainid
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
 	return p
 }
 
-// This deprecated function used to locks an account. Look for the API DisableAccount instead
-func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
-	resp, err := s.cs.newRequest("lockAccount", p.toURLValues())
+// This deprecated function used to locks an account. Look for the API DisableAccount instead.
+//
+// Required params: account, domainid.
+func (s *AccountService) LockAccount(p *LockAccountParams, opts ...CallOption) (*LockAccountResponse, error) {
+	resp, err := s.cs.newRequest("lockAccount", s.cs.encodeParams("lockAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2191,103 +3088,196 @@ type LockAccountResponseUser struct {
 }
 
 type MarkDefaultZoneForAccountParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
+	zoneid   optString
 }
 
-func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *MarkDefaultZoneForAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseMarkDefaultZoneForAccountParams parses url.Values, for example taken from a raw API request,
+// into a new MarkDefaultZoneForAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseMarkDefaultZoneForAccountParams(u url.Values) (*MarkDefaultZoneForAccountParams, error) {
+	p := &MarkDefaultZoneForAccountParams{}
+	if err := checkParamNames("markDefaultZoneForAccount", u, "account", "domainid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param. This param is required.
+func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *MarkDefaultZoneForAccountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *MarkDefaultZoneForAccountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *MarkDefaultZoneForAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *MarkDefaultZoneForAccountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *MarkDefaultZoneForAccountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *MarkDefaultZoneForAccountParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *MarkDefaultZoneForAccountParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *MarkDefaultZoneForAccountParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *MarkDefaultZoneForAccountParams) Clone() *MarkDefaultZoneForAccountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *MarkDefaultZoneForAccountParams) Equal(o *MarkDefaultZoneForAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedMarkDefaultZoneForAccountParams is used to (un)marshal MarkDefaultZoneForAccountParams using the API param names
+type serializedMarkDefaultZoneForAccountParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *MarkDefaultZoneForAccountParams) toSerialized() *serializedMarkDefaultZoneForAccountParams {
+	s := &serializedMarkDefaultZoneForAccountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *MarkDefaultZoneForAccountParams) fromSerialized(s *serializedMarkDefaultZoneForAccountParams) {
+	*p = MarkDefaultZoneForAccountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p MarkDefaultZoneForAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *MarkDefaultZoneForAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedMarkDefaultZoneForAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p MarkDefaultZoneForAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *MarkDefaultZoneForAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedMarkDefaultZoneForAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new MarkDefaultZoneForAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams {
 	p := &MarkDefaultZoneForAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["domainid"] = domainid
-	p.p["zoneid"] = zoneid
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Marks a default zone for this account
-func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
-	resp, err := s.cs.newRequest("markDefaultZoneForAccount", p.toURLValues())
+// Marks a default zone for this account.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: account, domainid,
+// zoneid.
+func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams, opts ...CallOption) (*MarkDefaultZoneForAccountResponse, error) {
+	resp, err := s.cs.newRequest("markDefaultZoneForAccount", s.cs.encodeParams("markDefaultZoneForAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2298,8 +3288,8 @@ func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountP
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2412,199 +3402,315 @@ type MarkDefaultZoneForAccountResponseUser struct {
 }
 
 type UpdateAccountParams struct {
-	p map[string]interface{}
+	account        optString
+	accountdetails optStringMap
+	domainid       optString
+	id             optString
+	networkdomain  optString
+	newname        optString
+	roleid         optString
 }
 
-func (p *UpdateAccountParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accountdetails"]; found {
-		m := v.(map[string]string)
+	if p.accountdetails.ok {
+		m := p.accountdetails.v
 		for _, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[0].%s", k), m[k])
 		}
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
-	if v, found := p.p["newname"]; found {
-		u.Set("newname", v.(string))
+	if p.newname.ok {
+		u.Set("newname", p.newname.v)
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
 	return u
 }
 
-func (p *UpdateAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateAccountParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateAccountParams(u url.Values) (*UpdateAccountParams, error) {
+	p := &UpdateAccountParams{}
+	if err := checkParamNames("updateAccount", u, "account", "accountdetails[]", "domainid", "id", "networkdomain", "newname", "roleid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if m, err := parseIndexedMap(u, "accountdetails", true); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetAccountdetails(m)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
+	}
+	if _, found := u["newname"]; found {
+		p.SetNewname(u.Get("newname"))
+	}
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *UpdateAccountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *UpdateAccountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UpdateAccountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccountdetails sets the accountdetails param.
 func (p *UpdateAccountParams) SetAccountdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountdetails"] = v
+	p.accountdetails = optStringMap{v: v, ok: true}
 }
 
+// ResetAccountdetails unsets the accountdetails param
 func (p *UpdateAccountParams) ResetAccountdetails() {
-	if p.p != nil && p.p["accountdetails"] != nil {
-		delete(p.p, "accountdetails")
-	}
+	p.accountdetails = optStringMap{}
 }
 
+// GetAccountdetails returns the accountdetails param and if it is set
 func (p *UpdateAccountParams) GetAccountdetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountdetails"].(map[string]string)
-	return value, ok
+	return p.accountdetails.v, p.accountdetails.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateAccountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateAccountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *UpdateAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateAccountParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateAccountParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *UpdateAccountParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *UpdateAccountParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *UpdateAccountParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+	return p.networkdomain.v, p.networkdomain.ok
 }
 
+// SetNewname sets the newname param.
 func (p *UpdateAccountParams) SetNewname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["newname"] = v
+	p.newname = optString{v: v, ok: true}
 }
 
+// ResetNewname unsets the newname param
 func (p *UpdateAccountParams) ResetNewname() {
-	if p.p != nil && p.p["newname"] != nil {
-		delete(p.p, "newname")
-	}
+	p.newname = optString{}
 }
 
+// GetNewname returns the newname param and if it is set
 func (p *UpdateAccountParams) GetNewname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["newname"].(string)
-	return value, ok
+	return p.newname.v, p.newname.ok
 }
 
+// SetRoleid sets the roleid param.
 func (p *UpdateAccountParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roleid"] = v
+	p.roleid = optString{v: v, ok: true}
 }
 
+// ResetRoleid unsets the roleid param
 func (p *UpdateAccountParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *UpdateAccountParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.roleid.v, p.roleid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateAccountParams) Clone() *UpdateAccountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.accountdetails = p.accountdetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateAccountParams) Equal(o *UpdateAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accountdetails.equal(o.accountdetails) &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.networkdomain == o.networkdomain &&
+		p.newname == o.newname &&
+		p.roleid == o.roleid
+}
+
+// serializedUpdateAccountParams is used to (un)marshal UpdateAccountParams using the API param names
+type serializedUpdateAccountParams struct {
+	Account        *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails *map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Domainid       *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id             *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Networkdomain  *string            `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Newname        *string            `json:"newname,omitempty" yaml:"newname,omitempty"`
+	Roleid         *string            `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+}
+
+func (p *UpdateAccountParams) toSerialized() *serializedUpdateAccountParams {
+	s := &serializedUpdateAccountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accountdetails.ok {
+		s.Accountdetails = &p.accountdetails.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	if p.newname.ok {
+		s.Newname = &p.newname.v
+	}
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
+	}
+	return s
+}
+
+func (p *UpdateAccountParams) fromSerialized(s *serializedUpdateAccountParams) {
+	*p = UpdateAccountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountdetails != nil {
+		p.SetAccountdetails(*s.Accountdetails)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
+	}
+	if s.Newname != nil {
+		p.SetNewname(*s.Newname)
+	}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewUpdateAccountParams() *UpdateAccountParams {
 	p := &UpdateAccountParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Updates account information for the authenticated user
-func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
-	resp, err := s.cs.newRequest("updateAccount", p.toURLValues())
+// Updates account information for the authenticated user.
+func (s *AccountService) UpdateAccount(p *UpdateAccountParams, opts ...CallOption) (*UpdateAccountResponse, error) {
+	resp, err := s.cs.newRequest("updateAccount", s.cs.encodeParams("updateAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,63 +53,83 @@ func (m *MockAccountServiceIface) EXPECT() *MockAccountServiceIfaceMockRecorder
 }
 
 // CreateAccount mocks base method.
-func (m *MockAccountServiceIface) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
+func (m *MockAccountServiceIface) CreateAccount(p *CreateAccountParams, opts ...CallOption) (*CreateAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAccount", varargs...)
 	ret0, _ := ret[0].(*CreateAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockAccountServiceIfaceMockRecorder) CreateAccount(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) CreateAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).CreateAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).CreateAccount), varargs...)
 }
 
 // DeleteAccount mocks base method.
-func (m *MockAccountServiceIface) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
+func (m *MockAccountServiceIface) DeleteAccount(p *DeleteAccountParams, opts ...CallOption) (*DeleteAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAccount", varargs...)
 	ret0, _ := ret[0].(*DeleteAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockAccountServiceIfaceMockRecorder) DeleteAccount(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) DeleteAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).DeleteAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).DeleteAccount), varargs...)
 }
 
 // DisableAccount mocks base method.
-func (m *MockAccountServiceIface) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
+func (m *MockAccountServiceIface) DisableAccount(p *DisableAccountParams, opts ...CallOption) (*DisableAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableAccount", varargs...)
 	ret0, _ := ret[0].(*DisableAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableAccount indicates an expected call of DisableAccount.
-func (mr *MockAccountServiceIfaceMockRecorder) DisableAccount(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) DisableAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).DisableAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).DisableAccount), varargs...)
 }
 
 // EnableAccount mocks base method.
-func (m *MockAccountServiceIface) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
+func (m *MockAccountServiceIface) EnableAccount(p *EnableAccountParams, opts ...CallOption) (*EnableAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableAccount", varargs...)
 	ret0, _ := ret[0].(*EnableAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableAccount indicates an expected call of EnableAccount.
-func (mr *MockAccountServiceIfaceMockRecorder) EnableAccount(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) EnableAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).EnableAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).EnableAccount), varargs...)
 }
 
 // GetAccountByID mocks base method.
@@ -197,78 +217,103 @@ func (mr *MockAccountServiceIfaceMockRecorder) GetProjectAccountID(keyword, proj
 }
 
 // GetSolidFireAccountId mocks base method.
-func (m *MockAccountServiceIface) GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
+func (m *MockAccountServiceIface) GetSolidFireAccountId(p *GetSolidFireAccountIdParams, opts ...CallOption) (*GetSolidFireAccountIdResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSolidFireAccountId", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSolidFireAccountId", varargs...)
 	ret0, _ := ret[0].(*GetSolidFireAccountIdResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSolidFireAccountId indicates an expected call of GetSolidFireAccountId.
-func (mr *MockAccountServiceIfaceMockRecorder) GetSolidFireAccountId(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) GetSolidFireAccountId(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireAccountId", reflect.TypeOf((*MockAccountServiceIface)(nil).GetSolidFireAccountId), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireAccountId", reflect.TypeOf((*MockAccountServiceIface)(nil).GetSolidFireAccountId), varargs...)
 }
 
 // ListAccounts mocks base method.
-func (m *MockAccountServiceIface) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
+func (m *MockAccountServiceIface) ListAccounts(p *ListAccountsParams, opts ...CallOption) (*ListAccountsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccounts", varargs...)
 	ret0, _ := ret[0].(*ListAccountsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockAccountServiceIfaceMockRecorder) ListAccounts(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) ListAccounts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccounts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccounts), varargs...)
 }
 
 // ListProjectAccounts mocks base method.
-func (m *MockAccountServiceIface) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+func (m *MockAccountServiceIface) ListProjectAccounts(p *ListProjectAccountsParams, opts ...CallOption) (*ListProjectAccountsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjectAccounts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectAccounts", varargs...)
 	ret0, _ := ret[0].(*ListProjectAccountsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProjectAccounts indicates an expected call of ListProjectAccounts.
-func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccounts(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccounts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccounts", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccounts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccounts", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccounts), varargs...)
 }
 
 // LockAccount mocks base method.
-func (m *MockAccountServiceIface) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
+func (m *MockAccountServiceIface) LockAccount(p *LockAccountParams, opts ...CallOption) (*LockAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LockAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LockAccount", varargs...)
 	ret0, _ := ret[0].(*LockAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LockAccount indicates an expected call of LockAccount.
-func (mr *MockAccountServiceIfaceMockRecorder) LockAccount(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) LockAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).LockAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).LockAccount), varargs...)
 }
 
 // MarkDefaultZoneForAccount mocks base method.
-func (m *MockAccountServiceIface) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+func (m *MockAccountServiceIface) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams, opts ...CallOption) (*MarkDefaultZoneForAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkDefaultZoneForAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkDefaultZoneForAccount", varargs...)
 	ret0, _ := ret[0].(*MarkDefaultZoneForAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MarkDefaultZoneForAccount indicates an expected call of MarkDefaultZoneForAccount.
-func (mr *MockAccountServiceIfaceMockRecorder) MarkDefaultZoneForAccount(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) MarkDefaultZoneForAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDefaultZoneForAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).MarkDefaultZoneForAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDefaultZoneForAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).MarkDefaultZoneForAccount), varargs...)
 }
 
 // NewCreateAccountParams mocks base method.
@@ -412,16 +457,21 @@ func (mr *MockAccountServiceIfaceMockRecorder) NewUpdateAccountParams() *gomock.
 }
 
 // UpdateAccount mocks base method.
-func (m *MockAccountServiceIface) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+func (m *MockAccountServiceIface) UpdateAccount(p *UpdateAccountParams, opts ...CallOption) (*UpdateAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAccount", varargs...)
 	ret0, _ := ret[0].(*UpdateAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockAccountServiceIfaceMockRecorder) UpdateAccount(p interface{}) *gomock.Call {
+func (mr *MockAccountServiceIfaceMockRecorder) UpdateAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).UpdateAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).UpdateAccount), varargs...)
 }
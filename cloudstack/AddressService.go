@@ -28,285 +28,428 @@ import (
 )
 
 type AddressServiceIface interface {
-	AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddress(p *AssociateIpAddressParams, opts ...CallOption) (*AssociateIpAddressResponse, error)
 	NewAssociateIpAddressParams() *AssociateIpAddressParams
-	DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddress(p *DisassociateIpAddressParams, opts ...CallOption) (*DisassociateIpAddressResponse, error)
 	NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams
-	ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddresses(p *ListPublicIpAddressesParams, opts ...CallOption) (*ListPublicIpAddressesResponse, error)
 	NewListPublicIpAddressesParams() *ListPublicIpAddressesParams
 	GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
-	UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddress(p *UpdateIpAddressParams, opts ...CallOption) (*UpdateIpAddressResponse, error)
 	NewUpdateIpAddressParams(id string) *UpdateIpAddressParams
-	ReleaseIpAddress(p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error)
+	ReleaseIpAddress(p *ReleaseIpAddressParams, opts ...CallOption) (*ReleaseIpAddressResponse, error)
 	NewReleaseIpAddressParams(id string) *ReleaseIpAddressParams
 }
 
 type AssociateIpAddressParams struct {
-	p map[string]interface{}
-}
-
-func (p *AssociateIpAddressParams) toURLValues() url.Values {
+	account    optString
+	domainid   optString
+	fordisplay optBool
+	ipaddress  optString
+	isportable optBool
+	networkid  optString
+	projectid  optString
+	regionid   optInt
+	vpcid      optString
+	zoneid     optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AssociateIpAddressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["isportable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isportable", vv)
+	if p.isportable.ok {
+		u.Set("isportable", strconv.FormatBool(p.isportable.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
+	if p.regionid.ok {
+		u.Set("regionid", strconv.Itoa(p.regionid.v))
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AssociateIpAddressParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAssociateIpAddressParams parses url.Values, for example taken from a raw API request,
+// into a new AssociateIpAddressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAssociateIpAddressParams(u url.Values) (*AssociateIpAddressParams, error) {
+	p := &AssociateIpAddressParams{}
+	if err := checkParamNames("associateIpAddress", u, "account", "domainid", "fordisplay", "ipaddress", "isportable", "networkid", "projectid", "regionid", "vpcid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["isportable"]; found {
+		v, err := strconv.ParseBool(u.Get("isportable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isportable: %v", err)
+		}
+		p.SetIsportable(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["regionid"]; found {
+		v, err := strconv.Atoi(u.Get("regionid"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param regionid: %v", err)
+		}
+		p.SetRegionid(v)
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
 	}
-	p.p["account"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *AssociateIpAddressParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *AssociateIpAddressParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *AssociateIpAddressParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *AssociateIpAddressParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *AssociateIpAddressParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *AssociateIpAddressParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *AssociateIpAddressParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *AssociateIpAddressParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *AssociateIpAddressParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *AssociateIpAddressParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *AssociateIpAddressParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *AssociateIpAddressParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetIsportable sets the isportable param.
 func (p *AssociateIpAddressParams) SetIsportable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isportable"] = v
+	p.isportable = optBool{v: v, ok: true}
 }
 
+// ResetIsportable unsets the isportable param
 func (p *AssociateIpAddressParams) ResetIsportable() {
-	if p.p != nil && p.p["isportable"] != nil {
-		delete(p.p, "isportable")
-	}
+	p.isportable = optBool{}
 }
 
+// GetIsportable returns the isportable param and if it is set
 func (p *AssociateIpAddressParams) GetIsportable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isportable"].(bool)
-	return value, ok
+	return p.isportable.v, p.isportable.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *AssociateIpAddressParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *AssociateIpAddressParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *AssociateIpAddressParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *AssociateIpAddressParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *AssociateIpAddressParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *AssociateIpAddressParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRegionid sets the regionid param.
 func (p *AssociateIpAddressParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
+	p.regionid = optInt{v: v, ok: true}
 }
 
+// ResetRegionid unsets the regionid param
 func (p *AssociateIpAddressParams) ResetRegionid() {
-	if p.p != nil && p.p["regionid"] != nil {
-		delete(p.p, "regionid")
-	}
+	p.regionid = optInt{}
 }
 
+// GetRegionid returns the regionid param and if it is set
 func (p *AssociateIpAddressParams) GetRegionid() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["regionid"].(int)
-	return value, ok
+	return p.regionid.v, p.regionid.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *AssociateIpAddressParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *AssociateIpAddressParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *AssociateIpAddressParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *AssociateIpAddressParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AssociateIpAddressParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AssociateIpAddressParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AssociateIpAddressParams) Clone() *AssociateIpAddressParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AssociateIpAddressParams) Equal(o *AssociateIpAddressParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.ipaddress == o.ipaddress &&
+		p.isportable == o.isportable &&
+		p.networkid == o.networkid &&
+		p.projectid == o.projectid &&
+		p.regionid == o.regionid &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAssociateIpAddressParams is used to (un)marshal AssociateIpAddressParams using the API param names
+type serializedAssociateIpAddressParams struct {
+	Account    *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid   *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Ipaddress  *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Isportable *bool   `json:"isportable,omitempty" yaml:"isportable,omitempty"`
+	Networkid  *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Projectid  *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Regionid   *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+	Vpcid      *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid     *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AssociateIpAddressParams) toSerialized() *serializedAssociateIpAddressParams {
+	s := &serializedAssociateIpAddressParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.isportable.ok {
+		s.Isportable = &p.isportable.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.regionid.ok {
+		s.Regionid = &p.regionid.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AssociateIpAddressParams) fromSerialized(s *serializedAssociateIpAddressParams) {
+	*p = AssociateIpAddressParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Isportable != nil {
+		p.SetIsportable(*s.Isportable)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Regionid != nil {
+		p.SetRegionid(*s.Regionid)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AssociateIpAddressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AssociateIpAddressParams) UnmarshalJSON(b []byte) error {
+	var s serializedAssociateIpAddressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AssociateIpAddressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AssociateIpAddressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAssociateIpAddressParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AssociateIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewAssociateIpAddressParams() *AssociateIpAddressParams {
 	p := &AssociateIpAddressParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Acquires and associates a public IP to an account.
-func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("associateIpAddress", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams, opts ...CallOption) (*AssociateIpAddressResponse, error) {
+	resp, err := s.cs.newRequest("associateIpAddress", s.cs.encodeParams("associateIpAddress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -317,8 +460,8 @@ func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*Assoc
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -380,77 +523,163 @@ type AssociateIpAddressResponse struct {
 }
 
 type DisassociateIpAddressParams struct {
-	p map[string]interface{}
+	id        optString
+	ipaddress optString
 }
 
-func (p *DisassociateIpAddressParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisassociateIpAddressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
 	return u
 }
 
-func (p *DisassociateIpAddressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisassociateIpAddressParams parses url.Values, for example taken from a raw API request,
+// into a new DisassociateIpAddressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisassociateIpAddressParams(u url.Values) (*DisassociateIpAddressParams, error) {
+	p := &DisassociateIpAddressParams{}
+	if err := checkParamNames("disassociateIpAddress", u, "id", "ipaddress"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DisassociateIpAddressParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DisassociateIpAddressParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DisassociateIpAddressParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *DisassociateIpAddressParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *DisassociateIpAddressParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *DisassociateIpAddressParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ipaddress.v, p.ipaddress.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisassociateIpAddressParams) Clone() *DisassociateIpAddressParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisassociateIpAddressParams) Equal(o *DisassociateIpAddressParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.ipaddress == o.ipaddress
+}
+
+// serializedDisassociateIpAddressParams is used to (un)marshal DisassociateIpAddressParams using the API param names
+type serializedDisassociateIpAddressParams struct {
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddress *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+}
+
+func (p *DisassociateIpAddressParams) toSerialized() *serializedDisassociateIpAddressParams {
+	s := &serializedDisassociateIpAddressParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	return s
+}
+
+func (p *DisassociateIpAddressParams) fromSerialized(s *serializedDisassociateIpAddressParams) {
+	*p = DisassociateIpAddressParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisassociateIpAddressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisassociateIpAddressParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisassociateIpAddressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisassociateIpAddressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisassociateIpAddressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisassociateIpAddressParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisassociateIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams {
 	p := &DisassociateIpAddressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Disassociates an IP address from the account.
-func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("disassociateIpAddress", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams, opts ...CallOption) (*DisassociateIpAddressResponse, error) {
+	resp, err := s.cs.newRequest("disassociateIpAddress", s.cs.encodeParams("disassociateIpAddress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -461,8 +690,8 @@ func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -486,646 +715,902 @@ type DisassociateIpAddressResponse struct {
 }
 
 type ListPublicIpAddressesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
+	account                   optString
+	allocatedonly             optBool
+	associatednetworkid       optString
+	domainid                  optString
+	fordisplay                optBool
+	forloadbalancing          optBool
+	forvirtualnetwork         optBool
+	id                        optString
+	ipaddress                 optString
+	isrecursive               optBool
+	issourcenat               optBool
+	isstaticnat               optBool
+	keyword                   optString
+	listall                   optBool
+	networkid                 optString
+	page                      optInt
+	pagesize                  optInt
+	physicalnetworkid         optString
+	projectid                 optString
+	retrieveonlyresourcecount optBool
+	state                     optString
+	tags                      optStringMap
+	vlanid                    optString
+	vpcid                     optString
+	zoneid                    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPublicIpAddressesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["allocatedonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("allocatedonly", vv)
+	if p.allocatedonly.ok {
+		u.Set("allocatedonly", strconv.FormatBool(p.allocatedonly.v))
 	}
-	if v, found := p.p["associatednetworkid"]; found {
-		u.Set("associatednetworkid", v.(string))
+	if p.associatednetworkid.ok {
+		u.Set("associatednetworkid", p.associatednetworkid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["forloadbalancing"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forloadbalancing", vv)
+	if p.forloadbalancing.ok {
+		u.Set("forloadbalancing", strconv.FormatBool(p.forloadbalancing.v))
 	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
+	if p.forvirtualnetwork.ok {
+		u.Set("forvirtualnetwork", strconv.FormatBool(p.forvirtualnetwork.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["issourcenat"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("issourcenat", vv)
+	if p.issourcenat.ok {
+		u.Set("issourcenat", strconv.FormatBool(p.issourcenat.v))
 	}
-	if v, found := p.p["isstaticnat"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isstaticnat", vv)
+	if p.isstaticnat.ok {
+		u.Set("isstaticnat", strconv.FormatBool(p.isstaticnat.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["retrieveonlyresourcecount"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("retrieveonlyresourcecount", vv)
+	if p.retrieveonlyresourcecount.ok {
+		u.Set("retrieveonlyresourcecount", strconv.FormatBool(p.retrieveonlyresourcecount.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["vlanid"]; found {
-		u.Set("vlanid", v.(string))
+	if p.vlanid.ok {
+		u.Set("vlanid", p.vlanid.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListPublicIpAddressesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPublicIpAddressesParams parses url.Values, for example taken from a raw API request,
+// into a new ListPublicIpAddressesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPublicIpAddressesParams(u url.Values) (*ListPublicIpAddressesParams, error) {
+	p := &ListPublicIpAddressesParams{}
+	if err := checkParamNames("listPublicIpAddresses", u, "account", "allocatedonly", "associatednetworkid", "domainid", "fordisplay", "forloadbalancing", "forvirtualnetwork", "id", "ipaddress", "isrecursive", "issourcenat", "isstaticnat", "keyword", "listall", "networkid", "page", "pagesize", "physicalnetworkid", "projectid", "retrieveonlyresourcecount", "state", "tags[]", "vlanid", "vpcid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["allocatedonly"]; found {
+		v, err := strconv.ParseBool(u.Get("allocatedonly"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param allocatedonly: %v", err)
+		}
+		p.SetAllocatedonly(v)
+	}
+	if _, found := u["associatednetworkid"]; found {
+		p.SetAssociatednetworkid(u.Get("associatednetworkid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["forloadbalancing"]; found {
+		v, err := strconv.ParseBool(u.Get("forloadbalancing"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forloadbalancing: %v", err)
+		}
+		p.SetForloadbalancing(v)
+	}
+	if _, found := u["forvirtualnetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("forvirtualnetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvirtualnetwork: %v", err)
+		}
+		p.SetForvirtualnetwork(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["issourcenat"]; found {
+		v, err := strconv.ParseBool(u.Get("issourcenat"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param issourcenat: %v", err)
+		}
+		p.SetIssourcenat(v)
+	}
+	if _, found := u["isstaticnat"]; found {
+		v, err := strconv.ParseBool(u.Get("isstaticnat"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isstaticnat: %v", err)
+		}
+		p.SetIsstaticnat(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["retrieveonlyresourcecount"]; found {
+		v, err := strconv.ParseBool(u.Get("retrieveonlyresourcecount"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param retrieveonlyresourcecount: %v", err)
+		}
+		p.SetRetrieveonlyresourcecount(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["vlanid"]; found {
+		p.SetVlanid(u.Get("vlanid"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListPublicIpAddressesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListPublicIpAddressesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListPublicIpAddressesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAllocatedonly sets the allocatedonly param.
 func (p *ListPublicIpAddressesParams) SetAllocatedonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocatedonly"] = v
+	p.allocatedonly = optBool{v: v, ok: true}
 }
 
+// ResetAllocatedonly unsets the allocatedonly param
 func (p *ListPublicIpAddressesParams) ResetAllocatedonly() {
-	if p.p != nil && p.p["allocatedonly"] != nil {
-		delete(p.p, "allocatedonly")
-	}
+	p.allocatedonly = optBool{}
 }
 
+// GetAllocatedonly returns the allocatedonly param and if it is set
 func (p *ListPublicIpAddressesParams) GetAllocatedonly() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocatedonly"].(bool)
-	return value, ok
+	return p.allocatedonly.v, p.allocatedonly.ok
 }
 
+// SetAssociatednetworkid sets the associatednetworkid param.
 func (p *ListPublicIpAddressesParams) SetAssociatednetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["associatednetworkid"] = v
+	p.associatednetworkid = optString{v: v, ok: true}
 }
 
+// ResetAssociatednetworkid unsets the associatednetworkid param
 func (p *ListPublicIpAddressesParams) ResetAssociatednetworkid() {
-	if p.p != nil && p.p["associatednetworkid"] != nil {
-		delete(p.p, "associatednetworkid")
-	}
+	p.associatednetworkid = optString{}
 }
 
+// GetAssociatednetworkid returns the associatednetworkid param and if it is set
 func (p *ListPublicIpAddressesParams) GetAssociatednetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["associatednetworkid"].(string)
-	return value, ok
+	return p.associatednetworkid.v, p.associatednetworkid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListPublicIpAddressesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListPublicIpAddressesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListPublicIpAddressesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListPublicIpAddressesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListPublicIpAddressesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListPublicIpAddressesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetForloadbalancing sets the forloadbalancing param.
 func (p *ListPublicIpAddressesParams) SetForloadbalancing(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forloadbalancing"] = v
+	p.forloadbalancing = optBool{v: v, ok: true}
 }
 
+// ResetForloadbalancing unsets the forloadbalancing param
 func (p *ListPublicIpAddressesParams) ResetForloadbalancing() {
-	if p.p != nil && p.p["forloadbalancing"] != nil {
-		delete(p.p, "forloadbalancing")
-	}
+	p.forloadbalancing = optBool{}
 }
 
+// GetForloadbalancing returns the forloadbalancing param and if it is set
 func (p *ListPublicIpAddressesParams) GetForloadbalancing() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forloadbalancing"].(bool)
-	return value, ok
+	return p.forloadbalancing.v, p.forloadbalancing.ok
 }
 
+// SetForvirtualnetwork sets the forvirtualnetwork param.
 func (p *ListPublicIpAddressesParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvirtualnetwork"] = v
+	p.forvirtualnetwork = optBool{v: v, ok: true}
 }
 
+// ResetForvirtualnetwork unsets the forvirtualnetwork param
 func (p *ListPublicIpAddressesParams) ResetForvirtualnetwork() {
-	if p.p != nil && p.p["forvirtualnetwork"] != nil {
-		delete(p.p, "forvirtualnetwork")
-	}
+	p.forvirtualnetwork = optBool{}
 }
 
+// GetForvirtualnetwork returns the forvirtualnetwork param and if it is set
 func (p *ListPublicIpAddressesParams) GetForvirtualnetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvirtualnetwork"].(bool)
-	return value, ok
+	return p.forvirtualnetwork.v, p.forvirtualnetwork.ok
 }
 
+// SetId sets the id param.
 func (p *ListPublicIpAddressesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListPublicIpAddressesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListPublicIpAddressesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *ListPublicIpAddressesParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *ListPublicIpAddressesParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *ListPublicIpAddressesParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListPublicIpAddressesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListPublicIpAddressesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListPublicIpAddressesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetIssourcenat sets the issourcenat param.
 func (p *ListPublicIpAddressesParams) SetIssourcenat(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["issourcenat"] = v
+	p.issourcenat = optBool{v: v, ok: true}
 }
 
+// ResetIssourcenat unsets the issourcenat param
 func (p *ListPublicIpAddressesParams) ResetIssourcenat() {
-	if p.p != nil && p.p["issourcenat"] != nil {
-		delete(p.p, "issourcenat")
-	}
+	p.issourcenat = optBool{}
 }
 
+// GetIssourcenat returns the issourcenat param and if it is set
 func (p *ListPublicIpAddressesParams) GetIssourcenat() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["issourcenat"].(bool)
-	return value, ok
+	return p.issourcenat.v, p.issourcenat.ok
 }
 
+// SetIsstaticnat sets the isstaticnat param.
 func (p *ListPublicIpAddressesParams) SetIsstaticnat(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isstaticnat"] = v
+	p.isstaticnat = optBool{v: v, ok: true}
 }
 
+// ResetIsstaticnat unsets the isstaticnat param
 func (p *ListPublicIpAddressesParams) ResetIsstaticnat() {
-	if p.p != nil && p.p["isstaticnat"] != nil {
-		delete(p.p, "isstaticnat")
-	}
+	p.isstaticnat = optBool{}
 }
 
+// GetIsstaticnat returns the isstaticnat param and if it is set
 func (p *ListPublicIpAddressesParams) GetIsstaticnat() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isstaticnat"].(bool)
-	return value, ok
+	return p.isstaticnat.v, p.isstaticnat.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListPublicIpAddressesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPublicIpAddressesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPublicIpAddressesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListPublicIpAddressesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListPublicIpAddressesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListPublicIpAddressesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListPublicIpAddressesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListPublicIpAddressesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListPublicIpAddressesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPublicIpAddressesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPublicIpAddressesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPublicIpAddressesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPublicIpAddressesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPublicIpAddressesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPublicIpAddressesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListPublicIpAddressesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListPublicIpAddressesParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListPublicIpAddressesParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListPublicIpAddressesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListPublicIpAddressesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListPublicIpAddressesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRetrieveonlyresourcecount sets the retrieveonlyresourcecount param.
 func (p *ListPublicIpAddressesParams) SetRetrieveonlyresourcecount(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["retrieveonlyresourcecount"] = v
+	p.retrieveonlyresourcecount = optBool{v: v, ok: true}
 }
 
+// ResetRetrieveonlyresourcecount unsets the retrieveonlyresourcecount param
 func (p *ListPublicIpAddressesParams) ResetRetrieveonlyresourcecount() {
-	if p.p != nil && p.p["retrieveonlyresourcecount"] != nil {
-		delete(p.p, "retrieveonlyresourcecount")
-	}
+	p.retrieveonlyresourcecount = optBool{}
 }
 
+// GetRetrieveonlyresourcecount returns the retrieveonlyresourcecount param and if it is set
 func (p *ListPublicIpAddressesParams) GetRetrieveonlyresourcecount() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["retrieveonlyresourcecount"].(bool)
-	return value, ok
+	return p.retrieveonlyresourcecount.v, p.retrieveonlyresourcecount.ok
 }
 
+// SetState sets the state param.
 func (p *ListPublicIpAddressesParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListPublicIpAddressesParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListPublicIpAddressesParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListPublicIpAddressesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListPublicIpAddressesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListPublicIpAddressesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetVlanid sets the vlanid param.
 func (p *ListPublicIpAddressesParams) SetVlanid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlanid"] = v
+	p.vlanid = optString{v: v, ok: true}
 }
 
+// ResetVlanid unsets the vlanid param
 func (p *ListPublicIpAddressesParams) ResetVlanid() {
-	if p.p != nil && p.p["vlanid"] != nil {
-		delete(p.p, "vlanid")
-	}
+	p.vlanid = optString{}
 }
 
+// GetVlanid returns the vlanid param and if it is set
 func (p *ListPublicIpAddressesParams) GetVlanid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlanid"].(string)
-	return value, ok
+	return p.vlanid.v, p.vlanid.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListPublicIpAddressesParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListPublicIpAddressesParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListPublicIpAddressesParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListPublicIpAddressesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListPublicIpAddressesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListPublicIpAddressesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPublicIpAddressesParams) Clone() *ListPublicIpAddressesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPublicIpAddressesParams) Equal(o *ListPublicIpAddressesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.allocatedonly == o.allocatedonly &&
+		p.associatednetworkid == o.associatednetworkid &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.forloadbalancing == o.forloadbalancing &&
+		p.forvirtualnetwork == o.forvirtualnetwork &&
+		p.id == o.id &&
+		p.ipaddress == o.ipaddress &&
+		p.isrecursive == o.isrecursive &&
+		p.issourcenat == o.issourcenat &&
+		p.isstaticnat == o.isstaticnat &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.projectid == o.projectid &&
+		p.retrieveonlyresourcecount == o.retrieveonlyresourcecount &&
+		p.state == o.state &&
+		p.tags.equal(o.tags) &&
+		p.vlanid == o.vlanid &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListPublicIpAddressesParams is used to (un)marshal ListPublicIpAddressesParams using the API param names
+type serializedListPublicIpAddressesParams struct {
+	Account                   *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Allocatedonly             *bool              `json:"allocatedonly,omitempty" yaml:"allocatedonly,omitempty"`
+	Associatednetworkid       *string            `json:"associatednetworkid,omitempty" yaml:"associatednetworkid,omitempty"`
+	Domainid                  *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay                *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Forloadbalancing          *bool              `json:"forloadbalancing,omitempty" yaml:"forloadbalancing,omitempty"`
+	Forvirtualnetwork         *bool              `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Id                        *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddress                 *string            `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Isrecursive               *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Issourcenat               *bool              `json:"issourcenat,omitempty" yaml:"issourcenat,omitempty"`
+	Isstaticnat               *bool              `json:"isstaticnat,omitempty" yaml:"isstaticnat,omitempty"`
+	Keyword                   *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                   *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid                 *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page                      *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                  *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid         *string            `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid                 *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Retrieveonlyresourcecount *bool              `json:"retrieveonlyresourcecount,omitempty" yaml:"retrieveonlyresourcecount,omitempty"`
+	State                     *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags                      *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vlanid                    *string            `json:"vlanid,omitempty" yaml:"vlanid,omitempty"`
+	Vpcid                     *string            `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid                    *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListPublicIpAddressesParams) toSerialized() *serializedListPublicIpAddressesParams {
+	s := &serializedListPublicIpAddressesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.allocatedonly.ok {
+		s.Allocatedonly = &p.allocatedonly.v
+	}
+	if p.associatednetworkid.ok {
+		s.Associatednetworkid = &p.associatednetworkid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.forloadbalancing.ok {
+		s.Forloadbalancing = &p.forloadbalancing.v
+	}
+	if p.forvirtualnetwork.ok {
+		s.Forvirtualnetwork = &p.forvirtualnetwork.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.issourcenat.ok {
+		s.Issourcenat = &p.issourcenat.v
+	}
+	if p.isstaticnat.ok {
+		s.Isstaticnat = &p.isstaticnat.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.retrieveonlyresourcecount.ok {
+		s.Retrieveonlyresourcecount = &p.retrieveonlyresourcecount.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.vlanid.ok {
+		s.Vlanid = &p.vlanid.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListPublicIpAddressesParams) fromSerialized(s *serializedListPublicIpAddressesParams) {
+	*p = ListPublicIpAddressesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Allocatedonly != nil {
+		p.SetAllocatedonly(*s.Allocatedonly)
+	}
+	if s.Associatednetworkid != nil {
+		p.SetAssociatednetworkid(*s.Associatednetworkid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Forloadbalancing != nil {
+		p.SetForloadbalancing(*s.Forloadbalancing)
+	}
+	if s.Forvirtualnetwork != nil {
+		p.SetForvirtualnetwork(*s.Forvirtualnetwork)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Issourcenat != nil {
+		p.SetIssourcenat(*s.Issourcenat)
+	}
+	if s.Isstaticnat != nil {
+		p.SetIsstaticnat(*s.Isstaticnat)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Retrieveonlyresourcecount != nil {
+		p.SetRetrieveonlyresourcecount(*s.Retrieveonlyresourcecount)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Vlanid != nil {
+		p.SetVlanid(*s.Vlanid)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPublicIpAddressesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPublicIpAddressesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPublicIpAddressesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPublicIpAddressesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPublicIpAddressesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPublicIpAddressesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPublicIpAddressesParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewListPublicIpAddressesParams() *ListPublicIpAddressesParams {
 	p := &ListPublicIpAddressesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
 	p := &ListPublicIpAddressesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1153,9 +1638,9 @@ func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (
 	return nil, l.Count, fmt.Errorf("There is more then one result for PublicIpAddress UUID: %s!", id)
 }
 
-// Lists all public ip addresses
-func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
-	resp, err := s.cs.newRequest("listPublicIpAddresses", p.toURLValues())
+// Lists all public ip addresses.
+func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams, opts ...CallOption) (*ListPublicIpAddressesResponse, error) {
+	resp, err := s.cs.newRequest("listPublicIpAddresses", s.cs.encodeParams("listPublicIpAddresses", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1214,102 +1699,197 @@ type PublicIpAddress struct {
 }
 
 type UpdateIpAddressParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateIpAddressParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateIpAddressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateIpAddressParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateIpAddressParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateIpAddressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateIpAddressParams(u url.Values) (*UpdateIpAddressParams, error) {
+	p := &UpdateIpAddressParams{}
+	if err := checkParamNames("updateIpAddress", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
 	}
-	p.p["customid"] = v
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetCustomid sets the customid param.
+func (p *UpdateIpAddressParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
+}
+
+// ResetCustomid unsets the customid param
 func (p *UpdateIpAddressParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateIpAddressParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateIpAddressParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateIpAddressParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateIpAddressParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateIpAddressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateIpAddressParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateIpAddressParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateIpAddressParams) Clone() *UpdateIpAddressParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateIpAddressParams) Equal(o *UpdateIpAddressParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateIpAddressParams is used to (un)marshal UpdateIpAddressParams using the API param names
+type serializedUpdateIpAddressParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateIpAddressParams) toSerialized() *serializedUpdateIpAddressParams {
+	s := &serializedUpdateIpAddressParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateIpAddressParams) fromSerialized(s *serializedUpdateIpAddressParams) {
+	*p = UpdateIpAddressParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateIpAddressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateIpAddressParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateIpAddressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateIpAddressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateIpAddressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateIpAddressParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressParams {
 	p := &UpdateIpAddressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates an IP address
-func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("updateIpAddress", p.toURLValues())
+// Updates an IP address.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams, opts ...CallOption) (*UpdateIpAddressResponse, error) {
+	resp, err := s.cs.newRequest("updateIpAddress", s.cs.encodeParams("updateIpAddress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1320,8 +1900,8 @@ func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAdd
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1383,53 +1963,132 @@ type UpdateIpAddressResponse struct {
 }
 
 type ReleaseIpAddressParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ReleaseIpAddressParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleaseIpAddressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ReleaseIpAddressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleaseIpAddressParams parses url.Values, for example taken from a raw API request,
+// into a new ReleaseIpAddressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleaseIpAddressParams(u url.Values) (*ReleaseIpAddressParams, error) {
+	p := &ReleaseIpAddressParams{}
+	if err := checkParamNames("releaseIpAddress", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ReleaseIpAddressParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ReleaseIpAddressParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ReleaseIpAddressParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleaseIpAddressParams) Clone() *ReleaseIpAddressParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleaseIpAddressParams) Equal(o *ReleaseIpAddressParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id == o.id
+}
+
+// serializedReleaseIpAddressParams is used to (un)marshal ReleaseIpAddressParams using the API param names
+type serializedReleaseIpAddressParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ReleaseIpAddressParams) toSerialized() *serializedReleaseIpAddressParams {
+	s := &serializedReleaseIpAddressParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ReleaseIpAddressParams) fromSerialized(s *serializedReleaseIpAddressParams) {
+	*p = ReleaseIpAddressParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleaseIpAddressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleaseIpAddressParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleaseIpAddressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleaseIpAddressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleaseIpAddressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleaseIpAddressParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleaseIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewReleaseIpAddressParams(id string) *ReleaseIpAddressParams {
 	p := &ReleaseIpAddressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Releases an IP address from the account.
-func (s *AddressService) ReleaseIpAddress(p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("releaseIpAddress", p.toURLValues())
+//
+// Required params: id.
+func (s *AddressService) ReleaseIpAddress(p *ReleaseIpAddressParams, opts ...CallOption) (*ReleaseIpAddressResponse, error) {
+	resp, err := s.cs.newRequest("releaseIpAddress", s.cs.encodeParams("releaseIpAddress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
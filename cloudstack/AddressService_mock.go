@@ -53,33 +53,43 @@ func (m *MockAddressServiceIface) EXPECT() *MockAddressServiceIfaceMockRecorder
 }
 
 // AssociateIpAddress mocks base method.
-func (m *MockAddressServiceIface) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
+func (m *MockAddressServiceIface) AssociateIpAddress(p *AssociateIpAddressParams, opts ...CallOption) (*AssociateIpAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssociateIpAddress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssociateIpAddress", varargs...)
 	ret0, _ := ret[0].(*AssociateIpAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssociateIpAddress indicates an expected call of AssociateIpAddress.
-func (mr *MockAddressServiceIfaceMockRecorder) AssociateIpAddress(p interface{}) *gomock.Call {
+func (mr *MockAddressServiceIfaceMockRecorder) AssociateIpAddress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).AssociateIpAddress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).AssociateIpAddress), varargs...)
 }
 
 // DisassociateIpAddress mocks base method.
-func (m *MockAddressServiceIface) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+func (m *MockAddressServiceIface) DisassociateIpAddress(p *DisassociateIpAddressParams, opts ...CallOption) (*DisassociateIpAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisassociateIpAddress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisassociateIpAddress", varargs...)
 	ret0, _ := ret[0].(*DisassociateIpAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisassociateIpAddress indicates an expected call of DisassociateIpAddress.
-func (mr *MockAddressServiceIfaceMockRecorder) DisassociateIpAddress(p interface{}) *gomock.Call {
+func (mr *MockAddressServiceIfaceMockRecorder) DisassociateIpAddress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).DisassociateIpAddress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).DisassociateIpAddress), varargs...)
 }
 
 // GetPublicIpAddressByID mocks base method.
@@ -104,18 +114,23 @@ func (mr *MockAddressServiceIfaceMockRecorder) GetPublicIpAddressByID(id interfa
 }
 
 // ListPublicIpAddresses mocks base method.
-func (m *MockAddressServiceIface) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+func (m *MockAddressServiceIface) ListPublicIpAddresses(p *ListPublicIpAddressesParams, opts ...CallOption) (*ListPublicIpAddressesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPublicIpAddresses", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPublicIpAddresses", varargs...)
 	ret0, _ := ret[0].(*ListPublicIpAddressesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPublicIpAddresses indicates an expected call of ListPublicIpAddresses.
-func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddresses(p interface{}) *gomock.Call {
+func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddresses(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddresses", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddresses), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddresses", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddresses), varargs...)
 }
 
 // NewAssociateIpAddressParams mocks base method.
@@ -189,31 +204,41 @@ func (mr *MockAddressServiceIfaceMockRecorder) NewUpdateIpAddressParams(id inter
 }
 
 // ReleaseIpAddress mocks base method.
-func (m *MockAddressServiceIface) ReleaseIpAddress(p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error) {
+func (m *MockAddressServiceIface) ReleaseIpAddress(p *ReleaseIpAddressParams, opts ...CallOption) (*ReleaseIpAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseIpAddress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseIpAddress", varargs...)
 	ret0, _ := ret[0].(*ReleaseIpAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseIpAddress indicates an expected call of ReleaseIpAddress.
-func (mr *MockAddressServiceIfaceMockRecorder) ReleaseIpAddress(p interface{}) *gomock.Call {
+func (mr *MockAddressServiceIfaceMockRecorder) ReleaseIpAddress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).ReleaseIpAddress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).ReleaseIpAddress), varargs...)
 }
 
 // UpdateIpAddress mocks base method.
-func (m *MockAddressServiceIface) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+func (m *MockAddressServiceIface) UpdateIpAddress(p *UpdateIpAddressParams, opts ...CallOption) (*UpdateIpAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIpAddress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateIpAddress", varargs...)
 	ret0, _ := ret[0].(*UpdateIpAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIpAddress indicates an expected call of UpdateIpAddress.
-func (mr *MockAddressServiceIfaceMockRecorder) UpdateIpAddress(p interface{}) *gomock.Call {
+func (mr *MockAddressServiceIfaceMockRecorder) UpdateIpAddress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).UpdateIpAddress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).UpdateIpAddress), varargs...)
 }
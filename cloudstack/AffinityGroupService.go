@@ -28,190 +28,300 @@ import (
 )
 
 type AffinityGroupServiceIface interface {
-	CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroup(p *CreateAffinityGroupParams, opts ...CallOption) (*CreateAffinityGroupResponse, error)
 	NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams
-	DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroup(p *DeleteAffinityGroupParams, opts ...CallOption) (*DeleteAffinityGroupResponse, error)
 	NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams
-	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams, opts ...CallOption) (*ListAffinityGroupTypesResponse, error)
 	NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams
-	ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroups(p *ListAffinityGroupsParams, opts ...CallOption) (*ListAffinityGroupsResponse, error)
 	NewListAffinityGroupsParams() *ListAffinityGroupsParams
 	GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error)
 	GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
 	GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
-	UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams, opts ...CallOption) (*UpdateVMAffinityGroupResponse, error)
 	NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams
 }
 
 type CreateAffinityGroupParams struct {
-	p map[string]interface{}
+	account     optString
+	description optString
+	domainid    optString
+	name        optString
+	projectid   optString
+	type_       optString
 }
 
-func (p *CreateAffinityGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateAffinityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *CreateAffinityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateAffinityGroupParams parses url.Values, for example taken from a raw API request,
+// into a new CreateAffinityGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateAffinityGroupParams(u url.Values) (*CreateAffinityGroupParams, error) {
+	p := &CreateAffinityGroupParams{}
+	if err := checkParamNames("createAffinityGroup", u, "account", "description", "domainid", "name", "projectid", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateAffinityGroupParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateAffinityGroupParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateAffinityGroupParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDescription sets the description param.
 func (p *CreateAffinityGroupParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateAffinityGroupParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateAffinityGroupParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateAffinityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateAffinityGroupParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateAffinityGroupParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateAffinityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateAffinityGroupParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateAffinityGroupParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateAffinityGroupParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateAffinityGroupParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateAffinityGroupParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetType sets the type param. This param is required.
 func (p *CreateAffinityGroupParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *CreateAffinityGroupParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *CreateAffinityGroupParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateAffinityGroupParams) Clone() *CreateAffinityGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateAffinityGroupParams) Equal(o *CreateAffinityGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.description == o.description &&
+		p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.projectid == o.projectid &&
+		p.type_ == o.type_
+}
+
+// serializedCreateAffinityGroupParams is used to (un)marshal CreateAffinityGroupParams using the API param names
+type serializedCreateAffinityGroupParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Type        *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *CreateAffinityGroupParams) toSerialized() *serializedCreateAffinityGroupParams {
+	s := &serializedCreateAffinityGroupParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *CreateAffinityGroupParams) fromSerialized(s *serializedCreateAffinityGroupParams) {
+	*p = CreateAffinityGroupParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateAffinityGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateAffinityGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateAffinityGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateAffinityGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateAffinityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams {
 	p := &CreateAffinityGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["type"] = affinityGroupType
+	p.SetName(name)
+	p.SetType(affinityGroupType)
 	return p
 }
 
-// Creates an affinity/anti-affinity group
-func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAffinityGroup", p.toURLValues())
+// Creates an affinity/anti-affinity group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: name, type.
+func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams, opts ...CallOption) (*CreateAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequest("createAffinityGroup", s.cs.encodeParams("createAffinityGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -222,8 +332,8 @@ func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -260,148 +370,252 @@ type CreateAffinityGroupResponse struct {
 }
 
 type DeleteAffinityGroupParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	id        optString
+	name      optString
+	projectid optString
 }
 
-func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteAffinityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *DeleteAffinityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteAffinityGroupParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteAffinityGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteAffinityGroupParams(u url.Values) (*DeleteAffinityGroupParams, error) {
+	p := &DeleteAffinityGroupParams{}
+	if err := checkParamNames("deleteAffinityGroup", u, "account", "domainid", "id", "name", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *DeleteAffinityGroupParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *DeleteAffinityGroupParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DeleteAffinityGroupParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *DeleteAffinityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DeleteAffinityGroupParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DeleteAffinityGroupParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *DeleteAffinityGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteAffinityGroupParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteAffinityGroupParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *DeleteAffinityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *DeleteAffinityGroupParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *DeleteAffinityGroupParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *DeleteAffinityGroupParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DeleteAffinityGroupParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DeleteAffinityGroupParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteAffinityGroupParams) Clone() *DeleteAffinityGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteAffinityGroupParams) Equal(o *DeleteAffinityGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.projectid == o.projectid
+}
+
+// serializedDeleteAffinityGroupParams is used to (un)marshal DeleteAffinityGroupParams using the API param names
+type serializedDeleteAffinityGroupParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *DeleteAffinityGroupParams) toSerialized() *serializedDeleteAffinityGroupParams {
+	s := &serializedDeleteAffinityGroupParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *DeleteAffinityGroupParams) fromSerialized(s *serializedDeleteAffinityGroupParams) {
+	*p = DeleteAffinityGroupParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteAffinityGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteAffinityGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteAffinityGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteAffinityGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteAffinityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams {
 	p := &DeleteAffinityGroupParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Deletes affinity group
-func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAffinityGroup", p.toURLValues())
+// Deletes affinity group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams, opts ...CallOption) (*DeleteAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequest("deleteAffinityGroup", s.cs.encodeParams("deleteAffinityGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -412,8 +626,8 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -437,102 +651,197 @@ type DeleteAffinityGroupResponse struct {
 }
 
 type ListAffinityGroupTypesParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAffinityGroupTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAffinityGroupTypesParams parses url.Values, for example taken from a raw API request,
+// into a new ListAffinityGroupTypesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAffinityGroupTypesParams(u url.Values) (*ListAffinityGroupTypesParams, error) {
+	p := &ListAffinityGroupTypesParams{}
+	if err := checkParamNames("listAffinityGroupTypes", u, "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["keyword"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAffinityGroupTypesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAffinityGroupTypesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAffinityGroupTypesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAffinityGroupTypesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAffinityGroupTypesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAffinityGroupTypesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAffinityGroupTypesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAffinityGroupTypesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAffinityGroupTypesParams) Clone() *ListAffinityGroupTypesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAffinityGroupTypesParams) Equal(o *ListAffinityGroupTypesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListAffinityGroupTypesParams is used to (un)marshal ListAffinityGroupTypesParams using the API param names
+type serializedListAffinityGroupTypesParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListAffinityGroupTypesParams) toSerialized() *serializedListAffinityGroupTypesParams {
+	s := &serializedListAffinityGroupTypesParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListAffinityGroupTypesParams) fromSerialized(s *serializedListAffinityGroupTypesParams) {
+	*p = ListAffinityGroupTypesParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAffinityGroupTypesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAffinityGroupTypesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAffinityGroupTypesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAffinityGroupTypesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAffinityGroupTypesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAffinityGroupTypesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAffinityGroupTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams {
 	p := &ListAffinityGroupTypesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists affinity group types available
-func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroupTypes", p.toURLValues())
+// Lists affinity group types available.
+func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams, opts ...CallOption) (*ListAffinityGroupTypesResponse, error) {
+	resp, err := s.cs.newRequest("listAffinityGroupTypes", s.cs.encodeParams("listAffinityGroupTypes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -557,323 +866,477 @@ type AffinityGroupType struct {
 }
 
 type ListAffinityGroupsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAffinityGroupsParams) toURLValues() url.Values {
+	account          optString
+	domainid         optString
+	id               optString
+	isrecursive      optBool
+	keyword          optString
+	listall          optBool
+	name             optString
+	page             optInt
+	pagesize         optInt
+	projectid        optString
+	type_            optString
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAffinityGroupsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *ListAffinityGroupsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAffinityGroupsParams parses url.Values, for example taken from a raw API request,
+// into a new ListAffinityGroupsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAffinityGroupsParams(u url.Values) (*ListAffinityGroupsParams, error) {
+	p := &ListAffinityGroupsParams{}
+	if err := checkParamNames("listAffinityGroups", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid", "type", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["account"] = v
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListAffinityGroupsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListAffinityGroupsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListAffinityGroupsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListAffinityGroupsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListAffinityGroupsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListAffinityGroupsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListAffinityGroupsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListAffinityGroupsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListAffinityGroupsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListAffinityGroupsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListAffinityGroupsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListAffinityGroupsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAffinityGroupsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAffinityGroupsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAffinityGroupsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListAffinityGroupsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListAffinityGroupsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListAffinityGroupsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListAffinityGroupsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListAffinityGroupsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListAffinityGroupsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAffinityGroupsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAffinityGroupsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAffinityGroupsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAffinityGroupsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAffinityGroupsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAffinityGroupsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListAffinityGroupsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListAffinityGroupsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListAffinityGroupsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetType sets the type param.
 func (p *ListAffinityGroupsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListAffinityGroupsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListAffinityGroupsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListAffinityGroupsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListAffinityGroupsParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListAffinityGroupsParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAffinityGroupsParams) Clone() *ListAffinityGroupsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAffinityGroupsParams) Equal(o *ListAffinityGroupsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.type_ == o.type_ &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedListAffinityGroupsParams is used to (un)marshal ListAffinityGroupsParams using the API param names
+type serializedListAffinityGroupsParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Type             *string `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *ListAffinityGroupsParams) toSerialized() *serializedListAffinityGroupsParams {
+	s := &serializedListAffinityGroupsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *ListAffinityGroupsParams) fromSerialized(s *serializedListAffinityGroupsParams) {
+	*p = ListAffinityGroupsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAffinityGroupsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAffinityGroupsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAffinityGroupsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAffinityGroupsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAffinityGroupsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAffinityGroupsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAffinityGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewListAffinityGroupsParams() *ListAffinityGroupsParams {
 	p := &ListAffinityGroupsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAffinityGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -925,9 +1388,8 @@ func (s *AffinityGroupService) GetAffinityGroupByName(name string, opts ...Optio
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
 	p := &ListAffinityGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -959,9 +1421,9 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 	return nil, l.Count, fmt.Errorf("There is more then one result for AffinityGroup UUID: %s!", id)
 }
 
-// Lists affinity groups
-func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroups", p.toURLValues())
+// Lists affinity groups.
+func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams, opts ...CallOption) (*ListAffinityGroupsResponse, error) {
+	resp, err := s.cs.newRequest("listAffinityGroups", s.cs.encodeParams("listAffinityGroups", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -995,103 +1457,196 @@ type AffinityGroup struct {
 }
 
 type UpdateVMAffinityGroupParams struct {
-	p map[string]interface{}
+	affinitygroupids   optStrings
+	affinitygroupnames optStrings
+	id                 optString
 }
 
-func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVMAffinityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["affinitygroupids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("affinitygroupids", vv)
+	if p.affinitygroupids.ok {
+		u.Set("affinitygroupids", strings.Join(p.affinitygroupids.v, ","))
 	}
-	if v, found := p.p["affinitygroupnames"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("affinitygroupnames", vv)
+	if p.affinitygroupnames.ok {
+		u.Set("affinitygroupnames", strings.Join(p.affinitygroupnames.v, ","))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVMAffinityGroupParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVMAffinityGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVMAffinityGroupParams(u url.Values) (*UpdateVMAffinityGroupParams, error) {
+	p := &UpdateVMAffinityGroupParams{}
+	if err := checkParamNames("updateVMAffinityGroup", u, "affinitygroupids", "affinitygroupnames", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["affinitygroupids"]; found {
+		p.SetAffinitygroupids(strings.Split(u.Get("affinitygroupids"), ","))
+	}
+	if _, found := u["affinitygroupnames"]; found {
+		p.SetAffinitygroupnames(strings.Split(u.Get("affinitygroupnames"), ","))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["affinitygroupids"] = v
+	return p, nil
 }
 
+// SetAffinitygroupids sets the affinitygroupids param.
+func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
+	p.affinitygroupids = optStrings{v: v, ok: true}
+}
+
+// ResetAffinitygroupids unsets the affinitygroupids param
 func (p *UpdateVMAffinityGroupParams) ResetAffinitygroupids() {
-	if p.p != nil && p.p["affinitygroupids"] != nil {
-		delete(p.p, "affinitygroupids")
-	}
+	p.affinitygroupids = optStrings{}
 }
 
+// GetAffinitygroupids returns the affinitygroupids param and if it is set
 func (p *UpdateVMAffinityGroupParams) GetAffinitygroupids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupids"].([]string)
-	return value, ok
+	return p.affinitygroupids.v, p.affinitygroupids.ok
 }
 
+// SetAffinitygroupnames sets the affinitygroupnames param.
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnames(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupnames"] = v
+	p.affinitygroupnames = optStrings{v: v, ok: true}
 }
 
+// ResetAffinitygroupnames unsets the affinitygroupnames param
 func (p *UpdateVMAffinityGroupParams) ResetAffinitygroupnames() {
-	if p.p != nil && p.p["affinitygroupnames"] != nil {
-		delete(p.p, "affinitygroupnames")
-	}
+	p.affinitygroupnames = optStrings{}
 }
 
+// GetAffinitygroupnames returns the affinitygroupnames param and if it is set
 func (p *UpdateVMAffinityGroupParams) GetAffinitygroupnames() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupnames"].([]string)
-	return value, ok
+	return p.affinitygroupnames.v, p.affinitygroupnames.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVMAffinityGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVMAffinityGroupParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVMAffinityGroupParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVMAffinityGroupParams) Clone() *UpdateVMAffinityGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.affinitygroupids = p.affinitygroupids.clone()
+	c.affinitygroupnames = p.affinitygroupnames.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVMAffinityGroupParams) Equal(o *UpdateVMAffinityGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.affinitygroupids.equal(o.affinitygroupids) &&
+		p.affinitygroupnames.equal(o.affinitygroupnames) &&
+		p.id == o.id
+}
+
+// serializedUpdateVMAffinityGroupParams is used to (un)marshal UpdateVMAffinityGroupParams using the API param names
+type serializedUpdateVMAffinityGroupParams struct {
+	Affinitygroupids   *[]string `json:"affinitygroupids,omitempty" yaml:"affinitygroupids,omitempty"`
+	Affinitygroupnames *[]string `json:"affinitygroupnames,omitempty" yaml:"affinitygroupnames,omitempty"`
+	Id                 *string   `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateVMAffinityGroupParams) toSerialized() *serializedUpdateVMAffinityGroupParams {
+	s := &serializedUpdateVMAffinityGroupParams{}
+	if p.affinitygroupids.ok {
+		s.Affinitygroupids = &p.affinitygroupids.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	if p.affinitygroupnames.ok {
+		s.Affinitygroupnames = &p.affinitygroupnames.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateVMAffinityGroupParams) fromSerialized(s *serializedUpdateVMAffinityGroupParams) {
+	*p = UpdateVMAffinityGroupParams{}
+	if s.Affinitygroupids != nil {
+		p.SetAffinitygroupids(*s.Affinitygroupids)
+	}
+	if s.Affinitygroupnames != nil {
+		p.SetAffinitygroupnames(*s.Affinitygroupnames)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVMAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVMAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVMAffinityGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVMAffinityGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVMAffinityGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVMAffinityGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVMAffinityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams {
 	p := &UpdateVMAffinityGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates the affinity/anti-affinity group associations of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect.
-func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateVMAffinityGroup", p.toURLValues())
+// Updates the affinity/anti-affinity group associations of a virtual machine. The VM has to be
+// stopped and restarted for the new properties to take effect.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams, opts ...CallOption) (*UpdateVMAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequest("updateVMAffinityGroup", s.cs.encodeParams("updateVMAffinityGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1102,8 +1657,8 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,33 +53,43 @@ func (m *MockAffinityGroupServiceIface) EXPECT() *MockAffinityGroupServiceIfaceM
 }
 
 // CreateAffinityGroup mocks base method.
-func (m *MockAffinityGroupServiceIface) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
+func (m *MockAffinityGroupServiceIface) CreateAffinityGroup(p *CreateAffinityGroupParams, opts ...CallOption) (*CreateAffinityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAffinityGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAffinityGroup", varargs...)
 	ret0, _ := ret[0].(*CreateAffinityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAffinityGroup indicates an expected call of CreateAffinityGroup.
-func (mr *MockAffinityGroupServiceIfaceMockRecorder) CreateAffinityGroup(p interface{}) *gomock.Call {
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) CreateAffinityGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).CreateAffinityGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).CreateAffinityGroup), varargs...)
 }
 
 // DeleteAffinityGroup mocks base method.
-func (m *MockAffinityGroupServiceIface) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
+func (m *MockAffinityGroupServiceIface) DeleteAffinityGroup(p *DeleteAffinityGroupParams, opts ...CallOption) (*DeleteAffinityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAffinityGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAffinityGroup", varargs...)
 	ret0, _ := ret[0].(*DeleteAffinityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAffinityGroup indicates an expected call of DeleteAffinityGroup.
-func (mr *MockAffinityGroupServiceIfaceMockRecorder) DeleteAffinityGroup(p interface{}) *gomock.Call {
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) DeleteAffinityGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).DeleteAffinityGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).DeleteAffinityGroup), varargs...)
 }
 
 // GetAffinityGroupByID mocks base method.
@@ -146,33 +156,43 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) GetAffinityGroupID(name int
 }
 
 // ListAffinityGroupTypes mocks base method.
-func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams, opts ...CallOption) (*ListAffinityGroupTypesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAffinityGroupTypes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupTypes", varargs...)
 	ret0, _ := ret[0].(*ListAffinityGroupTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAffinityGroupTypes indicates an expected call of ListAffinityGroupTypes.
-func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypes(p interface{}) *gomock.Call {
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypes", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypes", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypes), varargs...)
 }
 
 // ListAffinityGroups mocks base method.
-func (m *MockAffinityGroupServiceIface) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+func (m *MockAffinityGroupServiceIface) ListAffinityGroups(p *ListAffinityGroupsParams, opts ...CallOption) (*ListAffinityGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAffinityGroups", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroups", varargs...)
 	ret0, _ := ret[0].(*ListAffinityGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAffinityGroups indicates an expected call of ListAffinityGroups.
-func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroups(p interface{}) *gomock.Call {
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroups(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroups", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroups), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroups", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroups), varargs...)
 }
 
 // NewCreateAffinityGroupParams mocks base method.
@@ -246,16 +266,21 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) NewUpdateVMAffinityGroupPar
 }
 
 // UpdateVMAffinityGroup mocks base method.
-func (m *MockAffinityGroupServiceIface) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
+func (m *MockAffinityGroupServiceIface) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams, opts ...CallOption) (*UpdateVMAffinityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVMAffinityGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVMAffinityGroup", varargs...)
 	ret0, _ := ret[0].(*UpdateVMAffinityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVMAffinityGroup indicates an expected call of UpdateVMAffinityGroup.
-func (mr *MockAffinityGroupServiceIfaceMockRecorder) UpdateVMAffinityGroup(p interface{}) *gomock.Call {
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) UpdateVMAffinityGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).UpdateVMAffinityGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).UpdateVMAffinityGroup), varargs...)
 }
@@ -28,13 +28,13 @@ import (
 )
 
 type AlertServiceIface interface {
-	ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	ArchiveAlerts(p *ArchiveAlertsParams, opts ...CallOption) (*ArchiveAlertsResponse, error)
 	NewArchiveAlertsParams() *ArchiveAlertsParams
-	DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	DeleteAlerts(p *DeleteAlertsParams, opts ...CallOption) (*DeleteAlertsResponse, error)
 	NewDeleteAlertsParams() *DeleteAlertsParams
-	GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlert(p *GenerateAlertParams, opts ...CallOption) (*GenerateAlertResponse, error)
 	NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams
-	ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAlerts(p *ListAlertsParams, opts ...CallOption) (*ListAlertsResponse, error)
 	NewListAlertsParams() *ListAlertsParams
 	GetAlertID(name string, opts ...OptionFunc) (string, int, error)
 	GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error)
@@ -42,125 +42,220 @@ type AlertServiceIface interface {
 }
 
 type ArchiveAlertsParams struct {
-	p map[string]interface{}
+	enddate   optString
+	ids       optStrings
+	startdate optString
+	type_     optString
 }
 
-func (p *ArchiveAlertsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ArchiveAlertsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *ArchiveAlertsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseArchiveAlertsParams parses url.Values, for example taken from a raw API request,
+// into a new ArchiveAlertsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseArchiveAlertsParams(u url.Values) (*ArchiveAlertsParams, error) {
+	p := &ArchiveAlertsParams{}
+	if err := checkParamNames("archiveAlerts", u, "enddate", "ids", "startdate", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
 	}
-	p.p["enddate"] = v
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
 }
 
+// SetEnddate sets the enddate param.
+func (p *ArchiveAlertsParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
 func (p *ArchiveAlertsParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *ArchiveAlertsParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetIds sets the ids param.
 func (p *ArchiveAlertsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ArchiveAlertsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ArchiveAlertsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetStartdate sets the startdate param.
 func (p *ArchiveAlertsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *ArchiveAlertsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *ArchiveAlertsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	return p.startdate.v, p.startdate.ok
 }
 
+// SetType sets the type param.
 func (p *ArchiveAlertsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ArchiveAlertsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ArchiveAlertsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ArchiveAlertsParams) Clone() *ArchiveAlertsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ArchiveAlertsParams) Equal(o *ArchiveAlertsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enddate == o.enddate &&
+		p.ids.equal(o.ids) &&
+		p.startdate == o.startdate &&
+		p.type_ == o.type_
+}
+
+// serializedArchiveAlertsParams is used to (un)marshal ArchiveAlertsParams using the API param names
+type serializedArchiveAlertsParams struct {
+	Enddate   *string   `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       *[]string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string   `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *ArchiveAlertsParams) toSerialized() *serializedArchiveAlertsParams {
+	s := &serializedArchiveAlertsParams{}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ArchiveAlertsParams) fromSerialized(s *serializedArchiveAlertsParams) {
+	*p = ArchiveAlertsParams{}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ArchiveAlertsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ArchiveAlertsParams) UnmarshalJSON(b []byte) error {
+	var s serializedArchiveAlertsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ArchiveAlertsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ArchiveAlertsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedArchiveAlertsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ArchiveAlertsParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
 	p := &ArchiveAlertsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Archive one or more alerts.
-func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
-	resp, err := s.cs.newRequest("archiveAlerts", p.toURLValues())
+func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams, opts ...CallOption) (*ArchiveAlertsResponse, error) {
+	resp, err := s.cs.newRequest("archiveAlerts", s.cs.encodeParams("archiveAlerts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,125 +303,220 @@ func (r *ArchiveAlertsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteAlertsParams struct {
-	p map[string]interface{}
+	enddate   optString
+	ids       optStrings
+	startdate optString
+	type_     optString
 }
 
-func (p *DeleteAlertsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteAlertsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *DeleteAlertsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteAlertsParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteAlertsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteAlertsParams(u url.Values) (*DeleteAlertsParams, error) {
+	p := &DeleteAlertsParams{}
+	if err := checkParamNames("deleteAlerts", u, "enddate", "ids", "startdate", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
 	}
-	p.p["enddate"] = v
+	return p, nil
 }
 
+// SetEnddate sets the enddate param.
+func (p *DeleteAlertsParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
 func (p *DeleteAlertsParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *DeleteAlertsParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetIds sets the ids param.
 func (p *DeleteAlertsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *DeleteAlertsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *DeleteAlertsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetStartdate sets the startdate param.
 func (p *DeleteAlertsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *DeleteAlertsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *DeleteAlertsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	return p.startdate.v, p.startdate.ok
 }
 
+// SetType sets the type param.
 func (p *DeleteAlertsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *DeleteAlertsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *DeleteAlertsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteAlertsParams) Clone() *DeleteAlertsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	c := *p
+	c.ids = p.ids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteAlertsParams) Equal(o *DeleteAlertsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enddate == o.enddate &&
+		p.ids.equal(o.ids) &&
+		p.startdate == o.startdate &&
+		p.type_ == o.type_
+}
+
+// serializedDeleteAlertsParams is used to (un)marshal DeleteAlertsParams using the API param names
+type serializedDeleteAlertsParams struct {
+	Enddate   *string   `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       *[]string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string   `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *DeleteAlertsParams) toSerialized() *serializedDeleteAlertsParams {
+	s := &serializedDeleteAlertsParams{}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *DeleteAlertsParams) fromSerialized(s *serializedDeleteAlertsParams) {
+	*p = DeleteAlertsParams{}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteAlertsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteAlertsParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteAlertsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteAlertsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteAlertsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteAlertsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteAlertsParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
 	p := &DeleteAlertsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Delete one or more alerts.
-func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
-	resp, err := s.cs.newRequest("deleteAlerts", p.toURLValues())
+func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams, opts ...CallOption) (*DeleteAlertsResponse, error) {
+	resp, err := s.cs.newRequest("deleteAlerts", s.cs.encodeParams("deleteAlerts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -374,152 +564,259 @@ func (r *DeleteAlertsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type GenerateAlertParams struct {
-	p map[string]interface{}
+	description optString
+	name        optString
+	podid       optString
+	type_       optInt
+	zoneid      optString
 }
 
-func (p *GenerateAlertParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GenerateAlertParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["type"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("type", vv)
+	if p.type_.ok {
+		u.Set("type", strconv.Itoa(p.type_.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *GenerateAlertParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGenerateAlertParams parses url.Values, for example taken from a raw API request,
+// into a new GenerateAlertParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGenerateAlertParams(u url.Values) (*GenerateAlertParams, error) {
+	p := &GenerateAlertParams{}
+	if err := checkParamNames("generateAlert", u, "description", "name", "podid", "type", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["description"] = v
+	if _, found := u["type"]; found {
+		v, err := strconv.Atoi(u.Get("type"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param type: %v", err)
+		}
+		p.SetType(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetDescription sets the description param. This param is required.
+func (p *GenerateAlertParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *GenerateAlertParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *GenerateAlertParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *GenerateAlertParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *GenerateAlertParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *GenerateAlertParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPodid sets the podid param.
 func (p *GenerateAlertParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *GenerateAlertParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *GenerateAlertParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetType sets the type param. This param is required.
 func (p *GenerateAlertParams) SetType(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optInt{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *GenerateAlertParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optInt{}
 }
 
+// GetType returns the type param and if it is set
 func (p *GenerateAlertParams) GetType() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(int)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *GenerateAlertParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *GenerateAlertParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *GenerateAlertParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GenerateAlertParams) Clone() *GenerateAlertParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GenerateAlertParams) Equal(o *GenerateAlertParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.name == o.name &&
+		p.podid == o.podid &&
+		p.type_ == o.type_ &&
+		p.zoneid == o.zoneid
+}
+
+// serializedGenerateAlertParams is used to (un)marshal GenerateAlertParams using the API param names
+type serializedGenerateAlertParams struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Podid       *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Type        *int    `json:"type,omitempty" yaml:"type,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *GenerateAlertParams) toSerialized() *serializedGenerateAlertParams {
+	s := &serializedGenerateAlertParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *GenerateAlertParams) fromSerialized(s *serializedGenerateAlertParams) {
+	*p = GenerateAlertParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GenerateAlertParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GenerateAlertParams) UnmarshalJSON(b []byte) error {
+	var s serializedGenerateAlertParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GenerateAlertParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GenerateAlertParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGenerateAlertParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GenerateAlertParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams {
 	p := &GenerateAlertParams{}
-	p.p = make(map[string]interface{})
-	p.p["description"] = description
-	p.p["name"] = name
-	p.p["type"] = alertType
+	p.SetDescription(description)
+	p.SetName(name)
+	p.SetType(alertType)
 	return p
 }
 
-// Generates an alert
-func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
-	resp, err := s.cs.newRequest("generateAlert", p.toURLValues())
+// Generates an alert.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: description, name, type.
+func (s *AlertService) GenerateAlert(p *GenerateAlertParams, opts ...CallOption) (*GenerateAlertResponse, error) {
+	resp, err := s.cs.newRequest("generateAlert", s.cs.encodeParams("generateAlert", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -530,8 +827,8 @@ func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResp
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -555,177 +852,289 @@ type GenerateAlertResponse struct {
 }
 
 type ListAlertsParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	type_    optString
 }
 
-func (p *ListAlertsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAlertsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *ListAlertsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAlertsParams parses url.Values, for example taken from a raw API request,
+// into a new ListAlertsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAlertsParams(u url.Values) (*ListAlertsParams, error) {
+	p := &ListAlertsParams{}
+	if err := checkParamNames("listAlerts", u, "id", "keyword", "name", "page", "pagesize", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["id"] = v
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListAlertsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListAlertsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListAlertsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAlertsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAlertsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAlertsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListAlertsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListAlertsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListAlertsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAlertsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAlertsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAlertsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAlertsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAlertsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAlertsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetType sets the type param.
 func (p *ListAlertsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListAlertsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListAlertsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAlertsParams) Clone() *ListAlertsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAlertsParams) Equal(o *ListAlertsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.type_ == o.type_
+}
+
+// serializedListAlertsParams is used to (un)marshal ListAlertsParams using the API param names
+type serializedListAlertsParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Type     *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *ListAlertsParams) toSerialized() *serializedListAlertsParams {
+	s := &serializedListAlertsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *ListAlertsParams) fromSerialized(s *serializedListAlertsParams) {
+	*p = ListAlertsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAlertsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAlertsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAlertsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAlertsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAlertsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAlertsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAlertsParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewListAlertsParams() *ListAlertsParams {
 	p := &ListAlertsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAlertsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -773,9 +1182,8 @@ func (s *AlertService) GetAlertByName(name string, opts ...OptionFunc) (*Alert,
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error) {
 	p := &ListAlertsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -804,8 +1212,8 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 }
 
 // Lists all alerts.
-func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
-	resp, err := s.cs.newRequest("listAlerts", p.toURLValues())
+func (s *AlertService) ListAlerts(p *ListAlertsParams, opts ...CallOption) (*ListAlertsResponse, error) {
+	resp, err := s.cs.newRequest("listAlerts", s.cs.encodeParams("listAlerts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,48 +53,63 @@ func (m *MockAlertServiceIface) EXPECT() *MockAlertServiceIfaceMockRecorder {
 }
 
 // ArchiveAlerts mocks base method.
-func (m *MockAlertServiceIface) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+func (m *MockAlertServiceIface) ArchiveAlerts(p *ArchiveAlertsParams, opts ...CallOption) (*ArchiveAlertsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ArchiveAlerts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ArchiveAlerts", varargs...)
 	ret0, _ := ret[0].(*ArchiveAlertsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ArchiveAlerts indicates an expected call of ArchiveAlerts.
-func (mr *MockAlertServiceIfaceMockRecorder) ArchiveAlerts(p interface{}) *gomock.Call {
+func (mr *MockAlertServiceIfaceMockRecorder) ArchiveAlerts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).ArchiveAlerts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).ArchiveAlerts), varargs...)
 }
 
 // DeleteAlerts mocks base method.
-func (m *MockAlertServiceIface) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+func (m *MockAlertServiceIface) DeleteAlerts(p *DeleteAlertsParams, opts ...CallOption) (*DeleteAlertsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAlerts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAlerts", varargs...)
 	ret0, _ := ret[0].(*DeleteAlertsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAlerts indicates an expected call of DeleteAlerts.
-func (mr *MockAlertServiceIfaceMockRecorder) DeleteAlerts(p interface{}) *gomock.Call {
+func (mr *MockAlertServiceIfaceMockRecorder) DeleteAlerts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).DeleteAlerts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).DeleteAlerts), varargs...)
 }
 
 // GenerateAlert mocks base method.
-func (m *MockAlertServiceIface) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+func (m *MockAlertServiceIface) GenerateAlert(p *GenerateAlertParams, opts ...CallOption) (*GenerateAlertResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAlert", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateAlert", varargs...)
 	ret0, _ := ret[0].(*GenerateAlertResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateAlert indicates an expected call of GenerateAlert.
-func (mr *MockAlertServiceIfaceMockRecorder) GenerateAlert(p interface{}) *gomock.Call {
+func (mr *MockAlertServiceIfaceMockRecorder) GenerateAlert(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAlert", reflect.TypeOf((*MockAlertServiceIface)(nil).GenerateAlert), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAlert", reflect.TypeOf((*MockAlertServiceIface)(nil).GenerateAlert), varargs...)
 }
 
 // GetAlertByID mocks base method.
@@ -161,18 +176,23 @@ func (mr *MockAlertServiceIfaceMockRecorder) GetAlertID(name interface{}, opts .
 }
 
 // ListAlerts mocks base method.
-func (m *MockAlertServiceIface) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
+func (m *MockAlertServiceIface) ListAlerts(p *ListAlertsParams, opts ...CallOption) (*ListAlertsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAlerts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAlerts", varargs...)
 	ret0, _ := ret[0].(*ListAlertsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAlerts indicates an expected call of ListAlerts.
-func (mr *MockAlertServiceIfaceMockRecorder) ListAlerts(p interface{}) *gomock.Call {
+func (mr *MockAlertServiceIfaceMockRecorder) ListAlerts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlerts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlerts), varargs...)
 }
 
 // NewArchiveAlertsParams mocks base method.
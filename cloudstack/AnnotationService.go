@@ -28,137 +28,235 @@ import (
 )
 
 type AnnotationServiceIface interface {
-	AddAnnotation(p *AddAnnotationParams) (*AddAnnotationResponse, error)
+	AddAnnotation(p *AddAnnotationParams, opts ...CallOption) (*AddAnnotationResponse, error)
 	NewAddAnnotationParams() *AddAnnotationParams
-	ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error)
+	ListAnnotations(p *ListAnnotationsParams, opts ...CallOption) (*ListAnnotationsResponse, error)
 	NewListAnnotationsParams() *ListAnnotationsParams
 	GetAnnotationByID(id string, opts ...OptionFunc) (*Annotation, int, error)
-	RemoveAnnotation(p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error)
+	RemoveAnnotation(p *RemoveAnnotationParams, opts ...CallOption) (*RemoveAnnotationResponse, error)
 	NewRemoveAnnotationParams(id string) *RemoveAnnotationParams
-	UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error)
+	UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams, opts ...CallOption) (*UpdateAnnotationVisibilityResponse, error)
 	NewUpdateAnnotationVisibilityParams(adminsonly bool, id string) *UpdateAnnotationVisibilityParams
 }
 
 type AddAnnotationParams struct {
-	p map[string]interface{}
+	adminsonly optBool
+	annotation optString
+	entityid   optString
+	entitytype optString
 }
 
-func (p *AddAnnotationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddAnnotationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["adminsonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("adminsonly", vv)
+	if p.adminsonly.ok {
+		u.Set("adminsonly", strconv.FormatBool(p.adminsonly.v))
 	}
-	if v, found := p.p["annotation"]; found {
-		u.Set("annotation", v.(string))
+	if p.annotation.ok {
+		u.Set("annotation", p.annotation.v)
 	}
-	if v, found := p.p["entityid"]; found {
-		u.Set("entityid", v.(string))
+	if p.entityid.ok {
+		u.Set("entityid", p.entityid.v)
 	}
-	if v, found := p.p["entitytype"]; found {
-		u.Set("entitytype", v.(string))
+	if p.entitytype.ok {
+		u.Set("entitytype", p.entitytype.v)
 	}
 	return u
 }
 
-func (p *AddAnnotationParams) SetAdminsonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddAnnotationParams parses url.Values, for example taken from a raw API request,
+// into a new AddAnnotationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddAnnotationParams(u url.Values) (*AddAnnotationParams, error) {
+	p := &AddAnnotationParams{}
+	if err := checkParamNames("addAnnotation", u, "adminsonly", "annotation", "entityid", "entitytype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["adminsonly"]; found {
+		v, err := strconv.ParseBool(u.Get("adminsonly"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param adminsonly: %v", err)
+		}
+		p.SetAdminsonly(v)
+	}
+	if _, found := u["annotation"]; found {
+		p.SetAnnotation(u.Get("annotation"))
+	}
+	if _, found := u["entityid"]; found {
+		p.SetEntityid(u.Get("entityid"))
 	}
-	p.p["adminsonly"] = v
+	if _, found := u["entitytype"]; found {
+		p.SetEntitytype(u.Get("entitytype"))
+	}
+	return p, nil
+}
+
+// SetAdminsonly sets the adminsonly param.
+func (p *AddAnnotationParams) SetAdminsonly(v bool) {
+	p.adminsonly = optBool{v: v, ok: true}
 }
 
+// ResetAdminsonly unsets the adminsonly param
 func (p *AddAnnotationParams) ResetAdminsonly() {
-	if p.p != nil && p.p["adminsonly"] != nil {
-		delete(p.p, "adminsonly")
-	}
+	p.adminsonly = optBool{}
 }
 
+// GetAdminsonly returns the adminsonly param and if it is set
 func (p *AddAnnotationParams) GetAdminsonly() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["adminsonly"].(bool)
-	return value, ok
+	return p.adminsonly.v, p.adminsonly.ok
 }
 
+// SetAnnotation sets the annotation param.
 func (p *AddAnnotationParams) SetAnnotation(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["annotation"] = v
+	p.annotation = optString{v: v, ok: true}
 }
 
+// ResetAnnotation unsets the annotation param
 func (p *AddAnnotationParams) ResetAnnotation() {
-	if p.p != nil && p.p["annotation"] != nil {
-		delete(p.p, "annotation")
-	}
+	p.annotation = optString{}
 }
 
+// GetAnnotation returns the annotation param and if it is set
 func (p *AddAnnotationParams) GetAnnotation() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["annotation"].(string)
-	return value, ok
+	return p.annotation.v, p.annotation.ok
 }
 
+// SetEntityid sets the entityid param.
 func (p *AddAnnotationParams) SetEntityid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["entityid"] = v
+	p.entityid = optString{v: v, ok: true}
 }
 
+// ResetEntityid unsets the entityid param
 func (p *AddAnnotationParams) ResetEntityid() {
-	if p.p != nil && p.p["entityid"] != nil {
-		delete(p.p, "entityid")
-	}
+	p.entityid = optString{}
 }
 
+// GetEntityid returns the entityid param and if it is set
 func (p *AddAnnotationParams) GetEntityid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["entityid"].(string)
-	return value, ok
+	return p.entityid.v, p.entityid.ok
 }
 
+// SetEntitytype sets the entitytype param.
 func (p *AddAnnotationParams) SetEntitytype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["entitytype"] = v
+	p.entitytype = optString{v: v, ok: true}
 }
 
+// ResetEntitytype unsets the entitytype param
 func (p *AddAnnotationParams) ResetEntitytype() {
-	if p.p != nil && p.p["entitytype"] != nil {
-		delete(p.p, "entitytype")
-	}
+	p.entitytype = optString{}
 }
 
+// GetEntitytype returns the entitytype param and if it is set
 func (p *AddAnnotationParams) GetEntitytype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.entitytype.v, p.entitytype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddAnnotationParams) Clone() *AddAnnotationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddAnnotationParams) Equal(o *AddAnnotationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.adminsonly == o.adminsonly &&
+		p.annotation == o.annotation &&
+		p.entityid == o.entityid &&
+		p.entitytype == o.entitytype
+}
+
+// serializedAddAnnotationParams is used to (un)marshal AddAnnotationParams using the API param names
+type serializedAddAnnotationParams struct {
+	Adminsonly *bool   `json:"adminsonly,omitempty" yaml:"adminsonly,omitempty"`
+	Annotation *string `json:"annotation,omitempty" yaml:"annotation,omitempty"`
+	Entityid   *string `json:"entityid,omitempty" yaml:"entityid,omitempty"`
+	Entitytype *string `json:"entitytype,omitempty" yaml:"entitytype,omitempty"`
+}
+
+func (p *AddAnnotationParams) toSerialized() *serializedAddAnnotationParams {
+	s := &serializedAddAnnotationParams{}
+	if p.adminsonly.ok {
+		s.Adminsonly = &p.adminsonly.v
+	}
+	if p.annotation.ok {
+		s.Annotation = &p.annotation.v
+	}
+	if p.entityid.ok {
+		s.Entityid = &p.entityid.v
+	}
+	if p.entitytype.ok {
+		s.Entitytype = &p.entitytype.v
+	}
+	return s
+}
+
+func (p *AddAnnotationParams) fromSerialized(s *serializedAddAnnotationParams) {
+	*p = AddAnnotationParams{}
+	if s.Adminsonly != nil {
+		p.SetAdminsonly(*s.Adminsonly)
+	}
+	if s.Annotation != nil {
+		p.SetAnnotation(*s.Annotation)
+	}
+	if s.Entityid != nil {
+		p.SetEntityid(*s.Entityid)
+	}
+	if s.Entitytype != nil {
+		p.SetEntitytype(*s.Entitytype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddAnnotationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddAnnotationParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddAnnotationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddAnnotationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddAnnotationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddAnnotationParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["entitytype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddAnnotationParams instance,
 // as then you are sure you have configured all required params
 func (s *AnnotationService) NewAddAnnotationParams() *AddAnnotationParams {
 	p := &AddAnnotationParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // add an annotation.
-func (s *AnnotationService) AddAnnotation(p *AddAnnotationParams) (*AddAnnotationResponse, error) {
-	resp, err := s.cs.newRequest("addAnnotation", p.toURLValues())
+func (s *AnnotationService) AddAnnotation(p *AddAnnotationParams, opts ...CallOption) (*AddAnnotationResponse, error) {
+	resp, err := s.cs.newRequest("addAnnotation", s.cs.encodeParams("addAnnotation", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -191,225 +289,349 @@ type AddAnnotationResponse struct {
 }
 
 type ListAnnotationsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAnnotationsParams) toURLValues() url.Values {
+	annotationfilter optString
+	entityid         optString
+	entitytype       optString
+	id               optString
+	keyword          optString
+	page             optInt
+	pagesize         optInt
+	userid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAnnotationsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["annotationfilter"]; found {
-		u.Set("annotationfilter", v.(string))
+	if p.annotationfilter.ok {
+		u.Set("annotationfilter", p.annotationfilter.v)
 	}
-	if v, found := p.p["entityid"]; found {
-		u.Set("entityid", v.(string))
+	if p.entityid.ok {
+		u.Set("entityid", p.entityid.v)
 	}
-	if v, found := p.p["entitytype"]; found {
-		u.Set("entitytype", v.(string))
+	if p.entitytype.ok {
+		u.Set("entitytype", p.entitytype.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *ListAnnotationsParams) SetAnnotationfilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAnnotationsParams parses url.Values, for example taken from a raw API request,
+// into a new ListAnnotationsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAnnotationsParams(u url.Values) (*ListAnnotationsParams, error) {
+	p := &ListAnnotationsParams{}
+	if err := checkParamNames("listAnnotations", u, "annotationfilter", "entityid", "entitytype", "id", "keyword", "page", "pagesize", "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["annotationfilter"]; found {
+		p.SetAnnotationfilter(u.Get("annotationfilter"))
+	}
+	if _, found := u["entityid"]; found {
+		p.SetEntityid(u.Get("entityid"))
 	}
-	p.p["annotationfilter"] = v
+	if _, found := u["entitytype"]; found {
+		p.SetEntitytype(u.Get("entitytype"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	return p, nil
+}
+
+// SetAnnotationfilter sets the annotationfilter param.
+func (p *ListAnnotationsParams) SetAnnotationfilter(v string) {
+	p.annotationfilter = optString{v: v, ok: true}
 }
 
+// ResetAnnotationfilter unsets the annotationfilter param
 func (p *ListAnnotationsParams) ResetAnnotationfilter() {
-	if p.p != nil && p.p["annotationfilter"] != nil {
-		delete(p.p, "annotationfilter")
-	}
+	p.annotationfilter = optString{}
 }
 
+// GetAnnotationfilter returns the annotationfilter param and if it is set
 func (p *ListAnnotationsParams) GetAnnotationfilter() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["annotationfilter"].(string)
-	return value, ok
+	return p.annotationfilter.v, p.annotationfilter.ok
 }
 
+// SetEntityid sets the entityid param.
 func (p *ListAnnotationsParams) SetEntityid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["entityid"] = v
+	p.entityid = optString{v: v, ok: true}
 }
 
+// ResetEntityid unsets the entityid param
 func (p *ListAnnotationsParams) ResetEntityid() {
-	if p.p != nil && p.p["entityid"] != nil {
-		delete(p.p, "entityid")
-	}
+	p.entityid = optString{}
 }
 
+// GetEntityid returns the entityid param and if it is set
 func (p *ListAnnotationsParams) GetEntityid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["entityid"].(string)
-	return value, ok
+	return p.entityid.v, p.entityid.ok
 }
 
+// SetEntitytype sets the entitytype param.
 func (p *ListAnnotationsParams) SetEntitytype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["entitytype"] = v
+	p.entitytype = optString{v: v, ok: true}
 }
 
+// ResetEntitytype unsets the entitytype param
 func (p *ListAnnotationsParams) ResetEntitytype() {
-	if p.p != nil && p.p["entitytype"] != nil {
-		delete(p.p, "entitytype")
-	}
+	p.entitytype = optString{}
 }
 
+// GetEntitytype returns the entitytype param and if it is set
 func (p *ListAnnotationsParams) GetEntitytype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["entitytype"].(string)
-	return value, ok
+	return p.entitytype.v, p.entitytype.ok
 }
 
+// SetId sets the id param.
 func (p *ListAnnotationsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListAnnotationsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListAnnotationsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAnnotationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAnnotationsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAnnotationsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAnnotationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAnnotationsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAnnotationsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAnnotationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAnnotationsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAnnotationsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetUserid sets the userid param.
 func (p *ListAnnotationsParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *ListAnnotationsParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *ListAnnotationsParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAnnotationsParams) Clone() *ListAnnotationsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAnnotationsParams) Equal(o *ListAnnotationsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.annotationfilter == o.annotationfilter &&
+		p.entityid == o.entityid &&
+		p.entitytype == o.entitytype &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.userid == o.userid
+}
+
+// serializedListAnnotationsParams is used to (un)marshal ListAnnotationsParams using the API param names
+type serializedListAnnotationsParams struct {
+	Annotationfilter *string `json:"annotationfilter,omitempty" yaml:"annotationfilter,omitempty"`
+	Entityid         *string `json:"entityid,omitempty" yaml:"entityid,omitempty"`
+	Entitytype       *string `json:"entitytype,omitempty" yaml:"entitytype,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Userid           *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *ListAnnotationsParams) toSerialized() *serializedListAnnotationsParams {
+	s := &serializedListAnnotationsParams{}
+	if p.annotationfilter.ok {
+		s.Annotationfilter = &p.annotationfilter.v
+	}
+	if p.entityid.ok {
+		s.Entityid = &p.entityid.v
+	}
+	if p.entitytype.ok {
+		s.Entitytype = &p.entitytype.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *ListAnnotationsParams) fromSerialized(s *serializedListAnnotationsParams) {
+	*p = ListAnnotationsParams{}
+	if s.Annotationfilter != nil {
+		p.SetAnnotationfilter(*s.Annotationfilter)
+	}
+	if s.Entityid != nil {
+		p.SetEntityid(*s.Entityid)
+	}
+	if s.Entitytype != nil {
+		p.SetEntitytype(*s.Entitytype)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAnnotationsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAnnotationsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAnnotationsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAnnotationsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAnnotationsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAnnotationsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAnnotationsParams instance,
 // as then you are sure you have configured all required params
 func (s *AnnotationService) NewListAnnotationsParams() *ListAnnotationsParams {
 	p := &ListAnnotationsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AnnotationService) GetAnnotationByID(id string, opts ...OptionFunc) (*Annotation, int, error) {
 	p := &ListAnnotationsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -438,8 +660,8 @@ func (s *AnnotationService) GetAnnotationByID(id string, opts ...OptionFunc) (*A
 }
 
 // Lists annotations.
-func (s *AnnotationService) ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
-	resp, err := s.cs.newRequest("listAnnotations", p.toURLValues())
+func (s *AnnotationService) ListAnnotations(p *ListAnnotationsParams, opts ...CallOption) (*ListAnnotationsResponse, error) {
+	resp, err := s.cs.newRequest("listAnnotations", s.cs.encodeParams("listAnnotations", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -473,53 +695,132 @@ type Annotation struct {
 }
 
 type RemoveAnnotationParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RemoveAnnotationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveAnnotationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RemoveAnnotationParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveAnnotationParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveAnnotationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveAnnotationParams(u url.Values) (*RemoveAnnotationParams, error) {
+	p := &RemoveAnnotationParams{}
+	if err := checkParamNames("removeAnnotation", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RemoveAnnotationParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RemoveAnnotationParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveAnnotationParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveAnnotationParams) Clone() *RemoveAnnotationParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveAnnotationParams) Equal(o *RemoveAnnotationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRemoveAnnotationParams is used to (un)marshal RemoveAnnotationParams using the API param names
+type serializedRemoveAnnotationParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RemoveAnnotationParams) toSerialized() *serializedRemoveAnnotationParams {
+	s := &serializedRemoveAnnotationParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RemoveAnnotationParams) fromSerialized(s *serializedRemoveAnnotationParams) {
+	*p = RemoveAnnotationParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveAnnotationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveAnnotationParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveAnnotationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveAnnotationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveAnnotationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveAnnotationParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveAnnotationParams instance,
 // as then you are sure you have configured all required params
 func (s *AnnotationService) NewRemoveAnnotationParams(id string) *RemoveAnnotationParams {
 	p := &RemoveAnnotationParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // remove an annotation.
-func (s *AnnotationService) RemoveAnnotation(p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error) {
-	resp, err := s.cs.newRequest("removeAnnotation", p.toURLValues())
+//
+// Required params: id.
+func (s *AnnotationService) RemoveAnnotation(p *RemoveAnnotationParams, opts ...CallOption) (*RemoveAnnotationResponse, error) {
+	resp, err := s.cs.newRequest("removeAnnotation", s.cs.encodeParams("removeAnnotation", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -552,79 +853,167 @@ type RemoveAnnotationResponse struct {
 }
 
 type UpdateAnnotationVisibilityParams struct {
-	p map[string]interface{}
+	adminsonly optBool
+	id         optString
 }
 
-func (p *UpdateAnnotationVisibilityParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateAnnotationVisibilityParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["adminsonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("adminsonly", vv)
+	if p.adminsonly.ok {
+		u.Set("adminsonly", strconv.FormatBool(p.adminsonly.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateAnnotationVisibilityParams) SetAdminsonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateAnnotationVisibilityParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateAnnotationVisibilityParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateAnnotationVisibilityParams(u url.Values) (*UpdateAnnotationVisibilityParams, error) {
+	p := &UpdateAnnotationVisibilityParams{}
+	if err := checkParamNames("updateAnnotationVisibility", u, "adminsonly", "id"); err != nil {
+		return nil, err
 	}
-	p.p["adminsonly"] = v
+	if _, found := u["adminsonly"]; found {
+		v, err := strconv.ParseBool(u.Get("adminsonly"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param adminsonly: %v", err)
+		}
+		p.SetAdminsonly(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetAdminsonly sets the adminsonly param. This param is required.
+func (p *UpdateAnnotationVisibilityParams) SetAdminsonly(v bool) {
+	p.adminsonly = optBool{v: v, ok: true}
 }
 
+// ResetAdminsonly unsets the adminsonly param
 func (p *UpdateAnnotationVisibilityParams) ResetAdminsonly() {
-	if p.p != nil && p.p["adminsonly"] != nil {
-		delete(p.p, "adminsonly")
-	}
+	p.adminsonly = optBool{}
 }
 
+// GetAdminsonly returns the adminsonly param and if it is set
 func (p *UpdateAnnotationVisibilityParams) GetAdminsonly() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["adminsonly"].(bool)
-	return value, ok
+	return p.adminsonly.v, p.adminsonly.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateAnnotationVisibilityParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateAnnotationVisibilityParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateAnnotationVisibilityParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateAnnotationVisibilityParams) Clone() *UpdateAnnotationVisibilityParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateAnnotationVisibilityParams) Equal(o *UpdateAnnotationVisibilityParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.adminsonly == o.adminsonly &&
+		p.id == o.id
+}
+
+// serializedUpdateAnnotationVisibilityParams is used to (un)marshal UpdateAnnotationVisibilityParams using the API param names
+type serializedUpdateAnnotationVisibilityParams struct {
+	Adminsonly *bool   `json:"adminsonly,omitempty" yaml:"adminsonly,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateAnnotationVisibilityParams) toSerialized() *serializedUpdateAnnotationVisibilityParams {
+	s := &serializedUpdateAnnotationVisibilityParams{}
+	if p.adminsonly.ok {
+		s.Adminsonly = &p.adminsonly.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateAnnotationVisibilityParams) fromSerialized(s *serializedUpdateAnnotationVisibilityParams) {
+	*p = UpdateAnnotationVisibilityParams{}
+	if s.Adminsonly != nil {
+		p.SetAdminsonly(*s.Adminsonly)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateAnnotationVisibilityParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateAnnotationVisibilityParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateAnnotationVisibilityParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateAnnotationVisibilityParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateAnnotationVisibilityParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateAnnotationVisibilityParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateAnnotationVisibilityParams instance,
 // as then you are sure you have configured all required params
 func (s *AnnotationService) NewUpdateAnnotationVisibilityParams(adminsonly bool, id string) *UpdateAnnotationVisibilityParams {
 	p := &UpdateAnnotationVisibilityParams{}
-	p.p = make(map[string]interface{})
-	p.p["adminsonly"] = adminsonly
-	p.p["id"] = id
+	p.SetAdminsonly(adminsonly)
+	p.SetId(id)
 	return p
 }
 
 // update an annotation visibility.
-func (s *AnnotationService) UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error) {
-	resp, err := s.cs.newRequest("updateAnnotationVisibility", p.toURLValues())
+//
+// Required params: adminsonly, id.
+func (s *AnnotationService) UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams, opts ...CallOption) (*UpdateAnnotationVisibilityResponse, error) {
+	resp, err := s.cs.newRequest("updateAnnotationVisibility", s.cs.encodeParams("updateAnnotationVisibility", p), opts...)
 	if err != nil {
 		return nil, err
 	}
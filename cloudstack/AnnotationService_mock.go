@@ -53,18 +53,23 @@ func (m *MockAnnotationServiceIface) EXPECT() *MockAnnotationServiceIfaceMockRec
 }
 
 // AddAnnotation mocks base method.
-func (m *MockAnnotationServiceIface) AddAnnotation(p *AddAnnotationParams) (*AddAnnotationResponse, error) {
+func (m *MockAnnotationServiceIface) AddAnnotation(p *AddAnnotationParams, opts ...CallOption) (*AddAnnotationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddAnnotation", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddAnnotation", varargs...)
 	ret0, _ := ret[0].(*AddAnnotationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddAnnotation indicates an expected call of AddAnnotation.
-func (mr *MockAnnotationServiceIfaceMockRecorder) AddAnnotation(p interface{}) *gomock.Call {
+func (mr *MockAnnotationServiceIfaceMockRecorder) AddAnnotation(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnnotation", reflect.TypeOf((*MockAnnotationServiceIface)(nil).AddAnnotation), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnnotation", reflect.TypeOf((*MockAnnotationServiceIface)(nil).AddAnnotation), varargs...)
 }
 
 // GetAnnotationByID mocks base method.
@@ -89,18 +94,23 @@ func (mr *MockAnnotationServiceIfaceMockRecorder) GetAnnotationByID(id interface
 }
 
 // ListAnnotations mocks base method.
-func (m *MockAnnotationServiceIface) ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
+func (m *MockAnnotationServiceIface) ListAnnotations(p *ListAnnotationsParams, opts ...CallOption) (*ListAnnotationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAnnotations", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAnnotations", varargs...)
 	ret0, _ := ret[0].(*ListAnnotationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAnnotations indicates an expected call of ListAnnotations.
-func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotations(p interface{}) *gomock.Call {
+func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotations(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotations", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotations), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotations", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotations), varargs...)
 }
 
 // NewAddAnnotationParams mocks base method.
@@ -160,31 +170,41 @@ func (mr *MockAnnotationServiceIfaceMockRecorder) NewUpdateAnnotationVisibilityP
 }
 
 // RemoveAnnotation mocks base method.
-func (m *MockAnnotationServiceIface) RemoveAnnotation(p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error) {
+func (m *MockAnnotationServiceIface) RemoveAnnotation(p *RemoveAnnotationParams, opts ...CallOption) (*RemoveAnnotationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveAnnotation", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveAnnotation", varargs...)
 	ret0, _ := ret[0].(*RemoveAnnotationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveAnnotation indicates an expected call of RemoveAnnotation.
-func (mr *MockAnnotationServiceIfaceMockRecorder) RemoveAnnotation(p interface{}) *gomock.Call {
+func (mr *MockAnnotationServiceIfaceMockRecorder) RemoveAnnotation(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAnnotation", reflect.TypeOf((*MockAnnotationServiceIface)(nil).RemoveAnnotation), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAnnotation", reflect.TypeOf((*MockAnnotationServiceIface)(nil).RemoveAnnotation), varargs...)
 }
 
 // UpdateAnnotationVisibility mocks base method.
-func (m *MockAnnotationServiceIface) UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error) {
+func (m *MockAnnotationServiceIface) UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams, opts ...CallOption) (*UpdateAnnotationVisibilityResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAnnotationVisibility", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAnnotationVisibility", varargs...)
 	ret0, _ := ret[0].(*UpdateAnnotationVisibilityResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAnnotationVisibility indicates an expected call of UpdateAnnotationVisibility.
-func (mr *MockAnnotationServiceIfaceMockRecorder) UpdateAnnotationVisibility(p interface{}) *gomock.Call {
+func (mr *MockAnnotationServiceIfaceMockRecorder) UpdateAnnotationVisibility(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationVisibility", reflect.TypeOf((*MockAnnotationServiceIface)(nil).UpdateAnnotationVisibility), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationVisibility", reflect.TypeOf((*MockAnnotationServiceIface)(nil).UpdateAnnotationVisibility), varargs...)
 }
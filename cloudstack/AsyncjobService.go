@@ -21,261 +21,399 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 )
 
 type AsyncjobServiceIface interface {
-	ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	ListAsyncJobs(p *ListAsyncJobsParams, opts ...CallOption) (*ListAsyncJobsResponse, error)
 	NewListAsyncJobsParams() *ListAsyncJobsParams
-	QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResult(p *QueryAsyncJobResultParams, opts ...CallOption) (*QueryAsyncJobResultResponse, error)
 	NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams
 }
 
 type ListAsyncJobsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAsyncJobsParams) toURLValues() url.Values {
+	account            optString
+	domainid           optString
+	isrecursive        optBool
+	keyword            optString
+	listall            optBool
+	managementserverid optUUID
+	page               optInt
+	pagesize           optInt
+	startdate          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAsyncJobsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["managementserverid"]; found {
-		u.Set("managementserverid", v.(string))
+	if p.managementserverid.ok {
+		u.Set("managementserverid", string(p.managementserverid.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
 	return u
 }
 
-func (p *ListAsyncJobsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAsyncJobsParams parses url.Values, for example taken from a raw API request,
+// into a new ListAsyncJobsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAsyncJobsParams(u url.Values) (*ListAsyncJobsParams, error) {
+	p := &ListAsyncJobsParams{}
+	if err := checkParamNames("listAsyncJobs", u, "account", "domainid", "isrecursive", "keyword", "listall", "managementserverid", "page", "pagesize", "startdate"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["managementserverid"]; found {
+		p.SetManagementserverid(UUID(u.Get("managementserverid")))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListAsyncJobsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListAsyncJobsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListAsyncJobsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListAsyncJobsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListAsyncJobsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListAsyncJobsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListAsyncJobsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListAsyncJobsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListAsyncJobsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAsyncJobsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAsyncJobsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAsyncJobsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListAsyncJobsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListAsyncJobsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListAsyncJobsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetManagementserverid sets the managementserverid param.
 func (p *ListAsyncJobsParams) SetManagementserverid(v UUID) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["managementserverid"] = v
+	p.managementserverid = optUUID{v: v, ok: true}
 }
 
+// ResetManagementserverid unsets the managementserverid param
 func (p *ListAsyncJobsParams) ResetManagementserverid() {
-	if p.p != nil && p.p["managementserverid"] != nil {
-		delete(p.p, "managementserverid")
-	}
+	p.managementserverid = optUUID{}
 }
 
+// GetManagementserverid returns the managementserverid param and if it is set
 func (p *ListAsyncJobsParams) GetManagementserverid() (UUID, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["managementserverid"].(UUID)
-	return value, ok
+	return p.managementserverid.v, p.managementserverid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAsyncJobsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAsyncJobsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAsyncJobsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAsyncJobsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAsyncJobsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAsyncJobsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetStartdate sets the startdate param.
 func (p *ListAsyncJobsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *ListAsyncJobsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *ListAsyncJobsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.startdate.v, p.startdate.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAsyncJobsParams) Clone() *ListAsyncJobsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAsyncJobsParams) Equal(o *ListAsyncJobsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.managementserverid == o.managementserverid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.startdate == o.startdate
+}
+
+// serializedListAsyncJobsParams is used to (un)marshal ListAsyncJobsParams using the API param names
+type serializedListAsyncJobsParams struct {
+	Account            *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid           *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isrecursive        *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword            *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall            *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Managementserverid *UUID   `json:"managementserverid,omitempty" yaml:"managementserverid,omitempty"`
+	Page               *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize           *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Startdate          *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+}
+
+func (p *ListAsyncJobsParams) toSerialized() *serializedListAsyncJobsParams {
+	s := &serializedListAsyncJobsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.managementserverid.ok {
+		s.Managementserverid = &p.managementserverid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	return s
+}
+
+func (p *ListAsyncJobsParams) fromSerialized(s *serializedListAsyncJobsParams) {
+	*p = ListAsyncJobsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Managementserverid != nil {
+		p.SetManagementserverid(*s.Managementserverid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAsyncJobsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAsyncJobsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAsyncJobsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAsyncJobsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAsyncJobsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAsyncJobsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAsyncJobsParams instance,
 // as then you are sure you have configured all required params
 func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
 	p := &ListAsyncJobsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists all pending asynchronous jobs for the account.
-func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
-	resp, err := s.cs.newRequest("listAsyncJobs", p.toURLValues())
+func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams, opts ...CallOption) (*ListAsyncJobsResponse, error) {
+	resp, err := s.cs.newRequest("listAsyncJobs", s.cs.encodeParams("listAsyncJobs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -314,58 +452,137 @@ type AsyncJob struct {
 }
 
 type QueryAsyncJobResultParams struct {
-	p map[string]interface{}
+	jobid optString
 }
 
-func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QueryAsyncJobResultParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["jobid"]; found {
-		u.Set("jobid", v.(string))
+	if p.jobid.ok {
+		u.Set("jobid", p.jobid.v)
 	}
 	return u
 }
 
-func (p *QueryAsyncJobResultParams) SetJobID(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseQueryAsyncJobResultParams parses url.Values, for example taken from a raw API request,
+// into a new QueryAsyncJobResultParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQueryAsyncJobResultParams(u url.Values) (*QueryAsyncJobResultParams, error) {
+	p := &QueryAsyncJobResultParams{}
+	if err := checkParamNames("queryAsyncJobResult", u, "jobid"); err != nil {
+		return nil, err
 	}
-	p.p["jobid"] = v
+	if _, found := u["jobid"]; found {
+		p.SetJobID(u.Get("jobid"))
+	}
+	return p, nil
+}
+
+// SetJobID sets the jobid param. This param is required.
+func (p *QueryAsyncJobResultParams) SetJobID(v string) {
+	p.jobid = optString{v: v, ok: true}
 }
 
+// ResetJobID unsets the jobid param
 func (p *QueryAsyncJobResultParams) ResetJobID() {
-	if p.p != nil && p.p["jobid"] != nil {
-		delete(p.p, "jobid")
-	}
+	p.jobid = optString{}
 }
 
+// GetJobID returns the jobid param and if it is set
 func (p *QueryAsyncJobResultParams) GetJobID() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.jobid.v, p.jobid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QueryAsyncJobResultParams) Clone() *QueryAsyncJobResultParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QueryAsyncJobResultParams) Equal(o *QueryAsyncJobResultParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.jobid == o.jobid
+}
+
+// serializedQueryAsyncJobResultParams is used to (un)marshal QueryAsyncJobResultParams using the API param names
+type serializedQueryAsyncJobResultParams struct {
+	JobID *string `json:"jobid,omitempty" yaml:"jobid,omitempty"`
+}
+
+func (p *QueryAsyncJobResultParams) toSerialized() *serializedQueryAsyncJobResultParams {
+	s := &serializedQueryAsyncJobResultParams{}
+	if p.jobid.ok {
+		s.JobID = &p.jobid.v
 	}
-	value, ok := p.p["jobid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *QueryAsyncJobResultParams) fromSerialized(s *serializedQueryAsyncJobResultParams) {
+	*p = QueryAsyncJobResultParams{}
+	if s.JobID != nil {
+		p.SetJobID(*s.JobID)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QueryAsyncJobResultParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QueryAsyncJobResultParams) UnmarshalJSON(b []byte) error {
+	var s serializedQueryAsyncJobResultParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QueryAsyncJobResultParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QueryAsyncJobResultParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQueryAsyncJobResultParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new QueryAsyncJobResultParams instance,
 // as then you are sure you have configured all required params
 func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams {
 	p := &QueryAsyncJobResultParams{}
-	p.p = make(map[string]interface{})
-	p.p["jobid"] = jobid
+	p.SetJobID(jobid)
 	return p
 }
 
 // Retrieves the current status of asynchronous job.
-func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+//
+// Required params: jobid.
+func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams, opts ...CallOption) (*QueryAsyncJobResultResponse, error) {
 	var resp json.RawMessage
 	var err error
 
 	// We should be able to retry on failure as this call is idempotent
 	for i := 0; i < 3; i++ {
-		resp, err = s.cs.newRequest("queryAsyncJobResult", p.toURLValues())
+		resp, err = s.cs.newRequest("queryAsyncJobResult", s.cs.encodeParams("queryAsyncJobResult", p), opts...)
 		if err == nil {
 			break
 		}
@@ -53,18 +53,23 @@ func (m *MockAsyncjobServiceIface) EXPECT() *MockAsyncjobServiceIfaceMockRecorde
 }
 
 // ListAsyncJobs mocks base method.
-func (m *MockAsyncjobServiceIface) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+func (m *MockAsyncjobServiceIface) ListAsyncJobs(p *ListAsyncJobsParams, opts ...CallOption) (*ListAsyncJobsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAsyncJobs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAsyncJobs", varargs...)
 	ret0, _ := ret[0].(*ListAsyncJobsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAsyncJobs indicates an expected call of ListAsyncJobs.
-func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobs(p interface{}) *gomock.Call {
+func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobs", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobs", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobs), varargs...)
 }
 
 // NewListAsyncJobsParams mocks base method.
@@ -96,16 +101,21 @@ func (mr *MockAsyncjobServiceIfaceMockRecorder) NewQueryAsyncJobResultParams(job
 }
 
 // QueryAsyncJobResult mocks base method.
-func (m *MockAsyncjobServiceIface) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+func (m *MockAsyncjobServiceIface) QueryAsyncJobResult(p *QueryAsyncJobResultParams, opts ...CallOption) (*QueryAsyncJobResultResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryAsyncJobResult", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryAsyncJobResult", varargs...)
 	ret0, _ := ret[0].(*QueryAsyncJobResultResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryAsyncJobResult indicates an expected call of QueryAsyncJobResult.
-func (mr *MockAsyncjobServiceIfaceMockRecorder) QueryAsyncJobResult(p interface{}) *gomock.Call {
+func (mr *MockAsyncjobServiceIfaceMockRecorder) QueryAsyncJobResult(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAsyncJobResult", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).QueryAsyncJobResult), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAsyncJobResult", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).QueryAsyncJobResult), varargs...)
 }
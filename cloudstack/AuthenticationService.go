@@ -21,139 +21,242 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type AuthenticationServiceIface interface {
-	Login(p *LoginParams) (*LoginResponse, error)
+	Login(p *LoginParams, opts ...CallOption) (*LoginResponse, error)
 	NewLoginParams(password string, username string) *LoginParams
-	Logout(p *LogoutParams) (*LogoutResponse, error)
+	Logout(p *LogoutParams, opts ...CallOption) (*LogoutResponse, error)
 	NewLogoutParams() *LogoutParams
 }
 
 type LoginParams struct {
-	p map[string]interface{}
+	domain   optString
+	domainId optInt64
+	password optString
+	username optString
 }
 
-func (p *LoginParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LoginParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["domain"]; found {
-		u.Set("domain", v.(string))
+	if p.domain.ok {
+		u.Set("domain", p.domain.v)
 	}
-	if v, found := p.p["domainId"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("domainId", vv)
+	if p.domainId.ok {
+		u.Set("domainId", strconv.FormatInt(p.domainId.v, 10))
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *LoginParams) SetDomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseLoginParams parses url.Values, for example taken from a raw API request,
+// into a new LoginParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLoginParams(u url.Values) (*LoginParams, error) {
+	p := &LoginParams{}
+	if err := checkParamNames("login", u, "domain", "domainId", "password", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["domain"]; found {
+		p.SetDomain(u.Get("domain"))
+	}
+	if _, found := u["domainId"]; found {
+		v, err := strconv.ParseInt(u.Get("domainId"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param domainId: %v", err)
+		}
+		p.SetDomainId(v)
 	}
-	p.p["domain"] = v
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetDomain sets the domain param.
+func (p *LoginParams) SetDomain(v string) {
+	p.domain = optString{v: v, ok: true}
 }
 
+// ResetDomain unsets the domain param
 func (p *LoginParams) ResetDomain() {
-	if p.p != nil && p.p["domain"] != nil {
-		delete(p.p, "domain")
-	}
+	p.domain = optString{}
 }
 
+// GetDomain returns the domain param and if it is set
 func (p *LoginParams) GetDomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domain"].(string)
-	return value, ok
+	return p.domain.v, p.domain.ok
 }
 
+// SetDomainId sets the domainId param.
 func (p *LoginParams) SetDomainId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainId"] = v
+	p.domainId = optInt64{v: v, ok: true}
 }
 
+// ResetDomainId unsets the domainId param
 func (p *LoginParams) ResetDomainId() {
-	if p.p != nil && p.p["domainId"] != nil {
-		delete(p.p, "domainId")
-	}
+	p.domainId = optInt64{}
 }
 
+// GetDomainId returns the domainId param and if it is set
 func (p *LoginParams) GetDomainId() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainId"].(int64)
-	return value, ok
+	return p.domainId.v, p.domainId.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *LoginParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *LoginParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *LoginParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *LoginParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *LoginParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *LoginParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *LoginParams) Clone() *LoginParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LoginParams) Equal(o *LoginParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.domain == o.domain &&
+		p.domainId == o.domainId &&
+		p.password == o.password &&
+		p.username == o.username
+}
+
+// serializedLoginParams is used to (un)marshal LoginParams using the API param names
+type serializedLoginParams struct {
+	Domain   *string `json:"domain,omitempty" yaml:"domain,omitempty"`
+	DomainId *int64  `json:"domainId,omitempty" yaml:"domainId,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *LoginParams) toSerialized() *serializedLoginParams {
+	s := &serializedLoginParams{}
+	if p.domain.ok {
+		s.Domain = &p.domain.v
+	}
+	if p.domainId.ok {
+		s.DomainId = &p.domainId.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *LoginParams) fromSerialized(s *serializedLoginParams) {
+	*p = LoginParams{}
+	if s.Domain != nil {
+		p.SetDomain(*s.Domain)
+	}
+	if s.DomainId != nil {
+		p.SetDomainId(*s.DomainId)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LoginParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LoginParams) UnmarshalJSON(b []byte) error {
+	var s serializedLoginParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LoginParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LoginParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLoginParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new LoginParams instance,
 // as then you are sure you have configured all required params
 func (s *AuthenticationService) NewLoginParams(password string, username string) *LoginParams {
 	p := &LoginParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["username"] = username
+	p.SetPassword(password)
+	p.SetUsername(username)
 	return p
 }
 
-// Logs a user into the CloudStack. A successful login attempt will generate a JSESSIONID cookie value that can be passed in subsequent Query command calls until the "logout" command has been issued or the session has expired.
-func (s *AuthenticationService) Login(p *LoginParams) (*LoginResponse, error) {
-	resp, err := s.cs.newPostRequest("login", p.toURLValues())
+// Logs a user into the CloudStack. A successful login attempt will generate a JSESSIONID cookie
+// value that can be passed in subsequent Query command calls until the "logout" command has been
+// issued or the session has expired.
+//
+// Required params: password, username.
+func (s *AuthenticationService) Login(p *LoginParams, opts ...CallOption) (*LoginResponse, error) {
+	resp, err := s.cs.newPostRequest("login", s.cs.encodeParams("login", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -188,28 +291,100 @@ type LoginResponse struct {
 }
 
 type LogoutParams struct {
-	p map[string]interface{}
 }
 
-func (p *LogoutParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LogoutParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseLogoutParams parses url.Values, for example taken from a raw API request,
+// into a new LogoutParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLogoutParams(u url.Values) (*LogoutParams, error) {
+	p := &LogoutParams{}
+	if err := checkParamNames("logout", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *LogoutParams) Clone() *LogoutParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LogoutParams) Equal(o *LogoutParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedLogoutParams is used to (un)marshal LogoutParams using the API param names
+type serializedLogoutParams struct {
+}
+
+func (p *LogoutParams) toSerialized() *serializedLogoutParams {
+	s := &serializedLogoutParams{}
+	return s
+}
+
+func (p *LogoutParams) fromSerialized(s *serializedLogoutParams) {
+	*p = LogoutParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LogoutParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LogoutParams) UnmarshalJSON(b []byte) error {
+	var s serializedLogoutParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LogoutParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LogoutParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLogoutParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new LogoutParams instance,
 // as then you are sure you have configured all required params
 func (s *AuthenticationService) NewLogoutParams() *LogoutParams {
 	p := &LogoutParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Logs out the user
-func (s *AuthenticationService) Logout(p *LogoutParams) (*LogoutResponse, error) {
-	resp, err := s.cs.newRequest("logout", p.toURLValues())
+// Logs out the user.
+func (s *AuthenticationService) Logout(p *LogoutParams, opts ...CallOption) (*LogoutResponse, error) {
+	resp, err := s.cs.newRequest("logout", s.cs.encodeParams("logout", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +53,43 @@ func (m *MockAuthenticationServiceIface) EXPECT() *MockAuthenticationServiceIfac
 }
 
 // Login mocks base method.
-func (m *MockAuthenticationServiceIface) Login(p *LoginParams) (*LoginResponse, error) {
+func (m *MockAuthenticationServiceIface) Login(p *LoginParams, opts ...CallOption) (*LoginResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Login", varargs...)
 	ret0, _ := ret[0].(*LoginResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockAuthenticationServiceIfaceMockRecorder) Login(p interface{}) *gomock.Call {
+func (mr *MockAuthenticationServiceIfaceMockRecorder) Login(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).Login), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).Login), varargs...)
 }
 
 // Logout mocks base method.
-func (m *MockAuthenticationServiceIface) Logout(p *LogoutParams) (*LogoutResponse, error) {
+func (m *MockAuthenticationServiceIface) Logout(p *LogoutParams, opts ...CallOption) (*LogoutResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logout", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Logout", varargs...)
 	ret0, _ := ret[0].(*LogoutResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Logout indicates an expected call of Logout.
-func (mr *MockAuthenticationServiceIfaceMockRecorder) Logout(p interface{}) *gomock.Call {
+func (mr *MockAuthenticationServiceIfaceMockRecorder) Logout(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).Logout), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).Logout), varargs...)
 }
 
 // NewLoginParams mocks base method.
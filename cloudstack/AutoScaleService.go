@@ -28,208 +28,321 @@ import (
 )
 
 type AutoScaleServiceIface interface {
-	CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicy(p *CreateAutoScalePolicyParams, opts ...CallOption) (*CreateAutoScalePolicyResponse, error)
 	NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams
-	CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams, opts ...CallOption) (*CreateAutoScaleVmGroupResponse, error)
 	NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams
-	CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams, opts ...CallOption) (*CreateAutoScaleVmProfileResponse, error)
 	NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams
-	CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error)
+	CreateCondition(p *CreateConditionParams, opts ...CallOption) (*CreateConditionResponse, error)
 	NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams
-	CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error)
+	CreateCounter(p *CreateCounterParams, opts ...CallOption) (*CreateCounterResponse, error)
 	NewCreateCounterParams(name string, provider string, source string, value string) *CreateCounterParams
-	DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams, opts ...CallOption) (*DeleteAutoScalePolicyResponse, error)
 	NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams
-	DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams, opts ...CallOption) (*DeleteAutoScaleVmGroupResponse, error)
 	NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams
-	DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams, opts ...CallOption) (*DeleteAutoScaleVmProfileResponse, error)
 	NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams
-	DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error)
+	DeleteCondition(p *DeleteConditionParams, opts ...CallOption) (*DeleteConditionResponse, error)
 	NewDeleteConditionParams(id string) *DeleteConditionParams
-	DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error)
+	DeleteCounter(p *DeleteCounterParams, opts ...CallOption) (*DeleteCounterResponse, error)
 	NewDeleteCounterParams(id string) *DeleteCounterParams
-	DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams, opts ...CallOption) (*DisableAutoScaleVmGroupResponse, error)
 	NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams
-	EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams, opts ...CallOption) (*EnableAutoScaleVmGroupResponse, error)
 	NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams
-	ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePolicies(p *ListAutoScalePoliciesParams, opts ...CallOption) (*ListAutoScalePoliciesResponse, error)
 	NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams
 	GetAutoScalePolicyID(name string, opts ...OptionFunc) (string, int, error)
 	GetAutoScalePolicyByName(name string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
 	GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
-	ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams, opts ...CallOption) (*ListAutoScaleVmGroupsResponse, error)
 	NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams
 	GetAutoScaleVmGroupID(name string, opts ...OptionFunc) (string, int, error)
 	GetAutoScaleVmGroupByName(name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
 	GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
-	ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams, opts ...CallOption) (*ListAutoScaleVmProfilesResponse, error)
 	NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams
 	GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error)
-	ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error)
+	ListConditions(p *ListConditionsParams, opts ...CallOption) (*ListConditionsResponse, error)
 	NewListConditionsParams() *ListConditionsParams
 	GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error)
-	ListCounters(p *ListCountersParams) (*ListCountersResponse, error)
+	ListCounters(p *ListCountersParams, opts ...CallOption) (*ListCountersResponse, error)
 	NewListCountersParams() *ListCountersParams
 	GetCounterID(name string, opts ...OptionFunc) (string, int, error)
 	GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error)
 	GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error)
-	UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams, opts ...CallOption) (*UpdateAutoScalePolicyResponse, error)
 	NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams
-	UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams, opts ...CallOption) (*UpdateAutoScaleVmGroupResponse, error)
 	NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams
-	UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams, opts ...CallOption) (*UpdateAutoScaleVmProfileResponse, error)
 	NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams
 }
 
 type CreateAutoScalePolicyParams struct {
-	p map[string]interface{}
+	action       optString
+	conditionids optStrings
+	duration     optInt
+	name         optString
+	quiettime    optInt
 }
 
-func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateAutoScalePolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if p.action.ok {
+		u.Set("action", p.action.v)
 	}
-	if v, found := p.p["conditionids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("conditionids", vv)
+	if p.conditionids.ok {
+		u.Set("conditionids", strings.Join(p.conditionids.v, ","))
 	}
-	if v, found := p.p["duration"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("duration", vv)
+	if p.duration.ok {
+		u.Set("duration", strconv.Itoa(p.duration.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["quiettime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("quiettime", vv)
+	if p.quiettime.ok {
+		u.Set("quiettime", strconv.Itoa(p.quiettime.v))
 	}
 	return u
 }
 
-func (p *CreateAutoScalePolicyParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateAutoScalePolicyParams parses url.Values, for example taken from a raw API request,
+// into a new CreateAutoScalePolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateAutoScalePolicyParams(u url.Values) (*CreateAutoScalePolicyParams, error) {
+	p := &CreateAutoScalePolicyParams{}
+	if err := checkParamNames("createAutoScalePolicy", u, "action", "conditionids", "duration", "name", "quiettime"); err != nil {
+		return nil, err
+	}
+	if _, found := u["action"]; found {
+		p.SetAction(u.Get("action"))
+	}
+	if _, found := u["conditionids"]; found {
+		p.SetConditionids(strings.Split(u.Get("conditionids"), ","))
+	}
+	if _, found := u["duration"]; found {
+		v, err := strconv.Atoi(u.Get("duration"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param duration: %v", err)
+		}
+		p.SetDuration(v)
 	}
-	p.p["action"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["quiettime"]; found {
+		v, err := strconv.Atoi(u.Get("quiettime"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param quiettime: %v", err)
+		}
+		p.SetQuiettime(v)
+	}
+	return p, nil
 }
 
+// SetAction sets the action param. This param is required.
+func (p *CreateAutoScalePolicyParams) SetAction(v string) {
+	p.action = optString{v: v, ok: true}
+}
+
+// ResetAction unsets the action param
 func (p *CreateAutoScalePolicyParams) ResetAction() {
-	if p.p != nil && p.p["action"] != nil {
-		delete(p.p, "action")
-	}
+	p.action = optString{}
 }
 
+// GetAction returns the action param and if it is set
 func (p *CreateAutoScalePolicyParams) GetAction() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["action"].(string)
-	return value, ok
+	return p.action.v, p.action.ok
 }
 
+// SetConditionids sets the conditionids param. This param is required.
 func (p *CreateAutoScalePolicyParams) SetConditionids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["conditionids"] = v
+	p.conditionids = optStrings{v: v, ok: true}
 }
 
+// ResetConditionids unsets the conditionids param
 func (p *CreateAutoScalePolicyParams) ResetConditionids() {
-	if p.p != nil && p.p["conditionids"] != nil {
-		delete(p.p, "conditionids")
-	}
+	p.conditionids = optStrings{}
 }
 
+// GetConditionids returns the conditionids param and if it is set
 func (p *CreateAutoScalePolicyParams) GetConditionids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["conditionids"].([]string)
-	return value, ok
+	return p.conditionids.v, p.conditionids.ok
 }
 
+// SetDuration sets the duration param. This param is required.
 func (p *CreateAutoScalePolicyParams) SetDuration(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["duration"] = v
+	p.duration = optInt{v: v, ok: true}
 }
 
+// ResetDuration unsets the duration param
 func (p *CreateAutoScalePolicyParams) ResetDuration() {
-	if p.p != nil && p.p["duration"] != nil {
-		delete(p.p, "duration")
-	}
+	p.duration = optInt{}
 }
 
+// GetDuration returns the duration param and if it is set
 func (p *CreateAutoScalePolicyParams) GetDuration() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["duration"].(int)
-	return value, ok
+	return p.duration.v, p.duration.ok
 }
 
+// SetName sets the name param.
 func (p *CreateAutoScalePolicyParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateAutoScalePolicyParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateAutoScalePolicyParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetQuiettime sets the quiettime param.
 func (p *CreateAutoScalePolicyParams) SetQuiettime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiettime"] = v
+	p.quiettime = optInt{v: v, ok: true}
 }
 
+// ResetQuiettime unsets the quiettime param
 func (p *CreateAutoScalePolicyParams) ResetQuiettime() {
-	if p.p != nil && p.p["quiettime"] != nil {
-		delete(p.p, "quiettime")
-	}
+	p.quiettime = optInt{}
 }
 
+// GetQuiettime returns the quiettime param and if it is set
 func (p *CreateAutoScalePolicyParams) GetQuiettime() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.quiettime.v, p.quiettime.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateAutoScalePolicyParams) Clone() *CreateAutoScalePolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.conditionids = p.conditionids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateAutoScalePolicyParams) Equal(o *CreateAutoScalePolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.action == o.action &&
+		p.conditionids.equal(o.conditionids) &&
+		p.duration == o.duration &&
+		p.name == o.name &&
+		p.quiettime == o.quiettime
+}
+
+// serializedCreateAutoScalePolicyParams is used to (un)marshal CreateAutoScalePolicyParams using the API param names
+type serializedCreateAutoScalePolicyParams struct {
+	Action       *string   `json:"action,omitempty" yaml:"action,omitempty"`
+	Conditionids *[]string `json:"conditionids,omitempty" yaml:"conditionids,omitempty"`
+	Duration     *int      `json:"duration,omitempty" yaml:"duration,omitempty"`
+	Name         *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Quiettime    *int      `json:"quiettime,omitempty" yaml:"quiettime,omitempty"`
+}
+
+func (p *CreateAutoScalePolicyParams) toSerialized() *serializedCreateAutoScalePolicyParams {
+	s := &serializedCreateAutoScalePolicyParams{}
+	if p.action.ok {
+		s.Action = &p.action.v
+	}
+	if p.conditionids.ok {
+		s.Conditionids = &p.conditionids.v
+	}
+	if p.duration.ok {
+		s.Duration = &p.duration.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.quiettime.ok {
+		s.Quiettime = &p.quiettime.v
+	}
+	return s
+}
+
+func (p *CreateAutoScalePolicyParams) fromSerialized(s *serializedCreateAutoScalePolicyParams) {
+	*p = CreateAutoScalePolicyParams{}
+	if s.Action != nil {
+		p.SetAction(*s.Action)
+	}
+	if s.Conditionids != nil {
+		p.SetConditionids(*s.Conditionids)
+	}
+	if s.Duration != nil {
+		p.SetDuration(*s.Duration)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
 	}
-	value, ok := p.p["quiettime"].(int)
-	return value, ok
+	if s.Quiettime != nil {
+		p.SetQuiettime(*s.Quiettime)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateAutoScalePolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateAutoScalePolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateAutoScalePolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateAutoScalePolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams {
 	p := &CreateAutoScalePolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["action"] = action
-	p.p["conditionids"] = conditionids
-	p.p["duration"] = duration
+	p.SetAction(action)
+	p.SetConditionids(conditionids)
+	p.SetDuration(duration)
 	return p
 }
 
-// Creates an autoscale policy for a provision or deprovision action, the action is taken when the all the conditions evaluates to true for the specified duration. The policy is in effect once it is attached to a autscale vm group.
-func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScalePolicy", p.toURLValues())
+// Creates an autoscale policy for a provision or deprovision action, the action is taken when the
+// all the conditions evaluates to true for the specified duration. The policy is in effect once it
+// is attached to a autscale vm group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: action, conditionids,
+// duration.
+func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams, opts ...CallOption) (*CreateAutoScalePolicyResponse, error) {
+	resp, err := s.cs.newRequest("createAutoScalePolicy", s.cs.encodeParams("createAutoScalePolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -240,8 +353,8 @@ func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -279,256 +392,398 @@ type CreateAutoScalePolicyResponse struct {
 }
 
 type CreateAutoScaleVmGroupParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
+	fordisplay         optBool
+	interval           optInt
+	lbruleid           optString
+	maxmembers         optInt
+	minmembers         optInt
+	name               optString
+	scaledownpolicyids optStrings
+	scaleuppolicyids   optStrings
+	vmprofileid        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["interval"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("interval", vv)
+	if p.interval.ok {
+		u.Set("interval", strconv.Itoa(p.interval.v))
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
-	if v, found := p.p["maxmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxmembers", vv)
+	if p.maxmembers.ok {
+		u.Set("maxmembers", strconv.Itoa(p.maxmembers.v))
 	}
-	if v, found := p.p["minmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("minmembers", vv)
+	if p.minmembers.ok {
+		u.Set("minmembers", strconv.Itoa(p.minmembers.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["scaledownpolicyids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("scaledownpolicyids", vv)
+	if p.scaledownpolicyids.ok {
+		u.Set("scaledownpolicyids", strings.Join(p.scaledownpolicyids.v, ","))
 	}
-	if v, found := p.p["scaleuppolicyids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("scaleuppolicyids", vv)
+	if p.scaleuppolicyids.ok {
+		u.Set("scaleuppolicyids", strings.Join(p.scaleuppolicyids.v, ","))
 	}
-	if v, found := p.p["vmprofileid"]; found {
-		u.Set("vmprofileid", v.(string))
+	if p.vmprofileid.ok {
+		u.Set("vmprofileid", p.vmprofileid.v)
 	}
 	return u
 }
 
-func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateAutoScaleVmGroupParams parses url.Values, for example taken from a raw API request,
+// into a new CreateAutoScaleVmGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateAutoScaleVmGroupParams(u url.Values) (*CreateAutoScaleVmGroupParams, error) {
+	p := &CreateAutoScaleVmGroupParams{}
+	if err := checkParamNames("createAutoScaleVmGroup", u, "fordisplay", "interval", "lbruleid", "maxmembers", "minmembers", "name", "scaledownpolicyids", "scaleuppolicyids", "vmprofileid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["interval"]; found {
+		v, err := strconv.Atoi(u.Get("interval"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param interval: %v", err)
+		}
+		p.SetInterval(v)
+	}
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
+	}
+	if _, found := u["maxmembers"]; found {
+		v, err := strconv.Atoi(u.Get("maxmembers"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxmembers: %v", err)
+		}
+		p.SetMaxmembers(v)
+	}
+	if _, found := u["minmembers"]; found {
+		v, err := strconv.Atoi(u.Get("minmembers"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param minmembers: %v", err)
+		}
+		p.SetMinmembers(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["scaledownpolicyids"]; found {
+		p.SetScaledownpolicyids(strings.Split(u.Get("scaledownpolicyids"), ","))
 	}
-	p.p["fordisplay"] = v
+	if _, found := u["scaleuppolicyids"]; found {
+		p.SetScaleuppolicyids(strings.Split(u.Get("scaleuppolicyids"), ","))
+	}
+	if _, found := u["vmprofileid"]; found {
+		p.SetVmprofileid(u.Get("vmprofileid"))
+	}
+	return p, nil
+}
+
+// SetFordisplay sets the fordisplay param.
+func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateAutoScaleVmGroupParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetInterval sets the interval param.
 func (p *CreateAutoScaleVmGroupParams) SetInterval(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["interval"] = v
+	p.interval = optInt{v: v, ok: true}
 }
 
+// ResetInterval unsets the interval param
 func (p *CreateAutoScaleVmGroupParams) ResetInterval() {
-	if p.p != nil && p.p["interval"] != nil {
-		delete(p.p, "interval")
-	}
+	p.interval = optInt{}
 }
 
+// GetInterval returns the interval param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetInterval() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["interval"].(int)
-	return value, ok
+	return p.interval.v, p.interval.ok
 }
 
+// SetLbruleid sets the lbruleid param. This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *CreateAutoScaleVmGroupParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	return p.lbruleid.v, p.lbruleid.ok
 }
 
+// SetMaxmembers sets the maxmembers param. This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetMaxmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxmembers"] = v
+	p.maxmembers = optInt{v: v, ok: true}
 }
 
+// ResetMaxmembers unsets the maxmembers param
 func (p *CreateAutoScaleVmGroupParams) ResetMaxmembers() {
-	if p.p != nil && p.p["maxmembers"] != nil {
-		delete(p.p, "maxmembers")
-	}
+	p.maxmembers = optInt{}
 }
 
+// GetMaxmembers returns the maxmembers param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetMaxmembers() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxmembers"].(int)
-	return value, ok
+	return p.maxmembers.v, p.maxmembers.ok
 }
 
+// SetMinmembers sets the minmembers param. This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetMinmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minmembers"] = v
+	p.minmembers = optInt{v: v, ok: true}
 }
 
+// ResetMinmembers unsets the minmembers param
 func (p *CreateAutoScaleVmGroupParams) ResetMinmembers() {
-	if p.p != nil && p.p["minmembers"] != nil {
-		delete(p.p, "minmembers")
-	}
+	p.minmembers = optInt{}
 }
 
+// GetMinmembers returns the minmembers param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetMinmembers() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["minmembers"].(int)
-	return value, ok
+	return p.minmembers.v, p.minmembers.ok
 }
 
+// SetName sets the name param.
 func (p *CreateAutoScaleVmGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateAutoScaleVmGroupParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetScaledownpolicyids sets the scaledownpolicyids param. This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scaledownpolicyids"] = v
+	p.scaledownpolicyids = optStrings{v: v, ok: true}
 }
 
+// ResetScaledownpolicyids unsets the scaledownpolicyids param
 func (p *CreateAutoScaleVmGroupParams) ResetScaledownpolicyids() {
-	if p.p != nil && p.p["scaledownpolicyids"] != nil {
-		delete(p.p, "scaledownpolicyids")
-	}
+	p.scaledownpolicyids = optStrings{}
 }
 
+// GetScaledownpolicyids returns the scaledownpolicyids param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetScaledownpolicyids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scaledownpolicyids"].([]string)
-	return value, ok
+	return p.scaledownpolicyids.v, p.scaledownpolicyids.ok
 }
 
+// SetScaleuppolicyids sets the scaleuppolicyids param. This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scaleuppolicyids"] = v
+	p.scaleuppolicyids = optStrings{v: v, ok: true}
 }
 
+// ResetScaleuppolicyids unsets the scaleuppolicyids param
 func (p *CreateAutoScaleVmGroupParams) ResetScaleuppolicyids() {
-	if p.p != nil && p.p["scaleuppolicyids"] != nil {
-		delete(p.p, "scaleuppolicyids")
-	}
+	p.scaleuppolicyids = optStrings{}
 }
 
+// GetScaleuppolicyids returns the scaleuppolicyids param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetScaleuppolicyids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scaleuppolicyids"].([]string)
-	return value, ok
+	return p.scaleuppolicyids.v, p.scaleuppolicyids.ok
 }
 
+// SetVmprofileid sets the vmprofileid param. This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetVmprofileid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmprofileid"] = v
+	p.vmprofileid = optString{v: v, ok: true}
 }
 
+// ResetVmprofileid unsets the vmprofileid param
 func (p *CreateAutoScaleVmGroupParams) ResetVmprofileid() {
-	if p.p != nil && p.p["vmprofileid"] != nil {
-		delete(p.p, "vmprofileid")
-	}
+	p.vmprofileid = optString{}
 }
 
+// GetVmprofileid returns the vmprofileid param and if it is set
 func (p *CreateAutoScaleVmGroupParams) GetVmprofileid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmprofileid.v, p.vmprofileid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateAutoScaleVmGroupParams) Clone() *CreateAutoScaleVmGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.scaledownpolicyids = p.scaledownpolicyids.clone()
+	c.scaleuppolicyids = p.scaleuppolicyids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateAutoScaleVmGroupParams) Equal(o *CreateAutoScaleVmGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.interval == o.interval &&
+		p.lbruleid == o.lbruleid &&
+		p.maxmembers == o.maxmembers &&
+		p.minmembers == o.minmembers &&
+		p.name == o.name &&
+		p.scaledownpolicyids.equal(o.scaledownpolicyids) &&
+		p.scaleuppolicyids.equal(o.scaleuppolicyids) &&
+		p.vmprofileid == o.vmprofileid
+}
+
+// serializedCreateAutoScaleVmGroupParams is used to (un)marshal CreateAutoScaleVmGroupParams using the API param names
+type serializedCreateAutoScaleVmGroupParams struct {
+	Fordisplay         *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Interval           *int      `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Lbruleid           *string   `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Maxmembers         *int      `json:"maxmembers,omitempty" yaml:"maxmembers,omitempty"`
+	Minmembers         *int      `json:"minmembers,omitempty" yaml:"minmembers,omitempty"`
+	Name               *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Scaledownpolicyids *[]string `json:"scaledownpolicyids,omitempty" yaml:"scaledownpolicyids,omitempty"`
+	Scaleuppolicyids   *[]string `json:"scaleuppolicyids,omitempty" yaml:"scaleuppolicyids,omitempty"`
+	Vmprofileid        *string   `json:"vmprofileid,omitempty" yaml:"vmprofileid,omitempty"`
+}
+
+func (p *CreateAutoScaleVmGroupParams) toSerialized() *serializedCreateAutoScaleVmGroupParams {
+	s := &serializedCreateAutoScaleVmGroupParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.interval.ok {
+		s.Interval = &p.interval.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	if p.maxmembers.ok {
+		s.Maxmembers = &p.maxmembers.v
+	}
+	if p.minmembers.ok {
+		s.Minmembers = &p.minmembers.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.scaledownpolicyids.ok {
+		s.Scaledownpolicyids = &p.scaledownpolicyids.v
+	}
+	if p.scaleuppolicyids.ok {
+		s.Scaleuppolicyids = &p.scaleuppolicyids.v
+	}
+	if p.vmprofileid.ok {
+		s.Vmprofileid = &p.vmprofileid.v
 	}
-	value, ok := p.p["vmprofileid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *CreateAutoScaleVmGroupParams) fromSerialized(s *serializedCreateAutoScaleVmGroupParams) {
+	*p = CreateAutoScaleVmGroupParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Interval != nil {
+		p.SetInterval(*s.Interval)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
+	}
+	if s.Maxmembers != nil {
+		p.SetMaxmembers(*s.Maxmembers)
+	}
+	if s.Minmembers != nil {
+		p.SetMinmembers(*s.Minmembers)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Scaledownpolicyids != nil {
+		p.SetScaledownpolicyids(*s.Scaledownpolicyids)
+	}
+	if s.Scaleuppolicyids != nil {
+		p.SetScaleuppolicyids(*s.Scaleuppolicyids)
+	}
+	if s.Vmprofileid != nil {
+		p.SetVmprofileid(*s.Vmprofileid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateAutoScaleVmGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateAutoScaleVmGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateAutoScaleVmGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateAutoScaleVmGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams {
 	p := &CreateAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
-	p.p["maxmembers"] = maxmembers
-	p.p["minmembers"] = minmembers
-	p.p["scaledownpolicyids"] = scaledownpolicyids
-	p.p["scaleuppolicyids"] = scaleuppolicyids
-	p.p["vmprofileid"] = vmprofileid
+	p.SetLbruleid(lbruleid)
+	p.SetMaxmembers(maxmembers)
+	p.SetMinmembers(minmembers)
+	p.SetScaledownpolicyids(scaledownpolicyids)
+	p.SetScaleuppolicyids(scaleuppolicyids)
+	p.SetVmprofileid(vmprofileid)
 	return p
 }
 
-// Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
-func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmGroup", p.toURLValues())
+// Creates and automatically starts a virtual machine based on a service offering, disk offering,
+// and template.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: lbruleid, maxmembers,
+// minmembers, scaledownpolicyids, scaleuppolicyids, vmprofileid.
+func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams, opts ...CallOption) (*CreateAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequest("createAutoScaleVmGroup", s.cs.encodeParams("createAutoScaleVmGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +794,8 @@ func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParam
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -593,416 +848,591 @@ type CreateAutoScaleVmGroupResponse struct {
 }
 
 type CreateAutoScaleVmProfileParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
+	account              optString
+	autoscaleuserid      optString
+	counterparam         optStringMap
+	domainid             optString
+	expungevmgraceperiod optInt
+	fordisplay           optBool
+	otherdeployparams    optStringMap
+	projectid            optString
+	serviceofferingid    optString
+	templateid           optString
+	userdata             optString
+	userdatadetails      optStringMap
+	userdataid           optString
+	zoneid               optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateAutoScaleVmProfileParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["autoscaleuserid"]; found {
-		u.Set("autoscaleuserid", v.(string))
+	if p.autoscaleuserid.ok {
+		u.Set("autoscaleuserid", p.autoscaleuserid.v)
 	}
-	if v, found := p.p["counterparam"]; found {
-		m := v.(map[string]string)
+	if p.counterparam.ok {
+		m := p.counterparam.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
 			u.Set(fmt.Sprintf("counterparam[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["expungevmgraceperiod"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("expungevmgraceperiod", vv)
+	if p.expungevmgraceperiod.ok {
+		u.Set("expungevmgraceperiod", strconv.Itoa(p.expungevmgraceperiod.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["otherdeployparams"]; found {
-		m := v.(map[string]string)
+	if p.otherdeployparams.ok {
+		m := p.otherdeployparams.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("otherdeployparams[%d].key", i), k)
 			u.Set(fmt.Sprintf("otherdeployparams[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["userdata"]; found {
-		u.Set("userdata", v.(string))
+	if p.userdata.ok {
+		u.Set("userdata", p.userdata.v)
 	}
-	if v, found := p.p["userdatadetails"]; found {
-		m := v.(map[string]string)
+	if p.userdatadetails.ok {
+		m := p.userdatadetails.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("userdatadetails[%d].key", i), k)
 			u.Set(fmt.Sprintf("userdatadetails[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["userdataid"]; found {
-		u.Set("userdataid", v.(string))
+	if p.userdataid.ok {
+		u.Set("userdataid", p.userdataid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateAutoScaleVmProfileParams parses url.Values, for example taken from a raw API request,
+// into a new CreateAutoScaleVmProfileParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateAutoScaleVmProfileParams(u url.Values) (*CreateAutoScaleVmProfileParams, error) {
+	p := &CreateAutoScaleVmProfileParams{}
+	if err := checkParamNames("createAutoScaleVmProfile", u, "account", "autoscaleuserid", "counterparam[]", "domainid", "expungevmgraceperiod", "fordisplay", "otherdeployparams[]", "projectid", "serviceofferingid", "templateid", "userdata", "userdatadetails[]", "userdataid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["autoscaleuserid"]; found {
+		p.SetAutoscaleuserid(u.Get("autoscaleuserid"))
+	}
+	if m, err := parseKeyValueMap(u, "counterparam", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetCounterparam(m)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["expungevmgraceperiod"]; found {
+		v, err := strconv.Atoi(u.Get("expungevmgraceperiod"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param expungevmgraceperiod: %v", err)
+		}
+		p.SetExpungevmgraceperiod(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if m, err := parseKeyValueMap(u, "otherdeployparams", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetOtherdeployparams(m)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["userdata"]; found {
+		p.SetUserdata(u.Get("userdata"))
+	}
+	if m, err := parseKeyValueMap(u, "userdatadetails", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetUserdatadetails(m)
+	}
+	if _, found := u["userdataid"]; found {
+		p.SetUserdataid(u.Get("userdataid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateAutoScaleVmProfileParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateAutoScaleVmProfileParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAutoscaleuserid sets the autoscaleuserid param.
 func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["autoscaleuserid"] = v
+	p.autoscaleuserid = optString{v: v, ok: true}
 }
 
+// ResetAutoscaleuserid unsets the autoscaleuserid param
 func (p *CreateAutoScaleVmProfileParams) ResetAutoscaleuserid() {
-	if p.p != nil && p.p["autoscaleuserid"] != nil {
-		delete(p.p, "autoscaleuserid")
-	}
+	p.autoscaleuserid = optString{}
 }
 
+// GetAutoscaleuserid returns the autoscaleuserid param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetAutoscaleuserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoscaleuserid"].(string)
-	return value, ok
+	return p.autoscaleuserid.v, p.autoscaleuserid.ok
 }
 
+// SetCounterparam sets the counterparam param.
 func (p *CreateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["counterparam"] = v
+	p.counterparam = optStringMap{v: v, ok: true}
 }
 
+// ResetCounterparam unsets the counterparam param
 func (p *CreateAutoScaleVmProfileParams) ResetCounterparam() {
-	if p.p != nil && p.p["counterparam"] != nil {
-		delete(p.p, "counterparam")
-	}
+	p.counterparam = optStringMap{}
 }
 
+// GetCounterparam returns the counterparam param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetCounterparam() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["counterparam"].(map[string]string)
-	return value, ok
+	return p.counterparam.v, p.counterparam.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateAutoScaleVmProfileParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateAutoScaleVmProfileParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetExpungevmgraceperiod sets the expungevmgraceperiod param.
 func (p *CreateAutoScaleVmProfileParams) SetExpungevmgraceperiod(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["expungevmgraceperiod"] = v
+	p.expungevmgraceperiod = optInt{v: v, ok: true}
 }
 
+// ResetExpungevmgraceperiod unsets the expungevmgraceperiod param
 func (p *CreateAutoScaleVmProfileParams) ResetExpungevmgraceperiod() {
-	if p.p != nil && p.p["expungevmgraceperiod"] != nil {
-		delete(p.p, "expungevmgraceperiod")
-	}
+	p.expungevmgraceperiod = optInt{}
 }
 
+// GetExpungevmgraceperiod returns the expungevmgraceperiod param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetExpungevmgraceperiod() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["expungevmgraceperiod"].(int)
-	return value, ok
+	return p.expungevmgraceperiod.v, p.expungevmgraceperiod.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateAutoScaleVmProfileParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateAutoScaleVmProfileParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetOtherdeployparams sets the otherdeployparams param.
 func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparams(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["otherdeployparams"] = v
+	p.otherdeployparams = optStringMap{v: v, ok: true}
 }
 
+// ResetOtherdeployparams unsets the otherdeployparams param
 func (p *CreateAutoScaleVmProfileParams) ResetOtherdeployparams() {
-	if p.p != nil && p.p["otherdeployparams"] != nil {
-		delete(p.p, "otherdeployparams")
-	}
+	p.otherdeployparams = optStringMap{}
 }
 
+// GetOtherdeployparams returns the otherdeployparams param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetOtherdeployparams() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["otherdeployparams"].(map[string]string)
-	return value, ok
+	return p.otherdeployparams.v, p.otherdeployparams.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateAutoScaleVmProfileParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateAutoScaleVmProfileParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *CreateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *CreateAutoScaleVmProfileParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetTemplateid sets the templateid param. This param is required.
 func (p *CreateAutoScaleVmProfileParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *CreateAutoScaleVmProfileParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetUserdata sets the userdata param.
 func (p *CreateAutoScaleVmProfileParams) SetUserdata(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
+	p.userdata = optString{v: v, ok: true}
 }
 
+// ResetUserdata unsets the userdata param
 func (p *CreateAutoScaleVmProfileParams) ResetUserdata() {
-	if p.p != nil && p.p["userdata"] != nil {
-		delete(p.p, "userdata")
-	}
+	p.userdata = optString{}
 }
 
+// GetUserdata returns the userdata param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetUserdata() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdata"].(string)
-	return value, ok
+	return p.userdata.v, p.userdata.ok
 }
 
+// SetUserdatadetails sets the userdatadetails param.
 func (p *CreateAutoScaleVmProfileParams) SetUserdatadetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdatadetails"] = v
+	p.userdatadetails = optStringMap{v: v, ok: true}
 }
 
+// ResetUserdatadetails unsets the userdatadetails param
 func (p *CreateAutoScaleVmProfileParams) ResetUserdatadetails() {
-	if p.p != nil && p.p["userdatadetails"] != nil {
-		delete(p.p, "userdatadetails")
-	}
+	p.userdatadetails = optStringMap{}
 }
 
+// GetUserdatadetails returns the userdatadetails param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetUserdatadetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdatadetails"].(map[string]string)
-	return value, ok
+	return p.userdatadetails.v, p.userdatadetails.ok
 }
 
+// SetUserdataid sets the userdataid param.
 func (p *CreateAutoScaleVmProfileParams) SetUserdataid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdataid"] = v
+	p.userdataid = optString{v: v, ok: true}
 }
 
+// ResetUserdataid unsets the userdataid param
 func (p *CreateAutoScaleVmProfileParams) ResetUserdataid() {
-	if p.p != nil && p.p["userdataid"] != nil {
-		delete(p.p, "userdataid")
-	}
+	p.userdataid = optString{}
 }
 
+// GetUserdataid returns the userdataid param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetUserdataid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdataid"].(string)
-	return value, ok
+	return p.userdataid.v, p.userdataid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreateAutoScaleVmProfileParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateAutoScaleVmProfileParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateAutoScaleVmProfileParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
-}
-
-// You should always use this function to get a new CreateAutoScaleVmProfileParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams {
-	p := &CreateAutoScaleVmProfileParams{}
-	p.p = make(map[string]interface{})
-	p.p["serviceofferingid"] = serviceofferingid
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
-	return p
+	return p.zoneid.v, p.zoneid.ok
 }
 
-// Creates a profile that contains information about the virtual machine which will be provisioned automatically by autoscale feature.
-func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmProfile", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r CreateAutoScaleVmProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Clone returns a deep copy of the params
+func (p *CreateAutoScaleVmProfileParams) Clone() *CreateAutoScaleVmProfileParams {
+	if p == nil {
+		return nil
 	}
+	c := *p
+	c.counterparam = p.counterparam.clone()
+	c.otherdeployparams = p.otherdeployparams.clone()
+	c.userdatadetails = p.userdatadetails.clone()
+	return &c
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateAutoScaleVmProfileParams) Equal(o *CreateAutoScaleVmProfileParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
+	return p.account == o.account &&
+		p.autoscaleuserid == o.autoscaleuserid &&
+		p.counterparam.equal(o.counterparam) &&
+		p.domainid == o.domainid &&
+		p.expungevmgraceperiod == o.expungevmgraceperiod &&
+		p.fordisplay == o.fordisplay &&
+		p.otherdeployparams.equal(o.otherdeployparams) &&
+		p.projectid == o.projectid &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.templateid == o.templateid &&
+		p.userdata == o.userdata &&
+		p.userdatadetails.equal(o.userdatadetails) &&
+		p.userdataid == o.userdataid &&
+		p.zoneid == o.zoneid
+}
 
-	return &r, nil
+// serializedCreateAutoScaleVmProfileParams is used to (un)marshal CreateAutoScaleVmProfileParams using the API param names
+type serializedCreateAutoScaleVmProfileParams struct {
+	Account              *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Autoscaleuserid      *string            `json:"autoscaleuserid,omitempty" yaml:"autoscaleuserid,omitempty"`
+	Counterparam         *map[string]string `json:"counterparam,omitempty" yaml:"counterparam,omitempty"`
+	Domainid             *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Expungevmgraceperiod *int               `json:"expungevmgraceperiod,omitempty" yaml:"expungevmgraceperiod,omitempty"`
+	Fordisplay           *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Otherdeployparams    *map[string]string `json:"otherdeployparams,omitempty" yaml:"otherdeployparams,omitempty"`
+	Projectid            *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Serviceofferingid    *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Templateid           *string            `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Userdata             *string            `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Userdatadetails      *map[string]string `json:"userdatadetails,omitempty" yaml:"userdatadetails,omitempty"`
+	Userdataid           *string            `json:"userdataid,omitempty" yaml:"userdataid,omitempty"`
+	Zoneid               *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-type CreateAutoScaleVmProfileResponse struct {
-	Account              string            `json:"account"`
-	Autoscaleuserid      string            `json:"autoscaleuserid"`
+func (p *CreateAutoScaleVmProfileParams) toSerialized() *serializedCreateAutoScaleVmProfileParams {
+	s := &serializedCreateAutoScaleVmProfileParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.autoscaleuserid.ok {
+		s.Autoscaleuserid = &p.autoscaleuserid.v
+	}
+	if p.counterparam.ok {
+		s.Counterparam = &p.counterparam.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.expungevmgraceperiod.ok {
+		s.Expungevmgraceperiod = &p.expungevmgraceperiod.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.otherdeployparams.ok {
+		s.Otherdeployparams = &p.otherdeployparams.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	if p.userdatadetails.ok {
+		s.Userdatadetails = &p.userdatadetails.v
+	}
+	if p.userdataid.ok {
+		s.Userdataid = &p.userdataid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateAutoScaleVmProfileParams) fromSerialized(s *serializedCreateAutoScaleVmProfileParams) {
+	*p = CreateAutoScaleVmProfileParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Autoscaleuserid != nil {
+		p.SetAutoscaleuserid(*s.Autoscaleuserid)
+	}
+	if s.Counterparam != nil {
+		p.SetCounterparam(*s.Counterparam)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Expungevmgraceperiod != nil {
+		p.SetExpungevmgraceperiod(*s.Expungevmgraceperiod)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Otherdeployparams != nil {
+		p.SetOtherdeployparams(*s.Otherdeployparams)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+	if s.Userdatadetails != nil {
+		p.SetUserdatadetails(*s.Userdatadetails)
+	}
+	if s.Userdataid != nil {
+		p.SetUserdataid(*s.Userdataid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateAutoScaleVmProfileParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateAutoScaleVmProfileParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateAutoScaleVmProfileParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateAutoScaleVmProfileParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateAutoScaleVmProfileParams instance,
+// as then you are sure you have configured all required params
+func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams {
+	p := &CreateAutoScaleVmProfileParams{}
+	p.SetServiceofferingid(serviceofferingid)
+	p.SetTemplateid(templateid)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Creates a profile that contains information about the virtual machine which will be provisioned
+// automatically by autoscale feature.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: serviceofferingid,
+// templateid, zoneid.
+func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams, opts ...CallOption) (*CreateAutoScaleVmProfileResponse, error) {
+	resp, err := s.cs.newRequest("createAutoScaleVmProfile", s.cs.encodeParams("createAutoScaleVmProfile", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateAutoScaleVmProfileResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type CreateAutoScaleVmProfileResponse struct {
+	Account              string            `json:"account"`
+	Autoscaleuserid      string            `json:"autoscaleuserid"`
 	Domain               string            `json:"domain"`
 	Domainid             string            `json:"domainid"`
 	Expungevmgraceperiod int               `json:"expungevmgraceperiod"`
@@ -1024,176 +1454,290 @@ type CreateAutoScaleVmProfileResponse struct {
 }
 
 type CreateConditionParams struct {
-	p map[string]interface{}
+	account            optString
+	counterid          optString
+	domainid           optString
+	projectid          optString
+	relationaloperator optString
+	threshold          optInt64
 }
 
-func (p *CreateConditionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateConditionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["counterid"]; found {
-		u.Set("counterid", v.(string))
+	if p.counterid.ok {
+		u.Set("counterid", p.counterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["relationaloperator"]; found {
-		u.Set("relationaloperator", v.(string))
+	if p.relationaloperator.ok {
+		u.Set("relationaloperator", p.relationaloperator.v)
 	}
-	if v, found := p.p["threshold"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("threshold", vv)
+	if p.threshold.ok {
+		u.Set("threshold", strconv.FormatInt(p.threshold.v, 10))
 	}
 	return u
 }
 
-func (p *CreateConditionParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateConditionParams parses url.Values, for example taken from a raw API request,
+// into a new CreateConditionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateConditionParams(u url.Values) (*CreateConditionParams, error) {
+	p := &CreateConditionParams{}
+	if err := checkParamNames("createCondition", u, "account", "counterid", "domainid", "projectid", "relationaloperator", "threshold"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["counterid"]; found {
+		p.SetCounterid(u.Get("counterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["relationaloperator"]; found {
+		p.SetRelationaloperator(u.Get("relationaloperator"))
+	}
+	if _, found := u["threshold"]; found {
+		v, err := strconv.ParseInt(u.Get("threshold"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param threshold: %v", err)
+		}
+		p.SetThreshold(v)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateConditionParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateConditionParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateConditionParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCounterid sets the counterid param. This param is required.
 func (p *CreateConditionParams) SetCounterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["counterid"] = v
+	p.counterid = optString{v: v, ok: true}
 }
 
+// ResetCounterid unsets the counterid param
 func (p *CreateConditionParams) ResetCounterid() {
-	if p.p != nil && p.p["counterid"] != nil {
-		delete(p.p, "counterid")
-	}
+	p.counterid = optString{}
 }
 
+// GetCounterid returns the counterid param and if it is set
 func (p *CreateConditionParams) GetCounterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["counterid"].(string)
-	return value, ok
+	return p.counterid.v, p.counterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateConditionParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateConditionParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateConditionParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateConditionParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateConditionParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateConditionParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRelationaloperator sets the relationaloperator param. This param is required.
 func (p *CreateConditionParams) SetRelationaloperator(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["relationaloperator"] = v
+	p.relationaloperator = optString{v: v, ok: true}
 }
 
+// ResetRelationaloperator unsets the relationaloperator param
 func (p *CreateConditionParams) ResetRelationaloperator() {
-	if p.p != nil && p.p["relationaloperator"] != nil {
-		delete(p.p, "relationaloperator")
-	}
+	p.relationaloperator = optString{}
 }
 
+// GetRelationaloperator returns the relationaloperator param and if it is set
 func (p *CreateConditionParams) GetRelationaloperator() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["relationaloperator"].(string)
-	return value, ok
+	return p.relationaloperator.v, p.relationaloperator.ok
 }
 
+// SetThreshold sets the threshold param. This param is required.
 func (p *CreateConditionParams) SetThreshold(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["threshold"] = v
+	p.threshold = optInt64{v: v, ok: true}
 }
 
+// ResetThreshold unsets the threshold param
 func (p *CreateConditionParams) ResetThreshold() {
-	if p.p != nil && p.p["threshold"] != nil {
-		delete(p.p, "threshold")
-	}
+	p.threshold = optInt64{}
 }
 
+// GetThreshold returns the threshold param and if it is set
 func (p *CreateConditionParams) GetThreshold() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.threshold.v, p.threshold.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateConditionParams) Clone() *CreateConditionParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["threshold"].(int64)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateConditionParams) Equal(o *CreateConditionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.counterid == o.counterid &&
+		p.domainid == o.domainid &&
+		p.projectid == o.projectid &&
+		p.relationaloperator == o.relationaloperator &&
+		p.threshold == o.threshold
+}
+
+// serializedCreateConditionParams is used to (un)marshal CreateConditionParams using the API param names
+type serializedCreateConditionParams struct {
+	Account            *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Counterid          *string `json:"counterid,omitempty" yaml:"counterid,omitempty"`
+	Domainid           *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Projectid          *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Relationaloperator *string `json:"relationaloperator,omitempty" yaml:"relationaloperator,omitempty"`
+	Threshold          *int64  `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+}
+
+func (p *CreateConditionParams) toSerialized() *serializedCreateConditionParams {
+	s := &serializedCreateConditionParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.counterid.ok {
+		s.Counterid = &p.counterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.relationaloperator.ok {
+		s.Relationaloperator = &p.relationaloperator.v
+	}
+	if p.threshold.ok {
+		s.Threshold = &p.threshold.v
+	}
+	return s
+}
+
+func (p *CreateConditionParams) fromSerialized(s *serializedCreateConditionParams) {
+	*p = CreateConditionParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Counterid != nil {
+		p.SetCounterid(*s.Counterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Relationaloperator != nil {
+		p.SetRelationaloperator(*s.Relationaloperator)
+	}
+	if s.Threshold != nil {
+		p.SetThreshold(*s.Threshold)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateConditionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateConditionParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateConditionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateConditionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateConditionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateConditionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateConditionParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams {
 	p := &CreateConditionParams{}
-	p.p = make(map[string]interface{})
-	p.p["counterid"] = counterid
-	p.p["relationaloperator"] = relationaloperator
-	p.p["threshold"] = threshold
+	p.SetCounterid(counterid)
+	p.SetRelationaloperator(relationaloperator)
+	p.SetThreshold(threshold)
 	return p
 }
 
-// Creates a condition for VM auto scaling
-func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
-	resp, err := s.cs.newRequest("createCondition", p.toURLValues())
+// Creates a condition for VM auto scaling.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: counterid,
+// relationaloperator, threshold.
+func (s *AutoScaleService) CreateCondition(p *CreateConditionParams, opts ...CallOption) (*CreateConditionResponse, error) {
+	resp, err := s.cs.newRequest("createCondition", s.cs.encodeParams("createCondition", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1204,8 +1748,8 @@ func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateCon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1244,128 +1788,227 @@ type CreateConditionResponse struct {
 }
 
 type CreateCounterParams struct {
-	p map[string]interface{}
+	name     optString
+	provider optString
+	source   optString
+	value    optString
 }
 
-func (p *CreateCounterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateCounterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["source"]; found {
-		u.Set("source", v.(string))
+	if p.source.ok {
+		u.Set("source", p.source.v)
 	}
-	if v, found := p.p["value"]; found {
-		u.Set("value", v.(string))
+	if p.value.ok {
+		u.Set("value", p.value.v)
 	}
 	return u
 }
 
-func (p *CreateCounterParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateCounterParams parses url.Values, for example taken from a raw API request,
+// into a new CreateCounterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateCounterParams(u url.Values) (*CreateCounterParams, error) {
+	p := &CreateCounterParams{}
+	if err := checkParamNames("createCounter", u, "name", "provider", "source", "value"); err != nil {
+		return nil, err
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["name"] = v
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["source"]; found {
+		p.SetSource(u.Get("source"))
+	}
+	if _, found := u["value"]; found {
+		p.SetValue(u.Get("value"))
+	}
+	return p, nil
 }
 
+// SetName sets the name param. This param is required.
+func (p *CreateCounterParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
 func (p *CreateCounterParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateCounterParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProvider sets the provider param. This param is required.
 func (p *CreateCounterParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *CreateCounterParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *CreateCounterParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetSource sets the source param. This param is required.
 func (p *CreateCounterParams) SetSource(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["source"] = v
+	p.source = optString{v: v, ok: true}
 }
 
+// ResetSource unsets the source param
 func (p *CreateCounterParams) ResetSource() {
-	if p.p != nil && p.p["source"] != nil {
-		delete(p.p, "source")
-	}
+	p.source = optString{}
 }
 
+// GetSource returns the source param and if it is set
 func (p *CreateCounterParams) GetSource() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["source"].(string)
-	return value, ok
+	return p.source.v, p.source.ok
 }
 
+// SetValue sets the value param. This param is required.
 func (p *CreateCounterParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["value"] = v
+	p.value = optString{v: v, ok: true}
 }
 
+// ResetValue unsets the value param
 func (p *CreateCounterParams) ResetValue() {
-	if p.p != nil && p.p["value"] != nil {
-		delete(p.p, "value")
-	}
+	p.value = optString{}
 }
 
+// GetValue returns the value param and if it is set
 func (p *CreateCounterParams) GetValue() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.value.v, p.value.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateCounterParams) Clone() *CreateCounterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateCounterParams) Equal(o *CreateCounterParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["value"].(string)
-	return value, ok
+	return p.name == o.name &&
+		p.provider == o.provider &&
+		p.source == o.source &&
+		p.value == o.value
+}
+
+// serializedCreateCounterParams is used to (un)marshal CreateCounterParams using the API param names
+type serializedCreateCounterParams struct {
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Source   *string `json:"source,omitempty" yaml:"source,omitempty"`
+	Value    *string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+func (p *CreateCounterParams) toSerialized() *serializedCreateCounterParams {
+	s := &serializedCreateCounterParams{}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.source.ok {
+		s.Source = &p.source.v
+	}
+	if p.value.ok {
+		s.Value = &p.value.v
+	}
+	return s
+}
+
+func (p *CreateCounterParams) fromSerialized(s *serializedCreateCounterParams) {
+	*p = CreateCounterParams{}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Source != nil {
+		p.SetSource(*s.Source)
+	}
+	if s.Value != nil {
+		p.SetValue(*s.Value)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateCounterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateCounterParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateCounterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateCounterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateCounterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateCounterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateCounterParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateCounterParams(name string, provider string, source string, value string) *CreateCounterParams {
 	p := &CreateCounterParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["provider"] = provider
-	p.p["source"] = source
-	p.p["value"] = value
+	p.SetName(name)
+	p.SetProvider(provider)
+	p.SetSource(source)
+	p.SetValue(value)
 	return p
 }
 
-// Adds metric counter for VM auto scaling
-func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
-	resp, err := s.cs.newRequest("createCounter", p.toURLValues())
+// Adds metric counter for VM auto scaling.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: name, provider, source,
+// value.
+func (s *AutoScaleService) CreateCounter(p *CreateCounterParams, opts ...CallOption) (*CreateCounterResponse, error) {
+	resp, err := s.cs.newRequest("createCounter", s.cs.encodeParams("createCounter", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1376,8 +2019,8 @@ func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounter
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1410,53 +2053,133 @@ type CreateCounterResponse struct {
 }
 
 type DeleteAutoScalePolicyParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteAutoScalePolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteAutoScalePolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteAutoScalePolicyParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteAutoScalePolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteAutoScalePolicyParams(u url.Values) (*DeleteAutoScalePolicyParams, error) {
+	p := &DeleteAutoScalePolicyParams{}
+	if err := checkParamNames("deleteAutoScalePolicy", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteAutoScalePolicyParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteAutoScalePolicyParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteAutoScalePolicyParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteAutoScalePolicyParams) Clone() *DeleteAutoScalePolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteAutoScalePolicyParams) Equal(o *DeleteAutoScalePolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteAutoScalePolicyParams is used to (un)marshal DeleteAutoScalePolicyParams using the API param names
+type serializedDeleteAutoScalePolicyParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteAutoScalePolicyParams) toSerialized() *serializedDeleteAutoScalePolicyParams {
+	s := &serializedDeleteAutoScalePolicyParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DeleteAutoScalePolicyParams) fromSerialized(s *serializedDeleteAutoScalePolicyParams) {
+	*p = DeleteAutoScalePolicyParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteAutoScalePolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteAutoScalePolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteAutoScalePolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteAutoScalePolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams {
 	p := &DeleteAutoScalePolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a autoscale policy.
-func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScalePolicy", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams, opts ...CallOption) (*DeleteAutoScalePolicyResponse, error) {
+	resp, err := s.cs.newRequest("deleteAutoScalePolicy", s.cs.encodeParams("deleteAutoScalePolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1467,8 +2190,8 @@ func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1492,78 +2215,167 @@ type DeleteAutoScalePolicyResponse struct {
 }
 
 type DeleteAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+	cleanup optBool
+	id      optString
 }
 
-func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanup", vv)
+	if p.cleanup.ok {
+		u.Set("cleanup", strconv.FormatBool(p.cleanup.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteAutoScaleVmGroupParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteAutoScaleVmGroupParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteAutoScaleVmGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteAutoScaleVmGroupParams(u url.Values) (*DeleteAutoScaleVmGroupParams, error) {
+	p := &DeleteAutoScaleVmGroupParams{}
+	if err := checkParamNames("deleteAutoScaleVmGroup", u, "cleanup", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cleanup"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanup: %v", err)
+		}
+		p.SetCleanup(v)
 	}
-	p.p["cleanup"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetCleanup sets the cleanup param.
+func (p *DeleteAutoScaleVmGroupParams) SetCleanup(v bool) {
+	p.cleanup = optBool{v: v, ok: true}
+}
+
+// ResetCleanup unsets the cleanup param
 func (p *DeleteAutoScaleVmGroupParams) ResetCleanup() {
-	if p.p != nil && p.p["cleanup"] != nil {
-		delete(p.p, "cleanup")
-	}
+	p.cleanup = optBool{}
 }
 
+// GetCleanup returns the cleanup param and if it is set
 func (p *DeleteAutoScaleVmGroupParams) GetCleanup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanup"].(bool)
-	return value, ok
+	return p.cleanup.v, p.cleanup.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteAutoScaleVmGroupParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteAutoScaleVmGroupParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteAutoScaleVmGroupParams) Clone() *DeleteAutoScaleVmGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteAutoScaleVmGroupParams) Equal(o *DeleteAutoScaleVmGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cleanup == o.cleanup &&
+		p.id == o.id
+}
+
+// serializedDeleteAutoScaleVmGroupParams is used to (un)marshal DeleteAutoScaleVmGroupParams using the API param names
+type serializedDeleteAutoScaleVmGroupParams struct {
+	Cleanup *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteAutoScaleVmGroupParams) toSerialized() *serializedDeleteAutoScaleVmGroupParams {
+	s := &serializedDeleteAutoScaleVmGroupParams{}
+	if p.cleanup.ok {
+		s.Cleanup = &p.cleanup.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteAutoScaleVmGroupParams) fromSerialized(s *serializedDeleteAutoScaleVmGroupParams) {
+	*p = DeleteAutoScaleVmGroupParams{}
+	if s.Cleanup != nil {
+		p.SetCleanup(*s.Cleanup)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteAutoScaleVmGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteAutoScaleVmGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteAutoScaleVmGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteAutoScaleVmGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams {
 	p := &DeleteAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a autoscale vm group.
-func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmGroup", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams, opts ...CallOption) (*DeleteAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequest("deleteAutoScaleVmGroup", s.cs.encodeParams("deleteAutoScaleVmGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1574,8 +2386,8 @@ func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParam
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1599,53 +2411,133 @@ type DeleteAutoScaleVmGroupResponse struct {
 }
 
 type DeleteAutoScaleVmProfileParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteAutoScaleVmProfileParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteAutoScaleVmProfileParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteAutoScaleVmProfileParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteAutoScaleVmProfileParams(u url.Values) (*DeleteAutoScaleVmProfileParams, error) {
+	p := &DeleteAutoScaleVmProfileParams{}
+	if err := checkParamNames("deleteAutoScaleVmProfile", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteAutoScaleVmProfileParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteAutoScaleVmProfileParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteAutoScaleVmProfileParams) Clone() *DeleteAutoScaleVmProfileParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteAutoScaleVmProfileParams) Equal(o *DeleteAutoScaleVmProfileParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteAutoScaleVmProfileParams is used to (un)marshal DeleteAutoScaleVmProfileParams using the API param names
+type serializedDeleteAutoScaleVmProfileParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteAutoScaleVmProfileParams) toSerialized() *serializedDeleteAutoScaleVmProfileParams {
+	s := &serializedDeleteAutoScaleVmProfileParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteAutoScaleVmProfileParams) fromSerialized(s *serializedDeleteAutoScaleVmProfileParams) {
+	*p = DeleteAutoScaleVmProfileParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteAutoScaleVmProfileParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteAutoScaleVmProfileParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteAutoScaleVmProfileParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteAutoScaleVmProfileParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteAutoScaleVmProfileParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams {
 	p := &DeleteAutoScaleVmProfileParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a autoscale vm profile.
-func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmProfile", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams, opts ...CallOption) (*DeleteAutoScaleVmProfileResponse, error) {
+	resp, err := s.cs.newRequest("deleteAutoScaleVmProfile", s.cs.encodeParams("deleteAutoScaleVmProfile", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1656,8 +2548,8 @@ func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileP
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1681,53 +2573,133 @@ type DeleteAutoScaleVmProfileResponse struct {
 }
 
 type DeleteConditionParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteConditionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteConditionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteConditionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteConditionParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteConditionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteConditionParams(u url.Values) (*DeleteConditionParams, error) {
+	p := &DeleteConditionParams{}
+	if err := checkParamNames("deleteCondition", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteConditionParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteConditionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteConditionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteConditionParams) Clone() *DeleteConditionParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteConditionParams) Equal(o *DeleteConditionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteConditionParams is used to (un)marshal DeleteConditionParams using the API param names
+type serializedDeleteConditionParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteConditionParams) toSerialized() *serializedDeleteConditionParams {
+	s := &serializedDeleteConditionParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteConditionParams) fromSerialized(s *serializedDeleteConditionParams) {
+	*p = DeleteConditionParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteConditionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteConditionParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteConditionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteConditionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteConditionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteConditionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteConditionParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteConditionParams(id string) *DeleteConditionParams {
 	p := &DeleteConditionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Removes a condition for VM auto scaling
-func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
-	resp, err := s.cs.newRequest("deleteCondition", p.toURLValues())
+// Removes a condition for VM auto scaling.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams, opts ...CallOption) (*DeleteConditionResponse, error) {
+	resp, err := s.cs.newRequest("deleteCondition", s.cs.encodeParams("deleteCondition", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1738,8 +2710,8 @@ func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteCon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1763,53 +2735,133 @@ type DeleteConditionResponse struct {
 }
 
 type DeleteCounterParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteCounterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteCounterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteCounterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteCounterParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteCounterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteCounterParams(u url.Values) (*DeleteCounterParams, error) {
+	p := &DeleteCounterParams{}
+	if err := checkParamNames("deleteCounter", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteCounterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteCounterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteCounterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteCounterParams) Clone() *DeleteCounterParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteCounterParams) Equal(o *DeleteCounterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteCounterParams is used to (un)marshal DeleteCounterParams using the API param names
+type serializedDeleteCounterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteCounterParams) toSerialized() *serializedDeleteCounterParams {
+	s := &serializedDeleteCounterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteCounterParams) fromSerialized(s *serializedDeleteCounterParams) {
+	*p = DeleteCounterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteCounterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteCounterParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteCounterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteCounterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteCounterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteCounterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteCounterParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteCounterParams(id string) *DeleteCounterParams {
 	p := &DeleteCounterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a counter for VM auto scaling
-func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCounter", p.toURLValues())
+// Deletes a counter for VM auto scaling.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams, opts ...CallOption) (*DeleteCounterResponse, error) {
+	resp, err := s.cs.newRequest("deleteCounter", s.cs.encodeParams("deleteCounter", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1820,8 +2872,8 @@ func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounter
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1845,53 +2897,133 @@ type DeleteCounterResponse struct {
 }
 
 type DisableAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableAutoScaleVmGroupParams parses url.Values, for example taken from a raw API request,
+// into a new DisableAutoScaleVmGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableAutoScaleVmGroupParams(u url.Values) (*DisableAutoScaleVmGroupParams, error) {
+	p := &DisableAutoScaleVmGroupParams{}
+	if err := checkParamNames("disableAutoScaleVmGroup", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DisableAutoScaleVmGroupParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DisableAutoScaleVmGroupParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableAutoScaleVmGroupParams) Clone() *DisableAutoScaleVmGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableAutoScaleVmGroupParams) Equal(o *DisableAutoScaleVmGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDisableAutoScaleVmGroupParams is used to (un)marshal DisableAutoScaleVmGroupParams using the API param names
+type serializedDisableAutoScaleVmGroupParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DisableAutoScaleVmGroupParams) toSerialized() *serializedDisableAutoScaleVmGroupParams {
+	s := &serializedDisableAutoScaleVmGroupParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DisableAutoScaleVmGroupParams) fromSerialized(s *serializedDisableAutoScaleVmGroupParams) {
+	*p = DisableAutoScaleVmGroupParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableAutoScaleVmGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableAutoScaleVmGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableAutoScaleVmGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableAutoScaleVmGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams {
 	p := &DisableAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Disables an AutoScale Vm Group
-func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("disableAutoScaleVmGroup", p.toURLValues())
+// Disables an AutoScale Vm Group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams, opts ...CallOption) (*DisableAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequest("disableAutoScaleVmGroup", s.cs.encodeParams("disableAutoScaleVmGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1902,8 +3034,8 @@ func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupPar
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1956,53 +3088,133 @@ type DisableAutoScaleVmGroupResponse struct {
 }
 
 type EnableAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableAutoScaleVmGroupParams parses url.Values, for example taken from a raw API request,
+// into a new EnableAutoScaleVmGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableAutoScaleVmGroupParams(u url.Values) (*EnableAutoScaleVmGroupParams, error) {
+	p := &EnableAutoScaleVmGroupParams{}
+	if err := checkParamNames("enableAutoScaleVmGroup", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *EnableAutoScaleVmGroupParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *EnableAutoScaleVmGroupParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableAutoScaleVmGroupParams) Clone() *EnableAutoScaleVmGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableAutoScaleVmGroupParams) Equal(o *EnableAutoScaleVmGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedEnableAutoScaleVmGroupParams is used to (un)marshal EnableAutoScaleVmGroupParams using the API param names
+type serializedEnableAutoScaleVmGroupParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *EnableAutoScaleVmGroupParams) toSerialized() *serializedEnableAutoScaleVmGroupParams {
+	s := &serializedEnableAutoScaleVmGroupParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *EnableAutoScaleVmGroupParams) fromSerialized(s *serializedEnableAutoScaleVmGroupParams) {
+	*p = EnableAutoScaleVmGroupParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableAutoScaleVmGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableAutoScaleVmGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableAutoScaleVmGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableAutoScaleVmGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams {
 	p := &EnableAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Enables an AutoScale Vm Group
-func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("enableAutoScaleVmGroup", p.toURLValues())
+// Enables an AutoScale Vm Group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams, opts ...CallOption) (*EnableAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequest("enableAutoScaleVmGroup", s.cs.encodeParams("enableAutoScaleVmGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2013,8 +3225,8 @@ func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParam
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2067,347 +3279,507 @@ type EnableAutoScaleVmGroupResponse struct {
 }
 
 type ListAutoScalePoliciesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAutoScalePoliciesParams) toURLValues() url.Values {
+	account     optString
+	action      optString
+	conditionid optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	vmgroupid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAutoScalePoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if p.action.ok {
+		u.Set("action", p.action.v)
 	}
-	if v, found := p.p["conditionid"]; found {
-		u.Set("conditionid", v.(string))
+	if p.conditionid.ok {
+		u.Set("conditionid", p.conditionid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["vmgroupid"]; found {
-		u.Set("vmgroupid", v.(string))
+	if p.vmgroupid.ok {
+		u.Set("vmgroupid", p.vmgroupid.v)
 	}
 	return u
 }
 
+// ParseListAutoScalePoliciesParams parses url.Values, for example taken from a raw API request,
+// into a new ListAutoScalePoliciesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAutoScalePoliciesParams(u url.Values) (*ListAutoScalePoliciesParams, error) {
+	p := &ListAutoScalePoliciesParams{}
+	if err := checkParamNames("listAutoScalePolicies", u, "account", "action", "conditionid", "domainid", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid", "vmgroupid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["action"]; found {
+		p.SetAction(u.Get("action"))
+	}
+	if _, found := u["conditionid"]; found {
+		p.SetConditionid(u.Get("conditionid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["vmgroupid"]; found {
+		p.SetVmgroupid(u.Get("vmgroupid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
 func (p *ListAutoScalePoliciesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListAutoScalePoliciesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListAutoScalePoliciesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAction sets the action param.
 func (p *ListAutoScalePoliciesParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["action"] = v
+	p.action = optString{v: v, ok: true}
 }
 
+// ResetAction unsets the action param
 func (p *ListAutoScalePoliciesParams) ResetAction() {
-	if p.p != nil && p.p["action"] != nil {
-		delete(p.p, "action")
-	}
+	p.action = optString{}
 }
 
+// GetAction returns the action param and if it is set
 func (p *ListAutoScalePoliciesParams) GetAction() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["action"].(string)
-	return value, ok
+	return p.action.v, p.action.ok
 }
 
+// SetConditionid sets the conditionid param.
 func (p *ListAutoScalePoliciesParams) SetConditionid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["conditionid"] = v
+	p.conditionid = optString{v: v, ok: true}
 }
 
+// ResetConditionid unsets the conditionid param
 func (p *ListAutoScalePoliciesParams) ResetConditionid() {
-	if p.p != nil && p.p["conditionid"] != nil {
-		delete(p.p, "conditionid")
-	}
+	p.conditionid = optString{}
 }
 
+// GetConditionid returns the conditionid param and if it is set
 func (p *ListAutoScalePoliciesParams) GetConditionid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["conditionid"].(string)
-	return value, ok
+	return p.conditionid.v, p.conditionid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListAutoScalePoliciesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListAutoScalePoliciesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListAutoScalePoliciesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListAutoScalePoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListAutoScalePoliciesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListAutoScalePoliciesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListAutoScalePoliciesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListAutoScalePoliciesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListAutoScalePoliciesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAutoScalePoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAutoScalePoliciesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAutoScalePoliciesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListAutoScalePoliciesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListAutoScalePoliciesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListAutoScalePoliciesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListAutoScalePoliciesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListAutoScalePoliciesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListAutoScalePoliciesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAutoScalePoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAutoScalePoliciesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAutoScalePoliciesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAutoScalePoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAutoScalePoliciesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAutoScalePoliciesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListAutoScalePoliciesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListAutoScalePoliciesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListAutoScalePoliciesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVmgroupid sets the vmgroupid param.
 func (p *ListAutoScalePoliciesParams) SetVmgroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmgroupid"] = v
+	p.vmgroupid = optString{v: v, ok: true}
 }
 
+// ResetVmgroupid unsets the vmgroupid param
 func (p *ListAutoScalePoliciesParams) ResetVmgroupid() {
-	if p.p != nil && p.p["vmgroupid"] != nil {
-		delete(p.p, "vmgroupid")
-	}
+	p.vmgroupid = optString{}
 }
 
+// GetVmgroupid returns the vmgroupid param and if it is set
 func (p *ListAutoScalePoliciesParams) GetVmgroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmgroupid.v, p.vmgroupid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAutoScalePoliciesParams) Clone() *ListAutoScalePoliciesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAutoScalePoliciesParams) Equal(o *ListAutoScalePoliciesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.action == o.action &&
+		p.conditionid == o.conditionid &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.vmgroupid == o.vmgroupid
+}
+
+// serializedListAutoScalePoliciesParams is used to (un)marshal ListAutoScalePoliciesParams using the API param names
+type serializedListAutoScalePoliciesParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Action      *string `json:"action,omitempty" yaml:"action,omitempty"`
+	Conditionid *string `json:"conditionid,omitempty" yaml:"conditionid,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vmgroupid   *string `json:"vmgroupid,omitempty" yaml:"vmgroupid,omitempty"`
+}
+
+func (p *ListAutoScalePoliciesParams) toSerialized() *serializedListAutoScalePoliciesParams {
+	s := &serializedListAutoScalePoliciesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.action.ok {
+		s.Action = &p.action.v
+	}
+	if p.conditionid.ok {
+		s.Conditionid = &p.conditionid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.vmgroupid.ok {
+		s.Vmgroupid = &p.vmgroupid.v
+	}
+	return s
+}
+
+func (p *ListAutoScalePoliciesParams) fromSerialized(s *serializedListAutoScalePoliciesParams) {
+	*p = ListAutoScalePoliciesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Action != nil {
+		p.SetAction(*s.Action)
 	}
-	value, ok := p.p["vmgroupid"].(string)
-	return value, ok
+	if s.Conditionid != nil {
+		p.SetConditionid(*s.Conditionid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Vmgroupid != nil {
+		p.SetVmgroupid(*s.Vmgroupid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAutoScalePoliciesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAutoScalePoliciesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAutoScalePoliciesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAutoScalePoliciesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAutoScalePoliciesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAutoScalePoliciesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAutoScalePoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams {
 	p := &ListAutoScalePoliciesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScalePolicyID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAutoScalePoliciesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2455,9 +3827,8 @@ func (s *AutoScaleService) GetAutoScalePolicyByName(name string, opts ...OptionF
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
 	p := &ListAutoScalePoliciesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2486,8 +3857,8 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc)
 }
 
 // Lists autoscale policies.
-func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScalePolicies", p.toURLValues())
+func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams, opts ...CallOption) (*ListAutoScalePoliciesResponse, error) {
+	resp, err := s.cs.newRequest("listAutoScalePolicies", s.cs.encodeParams("listAutoScalePolicies", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2522,396 +3893,571 @@ type AutoScalePolicy struct {
 }
 
 type ListAutoScaleVmGroupsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAutoScaleVmGroupsParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	lbruleid    optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	policyid    optString
+	projectid   optString
+	vmprofileid optString
+	zoneid      optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAutoScaleVmGroupsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["policyid"]; found {
-		u.Set("policyid", v.(string))
+	if p.policyid.ok {
+		u.Set("policyid", p.policyid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["vmprofileid"]; found {
-		u.Set("vmprofileid", v.(string))
+	if p.vmprofileid.ok {
+		u.Set("vmprofileid", p.vmprofileid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAutoScaleVmGroupsParams parses url.Values, for example taken from a raw API request,
+// into a new ListAutoScaleVmGroupsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAutoScaleVmGroupsParams(u url.Values) (*ListAutoScaleVmGroupsParams, error) {
+	p := &ListAutoScaleVmGroupsParams{}
+	if err := checkParamNames("listAutoScaleVmGroups", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "lbruleid", "listall", "name", "page", "pagesize", "policyid", "projectid", "vmprofileid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["account"] = v
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["policyid"]; found {
+		p.SetPolicyid(u.Get("policyid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["vmprofileid"]; found {
+		p.SetVmprofileid(u.Get("vmprofileid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListAutoScaleVmGroupsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListAutoScaleVmGroupsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListAutoScaleVmGroupsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListAutoScaleVmGroupsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListAutoScaleVmGroupsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListAutoScaleVmGroupsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListAutoScaleVmGroupsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListAutoScaleVmGroupsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListAutoScaleVmGroupsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAutoScaleVmGroupsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAutoScaleVmGroupsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLbruleid sets the lbruleid param.
 func (p *ListAutoScaleVmGroupsParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *ListAutoScaleVmGroupsParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	return p.lbruleid.v, p.lbruleid.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListAutoScaleVmGroupsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListAutoScaleVmGroupsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListAutoScaleVmGroupsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListAutoScaleVmGroupsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAutoScaleVmGroupsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAutoScaleVmGroupsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAutoScaleVmGroupsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAutoScaleVmGroupsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPolicyid sets the policyid param.
 func (p *ListAutoScaleVmGroupsParams) SetPolicyid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["policyid"] = v
+	p.policyid = optString{v: v, ok: true}
 }
 
+// ResetPolicyid unsets the policyid param
 func (p *ListAutoScaleVmGroupsParams) ResetPolicyid() {
-	if p.p != nil && p.p["policyid"] != nil {
-		delete(p.p, "policyid")
-	}
+	p.policyid = optString{}
 }
 
+// GetPolicyid returns the policyid param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetPolicyid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["policyid"].(string)
-	return value, ok
+	return p.policyid.v, p.policyid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListAutoScaleVmGroupsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListAutoScaleVmGroupsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVmprofileid sets the vmprofileid param.
 func (p *ListAutoScaleVmGroupsParams) SetVmprofileid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmprofileid"] = v
+	p.vmprofileid = optString{v: v, ok: true}
 }
 
+// ResetVmprofileid unsets the vmprofileid param
 func (p *ListAutoScaleVmGroupsParams) ResetVmprofileid() {
-	if p.p != nil && p.p["vmprofileid"] != nil {
-		delete(p.p, "vmprofileid")
-	}
+	p.vmprofileid = optString{}
 }
 
+// GetVmprofileid returns the vmprofileid param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetVmprofileid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vmprofileid"].(string)
-	return value, ok
+	return p.vmprofileid.v, p.vmprofileid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListAutoScaleVmGroupsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListAutoScaleVmGroupsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListAutoScaleVmGroupsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAutoScaleVmGroupsParams) Clone() *ListAutoScaleVmGroupsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAutoScaleVmGroupsParams) Equal(o *ListAutoScaleVmGroupsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.lbruleid == o.lbruleid &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.policyid == o.policyid &&
+		p.projectid == o.projectid &&
+		p.vmprofileid == o.vmprofileid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListAutoScaleVmGroupsParams is used to (un)marshal ListAutoScaleVmGroupsParams using the API param names
+type serializedListAutoScaleVmGroupsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbruleid    *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Policyid    *string `json:"policyid,omitempty" yaml:"policyid,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vmprofileid *string `json:"vmprofileid,omitempty" yaml:"vmprofileid,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListAutoScaleVmGroupsParams) toSerialized() *serializedListAutoScaleVmGroupsParams {
+	s := &serializedListAutoScaleVmGroupsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.policyid.ok {
+		s.Policyid = &p.policyid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.vmprofileid.ok {
+		s.Vmprofileid = &p.vmprofileid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListAutoScaleVmGroupsParams) fromSerialized(s *serializedListAutoScaleVmGroupsParams) {
+	*p = ListAutoScaleVmGroupsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Policyid != nil {
+		p.SetPolicyid(*s.Policyid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Vmprofileid != nil {
+		p.SetVmprofileid(*s.Vmprofileid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAutoScaleVmGroupsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAutoScaleVmGroupsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAutoScaleVmGroupsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAutoScaleVmGroupsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAutoScaleVmGroupsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAutoScaleVmGroupsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAutoScaleVmGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams {
 	p := &ListAutoScaleVmGroupsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmGroupID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAutoScaleVmGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2959,9 +4505,8 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByName(name string, opts ...Option
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
 	p := &ListAutoScaleVmGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2990,8 +4535,8 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc
 }
 
 // Lists autoscale vm groups.
-func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmGroups", p.toURLValues())
+func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams, opts ...CallOption) (*ListAutoScaleVmGroupsResponse, error) {
+	resp, err := s.cs.newRequest("listAutoScaleVmGroups", s.cs.encodeParams("listAutoScaleVmGroups", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3041,372 +4586,541 @@ type AutoScaleVmGroup struct {
 }
 
 type ListAutoScaleVmProfilesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAutoScaleVmProfilesParams) toURLValues() url.Values {
+	account           optString
+	domainid          optString
+	fordisplay        optBool
+	id                optString
+	isrecursive       optBool
+	keyword           optString
+	listall           optBool
+	otherdeployparams optString
+	page              optInt
+	pagesize          optInt
+	projectid         optString
+	serviceofferingid optString
+	templateid        optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListAutoScaleVmProfilesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["otherdeployparams"]; found {
-		u.Set("otherdeployparams", v.(string))
+	if p.otherdeployparams.ok {
+		u.Set("otherdeployparams", p.otherdeployparams.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListAutoScaleVmProfilesParams parses url.Values, for example taken from a raw API request,
+// into a new ListAutoScaleVmProfilesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListAutoScaleVmProfilesParams(u url.Values) (*ListAutoScaleVmProfilesParams, error) {
+	p := &ListAutoScaleVmProfilesParams{}
+	if err := checkParamNames("listAutoScaleVmProfiles", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "otherdeployparams", "page", "pagesize", "projectid", "serviceofferingid", "templateid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["otherdeployparams"]; found {
+		p.SetOtherdeployparams(u.Get("otherdeployparams"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
 	}
-	p.p["account"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListAutoScaleVmProfilesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListAutoScaleVmProfilesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListAutoScaleVmProfilesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListAutoScaleVmProfilesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListAutoScaleVmProfilesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListAutoScaleVmProfilesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListAutoScaleVmProfilesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListAutoScaleVmProfilesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListAutoScaleVmProfilesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListAutoScaleVmProfilesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListAutoScaleVmProfilesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListAutoScaleVmProfilesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListAutoScaleVmProfilesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetOtherdeployparams sets the otherdeployparams param.
 func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparams(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["otherdeployparams"] = v
+	p.otherdeployparams = optString{v: v, ok: true}
 }
 
+// ResetOtherdeployparams unsets the otherdeployparams param
 func (p *ListAutoScaleVmProfilesParams) ResetOtherdeployparams() {
-	if p.p != nil && p.p["otherdeployparams"] != nil {
-		delete(p.p, "otherdeployparams")
-	}
+	p.otherdeployparams = optString{}
 }
 
+// GetOtherdeployparams returns the otherdeployparams param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetOtherdeployparams() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["otherdeployparams"].(string)
-	return value, ok
+	return p.otherdeployparams.v, p.otherdeployparams.ok
 }
 
+// SetPage sets the page param.
 func (p *ListAutoScaleVmProfilesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListAutoScaleVmProfilesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListAutoScaleVmProfilesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListAutoScaleVmProfilesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListAutoScaleVmProfilesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListAutoScaleVmProfilesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param.
 func (p *ListAutoScaleVmProfilesParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ListAutoScaleVmProfilesParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetTemplateid sets the templateid param.
 func (p *ListAutoScaleVmProfilesParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *ListAutoScaleVmProfilesParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListAutoScaleVmProfilesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListAutoScaleVmProfilesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListAutoScaleVmProfilesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListAutoScaleVmProfilesParams) Clone() *ListAutoScaleVmProfilesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListAutoScaleVmProfilesParams) Equal(o *ListAutoScaleVmProfilesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.otherdeployparams == o.otherdeployparams &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.templateid == o.templateid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListAutoScaleVmProfilesParams is used to (un)marshal ListAutoScaleVmProfilesParams using the API param names
+type serializedListAutoScaleVmProfilesParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay        *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive       *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Otherdeployparams *string `json:"otherdeployparams,omitempty" yaml:"otherdeployparams,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Serviceofferingid *string `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Templateid        *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListAutoScaleVmProfilesParams) toSerialized() *serializedListAutoScaleVmProfilesParams {
+	s := &serializedListAutoScaleVmProfilesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.otherdeployparams.ok {
+		s.Otherdeployparams = &p.otherdeployparams.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListAutoScaleVmProfilesParams) fromSerialized(s *serializedListAutoScaleVmProfilesParams) {
+	*p = ListAutoScaleVmProfilesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Otherdeployparams != nil {
+		p.SetOtherdeployparams(*s.Otherdeployparams)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListAutoScaleVmProfilesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListAutoScaleVmProfilesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListAutoScaleVmProfilesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListAutoScaleVmProfilesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListAutoScaleVmProfilesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListAutoScaleVmProfilesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListAutoScaleVmProfilesParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams {
 	p := &ListAutoScaleVmProfilesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
 	p := &ListAutoScaleVmProfilesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3435,8 +5149,8 @@ func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFu
 }
 
 // Lists autoscale vm profiles.
-func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmProfiles", p.toURLValues())
+func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams, opts ...CallOption) (*ListAutoScaleVmProfilesResponse, error) {
+	resp, err := s.cs.newRequest("listAutoScaleVmProfiles", s.cs.encodeParams("listAutoScaleVmProfiles", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3478,299 +5192,447 @@ type AutoScaleVmProfile struct {
 }
 
 type ListConditionsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListConditionsParams) toURLValues() url.Values {
+	account     optString
+	counterid   optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	policyid    optString
+	projectid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListConditionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["counterid"]; found {
-		u.Set("counterid", v.(string))
+	if p.counterid.ok {
+		u.Set("counterid", p.counterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["policyid"]; found {
-		u.Set("policyid", v.(string))
+	if p.policyid.ok {
+		u.Set("policyid", p.policyid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *ListConditionsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListConditionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListConditionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListConditionsParams(u url.Values) (*ListConditionsParams, error) {
+	p := &ListConditionsParams{}
+	if err := checkParamNames("listConditions", u, "account", "counterid", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "policyid", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["counterid"]; found {
+		p.SetCounterid(u.Get("counterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["policyid"]; found {
+		p.SetPolicyid(u.Get("policyid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListConditionsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListConditionsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListConditionsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCounterid sets the counterid param.
 func (p *ListConditionsParams) SetCounterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["counterid"] = v
+	p.counterid = optString{v: v, ok: true}
 }
 
+// ResetCounterid unsets the counterid param
 func (p *ListConditionsParams) ResetCounterid() {
-	if p.p != nil && p.p["counterid"] != nil {
-		delete(p.p, "counterid")
-	}
+	p.counterid = optString{}
 }
 
+// GetCounterid returns the counterid param and if it is set
 func (p *ListConditionsParams) GetCounterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["counterid"].(string)
-	return value, ok
+	return p.counterid.v, p.counterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListConditionsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListConditionsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListConditionsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListConditionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListConditionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListConditionsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListConditionsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListConditionsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListConditionsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListConditionsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListConditionsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListConditionsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListConditionsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListConditionsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListConditionsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListConditionsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListConditionsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListConditionsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListConditionsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListConditionsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListConditionsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPolicyid sets the policyid param.
 func (p *ListConditionsParams) SetPolicyid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["policyid"] = v
+	p.policyid = optString{v: v, ok: true}
 }
 
+// ResetPolicyid unsets the policyid param
 func (p *ListConditionsParams) ResetPolicyid() {
-	if p.p != nil && p.p["policyid"] != nil {
-		delete(p.p, "policyid")
-	}
+	p.policyid = optString{}
 }
 
+// GetPolicyid returns the policyid param and if it is set
 func (p *ListConditionsParams) GetPolicyid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["policyid"].(string)
-	return value, ok
+	return p.policyid.v, p.policyid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListConditionsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListConditionsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListConditionsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListConditionsParams) Clone() *ListConditionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListConditionsParams) Equal(o *ListConditionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.counterid == o.counterid &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.policyid == o.policyid &&
+		p.projectid == o.projectid
+}
+
+// serializedListConditionsParams is used to (un)marshal ListConditionsParams using the API param names
+type serializedListConditionsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Counterid   *string `json:"counterid,omitempty" yaml:"counterid,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Policyid    *string `json:"policyid,omitempty" yaml:"policyid,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *ListConditionsParams) toSerialized() *serializedListConditionsParams {
+	s := &serializedListConditionsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.counterid.ok {
+		s.Counterid = &p.counterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.policyid.ok {
+		s.Policyid = &p.policyid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *ListConditionsParams) fromSerialized(s *serializedListConditionsParams) {
+	*p = ListConditionsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Counterid != nil {
+		p.SetCounterid(*s.Counterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Policyid != nil {
+		p.SetPolicyid(*s.Policyid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListConditionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListConditionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListConditionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListConditionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListConditionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListConditionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListConditionsParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListConditionsParams() *ListConditionsParams {
 	p := &ListConditionsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error) {
 	p := &ListConditionsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3798,9 +5660,9 @@ func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Con
 	return nil, l.Count, fmt.Errorf("There is more then one result for Condition UUID: %s!", id)
 }
 
-// List Conditions for VM auto scaling
-func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
-	resp, err := s.cs.newRequest("listConditions", p.toURLValues())
+// List Conditions for VM auto scaling.
+func (s *AutoScaleService) ListConditions(p *ListConditionsParams, opts ...CallOption) (*ListConditionsResponse, error) {
+	resp, err := s.cs.newRequest("listConditions", s.cs.encodeParams("listConditions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3836,201 +5698,319 @@ type Condition struct {
 }
 
 type ListCountersParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	provider optString
+	source   optString
 }
 
-func (p *ListCountersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListCountersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["source"]; found {
-		u.Set("source", v.(string))
+	if p.source.ok {
+		u.Set("source", p.source.v)
 	}
 	return u
 }
 
-func (p *ListCountersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListCountersParams parses url.Values, for example taken from a raw API request,
+// into a new ListCountersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListCountersParams(u url.Values) (*ListCountersParams, error) {
+	p := &ListCountersParams{}
+	if err := checkParamNames("listCounters", u, "id", "keyword", "name", "page", "pagesize", "provider", "source"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["source"]; found {
+		p.SetSource(u.Get("source"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListCountersParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListCountersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListCountersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListCountersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListCountersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListCountersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListCountersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListCountersParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListCountersParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListCountersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListCountersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListCountersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListCountersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListCountersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListCountersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProvider sets the provider param.
 func (p *ListCountersParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *ListCountersParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *ListCountersParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetSource sets the source param.
 func (p *ListCountersParams) SetSource(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["source"] = v
+	p.source = optString{v: v, ok: true}
 }
 
+// ResetSource unsets the source param
 func (p *ListCountersParams) ResetSource() {
-	if p.p != nil && p.p["source"] != nil {
-		delete(p.p, "source")
-	}
+	p.source = optString{}
 }
 
+// GetSource returns the source param and if it is set
 func (p *ListCountersParams) GetSource() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.source.v, p.source.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListCountersParams) Clone() *ListCountersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListCountersParams) Equal(o *ListCountersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.provider == o.provider &&
+		p.source == o.source
+}
+
+// serializedListCountersParams is used to (un)marshal ListCountersParams using the API param names
+type serializedListCountersParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Source   *string `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+func (p *ListCountersParams) toSerialized() *serializedListCountersParams {
+	s := &serializedListCountersParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["source"].(string)
-	return value, ok
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.source.ok {
+		s.Source = &p.source.v
+	}
+	return s
+}
+
+func (p *ListCountersParams) fromSerialized(s *serializedListCountersParams) {
+	*p = ListCountersParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Source != nil {
+		p.SetSource(*s.Source)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListCountersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListCountersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListCountersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListCountersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListCountersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListCountersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListCountersParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListCountersParams() *ListCountersParams {
 	p := &ListCountersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListCountersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4078,9 +6058,8 @@ func (s *AutoScaleService) GetCounterByName(name string, opts ...OptionFunc) (*C
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error) {
 	p := &ListCountersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4108,9 +6087,9 @@ func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Count
 	return nil, l.Count, fmt.Errorf("There is more then one result for Counter UUID: %s!", id)
 }
 
-// List the counters for VM auto scaling
-func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
-	resp, err := s.cs.newRequest("listCounters", p.toURLValues())
+// List the counters for VM auto scaling.
+func (s *AutoScaleService) ListCounters(p *ListCountersParams, opts ...CallOption) (*ListCountersResponse, error) {
+	resp, err := s.cs.newRequest("listCounters", s.cs.encodeParams("listCounters", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4140,152 +6119,262 @@ type Counter struct {
 }
 
 type UpdateAutoScalePolicyParams struct {
-	p map[string]interface{}
+	conditionids optStrings
+	duration     optInt
+	id           optString
+	name         optString
+	quiettime    optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateAutoScalePolicyParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.conditionids.ok {
+		u.Set("conditionids", strings.Join(p.conditionids.v, ","))
+	}
+	if p.duration.ok {
+		u.Set("duration", strconv.Itoa(p.duration.v))
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.quiettime.ok {
+		u.Set("quiettime", strconv.Itoa(p.quiettime.v))
+	}
+	return u
 }
 
-func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// ParseUpdateAutoScalePolicyParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateAutoScalePolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateAutoScalePolicyParams(u url.Values) (*UpdateAutoScalePolicyParams, error) {
+	p := &UpdateAutoScalePolicyParams{}
+	if err := checkParamNames("updateAutoScalePolicy", u, "conditionids", "duration", "id", "name", "quiettime"); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["conditionids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("conditionids", vv)
+	if _, found := u["conditionids"]; found {
+		p.SetConditionids(strings.Split(u.Get("conditionids"), ","))
 	}
-	if v, found := p.p["duration"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("duration", vv)
+	if _, found := u["duration"]; found {
+		v, err := strconv.Atoi(u.Get("duration"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param duration: %v", err)
+		}
+		p.SetDuration(v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	if v, found := p.p["quiettime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("quiettime", vv)
+	if _, found := u["quiettime"]; found {
+		v, err := strconv.Atoi(u.Get("quiettime"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param quiettime: %v", err)
+		}
+		p.SetQuiettime(v)
 	}
-	return u
+	return p, nil
 }
 
+// SetConditionids sets the conditionids param.
 func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["conditionids"] = v
+	p.conditionids = optStrings{v: v, ok: true}
 }
 
+// ResetConditionids unsets the conditionids param
 func (p *UpdateAutoScalePolicyParams) ResetConditionids() {
-	if p.p != nil && p.p["conditionids"] != nil {
-		delete(p.p, "conditionids")
-	}
+	p.conditionids = optStrings{}
 }
 
+// GetConditionids returns the conditionids param and if it is set
 func (p *UpdateAutoScalePolicyParams) GetConditionids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["conditionids"].([]string)
-	return value, ok
+	return p.conditionids.v, p.conditionids.ok
 }
 
+// SetDuration sets the duration param.
 func (p *UpdateAutoScalePolicyParams) SetDuration(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["duration"] = v
+	p.duration = optInt{v: v, ok: true}
 }
 
+// ResetDuration unsets the duration param
 func (p *UpdateAutoScalePolicyParams) ResetDuration() {
-	if p.p != nil && p.p["duration"] != nil {
-		delete(p.p, "duration")
-	}
+	p.duration = optInt{}
 }
 
+// GetDuration returns the duration param and if it is set
 func (p *UpdateAutoScalePolicyParams) GetDuration() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["duration"].(int)
-	return value, ok
+	return p.duration.v, p.duration.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateAutoScalePolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateAutoScalePolicyParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateAutoScalePolicyParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateAutoScalePolicyParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateAutoScalePolicyParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateAutoScalePolicyParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetQuiettime sets the quiettime param.
 func (p *UpdateAutoScalePolicyParams) SetQuiettime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiettime"] = v
+	p.quiettime = optInt{v: v, ok: true}
 }
 
+// ResetQuiettime unsets the quiettime param
 func (p *UpdateAutoScalePolicyParams) ResetQuiettime() {
-	if p.p != nil && p.p["quiettime"] != nil {
-		delete(p.p, "quiettime")
-	}
+	p.quiettime = optInt{}
 }
 
+// GetQuiettime returns the quiettime param and if it is set
 func (p *UpdateAutoScalePolicyParams) GetQuiettime() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.quiettime.v, p.quiettime.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateAutoScalePolicyParams) Clone() *UpdateAutoScalePolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.conditionids = p.conditionids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateAutoScalePolicyParams) Equal(o *UpdateAutoScalePolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["quiettime"].(int)
-	return value, ok
+	return p.conditionids.equal(o.conditionids) &&
+		p.duration == o.duration &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.quiettime == o.quiettime
+}
+
+// serializedUpdateAutoScalePolicyParams is used to (un)marshal UpdateAutoScalePolicyParams using the API param names
+type serializedUpdateAutoScalePolicyParams struct {
+	Conditionids *[]string `json:"conditionids,omitempty" yaml:"conditionids,omitempty"`
+	Duration     *int      `json:"duration,omitempty" yaml:"duration,omitempty"`
+	Id           *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Name         *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Quiettime    *int      `json:"quiettime,omitempty" yaml:"quiettime,omitempty"`
+}
+
+func (p *UpdateAutoScalePolicyParams) toSerialized() *serializedUpdateAutoScalePolicyParams {
+	s := &serializedUpdateAutoScalePolicyParams{}
+	if p.conditionids.ok {
+		s.Conditionids = &p.conditionids.v
+	}
+	if p.duration.ok {
+		s.Duration = &p.duration.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.quiettime.ok {
+		s.Quiettime = &p.quiettime.v
+	}
+	return s
+}
+
+func (p *UpdateAutoScalePolicyParams) fromSerialized(s *serializedUpdateAutoScalePolicyParams) {
+	*p = UpdateAutoScalePolicyParams{}
+	if s.Conditionids != nil {
+		p.SetConditionids(*s.Conditionids)
+	}
+	if s.Duration != nil {
+		p.SetDuration(*s.Duration)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Quiettime != nil {
+		p.SetQuiettime(*s.Quiettime)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateAutoScalePolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateAutoScalePolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateAutoScalePolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateAutoScalePolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams {
 	p := &UpdateAutoScalePolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates an existing autoscale policy.
-func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScalePolicy", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams, opts ...CallOption) (*UpdateAutoScalePolicyResponse, error) {
+	resp, err := s.cs.newRequest("updateAutoScalePolicy", s.cs.encodeParams("updateAutoScalePolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4296,8 +6385,8 @@ func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4335,251 +6424,391 @@ type UpdateAutoScalePolicyResponse struct {
 }
 
 type UpdateAutoScaleVmGroupParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
+	customid           optString
+	fordisplay         optBool
+	id                 optString
+	interval           optInt
+	maxmembers         optInt
+	minmembers         optInt
+	name               optString
+	scaledownpolicyids optStrings
+	scaleuppolicyids   optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["interval"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("interval", vv)
+	if p.interval.ok {
+		u.Set("interval", strconv.Itoa(p.interval.v))
 	}
-	if v, found := p.p["maxmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxmembers", vv)
+	if p.maxmembers.ok {
+		u.Set("maxmembers", strconv.Itoa(p.maxmembers.v))
 	}
-	if v, found := p.p["minmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("minmembers", vv)
+	if p.minmembers.ok {
+		u.Set("minmembers", strconv.Itoa(p.minmembers.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["scaledownpolicyids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("scaledownpolicyids", vv)
+	if p.scaledownpolicyids.ok {
+		u.Set("scaledownpolicyids", strings.Join(p.scaledownpolicyids.v, ","))
 	}
-	if v, found := p.p["scaleuppolicyids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("scaleuppolicyids", vv)
+	if p.scaleuppolicyids.ok {
+		u.Set("scaleuppolicyids", strings.Join(p.scaleuppolicyids.v, ","))
 	}
 	return u
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateAutoScaleVmGroupParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateAutoScaleVmGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateAutoScaleVmGroupParams(u url.Values) (*UpdateAutoScaleVmGroupParams, error) {
+	p := &UpdateAutoScaleVmGroupParams{}
+	if err := checkParamNames("updateAutoScaleVmGroup", u, "customid", "fordisplay", "id", "interval", "maxmembers", "minmembers", "name", "scaledownpolicyids", "scaleuppolicyids"); err != nil {
+		return nil, err
 	}
-	p.p["customid"] = v
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["interval"]; found {
+		v, err := strconv.Atoi(u.Get("interval"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param interval: %v", err)
+		}
+		p.SetInterval(v)
+	}
+	if _, found := u["maxmembers"]; found {
+		v, err := strconv.Atoi(u.Get("maxmembers"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxmembers: %v", err)
+		}
+		p.SetMaxmembers(v)
+	}
+	if _, found := u["minmembers"]; found {
+		v, err := strconv.Atoi(u.Get("minmembers"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param minmembers: %v", err)
+		}
+		p.SetMinmembers(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["scaledownpolicyids"]; found {
+		p.SetScaledownpolicyids(strings.Split(u.Get("scaledownpolicyids"), ","))
+	}
+	if _, found := u["scaleuppolicyids"]; found {
+		p.SetScaleuppolicyids(strings.Split(u.Get("scaleuppolicyids"), ","))
+	}
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
+func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateAutoScaleVmGroupParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateAutoScaleVmGroupParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateAutoScaleVmGroupParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateAutoScaleVmGroupParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetInterval sets the interval param.
 func (p *UpdateAutoScaleVmGroupParams) SetInterval(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["interval"] = v
+	p.interval = optInt{v: v, ok: true}
 }
 
+// ResetInterval unsets the interval param
 func (p *UpdateAutoScaleVmGroupParams) ResetInterval() {
-	if p.p != nil && p.p["interval"] != nil {
-		delete(p.p, "interval")
-	}
+	p.interval = optInt{}
 }
 
+// GetInterval returns the interval param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetInterval() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["interval"].(int)
-	return value, ok
+	return p.interval.v, p.interval.ok
 }
 
+// SetMaxmembers sets the maxmembers param.
 func (p *UpdateAutoScaleVmGroupParams) SetMaxmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxmembers"] = v
+	p.maxmembers = optInt{v: v, ok: true}
 }
 
+// ResetMaxmembers unsets the maxmembers param
 func (p *UpdateAutoScaleVmGroupParams) ResetMaxmembers() {
-	if p.p != nil && p.p["maxmembers"] != nil {
-		delete(p.p, "maxmembers")
-	}
+	p.maxmembers = optInt{}
 }
 
+// GetMaxmembers returns the maxmembers param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetMaxmembers() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxmembers"].(int)
-	return value, ok
+	return p.maxmembers.v, p.maxmembers.ok
 }
 
+// SetMinmembers sets the minmembers param.
 func (p *UpdateAutoScaleVmGroupParams) SetMinmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minmembers"] = v
+	p.minmembers = optInt{v: v, ok: true}
 }
 
+// ResetMinmembers unsets the minmembers param
 func (p *UpdateAutoScaleVmGroupParams) ResetMinmembers() {
-	if p.p != nil && p.p["minmembers"] != nil {
-		delete(p.p, "minmembers")
-	}
+	p.minmembers = optInt{}
 }
 
+// GetMinmembers returns the minmembers param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetMinmembers() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["minmembers"].(int)
-	return value, ok
+	return p.minmembers.v, p.minmembers.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateAutoScaleVmGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateAutoScaleVmGroupParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetScaledownpolicyids sets the scaledownpolicyids param.
 func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scaledownpolicyids"] = v
+	p.scaledownpolicyids = optStrings{v: v, ok: true}
 }
 
+// ResetScaledownpolicyids unsets the scaledownpolicyids param
 func (p *UpdateAutoScaleVmGroupParams) ResetScaledownpolicyids() {
-	if p.p != nil && p.p["scaledownpolicyids"] != nil {
-		delete(p.p, "scaledownpolicyids")
-	}
+	p.scaledownpolicyids = optStrings{}
 }
 
+// GetScaledownpolicyids returns the scaledownpolicyids param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetScaledownpolicyids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scaledownpolicyids"].([]string)
-	return value, ok
+	return p.scaledownpolicyids.v, p.scaledownpolicyids.ok
 }
 
+// SetScaleuppolicyids sets the scaleuppolicyids param.
 func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scaleuppolicyids"] = v
+	p.scaleuppolicyids = optStrings{v: v, ok: true}
 }
 
+// ResetScaleuppolicyids unsets the scaleuppolicyids param
 func (p *UpdateAutoScaleVmGroupParams) ResetScaleuppolicyids() {
-	if p.p != nil && p.p["scaleuppolicyids"] != nil {
-		delete(p.p, "scaleuppolicyids")
-	}
+	p.scaleuppolicyids = optStrings{}
 }
 
+// GetScaleuppolicyids returns the scaleuppolicyids param and if it is set
 func (p *UpdateAutoScaleVmGroupParams) GetScaleuppolicyids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.scaleuppolicyids.v, p.scaleuppolicyids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateAutoScaleVmGroupParams) Clone() *UpdateAutoScaleVmGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.scaledownpolicyids = p.scaledownpolicyids.clone()
+	c.scaleuppolicyids = p.scaleuppolicyids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateAutoScaleVmGroupParams) Equal(o *UpdateAutoScaleVmGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.interval == o.interval &&
+		p.maxmembers == o.maxmembers &&
+		p.minmembers == o.minmembers &&
+		p.name == o.name &&
+		p.scaledownpolicyids.equal(o.scaledownpolicyids) &&
+		p.scaleuppolicyids.equal(o.scaleuppolicyids)
+}
+
+// serializedUpdateAutoScaleVmGroupParams is used to (un)marshal UpdateAutoScaleVmGroupParams using the API param names
+type serializedUpdateAutoScaleVmGroupParams struct {
+	Customid           *string   `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay         *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                 *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Interval           *int      `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Maxmembers         *int      `json:"maxmembers,omitempty" yaml:"maxmembers,omitempty"`
+	Minmembers         *int      `json:"minmembers,omitempty" yaml:"minmembers,omitempty"`
+	Name               *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Scaledownpolicyids *[]string `json:"scaledownpolicyids,omitempty" yaml:"scaledownpolicyids,omitempty"`
+	Scaleuppolicyids   *[]string `json:"scaleuppolicyids,omitempty" yaml:"scaleuppolicyids,omitempty"`
+}
+
+func (p *UpdateAutoScaleVmGroupParams) toSerialized() *serializedUpdateAutoScaleVmGroupParams {
+	s := &serializedUpdateAutoScaleVmGroupParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.interval.ok {
+		s.Interval = &p.interval.v
+	}
+	if p.maxmembers.ok {
+		s.Maxmembers = &p.maxmembers.v
+	}
+	if p.minmembers.ok {
+		s.Minmembers = &p.minmembers.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.scaledownpolicyids.ok {
+		s.Scaledownpolicyids = &p.scaledownpolicyids.v
+	}
+	if p.scaleuppolicyids.ok {
+		s.Scaleuppolicyids = &p.scaleuppolicyids.v
+	}
+	return s
+}
+
+func (p *UpdateAutoScaleVmGroupParams) fromSerialized(s *serializedUpdateAutoScaleVmGroupParams) {
+	*p = UpdateAutoScaleVmGroupParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Interval != nil {
+		p.SetInterval(*s.Interval)
+	}
+	if s.Maxmembers != nil {
+		p.SetMaxmembers(*s.Maxmembers)
+	}
+	if s.Minmembers != nil {
+		p.SetMinmembers(*s.Minmembers)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Scaledownpolicyids != nil {
+		p.SetScaledownpolicyids(*s.Scaledownpolicyids)
+	}
+	if s.Scaleuppolicyids != nil {
+		p.SetScaleuppolicyids(*s.Scaleuppolicyids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateAutoScaleVmGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateAutoScaleVmGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateAutoScaleVmGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateAutoScaleVmGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["scaleuppolicyids"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams {
 	p := &UpdateAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates an existing autoscale vm group.
-func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmGroup", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams, opts ...CallOption) (*UpdateAutoScaleVmGroupResponse, error) {
+	resp, err := s.cs.newRequest("updateAutoScaleVmGroup", s.cs.encodeParams("updateAutoScaleVmGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4590,8 +6819,8 @@ func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParam
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4644,331 +6873,492 @@ type UpdateAutoScaleVmGroupResponse struct {
 }
 
 type UpdateAutoScaleVmProfileParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
+	autoscaleuserid      optString
+	counterparam         optStringMap
+	customid             optString
+	expungevmgraceperiod optInt
+	fordisplay           optBool
+	id                   optString
+	otherdeployparams    optStringMap
+	serviceofferingid    optString
+	templateid           optString
+	userdata             optString
+	userdatadetails      optStringMap
+	userdataid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateAutoScaleVmProfileParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["autoscaleuserid"]; found {
-		u.Set("autoscaleuserid", v.(string))
+	if p.autoscaleuserid.ok {
+		u.Set("autoscaleuserid", p.autoscaleuserid.v)
 	}
-	if v, found := p.p["counterparam"]; found {
-		m := v.(map[string]string)
+	if p.counterparam.ok {
+		m := p.counterparam.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
 			u.Set(fmt.Sprintf("counterparam[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["expungevmgraceperiod"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("expungevmgraceperiod", vv)
+	if p.expungevmgraceperiod.ok {
+		u.Set("expungevmgraceperiod", strconv.Itoa(p.expungevmgraceperiod.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["otherdeployparams"]; found {
-		m := v.(map[string]string)
+	if p.otherdeployparams.ok {
+		m := p.otherdeployparams.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("otherdeployparams[%d].key", i), k)
 			u.Set(fmt.Sprintf("otherdeployparams[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["userdata"]; found {
-		u.Set("userdata", v.(string))
+	if p.userdata.ok {
+		u.Set("userdata", p.userdata.v)
 	}
-	if v, found := p.p["userdatadetails"]; found {
-		m := v.(map[string]string)
+	if p.userdatadetails.ok {
+		m := p.userdatadetails.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("userdatadetails[%d].key", i), k)
 			u.Set(fmt.Sprintf("userdatadetails[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["userdataid"]; found {
-		u.Set("userdataid", v.(string))
+	if p.userdataid.ok {
+		u.Set("userdataid", p.userdataid.v)
 	}
 	return u
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateAutoScaleVmProfileParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateAutoScaleVmProfileParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateAutoScaleVmProfileParams(u url.Values) (*UpdateAutoScaleVmProfileParams, error) {
+	p := &UpdateAutoScaleVmProfileParams{}
+	if err := checkParamNames("updateAutoScaleVmProfile", u, "autoscaleuserid", "counterparam[]", "customid", "expungevmgraceperiod", "fordisplay", "id", "otherdeployparams[]", "serviceofferingid", "templateid", "userdata", "userdatadetails[]", "userdataid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["autoscaleuserid"]; found {
+		p.SetAutoscaleuserid(u.Get("autoscaleuserid"))
+	}
+	if m, err := parseKeyValueMap(u, "counterparam", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetCounterparam(m)
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["expungevmgraceperiod"]; found {
+		v, err := strconv.Atoi(u.Get("expungevmgraceperiod"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param expungevmgraceperiod: %v", err)
+		}
+		p.SetExpungevmgraceperiod(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if m, err := parseKeyValueMap(u, "otherdeployparams", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetOtherdeployparams(m)
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
 	}
-	p.p["autoscaleuserid"] = v
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["userdata"]; found {
+		p.SetUserdata(u.Get("userdata"))
+	}
+	if m, err := parseKeyValueMap(u, "userdatadetails", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetUserdatadetails(m)
+	}
+	if _, found := u["userdataid"]; found {
+		p.SetUserdataid(u.Get("userdataid"))
+	}
+	return p, nil
+}
+
+// SetAutoscaleuserid sets the autoscaleuserid param.
+func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
+	p.autoscaleuserid = optString{v: v, ok: true}
 }
 
+// ResetAutoscaleuserid unsets the autoscaleuserid param
 func (p *UpdateAutoScaleVmProfileParams) ResetAutoscaleuserid() {
-	if p.p != nil && p.p["autoscaleuserid"] != nil {
-		delete(p.p, "autoscaleuserid")
-	}
+	p.autoscaleuserid = optString{}
 }
 
+// GetAutoscaleuserid returns the autoscaleuserid param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetAutoscaleuserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoscaleuserid"].(string)
-	return value, ok
+	return p.autoscaleuserid.v, p.autoscaleuserid.ok
 }
 
+// SetCounterparam sets the counterparam param.
 func (p *UpdateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["counterparam"] = v
+	p.counterparam = optStringMap{v: v, ok: true}
 }
 
+// ResetCounterparam unsets the counterparam param
 func (p *UpdateAutoScaleVmProfileParams) ResetCounterparam() {
-	if p.p != nil && p.p["counterparam"] != nil {
-		delete(p.p, "counterparam")
-	}
+	p.counterparam = optStringMap{}
 }
 
+// GetCounterparam returns the counterparam param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetCounterparam() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["counterparam"].(map[string]string)
-	return value, ok
+	return p.counterparam.v, p.counterparam.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *UpdateAutoScaleVmProfileParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateAutoScaleVmProfileParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetExpungevmgraceperiod sets the expungevmgraceperiod param.
 func (p *UpdateAutoScaleVmProfileParams) SetExpungevmgraceperiod(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["expungevmgraceperiod"] = v
+	p.expungevmgraceperiod = optInt{v: v, ok: true}
 }
 
+// ResetExpungevmgraceperiod unsets the expungevmgraceperiod param
 func (p *UpdateAutoScaleVmProfileParams) ResetExpungevmgraceperiod() {
-	if p.p != nil && p.p["expungevmgraceperiod"] != nil {
-		delete(p.p, "expungevmgraceperiod")
-	}
+	p.expungevmgraceperiod = optInt{}
 }
 
+// GetExpungevmgraceperiod returns the expungevmgraceperiod param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetExpungevmgraceperiod() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["expungevmgraceperiod"].(int)
-	return value, ok
+	return p.expungevmgraceperiod.v, p.expungevmgraceperiod.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateAutoScaleVmProfileParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateAutoScaleVmProfileParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateAutoScaleVmProfileParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateAutoScaleVmProfileParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetOtherdeployparams sets the otherdeployparams param.
 func (p *UpdateAutoScaleVmProfileParams) SetOtherdeployparams(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["otherdeployparams"] = v
+	p.otherdeployparams = optStringMap{v: v, ok: true}
 }
 
+// ResetOtherdeployparams unsets the otherdeployparams param
 func (p *UpdateAutoScaleVmProfileParams) ResetOtherdeployparams() {
-	if p.p != nil && p.p["otherdeployparams"] != nil {
-		delete(p.p, "otherdeployparams")
-	}
+	p.otherdeployparams = optStringMap{}
 }
 
+// GetOtherdeployparams returns the otherdeployparams param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetOtherdeployparams() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["otherdeployparams"].(map[string]string)
-	return value, ok
+	return p.otherdeployparams.v, p.otherdeployparams.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param.
 func (p *UpdateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *UpdateAutoScaleVmProfileParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetTemplateid sets the templateid param.
 func (p *UpdateAutoScaleVmProfileParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *UpdateAutoScaleVmProfileParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetUserdata sets the userdata param.
 func (p *UpdateAutoScaleVmProfileParams) SetUserdata(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
+	p.userdata = optString{v: v, ok: true}
 }
 
+// ResetUserdata unsets the userdata param
 func (p *UpdateAutoScaleVmProfileParams) ResetUserdata() {
-	if p.p != nil && p.p["userdata"] != nil {
-		delete(p.p, "userdata")
-	}
+	p.userdata = optString{}
 }
 
+// GetUserdata returns the userdata param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetUserdata() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdata"].(string)
-	return value, ok
+	return p.userdata.v, p.userdata.ok
 }
 
+// SetUserdatadetails sets the userdatadetails param.
 func (p *UpdateAutoScaleVmProfileParams) SetUserdatadetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdatadetails"] = v
+	p.userdatadetails = optStringMap{v: v, ok: true}
 }
 
+// ResetUserdatadetails unsets the userdatadetails param
 func (p *UpdateAutoScaleVmProfileParams) ResetUserdatadetails() {
-	if p.p != nil && p.p["userdatadetails"] != nil {
-		delete(p.p, "userdatadetails")
-	}
+	p.userdatadetails = optStringMap{}
 }
 
+// GetUserdatadetails returns the userdatadetails param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetUserdatadetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdatadetails"].(map[string]string)
-	return value, ok
+	return p.userdatadetails.v, p.userdatadetails.ok
 }
 
+// SetUserdataid sets the userdataid param.
 func (p *UpdateAutoScaleVmProfileParams) SetUserdataid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdataid"] = v
+	p.userdataid = optString{v: v, ok: true}
 }
 
+// ResetUserdataid unsets the userdataid param
 func (p *UpdateAutoScaleVmProfileParams) ResetUserdataid() {
-	if p.p != nil && p.p["userdataid"] != nil {
-		delete(p.p, "userdataid")
-	}
+	p.userdataid = optString{}
 }
 
+// GetUserdataid returns the userdataid param and if it is set
 func (p *UpdateAutoScaleVmProfileParams) GetUserdataid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userdataid.v, p.userdataid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateAutoScaleVmProfileParams) Clone() *UpdateAutoScaleVmProfileParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.counterparam = p.counterparam.clone()
+	c.otherdeployparams = p.otherdeployparams.clone()
+	c.userdatadetails = p.userdatadetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateAutoScaleVmProfileParams) Equal(o *UpdateAutoScaleVmProfileParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.autoscaleuserid == o.autoscaleuserid &&
+		p.counterparam.equal(o.counterparam) &&
+		p.customid == o.customid &&
+		p.expungevmgraceperiod == o.expungevmgraceperiod &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.otherdeployparams.equal(o.otherdeployparams) &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.templateid == o.templateid &&
+		p.userdata == o.userdata &&
+		p.userdatadetails.equal(o.userdatadetails) &&
+		p.userdataid == o.userdataid
+}
+
+// serializedUpdateAutoScaleVmProfileParams is used to (un)marshal UpdateAutoScaleVmProfileParams using the API param names
+type serializedUpdateAutoScaleVmProfileParams struct {
+	Autoscaleuserid      *string            `json:"autoscaleuserid,omitempty" yaml:"autoscaleuserid,omitempty"`
+	Counterparam         *map[string]string `json:"counterparam,omitempty" yaml:"counterparam,omitempty"`
+	Customid             *string            `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Expungevmgraceperiod *int               `json:"expungevmgraceperiod,omitempty" yaml:"expungevmgraceperiod,omitempty"`
+	Fordisplay           *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                   *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Otherdeployparams    *map[string]string `json:"otherdeployparams,omitempty" yaml:"otherdeployparams,omitempty"`
+	Serviceofferingid    *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Templateid           *string            `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Userdata             *string            `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Userdatadetails      *map[string]string `json:"userdatadetails,omitempty" yaml:"userdatadetails,omitempty"`
+	Userdataid           *string            `json:"userdataid,omitempty" yaml:"userdataid,omitempty"`
+}
+
+func (p *UpdateAutoScaleVmProfileParams) toSerialized() *serializedUpdateAutoScaleVmProfileParams {
+	s := &serializedUpdateAutoScaleVmProfileParams{}
+	if p.autoscaleuserid.ok {
+		s.Autoscaleuserid = &p.autoscaleuserid.v
+	}
+	if p.counterparam.ok {
+		s.Counterparam = &p.counterparam.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.expungevmgraceperiod.ok {
+		s.Expungevmgraceperiod = &p.expungevmgraceperiod.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
 	}
-	value, ok := p.p["userdataid"].(string)
-	return value, ok
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.otherdeployparams.ok {
+		s.Otherdeployparams = &p.otherdeployparams.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	if p.userdatadetails.ok {
+		s.Userdatadetails = &p.userdatadetails.v
+	}
+	if p.userdataid.ok {
+		s.Userdataid = &p.userdataid.v
+	}
+	return s
+}
+
+func (p *UpdateAutoScaleVmProfileParams) fromSerialized(s *serializedUpdateAutoScaleVmProfileParams) {
+	*p = UpdateAutoScaleVmProfileParams{}
+	if s.Autoscaleuserid != nil {
+		p.SetAutoscaleuserid(*s.Autoscaleuserid)
+	}
+	if s.Counterparam != nil {
+		p.SetCounterparam(*s.Counterparam)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Expungevmgraceperiod != nil {
+		p.SetExpungevmgraceperiod(*s.Expungevmgraceperiod)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Otherdeployparams != nil {
+		p.SetOtherdeployparams(*s.Otherdeployparams)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+	if s.Userdatadetails != nil {
+		p.SetUserdatadetails(*s.Userdatadetails)
+	}
+	if s.Userdataid != nil {
+		p.SetUserdataid(*s.Userdataid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateAutoScaleVmProfileParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateAutoScaleVmProfileParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateAutoScaleVmProfileParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateAutoScaleVmProfileParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateAutoScaleVmProfileParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams {
 	p := &UpdateAutoScaleVmProfileParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates an existing autoscale vm profile.
-func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmProfile", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams, opts ...CallOption) (*UpdateAutoScaleVmProfileResponse, error) {
+	resp, err := s.cs.newRequest("updateAutoScaleVmProfile", s.cs.encodeParams("updateAutoScaleVmProfile", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4979,8 +7369,8 @@ func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileP
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
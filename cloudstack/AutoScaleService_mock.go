@@ -53,183 +53,243 @@ func (m *MockAutoScaleServiceIface) EXPECT() *MockAutoScaleServiceIfaceMockRecor
 }
 
 // CreateAutoScalePolicy mocks base method.
-func (m *MockAutoScaleServiceIface) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
+func (m *MockAutoScaleServiceIface) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams, opts ...CallOption) (*CreateAutoScalePolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAutoScalePolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAutoScalePolicy", varargs...)
 	ret0, _ := ret[0].(*CreateAutoScalePolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAutoScalePolicy indicates an expected call of CreateAutoScalePolicy.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScalePolicy(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScalePolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScalePolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScalePolicy), varargs...)
 }
 
 // CreateAutoScaleVmGroup mocks base method.
-func (m *MockAutoScaleServiceIface) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
+func (m *MockAutoScaleServiceIface) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams, opts ...CallOption) (*CreateAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAutoScaleVmGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAutoScaleVmGroup", varargs...)
 	ret0, _ := ret[0].(*CreateAutoScaleVmGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAutoScaleVmGroup indicates an expected call of CreateAutoScaleVmGroup.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmGroup(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmGroup), varargs...)
 }
 
 // CreateAutoScaleVmProfile mocks base method.
-func (m *MockAutoScaleServiceIface) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
+func (m *MockAutoScaleServiceIface) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams, opts ...CallOption) (*CreateAutoScaleVmProfileResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAutoScaleVmProfile", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAutoScaleVmProfile", varargs...)
 	ret0, _ := ret[0].(*CreateAutoScaleVmProfileResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAutoScaleVmProfile indicates an expected call of CreateAutoScaleVmProfile.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmProfile(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmProfile(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmProfile), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmProfile), varargs...)
 }
 
 // CreateCondition mocks base method.
-func (m *MockAutoScaleServiceIface) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
+func (m *MockAutoScaleServiceIface) CreateCondition(p *CreateConditionParams, opts ...CallOption) (*CreateConditionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCondition", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCondition", varargs...)
 	ret0, _ := ret[0].(*CreateConditionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateCondition indicates an expected call of CreateCondition.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCondition(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCondition(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCondition", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCondition), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCondition", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCondition), varargs...)
 }
 
 // CreateCounter mocks base method.
-func (m *MockAutoScaleServiceIface) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
+func (m *MockAutoScaleServiceIface) CreateCounter(p *CreateCounterParams, opts ...CallOption) (*CreateCounterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCounter", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCounter", varargs...)
 	ret0, _ := ret[0].(*CreateCounterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateCounter indicates an expected call of CreateCounter.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCounter(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCounter(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCounter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCounter), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCounter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCounter), varargs...)
 }
 
 // DeleteAutoScalePolicy mocks base method.
-func (m *MockAutoScaleServiceIface) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
+func (m *MockAutoScaleServiceIface) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams, opts ...CallOption) (*DeleteAutoScalePolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAutoScalePolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAutoScalePolicy", varargs...)
 	ret0, _ := ret[0].(*DeleteAutoScalePolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAutoScalePolicy indicates an expected call of DeleteAutoScalePolicy.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScalePolicy(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScalePolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScalePolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScalePolicy), varargs...)
 }
 
 // DeleteAutoScaleVmGroup mocks base method.
-func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
+func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams, opts ...CallOption) (*DeleteAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAutoScaleVmGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAutoScaleVmGroup", varargs...)
 	ret0, _ := ret[0].(*DeleteAutoScaleVmGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAutoScaleVmGroup indicates an expected call of DeleteAutoScaleVmGroup.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmGroup(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmGroup), varargs...)
 }
 
 // DeleteAutoScaleVmProfile mocks base method.
-func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
+func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams, opts ...CallOption) (*DeleteAutoScaleVmProfileResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAutoScaleVmProfile", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAutoScaleVmProfile", varargs...)
 	ret0, _ := ret[0].(*DeleteAutoScaleVmProfileResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAutoScaleVmProfile indicates an expected call of DeleteAutoScaleVmProfile.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmProfile(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmProfile(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmProfile), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmProfile), varargs...)
 }
 
 // DeleteCondition mocks base method.
-func (m *MockAutoScaleServiceIface) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
+func (m *MockAutoScaleServiceIface) DeleteCondition(p *DeleteConditionParams, opts ...CallOption) (*DeleteConditionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCondition", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCondition", varargs...)
 	ret0, _ := ret[0].(*DeleteConditionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteCondition indicates an expected call of DeleteCondition.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCondition(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCondition(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCondition", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCondition), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCondition", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCondition), varargs...)
 }
 
 // DeleteCounter mocks base method.
-func (m *MockAutoScaleServiceIface) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
+func (m *MockAutoScaleServiceIface) DeleteCounter(p *DeleteCounterParams, opts ...CallOption) (*DeleteCounterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCounter", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCounter", varargs...)
 	ret0, _ := ret[0].(*DeleteCounterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteCounter indicates an expected call of DeleteCounter.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCounter(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCounter(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCounter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCounter), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCounter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCounter), varargs...)
 }
 
 // DisableAutoScaleVmGroup mocks base method.
-func (m *MockAutoScaleServiceIface) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
+func (m *MockAutoScaleServiceIface) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams, opts ...CallOption) (*DisableAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableAutoScaleVmGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableAutoScaleVmGroup", varargs...)
 	ret0, _ := ret[0].(*DisableAutoScaleVmGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableAutoScaleVmGroup indicates an expected call of DisableAutoScaleVmGroup.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) DisableAutoScaleVmGroup(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DisableAutoScaleVmGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DisableAutoScaleVmGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DisableAutoScaleVmGroup), varargs...)
 }
 
 // EnableAutoScaleVmGroup mocks base method.
-func (m *MockAutoScaleServiceIface) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
+func (m *MockAutoScaleServiceIface) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams, opts ...CallOption) (*EnableAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableAutoScaleVmGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableAutoScaleVmGroup", varargs...)
 	ret0, _ := ret[0].(*EnableAutoScaleVmGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableAutoScaleVmGroup indicates an expected call of EnableAutoScaleVmGroup.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) EnableAutoScaleVmGroup(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) EnableAutoScaleVmGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).EnableAutoScaleVmGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).EnableAutoScaleVmGroup), varargs...)
 }
 
 // GetAutoScalePolicyByID mocks base method.
@@ -464,78 +524,103 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterID(name interface{},
 }
 
 // ListAutoScalePolicies mocks base method.
-func (m *MockAutoScaleServiceIface) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+func (m *MockAutoScaleServiceIface) ListAutoScalePolicies(p *ListAutoScalePoliciesParams, opts ...CallOption) (*ListAutoScalePoliciesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAutoScalePolicies", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScalePolicies", varargs...)
 	ret0, _ := ret[0].(*ListAutoScalePoliciesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAutoScalePolicies indicates an expected call of ListAutoScalePolicies.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePolicies(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePolicies(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePolicies", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePolicies), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePolicies", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePolicies), varargs...)
 }
 
 // ListAutoScaleVmGroups mocks base method.
-func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams, opts ...CallOption) (*ListAutoScaleVmGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAutoScaleVmGroups", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmGroups", varargs...)
 	ret0, _ := ret[0].(*ListAutoScaleVmGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAutoScaleVmGroups indicates an expected call of ListAutoScaleVmGroups.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroups(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroups(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroups", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroups), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroups", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroups), varargs...)
 }
 
 // ListAutoScaleVmProfiles mocks base method.
-func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams, opts ...CallOption) (*ListAutoScaleVmProfilesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAutoScaleVmProfiles", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmProfiles", varargs...)
 	ret0, _ := ret[0].(*ListAutoScaleVmProfilesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAutoScaleVmProfiles indicates an expected call of ListAutoScaleVmProfiles.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfiles(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfiles(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfiles", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfiles), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfiles", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfiles), varargs...)
 }
 
 // ListConditions mocks base method.
-func (m *MockAutoScaleServiceIface) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
+func (m *MockAutoScaleServiceIface) ListConditions(p *ListConditionsParams, opts ...CallOption) (*ListConditionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListConditions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConditions", varargs...)
 	ret0, _ := ret[0].(*ListConditionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListConditions indicates an expected call of ListConditions.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditions(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditions", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditions", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditions), varargs...)
 }
 
 // ListCounters mocks base method.
-func (m *MockAutoScaleServiceIface) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
+func (m *MockAutoScaleServiceIface) ListCounters(p *ListCountersParams, opts ...CallOption) (*ListCountersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCounters", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCounters", varargs...)
 	ret0, _ := ret[0].(*ListCountersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListCounters indicates an expected call of ListCounters.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCounters(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCounters(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCounters", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCounters), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCounters", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCounters), varargs...)
 }
 
 // NewCreateAutoScalePolicyParams mocks base method.
@@ -819,46 +904,61 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) NewUpdateAutoScaleVmProfilePara
 }
 
 // UpdateAutoScalePolicy mocks base method.
-func (m *MockAutoScaleServiceIface) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
+func (m *MockAutoScaleServiceIface) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams, opts ...CallOption) (*UpdateAutoScalePolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAutoScalePolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAutoScalePolicy", varargs...)
 	ret0, _ := ret[0].(*UpdateAutoScalePolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAutoScalePolicy indicates an expected call of UpdateAutoScalePolicy.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScalePolicy(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScalePolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScalePolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScalePolicy), varargs...)
 }
 
 // UpdateAutoScaleVmGroup mocks base method.
-func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
+func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams, opts ...CallOption) (*UpdateAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAutoScaleVmGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAutoScaleVmGroup", varargs...)
 	ret0, _ := ret[0].(*UpdateAutoScaleVmGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAutoScaleVmGroup indicates an expected call of UpdateAutoScaleVmGroup.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmGroup(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmGroup), varargs...)
 }
 
 // UpdateAutoScaleVmProfile mocks base method.
-func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
+func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams, opts ...CallOption) (*UpdateAutoScaleVmProfileResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAutoScaleVmProfile", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAutoScaleVmProfile", varargs...)
 	ret0, _ := ret[0].(*UpdateAutoScaleVmProfileResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAutoScaleVmProfile indicates an expected call of UpdateAutoScaleVmProfile.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmProfile(p interface{}) *gomock.Call {
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmProfile(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmProfile), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmProfile), varargs...)
 }
@@ -0,0 +1,108 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"fmt"
+	"time"
+)
+
+// backupDateLayout is the layout of the dates returned by the API
+const backupDateLayout = "2006-01-02T15:04:05-0700"
+
+// backupStatusBackedUp is the status of a backup that finished successfully
+const backupStatusBackedUp = "BackedUp"
+
+// backupPageSize is the number of backups listed per call, as the API cannot sort them
+const backupPageSize = 500
+
+// NoBackupError is returned when a VM has no backup that can be restored
+type NoBackupError struct {
+	VirtualMachineID string
+}
+
+func (e *NoBackupError) Error() string {
+	return fmt.Sprintf("No finished backup found for VM %s", e.VirtualMachineID)
+}
+
+// LatestBackup returns the most recent backup of a VM that finished successfully. All
+// backups of the VM are listed, one page at a time, as the API does not sort them. A
+// *NoBackupError is returned when the VM has no such backup.
+func (cs *CloudStackClient) LatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error) {
+	p := cs.Backup.NewListBackupsParams()
+	p.SetVirtualmachineid(virtualmachineid)
+	p.SetPagesize(backupPageSize)
+
+	var latest *Backup
+	var latestTime time.Time
+	for page, listed := 1, 0; ; page++ {
+		p.SetPage(page)
+		l, err := cs.Backup.ListBackups(p, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range l.Backups {
+			if b.Status != backupStatusBackedUp {
+				continue
+			}
+			created, err := time.Parse(backupDateLayout, b.Created)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to parse the creation date of backup %s: %v", b.Id, err)
+			}
+			if latest == nil || created.After(latestTime) {
+				latest, latestTime = b, created
+			}
+		}
+
+		listed += len(l.Backups)
+		if len(l.Backups) < backupPageSize || listed >= l.Count {
+			break
+		}
+	}
+
+	if latest == nil {
+		return nil, &NoBackupError{VirtualMachineID: virtualmachineid}
+	}
+	return latest, nil
+}
+
+// RestoreFromLatestBackup restores a VM using its most recent backup that finished
+// successfully, and returns the restored backup. The VM has to be stopped before it can be
+// restored. This call always waits for the restore job to finish, also when the client is
+// not an async client, so use WithCallAsyncTimeout to set how long it may take.
+func (cs *CloudStackClient) RestoreFromLatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error) {
+	b, err := cs.LatestBackup(virtualmachineid, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	p := cs.Backup.NewRestoreBackupParams(b.Id)
+	// Copy the options, so the backing array of the slice of the caller is never modified
+	r, err := cs.Backup.RestoreBackup(p, append(append([]CallOption{}, opts...), WithCallWait(true))...)
+	if err != nil {
+		return nil, err
+	}
+	if !r.Success {
+		return nil, fmt.Errorf("Failed to restore VM %s from backup %s: %s", virtualmachineid, b.Id, r.Displaytext)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,4063 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type BackupServiceIface interface {
+	AssignVirtualMachineToBackupOffering(p *AssignVirtualMachineToBackupOfferingParams, opts ...CallOption) (*AssignVirtualMachineToBackupOfferingResponse, error)
+	NewAssignVirtualMachineToBackupOfferingParams(backupofferingid string, virtualmachineid string) *AssignVirtualMachineToBackupOfferingParams
+	CreateBackup(p *CreateBackupParams, opts ...CallOption) (*CreateBackupResponse, error)
+	NewCreateBackupParams(virtualmachineid string) *CreateBackupParams
+	CreateBackupSchedule(p *CreateBackupScheduleParams, opts ...CallOption) (*CreateBackupScheduleResponse, error)
+	NewCreateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams
+	DeleteBackup(p *DeleteBackupParams, opts ...CallOption) (*DeleteBackupResponse, error)
+	NewDeleteBackupParams(id string) *DeleteBackupParams
+	DeleteBackupOffering(p *DeleteBackupOfferingParams, opts ...CallOption) (*DeleteBackupOfferingResponse, error)
+	NewDeleteBackupOfferingParams(id string) *DeleteBackupOfferingParams
+	DeleteBackupSchedule(p *DeleteBackupScheduleParams, opts ...CallOption) (*DeleteBackupScheduleResponse, error)
+	NewDeleteBackupScheduleParams(virtualmachineid string) *DeleteBackupScheduleParams
+	ImportBackupOffering(p *ImportBackupOfferingParams, opts ...CallOption) (*ImportBackupOfferingResponse, error)
+	NewImportBackupOfferingParams(allowuserdrivenbackups bool, description string, externalid string, name string, zoneid string) *ImportBackupOfferingParams
+	ListBackupOfferings(p *ListBackupOfferingsParams, opts ...CallOption) (*ListBackupOfferingsResponse, error)
+	NewListBackupOfferingsParams() *ListBackupOfferingsParams
+	GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error)
+	GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error)
+	ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams, opts ...CallOption) (*ListBackupProviderOfferingsResponse, error)
+	NewListBackupProviderOfferingsParams(zoneid string) *ListBackupProviderOfferingsParams
+	GetBackupProviderOfferingID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	ListBackupProviders(p *ListBackupProvidersParams, opts ...CallOption) (*ListBackupProvidersResponse, error)
+	NewListBackupProvidersParams() *ListBackupProvidersParams
+	ListBackupSchedule(p *ListBackupScheduleParams, opts ...CallOption) (*ListBackupScheduleResponse, error)
+	NewListBackupScheduleParams(virtualmachineid string) *ListBackupScheduleParams
+	ListBackups(p *ListBackupsParams, opts ...CallOption) (*ListBackupsResponse, error)
+	NewListBackupsParams() *ListBackupsParams
+	GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error)
+	RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams, opts ...CallOption) (*RemoveVirtualMachineFromBackupOfferingResponse, error)
+	NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid string) *RemoveVirtualMachineFromBackupOfferingParams
+	RestoreBackup(p *RestoreBackupParams, opts ...CallOption) (*RestoreBackupResponse, error)
+	NewRestoreBackupParams(id string) *RestoreBackupParams
+	RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams, opts ...CallOption) (*RestoreVolumeFromBackupAndAttachToVMResponse, error)
+	NewRestoreVolumeFromBackupAndAttachToVMParams(id string, virtualmachineid string, volumeid string) *RestoreVolumeFromBackupAndAttachToVMParams
+	UpdateBackupOffering(p *UpdateBackupOfferingParams, opts ...CallOption) (*UpdateBackupOfferingResponse, error)
+	NewUpdateBackupOfferingParams(id string) *UpdateBackupOfferingParams
+	UpdateBackupSchedule(p *UpdateBackupScheduleParams, opts ...CallOption) (*UpdateBackupScheduleResponse, error)
+	NewUpdateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams
+}
+
+type AssignVirtualMachineToBackupOfferingParams struct {
+	backupofferingid optString
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AssignVirtualMachineToBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.backupofferingid.ok {
+		u.Set("backupofferingid", p.backupofferingid.v)
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseAssignVirtualMachineToBackupOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new AssignVirtualMachineToBackupOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAssignVirtualMachineToBackupOfferingParams(u url.Values) (*AssignVirtualMachineToBackupOfferingParams, error) {
+	p := &AssignVirtualMachineToBackupOfferingParams{}
+	if err := checkParamNames("assignVirtualMachineToBackupOffering", u, "backupofferingid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["backupofferingid"]; found {
+		p.SetBackupofferingid(u.Get("backupofferingid"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetBackupofferingid sets the backupofferingid param. This param is required.
+func (p *AssignVirtualMachineToBackupOfferingParams) SetBackupofferingid(v string) {
+	p.backupofferingid = optString{v: v, ok: true}
+}
+
+// ResetBackupofferingid unsets the backupofferingid param
+func (p *AssignVirtualMachineToBackupOfferingParams) ResetBackupofferingid() {
+	p.backupofferingid = optString{}
+}
+
+// GetBackupofferingid returns the backupofferingid param and if it is set
+func (p *AssignVirtualMachineToBackupOfferingParams) GetBackupofferingid() (string, bool) {
+	return p.backupofferingid.v, p.backupofferingid.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *AssignVirtualMachineToBackupOfferingParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *AssignVirtualMachineToBackupOfferingParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *AssignVirtualMachineToBackupOfferingParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AssignVirtualMachineToBackupOfferingParams) Clone() *AssignVirtualMachineToBackupOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AssignVirtualMachineToBackupOfferingParams) Equal(o *AssignVirtualMachineToBackupOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.backupofferingid == o.backupofferingid &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedAssignVirtualMachineToBackupOfferingParams is used to (un)marshal AssignVirtualMachineToBackupOfferingParams using the API param names
+type serializedAssignVirtualMachineToBackupOfferingParams struct {
+	Backupofferingid *string `json:"backupofferingid,omitempty" yaml:"backupofferingid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) toSerialized() *serializedAssignVirtualMachineToBackupOfferingParams {
+	s := &serializedAssignVirtualMachineToBackupOfferingParams{}
+	if p.backupofferingid.ok {
+		s.Backupofferingid = &p.backupofferingid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) fromSerialized(s *serializedAssignVirtualMachineToBackupOfferingParams) {
+	*p = AssignVirtualMachineToBackupOfferingParams{}
+	if s.Backupofferingid != nil {
+		p.SetBackupofferingid(*s.Backupofferingid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AssignVirtualMachineToBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AssignVirtualMachineToBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedAssignVirtualMachineToBackupOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AssignVirtualMachineToBackupOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AssignVirtualMachineToBackupOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAssignVirtualMachineToBackupOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new AssignVirtualMachineToBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewAssignVirtualMachineToBackupOfferingParams(backupofferingid string, virtualmachineid string) *AssignVirtualMachineToBackupOfferingParams {
+	p := &AssignVirtualMachineToBackupOfferingParams{}
+	p.SetBackupofferingid(backupofferingid)
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Assigns a VM to a backup offering.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: backupofferingid,
+// virtualmachineid.
+func (s *BackupService) AssignVirtualMachineToBackupOffering(p *AssignVirtualMachineToBackupOfferingParams, opts ...CallOption) (*AssignVirtualMachineToBackupOfferingResponse, error) {
+	resp, err := s.cs.newRequest("assignVirtualMachineToBackupOffering", s.cs.encodeParams("assignVirtualMachineToBackupOffering", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignVirtualMachineToBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type AssignVirtualMachineToBackupOfferingResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type CreateBackupParams struct {
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateBackupParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseCreateBackupParams parses url.Values, for example taken from a raw API request,
+// into a new CreateBackupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateBackupParams(u url.Values) (*CreateBackupParams, error) {
+	p := &CreateBackupParams{}
+	if err := checkParamNames("createBackup", u, "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *CreateBackupParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *CreateBackupParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *CreateBackupParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateBackupParams) Clone() *CreateBackupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateBackupParams) Equal(o *CreateBackupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedCreateBackupParams is used to (un)marshal CreateBackupParams using the API param names
+type serializedCreateBackupParams struct {
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *CreateBackupParams) toSerialized() *serializedCreateBackupParams {
+	s := &serializedCreateBackupParams{}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *CreateBackupParams) fromSerialized(s *serializedCreateBackupParams) {
+	*p = CreateBackupParams{}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateBackupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateBackupParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateBackupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateBackupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateBackupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateBackupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateBackupParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewCreateBackupParams(virtualmachineid string) *CreateBackupParams {
+	p := &CreateBackupParams{}
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Create VM backup.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *BackupService) CreateBackup(p *CreateBackupParams, opts ...CallOption) (*CreateBackupResponse, error) {
+	resp, err := s.cs.newRequest("createBackup", s.cs.encodeParams("createBackup", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type CreateBackupResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type CreateBackupScheduleParams struct {
+	intervaltype     optString
+	schedule         optString
+	timezone         optString
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.intervaltype.ok {
+		u.Set("intervaltype", p.intervaltype.v)
+	}
+	if p.schedule.ok {
+		u.Set("schedule", p.schedule.v)
+	}
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseCreateBackupScheduleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateBackupScheduleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateBackupScheduleParams(u url.Values) (*CreateBackupScheduleParams, error) {
+	p := &CreateBackupScheduleParams{}
+	if err := checkParamNames("createBackupSchedule", u, "intervaltype", "schedule", "timezone", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["intervaltype"]; found {
+		p.SetIntervaltype(u.Get("intervaltype"))
+	}
+	if _, found := u["schedule"]; found {
+		p.SetSchedule(u.Get("schedule"))
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetIntervaltype sets the intervaltype param. This param is required.
+func (p *CreateBackupScheduleParams) SetIntervaltype(v string) {
+	p.intervaltype = optString{v: v, ok: true}
+}
+
+// ResetIntervaltype unsets the intervaltype param
+func (p *CreateBackupScheduleParams) ResetIntervaltype() {
+	p.intervaltype = optString{}
+}
+
+// GetIntervaltype returns the intervaltype param and if it is set
+func (p *CreateBackupScheduleParams) GetIntervaltype() (string, bool) {
+	return p.intervaltype.v, p.intervaltype.ok
+}
+
+// SetSchedule sets the schedule param. This param is required.
+func (p *CreateBackupScheduleParams) SetSchedule(v string) {
+	p.schedule = optString{v: v, ok: true}
+}
+
+// ResetSchedule unsets the schedule param
+func (p *CreateBackupScheduleParams) ResetSchedule() {
+	p.schedule = optString{}
+}
+
+// GetSchedule returns the schedule param and if it is set
+func (p *CreateBackupScheduleParams) GetSchedule() (string, bool) {
+	return p.schedule.v, p.schedule.ok
+}
+
+// SetTimezone sets the timezone param. This param is required.
+func (p *CreateBackupScheduleParams) SetTimezone(v string) {
+	p.timezone = optString{v: v, ok: true}
+}
+
+// ResetTimezone unsets the timezone param
+func (p *CreateBackupScheduleParams) ResetTimezone() {
+	p.timezone = optString{}
+}
+
+// GetTimezone returns the timezone param and if it is set
+func (p *CreateBackupScheduleParams) GetTimezone() (string, bool) {
+	return p.timezone.v, p.timezone.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *CreateBackupScheduleParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *CreateBackupScheduleParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *CreateBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateBackupScheduleParams) Clone() *CreateBackupScheduleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateBackupScheduleParams) Equal(o *CreateBackupScheduleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.intervaltype == o.intervaltype &&
+		p.schedule == o.schedule &&
+		p.timezone == o.timezone &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedCreateBackupScheduleParams is used to (un)marshal CreateBackupScheduleParams using the API param names
+type serializedCreateBackupScheduleParams struct {
+	Intervaltype     *string `json:"intervaltype,omitempty" yaml:"intervaltype,omitempty"`
+	Schedule         *string `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	Timezone         *string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *CreateBackupScheduleParams) toSerialized() *serializedCreateBackupScheduleParams {
+	s := &serializedCreateBackupScheduleParams{}
+	if p.intervaltype.ok {
+		s.Intervaltype = &p.intervaltype.v
+	}
+	if p.schedule.ok {
+		s.Schedule = &p.schedule.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *CreateBackupScheduleParams) fromSerialized(s *serializedCreateBackupScheduleParams) {
+	*p = CreateBackupScheduleParams{}
+	if s.Intervaltype != nil {
+		p.SetIntervaltype(*s.Intervaltype)
+	}
+	if s.Schedule != nil {
+		p.SetSchedule(*s.Schedule)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateBackupScheduleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateBackupScheduleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateBackupScheduleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateBackupScheduleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewCreateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams {
+	p := &CreateBackupScheduleParams{}
+	p.SetIntervaltype(intervaltype)
+	p.SetSchedule(schedule)
+	p.SetTimezone(timezone)
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Creates a user-defined VM backup schedule.
+//
+// Required params: intervaltype, schedule, timezone, virtualmachineid.
+func (s *BackupService) CreateBackupSchedule(p *CreateBackupScheduleParams, opts ...CallOption) (*CreateBackupScheduleResponse, error) {
+	resp, err := s.cs.newRequest("createBackupSchedule", s.cs.encodeParams("createBackupSchedule", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r CreateBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type CreateBackupScheduleResponse struct {
+	Intervaltype       string `json:"intervaltype"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Schedule           string `json:"schedule"`
+	Timezone           string `json:"timezone"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+}
+
+type DeleteBackupParams struct {
+	forced optBool
+	id     optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteBackupParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseDeleteBackupParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteBackupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteBackupParams(u url.Values) (*DeleteBackupParams, error) {
+	p := &DeleteBackupParams{}
+	if err := checkParamNames("deleteBackup", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *DeleteBackupParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
+func (p *DeleteBackupParams) ResetForced() {
+	p.forced = optBool{}
+}
+
+// GetForced returns the forced param and if it is set
+func (p *DeleteBackupParams) GetForced() (bool, bool) {
+	return p.forced.v, p.forced.ok
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteBackupParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteBackupParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteBackupParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteBackupParams) Clone() *DeleteBackupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteBackupParams) Equal(o *DeleteBackupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedDeleteBackupParams is used to (un)marshal DeleteBackupParams using the API param names
+type serializedDeleteBackupParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteBackupParams) toSerialized() *serializedDeleteBackupParams {
+	s := &serializedDeleteBackupParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteBackupParams) fromSerialized(s *serializedDeleteBackupParams) {
+	*p = DeleteBackupParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteBackupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteBackupParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteBackupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteBackupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteBackupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteBackupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteBackupParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewDeleteBackupParams(id string) *DeleteBackupParams {
+	p := &DeleteBackupParams{}
+	p.SetId(id)
+	return p
+}
+
+// Delete VM backup.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *BackupService) DeleteBackup(p *DeleteBackupParams, opts ...CallOption) (*DeleteBackupResponse, error) {
+	resp, err := s.cs.newRequest("deleteBackup", s.cs.encodeParams("deleteBackup", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type DeleteBackupResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteBackupOfferingParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseDeleteBackupOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteBackupOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteBackupOfferingParams(u url.Values) (*DeleteBackupOfferingParams, error) {
+	p := &DeleteBackupOfferingParams{}
+	if err := checkParamNames("deleteBackupOffering", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteBackupOfferingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteBackupOfferingParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteBackupOfferingParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteBackupOfferingParams) Clone() *DeleteBackupOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteBackupOfferingParams) Equal(o *DeleteBackupOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteBackupOfferingParams is used to (un)marshal DeleteBackupOfferingParams using the API param names
+type serializedDeleteBackupOfferingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteBackupOfferingParams) toSerialized() *serializedDeleteBackupOfferingParams {
+	s := &serializedDeleteBackupOfferingParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteBackupOfferingParams) fromSerialized(s *serializedDeleteBackupOfferingParams) {
+	*p = DeleteBackupOfferingParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteBackupOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteBackupOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteBackupOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteBackupOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewDeleteBackupOfferingParams(id string) *DeleteBackupOfferingParams {
+	p := &DeleteBackupOfferingParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a backup offering.
+//
+// Required params: id.
+func (s *BackupService) DeleteBackupOffering(p *DeleteBackupOfferingParams, opts ...CallOption) (*DeleteBackupOfferingResponse, error) {
+	resp, err := s.cs.newRequest("deleteBackupOffering", s.cs.encodeParams("deleteBackupOffering", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteBackupOfferingResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteBackupOfferingResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteBackupOfferingResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteBackupScheduleParams struct {
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseDeleteBackupScheduleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteBackupScheduleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteBackupScheduleParams(u url.Values) (*DeleteBackupScheduleParams, error) {
+	p := &DeleteBackupScheduleParams{}
+	if err := checkParamNames("deleteBackupSchedule", u, "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *DeleteBackupScheduleParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *DeleteBackupScheduleParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *DeleteBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteBackupScheduleParams) Clone() *DeleteBackupScheduleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteBackupScheduleParams) Equal(o *DeleteBackupScheduleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedDeleteBackupScheduleParams is used to (un)marshal DeleteBackupScheduleParams using the API param names
+type serializedDeleteBackupScheduleParams struct {
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *DeleteBackupScheduleParams) toSerialized() *serializedDeleteBackupScheduleParams {
+	s := &serializedDeleteBackupScheduleParams{}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *DeleteBackupScheduleParams) fromSerialized(s *serializedDeleteBackupScheduleParams) {
+	*p = DeleteBackupScheduleParams{}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteBackupScheduleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteBackupScheduleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteBackupScheduleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteBackupScheduleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewDeleteBackupScheduleParams(virtualmachineid string) *DeleteBackupScheduleParams {
+	p := &DeleteBackupScheduleParams{}
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Deletes the backup schedule of a VM.
+//
+// Required params: virtualmachineid.
+func (s *BackupService) DeleteBackupSchedule(p *DeleteBackupScheduleParams, opts ...CallOption) (*DeleteBackupScheduleResponse, error) {
+	resp, err := s.cs.newRequest("deleteBackupSchedule", s.cs.encodeParams("deleteBackupSchedule", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteBackupScheduleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteBackupScheduleResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteBackupScheduleResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ImportBackupOfferingParams struct {
+	allowuserdrivenbackups optBool
+	description            optString
+	externalid             optString
+	name                   optString
+	zoneid                 optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ImportBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.allowuserdrivenbackups.ok {
+		u.Set("allowuserdrivenbackups", strconv.FormatBool(p.allowuserdrivenbackups.v))
+	}
+	if p.description.ok {
+		u.Set("description", p.description.v)
+	}
+	if p.externalid.ok {
+		u.Set("externalid", p.externalid.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
+	}
+	return u
+}
+
+// ParseImportBackupOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new ImportBackupOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseImportBackupOfferingParams(u url.Values) (*ImportBackupOfferingParams, error) {
+	p := &ImportBackupOfferingParams{}
+	if err := checkParamNames("importBackupOffering", u, "allowuserdrivenbackups", "description", "externalid", "name", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allowuserdrivenbackups"]; found {
+		v, err := strconv.ParseBool(u.Get("allowuserdrivenbackups"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param allowuserdrivenbackups: %v", err)
+		}
+		p.SetAllowuserdrivenbackups(v)
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["externalid"]; found {
+		p.SetExternalid(u.Get("externalid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAllowuserdrivenbackups sets the allowuserdrivenbackups param. This param is required.
+func (p *ImportBackupOfferingParams) SetAllowuserdrivenbackups(v bool) {
+	p.allowuserdrivenbackups = optBool{v: v, ok: true}
+}
+
+// ResetAllowuserdrivenbackups unsets the allowuserdrivenbackups param
+func (p *ImportBackupOfferingParams) ResetAllowuserdrivenbackups() {
+	p.allowuserdrivenbackups = optBool{}
+}
+
+// GetAllowuserdrivenbackups returns the allowuserdrivenbackups param and if it is set
+func (p *ImportBackupOfferingParams) GetAllowuserdrivenbackups() (bool, bool) {
+	return p.allowuserdrivenbackups.v, p.allowuserdrivenbackups.ok
+}
+
+// SetDescription sets the description param. This param is required.
+func (p *ImportBackupOfferingParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
+func (p *ImportBackupOfferingParams) ResetDescription() {
+	p.description = optString{}
+}
+
+// GetDescription returns the description param and if it is set
+func (p *ImportBackupOfferingParams) GetDescription() (string, bool) {
+	return p.description.v, p.description.ok
+}
+
+// SetExternalid sets the externalid param. This param is required.
+func (p *ImportBackupOfferingParams) SetExternalid(v string) {
+	p.externalid = optString{v: v, ok: true}
+}
+
+// ResetExternalid unsets the externalid param
+func (p *ImportBackupOfferingParams) ResetExternalid() {
+	p.externalid = optString{}
+}
+
+// GetExternalid returns the externalid param and if it is set
+func (p *ImportBackupOfferingParams) GetExternalid() (string, bool) {
+	return p.externalid.v, p.externalid.ok
+}
+
+// SetName sets the name param. This param is required.
+func (p *ImportBackupOfferingParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *ImportBackupOfferingParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *ImportBackupOfferingParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetZoneid sets the zoneid param. This param is required.
+func (p *ImportBackupOfferingParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *ImportBackupOfferingParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *ImportBackupOfferingParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ImportBackupOfferingParams) Clone() *ImportBackupOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ImportBackupOfferingParams) Equal(o *ImportBackupOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allowuserdrivenbackups == o.allowuserdrivenbackups &&
+		p.description == o.description &&
+		p.externalid == o.externalid &&
+		p.name == o.name &&
+		p.zoneid == o.zoneid
+}
+
+// serializedImportBackupOfferingParams is used to (un)marshal ImportBackupOfferingParams using the API param names
+type serializedImportBackupOfferingParams struct {
+	Allowuserdrivenbackups *bool   `json:"allowuserdrivenbackups,omitempty" yaml:"allowuserdrivenbackups,omitempty"`
+	Description            *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Externalid             *string `json:"externalid,omitempty" yaml:"externalid,omitempty"`
+	Name                   *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Zoneid                 *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ImportBackupOfferingParams) toSerialized() *serializedImportBackupOfferingParams {
+	s := &serializedImportBackupOfferingParams{}
+	if p.allowuserdrivenbackups.ok {
+		s.Allowuserdrivenbackups = &p.allowuserdrivenbackups.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.externalid.ok {
+		s.Externalid = &p.externalid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ImportBackupOfferingParams) fromSerialized(s *serializedImportBackupOfferingParams) {
+	*p = ImportBackupOfferingParams{}
+	if s.Allowuserdrivenbackups != nil {
+		p.SetAllowuserdrivenbackups(*s.Allowuserdrivenbackups)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Externalid != nil {
+		p.SetExternalid(*s.Externalid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ImportBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ImportBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedImportBackupOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ImportBackupOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ImportBackupOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedImportBackupOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ImportBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewImportBackupOfferingParams(allowuserdrivenbackups bool, description string, externalid string, name string, zoneid string) *ImportBackupOfferingParams {
+	p := &ImportBackupOfferingParams{}
+	p.SetAllowuserdrivenbackups(allowuserdrivenbackups)
+	p.SetDescription(description)
+	p.SetExternalid(externalid)
+	p.SetName(name)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Imports a backup offering using a backup provider.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: allowuserdrivenbackups,
+// description, externalid, name, zoneid.
+func (s *BackupService) ImportBackupOffering(p *ImportBackupOfferingParams, opts ...CallOption) (*ImportBackupOfferingResponse, error) {
+	resp, err := s.cs.newRequest("importBackupOffering", s.cs.encodeParams("importBackupOffering", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type ImportBackupOfferingResponse struct {
+	Allowuserdrivenbackups bool   `json:"allowuserdrivenbackups"`
+	Created                string `json:"created"`
+	Description            string `json:"description"`
+	Externalid             string `json:"externalid"`
+	Id                     string `json:"id"`
+	JobID                  string `json:"jobid"`
+	Jobstatus              int    `json:"jobstatus"`
+	Name                   string `json:"name"`
+	Zoneid                 string `json:"zoneid"`
+	Zonename               string `json:"zonename"`
+}
+
+type ListBackupOfferingsParams struct {
+	id       optString
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	zoneid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBackupOfferingsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
+	}
+	return u
+}
+
+// ParseListBackupOfferingsParams parses url.Values, for example taken from a raw API request,
+// into a new ListBackupOfferingsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBackupOfferingsParams(u url.Values) (*ListBackupOfferingsParams, error) {
+	p := &ListBackupOfferingsParams{}
+	if err := checkParamNames("listBackupOfferings", u, "id", "keyword", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListBackupOfferingsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *ListBackupOfferingsParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *ListBackupOfferingsParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListBackupOfferingsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *ListBackupOfferingsParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *ListBackupOfferingsParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetPage sets the page param.
+func (p *ListBackupOfferingsParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *ListBackupOfferingsParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *ListBackupOfferingsParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *ListBackupOfferingsParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListBackupOfferingsParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListBackupOfferingsParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetZoneid sets the zoneid param.
+func (p *ListBackupOfferingsParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *ListBackupOfferingsParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *ListBackupOfferingsParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBackupOfferingsParams) Clone() *ListBackupOfferingsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBackupOfferingsParams) Equal(o *ListBackupOfferingsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListBackupOfferingsParams is used to (un)marshal ListBackupOfferingsParams using the API param names
+type serializedListBackupOfferingsParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListBackupOfferingsParams) toSerialized() *serializedListBackupOfferingsParams {
+	s := &serializedListBackupOfferingsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListBackupOfferingsParams) fromSerialized(s *serializedListBackupOfferingsParams) {
+	*p = ListBackupOfferingsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBackupOfferingsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBackupOfferingsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBackupOfferingsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBackupOfferingsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBackupOfferingsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBackupOfferingsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListBackupOfferingsParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupOfferingsParams() *ListBackupOfferingsParams {
+	p := &ListBackupOfferingsParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListBackupOfferingsParams{}
+
+	p.SetKeyword(keyword)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListBackupOfferings(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.BackupOfferings[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.BackupOfferings {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	id, count, err := s.GetBackupOfferingID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetBackupOfferingByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	p := &ListBackupOfferingsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListBackupOfferings(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.BackupOfferings[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for BackupOffering UUID: %s!", id)
+}
+
+// Lists backup offerings.
+func (s *BackupService) ListBackupOfferings(p *ListBackupOfferingsParams, opts ...CallOption) (*ListBackupOfferingsResponse, error) {
+	resp, err := s.cs.newRequest("listBackupOfferings", s.cs.encodeParams("listBackupOfferings", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupOfferingsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBackupOfferingsResponse struct {
+	Count           int               `json:"count"`
+	BackupOfferings []*BackupOffering `json:"backupoffering"`
+}
+
+type BackupOffering struct {
+	Allowuserdrivenbackups bool   `json:"allowuserdrivenbackups"`
+	Created                string `json:"created"`
+	Description            string `json:"description"`
+	Externalid             string `json:"externalid"`
+	Id                     string `json:"id"`
+	JobID                  string `json:"jobid"`
+	Jobstatus              int    `json:"jobstatus"`
+	Name                   string `json:"name"`
+	Zoneid                 string `json:"zoneid"`
+	Zonename               string `json:"zonename"`
+}
+
+type ListBackupProviderOfferingsParams struct {
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	zoneid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBackupProviderOfferingsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
+	}
+	return u
+}
+
+// ParseListBackupProviderOfferingsParams parses url.Values, for example taken from a raw API request,
+// into a new ListBackupProviderOfferingsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBackupProviderOfferingsParams(u url.Values) (*ListBackupProviderOfferingsParams, error) {
+	p := &ListBackupProviderOfferingsParams{}
+	if err := checkParamNames("listBackupProviderOfferings", u, "keyword", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListBackupProviderOfferingsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *ListBackupProviderOfferingsParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *ListBackupProviderOfferingsParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetPage sets the page param.
+func (p *ListBackupProviderOfferingsParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *ListBackupProviderOfferingsParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *ListBackupProviderOfferingsParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *ListBackupProviderOfferingsParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListBackupProviderOfferingsParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListBackupProviderOfferingsParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetZoneid sets the zoneid param. This param is required.
+func (p *ListBackupProviderOfferingsParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *ListBackupProviderOfferingsParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *ListBackupProviderOfferingsParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBackupProviderOfferingsParams) Clone() *ListBackupProviderOfferingsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBackupProviderOfferingsParams) Equal(o *ListBackupProviderOfferingsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListBackupProviderOfferingsParams is used to (un)marshal ListBackupProviderOfferingsParams using the API param names
+type serializedListBackupProviderOfferingsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListBackupProviderOfferingsParams) toSerialized() *serializedListBackupProviderOfferingsParams {
+	s := &serializedListBackupProviderOfferingsParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListBackupProviderOfferingsParams) fromSerialized(s *serializedListBackupProviderOfferingsParams) {
+	*p = ListBackupProviderOfferingsParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBackupProviderOfferingsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBackupProviderOfferingsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBackupProviderOfferingsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBackupProviderOfferingsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBackupProviderOfferingsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBackupProviderOfferingsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListBackupProviderOfferingsParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupProviderOfferingsParams(zoneid string) *ListBackupProviderOfferingsParams {
+	p := &ListBackupProviderOfferingsParams{}
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupProviderOfferingID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	p := &ListBackupProviderOfferingsParams{}
+
+	p.SetKeyword(keyword)
+	p.SetZoneid(zoneid)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListBackupProviderOfferings(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.BackupProviderOfferings[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.BackupProviderOfferings {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// Lists external backup offerings of the provider.
+//
+// Required params: zoneid.
+func (s *BackupService) ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams, opts ...CallOption) (*ListBackupProviderOfferingsResponse, error) {
+	resp, err := s.cs.newRequest("listBackupProviderOfferings", s.cs.encodeParams("listBackupProviderOfferings", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupProviderOfferingsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBackupProviderOfferingsResponse struct {
+	Count                   int                       `json:"count"`
+	BackupProviderOfferings []*BackupProviderOffering `json:"backupprovideroffering"`
+}
+
+type BackupProviderOffering struct {
+	Allowuserdrivenbackups bool   `json:"allowuserdrivenbackups"`
+	Created                string `json:"created"`
+	Description            string `json:"description"`
+	Externalid             string `json:"externalid"`
+	Id                     string `json:"id"`
+	JobID                  string `json:"jobid"`
+	Jobstatus              int    `json:"jobstatus"`
+	Name                   string `json:"name"`
+	Zoneid                 string `json:"zoneid"`
+	Zonename               string `json:"zonename"`
+}
+
+type ListBackupProvidersParams struct {
+	name optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBackupProvidersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	return u
+}
+
+// ParseListBackupProvidersParams parses url.Values, for example taken from a raw API request,
+// into a new ListBackupProvidersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBackupProvidersParams(u url.Values) (*ListBackupProvidersParams, error) {
+	p := &ListBackupProvidersParams{}
+	if err := checkParamNames("listBackupProviders", u, "name"); err != nil {
+		return nil, err
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	return p, nil
+}
+
+// SetName sets the name param.
+func (p *ListBackupProvidersParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *ListBackupProvidersParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *ListBackupProvidersParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBackupProvidersParams) Clone() *ListBackupProvidersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBackupProvidersParams) Equal(o *ListBackupProvidersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.name == o.name
+}
+
+// serializedListBackupProvidersParams is used to (un)marshal ListBackupProvidersParams using the API param names
+type serializedListBackupProvidersParams struct {
+	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (p *ListBackupProvidersParams) toSerialized() *serializedListBackupProvidersParams {
+	s := &serializedListBackupProvidersParams{}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	return s
+}
+
+func (p *ListBackupProvidersParams) fromSerialized(s *serializedListBackupProvidersParams) {
+	*p = ListBackupProvidersParams{}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBackupProvidersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBackupProvidersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBackupProvidersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBackupProvidersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBackupProvidersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBackupProvidersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListBackupProvidersParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupProvidersParams() *ListBackupProvidersParams {
+	p := &ListBackupProvidersParams{}
+	return p
+}
+
+// Lists Backup and Recovery providers.
+func (s *BackupService) ListBackupProviders(p *ListBackupProvidersParams, opts ...CallOption) (*ListBackupProvidersResponse, error) {
+	resp, err := s.cs.newRequest("listBackupProviders", s.cs.encodeParams("listBackupProviders", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupProvidersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBackupProvidersResponse struct {
+	Count           int               `json:"count"`
+	BackupProviders []*BackupProvider `json:"backupprovider"`
+}
+
+type BackupProvider struct {
+	Description string `json:"description"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+}
+
+type ListBackupScheduleParams struct {
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseListBackupScheduleParams parses url.Values, for example taken from a raw API request,
+// into a new ListBackupScheduleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBackupScheduleParams(u url.Values) (*ListBackupScheduleParams, error) {
+	p := &ListBackupScheduleParams{}
+	if err := checkParamNames("listBackupSchedule", u, "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *ListBackupScheduleParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *ListBackupScheduleParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *ListBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBackupScheduleParams) Clone() *ListBackupScheduleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBackupScheduleParams) Equal(o *ListBackupScheduleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedListBackupScheduleParams is used to (un)marshal ListBackupScheduleParams using the API param names
+type serializedListBackupScheduleParams struct {
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *ListBackupScheduleParams) toSerialized() *serializedListBackupScheduleParams {
+	s := &serializedListBackupScheduleParams{}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *ListBackupScheduleParams) fromSerialized(s *serializedListBackupScheduleParams) {
+	*p = ListBackupScheduleParams{}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBackupScheduleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBackupScheduleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBackupScheduleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBackupScheduleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupScheduleParams(virtualmachineid string) *ListBackupScheduleParams {
+	p := &ListBackupScheduleParams{}
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// List backup schedule of a VM.
+//
+// Required params: virtualmachineid.
+func (s *BackupService) ListBackupSchedule(p *ListBackupScheduleParams, opts ...CallOption) (*ListBackupScheduleResponse, error) {
+	resp, err := s.cs.newRequest("listBackupSchedule", s.cs.encodeParams("listBackupSchedule", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBackupScheduleResponse struct {
+	Count          int               `json:"count"`
+	BackupSchedule []*BackupSchedule `json:"backupschedule"`
+}
+
+type BackupSchedule struct {
+	Intervaltype       string `json:"intervaltype"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Schedule           string `json:"schedule"`
+	Timezone           string `json:"timezone"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+}
+
+type ListBackupsParams struct {
+	account          optString
+	domainid         optString
+	id               optString
+	isrecursive      optBool
+	keyword          optString
+	listall          optBool
+	page             optInt
+	pagesize         optInt
+	projectid        optString
+	virtualmachineid optString
+	zoneid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBackupsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
+	}
+	return u
+}
+
+// ParseListBackupsParams parses url.Values, for example taken from a raw API request,
+// into a new ListBackupsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBackupsParams(u url.Values) (*ListBackupsParams, error) {
+	p := &ListBackupsParams{}
+	if err := checkParamNames("listBackups", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListBackupsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *ListBackupsParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *ListBackupsParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *ListBackupsParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *ListBackupsParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *ListBackupsParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetId sets the id param.
+func (p *ListBackupsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *ListBackupsParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *ListBackupsParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetIsrecursive sets the isrecursive param.
+func (p *ListBackupsParams) SetIsrecursive(v bool) {
+	p.isrecursive = optBool{v: v, ok: true}
+}
+
+// ResetIsrecursive unsets the isrecursive param
+func (p *ListBackupsParams) ResetIsrecursive() {
+	p.isrecursive = optBool{}
+}
+
+// GetIsrecursive returns the isrecursive param and if it is set
+func (p *ListBackupsParams) GetIsrecursive() (bool, bool) {
+	return p.isrecursive.v, p.isrecursive.ok
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListBackupsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *ListBackupsParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *ListBackupsParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetListall sets the listall param.
+func (p *ListBackupsParams) SetListall(v bool) {
+	p.listall = optBool{v: v, ok: true}
+}
+
+// ResetListall unsets the listall param
+func (p *ListBackupsParams) ResetListall() {
+	p.listall = optBool{}
+}
+
+// GetListall returns the listall param and if it is set
+func (p *ListBackupsParams) GetListall() (bool, bool) {
+	return p.listall.v, p.listall.ok
+}
+
+// SetPage sets the page param.
+func (p *ListBackupsParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *ListBackupsParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *ListBackupsParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *ListBackupsParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListBackupsParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListBackupsParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *ListBackupsParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *ListBackupsParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *ListBackupsParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param.
+func (p *ListBackupsParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *ListBackupsParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *ListBackupsParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// SetZoneid sets the zoneid param.
+func (p *ListBackupsParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *ListBackupsParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *ListBackupsParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBackupsParams) Clone() *ListBackupsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBackupsParams) Equal(o *ListBackupsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListBackupsParams is used to (un)marshal ListBackupsParams using the API param names
+type serializedListBackupsParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListBackupsParams) toSerialized() *serializedListBackupsParams {
+	s := &serializedListBackupsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListBackupsParams) fromSerialized(s *serializedListBackupsParams) {
+	*p = ListBackupsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBackupsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBackupsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBackupsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBackupsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBackupsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBackupsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListBackupsParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupsParams() *ListBackupsParams {
+	p := &ListBackupsParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error) {
+	p := &ListBackupsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListBackups(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.Backups[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Backup UUID: %s!", id)
+}
+
+// Lists VM backups.
+func (s *BackupService) ListBackups(p *ListBackupsParams, opts ...CallOption) (*ListBackupsResponse, error) {
+	resp, err := s.cs.newRequest("listBackups", s.cs.encodeParams("listBackups", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBackupsResponse struct {
+	Count   int       `json:"count"`
+	Backups []*Backup `json:"backup"`
+}
+
+type Backup struct {
+	Account            string `json:"account"`
+	Accountid          string `json:"accountid"`
+	Backupofferingid   string `json:"backupofferingid"`
+	Backupofferingname string `json:"backupofferingname"`
+	Created            string `json:"created"`
+	Domain             string `json:"domain"`
+	Domainid           string `json:"domainid"`
+	Externalid         string `json:"externalid"`
+	Id                 string `json:"id"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Size               int64  `json:"size"`
+	Status             string `json:"status"`
+	Type               string `json:"type"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+	Virtualsize        int64  `json:"virtualsize"`
+	Volumes            string `json:"volumes"`
+	Zone               string `json:"zone"`
+	Zoneid             string `json:"zoneid"`
+}
+
+type RemoveVirtualMachineFromBackupOfferingParams struct {
+	forced           optBool
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveVirtualMachineFromBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseRemoveVirtualMachineFromBackupOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveVirtualMachineFromBackupOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveVirtualMachineFromBackupOfferingParams(u url.Values) (*RemoveVirtualMachineFromBackupOfferingParams, error) {
+	p := &RemoveVirtualMachineFromBackupOfferingParams{}
+	if err := checkParamNames("removeVirtualMachineFromBackupOffering", u, "forced", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *RemoveVirtualMachineFromBackupOfferingParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
+func (p *RemoveVirtualMachineFromBackupOfferingParams) ResetForced() {
+	p.forced = optBool{}
+}
+
+// GetForced returns the forced param and if it is set
+func (p *RemoveVirtualMachineFromBackupOfferingParams) GetForced() (bool, bool) {
+	return p.forced.v, p.forced.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *RemoveVirtualMachineFromBackupOfferingParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *RemoveVirtualMachineFromBackupOfferingParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *RemoveVirtualMachineFromBackupOfferingParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveVirtualMachineFromBackupOfferingParams) Clone() *RemoveVirtualMachineFromBackupOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveVirtualMachineFromBackupOfferingParams) Equal(o *RemoveVirtualMachineFromBackupOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedRemoveVirtualMachineFromBackupOfferingParams is used to (un)marshal RemoveVirtualMachineFromBackupOfferingParams using the API param names
+type serializedRemoveVirtualMachineFromBackupOfferingParams struct {
+	Forced           *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) toSerialized() *serializedRemoveVirtualMachineFromBackupOfferingParams {
+	s := &serializedRemoveVirtualMachineFromBackupOfferingParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) fromSerialized(s *serializedRemoveVirtualMachineFromBackupOfferingParams) {
+	*p = RemoveVirtualMachineFromBackupOfferingParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveVirtualMachineFromBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveVirtualMachineFromBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveVirtualMachineFromBackupOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveVirtualMachineFromBackupOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveVirtualMachineFromBackupOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveVirtualMachineFromBackupOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new RemoveVirtualMachineFromBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid string) *RemoveVirtualMachineFromBackupOfferingParams {
+	p := &RemoveVirtualMachineFromBackupOfferingParams{}
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Removes a VM from any existing backup offering.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *BackupService) RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams, opts ...CallOption) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
+	resp, err := s.cs.newRequest("removeVirtualMachineFromBackupOffering", s.cs.encodeParams("removeVirtualMachineFromBackupOffering", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveVirtualMachineFromBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type RemoveVirtualMachineFromBackupOfferingResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type RestoreBackupParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RestoreBackupParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseRestoreBackupParams parses url.Values, for example taken from a raw API request,
+// into a new RestoreBackupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRestoreBackupParams(u url.Values) (*RestoreBackupParams, error) {
+	p := &RestoreBackupParams{}
+	if err := checkParamNames("restoreBackup", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RestoreBackupParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *RestoreBackupParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *RestoreBackupParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RestoreBackupParams) Clone() *RestoreBackupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RestoreBackupParams) Equal(o *RestoreBackupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRestoreBackupParams is used to (un)marshal RestoreBackupParams using the API param names
+type serializedRestoreBackupParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RestoreBackupParams) toSerialized() *serializedRestoreBackupParams {
+	s := &serializedRestoreBackupParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RestoreBackupParams) fromSerialized(s *serializedRestoreBackupParams) {
+	*p = RestoreBackupParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RestoreBackupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RestoreBackupParams) UnmarshalJSON(b []byte) error {
+	var s serializedRestoreBackupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RestoreBackupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RestoreBackupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRestoreBackupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new RestoreBackupParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewRestoreBackupParams(id string) *RestoreBackupParams {
+	p := &RestoreBackupParams{}
+	p.SetId(id)
+	return p
+}
+
+// Restores an existing stopped or deleted VM using a VM backup.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *BackupService) RestoreBackup(p *RestoreBackupParams, opts ...CallOption) (*RestoreBackupResponse, error) {
+	resp, err := s.cs.newRequest("restoreBackup", s.cs.encodeParams("restoreBackup", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type RestoreBackupResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type RestoreVolumeFromBackupAndAttachToVMParams struct {
+	id               optString
+	virtualmachineid optString
+	volumeid         optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
+	}
+	return u
+}
+
+// ParseRestoreVolumeFromBackupAndAttachToVMParams parses url.Values, for example taken from a raw API request,
+// into a new RestoreVolumeFromBackupAndAttachToVMParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRestoreVolumeFromBackupAndAttachToVMParams(u url.Values) (*RestoreVolumeFromBackupAndAttachToVMParams, error) {
+	p := &RestoreVolumeFromBackupAndAttachToVMParams{}
+	if err := checkParamNames("restoreVolumeFromBackupAndAttachToVM", u, "id", "virtualmachineid", "volumeid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// SetVolumeid sets the volumeid param. This param is required.
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) SetVolumeid(v string) {
+	p.volumeid = optString{v: v, ok: true}
+}
+
+// ResetVolumeid unsets the volumeid param
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ResetVolumeid() {
+	p.volumeid = optString{}
+}
+
+// GetVolumeid returns the volumeid param and if it is set
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) GetVolumeid() (string, bool) {
+	return p.volumeid.v, p.volumeid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) Clone() *RestoreVolumeFromBackupAndAttachToVMParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) Equal(o *RestoreVolumeFromBackupAndAttachToVMParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.volumeid == o.volumeid
+}
+
+// serializedRestoreVolumeFromBackupAndAttachToVMParams is used to (un)marshal RestoreVolumeFromBackupAndAttachToVMParams using the API param names
+type serializedRestoreVolumeFromBackupAndAttachToVMParams struct {
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Volumeid         *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) toSerialized() *serializedRestoreVolumeFromBackupAndAttachToVMParams {
+	s := &serializedRestoreVolumeFromBackupAndAttachToVMParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	return s
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) fromSerialized(s *serializedRestoreVolumeFromBackupAndAttachToVMParams) {
+	*p = RestoreVolumeFromBackupAndAttachToVMParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RestoreVolumeFromBackupAndAttachToVMParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) UnmarshalJSON(b []byte) error {
+	var s serializedRestoreVolumeFromBackupAndAttachToVMParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RestoreVolumeFromBackupAndAttachToVMParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRestoreVolumeFromBackupAndAttachToVMParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new RestoreVolumeFromBackupAndAttachToVMParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewRestoreVolumeFromBackupAndAttachToVMParams(id string, virtualmachineid string, volumeid string) *RestoreVolumeFromBackupAndAttachToVMParams {
+	p := &RestoreVolumeFromBackupAndAttachToVMParams{}
+	p.SetId(id)
+	p.SetVirtualmachineid(virtualmachineid)
+	p.SetVolumeid(volumeid)
+	return p
+}
+
+// Restore and attach a backed up volume to VM.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, virtualmachineid,
+// volumeid.
+func (s *BackupService) RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams, opts ...CallOption) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
+	resp, err := s.cs.newRequest("restoreVolumeFromBackupAndAttachToVM", s.cs.encodeParams("restoreVolumeFromBackupAndAttachToVM", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreVolumeFromBackupAndAttachToVMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type RestoreVolumeFromBackupAndAttachToVMResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type UpdateBackupOfferingParams struct {
+	allowuserdrivenbackups optBool
+	description            optString
+	id                     optString
+	name                   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.allowuserdrivenbackups.ok {
+		u.Set("allowuserdrivenbackups", strconv.FormatBool(p.allowuserdrivenbackups.v))
+	}
+	if p.description.ok {
+		u.Set("description", p.description.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	return u
+}
+
+// ParseUpdateBackupOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateBackupOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateBackupOfferingParams(u url.Values) (*UpdateBackupOfferingParams, error) {
+	p := &UpdateBackupOfferingParams{}
+	if err := checkParamNames("updateBackupOffering", u, "allowuserdrivenbackups", "description", "id", "name"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allowuserdrivenbackups"]; found {
+		v, err := strconv.ParseBool(u.Get("allowuserdrivenbackups"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param allowuserdrivenbackups: %v", err)
+		}
+		p.SetAllowuserdrivenbackups(v)
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	return p, nil
+}
+
+// SetAllowuserdrivenbackups sets the allowuserdrivenbackups param.
+func (p *UpdateBackupOfferingParams) SetAllowuserdrivenbackups(v bool) {
+	p.allowuserdrivenbackups = optBool{v: v, ok: true}
+}
+
+// ResetAllowuserdrivenbackups unsets the allowuserdrivenbackups param
+func (p *UpdateBackupOfferingParams) ResetAllowuserdrivenbackups() {
+	p.allowuserdrivenbackups = optBool{}
+}
+
+// GetAllowuserdrivenbackups returns the allowuserdrivenbackups param and if it is set
+func (p *UpdateBackupOfferingParams) GetAllowuserdrivenbackups() (bool, bool) {
+	return p.allowuserdrivenbackups.v, p.allowuserdrivenbackups.ok
+}
+
+// SetDescription sets the description param.
+func (p *UpdateBackupOfferingParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
+func (p *UpdateBackupOfferingParams) ResetDescription() {
+	p.description = optString{}
+}
+
+// GetDescription returns the description param and if it is set
+func (p *UpdateBackupOfferingParams) GetDescription() (string, bool) {
+	return p.description.v, p.description.ok
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpdateBackupOfferingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *UpdateBackupOfferingParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *UpdateBackupOfferingParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetName sets the name param.
+func (p *UpdateBackupOfferingParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *UpdateBackupOfferingParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *UpdateBackupOfferingParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateBackupOfferingParams) Clone() *UpdateBackupOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateBackupOfferingParams) Equal(o *UpdateBackupOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allowuserdrivenbackups == o.allowuserdrivenbackups &&
+		p.description == o.description &&
+		p.id == o.id &&
+		p.name == o.name
+}
+
+// serializedUpdateBackupOfferingParams is used to (un)marshal UpdateBackupOfferingParams using the API param names
+type serializedUpdateBackupOfferingParams struct {
+	Allowuserdrivenbackups *bool   `json:"allowuserdrivenbackups,omitempty" yaml:"allowuserdrivenbackups,omitempty"`
+	Description            *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Id                     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name                   *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (p *UpdateBackupOfferingParams) toSerialized() *serializedUpdateBackupOfferingParams {
+	s := &serializedUpdateBackupOfferingParams{}
+	if p.allowuserdrivenbackups.ok {
+		s.Allowuserdrivenbackups = &p.allowuserdrivenbackups.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	return s
+}
+
+func (p *UpdateBackupOfferingParams) fromSerialized(s *serializedUpdateBackupOfferingParams) {
+	*p = UpdateBackupOfferingParams{}
+	if s.Allowuserdrivenbackups != nil {
+		p.SetAllowuserdrivenbackups(*s.Allowuserdrivenbackups)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateBackupOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateBackupOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateBackupOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateBackupOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new UpdateBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewUpdateBackupOfferingParams(id string) *UpdateBackupOfferingParams {
+	p := &UpdateBackupOfferingParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates a backup offering.
+//
+// Required params: id.
+func (s *BackupService) UpdateBackupOffering(p *UpdateBackupOfferingParams, opts ...CallOption) (*UpdateBackupOfferingResponse, error) {
+	resp, err := s.cs.newRequest("updateBackupOffering", s.cs.encodeParams("updateBackupOffering", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r UpdateBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateBackupOfferingResponse struct {
+	Allowuserdrivenbackups bool   `json:"allowuserdrivenbackups"`
+	Created                string `json:"created"`
+	Description            string `json:"description"`
+	Externalid             string `json:"externalid"`
+	Id                     string `json:"id"`
+	JobID                  string `json:"jobid"`
+	Jobstatus              int    `json:"jobstatus"`
+	Name                   string `json:"name"`
+	Zoneid                 string `json:"zoneid"`
+	Zonename               string `json:"zonename"`
+}
+
+type UpdateBackupScheduleParams struct {
+	intervaltype     optString
+	schedule         optString
+	timezone         optString
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.intervaltype.ok {
+		u.Set("intervaltype", p.intervaltype.v)
+	}
+	if p.schedule.ok {
+		u.Set("schedule", p.schedule.v)
+	}
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseUpdateBackupScheduleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateBackupScheduleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateBackupScheduleParams(u url.Values) (*UpdateBackupScheduleParams, error) {
+	p := &UpdateBackupScheduleParams{}
+	if err := checkParamNames("updateBackupSchedule", u, "intervaltype", "schedule", "timezone", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["intervaltype"]; found {
+		p.SetIntervaltype(u.Get("intervaltype"))
+	}
+	if _, found := u["schedule"]; found {
+		p.SetSchedule(u.Get("schedule"))
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetIntervaltype sets the intervaltype param. This param is required.
+func (p *UpdateBackupScheduleParams) SetIntervaltype(v string) {
+	p.intervaltype = optString{v: v, ok: true}
+}
+
+// ResetIntervaltype unsets the intervaltype param
+func (p *UpdateBackupScheduleParams) ResetIntervaltype() {
+	p.intervaltype = optString{}
+}
+
+// GetIntervaltype returns the intervaltype param and if it is set
+func (p *UpdateBackupScheduleParams) GetIntervaltype() (string, bool) {
+	return p.intervaltype.v, p.intervaltype.ok
+}
+
+// SetSchedule sets the schedule param. This param is required.
+func (p *UpdateBackupScheduleParams) SetSchedule(v string) {
+	p.schedule = optString{v: v, ok: true}
+}
+
+// ResetSchedule unsets the schedule param
+func (p *UpdateBackupScheduleParams) ResetSchedule() {
+	p.schedule = optString{}
+}
+
+// GetSchedule returns the schedule param and if it is set
+func (p *UpdateBackupScheduleParams) GetSchedule() (string, bool) {
+	return p.schedule.v, p.schedule.ok
+}
+
+// SetTimezone sets the timezone param. This param is required.
+func (p *UpdateBackupScheduleParams) SetTimezone(v string) {
+	p.timezone = optString{v: v, ok: true}
+}
+
+// ResetTimezone unsets the timezone param
+func (p *UpdateBackupScheduleParams) ResetTimezone() {
+	p.timezone = optString{}
+}
+
+// GetTimezone returns the timezone param and if it is set
+func (p *UpdateBackupScheduleParams) GetTimezone() (string, bool) {
+	return p.timezone.v, p.timezone.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *UpdateBackupScheduleParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *UpdateBackupScheduleParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *UpdateBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateBackupScheduleParams) Clone() *UpdateBackupScheduleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateBackupScheduleParams) Equal(o *UpdateBackupScheduleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.intervaltype == o.intervaltype &&
+		p.schedule == o.schedule &&
+		p.timezone == o.timezone &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedUpdateBackupScheduleParams is used to (un)marshal UpdateBackupScheduleParams using the API param names
+type serializedUpdateBackupScheduleParams struct {
+	Intervaltype     *string `json:"intervaltype,omitempty" yaml:"intervaltype,omitempty"`
+	Schedule         *string `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	Timezone         *string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *UpdateBackupScheduleParams) toSerialized() *serializedUpdateBackupScheduleParams {
+	s := &serializedUpdateBackupScheduleParams{}
+	if p.intervaltype.ok {
+		s.Intervaltype = &p.intervaltype.v
+	}
+	if p.schedule.ok {
+		s.Schedule = &p.schedule.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *UpdateBackupScheduleParams) fromSerialized(s *serializedUpdateBackupScheduleParams) {
+	*p = UpdateBackupScheduleParams{}
+	if s.Intervaltype != nil {
+		p.SetIntervaltype(*s.Intervaltype)
+	}
+	if s.Schedule != nil {
+		p.SetSchedule(*s.Schedule)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateBackupScheduleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateBackupScheduleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateBackupScheduleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateBackupScheduleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new UpdateBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewUpdateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams {
+	p := &UpdateBackupScheduleParams{}
+	p.SetIntervaltype(intervaltype)
+	p.SetSchedule(schedule)
+	p.SetTimezone(timezone)
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Updates a user-defined VM backup schedule.
+//
+// Required params: intervaltype, schedule, timezone, virtualmachineid.
+func (s *BackupService) UpdateBackupSchedule(p *UpdateBackupScheduleParams, opts ...CallOption) (*UpdateBackupScheduleResponse, error) {
+	resp, err := s.cs.newRequest("updateBackupSchedule", s.cs.encodeParams("updateBackupSchedule", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r UpdateBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateBackupScheduleResponse struct {
+	Intervaltype       string `json:"intervaltype"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Schedule           string `json:"schedule"`
+	Timezone           string `json:"timezone"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+}
@@ -0,0 +1,736 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./cloudstack/BackupService.go
+
+// Package cloudstack is a generated GoMock package.
+package cloudstack
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBackupServiceIface is a mock of BackupServiceIface interface.
+type MockBackupServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupServiceIfaceMockRecorder
+}
+
+// MockBackupServiceIfaceMockRecorder is the mock recorder for MockBackupServiceIface.
+type MockBackupServiceIfaceMockRecorder struct {
+	mock *MockBackupServiceIface
+}
+
+// NewMockBackupServiceIface creates a new mock instance.
+func NewMockBackupServiceIface(ctrl *gomock.Controller) *MockBackupServiceIface {
+	mock := &MockBackupServiceIface{ctrl: ctrl}
+	mock.recorder = &MockBackupServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupServiceIface) EXPECT() *MockBackupServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// AssignVirtualMachineToBackupOffering mocks base method.
+func (m *MockBackupServiceIface) AssignVirtualMachineToBackupOffering(p *AssignVirtualMachineToBackupOfferingParams, opts ...CallOption) (*AssignVirtualMachineToBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignVirtualMachineToBackupOffering", varargs...)
+	ret0, _ := ret[0].(*AssignVirtualMachineToBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignVirtualMachineToBackupOffering indicates an expected call of AssignVirtualMachineToBackupOffering.
+func (mr *MockBackupServiceIfaceMockRecorder) AssignVirtualMachineToBackupOffering(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachineToBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).AssignVirtualMachineToBackupOffering), varargs...)
+}
+
+// CreateBackup mocks base method.
+func (m *MockBackupServiceIface) CreateBackup(p *CreateBackupParams, opts ...CallOption) (*CreateBackupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateBackup", varargs...)
+	ret0, _ := ret[0].(*CreateBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackup indicates an expected call of CreateBackup.
+func (mr *MockBackupServiceIfaceMockRecorder) CreateBackup(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackup", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateBackup), varargs...)
+}
+
+// CreateBackupSchedule mocks base method.
+func (m *MockBackupServiceIface) CreateBackupSchedule(p *CreateBackupScheduleParams, opts ...CallOption) (*CreateBackupScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateBackupSchedule", varargs...)
+	ret0, _ := ret[0].(*CreateBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackupSchedule indicates an expected call of CreateBackupSchedule.
+func (mr *MockBackupServiceIfaceMockRecorder) CreateBackupSchedule(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateBackupSchedule), varargs...)
+}
+
+// DeleteBackup mocks base method.
+func (m *MockBackupServiceIface) DeleteBackup(p *DeleteBackupParams, opts ...CallOption) (*DeleteBackupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBackup", varargs...)
+	ret0, _ := ret[0].(*DeleteBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackup indicates an expected call of DeleteBackup.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackup(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackup", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackup), varargs...)
+}
+
+// DeleteBackupOffering mocks base method.
+func (m *MockBackupServiceIface) DeleteBackupOffering(p *DeleteBackupOfferingParams, opts ...CallOption) (*DeleteBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBackupOffering", varargs...)
+	ret0, _ := ret[0].(*DeleteBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupOffering indicates an expected call of DeleteBackupOffering.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupOffering(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupOffering), varargs...)
+}
+
+// DeleteBackupSchedule mocks base method.
+func (m *MockBackupServiceIface) DeleteBackupSchedule(p *DeleteBackupScheduleParams, opts ...CallOption) (*DeleteBackupScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBackupSchedule", varargs...)
+	ret0, _ := ret[0].(*DeleteBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupSchedule indicates an expected call of DeleteBackupSchedule.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupSchedule(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupSchedule), varargs...)
+}
+
+// GetBackupByID mocks base method.
+func (m *MockBackupServiceIface) GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupByID", varargs...)
+	ret0, _ := ret[0].(*Backup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupByID indicates an expected call of GetBackupByID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupByID), varargs...)
+}
+
+// GetBackupOfferingByID mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingByID", varargs...)
+	ret0, _ := ret[0].(*BackupOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingByID indicates an expected call of GetBackupOfferingByID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByID), varargs...)
+}
+
+// GetBackupOfferingByName mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingByName", varargs...)
+	ret0, _ := ret[0].(*BackupOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingByName indicates an expected call of GetBackupOfferingByName.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByName", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByName), varargs...)
+}
+
+// GetBackupOfferingID mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingID indicates an expected call of GetBackupOfferingID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingID(keyword interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingID), varargs...)
+}
+
+// GetBackupProviderOfferingID mocks base method.
+func (m *MockBackupServiceIface) GetBackupProviderOfferingID(keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{keyword, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupProviderOfferingID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupProviderOfferingID indicates an expected call of GetBackupProviderOfferingID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupProviderOfferingID(keyword, zoneid interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{keyword, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupProviderOfferingID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupProviderOfferingID), varargs...)
+}
+
+// ImportBackupOffering mocks base method.
+func (m *MockBackupServiceIface) ImportBackupOffering(p *ImportBackupOfferingParams, opts ...CallOption) (*ImportBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportBackupOffering", varargs...)
+	ret0, _ := ret[0].(*ImportBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBackupOffering indicates an expected call of ImportBackupOffering.
+func (mr *MockBackupServiceIfaceMockRecorder) ImportBackupOffering(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).ImportBackupOffering), varargs...)
+}
+
+// ListBackupOfferings mocks base method.
+func (m *MockBackupServiceIface) ListBackupOfferings(p *ListBackupOfferingsParams, opts ...CallOption) (*ListBackupOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupOfferings", varargs...)
+	ret0, _ := ret[0].(*ListBackupOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupOfferings indicates an expected call of ListBackupOfferings.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferings(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferings", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferings), varargs...)
+}
+
+// ListBackupProviderOfferings mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams, opts ...CallOption) (*ListBackupProviderOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupProviderOfferings", varargs...)
+	ret0, _ := ret[0].(*ListBackupProviderOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProviderOfferings indicates an expected call of ListBackupProviderOfferings.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferings(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferings", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferings), varargs...)
+}
+
+// ListBackupProviders mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviders(p *ListBackupProvidersParams, opts ...CallOption) (*ListBackupProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupProviders", varargs...)
+	ret0, _ := ret[0].(*ListBackupProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProviders indicates an expected call of ListBackupProviders.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviders(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviders", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviders), varargs...)
+}
+
+// ListBackupSchedule mocks base method.
+func (m *MockBackupServiceIface) ListBackupSchedule(p *ListBackupScheduleParams, opts ...CallOption) (*ListBackupScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupSchedule", varargs...)
+	ret0, _ := ret[0].(*ListBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupSchedule indicates an expected call of ListBackupSchedule.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupSchedule(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupSchedule), varargs...)
+}
+
+// ListBackups mocks base method.
+func (m *MockBackupServiceIface) ListBackups(p *ListBackupsParams, opts ...CallOption) (*ListBackupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackups", varargs...)
+	ret0, _ := ret[0].(*ListBackupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackups indicates an expected call of ListBackups.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackups(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackups", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackups), varargs...)
+}
+
+// NewAssignVirtualMachineToBackupOfferingParams mocks base method.
+func (m *MockBackupServiceIface) NewAssignVirtualMachineToBackupOfferingParams(backupofferingid, virtualmachineid string) *AssignVirtualMachineToBackupOfferingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAssignVirtualMachineToBackupOfferingParams", backupofferingid, virtualmachineid)
+	ret0, _ := ret[0].(*AssignVirtualMachineToBackupOfferingParams)
+	return ret0
+}
+
+// NewAssignVirtualMachineToBackupOfferingParams indicates an expected call of NewAssignVirtualMachineToBackupOfferingParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewAssignVirtualMachineToBackupOfferingParams(backupofferingid, virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAssignVirtualMachineToBackupOfferingParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewAssignVirtualMachineToBackupOfferingParams), backupofferingid, virtualmachineid)
+}
+
+// NewCreateBackupParams mocks base method.
+func (m *MockBackupServiceIface) NewCreateBackupParams(virtualmachineid string) *CreateBackupParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateBackupParams", virtualmachineid)
+	ret0, _ := ret[0].(*CreateBackupParams)
+	return ret0
+}
+
+// NewCreateBackupParams indicates an expected call of NewCreateBackupParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewCreateBackupParams(virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBackupParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewCreateBackupParams), virtualmachineid)
+}
+
+// NewCreateBackupScheduleParams mocks base method.
+func (m *MockBackupServiceIface) NewCreateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid string) *CreateBackupScheduleParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateBackupScheduleParams", intervaltype, schedule, timezone, virtualmachineid)
+	ret0, _ := ret[0].(*CreateBackupScheduleParams)
+	return ret0
+}
+
+// NewCreateBackupScheduleParams indicates an expected call of NewCreateBackupScheduleParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewCreateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBackupScheduleParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewCreateBackupScheduleParams), intervaltype, schedule, timezone, virtualmachineid)
+}
+
+// NewDeleteBackupOfferingParams mocks base method.
+func (m *MockBackupServiceIface) NewDeleteBackupOfferingParams(id string) *DeleteBackupOfferingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteBackupOfferingParams", id)
+	ret0, _ := ret[0].(*DeleteBackupOfferingParams)
+	return ret0
+}
+
+// NewDeleteBackupOfferingParams indicates an expected call of NewDeleteBackupOfferingParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewDeleteBackupOfferingParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteBackupOfferingParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewDeleteBackupOfferingParams), id)
+}
+
+// NewDeleteBackupParams mocks base method.
+func (m *MockBackupServiceIface) NewDeleteBackupParams(id string) *DeleteBackupParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteBackupParams", id)
+	ret0, _ := ret[0].(*DeleteBackupParams)
+	return ret0
+}
+
+// NewDeleteBackupParams indicates an expected call of NewDeleteBackupParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewDeleteBackupParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteBackupParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewDeleteBackupParams), id)
+}
+
+// NewDeleteBackupScheduleParams mocks base method.
+func (m *MockBackupServiceIface) NewDeleteBackupScheduleParams(virtualmachineid string) *DeleteBackupScheduleParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteBackupScheduleParams", virtualmachineid)
+	ret0, _ := ret[0].(*DeleteBackupScheduleParams)
+	return ret0
+}
+
+// NewDeleteBackupScheduleParams indicates an expected call of NewDeleteBackupScheduleParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewDeleteBackupScheduleParams(virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteBackupScheduleParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewDeleteBackupScheduleParams), virtualmachineid)
+}
+
+// NewImportBackupOfferingParams mocks base method.
+func (m *MockBackupServiceIface) NewImportBackupOfferingParams(allowuserdrivenbackups bool, description, externalid, name, zoneid string) *ImportBackupOfferingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewImportBackupOfferingParams", allowuserdrivenbackups, description, externalid, name, zoneid)
+	ret0, _ := ret[0].(*ImportBackupOfferingParams)
+	return ret0
+}
+
+// NewImportBackupOfferingParams indicates an expected call of NewImportBackupOfferingParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewImportBackupOfferingParams(allowuserdrivenbackups, description, externalid, name, zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewImportBackupOfferingParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewImportBackupOfferingParams), allowuserdrivenbackups, description, externalid, name, zoneid)
+}
+
+// NewListBackupOfferingsParams mocks base method.
+func (m *MockBackupServiceIface) NewListBackupOfferingsParams() *ListBackupOfferingsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBackupOfferingsParams")
+	ret0, _ := ret[0].(*ListBackupOfferingsParams)
+	return ret0
+}
+
+// NewListBackupOfferingsParams indicates an expected call of NewListBackupOfferingsParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewListBackupOfferingsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBackupOfferingsParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewListBackupOfferingsParams))
+}
+
+// NewListBackupProviderOfferingsParams mocks base method.
+func (m *MockBackupServiceIface) NewListBackupProviderOfferingsParams(zoneid string) *ListBackupProviderOfferingsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBackupProviderOfferingsParams", zoneid)
+	ret0, _ := ret[0].(*ListBackupProviderOfferingsParams)
+	return ret0
+}
+
+// NewListBackupProviderOfferingsParams indicates an expected call of NewListBackupProviderOfferingsParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewListBackupProviderOfferingsParams(zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBackupProviderOfferingsParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewListBackupProviderOfferingsParams), zoneid)
+}
+
+// NewListBackupProvidersParams mocks base method.
+func (m *MockBackupServiceIface) NewListBackupProvidersParams() *ListBackupProvidersParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBackupProvidersParams")
+	ret0, _ := ret[0].(*ListBackupProvidersParams)
+	return ret0
+}
+
+// NewListBackupProvidersParams indicates an expected call of NewListBackupProvidersParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewListBackupProvidersParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBackupProvidersParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewListBackupProvidersParams))
+}
+
+// NewListBackupScheduleParams mocks base method.
+func (m *MockBackupServiceIface) NewListBackupScheduleParams(virtualmachineid string) *ListBackupScheduleParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBackupScheduleParams", virtualmachineid)
+	ret0, _ := ret[0].(*ListBackupScheduleParams)
+	return ret0
+}
+
+// NewListBackupScheduleParams indicates an expected call of NewListBackupScheduleParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewListBackupScheduleParams(virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBackupScheduleParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewListBackupScheduleParams), virtualmachineid)
+}
+
+// NewListBackupsParams mocks base method.
+func (m *MockBackupServiceIface) NewListBackupsParams() *ListBackupsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBackupsParams")
+	ret0, _ := ret[0].(*ListBackupsParams)
+	return ret0
+}
+
+// NewListBackupsParams indicates an expected call of NewListBackupsParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewListBackupsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBackupsParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewListBackupsParams))
+}
+
+// NewRemoveVirtualMachineFromBackupOfferingParams mocks base method.
+func (m *MockBackupServiceIface) NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid string) *RemoveVirtualMachineFromBackupOfferingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRemoveVirtualMachineFromBackupOfferingParams", virtualmachineid)
+	ret0, _ := ret[0].(*RemoveVirtualMachineFromBackupOfferingParams)
+	return ret0
+}
+
+// NewRemoveVirtualMachineFromBackupOfferingParams indicates an expected call of NewRemoveVirtualMachineFromBackupOfferingParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRemoveVirtualMachineFromBackupOfferingParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewRemoveVirtualMachineFromBackupOfferingParams), virtualmachineid)
+}
+
+// NewRestoreBackupParams mocks base method.
+func (m *MockBackupServiceIface) NewRestoreBackupParams(id string) *RestoreBackupParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRestoreBackupParams", id)
+	ret0, _ := ret[0].(*RestoreBackupParams)
+	return ret0
+}
+
+// NewRestoreBackupParams indicates an expected call of NewRestoreBackupParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewRestoreBackupParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRestoreBackupParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewRestoreBackupParams), id)
+}
+
+// NewRestoreVolumeFromBackupAndAttachToVMParams mocks base method.
+func (m *MockBackupServiceIface) NewRestoreVolumeFromBackupAndAttachToVMParams(id, virtualmachineid, volumeid string) *RestoreVolumeFromBackupAndAttachToVMParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRestoreVolumeFromBackupAndAttachToVMParams", id, virtualmachineid, volumeid)
+	ret0, _ := ret[0].(*RestoreVolumeFromBackupAndAttachToVMParams)
+	return ret0
+}
+
+// NewRestoreVolumeFromBackupAndAttachToVMParams indicates an expected call of NewRestoreVolumeFromBackupAndAttachToVMParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewRestoreVolumeFromBackupAndAttachToVMParams(id, virtualmachineid, volumeid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRestoreVolumeFromBackupAndAttachToVMParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewRestoreVolumeFromBackupAndAttachToVMParams), id, virtualmachineid, volumeid)
+}
+
+// NewUpdateBackupOfferingParams mocks base method.
+func (m *MockBackupServiceIface) NewUpdateBackupOfferingParams(id string) *UpdateBackupOfferingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateBackupOfferingParams", id)
+	ret0, _ := ret[0].(*UpdateBackupOfferingParams)
+	return ret0
+}
+
+// NewUpdateBackupOfferingParams indicates an expected call of NewUpdateBackupOfferingParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewUpdateBackupOfferingParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateBackupOfferingParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewUpdateBackupOfferingParams), id)
+}
+
+// NewUpdateBackupScheduleParams mocks base method.
+func (m *MockBackupServiceIface) NewUpdateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid string) *UpdateBackupScheduleParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateBackupScheduleParams", intervaltype, schedule, timezone, virtualmachineid)
+	ret0, _ := ret[0].(*UpdateBackupScheduleParams)
+	return ret0
+}
+
+// NewUpdateBackupScheduleParams indicates an expected call of NewUpdateBackupScheduleParams.
+func (mr *MockBackupServiceIfaceMockRecorder) NewUpdateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateBackupScheduleParams", reflect.TypeOf((*MockBackupServiceIface)(nil).NewUpdateBackupScheduleParams), intervaltype, schedule, timezone, virtualmachineid)
+}
+
+// RemoveVirtualMachineFromBackupOffering mocks base method.
+func (m *MockBackupServiceIface) RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams, opts ...CallOption) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveVirtualMachineFromBackupOffering", varargs...)
+	ret0, _ := ret[0].(*RemoveVirtualMachineFromBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVirtualMachineFromBackupOffering indicates an expected call of RemoveVirtualMachineFromBackupOffering.
+func (mr *MockBackupServiceIfaceMockRecorder) RemoveVirtualMachineFromBackupOffering(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVirtualMachineFromBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).RemoveVirtualMachineFromBackupOffering), varargs...)
+}
+
+// RestoreBackup mocks base method.
+func (m *MockBackupServiceIface) RestoreBackup(p *RestoreBackupParams, opts ...CallOption) (*RestoreBackupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreBackup", varargs...)
+	ret0, _ := ret[0].(*RestoreBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBackup indicates an expected call of RestoreBackup.
+func (mr *MockBackupServiceIfaceMockRecorder) RestoreBackup(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackup", reflect.TypeOf((*MockBackupServiceIface)(nil).RestoreBackup), varargs...)
+}
+
+// RestoreVolumeFromBackupAndAttachToVM mocks base method.
+func (m *MockBackupServiceIface) RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams, opts ...CallOption) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreVolumeFromBackupAndAttachToVM", varargs...)
+	ret0, _ := ret[0].(*RestoreVolumeFromBackupAndAttachToVMResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVolumeFromBackupAndAttachToVM indicates an expected call of RestoreVolumeFromBackupAndAttachToVM.
+func (mr *MockBackupServiceIfaceMockRecorder) RestoreVolumeFromBackupAndAttachToVM(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVolumeFromBackupAndAttachToVM", reflect.TypeOf((*MockBackupServiceIface)(nil).RestoreVolumeFromBackupAndAttachToVM), varargs...)
+}
+
+// UpdateBackupOffering mocks base method.
+func (m *MockBackupServiceIface) UpdateBackupOffering(p *UpdateBackupOfferingParams, opts ...CallOption) (*UpdateBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBackupOffering", varargs...)
+	ret0, _ := ret[0].(*UpdateBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBackupOffering indicates an expected call of UpdateBackupOffering.
+func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupOffering(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupOffering), varargs...)
+}
+
+// UpdateBackupSchedule mocks base method.
+func (m *MockBackupServiceIface) UpdateBackupSchedule(p *UpdateBackupScheduleParams, opts ...CallOption) (*UpdateBackupScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBackupSchedule", varargs...)
+	ret0, _ := ret[0].(*UpdateBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBackupSchedule indicates an expected call of UpdateBackupSchedule.
+func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupSchedule(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupSchedule), varargs...)
+}
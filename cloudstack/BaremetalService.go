@@ -21,179 +21,285 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type BaremetalServiceIface interface {
-	AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcp(p *AddBaremetalDhcpParams, opts ...CallOption) (*AddBaremetalDhcpResponse, error)
 	NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams
-	AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams, opts ...CallOption) (*AddBaremetalPxeKickStartServerResponse, error)
 	NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams
-	AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams, opts ...CallOption) (*AddBaremetalPxePingServerResponse, error)
 	NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams
-	AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error)
+	AddBaremetalRct(p *AddBaremetalRctParams, opts ...CallOption) (*AddBaremetalRctResponse, error)
 	NewAddBaremetalRctParams(baremetalrcturl string) *AddBaremetalRctParams
-	DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRct(p *DeleteBaremetalRctParams, opts ...CallOption) (*DeleteBaremetalRctResponse, error)
 	NewDeleteBaremetalRctParams(id string) *DeleteBaremetalRctParams
-	ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcp(p *ListBaremetalDhcpParams, opts ...CallOption) (*ListBaremetalDhcpResponse, error)
 	NewListBaremetalDhcpParams(physicalnetworkid string) *ListBaremetalDhcpParams
-	ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServers(p *ListBaremetalPxeServersParams, opts ...CallOption) (*ListBaremetalPxeServersResponse, error)
 	NewListBaremetalPxeServersParams(physicalnetworkid string) *ListBaremetalPxeServersParams
-	ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	ListBaremetalRct(p *ListBaremetalRctParams, opts ...CallOption) (*ListBaremetalRctResponse, error)
 	NewListBaremetalRctParams() *ListBaremetalRctParams
-	NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams, opts ...CallOption) (*NotifyBaremetalProvisionDoneResponse, error)
 	NewNotifyBaremetalProvisionDoneParams(mac string) *NotifyBaremetalProvisionDoneParams
 }
 
 type AddBaremetalDhcpParams struct {
-	p map[string]interface{}
+	dhcpservertype    optString
+	password          optString
+	physicalnetworkid optString
+	url               optString
+	username          optString
 }
 
-func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddBaremetalDhcpParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["dhcpservertype"]; found {
-		u.Set("dhcpservertype", v.(string))
+	if p.dhcpservertype.ok {
+		u.Set("dhcpservertype", p.dhcpservertype.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddBaremetalDhcpParams parses url.Values, for example taken from a raw API request,
+// into a new AddBaremetalDhcpParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddBaremetalDhcpParams(u url.Values) (*AddBaremetalDhcpParams, error) {
+	p := &AddBaremetalDhcpParams{}
+	if err := checkParamNames("addBaremetalDhcp", u, "dhcpservertype", "password", "physicalnetworkid", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["dhcpservertype"]; found {
+		p.SetDhcpservertype(u.Get("dhcpservertype"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
 	}
-	p.p["dhcpservertype"] = v
+	return p, nil
 }
 
+// SetDhcpservertype sets the dhcpservertype param. This param is required.
+func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
+	p.dhcpservertype = optString{v: v, ok: true}
+}
+
+// ResetDhcpservertype unsets the dhcpservertype param
 func (p *AddBaremetalDhcpParams) ResetDhcpservertype() {
-	if p.p != nil && p.p["dhcpservertype"] != nil {
-		delete(p.p, "dhcpservertype")
-	}
+	p.dhcpservertype = optString{}
 }
 
+// GetDhcpservertype returns the dhcpservertype param and if it is set
 func (p *AddBaremetalDhcpParams) GetDhcpservertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dhcpservertype"].(string)
-	return value, ok
+	return p.dhcpservertype.v, p.dhcpservertype.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddBaremetalDhcpParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddBaremetalDhcpParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddBaremetalDhcpParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddBaremetalDhcpParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddBaremetalDhcpParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddBaremetalDhcpParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddBaremetalDhcpParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddBaremetalDhcpParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddBaremetalDhcpParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddBaremetalDhcpParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddBaremetalDhcpParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddBaremetalDhcpParams) Clone() *AddBaremetalDhcpParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddBaremetalDhcpParams) Equal(o *AddBaremetalDhcpParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.dhcpservertype == o.dhcpservertype &&
+		p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddBaremetalDhcpParams is used to (un)marshal AddBaremetalDhcpParams using the API param names
+type serializedAddBaremetalDhcpParams struct {
+	Dhcpservertype    *string `json:"dhcpservertype,omitempty" yaml:"dhcpservertype,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddBaremetalDhcpParams) toSerialized() *serializedAddBaremetalDhcpParams {
+	s := &serializedAddBaremetalDhcpParams{}
+	if p.dhcpservertype.ok {
+		s.Dhcpservertype = &p.dhcpservertype.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddBaremetalDhcpParams) fromSerialized(s *serializedAddBaremetalDhcpParams) {
+	*p = AddBaremetalDhcpParams{}
+	if s.Dhcpservertype != nil {
+		p.SetDhcpservertype(*s.Dhcpservertype)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddBaremetalDhcpParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddBaremetalDhcpParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddBaremetalDhcpParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddBaremetalDhcpParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddBaremetalDhcpParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddBaremetalDhcpParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddBaremetalDhcpParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams {
 	p := &AddBaremetalDhcpParams{}
-	p.p = make(map[string]interface{})
-	p.p["dhcpservertype"] = dhcpservertype
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
+	p.SetDhcpservertype(dhcpservertype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// adds a baremetal dhcp server
-func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalDhcp", p.toURLValues())
+// adds a baremetal dhcp server.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: dhcpservertype,
+// password, physicalnetworkid, url, username.
+func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams, opts ...CallOption) (*AddBaremetalDhcpResponse, error) {
+	resp, err := s.cs.newRequest("addBaremetalDhcp", s.cs.encodeParams("addBaremetalDhcp", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -204,8 +310,8 @@ func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBare
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -237,202 +343,319 @@ type AddBaremetalDhcpResponse struct {
 }
 
 type AddBaremetalPxeKickStartServerParams struct {
-	p map[string]interface{}
+	password          optString
+	physicalnetworkid optString
+	podid             optString
+	pxeservertype     optString
+	tftpdir           optString
+	url               optString
+	username          optString
 }
 
-func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddBaremetalPxeKickStartServerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["pxeservertype"]; found {
-		u.Set("pxeservertype", v.(string))
+	if p.pxeservertype.ok {
+		u.Set("pxeservertype", p.pxeservertype.v)
 	}
-	if v, found := p.p["tftpdir"]; found {
-		u.Set("tftpdir", v.(string))
+	if p.tftpdir.ok {
+		u.Set("tftpdir", p.tftpdir.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddBaremetalPxeKickStartServerParams parses url.Values, for example taken from a raw API request,
+// into a new AddBaremetalPxeKickStartServerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddBaremetalPxeKickStartServerParams(u url.Values) (*AddBaremetalPxeKickStartServerParams, error) {
+	p := &AddBaremetalPxeKickStartServerParams{}
+	if err := checkParamNames("addBaremetalPxeKickStartServer", u, "password", "physicalnetworkid", "podid", "pxeservertype", "tftpdir", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["password"] = v
+	if _, found := u["pxeservertype"]; found {
+		p.SetPxeservertype(u.Get("pxeservertype"))
+	}
+	if _, found := u["tftpdir"]; found {
+		p.SetTftpdir(u.Get("tftpdir"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
 }
 
+// SetPassword sets the password param. This param is required.
+func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
+	p.password = optString{v: v, ok: true}
+}
+
+// ResetPassword unsets the password param
 func (p *AddBaremetalPxeKickStartServerParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddBaremetalPxeKickStartServerParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddBaremetalPxeKickStartServerParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddBaremetalPxeKickStartServerParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetPodid sets the podid param.
 func (p *AddBaremetalPxeKickStartServerParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *AddBaremetalPxeKickStartServerParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *AddBaremetalPxeKickStartServerParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetPxeservertype sets the pxeservertype param. This param is required.
 func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pxeservertype"] = v
+	p.pxeservertype = optString{v: v, ok: true}
 }
 
+// ResetPxeservertype unsets the pxeservertype param
 func (p *AddBaremetalPxeKickStartServerParams) ResetPxeservertype() {
-	if p.p != nil && p.p["pxeservertype"] != nil {
-		delete(p.p, "pxeservertype")
-	}
+	p.pxeservertype = optString{}
 }
 
+// GetPxeservertype returns the pxeservertype param and if it is set
 func (p *AddBaremetalPxeKickStartServerParams) GetPxeservertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pxeservertype"].(string)
-	return value, ok
+	return p.pxeservertype.v, p.pxeservertype.ok
 }
 
+// SetTftpdir sets the tftpdir param. This param is required.
 func (p *AddBaremetalPxeKickStartServerParams) SetTftpdir(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tftpdir"] = v
+	p.tftpdir = optString{v: v, ok: true}
 }
 
+// ResetTftpdir unsets the tftpdir param
 func (p *AddBaremetalPxeKickStartServerParams) ResetTftpdir() {
-	if p.p != nil && p.p["tftpdir"] != nil {
-		delete(p.p, "tftpdir")
-	}
+	p.tftpdir = optString{}
 }
 
+// GetTftpdir returns the tftpdir param and if it is set
 func (p *AddBaremetalPxeKickStartServerParams) GetTftpdir() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tftpdir"].(string)
-	return value, ok
+	return p.tftpdir.v, p.tftpdir.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddBaremetalPxeKickStartServerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddBaremetalPxeKickStartServerParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddBaremetalPxeKickStartServerParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddBaremetalPxeKickStartServerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddBaremetalPxeKickStartServerParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddBaremetalPxeKickStartServerParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddBaremetalPxeKickStartServerParams) Clone() *AddBaremetalPxeKickStartServerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddBaremetalPxeKickStartServerParams) Equal(o *AddBaremetalPxeKickStartServerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.podid == o.podid &&
+		p.pxeservertype == o.pxeservertype &&
+		p.tftpdir == o.tftpdir &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddBaremetalPxeKickStartServerParams is used to (un)marshal AddBaremetalPxeKickStartServerParams using the API param names
+type serializedAddBaremetalPxeKickStartServerParams struct {
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Pxeservertype     *string `json:"pxeservertype,omitempty" yaml:"pxeservertype,omitempty"`
+	Tftpdir           *string `json:"tftpdir,omitempty" yaml:"tftpdir,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) toSerialized() *serializedAddBaremetalPxeKickStartServerParams {
+	s := &serializedAddBaremetalPxeKickStartServerParams{}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.pxeservertype.ok {
+		s.Pxeservertype = &p.pxeservertype.v
+	}
+	if p.tftpdir.ok {
+		s.Tftpdir = &p.tftpdir.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) fromSerialized(s *serializedAddBaremetalPxeKickStartServerParams) {
+	*p = AddBaremetalPxeKickStartServerParams{}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Pxeservertype != nil {
+		p.SetPxeservertype(*s.Pxeservertype)
+	}
+	if s.Tftpdir != nil {
+		p.SetTftpdir(*s.Tftpdir)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddBaremetalPxeKickStartServerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddBaremetalPxeKickStartServerParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddBaremetalPxeKickStartServerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddBaremetalPxeKickStartServerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddBaremetalPxeKickStartServerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddBaremetalPxeKickStartServerParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddBaremetalPxeKickStartServerParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams {
 	p := &AddBaremetalPxeKickStartServerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["pxeservertype"] = pxeservertype
-	p.p["tftpdir"] = tftpdir
-	p.p["url"] = url
-	p.p["username"] = username
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetPxeservertype(pxeservertype)
+	p.SetTftpdir(tftpdir)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// add a baremetal pxe server
-func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxeKickStartServer", p.toURLValues())
+// add a baremetal pxe server.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: password,
+// physicalnetworkid, pxeservertype, tftpdir, url, username.
+func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams, opts ...CallOption) (*AddBaremetalPxeKickStartServerResponse, error) {
+	resp, err := s.cs.newRequest("addBaremetalPxeKickStartServer", s.cs.encodeParams("addBaremetalPxeKickStartServer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -443,8 +666,8 @@ func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKick
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -476,300 +699,441 @@ type AddBaremetalPxeKickStartServerResponse struct {
 }
 
 type AddBaremetalPxePingServerParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
+	password            optString
+	physicalnetworkid   optString
+	pingcifspassword    optString
+	pingcifsusername    optString
+	pingdir             optString
+	pingstorageserverip optString
+	podid               optString
+	pxeservertype       optString
+	tftpdir             optString
+	url                 optString
+	username            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddBaremetalPxePingServerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["pingcifspassword"]; found {
-		u.Set("pingcifspassword", v.(string))
+	if p.pingcifspassword.ok {
+		u.Set("pingcifspassword", p.pingcifspassword.v)
 	}
-	if v, found := p.p["pingcifsusername"]; found {
-		u.Set("pingcifsusername", v.(string))
+	if p.pingcifsusername.ok {
+		u.Set("pingcifsusername", p.pingcifsusername.v)
 	}
-	if v, found := p.p["pingdir"]; found {
-		u.Set("pingdir", v.(string))
+	if p.pingdir.ok {
+		u.Set("pingdir", p.pingdir.v)
 	}
-	if v, found := p.p["pingstorageserverip"]; found {
-		u.Set("pingstorageserverip", v.(string))
+	if p.pingstorageserverip.ok {
+		u.Set("pingstorageserverip", p.pingstorageserverip.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["pxeservertype"]; found {
-		u.Set("pxeservertype", v.(string))
+	if p.pxeservertype.ok {
+		u.Set("pxeservertype", p.pxeservertype.v)
 	}
-	if v, found := p.p["tftpdir"]; found {
-		u.Set("tftpdir", v.(string))
+	if p.tftpdir.ok {
+		u.Set("tftpdir", p.tftpdir.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddBaremetalPxePingServerParams parses url.Values, for example taken from a raw API request,
+// into a new AddBaremetalPxePingServerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddBaremetalPxePingServerParams(u url.Values) (*AddBaremetalPxePingServerParams, error) {
+	p := &AddBaremetalPxePingServerParams{}
+	if err := checkParamNames("addBaremetalPxePingServer", u, "password", "physicalnetworkid", "pingcifspassword", "pingcifsusername", "pingdir", "pingstorageserverip", "podid", "pxeservertype", "tftpdir", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["pingcifspassword"]; found {
+		p.SetPingcifspassword(u.Get("pingcifspassword"))
+	}
+	if _, found := u["pingcifsusername"]; found {
+		p.SetPingcifsusername(u.Get("pingcifsusername"))
+	}
+	if _, found := u["pingdir"]; found {
+		p.SetPingdir(u.Get("pingdir"))
+	}
+	if _, found := u["pingstorageserverip"]; found {
+		p.SetPingstorageserverip(u.Get("pingstorageserverip"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["pxeservertype"]; found {
+		p.SetPxeservertype(u.Get("pxeservertype"))
+	}
+	if _, found := u["tftpdir"]; found {
+		p.SetTftpdir(u.Get("tftpdir"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
 	}
-	p.p["password"] = v
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetPassword sets the password param. This param is required.
+func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddBaremetalPxePingServerParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddBaremetalPxePingServerParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetPingcifspassword sets the pingcifspassword param.
 func (p *AddBaremetalPxePingServerParams) SetPingcifspassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingcifspassword"] = v
+	p.pingcifspassword = optString{v: v, ok: true}
 }
 
+// ResetPingcifspassword unsets the pingcifspassword param
 func (p *AddBaremetalPxePingServerParams) ResetPingcifspassword() {
-	if p.p != nil && p.p["pingcifspassword"] != nil {
-		delete(p.p, "pingcifspassword")
-	}
+	p.pingcifspassword = optString{}
 }
 
+// GetPingcifspassword returns the pingcifspassword param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPingcifspassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pingcifspassword"].(string)
-	return value, ok
+	return p.pingcifspassword.v, p.pingcifspassword.ok
 }
 
+// SetPingcifsusername sets the pingcifsusername param.
 func (p *AddBaremetalPxePingServerParams) SetPingcifsusername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingcifsusername"] = v
+	p.pingcifsusername = optString{v: v, ok: true}
 }
 
+// ResetPingcifsusername unsets the pingcifsusername param
 func (p *AddBaremetalPxePingServerParams) ResetPingcifsusername() {
-	if p.p != nil && p.p["pingcifsusername"] != nil {
-		delete(p.p, "pingcifsusername")
-	}
+	p.pingcifsusername = optString{}
 }
 
+// GetPingcifsusername returns the pingcifsusername param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPingcifsusername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pingcifsusername"].(string)
-	return value, ok
+	return p.pingcifsusername.v, p.pingcifsusername.ok
 }
 
+// SetPingdir sets the pingdir param. This param is required.
 func (p *AddBaremetalPxePingServerParams) SetPingdir(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingdir"] = v
+	p.pingdir = optString{v: v, ok: true}
 }
 
+// ResetPingdir unsets the pingdir param
 func (p *AddBaremetalPxePingServerParams) ResetPingdir() {
-	if p.p != nil && p.p["pingdir"] != nil {
-		delete(p.p, "pingdir")
-	}
+	p.pingdir = optString{}
 }
 
+// GetPingdir returns the pingdir param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPingdir() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pingdir"].(string)
-	return value, ok
+	return p.pingdir.v, p.pingdir.ok
 }
 
+// SetPingstorageserverip sets the pingstorageserverip param. This param is required.
 func (p *AddBaremetalPxePingServerParams) SetPingstorageserverip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingstorageserverip"] = v
+	p.pingstorageserverip = optString{v: v, ok: true}
 }
 
+// ResetPingstorageserverip unsets the pingstorageserverip param
 func (p *AddBaremetalPxePingServerParams) ResetPingstorageserverip() {
-	if p.p != nil && p.p["pingstorageserverip"] != nil {
-		delete(p.p, "pingstorageserverip")
-	}
+	p.pingstorageserverip = optString{}
 }
 
+// GetPingstorageserverip returns the pingstorageserverip param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPingstorageserverip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pingstorageserverip"].(string)
-	return value, ok
+	return p.pingstorageserverip.v, p.pingstorageserverip.ok
 }
 
+// SetPodid sets the podid param.
 func (p *AddBaremetalPxePingServerParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *AddBaremetalPxePingServerParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetPxeservertype sets the pxeservertype param. This param is required.
 func (p *AddBaremetalPxePingServerParams) SetPxeservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pxeservertype"] = v
+	p.pxeservertype = optString{v: v, ok: true}
 }
 
+// ResetPxeservertype unsets the pxeservertype param
 func (p *AddBaremetalPxePingServerParams) ResetPxeservertype() {
-	if p.p != nil && p.p["pxeservertype"] != nil {
-		delete(p.p, "pxeservertype")
-	}
+	p.pxeservertype = optString{}
 }
 
+// GetPxeservertype returns the pxeservertype param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetPxeservertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pxeservertype"].(string)
-	return value, ok
+	return p.pxeservertype.v, p.pxeservertype.ok
 }
 
+// SetTftpdir sets the tftpdir param. This param is required.
 func (p *AddBaremetalPxePingServerParams) SetTftpdir(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tftpdir"] = v
+	p.tftpdir = optString{v: v, ok: true}
 }
 
+// ResetTftpdir unsets the tftpdir param
 func (p *AddBaremetalPxePingServerParams) ResetTftpdir() {
-	if p.p != nil && p.p["tftpdir"] != nil {
-		delete(p.p, "tftpdir")
-	}
+	p.tftpdir = optString{}
 }
 
+// GetTftpdir returns the tftpdir param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetTftpdir() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tftpdir"].(string)
-	return value, ok
+	return p.tftpdir.v, p.tftpdir.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddBaremetalPxePingServerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddBaremetalPxePingServerParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddBaremetalPxePingServerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddBaremetalPxePingServerParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddBaremetalPxePingServerParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddBaremetalPxePingServerParams) Clone() *AddBaremetalPxePingServerParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddBaremetalPxePingServerParams) Equal(o *AddBaremetalPxePingServerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.pingcifspassword == o.pingcifspassword &&
+		p.pingcifsusername == o.pingcifsusername &&
+		p.pingdir == o.pingdir &&
+		p.pingstorageserverip == o.pingstorageserverip &&
+		p.podid == o.podid &&
+		p.pxeservertype == o.pxeservertype &&
+		p.tftpdir == o.tftpdir &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddBaremetalPxePingServerParams is used to (un)marshal AddBaremetalPxePingServerParams using the API param names
+type serializedAddBaremetalPxePingServerParams struct {
+	Password            *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid   *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Pingcifspassword    *string `json:"pingcifspassword,omitempty" yaml:"pingcifspassword,omitempty"`
+	Pingcifsusername    *string `json:"pingcifsusername,omitempty" yaml:"pingcifsusername,omitempty"`
+	Pingdir             *string `json:"pingdir,omitempty" yaml:"pingdir,omitempty"`
+	Pingstorageserverip *string `json:"pingstorageserverip,omitempty" yaml:"pingstorageserverip,omitempty"`
+	Podid               *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Pxeservertype       *string `json:"pxeservertype,omitempty" yaml:"pxeservertype,omitempty"`
+	Tftpdir             *string `json:"tftpdir,omitempty" yaml:"tftpdir,omitempty"`
+	Url                 *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username            *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddBaremetalPxePingServerParams) toSerialized() *serializedAddBaremetalPxePingServerParams {
+	s := &serializedAddBaremetalPxePingServerParams{}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.pingcifspassword.ok {
+		s.Pingcifspassword = &p.pingcifspassword.v
+	}
+	if p.pingcifsusername.ok {
+		s.Pingcifsusername = &p.pingcifsusername.v
+	}
+	if p.pingdir.ok {
+		s.Pingdir = &p.pingdir.v
+	}
+	if p.pingstorageserverip.ok {
+		s.Pingstorageserverip = &p.pingstorageserverip.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.pxeservertype.ok {
+		s.Pxeservertype = &p.pxeservertype.v
+	}
+	if p.tftpdir.ok {
+		s.Tftpdir = &p.tftpdir.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddBaremetalPxePingServerParams) fromSerialized(s *serializedAddBaremetalPxePingServerParams) {
+	*p = AddBaremetalPxePingServerParams{}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Pingcifspassword != nil {
+		p.SetPingcifspassword(*s.Pingcifspassword)
+	}
+	if s.Pingcifsusername != nil {
+		p.SetPingcifsusername(*s.Pingcifsusername)
+	}
+	if s.Pingdir != nil {
+		p.SetPingdir(*s.Pingdir)
+	}
+	if s.Pingstorageserverip != nil {
+		p.SetPingstorageserverip(*s.Pingstorageserverip)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Pxeservertype != nil {
+		p.SetPxeservertype(*s.Pxeservertype)
+	}
+	if s.Tftpdir != nil {
+		p.SetTftpdir(*s.Tftpdir)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddBaremetalPxePingServerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddBaremetalPxePingServerParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddBaremetalPxePingServerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddBaremetalPxePingServerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddBaremetalPxePingServerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddBaremetalPxePingServerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddBaremetalPxePingServerParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams {
 	p := &AddBaremetalPxePingServerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["pingdir"] = pingdir
-	p.p["pingstorageserverip"] = pingstorageserverip
-	p.p["pxeservertype"] = pxeservertype
-	p.p["tftpdir"] = tftpdir
-	p.p["url"] = url
-	p.p["username"] = username
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetPingdir(pingdir)
+	p.SetPingstorageserverip(pingstorageserverip)
+	p.SetPxeservertype(pxeservertype)
+	p.SetTftpdir(tftpdir)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// add a baremetal ping pxe server
-func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxePingServer", p.toURLValues())
+// add a baremetal ping pxe server.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: password,
+// physicalnetworkid, pingdir, pingstorageserverip, pxeservertype, tftpdir, url, username.
+func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams, opts ...CallOption) (*AddBaremetalPxePingServerResponse, error) {
+	resp, err := s.cs.newRequest("addBaremetalPxePingServer", s.cs.encodeParams("addBaremetalPxePingServer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -780,8 +1144,8 @@ func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -815,53 +1179,133 @@ type AddBaremetalPxePingServerResponse struct {
 }
 
 type AddBaremetalRctParams struct {
-	p map[string]interface{}
+	baremetalrcturl optString
 }
 
-func (p *AddBaremetalRctParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddBaremetalRctParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["baremetalrcturl"]; found {
-		u.Set("baremetalrcturl", v.(string))
+	if p.baremetalrcturl.ok {
+		u.Set("baremetalrcturl", p.baremetalrcturl.v)
 	}
 	return u
 }
 
-func (p *AddBaremetalRctParams) SetBaremetalrcturl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddBaremetalRctParams parses url.Values, for example taken from a raw API request,
+// into a new AddBaremetalRctParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddBaremetalRctParams(u url.Values) (*AddBaremetalRctParams, error) {
+	p := &AddBaremetalRctParams{}
+	if err := checkParamNames("addBaremetalRct", u, "baremetalrcturl"); err != nil {
+		return nil, err
+	}
+	if _, found := u["baremetalrcturl"]; found {
+		p.SetBaremetalrcturl(u.Get("baremetalrcturl"))
 	}
-	p.p["baremetalrcturl"] = v
+	return p, nil
 }
 
+// SetBaremetalrcturl sets the baremetalrcturl param. This param is required.
+func (p *AddBaremetalRctParams) SetBaremetalrcturl(v string) {
+	p.baremetalrcturl = optString{v: v, ok: true}
+}
+
+// ResetBaremetalrcturl unsets the baremetalrcturl param
 func (p *AddBaremetalRctParams) ResetBaremetalrcturl() {
-	if p.p != nil && p.p["baremetalrcturl"] != nil {
-		delete(p.p, "baremetalrcturl")
-	}
+	p.baremetalrcturl = optString{}
 }
 
+// GetBaremetalrcturl returns the baremetalrcturl param and if it is set
 func (p *AddBaremetalRctParams) GetBaremetalrcturl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.baremetalrcturl.v, p.baremetalrcturl.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddBaremetalRctParams) Clone() *AddBaremetalRctParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddBaremetalRctParams) Equal(o *AddBaremetalRctParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["baremetalrcturl"].(string)
-	return value, ok
+	return p.baremetalrcturl == o.baremetalrcturl
+}
+
+// serializedAddBaremetalRctParams is used to (un)marshal AddBaremetalRctParams using the API param names
+type serializedAddBaremetalRctParams struct {
+	Baremetalrcturl *string `json:"baremetalrcturl,omitempty" yaml:"baremetalrcturl,omitempty"`
+}
+
+func (p *AddBaremetalRctParams) toSerialized() *serializedAddBaremetalRctParams {
+	s := &serializedAddBaremetalRctParams{}
+	if p.baremetalrcturl.ok {
+		s.Baremetalrcturl = &p.baremetalrcturl.v
+	}
+	return s
+}
+
+func (p *AddBaremetalRctParams) fromSerialized(s *serializedAddBaremetalRctParams) {
+	*p = AddBaremetalRctParams{}
+	if s.Baremetalrcturl != nil {
+		p.SetBaremetalrcturl(*s.Baremetalrcturl)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddBaremetalRctParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddBaremetalRctParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddBaremetalRctParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddBaremetalRctParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddBaremetalRctParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalRctParams(baremetalrcturl string) *AddBaremetalRctParams {
 	p := &AddBaremetalRctParams{}
-	p.p = make(map[string]interface{})
-	p.p["baremetalrcturl"] = baremetalrcturl
+	p.SetBaremetalrcturl(baremetalrcturl)
 	return p
 }
 
-// adds baremetal rack configuration text
-func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalRct", p.toURLValues())
+// adds baremetal rack configuration text.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: baremetalrcturl.
+func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams, opts ...CallOption) (*AddBaremetalRctResponse, error) {
+	resp, err := s.cs.newRequest("addBaremetalRct", s.cs.encodeParams("addBaremetalRct", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -872,8 +1316,8 @@ func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBareme
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -902,53 +1346,133 @@ type AddBaremetalRctResponse struct {
 }
 
 type DeleteBaremetalRctParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteBaremetalRctParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteBaremetalRctParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteBaremetalRctParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteBaremetalRctParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteBaremetalRctParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteBaremetalRctParams(u url.Values) (*DeleteBaremetalRctParams, error) {
+	p := &DeleteBaremetalRctParams{}
+	if err := checkParamNames("deleteBaremetalRct", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteBaremetalRctParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteBaremetalRctParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteBaremetalRctParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteBaremetalRctParams) Clone() *DeleteBaremetalRctParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteBaremetalRctParams) Equal(o *DeleteBaremetalRctParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteBaremetalRctParams is used to (un)marshal DeleteBaremetalRctParams using the API param names
+type serializedDeleteBaremetalRctParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteBaremetalRctParams) toSerialized() *serializedDeleteBaremetalRctParams {
+	s := &serializedDeleteBaremetalRctParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteBaremetalRctParams) fromSerialized(s *serializedDeleteBaremetalRctParams) {
+	*p = DeleteBaremetalRctParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteBaremetalRctParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteBaremetalRctParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteBaremetalRctParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteBaremetalRctParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteBaremetalRctParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewDeleteBaremetalRctParams(id string) *DeleteBaremetalRctParams {
 	p := &DeleteBaremetalRctParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// deletes baremetal rack configuration text
-func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("deleteBaremetalRct", p.toURLValues())
+// deletes baremetal rack configuration text.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams, opts ...CallOption) (*DeleteBaremetalRctResponse, error) {
+	resp, err := s.cs.newRequest("deleteBaremetalRct", s.cs.encodeParams("deleteBaremetalRct", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -959,8 +1483,8 @@ func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*Del
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -984,176 +1508,294 @@ type DeleteBaremetalRctResponse struct {
 }
 
 type ListBaremetalDhcpParams struct {
-	p map[string]interface{}
+	dhcpservertype    optString
+	id                optInt64
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
 }
 
-func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBaremetalDhcpParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["dhcpservertype"]; found {
-		u.Set("dhcpservertype", v.(string))
+	if p.dhcpservertype.ok {
+		u.Set("dhcpservertype", p.dhcpservertype.v)
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.FormatInt(p.id.v, 10))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListBaremetalDhcpParams parses url.Values, for example taken from a raw API request,
+// into a new ListBaremetalDhcpParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBaremetalDhcpParams(u url.Values) (*ListBaremetalDhcpParams, error) {
+	p := &ListBaremetalDhcpParams{}
+	if err := checkParamNames("listBaremetalDhcp", u, "dhcpservertype", "id", "keyword", "page", "pagesize", "physicalnetworkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["dhcpservertype"]; found {
+		p.SetDhcpservertype(u.Get("dhcpservertype"))
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.ParseInt(u.Get("id"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["dhcpservertype"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	return p, nil
 }
 
+// SetDhcpservertype sets the dhcpservertype param.
+func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
+	p.dhcpservertype = optString{v: v, ok: true}
+}
+
+// ResetDhcpservertype unsets the dhcpservertype param
 func (p *ListBaremetalDhcpParams) ResetDhcpservertype() {
-	if p.p != nil && p.p["dhcpservertype"] != nil {
-		delete(p.p, "dhcpservertype")
-	}
+	p.dhcpservertype = optString{}
 }
 
+// GetDhcpservertype returns the dhcpservertype param and if it is set
 func (p *ListBaremetalDhcpParams) GetDhcpservertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dhcpservertype"].(string)
-	return value, ok
+	return p.dhcpservertype.v, p.dhcpservertype.ok
 }
 
+// SetId sets the id param.
 func (p *ListBaremetalDhcpParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optInt64{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListBaremetalDhcpParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt64{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListBaremetalDhcpParams) GetId() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int64)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListBaremetalDhcpParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListBaremetalDhcpParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListBaremetalDhcpParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListBaremetalDhcpParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListBaremetalDhcpParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListBaremetalDhcpParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListBaremetalDhcpParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListBaremetalDhcpParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListBaremetalDhcpParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *ListBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListBaremetalDhcpParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListBaremetalDhcpParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBaremetalDhcpParams) Clone() *ListBaremetalDhcpParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBaremetalDhcpParams) Equal(o *ListBaremetalDhcpParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.dhcpservertype == o.dhcpservertype &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListBaremetalDhcpParams is used to (un)marshal ListBaremetalDhcpParams using the API param names
+type serializedListBaremetalDhcpParams struct {
+	Dhcpservertype    *string `json:"dhcpservertype,omitempty" yaml:"dhcpservertype,omitempty"`
+	Id                *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListBaremetalDhcpParams) toSerialized() *serializedListBaremetalDhcpParams {
+	s := &serializedListBaremetalDhcpParams{}
+	if p.dhcpservertype.ok {
+		s.Dhcpservertype = &p.dhcpservertype.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListBaremetalDhcpParams) fromSerialized(s *serializedListBaremetalDhcpParams) {
+	*p = ListBaremetalDhcpParams{}
+	if s.Dhcpservertype != nil {
+		p.SetDhcpservertype(*s.Dhcpservertype)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBaremetalDhcpParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBaremetalDhcpParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBaremetalDhcpParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBaremetalDhcpParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBaremetalDhcpParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBaremetalDhcpParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListBaremetalDhcpParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewListBaremetalDhcpParams(physicalnetworkid string) *ListBaremetalDhcpParams {
 	p := &ListBaremetalDhcpParams{}
-	p.p = make(map[string]interface{})
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetPhysicalnetworkid(physicalnetworkid)
 	return p
 }
 
-// list baremetal dhcp servers
-func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalDhcp", p.toURLValues())
+// list baremetal dhcp servers.
+//
+// Required params: physicalnetworkid.
+func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams, opts ...CallOption) (*ListBaremetalDhcpResponse, error) {
+	resp, err := s.cs.newRequest("listBaremetalDhcp", s.cs.encodeParams("listBaremetalDhcp", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1182,152 +1824,264 @@ type BaremetalDhcp struct {
 }
 
 type ListBaremetalPxeServersParams struct {
-	p map[string]interface{}
+	id                optInt64
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
 }
 
-func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBaremetalPxeServersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.FormatInt(p.id.v, 10))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListBaremetalPxeServersParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListBaremetalPxeServersParams parses url.Values, for example taken from a raw API request,
+// into a new ListBaremetalPxeServersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBaremetalPxeServersParams(u url.Values) (*ListBaremetalPxeServersParams, error) {
+	p := &ListBaremetalPxeServersParams{}
+	if err := checkParamNames("listBaremetalPxeServers", u, "id", "keyword", "page", "pagesize", "physicalnetworkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.ParseInt(u.Get("id"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["id"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListBaremetalPxeServersParams) SetId(v int64) {
+	p.id = optInt64{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListBaremetalPxeServersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt64{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListBaremetalPxeServersParams) GetId() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int64)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListBaremetalPxeServersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListBaremetalPxeServersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListBaremetalPxeServersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListBaremetalPxeServersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListBaremetalPxeServersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListBaremetalPxeServersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListBaremetalPxeServersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListBaremetalPxeServersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListBaremetalPxeServersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *ListBaremetalPxeServersParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListBaremetalPxeServersParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListBaremetalPxeServersParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBaremetalPxeServersParams) Clone() *ListBaremetalPxeServersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBaremetalPxeServersParams) Equal(o *ListBaremetalPxeServersParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListBaremetalPxeServersParams is used to (un)marshal ListBaremetalPxeServersParams using the API param names
+type serializedListBaremetalPxeServersParams struct {
+	Id                *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListBaremetalPxeServersParams) toSerialized() *serializedListBaremetalPxeServersParams {
+	s := &serializedListBaremetalPxeServersParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListBaremetalPxeServersParams) fromSerialized(s *serializedListBaremetalPxeServersParams) {
+	*p = ListBaremetalPxeServersParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBaremetalPxeServersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBaremetalPxeServersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBaremetalPxeServersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBaremetalPxeServersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBaremetalPxeServersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBaremetalPxeServersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListBaremetalPxeServersParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewListBaremetalPxeServersParams(physicalnetworkid string) *ListBaremetalPxeServersParams {
 	p := &ListBaremetalPxeServersParams{}
-	p.p = make(map[string]interface{})
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetPhysicalnetworkid(physicalnetworkid)
 	return p
 }
 
-// list baremetal pxe server
-func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalPxeServers", p.toURLValues())
+// list baremetal pxe server.
+//
+// Required params: physicalnetworkid.
+func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams, opts ...CallOption) (*ListBaremetalPxeServersResponse, error) {
+	resp, err := s.cs.newRequest("listBaremetalPxeServers", s.cs.encodeParams("listBaremetalPxeServers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,102 +2109,197 @@ type BaremetalPxeServer struct {
 }
 
 type ListBaremetalRctParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListBaremetalRctParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBaremetalRctParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListBaremetalRctParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListBaremetalRctParams parses url.Values, for example taken from a raw API request,
+// into a new ListBaremetalRctParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBaremetalRctParams(u url.Values) (*ListBaremetalRctParams, error) {
+	p := &ListBaremetalRctParams{}
+	if err := checkParamNames("listBaremetalRct", u, "keyword", "page", "pagesize"); err != nil {
+		return nil, err
 	}
-	p.p["keyword"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
 }
 
+// SetKeyword sets the keyword param.
+func (p *ListBaremetalRctParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
 func (p *ListBaremetalRctParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListBaremetalRctParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListBaremetalRctParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListBaremetalRctParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListBaremetalRctParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListBaremetalRctParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListBaremetalRctParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListBaremetalRctParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBaremetalRctParams) Clone() *ListBaremetalRctParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBaremetalRctParams) Equal(o *ListBaremetalRctParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListBaremetalRctParams is used to (un)marshal ListBaremetalRctParams using the API param names
+type serializedListBaremetalRctParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListBaremetalRctParams) toSerialized() *serializedListBaremetalRctParams {
+	s := &serializedListBaremetalRctParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListBaremetalRctParams) fromSerialized(s *serializedListBaremetalRctParams) {
+	*p = ListBaremetalRctParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBaremetalRctParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBaremetalRctParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBaremetalRctParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBaremetalRctParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListBaremetalRctParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewListBaremetalRctParams() *ListBaremetalRctParams {
 	p := &ListBaremetalRctParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// list baremetal rack configuration
-func (s *BaremetalService) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalRct", p.toURLValues())
+// list baremetal rack configuration.
+func (s *BaremetalService) ListBaremetalRct(p *ListBaremetalRctParams, opts ...CallOption) (*ListBaremetalRctResponse, error) {
+	resp, err := s.cs.newRequest("listBaremetalRct", s.cs.encodeParams("listBaremetalRct", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1476,53 +2325,134 @@ type BaremetalRct struct {
 }
 
 type NotifyBaremetalProvisionDoneParams struct {
-	p map[string]interface{}
+	mac optString
 }
 
-func (p *NotifyBaremetalProvisionDoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *NotifyBaremetalProvisionDoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["mac"]; found {
-		u.Set("mac", v.(string))
+	if p.mac.ok {
+		u.Set("mac", p.mac.v)
 	}
 	return u
 }
 
-func (p *NotifyBaremetalProvisionDoneParams) SetMac(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseNotifyBaremetalProvisionDoneParams parses url.Values, for example taken from a raw API request,
+// into a new NotifyBaremetalProvisionDoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseNotifyBaremetalProvisionDoneParams(u url.Values) (*NotifyBaremetalProvisionDoneParams, error) {
+	p := &NotifyBaremetalProvisionDoneParams{}
+	if err := checkParamNames("notifyBaremetalProvisionDone", u, "mac"); err != nil {
+		return nil, err
+	}
+	if _, found := u["mac"]; found {
+		p.SetMac(u.Get("mac"))
 	}
-	p.p["mac"] = v
+	return p, nil
+}
+
+// SetMac sets the mac param. This param is required.
+func (p *NotifyBaremetalProvisionDoneParams) SetMac(v string) {
+	p.mac = optString{v: v, ok: true}
 }
 
+// ResetMac unsets the mac param
 func (p *NotifyBaremetalProvisionDoneParams) ResetMac() {
-	if p.p != nil && p.p["mac"] != nil {
-		delete(p.p, "mac")
-	}
+	p.mac = optString{}
 }
 
+// GetMac returns the mac param and if it is set
 func (p *NotifyBaremetalProvisionDoneParams) GetMac() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.mac.v, p.mac.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *NotifyBaremetalProvisionDoneParams) Clone() *NotifyBaremetalProvisionDoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *NotifyBaremetalProvisionDoneParams) Equal(o *NotifyBaremetalProvisionDoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.mac == o.mac
+}
+
+// serializedNotifyBaremetalProvisionDoneParams is used to (un)marshal NotifyBaremetalProvisionDoneParams using the API param names
+type serializedNotifyBaremetalProvisionDoneParams struct {
+	Mac *string `json:"mac,omitempty" yaml:"mac,omitempty"`
+}
+
+func (p *NotifyBaremetalProvisionDoneParams) toSerialized() *serializedNotifyBaremetalProvisionDoneParams {
+	s := &serializedNotifyBaremetalProvisionDoneParams{}
+	if p.mac.ok {
+		s.Mac = &p.mac.v
+	}
+	return s
+}
+
+func (p *NotifyBaremetalProvisionDoneParams) fromSerialized(s *serializedNotifyBaremetalProvisionDoneParams) {
+	*p = NotifyBaremetalProvisionDoneParams{}
+	if s.Mac != nil {
+		p.SetMac(*s.Mac)
 	}
-	value, ok := p.p["mac"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p NotifyBaremetalProvisionDoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *NotifyBaremetalProvisionDoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedNotifyBaremetalProvisionDoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p NotifyBaremetalProvisionDoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *NotifyBaremetalProvisionDoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedNotifyBaremetalProvisionDoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new NotifyBaremetalProvisionDoneParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewNotifyBaremetalProvisionDoneParams(mac string) *NotifyBaremetalProvisionDoneParams {
 	p := &NotifyBaremetalProvisionDoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["mac"] = mac
+	p.SetMac(mac)
 	return p
 }
 
-// Notify provision has been done on a host. This api is for baremetal virtual router service, not for end user
-func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
-	resp, err := s.cs.newRequest("notifyBaremetalProvisionDone", p.toURLValues())
+// Notify provision has been done on a host. This api is for baremetal virtual router service, not
+// for end user.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: mac.
+func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams, opts ...CallOption) (*NotifyBaremetalProvisionDoneResponse, error) {
+	resp, err := s.cs.newRequest("notifyBaremetalProvisionDone", s.cs.encodeParams("notifyBaremetalProvisionDone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1533,8 +2463,8 @@ func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvis
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,123 +53,163 @@ func (m *MockBaremetalServiceIface) EXPECT() *MockBaremetalServiceIfaceMockRecor
 }
 
 // AddBaremetalDhcp mocks base method.
-func (m *MockBaremetalServiceIface) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
+func (m *MockBaremetalServiceIface) AddBaremetalDhcp(p *AddBaremetalDhcpParams, opts ...CallOption) (*AddBaremetalDhcpResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBaremetalDhcp", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBaremetalDhcp", varargs...)
 	ret0, _ := ret[0].(*AddBaremetalDhcpResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBaremetalDhcp indicates an expected call of AddBaremetalDhcp.
-func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalDhcp(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalDhcp(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalDhcp", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalDhcp), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalDhcp", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalDhcp), varargs...)
 }
 
 // AddBaremetalPxeKickStartServer mocks base method.
-func (m *MockBaremetalServiceIface) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
+func (m *MockBaremetalServiceIface) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams, opts ...CallOption) (*AddBaremetalPxeKickStartServerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBaremetalPxeKickStartServer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBaremetalPxeKickStartServer", varargs...)
 	ret0, _ := ret[0].(*AddBaremetalPxeKickStartServerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBaremetalPxeKickStartServer indicates an expected call of AddBaremetalPxeKickStartServer.
-func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxeKickStartServer(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxeKickStartServer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxeKickStartServer", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxeKickStartServer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxeKickStartServer", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxeKickStartServer), varargs...)
 }
 
 // AddBaremetalPxePingServer mocks base method.
-func (m *MockBaremetalServiceIface) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
+func (m *MockBaremetalServiceIface) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams, opts ...CallOption) (*AddBaremetalPxePingServerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBaremetalPxePingServer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBaremetalPxePingServer", varargs...)
 	ret0, _ := ret[0].(*AddBaremetalPxePingServerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBaremetalPxePingServer indicates an expected call of AddBaremetalPxePingServer.
-func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxePingServer(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxePingServer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxePingServer", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxePingServer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxePingServer", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxePingServer), varargs...)
 }
 
 // AddBaremetalRct mocks base method.
-func (m *MockBaremetalServiceIface) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
+func (m *MockBaremetalServiceIface) AddBaremetalRct(p *AddBaremetalRctParams, opts ...CallOption) (*AddBaremetalRctResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBaremetalRct", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBaremetalRct", varargs...)
 	ret0, _ := ret[0].(*AddBaremetalRctResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBaremetalRct indicates an expected call of AddBaremetalRct.
-func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalRct(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalRct(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalRct), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalRct), varargs...)
 }
 
 // DeleteBaremetalRct mocks base method.
-func (m *MockBaremetalServiceIface) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
+func (m *MockBaremetalServiceIface) DeleteBaremetalRct(p *DeleteBaremetalRctParams, opts ...CallOption) (*DeleteBaremetalRctResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBaremetalRct", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBaremetalRct", varargs...)
 	ret0, _ := ret[0].(*DeleteBaremetalRctResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteBaremetalRct indicates an expected call of DeleteBaremetalRct.
-func (mr *MockBaremetalServiceIfaceMockRecorder) DeleteBaremetalRct(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) DeleteBaremetalRct(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).DeleteBaremetalRct), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).DeleteBaremetalRct), varargs...)
 }
 
 // ListBaremetalDhcp mocks base method.
-func (m *MockBaremetalServiceIface) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+func (m *MockBaremetalServiceIface) ListBaremetalDhcp(p *ListBaremetalDhcpParams, opts ...CallOption) (*ListBaremetalDhcpResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBaremetalDhcp", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalDhcp", varargs...)
 	ret0, _ := ret[0].(*ListBaremetalDhcpResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListBaremetalDhcp indicates an expected call of ListBaremetalDhcp.
-func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcp(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcp(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcp", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcp), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcp", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcp), varargs...)
 }
 
 // ListBaremetalPxeServers mocks base method.
-func (m *MockBaremetalServiceIface) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
+func (m *MockBaremetalServiceIface) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams, opts ...CallOption) (*ListBaremetalPxeServersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBaremetalPxeServers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalPxeServers", varargs...)
 	ret0, _ := ret[0].(*ListBaremetalPxeServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListBaremetalPxeServers indicates an expected call of ListBaremetalPxeServers.
-func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServers(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServers", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServers", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServers), varargs...)
 }
 
 // ListBaremetalRct mocks base method.
-func (m *MockBaremetalServiceIface) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
+func (m *MockBaremetalServiceIface) ListBaremetalRct(p *ListBaremetalRctParams, opts ...CallOption) (*ListBaremetalRctResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBaremetalRct", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalRct", varargs...)
 	ret0, _ := ret[0].(*ListBaremetalRctResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListBaremetalRct indicates an expected call of ListBaremetalRct.
-func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRct(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRct(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRct), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRct), varargs...)
 }
 
 // NewAddBaremetalDhcpParams mocks base method.
@@ -299,16 +339,21 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) NewNotifyBaremetalProvisionDone
 }
 
 // NotifyBaremetalProvisionDone mocks base method.
-func (m *MockBaremetalServiceIface) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
+func (m *MockBaremetalServiceIface) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams, opts ...CallOption) (*NotifyBaremetalProvisionDoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NotifyBaremetalProvisionDone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NotifyBaremetalProvisionDone", varargs...)
 	ret0, _ := ret[0].(*NotifyBaremetalProvisionDoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NotifyBaremetalProvisionDone indicates an expected call of NotifyBaremetalProvisionDone.
-func (mr *MockBaremetalServiceIfaceMockRecorder) NotifyBaremetalProvisionDone(p interface{}) *gomock.Call {
+func (mr *MockBaremetalServiceIfaceMockRecorder) NotifyBaremetalProvisionDone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyBaremetalProvisionDone", reflect.TypeOf((*MockBaremetalServiceIface)(nil).NotifyBaremetalProvisionDone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyBaremetalProvisionDone", reflect.TypeOf((*MockBaremetalServiceIface)(nil).NotifyBaremetalProvisionDone), varargs...)
 }
@@ -21,168 +21,277 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type BigSwitchBCFServiceIface interface {
-	AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams, opts ...CallOption) (*AddBigSwitchBcfDeviceResponse, error)
 	NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) *AddBigSwitchBcfDeviceParams
-	DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams, opts ...CallOption) (*DeleteBigSwitchBcfDeviceResponse, error)
 	NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) *DeleteBigSwitchBcfDeviceParams
-	ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams, opts ...CallOption) (*ListBigSwitchBcfDevicesResponse, error)
 	NewListBigSwitchBcfDevicesParams() *ListBigSwitchBcfDevicesParams
 }
 
 type AddBigSwitchBcfDeviceParams struct {
-	p map[string]interface{}
+	hostname          optString
+	nat               optBool
+	password          optString
+	physicalnetworkid optString
+	username          optString
 }
 
-func (p *AddBigSwitchBcfDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddBigSwitchBcfDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if p.hostname.ok {
+		u.Set("hostname", p.hostname.v)
 	}
-	if v, found := p.p["nat"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("nat", vv)
+	if p.nat.ok {
+		u.Set("nat", strconv.FormatBool(p.nat.v))
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddBigSwitchBcfDeviceParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddBigSwitchBcfDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new AddBigSwitchBcfDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddBigSwitchBcfDeviceParams(u url.Values) (*AddBigSwitchBcfDeviceParams, error) {
+	p := &AddBigSwitchBcfDeviceParams{}
+	if err := checkParamNames("addBigSwitchBcfDevice", u, "hostname", "nat", "password", "physicalnetworkid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostname"]; found {
+		p.SetHostname(u.Get("hostname"))
+	}
+	if _, found := u["nat"]; found {
+		v, err := strconv.ParseBool(u.Get("nat"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param nat: %v", err)
+		}
+		p.SetNat(v)
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
 	}
-	p.p["hostname"] = v
+	return p, nil
 }
 
+// SetHostname sets the hostname param. This param is required.
+func (p *AddBigSwitchBcfDeviceParams) SetHostname(v string) {
+	p.hostname = optString{v: v, ok: true}
+}
+
+// ResetHostname unsets the hostname param
 func (p *AddBigSwitchBcfDeviceParams) ResetHostname() {
-	if p.p != nil && p.p["hostname"] != nil {
-		delete(p.p, "hostname")
-	}
+	p.hostname = optString{}
 }
 
+// GetHostname returns the hostname param and if it is set
 func (p *AddBigSwitchBcfDeviceParams) GetHostname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostname"].(string)
-	return value, ok
+	return p.hostname.v, p.hostname.ok
 }
 
+// SetNat sets the nat param. This param is required.
 func (p *AddBigSwitchBcfDeviceParams) SetNat(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nat"] = v
+	p.nat = optBool{v: v, ok: true}
 }
 
+// ResetNat unsets the nat param
 func (p *AddBigSwitchBcfDeviceParams) ResetNat() {
-	if p.p != nil && p.p["nat"] != nil {
-		delete(p.p, "nat")
-	}
+	p.nat = optBool{}
 }
 
+// GetNat returns the nat param and if it is set
 func (p *AddBigSwitchBcfDeviceParams) GetNat() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nat"].(bool)
-	return value, ok
+	return p.nat.v, p.nat.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddBigSwitchBcfDeviceParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddBigSwitchBcfDeviceParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddBigSwitchBcfDeviceParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddBigSwitchBcfDeviceParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddBigSwitchBcfDeviceParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddBigSwitchBcfDeviceParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddBigSwitchBcfDeviceParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddBigSwitchBcfDeviceParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddBigSwitchBcfDeviceParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddBigSwitchBcfDeviceParams) Clone() *AddBigSwitchBcfDeviceParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddBigSwitchBcfDeviceParams) Equal(o *AddBigSwitchBcfDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostname == o.hostname &&
+		p.nat == o.nat &&
+		p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.username == o.username
+}
+
+// serializedAddBigSwitchBcfDeviceParams is used to (un)marshal AddBigSwitchBcfDeviceParams using the API param names
+type serializedAddBigSwitchBcfDeviceParams struct {
+	Hostname          *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Nat               *bool   `json:"nat,omitempty" yaml:"nat,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddBigSwitchBcfDeviceParams) toSerialized() *serializedAddBigSwitchBcfDeviceParams {
+	s := &serializedAddBigSwitchBcfDeviceParams{}
+	if p.hostname.ok {
+		s.Hostname = &p.hostname.v
+	}
+	if p.nat.ok {
+		s.Nat = &p.nat.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddBigSwitchBcfDeviceParams) fromSerialized(s *serializedAddBigSwitchBcfDeviceParams) {
+	*p = AddBigSwitchBcfDeviceParams{}
+	if s.Hostname != nil {
+		p.SetHostname(*s.Hostname)
+	}
+	if s.Nat != nil {
+		p.SetNat(*s.Nat)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddBigSwitchBcfDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddBigSwitchBcfDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddBigSwitchBcfDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddBigSwitchBcfDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddBigSwitchBcfDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddBigSwitchBcfDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddBigSwitchBcfDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) *AddBigSwitchBcfDeviceParams {
 	p := &AddBigSwitchBcfDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["nat"] = nat
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["username"] = username
+	p.SetHostname(hostname)
+	p.SetNat(nat)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds a BigSwitch BCF Controller device
-func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addBigSwitchBcfDevice", p.toURLValues())
+// Adds a BigSwitch BCF Controller device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostname, nat, password,
+// physicalnetworkid, username.
+func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams, opts ...CallOption) (*AddBigSwitchBcfDeviceResponse, error) {
+	resp, err := s.cs.newRequest("addBigSwitchBcfDevice", s.cs.encodeParams("addBigSwitchBcfDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -193,8 +302,8 @@ func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDevicePara
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -229,53 +338,133 @@ type AddBigSwitchBcfDeviceResponse struct {
 }
 
 type DeleteBigSwitchBcfDeviceParams struct {
-	p map[string]interface{}
+	bcfdeviceid optString
 }
 
-func (p *DeleteBigSwitchBcfDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteBigSwitchBcfDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bcfdeviceid"]; found {
-		u.Set("bcfdeviceid", v.(string))
+	if p.bcfdeviceid.ok {
+		u.Set("bcfdeviceid", p.bcfdeviceid.v)
 	}
 	return u
 }
 
-func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteBigSwitchBcfDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteBigSwitchBcfDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteBigSwitchBcfDeviceParams(u url.Values) (*DeleteBigSwitchBcfDeviceParams, error) {
+	p := &DeleteBigSwitchBcfDeviceParams{}
+	if err := checkParamNames("deleteBigSwitchBcfDevice", u, "bcfdeviceid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bcfdeviceid"]; found {
+		p.SetBcfdeviceid(u.Get("bcfdeviceid"))
 	}
-	p.p["bcfdeviceid"] = v
+	return p, nil
 }
 
+// SetBcfdeviceid sets the bcfdeviceid param. This param is required.
+func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceid(v string) {
+	p.bcfdeviceid = optString{v: v, ok: true}
+}
+
+// ResetBcfdeviceid unsets the bcfdeviceid param
 func (p *DeleteBigSwitchBcfDeviceParams) ResetBcfdeviceid() {
-	if p.p != nil && p.p["bcfdeviceid"] != nil {
-		delete(p.p, "bcfdeviceid")
-	}
+	p.bcfdeviceid = optString{}
 }
 
+// GetBcfdeviceid returns the bcfdeviceid param and if it is set
 func (p *DeleteBigSwitchBcfDeviceParams) GetBcfdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.bcfdeviceid.v, p.bcfdeviceid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteBigSwitchBcfDeviceParams) Clone() *DeleteBigSwitchBcfDeviceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteBigSwitchBcfDeviceParams) Equal(o *DeleteBigSwitchBcfDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["bcfdeviceid"].(string)
-	return value, ok
+	return p.bcfdeviceid == o.bcfdeviceid
+}
+
+// serializedDeleteBigSwitchBcfDeviceParams is used to (un)marshal DeleteBigSwitchBcfDeviceParams using the API param names
+type serializedDeleteBigSwitchBcfDeviceParams struct {
+	Bcfdeviceid *string `json:"bcfdeviceid,omitempty" yaml:"bcfdeviceid,omitempty"`
+}
+
+func (p *DeleteBigSwitchBcfDeviceParams) toSerialized() *serializedDeleteBigSwitchBcfDeviceParams {
+	s := &serializedDeleteBigSwitchBcfDeviceParams{}
+	if p.bcfdeviceid.ok {
+		s.Bcfdeviceid = &p.bcfdeviceid.v
+	}
+	return s
+}
+
+func (p *DeleteBigSwitchBcfDeviceParams) fromSerialized(s *serializedDeleteBigSwitchBcfDeviceParams) {
+	*p = DeleteBigSwitchBcfDeviceParams{}
+	if s.Bcfdeviceid != nil {
+		p.SetBcfdeviceid(*s.Bcfdeviceid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteBigSwitchBcfDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteBigSwitchBcfDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteBigSwitchBcfDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteBigSwitchBcfDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteBigSwitchBcfDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteBigSwitchBcfDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteBigSwitchBcfDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) *DeleteBigSwitchBcfDeviceParams {
 	p := &DeleteBigSwitchBcfDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["bcfdeviceid"] = bcfdeviceid
+	p.SetBcfdeviceid(bcfdeviceid)
 	return p
 }
 
-// delete a BigSwitch BCF Controller device
-func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteBigSwitchBcfDevice", p.toURLValues())
+// delete a BigSwitch BCF Controller device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: bcfdeviceid.
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams, opts ...CallOption) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	resp, err := s.cs.newRequest("deleteBigSwitchBcfDevice", s.cs.encodeParams("deleteBigSwitchBcfDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -286,8 +475,8 @@ func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDevi
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -311,150 +500,257 @@ type DeleteBigSwitchBcfDeviceResponse struct {
 }
 
 type ListBigSwitchBcfDevicesParams struct {
-	p map[string]interface{}
+	bcfdeviceid       optString
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
 }
 
-func (p *ListBigSwitchBcfDevicesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBigSwitchBcfDevicesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bcfdeviceid"]; found {
-		u.Set("bcfdeviceid", v.(string))
+	if p.bcfdeviceid.ok {
+		u.Set("bcfdeviceid", p.bcfdeviceid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListBigSwitchBcfDevicesParams) SetBcfdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListBigSwitchBcfDevicesParams parses url.Values, for example taken from a raw API request,
+// into a new ListBigSwitchBcfDevicesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBigSwitchBcfDevicesParams(u url.Values) (*ListBigSwitchBcfDevicesParams, error) {
+	p := &ListBigSwitchBcfDevicesParams{}
+	if err := checkParamNames("listBigSwitchBcfDevices", u, "bcfdeviceid", "keyword", "page", "pagesize", "physicalnetworkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bcfdeviceid"]; found {
+		p.SetBcfdeviceid(u.Get("bcfdeviceid"))
 	}
-	p.p["bcfdeviceid"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	return p, nil
+}
+
+// SetBcfdeviceid sets the bcfdeviceid param.
+func (p *ListBigSwitchBcfDevicesParams) SetBcfdeviceid(v string) {
+	p.bcfdeviceid = optString{v: v, ok: true}
 }
 
+// ResetBcfdeviceid unsets the bcfdeviceid param
 func (p *ListBigSwitchBcfDevicesParams) ResetBcfdeviceid() {
-	if p.p != nil && p.p["bcfdeviceid"] != nil {
-		delete(p.p, "bcfdeviceid")
-	}
+	p.bcfdeviceid = optString{}
 }
 
+// GetBcfdeviceid returns the bcfdeviceid param and if it is set
 func (p *ListBigSwitchBcfDevicesParams) GetBcfdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bcfdeviceid"].(string)
-	return value, ok
+	return p.bcfdeviceid.v, p.bcfdeviceid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListBigSwitchBcfDevicesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListBigSwitchBcfDevicesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListBigSwitchBcfDevicesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListBigSwitchBcfDevicesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListBigSwitchBcfDevicesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListBigSwitchBcfDevicesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListBigSwitchBcfDevicesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListBigSwitchBcfDevicesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListBigSwitchBcfDevicesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListBigSwitchBcfDevicesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListBigSwitchBcfDevicesParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListBigSwitchBcfDevicesParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBigSwitchBcfDevicesParams) Clone() *ListBigSwitchBcfDevicesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBigSwitchBcfDevicesParams) Equal(o *ListBigSwitchBcfDevicesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bcfdeviceid == o.bcfdeviceid &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListBigSwitchBcfDevicesParams is used to (un)marshal ListBigSwitchBcfDevicesParams using the API param names
+type serializedListBigSwitchBcfDevicesParams struct {
+	Bcfdeviceid       *string `json:"bcfdeviceid,omitempty" yaml:"bcfdeviceid,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListBigSwitchBcfDevicesParams) toSerialized() *serializedListBigSwitchBcfDevicesParams {
+	s := &serializedListBigSwitchBcfDevicesParams{}
+	if p.bcfdeviceid.ok {
+		s.Bcfdeviceid = &p.bcfdeviceid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListBigSwitchBcfDevicesParams) fromSerialized(s *serializedListBigSwitchBcfDevicesParams) {
+	*p = ListBigSwitchBcfDevicesParams{}
+	if s.Bcfdeviceid != nil {
+		p.SetBcfdeviceid(*s.Bcfdeviceid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBigSwitchBcfDevicesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBigSwitchBcfDevicesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBigSwitchBcfDevicesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBigSwitchBcfDevicesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBigSwitchBcfDevicesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBigSwitchBcfDevicesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListBigSwitchBcfDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *BigSwitchBCFService) NewListBigSwitchBcfDevicesParams() *ListBigSwitchBcfDevicesParams {
 	p := &ListBigSwitchBcfDevicesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists BigSwitch BCF Controller devices
-func (s *BigSwitchBCFService) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBigSwitchBcfDevices", p.toURLValues())
+// Lists BigSwitch BCF Controller devices.
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams, opts ...CallOption) (*ListBigSwitchBcfDevicesResponse, error) {
+	resp, err := s.cs.newRequest("listBigSwitchBcfDevices", s.cs.encodeParams("listBigSwitchBcfDevices", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,48 +53,63 @@ func (m *MockBigSwitchBCFServiceIface) EXPECT() *MockBigSwitchBCFServiceIfaceMoc
 }
 
 // AddBigSwitchBcfDevice mocks base method.
-func (m *MockBigSwitchBCFServiceIface) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
+func (m *MockBigSwitchBCFServiceIface) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams, opts ...CallOption) (*AddBigSwitchBcfDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBigSwitchBcfDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBigSwitchBcfDevice", varargs...)
 	ret0, _ := ret[0].(*AddBigSwitchBcfDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBigSwitchBcfDevice indicates an expected call of AddBigSwitchBcfDevice.
-func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) AddBigSwitchBcfDevice(p interface{}) *gomock.Call {
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) AddBigSwitchBcfDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBigSwitchBcfDevice", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).AddBigSwitchBcfDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBigSwitchBcfDevice", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).AddBigSwitchBcfDevice), varargs...)
 }
 
 // DeleteBigSwitchBcfDevice mocks base method.
-func (m *MockBigSwitchBCFServiceIface) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
+func (m *MockBigSwitchBCFServiceIface) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams, opts ...CallOption) (*DeleteBigSwitchBcfDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBigSwitchBcfDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBigSwitchBcfDevice", varargs...)
 	ret0, _ := ret[0].(*DeleteBigSwitchBcfDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteBigSwitchBcfDevice indicates an expected call of DeleteBigSwitchBcfDevice.
-func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) DeleteBigSwitchBcfDevice(p interface{}) *gomock.Call {
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) DeleteBigSwitchBcfDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBigSwitchBcfDevice", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).DeleteBigSwitchBcfDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBigSwitchBcfDevice", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).DeleteBigSwitchBcfDevice), varargs...)
 }
 
 // ListBigSwitchBcfDevices mocks base method.
-func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
+func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams, opts ...CallOption) (*ListBigSwitchBcfDevicesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBigSwitchBcfDevices", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBigSwitchBcfDevices", varargs...)
 	ret0, _ := ret[0].(*ListBigSwitchBcfDevicesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListBigSwitchBcfDevices indicates an expected call of ListBigSwitchBcfDevices.
-func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevices(p interface{}) *gomock.Call {
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevices(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevices", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevices), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevices", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevices), varargs...)
 }
 
 // NewAddBigSwitchBcfDeviceParams mocks base method.
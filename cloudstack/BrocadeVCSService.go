@@ -27,140 +27,239 @@ import (
 )
 
 type BrocadeVCSServiceIface interface {
-	AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams, opts ...CallOption) (*AddBrocadeVcsDeviceResponse, error)
 	NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) *AddBrocadeVcsDeviceParams
-	DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams, opts ...CallOption) (*DeleteBrocadeVcsDeviceResponse, error)
 	NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) *DeleteBrocadeVcsDeviceParams
-	ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams, opts ...CallOption) (*ListBrocadeVcsDeviceNetworksResponse, error)
 	NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) *ListBrocadeVcsDeviceNetworksParams
 	GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error)
-	ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams, opts ...CallOption) (*ListBrocadeVcsDevicesResponse, error)
 	NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevicesParams
 }
 
 type AddBrocadeVcsDeviceParams struct {
-	p map[string]interface{}
+	hostname          optString
+	password          optString
+	physicalnetworkid optString
+	username          optString
 }
 
-func (p *AddBrocadeVcsDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddBrocadeVcsDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if p.hostname.ok {
+		u.Set("hostname", p.hostname.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddBrocadeVcsDeviceParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddBrocadeVcsDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new AddBrocadeVcsDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddBrocadeVcsDeviceParams(u url.Values) (*AddBrocadeVcsDeviceParams, error) {
+	p := &AddBrocadeVcsDeviceParams{}
+	if err := checkParamNames("addBrocadeVcsDevice", u, "hostname", "password", "physicalnetworkid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostname"]; found {
+		p.SetHostname(u.Get("hostname"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
 	}
-	p.p["hostname"] = v
+	return p, nil
 }
 
+// SetHostname sets the hostname param. This param is required.
+func (p *AddBrocadeVcsDeviceParams) SetHostname(v string) {
+	p.hostname = optString{v: v, ok: true}
+}
+
+// ResetHostname unsets the hostname param
 func (p *AddBrocadeVcsDeviceParams) ResetHostname() {
-	if p.p != nil && p.p["hostname"] != nil {
-		delete(p.p, "hostname")
-	}
+	p.hostname = optString{}
 }
 
+// GetHostname returns the hostname param and if it is set
 func (p *AddBrocadeVcsDeviceParams) GetHostname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostname"].(string)
-	return value, ok
+	return p.hostname.v, p.hostname.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddBrocadeVcsDeviceParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddBrocadeVcsDeviceParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddBrocadeVcsDeviceParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddBrocadeVcsDeviceParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddBrocadeVcsDeviceParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddBrocadeVcsDeviceParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddBrocadeVcsDeviceParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddBrocadeVcsDeviceParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddBrocadeVcsDeviceParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddBrocadeVcsDeviceParams) Clone() *AddBrocadeVcsDeviceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddBrocadeVcsDeviceParams) Equal(o *AddBrocadeVcsDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostname == o.hostname &&
+		p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.username == o.username
+}
+
+// serializedAddBrocadeVcsDeviceParams is used to (un)marshal AddBrocadeVcsDeviceParams using the API param names
+type serializedAddBrocadeVcsDeviceParams struct {
+	Hostname          *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddBrocadeVcsDeviceParams) toSerialized() *serializedAddBrocadeVcsDeviceParams {
+	s := &serializedAddBrocadeVcsDeviceParams{}
+	if p.hostname.ok {
+		s.Hostname = &p.hostname.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddBrocadeVcsDeviceParams) fromSerialized(s *serializedAddBrocadeVcsDeviceParams) {
+	*p = AddBrocadeVcsDeviceParams{}
+	if s.Hostname != nil {
+		p.SetHostname(*s.Hostname)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddBrocadeVcsDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddBrocadeVcsDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddBrocadeVcsDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddBrocadeVcsDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddBrocadeVcsDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddBrocadeVcsDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddBrocadeVcsDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) *AddBrocadeVcsDeviceParams {
 	p := &AddBrocadeVcsDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["username"] = username
+	p.SetHostname(hostname)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds a Brocade VCS Switch
-func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addBrocadeVcsDevice", p.toURLValues())
+// Adds a Brocade VCS Switch.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostname, password,
+// physicalnetworkid, username.
+func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams, opts ...CallOption) (*AddBrocadeVcsDeviceResponse, error) {
+	resp, err := s.cs.newRequest("addBrocadeVcsDevice", s.cs.encodeParams("addBrocadeVcsDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -171,8 +270,8 @@ func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -204,53 +303,133 @@ type AddBrocadeVcsDeviceResponse struct {
 }
 
 type DeleteBrocadeVcsDeviceParams struct {
-	p map[string]interface{}
+	vcsdeviceid optString
 }
 
-func (p *DeleteBrocadeVcsDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteBrocadeVcsDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["vcsdeviceid"]; found {
-		u.Set("vcsdeviceid", v.(string))
+	if p.vcsdeviceid.ok {
+		u.Set("vcsdeviceid", p.vcsdeviceid.v)
 	}
 	return u
 }
 
-func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteBrocadeVcsDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteBrocadeVcsDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteBrocadeVcsDeviceParams(u url.Values) (*DeleteBrocadeVcsDeviceParams, error) {
+	p := &DeleteBrocadeVcsDeviceParams{}
+	if err := checkParamNames("deleteBrocadeVcsDevice", u, "vcsdeviceid"); err != nil {
+		return nil, err
 	}
-	p.p["vcsdeviceid"] = v
+	if _, found := u["vcsdeviceid"]; found {
+		p.SetVcsdeviceid(u.Get("vcsdeviceid"))
+	}
+	return p, nil
 }
 
+// SetVcsdeviceid sets the vcsdeviceid param. This param is required.
+func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceid(v string) {
+	p.vcsdeviceid = optString{v: v, ok: true}
+}
+
+// ResetVcsdeviceid unsets the vcsdeviceid param
 func (p *DeleteBrocadeVcsDeviceParams) ResetVcsdeviceid() {
-	if p.p != nil && p.p["vcsdeviceid"] != nil {
-		delete(p.p, "vcsdeviceid")
-	}
+	p.vcsdeviceid = optString{}
 }
 
+// GetVcsdeviceid returns the vcsdeviceid param and if it is set
 func (p *DeleteBrocadeVcsDeviceParams) GetVcsdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vcsdeviceid.v, p.vcsdeviceid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteBrocadeVcsDeviceParams) Clone() *DeleteBrocadeVcsDeviceParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["vcsdeviceid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteBrocadeVcsDeviceParams) Equal(o *DeleteBrocadeVcsDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.vcsdeviceid == o.vcsdeviceid
+}
+
+// serializedDeleteBrocadeVcsDeviceParams is used to (un)marshal DeleteBrocadeVcsDeviceParams using the API param names
+type serializedDeleteBrocadeVcsDeviceParams struct {
+	Vcsdeviceid *string `json:"vcsdeviceid,omitempty" yaml:"vcsdeviceid,omitempty"`
+}
+
+func (p *DeleteBrocadeVcsDeviceParams) toSerialized() *serializedDeleteBrocadeVcsDeviceParams {
+	s := &serializedDeleteBrocadeVcsDeviceParams{}
+	if p.vcsdeviceid.ok {
+		s.Vcsdeviceid = &p.vcsdeviceid.v
+	}
+	return s
+}
+
+func (p *DeleteBrocadeVcsDeviceParams) fromSerialized(s *serializedDeleteBrocadeVcsDeviceParams) {
+	*p = DeleteBrocadeVcsDeviceParams{}
+	if s.Vcsdeviceid != nil {
+		p.SetVcsdeviceid(*s.Vcsdeviceid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteBrocadeVcsDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteBrocadeVcsDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteBrocadeVcsDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteBrocadeVcsDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteBrocadeVcsDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteBrocadeVcsDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteBrocadeVcsDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) *DeleteBrocadeVcsDeviceParams {
 	p := &DeleteBrocadeVcsDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["vcsdeviceid"] = vcsdeviceid
+	p.SetVcsdeviceid(vcsdeviceid)
 	return p
 }
 
-// delete a Brocade VCS Switch
-func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteBrocadeVcsDevice", p.toURLValues())
+// delete a Brocade VCS Switch.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: vcsdeviceid.
+func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams, opts ...CallOption) (*DeleteBrocadeVcsDeviceResponse, error) {
+	resp, err := s.cs.newRequest("deleteBrocadeVcsDevice", s.cs.encodeParams("deleteBrocadeVcsDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -261,8 +440,8 @@ func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDevicePara
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -286,131 +465,231 @@ type DeleteBrocadeVcsDeviceResponse struct {
 }
 
 type ListBrocadeVcsDeviceNetworksParams struct {
-	p map[string]interface{}
+	keyword     optString
+	page        optInt
+	pagesize    optInt
+	vcsdeviceid optString
 }
 
-func (p *ListBrocadeVcsDeviceNetworksParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBrocadeVcsDeviceNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["vcsdeviceid"]; found {
-		u.Set("vcsdeviceid", v.(string))
+	if p.vcsdeviceid.ok {
+		u.Set("vcsdeviceid", p.vcsdeviceid.v)
 	}
 	return u
 }
 
-func (p *ListBrocadeVcsDeviceNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListBrocadeVcsDeviceNetworksParams parses url.Values, for example taken from a raw API request,
+// into a new ListBrocadeVcsDeviceNetworksParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBrocadeVcsDeviceNetworksParams(u url.Values) (*ListBrocadeVcsDeviceNetworksParams, error) {
+	p := &ListBrocadeVcsDeviceNetworksParams{}
+	if err := checkParamNames("listBrocadeVcsDeviceNetworks", u, "keyword", "page", "pagesize", "vcsdeviceid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["keyword"] = v
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["vcsdeviceid"]; found {
+		p.SetVcsdeviceid(u.Get("vcsdeviceid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListBrocadeVcsDeviceNetworksParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListBrocadeVcsDeviceNetworksParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListBrocadeVcsDeviceNetworksParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListBrocadeVcsDeviceNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListBrocadeVcsDeviceNetworksParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListBrocadeVcsDeviceNetworksParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListBrocadeVcsDeviceNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListBrocadeVcsDeviceNetworksParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListBrocadeVcsDeviceNetworksParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetVcsdeviceid sets the vcsdeviceid param. This param is required.
 func (p *ListBrocadeVcsDeviceNetworksParams) SetVcsdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vcsdeviceid"] = v
+	p.vcsdeviceid = optString{v: v, ok: true}
 }
 
+// ResetVcsdeviceid unsets the vcsdeviceid param
 func (p *ListBrocadeVcsDeviceNetworksParams) ResetVcsdeviceid() {
-	if p.p != nil && p.p["vcsdeviceid"] != nil {
-		delete(p.p, "vcsdeviceid")
-	}
+	p.vcsdeviceid = optString{}
 }
 
+// GetVcsdeviceid returns the vcsdeviceid param and if it is set
 func (p *ListBrocadeVcsDeviceNetworksParams) GetVcsdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vcsdeviceid.v, p.vcsdeviceid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBrocadeVcsDeviceNetworksParams) Clone() *ListBrocadeVcsDeviceNetworksParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBrocadeVcsDeviceNetworksParams) Equal(o *ListBrocadeVcsDeviceNetworksParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["vcsdeviceid"].(string)
-	return value, ok
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.vcsdeviceid == o.vcsdeviceid
+}
+
+// serializedListBrocadeVcsDeviceNetworksParams is used to (un)marshal ListBrocadeVcsDeviceNetworksParams using the API param names
+type serializedListBrocadeVcsDeviceNetworksParams struct {
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Vcsdeviceid *string `json:"vcsdeviceid,omitempty" yaml:"vcsdeviceid,omitempty"`
+}
+
+func (p *ListBrocadeVcsDeviceNetworksParams) toSerialized() *serializedListBrocadeVcsDeviceNetworksParams {
+	s := &serializedListBrocadeVcsDeviceNetworksParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.vcsdeviceid.ok {
+		s.Vcsdeviceid = &p.vcsdeviceid.v
+	}
+	return s
+}
+
+func (p *ListBrocadeVcsDeviceNetworksParams) fromSerialized(s *serializedListBrocadeVcsDeviceNetworksParams) {
+	*p = ListBrocadeVcsDeviceNetworksParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Vcsdeviceid != nil {
+		p.SetVcsdeviceid(*s.Vcsdeviceid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBrocadeVcsDeviceNetworksParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBrocadeVcsDeviceNetworksParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBrocadeVcsDeviceNetworksParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBrocadeVcsDeviceNetworksParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBrocadeVcsDeviceNetworksParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBrocadeVcsDeviceNetworksParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListBrocadeVcsDeviceNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) *ListBrocadeVcsDeviceNetworksParams {
 	p := &ListBrocadeVcsDeviceNetworksParams{}
-	p.p = make(map[string]interface{})
-	p.p["vcsdeviceid"] = vcsdeviceid
+	p.SetVcsdeviceid(vcsdeviceid)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBrocadeVcsDeviceNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["vcsdeviceid"] = vcsdeviceid
+	p.SetKeyword(keyword)
+	p.SetVcsdeviceid(vcsdeviceid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -441,9 +720,11 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// lists network that are using a brocade vcs switch
-func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDeviceNetworks", p.toURLValues())
+// lists network that are using a brocade vcs switch.
+//
+// Required params: vcsdeviceid.
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams, opts ...CallOption) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	resp, err := s.cs.newRequest("listBrocadeVcsDeviceNetworks", s.cs.encodeParams("listBrocadeVcsDeviceNetworks", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -560,150 +841,257 @@ type BrocadeVcsDeviceNetworkServiceCapability struct {
 }
 
 type ListBrocadeVcsDevicesParams struct {
-	p map[string]interface{}
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
+	vcsdeviceid       optString
 }
 
-func (p *ListBrocadeVcsDevicesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBrocadeVcsDevicesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["vcsdeviceid"]; found {
-		u.Set("vcsdeviceid", v.(string))
+	if p.vcsdeviceid.ok {
+		u.Set("vcsdeviceid", p.vcsdeviceid.v)
 	}
 	return u
 }
 
-func (p *ListBrocadeVcsDevicesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListBrocadeVcsDevicesParams parses url.Values, for example taken from a raw API request,
+// into a new ListBrocadeVcsDevicesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBrocadeVcsDevicesParams(u url.Values) (*ListBrocadeVcsDevicesParams, error) {
+	p := &ListBrocadeVcsDevicesParams{}
+	if err := checkParamNames("listBrocadeVcsDevices", u, "keyword", "page", "pagesize", "physicalnetworkid", "vcsdeviceid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
 	}
-	p.p["keyword"] = v
+	if _, found := u["vcsdeviceid"]; found {
+		p.SetVcsdeviceid(u.Get("vcsdeviceid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListBrocadeVcsDevicesParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListBrocadeVcsDevicesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListBrocadeVcsDevicesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListBrocadeVcsDevicesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListBrocadeVcsDevicesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListBrocadeVcsDevicesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListBrocadeVcsDevicesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListBrocadeVcsDevicesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListBrocadeVcsDevicesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListBrocadeVcsDevicesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListBrocadeVcsDevicesParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListBrocadeVcsDevicesParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetVcsdeviceid sets the vcsdeviceid param.
 func (p *ListBrocadeVcsDevicesParams) SetVcsdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vcsdeviceid"] = v
+	p.vcsdeviceid = optString{v: v, ok: true}
 }
 
+// ResetVcsdeviceid unsets the vcsdeviceid param
 func (p *ListBrocadeVcsDevicesParams) ResetVcsdeviceid() {
-	if p.p != nil && p.p["vcsdeviceid"] != nil {
-		delete(p.p, "vcsdeviceid")
-	}
+	p.vcsdeviceid = optString{}
 }
 
+// GetVcsdeviceid returns the vcsdeviceid param and if it is set
 func (p *ListBrocadeVcsDevicesParams) GetVcsdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vcsdeviceid.v, p.vcsdeviceid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBrocadeVcsDevicesParams) Clone() *ListBrocadeVcsDevicesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBrocadeVcsDevicesParams) Equal(o *ListBrocadeVcsDevicesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.vcsdeviceid == o.vcsdeviceid
+}
+
+// serializedListBrocadeVcsDevicesParams is used to (un)marshal ListBrocadeVcsDevicesParams using the API param names
+type serializedListBrocadeVcsDevicesParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Vcsdeviceid       *string `json:"vcsdeviceid,omitempty" yaml:"vcsdeviceid,omitempty"`
+}
+
+func (p *ListBrocadeVcsDevicesParams) toSerialized() *serializedListBrocadeVcsDevicesParams {
+	s := &serializedListBrocadeVcsDevicesParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.vcsdeviceid.ok {
+		s.Vcsdeviceid = &p.vcsdeviceid.v
+	}
+	return s
+}
+
+func (p *ListBrocadeVcsDevicesParams) fromSerialized(s *serializedListBrocadeVcsDevicesParams) {
+	*p = ListBrocadeVcsDevicesParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Vcsdeviceid != nil {
+		p.SetVcsdeviceid(*s.Vcsdeviceid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBrocadeVcsDevicesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBrocadeVcsDevicesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBrocadeVcsDevicesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBrocadeVcsDevicesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBrocadeVcsDevicesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBrocadeVcsDevicesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["vcsdeviceid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListBrocadeVcsDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevicesParams {
 	p := &ListBrocadeVcsDevicesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists Brocade VCS Switches
-func (s *BrocadeVCSService) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDevices", p.toURLValues())
+// Lists Brocade VCS Switches.
+func (s *BrocadeVCSService) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams, opts ...CallOption) (*ListBrocadeVcsDevicesResponse, error) {
+	resp, err := s.cs.newRequest("listBrocadeVcsDevices", s.cs.encodeParams("listBrocadeVcsDevices", p), opts...)
 	if err != nil {
 		return nil, err
 	}
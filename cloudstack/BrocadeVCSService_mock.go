@@ -53,33 +53,43 @@ func (m *MockBrocadeVCSServiceIface) EXPECT() *MockBrocadeVCSServiceIfaceMockRec
 }
 
 // AddBrocadeVcsDevice mocks base method.
-func (m *MockBrocadeVCSServiceIface) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
+func (m *MockBrocadeVCSServiceIface) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams, opts ...CallOption) (*AddBrocadeVcsDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBrocadeVcsDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBrocadeVcsDevice", varargs...)
 	ret0, _ := ret[0].(*AddBrocadeVcsDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBrocadeVcsDevice indicates an expected call of AddBrocadeVcsDevice.
-func (mr *MockBrocadeVCSServiceIfaceMockRecorder) AddBrocadeVcsDevice(p interface{}) *gomock.Call {
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) AddBrocadeVcsDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBrocadeVcsDevice", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).AddBrocadeVcsDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBrocadeVcsDevice", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).AddBrocadeVcsDevice), varargs...)
 }
 
 // DeleteBrocadeVcsDevice mocks base method.
-func (m *MockBrocadeVCSServiceIface) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
+func (m *MockBrocadeVCSServiceIface) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams, opts ...CallOption) (*DeleteBrocadeVcsDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBrocadeVcsDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBrocadeVcsDevice", varargs...)
 	ret0, _ := ret[0].(*DeleteBrocadeVcsDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteBrocadeVcsDevice indicates an expected call of DeleteBrocadeVcsDevice.
-func (mr *MockBrocadeVCSServiceIfaceMockRecorder) DeleteBrocadeVcsDevice(p interface{}) *gomock.Call {
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) DeleteBrocadeVcsDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrocadeVcsDevice", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).DeleteBrocadeVcsDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrocadeVcsDevice", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).DeleteBrocadeVcsDevice), varargs...)
 }
 
 // GetBrocadeVcsDeviceNetworkID mocks base method.
@@ -104,33 +114,43 @@ func (mr *MockBrocadeVCSServiceIfaceMockRecorder) GetBrocadeVcsDeviceNetworkID(k
 }
 
 // ListBrocadeVcsDeviceNetworks mocks base method.
-func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams, opts ...CallOption) (*ListBrocadeVcsDeviceNetworksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBrocadeVcsDeviceNetworks", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDeviceNetworks", varargs...)
 	ret0, _ := ret[0].(*ListBrocadeVcsDeviceNetworksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListBrocadeVcsDeviceNetworks indicates an expected call of ListBrocadeVcsDeviceNetworks.
-func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworks(p interface{}) *gomock.Call {
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworks(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworks", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworks), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworks", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworks), varargs...)
 }
 
 // ListBrocadeVcsDevices mocks base method.
-func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams, opts ...CallOption) (*ListBrocadeVcsDevicesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBrocadeVcsDevices", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDevices", varargs...)
 	ret0, _ := ret[0].(*ListBrocadeVcsDevicesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListBrocadeVcsDevices indicates an expected call of ListBrocadeVcsDevices.
-func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevices(p interface{}) *gomock.Call {
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevices(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevices", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevices), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevices", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevices), varargs...)
 }
 
 // NewAddBrocadeVcsDeviceParams mocks base method.
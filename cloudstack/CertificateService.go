@@ -21,161 +21,272 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type CertificateServiceIface interface {
-	UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificate(p *UploadCustomCertificateParams, opts ...CallOption) (*UploadCustomCertificateResponse, error)
 	NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams
 }
 
 type UploadCustomCertificateParams struct {
-	p map[string]interface{}
+	certificate  optString
+	domainsuffix optString
+	id           optInt
+	name         optString
+	privatekey   optString
 }
 
-func (p *UploadCustomCertificateParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UploadCustomCertificateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["certificate"]; found {
-		u.Set("certificate", v.(string))
+	if p.certificate.ok {
+		u.Set("certificate", p.certificate.v)
 	}
-	if v, found := p.p["domainsuffix"]; found {
-		u.Set("domainsuffix", v.(string))
+	if p.domainsuffix.ok {
+		u.Set("domainsuffix", p.domainsuffix.v)
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.Itoa(p.id.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["privatekey"]; found {
-		u.Set("privatekey", v.(string))
+	if p.privatekey.ok {
+		u.Set("privatekey", p.privatekey.v)
 	}
 	return u
 }
 
-func (p *UploadCustomCertificateParams) SetCertificate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUploadCustomCertificateParams parses url.Values, for example taken from a raw API request,
+// into a new UploadCustomCertificateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUploadCustomCertificateParams(u url.Values) (*UploadCustomCertificateParams, error) {
+	p := &UploadCustomCertificateParams{}
+	if err := checkParamNames("uploadCustomCertificate", u, "certificate", "domainsuffix", "id", "name", "privatekey"); err != nil {
+		return nil, err
+	}
+	if _, found := u["certificate"]; found {
+		p.SetCertificate(u.Get("certificate"))
+	}
+	if _, found := u["domainsuffix"]; found {
+		p.SetDomainsuffix(u.Get("domainsuffix"))
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.Atoi(u.Get("id"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
 	}
-	p.p["certificate"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["privatekey"]; found {
+		p.SetPrivatekey(u.Get("privatekey"))
+	}
+	return p, nil
 }
 
+// SetCertificate sets the certificate param. This param is required.
+func (p *UploadCustomCertificateParams) SetCertificate(v string) {
+	p.certificate = optString{v: v, ok: true}
+}
+
+// ResetCertificate unsets the certificate param
 func (p *UploadCustomCertificateParams) ResetCertificate() {
-	if p.p != nil && p.p["certificate"] != nil {
-		delete(p.p, "certificate")
-	}
+	p.certificate = optString{}
 }
 
+// GetCertificate returns the certificate param and if it is set
 func (p *UploadCustomCertificateParams) GetCertificate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["certificate"].(string)
-	return value, ok
+	return p.certificate.v, p.certificate.ok
 }
 
+// SetDomainsuffix sets the domainsuffix param. This param is required.
 func (p *UploadCustomCertificateParams) SetDomainsuffix(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainsuffix"] = v
+	p.domainsuffix = optString{v: v, ok: true}
 }
 
+// ResetDomainsuffix unsets the domainsuffix param
 func (p *UploadCustomCertificateParams) ResetDomainsuffix() {
-	if p.p != nil && p.p["domainsuffix"] != nil {
-		delete(p.p, "domainsuffix")
-	}
+	p.domainsuffix = optString{}
 }
 
+// GetDomainsuffix returns the domainsuffix param and if it is set
 func (p *UploadCustomCertificateParams) GetDomainsuffix() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainsuffix"].(string)
-	return value, ok
+	return p.domainsuffix.v, p.domainsuffix.ok
 }
 
+// SetId sets the id param.
 func (p *UploadCustomCertificateParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optInt{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UploadCustomCertificateParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UploadCustomCertificateParams) GetId() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UploadCustomCertificateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UploadCustomCertificateParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UploadCustomCertificateParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPrivatekey sets the privatekey param.
 func (p *UploadCustomCertificateParams) SetPrivatekey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privatekey"] = v
+	p.privatekey = optString{v: v, ok: true}
 }
 
+// ResetPrivatekey unsets the privatekey param
 func (p *UploadCustomCertificateParams) ResetPrivatekey() {
-	if p.p != nil && p.p["privatekey"] != nil {
-		delete(p.p, "privatekey")
-	}
+	p.privatekey = optString{}
 }
 
+// GetPrivatekey returns the privatekey param and if it is set
 func (p *UploadCustomCertificateParams) GetPrivatekey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.privatekey.v, p.privatekey.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UploadCustomCertificateParams) Clone() *UploadCustomCertificateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UploadCustomCertificateParams) Equal(o *UploadCustomCertificateParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["privatekey"].(string)
-	return value, ok
+	return p.certificate == o.certificate &&
+		p.domainsuffix == o.domainsuffix &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.privatekey == o.privatekey
+}
+
+// serializedUploadCustomCertificateParams is used to (un)marshal UploadCustomCertificateParams using the API param names
+type serializedUploadCustomCertificateParams struct {
+	Certificate  *string `json:"certificate,omitempty" yaml:"certificate,omitempty"`
+	Domainsuffix *string `json:"domainsuffix,omitempty" yaml:"domainsuffix,omitempty"`
+	Id           *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name         *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Privatekey   *string `json:"privatekey,omitempty" yaml:"privatekey,omitempty"`
+}
+
+func (p *UploadCustomCertificateParams) toSerialized() *serializedUploadCustomCertificateParams {
+	s := &serializedUploadCustomCertificateParams{}
+	if p.certificate.ok {
+		s.Certificate = &p.certificate.v
+	}
+	if p.domainsuffix.ok {
+		s.Domainsuffix = &p.domainsuffix.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.privatekey.ok {
+		s.Privatekey = &p.privatekey.v
+	}
+	return s
+}
+
+func (p *UploadCustomCertificateParams) fromSerialized(s *serializedUploadCustomCertificateParams) {
+	*p = UploadCustomCertificateParams{}
+	if s.Certificate != nil {
+		p.SetCertificate(*s.Certificate)
+	}
+	if s.Domainsuffix != nil {
+		p.SetDomainsuffix(*s.Domainsuffix)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Privatekey != nil {
+		p.SetPrivatekey(*s.Privatekey)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UploadCustomCertificateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UploadCustomCertificateParams) UnmarshalJSON(b []byte) error {
+	var s serializedUploadCustomCertificateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UploadCustomCertificateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UploadCustomCertificateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUploadCustomCertificateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UploadCustomCertificateParams instance,
 // as then you are sure you have configured all required params
 func (s *CertificateService) NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams {
 	p := &UploadCustomCertificateParams{}
-	p.p = make(map[string]interface{})
-	p.p["certificate"] = certificate
-	p.p["domainsuffix"] = domainsuffix
+	p.SetCertificate(certificate)
+	p.SetDomainsuffix(domainsuffix)
 	return p
 }
 
-// Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a single certificate signed by a known CA. Can also be used, through multiple calls, to upload a chain of certificates from CA to the custom certificate itself.
-func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
-	resp, err := s.cs.newRequest("uploadCustomCertificate", p.toURLValues())
+// Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a
+// single certificate signed by a known CA. Can also be used, through multiple calls, to upload a
+// chain of certificates from CA to the custom certificate itself.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: certificate,
+// domainsuffix.
+func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams, opts ...CallOption) (*UploadCustomCertificateResponse, error) {
+	resp, err := s.cs.newRequest("uploadCustomCertificate", s.cs.encodeParams("uploadCustomCertificate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -186,8 +297,8 @@ func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateP
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
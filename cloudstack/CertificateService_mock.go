@@ -67,16 +67,21 @@ func (mr *MockCertificateServiceIfaceMockRecorder) NewUploadCustomCertificatePar
 }
 
 // UploadCustomCertificate mocks base method.
-func (m *MockCertificateServiceIface) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
+func (m *MockCertificateServiceIface) UploadCustomCertificate(p *UploadCustomCertificateParams, opts ...CallOption) (*UploadCustomCertificateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadCustomCertificate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadCustomCertificate", varargs...)
 	ret0, _ := ret[0].(*UploadCustomCertificateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadCustomCertificate indicates an expected call of UploadCustomCertificate.
-func (mr *MockCertificateServiceIfaceMockRecorder) UploadCustomCertificate(p interface{}) *gomock.Call {
+func (mr *MockCertificateServiceIfaceMockRecorder) UploadCustomCertificate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCustomCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadCustomCertificate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCustomCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadCustomCertificate), varargs...)
 }
@@ -25,58 +25,137 @@ import (
 )
 
 type CloudIdentifierServiceIface interface {
-	GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+	GetCloudIdentifier(p *GetCloudIdentifierParams, opts ...CallOption) (*GetCloudIdentifierResponse, error)
 	NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams
 }
 
 type GetCloudIdentifierParams struct {
-	p map[string]interface{}
+	userid optString
 }
 
-func (p *GetCloudIdentifierParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetCloudIdentifierParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *GetCloudIdentifierParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetCloudIdentifierParams parses url.Values, for example taken from a raw API request,
+// into a new GetCloudIdentifierParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetCloudIdentifierParams(u url.Values) (*GetCloudIdentifierParams, error) {
+	p := &GetCloudIdentifierParams{}
+	if err := checkParamNames("getCloudIdentifier", u, "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
 	}
-	p.p["userid"] = v
+	return p, nil
 }
 
+// SetUserid sets the userid param. This param is required.
+func (p *GetCloudIdentifierParams) SetUserid(v string) {
+	p.userid = optString{v: v, ok: true}
+}
+
+// ResetUserid unsets the userid param
 func (p *GetCloudIdentifierParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *GetCloudIdentifierParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetCloudIdentifierParams) Clone() *GetCloudIdentifierParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetCloudIdentifierParams) Equal(o *GetCloudIdentifierParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.userid == o.userid
+}
+
+// serializedGetCloudIdentifierParams is used to (un)marshal GetCloudIdentifierParams using the API param names
+type serializedGetCloudIdentifierParams struct {
+	Userid *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *GetCloudIdentifierParams) toSerialized() *serializedGetCloudIdentifierParams {
+	s := &serializedGetCloudIdentifierParams{}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *GetCloudIdentifierParams) fromSerialized(s *serializedGetCloudIdentifierParams) {
+	*p = GetCloudIdentifierParams{}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetCloudIdentifierParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetCloudIdentifierParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetCloudIdentifierParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetCloudIdentifierParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetCloudIdentifierParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetCloudIdentifierParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetCloudIdentifierParams instance,
 // as then you are sure you have configured all required params
 func (s *CloudIdentifierService) NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams {
 	p := &GetCloudIdentifierParams{}
-	p.p = make(map[string]interface{})
-	p.p["userid"] = userid
+	p.SetUserid(userid)
 	return p
 }
 
 // Retrieves a cloud identifier.
-func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
-	resp, err := s.cs.newRequest("getCloudIdentifier", p.toURLValues())
+//
+// Required params: userid.
+func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams, opts ...CallOption) (*GetCloudIdentifierResponse, error) {
+	resp, err := s.cs.newRequest("getCloudIdentifier", s.cs.encodeParams("getCloudIdentifier", p), opts...)
 	if err != nil {
 		return nil, err
 	}
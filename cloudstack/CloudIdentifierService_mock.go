@@ -53,18 +53,23 @@ func (m *MockCloudIdentifierServiceIface) EXPECT() *MockCloudIdentifierServiceIf
 }
 
 // GetCloudIdentifier mocks base method.
-func (m *MockCloudIdentifierServiceIface) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
+func (m *MockCloudIdentifierServiceIface) GetCloudIdentifier(p *GetCloudIdentifierParams, opts ...CallOption) (*GetCloudIdentifierResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCloudIdentifier", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCloudIdentifier", varargs...)
 	ret0, _ := ret[0].(*GetCloudIdentifierResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCloudIdentifier indicates an expected call of GetCloudIdentifier.
-func (mr *MockCloudIdentifierServiceIfaceMockRecorder) GetCloudIdentifier(p interface{}) *gomock.Call {
+func (mr *MockCloudIdentifierServiceIfaceMockRecorder) GetCloudIdentifier(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudIdentifier", reflect.TypeOf((*MockCloudIdentifierServiceIface)(nil).GetCloudIdentifier), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudIdentifier", reflect.TypeOf((*MockCloudIdentifierServiceIface)(nil).GetCloudIdentifier), varargs...)
 }
 
 // NewGetCloudIdentifierParams mocks base method.
@@ -0,0 +1,499 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CloudStackClientIface describes all methods of the CloudStackClient. Code that accepts
+// this interface instead of a *CloudStackClient can be tested using a MockCloudStackClientIface.
+// The services are available through accessor methods, for example VirtualMachineService().
+// Scoped clients are created using the ForProject, ForDomain and ForAccount methods of the
+// concrete client.
+type CloudStackClientIface interface {
+	AsyncTimeout(timeoutInSeconds int64)
+	Timeout(timeout time.Duration)
+	DefaultOptions(options ...OptionFunc)
+	GetAsyncJobResult(jobid string, timeout int64, opts ...CallOption) (json.RawMessage, error)
+	DiscoverAPIs() (*APICapabilities, error)
+	APICapabilities() (*APICapabilities, error)
+	Supports(command, param string) bool
+	DetectServerVersion() (string, error)
+	ServerVersion() string
+	LatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error)
+	RestoreFromLatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error)
+	AccountQuotaStatement(account, domainid string, start, end time.Time, opts ...CallOption) (*AccountQuotaStatement, error)
+	GetUserDataMaxLength(opts ...CallOption) (int, error)
+
+	APIDiscoveryService() APIDiscoveryServiceIface
+	AccountService() AccountServiceIface
+	AddressService() AddressServiceIface
+	AffinityGroupService() AffinityGroupServiceIface
+	AlertService() AlertServiceIface
+	AnnotationService() AnnotationServiceIface
+	AsyncjobService() AsyncjobServiceIface
+	AuthenticationService() AuthenticationServiceIface
+	AutoScaleService() AutoScaleServiceIface
+	BackupService() BackupServiceIface
+	BaremetalService() BaremetalServiceIface
+	BigSwitchBCFService() BigSwitchBCFServiceIface
+	BrocadeVCSService() BrocadeVCSServiceIface
+	CertificateService() CertificateServiceIface
+	CloudIdentifierService() CloudIdentifierServiceIface
+	ClusterService() ClusterServiceIface
+	ConfigurationService() ConfigurationServiceIface
+	ConsoleEndpointService() ConsoleEndpointServiceIface
+	CustomService() CustomServiceIface
+	DiskOfferingService() DiskOfferingServiceIface
+	DomainService() DomainServiceIface
+	EventService() EventServiceIface
+	FirewallService() FirewallServiceIface
+	GuestOSService() GuestOSServiceIface
+	HostService() HostServiceIface
+	HypervisorService() HypervisorServiceIface
+	ISOService() ISOServiceIface
+	ImageStoreService() ImageStoreServiceIface
+	InfrastructureUsageService() InfrastructureUsageServiceIface
+	InternalLBService() InternalLBServiceIface
+	KubernetesService() KubernetesServiceIface
+	LDAPService() LDAPServiceIface
+	LimitService() LimitServiceIface
+	LoadBalancerService() LoadBalancerServiceIface
+	NATService() NATServiceIface
+	NetworkACLService() NetworkACLServiceIface
+	NetworkDeviceService() NetworkDeviceServiceIface
+	NetworkOfferingService() NetworkOfferingServiceIface
+	NetworkService() NetworkServiceIface
+	NicService() NicServiceIface
+	NiciraNVPService() NiciraNVPServiceIface
+	ObjectStoreService() ObjectStoreServiceIface
+	OutofbandManagementService() OutofbandManagementServiceIface
+	OvsElementService() OvsElementServiceIface
+	PodService() PodServiceIface
+	PoolService() PoolServiceIface
+	PortableIPService() PortableIPServiceIface
+	ProjectService() ProjectServiceIface
+	QuotaService() QuotaServiceIface
+	RegionService() RegionServiceIface
+	ResourcemetadataService() ResourcemetadataServiceIface
+	ResourcetagsService() ResourcetagsServiceIface
+	RoleService() RoleServiceIface
+	RouterService() RouterServiceIface
+	SSHService() SSHServiceIface
+	SecurityGroupService() SecurityGroupServiceIface
+	ServiceOfferingService() ServiceOfferingServiceIface
+	SnapshotService() SnapshotServiceIface
+	StoragePoolService() StoragePoolServiceIface
+	StratosphereSSPService() StratosphereSSPServiceIface
+	SwiftService() SwiftServiceIface
+	SystemCapacityService() SystemCapacityServiceIface
+	SystemVMService() SystemVMServiceIface
+	TemplateService() TemplateServiceIface
+	UCSService() UCSServiceIface
+	UsageService() UsageServiceIface
+	UserDataService() UserDataServiceIface
+	UserService() UserServiceIface
+	VLANService() VLANServiceIface
+	VMGroupService() VMGroupServiceIface
+	VPCService() VPCServiceIface
+	VPNService() VPNServiceIface
+	VirtualMachineService() VirtualMachineServiceIface
+	VolumeService() VolumeServiceIface
+	ZoneService() ZoneServiceIface
+}
+
+var _ CloudStackClientIface = &CloudStackClient{}
+
+// APIDiscoveryService returns the APIDiscoveryService of the client
+func (cs *CloudStackClient) APIDiscoveryService() APIDiscoveryServiceIface {
+	return cs.APIDiscovery
+}
+
+// AccountService returns the AccountService of the client
+func (cs *CloudStackClient) AccountService() AccountServiceIface {
+	return cs.Account
+}
+
+// AddressService returns the AddressService of the client
+func (cs *CloudStackClient) AddressService() AddressServiceIface {
+	return cs.Address
+}
+
+// AffinityGroupService returns the AffinityGroupService of the client
+func (cs *CloudStackClient) AffinityGroupService() AffinityGroupServiceIface {
+	return cs.AffinityGroup
+}
+
+// AlertService returns the AlertService of the client
+func (cs *CloudStackClient) AlertService() AlertServiceIface {
+	return cs.Alert
+}
+
+// AnnotationService returns the AnnotationService of the client
+func (cs *CloudStackClient) AnnotationService() AnnotationServiceIface {
+	return cs.Annotation
+}
+
+// AsyncjobService returns the AsyncjobService of the client
+func (cs *CloudStackClient) AsyncjobService() AsyncjobServiceIface {
+	return cs.Asyncjob
+}
+
+// AuthenticationService returns the AuthenticationService of the client
+func (cs *CloudStackClient) AuthenticationService() AuthenticationServiceIface {
+	return cs.Authentication
+}
+
+// AutoScaleService returns the AutoScaleService of the client
+func (cs *CloudStackClient) AutoScaleService() AutoScaleServiceIface {
+	return cs.AutoScale
+}
+
+// BackupService returns the BackupService of the client
+func (cs *CloudStackClient) BackupService() BackupServiceIface {
+	return cs.Backup
+}
+
+// BaremetalService returns the BaremetalService of the client
+func (cs *CloudStackClient) BaremetalService() BaremetalServiceIface {
+	return cs.Baremetal
+}
+
+// BigSwitchBCFService returns the BigSwitchBCFService of the client
+func (cs *CloudStackClient) BigSwitchBCFService() BigSwitchBCFServiceIface {
+	return cs.BigSwitchBCF
+}
+
+// BrocadeVCSService returns the BrocadeVCSService of the client
+func (cs *CloudStackClient) BrocadeVCSService() BrocadeVCSServiceIface {
+	return cs.BrocadeVCS
+}
+
+// CertificateService returns the CertificateService of the client
+func (cs *CloudStackClient) CertificateService() CertificateServiceIface {
+	return cs.Certificate
+}
+
+// CloudIdentifierService returns the CloudIdentifierService of the client
+func (cs *CloudStackClient) CloudIdentifierService() CloudIdentifierServiceIface {
+	return cs.CloudIdentifier
+}
+
+// ClusterService returns the ClusterService of the client
+func (cs *CloudStackClient) ClusterService() ClusterServiceIface {
+	return cs.Cluster
+}
+
+// ConfigurationService returns the ConfigurationService of the client
+func (cs *CloudStackClient) ConfigurationService() ConfigurationServiceIface {
+	return cs.Configuration
+}
+
+// ConsoleEndpointService returns the ConsoleEndpointService of the client
+func (cs *CloudStackClient) ConsoleEndpointService() ConsoleEndpointServiceIface {
+	return cs.ConsoleEndpoint
+}
+
+// CustomService returns the CustomService of the client
+func (cs *CloudStackClient) CustomService() CustomServiceIface {
+	return cs.Custom
+}
+
+// DiskOfferingService returns the DiskOfferingService of the client
+func (cs *CloudStackClient) DiskOfferingService() DiskOfferingServiceIface {
+	return cs.DiskOffering
+}
+
+// DomainService returns the DomainService of the client
+func (cs *CloudStackClient) DomainService() DomainServiceIface {
+	return cs.Domain
+}
+
+// EventService returns the EventService of the client
+func (cs *CloudStackClient) EventService() EventServiceIface {
+	return cs.Event
+}
+
+// FirewallService returns the FirewallService of the client
+func (cs *CloudStackClient) FirewallService() FirewallServiceIface {
+	return cs.Firewall
+}
+
+// GuestOSService returns the GuestOSService of the client
+func (cs *CloudStackClient) GuestOSService() GuestOSServiceIface {
+	return cs.GuestOS
+}
+
+// HostService returns the HostService of the client
+func (cs *CloudStackClient) HostService() HostServiceIface {
+	return cs.Host
+}
+
+// HypervisorService returns the HypervisorService of the client
+func (cs *CloudStackClient) HypervisorService() HypervisorServiceIface {
+	return cs.Hypervisor
+}
+
+// ISOService returns the ISOService of the client
+func (cs *CloudStackClient) ISOService() ISOServiceIface {
+	return cs.ISO
+}
+
+// ImageStoreService returns the ImageStoreService of the client
+func (cs *CloudStackClient) ImageStoreService() ImageStoreServiceIface {
+	return cs.ImageStore
+}
+
+// InfrastructureUsageService returns the InfrastructureUsageService of the client
+func (cs *CloudStackClient) InfrastructureUsageService() InfrastructureUsageServiceIface {
+	return cs.InfrastructureUsage
+}
+
+// InternalLBService returns the InternalLBService of the client
+func (cs *CloudStackClient) InternalLBService() InternalLBServiceIface {
+	return cs.InternalLB
+}
+
+// KubernetesService returns the KubernetesService of the client
+func (cs *CloudStackClient) KubernetesService() KubernetesServiceIface {
+	return cs.Kubernetes
+}
+
+// LDAPService returns the LDAPService of the client
+func (cs *CloudStackClient) LDAPService() LDAPServiceIface {
+	return cs.LDAP
+}
+
+// LimitService returns the LimitService of the client
+func (cs *CloudStackClient) LimitService() LimitServiceIface {
+	return cs.Limit
+}
+
+// LoadBalancerService returns the LoadBalancerService of the client
+func (cs *CloudStackClient) LoadBalancerService() LoadBalancerServiceIface {
+	return cs.LoadBalancer
+}
+
+// NATService returns the NATService of the client
+func (cs *CloudStackClient) NATService() NATServiceIface {
+	return cs.NAT
+}
+
+// NetworkACLService returns the NetworkACLService of the client
+func (cs *CloudStackClient) NetworkACLService() NetworkACLServiceIface {
+	return cs.NetworkACL
+}
+
+// NetworkDeviceService returns the NetworkDeviceService of the client
+func (cs *CloudStackClient) NetworkDeviceService() NetworkDeviceServiceIface {
+	return cs.NetworkDevice
+}
+
+// NetworkOfferingService returns the NetworkOfferingService of the client
+func (cs *CloudStackClient) NetworkOfferingService() NetworkOfferingServiceIface {
+	return cs.NetworkOffering
+}
+
+// NetworkService returns the NetworkService of the client
+func (cs *CloudStackClient) NetworkService() NetworkServiceIface {
+	return cs.Network
+}
+
+// NicService returns the NicService of the client
+func (cs *CloudStackClient) NicService() NicServiceIface {
+	return cs.Nic
+}
+
+// NiciraNVPService returns the NiciraNVPService of the client
+func (cs *CloudStackClient) NiciraNVPService() NiciraNVPServiceIface {
+	return cs.NiciraNVP
+}
+
+// ObjectStoreService returns the ObjectStoreService of the client
+func (cs *CloudStackClient) ObjectStoreService() ObjectStoreServiceIface {
+	return cs.ObjectStore
+}
+
+// OutofbandManagementService returns the OutofbandManagementService of the client
+func (cs *CloudStackClient) OutofbandManagementService() OutofbandManagementServiceIface {
+	return cs.OutofbandManagement
+}
+
+// OvsElementService returns the OvsElementService of the client
+func (cs *CloudStackClient) OvsElementService() OvsElementServiceIface {
+	return cs.OvsElement
+}
+
+// PodService returns the PodService of the client
+func (cs *CloudStackClient) PodService() PodServiceIface {
+	return cs.Pod
+}
+
+// PoolService returns the PoolService of the client
+func (cs *CloudStackClient) PoolService() PoolServiceIface {
+	return cs.Pool
+}
+
+// PortableIPService returns the PortableIPService of the client
+func (cs *CloudStackClient) PortableIPService() PortableIPServiceIface {
+	return cs.PortableIP
+}
+
+// ProjectService returns the ProjectService of the client
+func (cs *CloudStackClient) ProjectService() ProjectServiceIface {
+	return cs.Project
+}
+
+// QuotaService returns the QuotaService of the client
+func (cs *CloudStackClient) QuotaService() QuotaServiceIface {
+	return cs.Quota
+}
+
+// RegionService returns the RegionService of the client
+func (cs *CloudStackClient) RegionService() RegionServiceIface {
+	return cs.Region
+}
+
+// ResourcemetadataService returns the ResourcemetadataService of the client
+func (cs *CloudStackClient) ResourcemetadataService() ResourcemetadataServiceIface {
+	return cs.Resourcemetadata
+}
+
+// ResourcetagsService returns the ResourcetagsService of the client
+func (cs *CloudStackClient) ResourcetagsService() ResourcetagsServiceIface {
+	return cs.Resourcetags
+}
+
+// RoleService returns the RoleService of the client
+func (cs *CloudStackClient) RoleService() RoleServiceIface {
+	return cs.Role
+}
+
+// RouterService returns the RouterService of the client
+func (cs *CloudStackClient) RouterService() RouterServiceIface {
+	return cs.Router
+}
+
+// SSHService returns the SSHService of the client
+func (cs *CloudStackClient) SSHService() SSHServiceIface {
+	return cs.SSH
+}
+
+// SecurityGroupService returns the SecurityGroupService of the client
+func (cs *CloudStackClient) SecurityGroupService() SecurityGroupServiceIface {
+	return cs.SecurityGroup
+}
+
+// ServiceOfferingService returns the ServiceOfferingService of the client
+func (cs *CloudStackClient) ServiceOfferingService() ServiceOfferingServiceIface {
+	return cs.ServiceOffering
+}
+
+// SnapshotService returns the SnapshotService of the client
+func (cs *CloudStackClient) SnapshotService() SnapshotServiceIface {
+	return cs.Snapshot
+}
+
+// StoragePoolService returns the StoragePoolService of the client
+func (cs *CloudStackClient) StoragePoolService() StoragePoolServiceIface {
+	return cs.StoragePool
+}
+
+// StratosphereSSPService returns the StratosphereSSPService of the client
+func (cs *CloudStackClient) StratosphereSSPService() StratosphereSSPServiceIface {
+	return cs.StratosphereSSP
+}
+
+// SwiftService returns the SwiftService of the client
+func (cs *CloudStackClient) SwiftService() SwiftServiceIface {
+	return cs.Swift
+}
+
+// SystemCapacityService returns the SystemCapacityService of the client
+func (cs *CloudStackClient) SystemCapacityService() SystemCapacityServiceIface {
+	return cs.SystemCapacity
+}
+
+// SystemVMService returns the SystemVMService of the client
+func (cs *CloudStackClient) SystemVMService() SystemVMServiceIface {
+	return cs.SystemVM
+}
+
+// TemplateService returns the TemplateService of the client
+func (cs *CloudStackClient) TemplateService() TemplateServiceIface {
+	return cs.Template
+}
+
+// UCSService returns the UCSService of the client
+func (cs *CloudStackClient) UCSService() UCSServiceIface {
+	return cs.UCS
+}
+
+// UsageService returns the UsageService of the client
+func (cs *CloudStackClient) UsageService() UsageServiceIface {
+	return cs.Usage
+}
+
+// UserDataService returns the UserDataService of the client
+func (cs *CloudStackClient) UserDataService() UserDataServiceIface {
+	return cs.UserData
+}
+
+// UserService returns the UserService of the client
+func (cs *CloudStackClient) UserService() UserServiceIface {
+	return cs.User
+}
+
+// VLANService returns the VLANService of the client
+func (cs *CloudStackClient) VLANService() VLANServiceIface {
+	return cs.VLAN
+}
+
+// VMGroupService returns the VMGroupService of the client
+func (cs *CloudStackClient) VMGroupService() VMGroupServiceIface {
+	return cs.VMGroup
+}
+
+// VPCService returns the VPCService of the client
+func (cs *CloudStackClient) VPCService() VPCServiceIface {
+	return cs.VPC
+}
+
+// VPNService returns the VPNService of the client
+func (cs *CloudStackClient) VPNService() VPNServiceIface {
+	return cs.VPN
+}
+
+// VirtualMachineService returns the VirtualMachineService of the client
+func (cs *CloudStackClient) VirtualMachineService() VirtualMachineServiceIface {
+	return cs.VirtualMachine
+}
+
+// VolumeService returns the VolumeService of the client
+func (cs *CloudStackClient) VolumeService() VolumeServiceIface {
+	return cs.Volume
+}
+
+// ZoneService returns the ZoneService of the client
+func (cs *CloudStackClient) ZoneService() ZoneServiceIface {
+	return cs.Zone
+}
@@ -0,0 +1,1317 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./cloudstack/CloudStackClient.go
+
+// Package cloudstack is a generated GoMock package.
+package cloudstack
+
+import (
+	json "encoding/json"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCloudStackClientIface is a mock of CloudStackClientIface interface.
+type MockCloudStackClientIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudStackClientIfaceMockRecorder
+}
+
+// MockCloudStackClientIfaceMockRecorder is the mock recorder for MockCloudStackClientIface.
+type MockCloudStackClientIfaceMockRecorder struct {
+	mock *MockCloudStackClientIface
+}
+
+// NewMockCloudStackClientIface creates a new mock instance.
+func NewMockCloudStackClientIface(ctrl *gomock.Controller) *MockCloudStackClientIface {
+	mock := &MockCloudStackClientIface{ctrl: ctrl}
+	mock.recorder = &MockCloudStackClientIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudStackClientIface) EXPECT() *MockCloudStackClientIfaceMockRecorder {
+	return m.recorder
+}
+
+// APICapabilities mocks base method.
+func (m *MockCloudStackClientIface) APICapabilities() (*APICapabilities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APICapabilities")
+	ret0, _ := ret[0].(*APICapabilities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APICapabilities indicates an expected call of APICapabilities.
+func (mr *MockCloudStackClientIfaceMockRecorder) APICapabilities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APICapabilities", reflect.TypeOf((*MockCloudStackClientIface)(nil).APICapabilities))
+}
+
+// APIDiscoveryService mocks base method.
+func (m *MockCloudStackClientIface) APIDiscoveryService() APIDiscoveryServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIDiscoveryService")
+	ret0, _ := ret[0].(APIDiscoveryServiceIface)
+	return ret0
+}
+
+// APIDiscoveryService indicates an expected call of APIDiscoveryService.
+func (mr *MockCloudStackClientIfaceMockRecorder) APIDiscoveryService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIDiscoveryService", reflect.TypeOf((*MockCloudStackClientIface)(nil).APIDiscoveryService))
+}
+
+// AccountQuotaStatement mocks base method.
+func (m *MockCloudStackClientIface) AccountQuotaStatement(account, domainid string, start, end time.Time, opts ...CallOption) (*AccountQuotaStatement, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{account, domainid, start, end}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AccountQuotaStatement", varargs...)
+	ret0, _ := ret[0].(*AccountQuotaStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountQuotaStatement indicates an expected call of AccountQuotaStatement.
+func (mr *MockCloudStackClientIfaceMockRecorder) AccountQuotaStatement(account, domainid, start, end interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{account, domainid, start, end}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountQuotaStatement", reflect.TypeOf((*MockCloudStackClientIface)(nil).AccountQuotaStatement), varargs...)
+}
+
+// AccountService mocks base method.
+func (m *MockCloudStackClientIface) AccountService() AccountServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountService")
+	ret0, _ := ret[0].(AccountServiceIface)
+	return ret0
+}
+
+// AccountService indicates an expected call of AccountService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AccountService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AccountService))
+}
+
+// AddressService mocks base method.
+func (m *MockCloudStackClientIface) AddressService() AddressServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressService")
+	ret0, _ := ret[0].(AddressServiceIface)
+	return ret0
+}
+
+// AddressService indicates an expected call of AddressService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AddressService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AddressService))
+}
+
+// AffinityGroupService mocks base method.
+func (m *MockCloudStackClientIface) AffinityGroupService() AffinityGroupServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AffinityGroupService")
+	ret0, _ := ret[0].(AffinityGroupServiceIface)
+	return ret0
+}
+
+// AffinityGroupService indicates an expected call of AffinityGroupService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AffinityGroupService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AffinityGroupService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AffinityGroupService))
+}
+
+// AlertService mocks base method.
+func (m *MockCloudStackClientIface) AlertService() AlertServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertService")
+	ret0, _ := ret[0].(AlertServiceIface)
+	return ret0
+}
+
+// AlertService indicates an expected call of AlertService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AlertService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AlertService))
+}
+
+// AnnotationService mocks base method.
+func (m *MockCloudStackClientIface) AnnotationService() AnnotationServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnotationService")
+	ret0, _ := ret[0].(AnnotationServiceIface)
+	return ret0
+}
+
+// AnnotationService indicates an expected call of AnnotationService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AnnotationService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotationService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AnnotationService))
+}
+
+// AsyncTimeout mocks base method.
+func (m *MockCloudStackClientIface) AsyncTimeout(timeoutInSeconds int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AsyncTimeout", timeoutInSeconds)
+}
+
+// AsyncTimeout indicates an expected call of AsyncTimeout.
+func (mr *MockCloudStackClientIfaceMockRecorder) AsyncTimeout(timeoutInSeconds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncTimeout", reflect.TypeOf((*MockCloudStackClientIface)(nil).AsyncTimeout), timeoutInSeconds)
+}
+
+// AsyncjobService mocks base method.
+func (m *MockCloudStackClientIface) AsyncjobService() AsyncjobServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AsyncjobService")
+	ret0, _ := ret[0].(AsyncjobServiceIface)
+	return ret0
+}
+
+// AsyncjobService indicates an expected call of AsyncjobService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AsyncjobService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncjobService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AsyncjobService))
+}
+
+// AuthenticationService mocks base method.
+func (m *MockCloudStackClientIface) AuthenticationService() AuthenticationServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticationService")
+	ret0, _ := ret[0].(AuthenticationServiceIface)
+	return ret0
+}
+
+// AuthenticationService indicates an expected call of AuthenticationService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AuthenticationService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticationService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AuthenticationService))
+}
+
+// AutoScaleService mocks base method.
+func (m *MockCloudStackClientIface) AutoScaleService() AutoScaleServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoScaleService")
+	ret0, _ := ret[0].(AutoScaleServiceIface)
+	return ret0
+}
+
+// AutoScaleService indicates an expected call of AutoScaleService.
+func (mr *MockCloudStackClientIfaceMockRecorder) AutoScaleService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoScaleService", reflect.TypeOf((*MockCloudStackClientIface)(nil).AutoScaleService))
+}
+
+// BackupService mocks base method.
+func (m *MockCloudStackClientIface) BackupService() BackupServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupService")
+	ret0, _ := ret[0].(BackupServiceIface)
+	return ret0
+}
+
+// BackupService indicates an expected call of BackupService.
+func (mr *MockCloudStackClientIfaceMockRecorder) BackupService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupService", reflect.TypeOf((*MockCloudStackClientIface)(nil).BackupService))
+}
+
+// BaremetalService mocks base method.
+func (m *MockCloudStackClientIface) BaremetalService() BaremetalServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaremetalService")
+	ret0, _ := ret[0].(BaremetalServiceIface)
+	return ret0
+}
+
+// BaremetalService indicates an expected call of BaremetalService.
+func (mr *MockCloudStackClientIfaceMockRecorder) BaremetalService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaremetalService", reflect.TypeOf((*MockCloudStackClientIface)(nil).BaremetalService))
+}
+
+// BigSwitchBCFService mocks base method.
+func (m *MockCloudStackClientIface) BigSwitchBCFService() BigSwitchBCFServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BigSwitchBCFService")
+	ret0, _ := ret[0].(BigSwitchBCFServiceIface)
+	return ret0
+}
+
+// BigSwitchBCFService indicates an expected call of BigSwitchBCFService.
+func (mr *MockCloudStackClientIfaceMockRecorder) BigSwitchBCFService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BigSwitchBCFService", reflect.TypeOf((*MockCloudStackClientIface)(nil).BigSwitchBCFService))
+}
+
+// BrocadeVCSService mocks base method.
+func (m *MockCloudStackClientIface) BrocadeVCSService() BrocadeVCSServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BrocadeVCSService")
+	ret0, _ := ret[0].(BrocadeVCSServiceIface)
+	return ret0
+}
+
+// BrocadeVCSService indicates an expected call of BrocadeVCSService.
+func (mr *MockCloudStackClientIfaceMockRecorder) BrocadeVCSService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BrocadeVCSService", reflect.TypeOf((*MockCloudStackClientIface)(nil).BrocadeVCSService))
+}
+
+// CertificateService mocks base method.
+func (m *MockCloudStackClientIface) CertificateService() CertificateServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertificateService")
+	ret0, _ := ret[0].(CertificateServiceIface)
+	return ret0
+}
+
+// CertificateService indicates an expected call of CertificateService.
+func (mr *MockCloudStackClientIfaceMockRecorder) CertificateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertificateService", reflect.TypeOf((*MockCloudStackClientIface)(nil).CertificateService))
+}
+
+// CloudIdentifierService mocks base method.
+func (m *MockCloudStackClientIface) CloudIdentifierService() CloudIdentifierServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudIdentifierService")
+	ret0, _ := ret[0].(CloudIdentifierServiceIface)
+	return ret0
+}
+
+// CloudIdentifierService indicates an expected call of CloudIdentifierService.
+func (mr *MockCloudStackClientIfaceMockRecorder) CloudIdentifierService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudIdentifierService", reflect.TypeOf((*MockCloudStackClientIface)(nil).CloudIdentifierService))
+}
+
+// ClusterService mocks base method.
+func (m *MockCloudStackClientIface) ClusterService() ClusterServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterService")
+	ret0, _ := ret[0].(ClusterServiceIface)
+	return ret0
+}
+
+// ClusterService indicates an expected call of ClusterService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ClusterService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ClusterService))
+}
+
+// ConfigurationService mocks base method.
+func (m *MockCloudStackClientIface) ConfigurationService() ConfigurationServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigurationService")
+	ret0, _ := ret[0].(ConfigurationServiceIface)
+	return ret0
+}
+
+// ConfigurationService indicates an expected call of ConfigurationService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ConfigurationService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigurationService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ConfigurationService))
+}
+
+// ConsoleEndpointService mocks base method.
+func (m *MockCloudStackClientIface) ConsoleEndpointService() ConsoleEndpointServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsoleEndpointService")
+	ret0, _ := ret[0].(ConsoleEndpointServiceIface)
+	return ret0
+}
+
+// ConsoleEndpointService indicates an expected call of ConsoleEndpointService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ConsoleEndpointService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsoleEndpointService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ConsoleEndpointService))
+}
+
+// CustomService mocks base method.
+func (m *MockCloudStackClientIface) CustomService() CustomServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomService")
+	ret0, _ := ret[0].(CustomServiceIface)
+	return ret0
+}
+
+// CustomService indicates an expected call of CustomService.
+func (mr *MockCloudStackClientIfaceMockRecorder) CustomService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomService", reflect.TypeOf((*MockCloudStackClientIface)(nil).CustomService))
+}
+
+// DefaultOptions mocks base method.
+func (m *MockCloudStackClientIface) DefaultOptions(options ...OptionFunc) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "DefaultOptions", varargs...)
+}
+
+// DefaultOptions indicates an expected call of DefaultOptions.
+func (mr *MockCloudStackClientIfaceMockRecorder) DefaultOptions(options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultOptions", reflect.TypeOf((*MockCloudStackClientIface)(nil).DefaultOptions), options...)
+}
+
+// DetectServerVersion mocks base method.
+func (m *MockCloudStackClientIface) DetectServerVersion() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectServerVersion")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectServerVersion indicates an expected call of DetectServerVersion.
+func (mr *MockCloudStackClientIfaceMockRecorder) DetectServerVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectServerVersion", reflect.TypeOf((*MockCloudStackClientIface)(nil).DetectServerVersion))
+}
+
+// DiscoverAPIs mocks base method.
+func (m *MockCloudStackClientIface) DiscoverAPIs() (*APICapabilities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverAPIs")
+	ret0, _ := ret[0].(*APICapabilities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverAPIs indicates an expected call of DiscoverAPIs.
+func (mr *MockCloudStackClientIfaceMockRecorder) DiscoverAPIs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverAPIs", reflect.TypeOf((*MockCloudStackClientIface)(nil).DiscoverAPIs))
+}
+
+// DiskOfferingService mocks base method.
+func (m *MockCloudStackClientIface) DiskOfferingService() DiskOfferingServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiskOfferingService")
+	ret0, _ := ret[0].(DiskOfferingServiceIface)
+	return ret0
+}
+
+// DiskOfferingService indicates an expected call of DiskOfferingService.
+func (mr *MockCloudStackClientIfaceMockRecorder) DiskOfferingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiskOfferingService", reflect.TypeOf((*MockCloudStackClientIface)(nil).DiskOfferingService))
+}
+
+// DomainService mocks base method.
+func (m *MockCloudStackClientIface) DomainService() DomainServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainService")
+	ret0, _ := ret[0].(DomainServiceIface)
+	return ret0
+}
+
+// DomainService indicates an expected call of DomainService.
+func (mr *MockCloudStackClientIfaceMockRecorder) DomainService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainService", reflect.TypeOf((*MockCloudStackClientIface)(nil).DomainService))
+}
+
+// EventService mocks base method.
+func (m *MockCloudStackClientIface) EventService() EventServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventService")
+	ret0, _ := ret[0].(EventServiceIface)
+	return ret0
+}
+
+// EventService indicates an expected call of EventService.
+func (mr *MockCloudStackClientIfaceMockRecorder) EventService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventService", reflect.TypeOf((*MockCloudStackClientIface)(nil).EventService))
+}
+
+// FirewallService mocks base method.
+func (m *MockCloudStackClientIface) FirewallService() FirewallServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallService")
+	ret0, _ := ret[0].(FirewallServiceIface)
+	return ret0
+}
+
+// FirewallService indicates an expected call of FirewallService.
+func (mr *MockCloudStackClientIfaceMockRecorder) FirewallService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallService", reflect.TypeOf((*MockCloudStackClientIface)(nil).FirewallService))
+}
+
+// GetAsyncJobResult mocks base method.
+func (m *MockCloudStackClientIface) GetAsyncJobResult(jobid string, timeout int64, opts ...CallOption) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{jobid, timeout}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAsyncJobResult", varargs...)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncJobResult indicates an expected call of GetAsyncJobResult.
+func (mr *MockCloudStackClientIfaceMockRecorder) GetAsyncJobResult(jobid, timeout interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{jobid, timeout}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncJobResult", reflect.TypeOf((*MockCloudStackClientIface)(nil).GetAsyncJobResult), varargs...)
+}
+
+// GetUserDataMaxLength mocks base method.
+func (m *MockCloudStackClientIface) GetUserDataMaxLength(opts ...CallOption) (int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataMaxLength", varargs...)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDataMaxLength indicates an expected call of GetUserDataMaxLength.
+func (mr *MockCloudStackClientIfaceMockRecorder) GetUserDataMaxLength(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataMaxLength", reflect.TypeOf((*MockCloudStackClientIface)(nil).GetUserDataMaxLength), opts...)
+}
+
+// GuestOSService mocks base method.
+func (m *MockCloudStackClientIface) GuestOSService() GuestOSServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestOSService")
+	ret0, _ := ret[0].(GuestOSServiceIface)
+	return ret0
+}
+
+// GuestOSService indicates an expected call of GuestOSService.
+func (mr *MockCloudStackClientIfaceMockRecorder) GuestOSService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestOSService", reflect.TypeOf((*MockCloudStackClientIface)(nil).GuestOSService))
+}
+
+// HostService mocks base method.
+func (m *MockCloudStackClientIface) HostService() HostServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostService")
+	ret0, _ := ret[0].(HostServiceIface)
+	return ret0
+}
+
+// HostService indicates an expected call of HostService.
+func (mr *MockCloudStackClientIfaceMockRecorder) HostService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostService", reflect.TypeOf((*MockCloudStackClientIface)(nil).HostService))
+}
+
+// HypervisorService mocks base method.
+func (m *MockCloudStackClientIface) HypervisorService() HypervisorServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HypervisorService")
+	ret0, _ := ret[0].(HypervisorServiceIface)
+	return ret0
+}
+
+// HypervisorService indicates an expected call of HypervisorService.
+func (mr *MockCloudStackClientIfaceMockRecorder) HypervisorService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HypervisorService", reflect.TypeOf((*MockCloudStackClientIface)(nil).HypervisorService))
+}
+
+// ISOService mocks base method.
+func (m *MockCloudStackClientIface) ISOService() ISOServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ISOService")
+	ret0, _ := ret[0].(ISOServiceIface)
+	return ret0
+}
+
+// ISOService indicates an expected call of ISOService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ISOService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ISOService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ISOService))
+}
+
+// ImageStoreService mocks base method.
+func (m *MockCloudStackClientIface) ImageStoreService() ImageStoreServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageStoreService")
+	ret0, _ := ret[0].(ImageStoreServiceIface)
+	return ret0
+}
+
+// ImageStoreService indicates an expected call of ImageStoreService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ImageStoreService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageStoreService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ImageStoreService))
+}
+
+// InfrastructureUsageService mocks base method.
+func (m *MockCloudStackClientIface) InfrastructureUsageService() InfrastructureUsageServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InfrastructureUsageService")
+	ret0, _ := ret[0].(InfrastructureUsageServiceIface)
+	return ret0
+}
+
+// InfrastructureUsageService indicates an expected call of InfrastructureUsageService.
+func (mr *MockCloudStackClientIfaceMockRecorder) InfrastructureUsageService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InfrastructureUsageService", reflect.TypeOf((*MockCloudStackClientIface)(nil).InfrastructureUsageService))
+}
+
+// InternalLBService mocks base method.
+func (m *MockCloudStackClientIface) InternalLBService() InternalLBServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InternalLBService")
+	ret0, _ := ret[0].(InternalLBServiceIface)
+	return ret0
+}
+
+// InternalLBService indicates an expected call of InternalLBService.
+func (mr *MockCloudStackClientIfaceMockRecorder) InternalLBService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InternalLBService", reflect.TypeOf((*MockCloudStackClientIface)(nil).InternalLBService))
+}
+
+// KubernetesService mocks base method.
+func (m *MockCloudStackClientIface) KubernetesService() KubernetesServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubernetesService")
+	ret0, _ := ret[0].(KubernetesServiceIface)
+	return ret0
+}
+
+// KubernetesService indicates an expected call of KubernetesService.
+func (mr *MockCloudStackClientIfaceMockRecorder) KubernetesService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubernetesService", reflect.TypeOf((*MockCloudStackClientIface)(nil).KubernetesService))
+}
+
+// LDAPService mocks base method.
+func (m *MockCloudStackClientIface) LDAPService() LDAPServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LDAPService")
+	ret0, _ := ret[0].(LDAPServiceIface)
+	return ret0
+}
+
+// LDAPService indicates an expected call of LDAPService.
+func (mr *MockCloudStackClientIfaceMockRecorder) LDAPService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LDAPService", reflect.TypeOf((*MockCloudStackClientIface)(nil).LDAPService))
+}
+
+// LatestBackup mocks base method.
+func (m *MockCloudStackClientIface) LatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{virtualmachineid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LatestBackup", varargs...)
+	ret0, _ := ret[0].(*Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBackup indicates an expected call of LatestBackup.
+func (mr *MockCloudStackClientIfaceMockRecorder) LatestBackup(virtualmachineid interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{virtualmachineid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBackup", reflect.TypeOf((*MockCloudStackClientIface)(nil).LatestBackup), varargs...)
+}
+
+// LimitService mocks base method.
+func (m *MockCloudStackClientIface) LimitService() LimitServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitService")
+	ret0, _ := ret[0].(LimitServiceIface)
+	return ret0
+}
+
+// LimitService indicates an expected call of LimitService.
+func (mr *MockCloudStackClientIfaceMockRecorder) LimitService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitService", reflect.TypeOf((*MockCloudStackClientIface)(nil).LimitService))
+}
+
+// LoadBalancerService mocks base method.
+func (m *MockCloudStackClientIface) LoadBalancerService() LoadBalancerServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancerService")
+	ret0, _ := ret[0].(LoadBalancerServiceIface)
+	return ret0
+}
+
+// LoadBalancerService indicates an expected call of LoadBalancerService.
+func (mr *MockCloudStackClientIfaceMockRecorder) LoadBalancerService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancerService", reflect.TypeOf((*MockCloudStackClientIface)(nil).LoadBalancerService))
+}
+
+// NATService mocks base method.
+func (m *MockCloudStackClientIface) NATService() NATServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NATService")
+	ret0, _ := ret[0].(NATServiceIface)
+	return ret0
+}
+
+// NATService indicates an expected call of NATService.
+func (mr *MockCloudStackClientIfaceMockRecorder) NATService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NATService", reflect.TypeOf((*MockCloudStackClientIface)(nil).NATService))
+}
+
+// NetworkACLService mocks base method.
+func (m *MockCloudStackClientIface) NetworkACLService() NetworkACLServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkACLService")
+	ret0, _ := ret[0].(NetworkACLServiceIface)
+	return ret0
+}
+
+// NetworkACLService indicates an expected call of NetworkACLService.
+func (mr *MockCloudStackClientIfaceMockRecorder) NetworkACLService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkACLService", reflect.TypeOf((*MockCloudStackClientIface)(nil).NetworkACLService))
+}
+
+// NetworkDeviceService mocks base method.
+func (m *MockCloudStackClientIface) NetworkDeviceService() NetworkDeviceServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkDeviceService")
+	ret0, _ := ret[0].(NetworkDeviceServiceIface)
+	return ret0
+}
+
+// NetworkDeviceService indicates an expected call of NetworkDeviceService.
+func (mr *MockCloudStackClientIfaceMockRecorder) NetworkDeviceService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkDeviceService", reflect.TypeOf((*MockCloudStackClientIface)(nil).NetworkDeviceService))
+}
+
+// NetworkOfferingService mocks base method.
+func (m *MockCloudStackClientIface) NetworkOfferingService() NetworkOfferingServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkOfferingService")
+	ret0, _ := ret[0].(NetworkOfferingServiceIface)
+	return ret0
+}
+
+// NetworkOfferingService indicates an expected call of NetworkOfferingService.
+func (mr *MockCloudStackClientIfaceMockRecorder) NetworkOfferingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkOfferingService", reflect.TypeOf((*MockCloudStackClientIface)(nil).NetworkOfferingService))
+}
+
+// NetworkService mocks base method.
+func (m *MockCloudStackClientIface) NetworkService() NetworkServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkService")
+	ret0, _ := ret[0].(NetworkServiceIface)
+	return ret0
+}
+
+// NetworkService indicates an expected call of NetworkService.
+func (mr *MockCloudStackClientIfaceMockRecorder) NetworkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkService", reflect.TypeOf((*MockCloudStackClientIface)(nil).NetworkService))
+}
+
+// NicService mocks base method.
+func (m *MockCloudStackClientIface) NicService() NicServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NicService")
+	ret0, _ := ret[0].(NicServiceIface)
+	return ret0
+}
+
+// NicService indicates an expected call of NicService.
+func (mr *MockCloudStackClientIfaceMockRecorder) NicService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NicService", reflect.TypeOf((*MockCloudStackClientIface)(nil).NicService))
+}
+
+// NiciraNVPService mocks base method.
+func (m *MockCloudStackClientIface) NiciraNVPService() NiciraNVPServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NiciraNVPService")
+	ret0, _ := ret[0].(NiciraNVPServiceIface)
+	return ret0
+}
+
+// NiciraNVPService indicates an expected call of NiciraNVPService.
+func (mr *MockCloudStackClientIfaceMockRecorder) NiciraNVPService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NiciraNVPService", reflect.TypeOf((*MockCloudStackClientIface)(nil).NiciraNVPService))
+}
+
+// ObjectStoreService mocks base method.
+func (m *MockCloudStackClientIface) ObjectStoreService() ObjectStoreServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStoreService")
+	ret0, _ := ret[0].(ObjectStoreServiceIface)
+	return ret0
+}
+
+// ObjectStoreService indicates an expected call of ObjectStoreService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ObjectStoreService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStoreService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ObjectStoreService))
+}
+
+// OutofbandManagementService mocks base method.
+func (m *MockCloudStackClientIface) OutofbandManagementService() OutofbandManagementServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutofbandManagementService")
+	ret0, _ := ret[0].(OutofbandManagementServiceIface)
+	return ret0
+}
+
+// OutofbandManagementService indicates an expected call of OutofbandManagementService.
+func (mr *MockCloudStackClientIfaceMockRecorder) OutofbandManagementService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutofbandManagementService", reflect.TypeOf((*MockCloudStackClientIface)(nil).OutofbandManagementService))
+}
+
+// OvsElementService mocks base method.
+func (m *MockCloudStackClientIface) OvsElementService() OvsElementServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OvsElementService")
+	ret0, _ := ret[0].(OvsElementServiceIface)
+	return ret0
+}
+
+// OvsElementService indicates an expected call of OvsElementService.
+func (mr *MockCloudStackClientIfaceMockRecorder) OvsElementService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OvsElementService", reflect.TypeOf((*MockCloudStackClientIface)(nil).OvsElementService))
+}
+
+// PodService mocks base method.
+func (m *MockCloudStackClientIface) PodService() PodServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodService")
+	ret0, _ := ret[0].(PodServiceIface)
+	return ret0
+}
+
+// PodService indicates an expected call of PodService.
+func (mr *MockCloudStackClientIfaceMockRecorder) PodService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodService", reflect.TypeOf((*MockCloudStackClientIface)(nil).PodService))
+}
+
+// PoolService mocks base method.
+func (m *MockCloudStackClientIface) PoolService() PoolServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PoolService")
+	ret0, _ := ret[0].(PoolServiceIface)
+	return ret0
+}
+
+// PoolService indicates an expected call of PoolService.
+func (mr *MockCloudStackClientIfaceMockRecorder) PoolService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolService", reflect.TypeOf((*MockCloudStackClientIface)(nil).PoolService))
+}
+
+// PortableIPService mocks base method.
+func (m *MockCloudStackClientIface) PortableIPService() PortableIPServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortableIPService")
+	ret0, _ := ret[0].(PortableIPServiceIface)
+	return ret0
+}
+
+// PortableIPService indicates an expected call of PortableIPService.
+func (mr *MockCloudStackClientIfaceMockRecorder) PortableIPService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortableIPService", reflect.TypeOf((*MockCloudStackClientIface)(nil).PortableIPService))
+}
+
+// ProjectService mocks base method.
+func (m *MockCloudStackClientIface) ProjectService() ProjectServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectService")
+	ret0, _ := ret[0].(ProjectServiceIface)
+	return ret0
+}
+
+// ProjectService indicates an expected call of ProjectService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ProjectService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ProjectService))
+}
+
+// QuotaService mocks base method.
+func (m *MockCloudStackClientIface) QuotaService() QuotaServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaService")
+	ret0, _ := ret[0].(QuotaServiceIface)
+	return ret0
+}
+
+// QuotaService indicates an expected call of QuotaService.
+func (mr *MockCloudStackClientIfaceMockRecorder) QuotaService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaService", reflect.TypeOf((*MockCloudStackClientIface)(nil).QuotaService))
+}
+
+// RegionService mocks base method.
+func (m *MockCloudStackClientIface) RegionService() RegionServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegionService")
+	ret0, _ := ret[0].(RegionServiceIface)
+	return ret0
+}
+
+// RegionService indicates an expected call of RegionService.
+func (mr *MockCloudStackClientIfaceMockRecorder) RegionService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionService", reflect.TypeOf((*MockCloudStackClientIface)(nil).RegionService))
+}
+
+// ResourcemetadataService mocks base method.
+func (m *MockCloudStackClientIface) ResourcemetadataService() ResourcemetadataServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourcemetadataService")
+	ret0, _ := ret[0].(ResourcemetadataServiceIface)
+	return ret0
+}
+
+// ResourcemetadataService indicates an expected call of ResourcemetadataService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ResourcemetadataService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourcemetadataService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ResourcemetadataService))
+}
+
+// ResourcetagsService mocks base method.
+func (m *MockCloudStackClientIface) ResourcetagsService() ResourcetagsServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourcetagsService")
+	ret0, _ := ret[0].(ResourcetagsServiceIface)
+	return ret0
+}
+
+// ResourcetagsService indicates an expected call of ResourcetagsService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ResourcetagsService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourcetagsService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ResourcetagsService))
+}
+
+// RestoreFromLatestBackup mocks base method.
+func (m *MockCloudStackClientIface) RestoreFromLatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{virtualmachineid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreFromLatestBackup", varargs...)
+	ret0, _ := ret[0].(*Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreFromLatestBackup indicates an expected call of RestoreFromLatestBackup.
+func (mr *MockCloudStackClientIfaceMockRecorder) RestoreFromLatestBackup(virtualmachineid interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{virtualmachineid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromLatestBackup", reflect.TypeOf((*MockCloudStackClientIface)(nil).RestoreFromLatestBackup), varargs...)
+}
+
+// RoleService mocks base method.
+func (m *MockCloudStackClientIface) RoleService() RoleServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleService")
+	ret0, _ := ret[0].(RoleServiceIface)
+	return ret0
+}
+
+// RoleService indicates an expected call of RoleService.
+func (mr *MockCloudStackClientIfaceMockRecorder) RoleService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleService", reflect.TypeOf((*MockCloudStackClientIface)(nil).RoleService))
+}
+
+// RouterService mocks base method.
+func (m *MockCloudStackClientIface) RouterService() RouterServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RouterService")
+	ret0, _ := ret[0].(RouterServiceIface)
+	return ret0
+}
+
+// RouterService indicates an expected call of RouterService.
+func (mr *MockCloudStackClientIfaceMockRecorder) RouterService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouterService", reflect.TypeOf((*MockCloudStackClientIface)(nil).RouterService))
+}
+
+// SSHService mocks base method.
+func (m *MockCloudStackClientIface) SSHService() SSHServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHService")
+	ret0, _ := ret[0].(SSHServiceIface)
+	return ret0
+}
+
+// SSHService indicates an expected call of SSHService.
+func (mr *MockCloudStackClientIfaceMockRecorder) SSHService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHService", reflect.TypeOf((*MockCloudStackClientIface)(nil).SSHService))
+}
+
+// SecurityGroupService mocks base method.
+func (m *MockCloudStackClientIface) SecurityGroupService() SecurityGroupServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityGroupService")
+	ret0, _ := ret[0].(SecurityGroupServiceIface)
+	return ret0
+}
+
+// SecurityGroupService indicates an expected call of SecurityGroupService.
+func (mr *MockCloudStackClientIfaceMockRecorder) SecurityGroupService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroupService", reflect.TypeOf((*MockCloudStackClientIface)(nil).SecurityGroupService))
+}
+
+// ServerVersion mocks base method.
+func (m *MockCloudStackClientIface) ServerVersion() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerVersion")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ServerVersion indicates an expected call of ServerVersion.
+func (mr *MockCloudStackClientIfaceMockRecorder) ServerVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerVersion", reflect.TypeOf((*MockCloudStackClientIface)(nil).ServerVersion))
+}
+
+// ServiceOfferingService mocks base method.
+func (m *MockCloudStackClientIface) ServiceOfferingService() ServiceOfferingServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceOfferingService")
+	ret0, _ := ret[0].(ServiceOfferingServiceIface)
+	return ret0
+}
+
+// ServiceOfferingService indicates an expected call of ServiceOfferingService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ServiceOfferingService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceOfferingService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ServiceOfferingService))
+}
+
+// SnapshotService mocks base method.
+func (m *MockCloudStackClientIface) SnapshotService() SnapshotServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotService")
+	ret0, _ := ret[0].(SnapshotServiceIface)
+	return ret0
+}
+
+// SnapshotService indicates an expected call of SnapshotService.
+func (mr *MockCloudStackClientIfaceMockRecorder) SnapshotService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotService", reflect.TypeOf((*MockCloudStackClientIface)(nil).SnapshotService))
+}
+
+// StoragePoolService mocks base method.
+func (m *MockCloudStackClientIface) StoragePoolService() StoragePoolServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoragePoolService")
+	ret0, _ := ret[0].(StoragePoolServiceIface)
+	return ret0
+}
+
+// StoragePoolService indicates an expected call of StoragePoolService.
+func (mr *MockCloudStackClientIfaceMockRecorder) StoragePoolService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoragePoolService", reflect.TypeOf((*MockCloudStackClientIface)(nil).StoragePoolService))
+}
+
+// StratosphereSSPService mocks base method.
+func (m *MockCloudStackClientIface) StratosphereSSPService() StratosphereSSPServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StratosphereSSPService")
+	ret0, _ := ret[0].(StratosphereSSPServiceIface)
+	return ret0
+}
+
+// StratosphereSSPService indicates an expected call of StratosphereSSPService.
+func (mr *MockCloudStackClientIfaceMockRecorder) StratosphereSSPService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StratosphereSSPService", reflect.TypeOf((*MockCloudStackClientIface)(nil).StratosphereSSPService))
+}
+
+// Supports mocks base method.
+func (m *MockCloudStackClientIface) Supports(command, param string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Supports", command, param)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Supports indicates an expected call of Supports.
+func (mr *MockCloudStackClientIfaceMockRecorder) Supports(command, param interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Supports", reflect.TypeOf((*MockCloudStackClientIface)(nil).Supports), command, param)
+}
+
+// SwiftService mocks base method.
+func (m *MockCloudStackClientIface) SwiftService() SwiftServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwiftService")
+	ret0, _ := ret[0].(SwiftServiceIface)
+	return ret0
+}
+
+// SwiftService indicates an expected call of SwiftService.
+func (mr *MockCloudStackClientIfaceMockRecorder) SwiftService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwiftService", reflect.TypeOf((*MockCloudStackClientIface)(nil).SwiftService))
+}
+
+// SystemCapacityService mocks base method.
+func (m *MockCloudStackClientIface) SystemCapacityService() SystemCapacityServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SystemCapacityService")
+	ret0, _ := ret[0].(SystemCapacityServiceIface)
+	return ret0
+}
+
+// SystemCapacityService indicates an expected call of SystemCapacityService.
+func (mr *MockCloudStackClientIfaceMockRecorder) SystemCapacityService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SystemCapacityService", reflect.TypeOf((*MockCloudStackClientIface)(nil).SystemCapacityService))
+}
+
+// SystemVMService mocks base method.
+func (m *MockCloudStackClientIface) SystemVMService() SystemVMServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SystemVMService")
+	ret0, _ := ret[0].(SystemVMServiceIface)
+	return ret0
+}
+
+// SystemVMService indicates an expected call of SystemVMService.
+func (mr *MockCloudStackClientIfaceMockRecorder) SystemVMService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SystemVMService", reflect.TypeOf((*MockCloudStackClientIface)(nil).SystemVMService))
+}
+
+// TemplateService mocks base method.
+func (m *MockCloudStackClientIface) TemplateService() TemplateServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateService")
+	ret0, _ := ret[0].(TemplateServiceIface)
+	return ret0
+}
+
+// TemplateService indicates an expected call of TemplateService.
+func (mr *MockCloudStackClientIfaceMockRecorder) TemplateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateService", reflect.TypeOf((*MockCloudStackClientIface)(nil).TemplateService))
+}
+
+// Timeout mocks base method.
+func (m *MockCloudStackClientIface) Timeout(timeout time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Timeout", timeout)
+}
+
+// Timeout indicates an expected call of Timeout.
+func (mr *MockCloudStackClientIfaceMockRecorder) Timeout(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeout", reflect.TypeOf((*MockCloudStackClientIface)(nil).Timeout), timeout)
+}
+
+// UCSService mocks base method.
+func (m *MockCloudStackClientIface) UCSService() UCSServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UCSService")
+	ret0, _ := ret[0].(UCSServiceIface)
+	return ret0
+}
+
+// UCSService indicates an expected call of UCSService.
+func (mr *MockCloudStackClientIfaceMockRecorder) UCSService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UCSService", reflect.TypeOf((*MockCloudStackClientIface)(nil).UCSService))
+}
+
+// UsageService mocks base method.
+func (m *MockCloudStackClientIface) UsageService() UsageServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsageService")
+	ret0, _ := ret[0].(UsageServiceIface)
+	return ret0
+}
+
+// UsageService indicates an expected call of UsageService.
+func (mr *MockCloudStackClientIfaceMockRecorder) UsageService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsageService", reflect.TypeOf((*MockCloudStackClientIface)(nil).UsageService))
+}
+
+// UserDataService mocks base method.
+func (m *MockCloudStackClientIface) UserDataService() UserDataServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDataService")
+	ret0, _ := ret[0].(UserDataServiceIface)
+	return ret0
+}
+
+// UserDataService indicates an expected call of UserDataService.
+func (mr *MockCloudStackClientIfaceMockRecorder) UserDataService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDataService", reflect.TypeOf((*MockCloudStackClientIface)(nil).UserDataService))
+}
+
+// UserService mocks base method.
+func (m *MockCloudStackClientIface) UserService() UserServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserService")
+	ret0, _ := ret[0].(UserServiceIface)
+	return ret0
+}
+
+// UserService indicates an expected call of UserService.
+func (mr *MockCloudStackClientIfaceMockRecorder) UserService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserService", reflect.TypeOf((*MockCloudStackClientIface)(nil).UserService))
+}
+
+// VLANService mocks base method.
+func (m *MockCloudStackClientIface) VLANService() VLANServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VLANService")
+	ret0, _ := ret[0].(VLANServiceIface)
+	return ret0
+}
+
+// VLANService indicates an expected call of VLANService.
+func (mr *MockCloudStackClientIfaceMockRecorder) VLANService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VLANService", reflect.TypeOf((*MockCloudStackClientIface)(nil).VLANService))
+}
+
+// VMGroupService mocks base method.
+func (m *MockCloudStackClientIface) VMGroupService() VMGroupServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VMGroupService")
+	ret0, _ := ret[0].(VMGroupServiceIface)
+	return ret0
+}
+
+// VMGroupService indicates an expected call of VMGroupService.
+func (mr *MockCloudStackClientIfaceMockRecorder) VMGroupService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VMGroupService", reflect.TypeOf((*MockCloudStackClientIface)(nil).VMGroupService))
+}
+
+// VPCService mocks base method.
+func (m *MockCloudStackClientIface) VPCService() VPCServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCService")
+	ret0, _ := ret[0].(VPCServiceIface)
+	return ret0
+}
+
+// VPCService indicates an expected call of VPCService.
+func (mr *MockCloudStackClientIfaceMockRecorder) VPCService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCService", reflect.TypeOf((*MockCloudStackClientIface)(nil).VPCService))
+}
+
+// VPNService mocks base method.
+func (m *MockCloudStackClientIface) VPNService() VPNServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPNService")
+	ret0, _ := ret[0].(VPNServiceIface)
+	return ret0
+}
+
+// VPNService indicates an expected call of VPNService.
+func (mr *MockCloudStackClientIfaceMockRecorder) VPNService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPNService", reflect.TypeOf((*MockCloudStackClientIface)(nil).VPNService))
+}
+
+// VirtualMachineService mocks base method.
+func (m *MockCloudStackClientIface) VirtualMachineService() VirtualMachineServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineService")
+	ret0, _ := ret[0].(VirtualMachineServiceIface)
+	return ret0
+}
+
+// VirtualMachineService indicates an expected call of VirtualMachineService.
+func (mr *MockCloudStackClientIfaceMockRecorder) VirtualMachineService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineService", reflect.TypeOf((*MockCloudStackClientIface)(nil).VirtualMachineService))
+}
+
+// VolumeService mocks base method.
+func (m *MockCloudStackClientIface) VolumeService() VolumeServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeService")
+	ret0, _ := ret[0].(VolumeServiceIface)
+	return ret0
+}
+
+// VolumeService indicates an expected call of VolumeService.
+func (mr *MockCloudStackClientIfaceMockRecorder) VolumeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeService", reflect.TypeOf((*MockCloudStackClientIface)(nil).VolumeService))
+}
+
+// ZoneService mocks base method.
+func (m *MockCloudStackClientIface) ZoneService() ZoneServiceIface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZoneService")
+	ret0, _ := ret[0].(ZoneServiceIface)
+	return ret0
+}
+
+// ZoneService indicates an expected call of ZoneService.
+func (mr *MockCloudStackClientIfaceMockRecorder) ZoneService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZoneService", reflect.TypeOf((*MockCloudStackClientIface)(nil).ZoneService))
+}
@@ -28,522 +28,709 @@ import (
 )
 
 type ClusterServiceIface interface {
-	AddCluster(p *AddClusterParams) (*AddClusterResponse, error)
+	AddCluster(p *AddClusterParams, opts ...CallOption) (*AddClusterResponse, error)
 	NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams
-	DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateCluster(p *DedicateClusterParams, opts ...CallOption) (*DedicateClusterResponse, error)
 	NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams
-	DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	DeleteCluster(p *DeleteClusterParams, opts ...CallOption) (*DeleteClusterResponse, error)
 	NewDeleteClusterParams(id string) *DeleteClusterParams
-	DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams, opts ...CallOption) (*DisableOutOfBandManagementForClusterResponse, error)
 	NewDisableOutOfBandManagementForClusterParams(clusterid string) *DisableOutOfBandManagementForClusterParams
-	EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams, opts ...CallOption) (*EnableOutOfBandManagementForClusterResponse, error)
 	NewEnableOutOfBandManagementForClusterParams(clusterid string) *EnableOutOfBandManagementForClusterParams
-	EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error)
+	EnableHAForCluster(p *EnableHAForClusterParams, opts ...CallOption) (*EnableHAForClusterResponse, error)
 	NewEnableHAForClusterParams(clusterid string) *EnableHAForClusterParams
-	DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error)
+	DisableHAForCluster(p *DisableHAForClusterParams, opts ...CallOption) (*DisableHAForClusterResponse, error)
 	NewDisableHAForClusterParams(clusterid string) *DisableHAForClusterParams
-	ListClusters(p *ListClustersParams) (*ListClustersResponse, error)
+	ListClusters(p *ListClustersParams, opts ...CallOption) (*ListClustersResponse, error)
 	NewListClustersParams() *ListClustersParams
 	GetClusterID(name string, opts ...OptionFunc) (string, int, error)
 	GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error)
 	GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error)
-	ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	ListClustersMetrics(p *ListClustersMetricsParams, opts ...CallOption) (*ListClustersMetricsResponse, error)
 	NewListClustersMetricsParams() *ListClustersMetricsParams
 	GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error)
 	GetClustersMetricByName(name string, opts ...OptionFunc) (*ClustersMetric, int, error)
 	GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error)
-	ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClusters(p *ListDedicatedClustersParams, opts ...CallOption) (*ListDedicatedClustersResponse, error)
 	NewListDedicatedClustersParams() *ListDedicatedClustersParams
-	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams, opts ...CallOption) (*ReleaseDedicatedClusterResponse, error)
 	NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams
-	UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error)
+	UpdateCluster(p *UpdateClusterParams, opts ...CallOption) (*UpdateClusterResponse, error)
 	NewUpdateClusterParams(id string) *UpdateClusterParams
 }
 
 type AddClusterParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddClusterParams) toURLValues() url.Values {
+	allocationstate   optString
+	clustername       optString
+	clustertype       optString
+	guestvswitchname  optString
+	guestvswitchtype  optString
+	hypervisor        optString
+	ovm3cluster       optString
+	ovm3pool          optString
+	ovm3vip           optString
+	password          optString
+	podid             optString
+	publicvswitchname optString
+	publicvswitchtype optString
+	url               optString
+	username          optString
+	vsmipaddress      optString
+	vsmpassword       optString
+	vsmusername       optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
+	if p.clustername.ok {
+		u.Set("clustername", p.clustername.v)
 	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
+	if p.clustertype.ok {
+		u.Set("clustertype", p.clustertype.v)
 	}
-	if v, found := p.p["guestvswitchname"]; found {
-		u.Set("guestvswitchname", v.(string))
+	if p.guestvswitchname.ok {
+		u.Set("guestvswitchname", p.guestvswitchname.v)
 	}
-	if v, found := p.p["guestvswitchtype"]; found {
-		u.Set("guestvswitchtype", v.(string))
+	if p.guestvswitchtype.ok {
+		u.Set("guestvswitchtype", p.guestvswitchtype.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["ovm3cluster"]; found {
-		u.Set("ovm3cluster", v.(string))
+	if p.ovm3cluster.ok {
+		u.Set("ovm3cluster", p.ovm3cluster.v)
 	}
-	if v, found := p.p["ovm3pool"]; found {
-		u.Set("ovm3pool", v.(string))
+	if p.ovm3pool.ok {
+		u.Set("ovm3pool", p.ovm3pool.v)
 	}
-	if v, found := p.p["ovm3vip"]; found {
-		u.Set("ovm3vip", v.(string))
+	if p.ovm3vip.ok {
+		u.Set("ovm3vip", p.ovm3vip.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["publicvswitchname"]; found {
-		u.Set("publicvswitchname", v.(string))
+	if p.publicvswitchname.ok {
+		u.Set("publicvswitchname", p.publicvswitchname.v)
 	}
-	if v, found := p.p["publicvswitchtype"]; found {
-		u.Set("publicvswitchtype", v.(string))
+	if p.publicvswitchtype.ok {
+		u.Set("publicvswitchtype", p.publicvswitchtype.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
-	if v, found := p.p["vsmipaddress"]; found {
-		u.Set("vsmipaddress", v.(string))
+	if p.vsmipaddress.ok {
+		u.Set("vsmipaddress", p.vsmipaddress.v)
 	}
-	if v, found := p.p["vsmpassword"]; found {
-		u.Set("vsmpassword", v.(string))
+	if p.vsmpassword.ok {
+		u.Set("vsmpassword", p.vsmpassword.v)
 	}
-	if v, found := p.p["vsmusername"]; found {
-		u.Set("vsmusername", v.(string))
+	if p.vsmusername.ok {
+		u.Set("vsmusername", p.vsmusername.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddClusterParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddClusterParams parses url.Values, for example taken from a raw API request,
+// into a new AddClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddClusterParams(u url.Values) (*AddClusterParams, error) {
+	p := &AddClusterParams{}
+	if err := checkParamNames("addCluster", u, "allocationstate", "clustername", "clustertype", "guestvswitchname", "guestvswitchtype", "hypervisor", "ovm3cluster", "ovm3pool", "ovm3vip", "password", "podid", "publicvswitchname", "publicvswitchtype", "url", "username", "vsmipaddress", "vsmpassword", "vsmusername", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["clustername"]; found {
+		p.SetClustername(u.Get("clustername"))
+	}
+	if _, found := u["clustertype"]; found {
+		p.SetClustertype(u.Get("clustertype"))
+	}
+	if _, found := u["guestvswitchname"]; found {
+		p.SetGuestvswitchname(u.Get("guestvswitchname"))
+	}
+	if _, found := u["guestvswitchtype"]; found {
+		p.SetGuestvswitchtype(u.Get("guestvswitchtype"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["ovm3cluster"]; found {
+		p.SetOvm3cluster(u.Get("ovm3cluster"))
+	}
+	if _, found := u["ovm3pool"]; found {
+		p.SetOvm3pool(u.Get("ovm3pool"))
+	}
+	if _, found := u["ovm3vip"]; found {
+		p.SetOvm3vip(u.Get("ovm3vip"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["publicvswitchname"]; found {
+		p.SetPublicvswitchname(u.Get("publicvswitchname"))
+	}
+	if _, found := u["publicvswitchtype"]; found {
+		p.SetPublicvswitchtype(u.Get("publicvswitchtype"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	if _, found := u["vsmipaddress"]; found {
+		p.SetVsmipaddress(u.Get("vsmipaddress"))
+	}
+	if _, found := u["vsmpassword"]; found {
+		p.SetVsmpassword(u.Get("vsmpassword"))
+	}
+	if _, found := u["vsmusername"]; found {
+		p.SetVsmusername(u.Get("vsmusername"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAllocationstate sets the allocationstate param.
+func (p *AddClusterParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
+}
+
+// ResetAllocationstate unsets the allocationstate param
 func (p *AddClusterParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *AddClusterParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetClustername sets the clustername param. This param is required.
 func (p *AddClusterParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustername"] = v
+	p.clustername = optString{v: v, ok: true}
 }
 
+// ResetClustername unsets the clustername param
 func (p *AddClusterParams) ResetClustername() {
-	if p.p != nil && p.p["clustername"] != nil {
-		delete(p.p, "clustername")
-	}
+	p.clustername = optString{}
 }
 
+// GetClustername returns the clustername param and if it is set
 func (p *AddClusterParams) GetClustername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustername"].(string)
-	return value, ok
+	return p.clustername.v, p.clustername.ok
 }
 
+// SetClustertype sets the clustertype param. This param is required.
 func (p *AddClusterParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
+	p.clustertype = optString{v: v, ok: true}
 }
 
+// ResetClustertype unsets the clustertype param
 func (p *AddClusterParams) ResetClustertype() {
-	if p.p != nil && p.p["clustertype"] != nil {
-		delete(p.p, "clustertype")
-	}
+	p.clustertype = optString{}
 }
 
+// GetClustertype returns the clustertype param and if it is set
 func (p *AddClusterParams) GetClustertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustertype"].(string)
-	return value, ok
+	return p.clustertype.v, p.clustertype.ok
 }
 
+// SetGuestvswitchname sets the guestvswitchname param.
 func (p *AddClusterParams) SetGuestvswitchname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestvswitchname"] = v
+	p.guestvswitchname = optString{v: v, ok: true}
 }
 
+// ResetGuestvswitchname unsets the guestvswitchname param
 func (p *AddClusterParams) ResetGuestvswitchname() {
-	if p.p != nil && p.p["guestvswitchname"] != nil {
-		delete(p.p, "guestvswitchname")
-	}
+	p.guestvswitchname = optString{}
 }
 
+// GetGuestvswitchname returns the guestvswitchname param and if it is set
 func (p *AddClusterParams) GetGuestvswitchname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestvswitchname"].(string)
-	return value, ok
+	return p.guestvswitchname.v, p.guestvswitchname.ok
 }
 
+// SetGuestvswitchtype sets the guestvswitchtype param.
 func (p *AddClusterParams) SetGuestvswitchtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestvswitchtype"] = v
+	p.guestvswitchtype = optString{v: v, ok: true}
 }
 
+// ResetGuestvswitchtype unsets the guestvswitchtype param
 func (p *AddClusterParams) ResetGuestvswitchtype() {
-	if p.p != nil && p.p["guestvswitchtype"] != nil {
-		delete(p.p, "guestvswitchtype")
-	}
+	p.guestvswitchtype = optString{}
 }
 
+// GetGuestvswitchtype returns the guestvswitchtype param and if it is set
 func (p *AddClusterParams) GetGuestvswitchtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestvswitchtype"].(string)
-	return value, ok
+	return p.guestvswitchtype.v, p.guestvswitchtype.ok
 }
 
+// SetHypervisor sets the hypervisor param. This param is required.
 func (p *AddClusterParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *AddClusterParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *AddClusterParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetOvm3cluster sets the ovm3cluster param.
 func (p *AddClusterParams) SetOvm3cluster(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ovm3cluster"] = v
+	p.ovm3cluster = optString{v: v, ok: true}
 }
 
+// ResetOvm3cluster unsets the ovm3cluster param
 func (p *AddClusterParams) ResetOvm3cluster() {
-	if p.p != nil && p.p["ovm3cluster"] != nil {
-		delete(p.p, "ovm3cluster")
-	}
+	p.ovm3cluster = optString{}
 }
 
+// GetOvm3cluster returns the ovm3cluster param and if it is set
 func (p *AddClusterParams) GetOvm3cluster() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ovm3cluster"].(string)
-	return value, ok
+	return p.ovm3cluster.v, p.ovm3cluster.ok
 }
 
+// SetOvm3pool sets the ovm3pool param.
 func (p *AddClusterParams) SetOvm3pool(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ovm3pool"] = v
+	p.ovm3pool = optString{v: v, ok: true}
 }
 
+// ResetOvm3pool unsets the ovm3pool param
 func (p *AddClusterParams) ResetOvm3pool() {
-	if p.p != nil && p.p["ovm3pool"] != nil {
-		delete(p.p, "ovm3pool")
-	}
+	p.ovm3pool = optString{}
 }
 
+// GetOvm3pool returns the ovm3pool param and if it is set
 func (p *AddClusterParams) GetOvm3pool() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ovm3pool"].(string)
-	return value, ok
+	return p.ovm3pool.v, p.ovm3pool.ok
 }
 
+// SetOvm3vip sets the ovm3vip param.
 func (p *AddClusterParams) SetOvm3vip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ovm3vip"] = v
+	p.ovm3vip = optString{v: v, ok: true}
 }
 
+// ResetOvm3vip unsets the ovm3vip param
 func (p *AddClusterParams) ResetOvm3vip() {
-	if p.p != nil && p.p["ovm3vip"] != nil {
-		delete(p.p, "ovm3vip")
-	}
+	p.ovm3vip = optString{}
 }
 
+// GetOvm3vip returns the ovm3vip param and if it is set
 func (p *AddClusterParams) GetOvm3vip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ovm3vip"].(string)
-	return value, ok
+	return p.ovm3vip.v, p.ovm3vip.ok
 }
 
+// SetPassword sets the password param.
 func (p *AddClusterParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddClusterParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddClusterParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPodid sets the podid param. This param is required.
 func (p *AddClusterParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *AddClusterParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *AddClusterParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetPublicvswitchname sets the publicvswitchname param.
 func (p *AddClusterParams) SetPublicvswitchname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicvswitchname"] = v
+	p.publicvswitchname = optString{v: v, ok: true}
 }
 
+// ResetPublicvswitchname unsets the publicvswitchname param
 func (p *AddClusterParams) ResetPublicvswitchname() {
-	if p.p != nil && p.p["publicvswitchname"] != nil {
-		delete(p.p, "publicvswitchname")
-	}
+	p.publicvswitchname = optString{}
 }
 
+// GetPublicvswitchname returns the publicvswitchname param and if it is set
 func (p *AddClusterParams) GetPublicvswitchname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicvswitchname"].(string)
-	return value, ok
+	return p.publicvswitchname.v, p.publicvswitchname.ok
 }
 
+// SetPublicvswitchtype sets the publicvswitchtype param.
 func (p *AddClusterParams) SetPublicvswitchtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicvswitchtype"] = v
+	p.publicvswitchtype = optString{v: v, ok: true}
 }
 
+// ResetPublicvswitchtype unsets the publicvswitchtype param
 func (p *AddClusterParams) ResetPublicvswitchtype() {
-	if p.p != nil && p.p["publicvswitchtype"] != nil {
-		delete(p.p, "publicvswitchtype")
-	}
+	p.publicvswitchtype = optString{}
 }
 
+// GetPublicvswitchtype returns the publicvswitchtype param and if it is set
 func (p *AddClusterParams) GetPublicvswitchtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicvswitchtype"].(string)
-	return value, ok
+	return p.publicvswitchtype.v, p.publicvswitchtype.ok
 }
 
+// SetUrl sets the url param.
 func (p *AddClusterParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddClusterParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddClusterParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param.
 func (p *AddClusterParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddClusterParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddClusterParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.username.v, p.username.ok
 }
 
+// SetVsmipaddress sets the vsmipaddress param.
 func (p *AddClusterParams) SetVsmipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vsmipaddress"] = v
+	p.vsmipaddress = optString{v: v, ok: true}
 }
 
+// ResetVsmipaddress unsets the vsmipaddress param
 func (p *AddClusterParams) ResetVsmipaddress() {
-	if p.p != nil && p.p["vsmipaddress"] != nil {
-		delete(p.p, "vsmipaddress")
-	}
+	p.vsmipaddress = optString{}
 }
 
+// GetVsmipaddress returns the vsmipaddress param and if it is set
 func (p *AddClusterParams) GetVsmipaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vsmipaddress"].(string)
-	return value, ok
+	return p.vsmipaddress.v, p.vsmipaddress.ok
 }
 
+// SetVsmpassword sets the vsmpassword param.
 func (p *AddClusterParams) SetVsmpassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vsmpassword"] = v
+	p.vsmpassword = optString{v: v, ok: true}
 }
 
+// ResetVsmpassword unsets the vsmpassword param
 func (p *AddClusterParams) ResetVsmpassword() {
-	if p.p != nil && p.p["vsmpassword"] != nil {
-		delete(p.p, "vsmpassword")
-	}
+	p.vsmpassword = optString{}
 }
 
+// GetVsmpassword returns the vsmpassword param and if it is set
 func (p *AddClusterParams) GetVsmpassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vsmpassword"].(string)
-	return value, ok
+	return p.vsmpassword.v, p.vsmpassword.ok
 }
 
+// SetVsmusername sets the vsmusername param.
 func (p *AddClusterParams) SetVsmusername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vsmusername"] = v
+	p.vsmusername = optString{v: v, ok: true}
 }
 
+// ResetVsmusername unsets the vsmusername param
 func (p *AddClusterParams) ResetVsmusername() {
-	if p.p != nil && p.p["vsmusername"] != nil {
-		delete(p.p, "vsmusername")
-	}
+	p.vsmusername = optString{}
 }
 
+// GetVsmusername returns the vsmusername param and if it is set
 func (p *AddClusterParams) GetVsmusername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vsmusername"].(string)
-	return value, ok
+	return p.vsmusername.v, p.vsmusername.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *AddClusterParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddClusterParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddClusterParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddClusterParams) Clone() *AddClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddClusterParams) Equal(o *AddClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.clustername == o.clustername &&
+		p.clustertype == o.clustertype &&
+		p.guestvswitchname == o.guestvswitchname &&
+		p.guestvswitchtype == o.guestvswitchtype &&
+		p.hypervisor == o.hypervisor &&
+		p.ovm3cluster == o.ovm3cluster &&
+		p.ovm3pool == o.ovm3pool &&
+		p.ovm3vip == o.ovm3vip &&
+		p.password == o.password &&
+		p.podid == o.podid &&
+		p.publicvswitchname == o.publicvswitchname &&
+		p.publicvswitchtype == o.publicvswitchtype &&
+		p.url == o.url &&
+		p.username == o.username &&
+		p.vsmipaddress == o.vsmipaddress &&
+		p.vsmpassword == o.vsmpassword &&
+		p.vsmusername == o.vsmusername &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddClusterParams is used to (un)marshal AddClusterParams using the API param names
+type serializedAddClusterParams struct {
+	Allocationstate   *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clustername       *string `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Clustertype       *string `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Guestvswitchname  *string `json:"guestvswitchname,omitempty" yaml:"guestvswitchname,omitempty"`
+	Guestvswitchtype  *string `json:"guestvswitchtype,omitempty" yaml:"guestvswitchtype,omitempty"`
+	Hypervisor        *string `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Ovm3cluster       *string `json:"ovm3cluster,omitempty" yaml:"ovm3cluster,omitempty"`
+	Ovm3pool          *string `json:"ovm3pool,omitempty" yaml:"ovm3pool,omitempty"`
+	Ovm3vip           *string `json:"ovm3vip,omitempty" yaml:"ovm3vip,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Publicvswitchname *string `json:"publicvswitchname,omitempty" yaml:"publicvswitchname,omitempty"`
+	Publicvswitchtype *string `json:"publicvswitchtype,omitempty" yaml:"publicvswitchtype,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Vsmipaddress      *string `json:"vsmipaddress,omitempty" yaml:"vsmipaddress,omitempty"`
+	Vsmpassword       *string `json:"vsmpassword,omitempty" yaml:"vsmpassword,omitempty"`
+	Vsmusername       *string `json:"vsmusername,omitempty" yaml:"vsmusername,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddClusterParams) toSerialized() *serializedAddClusterParams {
+	s := &serializedAddClusterParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.clustername.ok {
+		s.Clustername = &p.clustername.v
+	}
+	if p.clustertype.ok {
+		s.Clustertype = &p.clustertype.v
+	}
+	if p.guestvswitchname.ok {
+		s.Guestvswitchname = &p.guestvswitchname.v
+	}
+	if p.guestvswitchtype.ok {
+		s.Guestvswitchtype = &p.guestvswitchtype.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.ovm3cluster.ok {
+		s.Ovm3cluster = &p.ovm3cluster.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.ovm3pool.ok {
+		s.Ovm3pool = &p.ovm3pool.v
+	}
+	if p.ovm3vip.ok {
+		s.Ovm3vip = &p.ovm3vip.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.publicvswitchname.ok {
+		s.Publicvswitchname = &p.publicvswitchname.v
+	}
+	if p.publicvswitchtype.ok {
+		s.Publicvswitchtype = &p.publicvswitchtype.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	if p.vsmipaddress.ok {
+		s.Vsmipaddress = &p.vsmipaddress.v
+	}
+	if p.vsmpassword.ok {
+		s.Vsmpassword = &p.vsmpassword.v
+	}
+	if p.vsmusername.ok {
+		s.Vsmusername = &p.vsmusername.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddClusterParams) fromSerialized(s *serializedAddClusterParams) {
+	*p = AddClusterParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Clustername != nil {
+		p.SetClustername(*s.Clustername)
+	}
+	if s.Clustertype != nil {
+		p.SetClustertype(*s.Clustertype)
+	}
+	if s.Guestvswitchname != nil {
+		p.SetGuestvswitchname(*s.Guestvswitchname)
+	}
+	if s.Guestvswitchtype != nil {
+		p.SetGuestvswitchtype(*s.Guestvswitchtype)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Ovm3cluster != nil {
+		p.SetOvm3cluster(*s.Ovm3cluster)
+	}
+	if s.Ovm3pool != nil {
+		p.SetOvm3pool(*s.Ovm3pool)
+	}
+	if s.Ovm3vip != nil {
+		p.SetOvm3vip(*s.Ovm3vip)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Publicvswitchname != nil {
+		p.SetPublicvswitchname(*s.Publicvswitchname)
+	}
+	if s.Publicvswitchtype != nil {
+		p.SetPublicvswitchtype(*s.Publicvswitchtype)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+	if s.Vsmipaddress != nil {
+		p.SetVsmipaddress(*s.Vsmipaddress)
+	}
+	if s.Vsmpassword != nil {
+		p.SetVsmpassword(*s.Vsmpassword)
+	}
+	if s.Vsmusername != nil {
+		p.SetVsmusername(*s.Vsmusername)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams {
 	p := &AddClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clustername"] = clustername
-	p.p["clustertype"] = clustertype
-	p.p["hypervisor"] = hypervisor
-	p.p["podid"] = podid
-	p.p["zoneid"] = zoneid
+	p.SetClustername(clustername)
+	p.SetClustertype(clustertype)
+	p.SetHypervisor(hypervisor)
+	p.SetPodid(podid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Adds a new cluster
-func (s *ClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
-	resp, err := s.cs.newRequest("addCluster", p.toURLValues())
+// Adds a new cluster.
+//
+// Required params: clustername, clustertype, hypervisor, podid, zoneid.
+func (s *ClusterService) AddCluster(p *AddClusterParams, opts ...CallOption) (*AddClusterResponse, error) {
+	resp, err := s.cs.newRequest("addCluster", s.cs.encodeParams("addCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -597,102 +784,194 @@ type AddClusterResponseCapacity struct {
 }
 
 type DedicateClusterParams struct {
-	p map[string]interface{}
+	account   optString
+	clusterid optString
+	domainid  optString
 }
 
-func (p *DedicateClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DedicateClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
 	return u
 }
 
-func (p *DedicateClusterParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDedicateClusterParams parses url.Values, for example taken from a raw API request,
+// into a new DedicateClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDedicateClusterParams(u url.Values) (*DedicateClusterParams, error) {
+	p := &DedicateClusterParams{}
+	if err := checkParamNames("dedicateCluster", u, "account", "clusterid", "domainid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *DedicateClusterParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *DedicateClusterParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DedicateClusterParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetClusterid sets the clusterid param. This param is required.
 func (p *DedicateClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *DedicateClusterParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *DedicateClusterParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *DedicateClusterParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DedicateClusterParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DedicateClusterParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.domainid.v, p.domainid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DedicateClusterParams) Clone() *DedicateClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DedicateClusterParams) Equal(o *DedicateClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.clusterid == o.clusterid &&
+		p.domainid == o.domainid
+}
+
+// serializedDedicateClusterParams is used to (un)marshal DedicateClusterParams using the API param names
+type serializedDedicateClusterParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+}
+
+func (p *DedicateClusterParams) toSerialized() *serializedDedicateClusterParams {
+	s := &serializedDedicateClusterParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	return s
+}
+
+func (p *DedicateClusterParams) fromSerialized(s *serializedDedicateClusterParams) {
+	*p = DedicateClusterParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DedicateClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DedicateClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedDedicateClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DedicateClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DedicateClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDedicateClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DedicateClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams {
 	p := &DedicateClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
-	p.p["domainid"] = domainid
+	p.SetClusterid(clusterid)
+	p.SetDomainid(domainid)
 	return p
 }
 
-// Dedicate an existing cluster
-func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
-	resp, err := s.cs.newRequest("dedicateCluster", p.toURLValues())
+// Dedicate an existing cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: clusterid, domainid.
+func (s *ClusterService) DedicateCluster(p *DedicateClusterParams, opts ...CallOption) (*DedicateClusterResponse, error) {
+	resp, err := s.cs.newRequest("dedicateCluster", s.cs.encodeParams("dedicateCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -703,8 +982,8 @@ func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClu
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -737,53 +1016,132 @@ type DedicateClusterResponse struct {
 }
 
 type DeleteClusterParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteClusterParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteClusterParams(u url.Values) (*DeleteClusterParams, error) {
+	p := &DeleteClusterParams{}
+	if err := checkParamNames("deleteCluster", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteClusterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteClusterParams) Clone() *DeleteClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteClusterParams) Equal(o *DeleteClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteClusterParams is used to (un)marshal DeleteClusterParams using the API param names
+type serializedDeleteClusterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteClusterParams) toSerialized() *serializedDeleteClusterParams {
+	s := &serializedDeleteClusterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteClusterParams) fromSerialized(s *serializedDeleteClusterParams) {
+	*p = DeleteClusterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams {
 	p := &DeleteClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a cluster.
-func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCluster", p.toURLValues())
+//
+// Required params: id.
+func (s *ClusterService) DeleteCluster(p *DeleteClusterParams, opts ...CallOption) (*DeleteClusterResponse, error) {
+	resp, err := s.cs.newRequest("deleteCluster", s.cs.encodeParams("deleteCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -831,53 +1189,133 @@ func (r *DeleteClusterResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DisableOutOfBandManagementForClusterParams struct {
-	p map[string]interface{}
+	clusterid optString
 }
 
-func (p *DisableOutOfBandManagementForClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableOutOfBandManagementForClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
 	return u
 }
 
-func (p *DisableOutOfBandManagementForClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableOutOfBandManagementForClusterParams parses url.Values, for example taken from a raw API request,
+// into a new DisableOutOfBandManagementForClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableOutOfBandManagementForClusterParams(u url.Values) (*DisableOutOfBandManagementForClusterParams, error) {
+	p := &DisableOutOfBandManagementForClusterParams{}
+	if err := checkParamNames("disableOutOfBandManagementForCluster", u, "clusterid"); err != nil {
+		return nil, err
 	}
-	p.p["clusterid"] = v
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param. This param is required.
+func (p *DisableOutOfBandManagementForClusterParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *DisableOutOfBandManagementForClusterParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *DisableOutOfBandManagementForClusterParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.clusterid.v, p.clusterid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableOutOfBandManagementForClusterParams) Clone() *DisableOutOfBandManagementForClusterParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableOutOfBandManagementForClusterParams) Equal(o *DisableOutOfBandManagementForClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid
+}
+
+// serializedDisableOutOfBandManagementForClusterParams is used to (un)marshal DisableOutOfBandManagementForClusterParams using the API param names
+type serializedDisableOutOfBandManagementForClusterParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+}
+
+func (p *DisableOutOfBandManagementForClusterParams) toSerialized() *serializedDisableOutOfBandManagementForClusterParams {
+	s := &serializedDisableOutOfBandManagementForClusterParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	return s
+}
+
+func (p *DisableOutOfBandManagementForClusterParams) fromSerialized(s *serializedDisableOutOfBandManagementForClusterParams) {
+	*p = DisableOutOfBandManagementForClusterParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableOutOfBandManagementForClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableOutOfBandManagementForClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableOutOfBandManagementForClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableOutOfBandManagementForClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableOutOfBandManagementForClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableOutOfBandManagementForClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableOutOfBandManagementForClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewDisableOutOfBandManagementForClusterParams(clusterid string) *DisableOutOfBandManagementForClusterParams {
 	p := &DisableOutOfBandManagementForClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
+	p.SetClusterid(clusterid)
 	return p
 }
 
-// Disables out-of-band management for a cluster
-func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForCluster", p.toURLValues())
+// Disables out-of-band management for a cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: clusterid.
+func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams, opts ...CallOption) (*DisableOutOfBandManagementForClusterResponse, error) {
+	resp, err := s.cs.newRequest("disableOutOfBandManagementForCluster", s.cs.encodeParams("disableOutOfBandManagementForCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -888,8 +1326,8 @@ func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBan
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -927,53 +1365,133 @@ type DisableOutOfBandManagementForClusterResponse struct {
 }
 
 type EnableOutOfBandManagementForClusterParams struct {
-	p map[string]interface{}
+	clusterid optString
 }
 
-func (p *EnableOutOfBandManagementForClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableOutOfBandManagementForClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
 	return u
 }
 
-func (p *EnableOutOfBandManagementForClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableOutOfBandManagementForClusterParams parses url.Values, for example taken from a raw API request,
+// into a new EnableOutOfBandManagementForClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableOutOfBandManagementForClusterParams(u url.Values) (*EnableOutOfBandManagementForClusterParams, error) {
+	p := &EnableOutOfBandManagementForClusterParams{}
+	if err := checkParamNames("enableOutOfBandManagementForCluster", u, "clusterid"); err != nil {
+		return nil, err
 	}
-	p.p["clusterid"] = v
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param. This param is required.
+func (p *EnableOutOfBandManagementForClusterParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *EnableOutOfBandManagementForClusterParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *EnableOutOfBandManagementForClusterParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.clusterid.v, p.clusterid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableOutOfBandManagementForClusterParams) Clone() *EnableOutOfBandManagementForClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableOutOfBandManagementForClusterParams) Equal(o *EnableOutOfBandManagementForClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid == o.clusterid
+}
+
+// serializedEnableOutOfBandManagementForClusterParams is used to (un)marshal EnableOutOfBandManagementForClusterParams using the API param names
+type serializedEnableOutOfBandManagementForClusterParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+}
+
+func (p *EnableOutOfBandManagementForClusterParams) toSerialized() *serializedEnableOutOfBandManagementForClusterParams {
+	s := &serializedEnableOutOfBandManagementForClusterParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	return s
+}
+
+func (p *EnableOutOfBandManagementForClusterParams) fromSerialized(s *serializedEnableOutOfBandManagementForClusterParams) {
+	*p = EnableOutOfBandManagementForClusterParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableOutOfBandManagementForClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableOutOfBandManagementForClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableOutOfBandManagementForClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableOutOfBandManagementForClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableOutOfBandManagementForClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableOutOfBandManagementForClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableOutOfBandManagementForClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewEnableOutOfBandManagementForClusterParams(clusterid string) *EnableOutOfBandManagementForClusterParams {
 	p := &EnableOutOfBandManagementForClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
+	p.SetClusterid(clusterid)
 	return p
 }
 
-// Enables out-of-band management for a cluster
-func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForCluster", p.toURLValues())
+// Enables out-of-band management for a cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: clusterid.
+func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams, opts ...CallOption) (*EnableOutOfBandManagementForClusterResponse, error) {
+	resp, err := s.cs.newRequest("enableOutOfBandManagementForCluster", s.cs.encodeParams("enableOutOfBandManagementForCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -984,8 +1502,8 @@ func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandM
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1023,53 +1541,133 @@ type EnableOutOfBandManagementForClusterResponse struct {
 }
 
 type EnableHAForClusterParams struct {
-	p map[string]interface{}
+	clusterid optString
 }
 
-func (p *EnableHAForClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableHAForClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
 	return u
 }
 
-func (p *EnableHAForClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableHAForClusterParams parses url.Values, for example taken from a raw API request,
+// into a new EnableHAForClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableHAForClusterParams(u url.Values) (*EnableHAForClusterParams, error) {
+	p := &EnableHAForClusterParams{}
+	if err := checkParamNames("enableHAForCluster", u, "clusterid"); err != nil {
+		return nil, err
 	}
-	p.p["clusterid"] = v
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param. This param is required.
+func (p *EnableHAForClusterParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *EnableHAForClusterParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *EnableHAForClusterParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.clusterid.v, p.clusterid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableHAForClusterParams) Clone() *EnableHAForClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableHAForClusterParams) Equal(o *EnableHAForClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid
+}
+
+// serializedEnableHAForClusterParams is used to (un)marshal EnableHAForClusterParams using the API param names
+type serializedEnableHAForClusterParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+}
+
+func (p *EnableHAForClusterParams) toSerialized() *serializedEnableHAForClusterParams {
+	s := &serializedEnableHAForClusterParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
 	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *EnableHAForClusterParams) fromSerialized(s *serializedEnableHAForClusterParams) {
+	*p = EnableHAForClusterParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableHAForClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableHAForClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableHAForClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableHAForClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableHAForClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableHAForClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableHAForClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewEnableHAForClusterParams(clusterid string) *EnableHAForClusterParams {
 	p := &EnableHAForClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
+	p.SetClusterid(clusterid)
 	return p
 }
 
-// Enables HA cluster-wide
-func (s *ClusterService) EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
-	resp, err := s.cs.newRequest("enableHAForCluster", p.toURLValues())
+// Enables HA cluster-wide.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: clusterid.
+func (s *ClusterService) EnableHAForCluster(p *EnableHAForClusterParams, opts ...CallOption) (*EnableHAForClusterResponse, error) {
+	resp, err := s.cs.newRequest("enableHAForCluster", s.cs.encodeParams("enableHAForCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1080,8 +1678,8 @@ func (s *ClusterService) EnableHAForCluster(p *EnableHAForClusterParams) (*Enabl
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1105,53 +1703,133 @@ type EnableHAForClusterResponse struct {
 }
 
 type DisableHAForClusterParams struct {
-	p map[string]interface{}
+	clusterid optString
 }
 
-func (p *DisableHAForClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableHAForClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
 	return u
 }
 
-func (p *DisableHAForClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableHAForClusterParams parses url.Values, for example taken from a raw API request,
+// into a new DisableHAForClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableHAForClusterParams(u url.Values) (*DisableHAForClusterParams, error) {
+	p := &DisableHAForClusterParams{}
+	if err := checkParamNames("disableHAForCluster", u, "clusterid"); err != nil {
+		return nil, err
 	}
-	p.p["clusterid"] = v
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param. This param is required.
+func (p *DisableHAForClusterParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *DisableHAForClusterParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *DisableHAForClusterParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.clusterid.v, p.clusterid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableHAForClusterParams) Clone() *DisableHAForClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableHAForClusterParams) Equal(o *DisableHAForClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid
+}
+
+// serializedDisableHAForClusterParams is used to (un)marshal DisableHAForClusterParams using the API param names
+type serializedDisableHAForClusterParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+}
+
+func (p *DisableHAForClusterParams) toSerialized() *serializedDisableHAForClusterParams {
+	s := &serializedDisableHAForClusterParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	return s
+}
+
+func (p *DisableHAForClusterParams) fromSerialized(s *serializedDisableHAForClusterParams) {
+	*p = DisableHAForClusterParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableHAForClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableHAForClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableHAForClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableHAForClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableHAForClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableHAForClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableHAForClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewDisableHAForClusterParams(clusterid string) *DisableHAForClusterParams {
 	p := &DisableHAForClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
+	p.SetClusterid(clusterid)
 	return p
 }
 
-// Disables HA cluster-wide
-func (s *ClusterService) DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
-	resp, err := s.cs.newRequest("disableHAForCluster", p.toURLValues())
+// Disables HA cluster-wide.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: clusterid.
+func (s *ClusterService) DisableHAForCluster(p *DisableHAForClusterParams, opts ...CallOption) (*DisableHAForClusterResponse, error) {
+	resp, err := s.cs.newRequest("disableHAForCluster", s.cs.encodeParams("disableHAForCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1162,8 +1840,8 @@ func (s *ClusterService) DisableHAForCluster(p *DisableHAForClusterParams) (*Dis
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1187,322 +1865,473 @@ type DisableHAForClusterResponse struct {
 }
 
 type ListClustersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListClustersParams) toURLValues() url.Values {
+	allocationstate optString
+	clustertype     optString
+	hypervisor      optString
+	id              optString
+	keyword         optString
+	managedstate    optString
+	name            optString
+	page            optInt
+	pagesize        optInt
+	podid           optString
+	showcapacities  optBool
+	zoneid          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListClustersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
+	if p.clustertype.ok {
+		u.Set("clustertype", p.clustertype.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["managedstate"]; found {
-		u.Set("managedstate", v.(string))
+	if p.managedstate.ok {
+		u.Set("managedstate", p.managedstate.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
+	if p.showcapacities.ok {
+		u.Set("showcapacities", strconv.FormatBool(p.showcapacities.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListClustersParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListClustersParams parses url.Values, for example taken from a raw API request,
+// into a new ListClustersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListClustersParams(u url.Values) (*ListClustersParams, error) {
+	p := &ListClustersParams{}
+	if err := checkParamNames("listClusters", u, "allocationstate", "clustertype", "hypervisor", "id", "keyword", "managedstate", "name", "page", "pagesize", "podid", "showcapacities", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["clustertype"]; found {
+		p.SetClustertype(u.Get("clustertype"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["managedstate"]; found {
+		p.SetManagedstate(u.Get("managedstate"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["showcapacities"]; found {
+		v, err := strconv.ParseBool(u.Get("showcapacities"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showcapacities: %v", err)
+		}
+		p.SetShowcapacities(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *ListClustersParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *ListClustersParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *ListClustersParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetClustertype sets the clustertype param.
 func (p *ListClustersParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
+	p.clustertype = optString{v: v, ok: true}
 }
 
+// ResetClustertype unsets the clustertype param
 func (p *ListClustersParams) ResetClustertype() {
-	if p.p != nil && p.p["clustertype"] != nil {
-		delete(p.p, "clustertype")
-	}
+	p.clustertype = optString{}
 }
 
+// GetClustertype returns the clustertype param and if it is set
 func (p *ListClustersParams) GetClustertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustertype"].(string)
-	return value, ok
+	return p.clustertype.v, p.clustertype.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListClustersParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListClustersParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListClustersParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListClustersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListClustersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListClustersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListClustersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListClustersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListClustersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetManagedstate sets the managedstate param.
 func (p *ListClustersParams) SetManagedstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["managedstate"] = v
+	p.managedstate = optString{v: v, ok: true}
 }
 
+// ResetManagedstate unsets the managedstate param
 func (p *ListClustersParams) ResetManagedstate() {
-	if p.p != nil && p.p["managedstate"] != nil {
-		delete(p.p, "managedstate")
-	}
+	p.managedstate = optString{}
 }
 
+// GetManagedstate returns the managedstate param and if it is set
 func (p *ListClustersParams) GetManagedstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["managedstate"].(string)
-	return value, ok
+	return p.managedstate.v, p.managedstate.ok
 }
 
+// SetName sets the name param.
 func (p *ListClustersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListClustersParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListClustersParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListClustersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListClustersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListClustersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListClustersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListClustersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListClustersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListClustersParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListClustersParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListClustersParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetShowcapacities sets the showcapacities param.
 func (p *ListClustersParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
+	p.showcapacities = optBool{v: v, ok: true}
 }
 
+// ResetShowcapacities unsets the showcapacities param
 func (p *ListClustersParams) ResetShowcapacities() {
-	if p.p != nil && p.p["showcapacities"] != nil {
-		delete(p.p, "showcapacities")
-	}
+	p.showcapacities = optBool{}
 }
 
+// GetShowcapacities returns the showcapacities param and if it is set
 func (p *ListClustersParams) GetShowcapacities() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showcapacities"].(bool)
-	return value, ok
+	return p.showcapacities.v, p.showcapacities.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListClustersParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListClustersParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListClustersParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListClustersParams) Clone() *ListClustersParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListClustersParams) Equal(o *ListClustersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.clustertype == o.clustertype &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.managedstate == o.managedstate &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.showcapacities == o.showcapacities &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListClustersParams is used to (un)marshal ListClustersParams using the API param names
+type serializedListClustersParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clustertype     *string `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Hypervisor      *string `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Managedstate    *string `json:"managedstate,omitempty" yaml:"managedstate,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid           *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Showcapacities  *bool   `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListClustersParams) toSerialized() *serializedListClustersParams {
+	s := &serializedListClustersParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.clustertype.ok {
+		s.Clustertype = &p.clustertype.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.managedstate.ok {
+		s.Managedstate = &p.managedstate.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.showcapacities.ok {
+		s.Showcapacities = &p.showcapacities.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListClustersParams) fromSerialized(s *serializedListClustersParams) {
+	*p = ListClustersParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Clustertype != nil {
+		p.SetClustertype(*s.Clustertype)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Managedstate != nil {
+		p.SetManagedstate(*s.Managedstate)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Showcapacities != nil {
+		p.SetShowcapacities(*s.Showcapacities)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListClustersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListClustersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListClustersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListClustersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListClustersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListClustersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListClustersParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewListClustersParams() *ListClustersParams {
 	p := &ListClustersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListClustersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1550,9 +2379,8 @@ func (s *ClusterService) GetClusterByName(name string, opts ...OptionFunc) (*Clu
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error) {
 	p := &ListClustersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1581,8 +2409,8 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 }
 
 // Lists clusters.
-func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
-	resp, err := s.cs.newRequest("listClusters", p.toURLValues())
+func (s *ClusterService) ListClusters(p *ListClustersParams, opts ...CallOption) (*ListClustersResponse, error) {
+	resp, err := s.cs.newRequest("listClusters", s.cs.encodeParams("listClusters", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1637,322 +2465,473 @@ type ClusterCapacity struct {
 }
 
 type ListClustersMetricsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListClustersMetricsParams) toURLValues() url.Values {
+	allocationstate optString
+	clustertype     optString
+	hypervisor      optString
+	id              optString
+	keyword         optString
+	managedstate    optString
+	name            optString
+	page            optInt
+	pagesize        optInt
+	podid           optString
+	showcapacities  optBool
+	zoneid          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListClustersMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
+	}
+	if p.clustertype.ok {
+		u.Set("clustertype", p.clustertype.v)
+	}
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.managedstate.ok {
+		u.Set("managedstate", p.managedstate.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.showcapacities.ok {
+		u.Set("showcapacities", strconv.FormatBool(p.showcapacities.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
-	if v, found := p.p["managedstate"]; found {
-		u.Set("managedstate", v.(string))
+	return u
+}
+
+// ParseListClustersMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListClustersMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListClustersMetricsParams(u url.Values) (*ListClustersMetricsParams, error) {
+	p := &ListClustersMetricsParams{}
+	if err := checkParamNames("listClustersMetrics", u, "allocationstate", "clustertype", "hypervisor", "id", "keyword", "managedstate", "name", "page", "pagesize", "podid", "showcapacities", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["clustertype"]; found {
+		p.SetClustertype(u.Get("clustertype"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if _, found := u["managedstate"]; found {
+		p.SetManagedstate(u.Get("managedstate"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
+	if _, found := u["showcapacities"]; found {
+		v, err := strconv.ParseBool(u.Get("showcapacities"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showcapacities: %v", err)
+		}
+		p.SetShowcapacities(v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	return u
+	return p, nil
 }
 
+// SetAllocationstate sets the allocationstate param.
 func (p *ListClustersMetricsParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *ListClustersMetricsParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *ListClustersMetricsParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetClustertype sets the clustertype param.
 func (p *ListClustersMetricsParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
+	p.clustertype = optString{v: v, ok: true}
 }
 
+// ResetClustertype unsets the clustertype param
 func (p *ListClustersMetricsParams) ResetClustertype() {
-	if p.p != nil && p.p["clustertype"] != nil {
-		delete(p.p, "clustertype")
-	}
+	p.clustertype = optString{}
 }
 
+// GetClustertype returns the clustertype param and if it is set
 func (p *ListClustersMetricsParams) GetClustertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustertype"].(string)
-	return value, ok
+	return p.clustertype.v, p.clustertype.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListClustersMetricsParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListClustersMetricsParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListClustersMetricsParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListClustersMetricsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListClustersMetricsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListClustersMetricsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListClustersMetricsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListClustersMetricsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListClustersMetricsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetManagedstate sets the managedstate param.
 func (p *ListClustersMetricsParams) SetManagedstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["managedstate"] = v
+	p.managedstate = optString{v: v, ok: true}
 }
 
+// ResetManagedstate unsets the managedstate param
 func (p *ListClustersMetricsParams) ResetManagedstate() {
-	if p.p != nil && p.p["managedstate"] != nil {
-		delete(p.p, "managedstate")
-	}
+	p.managedstate = optString{}
 }
 
+// GetManagedstate returns the managedstate param and if it is set
 func (p *ListClustersMetricsParams) GetManagedstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["managedstate"].(string)
-	return value, ok
+	return p.managedstate.v, p.managedstate.ok
 }
 
+// SetName sets the name param.
 func (p *ListClustersMetricsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListClustersMetricsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListClustersMetricsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListClustersMetricsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListClustersMetricsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListClustersMetricsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListClustersMetricsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListClustersMetricsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListClustersMetricsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListClustersMetricsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListClustersMetricsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListClustersMetricsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetShowcapacities sets the showcapacities param.
 func (p *ListClustersMetricsParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
+	p.showcapacities = optBool{v: v, ok: true}
 }
 
+// ResetShowcapacities unsets the showcapacities param
 func (p *ListClustersMetricsParams) ResetShowcapacities() {
-	if p.p != nil && p.p["showcapacities"] != nil {
-		delete(p.p, "showcapacities")
-	}
+	p.showcapacities = optBool{}
 }
 
+// GetShowcapacities returns the showcapacities param and if it is set
 func (p *ListClustersMetricsParams) GetShowcapacities() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showcapacities"].(bool)
-	return value, ok
+	return p.showcapacities.v, p.showcapacities.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListClustersMetricsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListClustersMetricsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListClustersMetricsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListClustersMetricsParams) Clone() *ListClustersMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListClustersMetricsParams) Equal(o *ListClustersMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.clustertype == o.clustertype &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.managedstate == o.managedstate &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.showcapacities == o.showcapacities &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListClustersMetricsParams is used to (un)marshal ListClustersMetricsParams using the API param names
+type serializedListClustersMetricsParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clustertype     *string `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Hypervisor      *string `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Managedstate    *string `json:"managedstate,omitempty" yaml:"managedstate,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid           *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Showcapacities  *bool   `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListClustersMetricsParams) toSerialized() *serializedListClustersMetricsParams {
+	s := &serializedListClustersMetricsParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.clustertype.ok {
+		s.Clustertype = &p.clustertype.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.managedstate.ok {
+		s.Managedstate = &p.managedstate.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.showcapacities.ok {
+		s.Showcapacities = &p.showcapacities.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListClustersMetricsParams) fromSerialized(s *serializedListClustersMetricsParams) {
+	*p = ListClustersMetricsParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Clustertype != nil {
+		p.SetClustertype(*s.Clustertype)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Managedstate != nil {
+		p.SetManagedstate(*s.Managedstate)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Showcapacities != nil {
+		p.SetShowcapacities(*s.Showcapacities)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListClustersMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListClustersMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListClustersMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListClustersMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListClustersMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListClustersMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListClustersMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewListClustersMetricsParams() *ListClustersMetricsParams {
 	p := &ListClustersMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListClustersMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2000,9 +2979,8 @@ func (s *ClusterService) GetClustersMetricByName(name string, opts ...OptionFunc
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error) {
 	p := &ListClustersMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2030,9 +3008,9 @@ func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*
 	return nil, l.Count, fmt.Errorf("There is more then one result for ClustersMetric UUID: %s!", id)
 }
 
-// Lists clusters metrics
-func (s *ClusterService) ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listClustersMetrics", p.toURLValues())
+// Lists clusters metrics.
+func (s *ClusterService) ListClustersMetrics(p *ListClustersMetricsParams, opts ...CallOption) (*ListClustersMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listClustersMetrics", s.cs.encodeParams("listClustersMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2105,198 +3083,317 @@ type ClustersMetricCapacity struct {
 }
 
 type ListDedicatedClustersParams struct {
-	p map[string]interface{}
+	account         optString
+	affinitygroupid optString
+	clusterid       optString
+	domainid        optString
+	keyword         optString
+	page            optInt
+	pagesize        optInt
 }
 
-func (p *ListDedicatedClustersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDedicatedClustersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if p.affinitygroupid.ok {
+		u.Set("affinitygroupid", p.affinitygroupid.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListDedicatedClustersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDedicatedClustersParams parses url.Values, for example taken from a raw API request,
+// into a new ListDedicatedClustersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDedicatedClustersParams(u url.Values) (*ListDedicatedClustersParams, error) {
+	p := &ListDedicatedClustersParams{}
+	if err := checkParamNames("listDedicatedClusters", u, "account", "affinitygroupid", "clusterid", "domainid", "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["affinitygroupid"]; found {
+		p.SetAffinitygroupid(u.Get("affinitygroupid"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListDedicatedClustersParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListDedicatedClustersParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListDedicatedClustersParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAffinitygroupid sets the affinitygroupid param.
 func (p *ListDedicatedClustersParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
+	p.affinitygroupid = optString{v: v, ok: true}
 }
 
+// ResetAffinitygroupid unsets the affinitygroupid param
 func (p *ListDedicatedClustersParams) ResetAffinitygroupid() {
-	if p.p != nil && p.p["affinitygroupid"] != nil {
-		delete(p.p, "affinitygroupid")
-	}
+	p.affinitygroupid = optString{}
 }
 
+// GetAffinitygroupid returns the affinitygroupid param and if it is set
 func (p *ListDedicatedClustersParams) GetAffinitygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupid"].(string)
-	return value, ok
+	return p.affinitygroupid.v, p.affinitygroupid.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ListDedicatedClustersParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListDedicatedClustersParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListDedicatedClustersParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListDedicatedClustersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListDedicatedClustersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListDedicatedClustersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDedicatedClustersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDedicatedClustersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDedicatedClustersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDedicatedClustersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDedicatedClustersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDedicatedClustersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDedicatedClustersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDedicatedClustersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDedicatedClustersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDedicatedClustersParams) Clone() *ListDedicatedClustersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDedicatedClustersParams) Equal(o *ListDedicatedClustersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.affinitygroupid == o.affinitygroupid &&
+		p.clusterid == o.clusterid &&
+		p.domainid == o.domainid &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListDedicatedClustersParams is used to (un)marshal ListDedicatedClustersParams using the API param names
+type serializedListDedicatedClustersParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Clusterid       *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListDedicatedClustersParams) toSerialized() *serializedListDedicatedClustersParams {
+	s := &serializedListDedicatedClustersParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.affinitygroupid.ok {
+		s.Affinitygroupid = &p.affinitygroupid.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListDedicatedClustersParams) fromSerialized(s *serializedListDedicatedClustersParams) {
+	*p = ListDedicatedClustersParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Affinitygroupid != nil {
+		p.SetAffinitygroupid(*s.Affinitygroupid)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDedicatedClustersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDedicatedClustersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDedicatedClustersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDedicatedClustersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDedicatedClustersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDedicatedClustersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDedicatedClustersParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClustersParams {
 	p := &ListDedicatedClustersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists dedicated clusters.
-func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedClusters", p.toURLValues())
+func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams, opts ...CallOption) (*ListDedicatedClustersResponse, error) {
+	resp, err := s.cs.newRequest("listDedicatedClusters", s.cs.encodeParams("listDedicatedClusters", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2326,53 +3423,133 @@ type DedicatedCluster struct {
 }
 
 type ReleaseDedicatedClusterParams struct {
-	p map[string]interface{}
+	clusterid optString
 }
 
-func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleaseDedicatedClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
 	return u
 }
 
-func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleaseDedicatedClusterParams parses url.Values, for example taken from a raw API request,
+// into a new ReleaseDedicatedClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleaseDedicatedClusterParams(u url.Values) (*ReleaseDedicatedClusterParams, error) {
+	p := &ReleaseDedicatedClusterParams{}
+	if err := checkParamNames("releaseDedicatedCluster", u, "clusterid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
 	}
-	p.p["clusterid"] = v
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param. This param is required.
+func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ReleaseDedicatedClusterParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ReleaseDedicatedClusterParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.clusterid.v, p.clusterid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleaseDedicatedClusterParams) Clone() *ReleaseDedicatedClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleaseDedicatedClusterParams) Equal(o *ReleaseDedicatedClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid
+}
+
+// serializedReleaseDedicatedClusterParams is used to (un)marshal ReleaseDedicatedClusterParams using the API param names
+type serializedReleaseDedicatedClusterParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+}
+
+func (p *ReleaseDedicatedClusterParams) toSerialized() *serializedReleaseDedicatedClusterParams {
+	s := &serializedReleaseDedicatedClusterParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
 	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ReleaseDedicatedClusterParams) fromSerialized(s *serializedReleaseDedicatedClusterParams) {
+	*p = ReleaseDedicatedClusterParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleaseDedicatedClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleaseDedicatedClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleaseDedicatedClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleaseDedicatedClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleaseDedicatedClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleaseDedicatedClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleaseDedicatedClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams {
 	p := &ReleaseDedicatedClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
+	p.SetClusterid(clusterid)
 	return p
 }
 
-// Release the dedication for cluster
-func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedCluster", p.toURLValues())
+// Release the dedication for cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: clusterid.
+func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams, opts ...CallOption) (*ReleaseDedicatedClusterResponse, error) {
+	resp, err := s.cs.newRequest("releaseDedicatedCluster", s.cs.encodeParams("releaseDedicatedCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2383,8 +3560,8 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2408,173 +3585,282 @@ type ReleaseDedicatedClusterResponse struct {
 }
 
 type UpdateClusterParams struct {
-	p map[string]interface{}
+	allocationstate optString
+	clustername     optString
+	clustertype     optString
+	hypervisor      optString
+	id              optString
+	managedstate    optString
 }
 
-func (p *UpdateClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
+	if p.clustername.ok {
+		u.Set("clustername", p.clustername.v)
 	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
+	if p.clustertype.ok {
+		u.Set("clustertype", p.clustertype.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["managedstate"]; found {
-		u.Set("managedstate", v.(string))
+	if p.managedstate.ok {
+		u.Set("managedstate", p.managedstate.v)
 	}
 	return u
 }
 
-func (p *UpdateClusterParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateClusterParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateClusterParams(u url.Values) (*UpdateClusterParams, error) {
+	p := &UpdateClusterParams{}
+	if err := checkParamNames("updateCluster", u, "allocationstate", "clustername", "clustertype", "hypervisor", "id", "managedstate"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["clustername"]; found {
+		p.SetClustername(u.Get("clustername"))
+	}
+	if _, found := u["clustertype"]; found {
+		p.SetClustertype(u.Get("clustertype"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["managedstate"]; found {
+		p.SetManagedstate(u.Get("managedstate"))
+	}
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *UpdateClusterParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *UpdateClusterParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *UpdateClusterParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetClustername sets the clustername param.
 func (p *UpdateClusterParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustername"] = v
+	p.clustername = optString{v: v, ok: true}
 }
 
+// ResetClustername unsets the clustername param
 func (p *UpdateClusterParams) ResetClustername() {
-	if p.p != nil && p.p["clustername"] != nil {
-		delete(p.p, "clustername")
-	}
+	p.clustername = optString{}
 }
 
+// GetClustername returns the clustername param and if it is set
 func (p *UpdateClusterParams) GetClustername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustername"].(string)
-	return value, ok
+	return p.clustername.v, p.clustername.ok
 }
 
+// SetClustertype sets the clustertype param.
 func (p *UpdateClusterParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
+	p.clustertype = optString{v: v, ok: true}
 }
 
+// ResetClustertype unsets the clustertype param
 func (p *UpdateClusterParams) ResetClustertype() {
-	if p.p != nil && p.p["clustertype"] != nil {
-		delete(p.p, "clustertype")
-	}
+	p.clustertype = optString{}
 }
 
+// GetClustertype returns the clustertype param and if it is set
 func (p *UpdateClusterParams) GetClustertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustertype"].(string)
-	return value, ok
+	return p.clustertype.v, p.clustertype.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *UpdateClusterParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *UpdateClusterParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *UpdateClusterParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetManagedstate sets the managedstate param.
 func (p *UpdateClusterParams) SetManagedstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["managedstate"] = v
+	p.managedstate = optString{v: v, ok: true}
 }
 
+// ResetManagedstate unsets the managedstate param
 func (p *UpdateClusterParams) ResetManagedstate() {
-	if p.p != nil && p.p["managedstate"] != nil {
-		delete(p.p, "managedstate")
-	}
+	p.managedstate = optString{}
 }
 
+// GetManagedstate returns the managedstate param and if it is set
 func (p *UpdateClusterParams) GetManagedstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.managedstate.v, p.managedstate.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateClusterParams) Clone() *UpdateClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateClusterParams) Equal(o *UpdateClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.clustername == o.clustername &&
+		p.clustertype == o.clustertype &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.managedstate == o.managedstate
+}
+
+// serializedUpdateClusterParams is used to (un)marshal UpdateClusterParams using the API param names
+type serializedUpdateClusterParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clustername     *string `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Clustertype     *string `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Hypervisor      *string `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Managedstate    *string `json:"managedstate,omitempty" yaml:"managedstate,omitempty"`
+}
+
+func (p *UpdateClusterParams) toSerialized() *serializedUpdateClusterParams {
+	s := &serializedUpdateClusterParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.clustername.ok {
+		s.Clustername = &p.clustername.v
+	}
+	if p.clustertype.ok {
+		s.Clustertype = &p.clustertype.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.managedstate.ok {
+		s.Managedstate = &p.managedstate.v
+	}
+	return s
+}
+
+func (p *UpdateClusterParams) fromSerialized(s *serializedUpdateClusterParams) {
+	*p = UpdateClusterParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Clustername != nil {
+		p.SetClustername(*s.Clustername)
+	}
+	if s.Clustertype != nil {
+		p.SetClustertype(*s.Clustertype)
 	}
-	value, ok := p.p["managedstate"].(string)
-	return value, ok
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Managedstate != nil {
+		p.SetManagedstate(*s.Managedstate)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams {
 	p := &UpdateClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates an existing cluster
-func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
-	resp, err := s.cs.newRequest("updateCluster", p.toURLValues())
+// Updates an existing cluster.
+//
+// Required params: id.
+func (s *ClusterService) UpdateCluster(p *UpdateClusterParams, opts ...CallOption) (*UpdateClusterResponse, error) {
+	resp, err := s.cs.newRequest("updateCluster", s.cs.encodeParams("updateCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
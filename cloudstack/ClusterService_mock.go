@@ -53,108 +53,143 @@ func (m *MockClusterServiceIface) EXPECT() *MockClusterServiceIfaceMockRecorder
 }
 
 // AddCluster mocks base method.
-func (m *MockClusterServiceIface) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
+func (m *MockClusterServiceIface) AddCluster(p *AddClusterParams, opts ...CallOption) (*AddClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddCluster", varargs...)
 	ret0, _ := ret[0].(*AddClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddCluster indicates an expected call of AddCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) AddCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) AddCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).AddCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).AddCluster), varargs...)
 }
 
 // DedicateCluster mocks base method.
-func (m *MockClusterServiceIface) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+func (m *MockClusterServiceIface) DedicateCluster(p *DedicateClusterParams, opts ...CallOption) (*DedicateClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DedicateCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DedicateCluster", varargs...)
 	ret0, _ := ret[0].(*DedicateClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DedicateCluster indicates an expected call of DedicateCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) DedicateCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) DedicateCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DedicateCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DedicateCluster), varargs...)
 }
 
 // DeleteCluster mocks base method.
-func (m *MockClusterServiceIface) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+func (m *MockClusterServiceIface) DeleteCluster(p *DeleteClusterParams, opts ...CallOption) (*DeleteClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCluster", varargs...)
 	ret0, _ := ret[0].(*DeleteClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteCluster indicates an expected call of DeleteCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) DeleteCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) DeleteCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DeleteCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DeleteCluster), varargs...)
 }
 
 // DisableHAForCluster mocks base method.
-func (m *MockClusterServiceIface) DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
+func (m *MockClusterServiceIface) DisableHAForCluster(p *DisableHAForClusterParams, opts ...CallOption) (*DisableHAForClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableHAForCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableHAForCluster", varargs...)
 	ret0, _ := ret[0].(*DisableHAForClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableHAForCluster indicates an expected call of DisableHAForCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) DisableHAForCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) DisableHAForCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableHAForCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableHAForCluster), varargs...)
 }
 
 // DisableOutOfBandManagementForCluster mocks base method.
-func (m *MockClusterServiceIface) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
+func (m *MockClusterServiceIface) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams, opts ...CallOption) (*DisableOutOfBandManagementForClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForCluster", varargs...)
 	ret0, _ := ret[0].(*DisableOutOfBandManagementForClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableOutOfBandManagementForCluster indicates an expected call of DisableOutOfBandManagementForCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) DisableOutOfBandManagementForCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) DisableOutOfBandManagementForCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableOutOfBandManagementForCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableOutOfBandManagementForCluster), varargs...)
 }
 
 // EnableHAForCluster mocks base method.
-func (m *MockClusterServiceIface) EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
+func (m *MockClusterServiceIface) EnableHAForCluster(p *EnableHAForClusterParams, opts ...CallOption) (*EnableHAForClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableHAForCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableHAForCluster", varargs...)
 	ret0, _ := ret[0].(*EnableHAForClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableHAForCluster indicates an expected call of EnableHAForCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) EnableHAForCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) EnableHAForCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableHAForCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableHAForCluster), varargs...)
 }
 
 // EnableOutOfBandManagementForCluster mocks base method.
-func (m *MockClusterServiceIface) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
+func (m *MockClusterServiceIface) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams, opts ...CallOption) (*EnableOutOfBandManagementForClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForCluster", varargs...)
 	ret0, _ := ret[0].(*EnableOutOfBandManagementForClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableOutOfBandManagementForCluster indicates an expected call of EnableOutOfBandManagementForCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) EnableOutOfBandManagementForCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) EnableOutOfBandManagementForCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableOutOfBandManagementForCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableOutOfBandManagementForCluster), varargs...)
 }
 
 // GetClusterByID mocks base method.
@@ -284,48 +319,63 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricID(name interfac
 }
 
 // ListClusters mocks base method.
-func (m *MockClusterServiceIface) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
+func (m *MockClusterServiceIface) ListClusters(p *ListClustersParams, opts ...CallOption) (*ListClustersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClusters", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusters", varargs...)
 	ret0, _ := ret[0].(*ListClustersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListClusters indicates an expected call of ListClusters.
-func (mr *MockClusterServiceIfaceMockRecorder) ListClusters(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) ListClusters(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusters), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusters), varargs...)
 }
 
 // ListClustersMetrics mocks base method.
-func (m *MockClusterServiceIface) ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
+func (m *MockClusterServiceIface) ListClustersMetrics(p *ListClustersMetricsParams, opts ...CallOption) (*ListClustersMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClustersMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersMetrics", varargs...)
 	ret0, _ := ret[0].(*ListClustersMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListClustersMetrics indicates an expected call of ListClustersMetrics.
-func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetrics(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetrics", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetrics", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetrics), varargs...)
 }
 
 // ListDedicatedClusters mocks base method.
-func (m *MockClusterServiceIface) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+func (m *MockClusterServiceIface) ListDedicatedClusters(p *ListDedicatedClustersParams, opts ...CallOption) (*ListDedicatedClustersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDedicatedClusters", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedClusters", varargs...)
 	ret0, _ := ret[0].(*ListDedicatedClustersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDedicatedClusters indicates an expected call of ListDedicatedClusters.
-func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClusters(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClusters(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClusters), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClusters), varargs...)
 }
 
 // NewAddClusterParams mocks base method.
@@ -497,31 +547,41 @@ func (mr *MockClusterServiceIfaceMockRecorder) NewUpdateClusterParams(id interfa
 }
 
 // ReleaseDedicatedCluster mocks base method.
-func (m *MockClusterServiceIface) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
+func (m *MockClusterServiceIface) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams, opts ...CallOption) (*ReleaseDedicatedClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseDedicatedCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseDedicatedCluster", varargs...)
 	ret0, _ := ret[0].(*ReleaseDedicatedClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseDedicatedCluster indicates an expected call of ReleaseDedicatedCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) ReleaseDedicatedCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) ReleaseDedicatedCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).ReleaseDedicatedCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).ReleaseDedicatedCluster), varargs...)
 }
 
 // UpdateCluster mocks base method.
-func (m *MockClusterServiceIface) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+func (m *MockClusterServiceIface) UpdateCluster(p *UpdateClusterParams, opts ...CallOption) (*UpdateClusterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCluster", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCluster", varargs...)
 	ret0, _ := ret[0].(*UpdateClusterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCluster indicates an expected call of UpdateCluster.
-func (mr *MockClusterServiceIfaceMockRecorder) UpdateCluster(p interface{}) *gomock.Call {
+func (mr *MockClusterServiceIfaceMockRecorder) UpdateCluster(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).UpdateCluster), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).UpdateCluster), varargs...)
 }
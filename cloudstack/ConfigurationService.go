@@ -21,46 +21,119 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type ConfigurationServiceIface interface {
-	ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	ListCapabilities(p *ListCapabilitiesParams, opts ...CallOption) (*ListCapabilitiesResponse, error)
 	NewListCapabilitiesParams() *ListCapabilitiesParams
-	ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListConfigurations(p *ListConfigurationsParams, opts ...CallOption) (*ListConfigurationsResponse, error)
 	NewListConfigurationsParams() *ListConfigurationsParams
-	ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlanners(p *ListDeploymentPlannersParams, opts ...CallOption) (*ListDeploymentPlannersResponse, error)
 	NewListDeploymentPlannersParams() *ListDeploymentPlannersParams
-	UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+	UpdateConfiguration(p *UpdateConfigurationParams, opts ...CallOption) (*UpdateConfigurationResponse, error)
 	NewUpdateConfigurationParams(name string) *UpdateConfigurationParams
-	ResetConfiguration(p *ResetConfigurationParams) (*ResetConfigurationResponse, error)
+	ResetConfiguration(p *ResetConfigurationParams, opts ...CallOption) (*ResetConfigurationResponse, error)
 	NewResetConfigurationParams(name string) *ResetConfigurationParams
 }
 
 type ListCapabilitiesParams struct {
-	p map[string]interface{}
 }
 
-func (p *ListCapabilitiesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListCapabilitiesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseListCapabilitiesParams parses url.Values, for example taken from a raw API request,
+// into a new ListCapabilitiesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListCapabilitiesParams(u url.Values) (*ListCapabilitiesParams, error) {
+	p := &ListCapabilitiesParams{}
+	if err := checkParamNames("listCapabilities", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *ListCapabilitiesParams) Clone() *ListCapabilitiesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListCapabilitiesParams) Equal(o *ListCapabilitiesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedListCapabilitiesParams is used to (un)marshal ListCapabilitiesParams using the API param names
+type serializedListCapabilitiesParams struct {
+}
+
+func (p *ListCapabilitiesParams) toSerialized() *serializedListCapabilitiesParams {
+	s := &serializedListCapabilitiesParams{}
+	return s
+}
+
+func (p *ListCapabilitiesParams) fromSerialized(s *serializedListCapabilitiesParams) {
+	*p = ListCapabilitiesParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListCapabilitiesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListCapabilitiesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListCapabilitiesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListCapabilitiesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new ListCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesParams {
 	p := &ListCapabilitiesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists capabilities
-func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listCapabilities", p.toURLValues())
+// Lists capabilities.
+func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams, opts ...CallOption) (*ListCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequest("listCapabilities", s.cs.encodeParams("listCapabilities", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,366 +181,527 @@ type Capability struct {
 }
 
 type ListConfigurationsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListConfigurationsParams) toURLValues() url.Values {
+	accountid      optString
+	category       optString
+	clusterid      optString
+	domainid       optString
+	group          optString
+	imagestoreuuid optString
+	keyword        optString
+	name           optString
+	page           optInt
+	pagesize       optInt
+	parent         optString
+	storageid      optString
+	subgroup       optString
+	zoneid         optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListConfigurationsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["category"]; found {
-		u.Set("category", v.(string))
+	if p.category.ok {
+		u.Set("category", p.category.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["group"]; found {
-		u.Set("group", v.(string))
+	if p.group.ok {
+		u.Set("group", p.group.v)
 	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
+	if p.imagestoreuuid.ok {
+		u.Set("imagestoreuuid", p.imagestoreuuid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["parent"]; found {
-		u.Set("parent", v.(string))
+	if p.parent.ok {
+		u.Set("parent", p.parent.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["subgroup"]; found {
-		u.Set("subgroup", v.(string))
+	if p.subgroup.ok {
+		u.Set("subgroup", p.subgroup.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListConfigurationsParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListConfigurationsParams parses url.Values, for example taken from a raw API request,
+// into a new ListConfigurationsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListConfigurationsParams(u url.Values) (*ListConfigurationsParams, error) {
+	p := &ListConfigurationsParams{}
+	if err := checkParamNames("listConfigurations", u, "accountid", "category", "clusterid", "domainid", "group", "imagestoreuuid", "keyword", "name", "page", "pagesize", "parent", "storageid", "subgroup", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["category"]; found {
+		p.SetCategory(u.Get("category"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["group"]; found {
+		p.SetGroup(u.Get("group"))
+	}
+	if _, found := u["imagestoreuuid"]; found {
+		p.SetImagestoreuuid(u.Get("imagestoreuuid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["accountid"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["parent"]; found {
+		p.SetParent(u.Get("parent"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["subgroup"]; found {
+		p.SetSubgroup(u.Get("subgroup"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccountid sets the accountid param.
+func (p *ListConfigurationsParams) SetAccountid(v string) {
+	p.accountid = optString{v: v, ok: true}
+}
+
+// ResetAccountid unsets the accountid param
 func (p *ListConfigurationsParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *ListConfigurationsParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetCategory sets the category param.
 func (p *ListConfigurationsParams) SetCategory(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["category"] = v
+	p.category = optString{v: v, ok: true}
 }
 
+// ResetCategory unsets the category param
 func (p *ListConfigurationsParams) ResetCategory() {
-	if p.p != nil && p.p["category"] != nil {
-		delete(p.p, "category")
-	}
+	p.category = optString{}
 }
 
+// GetCategory returns the category param and if it is set
 func (p *ListConfigurationsParams) GetCategory() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["category"].(string)
-	return value, ok
+	return p.category.v, p.category.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ListConfigurationsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListConfigurationsParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListConfigurationsParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListConfigurationsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListConfigurationsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListConfigurationsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetGroup sets the group param.
 func (p *ListConfigurationsParams) SetGroup(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["group"] = v
+	p.group = optString{v: v, ok: true}
 }
 
+// ResetGroup unsets the group param
 func (p *ListConfigurationsParams) ResetGroup() {
-	if p.p != nil && p.p["group"] != nil {
-		delete(p.p, "group")
-	}
+	p.group = optString{}
 }
 
+// GetGroup returns the group param and if it is set
 func (p *ListConfigurationsParams) GetGroup() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["group"].(string)
-	return value, ok
+	return p.group.v, p.group.ok
 }
 
+// SetImagestoreuuid sets the imagestoreuuid param.
 func (p *ListConfigurationsParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreuuid"] = v
+	p.imagestoreuuid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreuuid unsets the imagestoreuuid param
 func (p *ListConfigurationsParams) ResetImagestoreuuid() {
-	if p.p != nil && p.p["imagestoreuuid"] != nil {
-		delete(p.p, "imagestoreuuid")
-	}
+	p.imagestoreuuid = optString{}
 }
 
+// GetImagestoreuuid returns the imagestoreuuid param and if it is set
 func (p *ListConfigurationsParams) GetImagestoreuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreuuid"].(string)
-	return value, ok
+	return p.imagestoreuuid.v, p.imagestoreuuid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListConfigurationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListConfigurationsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListConfigurationsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListConfigurationsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListConfigurationsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListConfigurationsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListConfigurationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListConfigurationsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListConfigurationsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListConfigurationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListConfigurationsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListConfigurationsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetParent sets the parent param.
 func (p *ListConfigurationsParams) SetParent(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["parent"] = v
+	p.parent = optString{v: v, ok: true}
 }
 
+// ResetParent unsets the parent param
 func (p *ListConfigurationsParams) ResetParent() {
-	if p.p != nil && p.p["parent"] != nil {
-		delete(p.p, "parent")
-	}
+	p.parent = optString{}
 }
 
+// GetParent returns the parent param and if it is set
 func (p *ListConfigurationsParams) GetParent() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["parent"].(string)
-	return value, ok
+	return p.parent.v, p.parent.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListConfigurationsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListConfigurationsParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListConfigurationsParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetSubgroup sets the subgroup param.
 func (p *ListConfigurationsParams) SetSubgroup(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["subgroup"] = v
+	p.subgroup = optString{v: v, ok: true}
 }
 
+// ResetSubgroup unsets the subgroup param
 func (p *ListConfigurationsParams) ResetSubgroup() {
-	if p.p != nil && p.p["subgroup"] != nil {
-		delete(p.p, "subgroup")
-	}
+	p.subgroup = optString{}
 }
 
+// GetSubgroup returns the subgroup param and if it is set
 func (p *ListConfigurationsParams) GetSubgroup() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["subgroup"].(string)
-	return value, ok
+	return p.subgroup.v, p.subgroup.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListConfigurationsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListConfigurationsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListConfigurationsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListConfigurationsParams) Clone() *ListConfigurationsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListConfigurationsParams) Equal(o *ListConfigurationsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accountid == o.accountid &&
+		p.category == o.category &&
+		p.clusterid == o.clusterid &&
+		p.domainid == o.domainid &&
+		p.group == o.group &&
+		p.imagestoreuuid == o.imagestoreuuid &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.parent == o.parent &&
+		p.storageid == o.storageid &&
+		p.subgroup == o.subgroup &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListConfigurationsParams is used to (un)marshal ListConfigurationsParams using the API param names
+type serializedListConfigurationsParams struct {
+	Accountid      *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Category       *string `json:"category,omitempty" yaml:"category,omitempty"`
+	Clusterid      *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid       *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Group          *string `json:"group,omitempty" yaml:"group,omitempty"`
+	Imagestoreuuid *string `json:"imagestoreuuid,omitempty" yaml:"imagestoreuuid,omitempty"`
+	Keyword        *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name           *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page           *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Parent         *string `json:"parent,omitempty" yaml:"parent,omitempty"`
+	Storageid      *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Subgroup       *string `json:"subgroup,omitempty" yaml:"subgroup,omitempty"`
+	Zoneid         *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListConfigurationsParams) toSerialized() *serializedListConfigurationsParams {
+	s := &serializedListConfigurationsParams{}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.category.ok {
+		s.Category = &p.category.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.group.ok {
+		s.Group = &p.group.v
+	}
+	if p.imagestoreuuid.ok {
+		s.Imagestoreuuid = &p.imagestoreuuid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.parent.ok {
+		s.Parent = &p.parent.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.subgroup.ok {
+		s.Subgroup = &p.subgroup.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListConfigurationsParams) fromSerialized(s *serializedListConfigurationsParams) {
+	*p = ListConfigurationsParams{}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Category != nil {
+		p.SetCategory(*s.Category)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Group != nil {
+		p.SetGroup(*s.Group)
+	}
+	if s.Imagestoreuuid != nil {
+		p.SetImagestoreuuid(*s.Imagestoreuuid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Parent != nil {
+		p.SetParent(*s.Parent)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Subgroup != nil {
+		p.SetSubgroup(*s.Subgroup)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListConfigurationsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListConfigurationsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListConfigurationsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListConfigurationsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListConfigurationsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListConfigurationsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListConfigurationsParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListConfigurationsParams() *ListConfigurationsParams {
 	p := &ListConfigurationsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists all configurations.
-func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listConfigurations", p.toURLValues())
+func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams, opts ...CallOption) (*ListConfigurationsResponse, error) {
+	resp, err := s.cs.newRequest("listConfigurations", s.cs.encodeParams("listConfigurations", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -506,102 +740,197 @@ type Configuration struct {
 }
 
 type ListDeploymentPlannersParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDeploymentPlannersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDeploymentPlannersParams parses url.Values, for example taken from a raw API request,
+// into a new ListDeploymentPlannersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDeploymentPlannersParams(u url.Values) (*ListDeploymentPlannersParams, error) {
+	p := &ListDeploymentPlannersParams{}
+	if err := checkParamNames("listDeploymentPlanners", u, "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["keyword"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
 }
 
+// SetKeyword sets the keyword param.
+func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
 func (p *ListDeploymentPlannersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDeploymentPlannersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDeploymentPlannersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDeploymentPlannersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDeploymentPlannersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDeploymentPlannersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDeploymentPlannersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDeploymentPlannersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDeploymentPlannersParams) Clone() *ListDeploymentPlannersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDeploymentPlannersParams) Equal(o *ListDeploymentPlannersParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListDeploymentPlannersParams is used to (un)marshal ListDeploymentPlannersParams using the API param names
+type serializedListDeploymentPlannersParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListDeploymentPlannersParams) toSerialized() *serializedListDeploymentPlannersParams {
+	s := &serializedListDeploymentPlannersParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListDeploymentPlannersParams) fromSerialized(s *serializedListDeploymentPlannersParams) {
+	*p = ListDeploymentPlannersParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDeploymentPlannersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDeploymentPlannersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDeploymentPlannersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDeploymentPlannersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDeploymentPlannersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDeploymentPlannersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDeploymentPlannersParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListDeploymentPlannersParams() *ListDeploymentPlannersParams {
 	p := &ListDeploymentPlannersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists all DeploymentPlanners available.
-func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
-	resp, err := s.cs.newRequest("listDeploymentPlanners", p.toURLValues())
+func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams, opts ...CallOption) (*ListDeploymentPlannersResponse, error) {
+	resp, err := s.cs.newRequest("listDeploymentPlanners", s.cs.encodeParams("listDeploymentPlanners", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -626,221 +955,342 @@ type DeploymentPlanner struct {
 }
 
 type UpdateConfigurationParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateConfigurationParams) toURLValues() url.Values {
+	accountid      optString
+	clusterid      optString
+	domainid       optString
+	imagestoreuuid optString
+	name           optString
+	storageid      optString
+	value          optString
+	zoneid         optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateConfigurationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
+	if p.imagestoreuuid.ok {
+		u.Set("imagestoreuuid", p.imagestoreuuid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["value"]; found {
-		u.Set("value", v.(string))
+	if p.value.ok {
+		u.Set("value", p.value.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *UpdateConfigurationParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateConfigurationParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateConfigurationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateConfigurationParams(u url.Values) (*UpdateConfigurationParams, error) {
+	p := &UpdateConfigurationParams{}
+	if err := checkParamNames("updateConfiguration", u, "accountid", "clusterid", "domainid", "imagestoreuuid", "name", "storageid", "value", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["imagestoreuuid"]; found {
+		p.SetImagestoreuuid(u.Get("imagestoreuuid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["value"]; found {
+		p.SetValue(u.Get("value"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["accountid"] = v
+	return p, nil
 }
 
+// SetAccountid sets the accountid param.
+func (p *UpdateConfigurationParams) SetAccountid(v string) {
+	p.accountid = optString{v: v, ok: true}
+}
+
+// ResetAccountid unsets the accountid param
 func (p *UpdateConfigurationParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *UpdateConfigurationParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *UpdateConfigurationParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *UpdateConfigurationParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *UpdateConfigurationParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateConfigurationParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateConfigurationParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateConfigurationParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetImagestoreuuid sets the imagestoreuuid param.
 func (p *UpdateConfigurationParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreuuid"] = v
+	p.imagestoreuuid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreuuid unsets the imagestoreuuid param
 func (p *UpdateConfigurationParams) ResetImagestoreuuid() {
-	if p.p != nil && p.p["imagestoreuuid"] != nil {
-		delete(p.p, "imagestoreuuid")
-	}
+	p.imagestoreuuid = optString{}
 }
 
+// GetImagestoreuuid returns the imagestoreuuid param and if it is set
 func (p *UpdateConfigurationParams) GetImagestoreuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreuuid"].(string)
-	return value, ok
+	return p.imagestoreuuid.v, p.imagestoreuuid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *UpdateConfigurationParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateConfigurationParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateConfigurationParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *UpdateConfigurationParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *UpdateConfigurationParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *UpdateConfigurationParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetValue sets the value param.
 func (p *UpdateConfigurationParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["value"] = v
+	p.value = optString{v: v, ok: true}
 }
 
+// ResetValue unsets the value param
 func (p *UpdateConfigurationParams) ResetValue() {
-	if p.p != nil && p.p["value"] != nil {
-		delete(p.p, "value")
-	}
+	p.value = optString{}
 }
 
+// GetValue returns the value param and if it is set
 func (p *UpdateConfigurationParams) GetValue() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["value"].(string)
-	return value, ok
+	return p.value.v, p.value.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *UpdateConfigurationParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *UpdateConfigurationParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *UpdateConfigurationParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateConfigurationParams) Clone() *UpdateConfigurationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateConfigurationParams) Equal(o *UpdateConfigurationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accountid == o.accountid &&
+		p.clusterid == o.clusterid &&
+		p.domainid == o.domainid &&
+		p.imagestoreuuid == o.imagestoreuuid &&
+		p.name == o.name &&
+		p.storageid == o.storageid &&
+		p.value == o.value &&
+		p.zoneid == o.zoneid
+}
+
+// serializedUpdateConfigurationParams is used to (un)marshal UpdateConfigurationParams using the API param names
+type serializedUpdateConfigurationParams struct {
+	Accountid      *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Clusterid      *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid       *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Imagestoreuuid *string `json:"imagestoreuuid,omitempty" yaml:"imagestoreuuid,omitempty"`
+	Name           *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Storageid      *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Value          *string `json:"value,omitempty" yaml:"value,omitempty"`
+	Zoneid         *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *UpdateConfigurationParams) toSerialized() *serializedUpdateConfigurationParams {
+	s := &serializedUpdateConfigurationParams{}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.imagestoreuuid.ok {
+		s.Imagestoreuuid = &p.imagestoreuuid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.value.ok {
+		s.Value = &p.value.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *UpdateConfigurationParams) fromSerialized(s *serializedUpdateConfigurationParams) {
+	*p = UpdateConfigurationParams{}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Imagestoreuuid != nil {
+		p.SetImagestoreuuid(*s.Imagestoreuuid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Value != nil {
+		p.SetValue(*s.Value)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateConfigurationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateConfigurationParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateConfigurationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateConfigurationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateConfigurationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateConfigurationParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateConfigurationParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *UpdateConfigurationParams {
 	p := &UpdateConfigurationParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
 // Updates a configuration.
-func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("updateConfiguration", p.toURLValues())
+//
+// Required params: name.
+func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams, opts ...CallOption) (*UpdateConfigurationResponse, error) {
+	resp, err := s.cs.newRequest("updateConfiguration", s.cs.encodeParams("updateConfiguration", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -878,197 +1328,313 @@ type UpdateConfigurationResponse struct {
 }
 
 type ResetConfigurationParams struct {
-	p map[string]interface{}
+	accountid    optString
+	clusterid    optString
+	domainid     optString
+	imagestoreid optString
+	name         optString
+	storageid    optString
+	zoneid       optString
 }
 
-func (p *ResetConfigurationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResetConfigurationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["imagestoreid"]; found {
-		u.Set("imagestoreid", v.(string))
+	if p.imagestoreid.ok {
+		u.Set("imagestoreid", p.imagestoreid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ResetConfigurationParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseResetConfigurationParams parses url.Values, for example taken from a raw API request,
+// into a new ResetConfigurationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResetConfigurationParams(u url.Values) (*ResetConfigurationParams, error) {
+	p := &ResetConfigurationParams{}
+	if err := checkParamNames("resetConfiguration", u, "accountid", "clusterid", "domainid", "imagestoreid", "name", "storageid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["imagestoreid"]; found {
+		p.SetImagestoreid(u.Get("imagestoreid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["accountid"] = v
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccountid sets the accountid param.
+func (p *ResetConfigurationParams) SetAccountid(v string) {
+	p.accountid = optString{v: v, ok: true}
 }
 
+// ResetAccountid unsets the accountid param
 func (p *ResetConfigurationParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *ResetConfigurationParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ResetConfigurationParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ResetConfigurationParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ResetConfigurationParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ResetConfigurationParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ResetConfigurationParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ResetConfigurationParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetImagestoreid sets the imagestoreid param.
 func (p *ResetConfigurationParams) SetImagestoreid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreid"] = v
+	p.imagestoreid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreid unsets the imagestoreid param
 func (p *ResetConfigurationParams) ResetImagestoreid() {
-	if p.p != nil && p.p["imagestoreid"] != nil {
-		delete(p.p, "imagestoreid")
-	}
+	p.imagestoreid = optString{}
 }
 
+// GetImagestoreid returns the imagestoreid param and if it is set
 func (p *ResetConfigurationParams) GetImagestoreid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreid"].(string)
-	return value, ok
+	return p.imagestoreid.v, p.imagestoreid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *ResetConfigurationParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ResetConfigurationParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ResetConfigurationParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ResetConfigurationParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ResetConfigurationParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ResetConfigurationParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ResetConfigurationParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ResetConfigurationParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ResetConfigurationParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResetConfigurationParams) Clone() *ResetConfigurationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResetConfigurationParams) Equal(o *ResetConfigurationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accountid == o.accountid &&
+		p.clusterid == o.clusterid &&
+		p.domainid == o.domainid &&
+		p.imagestoreid == o.imagestoreid &&
+		p.name == o.name &&
+		p.storageid == o.storageid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedResetConfigurationParams is used to (un)marshal ResetConfigurationParams using the API param names
+type serializedResetConfigurationParams struct {
+	Accountid    *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Clusterid    *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Imagestoreid *string `json:"imagestoreid,omitempty" yaml:"imagestoreid,omitempty"`
+	Name         *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Storageid    *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Zoneid       *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ResetConfigurationParams) toSerialized() *serializedResetConfigurationParams {
+	s := &serializedResetConfigurationParams{}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.imagestoreid.ok {
+		s.Imagestoreid = &p.imagestoreid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ResetConfigurationParams) fromSerialized(s *serializedResetConfigurationParams) {
+	*p = ResetConfigurationParams{}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Imagestoreid != nil {
+		p.SetImagestoreid(*s.Imagestoreid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResetConfigurationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResetConfigurationParams) UnmarshalJSON(b []byte) error {
+	var s serializedResetConfigurationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResetConfigurationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResetConfigurationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResetConfigurationParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ResetConfigurationParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewResetConfigurationParams(name string) *ResetConfigurationParams {
 	p := &ResetConfigurationParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
-// Resets a configuration. The configuration will be set to default value for global setting, and removed from account_details or domain_details for Account/Domain settings
-func (s *ConfigurationService) ResetConfiguration(p *ResetConfigurationParams) (*ResetConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("resetConfiguration", p.toURLValues())
+// Resets a configuration. The configuration will be set to default value for global setting, and
+// removed from account_details or domain_details for Account/Domain settings.
+//
+// Required params: name.
+func (s *ConfigurationService) ResetConfiguration(p *ResetConfigurationParams, opts ...CallOption) (*ResetConfigurationResponse, error) {
+	resp, err := s.cs.newRequest("resetConfiguration", s.cs.encodeParams("resetConfiguration", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,48 +53,63 @@ func (m *MockConfigurationServiceIface) EXPECT() *MockConfigurationServiceIfaceM
 }
 
 // ListCapabilities mocks base method.
-func (m *MockConfigurationServiceIface) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+func (m *MockConfigurationServiceIface) ListCapabilities(p *ListCapabilitiesParams, opts ...CallOption) (*ListCapabilitiesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCapabilities", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCapabilities", varargs...)
 	ret0, _ := ret[0].(*ListCapabilitiesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListCapabilities indicates an expected call of ListCapabilities.
-func (mr *MockConfigurationServiceIfaceMockRecorder) ListCapabilities(p interface{}) *gomock.Call {
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListCapabilities(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapabilities", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListCapabilities), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapabilities", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListCapabilities), varargs...)
 }
 
 // ListConfigurations mocks base method.
-func (m *MockConfigurationServiceIface) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+func (m *MockConfigurationServiceIface) ListConfigurations(p *ListConfigurationsParams, opts ...CallOption) (*ListConfigurationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListConfigurations", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurations", varargs...)
 	ret0, _ := ret[0].(*ListConfigurationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListConfigurations indicates an expected call of ListConfigurations.
-func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurations(p interface{}) *gomock.Call {
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurations(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurations", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurations), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurations", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurations), varargs...)
 }
 
 // ListDeploymentPlanners mocks base method.
-func (m *MockConfigurationServiceIface) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+func (m *MockConfigurationServiceIface) ListDeploymentPlanners(p *ListDeploymentPlannersParams, opts ...CallOption) (*ListDeploymentPlannersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDeploymentPlanners", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeploymentPlanners", varargs...)
 	ret0, _ := ret[0].(*ListDeploymentPlannersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDeploymentPlanners indicates an expected call of ListDeploymentPlanners.
-func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlanners(p interface{}) *gomock.Call {
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlanners(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlanners", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlanners), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlanners", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlanners), varargs...)
 }
 
 // NewListCapabilitiesParams mocks base method.
@@ -168,31 +183,41 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) NewUpdateConfigurationParam
 }
 
 // ResetConfiguration mocks base method.
-func (m *MockConfigurationServiceIface) ResetConfiguration(p *ResetConfigurationParams) (*ResetConfigurationResponse, error) {
+func (m *MockConfigurationServiceIface) ResetConfiguration(p *ResetConfigurationParams, opts ...CallOption) (*ResetConfigurationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetConfiguration", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetConfiguration", varargs...)
 	ret0, _ := ret[0].(*ResetConfigurationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResetConfiguration indicates an expected call of ResetConfiguration.
-func (mr *MockConfigurationServiceIfaceMockRecorder) ResetConfiguration(p interface{}) *gomock.Call {
+func (mr *MockConfigurationServiceIfaceMockRecorder) ResetConfiguration(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ResetConfiguration), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ResetConfiguration), varargs...)
 }
 
 // UpdateConfiguration mocks base method.
-func (m *MockConfigurationServiceIface) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+func (m *MockConfigurationServiceIface) UpdateConfiguration(p *UpdateConfigurationParams, opts ...CallOption) (*UpdateConfigurationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateConfiguration", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateConfiguration", varargs...)
 	ret0, _ := ret[0].(*UpdateConfigurationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateConfiguration indicates an expected call of UpdateConfiguration.
-func (mr *MockConfigurationServiceIfaceMockRecorder) UpdateConfiguration(p interface{}) *gomock.Call {
+func (mr *MockConfigurationServiceIfaceMockRecorder) UpdateConfiguration(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).UpdateConfiguration), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).UpdateConfiguration), varargs...)
 }
@@ -26,82 +26,167 @@ import (
 )
 
 type ConsoleEndpointServiceIface interface {
-	CreateConsoleEndpoint(p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error)
+	CreateConsoleEndpoint(p *CreateConsoleEndpointParams, opts ...CallOption) (*CreateConsoleEndpointResponse, error)
 	NewCreateConsoleEndpointParams(virtualmachineid string) *CreateConsoleEndpointParams
 }
 
 type CreateConsoleEndpointParams struct {
-	p map[string]interface{}
+	token            optString
+	virtualmachineid optString
 }
 
-func (p *CreateConsoleEndpointParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateConsoleEndpointParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["token"]; found {
-		u.Set("token", v.(string))
+	if p.token.ok {
+		u.Set("token", p.token.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *CreateConsoleEndpointParams) SetToken(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateConsoleEndpointParams parses url.Values, for example taken from a raw API request,
+// into a new CreateConsoleEndpointParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateConsoleEndpointParams(u url.Values) (*CreateConsoleEndpointParams, error) {
+	p := &CreateConsoleEndpointParams{}
+	if err := checkParamNames("createConsoleEndpoint", u, "token", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["token"]; found {
+		p.SetToken(u.Get("token"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
 	}
-	p.p["token"] = v
+	return p, nil
 }
 
+// SetToken sets the token param.
+func (p *CreateConsoleEndpointParams) SetToken(v string) {
+	p.token = optString{v: v, ok: true}
+}
+
+// ResetToken unsets the token param
 func (p *CreateConsoleEndpointParams) ResetToken() {
-	if p.p != nil && p.p["token"] != nil {
-		delete(p.p, "token")
-	}
+	p.token = optString{}
 }
 
+// GetToken returns the token param and if it is set
 func (p *CreateConsoleEndpointParams) GetToken() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["token"].(string)
-	return value, ok
+	return p.token.v, p.token.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *CreateConsoleEndpointParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *CreateConsoleEndpointParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *CreateConsoleEndpointParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateConsoleEndpointParams) Clone() *CreateConsoleEndpointParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateConsoleEndpointParams) Equal(o *CreateConsoleEndpointParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.token == o.token &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedCreateConsoleEndpointParams is used to (un)marshal CreateConsoleEndpointParams using the API param names
+type serializedCreateConsoleEndpointParams struct {
+	Token            *string `json:"token,omitempty" yaml:"token,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *CreateConsoleEndpointParams) toSerialized() *serializedCreateConsoleEndpointParams {
+	s := &serializedCreateConsoleEndpointParams{}
+	if p.token.ok {
+		s.Token = &p.token.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *CreateConsoleEndpointParams) fromSerialized(s *serializedCreateConsoleEndpointParams) {
+	*p = CreateConsoleEndpointParams{}
+	if s.Token != nil {
+		p.SetToken(*s.Token)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateConsoleEndpointParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateConsoleEndpointParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateConsoleEndpointParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateConsoleEndpointParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateConsoleEndpointParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateConsoleEndpointParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateConsoleEndpointParams instance,
 // as then you are sure you have configured all required params
 func (s *ConsoleEndpointService) NewCreateConsoleEndpointParams(virtualmachineid string) *CreateConsoleEndpointParams {
 	p := &CreateConsoleEndpointParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Create a console endpoint to connect to a VM console
-func (s *ConsoleEndpointService) CreateConsoleEndpoint(p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error) {
-	resp, err := s.cs.newRequest("createConsoleEndpoint", p.toURLValues())
+// Create a console endpoint to connect to a VM console.
+//
+// Required params: virtualmachineid.
+func (s *ConsoleEndpointService) CreateConsoleEndpoint(p *CreateConsoleEndpointParams, opts ...CallOption) (*CreateConsoleEndpointResponse, error) {
+	resp, err := s.cs.newRequest("createConsoleEndpoint", s.cs.encodeParams("createConsoleEndpoint", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,18 +53,23 @@ func (m *MockConsoleEndpointServiceIface) EXPECT() *MockConsoleEndpointServiceIf
 }
 
 // CreateConsoleEndpoint mocks base method.
-func (m *MockConsoleEndpointServiceIface) CreateConsoleEndpoint(p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error) {
+func (m *MockConsoleEndpointServiceIface) CreateConsoleEndpoint(p *CreateConsoleEndpointParams, opts ...CallOption) (*CreateConsoleEndpointResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateConsoleEndpoint", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateConsoleEndpoint", varargs...)
 	ret0, _ := ret[0].(*CreateConsoleEndpointResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateConsoleEndpoint indicates an expected call of CreateConsoleEndpoint.
-func (mr *MockConsoleEndpointServiceIfaceMockRecorder) CreateConsoleEndpoint(p interface{}) *gomock.Call {
+func (mr *MockConsoleEndpointServiceIfaceMockRecorder) CreateConsoleEndpoint(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsoleEndpoint", reflect.TypeOf((*MockConsoleEndpointServiceIface)(nil).CreateConsoleEndpoint), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsoleEndpoint", reflect.TypeOf((*MockConsoleEndpointServiceIface)(nil).CreateConsoleEndpoint), varargs...)
 }
 
 // NewCreateConsoleEndpointParams mocks base method.
@@ -31,9 +31,20 @@ type CustomServiceParams struct {
 	p map[string]interface{}
 }
 
-func (p *CustomServiceParams) toURLValues() url.Values {
+// NewCustomServiceParams returns a new, empty CustomServiceParams instance
+func (s *CustomService) NewCustomServiceParams() *CustomServiceParams {
+	return &CustomServiceParams{p: make(map[string]interface{})}
+}
+
+// ToURLValues encodes the params in the format expected by the API. Lists are
+// joined with a comma and maps are encoded as indexed params sorted by key, so the
+// same params always result in the same encoding:
+//
+//	map[string]string:   name[0].key1=value1&name[1].key2=value2
+//	[]map[string]string: name[0].field1=value1&name[0].field2=value2
+func (p *CustomServiceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil || p.p == nil {
 		return u
 	}
 
@@ -46,16 +57,26 @@ func (p *CustomServiceParams) toURLValues() url.Values {
 		case int64:
 			vv := strconv.FormatInt(t, 10)
 			u.Set(k, vv)
+		case float64:
+			u.Set(k, strconv.FormatFloat(t, 'f', -1, 64))
 		case string:
 			u.Set(k, t)
+		case UUID:
+			u.Set(k, string(t))
 		case []string:
-			u.Set(k, strings.Join(t, ", "))
+			u.Set(k, strings.Join(t, ","))
 		case map[string]string:
-			i := 0
-			for kk, vv := range t {
-				u.Set(fmt.Sprintf("%s[%d].%s", k, i, kk), vv)
-				i++
+			for i, kk := range getSortedKeysFromMap(t) {
+				u.Set(fmt.Sprintf("%s[%d].%s", k, i, kk), t[kk])
+			}
+		case []map[string]string:
+			for i, m := range t {
+				for _, kk := range getSortedKeysFromMap(m) {
+					u.Set(fmt.Sprintf("%s[%d].%s", k, i, kk), m[kk])
+				}
 			}
+		default:
+			u.Set(k, fmt.Sprint(t))
 		}
 	}
 
@@ -68,6 +89,17 @@ func (p *CustomServiceParams) SetParam(param string, v interface{}) {
 	}
 	p.p[param] = v
 }
+
+// SetKeyValueParam sets a map param that is encoded using separate key and value
+// fields, for example `tags[0].key=k&tags[0].value=v`
+func (p *CustomServiceParams) SetKeyValueParam(param string, v map[string]string) {
+	m := make([]map[string]string, 0, len(v))
+	for _, k := range getSortedKeysFromMap(v) {
+		m = append(m, map[string]string{"key": k, "value": v[k]})
+	}
+	p.SetParam(param, m)
+}
+
 func (p *CustomServiceParams) GetParam(param string) (interface{}, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -76,16 +108,23 @@ func (p *CustomServiceParams) GetParam(param string) (interface{}, bool) {
 	return value, ok
 }
 
-func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {
-	resp, err := s.cs.newRequest(api, p.toURLValues())
+func (p *CustomServiceParams) ResetParam(param string) {
+	if p.p != nil {
+		delete(p.p, param)
+	}
+}
+
+func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {
+	resp, err := s.cs.newRequest(api, p.ToURLValues(), opts...)
 	if err != nil {
 		return err
 	}
 
 	return json.Unmarshal(resp, result)
 }
-func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, result interface{}) error {
-	resp, err := s.cs.newPostRequest(api, p.toURLValues())
+
+func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {
+	resp, err := s.cs.newPostRequest(api, p.ToURLValues(), opts...)
 	if err != nil {
 		return err
 	}
@@ -93,5 +132,93 @@ func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, re
 	return json.Unmarshal(resp, result)
 }
 
+// RawRequest calls any API command using the cached listApis metadata of the server.
+// The command and params are validated before anything is sent, commands that require
+// it are sent using a POST call and async commands are handled the same way as the
+// generated API calls. It returns the raw result of the command, which has the same shape
+// for sync calls and (waited for) async calls: an object is returned without the object
+// CloudStack wraps it in, like {"virtualmachine": {...}}, and other results, like lists
+// and success responses, are returned as is.
+func (s *CustomService) RawRequest(api string, p *CustomServiceParams, opts ...CallOption) (json.RawMessage, error) {
+	caps, err := s.cs.APICapabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	u := p.ToURLValues()
+	if err := caps.Validate(api, u); err != nil {
+		return nil, err
+	}
+
+	var resp json.RawMessage
+	if postCommands[strings.ToLower(api)] {
+		resp, err = s.cs.newPostRequest(api, u, opts...)
+	} else {
+		resp, err = s.cs.newRequest(api, u, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if a, _ := caps.API(api); a.Isasync {
+		if o := s.cs.newCallOptions(opts); o.async {
+			var r struct {
+				JobID string `json:"jobid"`
+			}
+			if err := json.Unmarshal(resp, &r); err != nil {
+				return nil, err
+			}
+
+			b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+			if err != nil {
+				if err == AsyncTimeoutErr {
+					return resp, err
+				}
+				return nil, err
+			}
+
+			return rawResult(b), nil
+		}
+	}
+
+	return rawResult(resp), nil
+}
+
+// rawResult removes the object CloudStack wraps a returned object in, which is an object
+// with the name of the returned object as its only key. Other results are returned as is.
+func rawResult(b json.RawMessage) json.RawMessage {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil || len(m) != 1 {
+		return b
+	}
+	for _, v := range m {
+		var o map[string]json.RawMessage
+		if err := json.Unmarshal(v, &o); err == nil && o != nil {
+			return v
+		}
+	}
+	return b
+}
+
+// Request works the same as RawRequest, but unmarshals the result into the given value
+func (s *CustomService) Request(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {
+	resp, err := s.RawRequest(api, p, opts...)
+	if resp == nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp, result); err != nil {
+		return err
+	}
+
+	return err
+}
+
 type CustomServiceIface interface {
+	CustomRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error
+	CustomPostRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error
+	RawRequest(api string, p *CustomServiceParams, opts ...CallOption) (json.RawMessage, error)
+	Request(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error
+	NewCustomServiceParams() *CustomServiceParams
 }
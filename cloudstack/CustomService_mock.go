@@ -24,6 +24,9 @@
 package cloudstack
 
 import (
+	json "encoding/json"
+	reflect "reflect"
+
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -49,3 +52,94 @@ func NewMockCustomServiceIface(ctrl *gomock.Controller) *MockCustomServiceIface
 func (m *MockCustomServiceIface) EXPECT() *MockCustomServiceIfaceMockRecorder {
 	return m.recorder
 }
+
+// CustomPostRequest mocks base method.
+func (m *MockCustomServiceIface) CustomPostRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{api, p, result}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CustomPostRequest", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomPostRequest indicates an expected call of CustomPostRequest.
+func (mr *MockCustomServiceIfaceMockRecorder) CustomPostRequest(api, p, result interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{api, p, result}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomPostRequest", reflect.TypeOf((*MockCustomServiceIface)(nil).CustomPostRequest), varargs...)
+}
+
+// CustomRequest mocks base method.
+func (m *MockCustomServiceIface) CustomRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{api, p, result}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CustomRequest", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomRequest indicates an expected call of CustomRequest.
+func (mr *MockCustomServiceIfaceMockRecorder) CustomRequest(api, p, result interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{api, p, result}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomRequest", reflect.TypeOf((*MockCustomServiceIface)(nil).CustomRequest), varargs...)
+}
+
+// NewCustomServiceParams mocks base method.
+func (m *MockCustomServiceIface) NewCustomServiceParams() *CustomServiceParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCustomServiceParams")
+	ret0, _ := ret[0].(*CustomServiceParams)
+	return ret0
+}
+
+// NewCustomServiceParams indicates an expected call of NewCustomServiceParams.
+func (mr *MockCustomServiceIfaceMockRecorder) NewCustomServiceParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCustomServiceParams", reflect.TypeOf((*MockCustomServiceIface)(nil).NewCustomServiceParams))
+}
+
+// RawRequest mocks base method.
+func (m *MockCustomServiceIface) RawRequest(api string, p *CustomServiceParams, opts ...CallOption) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{api, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RawRequest", varargs...)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RawRequest indicates an expected call of RawRequest.
+func (mr *MockCustomServiceIfaceMockRecorder) RawRequest(api, p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{api, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RawRequest", reflect.TypeOf((*MockCustomServiceIface)(nil).RawRequest), varargs...)
+}
+
+// Request mocks base method.
+func (m *MockCustomServiceIface) Request(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{api, p, result}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Request", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockCustomServiceIfaceMockRecorder) Request(api, p, result interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{api, p, result}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockCustomServiceIface)(nil).Request), varargs...)
+}
@@ -28,814 +28,1139 @@ import (
 )
 
 type DiskOfferingServiceIface interface {
-	CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	CreateDiskOffering(p *CreateDiskOfferingParams, opts ...CallOption) (*CreateDiskOfferingResponse, error)
 	NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams
-	DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	DeleteDiskOffering(p *DeleteDiskOfferingParams, opts ...CallOption) (*DeleteDiskOfferingResponse, error)
 	NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams
-	ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferings(p *ListDiskOfferingsParams, opts ...CallOption) (*ListDiskOfferingsResponse, error)
 	NewListDiskOfferingsParams() *ListDiskOfferingsParams
 	GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error)
 	GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error)
 	GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error)
-	UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+	UpdateDiskOffering(p *UpdateDiskOfferingParams, opts ...CallOption) (*UpdateDiskOfferingResponse, error)
 	NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams
 }
 
 type CreateDiskOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateDiskOfferingParams) toURLValues() url.Values {
+	bytesreadrate             optInt64
+	bytesreadratemax          optInt64
+	bytesreadratemaxlength    optInt64
+	byteswriterate            optInt64
+	byteswriteratemax         optInt64
+	byteswriteratemaxlength   optInt64
+	cachemode                 optString
+	customized                optBool
+	customizediops            optBool
+	details                   optStringMap
+	disksize                  optInt64
+	disksizestrictness        optBool
+	displayoffering           optBool
+	displaytext               optString
+	domainid                  optStrings
+	encrypt                   optBool
+	hypervisorsnapshotreserve optInt
+	iopsreadrate              optInt64
+	iopsreadratemax           optInt64
+	iopsreadratemaxlength     optInt64
+	iopswriterate             optInt64
+	iopswriteratemax          optInt64
+	iopswriteratemaxlength    optInt64
+	maxiops                   optInt64
+	miniops                   optInt64
+	name                      optString
+	provisioningtype          optString
+	storagepolicy             optString
+	storagetype               optString
+	tags                      optString
+	zoneid                    optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateDiskOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bytesreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadrate", vv)
+	if p.bytesreadrate.ok {
+		u.Set("bytesreadrate", strconv.FormatInt(p.bytesreadrate.v, 10))
 	}
-	if v, found := p.p["bytesreadratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadratemax", vv)
+	if p.bytesreadratemax.ok {
+		u.Set("bytesreadratemax", strconv.FormatInt(p.bytesreadratemax.v, 10))
 	}
-	if v, found := p.p["bytesreadratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadratemaxlength", vv)
+	if p.bytesreadratemaxlength.ok {
+		u.Set("bytesreadratemaxlength", strconv.FormatInt(p.bytesreadratemaxlength.v, 10))
 	}
-	if v, found := p.p["byteswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriterate", vv)
+	if p.byteswriterate.ok {
+		u.Set("byteswriterate", strconv.FormatInt(p.byteswriterate.v, 10))
 	}
-	if v, found := p.p["byteswriteratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriteratemax", vv)
+	if p.byteswriteratemax.ok {
+		u.Set("byteswriteratemax", strconv.FormatInt(p.byteswriteratemax.v, 10))
 	}
-	if v, found := p.p["byteswriteratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriteratemaxlength", vv)
+	if p.byteswriteratemaxlength.ok {
+		u.Set("byteswriteratemaxlength", strconv.FormatInt(p.byteswriteratemaxlength.v, 10))
 	}
-	if v, found := p.p["cachemode"]; found {
-		u.Set("cachemode", v.(string))
+	if p.cachemode.ok {
+		u.Set("cachemode", p.cachemode.v)
 	}
-	if v, found := p.p["customized"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("customized", vv)
+	if p.customized.ok {
+		u.Set("customized", strconv.FormatBool(p.customized.v))
 	}
-	if v, found := p.p["customizediops"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("customizediops", vv)
+	if p.customizediops.ok {
+		u.Set("customizediops", strconv.FormatBool(p.customizediops.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["disksize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("disksize", vv)
+	if p.disksize.ok {
+		u.Set("disksize", strconv.FormatInt(p.disksize.v, 10))
 	}
-	if v, found := p.p["disksizestrictness"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("disksizestrictness", vv)
+	if p.disksizestrictness.ok {
+		u.Set("disksizestrictness", strconv.FormatBool(p.disksizestrictness.v))
 	}
-	if v, found := p.p["displayoffering"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayoffering", vv)
+	if p.displayoffering.ok {
+		u.Set("displayoffering", strconv.FormatBool(p.displayoffering.v))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("domainid", vv)
+	if p.domainid.ok {
+		u.Set("domainid", strings.Join(p.domainid.v, ","))
 	}
-	if v, found := p.p["encrypt"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("encrypt", vv)
+	if p.encrypt.ok {
+		u.Set("encrypt", strconv.FormatBool(p.encrypt.v))
 	}
-	if v, found := p.p["hypervisorsnapshotreserve"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("hypervisorsnapshotreserve", vv)
+	if p.hypervisorsnapshotreserve.ok {
+		u.Set("hypervisorsnapshotreserve", strconv.Itoa(p.hypervisorsnapshotreserve.v))
 	}
-	if v, found := p.p["iopsreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadrate", vv)
+	if p.iopsreadrate.ok {
+		u.Set("iopsreadrate", strconv.FormatInt(p.iopsreadrate.v, 10))
 	}
-	if v, found := p.p["iopsreadratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadratemax", vv)
+	if p.iopsreadratemax.ok {
+		u.Set("iopsreadratemax", strconv.FormatInt(p.iopsreadratemax.v, 10))
 	}
-	if v, found := p.p["iopsreadratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadratemaxlength", vv)
+	if p.iopsreadratemaxlength.ok {
+		u.Set("iopsreadratemaxlength", strconv.FormatInt(p.iopsreadratemaxlength.v, 10))
 	}
-	if v, found := p.p["iopswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriterate", vv)
+	if p.iopswriterate.ok {
+		u.Set("iopswriterate", strconv.FormatInt(p.iopswriterate.v, 10))
 	}
-	if v, found := p.p["iopswriteratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriteratemax", vv)
+	if p.iopswriteratemax.ok {
+		u.Set("iopswriteratemax", strconv.FormatInt(p.iopswriteratemax.v, 10))
 	}
-	if v, found := p.p["iopswriteratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriteratemaxlength", vv)
+	if p.iopswriteratemaxlength.ok {
+		u.Set("iopswriteratemaxlength", strconv.FormatInt(p.iopswriteratemaxlength.v, 10))
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
+	if p.maxiops.ok {
+		u.Set("maxiops", strconv.FormatInt(p.maxiops.v, 10))
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
+	if p.miniops.ok {
+		u.Set("miniops", strconv.FormatInt(p.miniops.v, 10))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["provisioningtype"]; found {
-		u.Set("provisioningtype", v.(string))
+	if p.provisioningtype.ok {
+		u.Set("provisioningtype", p.provisioningtype.v)
 	}
-	if v, found := p.p["storagepolicy"]; found {
-		u.Set("storagepolicy", v.(string))
+	if p.storagepolicy.ok {
+		u.Set("storagepolicy", p.storagepolicy.v)
 	}
-	if v, found := p.p["storagetype"]; found {
-		u.Set("storagetype", v.(string))
+	if p.storagetype.ok {
+		u.Set("storagetype", p.storagetype.v)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("zoneid", vv)
+	if p.zoneid.ok {
+		u.Set("zoneid", strings.Join(p.zoneid.v, ","))
 	}
 	return u
 }
 
-func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateDiskOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new CreateDiskOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateDiskOfferingParams(u url.Values) (*CreateDiskOfferingParams, error) {
+	p := &CreateDiskOfferingParams{}
+	if err := checkParamNames("createDiskOffering", u, "bytesreadrate", "bytesreadratemax", "bytesreadratemaxlength", "byteswriterate", "byteswriteratemax", "byteswriteratemaxlength", "cachemode", "customized", "customizediops", "details[]", "disksize", "disksizestrictness", "displayoffering", "displaytext", "domainid", "encrypt", "hypervisorsnapshotreserve", "iopsreadrate", "iopsreadratemax", "iopsreadratemaxlength", "iopswriterate", "iopswriteratemax", "iopswriteratemaxlength", "maxiops", "miniops", "name", "provisioningtype", "storagepolicy", "storagetype", "tags", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bytesreadrate"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadrate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadrate: %v", err)
+		}
+		p.SetBytesreadrate(v)
+	}
+	if _, found := u["bytesreadratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadratemax: %v", err)
+		}
+		p.SetBytesreadratemax(v)
+	}
+	if _, found := u["bytesreadratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadratemaxlength: %v", err)
+		}
+		p.SetBytesreadratemaxlength(v)
+	}
+	if _, found := u["byteswriterate"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriterate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriterate: %v", err)
+		}
+		p.SetByteswriterate(v)
+	}
+	if _, found := u["byteswriteratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriteratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriteratemax: %v", err)
+		}
+		p.SetByteswriteratemax(v)
+	}
+	if _, found := u["byteswriteratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriteratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriteratemaxlength: %v", err)
+		}
+		p.SetByteswriteratemaxlength(v)
+	}
+	if _, found := u["cachemode"]; found {
+		p.SetCachemode(u.Get("cachemode"))
+	}
+	if _, found := u["customized"]; found {
+		v, err := strconv.ParseBool(u.Get("customized"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param customized: %v", err)
+		}
+		p.SetCustomized(v)
+	}
+	if _, found := u["customizediops"]; found {
+		v, err := strconv.ParseBool(u.Get("customizediops"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param customizediops: %v", err)
+		}
+		p.SetCustomizediops(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["disksize"]; found {
+		v, err := strconv.ParseInt(u.Get("disksize"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param disksize: %v", err)
+		}
+		p.SetDisksize(v)
+	}
+	if _, found := u["disksizestrictness"]; found {
+		v, err := strconv.ParseBool(u.Get("disksizestrictness"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param disksizestrictness: %v", err)
+		}
+		p.SetDisksizestrictness(v)
+	}
+	if _, found := u["displayoffering"]; found {
+		v, err := strconv.ParseBool(u.Get("displayoffering"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayoffering: %v", err)
+		}
+		p.SetDisplayoffering(v)
 	}
-	p.p["bytesreadrate"] = v
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(strings.Split(u.Get("domainid"), ","))
+	}
+	if _, found := u["encrypt"]; found {
+		v, err := strconv.ParseBool(u.Get("encrypt"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param encrypt: %v", err)
+		}
+		p.SetEncrypt(v)
+	}
+	if _, found := u["hypervisorsnapshotreserve"]; found {
+		v, err := strconv.Atoi(u.Get("hypervisorsnapshotreserve"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param hypervisorsnapshotreserve: %v", err)
+		}
+		p.SetHypervisorsnapshotreserve(v)
+	}
+	if _, found := u["iopsreadrate"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadrate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadrate: %v", err)
+		}
+		p.SetIopsreadrate(v)
+	}
+	if _, found := u["iopsreadratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadratemax: %v", err)
+		}
+		p.SetIopsreadratemax(v)
+	}
+	if _, found := u["iopsreadratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadratemaxlength: %v", err)
+		}
+		p.SetIopsreadratemaxlength(v)
+	}
+	if _, found := u["iopswriterate"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriterate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriterate: %v", err)
+		}
+		p.SetIopswriterate(v)
+	}
+	if _, found := u["iopswriteratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriteratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriteratemax: %v", err)
+		}
+		p.SetIopswriteratemax(v)
+	}
+	if _, found := u["iopswriteratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriteratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriteratemaxlength: %v", err)
+		}
+		p.SetIopswriteratemaxlength(v)
+	}
+	if _, found := u["maxiops"]; found {
+		v, err := strconv.ParseInt(u.Get("maxiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxiops: %v", err)
+		}
+		p.SetMaxiops(v)
+	}
+	if _, found := u["miniops"]; found {
+		v, err := strconv.ParseInt(u.Get("miniops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param miniops: %v", err)
+		}
+		p.SetMiniops(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["provisioningtype"]; found {
+		p.SetProvisioningtype(u.Get("provisioningtype"))
+	}
+	if _, found := u["storagepolicy"]; found {
+		p.SetStoragepolicy(u.Get("storagepolicy"))
+	}
+	if _, found := u["storagetype"]; found {
+		p.SetStoragetype(u.Get("storagetype"))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(strings.Split(u.Get("zoneid"), ","))
+	}
+	return p, nil
 }
 
+// SetBytesreadrate sets the bytesreadrate param.
+func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
+	p.bytesreadrate = optInt64{v: v, ok: true}
+}
+
+// ResetBytesreadrate unsets the bytesreadrate param
 func (p *CreateDiskOfferingParams) ResetBytesreadrate() {
-	if p.p != nil && p.p["bytesreadrate"] != nil {
-		delete(p.p, "bytesreadrate")
-	}
+	p.bytesreadrate = optInt64{}
 }
 
+// GetBytesreadrate returns the bytesreadrate param and if it is set
 func (p *CreateDiskOfferingParams) GetBytesreadrate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadrate"].(int64)
-	return value, ok
+	return p.bytesreadrate.v, p.bytesreadrate.ok
 }
 
+// SetBytesreadratemax sets the bytesreadratemax param.
 func (p *CreateDiskOfferingParams) SetBytesreadratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadratemax"] = v
+	p.bytesreadratemax = optInt64{v: v, ok: true}
 }
 
+// ResetBytesreadratemax unsets the bytesreadratemax param
 func (p *CreateDiskOfferingParams) ResetBytesreadratemax() {
-	if p.p != nil && p.p["bytesreadratemax"] != nil {
-		delete(p.p, "bytesreadratemax")
-	}
+	p.bytesreadratemax = optInt64{}
 }
 
+// GetBytesreadratemax returns the bytesreadratemax param and if it is set
 func (p *CreateDiskOfferingParams) GetBytesreadratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadratemax"].(int64)
-	return value, ok
+	return p.bytesreadratemax.v, p.bytesreadratemax.ok
 }
 
+// SetBytesreadratemaxlength sets the bytesreadratemaxlength param.
 func (p *CreateDiskOfferingParams) SetBytesreadratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadratemaxlength"] = v
+	p.bytesreadratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetBytesreadratemaxlength unsets the bytesreadratemaxlength param
 func (p *CreateDiskOfferingParams) ResetBytesreadratemaxlength() {
-	if p.p != nil && p.p["bytesreadratemaxlength"] != nil {
-		delete(p.p, "bytesreadratemaxlength")
-	}
+	p.bytesreadratemaxlength = optInt64{}
 }
 
+// GetBytesreadratemaxlength returns the bytesreadratemaxlength param and if it is set
 func (p *CreateDiskOfferingParams) GetBytesreadratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadratemaxlength"].(int64)
-	return value, ok
+	return p.bytesreadratemaxlength.v, p.bytesreadratemaxlength.ok
 }
 
+// SetByteswriterate sets the byteswriterate param.
 func (p *CreateDiskOfferingParams) SetByteswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriterate"] = v
+	p.byteswriterate = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriterate unsets the byteswriterate param
 func (p *CreateDiskOfferingParams) ResetByteswriterate() {
-	if p.p != nil && p.p["byteswriterate"] != nil {
-		delete(p.p, "byteswriterate")
-	}
+	p.byteswriterate = optInt64{}
 }
 
+// GetByteswriterate returns the byteswriterate param and if it is set
 func (p *CreateDiskOfferingParams) GetByteswriterate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriterate"].(int64)
-	return value, ok
+	return p.byteswriterate.v, p.byteswriterate.ok
 }
 
+// SetByteswriteratemax sets the byteswriteratemax param.
 func (p *CreateDiskOfferingParams) SetByteswriteratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriteratemax"] = v
+	p.byteswriteratemax = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriteratemax unsets the byteswriteratemax param
 func (p *CreateDiskOfferingParams) ResetByteswriteratemax() {
-	if p.p != nil && p.p["byteswriteratemax"] != nil {
-		delete(p.p, "byteswriteratemax")
-	}
+	p.byteswriteratemax = optInt64{}
 }
 
+// GetByteswriteratemax returns the byteswriteratemax param and if it is set
 func (p *CreateDiskOfferingParams) GetByteswriteratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriteratemax"].(int64)
-	return value, ok
+	return p.byteswriteratemax.v, p.byteswriteratemax.ok
 }
 
+// SetByteswriteratemaxlength sets the byteswriteratemaxlength param.
 func (p *CreateDiskOfferingParams) SetByteswriteratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriteratemaxlength"] = v
+	p.byteswriteratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriteratemaxlength unsets the byteswriteratemaxlength param
 func (p *CreateDiskOfferingParams) ResetByteswriteratemaxlength() {
-	if p.p != nil && p.p["byteswriteratemaxlength"] != nil {
-		delete(p.p, "byteswriteratemaxlength")
-	}
+	p.byteswriteratemaxlength = optInt64{}
 }
 
+// GetByteswriteratemaxlength returns the byteswriteratemaxlength param and if it is set
 func (p *CreateDiskOfferingParams) GetByteswriteratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriteratemaxlength"].(int64)
-	return value, ok
+	return p.byteswriteratemaxlength.v, p.byteswriteratemaxlength.ok
 }
 
+// SetCachemode sets the cachemode param.
 func (p *CreateDiskOfferingParams) SetCachemode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cachemode"] = v
+	p.cachemode = optString{v: v, ok: true}
 }
 
+// ResetCachemode unsets the cachemode param
 func (p *CreateDiskOfferingParams) ResetCachemode() {
-	if p.p != nil && p.p["cachemode"] != nil {
-		delete(p.p, "cachemode")
-	}
+	p.cachemode = optString{}
 }
 
+// GetCachemode returns the cachemode param and if it is set
 func (p *CreateDiskOfferingParams) GetCachemode() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cachemode"].(string)
-	return value, ok
+	return p.cachemode.v, p.cachemode.ok
 }
 
+// SetCustomized sets the customized param.
 func (p *CreateDiskOfferingParams) SetCustomized(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customized"] = v
+	p.customized = optBool{v: v, ok: true}
 }
 
+// ResetCustomized unsets the customized param
 func (p *CreateDiskOfferingParams) ResetCustomized() {
-	if p.p != nil && p.p["customized"] != nil {
-		delete(p.p, "customized")
-	}
+	p.customized = optBool{}
 }
 
+// GetCustomized returns the customized param and if it is set
 func (p *CreateDiskOfferingParams) GetCustomized() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customized"].(bool)
-	return value, ok
+	return p.customized.v, p.customized.ok
 }
 
+// SetCustomizediops sets the customizediops param.
 func (p *CreateDiskOfferingParams) SetCustomizediops(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customizediops"] = v
+	p.customizediops = optBool{v: v, ok: true}
 }
 
+// ResetCustomizediops unsets the customizediops param
 func (p *CreateDiskOfferingParams) ResetCustomizediops() {
-	if p.p != nil && p.p["customizediops"] != nil {
-		delete(p.p, "customizediops")
-	}
+	p.customizediops = optBool{}
 }
 
+// GetCustomizediops returns the customizediops param and if it is set
 func (p *CreateDiskOfferingParams) GetCustomizediops() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customizediops"].(bool)
-	return value, ok
+	return p.customizediops.v, p.customizediops.ok
 }
 
+// SetDetails sets the details param.
 func (p *CreateDiskOfferingParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *CreateDiskOfferingParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *CreateDiskOfferingParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisksize sets the disksize param.
 func (p *CreateDiskOfferingParams) SetDisksize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["disksize"] = v
+	p.disksize = optInt64{v: v, ok: true}
 }
 
+// ResetDisksize unsets the disksize param
 func (p *CreateDiskOfferingParams) ResetDisksize() {
-	if p.p != nil && p.p["disksize"] != nil {
-		delete(p.p, "disksize")
-	}
+	p.disksize = optInt64{}
 }
 
+// GetDisksize returns the disksize param and if it is set
 func (p *CreateDiskOfferingParams) GetDisksize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["disksize"].(int64)
-	return value, ok
+	return p.disksize.v, p.disksize.ok
 }
 
+// SetDisksizestrictness sets the disksizestrictness param.
 func (p *CreateDiskOfferingParams) SetDisksizestrictness(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["disksizestrictness"] = v
+	p.disksizestrictness = optBool{v: v, ok: true}
 }
 
+// ResetDisksizestrictness unsets the disksizestrictness param
 func (p *CreateDiskOfferingParams) ResetDisksizestrictness() {
-	if p.p != nil && p.p["disksizestrictness"] != nil {
-		delete(p.p, "disksizestrictness")
-	}
+	p.disksizestrictness = optBool{}
 }
 
+// GetDisksizestrictness returns the disksizestrictness param and if it is set
 func (p *CreateDiskOfferingParams) GetDisksizestrictness() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["disksizestrictness"].(bool)
-	return value, ok
+	return p.disksizestrictness.v, p.disksizestrictness.ok
 }
 
+// SetDisplayoffering sets the displayoffering param.
 func (p *CreateDiskOfferingParams) SetDisplayoffering(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayoffering"] = v
+	p.displayoffering = optBool{v: v, ok: true}
 }
 
+// ResetDisplayoffering unsets the displayoffering param
 func (p *CreateDiskOfferingParams) ResetDisplayoffering() {
-	if p.p != nil && p.p["displayoffering"] != nil {
-		delete(p.p, "displayoffering")
-	}
+	p.displayoffering = optBool{}
 }
 
+// GetDisplayoffering returns the displayoffering param and if it is set
 func (p *CreateDiskOfferingParams) GetDisplayoffering() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayoffering"].(bool)
-	return value, ok
+	return p.displayoffering.v, p.displayoffering.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *CreateDiskOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateDiskOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateDiskOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateDiskOfferingParams) SetDomainid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optStrings{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateDiskOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optStrings{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateDiskOfferingParams) GetDomainid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].([]string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEncrypt sets the encrypt param.
 func (p *CreateDiskOfferingParams) SetEncrypt(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["encrypt"] = v
+	p.encrypt = optBool{v: v, ok: true}
 }
 
+// ResetEncrypt unsets the encrypt param
 func (p *CreateDiskOfferingParams) ResetEncrypt() {
-	if p.p != nil && p.p["encrypt"] != nil {
-		delete(p.p, "encrypt")
-	}
+	p.encrypt = optBool{}
 }
 
+// GetEncrypt returns the encrypt param and if it is set
 func (p *CreateDiskOfferingParams) GetEncrypt() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["encrypt"].(bool)
-	return value, ok
+	return p.encrypt.v, p.encrypt.ok
 }
 
+// SetHypervisorsnapshotreserve sets the hypervisorsnapshotreserve param.
 func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserve(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisorsnapshotreserve"] = v
+	p.hypervisorsnapshotreserve = optInt{v: v, ok: true}
 }
 
+// ResetHypervisorsnapshotreserve unsets the hypervisorsnapshotreserve param
 func (p *CreateDiskOfferingParams) ResetHypervisorsnapshotreserve() {
-	if p.p != nil && p.p["hypervisorsnapshotreserve"] != nil {
-		delete(p.p, "hypervisorsnapshotreserve")
-	}
+	p.hypervisorsnapshotreserve = optInt{}
 }
 
+// GetHypervisorsnapshotreserve returns the hypervisorsnapshotreserve param and if it is set
 func (p *CreateDiskOfferingParams) GetHypervisorsnapshotreserve() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisorsnapshotreserve"].(int)
-	return value, ok
+	return p.hypervisorsnapshotreserve.v, p.hypervisorsnapshotreserve.ok
 }
 
+// SetIopsreadrate sets the iopsreadrate param.
 func (p *CreateDiskOfferingParams) SetIopsreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadrate"] = v
+	p.iopsreadrate = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadrate unsets the iopsreadrate param
 func (p *CreateDiskOfferingParams) ResetIopsreadrate() {
-	if p.p != nil && p.p["iopsreadrate"] != nil {
-		delete(p.p, "iopsreadrate")
-	}
+	p.iopsreadrate = optInt64{}
 }
 
+// GetIopsreadrate returns the iopsreadrate param and if it is set
 func (p *CreateDiskOfferingParams) GetIopsreadrate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadrate"].(int64)
-	return value, ok
+	return p.iopsreadrate.v, p.iopsreadrate.ok
 }
 
+// SetIopsreadratemax sets the iopsreadratemax param.
 func (p *CreateDiskOfferingParams) SetIopsreadratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadratemax"] = v
+	p.iopsreadratemax = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadratemax unsets the iopsreadratemax param
 func (p *CreateDiskOfferingParams) ResetIopsreadratemax() {
-	if p.p != nil && p.p["iopsreadratemax"] != nil {
-		delete(p.p, "iopsreadratemax")
-	}
+	p.iopsreadratemax = optInt64{}
 }
 
+// GetIopsreadratemax returns the iopsreadratemax param and if it is set
 func (p *CreateDiskOfferingParams) GetIopsreadratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadratemax"].(int64)
-	return value, ok
+	return p.iopsreadratemax.v, p.iopsreadratemax.ok
 }
 
+// SetIopsreadratemaxlength sets the iopsreadratemaxlength param.
 func (p *CreateDiskOfferingParams) SetIopsreadratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadratemaxlength"] = v
+	p.iopsreadratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadratemaxlength unsets the iopsreadratemaxlength param
 func (p *CreateDiskOfferingParams) ResetIopsreadratemaxlength() {
-	if p.p != nil && p.p["iopsreadratemaxlength"] != nil {
-		delete(p.p, "iopsreadratemaxlength")
-	}
+	p.iopsreadratemaxlength = optInt64{}
 }
 
+// GetIopsreadratemaxlength returns the iopsreadratemaxlength param and if it is set
 func (p *CreateDiskOfferingParams) GetIopsreadratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadratemaxlength"].(int64)
-	return value, ok
+	return p.iopsreadratemaxlength.v, p.iopsreadratemaxlength.ok
 }
 
+// SetIopswriterate sets the iopswriterate param.
 func (p *CreateDiskOfferingParams) SetIopswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriterate"] = v
+	p.iopswriterate = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriterate unsets the iopswriterate param
 func (p *CreateDiskOfferingParams) ResetIopswriterate() {
-	if p.p != nil && p.p["iopswriterate"] != nil {
-		delete(p.p, "iopswriterate")
-	}
+	p.iopswriterate = optInt64{}
 }
 
+// GetIopswriterate returns the iopswriterate param and if it is set
 func (p *CreateDiskOfferingParams) GetIopswriterate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriterate"].(int64)
-	return value, ok
+	return p.iopswriterate.v, p.iopswriterate.ok
 }
 
+// SetIopswriteratemax sets the iopswriteratemax param.
 func (p *CreateDiskOfferingParams) SetIopswriteratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriteratemax"] = v
+	p.iopswriteratemax = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriteratemax unsets the iopswriteratemax param
 func (p *CreateDiskOfferingParams) ResetIopswriteratemax() {
-	if p.p != nil && p.p["iopswriteratemax"] != nil {
-		delete(p.p, "iopswriteratemax")
-	}
+	p.iopswriteratemax = optInt64{}
 }
 
+// GetIopswriteratemax returns the iopswriteratemax param and if it is set
 func (p *CreateDiskOfferingParams) GetIopswriteratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriteratemax"].(int64)
-	return value, ok
+	return p.iopswriteratemax.v, p.iopswriteratemax.ok
 }
 
+// SetIopswriteratemaxlength sets the iopswriteratemaxlength param.
 func (p *CreateDiskOfferingParams) SetIopswriteratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriteratemaxlength"] = v
+	p.iopswriteratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriteratemaxlength unsets the iopswriteratemaxlength param
 func (p *CreateDiskOfferingParams) ResetIopswriteratemaxlength() {
-	if p.p != nil && p.p["iopswriteratemaxlength"] != nil {
-		delete(p.p, "iopswriteratemaxlength")
-	}
+	p.iopswriteratemaxlength = optInt64{}
 }
 
+// GetIopswriteratemaxlength returns the iopswriteratemaxlength param and if it is set
 func (p *CreateDiskOfferingParams) GetIopswriteratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriteratemaxlength"].(int64)
-	return value, ok
+	return p.iopswriteratemaxlength.v, p.iopswriteratemaxlength.ok
 }
 
+// SetMaxiops sets the maxiops param.
 func (p *CreateDiskOfferingParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
+	p.maxiops = optInt64{v: v, ok: true}
 }
 
+// ResetMaxiops unsets the maxiops param
 func (p *CreateDiskOfferingParams) ResetMaxiops() {
-	if p.p != nil && p.p["maxiops"] != nil {
-		delete(p.p, "maxiops")
-	}
+	p.maxiops = optInt64{}
 }
 
+// GetMaxiops returns the maxiops param and if it is set
 func (p *CreateDiskOfferingParams) GetMaxiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxiops"].(int64)
-	return value, ok
+	return p.maxiops.v, p.maxiops.ok
 }
 
+// SetMiniops sets the miniops param.
 func (p *CreateDiskOfferingParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
+	p.miniops = optInt64{v: v, ok: true}
 }
 
+// ResetMiniops unsets the miniops param
 func (p *CreateDiskOfferingParams) ResetMiniops() {
-	if p.p != nil && p.p["miniops"] != nil {
-		delete(p.p, "miniops")
-	}
+	p.miniops = optInt64{}
 }
 
+// GetMiniops returns the miniops param and if it is set
 func (p *CreateDiskOfferingParams) GetMiniops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["miniops"].(int64)
-	return value, ok
+	return p.miniops.v, p.miniops.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateDiskOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateDiskOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateDiskOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProvisioningtype sets the provisioningtype param.
 func (p *CreateDiskOfferingParams) SetProvisioningtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provisioningtype"] = v
+	p.provisioningtype = optString{v: v, ok: true}
 }
 
+// ResetProvisioningtype unsets the provisioningtype param
 func (p *CreateDiskOfferingParams) ResetProvisioningtype() {
-	if p.p != nil && p.p["provisioningtype"] != nil {
-		delete(p.p, "provisioningtype")
-	}
+	p.provisioningtype = optString{}
 }
 
+// GetProvisioningtype returns the provisioningtype param and if it is set
 func (p *CreateDiskOfferingParams) GetProvisioningtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provisioningtype"].(string)
-	return value, ok
+	return p.provisioningtype.v, p.provisioningtype.ok
 }
 
+// SetStoragepolicy sets the storagepolicy param.
 func (p *CreateDiskOfferingParams) SetStoragepolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagepolicy"] = v
+	p.storagepolicy = optString{v: v, ok: true}
 }
 
+// ResetStoragepolicy unsets the storagepolicy param
 func (p *CreateDiskOfferingParams) ResetStoragepolicy() {
-	if p.p != nil && p.p["storagepolicy"] != nil {
-		delete(p.p, "storagepolicy")
-	}
+	p.storagepolicy = optString{}
 }
 
+// GetStoragepolicy returns the storagepolicy param and if it is set
 func (p *CreateDiskOfferingParams) GetStoragepolicy() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagepolicy"].(string)
-	return value, ok
+	return p.storagepolicy.v, p.storagepolicy.ok
 }
 
+// SetStoragetype sets the storagetype param.
 func (p *CreateDiskOfferingParams) SetStoragetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagetype"] = v
+	p.storagetype = optString{v: v, ok: true}
 }
 
+// ResetStoragetype unsets the storagetype param
 func (p *CreateDiskOfferingParams) ResetStoragetype() {
-	if p.p != nil && p.p["storagetype"] != nil {
-		delete(p.p, "storagetype")
-	}
+	p.storagetype = optString{}
 }
 
+// GetStoragetype returns the storagetype param and if it is set
 func (p *CreateDiskOfferingParams) GetStoragetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagetype"].(string)
-	return value, ok
+	return p.storagetype.v, p.storagetype.ok
 }
 
+// SetTags sets the tags param.
 func (p *CreateDiskOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optString{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreateDiskOfferingParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optString{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreateDiskOfferingParams) GetTags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateDiskOfferingParams) SetZoneid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optStrings{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateDiskOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optStrings{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateDiskOfferingParams) GetZoneid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateDiskOfferingParams) Clone() *CreateDiskOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.domainid = p.domainid.clone()
+	c.zoneid = p.zoneid.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateDiskOfferingParams) Equal(o *CreateDiskOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bytesreadrate == o.bytesreadrate &&
+		p.bytesreadratemax == o.bytesreadratemax &&
+		p.bytesreadratemaxlength == o.bytesreadratemaxlength &&
+		p.byteswriterate == o.byteswriterate &&
+		p.byteswriteratemax == o.byteswriteratemax &&
+		p.byteswriteratemaxlength == o.byteswriteratemaxlength &&
+		p.cachemode == o.cachemode &&
+		p.customized == o.customized &&
+		p.customizediops == o.customizediops &&
+		p.details.equal(o.details) &&
+		p.disksize == o.disksize &&
+		p.disksizestrictness == o.disksizestrictness &&
+		p.displayoffering == o.displayoffering &&
+		p.displaytext == o.displaytext &&
+		p.domainid.equal(o.domainid) &&
+		p.encrypt == o.encrypt &&
+		p.hypervisorsnapshotreserve == o.hypervisorsnapshotreserve &&
+		p.iopsreadrate == o.iopsreadrate &&
+		p.iopsreadratemax == o.iopsreadratemax &&
+		p.iopsreadratemaxlength == o.iopsreadratemaxlength &&
+		p.iopswriterate == o.iopswriterate &&
+		p.iopswriteratemax == o.iopswriteratemax &&
+		p.iopswriteratemaxlength == o.iopswriteratemaxlength &&
+		p.maxiops == o.maxiops &&
+		p.miniops == o.miniops &&
+		p.name == o.name &&
+		p.provisioningtype == o.provisioningtype &&
+		p.storagepolicy == o.storagepolicy &&
+		p.storagetype == o.storagetype &&
+		p.tags == o.tags &&
+		p.zoneid.equal(o.zoneid)
+}
+
+// serializedCreateDiskOfferingParams is used to (un)marshal CreateDiskOfferingParams using the API param names
+type serializedCreateDiskOfferingParams struct {
+	Bytesreadrate             *int64             `json:"bytesreadrate,omitempty" yaml:"bytesreadrate,omitempty"`
+	Bytesreadratemax          *int64             `json:"bytesreadratemax,omitempty" yaml:"bytesreadratemax,omitempty"`
+	Bytesreadratemaxlength    *int64             `json:"bytesreadratemaxlength,omitempty" yaml:"bytesreadratemaxlength,omitempty"`
+	Byteswriterate            *int64             `json:"byteswriterate,omitempty" yaml:"byteswriterate,omitempty"`
+	Byteswriteratemax         *int64             `json:"byteswriteratemax,omitempty" yaml:"byteswriteratemax,omitempty"`
+	Byteswriteratemaxlength   *int64             `json:"byteswriteratemaxlength,omitempty" yaml:"byteswriteratemaxlength,omitempty"`
+	Cachemode                 *string            `json:"cachemode,omitempty" yaml:"cachemode,omitempty"`
+	Customized                *bool              `json:"customized,omitempty" yaml:"customized,omitempty"`
+	Customizediops            *bool              `json:"customizediops,omitempty" yaml:"customizediops,omitempty"`
+	Details                   *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Disksize                  *int64             `json:"disksize,omitempty" yaml:"disksize,omitempty"`
+	Disksizestrictness        *bool              `json:"disksizestrictness,omitempty" yaml:"disksizestrictness,omitempty"`
+	Displayoffering           *bool              `json:"displayoffering,omitempty" yaml:"displayoffering,omitempty"`
+	Displaytext               *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid                  *[]string          `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Encrypt                   *bool              `json:"encrypt,omitempty" yaml:"encrypt,omitempty"`
+	Hypervisorsnapshotreserve *int               `json:"hypervisorsnapshotreserve,omitempty" yaml:"hypervisorsnapshotreserve,omitempty"`
+	Iopsreadrate              *int64             `json:"iopsreadrate,omitempty" yaml:"iopsreadrate,omitempty"`
+	Iopsreadratemax           *int64             `json:"iopsreadratemax,omitempty" yaml:"iopsreadratemax,omitempty"`
+	Iopsreadratemaxlength     *int64             `json:"iopsreadratemaxlength,omitempty" yaml:"iopsreadratemaxlength,omitempty"`
+	Iopswriterate             *int64             `json:"iopswriterate,omitempty" yaml:"iopswriterate,omitempty"`
+	Iopswriteratemax          *int64             `json:"iopswriteratemax,omitempty" yaml:"iopswriteratemax,omitempty"`
+	Iopswriteratemaxlength    *int64             `json:"iopswriteratemaxlength,omitempty" yaml:"iopswriteratemaxlength,omitempty"`
+	Maxiops                   *int64             `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops                   *int64             `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Name                      *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Provisioningtype          *string            `json:"provisioningtype,omitempty" yaml:"provisioningtype,omitempty"`
+	Storagepolicy             *string            `json:"storagepolicy,omitempty" yaml:"storagepolicy,omitempty"`
+	Storagetype               *string            `json:"storagetype,omitempty" yaml:"storagetype,omitempty"`
+	Tags                      *string            `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Zoneid                    *[]string          `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateDiskOfferingParams) toSerialized() *serializedCreateDiskOfferingParams {
+	s := &serializedCreateDiskOfferingParams{}
+	if p.bytesreadrate.ok {
+		s.Bytesreadrate = &p.bytesreadrate.v
+	}
+	if p.bytesreadratemax.ok {
+		s.Bytesreadratemax = &p.bytesreadratemax.v
+	}
+	if p.bytesreadratemaxlength.ok {
+		s.Bytesreadratemaxlength = &p.bytesreadratemaxlength.v
+	}
+	if p.byteswriterate.ok {
+		s.Byteswriterate = &p.byteswriterate.v
+	}
+	if p.byteswriteratemax.ok {
+		s.Byteswriteratemax = &p.byteswriteratemax.v
+	}
+	if p.byteswriteratemaxlength.ok {
+		s.Byteswriteratemaxlength = &p.byteswriteratemaxlength.v
+	}
+	if p.cachemode.ok {
+		s.Cachemode = &p.cachemode.v
+	}
+	if p.customized.ok {
+		s.Customized = &p.customized.v
+	}
+	if p.customizediops.ok {
+		s.Customizediops = &p.customizediops.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.disksize.ok {
+		s.Disksize = &p.disksize.v
+	}
+	if p.disksizestrictness.ok {
+		s.Disksizestrictness = &p.disksizestrictness.v
+	}
+	if p.displayoffering.ok {
+		s.Displayoffering = &p.displayoffering.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.encrypt.ok {
+		s.Encrypt = &p.encrypt.v
+	}
+	if p.hypervisorsnapshotreserve.ok {
+		s.Hypervisorsnapshotreserve = &p.hypervisorsnapshotreserve.v
+	}
+	if p.iopsreadrate.ok {
+		s.Iopsreadrate = &p.iopsreadrate.v
+	}
+	if p.iopsreadratemax.ok {
+		s.Iopsreadratemax = &p.iopsreadratemax.v
+	}
+	if p.iopsreadratemaxlength.ok {
+		s.Iopsreadratemaxlength = &p.iopsreadratemaxlength.v
+	}
+	if p.iopswriterate.ok {
+		s.Iopswriterate = &p.iopswriterate.v
+	}
+	if p.iopswriteratemax.ok {
+		s.Iopswriteratemax = &p.iopswriteratemax.v
+	}
+	if p.iopswriteratemaxlength.ok {
+		s.Iopswriteratemaxlength = &p.iopswriteratemaxlength.v
+	}
+	if p.maxiops.ok {
+		s.Maxiops = &p.maxiops.v
+	}
+	if p.miniops.ok {
+		s.Miniops = &p.miniops.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.provisioningtype.ok {
+		s.Provisioningtype = &p.provisioningtype.v
+	}
+	if p.storagepolicy.ok {
+		s.Storagepolicy = &p.storagepolicy.v
+	}
+	if p.storagetype.ok {
+		s.Storagetype = &p.storagetype.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateDiskOfferingParams) fromSerialized(s *serializedCreateDiskOfferingParams) {
+	*p = CreateDiskOfferingParams{}
+	if s.Bytesreadrate != nil {
+		p.SetBytesreadrate(*s.Bytesreadrate)
+	}
+	if s.Bytesreadratemax != nil {
+		p.SetBytesreadratemax(*s.Bytesreadratemax)
+	}
+	if s.Bytesreadratemaxlength != nil {
+		p.SetBytesreadratemaxlength(*s.Bytesreadratemaxlength)
+	}
+	if s.Byteswriterate != nil {
+		p.SetByteswriterate(*s.Byteswriterate)
+	}
+	if s.Byteswriteratemax != nil {
+		p.SetByteswriteratemax(*s.Byteswriteratemax)
+	}
+	if s.Byteswriteratemaxlength != nil {
+		p.SetByteswriteratemaxlength(*s.Byteswriteratemaxlength)
+	}
+	if s.Cachemode != nil {
+		p.SetCachemode(*s.Cachemode)
+	}
+	if s.Customized != nil {
+		p.SetCustomized(*s.Customized)
 	}
-	value, ok := p.p["zoneid"].([]string)
-	return value, ok
+	if s.Customizediops != nil {
+		p.SetCustomizediops(*s.Customizediops)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Disksize != nil {
+		p.SetDisksize(*s.Disksize)
+	}
+	if s.Disksizestrictness != nil {
+		p.SetDisksizestrictness(*s.Disksizestrictness)
+	}
+	if s.Displayoffering != nil {
+		p.SetDisplayoffering(*s.Displayoffering)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Encrypt != nil {
+		p.SetEncrypt(*s.Encrypt)
+	}
+	if s.Hypervisorsnapshotreserve != nil {
+		p.SetHypervisorsnapshotreserve(*s.Hypervisorsnapshotreserve)
+	}
+	if s.Iopsreadrate != nil {
+		p.SetIopsreadrate(*s.Iopsreadrate)
+	}
+	if s.Iopsreadratemax != nil {
+		p.SetIopsreadratemax(*s.Iopsreadratemax)
+	}
+	if s.Iopsreadratemaxlength != nil {
+		p.SetIopsreadratemaxlength(*s.Iopsreadratemaxlength)
+	}
+	if s.Iopswriterate != nil {
+		p.SetIopswriterate(*s.Iopswriterate)
+	}
+	if s.Iopswriteratemax != nil {
+		p.SetIopswriteratemax(*s.Iopswriteratemax)
+	}
+	if s.Iopswriteratemaxlength != nil {
+		p.SetIopswriteratemaxlength(*s.Iopswriteratemaxlength)
+	}
+	if s.Maxiops != nil {
+		p.SetMaxiops(*s.Maxiops)
+	}
+	if s.Miniops != nil {
+		p.SetMiniops(*s.Miniops)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Provisioningtype != nil {
+		p.SetProvisioningtype(*s.Provisioningtype)
+	}
+	if s.Storagepolicy != nil {
+		p.SetStoragepolicy(*s.Storagepolicy)
+	}
+	if s.Storagetype != nil {
+		p.SetStoragetype(*s.Storagetype)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateDiskOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateDiskOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateDiskOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateDiskOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateDiskOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams {
 	p := &CreateDiskOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
 	return p
 }
 
 // Creates a disk offering.
-func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createDiskOffering", p.toURLValues())
+//
+// Required params: displaytext, name.
+func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams, opts ...CallOption) (*CreateDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequest("createDiskOffering", s.cs.encodeParams("createDiskOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -894,53 +1219,132 @@ type CreateDiskOfferingResponse struct {
 }
 
 type DeleteDiskOfferingParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteDiskOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteDiskOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteDiskOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteDiskOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteDiskOfferingParams(u url.Values) (*DeleteDiskOfferingParams, error) {
+	p := &DeleteDiskOfferingParams{}
+	if err := checkParamNames("deleteDiskOffering", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteDiskOfferingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteDiskOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteDiskOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteDiskOfferingParams) Clone() *DeleteDiskOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteDiskOfferingParams) Equal(o *DeleteDiskOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteDiskOfferingParams is used to (un)marshal DeleteDiskOfferingParams using the API param names
+type serializedDeleteDiskOfferingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteDiskOfferingParams) toSerialized() *serializedDeleteDiskOfferingParams {
+	s := &serializedDeleteDiskOfferingParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteDiskOfferingParams) fromSerialized(s *serializedDeleteDiskOfferingParams) {
+	*p = DeleteDiskOfferingParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteDiskOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteDiskOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteDiskOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteDiskOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteDiskOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams {
 	p := &DeleteDiskOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a disk offering.
-func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteDiskOffering", p.toURLValues())
+//
+// Required params: id.
+func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams, opts ...CallOption) (*DeleteDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequest("deleteDiskOffering", s.cs.encodeParams("deleteDiskOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -988,396 +1392,571 @@ func (r *DeleteDiskOfferingResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListDiskOfferingsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDiskOfferingsParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	encrypt     optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	storageid   optString
+	storagetype optString
+	volumeid    optString
+	zoneid      optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDiskOfferingsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["encrypt"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("encrypt", vv)
+	if p.encrypt.ok {
+		u.Set("encrypt", strconv.FormatBool(p.encrypt.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["storagetype"]; found {
-		u.Set("storagetype", v.(string))
+	if p.storagetype.ok {
+		u.Set("storagetype", p.storagetype.v)
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListDiskOfferingsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDiskOfferingsParams parses url.Values, for example taken from a raw API request,
+// into a new ListDiskOfferingsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDiskOfferingsParams(u url.Values) (*ListDiskOfferingsParams, error) {
+	p := &ListDiskOfferingsParams{}
+	if err := checkParamNames("listDiskOfferings", u, "account", "domainid", "encrypt", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid", "storageid", "storagetype", "volumeid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["encrypt"]; found {
+		v, err := strconv.ParseBool(u.Get("encrypt"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param encrypt: %v", err)
+		}
+		p.SetEncrypt(v)
 	}
-	p.p["account"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["storagetype"]; found {
+		p.SetStoragetype(u.Get("storagetype"))
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListDiskOfferingsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListDiskOfferingsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListDiskOfferingsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListDiskOfferingsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListDiskOfferingsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListDiskOfferingsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEncrypt sets the encrypt param.
 func (p *ListDiskOfferingsParams) SetEncrypt(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["encrypt"] = v
+	p.encrypt = optBool{v: v, ok: true}
 }
 
+// ResetEncrypt unsets the encrypt param
 func (p *ListDiskOfferingsParams) ResetEncrypt() {
-	if p.p != nil && p.p["encrypt"] != nil {
-		delete(p.p, "encrypt")
-	}
+	p.encrypt = optBool{}
 }
 
+// GetEncrypt returns the encrypt param and if it is set
 func (p *ListDiskOfferingsParams) GetEncrypt() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["encrypt"].(bool)
-	return value, ok
+	return p.encrypt.v, p.encrypt.ok
 }
 
+// SetId sets the id param.
 func (p *ListDiskOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListDiskOfferingsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListDiskOfferingsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListDiskOfferingsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListDiskOfferingsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListDiskOfferingsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDiskOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDiskOfferingsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDiskOfferingsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListDiskOfferingsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListDiskOfferingsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListDiskOfferingsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListDiskOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListDiskOfferingsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListDiskOfferingsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDiskOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDiskOfferingsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDiskOfferingsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDiskOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDiskOfferingsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDiskOfferingsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListDiskOfferingsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListDiskOfferingsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListDiskOfferingsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListDiskOfferingsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListDiskOfferingsParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListDiskOfferingsParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetStoragetype sets the storagetype param.
 func (p *ListDiskOfferingsParams) SetStoragetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagetype"] = v
+	p.storagetype = optString{v: v, ok: true}
 }
 
+// ResetStoragetype unsets the storagetype param
 func (p *ListDiskOfferingsParams) ResetStoragetype() {
-	if p.p != nil && p.p["storagetype"] != nil {
-		delete(p.p, "storagetype")
-	}
+	p.storagetype = optString{}
 }
 
+// GetStoragetype returns the storagetype param and if it is set
 func (p *ListDiskOfferingsParams) GetStoragetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagetype"].(string)
-	return value, ok
+	return p.storagetype.v, p.storagetype.ok
 }
 
+// SetVolumeid sets the volumeid param.
 func (p *ListDiskOfferingsParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
+	p.volumeid = optString{v: v, ok: true}
 }
 
+// ResetVolumeid unsets the volumeid param
 func (p *ListDiskOfferingsParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *ListDiskOfferingsParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+	return p.volumeid.v, p.volumeid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListDiskOfferingsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListDiskOfferingsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListDiskOfferingsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDiskOfferingsParams) Clone() *ListDiskOfferingsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDiskOfferingsParams) Equal(o *ListDiskOfferingsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.encrypt == o.encrypt &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.storageid == o.storageid &&
+		p.storagetype == o.storagetype &&
+		p.volumeid == o.volumeid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListDiskOfferingsParams is used to (un)marshal ListDiskOfferingsParams using the API param names
+type serializedListDiskOfferingsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Encrypt     *bool   `json:"encrypt,omitempty" yaml:"encrypt,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Storageid   *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Storagetype *string `json:"storagetype,omitempty" yaml:"storagetype,omitempty"`
+	Volumeid    *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListDiskOfferingsParams) toSerialized() *serializedListDiskOfferingsParams {
+	s := &serializedListDiskOfferingsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.encrypt.ok {
+		s.Encrypt = &p.encrypt.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.storagetype.ok {
+		s.Storagetype = &p.storagetype.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListDiskOfferingsParams) fromSerialized(s *serializedListDiskOfferingsParams) {
+	*p = ListDiskOfferingsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Encrypt != nil {
+		p.SetEncrypt(*s.Encrypt)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Storagetype != nil {
+		p.SetStoragetype(*s.Storagetype)
+	}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDiskOfferingsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDiskOfferingsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDiskOfferingsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDiskOfferingsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDiskOfferingsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDiskOfferingsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDiskOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewListDiskOfferingsParams() *ListDiskOfferingsParams {
 	p := &ListDiskOfferingsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDiskOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1425,9 +2004,8 @@ func (s *DiskOfferingService) GetDiskOfferingByName(name string, opts ...OptionF
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error) {
 	p := &ListDiskOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1456,8 +2034,8 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 }
 
 // Lists all available disk offerings.
-func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listDiskOfferings", p.toURLValues())
+func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams, opts ...CallOption) (*ListDiskOfferingsResponse, error) {
+	resp, err := s.cs.newRequest("listDiskOfferings", s.cs.encodeParams("listDiskOfferings", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1517,547 +2095,788 @@ type DiskOffering struct {
 }
 
 type UpdateDiskOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
+	bytesreadrate           optInt64
+	bytesreadratemax        optInt64
+	bytesreadratemaxlength  optInt64
+	byteswriterate          optInt64
+	byteswriteratemax       optInt64
+	byteswriteratemaxlength optInt64
+	cachemode               optString
+	displayoffering         optBool
+	displaytext             optString
+	domainid                optString
+	id                      optString
+	iopsreadrate            optInt64
+	iopsreadratemax         optInt64
+	iopsreadratemaxlength   optInt64
+	iopswriterate           optInt64
+	iopswriteratemax        optInt64
+	iopswriteratemaxlength  optInt64
+	name                    optString
+	sortkey                 optInt
+	tags                    optString
+	zoneid                  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateDiskOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bytesreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadrate", vv)
+	if p.bytesreadrate.ok {
+		u.Set("bytesreadrate", strconv.FormatInt(p.bytesreadrate.v, 10))
 	}
-	if v, found := p.p["bytesreadratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadratemax", vv)
+	if p.bytesreadratemax.ok {
+		u.Set("bytesreadratemax", strconv.FormatInt(p.bytesreadratemax.v, 10))
 	}
-	if v, found := p.p["bytesreadratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadratemaxlength", vv)
+	if p.bytesreadratemaxlength.ok {
+		u.Set("bytesreadratemaxlength", strconv.FormatInt(p.bytesreadratemaxlength.v, 10))
 	}
-	if v, found := p.p["byteswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriterate", vv)
+	if p.byteswriterate.ok {
+		u.Set("byteswriterate", strconv.FormatInt(p.byteswriterate.v, 10))
 	}
-	if v, found := p.p["byteswriteratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriteratemax", vv)
+	if p.byteswriteratemax.ok {
+		u.Set("byteswriteratemax", strconv.FormatInt(p.byteswriteratemax.v, 10))
 	}
-	if v, found := p.p["byteswriteratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriteratemaxlength", vv)
+	if p.byteswriteratemaxlength.ok {
+		u.Set("byteswriteratemaxlength", strconv.FormatInt(p.byteswriteratemaxlength.v, 10))
 	}
-	if v, found := p.p["cachemode"]; found {
-		u.Set("cachemode", v.(string))
+	if p.cachemode.ok {
+		u.Set("cachemode", p.cachemode.v)
 	}
-	if v, found := p.p["displayoffering"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayoffering", vv)
+	if p.displayoffering.ok {
+		u.Set("displayoffering", strconv.FormatBool(p.displayoffering.v))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["iopsreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadrate", vv)
+	if p.iopsreadrate.ok {
+		u.Set("iopsreadrate", strconv.FormatInt(p.iopsreadrate.v, 10))
 	}
-	if v, found := p.p["iopsreadratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadratemax", vv)
+	if p.iopsreadratemax.ok {
+		u.Set("iopsreadratemax", strconv.FormatInt(p.iopsreadratemax.v, 10))
 	}
-	if v, found := p.p["iopsreadratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadratemaxlength", vv)
+	if p.iopsreadratemaxlength.ok {
+		u.Set("iopsreadratemaxlength", strconv.FormatInt(p.iopsreadratemaxlength.v, 10))
 	}
-	if v, found := p.p["iopswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriterate", vv)
+	if p.iopswriterate.ok {
+		u.Set("iopswriterate", strconv.FormatInt(p.iopswriterate.v, 10))
 	}
-	if v, found := p.p["iopswriteratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriteratemax", vv)
+	if p.iopswriteratemax.ok {
+		u.Set("iopswriteratemax", strconv.FormatInt(p.iopswriteratemax.v, 10))
 	}
-	if v, found := p.p["iopswriteratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriteratemaxlength", vv)
+	if p.iopswriteratemaxlength.ok {
+		u.Set("iopswriteratemaxlength", strconv.FormatInt(p.iopswriteratemaxlength.v, 10))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if p.sortkey.ok {
+		u.Set("sortkey", strconv.Itoa(p.sortkey.v))
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *UpdateDiskOfferingParams) SetBytesreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateDiskOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateDiskOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateDiskOfferingParams(u url.Values) (*UpdateDiskOfferingParams, error) {
+	p := &UpdateDiskOfferingParams{}
+	if err := checkParamNames("updateDiskOffering", u, "bytesreadrate", "bytesreadratemax", "bytesreadratemaxlength", "byteswriterate", "byteswriteratemax", "byteswriteratemaxlength", "cachemode", "displayoffering", "displaytext", "domainid", "id", "iopsreadrate", "iopsreadratemax", "iopsreadratemaxlength", "iopswriterate", "iopswriteratemax", "iopswriteratemaxlength", "name", "sortkey", "tags", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bytesreadrate"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadrate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadrate: %v", err)
+		}
+		p.SetBytesreadrate(v)
+	}
+	if _, found := u["bytesreadratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadratemax: %v", err)
+		}
+		p.SetBytesreadratemax(v)
+	}
+	if _, found := u["bytesreadratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadratemaxlength: %v", err)
+		}
+		p.SetBytesreadratemaxlength(v)
+	}
+	if _, found := u["byteswriterate"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriterate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriterate: %v", err)
+		}
+		p.SetByteswriterate(v)
+	}
+	if _, found := u["byteswriteratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriteratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriteratemax: %v", err)
+		}
+		p.SetByteswriteratemax(v)
+	}
+	if _, found := u["byteswriteratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriteratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriteratemaxlength: %v", err)
+		}
+		p.SetByteswriteratemaxlength(v)
+	}
+	if _, found := u["cachemode"]; found {
+		p.SetCachemode(u.Get("cachemode"))
+	}
+	if _, found := u["displayoffering"]; found {
+		v, err := strconv.ParseBool(u.Get("displayoffering"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayoffering: %v", err)
+		}
+		p.SetDisplayoffering(v)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["iopsreadrate"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadrate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadrate: %v", err)
+		}
+		p.SetIopsreadrate(v)
+	}
+	if _, found := u["iopsreadratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadratemax: %v", err)
+		}
+		p.SetIopsreadratemax(v)
+	}
+	if _, found := u["iopsreadratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadratemaxlength: %v", err)
+		}
+		p.SetIopsreadratemaxlength(v)
+	}
+	if _, found := u["iopswriterate"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriterate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriterate: %v", err)
+		}
+		p.SetIopswriterate(v)
+	}
+	if _, found := u["iopswriteratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriteratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriteratemax: %v", err)
+		}
+		p.SetIopswriteratemax(v)
+	}
+	if _, found := u["iopswriteratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriteratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriteratemaxlength: %v", err)
+		}
+		p.SetIopswriteratemaxlength(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["bytesreadrate"] = v
+	if _, found := u["sortkey"]; found {
+		v, err := strconv.Atoi(u.Get("sortkey"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sortkey: %v", err)
+		}
+		p.SetSortkey(v)
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetBytesreadrate sets the bytesreadrate param.
+func (p *UpdateDiskOfferingParams) SetBytesreadrate(v int64) {
+	p.bytesreadrate = optInt64{v: v, ok: true}
 }
 
+// ResetBytesreadrate unsets the bytesreadrate param
 func (p *UpdateDiskOfferingParams) ResetBytesreadrate() {
-	if p.p != nil && p.p["bytesreadrate"] != nil {
-		delete(p.p, "bytesreadrate")
-	}
+	p.bytesreadrate = optInt64{}
 }
 
+// GetBytesreadrate returns the bytesreadrate param and if it is set
 func (p *UpdateDiskOfferingParams) GetBytesreadrate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadrate"].(int64)
-	return value, ok
+	return p.bytesreadrate.v, p.bytesreadrate.ok
 }
 
+// SetBytesreadratemax sets the bytesreadratemax param.
 func (p *UpdateDiskOfferingParams) SetBytesreadratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadratemax"] = v
+	p.bytesreadratemax = optInt64{v: v, ok: true}
 }
 
+// ResetBytesreadratemax unsets the bytesreadratemax param
 func (p *UpdateDiskOfferingParams) ResetBytesreadratemax() {
-	if p.p != nil && p.p["bytesreadratemax"] != nil {
-		delete(p.p, "bytesreadratemax")
-	}
+	p.bytesreadratemax = optInt64{}
 }
 
+// GetBytesreadratemax returns the bytesreadratemax param and if it is set
 func (p *UpdateDiskOfferingParams) GetBytesreadratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadratemax"].(int64)
-	return value, ok
+	return p.bytesreadratemax.v, p.bytesreadratemax.ok
 }
 
+// SetBytesreadratemaxlength sets the bytesreadratemaxlength param.
 func (p *UpdateDiskOfferingParams) SetBytesreadratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadratemaxlength"] = v
+	p.bytesreadratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetBytesreadratemaxlength unsets the bytesreadratemaxlength param
 func (p *UpdateDiskOfferingParams) ResetBytesreadratemaxlength() {
-	if p.p != nil && p.p["bytesreadratemaxlength"] != nil {
-		delete(p.p, "bytesreadratemaxlength")
-	}
+	p.bytesreadratemaxlength = optInt64{}
 }
 
+// GetBytesreadratemaxlength returns the bytesreadratemaxlength param and if it is set
 func (p *UpdateDiskOfferingParams) GetBytesreadratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadratemaxlength"].(int64)
-	return value, ok
+	return p.bytesreadratemaxlength.v, p.bytesreadratemaxlength.ok
 }
 
+// SetByteswriterate sets the byteswriterate param.
 func (p *UpdateDiskOfferingParams) SetByteswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriterate"] = v
+	p.byteswriterate = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriterate unsets the byteswriterate param
 func (p *UpdateDiskOfferingParams) ResetByteswriterate() {
-	if p.p != nil && p.p["byteswriterate"] != nil {
-		delete(p.p, "byteswriterate")
-	}
+	p.byteswriterate = optInt64{}
 }
 
+// GetByteswriterate returns the byteswriterate param and if it is set
 func (p *UpdateDiskOfferingParams) GetByteswriterate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriterate"].(int64)
-	return value, ok
+	return p.byteswriterate.v, p.byteswriterate.ok
 }
 
+// SetByteswriteratemax sets the byteswriteratemax param.
 func (p *UpdateDiskOfferingParams) SetByteswriteratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriteratemax"] = v
+	p.byteswriteratemax = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriteratemax unsets the byteswriteratemax param
 func (p *UpdateDiskOfferingParams) ResetByteswriteratemax() {
-	if p.p != nil && p.p["byteswriteratemax"] != nil {
-		delete(p.p, "byteswriteratemax")
-	}
+	p.byteswriteratemax = optInt64{}
 }
 
+// GetByteswriteratemax returns the byteswriteratemax param and if it is set
 func (p *UpdateDiskOfferingParams) GetByteswriteratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriteratemax"].(int64)
-	return value, ok
+	return p.byteswriteratemax.v, p.byteswriteratemax.ok
 }
 
+// SetByteswriteratemaxlength sets the byteswriteratemaxlength param.
 func (p *UpdateDiskOfferingParams) SetByteswriteratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriteratemaxlength"] = v
+	p.byteswriteratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriteratemaxlength unsets the byteswriteratemaxlength param
 func (p *UpdateDiskOfferingParams) ResetByteswriteratemaxlength() {
-	if p.p != nil && p.p["byteswriteratemaxlength"] != nil {
-		delete(p.p, "byteswriteratemaxlength")
-	}
+	p.byteswriteratemaxlength = optInt64{}
 }
 
+// GetByteswriteratemaxlength returns the byteswriteratemaxlength param and if it is set
 func (p *UpdateDiskOfferingParams) GetByteswriteratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriteratemaxlength"].(int64)
-	return value, ok
+	return p.byteswriteratemaxlength.v, p.byteswriteratemaxlength.ok
 }
 
+// SetCachemode sets the cachemode param.
 func (p *UpdateDiskOfferingParams) SetCachemode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cachemode"] = v
+	p.cachemode = optString{v: v, ok: true}
 }
 
+// ResetCachemode unsets the cachemode param
 func (p *UpdateDiskOfferingParams) ResetCachemode() {
-	if p.p != nil && p.p["cachemode"] != nil {
-		delete(p.p, "cachemode")
-	}
+	p.cachemode = optString{}
 }
 
+// GetCachemode returns the cachemode param and if it is set
 func (p *UpdateDiskOfferingParams) GetCachemode() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cachemode"].(string)
-	return value, ok
+	return p.cachemode.v, p.cachemode.ok
 }
 
+// SetDisplayoffering sets the displayoffering param.
 func (p *UpdateDiskOfferingParams) SetDisplayoffering(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayoffering"] = v
+	p.displayoffering = optBool{v: v, ok: true}
 }
 
+// ResetDisplayoffering unsets the displayoffering param
 func (p *UpdateDiskOfferingParams) ResetDisplayoffering() {
-	if p.p != nil && p.p["displayoffering"] != nil {
-		delete(p.p, "displayoffering")
-	}
+	p.displayoffering = optBool{}
 }
 
+// GetDisplayoffering returns the displayoffering param and if it is set
 func (p *UpdateDiskOfferingParams) GetDisplayoffering() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayoffering"].(bool)
-	return value, ok
+	return p.displayoffering.v, p.displayoffering.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *UpdateDiskOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateDiskOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateDiskOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateDiskOfferingParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateDiskOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateDiskOfferingParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateDiskOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateDiskOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateDiskOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIopsreadrate sets the iopsreadrate param.
 func (p *UpdateDiskOfferingParams) SetIopsreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadrate"] = v
+	p.iopsreadrate = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadrate unsets the iopsreadrate param
 func (p *UpdateDiskOfferingParams) ResetIopsreadrate() {
-	if p.p != nil && p.p["iopsreadrate"] != nil {
-		delete(p.p, "iopsreadrate")
-	}
+	p.iopsreadrate = optInt64{}
 }
 
+// GetIopsreadrate returns the iopsreadrate param and if it is set
 func (p *UpdateDiskOfferingParams) GetIopsreadrate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadrate"].(int64)
-	return value, ok
+	return p.iopsreadrate.v, p.iopsreadrate.ok
 }
 
+// SetIopsreadratemax sets the iopsreadratemax param.
 func (p *UpdateDiskOfferingParams) SetIopsreadratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadratemax"] = v
+	p.iopsreadratemax = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadratemax unsets the iopsreadratemax param
 func (p *UpdateDiskOfferingParams) ResetIopsreadratemax() {
-	if p.p != nil && p.p["iopsreadratemax"] != nil {
-		delete(p.p, "iopsreadratemax")
-	}
+	p.iopsreadratemax = optInt64{}
 }
 
+// GetIopsreadratemax returns the iopsreadratemax param and if it is set
 func (p *UpdateDiskOfferingParams) GetIopsreadratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadratemax"].(int64)
-	return value, ok
+	return p.iopsreadratemax.v, p.iopsreadratemax.ok
 }
 
+// SetIopsreadratemaxlength sets the iopsreadratemaxlength param.
 func (p *UpdateDiskOfferingParams) SetIopsreadratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadratemaxlength"] = v
+	p.iopsreadratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadratemaxlength unsets the iopsreadratemaxlength param
 func (p *UpdateDiskOfferingParams) ResetIopsreadratemaxlength() {
-	if p.p != nil && p.p["iopsreadratemaxlength"] != nil {
-		delete(p.p, "iopsreadratemaxlength")
-	}
+	p.iopsreadratemaxlength = optInt64{}
 }
 
+// GetIopsreadratemaxlength returns the iopsreadratemaxlength param and if it is set
 func (p *UpdateDiskOfferingParams) GetIopsreadratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadratemaxlength"].(int64)
-	return value, ok
+	return p.iopsreadratemaxlength.v, p.iopsreadratemaxlength.ok
 }
 
+// SetIopswriterate sets the iopswriterate param.
 func (p *UpdateDiskOfferingParams) SetIopswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriterate"] = v
+	p.iopswriterate = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriterate unsets the iopswriterate param
 func (p *UpdateDiskOfferingParams) ResetIopswriterate() {
-	if p.p != nil && p.p["iopswriterate"] != nil {
-		delete(p.p, "iopswriterate")
-	}
+	p.iopswriterate = optInt64{}
 }
 
+// GetIopswriterate returns the iopswriterate param and if it is set
 func (p *UpdateDiskOfferingParams) GetIopswriterate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriterate"].(int64)
-	return value, ok
+	return p.iopswriterate.v, p.iopswriterate.ok
 }
 
+// SetIopswriteratemax sets the iopswriteratemax param.
 func (p *UpdateDiskOfferingParams) SetIopswriteratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriteratemax"] = v
+	p.iopswriteratemax = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriteratemax unsets the iopswriteratemax param
 func (p *UpdateDiskOfferingParams) ResetIopswriteratemax() {
-	if p.p != nil && p.p["iopswriteratemax"] != nil {
-		delete(p.p, "iopswriteratemax")
-	}
+	p.iopswriteratemax = optInt64{}
 }
 
+// GetIopswriteratemax returns the iopswriteratemax param and if it is set
 func (p *UpdateDiskOfferingParams) GetIopswriteratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriteratemax"].(int64)
-	return value, ok
+	return p.iopswriteratemax.v, p.iopswriteratemax.ok
 }
 
+// SetIopswriteratemaxlength sets the iopswriteratemaxlength param.
 func (p *UpdateDiskOfferingParams) SetIopswriteratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriteratemaxlength"] = v
+	p.iopswriteratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriteratemaxlength unsets the iopswriteratemaxlength param
 func (p *UpdateDiskOfferingParams) ResetIopswriteratemaxlength() {
-	if p.p != nil && p.p["iopswriteratemaxlength"] != nil {
-		delete(p.p, "iopswriteratemaxlength")
-	}
+	p.iopswriteratemaxlength = optInt64{}
 }
 
+// GetIopswriteratemaxlength returns the iopswriteratemaxlength param and if it is set
 func (p *UpdateDiskOfferingParams) GetIopswriteratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriteratemaxlength"].(int64)
-	return value, ok
+	return p.iopswriteratemaxlength.v, p.iopswriteratemaxlength.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateDiskOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateDiskOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateDiskOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetSortkey sets the sortkey param.
 func (p *UpdateDiskOfferingParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
+	p.sortkey = optInt{v: v, ok: true}
 }
 
+// ResetSortkey unsets the sortkey param
 func (p *UpdateDiskOfferingParams) ResetSortkey() {
-	if p.p != nil && p.p["sortkey"] != nil {
-		delete(p.p, "sortkey")
-	}
+	p.sortkey = optInt{}
 }
 
+// GetSortkey returns the sortkey param and if it is set
 func (p *UpdateDiskOfferingParams) GetSortkey() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sortkey"].(int)
-	return value, ok
+	return p.sortkey.v, p.sortkey.ok
 }
 
+// SetTags sets the tags param.
 func (p *UpdateDiskOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optString{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *UpdateDiskOfferingParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optString{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *UpdateDiskOfferingParams) GetTags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *UpdateDiskOfferingParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *UpdateDiskOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *UpdateDiskOfferingParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateDiskOfferingParams) Clone() *UpdateDiskOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateDiskOfferingParams) Equal(o *UpdateDiskOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bytesreadrate == o.bytesreadrate &&
+		p.bytesreadratemax == o.bytesreadratemax &&
+		p.bytesreadratemaxlength == o.bytesreadratemaxlength &&
+		p.byteswriterate == o.byteswriterate &&
+		p.byteswriteratemax == o.byteswriteratemax &&
+		p.byteswriteratemaxlength == o.byteswriteratemaxlength &&
+		p.cachemode == o.cachemode &&
+		p.displayoffering == o.displayoffering &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.iopsreadrate == o.iopsreadrate &&
+		p.iopsreadratemax == o.iopsreadratemax &&
+		p.iopsreadratemaxlength == o.iopsreadratemaxlength &&
+		p.iopswriterate == o.iopswriterate &&
+		p.iopswriteratemax == o.iopswriteratemax &&
+		p.iopswriteratemaxlength == o.iopswriteratemaxlength &&
+		p.name == o.name &&
+		p.sortkey == o.sortkey &&
+		p.tags == o.tags &&
+		p.zoneid == o.zoneid
+}
+
+// serializedUpdateDiskOfferingParams is used to (un)marshal UpdateDiskOfferingParams using the API param names
+type serializedUpdateDiskOfferingParams struct {
+	Bytesreadrate           *int64  `json:"bytesreadrate,omitempty" yaml:"bytesreadrate,omitempty"`
+	Bytesreadratemax        *int64  `json:"bytesreadratemax,omitempty" yaml:"bytesreadratemax,omitempty"`
+	Bytesreadratemaxlength  *int64  `json:"bytesreadratemaxlength,omitempty" yaml:"bytesreadratemaxlength,omitempty"`
+	Byteswriterate          *int64  `json:"byteswriterate,omitempty" yaml:"byteswriterate,omitempty"`
+	Byteswriteratemax       *int64  `json:"byteswriteratemax,omitempty" yaml:"byteswriteratemax,omitempty"`
+	Byteswriteratemaxlength *int64  `json:"byteswriteratemaxlength,omitempty" yaml:"byteswriteratemaxlength,omitempty"`
+	Cachemode               *string `json:"cachemode,omitempty" yaml:"cachemode,omitempty"`
+	Displayoffering         *bool   `json:"displayoffering,omitempty" yaml:"displayoffering,omitempty"`
+	Displaytext             *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid                *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id                      *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Iopsreadrate            *int64  `json:"iopsreadrate,omitempty" yaml:"iopsreadrate,omitempty"`
+	Iopsreadratemax         *int64  `json:"iopsreadratemax,omitempty" yaml:"iopsreadratemax,omitempty"`
+	Iopsreadratemaxlength   *int64  `json:"iopsreadratemaxlength,omitempty" yaml:"iopsreadratemaxlength,omitempty"`
+	Iopswriterate           *int64  `json:"iopswriterate,omitempty" yaml:"iopswriterate,omitempty"`
+	Iopswriteratemax        *int64  `json:"iopswriteratemax,omitempty" yaml:"iopswriteratemax,omitempty"`
+	Iopswriteratemaxlength  *int64  `json:"iopswriteratemaxlength,omitempty" yaml:"iopswriteratemaxlength,omitempty"`
+	Name                    *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey                 *int    `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+	Tags                    *string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Zoneid                  *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *UpdateDiskOfferingParams) toSerialized() *serializedUpdateDiskOfferingParams {
+	s := &serializedUpdateDiskOfferingParams{}
+	if p.bytesreadrate.ok {
+		s.Bytesreadrate = &p.bytesreadrate.v
+	}
+	if p.bytesreadratemax.ok {
+		s.Bytesreadratemax = &p.bytesreadratemax.v
+	}
+	if p.bytesreadratemaxlength.ok {
+		s.Bytesreadratemaxlength = &p.bytesreadratemaxlength.v
+	}
+	if p.byteswriterate.ok {
+		s.Byteswriterate = &p.byteswriterate.v
+	}
+	if p.byteswriteratemax.ok {
+		s.Byteswriteratemax = &p.byteswriteratemax.v
+	}
+	if p.byteswriteratemaxlength.ok {
+		s.Byteswriteratemaxlength = &p.byteswriteratemaxlength.v
+	}
+	if p.cachemode.ok {
+		s.Cachemode = &p.cachemode.v
+	}
+	if p.displayoffering.ok {
+		s.Displayoffering = &p.displayoffering.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.iopsreadrate.ok {
+		s.Iopsreadrate = &p.iopsreadrate.v
+	}
+	if p.iopsreadratemax.ok {
+		s.Iopsreadratemax = &p.iopsreadratemax.v
+	}
+	if p.iopsreadratemaxlength.ok {
+		s.Iopsreadratemaxlength = &p.iopsreadratemaxlength.v
+	}
+	if p.iopswriterate.ok {
+		s.Iopswriterate = &p.iopswriterate.v
+	}
+	if p.iopswriteratemax.ok {
+		s.Iopswriteratemax = &p.iopswriteratemax.v
+	}
+	if p.iopswriteratemaxlength.ok {
+		s.Iopswriteratemaxlength = &p.iopswriteratemaxlength.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.sortkey.ok {
+		s.Sortkey = &p.sortkey.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *UpdateDiskOfferingParams) fromSerialized(s *serializedUpdateDiskOfferingParams) {
+	*p = UpdateDiskOfferingParams{}
+	if s.Bytesreadrate != nil {
+		p.SetBytesreadrate(*s.Bytesreadrate)
+	}
+	if s.Bytesreadratemax != nil {
+		p.SetBytesreadratemax(*s.Bytesreadratemax)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Bytesreadratemaxlength != nil {
+		p.SetBytesreadratemaxlength(*s.Bytesreadratemaxlength)
+	}
+	if s.Byteswriterate != nil {
+		p.SetByteswriterate(*s.Byteswriterate)
+	}
+	if s.Byteswriteratemax != nil {
+		p.SetByteswriteratemax(*s.Byteswriteratemax)
+	}
+	if s.Byteswriteratemaxlength != nil {
+		p.SetByteswriteratemaxlength(*s.Byteswriteratemaxlength)
+	}
+	if s.Cachemode != nil {
+		p.SetCachemode(*s.Cachemode)
+	}
+	if s.Displayoffering != nil {
+		p.SetDisplayoffering(*s.Displayoffering)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Iopsreadrate != nil {
+		p.SetIopsreadrate(*s.Iopsreadrate)
+	}
+	if s.Iopsreadratemax != nil {
+		p.SetIopsreadratemax(*s.Iopsreadratemax)
+	}
+	if s.Iopsreadratemaxlength != nil {
+		p.SetIopsreadratemaxlength(*s.Iopsreadratemaxlength)
+	}
+	if s.Iopswriterate != nil {
+		p.SetIopswriterate(*s.Iopswriterate)
+	}
+	if s.Iopswriteratemax != nil {
+		p.SetIopswriteratemax(*s.Iopswriteratemax)
+	}
+	if s.Iopswriteratemaxlength != nil {
+		p.SetIopswriteratemaxlength(*s.Iopswriteratemaxlength)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Sortkey != nil {
+		p.SetSortkey(*s.Sortkey)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateDiskOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateDiskOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateDiskOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateDiskOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateDiskOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams {
 	p := &UpdateDiskOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a disk offering.
-func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateDiskOffering", p.toURLValues())
+//
+// Required params: id.
+func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams, opts ...CallOption) (*UpdateDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequest("updateDiskOffering", s.cs.encodeParams("updateDiskOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +53,43 @@ func (m *MockDiskOfferingServiceIface) EXPECT() *MockDiskOfferingServiceIfaceMoc
 }
 
 // CreateDiskOffering mocks base method.
-func (m *MockDiskOfferingServiceIface) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+func (m *MockDiskOfferingServiceIface) CreateDiskOffering(p *CreateDiskOfferingParams, opts ...CallOption) (*CreateDiskOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDiskOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDiskOffering", varargs...)
 	ret0, _ := ret[0].(*CreateDiskOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateDiskOffering indicates an expected call of CreateDiskOffering.
-func (mr *MockDiskOfferingServiceIfaceMockRecorder) CreateDiskOffering(p interface{}) *gomock.Call {
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) CreateDiskOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).CreateDiskOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).CreateDiskOffering), varargs...)
 }
 
 // DeleteDiskOffering mocks base method.
-func (m *MockDiskOfferingServiceIface) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
+func (m *MockDiskOfferingServiceIface) DeleteDiskOffering(p *DeleteDiskOfferingParams, opts ...CallOption) (*DeleteDiskOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDiskOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDiskOffering", varargs...)
 	ret0, _ := ret[0].(*DeleteDiskOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteDiskOffering indicates an expected call of DeleteDiskOffering.
-func (mr *MockDiskOfferingServiceIfaceMockRecorder) DeleteDiskOffering(p interface{}) *gomock.Call {
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) DeleteDiskOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).DeleteDiskOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).DeleteDiskOffering), varargs...)
 }
 
 // GetDiskOfferingByID mocks base method.
@@ -146,18 +156,23 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) GetDiskOfferingID(name inter
 }
 
 // ListDiskOfferings mocks base method.
-func (m *MockDiskOfferingServiceIface) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+func (m *MockDiskOfferingServiceIface) ListDiskOfferings(p *ListDiskOfferingsParams, opts ...CallOption) (*ListDiskOfferingsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDiskOfferings", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiskOfferings", varargs...)
 	ret0, _ := ret[0].(*ListDiskOfferingsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDiskOfferings indicates an expected call of ListDiskOfferings.
-func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferings(p interface{}) *gomock.Call {
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferings(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferings", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferings), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferings", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferings), varargs...)
 }
 
 // NewCreateDiskOfferingParams mocks base method.
@@ -217,16 +232,21 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) NewUpdateDiskOfferingParams(
 }
 
 // UpdateDiskOffering mocks base method.
-func (m *MockDiskOfferingServiceIface) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+func (m *MockDiskOfferingServiceIface) UpdateDiskOffering(p *UpdateDiskOfferingParams, opts ...CallOption) (*UpdateDiskOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDiskOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDiskOffering", varargs...)
 	ret0, _ := ret[0].(*UpdateDiskOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateDiskOffering indicates an expected call of UpdateDiskOffering.
-func (mr *MockDiskOfferingServiceIfaceMockRecorder) UpdateDiskOffering(p interface{}) *gomock.Call {
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) UpdateDiskOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).UpdateDiskOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).UpdateDiskOffering), varargs...)
 }
@@ -28,144 +28,241 @@ import (
 )
 
 type DomainServiceIface interface {
-	CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error)
+	CreateDomain(p *CreateDomainParams, opts ...CallOption) (*CreateDomainResponse, error)
 	NewCreateDomainParams(name string) *CreateDomainParams
-	DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomain(p *DeleteDomainParams, opts ...CallOption) (*DeleteDomainResponse, error)
 	NewDeleteDomainParams(id string) *DeleteDomainParams
-	ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomainChildren(p *ListDomainChildrenParams, opts ...CallOption) (*ListDomainChildrenResponse, error)
 	NewListDomainChildrenParams() *ListDomainChildrenParams
 	GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error)
 	GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error)
 	GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error)
-	ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error)
+	ListDomains(p *ListDomainsParams, opts ...CallOption) (*ListDomainsResponse, error)
 	NewListDomainsParams() *ListDomainsParams
 	GetDomainID(name string, opts ...OptionFunc) (string, int, error)
 	GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error)
 	GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error)
-	UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error)
+	UpdateDomain(p *UpdateDomainParams, opts ...CallOption) (*UpdateDomainResponse, error)
 	NewUpdateDomainParams(id string) *UpdateDomainParams
 }
 
 type CreateDomainParams struct {
-	p map[string]interface{}
+	domainid       optString
+	name           optString
+	networkdomain  optString
+	parentdomainid optString
 }
 
-func (p *CreateDomainParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateDomainParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
-	if v, found := p.p["parentdomainid"]; found {
-		u.Set("parentdomainid", v.(string))
+	if p.parentdomainid.ok {
+		u.Set("parentdomainid", p.parentdomainid.v)
 	}
 	return u
 }
 
-func (p *CreateDomainParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateDomainParams parses url.Values, for example taken from a raw API request,
+// into a new CreateDomainParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateDomainParams(u url.Values) (*CreateDomainParams, error) {
+	p := &CreateDomainParams{}
+	if err := checkParamNames("createDomain", u, "domainid", "name", "networkdomain", "parentdomainid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["domainid"] = v
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
+	}
+	if _, found := u["parentdomainid"]; found {
+		p.SetParentdomainid(u.Get("parentdomainid"))
+	}
+	return p, nil
+}
+
+// SetDomainid sets the domainid param.
+func (p *CreateDomainParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateDomainParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateDomainParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateDomainParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateDomainParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateDomainParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *CreateDomainParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *CreateDomainParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *CreateDomainParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+	return p.networkdomain.v, p.networkdomain.ok
 }
 
+// SetParentdomainid sets the parentdomainid param.
 func (p *CreateDomainParams) SetParentdomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["parentdomainid"] = v
+	p.parentdomainid = optString{v: v, ok: true}
 }
 
+// ResetParentdomainid unsets the parentdomainid param
 func (p *CreateDomainParams) ResetParentdomainid() {
-	if p.p != nil && p.p["parentdomainid"] != nil {
-		delete(p.p, "parentdomainid")
-	}
+	p.parentdomainid = optString{}
 }
 
+// GetParentdomainid returns the parentdomainid param and if it is set
 func (p *CreateDomainParams) GetParentdomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.parentdomainid.v, p.parentdomainid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateDomainParams) Clone() *CreateDomainParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateDomainParams) Equal(o *CreateDomainParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.networkdomain == o.networkdomain &&
+		p.parentdomainid == o.parentdomainid
+}
+
+// serializedCreateDomainParams is used to (un)marshal CreateDomainParams using the API param names
+type serializedCreateDomainParams struct {
+	Domainid       *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name           *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain  *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Parentdomainid *string `json:"parentdomainid,omitempty" yaml:"parentdomainid,omitempty"`
+}
+
+func (p *CreateDomainParams) toSerialized() *serializedCreateDomainParams {
+	s := &serializedCreateDomainParams{}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	if p.parentdomainid.ok {
+		s.Parentdomainid = &p.parentdomainid.v
+	}
+	return s
+}
+
+func (p *CreateDomainParams) fromSerialized(s *serializedCreateDomainParams) {
+	*p = CreateDomainParams{}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
+	}
+	if s.Parentdomainid != nil {
+		p.SetParentdomainid(*s.Parentdomainid)
 	}
-	value, ok := p.p["parentdomainid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateDomainParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateDomainParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateDomainParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateDomainParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateDomainParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateDomainParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateDomainParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewCreateDomainParams(name string) *CreateDomainParams {
 	p := &CreateDomainParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
-// Creates a domain
-func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
-	resp, err := s.cs.newRequest("createDomain", p.toURLValues())
+// Creates a domain.
+//
+// Required params: name.
+func (s *DomainService) CreateDomain(p *CreateDomainParams, opts ...CallOption) (*CreateDomainResponse, error) {
+	resp, err := s.cs.newRequest("createDomain", s.cs.encodeParams("createDomain", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -237,78 +334,167 @@ type CreateDomainResponse struct {
 }
 
 type DeleteDomainParams struct {
-	p map[string]interface{}
+	cleanup optBool
+	id      optString
 }
 
-func (p *DeleteDomainParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteDomainParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanup", vv)
+	if p.cleanup.ok {
+		u.Set("cleanup", strconv.FormatBool(p.cleanup.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteDomainParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteDomainParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteDomainParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteDomainParams(u url.Values) (*DeleteDomainParams, error) {
+	p := &DeleteDomainParams{}
+	if err := checkParamNames("deleteDomain", u, "cleanup", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cleanup"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanup: %v", err)
+		}
+		p.SetCleanup(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["cleanup"] = v
+	return p, nil
 }
 
+// SetCleanup sets the cleanup param.
+func (p *DeleteDomainParams) SetCleanup(v bool) {
+	p.cleanup = optBool{v: v, ok: true}
+}
+
+// ResetCleanup unsets the cleanup param
 func (p *DeleteDomainParams) ResetCleanup() {
-	if p.p != nil && p.p["cleanup"] != nil {
-		delete(p.p, "cleanup")
-	}
+	p.cleanup = optBool{}
 }
 
+// GetCleanup returns the cleanup param and if it is set
 func (p *DeleteDomainParams) GetCleanup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanup"].(bool)
-	return value, ok
+	return p.cleanup.v, p.cleanup.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteDomainParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteDomainParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteDomainParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteDomainParams) Clone() *DeleteDomainParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteDomainParams) Equal(o *DeleteDomainParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.cleanup == o.cleanup &&
+		p.id == o.id
+}
+
+// serializedDeleteDomainParams is used to (un)marshal DeleteDomainParams using the API param names
+type serializedDeleteDomainParams struct {
+	Cleanup *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteDomainParams) toSerialized() *serializedDeleteDomainParams {
+	s := &serializedDeleteDomainParams{}
+	if p.cleanup.ok {
+		s.Cleanup = &p.cleanup.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteDomainParams) fromSerialized(s *serializedDeleteDomainParams) {
+	*p = DeleteDomainParams{}
+	if s.Cleanup != nil {
+		p.SetCleanup(*s.Cleanup)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteDomainParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteDomainParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteDomainParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteDomainParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteDomainParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteDomainParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteDomainParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
 	p := &DeleteDomainParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a specified domain
-func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
-	resp, err := s.cs.newRequest("deleteDomain", p.toURLValues())
+// Deletes a specified domain.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *DomainService) DeleteDomain(p *DeleteDomainParams, opts ...CallOption) (*DeleteDomainResponse, error) {
+	resp, err := s.cs.newRequest("deleteDomain", s.cs.encodeParams("deleteDomain", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -319,8 +505,8 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -344,228 +530,361 @@ type DeleteDomainResponse struct {
 }
 
 type ListDomainChildrenParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDomainChildrenParams) toURLValues() url.Values {
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	showicon    optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDomainChildrenParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
 	return u
 }
 
-func (p *ListDomainChildrenParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDomainChildrenParams parses url.Values, for example taken from a raw API request,
+// into a new ListDomainChildrenParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDomainChildrenParams(u url.Values) (*ListDomainChildrenParams, error) {
+	p := &ListDomainChildrenParams{}
+	if err := checkParamNames("listDomainChildren", u, "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "showicon"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListDomainChildrenParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListDomainChildrenParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListDomainChildrenParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListDomainChildrenParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListDomainChildrenParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListDomainChildrenParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDomainChildrenParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDomainChildrenParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDomainChildrenParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListDomainChildrenParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListDomainChildrenParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListDomainChildrenParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListDomainChildrenParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListDomainChildrenParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListDomainChildrenParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDomainChildrenParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDomainChildrenParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDomainChildrenParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDomainChildrenParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDomainChildrenParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDomainChildrenParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListDomainChildrenParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListDomainChildrenParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListDomainChildrenParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.showicon.v, p.showicon.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDomainChildrenParams) Clone() *ListDomainChildrenParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDomainChildrenParams) Equal(o *ListDomainChildrenParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showicon == o.showicon
+}
+
+// serializedListDomainChildrenParams is used to (un)marshal ListDomainChildrenParams using the API param names
+type serializedListDomainChildrenParams struct {
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showicon    *bool   `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+}
+
+func (p *ListDomainChildrenParams) toSerialized() *serializedListDomainChildrenParams {
+	s := &serializedListDomainChildrenParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
 	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return s
+}
+
+func (p *ListDomainChildrenParams) fromSerialized(s *serializedListDomainChildrenParams) {
+	*p = ListDomainChildrenParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDomainChildrenParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDomainChildrenParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDomainChildrenParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDomainChildrenParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDomainChildrenParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDomainChildrenParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDomainChildrenParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewListDomainChildrenParams() *ListDomainChildrenParams {
 	p := &ListDomainChildrenParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainChildrenParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -613,9 +932,8 @@ func (s *DomainService) GetDomainChildrenByName(name string, opts ...OptionFunc)
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error) {
 	p := &ListDomainChildrenParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -643,9 +961,9 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 	return nil, l.Count, fmt.Errorf("There is more then one result for DomainChildren UUID: %s!", id)
 }
 
-// Lists all children domains belonging to a specified domain
-func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
-	resp, err := s.cs.newRequest("listDomainChildren", p.toURLValues())
+// Lists all children domains belonging to a specified domain.
+func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams, opts ...CallOption) (*ListDomainChildrenResponse, error) {
+	resp, err := s.cs.newRequest("listDomainChildren", s.cs.encodeParams("listDomainChildren", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -718,253 +1036,392 @@ type DomainChildren struct {
 }
 
 type ListDomainsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDomainsParams) toURLValues() url.Values {
+	details  optStrings
+	id       optString
+	keyword  optString
+	level    optInt
+	listall  optBool
+	name     optString
+	page     optInt
+	pagesize optInt
+	showicon optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDomainsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["level"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("level", vv)
+	if p.level.ok {
+		u.Set("level", strconv.Itoa(p.level.v))
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
 	return u
 }
 
-func (p *ListDomainsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDomainsParams parses url.Values, for example taken from a raw API request,
+// into a new ListDomainsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDomainsParams(u url.Values) (*ListDomainsParams, error) {
+	p := &ListDomainsParams{}
+	if err := checkParamNames("listDomains", u, "details", "id", "keyword", "level", "listall", "name", "page", "pagesize", "showicon"); err != nil {
+		return nil, err
+	}
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["level"]; found {
+		v, err := strconv.Atoi(u.Get("level"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param level: %v", err)
+		}
+		p.SetLevel(v)
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
 	}
-	p.p["details"] = v
+	return p, nil
 }
 
+// SetDetails sets the details param.
+func (p *ListDomainsParams) SetDetails(v []string) {
+	p.details = optStrings{v: v, ok: true}
+}
+
+// ResetDetails unsets the details param
 func (p *ListDomainsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListDomainsParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetId sets the id param.
 func (p *ListDomainsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListDomainsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListDomainsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDomainsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDomainsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDomainsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLevel sets the level param.
 func (p *ListDomainsParams) SetLevel(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["level"] = v
+	p.level = optInt{v: v, ok: true}
 }
 
+// ResetLevel unsets the level param
 func (p *ListDomainsParams) ResetLevel() {
-	if p.p != nil && p.p["level"] != nil {
-		delete(p.p, "level")
-	}
+	p.level = optInt{}
 }
 
+// GetLevel returns the level param and if it is set
 func (p *ListDomainsParams) GetLevel() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["level"].(int)
-	return value, ok
+	return p.level.v, p.level.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListDomainsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListDomainsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListDomainsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListDomainsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListDomainsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListDomainsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDomainsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDomainsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDomainsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDomainsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDomainsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDomainsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListDomainsParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListDomainsParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListDomainsParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.showicon.v, p.showicon.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDomainsParams) Clone() *ListDomainsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDomainsParams) Equal(o *ListDomainsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.level == o.level &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showicon == o.showicon
+}
+
+// serializedListDomainsParams is used to (un)marshal ListDomainsParams using the API param names
+type serializedListDomainsParams struct {
+	Details  *[]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id       *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Level    *int      `json:"level,omitempty" yaml:"level,omitempty"`
+	Listall  *bool     `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name     *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showicon *bool     `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+}
+
+func (p *ListDomainsParams) toSerialized() *serializedListDomainsParams {
+	s := &serializedListDomainsParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.level.ok {
+		s.Level = &p.level.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	return s
+}
+
+func (p *ListDomainsParams) fromSerialized(s *serializedListDomainsParams) {
+	*p = ListDomainsParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Level != nil {
+		p.SetLevel(*s.Level)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDomainsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDomainsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDomainsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDomainsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDomainsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDomainsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDomainsParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewListDomainsParams() *ListDomainsParams {
 	p := &ListDomainsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1012,9 +1469,8 @@ func (s *DomainService) GetDomainByName(name string, opts ...OptionFunc) (*Domai
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error) {
 	p := &ListDomainsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1042,9 +1498,9 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 	return nil, l.Count, fmt.Errorf("There is more then one result for Domain UUID: %s!", id)
 }
 
-// Lists domains and provides detailed information for listed domains
-func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
-	resp, err := s.cs.newRequest("listDomains", p.toURLValues())
+// Lists domains and provides detailed information for listed domains.
+func (s *DomainService) ListDomains(p *ListDomainsParams, opts ...CallOption) (*ListDomainsResponse, error) {
+	resp, err := s.cs.newRequest("listDomains", s.cs.encodeParams("listDomains", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1117,101 +1573,192 @@ type Domain struct {
 }
 
 type UpdateDomainParams struct {
-	p map[string]interface{}
+	id            optString
+	name          optString
+	networkdomain optString
 }
 
-func (p *UpdateDomainParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateDomainParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
 	return u
 }
 
-func (p *UpdateDomainParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateDomainParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateDomainParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateDomainParams(u url.Values) (*UpdateDomainParams, error) {
+	p := &UpdateDomainParams{}
+	if err := checkParamNames("updateDomain", u, "id", "name", "networkdomain"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *UpdateDomainParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *UpdateDomainParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateDomainParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateDomainParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateDomainParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateDomainParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *UpdateDomainParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *UpdateDomainParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *UpdateDomainParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.networkdomain.v, p.networkdomain.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateDomainParams) Clone() *UpdateDomainParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateDomainParams) Equal(o *UpdateDomainParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.name == o.name &&
+		p.networkdomain == o.networkdomain
+}
+
+// serializedUpdateDomainParams is used to (un)marshal UpdateDomainParams using the API param names
+type serializedUpdateDomainParams struct {
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name          *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+}
+
+func (p *UpdateDomainParams) toSerialized() *serializedUpdateDomainParams {
+	s := &serializedUpdateDomainParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	return s
+}
+
+func (p *UpdateDomainParams) fromSerialized(s *serializedUpdateDomainParams) {
+	*p = UpdateDomainParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
 	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateDomainParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateDomainParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateDomainParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateDomainParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateDomainParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateDomainParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateDomainParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
 	p := &UpdateDomainParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a domain with a new name
-func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
-	resp, err := s.cs.newRequest("updateDomain", p.toURLValues())
+// Updates a domain with a new name.
+//
+// Required params: id.
+func (s *DomainService) UpdateDomain(p *UpdateDomainParams, opts ...CallOption) (*UpdateDomainResponse, error) {
+	resp, err := s.cs.newRequest("updateDomain", s.cs.encodeParams("updateDomain", p), opts...)
 	if err != nil {
 		return nil, err
 	}
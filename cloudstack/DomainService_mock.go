@@ -53,33 +53,43 @@ func (m *MockDomainServiceIface) EXPECT() *MockDomainServiceIfaceMockRecorder {
 }
 
 // CreateDomain mocks base method.
-func (m *MockDomainServiceIface) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
+func (m *MockDomainServiceIface) CreateDomain(p *CreateDomainParams, opts ...CallOption) (*CreateDomainResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDomain", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDomain", varargs...)
 	ret0, _ := ret[0].(*CreateDomainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateDomain indicates an expected call of CreateDomain.
-func (mr *MockDomainServiceIfaceMockRecorder) CreateDomain(p interface{}) *gomock.Call {
+func (mr *MockDomainServiceIfaceMockRecorder) CreateDomain(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).CreateDomain), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).CreateDomain), varargs...)
 }
 
 // DeleteDomain mocks base method.
-func (m *MockDomainServiceIface) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
+func (m *MockDomainServiceIface) DeleteDomain(p *DeleteDomainParams, opts ...CallOption) (*DeleteDomainResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDomain", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDomain", varargs...)
 	ret0, _ := ret[0].(*DeleteDomainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteDomain indicates an expected call of DeleteDomain.
-func (mr *MockDomainServiceIfaceMockRecorder) DeleteDomain(p interface{}) *gomock.Call {
+func (mr *MockDomainServiceIfaceMockRecorder) DeleteDomain(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).DeleteDomain), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).DeleteDomain), varargs...)
 }
 
 // GetDomainByID mocks base method.
@@ -209,33 +219,43 @@ func (mr *MockDomainServiceIfaceMockRecorder) GetDomainID(name interface{}, opts
 }
 
 // ListDomainChildren mocks base method.
-func (m *MockDomainServiceIface) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+func (m *MockDomainServiceIface) ListDomainChildren(p *ListDomainChildrenParams, opts ...CallOption) (*ListDomainChildrenResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDomainChildren", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainChildren", varargs...)
 	ret0, _ := ret[0].(*ListDomainChildrenResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDomainChildren indicates an expected call of ListDomainChildren.
-func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildren(p interface{}) *gomock.Call {
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildren(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildren", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildren), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildren", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildren), varargs...)
 }
 
 // ListDomains mocks base method.
-func (m *MockDomainServiceIface) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
+func (m *MockDomainServiceIface) ListDomains(p *ListDomainsParams, opts ...CallOption) (*ListDomainsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDomains", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomains", varargs...)
 	ret0, _ := ret[0].(*ListDomainsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDomains indicates an expected call of ListDomains.
-func (mr *MockDomainServiceIfaceMockRecorder) ListDomains(p interface{}) *gomock.Call {
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomains(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomains), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomains), varargs...)
 }
 
 // NewCreateDomainParams mocks base method.
@@ -309,16 +329,21 @@ func (mr *MockDomainServiceIfaceMockRecorder) NewUpdateDomainParams(id interface
 }
 
 // UpdateDomain mocks base method.
-func (m *MockDomainServiceIface) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+func (m *MockDomainServiceIface) UpdateDomain(p *UpdateDomainParams, opts ...CallOption) (*UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDomain", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDomain", varargs...)
 	ret0, _ := ret[0].(*UpdateDomainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateDomain indicates an expected call of UpdateDomain.
-func (mr *MockDomainServiceIfaceMockRecorder) UpdateDomain(p interface{}) *gomock.Call {
+func (mr *MockDomainServiceIfaceMockRecorder) UpdateDomain(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).UpdateDomain), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).UpdateDomain), varargs...)
 }
@@ -28,137 +28,232 @@ import (
 )
 
 type EventServiceIface interface {
-	ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	ArchiveEvents(p *ArchiveEventsParams, opts ...CallOption) (*ArchiveEventsResponse, error)
 	NewArchiveEventsParams() *ArchiveEventsParams
-	DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	DeleteEvents(p *DeleteEventsParams, opts ...CallOption) (*DeleteEventsResponse, error)
 	NewDeleteEventsParams() *DeleteEventsParams
-	ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	ListEventTypes(p *ListEventTypesParams, opts ...CallOption) (*ListEventTypesResponse, error)
 	NewListEventTypesParams() *ListEventTypesParams
-	ListEvents(p *ListEventsParams) (*ListEventsResponse, error)
+	ListEvents(p *ListEventsParams, opts ...CallOption) (*ListEventsResponse, error)
 	NewListEventsParams() *ListEventsParams
 	GetEventByID(id string, opts ...OptionFunc) (*Event, int, error)
 }
 
 type ArchiveEventsParams struct {
-	p map[string]interface{}
+	enddate   optString
+	ids       optStrings
+	startdate optString
+	type_     optString
 }
 
-func (p *ArchiveEventsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ArchiveEventsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *ArchiveEventsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseArchiveEventsParams parses url.Values, for example taken from a raw API request,
+// into a new ArchiveEventsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseArchiveEventsParams(u url.Values) (*ArchiveEventsParams, error) {
+	p := &ArchiveEventsParams{}
+	if err := checkParamNames("archiveEvents", u, "enddate", "ids", "startdate", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
 	}
-	p.p["enddate"] = v
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
+}
+
+// SetEnddate sets the enddate param.
+func (p *ArchiveEventsParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
 }
 
+// ResetEnddate unsets the enddate param
 func (p *ArchiveEventsParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *ArchiveEventsParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetIds sets the ids param.
 func (p *ArchiveEventsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ArchiveEventsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ArchiveEventsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetStartdate sets the startdate param.
 func (p *ArchiveEventsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *ArchiveEventsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *ArchiveEventsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	return p.startdate.v, p.startdate.ok
 }
 
+// SetType sets the type param.
 func (p *ArchiveEventsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ArchiveEventsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ArchiveEventsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ArchiveEventsParams) Clone() *ArchiveEventsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ArchiveEventsParams) Equal(o *ArchiveEventsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enddate == o.enddate &&
+		p.ids.equal(o.ids) &&
+		p.startdate == o.startdate &&
+		p.type_ == o.type_
+}
+
+// serializedArchiveEventsParams is used to (un)marshal ArchiveEventsParams using the API param names
+type serializedArchiveEventsParams struct {
+	Enddate   *string   `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       *[]string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string   `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *ArchiveEventsParams) toSerialized() *serializedArchiveEventsParams {
+	s := &serializedArchiveEventsParams{}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *ArchiveEventsParams) fromSerialized(s *serializedArchiveEventsParams) {
+	*p = ArchiveEventsParams{}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ArchiveEventsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ArchiveEventsParams) UnmarshalJSON(b []byte) error {
+	var s serializedArchiveEventsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ArchiveEventsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ArchiveEventsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedArchiveEventsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ArchiveEventsParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
 	p := &ArchiveEventsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Archive one or more events.
-func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
-	resp, err := s.cs.newRequest("archiveEvents", p.toURLValues())
+func (s *EventService) ArchiveEvents(p *ArchiveEventsParams, opts ...CallOption) (*ArchiveEventsResponse, error) {
+	resp, err := s.cs.newRequest("archiveEvents", s.cs.encodeParams("archiveEvents", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -206,125 +301,220 @@ func (r *ArchiveEventsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteEventsParams struct {
-	p map[string]interface{}
+	enddate   optString
+	ids       optStrings
+	startdate optString
+	type_     optString
 }
 
-func (p *DeleteEventsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteEventsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *DeleteEventsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteEventsParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteEventsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteEventsParams(u url.Values) (*DeleteEventsParams, error) {
+	p := &DeleteEventsParams{}
+	if err := checkParamNames("deleteEvents", u, "enddate", "ids", "startdate", "type"); err != nil {
+		return nil, err
 	}
-	p.p["enddate"] = v
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
 }
 
+// SetEnddate sets the enddate param.
+func (p *DeleteEventsParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
 func (p *DeleteEventsParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *DeleteEventsParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetIds sets the ids param.
 func (p *DeleteEventsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *DeleteEventsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *DeleteEventsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetStartdate sets the startdate param.
 func (p *DeleteEventsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *DeleteEventsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *DeleteEventsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	return p.startdate.v, p.startdate.ok
 }
 
+// SetType sets the type param.
 func (p *DeleteEventsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *DeleteEventsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *DeleteEventsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteEventsParams) Clone() *DeleteEventsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteEventsParams) Equal(o *DeleteEventsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enddate == o.enddate &&
+		p.ids.equal(o.ids) &&
+		p.startdate == o.startdate &&
+		p.type_ == o.type_
+}
+
+// serializedDeleteEventsParams is used to (un)marshal DeleteEventsParams using the API param names
+type serializedDeleteEventsParams struct {
+	Enddate   *string   `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       *[]string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string   `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *DeleteEventsParams) toSerialized() *serializedDeleteEventsParams {
+	s := &serializedDeleteEventsParams{}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *DeleteEventsParams) fromSerialized(s *serializedDeleteEventsParams) {
+	*p = DeleteEventsParams{}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteEventsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteEventsParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteEventsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteEventsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteEventsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteEventsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteEventsParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
 	p := &DeleteEventsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Delete one or more events.
-func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
-	resp, err := s.cs.newRequest("deleteEvents", p.toURLValues())
+func (s *EventService) DeleteEvents(p *DeleteEventsParams, opts ...CallOption) (*DeleteEventsResponse, error) {
+	resp, err := s.cs.newRequest("deleteEvents", s.cs.encodeParams("deleteEvents", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -372,28 +562,100 @@ func (r *DeleteEventsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListEventTypesParams struct {
-	p map[string]interface{}
 }
 
-func (p *ListEventTypesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListEventTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseListEventTypesParams parses url.Values, for example taken from a raw API request,
+// into a new ListEventTypesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListEventTypesParams(u url.Values) (*ListEventTypesParams, error) {
+	p := &ListEventTypesParams{}
+	if err := checkParamNames("listEventTypes", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *ListEventTypesParams) Clone() *ListEventTypesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListEventTypesParams) Equal(o *ListEventTypesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedListEventTypesParams is used to (un)marshal ListEventTypesParams using the API param names
+type serializedListEventTypesParams struct {
+}
+
+func (p *ListEventTypesParams) toSerialized() *serializedListEventTypesParams {
+	s := &serializedListEventTypesParams{}
+	return s
+}
+
+func (p *ListEventTypesParams) fromSerialized(s *serializedListEventTypesParams) {
+	*p = ListEventTypesParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListEventTypesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListEventTypesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListEventTypesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListEventTypesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListEventTypesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListEventTypesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new ListEventTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
 	p := &ListEventTypesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// List Event Types
-func (s *EventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
-	resp, err := s.cs.newRequest("listEventTypes", p.toURLValues())
+// List Event Types.
+func (s *EventService) ListEventTypes(p *ListEventTypesParams, opts ...CallOption) (*ListEventTypesResponse, error) {
+	resp, err := s.cs.newRequest("listEventTypes", s.cs.encodeParams("listEventTypes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -418,494 +680,699 @@ type EventType struct {
 }
 
 type ListEventsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListEventsParams) toURLValues() url.Values {
+	account      optString
+	archived     optBool
+	domainid     optString
+	duration     optInt
+	enddate      optString
+	entrytime    optInt
+	id           optString
+	isrecursive  optBool
+	keyword      optString
+	level        optString
+	listall      optBool
+	page         optInt
+	pagesize     optInt
+	projectid    optString
+	resourceid   optString
+	resourcetype optString
+	startdate    optString
+	startid      optString
+	type_        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListEventsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["archived"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("archived", vv)
+	if p.archived.ok {
+		u.Set("archived", strconv.FormatBool(p.archived.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["duration"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("duration", vv)
+	if p.duration.ok {
+		u.Set("duration", strconv.Itoa(p.duration.v))
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["entrytime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("entrytime", vv)
+	if p.entrytime.ok {
+		u.Set("entrytime", strconv.Itoa(p.entrytime.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["level"]; found {
-		u.Set("level", v.(string))
+	if p.level.ok {
+		u.Set("level", p.level.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if p.resourceid.ok {
+		u.Set("resourceid", p.resourceid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if p.resourcetype.ok {
+		u.Set("resourcetype", p.resourcetype.v)
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
-	if v, found := p.p["startid"]; found {
-		u.Set("startid", v.(string))
+	if p.startid.ok {
+		u.Set("startid", p.startid.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *ListEventsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListEventsParams parses url.Values, for example taken from a raw API request,
+// into a new ListEventsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListEventsParams(u url.Values) (*ListEventsParams, error) {
+	p := &ListEventsParams{}
+	if err := checkParamNames("listEvents", u, "account", "archived", "domainid", "duration", "enddate", "entrytime", "id", "isrecursive", "keyword", "level", "listall", "page", "pagesize", "projectid", "resourceid", "resourcetype", "startdate", "startid", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["archived"]; found {
+		v, err := strconv.ParseBool(u.Get("archived"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param archived: %v", err)
+		}
+		p.SetArchived(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["duration"]; found {
+		v, err := strconv.Atoi(u.Get("duration"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param duration: %v", err)
+		}
+		p.SetDuration(v)
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["entrytime"]; found {
+		v, err := strconv.Atoi(u.Get("entrytime"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param entrytime: %v", err)
+		}
+		p.SetEntrytime(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["level"]; found {
+		p.SetLevel(u.Get("level"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["resourceid"]; found {
+		p.SetResourceid(u.Get("resourceid"))
+	}
+	if _, found := u["resourcetype"]; found {
+		p.SetResourcetype(u.Get("resourcetype"))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["startid"]; found {
+		p.SetStartid(u.Get("startid"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListEventsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListEventsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListEventsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetArchived sets the archived param.
 func (p *ListEventsParams) SetArchived(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["archived"] = v
+	p.archived = optBool{v: v, ok: true}
 }
 
+// ResetArchived unsets the archived param
 func (p *ListEventsParams) ResetArchived() {
-	if p.p != nil && p.p["archived"] != nil {
-		delete(p.p, "archived")
-	}
+	p.archived = optBool{}
 }
 
+// GetArchived returns the archived param and if it is set
 func (p *ListEventsParams) GetArchived() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["archived"].(bool)
-	return value, ok
+	return p.archived.v, p.archived.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListEventsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListEventsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListEventsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetDuration sets the duration param.
 func (p *ListEventsParams) SetDuration(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["duration"] = v
+	p.duration = optInt{v: v, ok: true}
 }
 
+// ResetDuration unsets the duration param
 func (p *ListEventsParams) ResetDuration() {
-	if p.p != nil && p.p["duration"] != nil {
-		delete(p.p, "duration")
-	}
+	p.duration = optInt{}
 }
 
+// GetDuration returns the duration param and if it is set
 func (p *ListEventsParams) GetDuration() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["duration"].(int)
-	return value, ok
+	return p.duration.v, p.duration.ok
 }
 
+// SetEnddate sets the enddate param.
 func (p *ListEventsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
+	p.enddate = optString{v: v, ok: true}
 }
 
+// ResetEnddate unsets the enddate param
 func (p *ListEventsParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *ListEventsParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetEntrytime sets the entrytime param.
 func (p *ListEventsParams) SetEntrytime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["entrytime"] = v
+	p.entrytime = optInt{v: v, ok: true}
 }
 
+// ResetEntrytime unsets the entrytime param
 func (p *ListEventsParams) ResetEntrytime() {
-	if p.p != nil && p.p["entrytime"] != nil {
-		delete(p.p, "entrytime")
-	}
+	p.entrytime = optInt{}
 }
 
+// GetEntrytime returns the entrytime param and if it is set
 func (p *ListEventsParams) GetEntrytime() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["entrytime"].(int)
-	return value, ok
+	return p.entrytime.v, p.entrytime.ok
 }
 
+// SetId sets the id param.
 func (p *ListEventsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListEventsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListEventsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListEventsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListEventsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListEventsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListEventsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListEventsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListEventsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLevel sets the level param.
 func (p *ListEventsParams) SetLevel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["level"] = v
+	p.level = optString{v: v, ok: true}
 }
 
+// ResetLevel unsets the level param
 func (p *ListEventsParams) ResetLevel() {
-	if p.p != nil && p.p["level"] != nil {
-		delete(p.p, "level")
-	}
+	p.level = optString{}
 }
 
+// GetLevel returns the level param and if it is set
 func (p *ListEventsParams) GetLevel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["level"].(string)
-	return value, ok
+	return p.level.v, p.level.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListEventsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListEventsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListEventsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListEventsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListEventsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListEventsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListEventsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListEventsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListEventsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListEventsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListEventsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListEventsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetResourceid sets the resourceid param.
 func (p *ListEventsParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+	p.resourceid = optString{v: v, ok: true}
 }
 
+// ResetResourceid unsets the resourceid param
 func (p *ListEventsParams) ResetResourceid() {
-	if p.p != nil && p.p["resourceid"] != nil {
-		delete(p.p, "resourceid")
-	}
+	p.resourceid = optString{}
 }
 
+// GetResourceid returns the resourceid param and if it is set
 func (p *ListEventsParams) GetResourceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourceid"].(string)
-	return value, ok
+	return p.resourceid.v, p.resourceid.ok
 }
 
+// SetResourcetype sets the resourcetype param.
 func (p *ListEventsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optString{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *ListEventsParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optString{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *ListEventsParams) GetResourcetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcetype"].(string)
-	return value, ok
+	return p.resourcetype.v, p.resourcetype.ok
 }
 
+// SetStartdate sets the startdate param.
 func (p *ListEventsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *ListEventsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *ListEventsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	return p.startdate.v, p.startdate.ok
 }
 
+// SetStartid sets the startid param.
 func (p *ListEventsParams) SetStartid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startid"] = v
+	p.startid = optString{v: v, ok: true}
 }
 
+// ResetStartid unsets the startid param
 func (p *ListEventsParams) ResetStartid() {
-	if p.p != nil && p.p["startid"] != nil {
-		delete(p.p, "startid")
-	}
+	p.startid = optString{}
 }
 
+// GetStartid returns the startid param and if it is set
 func (p *ListEventsParams) GetStartid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startid"].(string)
-	return value, ok
+	return p.startid.v, p.startid.ok
 }
 
+// SetType sets the type param.
 func (p *ListEventsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListEventsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListEventsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListEventsParams) Clone() *ListEventsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListEventsParams) Equal(o *ListEventsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.archived == o.archived &&
+		p.domainid == o.domainid &&
+		p.duration == o.duration &&
+		p.enddate == o.enddate &&
+		p.entrytime == o.entrytime &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.level == o.level &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.resourceid == o.resourceid &&
+		p.resourcetype == o.resourcetype &&
+		p.startdate == o.startdate &&
+		p.startid == o.startid &&
+		p.type_ == o.type_
+}
+
+// serializedListEventsParams is used to (un)marshal ListEventsParams using the API param names
+type serializedListEventsParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Archived     *bool   `json:"archived,omitempty" yaml:"archived,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Duration     *int    `json:"duration,omitempty" yaml:"duration,omitempty"`
+	Enddate      *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Entrytime    *int    `json:"entrytime,omitempty" yaml:"entrytime,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive  *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Level        *string `json:"level,omitempty" yaml:"level,omitempty"`
+	Listall      *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourceid   *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *string `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Startdate    *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Startid      *string `json:"startid,omitempty" yaml:"startid,omitempty"`
+	Type         *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *ListEventsParams) toSerialized() *serializedListEventsParams {
+	s := &serializedListEventsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.archived.ok {
+		s.Archived = &p.archived.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.duration.ok {
+		s.Duration = &p.duration.v
+	}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.entrytime.ok {
+		s.Entrytime = &p.entrytime.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.level.ok {
+		s.Level = &p.level.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.resourceid.ok {
+		s.Resourceid = &p.resourceid.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.startid.ok {
+		s.Startid = &p.startid.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *ListEventsParams) fromSerialized(s *serializedListEventsParams) {
+	*p = ListEventsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Archived != nil {
+		p.SetArchived(*s.Archived)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Duration != nil {
+		p.SetDuration(*s.Duration)
+	}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Entrytime != nil {
+		p.SetEntrytime(*s.Entrytime)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Level != nil {
+		p.SetLevel(*s.Level)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Resourceid != nil {
+		p.SetResourceid(*s.Resourceid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Startid != nil {
+		p.SetStartid(*s.Startid)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListEventsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListEventsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListEventsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListEventsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListEventsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListEventsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListEventsParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewListEventsParams() *ListEventsParams {
 	p := &ListEventsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int, error) {
 	p := &ListEventsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -934,8 +1401,8 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 }
 
 // A command to list events.
-func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
-	resp, err := s.cs.newRequest("listEvents", p.toURLValues())
+func (s *EventService) ListEvents(p *ListEventsParams, opts ...CallOption) (*ListEventsResponse, error) {
+	resp, err := s.cs.newRequest("listEvents", s.cs.encodeParams("listEvents", p), opts...)
 	if err != nil {
 		return nil, err
 	}
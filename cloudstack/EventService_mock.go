@@ -53,33 +53,43 @@ func (m *MockEventServiceIface) EXPECT() *MockEventServiceIfaceMockRecorder {
 }
 
 // ArchiveEvents mocks base method.
-func (m *MockEventServiceIface) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+func (m *MockEventServiceIface) ArchiveEvents(p *ArchiveEventsParams, opts ...CallOption) (*ArchiveEventsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ArchiveEvents", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ArchiveEvents", varargs...)
 	ret0, _ := ret[0].(*ArchiveEventsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ArchiveEvents indicates an expected call of ArchiveEvents.
-func (mr *MockEventServiceIfaceMockRecorder) ArchiveEvents(p interface{}) *gomock.Call {
+func (mr *MockEventServiceIfaceMockRecorder) ArchiveEvents(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveEvents", reflect.TypeOf((*MockEventServiceIface)(nil).ArchiveEvents), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveEvents", reflect.TypeOf((*MockEventServiceIface)(nil).ArchiveEvents), varargs...)
 }
 
 // DeleteEvents mocks base method.
-func (m *MockEventServiceIface) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+func (m *MockEventServiceIface) DeleteEvents(p *DeleteEventsParams, opts ...CallOption) (*DeleteEventsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEvents", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEvents", varargs...)
 	ret0, _ := ret[0].(*DeleteEventsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteEvents indicates an expected call of DeleteEvents.
-func (mr *MockEventServiceIfaceMockRecorder) DeleteEvents(p interface{}) *gomock.Call {
+func (mr *MockEventServiceIfaceMockRecorder) DeleteEvents(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockEventServiceIface)(nil).DeleteEvents), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockEventServiceIface)(nil).DeleteEvents), varargs...)
 }
 
 // GetEventByID mocks base method.
@@ -104,33 +114,43 @@ func (mr *MockEventServiceIfaceMockRecorder) GetEventByID(id interface{}, opts .
 }
 
 // ListEventTypes mocks base method.
-func (m *MockEventServiceIface) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+func (m *MockEventServiceIface) ListEventTypes(p *ListEventTypesParams, opts ...CallOption) (*ListEventTypesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEventTypes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventTypes", varargs...)
 	ret0, _ := ret[0].(*ListEventTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListEventTypes indicates an expected call of ListEventTypes.
-func (mr *MockEventServiceIfaceMockRecorder) ListEventTypes(p interface{}) *gomock.Call {
+func (mr *MockEventServiceIfaceMockRecorder) ListEventTypes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventTypes", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventTypes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventTypes", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventTypes), varargs...)
 }
 
 // ListEvents mocks base method.
-func (m *MockEventServiceIface) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
+func (m *MockEventServiceIface) ListEvents(p *ListEventsParams, opts ...CallOption) (*ListEventsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEvents", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEvents", varargs...)
 	ret0, _ := ret[0].(*ListEventsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListEvents indicates an expected call of ListEvents.
-func (mr *MockEventServiceIfaceMockRecorder) ListEvents(p interface{}) *gomock.Call {
+func (mr *MockEventServiceIfaceMockRecorder) ListEvents(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventServiceIface)(nil).ListEvents), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventServiceIface)(nil).ListEvents), varargs...)
 }
 
 // NewArchiveEventsParams mocks base method.
@@ -78,200 +78,305 @@ func convertFirewallServiceListResponse(b []byte) ([]byte, error) {
 }
 
 type FirewallServiceIface interface {
-	AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewall(p *AddPaloAltoFirewallParams, opts ...CallOption) (*AddPaloAltoFirewallResponse, error)
 	NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams
-	ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams, opts ...CallOption) (*PaloAltoFirewallResponse, error)
 	NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams
-	CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams, opts ...CallOption) (*CreateEgressFirewallRuleResponse, error)
 	NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams
-	CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRule(p *CreateFirewallRuleParams, opts ...CallOption) (*CreateFirewallRuleResponse, error)
 	NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams
-	CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRule(p *CreatePortForwardingRuleParams, opts ...CallOption) (*CreatePortForwardingRuleResponse, error)
 	NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams
-	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams, opts ...CallOption) (*DeleteEgressFirewallRuleResponse, error)
 	NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams
-	DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRule(p *DeleteFirewallRuleParams, opts ...CallOption) (*DeleteFirewallRuleResponse, error)
 	NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams
-	DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams, opts ...CallOption) (*DeletePaloAltoFirewallResponse, error)
 	NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams
-	DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRule(p *DeletePortForwardingRuleParams, opts ...CallOption) (*DeletePortForwardingRuleResponse, error)
 	NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams
-	ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRules(p *ListEgressFirewallRulesParams, opts ...CallOption) (*ListEgressFirewallRulesResponse, error)
 	NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams
 	GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
-	ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListFirewallRules(p *ListFirewallRulesParams, opts ...CallOption) (*ListFirewallRulesResponse, error)
 	NewListFirewallRulesParams() *ListFirewallRulesParams
 	GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error)
-	ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams, opts ...CallOption) (*ListPaloAltoFirewallsResponse, error)
 	NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams
-	ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRules(p *ListPortForwardingRulesParams, opts ...CallOption) (*ListPortForwardingRulesResponse, error)
 	NewListPortForwardingRulesParams() *ListPortForwardingRulesParams
 	GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
-	UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams, opts ...CallOption) (*UpdateEgressFirewallRuleResponse, error)
 	NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams
-	UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRule(p *UpdateFirewallRuleParams, opts ...CallOption) (*UpdateFirewallRuleResponse, error)
 	NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams
-	UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams, opts ...CallOption) (*UpdatePortForwardingRuleResponse, error)
 	NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams
-	ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error)
+	ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams, opts ...CallOption) (*ListIpv6FirewallRulesResponse, error)
 	NewListIpv6FirewallRulesParams() *ListIpv6FirewallRulesParams
 	GetIpv6FirewallRuleByID(id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error)
-	CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error)
+	CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams, opts ...CallOption) (*CreateIpv6FirewallRuleResponse, error)
 	NewCreateIpv6FirewallRuleParams(networkid string, protocol string) *CreateIpv6FirewallRuleParams
-	UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error)
+	UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams, opts ...CallOption) (*UpdateIpv6FirewallRuleResponse, error)
 	NewUpdateIpv6FirewallRuleParams(id string) *UpdateIpv6FirewallRuleParams
-	DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error)
+	DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams, opts ...CallOption) (*DeleteIpv6FirewallRuleResponse, error)
 	NewDeleteIpv6FirewallRuleParams(id string) *DeleteIpv6FirewallRuleParams
 }
 
 type AddPaloAltoFirewallParams struct {
-	p map[string]interface{}
+	networkdevicetype optString
+	password          optString
+	physicalnetworkid optString
+	url               optString
+	username          optString
 }
 
-func (p *AddPaloAltoFirewallParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddPaloAltoFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
+	if p.networkdevicetype.ok {
+		u.Set("networkdevicetype", p.networkdevicetype.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddPaloAltoFirewallParams parses url.Values, for example taken from a raw API request,
+// into a new AddPaloAltoFirewallParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddPaloAltoFirewallParams(u url.Values) (*AddPaloAltoFirewallParams, error) {
+	p := &AddPaloAltoFirewallParams{}
+	if err := checkParamNames("addPaloAltoFirewall", u, "networkdevicetype", "password", "physicalnetworkid", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["networkdevicetype"]; found {
+		p.SetNetworkdevicetype(u.Get("networkdevicetype"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
 	}
-	p.p["networkdevicetype"] = v
+	return p, nil
 }
 
+// SetNetworkdevicetype sets the networkdevicetype param. This param is required.
+func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
+	p.networkdevicetype = optString{v: v, ok: true}
+}
+
+// ResetNetworkdevicetype unsets the networkdevicetype param
 func (p *AddPaloAltoFirewallParams) ResetNetworkdevicetype() {
-	if p.p != nil && p.p["networkdevicetype"] != nil {
-		delete(p.p, "networkdevicetype")
-	}
+	p.networkdevicetype = optString{}
 }
 
+// GetNetworkdevicetype returns the networkdevicetype param and if it is set
 func (p *AddPaloAltoFirewallParams) GetNetworkdevicetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdevicetype"].(string)
-	return value, ok
+	return p.networkdevicetype.v, p.networkdevicetype.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddPaloAltoFirewallParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddPaloAltoFirewallParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddPaloAltoFirewallParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddPaloAltoFirewallParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddPaloAltoFirewallParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddPaloAltoFirewallParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddPaloAltoFirewallParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddPaloAltoFirewallParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddPaloAltoFirewallParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddPaloAltoFirewallParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddPaloAltoFirewallParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddPaloAltoFirewallParams) Clone() *AddPaloAltoFirewallParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddPaloAltoFirewallParams) Equal(o *AddPaloAltoFirewallParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.networkdevicetype == o.networkdevicetype &&
+		p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddPaloAltoFirewallParams is used to (un)marshal AddPaloAltoFirewallParams using the API param names
+type serializedAddPaloAltoFirewallParams struct {
+	Networkdevicetype *string `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddPaloAltoFirewallParams) toSerialized() *serializedAddPaloAltoFirewallParams {
+	s := &serializedAddPaloAltoFirewallParams{}
+	if p.networkdevicetype.ok {
+		s.Networkdevicetype = &p.networkdevicetype.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddPaloAltoFirewallParams) fromSerialized(s *serializedAddPaloAltoFirewallParams) {
+	*p = AddPaloAltoFirewallParams{}
+	if s.Networkdevicetype != nil {
+		p.SetNetworkdevicetype(*s.Networkdevicetype)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddPaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddPaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddPaloAltoFirewallParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddPaloAltoFirewallParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddPaloAltoFirewallParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddPaloAltoFirewallParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddPaloAltoFirewallParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams {
 	p := &AddPaloAltoFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkdevicetype"] = networkdevicetype
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
+	p.SetNetworkdevicetype(networkdevicetype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds a Palo Alto firewall device
-func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("addPaloAltoFirewall", p.toURLValues())
+// Adds a Palo Alto firewall device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: networkdevicetype,
+// password, physicalnetworkid, url, username.
+func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams, opts ...CallOption) (*AddPaloAltoFirewallResponse, error) {
+	resp, err := s.cs.newRequest("addPaloAltoFirewall", s.cs.encodeParams("addPaloAltoFirewall", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -282,8 +387,8 @@ func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*Ad
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -331,78 +436,167 @@ type AddPaloAltoFirewallResponse struct {
 }
 
 type ConfigurePaloAltoFirewallParams struct {
-	p map[string]interface{}
+	fwdevicecapacity optInt64
+	fwdeviceid       optString
 }
 
-func (p *ConfigurePaloAltoFirewallParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ConfigurePaloAltoFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fwdevicecapacity"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("fwdevicecapacity", vv)
+	if p.fwdevicecapacity.ok {
+		u.Set("fwdevicecapacity", strconv.FormatInt(p.fwdevicecapacity.v, 10))
 	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if p.fwdeviceid.ok {
+		u.Set("fwdeviceid", p.fwdeviceid.v)
 	}
 	return u
 }
 
-func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseConfigurePaloAltoFirewallParams parses url.Values, for example taken from a raw API request,
+// into a new ConfigurePaloAltoFirewallParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseConfigurePaloAltoFirewallParams(u url.Values) (*ConfigurePaloAltoFirewallParams, error) {
+	p := &ConfigurePaloAltoFirewallParams{}
+	if err := checkParamNames("configurePaloAltoFirewall", u, "fwdevicecapacity", "fwdeviceid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fwdevicecapacity"]; found {
+		v, err := strconv.ParseInt(u.Get("fwdevicecapacity"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fwdevicecapacity: %v", err)
+		}
+		p.SetFwdevicecapacity(v)
 	}
-	p.p["fwdevicecapacity"] = v
+	if _, found := u["fwdeviceid"]; found {
+		p.SetFwdeviceid(u.Get("fwdeviceid"))
+	}
+	return p, nil
+}
+
+// SetFwdevicecapacity sets the fwdevicecapacity param.
+func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
+	p.fwdevicecapacity = optInt64{v: v, ok: true}
 }
 
+// ResetFwdevicecapacity unsets the fwdevicecapacity param
 func (p *ConfigurePaloAltoFirewallParams) ResetFwdevicecapacity() {
-	if p.p != nil && p.p["fwdevicecapacity"] != nil {
-		delete(p.p, "fwdevicecapacity")
-	}
+	p.fwdevicecapacity = optInt64{}
 }
 
+// GetFwdevicecapacity returns the fwdevicecapacity param and if it is set
 func (p *ConfigurePaloAltoFirewallParams) GetFwdevicecapacity() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fwdevicecapacity"].(int64)
-	return value, ok
+	return p.fwdevicecapacity.v, p.fwdevicecapacity.ok
 }
 
+// SetFwdeviceid sets the fwdeviceid param. This param is required.
 func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fwdeviceid"] = v
+	p.fwdeviceid = optString{v: v, ok: true}
 }
 
+// ResetFwdeviceid unsets the fwdeviceid param
 func (p *ConfigurePaloAltoFirewallParams) ResetFwdeviceid() {
-	if p.p != nil && p.p["fwdeviceid"] != nil {
-		delete(p.p, "fwdeviceid")
-	}
+	p.fwdeviceid = optString{}
 }
 
+// GetFwdeviceid returns the fwdeviceid param and if it is set
 func (p *ConfigurePaloAltoFirewallParams) GetFwdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.fwdeviceid.v, p.fwdeviceid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ConfigurePaloAltoFirewallParams) Clone() *ConfigurePaloAltoFirewallParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ConfigurePaloAltoFirewallParams) Equal(o *ConfigurePaloAltoFirewallParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fwdevicecapacity == o.fwdevicecapacity &&
+		p.fwdeviceid == o.fwdeviceid
+}
+
+// serializedConfigurePaloAltoFirewallParams is used to (un)marshal ConfigurePaloAltoFirewallParams using the API param names
+type serializedConfigurePaloAltoFirewallParams struct {
+	Fwdevicecapacity *int64  `json:"fwdevicecapacity,omitempty" yaml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid       *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
+}
+
+func (p *ConfigurePaloAltoFirewallParams) toSerialized() *serializedConfigurePaloAltoFirewallParams {
+	s := &serializedConfigurePaloAltoFirewallParams{}
+	if p.fwdevicecapacity.ok {
+		s.Fwdevicecapacity = &p.fwdevicecapacity.v
 	}
-	value, ok := p.p["fwdeviceid"].(string)
-	return value, ok
+	if p.fwdeviceid.ok {
+		s.Fwdeviceid = &p.fwdeviceid.v
+	}
+	return s
+}
+
+func (p *ConfigurePaloAltoFirewallParams) fromSerialized(s *serializedConfigurePaloAltoFirewallParams) {
+	*p = ConfigurePaloAltoFirewallParams{}
+	if s.Fwdevicecapacity != nil {
+		p.SetFwdevicecapacity(*s.Fwdevicecapacity)
+	}
+	if s.Fwdeviceid != nil {
+		p.SetFwdeviceid(*s.Fwdeviceid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ConfigurePaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ConfigurePaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	var s serializedConfigurePaloAltoFirewallParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ConfigurePaloAltoFirewallParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ConfigurePaloAltoFirewallParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedConfigurePaloAltoFirewallParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ConfigurePaloAltoFirewallParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams {
 	p := &ConfigurePaloAltoFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
+	p.SetFwdeviceid(fwdeviceid)
 	return p
 }
 
-// Configures a Palo Alto firewall device
-func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("configurePaloAltoFirewall", p.toURLValues())
+// Configures a Palo Alto firewall device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: fwdeviceid.
+func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams, opts ...CallOption) (*PaloAltoFirewallResponse, error) {
+	resp, err := s.cs.newRequest("configurePaloAltoFirewall", s.cs.encodeParams("configurePaloAltoFirewall", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -413,8 +607,8 @@ func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewall
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -462,277 +656,426 @@ type PaloAltoFirewallResponse struct {
 }
 
 type CreateEgressFirewallRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
+	cidrlist     optStrings
+	destcidrlist optStrings
+	endport      optInt
+	fordisplay   optBool
+	icmpcode     optInt
+	icmptype     optInt
+	networkid    optString
+	protocol     optString
+	startport    optInt
+	type_        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateEgressFirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["destcidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("destcidrlist", vv)
+	if p.destcidrlist.ok {
+		u.Set("destcidrlist", strings.Join(p.destcidrlist.v, ","))
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateEgressFirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateEgressFirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateEgressFirewallRuleParams(u url.Values) (*CreateEgressFirewallRuleParams, error) {
+	p := &CreateEgressFirewallRuleParams{}
+	if err := checkParamNames("createEgressFirewallRule", u, "cidrlist", "destcidrlist", "endport", "fordisplay", "icmpcode", "icmptype", "networkid", "protocol", "startport", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["destcidrlist"]; found {
+		p.SetDestcidrlist(strings.Split(u.Get("destcidrlist"), ","))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
 	}
-	p.p["cidrlist"] = v
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
+}
+
+// SetCidrlist sets the cidrlist param.
+func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *CreateEgressFirewallRuleParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetDestcidrlist sets the destcidrlist param.
 func (p *CreateEgressFirewallRuleParams) SetDestcidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["destcidrlist"] = v
+	p.destcidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetDestcidrlist unsets the destcidrlist param
 func (p *CreateEgressFirewallRuleParams) ResetDestcidrlist() {
-	if p.p != nil && p.p["destcidrlist"] != nil {
-		delete(p.p, "destcidrlist")
-	}
+	p.destcidrlist = optStrings{}
 }
 
+// GetDestcidrlist returns the destcidrlist param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetDestcidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["destcidrlist"].([]string)
-	return value, ok
+	return p.destcidrlist.v, p.destcidrlist.ok
 }
 
+// SetEndport sets the endport param.
 func (p *CreateEgressFirewallRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *CreateEgressFirewallRuleParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateEgressFirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateEgressFirewallRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *CreateEgressFirewallRuleParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *CreateEgressFirewallRuleParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *CreateEgressFirewallRuleParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *CreateEgressFirewallRuleParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetNetworkid sets the networkid param. This param is required.
 func (p *CreateEgressFirewallRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateEgressFirewallRuleParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetProtocol sets the protocol param. This param is required.
 func (p *CreateEgressFirewallRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *CreateEgressFirewallRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetStartport sets the startport param.
 func (p *CreateEgressFirewallRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *CreateEgressFirewallRuleParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetType sets the type param.
 func (p *CreateEgressFirewallRuleParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *CreateEgressFirewallRuleParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *CreateEgressFirewallRuleParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateEgressFirewallRuleParams) Clone() *CreateEgressFirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	c.destcidrlist = p.destcidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateEgressFirewallRuleParams) Equal(o *CreateEgressFirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cidrlist.equal(o.cidrlist) &&
+		p.destcidrlist.equal(o.destcidrlist) &&
+		p.endport == o.endport &&
+		p.fordisplay == o.fordisplay &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.networkid == o.networkid &&
+		p.protocol == o.protocol &&
+		p.startport == o.startport &&
+		p.type_ == o.type_
+}
+
+// serializedCreateEgressFirewallRuleParams is used to (un)marshal CreateEgressFirewallRuleParams using the API param names
+type serializedCreateEgressFirewallRuleParams struct {
+	Cidrlist     *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Destcidrlist *[]string `json:"destcidrlist,omitempty" yaml:"destcidrlist,omitempty"`
+	Endport      *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay   *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode     *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype     *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Networkid    *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Protocol     *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport    *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Type         *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *CreateEgressFirewallRuleParams) toSerialized() *serializedCreateEgressFirewallRuleParams {
+	s := &serializedCreateEgressFirewallRuleParams{}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.destcidrlist.ok {
+		s.Destcidrlist = &p.destcidrlist.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *CreateEgressFirewallRuleParams) fromSerialized(s *serializedCreateEgressFirewallRuleParams) {
+	*p = CreateEgressFirewallRuleParams{}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Destcidrlist != nil {
+		p.SetDestcidrlist(*s.Destcidrlist)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateEgressFirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateEgressFirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateEgressFirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateEgressFirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams {
 	p := &CreateEgressFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
-	p.p["protocol"] = protocol
+	p.SetNetworkid(networkid)
+	p.SetProtocol(protocol)
 	return p
 }
 
-// Creates a egress firewall rule for a given network
-func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createEgressFirewallRule", p.toURLValues())
+// Creates a egress firewall rule for a given network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: networkid, protocol.
+func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams, opts ...CallOption) (*CreateEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("createEgressFirewallRule", s.cs.encodeParams("createEgressFirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -743,8 +1086,8 @@ func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -791,254 +1134,397 @@ type CreateEgressFirewallRuleResponse struct {
 }
 
 type CreateFirewallRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateFirewallRuleParams) toURLValues() url.Values {
+	cidrlist    optStrings
+	endport     optInt
+	fordisplay  optBool
+	icmpcode    optInt
+	icmptype    optInt
+	ipaddressid optString
+	protocol    optString
+	startport   optInt
+	type_       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateFirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateFirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateFirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateFirewallRuleParams(u url.Values) (*CreateFirewallRuleParams, error) {
+	p := &CreateFirewallRuleParams{}
+	if err := checkParamNames("createFirewallRule", u, "cidrlist", "endport", "fordisplay", "icmpcode", "icmptype", "ipaddressid", "protocol", "startport", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
+	}
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
 	}
-	p.p["cidrlist"] = v
+	return p, nil
+}
+
+// SetCidrlist sets the cidrlist param.
+func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *CreateFirewallRuleParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreateFirewallRuleParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetEndport sets the endport param.
 func (p *CreateFirewallRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *CreateFirewallRuleParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *CreateFirewallRuleParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateFirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateFirewallRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateFirewallRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *CreateFirewallRuleParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *CreateFirewallRuleParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *CreateFirewallRuleParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *CreateFirewallRuleParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetIpaddressid sets the ipaddressid param. This param is required.
 func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *CreateFirewallRuleParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *CreateFirewallRuleParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetProtocol sets the protocol param. This param is required.
 func (p *CreateFirewallRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *CreateFirewallRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *CreateFirewallRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetStartport sets the startport param.
 func (p *CreateFirewallRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *CreateFirewallRuleParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *CreateFirewallRuleParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetType sets the type param.
 func (p *CreateFirewallRuleParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *CreateFirewallRuleParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *CreateFirewallRuleParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
-// You should always use this function to get a new CreateFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
-	p := &CreateFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["protocol"] = protocol
-	return p
+// Clone returns a deep copy of the params
+func (p *CreateFirewallRuleParams) Clone() *CreateFirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	return &c
 }
 
-// Creates a firewall rule for a given IP address
-func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createFirewallRule", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateFirewallRuleParams) Equal(o *CreateFirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cidrlist.equal(o.cidrlist) &&
+		p.endport == o.endport &&
+		p.fordisplay == o.fordisplay &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.ipaddressid == o.ipaddressid &&
+		p.protocol == o.protocol &&
+		p.startport == o.startport &&
+		p.type_ == o.type_
+}
+
+// serializedCreateFirewallRuleParams is used to (un)marshal CreateFirewallRuleParams using the API param names
+type serializedCreateFirewallRuleParams struct {
+	Cidrlist    *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Endport     *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay  *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode    *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype    *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Ipaddressid *string   `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Protocol    *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport   *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Type        *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *CreateFirewallRuleParams) toSerialized() *serializedCreateFirewallRuleParams {
+	s := &serializedCreateFirewallRuleParams{}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *CreateFirewallRuleParams) fromSerialized(s *serializedCreateFirewallRuleParams) {
+	*p = CreateFirewallRuleParams{}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateFirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateFirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateFirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateFirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateFirewallRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
+	p := &CreateFirewallRuleParams{}
+	p.SetIpaddressid(ipaddressid)
+	p.SetProtocol(protocol)
+	return p
+}
+
+// Creates a firewall rule for a given IP address.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: ipaddressid, protocol.
+func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams, opts ...CallOption) (*CreateFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("createFirewallRule", s.cs.encodeParams("createFirewallRule", p), opts...)
+	if err != nil {
+		return nil, err
 	}
 
 	var r CreateFirewallRuleResponse
@@ -1047,8 +1533,8 @@ func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*Crea
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1095,328 +1581,493 @@ type CreateFirewallRuleResponse struct {
 }
 
 type CreatePortForwardingRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
+	cidrlist         optStrings
+	fordisplay       optBool
+	ipaddressid      optString
+	networkid        optString
+	openfirewall     optBool
+	privateendport   optInt
+	privateport      optInt
+	protocol         optString
+	publicendport    optInt
+	publicport       optInt
+	virtualmachineid optString
+	vmguestip        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreatePortForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
+	if p.openfirewall.ok {
+		u.Set("openfirewall", strconv.FormatBool(p.openfirewall.v))
 	}
-	if v, found := p.p["privateendport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateendport", vv)
+	if p.privateendport.ok {
+		u.Set("privateendport", strconv.Itoa(p.privateendport.v))
 	}
-	if v, found := p.p["privateport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateport", vv)
+	if p.privateport.ok {
+		u.Set("privateport", strconv.Itoa(p.privateport.v))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["publicendport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicendport", vv)
+	if p.publicendport.ok {
+		u.Set("publicendport", strconv.Itoa(p.publicendport.v))
 	}
-	if v, found := p.p["publicport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicport", vv)
+	if p.publicport.ok {
+		u.Set("publicport", strconv.Itoa(p.publicport.v))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["vmguestip"]; found {
-		u.Set("vmguestip", v.(string))
+	if p.vmguestip.ok {
+		u.Set("vmguestip", p.vmguestip.v)
 	}
 	return u
 }
 
-func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreatePortForwardingRuleParams parses url.Values, for example taken from a raw API request,
+// into a new CreatePortForwardingRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreatePortForwardingRuleParams(u url.Values) (*CreatePortForwardingRuleParams, error) {
+	p := &CreatePortForwardingRuleParams{}
+	if err := checkParamNames("createPortForwardingRule", u, "cidrlist", "fordisplay", "ipaddressid", "networkid", "openfirewall", "privateendport", "privateport", "protocol", "publicendport", "publicport", "virtualmachineid", "vmguestip"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["openfirewall"]; found {
+		v, err := strconv.ParseBool(u.Get("openfirewall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param openfirewall: %v", err)
+		}
+		p.SetOpenfirewall(v)
+	}
+	if _, found := u["privateendport"]; found {
+		v, err := strconv.Atoi(u.Get("privateendport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param privateendport: %v", err)
+		}
+		p.SetPrivateendport(v)
+	}
+	if _, found := u["privateport"]; found {
+		v, err := strconv.Atoi(u.Get("privateport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param privateport: %v", err)
+		}
+		p.SetPrivateport(v)
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["publicendport"]; found {
+		v, err := strconv.Atoi(u.Get("publicendport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param publicendport: %v", err)
+		}
+		p.SetPublicendport(v)
+	}
+	if _, found := u["publicport"]; found {
+		v, err := strconv.Atoi(u.Get("publicport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param publicport: %v", err)
+		}
+		p.SetPublicport(v)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
 	}
-	p.p["cidrlist"] = v
+	if _, found := u["vmguestip"]; found {
+		p.SetVmguestip(u.Get("vmguestip"))
+	}
+	return p, nil
+}
+
+// SetCidrlist sets the cidrlist param.
+func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *CreatePortForwardingRuleParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreatePortForwardingRuleParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreatePortForwardingRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreatePortForwardingRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreatePortForwardingRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIpaddressid sets the ipaddressid param. This param is required.
 func (p *CreatePortForwardingRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *CreatePortForwardingRuleParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *CreatePortForwardingRuleParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *CreatePortForwardingRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreatePortForwardingRuleParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreatePortForwardingRuleParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetOpenfirewall sets the openfirewall param.
 func (p *CreatePortForwardingRuleParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["openfirewall"] = v
+	p.openfirewall = optBool{v: v, ok: true}
 }
 
+// ResetOpenfirewall unsets the openfirewall param
 func (p *CreatePortForwardingRuleParams) ResetOpenfirewall() {
-	if p.p != nil && p.p["openfirewall"] != nil {
-		delete(p.p, "openfirewall")
-	}
+	p.openfirewall = optBool{}
 }
 
+// GetOpenfirewall returns the openfirewall param and if it is set
 func (p *CreatePortForwardingRuleParams) GetOpenfirewall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["openfirewall"].(bool)
-	return value, ok
+	return p.openfirewall.v, p.openfirewall.ok
 }
 
+// SetPrivateendport sets the privateendport param.
 func (p *CreatePortForwardingRuleParams) SetPrivateendport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateendport"] = v
+	p.privateendport = optInt{v: v, ok: true}
 }
 
+// ResetPrivateendport unsets the privateendport param
 func (p *CreatePortForwardingRuleParams) ResetPrivateendport() {
-	if p.p != nil && p.p["privateendport"] != nil {
-		delete(p.p, "privateendport")
-	}
+	p.privateendport = optInt{}
 }
 
+// GetPrivateendport returns the privateendport param and if it is set
 func (p *CreatePortForwardingRuleParams) GetPrivateendport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["privateendport"].(int)
-	return value, ok
+	return p.privateendport.v, p.privateendport.ok
 }
 
+// SetPrivateport sets the privateport param. This param is required.
 func (p *CreatePortForwardingRuleParams) SetPrivateport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateport"] = v
+	p.privateport = optInt{v: v, ok: true}
 }
 
+// ResetPrivateport unsets the privateport param
 func (p *CreatePortForwardingRuleParams) ResetPrivateport() {
-	if p.p != nil && p.p["privateport"] != nil {
-		delete(p.p, "privateport")
-	}
+	p.privateport = optInt{}
 }
 
+// GetPrivateport returns the privateport param and if it is set
 func (p *CreatePortForwardingRuleParams) GetPrivateport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["privateport"].(int)
-	return value, ok
+	return p.privateport.v, p.privateport.ok
 }
 
+// SetProtocol sets the protocol param. This param is required.
 func (p *CreatePortForwardingRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *CreatePortForwardingRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *CreatePortForwardingRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetPublicendport sets the publicendport param.
 func (p *CreatePortForwardingRuleParams) SetPublicendport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicendport"] = v
+	p.publicendport = optInt{v: v, ok: true}
 }
 
+// ResetPublicendport unsets the publicendport param
 func (p *CreatePortForwardingRuleParams) ResetPublicendport() {
-	if p.p != nil && p.p["publicendport"] != nil {
-		delete(p.p, "publicendport")
-	}
+	p.publicendport = optInt{}
 }
 
+// GetPublicendport returns the publicendport param and if it is set
 func (p *CreatePortForwardingRuleParams) GetPublicendport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicendport"].(int)
-	return value, ok
+	return p.publicendport.v, p.publicendport.ok
 }
 
+// SetPublicport sets the publicport param. This param is required.
 func (p *CreatePortForwardingRuleParams) SetPublicport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicport"] = v
+	p.publicport = optInt{v: v, ok: true}
 }
 
+// ResetPublicport unsets the publicport param
 func (p *CreatePortForwardingRuleParams) ResetPublicport() {
-	if p.p != nil && p.p["publicport"] != nil {
-		delete(p.p, "publicport")
-	}
+	p.publicport = optInt{}
 }
 
+// GetPublicport returns the publicport param and if it is set
 func (p *CreatePortForwardingRuleParams) GetPublicport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicport"].(int)
-	return value, ok
+	return p.publicport.v, p.publicport.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *CreatePortForwardingRuleParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *CreatePortForwardingRuleParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *CreatePortForwardingRuleParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetVmguestip sets the vmguestip param.
 func (p *CreatePortForwardingRuleParams) SetVmguestip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmguestip"] = v
+	p.vmguestip = optString{v: v, ok: true}
 }
 
+// ResetVmguestip unsets the vmguestip param
 func (p *CreatePortForwardingRuleParams) ResetVmguestip() {
-	if p.p != nil && p.p["vmguestip"] != nil {
-		delete(p.p, "vmguestip")
-	}
+	p.vmguestip = optString{}
 }
 
+// GetVmguestip returns the vmguestip param and if it is set
 func (p *CreatePortForwardingRuleParams) GetVmguestip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmguestip.v, p.vmguestip.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreatePortForwardingRuleParams) Clone() *CreatePortForwardingRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreatePortForwardingRuleParams) Equal(o *CreatePortForwardingRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cidrlist.equal(o.cidrlist) &&
+		p.fordisplay == o.fordisplay &&
+		p.ipaddressid == o.ipaddressid &&
+		p.networkid == o.networkid &&
+		p.openfirewall == o.openfirewall &&
+		p.privateendport == o.privateendport &&
+		p.privateport == o.privateport &&
+		p.protocol == o.protocol &&
+		p.publicendport == o.publicendport &&
+		p.publicport == o.publicport &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.vmguestip == o.vmguestip
+}
+
+// serializedCreatePortForwardingRuleParams is used to (un)marshal CreatePortForwardingRuleParams using the API param names
+type serializedCreatePortForwardingRuleParams struct {
+	Cidrlist         *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Fordisplay       *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Ipaddressid      *string   `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Networkid        *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Openfirewall     *bool     `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Privateendport   *int      `json:"privateendport,omitempty" yaml:"privateendport,omitempty"`
+	Privateport      *int      `json:"privateport,omitempty" yaml:"privateport,omitempty"`
+	Protocol         *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Publicendport    *int      `json:"publicendport,omitempty" yaml:"publicendport,omitempty"`
+	Publicport       *int      `json:"publicport,omitempty" yaml:"publicport,omitempty"`
+	Virtualmachineid *string   `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Vmguestip        *string   `json:"vmguestip,omitempty" yaml:"vmguestip,omitempty"`
+}
+
+func (p *CreatePortForwardingRuleParams) toSerialized() *serializedCreatePortForwardingRuleParams {
+	s := &serializedCreatePortForwardingRuleParams{}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.openfirewall.ok {
+		s.Openfirewall = &p.openfirewall.v
+	}
+	if p.privateendport.ok {
+		s.Privateendport = &p.privateendport.v
+	}
+	if p.privateport.ok {
+		s.Privateport = &p.privateport.v
 	}
-	value, ok := p.p["vmguestip"].(string)
-	return value, ok
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.publicendport.ok {
+		s.Publicendport = &p.publicendport.v
+	}
+	if p.publicport.ok {
+		s.Publicport = &p.publicport.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.vmguestip.ok {
+		s.Vmguestip = &p.vmguestip.v
+	}
+	return s
+}
+
+func (p *CreatePortForwardingRuleParams) fromSerialized(s *serializedCreatePortForwardingRuleParams) {
+	*p = CreatePortForwardingRuleParams{}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Openfirewall != nil {
+		p.SetOpenfirewall(*s.Openfirewall)
+	}
+	if s.Privateendport != nil {
+		p.SetPrivateendport(*s.Privateendport)
+	}
+	if s.Privateport != nil {
+		p.SetPrivateport(*s.Privateport)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Publicendport != nil {
+		p.SetPublicendport(*s.Publicendport)
+	}
+	if s.Publicport != nil {
+		p.SetPublicport(*s.Publicport)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Vmguestip != nil {
+		p.SetVmguestip(*s.Vmguestip)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreatePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreatePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreatePortForwardingRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreatePortForwardingRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreatePortForwardingRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreatePortForwardingRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreatePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
 	p := &CreatePortForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["privateport"] = privateport
-	p.p["protocol"] = protocol
-	p.p["publicport"] = publicport
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetIpaddressid(ipaddressid)
+	p.SetPrivateport(privateport)
+	p.SetProtocol(protocol)
+	p.SetPublicport(publicport)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Creates a port forwarding rule
-func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createPortForwardingRule", p.toURLValues())
+// Creates a port forwarding rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: ipaddressid,
+// privateport, protocol, publicport, virtualmachineid.
+func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams, opts ...CallOption) (*CreatePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest("createPortForwardingRule", s.cs.encodeParams("createPortForwardingRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1427,8 +2078,8 @@ func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1477,53 +2128,133 @@ type CreatePortForwardingRuleResponse struct {
 }
 
 type DeleteEgressFirewallRuleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteEgressFirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteEgressFirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteEgressFirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteEgressFirewallRuleParams(u url.Values) (*DeleteEgressFirewallRuleParams, error) {
+	p := &DeleteEgressFirewallRuleParams{}
+	if err := checkParamNames("deleteEgressFirewallRule", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteEgressFirewallRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteEgressFirewallRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteEgressFirewallRuleParams) Clone() *DeleteEgressFirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteEgressFirewallRuleParams) Equal(o *DeleteEgressFirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteEgressFirewallRuleParams is used to (un)marshal DeleteEgressFirewallRuleParams using the API param names
+type serializedDeleteEgressFirewallRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteEgressFirewallRuleParams) toSerialized() *serializedDeleteEgressFirewallRuleParams {
+	s := &serializedDeleteEgressFirewallRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DeleteEgressFirewallRuleParams) fromSerialized(s *serializedDeleteEgressFirewallRuleParams) {
+	*p = DeleteEgressFirewallRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteEgressFirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteEgressFirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteEgressFirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteEgressFirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams {
 	p := &DeleteEgressFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes an egress firewall rule
-func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteEgressFirewallRule", p.toURLValues())
+// Deletes an egress firewall rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams, opts ...CallOption) (*DeleteEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("deleteEgressFirewallRule", s.cs.encodeParams("deleteEgressFirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1534,8 +2265,8 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1564,53 +2295,133 @@ type DeleteEgressFirewallRuleResponse struct {
 }
 
 type DeleteFirewallRuleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteFirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteFirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteFirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteFirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteFirewallRuleParams(u url.Values) (*DeleteFirewallRuleParams, error) {
+	p := &DeleteFirewallRuleParams{}
+	if err := checkParamNames("deleteFirewallRule", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteFirewallRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteFirewallRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteFirewallRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteFirewallRuleParams) Clone() *DeleteFirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteFirewallRuleParams) Equal(o *DeleteFirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteFirewallRuleParams is used to (un)marshal DeleteFirewallRuleParams using the API param names
+type serializedDeleteFirewallRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteFirewallRuleParams) toSerialized() *serializedDeleteFirewallRuleParams {
+	s := &serializedDeleteFirewallRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DeleteFirewallRuleParams) fromSerialized(s *serializedDeleteFirewallRuleParams) {
+	*p = DeleteFirewallRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteFirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteFirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteFirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteFirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteFirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams {
 	p := &DeleteFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a firewall rule
-func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteFirewallRule", p.toURLValues())
+// Deletes a firewall rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams, opts ...CallOption) (*DeleteFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("deleteFirewallRule", s.cs.encodeParams("deleteFirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1621,8 +2432,8 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1651,53 +2462,133 @@ type DeleteFirewallRuleResponse struct {
 }
 
 type DeletePaloAltoFirewallParams struct {
-	p map[string]interface{}
+	fwdeviceid optString
 }
 
-func (p *DeletePaloAltoFirewallParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeletePaloAltoFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if p.fwdeviceid.ok {
+		u.Set("fwdeviceid", p.fwdeviceid.v)
 	}
 	return u
 }
 
-func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeletePaloAltoFirewallParams parses url.Values, for example taken from a raw API request,
+// into a new DeletePaloAltoFirewallParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeletePaloAltoFirewallParams(u url.Values) (*DeletePaloAltoFirewallParams, error) {
+	p := &DeletePaloAltoFirewallParams{}
+	if err := checkParamNames("deletePaloAltoFirewall", u, "fwdeviceid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fwdeviceid"]; found {
+		p.SetFwdeviceid(u.Get("fwdeviceid"))
 	}
-	p.p["fwdeviceid"] = v
+	return p, nil
+}
+
+// SetFwdeviceid sets the fwdeviceid param. This param is required.
+func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
+	p.fwdeviceid = optString{v: v, ok: true}
 }
 
+// ResetFwdeviceid unsets the fwdeviceid param
 func (p *DeletePaloAltoFirewallParams) ResetFwdeviceid() {
-	if p.p != nil && p.p["fwdeviceid"] != nil {
-		delete(p.p, "fwdeviceid")
-	}
+	p.fwdeviceid = optString{}
 }
 
+// GetFwdeviceid returns the fwdeviceid param and if it is set
 func (p *DeletePaloAltoFirewallParams) GetFwdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.fwdeviceid.v, p.fwdeviceid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeletePaloAltoFirewallParams) Clone() *DeletePaloAltoFirewallParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeletePaloAltoFirewallParams) Equal(o *DeletePaloAltoFirewallParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fwdeviceid == o.fwdeviceid
+}
+
+// serializedDeletePaloAltoFirewallParams is used to (un)marshal DeletePaloAltoFirewallParams using the API param names
+type serializedDeletePaloAltoFirewallParams struct {
+	Fwdeviceid *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
+}
+
+func (p *DeletePaloAltoFirewallParams) toSerialized() *serializedDeletePaloAltoFirewallParams {
+	s := &serializedDeletePaloAltoFirewallParams{}
+	if p.fwdeviceid.ok {
+		s.Fwdeviceid = &p.fwdeviceid.v
 	}
-	value, ok := p.p["fwdeviceid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DeletePaloAltoFirewallParams) fromSerialized(s *serializedDeletePaloAltoFirewallParams) {
+	*p = DeletePaloAltoFirewallParams{}
+	if s.Fwdeviceid != nil {
+		p.SetFwdeviceid(*s.Fwdeviceid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeletePaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeletePaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeletePaloAltoFirewallParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeletePaloAltoFirewallParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeletePaloAltoFirewallParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeletePaloAltoFirewallParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeletePaloAltoFirewallParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams {
 	p := &DeletePaloAltoFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
+	p.SetFwdeviceid(fwdeviceid)
 	return p
 }
 
-// delete a Palo Alto firewall device
-func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("deletePaloAltoFirewall", p.toURLValues())
+// delete a Palo Alto firewall device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: fwdeviceid.
+func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams, opts ...CallOption) (*DeletePaloAltoFirewallResponse, error) {
+	resp, err := s.cs.newRequest("deletePaloAltoFirewall", s.cs.encodeParams("deletePaloAltoFirewall", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1708,8 +2599,8 @@ func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1738,53 +2629,133 @@ type DeletePaloAltoFirewallResponse struct {
 }
 
 type DeletePortForwardingRuleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeletePortForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeletePortForwardingRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// ParseDeletePortForwardingRuleParams parses url.Values, for example taken from a raw API request,
+// into a new DeletePortForwardingRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeletePortForwardingRuleParams(u url.Values) (*DeletePortForwardingRuleParams, error) {
+	p := &DeletePortForwardingRuleParams{}
+	if err := checkParamNames("deletePortForwardingRule", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeletePortForwardingRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeletePortForwardingRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeletePortForwardingRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeletePortForwardingRuleParams) Clone() *DeletePortForwardingRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeletePortForwardingRuleParams) Equal(o *DeletePortForwardingRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeletePortForwardingRuleParams is used to (un)marshal DeletePortForwardingRuleParams using the API param names
+type serializedDeletePortForwardingRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeletePortForwardingRuleParams) toSerialized() *serializedDeletePortForwardingRuleParams {
+	s := &serializedDeletePortForwardingRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeletePortForwardingRuleParams) fromSerialized(s *serializedDeletePortForwardingRuleParams) {
+	*p = DeletePortForwardingRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeletePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeletePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeletePortForwardingRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeletePortForwardingRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeletePortForwardingRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeletePortForwardingRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeletePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams {
 	p := &DeletePortForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a port forwarding rule
-func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deletePortForwardingRule", p.toURLValues())
+// Deletes a port forwarding rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams, opts ...CallOption) (*DeletePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest("deletePortForwardingRule", s.cs.encodeParams("deletePortForwardingRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1795,8 +2766,8 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1825,57 +2796,65 @@ type DeletePortForwardingRuleResponse struct {
 }
 
 type ListEgressFirewallRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	ipaddressid optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	networkid   optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	tags        optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListEgressFirewallRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
@@ -1884,293 +2863,451 @@ func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListEgressFirewallRulesParams parses url.Values, for example taken from a raw API request,
+// into a new ListEgressFirewallRulesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListEgressFirewallRulesParams(u url.Values) (*ListEgressFirewallRulesParams, error) {
+	p := &ListEgressFirewallRulesParams{}
+	if err := checkParamNames("listEgressFirewallRules", u, "account", "domainid", "fordisplay", "id", "ipaddressid", "isrecursive", "keyword", "listall", "networkid", "page", "pagesize", "projectid", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListEgressFirewallRulesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListEgressFirewallRulesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListEgressFirewallRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListEgressFirewallRulesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListEgressFirewallRulesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListEgressFirewallRulesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListEgressFirewallRulesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListEgressFirewallRulesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListEgressFirewallRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListEgressFirewallRulesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListEgressFirewallRulesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddressid sets the ipaddressid param.
 func (p *ListEgressFirewallRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *ListEgressFirewallRulesParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *ListEgressFirewallRulesParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListEgressFirewallRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListEgressFirewallRulesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListEgressFirewallRulesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListEgressFirewallRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListEgressFirewallRulesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListEgressFirewallRulesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListEgressFirewallRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListEgressFirewallRulesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListEgressFirewallRulesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListEgressFirewallRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListEgressFirewallRulesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListEgressFirewallRulesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListEgressFirewallRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListEgressFirewallRulesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListEgressFirewallRulesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListEgressFirewallRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListEgressFirewallRulesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListEgressFirewallRulesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListEgressFirewallRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListEgressFirewallRulesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListEgressFirewallRulesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListEgressFirewallRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListEgressFirewallRulesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListEgressFirewallRulesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListEgressFirewallRulesParams) Clone() *ListEgressFirewallRulesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListEgressFirewallRulesParams) Equal(o *ListEgressFirewallRulesParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.ipaddressid == o.ipaddressid &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListEgressFirewallRulesParams is used to (un)marshal ListEgressFirewallRulesParams using the API param names
+type serializedListEgressFirewallRulesParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid *string            `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListEgressFirewallRulesParams) toSerialized() *serializedListEgressFirewallRulesParams {
+	s := &serializedListEgressFirewallRulesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListEgressFirewallRulesParams) fromSerialized(s *serializedListEgressFirewallRulesParams) {
+	*p = ListEgressFirewallRulesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListEgressFirewallRulesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListEgressFirewallRulesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListEgressFirewallRulesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListEgressFirewallRulesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListEgressFirewallRulesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListEgressFirewallRulesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListEgressFirewallRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams {
 	p := &ListEgressFirewallRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
 	p := &ListEgressFirewallRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2199,8 +3336,8 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFun
 }
 
 // Lists all egress firewall rules for network ID.
-func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listEgressFirewallRules", p.toURLValues())
+func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams, opts ...CallOption) (*ListEgressFirewallRulesResponse, error) {
+	resp, err := s.cs.newRequest("listEgressFirewallRules", s.cs.encodeParams("listEgressFirewallRules", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2244,57 +3381,65 @@ type EgressFirewallRule struct {
 }
 
 type ListFirewallRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListFirewallRulesParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	ipaddressid optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	networkid   optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	tags        optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListFirewallRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
@@ -2303,293 +3448,451 @@ func (p *ListFirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListFirewallRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListFirewallRulesParams parses url.Values, for example taken from a raw API request,
+// into a new ListFirewallRulesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListFirewallRulesParams(u url.Values) (*ListFirewallRulesParams, error) {
+	p := &ListFirewallRulesParams{}
+	if err := checkParamNames("listFirewallRules", u, "account", "domainid", "fordisplay", "id", "ipaddressid", "isrecursive", "keyword", "listall", "networkid", "page", "pagesize", "projectid", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["account"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListFirewallRulesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListFirewallRulesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListFirewallRulesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListFirewallRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListFirewallRulesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListFirewallRulesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListFirewallRulesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListFirewallRulesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListFirewallRulesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListFirewallRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListFirewallRulesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListFirewallRulesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddressid sets the ipaddressid param.
 func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *ListFirewallRulesParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *ListFirewallRulesParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListFirewallRulesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListFirewallRulesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListFirewallRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListFirewallRulesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListFirewallRulesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListFirewallRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListFirewallRulesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListFirewallRulesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListFirewallRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListFirewallRulesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListFirewallRulesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListFirewallRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListFirewallRulesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListFirewallRulesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListFirewallRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListFirewallRulesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListFirewallRulesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListFirewallRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListFirewallRulesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListFirewallRulesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListFirewallRulesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListFirewallRulesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListFirewallRulesParams) Clone() *ListFirewallRulesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListFirewallRulesParams) Equal(o *ListFirewallRulesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.ipaddressid == o.ipaddressid &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListFirewallRulesParams is used to (un)marshal ListFirewallRulesParams using the API param names
+type serializedListFirewallRulesParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid *string            `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListFirewallRulesParams) toSerialized() *serializedListFirewallRulesParams {
+	s := &serializedListFirewallRulesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListFirewallRulesParams) fromSerialized(s *serializedListFirewallRulesParams) {
+	*p = ListFirewallRulesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListFirewallRulesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListFirewallRulesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListFirewallRulesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListFirewallRulesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListFirewallRulesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListFirewallRulesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListFirewallRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams {
 	p := &ListFirewallRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
 	p := &ListFirewallRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2618,8 +3921,8 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 }
 
 // Lists all firewall rules for an IP address.
-func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listFirewallRules", p.toURLValues())
+func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams, opts ...CallOption) (*ListFirewallRulesResponse, error) {
+	resp, err := s.cs.newRequest("listFirewallRules", s.cs.encodeParams("listFirewallRules", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2663,150 +3966,257 @@ type FirewallRule struct {
 }
 
 type ListPaloAltoFirewallsParams struct {
-	p map[string]interface{}
+	fwdeviceid        optString
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
 }
 
-func (p *ListPaloAltoFirewallsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPaloAltoFirewallsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if p.fwdeviceid.ok {
+		u.Set("fwdeviceid", p.fwdeviceid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPaloAltoFirewallsParams parses url.Values, for example taken from a raw API request,
+// into a new ListPaloAltoFirewallsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPaloAltoFirewallsParams(u url.Values) (*ListPaloAltoFirewallsParams, error) {
+	p := &ListPaloAltoFirewallsParams{}
+	if err := checkParamNames("listPaloAltoFirewalls", u, "fwdeviceid", "keyword", "page", "pagesize", "physicalnetworkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fwdeviceid"]; found {
+		p.SetFwdeviceid(u.Get("fwdeviceid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["fwdeviceid"] = v
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	return p, nil
+}
+
+// SetFwdeviceid sets the fwdeviceid param.
+func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
+	p.fwdeviceid = optString{v: v, ok: true}
 }
 
+// ResetFwdeviceid unsets the fwdeviceid param
 func (p *ListPaloAltoFirewallsParams) ResetFwdeviceid() {
-	if p.p != nil && p.p["fwdeviceid"] != nil {
-		delete(p.p, "fwdeviceid")
-	}
+	p.fwdeviceid = optString{}
 }
 
+// GetFwdeviceid returns the fwdeviceid param and if it is set
 func (p *ListPaloAltoFirewallsParams) GetFwdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fwdeviceid"].(string)
-	return value, ok
+	return p.fwdeviceid.v, p.fwdeviceid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListPaloAltoFirewallsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPaloAltoFirewallsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPaloAltoFirewallsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPaloAltoFirewallsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPaloAltoFirewallsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPaloAltoFirewallsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPaloAltoFirewallsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPaloAltoFirewallsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPaloAltoFirewallsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListPaloAltoFirewallsParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListPaloAltoFirewallsParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPaloAltoFirewallsParams) Clone() *ListPaloAltoFirewallsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPaloAltoFirewallsParams) Equal(o *ListPaloAltoFirewallsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fwdeviceid == o.fwdeviceid &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListPaloAltoFirewallsParams is used to (un)marshal ListPaloAltoFirewallsParams using the API param names
+type serializedListPaloAltoFirewallsParams struct {
+	Fwdeviceid        *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListPaloAltoFirewallsParams) toSerialized() *serializedListPaloAltoFirewallsParams {
+	s := &serializedListPaloAltoFirewallsParams{}
+	if p.fwdeviceid.ok {
+		s.Fwdeviceid = &p.fwdeviceid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListPaloAltoFirewallsParams) fromSerialized(s *serializedListPaloAltoFirewallsParams) {
+	*p = ListPaloAltoFirewallsParams{}
+	if s.Fwdeviceid != nil {
+		p.SetFwdeviceid(*s.Fwdeviceid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPaloAltoFirewallsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPaloAltoFirewallsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPaloAltoFirewallsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPaloAltoFirewallsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPaloAltoFirewallsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPaloAltoFirewallsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPaloAltoFirewallsParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams {
 	p := &ListPaloAltoFirewallsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// lists Palo Alto firewall devices in a physical network
-func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewalls", p.toURLValues())
+// lists Palo Alto firewall devices in a physical network.
+func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams, opts ...CallOption) (*ListPaloAltoFirewallsResponse, error) {
+	resp, err := s.cs.newRequest("listPaloAltoFirewalls", s.cs.encodeParams("listPaloAltoFirewalls", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2851,57 +4261,65 @@ type PaloAltoFirewall struct {
 }
 
 type ListPortForwardingRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	ipaddressid optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	networkid   optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	tags        optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPortForwardingRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
@@ -2910,293 +4328,451 @@ func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListPortForwardingRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPortForwardingRulesParams parses url.Values, for example taken from a raw API request,
+// into a new ListPortForwardingRulesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPortForwardingRulesParams(u url.Values) (*ListPortForwardingRulesParams, error) {
+	p := &ListPortForwardingRulesParams{}
+	if err := checkParamNames("listPortForwardingRules", u, "account", "domainid", "fordisplay", "id", "ipaddressid", "isrecursive", "keyword", "listall", "networkid", "page", "pagesize", "projectid", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListPortForwardingRulesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListPortForwardingRulesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListPortForwardingRulesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListPortForwardingRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListPortForwardingRulesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListPortForwardingRulesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListPortForwardingRulesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListPortForwardingRulesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListPortForwardingRulesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListPortForwardingRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListPortForwardingRulesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListPortForwardingRulesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddressid sets the ipaddressid param.
 func (p *ListPortForwardingRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *ListPortForwardingRulesParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *ListPortForwardingRulesParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListPortForwardingRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListPortForwardingRulesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListPortForwardingRulesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListPortForwardingRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPortForwardingRulesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPortForwardingRulesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListPortForwardingRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListPortForwardingRulesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListPortForwardingRulesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListPortForwardingRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListPortForwardingRulesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListPortForwardingRulesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPortForwardingRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPortForwardingRulesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPortForwardingRulesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPortForwardingRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPortForwardingRulesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPortForwardingRulesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListPortForwardingRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListPortForwardingRulesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListPortForwardingRulesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListPortForwardingRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListPortForwardingRulesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListPortForwardingRulesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPortForwardingRulesParams) Clone() *ListPortForwardingRulesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPortForwardingRulesParams) Equal(o *ListPortForwardingRulesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.ipaddressid == o.ipaddressid &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListPortForwardingRulesParams is used to (un)marshal ListPortForwardingRulesParams using the API param names
+type serializedListPortForwardingRulesParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid *string            `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListPortForwardingRulesParams) toSerialized() *serializedListPortForwardingRulesParams {
+	s := &serializedListPortForwardingRulesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListPortForwardingRulesParams) fromSerialized(s *serializedListPortForwardingRulesParams) {
+	*p = ListPortForwardingRulesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPortForwardingRulesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPortForwardingRulesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPortForwardingRulesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPortForwardingRulesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPortForwardingRulesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPortForwardingRulesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPortForwardingRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewListPortForwardingRulesParams() *ListPortForwardingRulesParams {
 	p := &ListPortForwardingRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
 	p := &ListPortForwardingRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3225,8 +4801,8 @@ func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFun
 }
 
 // Lists all port forwarding rules for an IP address.
-func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listPortForwardingRules", p.toURLValues())
+func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams, opts ...CallOption) (*ListPortForwardingRulesResponse, error) {
+	resp, err := s.cs.newRequest("listPortForwardingRules", s.cs.encodeParams("listPortForwardingRules", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3271,103 +4847,198 @@ type PortForwardingRule struct {
 	Vmguestip                 string `json:"vmguestip"`
 }
 
-type UpdateEgressFirewallRuleParams struct {
-	p map[string]interface{}
+type UpdateEgressFirewallRuleParams struct {
+	customid   optString
+	fordisplay optBool
+	id         optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateEgressFirewallRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
+	}
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseUpdateEgressFirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateEgressFirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateEgressFirewallRuleParams(u url.Values) (*UpdateEgressFirewallRuleParams, error) {
+	p := &UpdateEgressFirewallRuleParams{}
+	if err := checkParamNames("updateEgressFirewallRule", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
+func (p *UpdateEgressFirewallRuleParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
+}
+
+// ResetCustomid unsets the customid param
+func (p *UpdateEgressFirewallRuleParams) ResetCustomid() {
+	p.customid = optString{}
+}
+
+// GetCustomid returns the customid param and if it is set
+func (p *UpdateEgressFirewallRuleParams) GetCustomid() (string, bool) {
+	return p.customid.v, p.customid.ok
+}
+
+// SetFordisplay sets the fordisplay param.
+func (p *UpdateEgressFirewallRuleParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
+}
+
+// ResetFordisplay unsets the fordisplay param
+func (p *UpdateEgressFirewallRuleParams) ResetFordisplay() {
+	p.fordisplay = optBool{}
+}
+
+// GetFordisplay returns the fordisplay param and if it is set
+func (p *UpdateEgressFirewallRuleParams) GetFordisplay() (bool, bool) {
+	return p.fordisplay.v, p.fordisplay.ok
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpdateEgressFirewallRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *UpdateEgressFirewallRuleParams) ResetId() {
+	p.id = optString{}
 }
 
-func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+// GetId returns the id param and if it is set
+func (p *UpdateEgressFirewallRuleParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
 }
 
-func (p *UpdateEgressFirewallRuleParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Clone returns a deep copy of the params
+func (p *UpdateEgressFirewallRuleParams) Clone() *UpdateEgressFirewallRuleParams {
+	if p == nil {
+		return nil
 	}
-	p.p["customid"] = v
+	c := *p
+	return &c
 }
 
-func (p *UpdateEgressFirewallRuleParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateEgressFirewallRuleParams) Equal(o *UpdateEgressFirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
 }
 
-func (p *UpdateEgressFirewallRuleParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+// serializedUpdateEgressFirewallRuleParams is used to (un)marshal UpdateEgressFirewallRuleParams using the API param names
+type serializedUpdateEgressFirewallRuleParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *UpdateEgressFirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateEgressFirewallRuleParams) toSerialized() *serializedUpdateEgressFirewallRuleParams {
+	s := &serializedUpdateEgressFirewallRuleParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
 	}
-	p.p["fordisplay"] = v
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
 }
 
-func (p *UpdateEgressFirewallRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
+func (p *UpdateEgressFirewallRuleParams) fromSerialized(s *serializedUpdateEgressFirewallRuleParams) {
+	*p = UpdateEgressFirewallRuleParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
 }
 
-func (p *UpdateEgressFirewallRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *UpdateEgressFirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateEgressFirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["id"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *UpdateEgressFirewallRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateEgressFirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *UpdateEgressFirewallRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateEgressFirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateEgressFirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams {
 	p := &UpdateEgressFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates egress firewall rule
-func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateEgressFirewallRule", p.toURLValues())
+// Updates egress firewall rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams, opts ...CallOption) (*UpdateEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("updateEgressFirewallRule", s.cs.encodeParams("updateEgressFirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3378,8 +5049,8 @@ func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3426,102 +5097,197 @@ type UpdateEgressFirewallRuleResponse struct {
 }
 
 type UpdateFirewallRuleParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateFirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateFirewallRuleParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateFirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateFirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateFirewallRuleParams(u url.Values) (*UpdateFirewallRuleParams, error) {
+	p := &UpdateFirewallRuleParams{}
+	if err := checkParamNames("updateFirewallRule", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
 	}
-	p.p["customid"] = v
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetCustomid sets the customid param.
+func (p *UpdateFirewallRuleParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
+}
+
+// ResetCustomid unsets the customid param
 func (p *UpdateFirewallRuleParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateFirewallRuleParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateFirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateFirewallRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateFirewallRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateFirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateFirewallRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateFirewallRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateFirewallRuleParams) Clone() *UpdateFirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateFirewallRuleParams) Equal(o *UpdateFirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateFirewallRuleParams is used to (un)marshal UpdateFirewallRuleParams using the API param names
+type serializedUpdateFirewallRuleParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateFirewallRuleParams) toSerialized() *serializedUpdateFirewallRuleParams {
+	s := &serializedUpdateFirewallRuleParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateFirewallRuleParams) fromSerialized(s *serializedUpdateFirewallRuleParams) {
+	*p = UpdateFirewallRuleParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateFirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateFirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateFirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateFirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateFirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams {
 	p := &UpdateFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates firewall rule
-func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateFirewallRule", p.toURLValues())
+// Updates firewall rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams, opts ...CallOption) (*UpdateFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("updateFirewallRule", s.cs.encodeParams("updateFirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3532,8 +5298,8 @@ func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*Upda
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3580,200 +5346,325 @@ type UpdateFirewallRuleResponse struct {
 }
 
 type UpdatePortForwardingRuleParams struct {
-	p map[string]interface{}
+	customid         optString
+	fordisplay       optBool
+	id               optString
+	privateendport   optInt
+	privateport      optInt
+	virtualmachineid optString
+	vmguestip        optString
 }
 
-func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdatePortForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["privateendport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateendport", vv)
+	if p.privateendport.ok {
+		u.Set("privateendport", strconv.Itoa(p.privateendport.v))
 	}
-	if v, found := p.p["privateport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateport", vv)
+	if p.privateport.ok {
+		u.Set("privateport", strconv.Itoa(p.privateport.v))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["vmguestip"]; found {
-		u.Set("vmguestip", v.(string))
+	if p.vmguestip.ok {
+		u.Set("vmguestip", p.vmguestip.v)
 	}
 	return u
 }
 
-func (p *UpdatePortForwardingRuleParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdatePortForwardingRuleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdatePortForwardingRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdatePortForwardingRuleParams(u url.Values) (*UpdatePortForwardingRuleParams, error) {
+	p := &UpdatePortForwardingRuleParams{}
+	if err := checkParamNames("updatePortForwardingRule", u, "customid", "fordisplay", "id", "privateendport", "privateport", "virtualmachineid", "vmguestip"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["privateendport"]; found {
+		v, err := strconv.Atoi(u.Get("privateendport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param privateendport: %v", err)
+		}
+		p.SetPrivateendport(v)
+	}
+	if _, found := u["privateport"]; found {
+		v, err := strconv.Atoi(u.Get("privateport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param privateport: %v", err)
+		}
+		p.SetPrivateport(v)
 	}
-	p.p["customid"] = v
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["vmguestip"]; found {
+		p.SetVmguestip(u.Get("vmguestip"))
+	}
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
+func (p *UpdatePortForwardingRuleParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdatePortForwardingRuleParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdatePortForwardingRuleParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdatePortForwardingRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdatePortForwardingRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdatePortForwardingRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdatePortForwardingRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdatePortForwardingRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdatePortForwardingRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetPrivateendport sets the privateendport param.
 func (p *UpdatePortForwardingRuleParams) SetPrivateendport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateendport"] = v
+	p.privateendport = optInt{v: v, ok: true}
 }
 
+// ResetPrivateendport unsets the privateendport param
 func (p *UpdatePortForwardingRuleParams) ResetPrivateendport() {
-	if p.p != nil && p.p["privateendport"] != nil {
-		delete(p.p, "privateendport")
-	}
+	p.privateendport = optInt{}
 }
 
+// GetPrivateendport returns the privateendport param and if it is set
 func (p *UpdatePortForwardingRuleParams) GetPrivateendport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["privateendport"].(int)
-	return value, ok
+	return p.privateendport.v, p.privateendport.ok
 }
 
+// SetPrivateport sets the privateport param.
 func (p *UpdatePortForwardingRuleParams) SetPrivateport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateport"] = v
+	p.privateport = optInt{v: v, ok: true}
 }
 
+// ResetPrivateport unsets the privateport param
 func (p *UpdatePortForwardingRuleParams) ResetPrivateport() {
-	if p.p != nil && p.p["privateport"] != nil {
-		delete(p.p, "privateport")
-	}
+	p.privateport = optInt{}
 }
 
+// GetPrivateport returns the privateport param and if it is set
 func (p *UpdatePortForwardingRuleParams) GetPrivateport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["privateport"].(int)
-	return value, ok
+	return p.privateport.v, p.privateport.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *UpdatePortForwardingRuleParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *UpdatePortForwardingRuleParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *UpdatePortForwardingRuleParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetVmguestip sets the vmguestip param.
 func (p *UpdatePortForwardingRuleParams) SetVmguestip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmguestip"] = v
+	p.vmguestip = optString{v: v, ok: true}
 }
 
+// ResetVmguestip unsets the vmguestip param
 func (p *UpdatePortForwardingRuleParams) ResetVmguestip() {
-	if p.p != nil && p.p["vmguestip"] != nil {
-		delete(p.p, "vmguestip")
-	}
+	p.vmguestip = optString{}
 }
 
+// GetVmguestip returns the vmguestip param and if it is set
 func (p *UpdatePortForwardingRuleParams) GetVmguestip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmguestip.v, p.vmguestip.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdatePortForwardingRuleParams) Clone() *UpdatePortForwardingRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdatePortForwardingRuleParams) Equal(o *UpdatePortForwardingRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.privateendport == o.privateendport &&
+		p.privateport == o.privateport &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.vmguestip == o.vmguestip
+}
+
+// serializedUpdatePortForwardingRuleParams is used to (un)marshal UpdatePortForwardingRuleParams using the API param names
+type serializedUpdatePortForwardingRuleParams struct {
+	Customid         *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay       *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Privateendport   *int    `json:"privateendport,omitempty" yaml:"privateendport,omitempty"`
+	Privateport      *int    `json:"privateport,omitempty" yaml:"privateport,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Vmguestip        *string `json:"vmguestip,omitempty" yaml:"vmguestip,omitempty"`
+}
+
+func (p *UpdatePortForwardingRuleParams) toSerialized() *serializedUpdatePortForwardingRuleParams {
+	s := &serializedUpdatePortForwardingRuleParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.privateendport.ok {
+		s.Privateendport = &p.privateendport.v
+	}
+	if p.privateport.ok {
+		s.Privateport = &p.privateport.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.vmguestip.ok {
+		s.Vmguestip = &p.vmguestip.v
 	}
-	value, ok := p.p["vmguestip"].(string)
-	return value, ok
+	return s
+}
+
+func (p *UpdatePortForwardingRuleParams) fromSerialized(s *serializedUpdatePortForwardingRuleParams) {
+	*p = UpdatePortForwardingRuleParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Privateendport != nil {
+		p.SetPrivateendport(*s.Privateendport)
+	}
+	if s.Privateport != nil {
+		p.SetPrivateport(*s.Privateport)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Vmguestip != nil {
+		p.SetVmguestip(*s.Vmguestip)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdatePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdatePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdatePortForwardingRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdatePortForwardingRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdatePortForwardingRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdatePortForwardingRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdatePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams {
 	p := &UpdatePortForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a port forwarding rule. Only the private port and the virtual machine can be updated.
-func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("updatePortForwardingRule", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams, opts ...CallOption) (*UpdatePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest("updatePortForwardingRule", s.cs.encodeParams("updatePortForwardingRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3784,8 +5675,8 @@ func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3834,352 +5725,518 @@ type UpdatePortForwardingRuleResponse struct {
 }
 
 type ListIpv6FirewallRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListIpv6FirewallRulesParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	networkid   optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	tags        optStringMap
+	traffictype optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListIpv6FirewallRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
 	return u
 }
 
-func (p *ListIpv6FirewallRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListIpv6FirewallRulesParams parses url.Values, for example taken from a raw API request,
+// into a new ListIpv6FirewallRulesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListIpv6FirewallRulesParams(u url.Values) (*ListIpv6FirewallRulesParams, error) {
+	p := &ListIpv6FirewallRulesParams{}
+	if err := checkParamNames("listIpv6FirewallRules", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "networkid", "page", "pagesize", "projectid", "tags[]", "traffictype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
 	}
-	p.p["account"] = v
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListIpv6FirewallRulesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListIpv6FirewallRulesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListIpv6FirewallRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListIpv6FirewallRulesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListIpv6FirewallRulesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListIpv6FirewallRulesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListIpv6FirewallRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListIpv6FirewallRulesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListIpv6FirewallRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListIpv6FirewallRulesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListIpv6FirewallRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListIpv6FirewallRulesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListIpv6FirewallRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListIpv6FirewallRulesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListIpv6FirewallRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListIpv6FirewallRulesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListIpv6FirewallRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListIpv6FirewallRulesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListIpv6FirewallRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListIpv6FirewallRulesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListIpv6FirewallRulesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *ListIpv6FirewallRulesParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *ListIpv6FirewallRulesParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *ListIpv6FirewallRulesParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetTags sets the tags param.
+func (p *ListIpv6FirewallRulesParams) SetTags(v map[string]string) {
+	p.tags = optStringMap{v: v, ok: true}
+}
+
+// ResetTags unsets the tags param
+func (p *ListIpv6FirewallRulesParams) ResetTags() {
+	p.tags = optStringMap{}
+}
+
+// GetTags returns the tags param and if it is set
+func (p *ListIpv6FirewallRulesParams) GetTags() (map[string]string, bool) {
+	return p.tags.v, p.tags.ok
+}
+
+// SetTraffictype sets the traffictype param.
+func (p *ListIpv6FirewallRulesParams) SetTraffictype(v string) {
+	p.traffictype = optString{v: v, ok: true}
+}
+
+// ResetTraffictype unsets the traffictype param
+func (p *ListIpv6FirewallRulesParams) ResetTraffictype() {
+	p.traffictype = optString{}
+}
+
+// GetTraffictype returns the traffictype param and if it is set
+func (p *ListIpv6FirewallRulesParams) GetTraffictype() (string, bool) {
+	return p.traffictype.v, p.traffictype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListIpv6FirewallRulesParams) Clone() *ListIpv6FirewallRulesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListIpv6FirewallRulesParams) Equal(o *ListIpv6FirewallRulesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.tags.equal(o.tags) &&
+		p.traffictype == o.traffictype
+}
+
+// serializedListIpv6FirewallRulesParams is used to (un)marshal ListIpv6FirewallRulesParams using the API param names
+type serializedListIpv6FirewallRulesParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype *string            `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+func (p *ListIpv6FirewallRulesParams) toSerialized() *serializedListIpv6FirewallRulesParams {
+	s := &serializedListIpv6FirewallRulesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
-}
-
-func (p *ListIpv6FirewallRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
 	}
-	p.p["projectid"] = v
+	return s
 }
 
-func (p *ListIpv6FirewallRulesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
+func (p *ListIpv6FirewallRulesParams) fromSerialized(s *serializedListIpv6FirewallRulesParams) {
+	*p = ListIpv6FirewallRulesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
 	}
-}
-
-func (p *ListIpv6FirewallRulesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
-}
-
-func (p *ListIpv6FirewallRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
 	}
-	p.p["tags"] = v
-}
-
-func (p *ListIpv6FirewallRulesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
 	}
 }
 
-func (p *ListIpv6FirewallRulesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListIpv6FirewallRulesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListIpv6FirewallRulesParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListIpv6FirewallRulesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListIpv6FirewallRulesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["traffictype"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListIpv6FirewallRulesParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListIpv6FirewallRulesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListIpv6FirewallRulesParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListIpv6FirewallRulesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListIpv6FirewallRulesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListIpv6FirewallRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewListIpv6FirewallRulesParams() *ListIpv6FirewallRulesParams {
 	p := &ListIpv6FirewallRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetIpv6FirewallRuleByID(id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error) {
 	p := &ListIpv6FirewallRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4207,9 +6264,9 @@ func (s *FirewallService) GetIpv6FirewallRuleByID(id string, opts ...OptionFunc)
 	return nil, l.Count, fmt.Errorf("There is more then one result for Ipv6FirewallRule UUID: %s!", id)
 }
 
-// Lists all IPv6 firewall rules
-func (s *FirewallService) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listIpv6FirewallRules", p.toURLValues())
+// Lists all IPv6 firewall rules.
+func (s *FirewallService) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams, opts ...CallOption) (*ListIpv6FirewallRulesResponse, error) {
+	resp, err := s.cs.newRequest("listIpv6FirewallRules", s.cs.encodeParams("listIpv6FirewallRules", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4255,277 +6312,426 @@ type Ipv6FirewallRule struct {
 }
 
 type CreateIpv6FirewallRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateIpv6FirewallRuleParams) toURLValues() url.Values {
+	cidrlist     optStrings
+	destcidrlist optStrings
+	endport      optInt
+	fordisplay   optBool
+	icmpcode     optInt
+	icmptype     optInt
+	networkid    optString
+	protocol     optString
+	startport    optInt
+	traffictype  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateIpv6FirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["destcidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("destcidrlist", vv)
+	if p.destcidrlist.ok {
+		u.Set("destcidrlist", strings.Join(p.destcidrlist.v, ","))
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
 	return u
 }
 
-func (p *CreateIpv6FirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateIpv6FirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateIpv6FirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateIpv6FirewallRuleParams(u url.Values) (*CreateIpv6FirewallRuleParams, error) {
+	p := &CreateIpv6FirewallRuleParams{}
+	if err := checkParamNames("createIpv6FirewallRule", u, "cidrlist", "destcidrlist", "endport", "fordisplay", "icmpcode", "icmptype", "networkid", "protocol", "startport", "traffictype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["destcidrlist"]; found {
+		p.SetDestcidrlist(strings.Split(u.Get("destcidrlist"), ","))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
+	}
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
 	}
-	p.p["cidrlist"] = v
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	return p, nil
+}
+
+// SetCidrlist sets the cidrlist param.
+func (p *CreateIpv6FirewallRuleParams) SetCidrlist(v []string) {
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *CreateIpv6FirewallRuleParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetDestcidrlist sets the destcidrlist param.
 func (p *CreateIpv6FirewallRuleParams) SetDestcidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["destcidrlist"] = v
+	p.destcidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetDestcidrlist unsets the destcidrlist param
 func (p *CreateIpv6FirewallRuleParams) ResetDestcidrlist() {
-	if p.p != nil && p.p["destcidrlist"] != nil {
-		delete(p.p, "destcidrlist")
-	}
+	p.destcidrlist = optStrings{}
 }
 
+// GetDestcidrlist returns the destcidrlist param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetDestcidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["destcidrlist"].([]string)
-	return value, ok
+	return p.destcidrlist.v, p.destcidrlist.ok
 }
 
+// SetEndport sets the endport param.
 func (p *CreateIpv6FirewallRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *CreateIpv6FirewallRuleParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateIpv6FirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateIpv6FirewallRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *CreateIpv6FirewallRuleParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *CreateIpv6FirewallRuleParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *CreateIpv6FirewallRuleParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *CreateIpv6FirewallRuleParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetNetworkid sets the networkid param. This param is required.
 func (p *CreateIpv6FirewallRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateIpv6FirewallRuleParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetProtocol sets the protocol param. This param is required.
 func (p *CreateIpv6FirewallRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *CreateIpv6FirewallRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetStartport sets the startport param.
 func (p *CreateIpv6FirewallRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *CreateIpv6FirewallRuleParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetTraffictype sets the traffictype param.
 func (p *CreateIpv6FirewallRuleParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *CreateIpv6FirewallRuleParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *CreateIpv6FirewallRuleParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.traffictype.v, p.traffictype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateIpv6FirewallRuleParams) Clone() *CreateIpv6FirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	c.destcidrlist = p.destcidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateIpv6FirewallRuleParams) Equal(o *CreateIpv6FirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cidrlist.equal(o.cidrlist) &&
+		p.destcidrlist.equal(o.destcidrlist) &&
+		p.endport == o.endport &&
+		p.fordisplay == o.fordisplay &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.networkid == o.networkid &&
+		p.protocol == o.protocol &&
+		p.startport == o.startport &&
+		p.traffictype == o.traffictype
+}
+
+// serializedCreateIpv6FirewallRuleParams is used to (un)marshal CreateIpv6FirewallRuleParams using the API param names
+type serializedCreateIpv6FirewallRuleParams struct {
+	Cidrlist     *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Destcidrlist *[]string `json:"destcidrlist,omitempty" yaml:"destcidrlist,omitempty"`
+	Endport      *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay   *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode     *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype     *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Networkid    *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Protocol     *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport    *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Traffictype  *string   `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+func (p *CreateIpv6FirewallRuleParams) toSerialized() *serializedCreateIpv6FirewallRuleParams {
+	s := &serializedCreateIpv6FirewallRuleParams{}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.destcidrlist.ok {
+		s.Destcidrlist = &p.destcidrlist.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
 	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	return s
+}
+
+func (p *CreateIpv6FirewallRuleParams) fromSerialized(s *serializedCreateIpv6FirewallRuleParams) {
+	*p = CreateIpv6FirewallRuleParams{}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Destcidrlist != nil {
+		p.SetDestcidrlist(*s.Destcidrlist)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateIpv6FirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateIpv6FirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateIpv6FirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateIpv6FirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateIpv6FirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateIpv6FirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateIpv6FirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewCreateIpv6FirewallRuleParams(networkid string, protocol string) *CreateIpv6FirewallRuleParams {
 	p := &CreateIpv6FirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
-	p.p["protocol"] = protocol
+	p.SetNetworkid(networkid)
+	p.SetProtocol(protocol)
 	return p
 }
 
-// Creates an Ipv6 firewall rule in the given network (the network has to belong to VPC)
-func (s *FirewallService) CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createIpv6FirewallRule", p.toURLValues())
+// Creates an Ipv6 firewall rule in the given network (the network has to belong to VPC).
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: networkid, protocol.
+func (s *FirewallService) CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams, opts ...CallOption) (*CreateIpv6FirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("createIpv6FirewallRule", s.cs.encodeParams("createIpv6FirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4536,8 +6742,8 @@ func (s *FirewallService) CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4586,275 +6792,424 @@ type CreateIpv6FirewallRuleResponse struct {
 }
 
 type UpdateIpv6FirewallRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateIpv6FirewallRuleParams) toURLValues() url.Values {
+	cidrlist    optStrings
+	customid    optString
+	endport     optInt
+	fordisplay  optBool
+	icmpcode    optInt
+	icmptype    optInt
+	id          optString
+	protocol    optString
+	startport   optInt
+	traffictype optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateIpv6FirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
 	return u
 }
 
-func (p *UpdateIpv6FirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateIpv6FirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateIpv6FirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateIpv6FirewallRuleParams(u url.Values) (*UpdateIpv6FirewallRuleParams, error) {
+	p := &UpdateIpv6FirewallRuleParams{}
+	if err := checkParamNames("updateIpv6FirewallRule", u, "cidrlist", "customid", "endport", "fordisplay", "icmpcode", "icmptype", "id", "protocol", "startport", "traffictype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
+	}
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
 	}
-	p.p["cidrlist"] = v
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	return p, nil
+}
+
+// SetCidrlist sets the cidrlist param.
+func (p *UpdateIpv6FirewallRuleParams) SetCidrlist(v []string) {
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *UpdateIpv6FirewallRuleParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *UpdateIpv6FirewallRuleParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateIpv6FirewallRuleParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetEndport sets the endport param.
 func (p *UpdateIpv6FirewallRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *UpdateIpv6FirewallRuleParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateIpv6FirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateIpv6FirewallRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *UpdateIpv6FirewallRuleParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *UpdateIpv6FirewallRuleParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *UpdateIpv6FirewallRuleParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *UpdateIpv6FirewallRuleParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateIpv6FirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateIpv6FirewallRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *UpdateIpv6FirewallRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *UpdateIpv6FirewallRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetStartport sets the startport param.
 func (p *UpdateIpv6FirewallRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *UpdateIpv6FirewallRuleParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetTraffictype sets the traffictype param.
 func (p *UpdateIpv6FirewallRuleParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *UpdateIpv6FirewallRuleParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *UpdateIpv6FirewallRuleParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.traffictype.v, p.traffictype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateIpv6FirewallRuleParams) Clone() *UpdateIpv6FirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateIpv6FirewallRuleParams) Equal(o *UpdateIpv6FirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cidrlist.equal(o.cidrlist) &&
+		p.customid == o.customid &&
+		p.endport == o.endport &&
+		p.fordisplay == o.fordisplay &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.id == o.id &&
+		p.protocol == o.protocol &&
+		p.startport == o.startport &&
+		p.traffictype == o.traffictype
+}
+
+// serializedUpdateIpv6FirewallRuleParams is used to (un)marshal UpdateIpv6FirewallRuleParams using the API param names
+type serializedUpdateIpv6FirewallRuleParams struct {
+	Cidrlist    *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Customid    *string   `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Endport     *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay  *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode    *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype    *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Id          *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Protocol    *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport   *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Traffictype *string   `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+func (p *UpdateIpv6FirewallRuleParams) toSerialized() *serializedUpdateIpv6FirewallRuleParams {
+	s := &serializedUpdateIpv6FirewallRuleParams{}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
 	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	return s
+}
+
+func (p *UpdateIpv6FirewallRuleParams) fromSerialized(s *serializedUpdateIpv6FirewallRuleParams) {
+	*p = UpdateIpv6FirewallRuleParams{}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateIpv6FirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateIpv6FirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateIpv6FirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateIpv6FirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateIpv6FirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateIpv6FirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateIpv6FirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewUpdateIpv6FirewallRuleParams(id string) *UpdateIpv6FirewallRuleParams {
 	p := &UpdateIpv6FirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates Ipv6 firewall rule with specified ID
-func (s *FirewallService) UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateIpv6FirewallRule", p.toURLValues())
+// Updates Ipv6 firewall rule with specified ID.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams, opts ...CallOption) (*UpdateIpv6FirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("updateIpv6FirewallRule", s.cs.encodeParams("updateIpv6FirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4865,8 +7220,8 @@ func (s *FirewallService) UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4915,53 +7270,133 @@ type UpdateIpv6FirewallRuleResponse struct {
 }
 
 type DeleteIpv6FirewallRuleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteIpv6FirewallRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteIpv6FirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteIpv6FirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteIpv6FirewallRuleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteIpv6FirewallRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteIpv6FirewallRuleParams(u url.Values) (*DeleteIpv6FirewallRuleParams, error) {
+	p := &DeleteIpv6FirewallRuleParams{}
+	if err := checkParamNames("deleteIpv6FirewallRule", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteIpv6FirewallRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteIpv6FirewallRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteIpv6FirewallRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteIpv6FirewallRuleParams) Clone() *DeleteIpv6FirewallRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteIpv6FirewallRuleParams) Equal(o *DeleteIpv6FirewallRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteIpv6FirewallRuleParams is used to (un)marshal DeleteIpv6FirewallRuleParams using the API param names
+type serializedDeleteIpv6FirewallRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteIpv6FirewallRuleParams) toSerialized() *serializedDeleteIpv6FirewallRuleParams {
+	s := &serializedDeleteIpv6FirewallRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteIpv6FirewallRuleParams) fromSerialized(s *serializedDeleteIpv6FirewallRuleParams) {
+	*p = DeleteIpv6FirewallRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteIpv6FirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteIpv6FirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteIpv6FirewallRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteIpv6FirewallRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteIpv6FirewallRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteIpv6FirewallRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteIpv6FirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewDeleteIpv6FirewallRuleParams(id string) *DeleteIpv6FirewallRuleParams {
 	p := &DeleteIpv6FirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a IPv6 firewall rule
-func (s *FirewallService) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteIpv6FirewallRule", p.toURLValues())
+// Deletes a IPv6 firewall rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *FirewallService) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams, opts ...CallOption) (*DeleteIpv6FirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest("deleteIpv6FirewallRule", s.cs.encodeParams("deleteIpv6FirewallRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4972,8 +7407,8 @@ func (s *FirewallService) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,168 +53,223 @@ func (m *MockFirewallServiceIface) EXPECT() *MockFirewallServiceIfaceMockRecorde
 }
 
 // AddPaloAltoFirewall mocks base method.
-func (m *MockFirewallServiceIface) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
+func (m *MockFirewallServiceIface) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams, opts ...CallOption) (*AddPaloAltoFirewallResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPaloAltoFirewall", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddPaloAltoFirewall", varargs...)
 	ret0, _ := ret[0].(*AddPaloAltoFirewallResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddPaloAltoFirewall indicates an expected call of AddPaloAltoFirewall.
-func (mr *MockFirewallServiceIfaceMockRecorder) AddPaloAltoFirewall(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) AddPaloAltoFirewall(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).AddPaloAltoFirewall), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).AddPaloAltoFirewall), varargs...)
 }
 
 // ConfigurePaloAltoFirewall mocks base method.
-func (m *MockFirewallServiceIface) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
+func (m *MockFirewallServiceIface) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams, opts ...CallOption) (*PaloAltoFirewallResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigurePaloAltoFirewall", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfigurePaloAltoFirewall", varargs...)
 	ret0, _ := ret[0].(*PaloAltoFirewallResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfigurePaloAltoFirewall indicates an expected call of ConfigurePaloAltoFirewall.
-func (mr *MockFirewallServiceIfaceMockRecorder) ConfigurePaloAltoFirewall(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) ConfigurePaloAltoFirewall(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigurePaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).ConfigurePaloAltoFirewall), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigurePaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).ConfigurePaloAltoFirewall), varargs...)
 }
 
 // CreateEgressFirewallRule mocks base method.
-func (m *MockFirewallServiceIface) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams, opts ...CallOption) (*CreateEgressFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEgressFirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEgressFirewallRule", varargs...)
 	ret0, _ := ret[0].(*CreateEgressFirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateEgressFirewallRule indicates an expected call of CreateEgressFirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) CreateEgressFirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateEgressFirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateEgressFirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateEgressFirewallRule), varargs...)
 }
 
 // CreateFirewallRule mocks base method.
-func (m *MockFirewallServiceIface) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) CreateFirewallRule(p *CreateFirewallRuleParams, opts ...CallOption) (*CreateFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFirewallRule", varargs...)
 	ret0, _ := ret[0].(*CreateFirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateFirewallRule indicates an expected call of CreateFirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) CreateFirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateFirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateFirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateFirewallRule), varargs...)
 }
 
 // CreateIpv6FirewallRule mocks base method.
-func (m *MockFirewallServiceIface) CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams, opts ...CallOption) (*CreateIpv6FirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateIpv6FirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateIpv6FirewallRule", varargs...)
 	ret0, _ := ret[0].(*CreateIpv6FirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateIpv6FirewallRule indicates an expected call of CreateIpv6FirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) CreateIpv6FirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateIpv6FirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateIpv6FirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateIpv6FirewallRule), varargs...)
 }
 
 // CreatePortForwardingRule mocks base method.
-func (m *MockFirewallServiceIface) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+func (m *MockFirewallServiceIface) CreatePortForwardingRule(p *CreatePortForwardingRuleParams, opts ...CallOption) (*CreatePortForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePortForwardingRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePortForwardingRule", varargs...)
 	ret0, _ := ret[0].(*CreatePortForwardingRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePortForwardingRule indicates an expected call of CreatePortForwardingRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) CreatePortForwardingRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) CreatePortForwardingRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreatePortForwardingRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreatePortForwardingRule), varargs...)
 }
 
 // DeleteEgressFirewallRule mocks base method.
-func (m *MockFirewallServiceIface) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams, opts ...CallOption) (*DeleteEgressFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEgressFirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEgressFirewallRule", varargs...)
 	ret0, _ := ret[0].(*DeleteEgressFirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteEgressFirewallRule indicates an expected call of DeleteEgressFirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeleteEgressFirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteEgressFirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteEgressFirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteEgressFirewallRule), varargs...)
 }
 
 // DeleteFirewallRule mocks base method.
-func (m *MockFirewallServiceIface) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) DeleteFirewallRule(p *DeleteFirewallRuleParams, opts ...CallOption) (*DeleteFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFirewallRule", varargs...)
 	ret0, _ := ret[0].(*DeleteFirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteFirewallRule indicates an expected call of DeleteFirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeleteFirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteFirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteFirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteFirewallRule), varargs...)
 }
 
 // DeleteIpv6FirewallRule mocks base method.
-func (m *MockFirewallServiceIface) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams, opts ...CallOption) (*DeleteIpv6FirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteIpv6FirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIpv6FirewallRule", varargs...)
 	ret0, _ := ret[0].(*DeleteIpv6FirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteIpv6FirewallRule indicates an expected call of DeleteIpv6FirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeleteIpv6FirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteIpv6FirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteIpv6FirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteIpv6FirewallRule), varargs...)
 }
 
 // DeletePaloAltoFirewall mocks base method.
-func (m *MockFirewallServiceIface) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
+func (m *MockFirewallServiceIface) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams, opts ...CallOption) (*DeletePaloAltoFirewallResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePaloAltoFirewall", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePaloAltoFirewall", varargs...)
 	ret0, _ := ret[0].(*DeletePaloAltoFirewallResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeletePaloAltoFirewall indicates an expected call of DeletePaloAltoFirewall.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeletePaloAltoFirewall(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePaloAltoFirewall(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePaloAltoFirewall), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePaloAltoFirewall), varargs...)
 }
 
 // DeletePortForwardingRule mocks base method.
-func (m *MockFirewallServiceIface) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+func (m *MockFirewallServiceIface) DeletePortForwardingRule(p *DeletePortForwardingRuleParams, opts ...CallOption) (*DeletePortForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePortForwardingRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePortForwardingRule", varargs...)
 	ret0, _ := ret[0].(*DeletePortForwardingRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeletePortForwardingRule indicates an expected call of DeletePortForwardingRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeletePortForwardingRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePortForwardingRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePortForwardingRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePortForwardingRule), varargs...)
 }
 
 // GetEgressFirewallRuleByID mocks base method.
@@ -302,78 +357,103 @@ func (mr *MockFirewallServiceIfaceMockRecorder) GetPortForwardingRuleByID(id int
 }
 
 // ListEgressFirewallRules mocks base method.
-func (m *MockFirewallServiceIface) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+func (m *MockFirewallServiceIface) ListEgressFirewallRules(p *ListEgressFirewallRulesParams, opts ...CallOption) (*ListEgressFirewallRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEgressFirewallRules", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEgressFirewallRules", varargs...)
 	ret0, _ := ret[0].(*ListEgressFirewallRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListEgressFirewallRules indicates an expected call of ListEgressFirewallRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRules(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRules(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRules), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRules), varargs...)
 }
 
 // ListFirewallRules mocks base method.
-func (m *MockFirewallServiceIface) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+func (m *MockFirewallServiceIface) ListFirewallRules(p *ListFirewallRulesParams, opts ...CallOption) (*ListFirewallRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListFirewallRules", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFirewallRules", varargs...)
 	ret0, _ := ret[0].(*ListFirewallRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListFirewallRules indicates an expected call of ListFirewallRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRules(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRules(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRules), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRules), varargs...)
 }
 
 // ListIpv6FirewallRules mocks base method.
-func (m *MockFirewallServiceIface) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error) {
+func (m *MockFirewallServiceIface) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams, opts ...CallOption) (*ListIpv6FirewallRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIpv6FirewallRules", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv6FirewallRules", varargs...)
 	ret0, _ := ret[0].(*ListIpv6FirewallRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListIpv6FirewallRules indicates an expected call of ListIpv6FirewallRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRules(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRules(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRules), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRules), varargs...)
 }
 
 // ListPaloAltoFirewalls mocks base method.
-func (m *MockFirewallServiceIface) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+func (m *MockFirewallServiceIface) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams, opts ...CallOption) (*ListPaloAltoFirewallsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPaloAltoFirewalls", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewalls", varargs...)
 	ret0, _ := ret[0].(*ListPaloAltoFirewallsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPaloAltoFirewalls indicates an expected call of ListPaloAltoFirewalls.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewalls(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewalls(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewalls", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewalls), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewalls", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewalls), varargs...)
 }
 
 // ListPortForwardingRules mocks base method.
-func (m *MockFirewallServiceIface) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+func (m *MockFirewallServiceIface) ListPortForwardingRules(p *ListPortForwardingRulesParams, opts ...CallOption) (*ListPortForwardingRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPortForwardingRules", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPortForwardingRules", varargs...)
 	ret0, _ := ret[0].(*ListPortForwardingRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPortForwardingRules indicates an expected call of ListPortForwardingRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRules(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRules(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRules), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRules), varargs...)
 }
 
 // NewAddPaloAltoFirewallParams mocks base method.
@@ -657,61 +737,81 @@ func (mr *MockFirewallServiceIfaceMockRecorder) NewUpdatePortForwardingRuleParam
 }
 
 // UpdateEgressFirewallRule mocks base method.
-func (m *MockFirewallServiceIface) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams, opts ...CallOption) (*UpdateEgressFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEgressFirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEgressFirewallRule", varargs...)
 	ret0, _ := ret[0].(*UpdateEgressFirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateEgressFirewallRule indicates an expected call of UpdateEgressFirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) UpdateEgressFirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateEgressFirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateEgressFirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateEgressFirewallRule), varargs...)
 }
 
 // UpdateFirewallRule mocks base method.
-func (m *MockFirewallServiceIface) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) UpdateFirewallRule(p *UpdateFirewallRuleParams, opts ...CallOption) (*UpdateFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFirewallRule", varargs...)
 	ret0, _ := ret[0].(*UpdateFirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateFirewallRule indicates an expected call of UpdateFirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) UpdateFirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateFirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateFirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateFirewallRule), varargs...)
 }
 
 // UpdateIpv6FirewallRule mocks base method.
-func (m *MockFirewallServiceIface) UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error) {
+func (m *MockFirewallServiceIface) UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams, opts ...CallOption) (*UpdateIpv6FirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIpv6FirewallRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateIpv6FirewallRule", varargs...)
 	ret0, _ := ret[0].(*UpdateIpv6FirewallRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIpv6FirewallRule indicates an expected call of UpdateIpv6FirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) UpdateIpv6FirewallRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateIpv6FirewallRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateIpv6FirewallRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateIpv6FirewallRule), varargs...)
 }
 
 // UpdatePortForwardingRule mocks base method.
-func (m *MockFirewallServiceIface) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+func (m *MockFirewallServiceIface) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams, opts ...CallOption) (*UpdatePortForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePortForwardingRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePortForwardingRule", varargs...)
 	ret0, _ := ret[0].(*UpdatePortForwardingRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdatePortForwardingRule indicates an expected call of UpdatePortForwardingRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) UpdatePortForwardingRule(p interface{}) *gomock.Call {
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdatePortForwardingRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdatePortForwardingRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdatePortForwardingRule), varargs...)
 }
@@ -28,183 +28,294 @@ import (
 )
 
 type GuestOSServiceIface interface {
-	AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOs(p *AddGuestOsParams, opts ...CallOption) (*AddGuestOsResponse, error)
 	NewAddGuestOsParams(oscategoryid string, osdisplayname string) *AddGuestOsParams
-	AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMapping(p *AddGuestOsMappingParams, opts ...CallOption) (*AddGuestOsMappingResponse, error)
 	NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams
-	ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMapping(p *ListGuestOsMappingParams, opts ...CallOption) (*ListGuestOsMappingResponse, error)
 	NewListGuestOsMappingParams() *ListGuestOsMappingParams
 	GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
-	ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsCategories(p *ListOsCategoriesParams, opts ...CallOption) (*ListOsCategoriesResponse, error)
 	NewListOsCategoriesParams() *ListOsCategoriesParams
 	GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error)
 	GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error)
 	GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error)
-	ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	ListOsTypes(p *ListOsTypesParams, opts ...CallOption) (*ListOsTypesResponse, error)
 	NewListOsTypesParams() *ListOsTypesParams
 	GetOsTypeID(keyword string, opts ...OptionFunc) (string, int, error)
 	GetOsTypeByName(name string, opts ...OptionFunc) (*OsType, int, error)
 	GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error)
-	RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOs(p *RemoveGuestOsParams, opts ...CallOption) (*RemoveGuestOsResponse, error)
 	NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
-	RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMapping(p *RemoveGuestOsMappingParams, opts ...CallOption) (*RemoveGuestOsMappingResponse, error)
 	NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams
-	UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOs(p *UpdateGuestOsParams, opts ...CallOption) (*UpdateGuestOsResponse, error)
 	NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams
-	UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMapping(p *UpdateGuestOsMappingParams, opts ...CallOption) (*UpdateGuestOsMappingResponse, error)
 	NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams
 }
 
 type AddGuestOsParams struct {
-	p map[string]interface{}
+	details       optStringMap
+	forDisplay    optBool
+	name          optString
+	oscategoryid  optString
+	osdisplayname optString
 }
 
-func (p *AddGuestOsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddGuestOsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["forDisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forDisplay", vv)
+	if p.forDisplay.ok {
+		u.Set("forDisplay", strconv.FormatBool(p.forDisplay.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["oscategoryid"]; found {
-		u.Set("oscategoryid", v.(string))
+	if p.oscategoryid.ok {
+		u.Set("oscategoryid", p.oscategoryid.v)
 	}
-	if v, found := p.p["osdisplayname"]; found {
-		u.Set("osdisplayname", v.(string))
+	if p.osdisplayname.ok {
+		u.Set("osdisplayname", p.osdisplayname.v)
 	}
 	return u
 }
 
-func (p *AddGuestOsParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddGuestOsParams parses url.Values, for example taken from a raw API request,
+// into a new AddGuestOsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddGuestOsParams(u url.Values) (*AddGuestOsParams, error) {
+	p := &AddGuestOsParams{}
+	if err := checkParamNames("addGuestOs", u, "details[]", "forDisplay", "name", "oscategoryid", "osdisplayname"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["forDisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("forDisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forDisplay: %v", err)
+		}
+		p.SetForDisplay(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["details"] = v
+	if _, found := u["oscategoryid"]; found {
+		p.SetOscategoryid(u.Get("oscategoryid"))
+	}
+	if _, found := u["osdisplayname"]; found {
+		p.SetOsdisplayname(u.Get("osdisplayname"))
+	}
+	return p, nil
+}
+
+// SetDetails sets the details param.
+func (p *AddGuestOsParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *AddGuestOsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *AddGuestOsParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetForDisplay sets the forDisplay param.
 func (p *AddGuestOsParams) SetForDisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forDisplay"] = v
+	p.forDisplay = optBool{v: v, ok: true}
 }
 
+// ResetForDisplay unsets the forDisplay param
 func (p *AddGuestOsParams) ResetForDisplay() {
-	if p.p != nil && p.p["forDisplay"] != nil {
-		delete(p.p, "forDisplay")
-	}
+	p.forDisplay = optBool{}
 }
 
+// GetForDisplay returns the forDisplay param and if it is set
 func (p *AddGuestOsParams) GetForDisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forDisplay"].(bool)
-	return value, ok
+	return p.forDisplay.v, p.forDisplay.ok
 }
 
+// SetName sets the name param.
 func (p *AddGuestOsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *AddGuestOsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *AddGuestOsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOscategoryid sets the oscategoryid param. This param is required.
 func (p *AddGuestOsParams) SetOscategoryid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["oscategoryid"] = v
+	p.oscategoryid = optString{v: v, ok: true}
 }
 
+// ResetOscategoryid unsets the oscategoryid param
 func (p *AddGuestOsParams) ResetOscategoryid() {
-	if p.p != nil && p.p["oscategoryid"] != nil {
-		delete(p.p, "oscategoryid")
-	}
+	p.oscategoryid = optString{}
 }
 
+// GetOscategoryid returns the oscategoryid param and if it is set
 func (p *AddGuestOsParams) GetOscategoryid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["oscategoryid"].(string)
-	return value, ok
+	return p.oscategoryid.v, p.oscategoryid.ok
 }
 
+// SetOsdisplayname sets the osdisplayname param. This param is required.
 func (p *AddGuestOsParams) SetOsdisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osdisplayname"] = v
+	p.osdisplayname = optString{v: v, ok: true}
 }
 
+// ResetOsdisplayname unsets the osdisplayname param
 func (p *AddGuestOsParams) ResetOsdisplayname() {
-	if p.p != nil && p.p["osdisplayname"] != nil {
-		delete(p.p, "osdisplayname")
-	}
+	p.osdisplayname = optString{}
 }
 
+// GetOsdisplayname returns the osdisplayname param and if it is set
 func (p *AddGuestOsParams) GetOsdisplayname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.osdisplayname.v, p.osdisplayname.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddGuestOsParams) Clone() *AddGuestOsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddGuestOsParams) Equal(o *AddGuestOsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.forDisplay == o.forDisplay &&
+		p.name == o.name &&
+		p.oscategoryid == o.oscategoryid &&
+		p.osdisplayname == o.osdisplayname
+}
+
+// serializedAddGuestOsParams is used to (un)marshal AddGuestOsParams using the API param names
+type serializedAddGuestOsParams struct {
+	Details       *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	ForDisplay    *bool              `json:"forDisplay,omitempty" yaml:"forDisplay,omitempty"`
+	Name          *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Oscategoryid  *string            `json:"oscategoryid,omitempty" yaml:"oscategoryid,omitempty"`
+	Osdisplayname *string            `json:"osdisplayname,omitempty" yaml:"osdisplayname,omitempty"`
+}
+
+func (p *AddGuestOsParams) toSerialized() *serializedAddGuestOsParams {
+	s := &serializedAddGuestOsParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.forDisplay.ok {
+		s.ForDisplay = &p.forDisplay.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.oscategoryid.ok {
+		s.Oscategoryid = &p.oscategoryid.v
+	}
+	if p.osdisplayname.ok {
+		s.Osdisplayname = &p.osdisplayname.v
+	}
+	return s
+}
+
+func (p *AddGuestOsParams) fromSerialized(s *serializedAddGuestOsParams) {
+	*p = AddGuestOsParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.ForDisplay != nil {
+		p.SetForDisplay(*s.ForDisplay)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Oscategoryid != nil {
+		p.SetOscategoryid(*s.Oscategoryid)
+	}
+	if s.Osdisplayname != nil {
+		p.SetOsdisplayname(*s.Osdisplayname)
 	}
-	value, ok := p.p["osdisplayname"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddGuestOsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddGuestOsParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddGuestOsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddGuestOsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddGuestOsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddGuestOsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddGuestOsParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewAddGuestOsParams(oscategoryid string, osdisplayname string) *AddGuestOsParams {
 	p := &AddGuestOsParams{}
-	p.p = make(map[string]interface{})
-	p.p["oscategoryid"] = oscategoryid
-	p.p["osdisplayname"] = osdisplayname
+	p.SetOscategoryid(oscategoryid)
+	p.SetOsdisplayname(osdisplayname)
 	return p
 }
 
-// Add a new guest OS type
-func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOs", p.toURLValues())
+// Add a new guest OS type.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: oscategoryid,
+// osdisplayname.
+func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams, opts ...CallOption) (*AddGuestOsResponse, error) {
+	resp, err := s.cs.newRequest("addGuestOs", s.cs.encodeParams("addGuestOs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -215,8 +326,8 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -250,201 +361,324 @@ type AddGuestOsResponse struct {
 }
 
 type AddGuestOsMappingParams struct {
-	p map[string]interface{}
+	forced                optBool
+	hypervisor            optString
+	hypervisorversion     optString
+	osdisplayname         optString
+	osmappingcheckenabled optBool
+	osnameforhypervisor   optString
+	ostypeid              optString
 }
 
-func (p *AddGuestOsMappingParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["hypervisorversion"]; found {
-		u.Set("hypervisorversion", v.(string))
+	if p.hypervisorversion.ok {
+		u.Set("hypervisorversion", p.hypervisorversion.v)
 	}
-	if v, found := p.p["osdisplayname"]; found {
-		u.Set("osdisplayname", v.(string))
+	if p.osdisplayname.ok {
+		u.Set("osdisplayname", p.osdisplayname.v)
 	}
-	if v, found := p.p["osmappingcheckenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("osmappingcheckenabled", vv)
+	if p.osmappingcheckenabled.ok {
+		u.Set("osmappingcheckenabled", strconv.FormatBool(p.osmappingcheckenabled.v))
 	}
-	if v, found := p.p["osnameforhypervisor"]; found {
-		u.Set("osnameforhypervisor", v.(string))
+	if p.osnameforhypervisor.ok {
+		u.Set("osnameforhypervisor", p.osnameforhypervisor.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
 	return u
 }
 
-func (p *AddGuestOsMappingParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddGuestOsMappingParams parses url.Values, for example taken from a raw API request,
+// into a new AddGuestOsMappingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddGuestOsMappingParams(u url.Values) (*AddGuestOsMappingParams, error) {
+	p := &AddGuestOsMappingParams{}
+	if err := checkParamNames("addGuestOsMapping", u, "forced", "hypervisor", "hypervisorversion", "osdisplayname", "osmappingcheckenabled", "osnameforhypervisor", "ostypeid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["hypervisorversion"]; found {
+		p.SetHypervisorversion(u.Get("hypervisorversion"))
+	}
+	if _, found := u["osdisplayname"]; found {
+		p.SetOsdisplayname(u.Get("osdisplayname"))
 	}
-	p.p["forced"] = v
+	if _, found := u["osmappingcheckenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("osmappingcheckenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param osmappingcheckenabled: %v", err)
+		}
+		p.SetOsmappingcheckenabled(v)
+	}
+	if _, found := u["osnameforhypervisor"]; found {
+		p.SetOsnameforhypervisor(u.Get("osnameforhypervisor"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *AddGuestOsMappingParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *AddGuestOsMappingParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *AddGuestOsMappingParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetHypervisor sets the hypervisor param. This param is required.
 func (p *AddGuestOsMappingParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *AddGuestOsMappingParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *AddGuestOsMappingParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetHypervisorversion sets the hypervisorversion param. This param is required.
 func (p *AddGuestOsMappingParams) SetHypervisorversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisorversion"] = v
+	p.hypervisorversion = optString{v: v, ok: true}
 }
 
+// ResetHypervisorversion unsets the hypervisorversion param
 func (p *AddGuestOsMappingParams) ResetHypervisorversion() {
-	if p.p != nil && p.p["hypervisorversion"] != nil {
-		delete(p.p, "hypervisorversion")
-	}
+	p.hypervisorversion = optString{}
 }
 
+// GetHypervisorversion returns the hypervisorversion param and if it is set
 func (p *AddGuestOsMappingParams) GetHypervisorversion() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisorversion"].(string)
-	return value, ok
+	return p.hypervisorversion.v, p.hypervisorversion.ok
 }
 
+// SetOsdisplayname sets the osdisplayname param.
 func (p *AddGuestOsMappingParams) SetOsdisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osdisplayname"] = v
+	p.osdisplayname = optString{v: v, ok: true}
 }
 
+// ResetOsdisplayname unsets the osdisplayname param
 func (p *AddGuestOsMappingParams) ResetOsdisplayname() {
-	if p.p != nil && p.p["osdisplayname"] != nil {
-		delete(p.p, "osdisplayname")
-	}
+	p.osdisplayname = optString{}
 }
 
+// GetOsdisplayname returns the osdisplayname param and if it is set
 func (p *AddGuestOsMappingParams) GetOsdisplayname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["osdisplayname"].(string)
-	return value, ok
+	return p.osdisplayname.v, p.osdisplayname.ok
 }
 
+// SetOsmappingcheckenabled sets the osmappingcheckenabled param.
 func (p *AddGuestOsMappingParams) SetOsmappingcheckenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osmappingcheckenabled"] = v
+	p.osmappingcheckenabled = optBool{v: v, ok: true}
 }
 
+// ResetOsmappingcheckenabled unsets the osmappingcheckenabled param
 func (p *AddGuestOsMappingParams) ResetOsmappingcheckenabled() {
-	if p.p != nil && p.p["osmappingcheckenabled"] != nil {
-		delete(p.p, "osmappingcheckenabled")
-	}
+	p.osmappingcheckenabled = optBool{}
 }
 
+// GetOsmappingcheckenabled returns the osmappingcheckenabled param and if it is set
 func (p *AddGuestOsMappingParams) GetOsmappingcheckenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["osmappingcheckenabled"].(bool)
-	return value, ok
+	return p.osmappingcheckenabled.v, p.osmappingcheckenabled.ok
 }
 
+// SetOsnameforhypervisor sets the osnameforhypervisor param. This param is required.
 func (p *AddGuestOsMappingParams) SetOsnameforhypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osnameforhypervisor"] = v
+	p.osnameforhypervisor = optString{v: v, ok: true}
 }
 
+// ResetOsnameforhypervisor unsets the osnameforhypervisor param
 func (p *AddGuestOsMappingParams) ResetOsnameforhypervisor() {
-	if p.p != nil && p.p["osnameforhypervisor"] != nil {
-		delete(p.p, "osnameforhypervisor")
-	}
+	p.osnameforhypervisor = optString{}
 }
 
+// GetOsnameforhypervisor returns the osnameforhypervisor param and if it is set
 func (p *AddGuestOsMappingParams) GetOsnameforhypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["osnameforhypervisor"].(string)
-	return value, ok
+	return p.osnameforhypervisor.v, p.osnameforhypervisor.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *AddGuestOsMappingParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *AddGuestOsMappingParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *AddGuestOsMappingParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ostypeid.v, p.ostypeid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddGuestOsMappingParams) Clone() *AddGuestOsMappingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddGuestOsMappingParams) Equal(o *AddGuestOsMappingParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.forced == o.forced &&
+		p.hypervisor == o.hypervisor &&
+		p.hypervisorversion == o.hypervisorversion &&
+		p.osdisplayname == o.osdisplayname &&
+		p.osmappingcheckenabled == o.osmappingcheckenabled &&
+		p.osnameforhypervisor == o.osnameforhypervisor &&
+		p.ostypeid == o.ostypeid
+}
+
+// serializedAddGuestOsMappingParams is used to (un)marshal AddGuestOsMappingParams using the API param names
+type serializedAddGuestOsMappingParams struct {
+	Forced                *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Hypervisor            *string `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Hypervisorversion     *string `json:"hypervisorversion,omitempty" yaml:"hypervisorversion,omitempty"`
+	Osdisplayname         *string `json:"osdisplayname,omitempty" yaml:"osdisplayname,omitempty"`
+	Osmappingcheckenabled *bool   `json:"osmappingcheckenabled,omitempty" yaml:"osmappingcheckenabled,omitempty"`
+	Osnameforhypervisor   *string `json:"osnameforhypervisor,omitempty" yaml:"osnameforhypervisor,omitempty"`
+	Ostypeid              *string `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+}
+
+func (p *AddGuestOsMappingParams) toSerialized() *serializedAddGuestOsMappingParams {
+	s := &serializedAddGuestOsMappingParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.hypervisorversion.ok {
+		s.Hypervisorversion = &p.hypervisorversion.v
+	}
+	if p.osdisplayname.ok {
+		s.Osdisplayname = &p.osdisplayname.v
+	}
+	if p.osmappingcheckenabled.ok {
+		s.Osmappingcheckenabled = &p.osmappingcheckenabled.v
+	}
+	if p.osnameforhypervisor.ok {
+		s.Osnameforhypervisor = &p.osnameforhypervisor.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	return s
+}
+
+func (p *AddGuestOsMappingParams) fromSerialized(s *serializedAddGuestOsMappingParams) {
+	*p = AddGuestOsMappingParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Hypervisorversion != nil {
+		p.SetHypervisorversion(*s.Hypervisorversion)
+	}
+	if s.Osdisplayname != nil {
+		p.SetOsdisplayname(*s.Osdisplayname)
+	}
+	if s.Osmappingcheckenabled != nil {
+		p.SetOsmappingcheckenabled(*s.Osmappingcheckenabled)
+	}
+	if s.Osnameforhypervisor != nil {
+		p.SetOsnameforhypervisor(*s.Osnameforhypervisor)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddGuestOsMappingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddGuestOsMappingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddGuestOsMappingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddGuestOsMappingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
 	p := &AddGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-	p.p["hypervisor"] = hypervisor
-	p.p["hypervisorversion"] = hypervisorversion
-	p.p["osnameforhypervisor"] = osnameforhypervisor
+	p.SetHypervisor(hypervisor)
+	p.SetHypervisorversion(hypervisorversion)
+	p.SetOsnameforhypervisor(osnameforhypervisor)
 	return p
 }
 
-// Adds a guest OS name to hypervisor OS name mapping
-func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOsMapping", p.toURLValues())
+// Adds a guest OS name to hypervisor OS name mapping.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hypervisor,
+// hypervisorversion, osnameforhypervisor.
+func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams, opts ...CallOption) (*AddGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequest("addGuestOsMapping", s.cs.encodeParams("addGuestOsMapping", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -455,8 +689,8 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -517,249 +751,379 @@ func (r *AddGuestOsMappingResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListGuestOsMappingParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListGuestOsMappingParams) toURLValues() url.Values {
+	hypervisor          optString
+	hypervisorversion   optString
+	id                  optString
+	keyword             optString
+	osdisplayname       optString
+	osnameforhypervisor optString
+	ostypeid            optString
+	page                optInt
+	pagesize            optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["hypervisorversion"]; found {
-		u.Set("hypervisorversion", v.(string))
+	if p.hypervisorversion.ok {
+		u.Set("hypervisorversion", p.hypervisorversion.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["osdisplayname"]; found {
-		u.Set("osdisplayname", v.(string))
+	if p.osdisplayname.ok {
+		u.Set("osdisplayname", p.osdisplayname.v)
 	}
-	if v, found := p.p["osnameforhypervisor"]; found {
-		u.Set("osnameforhypervisor", v.(string))
+	if p.osnameforhypervisor.ok {
+		u.Set("osnameforhypervisor", p.osnameforhypervisor.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListGuestOsMappingParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListGuestOsMappingParams parses url.Values, for example taken from a raw API request,
+// into a new ListGuestOsMappingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListGuestOsMappingParams(u url.Values) (*ListGuestOsMappingParams, error) {
+	p := &ListGuestOsMappingParams{}
+	if err := checkParamNames("listGuestOsMapping", u, "hypervisor", "hypervisorversion", "id", "keyword", "osdisplayname", "osnameforhypervisor", "ostypeid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["hypervisorversion"]; found {
+		p.SetHypervisorversion(u.Get("hypervisorversion"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["osdisplayname"]; found {
+		p.SetOsdisplayname(u.Get("osdisplayname"))
+	}
+	if _, found := u["osnameforhypervisor"]; found {
+		p.SetOsnameforhypervisor(u.Get("osnameforhypervisor"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["hypervisor"] = v
+	return p, nil
 }
 
+// SetHypervisor sets the hypervisor param.
+func (p *ListGuestOsMappingParams) SetHypervisor(v string) {
+	p.hypervisor = optString{v: v, ok: true}
+}
+
+// ResetHypervisor unsets the hypervisor param
 func (p *ListGuestOsMappingParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListGuestOsMappingParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetHypervisorversion sets the hypervisorversion param.
 func (p *ListGuestOsMappingParams) SetHypervisorversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisorversion"] = v
+	p.hypervisorversion = optString{v: v, ok: true}
 }
 
+// ResetHypervisorversion unsets the hypervisorversion param
 func (p *ListGuestOsMappingParams) ResetHypervisorversion() {
-	if p.p != nil && p.p["hypervisorversion"] != nil {
-		delete(p.p, "hypervisorversion")
-	}
+	p.hypervisorversion = optString{}
 }
 
+// GetHypervisorversion returns the hypervisorversion param and if it is set
 func (p *ListGuestOsMappingParams) GetHypervisorversion() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisorversion"].(string)
-	return value, ok
+	return p.hypervisorversion.v, p.hypervisorversion.ok
 }
 
+// SetId sets the id param.
 func (p *ListGuestOsMappingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListGuestOsMappingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListGuestOsMappingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListGuestOsMappingParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListGuestOsMappingParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListGuestOsMappingParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetOsdisplayname sets the osdisplayname param.
 func (p *ListGuestOsMappingParams) SetOsdisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osdisplayname"] = v
+	p.osdisplayname = optString{v: v, ok: true}
 }
 
+// ResetOsdisplayname unsets the osdisplayname param
 func (p *ListGuestOsMappingParams) ResetOsdisplayname() {
-	if p.p != nil && p.p["osdisplayname"] != nil {
-		delete(p.p, "osdisplayname")
-	}
+	p.osdisplayname = optString{}
 }
 
+// GetOsdisplayname returns the osdisplayname param and if it is set
 func (p *ListGuestOsMappingParams) GetOsdisplayname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["osdisplayname"].(string)
-	return value, ok
+	return p.osdisplayname.v, p.osdisplayname.ok
 }
 
+// SetOsnameforhypervisor sets the osnameforhypervisor param.
 func (p *ListGuestOsMappingParams) SetOsnameforhypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osnameforhypervisor"] = v
+	p.osnameforhypervisor = optString{v: v, ok: true}
 }
 
+// ResetOsnameforhypervisor unsets the osnameforhypervisor param
 func (p *ListGuestOsMappingParams) ResetOsnameforhypervisor() {
-	if p.p != nil && p.p["osnameforhypervisor"] != nil {
-		delete(p.p, "osnameforhypervisor")
-	}
+	p.osnameforhypervisor = optString{}
 }
 
+// GetOsnameforhypervisor returns the osnameforhypervisor param and if it is set
 func (p *ListGuestOsMappingParams) GetOsnameforhypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["osnameforhypervisor"].(string)
-	return value, ok
+	return p.osnameforhypervisor.v, p.osnameforhypervisor.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *ListGuestOsMappingParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *ListGuestOsMappingParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *ListGuestOsMappingParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListGuestOsMappingParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListGuestOsMappingParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListGuestOsMappingParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListGuestOsMappingParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListGuestOsMappingParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListGuestOsMappingParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListGuestOsMappingParams) Clone() *ListGuestOsMappingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListGuestOsMappingParams) Equal(o *ListGuestOsMappingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hypervisor == o.hypervisor &&
+		p.hypervisorversion == o.hypervisorversion &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.osdisplayname == o.osdisplayname &&
+		p.osnameforhypervisor == o.osnameforhypervisor &&
+		p.ostypeid == o.ostypeid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListGuestOsMappingParams is used to (un)marshal ListGuestOsMappingParams using the API param names
+type serializedListGuestOsMappingParams struct {
+	Hypervisor          *string `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Hypervisorversion   *string `json:"hypervisorversion,omitempty" yaml:"hypervisorversion,omitempty"`
+	Id                  *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword             *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Osdisplayname       *string `json:"osdisplayname,omitempty" yaml:"osdisplayname,omitempty"`
+	Osnameforhypervisor *string `json:"osnameforhypervisor,omitempty" yaml:"osnameforhypervisor,omitempty"`
+	Ostypeid            *string `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Page                *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize            *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListGuestOsMappingParams) toSerialized() *serializedListGuestOsMappingParams {
+	s := &serializedListGuestOsMappingParams{}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.hypervisorversion.ok {
+		s.Hypervisorversion = &p.hypervisorversion.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.osdisplayname.ok {
+		s.Osdisplayname = &p.osdisplayname.v
+	}
+	if p.osnameforhypervisor.ok {
+		s.Osnameforhypervisor = &p.osnameforhypervisor.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListGuestOsMappingParams) fromSerialized(s *serializedListGuestOsMappingParams) {
+	*p = ListGuestOsMappingParams{}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Hypervisorversion != nil {
+		p.SetHypervisorversion(*s.Hypervisorversion)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Osdisplayname != nil {
+		p.SetOsdisplayname(*s.Osdisplayname)
+	}
+	if s.Osnameforhypervisor != nil {
+		p.SetOsnameforhypervisor(*s.Osnameforhypervisor)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var s serializedListGuestOsMappingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListGuestOsMappingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListGuestOsMappingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListGuestOsMappingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams {
 	p := &ListGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
 	p := &ListGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -787,9 +1151,9 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 	return nil, l.Count, fmt.Errorf("There is more then one result for GuestOsMapping UUID: %s!", id)
 }
 
-// Lists all available OS mappings for given hypervisor
-func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("listGuestOsMapping", p.toURLValues())
+// Lists all available OS mappings for given hypervisor.
+func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams, opts ...CallOption) (*ListGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequest("listGuestOsMapping", s.cs.encodeParams("listGuestOsMapping", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -847,153 +1211,259 @@ func (r *GuestOsMapping) UnmarshalJSON(b []byte) error {
 }
 
 type ListOsCategoriesParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListOsCategoriesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListOsCategoriesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListOsCategoriesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListOsCategoriesParams parses url.Values, for example taken from a raw API request,
+// into a new ListOsCategoriesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListOsCategoriesParams(u url.Values) (*ListOsCategoriesParams, error) {
+	p := &ListOsCategoriesParams{}
+	if err := checkParamNames("listOsCategories", u, "id", "keyword", "name", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListOsCategoriesParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListOsCategoriesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListOsCategoriesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListOsCategoriesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListOsCategoriesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListOsCategoriesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListOsCategoriesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListOsCategoriesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListOsCategoriesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListOsCategoriesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListOsCategoriesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *ListOsCategoriesParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *ListOsCategoriesParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListOsCategoriesParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListOsCategoriesParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListOsCategoriesParams) Clone() *ListOsCategoriesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListOsCategoriesParams) Equal(o *ListOsCategoriesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListOsCategoriesParams is used to (un)marshal ListOsCategoriesParams using the API param names
+type serializedListOsCategoriesParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListOsCategoriesParams) toSerialized() *serializedListOsCategoriesParams {
+	s := &serializedListOsCategoriesParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListOsCategoriesParams) fromSerialized(s *serializedListOsCategoriesParams) {
+	*p = ListOsCategoriesParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
 	}
 }
 
-func (p *ListOsCategoriesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListOsCategoriesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListOsCategoriesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListOsCategoriesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListOsCategoriesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["pagesize"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListOsCategoriesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListOsCategoriesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListOsCategoriesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListOsCategoriesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListOsCategoriesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListOsCategoriesParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
 	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1041,9 +1511,8 @@ func (s *GuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error) {
 	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1072,8 +1541,8 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 }
 
 // Lists all supported OS categories for this cloud.
-func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
-	resp, err := s.cs.newRequest("listOsCategories", p.toURLValues())
+func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams, opts ...CallOption) (*ListOsCategoriesResponse, error) {
+	resp, err := s.cs.newRequest("listOsCategories", s.cs.encodeParams("listOsCategories", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1099,202 +1568,323 @@ type OsCategory struct {
 }
 
 type ListOsTypesParams struct {
-	p map[string]interface{}
+	description  optString
+	fordisplay   optBool
+	id           optString
+	keyword      optString
+	oscategoryid optString
+	page         optInt
+	pagesize     optInt
 }
 
-func (p *ListOsTypesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListOsTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["oscategoryid"]; found {
-		u.Set("oscategoryid", v.(string))
+	if p.oscategoryid.ok {
+		u.Set("oscategoryid", p.oscategoryid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListOsTypesParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListOsTypesParams parses url.Values, for example taken from a raw API request,
+// into a new ListOsTypesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListOsTypesParams(u url.Values) (*ListOsTypesParams, error) {
+	p := &ListOsTypesParams{}
+	if err := checkParamNames("listOsTypes", u, "description", "fordisplay", "id", "keyword", "oscategoryid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["oscategoryid"]; found {
+		p.SetOscategoryid(u.Get("oscategoryid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["description"] = v
+	return p, nil
+}
+
+// SetDescription sets the description param.
+func (p *ListOsTypesParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *ListOsTypesParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *ListOsTypesParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListOsTypesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListOsTypesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListOsTypesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListOsTypesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListOsTypesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListOsTypesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListOsTypesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListOsTypesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListOsTypesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetOscategoryid sets the oscategoryid param.
 func (p *ListOsTypesParams) SetOscategoryid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["oscategoryid"] = v
+	p.oscategoryid = optString{v: v, ok: true}
 }
 
+// ResetOscategoryid unsets the oscategoryid param
 func (p *ListOsTypesParams) ResetOscategoryid() {
-	if p.p != nil && p.p["oscategoryid"] != nil {
-		delete(p.p, "oscategoryid")
-	}
+	p.oscategoryid = optString{}
 }
 
+// GetOscategoryid returns the oscategoryid param and if it is set
 func (p *ListOsTypesParams) GetOscategoryid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["oscategoryid"].(string)
-	return value, ok
+	return p.oscategoryid.v, p.oscategoryid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListOsTypesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListOsTypesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListOsTypesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListOsTypesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListOsTypesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListOsTypesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListOsTypesParams) Clone() *ListOsTypesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListOsTypesParams) Equal(o *ListOsTypesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.oscategoryid == o.oscategoryid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListOsTypesParams is used to (un)marshal ListOsTypesParams using the API param names
+type serializedListOsTypesParams struct {
+	Description  *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay   *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Oscategoryid *string `json:"oscategoryid,omitempty" yaml:"oscategoryid,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListOsTypesParams) toSerialized() *serializedListOsTypesParams {
+	s := &serializedListOsTypesParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.oscategoryid.ok {
+		s.Oscategoryid = &p.oscategoryid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return s
+}
+
+func (p *ListOsTypesParams) fromSerialized(s *serializedListOsTypesParams) {
+	*p = ListOsTypesParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Oscategoryid != nil {
+		p.SetOscategoryid(*s.Oscategoryid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListOsTypesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListOsTypesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListOsTypesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListOsTypesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListOsTypesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListOsTypesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListOsTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
 	p := &ListOsTypesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsTypeID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListOsTypesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1342,9 +1932,8 @@ func (s *GuestOSService) GetOsTypeByName(name string, opts ...OptionFunc) (*OsTy
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
 	p := &ListOsTypesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1373,8 +1962,8 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 }
 
 // Lists all supported OS types for this cloud.
-func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
-	resp, err := s.cs.newRequest("listOsTypes", p.toURLValues())
+func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams, opts ...CallOption) (*ListOsTypesResponse, error) {
+	resp, err := s.cs.newRequest("listOsTypes", s.cs.encodeParams("listOsTypes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1405,53 +1994,133 @@ type OsType struct {
 }
 
 type RemoveGuestOsParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RemoveGuestOsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveGuestOsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RemoveGuestOsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveGuestOsParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveGuestOsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveGuestOsParams(u url.Values) (*RemoveGuestOsParams, error) {
+	p := &RemoveGuestOsParams{}
+	if err := checkParamNames("removeGuestOs", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RemoveGuestOsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RemoveGuestOsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveGuestOsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveGuestOsParams) Clone() *RemoveGuestOsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveGuestOsParams) Equal(o *RemoveGuestOsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRemoveGuestOsParams is used to (un)marshal RemoveGuestOsParams using the API param names
+type serializedRemoveGuestOsParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RemoveGuestOsParams) toSerialized() *serializedRemoveGuestOsParams {
+	s := &serializedRemoveGuestOsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RemoveGuestOsParams) fromSerialized(s *serializedRemoveGuestOsParams) {
+	*p = RemoveGuestOsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveGuestOsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveGuestOsParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveGuestOsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveGuestOsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveGuestOsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveGuestOsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveGuestOsParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams {
 	p := &RemoveGuestOsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Removes a Guest OS from listing.
-func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOs", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams, opts ...CallOption) (*RemoveGuestOsResponse, error) {
+	resp, err := s.cs.newRequest("removeGuestOs", s.cs.encodeParams("removeGuestOs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1462,8 +2131,8 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1487,53 +2156,133 @@ type RemoveGuestOsResponse struct {
 }
 
 type RemoveGuestOsMappingParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RemoveGuestOsMappingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveGuestOsMappingParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveGuestOsMappingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveGuestOsMappingParams(u url.Values) (*RemoveGuestOsMappingParams, error) {
+	p := &RemoveGuestOsMappingParams{}
+	if err := checkParamNames("removeGuestOsMapping", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RemoveGuestOsMappingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RemoveGuestOsMappingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveGuestOsMappingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveGuestOsMappingParams) Clone() *RemoveGuestOsMappingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveGuestOsMappingParams) Equal(o *RemoveGuestOsMappingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRemoveGuestOsMappingParams is used to (un)marshal RemoveGuestOsMappingParams using the API param names
+type serializedRemoveGuestOsMappingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RemoveGuestOsMappingParams) toSerialized() *serializedRemoveGuestOsMappingParams {
+	s := &serializedRemoveGuestOsMappingParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RemoveGuestOsMappingParams) fromSerialized(s *serializedRemoveGuestOsMappingParams) {
+	*p = RemoveGuestOsMappingParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveGuestOsMappingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveGuestOsMappingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveGuestOsMappingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveGuestOsMappingParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams {
 	p := &RemoveGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Removes a Guest OS Mapping.
-func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOsMapping", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams, opts ...CallOption) (*RemoveGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequest("removeGuestOsMapping", s.cs.encodeParams("removeGuestOsMapping", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1544,8 +2293,8 @@ func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*R
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1569,131 +2318,235 @@ type RemoveGuestOsMappingResponse struct {
 }
 
 type UpdateGuestOsParams struct {
-	p map[string]interface{}
+	details       optStringMap
+	forDisplay    optBool
+	id            optString
+	osdisplayname optString
 }
 
-func (p *UpdateGuestOsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateGuestOsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["forDisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forDisplay", vv)
+	if p.forDisplay.ok {
+		u.Set("forDisplay", strconv.FormatBool(p.forDisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["osdisplayname"]; found {
-		u.Set("osdisplayname", v.(string))
+	if p.osdisplayname.ok {
+		u.Set("osdisplayname", p.osdisplayname.v)
 	}
 	return u
 }
 
-func (p *UpdateGuestOsParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateGuestOsParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateGuestOsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateGuestOsParams(u url.Values) (*UpdateGuestOsParams, error) {
+	p := &UpdateGuestOsParams{}
+	if err := checkParamNames("updateGuestOs", u, "details[]", "forDisplay", "id", "osdisplayname"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["forDisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("forDisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forDisplay: %v", err)
+		}
+		p.SetForDisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["details"] = v
+	if _, found := u["osdisplayname"]; found {
+		p.SetOsdisplayname(u.Get("osdisplayname"))
+	}
+	return p, nil
+}
+
+// SetDetails sets the details param.
+func (p *UpdateGuestOsParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *UpdateGuestOsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *UpdateGuestOsParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetForDisplay sets the forDisplay param.
 func (p *UpdateGuestOsParams) SetForDisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forDisplay"] = v
+	p.forDisplay = optBool{v: v, ok: true}
 }
 
+// ResetForDisplay unsets the forDisplay param
 func (p *UpdateGuestOsParams) ResetForDisplay() {
-	if p.p != nil && p.p["forDisplay"] != nil {
-		delete(p.p, "forDisplay")
-	}
+	p.forDisplay = optBool{}
 }
 
+// GetForDisplay returns the forDisplay param and if it is set
 func (p *UpdateGuestOsParams) GetForDisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forDisplay"].(bool)
-	return value, ok
+	return p.forDisplay.v, p.forDisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateGuestOsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateGuestOsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateGuestOsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetOsdisplayname sets the osdisplayname param. This param is required.
 func (p *UpdateGuestOsParams) SetOsdisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osdisplayname"] = v
+	p.osdisplayname = optString{v: v, ok: true}
 }
 
+// ResetOsdisplayname unsets the osdisplayname param
 func (p *UpdateGuestOsParams) ResetOsdisplayname() {
-	if p.p != nil && p.p["osdisplayname"] != nil {
-		delete(p.p, "osdisplayname")
-	}
+	p.osdisplayname = optString{}
 }
 
+// GetOsdisplayname returns the osdisplayname param and if it is set
 func (p *UpdateGuestOsParams) GetOsdisplayname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.osdisplayname.v, p.osdisplayname.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateGuestOsParams) Clone() *UpdateGuestOsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateGuestOsParams) Equal(o *UpdateGuestOsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.forDisplay == o.forDisplay &&
+		p.id == o.id &&
+		p.osdisplayname == o.osdisplayname
+}
+
+// serializedUpdateGuestOsParams is used to (un)marshal UpdateGuestOsParams using the API param names
+type serializedUpdateGuestOsParams struct {
+	Details       *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	ForDisplay    *bool              `json:"forDisplay,omitempty" yaml:"forDisplay,omitempty"`
+	Id            *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Osdisplayname *string            `json:"osdisplayname,omitempty" yaml:"osdisplayname,omitempty"`
+}
+
+func (p *UpdateGuestOsParams) toSerialized() *serializedUpdateGuestOsParams {
+	s := &serializedUpdateGuestOsParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.forDisplay.ok {
+		s.ForDisplay = &p.forDisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.osdisplayname.ok {
+		s.Osdisplayname = &p.osdisplayname.v
+	}
+	return s
+}
+
+func (p *UpdateGuestOsParams) fromSerialized(s *serializedUpdateGuestOsParams) {
+	*p = UpdateGuestOsParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
 	}
-	value, ok := p.p["osdisplayname"].(string)
-	return value, ok
+	if s.ForDisplay != nil {
+		p.SetForDisplay(*s.ForDisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Osdisplayname != nil {
+		p.SetOsdisplayname(*s.Osdisplayname)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateGuestOsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateGuestOsParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateGuestOsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateGuestOsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateGuestOsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateGuestOsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateGuestOsParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams {
 	p := &UpdateGuestOsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["osdisplayname"] = osdisplayname
+	p.SetId(id)
+	p.SetOsdisplayname(osdisplayname)
 	return p
 }
 
-// Updates the information about Guest OS
-func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOs", p.toURLValues())
+// Updates the information about Guest OS.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, osdisplayname.
+func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams, opts ...CallOption) (*UpdateGuestOsResponse, error) {
+	resp, err := s.cs.newRequest("updateGuestOs", s.cs.encodeParams("updateGuestOs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1704,8 +2557,8 @@ func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1739,103 +2592,198 @@ type UpdateGuestOsResponse struct {
 }
 
 type UpdateGuestOsMappingParams struct {
-	p map[string]interface{}
+	id                    optString
+	osmappingcheckenabled optBool
+	osnameforhypervisor   optString
 }
 
-func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["osmappingcheckenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("osmappingcheckenabled", vv)
+	if p.osmappingcheckenabled.ok {
+		u.Set("osmappingcheckenabled", strconv.FormatBool(p.osmappingcheckenabled.v))
 	}
-	if v, found := p.p["osnameforhypervisor"]; found {
-		u.Set("osnameforhypervisor", v.(string))
+	if p.osnameforhypervisor.ok {
+		u.Set("osnameforhypervisor", p.osnameforhypervisor.v)
 	}
 	return u
 }
 
-func (p *UpdateGuestOsMappingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateGuestOsMappingParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateGuestOsMappingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateGuestOsMappingParams(u url.Values) (*UpdateGuestOsMappingParams, error) {
+	p := &UpdateGuestOsMappingParams{}
+	if err := checkParamNames("updateGuestOsMapping", u, "id", "osmappingcheckenabled", "osnameforhypervisor"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["osmappingcheckenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("osmappingcheckenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param osmappingcheckenabled: %v", err)
+		}
+		p.SetOsmappingcheckenabled(v)
+	}
+	if _, found := u["osnameforhypervisor"]; found {
+		p.SetOsnameforhypervisor(u.Get("osnameforhypervisor"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *UpdateGuestOsMappingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *UpdateGuestOsMappingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateGuestOsMappingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetOsmappingcheckenabled sets the osmappingcheckenabled param.
 func (p *UpdateGuestOsMappingParams) SetOsmappingcheckenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osmappingcheckenabled"] = v
+	p.osmappingcheckenabled = optBool{v: v, ok: true}
 }
 
+// ResetOsmappingcheckenabled unsets the osmappingcheckenabled param
 func (p *UpdateGuestOsMappingParams) ResetOsmappingcheckenabled() {
-	if p.p != nil && p.p["osmappingcheckenabled"] != nil {
-		delete(p.p, "osmappingcheckenabled")
-	}
+	p.osmappingcheckenabled = optBool{}
 }
 
+// GetOsmappingcheckenabled returns the osmappingcheckenabled param and if it is set
 func (p *UpdateGuestOsMappingParams) GetOsmappingcheckenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["osmappingcheckenabled"].(bool)
-	return value, ok
+	return p.osmappingcheckenabled.v, p.osmappingcheckenabled.ok
 }
 
+// SetOsnameforhypervisor sets the osnameforhypervisor param. This param is required.
 func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osnameforhypervisor"] = v
+	p.osnameforhypervisor = optString{v: v, ok: true}
 }
 
+// ResetOsnameforhypervisor unsets the osnameforhypervisor param
 func (p *UpdateGuestOsMappingParams) ResetOsnameforhypervisor() {
-	if p.p != nil && p.p["osnameforhypervisor"] != nil {
-		delete(p.p, "osnameforhypervisor")
-	}
+	p.osnameforhypervisor = optString{}
 }
 
+// GetOsnameforhypervisor returns the osnameforhypervisor param and if it is set
 func (p *UpdateGuestOsMappingParams) GetOsnameforhypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.osnameforhypervisor.v, p.osnameforhypervisor.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateGuestOsMappingParams) Clone() *UpdateGuestOsMappingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateGuestOsMappingParams) Equal(o *UpdateGuestOsMappingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.osmappingcheckenabled == o.osmappingcheckenabled &&
+		p.osnameforhypervisor == o.osnameforhypervisor
+}
+
+// serializedUpdateGuestOsMappingParams is used to (un)marshal UpdateGuestOsMappingParams using the API param names
+type serializedUpdateGuestOsMappingParams struct {
+	Id                    *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Osmappingcheckenabled *bool   `json:"osmappingcheckenabled,omitempty" yaml:"osmappingcheckenabled,omitempty"`
+	Osnameforhypervisor   *string `json:"osnameforhypervisor,omitempty" yaml:"osnameforhypervisor,omitempty"`
+}
+
+func (p *UpdateGuestOsMappingParams) toSerialized() *serializedUpdateGuestOsMappingParams {
+	s := &serializedUpdateGuestOsMappingParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.osmappingcheckenabled.ok {
+		s.Osmappingcheckenabled = &p.osmappingcheckenabled.v
+	}
+	if p.osnameforhypervisor.ok {
+		s.Osnameforhypervisor = &p.osnameforhypervisor.v
+	}
+	return s
+}
+
+func (p *UpdateGuestOsMappingParams) fromSerialized(s *serializedUpdateGuestOsMappingParams) {
+	*p = UpdateGuestOsMappingParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Osmappingcheckenabled != nil {
+		p.SetOsmappingcheckenabled(*s.Osmappingcheckenabled)
+	}
+	if s.Osnameforhypervisor != nil {
+		p.SetOsnameforhypervisor(*s.Osnameforhypervisor)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateGuestOsMappingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateGuestOsMappingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateGuestOsMappingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateGuestOsMappingParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["osnameforhypervisor"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams {
 	p := &UpdateGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["osnameforhypervisor"] = osnameforhypervisor
+	p.SetId(id)
+	p.SetOsnameforhypervisor(osnameforhypervisor)
 	return p
 }
 
-// Updates the information about Guest OS to Hypervisor specific name mapping
-func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOsMapping", p.toURLValues())
+// Updates the information about Guest OS to Hypervisor specific name mapping.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, osnameforhypervisor.
+func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams, opts ...CallOption) (*UpdateGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequest("updateGuestOsMapping", s.cs.encodeParams("updateGuestOsMapping", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1846,8 +2794,8 @@ func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*U
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,33 +53,43 @@ func (m *MockGuestOSServiceIface) EXPECT() *MockGuestOSServiceIfaceMockRecorder
 }
 
 // AddGuestOs mocks base method.
-func (m *MockGuestOSServiceIface) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
+func (m *MockGuestOSServiceIface) AddGuestOs(p *AddGuestOsParams, opts ...CallOption) (*AddGuestOsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddGuestOs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGuestOs", varargs...)
 	ret0, _ := ret[0].(*AddGuestOsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddGuestOs indicates an expected call of AddGuestOs.
-func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOs(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOs), varargs...)
 }
 
 // AddGuestOsMapping mocks base method.
-func (m *MockGuestOSServiceIface) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
+func (m *MockGuestOSServiceIface) AddGuestOsMapping(p *AddGuestOsMappingParams, opts ...CallOption) (*AddGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddGuestOsMapping", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGuestOsMapping", varargs...)
 	ret0, _ := ret[0].(*AddGuestOsMappingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddGuestOsMapping indicates an expected call of AddGuestOsMapping.
-func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsMapping(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsMapping(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsMapping), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsMapping), varargs...)
 }
 
 // GetGuestOsMappingByID mocks base method.
@@ -230,48 +240,63 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeID(keyword interface{},
 }
 
 // ListGuestOsMapping mocks base method.
-func (m *MockGuestOSServiceIface) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+func (m *MockGuestOSServiceIface) ListGuestOsMapping(p *ListGuestOsMappingParams, opts ...CallOption) (*ListGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListGuestOsMapping", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestOsMapping", varargs...)
 	ret0, _ := ret[0].(*ListGuestOsMappingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListGuestOsMapping indicates an expected call of ListGuestOsMapping.
-func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMapping(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMapping(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMapping), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMapping), varargs...)
 }
 
 // ListOsCategories mocks base method.
-func (m *MockGuestOSServiceIface) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+func (m *MockGuestOSServiceIface) ListOsCategories(p *ListOsCategoriesParams, opts ...CallOption) (*ListOsCategoriesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOsCategories", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsCategories", varargs...)
 	ret0, _ := ret[0].(*ListOsCategoriesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListOsCategories indicates an expected call of ListOsCategories.
-func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategories(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategories(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategories", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategories), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategories", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategories), varargs...)
 }
 
 // ListOsTypes mocks base method.
-func (m *MockGuestOSServiceIface) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+func (m *MockGuestOSServiceIface) ListOsTypes(p *ListOsTypesParams, opts ...CallOption) (*ListOsTypesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOsTypes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsTypes", varargs...)
 	ret0, _ := ret[0].(*ListOsTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListOsTypes indicates an expected call of ListOsTypes.
-func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypes(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypes", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypes", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypes), varargs...)
 }
 
 // NewAddGuestOsMappingParams mocks base method.
@@ -401,61 +426,81 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) NewUpdateGuestOsParams(id, osdisp
 }
 
 // RemoveGuestOs mocks base method.
-func (m *MockGuestOSServiceIface) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+func (m *MockGuestOSServiceIface) RemoveGuestOs(p *RemoveGuestOsParams, opts ...CallOption) (*RemoveGuestOsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveGuestOs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveGuestOs", varargs...)
 	ret0, _ := ret[0].(*RemoveGuestOsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveGuestOs indicates an expected call of RemoveGuestOs.
-func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOs(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOs), varargs...)
 }
 
 // RemoveGuestOsMapping mocks base method.
-func (m *MockGuestOSServiceIface) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+func (m *MockGuestOSServiceIface) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams, opts ...CallOption) (*RemoveGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveGuestOsMapping", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveGuestOsMapping", varargs...)
 	ret0, _ := ret[0].(*RemoveGuestOsMappingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveGuestOsMapping indicates an expected call of RemoveGuestOsMapping.
-func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsMapping(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsMapping(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsMapping), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsMapping), varargs...)
 }
 
 // UpdateGuestOs mocks base method.
-func (m *MockGuestOSServiceIface) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+func (m *MockGuestOSServiceIface) UpdateGuestOs(p *UpdateGuestOsParams, opts ...CallOption) (*UpdateGuestOsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGuestOs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGuestOs", varargs...)
 	ret0, _ := ret[0].(*UpdateGuestOsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateGuestOs indicates an expected call of UpdateGuestOs.
-func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOs(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOs), varargs...)
 }
 
 // UpdateGuestOsMapping mocks base method.
-func (m *MockGuestOSServiceIface) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+func (m *MockGuestOSServiceIface) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams, opts ...CallOption) (*UpdateGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGuestOsMapping", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGuestOsMapping", varargs...)
 	ret0, _ := ret[0].(*UpdateGuestOsMappingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateGuestOsMapping indicates an expected call of UpdateGuestOsMapping.
-func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsMapping(p interface{}) *gomock.Call {
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsMapping(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsMapping), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsMapping), varargs...)
 }
@@ -28,351 +28,490 @@ import (
 )
 
 type HostServiceIface interface {
-	AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error)
+	AddBaremetalHost(p *AddBaremetalHostParams, opts ...CallOption) (*AddBaremetalHostResponse, error)
 	NewAddBaremetalHostParams(hypervisor string, podid string, url string, zoneid string) *AddBaremetalHostParams
-	AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHost(p *AddGloboDnsHostParams, opts ...CallOption) (*AddGloboDnsHostResponse, error)
 	NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) *AddGloboDnsHostParams
-	AddHost(p *AddHostParams) (*AddHostResponse, error)
+	AddHost(p *AddHostParams, opts ...CallOption) (*AddHostResponse, error)
 	NewAddHostParams(hypervisor string, podid string, url string, zoneid string) *AddHostParams
-	AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+	AddSecondaryStorage(p *AddSecondaryStorageParams, opts ...CallOption) (*AddSecondaryStorageResponse, error)
 	NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams
-	CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenance(p *CancelHostMaintenanceParams, opts ...CallOption) (*CancelHostMaintenanceResponse, error)
 	NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams
-	ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error)
+	ConfigureHAForHost(p *ConfigureHAForHostParams, opts ...CallOption) (*HAForHostResponse, error)
 	NewConfigureHAForHostParams(hostid string, provider string) *ConfigureHAForHostParams
-	EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error)
+	EnableHAForHost(p *EnableHAForHostParams, opts ...CallOption) (*EnableHAForHostResponse, error)
 	NewEnableHAForHostParams(hostid string) *EnableHAForHostParams
-	DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHost(p *DedicateHostParams, opts ...CallOption) (*DedicateHostResponse, error)
 	NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams
-	DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error)
+	DeleteHost(p *DeleteHostParams, opts ...CallOption) (*DeleteHostResponse, error)
 	NewDeleteHostParams(id string) *DeleteHostParams
-	DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams, opts ...CallOption) (*DisableOutOfBandManagementForHostResponse, error)
 	NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams
-	EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams, opts ...CallOption) (*EnableOutOfBandManagementForHostResponse, error)
 	NewEnableOutOfBandManagementForHostParams(hostid string) *EnableOutOfBandManagementForHostParams
-	FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	FindHostsForMigration(p *FindHostsForMigrationParams, opts ...CallOption) (*FindHostsForMigrationResponse, error)
 	NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams
-	ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHosts(p *ListDedicatedHostsParams, opts ...CallOption) (*ListDedicatedHostsResponse, error)
 	NewListDedicatedHostsParams() *ListDedicatedHostsParams
-	ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHostTags(p *ListHostTagsParams, opts ...CallOption) (*ListHostTagsResponse, error)
 	NewListHostTagsParams() *ListHostTagsParams
 	GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error)
-	ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
+	ListHosts(p *ListHostsParams, opts ...CallOption) (*ListHostsResponse, error)
 	NewListHostsParams() *ListHostsParams
 	GetHostID(name string, opts ...OptionFunc) (string, int, error)
 	GetHostByName(name string, opts ...OptionFunc) (*Host, int, error)
 	GetHostByID(id string, opts ...OptionFunc) (*Host, int, error)
-	ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	ListHostsMetrics(p *ListHostsMetricsParams, opts ...CallOption) (*ListHostsMetricsResponse, error)
 	NewListHostsMetricsParams() *ListHostsMetricsParams
 	GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error)
 	GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error)
 	GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error)
-	PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams, opts ...CallOption) (*PrepareHostForMaintenanceResponse, error)
 	NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams
-	ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHost(p *ReconnectHostParams, opts ...CallOption) (*ReconnectHostResponse, error)
 	NewReconnectHostParams(id string) *ReconnectHostParams
-	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams, opts ...CallOption) (*ReleaseDedicatedHostResponse, error)
 	NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams
-	ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservation(p *ReleaseHostReservationParams, opts ...CallOption) (*ReleaseHostReservationResponse, error)
 	NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams
-	UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error)
+	UpdateHost(p *UpdateHostParams, opts ...CallOption) (*UpdateHostResponse, error)
 	NewUpdateHostParams(id string) *UpdateHostParams
-	UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+	UpdateHostPassword(p *UpdateHostPasswordParams, opts ...CallOption) (*UpdateHostPasswordResponse, error)
 	NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams
 }
 
 type AddBaremetalHostParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddBaremetalHostParams) toURLValues() url.Values {
+	allocationstate optString
+	clusterid       optString
+	clustername     optString
+	hosttags        optStrings
+	hypervisor      optString
+	ipaddress       optString
+	password        optString
+	podid           optString
+	url             optString
+	username        optString
+	zoneid          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddBaremetalHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
+	if p.clustername.ok {
+		u.Set("clustername", p.clustername.v)
 	}
-	if v, found := p.p["hosttags"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("hosttags", vv)
+	if p.hosttags.ok {
+		u.Set("hosttags", strings.Join(p.hosttags.v, ","))
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddBaremetalHostParams parses url.Values, for example taken from a raw API request,
+// into a new AddBaremetalHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddBaremetalHostParams(u url.Values) (*AddBaremetalHostParams, error) {
+	p := &AddBaremetalHostParams{}
+	if err := checkParamNames("addBaremetalHost", u, "allocationstate", "clusterid", "clustername", "hosttags", "hypervisor", "ipaddress", "password", "podid", "url", "username", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["clustername"]; found {
+		p.SetClustername(u.Get("clustername"))
+	}
+	if _, found := u["hosttags"]; found {
+		p.SetHosttags(strings.Split(u.Get("hosttags"), ","))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["allocationstate"] = v
+	return p, nil
 }
 
+// SetAllocationstate sets the allocationstate param.
+func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
+}
+
+// ResetAllocationstate unsets the allocationstate param
 func (p *AddBaremetalHostParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *AddBaremetalHostParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *AddBaremetalHostParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *AddBaremetalHostParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *AddBaremetalHostParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetClustername sets the clustername param.
 func (p *AddBaremetalHostParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustername"] = v
+	p.clustername = optString{v: v, ok: true}
 }
 
+// ResetClustername unsets the clustername param
 func (p *AddBaremetalHostParams) ResetClustername() {
-	if p.p != nil && p.p["clustername"] != nil {
-		delete(p.p, "clustername")
-	}
+	p.clustername = optString{}
 }
 
+// GetClustername returns the clustername param and if it is set
 func (p *AddBaremetalHostParams) GetClustername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustername"].(string)
-	return value, ok
+	return p.clustername.v, p.clustername.ok
 }
 
+// SetHosttags sets the hosttags param.
 func (p *AddBaremetalHostParams) SetHosttags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hosttags"] = v
+	p.hosttags = optStrings{v: v, ok: true}
 }
 
+// ResetHosttags unsets the hosttags param
 func (p *AddBaremetalHostParams) ResetHosttags() {
-	if p.p != nil && p.p["hosttags"] != nil {
-		delete(p.p, "hosttags")
-	}
+	p.hosttags = optStrings{}
 }
 
+// GetHosttags returns the hosttags param and if it is set
 func (p *AddBaremetalHostParams) GetHosttags() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hosttags"].([]string)
-	return value, ok
+	return p.hosttags.v, p.hosttags.ok
 }
 
+// SetHypervisor sets the hypervisor param. This param is required.
 func (p *AddBaremetalHostParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *AddBaremetalHostParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *AddBaremetalHostParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *AddBaremetalHostParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *AddBaremetalHostParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *AddBaremetalHostParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetPassword sets the password param.
 func (p *AddBaremetalHostParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddBaremetalHostParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddBaremetalHostParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPodid sets the podid param. This param is required.
 func (p *AddBaremetalHostParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *AddBaremetalHostParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *AddBaremetalHostParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddBaremetalHostParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddBaremetalHostParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddBaremetalHostParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param.
 func (p *AddBaremetalHostParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddBaremetalHostParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddBaremetalHostParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.username.v, p.username.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *AddBaremetalHostParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddBaremetalHostParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddBaremetalHostParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddBaremetalHostParams) Clone() *AddBaremetalHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.hosttags = p.hosttags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddBaremetalHostParams) Equal(o *AddBaremetalHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.clusterid == o.clusterid &&
+		p.clustername == o.clustername &&
+		p.hosttags.equal(o.hosttags) &&
+		p.hypervisor == o.hypervisor &&
+		p.ipaddress == o.ipaddress &&
+		p.password == o.password &&
+		p.podid == o.podid &&
+		p.url == o.url &&
+		p.username == o.username &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddBaremetalHostParams is used to (un)marshal AddBaremetalHostParams using the API param names
+type serializedAddBaremetalHostParams struct {
+	Allocationstate *string   `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clusterid       *string   `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Clustername     *string   `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Hosttags        *[]string `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Hypervisor      *string   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Ipaddress       *string   `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Password        *string   `json:"password,omitempty" yaml:"password,omitempty"`
+	Podid           *string   `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Url             *string   `json:"url,omitempty" yaml:"url,omitempty"`
+	Username        *string   `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid          *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddBaremetalHostParams) toSerialized() *serializedAddBaremetalHostParams {
+	s := &serializedAddBaremetalHostParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.clustername.ok {
+		s.Clustername = &p.clustername.v
+	}
+	if p.hosttags.ok {
+		s.Hosttags = &p.hosttags.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddBaremetalHostParams) fromSerialized(s *serializedAddBaremetalHostParams) {
+	*p = AddBaremetalHostParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Clustername != nil {
+		p.SetClustername(*s.Clustername)
+	}
+	if s.Hosttags != nil {
+		p.SetHosttags(*s.Hosttags)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddBaremetalHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddBaremetalHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddBaremetalHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddBaremetalHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddBaremetalHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddBaremetalHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddBaremetalHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewAddBaremetalHostParams(hypervisor string, podid string, url string, zoneid string) *AddBaremetalHostParams {
 	p := &AddBaremetalHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hypervisor"] = hypervisor
-	p.p["podid"] = podid
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetHypervisor(hypervisor)
+	p.SetPodid(podid)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// add a baremetal host
-func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalHost", p.toURLValues())
+// add a baremetal host.
+//
+// Required params: hypervisor, podid, url, zoneid.
+func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams, opts ...CallOption) (*AddBaremetalHostResponse, error) {
+	resp, err := s.cs.newRequest("addBaremetalHost", s.cs.encodeParams("addBaremetalHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -468,128 +607,227 @@ type AddBaremetalHostResponseGpugroupVgpu struct {
 }
 
 type AddGloboDnsHostParams struct {
-	p map[string]interface{}
+	password          optString
+	physicalnetworkid optString
+	url               optString
+	username          optString
 }
 
-func (p *AddGloboDnsHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddGloboDnsHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddGloboDnsHostParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddGloboDnsHostParams parses url.Values, for example taken from a raw API request,
+// into a new AddGloboDnsHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddGloboDnsHostParams(u url.Values) (*AddGloboDnsHostParams, error) {
+	p := &AddGloboDnsHostParams{}
+	if err := checkParamNames("addGloboDnsHost", u, "password", "physicalnetworkid", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
 	}
-	p.p["password"] = v
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetPassword sets the password param. This param is required.
+func (p *AddGloboDnsHostParams) SetPassword(v string) {
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddGloboDnsHostParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddGloboDnsHostParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddGloboDnsHostParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddGloboDnsHostParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddGloboDnsHostParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddGloboDnsHostParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddGloboDnsHostParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddGloboDnsHostParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddGloboDnsHostParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddGloboDnsHostParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddGloboDnsHostParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddGloboDnsHostParams) Clone() *AddGloboDnsHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddGloboDnsHostParams) Equal(o *AddGloboDnsHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddGloboDnsHostParams is used to (un)marshal AddGloboDnsHostParams using the API param names
+type serializedAddGloboDnsHostParams struct {
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddGloboDnsHostParams) toSerialized() *serializedAddGloboDnsHostParams {
+	s := &serializedAddGloboDnsHostParams{}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddGloboDnsHostParams) fromSerialized(s *serializedAddGloboDnsHostParams) {
+	*p = AddGloboDnsHostParams{}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddGloboDnsHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddGloboDnsHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddGloboDnsHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddGloboDnsHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddGloboDnsHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddGloboDnsHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddGloboDnsHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) *AddGloboDnsHostParams {
 	p := &AddGloboDnsHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds the GloboDNS external host
-func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
-	resp, err := s.cs.newRequest("addGloboDnsHost", p.toURLValues())
+// Adds the GloboDNS external host.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: password,
+// physicalnetworkid, url, username.
+func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams, opts ...CallOption) (*AddGloboDnsHostResponse, error) {
+	resp, err := s.cs.newRequest("addGloboDnsHost", s.cs.encodeParams("addGloboDnsHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -600,8 +838,8 @@ func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHos
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -625,273 +863,406 @@ type AddGloboDnsHostResponse struct {
 }
 
 type AddHostParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddHostParams) toURLValues() url.Values {
+	allocationstate optString
+	clusterid       optString
+	clustername     optString
+	hosttags        optStrings
+	hypervisor      optString
+	password        optString
+	podid           optString
+	url             optString
+	username        optString
+	zoneid          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
+	if p.clustername.ok {
+		u.Set("clustername", p.clustername.v)
 	}
-	if v, found := p.p["hosttags"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("hosttags", vv)
+	if p.hosttags.ok {
+		u.Set("hosttags", strings.Join(p.hosttags.v, ","))
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddHostParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddHostParams parses url.Values, for example taken from a raw API request,
+// into a new AddHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddHostParams(u url.Values) (*AddHostParams, error) {
+	p := &AddHostParams{}
+	if err := checkParamNames("addHost", u, "allocationstate", "clusterid", "clustername", "hosttags", "hypervisor", "password", "podid", "url", "username", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["clustername"]; found {
+		p.SetClustername(u.Get("clustername"))
+	}
+	if _, found := u["hosttags"]; found {
+		p.SetHosttags(strings.Split(u.Get("hosttags"), ","))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *AddHostParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *AddHostParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *AddHostParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *AddHostParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *AddHostParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *AddHostParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetClustername sets the clustername param.
 func (p *AddHostParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustername"] = v
+	p.clustername = optString{v: v, ok: true}
 }
 
+// ResetClustername unsets the clustername param
 func (p *AddHostParams) ResetClustername() {
-	if p.p != nil && p.p["clustername"] != nil {
-		delete(p.p, "clustername")
-	}
+	p.clustername = optString{}
 }
 
+// GetClustername returns the clustername param and if it is set
 func (p *AddHostParams) GetClustername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustername"].(string)
-	return value, ok
+	return p.clustername.v, p.clustername.ok
 }
 
+// SetHosttags sets the hosttags param.
 func (p *AddHostParams) SetHosttags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hosttags"] = v
+	p.hosttags = optStrings{v: v, ok: true}
 }
 
+// ResetHosttags unsets the hosttags param
 func (p *AddHostParams) ResetHosttags() {
-	if p.p != nil && p.p["hosttags"] != nil {
-		delete(p.p, "hosttags")
-	}
+	p.hosttags = optStrings{}
 }
 
+// GetHosttags returns the hosttags param and if it is set
 func (p *AddHostParams) GetHosttags() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hosttags"].([]string)
-	return value, ok
+	return p.hosttags.v, p.hosttags.ok
 }
 
+// SetHypervisor sets the hypervisor param. This param is required.
 func (p *AddHostParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *AddHostParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *AddHostParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetPassword sets the password param.
 func (p *AddHostParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddHostParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddHostParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPodid sets the podid param. This param is required.
 func (p *AddHostParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *AddHostParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *AddHostParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddHostParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddHostParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddHostParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param.
 func (p *AddHostParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddHostParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddHostParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.username.v, p.username.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *AddHostParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddHostParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddHostParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddHostParams) Clone() *AddHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.hosttags = p.hosttags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddHostParams) Equal(o *AddHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.clusterid == o.clusterid &&
+		p.clustername == o.clustername &&
+		p.hosttags.equal(o.hosttags) &&
+		p.hypervisor == o.hypervisor &&
+		p.password == o.password &&
+		p.podid == o.podid &&
+		p.url == o.url &&
+		p.username == o.username &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddHostParams is used to (un)marshal AddHostParams using the API param names
+type serializedAddHostParams struct {
+	Allocationstate *string   `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clusterid       *string   `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Clustername     *string   `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Hosttags        *[]string `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Hypervisor      *string   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Password        *string   `json:"password,omitempty" yaml:"password,omitempty"`
+	Podid           *string   `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Url             *string   `json:"url,omitempty" yaml:"url,omitempty"`
+	Username        *string   `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid          *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddHostParams) toSerialized() *serializedAddHostParams {
+	s := &serializedAddHostParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.clustername.ok {
+		s.Clustername = &p.clustername.v
+	}
+	if p.hosttags.ok {
+		s.Hosttags = &p.hosttags.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddHostParams) fromSerialized(s *serializedAddHostParams) {
+	*p = AddHostParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Clustername != nil {
+		p.SetClustername(*s.Clustername)
+	}
+	if s.Hosttags != nil {
+		p.SetHosttags(*s.Hosttags)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewAddHostParams(hypervisor string, podid string, url string, zoneid string) *AddHostParams {
 	p := &AddHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hypervisor"] = hypervisor
-	p.p["podid"] = podid
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetHypervisor(hypervisor)
+	p.SetPodid(podid)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Adds a new host.
-func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
-	resp, err := s.cs.newRequest("addHost", p.toURLValues())
+//
+// Required params: hypervisor, podid, url, zoneid.
+func (s *HostService) AddHost(p *AddHostParams, opts ...CallOption) (*AddHostResponse, error) {
+	resp, err := s.cs.newRequest("addHost", s.cs.encodeParams("addHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -991,77 +1362,162 @@ type AddHostResponseGpugroupVgpu struct {
 }
 
 type AddSecondaryStorageParams struct {
-	p map[string]interface{}
+	url    optString
+	zoneid optString
 }
 
-func (p *AddSecondaryStorageParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddSecondaryStorageParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddSecondaryStorageParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddSecondaryStorageParams parses url.Values, for example taken from a raw API request,
+// into a new AddSecondaryStorageParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddSecondaryStorageParams(u url.Values) (*AddSecondaryStorageParams, error) {
+	p := &AddSecondaryStorageParams{}
+	if err := checkParamNames("addSecondaryStorage", u, "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
 	}
-	p.p["url"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetUrl sets the url param. This param is required.
+func (p *AddSecondaryStorageParams) SetUrl(v string) {
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddSecondaryStorageParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddSecondaryStorageParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *AddSecondaryStorageParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddSecondaryStorageParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddSecondaryStorageParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddSecondaryStorageParams) Clone() *AddSecondaryStorageParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new AddSecondaryStorageParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams {
-	p := &AddSecondaryStorageParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddSecondaryStorageParams) Equal(o *AddSecondaryStorageParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddSecondaryStorageParams is used to (un)marshal AddSecondaryStorageParams using the API param names
+type serializedAddSecondaryStorageParams struct {
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddSecondaryStorageParams) toSerialized() *serializedAddSecondaryStorageParams {
+	s := &serializedAddSecondaryStorageParams{}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddSecondaryStorageParams) fromSerialized(s *serializedAddSecondaryStorageParams) {
+	*p = AddSecondaryStorageParams{}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddSecondaryStorageParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddSecondaryStorageParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddSecondaryStorageParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddSecondaryStorageParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddSecondaryStorageParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddSecondaryStorageParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new AddSecondaryStorageParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams {
+	p := &AddSecondaryStorageParams{}
+	p.SetUrl(url)
 	return p
 }
 
 // Adds secondary storage.
-func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
-	resp, err := s.cs.newRequest("addSecondaryStorage", p.toURLValues())
+//
+// Required params: url.
+func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams, opts ...CallOption) (*AddSecondaryStorageResponse, error) {
+	resp, err := s.cs.newRequest("addSecondaryStorage", s.cs.encodeParams("addSecondaryStorage", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1092,53 +1548,133 @@ type AddSecondaryStorageResponse struct {
 }
 
 type CancelHostMaintenanceParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CancelHostMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *CancelHostMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCancelHostMaintenanceParams parses url.Values, for example taken from a raw API request,
+// into a new CancelHostMaintenanceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCancelHostMaintenanceParams(u url.Values) (*CancelHostMaintenanceParams, error) {
+	p := &CancelHostMaintenanceParams{}
+	if err := checkParamNames("cancelHostMaintenance", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *CancelHostMaintenanceParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *CancelHostMaintenanceParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *CancelHostMaintenanceParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CancelHostMaintenanceParams) Clone() *CancelHostMaintenanceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CancelHostMaintenanceParams) Equal(o *CancelHostMaintenanceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedCancelHostMaintenanceParams is used to (un)marshal CancelHostMaintenanceParams using the API param names
+type serializedCancelHostMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *CancelHostMaintenanceParams) toSerialized() *serializedCancelHostMaintenanceParams {
+	s := &serializedCancelHostMaintenanceParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *CancelHostMaintenanceParams) fromSerialized(s *serializedCancelHostMaintenanceParams) {
+	*p = CancelHostMaintenanceParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CancelHostMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CancelHostMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var s serializedCancelHostMaintenanceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CancelHostMaintenanceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CancelHostMaintenanceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCancelHostMaintenanceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CancelHostMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams {
 	p := &CancelHostMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Cancels host maintenance.
-func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelHostMaintenance", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams, opts ...CallOption) (*CancelHostMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest("cancelHostMaintenance", s.cs.encodeParams("cancelHostMaintenance", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1149,8 +1685,8 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1254,78 +1790,164 @@ type CancelHostMaintenanceResponseGpugroupVgpu struct {
 }
 
 type ConfigureHAForHostParams struct {
-	p map[string]interface{}
+	hostid   optString
+	provider optString
 }
 
-func (p *ConfigureHAForHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ConfigureHAForHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
 	return u
 }
 
-func (p *ConfigureHAForHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseConfigureHAForHostParams parses url.Values, for example taken from a raw API request,
+// into a new ConfigureHAForHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseConfigureHAForHostParams(u url.Values) (*ConfigureHAForHostParams, error) {
+	p := &ConfigureHAForHostParams{}
+	if err := checkParamNames("configureHAForHost", u, "hostid", "provider"); err != nil {
+		return nil, err
 	}
-	p.p["hostid"] = v
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	return p, nil
+}
+
+// SetHostid sets the hostid param. This param is required.
+func (p *ConfigureHAForHostParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ConfigureHAForHostParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ConfigureHAForHostParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetProvider sets the provider param. This param is required.
 func (p *ConfigureHAForHostParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *ConfigureHAForHostParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *ConfigureHAForHostParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.provider.v, p.provider.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ConfigureHAForHostParams) Clone() *ConfigureHAForHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ConfigureHAForHostParams) Equal(o *ConfigureHAForHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid &&
+		p.provider == o.provider
+}
+
+// serializedConfigureHAForHostParams is used to (un)marshal ConfigureHAForHostParams using the API param names
+type serializedConfigureHAForHostParams struct {
+	Hostid   *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+}
+
+func (p *ConfigureHAForHostParams) toSerialized() *serializedConfigureHAForHostParams {
+	s := &serializedConfigureHAForHostParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
 	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ConfigureHAForHostParams) fromSerialized(s *serializedConfigureHAForHostParams) {
+	*p = ConfigureHAForHostParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ConfigureHAForHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ConfigureHAForHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedConfigureHAForHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ConfigureHAForHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ConfigureHAForHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedConfigureHAForHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ConfigureHAForHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewConfigureHAForHostParams(hostid string, provider string) *ConfigureHAForHostParams {
 	p := &ConfigureHAForHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
-	p.p["provider"] = provider
+	p.SetHostid(hostid)
+	p.SetProvider(provider)
 	return p
 }
 
-// Configures HA for a host
-func (s *HostService) ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
-	resp, err := s.cs.newRequest("configureHAForHost", p.toURLValues())
+// Configures HA for a host.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostid, provider.
+func (s *HostService) ConfigureHAForHost(p *ConfigureHAForHostParams, opts ...CallOption) (*HAForHostResponse, error) {
+	resp, err := s.cs.newRequest("configureHAForHost", s.cs.encodeParams("configureHAForHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1336,8 +1958,8 @@ func (s *HostService) ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHos
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1369,53 +1991,133 @@ type HAForHostResponse struct {
 }
 
 type EnableHAForHostParams struct {
-	p map[string]interface{}
+	hostid optString
 }
 
-func (p *EnableHAForHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableHAForHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
 	return u
 }
 
-func (p *EnableHAForHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableHAForHostParams parses url.Values, for example taken from a raw API request,
+// into a new EnableHAForHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableHAForHostParams(u url.Values) (*EnableHAForHostParams, error) {
+	p := &EnableHAForHostParams{}
+	if err := checkParamNames("enableHAForHost", u, "hostid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["hostid"] = v
+	return p, nil
 }
 
+// SetHostid sets the hostid param. This param is required.
+func (p *EnableHAForHostParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
+}
+
+// ResetHostid unsets the hostid param
 func (p *EnableHAForHostParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *EnableHAForHostParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.hostid.v, p.hostid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableHAForHostParams) Clone() *EnableHAForHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableHAForHostParams) Equal(o *EnableHAForHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid
+}
+
+// serializedEnableHAForHostParams is used to (un)marshal EnableHAForHostParams using the API param names
+type serializedEnableHAForHostParams struct {
+	Hostid *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+func (p *EnableHAForHostParams) toSerialized() *serializedEnableHAForHostParams {
+	s := &serializedEnableHAForHostParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	return s
+}
+
+func (p *EnableHAForHostParams) fromSerialized(s *serializedEnableHAForHostParams) {
+	*p = EnableHAForHostParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableHAForHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableHAForHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableHAForHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableHAForHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableHAForHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableHAForHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableHAForHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewEnableHAForHostParams(hostid string) *EnableHAForHostParams {
 	p := &EnableHAForHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+	p.SetHostid(hostid)
 	return p
 }
 
-// Enables HA for a host
-func (s *HostService) EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
-	resp, err := s.cs.newRequest("enableHAForHost", p.toURLValues())
+// Enables HA for a host.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostid.
+func (s *HostService) EnableHAForHost(p *EnableHAForHostParams, opts ...CallOption) (*EnableHAForHostResponse, error) {
+	resp, err := s.cs.newRequest("enableHAForHost", s.cs.encodeParams("enableHAForHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1426,8 +2128,8 @@ func (s *HostService) EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHos
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1459,102 +2161,194 @@ type EnableHAForHostResponse struct {
 }
 
 type DedicateHostParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
+	hostid   optString
 }
 
-func (p *DedicateHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DedicateHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
 	return u
 }
 
-func (p *DedicateHostParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDedicateHostParams parses url.Values, for example taken from a raw API request,
+// into a new DedicateHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDedicateHostParams(u url.Values) (*DedicateHostParams, error) {
+	p := &DedicateHostParams{}
+	if err := checkParamNames("dedicateHost", u, "account", "domainid", "hostid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *DedicateHostParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *DedicateHostParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DedicateHostParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *DedicateHostParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DedicateHostParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DedicateHostParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHostid sets the hostid param. This param is required.
 func (p *DedicateHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *DedicateHostParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *DedicateHostParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.hostid.v, p.hostid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DedicateHostParams) Clone() *DedicateHostParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DedicateHostParams) Equal(o *DedicateHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.hostid == o.hostid
+}
+
+// serializedDedicateHostParams is used to (un)marshal DedicateHostParams using the API param names
+type serializedDedicateHostParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostid   *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+func (p *DedicateHostParams) toSerialized() *serializedDedicateHostParams {
+	s := &serializedDedicateHostParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	return s
+}
+
+func (p *DedicateHostParams) fromSerialized(s *serializedDedicateHostParams) {
+	*p = DedicateHostParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DedicateHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DedicateHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedDedicateHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DedicateHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DedicateHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDedicateHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DedicateHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams {
 	p := &DedicateHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
-	p.p["hostid"] = hostid
+	p.SetDomainid(domainid)
+	p.SetHostid(hostid)
 	return p
 }
 
 // Dedicates a host.
-func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
-	resp, err := s.cs.newRequest("dedicateHost", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: domainid, hostid.
+func (s *HostService) DedicateHost(p *DedicateHostParams, opts ...CallOption) (*DedicateHostResponse, error) {
+	resp, err := s.cs.newRequest("dedicateHost", s.cs.encodeParams("dedicateHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1565,8 +2359,8 @@ func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1599,103 +2393,200 @@ type DedicateHostResponse struct {
 }
 
 type DeleteHostParams struct {
-	p map[string]interface{}
+	forced                   optBool
+	forcedestroylocalstorage optBool
+	id                       optString
 }
 
-func (p *DeleteHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["forcedestroylocalstorage"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forcedestroylocalstorage", vv)
+	if p.forcedestroylocalstorage.ok {
+		u.Set("forcedestroylocalstorage", strconv.FormatBool(p.forcedestroylocalstorage.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteHostParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteHostParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteHostParams(u url.Values) (*DeleteHostParams, error) {
+	p := &DeleteHostParams{}
+	if err := checkParamNames("deleteHost", u, "forced", "forcedestroylocalstorage", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["forcedestroylocalstorage"]; found {
+		v, err := strconv.ParseBool(u.Get("forcedestroylocalstorage"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forcedestroylocalstorage: %v", err)
+		}
+		p.SetForcedestroylocalstorage(v)
 	}
-	p.p["forced"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *DeleteHostParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *DeleteHostParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *DeleteHostParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetForcedestroylocalstorage sets the forcedestroylocalstorage param.
 func (p *DeleteHostParams) SetForcedestroylocalstorage(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forcedestroylocalstorage"] = v
+	p.forcedestroylocalstorage = optBool{v: v, ok: true}
 }
 
+// ResetForcedestroylocalstorage unsets the forcedestroylocalstorage param
 func (p *DeleteHostParams) ResetForcedestroylocalstorage() {
-	if p.p != nil && p.p["forcedestroylocalstorage"] != nil {
-		delete(p.p, "forcedestroylocalstorage")
-	}
+	p.forcedestroylocalstorage = optBool{}
 }
 
+// GetForcedestroylocalstorage returns the forcedestroylocalstorage param and if it is set
 func (p *DeleteHostParams) GetForcedestroylocalstorage() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forcedestroylocalstorage"].(bool)
-	return value, ok
+	return p.forcedestroylocalstorage.v, p.forcedestroylocalstorage.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteHostParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteHostParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteHostParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteHostParams) Clone() *DeleteHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteHostParams) Equal(o *DeleteHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.forcedestroylocalstorage == o.forcedestroylocalstorage &&
+		p.id == o.id
+}
+
+// serializedDeleteHostParams is used to (un)marshal DeleteHostParams using the API param names
+type serializedDeleteHostParams struct {
+	Forced                   *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Forcedestroylocalstorage *bool   `json:"forcedestroylocalstorage,omitempty" yaml:"forcedestroylocalstorage,omitempty"`
+	Id                       *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteHostParams) toSerialized() *serializedDeleteHostParams {
+	s := &serializedDeleteHostParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.forcedestroylocalstorage.ok {
+		s.Forcedestroylocalstorage = &p.forcedestroylocalstorage.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteHostParams) fromSerialized(s *serializedDeleteHostParams) {
+	*p = DeleteHostParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Forcedestroylocalstorage != nil {
+		p.SetForcedestroylocalstorage(*s.Forcedestroylocalstorage)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
 	p := &DeleteHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a host.
-func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
-	resp, err := s.cs.newRequest("deleteHost", p.toURLValues())
+//
+// Required params: id.
+func (s *HostService) DeleteHost(p *DeleteHostParams, opts ...CallOption) (*DeleteHostResponse, error) {
+	resp, err := s.cs.newRequest("deleteHost", s.cs.encodeParams("deleteHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1743,53 +2634,133 @@ func (r *DeleteHostResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DisableOutOfBandManagementForHostParams struct {
-	p map[string]interface{}
+	hostid optString
 }
 
-func (p *DisableOutOfBandManagementForHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableOutOfBandManagementForHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
 	return u
 }
 
-func (p *DisableOutOfBandManagementForHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableOutOfBandManagementForHostParams parses url.Values, for example taken from a raw API request,
+// into a new DisableOutOfBandManagementForHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableOutOfBandManagementForHostParams(u url.Values) (*DisableOutOfBandManagementForHostParams, error) {
+	p := &DisableOutOfBandManagementForHostParams{}
+	if err := checkParamNames("disableOutOfBandManagementForHost", u, "hostid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["hostid"] = v
+	return p, nil
 }
 
+// SetHostid sets the hostid param. This param is required.
+func (p *DisableOutOfBandManagementForHostParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
+}
+
+// ResetHostid unsets the hostid param
 func (p *DisableOutOfBandManagementForHostParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *DisableOutOfBandManagementForHostParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.hostid.v, p.hostid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableOutOfBandManagementForHostParams) Clone() *DisableOutOfBandManagementForHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableOutOfBandManagementForHostParams) Equal(o *DisableOutOfBandManagementForHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid
+}
+
+// serializedDisableOutOfBandManagementForHostParams is used to (un)marshal DisableOutOfBandManagementForHostParams using the API param names
+type serializedDisableOutOfBandManagementForHostParams struct {
+	Hostid *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+func (p *DisableOutOfBandManagementForHostParams) toSerialized() *serializedDisableOutOfBandManagementForHostParams {
+	s := &serializedDisableOutOfBandManagementForHostParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
 	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DisableOutOfBandManagementForHostParams) fromSerialized(s *serializedDisableOutOfBandManagementForHostParams) {
+	*p = DisableOutOfBandManagementForHostParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableOutOfBandManagementForHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableOutOfBandManagementForHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableOutOfBandManagementForHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableOutOfBandManagementForHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableOutOfBandManagementForHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableOutOfBandManagementForHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableOutOfBandManagementForHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams {
 	p := &DisableOutOfBandManagementForHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+	p.SetHostid(hostid)
 	return p
 }
 
-// Disables out-of-band management for a host
-func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForHost", p.toURLValues())
+// Disables out-of-band management for a host.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostid.
+func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams, opts ...CallOption) (*DisableOutOfBandManagementForHostResponse, error) {
+	resp, err := s.cs.newRequest("disableOutOfBandManagementForHost", s.cs.encodeParams("disableOutOfBandManagementForHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1800,8 +2771,8 @@ func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManag
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1839,53 +2810,133 @@ type DisableOutOfBandManagementForHostResponse struct {
 }
 
 type EnableOutOfBandManagementForHostParams struct {
-	p map[string]interface{}
+	hostid optString
 }
 
-func (p *EnableOutOfBandManagementForHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableOutOfBandManagementForHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
 	return u
 }
 
-func (p *EnableOutOfBandManagementForHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableOutOfBandManagementForHostParams parses url.Values, for example taken from a raw API request,
+// into a new EnableOutOfBandManagementForHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableOutOfBandManagementForHostParams(u url.Values) (*EnableOutOfBandManagementForHostParams, error) {
+	p := &EnableOutOfBandManagementForHostParams{}
+	if err := checkParamNames("enableOutOfBandManagementForHost", u, "hostid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["hostid"] = v
+	return p, nil
 }
 
+// SetHostid sets the hostid param. This param is required.
+func (p *EnableOutOfBandManagementForHostParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
+}
+
+// ResetHostid unsets the hostid param
 func (p *EnableOutOfBandManagementForHostParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *EnableOutOfBandManagementForHostParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.hostid.v, p.hostid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableOutOfBandManagementForHostParams) Clone() *EnableOutOfBandManagementForHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableOutOfBandManagementForHostParams) Equal(o *EnableOutOfBandManagementForHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid
+}
+
+// serializedEnableOutOfBandManagementForHostParams is used to (un)marshal EnableOutOfBandManagementForHostParams using the API param names
+type serializedEnableOutOfBandManagementForHostParams struct {
+	Hostid *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+func (p *EnableOutOfBandManagementForHostParams) toSerialized() *serializedEnableOutOfBandManagementForHostParams {
+	s := &serializedEnableOutOfBandManagementForHostParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	return s
+}
+
+func (p *EnableOutOfBandManagementForHostParams) fromSerialized(s *serializedEnableOutOfBandManagementForHostParams) {
+	*p = EnableOutOfBandManagementForHostParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableOutOfBandManagementForHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableOutOfBandManagementForHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableOutOfBandManagementForHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableOutOfBandManagementForHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableOutOfBandManagementForHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableOutOfBandManagementForHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableOutOfBandManagementForHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewEnableOutOfBandManagementForHostParams(hostid string) *EnableOutOfBandManagementForHostParams {
 	p := &EnableOutOfBandManagementForHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+	p.SetHostid(hostid)
 	return p
 }
 
-// Enables out-of-band management for a host
-func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForHost", p.toURLValues())
+// Enables out-of-band management for a host.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostid.
+func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams, opts ...CallOption) (*EnableOutOfBandManagementForHostResponse, error) {
+	resp, err := s.cs.newRequest("enableOutOfBandManagementForHost", s.cs.encodeParams("enableOutOfBandManagementForHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1896,8 +2947,8 @@ func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagem
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1935,127 +2986,230 @@ type EnableOutOfBandManagementForHostResponse struct {
 }
 
 type FindHostsForMigrationParams struct {
-	p map[string]interface{}
+	keyword          optString
+	page             optInt
+	pagesize         optInt
+	virtualmachineid optString
 }
 
-func (p *FindHostsForMigrationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *FindHostsForMigrationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *FindHostsForMigrationParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseFindHostsForMigrationParams parses url.Values, for example taken from a raw API request,
+// into a new FindHostsForMigrationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseFindHostsForMigrationParams(u url.Values) (*FindHostsForMigrationParams, error) {
+	p := &FindHostsForMigrationParams{}
+	if err := checkParamNames("findHostsForMigration", u, "keyword", "page", "pagesize", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *FindHostsForMigrationParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *FindHostsForMigrationParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *FindHostsForMigrationParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *FindHostsForMigrationParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *FindHostsForMigrationParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *FindHostsForMigrationParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *FindHostsForMigrationParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *FindHostsForMigrationParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *FindHostsForMigrationParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *FindHostsForMigrationParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *FindHostsForMigrationParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *FindHostsForMigrationParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *FindHostsForMigrationParams) Clone() *FindHostsForMigrationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *FindHostsForMigrationParams) Equal(o *FindHostsForMigrationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedFindHostsForMigrationParams is used to (un)marshal FindHostsForMigrationParams using the API param names
+type serializedFindHostsForMigrationParams struct {
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *FindHostsForMigrationParams) toSerialized() *serializedFindHostsForMigrationParams {
+	s := &serializedFindHostsForMigrationParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *FindHostsForMigrationParams) fromSerialized(s *serializedFindHostsForMigrationParams) {
+	*p = FindHostsForMigrationParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p FindHostsForMigrationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *FindHostsForMigrationParams) UnmarshalJSON(b []byte) error {
+	var s serializedFindHostsForMigrationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p FindHostsForMigrationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *FindHostsForMigrationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedFindHostsForMigrationParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new FindHostsForMigrationParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams {
 	p := &FindHostsForMigrationParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Find hosts suitable for migrating a virtual machine.
-func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findHostsForMigration", p.toURLValues())
+//
+// Required params: virtualmachineid.
+func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams, opts ...CallOption) (*FindHostsForMigrationResponse, error) {
+	resp, err := s.cs.newRequest("findHostsForMigration", s.cs.encodeParams("findHostsForMigration", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2129,198 +3283,317 @@ type HostForMigration struct {
 }
 
 type ListDedicatedHostsParams struct {
-	p map[string]interface{}
+	account         optString
+	affinitygroupid optString
+	domainid        optString
+	hostid          optString
+	keyword         optString
+	page            optInt
+	pagesize        optInt
 }
 
-func (p *ListDedicatedHostsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDedicatedHostsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if p.affinitygroupid.ok {
+		u.Set("affinitygroupid", p.affinitygroupid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListDedicatedHostsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDedicatedHostsParams parses url.Values, for example taken from a raw API request,
+// into a new ListDedicatedHostsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDedicatedHostsParams(u url.Values) (*ListDedicatedHostsParams, error) {
+	p := &ListDedicatedHostsParams{}
+	if err := checkParamNames("listDedicatedHosts", u, "account", "affinitygroupid", "domainid", "hostid", "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["affinitygroupid"]; found {
+		p.SetAffinitygroupid(u.Get("affinitygroupid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListDedicatedHostsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListDedicatedHostsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListDedicatedHostsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAffinitygroupid sets the affinitygroupid param.
 func (p *ListDedicatedHostsParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
+	p.affinitygroupid = optString{v: v, ok: true}
 }
 
+// ResetAffinitygroupid unsets the affinitygroupid param
 func (p *ListDedicatedHostsParams) ResetAffinitygroupid() {
-	if p.p != nil && p.p["affinitygroupid"] != nil {
-		delete(p.p, "affinitygroupid")
-	}
+	p.affinitygroupid = optString{}
 }
 
+// GetAffinitygroupid returns the affinitygroupid param and if it is set
 func (p *ListDedicatedHostsParams) GetAffinitygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupid"].(string)
-	return value, ok
+	return p.affinitygroupid.v, p.affinitygroupid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListDedicatedHostsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListDedicatedHostsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListDedicatedHostsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListDedicatedHostsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListDedicatedHostsParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListDedicatedHostsParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDedicatedHostsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDedicatedHostsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDedicatedHostsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDedicatedHostsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDedicatedHostsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDedicatedHostsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDedicatedHostsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDedicatedHostsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDedicatedHostsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDedicatedHostsParams) Clone() *ListDedicatedHostsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDedicatedHostsParams) Equal(o *ListDedicatedHostsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.affinitygroupid == o.affinitygroupid &&
+		p.domainid == o.domainid &&
+		p.hostid == o.hostid &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListDedicatedHostsParams is used to (un)marshal ListDedicatedHostsParams using the API param names
+type serializedListDedicatedHostsParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostid          *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListDedicatedHostsParams) toSerialized() *serializedListDedicatedHostsParams {
+	s := &serializedListDedicatedHostsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.affinitygroupid.ok {
+		s.Affinitygroupid = &p.affinitygroupid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListDedicatedHostsParams) fromSerialized(s *serializedListDedicatedHostsParams) {
+	*p = ListDedicatedHostsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Affinitygroupid != nil {
+		p.SetAffinitygroupid(*s.Affinitygroupid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDedicatedHostsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDedicatedHostsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDedicatedHostsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDedicatedHostsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDedicatedHostsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDedicatedHostsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDedicatedHostsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
 	p := &ListDedicatedHostsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists dedicated hosts.
-func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedHosts", p.toURLValues())
+func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams, opts ...CallOption) (*ListDedicatedHostsResponse, error) {
+	resp, err := s.cs.newRequest("listDedicatedHosts", s.cs.encodeParams("listDedicatedHosts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2350,105 +3623,199 @@ type DedicatedHost struct {
 }
 
 type ListHostTagsParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListHostTagsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListHostTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListHostTagsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListHostTagsParams parses url.Values, for example taken from a raw API request,
+// into a new ListHostTagsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListHostTagsParams(u url.Values) (*ListHostTagsParams, error) {
+	p := &ListHostTagsParams{}
+	if err := checkParamNames("listHostTags", u, "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListHostTagsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListHostTagsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListHostTagsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListHostTagsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListHostTagsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListHostTagsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListHostTagsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListHostTagsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListHostTagsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListHostTagsParams) Clone() *ListHostTagsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListHostTagsParams) Equal(o *ListHostTagsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListHostTagsParams is used to (un)marshal ListHostTagsParams using the API param names
+type serializedListHostTagsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListHostTagsParams) toSerialized() *serializedListHostTagsParams {
+	s := &serializedListHostTagsParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListHostTagsParams) fromSerialized(s *serializedListHostTagsParams) {
+	*p = ListHostTagsParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListHostTagsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListHostTagsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListHostTagsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListHostTagsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListHostTagsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListHostTagsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListHostTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListHostTagsParams() *ListHostTagsParams {
 	p := &ListHostTagsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostTagsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2479,9 +3846,9 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// Lists host tags
-func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
-	resp, err := s.cs.newRequest("listHostTags", p.toURLValues())
+// Lists host tags.
+func (s *HostService) ListHostTags(p *ListHostTagsParams, opts ...CallOption) (*ListHostTagsResponse, error) {
+	resp, err := s.cs.newRequest("listHostTags", s.cs.encodeParams("listHostTags", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2508,444 +3875,628 @@ type HostTag struct {
 }
 
 type ListHostsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListHostsParams) toURLValues() url.Values {
+	clusterid                     optString
+	details                       optStrings
+	hahost                        optBool
+	hypervisor                    optString
+	id                            optString
+	keyword                       optString
+	name                          optString
+	outofbandmanagementenabled    optBool
+	outofbandmanagementpowerstate optString
+	page                          optInt
+	pagesize                      optInt
+	podid                         optString
+	resourcestate                 optString
+	state                         optString
+	type_                         optString
+	virtualmachineid              optString
+	zoneid                        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListHostsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["hahost"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("hahost", vv)
+	if p.hahost.ok {
+		u.Set("hahost", strconv.FormatBool(p.hahost.v))
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["outofbandmanagementenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("outofbandmanagementenabled", vv)
+	if p.outofbandmanagementenabled.ok {
+		u.Set("outofbandmanagementenabled", strconv.FormatBool(p.outofbandmanagementenabled.v))
 	}
-	if v, found := p.p["outofbandmanagementpowerstate"]; found {
-		u.Set("outofbandmanagementpowerstate", v.(string))
+	if p.outofbandmanagementpowerstate.ok {
+		u.Set("outofbandmanagementpowerstate", p.outofbandmanagementpowerstate.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["resourcestate"]; found {
-		u.Set("resourcestate", v.(string))
+	if p.resourcestate.ok {
+		u.Set("resourcestate", p.resourcestate.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListHostsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListHostsParams parses url.Values, for example taken from a raw API request,
+// into a new ListHostsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListHostsParams(u url.Values) (*ListHostsParams, error) {
+	p := &ListHostsParams{}
+	if err := checkParamNames("listHosts", u, "clusterid", "details", "hahost", "hypervisor", "id", "keyword", "name", "outofbandmanagementenabled", "outofbandmanagementpowerstate", "page", "pagesize", "podid", "resourcestate", "state", "type", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["hahost"]; found {
+		v, err := strconv.ParseBool(u.Get("hahost"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param hahost: %v", err)
+		}
+		p.SetHahost(v)
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["clusterid"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["outofbandmanagementenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("outofbandmanagementenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param outofbandmanagementenabled: %v", err)
+		}
+		p.SetOutofbandmanagementenabled(v)
+	}
+	if _, found := u["outofbandmanagementpowerstate"]; found {
+		p.SetOutofbandmanagementpowerstate(u.Get("outofbandmanagementpowerstate"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["resourcestate"]; found {
+		p.SetResourcestate(u.Get("resourcestate"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param.
+func (p *ListHostsParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListHostsParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListHostsParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDetails sets the details param.
 func (p *ListHostsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStrings{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ListHostsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListHostsParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetHahost sets the hahost param.
 func (p *ListHostsParams) SetHahost(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hahost"] = v
+	p.hahost = optBool{v: v, ok: true}
 }
 
+// ResetHahost unsets the hahost param
 func (p *ListHostsParams) ResetHahost() {
-	if p.p != nil && p.p["hahost"] != nil {
-		delete(p.p, "hahost")
-	}
+	p.hahost = optBool{}
 }
 
+// GetHahost returns the hahost param and if it is set
 func (p *ListHostsParams) GetHahost() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hahost"].(bool)
-	return value, ok
+	return p.hahost.v, p.hahost.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListHostsParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListHostsParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListHostsParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListHostsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListHostsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListHostsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListHostsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListHostsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListHostsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListHostsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListHostsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListHostsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOutofbandmanagementenabled sets the outofbandmanagementenabled param.
 func (p *ListHostsParams) SetOutofbandmanagementenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["outofbandmanagementenabled"] = v
+	p.outofbandmanagementenabled = optBool{v: v, ok: true}
 }
 
+// ResetOutofbandmanagementenabled unsets the outofbandmanagementenabled param
 func (p *ListHostsParams) ResetOutofbandmanagementenabled() {
-	if p.p != nil && p.p["outofbandmanagementenabled"] != nil {
-		delete(p.p, "outofbandmanagementenabled")
-	}
+	p.outofbandmanagementenabled = optBool{}
 }
 
+// GetOutofbandmanagementenabled returns the outofbandmanagementenabled param and if it is set
 func (p *ListHostsParams) GetOutofbandmanagementenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["outofbandmanagementenabled"].(bool)
-	return value, ok
+	return p.outofbandmanagementenabled.v, p.outofbandmanagementenabled.ok
 }
 
+// SetOutofbandmanagementpowerstate sets the outofbandmanagementpowerstate param.
 func (p *ListHostsParams) SetOutofbandmanagementpowerstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["outofbandmanagementpowerstate"] = v
+	p.outofbandmanagementpowerstate = optString{v: v, ok: true}
 }
 
+// ResetOutofbandmanagementpowerstate unsets the outofbandmanagementpowerstate param
 func (p *ListHostsParams) ResetOutofbandmanagementpowerstate() {
-	if p.p != nil && p.p["outofbandmanagementpowerstate"] != nil {
-		delete(p.p, "outofbandmanagementpowerstate")
-	}
+	p.outofbandmanagementpowerstate = optString{}
 }
 
+// GetOutofbandmanagementpowerstate returns the outofbandmanagementpowerstate param and if it is set
 func (p *ListHostsParams) GetOutofbandmanagementpowerstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["outofbandmanagementpowerstate"].(string)
-	return value, ok
+	return p.outofbandmanagementpowerstate.v, p.outofbandmanagementpowerstate.ok
 }
 
+// SetPage sets the page param.
 func (p *ListHostsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListHostsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListHostsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListHostsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListHostsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListHostsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListHostsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListHostsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListHostsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetResourcestate sets the resourcestate param.
 func (p *ListHostsParams) SetResourcestate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcestate"] = v
+	p.resourcestate = optString{v: v, ok: true}
 }
 
+// ResetResourcestate unsets the resourcestate param
 func (p *ListHostsParams) ResetResourcestate() {
-	if p.p != nil && p.p["resourcestate"] != nil {
-		delete(p.p, "resourcestate")
-	}
+	p.resourcestate = optString{}
 }
 
+// GetResourcestate returns the resourcestate param and if it is set
 func (p *ListHostsParams) GetResourcestate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcestate"].(string)
-	return value, ok
+	return p.resourcestate.v, p.resourcestate.ok
 }
 
+// SetState sets the state param.
 func (p *ListHostsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListHostsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListHostsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetType sets the type param.
 func (p *ListHostsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListHostsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListHostsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListHostsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListHostsParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListHostsParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListHostsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListHostsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListHostsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListHostsParams) Clone() *ListHostsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListHostsParams) Equal(o *ListHostsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid &&
+		p.details.equal(o.details) &&
+		p.hahost == o.hahost &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.outofbandmanagementenabled == o.outofbandmanagementenabled &&
+		p.outofbandmanagementpowerstate == o.outofbandmanagementpowerstate &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.resourcestate == o.resourcestate &&
+		p.state == o.state &&
+		p.type_ == o.type_ &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListHostsParams is used to (un)marshal ListHostsParams using the API param names
+type serializedListHostsParams struct {
+	Clusterid                     *string   `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Details                       *[]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Hahost                        *bool     `json:"hahost,omitempty" yaml:"hahost,omitempty"`
+	Hypervisor                    *string   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id                            *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword                       *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name                          *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Outofbandmanagementenabled    *bool     `json:"outofbandmanagementenabled,omitempty" yaml:"outofbandmanagementenabled,omitempty"`
+	Outofbandmanagementpowerstate *string   `json:"outofbandmanagementpowerstate,omitempty" yaml:"outofbandmanagementpowerstate,omitempty"`
+	Page                          *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                      *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                         *string   `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Resourcestate                 *string   `json:"resourcestate,omitempty" yaml:"resourcestate,omitempty"`
+	State                         *string   `json:"state,omitempty" yaml:"state,omitempty"`
+	Type                          *string   `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid              *string   `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid                        *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListHostsParams) toSerialized() *serializedListHostsParams {
+	s := &serializedListHostsParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.hahost.ok {
+		s.Hahost = &p.hahost.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.outofbandmanagementenabled.ok {
+		s.Outofbandmanagementenabled = &p.outofbandmanagementenabled.v
+	}
+	if p.outofbandmanagementpowerstate.ok {
+		s.Outofbandmanagementpowerstate = &p.outofbandmanagementpowerstate.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.resourcestate.ok {
+		s.Resourcestate = &p.resourcestate.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListHostsParams) fromSerialized(s *serializedListHostsParams) {
+	*p = ListHostsParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Hahost != nil {
+		p.SetHahost(*s.Hahost)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Outofbandmanagementenabled != nil {
+		p.SetOutofbandmanagementenabled(*s.Outofbandmanagementenabled)
+	}
+	if s.Outofbandmanagementpowerstate != nil {
+		p.SetOutofbandmanagementpowerstate(*s.Outofbandmanagementpowerstate)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Resourcestate != nil {
+		p.SetResourcestate(*s.Resourcestate)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListHostsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListHostsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListHostsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListHostsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListHostsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListHostsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListHostsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListHostsParams() *ListHostsParams {
 	p := &ListHostsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2993,9 +4544,8 @@ func (s *HostService) GetHostByName(name string, opts ...OptionFunc) (*Host, int
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, error) {
 	p := &ListHostsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3024,8 +4574,8 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 }
 
 // Lists hosts.
-func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
-	resp, err := s.cs.newRequest("listHosts", p.toURLValues())
+func (s *HostService) ListHosts(p *ListHostsParams, opts ...CallOption) (*ListHostsResponse, error) {
+	resp, err := s.cs.newRequest("listHosts", s.cs.encodeParams("listHosts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3126,444 +4676,628 @@ type HostGpugroupVgpu struct {
 }
 
 type ListHostsMetricsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListHostsMetricsParams) toURLValues() url.Values {
+	clusterid                     optString
+	details                       optStrings
+	hahost                        optBool
+	hypervisor                    optString
+	id                            optString
+	keyword                       optString
+	name                          optString
+	outofbandmanagementenabled    optBool
+	outofbandmanagementpowerstate optString
+	page                          optInt
+	pagesize                      optInt
+	podid                         optString
+	resourcestate                 optString
+	state                         optString
+	type_                         optString
+	virtualmachineid              optString
+	zoneid                        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListHostsMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["hahost"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("hahost", vv)
+	if p.hahost.ok {
+		u.Set("hahost", strconv.FormatBool(p.hahost.v))
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["outofbandmanagementenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("outofbandmanagementenabled", vv)
+	if p.outofbandmanagementenabled.ok {
+		u.Set("outofbandmanagementenabled", strconv.FormatBool(p.outofbandmanagementenabled.v))
 	}
-	if v, found := p.p["outofbandmanagementpowerstate"]; found {
-		u.Set("outofbandmanagementpowerstate", v.(string))
+	if p.outofbandmanagementpowerstate.ok {
+		u.Set("outofbandmanagementpowerstate", p.outofbandmanagementpowerstate.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["resourcestate"]; found {
-		u.Set("resourcestate", v.(string))
+	if p.resourcestate.ok {
+		u.Set("resourcestate", p.resourcestate.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListHostsMetricsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListHostsMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListHostsMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListHostsMetricsParams(u url.Values) (*ListHostsMetricsParams, error) {
+	p := &ListHostsMetricsParams{}
+	if err := checkParamNames("listHostsMetrics", u, "clusterid", "details", "hahost", "hypervisor", "id", "keyword", "name", "outofbandmanagementenabled", "outofbandmanagementpowerstate", "page", "pagesize", "podid", "resourcestate", "state", "type", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
 	}
-	p.p["clusterid"] = v
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["hahost"]; found {
+		v, err := strconv.ParseBool(u.Get("hahost"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param hahost: %v", err)
+		}
+		p.SetHahost(v)
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["outofbandmanagementenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("outofbandmanagementenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param outofbandmanagementenabled: %v", err)
+		}
+		p.SetOutofbandmanagementenabled(v)
+	}
+	if _, found := u["outofbandmanagementpowerstate"]; found {
+		p.SetOutofbandmanagementpowerstate(u.Get("outofbandmanagementpowerstate"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["resourcestate"]; found {
+		p.SetResourcestate(u.Get("resourcestate"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param.
+func (p *ListHostsMetricsParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListHostsMetricsParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListHostsMetricsParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDetails sets the details param.
 func (p *ListHostsMetricsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStrings{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ListHostsMetricsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListHostsMetricsParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetHahost sets the hahost param.
 func (p *ListHostsMetricsParams) SetHahost(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hahost"] = v
+	p.hahost = optBool{v: v, ok: true}
 }
 
+// ResetHahost unsets the hahost param
 func (p *ListHostsMetricsParams) ResetHahost() {
-	if p.p != nil && p.p["hahost"] != nil {
-		delete(p.p, "hahost")
-	}
+	p.hahost = optBool{}
 }
 
+// GetHahost returns the hahost param and if it is set
 func (p *ListHostsMetricsParams) GetHahost() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hahost"].(bool)
-	return value, ok
+	return p.hahost.v, p.hahost.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListHostsMetricsParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListHostsMetricsParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListHostsMetricsParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListHostsMetricsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListHostsMetricsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListHostsMetricsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListHostsMetricsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListHostsMetricsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListHostsMetricsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListHostsMetricsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListHostsMetricsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListHostsMetricsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOutofbandmanagementenabled sets the outofbandmanagementenabled param.
 func (p *ListHostsMetricsParams) SetOutofbandmanagementenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["outofbandmanagementenabled"] = v
+	p.outofbandmanagementenabled = optBool{v: v, ok: true}
 }
 
+// ResetOutofbandmanagementenabled unsets the outofbandmanagementenabled param
 func (p *ListHostsMetricsParams) ResetOutofbandmanagementenabled() {
-	if p.p != nil && p.p["outofbandmanagementenabled"] != nil {
-		delete(p.p, "outofbandmanagementenabled")
-	}
+	p.outofbandmanagementenabled = optBool{}
 }
 
+// GetOutofbandmanagementenabled returns the outofbandmanagementenabled param and if it is set
 func (p *ListHostsMetricsParams) GetOutofbandmanagementenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["outofbandmanagementenabled"].(bool)
-	return value, ok
+	return p.outofbandmanagementenabled.v, p.outofbandmanagementenabled.ok
 }
 
+// SetOutofbandmanagementpowerstate sets the outofbandmanagementpowerstate param.
 func (p *ListHostsMetricsParams) SetOutofbandmanagementpowerstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["outofbandmanagementpowerstate"] = v
+	p.outofbandmanagementpowerstate = optString{v: v, ok: true}
 }
 
+// ResetOutofbandmanagementpowerstate unsets the outofbandmanagementpowerstate param
 func (p *ListHostsMetricsParams) ResetOutofbandmanagementpowerstate() {
-	if p.p != nil && p.p["outofbandmanagementpowerstate"] != nil {
-		delete(p.p, "outofbandmanagementpowerstate")
-	}
+	p.outofbandmanagementpowerstate = optString{}
 }
 
+// GetOutofbandmanagementpowerstate returns the outofbandmanagementpowerstate param and if it is set
 func (p *ListHostsMetricsParams) GetOutofbandmanagementpowerstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["outofbandmanagementpowerstate"].(string)
-	return value, ok
+	return p.outofbandmanagementpowerstate.v, p.outofbandmanagementpowerstate.ok
 }
 
+// SetPage sets the page param.
 func (p *ListHostsMetricsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListHostsMetricsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListHostsMetricsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListHostsMetricsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListHostsMetricsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListHostsMetricsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListHostsMetricsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListHostsMetricsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListHostsMetricsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetResourcestate sets the resourcestate param.
 func (p *ListHostsMetricsParams) SetResourcestate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcestate"] = v
+	p.resourcestate = optString{v: v, ok: true}
 }
 
+// ResetResourcestate unsets the resourcestate param
 func (p *ListHostsMetricsParams) ResetResourcestate() {
-	if p.p != nil && p.p["resourcestate"] != nil {
-		delete(p.p, "resourcestate")
-	}
+	p.resourcestate = optString{}
 }
 
+// GetResourcestate returns the resourcestate param and if it is set
 func (p *ListHostsMetricsParams) GetResourcestate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcestate"].(string)
-	return value, ok
+	return p.resourcestate.v, p.resourcestate.ok
 }
 
+// SetState sets the state param.
 func (p *ListHostsMetricsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListHostsMetricsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListHostsMetricsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetType sets the type param.
 func (p *ListHostsMetricsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListHostsMetricsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListHostsMetricsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListHostsMetricsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListHostsMetricsParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListHostsMetricsParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListHostsMetricsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListHostsMetricsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListHostsMetricsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListHostsMetricsParams) Clone() *ListHostsMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListHostsMetricsParams) Equal(o *ListHostsMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid &&
+		p.details.equal(o.details) &&
+		p.hahost == o.hahost &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.outofbandmanagementenabled == o.outofbandmanagementenabled &&
+		p.outofbandmanagementpowerstate == o.outofbandmanagementpowerstate &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.resourcestate == o.resourcestate &&
+		p.state == o.state &&
+		p.type_ == o.type_ &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListHostsMetricsParams is used to (un)marshal ListHostsMetricsParams using the API param names
+type serializedListHostsMetricsParams struct {
+	Clusterid                     *string   `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Details                       *[]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Hahost                        *bool     `json:"hahost,omitempty" yaml:"hahost,omitempty"`
+	Hypervisor                    *string   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id                            *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword                       *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name                          *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Outofbandmanagementenabled    *bool     `json:"outofbandmanagementenabled,omitempty" yaml:"outofbandmanagementenabled,omitempty"`
+	Outofbandmanagementpowerstate *string   `json:"outofbandmanagementpowerstate,omitempty" yaml:"outofbandmanagementpowerstate,omitempty"`
+	Page                          *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                      *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                         *string   `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Resourcestate                 *string   `json:"resourcestate,omitempty" yaml:"resourcestate,omitempty"`
+	State                         *string   `json:"state,omitempty" yaml:"state,omitempty"`
+	Type                          *string   `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid              *string   `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid                        *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListHostsMetricsParams) toSerialized() *serializedListHostsMetricsParams {
+	s := &serializedListHostsMetricsParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.hahost.ok {
+		s.Hahost = &p.hahost.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.outofbandmanagementenabled.ok {
+		s.Outofbandmanagementenabled = &p.outofbandmanagementenabled.v
+	}
+	if p.outofbandmanagementpowerstate.ok {
+		s.Outofbandmanagementpowerstate = &p.outofbandmanagementpowerstate.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.resourcestate.ok {
+		s.Resourcestate = &p.resourcestate.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListHostsMetricsParams) fromSerialized(s *serializedListHostsMetricsParams) {
+	*p = ListHostsMetricsParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Hahost != nil {
+		p.SetHahost(*s.Hahost)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Outofbandmanagementenabled != nil {
+		p.SetOutofbandmanagementenabled(*s.Outofbandmanagementenabled)
+	}
+	if s.Outofbandmanagementpowerstate != nil {
+		p.SetOutofbandmanagementpowerstate(*s.Outofbandmanagementpowerstate)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Resourcestate != nil {
+		p.SetResourcestate(*s.Resourcestate)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListHostsMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListHostsMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListHostsMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListHostsMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListHostsMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListHostsMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListHostsMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListHostsMetricsParams() *ListHostsMetricsParams {
 	p := &ListHostsMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostsMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3611,9 +5345,8 @@ func (s *HostService) GetHostsMetricByName(name string, opts ...OptionFunc) (*Ho
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error) {
 	p := &ListHostsMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3641,9 +5374,9 @@ func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsM
 	return nil, l.Count, fmt.Errorf("There is more then one result for HostsMetric UUID: %s!", id)
 }
 
-// Lists hosts metrics
-func (s *HostService) ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listHostsMetrics", p.toURLValues())
+// Lists hosts metrics.
+func (s *HostService) ListHostsMetrics(p *ListHostsMetricsParams, opts ...CallOption) (*ListHostsMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listHostsMetrics", s.cs.encodeParams("listHostsMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3763,53 +5496,133 @@ type HostsMetricGpugroupVgpu struct {
 }
 
 type PrepareHostForMaintenanceParams struct {
-	p map[string]interface{}
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *PrepareHostForMaintenanceParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParsePrepareHostForMaintenanceParams parses url.Values, for example taken from a raw API request,
+// into a new PrepareHostForMaintenanceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParsePrepareHostForMaintenanceParams(u url.Values) (*PrepareHostForMaintenanceParams, error) {
+	p := &PrepareHostForMaintenanceParams{}
+	if err := checkParamNames("prepareHostForMaintenance", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *PrepareHostForMaintenanceParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *PrepareHostForMaintenanceParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *PrepareHostForMaintenanceParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *PrepareHostForMaintenanceParams) Clone() *PrepareHostForMaintenanceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *PrepareHostForMaintenanceParams) Equal(o *PrepareHostForMaintenanceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedPrepareHostForMaintenanceParams is used to (un)marshal PrepareHostForMaintenanceParams using the API param names
+type serializedPrepareHostForMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func (p *PrepareHostForMaintenanceParams) toSerialized() *serializedPrepareHostForMaintenanceParams {
+	s := &serializedPrepareHostForMaintenanceParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+	return s
 }
 
-func (p *PrepareHostForMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *PrepareHostForMaintenanceParams) fromSerialized(s *serializedPrepareHostForMaintenanceParams) {
+	*p = PrepareHostForMaintenanceParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	p.p["id"] = v
 }
 
-func (p *PrepareHostForMaintenanceParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p PrepareHostForMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *PrepareHostForMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var s serializedPrepareHostForMaintenanceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *PrepareHostForMaintenanceParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p PrepareHostForMaintenanceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *PrepareHostForMaintenanceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedPrepareHostForMaintenanceParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new PrepareHostForMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams {
 	p := &PrepareHostForMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Prepares a host for maintenance.
-func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("prepareHostForMaintenance", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams, opts ...CallOption) (*PrepareHostForMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest("prepareHostForMaintenance", s.cs.encodeParams("prepareHostForMaintenance", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3820,8 +5633,8 @@ func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenancePara
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3925,53 +5738,133 @@ type PrepareHostForMaintenanceResponseGpugroupVgpu struct {
 }
 
 type ReconnectHostParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ReconnectHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReconnectHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ReconnectHostParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReconnectHostParams parses url.Values, for example taken from a raw API request,
+// into a new ReconnectHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReconnectHostParams(u url.Values) (*ReconnectHostParams, error) {
+	p := &ReconnectHostParams{}
+	if err := checkParamNames("reconnectHost", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ReconnectHostParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ReconnectHostParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ReconnectHostParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReconnectHostParams) Clone() *ReconnectHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReconnectHostParams) Equal(o *ReconnectHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedReconnectHostParams is used to (un)marshal ReconnectHostParams using the API param names
+type serializedReconnectHostParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ReconnectHostParams) toSerialized() *serializedReconnectHostParams {
+	s := &serializedReconnectHostParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ReconnectHostParams) fromSerialized(s *serializedReconnectHostParams) {
+	*p = ReconnectHostParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReconnectHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReconnectHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedReconnectHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReconnectHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReconnectHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReconnectHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReconnectHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
 	p := &ReconnectHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Reconnects a host.
-func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
-	resp, err := s.cs.newRequest("reconnectHost", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *HostService) ReconnectHost(p *ReconnectHostParams, opts ...CallOption) (*ReconnectHostResponse, error) {
+	resp, err := s.cs.newRequest("reconnectHost", s.cs.encodeParams("reconnectHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3982,8 +5875,8 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4087,53 +5980,133 @@ type ReconnectHostResponseGpugroupVgpu struct {
 }
 
 type ReleaseDedicatedHostParams struct {
-	p map[string]interface{}
+	hostid optString
 }
 
-func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleaseDedicatedHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
 	return u
 }
 
-func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleaseDedicatedHostParams parses url.Values, for example taken from a raw API request,
+// into a new ReleaseDedicatedHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleaseDedicatedHostParams(u url.Values) (*ReleaseDedicatedHostParams, error) {
+	p := &ReleaseDedicatedHostParams{}
+	if err := checkParamNames("releaseDedicatedHost", u, "hostid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["hostid"] = v
+	return p, nil
 }
 
+// SetHostid sets the hostid param. This param is required.
+func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
+}
+
+// ResetHostid unsets the hostid param
 func (p *ReleaseDedicatedHostParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ReleaseDedicatedHostParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.hostid.v, p.hostid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleaseDedicatedHostParams) Clone() *ReleaseDedicatedHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleaseDedicatedHostParams) Equal(o *ReleaseDedicatedHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid
+}
+
+// serializedReleaseDedicatedHostParams is used to (un)marshal ReleaseDedicatedHostParams using the API param names
+type serializedReleaseDedicatedHostParams struct {
+	Hostid *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+func (p *ReleaseDedicatedHostParams) toSerialized() *serializedReleaseDedicatedHostParams {
+	s := &serializedReleaseDedicatedHostParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	return s
+}
+
+func (p *ReleaseDedicatedHostParams) fromSerialized(s *serializedReleaseDedicatedHostParams) {
+	*p = ReleaseDedicatedHostParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleaseDedicatedHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleaseDedicatedHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleaseDedicatedHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleaseDedicatedHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleaseDedicatedHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleaseDedicatedHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleaseDedicatedHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams {
 	p := &ReleaseDedicatedHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+	p.SetHostid(hostid)
 	return p
 }
 
-// Release the dedication for host
-func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedHost", p.toURLValues())
+// Release the dedication for host.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostid.
+func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams, opts ...CallOption) (*ReleaseDedicatedHostResponse, error) {
+	resp, err := s.cs.newRequest("releaseDedicatedHost", s.cs.encodeParams("releaseDedicatedHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4144,8 +6117,8 @@ func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*Rele
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4169,53 +6142,133 @@ type ReleaseDedicatedHostResponse struct {
 }
 
 type ReleaseHostReservationParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ReleaseHostReservationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleaseHostReservationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ReleaseHostReservationParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleaseHostReservationParams parses url.Values, for example taken from a raw API request,
+// into a new ReleaseHostReservationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleaseHostReservationParams(u url.Values) (*ReleaseHostReservationParams, error) {
+	p := &ReleaseHostReservationParams{}
+	if err := checkParamNames("releaseHostReservation", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ReleaseHostReservationParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ReleaseHostReservationParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ReleaseHostReservationParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleaseHostReservationParams) Clone() *ReleaseHostReservationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleaseHostReservationParams) Equal(o *ReleaseHostReservationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedReleaseHostReservationParams is used to (un)marshal ReleaseHostReservationParams using the API param names
+type serializedReleaseHostReservationParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ReleaseHostReservationParams) toSerialized() *serializedReleaseHostReservationParams {
+	s := &serializedReleaseHostReservationParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ReleaseHostReservationParams) fromSerialized(s *serializedReleaseHostReservationParams) {
+	*p = ReleaseHostReservationParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleaseHostReservationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleaseHostReservationParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleaseHostReservationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleaseHostReservationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleaseHostReservationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleaseHostReservationParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleaseHostReservationParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams {
 	p := &ReleaseHostReservationParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Releases host reservation.
-func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
-	resp, err := s.cs.newRequest("releaseHostReservation", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams, opts ...CallOption) (*ReleaseHostReservationResponse, error) {
+	resp, err := s.cs.newRequest("releaseHostReservation", s.cs.encodeParams("releaseHostReservation", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4226,8 +6279,8 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4251,223 +6304,347 @@ type ReleaseHostReservationResponse struct {
 }
 
 type UpdateHostParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateHostParams) toURLValues() url.Values {
+	allocationstate optString
+	annotation      optString
+	hosttags        optStrings
+	id              optString
+	istagarule      optBool
+	name            optString
+	oscategoryid    optString
+	url             optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateHostParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["annotation"]; found {
-		u.Set("annotation", v.(string))
+	if p.annotation.ok {
+		u.Set("annotation", p.annotation.v)
 	}
-	if v, found := p.p["hosttags"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("hosttags", vv)
+	if p.hosttags.ok {
+		u.Set("hosttags", strings.Join(p.hosttags.v, ","))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["istagarule"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("istagarule", vv)
+	if p.istagarule.ok {
+		u.Set("istagarule", strconv.FormatBool(p.istagarule.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["oscategoryid"]; found {
-		u.Set("oscategoryid", v.(string))
+	if p.oscategoryid.ok {
+		u.Set("oscategoryid", p.oscategoryid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
 	return u
 }
 
-func (p *UpdateHostParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateHostParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateHostParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateHostParams(u url.Values) (*UpdateHostParams, error) {
+	p := &UpdateHostParams{}
+	if err := checkParamNames("updateHost", u, "allocationstate", "annotation", "hosttags", "id", "istagarule", "name", "oscategoryid", "url"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["annotation"]; found {
+		p.SetAnnotation(u.Get("annotation"))
+	}
+	if _, found := u["hosttags"]; found {
+		p.SetHosttags(strings.Split(u.Get("hosttags"), ","))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["istagarule"]; found {
+		v, err := strconv.ParseBool(u.Get("istagarule"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param istagarule: %v", err)
+		}
+		p.SetIstagarule(v)
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["oscategoryid"]; found {
+		p.SetOscategoryid(u.Get("oscategoryid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *UpdateHostParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *UpdateHostParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *UpdateHostParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetAnnotation sets the annotation param.
 func (p *UpdateHostParams) SetAnnotation(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["annotation"] = v
+	p.annotation = optString{v: v, ok: true}
 }
 
+// ResetAnnotation unsets the annotation param
 func (p *UpdateHostParams) ResetAnnotation() {
-	if p.p != nil && p.p["annotation"] != nil {
-		delete(p.p, "annotation")
-	}
+	p.annotation = optString{}
 }
 
+// GetAnnotation returns the annotation param and if it is set
 func (p *UpdateHostParams) GetAnnotation() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["annotation"].(string)
-	return value, ok
+	return p.annotation.v, p.annotation.ok
 }
 
+// SetHosttags sets the hosttags param.
 func (p *UpdateHostParams) SetHosttags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hosttags"] = v
+	p.hosttags = optStrings{v: v, ok: true}
 }
 
+// ResetHosttags unsets the hosttags param
 func (p *UpdateHostParams) ResetHosttags() {
-	if p.p != nil && p.p["hosttags"] != nil {
-		delete(p.p, "hosttags")
-	}
+	p.hosttags = optStrings{}
 }
 
+// GetHosttags returns the hosttags param and if it is set
 func (p *UpdateHostParams) GetHosttags() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hosttags"].([]string)
-	return value, ok
+	return p.hosttags.v, p.hosttags.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateHostParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateHostParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateHostParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIstagarule sets the istagarule param.
 func (p *UpdateHostParams) SetIstagarule(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["istagarule"] = v
+	p.istagarule = optBool{v: v, ok: true}
 }
 
+// ResetIstagarule unsets the istagarule param
 func (p *UpdateHostParams) ResetIstagarule() {
-	if p.p != nil && p.p["istagarule"] != nil {
-		delete(p.p, "istagarule")
-	}
+	p.istagarule = optBool{}
 }
 
+// GetIstagarule returns the istagarule param and if it is set
 func (p *UpdateHostParams) GetIstagarule() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["istagarule"].(bool)
-	return value, ok
+	return p.istagarule.v, p.istagarule.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateHostParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateHostParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateHostParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOscategoryid sets the oscategoryid param.
 func (p *UpdateHostParams) SetOscategoryid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["oscategoryid"] = v
+	p.oscategoryid = optString{v: v, ok: true}
 }
 
+// ResetOscategoryid unsets the oscategoryid param
 func (p *UpdateHostParams) ResetOscategoryid() {
-	if p.p != nil && p.p["oscategoryid"] != nil {
-		delete(p.p, "oscategoryid")
-	}
+	p.oscategoryid = optString{}
 }
 
+// GetOscategoryid returns the oscategoryid param and if it is set
 func (p *UpdateHostParams) GetOscategoryid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["oscategoryid"].(string)
-	return value, ok
+	return p.oscategoryid.v, p.oscategoryid.ok
 }
 
+// SetUrl sets the url param.
 func (p *UpdateHostParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *UpdateHostParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *UpdateHostParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.url.v, p.url.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateHostParams) Clone() *UpdateHostParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.hosttags = p.hosttags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateHostParams) Equal(o *UpdateHostParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.annotation == o.annotation &&
+		p.hosttags.equal(o.hosttags) &&
+		p.id == o.id &&
+		p.istagarule == o.istagarule &&
+		p.name == o.name &&
+		p.oscategoryid == o.oscategoryid &&
+		p.url == o.url
+}
+
+// serializedUpdateHostParams is used to (un)marshal UpdateHostParams using the API param names
+type serializedUpdateHostParams struct {
+	Allocationstate *string   `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Annotation      *string   `json:"annotation,omitempty" yaml:"annotation,omitempty"`
+	Hosttags        *[]string `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Id              *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Istagarule      *bool     `json:"istagarule,omitempty" yaml:"istagarule,omitempty"`
+	Name            *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Oscategoryid    *string   `json:"oscategoryid,omitempty" yaml:"oscategoryid,omitempty"`
+	Url             *string   `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+func (p *UpdateHostParams) toSerialized() *serializedUpdateHostParams {
+	s := &serializedUpdateHostParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.annotation.ok {
+		s.Annotation = &p.annotation.v
+	}
+	if p.hosttags.ok {
+		s.Hosttags = &p.hosttags.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.istagarule.ok {
+		s.Istagarule = &p.istagarule.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.oscategoryid.ok {
+		s.Oscategoryid = &p.oscategoryid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	return s
+}
+
+func (p *UpdateHostParams) fromSerialized(s *serializedUpdateHostParams) {
+	*p = UpdateHostParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Annotation != nil {
+		p.SetAnnotation(*s.Annotation)
+	}
+	if s.Hosttags != nil {
+		p.SetHosttags(*s.Hosttags)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Istagarule != nil {
+		p.SetIstagarule(*s.Istagarule)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Oscategoryid != nil {
+		p.SetOscategoryid(*s.Oscategoryid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateHostParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateHostParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateHostParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateHostParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateHostParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateHostParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
 	p := &UpdateHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a host.
-func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
-	resp, err := s.cs.newRequest("updateHost", p.toURLValues())
+//
+// Required params: id.
+func (s *HostService) UpdateHost(p *UpdateHostParams, opts ...CallOption) (*UpdateHostResponse, error) {
+	resp, err := s.cs.newRequest("updateHost", s.cs.encodeParams("updateHost", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4563,151 +6740,257 @@ type UpdateHostResponseGpugroupVgpu struct {
 }
 
 type UpdateHostPasswordParams struct {
-	p map[string]interface{}
+	clusterid             optString
+	hostid                optString
+	password              optString
+	update_passwd_on_host optBool
+	username              optString
 }
 
-func (p *UpdateHostPasswordParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateHostPasswordParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["update_passwd_on_host"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("update_passwd_on_host", vv)
+	if p.update_passwd_on_host.ok {
+		u.Set("update_passwd_on_host", strconv.FormatBool(p.update_passwd_on_host.v))
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *UpdateHostPasswordParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateHostPasswordParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateHostPasswordParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateHostPasswordParams(u url.Values) (*UpdateHostPasswordParams, error) {
+	p := &UpdateHostPasswordParams{}
+	if err := checkParamNames("updateHostPassword", u, "clusterid", "hostid", "password", "update_passwd_on_host", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
 	}
-	p.p["clusterid"] = v
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["update_passwd_on_host"]; found {
+		v, err := strconv.ParseBool(u.Get("update_passwd_on_host"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param update_passwd_on_host: %v", err)
+		}
+		p.SetUpdate_passwd_on_host(v)
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param.
+func (p *UpdateHostPasswordParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *UpdateHostPasswordParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *UpdateHostPasswordParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *UpdateHostPasswordParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *UpdateHostPasswordParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *UpdateHostPasswordParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *UpdateHostPasswordParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *UpdateHostPasswordParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *UpdateHostPasswordParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetUpdate_passwd_on_host sets the update_passwd_on_host param.
 func (p *UpdateHostPasswordParams) SetUpdate_passwd_on_host(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["update_passwd_on_host"] = v
+	p.update_passwd_on_host = optBool{v: v, ok: true}
 }
 
+// ResetUpdate_passwd_on_host unsets the update_passwd_on_host param
 func (p *UpdateHostPasswordParams) ResetUpdate_passwd_on_host() {
-	if p.p != nil && p.p["update_passwd_on_host"] != nil {
-		delete(p.p, "update_passwd_on_host")
-	}
+	p.update_passwd_on_host = optBool{}
 }
 
+// GetUpdate_passwd_on_host returns the update_passwd_on_host param and if it is set
 func (p *UpdateHostPasswordParams) GetUpdate_passwd_on_host() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["update_passwd_on_host"].(bool)
-	return value, ok
+	return p.update_passwd_on_host.v, p.update_passwd_on_host.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *UpdateHostPasswordParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *UpdateHostPasswordParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *UpdateHostPasswordParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateHostPasswordParams) Clone() *UpdateHostPasswordParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateHostPasswordParams) Equal(o *UpdateHostPasswordParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid &&
+		p.hostid == o.hostid &&
+		p.password == o.password &&
+		p.update_passwd_on_host == o.update_passwd_on_host &&
+		p.username == o.username
+}
+
+// serializedUpdateHostPasswordParams is used to (un)marshal UpdateHostPasswordParams using the API param names
+type serializedUpdateHostPasswordParams struct {
+	Clusterid             *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Hostid                *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Password              *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Update_passwd_on_host *bool   `json:"update_passwd_on_host,omitempty" yaml:"update_passwd_on_host,omitempty"`
+	Username              *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *UpdateHostPasswordParams) toSerialized() *serializedUpdateHostPasswordParams {
+	s := &serializedUpdateHostPasswordParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.update_passwd_on_host.ok {
+		s.Update_passwd_on_host = &p.update_passwd_on_host.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *UpdateHostPasswordParams) fromSerialized(s *serializedUpdateHostPasswordParams) {
+	*p = UpdateHostPasswordParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Update_passwd_on_host != nil {
+		p.SetUpdate_passwd_on_host(*s.Update_passwd_on_host)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateHostPasswordParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateHostPasswordParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateHostPasswordParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateHostPasswordParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateHostPasswordParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateHostPasswordParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateHostPasswordParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams {
 	p := &UpdateHostPasswordParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["username"] = username
+	p.SetPassword(password)
+	p.SetUsername(username)
 	return p
 }
 
 // Update password of a host/pool on management server.
-func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
-	resp, err := s.cs.newRequest("updateHostPassword", p.toURLValues())
+//
+// Required params: password, username.
+func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams, opts ...CallOption) (*UpdateHostPasswordResponse, error) {
+	resp, err := s.cs.newRequest("updateHostPassword", s.cs.encodeParams("updateHostPassword", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,183 +53,243 @@ func (m *MockHostServiceIface) EXPECT() *MockHostServiceIfaceMockRecorder {
 }
 
 // AddBaremetalHost mocks base method.
-func (m *MockHostServiceIface) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
+func (m *MockHostServiceIface) AddBaremetalHost(p *AddBaremetalHostParams, opts ...CallOption) (*AddBaremetalHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBaremetalHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddBaremetalHost", varargs...)
 	ret0, _ := ret[0].(*AddBaremetalHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBaremetalHost indicates an expected call of AddBaremetalHost.
-func (mr *MockHostServiceIfaceMockRecorder) AddBaremetalHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) AddBaremetalHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddBaremetalHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddBaremetalHost), varargs...)
 }
 
 // AddGloboDnsHost mocks base method.
-func (m *MockHostServiceIface) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
+func (m *MockHostServiceIface) AddGloboDnsHost(p *AddGloboDnsHostParams, opts ...CallOption) (*AddGloboDnsHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddGloboDnsHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGloboDnsHost", varargs...)
 	ret0, _ := ret[0].(*AddGloboDnsHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddGloboDnsHost indicates an expected call of AddGloboDnsHost.
-func (mr *MockHostServiceIfaceMockRecorder) AddGloboDnsHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) AddGloboDnsHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGloboDnsHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddGloboDnsHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGloboDnsHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddGloboDnsHost), varargs...)
 }
 
 // AddHost mocks base method.
-func (m *MockHostServiceIface) AddHost(p *AddHostParams) (*AddHostResponse, error) {
+func (m *MockHostServiceIface) AddHost(p *AddHostParams, opts ...CallOption) (*AddHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddHost", varargs...)
 	ret0, _ := ret[0].(*AddHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddHost indicates an expected call of AddHost.
-func (mr *MockHostServiceIfaceMockRecorder) AddHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) AddHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddHost), varargs...)
 }
 
 // AddSecondaryStorage mocks base method.
-func (m *MockHostServiceIface) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+func (m *MockHostServiceIface) AddSecondaryStorage(p *AddSecondaryStorageParams, opts ...CallOption) (*AddSecondaryStorageResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSecondaryStorage", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddSecondaryStorage", varargs...)
 	ret0, _ := ret[0].(*AddSecondaryStorageResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddSecondaryStorage indicates an expected call of AddSecondaryStorage.
-func (mr *MockHostServiceIfaceMockRecorder) AddSecondaryStorage(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) AddSecondaryStorage(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecondaryStorage", reflect.TypeOf((*MockHostServiceIface)(nil).AddSecondaryStorage), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecondaryStorage", reflect.TypeOf((*MockHostServiceIface)(nil).AddSecondaryStorage), varargs...)
 }
 
 // CancelHostMaintenance mocks base method.
-func (m *MockHostServiceIface) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+func (m *MockHostServiceIface) CancelHostMaintenance(p *CancelHostMaintenanceParams, opts ...CallOption) (*CancelHostMaintenanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelHostMaintenance", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelHostMaintenance", varargs...)
 	ret0, _ := ret[0].(*CancelHostMaintenanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CancelHostMaintenance indicates an expected call of CancelHostMaintenance.
-func (mr *MockHostServiceIfaceMockRecorder) CancelHostMaintenance(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) CancelHostMaintenance(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostMaintenance", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostMaintenance), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostMaintenance", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostMaintenance), varargs...)
 }
 
 // ConfigureHAForHost mocks base method.
-func (m *MockHostServiceIface) ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
+func (m *MockHostServiceIface) ConfigureHAForHost(p *ConfigureHAForHostParams, opts ...CallOption) (*HAForHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigureHAForHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfigureHAForHost", varargs...)
 	ret0, _ := ret[0].(*HAForHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfigureHAForHost indicates an expected call of ConfigureHAForHost.
-func (mr *MockHostServiceIfaceMockRecorder) ConfigureHAForHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ConfigureHAForHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureHAForHost", reflect.TypeOf((*MockHostServiceIface)(nil).ConfigureHAForHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureHAForHost", reflect.TypeOf((*MockHostServiceIface)(nil).ConfigureHAForHost), varargs...)
 }
 
 // DedicateHost mocks base method.
-func (m *MockHostServiceIface) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
+func (m *MockHostServiceIface) DedicateHost(p *DedicateHostParams, opts ...CallOption) (*DedicateHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DedicateHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DedicateHost", varargs...)
 	ret0, _ := ret[0].(*DedicateHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DedicateHost indicates an expected call of DedicateHost.
-func (mr *MockHostServiceIfaceMockRecorder) DedicateHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) DedicateHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateHost", reflect.TypeOf((*MockHostServiceIface)(nil).DedicateHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateHost", reflect.TypeOf((*MockHostServiceIface)(nil).DedicateHost), varargs...)
 }
 
 // DeleteHost mocks base method.
-func (m *MockHostServiceIface) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
+func (m *MockHostServiceIface) DeleteHost(p *DeleteHostParams, opts ...CallOption) (*DeleteHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteHost", varargs...)
 	ret0, _ := ret[0].(*DeleteHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteHost indicates an expected call of DeleteHost.
-func (mr *MockHostServiceIfaceMockRecorder) DeleteHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) DeleteHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHost", reflect.TypeOf((*MockHostServiceIface)(nil).DeleteHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHost", reflect.TypeOf((*MockHostServiceIface)(nil).DeleteHost), varargs...)
 }
 
 // DisableOutOfBandManagementForHost mocks base method.
-func (m *MockHostServiceIface) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
+func (m *MockHostServiceIface) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams, opts ...CallOption) (*DisableOutOfBandManagementForHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForHost", varargs...)
 	ret0, _ := ret[0].(*DisableOutOfBandManagementForHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableOutOfBandManagementForHost indicates an expected call of DisableOutOfBandManagementForHost.
-func (mr *MockHostServiceIfaceMockRecorder) DisableOutOfBandManagementForHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) DisableOutOfBandManagementForHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForHost", reflect.TypeOf((*MockHostServiceIface)(nil).DisableOutOfBandManagementForHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForHost", reflect.TypeOf((*MockHostServiceIface)(nil).DisableOutOfBandManagementForHost), varargs...)
 }
 
 // EnableHAForHost mocks base method.
-func (m *MockHostServiceIface) EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
+func (m *MockHostServiceIface) EnableHAForHost(p *EnableHAForHostParams, opts ...CallOption) (*EnableHAForHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableHAForHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableHAForHost", varargs...)
 	ret0, _ := ret[0].(*EnableHAForHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableHAForHost indicates an expected call of EnableHAForHost.
-func (mr *MockHostServiceIfaceMockRecorder) EnableHAForHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) EnableHAForHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForHost", reflect.TypeOf((*MockHostServiceIface)(nil).EnableHAForHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForHost", reflect.TypeOf((*MockHostServiceIface)(nil).EnableHAForHost), varargs...)
 }
 
 // EnableOutOfBandManagementForHost mocks base method.
-func (m *MockHostServiceIface) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
+func (m *MockHostServiceIface) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams, opts ...CallOption) (*EnableOutOfBandManagementForHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForHost", varargs...)
 	ret0, _ := ret[0].(*EnableOutOfBandManagementForHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableOutOfBandManagementForHost indicates an expected call of EnableOutOfBandManagementForHost.
-func (mr *MockHostServiceIfaceMockRecorder) EnableOutOfBandManagementForHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) EnableOutOfBandManagementForHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForHost", reflect.TypeOf((*MockHostServiceIface)(nil).EnableOutOfBandManagementForHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForHost", reflect.TypeOf((*MockHostServiceIface)(nil).EnableOutOfBandManagementForHost), varargs...)
 }
 
 // FindHostsForMigration mocks base method.
-func (m *MockHostServiceIface) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+func (m *MockHostServiceIface) FindHostsForMigration(p *FindHostsForMigrationParams, opts ...CallOption) (*FindHostsForMigrationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindHostsForMigration", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindHostsForMigration", varargs...)
 	ret0, _ := ret[0].(*FindHostsForMigrationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindHostsForMigration indicates an expected call of FindHostsForMigration.
-func (mr *MockHostServiceIfaceMockRecorder) FindHostsForMigration(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) FindHostsForMigration(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHostsForMigration", reflect.TypeOf((*MockHostServiceIface)(nil).FindHostsForMigration), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHostsForMigration", reflect.TypeOf((*MockHostServiceIface)(nil).FindHostsForMigration), varargs...)
 }
 
 // GetHostByID mocks base method.
@@ -380,63 +440,83 @@ func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricID(name interface{}, o
 }
 
 // ListDedicatedHosts mocks base method.
-func (m *MockHostServiceIface) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+func (m *MockHostServiceIface) ListDedicatedHosts(p *ListDedicatedHostsParams, opts ...CallOption) (*ListDedicatedHostsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDedicatedHosts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedHosts", varargs...)
 	ret0, _ := ret[0].(*ListDedicatedHostsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDedicatedHosts indicates an expected call of ListDedicatedHosts.
-func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHosts(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHosts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHosts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHosts), varargs...)
 }
 
 // ListHostTags mocks base method.
-func (m *MockHostServiceIface) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+func (m *MockHostServiceIface) ListHostTags(p *ListHostTagsParams, opts ...CallOption) (*ListHostTagsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHostTags", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostTags", varargs...)
 	ret0, _ := ret[0].(*ListHostTagsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListHostTags indicates an expected call of ListHostTags.
-func (mr *MockHostServiceIfaceMockRecorder) ListHostTags(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ListHostTags(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTags", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTags), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTags", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTags), varargs...)
 }
 
 // ListHosts mocks base method.
-func (m *MockHostServiceIface) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
+func (m *MockHostServiceIface) ListHosts(p *ListHostsParams, opts ...CallOption) (*ListHostsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHosts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHosts", varargs...)
 	ret0, _ := ret[0].(*ListHostsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListHosts indicates an expected call of ListHosts.
-func (mr *MockHostServiceIfaceMockRecorder) ListHosts(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ListHosts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListHosts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListHosts), varargs...)
 }
 
 // ListHostsMetrics mocks base method.
-func (m *MockHostServiceIface) ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
+func (m *MockHostServiceIface) ListHostsMetrics(p *ListHostsMetricsParams, opts ...CallOption) (*ListHostsMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHostsMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostsMetrics", varargs...)
 	ret0, _ := ret[0].(*ListHostsMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListHostsMetrics indicates an expected call of ListHostsMetrics.
-func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetrics(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetrics", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetrics", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetrics), varargs...)
 }
 
 // NewAddBaremetalHostParams mocks base method.
@@ -748,91 +828,121 @@ func (mr *MockHostServiceIfaceMockRecorder) NewUpdateHostPasswordParams(password
 }
 
 // PrepareHostForMaintenance mocks base method.
-func (m *MockHostServiceIface) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+func (m *MockHostServiceIface) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams, opts ...CallOption) (*PrepareHostForMaintenanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PrepareHostForMaintenance", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PrepareHostForMaintenance", varargs...)
 	ret0, _ := ret[0].(*PrepareHostForMaintenanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PrepareHostForMaintenance indicates an expected call of PrepareHostForMaintenance.
-func (mr *MockHostServiceIfaceMockRecorder) PrepareHostForMaintenance(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) PrepareHostForMaintenance(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareHostForMaintenance", reflect.TypeOf((*MockHostServiceIface)(nil).PrepareHostForMaintenance), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareHostForMaintenance", reflect.TypeOf((*MockHostServiceIface)(nil).PrepareHostForMaintenance), varargs...)
 }
 
 // ReconnectHost mocks base method.
-func (m *MockHostServiceIface) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+func (m *MockHostServiceIface) ReconnectHost(p *ReconnectHostParams, opts ...CallOption) (*ReconnectHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReconnectHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReconnectHost", varargs...)
 	ret0, _ := ret[0].(*ReconnectHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReconnectHost indicates an expected call of ReconnectHost.
-func (mr *MockHostServiceIfaceMockRecorder) ReconnectHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ReconnectHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconnectHost", reflect.TypeOf((*MockHostServiceIface)(nil).ReconnectHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconnectHost", reflect.TypeOf((*MockHostServiceIface)(nil).ReconnectHost), varargs...)
 }
 
 // ReleaseDedicatedHost mocks base method.
-func (m *MockHostServiceIface) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+func (m *MockHostServiceIface) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams, opts ...CallOption) (*ReleaseDedicatedHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseDedicatedHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseDedicatedHost", varargs...)
 	ret0, _ := ret[0].(*ReleaseDedicatedHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseDedicatedHost indicates an expected call of ReleaseDedicatedHost.
-func (mr *MockHostServiceIfaceMockRecorder) ReleaseDedicatedHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ReleaseDedicatedHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedHost", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseDedicatedHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedHost", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseDedicatedHost), varargs...)
 }
 
 // ReleaseHostReservation mocks base method.
-func (m *MockHostServiceIface) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+func (m *MockHostServiceIface) ReleaseHostReservation(p *ReleaseHostReservationParams, opts ...CallOption) (*ReleaseHostReservationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseHostReservation", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseHostReservation", varargs...)
 	ret0, _ := ret[0].(*ReleaseHostReservationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseHostReservation indicates an expected call of ReleaseHostReservation.
-func (mr *MockHostServiceIfaceMockRecorder) ReleaseHostReservation(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) ReleaseHostReservation(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHostReservation", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseHostReservation), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHostReservation", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseHostReservation), varargs...)
 }
 
 // UpdateHost mocks base method.
-func (m *MockHostServiceIface) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
+func (m *MockHostServiceIface) UpdateHost(p *UpdateHostParams, opts ...CallOption) (*UpdateHostResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHost", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateHost", varargs...)
 	ret0, _ := ret[0].(*UpdateHostResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateHost indicates an expected call of UpdateHost.
-func (mr *MockHostServiceIfaceMockRecorder) UpdateHost(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) UpdateHost(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHost", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateHost), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHost", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateHost), varargs...)
 }
 
 // UpdateHostPassword mocks base method.
-func (m *MockHostServiceIface) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+func (m *MockHostServiceIface) UpdateHostPassword(p *UpdateHostPasswordParams, opts ...CallOption) (*UpdateHostPasswordResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHostPassword", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateHostPassword", varargs...)
 	ret0, _ := ret[0].(*UpdateHostPasswordResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateHostPassword indicates an expected call of UpdateHostPassword.
-func (mr *MockHostServiceIfaceMockRecorder) UpdateHostPassword(p interface{}) *gomock.Call {
+func (mr *MockHostServiceIfaceMockRecorder) UpdateHostPassword(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostPassword", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateHostPassword), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostPassword", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateHostPassword), varargs...)
 }
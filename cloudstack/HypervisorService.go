@@ -28,163 +28,269 @@ import (
 )
 
 type HypervisorServiceIface interface {
-	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams, opts ...CallOption) (*ListHypervisorCapabilitiesResponse, error)
 	NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams
 	GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
-	ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	ListHypervisors(p *ListHypervisorsParams, opts ...CallOption) (*ListHypervisorsResponse, error)
 	NewListHypervisorsParams() *ListHypervisorsParams
-	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams, opts ...CallOption) (*UpdateHypervisorCapabilitiesResponse, error)
 	NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams
 }
 
 type ListHypervisorCapabilitiesParams struct {
-	p map[string]interface{}
+	hypervisor optString
+	id         optString
+	keyword    optString
+	page       optInt
+	pagesize   optInt
 }
 
-func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListHypervisorCapabilitiesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListHypervisorCapabilitiesParams parses url.Values, for example taken from a raw API request,
+// into a new ListHypervisorCapabilitiesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListHypervisorCapabilitiesParams(u url.Values) (*ListHypervisorCapabilitiesParams, error) {
+	p := &ListHypervisorCapabilitiesParams{}
+	if err := checkParamNames("listHypervisorCapabilities", u, "hypervisor", "id", "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["hypervisor"] = v
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
 }
 
+// SetHypervisor sets the hypervisor param.
+func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v string) {
+	p.hypervisor = optString{v: v, ok: true}
+}
+
+// ResetHypervisor unsets the hypervisor param
 func (p *ListHypervisorCapabilitiesParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListHypervisorCapabilitiesParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListHypervisorCapabilitiesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListHypervisorCapabilitiesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListHypervisorCapabilitiesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListHypervisorCapabilitiesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListHypervisorCapabilitiesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListHypervisorCapabilitiesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListHypervisorCapabilitiesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListHypervisorCapabilitiesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListHypervisorCapabilitiesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListHypervisorCapabilitiesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListHypervisorCapabilitiesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListHypervisorCapabilitiesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListHypervisorCapabilitiesParams) Clone() *ListHypervisorCapabilitiesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListHypervisorCapabilitiesParams) Equal(o *ListHypervisorCapabilitiesParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListHypervisorCapabilitiesParams is used to (un)marshal ListHypervisorCapabilitiesParams using the API param names
+type serializedListHypervisorCapabilitiesParams struct {
+	Hypervisor *string `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListHypervisorCapabilitiesParams) toSerialized() *serializedListHypervisorCapabilitiesParams {
+	s := &serializedListHypervisorCapabilitiesParams{}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListHypervisorCapabilitiesParams) fromSerialized(s *serializedListHypervisorCapabilitiesParams) {
+	*p = ListHypervisorCapabilitiesParams{}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListHypervisorCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListHypervisorCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListHypervisorCapabilitiesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListHypervisorCapabilitiesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListHypervisorCapabilitiesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListHypervisorCapabilitiesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListHypervisorCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams {
 	p := &ListHypervisorCapabilitiesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
 	p := &ListHypervisorCapabilitiesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -213,8 +319,8 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 }
 
 // Lists all hypervisor capabilities.
-func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisorCapabilities", p.toURLValues())
+func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams, opts ...CallOption) (*ListHypervisorCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequest("listHypervisorCapabilities", s.cs.encodeParams("listHypervisorCapabilities", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -247,52 +353,129 @@ type HypervisorCapability struct {
 }
 
 type ListHypervisorsParams struct {
-	p map[string]interface{}
+	zoneid optString
 }
 
-func (p *ListHypervisorsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListHypervisorsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListHypervisorsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListHypervisorsParams parses url.Values, for example taken from a raw API request,
+// into a new ListHypervisorsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListHypervisorsParams(u url.Values) (*ListHypervisorsParams, error) {
+	p := &ListHypervisorsParams{}
+	if err := checkParamNames("listHypervisors", u, "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["zoneid"] = v
+	return p, nil
 }
 
+// SetZoneid sets the zoneid param.
+func (p *ListHypervisorsParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
 func (p *ListHypervisorsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListHypervisorsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListHypervisorsParams) Clone() *ListHypervisorsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListHypervisorsParams) Equal(o *ListHypervisorsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.zoneid == o.zoneid
+}
+
+// serializedListHypervisorsParams is used to (un)marshal ListHypervisorsParams using the API param names
+type serializedListHypervisorsParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListHypervisorsParams) toSerialized() *serializedListHypervisorsParams {
+	s := &serializedListHypervisorsParams{}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListHypervisorsParams) fromSerialized(s *serializedListHypervisorsParams) {
+	*p = ListHypervisorsParams{}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListHypervisorsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListHypervisorsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListHypervisorsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListHypervisorsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListHypervisorsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListHypervisorsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListHypervisorsParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
 	p := &ListHypervisorsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// List hypervisors
-func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisors", p.toURLValues())
+// List hypervisors.
+func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams, opts ...CallOption) (*ListHypervisorsResponse, error) {
+	resp, err := s.cs.newRequest("listHypervisors", s.cs.encodeParams("listHypervisors", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -317,202 +500,333 @@ type Hypervisor struct {
 }
 
 type UpdateHypervisorCapabilitiesParams struct {
-	p map[string]interface{}
+	id                   optString
+	maxdatavolumeslimit  optInt
+	maxguestslimit       optInt64
+	maxhostspercluster   optInt
+	securitygroupenabled optBool
+	storagemotionenabled optBool
+	vmsnapshotenabled    optBool
 }
 
-func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateHypervisorCapabilitiesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["maxdatavolumeslimit"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxdatavolumeslimit", vv)
+	if p.maxdatavolumeslimit.ok {
+		u.Set("maxdatavolumeslimit", strconv.Itoa(p.maxdatavolumeslimit.v))
 	}
-	if v, found := p.p["maxguestslimit"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxguestslimit", vv)
+	if p.maxguestslimit.ok {
+		u.Set("maxguestslimit", strconv.FormatInt(p.maxguestslimit.v, 10))
 	}
-	if v, found := p.p["maxhostspercluster"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxhostspercluster", vv)
+	if p.maxhostspercluster.ok {
+		u.Set("maxhostspercluster", strconv.Itoa(p.maxhostspercluster.v))
 	}
-	if v, found := p.p["securitygroupenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("securitygroupenabled", vv)
+	if p.securitygroupenabled.ok {
+		u.Set("securitygroupenabled", strconv.FormatBool(p.securitygroupenabled.v))
 	}
-	if v, found := p.p["storagemotionenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("storagemotionenabled", vv)
+	if p.storagemotionenabled.ok {
+		u.Set("storagemotionenabled", strconv.FormatBool(p.storagemotionenabled.v))
 	}
-	if v, found := p.p["vmsnapshotenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("vmsnapshotenabled", vv)
+	if p.vmsnapshotenabled.ok {
+		u.Set("vmsnapshotenabled", strconv.FormatBool(p.vmsnapshotenabled.v))
 	}
 	return u
 }
 
-func (p *UpdateHypervisorCapabilitiesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateHypervisorCapabilitiesParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateHypervisorCapabilitiesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateHypervisorCapabilitiesParams(u url.Values) (*UpdateHypervisorCapabilitiesParams, error) {
+	p := &UpdateHypervisorCapabilitiesParams{}
+	if err := checkParamNames("updateHypervisorCapabilities", u, "id", "maxdatavolumeslimit", "maxguestslimit", "maxhostspercluster", "securitygroupenabled", "storagemotionenabled", "vmsnapshotenabled"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["maxdatavolumeslimit"]; found {
+		v, err := strconv.Atoi(u.Get("maxdatavolumeslimit"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxdatavolumeslimit: %v", err)
+		}
+		p.SetMaxdatavolumeslimit(v)
+	}
+	if _, found := u["maxguestslimit"]; found {
+		v, err := strconv.ParseInt(u.Get("maxguestslimit"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxguestslimit: %v", err)
+		}
+		p.SetMaxguestslimit(v)
+	}
+	if _, found := u["maxhostspercluster"]; found {
+		v, err := strconv.Atoi(u.Get("maxhostspercluster"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxhostspercluster: %v", err)
+		}
+		p.SetMaxhostspercluster(v)
+	}
+	if _, found := u["securitygroupenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("securitygroupenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param securitygroupenabled: %v", err)
+		}
+		p.SetSecuritygroupenabled(v)
+	}
+	if _, found := u["storagemotionenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("storagemotionenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param storagemotionenabled: %v", err)
+		}
+		p.SetStoragemotionenabled(v)
+	}
+	if _, found := u["vmsnapshotenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("vmsnapshotenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param vmsnapshotenabled: %v", err)
+		}
+		p.SetVmsnapshotenabled(v)
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *UpdateHypervisorCapabilitiesParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateHypervisorCapabilitiesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateHypervisorCapabilitiesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMaxdatavolumeslimit sets the maxdatavolumeslimit param.
 func (p *UpdateHypervisorCapabilitiesParams) SetMaxdatavolumeslimit(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxdatavolumeslimit"] = v
+	p.maxdatavolumeslimit = optInt{v: v, ok: true}
 }
 
+// ResetMaxdatavolumeslimit unsets the maxdatavolumeslimit param
 func (p *UpdateHypervisorCapabilitiesParams) ResetMaxdatavolumeslimit() {
-	if p.p != nil && p.p["maxdatavolumeslimit"] != nil {
-		delete(p.p, "maxdatavolumeslimit")
-	}
+	p.maxdatavolumeslimit = optInt{}
 }
 
+// GetMaxdatavolumeslimit returns the maxdatavolumeslimit param and if it is set
 func (p *UpdateHypervisorCapabilitiesParams) GetMaxdatavolumeslimit() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxdatavolumeslimit"].(int)
-	return value, ok
+	return p.maxdatavolumeslimit.v, p.maxdatavolumeslimit.ok
 }
 
+// SetMaxguestslimit sets the maxguestslimit param.
 func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimit(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxguestslimit"] = v
+	p.maxguestslimit = optInt64{v: v, ok: true}
 }
 
+// ResetMaxguestslimit unsets the maxguestslimit param
 func (p *UpdateHypervisorCapabilitiesParams) ResetMaxguestslimit() {
-	if p.p != nil && p.p["maxguestslimit"] != nil {
-		delete(p.p, "maxguestslimit")
-	}
+	p.maxguestslimit = optInt64{}
 }
 
+// GetMaxguestslimit returns the maxguestslimit param and if it is set
 func (p *UpdateHypervisorCapabilitiesParams) GetMaxguestslimit() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxguestslimit"].(int64)
-	return value, ok
+	return p.maxguestslimit.v, p.maxguestslimit.ok
 }
 
+// SetMaxhostspercluster sets the maxhostspercluster param.
 func (p *UpdateHypervisorCapabilitiesParams) SetMaxhostspercluster(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxhostspercluster"] = v
+	p.maxhostspercluster = optInt{v: v, ok: true}
 }
 
+// ResetMaxhostspercluster unsets the maxhostspercluster param
 func (p *UpdateHypervisorCapabilitiesParams) ResetMaxhostspercluster() {
-	if p.p != nil && p.p["maxhostspercluster"] != nil {
-		delete(p.p, "maxhostspercluster")
-	}
+	p.maxhostspercluster = optInt{}
 }
 
+// GetMaxhostspercluster returns the maxhostspercluster param and if it is set
 func (p *UpdateHypervisorCapabilitiesParams) GetMaxhostspercluster() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxhostspercluster"].(int)
-	return value, ok
+	return p.maxhostspercluster.v, p.maxhostspercluster.ok
 }
 
+// SetSecuritygroupenabled sets the securitygroupenabled param.
 func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupenabled"] = v
+	p.securitygroupenabled = optBool{v: v, ok: true}
 }
 
+// ResetSecuritygroupenabled unsets the securitygroupenabled param
 func (p *UpdateHypervisorCapabilitiesParams) ResetSecuritygroupenabled() {
-	if p.p != nil && p.p["securitygroupenabled"] != nil {
-		delete(p.p, "securitygroupenabled")
-	}
+	p.securitygroupenabled = optBool{}
 }
 
+// GetSecuritygroupenabled returns the securitygroupenabled param and if it is set
 func (p *UpdateHypervisorCapabilitiesParams) GetSecuritygroupenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupenabled"].(bool)
-	return value, ok
+	return p.securitygroupenabled.v, p.securitygroupenabled.ok
 }
 
+// SetStoragemotionenabled sets the storagemotionenabled param.
 func (p *UpdateHypervisorCapabilitiesParams) SetStoragemotionenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagemotionenabled"] = v
+	p.storagemotionenabled = optBool{v: v, ok: true}
 }
 
+// ResetStoragemotionenabled unsets the storagemotionenabled param
 func (p *UpdateHypervisorCapabilitiesParams) ResetStoragemotionenabled() {
-	if p.p != nil && p.p["storagemotionenabled"] != nil {
-		delete(p.p, "storagemotionenabled")
-	}
+	p.storagemotionenabled = optBool{}
 }
 
+// GetStoragemotionenabled returns the storagemotionenabled param and if it is set
 func (p *UpdateHypervisorCapabilitiesParams) GetStoragemotionenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagemotionenabled"].(bool)
-	return value, ok
+	return p.storagemotionenabled.v, p.storagemotionenabled.ok
 }
 
+// SetVmsnapshotenabled sets the vmsnapshotenabled param.
 func (p *UpdateHypervisorCapabilitiesParams) SetVmsnapshotenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmsnapshotenabled"] = v
+	p.vmsnapshotenabled = optBool{v: v, ok: true}
 }
 
+// ResetVmsnapshotenabled unsets the vmsnapshotenabled param
 func (p *UpdateHypervisorCapabilitiesParams) ResetVmsnapshotenabled() {
-	if p.p != nil && p.p["vmsnapshotenabled"] != nil {
-		delete(p.p, "vmsnapshotenabled")
-	}
+	p.vmsnapshotenabled = optBool{}
 }
 
+// GetVmsnapshotenabled returns the vmsnapshotenabled param and if it is set
 func (p *UpdateHypervisorCapabilitiesParams) GetVmsnapshotenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmsnapshotenabled.v, p.vmsnapshotenabled.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateHypervisorCapabilitiesParams) Clone() *UpdateHypervisorCapabilitiesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateHypervisorCapabilitiesParams) Equal(o *UpdateHypervisorCapabilitiesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.maxdatavolumeslimit == o.maxdatavolumeslimit &&
+		p.maxguestslimit == o.maxguestslimit &&
+		p.maxhostspercluster == o.maxhostspercluster &&
+		p.securitygroupenabled == o.securitygroupenabled &&
+		p.storagemotionenabled == o.storagemotionenabled &&
+		p.vmsnapshotenabled == o.vmsnapshotenabled
+}
+
+// serializedUpdateHypervisorCapabilitiesParams is used to (un)marshal UpdateHypervisorCapabilitiesParams using the API param names
+type serializedUpdateHypervisorCapabilitiesParams struct {
+	Id                   *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Maxdatavolumeslimit  *int    `json:"maxdatavolumeslimit,omitempty" yaml:"maxdatavolumeslimit,omitempty"`
+	Maxguestslimit       *int64  `json:"maxguestslimit,omitempty" yaml:"maxguestslimit,omitempty"`
+	Maxhostspercluster   *int    `json:"maxhostspercluster,omitempty" yaml:"maxhostspercluster,omitempty"`
+	Securitygroupenabled *bool   `json:"securitygroupenabled,omitempty" yaml:"securitygroupenabled,omitempty"`
+	Storagemotionenabled *bool   `json:"storagemotionenabled,omitempty" yaml:"storagemotionenabled,omitempty"`
+	Vmsnapshotenabled    *bool   `json:"vmsnapshotenabled,omitempty" yaml:"vmsnapshotenabled,omitempty"`
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) toSerialized() *serializedUpdateHypervisorCapabilitiesParams {
+	s := &serializedUpdateHypervisorCapabilitiesParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.maxdatavolumeslimit.ok {
+		s.Maxdatavolumeslimit = &p.maxdatavolumeslimit.v
+	}
+	if p.maxguestslimit.ok {
+		s.Maxguestslimit = &p.maxguestslimit.v
+	}
+	if p.maxhostspercluster.ok {
+		s.Maxhostspercluster = &p.maxhostspercluster.v
+	}
+	if p.securitygroupenabled.ok {
+		s.Securitygroupenabled = &p.securitygroupenabled.v
+	}
+	if p.storagemotionenabled.ok {
+		s.Storagemotionenabled = &p.storagemotionenabled.v
+	}
+	if p.vmsnapshotenabled.ok {
+		s.Vmsnapshotenabled = &p.vmsnapshotenabled.v
+	}
+	return s
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) fromSerialized(s *serializedUpdateHypervisorCapabilitiesParams) {
+	*p = UpdateHypervisorCapabilitiesParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Maxdatavolumeslimit != nil {
+		p.SetMaxdatavolumeslimit(*s.Maxdatavolumeslimit)
+	}
+	if s.Maxguestslimit != nil {
+		p.SetMaxguestslimit(*s.Maxguestslimit)
+	}
+	if s.Maxhostspercluster != nil {
+		p.SetMaxhostspercluster(*s.Maxhostspercluster)
+	}
+	if s.Securitygroupenabled != nil {
+		p.SetSecuritygroupenabled(*s.Securitygroupenabled)
+	}
+	if s.Storagemotionenabled != nil {
+		p.SetStoragemotionenabled(*s.Storagemotionenabled)
+	}
+	if s.Vmsnapshotenabled != nil {
+		p.SetVmsnapshotenabled(*s.Vmsnapshotenabled)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateHypervisorCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateHypervisorCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateHypervisorCapabilitiesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateHypervisorCapabilitiesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateHypervisorCapabilitiesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateHypervisorCapabilitiesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["vmsnapshotenabled"].(bool)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateHypervisorCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams {
 	p := &UpdateHypervisorCapabilitiesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Updates a hypervisor capabilities.
-func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("updateHypervisorCapabilities", p.toURLValues())
+func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams, opts ...CallOption) (*UpdateHypervisorCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequest("updateHypervisorCapabilities", s.cs.encodeParams("updateHypervisorCapabilities", p), opts...)
 	if err != nil {
 		return nil, err
 	}
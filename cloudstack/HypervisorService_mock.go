@@ -74,33 +74,43 @@ func (mr *MockHypervisorServiceIfaceMockRecorder) GetHypervisorCapabilityByID(id
 }
 
 // ListHypervisorCapabilities mocks base method.
-func (m *MockHypervisorServiceIface) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+func (m *MockHypervisorServiceIface) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams, opts ...CallOption) (*ListHypervisorCapabilitiesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHypervisorCapabilities", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHypervisorCapabilities", varargs...)
 	ret0, _ := ret[0].(*ListHypervisorCapabilitiesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListHypervisorCapabilities indicates an expected call of ListHypervisorCapabilities.
-func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilities(p interface{}) *gomock.Call {
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilities(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilities", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilities), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilities", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilities), varargs...)
 }
 
 // ListHypervisors mocks base method.
-func (m *MockHypervisorServiceIface) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+func (m *MockHypervisorServiceIface) ListHypervisors(p *ListHypervisorsParams, opts ...CallOption) (*ListHypervisorsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHypervisors", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHypervisors", varargs...)
 	ret0, _ := ret[0].(*ListHypervisorsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListHypervisors indicates an expected call of ListHypervisors.
-func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisors(p interface{}) *gomock.Call {
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisors(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisors", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisors), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisors", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisors), varargs...)
 }
 
 // NewListHypervisorCapabilitiesParams mocks base method.
@@ -146,16 +156,21 @@ func (mr *MockHypervisorServiceIfaceMockRecorder) NewUpdateHypervisorCapabilitie
 }
 
 // UpdateHypervisorCapabilities mocks base method.
-func (m *MockHypervisorServiceIface) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+func (m *MockHypervisorServiceIface) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams, opts ...CallOption) (*UpdateHypervisorCapabilitiesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHypervisorCapabilities", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateHypervisorCapabilities", varargs...)
 	ret0, _ := ret[0].(*UpdateHypervisorCapabilitiesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateHypervisorCapabilities indicates an expected call of UpdateHypervisorCapabilities.
-func (mr *MockHypervisorServiceIfaceMockRecorder) UpdateHypervisorCapabilities(p interface{}) *gomock.Call {
+func (mr *MockHypervisorServiceIfaceMockRecorder) UpdateHypervisorCapabilities(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHypervisorCapabilities", reflect.TypeOf((*MockHypervisorServiceIface)(nil).UpdateHypervisorCapabilities), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHypervisorCapabilities", reflect.TypeOf((*MockHypervisorServiceIface)(nil).UpdateHypervisorCapabilities), varargs...)
 }
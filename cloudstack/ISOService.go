@@ -28,130 +28,225 @@ import (
 )
 
 type ISOServiceIface interface {
-	AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIso(p *AttachIsoParams, opts ...CallOption) (*AttachIsoResponse, error)
 	NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams
-	CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIso(p *CopyIsoParams, opts ...CallOption) (*CopyIsoResponse, error)
 	NewCopyIsoParams(id string) *CopyIsoParams
-	DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIso(p *DeleteIsoParams, opts ...CallOption) (*DeleteIsoResponse, error)
 	NewDeleteIsoParams(id string) *DeleteIsoParams
-	DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIso(p *DetachIsoParams, opts ...CallOption) (*DetachIsoResponse, error)
 	NewDetachIsoParams(virtualmachineid string) *DetachIsoParams
-	ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIso(p *ExtractIsoParams, opts ...CallOption) (*ExtractIsoResponse, error)
 	NewExtractIsoParams(id string, mode string) *ExtractIsoParams
-	ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	ListIsoPermissions(p *ListIsoPermissionsParams, opts ...CallOption) (*ListIsoPermissionsResponse, error)
 	NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams
 	GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error)
-	ListIsos(p *ListIsosParams) (*ListIsosResponse, error)
+	ListIsos(p *ListIsosParams, opts ...CallOption) (*ListIsosResponse, error)
 	NewListIsosParams() *ListIsosParams
 	GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
 	GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
 	GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error)
-	RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	RegisterIso(p *RegisterIsoParams, opts ...CallOption) (*RegisterIsoResponse, error)
 	NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams
-	UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	UpdateIso(p *UpdateIsoParams, opts ...CallOption) (*UpdateIsoResponse, error)
 	NewUpdateIsoParams(id string) *UpdateIsoParams
-	UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissions(p *UpdateIsoPermissionsParams, opts ...CallOption) (*UpdateIsoPermissionsResponse, error)
 	NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams
 }
 
 type AttachIsoParams struct {
-	p map[string]interface{}
+	forced           optBool
+	id               optString
+	virtualmachineid optString
 }
 
-func (p *AttachIsoParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AttachIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *AttachIsoParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAttachIsoParams parses url.Values, for example taken from a raw API request,
+// into a new AttachIsoParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAttachIsoParams(u url.Values) (*AttachIsoParams, error) {
+	p := &AttachIsoParams{}
+	if err := checkParamNames("attachIso", u, "forced", "id", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
 	}
-	p.p["forced"] = v
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *AttachIsoParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *AttachIsoParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *AttachIsoParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *AttachIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *AttachIsoParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *AttachIsoParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *AttachIsoParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *AttachIsoParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *AttachIsoParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AttachIsoParams) Clone() *AttachIsoParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AttachIsoParams) Equal(o *AttachIsoParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedAttachIsoParams is used to (un)marshal AttachIsoParams using the API param names
+type serializedAttachIsoParams struct {
+	Forced           *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *AttachIsoParams) toSerialized() *serializedAttachIsoParams {
+	s := &serializedAttachIsoParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *AttachIsoParams) fromSerialized(s *serializedAttachIsoParams) {
+	*p = AttachIsoParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AttachIsoParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AttachIsoParams) UnmarshalJSON(b []byte) error {
+	var s serializedAttachIsoParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AttachIsoParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AttachIsoParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAttachIsoParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AttachIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams {
 	p := &AttachIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetId(id)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Attaches an ISO to a virtual machine.
-func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
-	resp, err := s.cs.newRequest("attachIso", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, virtualmachineid.
+func (s *ISOService) AttachIso(p *AttachIsoParams, opts ...CallOption) (*AttachIsoResponse, error) {
+	resp, err := s.cs.newRequest("attachIso", s.cs.encodeParams("attachIso", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -162,8 +257,8 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -345,126 +440,224 @@ func (r *AttachIsoResponse) UnmarshalJSON(b []byte) error {
 }
 
 type CopyIsoParams struct {
-	p map[string]interface{}
+	destzoneid   optString
+	destzoneids  optStrings
+	id           optString
+	sourcezoneid optString
 }
 
-func (p *CopyIsoParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CopyIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["destzoneid"]; found {
-		u.Set("destzoneid", v.(string))
+	if p.destzoneid.ok {
+		u.Set("destzoneid", p.destzoneid.v)
 	}
-	if v, found := p.p["destzoneids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("destzoneids", vv)
+	if p.destzoneids.ok {
+		u.Set("destzoneids", strings.Join(p.destzoneids.v, ","))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["sourcezoneid"]; found {
-		u.Set("sourcezoneid", v.(string))
+	if p.sourcezoneid.ok {
+		u.Set("sourcezoneid", p.sourcezoneid.v)
 	}
 	return u
 }
 
-func (p *CopyIsoParams) SetDestzoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCopyIsoParams parses url.Values, for example taken from a raw API request,
+// into a new CopyIsoParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCopyIsoParams(u url.Values) (*CopyIsoParams, error) {
+	p := &CopyIsoParams{}
+	if err := checkParamNames("copyIso", u, "destzoneid", "destzoneids", "id", "sourcezoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["destzoneid"]; found {
+		p.SetDestzoneid(u.Get("destzoneid"))
 	}
-	p.p["destzoneid"] = v
+	if _, found := u["destzoneids"]; found {
+		p.SetDestzoneids(strings.Split(u.Get("destzoneids"), ","))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["sourcezoneid"]; found {
+		p.SetSourcezoneid(u.Get("sourcezoneid"))
+	}
+	return p, nil
+}
+
+// SetDestzoneid sets the destzoneid param.
+func (p *CopyIsoParams) SetDestzoneid(v string) {
+	p.destzoneid = optString{v: v, ok: true}
 }
 
+// ResetDestzoneid unsets the destzoneid param
 func (p *CopyIsoParams) ResetDestzoneid() {
-	if p.p != nil && p.p["destzoneid"] != nil {
-		delete(p.p, "destzoneid")
-	}
+	p.destzoneid = optString{}
 }
 
+// GetDestzoneid returns the destzoneid param and if it is set
 func (p *CopyIsoParams) GetDestzoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["destzoneid"].(string)
-	return value, ok
+	return p.destzoneid.v, p.destzoneid.ok
 }
 
+// SetDestzoneids sets the destzoneids param.
 func (p *CopyIsoParams) SetDestzoneids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["destzoneids"] = v
+	p.destzoneids = optStrings{v: v, ok: true}
 }
 
+// ResetDestzoneids unsets the destzoneids param
 func (p *CopyIsoParams) ResetDestzoneids() {
-	if p.p != nil && p.p["destzoneids"] != nil {
-		delete(p.p, "destzoneids")
-	}
+	p.destzoneids = optStrings{}
 }
 
+// GetDestzoneids returns the destzoneids param and if it is set
 func (p *CopyIsoParams) GetDestzoneids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["destzoneids"].([]string)
-	return value, ok
+	return p.destzoneids.v, p.destzoneids.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *CopyIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *CopyIsoParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *CopyIsoParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetSourcezoneid sets the sourcezoneid param.
 func (p *CopyIsoParams) SetSourcezoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcezoneid"] = v
+	p.sourcezoneid = optString{v: v, ok: true}
 }
 
+// ResetSourcezoneid unsets the sourcezoneid param
 func (p *CopyIsoParams) ResetSourcezoneid() {
-	if p.p != nil && p.p["sourcezoneid"] != nil {
-		delete(p.p, "sourcezoneid")
-	}
+	p.sourcezoneid = optString{}
 }
 
+// GetSourcezoneid returns the sourcezoneid param and if it is set
 func (p *CopyIsoParams) GetSourcezoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.sourcezoneid.v, p.sourcezoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CopyIsoParams) Clone() *CopyIsoParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.destzoneids = p.destzoneids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CopyIsoParams) Equal(o *CopyIsoParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.destzoneid == o.destzoneid &&
+		p.destzoneids.equal(o.destzoneids) &&
+		p.id == o.id &&
+		p.sourcezoneid == o.sourcezoneid
+}
+
+// serializedCopyIsoParams is used to (un)marshal CopyIsoParams using the API param names
+type serializedCopyIsoParams struct {
+	Destzoneid   *string   `json:"destzoneid,omitempty" yaml:"destzoneid,omitempty"`
+	Destzoneids  *[]string `json:"destzoneids,omitempty" yaml:"destzoneids,omitempty"`
+	Id           *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Sourcezoneid *string   `json:"sourcezoneid,omitempty" yaml:"sourcezoneid,omitempty"`
+}
+
+func (p *CopyIsoParams) toSerialized() *serializedCopyIsoParams {
+	s := &serializedCopyIsoParams{}
+	if p.destzoneid.ok {
+		s.Destzoneid = &p.destzoneid.v
+	}
+	if p.destzoneids.ok {
+		s.Destzoneids = &p.destzoneids.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.sourcezoneid.ok {
+		s.Sourcezoneid = &p.sourcezoneid.v
+	}
+	return s
+}
+
+func (p *CopyIsoParams) fromSerialized(s *serializedCopyIsoParams) {
+	*p = CopyIsoParams{}
+	if s.Destzoneid != nil {
+		p.SetDestzoneid(*s.Destzoneid)
+	}
+	if s.Destzoneids != nil {
+		p.SetDestzoneids(*s.Destzoneids)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Sourcezoneid != nil {
+		p.SetSourcezoneid(*s.Sourcezoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CopyIsoParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CopyIsoParams) UnmarshalJSON(b []byte) error {
+	var s serializedCopyIsoParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CopyIsoParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CopyIsoParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCopyIsoParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["sourcezoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CopyIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewCopyIsoParams(id string) *CopyIsoParams {
 	p := &CopyIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Copies an iso from one zone to another.
-func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
-	resp, err := s.cs.newRequest("copyIso", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *ISOService) CopyIso(p *CopyIsoParams, opts ...CallOption) (*CopyIsoResponse, error) {
+	resp, err := s.cs.newRequest("copyIso", s.cs.encodeParams("copyIso", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -475,8 +668,8 @@ func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -582,77 +775,163 @@ func (r *CopyIsoResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteIsoParams struct {
-	p map[string]interface{}
+	id     optString
+	zoneid optString
 }
 
-func (p *DeleteIsoParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *DeleteIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteIsoParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteIsoParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteIsoParams(u url.Values) (*DeleteIsoParams, error) {
+	p := &DeleteIsoParams{}
+	if err := checkParamNames("deleteIso", u, "id", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteIsoParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteIsoParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteIsoParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *DeleteIsoParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *DeleteIsoParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *DeleteIsoParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteIsoParams) Clone() *DeleteIsoParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteIsoParams) Equal(o *DeleteIsoParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.zoneid == o.zoneid
+}
+
+// serializedDeleteIsoParams is used to (un)marshal DeleteIsoParams using the API param names
+type serializedDeleteIsoParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *DeleteIsoParams) toSerialized() *serializedDeleteIsoParams {
+	s := &serializedDeleteIsoParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *DeleteIsoParams) fromSerialized(s *serializedDeleteIsoParams) {
+	*p = DeleteIsoParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteIsoParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteIsoParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteIsoParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteIsoParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteIsoParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteIsoParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
 	p := &DeleteIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes an ISO file.
-func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
-	resp, err := s.cs.newRequest("deleteIso", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *ISOService) DeleteIso(p *DeleteIsoParams, opts ...CallOption) (*DeleteIsoResponse, error) {
+	resp, err := s.cs.newRequest("deleteIso", s.cs.encodeParams("deleteIso", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -663,8 +942,8 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -688,78 +967,167 @@ type DeleteIsoResponse struct {
 }
 
 type DetachIsoParams struct {
-	p map[string]interface{}
+	forced           optBool
+	virtualmachineid optString
 }
 
-func (p *DetachIsoParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DetachIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *DetachIsoParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDetachIsoParams parses url.Values, for example taken from a raw API request,
+// into a new DetachIsoParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDetachIsoParams(u url.Values) (*DetachIsoParams, error) {
+	p := &DetachIsoParams{}
+	if err := checkParamNames("detachIso", u, "forced", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
 	}
-	p.p["forced"] = v
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *DetachIsoParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *DetachIsoParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *DetachIsoParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *DetachIsoParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *DetachIsoParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *DetachIsoParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DetachIsoParams) Clone() *DetachIsoParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DetachIsoParams) Equal(o *DetachIsoParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedDetachIsoParams is used to (un)marshal DetachIsoParams using the API param names
+type serializedDetachIsoParams struct {
+	Forced           *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *DetachIsoParams) toSerialized() *serializedDetachIsoParams {
+	s := &serializedDetachIsoParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *DetachIsoParams) fromSerialized(s *serializedDetachIsoParams) {
+	*p = DetachIsoParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DetachIsoParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DetachIsoParams) UnmarshalJSON(b []byte) error {
+	var s serializedDetachIsoParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DetachIsoParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DetachIsoParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDetachIsoParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DetachIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParams {
 	p := &DetachIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Detaches any ISO file (if any) currently attached to a virtual machine.
-func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
-	resp, err := s.cs.newRequest("detachIso", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *ISOService) DetachIso(p *DetachIsoParams, opts ...CallOption) (*DetachIsoResponse, error) {
+	resp, err := s.cs.newRequest("detachIso", s.cs.encodeParams("detachIso", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -770,8 +1138,8 @@ func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -953,126 +1321,224 @@ func (r *DetachIsoResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ExtractIsoParams struct {
-	p map[string]interface{}
+	id     optString
+	mode   optString
+	url    optString
+	zoneid optString
 }
 
-func (p *ExtractIsoParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ExtractIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["mode"]; found {
-		u.Set("mode", v.(string))
+	if p.mode.ok {
+		u.Set("mode", p.mode.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ExtractIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseExtractIsoParams parses url.Values, for example taken from a raw API request,
+// into a new ExtractIsoParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseExtractIsoParams(u url.Values) (*ExtractIsoParams, error) {
+	p := &ExtractIsoParams{}
+	if err := checkParamNames("extractIso", u, "id", "mode", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["mode"]; found {
+		p.SetMode(u.Get("mode"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
 	}
-	p.p["id"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ExtractIsoParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ExtractIsoParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ExtractIsoParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMode sets the mode param. This param is required.
 func (p *ExtractIsoParams) SetMode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["mode"] = v
+	p.mode = optString{v: v, ok: true}
 }
 
+// ResetMode unsets the mode param
 func (p *ExtractIsoParams) ResetMode() {
-	if p.p != nil && p.p["mode"] != nil {
-		delete(p.p, "mode")
-	}
+	p.mode = optString{}
 }
 
+// GetMode returns the mode param and if it is set
 func (p *ExtractIsoParams) GetMode() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["mode"].(string)
-	return value, ok
+	return p.mode.v, p.mode.ok
 }
 
+// SetUrl sets the url param.
 func (p *ExtractIsoParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *ExtractIsoParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *ExtractIsoParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ExtractIsoParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ExtractIsoParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ExtractIsoParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ExtractIsoParams) Clone() *ExtractIsoParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ExtractIsoParams) Equal(o *ExtractIsoParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.mode == o.mode &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedExtractIsoParams is used to (un)marshal ExtractIsoParams using the API param names
+type serializedExtractIsoParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Mode   *string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ExtractIsoParams) toSerialized() *serializedExtractIsoParams {
+	s := &serializedExtractIsoParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.mode.ok {
+		s.Mode = &p.mode.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ExtractIsoParams) fromSerialized(s *serializedExtractIsoParams) {
+	*p = ExtractIsoParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Mode != nil {
+		p.SetMode(*s.Mode)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ExtractIsoParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ExtractIsoParams) UnmarshalJSON(b []byte) error {
+	var s serializedExtractIsoParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ExtractIsoParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ExtractIsoParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedExtractIsoParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ExtractIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoParams {
 	p := &ExtractIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["mode"] = mode
+	p.SetId(id)
+	p.SetMode(mode)
 	return p
 }
 
-// Extracts an ISO
-func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
-	resp, err := s.cs.newRequest("extractIso", p.toURLValues())
+// Extracts an ISO.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, mode.
+func (s *ISOService) ExtractIso(p *ExtractIsoParams, opts ...CallOption) (*ExtractIsoResponse, error) {
+	resp, err := s.cs.newRequest("extractIso", s.cs.encodeParams("extractIso", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1083,8 +1549,8 @@ func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1125,56 +1591,132 @@ type ExtractIsoResponse struct {
 }
 
 type ListIsoPermissionsParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ListIsoPermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListIsoPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ListIsoPermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListIsoPermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListIsoPermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListIsoPermissionsParams(u url.Values) (*ListIsoPermissionsParams, error) {
+	p := &ListIsoPermissionsParams{}
+	if err := checkParamNames("listIsoPermissions", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ListIsoPermissionsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListIsoPermissionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListIsoPermissionsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListIsoPermissionsParams) Clone() *ListIsoPermissionsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new ListIsoPermissionsParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams {
-	p := &ListIsoPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListIsoPermissionsParams) Equal(o *ListIsoPermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
-	p := &ListIsoPermissionsParams{}
-	p.p = make(map[string]interface{})
+// serializedListIsoPermissionsParams is used to (un)marshal ListIsoPermissionsParams using the API param names
+type serializedListIsoPermissionsParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
 
-	p.p["id"] = id
+func (p *ListIsoPermissionsParams) toSerialized() *serializedListIsoPermissionsParams {
+	s := &serializedListIsoPermissionsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ListIsoPermissionsParams) fromSerialized(s *serializedListIsoPermissionsParams) {
+	*p = ListIsoPermissionsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListIsoPermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListIsoPermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListIsoPermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListIsoPermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListIsoPermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListIsoPermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListIsoPermissionsParams instance,
+// as then you are sure you have configured all required params
+func (s *ISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams {
+	p := &ListIsoPermissionsParams{}
+	p.SetId(id)
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
+	p := &ListIsoPermissionsParams{}
+
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1203,8 +1745,10 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 }
 
 // List iso visibility and all accounts that have permissions to view this iso.
-func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listIsoPermissions", p.toURLValues())
+//
+// Required params: id.
+func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams, opts ...CallOption) (*ListIsoPermissionsResponse, error) {
+	resp, err := s.cs.newRequest("listIsoPermissions", s.cs.encodeParams("listIsoPermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1233,575 +1777,810 @@ type IsoPermission struct {
 }
 
 type ListIsosParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListIsosParams) toURLValues() url.Values {
+	account      optString
+	bootable     optBool
+	domainid     optString
+	hypervisor   optString
+	id           optString
+	imagestoreid optString
+	isofilter    optString
+	ispublic     optBool
+	isready      optBool
+	isrecursive  optBool
+	keyword      optString
+	listall      optBool
+	name         optString
+	page         optInt
+	pagesize     optInt
+	projectid    optString
+	showicon     optBool
+	showremoved  optBool
+	showunique   optBool
+	storageid    optString
+	tags         optStringMap
+	zoneid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListIsosParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootable", vv)
+	if p.bootable.ok {
+		u.Set("bootable", strconv.FormatBool(p.bootable.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["imagestoreid"]; found {
-		u.Set("imagestoreid", v.(string))
+	if p.imagestoreid.ok {
+		u.Set("imagestoreid", p.imagestoreid.v)
 	}
-	if v, found := p.p["isofilter"]; found {
-		u.Set("isofilter", v.(string))
+	if p.isofilter.ok {
+		u.Set("isofilter", p.isofilter.v)
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["isready"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isready", vv)
+	if p.isready.ok {
+		u.Set("isready", strconv.FormatBool(p.isready.v))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["showremoved"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showremoved", vv)
+	if p.showremoved.ok {
+		u.Set("showremoved", strconv.FormatBool(p.showremoved.v))
 	}
-	if v, found := p.p["showunique"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showunique", vv)
+	if p.showunique.ok {
+		u.Set("showunique", strconv.FormatBool(p.showunique.v))
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListIsosParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListIsosParams parses url.Values, for example taken from a raw API request,
+// into a new ListIsosParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListIsosParams(u url.Values) (*ListIsosParams, error) {
+	p := &ListIsosParams{}
+	if err := checkParamNames("listIsos", u, "account", "bootable", "domainid", "hypervisor", "id", "imagestoreid", "isofilter", "ispublic", "isready", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid", "showicon", "showremoved", "showunique", "storageid", "tags[]", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["bootable"]; found {
+		v, err := strconv.ParseBool(u.Get("bootable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bootable: %v", err)
+		}
+		p.SetBootable(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["imagestoreid"]; found {
+		p.SetImagestoreid(u.Get("imagestoreid"))
+	}
+	if _, found := u["isofilter"]; found {
+		p.SetIsofilter(u.Get("isofilter"))
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["isready"]; found {
+		v, err := strconv.ParseBool(u.Get("isready"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isready: %v", err)
+		}
+		p.SetIsready(v)
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["showremoved"]; found {
+		v, err := strconv.ParseBool(u.Get("showremoved"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showremoved: %v", err)
+		}
+		p.SetShowremoved(v)
+	}
+	if _, found := u["showunique"]; found {
+		v, err := strconv.ParseBool(u.Get("showunique"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showunique: %v", err)
+		}
+		p.SetShowunique(v)
 	}
-	p.p["account"] = v
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListIsosParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListIsosParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListIsosParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetBootable sets the bootable param.
 func (p *ListIsosParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bootable"] = v
+	p.bootable = optBool{v: v, ok: true}
 }
 
+// ResetBootable unsets the bootable param
 func (p *ListIsosParams) ResetBootable() {
-	if p.p != nil && p.p["bootable"] != nil {
-		delete(p.p, "bootable")
-	}
+	p.bootable = optBool{}
 }
 
+// GetBootable returns the bootable param and if it is set
 func (p *ListIsosParams) GetBootable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootable"].(bool)
-	return value, ok
+	return p.bootable.v, p.bootable.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListIsosParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListIsosParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListIsosParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListIsosParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListIsosParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListIsosParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListIsosParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListIsosParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListIsosParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetImagestoreid sets the imagestoreid param.
 func (p *ListIsosParams) SetImagestoreid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreid"] = v
+	p.imagestoreid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreid unsets the imagestoreid param
 func (p *ListIsosParams) ResetImagestoreid() {
-	if p.p != nil && p.p["imagestoreid"] != nil {
-		delete(p.p, "imagestoreid")
-	}
+	p.imagestoreid = optString{}
 }
 
+// GetImagestoreid returns the imagestoreid param and if it is set
 func (p *ListIsosParams) GetImagestoreid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreid"].(string)
-	return value, ok
+	return p.imagestoreid.v, p.imagestoreid.ok
 }
 
+// SetIsofilter sets the isofilter param.
 func (p *ListIsosParams) SetIsofilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isofilter"] = v
+	p.isofilter = optString{v: v, ok: true}
 }
 
+// ResetIsofilter unsets the isofilter param
 func (p *ListIsosParams) ResetIsofilter() {
-	if p.p != nil && p.p["isofilter"] != nil {
-		delete(p.p, "isofilter")
-	}
+	p.isofilter = optString{}
 }
 
+// GetIsofilter returns the isofilter param and if it is set
 func (p *ListIsosParams) GetIsofilter() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isofilter"].(string)
-	return value, ok
+	return p.isofilter.v, p.isofilter.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *ListIsosParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *ListIsosParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *ListIsosParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetIsready sets the isready param.
 func (p *ListIsosParams) SetIsready(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isready"] = v
+	p.isready = optBool{v: v, ok: true}
 }
 
+// ResetIsready unsets the isready param
 func (p *ListIsosParams) ResetIsready() {
-	if p.p != nil && p.p["isready"] != nil {
-		delete(p.p, "isready")
-	}
+	p.isready = optBool{}
 }
 
+// GetIsready returns the isready param and if it is set
 func (p *ListIsosParams) GetIsready() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isready"].(bool)
-	return value, ok
+	return p.isready.v, p.isready.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListIsosParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListIsosParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListIsosParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListIsosParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListIsosParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListIsosParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListIsosParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListIsosParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListIsosParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListIsosParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListIsosParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListIsosParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListIsosParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListIsosParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListIsosParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListIsosParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListIsosParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListIsosParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListIsosParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListIsosParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListIsosParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListIsosParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListIsosParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListIsosParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetShowremoved sets the showremoved param.
 func (p *ListIsosParams) SetShowremoved(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showremoved"] = v
+	p.showremoved = optBool{v: v, ok: true}
 }
 
+// ResetShowremoved unsets the showremoved param
 func (p *ListIsosParams) ResetShowremoved() {
-	if p.p != nil && p.p["showremoved"] != nil {
-		delete(p.p, "showremoved")
-	}
+	p.showremoved = optBool{}
 }
 
+// GetShowremoved returns the showremoved param and if it is set
 func (p *ListIsosParams) GetShowremoved() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showremoved"].(bool)
-	return value, ok
+	return p.showremoved.v, p.showremoved.ok
 }
 
+// SetShowunique sets the showunique param.
 func (p *ListIsosParams) SetShowunique(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showunique"] = v
+	p.showunique = optBool{v: v, ok: true}
 }
 
+// ResetShowunique unsets the showunique param
 func (p *ListIsosParams) ResetShowunique() {
-	if p.p != nil && p.p["showunique"] != nil {
-		delete(p.p, "showunique")
-	}
+	p.showunique = optBool{}
 }
 
+// GetShowunique returns the showunique param and if it is set
 func (p *ListIsosParams) GetShowunique() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showunique"].(bool)
-	return value, ok
+	return p.showunique.v, p.showunique.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListIsosParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListIsosParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListIsosParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListIsosParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListIsosParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListIsosParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListIsosParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListIsosParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListIsosParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListIsosParams) Clone() *ListIsosParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListIsosParams) Equal(o *ListIsosParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.bootable == o.bootable &&
+		p.domainid == o.domainid &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.imagestoreid == o.imagestoreid &&
+		p.isofilter == o.isofilter &&
+		p.ispublic == o.ispublic &&
+		p.isready == o.isready &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.showicon == o.showicon &&
+		p.showremoved == o.showremoved &&
+		p.showunique == o.showunique &&
+		p.storageid == o.storageid &&
+		p.tags.equal(o.tags) &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListIsosParams is used to (un)marshal ListIsosParams using the API param names
+type serializedListIsosParams struct {
+	Account      *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Bootable     *bool              `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Domainid     *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hypervisor   *string            `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id           *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Imagestoreid *string            `json:"imagestoreid,omitempty" yaml:"imagestoreid,omitempty"`
+	Isofilter    *string            `json:"isofilter,omitempty" yaml:"isofilter,omitempty"`
+	Ispublic     *bool              `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Isready      *bool              `json:"isready,omitempty" yaml:"isready,omitempty"`
+	Isrecursive  *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword      *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name         *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page         *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Showicon     *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	Showremoved  *bool              `json:"showremoved,omitempty" yaml:"showremoved,omitempty"`
+	Showunique   *bool              `json:"showunique,omitempty" yaml:"showunique,omitempty"`
+	Storageid    *string            `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags         *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Zoneid       *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListIsosParams) toSerialized() *serializedListIsosParams {
+	s := &serializedListIsosParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.bootable.ok {
+		s.Bootable = &p.bootable.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.imagestoreid.ok {
+		s.Imagestoreid = &p.imagestoreid.v
+	}
+	if p.isofilter.ok {
+		s.Isofilter = &p.isofilter.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.isready.ok {
+		s.Isready = &p.isready.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.showremoved.ok {
+		s.Showremoved = &p.showremoved.v
+	}
+	if p.showunique.ok {
+		s.Showunique = &p.showunique.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListIsosParams) fromSerialized(s *serializedListIsosParams) {
+	*p = ListIsosParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Bootable != nil {
+		p.SetBootable(*s.Bootable)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Imagestoreid != nil {
+		p.SetImagestoreid(*s.Imagestoreid)
+	}
+	if s.Isofilter != nil {
+		p.SetIsofilter(*s.Isofilter)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Isready != nil {
+		p.SetIsready(*s.Isready)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.Showremoved != nil {
+		p.SetShowremoved(*s.Showremoved)
+	}
+	if s.Showunique != nil {
+		p.SetShowunique(*s.Showunique)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListIsosParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListIsosParams) UnmarshalJSON(b []byte) error {
+	var s serializedListIsosParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListIsosParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListIsosParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListIsosParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListIsosParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewListIsosParams() *ListIsosParams {
 	p := &ListIsosParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListIsosParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
-	p.p["isofilter"] = isofilter
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetIsofilter(isofilter)
+	p.SetZoneid(zoneid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1849,9 +2628,8 @@ func (s *ISOService) GetIsoByName(name string, isofilter string, zoneid string,
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error) {
 	p := &ListIsosParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1880,8 +2658,8 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 }
 
 // Lists all available ISO files.
-func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
-	resp, err := s.cs.newRequest("listIsos", p.toURLValues())
+func (s *ISOService) ListIsos(p *ListIsosParams, opts ...CallOption) (*ListIsosResponse, error) {
+	resp, err := s.cs.newRequest("listIsos", s.cs.encodeParams("listIsos", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1984,447 +2762,643 @@ func (r *Iso) UnmarshalJSON(b []byte) error {
 }
 
 type RegisterIsoParams struct {
-	p map[string]interface{}
-}
-
-func (p *RegisterIsoParams) toURLValues() url.Values {
+	account               optString
+	bootable              optBool
+	checksum              optString
+	directdownload        optBool
+	displaytext           optString
+	domainid              optString
+	imagestoreuuid        optString
+	isdynamicallyscalable optBool
+	isextractable         optBool
+	isfeatured            optBool
+	ispublic              optBool
+	name                  optString
+	ostypeid              optString
+	passwordenabled       optBool
+	projectid             optString
+	url                   optString
+	zoneid                optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RegisterIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootable", vv)
+	if p.bootable.ok {
+		u.Set("bootable", strconv.FormatBool(p.bootable.v))
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	if p.checksum.ok {
+		u.Set("checksum", p.checksum.v)
 	}
-	if v, found := p.p["directdownload"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("directdownload", vv)
+	if p.directdownload.ok {
+		u.Set("directdownload", strconv.FormatBool(p.directdownload.v))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
+	if p.imagestoreuuid.ok {
+		u.Set("imagestoreuuid", p.imagestoreuuid.v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
+	if p.isdynamicallyscalable.ok {
+		u.Set("isdynamicallyscalable", strconv.FormatBool(p.isdynamicallyscalable.v))
 	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
+	if p.isextractable.ok {
+		u.Set("isextractable", strconv.FormatBool(p.isextractable.v))
 	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
+	if p.isfeatured.ok {
+		u.Set("isfeatured", strconv.FormatBool(p.isfeatured.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
+	if p.passwordenabled.ok {
+		u.Set("passwordenabled", strconv.FormatBool(p.passwordenabled.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *RegisterIsoParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRegisterIsoParams parses url.Values, for example taken from a raw API request,
+// into a new RegisterIsoParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRegisterIsoParams(u url.Values) (*RegisterIsoParams, error) {
+	p := &RegisterIsoParams{}
+	if err := checkParamNames("registerIso", u, "account", "bootable", "checksum", "directdownload", "displaytext", "domainid", "imagestoreuuid", "isdynamicallyscalable", "isextractable", "isfeatured", "ispublic", "name", "ostypeid", "passwordenabled", "projectid", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["bootable"]; found {
+		v, err := strconv.ParseBool(u.Get("bootable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bootable: %v", err)
+		}
+		p.SetBootable(v)
+	}
+	if _, found := u["checksum"]; found {
+		p.SetChecksum(u.Get("checksum"))
+	}
+	if _, found := u["directdownload"]; found {
+		v, err := strconv.ParseBool(u.Get("directdownload"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param directdownload: %v", err)
+		}
+		p.SetDirectdownload(v)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["imagestoreuuid"]; found {
+		p.SetImagestoreuuid(u.Get("imagestoreuuid"))
+	}
+	if _, found := u["isdynamicallyscalable"]; found {
+		v, err := strconv.ParseBool(u.Get("isdynamicallyscalable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdynamicallyscalable: %v", err)
+		}
+		p.SetIsdynamicallyscalable(v)
+	}
+	if _, found := u["isextractable"]; found {
+		v, err := strconv.ParseBool(u.Get("isextractable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isextractable: %v", err)
+		}
+		p.SetIsextractable(v)
 	}
-	p.p["account"] = v
+	if _, found := u["isfeatured"]; found {
+		v, err := strconv.ParseBool(u.Get("isfeatured"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isfeatured: %v", err)
+		}
+		p.SetIsfeatured(v)
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	if _, found := u["passwordenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("passwordenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param passwordenabled: %v", err)
+		}
+		p.SetPasswordenabled(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *RegisterIsoParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *RegisterIsoParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *RegisterIsoParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetBootable sets the bootable param.
 func (p *RegisterIsoParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bootable"] = v
+	p.bootable = optBool{v: v, ok: true}
 }
 
+// ResetBootable unsets the bootable param
 func (p *RegisterIsoParams) ResetBootable() {
-	if p.p != nil && p.p["bootable"] != nil {
-		delete(p.p, "bootable")
-	}
+	p.bootable = optBool{}
 }
 
+// GetBootable returns the bootable param and if it is set
 func (p *RegisterIsoParams) GetBootable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootable"].(bool)
-	return value, ok
+	return p.bootable.v, p.bootable.ok
 }
 
+// SetChecksum sets the checksum param.
 func (p *RegisterIsoParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["checksum"] = v
+	p.checksum = optString{v: v, ok: true}
 }
 
+// ResetChecksum unsets the checksum param
 func (p *RegisterIsoParams) ResetChecksum() {
-	if p.p != nil && p.p["checksum"] != nil {
-		delete(p.p, "checksum")
-	}
+	p.checksum = optString{}
 }
 
+// GetChecksum returns the checksum param and if it is set
 func (p *RegisterIsoParams) GetChecksum() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["checksum"].(string)
-	return value, ok
+	return p.checksum.v, p.checksum.ok
 }
 
+// SetDirectdownload sets the directdownload param.
 func (p *RegisterIsoParams) SetDirectdownload(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["directdownload"] = v
+	p.directdownload = optBool{v: v, ok: true}
 }
 
+// ResetDirectdownload unsets the directdownload param
 func (p *RegisterIsoParams) ResetDirectdownload() {
-	if p.p != nil && p.p["directdownload"] != nil {
-		delete(p.p, "directdownload")
-	}
+	p.directdownload = optBool{}
 }
 
+// GetDirectdownload returns the directdownload param and if it is set
 func (p *RegisterIsoParams) GetDirectdownload() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["directdownload"].(bool)
-	return value, ok
+	return p.directdownload.v, p.directdownload.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *RegisterIsoParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *RegisterIsoParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *RegisterIsoParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *RegisterIsoParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *RegisterIsoParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *RegisterIsoParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetImagestoreuuid sets the imagestoreuuid param.
 func (p *RegisterIsoParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreuuid"] = v
+	p.imagestoreuuid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreuuid unsets the imagestoreuuid param
 func (p *RegisterIsoParams) ResetImagestoreuuid() {
-	if p.p != nil && p.p["imagestoreuuid"] != nil {
-		delete(p.p, "imagestoreuuid")
-	}
+	p.imagestoreuuid = optString{}
 }
 
+// GetImagestoreuuid returns the imagestoreuuid param and if it is set
 func (p *RegisterIsoParams) GetImagestoreuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreuuid"].(string)
-	return value, ok
+	return p.imagestoreuuid.v, p.imagestoreuuid.ok
 }
 
+// SetIsdynamicallyscalable sets the isdynamicallyscalable param.
 func (p *RegisterIsoParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
+	p.isdynamicallyscalable = optBool{v: v, ok: true}
 }
 
+// ResetIsdynamicallyscalable unsets the isdynamicallyscalable param
 func (p *RegisterIsoParams) ResetIsdynamicallyscalable() {
-	if p.p != nil && p.p["isdynamicallyscalable"] != nil {
-		delete(p.p, "isdynamicallyscalable")
-	}
+	p.isdynamicallyscalable = optBool{}
 }
 
+// GetIsdynamicallyscalable returns the isdynamicallyscalable param and if it is set
 func (p *RegisterIsoParams) GetIsdynamicallyscalable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdynamicallyscalable"].(bool)
-	return value, ok
+	return p.isdynamicallyscalable.v, p.isdynamicallyscalable.ok
 }
 
+// SetIsextractable sets the isextractable param.
 func (p *RegisterIsoParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isextractable"] = v
+	p.isextractable = optBool{v: v, ok: true}
 }
 
+// ResetIsextractable unsets the isextractable param
 func (p *RegisterIsoParams) ResetIsextractable() {
-	if p.p != nil && p.p["isextractable"] != nil {
-		delete(p.p, "isextractable")
-	}
+	p.isextractable = optBool{}
 }
 
+// GetIsextractable returns the isextractable param and if it is set
 func (p *RegisterIsoParams) GetIsextractable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isextractable"].(bool)
-	return value, ok
+	return p.isextractable.v, p.isextractable.ok
 }
 
+// SetIsfeatured sets the isfeatured param.
 func (p *RegisterIsoParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
+	p.isfeatured = optBool{v: v, ok: true}
 }
 
+// ResetIsfeatured unsets the isfeatured param
 func (p *RegisterIsoParams) ResetIsfeatured() {
-	if p.p != nil && p.p["isfeatured"] != nil {
-		delete(p.p, "isfeatured")
-	}
+	p.isfeatured = optBool{}
 }
 
+// GetIsfeatured returns the isfeatured param and if it is set
 func (p *RegisterIsoParams) GetIsfeatured() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isfeatured"].(bool)
-	return value, ok
+	return p.isfeatured.v, p.isfeatured.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *RegisterIsoParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *RegisterIsoParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *RegisterIsoParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *RegisterIsoParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *RegisterIsoParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *RegisterIsoParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *RegisterIsoParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *RegisterIsoParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *RegisterIsoParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetPasswordenabled sets the passwordenabled param.
 func (p *RegisterIsoParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["passwordenabled"] = v
+	p.passwordenabled = optBool{v: v, ok: true}
 }
 
+// ResetPasswordenabled unsets the passwordenabled param
 func (p *RegisterIsoParams) ResetPasswordenabled() {
-	if p.p != nil && p.p["passwordenabled"] != nil {
-		delete(p.p, "passwordenabled")
-	}
+	p.passwordenabled = optBool{}
 }
 
+// GetPasswordenabled returns the passwordenabled param and if it is set
 func (p *RegisterIsoParams) GetPasswordenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["passwordenabled"].(bool)
-	return value, ok
+	return p.passwordenabled.v, p.passwordenabled.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *RegisterIsoParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *RegisterIsoParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *RegisterIsoParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *RegisterIsoParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *RegisterIsoParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *RegisterIsoParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *RegisterIsoParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *RegisterIsoParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *RegisterIsoParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RegisterIsoParams) Clone() *RegisterIsoParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RegisterIsoParams) Equal(o *RegisterIsoParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.bootable == o.bootable &&
+		p.checksum == o.checksum &&
+		p.directdownload == o.directdownload &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.imagestoreuuid == o.imagestoreuuid &&
+		p.isdynamicallyscalable == o.isdynamicallyscalable &&
+		p.isextractable == o.isextractable &&
+		p.isfeatured == o.isfeatured &&
+		p.ispublic == o.ispublic &&
+		p.name == o.name &&
+		p.ostypeid == o.ostypeid &&
+		p.passwordenabled == o.passwordenabled &&
+		p.projectid == o.projectid &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedRegisterIsoParams is used to (un)marshal RegisterIsoParams using the API param names
+type serializedRegisterIsoParams struct {
+	Account               *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Bootable              *bool   `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Checksum              *string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Directdownload        *bool   `json:"directdownload,omitempty" yaml:"directdownload,omitempty"`
+	Displaytext           *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Imagestoreuuid        *string `json:"imagestoreuuid,omitempty" yaml:"imagestoreuuid,omitempty"`
+	Isdynamicallyscalable *bool   `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isextractable         *bool   `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured            *bool   `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic              *bool   `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Name                  *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool   `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Projectid             *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Url                   *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid                *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *RegisterIsoParams) toSerialized() *serializedRegisterIsoParams {
+	s := &serializedRegisterIsoParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.bootable.ok {
+		s.Bootable = &p.bootable.v
+	}
+	if p.checksum.ok {
+		s.Checksum = &p.checksum.v
+	}
+	if p.directdownload.ok {
+		s.Directdownload = &p.directdownload.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.imagestoreuuid.ok {
+		s.Imagestoreuuid = &p.imagestoreuuid.v
+	}
+	if p.isdynamicallyscalable.ok {
+		s.Isdynamicallyscalable = &p.isdynamicallyscalable.v
+	}
+	if p.isextractable.ok {
+		s.Isextractable = &p.isextractable.v
+	}
+	if p.isfeatured.ok {
+		s.Isfeatured = &p.isfeatured.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.passwordenabled.ok {
+		s.Passwordenabled = &p.passwordenabled.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *RegisterIsoParams) fromSerialized(s *serializedRegisterIsoParams) {
+	*p = RegisterIsoParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Bootable != nil {
+		p.SetBootable(*s.Bootable)
+	}
+	if s.Checksum != nil {
+		p.SetChecksum(*s.Checksum)
+	}
+	if s.Directdownload != nil {
+		p.SetDirectdownload(*s.Directdownload)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Imagestoreuuid != nil {
+		p.SetImagestoreuuid(*s.Imagestoreuuid)
+	}
+	if s.Isdynamicallyscalable != nil {
+		p.SetIsdynamicallyscalable(*s.Isdynamicallyscalable)
+	}
+	if s.Isextractable != nil {
+		p.SetIsextractable(*s.Isextractable)
+	}
+	if s.Isfeatured != nil {
+		p.SetIsfeatured(*s.Isfeatured)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+	if s.Passwordenabled != nil {
+		p.SetPasswordenabled(*s.Passwordenabled)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RegisterIsoParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RegisterIsoParams) UnmarshalJSON(b []byte) error {
+	var s serializedRegisterIsoParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RegisterIsoParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RegisterIsoParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRegisterIsoParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RegisterIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams {
 	p := &RegisterIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Registers an existing ISO into the CloudStack Cloud.
-func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
-	resp, err := s.cs.newRequest("registerIso", p.toURLValues())
+//
+// Required params: displaytext, name, url, zoneid.
+func (s *ISOService) RegisterIso(p *RegisterIsoParams, opts ...CallOption) (*RegisterIsoResponse, error) {
+	resp, err := s.cs.newRequest("registerIso", s.cs.encodeParams("registerIso", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2526,376 +3500,560 @@ func (r *RegisterIsoResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateIsoParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateIsoParams) toURLValues() url.Values {
+	bootable              optBool
+	cleanupdetails        optBool
+	details               optStringMap
+	displaytext           optString
+	format                optString
+	id                    optString
+	isdynamicallyscalable optBool
+	isrouting             optBool
+	name                  optString
+	ostypeid              optString
+	passwordenabled       optBool
+	requireshvm           optBool
+	sortkey               optInt
+	sshkeyenabled         optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootable", vv)
+	if p.bootable.ok {
+		u.Set("bootable", strconv.FormatBool(p.bootable.v))
 	}
-	if v, found := p.p["cleanupdetails"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanupdetails", vv)
+	if p.cleanupdetails.ok {
+		u.Set("cleanupdetails", strconv.FormatBool(p.cleanupdetails.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if p.format.ok {
+		u.Set("format", p.format.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
+	if p.isdynamicallyscalable.ok {
+		u.Set("isdynamicallyscalable", strconv.FormatBool(p.isdynamicallyscalable.v))
 	}
-	if v, found := p.p["isrouting"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrouting", vv)
+	if p.isrouting.ok {
+		u.Set("isrouting", strconv.FormatBool(p.isrouting.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
+	if p.passwordenabled.ok {
+		u.Set("passwordenabled", strconv.FormatBool(p.passwordenabled.v))
 	}
-	if v, found := p.p["requireshvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("requireshvm", vv)
+	if p.requireshvm.ok {
+		u.Set("requireshvm", strconv.FormatBool(p.requireshvm.v))
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if p.sortkey.ok {
+		u.Set("sortkey", strconv.Itoa(p.sortkey.v))
 	}
-	if v, found := p.p["sshkeyenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sshkeyenabled", vv)
+	if p.sshkeyenabled.ok {
+		u.Set("sshkeyenabled", strconv.FormatBool(p.sshkeyenabled.v))
 	}
 	return u
 }
 
-func (p *UpdateIsoParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateIsoParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateIsoParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateIsoParams(u url.Values) (*UpdateIsoParams, error) {
+	p := &UpdateIsoParams{}
+	if err := checkParamNames("updateIso", u, "bootable", "cleanupdetails", "details[]", "displaytext", "format", "id", "isdynamicallyscalable", "isrouting", "name", "ostypeid", "passwordenabled", "requireshvm", "sortkey", "sshkeyenabled"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bootable"]; found {
+		v, err := strconv.ParseBool(u.Get("bootable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bootable: %v", err)
+		}
+		p.SetBootable(v)
+	}
+	if _, found := u["cleanupdetails"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanupdetails"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanupdetails: %v", err)
+		}
+		p.SetCleanupdetails(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["format"]; found {
+		p.SetFormat(u.Get("format"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isdynamicallyscalable"]; found {
+		v, err := strconv.ParseBool(u.Get("isdynamicallyscalable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdynamicallyscalable: %v", err)
+		}
+		p.SetIsdynamicallyscalable(v)
+	}
+	if _, found := u["isrouting"]; found {
+		v, err := strconv.ParseBool(u.Get("isrouting"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrouting: %v", err)
+		}
+		p.SetIsrouting(v)
 	}
-	p.p["bootable"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	if _, found := u["passwordenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("passwordenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param passwordenabled: %v", err)
+		}
+		p.SetPasswordenabled(v)
+	}
+	if _, found := u["requireshvm"]; found {
+		v, err := strconv.ParseBool(u.Get("requireshvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param requireshvm: %v", err)
+		}
+		p.SetRequireshvm(v)
+	}
+	if _, found := u["sortkey"]; found {
+		v, err := strconv.Atoi(u.Get("sortkey"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sortkey: %v", err)
+		}
+		p.SetSortkey(v)
+	}
+	if _, found := u["sshkeyenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("sshkeyenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sshkeyenabled: %v", err)
+		}
+		p.SetSshkeyenabled(v)
+	}
+	return p, nil
 }
 
+// SetBootable sets the bootable param.
+func (p *UpdateIsoParams) SetBootable(v bool) {
+	p.bootable = optBool{v: v, ok: true}
+}
+
+// ResetBootable unsets the bootable param
 func (p *UpdateIsoParams) ResetBootable() {
-	if p.p != nil && p.p["bootable"] != nil {
-		delete(p.p, "bootable")
-	}
+	p.bootable = optBool{}
 }
 
+// GetBootable returns the bootable param and if it is set
 func (p *UpdateIsoParams) GetBootable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootable"].(bool)
-	return value, ok
+	return p.bootable.v, p.bootable.ok
 }
 
+// SetCleanupdetails sets the cleanupdetails param.
 func (p *UpdateIsoParams) SetCleanupdetails(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cleanupdetails"] = v
+	p.cleanupdetails = optBool{v: v, ok: true}
 }
 
+// ResetCleanupdetails unsets the cleanupdetails param
 func (p *UpdateIsoParams) ResetCleanupdetails() {
-	if p.p != nil && p.p["cleanupdetails"] != nil {
-		delete(p.p, "cleanupdetails")
-	}
+	p.cleanupdetails = optBool{}
 }
 
+// GetCleanupdetails returns the cleanupdetails param and if it is set
 func (p *UpdateIsoParams) GetCleanupdetails() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanupdetails"].(bool)
-	return value, ok
+	return p.cleanupdetails.v, p.cleanupdetails.ok
 }
 
+// SetDetails sets the details param.
 func (p *UpdateIsoParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *UpdateIsoParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *UpdateIsoParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *UpdateIsoParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateIsoParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateIsoParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetFormat sets the format param.
 func (p *UpdateIsoParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
+	p.format = optString{v: v, ok: true}
 }
 
+// ResetFormat unsets the format param
 func (p *UpdateIsoParams) ResetFormat() {
-	if p.p != nil && p.p["format"] != nil {
-		delete(p.p, "format")
-	}
+	p.format = optString{}
 }
 
+// GetFormat returns the format param and if it is set
 func (p *UpdateIsoParams) GetFormat() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["format"].(string)
-	return value, ok
+	return p.format.v, p.format.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateIsoParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateIsoParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsdynamicallyscalable sets the isdynamicallyscalable param.
 func (p *UpdateIsoParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
+	p.isdynamicallyscalable = optBool{v: v, ok: true}
 }
 
+// ResetIsdynamicallyscalable unsets the isdynamicallyscalable param
 func (p *UpdateIsoParams) ResetIsdynamicallyscalable() {
-	if p.p != nil && p.p["isdynamicallyscalable"] != nil {
-		delete(p.p, "isdynamicallyscalable")
-	}
+	p.isdynamicallyscalable = optBool{}
 }
 
+// GetIsdynamicallyscalable returns the isdynamicallyscalable param and if it is set
 func (p *UpdateIsoParams) GetIsdynamicallyscalable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdynamicallyscalable"].(bool)
-	return value, ok
+	return p.isdynamicallyscalable.v, p.isdynamicallyscalable.ok
 }
 
+// SetIsrouting sets the isrouting param.
 func (p *UpdateIsoParams) SetIsrouting(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrouting"] = v
+	p.isrouting = optBool{v: v, ok: true}
 }
 
+// ResetIsrouting unsets the isrouting param
 func (p *UpdateIsoParams) ResetIsrouting() {
-	if p.p != nil && p.p["isrouting"] != nil {
-		delete(p.p, "isrouting")
-	}
+	p.isrouting = optBool{}
 }
 
+// GetIsrouting returns the isrouting param and if it is set
 func (p *UpdateIsoParams) GetIsrouting() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrouting"].(bool)
-	return value, ok
+	return p.isrouting.v, p.isrouting.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateIsoParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateIsoParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateIsoParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *UpdateIsoParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *UpdateIsoParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *UpdateIsoParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetPasswordenabled sets the passwordenabled param.
 func (p *UpdateIsoParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["passwordenabled"] = v
+	p.passwordenabled = optBool{v: v, ok: true}
 }
 
+// ResetPasswordenabled unsets the passwordenabled param
 func (p *UpdateIsoParams) ResetPasswordenabled() {
-	if p.p != nil && p.p["passwordenabled"] != nil {
-		delete(p.p, "passwordenabled")
-	}
+	p.passwordenabled = optBool{}
 }
 
+// GetPasswordenabled returns the passwordenabled param and if it is set
 func (p *UpdateIsoParams) GetPasswordenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["passwordenabled"].(bool)
-	return value, ok
+	return p.passwordenabled.v, p.passwordenabled.ok
 }
 
+// SetRequireshvm sets the requireshvm param.
 func (p *UpdateIsoParams) SetRequireshvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["requireshvm"] = v
+	p.requireshvm = optBool{v: v, ok: true}
 }
 
+// ResetRequireshvm unsets the requireshvm param
 func (p *UpdateIsoParams) ResetRequireshvm() {
-	if p.p != nil && p.p["requireshvm"] != nil {
-		delete(p.p, "requireshvm")
-	}
+	p.requireshvm = optBool{}
 }
 
+// GetRequireshvm returns the requireshvm param and if it is set
 func (p *UpdateIsoParams) GetRequireshvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["requireshvm"].(bool)
-	return value, ok
+	return p.requireshvm.v, p.requireshvm.ok
 }
 
+// SetSortkey sets the sortkey param.
 func (p *UpdateIsoParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
+	p.sortkey = optInt{v: v, ok: true}
 }
 
+// ResetSortkey unsets the sortkey param
 func (p *UpdateIsoParams) ResetSortkey() {
-	if p.p != nil && p.p["sortkey"] != nil {
-		delete(p.p, "sortkey")
-	}
+	p.sortkey = optInt{}
 }
 
+// GetSortkey returns the sortkey param and if it is set
 func (p *UpdateIsoParams) GetSortkey() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sortkey"].(int)
-	return value, ok
+	return p.sortkey.v, p.sortkey.ok
 }
 
+// SetSshkeyenabled sets the sshkeyenabled param.
 func (p *UpdateIsoParams) SetSshkeyenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sshkeyenabled"] = v
+	p.sshkeyenabled = optBool{v: v, ok: true}
 }
 
+// ResetSshkeyenabled unsets the sshkeyenabled param
 func (p *UpdateIsoParams) ResetSshkeyenabled() {
-	if p.p != nil && p.p["sshkeyenabled"] != nil {
-		delete(p.p, "sshkeyenabled")
-	}
+	p.sshkeyenabled = optBool{}
 }
 
+// GetSshkeyenabled returns the sshkeyenabled param and if it is set
 func (p *UpdateIsoParams) GetSshkeyenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.sshkeyenabled.v, p.sshkeyenabled.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateIsoParams) Clone() *UpdateIsoParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateIsoParams) Equal(o *UpdateIsoParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bootable == o.bootable &&
+		p.cleanupdetails == o.cleanupdetails &&
+		p.details.equal(o.details) &&
+		p.displaytext == o.displaytext &&
+		p.format == o.format &&
+		p.id == o.id &&
+		p.isdynamicallyscalable == o.isdynamicallyscalable &&
+		p.isrouting == o.isrouting &&
+		p.name == o.name &&
+		p.ostypeid == o.ostypeid &&
+		p.passwordenabled == o.passwordenabled &&
+		p.requireshvm == o.requireshvm &&
+		p.sortkey == o.sortkey &&
+		p.sshkeyenabled == o.sshkeyenabled
+}
+
+// serializedUpdateIsoParams is used to (un)marshal UpdateIsoParams using the API param names
+type serializedUpdateIsoParams struct {
+	Bootable              *bool              `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Cleanupdetails        *bool              `json:"cleanupdetails,omitempty" yaml:"cleanupdetails,omitempty"`
+	Details               *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Format                *string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Id                    *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdynamicallyscalable *bool              `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isrouting             *bool              `json:"isrouting,omitempty" yaml:"isrouting,omitempty"`
+	Name                  *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string            `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool              `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Requireshvm           *bool              `json:"requireshvm,omitempty" yaml:"requireshvm,omitempty"`
+	Sortkey               *int               `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+	Sshkeyenabled         *bool              `json:"sshkeyenabled,omitempty" yaml:"sshkeyenabled,omitempty"`
+}
+
+func (p *UpdateIsoParams) toSerialized() *serializedUpdateIsoParams {
+	s := &serializedUpdateIsoParams{}
+	if p.bootable.ok {
+		s.Bootable = &p.bootable.v
+	}
+	if p.cleanupdetails.ok {
+		s.Cleanupdetails = &p.cleanupdetails.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.format.ok {
+		s.Format = &p.format.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isdynamicallyscalable.ok {
+		s.Isdynamicallyscalable = &p.isdynamicallyscalable.v
+	}
+	if p.isrouting.ok {
+		s.Isrouting = &p.isrouting.v
 	}
-	value, ok := p.p["sshkeyenabled"].(bool)
-	return value, ok
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.passwordenabled.ok {
+		s.Passwordenabled = &p.passwordenabled.v
+	}
+	if p.requireshvm.ok {
+		s.Requireshvm = &p.requireshvm.v
+	}
+	if p.sortkey.ok {
+		s.Sortkey = &p.sortkey.v
+	}
+	if p.sshkeyenabled.ok {
+		s.Sshkeyenabled = &p.sshkeyenabled.v
+	}
+	return s
+}
+
+func (p *UpdateIsoParams) fromSerialized(s *serializedUpdateIsoParams) {
+	*p = UpdateIsoParams{}
+	if s.Bootable != nil {
+		p.SetBootable(*s.Bootable)
+	}
+	if s.Cleanupdetails != nil {
+		p.SetCleanupdetails(*s.Cleanupdetails)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Format != nil {
+		p.SetFormat(*s.Format)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isdynamicallyscalable != nil {
+		p.SetIsdynamicallyscalable(*s.Isdynamicallyscalable)
+	}
+	if s.Isrouting != nil {
+		p.SetIsrouting(*s.Isrouting)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+	if s.Passwordenabled != nil {
+		p.SetPasswordenabled(*s.Passwordenabled)
+	}
+	if s.Requireshvm != nil {
+		p.SetRequireshvm(*s.Requireshvm)
+	}
+	if s.Sortkey != nil {
+		p.SetSortkey(*s.Sortkey)
+	}
+	if s.Sshkeyenabled != nil {
+		p.SetSshkeyenabled(*s.Sshkeyenabled)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateIsoParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateIsoParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateIsoParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateIsoParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateIsoParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateIsoParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
 	p := &UpdateIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates an ISO file.
-func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
-	resp, err := s.cs.newRequest("updateIso", p.toURLValues())
+//
+// Required params: id.
+func (s *ISOService) UpdateIso(p *UpdateIsoParams, opts ...CallOption) (*UpdateIsoResponse, error) {
+	resp, err := s.cs.newRequest("updateIso", s.cs.encodeParams("updateIso", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2993,202 +4151,326 @@ func (r *UpdateIsoResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateIsoPermissionsParams struct {
-	p map[string]interface{}
+	accounts      optStrings
+	id            optString
+	isextractable optBool
+	isfeatured    optBool
+	ispublic      optBool
+	op            optString
+	projectids    optStrings
 }
 
-func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateIsoPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accounts"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("accounts", vv)
+	if p.accounts.ok {
+		u.Set("accounts", strings.Join(p.accounts.v, ","))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
+	if p.isextractable.ok {
+		u.Set("isextractable", strconv.FormatBool(p.isextractable.v))
 	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
+	if p.isfeatured.ok {
+		u.Set("isfeatured", strconv.FormatBool(p.isfeatured.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["op"]; found {
-		u.Set("op", v.(string))
+	if p.op.ok {
+		u.Set("op", p.op.v)
 	}
-	if v, found := p.p["projectids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("projectids", vv)
+	if p.projectids.ok {
+		u.Set("projectids", strings.Join(p.projectids.v, ","))
 	}
 	return u
 }
 
-func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateIsoPermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateIsoPermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateIsoPermissionsParams(u url.Values) (*UpdateIsoPermissionsParams, error) {
+	p := &UpdateIsoPermissionsParams{}
+	if err := checkParamNames("updateIsoPermissions", u, "accounts", "id", "isextractable", "isfeatured", "ispublic", "op", "projectids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accounts"]; found {
+		p.SetAccounts(strings.Split(u.Get("accounts"), ","))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isextractable"]; found {
+		v, err := strconv.ParseBool(u.Get("isextractable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isextractable: %v", err)
+		}
+		p.SetIsextractable(v)
 	}
-	p.p["accounts"] = v
+	if _, found := u["isfeatured"]; found {
+		v, err := strconv.ParseBool(u.Get("isfeatured"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isfeatured: %v", err)
+		}
+		p.SetIsfeatured(v)
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["op"]; found {
+		p.SetOp(u.Get("op"))
+	}
+	if _, found := u["projectids"]; found {
+		p.SetProjectids(strings.Split(u.Get("projectids"), ","))
+	}
+	return p, nil
 }
 
+// SetAccounts sets the accounts param.
+func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
+	p.accounts = optStrings{v: v, ok: true}
+}
+
+// ResetAccounts unsets the accounts param
 func (p *UpdateIsoPermissionsParams) ResetAccounts() {
-	if p.p != nil && p.p["accounts"] != nil {
-		delete(p.p, "accounts")
-	}
+	p.accounts = optStrings{}
 }
 
+// GetAccounts returns the accounts param and if it is set
 func (p *UpdateIsoPermissionsParams) GetAccounts() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounts"].([]string)
-	return value, ok
+	return p.accounts.v, p.accounts.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateIsoPermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateIsoPermissionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateIsoPermissionsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsextractable sets the isextractable param.
 func (p *UpdateIsoPermissionsParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isextractable"] = v
+	p.isextractable = optBool{v: v, ok: true}
 }
 
+// ResetIsextractable unsets the isextractable param
 func (p *UpdateIsoPermissionsParams) ResetIsextractable() {
-	if p.p != nil && p.p["isextractable"] != nil {
-		delete(p.p, "isextractable")
-	}
+	p.isextractable = optBool{}
 }
 
+// GetIsextractable returns the isextractable param and if it is set
 func (p *UpdateIsoPermissionsParams) GetIsextractable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isextractable"].(bool)
-	return value, ok
+	return p.isextractable.v, p.isextractable.ok
 }
 
+// SetIsfeatured sets the isfeatured param.
 func (p *UpdateIsoPermissionsParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
+	p.isfeatured = optBool{v: v, ok: true}
 }
 
+// ResetIsfeatured unsets the isfeatured param
 func (p *UpdateIsoPermissionsParams) ResetIsfeatured() {
-	if p.p != nil && p.p["isfeatured"] != nil {
-		delete(p.p, "isfeatured")
-	}
+	p.isfeatured = optBool{}
 }
 
+// GetIsfeatured returns the isfeatured param and if it is set
 func (p *UpdateIsoPermissionsParams) GetIsfeatured() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isfeatured"].(bool)
-	return value, ok
+	return p.isfeatured.v, p.isfeatured.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *UpdateIsoPermissionsParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *UpdateIsoPermissionsParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *UpdateIsoPermissionsParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetOp sets the op param.
 func (p *UpdateIsoPermissionsParams) SetOp(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["op"] = v
+	p.op = optString{v: v, ok: true}
 }
 
+// ResetOp unsets the op param
 func (p *UpdateIsoPermissionsParams) ResetOp() {
-	if p.p != nil && p.p["op"] != nil {
-		delete(p.p, "op")
-	}
+	p.op = optString{}
 }
 
+// GetOp returns the op param and if it is set
 func (p *UpdateIsoPermissionsParams) GetOp() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["op"].(string)
-	return value, ok
+	return p.op.v, p.op.ok
 }
 
+// SetProjectids sets the projectids param.
 func (p *UpdateIsoPermissionsParams) SetProjectids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectids"] = v
+	p.projectids = optStrings{v: v, ok: true}
 }
 
+// ResetProjectids unsets the projectids param
 func (p *UpdateIsoPermissionsParams) ResetProjectids() {
-	if p.p != nil && p.p["projectids"] != nil {
-		delete(p.p, "projectids")
-	}
+	p.projectids = optStrings{}
 }
 
+// GetProjectids returns the projectids param and if it is set
 func (p *UpdateIsoPermissionsParams) GetProjectids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectids.v, p.projectids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateIsoPermissionsParams) Clone() *UpdateIsoPermissionsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["projectids"].([]string)
-	return value, ok
+	c := *p
+	c.accounts = p.accounts.clone()
+	c.projectids = p.projectids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateIsoPermissionsParams) Equal(o *UpdateIsoPermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accounts.equal(o.accounts) &&
+		p.id == o.id &&
+		p.isextractable == o.isextractable &&
+		p.isfeatured == o.isfeatured &&
+		p.ispublic == o.ispublic &&
+		p.op == o.op &&
+		p.projectids.equal(o.projectids)
+}
+
+// serializedUpdateIsoPermissionsParams is used to (un)marshal UpdateIsoPermissionsParams using the API param names
+type serializedUpdateIsoPermissionsParams struct {
+	Accounts      *[]string `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	Id            *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Isextractable *bool     `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured    *bool     `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic      *bool     `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Op            *string   `json:"op,omitempty" yaml:"op,omitempty"`
+	Projectids    *[]string `json:"projectids,omitempty" yaml:"projectids,omitempty"`
+}
+
+func (p *UpdateIsoPermissionsParams) toSerialized() *serializedUpdateIsoPermissionsParams {
+	s := &serializedUpdateIsoPermissionsParams{}
+	if p.accounts.ok {
+		s.Accounts = &p.accounts.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isextractable.ok {
+		s.Isextractable = &p.isextractable.v
+	}
+	if p.isfeatured.ok {
+		s.Isfeatured = &p.isfeatured.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.op.ok {
+		s.Op = &p.op.v
+	}
+	if p.projectids.ok {
+		s.Projectids = &p.projectids.v
+	}
+	return s
+}
+
+func (p *UpdateIsoPermissionsParams) fromSerialized(s *serializedUpdateIsoPermissionsParams) {
+	*p = UpdateIsoPermissionsParams{}
+	if s.Accounts != nil {
+		p.SetAccounts(*s.Accounts)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isextractable != nil {
+		p.SetIsextractable(*s.Isextractable)
+	}
+	if s.Isfeatured != nil {
+		p.SetIsfeatured(*s.Isfeatured)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Op != nil {
+		p.SetOp(*s.Op)
+	}
+	if s.Projectids != nil {
+		p.SetProjectids(*s.Projectids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateIsoPermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateIsoPermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateIsoPermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateIsoPermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateIsoPermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateIsoPermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateIsoPermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams {
 	p := &UpdateIsoPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates ISO permissions
-func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateIsoPermissions", p.toURLValues())
+// Updates ISO permissions.
+//
+// Required params: id.
+func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams, opts ...CallOption) (*UpdateIsoPermissionsResponse, error) {
+	resp, err := s.cs.newRequest("updateIsoPermissions", s.cs.encodeParams("updateIsoPermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,78 +53,103 @@ func (m *MockISOServiceIface) EXPECT() *MockISOServiceIfaceMockRecorder {
 }
 
 // AttachIso mocks base method.
-func (m *MockISOServiceIface) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
+func (m *MockISOServiceIface) AttachIso(p *AttachIsoParams, opts ...CallOption) (*AttachIsoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AttachIso", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttachIso", varargs...)
 	ret0, _ := ret[0].(*AttachIsoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AttachIso indicates an expected call of AttachIso.
-func (mr *MockISOServiceIfaceMockRecorder) AttachIso(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) AttachIso(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachIso", reflect.TypeOf((*MockISOServiceIface)(nil).AttachIso), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachIso", reflect.TypeOf((*MockISOServiceIface)(nil).AttachIso), varargs...)
 }
 
 // CopyIso mocks base method.
-func (m *MockISOServiceIface) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
+func (m *MockISOServiceIface) CopyIso(p *CopyIsoParams, opts ...CallOption) (*CopyIsoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CopyIso", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CopyIso", varargs...)
 	ret0, _ := ret[0].(*CopyIsoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CopyIso indicates an expected call of CopyIso.
-func (mr *MockISOServiceIfaceMockRecorder) CopyIso(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) CopyIso(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyIso", reflect.TypeOf((*MockISOServiceIface)(nil).CopyIso), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyIso", reflect.TypeOf((*MockISOServiceIface)(nil).CopyIso), varargs...)
 }
 
 // DeleteIso mocks base method.
-func (m *MockISOServiceIface) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+func (m *MockISOServiceIface) DeleteIso(p *DeleteIsoParams, opts ...CallOption) (*DeleteIsoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteIso", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIso", varargs...)
 	ret0, _ := ret[0].(*DeleteIsoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteIso indicates an expected call of DeleteIso.
-func (mr *MockISOServiceIfaceMockRecorder) DeleteIso(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) DeleteIso(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIso", reflect.TypeOf((*MockISOServiceIface)(nil).DeleteIso), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIso", reflect.TypeOf((*MockISOServiceIface)(nil).DeleteIso), varargs...)
 }
 
 // DetachIso mocks base method.
-func (m *MockISOServiceIface) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
+func (m *MockISOServiceIface) DetachIso(p *DetachIsoParams, opts ...CallOption) (*DetachIsoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DetachIso", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DetachIso", varargs...)
 	ret0, _ := ret[0].(*DetachIsoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DetachIso indicates an expected call of DetachIso.
-func (mr *MockISOServiceIfaceMockRecorder) DetachIso(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) DetachIso(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachIso", reflect.TypeOf((*MockISOServiceIface)(nil).DetachIso), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachIso", reflect.TypeOf((*MockISOServiceIface)(nil).DetachIso), varargs...)
 }
 
 // ExtractIso mocks base method.
-func (m *MockISOServiceIface) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+func (m *MockISOServiceIface) ExtractIso(p *ExtractIsoParams, opts ...CallOption) (*ExtractIsoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExtractIso", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExtractIso", varargs...)
 	ret0, _ := ret[0].(*ExtractIsoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExtractIso indicates an expected call of ExtractIso.
-func (mr *MockISOServiceIfaceMockRecorder) ExtractIso(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) ExtractIso(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractIso", reflect.TypeOf((*MockISOServiceIface)(nil).ExtractIso), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractIso", reflect.TypeOf((*MockISOServiceIface)(nil).ExtractIso), varargs...)
 }
 
 // GetIsoByID mocks base method.
@@ -212,33 +237,43 @@ func (mr *MockISOServiceIfaceMockRecorder) GetIsoPermissionByID(id interface{},
 }
 
 // ListIsoPermissions mocks base method.
-func (m *MockISOServiceIface) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+func (m *MockISOServiceIface) ListIsoPermissions(p *ListIsoPermissionsParams, opts ...CallOption) (*ListIsoPermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIsoPermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIsoPermissions", varargs...)
 	ret0, _ := ret[0].(*ListIsoPermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListIsoPermissions indicates an expected call of ListIsoPermissions.
-func (mr *MockISOServiceIfaceMockRecorder) ListIsoPermissions(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) ListIsoPermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsoPermissions", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsoPermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsoPermissions", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsoPermissions), varargs...)
 }
 
 // ListIsos mocks base method.
-func (m *MockISOServiceIface) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
+func (m *MockISOServiceIface) ListIsos(p *ListIsosParams, opts ...CallOption) (*ListIsosResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIsos", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIsos", varargs...)
 	ret0, _ := ret[0].(*ListIsosResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListIsos indicates an expected call of ListIsos.
-func (mr *MockISOServiceIfaceMockRecorder) ListIsos(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) ListIsos(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsos", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsos), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsos", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsos), varargs...)
 }
 
 // NewAttachIsoParams mocks base method.
@@ -382,46 +417,61 @@ func (mr *MockISOServiceIfaceMockRecorder) NewUpdateIsoPermissionsParams(id inte
 }
 
 // RegisterIso mocks base method.
-func (m *MockISOServiceIface) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
+func (m *MockISOServiceIface) RegisterIso(p *RegisterIsoParams, opts ...CallOption) (*RegisterIsoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterIso", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterIso", varargs...)
 	ret0, _ := ret[0].(*RegisterIsoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterIso indicates an expected call of RegisterIso.
-func (mr *MockISOServiceIfaceMockRecorder) RegisterIso(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) RegisterIso(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterIso", reflect.TypeOf((*MockISOServiceIface)(nil).RegisterIso), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterIso", reflect.TypeOf((*MockISOServiceIface)(nil).RegisterIso), varargs...)
 }
 
 // UpdateIso mocks base method.
-func (m *MockISOServiceIface) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+func (m *MockISOServiceIface) UpdateIso(p *UpdateIsoParams, opts ...CallOption) (*UpdateIsoResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIso", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateIso", varargs...)
 	ret0, _ := ret[0].(*UpdateIsoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIso indicates an expected call of UpdateIso.
-func (mr *MockISOServiceIfaceMockRecorder) UpdateIso(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) UpdateIso(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIso", reflect.TypeOf((*MockISOServiceIface)(nil).UpdateIso), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIso", reflect.TypeOf((*MockISOServiceIface)(nil).UpdateIso), varargs...)
 }
 
 // UpdateIsoPermissions mocks base method.
-func (m *MockISOServiceIface) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+func (m *MockISOServiceIface) UpdateIsoPermissions(p *UpdateIsoPermissionsParams, opts ...CallOption) (*UpdateIsoPermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIsoPermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateIsoPermissions", varargs...)
 	ret0, _ := ret[0].(*UpdateIsoPermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateIsoPermissions indicates an expected call of UpdateIsoPermissions.
-func (mr *MockISOServiceIfaceMockRecorder) UpdateIsoPermissions(p interface{}) *gomock.Call {
+func (mr *MockISOServiceIfaceMockRecorder) UpdateIsoPermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsoPermissions", reflect.TypeOf((*MockISOServiceIface)(nil).UpdateIsoPermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsoPermissions", reflect.TypeOf((*MockISOServiceIface)(nil).UpdateIsoPermissions), varargs...)
 }
@@ -28,178 +28,284 @@ import (
 )
 
 type ImageStoreServiceIface interface {
-	AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	AddImageStore(p *AddImageStoreParams, opts ...CallOption) (*AddImageStoreResponse, error)
 	NewAddImageStoreParams(provider string) *AddImageStoreParams
-	AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error)
+	AddImageStoreS3(p *AddImageStoreS3Params, opts ...CallOption) (*AddImageStoreS3Response, error)
 	NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) *AddImageStoreS3Params
-	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams, opts ...CallOption) (*CreateSecondaryStagingStoreResponse, error)
 	NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams
-	DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	DeleteImageStore(p *DeleteImageStoreParams, opts ...CallOption) (*DeleteImageStoreResponse, error)
 	NewDeleteImageStoreParams(id string) *DeleteImageStoreParams
-	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams, opts ...CallOption) (*DeleteSecondaryStagingStoreResponse, error)
 	NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams
-	ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListImageStores(p *ListImageStoresParams, opts ...CallOption) (*ListImageStoresResponse, error)
 	NewListImageStoresParams() *ListImageStoresParams
 	GetImageStoreID(name string, opts ...OptionFunc) (string, int, error)
 	GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error)
 	GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error)
-	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams, opts ...CallOption) (*ListSecondaryStagingStoresResponse, error)
 	NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams
 	GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error)
 	GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
 	GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
-	UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error)
+	UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams, opts ...CallOption) (*UpdateCloudToUseObjectStoreResponse, error)
 	NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams
 }
 
 type AddImageStoreParams struct {
-	p map[string]interface{}
+	details  optStringMap
+	name     optString
+	provider optString
+	url      optString
+	zoneid   optString
 }
 
-func (p *AddImageStoreParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddImageStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddImageStoreParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddImageStoreParams parses url.Values, for example taken from a raw API request,
+// into a new AddImageStoreParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddImageStoreParams(u url.Values) (*AddImageStoreParams, error) {
+	p := &AddImageStoreParams{}
+	if err := checkParamNames("addImageStore", u, "details[]", "name", "provider", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
 	}
-	p.p["details"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetDetails sets the details param.
+func (p *AddImageStoreParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
+}
+
+// ResetDetails unsets the details param
 func (p *AddImageStoreParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *AddImageStoreParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetName sets the name param.
 func (p *AddImageStoreParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *AddImageStoreParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *AddImageStoreParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProvider sets the provider param. This param is required.
 func (p *AddImageStoreParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *AddImageStoreParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *AddImageStoreParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetUrl sets the url param.
 func (p *AddImageStoreParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddImageStoreParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddImageStoreParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *AddImageStoreParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddImageStoreParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddImageStoreParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddImageStoreParams) Clone() *AddImageStoreParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddImageStoreParams) Equal(o *AddImageStoreParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.name == o.name &&
+		p.provider == o.provider &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddImageStoreParams is used to (un)marshal AddImageStoreParams using the API param names
+type serializedAddImageStoreParams struct {
+	Details  *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Name     *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Provider *string            `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Url      *string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid   *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddImageStoreParams) toSerialized() *serializedAddImageStoreParams {
+	s := &serializedAddImageStoreParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddImageStoreParams) fromSerialized(s *serializedAddImageStoreParams) {
+	*p = AddImageStoreParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddImageStoreParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddImageStoreParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddImageStoreParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddImageStoreParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddImageStoreParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddImageStoreParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddImageStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewAddImageStoreParams(provider string) *AddImageStoreParams {
 	p := &AddImageStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["provider"] = provider
+	p.SetProvider(provider)
 	return p
 }
 
 // Adds backup image store.
-func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("addImageStore", p.toURLValues())
+//
+// Required params: provider.
+func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams, opts ...CallOption) (*AddImageStoreResponse, error) {
+	resp, err := s.cs.newRequest("addImageStore", s.cs.encodeParams("addImageStore", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -234,302 +340,459 @@ type AddImageStoreResponse struct {
 }
 
 type AddImageStoreS3Params struct {
-	p map[string]interface{}
-}
-
-func (p *AddImageStoreS3Params) toURLValues() url.Values {
+	accesskey         optString
+	bucket            optString
+	connectiontimeout optInt
+	connectionttl     optInt
+	endpoint          optString
+	maxerrorretry     optInt
+	s3signer          optString
+	secretkey         optString
+	sockettimeout     optInt
+	usehttps          optBool
+	usetcpkeepalive   optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddImageStoreS3Params) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accesskey"]; found {
-		u.Set("accesskey", v.(string))
+	if p.accesskey.ok {
+		u.Set("accesskey", p.accesskey.v)
 	}
-	if v, found := p.p["bucket"]; found {
-		u.Set("bucket", v.(string))
+	if p.bucket.ok {
+		u.Set("bucket", p.bucket.v)
 	}
-	if v, found := p.p["connectiontimeout"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("connectiontimeout", vv)
+	if p.connectiontimeout.ok {
+		u.Set("connectiontimeout", strconv.Itoa(p.connectiontimeout.v))
 	}
-	if v, found := p.p["connectionttl"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("connectionttl", vv)
+	if p.connectionttl.ok {
+		u.Set("connectionttl", strconv.Itoa(p.connectionttl.v))
 	}
-	if v, found := p.p["endpoint"]; found {
-		u.Set("endpoint", v.(string))
+	if p.endpoint.ok {
+		u.Set("endpoint", p.endpoint.v)
 	}
-	if v, found := p.p["maxerrorretry"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxerrorretry", vv)
+	if p.maxerrorretry.ok {
+		u.Set("maxerrorretry", strconv.Itoa(p.maxerrorretry.v))
 	}
-	if v, found := p.p["s3signer"]; found {
-		u.Set("s3signer", v.(string))
+	if p.s3signer.ok {
+		u.Set("s3signer", p.s3signer.v)
 	}
-	if v, found := p.p["secretkey"]; found {
-		u.Set("secretkey", v.(string))
+	if p.secretkey.ok {
+		u.Set("secretkey", p.secretkey.v)
 	}
-	if v, found := p.p["sockettimeout"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sockettimeout", vv)
+	if p.sockettimeout.ok {
+		u.Set("sockettimeout", strconv.Itoa(p.sockettimeout.v))
 	}
-	if v, found := p.p["usehttps"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("usehttps", vv)
+	if p.usehttps.ok {
+		u.Set("usehttps", strconv.FormatBool(p.usehttps.v))
 	}
-	if v, found := p.p["usetcpkeepalive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("usetcpkeepalive", vv)
+	if p.usetcpkeepalive.ok {
+		u.Set("usetcpkeepalive", strconv.FormatBool(p.usetcpkeepalive.v))
 	}
 	return u
 }
 
-func (p *AddImageStoreS3Params) SetAccesskey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddImageStoreS3Params parses url.Values, for example taken from a raw API request,
+// into a new AddImageStoreS3Params instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddImageStoreS3Params(u url.Values) (*AddImageStoreS3Params, error) {
+	p := &AddImageStoreS3Params{}
+	if err := checkParamNames("addImageStoreS3", u, "accesskey", "bucket", "connectiontimeout", "connectionttl", "endpoint", "maxerrorretry", "s3signer", "secretkey", "sockettimeout", "usehttps", "usetcpkeepalive"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accesskey"]; found {
+		p.SetAccesskey(u.Get("accesskey"))
+	}
+	if _, found := u["bucket"]; found {
+		p.SetBucket(u.Get("bucket"))
+	}
+	if _, found := u["connectiontimeout"]; found {
+		v, err := strconv.Atoi(u.Get("connectiontimeout"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param connectiontimeout: %v", err)
+		}
+		p.SetConnectiontimeout(v)
+	}
+	if _, found := u["connectionttl"]; found {
+		v, err := strconv.Atoi(u.Get("connectionttl"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param connectionttl: %v", err)
+		}
+		p.SetConnectionttl(v)
+	}
+	if _, found := u["endpoint"]; found {
+		p.SetEndpoint(u.Get("endpoint"))
+	}
+	if _, found := u["maxerrorretry"]; found {
+		v, err := strconv.Atoi(u.Get("maxerrorretry"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxerrorretry: %v", err)
+		}
+		p.SetMaxerrorretry(v)
 	}
-	p.p["accesskey"] = v
+	if _, found := u["s3signer"]; found {
+		p.SetS3signer(u.Get("s3signer"))
+	}
+	if _, found := u["secretkey"]; found {
+		p.SetSecretkey(u.Get("secretkey"))
+	}
+	if _, found := u["sockettimeout"]; found {
+		v, err := strconv.Atoi(u.Get("sockettimeout"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sockettimeout: %v", err)
+		}
+		p.SetSockettimeout(v)
+	}
+	if _, found := u["usehttps"]; found {
+		v, err := strconv.ParseBool(u.Get("usehttps"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param usehttps: %v", err)
+		}
+		p.SetUsehttps(v)
+	}
+	if _, found := u["usetcpkeepalive"]; found {
+		v, err := strconv.ParseBool(u.Get("usetcpkeepalive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param usetcpkeepalive: %v", err)
+		}
+		p.SetUsetcpkeepalive(v)
+	}
+	return p, nil
 }
 
+// SetAccesskey sets the accesskey param. This param is required.
+func (p *AddImageStoreS3Params) SetAccesskey(v string) {
+	p.accesskey = optString{v: v, ok: true}
+}
+
+// ResetAccesskey unsets the accesskey param
 func (p *AddImageStoreS3Params) ResetAccesskey() {
-	if p.p != nil && p.p["accesskey"] != nil {
-		delete(p.p, "accesskey")
-	}
+	p.accesskey = optString{}
 }
 
+// GetAccesskey returns the accesskey param and if it is set
 func (p *AddImageStoreS3Params) GetAccesskey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accesskey"].(string)
-	return value, ok
+	return p.accesskey.v, p.accesskey.ok
 }
 
+// SetBucket sets the bucket param. This param is required.
 func (p *AddImageStoreS3Params) SetBucket(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bucket"] = v
+	p.bucket = optString{v: v, ok: true}
 }
 
+// ResetBucket unsets the bucket param
 func (p *AddImageStoreS3Params) ResetBucket() {
-	if p.p != nil && p.p["bucket"] != nil {
-		delete(p.p, "bucket")
-	}
+	p.bucket = optString{}
 }
 
+// GetBucket returns the bucket param and if it is set
 func (p *AddImageStoreS3Params) GetBucket() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bucket"].(string)
-	return value, ok
+	return p.bucket.v, p.bucket.ok
 }
 
+// SetConnectiontimeout sets the connectiontimeout param.
 func (p *AddImageStoreS3Params) SetConnectiontimeout(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["connectiontimeout"] = v
+	p.connectiontimeout = optInt{v: v, ok: true}
 }
 
+// ResetConnectiontimeout unsets the connectiontimeout param
 func (p *AddImageStoreS3Params) ResetConnectiontimeout() {
-	if p.p != nil && p.p["connectiontimeout"] != nil {
-		delete(p.p, "connectiontimeout")
-	}
+	p.connectiontimeout = optInt{}
 }
 
+// GetConnectiontimeout returns the connectiontimeout param and if it is set
 func (p *AddImageStoreS3Params) GetConnectiontimeout() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["connectiontimeout"].(int)
-	return value, ok
+	return p.connectiontimeout.v, p.connectiontimeout.ok
 }
 
+// SetConnectionttl sets the connectionttl param.
 func (p *AddImageStoreS3Params) SetConnectionttl(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["connectionttl"] = v
+	p.connectionttl = optInt{v: v, ok: true}
 }
 
+// ResetConnectionttl unsets the connectionttl param
 func (p *AddImageStoreS3Params) ResetConnectionttl() {
-	if p.p != nil && p.p["connectionttl"] != nil {
-		delete(p.p, "connectionttl")
-	}
+	p.connectionttl = optInt{}
 }
 
+// GetConnectionttl returns the connectionttl param and if it is set
 func (p *AddImageStoreS3Params) GetConnectionttl() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["connectionttl"].(int)
-	return value, ok
+	return p.connectionttl.v, p.connectionttl.ok
 }
 
+// SetEndpoint sets the endpoint param. This param is required.
 func (p *AddImageStoreS3Params) SetEndpoint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endpoint"] = v
+	p.endpoint = optString{v: v, ok: true}
 }
 
+// ResetEndpoint unsets the endpoint param
 func (p *AddImageStoreS3Params) ResetEndpoint() {
-	if p.p != nil && p.p["endpoint"] != nil {
-		delete(p.p, "endpoint")
-	}
+	p.endpoint = optString{}
 }
 
+// GetEndpoint returns the endpoint param and if it is set
 func (p *AddImageStoreS3Params) GetEndpoint() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endpoint"].(string)
-	return value, ok
+	return p.endpoint.v, p.endpoint.ok
 }
 
+// SetMaxerrorretry sets the maxerrorretry param.
 func (p *AddImageStoreS3Params) SetMaxerrorretry(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxerrorretry"] = v
+	p.maxerrorretry = optInt{v: v, ok: true}
 }
 
+// ResetMaxerrorretry unsets the maxerrorretry param
 func (p *AddImageStoreS3Params) ResetMaxerrorretry() {
-	if p.p != nil && p.p["maxerrorretry"] != nil {
-		delete(p.p, "maxerrorretry")
-	}
+	p.maxerrorretry = optInt{}
 }
 
+// GetMaxerrorretry returns the maxerrorretry param and if it is set
 func (p *AddImageStoreS3Params) GetMaxerrorretry() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxerrorretry"].(int)
-	return value, ok
+	return p.maxerrorretry.v, p.maxerrorretry.ok
 }
 
+// SetS3signer sets the s3signer param.
 func (p *AddImageStoreS3Params) SetS3signer(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["s3signer"] = v
+	p.s3signer = optString{v: v, ok: true}
 }
 
+// ResetS3signer unsets the s3signer param
 func (p *AddImageStoreS3Params) ResetS3signer() {
-	if p.p != nil && p.p["s3signer"] != nil {
-		delete(p.p, "s3signer")
-	}
+	p.s3signer = optString{}
 }
 
+// GetS3signer returns the s3signer param and if it is set
 func (p *AddImageStoreS3Params) GetS3signer() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["s3signer"].(string)
-	return value, ok
+	return p.s3signer.v, p.s3signer.ok
 }
 
+// SetSecretkey sets the secretkey param. This param is required.
 func (p *AddImageStoreS3Params) SetSecretkey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["secretkey"] = v
+	p.secretkey = optString{v: v, ok: true}
 }
 
+// ResetSecretkey unsets the secretkey param
 func (p *AddImageStoreS3Params) ResetSecretkey() {
-	if p.p != nil && p.p["secretkey"] != nil {
-		delete(p.p, "secretkey")
-	}
+	p.secretkey = optString{}
 }
 
+// GetSecretkey returns the secretkey param and if it is set
 func (p *AddImageStoreS3Params) GetSecretkey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["secretkey"].(string)
-	return value, ok
+	return p.secretkey.v, p.secretkey.ok
 }
 
+// SetSockettimeout sets the sockettimeout param.
 func (p *AddImageStoreS3Params) SetSockettimeout(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sockettimeout"] = v
+	p.sockettimeout = optInt{v: v, ok: true}
 }
 
+// ResetSockettimeout unsets the sockettimeout param
 func (p *AddImageStoreS3Params) ResetSockettimeout() {
-	if p.p != nil && p.p["sockettimeout"] != nil {
-		delete(p.p, "sockettimeout")
-	}
+	p.sockettimeout = optInt{}
 }
 
+// GetSockettimeout returns the sockettimeout param and if it is set
 func (p *AddImageStoreS3Params) GetSockettimeout() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sockettimeout"].(int)
-	return value, ok
+	return p.sockettimeout.v, p.sockettimeout.ok
 }
 
+// SetUsehttps sets the usehttps param.
 func (p *AddImageStoreS3Params) SetUsehttps(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usehttps"] = v
+	p.usehttps = optBool{v: v, ok: true}
 }
 
+// ResetUsehttps unsets the usehttps param
 func (p *AddImageStoreS3Params) ResetUsehttps() {
-	if p.p != nil && p.p["usehttps"] != nil {
-		delete(p.p, "usehttps")
-	}
+	p.usehttps = optBool{}
 }
 
+// GetUsehttps returns the usehttps param and if it is set
 func (p *AddImageStoreS3Params) GetUsehttps() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["usehttps"].(bool)
-	return value, ok
+	return p.usehttps.v, p.usehttps.ok
 }
 
+// SetUsetcpkeepalive sets the usetcpkeepalive param.
 func (p *AddImageStoreS3Params) SetUsetcpkeepalive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usetcpkeepalive"] = v
+	p.usetcpkeepalive = optBool{v: v, ok: true}
 }
 
+// ResetUsetcpkeepalive unsets the usetcpkeepalive param
 func (p *AddImageStoreS3Params) ResetUsetcpkeepalive() {
-	if p.p != nil && p.p["usetcpkeepalive"] != nil {
-		delete(p.p, "usetcpkeepalive")
-	}
+	p.usetcpkeepalive = optBool{}
 }
 
+// GetUsetcpkeepalive returns the usetcpkeepalive param and if it is set
 func (p *AddImageStoreS3Params) GetUsetcpkeepalive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.usetcpkeepalive.v, p.usetcpkeepalive.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddImageStoreS3Params) Clone() *AddImageStoreS3Params {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddImageStoreS3Params) Equal(o *AddImageStoreS3Params) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accesskey == o.accesskey &&
+		p.bucket == o.bucket &&
+		p.connectiontimeout == o.connectiontimeout &&
+		p.connectionttl == o.connectionttl &&
+		p.endpoint == o.endpoint &&
+		p.maxerrorretry == o.maxerrorretry &&
+		p.s3signer == o.s3signer &&
+		p.secretkey == o.secretkey &&
+		p.sockettimeout == o.sockettimeout &&
+		p.usehttps == o.usehttps &&
+		p.usetcpkeepalive == o.usetcpkeepalive
+}
+
+// serializedAddImageStoreS3Params is used to (un)marshal AddImageStoreS3Params using the API param names
+type serializedAddImageStoreS3Params struct {
+	Accesskey         *string `json:"accesskey,omitempty" yaml:"accesskey,omitempty"`
+	Bucket            *string `json:"bucket,omitempty" yaml:"bucket,omitempty"`
+	Connectiontimeout *int    `json:"connectiontimeout,omitempty" yaml:"connectiontimeout,omitempty"`
+	Connectionttl     *int    `json:"connectionttl,omitempty" yaml:"connectionttl,omitempty"`
+	Endpoint          *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Maxerrorretry     *int    `json:"maxerrorretry,omitempty" yaml:"maxerrorretry,omitempty"`
+	S3signer          *string `json:"s3signer,omitempty" yaml:"s3signer,omitempty"`
+	Secretkey         *string `json:"secretkey,omitempty" yaml:"secretkey,omitempty"`
+	Sockettimeout     *int    `json:"sockettimeout,omitempty" yaml:"sockettimeout,omitempty"`
+	Usehttps          *bool   `json:"usehttps,omitempty" yaml:"usehttps,omitempty"`
+	Usetcpkeepalive   *bool   `json:"usetcpkeepalive,omitempty" yaml:"usetcpkeepalive,omitempty"`
+}
+
+func (p *AddImageStoreS3Params) toSerialized() *serializedAddImageStoreS3Params {
+	s := &serializedAddImageStoreS3Params{}
+	if p.accesskey.ok {
+		s.Accesskey = &p.accesskey.v
+	}
+	if p.bucket.ok {
+		s.Bucket = &p.bucket.v
+	}
+	if p.connectiontimeout.ok {
+		s.Connectiontimeout = &p.connectiontimeout.v
 	}
-	value, ok := p.p["usetcpkeepalive"].(bool)
-	return value, ok
+	if p.connectionttl.ok {
+		s.Connectionttl = &p.connectionttl.v
+	}
+	if p.endpoint.ok {
+		s.Endpoint = &p.endpoint.v
+	}
+	if p.maxerrorretry.ok {
+		s.Maxerrorretry = &p.maxerrorretry.v
+	}
+	if p.s3signer.ok {
+		s.S3signer = &p.s3signer.v
+	}
+	if p.secretkey.ok {
+		s.Secretkey = &p.secretkey.v
+	}
+	if p.sockettimeout.ok {
+		s.Sockettimeout = &p.sockettimeout.v
+	}
+	if p.usehttps.ok {
+		s.Usehttps = &p.usehttps.v
+	}
+	if p.usetcpkeepalive.ok {
+		s.Usetcpkeepalive = &p.usetcpkeepalive.v
+	}
+	return s
+}
+
+func (p *AddImageStoreS3Params) fromSerialized(s *serializedAddImageStoreS3Params) {
+	*p = AddImageStoreS3Params{}
+	if s.Accesskey != nil {
+		p.SetAccesskey(*s.Accesskey)
+	}
+	if s.Bucket != nil {
+		p.SetBucket(*s.Bucket)
+	}
+	if s.Connectiontimeout != nil {
+		p.SetConnectiontimeout(*s.Connectiontimeout)
+	}
+	if s.Connectionttl != nil {
+		p.SetConnectionttl(*s.Connectionttl)
+	}
+	if s.Endpoint != nil {
+		p.SetEndpoint(*s.Endpoint)
+	}
+	if s.Maxerrorretry != nil {
+		p.SetMaxerrorretry(*s.Maxerrorretry)
+	}
+	if s.S3signer != nil {
+		p.SetS3signer(*s.S3signer)
+	}
+	if s.Secretkey != nil {
+		p.SetSecretkey(*s.Secretkey)
+	}
+	if s.Sockettimeout != nil {
+		p.SetSockettimeout(*s.Sockettimeout)
+	}
+	if s.Usehttps != nil {
+		p.SetUsehttps(*s.Usehttps)
+	}
+	if s.Usetcpkeepalive != nil {
+		p.SetUsetcpkeepalive(*s.Usetcpkeepalive)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddImageStoreS3Params) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddImageStoreS3Params) UnmarshalJSON(b []byte) error {
+	var s serializedAddImageStoreS3Params
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddImageStoreS3Params) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddImageStoreS3Params) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddImageStoreS3Params
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddImageStoreS3Params instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) *AddImageStoreS3Params {
 	p := &AddImageStoreS3Params{}
-	p.p = make(map[string]interface{})
-	p.p["accesskey"] = accesskey
-	p.p["bucket"] = bucket
-	p.p["endpoint"] = endpoint
-	p.p["secretkey"] = secretkey
+	p.SetAccesskey(accesskey)
+	p.SetBucket(bucket)
+	p.SetEndpoint(endpoint)
+	p.SetSecretkey(secretkey)
 	return p
 }
 
-// Adds S3 Image Store
-func (s *ImageStoreService) AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
-	resp, err := s.cs.newRequest("addImageStoreS3", p.toURLValues())
+// Adds S3 Image Store.
+//
+// Required params: accesskey, bucket, endpoint, secretkey.
+func (s *ImageStoreService) AddImageStoreS3(p *AddImageStoreS3Params, opts ...CallOption) (*AddImageStoreS3Response, error) {
+	resp, err := s.cs.newRequest("addImageStoreS3", s.cs.encodeParams("addImageStoreS3", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -560,153 +823,259 @@ type AddImageStoreS3Response struct {
 }
 
 type CreateSecondaryStagingStoreParams struct {
-	p map[string]interface{}
+	details  optStringMap
+	provider optString
+	scope    optString
+	url      optString
+	zoneid   optString
 }
 
-func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateSecondaryStagingStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["scope"]; found {
-		u.Set("scope", v.(string))
+	if p.scope.ok {
+		u.Set("scope", p.scope.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateSecondaryStagingStoreParams parses url.Values, for example taken from a raw API request,
+// into a new CreateSecondaryStagingStoreParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateSecondaryStagingStoreParams(u url.Values) (*CreateSecondaryStagingStoreParams, error) {
+	p := &CreateSecondaryStagingStoreParams{}
+	if err := checkParamNames("createSecondaryStagingStore", u, "details[]", "provider", "scope", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["scope"]; found {
+		p.SetScope(u.Get("scope"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["details"] = v
+	return p, nil
 }
 
+// SetDetails sets the details param.
+func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
+}
+
+// ResetDetails unsets the details param
 func (p *CreateSecondaryStagingStoreParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *CreateSecondaryStagingStoreParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetProvider sets the provider param.
 func (p *CreateSecondaryStagingStoreParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *CreateSecondaryStagingStoreParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *CreateSecondaryStagingStoreParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetScope sets the scope param.
 func (p *CreateSecondaryStagingStoreParams) SetScope(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scope"] = v
+	p.scope = optString{v: v, ok: true}
 }
 
+// ResetScope unsets the scope param
 func (p *CreateSecondaryStagingStoreParams) ResetScope() {
-	if p.p != nil && p.p["scope"] != nil {
-		delete(p.p, "scope")
-	}
+	p.scope = optString{}
 }
 
+// GetScope returns the scope param and if it is set
 func (p *CreateSecondaryStagingStoreParams) GetScope() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scope"].(string)
-	return value, ok
+	return p.scope.v, p.scope.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *CreateSecondaryStagingStoreParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *CreateSecondaryStagingStoreParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *CreateSecondaryStagingStoreParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateSecondaryStagingStoreParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateSecondaryStagingStoreParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateSecondaryStagingStoreParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateSecondaryStagingStoreParams) Clone() *CreateSecondaryStagingStoreParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateSecondaryStagingStoreParams) Equal(o *CreateSecondaryStagingStoreParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.provider == o.provider &&
+		p.scope == o.scope &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateSecondaryStagingStoreParams is used to (un)marshal CreateSecondaryStagingStoreParams using the API param names
+type serializedCreateSecondaryStagingStoreParams struct {
+	Details  *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Provider *string            `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Scope    *string            `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Url      *string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid   *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateSecondaryStagingStoreParams) toSerialized() *serializedCreateSecondaryStagingStoreParams {
+	s := &serializedCreateSecondaryStagingStoreParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.scope.ok {
+		s.Scope = &p.scope.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateSecondaryStagingStoreParams) fromSerialized(s *serializedCreateSecondaryStagingStoreParams) {
+	*p = CreateSecondaryStagingStoreParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Scope != nil {
+		p.SetScope(*s.Scope)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateSecondaryStagingStoreParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateSecondaryStagingStoreParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateSecondaryStagingStoreParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateSecondaryStagingStoreParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateSecondaryStagingStoreParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateSecondaryStagingStoreParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateSecondaryStagingStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams {
 	p := &CreateSecondaryStagingStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
+	p.SetUrl(url)
 	return p
 }
 
 // create secondary staging store.
-func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("createSecondaryStagingStore", p.toURLValues())
+//
+// Required params: url.
+func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams, opts ...CallOption) (*CreateSecondaryStagingStoreResponse, error) {
+	resp, err := s.cs.newRequest("createSecondaryStagingStore", s.cs.encodeParams("createSecondaryStagingStore", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -737,53 +1106,132 @@ type CreateSecondaryStagingStoreResponse struct {
 }
 
 type DeleteImageStoreParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteImageStoreParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteImageStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteImageStoreParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteImageStoreParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteImageStoreParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteImageStoreParams(u url.Values) (*DeleteImageStoreParams, error) {
+	p := &DeleteImageStoreParams{}
+	if err := checkParamNames("deleteImageStore", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteImageStoreParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteImageStoreParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteImageStoreParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteImageStoreParams) Clone() *DeleteImageStoreParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteImageStoreParams) Equal(o *DeleteImageStoreParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteImageStoreParams is used to (un)marshal DeleteImageStoreParams using the API param names
+type serializedDeleteImageStoreParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteImageStoreParams) toSerialized() *serializedDeleteImageStoreParams {
+	s := &serializedDeleteImageStoreParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteImageStoreParams) fromSerialized(s *serializedDeleteImageStoreParams) {
+	*p = DeleteImageStoreParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteImageStoreParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteImageStoreParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteImageStoreParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteImageStoreParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteImageStoreParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteImageStoreParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteImageStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageStoreParams {
 	p := &DeleteImageStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes an image store or Secondary Storage.
-func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteImageStore", p.toURLValues())
+//
+// Required params: id.
+func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams, opts ...CallOption) (*DeleteImageStoreResponse, error) {
+	resp, err := s.cs.newRequest("deleteImageStore", s.cs.encodeParams("deleteImageStore", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -810,74 +1258,153 @@ func (r *DeleteImageStoreResponse) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	if success, ok := m["success"].(string); ok {
-		m["success"] = success == "true"
-		b, err = json.Marshal(m)
-		if err != nil {
-			return err
-		}
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteImageStoreResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteSecondaryStagingStoreParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteSecondaryStagingStoreParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseDeleteSecondaryStagingStoreParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteSecondaryStagingStoreParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteSecondaryStagingStoreParams(u url.Values) (*DeleteSecondaryStagingStoreParams, error) {
+	p := &DeleteSecondaryStagingStoreParams{}
+	if err := checkParamNames("deleteSecondaryStagingStore", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteSecondaryStagingStoreParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteSecondaryStagingStoreParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteSecondaryStagingStoreParams) Clone() *DeleteSecondaryStagingStoreParams {
+	if p == nil {
+		return nil
 	}
+	c := *p
+	return &c
+}
 
-	if ostypeid, ok := m["ostypeid"].(float64); ok {
-		m["ostypeid"] = strconv.Itoa(int(ostypeid))
-		b, err = json.Marshal(m)
-		if err != nil {
-			return err
-		}
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteSecondaryStagingStoreParams) Equal(o *DeleteSecondaryStagingStoreParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-
-	type alias DeleteImageStoreResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return p.id == o.id
 }
 
-type DeleteSecondaryStagingStoreParams struct {
-	p map[string]interface{}
+// serializedDeleteSecondaryStagingStoreParams is used to (un)marshal DeleteSecondaryStagingStoreParams using the API param names
+type serializedDeleteSecondaryStagingStoreParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+func (p *DeleteSecondaryStagingStoreParams) toSerialized() *serializedDeleteSecondaryStagingStoreParams {
+	s := &serializedDeleteSecondaryStagingStoreParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	return u
+	return s
 }
 
-func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeleteSecondaryStagingStoreParams) fromSerialized(s *serializedDeleteSecondaryStagingStoreParams) {
+	*p = DeleteSecondaryStagingStoreParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	p.p["id"] = v
 }
 
-func (p *DeleteSecondaryStagingStoreParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteSecondaryStagingStoreParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteSecondaryStagingStoreParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteSecondaryStagingStoreParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *DeleteSecondaryStagingStoreParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteSecondaryStagingStoreParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteSecondaryStagingStoreParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteSecondaryStagingStoreParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteSecondaryStagingStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams {
 	p := &DeleteSecondaryStagingStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a secondary staging store .
-func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecondaryStagingStore", p.toURLValues())
+//
+// Required params: id.
+func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams, opts ...CallOption) (*DeleteSecondaryStagingStoreResponse, error) {
+	resp, err := s.cs.newRequest("deleteSecondaryStagingStore", s.cs.encodeParams("deleteSecondaryStagingStore", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -925,250 +1452,383 @@ func (r *DeleteSecondaryStagingStoreResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListImageStoresParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListImageStoresParams) toURLValues() url.Values {
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	protocol optString
+	provider optString
+	readonly optBool
+	zoneid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListImageStoresParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["readonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("readonly", vv)
+	if p.readonly.ok {
+		u.Set("readonly", strconv.FormatBool(p.readonly.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListImageStoresParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListImageStoresParams parses url.Values, for example taken from a raw API request,
+// into a new ListImageStoresParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListImageStoresParams(u url.Values) (*ListImageStoresParams, error) {
+	p := &ListImageStoresParams{}
+	if err := checkParamNames("listImageStores", u, "id", "keyword", "name", "page", "pagesize", "protocol", "provider", "readonly", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
 	}
-	p.p["id"] = v
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["readonly"]; found {
+		v, err := strconv.ParseBool(u.Get("readonly"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param readonly: %v", err)
+		}
+		p.SetReadonly(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListImageStoresParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListImageStoresParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListImageStoresParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListImageStoresParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListImageStoresParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListImageStoresParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListImageStoresParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListImageStoresParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListImageStoresParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListImageStoresParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListImageStoresParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListImageStoresParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListImageStoresParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListImageStoresParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListImageStoresParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *ListImageStoresParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *ListImageStoresParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *ListImageStoresParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetProvider sets the provider param.
 func (p *ListImageStoresParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *ListImageStoresParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *ListImageStoresParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetReadonly sets the readonly param.
 func (p *ListImageStoresParams) SetReadonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["readonly"] = v
+	p.readonly = optBool{v: v, ok: true}
 }
 
+// ResetReadonly unsets the readonly param
 func (p *ListImageStoresParams) ResetReadonly() {
-	if p.p != nil && p.p["readonly"] != nil {
-		delete(p.p, "readonly")
-	}
+	p.readonly = optBool{}
 }
 
+// GetReadonly returns the readonly param and if it is set
 func (p *ListImageStoresParams) GetReadonly() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["readonly"].(bool)
-	return value, ok
+	return p.readonly.v, p.readonly.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListImageStoresParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListImageStoresParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListImageStoresParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListImageStoresParams) Clone() *ListImageStoresParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListImageStoresParams) Equal(o *ListImageStoresParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.protocol == o.protocol &&
+		p.provider == o.provider &&
+		p.readonly == o.readonly &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListImageStoresParams is used to (un)marshal ListImageStoresParams using the API param names
+type serializedListImageStoresParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Protocol *string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Readonly *bool   `json:"readonly,omitempty" yaml:"readonly,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListImageStoresParams) toSerialized() *serializedListImageStoresParams {
+	s := &serializedListImageStoresParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.readonly.ok {
+		s.Readonly = &p.readonly.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListImageStoresParams) fromSerialized(s *serializedListImageStoresParams) {
+	*p = ListImageStoresParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Readonly != nil {
+		p.SetReadonly(*s.Readonly)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListImageStoresParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListImageStoresParams) UnmarshalJSON(b []byte) error {
+	var s serializedListImageStoresParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListImageStoresParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListImageStoresParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListImageStoresParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListImageStoresParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewListImageStoresParams() *ListImageStoresParams {
 	p := &ListImageStoresParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListImageStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1216,9 +1876,8 @@ func (s *ImageStoreService) GetImageStoreByName(name string, opts ...OptionFunc)
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error) {
 	p := &ListImageStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1247,8 +1906,8 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 }
 
 // Lists image stores.
-func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
-	resp, err := s.cs.newRequest("listImageStores", p.toURLValues())
+func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams, opts ...CallOption) (*ListImageStoresResponse, error) {
+	resp, err := s.cs.newRequest("listImageStores", s.cs.encodeParams("listImageStores", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1284,225 +1943,349 @@ type ImageStore struct {
 }
 
 type ListSecondaryStagingStoresParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	protocol optString
+	provider optString
+	zoneid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSecondaryStagingStoresParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListSecondaryStagingStoresParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSecondaryStagingStoresParams parses url.Values, for example taken from a raw API request,
+// into a new ListSecondaryStagingStoresParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSecondaryStagingStoresParams(u url.Values) (*ListSecondaryStagingStoresParams, error) {
+	p := &ListSecondaryStagingStoresParams{}
+	if err := checkParamNames("listSecondaryStagingStores", u, "id", "keyword", "name", "page", "pagesize", "protocol", "provider", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListSecondaryStagingStoresParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListSecondaryStagingStoresParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListSecondaryStagingStoresParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListSecondaryStagingStoresParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListSecondaryStagingStoresParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListSecondaryStagingStoresParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSecondaryStagingStoresParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSecondaryStagingStoresParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSecondaryStagingStoresParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSecondaryStagingStoresParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *ListSecondaryStagingStoresParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *ListSecondaryStagingStoresParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetProvider sets the provider param.
 func (p *ListSecondaryStagingStoresParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *ListSecondaryStagingStoresParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListSecondaryStagingStoresParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListSecondaryStagingStoresParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListSecondaryStagingStoresParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSecondaryStagingStoresParams) Clone() *ListSecondaryStagingStoresParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSecondaryStagingStoresParams) Equal(o *ListSecondaryStagingStoresParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.protocol == o.protocol &&
+		p.provider == o.provider &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListSecondaryStagingStoresParams is used to (un)marshal ListSecondaryStagingStoresParams using the API param names
+type serializedListSecondaryStagingStoresParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Protocol *string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListSecondaryStagingStoresParams) toSerialized() *serializedListSecondaryStagingStoresParams {
+	s := &serializedListSecondaryStagingStoresParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListSecondaryStagingStoresParams) fromSerialized(s *serializedListSecondaryStagingStoresParams) {
+	*p = ListSecondaryStagingStoresParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSecondaryStagingStoresParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSecondaryStagingStoresParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSecondaryStagingStoresParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSecondaryStagingStoresParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSecondaryStagingStoresParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSecondaryStagingStoresParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSecondaryStagingStoresParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams {
 	p := &ListSecondaryStagingStoresParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1550,9 +2333,8 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1581,8 +2363,8 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 }
 
 // Lists secondary staging stores.
-func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
-	resp, err := s.cs.newRequest("listSecondaryStagingStores", p.toURLValues())
+func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams, opts ...CallOption) (*ListSecondaryStagingStoresResponse, error) {
+	resp, err := s.cs.newRequest("listSecondaryStagingStores", s.cs.encodeParams("listSecondaryStagingStores", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1618,129 +2400,229 @@ type SecondaryStagingStore struct {
 }
 
 type UpdateCloudToUseObjectStoreParams struct {
-	p map[string]interface{}
+	details  optStringMap
+	name     optString
+	provider optString
+	url      optString
 }
 
-func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateCloudToUseObjectStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
 	return u
 }
 
-func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateCloudToUseObjectStoreParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateCloudToUseObjectStoreParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateCloudToUseObjectStoreParams(u url.Values) (*UpdateCloudToUseObjectStoreParams, error) {
+	p := &UpdateCloudToUseObjectStoreParams{}
+	if err := checkParamNames("updateCloudToUseObjectStore", u, "details[]", "name", "provider", "url"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
 	}
-	p.p["details"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	return p, nil
+}
+
+// SetDetails sets the details param.
+func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *UpdateCloudToUseObjectStoreParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *UpdateCloudToUseObjectStoreParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateCloudToUseObjectStoreParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateCloudToUseObjectStoreParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateCloudToUseObjectStoreParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProvider sets the provider param. This param is required.
 func (p *UpdateCloudToUseObjectStoreParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *UpdateCloudToUseObjectStoreParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *UpdateCloudToUseObjectStoreParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetUrl sets the url param.
 func (p *UpdateCloudToUseObjectStoreParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *UpdateCloudToUseObjectStoreParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *UpdateCloudToUseObjectStoreParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.url.v, p.url.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateCloudToUseObjectStoreParams) Clone() *UpdateCloudToUseObjectStoreParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateCloudToUseObjectStoreParams) Equal(o *UpdateCloudToUseObjectStoreParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.name == o.name &&
+		p.provider == o.provider &&
+		p.url == o.url
+}
+
+// serializedUpdateCloudToUseObjectStoreParams is used to (un)marshal UpdateCloudToUseObjectStoreParams using the API param names
+type serializedUpdateCloudToUseObjectStoreParams struct {
+	Details  *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Name     *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Provider *string            `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Url      *string            `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) toSerialized() *serializedUpdateCloudToUseObjectStoreParams {
+	s := &serializedUpdateCloudToUseObjectStoreParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	return s
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) fromSerialized(s *serializedUpdateCloudToUseObjectStoreParams) {
+	*p = UpdateCloudToUseObjectStoreParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
 	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateCloudToUseObjectStoreParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateCloudToUseObjectStoreParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateCloudToUseObjectStoreParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateCloudToUseObjectStoreParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateCloudToUseObjectStoreParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateCloudToUseObjectStoreParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateCloudToUseObjectStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams {
 	p := &UpdateCloudToUseObjectStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["provider"] = provider
+	p.SetProvider(provider)
 	return p
 }
 
 // Migrate current NFS secondary storages to use object store.
-func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
-	resp, err := s.cs.newRequest("updateCloudToUseObjectStore", p.toURLValues())
+//
+// Required params: provider.
+func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams, opts ...CallOption) (*UpdateCloudToUseObjectStoreResponse, error) {
+	resp, err := s.cs.newRequest("updateCloudToUseObjectStore", s.cs.encodeParams("updateCloudToUseObjectStore", p), opts...)
 	if err != nil {
 		return nil, err
 	}
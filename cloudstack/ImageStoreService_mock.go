@@ -53,78 +53,103 @@ func (m *MockImageStoreServiceIface) EXPECT() *MockImageStoreServiceIfaceMockRec
 }
 
 // AddImageStore mocks base method.
-func (m *MockImageStoreServiceIface) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
+func (m *MockImageStoreServiceIface) AddImageStore(p *AddImageStoreParams, opts ...CallOption) (*AddImageStoreResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddImageStore", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddImageStore", varargs...)
 	ret0, _ := ret[0].(*AddImageStoreResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddImageStore indicates an expected call of AddImageStore.
-func (mr *MockImageStoreServiceIfaceMockRecorder) AddImageStore(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) AddImageStore(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).AddImageStore), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).AddImageStore), varargs...)
 }
 
 // AddImageStoreS3 mocks base method.
-func (m *MockImageStoreServiceIface) AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
+func (m *MockImageStoreServiceIface) AddImageStoreS3(p *AddImageStoreS3Params, opts ...CallOption) (*AddImageStoreS3Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddImageStoreS3", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddImageStoreS3", varargs...)
 	ret0, _ := ret[0].(*AddImageStoreS3Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddImageStoreS3 indicates an expected call of AddImageStoreS3.
-func (mr *MockImageStoreServiceIfaceMockRecorder) AddImageStoreS3(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) AddImageStoreS3(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageStoreS3", reflect.TypeOf((*MockImageStoreServiceIface)(nil).AddImageStoreS3), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageStoreS3", reflect.TypeOf((*MockImageStoreServiceIface)(nil).AddImageStoreS3), varargs...)
 }
 
 // CreateSecondaryStagingStore mocks base method.
-func (m *MockImageStoreServiceIface) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
+func (m *MockImageStoreServiceIface) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams, opts ...CallOption) (*CreateSecondaryStagingStoreResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecondaryStagingStore", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSecondaryStagingStore", varargs...)
 	ret0, _ := ret[0].(*CreateSecondaryStagingStoreResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSecondaryStagingStore indicates an expected call of CreateSecondaryStagingStore.
-func (mr *MockImageStoreServiceIfaceMockRecorder) CreateSecondaryStagingStore(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) CreateSecondaryStagingStore(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecondaryStagingStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).CreateSecondaryStagingStore), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecondaryStagingStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).CreateSecondaryStagingStore), varargs...)
 }
 
 // DeleteImageStore mocks base method.
-func (m *MockImageStoreServiceIface) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+func (m *MockImageStoreServiceIface) DeleteImageStore(p *DeleteImageStoreParams, opts ...CallOption) (*DeleteImageStoreResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteImageStore", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteImageStore", varargs...)
 	ret0, _ := ret[0].(*DeleteImageStoreResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteImageStore indicates an expected call of DeleteImageStore.
-func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteImageStore(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteImageStore(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteImageStore), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteImageStore), varargs...)
 }
 
 // DeleteSecondaryStagingStore mocks base method.
-func (m *MockImageStoreServiceIface) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+func (m *MockImageStoreServiceIface) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams, opts ...CallOption) (*DeleteSecondaryStagingStoreResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSecondaryStagingStore", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSecondaryStagingStore", varargs...)
 	ret0, _ := ret[0].(*DeleteSecondaryStagingStoreResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteSecondaryStagingStore indicates an expected call of DeleteSecondaryStagingStore.
-func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteSecondaryStagingStore(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteSecondaryStagingStore(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecondaryStagingStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteSecondaryStagingStore), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecondaryStagingStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteSecondaryStagingStore), varargs...)
 }
 
 // GetImageStoreByID mocks base method.
@@ -254,33 +279,43 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetSecondaryStagingStoreID(nam
 }
 
 // ListImageStores mocks base method.
-func (m *MockImageStoreServiceIface) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+func (m *MockImageStoreServiceIface) ListImageStores(p *ListImageStoresParams, opts ...CallOption) (*ListImageStoresResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListImageStores", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStores", varargs...)
 	ret0, _ := ret[0].(*ListImageStoresResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListImageStores indicates an expected call of ListImageStores.
-func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStores(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStores(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStores", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStores), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStores", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStores), varargs...)
 }
 
 // ListSecondaryStagingStores mocks base method.
-func (m *MockImageStoreServiceIface) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+func (m *MockImageStoreServiceIface) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams, opts ...CallOption) (*ListSecondaryStagingStoresResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecondaryStagingStores", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStagingStores", varargs...)
 	ret0, _ := ret[0].(*ListSecondaryStagingStoresResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSecondaryStagingStores indicates an expected call of ListSecondaryStagingStores.
-func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStores(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStores(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStores", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStores), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStores", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStores), varargs...)
 }
 
 // NewAddImageStoreParams mocks base method.
@@ -396,16 +431,21 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) NewUpdateCloudToUseObjectStore
 }
 
 // UpdateCloudToUseObjectStore mocks base method.
-func (m *MockImageStoreServiceIface) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
+func (m *MockImageStoreServiceIface) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams, opts ...CallOption) (*UpdateCloudToUseObjectStoreResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCloudToUseObjectStore", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCloudToUseObjectStore", varargs...)
 	ret0, _ := ret[0].(*UpdateCloudToUseObjectStoreResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCloudToUseObjectStore indicates an expected call of UpdateCloudToUseObjectStore.
-func (mr *MockImageStoreServiceIfaceMockRecorder) UpdateCloudToUseObjectStore(p interface{}) *gomock.Call {
+func (mr *MockImageStoreServiceIfaceMockRecorder) UpdateCloudToUseObjectStore(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCloudToUseObjectStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).UpdateCloudToUseObjectStore), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCloudToUseObjectStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).UpdateCloudToUseObjectStore), varargs...)
 }
@@ -28,188 +28,303 @@ import (
 )
 
 type InfrastructureUsageServiceIface interface {
-	ListManagementServersMetrics(p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error)
+	ListManagementServersMetrics(p *ListManagementServersMetricsParams, opts ...CallOption) (*ListManagementServersMetricsResponse, error)
 	NewListManagementServersMetricsParams() *ListManagementServersMetricsParams
 	GetManagementServersMetricID(name string, opts ...OptionFunc) (string, int, error)
 	GetManagementServersMetricByName(name string, opts ...OptionFunc) (*ManagementServersMetric, int, error)
 	GetManagementServersMetricByID(id string, opts ...OptionFunc) (*ManagementServersMetric, int, error)
-	ListDbMetrics(p *ListDbMetricsParams) (*ListDbMetricsResponse, error)
+	ListDbMetrics(p *ListDbMetricsParams, opts ...CallOption) (*ListDbMetricsResponse, error)
 	NewListDbMetricsParams() *ListDbMetricsParams
 }
 
 type ListManagementServersMetricsParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	system   optBool
 }
 
-func (p *ListManagementServersMetricsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListManagementServersMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["system"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("system", vv)
+	if p.system.ok {
+		u.Set("system", strconv.FormatBool(p.system.v))
 	}
 	return u
 }
 
-func (p *ListManagementServersMetricsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListManagementServersMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListManagementServersMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListManagementServersMetricsParams(u url.Values) (*ListManagementServersMetricsParams, error) {
+	p := &ListManagementServersMetricsParams{}
+	if err := checkParamNames("listManagementServersMetrics", u, "id", "keyword", "name", "page", "pagesize", "system"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["system"]; found {
+		v, err := strconv.ParseBool(u.Get("system"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param system: %v", err)
+		}
+		p.SetSystem(v)
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListManagementServersMetricsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListManagementServersMetricsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListManagementServersMetricsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListManagementServersMetricsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListManagementServersMetricsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListManagementServersMetricsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListManagementServersMetricsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListManagementServersMetricsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListManagementServersMetricsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListManagementServersMetricsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListManagementServersMetricsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListManagementServersMetricsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListManagementServersMetricsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListManagementServersMetricsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListManagementServersMetricsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetSystem sets the system param.
 func (p *ListManagementServersMetricsParams) SetSystem(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["system"] = v
+	p.system = optBool{v: v, ok: true}
 }
 
+// ResetSystem unsets the system param
 func (p *ListManagementServersMetricsParams) ResetSystem() {
-	if p.p != nil && p.p["system"] != nil {
-		delete(p.p, "system")
-	}
+	p.system = optBool{}
 }
 
+// GetSystem returns the system param and if it is set
 func (p *ListManagementServersMetricsParams) GetSystem() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.system.v, p.system.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListManagementServersMetricsParams) Clone() *ListManagementServersMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListManagementServersMetricsParams) Equal(o *ListManagementServersMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.system == o.system
+}
+
+// serializedListManagementServersMetricsParams is used to (un)marshal ListManagementServersMetricsParams using the API param names
+type serializedListManagementServersMetricsParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	System   *bool   `json:"system,omitempty" yaml:"system,omitempty"`
+}
+
+func (p *ListManagementServersMetricsParams) toSerialized() *serializedListManagementServersMetricsParams {
+	s := &serializedListManagementServersMetricsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.system.ok {
+		s.System = &p.system.v
 	}
-	value, ok := p.p["system"].(bool)
-	return value, ok
+	return s
+}
+
+func (p *ListManagementServersMetricsParams) fromSerialized(s *serializedListManagementServersMetricsParams) {
+	*p = ListManagementServersMetricsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.System != nil {
+		p.SetSystem(*s.System)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListManagementServersMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListManagementServersMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListManagementServersMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListManagementServersMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListManagementServersMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListManagementServersMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListManagementServersMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *InfrastructureUsageService) NewListManagementServersMetricsParams() *ListManagementServersMetricsParams {
 	p := &ListManagementServersMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InfrastructureUsageService) GetManagementServersMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListManagementServersMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -257,9 +372,8 @@ func (s *InfrastructureUsageService) GetManagementServersMetricByName(name strin
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InfrastructureUsageService) GetManagementServersMetricByID(id string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
 	p := &ListManagementServersMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -287,9 +401,9 @@ func (s *InfrastructureUsageService) GetManagementServersMetricByID(id string, o
 	return nil, l.Count, fmt.Errorf("There is more then one result for ManagementServersMetric UUID: %s!", id)
 }
 
-// Lists Management Server metrics
-func (s *InfrastructureUsageService) ListManagementServersMetrics(p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listManagementServersMetrics", p.toURLValues())
+// Lists Management Server metrics.
+func (s *InfrastructureUsageService) ListManagementServersMetrics(p *ListManagementServersMetricsParams, opts ...CallOption) (*ListManagementServersMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listManagementServersMetrics", s.cs.encodeParams("listManagementServersMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -348,28 +462,100 @@ type ManagementServersMetric struct {
 }
 
 type ListDbMetricsParams struct {
-	p map[string]interface{}
 }
 
-func (p *ListDbMetricsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDbMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseListDbMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListDbMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDbMetricsParams(u url.Values) (*ListDbMetricsParams, error) {
+	p := &ListDbMetricsParams{}
+	if err := checkParamNames("listDbMetrics", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDbMetricsParams) Clone() *ListDbMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDbMetricsParams) Equal(o *ListDbMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedListDbMetricsParams is used to (un)marshal ListDbMetricsParams using the API param names
+type serializedListDbMetricsParams struct {
+}
+
+func (p *ListDbMetricsParams) toSerialized() *serializedListDbMetricsParams {
+	s := &serializedListDbMetricsParams{}
+	return s
+}
+
+func (p *ListDbMetricsParams) fromSerialized(s *serializedListDbMetricsParams) {
+	*p = ListDbMetricsParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDbMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDbMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDbMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDbMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDbMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDbMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new ListDbMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *InfrastructureUsageService) NewListDbMetricsParams() *ListDbMetricsParams {
 	p := &ListDbMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// list the db hosts and statistics
-func (s *InfrastructureUsageService) ListDbMetrics(p *ListDbMetricsParams) (*ListDbMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listDbMetrics", p.toURLValues())
+// list the db hosts and statistics.
+func (s *InfrastructureUsageService) ListDbMetrics(p *ListDbMetricsParams, opts ...CallOption) (*ListDbMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listDbMetrics", s.cs.encodeParams("listDbMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
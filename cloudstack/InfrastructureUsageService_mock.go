@@ -116,33 +116,43 @@ func (mr *MockInfrastructureUsageServiceIfaceMockRecorder) GetManagementServersM
 }
 
 // ListDbMetrics mocks base method.
-func (m *MockInfrastructureUsageServiceIface) ListDbMetrics(p *ListDbMetricsParams) (*ListDbMetricsResponse, error) {
+func (m *MockInfrastructureUsageServiceIface) ListDbMetrics(p *ListDbMetricsParams, opts ...CallOption) (*ListDbMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDbMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDbMetrics", varargs...)
 	ret0, _ := ret[0].(*ListDbMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDbMetrics indicates an expected call of ListDbMetrics.
-func (mr *MockInfrastructureUsageServiceIfaceMockRecorder) ListDbMetrics(p interface{}) *gomock.Call {
+func (mr *MockInfrastructureUsageServiceIfaceMockRecorder) ListDbMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDbMetrics", reflect.TypeOf((*MockInfrastructureUsageServiceIface)(nil).ListDbMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDbMetrics", reflect.TypeOf((*MockInfrastructureUsageServiceIface)(nil).ListDbMetrics), varargs...)
 }
 
 // ListManagementServersMetrics mocks base method.
-func (m *MockInfrastructureUsageServiceIface) ListManagementServersMetrics(p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error) {
+func (m *MockInfrastructureUsageServiceIface) ListManagementServersMetrics(p *ListManagementServersMetricsParams, opts ...CallOption) (*ListManagementServersMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListManagementServersMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersMetrics", varargs...)
 	ret0, _ := ret[0].(*ListManagementServersMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListManagementServersMetrics indicates an expected call of ListManagementServersMetrics.
-func (mr *MockInfrastructureUsageServiceIfaceMockRecorder) ListManagementServersMetrics(p interface{}) *gomock.Call {
+func (mr *MockInfrastructureUsageServiceIfaceMockRecorder) ListManagementServersMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetrics", reflect.TypeOf((*MockInfrastructureUsageServiceIface)(nil).ListManagementServersMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetrics", reflect.TypeOf((*MockInfrastructureUsageServiceIface)(nil).ListManagementServersMetrics), varargs...)
 }
 
 // NewListDbMetricsParams mocks base method.
@@ -28,98 +28,187 @@ import (
 )
 
 type InternalLBServiceIface interface {
-	ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams, opts ...CallOption) (*InternalLoadBalancerElementResponse, error)
 	NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams
-	CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams, opts ...CallOption) (*CreateInternalLoadBalancerElementResponse, error)
 	NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams
-	ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams, opts ...CallOption) (*ListInternalLoadBalancerElementsResponse, error)
 	NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams
 	GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
-	ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams, opts ...CallOption) (*ListInternalLoadBalancerVMsResponse, error)
 	NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams
 	GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error)
 	GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
 	GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
-	StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams, opts ...CallOption) (*StartInternalLoadBalancerVMResponse, error)
 	NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams
-	StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams, opts ...CallOption) (*StopInternalLoadBalancerVMResponse, error)
 	NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams
 }
 
 type ConfigureInternalLoadBalancerElementParams struct {
-	p map[string]interface{}
+	enabled optBool
+	id      optString
 }
 
-func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ConfigureInternalLoadBalancerElementParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
+	if p.enabled.ok {
+		u.Set("enabled", strconv.FormatBool(p.enabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseConfigureInternalLoadBalancerElementParams parses url.Values, for example taken from a raw API request,
+// into a new ConfigureInternalLoadBalancerElementParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseConfigureInternalLoadBalancerElementParams(u url.Values) (*ConfigureInternalLoadBalancerElementParams, error) {
+	p := &ConfigureInternalLoadBalancerElementParams{}
+	if err := checkParamNames("configureInternalLoadBalancerElement", u, "enabled", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enabled"]; found {
+		v, err := strconv.ParseBool(u.Get("enabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabled: %v", err)
+		}
+		p.SetEnabled(v)
 	}
-	p.p["enabled"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetEnabled sets the enabled param. This param is required.
+func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
+	p.enabled = optBool{v: v, ok: true}
 }
 
+// ResetEnabled unsets the enabled param
 func (p *ConfigureInternalLoadBalancerElementParams) ResetEnabled() {
-	if p.p != nil && p.p["enabled"] != nil {
-		delete(p.p, "enabled")
-	}
+	p.enabled = optBool{}
 }
 
+// GetEnabled returns the enabled param and if it is set
 func (p *ConfigureInternalLoadBalancerElementParams) GetEnabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabled"].(bool)
-	return value, ok
+	return p.enabled.v, p.enabled.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ConfigureInternalLoadBalancerElementParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ConfigureInternalLoadBalancerElementParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ConfigureInternalLoadBalancerElementParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ConfigureInternalLoadBalancerElementParams) Clone() *ConfigureInternalLoadBalancerElementParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ConfigureInternalLoadBalancerElementParams) Equal(o *ConfigureInternalLoadBalancerElementParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enabled == o.enabled &&
+		p.id == o.id
+}
+
+// serializedConfigureInternalLoadBalancerElementParams is used to (un)marshal ConfigureInternalLoadBalancerElementParams using the API param names
+type serializedConfigureInternalLoadBalancerElementParams struct {
+	Enabled *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) toSerialized() *serializedConfigureInternalLoadBalancerElementParams {
+	s := &serializedConfigureInternalLoadBalancerElementParams{}
+	if p.enabled.ok {
+		s.Enabled = &p.enabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) fromSerialized(s *serializedConfigureInternalLoadBalancerElementParams) {
+	*p = ConfigureInternalLoadBalancerElementParams{}
+	if s.Enabled != nil {
+		p.SetEnabled(*s.Enabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ConfigureInternalLoadBalancerElementParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ConfigureInternalLoadBalancerElementParams) UnmarshalJSON(b []byte) error {
+	var s serializedConfigureInternalLoadBalancerElementParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ConfigureInternalLoadBalancerElementParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ConfigureInternalLoadBalancerElementParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedConfigureInternalLoadBalancerElementParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ConfigureInternalLoadBalancerElementParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams {
 	p := &ConfigureInternalLoadBalancerElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["enabled"] = enabled
-	p.p["id"] = id
+	p.SetEnabled(enabled)
+	p.SetId(id)
 	return p
 }
 
 // Configures an Internal Load Balancer element.
-func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("configureInternalLoadBalancerElement", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: enabled, id.
+func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams, opts ...CallOption) (*InternalLoadBalancerElementResponse, error) {
+	resp, err := s.cs.newRequest("configureInternalLoadBalancerElement", s.cs.encodeParams("configureInternalLoadBalancerElement", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +219,8 @@ func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInt
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -161,53 +250,133 @@ type InternalLoadBalancerElementResponse struct {
 }
 
 type CreateInternalLoadBalancerElementParams struct {
-	p map[string]interface{}
+	nspid optString
 }
 
-func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateInternalLoadBalancerElementParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
+	if p.nspid.ok {
+		u.Set("nspid", p.nspid.v)
 	}
 	return u
 }
 
-func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateInternalLoadBalancerElementParams parses url.Values, for example taken from a raw API request,
+// into a new CreateInternalLoadBalancerElementParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateInternalLoadBalancerElementParams(u url.Values) (*CreateInternalLoadBalancerElementParams, error) {
+	p := &CreateInternalLoadBalancerElementParams{}
+	if err := checkParamNames("createInternalLoadBalancerElement", u, "nspid"); err != nil {
+		return nil, err
 	}
-	p.p["nspid"] = v
+	if _, found := u["nspid"]; found {
+		p.SetNspid(u.Get("nspid"))
+	}
+	return p, nil
 }
 
+// SetNspid sets the nspid param. This param is required.
+func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
+	p.nspid = optString{v: v, ok: true}
+}
+
+// ResetNspid unsets the nspid param
 func (p *CreateInternalLoadBalancerElementParams) ResetNspid() {
-	if p.p != nil && p.p["nspid"] != nil {
-		delete(p.p, "nspid")
-	}
+	p.nspid = optString{}
 }
 
+// GetNspid returns the nspid param and if it is set
 func (p *CreateInternalLoadBalancerElementParams) GetNspid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.nspid.v, p.nspid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateInternalLoadBalancerElementParams) Clone() *CreateInternalLoadBalancerElementParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateInternalLoadBalancerElementParams) Equal(o *CreateInternalLoadBalancerElementParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.nspid == o.nspid
+}
+
+// serializedCreateInternalLoadBalancerElementParams is used to (un)marshal CreateInternalLoadBalancerElementParams using the API param names
+type serializedCreateInternalLoadBalancerElementParams struct {
+	Nspid *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+}
+
+func (p *CreateInternalLoadBalancerElementParams) toSerialized() *serializedCreateInternalLoadBalancerElementParams {
+	s := &serializedCreateInternalLoadBalancerElementParams{}
+	if p.nspid.ok {
+		s.Nspid = &p.nspid.v
+	}
+	return s
+}
+
+func (p *CreateInternalLoadBalancerElementParams) fromSerialized(s *serializedCreateInternalLoadBalancerElementParams) {
+	*p = CreateInternalLoadBalancerElementParams{}
+	if s.Nspid != nil {
+		p.SetNspid(*s.Nspid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateInternalLoadBalancerElementParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateInternalLoadBalancerElementParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateInternalLoadBalancerElementParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateInternalLoadBalancerElementParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateInternalLoadBalancerElementParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateInternalLoadBalancerElementParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["nspid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateInternalLoadBalancerElementParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams {
 	p := &CreateInternalLoadBalancerElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["nspid"] = nspid
+	p.SetNspid(nspid)
 	return p
 }
 
 // Create an Internal Load Balancer element.
-func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("createInternalLoadBalancerElement", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: nspid.
+func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams, opts ...CallOption) (*CreateInternalLoadBalancerElementResponse, error) {
+	resp, err := s.cs.newRequest("createInternalLoadBalancerElement", s.cs.encodeParams("createInternalLoadBalancerElement", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -218,8 +387,8 @@ func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalL
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -249,178 +418,293 @@ type CreateInternalLoadBalancerElementResponse struct {
 }
 
 type ListInternalLoadBalancerElementsParams struct {
-	p map[string]interface{}
+	enabled  optBool
+	id       optString
+	keyword  optString
+	nspid    optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListInternalLoadBalancerElementsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListInternalLoadBalancerElementsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
+	if p.enabled.ok {
+		u.Set("enabled", strconv.FormatBool(p.enabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
+	if p.nspid.ok {
+		u.Set("nspid", p.nspid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListInternalLoadBalancerElementsParams parses url.Values, for example taken from a raw API request,
+// into a new ListInternalLoadBalancerElementsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListInternalLoadBalancerElementsParams(u url.Values) (*ListInternalLoadBalancerElementsParams, error) {
+	p := &ListInternalLoadBalancerElementsParams{}
+	if err := checkParamNames("listInternalLoadBalancerElements", u, "enabled", "id", "keyword", "nspid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enabled"]; found {
+		v, err := strconv.ParseBool(u.Get("enabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabled: %v", err)
+		}
+		p.SetEnabled(v)
 	}
-	p.p["enabled"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["nspid"]; found {
+		p.SetNspid(u.Get("nspid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
 }
 
+// SetEnabled sets the enabled param.
+func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
+	p.enabled = optBool{v: v, ok: true}
+}
+
+// ResetEnabled unsets the enabled param
 func (p *ListInternalLoadBalancerElementsParams) ResetEnabled() {
-	if p.p != nil && p.p["enabled"] != nil {
-		delete(p.p, "enabled")
-	}
+	p.enabled = optBool{}
 }
 
+// GetEnabled returns the enabled param and if it is set
 func (p *ListInternalLoadBalancerElementsParams) GetEnabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabled"].(bool)
-	return value, ok
+	return p.enabled.v, p.enabled.ok
 }
 
+// SetId sets the id param.
 func (p *ListInternalLoadBalancerElementsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListInternalLoadBalancerElementsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListInternalLoadBalancerElementsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListInternalLoadBalancerElementsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListInternalLoadBalancerElementsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListInternalLoadBalancerElementsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNspid sets the nspid param.
 func (p *ListInternalLoadBalancerElementsParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nspid"] = v
+	p.nspid = optString{v: v, ok: true}
 }
 
+// ResetNspid unsets the nspid param
 func (p *ListInternalLoadBalancerElementsParams) ResetNspid() {
-	if p.p != nil && p.p["nspid"] != nil {
-		delete(p.p, "nspid")
-	}
+	p.nspid = optString{}
 }
 
+// GetNspid returns the nspid param and if it is set
 func (p *ListInternalLoadBalancerElementsParams) GetNspid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nspid"].(string)
-	return value, ok
+	return p.nspid.v, p.nspid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListInternalLoadBalancerElementsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListInternalLoadBalancerElementsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListInternalLoadBalancerElementsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListInternalLoadBalancerElementsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListInternalLoadBalancerElementsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListInternalLoadBalancerElementsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListInternalLoadBalancerElementsParams) Clone() *ListInternalLoadBalancerElementsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListInternalLoadBalancerElementsParams) Equal(o *ListInternalLoadBalancerElementsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enabled == o.enabled &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.nspid == o.nspid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListInternalLoadBalancerElementsParams is used to (un)marshal ListInternalLoadBalancerElementsParams using the API param names
+type serializedListInternalLoadBalancerElementsParams struct {
+	Enabled  *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nspid    *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListInternalLoadBalancerElementsParams) toSerialized() *serializedListInternalLoadBalancerElementsParams {
+	s := &serializedListInternalLoadBalancerElementsParams{}
+	if p.enabled.ok {
+		s.Enabled = &p.enabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.nspid.ok {
+		s.Nspid = &p.nspid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListInternalLoadBalancerElementsParams) fromSerialized(s *serializedListInternalLoadBalancerElementsParams) {
+	*p = ListInternalLoadBalancerElementsParams{}
+	if s.Enabled != nil {
+		p.SetEnabled(*s.Enabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Nspid != nil {
+		p.SetNspid(*s.Nspid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListInternalLoadBalancerElementsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListInternalLoadBalancerElementsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListInternalLoadBalancerElementsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListInternalLoadBalancerElementsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListInternalLoadBalancerElementsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListInternalLoadBalancerElementsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListInternalLoadBalancerElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams {
 	p := &ListInternalLoadBalancerElementsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
 	p := &ListInternalLoadBalancerElementsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -449,8 +733,8 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts .
 }
 
 // Lists all available Internal Load Balancer elements.
-func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerElements", p.toURLValues())
+func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams, opts ...CallOption) (*ListInternalLoadBalancerElementsResponse, error) {
+	resp, err := s.cs.newRequest("listInternalLoadBalancerElements", s.cs.encodeParams("listInternalLoadBalancerElements", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -477,469 +761,665 @@ type InternalLoadBalancerElement struct {
 }
 
 type ListInternalLoadBalancerVMsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListInternalLoadBalancerVMsParams) toURLValues() url.Values {
+	account                 optString
+	domainid                optString
+	fetchhealthcheckresults optBool
+	forvpc                  optBool
+	hostid                  optString
+	id                      optString
+	isrecursive             optBool
+	keyword                 optString
+	listall                 optBool
+	name                    optString
+	networkid               optString
+	page                    optInt
+	pagesize                optInt
+	podid                   optString
+	projectid               optString
+	state                   optString
+	vpcid                   optString
+	zoneid                  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListInternalLoadBalancerVMsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fetchhealthcheckresults"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fetchhealthcheckresults", vv)
+	if p.fetchhealthcheckresults.ok {
+		u.Set("fetchhealthcheckresults", strconv.FormatBool(p.fetchhealthcheckresults.v))
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvpc", vv)
+	if p.forvpc.ok {
+		u.Set("forvpc", strconv.FormatBool(p.forvpc.v))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListInternalLoadBalancerVMsParams parses url.Values, for example taken from a raw API request,
+// into a new ListInternalLoadBalancerVMsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListInternalLoadBalancerVMsParams(u url.Values) (*ListInternalLoadBalancerVMsParams, error) {
+	p := &ListInternalLoadBalancerVMsParams{}
+	if err := checkParamNames("listInternalLoadBalancerVMs", u, "account", "domainid", "fetchhealthcheckresults", "forvpc", "hostid", "id", "isrecursive", "keyword", "listall", "name", "networkid", "page", "pagesize", "podid", "projectid", "state", "vpcid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fetchhealthcheckresults"]; found {
+		v, err := strconv.ParseBool(u.Get("fetchhealthcheckresults"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fetchhealthcheckresults: %v", err)
+		}
+		p.SetFetchhealthcheckresults(v)
+	}
+	if _, found := u["forvpc"]; found {
+		v, err := strconv.ParseBool(u.Get("forvpc"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvpc: %v", err)
+		}
+		p.SetForvpc(v)
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListInternalLoadBalancerVMsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListInternalLoadBalancerVMsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListInternalLoadBalancerVMsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFetchhealthcheckresults sets the fetchhealthcheckresults param.
 func (p *ListInternalLoadBalancerVMsParams) SetFetchhealthcheckresults(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fetchhealthcheckresults"] = v
+	p.fetchhealthcheckresults = optBool{v: v, ok: true}
 }
 
+// ResetFetchhealthcheckresults unsets the fetchhealthcheckresults param
 func (p *ListInternalLoadBalancerVMsParams) ResetFetchhealthcheckresults() {
-	if p.p != nil && p.p["fetchhealthcheckresults"] != nil {
-		delete(p.p, "fetchhealthcheckresults")
-	}
+	p.fetchhealthcheckresults = optBool{}
 }
 
+// GetFetchhealthcheckresults returns the fetchhealthcheckresults param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetFetchhealthcheckresults() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fetchhealthcheckresults"].(bool)
-	return value, ok
+	return p.fetchhealthcheckresults.v, p.fetchhealthcheckresults.ok
 }
 
+// SetForvpc sets the forvpc param.
 func (p *ListInternalLoadBalancerVMsParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
+	p.forvpc = optBool{v: v, ok: true}
 }
 
+// ResetForvpc unsets the forvpc param
 func (p *ListInternalLoadBalancerVMsParams) ResetForvpc() {
-	if p.p != nil && p.p["forvpc"] != nil {
-		delete(p.p, "forvpc")
-	}
+	p.forvpc = optBool{}
 }
 
+// GetForvpc returns the forvpc param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetForvpc() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvpc"].(bool)
-	return value, ok
+	return p.forvpc.v, p.forvpc.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListInternalLoadBalancerVMsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListInternalLoadBalancerVMsParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param.
 func (p *ListInternalLoadBalancerVMsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListInternalLoadBalancerVMsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListInternalLoadBalancerVMsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListInternalLoadBalancerVMsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListInternalLoadBalancerVMsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListInternalLoadBalancerVMsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListInternalLoadBalancerVMsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListInternalLoadBalancerVMsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListInternalLoadBalancerVMsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListInternalLoadBalancerVMsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListInternalLoadBalancerVMsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListInternalLoadBalancerVMsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListInternalLoadBalancerVMsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListInternalLoadBalancerVMsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListInternalLoadBalancerVMsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListInternalLoadBalancerVMsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListInternalLoadBalancerVMsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListInternalLoadBalancerVMsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListInternalLoadBalancerVMsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListInternalLoadBalancerVMsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetState sets the state param.
 func (p *ListInternalLoadBalancerVMsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListInternalLoadBalancerVMsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListInternalLoadBalancerVMsParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListInternalLoadBalancerVMsParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListInternalLoadBalancerVMsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListInternalLoadBalancerVMsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListInternalLoadBalancerVMsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListInternalLoadBalancerVMsParams) Clone() *ListInternalLoadBalancerVMsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListInternalLoadBalancerVMsParams) Equal(o *ListInternalLoadBalancerVMsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fetchhealthcheckresults == o.fetchhealthcheckresults &&
+		p.forvpc == o.forvpc &&
+		p.hostid == o.hostid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.state == o.state &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListInternalLoadBalancerVMsParams is used to (un)marshal ListInternalLoadBalancerVMsParams using the API param names
+type serializedListInternalLoadBalancerVMsParams struct {
+	Account                 *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid                *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fetchhealthcheckresults *bool   `json:"fetchhealthcheckresults,omitempty" yaml:"fetchhealthcheckresults,omitempty"`
+	Forvpc                  *bool   `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Hostid                  *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id                      *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive             *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword                 *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                 *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name                    *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid               *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page                    *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                   *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid               *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State                   *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Vpcid                   *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid                  *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListInternalLoadBalancerVMsParams) toSerialized() *serializedListInternalLoadBalancerVMsParams {
+	s := &serializedListInternalLoadBalancerVMsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fetchhealthcheckresults.ok {
+		s.Fetchhealthcheckresults = &p.fetchhealthcheckresults.v
+	}
+	if p.forvpc.ok {
+		s.Forvpc = &p.forvpc.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListInternalLoadBalancerVMsParams) fromSerialized(s *serializedListInternalLoadBalancerVMsParams) {
+	*p = ListInternalLoadBalancerVMsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fetchhealthcheckresults != nil {
+		p.SetFetchhealthcheckresults(*s.Fetchhealthcheckresults)
+	}
+	if s.Forvpc != nil {
+		p.SetForvpc(*s.Forvpc)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListInternalLoadBalancerVMsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListInternalLoadBalancerVMsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListInternalLoadBalancerVMsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListInternalLoadBalancerVMsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListInternalLoadBalancerVMsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListInternalLoadBalancerVMsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListInternalLoadBalancerVMsParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams {
 	p := &ListInternalLoadBalancerVMsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -987,9 +1467,8 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string, opts ..
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1018,8 +1497,8 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...Opt
 }
 
 // List internal LB VMs.
-func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerVMs", p.toURLValues())
+func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams, opts ...CallOption) (*ListInternalLoadBalancerVMsResponse, error) {
+	resp, err := s.cs.newRequest("listInternalLoadBalancerVMs", s.cs.encodeParams("listInternalLoadBalancerVMs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1104,53 +1583,133 @@ type InternalLoadBalancerVMHealthcheckresults struct {
 }
 
 type StartInternalLoadBalancerVMParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StartInternalLoadBalancerVMParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStartInternalLoadBalancerVMParams parses url.Values, for example taken from a raw API request,
+// into a new StartInternalLoadBalancerVMParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStartInternalLoadBalancerVMParams(u url.Values) (*StartInternalLoadBalancerVMParams, error) {
+	p := &StartInternalLoadBalancerVMParams{}
+	if err := checkParamNames("startInternalLoadBalancerVM", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *StartInternalLoadBalancerVMParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StartInternalLoadBalancerVMParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StartInternalLoadBalancerVMParams) Clone() *StartInternalLoadBalancerVMParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StartInternalLoadBalancerVMParams) Equal(o *StartInternalLoadBalancerVMParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedStartInternalLoadBalancerVMParams is used to (un)marshal StartInternalLoadBalancerVMParams using the API param names
+type serializedStartInternalLoadBalancerVMParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StartInternalLoadBalancerVMParams) toSerialized() *serializedStartInternalLoadBalancerVMParams {
+	s := &serializedStartInternalLoadBalancerVMParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *StartInternalLoadBalancerVMParams) fromSerialized(s *serializedStartInternalLoadBalancerVMParams) {
+	*p = StartInternalLoadBalancerVMParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StartInternalLoadBalancerVMParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StartInternalLoadBalancerVMParams) UnmarshalJSON(b []byte) error {
+	var s serializedStartInternalLoadBalancerVMParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StartInternalLoadBalancerVMParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StartInternalLoadBalancerVMParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStartInternalLoadBalancerVMParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StartInternalLoadBalancerVMParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams {
 	p := &StartInternalLoadBalancerVMParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Starts an existing internal lb vm.
-func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("startInternalLoadBalancerVM", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams, opts ...CallOption) (*StartInternalLoadBalancerVMResponse, error) {
+	resp, err := s.cs.newRequest("startInternalLoadBalancerVM", s.cs.encodeParams("startInternalLoadBalancerVM", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1161,8 +1720,8 @@ func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBala
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1250,78 +1809,167 @@ type StartInternalLoadBalancerVMResponseHealthcheckresults struct {
 }
 
 type StopInternalLoadBalancerVMParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StopInternalLoadBalancerVMParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStopInternalLoadBalancerVMParams parses url.Values, for example taken from a raw API request,
+// into a new StopInternalLoadBalancerVMParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStopInternalLoadBalancerVMParams(u url.Values) (*StopInternalLoadBalancerVMParams, error) {
+	p := &StopInternalLoadBalancerVMParams{}
+	if err := checkParamNames("stopInternalLoadBalancerVM", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["forced"] = v
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *StopInternalLoadBalancerVMParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *StopInternalLoadBalancerVMParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *StopInternalLoadBalancerVMParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *StopInternalLoadBalancerVMParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StopInternalLoadBalancerVMParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StopInternalLoadBalancerVMParams) Clone() *StopInternalLoadBalancerVMParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StopInternalLoadBalancerVMParams) Equal(o *StopInternalLoadBalancerVMParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedStopInternalLoadBalancerVMParams is used to (un)marshal StopInternalLoadBalancerVMParams using the API param names
+type serializedStopInternalLoadBalancerVMParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StopInternalLoadBalancerVMParams) toSerialized() *serializedStopInternalLoadBalancerVMParams {
+	s := &serializedStopInternalLoadBalancerVMParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *StopInternalLoadBalancerVMParams) fromSerialized(s *serializedStopInternalLoadBalancerVMParams) {
+	*p = StopInternalLoadBalancerVMParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StopInternalLoadBalancerVMParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StopInternalLoadBalancerVMParams) UnmarshalJSON(b []byte) error {
+	var s serializedStopInternalLoadBalancerVMParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StopInternalLoadBalancerVMParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StopInternalLoadBalancerVMParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStopInternalLoadBalancerVMParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StopInternalLoadBalancerVMParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams {
 	p := &StopInternalLoadBalancerVMParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Stops an Internal LB vm.
-func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("stopInternalLoadBalancerVM", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams, opts ...CallOption) (*StopInternalLoadBalancerVMResponse, error) {
+	resp, err := s.cs.newRequest("stopInternalLoadBalancerVM", s.cs.encodeParams("stopInternalLoadBalancerVM", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1332,8 +1980,8 @@ func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalanc
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
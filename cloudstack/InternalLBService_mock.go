@@ -53,33 +53,43 @@ func (m *MockInternalLBServiceIface) EXPECT() *MockInternalLBServiceIfaceMockRec
 }
 
 // ConfigureInternalLoadBalancerElement mocks base method.
-func (m *MockInternalLBServiceIface) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
+func (m *MockInternalLBServiceIface) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams, opts ...CallOption) (*InternalLoadBalancerElementResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigureInternalLoadBalancerElement", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfigureInternalLoadBalancerElement", varargs...)
 	ret0, _ := ret[0].(*InternalLoadBalancerElementResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfigureInternalLoadBalancerElement indicates an expected call of ConfigureInternalLoadBalancerElement.
-func (mr *MockInternalLBServiceIfaceMockRecorder) ConfigureInternalLoadBalancerElement(p interface{}) *gomock.Call {
+func (mr *MockInternalLBServiceIfaceMockRecorder) ConfigureInternalLoadBalancerElement(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureInternalLoadBalancerElement", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ConfigureInternalLoadBalancerElement), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureInternalLoadBalancerElement", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ConfigureInternalLoadBalancerElement), varargs...)
 }
 
 // CreateInternalLoadBalancerElement mocks base method.
-func (m *MockInternalLBServiceIface) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
+func (m *MockInternalLBServiceIface) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams, opts ...CallOption) (*CreateInternalLoadBalancerElementResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInternalLoadBalancerElement", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateInternalLoadBalancerElement", varargs...)
 	ret0, _ := ret[0].(*CreateInternalLoadBalancerElementResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateInternalLoadBalancerElement indicates an expected call of CreateInternalLoadBalancerElement.
-func (mr *MockInternalLBServiceIfaceMockRecorder) CreateInternalLoadBalancerElement(p interface{}) *gomock.Call {
+func (mr *MockInternalLBServiceIfaceMockRecorder) CreateInternalLoadBalancerElement(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInternalLoadBalancerElement", reflect.TypeOf((*MockInternalLBServiceIface)(nil).CreateInternalLoadBalancerElement), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInternalLoadBalancerElement", reflect.TypeOf((*MockInternalLBServiceIface)(nil).CreateInternalLoadBalancerElement), varargs...)
 }
 
 // GetInternalLoadBalancerElementByID mocks base method.
@@ -167,33 +177,43 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerVMID(na
 }
 
 // ListInternalLoadBalancerElements mocks base method.
-func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams, opts ...CallOption) (*ListInternalLoadBalancerElementsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListInternalLoadBalancerElements", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerElements", varargs...)
 	ret0, _ := ret[0].(*ListInternalLoadBalancerElementsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListInternalLoadBalancerElements indicates an expected call of ListInternalLoadBalancerElements.
-func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElements(p interface{}) *gomock.Call {
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElements(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElements", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElements), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElements", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElements), varargs...)
 }
 
 // ListInternalLoadBalancerVMs mocks base method.
-func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams, opts ...CallOption) (*ListInternalLoadBalancerVMsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListInternalLoadBalancerVMs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerVMs", varargs...)
 	ret0, _ := ret[0].(*ListInternalLoadBalancerVMsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListInternalLoadBalancerVMs indicates an expected call of ListInternalLoadBalancerVMs.
-func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMs(p interface{}) *gomock.Call {
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMs", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMs", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMs), varargs...)
 }
 
 // NewConfigureInternalLoadBalancerElementParams mocks base method.
@@ -281,31 +301,41 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) NewStopInternalLoadBalancerVMP
 }
 
 // StartInternalLoadBalancerVM mocks base method.
-func (m *MockInternalLBServiceIface) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
+func (m *MockInternalLBServiceIface) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams, opts ...CallOption) (*StartInternalLoadBalancerVMResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartInternalLoadBalancerVM", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartInternalLoadBalancerVM", varargs...)
 	ret0, _ := ret[0].(*StartInternalLoadBalancerVMResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartInternalLoadBalancerVM indicates an expected call of StartInternalLoadBalancerVM.
-func (mr *MockInternalLBServiceIfaceMockRecorder) StartInternalLoadBalancerVM(p interface{}) *gomock.Call {
+func (mr *MockInternalLBServiceIfaceMockRecorder) StartInternalLoadBalancerVM(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInternalLoadBalancerVM", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StartInternalLoadBalancerVM), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInternalLoadBalancerVM", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StartInternalLoadBalancerVM), varargs...)
 }
 
 // StopInternalLoadBalancerVM mocks base method.
-func (m *MockInternalLBServiceIface) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
+func (m *MockInternalLBServiceIface) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams, opts ...CallOption) (*StopInternalLoadBalancerVMResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopInternalLoadBalancerVM", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopInternalLoadBalancerVM", varargs...)
 	ret0, _ := ret[0].(*StopInternalLoadBalancerVMResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StopInternalLoadBalancerVM indicates an expected call of StopInternalLoadBalancerVM.
-func (mr *MockInternalLBServiceIfaceMockRecorder) StopInternalLoadBalancerVM(p interface{}) *gomock.Call {
+func (mr *MockInternalLBServiceIfaceMockRecorder) StopInternalLoadBalancerVM(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInternalLoadBalancerVM", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StopInternalLoadBalancerVM), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInternalLoadBalancerVM", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StopInternalLoadBalancerVM), varargs...)
 }
@@ -28,263 +28,393 @@ import (
 )
 
 type KubernetesServiceIface interface {
-	AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error)
+	AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams, opts ...CallOption) (*AddKubernetesSupportedVersionResponse, error)
 	NewAddKubernetesSupportedVersionParams(mincpunumber int, minmemory int, semanticversion string) *AddKubernetesSupportedVersionParams
-	CreateKubernetesCluster(p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error)
+	CreateKubernetesCluster(p *CreateKubernetesClusterParams, opts ...CallOption) (*CreateKubernetesClusterResponse, error)
 	NewCreateKubernetesClusterParams(description string, kubernetesversionid string, name string, serviceofferingid string, size int64, zoneid string) *CreateKubernetesClusterParams
-	DeleteKubernetesCluster(p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error)
+	DeleteKubernetesCluster(p *DeleteKubernetesClusterParams, opts ...CallOption) (*DeleteKubernetesClusterResponse, error)
 	NewDeleteKubernetesClusterParams(id string) *DeleteKubernetesClusterParams
-	DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error)
+	DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams, opts ...CallOption) (*DeleteKubernetesSupportedVersionResponse, error)
 	NewDeleteKubernetesSupportedVersionParams(id string) *DeleteKubernetesSupportedVersionParams
-	GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error)
+	GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams, opts ...CallOption) (*GetKubernetesClusterConfigResponse, error)
 	NewGetKubernetesClusterConfigParams() *GetKubernetesClusterConfigParams
-	ListKubernetesClusters(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error)
+	ListKubernetesClusters(p *ListKubernetesClustersParams, opts ...CallOption) (*ListKubernetesClustersResponse, error)
 	NewListKubernetesClustersParams() *ListKubernetesClustersParams
 	GetKubernetesClusterID(name string, opts ...OptionFunc) (string, int, error)
 	GetKubernetesClusterByName(name string, opts ...OptionFunc) (*KubernetesCluster, int, error)
 	GetKubernetesClusterByID(id string, opts ...OptionFunc) (*KubernetesCluster, int, error)
-	ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error)
+	ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams, opts ...CallOption) (*ListKubernetesSupportedVersionsResponse, error)
 	NewListKubernetesSupportedVersionsParams() *ListKubernetesSupportedVersionsParams
 	GetKubernetesSupportedVersionID(keyword string, opts ...OptionFunc) (string, int, error)
 	GetKubernetesSupportedVersionByName(name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
 	GetKubernetesSupportedVersionByID(id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
-	ScaleKubernetesCluster(p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error)
+	ScaleKubernetesCluster(p *ScaleKubernetesClusterParams, opts ...CallOption) (*ScaleKubernetesClusterResponse, error)
 	NewScaleKubernetesClusterParams(id string) *ScaleKubernetesClusterParams
-	StartKubernetesCluster(p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error)
+	StartKubernetesCluster(p *StartKubernetesClusterParams, opts ...CallOption) (*StartKubernetesClusterResponse, error)
 	NewStartKubernetesClusterParams(id string) *StartKubernetesClusterParams
-	StopKubernetesCluster(p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error)
+	StopKubernetesCluster(p *StopKubernetesClusterParams, opts ...CallOption) (*StopKubernetesClusterResponse, error)
 	NewStopKubernetesClusterParams(id string) *StopKubernetesClusterParams
-	UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error)
+	UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams, opts ...CallOption) (*UpdateKubernetesSupportedVersionResponse, error)
 	NewUpdateKubernetesSupportedVersionParams(id string, state string) *UpdateKubernetesSupportedVersionParams
-	UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error)
+	UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams, opts ...CallOption) (*UpgradeKubernetesClusterResponse, error)
 	NewUpgradeKubernetesClusterParams(id string, kubernetesversionid string) *UpgradeKubernetesClusterParams
-	AddVirtualMachinesToKubernetesCluster(p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error)
+	AddVirtualMachinesToKubernetesCluster(p *AddVirtualMachinesToKubernetesClusterParams, opts ...CallOption) (*AddVirtualMachinesToKubernetesClusterResponse, error)
 	NewAddVirtualMachinesToKubernetesClusterParams(id string, virtualmachineids []string) *AddVirtualMachinesToKubernetesClusterParams
-	RemoveVirtualMachinesFromKubernetesCluster(p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error)
+	RemoveVirtualMachinesFromKubernetesCluster(p *RemoveVirtualMachinesFromKubernetesClusterParams, opts ...CallOption) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error)
 	NewRemoveVirtualMachinesFromKubernetesClusterParams(id string, virtualmachineids []string) *RemoveVirtualMachinesFromKubernetesClusterParams
 }
 
 type AddKubernetesSupportedVersionParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddKubernetesSupportedVersionParams) toURLValues() url.Values {
+	checksum        optString
+	directdownload  optBool
+	mincpunumber    optInt
+	minmemory       optInt
+	name            optString
+	semanticversion optString
+	url             optString
+	zoneid          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddKubernetesSupportedVersionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	if p.checksum.ok {
+		u.Set("checksum", p.checksum.v)
 	}
-	if v, found := p.p["directdownload"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("directdownload", vv)
+	if p.directdownload.ok {
+		u.Set("directdownload", strconv.FormatBool(p.directdownload.v))
 	}
-	if v, found := p.p["mincpunumber"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("mincpunumber", vv)
+	if p.mincpunumber.ok {
+		u.Set("mincpunumber", strconv.Itoa(p.mincpunumber.v))
 	}
-	if v, found := p.p["minmemory"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("minmemory", vv)
+	if p.minmemory.ok {
+		u.Set("minmemory", strconv.Itoa(p.minmemory.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["semanticversion"]; found {
-		u.Set("semanticversion", v.(string))
+	if p.semanticversion.ok {
+		u.Set("semanticversion", p.semanticversion.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddKubernetesSupportedVersionParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddKubernetesSupportedVersionParams parses url.Values, for example taken from a raw API request,
+// into a new AddKubernetesSupportedVersionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddKubernetesSupportedVersionParams(u url.Values) (*AddKubernetesSupportedVersionParams, error) {
+	p := &AddKubernetesSupportedVersionParams{}
+	if err := checkParamNames("addKubernetesSupportedVersion", u, "checksum", "directdownload", "mincpunumber", "minmemory", "name", "semanticversion", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["checksum"]; found {
+		p.SetChecksum(u.Get("checksum"))
+	}
+	if _, found := u["directdownload"]; found {
+		v, err := strconv.ParseBool(u.Get("directdownload"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param directdownload: %v", err)
+		}
+		p.SetDirectdownload(v)
+	}
+	if _, found := u["mincpunumber"]; found {
+		v, err := strconv.Atoi(u.Get("mincpunumber"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param mincpunumber: %v", err)
+		}
+		p.SetMincpunumber(v)
 	}
-	p.p["checksum"] = v
+	if _, found := u["minmemory"]; found {
+		v, err := strconv.Atoi(u.Get("minmemory"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param minmemory: %v", err)
+		}
+		p.SetMinmemory(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["semanticversion"]; found {
+		p.SetSemanticversion(u.Get("semanticversion"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetChecksum sets the checksum param.
+func (p *AddKubernetesSupportedVersionParams) SetChecksum(v string) {
+	p.checksum = optString{v: v, ok: true}
+}
+
+// ResetChecksum unsets the checksum param
 func (p *AddKubernetesSupportedVersionParams) ResetChecksum() {
-	if p.p != nil && p.p["checksum"] != nil {
-		delete(p.p, "checksum")
-	}
+	p.checksum = optString{}
 }
 
+// GetChecksum returns the checksum param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetChecksum() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["checksum"].(string)
-	return value, ok
+	return p.checksum.v, p.checksum.ok
 }
 
+// SetDirectdownload sets the directdownload param.
 func (p *AddKubernetesSupportedVersionParams) SetDirectdownload(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["directdownload"] = v
+	p.directdownload = optBool{v: v, ok: true}
 }
 
+// ResetDirectdownload unsets the directdownload param
 func (p *AddKubernetesSupportedVersionParams) ResetDirectdownload() {
-	if p.p != nil && p.p["directdownload"] != nil {
-		delete(p.p, "directdownload")
-	}
+	p.directdownload = optBool{}
 }
 
+// GetDirectdownload returns the directdownload param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetDirectdownload() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["directdownload"].(bool)
-	return value, ok
+	return p.directdownload.v, p.directdownload.ok
 }
 
+// SetMincpunumber sets the mincpunumber param. This param is required.
 func (p *AddKubernetesSupportedVersionParams) SetMincpunumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["mincpunumber"] = v
+	p.mincpunumber = optInt{v: v, ok: true}
 }
 
+// ResetMincpunumber unsets the mincpunumber param
 func (p *AddKubernetesSupportedVersionParams) ResetMincpunumber() {
-	if p.p != nil && p.p["mincpunumber"] != nil {
-		delete(p.p, "mincpunumber")
-	}
+	p.mincpunumber = optInt{}
 }
 
+// GetMincpunumber returns the mincpunumber param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetMincpunumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["mincpunumber"].(int)
-	return value, ok
+	return p.mincpunumber.v, p.mincpunumber.ok
 }
 
+// SetMinmemory sets the minmemory param. This param is required.
 func (p *AddKubernetesSupportedVersionParams) SetMinmemory(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minmemory"] = v
+	p.minmemory = optInt{v: v, ok: true}
 }
 
+// ResetMinmemory unsets the minmemory param
 func (p *AddKubernetesSupportedVersionParams) ResetMinmemory() {
-	if p.p != nil && p.p["minmemory"] != nil {
-		delete(p.p, "minmemory")
-	}
+	p.minmemory = optInt{}
 }
 
+// GetMinmemory returns the minmemory param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetMinmemory() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["minmemory"].(int)
-	return value, ok
+	return p.minmemory.v, p.minmemory.ok
 }
 
+// SetName sets the name param.
 func (p *AddKubernetesSupportedVersionParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *AddKubernetesSupportedVersionParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetSemanticversion sets the semanticversion param. This param is required.
 func (p *AddKubernetesSupportedVersionParams) SetSemanticversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["semanticversion"] = v
+	p.semanticversion = optString{v: v, ok: true}
 }
 
+// ResetSemanticversion unsets the semanticversion param
 func (p *AddKubernetesSupportedVersionParams) ResetSemanticversion() {
-	if p.p != nil && p.p["semanticversion"] != nil {
-		delete(p.p, "semanticversion")
-	}
+	p.semanticversion = optString{}
 }
 
+// GetSemanticversion returns the semanticversion param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetSemanticversion() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["semanticversion"].(string)
-	return value, ok
+	return p.semanticversion.v, p.semanticversion.ok
 }
 
+// SetUrl sets the url param.
 func (p *AddKubernetesSupportedVersionParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddKubernetesSupportedVersionParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *AddKubernetesSupportedVersionParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddKubernetesSupportedVersionParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddKubernetesSupportedVersionParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddKubernetesSupportedVersionParams) Clone() *AddKubernetesSupportedVersionParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddKubernetesSupportedVersionParams) Equal(o *AddKubernetesSupportedVersionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.checksum == o.checksum &&
+		p.directdownload == o.directdownload &&
+		p.mincpunumber == o.mincpunumber &&
+		p.minmemory == o.minmemory &&
+		p.name == o.name &&
+		p.semanticversion == o.semanticversion &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddKubernetesSupportedVersionParams is used to (un)marshal AddKubernetesSupportedVersionParams using the API param names
+type serializedAddKubernetesSupportedVersionParams struct {
+	Checksum        *string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Directdownload  *bool   `json:"directdownload,omitempty" yaml:"directdownload,omitempty"`
+	Mincpunumber    *int    `json:"mincpunumber,omitempty" yaml:"mincpunumber,omitempty"`
+	Minmemory       *int    `json:"minmemory,omitempty" yaml:"minmemory,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Semanticversion *string `json:"semanticversion,omitempty" yaml:"semanticversion,omitempty"`
+	Url             *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddKubernetesSupportedVersionParams) toSerialized() *serializedAddKubernetesSupportedVersionParams {
+	s := &serializedAddKubernetesSupportedVersionParams{}
+	if p.checksum.ok {
+		s.Checksum = &p.checksum.v
+	}
+	if p.directdownload.ok {
+		s.Directdownload = &p.directdownload.v
+	}
+	if p.mincpunumber.ok {
+		s.Mincpunumber = &p.mincpunumber.v
+	}
+	if p.minmemory.ok {
+		s.Minmemory = &p.minmemory.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.semanticversion.ok {
+		s.Semanticversion = &p.semanticversion.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddKubernetesSupportedVersionParams) fromSerialized(s *serializedAddKubernetesSupportedVersionParams) {
+	*p = AddKubernetesSupportedVersionParams{}
+	if s.Checksum != nil {
+		p.SetChecksum(*s.Checksum)
+	}
+	if s.Directdownload != nil {
+		p.SetDirectdownload(*s.Directdownload)
+	}
+	if s.Mincpunumber != nil {
+		p.SetMincpunumber(*s.Mincpunumber)
+	}
+	if s.Minmemory != nil {
+		p.SetMinmemory(*s.Minmemory)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Semanticversion != nil {
+		p.SetSemanticversion(*s.Semanticversion)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddKubernetesSupportedVersionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddKubernetesSupportedVersionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddKubernetesSupportedVersionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddKubernetesSupportedVersionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddKubernetesSupportedVersionParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewAddKubernetesSupportedVersionParams(mincpunumber int, minmemory int, semanticversion string) *AddKubernetesSupportedVersionParams {
 	p := &AddKubernetesSupportedVersionParams{}
-	p.p = make(map[string]interface{})
-	p.p["mincpunumber"] = mincpunumber
-	p.p["minmemory"] = minmemory
-	p.p["semanticversion"] = semanticversion
+	p.SetMincpunumber(mincpunumber)
+	p.SetMinmemory(minmemory)
+	p.SetSemanticversion(semanticversion)
 	return p
 }
 
-// Add a supported Kubernetes version
-func (s *KubernetesService) AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error) {
-	resp, err := s.cs.newRequest("addKubernetesSupportedVersion", p.toURLValues())
+// Add a supported Kubernetes version.
+//
+// Required params: mincpunumber, minmemory, semanticversion.
+func (s *KubernetesService) AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams, opts ...CallOption) (*AddKubernetesSupportedVersionResponse, error) {
+	resp, err := s.cs.newRequest("addKubernetesSupportedVersion", s.cs.encodeParams("addKubernetesSupportedVersion", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -322,494 +452,695 @@ type AddKubernetesSupportedVersionResponse struct {
 }
 
 type CreateKubernetesClusterParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateKubernetesClusterParams) toURLValues() url.Values {
+	account                       optString
+	clustertype                   optString
+	controlnodes                  optInt64
+	description                   optString
+	dockerregistrypassword        optString
+	dockerregistryurl             optString
+	dockerregistryusername        optString
+	domainid                      optString
+	externalloadbalanceripaddress optString
+	keypair                       optString
+	kubernetesversionid           optString
+	masternodes                   optInt64
+	name                          optString
+	networkid                     optString
+	noderootdisksize              optInt64
+	projectid                     optString
+	serviceofferingid             optString
+	size                          optInt64
+	zoneid                        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateKubernetesClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
+	if p.clustertype.ok {
+		u.Set("clustertype", p.clustertype.v)
 	}
-	if v, found := p.p["controlnodes"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("controlnodes", vv)
+	if p.controlnodes.ok {
+		u.Set("controlnodes", strconv.FormatInt(p.controlnodes.v, 10))
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["dockerregistrypassword"]; found {
-		u.Set("dockerregistrypassword", v.(string))
+	if p.dockerregistrypassword.ok {
+		u.Set("dockerregistrypassword", p.dockerregistrypassword.v)
 	}
-	if v, found := p.p["dockerregistryurl"]; found {
-		u.Set("dockerregistryurl", v.(string))
+	if p.dockerregistryurl.ok {
+		u.Set("dockerregistryurl", p.dockerregistryurl.v)
 	}
-	if v, found := p.p["dockerregistryusername"]; found {
-		u.Set("dockerregistryusername", v.(string))
+	if p.dockerregistryusername.ok {
+		u.Set("dockerregistryusername", p.dockerregistryusername.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["externalloadbalanceripaddress"]; found {
-		u.Set("externalloadbalanceripaddress", v.(string))
+	if p.externalloadbalanceripaddress.ok {
+		u.Set("externalloadbalanceripaddress", p.externalloadbalanceripaddress.v)
 	}
-	if v, found := p.p["keypair"]; found {
-		u.Set("keypair", v.(string))
+	if p.keypair.ok {
+		u.Set("keypair", p.keypair.v)
 	}
-	if v, found := p.p["kubernetesversionid"]; found {
-		u.Set("kubernetesversionid", v.(string))
+	if p.kubernetesversionid.ok {
+		u.Set("kubernetesversionid", p.kubernetesversionid.v)
 	}
-	if v, found := p.p["masternodes"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("masternodes", vv)
+	if p.masternodes.ok {
+		u.Set("masternodes", strconv.FormatInt(p.masternodes.v, 10))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["noderootdisksize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("noderootdisksize", vv)
+	if p.noderootdisksize.ok {
+		u.Set("noderootdisksize", strconv.FormatInt(p.noderootdisksize.v, 10))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
+	if p.size.ok {
+		u.Set("size", strconv.FormatInt(p.size.v, 10))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateKubernetesClusterParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new CreateKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateKubernetesClusterParams(u url.Values) (*CreateKubernetesClusterParams, error) {
+	p := &CreateKubernetesClusterParams{}
+	if err := checkParamNames("createKubernetesCluster", u, "account", "clustertype", "controlnodes", "description", "dockerregistrypassword", "dockerregistryurl", "dockerregistryusername", "domainid", "externalloadbalanceripaddress", "keypair", "kubernetesversionid", "masternodes", "name", "networkid", "noderootdisksize", "projectid", "serviceofferingid", "size", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["clustertype"]; found {
+		p.SetClustertype(u.Get("clustertype"))
+	}
+	if _, found := u["controlnodes"]; found {
+		v, err := strconv.ParseInt(u.Get("controlnodes"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param controlnodes: %v", err)
+		}
+		p.SetControlnodes(v)
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["dockerregistrypassword"]; found {
+		p.SetDockerregistrypassword(u.Get("dockerregistrypassword"))
+	}
+	if _, found := u["dockerregistryurl"]; found {
+		p.SetDockerregistryurl(u.Get("dockerregistryurl"))
+	}
+	if _, found := u["dockerregistryusername"]; found {
+		p.SetDockerregistryusername(u.Get("dockerregistryusername"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["externalloadbalanceripaddress"]; found {
+		p.SetExternalloadbalanceripaddress(u.Get("externalloadbalanceripaddress"))
+	}
+	if _, found := u["keypair"]; found {
+		p.SetKeypair(u.Get("keypair"))
+	}
+	if _, found := u["kubernetesversionid"]; found {
+		p.SetKubernetesversionid(u.Get("kubernetesversionid"))
+	}
+	if _, found := u["masternodes"]; found {
+		v, err := strconv.ParseInt(u.Get("masternodes"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param masternodes: %v", err)
+		}
+		p.SetMasternodes(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["noderootdisksize"]; found {
+		v, err := strconv.ParseInt(u.Get("noderootdisksize"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param noderootdisksize: %v", err)
+		}
+		p.SetNoderootdisksize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["size"]; found {
+		v, err := strconv.ParseInt(u.Get("size"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param size: %v", err)
+		}
+		p.SetSize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateKubernetesClusterParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateKubernetesClusterParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateKubernetesClusterParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetClustertype sets the clustertype param.
 func (p *CreateKubernetesClusterParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
+	p.clustertype = optString{v: v, ok: true}
 }
 
+// ResetClustertype unsets the clustertype param
 func (p *CreateKubernetesClusterParams) ResetClustertype() {
-	if p.p != nil && p.p["clustertype"] != nil {
-		delete(p.p, "clustertype")
-	}
+	p.clustertype = optString{}
 }
 
+// GetClustertype returns the clustertype param and if it is set
 func (p *CreateKubernetesClusterParams) GetClustertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustertype"].(string)
-	return value, ok
+	return p.clustertype.v, p.clustertype.ok
 }
 
+// SetControlnodes sets the controlnodes param.
 func (p *CreateKubernetesClusterParams) SetControlnodes(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["controlnodes"] = v
+	p.controlnodes = optInt64{v: v, ok: true}
 }
 
+// ResetControlnodes unsets the controlnodes param
 func (p *CreateKubernetesClusterParams) ResetControlnodes() {
-	if p.p != nil && p.p["controlnodes"] != nil {
-		delete(p.p, "controlnodes")
-	}
+	p.controlnodes = optInt64{}
 }
 
+// GetControlnodes returns the controlnodes param and if it is set
 func (p *CreateKubernetesClusterParams) GetControlnodes() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["controlnodes"].(int64)
-	return value, ok
+	return p.controlnodes.v, p.controlnodes.ok
 }
 
+// SetDescription sets the description param. This param is required.
 func (p *CreateKubernetesClusterParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateKubernetesClusterParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateKubernetesClusterParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetDockerregistrypassword sets the dockerregistrypassword param.
 func (p *CreateKubernetesClusterParams) SetDockerregistrypassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dockerregistrypassword"] = v
+	p.dockerregistrypassword = optString{v: v, ok: true}
 }
 
+// ResetDockerregistrypassword unsets the dockerregistrypassword param
 func (p *CreateKubernetesClusterParams) ResetDockerregistrypassword() {
-	if p.p != nil && p.p["dockerregistrypassword"] != nil {
-		delete(p.p, "dockerregistrypassword")
-	}
+	p.dockerregistrypassword = optString{}
 }
 
+// GetDockerregistrypassword returns the dockerregistrypassword param and if it is set
 func (p *CreateKubernetesClusterParams) GetDockerregistrypassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dockerregistrypassword"].(string)
-	return value, ok
+	return p.dockerregistrypassword.v, p.dockerregistrypassword.ok
 }
 
+// SetDockerregistryurl sets the dockerregistryurl param.
 func (p *CreateKubernetesClusterParams) SetDockerregistryurl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dockerregistryurl"] = v
+	p.dockerregistryurl = optString{v: v, ok: true}
 }
 
+// ResetDockerregistryurl unsets the dockerregistryurl param
 func (p *CreateKubernetesClusterParams) ResetDockerregistryurl() {
-	if p.p != nil && p.p["dockerregistryurl"] != nil {
-		delete(p.p, "dockerregistryurl")
-	}
+	p.dockerregistryurl = optString{}
 }
 
+// GetDockerregistryurl returns the dockerregistryurl param and if it is set
 func (p *CreateKubernetesClusterParams) GetDockerregistryurl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dockerregistryurl"].(string)
-	return value, ok
+	return p.dockerregistryurl.v, p.dockerregistryurl.ok
 }
 
+// SetDockerregistryusername sets the dockerregistryusername param.
 func (p *CreateKubernetesClusterParams) SetDockerregistryusername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dockerregistryusername"] = v
+	p.dockerregistryusername = optString{v: v, ok: true}
 }
 
+// ResetDockerregistryusername unsets the dockerregistryusername param
 func (p *CreateKubernetesClusterParams) ResetDockerregistryusername() {
-	if p.p != nil && p.p["dockerregistryusername"] != nil {
-		delete(p.p, "dockerregistryusername")
-	}
+	p.dockerregistryusername = optString{}
 }
 
+// GetDockerregistryusername returns the dockerregistryusername param and if it is set
 func (p *CreateKubernetesClusterParams) GetDockerregistryusername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dockerregistryusername"].(string)
-	return value, ok
+	return p.dockerregistryusername.v, p.dockerregistryusername.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateKubernetesClusterParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateKubernetesClusterParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateKubernetesClusterParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetExternalloadbalanceripaddress sets the externalloadbalanceripaddress param.
 func (p *CreateKubernetesClusterParams) SetExternalloadbalanceripaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["externalloadbalanceripaddress"] = v
+	p.externalloadbalanceripaddress = optString{v: v, ok: true}
 }
 
+// ResetExternalloadbalanceripaddress unsets the externalloadbalanceripaddress param
 func (p *CreateKubernetesClusterParams) ResetExternalloadbalanceripaddress() {
-	if p.p != nil && p.p["externalloadbalanceripaddress"] != nil {
-		delete(p.p, "externalloadbalanceripaddress")
-	}
+	p.externalloadbalanceripaddress = optString{}
 }
 
+// GetExternalloadbalanceripaddress returns the externalloadbalanceripaddress param and if it is set
 func (p *CreateKubernetesClusterParams) GetExternalloadbalanceripaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["externalloadbalanceripaddress"].(string)
-	return value, ok
+	return p.externalloadbalanceripaddress.v, p.externalloadbalanceripaddress.ok
 }
 
+// SetKeypair sets the keypair param.
 func (p *CreateKubernetesClusterParams) SetKeypair(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypair"] = v
+	p.keypair = optString{v: v, ok: true}
 }
 
+// ResetKeypair unsets the keypair param
 func (p *CreateKubernetesClusterParams) ResetKeypair() {
-	if p.p != nil && p.p["keypair"] != nil {
-		delete(p.p, "keypair")
-	}
+	p.keypair = optString{}
 }
 
+// GetKeypair returns the keypair param and if it is set
 func (p *CreateKubernetesClusterParams) GetKeypair() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keypair"].(string)
-	return value, ok
+	return p.keypair.v, p.keypair.ok
 }
 
+// SetKubernetesversionid sets the kubernetesversionid param. This param is required.
 func (p *CreateKubernetesClusterParams) SetKubernetesversionid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["kubernetesversionid"] = v
+	p.kubernetesversionid = optString{v: v, ok: true}
 }
 
+// ResetKubernetesversionid unsets the kubernetesversionid param
 func (p *CreateKubernetesClusterParams) ResetKubernetesversionid() {
-	if p.p != nil && p.p["kubernetesversionid"] != nil {
-		delete(p.p, "kubernetesversionid")
-	}
+	p.kubernetesversionid = optString{}
 }
 
+// GetKubernetesversionid returns the kubernetesversionid param and if it is set
 func (p *CreateKubernetesClusterParams) GetKubernetesversionid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["kubernetesversionid"].(string)
-	return value, ok
+	return p.kubernetesversionid.v, p.kubernetesversionid.ok
 }
 
+// SetMasternodes sets the masternodes param.
 func (p *CreateKubernetesClusterParams) SetMasternodes(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["masternodes"] = v
+	p.masternodes = optInt64{v: v, ok: true}
 }
 
+// ResetMasternodes unsets the masternodes param
 func (p *CreateKubernetesClusterParams) ResetMasternodes() {
-	if p.p != nil && p.p["masternodes"] != nil {
-		delete(p.p, "masternodes")
-	}
+	p.masternodes = optInt64{}
 }
 
+// GetMasternodes returns the masternodes param and if it is set
 func (p *CreateKubernetesClusterParams) GetMasternodes() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["masternodes"].(int64)
-	return value, ok
+	return p.masternodes.v, p.masternodes.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateKubernetesClusterParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateKubernetesClusterParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateKubernetesClusterParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *CreateKubernetesClusterParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateKubernetesClusterParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateKubernetesClusterParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetNoderootdisksize sets the noderootdisksize param.
 func (p *CreateKubernetesClusterParams) SetNoderootdisksize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["noderootdisksize"] = v
+	p.noderootdisksize = optInt64{v: v, ok: true}
 }
 
+// ResetNoderootdisksize unsets the noderootdisksize param
 func (p *CreateKubernetesClusterParams) ResetNoderootdisksize() {
-	if p.p != nil && p.p["noderootdisksize"] != nil {
-		delete(p.p, "noderootdisksize")
-	}
+	p.noderootdisksize = optInt64{}
 }
 
+// GetNoderootdisksize returns the noderootdisksize param and if it is set
 func (p *CreateKubernetesClusterParams) GetNoderootdisksize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["noderootdisksize"].(int64)
-	return value, ok
+	return p.noderootdisksize.v, p.noderootdisksize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateKubernetesClusterParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateKubernetesClusterParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateKubernetesClusterParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *CreateKubernetesClusterParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *CreateKubernetesClusterParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *CreateKubernetesClusterParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetSize sets the size param. This param is required.
 func (p *CreateKubernetesClusterParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["size"] = v
+	p.size = optInt64{v: v, ok: true}
 }
 
+// ResetSize unsets the size param
 func (p *CreateKubernetesClusterParams) ResetSize() {
-	if p.p != nil && p.p["size"] != nil {
-		delete(p.p, "size")
-	}
+	p.size = optInt64{}
 }
 
+// GetSize returns the size param and if it is set
 func (p *CreateKubernetesClusterParams) GetSize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["size"].(int64)
-	return value, ok
+	return p.size.v, p.size.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreateKubernetesClusterParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateKubernetesClusterParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateKubernetesClusterParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateKubernetesClusterParams) Clone() *CreateKubernetesClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateKubernetesClusterParams) Equal(o *CreateKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.clustertype == o.clustertype &&
+		p.controlnodes == o.controlnodes &&
+		p.description == o.description &&
+		p.dockerregistrypassword == o.dockerregistrypassword &&
+		p.dockerregistryurl == o.dockerregistryurl &&
+		p.dockerregistryusername == o.dockerregistryusername &&
+		p.domainid == o.domainid &&
+		p.externalloadbalanceripaddress == o.externalloadbalanceripaddress &&
+		p.keypair == o.keypair &&
+		p.kubernetesversionid == o.kubernetesversionid &&
+		p.masternodes == o.masternodes &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.noderootdisksize == o.noderootdisksize &&
+		p.projectid == o.projectid &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.size == o.size &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateKubernetesClusterParams is used to (un)marshal CreateKubernetesClusterParams using the API param names
+type serializedCreateKubernetesClusterParams struct {
+	Account                       *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clustertype                   *string `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Controlnodes                  *int64  `json:"controlnodes,omitempty" yaml:"controlnodes,omitempty"`
+	Description                   *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Dockerregistrypassword        *string `json:"dockerregistrypassword,omitempty" yaml:"dockerregistrypassword,omitempty"`
+	Dockerregistryurl             *string `json:"dockerregistryurl,omitempty" yaml:"dockerregistryurl,omitempty"`
+	Dockerregistryusername        *string `json:"dockerregistryusername,omitempty" yaml:"dockerregistryusername,omitempty"`
+	Domainid                      *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Externalloadbalanceripaddress *string `json:"externalloadbalanceripaddress,omitempty" yaml:"externalloadbalanceripaddress,omitempty"`
+	Keypair                       *string `json:"keypair,omitempty" yaml:"keypair,omitempty"`
+	Kubernetesversionid           *string `json:"kubernetesversionid,omitempty" yaml:"kubernetesversionid,omitempty"`
+	Masternodes                   *int64  `json:"masternodes,omitempty" yaml:"masternodes,omitempty"`
+	Name                          *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid                     *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Noderootdisksize              *int64  `json:"noderootdisksize,omitempty" yaml:"noderootdisksize,omitempty"`
+	Projectid                     *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Serviceofferingid             *string `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Size                          *int64  `json:"size,omitempty" yaml:"size,omitempty"`
+	Zoneid                        *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateKubernetesClusterParams) toSerialized() *serializedCreateKubernetesClusterParams {
+	s := &serializedCreateKubernetesClusterParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.clustertype.ok {
+		s.Clustertype = &p.clustertype.v
+	}
+	if p.controlnodes.ok {
+		s.Controlnodes = &p.controlnodes.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.dockerregistrypassword.ok {
+		s.Dockerregistrypassword = &p.dockerregistrypassword.v
+	}
+	if p.dockerregistryurl.ok {
+		s.Dockerregistryurl = &p.dockerregistryurl.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.dockerregistryusername.ok {
+		s.Dockerregistryusername = &p.dockerregistryusername.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.externalloadbalanceripaddress.ok {
+		s.Externalloadbalanceripaddress = &p.externalloadbalanceripaddress.v
+	}
+	if p.keypair.ok {
+		s.Keypair = &p.keypair.v
+	}
+	if p.kubernetesversionid.ok {
+		s.Kubernetesversionid = &p.kubernetesversionid.v
+	}
+	if p.masternodes.ok {
+		s.Masternodes = &p.masternodes.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.noderootdisksize.ok {
+		s.Noderootdisksize = &p.noderootdisksize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.size.ok {
+		s.Size = &p.size.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateKubernetesClusterParams) fromSerialized(s *serializedCreateKubernetesClusterParams) {
+	*p = CreateKubernetesClusterParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Clustertype != nil {
+		p.SetClustertype(*s.Clustertype)
+	}
+	if s.Controlnodes != nil {
+		p.SetControlnodes(*s.Controlnodes)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Dockerregistrypassword != nil {
+		p.SetDockerregistrypassword(*s.Dockerregistrypassword)
+	}
+	if s.Dockerregistryurl != nil {
+		p.SetDockerregistryurl(*s.Dockerregistryurl)
+	}
+	if s.Dockerregistryusername != nil {
+		p.SetDockerregistryusername(*s.Dockerregistryusername)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Externalloadbalanceripaddress != nil {
+		p.SetExternalloadbalanceripaddress(*s.Externalloadbalanceripaddress)
+	}
+	if s.Keypair != nil {
+		p.SetKeypair(*s.Keypair)
+	}
+	if s.Kubernetesversionid != nil {
+		p.SetKubernetesversionid(*s.Kubernetesversionid)
+	}
+	if s.Masternodes != nil {
+		p.SetMasternodes(*s.Masternodes)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Noderootdisksize != nil {
+		p.SetNoderootdisksize(*s.Noderootdisksize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Size != nil {
+		p.SetSize(*s.Size)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewCreateKubernetesClusterParams(description string, kubernetesversionid string, name string, serviceofferingid string, size int64, zoneid string) *CreateKubernetesClusterParams {
 	p := &CreateKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["description"] = description
-	p.p["kubernetesversionid"] = kubernetesversionid
-	p.p["name"] = name
-	p.p["serviceofferingid"] = serviceofferingid
-	p.p["size"] = size
-	p.p["zoneid"] = zoneid
+	p.SetDescription(description)
+	p.SetKubernetesversionid(kubernetesversionid)
+	p.SetName(name)
+	p.SetServiceofferingid(serviceofferingid)
+	p.SetSize(size)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Creates a Kubernetes cluster
-func (s *KubernetesService) CreateKubernetesCluster(p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("createKubernetesCluster", p.toURLValues())
+// Creates a Kubernetes cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: description,
+// kubernetesversionid, name, serviceofferingid, size, zoneid.
+func (s *KubernetesService) CreateKubernetesCluster(p *CreateKubernetesClusterParams, opts ...CallOption) (*CreateKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("createKubernetesCluster", s.cs.encodeParams("createKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -820,8 +1151,8 @@ func (s *KubernetesService) CreateKubernetesCluster(p *CreateKubernetesClusterPa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -883,103 +1214,201 @@ type CreateKubernetesClusterResponse struct {
 }
 
 type DeleteKubernetesClusterParams struct {
-	p map[string]interface{}
+	cleanup optBool
+	expunge optBool
+	id      optString
 }
 
-func (p *DeleteKubernetesClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteKubernetesClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanup", vv)
+	if p.cleanup.ok {
+		u.Set("cleanup", strconv.FormatBool(p.cleanup.v))
 	}
-	if v, found := p.p["expunge"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("expunge", vv)
+	if p.expunge.ok {
+		u.Set("expunge", strconv.FormatBool(p.expunge.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteKubernetesClusterParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteKubernetesClusterParams(u url.Values) (*DeleteKubernetesClusterParams, error) {
+	p := &DeleteKubernetesClusterParams{}
+	if err := checkParamNames("deleteKubernetesCluster", u, "cleanup", "expunge", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cleanup"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanup: %v", err)
+		}
+		p.SetCleanup(v)
+	}
+	if _, found := u["expunge"]; found {
+		v, err := strconv.ParseBool(u.Get("expunge"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param expunge: %v", err)
+		}
+		p.SetExpunge(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["cleanup"] = v
+	return p, nil
 }
 
+// SetCleanup sets the cleanup param.
+func (p *DeleteKubernetesClusterParams) SetCleanup(v bool) {
+	p.cleanup = optBool{v: v, ok: true}
+}
+
+// ResetCleanup unsets the cleanup param
 func (p *DeleteKubernetesClusterParams) ResetCleanup() {
-	if p.p != nil && p.p["cleanup"] != nil {
-		delete(p.p, "cleanup")
-	}
+	p.cleanup = optBool{}
 }
 
+// GetCleanup returns the cleanup param and if it is set
 func (p *DeleteKubernetesClusterParams) GetCleanup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanup"].(bool)
-	return value, ok
+	return p.cleanup.v, p.cleanup.ok
 }
 
+// SetExpunge sets the expunge param.
 func (p *DeleteKubernetesClusterParams) SetExpunge(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["expunge"] = v
+	p.expunge = optBool{v: v, ok: true}
 }
 
+// ResetExpunge unsets the expunge param
 func (p *DeleteKubernetesClusterParams) ResetExpunge() {
-	if p.p != nil && p.p["expunge"] != nil {
-		delete(p.p, "expunge")
-	}
+	p.expunge = optBool{}
 }
 
+// GetExpunge returns the expunge param and if it is set
 func (p *DeleteKubernetesClusterParams) GetExpunge() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["expunge"].(bool)
-	return value, ok
+	return p.expunge.v, p.expunge.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteKubernetesClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteKubernetesClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteKubernetesClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteKubernetesClusterParams) Clone() *DeleteKubernetesClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteKubernetesClusterParams) Equal(o *DeleteKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cleanup == o.cleanup &&
+		p.expunge == o.expunge &&
+		p.id == o.id
+}
+
+// serializedDeleteKubernetesClusterParams is used to (un)marshal DeleteKubernetesClusterParams using the API param names
+type serializedDeleteKubernetesClusterParams struct {
+	Cleanup *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Expunge *bool   `json:"expunge,omitempty" yaml:"expunge,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteKubernetesClusterParams) toSerialized() *serializedDeleteKubernetesClusterParams {
+	s := &serializedDeleteKubernetesClusterParams{}
+	if p.cleanup.ok {
+		s.Cleanup = &p.cleanup.v
+	}
+	if p.expunge.ok {
+		s.Expunge = &p.expunge.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteKubernetesClusterParams) fromSerialized(s *serializedDeleteKubernetesClusterParams) {
+	*p = DeleteKubernetesClusterParams{}
+	if s.Cleanup != nil {
+		p.SetCleanup(*s.Cleanup)
+	}
+	if s.Expunge != nil {
+		p.SetExpunge(*s.Expunge)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewDeleteKubernetesClusterParams(id string) *DeleteKubernetesClusterParams {
 	p := &DeleteKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a Kubernetes cluster
-func (s *KubernetesService) DeleteKubernetesCluster(p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("deleteKubernetesCluster", p.toURLValues())
+// Deletes a Kubernetes cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *KubernetesService) DeleteKubernetesCluster(p *DeleteKubernetesClusterParams, opts ...CallOption) (*DeleteKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("deleteKubernetesCluster", s.cs.encodeParams("deleteKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -990,8 +1419,8 @@ func (s *KubernetesService) DeleteKubernetesCluster(p *DeleteKubernetesClusterPa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1015,53 +1444,133 @@ type DeleteKubernetesClusterResponse struct {
 }
 
 type DeleteKubernetesSupportedVersionParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteKubernetesSupportedVersionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteKubernetesSupportedVersionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteKubernetesSupportedVersionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteKubernetesSupportedVersionParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteKubernetesSupportedVersionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteKubernetesSupportedVersionParams(u url.Values) (*DeleteKubernetesSupportedVersionParams, error) {
+	p := &DeleteKubernetesSupportedVersionParams{}
+	if err := checkParamNames("deleteKubernetesSupportedVersion", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteKubernetesSupportedVersionParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteKubernetesSupportedVersionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteKubernetesSupportedVersionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteKubernetesSupportedVersionParams) Clone() *DeleteKubernetesSupportedVersionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteKubernetesSupportedVersionParams) Equal(o *DeleteKubernetesSupportedVersionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteKubernetesSupportedVersionParams is used to (un)marshal DeleteKubernetesSupportedVersionParams using the API param names
+type serializedDeleteKubernetesSupportedVersionParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteKubernetesSupportedVersionParams) toSerialized() *serializedDeleteKubernetesSupportedVersionParams {
+	s := &serializedDeleteKubernetesSupportedVersionParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteKubernetesSupportedVersionParams) fromSerialized(s *serializedDeleteKubernetesSupportedVersionParams) {
+	*p = DeleteKubernetesSupportedVersionParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteKubernetesSupportedVersionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteKubernetesSupportedVersionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteKubernetesSupportedVersionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteKubernetesSupportedVersionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteKubernetesSupportedVersionParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewDeleteKubernetesSupportedVersionParams(id string) *DeleteKubernetesSupportedVersionParams {
 	p := &DeleteKubernetesSupportedVersionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a Kubernetes cluster
-func (s *KubernetesService) DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error) {
-	resp, err := s.cs.newRequest("deleteKubernetesSupportedVersion", p.toURLValues())
+// Deletes a Kubernetes cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *KubernetesService) DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams, opts ...CallOption) (*DeleteKubernetesSupportedVersionResponse, error) {
+	resp, err := s.cs.newRequest("deleteKubernetesSupportedVersion", s.cs.encodeParams("deleteKubernetesSupportedVersion", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1072,8 +1581,8 @@ func (s *KubernetesService) DeleteKubernetesSupportedVersion(p *DeleteKubernetes
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1097,52 +1606,129 @@ type DeleteKubernetesSupportedVersionResponse struct {
 }
 
 type GetKubernetesClusterConfigParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *GetKubernetesClusterConfigParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetKubernetesClusterConfigParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *GetKubernetesClusterConfigParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetKubernetesClusterConfigParams parses url.Values, for example taken from a raw API request,
+// into a new GetKubernetesClusterConfigParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetKubernetesClusterConfigParams(u url.Values) (*GetKubernetesClusterConfigParams, error) {
+	p := &GetKubernetesClusterConfigParams{}
+	if err := checkParamNames("getKubernetesClusterConfig", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *GetKubernetesClusterConfigParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *GetKubernetesClusterConfigParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *GetKubernetesClusterConfigParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetKubernetesClusterConfigParams) Clone() *GetKubernetesClusterConfigParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetKubernetesClusterConfigParams) Equal(o *GetKubernetesClusterConfigParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedGetKubernetesClusterConfigParams is used to (un)marshal GetKubernetesClusterConfigParams using the API param names
+type serializedGetKubernetesClusterConfigParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *GetKubernetesClusterConfigParams) toSerialized() *serializedGetKubernetesClusterConfigParams {
+	s := &serializedGetKubernetesClusterConfigParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *GetKubernetesClusterConfigParams) fromSerialized(s *serializedGetKubernetesClusterConfigParams) {
+	*p = GetKubernetesClusterConfigParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetKubernetesClusterConfigParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetKubernetesClusterConfigParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetKubernetesClusterConfigParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetKubernetesClusterConfigParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetKubernetesClusterConfigParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetKubernetesClusterConfigParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetKubernetesClusterConfigParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewGetKubernetesClusterConfigParams() *GetKubernetesClusterConfigParams {
 	p := &GetKubernetesClusterConfigParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Get Kubernetes cluster config
-func (s *KubernetesService) GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error) {
-	resp, err := s.cs.newRequest("getKubernetesClusterConfig", p.toURLValues())
+// Get Kubernetes cluster config.
+func (s *KubernetesService) GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams, opts ...CallOption) (*GetKubernetesClusterConfigResponse, error) {
+	resp, err := s.cs.newRequest("getKubernetesClusterConfig", s.cs.encodeParams("getKubernetesClusterConfig", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1167,323 +1753,477 @@ type GetKubernetesClusterConfigResponse struct {
 }
 
 type ListKubernetesClustersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListKubernetesClustersParams) toURLValues() url.Values {
+	account     optString
+	clustertype optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	state       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListKubernetesClustersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.clustertype.ok {
+		u.Set("clustertype", p.clustertype.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	if p.state.ok {
+		u.Set("state", p.state.v)
+	}
+	return u
+}
+
+// ParseListKubernetesClustersParams parses url.Values, for example taken from a raw API request,
+// into a new ListKubernetesClustersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListKubernetesClustersParams(u url.Values) (*ListKubernetesClustersParams, error) {
+	p := &ListKubernetesClustersParams{}
+	if err := checkParamNames("listKubernetesClusters", u, "account", "clustertype", "domainid", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid", "state"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
+	if _, found := u["clustertype"]; found {
+		p.SetClustertype(u.Get("clustertype"))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
 	}
-	return u
+	return p, nil
 }
 
+// SetAccount sets the account param.
 func (p *ListKubernetesClustersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListKubernetesClustersParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListKubernetesClustersParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetClustertype sets the clustertype param.
 func (p *ListKubernetesClustersParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
+	p.clustertype = optString{v: v, ok: true}
 }
 
+// ResetClustertype unsets the clustertype param
 func (p *ListKubernetesClustersParams) ResetClustertype() {
-	if p.p != nil && p.p["clustertype"] != nil {
-		delete(p.p, "clustertype")
-	}
+	p.clustertype = optString{}
 }
 
+// GetClustertype returns the clustertype param and if it is set
 func (p *ListKubernetesClustersParams) GetClustertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clustertype"].(string)
-	return value, ok
+	return p.clustertype.v, p.clustertype.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListKubernetesClustersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListKubernetesClustersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListKubernetesClustersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListKubernetesClustersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListKubernetesClustersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListKubernetesClustersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListKubernetesClustersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListKubernetesClustersParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListKubernetesClustersParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListKubernetesClustersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListKubernetesClustersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListKubernetesClustersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListKubernetesClustersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListKubernetesClustersParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListKubernetesClustersParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListKubernetesClustersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListKubernetesClustersParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListKubernetesClustersParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListKubernetesClustersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListKubernetesClustersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListKubernetesClustersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListKubernetesClustersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListKubernetesClustersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListKubernetesClustersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListKubernetesClustersParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListKubernetesClustersParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListKubernetesClustersParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetState sets the state param.
 func (p *ListKubernetesClustersParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListKubernetesClustersParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListKubernetesClustersParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.state.v, p.state.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListKubernetesClustersParams) Clone() *ListKubernetesClustersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListKubernetesClustersParams) Equal(o *ListKubernetesClustersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.clustertype == o.clustertype &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.state == o.state
+}
+
+// serializedListKubernetesClustersParams is used to (un)marshal ListKubernetesClustersParams using the API param names
+type serializedListKubernetesClustersParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clustertype *string `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+func (p *ListKubernetesClustersParams) toSerialized() *serializedListKubernetesClustersParams {
+	s := &serializedListKubernetesClustersParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.clustertype.ok {
+		s.Clustertype = &p.clustertype.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
 	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	return s
+}
+
+func (p *ListKubernetesClustersParams) fromSerialized(s *serializedListKubernetesClustersParams) {
+	*p = ListKubernetesClustersParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Clustertype != nil {
+		p.SetClustertype(*s.Clustertype)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListKubernetesClustersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListKubernetesClustersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListKubernetesClustersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListKubernetesClustersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListKubernetesClustersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListKubernetesClustersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListKubernetesClustersParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewListKubernetesClustersParams() *ListKubernetesClustersParams {
 	p := &ListKubernetesClustersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesClusterID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListKubernetesClustersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1531,9 +2271,8 @@ func (s *KubernetesService) GetKubernetesClusterByName(name string, opts ...Opti
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesClusterByID(id string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
 	p := &ListKubernetesClustersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1561,9 +2300,9 @@ func (s *KubernetesService) GetKubernetesClusterByID(id string, opts ...OptionFu
 	return nil, l.Count, fmt.Errorf("There is more then one result for KubernetesCluster UUID: %s!", id)
 }
 
-// Lists Kubernetes clusters
-func (s *KubernetesService) ListKubernetesClusters(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
-	resp, err := s.cs.newRequest("listKubernetesClusters", p.toURLValues())
+// Lists Kubernetes clusters.
+func (s *KubernetesService) ListKubernetesClusters(p *ListKubernetesClustersParams, opts ...CallOption) (*ListKubernetesClustersResponse, error) {
+	resp, err := s.cs.newRequest("listKubernetesClusters", s.cs.encodeParams("listKubernetesClusters", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1622,201 +2361,319 @@ type KubernetesCluster struct {
 }
 
 type ListKubernetesSupportedVersionsParams struct {
-	p map[string]interface{}
+	id                         optString
+	keyword                    optString
+	minimumkubernetesversionid optString
+	minimumsemanticversion     optString
+	page                       optInt
+	pagesize                   optInt
+	zoneid                     optString
 }
 
-func (p *ListKubernetesSupportedVersionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListKubernetesSupportedVersionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["minimumkubernetesversionid"]; found {
-		u.Set("minimumkubernetesversionid", v.(string))
+	if p.minimumkubernetesversionid.ok {
+		u.Set("minimumkubernetesversionid", p.minimumkubernetesversionid.v)
 	}
-	if v, found := p.p["minimumsemanticversion"]; found {
-		u.Set("minimumsemanticversion", v.(string))
+	if p.minimumsemanticversion.ok {
+		u.Set("minimumsemanticversion", p.minimumsemanticversion.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListKubernetesSupportedVersionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListKubernetesSupportedVersionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListKubernetesSupportedVersionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListKubernetesSupportedVersionsParams(u url.Values) (*ListKubernetesSupportedVersionsParams, error) {
+	p := &ListKubernetesSupportedVersionsParams{}
+	if err := checkParamNames("listKubernetesSupportedVersions", u, "id", "keyword", "minimumkubernetesversionid", "minimumsemanticversion", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["minimumkubernetesversionid"]; found {
+		p.SetMinimumkubernetesversionid(u.Get("minimumkubernetesversionid"))
+	}
+	if _, found := u["minimumsemanticversion"]; found {
+		p.SetMinimumsemanticversion(u.Get("minimumsemanticversion"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListKubernetesSupportedVersionsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListKubernetesSupportedVersionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListKubernetesSupportedVersionsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListKubernetesSupportedVersionsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListKubernetesSupportedVersionsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListKubernetesSupportedVersionsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetMinimumkubernetesversionid sets the minimumkubernetesversionid param.
 func (p *ListKubernetesSupportedVersionsParams) SetMinimumkubernetesversionid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minimumkubernetesversionid"] = v
+	p.minimumkubernetesversionid = optString{v: v, ok: true}
 }
 
+// ResetMinimumkubernetesversionid unsets the minimumkubernetesversionid param
 func (p *ListKubernetesSupportedVersionsParams) ResetMinimumkubernetesversionid() {
-	if p.p != nil && p.p["minimumkubernetesversionid"] != nil {
-		delete(p.p, "minimumkubernetesversionid")
-	}
+	p.minimumkubernetesversionid = optString{}
 }
 
+// GetMinimumkubernetesversionid returns the minimumkubernetesversionid param and if it is set
 func (p *ListKubernetesSupportedVersionsParams) GetMinimumkubernetesversionid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["minimumkubernetesversionid"].(string)
-	return value, ok
+	return p.minimumkubernetesversionid.v, p.minimumkubernetesversionid.ok
 }
 
+// SetMinimumsemanticversion sets the minimumsemanticversion param.
 func (p *ListKubernetesSupportedVersionsParams) SetMinimumsemanticversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minimumsemanticversion"] = v
+	p.minimumsemanticversion = optString{v: v, ok: true}
 }
 
+// ResetMinimumsemanticversion unsets the minimumsemanticversion param
 func (p *ListKubernetesSupportedVersionsParams) ResetMinimumsemanticversion() {
-	if p.p != nil && p.p["minimumsemanticversion"] != nil {
-		delete(p.p, "minimumsemanticversion")
-	}
+	p.minimumsemanticversion = optString{}
 }
 
+// GetMinimumsemanticversion returns the minimumsemanticversion param and if it is set
 func (p *ListKubernetesSupportedVersionsParams) GetMinimumsemanticversion() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["minimumsemanticversion"].(string)
-	return value, ok
+	return p.minimumsemanticversion.v, p.minimumsemanticversion.ok
 }
 
+// SetPage sets the page param.
 func (p *ListKubernetesSupportedVersionsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListKubernetesSupportedVersionsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListKubernetesSupportedVersionsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListKubernetesSupportedVersionsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListKubernetesSupportedVersionsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListKubernetesSupportedVersionsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListKubernetesSupportedVersionsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListKubernetesSupportedVersionsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListKubernetesSupportedVersionsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListKubernetesSupportedVersionsParams) Clone() *ListKubernetesSupportedVersionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListKubernetesSupportedVersionsParams) Equal(o *ListKubernetesSupportedVersionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.minimumkubernetesversionid == o.minimumkubernetesversionid &&
+		p.minimumsemanticversion == o.minimumsemanticversion &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListKubernetesSupportedVersionsParams is used to (un)marshal ListKubernetesSupportedVersionsParams using the API param names
+type serializedListKubernetesSupportedVersionsParams struct {
+	Id                         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword                    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Minimumkubernetesversionid *string `json:"minimumkubernetesversionid,omitempty" yaml:"minimumkubernetesversionid,omitempty"`
+	Minimumsemanticversion     *string `json:"minimumsemanticversion,omitempty" yaml:"minimumsemanticversion,omitempty"`
+	Page                       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid                     *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListKubernetesSupportedVersionsParams) toSerialized() *serializedListKubernetesSupportedVersionsParams {
+	s := &serializedListKubernetesSupportedVersionsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.minimumkubernetesversionid.ok {
+		s.Minimumkubernetesversionid = &p.minimumkubernetesversionid.v
+	}
+	if p.minimumsemanticversion.ok {
+		s.Minimumsemanticversion = &p.minimumsemanticversion.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListKubernetesSupportedVersionsParams) fromSerialized(s *serializedListKubernetesSupportedVersionsParams) {
+	*p = ListKubernetesSupportedVersionsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Minimumkubernetesversionid != nil {
+		p.SetMinimumkubernetesversionid(*s.Minimumkubernetesversionid)
+	}
+	if s.Minimumsemanticversion != nil {
+		p.SetMinimumsemanticversion(*s.Minimumsemanticversion)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListKubernetesSupportedVersionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListKubernetesSupportedVersionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListKubernetesSupportedVersionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListKubernetesSupportedVersionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListKubernetesSupportedVersionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListKubernetesSupportedVersionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListKubernetesSupportedVersionsParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewListKubernetesSupportedVersionsParams() *ListKubernetesSupportedVersionsParams {
 	p := &ListKubernetesSupportedVersionsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesSupportedVersionID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListKubernetesSupportedVersionsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1864,9 +2721,8 @@ func (s *KubernetesService) GetKubernetesSupportedVersionByName(name string, opt
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesSupportedVersionByID(id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
 	p := &ListKubernetesSupportedVersionsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1894,9 +2750,9 @@ func (s *KubernetesService) GetKubernetesSupportedVersionByID(id string, opts ..
 	return nil, l.Count, fmt.Errorf("There is more then one result for KubernetesSupportedVersion UUID: %s!", id)
 }
 
-// Lists supported Kubernetes version
-func (s *KubernetesService) ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
-	resp, err := s.cs.newRequest("listKubernetesSupportedVersions", p.toURLValues())
+// Lists supported Kubernetes version.
+func (s *KubernetesService) ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams, opts ...CallOption) (*ListKubernetesSupportedVersionsResponse, error) {
+	resp, err := s.cs.newRequest("listKubernetesSupportedVersions", s.cs.encodeParams("listKubernetesSupportedVersions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1935,202 +2791,330 @@ type KubernetesSupportedVersion struct {
 }
 
 type ScaleKubernetesClusterParams struct {
-	p map[string]interface{}
+	autoscalingenabled optBool
+	id                 optString
+	maxsize            optInt64
+	minsize            optInt64
+	nodeids            optStrings
+	serviceofferingid  optString
+	size               optInt64
 }
 
-func (p *ScaleKubernetesClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ScaleKubernetesClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["autoscalingenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("autoscalingenabled", vv)
+	if p.autoscalingenabled.ok {
+		u.Set("autoscalingenabled", strconv.FormatBool(p.autoscalingenabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["maxsize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxsize", vv)
+	if p.maxsize.ok {
+		u.Set("maxsize", strconv.FormatInt(p.maxsize.v, 10))
 	}
-	if v, found := p.p["minsize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("minsize", vv)
+	if p.minsize.ok {
+		u.Set("minsize", strconv.FormatInt(p.minsize.v, 10))
 	}
-	if v, found := p.p["nodeids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("nodeids", vv)
+	if p.nodeids.ok {
+		u.Set("nodeids", strings.Join(p.nodeids.v, ","))
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
+	if p.size.ok {
+		u.Set("size", strconv.FormatInt(p.size.v, 10))
 	}
 	return u
 }
 
-func (p *ScaleKubernetesClusterParams) SetAutoscalingenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseScaleKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new ScaleKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseScaleKubernetesClusterParams(u url.Values) (*ScaleKubernetesClusterParams, error) {
+	p := &ScaleKubernetesClusterParams{}
+	if err := checkParamNames("scaleKubernetesCluster", u, "autoscalingenabled", "id", "maxsize", "minsize", "nodeids", "serviceofferingid", "size"); err != nil {
+		return nil, err
+	}
+	if _, found := u["autoscalingenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("autoscalingenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param autoscalingenabled: %v", err)
+		}
+		p.SetAutoscalingenabled(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["maxsize"]; found {
+		v, err := strconv.ParseInt(u.Get("maxsize"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxsize: %v", err)
+		}
+		p.SetMaxsize(v)
 	}
-	p.p["autoscalingenabled"] = v
+	if _, found := u["minsize"]; found {
+		v, err := strconv.ParseInt(u.Get("minsize"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param minsize: %v", err)
+		}
+		p.SetMinsize(v)
+	}
+	if _, found := u["nodeids"]; found {
+		p.SetNodeids(strings.Split(u.Get("nodeids"), ","))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["size"]; found {
+		v, err := strconv.ParseInt(u.Get("size"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param size: %v", err)
+		}
+		p.SetSize(v)
+	}
+	return p, nil
 }
 
+// SetAutoscalingenabled sets the autoscalingenabled param.
+func (p *ScaleKubernetesClusterParams) SetAutoscalingenabled(v bool) {
+	p.autoscalingenabled = optBool{v: v, ok: true}
+}
+
+// ResetAutoscalingenabled unsets the autoscalingenabled param
 func (p *ScaleKubernetesClusterParams) ResetAutoscalingenabled() {
-	if p.p != nil && p.p["autoscalingenabled"] != nil {
-		delete(p.p, "autoscalingenabled")
-	}
+	p.autoscalingenabled = optBool{}
 }
 
+// GetAutoscalingenabled returns the autoscalingenabled param and if it is set
 func (p *ScaleKubernetesClusterParams) GetAutoscalingenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoscalingenabled"].(bool)
-	return value, ok
+	return p.autoscalingenabled.v, p.autoscalingenabled.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ScaleKubernetesClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ScaleKubernetesClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ScaleKubernetesClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMaxsize sets the maxsize param.
 func (p *ScaleKubernetesClusterParams) SetMaxsize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxsize"] = v
+	p.maxsize = optInt64{v: v, ok: true}
 }
 
+// ResetMaxsize unsets the maxsize param
 func (p *ScaleKubernetesClusterParams) ResetMaxsize() {
-	if p.p != nil && p.p["maxsize"] != nil {
-		delete(p.p, "maxsize")
-	}
+	p.maxsize = optInt64{}
 }
 
+// GetMaxsize returns the maxsize param and if it is set
 func (p *ScaleKubernetesClusterParams) GetMaxsize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxsize"].(int64)
-	return value, ok
+	return p.maxsize.v, p.maxsize.ok
 }
 
+// SetMinsize sets the minsize param.
 func (p *ScaleKubernetesClusterParams) SetMinsize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minsize"] = v
+	p.minsize = optInt64{v: v, ok: true}
 }
 
+// ResetMinsize unsets the minsize param
 func (p *ScaleKubernetesClusterParams) ResetMinsize() {
-	if p.p != nil && p.p["minsize"] != nil {
-		delete(p.p, "minsize")
-	}
+	p.minsize = optInt64{}
 }
 
+// GetMinsize returns the minsize param and if it is set
 func (p *ScaleKubernetesClusterParams) GetMinsize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["minsize"].(int64)
-	return value, ok
+	return p.minsize.v, p.minsize.ok
 }
 
+// SetNodeids sets the nodeids param.
 func (p *ScaleKubernetesClusterParams) SetNodeids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nodeids"] = v
+	p.nodeids = optStrings{v: v, ok: true}
 }
 
+// ResetNodeids unsets the nodeids param
 func (p *ScaleKubernetesClusterParams) ResetNodeids() {
-	if p.p != nil && p.p["nodeids"] != nil {
-		delete(p.p, "nodeids")
-	}
+	p.nodeids = optStrings{}
 }
 
+// GetNodeids returns the nodeids param and if it is set
 func (p *ScaleKubernetesClusterParams) GetNodeids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nodeids"].([]string)
-	return value, ok
+	return p.nodeids.v, p.nodeids.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param.
 func (p *ScaleKubernetesClusterParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ScaleKubernetesClusterParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ScaleKubernetesClusterParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetSize sets the size param.
 func (p *ScaleKubernetesClusterParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["size"] = v
+	p.size = optInt64{v: v, ok: true}
 }
 
+// ResetSize unsets the size param
 func (p *ScaleKubernetesClusterParams) ResetSize() {
-	if p.p != nil && p.p["size"] != nil {
-		delete(p.p, "size")
-	}
+	p.size = optInt64{}
 }
 
+// GetSize returns the size param and if it is set
 func (p *ScaleKubernetesClusterParams) GetSize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.size.v, p.size.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ScaleKubernetesClusterParams) Clone() *ScaleKubernetesClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.nodeids = p.nodeids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ScaleKubernetesClusterParams) Equal(o *ScaleKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.autoscalingenabled == o.autoscalingenabled &&
+		p.id == o.id &&
+		p.maxsize == o.maxsize &&
+		p.minsize == o.minsize &&
+		p.nodeids.equal(o.nodeids) &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.size == o.size
+}
+
+// serializedScaleKubernetesClusterParams is used to (un)marshal ScaleKubernetesClusterParams using the API param names
+type serializedScaleKubernetesClusterParams struct {
+	Autoscalingenabled *bool     `json:"autoscalingenabled,omitempty" yaml:"autoscalingenabled,omitempty"`
+	Id                 *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Maxsize            *int64    `json:"maxsize,omitempty" yaml:"maxsize,omitempty"`
+	Minsize            *int64    `json:"minsize,omitempty" yaml:"minsize,omitempty"`
+	Nodeids            *[]string `json:"nodeids,omitempty" yaml:"nodeids,omitempty"`
+	Serviceofferingid  *string   `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Size               *int64    `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+func (p *ScaleKubernetesClusterParams) toSerialized() *serializedScaleKubernetesClusterParams {
+	s := &serializedScaleKubernetesClusterParams{}
+	if p.autoscalingenabled.ok {
+		s.Autoscalingenabled = &p.autoscalingenabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.maxsize.ok {
+		s.Maxsize = &p.maxsize.v
+	}
+	if p.minsize.ok {
+		s.Minsize = &p.minsize.v
+	}
+	if p.nodeids.ok {
+		s.Nodeids = &p.nodeids.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.size.ok {
+		s.Size = &p.size.v
+	}
+	return s
+}
+
+func (p *ScaleKubernetesClusterParams) fromSerialized(s *serializedScaleKubernetesClusterParams) {
+	*p = ScaleKubernetesClusterParams{}
+	if s.Autoscalingenabled != nil {
+		p.SetAutoscalingenabled(*s.Autoscalingenabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Maxsize != nil {
+		p.SetMaxsize(*s.Maxsize)
+	}
+	if s.Minsize != nil {
+		p.SetMinsize(*s.Minsize)
+	}
+	if s.Nodeids != nil {
+		p.SetNodeids(*s.Nodeids)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Size != nil {
+		p.SetSize(*s.Size)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ScaleKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ScaleKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedScaleKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ScaleKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ScaleKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedScaleKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["size"].(int64)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ScaleKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewScaleKubernetesClusterParams(id string) *ScaleKubernetesClusterParams {
 	p := &ScaleKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Scales a created, running or stopped CloudManaged Kubernetes cluster
-func (s *KubernetesService) ScaleKubernetesCluster(p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("scaleKubernetesCluster", p.toURLValues())
+// Scales a created, running or stopped CloudManaged Kubernetes cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *KubernetesService) ScaleKubernetesCluster(p *ScaleKubernetesClusterParams, opts ...CallOption) (*ScaleKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("scaleKubernetesCluster", s.cs.encodeParams("scaleKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2141,8 +3125,8 @@ func (s *KubernetesService) ScaleKubernetesCluster(p *ScaleKubernetesClusterPara
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2203,54 +3187,134 @@ type ScaleKubernetesClusterResponse struct {
 	Zonename              string            `json:"zonename"`
 }
 
-type StartKubernetesClusterParams struct {
-	p map[string]interface{}
+type StartKubernetesClusterParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StartKubernetesClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseStartKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new StartKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStartKubernetesClusterParams(u url.Values) (*StartKubernetesClusterParams, error) {
+	p := &StartKubernetesClusterParams{}
+	if err := checkParamNames("startKubernetesCluster", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *StartKubernetesClusterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
-func (p *StartKubernetesClusterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// ResetId unsets the id param
+func (p *StartKubernetesClusterParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *StartKubernetesClusterParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StartKubernetesClusterParams) Clone() *StartKubernetesClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StartKubernetesClusterParams) Equal(o *StartKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	return p.id == o.id
+}
+
+// serializedStartKubernetesClusterParams is used to (un)marshal StartKubernetesClusterParams using the API param names
+type serializedStartKubernetesClusterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StartKubernetesClusterParams) toSerialized() *serializedStartKubernetesClusterParams {
+	s := &serializedStartKubernetesClusterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	return u
+	return s
 }
 
-func (p *StartKubernetesClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *StartKubernetesClusterParams) fromSerialized(s *serializedStartKubernetesClusterParams) {
+	*p = StartKubernetesClusterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	p.p["id"] = v
 }
 
-func (p *StartKubernetesClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StartKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StartKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedStartKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *StartKubernetesClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StartKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StartKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStartKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StartKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewStartKubernetesClusterParams(id string) *StartKubernetesClusterParams {
 	p := &StartKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Starts a stopped CloudManaged Kubernetes cluster
-func (s *KubernetesService) StartKubernetesCluster(p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("startKubernetesCluster", p.toURLValues())
+// Starts a stopped CloudManaged Kubernetes cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *KubernetesService) StartKubernetesCluster(p *StartKubernetesClusterParams, opts ...CallOption) (*StartKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("startKubernetesCluster", s.cs.encodeParams("startKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2261,8 +3325,8 @@ func (s *KubernetesService) StartKubernetesCluster(p *StartKubernetesClusterPara
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2324,53 +3388,133 @@ type StartKubernetesClusterResponse struct {
 }
 
 type StopKubernetesClusterParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *StopKubernetesClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StopKubernetesClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StopKubernetesClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStopKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new StopKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStopKubernetesClusterParams(u url.Values) (*StopKubernetesClusterParams, error) {
+	p := &StopKubernetesClusterParams{}
+	if err := checkParamNames("stopKubernetesCluster", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *StopKubernetesClusterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *StopKubernetesClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StopKubernetesClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StopKubernetesClusterParams) Clone() *StopKubernetesClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StopKubernetesClusterParams) Equal(o *StopKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedStopKubernetesClusterParams is used to (un)marshal StopKubernetesClusterParams using the API param names
+type serializedStopKubernetesClusterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StopKubernetesClusterParams) toSerialized() *serializedStopKubernetesClusterParams {
+	s := &serializedStopKubernetesClusterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *StopKubernetesClusterParams) fromSerialized(s *serializedStopKubernetesClusterParams) {
+	*p = StopKubernetesClusterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StopKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StopKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedStopKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StopKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StopKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStopKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StopKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewStopKubernetesClusterParams(id string) *StopKubernetesClusterParams {
 	p := &StopKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Stops a running CloudManaged Kubernetes cluster
-func (s *KubernetesService) StopKubernetesCluster(p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("stopKubernetesCluster", p.toURLValues())
+// Stops a running CloudManaged Kubernetes cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *KubernetesService) StopKubernetesCluster(p *StopKubernetesClusterParams, opts ...CallOption) (*StopKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("stopKubernetesCluster", s.cs.encodeParams("stopKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2381,8 +3525,8 @@ func (s *KubernetesService) StopKubernetesCluster(p *StopKubernetesClusterParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2406,78 +3550,163 @@ type StopKubernetesClusterResponse struct {
 }
 
 type UpdateKubernetesSupportedVersionParams struct {
-	p map[string]interface{}
+	id    optString
+	state optString
 }
 
-func (p *UpdateKubernetesSupportedVersionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateKubernetesSupportedVersionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
 	return u
 }
 
-func (p *UpdateKubernetesSupportedVersionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateKubernetesSupportedVersionParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateKubernetesSupportedVersionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateKubernetesSupportedVersionParams(u url.Values) (*UpdateKubernetesSupportedVersionParams, error) {
+	p := &UpdateKubernetesSupportedVersionParams{}
+	if err := checkParamNames("updateKubernetesSupportedVersion", u, "id", "state"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpdateKubernetesSupportedVersionParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateKubernetesSupportedVersionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateKubernetesSupportedVersionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetState sets the state param. This param is required.
 func (p *UpdateKubernetesSupportedVersionParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *UpdateKubernetesSupportedVersionParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *UpdateKubernetesSupportedVersionParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.state.v, p.state.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateKubernetesSupportedVersionParams) Clone() *UpdateKubernetesSupportedVersionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateKubernetesSupportedVersionParams) Equal(o *UpdateKubernetesSupportedVersionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.state == o.state
+}
+
+// serializedUpdateKubernetesSupportedVersionParams is used to (un)marshal UpdateKubernetesSupportedVersionParams using the API param names
+type serializedUpdateKubernetesSupportedVersionParams struct {
+	Id    *string `json:"id,omitempty" yaml:"id,omitempty"`
+	State *string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) toSerialized() *serializedUpdateKubernetesSupportedVersionParams {
+	s := &serializedUpdateKubernetesSupportedVersionParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	return s
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) fromSerialized(s *serializedUpdateKubernetesSupportedVersionParams) {
+	*p = UpdateKubernetesSupportedVersionParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateKubernetesSupportedVersionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateKubernetesSupportedVersionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateKubernetesSupportedVersionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateKubernetesSupportedVersionParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateKubernetesSupportedVersionParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewUpdateKubernetesSupportedVersionParams(id string, state string) *UpdateKubernetesSupportedVersionParams {
 	p := &UpdateKubernetesSupportedVersionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["state"] = state
+	p.SetId(id)
+	p.SetState(state)
 	return p
 }
 
-// Update a supported Kubernetes version
-func (s *KubernetesService) UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error) {
-	resp, err := s.cs.newRequest("updateKubernetesSupportedVersion", p.toURLValues())
+// Update a supported Kubernetes version.
+//
+// Required params: id, state.
+func (s *KubernetesService) UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams, opts ...CallOption) (*UpdateKubernetesSupportedVersionResponse, error) {
+	resp, err := s.cs.newRequest("updateKubernetesSupportedVersion", s.cs.encodeParams("updateKubernetesSupportedVersion", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2511,78 +3740,164 @@ type UpdateKubernetesSupportedVersionResponse struct {
 }
 
 type UpgradeKubernetesClusterParams struct {
-	p map[string]interface{}
+	id                  optString
+	kubernetesversionid optString
 }
 
-func (p *UpgradeKubernetesClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpgradeKubernetesClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["kubernetesversionid"]; found {
-		u.Set("kubernetesversionid", v.(string))
+	if p.kubernetesversionid.ok {
+		u.Set("kubernetesversionid", p.kubernetesversionid.v)
 	}
 	return u
 }
 
-func (p *UpgradeKubernetesClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpgradeKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new UpgradeKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpgradeKubernetesClusterParams(u url.Values) (*UpgradeKubernetesClusterParams, error) {
+	p := &UpgradeKubernetesClusterParams{}
+	if err := checkParamNames("upgradeKubernetesCluster", u, "id", "kubernetesversionid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["kubernetesversionid"]; found {
+		p.SetKubernetesversionid(u.Get("kubernetesversionid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpgradeKubernetesClusterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpgradeKubernetesClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpgradeKubernetesClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKubernetesversionid sets the kubernetesversionid param. This param is required.
 func (p *UpgradeKubernetesClusterParams) SetKubernetesversionid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["kubernetesversionid"] = v
+	p.kubernetesversionid = optString{v: v, ok: true}
 }
 
+// ResetKubernetesversionid unsets the kubernetesversionid param
 func (p *UpgradeKubernetesClusterParams) ResetKubernetesversionid() {
-	if p.p != nil && p.p["kubernetesversionid"] != nil {
-		delete(p.p, "kubernetesversionid")
-	}
+	p.kubernetesversionid = optString{}
 }
 
+// GetKubernetesversionid returns the kubernetesversionid param and if it is set
 func (p *UpgradeKubernetesClusterParams) GetKubernetesversionid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.kubernetesversionid.v, p.kubernetesversionid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpgradeKubernetesClusterParams) Clone() *UpgradeKubernetesClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpgradeKubernetesClusterParams) Equal(o *UpgradeKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.kubernetesversionid == o.kubernetesversionid
+}
+
+// serializedUpgradeKubernetesClusterParams is used to (un)marshal UpgradeKubernetesClusterParams using the API param names
+type serializedUpgradeKubernetesClusterParams struct {
+	Id                  *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Kubernetesversionid *string `json:"kubernetesversionid,omitempty" yaml:"kubernetesversionid,omitempty"`
+}
+
+func (p *UpgradeKubernetesClusterParams) toSerialized() *serializedUpgradeKubernetesClusterParams {
+	s := &serializedUpgradeKubernetesClusterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["kubernetesversionid"].(string)
-	return value, ok
+	if p.kubernetesversionid.ok {
+		s.Kubernetesversionid = &p.kubernetesversionid.v
+	}
+	return s
+}
+
+func (p *UpgradeKubernetesClusterParams) fromSerialized(s *serializedUpgradeKubernetesClusterParams) {
+	*p = UpgradeKubernetesClusterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Kubernetesversionid != nil {
+		p.SetKubernetesversionid(*s.Kubernetesversionid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpgradeKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpgradeKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpgradeKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpgradeKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpgradeKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpgradeKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpgradeKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewUpgradeKubernetesClusterParams(id string, kubernetesversionid string) *UpgradeKubernetesClusterParams {
 	p := &UpgradeKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["kubernetesversionid"] = kubernetesversionid
+	p.SetId(id)
+	p.SetKubernetesversionid(kubernetesversionid)
 	return p
 }
 
-// Upgrades a running CloudManaged Kubernetes cluster
-func (s *KubernetesService) UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("upgradeKubernetesCluster", p.toURLValues())
+// Upgrades a running CloudManaged Kubernetes cluster.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, kubernetesversionid.
+func (s *KubernetesService) UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams, opts ...CallOption) (*UpgradeKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("upgradeKubernetesCluster", s.cs.encodeParams("upgradeKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2593,8 +3908,8 @@ func (s *KubernetesService) UpgradeKubernetesCluster(p *UpgradeKubernetesCluster
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2656,104 +3971,199 @@ type UpgradeKubernetesClusterResponse struct {
 }
 
 type AddVirtualMachinesToKubernetesClusterParams struct {
-	p map[string]interface{}
+	id                optString
+	iscontrolnode     optBool
+	virtualmachineids optStrings
 }
 
-func (p *AddVirtualMachinesToKubernetesClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddVirtualMachinesToKubernetesClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["iscontrolnode"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("iscontrolnode", vv)
+	if p.iscontrolnode.ok {
+		u.Set("iscontrolnode", strconv.FormatBool(p.iscontrolnode.v))
 	}
-	if v, found := p.p["virtualmachineids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("virtualmachineids", vv)
+	if p.virtualmachineids.ok {
+		u.Set("virtualmachineids", strings.Join(p.virtualmachineids.v, ","))
 	}
 	return u
 }
 
-func (p *AddVirtualMachinesToKubernetesClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddVirtualMachinesToKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new AddVirtualMachinesToKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddVirtualMachinesToKubernetesClusterParams(u url.Values) (*AddVirtualMachinesToKubernetesClusterParams, error) {
+	p := &AddVirtualMachinesToKubernetesClusterParams{}
+	if err := checkParamNames("addVirtualMachinesToKubernetesCluster", u, "id", "iscontrolnode", "virtualmachineids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["iscontrolnode"]; found {
+		v, err := strconv.ParseBool(u.Get("iscontrolnode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iscontrolnode: %v", err)
+		}
+		p.SetIscontrolnode(v)
+	}
+	if _, found := u["virtualmachineids"]; found {
+		p.SetVirtualmachineids(strings.Split(u.Get("virtualmachineids"), ","))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *AddVirtualMachinesToKubernetesClusterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *AddVirtualMachinesToKubernetesClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *AddVirtualMachinesToKubernetesClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIscontrolnode sets the iscontrolnode param.
 func (p *AddVirtualMachinesToKubernetesClusterParams) SetIscontrolnode(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iscontrolnode"] = v
+	p.iscontrolnode = optBool{v: v, ok: true}
 }
 
+// ResetIscontrolnode unsets the iscontrolnode param
 func (p *AddVirtualMachinesToKubernetesClusterParams) ResetIscontrolnode() {
-	if p.p != nil && p.p["iscontrolnode"] != nil {
-		delete(p.p, "iscontrolnode")
-	}
+	p.iscontrolnode = optBool{}
 }
 
+// GetIscontrolnode returns the iscontrolnode param and if it is set
 func (p *AddVirtualMachinesToKubernetesClusterParams) GetIscontrolnode() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iscontrolnode"].(bool)
-	return value, ok
+	return p.iscontrolnode.v, p.iscontrolnode.ok
 }
 
+// SetVirtualmachineids sets the virtualmachineids param. This param is required.
 func (p *AddVirtualMachinesToKubernetesClusterParams) SetVirtualmachineids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineids"] = v
+	p.virtualmachineids = optStrings{v: v, ok: true}
 }
 
+// ResetVirtualmachineids unsets the virtualmachineids param
 func (p *AddVirtualMachinesToKubernetesClusterParams) ResetVirtualmachineids() {
-	if p.p != nil && p.p["virtualmachineids"] != nil {
-		delete(p.p, "virtualmachineids")
-	}
+	p.virtualmachineids = optStrings{}
 }
 
+// GetVirtualmachineids returns the virtualmachineids param and if it is set
 func (p *AddVirtualMachinesToKubernetesClusterParams) GetVirtualmachineids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineids.v, p.virtualmachineids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddVirtualMachinesToKubernetesClusterParams) Clone() *AddVirtualMachinesToKubernetesClusterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.virtualmachineids = p.virtualmachineids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddVirtualMachinesToKubernetesClusterParams) Equal(o *AddVirtualMachinesToKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.iscontrolnode == o.iscontrolnode &&
+		p.virtualmachineids.equal(o.virtualmachineids)
+}
+
+// serializedAddVirtualMachinesToKubernetesClusterParams is used to (un)marshal AddVirtualMachinesToKubernetesClusterParams using the API param names
+type serializedAddVirtualMachinesToKubernetesClusterParams struct {
+	Id                *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Iscontrolnode     *bool     `json:"iscontrolnode,omitempty" yaml:"iscontrolnode,omitempty"`
+	Virtualmachineids *[]string `json:"virtualmachineids,omitempty" yaml:"virtualmachineids,omitempty"`
+}
+
+func (p *AddVirtualMachinesToKubernetesClusterParams) toSerialized() *serializedAddVirtualMachinesToKubernetesClusterParams {
+	s := &serializedAddVirtualMachinesToKubernetesClusterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.iscontrolnode.ok {
+		s.Iscontrolnode = &p.iscontrolnode.v
+	}
+	if p.virtualmachineids.ok {
+		s.Virtualmachineids = &p.virtualmachineids.v
+	}
+	return s
+}
+
+func (p *AddVirtualMachinesToKubernetesClusterParams) fromSerialized(s *serializedAddVirtualMachinesToKubernetesClusterParams) {
+	*p = AddVirtualMachinesToKubernetesClusterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Iscontrolnode != nil {
+		p.SetIscontrolnode(*s.Iscontrolnode)
+	}
+	if s.Virtualmachineids != nil {
+		p.SetVirtualmachineids(*s.Virtualmachineids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddVirtualMachinesToKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddVirtualMachinesToKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddVirtualMachinesToKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddVirtualMachinesToKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddVirtualMachinesToKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddVirtualMachinesToKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineids"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddVirtualMachinesToKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewAddVirtualMachinesToKubernetesClusterParams(id string, virtualmachineids []string) *AddVirtualMachinesToKubernetesClusterParams {
 	p := &AddVirtualMachinesToKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["virtualmachineids"] = virtualmachineids
+	p.SetId(id)
+	p.SetVirtualmachineids(virtualmachineids)
 	return p
 }
 
-// Add VMs to an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes clusters.
-func (s *KubernetesService) AddVirtualMachinesToKubernetesCluster(p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("addVirtualMachinesToKubernetesCluster", p.toURLValues())
+// Add VMs to an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes
+// clusters.
+//
+// Required params: id, virtualmachineids.
+func (s *KubernetesService) AddVirtualMachinesToKubernetesCluster(p *AddVirtualMachinesToKubernetesClusterParams, opts ...CallOption) (*AddVirtualMachinesToKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("addVirtualMachinesToKubernetesCluster", s.cs.encodeParams("addVirtualMachinesToKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2801,153 +4211,263 @@ func (r *AddVirtualMachinesToKubernetesClusterResponse) UnmarshalJSON(b []byte)
 }
 
 type RemoveVirtualMachinesFromKubernetesClusterParams struct {
-	p map[string]interface{}
+	id                optString
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	virtualmachineids optStrings
 }
 
-func (p *RemoveVirtualMachinesFromKubernetesClusterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["virtualmachineids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("virtualmachineids", vv)
+	if p.virtualmachineids.ok {
+		u.Set("virtualmachineids", strings.Join(p.virtualmachineids.v, ","))
 	}
 	return u
 }
 
-func (p *RemoveVirtualMachinesFromKubernetesClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveVirtualMachinesFromKubernetesClusterParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveVirtualMachinesFromKubernetesClusterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveVirtualMachinesFromKubernetesClusterParams(u url.Values) (*RemoveVirtualMachinesFromKubernetesClusterParams, error) {
+	p := &RemoveVirtualMachinesFromKubernetesClusterParams{}
+	if err := checkParamNames("removeVirtualMachinesFromKubernetesCluster", u, "id", "keyword", "page", "pagesize", "virtualmachineids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["virtualmachineids"]; found {
+		p.SetVirtualmachineids(strings.Split(u.Get("virtualmachineids"), ","))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetVirtualmachineids sets the virtualmachineids param. This param is required.
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) SetVirtualmachineids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineids"] = v
+	p.virtualmachineids = optStrings{v: v, ok: true}
 }
 
+// ResetVirtualmachineids unsets the virtualmachineids param
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) ResetVirtualmachineids() {
-	if p.p != nil && p.p["virtualmachineids"] != nil {
-		delete(p.p, "virtualmachineids")
-	}
+	p.virtualmachineids = optStrings{}
 }
 
+// GetVirtualmachineids returns the virtualmachineids param and if it is set
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) GetVirtualmachineids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineids.v, p.virtualmachineids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) Clone() *RemoveVirtualMachinesFromKubernetesClusterParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["virtualmachineids"].([]string)
-	return value, ok
+	c := *p
+	c.virtualmachineids = p.virtualmachineids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) Equal(o *RemoveVirtualMachinesFromKubernetesClusterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.virtualmachineids.equal(o.virtualmachineids)
+}
+
+// serializedRemoveVirtualMachinesFromKubernetesClusterParams is used to (un)marshal RemoveVirtualMachinesFromKubernetesClusterParams using the API param names
+type serializedRemoveVirtualMachinesFromKubernetesClusterParams struct {
+	Id                *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Virtualmachineids *[]string `json:"virtualmachineids,omitempty" yaml:"virtualmachineids,omitempty"`
+}
+
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) toSerialized() *serializedRemoveVirtualMachinesFromKubernetesClusterParams {
+	s := &serializedRemoveVirtualMachinesFromKubernetesClusterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.virtualmachineids.ok {
+		s.Virtualmachineids = &p.virtualmachineids.v
+	}
+	return s
+}
+
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) fromSerialized(s *serializedRemoveVirtualMachinesFromKubernetesClusterParams) {
+	*p = RemoveVirtualMachinesFromKubernetesClusterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Virtualmachineids != nil {
+		p.SetVirtualmachineids(*s.Virtualmachineids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveVirtualMachinesFromKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveVirtualMachinesFromKubernetesClusterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveVirtualMachinesFromKubernetesClusterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveVirtualMachinesFromKubernetesClusterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveVirtualMachinesFromKubernetesClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *KubernetesService) NewRemoveVirtualMachinesFromKubernetesClusterParams(id string, virtualmachineids []string) *RemoveVirtualMachinesFromKubernetesClusterParams {
 	p := &RemoveVirtualMachinesFromKubernetesClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["virtualmachineids"] = virtualmachineids
+	p.SetId(id)
+	p.SetVirtualmachineids(virtualmachineids)
 	return p
 }
 
-// Remove VMs from an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes clusters.
-func (s *KubernetesService) RemoveVirtualMachinesFromKubernetesCluster(p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error) {
-	resp, err := s.cs.newRequest("removeVirtualMachinesFromKubernetesCluster", p.toURLValues())
+// Remove VMs from an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes
+// clusters.
+//
+// Required params: id, virtualmachineids.
+func (s *KubernetesService) RemoveVirtualMachinesFromKubernetesCluster(p *RemoveVirtualMachinesFromKubernetesClusterParams, opts ...CallOption) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error) {
+	resp, err := s.cs.newRequest("removeVirtualMachinesFromKubernetesCluster", s.cs.encodeParams("removeVirtualMachinesFromKubernetesCluster", p), opts...)
 	if err != nil {
 		return nil, err
 	}
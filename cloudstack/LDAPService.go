@@ -27,126 +27,220 @@ import (
 )
 
 type LDAPServiceIface interface {
-	AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	AddLdapConfiguration(p *AddLdapConfigurationParams, opts ...CallOption) (*AddLdapConfigurationResponse, error)
 	NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams
-	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams, opts ...CallOption) (*DeleteLdapConfigurationResponse, error)
 	NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams
-	ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	ImportLdapUsers(p *ImportLdapUsersParams, opts ...CallOption) (*ImportLdapUsersResponse, error)
 	NewImportLdapUsersParams() *ImportLdapUsersParams
-	LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error)
+	LdapConfig(p *LdapConfigParams, opts ...CallOption) (*LdapConfigResponse, error)
 	NewLdapConfigParams() *LdapConfigParams
-	LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	LdapCreateAccount(p *LdapCreateAccountParams, opts ...CallOption) (*LdapCreateAccountResponse, error)
 	NewLdapCreateAccountParams(username string) *LdapCreateAccountParams
-	LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error)
+	LdapRemove(p *LdapRemoveParams, opts ...CallOption) (*LdapRemoveResponse, error)
 	NewLdapRemoveParams() *LdapRemoveParams
-	LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	LinkDomainToLdap(p *LinkDomainToLdapParams, opts ...CallOption) (*LinkDomainToLdapResponse, error)
 	NewLinkDomainToLdapParams(accounttype int, domainid string, lDAPType string) *LinkDomainToLdapParams
-	ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurations(p *ListLdapConfigurationsParams, opts ...CallOption) (*ListLdapConfigurationsResponse, error)
 	NewListLdapConfigurationsParams() *ListLdapConfigurationsParams
-	ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	ListLdapUsers(p *ListLdapUsersParams, opts ...CallOption) (*ListLdapUsersResponse, error)
 	NewListLdapUsersParams() *ListLdapUsersParams
-	SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error)
+	SearchLdap(p *SearchLdapParams, opts ...CallOption) (*SearchLdapResponse, error)
 	NewSearchLdapParams(query string) *SearchLdapParams
 }
 
 type AddLdapConfigurationParams struct {
-	p map[string]interface{}
+	domainid optString
+	hostname optString
+	port     optInt
 }
 
-func (p *AddLdapConfigurationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddLdapConfigurationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if p.hostname.ok {
+		u.Set("hostname", p.hostname.v)
 	}
-	if v, found := p.p["port"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("port", vv)
+	if p.port.ok {
+		u.Set("port", strconv.Itoa(p.port.v))
 	}
 	return u
 }
 
-func (p *AddLdapConfigurationParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddLdapConfigurationParams parses url.Values, for example taken from a raw API request,
+// into a new AddLdapConfigurationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddLdapConfigurationParams(u url.Values) (*AddLdapConfigurationParams, error) {
+	p := &AddLdapConfigurationParams{}
+	if err := checkParamNames("addLdapConfiguration", u, "domainid", "hostname", "port"); err != nil {
+		return nil, err
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["hostname"]; found {
+		p.SetHostname(u.Get("hostname"))
+	}
+	if _, found := u["port"]; found {
+		v, err := strconv.Atoi(u.Get("port"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param port: %v", err)
+		}
+		p.SetPort(v)
 	}
-	p.p["domainid"] = v
+	return p, nil
 }
 
+// SetDomainid sets the domainid param.
+func (p *AddLdapConfigurationParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
 func (p *AddLdapConfigurationParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *AddLdapConfigurationParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHostname sets the hostname param. This param is required.
 func (p *AddLdapConfigurationParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
+	p.hostname = optString{v: v, ok: true}
 }
 
+// ResetHostname unsets the hostname param
 func (p *AddLdapConfigurationParams) ResetHostname() {
-	if p.p != nil && p.p["hostname"] != nil {
-		delete(p.p, "hostname")
-	}
+	p.hostname = optString{}
 }
 
+// GetHostname returns the hostname param and if it is set
 func (p *AddLdapConfigurationParams) GetHostname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostname"].(string)
-	return value, ok
+	return p.hostname.v, p.hostname.ok
 }
 
+// SetPort sets the port param. This param is required.
 func (p *AddLdapConfigurationParams) SetPort(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["port"] = v
+	p.port = optInt{v: v, ok: true}
 }
 
+// ResetPort unsets the port param
 func (p *AddLdapConfigurationParams) ResetPort() {
-	if p.p != nil && p.p["port"] != nil {
-		delete(p.p, "port")
-	}
+	p.port = optInt{}
 }
 
+// GetPort returns the port param and if it is set
 func (p *AddLdapConfigurationParams) GetPort() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.port.v, p.port.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddLdapConfigurationParams) Clone() *AddLdapConfigurationParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["port"].(int)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddLdapConfigurationParams) Equal(o *AddLdapConfigurationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.domainid == o.domainid &&
+		p.hostname == o.hostname &&
+		p.port == o.port
+}
+
+// serializedAddLdapConfigurationParams is used to (un)marshal AddLdapConfigurationParams using the API param names
+type serializedAddLdapConfigurationParams struct {
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Port     *int    `json:"port,omitempty" yaml:"port,omitempty"`
+}
+
+func (p *AddLdapConfigurationParams) toSerialized() *serializedAddLdapConfigurationParams {
+	s := &serializedAddLdapConfigurationParams{}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hostname.ok {
+		s.Hostname = &p.hostname.v
+	}
+	if p.port.ok {
+		s.Port = &p.port.v
+	}
+	return s
+}
+
+func (p *AddLdapConfigurationParams) fromSerialized(s *serializedAddLdapConfigurationParams) {
+	*p = AddLdapConfigurationParams{}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hostname != nil {
+		p.SetHostname(*s.Hostname)
+	}
+	if s.Port != nil {
+		p.SetPort(*s.Port)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddLdapConfigurationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddLdapConfigurationParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddLdapConfigurationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddLdapConfigurationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddLdapConfigurationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddLdapConfigurationParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddLdapConfigurationParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams {
 	p := &AddLdapConfigurationParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["port"] = port
+	p.SetHostname(hostname)
+	p.SetPort(port)
 	return p
 }
 
-// Add a new Ldap Configuration
-func (s *LDAPService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("addLdapConfiguration", p.toURLValues())
+// Add a new Ldap Configuration.
+//
+// Required params: hostname, port.
+func (s *LDAPService) AddLdapConfiguration(p *AddLdapConfigurationParams, opts ...CallOption) (*AddLdapConfigurationResponse, error) {
+	resp, err := s.cs.newRequest("addLdapConfiguration", s.cs.encodeParams("addLdapConfiguration", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -168,102 +262,196 @@ type AddLdapConfigurationResponse struct {
 }
 
 type DeleteLdapConfigurationParams struct {
-	p map[string]interface{}
+	domainid optString
+	hostname optString
+	port     optInt
 }
 
-func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteLdapConfigurationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if p.hostname.ok {
+		u.Set("hostname", p.hostname.v)
 	}
-	if v, found := p.p["port"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("port", vv)
+	if p.port.ok {
+		u.Set("port", strconv.Itoa(p.port.v))
 	}
 	return u
 }
 
-func (p *DeleteLdapConfigurationParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteLdapConfigurationParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteLdapConfigurationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteLdapConfigurationParams(u url.Values) (*DeleteLdapConfigurationParams, error) {
+	p := &DeleteLdapConfigurationParams{}
+	if err := checkParamNames("deleteLdapConfiguration", u, "domainid", "hostname", "port"); err != nil {
+		return nil, err
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["domainid"] = v
+	if _, found := u["hostname"]; found {
+		p.SetHostname(u.Get("hostname"))
+	}
+	if _, found := u["port"]; found {
+		v, err := strconv.Atoi(u.Get("port"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param port: %v", err)
+		}
+		p.SetPort(v)
+	}
+	return p, nil
 }
 
+// SetDomainid sets the domainid param.
+func (p *DeleteLdapConfigurationParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
 func (p *DeleteLdapConfigurationParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DeleteLdapConfigurationParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHostname sets the hostname param. This param is required.
 func (p *DeleteLdapConfigurationParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
+	p.hostname = optString{v: v, ok: true}
 }
 
+// ResetHostname unsets the hostname param
 func (p *DeleteLdapConfigurationParams) ResetHostname() {
-	if p.p != nil && p.p["hostname"] != nil {
-		delete(p.p, "hostname")
-	}
+	p.hostname = optString{}
 }
 
+// GetHostname returns the hostname param and if it is set
 func (p *DeleteLdapConfigurationParams) GetHostname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostname"].(string)
-	return value, ok
+	return p.hostname.v, p.hostname.ok
 }
 
+// SetPort sets the port param.
 func (p *DeleteLdapConfigurationParams) SetPort(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["port"] = v
+	p.port = optInt{v: v, ok: true}
 }
 
+// ResetPort unsets the port param
 func (p *DeleteLdapConfigurationParams) ResetPort() {
-	if p.p != nil && p.p["port"] != nil {
-		delete(p.p, "port")
-	}
+	p.port = optInt{}
 }
 
+// GetPort returns the port param and if it is set
 func (p *DeleteLdapConfigurationParams) GetPort() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.port.v, p.port.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteLdapConfigurationParams) Clone() *DeleteLdapConfigurationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteLdapConfigurationParams) Equal(o *DeleteLdapConfigurationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.domainid == o.domainid &&
+		p.hostname == o.hostname &&
+		p.port == o.port
+}
+
+// serializedDeleteLdapConfigurationParams is used to (un)marshal DeleteLdapConfigurationParams using the API param names
+type serializedDeleteLdapConfigurationParams struct {
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Port     *int    `json:"port,omitempty" yaml:"port,omitempty"`
+}
+
+func (p *DeleteLdapConfigurationParams) toSerialized() *serializedDeleteLdapConfigurationParams {
+	s := &serializedDeleteLdapConfigurationParams{}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hostname.ok {
+		s.Hostname = &p.hostname.v
+	}
+	if p.port.ok {
+		s.Port = &p.port.v
 	}
-	value, ok := p.p["port"].(int)
-	return value, ok
+	return s
+}
+
+func (p *DeleteLdapConfigurationParams) fromSerialized(s *serializedDeleteLdapConfigurationParams) {
+	*p = DeleteLdapConfigurationParams{}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hostname != nil {
+		p.SetHostname(*s.Hostname)
+	}
+	if s.Port != nil {
+		p.SetPort(*s.Port)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteLdapConfigurationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteLdapConfigurationParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteLdapConfigurationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteLdapConfigurationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteLdapConfigurationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteLdapConfigurationParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteLdapConfigurationParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams {
 	p := &DeleteLdapConfigurationParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
+	p.SetHostname(hostname)
 	return p
 }
 
-// Remove an Ldap Configuration
-func (s *LDAPService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("deleteLdapConfiguration", p.toURLValues())
+// Remove an Ldap Configuration.
+//
+// Required params: hostname.
+func (s *LDAPService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams, opts ...CallOption) (*DeleteLdapConfigurationResponse, error) {
+	resp, err := s.cs.newRequest("deleteLdapConfiguration", s.cs.encodeParams("deleteLdapConfiguration", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -285,275 +473,418 @@ type DeleteLdapConfigurationResponse struct {
 }
 
 type ImportLdapUsersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ImportLdapUsersParams) toURLValues() url.Values {
+	account        optString
+	accountdetails optStringMap
+	accounttype    optInt
+	domainid       optString
+	group          optString
+	keyword        optString
+	page           optInt
+	pagesize       optInt
+	roleid         optString
+	timezone       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ImportLdapUsersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accountdetails"]; found {
-		m := v.(map[string]string)
+	if p.accountdetails.ok {
+		m := p.accountdetails.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
 			u.Set(fmt.Sprintf("accountdetails[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("accounttype", vv)
+	if p.accounttype.ok {
+		u.Set("accounttype", strconv.Itoa(p.accounttype.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["group"]; found {
-		u.Set("group", v.(string))
+	if p.group.ok {
+		u.Set("group", p.group.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
 	}
 	return u
 }
 
-func (p *ImportLdapUsersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseImportLdapUsersParams parses url.Values, for example taken from a raw API request,
+// into a new ImportLdapUsersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseImportLdapUsersParams(u url.Values) (*ImportLdapUsersParams, error) {
+	p := &ImportLdapUsersParams{}
+	if err := checkParamNames("importLdapUsers", u, "account", "accountdetails[]", "accounttype", "domainid", "group", "keyword", "page", "pagesize", "roleid", "timezone"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if m, err := parseKeyValueMap(u, "accountdetails", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetAccountdetails(m)
 	}
-	p.p["account"] = v
+	if _, found := u["accounttype"]; found {
+		v, err := strconv.Atoi(u.Get("accounttype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accounttype: %v", err)
+		}
+		p.SetAccounttype(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["group"]; found {
+		p.SetGroup(u.Get("group"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ImportLdapUsersParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ImportLdapUsersParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ImportLdapUsersParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccountdetails sets the accountdetails param.
 func (p *ImportLdapUsersParams) SetAccountdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountdetails"] = v
+	p.accountdetails = optStringMap{v: v, ok: true}
 }
 
+// ResetAccountdetails unsets the accountdetails param
 func (p *ImportLdapUsersParams) ResetAccountdetails() {
-	if p.p != nil && p.p["accountdetails"] != nil {
-		delete(p.p, "accountdetails")
-	}
+	p.accountdetails = optStringMap{}
 }
 
+// GetAccountdetails returns the accountdetails param and if it is set
 func (p *ImportLdapUsersParams) GetAccountdetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountdetails"].(map[string]string)
-	return value, ok
+	return p.accountdetails.v, p.accountdetails.ok
 }
 
+// SetAccounttype sets the accounttype param.
 func (p *ImportLdapUsersParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounttype"] = v
+	p.accounttype = optInt{v: v, ok: true}
 }
 
+// ResetAccounttype unsets the accounttype param
 func (p *ImportLdapUsersParams) ResetAccounttype() {
-	if p.p != nil && p.p["accounttype"] != nil {
-		delete(p.p, "accounttype")
-	}
+	p.accounttype = optInt{}
 }
 
+// GetAccounttype returns the accounttype param and if it is set
 func (p *ImportLdapUsersParams) GetAccounttype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounttype"].(int)
-	return value, ok
+	return p.accounttype.v, p.accounttype.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ImportLdapUsersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ImportLdapUsersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ImportLdapUsersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetGroup sets the group param.
 func (p *ImportLdapUsersParams) SetGroup(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["group"] = v
+	p.group = optString{v: v, ok: true}
 }
 
+// ResetGroup unsets the group param
 func (p *ImportLdapUsersParams) ResetGroup() {
-	if p.p != nil && p.p["group"] != nil {
-		delete(p.p, "group")
-	}
+	p.group = optString{}
 }
 
+// GetGroup returns the group param and if it is set
 func (p *ImportLdapUsersParams) GetGroup() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["group"].(string)
-	return value, ok
+	return p.group.v, p.group.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ImportLdapUsersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ImportLdapUsersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ImportLdapUsersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ImportLdapUsersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ImportLdapUsersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ImportLdapUsersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ImportLdapUsersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ImportLdapUsersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ImportLdapUsersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetRoleid sets the roleid param.
 func (p *ImportLdapUsersParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roleid"] = v
+	p.roleid = optString{v: v, ok: true}
 }
 
+// ResetRoleid unsets the roleid param
 func (p *ImportLdapUsersParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *ImportLdapUsersParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	return p.roleid.v, p.roleid.ok
 }
 
+// SetTimezone sets the timezone param.
 func (p *ImportLdapUsersParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timezone"] = v
+	p.timezone = optString{v: v, ok: true}
 }
 
+// ResetTimezone unsets the timezone param
 func (p *ImportLdapUsersParams) ResetTimezone() {
-	if p.p != nil && p.p["timezone"] != nil {
-		delete(p.p, "timezone")
-	}
+	p.timezone = optString{}
 }
 
+// GetTimezone returns the timezone param and if it is set
 func (p *ImportLdapUsersParams) GetTimezone() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.timezone.v, p.timezone.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ImportLdapUsersParams) Clone() *ImportLdapUsersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.accountdetails = p.accountdetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ImportLdapUsersParams) Equal(o *ImportLdapUsersParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["timezone"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.accountdetails.equal(o.accountdetails) &&
+		p.accounttype == o.accounttype &&
+		p.domainid == o.domainid &&
+		p.group == o.group &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.roleid == o.roleid &&
+		p.timezone == o.timezone
+}
+
+// serializedImportLdapUsersParams is used to (un)marshal ImportLdapUsersParams using the API param names
+type serializedImportLdapUsersParams struct {
+	Account        *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails *map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Accounttype    *int               `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid       *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Group          *string            `json:"group,omitempty" yaml:"group,omitempty"`
+	Keyword        *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page           *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Roleid         *string            `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+	Timezone       *string            `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+}
+
+func (p *ImportLdapUsersParams) toSerialized() *serializedImportLdapUsersParams {
+	s := &serializedImportLdapUsersParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accountdetails.ok {
+		s.Accountdetails = &p.accountdetails.v
+	}
+	if p.accounttype.ok {
+		s.Accounttype = &p.accounttype.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.group.ok {
+		s.Group = &p.group.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
+	}
+	return s
+}
+
+func (p *ImportLdapUsersParams) fromSerialized(s *serializedImportLdapUsersParams) {
+	*p = ImportLdapUsersParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountdetails != nil {
+		p.SetAccountdetails(*s.Accountdetails)
+	}
+	if s.Accounttype != nil {
+		p.SetAccounttype(*s.Accounttype)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Group != nil {
+		p.SetGroup(*s.Group)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ImportLdapUsersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ImportLdapUsersParams) UnmarshalJSON(b []byte) error {
+	var s serializedImportLdapUsersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ImportLdapUsersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ImportLdapUsersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedImportLdapUsersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ImportLdapUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewImportLdapUsersParams() *ImportLdapUsersParams {
 	p := &ImportLdapUsersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Import LDAP users
-func (s *LDAPService) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("importLdapUsers", p.toURLValues())
+// Import LDAP users.
+func (s *LDAPService) ImportLdapUsers(p *ImportLdapUsersParams, opts ...CallOption) (*ImportLdapUsersResponse, error) {
+	resp, err := s.cs.newRequest("importLdapUsers", s.cs.encodeParams("importLdapUsers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -579,271 +910,411 @@ type ImportLdapUsersResponse struct {
 }
 
 type LdapConfigParams struct {
-	p map[string]interface{}
-}
-
-func (p *LdapConfigParams) toURLValues() url.Values {
+	binddn         optString
+	bindpass       optString
+	hostname       optString
+	listall        optBool
+	port           optInt
+	queryfilter    optString
+	searchbase     optString
+	ssl            optBool
+	truststore     optString
+	truststorepass optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LdapConfigParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["binddn"]; found {
-		u.Set("binddn", v.(string))
+	if p.binddn.ok {
+		u.Set("binddn", p.binddn.v)
 	}
-	if v, found := p.p["bindpass"]; found {
-		u.Set("bindpass", v.(string))
+	if p.bindpass.ok {
+		u.Set("bindpass", p.bindpass.v)
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if p.hostname.ok {
+		u.Set("hostname", p.hostname.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["port"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("port", vv)
+	if p.port.ok {
+		u.Set("port", strconv.Itoa(p.port.v))
 	}
-	if v, found := p.p["queryfilter"]; found {
-		u.Set("queryfilter", v.(string))
+	if p.queryfilter.ok {
+		u.Set("queryfilter", p.queryfilter.v)
 	}
-	if v, found := p.p["searchbase"]; found {
-		u.Set("searchbase", v.(string))
+	if p.searchbase.ok {
+		u.Set("searchbase", p.searchbase.v)
 	}
-	if v, found := p.p["ssl"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ssl", vv)
+	if p.ssl.ok {
+		u.Set("ssl", strconv.FormatBool(p.ssl.v))
 	}
-	if v, found := p.p["truststore"]; found {
-		u.Set("truststore", v.(string))
+	if p.truststore.ok {
+		u.Set("truststore", p.truststore.v)
 	}
-	if v, found := p.p["truststorepass"]; found {
-		u.Set("truststorepass", v.(string))
+	if p.truststorepass.ok {
+		u.Set("truststorepass", p.truststorepass.v)
 	}
 	return u
 }
 
-func (p *LdapConfigParams) SetBinddn(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseLdapConfigParams parses url.Values, for example taken from a raw API request,
+// into a new LdapConfigParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLdapConfigParams(u url.Values) (*LdapConfigParams, error) {
+	p := &LdapConfigParams{}
+	if err := checkParamNames("ldapConfig", u, "binddn", "bindpass", "hostname", "listall", "port", "queryfilter", "searchbase", "ssl", "truststore", "truststorepass"); err != nil {
+		return nil, err
+	}
+	if _, found := u["binddn"]; found {
+		p.SetBinddn(u.Get("binddn"))
+	}
+	if _, found := u["bindpass"]; found {
+		p.SetBindpass(u.Get("bindpass"))
+	}
+	if _, found := u["hostname"]; found {
+		p.SetHostname(u.Get("hostname"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["port"]; found {
+		v, err := strconv.Atoi(u.Get("port"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param port: %v", err)
+		}
+		p.SetPort(v)
+	}
+	if _, found := u["queryfilter"]; found {
+		p.SetQueryfilter(u.Get("queryfilter"))
+	}
+	if _, found := u["searchbase"]; found {
+		p.SetSearchbase(u.Get("searchbase"))
 	}
-	p.p["binddn"] = v
+	if _, found := u["ssl"]; found {
+		v, err := strconv.ParseBool(u.Get("ssl"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ssl: %v", err)
+		}
+		p.SetSsl(v)
+	}
+	if _, found := u["truststore"]; found {
+		p.SetTruststore(u.Get("truststore"))
+	}
+	if _, found := u["truststorepass"]; found {
+		p.SetTruststorepass(u.Get("truststorepass"))
+	}
+	return p, nil
+}
+
+// SetBinddn sets the binddn param.
+func (p *LdapConfigParams) SetBinddn(v string) {
+	p.binddn = optString{v: v, ok: true}
 }
 
+// ResetBinddn unsets the binddn param
 func (p *LdapConfigParams) ResetBinddn() {
-	if p.p != nil && p.p["binddn"] != nil {
-		delete(p.p, "binddn")
-	}
+	p.binddn = optString{}
 }
 
+// GetBinddn returns the binddn param and if it is set
 func (p *LdapConfigParams) GetBinddn() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["binddn"].(string)
-	return value, ok
+	return p.binddn.v, p.binddn.ok
 }
 
+// SetBindpass sets the bindpass param.
 func (p *LdapConfigParams) SetBindpass(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bindpass"] = v
+	p.bindpass = optString{v: v, ok: true}
 }
 
+// ResetBindpass unsets the bindpass param
 func (p *LdapConfigParams) ResetBindpass() {
-	if p.p != nil && p.p["bindpass"] != nil {
-		delete(p.p, "bindpass")
-	}
+	p.bindpass = optString{}
 }
 
+// GetBindpass returns the bindpass param and if it is set
 func (p *LdapConfigParams) GetBindpass() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bindpass"].(string)
-	return value, ok
+	return p.bindpass.v, p.bindpass.ok
 }
 
+// SetHostname sets the hostname param.
 func (p *LdapConfigParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
+	p.hostname = optString{v: v, ok: true}
 }
 
+// ResetHostname unsets the hostname param
 func (p *LdapConfigParams) ResetHostname() {
-	if p.p != nil && p.p["hostname"] != nil {
-		delete(p.p, "hostname")
-	}
+	p.hostname = optString{}
 }
 
+// GetHostname returns the hostname param and if it is set
 func (p *LdapConfigParams) GetHostname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostname"].(string)
-	return value, ok
+	return p.hostname.v, p.hostname.ok
 }
 
+// SetListall sets the listall param.
 func (p *LdapConfigParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *LdapConfigParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *LdapConfigParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPort sets the port param.
 func (p *LdapConfigParams) SetPort(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["port"] = v
+	p.port = optInt{v: v, ok: true}
 }
 
+// ResetPort unsets the port param
 func (p *LdapConfigParams) ResetPort() {
-	if p.p != nil && p.p["port"] != nil {
-		delete(p.p, "port")
-	}
+	p.port = optInt{}
 }
 
+// GetPort returns the port param and if it is set
 func (p *LdapConfigParams) GetPort() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["port"].(int)
-	return value, ok
+	return p.port.v, p.port.ok
 }
 
+// SetQueryfilter sets the queryfilter param.
 func (p *LdapConfigParams) SetQueryfilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["queryfilter"] = v
+	p.queryfilter = optString{v: v, ok: true}
 }
 
+// ResetQueryfilter unsets the queryfilter param
 func (p *LdapConfigParams) ResetQueryfilter() {
-	if p.p != nil && p.p["queryfilter"] != nil {
-		delete(p.p, "queryfilter")
-	}
+	p.queryfilter = optString{}
 }
 
+// GetQueryfilter returns the queryfilter param and if it is set
 func (p *LdapConfigParams) GetQueryfilter() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["queryfilter"].(string)
-	return value, ok
+	return p.queryfilter.v, p.queryfilter.ok
 }
 
+// SetSearchbase sets the searchbase param.
 func (p *LdapConfigParams) SetSearchbase(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["searchbase"] = v
+	p.searchbase = optString{v: v, ok: true}
 }
 
+// ResetSearchbase unsets the searchbase param
 func (p *LdapConfigParams) ResetSearchbase() {
-	if p.p != nil && p.p["searchbase"] != nil {
-		delete(p.p, "searchbase")
-	}
+	p.searchbase = optString{}
 }
 
+// GetSearchbase returns the searchbase param and if it is set
 func (p *LdapConfigParams) GetSearchbase() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["searchbase"].(string)
-	return value, ok
+	return p.searchbase.v, p.searchbase.ok
 }
 
+// SetSsl sets the ssl param.
 func (p *LdapConfigParams) SetSsl(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ssl"] = v
+	p.ssl = optBool{v: v, ok: true}
 }
 
+// ResetSsl unsets the ssl param
 func (p *LdapConfigParams) ResetSsl() {
-	if p.p != nil && p.p["ssl"] != nil {
-		delete(p.p, "ssl")
-	}
+	p.ssl = optBool{}
 }
 
+// GetSsl returns the ssl param and if it is set
 func (p *LdapConfigParams) GetSsl() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ssl"].(bool)
-	return value, ok
+	return p.ssl.v, p.ssl.ok
 }
 
+// SetTruststore sets the truststore param.
 func (p *LdapConfigParams) SetTruststore(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["truststore"] = v
+	p.truststore = optString{v: v, ok: true}
 }
 
+// ResetTruststore unsets the truststore param
 func (p *LdapConfigParams) ResetTruststore() {
-	if p.p != nil && p.p["truststore"] != nil {
-		delete(p.p, "truststore")
-	}
+	p.truststore = optString{}
 }
 
+// GetTruststore returns the truststore param and if it is set
 func (p *LdapConfigParams) GetTruststore() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["truststore"].(string)
-	return value, ok
+	return p.truststore.v, p.truststore.ok
 }
 
+// SetTruststorepass sets the truststorepass param.
 func (p *LdapConfigParams) SetTruststorepass(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["truststorepass"] = v
+	p.truststorepass = optString{v: v, ok: true}
 }
 
+// ResetTruststorepass unsets the truststorepass param
 func (p *LdapConfigParams) ResetTruststorepass() {
-	if p.p != nil && p.p["truststorepass"] != nil {
-		delete(p.p, "truststorepass")
-	}
+	p.truststorepass = optString{}
 }
 
+// GetTruststorepass returns the truststorepass param and if it is set
 func (p *LdapConfigParams) GetTruststorepass() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.truststorepass.v, p.truststorepass.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *LdapConfigParams) Clone() *LdapConfigParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["truststorepass"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LdapConfigParams) Equal(o *LdapConfigParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.binddn == o.binddn &&
+		p.bindpass == o.bindpass &&
+		p.hostname == o.hostname &&
+		p.listall == o.listall &&
+		p.port == o.port &&
+		p.queryfilter == o.queryfilter &&
+		p.searchbase == o.searchbase &&
+		p.ssl == o.ssl &&
+		p.truststore == o.truststore &&
+		p.truststorepass == o.truststorepass
+}
+
+// serializedLdapConfigParams is used to (un)marshal LdapConfigParams using the API param names
+type serializedLdapConfigParams struct {
+	Binddn         *string `json:"binddn,omitempty" yaml:"binddn,omitempty"`
+	Bindpass       *string `json:"bindpass,omitempty" yaml:"bindpass,omitempty"`
+	Hostname       *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Listall        *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Port           *int    `json:"port,omitempty" yaml:"port,omitempty"`
+	Queryfilter    *string `json:"queryfilter,omitempty" yaml:"queryfilter,omitempty"`
+	Searchbase     *string `json:"searchbase,omitempty" yaml:"searchbase,omitempty"`
+	Ssl            *bool   `json:"ssl,omitempty" yaml:"ssl,omitempty"`
+	Truststore     *string `json:"truststore,omitempty" yaml:"truststore,omitempty"`
+	Truststorepass *string `json:"truststorepass,omitempty" yaml:"truststorepass,omitempty"`
+}
+
+func (p *LdapConfigParams) toSerialized() *serializedLdapConfigParams {
+	s := &serializedLdapConfigParams{}
+	if p.binddn.ok {
+		s.Binddn = &p.binddn.v
+	}
+	if p.bindpass.ok {
+		s.Bindpass = &p.bindpass.v
+	}
+	if p.hostname.ok {
+		s.Hostname = &p.hostname.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.port.ok {
+		s.Port = &p.port.v
+	}
+	if p.queryfilter.ok {
+		s.Queryfilter = &p.queryfilter.v
+	}
+	if p.searchbase.ok {
+		s.Searchbase = &p.searchbase.v
+	}
+	if p.ssl.ok {
+		s.Ssl = &p.ssl.v
+	}
+	if p.truststore.ok {
+		s.Truststore = &p.truststore.v
+	}
+	if p.truststorepass.ok {
+		s.Truststorepass = &p.truststorepass.v
+	}
+	return s
+}
+
+func (p *LdapConfigParams) fromSerialized(s *serializedLdapConfigParams) {
+	*p = LdapConfigParams{}
+	if s.Binddn != nil {
+		p.SetBinddn(*s.Binddn)
+	}
+	if s.Bindpass != nil {
+		p.SetBindpass(*s.Bindpass)
+	}
+	if s.Hostname != nil {
+		p.SetHostname(*s.Hostname)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Port != nil {
+		p.SetPort(*s.Port)
+	}
+	if s.Queryfilter != nil {
+		p.SetQueryfilter(*s.Queryfilter)
+	}
+	if s.Searchbase != nil {
+		p.SetSearchbase(*s.Searchbase)
+	}
+	if s.Ssl != nil {
+		p.SetSsl(*s.Ssl)
+	}
+	if s.Truststore != nil {
+		p.SetTruststore(*s.Truststore)
+	}
+	if s.Truststorepass != nil {
+		p.SetTruststorepass(*s.Truststorepass)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LdapConfigParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LdapConfigParams) UnmarshalJSON(b []byte) error {
+	var s serializedLdapConfigParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LdapConfigParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LdapConfigParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLdapConfigParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new LdapConfigParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLdapConfigParams() *LdapConfigParams {
 	p := &LdapConfigParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // (Deprecated, use addLdapConfiguration) Configure the LDAP context for this site.
-func (s *LDAPService) LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error) {
-	resp, err := s.cs.newRequest("ldapConfig", p.toURLValues())
+func (s *LDAPService) LdapConfig(p *LdapConfigParams, opts ...CallOption) (*LdapConfigResponse, error) {
+	resp, err := s.cs.newRequest("ldapConfig", s.cs.encodeParams("ldapConfig", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -869,274 +1340,413 @@ type LdapConfigResponse struct {
 }
 
 type LdapCreateAccountParams struct {
-	p map[string]interface{}
-}
-
-func (p *LdapCreateAccountParams) toURLValues() url.Values {
+	account        optString
+	accountdetails optStringMap
+	accountid      optString
+	accounttype    optInt
+	domainid       optString
+	networkdomain  optString
+	roleid         optString
+	timezone       optString
+	userid         optString
+	username       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LdapCreateAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accountdetails"]; found {
-		m := v.(map[string]string)
+	if p.accountdetails.ok {
+		m := p.accountdetails.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
 			u.Set(fmt.Sprintf("accountdetails[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("accounttype", vv)
+	if p.accounttype.ok {
+		u.Set("accounttype", strconv.Itoa(p.accounttype.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *LdapCreateAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseLdapCreateAccountParams parses url.Values, for example taken from a raw API request,
+// into a new LdapCreateAccountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLdapCreateAccountParams(u url.Values) (*LdapCreateAccountParams, error) {
+	p := &LdapCreateAccountParams{}
+	if err := checkParamNames("ldapCreateAccount", u, "account", "accountdetails[]", "accountid", "accounttype", "domainid", "networkdomain", "roleid", "timezone", "userid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if m, err := parseKeyValueMap(u, "accountdetails", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetAccountdetails(m)
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
 	}
-	p.p["account"] = v
+	if _, found := u["accounttype"]; found {
+		v, err := strconv.Atoi(u.Get("accounttype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accounttype: %v", err)
+		}
+		p.SetAccounttype(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
+	}
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *LdapCreateAccountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *LdapCreateAccountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *LdapCreateAccountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccountdetails sets the accountdetails param.
 func (p *LdapCreateAccountParams) SetAccountdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountdetails"] = v
+	p.accountdetails = optStringMap{v: v, ok: true}
 }
 
+// ResetAccountdetails unsets the accountdetails param
 func (p *LdapCreateAccountParams) ResetAccountdetails() {
-	if p.p != nil && p.p["accountdetails"] != nil {
-		delete(p.p, "accountdetails")
-	}
+	p.accountdetails = optStringMap{}
 }
 
+// GetAccountdetails returns the accountdetails param and if it is set
 func (p *LdapCreateAccountParams) GetAccountdetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountdetails"].(map[string]string)
-	return value, ok
+	return p.accountdetails.v, p.accountdetails.ok
 }
 
+// SetAccountid sets the accountid param.
 func (p *LdapCreateAccountParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
+	p.accountid = optString{v: v, ok: true}
 }
 
+// ResetAccountid unsets the accountid param
 func (p *LdapCreateAccountParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *LdapCreateAccountParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetAccounttype sets the accounttype param.
 func (p *LdapCreateAccountParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounttype"] = v
+	p.accounttype = optInt{v: v, ok: true}
 }
 
+// ResetAccounttype unsets the accounttype param
 func (p *LdapCreateAccountParams) ResetAccounttype() {
-	if p.p != nil && p.p["accounttype"] != nil {
-		delete(p.p, "accounttype")
-	}
+	p.accounttype = optInt{}
 }
 
+// GetAccounttype returns the accounttype param and if it is set
 func (p *LdapCreateAccountParams) GetAccounttype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounttype"].(int)
-	return value, ok
+	return p.accounttype.v, p.accounttype.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *LdapCreateAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *LdapCreateAccountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *LdapCreateAccountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *LdapCreateAccountParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *LdapCreateAccountParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *LdapCreateAccountParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+	return p.networkdomain.v, p.networkdomain.ok
 }
 
+// SetRoleid sets the roleid param.
 func (p *LdapCreateAccountParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roleid"] = v
+	p.roleid = optString{v: v, ok: true}
 }
 
+// ResetRoleid unsets the roleid param
 func (p *LdapCreateAccountParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *LdapCreateAccountParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	return p.roleid.v, p.roleid.ok
 }
 
+// SetTimezone sets the timezone param.
 func (p *LdapCreateAccountParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timezone"] = v
+	p.timezone = optString{v: v, ok: true}
 }
 
+// ResetTimezone unsets the timezone param
 func (p *LdapCreateAccountParams) ResetTimezone() {
-	if p.p != nil && p.p["timezone"] != nil {
-		delete(p.p, "timezone")
-	}
+	p.timezone = optString{}
 }
 
+// GetTimezone returns the timezone param and if it is set
 func (p *LdapCreateAccountParams) GetTimezone() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["timezone"].(string)
-	return value, ok
+	return p.timezone.v, p.timezone.ok
 }
 
+// SetUserid sets the userid param.
 func (p *LdapCreateAccountParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *LdapCreateAccountParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *LdapCreateAccountParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	return p.userid.v, p.userid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *LdapCreateAccountParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *LdapCreateAccountParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *LdapCreateAccountParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *LdapCreateAccountParams) Clone() *LdapCreateAccountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.accountdetails = p.accountdetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LdapCreateAccountParams) Equal(o *LdapCreateAccountParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accountdetails.equal(o.accountdetails) &&
+		p.accountid == o.accountid &&
+		p.accounttype == o.accounttype &&
+		p.domainid == o.domainid &&
+		p.networkdomain == o.networkdomain &&
+		p.roleid == o.roleid &&
+		p.timezone == o.timezone &&
+		p.userid == o.userid &&
+		p.username == o.username
+}
+
+// serializedLdapCreateAccountParams is used to (un)marshal LdapCreateAccountParams using the API param names
+type serializedLdapCreateAccountParams struct {
+	Account        *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails *map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Accountid      *string            `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Accounttype    *int               `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid       *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Networkdomain  *string            `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Roleid         *string            `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+	Timezone       *string            `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userid         *string            `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Username       *string            `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *LdapCreateAccountParams) toSerialized() *serializedLdapCreateAccountParams {
+	s := &serializedLdapCreateAccountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if p.accountdetails.ok {
+		s.Accountdetails = &p.accountdetails.v
+	}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.accounttype.ok {
+		s.Accounttype = &p.accounttype.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *LdapCreateAccountParams) fromSerialized(s *serializedLdapCreateAccountParams) {
+	*p = LdapCreateAccountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountdetails != nil {
+		p.SetAccountdetails(*s.Accountdetails)
+	}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Accounttype != nil {
+		p.SetAccounttype(*s.Accounttype)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
+	}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LdapCreateAccountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LdapCreateAccountParams) UnmarshalJSON(b []byte) error {
+	var s serializedLdapCreateAccountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LdapCreateAccountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LdapCreateAccountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLdapCreateAccountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new LdapCreateAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLdapCreateAccountParams(username string) *LdapCreateAccountParams {
 	p := &LdapCreateAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["username"] = username
+	p.SetUsername(username)
 	return p
 }
 
-// Creates an account from an LDAP user
-func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("ldapCreateAccount", p.toURLValues())
+// Creates an account from an LDAP user.
+//
+// Required params: username.
+func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams, opts ...CallOption) (*LdapCreateAccountResponse, error) {
+	resp, err := s.cs.newRequest("ldapCreateAccount", s.cs.encodeParams("ldapCreateAccount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1240,29 +1850,101 @@ type LdapCreateAccountResponseUser struct {
 	Usersource          string      `json:"usersource"`
 }
 
-type LdapRemoveParams struct {
-	p map[string]interface{}
+type LdapRemoveParams struct {
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LdapRemoveParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	return u
+}
+
+// ParseLdapRemoveParams parses url.Values, for example taken from a raw API request,
+// into a new LdapRemoveParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLdapRemoveParams(u url.Values) (*LdapRemoveParams, error) {
+	p := &LdapRemoveParams{}
+	if err := checkParamNames("ldapRemove", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *LdapRemoveParams) Clone() *LdapRemoveParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LdapRemoveParams) Equal(o *LdapRemoveParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedLdapRemoveParams is used to (un)marshal LdapRemoveParams using the API param names
+type serializedLdapRemoveParams struct {
+}
+
+func (p *LdapRemoveParams) toSerialized() *serializedLdapRemoveParams {
+	s := &serializedLdapRemoveParams{}
+	return s
+}
+
+func (p *LdapRemoveParams) fromSerialized(s *serializedLdapRemoveParams) {
+	*p = LdapRemoveParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LdapRemoveParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LdapRemoveParams) UnmarshalJSON(b []byte) error {
+	var s serializedLdapRemoveParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *LdapRemoveParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LdapRemoveParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LdapRemoveParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLdapRemoveParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	return u
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new LdapRemoveParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLdapRemoveParams() *LdapRemoveParams {
 	p := &LdapRemoveParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // (Deprecated , use deleteLdapConfiguration) Remove the LDAP context for this site.
-func (s *LDAPService) LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error) {
-	resp, err := s.cs.newRequest("ldapRemove", p.toURLValues())
+func (s *LDAPService) LdapRemove(p *LdapRemoveParams, opts ...CallOption) (*LdapRemoveResponse, error) {
+	resp, err := s.cs.newRequest("ldapRemove", s.cs.encodeParams("ldapRemove", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1288,176 +1970,288 @@ type LdapRemoveResponse struct {
 }
 
 type LinkDomainToLdapParams struct {
-	p map[string]interface{}
+	accounttype optInt
+	admin       optString
+	domainid    optString
+	ldapdomain  optString
+	name        optString
+	type_       optString
 }
 
-func (p *LinkDomainToLdapParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LinkDomainToLdapParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("accounttype", vv)
+	if p.accounttype.ok {
+		u.Set("accounttype", strconv.Itoa(p.accounttype.v))
 	}
-	if v, found := p.p["admin"]; found {
-		u.Set("admin", v.(string))
+	if p.admin.ok {
+		u.Set("admin", p.admin.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["ldapdomain"]; found {
-		u.Set("ldapdomain", v.(string))
+	if p.ldapdomain.ok {
+		u.Set("ldapdomain", p.ldapdomain.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *LinkDomainToLdapParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseLinkDomainToLdapParams parses url.Values, for example taken from a raw API request,
+// into a new LinkDomainToLdapParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLinkDomainToLdapParams(u url.Values) (*LinkDomainToLdapParams, error) {
+	p := &LinkDomainToLdapParams{}
+	if err := checkParamNames("linkDomainToLdap", u, "accounttype", "admin", "domainid", "ldapdomain", "name", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accounttype"]; found {
+		v, err := strconv.Atoi(u.Get("accounttype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accounttype: %v", err)
+		}
+		p.SetAccounttype(v)
+	}
+	if _, found := u["admin"]; found {
+		p.SetAdmin(u.Get("admin"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["ldapdomain"]; found {
+		p.SetLdapdomain(u.Get("ldapdomain"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["accounttype"] = v
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
+}
+
+// SetAccounttype sets the accounttype param. This param is required.
+func (p *LinkDomainToLdapParams) SetAccounttype(v int) {
+	p.accounttype = optInt{v: v, ok: true}
 }
 
+// ResetAccounttype unsets the accounttype param
 func (p *LinkDomainToLdapParams) ResetAccounttype() {
-	if p.p != nil && p.p["accounttype"] != nil {
-		delete(p.p, "accounttype")
-	}
+	p.accounttype = optInt{}
 }
 
+// GetAccounttype returns the accounttype param and if it is set
 func (p *LinkDomainToLdapParams) GetAccounttype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounttype"].(int)
-	return value, ok
+	return p.accounttype.v, p.accounttype.ok
 }
 
+// SetAdmin sets the admin param.
 func (p *LinkDomainToLdapParams) SetAdmin(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["admin"] = v
+	p.admin = optString{v: v, ok: true}
 }
 
+// ResetAdmin unsets the admin param
 func (p *LinkDomainToLdapParams) ResetAdmin() {
-	if p.p != nil && p.p["admin"] != nil {
-		delete(p.p, "admin")
-	}
+	p.admin = optString{}
 }
 
+// GetAdmin returns the admin param and if it is set
 func (p *LinkDomainToLdapParams) GetAdmin() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["admin"].(string)
-	return value, ok
+	return p.admin.v, p.admin.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *LinkDomainToLdapParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *LinkDomainToLdapParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *LinkDomainToLdapParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetLdapdomain sets the ldapdomain param.
 func (p *LinkDomainToLdapParams) SetLdapdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ldapdomain"] = v
+	p.ldapdomain = optString{v: v, ok: true}
 }
 
+// ResetLdapdomain unsets the ldapdomain param
 func (p *LinkDomainToLdapParams) ResetLdapdomain() {
-	if p.p != nil && p.p["ldapdomain"] != nil {
-		delete(p.p, "ldapdomain")
-	}
+	p.ldapdomain = optString{}
 }
 
+// GetLdapdomain returns the ldapdomain param and if it is set
 func (p *LinkDomainToLdapParams) GetLdapdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ldapdomain"].(string)
-	return value, ok
+	return p.ldapdomain.v, p.ldapdomain.ok
 }
 
+// SetName sets the name param.
 func (p *LinkDomainToLdapParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *LinkDomainToLdapParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *LinkDomainToLdapParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetType sets the type param. This param is required.
 func (p *LinkDomainToLdapParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *LinkDomainToLdapParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *LinkDomainToLdapParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *LinkDomainToLdapParams) Clone() *LinkDomainToLdapParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LinkDomainToLdapParams) Equal(o *LinkDomainToLdapParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accounttype == o.accounttype &&
+		p.admin == o.admin &&
+		p.domainid == o.domainid &&
+		p.ldapdomain == o.ldapdomain &&
+		p.name == o.name &&
+		p.type_ == o.type_
+}
+
+// serializedLinkDomainToLdapParams is used to (un)marshal LinkDomainToLdapParams using the API param names
+type serializedLinkDomainToLdapParams struct {
+	Accounttype *int    `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Admin       *string `json:"admin,omitempty" yaml:"admin,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Ldapdomain  *string `json:"ldapdomain,omitempty" yaml:"ldapdomain,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type        *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *LinkDomainToLdapParams) toSerialized() *serializedLinkDomainToLdapParams {
+	s := &serializedLinkDomainToLdapParams{}
+	if p.accounttype.ok {
+		s.Accounttype = &p.accounttype.v
+	}
+	if p.admin.ok {
+		s.Admin = &p.admin.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.ldapdomain.ok {
+		s.Ldapdomain = &p.ldapdomain.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *LinkDomainToLdapParams) fromSerialized(s *serializedLinkDomainToLdapParams) {
+	*p = LinkDomainToLdapParams{}
+	if s.Accounttype != nil {
+		p.SetAccounttype(*s.Accounttype)
+	}
+	if s.Admin != nil {
+		p.SetAdmin(*s.Admin)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Ldapdomain != nil {
+		p.SetLdapdomain(*s.Ldapdomain)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LinkDomainToLdapParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LinkDomainToLdapParams) UnmarshalJSON(b []byte) error {
+	var s serializedLinkDomainToLdapParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LinkDomainToLdapParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LinkDomainToLdapParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLinkDomainToLdapParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new LinkDomainToLdapParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLinkDomainToLdapParams(accounttype int, domainid string, lDAPType string) *LinkDomainToLdapParams {
 	p := &LinkDomainToLdapParams{}
-	p.p = make(map[string]interface{})
-	p.p["accounttype"] = accounttype
-	p.p["domainid"] = domainid
-	p.p["type"] = lDAPType
+	p.SetAccounttype(accounttype)
+	p.SetDomainid(domainid)
+	p.SetType(lDAPType)
 	return p
 }
 
-// link an existing cloudstack domain to group or OU in ldap
-func (s *LDAPService) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
-	resp, err := s.cs.newRequest("linkDomainToLdap", p.toURLValues())
+// link an existing cloudstack domain to group or OU in ldap.
+//
+// Required params: accounttype, domainid, type.
+func (s *LDAPService) LinkDomainToLdap(p *LinkDomainToLdapParams, opts ...CallOption) (*LinkDomainToLdapResponse, error) {
+	resp, err := s.cs.newRequest("linkDomainToLdap", s.cs.encodeParams("linkDomainToLdap", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1482,200 +2276,325 @@ type LinkDomainToLdapResponse struct {
 }
 
 type ListLdapConfigurationsParams struct {
-	p map[string]interface{}
+	domainid optString
+	hostname optString
+	keyword  optString
+	listall  optBool
+	page     optInt
+	pagesize optInt
+	port     optInt
 }
 
-func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListLdapConfigurationsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if p.hostname.ok {
+		u.Set("hostname", p.hostname.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["port"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("port", vv)
+	if p.port.ok {
+		u.Set("port", strconv.Itoa(p.port.v))
 	}
 	return u
 }
 
-func (p *ListLdapConfigurationsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListLdapConfigurationsParams parses url.Values, for example taken from a raw API request,
+// into a new ListLdapConfigurationsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListLdapConfigurationsParams(u url.Values) (*ListLdapConfigurationsParams, error) {
+	p := &ListLdapConfigurationsParams{}
+	if err := checkParamNames("listLdapConfigurations", u, "domainid", "hostname", "keyword", "listall", "page", "pagesize", "port"); err != nil {
+		return nil, err
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["hostname"]; found {
+		p.SetHostname(u.Get("hostname"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["domainid"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["port"]; found {
+		v, err := strconv.Atoi(u.Get("port"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param port: %v", err)
+		}
+		p.SetPort(v)
+	}
+	return p, nil
 }
 
+// SetDomainid sets the domainid param.
+func (p *ListLdapConfigurationsParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
 func (p *ListLdapConfigurationsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListLdapConfigurationsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHostname sets the hostname param.
 func (p *ListLdapConfigurationsParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
+	p.hostname = optString{v: v, ok: true}
 }
 
+// ResetHostname unsets the hostname param
 func (p *ListLdapConfigurationsParams) ResetHostname() {
-	if p.p != nil && p.p["hostname"] != nil {
-		delete(p.p, "hostname")
-	}
+	p.hostname = optString{}
 }
 
+// GetHostname returns the hostname param and if it is set
 func (p *ListLdapConfigurationsParams) GetHostname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostname"].(string)
-	return value, ok
+	return p.hostname.v, p.hostname.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListLdapConfigurationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListLdapConfigurationsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListLdapConfigurationsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListLdapConfigurationsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListLdapConfigurationsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListLdapConfigurationsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListLdapConfigurationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListLdapConfigurationsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListLdapConfigurationsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListLdapConfigurationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListLdapConfigurationsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListLdapConfigurationsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPort sets the port param.
 func (p *ListLdapConfigurationsParams) SetPort(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["port"] = v
+	p.port = optInt{v: v, ok: true}
 }
 
+// ResetPort unsets the port param
 func (p *ListLdapConfigurationsParams) ResetPort() {
-	if p.p != nil && p.p["port"] != nil {
-		delete(p.p, "port")
-	}
+	p.port = optInt{}
 }
 
+// GetPort returns the port param and if it is set
 func (p *ListLdapConfigurationsParams) GetPort() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.port.v, p.port.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListLdapConfigurationsParams) Clone() *ListLdapConfigurationsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListLdapConfigurationsParams) Equal(o *ListLdapConfigurationsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.domainid == o.domainid &&
+		p.hostname == o.hostname &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.port == o.port
+}
+
+// serializedListLdapConfigurationsParams is used to (un)marshal ListLdapConfigurationsParams using the API param names
+type serializedListLdapConfigurationsParams struct {
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall  *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Port     *int    `json:"port,omitempty" yaml:"port,omitempty"`
+}
+
+func (p *ListLdapConfigurationsParams) toSerialized() *serializedListLdapConfigurationsParams {
+	s := &serializedListLdapConfigurationsParams{}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hostname.ok {
+		s.Hostname = &p.hostname.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.port.ok {
+		s.Port = &p.port.v
+	}
+	return s
+}
+
+func (p *ListLdapConfigurationsParams) fromSerialized(s *serializedListLdapConfigurationsParams) {
+	*p = ListLdapConfigurationsParams{}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hostname != nil {
+		p.SetHostname(*s.Hostname)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
 	}
-	value, ok := p.p["port"].(int)
-	return value, ok
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Port != nil {
+		p.SetPort(*s.Port)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListLdapConfigurationsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListLdapConfigurationsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListLdapConfigurationsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListLdapConfigurationsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListLdapConfigurationsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListLdapConfigurationsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListLdapConfigurationsParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewListLdapConfigurationsParams() *ListLdapConfigurationsParams {
 	p := &ListLdapConfigurationsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists all LDAP configurations
-func (s *LDAPService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listLdapConfigurations", p.toURLValues())
+// Lists all LDAP configurations.
+func (s *LDAPService) ListLdapConfigurations(p *ListLdapConfigurationsParams, opts ...CallOption) (*ListLdapConfigurationsResponse, error) {
+	resp, err := s.cs.newRequest("listLdapConfigurations", s.cs.encodeParams("listLdapConfigurations", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1702,174 +2621,287 @@ type LdapConfiguration struct {
 }
 
 type ListLdapUsersParams struct {
-	p map[string]interface{}
+	domainid   optString
+	keyword    optString
+	listtype   optString
+	page       optInt
+	pagesize   optInt
+	userfilter optString
 }
 
-func (p *ListLdapUsersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListLdapUsersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listtype"]; found {
-		u.Set("listtype", v.(string))
+	if p.listtype.ok {
+		u.Set("listtype", p.listtype.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["userfilter"]; found {
-		u.Set("userfilter", v.(string))
+	if p.userfilter.ok {
+		u.Set("userfilter", p.userfilter.v)
 	}
 	return u
 }
 
-func (p *ListLdapUsersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListLdapUsersParams parses url.Values, for example taken from a raw API request,
+// into a new ListLdapUsersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListLdapUsersParams(u url.Values) (*ListLdapUsersParams, error) {
+	p := &ListLdapUsersParams{}
+	if err := checkParamNames("listLdapUsers", u, "domainid", "keyword", "listtype", "page", "pagesize", "userfilter"); err != nil {
+		return nil, err
 	}
-	p.p["domainid"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listtype"]; found {
+		p.SetListtype(u.Get("listtype"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["userfilter"]; found {
+		p.SetUserfilter(u.Get("userfilter"))
+	}
+	return p, nil
 }
 
+// SetDomainid sets the domainid param.
+func (p *ListLdapUsersParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
 func (p *ListLdapUsersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListLdapUsersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListLdapUsersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListLdapUsersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListLdapUsersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListtype sets the listtype param.
 func (p *ListLdapUsersParams) SetListtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listtype"] = v
+	p.listtype = optString{v: v, ok: true}
 }
 
+// ResetListtype unsets the listtype param
 func (p *ListLdapUsersParams) ResetListtype() {
-	if p.p != nil && p.p["listtype"] != nil {
-		delete(p.p, "listtype")
-	}
+	p.listtype = optString{}
 }
 
+// GetListtype returns the listtype param and if it is set
 func (p *ListLdapUsersParams) GetListtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listtype"].(string)
-	return value, ok
+	return p.listtype.v, p.listtype.ok
 }
 
+// SetPage sets the page param.
 func (p *ListLdapUsersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListLdapUsersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListLdapUsersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListLdapUsersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListLdapUsersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListLdapUsersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetUserfilter sets the userfilter param.
 func (p *ListLdapUsersParams) SetUserfilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userfilter"] = v
+	p.userfilter = optString{v: v, ok: true}
 }
 
+// ResetUserfilter unsets the userfilter param
 func (p *ListLdapUsersParams) ResetUserfilter() {
-	if p.p != nil && p.p["userfilter"] != nil {
-		delete(p.p, "userfilter")
-	}
+	p.userfilter = optString{}
 }
 
+// GetUserfilter returns the userfilter param and if it is set
 func (p *ListLdapUsersParams) GetUserfilter() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userfilter.v, p.userfilter.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListLdapUsersParams) Clone() *ListLdapUsersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListLdapUsersParams) Equal(o *ListLdapUsersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.domainid == o.domainid &&
+		p.keyword == o.keyword &&
+		p.listtype == o.listtype &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.userfilter == o.userfilter
+}
+
+// serializedListLdapUsersParams is used to (un)marshal ListLdapUsersParams using the API param names
+type serializedListLdapUsersParams struct {
+	Domainid   *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listtype   *string `json:"listtype,omitempty" yaml:"listtype,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Userfilter *string `json:"userfilter,omitempty" yaml:"userfilter,omitempty"`
+}
+
+func (p *ListLdapUsersParams) toSerialized() *serializedListLdapUsersParams {
+	s := &serializedListLdapUsersParams{}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listtype.ok {
+		s.Listtype = &p.listtype.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.userfilter.ok {
+		s.Userfilter = &p.userfilter.v
+	}
+	return s
+}
+
+func (p *ListLdapUsersParams) fromSerialized(s *serializedListLdapUsersParams) {
+	*p = ListLdapUsersParams{}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listtype != nil {
+		p.SetListtype(*s.Listtype)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
 	}
-	value, ok := p.p["userfilter"].(string)
-	return value, ok
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Userfilter != nil {
+		p.SetUserfilter(*s.Userfilter)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListLdapUsersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListLdapUsersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListLdapUsersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListLdapUsersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListLdapUsersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListLdapUsersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListLdapUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewListLdapUsersParams() *ListLdapUsersParams {
 	p := &ListLdapUsersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists LDAP Users according to the specifications from the user request.
-func (s *LDAPService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("listLdapUsers", p.toURLValues())
+func (s *LDAPService) ListLdapUsers(p *ListLdapUsersParams, opts ...CallOption) (*ListLdapUsersResponse, error) {
+	resp, err := s.cs.newRequest("listLdapUsers", s.cs.encodeParams("listLdapUsers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1900,127 +2932,230 @@ type LdapUser struct {
 }
 
 type SearchLdapParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	query    optString
 }
 
-func (p *SearchLdapParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *SearchLdapParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["query"]; found {
-		u.Set("query", v.(string))
+	if p.query.ok {
+		u.Set("query", p.query.v)
 	}
 	return u
 }
 
-func (p *SearchLdapParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseSearchLdapParams parses url.Values, for example taken from a raw API request,
+// into a new SearchLdapParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseSearchLdapParams(u url.Values) (*SearchLdapParams, error) {
+	p := &SearchLdapParams{}
+	if err := checkParamNames("searchLdap", u, "keyword", "page", "pagesize", "query"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["keyword"] = v
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["query"]; found {
+		p.SetQuery(u.Get("query"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *SearchLdapParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *SearchLdapParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *SearchLdapParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *SearchLdapParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *SearchLdapParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *SearchLdapParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *SearchLdapParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *SearchLdapParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *SearchLdapParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetQuery sets the query param. This param is required.
 func (p *SearchLdapParams) SetQuery(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["query"] = v
+	p.query = optString{v: v, ok: true}
 }
 
+// ResetQuery unsets the query param
 func (p *SearchLdapParams) ResetQuery() {
-	if p.p != nil && p.p["query"] != nil {
-		delete(p.p, "query")
-	}
+	p.query = optString{}
 }
 
+// GetQuery returns the query param and if it is set
 func (p *SearchLdapParams) GetQuery() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.query.v, p.query.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *SearchLdapParams) Clone() *SearchLdapParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *SearchLdapParams) Equal(o *SearchLdapParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.query == o.query
+}
+
+// serializedSearchLdapParams is used to (un)marshal SearchLdapParams using the API param names
+type serializedSearchLdapParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Query    *string `json:"query,omitempty" yaml:"query,omitempty"`
+}
+
+func (p *SearchLdapParams) toSerialized() *serializedSearchLdapParams {
+	s := &serializedSearchLdapParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.query.ok {
+		s.Query = &p.query.v
+	}
+	return s
+}
+
+func (p *SearchLdapParams) fromSerialized(s *serializedSearchLdapParams) {
+	*p = SearchLdapParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Query != nil {
+		p.SetQuery(*s.Query)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p SearchLdapParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *SearchLdapParams) UnmarshalJSON(b []byte) error {
+	var s serializedSearchLdapParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p SearchLdapParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *SearchLdapParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedSearchLdapParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["query"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new SearchLdapParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewSearchLdapParams(query string) *SearchLdapParams {
 	p := &SearchLdapParams{}
-	p.p = make(map[string]interface{})
-	p.p["query"] = query
+	p.SetQuery(query)
 	return p
 }
 
-// Searches LDAP based on the username attribute
-func (s *LDAPService) SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error) {
-	resp, err := s.cs.newRequest("searchLdap", p.toURLValues())
+// Searches LDAP based on the username attribute.
+//
+// Required params: query.
+func (s *LDAPService) SearchLdap(p *SearchLdapParams, opts ...CallOption) (*SearchLdapResponse, error) {
+	resp, err := s.cs.newRequest("searchLdap", s.cs.encodeParams("searchLdap", p), opts...)
 	if err != nil {
 		return nil, err
 	}
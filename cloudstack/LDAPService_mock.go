@@ -53,138 +53,183 @@ func (m *MockLDAPServiceIface) EXPECT() *MockLDAPServiceIfaceMockRecorder {
 }
 
 // AddLdapConfiguration mocks base method.
-func (m *MockLDAPServiceIface) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
+func (m *MockLDAPServiceIface) AddLdapConfiguration(p *AddLdapConfigurationParams, opts ...CallOption) (*AddLdapConfigurationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddLdapConfiguration", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddLdapConfiguration", varargs...)
 	ret0, _ := ret[0].(*AddLdapConfigurationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddLdapConfiguration indicates an expected call of AddLdapConfiguration.
-func (mr *MockLDAPServiceIfaceMockRecorder) AddLdapConfiguration(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) AddLdapConfiguration(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLdapConfiguration", reflect.TypeOf((*MockLDAPServiceIface)(nil).AddLdapConfiguration), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLdapConfiguration", reflect.TypeOf((*MockLDAPServiceIface)(nil).AddLdapConfiguration), varargs...)
 }
 
 // DeleteLdapConfiguration mocks base method.
-func (m *MockLDAPServiceIface) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+func (m *MockLDAPServiceIface) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams, opts ...CallOption) (*DeleteLdapConfigurationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLdapConfiguration", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLdapConfiguration", varargs...)
 	ret0, _ := ret[0].(*DeleteLdapConfigurationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLdapConfiguration indicates an expected call of DeleteLdapConfiguration.
-func (mr *MockLDAPServiceIfaceMockRecorder) DeleteLdapConfiguration(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) DeleteLdapConfiguration(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLdapConfiguration", reflect.TypeOf((*MockLDAPServiceIface)(nil).DeleteLdapConfiguration), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLdapConfiguration", reflect.TypeOf((*MockLDAPServiceIface)(nil).DeleteLdapConfiguration), varargs...)
 }
 
 // ImportLdapUsers mocks base method.
-func (m *MockLDAPServiceIface) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
+func (m *MockLDAPServiceIface) ImportLdapUsers(p *ImportLdapUsersParams, opts ...CallOption) (*ImportLdapUsersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImportLdapUsers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportLdapUsers", varargs...)
 	ret0, _ := ret[0].(*ImportLdapUsersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ImportLdapUsers indicates an expected call of ImportLdapUsers.
-func (mr *MockLDAPServiceIfaceMockRecorder) ImportLdapUsers(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) ImportLdapUsers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportLdapUsers", reflect.TypeOf((*MockLDAPServiceIface)(nil).ImportLdapUsers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportLdapUsers", reflect.TypeOf((*MockLDAPServiceIface)(nil).ImportLdapUsers), varargs...)
 }
 
 // LdapConfig mocks base method.
-func (m *MockLDAPServiceIface) LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error) {
+func (m *MockLDAPServiceIface) LdapConfig(p *LdapConfigParams, opts ...CallOption) (*LdapConfigResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LdapConfig", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LdapConfig", varargs...)
 	ret0, _ := ret[0].(*LdapConfigResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LdapConfig indicates an expected call of LdapConfig.
-func (mr *MockLDAPServiceIfaceMockRecorder) LdapConfig(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) LdapConfig(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapConfig", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapConfig), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapConfig", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapConfig), varargs...)
 }
 
 // LdapCreateAccount mocks base method.
-func (m *MockLDAPServiceIface) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
+func (m *MockLDAPServiceIface) LdapCreateAccount(p *LdapCreateAccountParams, opts ...CallOption) (*LdapCreateAccountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LdapCreateAccount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LdapCreateAccount", varargs...)
 	ret0, _ := ret[0].(*LdapCreateAccountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LdapCreateAccount indicates an expected call of LdapCreateAccount.
-func (mr *MockLDAPServiceIfaceMockRecorder) LdapCreateAccount(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) LdapCreateAccount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapCreateAccount", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapCreateAccount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapCreateAccount", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapCreateAccount), varargs...)
 }
 
 // LdapRemove mocks base method.
-func (m *MockLDAPServiceIface) LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error) {
+func (m *MockLDAPServiceIface) LdapRemove(p *LdapRemoveParams, opts ...CallOption) (*LdapRemoveResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LdapRemove", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LdapRemove", varargs...)
 	ret0, _ := ret[0].(*LdapRemoveResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LdapRemove indicates an expected call of LdapRemove.
-func (mr *MockLDAPServiceIfaceMockRecorder) LdapRemove(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) LdapRemove(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapRemove", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapRemove), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapRemove", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapRemove), varargs...)
 }
 
 // LinkDomainToLdap mocks base method.
-func (m *MockLDAPServiceIface) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
+func (m *MockLDAPServiceIface) LinkDomainToLdap(p *LinkDomainToLdapParams, opts ...CallOption) (*LinkDomainToLdapResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LinkDomainToLdap", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LinkDomainToLdap", varargs...)
 	ret0, _ := ret[0].(*LinkDomainToLdapResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LinkDomainToLdap indicates an expected call of LinkDomainToLdap.
-func (mr *MockLDAPServiceIfaceMockRecorder) LinkDomainToLdap(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) LinkDomainToLdap(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkDomainToLdap", reflect.TypeOf((*MockLDAPServiceIface)(nil).LinkDomainToLdap), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkDomainToLdap", reflect.TypeOf((*MockLDAPServiceIface)(nil).LinkDomainToLdap), varargs...)
 }
 
 // ListLdapConfigurations mocks base method.
-func (m *MockLDAPServiceIface) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+func (m *MockLDAPServiceIface) ListLdapConfigurations(p *ListLdapConfigurationsParams, opts ...CallOption) (*ListLdapConfigurationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLdapConfigurations", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapConfigurations", varargs...)
 	ret0, _ := ret[0].(*ListLdapConfigurationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListLdapConfigurations indicates an expected call of ListLdapConfigurations.
-func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurations(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurations(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurations", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurations), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurations", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurations), varargs...)
 }
 
 // ListLdapUsers mocks base method.
-func (m *MockLDAPServiceIface) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+func (m *MockLDAPServiceIface) ListLdapUsers(p *ListLdapUsersParams, opts ...CallOption) (*ListLdapUsersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLdapUsers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapUsers", varargs...)
 	ret0, _ := ret[0].(*ListLdapUsersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListLdapUsers indicates an expected call of ListLdapUsers.
-func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsers(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsers", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsers", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsers), varargs...)
 }
 
 // NewAddLdapConfigurationParams mocks base method.
@@ -328,16 +373,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) NewSearchLdapParams(query interface{
 }
 
 // SearchLdap mocks base method.
-func (m *MockLDAPServiceIface) SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error) {
+func (m *MockLDAPServiceIface) SearchLdap(p *SearchLdapParams, opts ...CallOption) (*SearchLdapResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchLdap", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchLdap", varargs...)
 	ret0, _ := ret[0].(*SearchLdapResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchLdap indicates an expected call of SearchLdap.
-func (mr *MockLDAPServiceIfaceMockRecorder) SearchLdap(p interface{}) *gomock.Call {
+func (mr *MockLDAPServiceIfaceMockRecorder) SearchLdap(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchLdap", reflect.TypeOf((*MockLDAPServiceIface)(nil).SearchLdap), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchLdap", reflect.TypeOf((*MockLDAPServiceIface)(nil).SearchLdap), varargs...)
 }
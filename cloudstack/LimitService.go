@@ -21,46 +21,119 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type LimitServiceIface interface {
-	GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	GetApiLimit(p *GetApiLimitParams, opts ...CallOption) (*GetApiLimitResponse, error)
 	NewGetApiLimitParams() *GetApiLimitParams
-	ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	ListResourceLimits(p *ListResourceLimitsParams, opts ...CallOption) (*ListResourceLimitsResponse, error)
 	NewListResourceLimitsParams() *ListResourceLimitsParams
-	ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	ResetApiLimit(p *ResetApiLimitParams, opts ...CallOption) (*ResetApiLimitResponse, error)
 	NewResetApiLimitParams() *ResetApiLimitParams
-	UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	UpdateResourceCount(p *UpdateResourceCountParams, opts ...CallOption) (*UpdateResourceCountResponse, error)
 	NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams
-	UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+	UpdateResourceLimit(p *UpdateResourceLimitParams, opts ...CallOption) (*UpdateResourceLimitResponse, error)
 	NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams
 }
 
 type GetApiLimitParams struct {
-	p map[string]interface{}
 }
 
-func (p *GetApiLimitParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetApiLimitParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseGetApiLimitParams parses url.Values, for example taken from a raw API request,
+// into a new GetApiLimitParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetApiLimitParams(u url.Values) (*GetApiLimitParams, error) {
+	p := &GetApiLimitParams{}
+	if err := checkParamNames("getApiLimit", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *GetApiLimitParams) Clone() *GetApiLimitParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetApiLimitParams) Equal(o *GetApiLimitParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedGetApiLimitParams is used to (un)marshal GetApiLimitParams using the API param names
+type serializedGetApiLimitParams struct {
+}
+
+func (p *GetApiLimitParams) toSerialized() *serializedGetApiLimitParams {
+	s := &serializedGetApiLimitParams{}
+	return s
+}
+
+func (p *GetApiLimitParams) fromSerialized(s *serializedGetApiLimitParams) {
+	*p = GetApiLimitParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetApiLimitParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetApiLimitParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetApiLimitParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetApiLimitParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetApiLimitParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetApiLimitParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new GetApiLimitParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewGetApiLimitParams() *GetApiLimitParams {
 	p := &GetApiLimitParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Get API limit count for the caller
-func (s *LimitService) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("getApiLimit", p.toURLValues())
+// Get API limit count for the caller.
+func (s *LimitService) GetApiLimit(p *GetApiLimitParams, opts ...CallOption) (*GetApiLimitResponse, error) {
+	resp, err := s.cs.newRequest("getApiLimit", s.cs.encodeParams("getApiLimit", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,298 +157,453 @@ type GetApiLimitResponse struct {
 }
 
 type ListResourceLimitsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListResourceLimitsParams) toURLValues() url.Values {
+	account          optString
+	domainid         optString
+	id               optInt64
+	isrecursive      optBool
+	keyword          optString
+	listall          optBool
+	page             optInt
+	pagesize         optInt
+	projectid        optString
+	resourcetype     optInt
+	resourcetypename optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListResourceLimitsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.FormatInt(p.id.v, 10))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("resourcetype", vv)
+	if p.resourcetype.ok {
+		u.Set("resourcetype", strconv.Itoa(p.resourcetype.v))
 	}
-	if v, found := p.p["resourcetypename"]; found {
-		u.Set("resourcetypename", v.(string))
+	if p.resourcetypename.ok {
+		u.Set("resourcetypename", p.resourcetypename.v)
 	}
 	return u
 }
 
-func (p *ListResourceLimitsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListResourceLimitsParams parses url.Values, for example taken from a raw API request,
+// into a new ListResourceLimitsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListResourceLimitsParams(u url.Values) (*ListResourceLimitsParams, error) {
+	p := &ListResourceLimitsParams{}
+	if err := checkParamNames("listResourceLimits", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "resourcetype", "resourcetypename"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["id"]; found {
+		v, err := strconv.ParseInt(u.Get("id"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["resourcetype"]; found {
+		v, err := strconv.Atoi(u.Get("resourcetype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param resourcetype: %v", err)
+		}
+		p.SetResourcetype(v)
+	}
+	if _, found := u["resourcetypename"]; found {
+		p.SetResourcetypename(u.Get("resourcetypename"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListResourceLimitsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListResourceLimitsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListResourceLimitsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListResourceLimitsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListResourceLimitsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListResourceLimitsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListResourceLimitsParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optInt64{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListResourceLimitsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt64{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListResourceLimitsParams) GetId() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int64)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListResourceLimitsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListResourceLimitsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListResourceLimitsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListResourceLimitsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListResourceLimitsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListResourceLimitsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListResourceLimitsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListResourceLimitsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListResourceLimitsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListResourceLimitsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListResourceLimitsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListResourceLimitsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListResourceLimitsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListResourceLimitsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListResourceLimitsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListResourceLimitsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListResourceLimitsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListResourceLimitsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetResourcetype sets the resourcetype param.
 func (p *ListResourceLimitsParams) SetResourcetype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optInt{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *ListResourceLimitsParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optInt{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *ListResourceLimitsParams) GetResourcetype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcetype"].(int)
-	return value, ok
+	return p.resourcetype.v, p.resourcetype.ok
 }
 
+// SetResourcetypename sets the resourcetypename param.
 func (p *ListResourceLimitsParams) SetResourcetypename(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetypename"] = v
+	p.resourcetypename = optString{v: v, ok: true}
 }
 
+// ResetResourcetypename unsets the resourcetypename param
 func (p *ListResourceLimitsParams) ResetResourcetypename() {
-	if p.p != nil && p.p["resourcetypename"] != nil {
-		delete(p.p, "resourcetypename")
-	}
+	p.resourcetypename = optString{}
 }
 
+// GetResourcetypename returns the resourcetypename param and if it is set
 func (p *ListResourceLimitsParams) GetResourcetypename() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.resourcetypename.v, p.resourcetypename.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListResourceLimitsParams) Clone() *ListResourceLimitsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["resourcetypename"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListResourceLimitsParams) Equal(o *ListResourceLimitsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.resourcetype == o.resourcetype &&
+		p.resourcetypename == o.resourcetypename
+}
+
+// serializedListResourceLimitsParams is used to (un)marshal ListResourceLimitsParams using the API param names
+type serializedListResourceLimitsParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourcetype     *int    `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Resourcetypename *string `json:"resourcetypename,omitempty" yaml:"resourcetypename,omitempty"`
+}
+
+func (p *ListResourceLimitsParams) toSerialized() *serializedListResourceLimitsParams {
+	s := &serializedListResourceLimitsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	if p.resourcetypename.ok {
+		s.Resourcetypename = &p.resourcetypename.v
+	}
+	return s
+}
+
+func (p *ListResourceLimitsParams) fromSerialized(s *serializedListResourceLimitsParams) {
+	*p = ListResourceLimitsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+	if s.Resourcetypename != nil {
+		p.SetResourcetypename(*s.Resourcetypename)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListResourceLimitsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListResourceLimitsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListResourceLimitsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListResourceLimitsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListResourceLimitsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListResourceLimitsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListResourceLimitsParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewListResourceLimitsParams() *ListResourceLimitsParams {
 	p := &ListResourceLimitsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists resource limits.
-func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceLimits", p.toURLValues())
+func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams, opts ...CallOption) (*ListResourceLimitsResponse, error) {
+	resp, err := s.cs.newRequest("listResourceLimits", s.cs.encodeParams("listResourceLimits", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -407,52 +635,129 @@ type ResourceLimit struct {
 }
 
 type ResetApiLimitParams struct {
-	p map[string]interface{}
+	account optString
 }
 
-func (p *ResetApiLimitParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResetApiLimitParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
 	return u
 }
 
-func (p *ResetApiLimitParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseResetApiLimitParams parses url.Values, for example taken from a raw API request,
+// into a new ResetApiLimitParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResetApiLimitParams(u url.Values) (*ResetApiLimitParams, error) {
+	p := &ResetApiLimitParams{}
+	if err := checkParamNames("resetApiLimit", u, "account"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ResetApiLimitParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ResetApiLimitParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ResetApiLimitParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.account.v, p.account.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResetApiLimitParams) Clone() *ResetApiLimitParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResetApiLimitParams) Equal(o *ResetApiLimitParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account
+}
+
+// serializedResetApiLimitParams is used to (un)marshal ResetApiLimitParams using the API param names
+type serializedResetApiLimitParams struct {
+	Account *string `json:"account,omitempty" yaml:"account,omitempty"`
+}
+
+func (p *ResetApiLimitParams) toSerialized() *serializedResetApiLimitParams {
+	s := &serializedResetApiLimitParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	return s
+}
+
+func (p *ResetApiLimitParams) fromSerialized(s *serializedResetApiLimitParams) {
+	*p = ResetApiLimitParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResetApiLimitParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResetApiLimitParams) UnmarshalJSON(b []byte) error {
+	var s serializedResetApiLimitParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResetApiLimitParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResetApiLimitParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResetApiLimitParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ResetApiLimitParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewResetApiLimitParams() *ResetApiLimitParams {
 	p := &ResetApiLimitParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Reset api count
-func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("resetApiLimit", p.toURLValues())
+// Reset api count.
+func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams, opts ...CallOption) (*ResetApiLimitResponse, error) {
+	resp, err := s.cs.newRequest("resetApiLimit", s.cs.encodeParams("resetApiLimit", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -500,126 +805,226 @@ func (r *ResetApiLimitResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateResourceCountParams struct {
-	p map[string]interface{}
+	account      optString
+	domainid     optString
+	projectid    optString
+	resourcetype optInt
 }
 
-func (p *UpdateResourceCountParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateResourceCountParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("resourcetype", vv)
+	if p.resourcetype.ok {
+		u.Set("resourcetype", strconv.Itoa(p.resourcetype.v))
 	}
 	return u
 }
 
-func (p *UpdateResourceCountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateResourceCountParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateResourceCountParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateResourceCountParams(u url.Values) (*UpdateResourceCountParams, error) {
+	p := &UpdateResourceCountParams{}
+	if err := checkParamNames("updateResourceCount", u, "account", "domainid", "projectid", "resourcetype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	p.p["account"] = v
+	if _, found := u["resourcetype"]; found {
+		v, err := strconv.Atoi(u.Get("resourcetype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param resourcetype: %v", err)
+		}
+		p.SetResourcetype(v)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *UpdateResourceCountParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *UpdateResourceCountParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UpdateResourceCountParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *UpdateResourceCountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateResourceCountParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateResourceCountParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *UpdateResourceCountParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *UpdateResourceCountParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *UpdateResourceCountParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetResourcetype sets the resourcetype param.
 func (p *UpdateResourceCountParams) SetResourcetype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optInt{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *UpdateResourceCountParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optInt{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *UpdateResourceCountParams) GetResourcetype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.resourcetype.v, p.resourcetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateResourceCountParams) Clone() *UpdateResourceCountParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateResourceCountParams) Equal(o *UpdateResourceCountParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.projectid == o.projectid &&
+		p.resourcetype == o.resourcetype
+}
+
+// serializedUpdateResourceCountParams is used to (un)marshal UpdateResourceCountParams using the API param names
+type serializedUpdateResourceCountParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourcetype *int    `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+func (p *UpdateResourceCountParams) toSerialized() *serializedUpdateResourceCountParams {
+	s := &serializedUpdateResourceCountParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	return s
+}
+
+func (p *UpdateResourceCountParams) fromSerialized(s *serializedUpdateResourceCountParams) {
+	*p = UpdateResourceCountParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateResourceCountParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateResourceCountParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateResourceCountParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["resourcetype"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateResourceCountParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateResourceCountParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateResourceCountParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateResourceCountParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams {
 	p := &UpdateResourceCountParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
+	p.SetDomainid(domainid)
 	return p
 }
 
 // Recalculate and update resource count for an account or domain.
-func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceCount", p.toURLValues())
+//
+// Required params: domainid.
+func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams, opts ...CallOption) (*UpdateResourceCountResponse, error) {
+	resp, err := s.cs.newRequest("updateResourceCount", s.cs.encodeParams("updateResourceCount", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -646,151 +1051,260 @@ type UpdateResourceCountResponse struct {
 }
 
 type UpdateResourceLimitParams struct {
-	p map[string]interface{}
+	account      optString
+	domainid     optString
+	max          optInt64
+	projectid    optString
+	resourcetype optInt
 }
 
-func (p *UpdateResourceLimitParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateResourceLimitParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["max"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("max", vv)
+	if p.max.ok {
+		u.Set("max", strconv.FormatInt(p.max.v, 10))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("resourcetype", vv)
+	if p.resourcetype.ok {
+		u.Set("resourcetype", strconv.Itoa(p.resourcetype.v))
 	}
 	return u
 }
 
-func (p *UpdateResourceLimitParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateResourceLimitParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateResourceLimitParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateResourceLimitParams(u url.Values) (*UpdateResourceLimitParams, error) {
+	p := &UpdateResourceLimitParams{}
+	if err := checkParamNames("updateResourceLimit", u, "account", "domainid", "max", "projectid", "resourcetype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["max"]; found {
+		v, err := strconv.ParseInt(u.Get("max"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param max: %v", err)
+		}
+		p.SetMax(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	p.p["account"] = v
+	if _, found := u["resourcetype"]; found {
+		v, err := strconv.Atoi(u.Get("resourcetype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param resourcetype: %v", err)
+		}
+		p.SetResourcetype(v)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *UpdateResourceLimitParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *UpdateResourceLimitParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UpdateResourceLimitParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateResourceLimitParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateResourceLimitParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateResourceLimitParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetMax sets the max param.
 func (p *UpdateResourceLimitParams) SetMax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["max"] = v
+	p.max = optInt64{v: v, ok: true}
 }
 
+// ResetMax unsets the max param
 func (p *UpdateResourceLimitParams) ResetMax() {
-	if p.p != nil && p.p["max"] != nil {
-		delete(p.p, "max")
-	}
+	p.max = optInt64{}
 }
 
+// GetMax returns the max param and if it is set
 func (p *UpdateResourceLimitParams) GetMax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["max"].(int64)
-	return value, ok
+	return p.max.v, p.max.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *UpdateResourceLimitParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *UpdateResourceLimitParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *UpdateResourceLimitParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetResourcetype sets the resourcetype param. This param is required.
 func (p *UpdateResourceLimitParams) SetResourcetype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optInt{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *UpdateResourceLimitParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optInt{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *UpdateResourceLimitParams) GetResourcetype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.resourcetype.v, p.resourcetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateResourceLimitParams) Clone() *UpdateResourceLimitParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateResourceLimitParams) Equal(o *UpdateResourceLimitParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.max == o.max &&
+		p.projectid == o.projectid &&
+		p.resourcetype == o.resourcetype
+}
+
+// serializedUpdateResourceLimitParams is used to (un)marshal UpdateResourceLimitParams using the API param names
+type serializedUpdateResourceLimitParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Max          *int64  `json:"max,omitempty" yaml:"max,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourcetype *int    `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+func (p *UpdateResourceLimitParams) toSerialized() *serializedUpdateResourceLimitParams {
+	s := &serializedUpdateResourceLimitParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.max.ok {
+		s.Max = &p.max.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
 	}
-	value, ok := p.p["resourcetype"].(int)
-	return value, ok
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	return s
+}
+
+func (p *UpdateResourceLimitParams) fromSerialized(s *serializedUpdateResourceLimitParams) {
+	*p = UpdateResourceLimitParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Max != nil {
+		p.SetMax(*s.Max)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateResourceLimitParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateResourceLimitParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateResourceLimitParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateResourceLimitParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateResourceLimitParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateResourceLimitParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateResourceLimitParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams {
 	p := &UpdateResourceLimitParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourcetype"] = resourcetype
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
 // Updates resource limits for an account or domain.
-func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceLimit", p.toURLValues())
+//
+// Required params: resourcetype.
+func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams, opts ...CallOption) (*UpdateResourceLimitResponse, error) {
+	resp, err := s.cs.newRequest("updateResourceLimit", s.cs.encodeParams("updateResourceLimit", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +53,43 @@ func (m *MockLimitServiceIface) EXPECT() *MockLimitServiceIfaceMockRecorder {
 }
 
 // GetApiLimit mocks base method.
-func (m *MockLimitServiceIface) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error) {
+func (m *MockLimitServiceIface) GetApiLimit(p *GetApiLimitParams, opts ...CallOption) (*GetApiLimitResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetApiLimit", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetApiLimit", varargs...)
 	ret0, _ := ret[0].(*GetApiLimitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetApiLimit indicates an expected call of GetApiLimit.
-func (mr *MockLimitServiceIfaceMockRecorder) GetApiLimit(p interface{}) *gomock.Call {
+func (mr *MockLimitServiceIfaceMockRecorder) GetApiLimit(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).GetApiLimit), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).GetApiLimit), varargs...)
 }
 
 // ListResourceLimits mocks base method.
-func (m *MockLimitServiceIface) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+func (m *MockLimitServiceIface) ListResourceLimits(p *ListResourceLimitsParams, opts ...CallOption) (*ListResourceLimitsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListResourceLimits", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceLimits", varargs...)
 	ret0, _ := ret[0].(*ListResourceLimitsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListResourceLimits indicates an expected call of ListResourceLimits.
-func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimits(p interface{}) *gomock.Call {
+func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimits(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimits", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimits), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimits", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimits), varargs...)
 }
 
 // NewGetApiLimitParams mocks base method.
@@ -153,46 +163,61 @@ func (mr *MockLimitServiceIfaceMockRecorder) NewUpdateResourceLimitParams(resour
 }
 
 // ResetApiLimit mocks base method.
-func (m *MockLimitServiceIface) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
+func (m *MockLimitServiceIface) ResetApiLimit(p *ResetApiLimitParams, opts ...CallOption) (*ResetApiLimitResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetApiLimit", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetApiLimit", varargs...)
 	ret0, _ := ret[0].(*ResetApiLimitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResetApiLimit indicates an expected call of ResetApiLimit.
-func (mr *MockLimitServiceIfaceMockRecorder) ResetApiLimit(p interface{}) *gomock.Call {
+func (mr *MockLimitServiceIfaceMockRecorder) ResetApiLimit(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetApiLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).ResetApiLimit), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetApiLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).ResetApiLimit), varargs...)
 }
 
 // UpdateResourceCount mocks base method.
-func (m *MockLimitServiceIface) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
+func (m *MockLimitServiceIface) UpdateResourceCount(p *UpdateResourceCountParams, opts ...CallOption) (*UpdateResourceCountResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateResourceCount", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateResourceCount", varargs...)
 	ret0, _ := ret[0].(*UpdateResourceCountResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateResourceCount indicates an expected call of UpdateResourceCount.
-func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceCount(p interface{}) *gomock.Call {
+func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceCount(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceCount", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceCount), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceCount", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceCount), varargs...)
 }
 
 // UpdateResourceLimit mocks base method.
-func (m *MockLimitServiceIface) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
+func (m *MockLimitServiceIface) UpdateResourceLimit(p *UpdateResourceLimitParams, opts ...CallOption) (*UpdateResourceLimitResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateResourceLimit", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateResourceLimit", varargs...)
 	ret0, _ := ret[0].(*UpdateResourceLimitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateResourceLimit indicates an expected call of UpdateResourceLimit.
-func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceLimit(p interface{}) *gomock.Call {
+func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceLimit(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceLimit), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceLimit), varargs...)
 }
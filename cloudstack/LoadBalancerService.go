@@ -28,334 +28,469 @@ import (
 )
 
 type LoadBalancerServiceIface interface {
-	AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams, opts ...CallOption) (*AddNetscalerLoadBalancerResponse, error)
 	NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams
-	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams, opts ...CallOption) (*AssignCertToLoadBalancerResponse, error)
 	NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams
-	AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams, opts ...CallOption) (*AssignToGlobalLoadBalancerRuleResponse, error)
 	NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams
-	AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams, opts ...CallOption) (*AssignToLoadBalancerRuleResponse, error)
 	NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams
-	ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams, opts ...CallOption) (*NetscalerLoadBalancerResponse, error)
 	NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams
-	CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams, opts ...CallOption) (*CreateGlobalLoadBalancerRuleResponse, error)
 	NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams
-	CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams, opts ...CallOption) (*CreateLBHealthCheckPolicyResponse, error)
 	NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams
-	CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams, opts ...CallOption) (*CreateLBStickinessPolicyResponse, error)
 	NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams
-	CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancer(p *CreateLoadBalancerParams, opts ...CallOption) (*CreateLoadBalancerResponse, error)
 	NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams
-	CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams, opts ...CallOption) (*CreateLoadBalancerRuleResponse, error)
 	NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams
-	DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams, opts ...CallOption) (*DeleteGlobalLoadBalancerRuleResponse, error)
 	NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams
-	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams, opts ...CallOption) (*DeleteLBHealthCheckPolicyResponse, error)
 	NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams
-	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams, opts ...CallOption) (*DeleteLBStickinessPolicyResponse, error)
 	NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams
-	DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancer(p *DeleteLoadBalancerParams, opts ...CallOption) (*DeleteLoadBalancerResponse, error)
 	NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams
-	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams, opts ...CallOption) (*DeleteLoadBalancerRuleResponse, error)
 	NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams
-	DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams, opts ...CallOption) (*DeleteNetscalerLoadBalancerResponse, error)
 	NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams
-	DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	DeleteSslCert(p *DeleteSslCertParams, opts ...CallOption) (*DeleteSslCertResponse, error)
 	NewDeleteSslCertParams(id string) *DeleteSslCertParams
-	ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams, opts ...CallOption) (*ListGlobalLoadBalancerRulesResponse, error)
 	NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams
 	GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error)
 	GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
 	GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
-	ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams, opts ...CallOption) (*ListLBHealthCheckPoliciesResponse, error)
 	NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams
 	GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
-	ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams, opts ...CallOption) (*ListLBStickinessPoliciesResponse, error)
 	NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams
 	GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
-	ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams, opts ...CallOption) (*ListLoadBalancerRuleInstancesResponse, error)
 	NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams
 	GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
-	ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRules(p *ListLoadBalancerRulesParams, opts ...CallOption) (*ListLoadBalancerRulesResponse, error)
 	NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams
 	GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error)
 	GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
 	GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
-	ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	ListLoadBalancers(p *ListLoadBalancersParams, opts ...CallOption) (*ListLoadBalancersResponse, error)
 	NewListLoadBalancersParams() *ListLoadBalancersParams
 	GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error)
 	GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error)
 	GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error)
-	ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams, opts ...CallOption) (*ListNetscalerLoadBalancersResponse, error)
 	NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams
-	ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	ListSslCerts(p *ListSslCertsParams, opts ...CallOption) (*ListSslCertsResponse, error)
 	NewListSslCertsParams() *ListSslCertsParams
-	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams, opts ...CallOption) (*RemoveCertFromLoadBalancerResponse, error)
 	NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams
-	RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams, opts ...CallOption) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
 	NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams
-	RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams, opts ...CallOption) (*RemoveFromLoadBalancerRuleResponse, error)
 	NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams
-	UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams, opts ...CallOption) (*UpdateGlobalLoadBalancerRuleResponse, error)
 	NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams
-	UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams, opts ...CallOption) (*UpdateLBHealthCheckPolicyResponse, error)
 	NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams
-	UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams, opts ...CallOption) (*UpdateLBStickinessPolicyResponse, error)
 	NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams
-	UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancer(p *UpdateLoadBalancerParams, opts ...CallOption) (*UpdateLoadBalancerResponse, error)
 	NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams
-	UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams, opts ...CallOption) (*UpdateLoadBalancerRuleResponse, error)
 	NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams
-	UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error)
+	UploadSslCert(p *UploadSslCertParams, opts ...CallOption) (*UploadSslCertResponse, error)
 	NewUploadSslCertParams(certificate string, name string, privatekey string) *UploadSslCertParams
 }
 
 type AddNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddNetscalerLoadBalancerParams) toURLValues() url.Values {
+	gslbprovider            optBool
+	gslbproviderprivateip   optString
+	gslbproviderpublicip    optString
+	isexclusivegslbprovider optBool
+	networkdevicetype       optString
+	password                optString
+	physicalnetworkid       optString
+	url                     optString
+	username                optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddNetscalerLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["gslbprovider"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("gslbprovider", vv)
+	if p.gslbprovider.ok {
+		u.Set("gslbprovider", strconv.FormatBool(p.gslbprovider.v))
 	}
-	if v, found := p.p["gslbproviderprivateip"]; found {
-		u.Set("gslbproviderprivateip", v.(string))
+	if p.gslbproviderprivateip.ok {
+		u.Set("gslbproviderprivateip", p.gslbproviderprivateip.v)
 	}
-	if v, found := p.p["gslbproviderpublicip"]; found {
-		u.Set("gslbproviderpublicip", v.(string))
+	if p.gslbproviderpublicip.ok {
+		u.Set("gslbproviderpublicip", p.gslbproviderpublicip.v)
 	}
-	if v, found := p.p["isexclusivegslbprovider"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isexclusivegslbprovider", vv)
+	if p.isexclusivegslbprovider.ok {
+		u.Set("isexclusivegslbprovider", strconv.FormatBool(p.isexclusivegslbprovider.v))
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
+	if p.networkdevicetype.ok {
+		u.Set("networkdevicetype", p.networkdevicetype.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddNetscalerLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new AddNetscalerLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddNetscalerLoadBalancerParams(u url.Values) (*AddNetscalerLoadBalancerParams, error) {
+	p := &AddNetscalerLoadBalancerParams{}
+	if err := checkParamNames("addNetscalerLoadBalancer", u, "gslbprovider", "gslbproviderprivateip", "gslbproviderpublicip", "isexclusivegslbprovider", "networkdevicetype", "password", "physicalnetworkid", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["gslbprovider"]; found {
+		v, err := strconv.ParseBool(u.Get("gslbprovider"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param gslbprovider: %v", err)
+		}
+		p.SetGslbprovider(v)
+	}
+	if _, found := u["gslbproviderprivateip"]; found {
+		p.SetGslbproviderprivateip(u.Get("gslbproviderprivateip"))
+	}
+	if _, found := u["gslbproviderpublicip"]; found {
+		p.SetGslbproviderpublicip(u.Get("gslbproviderpublicip"))
+	}
+	if _, found := u["isexclusivegslbprovider"]; found {
+		v, err := strconv.ParseBool(u.Get("isexclusivegslbprovider"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isexclusivegslbprovider: %v", err)
+		}
+		p.SetIsexclusivegslbprovider(v)
+	}
+	if _, found := u["networkdevicetype"]; found {
+		p.SetNetworkdevicetype(u.Get("networkdevicetype"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
 	}
-	p.p["gslbprovider"] = v
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetGslbprovider sets the gslbprovider param.
+func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
+	p.gslbprovider = optBool{v: v, ok: true}
 }
 
+// ResetGslbprovider unsets the gslbprovider param
 func (p *AddNetscalerLoadBalancerParams) ResetGslbprovider() {
-	if p.p != nil && p.p["gslbprovider"] != nil {
-		delete(p.p, "gslbprovider")
-	}
+	p.gslbprovider = optBool{}
 }
 
+// GetGslbprovider returns the gslbprovider param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetGslbprovider() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslbprovider"].(bool)
-	return value, ok
+	return p.gslbprovider.v, p.gslbprovider.ok
 }
 
+// SetGslbproviderprivateip sets the gslbproviderprivateip param.
 func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbproviderprivateip"] = v
+	p.gslbproviderprivateip = optString{v: v, ok: true}
 }
 
+// ResetGslbproviderprivateip unsets the gslbproviderprivateip param
 func (p *AddNetscalerLoadBalancerParams) ResetGslbproviderprivateip() {
-	if p.p != nil && p.p["gslbproviderprivateip"] != nil {
-		delete(p.p, "gslbproviderprivateip")
-	}
+	p.gslbproviderprivateip = optString{}
 }
 
+// GetGslbproviderprivateip returns the gslbproviderprivateip param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetGslbproviderprivateip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslbproviderprivateip"].(string)
-	return value, ok
+	return p.gslbproviderprivateip.v, p.gslbproviderprivateip.ok
 }
 
+// SetGslbproviderpublicip sets the gslbproviderpublicip param.
 func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbproviderpublicip"] = v
+	p.gslbproviderpublicip = optString{v: v, ok: true}
 }
 
+// ResetGslbproviderpublicip unsets the gslbproviderpublicip param
 func (p *AddNetscalerLoadBalancerParams) ResetGslbproviderpublicip() {
-	if p.p != nil && p.p["gslbproviderpublicip"] != nil {
-		delete(p.p, "gslbproviderpublicip")
-	}
+	p.gslbproviderpublicip = optString{}
 }
 
+// GetGslbproviderpublicip returns the gslbproviderpublicip param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetGslbproviderpublicip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslbproviderpublicip"].(string)
-	return value, ok
+	return p.gslbproviderpublicip.v, p.gslbproviderpublicip.ok
 }
 
+// SetIsexclusivegslbprovider sets the isexclusivegslbprovider param.
 func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbprovider(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isexclusivegslbprovider"] = v
+	p.isexclusivegslbprovider = optBool{v: v, ok: true}
 }
 
+// ResetIsexclusivegslbprovider unsets the isexclusivegslbprovider param
 func (p *AddNetscalerLoadBalancerParams) ResetIsexclusivegslbprovider() {
-	if p.p != nil && p.p["isexclusivegslbprovider"] != nil {
-		delete(p.p, "isexclusivegslbprovider")
-	}
+	p.isexclusivegslbprovider = optBool{}
 }
 
+// GetIsexclusivegslbprovider returns the isexclusivegslbprovider param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetIsexclusivegslbprovider() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isexclusivegslbprovider"].(bool)
-	return value, ok
+	return p.isexclusivegslbprovider.v, p.isexclusivegslbprovider.ok
 }
 
+// SetNetworkdevicetype sets the networkdevicetype param. This param is required.
 func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdevicetype"] = v
+	p.networkdevicetype = optString{v: v, ok: true}
 }
 
+// ResetNetworkdevicetype unsets the networkdevicetype param
 func (p *AddNetscalerLoadBalancerParams) ResetNetworkdevicetype() {
-	if p.p != nil && p.p["networkdevicetype"] != nil {
-		delete(p.p, "networkdevicetype")
-	}
+	p.networkdevicetype = optString{}
 }
 
+// GetNetworkdevicetype returns the networkdevicetype param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetNetworkdevicetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdevicetype"].(string)
-	return value, ok
+	return p.networkdevicetype.v, p.networkdevicetype.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddNetscalerLoadBalancerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddNetscalerLoadBalancerParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddNetscalerLoadBalancerParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddNetscalerLoadBalancerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddNetscalerLoadBalancerParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddNetscalerLoadBalancerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddNetscalerLoadBalancerParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddNetscalerLoadBalancerParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddNetscalerLoadBalancerParams) Clone() *AddNetscalerLoadBalancerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddNetscalerLoadBalancerParams) Equal(o *AddNetscalerLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.gslbprovider == o.gslbprovider &&
+		p.gslbproviderprivateip == o.gslbproviderprivateip &&
+		p.gslbproviderpublicip == o.gslbproviderpublicip &&
+		p.isexclusivegslbprovider == o.isexclusivegslbprovider &&
+		p.networkdevicetype == o.networkdevicetype &&
+		p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddNetscalerLoadBalancerParams is used to (un)marshal AddNetscalerLoadBalancerParams using the API param names
+type serializedAddNetscalerLoadBalancerParams struct {
+	Gslbprovider            *bool   `json:"gslbprovider,omitempty" yaml:"gslbprovider,omitempty"`
+	Gslbproviderprivateip   *string `json:"gslbproviderprivateip,omitempty" yaml:"gslbproviderprivateip,omitempty"`
+	Gslbproviderpublicip    *string `json:"gslbproviderpublicip,omitempty" yaml:"gslbproviderpublicip,omitempty"`
+	Isexclusivegslbprovider *bool   `json:"isexclusivegslbprovider,omitempty" yaml:"isexclusivegslbprovider,omitempty"`
+	Networkdevicetype       *string `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Password                *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid       *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url                     *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username                *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddNetscalerLoadBalancerParams) toSerialized() *serializedAddNetscalerLoadBalancerParams {
+	s := &serializedAddNetscalerLoadBalancerParams{}
+	if p.gslbprovider.ok {
+		s.Gslbprovider = &p.gslbprovider.v
+	}
+	if p.gslbproviderprivateip.ok {
+		s.Gslbproviderprivateip = &p.gslbproviderprivateip.v
+	}
+	if p.gslbproviderpublicip.ok {
+		s.Gslbproviderpublicip = &p.gslbproviderpublicip.v
+	}
+	if p.isexclusivegslbprovider.ok {
+		s.Isexclusivegslbprovider = &p.isexclusivegslbprovider.v
+	}
+	if p.networkdevicetype.ok {
+		s.Networkdevicetype = &p.networkdevicetype.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return s
+}
+
+func (p *AddNetscalerLoadBalancerParams) fromSerialized(s *serializedAddNetscalerLoadBalancerParams) {
+	*p = AddNetscalerLoadBalancerParams{}
+	if s.Gslbprovider != nil {
+		p.SetGslbprovider(*s.Gslbprovider)
+	}
+	if s.Gslbproviderprivateip != nil {
+		p.SetGslbproviderprivateip(*s.Gslbproviderprivateip)
+	}
+	if s.Gslbproviderpublicip != nil {
+		p.SetGslbproviderpublicip(*s.Gslbproviderpublicip)
+	}
+	if s.Isexclusivegslbprovider != nil {
+		p.SetIsexclusivegslbprovider(*s.Isexclusivegslbprovider)
+	}
+	if s.Networkdevicetype != nil {
+		p.SetNetworkdevicetype(*s.Networkdevicetype)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddNetscalerLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddNetscalerLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddNetscalerLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddNetscalerLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddNetscalerLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams {
 	p := &AddNetscalerLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkdevicetype"] = networkdevicetype
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
+	p.SetNetworkdevicetype(networkdevicetype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds a netscaler load balancer device
-func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("addNetscalerLoadBalancer", p.toURLValues())
+// Adds a netscaler load balancer device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: networkdevicetype,
+// password, physicalnetworkid, url, username.
+func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams, opts ...CallOption) (*AddNetscalerLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("addNetscalerLoadBalancer", s.cs.encodeParams("addNetscalerLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -366,8 +501,8 @@ func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalanc
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -409,78 +544,164 @@ type AddNetscalerLoadBalancerResponse struct {
 }
 
 type AssignCertToLoadBalancerParams struct {
-	p map[string]interface{}
+	certid   optString
+	lbruleid optString
 }
 
-func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AssignCertToLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["certid"]; found {
-		u.Set("certid", v.(string))
+	if p.certid.ok {
+		u.Set("certid", p.certid.v)
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
 	return u
 }
 
-func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAssignCertToLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new AssignCertToLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAssignCertToLoadBalancerParams(u url.Values) (*AssignCertToLoadBalancerParams, error) {
+	p := &AssignCertToLoadBalancerParams{}
+	if err := checkParamNames("assignCertToLoadBalancer", u, "certid", "lbruleid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["certid"]; found {
+		p.SetCertid(u.Get("certid"))
 	}
-	p.p["certid"] = v
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
+	}
+	return p, nil
+}
+
+// SetCertid sets the certid param. This param is required.
+func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
+	p.certid = optString{v: v, ok: true}
 }
 
+// ResetCertid unsets the certid param
 func (p *AssignCertToLoadBalancerParams) ResetCertid() {
-	if p.p != nil && p.p["certid"] != nil {
-		delete(p.p, "certid")
-	}
+	p.certid = optString{}
 }
 
+// GetCertid returns the certid param and if it is set
 func (p *AssignCertToLoadBalancerParams) GetCertid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["certid"].(string)
-	return value, ok
+	return p.certid.v, p.certid.ok
 }
 
+// SetLbruleid sets the lbruleid param. This param is required.
 func (p *AssignCertToLoadBalancerParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *AssignCertToLoadBalancerParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *AssignCertToLoadBalancerParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.lbruleid.v, p.lbruleid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AssignCertToLoadBalancerParams) Clone() *AssignCertToLoadBalancerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AssignCertToLoadBalancerParams) Equal(o *AssignCertToLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.certid == o.certid &&
+		p.lbruleid == o.lbruleid
+}
+
+// serializedAssignCertToLoadBalancerParams is used to (un)marshal AssignCertToLoadBalancerParams using the API param names
+type serializedAssignCertToLoadBalancerParams struct {
+	Certid   *string `json:"certid,omitempty" yaml:"certid,omitempty"`
+	Lbruleid *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+}
+
+func (p *AssignCertToLoadBalancerParams) toSerialized() *serializedAssignCertToLoadBalancerParams {
+	s := &serializedAssignCertToLoadBalancerParams{}
+	if p.certid.ok {
+		s.Certid = &p.certid.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	return s
+}
+
+func (p *AssignCertToLoadBalancerParams) fromSerialized(s *serializedAssignCertToLoadBalancerParams) {
+	*p = AssignCertToLoadBalancerParams{}
+	if s.Certid != nil {
+		p.SetCertid(*s.Certid)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AssignCertToLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AssignCertToLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedAssignCertToLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AssignCertToLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AssignCertToLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAssignCertToLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AssignCertToLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams {
 	p := &AssignCertToLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["certid"] = certid
-	p.p["lbruleid"] = lbruleid
+	p.SetCertid(certid)
+	p.SetLbruleid(lbruleid)
 	return p
 }
 
-// Assigns a certificate to a load balancer rule
-func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("assignCertToLoadBalancer", p.toURLValues())
+// Assigns a certificate to a load balancer rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: certid, lbruleid.
+func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams, opts ...CallOption) (*AssignCertToLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("assignCertToLoadBalancer", s.cs.encodeParams("assignCertToLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -491,8 +712,8 @@ func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalanc
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -516,107 +737,203 @@ type AssignCertToLoadBalancerResponse struct {
 }
 
 type AssignToGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	gslblbruleweightsmap optStringMap
+	id                   optString
+	loadbalancerrulelist optStrings
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AssignToGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["gslblbruleweightsmap"]; found {
-		m := v.(map[string]string)
+	if p.gslblbruleweightsmap.ok {
+		m := p.gslblbruleweightsmap.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].key", i), k)
 			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["loadbalancerrulelist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("loadbalancerrulelist", vv)
+	if p.loadbalancerrulelist.ok {
+		u.Set("loadbalancerrulelist", strings.Join(p.loadbalancerrulelist.v, ","))
 	}
 	return u
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAssignToGlobalLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new AssignToGlobalLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAssignToGlobalLoadBalancerRuleParams(u url.Values) (*AssignToGlobalLoadBalancerRuleParams, error) {
+	p := &AssignToGlobalLoadBalancerRuleParams{}
+	if err := checkParamNames("assignToGlobalLoadBalancerRule", u, "gslblbruleweightsmap[]", "id", "loadbalancerrulelist"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "gslblbruleweightsmap", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetGslblbruleweightsmap(m)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["gslblbruleweightsmap"] = v
+	if _, found := u["loadbalancerrulelist"]; found {
+		p.SetLoadbalancerrulelist(strings.Split(u.Get("loadbalancerrulelist"), ","))
+	}
+	return p, nil
+}
+
+// SetGslblbruleweightsmap sets the gslblbruleweightsmap param.
+func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[string]string) {
+	p.gslblbruleweightsmap = optStringMap{v: v, ok: true}
 }
 
+// ResetGslblbruleweightsmap unsets the gslblbruleweightsmap param
 func (p *AssignToGlobalLoadBalancerRuleParams) ResetGslblbruleweightsmap() {
-	if p.p != nil && p.p["gslblbruleweightsmap"] != nil {
-		delete(p.p, "gslblbruleweightsmap")
-	}
+	p.gslblbruleweightsmap = optStringMap{}
 }
 
+// GetGslblbruleweightsmap returns the gslblbruleweightsmap param and if it is set
 func (p *AssignToGlobalLoadBalancerRuleParams) GetGslblbruleweightsmap() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslblbruleweightsmap"].(map[string]string)
-	return value, ok
+	return p.gslblbruleweightsmap.v, p.gslblbruleweightsmap.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *AssignToGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *AssignToGlobalLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *AssignToGlobalLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetLoadbalancerrulelist sets the loadbalancerrulelist param. This param is required.
 func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["loadbalancerrulelist"] = v
+	p.loadbalancerrulelist = optStrings{v: v, ok: true}
 }
 
+// ResetLoadbalancerrulelist unsets the loadbalancerrulelist param
 func (p *AssignToGlobalLoadBalancerRuleParams) ResetLoadbalancerrulelist() {
-	if p.p != nil && p.p["loadbalancerrulelist"] != nil {
-		delete(p.p, "loadbalancerrulelist")
-	}
+	p.loadbalancerrulelist = optStrings{}
 }
 
+// GetLoadbalancerrulelist returns the loadbalancerrulelist param and if it is set
 func (p *AssignToGlobalLoadBalancerRuleParams) GetLoadbalancerrulelist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.loadbalancerrulelist.v, p.loadbalancerrulelist.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AssignToGlobalLoadBalancerRuleParams) Clone() *AssignToGlobalLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.gslblbruleweightsmap = p.gslblbruleweightsmap.clone()
+	c.loadbalancerrulelist = p.loadbalancerrulelist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AssignToGlobalLoadBalancerRuleParams) Equal(o *AssignToGlobalLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.gslblbruleweightsmap.equal(o.gslblbruleweightsmap) &&
+		p.id == o.id &&
+		p.loadbalancerrulelist.equal(o.loadbalancerrulelist)
+}
+
+// serializedAssignToGlobalLoadBalancerRuleParams is used to (un)marshal AssignToGlobalLoadBalancerRuleParams using the API param names
+type serializedAssignToGlobalLoadBalancerRuleParams struct {
+	Gslblbruleweightsmap *map[string]string `json:"gslblbruleweightsmap,omitempty" yaml:"gslblbruleweightsmap,omitempty"`
+	Id                   *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Loadbalancerrulelist *[]string          `json:"loadbalancerrulelist,omitempty" yaml:"loadbalancerrulelist,omitempty"`
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) toSerialized() *serializedAssignToGlobalLoadBalancerRuleParams {
+	s := &serializedAssignToGlobalLoadBalancerRuleParams{}
+	if p.gslblbruleweightsmap.ok {
+		s.Gslblbruleweightsmap = &p.gslblbruleweightsmap.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.loadbalancerrulelist.ok {
+		s.Loadbalancerrulelist = &p.loadbalancerrulelist.v
+	}
+	return s
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) fromSerialized(s *serializedAssignToGlobalLoadBalancerRuleParams) {
+	*p = AssignToGlobalLoadBalancerRuleParams{}
+	if s.Gslblbruleweightsmap != nil {
+		p.SetGslblbruleweightsmap(*s.Gslblbruleweightsmap)
 	}
-	value, ok := p.p["loadbalancerrulelist"].([]string)
-	return value, ok
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Loadbalancerrulelist != nil {
+		p.SetLoadbalancerrulelist(*s.Loadbalancerrulelist)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AssignToGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AssignToGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedAssignToGlobalLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AssignToGlobalLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AssignToGlobalLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAssignToGlobalLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AssignToGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams {
 	p := &AssignToGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["loadbalancerrulelist"] = loadbalancerrulelist
+	p.SetId(id)
+	p.SetLoadbalancerrulelist(loadbalancerrulelist)
 	return p
 }
 
 // Assign load balancer rule or list of load balancer rules to a global load balancer rules.
-func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToGlobalLoadBalancerRule", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id,
+// loadbalancerrulelist.
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams, opts ...CallOption) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("assignToGlobalLoadBalancerRule", s.cs.encodeParams("assignToGlobalLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -627,8 +944,8 @@ func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLo
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -652,23 +969,25 @@ type AssignToGlobalLoadBalancerRuleResponse struct {
 }
 
 type AssignToLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	id                optString
+	virtualmachineids optStrings
+	vmidipmap         optStringMap
 }
 
-func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AssignToLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["virtualmachineids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("virtualmachineids", vv)
+	if p.virtualmachineids.ok {
+		u.Set("virtualmachineids", strings.Join(p.virtualmachineids.v, ","))
 	}
-	if v, found := p.p["vmidipmap"]; found {
-		m := v.(map[string]string)
+	if p.vmidipmap.ok {
+		m := p.vmidipmap.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
 			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
@@ -677,81 +996,174 @@ func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAssignToLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new AssignToLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAssignToLoadBalancerRuleParams(u url.Values) (*AssignToLoadBalancerRuleParams, error) {
+	p := &AssignToLoadBalancerRuleParams{}
+	if err := checkParamNames("assignToLoadBalancerRule", u, "id", "virtualmachineids", "vmidipmap[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["virtualmachineids"]; found {
+		p.SetVirtualmachineids(strings.Split(u.Get("virtualmachineids"), ","))
 	}
-	p.p["id"] = v
+	if m, err := parseKeyValueMap(u, "vmidipmap", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetVmidipmap(m)
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *AssignToLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *AssignToLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetVirtualmachineids sets the virtualmachineids param.
 func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineids"] = v
+	p.virtualmachineids = optStrings{v: v, ok: true}
 }
 
+// ResetVirtualmachineids unsets the virtualmachineids param
 func (p *AssignToLoadBalancerRuleParams) ResetVirtualmachineids() {
-	if p.p != nil && p.p["virtualmachineids"] != nil {
-		delete(p.p, "virtualmachineids")
-	}
+	p.virtualmachineids = optStrings{}
 }
 
+// GetVirtualmachineids returns the virtualmachineids param and if it is set
 func (p *AssignToLoadBalancerRuleParams) GetVirtualmachineids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineids"].([]string)
-	return value, ok
+	return p.virtualmachineids.v, p.virtualmachineids.ok
 }
 
+// SetVmidipmap sets the vmidipmap param.
 func (p *AssignToLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmidipmap"] = v
+	p.vmidipmap = optStringMap{v: v, ok: true}
 }
 
+// ResetVmidipmap unsets the vmidipmap param
 func (p *AssignToLoadBalancerRuleParams) ResetVmidipmap() {
-	if p.p != nil && p.p["vmidipmap"] != nil {
-		delete(p.p, "vmidipmap")
-	}
+	p.vmidipmap = optStringMap{}
 }
 
+// GetVmidipmap returns the vmidipmap param and if it is set
 func (p *AssignToLoadBalancerRuleParams) GetVmidipmap() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmidipmap.v, p.vmidipmap.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AssignToLoadBalancerRuleParams) Clone() *AssignToLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.virtualmachineids = p.virtualmachineids.clone()
+	c.vmidipmap = p.vmidipmap.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AssignToLoadBalancerRuleParams) Equal(o *AssignToLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.virtualmachineids.equal(o.virtualmachineids) &&
+		p.vmidipmap.equal(o.vmidipmap)
+}
+
+// serializedAssignToLoadBalancerRuleParams is used to (un)marshal AssignToLoadBalancerRuleParams using the API param names
+type serializedAssignToLoadBalancerRuleParams struct {
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineids *[]string          `json:"virtualmachineids,omitempty" yaml:"virtualmachineids,omitempty"`
+	Vmidipmap         *map[string]string `json:"vmidipmap,omitempty" yaml:"vmidipmap,omitempty"`
+}
+
+func (p *AssignToLoadBalancerRuleParams) toSerialized() *serializedAssignToLoadBalancerRuleParams {
+	s := &serializedAssignToLoadBalancerRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.virtualmachineids.ok {
+		s.Virtualmachineids = &p.virtualmachineids.v
+	}
+	if p.vmidipmap.ok {
+		s.Vmidipmap = &p.vmidipmap.v
+	}
+	return s
+}
+
+func (p *AssignToLoadBalancerRuleParams) fromSerialized(s *serializedAssignToLoadBalancerRuleParams) {
+	*p = AssignToLoadBalancerRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Virtualmachineids != nil {
+		p.SetVirtualmachineids(*s.Virtualmachineids)
 	}
-	value, ok := p.p["vmidipmap"].(map[string]string)
-	return value, ok
+	if s.Vmidipmap != nil {
+		p.SetVmidipmap(*s.Vmidipmap)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AssignToLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AssignToLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedAssignToLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AssignToLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AssignToLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAssignToLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AssignToLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams {
 	p := &AssignToLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Assigns virtual machine or a list of virtual machines to a load balancer rule.
-func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToLoadBalancerRule", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams, opts ...CallOption) (*AssignToLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("assignToLoadBalancerRule", s.cs.encodeParams("assignToLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -762,8 +1174,8 @@ func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRu
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -787,153 +1199,266 @@ type AssignToLoadBalancerRuleResponse struct {
 }
 
 type ConfigureNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
+	inline            optBool
+	lbdevicecapacity  optInt64
+	lbdevicededicated optBool
+	lbdeviceid        optString
+	podids            optStrings
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ConfigureNetscalerLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["inline"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("inline", vv)
+	if p.inline.ok {
+		u.Set("inline", strconv.FormatBool(p.inline.v))
 	}
-	if v, found := p.p["lbdevicecapacity"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("lbdevicecapacity", vv)
+	if p.lbdevicecapacity.ok {
+		u.Set("lbdevicecapacity", strconv.FormatInt(p.lbdevicecapacity.v, 10))
 	}
-	if v, found := p.p["lbdevicededicated"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("lbdevicededicated", vv)
+	if p.lbdevicededicated.ok {
+		u.Set("lbdevicededicated", strconv.FormatBool(p.lbdevicededicated.v))
 	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
+	if p.lbdeviceid.ok {
+		u.Set("lbdeviceid", p.lbdeviceid.v)
 	}
-	if v, found := p.p["podids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("podids", vv)
+	if p.podids.ok {
+		u.Set("podids", strings.Join(p.podids.v, ","))
 	}
 	return u
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseConfigureNetscalerLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new ConfigureNetscalerLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseConfigureNetscalerLoadBalancerParams(u url.Values) (*ConfigureNetscalerLoadBalancerParams, error) {
+	p := &ConfigureNetscalerLoadBalancerParams{}
+	if err := checkParamNames("configureNetscalerLoadBalancer", u, "inline", "lbdevicecapacity", "lbdevicededicated", "lbdeviceid", "podids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["inline"]; found {
+		v, err := strconv.ParseBool(u.Get("inline"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param inline: %v", err)
+		}
+		p.SetInline(v)
+	}
+	if _, found := u["lbdevicecapacity"]; found {
+		v, err := strconv.ParseInt(u.Get("lbdevicecapacity"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param lbdevicecapacity: %v", err)
+		}
+		p.SetLbdevicecapacity(v)
+	}
+	if _, found := u["lbdevicededicated"]; found {
+		v, err := strconv.ParseBool(u.Get("lbdevicededicated"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param lbdevicededicated: %v", err)
+		}
+		p.SetLbdevicededicated(v)
+	}
+	if _, found := u["lbdeviceid"]; found {
+		p.SetLbdeviceid(u.Get("lbdeviceid"))
+	}
+	if _, found := u["podids"]; found {
+		p.SetPodids(strings.Split(u.Get("podids"), ","))
 	}
-	p.p["inline"] = v
+	return p, nil
 }
 
+// SetInline sets the inline param.
+func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
+	p.inline = optBool{v: v, ok: true}
+}
+
+// ResetInline unsets the inline param
 func (p *ConfigureNetscalerLoadBalancerParams) ResetInline() {
-	if p.p != nil && p.p["inline"] != nil {
-		delete(p.p, "inline")
-	}
+	p.inline = optBool{}
 }
 
+// GetInline returns the inline param and if it is set
 func (p *ConfigureNetscalerLoadBalancerParams) GetInline() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["inline"].(bool)
-	return value, ok
+	return p.inline.v, p.inline.ok
 }
 
+// SetLbdevicecapacity sets the lbdevicecapacity param.
 func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacity(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdevicecapacity"] = v
+	p.lbdevicecapacity = optInt64{v: v, ok: true}
 }
 
+// ResetLbdevicecapacity unsets the lbdevicecapacity param
 func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdevicecapacity() {
-	if p.p != nil && p.p["lbdevicecapacity"] != nil {
-		delete(p.p, "lbdevicecapacity")
-	}
+	p.lbdevicecapacity = optInt64{}
 }
 
+// GetLbdevicecapacity returns the lbdevicecapacity param and if it is set
 func (p *ConfigureNetscalerLoadBalancerParams) GetLbdevicecapacity() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbdevicecapacity"].(int64)
-	return value, ok
+	return p.lbdevicecapacity.v, p.lbdevicecapacity.ok
 }
 
+// SetLbdevicededicated sets the lbdevicededicated param.
 func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicated(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdevicededicated"] = v
+	p.lbdevicededicated = optBool{v: v, ok: true}
 }
 
+// ResetLbdevicededicated unsets the lbdevicededicated param
 func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdevicededicated() {
-	if p.p != nil && p.p["lbdevicededicated"] != nil {
-		delete(p.p, "lbdevicededicated")
-	}
+	p.lbdevicededicated = optBool{}
 }
 
+// GetLbdevicededicated returns the lbdevicededicated param and if it is set
 func (p *ConfigureNetscalerLoadBalancerParams) GetLbdevicededicated() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbdevicededicated"].(bool)
-	return value, ok
+	return p.lbdevicededicated.v, p.lbdevicededicated.ok
 }
 
+// SetLbdeviceid sets the lbdeviceid param. This param is required.
 func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdeviceid"] = v
+	p.lbdeviceid = optString{v: v, ok: true}
 }
 
+// ResetLbdeviceid unsets the lbdeviceid param
 func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdeviceid() {
-	if p.p != nil && p.p["lbdeviceid"] != nil {
-		delete(p.p, "lbdeviceid")
-	}
+	p.lbdeviceid = optString{}
 }
 
+// GetLbdeviceid returns the lbdeviceid param and if it is set
 func (p *ConfigureNetscalerLoadBalancerParams) GetLbdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbdeviceid"].(string)
-	return value, ok
+	return p.lbdeviceid.v, p.lbdeviceid.ok
 }
 
+// SetPodids sets the podids param.
 func (p *ConfigureNetscalerLoadBalancerParams) SetPodids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podids"] = v
+	p.podids = optStrings{v: v, ok: true}
 }
 
+// ResetPodids unsets the podids param
 func (p *ConfigureNetscalerLoadBalancerParams) ResetPodids() {
-	if p.p != nil && p.p["podids"] != nil {
-		delete(p.p, "podids")
-	}
+	p.podids = optStrings{}
 }
 
+// GetPodids returns the podids param and if it is set
 func (p *ConfigureNetscalerLoadBalancerParams) GetPodids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.podids.v, p.podids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ConfigureNetscalerLoadBalancerParams) Clone() *ConfigureNetscalerLoadBalancerParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["podids"].([]string)
-	return value, ok
+	c := *p
+	c.podids = p.podids.clone()
+	return &c
 }
 
-// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
-// as then you are sure you have configured all required params
+// Equal reports whether p and o hold exactly the same param values
+func (p *ConfigureNetscalerLoadBalancerParams) Equal(o *ConfigureNetscalerLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.inline == o.inline &&
+		p.lbdevicecapacity == o.lbdevicecapacity &&
+		p.lbdevicededicated == o.lbdevicededicated &&
+		p.lbdeviceid == o.lbdeviceid &&
+		p.podids.equal(o.podids)
+}
+
+// serializedConfigureNetscalerLoadBalancerParams is used to (un)marshal ConfigureNetscalerLoadBalancerParams using the API param names
+type serializedConfigureNetscalerLoadBalancerParams struct {
+	Inline            *bool     `json:"inline,omitempty" yaml:"inline,omitempty"`
+	Lbdevicecapacity  *int64    `json:"lbdevicecapacity,omitempty" yaml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated *bool     `json:"lbdevicededicated,omitempty" yaml:"lbdevicededicated,omitempty"`
+	Lbdeviceid        *string   `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Podids            *[]string `json:"podids,omitempty" yaml:"podids,omitempty"`
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) toSerialized() *serializedConfigureNetscalerLoadBalancerParams {
+	s := &serializedConfigureNetscalerLoadBalancerParams{}
+	if p.inline.ok {
+		s.Inline = &p.inline.v
+	}
+	if p.lbdevicecapacity.ok {
+		s.Lbdevicecapacity = &p.lbdevicecapacity.v
+	}
+	if p.lbdevicededicated.ok {
+		s.Lbdevicededicated = &p.lbdevicededicated.v
+	}
+	if p.lbdeviceid.ok {
+		s.Lbdeviceid = &p.lbdeviceid.v
+	}
+	if p.podids.ok {
+		s.Podids = &p.podids.v
+	}
+	return s
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) fromSerialized(s *serializedConfigureNetscalerLoadBalancerParams) {
+	*p = ConfigureNetscalerLoadBalancerParams{}
+	if s.Inline != nil {
+		p.SetInline(*s.Inline)
+	}
+	if s.Lbdevicecapacity != nil {
+		p.SetLbdevicecapacity(*s.Lbdevicecapacity)
+	}
+	if s.Lbdevicededicated != nil {
+		p.SetLbdevicededicated(*s.Lbdevicededicated)
+	}
+	if s.Lbdeviceid != nil {
+		p.SetLbdeviceid(*s.Lbdeviceid)
+	}
+	if s.Podids != nil {
+		p.SetPodids(*s.Podids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ConfigureNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ConfigureNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedConfigureNetscalerLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ConfigureNetscalerLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ConfigureNetscalerLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedConfigureNetscalerLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
+// as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams {
 	p := &ConfigureNetscalerLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// configures a netscaler load balancer device
-func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("configureNetscalerLoadBalancer", p.toURLValues())
+// configures a netscaler load balancer device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: lbdeviceid.
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams, opts ...CallOption) (*NetscalerLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("configureNetscalerLoadBalancer", s.cs.encodeParams("configureNetscalerLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -944,8 +1469,8 @@ func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscal
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -987,249 +1512,381 @@ type NetscalerLoadBalancerResponse struct {
 }
 
 type CreateGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+	account                     optString
+	description                 optString
+	domainid                    optString
+	gslbdomainname              optString
+	gslblbmethod                optString
+	gslbservicetype             optString
+	gslbstickysessionmethodname optString
+	name                        optString
+	regionid                    optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["gslbdomainname"]; found {
-		u.Set("gslbdomainname", v.(string))
+	if p.gslbdomainname.ok {
+		u.Set("gslbdomainname", p.gslbdomainname.v)
 	}
-	if v, found := p.p["gslblbmethod"]; found {
-		u.Set("gslblbmethod", v.(string))
+	if p.gslblbmethod.ok {
+		u.Set("gslblbmethod", p.gslblbmethod.v)
 	}
-	if v, found := p.p["gslbservicetype"]; found {
-		u.Set("gslbservicetype", v.(string))
+	if p.gslbservicetype.ok {
+		u.Set("gslbservicetype", p.gslbservicetype.v)
 	}
-	if v, found := p.p["gslbstickysessionmethodname"]; found {
-		u.Set("gslbstickysessionmethodname", v.(string))
+	if p.gslbstickysessionmethodname.ok {
+		u.Set("gslbstickysessionmethodname", p.gslbstickysessionmethodname.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
+	if p.regionid.ok {
+		u.Set("regionid", strconv.Itoa(p.regionid.v))
 	}
 	return u
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateGlobalLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateGlobalLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateGlobalLoadBalancerRuleParams(u url.Values) (*CreateGlobalLoadBalancerRuleParams, error) {
+	p := &CreateGlobalLoadBalancerRuleParams{}
+	if err := checkParamNames("createGlobalLoadBalancerRule", u, "account", "description", "domainid", "gslbdomainname", "gslblbmethod", "gslbservicetype", "gslbstickysessionmethodname", "name", "regionid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["gslbdomainname"]; found {
+		p.SetGslbdomainname(u.Get("gslbdomainname"))
+	}
+	if _, found := u["gslblbmethod"]; found {
+		p.SetGslblbmethod(u.Get("gslblbmethod"))
+	}
+	if _, found := u["gslbservicetype"]; found {
+		p.SetGslbservicetype(u.Get("gslbservicetype"))
+	}
+	if _, found := u["gslbstickysessionmethodname"]; found {
+		p.SetGslbstickysessionmethodname(u.Get("gslbstickysessionmethodname"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["regionid"]; found {
+		v, err := strconv.Atoi(u.Get("regionid"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param regionid: %v", err)
+		}
+		p.SetRegionid(v)
 	}
-	p.p["account"] = v
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDescription sets the description param.
 func (p *CreateGlobalLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateGlobalLoadBalancerRuleParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetGslbdomainname sets the gslbdomainname param. This param is required.
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbdomainname"] = v
+	p.gslbdomainname = optString{v: v, ok: true}
 }
 
+// ResetGslbdomainname unsets the gslbdomainname param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbdomainname() {
-	if p.p != nil && p.p["gslbdomainname"] != nil {
-		delete(p.p, "gslbdomainname")
-	}
+	p.gslbdomainname = optString{}
 }
 
+// GetGslbdomainname returns the gslbdomainname param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetGslbdomainname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslbdomainname"].(string)
-	return value, ok
+	return p.gslbdomainname.v, p.gslbdomainname.ok
 }
 
+// SetGslblbmethod sets the gslblbmethod param.
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslblbmethod"] = v
+	p.gslblbmethod = optString{v: v, ok: true}
 }
 
+// ResetGslblbmethod unsets the gslblbmethod param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetGslblbmethod() {
-	if p.p != nil && p.p["gslblbmethod"] != nil {
-		delete(p.p, "gslblbmethod")
-	}
+	p.gslblbmethod = optString{}
 }
 
+// GetGslblbmethod returns the gslblbmethod param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetGslblbmethod() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslblbmethod"].(string)
-	return value, ok
+	return p.gslblbmethod.v, p.gslblbmethod.ok
 }
 
+// SetGslbservicetype sets the gslbservicetype param. This param is required.
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbservicetype"] = v
+	p.gslbservicetype = optString{v: v, ok: true}
 }
 
+// ResetGslbservicetype unsets the gslbservicetype param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbservicetype() {
-	if p.p != nil && p.p["gslbservicetype"] != nil {
-		delete(p.p, "gslbservicetype")
-	}
+	p.gslbservicetype = optString{}
 }
 
+// GetGslbservicetype returns the gslbservicetype param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetGslbservicetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslbservicetype"].(string)
-	return value, ok
+	return p.gslbservicetype.v, p.gslbservicetype.ok
 }
 
+// SetGslbstickysessionmethodname sets the gslbstickysessionmethodname param.
 func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbstickysessionmethodname"] = v
+	p.gslbstickysessionmethodname = optString{v: v, ok: true}
 }
 
+// ResetGslbstickysessionmethodname unsets the gslbstickysessionmethodname param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbstickysessionmethodname() {
-	if p.p != nil && p.p["gslbstickysessionmethodname"] != nil {
-		delete(p.p, "gslbstickysessionmethodname")
-	}
+	p.gslbstickysessionmethodname = optString{}
 }
 
+// GetGslbstickysessionmethodname returns the gslbstickysessionmethodname param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetGslbstickysessionmethodname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslbstickysessionmethodname"].(string)
-	return value, ok
+	return p.gslbstickysessionmethodname.v, p.gslbstickysessionmethodname.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateGlobalLoadBalancerRuleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetRegionid sets the regionid param. This param is required.
 func (p *CreateGlobalLoadBalancerRuleParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
+	p.regionid = optInt{v: v, ok: true}
 }
 
+// ResetRegionid unsets the regionid param
 func (p *CreateGlobalLoadBalancerRuleParams) ResetRegionid() {
-	if p.p != nil && p.p["regionid"] != nil {
-		delete(p.p, "regionid")
-	}
+	p.regionid = optInt{}
 }
 
+// GetRegionid returns the regionid param and if it is set
 func (p *CreateGlobalLoadBalancerRuleParams) GetRegionid() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.regionid.v, p.regionid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateGlobalLoadBalancerRuleParams) Clone() *CreateGlobalLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateGlobalLoadBalancerRuleParams) Equal(o *CreateGlobalLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.description == o.description &&
+		p.domainid == o.domainid &&
+		p.gslbdomainname == o.gslbdomainname &&
+		p.gslblbmethod == o.gslblbmethod &&
+		p.gslbservicetype == o.gslbservicetype &&
+		p.gslbstickysessionmethodname == o.gslbstickysessionmethodname &&
+		p.name == o.name &&
+		p.regionid == o.regionid
+}
+
+// serializedCreateGlobalLoadBalancerRuleParams is used to (un)marshal CreateGlobalLoadBalancerRuleParams using the API param names
+type serializedCreateGlobalLoadBalancerRuleParams struct {
+	Account                     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Description                 *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid                    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Gslbdomainname              *string `json:"gslbdomainname,omitempty" yaml:"gslbdomainname,omitempty"`
+	Gslblbmethod                *string `json:"gslblbmethod,omitempty" yaml:"gslblbmethod,omitempty"`
+	Gslbservicetype             *string `json:"gslbservicetype,omitempty" yaml:"gslbservicetype,omitempty"`
+	Gslbstickysessionmethodname *string `json:"gslbstickysessionmethodname,omitempty" yaml:"gslbstickysessionmethodname,omitempty"`
+	Name                        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Regionid                    *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) toSerialized() *serializedCreateGlobalLoadBalancerRuleParams {
+	s := &serializedCreateGlobalLoadBalancerRuleParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.gslbdomainname.ok {
+		s.Gslbdomainname = &p.gslbdomainname.v
+	}
+	if p.gslblbmethod.ok {
+		s.Gslblbmethod = &p.gslblbmethod.v
+	}
+	if p.gslbservicetype.ok {
+		s.Gslbservicetype = &p.gslbservicetype.v
+	}
+	if p.gslbstickysessionmethodname.ok {
+		s.Gslbstickysessionmethodname = &p.gslbstickysessionmethodname.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.regionid.ok {
+		s.Regionid = &p.regionid.v
 	}
-	value, ok := p.p["regionid"].(int)
-	return value, ok
+	return s
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) fromSerialized(s *serializedCreateGlobalLoadBalancerRuleParams) {
+	*p = CreateGlobalLoadBalancerRuleParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Gslbdomainname != nil {
+		p.SetGslbdomainname(*s.Gslbdomainname)
+	}
+	if s.Gslblbmethod != nil {
+		p.SetGslblbmethod(*s.Gslblbmethod)
+	}
+	if s.Gslbservicetype != nil {
+		p.SetGslbservicetype(*s.Gslbservicetype)
+	}
+	if s.Gslbstickysessionmethodname != nil {
+		p.SetGslbstickysessionmethodname(*s.Gslbstickysessionmethodname)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Regionid != nil {
+		p.SetRegionid(*s.Regionid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateGlobalLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateGlobalLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateGlobalLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateGlobalLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams {
 	p := &CreateGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["gslbdomainname"] = gslbdomainname
-	p.p["gslbservicetype"] = gslbservicetype
-	p.p["name"] = name
-	p.p["regionid"] = regionid
+	p.SetGslbdomainname(gslbdomainname)
+	p.SetGslbservicetype(gslbservicetype)
+	p.SetName(name)
+	p.SetRegionid(regionid)
 	return p
 }
 
-// Creates a global load balancer rule
-func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createGlobalLoadBalancerRule", p.toURLValues())
+// Creates a global load balancer rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: gslbdomainname,
+// gslbservicetype, name, regionid.
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams, opts ...CallOption) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("createGlobalLoadBalancerRule", s.cs.encodeParams("createGlobalLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1240,8 +1897,8 @@ func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1306,226 +1963,363 @@ type CreateGlobalLoadBalancerRuleResponseLoadbalancerrule struct {
 }
 
 type CreateLBHealthCheckPolicyParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
+	description        optString
+	fordisplay         optBool
+	healthythreshold   optInt
+	intervaltime       optInt
+	lbruleid           optString
+	pingpath           optString
+	responsetimeout    optInt
+	unhealthythreshold optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateLBHealthCheckPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["healthythreshold"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("healthythreshold", vv)
+	if p.healthythreshold.ok {
+		u.Set("healthythreshold", strconv.Itoa(p.healthythreshold.v))
 	}
-	if v, found := p.p["intervaltime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("intervaltime", vv)
+	if p.intervaltime.ok {
+		u.Set("intervaltime", strconv.Itoa(p.intervaltime.v))
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
-	if v, found := p.p["pingpath"]; found {
-		u.Set("pingpath", v.(string))
+	if p.pingpath.ok {
+		u.Set("pingpath", p.pingpath.v)
 	}
-	if v, found := p.p["responsetimeout"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("responsetimeout", vv)
+	if p.responsetimeout.ok {
+		u.Set("responsetimeout", strconv.Itoa(p.responsetimeout.v))
 	}
-	if v, found := p.p["unhealthythreshold"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("unhealthythreshold", vv)
+	if p.unhealthythreshold.ok {
+		u.Set("unhealthythreshold", strconv.Itoa(p.unhealthythreshold.v))
 	}
 	return u
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateLBHealthCheckPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new CreateLBHealthCheckPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateLBHealthCheckPolicyParams(u url.Values) (*CreateLBHealthCheckPolicyParams, error) {
+	p := &CreateLBHealthCheckPolicyParams{}
+	if err := checkParamNames("createLBHealthCheckPolicy", u, "description", "fordisplay", "healthythreshold", "intervaltime", "lbruleid", "pingpath", "responsetimeout", "unhealthythreshold"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["healthythreshold"]; found {
+		v, err := strconv.Atoi(u.Get("healthythreshold"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param healthythreshold: %v", err)
+		}
+		p.SetHealthythreshold(v)
+	}
+	if _, found := u["intervaltime"]; found {
+		v, err := strconv.Atoi(u.Get("intervaltime"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param intervaltime: %v", err)
+		}
+		p.SetIntervaltime(v)
+	}
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
 	}
-	p.p["description"] = v
+	if _, found := u["pingpath"]; found {
+		p.SetPingpath(u.Get("pingpath"))
+	}
+	if _, found := u["responsetimeout"]; found {
+		v, err := strconv.Atoi(u.Get("responsetimeout"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param responsetimeout: %v", err)
+		}
+		p.SetResponsetimeout(v)
+	}
+	if _, found := u["unhealthythreshold"]; found {
+		v, err := strconv.Atoi(u.Get("unhealthythreshold"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param unhealthythreshold: %v", err)
+		}
+		p.SetUnhealthythreshold(v)
+	}
+	return p, nil
+}
+
+// SetDescription sets the description param.
+func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateLBHealthCheckPolicyParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateLBHealthCheckPolicyParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetHealthythreshold sets the healthythreshold param.
 func (p *CreateLBHealthCheckPolicyParams) SetHealthythreshold(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["healthythreshold"] = v
+	p.healthythreshold = optInt{v: v, ok: true}
 }
 
+// ResetHealthythreshold unsets the healthythreshold param
 func (p *CreateLBHealthCheckPolicyParams) ResetHealthythreshold() {
-	if p.p != nil && p.p["healthythreshold"] != nil {
-		delete(p.p, "healthythreshold")
-	}
+	p.healthythreshold = optInt{}
 }
 
+// GetHealthythreshold returns the healthythreshold param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetHealthythreshold() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["healthythreshold"].(int)
-	return value, ok
+	return p.healthythreshold.v, p.healthythreshold.ok
 }
 
+// SetIntervaltime sets the intervaltime param.
 func (p *CreateLBHealthCheckPolicyParams) SetIntervaltime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["intervaltime"] = v
+	p.intervaltime = optInt{v: v, ok: true}
 }
 
+// ResetIntervaltime unsets the intervaltime param
 func (p *CreateLBHealthCheckPolicyParams) ResetIntervaltime() {
-	if p.p != nil && p.p["intervaltime"] != nil {
-		delete(p.p, "intervaltime")
-	}
+	p.intervaltime = optInt{}
 }
 
+// GetIntervaltime returns the intervaltime param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetIntervaltime() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["intervaltime"].(int)
-	return value, ok
+	return p.intervaltime.v, p.intervaltime.ok
 }
 
+// SetLbruleid sets the lbruleid param. This param is required.
 func (p *CreateLBHealthCheckPolicyParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *CreateLBHealthCheckPolicyParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	return p.lbruleid.v, p.lbruleid.ok
 }
 
+// SetPingpath sets the pingpath param.
 func (p *CreateLBHealthCheckPolicyParams) SetPingpath(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingpath"] = v
+	p.pingpath = optString{v: v, ok: true}
 }
 
+// ResetPingpath unsets the pingpath param
 func (p *CreateLBHealthCheckPolicyParams) ResetPingpath() {
-	if p.p != nil && p.p["pingpath"] != nil {
-		delete(p.p, "pingpath")
-	}
+	p.pingpath = optString{}
 }
 
+// GetPingpath returns the pingpath param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetPingpath() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pingpath"].(string)
-	return value, ok
+	return p.pingpath.v, p.pingpath.ok
 }
 
+// SetResponsetimeout sets the responsetimeout param.
 func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeout(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["responsetimeout"] = v
+	p.responsetimeout = optInt{v: v, ok: true}
 }
 
+// ResetResponsetimeout unsets the responsetimeout param
 func (p *CreateLBHealthCheckPolicyParams) ResetResponsetimeout() {
-	if p.p != nil && p.p["responsetimeout"] != nil {
-		delete(p.p, "responsetimeout")
-	}
+	p.responsetimeout = optInt{}
 }
 
+// GetResponsetimeout returns the responsetimeout param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetResponsetimeout() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["responsetimeout"].(int)
-	return value, ok
+	return p.responsetimeout.v, p.responsetimeout.ok
 }
 
+// SetUnhealthythreshold sets the unhealthythreshold param.
 func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythreshold(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["unhealthythreshold"] = v
+	p.unhealthythreshold = optInt{v: v, ok: true}
 }
 
+// ResetUnhealthythreshold unsets the unhealthythreshold param
 func (p *CreateLBHealthCheckPolicyParams) ResetUnhealthythreshold() {
-	if p.p != nil && p.p["unhealthythreshold"] != nil {
-		delete(p.p, "unhealthythreshold")
-	}
+	p.unhealthythreshold = optInt{}
 }
 
+// GetUnhealthythreshold returns the unhealthythreshold param and if it is set
 func (p *CreateLBHealthCheckPolicyParams) GetUnhealthythreshold() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.unhealthythreshold.v, p.unhealthythreshold.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateLBHealthCheckPolicyParams) Clone() *CreateLBHealthCheckPolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateLBHealthCheckPolicyParams) Equal(o *CreateLBHealthCheckPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.fordisplay == o.fordisplay &&
+		p.healthythreshold == o.healthythreshold &&
+		p.intervaltime == o.intervaltime &&
+		p.lbruleid == o.lbruleid &&
+		p.pingpath == o.pingpath &&
+		p.responsetimeout == o.responsetimeout &&
+		p.unhealthythreshold == o.unhealthythreshold
+}
+
+// serializedCreateLBHealthCheckPolicyParams is used to (un)marshal CreateLBHealthCheckPolicyParams using the API param names
+type serializedCreateLBHealthCheckPolicyParams struct {
+	Description        *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay         *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Healthythreshold   *int    `json:"healthythreshold,omitempty" yaml:"healthythreshold,omitempty"`
+	Intervaltime       *int    `json:"intervaltime,omitempty" yaml:"intervaltime,omitempty"`
+	Lbruleid           *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Pingpath           *string `json:"pingpath,omitempty" yaml:"pingpath,omitempty"`
+	Responsetimeout    *int    `json:"responsetimeout,omitempty" yaml:"responsetimeout,omitempty"`
+	Unhealthythreshold *int    `json:"unhealthythreshold,omitempty" yaml:"unhealthythreshold,omitempty"`
+}
+
+func (p *CreateLBHealthCheckPolicyParams) toSerialized() *serializedCreateLBHealthCheckPolicyParams {
+	s := &serializedCreateLBHealthCheckPolicyParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.healthythreshold.ok {
+		s.Healthythreshold = &p.healthythreshold.v
+	}
+	if p.intervaltime.ok {
+		s.Intervaltime = &p.intervaltime.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	if p.pingpath.ok {
+		s.Pingpath = &p.pingpath.v
+	}
+	if p.responsetimeout.ok {
+		s.Responsetimeout = &p.responsetimeout.v
+	}
+	if p.unhealthythreshold.ok {
+		s.Unhealthythreshold = &p.unhealthythreshold.v
+	}
+	return s
+}
+
+func (p *CreateLBHealthCheckPolicyParams) fromSerialized(s *serializedCreateLBHealthCheckPolicyParams) {
+	*p = CreateLBHealthCheckPolicyParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Healthythreshold != nil {
+		p.SetHealthythreshold(*s.Healthythreshold)
+	}
+	if s.Intervaltime != nil {
+		p.SetIntervaltime(*s.Intervaltime)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
 	}
-	value, ok := p.p["unhealthythreshold"].(int)
-	return value, ok
+	if s.Pingpath != nil {
+		p.SetPingpath(*s.Pingpath)
+	}
+	if s.Responsetimeout != nil {
+		p.SetResponsetimeout(*s.Responsetimeout)
+	}
+	if s.Unhealthythreshold != nil {
+		p.SetUnhealthythreshold(*s.Unhealthythreshold)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateLBHealthCheckPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateLBHealthCheckPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateLBHealthCheckPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateLBHealthCheckPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateLBHealthCheckPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams {
 	p := &CreateLBHealthCheckPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
+	p.SetLbruleid(lbruleid)
 	return p
 }
 
-// Creates a load balancer health check policy
-func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBHealthCheckPolicy", p.toURLValues())
+// Creates a load balancer health check policy.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: lbruleid.
+func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams, opts ...CallOption) (*CreateLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequest("createLBHealthCheckPolicy", s.cs.encodeParams("createLBHealthCheckPolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1536,8 +2330,8 @@ func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPo
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1582,32 +2376,37 @@ type CreateLBHealthCheckPolicyResponseHealthcheckpolicy struct {
 }
 
 type CreateLBStickinessPolicyParams struct {
-	p map[string]interface{}
+	description optString
+	fordisplay  optBool
+	lbruleid    optString
+	methodname  optString
+	name        optString
+	param       optStringMap
 }
 
-func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateLBStickinessPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
-	if v, found := p.p["methodname"]; found {
-		u.Set("methodname", v.(string))
+	if p.methodname.ok {
+		u.Set("methodname", p.methodname.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["param"]; found {
-		m := v.(map[string]string)
+	if p.param.ok {
+		m := p.param.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("param[%d].key", i), k)
 			u.Set(fmt.Sprintf("param[%d].value", i), m[k])
@@ -1616,159 +2415,271 @@ func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateLBStickinessPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new CreateLBStickinessPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateLBStickinessPolicyParams(u url.Values) (*CreateLBStickinessPolicyParams, error) {
+	p := &CreateLBStickinessPolicyParams{}
+	if err := checkParamNames("createLBStickinessPolicy", u, "description", "fordisplay", "lbruleid", "methodname", "name", "param[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
 	}
-	p.p["description"] = v
+	if _, found := u["methodname"]; found {
+		p.SetMethodname(u.Get("methodname"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if m, err := parseKeyValueMap(u, "param", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetParam(m)
+	}
+	return p, nil
 }
 
+// SetDescription sets the description param.
+func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
 func (p *CreateLBStickinessPolicyParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateLBStickinessPolicyParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateLBStickinessPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateLBStickinessPolicyParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateLBStickinessPolicyParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetLbruleid sets the lbruleid param. This param is required.
 func (p *CreateLBStickinessPolicyParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *CreateLBStickinessPolicyParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *CreateLBStickinessPolicyParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	return p.lbruleid.v, p.lbruleid.ok
 }
 
+// SetMethodname sets the methodname param. This param is required.
 func (p *CreateLBStickinessPolicyParams) SetMethodname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["methodname"] = v
+	p.methodname = optString{v: v, ok: true}
 }
 
+// ResetMethodname unsets the methodname param
 func (p *CreateLBStickinessPolicyParams) ResetMethodname() {
-	if p.p != nil && p.p["methodname"] != nil {
-		delete(p.p, "methodname")
-	}
+	p.methodname = optString{}
 }
 
+// GetMethodname returns the methodname param and if it is set
 func (p *CreateLBStickinessPolicyParams) GetMethodname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["methodname"].(string)
-	return value, ok
+	return p.methodname.v, p.methodname.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateLBStickinessPolicyParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateLBStickinessPolicyParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateLBStickinessPolicyParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetParam sets the param param.
 func (p *CreateLBStickinessPolicyParams) SetParam(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["param"] = v
+	p.param = optStringMap{v: v, ok: true}
 }
 
+// ResetParam unsets the param param
 func (p *CreateLBStickinessPolicyParams) ResetParam() {
-	if p.p != nil && p.p["param"] != nil {
-		delete(p.p, "param")
-	}
+	p.param = optStringMap{}
 }
 
+// GetParam returns the param param and if it is set
 func (p *CreateLBStickinessPolicyParams) GetParam() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["param"].(map[string]string)
-	return value, ok
+	return p.param.v, p.param.ok
 }
 
-// You should always use this function to get a new CreateLBStickinessPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
-	p := &CreateLBStickinessPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
-	p.p["methodname"] = methodname
-	p.p["name"] = name
-	return p
+// Clone returns a deep copy of the params
+func (p *CreateLBStickinessPolicyParams) Clone() *CreateLBStickinessPolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.param = p.param.clone()
+	return &c
 }
 
-// Creates a load balancer stickiness policy
-func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBStickinessPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateLBStickinessPolicyParams) Equal(o *CreateLBStickinessPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
+	return p.description == o.description &&
+		p.fordisplay == o.fordisplay &&
+		p.lbruleid == o.lbruleid &&
+		p.methodname == o.methodname &&
+		p.name == o.name &&
+		p.param.equal(o.param)
+}
 
-	var r CreateLBStickinessPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+// serializedCreateLBStickinessPolicyParams is used to (un)marshal CreateLBStickinessPolicyParams using the API param names
+type serializedCreateLBStickinessPolicyParams struct {
+	Description *string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay  *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Lbruleid    *string            `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Methodname  *string            `json:"methodname,omitempty" yaml:"methodname,omitempty"`
+	Name        *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Param       *map[string]string `json:"param,omitempty" yaml:"param,omitempty"`
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
+func (p *CreateLBStickinessPolicyParams) toSerialized() *serializedCreateLBStickinessPolicyParams {
+	s := &serializedCreateLBStickinessPolicyParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	if p.methodname.ok {
+		s.Methodname = &p.methodname.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.param.ok {
+		s.Param = &p.param.v
+	}
+	return s
+}
+
+func (p *CreateLBStickinessPolicyParams) fromSerialized(s *serializedCreateLBStickinessPolicyParams) {
+	*p = CreateLBStickinessPolicyParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
+	}
+	if s.Methodname != nil {
+		p.SetMethodname(*s.Methodname)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Param != nil {
+		p.SetParam(*s.Param)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateLBStickinessPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateLBStickinessPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateLBStickinessPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateLBStickinessPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateLBStickinessPolicyParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
+	p := &CreateLBStickinessPolicyParams{}
+	p.SetLbruleid(lbruleid)
+	p.SetMethodname(methodname)
+	p.SetName(name)
+	return p
+}
+
+// Creates a load balancer stickiness policy.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: lbruleid, methodname,
+// name.
+func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams, opts ...CallOption) (*CreateLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequest("createLBStickinessPolicy", s.cs.encodeParams("createLBStickinessPolicy", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
 			}
@@ -1813,278 +2724,422 @@ type CreateLBStickinessPolicyResponseStickinesspolicy struct {
 }
 
 type CreateLoadBalancerParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateLoadBalancerParams) toURLValues() url.Values {
+	algorithm                optString
+	description              optString
+	fordisplay               optBool
+	instanceport             optInt
+	name                     optString
+	networkid                optString
+	scheme                   optString
+	sourceipaddress          optString
+	sourceipaddressnetworkid optString
+	sourceport               optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
+	if p.algorithm.ok {
+		u.Set("algorithm", p.algorithm.v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["instanceport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("instanceport", vv)
+	if p.instanceport.ok {
+		u.Set("instanceport", strconv.Itoa(p.instanceport.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["scheme"]; found {
-		u.Set("scheme", v.(string))
+	if p.scheme.ok {
+		u.Set("scheme", p.scheme.v)
 	}
-	if v, found := p.p["sourceipaddress"]; found {
-		u.Set("sourceipaddress", v.(string))
+	if p.sourceipaddress.ok {
+		u.Set("sourceipaddress", p.sourceipaddress.v)
 	}
-	if v, found := p.p["sourceipaddressnetworkid"]; found {
-		u.Set("sourceipaddressnetworkid", v.(string))
+	if p.sourceipaddressnetworkid.ok {
+		u.Set("sourceipaddressnetworkid", p.sourceipaddressnetworkid.v)
 	}
-	if v, found := p.p["sourceport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sourceport", vv)
+	if p.sourceport.ok {
+		u.Set("sourceport", strconv.Itoa(p.sourceport.v))
 	}
 	return u
 }
 
-func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new CreateLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateLoadBalancerParams(u url.Values) (*CreateLoadBalancerParams, error) {
+	p := &CreateLoadBalancerParams{}
+	if err := checkParamNames("createLoadBalancer", u, "algorithm", "description", "fordisplay", "instanceport", "name", "networkid", "scheme", "sourceipaddress", "sourceipaddressnetworkid", "sourceport"); err != nil {
+		return nil, err
+	}
+	if _, found := u["algorithm"]; found {
+		p.SetAlgorithm(u.Get("algorithm"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["instanceport"]; found {
+		v, err := strconv.Atoi(u.Get("instanceport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param instanceport: %v", err)
+		}
+		p.SetInstanceport(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
 	}
-	p.p["algorithm"] = v
+	if _, found := u["scheme"]; found {
+		p.SetScheme(u.Get("scheme"))
+	}
+	if _, found := u["sourceipaddress"]; found {
+		p.SetSourceipaddress(u.Get("sourceipaddress"))
+	}
+	if _, found := u["sourceipaddressnetworkid"]; found {
+		p.SetSourceipaddressnetworkid(u.Get("sourceipaddressnetworkid"))
+	}
+	if _, found := u["sourceport"]; found {
+		v, err := strconv.Atoi(u.Get("sourceport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sourceport: %v", err)
+		}
+		p.SetSourceport(v)
+	}
+	return p, nil
 }
 
+// SetAlgorithm sets the algorithm param. This param is required.
+func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
+	p.algorithm = optString{v: v, ok: true}
+}
+
+// ResetAlgorithm unsets the algorithm param
 func (p *CreateLoadBalancerParams) ResetAlgorithm() {
-	if p.p != nil && p.p["algorithm"] != nil {
-		delete(p.p, "algorithm")
-	}
+	p.algorithm = optString{}
 }
 
+// GetAlgorithm returns the algorithm param and if it is set
 func (p *CreateLoadBalancerParams) GetAlgorithm() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["algorithm"].(string)
-	return value, ok
+	return p.algorithm.v, p.algorithm.ok
 }
 
+// SetDescription sets the description param.
 func (p *CreateLoadBalancerParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateLoadBalancerParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateLoadBalancerParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateLoadBalancerParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateLoadBalancerParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateLoadBalancerParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetInstanceport sets the instanceport param. This param is required.
 func (p *CreateLoadBalancerParams) SetInstanceport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["instanceport"] = v
+	p.instanceport = optInt{v: v, ok: true}
 }
 
+// ResetInstanceport unsets the instanceport param
 func (p *CreateLoadBalancerParams) ResetInstanceport() {
-	if p.p != nil && p.p["instanceport"] != nil {
-		delete(p.p, "instanceport")
-	}
+	p.instanceport = optInt{}
 }
 
+// GetInstanceport returns the instanceport param and if it is set
 func (p *CreateLoadBalancerParams) GetInstanceport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["instanceport"].(int)
-	return value, ok
+	return p.instanceport.v, p.instanceport.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateLoadBalancerParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateLoadBalancerParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateLoadBalancerParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param. This param is required.
 func (p *CreateLoadBalancerParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateLoadBalancerParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateLoadBalancerParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetScheme sets the scheme param. This param is required.
 func (p *CreateLoadBalancerParams) SetScheme(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scheme"] = v
+	p.scheme = optString{v: v, ok: true}
 }
 
+// ResetScheme unsets the scheme param
 func (p *CreateLoadBalancerParams) ResetScheme() {
-	if p.p != nil && p.p["scheme"] != nil {
-		delete(p.p, "scheme")
-	}
+	p.scheme = optString{}
 }
 
+// GetScheme returns the scheme param and if it is set
 func (p *CreateLoadBalancerParams) GetScheme() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scheme"].(string)
-	return value, ok
+	return p.scheme.v, p.scheme.ok
 }
 
+// SetSourceipaddress sets the sourceipaddress param.
 func (p *CreateLoadBalancerParams) SetSourceipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceipaddress"] = v
+	p.sourceipaddress = optString{v: v, ok: true}
 }
 
+// ResetSourceipaddress unsets the sourceipaddress param
 func (p *CreateLoadBalancerParams) ResetSourceipaddress() {
-	if p.p != nil && p.p["sourceipaddress"] != nil {
-		delete(p.p, "sourceipaddress")
-	}
+	p.sourceipaddress = optString{}
 }
 
+// GetSourceipaddress returns the sourceipaddress param and if it is set
 func (p *CreateLoadBalancerParams) GetSourceipaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourceipaddress"].(string)
-	return value, ok
+	return p.sourceipaddress.v, p.sourceipaddress.ok
 }
 
+// SetSourceipaddressnetworkid sets the sourceipaddressnetworkid param. This param is required.
 func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceipaddressnetworkid"] = v
+	p.sourceipaddressnetworkid = optString{v: v, ok: true}
 }
 
+// ResetSourceipaddressnetworkid unsets the sourceipaddressnetworkid param
 func (p *CreateLoadBalancerParams) ResetSourceipaddressnetworkid() {
-	if p.p != nil && p.p["sourceipaddressnetworkid"] != nil {
-		delete(p.p, "sourceipaddressnetworkid")
-	}
+	p.sourceipaddressnetworkid = optString{}
 }
 
+// GetSourceipaddressnetworkid returns the sourceipaddressnetworkid param and if it is set
 func (p *CreateLoadBalancerParams) GetSourceipaddressnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourceipaddressnetworkid"].(string)
-	return value, ok
+	return p.sourceipaddressnetworkid.v, p.sourceipaddressnetworkid.ok
 }
 
+// SetSourceport sets the sourceport param. This param is required.
 func (p *CreateLoadBalancerParams) SetSourceport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceport"] = v
+	p.sourceport = optInt{v: v, ok: true}
 }
 
+// ResetSourceport unsets the sourceport param
 func (p *CreateLoadBalancerParams) ResetSourceport() {
-	if p.p != nil && p.p["sourceport"] != nil {
-		delete(p.p, "sourceport")
-	}
+	p.sourceport = optInt{}
 }
 
+// GetSourceport returns the sourceport param and if it is set
 func (p *CreateLoadBalancerParams) GetSourceport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.sourceport.v, p.sourceport.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateLoadBalancerParams) Clone() *CreateLoadBalancerParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["sourceport"].(int)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateLoadBalancerParams) Equal(o *CreateLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.algorithm == o.algorithm &&
+		p.description == o.description &&
+		p.fordisplay == o.fordisplay &&
+		p.instanceport == o.instanceport &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.scheme == o.scheme &&
+		p.sourceipaddress == o.sourceipaddress &&
+		p.sourceipaddressnetworkid == o.sourceipaddressnetworkid &&
+		p.sourceport == o.sourceport
+}
+
+// serializedCreateLoadBalancerParams is used to (un)marshal CreateLoadBalancerParams using the API param names
+type serializedCreateLoadBalancerParams struct {
+	Algorithm                *string `json:"algorithm,omitempty" yaml:"algorithm,omitempty"`
+	Description              *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay               *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Instanceport             *int    `json:"instanceport,omitempty" yaml:"instanceport,omitempty"`
+	Name                     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid                *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Scheme                   *string `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Sourceipaddress          *string `json:"sourceipaddress,omitempty" yaml:"sourceipaddress,omitempty"`
+	Sourceipaddressnetworkid *string `json:"sourceipaddressnetworkid,omitempty" yaml:"sourceipaddressnetworkid,omitempty"`
+	Sourceport               *int    `json:"sourceport,omitempty" yaml:"sourceport,omitempty"`
+}
+
+func (p *CreateLoadBalancerParams) toSerialized() *serializedCreateLoadBalancerParams {
+	s := &serializedCreateLoadBalancerParams{}
+	if p.algorithm.ok {
+		s.Algorithm = &p.algorithm.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.instanceport.ok {
+		s.Instanceport = &p.instanceport.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.scheme.ok {
+		s.Scheme = &p.scheme.v
+	}
+	if p.sourceipaddress.ok {
+		s.Sourceipaddress = &p.sourceipaddress.v
+	}
+	if p.sourceipaddressnetworkid.ok {
+		s.Sourceipaddressnetworkid = &p.sourceipaddressnetworkid.v
+	}
+	if p.sourceport.ok {
+		s.Sourceport = &p.sourceport.v
+	}
+	return s
+}
+
+func (p *CreateLoadBalancerParams) fromSerialized(s *serializedCreateLoadBalancerParams) {
+	*p = CreateLoadBalancerParams{}
+	if s.Algorithm != nil {
+		p.SetAlgorithm(*s.Algorithm)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Instanceport != nil {
+		p.SetInstanceport(*s.Instanceport)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Scheme != nil {
+		p.SetScheme(*s.Scheme)
+	}
+	if s.Sourceipaddress != nil {
+		p.SetSourceipaddress(*s.Sourceipaddress)
+	}
+	if s.Sourceipaddressnetworkid != nil {
+		p.SetSourceipaddressnetworkid(*s.Sourceipaddressnetworkid)
+	}
+	if s.Sourceport != nil {
+		p.SetSourceport(*s.Sourceport)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams {
 	p := &CreateLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["algorithm"] = algorithm
-	p.p["instanceport"] = instanceport
-	p.p["name"] = name
-	p.p["networkid"] = networkid
-	p.p["scheme"] = scheme
-	p.p["sourceipaddressnetworkid"] = sourceipaddressnetworkid
-	p.p["sourceport"] = sourceport
+	p.SetAlgorithm(algorithm)
+	p.SetInstanceport(instanceport)
+	p.SetName(name)
+	p.SetNetworkid(networkid)
+	p.SetScheme(scheme)
+	p.SetSourceipaddressnetworkid(sourceipaddressnetworkid)
+	p.SetSourceport(sourceport)
 	return p
 }
 
-// Creates an internal load balancer
-func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancer", p.toURLValues())
+// Creates an internal load balancer.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: algorithm, instanceport,
+// name, networkid, scheme, sourceipaddressnetworkid, sourceport.
+func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams, opts ...CallOption) (*CreateLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("createLoadBalancer", s.cs.encodeParams("createLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2095,8 +3150,8 @@ func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2152,373 +3207,544 @@ type CreateLoadBalancerResponseLoadbalancerinstance struct {
 }
 
 type CreateLoadBalancerRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
+	account      optString
+	algorithm    optString
+	cidrlist     optStrings
+	description  optString
+	domainid     optString
+	fordisplay   optBool
+	name         optString
+	networkid    optString
+	openfirewall optBool
+	privateport  optInt
+	protocol     optString
+	publicipid   optString
+	publicport   optInt
+	zoneid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
+	if p.algorithm.ok {
+		u.Set("algorithm", p.algorithm.v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
+	if p.openfirewall.ok {
+		u.Set("openfirewall", strconv.FormatBool(p.openfirewall.v))
 	}
-	if v, found := p.p["privateport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateport", vv)
+	if p.privateport.ok {
+		u.Set("privateport", strconv.Itoa(p.privateport.v))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if p.publicipid.ok {
+		u.Set("publicipid", p.publicipid.v)
 	}
-	if v, found := p.p["publicport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicport", vv)
+	if p.publicport.ok {
+		u.Set("publicport", strconv.Itoa(p.publicport.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateLoadBalancerRuleParams(u url.Values) (*CreateLoadBalancerRuleParams, error) {
+	p := &CreateLoadBalancerRuleParams{}
+	if err := checkParamNames("createLoadBalancerRule", u, "account", "algorithm", "cidrlist", "description", "domainid", "fordisplay", "name", "networkid", "openfirewall", "privateport", "protocol", "publicipid", "publicport", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["algorithm"]; found {
+		p.SetAlgorithm(u.Get("algorithm"))
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["openfirewall"]; found {
+		v, err := strconv.ParseBool(u.Get("openfirewall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param openfirewall: %v", err)
+		}
+		p.SetOpenfirewall(v)
+	}
+	if _, found := u["privateport"]; found {
+		v, err := strconv.Atoi(u.Get("privateport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param privateport: %v", err)
+		}
+		p.SetPrivateport(v)
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["publicipid"]; found {
+		p.SetPublicipid(u.Get("publicipid"))
+	}
+	if _, found := u["publicport"]; found {
+		v, err := strconv.Atoi(u.Get("publicport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param publicport: %v", err)
+		}
+		p.SetPublicport(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateLoadBalancerRuleParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAlgorithm sets the algorithm param. This param is required.
 func (p *CreateLoadBalancerRuleParams) SetAlgorithm(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["algorithm"] = v
+	p.algorithm = optString{v: v, ok: true}
 }
 
+// ResetAlgorithm unsets the algorithm param
 func (p *CreateLoadBalancerRuleParams) ResetAlgorithm() {
-	if p.p != nil && p.p["algorithm"] != nil {
-		delete(p.p, "algorithm")
-	}
+	p.algorithm = optString{}
 }
 
+// GetAlgorithm returns the algorithm param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetAlgorithm() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["algorithm"].(string)
-	return value, ok
+	return p.algorithm.v, p.algorithm.ok
 }
 
+// SetCidrlist sets the cidrlist param.
 func (p *CreateLoadBalancerRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *CreateLoadBalancerRuleParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetDescription sets the description param.
 func (p *CreateLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateLoadBalancerRuleParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateLoadBalancerRuleParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateLoadBalancerRuleParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateLoadBalancerRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateLoadBalancerRuleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateLoadBalancerRuleParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *CreateLoadBalancerRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateLoadBalancerRuleParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetOpenfirewall sets the openfirewall param.
 func (p *CreateLoadBalancerRuleParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["openfirewall"] = v
+	p.openfirewall = optBool{v: v, ok: true}
 }
 
+// ResetOpenfirewall unsets the openfirewall param
 func (p *CreateLoadBalancerRuleParams) ResetOpenfirewall() {
-	if p.p != nil && p.p["openfirewall"] != nil {
-		delete(p.p, "openfirewall")
-	}
+	p.openfirewall = optBool{}
 }
 
+// GetOpenfirewall returns the openfirewall param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetOpenfirewall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["openfirewall"].(bool)
-	return value, ok
+	return p.openfirewall.v, p.openfirewall.ok
 }
 
+// SetPrivateport sets the privateport param. This param is required.
 func (p *CreateLoadBalancerRuleParams) SetPrivateport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateport"] = v
+	p.privateport = optInt{v: v, ok: true}
 }
 
+// ResetPrivateport unsets the privateport param
 func (p *CreateLoadBalancerRuleParams) ResetPrivateport() {
-	if p.p != nil && p.p["privateport"] != nil {
-		delete(p.p, "privateport")
-	}
+	p.privateport = optInt{}
 }
 
+// GetPrivateport returns the privateport param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetPrivateport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["privateport"].(int)
-	return value, ok
+	return p.privateport.v, p.privateport.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *CreateLoadBalancerRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *CreateLoadBalancerRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetPublicipid sets the publicipid param.
 func (p *CreateLoadBalancerRuleParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicipid"] = v
+	p.publicipid = optString{v: v, ok: true}
 }
 
+// ResetPublicipid unsets the publicipid param
 func (p *CreateLoadBalancerRuleParams) ResetPublicipid() {
-	if p.p != nil && p.p["publicipid"] != nil {
-		delete(p.p, "publicipid")
-	}
+	p.publicipid = optString{}
 }
 
+// GetPublicipid returns the publicipid param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetPublicipid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicipid"].(string)
-	return value, ok
+	return p.publicipid.v, p.publicipid.ok
 }
 
+// SetPublicport sets the publicport param. This param is required.
 func (p *CreateLoadBalancerRuleParams) SetPublicport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicport"] = v
+	p.publicport = optInt{v: v, ok: true}
 }
 
+// ResetPublicport unsets the publicport param
 func (p *CreateLoadBalancerRuleParams) ResetPublicport() {
-	if p.p != nil && p.p["publicport"] != nil {
-		delete(p.p, "publicport")
-	}
+	p.publicport = optInt{}
 }
 
+// GetPublicport returns the publicport param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetPublicport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicport"].(int)
-	return value, ok
+	return p.publicport.v, p.publicport.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateLoadBalancerRuleParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateLoadBalancerRuleParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateLoadBalancerRuleParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateLoadBalancerRuleParams) Clone() *CreateLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateLoadBalancerRuleParams) Equal(o *CreateLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.algorithm == o.algorithm &&
+		p.cidrlist.equal(o.cidrlist) &&
+		p.description == o.description &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.openfirewall == o.openfirewall &&
+		p.privateport == o.privateport &&
+		p.protocol == o.protocol &&
+		p.publicipid == o.publicipid &&
+		p.publicport == o.publicport &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateLoadBalancerRuleParams is used to (un)marshal CreateLoadBalancerRuleParams using the API param names
+type serializedCreateLoadBalancerRuleParams struct {
+	Account      *string   `json:"account,omitempty" yaml:"account,omitempty"`
+	Algorithm    *string   `json:"algorithm,omitempty" yaml:"algorithm,omitempty"`
+	Cidrlist     *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Description  *string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid     *string   `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay   *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Name         *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid    *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Openfirewall *bool     `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Privateport  *int      `json:"privateport,omitempty" yaml:"privateport,omitempty"`
+	Protocol     *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Publicipid   *string   `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+	Publicport   *int      `json:"publicport,omitempty" yaml:"publicport,omitempty"`
+	Zoneid       *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateLoadBalancerRuleParams) toSerialized() *serializedCreateLoadBalancerRuleParams {
+	s := &serializedCreateLoadBalancerRuleParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.algorithm.ok {
+		s.Algorithm = &p.algorithm.v
+	}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.openfirewall.ok {
+		s.Openfirewall = &p.openfirewall.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.privateport.ok {
+		s.Privateport = &p.privateport.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.publicipid.ok {
+		s.Publicipid = &p.publicipid.v
+	}
+	if p.publicport.ok {
+		s.Publicport = &p.publicport.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateLoadBalancerRuleParams) fromSerialized(s *serializedCreateLoadBalancerRuleParams) {
+	*p = CreateLoadBalancerRuleParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Algorithm != nil {
+		p.SetAlgorithm(*s.Algorithm)
+	}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Openfirewall != nil {
+		p.SetOpenfirewall(*s.Openfirewall)
+	}
+	if s.Privateport != nil {
+		p.SetPrivateport(*s.Privateport)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Publicipid != nil {
+		p.SetPublicipid(*s.Publicipid)
+	}
+	if s.Publicport != nil {
+		p.SetPublicport(*s.Publicport)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams {
 	p := &CreateLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["algorithm"] = algorithm
-	p.p["name"] = name
-	p.p["privateport"] = privateport
-	p.p["publicport"] = publicport
+	p.SetAlgorithm(algorithm)
+	p.SetName(name)
+	p.SetPrivateport(privateport)
+	p.SetPublicport(publicport)
 	return p
 }
 
-// Creates a load balancer rule
-func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancerRule", p.toURLValues())
+// Creates a load balancer rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: algorithm, name,
+// privateport, publicport.
+func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams, opts ...CallOption) (*CreateLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("createLoadBalancerRule", s.cs.encodeParams("createLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2529,8 +3755,8 @@ func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2578,53 +3804,133 @@ type CreateLoadBalancerRuleResponse struct {
 }
 
 type DeleteGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteGlobalLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteGlobalLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteGlobalLoadBalancerRuleParams(u url.Values) (*DeleteGlobalLoadBalancerRuleParams, error) {
+	p := &DeleteGlobalLoadBalancerRuleParams{}
+	if err := checkParamNames("deleteGlobalLoadBalancerRule", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteGlobalLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteGlobalLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteGlobalLoadBalancerRuleParams) Clone() *DeleteGlobalLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteGlobalLoadBalancerRuleParams) Equal(o *DeleteGlobalLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteGlobalLoadBalancerRuleParams is used to (un)marshal DeleteGlobalLoadBalancerRuleParams using the API param names
+type serializedDeleteGlobalLoadBalancerRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) toSerialized() *serializedDeleteGlobalLoadBalancerRuleParams {
+	s := &serializedDeleteGlobalLoadBalancerRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) fromSerialized(s *serializedDeleteGlobalLoadBalancerRuleParams) {
+	*p = DeleteGlobalLoadBalancerRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteGlobalLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteGlobalLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteGlobalLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteGlobalLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams {
 	p := &DeleteGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a global load balancer rule.
-func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteGlobalLoadBalancerRule", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams, opts ...CallOption) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("deleteGlobalLoadBalancerRule", s.cs.encodeParams("deleteGlobalLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2635,8 +3941,8 @@ func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2660,53 +3966,133 @@ type DeleteGlobalLoadBalancerRuleResponse struct {
 }
 
 type DeleteLBHealthCheckPolicyParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteLBHealthCheckPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteLBHealthCheckPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteLBHealthCheckPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteLBHealthCheckPolicyParams(u url.Values) (*DeleteLBHealthCheckPolicyParams, error) {
+	p := &DeleteLBHealthCheckPolicyParams{}
+	if err := checkParamNames("deleteLBHealthCheckPolicy", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteLBHealthCheckPolicyParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteLBHealthCheckPolicyParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteLBHealthCheckPolicyParams) Clone() *DeleteLBHealthCheckPolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteLBHealthCheckPolicyParams) Equal(o *DeleteLBHealthCheckPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteLBHealthCheckPolicyParams is used to (un)marshal DeleteLBHealthCheckPolicyParams using the API param names
+type serializedDeleteLBHealthCheckPolicyParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) toSerialized() *serializedDeleteLBHealthCheckPolicyParams {
+	s := &serializedDeleteLBHealthCheckPolicyParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) fromSerialized(s *serializedDeleteLBHealthCheckPolicyParams) {
+	*p = DeleteLBHealthCheckPolicyParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteLBHealthCheckPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteLBHealthCheckPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteLBHealthCheckPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteLBHealthCheckPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteLBHealthCheckPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams {
 	p := &DeleteLBHealthCheckPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a load balancer health check policy.
-func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBHealthCheckPolicy", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams, opts ...CallOption) (*DeleteLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequest("deleteLBHealthCheckPolicy", s.cs.encodeParams("deleteLBHealthCheckPolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2717,8 +4103,8 @@ func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPo
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2742,53 +4128,133 @@ type DeleteLBHealthCheckPolicyResponse struct {
 }
 
 type DeleteLBStickinessPolicyParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteLBStickinessPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteLBStickinessPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteLBStickinessPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteLBStickinessPolicyParams(u url.Values) (*DeleteLBStickinessPolicyParams, error) {
+	p := &DeleteLBStickinessPolicyParams{}
+	if err := checkParamNames("deleteLBStickinessPolicy", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteLBStickinessPolicyParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteLBStickinessPolicyParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteLBStickinessPolicyParams) Clone() *DeleteLBStickinessPolicyParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteLBStickinessPolicyParams) Equal(o *DeleteLBStickinessPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteLBStickinessPolicyParams is used to (un)marshal DeleteLBStickinessPolicyParams using the API param names
+type serializedDeleteLBStickinessPolicyParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteLBStickinessPolicyParams) toSerialized() *serializedDeleteLBStickinessPolicyParams {
+	s := &serializedDeleteLBStickinessPolicyParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteLBStickinessPolicyParams) fromSerialized(s *serializedDeleteLBStickinessPolicyParams) {
+	*p = DeleteLBStickinessPolicyParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteLBStickinessPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteLBStickinessPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteLBStickinessPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteLBStickinessPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteLBStickinessPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams {
 	p := &DeleteLBStickinessPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a load balancer stickiness policy.
-func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBStickinessPolicy", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams, opts ...CallOption) (*DeleteLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequest("deleteLBStickinessPolicy", s.cs.encodeParams("deleteLBStickinessPolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2799,8 +4265,8 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2824,53 +4290,133 @@ type DeleteLBStickinessPolicyResponse struct {
 }
 
 type DeleteLoadBalancerParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteLoadBalancerParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteLoadBalancerParams(u url.Values) (*DeleteLoadBalancerParams, error) {
+	p := &DeleteLoadBalancerParams{}
+	if err := checkParamNames("deleteLoadBalancer", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteLoadBalancerParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteLoadBalancerParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteLoadBalancerParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteLoadBalancerParams) Clone() *DeleteLoadBalancerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteLoadBalancerParams) Equal(o *DeleteLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id == o.id
+}
+
+// serializedDeleteLoadBalancerParams is used to (un)marshal DeleteLoadBalancerParams using the API param names
+type serializedDeleteLoadBalancerParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteLoadBalancerParams) toSerialized() *serializedDeleteLoadBalancerParams {
+	s := &serializedDeleteLoadBalancerParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteLoadBalancerParams) fromSerialized(s *serializedDeleteLoadBalancerParams) {
+	*p = DeleteLoadBalancerParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams {
 	p := &DeleteLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes an internal load balancer
-func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancer", p.toURLValues())
+// Deletes an internal load balancer.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams, opts ...CallOption) (*DeleteLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("deleteLoadBalancer", s.cs.encodeParams("deleteLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2881,8 +4427,8 @@ func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2906,53 +4452,133 @@ type DeleteLoadBalancerResponse struct {
 }
 
 type DeleteLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteLoadBalancerRuleParams(u url.Values) (*DeleteLoadBalancerRuleParams, error) {
+	p := &DeleteLoadBalancerRuleParams{}
+	if err := checkParamNames("deleteLoadBalancerRule", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteLoadBalancerRuleParams) Clone() *DeleteLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteLoadBalancerRuleParams) Equal(o *DeleteLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteLoadBalancerRuleParams is used to (un)marshal DeleteLoadBalancerRuleParams using the API param names
+type serializedDeleteLoadBalancerRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteLoadBalancerRuleParams) toSerialized() *serializedDeleteLoadBalancerRuleParams {
+	s := &serializedDeleteLoadBalancerRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteLoadBalancerRuleParams) fromSerialized(s *serializedDeleteLoadBalancerRuleParams) {
+	*p = DeleteLoadBalancerRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams {
 	p := &DeleteLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a load balancer rule.
-func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancerRule", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams, opts ...CallOption) (*DeleteLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("deleteLoadBalancerRule", s.cs.encodeParams("deleteLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2963,8 +4589,8 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2977,64 +4603,144 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 		}
 	}
 
-	return &r, nil
+	return &r, nil
+}
+
+type DeleteLoadBalancerRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteNetscalerLoadBalancerParams struct {
+	lbdeviceid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNetscalerLoadBalancerParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.lbdeviceid.ok {
+		u.Set("lbdeviceid", p.lbdeviceid.v)
+	}
+	return u
+}
+
+// ParseDeleteNetscalerLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNetscalerLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNetscalerLoadBalancerParams(u url.Values) (*DeleteNetscalerLoadBalancerParams, error) {
+	p := &DeleteNetscalerLoadBalancerParams{}
+	if err := checkParamNames("deleteNetscalerLoadBalancer", u, "lbdeviceid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["lbdeviceid"]; found {
+		p.SetLbdeviceid(u.Get("lbdeviceid"))
+	}
+	return p, nil
+}
+
+// SetLbdeviceid sets the lbdeviceid param. This param is required.
+func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
+	p.lbdeviceid = optString{v: v, ok: true}
+}
+
+// ResetLbdeviceid unsets the lbdeviceid param
+func (p *DeleteNetscalerLoadBalancerParams) ResetLbdeviceid() {
+	p.lbdeviceid = optString{}
 }
 
-type DeleteLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+// GetLbdeviceid returns the lbdeviceid param and if it is set
+func (p *DeleteNetscalerLoadBalancerParams) GetLbdeviceid() (string, bool) {
+	return p.lbdeviceid.v, p.lbdeviceid.ok
 }
 
-type DeleteNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
+// Clone returns a deep copy of the params
+func (p *DeleteNetscalerLoadBalancerParams) Clone() *DeleteNetscalerLoadBalancerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNetscalerLoadBalancerParams) Equal(o *DeleteNetscalerLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
+	return p.lbdeviceid == o.lbdeviceid
+}
+
+// serializedDeleteNetscalerLoadBalancerParams is used to (un)marshal DeleteNetscalerLoadBalancerParams using the API param names
+type serializedDeleteNetscalerLoadBalancerParams struct {
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+}
+
+func (p *DeleteNetscalerLoadBalancerParams) toSerialized() *serializedDeleteNetscalerLoadBalancerParams {
+	s := &serializedDeleteNetscalerLoadBalancerParams{}
+	if p.lbdeviceid.ok {
+		s.Lbdeviceid = &p.lbdeviceid.v
 	}
-	return u
+	return s
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeleteNetscalerLoadBalancerParams) fromSerialized(s *serializedDeleteNetscalerLoadBalancerParams) {
+	*p = DeleteNetscalerLoadBalancerParams{}
+	if s.Lbdeviceid != nil {
+		p.SetLbdeviceid(*s.Lbdeviceid)
 	}
-	p.p["lbdeviceid"] = v
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) ResetLbdeviceid() {
-	if p.p != nil && p.p["lbdeviceid"] != nil {
-		delete(p.p, "lbdeviceid")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNetscalerLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) GetLbdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNetscalerLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNetscalerLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNetscalerLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["lbdeviceid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNetscalerLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams {
 	p := &DeleteNetscalerLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// delete a netscaler load balancer device
-func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetscalerLoadBalancer", p.toURLValues())
+// delete a netscaler load balancer device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: lbdeviceid.
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams, opts ...CallOption) (*DeleteNetscalerLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("deleteNetscalerLoadBalancer", s.cs.encodeParams("deleteNetscalerLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3045,8 +4751,8 @@ func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoad
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3070,53 +4776,132 @@ type DeleteNetscalerLoadBalancerResponse struct {
 }
 
 type DeleteSslCertParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteSslCertParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteSslCertParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteSslCertParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteSslCertParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteSslCertParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteSslCertParams(u url.Values) (*DeleteSslCertParams, error) {
+	p := &DeleteSslCertParams{}
+	if err := checkParamNames("deleteSslCert", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteSslCertParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteSslCertParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteSslCertParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteSslCertParams) Clone() *DeleteSslCertParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteSslCertParams) Equal(o *DeleteSslCertParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteSslCertParams is used to (un)marshal DeleteSslCertParams using the API param names
+type serializedDeleteSslCertParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteSslCertParams) toSerialized() *serializedDeleteSslCertParams {
+	s := &serializedDeleteSslCertParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteSslCertParams) fromSerialized(s *serializedDeleteSslCertParams) {
+	*p = DeleteSslCertParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteSslCertParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteSslCertParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteSslCertParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteSslCertParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteSslCertParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteSslCertParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteSslCertParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertParams {
 	p := &DeleteSslCertParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Delete a certificate to CloudStack
-func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
-	resp, err := s.cs.newRequest("deleteSslCert", p.toURLValues())
+// Delete a certificate to CloudStack.
+//
+// Required params: id.
+func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams, opts ...CallOption) (*DeleteSslCertResponse, error) {
+	resp, err := s.cs.newRequest("deleteSslCert", s.cs.encodeParams("deleteSslCert", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3164,51 +4949,57 @@ func (r *DeleteSslCertResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListGlobalLoadBalancerRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	regionid    optInt
+	tags        optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListGlobalLoadBalancerRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
+	if p.regionid.ok {
+		u.Set("regionid", strconv.Itoa(p.regionid.v))
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
@@ -3217,251 +5008,399 @@ func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListGlobalLoadBalancerRulesParams parses url.Values, for example taken from a raw API request,
+// into a new ListGlobalLoadBalancerRulesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListGlobalLoadBalancerRulesParams(u url.Values) (*ListGlobalLoadBalancerRulesParams, error) {
+	p := &ListGlobalLoadBalancerRulesParams{}
+	if err := checkParamNames("listGlobalLoadBalancerRules", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "regionid", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["regionid"]; found {
+		v, err := strconv.Atoi(u.Get("regionid"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param regionid: %v", err)
+		}
+		p.SetRegionid(v)
 	}
-	p.p["account"] = v
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListGlobalLoadBalancerRulesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListGlobalLoadBalancerRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListGlobalLoadBalancerRulesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListGlobalLoadBalancerRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListGlobalLoadBalancerRulesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListGlobalLoadBalancerRulesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListGlobalLoadBalancerRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListGlobalLoadBalancerRulesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListGlobalLoadBalancerRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListGlobalLoadBalancerRulesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListGlobalLoadBalancerRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListGlobalLoadBalancerRulesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListGlobalLoadBalancerRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListGlobalLoadBalancerRulesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListGlobalLoadBalancerRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListGlobalLoadBalancerRulesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRegionid sets the regionid param.
 func (p *ListGlobalLoadBalancerRulesParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
+	p.regionid = optInt{v: v, ok: true}
 }
 
+// ResetRegionid unsets the regionid param
 func (p *ListGlobalLoadBalancerRulesParams) ResetRegionid() {
-	if p.p != nil && p.p["regionid"] != nil {
-		delete(p.p, "regionid")
-	}
+	p.regionid = optInt{}
 }
 
+// GetRegionid returns the regionid param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetRegionid() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["regionid"].(int)
-	return value, ok
+	return p.regionid.v, p.regionid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListGlobalLoadBalancerRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListGlobalLoadBalancerRulesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListGlobalLoadBalancerRulesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListGlobalLoadBalancerRulesParams) Clone() *ListGlobalLoadBalancerRulesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListGlobalLoadBalancerRulesParams) Equal(o *ListGlobalLoadBalancerRulesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.regionid == o.regionid &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListGlobalLoadBalancerRulesParams is used to (un)marshal ListGlobalLoadBalancerRulesParams using the API param names
+type serializedListGlobalLoadBalancerRulesParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Regionid    *int               `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) toSerialized() *serializedListGlobalLoadBalancerRulesParams {
+	s := &serializedListGlobalLoadBalancerRulesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.regionid.ok {
+		s.Regionid = &p.regionid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) fromSerialized(s *serializedListGlobalLoadBalancerRulesParams) {
+	*p = ListGlobalLoadBalancerRulesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Regionid != nil {
+		p.SetRegionid(*s.Regionid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListGlobalLoadBalancerRulesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListGlobalLoadBalancerRulesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListGlobalLoadBalancerRulesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListGlobalLoadBalancerRulesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListGlobalLoadBalancerRulesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListGlobalLoadBalancerRulesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListGlobalLoadBalancerRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams {
 	p := &ListGlobalLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListGlobalLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3509,9 +5448,8 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByName(name string, opts
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
 	p := &ListGlobalLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3540,8 +5478,8 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...O
 }
 
 // Lists load balancer rules.
-func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listGlobalLoadBalancerRules", p.toURLValues())
+func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams, opts ...CallOption) (*ListGlobalLoadBalancerRulesResponse, error) {
+	resp, err := s.cs.newRequest("listGlobalLoadBalancerRules", s.cs.encodeParams("listGlobalLoadBalancerRules", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3603,178 +5541,293 @@ type GlobalLoadBalancerRuleLoadbalancerrule struct {
 }
 
 type ListLBHealthCheckPoliciesParams struct {
-	p map[string]interface{}
+	fordisplay optBool
+	id         optString
+	keyword    optString
+	lbruleid   optString
+	page       optInt
+	pagesize   optInt
 }
 
-func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListLBHealthCheckPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListLBHealthCheckPoliciesParams parses url.Values, for example taken from a raw API request,
+// into a new ListLBHealthCheckPoliciesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListLBHealthCheckPoliciesParams(u url.Values) (*ListLBHealthCheckPoliciesParams, error) {
+	p := &ListLBHealthCheckPoliciesParams{}
+	if err := checkParamNames("listLBHealthCheckPolicies", u, "fordisplay", "id", "keyword", "lbruleid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["fordisplay"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
 }
 
+// SetFordisplay sets the fordisplay param.
+func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
+}
+
+// ResetFordisplay unsets the fordisplay param
 func (p *ListLBHealthCheckPoliciesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListLBHealthCheckPoliciesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListLBHealthCheckPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListLBHealthCheckPoliciesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListLBHealthCheckPoliciesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListLBHealthCheckPoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListLBHealthCheckPoliciesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListLBHealthCheckPoliciesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLbruleid sets the lbruleid param.
 func (p *ListLBHealthCheckPoliciesParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *ListLBHealthCheckPoliciesParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *ListLBHealthCheckPoliciesParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	return p.lbruleid.v, p.lbruleid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListLBHealthCheckPoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListLBHealthCheckPoliciesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListLBHealthCheckPoliciesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListLBHealthCheckPoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListLBHealthCheckPoliciesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListLBHealthCheckPoliciesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListLBHealthCheckPoliciesParams) Clone() *ListLBHealthCheckPoliciesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListLBHealthCheckPoliciesParams) Equal(o *ListLBHealthCheckPoliciesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.lbruleid == o.lbruleid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListLBHealthCheckPoliciesParams is used to (un)marshal ListLBHealthCheckPoliciesParams using the API param names
+type serializedListLBHealthCheckPoliciesParams struct {
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbruleid   *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListLBHealthCheckPoliciesParams) toSerialized() *serializedListLBHealthCheckPoliciesParams {
+	s := &serializedListLBHealthCheckPoliciesParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListLBHealthCheckPoliciesParams) fromSerialized(s *serializedListLBHealthCheckPoliciesParams) {
+	*p = ListLBHealthCheckPoliciesParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListLBHealthCheckPoliciesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListLBHealthCheckPoliciesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListLBHealthCheckPoliciesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListLBHealthCheckPoliciesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListLBHealthCheckPoliciesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListLBHealthCheckPoliciesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListLBHealthCheckPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams {
 	p := &ListLBHealthCheckPoliciesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
 	p := &ListLBHealthCheckPoliciesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3803,8 +5856,8 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 }
 
 // Lists load balancer health check policies.
-func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBHealthCheckPolicies", p.toURLValues())
+func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams, opts ...CallOption) (*ListLBHealthCheckPoliciesResponse, error) {
+	resp, err := s.cs.newRequest("listLBHealthCheckPolicies", s.cs.encodeParams("listLBHealthCheckPolicies", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3846,178 +5899,293 @@ type LBHealthCheckPolicyHealthcheckpolicy struct {
 }
 
 type ListLBStickinessPoliciesParams struct {
-	p map[string]interface{}
+	fordisplay optBool
+	id         optString
+	keyword    optString
+	lbruleid   optString
+	page       optInt
+	pagesize   optInt
 }
 
-func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListLBStickinessPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListLBStickinessPoliciesParams parses url.Values, for example taken from a raw API request,
+// into a new ListLBStickinessPoliciesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListLBStickinessPoliciesParams(u url.Values) (*ListLBStickinessPoliciesParams, error) {
+	p := &ListLBStickinessPoliciesParams{}
+	if err := checkParamNames("listLBStickinessPolicies", u, "fordisplay", "id", "keyword", "lbruleid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["fordisplay"] = v
+	return p, nil
+}
+
+// SetFordisplay sets the fordisplay param.
+func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListLBStickinessPoliciesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListLBStickinessPoliciesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListLBStickinessPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListLBStickinessPoliciesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListLBStickinessPoliciesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListLBStickinessPoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListLBStickinessPoliciesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListLBStickinessPoliciesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLbruleid sets the lbruleid param.
 func (p *ListLBStickinessPoliciesParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *ListLBStickinessPoliciesParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *ListLBStickinessPoliciesParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	return p.lbruleid.v, p.lbruleid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListLBStickinessPoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListLBStickinessPoliciesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListLBStickinessPoliciesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListLBStickinessPoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListLBStickinessPoliciesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListLBStickinessPoliciesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListLBStickinessPoliciesParams) Clone() *ListLBStickinessPoliciesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListLBStickinessPoliciesParams) Equal(o *ListLBStickinessPoliciesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.lbruleid == o.lbruleid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListLBStickinessPoliciesParams is used to (un)marshal ListLBStickinessPoliciesParams using the API param names
+type serializedListLBStickinessPoliciesParams struct {
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbruleid   *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListLBStickinessPoliciesParams) toSerialized() *serializedListLBStickinessPoliciesParams {
+	s := &serializedListLBStickinessPoliciesParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListLBStickinessPoliciesParams) fromSerialized(s *serializedListLBStickinessPoliciesParams) {
+	*p = ListLBStickinessPoliciesParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListLBStickinessPoliciesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListLBStickinessPoliciesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListLBStickinessPoliciesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListLBStickinessPoliciesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListLBStickinessPoliciesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListLBStickinessPoliciesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListLBStickinessPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams {
 	p := &ListLBStickinessPoliciesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
 	p := &ListLBStickinessPoliciesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4046,8 +6214,8 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 }
 
 // Lists load balancer stickiness policies.
-func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBStickinessPolicies", p.toURLValues())
+func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams, opts ...CallOption) (*ListLBStickinessPoliciesResponse, error) {
+	resp, err := s.cs.newRequest("listLBStickinessPolicies", s.cs.encodeParams("listLBStickinessPolicies", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4090,180 +6258,298 @@ type LBStickinessPolicyStickinesspolicy struct {
 }
 
 type ListLoadBalancerRuleInstancesParams struct {
-	p map[string]interface{}
+	applied  optBool
+	id       optString
+	keyword  optString
+	lbvmips  optBool
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListLoadBalancerRuleInstancesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["applied"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("applied", vv)
+	if p.applied.ok {
+		u.Set("applied", strconv.FormatBool(p.applied.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["lbvmips"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("lbvmips", vv)
+	if p.lbvmips.ok {
+		u.Set("lbvmips", strconv.FormatBool(p.lbvmips.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListLoadBalancerRuleInstancesParams parses url.Values, for example taken from a raw API request,
+// into a new ListLoadBalancerRuleInstancesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListLoadBalancerRuleInstancesParams(u url.Values) (*ListLoadBalancerRuleInstancesParams, error) {
+	p := &ListLoadBalancerRuleInstancesParams{}
+	if err := checkParamNames("listLoadBalancerRuleInstances", u, "applied", "id", "keyword", "lbvmips", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["applied"]; found {
+		v, err := strconv.ParseBool(u.Get("applied"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param applied: %v", err)
+		}
+		p.SetApplied(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["lbvmips"]; found {
+		v, err := strconv.ParseBool(u.Get("lbvmips"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param lbvmips: %v", err)
+		}
+		p.SetLbvmips(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["applied"] = v
+	return p, nil
 }
 
+// SetApplied sets the applied param.
+func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
+	p.applied = optBool{v: v, ok: true}
+}
+
+// ResetApplied unsets the applied param
 func (p *ListLoadBalancerRuleInstancesParams) ResetApplied() {
-	if p.p != nil && p.p["applied"] != nil {
-		delete(p.p, "applied")
-	}
+	p.applied = optBool{}
 }
 
+// GetApplied returns the applied param and if it is set
 func (p *ListLoadBalancerRuleInstancesParams) GetApplied() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["applied"].(bool)
-	return value, ok
+	return p.applied.v, p.applied.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ListLoadBalancerRuleInstancesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListLoadBalancerRuleInstancesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListLoadBalancerRuleInstancesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListLoadBalancerRuleInstancesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListLoadBalancerRuleInstancesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListLoadBalancerRuleInstancesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLbvmips sets the lbvmips param.
 func (p *ListLoadBalancerRuleInstancesParams) SetLbvmips(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbvmips"] = v
+	p.lbvmips = optBool{v: v, ok: true}
 }
 
+// ResetLbvmips unsets the lbvmips param
 func (p *ListLoadBalancerRuleInstancesParams) ResetLbvmips() {
-	if p.p != nil && p.p["lbvmips"] != nil {
-		delete(p.p, "lbvmips")
-	}
+	p.lbvmips = optBool{}
 }
 
+// GetLbvmips returns the lbvmips param and if it is set
 func (p *ListLoadBalancerRuleInstancesParams) GetLbvmips() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbvmips"].(bool)
-	return value, ok
+	return p.lbvmips.v, p.lbvmips.ok
 }
 
+// SetPage sets the page param.
 func (p *ListLoadBalancerRuleInstancesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListLoadBalancerRuleInstancesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListLoadBalancerRuleInstancesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListLoadBalancerRuleInstancesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListLoadBalancerRuleInstancesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListLoadBalancerRuleInstancesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListLoadBalancerRuleInstancesParams) Clone() *ListLoadBalancerRuleInstancesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListLoadBalancerRuleInstancesParams) Equal(o *ListLoadBalancerRuleInstancesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.applied == o.applied &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.lbvmips == o.lbvmips &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListLoadBalancerRuleInstancesParams is used to (un)marshal ListLoadBalancerRuleInstancesParams using the API param names
+type serializedListLoadBalancerRuleInstancesParams struct {
+	Applied  *bool   `json:"applied,omitempty" yaml:"applied,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbvmips  *bool   `json:"lbvmips,omitempty" yaml:"lbvmips,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) toSerialized() *serializedListLoadBalancerRuleInstancesParams {
+	s := &serializedListLoadBalancerRuleInstancesParams{}
+	if p.applied.ok {
+		s.Applied = &p.applied.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.lbvmips.ok {
+		s.Lbvmips = &p.lbvmips.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) fromSerialized(s *serializedListLoadBalancerRuleInstancesParams) {
+	*p = ListLoadBalancerRuleInstancesParams{}
+	if s.Applied != nil {
+		p.SetApplied(*s.Applied)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Lbvmips != nil {
+		p.SetLbvmips(*s.Lbvmips)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListLoadBalancerRuleInstancesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListLoadBalancerRuleInstancesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListLoadBalancerRuleInstancesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListLoadBalancerRuleInstancesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListLoadBalancerRuleInstancesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListLoadBalancerRuleInstancesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListLoadBalancerRuleInstancesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams {
 	p := &ListLoadBalancerRuleInstancesParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	p := &ListLoadBalancerRuleInstancesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4292,8 +6578,10 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 }
 
 // List all virtual machine instances that are assigned to a load balancer rule.
-func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", p.toURLValues())
+//
+// Required params: id.
+func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams, opts ...CallOption) (*ListLoadBalancerRuleInstancesResponse, error) {
+	resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", s.cs.encodeParams("listLoadBalancerRuleInstances", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4320,424 +6608,608 @@ type LoadBalancerRuleInstance struct {
 }
 
 type ListLoadBalancerRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
+	account          optString
+	domainid         optString
+	fordisplay       optBool
+	id               optString
+	isrecursive      optBool
+	keyword          optString
+	listall          optBool
+	name             optString
+	networkid        optString
+	page             optInt
+	pagesize         optInt
+	projectid        optString
+	publicipid       optString
+	tags             optStringMap
+	virtualmachineid optString
+	zoneid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListLoadBalancerRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if p.publicipid.ok {
+		u.Set("publicipid", p.publicipid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListLoadBalancerRulesParams parses url.Values, for example taken from a raw API request,
+// into a new ListLoadBalancerRulesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListLoadBalancerRulesParams(u url.Values) (*ListLoadBalancerRulesParams, error) {
+	p := &ListLoadBalancerRulesParams{}
+	if err := checkParamNames("listLoadBalancerRules", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "name", "networkid", "page", "pagesize", "projectid", "publicipid", "tags[]", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["publicipid"]; found {
+		p.SetPublicipid(u.Get("publicipid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListLoadBalancerRulesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListLoadBalancerRulesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListLoadBalancerRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListLoadBalancerRulesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListLoadBalancerRulesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListLoadBalancerRulesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListLoadBalancerRulesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListLoadBalancerRulesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListLoadBalancerRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListLoadBalancerRulesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListLoadBalancerRulesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListLoadBalancerRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListLoadBalancerRulesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListLoadBalancerRulesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListLoadBalancerRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListLoadBalancerRulesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListLoadBalancerRulesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListLoadBalancerRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListLoadBalancerRulesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListLoadBalancerRulesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListLoadBalancerRulesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListLoadBalancerRulesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListLoadBalancerRulesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListLoadBalancerRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListLoadBalancerRulesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListLoadBalancerRulesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListLoadBalancerRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListLoadBalancerRulesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListLoadBalancerRulesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListLoadBalancerRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListLoadBalancerRulesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListLoadBalancerRulesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListLoadBalancerRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListLoadBalancerRulesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListLoadBalancerRulesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetPublicipid sets the publicipid param.
 func (p *ListLoadBalancerRulesParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicipid"] = v
+	p.publicipid = optString{v: v, ok: true}
 }
 
+// ResetPublicipid unsets the publicipid param
 func (p *ListLoadBalancerRulesParams) ResetPublicipid() {
-	if p.p != nil && p.p["publicipid"] != nil {
-		delete(p.p, "publicipid")
-	}
+	p.publicipid = optString{}
 }
 
+// GetPublicipid returns the publicipid param and if it is set
 func (p *ListLoadBalancerRulesParams) GetPublicipid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicipid"].(string)
-	return value, ok
+	return p.publicipid.v, p.publicipid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListLoadBalancerRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListLoadBalancerRulesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListLoadBalancerRulesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param.
+func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *ListLoadBalancerRulesParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *ListLoadBalancerRulesParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// SetZoneid sets the zoneid param.
+func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *ListLoadBalancerRulesParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *ListLoadBalancerRulesParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListLoadBalancerRulesParams) Clone() *ListLoadBalancerRulesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListLoadBalancerRulesParams) Equal(o *ListLoadBalancerRulesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.publicipid == o.publicipid &&
+		p.tags.equal(o.tags) &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListLoadBalancerRulesParams is used to (un)marshal ListLoadBalancerRulesParams using the API param names
+type serializedListLoadBalancerRulesParams struct {
+	Account          *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay       *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id               *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid        *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page             *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publicipid       *string            `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+	Tags             *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Virtualmachineid *string            `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListLoadBalancerRulesParams) toSerialized() *serializedListLoadBalancerRulesParams {
+	s := &serializedListLoadBalancerRulesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.publicipid.ok {
+		s.Publicipid = &p.publicipid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListLoadBalancerRulesParams) fromSerialized(s *serializedListLoadBalancerRulesParams) {
+	*p = ListLoadBalancerRulesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Publicipid != nil {
+		p.SetPublicipid(*s.Publicipid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
-}
-
-func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
 	}
-	p.p["virtualmachineid"] = v
-}
-
-func (p *ListLoadBalancerRulesParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
 }
 
-func (p *ListLoadBalancerRulesParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListLoadBalancerRulesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListLoadBalancerRulesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListLoadBalancerRulesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["zoneid"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListLoadBalancerRulesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListLoadBalancerRulesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListLoadBalancerRulesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListLoadBalancerRulesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListLoadBalancerRulesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListLoadBalancerRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams {
 	p := &ListLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4785,9 +7257,8 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...Opt
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
 	p := &ListLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4816,8 +7287,8 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 }
 
 // Lists load balancer rules.
-func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRules", p.toURLValues())
+func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams, opts ...CallOption) (*ListLoadBalancerRulesResponse, error) {
+	resp, err := s.cs.newRequest("listLoadBalancerRules", s.cs.encodeParams("listLoadBalancerRules", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4862,66 +7333,77 @@ type LoadBalancerRule struct {
 }
 
 type ListLoadBalancersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListLoadBalancersParams) toURLValues() url.Values {
+	account                  optString
+	domainid                 optString
+	fordisplay               optBool
+	id                       optString
+	isrecursive              optBool
+	keyword                  optString
+	listall                  optBool
+	name                     optString
+	networkid                optString
+	page                     optInt
+	pagesize                 optInt
+	projectid                optString
+	scheme                   optString
+	sourceipaddress          optString
+	sourceipaddressnetworkid optString
+	tags                     optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListLoadBalancersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["scheme"]; found {
-		u.Set("scheme", v.(string))
+	if p.scheme.ok {
+		u.Set("scheme", p.scheme.v)
 	}
-	if v, found := p.p["sourceipaddress"]; found {
-		u.Set("sourceipaddress", v.(string))
+	if p.sourceipaddress.ok {
+		u.Set("sourceipaddress", p.sourceipaddress.v)
 	}
-	if v, found := p.p["sourceipaddressnetworkid"]; found {
-		u.Set("sourceipaddressnetworkid", v.(string))
+	if p.sourceipaddressnetworkid.ok {
+		u.Set("sourceipaddressnetworkid", p.sourceipaddressnetworkid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
@@ -4930,356 +7412,529 @@ func (p *ListLoadBalancersParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListLoadBalancersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListLoadBalancersParams parses url.Values, for example taken from a raw API request,
+// into a new ListLoadBalancersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListLoadBalancersParams(u url.Values) (*ListLoadBalancersParams, error) {
+	p := &ListLoadBalancersParams{}
+	if err := checkParamNames("listLoadBalancers", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "name", "networkid", "page", "pagesize", "projectid", "scheme", "sourceipaddress", "sourceipaddressnetworkid", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
 	}
-	p.p["account"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["scheme"]; found {
+		p.SetScheme(u.Get("scheme"))
+	}
+	if _, found := u["sourceipaddress"]; found {
+		p.SetSourceipaddress(u.Get("sourceipaddress"))
+	}
+	if _, found := u["sourceipaddressnetworkid"]; found {
+		p.SetSourceipaddressnetworkid(u.Get("sourceipaddressnetworkid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListLoadBalancersParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListLoadBalancersParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListLoadBalancersParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListLoadBalancersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListLoadBalancersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListLoadBalancersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListLoadBalancersParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListLoadBalancersParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListLoadBalancersParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListLoadBalancersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListLoadBalancersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListLoadBalancersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListLoadBalancersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListLoadBalancersParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListLoadBalancersParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListLoadBalancersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListLoadBalancersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListLoadBalancersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListLoadBalancersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListLoadBalancersParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListLoadBalancersParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListLoadBalancersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListLoadBalancersParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListLoadBalancersParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListLoadBalancersParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListLoadBalancersParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListLoadBalancersParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListLoadBalancersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListLoadBalancersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListLoadBalancersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListLoadBalancersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListLoadBalancersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListLoadBalancersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListLoadBalancersParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListLoadBalancersParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListLoadBalancersParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetScheme sets the scheme param.
 func (p *ListLoadBalancersParams) SetScheme(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scheme"] = v
+	p.scheme = optString{v: v, ok: true}
 }
 
+// ResetScheme unsets the scheme param
 func (p *ListLoadBalancersParams) ResetScheme() {
-	if p.p != nil && p.p["scheme"] != nil {
-		delete(p.p, "scheme")
-	}
+	p.scheme = optString{}
 }
 
+// GetScheme returns the scheme param and if it is set
 func (p *ListLoadBalancersParams) GetScheme() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scheme"].(string)
-	return value, ok
+	return p.scheme.v, p.scheme.ok
 }
 
+// SetSourceipaddress sets the sourceipaddress param.
 func (p *ListLoadBalancersParams) SetSourceipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceipaddress"] = v
+	p.sourceipaddress = optString{v: v, ok: true}
 }
 
+// ResetSourceipaddress unsets the sourceipaddress param
 func (p *ListLoadBalancersParams) ResetSourceipaddress() {
-	if p.p != nil && p.p["sourceipaddress"] != nil {
-		delete(p.p, "sourceipaddress")
-	}
+	p.sourceipaddress = optString{}
 }
 
+// GetSourceipaddress returns the sourceipaddress param and if it is set
 func (p *ListLoadBalancersParams) GetSourceipaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourceipaddress"].(string)
-	return value, ok
+	return p.sourceipaddress.v, p.sourceipaddress.ok
 }
 
+// SetSourceipaddressnetworkid sets the sourceipaddressnetworkid param.
 func (p *ListLoadBalancersParams) SetSourceipaddressnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceipaddressnetworkid"] = v
+	p.sourceipaddressnetworkid = optString{v: v, ok: true}
 }
 
+// ResetSourceipaddressnetworkid unsets the sourceipaddressnetworkid param
 func (p *ListLoadBalancersParams) ResetSourceipaddressnetworkid() {
-	if p.p != nil && p.p["sourceipaddressnetworkid"] != nil {
-		delete(p.p, "sourceipaddressnetworkid")
-	}
+	p.sourceipaddressnetworkid = optString{}
 }
 
+// GetSourceipaddressnetworkid returns the sourceipaddressnetworkid param and if it is set
 func (p *ListLoadBalancersParams) GetSourceipaddressnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourceipaddressnetworkid"].(string)
-	return value, ok
+	return p.sourceipaddressnetworkid.v, p.sourceipaddressnetworkid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListLoadBalancersParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListLoadBalancersParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListLoadBalancersParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListLoadBalancersParams) Clone() *ListLoadBalancersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListLoadBalancersParams) Equal(o *ListLoadBalancersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.scheme == o.scheme &&
+		p.sourceipaddress == o.sourceipaddress &&
+		p.sourceipaddressnetworkid == o.sourceipaddressnetworkid &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListLoadBalancersParams is used to (un)marshal ListLoadBalancersParams using the API param names
+type serializedListLoadBalancersParams struct {
+	Account                  *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid                 *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay               *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                       *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive              *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword                  *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                  *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name                     *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid                *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page                     *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                 *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid                *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Scheme                   *string            `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Sourceipaddress          *string            `json:"sourceipaddress,omitempty" yaml:"sourceipaddress,omitempty"`
+	Sourceipaddressnetworkid *string            `json:"sourceipaddressnetworkid,omitempty" yaml:"sourceipaddressnetworkid,omitempty"`
+	Tags                     *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListLoadBalancersParams) toSerialized() *serializedListLoadBalancersParams {
+	s := &serializedListLoadBalancersParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.scheme.ok {
+		s.Scheme = &p.scheme.v
+	}
+	if p.sourceipaddress.ok {
+		s.Sourceipaddress = &p.sourceipaddress.v
+	}
+	if p.sourceipaddressnetworkid.ok {
+		s.Sourceipaddressnetworkid = &p.sourceipaddressnetworkid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListLoadBalancersParams) fromSerialized(s *serializedListLoadBalancersParams) {
+	*p = ListLoadBalancersParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Scheme != nil {
+		p.SetScheme(*s.Scheme)
+	}
+	if s.Sourceipaddress != nil {
+		p.SetSourceipaddress(*s.Sourceipaddress)
+	}
+	if s.Sourceipaddressnetworkid != nil {
+		p.SetSourceipaddressnetworkid(*s.Sourceipaddressnetworkid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListLoadBalancersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListLoadBalancersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListLoadBalancersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListLoadBalancersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListLoadBalancersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListLoadBalancersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListLoadBalancersParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLoadBalancersParams() *ListLoadBalancersParams {
 	p := &ListLoadBalancersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5327,9 +7982,8 @@ func (s *LoadBalancerService) GetLoadBalancerByName(name string, opts ...OptionF
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
 	p := &ListLoadBalancersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5357,9 +8011,9 @@ func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc)
 	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancer UUID: %s!", id)
 }
 
-// Lists internal load balancers
-func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancers", p.toURLValues())
+// Lists internal load balancers.
+func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams, opts ...CallOption) (*ListLoadBalancersResponse, error) {
+	resp, err := s.cs.newRequest("listLoadBalancers", s.cs.encodeParams("listLoadBalancers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5412,150 +8066,257 @@ type LoadBalancerLoadbalancerinstance struct {
 }
 
 type ListNetscalerLoadBalancersParams struct {
-	p map[string]interface{}
+	keyword           optString
+	lbdeviceid        optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
 }
 
-func (p *ListNetscalerLoadBalancersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetscalerLoadBalancersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
+	if p.lbdeviceid.ok {
+		u.Set("lbdeviceid", p.lbdeviceid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetscalerLoadBalancersParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetscalerLoadBalancersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetscalerLoadBalancersParams(u url.Values) (*ListNetscalerLoadBalancersParams, error) {
+	p := &ListNetscalerLoadBalancersParams{}
+	if err := checkParamNames("listNetscalerLoadBalancers", u, "keyword", "lbdeviceid", "page", "pagesize", "physicalnetworkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["lbdeviceid"]; found {
+		p.SetLbdeviceid(u.Get("lbdeviceid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetscalerLoadBalancersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetscalerLoadBalancersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLbdeviceid sets the lbdeviceid param.
 func (p *ListNetscalerLoadBalancersParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdeviceid"] = v
+	p.lbdeviceid = optString{v: v, ok: true}
 }
 
+// ResetLbdeviceid unsets the lbdeviceid param
 func (p *ListNetscalerLoadBalancersParams) ResetLbdeviceid() {
-	if p.p != nil && p.p["lbdeviceid"] != nil {
-		delete(p.p, "lbdeviceid")
-	}
+	p.lbdeviceid = optString{}
 }
 
+// GetLbdeviceid returns the lbdeviceid param and if it is set
 func (p *ListNetscalerLoadBalancersParams) GetLbdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbdeviceid"].(string)
-	return value, ok
+	return p.lbdeviceid.v, p.lbdeviceid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetscalerLoadBalancersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetscalerLoadBalancersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetscalerLoadBalancersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetscalerLoadBalancersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetscalerLoadBalancersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetscalerLoadBalancersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListNetscalerLoadBalancersParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListNetscalerLoadBalancersParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetscalerLoadBalancersParams) Clone() *ListNetscalerLoadBalancersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetscalerLoadBalancersParams) Equal(o *ListNetscalerLoadBalancersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.lbdeviceid == o.lbdeviceid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListNetscalerLoadBalancersParams is used to (un)marshal ListNetscalerLoadBalancersParams using the API param names
+type serializedListNetscalerLoadBalancersParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid        *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListNetscalerLoadBalancersParams) toSerialized() *serializedListNetscalerLoadBalancersParams {
+	s := &serializedListNetscalerLoadBalancersParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.lbdeviceid.ok {
+		s.Lbdeviceid = &p.lbdeviceid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListNetscalerLoadBalancersParams) fromSerialized(s *serializedListNetscalerLoadBalancersParams) {
+	*p = ListNetscalerLoadBalancersParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Lbdeviceid != nil {
+		p.SetLbdeviceid(*s.Lbdeviceid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetscalerLoadBalancersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetscalerLoadBalancersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetscalerLoadBalancersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetscalerLoadBalancersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetscalerLoadBalancersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetscalerLoadBalancersParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetscalerLoadBalancersParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams {
 	p := &ListNetscalerLoadBalancersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// lists netscaler load balancer devices
-func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancers", p.toURLValues())
+// lists netscaler load balancer devices.
+func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams, opts ...CallOption) (*ListNetscalerLoadBalancersResponse, error) {
+	resp, err := s.cs.newRequest("listNetscalerLoadBalancers", s.cs.encodeParams("listNetscalerLoadBalancers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5594,124 +8355,219 @@ type NetscalerLoadBalancer struct {
 }
 
 type ListSslCertsParams struct {
-	p map[string]interface{}
+	accountid optString
+	certid    optString
+	lbruleid  optString
+	projectid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSslCertsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
+	}
+	if p.certid.ok {
+		u.Set("certid", p.certid.v)
+	}
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	return u
+}
+
+// ParseListSslCertsParams parses url.Values, for example taken from a raw API request,
+// into a new ListSslCertsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSslCertsParams(u url.Values) (*ListSslCertsParams, error) {
+	p := &ListSslCertsParams{}
+	if err := checkParamNames("listSslCerts", u, "accountid", "certid", "lbruleid", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["certid"]; found {
+		p.SetCertid(u.Get("certid"))
+	}
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccountid sets the accountid param.
+func (p *ListSslCertsParams) SetAccountid(v string) {
+	p.accountid = optString{v: v, ok: true}
+}
+
+// ResetAccountid unsets the accountid param
+func (p *ListSslCertsParams) ResetAccountid() {
+	p.accountid = optString{}
+}
+
+// GetAccountid returns the accountid param and if it is set
+func (p *ListSslCertsParams) GetAccountid() (string, bool) {
+	return p.accountid.v, p.accountid.ok
+}
+
+// SetCertid sets the certid param.
+func (p *ListSslCertsParams) SetCertid(v string) {
+	p.certid = optString{v: v, ok: true}
+}
+
+// ResetCertid unsets the certid param
+func (p *ListSslCertsParams) ResetCertid() {
+	p.certid = optString{}
+}
+
+// GetCertid returns the certid param and if it is set
+func (p *ListSslCertsParams) GetCertid() (string, bool) {
+	return p.certid.v, p.certid.ok
+}
+
+// SetLbruleid sets the lbruleid param.
+func (p *ListSslCertsParams) SetLbruleid(v string) {
+	p.lbruleid = optString{v: v, ok: true}
+}
+
+// ResetLbruleid unsets the lbruleid param
+func (p *ListSslCertsParams) ResetLbruleid() {
+	p.lbruleid = optString{}
+}
+
+// GetLbruleid returns the lbruleid param and if it is set
+func (p *ListSslCertsParams) GetLbruleid() (string, bool) {
+	return p.lbruleid.v, p.lbruleid.ok
 }
 
-func (p *ListSslCertsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
-	}
-	if v, found := p.p["certid"]; found {
-		u.Set("certid", v.(string))
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
+// SetProjectid sets the projectid param.
+func (p *ListSslCertsParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
 }
 
-func (p *ListSslCertsParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
+// ResetProjectid unsets the projectid param
+func (p *ListSslCertsParams) ResetProjectid() {
+	p.projectid = optString{}
 }
 
-func (p *ListSslCertsParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+// GetProjectid returns the projectid param and if it is set
+func (p *ListSslCertsParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
 }
 
-func (p *ListSslCertsParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Clone returns a deep copy of the params
+func (p *ListSslCertsParams) Clone() *ListSslCertsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-func (p *ListSslCertsParams) SetCertid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSslCertsParams) Equal(o *ListSslCertsParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	p.p["certid"] = v
+	return p.accountid == o.accountid &&
+		p.certid == o.certid &&
+		p.lbruleid == o.lbruleid &&
+		p.projectid == o.projectid
 }
 
-func (p *ListSslCertsParams) ResetCertid() {
-	if p.p != nil && p.p["certid"] != nil {
-		delete(p.p, "certid")
-	}
+// serializedListSslCertsParams is used to (un)marshal ListSslCertsParams using the API param names
+type serializedListSslCertsParams struct {
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Certid    *string `json:"certid,omitempty" yaml:"certid,omitempty"`
+	Lbruleid  *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
 }
 
-func (p *ListSslCertsParams) GetCertid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSslCertsParams) toSerialized() *serializedListSslCertsParams {
+	s := &serializedListSslCertsParams{}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
 	}
-	value, ok := p.p["certid"].(string)
-	return value, ok
-}
-
-func (p *ListSslCertsParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if p.certid.ok {
+		s.Certid = &p.certid.v
+	}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
 	}
-	p.p["lbruleid"] = v
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
 }
 
-func (p *ListSslCertsParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
+func (p *ListSslCertsParams) fromSerialized(s *serializedListSslCertsParams) {
+	*p = ListSslCertsParams{}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Certid != nil {
+		p.SetCertid(*s.Certid)
+	}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
 	}
 }
 
-func (p *ListSslCertsParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSslCertsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListSslCertsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSslCertsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSslCertsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["projectid"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListSslCertsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSslCertsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListSslCertsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSslCertsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSslCertsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSslCertsParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
 	p := &ListSslCertsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists SSL certificates
-func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
-	resp, err := s.cs.newRequest("listSslCerts", p.toURLValues())
+// Lists SSL certificates.
+func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams, opts ...CallOption) (*ListSslCertsResponse, error) {
+	resp, err := s.cs.newRequest("listSslCerts", s.cs.encodeParams("listSslCerts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5746,53 +8602,133 @@ type SslCert struct {
 }
 
 type RemoveCertFromLoadBalancerParams struct {
-	p map[string]interface{}
+	lbruleid optString
 }
 
-func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveCertFromLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if p.lbruleid.ok {
+		u.Set("lbruleid", p.lbruleid.v)
 	}
 	return u
 }
 
-func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveCertFromLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveCertFromLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveCertFromLoadBalancerParams(u url.Values) (*RemoveCertFromLoadBalancerParams, error) {
+	p := &RemoveCertFromLoadBalancerParams{}
+	if err := checkParamNames("removeCertFromLoadBalancer", u, "lbruleid"); err != nil {
+		return nil, err
 	}
-	p.p["lbruleid"] = v
+	if _, found := u["lbruleid"]; found {
+		p.SetLbruleid(u.Get("lbruleid"))
+	}
+	return p, nil
+}
+
+// SetLbruleid sets the lbruleid param. This param is required.
+func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
+	p.lbruleid = optString{v: v, ok: true}
 }
 
+// ResetLbruleid unsets the lbruleid param
 func (p *RemoveCertFromLoadBalancerParams) ResetLbruleid() {
-	if p.p != nil && p.p["lbruleid"] != nil {
-		delete(p.p, "lbruleid")
-	}
+	p.lbruleid = optString{}
 }
 
+// GetLbruleid returns the lbruleid param and if it is set
 func (p *RemoveCertFromLoadBalancerParams) GetLbruleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.lbruleid.v, p.lbruleid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveCertFromLoadBalancerParams) Clone() *RemoveCertFromLoadBalancerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveCertFromLoadBalancerParams) Equal(o *RemoveCertFromLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.lbruleid == o.lbruleid
+}
+
+// serializedRemoveCertFromLoadBalancerParams is used to (un)marshal RemoveCertFromLoadBalancerParams using the API param names
+type serializedRemoveCertFromLoadBalancerParams struct {
+	Lbruleid *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+}
+
+func (p *RemoveCertFromLoadBalancerParams) toSerialized() *serializedRemoveCertFromLoadBalancerParams {
+	s := &serializedRemoveCertFromLoadBalancerParams{}
+	if p.lbruleid.ok {
+		s.Lbruleid = &p.lbruleid.v
+	}
+	return s
+}
+
+func (p *RemoveCertFromLoadBalancerParams) fromSerialized(s *serializedRemoveCertFromLoadBalancerParams) {
+	*p = RemoveCertFromLoadBalancerParams{}
+	if s.Lbruleid != nil {
+		p.SetLbruleid(*s.Lbruleid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveCertFromLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveCertFromLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveCertFromLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["lbruleid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveCertFromLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveCertFromLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveCertFromLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveCertFromLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams {
 	p := &RemoveCertFromLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
+	p.SetLbruleid(lbruleid)
 	return p
 }
 
-// Removes a certificate from a load balancer rule
-func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("removeCertFromLoadBalancer", p.toURLValues())
+// Removes a certificate from a load balancer rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: lbruleid.
+func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams, opts ...CallOption) (*RemoveCertFromLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("removeCertFromLoadBalancer", s.cs.encodeParams("removeCertFromLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5803,8 +8739,8 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -5828,79 +8764,166 @@ type RemoveCertFromLoadBalancerResponse struct {
 }
 
 type RemoveFromGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	id                   optString
+	loadbalancerrulelist optStrings
 }
 
-func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["loadbalancerrulelist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("loadbalancerrulelist", vv)
+	if p.loadbalancerrulelist.ok {
+		u.Set("loadbalancerrulelist", strings.Join(p.loadbalancerrulelist.v, ","))
 	}
 	return u
 }
 
-func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveFromGlobalLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveFromGlobalLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveFromGlobalLoadBalancerRuleParams(u url.Values) (*RemoveFromGlobalLoadBalancerRuleParams, error) {
+	p := &RemoveFromGlobalLoadBalancerRuleParams{}
+	if err := checkParamNames("removeFromGlobalLoadBalancerRule", u, "id", "loadbalancerrulelist"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["loadbalancerrulelist"]; found {
+		p.SetLoadbalancerrulelist(strings.Split(u.Get("loadbalancerrulelist"), ","))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RemoveFromGlobalLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveFromGlobalLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetLoadbalancerrulelist sets the loadbalancerrulelist param. This param is required.
 func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["loadbalancerrulelist"] = v
+	p.loadbalancerrulelist = optStrings{v: v, ok: true}
 }
 
+// ResetLoadbalancerrulelist unsets the loadbalancerrulelist param
 func (p *RemoveFromGlobalLoadBalancerRuleParams) ResetLoadbalancerrulelist() {
-	if p.p != nil && p.p["loadbalancerrulelist"] != nil {
-		delete(p.p, "loadbalancerrulelist")
-	}
+	p.loadbalancerrulelist = optStrings{}
 }
 
+// GetLoadbalancerrulelist returns the loadbalancerrulelist param and if it is set
 func (p *RemoveFromGlobalLoadBalancerRuleParams) GetLoadbalancerrulelist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.loadbalancerrulelist.v, p.loadbalancerrulelist.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Clone() *RemoveFromGlobalLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.loadbalancerrulelist = p.loadbalancerrulelist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Equal(o *RemoveFromGlobalLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.loadbalancerrulelist.equal(o.loadbalancerrulelist)
+}
+
+// serializedRemoveFromGlobalLoadBalancerRuleParams is used to (un)marshal RemoveFromGlobalLoadBalancerRuleParams using the API param names
+type serializedRemoveFromGlobalLoadBalancerRuleParams struct {
+	Id                   *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Loadbalancerrulelist *[]string `json:"loadbalancerrulelist,omitempty" yaml:"loadbalancerrulelist,omitempty"`
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) toSerialized() *serializedRemoveFromGlobalLoadBalancerRuleParams {
+	s := &serializedRemoveFromGlobalLoadBalancerRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.loadbalancerrulelist.ok {
+		s.Loadbalancerrulelist = &p.loadbalancerrulelist.v
+	}
+	return s
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) fromSerialized(s *serializedRemoveFromGlobalLoadBalancerRuleParams) {
+	*p = RemoveFromGlobalLoadBalancerRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Loadbalancerrulelist != nil {
+		p.SetLoadbalancerrulelist(*s.Loadbalancerrulelist)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveFromGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveFromGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveFromGlobalLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["loadbalancerrulelist"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveFromGlobalLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveFromGlobalLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveFromGlobalLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveFromGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams {
 	p := &RemoveFromGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["loadbalancerrulelist"] = loadbalancerrulelist
+	p.SetId(id)
+	p.SetLoadbalancerrulelist(loadbalancerrulelist)
 	return p
 }
 
-// Removes a load balancer rule association with global load balancer rule
-func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromGlobalLoadBalancerRule", p.toURLValues())
+// Removes a load balancer rule association with global load balancer rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id,
+// loadbalancerrulelist.
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams, opts ...CallOption) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("removeFromGlobalLoadBalancerRule", s.cs.encodeParams("removeFromGlobalLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5911,8 +8934,8 @@ func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlob
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -5936,23 +8959,25 @@ type RemoveFromGlobalLoadBalancerRuleResponse struct {
 }
 
 type RemoveFromLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	id                optString
+	virtualmachineids optStrings
+	vmidipmap         optStringMap
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveFromLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["virtualmachineids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("virtualmachineids", vv)
+	if p.virtualmachineids.ok {
+		u.Set("virtualmachineids", strings.Join(p.virtualmachineids.v, ","))
 	}
-	if v, found := p.p["vmidipmap"]; found {
-		m := v.(map[string]string)
+	if p.vmidipmap.ok {
+		m := p.vmidipmap.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
 			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
@@ -5961,81 +8986,174 @@ func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveFromLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveFromLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveFromLoadBalancerRuleParams(u url.Values) (*RemoveFromLoadBalancerRuleParams, error) {
+	p := &RemoveFromLoadBalancerRuleParams{}
+	if err := checkParamNames("removeFromLoadBalancerRule", u, "id", "virtualmachineids", "vmidipmap[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["virtualmachineids"]; found {
+		p.SetVirtualmachineids(strings.Split(u.Get("virtualmachineids"), ","))
 	}
-	p.p["id"] = v
+	if m, err := parseKeyValueMap(u, "vmidipmap", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetVmidipmap(m)
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RemoveFromLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveFromLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetVirtualmachineids sets the virtualmachineids param.
 func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineids"] = v
+	p.virtualmachineids = optStrings{v: v, ok: true}
 }
 
+// ResetVirtualmachineids unsets the virtualmachineids param
 func (p *RemoveFromLoadBalancerRuleParams) ResetVirtualmachineids() {
-	if p.p != nil && p.p["virtualmachineids"] != nil {
-		delete(p.p, "virtualmachineids")
-	}
+	p.virtualmachineids = optStrings{}
 }
 
+// GetVirtualmachineids returns the virtualmachineids param and if it is set
 func (p *RemoveFromLoadBalancerRuleParams) GetVirtualmachineids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineids"].([]string)
-	return value, ok
+	return p.virtualmachineids.v, p.virtualmachineids.ok
 }
 
+// SetVmidipmap sets the vmidipmap param.
 func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmidipmap"] = v
+	p.vmidipmap = optStringMap{v: v, ok: true}
 }
 
+// ResetVmidipmap unsets the vmidipmap param
 func (p *RemoveFromLoadBalancerRuleParams) ResetVmidipmap() {
-	if p.p != nil && p.p["vmidipmap"] != nil {
-		delete(p.p, "vmidipmap")
-	}
+	p.vmidipmap = optStringMap{}
 }
 
+// GetVmidipmap returns the vmidipmap param and if it is set
 func (p *RemoveFromLoadBalancerRuleParams) GetVmidipmap() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmidipmap.v, p.vmidipmap.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveFromLoadBalancerRuleParams) Clone() *RemoveFromLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.virtualmachineids = p.virtualmachineids.clone()
+	c.vmidipmap = p.vmidipmap.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveFromLoadBalancerRuleParams) Equal(o *RemoveFromLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.virtualmachineids.equal(o.virtualmachineids) &&
+		p.vmidipmap.equal(o.vmidipmap)
+}
+
+// serializedRemoveFromLoadBalancerRuleParams is used to (un)marshal RemoveFromLoadBalancerRuleParams using the API param names
+type serializedRemoveFromLoadBalancerRuleParams struct {
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineids *[]string          `json:"virtualmachineids,omitempty" yaml:"virtualmachineids,omitempty"`
+	Vmidipmap         *map[string]string `json:"vmidipmap,omitempty" yaml:"vmidipmap,omitempty"`
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) toSerialized() *serializedRemoveFromLoadBalancerRuleParams {
+	s := &serializedRemoveFromLoadBalancerRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.virtualmachineids.ok {
+		s.Virtualmachineids = &p.virtualmachineids.v
+	}
+	if p.vmidipmap.ok {
+		s.Vmidipmap = &p.vmidipmap.v
+	}
+	return s
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) fromSerialized(s *serializedRemoveFromLoadBalancerRuleParams) {
+	*p = RemoveFromLoadBalancerRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Virtualmachineids != nil {
+		p.SetVirtualmachineids(*s.Virtualmachineids)
 	}
-	value, ok := p.p["vmidipmap"].(map[string]string)
-	return value, ok
+	if s.Vmidipmap != nil {
+		p.SetVmidipmap(*s.Vmidipmap)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveFromLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveFromLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveFromLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveFromLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveFromLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveFromLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveFromLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams {
 	p := &RemoveFromLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Removes a virtual machine or a list of virtual machines from a load balancer rule.
-func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromLoadBalancerRule", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams, opts ...CallOption) (*RemoveFromLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("removeFromLoadBalancerRule", s.cs.encodeParams("removeFromLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6046,8 +9164,8 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6071,125 +9189,223 @@ type RemoveFromLoadBalancerRuleResponse struct {
 }
 
 type UpdateGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	description                 optString
+	gslblbmethod                optString
+	gslbstickysessionmethodname optString
+	id                          optString
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["gslblbmethod"]; found {
-		u.Set("gslblbmethod", v.(string))
+	if p.gslblbmethod.ok {
+		u.Set("gslblbmethod", p.gslblbmethod.v)
 	}
-	if v, found := p.p["gslbstickysessionmethodname"]; found {
-		u.Set("gslbstickysessionmethodname", v.(string))
+	if p.gslbstickysessionmethodname.ok {
+		u.Set("gslbstickysessionmethodname", p.gslbstickysessionmethodname.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateGlobalLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateGlobalLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateGlobalLoadBalancerRuleParams(u url.Values) (*UpdateGlobalLoadBalancerRuleParams, error) {
+	p := &UpdateGlobalLoadBalancerRuleParams{}
+	if err := checkParamNames("updateGlobalLoadBalancerRule", u, "description", "gslblbmethod", "gslbstickysessionmethodname", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["gslblbmethod"]; found {
+		p.SetGslblbmethod(u.Get("gslblbmethod"))
+	}
+	if _, found := u["gslbstickysessionmethodname"]; found {
+		p.SetGslbstickysessionmethodname(u.Get("gslbstickysessionmethodname"))
 	}
-	p.p["description"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetDescription sets the description param.
+func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *UpdateGlobalLoadBalancerRuleParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *UpdateGlobalLoadBalancerRuleParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetGslblbmethod sets the gslblbmethod param.
 func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslblbmethod"] = v
+	p.gslblbmethod = optString{v: v, ok: true}
 }
 
+// ResetGslblbmethod unsets the gslblbmethod param
 func (p *UpdateGlobalLoadBalancerRuleParams) ResetGslblbmethod() {
-	if p.p != nil && p.p["gslblbmethod"] != nil {
-		delete(p.p, "gslblbmethod")
-	}
+	p.gslblbmethod = optString{}
 }
 
+// GetGslblbmethod returns the gslblbmethod param and if it is set
 func (p *UpdateGlobalLoadBalancerRuleParams) GetGslblbmethod() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslblbmethod"].(string)
-	return value, ok
+	return p.gslblbmethod.v, p.gslblbmethod.ok
 }
 
+// SetGslbstickysessionmethodname sets the gslbstickysessionmethodname param.
 func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbstickysessionmethodname"] = v
+	p.gslbstickysessionmethodname = optString{v: v, ok: true}
 }
 
+// ResetGslbstickysessionmethodname unsets the gslbstickysessionmethodname param
 func (p *UpdateGlobalLoadBalancerRuleParams) ResetGslbstickysessionmethodname() {
-	if p.p != nil && p.p["gslbstickysessionmethodname"] != nil {
-		delete(p.p, "gslbstickysessionmethodname")
-	}
+	p.gslbstickysessionmethodname = optString{}
 }
 
+// GetGslbstickysessionmethodname returns the gslbstickysessionmethodname param and if it is set
 func (p *UpdateGlobalLoadBalancerRuleParams) GetGslbstickysessionmethodname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gslbstickysessionmethodname"].(string)
-	return value, ok
+	return p.gslbstickysessionmethodname.v, p.gslbstickysessionmethodname.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateGlobalLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateGlobalLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateGlobalLoadBalancerRuleParams) Clone() *UpdateGlobalLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateGlobalLoadBalancerRuleParams) Equal(o *UpdateGlobalLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.gslblbmethod == o.gslblbmethod &&
+		p.gslbstickysessionmethodname == o.gslbstickysessionmethodname &&
+		p.id == o.id
+}
+
+// serializedUpdateGlobalLoadBalancerRuleParams is used to (un)marshal UpdateGlobalLoadBalancerRuleParams using the API param names
+type serializedUpdateGlobalLoadBalancerRuleParams struct {
+	Description                 *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Gslblbmethod                *string `json:"gslblbmethod,omitempty" yaml:"gslblbmethod,omitempty"`
+	Gslbstickysessionmethodname *string `json:"gslbstickysessionmethodname,omitempty" yaml:"gslbstickysessionmethodname,omitempty"`
+	Id                          *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) toSerialized() *serializedUpdateGlobalLoadBalancerRuleParams {
+	s := &serializedUpdateGlobalLoadBalancerRuleParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.gslblbmethod.ok {
+		s.Gslblbmethod = &p.gslblbmethod.v
+	}
+	if p.gslbstickysessionmethodname.ok {
+		s.Gslbstickysessionmethodname = &p.gslbstickysessionmethodname.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) fromSerialized(s *serializedUpdateGlobalLoadBalancerRuleParams) {
+	*p = UpdateGlobalLoadBalancerRuleParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Gslblbmethod != nil {
+		p.SetGslblbmethod(*s.Gslblbmethod)
+	}
+	if s.Gslbstickysessionmethodname != nil {
+		p.SetGslbstickysessionmethodname(*s.Gslbstickysessionmethodname)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateGlobalLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateGlobalLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateGlobalLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateGlobalLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams {
 	p := &UpdateGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // update global load balancer rules.
-func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateGlobalLoadBalancerRule", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams, opts ...CallOption) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("updateGlobalLoadBalancerRule", s.cs.encodeParams("updateGlobalLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6200,8 +9416,8 @@ func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6266,102 +9482,197 @@ type UpdateGlobalLoadBalancerRuleResponseLoadbalancerrule struct {
 }
 
 type UpdateLBHealthCheckPolicyParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateLBHealthCheckPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateLBHealthCheckPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateLBHealthCheckPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateLBHealthCheckPolicyParams(u url.Values) (*UpdateLBHealthCheckPolicyParams, error) {
+	p := &UpdateLBHealthCheckPolicyParams{}
+	if err := checkParamNames("updateLBHealthCheckPolicy", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["customid"] = v
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
+func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateLBHealthCheckPolicyParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateLBHealthCheckPolicyParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateLBHealthCheckPolicyParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateLBHealthCheckPolicyParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateLBHealthCheckPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateLBHealthCheckPolicyParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateLBHealthCheckPolicyParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateLBHealthCheckPolicyParams) Clone() *UpdateLBHealthCheckPolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateLBHealthCheckPolicyParams) Equal(o *UpdateLBHealthCheckPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateLBHealthCheckPolicyParams is used to (un)marshal UpdateLBHealthCheckPolicyParams using the API param names
+type serializedUpdateLBHealthCheckPolicyParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) toSerialized() *serializedUpdateLBHealthCheckPolicyParams {
+	s := &serializedUpdateLBHealthCheckPolicyParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) fromSerialized(s *serializedUpdateLBHealthCheckPolicyParams) {
+	*p = UpdateLBHealthCheckPolicyParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateLBHealthCheckPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateLBHealthCheckPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateLBHealthCheckPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateLBHealthCheckPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateLBHealthCheckPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams {
 	p := &UpdateLBHealthCheckPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates load balancer health check policy
-func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBHealthCheckPolicy", p.toURLValues())
+// Updates load balancer health check policy.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams, opts ...CallOption) (*UpdateLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequest("updateLBHealthCheckPolicy", s.cs.encodeParams("updateLBHealthCheckPolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6372,8 +9683,8 @@ func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPo
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6418,102 +9729,197 @@ type UpdateLBHealthCheckPolicyResponseHealthcheckpolicy struct {
 }
 
 type UpdateLBStickinessPolicyParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateLBStickinessPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateLBStickinessPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateLBStickinessPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateLBStickinessPolicyParams(u url.Values) (*UpdateLBStickinessPolicyParams, error) {
+	p := &UpdateLBStickinessPolicyParams{}
+	if err := checkParamNames("updateLBStickinessPolicy", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["customid"] = v
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
+func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateLBStickinessPolicyParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateLBStickinessPolicyParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateLBStickinessPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateLBStickinessPolicyParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateLBStickinessPolicyParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateLBStickinessPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateLBStickinessPolicyParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateLBStickinessPolicyParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateLBStickinessPolicyParams) Clone() *UpdateLBStickinessPolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateLBStickinessPolicyParams) Equal(o *UpdateLBStickinessPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateLBStickinessPolicyParams is used to (un)marshal UpdateLBStickinessPolicyParams using the API param names
+type serializedUpdateLBStickinessPolicyParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateLBStickinessPolicyParams) toSerialized() *serializedUpdateLBStickinessPolicyParams {
+	s := &serializedUpdateLBStickinessPolicyParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *UpdateLBStickinessPolicyParams) fromSerialized(s *serializedUpdateLBStickinessPolicyParams) {
+	*p = UpdateLBStickinessPolicyParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateLBStickinessPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateLBStickinessPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateLBStickinessPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateLBStickinessPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateLBStickinessPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams {
 	p := &UpdateLBStickinessPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates load balancer stickiness policy
-func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBStickinessPolicy", p.toURLValues())
+// Updates load balancer stickiness policy.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams, opts ...CallOption) (*UpdateLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequest("updateLBStickinessPolicy", s.cs.encodeParams("updateLBStickinessPolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6524,8 +9930,8 @@ func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPoli
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6571,102 +9977,197 @@ type UpdateLBStickinessPolicyResponseStickinesspolicy struct {
 }
 
 type UpdateLoadBalancerParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateLoadBalancerParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateLoadBalancerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateLoadBalancerParams(u url.Values) (*UpdateLoadBalancerParams, error) {
+	p := &UpdateLoadBalancerParams{}
+	if err := checkParamNames("updateLoadBalancer", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["customid"] = v
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
+func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateLoadBalancerParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateLoadBalancerParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateLoadBalancerParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateLoadBalancerParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateLoadBalancerParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateLoadBalancerParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateLoadBalancerParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateLoadBalancerParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateLoadBalancerParams) Clone() *UpdateLoadBalancerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateLoadBalancerParams) Equal(o *UpdateLoadBalancerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateLoadBalancerParams is used to (un)marshal UpdateLoadBalancerParams using the API param names
+type serializedUpdateLoadBalancerParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateLoadBalancerParams) toSerialized() *serializedUpdateLoadBalancerParams {
+	s := &serializedUpdateLoadBalancerParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateLoadBalancerParams) fromSerialized(s *serializedUpdateLoadBalancerParams) {
+	*p = UpdateLoadBalancerParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateLoadBalancerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateLoadBalancerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateLoadBalancerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateLoadBalancerParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams {
 	p := &UpdateLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates an internal load balancer
-func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancer", p.toURLValues())
+// Updates an internal load balancer.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams, opts ...CallOption) (*UpdateLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest("updateLoadBalancer", s.cs.encodeParams("updateLoadBalancer", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6677,8 +10178,8 @@ func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6734,198 +10235,317 @@ type UpdateLoadBalancerResponseLoadbalancerinstance struct {
 }
 
 type UpdateLoadBalancerRuleParams struct {
-	p map[string]interface{}
+	algorithm   optString
+	customid    optString
+	description optString
+	fordisplay  optBool
+	id          optString
+	name        optString
+	protocol    optString
 }
 
-func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
+	if p.algorithm.ok {
+		u.Set("algorithm", p.algorithm.v)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
 	return u
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateLoadBalancerRuleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateLoadBalancerRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateLoadBalancerRuleParams(u url.Values) (*UpdateLoadBalancerRuleParams, error) {
+	p := &UpdateLoadBalancerRuleParams{}
+	if err := checkParamNames("updateLoadBalancerRule", u, "algorithm", "customid", "description", "fordisplay", "id", "name", "protocol"); err != nil {
+		return nil, err
+	}
+	if _, found := u["algorithm"]; found {
+		p.SetAlgorithm(u.Get("algorithm"))
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
 	}
-	p.p["algorithm"] = v
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	return p, nil
+}
+
+// SetAlgorithm sets the algorithm param.
+func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
+	p.algorithm = optString{v: v, ok: true}
 }
 
+// ResetAlgorithm unsets the algorithm param
 func (p *UpdateLoadBalancerRuleParams) ResetAlgorithm() {
-	if p.p != nil && p.p["algorithm"] != nil {
-		delete(p.p, "algorithm")
-	}
+	p.algorithm = optString{}
 }
 
+// GetAlgorithm returns the algorithm param and if it is set
 func (p *UpdateLoadBalancerRuleParams) GetAlgorithm() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["algorithm"].(string)
-	return value, ok
+	return p.algorithm.v, p.algorithm.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *UpdateLoadBalancerRuleParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateLoadBalancerRuleParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateLoadBalancerRuleParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDescription sets the description param.
 func (p *UpdateLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *UpdateLoadBalancerRuleParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *UpdateLoadBalancerRuleParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateLoadBalancerRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateLoadBalancerRuleParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateLoadBalancerRuleParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateLoadBalancerRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateLoadBalancerRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateLoadBalancerRuleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateLoadBalancerRuleParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateLoadBalancerRuleParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *UpdateLoadBalancerRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *UpdateLoadBalancerRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *UpdateLoadBalancerRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.protocol.v, p.protocol.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateLoadBalancerRuleParams) Clone() *UpdateLoadBalancerRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateLoadBalancerRuleParams) Equal(o *UpdateLoadBalancerRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.algorithm == o.algorithm &&
+		p.customid == o.customid &&
+		p.description == o.description &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.protocol == o.protocol
+}
+
+// serializedUpdateLoadBalancerRuleParams is used to (un)marshal UpdateLoadBalancerRuleParams using the API param names
+type serializedUpdateLoadBalancerRuleParams struct {
+	Algorithm   *string `json:"algorithm,omitempty" yaml:"algorithm,omitempty"`
+	Customid    *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Protocol    *string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+}
+
+func (p *UpdateLoadBalancerRuleParams) toSerialized() *serializedUpdateLoadBalancerRuleParams {
+	s := &serializedUpdateLoadBalancerRuleParams{}
+	if p.algorithm.ok {
+		s.Algorithm = &p.algorithm.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	return s
+}
+
+func (p *UpdateLoadBalancerRuleParams) fromSerialized(s *serializedUpdateLoadBalancerRuleParams) {
+	*p = UpdateLoadBalancerRuleParams{}
+	if s.Algorithm != nil {
+		p.SetAlgorithm(*s.Algorithm)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateLoadBalancerRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateLoadBalancerRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateLoadBalancerRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateLoadBalancerRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams {
 	p := &UpdateLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates load balancer
-func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancerRule", p.toURLValues())
+// Updates load balancer.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams, opts ...CallOption) (*UpdateLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest("updateLoadBalancerRule", s.cs.encodeParams("updateLoadBalancerRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6936,8 +10556,8 @@ func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRulePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6985,248 +10605,378 @@ type UpdateLoadBalancerRuleResponse struct {
 }
 
 type UploadSslCertParams struct {
-	p map[string]interface{}
-}
-
-func (p *UploadSslCertParams) toURLValues() url.Values {
+	account                optString
+	certchain              optString
+	certificate            optString
+	domainid               optString
+	enabledrevocationcheck optBool
+	name                   optString
+	password               optString
+	privatekey             optString
+	projectid              optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UploadSslCertParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["certchain"]; found {
-		u.Set("certchain", v.(string))
+	if p.certchain.ok {
+		u.Set("certchain", p.certchain.v)
 	}
-	if v, found := p.p["certificate"]; found {
-		u.Set("certificate", v.(string))
+	if p.certificate.ok {
+		u.Set("certificate", p.certificate.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["enabledrevocationcheck"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabledrevocationcheck", vv)
+	if p.enabledrevocationcheck.ok {
+		u.Set("enabledrevocationcheck", strconv.FormatBool(p.enabledrevocationcheck.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["privatekey"]; found {
-		u.Set("privatekey", v.(string))
+	if p.privatekey.ok {
+		u.Set("privatekey", p.privatekey.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *UploadSslCertParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUploadSslCertParams parses url.Values, for example taken from a raw API request,
+// into a new UploadSslCertParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUploadSslCertParams(u url.Values) (*UploadSslCertParams, error) {
+	p := &UploadSslCertParams{}
+	if err := checkParamNames("uploadSslCert", u, "account", "certchain", "certificate", "domainid", "enabledrevocationcheck", "name", "password", "privatekey", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["certchain"]; found {
+		p.SetCertchain(u.Get("certchain"))
+	}
+	if _, found := u["certificate"]; found {
+		p.SetCertificate(u.Get("certificate"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["enabledrevocationcheck"]; found {
+		v, err := strconv.ParseBool(u.Get("enabledrevocationcheck"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabledrevocationcheck: %v", err)
+		}
+		p.SetEnabledrevocationcheck(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["privatekey"]; found {
+		p.SetPrivatekey(u.Get("privatekey"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *UploadSslCertParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *UploadSslCertParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UploadSslCertParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCertchain sets the certchain param.
 func (p *UploadSslCertParams) SetCertchain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["certchain"] = v
+	p.certchain = optString{v: v, ok: true}
 }
 
+// ResetCertchain unsets the certchain param
 func (p *UploadSslCertParams) ResetCertchain() {
-	if p.p != nil && p.p["certchain"] != nil {
-		delete(p.p, "certchain")
-	}
+	p.certchain = optString{}
 }
 
+// GetCertchain returns the certchain param and if it is set
 func (p *UploadSslCertParams) GetCertchain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["certchain"].(string)
-	return value, ok
+	return p.certchain.v, p.certchain.ok
 }
 
+// SetCertificate sets the certificate param. This param is required.
 func (p *UploadSslCertParams) SetCertificate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["certificate"] = v
+	p.certificate = optString{v: v, ok: true}
 }
 
+// ResetCertificate unsets the certificate param
 func (p *UploadSslCertParams) ResetCertificate() {
-	if p.p != nil && p.p["certificate"] != nil {
-		delete(p.p, "certificate")
-	}
+	p.certificate = optString{}
 }
 
+// GetCertificate returns the certificate param and if it is set
 func (p *UploadSslCertParams) GetCertificate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["certificate"].(string)
-	return value, ok
+	return p.certificate.v, p.certificate.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UploadSslCertParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UploadSslCertParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UploadSslCertParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEnabledrevocationcheck sets the enabledrevocationcheck param.
 func (p *UploadSslCertParams) SetEnabledrevocationcheck(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enabledrevocationcheck"] = v
+	p.enabledrevocationcheck = optBool{v: v, ok: true}
 }
 
+// ResetEnabledrevocationcheck unsets the enabledrevocationcheck param
 func (p *UploadSslCertParams) ResetEnabledrevocationcheck() {
-	if p.p != nil && p.p["enabledrevocationcheck"] != nil {
-		delete(p.p, "enabledrevocationcheck")
-	}
+	p.enabledrevocationcheck = optBool{}
 }
 
+// GetEnabledrevocationcheck returns the enabledrevocationcheck param and if it is set
 func (p *UploadSslCertParams) GetEnabledrevocationcheck() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabledrevocationcheck"].(bool)
-	return value, ok
+	return p.enabledrevocationcheck.v, p.enabledrevocationcheck.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *UploadSslCertParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UploadSslCertParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UploadSslCertParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPassword sets the password param.
 func (p *UploadSslCertParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *UploadSslCertParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *UploadSslCertParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPrivatekey sets the privatekey param. This param is required.
 func (p *UploadSslCertParams) SetPrivatekey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privatekey"] = v
+	p.privatekey = optString{v: v, ok: true}
 }
 
+// ResetPrivatekey unsets the privatekey param
 func (p *UploadSslCertParams) ResetPrivatekey() {
-	if p.p != nil && p.p["privatekey"] != nil {
-		delete(p.p, "privatekey")
-	}
+	p.privatekey = optString{}
 }
 
+// GetPrivatekey returns the privatekey param and if it is set
 func (p *UploadSslCertParams) GetPrivatekey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["privatekey"].(string)
-	return value, ok
+	return p.privatekey.v, p.privatekey.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *UploadSslCertParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *UploadSslCertParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *UploadSslCertParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UploadSslCertParams) Clone() *UploadSslCertParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UploadSslCertParams) Equal(o *UploadSslCertParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.certchain == o.certchain &&
+		p.certificate == o.certificate &&
+		p.domainid == o.domainid &&
+		p.enabledrevocationcheck == o.enabledrevocationcheck &&
+		p.name == o.name &&
+		p.password == o.password &&
+		p.privatekey == o.privatekey &&
+		p.projectid == o.projectid
+}
+
+// serializedUploadSslCertParams is used to (un)marshal UploadSslCertParams using the API param names
+type serializedUploadSslCertParams struct {
+	Account                *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Certchain              *string `json:"certchain,omitempty" yaml:"certchain,omitempty"`
+	Certificate            *string `json:"certificate,omitempty" yaml:"certificate,omitempty"`
+	Domainid               *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enabledrevocationcheck *bool   `json:"enabledrevocationcheck,omitempty" yaml:"enabledrevocationcheck,omitempty"`
+	Name                   *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Password               *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Privatekey             *string `json:"privatekey,omitempty" yaml:"privatekey,omitempty"`
+	Projectid              *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *UploadSslCertParams) toSerialized() *serializedUploadSslCertParams {
+	s := &serializedUploadSslCertParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	if p.certchain.ok {
+		s.Certchain = &p.certchain.v
+	}
+	if p.certificate.ok {
+		s.Certificate = &p.certificate.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.enabledrevocationcheck.ok {
+		s.Enabledrevocationcheck = &p.enabledrevocationcheck.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.privatekey.ok {
+		s.Privatekey = &p.privatekey.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *UploadSslCertParams) fromSerialized(s *serializedUploadSslCertParams) {
+	*p = UploadSslCertParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Certchain != nil {
+		p.SetCertchain(*s.Certchain)
+	}
+	if s.Certificate != nil {
+		p.SetCertificate(*s.Certificate)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Enabledrevocationcheck != nil {
+		p.SetEnabledrevocationcheck(*s.Enabledrevocationcheck)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Privatekey != nil {
+		p.SetPrivatekey(*s.Privatekey)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UploadSslCertParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UploadSslCertParams) UnmarshalJSON(b []byte) error {
+	var s serializedUploadSslCertParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UploadSslCertParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UploadSslCertParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUploadSslCertParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UploadSslCertParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, name string, privatekey string) *UploadSslCertParams {
 	p := &UploadSslCertParams{}
-	p.p = make(map[string]interface{})
-	p.p["certificate"] = certificate
-	p.p["name"] = name
-	p.p["privatekey"] = privatekey
+	p.SetCertificate(certificate)
+	p.SetName(name)
+	p.SetPrivatekey(privatekey)
 	return p
 }
 
-// Upload a certificate to CloudStack
-func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
-	resp, err := s.cs.newRequest("uploadSslCert", p.toURLValues())
+// Upload a certificate to CloudStack.
+//
+// Required params: certificate, name, privatekey.
+func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams, opts ...CallOption) (*UploadSslCertResponse, error) {
+	resp, err := s.cs.newRequest("uploadSslCert", s.cs.encodeParams("uploadSslCert", p), opts...)
 	if err != nil {
 		return nil, err
 	}
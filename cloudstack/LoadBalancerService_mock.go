@@ -53,258 +53,343 @@ func (m *MockLoadBalancerServiceIface) EXPECT() *MockLoadBalancerServiceIfaceMoc
 }
 
 // AddNetscalerLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams, opts ...CallOption) (*AddNetscalerLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddNetscalerLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddNetscalerLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*AddNetscalerLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddNetscalerLoadBalancer indicates an expected call of AddNetscalerLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) AddNetscalerLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AddNetscalerLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetscalerLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AddNetscalerLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetscalerLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AddNetscalerLoadBalancer), varargs...)
 }
 
 // AssignCertToLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams, opts ...CallOption) (*AssignCertToLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssignCertToLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignCertToLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*AssignCertToLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssignCertToLoadBalancer indicates an expected call of AssignCertToLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignCertToLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignCertToLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCertToLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignCertToLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCertToLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignCertToLoadBalancer), varargs...)
 }
 
 // AssignToGlobalLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams, opts ...CallOption) (*AssignToGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssignToGlobalLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignToGlobalLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*AssignToGlobalLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssignToGlobalLoadBalancerRule indicates an expected call of AssignToGlobalLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToGlobalLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToGlobalLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToGlobalLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToGlobalLoadBalancerRule), varargs...)
 }
 
 // AssignToLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams, opts ...CallOption) (*AssignToLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssignToLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignToLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*AssignToLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssignToLoadBalancerRule indicates an expected call of AssignToLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToLoadBalancerRule), varargs...)
 }
 
 // ConfigureNetscalerLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams, opts ...CallOption) (*NetscalerLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigureNetscalerLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfigureNetscalerLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*NetscalerLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfigureNetscalerLoadBalancer indicates an expected call of ConfigureNetscalerLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ConfigureNetscalerLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ConfigureNetscalerLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureNetscalerLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ConfigureNetscalerLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureNetscalerLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ConfigureNetscalerLoadBalancer), varargs...)
 }
 
 // CreateGlobalLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams, opts ...CallOption) (*CreateGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGlobalLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGlobalLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*CreateGlobalLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateGlobalLoadBalancerRule indicates an expected call of CreateGlobalLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateGlobalLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateGlobalLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateGlobalLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateGlobalLoadBalancerRule), varargs...)
 }
 
 // CreateLBHealthCheckPolicy mocks base method.
-func (m *MockLoadBalancerServiceIface) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+func (m *MockLoadBalancerServiceIface) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams, opts ...CallOption) (*CreateLBHealthCheckPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLBHealthCheckPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateLBHealthCheckPolicy", varargs...)
 	ret0, _ := ret[0].(*CreateLBHealthCheckPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLBHealthCheckPolicy indicates an expected call of CreateLBHealthCheckPolicy.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBHealthCheckPolicy(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBHealthCheckPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBHealthCheckPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBHealthCheckPolicy), varargs...)
 }
 
 // CreateLBStickinessPolicy mocks base method.
-func (m *MockLoadBalancerServiceIface) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+func (m *MockLoadBalancerServiceIface) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams, opts ...CallOption) (*CreateLBStickinessPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLBStickinessPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateLBStickinessPolicy", varargs...)
 	ret0, _ := ret[0].(*CreateLBStickinessPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLBStickinessPolicy indicates an expected call of CreateLBStickinessPolicy.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBStickinessPolicy(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBStickinessPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBStickinessPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBStickinessPolicy), varargs...)
 }
 
 // CreateLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) CreateLoadBalancer(p *CreateLoadBalancerParams, opts ...CallOption) (*CreateLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*CreateLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLoadBalancer indicates an expected call of CreateLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancer), varargs...)
 }
 
 // CreateLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams, opts ...CallOption) (*CreateLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*CreateLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLoadBalancerRule indicates an expected call of CreateLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancerRule), varargs...)
 }
 
 // DeleteGlobalLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams, opts ...CallOption) (*DeleteGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGlobalLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteGlobalLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*DeleteGlobalLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteGlobalLoadBalancerRule indicates an expected call of DeleteGlobalLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteGlobalLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteGlobalLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteGlobalLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteGlobalLoadBalancerRule), varargs...)
 }
 
 // DeleteLBHealthCheckPolicy mocks base method.
-func (m *MockLoadBalancerServiceIface) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+func (m *MockLoadBalancerServiceIface) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams, opts ...CallOption) (*DeleteLBHealthCheckPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLBHealthCheckPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLBHealthCheckPolicy", varargs...)
 	ret0, _ := ret[0].(*DeleteLBHealthCheckPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLBHealthCheckPolicy indicates an expected call of DeleteLBHealthCheckPolicy.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBHealthCheckPolicy(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBHealthCheckPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBHealthCheckPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBHealthCheckPolicy), varargs...)
 }
 
 // DeleteLBStickinessPolicy mocks base method.
-func (m *MockLoadBalancerServiceIface) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+func (m *MockLoadBalancerServiceIface) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams, opts ...CallOption) (*DeleteLBStickinessPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLBStickinessPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLBStickinessPolicy", varargs...)
 	ret0, _ := ret[0].(*DeleteLBStickinessPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLBStickinessPolicy indicates an expected call of DeleteLBStickinessPolicy.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBStickinessPolicy(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBStickinessPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBStickinessPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBStickinessPolicy), varargs...)
 }
 
 // DeleteLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) DeleteLoadBalancer(p *DeleteLoadBalancerParams, opts ...CallOption) (*DeleteLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*DeleteLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLoadBalancer indicates an expected call of DeleteLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancer), varargs...)
 }
 
 // DeleteLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams, opts ...CallOption) (*DeleteLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*DeleteLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLoadBalancerRule indicates an expected call of DeleteLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancerRule), varargs...)
 }
 
 // DeleteNetscalerLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams, opts ...CallOption) (*DeleteNetscalerLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNetscalerLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetscalerLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*DeleteNetscalerLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNetscalerLoadBalancer indicates an expected call of DeleteNetscalerLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteNetscalerLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteNetscalerLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetscalerLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteNetscalerLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetscalerLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteNetscalerLoadBalancer), varargs...)
 }
 
 // DeleteSslCert mocks base method.
-func (m *MockLoadBalancerServiceIface) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+func (m *MockLoadBalancerServiceIface) DeleteSslCert(p *DeleteSslCertParams, opts ...CallOption) (*DeleteSslCertResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSslCert", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSslCert", varargs...)
 	ret0, _ := ret[0].(*DeleteSslCertResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteSslCert indicates an expected call of DeleteSslCert.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteSslCert(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteSslCert(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSslCert", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteSslCert), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSslCert", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteSslCert), varargs...)
 }
 
 // GetGlobalLoadBalancerRuleByID mocks base method.
@@ -560,123 +645,163 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleInstanceB
 }
 
 // ListGlobalLoadBalancerRules mocks base method.
-func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams, opts ...CallOption) (*ListGlobalLoadBalancerRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRules", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRules", varargs...)
 	ret0, _ := ret[0].(*ListGlobalLoadBalancerRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListGlobalLoadBalancerRules indicates an expected call of ListGlobalLoadBalancerRules.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRules(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRules(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRules), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRules), varargs...)
 }
 
 // ListLBHealthCheckPolicies mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams, opts ...CallOption) (*ListLBHealthCheckPoliciesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLBHealthCheckPolicies", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBHealthCheckPolicies", varargs...)
 	ret0, _ := ret[0].(*ListLBHealthCheckPoliciesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListLBHealthCheckPolicies indicates an expected call of ListLBHealthCheckPolicies.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPolicies(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPolicies(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPolicies), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPolicies), varargs...)
 }
 
 // ListLBStickinessPolicies mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams, opts ...CallOption) (*ListLBStickinessPoliciesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLBStickinessPolicies", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBStickinessPolicies", varargs...)
 	ret0, _ := ret[0].(*ListLBStickinessPoliciesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListLBStickinessPolicies indicates an expected call of ListLBStickinessPolicies.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPolicies(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPolicies(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPolicies), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPolicies), varargs...)
 }
 
 // ListLoadBalancerRuleInstances mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams, opts ...CallOption) (*ListLoadBalancerRuleInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLoadBalancerRuleInstances", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancerRuleInstances", varargs...)
 	ret0, _ := ret[0].(*ListLoadBalancerRuleInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListLoadBalancerRuleInstances indicates an expected call of ListLoadBalancerRuleInstances.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRuleInstances(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRuleInstances(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRuleInstances", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRuleInstances), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRuleInstances", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRuleInstances), varargs...)
 }
 
 // ListLoadBalancerRules mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRules(p *ListLoadBalancerRulesParams, opts ...CallOption) (*ListLoadBalancerRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLoadBalancerRules", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancerRules", varargs...)
 	ret0, _ := ret[0].(*ListLoadBalancerRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListLoadBalancerRules indicates an expected call of ListLoadBalancerRules.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRules(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRules(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRules), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRules), varargs...)
 }
 
 // ListLoadBalancers mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListLoadBalancers(p *ListLoadBalancersParams, opts ...CallOption) (*ListLoadBalancersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLoadBalancers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancers", varargs...)
 	ret0, _ := ret[0].(*ListLoadBalancersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListLoadBalancers indicates an expected call of ListLoadBalancers.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancers(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancers", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancers", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancers), varargs...)
 }
 
 // ListNetscalerLoadBalancers mocks base method.
-func (m *MockLoadBalancerServiceIface) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams, opts ...CallOption) (*ListNetscalerLoadBalancersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancers", varargs...)
 	ret0, _ := ret[0].(*ListNetscalerLoadBalancersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetscalerLoadBalancers indicates an expected call of ListNetscalerLoadBalancers.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListNetscalerLoadBalancers(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListNetscalerLoadBalancers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancers", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListNetscalerLoadBalancers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancers", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListNetscalerLoadBalancers), varargs...)
 }
 
 // ListSslCerts mocks base method.
-func (m *MockLoadBalancerServiceIface) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+func (m *MockLoadBalancerServiceIface) ListSslCerts(p *ListSslCertsParams, opts ...CallOption) (*ListSslCertsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSslCerts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSslCerts", varargs...)
 	ret0, _ := ret[0].(*ListSslCertsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSslCerts indicates an expected call of ListSslCerts.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListSslCerts(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListSslCerts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSslCerts", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListSslCerts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSslCerts", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListSslCerts), varargs...)
 }
 
 // NewAddNetscalerLoadBalancerParams mocks base method.
@@ -1156,136 +1281,181 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) NewUploadSslCertParams(certi
 }
 
 // RemoveCertFromLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams, opts ...CallOption) (*RemoveCertFromLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveCertFromLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveCertFromLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*RemoveCertFromLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveCertFromLoadBalancer indicates an expected call of RemoveCertFromLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveCertFromLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveCertFromLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCertFromLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveCertFromLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCertFromLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveCertFromLoadBalancer), varargs...)
 }
 
 // RemoveFromGlobalLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams, opts ...CallOption) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveFromGlobalLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveFromGlobalLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*RemoveFromGlobalLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveFromGlobalLoadBalancerRule indicates an expected call of RemoveFromGlobalLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromGlobalLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromGlobalLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromGlobalLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromGlobalLoadBalancerRule), varargs...)
 }
 
 // RemoveFromLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams, opts ...CallOption) (*RemoveFromLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveFromLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveFromLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*RemoveFromLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveFromLoadBalancerRule indicates an expected call of RemoveFromLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromLoadBalancerRule), varargs...)
 }
 
 // UpdateGlobalLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams, opts ...CallOption) (*UpdateGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGlobalLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGlobalLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*UpdateGlobalLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateGlobalLoadBalancerRule indicates an expected call of UpdateGlobalLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateGlobalLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateGlobalLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateGlobalLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateGlobalLoadBalancerRule), varargs...)
 }
 
 // UpdateLBHealthCheckPolicy mocks base method.
-func (m *MockLoadBalancerServiceIface) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+func (m *MockLoadBalancerServiceIface) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams, opts ...CallOption) (*UpdateLBHealthCheckPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLBHealthCheckPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLBHealthCheckPolicy", varargs...)
 	ret0, _ := ret[0].(*UpdateLBHealthCheckPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateLBHealthCheckPolicy indicates an expected call of UpdateLBHealthCheckPolicy.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBHealthCheckPolicy(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBHealthCheckPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBHealthCheckPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBHealthCheckPolicy), varargs...)
 }
 
 // UpdateLBStickinessPolicy mocks base method.
-func (m *MockLoadBalancerServiceIface) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+func (m *MockLoadBalancerServiceIface) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams, opts ...CallOption) (*UpdateLBStickinessPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLBStickinessPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLBStickinessPolicy", varargs...)
 	ret0, _ := ret[0].(*UpdateLBStickinessPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateLBStickinessPolicy indicates an expected call of UpdateLBStickinessPolicy.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBStickinessPolicy(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBStickinessPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBStickinessPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBStickinessPolicy), varargs...)
 }
 
 // UpdateLoadBalancer mocks base method.
-func (m *MockLoadBalancerServiceIface) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
+func (m *MockLoadBalancerServiceIface) UpdateLoadBalancer(p *UpdateLoadBalancerParams, opts ...CallOption) (*UpdateLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLoadBalancer", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLoadBalancer", varargs...)
 	ret0, _ := ret[0].(*UpdateLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateLoadBalancer indicates an expected call of UpdateLoadBalancer.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancer(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancer(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancer), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancer), varargs...)
 }
 
 // UpdateLoadBalancerRule mocks base method.
-func (m *MockLoadBalancerServiceIface) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+func (m *MockLoadBalancerServiceIface) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams, opts ...CallOption) (*UpdateLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateLoadBalancerRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerRule", varargs...)
 	ret0, _ := ret[0].(*UpdateLoadBalancerRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateLoadBalancerRule indicates an expected call of UpdateLoadBalancerRule.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancerRule(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancerRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancerRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancerRule), varargs...)
 }
 
 // UploadSslCert mocks base method.
-func (m *MockLoadBalancerServiceIface) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+func (m *MockLoadBalancerServiceIface) UploadSslCert(p *UploadSslCertParams, opts ...CallOption) (*UploadSslCertResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadSslCert", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadSslCert", varargs...)
 	ret0, _ := ret[0].(*UploadSslCertResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadSslCert indicates an expected call of UploadSslCert.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) UploadSslCert(p interface{}) *gomock.Call {
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UploadSslCert(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSslCert", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UploadSslCert), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSslCert", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UploadSslCert), varargs...)
 }
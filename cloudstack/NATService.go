@@ -28,193 +28,313 @@ import (
 )
 
 type NATServiceIface interface {
-	CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRule(p *CreateIpForwardingRuleParams, opts ...CallOption) (*CreateIpForwardingRuleResponse, error)
 	NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams
-	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams, opts ...CallOption) (*DeleteIpForwardingRuleResponse, error)
 	NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams
-	DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNat(p *DisableStaticNatParams, opts ...CallOption) (*DisableStaticNatResponse, error)
 	NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams
-	EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+	EnableStaticNat(p *EnableStaticNatParams, opts ...CallOption) (*EnableStaticNatResponse, error)
 	NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams
-	ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRules(p *ListIpForwardingRulesParams, opts ...CallOption) (*ListIpForwardingRulesResponse, error)
 	NewListIpForwardingRulesParams() *ListIpForwardingRulesParams
 	GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
 }
 
 type CreateIpForwardingRuleParams struct {
-	p map[string]interface{}
+	cidrlist     optStrings
+	endport      optInt
+	ipaddressid  optString
+	openfirewall optBool
+	protocol     optString
+	startport    optInt
 }
 
-func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateIpForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
+	if p.openfirewall.ok {
+		u.Set("openfirewall", strconv.FormatBool(p.openfirewall.v))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
 	return u
 }
 
-func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateIpForwardingRuleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateIpForwardingRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateIpForwardingRuleParams(u url.Values) (*CreateIpForwardingRuleParams, error) {
+	p := &CreateIpForwardingRuleParams{}
+	if err := checkParamNames("createIpForwardingRule", u, "cidrlist", "endport", "ipaddressid", "openfirewall", "protocol", "startport"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
 	}
-	p.p["cidrlist"] = v
+	if _, found := u["openfirewall"]; found {
+		v, err := strconv.ParseBool(u.Get("openfirewall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param openfirewall: %v", err)
+		}
+		p.SetOpenfirewall(v)
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	return p, nil
 }
 
+// SetCidrlist sets the cidrlist param.
+func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
+	p.cidrlist = optStrings{v: v, ok: true}
+}
+
+// ResetCidrlist unsets the cidrlist param
 func (p *CreateIpForwardingRuleParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreateIpForwardingRuleParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetEndport sets the endport param.
 func (p *CreateIpForwardingRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *CreateIpForwardingRuleParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *CreateIpForwardingRuleParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetIpaddressid sets the ipaddressid param. This param is required.
 func (p *CreateIpForwardingRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *CreateIpForwardingRuleParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *CreateIpForwardingRuleParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetOpenfirewall sets the openfirewall param.
 func (p *CreateIpForwardingRuleParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["openfirewall"] = v
+	p.openfirewall = optBool{v: v, ok: true}
 }
 
+// ResetOpenfirewall unsets the openfirewall param
 func (p *CreateIpForwardingRuleParams) ResetOpenfirewall() {
-	if p.p != nil && p.p["openfirewall"] != nil {
-		delete(p.p, "openfirewall")
-	}
+	p.openfirewall = optBool{}
 }
 
+// GetOpenfirewall returns the openfirewall param and if it is set
 func (p *CreateIpForwardingRuleParams) GetOpenfirewall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["openfirewall"].(bool)
-	return value, ok
+	return p.openfirewall.v, p.openfirewall.ok
 }
 
+// SetProtocol sets the protocol param. This param is required.
 func (p *CreateIpForwardingRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *CreateIpForwardingRuleParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *CreateIpForwardingRuleParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetStartport sets the startport param. This param is required.
 func (p *CreateIpForwardingRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *CreateIpForwardingRuleParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *CreateIpForwardingRuleParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.startport.v, p.startport.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateIpForwardingRuleParams) Clone() *CreateIpForwardingRuleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateIpForwardingRuleParams) Equal(o *CreateIpForwardingRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.cidrlist.equal(o.cidrlist) &&
+		p.endport == o.endport &&
+		p.ipaddressid == o.ipaddressid &&
+		p.openfirewall == o.openfirewall &&
+		p.protocol == o.protocol &&
+		p.startport == o.startport
+}
+
+// serializedCreateIpForwardingRuleParams is used to (un)marshal CreateIpForwardingRuleParams using the API param names
+type serializedCreateIpForwardingRuleParams struct {
+	Cidrlist     *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Endport      *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Ipaddressid  *string   `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Openfirewall *bool     `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Protocol     *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport    *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+}
+
+func (p *CreateIpForwardingRuleParams) toSerialized() *serializedCreateIpForwardingRuleParams {
+	s := &serializedCreateIpForwardingRuleParams{}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.openfirewall.ok {
+		s.Openfirewall = &p.openfirewall.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	return s
+}
+
+func (p *CreateIpForwardingRuleParams) fromSerialized(s *serializedCreateIpForwardingRuleParams) {
+	*p = CreateIpForwardingRuleParams{}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+	if s.Openfirewall != nil {
+		p.SetOpenfirewall(*s.Openfirewall)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateIpForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateIpForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateIpForwardingRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateIpForwardingRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateIpForwardingRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateIpForwardingRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateIpForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams {
 	p := &CreateIpForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["protocol"] = protocol
-	p.p["startport"] = startport
+	p.SetIpaddressid(ipaddressid)
+	p.SetProtocol(protocol)
+	p.SetStartport(startport)
 	return p
 }
 
-// Creates an IP forwarding rule
-func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createIpForwardingRule", p.toURLValues())
+// Creates an IP forwarding rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: ipaddressid, protocol,
+// startport.
+func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams, opts ...CallOption) (*CreateIpForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest("createIpForwardingRule", s.cs.encodeParams("createIpForwardingRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -225,8 +345,8 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -270,53 +390,133 @@ type CreateIpForwardingRuleResponse struct {
 }
 
 type DeleteIpForwardingRuleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteIpForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteIpForwardingRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteIpForwardingRuleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteIpForwardingRuleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteIpForwardingRuleParams(u url.Values) (*DeleteIpForwardingRuleParams, error) {
+	p := &DeleteIpForwardingRuleParams{}
+	if err := checkParamNames("deleteIpForwardingRule", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteIpForwardingRuleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteIpForwardingRuleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteIpForwardingRuleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteIpForwardingRuleParams) Clone() *DeleteIpForwardingRuleParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteIpForwardingRuleParams) Equal(o *DeleteIpForwardingRuleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteIpForwardingRuleParams is used to (un)marshal DeleteIpForwardingRuleParams using the API param names
+type serializedDeleteIpForwardingRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteIpForwardingRuleParams) toSerialized() *serializedDeleteIpForwardingRuleParams {
+	s := &serializedDeleteIpForwardingRuleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteIpForwardingRuleParams) fromSerialized(s *serializedDeleteIpForwardingRuleParams) {
+	*p = DeleteIpForwardingRuleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteIpForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteIpForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteIpForwardingRuleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteIpForwardingRuleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteIpForwardingRuleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteIpForwardingRuleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteIpForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams {
 	p := &DeleteIpForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes an IP forwarding rule
-func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteIpForwardingRule", p.toURLValues())
+// Deletes an IP forwarding rule.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams, opts ...CallOption) (*DeleteIpForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest("deleteIpForwardingRule", s.cs.encodeParams("deleteIpForwardingRule", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -327,8 +527,8 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -352,53 +552,133 @@ type DeleteIpForwardingRuleResponse struct {
 }
 
 type DisableStaticNatParams struct {
-	p map[string]interface{}
+	ipaddressid optString
 }
 
-func (p *DisableStaticNatParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableStaticNatParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
 	return u
 }
 
-func (p *DisableStaticNatParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableStaticNatParams parses url.Values, for example taken from a raw API request,
+// into a new DisableStaticNatParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableStaticNatParams(u url.Values) (*DisableStaticNatParams, error) {
+	p := &DisableStaticNatParams{}
+	if err := checkParamNames("disableStaticNat", u, "ipaddressid"); err != nil {
+		return nil, err
 	}
-	p.p["ipaddressid"] = v
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	return p, nil
+}
+
+// SetIpaddressid sets the ipaddressid param. This param is required.
+func (p *DisableStaticNatParams) SetIpaddressid(v string) {
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *DisableStaticNatParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *DisableStaticNatParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ipaddressid.v, p.ipaddressid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableStaticNatParams) Clone() *DisableStaticNatParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableStaticNatParams) Equal(o *DisableStaticNatParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.ipaddressid == o.ipaddressid
+}
+
+// serializedDisableStaticNatParams is used to (un)marshal DisableStaticNatParams using the API param names
+type serializedDisableStaticNatParams struct {
+	Ipaddressid *string `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+}
+
+func (p *DisableStaticNatParams) toSerialized() *serializedDisableStaticNatParams {
+	s := &serializedDisableStaticNatParams{}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	return s
+}
+
+func (p *DisableStaticNatParams) fromSerialized(s *serializedDisableStaticNatParams) {
+	*p = DisableStaticNatParams{}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableStaticNatParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableStaticNatParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableStaticNatParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableStaticNatParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableStaticNatParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableStaticNatParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableStaticNatParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams {
 	p := &DisableStaticNatParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
+	p.SetIpaddressid(ipaddressid)
 	return p
 }
 
-// Disables static rule for given IP address
-func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("disableStaticNat", p.toURLValues())
+// Disables static rule for given IP address.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: ipaddressid.
+func (s *NATService) DisableStaticNat(p *DisableStaticNatParams, opts ...CallOption) (*DisableStaticNatResponse, error) {
+	resp, err := s.cs.newRequest("disableStaticNat", s.cs.encodeParams("disableStaticNat", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -409,8 +689,8 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -434,126 +714,223 @@ type DisableStaticNatResponse struct {
 }
 
 type EnableStaticNatParams struct {
-	p map[string]interface{}
+	ipaddressid      optString
+	networkid        optString
+	virtualmachineid optString
+	vmguestip        optString
 }
 
-func (p *EnableStaticNatParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableStaticNatParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["vmguestip"]; found {
-		u.Set("vmguestip", v.(string))
+	if p.vmguestip.ok {
+		u.Set("vmguestip", p.vmguestip.v)
 	}
 	return u
 }
 
-func (p *EnableStaticNatParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableStaticNatParams parses url.Values, for example taken from a raw API request,
+// into a new EnableStaticNatParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableStaticNatParams(u url.Values) (*EnableStaticNatParams, error) {
+	p := &EnableStaticNatParams{}
+	if err := checkParamNames("enableStaticNat", u, "ipaddressid", "networkid", "virtualmachineid", "vmguestip"); err != nil {
+		return nil, err
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
 	}
-	p.p["ipaddressid"] = v
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["vmguestip"]; found {
+		p.SetVmguestip(u.Get("vmguestip"))
+	}
+	return p, nil
 }
 
+// SetIpaddressid sets the ipaddressid param. This param is required.
+func (p *EnableStaticNatParams) SetIpaddressid(v string) {
+	p.ipaddressid = optString{v: v, ok: true}
+}
+
+// ResetIpaddressid unsets the ipaddressid param
 func (p *EnableStaticNatParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *EnableStaticNatParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *EnableStaticNatParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *EnableStaticNatParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *EnableStaticNatParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *EnableStaticNatParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *EnableStaticNatParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *EnableStaticNatParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetVmguestip sets the vmguestip param.
 func (p *EnableStaticNatParams) SetVmguestip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmguestip"] = v
+	p.vmguestip = optString{v: v, ok: true}
 }
 
+// ResetVmguestip unsets the vmguestip param
 func (p *EnableStaticNatParams) ResetVmguestip() {
-	if p.p != nil && p.p["vmguestip"] != nil {
-		delete(p.p, "vmguestip")
-	}
+	p.vmguestip = optString{}
 }
 
+// GetVmguestip returns the vmguestip param and if it is set
 func (p *EnableStaticNatParams) GetVmguestip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmguestip.v, p.vmguestip.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableStaticNatParams) Clone() *EnableStaticNatParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["vmguestip"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableStaticNatParams) Equal(o *EnableStaticNatParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.ipaddressid == o.ipaddressid &&
+		p.networkid == o.networkid &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.vmguestip == o.vmguestip
+}
+
+// serializedEnableStaticNatParams is used to (un)marshal EnableStaticNatParams using the API param names
+type serializedEnableStaticNatParams struct {
+	Ipaddressid      *string `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Networkid        *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Vmguestip        *string `json:"vmguestip,omitempty" yaml:"vmguestip,omitempty"`
+}
+
+func (p *EnableStaticNatParams) toSerialized() *serializedEnableStaticNatParams {
+	s := &serializedEnableStaticNatParams{}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.vmguestip.ok {
+		s.Vmguestip = &p.vmguestip.v
+	}
+	return s
+}
+
+func (p *EnableStaticNatParams) fromSerialized(s *serializedEnableStaticNatParams) {
+	*p = EnableStaticNatParams{}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Vmguestip != nil {
+		p.SetVmguestip(*s.Vmguestip)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableStaticNatParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableStaticNatParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableStaticNatParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableStaticNatParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableStaticNatParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableStaticNatParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableStaticNatParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams {
 	p := &EnableStaticNatParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetIpaddressid(ipaddressid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Enables static NAT for given IP address
-func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("enableStaticNat", p.toURLValues())
+// Enables static NAT for given IP address.
+//
+// Required params: ipaddressid, virtualmachineid.
+func (s *NATService) EnableStaticNat(p *EnableStaticNatParams, opts ...CallOption) (*EnableStaticNatResponse, error) {
+	resp, err := s.cs.newRequest("enableStaticNat", s.cs.encodeParams("enableStaticNat", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -601,299 +978,447 @@ func (r *EnableStaticNatResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListIpForwardingRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
+	account          optString
+	domainid         optString
+	id               optString
+	ipaddressid      optString
+	isrecursive      optBool
+	keyword          optString
+	listall          optBool
+	page             optInt
+	pagesize         optInt
+	projectid        optString
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListIpForwardingRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if p.ipaddressid.ok {
+		u.Set("ipaddressid", p.ipaddressid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *ListIpForwardingRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListIpForwardingRulesParams parses url.Values, for example taken from a raw API request,
+// into a new ListIpForwardingRulesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListIpForwardingRulesParams(u url.Values) (*ListIpForwardingRulesParams, error) {
+	p := &ListIpForwardingRulesParams{}
+	if err := checkParamNames("listIpForwardingRules", u, "account", "domainid", "id", "ipaddressid", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ipaddressid"]; found {
+		p.SetIpaddressid(u.Get("ipaddressid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
 	}
-	p.p["account"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListIpForwardingRulesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListIpForwardingRulesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListIpForwardingRulesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListIpForwardingRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListIpForwardingRulesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListIpForwardingRulesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListIpForwardingRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListIpForwardingRulesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListIpForwardingRulesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddressid sets the ipaddressid param.
 func (p *ListIpForwardingRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.ipaddressid = optString{v: v, ok: true}
 }
 
+// ResetIpaddressid unsets the ipaddressid param
 func (p *ListIpForwardingRulesParams) ResetIpaddressid() {
-	if p.p != nil && p.p["ipaddressid"] != nil {
-		delete(p.p, "ipaddressid")
-	}
+	p.ipaddressid = optString{}
 }
 
+// GetIpaddressid returns the ipaddressid param and if it is set
 func (p *ListIpForwardingRulesParams) GetIpaddressid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddressid"].(string)
-	return value, ok
+	return p.ipaddressid.v, p.ipaddressid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListIpForwardingRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListIpForwardingRulesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListIpForwardingRulesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListIpForwardingRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListIpForwardingRulesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListIpForwardingRulesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListIpForwardingRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListIpForwardingRulesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListIpForwardingRulesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListIpForwardingRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListIpForwardingRulesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListIpForwardingRulesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListIpForwardingRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListIpForwardingRulesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListIpForwardingRulesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListIpForwardingRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListIpForwardingRulesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListIpForwardingRulesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListIpForwardingRulesParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListIpForwardingRulesParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListIpForwardingRulesParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListIpForwardingRulesParams) Clone() *ListIpForwardingRulesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListIpForwardingRulesParams) Equal(o *ListIpForwardingRulesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.ipaddressid == o.ipaddressid &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedListIpForwardingRulesParams is used to (un)marshal ListIpForwardingRulesParams using the API param names
+type serializedListIpForwardingRulesParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid      *string `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive      *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *ListIpForwardingRulesParams) toSerialized() *serializedListIpForwardingRulesParams {
+	s := &serializedListIpForwardingRulesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddressid.ok {
+		s.Ipaddressid = &p.ipaddressid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *ListIpForwardingRulesParams) fromSerialized(s *serializedListIpForwardingRulesParams) {
+	*p = ListIpForwardingRulesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddressid != nil {
+		p.SetIpaddressid(*s.Ipaddressid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListIpForwardingRulesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListIpForwardingRulesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListIpForwardingRulesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListIpForwardingRulesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListIpForwardingRulesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListIpForwardingRulesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListIpForwardingRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewListIpForwardingRulesParams() *ListIpForwardingRulesParams {
 	p := &ListIpForwardingRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
 	p := &ListIpForwardingRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -921,9 +1446,9 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 	return nil, l.Count, fmt.Errorf("There is more then one result for IpForwardingRule UUID: %s!", id)
 }
 
-// List the IP forwarding rules
-func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listIpForwardingRules", p.toURLValues())
+// List the IP forwarding rules.
+func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams, opts ...CallOption) (*ListIpForwardingRulesResponse, error) {
+	resp, err := s.cs.newRequest("listIpForwardingRules", s.cs.encodeParams("listIpForwardingRules", p), opts...)
 	if err != nil {
 		return nil, err
 	}
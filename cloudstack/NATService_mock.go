@@ -53,63 +53,83 @@ func (m *MockNATServiceIface) EXPECT() *MockNATServiceIfaceMockRecorder {
 }
 
 // CreateIpForwardingRule mocks base method.
-func (m *MockNATServiceIface) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
+func (m *MockNATServiceIface) CreateIpForwardingRule(p *CreateIpForwardingRuleParams, opts ...CallOption) (*CreateIpForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateIpForwardingRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateIpForwardingRule", varargs...)
 	ret0, _ := ret[0].(*CreateIpForwardingRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateIpForwardingRule indicates an expected call of CreateIpForwardingRule.
-func (mr *MockNATServiceIfaceMockRecorder) CreateIpForwardingRule(p interface{}) *gomock.Call {
+func (mr *MockNATServiceIfaceMockRecorder) CreateIpForwardingRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpForwardingRule", reflect.TypeOf((*MockNATServiceIface)(nil).CreateIpForwardingRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpForwardingRule", reflect.TypeOf((*MockNATServiceIface)(nil).CreateIpForwardingRule), varargs...)
 }
 
 // DeleteIpForwardingRule mocks base method.
-func (m *MockNATServiceIface) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
+func (m *MockNATServiceIface) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams, opts ...CallOption) (*DeleteIpForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteIpForwardingRule", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIpForwardingRule", varargs...)
 	ret0, _ := ret[0].(*DeleteIpForwardingRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteIpForwardingRule indicates an expected call of DeleteIpForwardingRule.
-func (mr *MockNATServiceIfaceMockRecorder) DeleteIpForwardingRule(p interface{}) *gomock.Call {
+func (mr *MockNATServiceIfaceMockRecorder) DeleteIpForwardingRule(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpForwardingRule", reflect.TypeOf((*MockNATServiceIface)(nil).DeleteIpForwardingRule), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpForwardingRule", reflect.TypeOf((*MockNATServiceIface)(nil).DeleteIpForwardingRule), varargs...)
 }
 
 // DisableStaticNat mocks base method.
-func (m *MockNATServiceIface) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
+func (m *MockNATServiceIface) DisableStaticNat(p *DisableStaticNatParams, opts ...CallOption) (*DisableStaticNatResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableStaticNat", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableStaticNat", varargs...)
 	ret0, _ := ret[0].(*DisableStaticNatResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableStaticNat indicates an expected call of DisableStaticNat.
-func (mr *MockNATServiceIfaceMockRecorder) DisableStaticNat(p interface{}) *gomock.Call {
+func (mr *MockNATServiceIfaceMockRecorder) DisableStaticNat(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableStaticNat", reflect.TypeOf((*MockNATServiceIface)(nil).DisableStaticNat), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableStaticNat", reflect.TypeOf((*MockNATServiceIface)(nil).DisableStaticNat), varargs...)
 }
 
 // EnableStaticNat mocks base method.
-func (m *MockNATServiceIface) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+func (m *MockNATServiceIface) EnableStaticNat(p *EnableStaticNatParams, opts ...CallOption) (*EnableStaticNatResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableStaticNat", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableStaticNat", varargs...)
 	ret0, _ := ret[0].(*EnableStaticNatResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableStaticNat indicates an expected call of EnableStaticNat.
-func (mr *MockNATServiceIfaceMockRecorder) EnableStaticNat(p interface{}) *gomock.Call {
+func (mr *MockNATServiceIfaceMockRecorder) EnableStaticNat(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStaticNat", reflect.TypeOf((*MockNATServiceIface)(nil).EnableStaticNat), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStaticNat", reflect.TypeOf((*MockNATServiceIface)(nil).EnableStaticNat), varargs...)
 }
 
 // GetIpForwardingRuleByID mocks base method.
@@ -134,18 +154,23 @@ func (mr *MockNATServiceIfaceMockRecorder) GetIpForwardingRuleByID(id interface{
 }
 
 // ListIpForwardingRules mocks base method.
-func (m *MockNATServiceIface) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+func (m *MockNATServiceIface) ListIpForwardingRules(p *ListIpForwardingRulesParams, opts ...CallOption) (*ListIpForwardingRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIpForwardingRules", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpForwardingRules", varargs...)
 	ret0, _ := ret[0].(*ListIpForwardingRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListIpForwardingRules indicates an expected call of ListIpForwardingRules.
-func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRules(p interface{}) *gomock.Call {
+func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRules(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRules", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRules), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRules", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRules), varargs...)
 }
 
 // NewCreateIpForwardingRuleParams mocks base method.
@@ -28,373 +28,543 @@ import (
 )
 
 type NetworkACLServiceIface interface {
-	CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	CreateNetworkACL(p *CreateNetworkACLParams, opts ...CallOption) (*CreateNetworkACLResponse, error)
 	NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams
-	CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLList(p *CreateNetworkACLListParams, opts ...CallOption) (*CreateNetworkACLListResponse, error)
 	NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams
-	DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACL(p *DeleteNetworkACLParams, opts ...CallOption) (*DeleteNetworkACLResponse, error)
 	NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams
-	DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLList(p *DeleteNetworkACLListParams, opts ...CallOption) (*DeleteNetworkACLListResponse, error)
 	NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams
-	ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLLists(p *ListNetworkACLListsParams, opts ...CallOption) (*ListNetworkACLListsResponse, error)
 	NewListNetworkACLListsParams() *ListNetworkACLListsParams
 	GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error)
 	GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error)
 	GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error)
-	ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	ListNetworkACLs(p *ListNetworkACLsParams, opts ...CallOption) (*ListNetworkACLsResponse, error)
 	NewListNetworkACLsParams() *ListNetworkACLsParams
 	GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error)
-	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams, opts ...CallOption) (*ReplaceNetworkACLListResponse, error)
 	NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams
-	UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItem(p *UpdateNetworkACLItemParams, opts ...CallOption) (*UpdateNetworkACLItemResponse, error)
 	NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams
-	UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLList(p *UpdateNetworkACLListParams, opts ...CallOption) (*UpdateNetworkACLListResponse, error)
 	NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams
 }
 
 type CreateNetworkACLParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateNetworkACLParams) toURLValues() url.Values {
+	aclid       optString
+	action      optString
+	cidrlist    optStrings
+	endport     optInt
+	fordisplay  optBool
+	icmpcode    optInt
+	icmptype    optInt
+	networkid   optString
+	number      optInt
+	protocol    optString
+	reason      optString
+	startport   optInt
+	traffictype optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateNetworkACLParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if p.aclid.ok {
+		u.Set("aclid", p.aclid.v)
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if p.action.ok {
+		u.Set("action", p.action.v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["number"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("number", vv)
+	if p.number.ok {
+		u.Set("number", strconv.Itoa(p.number.v))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["reason"]; found {
-		u.Set("reason", v.(string))
+	if p.reason.ok {
+		u.Set("reason", p.reason.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
 	return u
 }
 
-func (p *CreateNetworkACLParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateNetworkACLParams parses url.Values, for example taken from a raw API request,
+// into a new CreateNetworkACLParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateNetworkACLParams(u url.Values) (*CreateNetworkACLParams, error) {
+	p := &CreateNetworkACLParams{}
+	if err := checkParamNames("createNetworkACL", u, "aclid", "action", "cidrlist", "endport", "fordisplay", "icmpcode", "icmptype", "networkid", "number", "protocol", "reason", "startport", "traffictype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["aclid"]; found {
+		p.SetAclid(u.Get("aclid"))
+	}
+	if _, found := u["action"]; found {
+		p.SetAction(u.Get("action"))
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
 	}
-	p.p["aclid"] = v
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
+	}
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["number"]; found {
+		v, err := strconv.Atoi(u.Get("number"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param number: %v", err)
+		}
+		p.SetNumber(v)
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["reason"]; found {
+		p.SetReason(u.Get("reason"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	return p, nil
 }
 
+// SetAclid sets the aclid param.
+func (p *CreateNetworkACLParams) SetAclid(v string) {
+	p.aclid = optString{v: v, ok: true}
+}
+
+// ResetAclid unsets the aclid param
 func (p *CreateNetworkACLParams) ResetAclid() {
-	if p.p != nil && p.p["aclid"] != nil {
-		delete(p.p, "aclid")
-	}
+	p.aclid = optString{}
 }
 
+// GetAclid returns the aclid param and if it is set
 func (p *CreateNetworkACLParams) GetAclid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["aclid"].(string)
-	return value, ok
+	return p.aclid.v, p.aclid.ok
 }
 
+// SetAction sets the action param.
 func (p *CreateNetworkACLParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["action"] = v
+	p.action = optString{v: v, ok: true}
 }
 
+// ResetAction unsets the action param
 func (p *CreateNetworkACLParams) ResetAction() {
-	if p.p != nil && p.p["action"] != nil {
-		delete(p.p, "action")
-	}
+	p.action = optString{}
 }
 
+// GetAction returns the action param and if it is set
 func (p *CreateNetworkACLParams) GetAction() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["action"].(string)
-	return value, ok
+	return p.action.v, p.action.ok
 }
 
+// SetCidrlist sets the cidrlist param.
 func (p *CreateNetworkACLParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *CreateNetworkACLParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreateNetworkACLParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetEndport sets the endport param.
 func (p *CreateNetworkACLParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *CreateNetworkACLParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *CreateNetworkACLParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateNetworkACLParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateNetworkACLParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateNetworkACLParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *CreateNetworkACLParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *CreateNetworkACLParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *CreateNetworkACLParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *CreateNetworkACLParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *CreateNetworkACLParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *CreateNetworkACLParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *CreateNetworkACLParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateNetworkACLParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateNetworkACLParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetNumber sets the number param.
 func (p *CreateNetworkACLParams) SetNumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["number"] = v
+	p.number = optInt{v: v, ok: true}
 }
 
+// ResetNumber unsets the number param
 func (p *CreateNetworkACLParams) ResetNumber() {
-	if p.p != nil && p.p["number"] != nil {
-		delete(p.p, "number")
-	}
+	p.number = optInt{}
 }
 
+// GetNumber returns the number param and if it is set
 func (p *CreateNetworkACLParams) GetNumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["number"].(int)
-	return value, ok
+	return p.number.v, p.number.ok
 }
 
+// SetProtocol sets the protocol param. This param is required.
 func (p *CreateNetworkACLParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *CreateNetworkACLParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *CreateNetworkACLParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetReason sets the reason param.
 func (p *CreateNetworkACLParams) SetReason(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["reason"] = v
+	p.reason = optString{v: v, ok: true}
 }
 
+// ResetReason unsets the reason param
 func (p *CreateNetworkACLParams) ResetReason() {
-	if p.p != nil && p.p["reason"] != nil {
-		delete(p.p, "reason")
-	}
+	p.reason = optString{}
 }
 
+// GetReason returns the reason param and if it is set
 func (p *CreateNetworkACLParams) GetReason() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["reason"].(string)
-	return value, ok
+	return p.reason.v, p.reason.ok
 }
 
+// SetStartport sets the startport param.
 func (p *CreateNetworkACLParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *CreateNetworkACLParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *CreateNetworkACLParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetTraffictype sets the traffictype param.
 func (p *CreateNetworkACLParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *CreateNetworkACLParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *CreateNetworkACLParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.traffictype.v, p.traffictype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateNetworkACLParams) Clone() *CreateNetworkACLParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateNetworkACLParams) Equal(o *CreateNetworkACLParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.aclid == o.aclid &&
+		p.action == o.action &&
+		p.cidrlist.equal(o.cidrlist) &&
+		p.endport == o.endport &&
+		p.fordisplay == o.fordisplay &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.networkid == o.networkid &&
+		p.number == o.number &&
+		p.protocol == o.protocol &&
+		p.reason == o.reason &&
+		p.startport == o.startport &&
+		p.traffictype == o.traffictype
+}
+
+// serializedCreateNetworkACLParams is used to (un)marshal CreateNetworkACLParams using the API param names
+type serializedCreateNetworkACLParams struct {
+	Aclid       *string   `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Action      *string   `json:"action,omitempty" yaml:"action,omitempty"`
+	Cidrlist    *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Endport     *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay  *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode    *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype    *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Networkid   *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Number      *int      `json:"number,omitempty" yaml:"number,omitempty"`
+	Protocol    *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Reason      *string   `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Startport   *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Traffictype *string   `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+func (p *CreateNetworkACLParams) toSerialized() *serializedCreateNetworkACLParams {
+	s := &serializedCreateNetworkACLParams{}
+	if p.aclid.ok {
+		s.Aclid = &p.aclid.v
+	}
+	if p.action.ok {
+		s.Action = &p.action.v
+	}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.number.ok {
+		s.Number = &p.number.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.reason.ok {
+		s.Reason = &p.reason.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
 	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	return s
+}
+
+func (p *CreateNetworkACLParams) fromSerialized(s *serializedCreateNetworkACLParams) {
+	*p = CreateNetworkACLParams{}
+	if s.Aclid != nil {
+		p.SetAclid(*s.Aclid)
+	}
+	if s.Action != nil {
+		p.SetAction(*s.Action)
+	}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Number != nil {
+		p.SetNumber(*s.Number)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Reason != nil {
+		p.SetReason(*s.Reason)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateNetworkACLParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateNetworkACLParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateNetworkACLParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateNetworkACLParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateNetworkACLParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateNetworkACLParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateNetworkACLParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams {
 	p := &CreateNetworkACLParams{}
-	p.p = make(map[string]interface{})
-	p.p["protocol"] = protocol
+	p.SetProtocol(protocol)
 	return p
 }
 
-// Creates a ACL rule in the given network (the network has to belong to VPC)
-func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACL", p.toURLValues())
+// Creates a ACL rule in the given network (the network has to belong to VPC).
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: protocol.
+func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams, opts ...CallOption) (*CreateNetworkACLResponse, error) {
+	resp, err := s.cs.newRequest("createNetworkACL", s.cs.encodeParams("createNetworkACL", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -405,8 +575,8 @@ func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*Create
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -449,127 +619,229 @@ type CreateNetworkACLResponse struct {
 }
 
 type CreateNetworkACLListParams struct {
-	p map[string]interface{}
+	description optString
+	fordisplay  optBool
+	name        optString
+	vpcid       optString
 }
 
-func (p *CreateNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *CreateNetworkACLListParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateNetworkACLListParams parses url.Values, for example taken from a raw API request,
+// into a new CreateNetworkACLListParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateNetworkACLListParams(u url.Values) (*CreateNetworkACLListParams, error) {
+	p := &CreateNetworkACLListParams{}
+	if err := checkParamNames("createNetworkACLList", u, "description", "fordisplay", "name", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
 	}
-	p.p["description"] = v
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	return p, nil
+}
+
+// SetDescription sets the description param.
+func (p *CreateNetworkACLListParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateNetworkACLListParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateNetworkACLListParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateNetworkACLListParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateNetworkACLListParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateNetworkACLListParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateNetworkACLListParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateNetworkACLListParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateNetworkACLListParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetVpcid sets the vpcid param. This param is required.
 func (p *CreateNetworkACLListParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *CreateNetworkACLListParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *CreateNetworkACLListParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateNetworkACLListParams) Clone() *CreateNetworkACLListParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateNetworkACLListParams) Equal(o *CreateNetworkACLListParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.fordisplay == o.fordisplay &&
+		p.name == o.name &&
+		p.vpcid == o.vpcid
+}
+
+// serializedCreateNetworkACLListParams is used to (un)marshal CreateNetworkACLListParams using the API param names
+type serializedCreateNetworkACLListParams struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *CreateNetworkACLListParams) toSerialized() *serializedCreateNetworkACLListParams {
+	s := &serializedCreateNetworkACLListParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *CreateNetworkACLListParams) fromSerialized(s *serializedCreateNetworkACLListParams) {
+	*p = CreateNetworkACLListParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateNetworkACLListParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateNetworkACLListParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateNetworkACLListParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateNetworkACLListParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams {
 	p := &CreateNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["vpcid"] = vpcid
+	p.SetName(name)
+	p.SetVpcid(vpcid)
 	return p
 }
 
-// Creates a network ACL. If no VPC is given, then it creates a global ACL that can be used by everyone.
-func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACLList", p.toURLValues())
+// Creates a network ACL. If no VPC is given, then it creates a global ACL that can be used by
+// everyone.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: name, vpcid.
+func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams, opts ...CallOption) (*CreateNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest("createNetworkACLList", s.cs.encodeParams("createNetworkACLList", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -580,8 +852,8 @@ func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -614,53 +886,133 @@ type CreateNetworkACLListResponse struct {
 }
 
 type DeleteNetworkACLParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteNetworkACLParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNetworkACLParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteNetworkACLParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteNetworkACLParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNetworkACLParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNetworkACLParams(u url.Values) (*DeleteNetworkACLParams, error) {
+	p := &DeleteNetworkACLParams{}
+	if err := checkParamNames("deleteNetworkACL", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteNetworkACLParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteNetworkACLParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteNetworkACLParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteNetworkACLParams) Clone() *DeleteNetworkACLParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNetworkACLParams) Equal(o *DeleteNetworkACLParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id == o.id
+}
+
+// serializedDeleteNetworkACLParams is used to (un)marshal DeleteNetworkACLParams using the API param names
+type serializedDeleteNetworkACLParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteNetworkACLParams) toSerialized() *serializedDeleteNetworkACLParams {
+	s := &serializedDeleteNetworkACLParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteNetworkACLParams) fromSerialized(s *serializedDeleteNetworkACLParams) {
+	*p = DeleteNetworkACLParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNetworkACLParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNetworkACLParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNetworkACLParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNetworkACLParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNetworkACLParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNetworkACLParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNetworkACLParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams {
 	p := &DeleteNetworkACLParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a network ACL
-func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACL", p.toURLValues())
+// Deletes a network ACL.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams, opts ...CallOption) (*DeleteNetworkACLResponse, error) {
+	resp, err := s.cs.newRequest("deleteNetworkACL", s.cs.encodeParams("deleteNetworkACL", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -671,8 +1023,8 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -696,53 +1048,133 @@ type DeleteNetworkACLResponse struct {
 }
 
 type DeleteNetworkACLListParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteNetworkACLListParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteNetworkACLListParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNetworkACLListParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNetworkACLListParams(u url.Values) (*DeleteNetworkACLListParams, error) {
+	p := &DeleteNetworkACLListParams{}
+	if err := checkParamNames("deleteNetworkACLList", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteNetworkACLListParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteNetworkACLListParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteNetworkACLListParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteNetworkACLListParams) Clone() *DeleteNetworkACLListParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNetworkACLListParams) Equal(o *DeleteNetworkACLListParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteNetworkACLListParams is used to (un)marshal DeleteNetworkACLListParams using the API param names
+type serializedDeleteNetworkACLListParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteNetworkACLListParams) toSerialized() *serializedDeleteNetworkACLListParams {
+	s := &serializedDeleteNetworkACLListParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteNetworkACLListParams) fromSerialized(s *serializedDeleteNetworkACLListParams) {
+	*p = DeleteNetworkACLListParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNetworkACLListParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNetworkACLListParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNetworkACLListParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNetworkACLListParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams {
 	p := &DeleteNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a network ACL
-func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACLList", p.toURLValues())
+// Deletes a network ACL.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams, opts ...CallOption) (*DeleteNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest("deleteNetworkACLList", s.cs.encodeParams("deleteNetworkACLList", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -753,8 +1185,8 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -778,348 +1210,511 @@ type DeleteNetworkACLListResponse struct {
 }
 
 type ListNetworkACLListsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworkACLListsParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	networkid   optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	vpcid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworkACLListsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *ListNetworkACLListsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworkACLListsParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworkACLListsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworkACLListsParams(u url.Values) (*ListNetworkACLListsParams, error) {
+	p := &ListNetworkACLListsParams{}
+	if err := checkParamNames("listNetworkACLLists", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "name", "networkid", "page", "pagesize", "projectid", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListNetworkACLListsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListNetworkACLListsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListNetworkACLListsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListNetworkACLListsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListNetworkACLListsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListNetworkACLListsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListNetworkACLListsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListNetworkACLListsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListNetworkACLListsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListNetworkACLListsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListNetworkACLListsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListNetworkACLListsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListNetworkACLListsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListNetworkACLListsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListNetworkACLListsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListNetworkACLListsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetworkACLListsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetworkACLListsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListNetworkACLListsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListNetworkACLListsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListNetworkACLListsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListNetworkACLListsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListNetworkACLListsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListNetworkACLListsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListNetworkACLListsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListNetworkACLListsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListNetworkACLListsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetworkACLListsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetworkACLListsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetworkACLListsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetworkACLListsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetworkACLListsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetworkACLListsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListNetworkACLListsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListNetworkACLListsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListNetworkACLListsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListNetworkACLListsParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListNetworkACLListsParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListNetworkACLListsParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetworkACLListsParams) Clone() *ListNetworkACLListsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworkACLListsParams) Equal(o *ListNetworkACLListsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.vpcid == o.vpcid
+}
+
+// serializedListNetworkACLListsParams is used to (un)marshal ListNetworkACLListsParams using the API param names
+type serializedListNetworkACLListsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid   *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *ListNetworkACLListsParams) toSerialized() *serializedListNetworkACLListsParams {
+	s := &serializedListNetworkACLListsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	return s
+}
+
+func (p *ListNetworkACLListsParams) fromSerialized(s *serializedListNetworkACLListsParams) {
+	*p = ListNetworkACLListsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworkACLListsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworkACLListsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworkACLListsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworkACLListsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworkACLListsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworkACLListsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetworkACLListsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewListNetworkACLListsParams() *ListNetworkACLListsParams {
 	p := &ListNetworkACLListsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkACLListsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1167,9 +1762,8 @@ func (s *NetworkACLService) GetNetworkACLListByName(name string, opts ...OptionF
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
 	p := &ListNetworkACLListsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1197,9 +1791,9 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkACLList UUID: %s!", id)
 }
 
-// Lists all network ACLs
-func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLLists", p.toURLValues())
+// Lists all network ACLs.
+func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams, opts ...CallOption) (*ListNetworkACLListsResponse, error) {
+	resp, err := s.cs.newRequest("listNetworkACLLists", s.cs.encodeParams("listNetworkACLLists", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1229,424 +1823,608 @@ type NetworkACLList struct {
 }
 
 type ListNetworkACLsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworkACLsParams) toURLValues() url.Values {
+	account     optString
+	aclid       optString
+	action      optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	networkid   optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	protocol    optString
+	tags        optStringMap
+	traffictype optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworkACLsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if p.aclid.ok {
+		u.Set("aclid", p.aclid.v)
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if p.action.ok {
+		u.Set("action", p.action.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
 	return u
 }
 
-func (p *ListNetworkACLsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworkACLsParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworkACLsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworkACLsParams(u url.Values) (*ListNetworkACLsParams, error) {
+	p := &ListNetworkACLsParams{}
+	if err := checkParamNames("listNetworkACLs", u, "account", "aclid", "action", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "networkid", "page", "pagesize", "projectid", "protocol", "tags[]", "traffictype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["aclid"]; found {
+		p.SetAclid(u.Get("aclid"))
+	}
+	if _, found := u["action"]; found {
+		p.SetAction(u.Get("action"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
 	}
-	p.p["account"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListNetworkACLsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListNetworkACLsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListNetworkACLsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAclid sets the aclid param.
 func (p *ListNetworkACLsParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["aclid"] = v
+	p.aclid = optString{v: v, ok: true}
 }
 
+// ResetAclid unsets the aclid param
 func (p *ListNetworkACLsParams) ResetAclid() {
-	if p.p != nil && p.p["aclid"] != nil {
-		delete(p.p, "aclid")
-	}
+	p.aclid = optString{}
 }
 
+// GetAclid returns the aclid param and if it is set
 func (p *ListNetworkACLsParams) GetAclid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["aclid"].(string)
-	return value, ok
+	return p.aclid.v, p.aclid.ok
 }
 
+// SetAction sets the action param.
 func (p *ListNetworkACLsParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["action"] = v
+	p.action = optString{v: v, ok: true}
 }
 
+// ResetAction unsets the action param
 func (p *ListNetworkACLsParams) ResetAction() {
-	if p.p != nil && p.p["action"] != nil {
-		delete(p.p, "action")
-	}
+	p.action = optString{}
 }
 
+// GetAction returns the action param and if it is set
 func (p *ListNetworkACLsParams) GetAction() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["action"].(string)
-	return value, ok
+	return p.action.v, p.action.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListNetworkACLsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListNetworkACLsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListNetworkACLsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListNetworkACLsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListNetworkACLsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListNetworkACLsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListNetworkACLsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListNetworkACLsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListNetworkACLsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListNetworkACLsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListNetworkACLsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListNetworkACLsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListNetworkACLsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetworkACLsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetworkACLsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListNetworkACLsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListNetworkACLsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListNetworkACLsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListNetworkACLsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListNetworkACLsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListNetworkACLsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetworkACLsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetworkACLsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetworkACLsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetworkACLsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetworkACLsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetworkACLsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListNetworkACLsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *ListNetworkACLsParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *ListNetworkACLsParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetProtocol sets the protocol param.
+func (p *ListNetworkACLsParams) SetProtocol(v string) {
+	p.protocol = optString{v: v, ok: true}
+}
+
+// ResetProtocol unsets the protocol param
+func (p *ListNetworkACLsParams) ResetProtocol() {
+	p.protocol = optString{}
+}
+
+// GetProtocol returns the protocol param and if it is set
+func (p *ListNetworkACLsParams) GetProtocol() (string, bool) {
+	return p.protocol.v, p.protocol.ok
+}
+
+// SetTags sets the tags param.
+func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
+	p.tags = optStringMap{v: v, ok: true}
+}
+
+// ResetTags unsets the tags param
+func (p *ListNetworkACLsParams) ResetTags() {
+	p.tags = optStringMap{}
+}
+
+// GetTags returns the tags param and if it is set
+func (p *ListNetworkACLsParams) GetTags() (map[string]string, bool) {
+	return p.tags.v, p.tags.ok
+}
+
+// SetTraffictype sets the traffictype param.
+func (p *ListNetworkACLsParams) SetTraffictype(v string) {
+	p.traffictype = optString{v: v, ok: true}
+}
+
+// ResetTraffictype unsets the traffictype param
+func (p *ListNetworkACLsParams) ResetTraffictype() {
+	p.traffictype = optString{}
+}
+
+// GetTraffictype returns the traffictype param and if it is set
+func (p *ListNetworkACLsParams) GetTraffictype() (string, bool) {
+	return p.traffictype.v, p.traffictype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetworkACLsParams) Clone() *ListNetworkACLsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworkACLsParams) Equal(o *ListNetworkACLsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.aclid == o.aclid &&
+		p.action == o.action &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.protocol == o.protocol &&
+		p.tags.equal(o.tags) &&
+		p.traffictype == o.traffictype
+}
+
+// serializedListNetworkACLsParams is used to (un)marshal ListNetworkACLsParams using the API param names
+type serializedListNetworkACLsParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Aclid       *string            `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Action      *string            `json:"action,omitempty" yaml:"action,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Protocol    *string            `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype *string            `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+func (p *ListNetworkACLsParams) toSerialized() *serializedListNetworkACLsParams {
+	s := &serializedListNetworkACLsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.aclid.ok {
+		s.Aclid = &p.aclid.v
+	}
+	if p.action.ok {
+		s.Action = &p.action.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	return s
+}
+
+func (p *ListNetworkACLsParams) fromSerialized(s *serializedListNetworkACLsParams) {
+	*p = ListNetworkACLsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Aclid != nil {
+		p.SetAclid(*s.Aclid)
+	}
+	if s.Action != nil {
+		p.SetAction(*s.Action)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
 	}
-	p.p["projectid"] = v
-}
-
-func (p *ListNetworkACLsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
 	}
-}
-
-func (p *ListNetworkACLsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
-}
-
-func (p *ListNetworkACLsParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Page != nil {
+		p.SetPage(*s.Page)
 	}
-	p.p["protocol"] = v
-}
-
-func (p *ListNetworkACLsParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
 	}
-}
-
-func (p *ListNetworkACLsParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
 	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
-}
-
-func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
 	}
-	p.p["tags"] = v
-}
-
-func (p *ListNetworkACLsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
 	}
 }
 
-func (p *ListNetworkACLsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworkACLsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListNetworkACLsParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworkACLsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworkACLsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["traffictype"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListNetworkACLsParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworkACLsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListNetworkACLsParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworkACLsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworkACLsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetworkACLsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
 	p := &ListNetworkACLsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
 	p := &ListNetworkACLsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1674,9 +2452,9 @@ func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*N
 	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkACL UUID: %s!", id)
 }
 
-// Lists all network ACL items
-func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLs", p.toURLValues())
+// Lists all network ACL items.
+func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams, opts ...CallOption) (*ListNetworkACLsResponse, error) {
+	resp, err := s.cs.newRequest("listNetworkACLs", s.cs.encodeParams("listNetworkACLs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1716,101 +2494,193 @@ type NetworkACL struct {
 }
 
 type ReplaceNetworkACLListParams struct {
-	p map[string]interface{}
+	aclid     optString
+	gatewayid optString
+	networkid optString
 }
 
-func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReplaceNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if p.aclid.ok {
+		u.Set("aclid", p.aclid.v)
 	}
-	if v, found := p.p["gatewayid"]; found {
-		u.Set("gatewayid", v.(string))
+	if p.gatewayid.ok {
+		u.Set("gatewayid", p.gatewayid.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
 	return u
 }
 
-func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReplaceNetworkACLListParams parses url.Values, for example taken from a raw API request,
+// into a new ReplaceNetworkACLListParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReplaceNetworkACLListParams(u url.Values) (*ReplaceNetworkACLListParams, error) {
+	p := &ReplaceNetworkACLListParams{}
+	if err := checkParamNames("replaceNetworkACLList", u, "aclid", "gatewayid", "networkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["aclid"]; found {
+		p.SetAclid(u.Get("aclid"))
 	}
-	p.p["aclid"] = v
+	if _, found := u["gatewayid"]; found {
+		p.SetGatewayid(u.Get("gatewayid"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	return p, nil
+}
+
+// SetAclid sets the aclid param. This param is required.
+func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
+	p.aclid = optString{v: v, ok: true}
 }
 
+// ResetAclid unsets the aclid param
 func (p *ReplaceNetworkACLListParams) ResetAclid() {
-	if p.p != nil && p.p["aclid"] != nil {
-		delete(p.p, "aclid")
-	}
+	p.aclid = optString{}
 }
 
+// GetAclid returns the aclid param and if it is set
 func (p *ReplaceNetworkACLListParams) GetAclid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["aclid"].(string)
-	return value, ok
+	return p.aclid.v, p.aclid.ok
 }
 
+// SetGatewayid sets the gatewayid param.
 func (p *ReplaceNetworkACLListParams) SetGatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gatewayid"] = v
+	p.gatewayid = optString{v: v, ok: true}
 }
 
+// ResetGatewayid unsets the gatewayid param
 func (p *ReplaceNetworkACLListParams) ResetGatewayid() {
-	if p.p != nil && p.p["gatewayid"] != nil {
-		delete(p.p, "gatewayid")
-	}
+	p.gatewayid = optString{}
 }
 
+// GetGatewayid returns the gatewayid param and if it is set
 func (p *ReplaceNetworkACLListParams) GetGatewayid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gatewayid"].(string)
-	return value, ok
+	return p.gatewayid.v, p.gatewayid.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ReplaceNetworkACLListParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ReplaceNetworkACLListParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ReplaceNetworkACLListParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.networkid.v, p.networkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReplaceNetworkACLListParams) Clone() *ReplaceNetworkACLListParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReplaceNetworkACLListParams) Equal(o *ReplaceNetworkACLListParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.aclid == o.aclid &&
+		p.gatewayid == o.gatewayid &&
+		p.networkid == o.networkid
+}
+
+// serializedReplaceNetworkACLListParams is used to (un)marshal ReplaceNetworkACLListParams using the API param names
+type serializedReplaceNetworkACLListParams struct {
+	Aclid     *string `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Gatewayid *string `json:"gatewayid,omitempty" yaml:"gatewayid,omitempty"`
+	Networkid *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+}
+
+func (p *ReplaceNetworkACLListParams) toSerialized() *serializedReplaceNetworkACLListParams {
+	s := &serializedReplaceNetworkACLListParams{}
+	if p.aclid.ok {
+		s.Aclid = &p.aclid.v
+	}
+	if p.gatewayid.ok {
+		s.Gatewayid = &p.gatewayid.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	return s
+}
+
+func (p *ReplaceNetworkACLListParams) fromSerialized(s *serializedReplaceNetworkACLListParams) {
+	*p = ReplaceNetworkACLListParams{}
+	if s.Aclid != nil {
+		p.SetAclid(*s.Aclid)
+	}
+	if s.Gatewayid != nil {
+		p.SetGatewayid(*s.Gatewayid)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReplaceNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReplaceNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var s serializedReplaceNetworkACLListParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReplaceNetworkACLListParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReplaceNetworkACLListParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReplaceNetworkACLListParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReplaceNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams {
 	p := &ReplaceNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["aclid"] = aclid
+	p.SetAclid(aclid)
 	return p
 }
 
-// Replaces ACL associated with a network or private gateway
-func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("replaceNetworkACLList", p.toURLValues())
+// Replaces ACL associated with a network or private gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: aclid.
+func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams, opts ...CallOption) (*ReplaceNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest("replaceNetworkACLList", s.cs.encodeParams("replaceNetworkACLList", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1821,8 +2691,8 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1846,373 +2716,552 @@ type ReplaceNetworkACLListResponse struct {
 }
 
 type UpdateNetworkACLItemParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
+	action         optString
+	cidrlist       optStrings
+	customid       optString
+	endport        optInt
+	fordisplay     optBool
+	icmpcode       optInt
+	icmptype       optInt
+	id             optString
+	number         optInt
+	partialupgrade optBool
+	protocol       optString
+	reason         optString
+	startport      optInt
+	traffictype    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateNetworkACLItemParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if p.action.ok {
+		u.Set("action", p.action.v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["number"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("number", vv)
+	if p.number.ok {
+		u.Set("number", strconv.Itoa(p.number.v))
 	}
-	if v, found := p.p["partialupgrade"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("partialupgrade", vv)
+	if p.partialupgrade.ok {
+		u.Set("partialupgrade", strconv.FormatBool(p.partialupgrade.v))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["reason"]; found {
-		u.Set("reason", v.(string))
+	if p.reason.ok {
+		u.Set("reason", p.reason.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
 	return u
 }
 
-func (p *UpdateNetworkACLItemParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateNetworkACLItemParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateNetworkACLItemParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateNetworkACLItemParams(u url.Values) (*UpdateNetworkACLItemParams, error) {
+	p := &UpdateNetworkACLItemParams{}
+	if err := checkParamNames("updateNetworkACLItem", u, "action", "cidrlist", "customid", "endport", "fordisplay", "icmpcode", "icmptype", "id", "number", "partialupgrade", "protocol", "reason", "startport", "traffictype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["action"]; found {
+		p.SetAction(u.Get("action"))
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
+	}
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["number"]; found {
+		v, err := strconv.Atoi(u.Get("number"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param number: %v", err)
+		}
+		p.SetNumber(v)
 	}
-	p.p["action"] = v
+	if _, found := u["partialupgrade"]; found {
+		v, err := strconv.ParseBool(u.Get("partialupgrade"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param partialupgrade: %v", err)
+		}
+		p.SetPartialupgrade(v)
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["reason"]; found {
+		p.SetReason(u.Get("reason"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	return p, nil
+}
+
+// SetAction sets the action param.
+func (p *UpdateNetworkACLItemParams) SetAction(v string) {
+	p.action = optString{v: v, ok: true}
 }
 
+// ResetAction unsets the action param
 func (p *UpdateNetworkACLItemParams) ResetAction() {
-	if p.p != nil && p.p["action"] != nil {
-		delete(p.p, "action")
-	}
+	p.action = optString{}
 }
 
+// GetAction returns the action param and if it is set
 func (p *UpdateNetworkACLItemParams) GetAction() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["action"].(string)
-	return value, ok
+	return p.action.v, p.action.ok
 }
 
+// SetCidrlist sets the cidrlist param.
 func (p *UpdateNetworkACLItemParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *UpdateNetworkACLItemParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *UpdateNetworkACLItemParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *UpdateNetworkACLItemParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateNetworkACLItemParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateNetworkACLItemParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetEndport sets the endport param.
 func (p *UpdateNetworkACLItemParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *UpdateNetworkACLItemParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *UpdateNetworkACLItemParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateNetworkACLItemParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateNetworkACLItemParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateNetworkACLItemParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *UpdateNetworkACLItemParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *UpdateNetworkACLItemParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *UpdateNetworkACLItemParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *UpdateNetworkACLItemParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *UpdateNetworkACLItemParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *UpdateNetworkACLItemParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateNetworkACLItemParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateNetworkACLItemParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateNetworkACLItemParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetNumber sets the number param.
 func (p *UpdateNetworkACLItemParams) SetNumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["number"] = v
+	p.number = optInt{v: v, ok: true}
 }
 
+// ResetNumber unsets the number param
 func (p *UpdateNetworkACLItemParams) ResetNumber() {
-	if p.p != nil && p.p["number"] != nil {
-		delete(p.p, "number")
-	}
+	p.number = optInt{}
 }
 
+// GetNumber returns the number param and if it is set
 func (p *UpdateNetworkACLItemParams) GetNumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["number"].(int)
-	return value, ok
+	return p.number.v, p.number.ok
 }
 
+// SetPartialupgrade sets the partialupgrade param.
 func (p *UpdateNetworkACLItemParams) SetPartialupgrade(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["partialupgrade"] = v
+	p.partialupgrade = optBool{v: v, ok: true}
 }
 
+// ResetPartialupgrade unsets the partialupgrade param
 func (p *UpdateNetworkACLItemParams) ResetPartialupgrade() {
-	if p.p != nil && p.p["partialupgrade"] != nil {
-		delete(p.p, "partialupgrade")
-	}
+	p.partialupgrade = optBool{}
 }
 
+// GetPartialupgrade returns the partialupgrade param and if it is set
 func (p *UpdateNetworkACLItemParams) GetPartialupgrade() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["partialupgrade"].(bool)
-	return value, ok
+	return p.partialupgrade.v, p.partialupgrade.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *UpdateNetworkACLItemParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *UpdateNetworkACLItemParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *UpdateNetworkACLItemParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetReason sets the reason param.
 func (p *UpdateNetworkACLItemParams) SetReason(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["reason"] = v
+	p.reason = optString{v: v, ok: true}
 }
 
+// ResetReason unsets the reason param
 func (p *UpdateNetworkACLItemParams) ResetReason() {
-	if p.p != nil && p.p["reason"] != nil {
-		delete(p.p, "reason")
-	}
+	p.reason = optString{}
 }
 
+// GetReason returns the reason param and if it is set
 func (p *UpdateNetworkACLItemParams) GetReason() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["reason"].(string)
-	return value, ok
+	return p.reason.v, p.reason.ok
 }
 
+// SetStartport sets the startport param.
 func (p *UpdateNetworkACLItemParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *UpdateNetworkACLItemParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *UpdateNetworkACLItemParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetTraffictype sets the traffictype param.
 func (p *UpdateNetworkACLItemParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *UpdateNetworkACLItemParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *UpdateNetworkACLItemParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.traffictype.v, p.traffictype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateNetworkACLItemParams) Clone() *UpdateNetworkACLItemParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateNetworkACLItemParams) Equal(o *UpdateNetworkACLItemParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.action == o.action &&
+		p.cidrlist.equal(o.cidrlist) &&
+		p.customid == o.customid &&
+		p.endport == o.endport &&
+		p.fordisplay == o.fordisplay &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.id == o.id &&
+		p.number == o.number &&
+		p.partialupgrade == o.partialupgrade &&
+		p.protocol == o.protocol &&
+		p.reason == o.reason &&
+		p.startport == o.startport &&
+		p.traffictype == o.traffictype
+}
+
+// serializedUpdateNetworkACLItemParams is used to (un)marshal UpdateNetworkACLItemParams using the API param names
+type serializedUpdateNetworkACLItemParams struct {
+	Action         *string   `json:"action,omitempty" yaml:"action,omitempty"`
+	Cidrlist       *[]string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Customid       *string   `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Endport        *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay     *bool     `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode       *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype       *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Id             *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Number         *int      `json:"number,omitempty" yaml:"number,omitempty"`
+	Partialupgrade *bool     `json:"partialupgrade,omitempty" yaml:"partialupgrade,omitempty"`
+	Protocol       *string   `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Reason         *string   `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Startport      *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Traffictype    *string   `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+func (p *UpdateNetworkACLItemParams) toSerialized() *serializedUpdateNetworkACLItemParams {
+	s := &serializedUpdateNetworkACLItemParams{}
+	if p.action.ok {
+		s.Action = &p.action.v
+	}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.number.ok {
+		s.Number = &p.number.v
+	}
+	if p.partialupgrade.ok {
+		s.Partialupgrade = &p.partialupgrade.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.reason.ok {
+		s.Reason = &p.reason.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	return s
+}
+
+func (p *UpdateNetworkACLItemParams) fromSerialized(s *serializedUpdateNetworkACLItemParams) {
+	*p = UpdateNetworkACLItemParams{}
+	if s.Action != nil {
+		p.SetAction(*s.Action)
+	}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Number != nil {
+		p.SetNumber(*s.Number)
+	}
+	if s.Partialupgrade != nil {
+		p.SetPartialupgrade(*s.Partialupgrade)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Reason != nil {
+		p.SetReason(*s.Reason)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateNetworkACLItemParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateNetworkACLItemParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateNetworkACLItemParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateNetworkACLItemParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateNetworkACLItemParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateNetworkACLItemParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateNetworkACLItemParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams {
 	p := &UpdateNetworkACLItemParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates ACL item with specified ID
-func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLItem", p.toURLValues())
+// Updates ACL item with specified ID.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams, opts ...CallOption) (*UpdateNetworkACLItemResponse, error) {
+	resp, err := s.cs.newRequest("updateNetworkACLItem", s.cs.encodeParams("updateNetworkACLItem", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2223,8 +3272,8 @@ func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2267,150 +3316,257 @@ type UpdateNetworkACLItemResponse struct {
 }
 
 type UpdateNetworkACLListParams struct {
-	p map[string]interface{}
+	customid    optString
+	description optString
+	fordisplay  optBool
+	id          optString
+	name        optString
 }
 
-func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
 	return u
 }
 
-func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateNetworkACLListParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateNetworkACLListParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateNetworkACLListParams(u url.Values) (*UpdateNetworkACLListParams, error) {
+	p := &UpdateNetworkACLListParams{}
+	if err := checkParamNames("updateNetworkACLList", u, "customid", "description", "fordisplay", "id", "name"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["customid"] = v
+	return p, nil
 }
 
+// SetCustomid sets the customid param.
+func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
+}
+
+// ResetCustomid unsets the customid param
 func (p *UpdateNetworkACLListParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateNetworkACLListParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDescription sets the description param.
 func (p *UpdateNetworkACLListParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *UpdateNetworkACLListParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *UpdateNetworkACLListParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateNetworkACLListParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateNetworkACLListParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateNetworkACLListParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateNetworkACLListParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateNetworkACLListParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateNetworkACLListParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateNetworkACLListParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateNetworkACLListParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateNetworkACLListParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.name.v, p.name.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateNetworkACLListParams) Clone() *UpdateNetworkACLListParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateNetworkACLListParams) Equal(o *UpdateNetworkACLListParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.description == o.description &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.name == o.name
+}
+
+// serializedUpdateNetworkACLListParams is used to (un)marshal UpdateNetworkACLListParams using the API param names
+type serializedUpdateNetworkACLListParams struct {
+	Customid    *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (p *UpdateNetworkACLListParams) toSerialized() *serializedUpdateNetworkACLListParams {
+	s := &serializedUpdateNetworkACLListParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	return s
+}
+
+func (p *UpdateNetworkACLListParams) fromSerialized(s *serializedUpdateNetworkACLListParams) {
+	*p = UpdateNetworkACLListParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateNetworkACLListParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateNetworkACLListParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateNetworkACLListParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateNetworkACLListParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams {
 	p := &UpdateNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates network ACL list
-func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLList", p.toURLValues())
+// Updates network ACL list.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams, opts ...CallOption) (*UpdateNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest("updateNetworkACLList", s.cs.encodeParams("updateNetworkACLList", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2421,8 +3577,8 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
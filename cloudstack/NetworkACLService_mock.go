@@ -53,63 +53,83 @@ func (m *MockNetworkACLServiceIface) EXPECT() *MockNetworkACLServiceIfaceMockRec
 }
 
 // CreateNetworkACL mocks base method.
-func (m *MockNetworkACLServiceIface) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+func (m *MockNetworkACLServiceIface) CreateNetworkACL(p *CreateNetworkACLParams, opts ...CallOption) (*CreateNetworkACLResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNetworkACL", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetworkACL", varargs...)
 	ret0, _ := ret[0].(*CreateNetworkACLResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNetworkACL indicates an expected call of CreateNetworkACL.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACL(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACL(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACL", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACL), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACL", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACL), varargs...)
 }
 
 // CreateNetworkACLList mocks base method.
-func (m *MockNetworkACLServiceIface) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+func (m *MockNetworkACLServiceIface) CreateNetworkACLList(p *CreateNetworkACLListParams, opts ...CallOption) (*CreateNetworkACLListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNetworkACLList", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetworkACLList", varargs...)
 	ret0, _ := ret[0].(*CreateNetworkACLListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNetworkACLList indicates an expected call of CreateNetworkACLList.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACLList(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACLList(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACLList), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACLList), varargs...)
 }
 
 // DeleteNetworkACL mocks base method.
-func (m *MockNetworkACLServiceIface) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
+func (m *MockNetworkACLServiceIface) DeleteNetworkACL(p *DeleteNetworkACLParams, opts ...CallOption) (*DeleteNetworkACLResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNetworkACL", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetworkACL", varargs...)
 	ret0, _ := ret[0].(*DeleteNetworkACLResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNetworkACL indicates an expected call of DeleteNetworkACL.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACL(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACL(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACL", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACL), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACL", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACL), varargs...)
 }
 
 // DeleteNetworkACLList mocks base method.
-func (m *MockNetworkACLServiceIface) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+func (m *MockNetworkACLServiceIface) DeleteNetworkACLList(p *DeleteNetworkACLListParams, opts ...CallOption) (*DeleteNetworkACLListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNetworkACLList", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetworkACLList", varargs...)
 	ret0, _ := ret[0].(*DeleteNetworkACLListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNetworkACLList indicates an expected call of DeleteNetworkACLList.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACLList(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACLList(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACLList), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACLList), varargs...)
 }
 
 // GetNetworkACLByID mocks base method.
@@ -197,33 +217,43 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLListID(name inter
 }
 
 // ListNetworkACLLists mocks base method.
-func (m *MockNetworkACLServiceIface) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+func (m *MockNetworkACLServiceIface) ListNetworkACLLists(p *ListNetworkACLListsParams, opts ...CallOption) (*ListNetworkACLListsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkACLLists", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLLists", varargs...)
 	ret0, _ := ret[0].(*ListNetworkACLListsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworkACLLists indicates an expected call of ListNetworkACLLists.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLLists(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLLists(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLLists", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLLists), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLLists", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLLists), varargs...)
 }
 
 // ListNetworkACLs mocks base method.
-func (m *MockNetworkACLServiceIface) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+func (m *MockNetworkACLServiceIface) ListNetworkACLs(p *ListNetworkACLsParams, opts ...CallOption) (*ListNetworkACLsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkACLs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLs", varargs...)
 	ret0, _ := ret[0].(*ListNetworkACLsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworkACLs indicates an expected call of ListNetworkACLs.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLs(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLs", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLs", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLs), varargs...)
 }
 
 // NewCreateNetworkACLListParams mocks base method.
@@ -353,46 +383,61 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) NewUpdateNetworkACLListParams(
 }
 
 // ReplaceNetworkACLList mocks base method.
-func (m *MockNetworkACLServiceIface) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+func (m *MockNetworkACLServiceIface) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams, opts ...CallOption) (*ReplaceNetworkACLListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceNetworkACLList", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplaceNetworkACLList", varargs...)
 	ret0, _ := ret[0].(*ReplaceNetworkACLListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReplaceNetworkACLList indicates an expected call of ReplaceNetworkACLList.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) ReplaceNetworkACLList(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ReplaceNetworkACLList(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ReplaceNetworkACLList), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ReplaceNetworkACLList), varargs...)
 }
 
 // UpdateNetworkACLItem mocks base method.
-func (m *MockNetworkACLServiceIface) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+func (m *MockNetworkACLServiceIface) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams, opts ...CallOption) (*UpdateNetworkACLItemResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNetworkACLItem", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNetworkACLItem", varargs...)
 	ret0, _ := ret[0].(*UpdateNetworkACLItemResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateNetworkACLItem indicates an expected call of UpdateNetworkACLItem.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLItem(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLItem(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLItem", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLItem), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLItem", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLItem), varargs...)
 }
 
 // UpdateNetworkACLList mocks base method.
-func (m *MockNetworkACLServiceIface) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
+func (m *MockNetworkACLServiceIface) UpdateNetworkACLList(p *UpdateNetworkACLListParams, opts ...CallOption) (*UpdateNetworkACLListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNetworkACLList", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNetworkACLList", varargs...)
 	ret0, _ := ret[0].(*UpdateNetworkACLListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateNetworkACLList indicates an expected call of UpdateNetworkACLList.
-func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLList(p interface{}) *gomock.Call {
+func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLList(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLList), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLList), varargs...)
 }
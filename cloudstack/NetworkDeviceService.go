@@ -27,89 +27,176 @@ import (
 )
 
 type NetworkDeviceServiceIface interface {
-	AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	AddNetworkDevice(p *AddNetworkDeviceParams, opts ...CallOption) (*AddNetworkDeviceResponse, error)
 	NewAddNetworkDeviceParams() *AddNetworkDeviceParams
-	DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDevice(p *DeleteNetworkDeviceParams, opts ...CallOption) (*DeleteNetworkDeviceResponse, error)
 	NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams
-	ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	ListNetworkDevice(p *ListNetworkDeviceParams, opts ...CallOption) (*ListNetworkDeviceResponse, error)
 	NewListNetworkDeviceParams() *ListNetworkDeviceParams
 }
 
 type AddNetworkDeviceParams struct {
-	p map[string]interface{}
+	networkdeviceparameterlist optStringMap
+	networkdevicetype          optString
 }
 
-func (p *AddNetworkDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddNetworkDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["networkdeviceparameterlist"]; found {
-		m := v.(map[string]string)
+	if p.networkdeviceparameterlist.ok {
+		m := p.networkdeviceparameterlist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
 			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
+	if p.networkdevicetype.ok {
+		u.Set("networkdevicetype", p.networkdevicetype.v)
 	}
 	return u
 }
 
-func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddNetworkDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new AddNetworkDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddNetworkDeviceParams(u url.Values) (*AddNetworkDeviceParams, error) {
+	p := &AddNetworkDeviceParams{}
+	if err := checkParamNames("addNetworkDevice", u, "networkdeviceparameterlist[]", "networkdevicetype"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "networkdeviceparameterlist", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetNetworkdeviceparameterlist(m)
 	}
-	p.p["networkdeviceparameterlist"] = v
+	if _, found := u["networkdevicetype"]; found {
+		p.SetNetworkdevicetype(u.Get("networkdevicetype"))
+	}
+	return p, nil
+}
+
+// SetNetworkdeviceparameterlist sets the networkdeviceparameterlist param.
+func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
+	p.networkdeviceparameterlist = optStringMap{v: v, ok: true}
 }
 
+// ResetNetworkdeviceparameterlist unsets the networkdeviceparameterlist param
 func (p *AddNetworkDeviceParams) ResetNetworkdeviceparameterlist() {
-	if p.p != nil && p.p["networkdeviceparameterlist"] != nil {
-		delete(p.p, "networkdeviceparameterlist")
-	}
+	p.networkdeviceparameterlist = optStringMap{}
 }
 
+// GetNetworkdeviceparameterlist returns the networkdeviceparameterlist param and if it is set
 func (p *AddNetworkDeviceParams) GetNetworkdeviceparameterlist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdeviceparameterlist"].(map[string]string)
-	return value, ok
+	return p.networkdeviceparameterlist.v, p.networkdeviceparameterlist.ok
 }
 
+// SetNetworkdevicetype sets the networkdevicetype param.
 func (p *AddNetworkDeviceParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdevicetype"] = v
+	p.networkdevicetype = optString{v: v, ok: true}
 }
 
+// ResetNetworkdevicetype unsets the networkdevicetype param
 func (p *AddNetworkDeviceParams) ResetNetworkdevicetype() {
-	if p.p != nil && p.p["networkdevicetype"] != nil {
-		delete(p.p, "networkdevicetype")
-	}
+	p.networkdevicetype = optString{}
 }
 
+// GetNetworkdevicetype returns the networkdevicetype param and if it is set
 func (p *AddNetworkDeviceParams) GetNetworkdevicetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.networkdevicetype.v, p.networkdevicetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddNetworkDeviceParams) Clone() *AddNetworkDeviceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.networkdeviceparameterlist = p.networkdeviceparameterlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddNetworkDeviceParams) Equal(o *AddNetworkDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.networkdeviceparameterlist.equal(o.networkdeviceparameterlist) &&
+		p.networkdevicetype == o.networkdevicetype
+}
+
+// serializedAddNetworkDeviceParams is used to (un)marshal AddNetworkDeviceParams using the API param names
+type serializedAddNetworkDeviceParams struct {
+	Networkdeviceparameterlist *map[string]string `json:"networkdeviceparameterlist,omitempty" yaml:"networkdeviceparameterlist,omitempty"`
+	Networkdevicetype          *string            `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+}
+
+func (p *AddNetworkDeviceParams) toSerialized() *serializedAddNetworkDeviceParams {
+	s := &serializedAddNetworkDeviceParams{}
+	if p.networkdeviceparameterlist.ok {
+		s.Networkdeviceparameterlist = &p.networkdeviceparameterlist.v
+	}
+	if p.networkdevicetype.ok {
+		s.Networkdevicetype = &p.networkdevicetype.v
+	}
+	return s
+}
+
+func (p *AddNetworkDeviceParams) fromSerialized(s *serializedAddNetworkDeviceParams) {
+	*p = AddNetworkDeviceParams{}
+	if s.Networkdeviceparameterlist != nil {
+		p.SetNetworkdeviceparameterlist(*s.Networkdeviceparameterlist)
+	}
+	if s.Networkdevicetype != nil {
+		p.SetNetworkdevicetype(*s.Networkdevicetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddNetworkDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["networkdevicetype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddNetworkDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddNetworkDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddNetworkDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddNetworkDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDeviceParams {
 	p := &AddNetworkDeviceParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Adds a network device of one of the following types: ExternalDhcp, ExternalFirewall, ExternalLoadBalancer, PxeServer
-func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkDevice", p.toURLValues())
+// Adds a network device of one of the following types: ExternalDhcp, ExternalFirewall,
+// ExternalLoadBalancer, PxeServer.
+func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams, opts ...CallOption) (*AddNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequest("addNetworkDevice", s.cs.encodeParams("addNetworkDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -129,53 +216,132 @@ type AddNetworkDeviceResponse struct {
 }
 
 type DeleteNetworkDeviceParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNetworkDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteNetworkDeviceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteNetworkDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNetworkDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNetworkDeviceParams(u url.Values) (*DeleteNetworkDeviceParams, error) {
+	p := &DeleteNetworkDeviceParams{}
+	if err := checkParamNames("deleteNetworkDevice", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteNetworkDeviceParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteNetworkDeviceParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteNetworkDeviceParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteNetworkDeviceParams) Clone() *DeleteNetworkDeviceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNetworkDeviceParams) Equal(o *DeleteNetworkDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteNetworkDeviceParams is used to (un)marshal DeleteNetworkDeviceParams using the API param names
+type serializedDeleteNetworkDeviceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteNetworkDeviceParams) toSerialized() *serializedDeleteNetworkDeviceParams {
+	s := &serializedDeleteNetworkDeviceParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteNetworkDeviceParams) fromSerialized(s *serializedDeleteNetworkDeviceParams) {
+	*p = DeleteNetworkDeviceParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNetworkDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNetworkDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNetworkDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNetworkDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNetworkDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams {
 	p := &DeleteNetworkDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes network device.
-func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkDevice", p.toURLValues())
+//
+// Required params: id.
+func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams, opts ...CallOption) (*DeleteNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequest("deleteNetworkDevice", s.cs.encodeParams("deleteNetworkDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -223,154 +389,264 @@ func (r *DeleteNetworkDeviceResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListNetworkDeviceParams struct {
-	p map[string]interface{}
+	keyword                    optString
+	networkdeviceparameterlist optStringMap
+	networkdevicetype          optString
+	page                       optInt
+	pagesize                   optInt
 }
 
-func (p *ListNetworkDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworkDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["networkdeviceparameterlist"]; found {
-		m := v.(map[string]string)
+	if p.networkdeviceparameterlist.ok {
+		m := p.networkdeviceparameterlist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
 			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
+	if p.networkdevicetype.ok {
+		u.Set("networkdevicetype", p.networkdevicetype.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListNetworkDeviceParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworkDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworkDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworkDeviceParams(u url.Values) (*ListNetworkDeviceParams, error) {
+	p := &ListNetworkDeviceParams{}
+	if err := checkParamNames("listNetworkDevice", u, "keyword", "networkdeviceparameterlist[]", "networkdevicetype", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if m, err := parseKeyValueMap(u, "networkdeviceparameterlist", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetNetworkdeviceparameterlist(m)
+	}
+	if _, found := u["networkdevicetype"]; found {
+		p.SetNetworkdevicetype(u.Get("networkdevicetype"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListNetworkDeviceParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetworkDeviceParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetworkDeviceParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNetworkdeviceparameterlist sets the networkdeviceparameterlist param.
 func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdeviceparameterlist"] = v
+	p.networkdeviceparameterlist = optStringMap{v: v, ok: true}
 }
 
+// ResetNetworkdeviceparameterlist unsets the networkdeviceparameterlist param
 func (p *ListNetworkDeviceParams) ResetNetworkdeviceparameterlist() {
-	if p.p != nil && p.p["networkdeviceparameterlist"] != nil {
-		delete(p.p, "networkdeviceparameterlist")
-	}
+	p.networkdeviceparameterlist = optStringMap{}
 }
 
+// GetNetworkdeviceparameterlist returns the networkdeviceparameterlist param and if it is set
 func (p *ListNetworkDeviceParams) GetNetworkdeviceparameterlist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdeviceparameterlist"].(map[string]string)
-	return value, ok
+	return p.networkdeviceparameterlist.v, p.networkdeviceparameterlist.ok
 }
 
+// SetNetworkdevicetype sets the networkdevicetype param.
 func (p *ListNetworkDeviceParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdevicetype"] = v
+	p.networkdevicetype = optString{v: v, ok: true}
 }
 
+// ResetNetworkdevicetype unsets the networkdevicetype param
 func (p *ListNetworkDeviceParams) ResetNetworkdevicetype() {
-	if p.p != nil && p.p["networkdevicetype"] != nil {
-		delete(p.p, "networkdevicetype")
-	}
+	p.networkdevicetype = optString{}
 }
 
+// GetNetworkdevicetype returns the networkdevicetype param and if it is set
 func (p *ListNetworkDeviceParams) GetNetworkdevicetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdevicetype"].(string)
-	return value, ok
+	return p.networkdevicetype.v, p.networkdevicetype.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetworkDeviceParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetworkDeviceParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetworkDeviceParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetworkDeviceParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetworkDeviceParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetworkDeviceParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetworkDeviceParams) Clone() *ListNetworkDeviceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.networkdeviceparameterlist = p.networkdeviceparameterlist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworkDeviceParams) Equal(o *ListNetworkDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.networkdeviceparameterlist.equal(o.networkdeviceparameterlist) &&
+		p.networkdevicetype == o.networkdevicetype &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListNetworkDeviceParams is used to (un)marshal ListNetworkDeviceParams using the API param names
+type serializedListNetworkDeviceParams struct {
+	Keyword                    *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Networkdeviceparameterlist *map[string]string `json:"networkdeviceparameterlist,omitempty" yaml:"networkdeviceparameterlist,omitempty"`
+	Networkdevicetype          *string            `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Page                       *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                   *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListNetworkDeviceParams) toSerialized() *serializedListNetworkDeviceParams {
+	s := &serializedListNetworkDeviceParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.networkdeviceparameterlist.ok {
+		s.Networkdeviceparameterlist = &p.networkdeviceparameterlist.v
+	}
+	if p.networkdevicetype.ok {
+		s.Networkdevicetype = &p.networkdevicetype.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListNetworkDeviceParams) fromSerialized(s *serializedListNetworkDeviceParams) {
+	*p = ListNetworkDeviceParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Networkdeviceparameterlist != nil {
+		p.SetNetworkdeviceparameterlist(*s.Networkdeviceparameterlist)
+	}
+	if s.Networkdevicetype != nil {
+		p.SetNetworkdevicetype(*s.Networkdevicetype)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworkDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworkDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworkDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworkDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetworkDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDeviceParams {
 	p := &ListNetworkDeviceParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// List network devices
-func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkDevice", p.toURLValues())
+// List network devices.
+func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams, opts ...CallOption) (*ListNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequest("listNetworkDevice", s.cs.encodeParams("listNetworkDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,48 +53,63 @@ func (m *MockNetworkDeviceServiceIface) EXPECT() *MockNetworkDeviceServiceIfaceM
 }
 
 // AddNetworkDevice mocks base method.
-func (m *MockNetworkDeviceServiceIface) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
+func (m *MockNetworkDeviceServiceIface) AddNetworkDevice(p *AddNetworkDeviceParams, opts ...CallOption) (*AddNetworkDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddNetworkDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddNetworkDevice", varargs...)
 	ret0, _ := ret[0].(*AddNetworkDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddNetworkDevice indicates an expected call of AddNetworkDevice.
-func (mr *MockNetworkDeviceServiceIfaceMockRecorder) AddNetworkDevice(p interface{}) *gomock.Call {
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) AddNetworkDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).AddNetworkDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).AddNetworkDevice), varargs...)
 }
 
 // DeleteNetworkDevice mocks base method.
-func (m *MockNetworkDeviceServiceIface) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+func (m *MockNetworkDeviceServiceIface) DeleteNetworkDevice(p *DeleteNetworkDeviceParams, opts ...CallOption) (*DeleteNetworkDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNetworkDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetworkDevice", varargs...)
 	ret0, _ := ret[0].(*DeleteNetworkDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNetworkDevice indicates an expected call of DeleteNetworkDevice.
-func (mr *MockNetworkDeviceServiceIfaceMockRecorder) DeleteNetworkDevice(p interface{}) *gomock.Call {
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) DeleteNetworkDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).DeleteNetworkDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).DeleteNetworkDevice), varargs...)
 }
 
 // ListNetworkDevice mocks base method.
-func (m *MockNetworkDeviceServiceIface) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+func (m *MockNetworkDeviceServiceIface) ListNetworkDevice(p *ListNetworkDeviceParams, opts ...CallOption) (*ListNetworkDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkDevice", varargs...)
 	ret0, _ := ret[0].(*ListNetworkDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworkDevice indicates an expected call of ListNetworkDevice.
-func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDevice(p interface{}) *gomock.Call {
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDevice), varargs...)
 }
 
 // NewAddNetworkDeviceParams mocks base method.
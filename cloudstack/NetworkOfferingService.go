@@ -28,671 +28,936 @@ import (
 )
 
 type NetworkOfferingServiceIface interface {
-	CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	CreateNetworkOffering(p *CreateNetworkOfferingParams, opts ...CallOption) (*CreateNetworkOfferingResponse, error)
 	NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, traffictype string) *CreateNetworkOfferingParams
-	DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOffering(p *DeleteNetworkOfferingParams, opts ...CallOption) (*DeleteNetworkOfferingResponse, error)
 	NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams
-	ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferings(p *ListNetworkOfferingsParams, opts ...CallOption) (*ListNetworkOfferingsResponse, error)
 	NewListNetworkOfferingsParams() *ListNetworkOfferingsParams
 	GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error)
 	GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error)
 	GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error)
-	UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOffering(p *UpdateNetworkOfferingParams, opts ...CallOption) (*UpdateNetworkOfferingResponse, error)
 	NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams
 }
 
 type CreateNetworkOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
+	availability          optString
+	conservemode          optBool
+	details               optStringMap
+	displaytext           optString
+	domainid              optStrings
+	egressdefaultpolicy   optBool
+	enable                optBool
+	fortungsten           optBool
+	forvpc                optBool
+	guestiptype           optString
+	internetprotocol      optString
+	ispersistent          optBool
+	keepaliveenabled      optBool
+	maxconnections        optInt
+	name                  optString
+	networkrate           optInt
+	servicecapabilitylist optStringMap
+	serviceofferingid     optString
+	serviceproviderlist   optStringMap
+	specifyipranges       optBool
+	specifyvlan           optBool
+	supportedservices     optStrings
+	tags                  optString
+	traffictype           optString
+	zoneid                optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateNetworkOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["availability"]; found {
-		u.Set("availability", v.(string))
+	if p.availability.ok {
+		u.Set("availability", p.availability.v)
 	}
-	if v, found := p.p["conservemode"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("conservemode", vv)
+	if p.conservemode.ok {
+		u.Set("conservemode", strconv.FormatBool(p.conservemode.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("domainid", vv)
+	if p.domainid.ok {
+		u.Set("domainid", strings.Join(p.domainid.v, ","))
 	}
-	if v, found := p.p["egressdefaultpolicy"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("egressdefaultpolicy", vv)
+	if p.egressdefaultpolicy.ok {
+		u.Set("egressdefaultpolicy", strconv.FormatBool(p.egressdefaultpolicy.v))
 	}
-	if v, found := p.p["enable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enable", vv)
+	if p.enable.ok {
+		u.Set("enable", strconv.FormatBool(p.enable.v))
 	}
-	if v, found := p.p["fortungsten"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fortungsten", vv)
+	if p.fortungsten.ok {
+		u.Set("fortungsten", strconv.FormatBool(p.fortungsten.v))
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvpc", vv)
+	if p.forvpc.ok {
+		u.Set("forvpc", strconv.FormatBool(p.forvpc.v))
 	}
-	if v, found := p.p["guestiptype"]; found {
-		u.Set("guestiptype", v.(string))
+	if p.guestiptype.ok {
+		u.Set("guestiptype", p.guestiptype.v)
 	}
-	if v, found := p.p["internetprotocol"]; found {
-		u.Set("internetprotocol", v.(string))
+	if p.internetprotocol.ok {
+		u.Set("internetprotocol", p.internetprotocol.v)
 	}
-	if v, found := p.p["ispersistent"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispersistent", vv)
+	if p.ispersistent.ok {
+		u.Set("ispersistent", strconv.FormatBool(p.ispersistent.v))
 	}
-	if v, found := p.p["keepaliveenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("keepaliveenabled", vv)
+	if p.keepaliveenabled.ok {
+		u.Set("keepaliveenabled", strconv.FormatBool(p.keepaliveenabled.v))
 	}
-	if v, found := p.p["maxconnections"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxconnections", vv)
+	if p.maxconnections.ok {
+		u.Set("maxconnections", strconv.Itoa(p.maxconnections.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkrate"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("networkrate", vv)
+	if p.networkrate.ok {
+		u.Set("networkrate", strconv.Itoa(p.networkrate.v))
 	}
-	if v, found := p.p["servicecapabilitylist"]; found {
-		m := v.(map[string]string)
+	if p.servicecapabilitylist.ok {
+		m := p.servicecapabilitylist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
 			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["serviceproviderlist"]; found {
-		m := v.(map[string]string)
+	if p.serviceproviderlist.ok {
+		m := p.serviceproviderlist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), k)
 			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), m[k])
 		}
 	}
-	if v, found := p.p["specifyipranges"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("specifyipranges", vv)
+	if p.specifyipranges.ok {
+		u.Set("specifyipranges", strconv.FormatBool(p.specifyipranges.v))
 	}
-	if v, found := p.p["specifyvlan"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("specifyvlan", vv)
+	if p.specifyvlan.ok {
+		u.Set("specifyvlan", strconv.FormatBool(p.specifyvlan.v))
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("supportedservices", vv)
+	if p.supportedservices.ok {
+		u.Set("supportedservices", strings.Join(p.supportedservices.v, ","))
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("zoneid", vv)
+	if p.zoneid.ok {
+		u.Set("zoneid", strings.Join(p.zoneid.v, ","))
 	}
 	return u
 }
 
-func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateNetworkOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new CreateNetworkOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateNetworkOfferingParams(u url.Values) (*CreateNetworkOfferingParams, error) {
+	p := &CreateNetworkOfferingParams{}
+	if err := checkParamNames("createNetworkOffering", u, "availability", "conservemode", "details[]", "displaytext", "domainid", "egressdefaultpolicy", "enable", "fortungsten", "forvpc", "guestiptype", "internetprotocol", "ispersistent", "keepaliveenabled", "maxconnections", "name", "networkrate", "servicecapabilitylist[]", "serviceofferingid", "serviceproviderlist[]", "specifyipranges", "specifyvlan", "supportedservices", "tags", "traffictype", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["availability"]; found {
+		p.SetAvailability(u.Get("availability"))
+	}
+	if _, found := u["conservemode"]; found {
+		v, err := strconv.ParseBool(u.Get("conservemode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param conservemode: %v", err)
+		}
+		p.SetConservemode(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(strings.Split(u.Get("domainid"), ","))
+	}
+	if _, found := u["egressdefaultpolicy"]; found {
+		v, err := strconv.ParseBool(u.Get("egressdefaultpolicy"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param egressdefaultpolicy: %v", err)
+		}
+		p.SetEgressdefaultpolicy(v)
 	}
-	p.p["availability"] = v
+	if _, found := u["enable"]; found {
+		v, err := strconv.ParseBool(u.Get("enable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enable: %v", err)
+		}
+		p.SetEnable(v)
+	}
+	if _, found := u["fortungsten"]; found {
+		v, err := strconv.ParseBool(u.Get("fortungsten"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fortungsten: %v", err)
+		}
+		p.SetFortungsten(v)
+	}
+	if _, found := u["forvpc"]; found {
+		v, err := strconv.ParseBool(u.Get("forvpc"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvpc: %v", err)
+		}
+		p.SetForvpc(v)
+	}
+	if _, found := u["guestiptype"]; found {
+		p.SetGuestiptype(u.Get("guestiptype"))
+	}
+	if _, found := u["internetprotocol"]; found {
+		p.SetInternetprotocol(u.Get("internetprotocol"))
+	}
+	if _, found := u["ispersistent"]; found {
+		v, err := strconv.ParseBool(u.Get("ispersistent"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispersistent: %v", err)
+		}
+		p.SetIspersistent(v)
+	}
+	if _, found := u["keepaliveenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("keepaliveenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param keepaliveenabled: %v", err)
+		}
+		p.SetKeepaliveenabled(v)
+	}
+	if _, found := u["maxconnections"]; found {
+		v, err := strconv.Atoi(u.Get("maxconnections"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxconnections: %v", err)
+		}
+		p.SetMaxconnections(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkrate"]; found {
+		v, err := strconv.Atoi(u.Get("networkrate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param networkrate: %v", err)
+		}
+		p.SetNetworkrate(v)
+	}
+	if m, err := parseKeyValueMap(u, "servicecapabilitylist", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetServicecapabilitylist(m)
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if m, err := parseKeyValueMap(u, "serviceproviderlist", "service", "provider", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetServiceproviderlist(m)
+	}
+	if _, found := u["specifyipranges"]; found {
+		v, err := strconv.ParseBool(u.Get("specifyipranges"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param specifyipranges: %v", err)
+		}
+		p.SetSpecifyipranges(v)
+	}
+	if _, found := u["specifyvlan"]; found {
+		v, err := strconv.ParseBool(u.Get("specifyvlan"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param specifyvlan: %v", err)
+		}
+		p.SetSpecifyvlan(v)
+	}
+	if _, found := u["supportedservices"]; found {
+		p.SetSupportedservices(strings.Split(u.Get("supportedservices"), ","))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(strings.Split(u.Get("zoneid"), ","))
+	}
+	return p, nil
+}
+
+// SetAvailability sets the availability param.
+func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
+	p.availability = optString{v: v, ok: true}
 }
 
+// ResetAvailability unsets the availability param
 func (p *CreateNetworkOfferingParams) ResetAvailability() {
-	if p.p != nil && p.p["availability"] != nil {
-		delete(p.p, "availability")
-	}
+	p.availability = optString{}
 }
 
+// GetAvailability returns the availability param and if it is set
 func (p *CreateNetworkOfferingParams) GetAvailability() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["availability"].(string)
-	return value, ok
+	return p.availability.v, p.availability.ok
 }
 
+// SetConservemode sets the conservemode param.
 func (p *CreateNetworkOfferingParams) SetConservemode(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["conservemode"] = v
+	p.conservemode = optBool{v: v, ok: true}
 }
 
+// ResetConservemode unsets the conservemode param
 func (p *CreateNetworkOfferingParams) ResetConservemode() {
-	if p.p != nil && p.p["conservemode"] != nil {
-		delete(p.p, "conservemode")
-	}
+	p.conservemode = optBool{}
 }
 
+// GetConservemode returns the conservemode param and if it is set
 func (p *CreateNetworkOfferingParams) GetConservemode() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["conservemode"].(bool)
-	return value, ok
+	return p.conservemode.v, p.conservemode.ok
 }
 
+// SetDetails sets the details param.
 func (p *CreateNetworkOfferingParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *CreateNetworkOfferingParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *CreateNetworkOfferingParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *CreateNetworkOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateNetworkOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateNetworkOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateNetworkOfferingParams) SetDomainid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optStrings{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateNetworkOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optStrings{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateNetworkOfferingParams) GetDomainid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].([]string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEgressdefaultpolicy sets the egressdefaultpolicy param.
 func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicy(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["egressdefaultpolicy"] = v
+	p.egressdefaultpolicy = optBool{v: v, ok: true}
 }
 
+// ResetEgressdefaultpolicy unsets the egressdefaultpolicy param
 func (p *CreateNetworkOfferingParams) ResetEgressdefaultpolicy() {
-	if p.p != nil && p.p["egressdefaultpolicy"] != nil {
-		delete(p.p, "egressdefaultpolicy")
-	}
+	p.egressdefaultpolicy = optBool{}
 }
 
+// GetEgressdefaultpolicy returns the egressdefaultpolicy param and if it is set
 func (p *CreateNetworkOfferingParams) GetEgressdefaultpolicy() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["egressdefaultpolicy"].(bool)
-	return value, ok
+	return p.egressdefaultpolicy.v, p.egressdefaultpolicy.ok
 }
 
+// SetEnable sets the enable param.
 func (p *CreateNetworkOfferingParams) SetEnable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enable"] = v
+	p.enable = optBool{v: v, ok: true}
 }
 
+// ResetEnable unsets the enable param
 func (p *CreateNetworkOfferingParams) ResetEnable() {
-	if p.p != nil && p.p["enable"] != nil {
-		delete(p.p, "enable")
-	}
+	p.enable = optBool{}
 }
 
+// GetEnable returns the enable param and if it is set
 func (p *CreateNetworkOfferingParams) GetEnable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enable"].(bool)
-	return value, ok
+	return p.enable.v, p.enable.ok
 }
 
+// SetFortungsten sets the fortungsten param.
 func (p *CreateNetworkOfferingParams) SetFortungsten(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fortungsten"] = v
+	p.fortungsten = optBool{v: v, ok: true}
 }
 
+// ResetFortungsten unsets the fortungsten param
 func (p *CreateNetworkOfferingParams) ResetFortungsten() {
-	if p.p != nil && p.p["fortungsten"] != nil {
-		delete(p.p, "fortungsten")
-	}
+	p.fortungsten = optBool{}
 }
 
+// GetFortungsten returns the fortungsten param and if it is set
 func (p *CreateNetworkOfferingParams) GetFortungsten() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fortungsten"].(bool)
-	return value, ok
+	return p.fortungsten.v, p.fortungsten.ok
 }
 
+// SetForvpc sets the forvpc param.
 func (p *CreateNetworkOfferingParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
+	p.forvpc = optBool{v: v, ok: true}
 }
 
+// ResetForvpc unsets the forvpc param
 func (p *CreateNetworkOfferingParams) ResetForvpc() {
-	if p.p != nil && p.p["forvpc"] != nil {
-		delete(p.p, "forvpc")
-	}
+	p.forvpc = optBool{}
 }
 
+// GetForvpc returns the forvpc param and if it is set
 func (p *CreateNetworkOfferingParams) GetForvpc() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvpc"].(bool)
-	return value, ok
+	return p.forvpc.v, p.forvpc.ok
 }
 
+// SetGuestiptype sets the guestiptype param. This param is required.
 func (p *CreateNetworkOfferingParams) SetGuestiptype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestiptype"] = v
+	p.guestiptype = optString{v: v, ok: true}
 }
 
+// ResetGuestiptype unsets the guestiptype param
 func (p *CreateNetworkOfferingParams) ResetGuestiptype() {
-	if p.p != nil && p.p["guestiptype"] != nil {
-		delete(p.p, "guestiptype")
-	}
+	p.guestiptype = optString{}
 }
 
+// GetGuestiptype returns the guestiptype param and if it is set
 func (p *CreateNetworkOfferingParams) GetGuestiptype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestiptype"].(string)
-	return value, ok
+	return p.guestiptype.v, p.guestiptype.ok
 }
 
+// SetInternetprotocol sets the internetprotocol param.
 func (p *CreateNetworkOfferingParams) SetInternetprotocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internetprotocol"] = v
+	p.internetprotocol = optString{v: v, ok: true}
 }
 
+// ResetInternetprotocol unsets the internetprotocol param
 func (p *CreateNetworkOfferingParams) ResetInternetprotocol() {
-	if p.p != nil && p.p["internetprotocol"] != nil {
-		delete(p.p, "internetprotocol")
-	}
+	p.internetprotocol = optString{}
 }
 
+// GetInternetprotocol returns the internetprotocol param and if it is set
 func (p *CreateNetworkOfferingParams) GetInternetprotocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["internetprotocol"].(string)
-	return value, ok
+	return p.internetprotocol.v, p.internetprotocol.ok
 }
 
+// SetIspersistent sets the ispersistent param.
 func (p *CreateNetworkOfferingParams) SetIspersistent(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispersistent"] = v
+	p.ispersistent = optBool{v: v, ok: true}
 }
 
+// ResetIspersistent unsets the ispersistent param
 func (p *CreateNetworkOfferingParams) ResetIspersistent() {
-	if p.p != nil && p.p["ispersistent"] != nil {
-		delete(p.p, "ispersistent")
-	}
+	p.ispersistent = optBool{}
 }
 
+// GetIspersistent returns the ispersistent param and if it is set
 func (p *CreateNetworkOfferingParams) GetIspersistent() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispersistent"].(bool)
-	return value, ok
+	return p.ispersistent.v, p.ispersistent.ok
 }
 
+// SetKeepaliveenabled sets the keepaliveenabled param.
 func (p *CreateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keepaliveenabled"] = v
+	p.keepaliveenabled = optBool{v: v, ok: true}
 }
 
+// ResetKeepaliveenabled unsets the keepaliveenabled param
 func (p *CreateNetworkOfferingParams) ResetKeepaliveenabled() {
-	if p.p != nil && p.p["keepaliveenabled"] != nil {
-		delete(p.p, "keepaliveenabled")
-	}
+	p.keepaliveenabled = optBool{}
 }
 
+// GetKeepaliveenabled returns the keepaliveenabled param and if it is set
 func (p *CreateNetworkOfferingParams) GetKeepaliveenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keepaliveenabled"].(bool)
-	return value, ok
+	return p.keepaliveenabled.v, p.keepaliveenabled.ok
 }
 
+// SetMaxconnections sets the maxconnections param.
 func (p *CreateNetworkOfferingParams) SetMaxconnections(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxconnections"] = v
+	p.maxconnections = optInt{v: v, ok: true}
 }
 
+// ResetMaxconnections unsets the maxconnections param
 func (p *CreateNetworkOfferingParams) ResetMaxconnections() {
-	if p.p != nil && p.p["maxconnections"] != nil {
-		delete(p.p, "maxconnections")
-	}
+	p.maxconnections = optInt{}
 }
 
+// GetMaxconnections returns the maxconnections param and if it is set
 func (p *CreateNetworkOfferingParams) GetMaxconnections() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxconnections"].(int)
-	return value, ok
+	return p.maxconnections.v, p.maxconnections.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateNetworkOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateNetworkOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateNetworkOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkrate sets the networkrate param.
 func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkrate"] = v
+	p.networkrate = optInt{v: v, ok: true}
 }
 
+// ResetNetworkrate unsets the networkrate param
 func (p *CreateNetworkOfferingParams) ResetNetworkrate() {
-	if p.p != nil && p.p["networkrate"] != nil {
-		delete(p.p, "networkrate")
-	}
+	p.networkrate = optInt{}
 }
 
+// GetNetworkrate returns the networkrate param and if it is set
 func (p *CreateNetworkOfferingParams) GetNetworkrate() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkrate"].(int)
-	return value, ok
+	return p.networkrate.v, p.networkrate.ok
 }
 
+// SetServicecapabilitylist sets the servicecapabilitylist param.
 func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["servicecapabilitylist"] = v
+	p.servicecapabilitylist = optStringMap{v: v, ok: true}
 }
 
+// ResetServicecapabilitylist unsets the servicecapabilitylist param
 func (p *CreateNetworkOfferingParams) ResetServicecapabilitylist() {
-	if p.p != nil && p.p["servicecapabilitylist"] != nil {
-		delete(p.p, "servicecapabilitylist")
-	}
+	p.servicecapabilitylist = optStringMap{}
 }
 
+// GetServicecapabilitylist returns the servicecapabilitylist param and if it is set
 func (p *CreateNetworkOfferingParams) GetServicecapabilitylist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["servicecapabilitylist"].(map[string]string)
-	return value, ok
+	return p.servicecapabilitylist.v, p.servicecapabilitylist.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param.
 func (p *CreateNetworkOfferingParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *CreateNetworkOfferingParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *CreateNetworkOfferingParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetServiceproviderlist sets the serviceproviderlist param.
 func (p *CreateNetworkOfferingParams) SetServiceproviderlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceproviderlist"] = v
+	p.serviceproviderlist = optStringMap{v: v, ok: true}
 }
 
+// ResetServiceproviderlist unsets the serviceproviderlist param
 func (p *CreateNetworkOfferingParams) ResetServiceproviderlist() {
-	if p.p != nil && p.p["serviceproviderlist"] != nil {
-		delete(p.p, "serviceproviderlist")
-	}
+	p.serviceproviderlist = optStringMap{}
 }
 
+// GetServiceproviderlist returns the serviceproviderlist param and if it is set
 func (p *CreateNetworkOfferingParams) GetServiceproviderlist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceproviderlist"].(map[string]string)
-	return value, ok
+	return p.serviceproviderlist.v, p.serviceproviderlist.ok
 }
 
+// SetSpecifyipranges sets the specifyipranges param.
 func (p *CreateNetworkOfferingParams) SetSpecifyipranges(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["specifyipranges"] = v
+	p.specifyipranges = optBool{v: v, ok: true}
 }
 
+// ResetSpecifyipranges unsets the specifyipranges param
 func (p *CreateNetworkOfferingParams) ResetSpecifyipranges() {
-	if p.p != nil && p.p["specifyipranges"] != nil {
-		delete(p.p, "specifyipranges")
-	}
+	p.specifyipranges = optBool{}
 }
 
+// GetSpecifyipranges returns the specifyipranges param and if it is set
 func (p *CreateNetworkOfferingParams) GetSpecifyipranges() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["specifyipranges"].(bool)
-	return value, ok
+	return p.specifyipranges.v, p.specifyipranges.ok
 }
 
+// SetSpecifyvlan sets the specifyvlan param.
 func (p *CreateNetworkOfferingParams) SetSpecifyvlan(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["specifyvlan"] = v
+	p.specifyvlan = optBool{v: v, ok: true}
 }
 
+// ResetSpecifyvlan unsets the specifyvlan param
 func (p *CreateNetworkOfferingParams) ResetSpecifyvlan() {
-	if p.p != nil && p.p["specifyvlan"] != nil {
-		delete(p.p, "specifyvlan")
-	}
+	p.specifyvlan = optBool{}
 }
 
+// GetSpecifyvlan returns the specifyvlan param and if it is set
 func (p *CreateNetworkOfferingParams) GetSpecifyvlan() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["specifyvlan"].(bool)
-	return value, ok
+	return p.specifyvlan.v, p.specifyvlan.ok
 }
 
+// SetSupportedservices sets the supportedservices param.
 func (p *CreateNetworkOfferingParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
+	p.supportedservices = optStrings{v: v, ok: true}
 }
 
+// ResetSupportedservices unsets the supportedservices param
 func (p *CreateNetworkOfferingParams) ResetSupportedservices() {
-	if p.p != nil && p.p["supportedservices"] != nil {
-		delete(p.p, "supportedservices")
-	}
+	p.supportedservices = optStrings{}
 }
 
+// GetSupportedservices returns the supportedservices param and if it is set
 func (p *CreateNetworkOfferingParams) GetSupportedservices() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["supportedservices"].([]string)
-	return value, ok
+	return p.supportedservices.v, p.supportedservices.ok
 }
 
+// SetTags sets the tags param.
 func (p *CreateNetworkOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optString{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreateNetworkOfferingParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optString{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreateNetworkOfferingParams) GetTags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetTraffictype sets the traffictype param. This param is required.
 func (p *CreateNetworkOfferingParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *CreateNetworkOfferingParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *CreateNetworkOfferingParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	return p.traffictype.v, p.traffictype.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateNetworkOfferingParams) SetZoneid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optStrings{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateNetworkOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optStrings{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateNetworkOfferingParams) GetZoneid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateNetworkOfferingParams) Clone() *CreateNetworkOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.domainid = p.domainid.clone()
+	c.servicecapabilitylist = p.servicecapabilitylist.clone()
+	c.serviceproviderlist = p.serviceproviderlist.clone()
+	c.supportedservices = p.supportedservices.clone()
+	c.zoneid = p.zoneid.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateNetworkOfferingParams) Equal(o *CreateNetworkOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.availability == o.availability &&
+		p.conservemode == o.conservemode &&
+		p.details.equal(o.details) &&
+		p.displaytext == o.displaytext &&
+		p.domainid.equal(o.domainid) &&
+		p.egressdefaultpolicy == o.egressdefaultpolicy &&
+		p.enable == o.enable &&
+		p.fortungsten == o.fortungsten &&
+		p.forvpc == o.forvpc &&
+		p.guestiptype == o.guestiptype &&
+		p.internetprotocol == o.internetprotocol &&
+		p.ispersistent == o.ispersistent &&
+		p.keepaliveenabled == o.keepaliveenabled &&
+		p.maxconnections == o.maxconnections &&
+		p.name == o.name &&
+		p.networkrate == o.networkrate &&
+		p.servicecapabilitylist.equal(o.servicecapabilitylist) &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.serviceproviderlist.equal(o.serviceproviderlist) &&
+		p.specifyipranges == o.specifyipranges &&
+		p.specifyvlan == o.specifyvlan &&
+		p.supportedservices.equal(o.supportedservices) &&
+		p.tags == o.tags &&
+		p.traffictype == o.traffictype &&
+		p.zoneid.equal(o.zoneid)
+}
+
+// serializedCreateNetworkOfferingParams is used to (un)marshal CreateNetworkOfferingParams using the API param names
+type serializedCreateNetworkOfferingParams struct {
+	Availability          *string            `json:"availability,omitempty" yaml:"availability,omitempty"`
+	Conservemode          *bool              `json:"conservemode,omitempty" yaml:"conservemode,omitempty"`
+	Details               *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *[]string          `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Egressdefaultpolicy   *bool              `json:"egressdefaultpolicy,omitempty" yaml:"egressdefaultpolicy,omitempty"`
+	Enable                *bool              `json:"enable,omitempty" yaml:"enable,omitempty"`
+	Fortungsten           *bool              `json:"fortungsten,omitempty" yaml:"fortungsten,omitempty"`
+	Forvpc                *bool              `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Guestiptype           *string            `json:"guestiptype,omitempty" yaml:"guestiptype,omitempty"`
+	Internetprotocol      *string            `json:"internetprotocol,omitempty" yaml:"internetprotocol,omitempty"`
+	Ispersistent          *bool              `json:"ispersistent,omitempty" yaml:"ispersistent,omitempty"`
+	Keepaliveenabled      *bool              `json:"keepaliveenabled,omitempty" yaml:"keepaliveenabled,omitempty"`
+	Maxconnections        *int               `json:"maxconnections,omitempty" yaml:"maxconnections,omitempty"`
+	Name                  *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkrate           *int               `json:"networkrate,omitempty" yaml:"networkrate,omitempty"`
+	Servicecapabilitylist *map[string]string `json:"servicecapabilitylist,omitempty" yaml:"servicecapabilitylist,omitempty"`
+	Serviceofferingid     *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Serviceproviderlist   *map[string]string `json:"serviceproviderlist,omitempty" yaml:"serviceproviderlist,omitempty"`
+	Specifyipranges       *bool              `json:"specifyipranges,omitempty" yaml:"specifyipranges,omitempty"`
+	Specifyvlan           *bool              `json:"specifyvlan,omitempty" yaml:"specifyvlan,omitempty"`
+	Supportedservices     *[]string          `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags                  *string            `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype           *string            `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+	Zoneid                *[]string          `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateNetworkOfferingParams) toSerialized() *serializedCreateNetworkOfferingParams {
+	s := &serializedCreateNetworkOfferingParams{}
+	if p.availability.ok {
+		s.Availability = &p.availability.v
+	}
+	if p.conservemode.ok {
+		s.Conservemode = &p.conservemode.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.egressdefaultpolicy.ok {
+		s.Egressdefaultpolicy = &p.egressdefaultpolicy.v
+	}
+	if p.enable.ok {
+		s.Enable = &p.enable.v
+	}
+	if p.fortungsten.ok {
+		s.Fortungsten = &p.fortungsten.v
+	}
+	if p.forvpc.ok {
+		s.Forvpc = &p.forvpc.v
+	}
+	if p.guestiptype.ok {
+		s.Guestiptype = &p.guestiptype.v
+	}
+	if p.internetprotocol.ok {
+		s.Internetprotocol = &p.internetprotocol.v
+	}
+	if p.ispersistent.ok {
+		s.Ispersistent = &p.ispersistent.v
+	}
+	if p.keepaliveenabled.ok {
+		s.Keepaliveenabled = &p.keepaliveenabled.v
+	}
+	if p.maxconnections.ok {
+		s.Maxconnections = &p.maxconnections.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkrate.ok {
+		s.Networkrate = &p.networkrate.v
+	}
+	if p.servicecapabilitylist.ok {
+		s.Servicecapabilitylist = &p.servicecapabilitylist.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.serviceproviderlist.ok {
+		s.Serviceproviderlist = &p.serviceproviderlist.v
+	}
+	if p.specifyipranges.ok {
+		s.Specifyipranges = &p.specifyipranges.v
+	}
+	if p.specifyvlan.ok {
+		s.Specifyvlan = &p.specifyvlan.v
+	}
+	if p.supportedservices.ok {
+		s.Supportedservices = &p.supportedservices.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateNetworkOfferingParams) fromSerialized(s *serializedCreateNetworkOfferingParams) {
+	*p = CreateNetworkOfferingParams{}
+	if s.Availability != nil {
+		p.SetAvailability(*s.Availability)
+	}
+	if s.Conservemode != nil {
+		p.SetConservemode(*s.Conservemode)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Egressdefaultpolicy != nil {
+		p.SetEgressdefaultpolicy(*s.Egressdefaultpolicy)
+	}
+	if s.Enable != nil {
+		p.SetEnable(*s.Enable)
+	}
+	if s.Fortungsten != nil {
+		p.SetFortungsten(*s.Fortungsten)
+	}
+	if s.Forvpc != nil {
+		p.SetForvpc(*s.Forvpc)
+	}
+	if s.Guestiptype != nil {
+		p.SetGuestiptype(*s.Guestiptype)
+	}
+	if s.Internetprotocol != nil {
+		p.SetInternetprotocol(*s.Internetprotocol)
+	}
+	if s.Ispersistent != nil {
+		p.SetIspersistent(*s.Ispersistent)
+	}
+	if s.Keepaliveenabled != nil {
+		p.SetKeepaliveenabled(*s.Keepaliveenabled)
+	}
+	if s.Maxconnections != nil {
+		p.SetMaxconnections(*s.Maxconnections)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkrate != nil {
+		p.SetNetworkrate(*s.Networkrate)
+	}
+	if s.Servicecapabilitylist != nil {
+		p.SetServicecapabilitylist(*s.Servicecapabilitylist)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Serviceproviderlist != nil {
+		p.SetServiceproviderlist(*s.Serviceproviderlist)
+	}
+	if s.Specifyipranges != nil {
+		p.SetSpecifyipranges(*s.Specifyipranges)
+	}
+	if s.Specifyvlan != nil {
+		p.SetSpecifyvlan(*s.Specifyvlan)
+	}
+	if s.Supportedservices != nil {
+		p.SetSupportedservices(*s.Supportedservices)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateNetworkOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateNetworkOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateNetworkOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateNetworkOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, traffictype string) *CreateNetworkOfferingParams {
 	p := &CreateNetworkOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["guestiptype"] = guestiptype
-	p.p["name"] = name
-	p.p["traffictype"] = traffictype
+	p.SetDisplaytext(displaytext)
+	p.SetGuestiptype(guestiptype)
+	p.SetName(name)
+	p.SetTraffictype(traffictype)
 	return p
 }
 
 // Creates a network offering.
-func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkOffering", p.toURLValues())
+//
+// Required params: displaytext, guestiptype, name, traffictype.
+func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams, opts ...CallOption) (*CreateNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequest("createNetworkOffering", s.cs.encodeParams("createNetworkOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -767,53 +1032,132 @@ type CreateNetworkOfferingResponseServiceCapability struct {
 }
 
 type DeleteNetworkOfferingParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNetworkOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteNetworkOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteNetworkOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNetworkOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNetworkOfferingParams(u url.Values) (*DeleteNetworkOfferingParams, error) {
+	p := &DeleteNetworkOfferingParams{}
+	if err := checkParamNames("deleteNetworkOffering", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteNetworkOfferingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteNetworkOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteNetworkOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteNetworkOfferingParams) Clone() *DeleteNetworkOfferingParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNetworkOfferingParams) Equal(o *DeleteNetworkOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteNetworkOfferingParams is used to (un)marshal DeleteNetworkOfferingParams using the API param names
+type serializedDeleteNetworkOfferingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteNetworkOfferingParams) toSerialized() *serializedDeleteNetworkOfferingParams {
+	s := &serializedDeleteNetworkOfferingParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteNetworkOfferingParams) fromSerialized(s *serializedDeleteNetworkOfferingParams) {
+	*p = DeleteNetworkOfferingParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNetworkOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNetworkOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNetworkOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNetworkOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams {
 	p := &DeleteNetworkOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a network offering.
-func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkOffering", p.toURLValues())
+//
+// Required params: id.
+func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams, opts ...CallOption) (*DeleteNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequest("deleteNetworkOffering", s.cs.encodeParams("deleteNetworkOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -861,544 +1205,764 @@ func (r *DeleteNetworkOfferingResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListNetworkOfferingsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
+	availability       optString
+	displaytext        optString
+	domainid           optString
+	forvpc             optBool
+	guestiptype        optString
+	id                 optString
+	isdefault          optBool
+	istagged           optBool
+	keyword            optString
+	name               optString
+	networkid          optString
+	page               optInt
+	pagesize           optInt
+	sourcenatsupported optBool
+	specifyipranges    optBool
+	specifyvlan        optBool
+	state              optString
+	supportedservices  optStrings
+	tags               optString
+	traffictype        optString
+	zoneid             optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworkOfferingsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["availability"]; found {
-		u.Set("availability", v.(string))
+	if p.availability.ok {
+		u.Set("availability", p.availability.v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvpc", vv)
+	if p.forvpc.ok {
+		u.Set("forvpc", strconv.FormatBool(p.forvpc.v))
 	}
-	if v, found := p.p["guestiptype"]; found {
-		u.Set("guestiptype", v.(string))
+	if p.guestiptype.ok {
+		u.Set("guestiptype", p.guestiptype.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isdefault"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdefault", vv)
+	if p.isdefault.ok {
+		u.Set("isdefault", strconv.FormatBool(p.isdefault.v))
 	}
-	if v, found := p.p["istagged"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("istagged", vv)
+	if p.istagged.ok {
+		u.Set("istagged", strconv.FormatBool(p.istagged.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["sourcenatsupported"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sourcenatsupported", vv)
+	if p.sourcenatsupported.ok {
+		u.Set("sourcenatsupported", strconv.FormatBool(p.sourcenatsupported.v))
 	}
-	if v, found := p.p["specifyipranges"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("specifyipranges", vv)
+	if p.specifyipranges.ok {
+		u.Set("specifyipranges", strconv.FormatBool(p.specifyipranges.v))
 	}
-	if v, found := p.p["specifyvlan"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("specifyvlan", vv)
+	if p.specifyvlan.ok {
+		u.Set("specifyvlan", strconv.FormatBool(p.specifyvlan.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("supportedservices", vv)
+	if p.supportedservices.ok {
+		u.Set("supportedservices", strings.Join(p.supportedservices.v, ","))
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworkOfferingsParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworkOfferingsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworkOfferingsParams(u url.Values) (*ListNetworkOfferingsParams, error) {
+	p := &ListNetworkOfferingsParams{}
+	if err := checkParamNames("listNetworkOfferings", u, "availability", "displaytext", "domainid", "forvpc", "guestiptype", "id", "isdefault", "istagged", "keyword", "name", "networkid", "page", "pagesize", "sourcenatsupported", "specifyipranges", "specifyvlan", "state", "supportedservices", "tags", "traffictype", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["availability"]; found {
+		p.SetAvailability(u.Get("availability"))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["forvpc"]; found {
+		v, err := strconv.ParseBool(u.Get("forvpc"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvpc: %v", err)
+		}
+		p.SetForvpc(v)
+	}
+	if _, found := u["guestiptype"]; found {
+		p.SetGuestiptype(u.Get("guestiptype"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isdefault"]; found {
+		v, err := strconv.ParseBool(u.Get("isdefault"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdefault: %v", err)
+		}
+		p.SetIsdefault(v)
+	}
+	if _, found := u["istagged"]; found {
+		v, err := strconv.ParseBool(u.Get("istagged"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param istagged: %v", err)
+		}
+		p.SetIstagged(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["availability"] = v
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["sourcenatsupported"]; found {
+		v, err := strconv.ParseBool(u.Get("sourcenatsupported"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sourcenatsupported: %v", err)
+		}
+		p.SetSourcenatsupported(v)
+	}
+	if _, found := u["specifyipranges"]; found {
+		v, err := strconv.ParseBool(u.Get("specifyipranges"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param specifyipranges: %v", err)
+		}
+		p.SetSpecifyipranges(v)
+	}
+	if _, found := u["specifyvlan"]; found {
+		v, err := strconv.ParseBool(u.Get("specifyvlan"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param specifyvlan: %v", err)
+		}
+		p.SetSpecifyvlan(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["supportedservices"]; found {
+		p.SetSupportedservices(strings.Split(u.Get("supportedservices"), ","))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAvailability sets the availability param.
+func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
+	p.availability = optString{v: v, ok: true}
 }
 
+// ResetAvailability unsets the availability param
 func (p *ListNetworkOfferingsParams) ResetAvailability() {
-	if p.p != nil && p.p["availability"] != nil {
-		delete(p.p, "availability")
-	}
+	p.availability = optString{}
 }
 
+// GetAvailability returns the availability param and if it is set
 func (p *ListNetworkOfferingsParams) GetAvailability() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["availability"].(string)
-	return value, ok
+	return p.availability.v, p.availability.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *ListNetworkOfferingsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *ListNetworkOfferingsParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *ListNetworkOfferingsParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListNetworkOfferingsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListNetworkOfferingsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListNetworkOfferingsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetForvpc sets the forvpc param.
 func (p *ListNetworkOfferingsParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
+	p.forvpc = optBool{v: v, ok: true}
 }
 
+// ResetForvpc unsets the forvpc param
 func (p *ListNetworkOfferingsParams) ResetForvpc() {
-	if p.p != nil && p.p["forvpc"] != nil {
-		delete(p.p, "forvpc")
-	}
+	p.forvpc = optBool{}
 }
 
+// GetForvpc returns the forvpc param and if it is set
 func (p *ListNetworkOfferingsParams) GetForvpc() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvpc"].(bool)
-	return value, ok
+	return p.forvpc.v, p.forvpc.ok
 }
 
+// SetGuestiptype sets the guestiptype param.
 func (p *ListNetworkOfferingsParams) SetGuestiptype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestiptype"] = v
+	p.guestiptype = optString{v: v, ok: true}
 }
 
+// ResetGuestiptype unsets the guestiptype param
 func (p *ListNetworkOfferingsParams) ResetGuestiptype() {
-	if p.p != nil && p.p["guestiptype"] != nil {
-		delete(p.p, "guestiptype")
-	}
+	p.guestiptype = optString{}
 }
 
+// GetGuestiptype returns the guestiptype param and if it is set
 func (p *ListNetworkOfferingsParams) GetGuestiptype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestiptype"].(string)
-	return value, ok
+	return p.guestiptype.v, p.guestiptype.ok
 }
 
+// SetId sets the id param.
 func (p *ListNetworkOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListNetworkOfferingsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListNetworkOfferingsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsdefault sets the isdefault param.
 func (p *ListNetworkOfferingsParams) SetIsdefault(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdefault"] = v
+	p.isdefault = optBool{v: v, ok: true}
 }
 
+// ResetIsdefault unsets the isdefault param
 func (p *ListNetworkOfferingsParams) ResetIsdefault() {
-	if p.p != nil && p.p["isdefault"] != nil {
-		delete(p.p, "isdefault")
-	}
+	p.isdefault = optBool{}
 }
 
+// GetIsdefault returns the isdefault param and if it is set
 func (p *ListNetworkOfferingsParams) GetIsdefault() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdefault"].(bool)
-	return value, ok
+	return p.isdefault.v, p.isdefault.ok
 }
 
+// SetIstagged sets the istagged param.
 func (p *ListNetworkOfferingsParams) SetIstagged(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["istagged"] = v
+	p.istagged = optBool{v: v, ok: true}
 }
 
+// ResetIstagged unsets the istagged param
 func (p *ListNetworkOfferingsParams) ResetIstagged() {
-	if p.p != nil && p.p["istagged"] != nil {
-		delete(p.p, "istagged")
-	}
+	p.istagged = optBool{}
 }
 
+// GetIstagged returns the istagged param and if it is set
 func (p *ListNetworkOfferingsParams) GetIstagged() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["istagged"].(bool)
-	return value, ok
+	return p.istagged.v, p.istagged.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListNetworkOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetworkOfferingsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetworkOfferingsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListNetworkOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListNetworkOfferingsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListNetworkOfferingsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListNetworkOfferingsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListNetworkOfferingsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListNetworkOfferingsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetworkOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetworkOfferingsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetworkOfferingsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetworkOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetworkOfferingsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetworkOfferingsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetSourcenatsupported sets the sourcenatsupported param.
 func (p *ListNetworkOfferingsParams) SetSourcenatsupported(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcenatsupported"] = v
+	p.sourcenatsupported = optBool{v: v, ok: true}
 }
 
+// ResetSourcenatsupported unsets the sourcenatsupported param
 func (p *ListNetworkOfferingsParams) ResetSourcenatsupported() {
-	if p.p != nil && p.p["sourcenatsupported"] != nil {
-		delete(p.p, "sourcenatsupported")
-	}
+	p.sourcenatsupported = optBool{}
 }
 
+// GetSourcenatsupported returns the sourcenatsupported param and if it is set
 func (p *ListNetworkOfferingsParams) GetSourcenatsupported() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourcenatsupported"].(bool)
-	return value, ok
+	return p.sourcenatsupported.v, p.sourcenatsupported.ok
 }
 
+// SetSpecifyipranges sets the specifyipranges param.
 func (p *ListNetworkOfferingsParams) SetSpecifyipranges(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["specifyipranges"] = v
+	p.specifyipranges = optBool{v: v, ok: true}
 }
 
+// ResetSpecifyipranges unsets the specifyipranges param
 func (p *ListNetworkOfferingsParams) ResetSpecifyipranges() {
-	if p.p != nil && p.p["specifyipranges"] != nil {
-		delete(p.p, "specifyipranges")
-	}
+	p.specifyipranges = optBool{}
 }
 
+// GetSpecifyipranges returns the specifyipranges param and if it is set
 func (p *ListNetworkOfferingsParams) GetSpecifyipranges() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["specifyipranges"].(bool)
-	return value, ok
+	return p.specifyipranges.v, p.specifyipranges.ok
 }
 
+// SetSpecifyvlan sets the specifyvlan param.
 func (p *ListNetworkOfferingsParams) SetSpecifyvlan(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["specifyvlan"] = v
+	p.specifyvlan = optBool{v: v, ok: true}
 }
 
+// ResetSpecifyvlan unsets the specifyvlan param
 func (p *ListNetworkOfferingsParams) ResetSpecifyvlan() {
-	if p.p != nil && p.p["specifyvlan"] != nil {
-		delete(p.p, "specifyvlan")
-	}
+	p.specifyvlan = optBool{}
 }
 
+// GetSpecifyvlan returns the specifyvlan param and if it is set
 func (p *ListNetworkOfferingsParams) GetSpecifyvlan() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["specifyvlan"].(bool)
-	return value, ok
+	return p.specifyvlan.v, p.specifyvlan.ok
 }
 
+// SetState sets the state param.
 func (p *ListNetworkOfferingsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListNetworkOfferingsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListNetworkOfferingsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetSupportedservices sets the supportedservices param.
 func (p *ListNetworkOfferingsParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
+	p.supportedservices = optStrings{v: v, ok: true}
 }
 
+// ResetSupportedservices unsets the supportedservices param
 func (p *ListNetworkOfferingsParams) ResetSupportedservices() {
-	if p.p != nil && p.p["supportedservices"] != nil {
-		delete(p.p, "supportedservices")
-	}
+	p.supportedservices = optStrings{}
 }
 
+// GetSupportedservices returns the supportedservices param and if it is set
 func (p *ListNetworkOfferingsParams) GetSupportedservices() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["supportedservices"].([]string)
-	return value, ok
+	return p.supportedservices.v, p.supportedservices.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListNetworkOfferingsParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optString{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListNetworkOfferingsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optString{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListNetworkOfferingsParams) GetTags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetTraffictype sets the traffictype param.
 func (p *ListNetworkOfferingsParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *ListNetworkOfferingsParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *ListNetworkOfferingsParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	return p.traffictype.v, p.traffictype.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListNetworkOfferingsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListNetworkOfferingsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListNetworkOfferingsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetworkOfferingsParams) Clone() *ListNetworkOfferingsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.supportedservices = p.supportedservices.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworkOfferingsParams) Equal(o *ListNetworkOfferingsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.availability == o.availability &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.forvpc == o.forvpc &&
+		p.guestiptype == o.guestiptype &&
+		p.id == o.id &&
+		p.isdefault == o.isdefault &&
+		p.istagged == o.istagged &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.sourcenatsupported == o.sourcenatsupported &&
+		p.specifyipranges == o.specifyipranges &&
+		p.specifyvlan == o.specifyvlan &&
+		p.state == o.state &&
+		p.supportedservices.equal(o.supportedservices) &&
+		p.tags == o.tags &&
+		p.traffictype == o.traffictype &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListNetworkOfferingsParams is used to (un)marshal ListNetworkOfferingsParams using the API param names
+type serializedListNetworkOfferingsParams struct {
+	Availability       *string   `json:"availability,omitempty" yaml:"availability,omitempty"`
+	Displaytext        *string   `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid           *string   `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvpc             *bool     `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Guestiptype        *string   `json:"guestiptype,omitempty" yaml:"guestiptype,omitempty"`
+	Id                 *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdefault          *bool     `json:"isdefault,omitempty" yaml:"isdefault,omitempty"`
+	Istagged           *bool     `json:"istagged,omitempty" yaml:"istagged,omitempty"`
+	Keyword            *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name               *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid          *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page               *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize           *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Sourcenatsupported *bool     `json:"sourcenatsupported,omitempty" yaml:"sourcenatsupported,omitempty"`
+	Specifyipranges    *bool     `json:"specifyipranges,omitempty" yaml:"specifyipranges,omitempty"`
+	Specifyvlan        *bool     `json:"specifyvlan,omitempty" yaml:"specifyvlan,omitempty"`
+	State              *string   `json:"state,omitempty" yaml:"state,omitempty"`
+	Supportedservices  *[]string `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags               *string   `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype        *string   `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+	Zoneid             *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListNetworkOfferingsParams) toSerialized() *serializedListNetworkOfferingsParams {
+	s := &serializedListNetworkOfferingsParams{}
+	if p.availability.ok {
+		s.Availability = &p.availability.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.forvpc.ok {
+		s.Forvpc = &p.forvpc.v
+	}
+	if p.guestiptype.ok {
+		s.Guestiptype = &p.guestiptype.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.isdefault.ok {
+		s.Isdefault = &p.isdefault.v
+	}
+	if p.istagged.ok {
+		s.Istagged = &p.istagged.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.sourcenatsupported.ok {
+		s.Sourcenatsupported = &p.sourcenatsupported.v
+	}
+	if p.specifyipranges.ok {
+		s.Specifyipranges = &p.specifyipranges.v
+	}
+	if p.specifyvlan.ok {
+		s.Specifyvlan = &p.specifyvlan.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.supportedservices.ok {
+		s.Supportedservices = &p.supportedservices.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListNetworkOfferingsParams) fromSerialized(s *serializedListNetworkOfferingsParams) {
+	*p = ListNetworkOfferingsParams{}
+	if s.Availability != nil {
+		p.SetAvailability(*s.Availability)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Forvpc != nil {
+		p.SetForvpc(*s.Forvpc)
+	}
+	if s.Guestiptype != nil {
+		p.SetGuestiptype(*s.Guestiptype)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isdefault != nil {
+		p.SetIsdefault(*s.Isdefault)
+	}
+	if s.Istagged != nil {
+		p.SetIstagged(*s.Istagged)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Sourcenatsupported != nil {
+		p.SetSourcenatsupported(*s.Sourcenatsupported)
+	}
+	if s.Specifyipranges != nil {
+		p.SetSpecifyipranges(*s.Specifyipranges)
+	}
+	if s.Specifyvlan != nil {
+		p.SetSpecifyvlan(*s.Specifyvlan)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Supportedservices != nil {
+		p.SetSupportedservices(*s.Supportedservices)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworkOfferingsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworkOfferingsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworkOfferingsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworkOfferingsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworkOfferingsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworkOfferingsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetworkOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewListNetworkOfferingsParams() *ListNetworkOfferingsParams {
 	p := &ListNetworkOfferingsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1446,9 +2010,8 @@ func (s *NetworkOfferingService) GetNetworkOfferingByName(name string, opts ...O
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
 	p := &ListNetworkOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1477,8 +2040,8 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 }
 
 // Lists all available network offerings.
-func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkOfferings", p.toURLValues())
+func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams, opts ...CallOption) (*ListNetworkOfferingsResponse, error) {
+	resp, err := s.cs.newRequest("listNetworkOfferings", s.cs.encodeParams("listNetworkOfferings", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1554,295 +2117,441 @@ type NetworkOfferingServiceInternalCapability struct {
 }
 
 type UpdateNetworkOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
+	availability     optString
+	displaytext      optString
+	domainid         optString
+	id               optString
+	keepaliveenabled optBool
+	maxconnections   optInt
+	name             optString
+	sortkey          optInt
+	state            optString
+	tags             optString
+	zoneid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateNetworkOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["availability"]; found {
-		u.Set("availability", v.(string))
+	if p.availability.ok {
+		u.Set("availability", p.availability.v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keepaliveenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("keepaliveenabled", vv)
+	if p.keepaliveenabled.ok {
+		u.Set("keepaliveenabled", strconv.FormatBool(p.keepaliveenabled.v))
 	}
-	if v, found := p.p["maxconnections"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxconnections", vv)
+	if p.maxconnections.ok {
+		u.Set("maxconnections", strconv.Itoa(p.maxconnections.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if p.sortkey.ok {
+		u.Set("sortkey", strconv.Itoa(p.sortkey.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateNetworkOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateNetworkOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateNetworkOfferingParams(u url.Values) (*UpdateNetworkOfferingParams, error) {
+	p := &UpdateNetworkOfferingParams{}
+	if err := checkParamNames("updateNetworkOffering", u, "availability", "displaytext", "domainid", "id", "keepaliveenabled", "maxconnections", "name", "sortkey", "state", "tags", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["availability"]; found {
+		p.SetAvailability(u.Get("availability"))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["availability"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keepaliveenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("keepaliveenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param keepaliveenabled: %v", err)
+		}
+		p.SetKeepaliveenabled(v)
+	}
+	if _, found := u["maxconnections"]; found {
+		v, err := strconv.Atoi(u.Get("maxconnections"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxconnections: %v", err)
+		}
+		p.SetMaxconnections(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["sortkey"]; found {
+		v, err := strconv.Atoi(u.Get("sortkey"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sortkey: %v", err)
+		}
+		p.SetSortkey(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAvailability sets the availability param.
+func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
+	p.availability = optString{v: v, ok: true}
+}
+
+// ResetAvailability unsets the availability param
 func (p *UpdateNetworkOfferingParams) ResetAvailability() {
-	if p.p != nil && p.p["availability"] != nil {
-		delete(p.p, "availability")
-	}
+	p.availability = optString{}
 }
 
+// GetAvailability returns the availability param and if it is set
 func (p *UpdateNetworkOfferingParams) GetAvailability() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["availability"].(string)
-	return value, ok
+	return p.availability.v, p.availability.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *UpdateNetworkOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateNetworkOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateNetworkOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateNetworkOfferingParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateNetworkOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateNetworkOfferingParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *UpdateNetworkOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateNetworkOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateNetworkOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeepaliveenabled sets the keepaliveenabled param.
 func (p *UpdateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keepaliveenabled"] = v
+	p.keepaliveenabled = optBool{v: v, ok: true}
 }
 
+// ResetKeepaliveenabled unsets the keepaliveenabled param
 func (p *UpdateNetworkOfferingParams) ResetKeepaliveenabled() {
-	if p.p != nil && p.p["keepaliveenabled"] != nil {
-		delete(p.p, "keepaliveenabled")
-	}
+	p.keepaliveenabled = optBool{}
 }
 
+// GetKeepaliveenabled returns the keepaliveenabled param and if it is set
 func (p *UpdateNetworkOfferingParams) GetKeepaliveenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keepaliveenabled"].(bool)
-	return value, ok
+	return p.keepaliveenabled.v, p.keepaliveenabled.ok
 }
 
+// SetMaxconnections sets the maxconnections param.
 func (p *UpdateNetworkOfferingParams) SetMaxconnections(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxconnections"] = v
+	p.maxconnections = optInt{v: v, ok: true}
 }
 
+// ResetMaxconnections unsets the maxconnections param
 func (p *UpdateNetworkOfferingParams) ResetMaxconnections() {
-	if p.p != nil && p.p["maxconnections"] != nil {
-		delete(p.p, "maxconnections")
-	}
+	p.maxconnections = optInt{}
 }
 
+// GetMaxconnections returns the maxconnections param and if it is set
 func (p *UpdateNetworkOfferingParams) GetMaxconnections() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxconnections"].(int)
-	return value, ok
+	return p.maxconnections.v, p.maxconnections.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateNetworkOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateNetworkOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateNetworkOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetSortkey sets the sortkey param.
 func (p *UpdateNetworkOfferingParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
+	p.sortkey = optInt{v: v, ok: true}
 }
 
+// ResetSortkey unsets the sortkey param
 func (p *UpdateNetworkOfferingParams) ResetSortkey() {
-	if p.p != nil && p.p["sortkey"] != nil {
-		delete(p.p, "sortkey")
-	}
+	p.sortkey = optInt{}
 }
 
+// GetSortkey returns the sortkey param and if it is set
 func (p *UpdateNetworkOfferingParams) GetSortkey() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sortkey"].(int)
-	return value, ok
+	return p.sortkey.v, p.sortkey.ok
 }
 
+// SetState sets the state param.
 func (p *UpdateNetworkOfferingParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *UpdateNetworkOfferingParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *UpdateNetworkOfferingParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetTags sets the tags param.
 func (p *UpdateNetworkOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optString{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *UpdateNetworkOfferingParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optString{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *UpdateNetworkOfferingParams) GetTags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *UpdateNetworkOfferingParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *UpdateNetworkOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *UpdateNetworkOfferingParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateNetworkOfferingParams) Clone() *UpdateNetworkOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateNetworkOfferingParams) Equal(o *UpdateNetworkOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.availability == o.availability &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.keepaliveenabled == o.keepaliveenabled &&
+		p.maxconnections == o.maxconnections &&
+		p.name == o.name &&
+		p.sortkey == o.sortkey &&
+		p.state == o.state &&
+		p.tags == o.tags &&
+		p.zoneid == o.zoneid
+}
+
+// serializedUpdateNetworkOfferingParams is used to (un)marshal UpdateNetworkOfferingParams using the API param names
+type serializedUpdateNetworkOfferingParams struct {
+	Availability     *string `json:"availability,omitempty" yaml:"availability,omitempty"`
+	Displaytext      *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keepaliveenabled *bool   `json:"keepaliveenabled,omitempty" yaml:"keepaliveenabled,omitempty"`
+	Maxconnections   *int    `json:"maxconnections,omitempty" yaml:"maxconnections,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey          *int    `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+	State            *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags             *string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Zoneid           *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *UpdateNetworkOfferingParams) toSerialized() *serializedUpdateNetworkOfferingParams {
+	s := &serializedUpdateNetworkOfferingParams{}
+	if p.availability.ok {
+		s.Availability = &p.availability.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keepaliveenabled.ok {
+		s.Keepaliveenabled = &p.keepaliveenabled.v
+	}
+	if p.maxconnections.ok {
+		s.Maxconnections = &p.maxconnections.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.sortkey.ok {
+		s.Sortkey = &p.sortkey.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *UpdateNetworkOfferingParams) fromSerialized(s *serializedUpdateNetworkOfferingParams) {
+	*p = UpdateNetworkOfferingParams{}
+	if s.Availability != nil {
+		p.SetAvailability(*s.Availability)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keepaliveenabled != nil {
+		p.SetKeepaliveenabled(*s.Keepaliveenabled)
+	}
+	if s.Maxconnections != nil {
+		p.SetMaxconnections(*s.Maxconnections)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Sortkey != nil {
+		p.SetSortkey(*s.Sortkey)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateNetworkOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateNetworkOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateNetworkOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateNetworkOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams {
 	p := &UpdateNetworkOfferingParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Updates a network offering.
-func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkOffering", p.toURLValues())
+func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams, opts ...CallOption) (*UpdateNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequest("updateNetworkOffering", s.cs.encodeParams("updateNetworkOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +53,43 @@ func (m *MockNetworkOfferingServiceIface) EXPECT() *MockNetworkOfferingServiceIf
 }
 
 // CreateNetworkOffering mocks base method.
-func (m *MockNetworkOfferingServiceIface) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+func (m *MockNetworkOfferingServiceIface) CreateNetworkOffering(p *CreateNetworkOfferingParams, opts ...CallOption) (*CreateNetworkOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNetworkOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetworkOffering", varargs...)
 	ret0, _ := ret[0].(*CreateNetworkOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNetworkOffering indicates an expected call of CreateNetworkOffering.
-func (mr *MockNetworkOfferingServiceIfaceMockRecorder) CreateNetworkOffering(p interface{}) *gomock.Call {
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) CreateNetworkOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).CreateNetworkOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).CreateNetworkOffering), varargs...)
 }
 
 // DeleteNetworkOffering mocks base method.
-func (m *MockNetworkOfferingServiceIface) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
+func (m *MockNetworkOfferingServiceIface) DeleteNetworkOffering(p *DeleteNetworkOfferingParams, opts ...CallOption) (*DeleteNetworkOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNetworkOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetworkOffering", varargs...)
 	ret0, _ := ret[0].(*DeleteNetworkOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNetworkOffering indicates an expected call of DeleteNetworkOffering.
-func (mr *MockNetworkOfferingServiceIfaceMockRecorder) DeleteNetworkOffering(p interface{}) *gomock.Call {
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) DeleteNetworkOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).DeleteNetworkOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).DeleteNetworkOffering), varargs...)
 }
 
 // GetNetworkOfferingByID mocks base method.
@@ -146,18 +156,23 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) GetNetworkOfferingID(name
 }
 
 // ListNetworkOfferings mocks base method.
-func (m *MockNetworkOfferingServiceIface) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+func (m *MockNetworkOfferingServiceIface) ListNetworkOfferings(p *ListNetworkOfferingsParams, opts ...CallOption) (*ListNetworkOfferingsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkOfferings", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkOfferings", varargs...)
 	ret0, _ := ret[0].(*ListNetworkOfferingsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworkOfferings indicates an expected call of ListNetworkOfferings.
-func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferings(p interface{}) *gomock.Call {
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferings(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferings", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferings), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferings", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferings), varargs...)
 }
 
 // NewCreateNetworkOfferingParams mocks base method.
@@ -217,16 +232,21 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) NewUpdateNetworkOfferingP
 }
 
 // UpdateNetworkOffering mocks base method.
-func (m *MockNetworkOfferingServiceIface) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+func (m *MockNetworkOfferingServiceIface) UpdateNetworkOffering(p *UpdateNetworkOfferingParams, opts ...CallOption) (*UpdateNetworkOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNetworkOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNetworkOffering", varargs...)
 	ret0, _ := ret[0].(*UpdateNetworkOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateNetworkOffering indicates an expected call of UpdateNetworkOffering.
-func (mr *MockNetworkOfferingServiceIfaceMockRecorder) UpdateNetworkOffering(p interface{}) *gomock.Call {
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) UpdateNetworkOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).UpdateNetworkOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).UpdateNetworkOffering), varargs...)
 }
@@ -28,213 +28,311 @@ import (
 )
 
 type NetworkServiceIface interface {
-	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams, opts ...CallOption) (*AddNetworkServiceProviderResponse, error)
 	NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams
-	AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightController(p *AddOpenDaylightControllerParams, opts ...CallOption) (*AddOpenDaylightControllerResponse, error)
 	NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) *AddOpenDaylightControllerParams
-	CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	CreateNetwork(p *CreateNetworkParams, opts ...CallOption) (*CreateNetworkResponse, error)
 	NewCreateNetworkParams(name string, networkofferingid string, zoneid string) *CreateNetworkParams
-	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams, opts ...CallOption) (*CreatePhysicalNetworkResponse, error)
 	NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams
-	CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstance(p *CreateServiceInstanceParams, opts ...CallOption) (*CreateServiceInstanceResponse, error)
 	NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams
-	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams, opts ...CallOption) (*CreateStorageNetworkIpRangeResponse, error)
 	NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams
-	DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRange(p *DedicatePublicIpRangeParams, opts ...CallOption) (*DedicatePublicIpRangeResponse, error)
 	NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams
-	DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetwork(p *DeleteNetworkParams, opts ...CallOption) (*DeleteNetworkResponse, error)
 	NewDeleteNetworkParams(id string) *DeleteNetworkParams
-	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams, opts ...CallOption) (*DeleteNetworkServiceProviderResponse, error)
 	NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams
-	DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams, opts ...CallOption) (*DeleteOpenDaylightControllerResponse, error)
 	NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams
-	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams, opts ...CallOption) (*DeletePhysicalNetworkResponse, error)
 	NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams
-	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams, opts ...CallOption) (*DeleteStorageNetworkIpRangeResponse, error)
 	NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams
-	ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams, opts ...CallOption) (*ListNetscalerLoadBalancerNetworksResponse, error)
 	NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams
 	GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
-	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams, opts ...CallOption) (*ListNetworkIsolationMethodsResponse, error)
 	NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams
-	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams, opts ...CallOption) (*ListNetworkServiceProvidersResponse, error)
 	NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams
 	GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error)
-	ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNetworks(p *ListNetworksParams, opts ...CallOption) (*ListNetworksResponse, error)
 	NewListNetworksParams() *ListNetworksParams
 	GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error)
 	GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error)
 	GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error)
-	ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams, opts ...CallOption) (*ListNiciraNvpDeviceNetworksResponse, error)
 	NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams
 	GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
-	ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
+	ListOpenDaylightControllers(p *ListOpenDaylightControllersParams, opts ...CallOption) (*ListOpenDaylightControllersResponse, error)
 	NewListOpenDaylightControllersParams() *ListOpenDaylightControllersParams
 	GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error)
-	ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams, opts ...CallOption) (*ListPaloAltoFirewallNetworksResponse, error)
 	NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams
 	GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
-	ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworks(p *ListPhysicalNetworksParams, opts ...CallOption) (*ListPhysicalNetworksResponse, error)
 	NewListPhysicalNetworksParams() *ListPhysicalNetworksParams
 	GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error)
 	GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
 	GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
-	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams, opts ...CallOption) (*ListStorageNetworkIpRangeResponse, error)
 	NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams
 	GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
-	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams, opts ...CallOption) (*ListSupportedNetworkServicesResponse, error)
 	NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams
-	ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRange(p *ReleasePublicIpRangeParams, opts ...CallOption) (*ReleasePublicIpRangeResponse, error)
 	NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams
-	RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetwork(p *RestartNetworkParams, opts ...CallOption) (*RestartNetworkResponse, error)
 	NewRestartNetworkParams(id string) *RestartNetworkParams
-	UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetwork(p *UpdateNetworkParams, opts ...CallOption) (*UpdateNetworkResponse, error)
 	NewUpdateNetworkParams(id string) *UpdateNetworkParams
-	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams, opts ...CallOption) (*UpdateNetworkServiceProviderResponse, error)
 	NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams
-	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams, opts ...CallOption) (*UpdatePhysicalNetworkResponse, error)
 	NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams
-	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams, opts ...CallOption) (*UpdateStorageNetworkIpRangeResponse, error)
 	NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams
-	DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error)
+	DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams, opts ...CallOption) (*DeleteGuestNetworkIpv6PrefixResponse, error)
 	NewDeleteGuestNetworkIpv6PrefixParams(id string) *DeleteGuestNetworkIpv6PrefixParams
-	CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error)
+	CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams, opts ...CallOption) (*CreateGuestNetworkIpv6PrefixResponse, error)
 	NewCreateGuestNetworkIpv6PrefixParams(prefix string, zoneid string) *CreateGuestNetworkIpv6PrefixParams
-	ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error)
+	ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams, opts ...CallOption) (*ListGuestNetworkIpv6PrefixesResponse, error)
 	NewListGuestNetworkIpv6PrefixesParams() *ListGuestNetworkIpv6PrefixesParams
 	GetGuestNetworkIpv6PrefixeByID(id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error)
-	CreateNetworkPermissions(p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error)
+	CreateNetworkPermissions(p *CreateNetworkPermissionsParams, opts ...CallOption) (*CreateNetworkPermissionsResponse, error)
 	NewCreateNetworkPermissionsParams(networkid string) *CreateNetworkPermissionsParams
-	ResetNetworkPermissions(p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error)
+	ResetNetworkPermissions(p *ResetNetworkPermissionsParams, opts ...CallOption) (*ResetNetworkPermissionsResponse, error)
 	NewResetNetworkPermissionsParams(networkid string) *ResetNetworkPermissionsParams
-	ListNetworkPermissions(p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error)
+	ListNetworkPermissions(p *ListNetworkPermissionsParams, opts ...CallOption) (*ListNetworkPermissionsResponse, error)
 	NewListNetworkPermissionsParams(networkid string) *ListNetworkPermissionsParams
-	RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error)
+	RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams, opts ...CallOption) (*RemoveNetworkPermissionsResponse, error)
 	NewRemoveNetworkPermissionsParams(networkid string) *RemoveNetworkPermissionsParams
 }
 
 type AddNetworkServiceProviderParams struct {
-	p map[string]interface{}
+	destinationphysicalnetworkid optString
+	name                         optString
+	physicalnetworkid            optString
+	servicelist                  optStrings
 }
 
-func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddNetworkServiceProviderParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["destinationphysicalnetworkid"]; found {
-		u.Set("destinationphysicalnetworkid", v.(string))
+	if p.destinationphysicalnetworkid.ok {
+		u.Set("destinationphysicalnetworkid", p.destinationphysicalnetworkid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["servicelist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("servicelist", vv)
+	if p.servicelist.ok {
+		u.Set("servicelist", strings.Join(p.servicelist.v, ","))
 	}
 	return u
 }
 
-func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddNetworkServiceProviderParams parses url.Values, for example taken from a raw API request,
+// into a new AddNetworkServiceProviderParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddNetworkServiceProviderParams(u url.Values) (*AddNetworkServiceProviderParams, error) {
+	p := &AddNetworkServiceProviderParams{}
+	if err := checkParamNames("addNetworkServiceProvider", u, "destinationphysicalnetworkid", "name", "physicalnetworkid", "servicelist"); err != nil {
+		return nil, err
+	}
+	if _, found := u["destinationphysicalnetworkid"]; found {
+		p.SetDestinationphysicalnetworkid(u.Get("destinationphysicalnetworkid"))
 	}
-	p.p["destinationphysicalnetworkid"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["servicelist"]; found {
+		p.SetServicelist(strings.Split(u.Get("servicelist"), ","))
+	}
+	return p, nil
+}
+
+// SetDestinationphysicalnetworkid sets the destinationphysicalnetworkid param.
+func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v string) {
+	p.destinationphysicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetDestinationphysicalnetworkid unsets the destinationphysicalnetworkid param
 func (p *AddNetworkServiceProviderParams) ResetDestinationphysicalnetworkid() {
-	if p.p != nil && p.p["destinationphysicalnetworkid"] != nil {
-		delete(p.p, "destinationphysicalnetworkid")
-	}
+	p.destinationphysicalnetworkid = optString{}
 }
 
+// GetDestinationphysicalnetworkid returns the destinationphysicalnetworkid param and if it is set
 func (p *AddNetworkServiceProviderParams) GetDestinationphysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["destinationphysicalnetworkid"].(string)
-	return value, ok
+	return p.destinationphysicalnetworkid.v, p.destinationphysicalnetworkid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *AddNetworkServiceProviderParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *AddNetworkServiceProviderParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *AddNetworkServiceProviderParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddNetworkServiceProviderParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddNetworkServiceProviderParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetServicelist sets the servicelist param.
 func (p *AddNetworkServiceProviderParams) SetServicelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["servicelist"] = v
+	p.servicelist = optStrings{v: v, ok: true}
 }
 
+// ResetServicelist unsets the servicelist param
 func (p *AddNetworkServiceProviderParams) ResetServicelist() {
-	if p.p != nil && p.p["servicelist"] != nil {
-		delete(p.p, "servicelist")
-	}
+	p.servicelist = optStrings{}
 }
 
+// GetServicelist returns the servicelist param and if it is set
 func (p *AddNetworkServiceProviderParams) GetServicelist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.servicelist.v, p.servicelist.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddNetworkServiceProviderParams) Clone() *AddNetworkServiceProviderParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.servicelist = p.servicelist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddNetworkServiceProviderParams) Equal(o *AddNetworkServiceProviderParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.destinationphysicalnetworkid == o.destinationphysicalnetworkid &&
+		p.name == o.name &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.servicelist.equal(o.servicelist)
+}
+
+// serializedAddNetworkServiceProviderParams is used to (un)marshal AddNetworkServiceProviderParams using the API param names
+type serializedAddNetworkServiceProviderParams struct {
+	Destinationphysicalnetworkid *string   `json:"destinationphysicalnetworkid,omitempty" yaml:"destinationphysicalnetworkid,omitempty"`
+	Name                         *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Physicalnetworkid            *string   `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Servicelist                  *[]string `json:"servicelist,omitempty" yaml:"servicelist,omitempty"`
+}
+
+func (p *AddNetworkServiceProviderParams) toSerialized() *serializedAddNetworkServiceProviderParams {
+	s := &serializedAddNetworkServiceProviderParams{}
+	if p.destinationphysicalnetworkid.ok {
+		s.Destinationphysicalnetworkid = &p.destinationphysicalnetworkid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
 	}
-	value, ok := p.p["servicelist"].([]string)
-	return value, ok
+	if p.servicelist.ok {
+		s.Servicelist = &p.servicelist.v
+	}
+	return s
+}
+
+func (p *AddNetworkServiceProviderParams) fromSerialized(s *serializedAddNetworkServiceProviderParams) {
+	*p = AddNetworkServiceProviderParams{}
+	if s.Destinationphysicalnetworkid != nil {
+		p.SetDestinationphysicalnetworkid(*s.Destinationphysicalnetworkid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Servicelist != nil {
+		p.SetServicelist(*s.Servicelist)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddNetworkServiceProviderParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddNetworkServiceProviderParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddNetworkServiceProviderParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddNetworkServiceProviderParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams {
 	p := &AddNetworkServiceProviderParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetName(name)
+	p.SetPhysicalnetworkid(physicalnetworkid)
 	return p
 }
 
-// Adds a network serviceProvider to a physical network
-func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkServiceProvider", p.toURLValues())
+// Adds a network serviceProvider to a physical network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: name, physicalnetworkid.
+func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams, opts ...CallOption) (*AddNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequest("addNetworkServiceProvider", s.cs.encodeParams("addNetworkServiceProvider", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -245,8 +343,8 @@ func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderP
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -280,128 +378,227 @@ type AddNetworkServiceProviderResponse struct {
 }
 
 type AddOpenDaylightControllerParams struct {
-	p map[string]interface{}
+	password          optString
+	physicalnetworkid optString
+	url               optString
+	username          optString
 }
 
-func (p *AddOpenDaylightControllerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddOpenDaylightControllerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddOpenDaylightControllerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddOpenDaylightControllerParams parses url.Values, for example taken from a raw API request,
+// into a new AddOpenDaylightControllerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddOpenDaylightControllerParams(u url.Values) (*AddOpenDaylightControllerParams, error) {
+	p := &AddOpenDaylightControllerParams{}
+	if err := checkParamNames("addOpenDaylightController", u, "password", "physicalnetworkid", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
 	}
-	p.p["password"] = v
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetPassword sets the password param. This param is required.
+func (p *AddOpenDaylightControllerParams) SetPassword(v string) {
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddOpenDaylightControllerParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddOpenDaylightControllerParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddOpenDaylightControllerParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddOpenDaylightControllerParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddOpenDaylightControllerParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddOpenDaylightControllerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddOpenDaylightControllerParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddOpenDaylightControllerParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddOpenDaylightControllerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddOpenDaylightControllerParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddOpenDaylightControllerParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddOpenDaylightControllerParams) Clone() *AddOpenDaylightControllerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddOpenDaylightControllerParams) Equal(o *AddOpenDaylightControllerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddOpenDaylightControllerParams is used to (un)marshal AddOpenDaylightControllerParams using the API param names
+type serializedAddOpenDaylightControllerParams struct {
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddOpenDaylightControllerParams) toSerialized() *serializedAddOpenDaylightControllerParams {
+	s := &serializedAddOpenDaylightControllerParams{}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddOpenDaylightControllerParams) fromSerialized(s *serializedAddOpenDaylightControllerParams) {
+	*p = AddOpenDaylightControllerParams{}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddOpenDaylightControllerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddOpenDaylightControllerParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddOpenDaylightControllerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddOpenDaylightControllerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddOpenDaylightControllerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddOpenDaylightControllerParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddOpenDaylightControllerParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) *AddOpenDaylightControllerParams {
 	p := &AddOpenDaylightControllerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds an OpenDyalight controler
-func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newRequest("addOpenDaylightController", p.toURLValues())
+// Adds an OpenDyalight controler.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: password,
+// physicalnetworkid, url, username.
+func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerParams, opts ...CallOption) (*AddOpenDaylightControllerResponse, error) {
+	resp, err := s.cs.newRequest("addOpenDaylightController", s.cs.encodeParams("addOpenDaylightController", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -412,8 +609,8 @@ func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerP
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -445,973 +642,1298 @@ type AddOpenDaylightControllerResponse struct {
 }
 
 type CreateNetworkParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateNetworkParams) toURLValues() url.Values {
+	account                   optString
+	aclid                     optString
+	acltype                   optString
+	associatednetworkid       optString
+	bypassvlanoverlapcheck    optBool
+	displaynetwork            optBool
+	displaytext               optString
+	dns1                      optString
+	dns2                      optString
+	domainid                  optString
+	endip                     optString
+	endipv6                   optString
+	externalid                optString
+	gateway                   optString
+	hideipaddressusage        optBool
+	ip6cidr                   optString
+	ip6dns1                   optString
+	ip6dns2                   optString
+	ip6gateway                optString
+	isolatedpvlan             optString
+	isolatedpvlantype         optString
+	name                      optString
+	netmask                   optString
+	networkdomain             optString
+	networkofferingid         optString
+	physicalnetworkid         optString
+	privatemtu                optInt
+	projectid                 optString
+	publicmtu                 optInt
+	routerip                  optString
+	routeripv6                optString
+	sourcenatipaddress        optString
+	startip                   optString
+	startipv6                 optString
+	subdomainaccess           optBool
+	tungstenvirtualrouteruuid optString
+	vlan                      optString
+	vpcid                     optString
+	zoneid                    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if p.aclid.ok {
+		u.Set("aclid", p.aclid.v)
 	}
-	if v, found := p.p["acltype"]; found {
-		u.Set("acltype", v.(string))
+	if p.acltype.ok {
+		u.Set("acltype", p.acltype.v)
 	}
-	if v, found := p.p["associatednetworkid"]; found {
-		u.Set("associatednetworkid", v.(string))
+	if p.associatednetworkid.ok {
+		u.Set("associatednetworkid", p.associatednetworkid.v)
 	}
-	if v, found := p.p["bypassvlanoverlapcheck"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bypassvlanoverlapcheck", vv)
+	if p.bypassvlanoverlapcheck.ok {
+		u.Set("bypassvlanoverlapcheck", strconv.FormatBool(p.bypassvlanoverlapcheck.v))
 	}
-	if v, found := p.p["displaynetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displaynetwork", vv)
+	if p.displaynetwork.ok {
+		u.Set("displaynetwork", strconv.FormatBool(p.displaynetwork.v))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["dns1"]; found {
-		u.Set("dns1", v.(string))
+	if p.dns1.ok {
+		u.Set("dns1", p.dns1.v)
 	}
-	if v, found := p.p["dns2"]; found {
-		u.Set("dns2", v.(string))
+	if p.dns2.ok {
+		u.Set("dns2", p.dns2.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["endipv6"]; found {
-		u.Set("endipv6", v.(string))
+	if p.endipv6.ok {
+		u.Set("endipv6", p.endipv6.v)
 	}
-	if v, found := p.p["externalid"]; found {
-		u.Set("externalid", v.(string))
+	if p.externalid.ok {
+		u.Set("externalid", p.externalid.v)
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["hideipaddressusage"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("hideipaddressusage", vv)
+	if p.hideipaddressusage.ok {
+		u.Set("hideipaddressusage", strconv.FormatBool(p.hideipaddressusage.v))
 	}
-	if v, found := p.p["ip6cidr"]; found {
-		u.Set("ip6cidr", v.(string))
+	if p.ip6cidr.ok {
+		u.Set("ip6cidr", p.ip6cidr.v)
 	}
-	if v, found := p.p["ip6dns1"]; found {
-		u.Set("ip6dns1", v.(string))
+	if p.ip6dns1.ok {
+		u.Set("ip6dns1", p.ip6dns1.v)
 	}
-	if v, found := p.p["ip6dns2"]; found {
-		u.Set("ip6dns2", v.(string))
+	if p.ip6dns2.ok {
+		u.Set("ip6dns2", p.ip6dns2.v)
 	}
-	if v, found := p.p["ip6gateway"]; found {
-		u.Set("ip6gateway", v.(string))
+	if p.ip6gateway.ok {
+		u.Set("ip6gateway", p.ip6gateway.v)
 	}
-	if v, found := p.p["isolatedpvlan"]; found {
-		u.Set("isolatedpvlan", v.(string))
+	if p.isolatedpvlan.ok {
+		u.Set("isolatedpvlan", p.isolatedpvlan.v)
 	}
-	if v, found := p.p["isolatedpvlantype"]; found {
-		u.Set("isolatedpvlantype", v.(string))
+	if p.isolatedpvlantype.ok {
+		u.Set("isolatedpvlantype", p.isolatedpvlantype.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
-	if v, found := p.p["networkofferingid"]; found {
-		u.Set("networkofferingid", v.(string))
+	if p.networkofferingid.ok {
+		u.Set("networkofferingid", p.networkofferingid.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["privatemtu"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privatemtu", vv)
+	if p.privatemtu.ok {
+		u.Set("privatemtu", strconv.Itoa(p.privatemtu.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["publicmtu"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicmtu", vv)
+	if p.publicmtu.ok {
+		u.Set("publicmtu", strconv.Itoa(p.publicmtu.v))
 	}
-	if v, found := p.p["routerip"]; found {
-		u.Set("routerip", v.(string))
+	if p.routerip.ok {
+		u.Set("routerip", p.routerip.v)
 	}
-	if v, found := p.p["routeripv6"]; found {
-		u.Set("routeripv6", v.(string))
+	if p.routeripv6.ok {
+		u.Set("routeripv6", p.routeripv6.v)
 	}
-	if v, found := p.p["sourcenatipaddress"]; found {
-		u.Set("sourcenatipaddress", v.(string))
+	if p.sourcenatipaddress.ok {
+		u.Set("sourcenatipaddress", p.sourcenatipaddress.v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
-	if v, found := p.p["startipv6"]; found {
-		u.Set("startipv6", v.(string))
+	if p.startipv6.ok {
+		u.Set("startipv6", p.startipv6.v)
 	}
-	if v, found := p.p["subdomainaccess"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("subdomainaccess", vv)
+	if p.subdomainaccess.ok {
+		u.Set("subdomainaccess", strconv.FormatBool(p.subdomainaccess.v))
 	}
-	if v, found := p.p["tungstenvirtualrouteruuid"]; found {
-		u.Set("tungstenvirtualrouteruuid", v.(string))
+	if p.tungstenvirtualrouteruuid.ok {
+		u.Set("tungstenvirtualrouteruuid", p.tungstenvirtualrouteruuid.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateNetworkParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateNetworkParams parses url.Values, for example taken from a raw API request,
+// into a new CreateNetworkParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateNetworkParams(u url.Values) (*CreateNetworkParams, error) {
+	p := &CreateNetworkParams{}
+	if err := checkParamNames("createNetwork", u, "account", "aclid", "acltype", "associatednetworkid", "bypassvlanoverlapcheck", "displaynetwork", "displaytext", "dns1", "dns2", "domainid", "endip", "endipv6", "externalid", "gateway", "hideipaddressusage", "ip6cidr", "ip6dns1", "ip6dns2", "ip6gateway", "isolatedpvlan", "isolatedpvlantype", "name", "netmask", "networkdomain", "networkofferingid", "physicalnetworkid", "privatemtu", "projectid", "publicmtu", "routerip", "routeripv6", "sourcenatipaddress", "startip", "startipv6", "subdomainaccess", "tungstenvirtualrouteruuid", "vlan", "vpcid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["aclid"]; found {
+		p.SetAclid(u.Get("aclid"))
+	}
+	if _, found := u["acltype"]; found {
+		p.SetAcltype(u.Get("acltype"))
+	}
+	if _, found := u["associatednetworkid"]; found {
+		p.SetAssociatednetworkid(u.Get("associatednetworkid"))
+	}
+	if _, found := u["bypassvlanoverlapcheck"]; found {
+		v, err := strconv.ParseBool(u.Get("bypassvlanoverlapcheck"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bypassvlanoverlapcheck: %v", err)
+		}
+		p.SetBypassvlanoverlapcheck(v)
+	}
+	if _, found := u["displaynetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("displaynetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displaynetwork: %v", err)
+		}
+		p.SetDisplaynetwork(v)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["dns1"]; found {
+		p.SetDns1(u.Get("dns1"))
+	}
+	if _, found := u["dns2"]; found {
+		p.SetDns2(u.Get("dns2"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["endipv6"]; found {
+		p.SetEndipv6(u.Get("endipv6"))
+	}
+	if _, found := u["externalid"]; found {
+		p.SetExternalid(u.Get("externalid"))
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["hideipaddressusage"]; found {
+		v, err := strconv.ParseBool(u.Get("hideipaddressusage"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param hideipaddressusage: %v", err)
+		}
+		p.SetHideipaddressusage(v)
+	}
+	if _, found := u["ip6cidr"]; found {
+		p.SetIp6cidr(u.Get("ip6cidr"))
+	}
+	if _, found := u["ip6dns1"]; found {
+		p.SetIp6dns1(u.Get("ip6dns1"))
+	}
+	if _, found := u["ip6dns2"]; found {
+		p.SetIp6dns2(u.Get("ip6dns2"))
+	}
+	if _, found := u["ip6gateway"]; found {
+		p.SetIp6gateway(u.Get("ip6gateway"))
+	}
+	if _, found := u["isolatedpvlan"]; found {
+		p.SetIsolatedpvlan(u.Get("isolatedpvlan"))
+	}
+	if _, found := u["isolatedpvlantype"]; found {
+		p.SetIsolatedpvlantype(u.Get("isolatedpvlantype"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
+	}
+	if _, found := u["networkofferingid"]; found {
+		p.SetNetworkofferingid(u.Get("networkofferingid"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["privatemtu"]; found {
+		v, err := strconv.Atoi(u.Get("privatemtu"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param privatemtu: %v", err)
+		}
+		p.SetPrivatemtu(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["publicmtu"]; found {
+		v, err := strconv.Atoi(u.Get("publicmtu"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param publicmtu: %v", err)
+		}
+		p.SetPublicmtu(v)
+	}
+	if _, found := u["routerip"]; found {
+		p.SetRouterip(u.Get("routerip"))
+	}
+	if _, found := u["routeripv6"]; found {
+		p.SetRouteripv6(u.Get("routeripv6"))
+	}
+	if _, found := u["sourcenatipaddress"]; found {
+		p.SetSourcenatipaddress(u.Get("sourcenatipaddress"))
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
+	}
+	if _, found := u["startipv6"]; found {
+		p.SetStartipv6(u.Get("startipv6"))
+	}
+	if _, found := u["subdomainaccess"]; found {
+		v, err := strconv.ParseBool(u.Get("subdomainaccess"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param subdomainaccess: %v", err)
+		}
+		p.SetSubdomainaccess(v)
+	}
+	if _, found := u["tungstenvirtualrouteruuid"]; found {
+		p.SetTungstenvirtualrouteruuid(u.Get("tungstenvirtualrouteruuid"))
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateNetworkParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateNetworkParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateNetworkParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAclid sets the aclid param.
 func (p *CreateNetworkParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["aclid"] = v
+	p.aclid = optString{v: v, ok: true}
 }
 
+// ResetAclid unsets the aclid param
 func (p *CreateNetworkParams) ResetAclid() {
-	if p.p != nil && p.p["aclid"] != nil {
-		delete(p.p, "aclid")
-	}
+	p.aclid = optString{}
 }
 
+// GetAclid returns the aclid param and if it is set
 func (p *CreateNetworkParams) GetAclid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["aclid"].(string)
-	return value, ok
+	return p.aclid.v, p.aclid.ok
 }
 
+// SetAcltype sets the acltype param.
 func (p *CreateNetworkParams) SetAcltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["acltype"] = v
+	p.acltype = optString{v: v, ok: true}
 }
 
+// ResetAcltype unsets the acltype param
 func (p *CreateNetworkParams) ResetAcltype() {
-	if p.p != nil && p.p["acltype"] != nil {
-		delete(p.p, "acltype")
-	}
+	p.acltype = optString{}
 }
 
+// GetAcltype returns the acltype param and if it is set
 func (p *CreateNetworkParams) GetAcltype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["acltype"].(string)
-	return value, ok
+	return p.acltype.v, p.acltype.ok
 }
 
+// SetAssociatednetworkid sets the associatednetworkid param.
 func (p *CreateNetworkParams) SetAssociatednetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["associatednetworkid"] = v
+	p.associatednetworkid = optString{v: v, ok: true}
 }
 
+// ResetAssociatednetworkid unsets the associatednetworkid param
 func (p *CreateNetworkParams) ResetAssociatednetworkid() {
-	if p.p != nil && p.p["associatednetworkid"] != nil {
-		delete(p.p, "associatednetworkid")
-	}
+	p.associatednetworkid = optString{}
 }
 
+// GetAssociatednetworkid returns the associatednetworkid param and if it is set
 func (p *CreateNetworkParams) GetAssociatednetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["associatednetworkid"].(string)
-	return value, ok
+	return p.associatednetworkid.v, p.associatednetworkid.ok
 }
 
+// SetBypassvlanoverlapcheck sets the bypassvlanoverlapcheck param.
 func (p *CreateNetworkParams) SetBypassvlanoverlapcheck(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bypassvlanoverlapcheck"] = v
+	p.bypassvlanoverlapcheck = optBool{v: v, ok: true}
 }
 
+// ResetBypassvlanoverlapcheck unsets the bypassvlanoverlapcheck param
 func (p *CreateNetworkParams) ResetBypassvlanoverlapcheck() {
-	if p.p != nil && p.p["bypassvlanoverlapcheck"] != nil {
-		delete(p.p, "bypassvlanoverlapcheck")
-	}
+	p.bypassvlanoverlapcheck = optBool{}
 }
 
+// GetBypassvlanoverlapcheck returns the bypassvlanoverlapcheck param and if it is set
 func (p *CreateNetworkParams) GetBypassvlanoverlapcheck() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bypassvlanoverlapcheck"].(bool)
-	return value, ok
+	return p.bypassvlanoverlapcheck.v, p.bypassvlanoverlapcheck.ok
 }
 
+// SetDisplaynetwork sets the displaynetwork param.
 func (p *CreateNetworkParams) SetDisplaynetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaynetwork"] = v
+	p.displaynetwork = optBool{v: v, ok: true}
 }
 
+// ResetDisplaynetwork unsets the displaynetwork param
 func (p *CreateNetworkParams) ResetDisplaynetwork() {
-	if p.p != nil && p.p["displaynetwork"] != nil {
-		delete(p.p, "displaynetwork")
-	}
+	p.displaynetwork = optBool{}
 }
 
+// GetDisplaynetwork returns the displaynetwork param and if it is set
 func (p *CreateNetworkParams) GetDisplaynetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaynetwork"].(bool)
-	return value, ok
+	return p.displaynetwork.v, p.displaynetwork.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *CreateNetworkParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateNetworkParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateNetworkParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDns1 sets the dns1 param.
 func (p *CreateNetworkParams) SetDns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns1"] = v
+	p.dns1 = optString{v: v, ok: true}
 }
 
+// ResetDns1 unsets the dns1 param
 func (p *CreateNetworkParams) ResetDns1() {
-	if p.p != nil && p.p["dns1"] != nil {
-		delete(p.p, "dns1")
-	}
+	p.dns1 = optString{}
 }
 
+// GetDns1 returns the dns1 param and if it is set
 func (p *CreateNetworkParams) GetDns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns1"].(string)
-	return value, ok
+	return p.dns1.v, p.dns1.ok
 }
 
+// SetDns2 sets the dns2 param.
 func (p *CreateNetworkParams) SetDns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns2"] = v
+	p.dns2 = optString{v: v, ok: true}
 }
 
+// ResetDns2 unsets the dns2 param
 func (p *CreateNetworkParams) ResetDns2() {
-	if p.p != nil && p.p["dns2"] != nil {
-		delete(p.p, "dns2")
-	}
+	p.dns2 = optString{}
 }
 
+// GetDns2 returns the dns2 param and if it is set
 func (p *CreateNetworkParams) GetDns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns2"].(string)
-	return value, ok
+	return p.dns2.v, p.dns2.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateNetworkParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateNetworkParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateNetworkParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEndip sets the endip param.
 func (p *CreateNetworkParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
+	p.endip = optString{v: v, ok: true}
 }
 
+// ResetEndip unsets the endip param
 func (p *CreateNetworkParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *CreateNetworkParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetEndipv6 sets the endipv6 param.
 func (p *CreateNetworkParams) SetEndipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endipv6"] = v
+	p.endipv6 = optString{v: v, ok: true}
 }
 
+// ResetEndipv6 unsets the endipv6 param
 func (p *CreateNetworkParams) ResetEndipv6() {
-	if p.p != nil && p.p["endipv6"] != nil {
-		delete(p.p, "endipv6")
-	}
+	p.endipv6 = optString{}
 }
 
+// GetEndipv6 returns the endipv6 param and if it is set
 func (p *CreateNetworkParams) GetEndipv6() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endipv6"].(string)
-	return value, ok
+	return p.endipv6.v, p.endipv6.ok
 }
 
+// SetExternalid sets the externalid param.
 func (p *CreateNetworkParams) SetExternalid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["externalid"] = v
+	p.externalid = optString{v: v, ok: true}
 }
 
+// ResetExternalid unsets the externalid param
 func (p *CreateNetworkParams) ResetExternalid() {
-	if p.p != nil && p.p["externalid"] != nil {
-		delete(p.p, "externalid")
-	}
+	p.externalid = optString{}
 }
 
+// GetExternalid returns the externalid param and if it is set
 func (p *CreateNetworkParams) GetExternalid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["externalid"].(string)
-	return value, ok
+	return p.externalid.v, p.externalid.ok
 }
 
+// SetGateway sets the gateway param.
 func (p *CreateNetworkParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *CreateNetworkParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *CreateNetworkParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetHideipaddressusage sets the hideipaddressusage param.
 func (p *CreateNetworkParams) SetHideipaddressusage(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hideipaddressusage"] = v
+	p.hideipaddressusage = optBool{v: v, ok: true}
 }
 
+// ResetHideipaddressusage unsets the hideipaddressusage param
 func (p *CreateNetworkParams) ResetHideipaddressusage() {
-	if p.p != nil && p.p["hideipaddressusage"] != nil {
-		delete(p.p, "hideipaddressusage")
-	}
+	p.hideipaddressusage = optBool{}
 }
 
+// GetHideipaddressusage returns the hideipaddressusage param and if it is set
 func (p *CreateNetworkParams) GetHideipaddressusage() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hideipaddressusage"].(bool)
-	return value, ok
+	return p.hideipaddressusage.v, p.hideipaddressusage.ok
 }
 
+// SetIp6cidr sets the ip6cidr param.
 func (p *CreateNetworkParams) SetIp6cidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6cidr"] = v
+	p.ip6cidr = optString{v: v, ok: true}
 }
 
+// ResetIp6cidr unsets the ip6cidr param
 func (p *CreateNetworkParams) ResetIp6cidr() {
-	if p.p != nil && p.p["ip6cidr"] != nil {
-		delete(p.p, "ip6cidr")
-	}
+	p.ip6cidr = optString{}
 }
 
+// GetIp6cidr returns the ip6cidr param and if it is set
 func (p *CreateNetworkParams) GetIp6cidr() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6cidr"].(string)
-	return value, ok
+	return p.ip6cidr.v, p.ip6cidr.ok
 }
 
+// SetIp6dns1 sets the ip6dns1 param.
 func (p *CreateNetworkParams) SetIp6dns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns1"] = v
+	p.ip6dns1 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns1 unsets the ip6dns1 param
 func (p *CreateNetworkParams) ResetIp6dns1() {
-	if p.p != nil && p.p["ip6dns1"] != nil {
-		delete(p.p, "ip6dns1")
-	}
+	p.ip6dns1 = optString{}
 }
 
+// GetIp6dns1 returns the ip6dns1 param and if it is set
 func (p *CreateNetworkParams) GetIp6dns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns1"].(string)
-	return value, ok
+	return p.ip6dns1.v, p.ip6dns1.ok
 }
 
+// SetIp6dns2 sets the ip6dns2 param.
 func (p *CreateNetworkParams) SetIp6dns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns2"] = v
+	p.ip6dns2 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns2 unsets the ip6dns2 param
 func (p *CreateNetworkParams) ResetIp6dns2() {
-	if p.p != nil && p.p["ip6dns2"] != nil {
-		delete(p.p, "ip6dns2")
-	}
+	p.ip6dns2 = optString{}
 }
 
+// GetIp6dns2 returns the ip6dns2 param and if it is set
 func (p *CreateNetworkParams) GetIp6dns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns2"].(string)
-	return value, ok
+	return p.ip6dns2.v, p.ip6dns2.ok
 }
 
+// SetIp6gateway sets the ip6gateway param.
 func (p *CreateNetworkParams) SetIp6gateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6gateway"] = v
+	p.ip6gateway = optString{v: v, ok: true}
 }
 
+// ResetIp6gateway unsets the ip6gateway param
 func (p *CreateNetworkParams) ResetIp6gateway() {
-	if p.p != nil && p.p["ip6gateway"] != nil {
-		delete(p.p, "ip6gateway")
-	}
+	p.ip6gateway = optString{}
 }
 
+// GetIp6gateway returns the ip6gateway param and if it is set
 func (p *CreateNetworkParams) GetIp6gateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6gateway"].(string)
-	return value, ok
+	return p.ip6gateway.v, p.ip6gateway.ok
 }
 
+// SetIsolatedpvlan sets the isolatedpvlan param.
 func (p *CreateNetworkParams) SetIsolatedpvlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isolatedpvlan"] = v
+	p.isolatedpvlan = optString{v: v, ok: true}
 }
 
+// ResetIsolatedpvlan unsets the isolatedpvlan param
 func (p *CreateNetworkParams) ResetIsolatedpvlan() {
-	if p.p != nil && p.p["isolatedpvlan"] != nil {
-		delete(p.p, "isolatedpvlan")
-	}
+	p.isolatedpvlan = optString{}
 }
 
+// GetIsolatedpvlan returns the isolatedpvlan param and if it is set
 func (p *CreateNetworkParams) GetIsolatedpvlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isolatedpvlan"].(string)
-	return value, ok
+	return p.isolatedpvlan.v, p.isolatedpvlan.ok
 }
 
+// SetIsolatedpvlantype sets the isolatedpvlantype param.
 func (p *CreateNetworkParams) SetIsolatedpvlantype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isolatedpvlantype"] = v
+	p.isolatedpvlantype = optString{v: v, ok: true}
 }
 
+// ResetIsolatedpvlantype unsets the isolatedpvlantype param
 func (p *CreateNetworkParams) ResetIsolatedpvlantype() {
-	if p.p != nil && p.p["isolatedpvlantype"] != nil {
-		delete(p.p, "isolatedpvlantype")
-	}
+	p.isolatedpvlantype = optString{}
 }
 
+// GetIsolatedpvlantype returns the isolatedpvlantype param and if it is set
 func (p *CreateNetworkParams) GetIsolatedpvlantype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isolatedpvlantype"].(string)
-	return value, ok
+	return p.isolatedpvlantype.v, p.isolatedpvlantype.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateNetworkParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateNetworkParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateNetworkParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetmask sets the netmask param.
 func (p *CreateNetworkParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *CreateNetworkParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *CreateNetworkParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *CreateNetworkParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *CreateNetworkParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *CreateNetworkParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+	return p.networkdomain.v, p.networkdomain.ok
 }
 
+// SetNetworkofferingid sets the networkofferingid param. This param is required.
 func (p *CreateNetworkParams) SetNetworkofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkofferingid"] = v
+	p.networkofferingid = optString{v: v, ok: true}
 }
 
+// ResetNetworkofferingid unsets the networkofferingid param
 func (p *CreateNetworkParams) ResetNetworkofferingid() {
-	if p.p != nil && p.p["networkofferingid"] != nil {
-		delete(p.p, "networkofferingid")
-	}
+	p.networkofferingid = optString{}
 }
 
+// GetNetworkofferingid returns the networkofferingid param and if it is set
 func (p *CreateNetworkParams) GetNetworkofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkofferingid"].(string)
-	return value, ok
+	return p.networkofferingid.v, p.networkofferingid.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *CreateNetworkParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *CreateNetworkParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *CreateNetworkParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetPrivatemtu sets the privatemtu param.
 func (p *CreateNetworkParams) SetPrivatemtu(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privatemtu"] = v
+	p.privatemtu = optInt{v: v, ok: true}
 }
 
+// ResetPrivatemtu unsets the privatemtu param
 func (p *CreateNetworkParams) ResetPrivatemtu() {
-	if p.p != nil && p.p["privatemtu"] != nil {
-		delete(p.p, "privatemtu")
-	}
+	p.privatemtu = optInt{}
 }
 
+// GetPrivatemtu returns the privatemtu param and if it is set
 func (p *CreateNetworkParams) GetPrivatemtu() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["privatemtu"].(int)
-	return value, ok
+	return p.privatemtu.v, p.privatemtu.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateNetworkParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateNetworkParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateNetworkParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetPublicmtu sets the publicmtu param.
 func (p *CreateNetworkParams) SetPublicmtu(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicmtu"] = v
+	p.publicmtu = optInt{v: v, ok: true}
 }
 
+// ResetPublicmtu unsets the publicmtu param
 func (p *CreateNetworkParams) ResetPublicmtu() {
-	if p.p != nil && p.p["publicmtu"] != nil {
-		delete(p.p, "publicmtu")
-	}
+	p.publicmtu = optInt{}
 }
 
+// GetPublicmtu returns the publicmtu param and if it is set
 func (p *CreateNetworkParams) GetPublicmtu() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicmtu"].(int)
-	return value, ok
+	return p.publicmtu.v, p.publicmtu.ok
 }
 
+// SetRouterip sets the routerip param.
 func (p *CreateNetworkParams) SetRouterip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["routerip"] = v
+	p.routerip = optString{v: v, ok: true}
 }
 
+// ResetRouterip unsets the routerip param
 func (p *CreateNetworkParams) ResetRouterip() {
-	if p.p != nil && p.p["routerip"] != nil {
-		delete(p.p, "routerip")
-	}
+	p.routerip = optString{}
 }
 
+// GetRouterip returns the routerip param and if it is set
 func (p *CreateNetworkParams) GetRouterip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["routerip"].(string)
-	return value, ok
+	return p.routerip.v, p.routerip.ok
 }
 
+// SetRouteripv6 sets the routeripv6 param.
 func (p *CreateNetworkParams) SetRouteripv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["routeripv6"] = v
+	p.routeripv6 = optString{v: v, ok: true}
 }
 
+// ResetRouteripv6 unsets the routeripv6 param
 func (p *CreateNetworkParams) ResetRouteripv6() {
-	if p.p != nil && p.p["routeripv6"] != nil {
-		delete(p.p, "routeripv6")
-	}
+	p.routeripv6 = optString{}
 }
 
+// GetRouteripv6 returns the routeripv6 param and if it is set
 func (p *CreateNetworkParams) GetRouteripv6() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["routeripv6"].(string)
-	return value, ok
+	return p.routeripv6.v, p.routeripv6.ok
 }
 
+// SetSourcenatipaddress sets the sourcenatipaddress param.
 func (p *CreateNetworkParams) SetSourcenatipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcenatipaddress"] = v
+	p.sourcenatipaddress = optString{v: v, ok: true}
 }
 
+// ResetSourcenatipaddress unsets the sourcenatipaddress param
 func (p *CreateNetworkParams) ResetSourcenatipaddress() {
-	if p.p != nil && p.p["sourcenatipaddress"] != nil {
-		delete(p.p, "sourcenatipaddress")
-	}
+	p.sourcenatipaddress = optString{}
 }
 
+// GetSourcenatipaddress returns the sourcenatipaddress param and if it is set
 func (p *CreateNetworkParams) GetSourcenatipaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourcenatipaddress"].(string)
-	return value, ok
+	return p.sourcenatipaddress.v, p.sourcenatipaddress.ok
 }
 
+// SetStartip sets the startip param.
 func (p *CreateNetworkParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *CreateNetworkParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *CreateNetworkParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	return p.startip.v, p.startip.ok
 }
 
+// SetStartipv6 sets the startipv6 param.
 func (p *CreateNetworkParams) SetStartipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startipv6"] = v
+	p.startipv6 = optString{v: v, ok: true}
 }
 
+// ResetStartipv6 unsets the startipv6 param
 func (p *CreateNetworkParams) ResetStartipv6() {
-	if p.p != nil && p.p["startipv6"] != nil {
-		delete(p.p, "startipv6")
-	}
+	p.startipv6 = optString{}
 }
 
+// GetStartipv6 returns the startipv6 param and if it is set
 func (p *CreateNetworkParams) GetStartipv6() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startipv6"].(string)
-	return value, ok
+	return p.startipv6.v, p.startipv6.ok
 }
 
+// SetSubdomainaccess sets the subdomainaccess param.
 func (p *CreateNetworkParams) SetSubdomainaccess(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["subdomainaccess"] = v
+	p.subdomainaccess = optBool{v: v, ok: true}
 }
 
+// ResetSubdomainaccess unsets the subdomainaccess param
 func (p *CreateNetworkParams) ResetSubdomainaccess() {
-	if p.p != nil && p.p["subdomainaccess"] != nil {
-		delete(p.p, "subdomainaccess")
-	}
+	p.subdomainaccess = optBool{}
 }
 
+// GetSubdomainaccess returns the subdomainaccess param and if it is set
 func (p *CreateNetworkParams) GetSubdomainaccess() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["subdomainaccess"].(bool)
-	return value, ok
+	return p.subdomainaccess.v, p.subdomainaccess.ok
 }
 
+// SetTungstenvirtualrouteruuid sets the tungstenvirtualrouteruuid param.
 func (p *CreateNetworkParams) SetTungstenvirtualrouteruuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tungstenvirtualrouteruuid"] = v
+	p.tungstenvirtualrouteruuid = optString{v: v, ok: true}
 }
 
+// ResetTungstenvirtualrouteruuid unsets the tungstenvirtualrouteruuid param
 func (p *CreateNetworkParams) ResetTungstenvirtualrouteruuid() {
-	if p.p != nil && p.p["tungstenvirtualrouteruuid"] != nil {
-		delete(p.p, "tungstenvirtualrouteruuid")
-	}
+	p.tungstenvirtualrouteruuid = optString{}
 }
 
+// GetTungstenvirtualrouteruuid returns the tungstenvirtualrouteruuid param and if it is set
 func (p *CreateNetworkParams) GetTungstenvirtualrouteruuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tungstenvirtualrouteruuid"].(string)
-	return value, ok
+	return p.tungstenvirtualrouteruuid.v, p.tungstenvirtualrouteruuid.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *CreateNetworkParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *CreateNetworkParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *CreateNetworkParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *CreateNetworkParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *CreateNetworkParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *CreateNetworkParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreateNetworkParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateNetworkParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateNetworkParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateNetworkParams) Clone() *CreateNetworkParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateNetworkParams) Equal(o *CreateNetworkParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.aclid == o.aclid &&
+		p.acltype == o.acltype &&
+		p.associatednetworkid == o.associatednetworkid &&
+		p.bypassvlanoverlapcheck == o.bypassvlanoverlapcheck &&
+		p.displaynetwork == o.displaynetwork &&
+		p.displaytext == o.displaytext &&
+		p.dns1 == o.dns1 &&
+		p.dns2 == o.dns2 &&
+		p.domainid == o.domainid &&
+		p.endip == o.endip &&
+		p.endipv6 == o.endipv6 &&
+		p.externalid == o.externalid &&
+		p.gateway == o.gateway &&
+		p.hideipaddressusage == o.hideipaddressusage &&
+		p.ip6cidr == o.ip6cidr &&
+		p.ip6dns1 == o.ip6dns1 &&
+		p.ip6dns2 == o.ip6dns2 &&
+		p.ip6gateway == o.ip6gateway &&
+		p.isolatedpvlan == o.isolatedpvlan &&
+		p.isolatedpvlantype == o.isolatedpvlantype &&
+		p.name == o.name &&
+		p.netmask == o.netmask &&
+		p.networkdomain == o.networkdomain &&
+		p.networkofferingid == o.networkofferingid &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.privatemtu == o.privatemtu &&
+		p.projectid == o.projectid &&
+		p.publicmtu == o.publicmtu &&
+		p.routerip == o.routerip &&
+		p.routeripv6 == o.routeripv6 &&
+		p.sourcenatipaddress == o.sourcenatipaddress &&
+		p.startip == o.startip &&
+		p.startipv6 == o.startipv6 &&
+		p.subdomainaccess == o.subdomainaccess &&
+		p.tungstenvirtualrouteruuid == o.tungstenvirtualrouteruuid &&
+		p.vlan == o.vlan &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateNetworkParams is used to (un)marshal CreateNetworkParams using the API param names
+type serializedCreateNetworkParams struct {
+	Account                   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Aclid                     *string `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Acltype                   *string `json:"acltype,omitempty" yaml:"acltype,omitempty"`
+	Associatednetworkid       *string `json:"associatednetworkid,omitempty" yaml:"associatednetworkid,omitempty"`
+	Bypassvlanoverlapcheck    *bool   `json:"bypassvlanoverlapcheck,omitempty" yaml:"bypassvlanoverlapcheck,omitempty"`
+	Displaynetwork            *bool   `json:"displaynetwork,omitempty" yaml:"displaynetwork,omitempty"`
+	Displaytext               *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Dns1                      *string `json:"dns1,omitempty" yaml:"dns1,omitempty"`
+	Dns2                      *string `json:"dns2,omitempty" yaml:"dns2,omitempty"`
+	Domainid                  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endip                     *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Endipv6                   *string `json:"endipv6,omitempty" yaml:"endipv6,omitempty"`
+	Externalid                *string `json:"externalid,omitempty" yaml:"externalid,omitempty"`
+	Gateway                   *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Hideipaddressusage        *bool   `json:"hideipaddressusage,omitempty" yaml:"hideipaddressusage,omitempty"`
+	Ip6cidr                   *string `json:"ip6cidr,omitempty" yaml:"ip6cidr,omitempty"`
+	Ip6dns1                   *string `json:"ip6dns1,omitempty" yaml:"ip6dns1,omitempty"`
+	Ip6dns2                   *string `json:"ip6dns2,omitempty" yaml:"ip6dns2,omitempty"`
+	Ip6gateway                *string `json:"ip6gateway,omitempty" yaml:"ip6gateway,omitempty"`
+	Isolatedpvlan             *string `json:"isolatedpvlan,omitempty" yaml:"isolatedpvlan,omitempty"`
+	Isolatedpvlantype         *string `json:"isolatedpvlantype,omitempty" yaml:"isolatedpvlantype,omitempty"`
+	Name                      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Netmask                   *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Networkdomain             *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Networkofferingid         *string `json:"networkofferingid,omitempty" yaml:"networkofferingid,omitempty"`
+	Physicalnetworkid         *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Privatemtu                *int    `json:"privatemtu,omitempty" yaml:"privatemtu,omitempty"`
+	Projectid                 *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publicmtu                 *int    `json:"publicmtu,omitempty" yaml:"publicmtu,omitempty"`
+	Routerip                  *string `json:"routerip,omitempty" yaml:"routerip,omitempty"`
+	Routeripv6                *string `json:"routeripv6,omitempty" yaml:"routeripv6,omitempty"`
+	Sourcenatipaddress        *string `json:"sourcenatipaddress,omitempty" yaml:"sourcenatipaddress,omitempty"`
+	Startip                   *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Startipv6                 *string `json:"startipv6,omitempty" yaml:"startipv6,omitempty"`
+	Subdomainaccess           *bool   `json:"subdomainaccess,omitempty" yaml:"subdomainaccess,omitempty"`
+	Tungstenvirtualrouteruuid *string `json:"tungstenvirtualrouteruuid,omitempty" yaml:"tungstenvirtualrouteruuid,omitempty"`
+	Vlan                      *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vpcid                     *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid                    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateNetworkParams) toSerialized() *serializedCreateNetworkParams {
+	s := &serializedCreateNetworkParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.aclid.ok {
+		s.Aclid = &p.aclid.v
+	}
+	if p.acltype.ok {
+		s.Acltype = &p.acltype.v
+	}
+	if p.associatednetworkid.ok {
+		s.Associatednetworkid = &p.associatednetworkid.v
+	}
+	if p.bypassvlanoverlapcheck.ok {
+		s.Bypassvlanoverlapcheck = &p.bypassvlanoverlapcheck.v
+	}
+	if p.displaynetwork.ok {
+		s.Displaynetwork = &p.displaynetwork.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.dns1.ok {
+		s.Dns1 = &p.dns1.v
+	}
+	if p.dns2.ok {
+		s.Dns2 = &p.dns2.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.endipv6.ok {
+		s.Endipv6 = &p.endipv6.v
+	}
+	if p.externalid.ok {
+		s.Externalid = &p.externalid.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.hideipaddressusage.ok {
+		s.Hideipaddressusage = &p.hideipaddressusage.v
+	}
+	if p.ip6cidr.ok {
+		s.Ip6cidr = &p.ip6cidr.v
+	}
+	if p.ip6dns1.ok {
+		s.Ip6dns1 = &p.ip6dns1.v
+	}
+	if p.ip6dns2.ok {
+		s.Ip6dns2 = &p.ip6dns2.v
+	}
+	if p.ip6gateway.ok {
+		s.Ip6gateway = &p.ip6gateway.v
+	}
+	if p.isolatedpvlan.ok {
+		s.Isolatedpvlan = &p.isolatedpvlan.v
+	}
+	if p.isolatedpvlantype.ok {
+		s.Isolatedpvlantype = &p.isolatedpvlantype.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	if p.networkofferingid.ok {
+		s.Networkofferingid = &p.networkofferingid.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.privatemtu.ok {
+		s.Privatemtu = &p.privatemtu.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.publicmtu.ok {
+		s.Publicmtu = &p.publicmtu.v
+	}
+	if p.routerip.ok {
+		s.Routerip = &p.routerip.v
+	}
+	if p.routeripv6.ok {
+		s.Routeripv6 = &p.routeripv6.v
+	}
+	if p.sourcenatipaddress.ok {
+		s.Sourcenatipaddress = &p.sourcenatipaddress.v
+	}
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	if p.startipv6.ok {
+		s.Startipv6 = &p.startipv6.v
+	}
+	if p.subdomainaccess.ok {
+		s.Subdomainaccess = &p.subdomainaccess.v
+	}
+	if p.tungstenvirtualrouteruuid.ok {
+		s.Tungstenvirtualrouteruuid = &p.tungstenvirtualrouteruuid.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateNetworkParams) fromSerialized(s *serializedCreateNetworkParams) {
+	*p = CreateNetworkParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Aclid != nil {
+		p.SetAclid(*s.Aclid)
+	}
+	if s.Acltype != nil {
+		p.SetAcltype(*s.Acltype)
+	}
+	if s.Associatednetworkid != nil {
+		p.SetAssociatednetworkid(*s.Associatednetworkid)
+	}
+	if s.Bypassvlanoverlapcheck != nil {
+		p.SetBypassvlanoverlapcheck(*s.Bypassvlanoverlapcheck)
+	}
+	if s.Displaynetwork != nil {
+		p.SetDisplaynetwork(*s.Displaynetwork)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Dns1 != nil {
+		p.SetDns1(*s.Dns1)
+	}
+	if s.Dns2 != nil {
+		p.SetDns2(*s.Dns2)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Endipv6 != nil {
+		p.SetEndipv6(*s.Endipv6)
+	}
+	if s.Externalid != nil {
+		p.SetExternalid(*s.Externalid)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Hideipaddressusage != nil {
+		p.SetHideipaddressusage(*s.Hideipaddressusage)
+	}
+	if s.Ip6cidr != nil {
+		p.SetIp6cidr(*s.Ip6cidr)
+	}
+	if s.Ip6dns1 != nil {
+		p.SetIp6dns1(*s.Ip6dns1)
+	}
+	if s.Ip6dns2 != nil {
+		p.SetIp6dns2(*s.Ip6dns2)
+	}
+	if s.Ip6gateway != nil {
+		p.SetIp6gateway(*s.Ip6gateway)
+	}
+	if s.Isolatedpvlan != nil {
+		p.SetIsolatedpvlan(*s.Isolatedpvlan)
+	}
+	if s.Isolatedpvlantype != nil {
+		p.SetIsolatedpvlantype(*s.Isolatedpvlantype)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
+	}
+	if s.Networkofferingid != nil {
+		p.SetNetworkofferingid(*s.Networkofferingid)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Privatemtu != nil {
+		p.SetPrivatemtu(*s.Privatemtu)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Publicmtu != nil {
+		p.SetPublicmtu(*s.Publicmtu)
+	}
+	if s.Routerip != nil {
+		p.SetRouterip(*s.Routerip)
+	}
+	if s.Routeripv6 != nil {
+		p.SetRouteripv6(*s.Routeripv6)
+	}
+	if s.Sourcenatipaddress != nil {
+		p.SetSourcenatipaddress(*s.Sourcenatipaddress)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+	if s.Startipv6 != nil {
+		p.SetStartipv6(*s.Startipv6)
+	}
+	if s.Subdomainaccess != nil {
+		p.SetSubdomainaccess(*s.Subdomainaccess)
+	}
+	if s.Tungstenvirtualrouteruuid != nil {
+		p.SetTungstenvirtualrouteruuid(*s.Tungstenvirtualrouteruuid)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateNetworkParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateNetworkParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateNetworkParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateNetworkParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateNetworkParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateNetworkParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreateNetworkParams(name string, networkofferingid string, zoneid string) *CreateNetworkParams {
 	p := &CreateNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["networkofferingid"] = networkofferingid
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetNetworkofferingid(networkofferingid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Creates a network
-func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createNetwork", p.toURLValues())
+// Creates a network.
+//
+// Required params: name, networkofferingid, zoneid.
+func (s *NetworkService) CreateNetwork(p *CreateNetworkParams, opts ...CallOption) (*CreateNetworkResponse, error) {
+	resp, err := s.cs.newRequest("createNetwork", s.cs.encodeParams("createNetwork", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1527,224 +2049,346 @@ type CreateNetworkResponseServiceCapability struct {
 }
 
 type CreatePhysicalNetworkParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
+	broadcastdomainrange optString
+	domainid             optString
+	isolationmethods     optStrings
+	name                 optString
+	networkspeed         optString
+	tags                 optStrings
+	vlan                 optString
+	zoneid               optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreatePhysicalNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["broadcastdomainrange"]; found {
-		u.Set("broadcastdomainrange", v.(string))
+	if p.broadcastdomainrange.ok {
+		u.Set("broadcastdomainrange", p.broadcastdomainrange.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["isolationmethods"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("isolationmethods", vv)
+	if p.isolationmethods.ok {
+		u.Set("isolationmethods", strings.Join(p.isolationmethods.v, ","))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkspeed"]; found {
-		u.Set("networkspeed", v.(string))
+	if p.networkspeed.ok {
+		u.Set("networkspeed", p.networkspeed.v)
 	}
-	if v, found := p.p["tags"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("tags", vv)
+	if p.tags.ok {
+		u.Set("tags", strings.Join(p.tags.v, ","))
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreatePhysicalNetworkParams parses url.Values, for example taken from a raw API request,
+// into a new CreatePhysicalNetworkParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreatePhysicalNetworkParams(u url.Values) (*CreatePhysicalNetworkParams, error) {
+	p := &CreatePhysicalNetworkParams{}
+	if err := checkParamNames("createPhysicalNetwork", u, "broadcastdomainrange", "domainid", "isolationmethods", "name", "networkspeed", "tags", "vlan", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["broadcastdomainrange"]; found {
+		p.SetBroadcastdomainrange(u.Get("broadcastdomainrange"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["isolationmethods"]; found {
+		p.SetIsolationmethods(strings.Split(u.Get("isolationmethods"), ","))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkspeed"]; found {
+		p.SetNetworkspeed(u.Get("networkspeed"))
 	}
-	p.p["broadcastdomainrange"] = v
+	if _, found := u["tags"]; found {
+		p.SetTags(strings.Split(u.Get("tags"), ","))
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetBroadcastdomainrange sets the broadcastdomainrange param.
+func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
+	p.broadcastdomainrange = optString{v: v, ok: true}
 }
 
+// ResetBroadcastdomainrange unsets the broadcastdomainrange param
 func (p *CreatePhysicalNetworkParams) ResetBroadcastdomainrange() {
-	if p.p != nil && p.p["broadcastdomainrange"] != nil {
-		delete(p.p, "broadcastdomainrange")
-	}
+	p.broadcastdomainrange = optString{}
 }
 
+// GetBroadcastdomainrange returns the broadcastdomainrange param and if it is set
 func (p *CreatePhysicalNetworkParams) GetBroadcastdomainrange() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["broadcastdomainrange"].(string)
-	return value, ok
+	return p.broadcastdomainrange.v, p.broadcastdomainrange.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreatePhysicalNetworkParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreatePhysicalNetworkParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreatePhysicalNetworkParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetIsolationmethods sets the isolationmethods param.
 func (p *CreatePhysicalNetworkParams) SetIsolationmethods(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isolationmethods"] = v
+	p.isolationmethods = optStrings{v: v, ok: true}
 }
 
+// ResetIsolationmethods unsets the isolationmethods param
 func (p *CreatePhysicalNetworkParams) ResetIsolationmethods() {
-	if p.p != nil && p.p["isolationmethods"] != nil {
-		delete(p.p, "isolationmethods")
-	}
+	p.isolationmethods = optStrings{}
 }
 
+// GetIsolationmethods returns the isolationmethods param and if it is set
 func (p *CreatePhysicalNetworkParams) GetIsolationmethods() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isolationmethods"].([]string)
-	return value, ok
+	return p.isolationmethods.v, p.isolationmethods.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreatePhysicalNetworkParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreatePhysicalNetworkParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreatePhysicalNetworkParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkspeed sets the networkspeed param.
 func (p *CreatePhysicalNetworkParams) SetNetworkspeed(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkspeed"] = v
+	p.networkspeed = optString{v: v, ok: true}
 }
 
+// ResetNetworkspeed unsets the networkspeed param
 func (p *CreatePhysicalNetworkParams) ResetNetworkspeed() {
-	if p.p != nil && p.p["networkspeed"] != nil {
-		delete(p.p, "networkspeed")
-	}
+	p.networkspeed = optString{}
 }
 
+// GetNetworkspeed returns the networkspeed param and if it is set
 func (p *CreatePhysicalNetworkParams) GetNetworkspeed() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkspeed"].(string)
-	return value, ok
+	return p.networkspeed.v, p.networkspeed.ok
 }
 
+// SetTags sets the tags param.
 func (p *CreatePhysicalNetworkParams) SetTags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStrings{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreatePhysicalNetworkParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStrings{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreatePhysicalNetworkParams) GetTags() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].([]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *CreatePhysicalNetworkParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *CreatePhysicalNetworkParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *CreatePhysicalNetworkParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreatePhysicalNetworkParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreatePhysicalNetworkParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreatePhysicalNetworkParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreatePhysicalNetworkParams) Clone() *CreatePhysicalNetworkParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.isolationmethods = p.isolationmethods.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreatePhysicalNetworkParams) Equal(o *CreatePhysicalNetworkParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.broadcastdomainrange == o.broadcastdomainrange &&
+		p.domainid == o.domainid &&
+		p.isolationmethods.equal(o.isolationmethods) &&
+		p.name == o.name &&
+		p.networkspeed == o.networkspeed &&
+		p.tags.equal(o.tags) &&
+		p.vlan == o.vlan &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreatePhysicalNetworkParams is used to (un)marshal CreatePhysicalNetworkParams using the API param names
+type serializedCreatePhysicalNetworkParams struct {
+	Broadcastdomainrange *string   `json:"broadcastdomainrange,omitempty" yaml:"broadcastdomainrange,omitempty"`
+	Domainid             *string   `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isolationmethods     *[]string `json:"isolationmethods,omitempty" yaml:"isolationmethods,omitempty"`
+	Name                 *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkspeed         *string   `json:"networkspeed,omitempty" yaml:"networkspeed,omitempty"`
+	Tags                 *[]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vlan                 *string   `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Zoneid               *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreatePhysicalNetworkParams) toSerialized() *serializedCreatePhysicalNetworkParams {
+	s := &serializedCreatePhysicalNetworkParams{}
+	if p.broadcastdomainrange.ok {
+		s.Broadcastdomainrange = &p.broadcastdomainrange.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.isolationmethods.ok {
+		s.Isolationmethods = &p.isolationmethods.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkspeed.ok {
+		s.Networkspeed = &p.networkspeed.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreatePhysicalNetworkParams) fromSerialized(s *serializedCreatePhysicalNetworkParams) {
+	*p = CreatePhysicalNetworkParams{}
+	if s.Broadcastdomainrange != nil {
+		p.SetBroadcastdomainrange(*s.Broadcastdomainrange)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Isolationmethods != nil {
+		p.SetIsolationmethods(*s.Isolationmethods)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkspeed != nil {
+		p.SetNetworkspeed(*s.Networkspeed)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreatePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreatePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreatePhysicalNetworkParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreatePhysicalNetworkParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreatePhysicalNetworkParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreatePhysicalNetworkParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreatePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams {
 	p := &CreatePhysicalNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Creates a physical network
-func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createPhysicalNetwork", p.toURLValues())
+// Creates a physical network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: name, zoneid.
+func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams, opts ...CallOption) (*CreatePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequest("createPhysicalNetwork", s.cs.encodeParams("createPhysicalNetwork", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1755,8 +2399,8 @@ func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1794,262 +2438,391 @@ type CreatePhysicalNetworkResponse struct {
 }
 
 type CreateServiceInstanceParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateServiceInstanceParams) toURLValues() url.Values {
+	account           optString
+	domainid          optString
+	leftnetworkid     optString
+	name              optString
+	projectid         optString
+	rightnetworkid    optString
+	serviceofferingid optString
+	templateid        optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateServiceInstanceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["leftnetworkid"]; found {
-		u.Set("leftnetworkid", v.(string))
+	if p.leftnetworkid.ok {
+		u.Set("leftnetworkid", p.leftnetworkid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["rightnetworkid"]; found {
-		u.Set("rightnetworkid", v.(string))
+	if p.rightnetworkid.ok {
+		u.Set("rightnetworkid", p.rightnetworkid.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateServiceInstanceParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateServiceInstanceParams parses url.Values, for example taken from a raw API request,
+// into a new CreateServiceInstanceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateServiceInstanceParams(u url.Values) (*CreateServiceInstanceParams, error) {
+	p := &CreateServiceInstanceParams{}
+	if err := checkParamNames("createServiceInstance", u, "account", "domainid", "leftnetworkid", "name", "projectid", "rightnetworkid", "serviceofferingid", "templateid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["leftnetworkid"]; found {
+		p.SetLeftnetworkid(u.Get("leftnetworkid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["rightnetworkid"]; found {
+		p.SetRightnetworkid(u.Get("rightnetworkid"))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
 	}
-	p.p["account"] = v
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateServiceInstanceParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateServiceInstanceParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateServiceInstanceParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateServiceInstanceParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateServiceInstanceParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateServiceInstanceParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetLeftnetworkid sets the leftnetworkid param. This param is required.
 func (p *CreateServiceInstanceParams) SetLeftnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["leftnetworkid"] = v
+	p.leftnetworkid = optString{v: v, ok: true}
 }
 
+// ResetLeftnetworkid unsets the leftnetworkid param
 func (p *CreateServiceInstanceParams) ResetLeftnetworkid() {
-	if p.p != nil && p.p["leftnetworkid"] != nil {
-		delete(p.p, "leftnetworkid")
-	}
+	p.leftnetworkid = optString{}
 }
 
+// GetLeftnetworkid returns the leftnetworkid param and if it is set
 func (p *CreateServiceInstanceParams) GetLeftnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["leftnetworkid"].(string)
-	return value, ok
+	return p.leftnetworkid.v, p.leftnetworkid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateServiceInstanceParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateServiceInstanceParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateServiceInstanceParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateServiceInstanceParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateServiceInstanceParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateServiceInstanceParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRightnetworkid sets the rightnetworkid param. This param is required.
 func (p *CreateServiceInstanceParams) SetRightnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["rightnetworkid"] = v
+	p.rightnetworkid = optString{v: v, ok: true}
 }
 
+// ResetRightnetworkid unsets the rightnetworkid param
 func (p *CreateServiceInstanceParams) ResetRightnetworkid() {
-	if p.p != nil && p.p["rightnetworkid"] != nil {
-		delete(p.p, "rightnetworkid")
-	}
+	p.rightnetworkid = optString{}
 }
 
+// GetRightnetworkid returns the rightnetworkid param and if it is set
 func (p *CreateServiceInstanceParams) GetRightnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["rightnetworkid"].(string)
-	return value, ok
+	return p.rightnetworkid.v, p.rightnetworkid.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *CreateServiceInstanceParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *CreateServiceInstanceParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *CreateServiceInstanceParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetTemplateid sets the templateid param. This param is required.
 func (p *CreateServiceInstanceParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *CreateServiceInstanceParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *CreateServiceInstanceParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreateServiceInstanceParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateServiceInstanceParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateServiceInstanceParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateServiceInstanceParams) Clone() *CreateServiceInstanceParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new CreateServiceInstanceParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams {
-	p := &CreateServiceInstanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["leftnetworkid"] = leftnetworkid
-	p.p["name"] = name
-	p.p["rightnetworkid"] = rightnetworkid
-	p.p["serviceofferingid"] = serviceofferingid
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
-	return p
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateServiceInstanceParams) Equal(o *CreateServiceInstanceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.leftnetworkid == o.leftnetworkid &&
+		p.name == o.name &&
+		p.projectid == o.projectid &&
+		p.rightnetworkid == o.rightnetworkid &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.templateid == o.templateid &&
+		p.zoneid == o.zoneid
 }
 
-// Creates a system virtual-machine that implements network services
-func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
-	resp, err := s.cs.newRequest("createServiceInstance", p.toURLValues())
-	if err != nil {
-		return nil, err
+// serializedCreateServiceInstanceParams is used to (un)marshal CreateServiceInstanceParams using the API param names
+type serializedCreateServiceInstanceParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Leftnetworkid     *string `json:"leftnetworkid,omitempty" yaml:"leftnetworkid,omitempty"`
+	Name              *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Rightnetworkid    *string `json:"rightnetworkid,omitempty" yaml:"rightnetworkid,omitempty"`
+	Serviceofferingid *string `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Templateid        *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateServiceInstanceParams) toSerialized() *serializedCreateServiceInstanceParams {
+	s := &serializedCreateServiceInstanceParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.leftnetworkid.ok {
+		s.Leftnetworkid = &p.leftnetworkid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.rightnetworkid.ok {
+		s.Rightnetworkid = &p.rightnetworkid.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
 	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
 
-	var r CreateServiceInstanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateServiceInstanceParams) fromSerialized(s *serializedCreateServiceInstanceParams) {
+	*p = CreateServiceInstanceParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Leftnetworkid != nil {
+		p.SetLeftnetworkid(*s.Leftnetworkid)
 	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Rightnetworkid != nil {
+		p.SetRightnetworkid(*s.Rightnetworkid)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateServiceInstanceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateServiceInstanceParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateServiceInstanceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateServiceInstanceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateServiceInstanceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateServiceInstanceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateServiceInstanceParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams {
+	p := &CreateServiceInstanceParams{}
+	p.SetLeftnetworkid(leftnetworkid)
+	p.SetName(name)
+	p.SetRightnetworkid(rightnetworkid)
+	p.SetServiceofferingid(serviceofferingid)
+	p.SetTemplateid(templateid)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Creates a system virtual-machine that implements network services.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: leftnetworkid, name,
+// rightnetworkid, serviceofferingid, templateid, zoneid.
+func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams, opts ...CallOption) (*CreateServiceInstanceResponse, error) {
+	resp, err := s.cs.newRequest("createServiceInstance", s.cs.encodeParams("createServiceInstance", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateServiceInstanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2084,177 +2857,291 @@ type CreateServiceInstanceResponse struct {
 }
 
 type CreateStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
+	endip   optString
+	gateway optString
+	netmask optString
+	podid   optString
+	startip optString
+	vlan    optInt
 }
 
-func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("vlan", vv)
+	if p.vlan.ok {
+		u.Set("vlan", strconv.Itoa(p.vlan.v))
 	}
 	return u
 }
 
-func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateStorageNetworkIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new CreateStorageNetworkIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateStorageNetworkIpRangeParams(u url.Values) (*CreateStorageNetworkIpRangeParams, error) {
+	p := &CreateStorageNetworkIpRangeParams{}
+	if err := checkParamNames("createStorageNetworkIpRange", u, "endip", "gateway", "netmask", "podid", "startip", "vlan"); err != nil {
+		return nil, err
+	}
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
+	}
+	if _, found := u["vlan"]; found {
+		v, err := strconv.Atoi(u.Get("vlan"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param vlan: %v", err)
+		}
+		p.SetVlan(v)
 	}
-	p.p["endip"] = v
+	return p, nil
+}
+
+// SetEndip sets the endip param.
+func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
+	p.endip = optString{v: v, ok: true}
 }
 
+// ResetEndip unsets the endip param
 func (p *CreateStorageNetworkIpRangeParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *CreateStorageNetworkIpRangeParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetGateway sets the gateway param. This param is required.
 func (p *CreateStorageNetworkIpRangeParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *CreateStorageNetworkIpRangeParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *CreateStorageNetworkIpRangeParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetNetmask sets the netmask param. This param is required.
 func (p *CreateStorageNetworkIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *CreateStorageNetworkIpRangeParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *CreateStorageNetworkIpRangeParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetPodid sets the podid param. This param is required.
 func (p *CreateStorageNetworkIpRangeParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *CreateStorageNetworkIpRangeParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *CreateStorageNetworkIpRangeParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetStartip sets the startip param. This param is required.
 func (p *CreateStorageNetworkIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *CreateStorageNetworkIpRangeParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *CreateStorageNetworkIpRangeParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	return p.startip.v, p.startip.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *CreateStorageNetworkIpRangeParams) SetVlan(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optInt{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *CreateStorageNetworkIpRangeParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optInt{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *CreateStorageNetworkIpRangeParams) GetVlan() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vlan.v, p.vlan.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateStorageNetworkIpRangeParams) Clone() *CreateStorageNetworkIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateStorageNetworkIpRangeParams) Equal(o *CreateStorageNetworkIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.endip == o.endip &&
+		p.gateway == o.gateway &&
+		p.netmask == o.netmask &&
+		p.podid == o.podid &&
+		p.startip == o.startip &&
+		p.vlan == o.vlan
+}
+
+// serializedCreateStorageNetworkIpRangeParams is used to (un)marshal CreateStorageNetworkIpRangeParams using the API param names
+type serializedCreateStorageNetworkIpRangeParams struct {
+	Endip   *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Netmask *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Podid   *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Startip *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Vlan    *int    `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+func (p *CreateStorageNetworkIpRangeParams) toSerialized() *serializedCreateStorageNetworkIpRangeParams {
+	s := &serializedCreateStorageNetworkIpRangeParams{}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	return s
+}
+
+func (p *CreateStorageNetworkIpRangeParams) fromSerialized(s *serializedCreateStorageNetworkIpRangeParams) {
+	*p = CreateStorageNetworkIpRangeParams{}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
 	}
-	value, ok := p.p["vlan"].(int)
-	return value, ok
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateStorageNetworkIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateStorageNetworkIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateStorageNetworkIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateStorageNetworkIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams {
 	p := &CreateStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["gateway"] = gateway
-	p.p["netmask"] = netmask
-	p.p["podid"] = podid
-	p.p["startip"] = startip
+	p.SetGateway(gateway)
+	p.SetNetmask(netmask)
+	p.SetPodid(podid)
+	p.SetStartip(startip)
 	return p
 }
 
 // Creates a Storage network IP range.
-func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createStorageNetworkIpRange", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: gateway, netmask, podid,
+// startip.
+func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams, opts ...CallOption) (*CreateStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("createStorageNetworkIpRange", s.cs.encodeParams("createStorageNetworkIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2265,8 +3152,8 @@ func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2302,126 +3189,223 @@ type CreateStorageNetworkIpRangeResponse struct {
 }
 
 type DedicatePublicIpRangeParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	id        optString
+	projectid optString
 }
 
-func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DedicatePublicIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDedicatePublicIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new DedicatePublicIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDedicatePublicIpRangeParams(u url.Values) (*DedicatePublicIpRangeParams, error) {
+	p := &DedicatePublicIpRangeParams{}
+	if err := checkParamNames("dedicatePublicIpRange", u, "account", "domainid", "id", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *DedicatePublicIpRangeParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DedicatePublicIpRangeParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *DedicatePublicIpRangeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DedicatePublicIpRangeParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DedicatePublicIpRangeParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DedicatePublicIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DedicatePublicIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DedicatePublicIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *DedicatePublicIpRangeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DedicatePublicIpRangeParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DedicatePublicIpRangeParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DedicatePublicIpRangeParams) Clone() *DedicatePublicIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DedicatePublicIpRangeParams) Equal(o *DedicatePublicIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.projectid == o.projectid
+}
+
+// serializedDedicatePublicIpRangeParams is used to (un)marshal DedicatePublicIpRangeParams using the API param names
+type serializedDedicatePublicIpRangeParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *DedicatePublicIpRangeParams) toSerialized() *serializedDedicatePublicIpRangeParams {
+	s := &serializedDedicatePublicIpRangeParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *DedicatePublicIpRangeParams) fromSerialized(s *serializedDedicatePublicIpRangeParams) {
+	*p = DedicatePublicIpRangeParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DedicatePublicIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DedicatePublicIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDedicatePublicIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DedicatePublicIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DedicatePublicIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDedicatePublicIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DedicatePublicIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams {
 	p := &DedicatePublicIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
-	p.p["id"] = id
+	p.SetDomainid(domainid)
+	p.SetId(id)
 	return p
 }
 
-// Dedicates a Public IP range to an account
-func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePublicIpRange", p.toURLValues())
+// Dedicates a Public IP range to an account.
+//
+// Required params: domainid, id.
+func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams, opts ...CallOption) (*DedicatePublicIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("dedicatePublicIpRange", s.cs.encodeParams("dedicatePublicIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2464,78 +3448,167 @@ type DedicatePublicIpRangeResponse struct {
 }
 
 type DeleteNetworkParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *DeleteNetworkParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteNetworkParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteNetworkParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNetworkParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNetworkParams(u url.Values) (*DeleteNetworkParams, error) {
+	p := &DeleteNetworkParams{}
+	if err := checkParamNames("deleteNetwork", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
 	}
-	p.p["forced"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *DeleteNetworkParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *DeleteNetworkParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *DeleteNetworkParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteNetworkParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteNetworkParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteNetworkParams) Clone() *DeleteNetworkParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNetworkParams) Equal(o *DeleteNetworkParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedDeleteNetworkParams is used to (un)marshal DeleteNetworkParams using the API param names
+type serializedDeleteNetworkParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteNetworkParams) toSerialized() *serializedDeleteNetworkParams {
+	s := &serializedDeleteNetworkParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteNetworkParams) fromSerialized(s *serializedDeleteNetworkParams) {
+	*p = DeleteNetworkParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNetworkParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNetworkParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNetworkParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNetworkParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNetworkParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNetworkParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams {
 	p := &DeleteNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a network
-func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetwork", p.toURLValues())
+// Deletes a network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams, opts ...CallOption) (*DeleteNetworkResponse, error) {
+	resp, err := s.cs.newRequest("deleteNetwork", s.cs.encodeParams("deleteNetwork", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2546,8 +3619,8 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2571,53 +3644,133 @@ type DeleteNetworkResponse struct {
 }
 
 type DeleteNetworkServiceProviderParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNetworkServiceProviderParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteNetworkServiceProviderParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNetworkServiceProviderParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNetworkServiceProviderParams(u url.Values) (*DeleteNetworkServiceProviderParams, error) {
+	p := &DeleteNetworkServiceProviderParams{}
+	if err := checkParamNames("deleteNetworkServiceProvider", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteNetworkServiceProviderParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteNetworkServiceProviderParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteNetworkServiceProviderParams) Clone() *DeleteNetworkServiceProviderParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNetworkServiceProviderParams) Equal(o *DeleteNetworkServiceProviderParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteNetworkServiceProviderParams is used to (un)marshal DeleteNetworkServiceProviderParams using the API param names
+type serializedDeleteNetworkServiceProviderParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteNetworkServiceProviderParams) toSerialized() *serializedDeleteNetworkServiceProviderParams {
+	s := &serializedDeleteNetworkServiceProviderParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DeleteNetworkServiceProviderParams) fromSerialized(s *serializedDeleteNetworkServiceProviderParams) {
+	*p = DeleteNetworkServiceProviderParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNetworkServiceProviderParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNetworkServiceProviderParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNetworkServiceProviderParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNetworkServiceProviderParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams {
 	p := &DeleteNetworkServiceProviderParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a Network Service Provider.
-func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkServiceProvider", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams, opts ...CallOption) (*DeleteNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequest("deleteNetworkServiceProvider", s.cs.encodeParams("deleteNetworkServiceProvider", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2628,8 +3781,8 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2653,71 +3806,151 @@ type DeleteNetworkServiceProviderResponse struct {
 }
 
 type DeleteOpenDaylightControllerParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteOpenDaylightControllerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteOpenDaylightControllerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteOpenDaylightControllerParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteOpenDaylightControllerParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteOpenDaylightControllerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteOpenDaylightControllerParams(u url.Values) (*DeleteOpenDaylightControllerParams, error) {
+	p := &DeleteOpenDaylightControllerParams{}
+	if err := checkParamNames("deleteOpenDaylightController", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteOpenDaylightControllerParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteOpenDaylightControllerParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteOpenDaylightControllerParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteOpenDaylightControllerParams) Clone() *DeleteOpenDaylightControllerParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new DeleteOpenDaylightControllerParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams {
-	p := &DeleteOpenDaylightControllerParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteOpenDaylightControllerParams) Equal(o *DeleteOpenDaylightControllerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
 }
 
-// Removes an OpenDyalight controler
-func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newRequest("deleteOpenDaylightController", p.toURLValues())
-	if err != nil {
-		return nil, err
+// serializedDeleteOpenDaylightControllerParams is used to (un)marshal DeleteOpenDaylightControllerParams using the API param names
+type serializedDeleteOpenDaylightControllerParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteOpenDaylightControllerParams) toSerialized() *serializedDeleteOpenDaylightControllerParams {
+	s := &serializedDeleteOpenDaylightControllerParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
+	return s
+}
 
-	var r DeleteOpenDaylightControllerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *DeleteOpenDaylightControllerParams) fromSerialized(s *serializedDeleteOpenDaylightControllerParams) {
+	*p = DeleteOpenDaylightControllerParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteOpenDaylightControllerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteOpenDaylightControllerParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteOpenDaylightControllerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteOpenDaylightControllerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteOpenDaylightControllerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteOpenDaylightControllerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteOpenDaylightControllerParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkService) NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams {
+	p := &DeleteOpenDaylightControllerParams{}
+	p.SetId(id)
+	return p
+}
+
+// Removes an OpenDyalight controler.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams, opts ...CallOption) (*DeleteOpenDaylightControllerResponse, error) {
+	resp, err := s.cs.newRequest("deleteOpenDaylightController", s.cs.encodeParams("deleteOpenDaylightController", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteOpenDaylightControllerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
 
 		b, err = getRawValue(b)
 		if err != nil {
@@ -2743,53 +3976,133 @@ type DeleteOpenDaylightControllerResponse struct {
 }
 
 type DeletePhysicalNetworkParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeletePhysicalNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeletePhysicalNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeletePhysicalNetworkParams parses url.Values, for example taken from a raw API request,
+// into a new DeletePhysicalNetworkParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeletePhysicalNetworkParams(u url.Values) (*DeletePhysicalNetworkParams, error) {
+	p := &DeletePhysicalNetworkParams{}
+	if err := checkParamNames("deletePhysicalNetwork", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeletePhysicalNetworkParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeletePhysicalNetworkParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeletePhysicalNetworkParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeletePhysicalNetworkParams) Clone() *DeletePhysicalNetworkParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeletePhysicalNetworkParams) Equal(o *DeletePhysicalNetworkParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeletePhysicalNetworkParams is used to (un)marshal DeletePhysicalNetworkParams using the API param names
+type serializedDeletePhysicalNetworkParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeletePhysicalNetworkParams) toSerialized() *serializedDeletePhysicalNetworkParams {
+	s := &serializedDeletePhysicalNetworkParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeletePhysicalNetworkParams) fromSerialized(s *serializedDeletePhysicalNetworkParams) {
+	*p = DeletePhysicalNetworkParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeletePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeletePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeletePhysicalNetworkParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeletePhysicalNetworkParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeletePhysicalNetworkParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeletePhysicalNetworkParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeletePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams {
 	p := &DeletePhysicalNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a Physical Network.
-func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deletePhysicalNetwork", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams, opts ...CallOption) (*DeletePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequest("deletePhysicalNetwork", s.cs.encodeParams("deletePhysicalNetwork", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2800,8 +4113,8 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2825,53 +4138,133 @@ type DeletePhysicalNetworkResponse struct {
 }
 
 type DeleteStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteStorageNetworkIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteStorageNetworkIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteStorageNetworkIpRangeParams(u url.Values) (*DeleteStorageNetworkIpRangeParams, error) {
+	p := &DeleteStorageNetworkIpRangeParams{}
+	if err := checkParamNames("deleteStorageNetworkIpRange", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteStorageNetworkIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteStorageNetworkIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteStorageNetworkIpRangeParams) Clone() *DeleteStorageNetworkIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteStorageNetworkIpRangeParams) Equal(o *DeleteStorageNetworkIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteStorageNetworkIpRangeParams is used to (un)marshal DeleteStorageNetworkIpRangeParams using the API param names
+type serializedDeleteStorageNetworkIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteStorageNetworkIpRangeParams) toSerialized() *serializedDeleteStorageNetworkIpRangeParams {
+	s := &serializedDeleteStorageNetworkIpRangeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteStorageNetworkIpRangeParams) fromSerialized(s *serializedDeleteStorageNetworkIpRangeParams) {
+	*p = DeleteStorageNetworkIpRangeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteStorageNetworkIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteStorageNetworkIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteStorageNetworkIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteStorageNetworkIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams {
 	p := &DeleteStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a storage network IP Range.
-func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteStorageNetworkIpRange", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams, opts ...CallOption) (*DeleteStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("deleteStorageNetworkIpRange", s.cs.encodeParams("deleteStorageNetworkIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2882,8 +4275,8 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2907,131 +4300,231 @@ type DeleteStorageNetworkIpRangeResponse struct {
 }
 
 type ListNetscalerLoadBalancerNetworksParams struct {
-	p map[string]interface{}
+	keyword    optString
+	lbdeviceid optString
+	page       optInt
+	pagesize   optInt
 }
 
-func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetscalerLoadBalancerNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
+	if p.lbdeviceid.ok {
+		u.Set("lbdeviceid", p.lbdeviceid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetscalerLoadBalancerNetworksParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetscalerLoadBalancerNetworksParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetscalerLoadBalancerNetworksParams(u url.Values) (*ListNetscalerLoadBalancerNetworksParams, error) {
+	p := &ListNetscalerLoadBalancerNetworksParams{}
+	if err := checkParamNames("listNetscalerLoadBalancerNetworks", u, "keyword", "lbdeviceid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["lbdeviceid"]; found {
+		p.SetLbdeviceid(u.Get("lbdeviceid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["keyword"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetscalerLoadBalancerNetworksParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetscalerLoadBalancerNetworksParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLbdeviceid sets the lbdeviceid param. This param is required.
 func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdeviceid"] = v
+	p.lbdeviceid = optString{v: v, ok: true}
 }
 
+// ResetLbdeviceid unsets the lbdeviceid param
 func (p *ListNetscalerLoadBalancerNetworksParams) ResetLbdeviceid() {
-	if p.p != nil && p.p["lbdeviceid"] != nil {
-		delete(p.p, "lbdeviceid")
-	}
+	p.lbdeviceid = optString{}
 }
 
+// GetLbdeviceid returns the lbdeviceid param and if it is set
 func (p *ListNetscalerLoadBalancerNetworksParams) GetLbdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbdeviceid"].(string)
-	return value, ok
+	return p.lbdeviceid.v, p.lbdeviceid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetscalerLoadBalancerNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetscalerLoadBalancerNetworksParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetscalerLoadBalancerNetworksParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetscalerLoadBalancerNetworksParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetscalerLoadBalancerNetworksParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetscalerLoadBalancerNetworksParams) Clone() *ListNetscalerLoadBalancerNetworksParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetscalerLoadBalancerNetworksParams) Equal(o *ListNetscalerLoadBalancerNetworksParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.lbdeviceid == o.lbdeviceid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListNetscalerLoadBalancerNetworksParams is used to (un)marshal ListNetscalerLoadBalancerNetworksParams using the API param names
+type serializedListNetscalerLoadBalancerNetworksParams struct {
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) toSerialized() *serializedListNetscalerLoadBalancerNetworksParams {
+	s := &serializedListNetscalerLoadBalancerNetworksParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.lbdeviceid.ok {
+		s.Lbdeviceid = &p.lbdeviceid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) fromSerialized(s *serializedListNetscalerLoadBalancerNetworksParams) {
+	*p = ListNetscalerLoadBalancerNetworksParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	if s.Lbdeviceid != nil {
+		p.SetLbdeviceid(*s.Lbdeviceid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetscalerLoadBalancerNetworksParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetscalerLoadBalancerNetworksParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetscalerLoadBalancerNetworksParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetscalerLoadBalancerNetworksParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetscalerLoadBalancerNetworksParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetscalerLoadBalancerNetworksParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetscalerLoadBalancerNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams {
 	p := &ListNetscalerLoadBalancerNetworksParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetscalerLoadBalancerNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["lbdeviceid"] = lbdeviceid
+	p.SetKeyword(keyword)
+	p.SetLbdeviceid(lbdeviceid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3062,9 +4555,11 @@ func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdev
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// lists network that are using a netscaler load balancer device
-func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancerNetworks", p.toURLValues())
+// lists network that are using a netscaler load balancer device.
+//
+// Required params: lbdeviceid.
+func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams, opts ...CallOption) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	resp, err := s.cs.newRequest("listNetscalerLoadBalancerNetworks", s.cs.encodeParams("listNetscalerLoadBalancerNetworks", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3181,102 +4676,197 @@ type NetscalerLoadBalancerNetworkServiceCapability struct {
 }
 
 type ListNetworkIsolationMethodsParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworkIsolationMethodsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworkIsolationMethodsParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworkIsolationMethodsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworkIsolationMethodsParams(u url.Values) (*ListNetworkIsolationMethodsParams, error) {
+	p := &ListNetworkIsolationMethodsParams{}
+	if err := checkParamNames("listNetworkIsolationMethods", u, "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetworkIsolationMethodsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetworkIsolationMethodsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetworkIsolationMethodsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetworkIsolationMethodsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetworkIsolationMethodsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetworkIsolationMethodsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetworkIsolationMethodsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetworkIsolationMethodsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetworkIsolationMethodsParams) Clone() *ListNetworkIsolationMethodsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworkIsolationMethodsParams) Equal(o *ListNetworkIsolationMethodsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListNetworkIsolationMethodsParams is used to (un)marshal ListNetworkIsolationMethodsParams using the API param names
+type serializedListNetworkIsolationMethodsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListNetworkIsolationMethodsParams) toSerialized() *serializedListNetworkIsolationMethodsParams {
+	s := &serializedListNetworkIsolationMethodsParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return s
+}
+
+func (p *ListNetworkIsolationMethodsParams) fromSerialized(s *serializedListNetworkIsolationMethodsParams) {
+	*p = ListNetworkIsolationMethodsParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworkIsolationMethodsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworkIsolationMethodsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworkIsolationMethodsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworkIsolationMethodsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworkIsolationMethodsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworkIsolationMethodsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetworkIsolationMethodsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams {
 	p := &ListNetworkIsolationMethodsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists supported methods of network isolation
-func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkIsolationMethods", p.toURLValues())
+// Lists supported methods of network isolation.
+func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams, opts ...CallOption) (*ListNetworkIsolationMethodsResponse, error) {
+	resp, err := s.cs.newRequest("listNetworkIsolationMethods", s.cs.encodeParams("listNetworkIsolationMethods", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3301,180 +4891,292 @@ type NetworkIsolationMethod struct {
 }
 
 type ListNetworkServiceProvidersParams struct {
-	p map[string]interface{}
+	keyword           optString
+	name              optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
+	state             optString
 }
 
-func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworkServiceProvidersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
 	return u
 }
 
-func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworkServiceProvidersParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworkServiceProvidersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworkServiceProvidersParams(u url.Values) (*ListNetworkServiceProvidersParams, error) {
+	p := &ListNetworkServiceProvidersParams{}
+	if err := checkParamNames("listNetworkServiceProviders", u, "keyword", "name", "page", "pagesize", "physicalnetworkid", "state"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetworkServiceProvidersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetworkServiceProvidersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListNetworkServiceProvidersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListNetworkServiceProvidersParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListNetworkServiceProvidersParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetworkServiceProvidersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetworkServiceProvidersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetworkServiceProvidersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetworkServiceProvidersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetworkServiceProvidersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetworkServiceProvidersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListNetworkServiceProvidersParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListNetworkServiceProvidersParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetState sets the state param.
 func (p *ListNetworkServiceProvidersParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListNetworkServiceProvidersParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListNetworkServiceProvidersParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
-// You should always use this function to get a new ListNetworkServiceProvidersParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams {
-	p := &ListNetworkServiceProvidersParams{}
-	p.p = make(map[string]interface{})
-	return p
+// Clone returns a deep copy of the params
+func (p *ListNetworkServiceProvidersParams) Clone() *ListNetworkServiceProvidersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error) {
-	p := &ListNetworkServiceProvidersParams{}
-	p.p = make(map[string]interface{})
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworkServiceProvidersParams) Equal(o *ListNetworkServiceProvidersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.state == o.state
+}
 
-	p.p["name"] = name
+// serializedListNetworkServiceProvidersParams is used to (un)marshal ListNetworkServiceProvidersParams using the API param names
+type serializedListNetworkServiceProvidersParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name              *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	State             *string `json:"state,omitempty" yaml:"state,omitempty"`
+}
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
+func (p *ListNetworkServiceProvidersParams) toSerialized() *serializedListNetworkServiceProvidersParams {
+	s := &serializedListNetworkServiceProvidersParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	return s
+}
+
+func (p *ListNetworkServiceProvidersParams) fromSerialized(s *serializedListNetworkServiceProvidersParams) {
+	*p = ListNetworkServiceProvidersParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworkServiceProvidersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworkServiceProvidersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworkServiceProvidersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworkServiceProvidersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworkServiceProvidersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworkServiceProvidersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListNetworkServiceProvidersParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams {
+	p := &ListNetworkServiceProvidersParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListNetworkServiceProvidersParams{}
+
+	p.SetName(name)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
 			return "", -1, err
 		}
 	}
@@ -3503,8 +5205,8 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 }
 
 // Lists network serviceproviders for a given physical network.
-func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkServiceProviders", p.toURLValues())
+func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams, opts ...CallOption) (*ListNetworkServiceProvidersResponse, error) {
+	resp, err := s.cs.newRequest("listNetworkServiceProviders", s.cs.encodeParams("listNetworkServiceProviders", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3535,744 +5237,1027 @@ type NetworkServiceProvider struct {
 }
 
 type ListNetworksParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworksParams) toURLValues() url.Values {
+	account                   optString
+	acltype                   optString
+	associatednetworkid       optString
+	canusefordeploy           optBool
+	displaynetwork            optBool
+	domainid                  optString
+	forvpc                    optBool
+	id                        optString
+	isrecursive               optBool
+	issystem                  optBool
+	keyword                   optString
+	listall                   optBool
+	networkfilter             optString
+	networkofferingid         optString
+	page                      optInt
+	pagesize                  optInt
+	physicalnetworkid         optString
+	projectid                 optString
+	restartrequired           optBool
+	retrieveonlyresourcecount optBool
+	showicon                  optBool
+	specifyipranges           optBool
+	supportedservices         optStrings
+	tags                      optStringMap
+	traffictype               optString
+	type_                     optString
+	vlan                      optString
+	vpcid                     optString
+	zoneid                    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["acltype"]; found {
-		u.Set("acltype", v.(string))
+	if p.acltype.ok {
+		u.Set("acltype", p.acltype.v)
 	}
-	if v, found := p.p["associatednetworkid"]; found {
-		u.Set("associatednetworkid", v.(string))
+	if p.associatednetworkid.ok {
+		u.Set("associatednetworkid", p.associatednetworkid.v)
 	}
-	if v, found := p.p["canusefordeploy"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("canusefordeploy", vv)
+	if p.canusefordeploy.ok {
+		u.Set("canusefordeploy", strconv.FormatBool(p.canusefordeploy.v))
 	}
-	if v, found := p.p["displaynetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displaynetwork", vv)
+	if p.displaynetwork.ok {
+		u.Set("displaynetwork", strconv.FormatBool(p.displaynetwork.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvpc", vv)
+	if p.forvpc.ok {
+		u.Set("forvpc", strconv.FormatBool(p.forvpc.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["issystem"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("issystem", vv)
+	if p.issystem.ok {
+		u.Set("issystem", strconv.FormatBool(p.issystem.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkfilter"]; found {
-		u.Set("networkfilter", v.(string))
+	if p.networkfilter.ok {
+		u.Set("networkfilter", p.networkfilter.v)
 	}
-	if v, found := p.p["networkofferingid"]; found {
-		u.Set("networkofferingid", v.(string))
+	if p.networkofferingid.ok {
+		u.Set("networkofferingid", p.networkofferingid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["restartrequired"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("restartrequired", vv)
+	if p.restartrequired.ok {
+		u.Set("restartrequired", strconv.FormatBool(p.restartrequired.v))
 	}
-	if v, found := p.p["retrieveonlyresourcecount"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("retrieveonlyresourcecount", vv)
+	if p.retrieveonlyresourcecount.ok {
+		u.Set("retrieveonlyresourcecount", strconv.FormatBool(p.retrieveonlyresourcecount.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["specifyipranges"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("specifyipranges", vv)
+	if p.specifyipranges.ok {
+		u.Set("specifyipranges", strconv.FormatBool(p.specifyipranges.v))
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("supportedservices", vv)
+	if p.supportedservices.ok {
+		u.Set("supportedservices", strings.Join(p.supportedservices.v, ","))
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListNetworksParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworksParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworksParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworksParams(u url.Values) (*ListNetworksParams, error) {
+	p := &ListNetworksParams{}
+	if err := checkParamNames("listNetworks", u, "account", "acltype", "associatednetworkid", "canusefordeploy", "displaynetwork", "domainid", "forvpc", "id", "isrecursive", "issystem", "keyword", "listall", "networkfilter", "networkofferingid", "page", "pagesize", "physicalnetworkid", "projectid", "restartrequired", "retrieveonlyresourcecount", "showicon", "specifyipranges", "supportedservices", "tags[]", "traffictype", "type", "vlan", "vpcid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["acltype"]; found {
+		p.SetAcltype(u.Get("acltype"))
+	}
+	if _, found := u["associatednetworkid"]; found {
+		p.SetAssociatednetworkid(u.Get("associatednetworkid"))
+	}
+	if _, found := u["canusefordeploy"]; found {
+		v, err := strconv.ParseBool(u.Get("canusefordeploy"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param canusefordeploy: %v", err)
+		}
+		p.SetCanusefordeploy(v)
+	}
+	if _, found := u["displaynetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("displaynetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displaynetwork: %v", err)
+		}
+		p.SetDisplaynetwork(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["forvpc"]; found {
+		v, err := strconv.ParseBool(u.Get("forvpc"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvpc: %v", err)
+		}
+		p.SetForvpc(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
 	}
-	p.p["account"] = v
+	if _, found := u["issystem"]; found {
+		v, err := strconv.ParseBool(u.Get("issystem"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param issystem: %v", err)
+		}
+		p.SetIssystem(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkfilter"]; found {
+		p.SetNetworkfilter(u.Get("networkfilter"))
+	}
+	if _, found := u["networkofferingid"]; found {
+		p.SetNetworkofferingid(u.Get("networkofferingid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["restartrequired"]; found {
+		v, err := strconv.ParseBool(u.Get("restartrequired"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param restartrequired: %v", err)
+		}
+		p.SetRestartrequired(v)
+	}
+	if _, found := u["retrieveonlyresourcecount"]; found {
+		v, err := strconv.ParseBool(u.Get("retrieveonlyresourcecount"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param retrieveonlyresourcecount: %v", err)
+		}
+		p.SetRetrieveonlyresourcecount(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["specifyipranges"]; found {
+		v, err := strconv.ParseBool(u.Get("specifyipranges"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param specifyipranges: %v", err)
+		}
+		p.SetSpecifyipranges(v)
+	}
+	if _, found := u["supportedservices"]; found {
+		p.SetSupportedservices(strings.Split(u.Get("supportedservices"), ","))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListNetworksParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListNetworksParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListNetworksParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAcltype sets the acltype param.
 func (p *ListNetworksParams) SetAcltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["acltype"] = v
+	p.acltype = optString{v: v, ok: true}
 }
 
+// ResetAcltype unsets the acltype param
 func (p *ListNetworksParams) ResetAcltype() {
-	if p.p != nil && p.p["acltype"] != nil {
-		delete(p.p, "acltype")
-	}
+	p.acltype = optString{}
 }
 
+// GetAcltype returns the acltype param and if it is set
 func (p *ListNetworksParams) GetAcltype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["acltype"].(string)
-	return value, ok
+	return p.acltype.v, p.acltype.ok
 }
 
+// SetAssociatednetworkid sets the associatednetworkid param.
 func (p *ListNetworksParams) SetAssociatednetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["associatednetworkid"] = v
+	p.associatednetworkid = optString{v: v, ok: true}
 }
 
+// ResetAssociatednetworkid unsets the associatednetworkid param
 func (p *ListNetworksParams) ResetAssociatednetworkid() {
-	if p.p != nil && p.p["associatednetworkid"] != nil {
-		delete(p.p, "associatednetworkid")
-	}
+	p.associatednetworkid = optString{}
 }
 
+// GetAssociatednetworkid returns the associatednetworkid param and if it is set
 func (p *ListNetworksParams) GetAssociatednetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["associatednetworkid"].(string)
-	return value, ok
+	return p.associatednetworkid.v, p.associatednetworkid.ok
 }
 
+// SetCanusefordeploy sets the canusefordeploy param.
 func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["canusefordeploy"] = v
+	p.canusefordeploy = optBool{v: v, ok: true}
 }
 
+// ResetCanusefordeploy unsets the canusefordeploy param
 func (p *ListNetworksParams) ResetCanusefordeploy() {
-	if p.p != nil && p.p["canusefordeploy"] != nil {
-		delete(p.p, "canusefordeploy")
-	}
+	p.canusefordeploy = optBool{}
 }
 
+// GetCanusefordeploy returns the canusefordeploy param and if it is set
 func (p *ListNetworksParams) GetCanusefordeploy() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["canusefordeploy"].(bool)
-	return value, ok
+	return p.canusefordeploy.v, p.canusefordeploy.ok
 }
 
+// SetDisplaynetwork sets the displaynetwork param.
 func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaynetwork"] = v
+	p.displaynetwork = optBool{v: v, ok: true}
 }
 
+// ResetDisplaynetwork unsets the displaynetwork param
 func (p *ListNetworksParams) ResetDisplaynetwork() {
-	if p.p != nil && p.p["displaynetwork"] != nil {
-		delete(p.p, "displaynetwork")
-	}
+	p.displaynetwork = optBool{}
 }
 
+// GetDisplaynetwork returns the displaynetwork param and if it is set
 func (p *ListNetworksParams) GetDisplaynetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaynetwork"].(bool)
-	return value, ok
+	return p.displaynetwork.v, p.displaynetwork.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListNetworksParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListNetworksParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListNetworksParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetForvpc sets the forvpc param.
 func (p *ListNetworksParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
+	p.forvpc = optBool{v: v, ok: true}
 }
 
+// ResetForvpc unsets the forvpc param
 func (p *ListNetworksParams) ResetForvpc() {
-	if p.p != nil && p.p["forvpc"] != nil {
-		delete(p.p, "forvpc")
-	}
+	p.forvpc = optBool{}
 }
 
+// GetForvpc returns the forvpc param and if it is set
 func (p *ListNetworksParams) GetForvpc() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvpc"].(bool)
-	return value, ok
+	return p.forvpc.v, p.forvpc.ok
 }
 
+// SetId sets the id param.
 func (p *ListNetworksParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListNetworksParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListNetworksParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListNetworksParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListNetworksParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListNetworksParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetIssystem sets the issystem param.
 func (p *ListNetworksParams) SetIssystem(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["issystem"] = v
+	p.issystem = optBool{v: v, ok: true}
 }
 
+// ResetIssystem unsets the issystem param
 func (p *ListNetworksParams) ResetIssystem() {
-	if p.p != nil && p.p["issystem"] != nil {
-		delete(p.p, "issystem")
-	}
+	p.issystem = optBool{}
 }
 
+// GetIssystem returns the issystem param and if it is set
 func (p *ListNetworksParams) GetIssystem() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["issystem"].(bool)
-	return value, ok
+	return p.issystem.v, p.issystem.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNetworksParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNetworksParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListNetworksParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListNetworksParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListNetworksParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkfilter sets the networkfilter param.
 func (p *ListNetworksParams) SetNetworkfilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkfilter"] = v
+	p.networkfilter = optString{v: v, ok: true}
 }
 
+// ResetNetworkfilter unsets the networkfilter param
 func (p *ListNetworksParams) ResetNetworkfilter() {
-	if p.p != nil && p.p["networkfilter"] != nil {
-		delete(p.p, "networkfilter")
-	}
+	p.networkfilter = optString{}
 }
 
+// GetNetworkfilter returns the networkfilter param and if it is set
 func (p *ListNetworksParams) GetNetworkfilter() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkfilter"].(string)
-	return value, ok
+	return p.networkfilter.v, p.networkfilter.ok
 }
 
+// SetNetworkofferingid sets the networkofferingid param.
 func (p *ListNetworksParams) SetNetworkofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkofferingid"] = v
+	p.networkofferingid = optString{v: v, ok: true}
 }
 
+// ResetNetworkofferingid unsets the networkofferingid param
 func (p *ListNetworksParams) ResetNetworkofferingid() {
-	if p.p != nil && p.p["networkofferingid"] != nil {
-		delete(p.p, "networkofferingid")
-	}
+	p.networkofferingid = optString{}
 }
 
+// GetNetworkofferingid returns the networkofferingid param and if it is set
 func (p *ListNetworksParams) GetNetworkofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkofferingid"].(string)
-	return value, ok
+	return p.networkofferingid.v, p.networkofferingid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNetworksParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNetworksParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNetworksParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNetworksParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListNetworksParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListNetworksParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListNetworksParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListNetworksParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListNetworksParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRestartrequired sets the restartrequired param.
 func (p *ListNetworksParams) SetRestartrequired(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["restartrequired"] = v
+	p.restartrequired = optBool{v: v, ok: true}
 }
 
+// ResetRestartrequired unsets the restartrequired param
 func (p *ListNetworksParams) ResetRestartrequired() {
-	if p.p != nil && p.p["restartrequired"] != nil {
-		delete(p.p, "restartrequired")
-	}
+	p.restartrequired = optBool{}
 }
 
+// GetRestartrequired returns the restartrequired param and if it is set
 func (p *ListNetworksParams) GetRestartrequired() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["restartrequired"].(bool)
-	return value, ok
+	return p.restartrequired.v, p.restartrequired.ok
 }
 
+// SetRetrieveonlyresourcecount sets the retrieveonlyresourcecount param.
 func (p *ListNetworksParams) SetRetrieveonlyresourcecount(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["retrieveonlyresourcecount"] = v
+	p.retrieveonlyresourcecount = optBool{v: v, ok: true}
 }
 
+// ResetRetrieveonlyresourcecount unsets the retrieveonlyresourcecount param
 func (p *ListNetworksParams) ResetRetrieveonlyresourcecount() {
-	if p.p != nil && p.p["retrieveonlyresourcecount"] != nil {
-		delete(p.p, "retrieveonlyresourcecount")
-	}
+	p.retrieveonlyresourcecount = optBool{}
 }
 
+// GetRetrieveonlyresourcecount returns the retrieveonlyresourcecount param and if it is set
 func (p *ListNetworksParams) GetRetrieveonlyresourcecount() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["retrieveonlyresourcecount"].(bool)
-	return value, ok
+	return p.retrieveonlyresourcecount.v, p.retrieveonlyresourcecount.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListNetworksParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListNetworksParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListNetworksParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetSpecifyipranges sets the specifyipranges param.
 func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["specifyipranges"] = v
+	p.specifyipranges = optBool{v: v, ok: true}
 }
 
+// ResetSpecifyipranges unsets the specifyipranges param
 func (p *ListNetworksParams) ResetSpecifyipranges() {
-	if p.p != nil && p.p["specifyipranges"] != nil {
-		delete(p.p, "specifyipranges")
-	}
+	p.specifyipranges = optBool{}
 }
 
+// GetSpecifyipranges returns the specifyipranges param and if it is set
 func (p *ListNetworksParams) GetSpecifyipranges() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["specifyipranges"].(bool)
-	return value, ok
+	return p.specifyipranges.v, p.specifyipranges.ok
 }
 
+// SetSupportedservices sets the supportedservices param.
 func (p *ListNetworksParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
+	p.supportedservices = optStrings{v: v, ok: true}
 }
 
+// ResetSupportedservices unsets the supportedservices param
 func (p *ListNetworksParams) ResetSupportedservices() {
-	if p.p != nil && p.p["supportedservices"] != nil {
-		delete(p.p, "supportedservices")
-	}
+	p.supportedservices = optStrings{}
 }
 
+// GetSupportedservices returns the supportedservices param and if it is set
 func (p *ListNetworksParams) GetSupportedservices() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["supportedservices"].([]string)
-	return value, ok
+	return p.supportedservices.v, p.supportedservices.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListNetworksParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListNetworksParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListNetworksParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetTraffictype sets the traffictype param.
 func (p *ListNetworksParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *ListNetworksParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *ListNetworksParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	return p.traffictype.v, p.traffictype.ok
 }
 
+// SetType sets the type param.
 func (p *ListNetworksParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListNetworksParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListNetworksParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *ListNetworksParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *ListNetworksParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *ListNetworksParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListNetworksParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListNetworksParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListNetworksParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListNetworksParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListNetworksParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListNetworksParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetworksParams) Clone() *ListNetworksParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.supportedservices = p.supportedservices.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworksParams) Equal(o *ListNetworksParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.acltype == o.acltype &&
+		p.associatednetworkid == o.associatednetworkid &&
+		p.canusefordeploy == o.canusefordeploy &&
+		p.displaynetwork == o.displaynetwork &&
+		p.domainid == o.domainid &&
+		p.forvpc == o.forvpc &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.issystem == o.issystem &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkfilter == o.networkfilter &&
+		p.networkofferingid == o.networkofferingid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.projectid == o.projectid &&
+		p.restartrequired == o.restartrequired &&
+		p.retrieveonlyresourcecount == o.retrieveonlyresourcecount &&
+		p.showicon == o.showicon &&
+		p.specifyipranges == o.specifyipranges &&
+		p.supportedservices.equal(o.supportedservices) &&
+		p.tags.equal(o.tags) &&
+		p.traffictype == o.traffictype &&
+		p.type_ == o.type_ &&
+		p.vlan == o.vlan &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListNetworksParams is used to (un)marshal ListNetworksParams using the API param names
+type serializedListNetworksParams struct {
+	Account                   *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Acltype                   *string            `json:"acltype,omitempty" yaml:"acltype,omitempty"`
+	Associatednetworkid       *string            `json:"associatednetworkid,omitempty" yaml:"associatednetworkid,omitempty"`
+	Canusefordeploy           *bool              `json:"canusefordeploy,omitempty" yaml:"canusefordeploy,omitempty"`
+	Displaynetwork            *bool              `json:"displaynetwork,omitempty" yaml:"displaynetwork,omitempty"`
+	Domainid                  *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvpc                    *bool              `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Id                        *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive               *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Issystem                  *bool              `json:"issystem,omitempty" yaml:"issystem,omitempty"`
+	Keyword                   *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                   *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkfilter             *string            `json:"networkfilter,omitempty" yaml:"networkfilter,omitempty"`
+	Networkofferingid         *string            `json:"networkofferingid,omitempty" yaml:"networkofferingid,omitempty"`
+	Page                      *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                  *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid         *string            `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid                 *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Restartrequired           *bool              `json:"restartrequired,omitempty" yaml:"restartrequired,omitempty"`
+	Retrieveonlyresourcecount *bool              `json:"retrieveonlyresourcecount,omitempty" yaml:"retrieveonlyresourcecount,omitempty"`
+	Showicon                  *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	Specifyipranges           *bool              `json:"specifyipranges,omitempty" yaml:"specifyipranges,omitempty"`
+	Supportedservices         *[]string          `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags                      *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype               *string            `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+	Type                      *string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Vlan                      *string            `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vpcid                     *string            `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid                    *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListNetworksParams) toSerialized() *serializedListNetworksParams {
+	s := &serializedListNetworksParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.acltype.ok {
+		s.Acltype = &p.acltype.v
+	}
+	if p.associatednetworkid.ok {
+		s.Associatednetworkid = &p.associatednetworkid.v
+	}
+	if p.canusefordeploy.ok {
+		s.Canusefordeploy = &p.canusefordeploy.v
+	}
+	if p.displaynetwork.ok {
+		s.Displaynetwork = &p.displaynetwork.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.forvpc.ok {
+		s.Forvpc = &p.forvpc.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.issystem.ok {
+		s.Issystem = &p.issystem.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.networkfilter.ok {
+		s.Networkfilter = &p.networkfilter.v
+	}
+	if p.networkofferingid.ok {
+		s.Networkofferingid = &p.networkofferingid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.restartrequired.ok {
+		s.Restartrequired = &p.restartrequired.v
+	}
+	if p.retrieveonlyresourcecount.ok {
+		s.Retrieveonlyresourcecount = &p.retrieveonlyresourcecount.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.specifyipranges.ok {
+		s.Specifyipranges = &p.specifyipranges.v
+	}
+	if p.supportedservices.ok {
+		s.Supportedservices = &p.supportedservices.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListNetworksParams) fromSerialized(s *serializedListNetworksParams) {
+	*p = ListNetworksParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Acltype != nil {
+		p.SetAcltype(*s.Acltype)
+	}
+	if s.Associatednetworkid != nil {
+		p.SetAssociatednetworkid(*s.Associatednetworkid)
+	}
+	if s.Canusefordeploy != nil {
+		p.SetCanusefordeploy(*s.Canusefordeploy)
+	}
+	if s.Displaynetwork != nil {
+		p.SetDisplaynetwork(*s.Displaynetwork)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Forvpc != nil {
+		p.SetForvpc(*s.Forvpc)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Issystem != nil {
+		p.SetIssystem(*s.Issystem)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Networkfilter != nil {
+		p.SetNetworkfilter(*s.Networkfilter)
+	}
+	if s.Networkofferingid != nil {
+		p.SetNetworkofferingid(*s.Networkofferingid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Restartrequired != nil {
+		p.SetRestartrequired(*s.Restartrequired)
+	}
+	if s.Retrieveonlyresourcecount != nil {
+		p.SetRetrieveonlyresourcecount(*s.Retrieveonlyresourcecount)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.Specifyipranges != nil {
+		p.SetSpecifyipranges(*s.Specifyipranges)
+	}
+	if s.Supportedservices != nil {
+		p.SetSupportedservices(*s.Supportedservices)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworksParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworksParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworksParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworksParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworksParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworksParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetworksParams() *ListNetworksParams {
 	p := &ListNetworksParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4320,9 +6305,8 @@ func (s *NetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Net
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error) {
 	p := &ListNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4351,8 +6335,8 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 }
 
 // Lists all available networks.
-func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetworks", p.toURLValues())
+func (s *NetworkService) ListNetworks(p *ListNetworksParams, opts ...CallOption) (*ListNetworksResponse, error) {
+	resp, err := s.cs.newRequest("listNetworks", s.cs.encodeParams("listNetworks", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4469,131 +6453,231 @@ type NetworkServiceInternalCapability struct {
 }
 
 type ListNiciraNvpDeviceNetworksParams struct {
-	p map[string]interface{}
+	keyword     optString
+	nvpdeviceid optString
+	page        optInt
+	pagesize    optInt
 }
 
-func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNiciraNvpDeviceNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["nvpdeviceid"]; found {
-		u.Set("nvpdeviceid", v.(string))
+	if p.nvpdeviceid.ok {
+		u.Set("nvpdeviceid", p.nvpdeviceid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNiciraNvpDeviceNetworksParams parses url.Values, for example taken from a raw API request,
+// into a new ListNiciraNvpDeviceNetworksParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNiciraNvpDeviceNetworksParams(u url.Values) (*ListNiciraNvpDeviceNetworksParams, error) {
+	p := &ListNiciraNvpDeviceNetworksParams{}
+	if err := checkParamNames("listNiciraNvpDeviceNetworks", u, "keyword", "nvpdeviceid", "page", "pagesize"); err != nil {
+		return nil, err
 	}
-	p.p["keyword"] = v
-}
-
-func (p *ListNiciraNvpDeviceNetworksParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-}
-
-func (p *ListNiciraNvpDeviceNetworksParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["nvpdeviceid"]; found {
+		p.SetNvpdeviceid(u.Get("nvpdeviceid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *ListNiciraNvpDeviceNetworksParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *ListNiciraNvpDeviceNetworksParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNvpdeviceid sets the nvpdeviceid param. This param is required.
 func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nvpdeviceid"] = v
+	p.nvpdeviceid = optString{v: v, ok: true}
 }
 
+// ResetNvpdeviceid unsets the nvpdeviceid param
 func (p *ListNiciraNvpDeviceNetworksParams) ResetNvpdeviceid() {
-	if p.p != nil && p.p["nvpdeviceid"] != nil {
-		delete(p.p, "nvpdeviceid")
-	}
+	p.nvpdeviceid = optString{}
 }
 
+// GetNvpdeviceid returns the nvpdeviceid param and if it is set
 func (p *ListNiciraNvpDeviceNetworksParams) GetNvpdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nvpdeviceid"].(string)
-	return value, ok
+	return p.nvpdeviceid.v, p.nvpdeviceid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNiciraNvpDeviceNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNiciraNvpDeviceNetworksParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNiciraNvpDeviceNetworksParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNiciraNvpDeviceNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNiciraNvpDeviceNetworksParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNiciraNvpDeviceNetworksParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNiciraNvpDeviceNetworksParams) Clone() *ListNiciraNvpDeviceNetworksParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNiciraNvpDeviceNetworksParams) Equal(o *ListNiciraNvpDeviceNetworksParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.nvpdeviceid == o.nvpdeviceid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListNiciraNvpDeviceNetworksParams is used to (un)marshal ListNiciraNvpDeviceNetworksParams using the API param names
+type serializedListNiciraNvpDeviceNetworksParams struct {
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nvpdeviceid *string `json:"nvpdeviceid,omitempty" yaml:"nvpdeviceid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) toSerialized() *serializedListNiciraNvpDeviceNetworksParams {
+	s := &serializedListNiciraNvpDeviceNetworksParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.nvpdeviceid.ok {
+		s.Nvpdeviceid = &p.nvpdeviceid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) fromSerialized(s *serializedListNiciraNvpDeviceNetworksParams) {
+	*p = ListNiciraNvpDeviceNetworksParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Nvpdeviceid != nil {
+		p.SetNvpdeviceid(*s.Nvpdeviceid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNiciraNvpDeviceNetworksParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNiciraNvpDeviceNetworksParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNiciraNvpDeviceNetworksParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNiciraNvpDeviceNetworksParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNiciraNvpDeviceNetworksParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNiciraNvpDeviceNetworksParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNiciraNvpDeviceNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams {
 	p := &ListNiciraNvpDeviceNetworksParams{}
-	p.p = make(map[string]interface{})
-	p.p["nvpdeviceid"] = nvpdeviceid
+	p.SetNvpdeviceid(nvpdeviceid)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNiciraNvpDeviceNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["nvpdeviceid"] = nvpdeviceid
+	p.SetKeyword(keyword)
+	p.SetNvpdeviceid(nvpdeviceid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4624,9 +6708,11 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// lists network that are using a nicira nvp device
-func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", p.toURLValues())
+// lists network that are using a nicira nvp device.
+//
+// Required params: nvpdeviceid.
+func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams, opts ...CallOption) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", s.cs.encodeParams("listNiciraNvpDeviceNetworks", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4743,79 +6829,161 @@ type NiciraNvpDeviceNetworkServiceCapability struct {
 }
 
 type ListOpenDaylightControllersParams struct {
-	p map[string]interface{}
+	id                optString
+	physicalnetworkid optString
 }
 
-func (p *ListOpenDaylightControllersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListOpenDaylightControllersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListOpenDaylightControllersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListOpenDaylightControllersParams parses url.Values, for example taken from a raw API request,
+// into a new ListOpenDaylightControllersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListOpenDaylightControllersParams(u url.Values) (*ListOpenDaylightControllersParams, error) {
+	p := &ListOpenDaylightControllersParams{}
+	if err := checkParamNames("listOpenDaylightControllers", u, "id", "physicalnetworkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListOpenDaylightControllersParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListOpenDaylightControllersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListOpenDaylightControllersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListOpenDaylightControllersParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListOpenDaylightControllersParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListOpenDaylightControllersParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListOpenDaylightControllersParams) Clone() *ListOpenDaylightControllersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListOpenDaylightControllersParams) Equal(o *ListOpenDaylightControllersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListOpenDaylightControllersParams is used to (un)marshal ListOpenDaylightControllersParams using the API param names
+type serializedListOpenDaylightControllersParams struct {
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListOpenDaylightControllersParams) toSerialized() *serializedListOpenDaylightControllersParams {
+	s := &serializedListOpenDaylightControllersParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListOpenDaylightControllersParams) fromSerialized(s *serializedListOpenDaylightControllersParams) {
+	*p = ListOpenDaylightControllersParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListOpenDaylightControllersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListOpenDaylightControllersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListOpenDaylightControllersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListOpenDaylightControllersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListOpenDaylightControllersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListOpenDaylightControllersParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListOpenDaylightControllersParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListOpenDaylightControllersParams() *ListOpenDaylightControllersParams {
 	p := &ListOpenDaylightControllersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
 	p := &ListOpenDaylightControllersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4843,9 +7011,9 @@ func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...Option
 	return nil, l.Count, fmt.Errorf("There is more then one result for OpenDaylightController UUID: %s!", id)
 }
 
-// Lists OpenDyalight controllers
-func (s *NetworkService) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
-	resp, err := s.cs.newRequest("listOpenDaylightControllers", p.toURLValues())
+// Lists OpenDyalight controllers.
+func (s *NetworkService) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams, opts ...CallOption) (*ListOpenDaylightControllersResponse, error) {
+	resp, err := s.cs.newRequest("listOpenDaylightControllers", s.cs.encodeParams("listOpenDaylightControllers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4874,131 +7042,231 @@ type OpenDaylightController struct {
 }
 
 type ListPaloAltoFirewallNetworksParams struct {
-	p map[string]interface{}
+	keyword    optString
+	lbdeviceid optString
+	page       optInt
+	pagesize   optInt
 }
 
-func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPaloAltoFirewallNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
+	if p.lbdeviceid.ok {
+		u.Set("lbdeviceid", p.lbdeviceid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPaloAltoFirewallNetworksParams parses url.Values, for example taken from a raw API request,
+// into a new ListPaloAltoFirewallNetworksParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPaloAltoFirewallNetworksParams(u url.Values) (*ListPaloAltoFirewallNetworksParams, error) {
+	p := &ListPaloAltoFirewallNetworksParams{}
+	if err := checkParamNames("listPaloAltoFirewallNetworks", u, "keyword", "lbdeviceid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["lbdeviceid"]; found {
+		p.SetLbdeviceid(u.Get("lbdeviceid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPaloAltoFirewallNetworksParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPaloAltoFirewallNetworksParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetLbdeviceid sets the lbdeviceid param. This param is required.
 func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdeviceid"] = v
+	p.lbdeviceid = optString{v: v, ok: true}
 }
 
+// ResetLbdeviceid unsets the lbdeviceid param
 func (p *ListPaloAltoFirewallNetworksParams) ResetLbdeviceid() {
-	if p.p != nil && p.p["lbdeviceid"] != nil {
-		delete(p.p, "lbdeviceid")
-	}
+	p.lbdeviceid = optString{}
 }
 
+// GetLbdeviceid returns the lbdeviceid param and if it is set
 func (p *ListPaloAltoFirewallNetworksParams) GetLbdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lbdeviceid"].(string)
-	return value, ok
+	return p.lbdeviceid.v, p.lbdeviceid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPaloAltoFirewallNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPaloAltoFirewallNetworksParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPaloAltoFirewallNetworksParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPaloAltoFirewallNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPaloAltoFirewallNetworksParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPaloAltoFirewallNetworksParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPaloAltoFirewallNetworksParams) Clone() *ListPaloAltoFirewallNetworksParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPaloAltoFirewallNetworksParams) Equal(o *ListPaloAltoFirewallNetworksParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.lbdeviceid == o.lbdeviceid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListPaloAltoFirewallNetworksParams is used to (un)marshal ListPaloAltoFirewallNetworksParams using the API param names
+type serializedListPaloAltoFirewallNetworksParams struct {
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) toSerialized() *serializedListPaloAltoFirewallNetworksParams {
+	s := &serializedListPaloAltoFirewallNetworksParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.lbdeviceid.ok {
+		s.Lbdeviceid = &p.lbdeviceid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) fromSerialized(s *serializedListPaloAltoFirewallNetworksParams) {
+	*p = ListPaloAltoFirewallNetworksParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Lbdeviceid != nil {
+		p.SetLbdeviceid(*s.Lbdeviceid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPaloAltoFirewallNetworksParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPaloAltoFirewallNetworksParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPaloAltoFirewallNetworksParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPaloAltoFirewallNetworksParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPaloAltoFirewallNetworksParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPaloAltoFirewallNetworksParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPaloAltoFirewallNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams {
 	p := &ListPaloAltoFirewallNetworksParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPaloAltoFirewallNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["lbdeviceid"] = lbdeviceid
+	p.SetKeyword(keyword)
+	p.SetLbdeviceid(lbdeviceid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5029,9 +7297,11 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// lists network that are using Palo Alto firewall device
-func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewallNetworks", p.toURLValues())
+// lists network that are using Palo Alto firewall device.
+//
+// Required params: lbdeviceid.
+func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams, opts ...CallOption) (*ListPaloAltoFirewallNetworksResponse, error) {
+	resp, err := s.cs.newRequest("listPaloAltoFirewallNetworks", s.cs.encodeParams("listPaloAltoFirewallNetworks", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5148,177 +7418,289 @@ type PaloAltoFirewallNetworkServiceCapability struct {
 }
 
 type ListPhysicalNetworksParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	zoneid   optString
 }
 
-func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPhysicalNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListPhysicalNetworksParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPhysicalNetworksParams parses url.Values, for example taken from a raw API request,
+// into a new ListPhysicalNetworksParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPhysicalNetworksParams(u url.Values) (*ListPhysicalNetworksParams, error) {
+	p := &ListPhysicalNetworksParams{}
+	if err := checkParamNames("listPhysicalNetworks", u, "id", "keyword", "name", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["id"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListPhysicalNetworksParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListPhysicalNetworksParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListPhysicalNetworksParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListPhysicalNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPhysicalNetworksParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPhysicalNetworksParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListPhysicalNetworksParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListPhysicalNetworksParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListPhysicalNetworksParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPhysicalNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPhysicalNetworksParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPhysicalNetworksParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPhysicalNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPhysicalNetworksParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPhysicalNetworksParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListPhysicalNetworksParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListPhysicalNetworksParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListPhysicalNetworksParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPhysicalNetworksParams) Clone() *ListPhysicalNetworksParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPhysicalNetworksParams) Equal(o *ListPhysicalNetworksParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListPhysicalNetworksParams is used to (un)marshal ListPhysicalNetworksParams using the API param names
+type serializedListPhysicalNetworksParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListPhysicalNetworksParams) toSerialized() *serializedListPhysicalNetworksParams {
+	s := &serializedListPhysicalNetworksParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListPhysicalNetworksParams) fromSerialized(s *serializedListPhysicalNetworksParams) {
+	*p = ListPhysicalNetworksParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPhysicalNetworksParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPhysicalNetworksParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPhysicalNetworksParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPhysicalNetworksParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPhysicalNetworksParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPhysicalNetworksParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPhysicalNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksParams {
 	p := &ListPhysicalNetworksParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPhysicalNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5366,9 +7748,8 @@ func (s *NetworkService) GetPhysicalNetworkByName(name string, opts ...OptionFun
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
 	p := &ListPhysicalNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5396,9 +7777,9 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 	return nil, l.Count, fmt.Errorf("There is more then one result for PhysicalNetwork UUID: %s!", id)
 }
 
-// Lists physical networks
-func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPhysicalNetworks", p.toURLValues())
+// Lists physical networks.
+func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams, opts ...CallOption) (*ListPhysicalNetworksResponse, error) {
+	resp, err := s.cs.newRequest("listPhysicalNetworks", s.cs.encodeParams("listPhysicalNetworks", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5433,177 +7814,289 @@ type PhysicalNetwork struct {
 }
 
 type ListStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	podid    optString
+	zoneid   optString
 }
 
-func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListStorageNetworkIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new ListStorageNetworkIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListStorageNetworkIpRangeParams(u url.Values) (*ListStorageNetworkIpRangeParams, error) {
+	p := &ListStorageNetworkIpRangeParams{}
+	if err := checkParamNames("listStorageNetworkIpRange", u, "id", "keyword", "page", "pagesize", "podid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["id"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListStorageNetworkIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListStorageNetworkIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListStorageNetworkIpRangeParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListStorageNetworkIpRangeParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListStorageNetworkIpRangeParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListStorageNetworkIpRangeParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListStorageNetworkIpRangeParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListStorageNetworkIpRangeParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListStorageNetworkIpRangeParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListStorageNetworkIpRangeParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListStorageNetworkIpRangeParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListStorageNetworkIpRangeParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListStorageNetworkIpRangeParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListStorageNetworkIpRangeParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListStorageNetworkIpRangeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListStorageNetworkIpRangeParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListStorageNetworkIpRangeParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListStorageNetworkIpRangeParams) Clone() *ListStorageNetworkIpRangeParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new ListStorageNetworkIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams {
-	p := &ListStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListStorageNetworkIpRangeParams) Equal(o *ListStorageNetworkIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListStorageNetworkIpRangeParams is used to (un)marshal ListStorageNetworkIpRangeParams using the API param names
+type serializedListStorageNetworkIpRangeParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid    *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListStorageNetworkIpRangeParams) toSerialized() *serializedListStorageNetworkIpRangeParams {
+	s := &serializedListStorageNetworkIpRangeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListStorageNetworkIpRangeParams) fromSerialized(s *serializedListStorageNetworkIpRangeParams) {
+	*p = ListStorageNetworkIpRangeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedListStorageNetworkIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListStorageNetworkIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListStorageNetworkIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListStorageNetworkIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListStorageNetworkIpRangeParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams {
+	p := &ListStorageNetworkIpRangeParams{}
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
 	p := &ListStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5632,8 +8125,8 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 }
 
 // List a storage network IP range.
-func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("listStorageNetworkIpRange", p.toURLValues())
+func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams, opts ...CallOption) (*ListStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("listStorageNetworkIpRange", s.cs.encodeParams("listStorageNetworkIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5666,150 +8159,257 @@ type StorageNetworkIpRange struct {
 }
 
 type ListSupportedNetworkServicesParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	provider optString
+	service  optString
 }
 
-func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSupportedNetworkServicesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["service"]; found {
-		u.Set("service", v.(string))
+	if p.service.ok {
+		u.Set("service", p.service.v)
 	}
 	return u
 }
 
-func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSupportedNetworkServicesParams parses url.Values, for example taken from a raw API request,
+// into a new ListSupportedNetworkServicesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSupportedNetworkServicesParams(u url.Values) (*ListSupportedNetworkServicesParams, error) {
+	p := &ListSupportedNetworkServicesParams{}
+	if err := checkParamNames("listSupportedNetworkServices", u, "keyword", "page", "pagesize", "provider", "service"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["service"]; found {
+		p.SetService(u.Get("service"))
 	}
-	p.p["keyword"] = v
+	return p, nil
 }
 
+// SetKeyword sets the keyword param.
+func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
 func (p *ListSupportedNetworkServicesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSupportedNetworkServicesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSupportedNetworkServicesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSupportedNetworkServicesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSupportedNetworkServicesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSupportedNetworkServicesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSupportedNetworkServicesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSupportedNetworkServicesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProvider sets the provider param.
 func (p *ListSupportedNetworkServicesParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *ListSupportedNetworkServicesParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *ListSupportedNetworkServicesParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetService sets the service param.
 func (p *ListSupportedNetworkServicesParams) SetService(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["service"] = v
+	p.service = optString{v: v, ok: true}
 }
 
+// ResetService unsets the service param
 func (p *ListSupportedNetworkServicesParams) ResetService() {
-	if p.p != nil && p.p["service"] != nil {
-		delete(p.p, "service")
-	}
+	p.service = optString{}
 }
 
+// GetService returns the service param and if it is set
 func (p *ListSupportedNetworkServicesParams) GetService() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.service.v, p.service.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSupportedNetworkServicesParams) Clone() *ListSupportedNetworkServicesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSupportedNetworkServicesParams) Equal(o *ListSupportedNetworkServicesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.provider == o.provider &&
+		p.service == o.service
+}
+
+// serializedListSupportedNetworkServicesParams is used to (un)marshal ListSupportedNetworkServicesParams using the API param names
+type serializedListSupportedNetworkServicesParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Service  *string `json:"service,omitempty" yaml:"service,omitempty"`
+}
+
+func (p *ListSupportedNetworkServicesParams) toSerialized() *serializedListSupportedNetworkServicesParams {
+	s := &serializedListSupportedNetworkServicesParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.service.ok {
+		s.Service = &p.service.v
+	}
+	return s
+}
+
+func (p *ListSupportedNetworkServicesParams) fromSerialized(s *serializedListSupportedNetworkServicesParams) {
+	*p = ListSupportedNetworkServicesParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Service != nil {
+		p.SetService(*s.Service)
 	}
-	value, ok := p.p["service"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSupportedNetworkServicesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSupportedNetworkServicesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSupportedNetworkServicesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSupportedNetworkServicesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSupportedNetworkServicesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSupportedNetworkServicesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSupportedNetworkServicesParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams {
 	p := &ListSupportedNetworkServicesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists all network services provided by CloudStack or for the given Provider.
-func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
-	resp, err := s.cs.newRequest("listSupportedNetworkServices", p.toURLValues())
+func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams, opts ...CallOption) (*ListSupportedNetworkServicesResponse, error) {
+	resp, err := s.cs.newRequest("listSupportedNetworkServices", s.cs.encodeParams("listSupportedNetworkServices", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5852,53 +8452,132 @@ type SupportedNetworkServiceCapability struct {
 }
 
 type ReleasePublicIpRangeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleasePublicIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ReleasePublicIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleasePublicIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new ReleasePublicIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleasePublicIpRangeParams(u url.Values) (*ReleasePublicIpRangeParams, error) {
+	p := &ReleasePublicIpRangeParams{}
+	if err := checkParamNames("releasePublicIpRange", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *ReleasePublicIpRangeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ReleasePublicIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ReleasePublicIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleasePublicIpRangeParams) Clone() *ReleasePublicIpRangeParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleasePublicIpRangeParams) Equal(o *ReleasePublicIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedReleasePublicIpRangeParams is used to (un)marshal ReleasePublicIpRangeParams using the API param names
+type serializedReleasePublicIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ReleasePublicIpRangeParams) toSerialized() *serializedReleasePublicIpRangeParams {
+	s := &serializedReleasePublicIpRangeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ReleasePublicIpRangeParams) fromSerialized(s *serializedReleasePublicIpRangeParams) {
+	*p = ReleasePublicIpRangeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleasePublicIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleasePublicIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleasePublicIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleasePublicIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleasePublicIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleasePublicIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleasePublicIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams {
 	p := &ReleasePublicIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Releases a Public IP range back to the system pool
-func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("releasePublicIpRange", p.toURLValues())
+// Releases a Public IP range back to the system pool.
+//
+// Required params: id.
+func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams, opts ...CallOption) (*ReleasePublicIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("releasePublicIpRange", s.cs.encodeParams("releasePublicIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5946,128 +8625,236 @@ func (r *ReleasePublicIpRangeResponse) UnmarshalJSON(b []byte) error {
 }
 
 type RestartNetworkParams struct {
-	p map[string]interface{}
+	cleanup       optBool
+	id            optString
+	livepatch     optBool
+	makeredundant optBool
 }
 
-func (p *RestartNetworkParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RestartNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanup", vv)
+	if p.cleanup.ok {
+		u.Set("cleanup", strconv.FormatBool(p.cleanup.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["livepatch"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("livepatch", vv)
+	if p.livepatch.ok {
+		u.Set("livepatch", strconv.FormatBool(p.livepatch.v))
 	}
-	if v, found := p.p["makeredundant"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("makeredundant", vv)
+	if p.makeredundant.ok {
+		u.Set("makeredundant", strconv.FormatBool(p.makeredundant.v))
 	}
 	return u
 }
 
-func (p *RestartNetworkParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRestartNetworkParams parses url.Values, for example taken from a raw API request,
+// into a new RestartNetworkParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRestartNetworkParams(u url.Values) (*RestartNetworkParams, error) {
+	p := &RestartNetworkParams{}
+	if err := checkParamNames("restartNetwork", u, "cleanup", "id", "livepatch", "makeredundant"); err != nil {
+		return nil, err
 	}
-	p.p["cleanup"] = v
+	if _, found := u["cleanup"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanup: %v", err)
+		}
+		p.SetCleanup(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["livepatch"]; found {
+		v, err := strconv.ParseBool(u.Get("livepatch"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param livepatch: %v", err)
+		}
+		p.SetLivepatch(v)
+	}
+	if _, found := u["makeredundant"]; found {
+		v, err := strconv.ParseBool(u.Get("makeredundant"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param makeredundant: %v", err)
+		}
+		p.SetMakeredundant(v)
+	}
+	return p, nil
 }
 
+// SetCleanup sets the cleanup param.
+func (p *RestartNetworkParams) SetCleanup(v bool) {
+	p.cleanup = optBool{v: v, ok: true}
+}
+
+// ResetCleanup unsets the cleanup param
 func (p *RestartNetworkParams) ResetCleanup() {
-	if p.p != nil && p.p["cleanup"] != nil {
-		delete(p.p, "cleanup")
-	}
+	p.cleanup = optBool{}
 }
 
+// GetCleanup returns the cleanup param and if it is set
 func (p *RestartNetworkParams) GetCleanup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanup"].(bool)
-	return value, ok
+	return p.cleanup.v, p.cleanup.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *RestartNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RestartNetworkParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RestartNetworkParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetLivepatch sets the livepatch param.
 func (p *RestartNetworkParams) SetLivepatch(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["livepatch"] = v
+	p.livepatch = optBool{v: v, ok: true}
 }
 
+// ResetLivepatch unsets the livepatch param
 func (p *RestartNetworkParams) ResetLivepatch() {
-	if p.p != nil && p.p["livepatch"] != nil {
-		delete(p.p, "livepatch")
-	}
+	p.livepatch = optBool{}
 }
 
+// GetLivepatch returns the livepatch param and if it is set
 func (p *RestartNetworkParams) GetLivepatch() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["livepatch"].(bool)
-	return value, ok
+	return p.livepatch.v, p.livepatch.ok
 }
 
+// SetMakeredundant sets the makeredundant param.
 func (p *RestartNetworkParams) SetMakeredundant(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["makeredundant"] = v
+	p.makeredundant = optBool{v: v, ok: true}
 }
 
+// ResetMakeredundant unsets the makeredundant param
 func (p *RestartNetworkParams) ResetMakeredundant() {
-	if p.p != nil && p.p["makeredundant"] != nil {
-		delete(p.p, "makeredundant")
-	}
+	p.makeredundant = optBool{}
 }
 
+// GetMakeredundant returns the makeredundant param and if it is set
 func (p *RestartNetworkParams) GetMakeredundant() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.makeredundant.v, p.makeredundant.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RestartNetworkParams) Clone() *RestartNetworkParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RestartNetworkParams) Equal(o *RestartNetworkParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cleanup == o.cleanup &&
+		p.id == o.id &&
+		p.livepatch == o.livepatch &&
+		p.makeredundant == o.makeredundant
+}
+
+// serializedRestartNetworkParams is used to (un)marshal RestartNetworkParams using the API param names
+type serializedRestartNetworkParams struct {
+	Cleanup       *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Livepatch     *bool   `json:"livepatch,omitempty" yaml:"livepatch,omitempty"`
+	Makeredundant *bool   `json:"makeredundant,omitempty" yaml:"makeredundant,omitempty"`
+}
+
+func (p *RestartNetworkParams) toSerialized() *serializedRestartNetworkParams {
+	s := &serializedRestartNetworkParams{}
+	if p.cleanup.ok {
+		s.Cleanup = &p.cleanup.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.livepatch.ok {
+		s.Livepatch = &p.livepatch.v
+	}
+	if p.makeredundant.ok {
+		s.Makeredundant = &p.makeredundant.v
 	}
-	value, ok := p.p["makeredundant"].(bool)
-	return value, ok
+	return s
+}
+
+func (p *RestartNetworkParams) fromSerialized(s *serializedRestartNetworkParams) {
+	*p = RestartNetworkParams{}
+	if s.Cleanup != nil {
+		p.SetCleanup(*s.Cleanup)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Livepatch != nil {
+		p.SetLivepatch(*s.Livepatch)
+	}
+	if s.Makeredundant != nil {
+		p.SetMakeredundant(*s.Makeredundant)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RestartNetworkParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RestartNetworkParams) UnmarshalJSON(b []byte) error {
+	var s serializedRestartNetworkParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RestartNetworkParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RestartNetworkParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRestartNetworkParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RestartNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParams {
 	p := &RestartNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Restarts the network; includes 1) restarting network elements - virtual routers, DHCP servers 2) reapplying all public IPs 3) reapplying loadBalancing/portForwarding rules
-func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
-	resp, err := s.cs.newRequest("restartNetwork", p.toURLValues())
+// Restarts the network; includes 1) restarting network elements - virtual routers, DHCP servers 2)
+// reapplying all public IPs 3) reapplying loadBalancing/portForwarding rules.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) RestartNetwork(p *RestartNetworkParams, opts ...CallOption) (*RestartNetworkResponse, error) {
+	resp, err := s.cs.newRequest("restartNetwork", s.cs.encodeParams("restartNetwork", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6078,8 +8865,8 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6103,492 +8890,701 @@ type RestartNetworkResponse struct {
 }
 
 type UpdateNetworkParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateNetworkParams) toURLValues() url.Values {
+	changecidr         optBool
+	customid           optString
+	displaynetwork     optBool
+	displaytext        optString
+	dns1               optString
+	dns2               optString
+	forced             optBool
+	guestvmcidr        optString
+	hideipaddressusage optBool
+	id                 optString
+	ip6dns1            optString
+	ip6dns2            optString
+	name               optString
+	networkdomain      optString
+	networkofferingid  optString
+	privatemtu         optInt
+	publicmtu          optInt
+	sourcenatipaddress optString
+	updateinsequence   optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["changecidr"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("changecidr", vv)
+	if p.changecidr.ok {
+		u.Set("changecidr", strconv.FormatBool(p.changecidr.v))
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["displaynetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displaynetwork", vv)
+	if p.displaynetwork.ok {
+		u.Set("displaynetwork", strconv.FormatBool(p.displaynetwork.v))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["dns1"]; found {
-		u.Set("dns1", v.(string))
+	if p.dns1.ok {
+		u.Set("dns1", p.dns1.v)
 	}
-	if v, found := p.p["dns2"]; found {
-		u.Set("dns2", v.(string))
+	if p.dns2.ok {
+		u.Set("dns2", p.dns2.v)
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["guestvmcidr"]; found {
-		u.Set("guestvmcidr", v.(string))
+	if p.guestvmcidr.ok {
+		u.Set("guestvmcidr", p.guestvmcidr.v)
 	}
-	if v, found := p.p["hideipaddressusage"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("hideipaddressusage", vv)
+	if p.hideipaddressusage.ok {
+		u.Set("hideipaddressusage", strconv.FormatBool(p.hideipaddressusage.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ip6dns1"]; found {
-		u.Set("ip6dns1", v.(string))
+	if p.ip6dns1.ok {
+		u.Set("ip6dns1", p.ip6dns1.v)
 	}
-	if v, found := p.p["ip6dns2"]; found {
-		u.Set("ip6dns2", v.(string))
+	if p.ip6dns2.ok {
+		u.Set("ip6dns2", p.ip6dns2.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
-	if v, found := p.p["networkofferingid"]; found {
-		u.Set("networkofferingid", v.(string))
+	if p.networkofferingid.ok {
+		u.Set("networkofferingid", p.networkofferingid.v)
 	}
-	if v, found := p.p["privatemtu"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privatemtu", vv)
+	if p.privatemtu.ok {
+		u.Set("privatemtu", strconv.Itoa(p.privatemtu.v))
 	}
-	if v, found := p.p["publicmtu"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicmtu", vv)
+	if p.publicmtu.ok {
+		u.Set("publicmtu", strconv.Itoa(p.publicmtu.v))
 	}
-	if v, found := p.p["sourcenatipaddress"]; found {
-		u.Set("sourcenatipaddress", v.(string))
+	if p.sourcenatipaddress.ok {
+		u.Set("sourcenatipaddress", p.sourcenatipaddress.v)
 	}
-	if v, found := p.p["updateinsequence"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("updateinsequence", vv)
+	if p.updateinsequence.ok {
+		u.Set("updateinsequence", strconv.FormatBool(p.updateinsequence.v))
 	}
 	return u
 }
 
-func (p *UpdateNetworkParams) SetChangecidr(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateNetworkParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateNetworkParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateNetworkParams(u url.Values) (*UpdateNetworkParams, error) {
+	p := &UpdateNetworkParams{}
+	if err := checkParamNames("updateNetwork", u, "changecidr", "customid", "displaynetwork", "displaytext", "dns1", "dns2", "forced", "guestvmcidr", "hideipaddressusage", "id", "ip6dns1", "ip6dns2", "name", "networkdomain", "networkofferingid", "privatemtu", "publicmtu", "sourcenatipaddress", "updateinsequence"); err != nil {
+		return nil, err
+	}
+	if _, found := u["changecidr"]; found {
+		v, err := strconv.ParseBool(u.Get("changecidr"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param changecidr: %v", err)
+		}
+		p.SetChangecidr(v)
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["displaynetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("displaynetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displaynetwork: %v", err)
+		}
+		p.SetDisplaynetwork(v)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["dns1"]; found {
+		p.SetDns1(u.Get("dns1"))
+	}
+	if _, found := u["dns2"]; found {
+		p.SetDns2(u.Get("dns2"))
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["guestvmcidr"]; found {
+		p.SetGuestvmcidr(u.Get("guestvmcidr"))
+	}
+	if _, found := u["hideipaddressusage"]; found {
+		v, err := strconv.ParseBool(u.Get("hideipaddressusage"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param hideipaddressusage: %v", err)
+		}
+		p.SetHideipaddressusage(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ip6dns1"]; found {
+		p.SetIp6dns1(u.Get("ip6dns1"))
 	}
-	p.p["changecidr"] = v
+	if _, found := u["ip6dns2"]; found {
+		p.SetIp6dns2(u.Get("ip6dns2"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
+	}
+	if _, found := u["networkofferingid"]; found {
+		p.SetNetworkofferingid(u.Get("networkofferingid"))
+	}
+	if _, found := u["privatemtu"]; found {
+		v, err := strconv.Atoi(u.Get("privatemtu"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param privatemtu: %v", err)
+		}
+		p.SetPrivatemtu(v)
+	}
+	if _, found := u["publicmtu"]; found {
+		v, err := strconv.Atoi(u.Get("publicmtu"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param publicmtu: %v", err)
+		}
+		p.SetPublicmtu(v)
+	}
+	if _, found := u["sourcenatipaddress"]; found {
+		p.SetSourcenatipaddress(u.Get("sourcenatipaddress"))
+	}
+	if _, found := u["updateinsequence"]; found {
+		v, err := strconv.ParseBool(u.Get("updateinsequence"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param updateinsequence: %v", err)
+		}
+		p.SetUpdateinsequence(v)
+	}
+	return p, nil
+}
+
+// SetChangecidr sets the changecidr param.
+func (p *UpdateNetworkParams) SetChangecidr(v bool) {
+	p.changecidr = optBool{v: v, ok: true}
 }
 
+// ResetChangecidr unsets the changecidr param
 func (p *UpdateNetworkParams) ResetChangecidr() {
-	if p.p != nil && p.p["changecidr"] != nil {
-		delete(p.p, "changecidr")
-	}
+	p.changecidr = optBool{}
 }
 
+// GetChangecidr returns the changecidr param and if it is set
 func (p *UpdateNetworkParams) GetChangecidr() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["changecidr"].(bool)
-	return value, ok
+	return p.changecidr.v, p.changecidr.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *UpdateNetworkParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateNetworkParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateNetworkParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDisplaynetwork sets the displaynetwork param.
 func (p *UpdateNetworkParams) SetDisplaynetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaynetwork"] = v
+	p.displaynetwork = optBool{v: v, ok: true}
 }
 
+// ResetDisplaynetwork unsets the displaynetwork param
 func (p *UpdateNetworkParams) ResetDisplaynetwork() {
-	if p.p != nil && p.p["displaynetwork"] != nil {
-		delete(p.p, "displaynetwork")
-	}
+	p.displaynetwork = optBool{}
 }
 
+// GetDisplaynetwork returns the displaynetwork param and if it is set
 func (p *UpdateNetworkParams) GetDisplaynetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaynetwork"].(bool)
-	return value, ok
+	return p.displaynetwork.v, p.displaynetwork.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *UpdateNetworkParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateNetworkParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateNetworkParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDns1 sets the dns1 param.
 func (p *UpdateNetworkParams) SetDns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns1"] = v
+	p.dns1 = optString{v: v, ok: true}
 }
 
+// ResetDns1 unsets the dns1 param
 func (p *UpdateNetworkParams) ResetDns1() {
-	if p.p != nil && p.p["dns1"] != nil {
-		delete(p.p, "dns1")
-	}
+	p.dns1 = optString{}
 }
 
+// GetDns1 returns the dns1 param and if it is set
 func (p *UpdateNetworkParams) GetDns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns1"].(string)
-	return value, ok
+	return p.dns1.v, p.dns1.ok
 }
 
+// SetDns2 sets the dns2 param.
 func (p *UpdateNetworkParams) SetDns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns2"] = v
+	p.dns2 = optString{v: v, ok: true}
 }
 
+// ResetDns2 unsets the dns2 param
 func (p *UpdateNetworkParams) ResetDns2() {
-	if p.p != nil && p.p["dns2"] != nil {
-		delete(p.p, "dns2")
-	}
+	p.dns2 = optString{}
 }
 
+// GetDns2 returns the dns2 param and if it is set
 func (p *UpdateNetworkParams) GetDns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns2"].(string)
-	return value, ok
+	return p.dns2.v, p.dns2.ok
 }
 
+// SetForced sets the forced param.
 func (p *UpdateNetworkParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *UpdateNetworkParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *UpdateNetworkParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetGuestvmcidr sets the guestvmcidr param.
 func (p *UpdateNetworkParams) SetGuestvmcidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestvmcidr"] = v
+	p.guestvmcidr = optString{v: v, ok: true}
 }
 
+// ResetGuestvmcidr unsets the guestvmcidr param
 func (p *UpdateNetworkParams) ResetGuestvmcidr() {
-	if p.p != nil && p.p["guestvmcidr"] != nil {
-		delete(p.p, "guestvmcidr")
-	}
+	p.guestvmcidr = optString{}
 }
 
+// GetGuestvmcidr returns the guestvmcidr param and if it is set
 func (p *UpdateNetworkParams) GetGuestvmcidr() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestvmcidr"].(string)
-	return value, ok
+	return p.guestvmcidr.v, p.guestvmcidr.ok
 }
 
+// SetHideipaddressusage sets the hideipaddressusage param.
 func (p *UpdateNetworkParams) SetHideipaddressusage(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hideipaddressusage"] = v
+	p.hideipaddressusage = optBool{v: v, ok: true}
 }
 
+// ResetHideipaddressusage unsets the hideipaddressusage param
 func (p *UpdateNetworkParams) ResetHideipaddressusage() {
-	if p.p != nil && p.p["hideipaddressusage"] != nil {
-		delete(p.p, "hideipaddressusage")
-	}
+	p.hideipaddressusage = optBool{}
 }
 
+// GetHideipaddressusage returns the hideipaddressusage param and if it is set
 func (p *UpdateNetworkParams) GetHideipaddressusage() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hideipaddressusage"].(bool)
-	return value, ok
+	return p.hideipaddressusage.v, p.hideipaddressusage.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateNetworkParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateNetworkParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIp6dns1 sets the ip6dns1 param.
 func (p *UpdateNetworkParams) SetIp6dns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns1"] = v
+	p.ip6dns1 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns1 unsets the ip6dns1 param
 func (p *UpdateNetworkParams) ResetIp6dns1() {
-	if p.p != nil && p.p["ip6dns1"] != nil {
-		delete(p.p, "ip6dns1")
-	}
+	p.ip6dns1 = optString{}
 }
 
+// GetIp6dns1 returns the ip6dns1 param and if it is set
 func (p *UpdateNetworkParams) GetIp6dns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns1"].(string)
-	return value, ok
+	return p.ip6dns1.v, p.ip6dns1.ok
 }
 
+// SetIp6dns2 sets the ip6dns2 param.
 func (p *UpdateNetworkParams) SetIp6dns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns2"] = v
+	p.ip6dns2 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns2 unsets the ip6dns2 param
 func (p *UpdateNetworkParams) ResetIp6dns2() {
-	if p.p != nil && p.p["ip6dns2"] != nil {
-		delete(p.p, "ip6dns2")
-	}
+	p.ip6dns2 = optString{}
 }
 
+// GetIp6dns2 returns the ip6dns2 param and if it is set
 func (p *UpdateNetworkParams) GetIp6dns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns2"].(string)
-	return value, ok
+	return p.ip6dns2.v, p.ip6dns2.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateNetworkParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateNetworkParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateNetworkParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *UpdateNetworkParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *UpdateNetworkParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *UpdateNetworkParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+	return p.networkdomain.v, p.networkdomain.ok
 }
 
+// SetNetworkofferingid sets the networkofferingid param.
 func (p *UpdateNetworkParams) SetNetworkofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkofferingid"] = v
+	p.networkofferingid = optString{v: v, ok: true}
 }
 
+// ResetNetworkofferingid unsets the networkofferingid param
 func (p *UpdateNetworkParams) ResetNetworkofferingid() {
-	if p.p != nil && p.p["networkofferingid"] != nil {
-		delete(p.p, "networkofferingid")
-	}
+	p.networkofferingid = optString{}
 }
 
+// GetNetworkofferingid returns the networkofferingid param and if it is set
 func (p *UpdateNetworkParams) GetNetworkofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkofferingid"].(string)
-	return value, ok
+	return p.networkofferingid.v, p.networkofferingid.ok
 }
 
+// SetPrivatemtu sets the privatemtu param.
 func (p *UpdateNetworkParams) SetPrivatemtu(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privatemtu"] = v
+	p.privatemtu = optInt{v: v, ok: true}
 }
 
+// ResetPrivatemtu unsets the privatemtu param
 func (p *UpdateNetworkParams) ResetPrivatemtu() {
-	if p.p != nil && p.p["privatemtu"] != nil {
-		delete(p.p, "privatemtu")
-	}
+	p.privatemtu = optInt{}
 }
 
+// GetPrivatemtu returns the privatemtu param and if it is set
 func (p *UpdateNetworkParams) GetPrivatemtu() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.privatemtu.v, p.privatemtu.ok
+}
+
+// SetPublicmtu sets the publicmtu param.
+func (p *UpdateNetworkParams) SetPublicmtu(v int) {
+	p.publicmtu = optInt{v: v, ok: true}
+}
+
+// ResetPublicmtu unsets the publicmtu param
+func (p *UpdateNetworkParams) ResetPublicmtu() {
+	p.publicmtu = optInt{}
+}
+
+// GetPublicmtu returns the publicmtu param and if it is set
+func (p *UpdateNetworkParams) GetPublicmtu() (int, bool) {
+	return p.publicmtu.v, p.publicmtu.ok
+}
+
+// SetSourcenatipaddress sets the sourcenatipaddress param.
+func (p *UpdateNetworkParams) SetSourcenatipaddress(v string) {
+	p.sourcenatipaddress = optString{v: v, ok: true}
+}
+
+// ResetSourcenatipaddress unsets the sourcenatipaddress param
+func (p *UpdateNetworkParams) ResetSourcenatipaddress() {
+	p.sourcenatipaddress = optString{}
+}
+
+// GetSourcenatipaddress returns the sourcenatipaddress param and if it is set
+func (p *UpdateNetworkParams) GetSourcenatipaddress() (string, bool) {
+	return p.sourcenatipaddress.v, p.sourcenatipaddress.ok
+}
+
+// SetUpdateinsequence sets the updateinsequence param.
+func (p *UpdateNetworkParams) SetUpdateinsequence(v bool) {
+	p.updateinsequence = optBool{v: v, ok: true}
+}
+
+// ResetUpdateinsequence unsets the updateinsequence param
+func (p *UpdateNetworkParams) ResetUpdateinsequence() {
+	p.updateinsequence = optBool{}
+}
+
+// GetUpdateinsequence returns the updateinsequence param and if it is set
+func (p *UpdateNetworkParams) GetUpdateinsequence() (bool, bool) {
+	return p.updateinsequence.v, p.updateinsequence.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateNetworkParams) Clone() *UpdateNetworkParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateNetworkParams) Equal(o *UpdateNetworkParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.changecidr == o.changecidr &&
+		p.customid == o.customid &&
+		p.displaynetwork == o.displaynetwork &&
+		p.displaytext == o.displaytext &&
+		p.dns1 == o.dns1 &&
+		p.dns2 == o.dns2 &&
+		p.forced == o.forced &&
+		p.guestvmcidr == o.guestvmcidr &&
+		p.hideipaddressusage == o.hideipaddressusage &&
+		p.id == o.id &&
+		p.ip6dns1 == o.ip6dns1 &&
+		p.ip6dns2 == o.ip6dns2 &&
+		p.name == o.name &&
+		p.networkdomain == o.networkdomain &&
+		p.networkofferingid == o.networkofferingid &&
+		p.privatemtu == o.privatemtu &&
+		p.publicmtu == o.publicmtu &&
+		p.sourcenatipaddress == o.sourcenatipaddress &&
+		p.updateinsequence == o.updateinsequence
+}
+
+// serializedUpdateNetworkParams is used to (un)marshal UpdateNetworkParams using the API param names
+type serializedUpdateNetworkParams struct {
+	Changecidr         *bool   `json:"changecidr,omitempty" yaml:"changecidr,omitempty"`
+	Customid           *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Displaynetwork     *bool   `json:"displaynetwork,omitempty" yaml:"displaynetwork,omitempty"`
+	Displaytext        *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Dns1               *string `json:"dns1,omitempty" yaml:"dns1,omitempty"`
+	Dns2               *string `json:"dns2,omitempty" yaml:"dns2,omitempty"`
+	Forced             *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Guestvmcidr        *string `json:"guestvmcidr,omitempty" yaml:"guestvmcidr,omitempty"`
+	Hideipaddressusage *bool   `json:"hideipaddressusage,omitempty" yaml:"hideipaddressusage,omitempty"`
+	Id                 *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ip6dns1            *string `json:"ip6dns1,omitempty" yaml:"ip6dns1,omitempty"`
+	Ip6dns2            *string `json:"ip6dns2,omitempty" yaml:"ip6dns2,omitempty"`
+	Name               *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain      *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Networkofferingid  *string `json:"networkofferingid,omitempty" yaml:"networkofferingid,omitempty"`
+	Privatemtu         *int    `json:"privatemtu,omitempty" yaml:"privatemtu,omitempty"`
+	Publicmtu          *int    `json:"publicmtu,omitempty" yaml:"publicmtu,omitempty"`
+	Sourcenatipaddress *string `json:"sourcenatipaddress,omitempty" yaml:"sourcenatipaddress,omitempty"`
+	Updateinsequence   *bool   `json:"updateinsequence,omitempty" yaml:"updateinsequence,omitempty"`
+}
+
+func (p *UpdateNetworkParams) toSerialized() *serializedUpdateNetworkParams {
+	s := &serializedUpdateNetworkParams{}
+	if p.changecidr.ok {
+		s.Changecidr = &p.changecidr.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.displaynetwork.ok {
+		s.Displaynetwork = &p.displaynetwork.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.dns1.ok {
+		s.Dns1 = &p.dns1.v
+	}
+	if p.dns2.ok {
+		s.Dns2 = &p.dns2.v
+	}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.guestvmcidr.ok {
+		s.Guestvmcidr = &p.guestvmcidr.v
+	}
+	if p.hideipaddressusage.ok {
+		s.Hideipaddressusage = &p.hideipaddressusage.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ip6dns1.ok {
+		s.Ip6dns1 = &p.ip6dns1.v
+	}
+	if p.ip6dns2.ok {
+		s.Ip6dns2 = &p.ip6dns2.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	if p.networkofferingid.ok {
+		s.Networkofferingid = &p.networkofferingid.v
+	}
+	if p.privatemtu.ok {
+		s.Privatemtu = &p.privatemtu.v
+	}
+	if p.publicmtu.ok {
+		s.Publicmtu = &p.publicmtu.v
+	}
+	if p.sourcenatipaddress.ok {
+		s.Sourcenatipaddress = &p.sourcenatipaddress.v
+	}
+	if p.updateinsequence.ok {
+		s.Updateinsequence = &p.updateinsequence.v
+	}
+	return s
+}
+
+func (p *UpdateNetworkParams) fromSerialized(s *serializedUpdateNetworkParams) {
+	*p = UpdateNetworkParams{}
+	if s.Changecidr != nil {
+		p.SetChangecidr(*s.Changecidr)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Displaynetwork != nil {
+		p.SetDisplaynetwork(*s.Displaynetwork)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Dns1 != nil {
+		p.SetDns1(*s.Dns1)
+	}
+	if s.Dns2 != nil {
+		p.SetDns2(*s.Dns2)
+	}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Guestvmcidr != nil {
+		p.SetGuestvmcidr(*s.Guestvmcidr)
+	}
+	if s.Hideipaddressusage != nil {
+		p.SetHideipaddressusage(*s.Hideipaddressusage)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ip6dns1 != nil {
+		p.SetIp6dns1(*s.Ip6dns1)
+	}
+	if s.Ip6dns2 != nil {
+		p.SetIp6dns2(*s.Ip6dns2)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
 	}
-	value, ok := p.p["privatemtu"].(int)
-	return value, ok
-}
-
-func (p *UpdateNetworkParams) SetPublicmtu(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
 	}
-	p.p["publicmtu"] = v
-}
-
-func (p *UpdateNetworkParams) ResetPublicmtu() {
-	if p.p != nil && p.p["publicmtu"] != nil {
-		delete(p.p, "publicmtu")
+	if s.Networkofferingid != nil {
+		p.SetNetworkofferingid(*s.Networkofferingid)
 	}
-}
-
-func (p *UpdateNetworkParams) GetPublicmtu() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Privatemtu != nil {
+		p.SetPrivatemtu(*s.Privatemtu)
 	}
-	value, ok := p.p["publicmtu"].(int)
-	return value, ok
-}
-
-func (p *UpdateNetworkParams) SetSourcenatipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Publicmtu != nil {
+		p.SetPublicmtu(*s.Publicmtu)
 	}
-	p.p["sourcenatipaddress"] = v
-}
-
-func (p *UpdateNetworkParams) ResetSourcenatipaddress() {
-	if p.p != nil && p.p["sourcenatipaddress"] != nil {
-		delete(p.p, "sourcenatipaddress")
+	if s.Sourcenatipaddress != nil {
+		p.SetSourcenatipaddress(*s.Sourcenatipaddress)
+	}
+	if s.Updateinsequence != nil {
+		p.SetUpdateinsequence(*s.Updateinsequence)
 	}
 }
 
-func (p *UpdateNetworkParams) GetSourcenatipaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourcenatipaddress"].(string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateNetworkParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *UpdateNetworkParams) SetUpdateinsequence(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateNetworkParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateNetworkParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["updateinsequence"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *UpdateNetworkParams) ResetUpdateinsequence() {
-	if p.p != nil && p.p["updateinsequence"] != nil {
-		delete(p.p, "updateinsequence")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateNetworkParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *UpdateNetworkParams) GetUpdateinsequence() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateNetworkParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateNetworkParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["updateinsequence"].(bool)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams {
 	p := &UpdateNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a network
-func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updateNetwork", p.toURLValues())
+// Updates a network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams, opts ...CallOption) (*UpdateNetworkResponse, error) {
+	resp, err := s.cs.newRequest("updateNetwork", s.cs.encodeParams("updateNetwork", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6599,8 +9595,8 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6720,102 +9716,194 @@ type UpdateNetworkResponseServiceCapability struct {
 }
 
 type UpdateNetworkServiceProviderParams struct {
-	p map[string]interface{}
+	id          optString
+	servicelist optStrings
+	state       optString
 }
 
-func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateNetworkServiceProviderParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["servicelist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("servicelist", vv)
+	if p.servicelist.ok {
+		u.Set("servicelist", strings.Join(p.servicelist.v, ","))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
 	return u
 }
 
-func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateNetworkServiceProviderParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateNetworkServiceProviderParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateNetworkServiceProviderParams(u url.Values) (*UpdateNetworkServiceProviderParams, error) {
+	p := &UpdateNetworkServiceProviderParams{}
+	if err := checkParamNames("updateNetworkServiceProvider", u, "id", "servicelist", "state"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["servicelist"]; found {
+		p.SetServicelist(strings.Split(u.Get("servicelist"), ","))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateNetworkServiceProviderParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateNetworkServiceProviderParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetServicelist sets the servicelist param.
 func (p *UpdateNetworkServiceProviderParams) SetServicelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["servicelist"] = v
+	p.servicelist = optStrings{v: v, ok: true}
 }
 
+// ResetServicelist unsets the servicelist param
 func (p *UpdateNetworkServiceProviderParams) ResetServicelist() {
-	if p.p != nil && p.p["servicelist"] != nil {
-		delete(p.p, "servicelist")
-	}
+	p.servicelist = optStrings{}
 }
 
+// GetServicelist returns the servicelist param and if it is set
 func (p *UpdateNetworkServiceProviderParams) GetServicelist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["servicelist"].([]string)
-	return value, ok
+	return p.servicelist.v, p.servicelist.ok
 }
 
+// SetState sets the state param.
 func (p *UpdateNetworkServiceProviderParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *UpdateNetworkServiceProviderParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *UpdateNetworkServiceProviderParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.state.v, p.state.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateNetworkServiceProviderParams) Clone() *UpdateNetworkServiceProviderParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.servicelist = p.servicelist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateNetworkServiceProviderParams) Equal(o *UpdateNetworkServiceProviderParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.servicelist.equal(o.servicelist) &&
+		p.state == o.state
+}
+
+// serializedUpdateNetworkServiceProviderParams is used to (un)marshal UpdateNetworkServiceProviderParams using the API param names
+type serializedUpdateNetworkServiceProviderParams struct {
+	Id          *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Servicelist *[]string `json:"servicelist,omitempty" yaml:"servicelist,omitempty"`
+	State       *string   `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+func (p *UpdateNetworkServiceProviderParams) toSerialized() *serializedUpdateNetworkServiceProviderParams {
+	s := &serializedUpdateNetworkServiceProviderParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.servicelist.ok {
+		s.Servicelist = &p.servicelist.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	return s
+}
+
+func (p *UpdateNetworkServiceProviderParams) fromSerialized(s *serializedUpdateNetworkServiceProviderParams) {
+	*p = UpdateNetworkServiceProviderParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Servicelist != nil {
+		p.SetServicelist(*s.Servicelist)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateNetworkServiceProviderParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateNetworkServiceProviderParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateNetworkServiceProviderParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateNetworkServiceProviderParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams {
 	p := &UpdateNetworkServiceProviderParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a network serviceProvider of a physical network
-func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkServiceProvider", p.toURLValues())
+// Updates a network serviceProvider of a physical network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams, opts ...CallOption) (*UpdateNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequest("updateNetworkServiceProvider", s.cs.encodeParams("updateNetworkServiceProvider", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6826,8 +9914,8 @@ func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServicePro
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6861,150 +9949,254 @@ type UpdateNetworkServiceProviderResponse struct {
 }
 
 type UpdatePhysicalNetworkParams struct {
-	p map[string]interface{}
+	id           optString
+	networkspeed optString
+	state        optString
+	tags         optStrings
+	vlan         optString
 }
 
-func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdatePhysicalNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["networkspeed"]; found {
-		u.Set("networkspeed", v.(string))
+	if p.networkspeed.ok {
+		u.Set("networkspeed", p.networkspeed.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["tags"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("tags", vv)
+	if p.tags.ok {
+		u.Set("tags", strings.Join(p.tags.v, ","))
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
 	return u
 }
 
-func (p *UpdatePhysicalNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdatePhysicalNetworkParams parses url.Values, for example taken from a raw API request,
+// into a new UpdatePhysicalNetworkParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdatePhysicalNetworkParams(u url.Values) (*UpdatePhysicalNetworkParams, error) {
+	p := &UpdatePhysicalNetworkParams{}
+	if err := checkParamNames("updatePhysicalNetwork", u, "id", "networkspeed", "state", "tags", "vlan"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["networkspeed"]; found {
+		p.SetNetworkspeed(u.Get("networkspeed"))
 	}
-	p.p["id"] = v
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(strings.Split(u.Get("tags"), ","))
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpdatePhysicalNetworkParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdatePhysicalNetworkParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdatePhysicalNetworkParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetNetworkspeed sets the networkspeed param.
 func (p *UpdatePhysicalNetworkParams) SetNetworkspeed(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkspeed"] = v
+	p.networkspeed = optString{v: v, ok: true}
 }
 
+// ResetNetworkspeed unsets the networkspeed param
 func (p *UpdatePhysicalNetworkParams) ResetNetworkspeed() {
-	if p.p != nil && p.p["networkspeed"] != nil {
-		delete(p.p, "networkspeed")
-	}
+	p.networkspeed = optString{}
 }
 
+// GetNetworkspeed returns the networkspeed param and if it is set
 func (p *UpdatePhysicalNetworkParams) GetNetworkspeed() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkspeed"].(string)
-	return value, ok
+	return p.networkspeed.v, p.networkspeed.ok
 }
 
+// SetState sets the state param.
 func (p *UpdatePhysicalNetworkParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *UpdatePhysicalNetworkParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *UpdatePhysicalNetworkParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetTags sets the tags param.
 func (p *UpdatePhysicalNetworkParams) SetTags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStrings{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *UpdatePhysicalNetworkParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStrings{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *UpdatePhysicalNetworkParams) GetTags() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].([]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *UpdatePhysicalNetworkParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *UpdatePhysicalNetworkParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *UpdatePhysicalNetworkParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vlan.v, p.vlan.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdatePhysicalNetworkParams) Clone() *UpdatePhysicalNetworkParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdatePhysicalNetworkParams) Equal(o *UpdatePhysicalNetworkParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.networkspeed == o.networkspeed &&
+		p.state == o.state &&
+		p.tags.equal(o.tags) &&
+		p.vlan == o.vlan
+}
+
+// serializedUpdatePhysicalNetworkParams is used to (un)marshal UpdatePhysicalNetworkParams using the API param names
+type serializedUpdatePhysicalNetworkParams struct {
+	Id           *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Networkspeed *string   `json:"networkspeed,omitempty" yaml:"networkspeed,omitempty"`
+	State        *string   `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags         *[]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vlan         *string   `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+func (p *UpdatePhysicalNetworkParams) toSerialized() *serializedUpdatePhysicalNetworkParams {
+	s := &serializedUpdatePhysicalNetworkParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.networkspeed.ok {
+		s.Networkspeed = &p.networkspeed.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	return s
+}
+
+func (p *UpdatePhysicalNetworkParams) fromSerialized(s *serializedUpdatePhysicalNetworkParams) {
+	*p = UpdatePhysicalNetworkParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Networkspeed != nil {
+		p.SetNetworkspeed(*s.Networkspeed)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdatePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdatePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdatePhysicalNetworkParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdatePhysicalNetworkParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdatePhysicalNetworkParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdatePhysicalNetworkParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdatePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams {
 	p := &UpdatePhysicalNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a physical network
-func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updatePhysicalNetwork", p.toURLValues())
+// Updates a physical network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams, opts ...CallOption) (*UpdatePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequest("updatePhysicalNetwork", s.cs.encodeParams("updatePhysicalNetwork", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7015,8 +10207,8 @@ func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7054,150 +10246,257 @@ type UpdatePhysicalNetworkResponse struct {
 }
 
 type UpdateStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
+	endip   optString
+	id      optString
+	netmask optString
+	startip optString
+	vlan    optInt
 }
 
-func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("vlan", vv)
+	if p.vlan.ok {
+		u.Set("vlan", strconv.Itoa(p.vlan.v))
 	}
 	return u
 }
 
-func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateStorageNetworkIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateStorageNetworkIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateStorageNetworkIpRangeParams(u url.Values) (*UpdateStorageNetworkIpRangeParams, error) {
+	p := &UpdateStorageNetworkIpRangeParams{}
+	if err := checkParamNames("updateStorageNetworkIpRange", u, "endip", "id", "netmask", "startip", "vlan"); err != nil {
+		return nil, err
+	}
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
 	}
-	p.p["endip"] = v
+	if _, found := u["vlan"]; found {
+		v, err := strconv.Atoi(u.Get("vlan"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param vlan: %v", err)
+		}
+		p.SetVlan(v)
+	}
+	return p, nil
+}
+
+// SetEndip sets the endip param.
+func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
+	p.endip = optString{v: v, ok: true}
 }
 
+// ResetEndip unsets the endip param
 func (p *UpdateStorageNetworkIpRangeParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *UpdateStorageNetworkIpRangeParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateStorageNetworkIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateStorageNetworkIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateStorageNetworkIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetNetmask sets the netmask param.
 func (p *UpdateStorageNetworkIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *UpdateStorageNetworkIpRangeParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *UpdateStorageNetworkIpRangeParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetStartip sets the startip param.
 func (p *UpdateStorageNetworkIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *UpdateStorageNetworkIpRangeParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *UpdateStorageNetworkIpRangeParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	return p.startip.v, p.startip.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *UpdateStorageNetworkIpRangeParams) SetVlan(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optInt{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *UpdateStorageNetworkIpRangeParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optInt{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *UpdateStorageNetworkIpRangeParams) GetVlan() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vlan.v, p.vlan.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateStorageNetworkIpRangeParams) Clone() *UpdateStorageNetworkIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateStorageNetworkIpRangeParams) Equal(o *UpdateStorageNetworkIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["vlan"].(int)
-	return value, ok
+	return p.endip == o.endip &&
+		p.id == o.id &&
+		p.netmask == o.netmask &&
+		p.startip == o.startip &&
+		p.vlan == o.vlan
+}
+
+// serializedUpdateStorageNetworkIpRangeParams is used to (un)marshal UpdateStorageNetworkIpRangeParams using the API param names
+type serializedUpdateStorageNetworkIpRangeParams struct {
+	Endip   *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Netmask *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Startip *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Vlan    *int    `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) toSerialized() *serializedUpdateStorageNetworkIpRangeParams {
+	s := &serializedUpdateStorageNetworkIpRangeParams{}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	return s
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) fromSerialized(s *serializedUpdateStorageNetworkIpRangeParams) {
+	*p = UpdateStorageNetworkIpRangeParams{}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateStorageNetworkIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateStorageNetworkIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateStorageNetworkIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateStorageNetworkIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams {
 	p := &UpdateStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Update a Storage network IP range, only allowed when no IPs in this range have been allocated.
-func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("updateStorageNetworkIpRange", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams, opts ...CallOption) (*UpdateStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("updateStorageNetworkIpRange", s.cs.encodeParams("updateStorageNetworkIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7208,8 +10507,8 @@ func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7245,53 +10544,133 @@ type UpdateStorageNetworkIpRangeResponse struct {
 }
 
 type DeleteGuestNetworkIpv6PrefixParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteGuestNetworkIpv6PrefixParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteGuestNetworkIpv6PrefixParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteGuestNetworkIpv6PrefixParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteGuestNetworkIpv6PrefixParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteGuestNetworkIpv6PrefixParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteGuestNetworkIpv6PrefixParams(u url.Values) (*DeleteGuestNetworkIpv6PrefixParams, error) {
+	p := &DeleteGuestNetworkIpv6PrefixParams{}
+	if err := checkParamNames("deleteGuestNetworkIpv6Prefix", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteGuestNetworkIpv6PrefixParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteGuestNetworkIpv6PrefixParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteGuestNetworkIpv6PrefixParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteGuestNetworkIpv6PrefixParams) Clone() *DeleteGuestNetworkIpv6PrefixParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteGuestNetworkIpv6PrefixParams) Equal(o *DeleteGuestNetworkIpv6PrefixParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteGuestNetworkIpv6PrefixParams is used to (un)marshal DeleteGuestNetworkIpv6PrefixParams using the API param names
+type serializedDeleteGuestNetworkIpv6PrefixParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteGuestNetworkIpv6PrefixParams) toSerialized() *serializedDeleteGuestNetworkIpv6PrefixParams {
+	s := &serializedDeleteGuestNetworkIpv6PrefixParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteGuestNetworkIpv6PrefixParams) fromSerialized(s *serializedDeleteGuestNetworkIpv6PrefixParams) {
+	*p = DeleteGuestNetworkIpv6PrefixParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteGuestNetworkIpv6PrefixParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteGuestNetworkIpv6PrefixParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteGuestNetworkIpv6PrefixParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteGuestNetworkIpv6PrefixParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteGuestNetworkIpv6PrefixParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteGuestNetworkIpv6PrefixParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteGuestNetworkIpv6PrefixParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteGuestNetworkIpv6PrefixParams(id string) *DeleteGuestNetworkIpv6PrefixParams {
 	p := &DeleteGuestNetworkIpv6PrefixParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes an existing guest network IPv6 prefix.
-func (s *NetworkService) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
-	resp, err := s.cs.newRequest("deleteGuestNetworkIpv6Prefix", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NetworkService) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams, opts ...CallOption) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
+	resp, err := s.cs.newRequest("deleteGuestNetworkIpv6Prefix", s.cs.encodeParams("deleteGuestNetworkIpv6Prefix", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7302,8 +10681,8 @@ func (s *NetworkService) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6P
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7326,79 +10705,165 @@ type DeleteGuestNetworkIpv6PrefixResponse struct {
 	Success     bool   `json:"success"`
 }
 
-type CreateGuestNetworkIpv6PrefixParams struct {
-	p map[string]interface{}
+type CreateGuestNetworkIpv6PrefixParams struct {
+	prefix optString
+	zoneid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateGuestNetworkIpv6PrefixParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.prefix.ok {
+		u.Set("prefix", p.prefix.v)
+	}
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
+	}
+	return u
+}
+
+// ParseCreateGuestNetworkIpv6PrefixParams parses url.Values, for example taken from a raw API request,
+// into a new CreateGuestNetworkIpv6PrefixParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateGuestNetworkIpv6PrefixParams(u url.Values) (*CreateGuestNetworkIpv6PrefixParams, error) {
+	p := &CreateGuestNetworkIpv6PrefixParams{}
+	if err := checkParamNames("createGuestNetworkIpv6Prefix", u, "prefix", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["prefix"]; found {
+		p.SetPrefix(u.Get("prefix"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetPrefix sets the prefix param. This param is required.
+func (p *CreateGuestNetworkIpv6PrefixParams) SetPrefix(v string) {
+	p.prefix = optString{v: v, ok: true}
+}
+
+// ResetPrefix unsets the prefix param
+func (p *CreateGuestNetworkIpv6PrefixParams) ResetPrefix() {
+	p.prefix = optString{}
+}
+
+// GetPrefix returns the prefix param and if it is set
+func (p *CreateGuestNetworkIpv6PrefixParams) GetPrefix() (string, bool) {
+	return p.prefix.v, p.prefix.ok
+}
+
+// SetZoneid sets the zoneid param. This param is required.
+func (p *CreateGuestNetworkIpv6PrefixParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *CreateGuestNetworkIpv6PrefixParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *CreateGuestNetworkIpv6PrefixParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
 }
 
-func (p *CreateGuestNetworkIpv6PrefixParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Clone returns a deep copy of the params
+func (p *CreateGuestNetworkIpv6PrefixParams) Clone() *CreateGuestNetworkIpv6PrefixParams {
+	if p == nil {
+		return nil
 	}
-	if v, found := p.p["prefix"]; found {
-		u.Set("prefix", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+	c := *p
+	return &c
 }
 
-func (p *CreateGuestNetworkIpv6PrefixParams) SetPrefix(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateGuestNetworkIpv6PrefixParams) Equal(o *CreateGuestNetworkIpv6PrefixParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	p.p["prefix"] = v
+	return p.prefix == o.prefix &&
+		p.zoneid == o.zoneid
 }
 
-func (p *CreateGuestNetworkIpv6PrefixParams) ResetPrefix() {
-	if p.p != nil && p.p["prefix"] != nil {
-		delete(p.p, "prefix")
-	}
+// serializedCreateGuestNetworkIpv6PrefixParams is used to (un)marshal CreateGuestNetworkIpv6PrefixParams using the API param names
+type serializedCreateGuestNetworkIpv6PrefixParams struct {
+	Prefix *string `json:"prefix,omitempty" yaml:"prefix,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-func (p *CreateGuestNetworkIpv6PrefixParams) GetPrefix() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateGuestNetworkIpv6PrefixParams) toSerialized() *serializedCreateGuestNetworkIpv6PrefixParams {
+	s := &serializedCreateGuestNetworkIpv6PrefixParams{}
+	if p.prefix.ok {
+		s.Prefix = &p.prefix.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
 	}
-	value, ok := p.p["prefix"].(string)
-	return value, ok
+	return s
 }
 
-func (p *CreateGuestNetworkIpv6PrefixParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateGuestNetworkIpv6PrefixParams) fromSerialized(s *serializedCreateGuestNetworkIpv6PrefixParams) {
+	*p = CreateGuestNetworkIpv6PrefixParams{}
+	if s.Prefix != nil {
+		p.SetPrefix(*s.Prefix)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
-	p.p["zoneid"] = v
 }
 
-func (p *CreateGuestNetworkIpv6PrefixParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateGuestNetworkIpv6PrefixParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateGuestNetworkIpv6PrefixParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateGuestNetworkIpv6PrefixParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *CreateGuestNetworkIpv6PrefixParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateGuestNetworkIpv6PrefixParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateGuestNetworkIpv6PrefixParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateGuestNetworkIpv6PrefixParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateGuestNetworkIpv6PrefixParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreateGuestNetworkIpv6PrefixParams(prefix string, zoneid string) *CreateGuestNetworkIpv6PrefixParams {
 	p := &CreateGuestNetworkIpv6PrefixParams{}
-	p.p = make(map[string]interface{})
-	p.p["prefix"] = prefix
-	p.p["zoneid"] = zoneid
+	p.SetPrefix(prefix)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Creates a guest network IPv6 prefix.
-func (s *NetworkService) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error) {
-	resp, err := s.cs.newRequest("createGuestNetworkIpv6Prefix", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: prefix, zoneid.
+func (s *NetworkService) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams, opts ...CallOption) (*CreateGuestNetworkIpv6PrefixResponse, error) {
+	resp, err := s.cs.newRequest("createGuestNetworkIpv6Prefix", s.cs.encodeParams("createGuestNetworkIpv6Prefix", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7409,8 +10874,8 @@ func (s *NetworkService) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6P
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7444,153 +10909,259 @@ type CreateGuestNetworkIpv6PrefixResponse struct {
 }
 
 type ListGuestNetworkIpv6PrefixesParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	zoneid   optString
 }
 
-func (p *ListGuestNetworkIpv6PrefixesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListGuestNetworkIpv6PrefixesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListGuestNetworkIpv6PrefixesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListGuestNetworkIpv6PrefixesParams parses url.Values, for example taken from a raw API request,
+// into a new ListGuestNetworkIpv6PrefixesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListGuestNetworkIpv6PrefixesParams(u url.Values) (*ListGuestNetworkIpv6PrefixesParams, error) {
+	p := &ListGuestNetworkIpv6PrefixesParams{}
+	if err := checkParamNames("listGuestNetworkIpv6Prefixes", u, "id", "keyword", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["id"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListGuestNetworkIpv6PrefixesParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListGuestNetworkIpv6PrefixesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListGuestNetworkIpv6PrefixesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListGuestNetworkIpv6PrefixesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListGuestNetworkIpv6PrefixesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListGuestNetworkIpv6PrefixesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListGuestNetworkIpv6PrefixesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListGuestNetworkIpv6PrefixesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListGuestNetworkIpv6PrefixesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListGuestNetworkIpv6PrefixesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListGuestNetworkIpv6PrefixesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListGuestNetworkIpv6PrefixesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListGuestNetworkIpv6PrefixesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListGuestNetworkIpv6PrefixesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListGuestNetworkIpv6PrefixesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListGuestNetworkIpv6PrefixesParams) Clone() *ListGuestNetworkIpv6PrefixesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListGuestNetworkIpv6PrefixesParams) Equal(o *ListGuestNetworkIpv6PrefixesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListGuestNetworkIpv6PrefixesParams is used to (un)marshal ListGuestNetworkIpv6PrefixesParams using the API param names
+type serializedListGuestNetworkIpv6PrefixesParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListGuestNetworkIpv6PrefixesParams) toSerialized() *serializedListGuestNetworkIpv6PrefixesParams {
+	s := &serializedListGuestNetworkIpv6PrefixesParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListGuestNetworkIpv6PrefixesParams) fromSerialized(s *serializedListGuestNetworkIpv6PrefixesParams) {
+	*p = ListGuestNetworkIpv6PrefixesParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListGuestNetworkIpv6PrefixesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListGuestNetworkIpv6PrefixesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListGuestNetworkIpv6PrefixesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListGuestNetworkIpv6PrefixesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListGuestNetworkIpv6PrefixesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListGuestNetworkIpv6PrefixesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListGuestNetworkIpv6PrefixesParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListGuestNetworkIpv6PrefixesParams() *ListGuestNetworkIpv6PrefixesParams {
 	p := &ListGuestNetworkIpv6PrefixesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetGuestNetworkIpv6PrefixeByID(id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error) {
 	p := &ListGuestNetworkIpv6PrefixesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -7618,9 +11189,9 @@ func (s *NetworkService) GetGuestNetworkIpv6PrefixeByID(id string, opts ...Optio
 	return nil, l.Count, fmt.Errorf("There is more then one result for GuestNetworkIpv6Prefixe UUID: %s!", id)
 }
 
-// Lists guest network IPv6 prefixes
-func (s *NetworkService) ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error) {
-	resp, err := s.cs.newRequest("listGuestNetworkIpv6Prefixes", p.toURLValues())
+// Lists guest network IPv6 prefixes.
+func (s *NetworkService) ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams, opts ...CallOption) (*ListGuestNetworkIpv6PrefixesResponse, error) {
+	resp, err := s.cs.newRequest("listGuestNetworkIpv6Prefixes", s.cs.encodeParams("listGuestNetworkIpv6Prefixes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7651,128 +11222,225 @@ type GuestNetworkIpv6Prefixe struct {
 }
 
 type CreateNetworkPermissionsParams struct {
-	p map[string]interface{}
+	accountids optStrings
+	accounts   optStrings
+	networkid  optString
+	projectids optStrings
 }
 
-func (p *CreateNetworkPermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateNetworkPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accountids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("accountids", vv)
+	if p.accountids.ok {
+		u.Set("accountids", strings.Join(p.accountids.v, ","))
 	}
-	if v, found := p.p["accounts"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("accounts", vv)
+	if p.accounts.ok {
+		u.Set("accounts", strings.Join(p.accounts.v, ","))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["projectids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("projectids", vv)
+	if p.projectids.ok {
+		u.Set("projectids", strings.Join(p.projectids.v, ","))
 	}
 	return u
 }
 
-func (p *CreateNetworkPermissionsParams) SetAccountids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateNetworkPermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new CreateNetworkPermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateNetworkPermissionsParams(u url.Values) (*CreateNetworkPermissionsParams, error) {
+	p := &CreateNetworkPermissionsParams{}
+	if err := checkParamNames("createNetworkPermissions", u, "accountids", "accounts", "networkid", "projectids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accountids"]; found {
+		p.SetAccountids(strings.Split(u.Get("accountids"), ","))
 	}
-	p.p["accountids"] = v
+	if _, found := u["accounts"]; found {
+		p.SetAccounts(strings.Split(u.Get("accounts"), ","))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["projectids"]; found {
+		p.SetProjectids(strings.Split(u.Get("projectids"), ","))
+	}
+	return p, nil
+}
+
+// SetAccountids sets the accountids param.
+func (p *CreateNetworkPermissionsParams) SetAccountids(v []string) {
+	p.accountids = optStrings{v: v, ok: true}
 }
 
+// ResetAccountids unsets the accountids param
 func (p *CreateNetworkPermissionsParams) ResetAccountids() {
-	if p.p != nil && p.p["accountids"] != nil {
-		delete(p.p, "accountids")
-	}
+	p.accountids = optStrings{}
 }
 
+// GetAccountids returns the accountids param and if it is set
 func (p *CreateNetworkPermissionsParams) GetAccountids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountids"].([]string)
-	return value, ok
+	return p.accountids.v, p.accountids.ok
 }
 
+// SetAccounts sets the accounts param.
 func (p *CreateNetworkPermissionsParams) SetAccounts(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounts"] = v
+	p.accounts = optStrings{v: v, ok: true}
 }
 
+// ResetAccounts unsets the accounts param
 func (p *CreateNetworkPermissionsParams) ResetAccounts() {
-	if p.p != nil && p.p["accounts"] != nil {
-		delete(p.p, "accounts")
-	}
+	p.accounts = optStrings{}
 }
 
+// GetAccounts returns the accounts param and if it is set
 func (p *CreateNetworkPermissionsParams) GetAccounts() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounts"].([]string)
-	return value, ok
+	return p.accounts.v, p.accounts.ok
 }
 
+// SetNetworkid sets the networkid param. This param is required.
 func (p *CreateNetworkPermissionsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateNetworkPermissionsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateNetworkPermissionsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetProjectids sets the projectids param.
 func (p *CreateNetworkPermissionsParams) SetProjectids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectids"] = v
+	p.projectids = optStrings{v: v, ok: true}
 }
 
+// ResetProjectids unsets the projectids param
 func (p *CreateNetworkPermissionsParams) ResetProjectids() {
-	if p.p != nil && p.p["projectids"] != nil {
-		delete(p.p, "projectids")
-	}
+	p.projectids = optStrings{}
 }
 
+// GetProjectids returns the projectids param and if it is set
 func (p *CreateNetworkPermissionsParams) GetProjectids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectids.v, p.projectids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateNetworkPermissionsParams) Clone() *CreateNetworkPermissionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.accountids = p.accountids.clone()
+	c.accounts = p.accounts.clone()
+	c.projectids = p.projectids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateNetworkPermissionsParams) Equal(o *CreateNetworkPermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accountids.equal(o.accountids) &&
+		p.accounts.equal(o.accounts) &&
+		p.networkid == o.networkid &&
+		p.projectids.equal(o.projectids)
+}
+
+// serializedCreateNetworkPermissionsParams is used to (un)marshal CreateNetworkPermissionsParams using the API param names
+type serializedCreateNetworkPermissionsParams struct {
+	Accountids *[]string `json:"accountids,omitempty" yaml:"accountids,omitempty"`
+	Accounts   *[]string `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	Networkid  *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Projectids *[]string `json:"projectids,omitempty" yaml:"projectids,omitempty"`
+}
+
+func (p *CreateNetworkPermissionsParams) toSerialized() *serializedCreateNetworkPermissionsParams {
+	s := &serializedCreateNetworkPermissionsParams{}
+	if p.accountids.ok {
+		s.Accountids = &p.accountids.v
+	}
+	if p.accounts.ok {
+		s.Accounts = &p.accounts.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.projectids.ok {
+		s.Projectids = &p.projectids.v
+	}
+	return s
+}
+
+func (p *CreateNetworkPermissionsParams) fromSerialized(s *serializedCreateNetworkPermissionsParams) {
+	*p = CreateNetworkPermissionsParams{}
+	if s.Accountids != nil {
+		p.SetAccountids(*s.Accountids)
+	}
+	if s.Accounts != nil {
+		p.SetAccounts(*s.Accounts)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Projectids != nil {
+		p.SetProjectids(*s.Projectids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateNetworkPermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateNetworkPermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateNetworkPermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateNetworkPermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["projectids"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateNetworkPermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreateNetworkPermissionsParams(networkid string) *CreateNetworkPermissionsParams {
 	p := &CreateNetworkPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
+	p.SetNetworkid(networkid)
 	return p
 }
 
 // Updates network permissions.
-func (s *NetworkService) CreateNetworkPermissions(p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkPermissions", p.toURLValues())
+//
+// Required params: networkid.
+func (s *NetworkService) CreateNetworkPermissions(p *CreateNetworkPermissionsParams, opts ...CallOption) (*CreateNetworkPermissionsResponse, error) {
+	resp, err := s.cs.newRequest("createNetworkPermissions", s.cs.encodeParams("createNetworkPermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7820,53 +11488,132 @@ func (r *CreateNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ResetNetworkPermissionsParams struct {
-	p map[string]interface{}
+	networkid optString
 }
 
-func (p *ResetNetworkPermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResetNetworkPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
 	return u
 }
 
-func (p *ResetNetworkPermissionsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseResetNetworkPermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new ResetNetworkPermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResetNetworkPermissionsParams(u url.Values) (*ResetNetworkPermissionsParams, error) {
+	p := &ResetNetworkPermissionsParams{}
+	if err := checkParamNames("resetNetworkPermissions", u, "networkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
 	}
-	p.p["networkid"] = v
+	return p, nil
 }
 
+// SetNetworkid sets the networkid param. This param is required.
+func (p *ResetNetworkPermissionsParams) SetNetworkid(v string) {
+	p.networkid = optString{v: v, ok: true}
+}
+
+// ResetNetworkid unsets the networkid param
 func (p *ResetNetworkPermissionsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ResetNetworkPermissionsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.networkid.v, p.networkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResetNetworkPermissionsParams) Clone() *ResetNetworkPermissionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResetNetworkPermissionsParams) Equal(o *ResetNetworkPermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.networkid == o.networkid
+}
+
+// serializedResetNetworkPermissionsParams is used to (un)marshal ResetNetworkPermissionsParams using the API param names
+type serializedResetNetworkPermissionsParams struct {
+	Networkid *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+}
+
+func (p *ResetNetworkPermissionsParams) toSerialized() *serializedResetNetworkPermissionsParams {
+	s := &serializedResetNetworkPermissionsParams{}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	return s
+}
+
+func (p *ResetNetworkPermissionsParams) fromSerialized(s *serializedResetNetworkPermissionsParams) {
+	*p = ResetNetworkPermissionsParams{}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResetNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResetNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedResetNetworkPermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResetNetworkPermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResetNetworkPermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResetNetworkPermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ResetNetworkPermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewResetNetworkPermissionsParams(networkid string) *ResetNetworkPermissionsParams {
 	p := &ResetNetworkPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
+	p.SetNetworkid(networkid)
 	return p
 }
 
 // Resets network permissions.
-func (s *NetworkService) ResetNetworkPermissions(p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("resetNetworkPermissions", p.toURLValues())
+//
+// Required params: networkid.
+func (s *NetworkService) ResetNetworkPermissions(p *ResetNetworkPermissionsParams, opts ...CallOption) (*ResetNetworkPermissionsResponse, error) {
+	resp, err := s.cs.newRequest("resetNetworkPermissions", s.cs.encodeParams("resetNetworkPermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7914,53 +11661,132 @@ func (r *ResetNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListNetworkPermissionsParams struct {
-	p map[string]interface{}
+	networkid optString
 }
 
-func (p *ListNetworkPermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNetworkPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
 	return u
 }
 
-func (p *ListNetworkPermissionsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNetworkPermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListNetworkPermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNetworkPermissionsParams(u url.Values) (*ListNetworkPermissionsParams, error) {
+	p := &ListNetworkPermissionsParams{}
+	if err := checkParamNames("listNetworkPermissions", u, "networkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
 	}
-	p.p["networkid"] = v
+	return p, nil
+}
+
+// SetNetworkid sets the networkid param. This param is required.
+func (p *ListNetworkPermissionsParams) SetNetworkid(v string) {
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListNetworkPermissionsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListNetworkPermissionsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.networkid.v, p.networkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNetworkPermissionsParams) Clone() *ListNetworkPermissionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNetworkPermissionsParams) Equal(o *ListNetworkPermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.networkid == o.networkid
+}
+
+// serializedListNetworkPermissionsParams is used to (un)marshal ListNetworkPermissionsParams using the API param names
+type serializedListNetworkPermissionsParams struct {
+	Networkid *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+}
+
+func (p *ListNetworkPermissionsParams) toSerialized() *serializedListNetworkPermissionsParams {
+	s := &serializedListNetworkPermissionsParams{}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	return s
+}
+
+func (p *ListNetworkPermissionsParams) fromSerialized(s *serializedListNetworkPermissionsParams) {
+	*p = ListNetworkPermissionsParams{}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNetworkPermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNetworkPermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNetworkPermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNetworkPermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNetworkPermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetworkPermissionsParams(networkid string) *ListNetworkPermissionsParams {
 	p := &ListNetworkPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
+	p.SetNetworkid(networkid)
 	return p
 }
 
 // List network visibility and all accounts that have permissions to view this network.
-func (s *NetworkService) ListNetworkPermissions(p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkPermissions", p.toURLValues())
+//
+// Required params: networkid.
+func (s *NetworkService) ListNetworkPermissions(p *ListNetworkPermissionsParams, opts ...CallOption) (*ListNetworkPermissionsResponse, error) {
+	resp, err := s.cs.newRequest("listNetworkPermissions", s.cs.encodeParams("listNetworkPermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7991,128 +11817,225 @@ type NetworkPermission struct {
 }
 
 type RemoveNetworkPermissionsParams struct {
-	p map[string]interface{}
+	accountids optStrings
+	accounts   optStrings
+	networkid  optString
+	projectids optStrings
 }
 
-func (p *RemoveNetworkPermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveNetworkPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accountids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("accountids", vv)
+	if p.accountids.ok {
+		u.Set("accountids", strings.Join(p.accountids.v, ","))
 	}
-	if v, found := p.p["accounts"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("accounts", vv)
+	if p.accounts.ok {
+		u.Set("accounts", strings.Join(p.accounts.v, ","))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["projectids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("projectids", vv)
+	if p.projectids.ok {
+		u.Set("projectids", strings.Join(p.projectids.v, ","))
 	}
 	return u
 }
 
-func (p *RemoveNetworkPermissionsParams) SetAccountids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveNetworkPermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveNetworkPermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveNetworkPermissionsParams(u url.Values) (*RemoveNetworkPermissionsParams, error) {
+	p := &RemoveNetworkPermissionsParams{}
+	if err := checkParamNames("removeNetworkPermissions", u, "accountids", "accounts", "networkid", "projectids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accountids"]; found {
+		p.SetAccountids(strings.Split(u.Get("accountids"), ","))
 	}
-	p.p["accountids"] = v
+	if _, found := u["accounts"]; found {
+		p.SetAccounts(strings.Split(u.Get("accounts"), ","))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["projectids"]; found {
+		p.SetProjectids(strings.Split(u.Get("projectids"), ","))
+	}
+	return p, nil
+}
+
+// SetAccountids sets the accountids param.
+func (p *RemoveNetworkPermissionsParams) SetAccountids(v []string) {
+	p.accountids = optStrings{v: v, ok: true}
 }
 
+// ResetAccountids unsets the accountids param
 func (p *RemoveNetworkPermissionsParams) ResetAccountids() {
-	if p.p != nil && p.p["accountids"] != nil {
-		delete(p.p, "accountids")
-	}
+	p.accountids = optStrings{}
 }
 
+// GetAccountids returns the accountids param and if it is set
 func (p *RemoveNetworkPermissionsParams) GetAccountids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountids"].([]string)
-	return value, ok
+	return p.accountids.v, p.accountids.ok
 }
 
+// SetAccounts sets the accounts param.
 func (p *RemoveNetworkPermissionsParams) SetAccounts(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounts"] = v
+	p.accounts = optStrings{v: v, ok: true}
 }
 
+// ResetAccounts unsets the accounts param
 func (p *RemoveNetworkPermissionsParams) ResetAccounts() {
-	if p.p != nil && p.p["accounts"] != nil {
-		delete(p.p, "accounts")
-	}
+	p.accounts = optStrings{}
 }
 
+// GetAccounts returns the accounts param and if it is set
 func (p *RemoveNetworkPermissionsParams) GetAccounts() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounts"].([]string)
-	return value, ok
+	return p.accounts.v, p.accounts.ok
 }
 
+// SetNetworkid sets the networkid param. This param is required.
 func (p *RemoveNetworkPermissionsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *RemoveNetworkPermissionsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *RemoveNetworkPermissionsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetProjectids sets the projectids param.
 func (p *RemoveNetworkPermissionsParams) SetProjectids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectids"] = v
+	p.projectids = optStrings{v: v, ok: true}
 }
 
+// ResetProjectids unsets the projectids param
 func (p *RemoveNetworkPermissionsParams) ResetProjectids() {
-	if p.p != nil && p.p["projectids"] != nil {
-		delete(p.p, "projectids")
-	}
+	p.projectids = optStrings{}
 }
 
+// GetProjectids returns the projectids param and if it is set
 func (p *RemoveNetworkPermissionsParams) GetProjectids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectids.v, p.projectids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveNetworkPermissionsParams) Clone() *RemoveNetworkPermissionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.accountids = p.accountids.clone()
+	c.accounts = p.accounts.clone()
+	c.projectids = p.projectids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveNetworkPermissionsParams) Equal(o *RemoveNetworkPermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accountids.equal(o.accountids) &&
+		p.accounts.equal(o.accounts) &&
+		p.networkid == o.networkid &&
+		p.projectids.equal(o.projectids)
+}
+
+// serializedRemoveNetworkPermissionsParams is used to (un)marshal RemoveNetworkPermissionsParams using the API param names
+type serializedRemoveNetworkPermissionsParams struct {
+	Accountids *[]string `json:"accountids,omitempty" yaml:"accountids,omitempty"`
+	Accounts   *[]string `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	Networkid  *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Projectids *[]string `json:"projectids,omitempty" yaml:"projectids,omitempty"`
+}
+
+func (p *RemoveNetworkPermissionsParams) toSerialized() *serializedRemoveNetworkPermissionsParams {
+	s := &serializedRemoveNetworkPermissionsParams{}
+	if p.accountids.ok {
+		s.Accountids = &p.accountids.v
+	}
+	if p.accounts.ok {
+		s.Accounts = &p.accounts.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.projectids.ok {
+		s.Projectids = &p.projectids.v
+	}
+	return s
+}
+
+func (p *RemoveNetworkPermissionsParams) fromSerialized(s *serializedRemoveNetworkPermissionsParams) {
+	*p = RemoveNetworkPermissionsParams{}
+	if s.Accountids != nil {
+		p.SetAccountids(*s.Accountids)
 	}
-	value, ok := p.p["projectids"].([]string)
-	return value, ok
+	if s.Accounts != nil {
+		p.SetAccounts(*s.Accounts)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Projectids != nil {
+		p.SetProjectids(*s.Projectids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveNetworkPermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveNetworkPermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveNetworkPermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveNetworkPermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveNetworkPermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewRemoveNetworkPermissionsParams(networkid string) *RemoveNetworkPermissionsParams {
 	p := &RemoveNetworkPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
+	p.SetNetworkid(networkid)
 	return p
 }
 
 // Removes network permissions.
-func (s *NetworkService) RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("removeNetworkPermissions", p.toURLValues())
+//
+// Required params: networkid.
+func (s *NetworkService) RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams, opts ...CallOption) (*RemoveNetworkPermissionsResponse, error) {
+	resp, err := s.cs.newRequest("removeNetworkPermissions", s.cs.encodeParams("removeNetworkPermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,228 +53,303 @@ func (m *MockNetworkServiceIface) EXPECT() *MockNetworkServiceIfaceMockRecorder
 }
 
 // AddNetworkServiceProvider mocks base method.
-func (m *MockNetworkServiceIface) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+func (m *MockNetworkServiceIface) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams, opts ...CallOption) (*AddNetworkServiceProviderResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddNetworkServiceProvider", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddNetworkServiceProvider", varargs...)
 	ret0, _ := ret[0].(*AddNetworkServiceProviderResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddNetworkServiceProvider indicates an expected call of AddNetworkServiceProvider.
-func (mr *MockNetworkServiceIfaceMockRecorder) AddNetworkServiceProvider(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) AddNetworkServiceProvider(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddNetworkServiceProvider), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddNetworkServiceProvider), varargs...)
 }
 
 // AddOpenDaylightController mocks base method.
-func (m *MockNetworkServiceIface) AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
+func (m *MockNetworkServiceIface) AddOpenDaylightController(p *AddOpenDaylightControllerParams, opts ...CallOption) (*AddOpenDaylightControllerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOpenDaylightController", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddOpenDaylightController", varargs...)
 	ret0, _ := ret[0].(*AddOpenDaylightControllerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddOpenDaylightController indicates an expected call of AddOpenDaylightController.
-func (mr *MockNetworkServiceIfaceMockRecorder) AddOpenDaylightController(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) AddOpenDaylightController(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOpenDaylightController", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddOpenDaylightController), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOpenDaylightController", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddOpenDaylightController), varargs...)
 }
 
 // CreateGuestNetworkIpv6Prefix mocks base method.
-func (m *MockNetworkServiceIface) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error) {
+func (m *MockNetworkServiceIface) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams, opts ...CallOption) (*CreateGuestNetworkIpv6PrefixResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGuestNetworkIpv6Prefix", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGuestNetworkIpv6Prefix", varargs...)
 	ret0, _ := ret[0].(*CreateGuestNetworkIpv6PrefixResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateGuestNetworkIpv6Prefix indicates an expected call of CreateGuestNetworkIpv6Prefix.
-func (mr *MockNetworkServiceIfaceMockRecorder) CreateGuestNetworkIpv6Prefix(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateGuestNetworkIpv6Prefix(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestNetworkIpv6Prefix", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateGuestNetworkIpv6Prefix), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestNetworkIpv6Prefix", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateGuestNetworkIpv6Prefix), varargs...)
 }
 
 // CreateNetwork mocks base method.
-func (m *MockNetworkServiceIface) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
+func (m *MockNetworkServiceIface) CreateNetwork(p *CreateNetworkParams, opts ...CallOption) (*CreateNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNetwork", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetwork", varargs...)
 	ret0, _ := ret[0].(*CreateNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNetwork indicates an expected call of CreateNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) CreateNetwork(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateNetwork(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateNetwork), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateNetwork), varargs...)
 }
 
 // CreateNetworkPermissions mocks base method.
-func (m *MockNetworkServiceIface) CreateNetworkPermissions(p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error) {
+func (m *MockNetworkServiceIface) CreateNetworkPermissions(p *CreateNetworkPermissionsParams, opts ...CallOption) (*CreateNetworkPermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNetworkPermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetworkPermissions", varargs...)
 	ret0, _ := ret[0].(*CreateNetworkPermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNetworkPermissions indicates an expected call of CreateNetworkPermissions.
-func (mr *MockNetworkServiceIfaceMockRecorder) CreateNetworkPermissions(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateNetworkPermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateNetworkPermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateNetworkPermissions), varargs...)
 }
 
 // CreatePhysicalNetwork mocks base method.
-func (m *MockNetworkServiceIface) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+func (m *MockNetworkServiceIface) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams, opts ...CallOption) (*CreatePhysicalNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePhysicalNetwork", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePhysicalNetwork", varargs...)
 	ret0, _ := ret[0].(*CreatePhysicalNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePhysicalNetwork indicates an expected call of CreatePhysicalNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) CreatePhysicalNetwork(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) CreatePhysicalNetwork(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreatePhysicalNetwork), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreatePhysicalNetwork), varargs...)
 }
 
 // CreateServiceInstance mocks base method.
-func (m *MockNetworkServiceIface) CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
+func (m *MockNetworkServiceIface) CreateServiceInstance(p *CreateServiceInstanceParams, opts ...CallOption) (*CreateServiceInstanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateServiceInstance", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceInstance", varargs...)
 	ret0, _ := ret[0].(*CreateServiceInstanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateServiceInstance indicates an expected call of CreateServiceInstance.
-func (mr *MockNetworkServiceIfaceMockRecorder) CreateServiceInstance(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateServiceInstance(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceInstance", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateServiceInstance), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceInstance", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateServiceInstance), varargs...)
 }
 
 // CreateStorageNetworkIpRange mocks base method.
-func (m *MockNetworkServiceIface) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
+func (m *MockNetworkServiceIface) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams, opts ...CallOption) (*CreateStorageNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateStorageNetworkIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateStorageNetworkIpRange", varargs...)
 	ret0, _ := ret[0].(*CreateStorageNetworkIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateStorageNetworkIpRange indicates an expected call of CreateStorageNetworkIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) CreateStorageNetworkIpRange(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateStorageNetworkIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateStorageNetworkIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateStorageNetworkIpRange), varargs...)
 }
 
 // DedicatePublicIpRange mocks base method.
-func (m *MockNetworkServiceIface) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+func (m *MockNetworkServiceIface) DedicatePublicIpRange(p *DedicatePublicIpRangeParams, opts ...CallOption) (*DedicatePublicIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DedicatePublicIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DedicatePublicIpRange", varargs...)
 	ret0, _ := ret[0].(*DedicatePublicIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DedicatePublicIpRange indicates an expected call of DedicatePublicIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) DedicatePublicIpRange(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) DedicatePublicIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePublicIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).DedicatePublicIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePublicIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).DedicatePublicIpRange), varargs...)
 }
 
 // DeleteGuestNetworkIpv6Prefix mocks base method.
-func (m *MockNetworkServiceIface) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
+func (m *MockNetworkServiceIface) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams, opts ...CallOption) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGuestNetworkIpv6Prefix", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteGuestNetworkIpv6Prefix", varargs...)
 	ret0, _ := ret[0].(*DeleteGuestNetworkIpv6PrefixResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteGuestNetworkIpv6Prefix indicates an expected call of DeleteGuestNetworkIpv6Prefix.
-func (mr *MockNetworkServiceIfaceMockRecorder) DeleteGuestNetworkIpv6Prefix(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteGuestNetworkIpv6Prefix(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuestNetworkIpv6Prefix", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteGuestNetworkIpv6Prefix), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuestNetworkIpv6Prefix", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteGuestNetworkIpv6Prefix), varargs...)
 }
 
 // DeleteNetwork mocks base method.
-func (m *MockNetworkServiceIface) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
+func (m *MockNetworkServiceIface) DeleteNetwork(p *DeleteNetworkParams, opts ...CallOption) (*DeleteNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNetwork", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetwork", varargs...)
 	ret0, _ := ret[0].(*DeleteNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNetwork indicates an expected call of DeleteNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetwork(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetwork(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetwork), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetwork), varargs...)
 }
 
 // DeleteNetworkServiceProvider mocks base method.
-func (m *MockNetworkServiceIface) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+func (m *MockNetworkServiceIface) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams, opts ...CallOption) (*DeleteNetworkServiceProviderResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNetworkServiceProvider", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetworkServiceProvider", varargs...)
 	ret0, _ := ret[0].(*DeleteNetworkServiceProviderResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNetworkServiceProvider indicates an expected call of DeleteNetworkServiceProvider.
-func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetworkServiceProvider(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetworkServiceProvider(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetworkServiceProvider), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetworkServiceProvider), varargs...)
 }
 
 // DeleteOpenDaylightController mocks base method.
-func (m *MockNetworkServiceIface) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
+func (m *MockNetworkServiceIface) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams, opts ...CallOption) (*DeleteOpenDaylightControllerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOpenDaylightController", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOpenDaylightController", varargs...)
 	ret0, _ := ret[0].(*DeleteOpenDaylightControllerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteOpenDaylightController indicates an expected call of DeleteOpenDaylightController.
-func (mr *MockNetworkServiceIfaceMockRecorder) DeleteOpenDaylightController(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteOpenDaylightController(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenDaylightController", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteOpenDaylightController), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenDaylightController", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteOpenDaylightController), varargs...)
 }
 
 // DeletePhysicalNetwork mocks base method.
-func (m *MockNetworkServiceIface) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+func (m *MockNetworkServiceIface) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams, opts ...CallOption) (*DeletePhysicalNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePhysicalNetwork", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePhysicalNetwork", varargs...)
 	ret0, _ := ret[0].(*DeletePhysicalNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeletePhysicalNetwork indicates an expected call of DeletePhysicalNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) DeletePhysicalNetwork(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) DeletePhysicalNetwork(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeletePhysicalNetwork), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeletePhysicalNetwork), varargs...)
 }
 
 // DeleteStorageNetworkIpRange mocks base method.
-func (m *MockNetworkServiceIface) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+func (m *MockNetworkServiceIface) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams, opts ...CallOption) (*DeleteStorageNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteStorageNetworkIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStorageNetworkIpRange", varargs...)
 	ret0, _ := ret[0].(*DeleteStorageNetworkIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteStorageNetworkIpRange indicates an expected call of DeleteStorageNetworkIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) DeleteStorageNetworkIpRange(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteStorageNetworkIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteStorageNetworkIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteStorageNetworkIpRange), varargs...)
 }
 
 // GetGuestNetworkIpv6PrefixeByID mocks base method.
@@ -551,183 +626,243 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetStorageNetworkIpRangeByID(id i
 }
 
 // ListGuestNetworkIpv6Prefixes mocks base method.
-func (m *MockNetworkServiceIface) ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error) {
+func (m *MockNetworkServiceIface) ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams, opts ...CallOption) (*ListGuestNetworkIpv6PrefixesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListGuestNetworkIpv6Prefixes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestNetworkIpv6Prefixes", varargs...)
 	ret0, _ := ret[0].(*ListGuestNetworkIpv6PrefixesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListGuestNetworkIpv6Prefixes indicates an expected call of ListGuestNetworkIpv6Prefixes.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6Prefixes(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6Prefixes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6Prefixes", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6Prefixes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6Prefixes", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6Prefixes), varargs...)
 }
 
 // ListNetscalerLoadBalancerNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+func (m *MockNetworkServiceIface) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams, opts ...CallOption) (*ListNetscalerLoadBalancerNetworksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancerNetworks", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancerNetworks", varargs...)
 	ret0, _ := ret[0].(*ListNetscalerLoadBalancerNetworksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetscalerLoadBalancerNetworks indicates an expected call of ListNetscalerLoadBalancerNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetworks(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetworks(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetscalerLoadBalancerNetworks), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetscalerLoadBalancerNetworks), varargs...)
 }
 
 // ListNetworkIsolationMethods mocks base method.
-func (m *MockNetworkServiceIface) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+func (m *MockNetworkServiceIface) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams, opts ...CallOption) (*ListNetworkIsolationMethodsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkIsolationMethods", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkIsolationMethods", varargs...)
 	ret0, _ := ret[0].(*ListNetworkIsolationMethodsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworkIsolationMethods indicates an expected call of ListNetworkIsolationMethods.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethods(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethods(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethods", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethods), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethods", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethods), varargs...)
 }
 
 // ListNetworkPermissions mocks base method.
-func (m *MockNetworkServiceIface) ListNetworkPermissions(p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error) {
+func (m *MockNetworkServiceIface) ListNetworkPermissions(p *ListNetworkPermissionsParams, opts ...CallOption) (*ListNetworkPermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkPermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkPermissions", varargs...)
 	ret0, _ := ret[0].(*ListNetworkPermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworkPermissions indicates an expected call of ListNetworkPermissions.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkPermissions(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkPermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkPermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkPermissions), varargs...)
 }
 
 // ListNetworkServiceProviders mocks base method.
-func (m *MockNetworkServiceIface) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+func (m *MockNetworkServiceIface) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams, opts ...CallOption) (*ListNetworkServiceProvidersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkServiceProviders", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkServiceProviders", varargs...)
 	ret0, _ := ret[0].(*ListNetworkServiceProvidersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworkServiceProviders indicates an expected call of ListNetworkServiceProviders.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProviders(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProviders(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProviders", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProviders), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProviders", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProviders), varargs...)
 }
 
 // ListNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
+func (m *MockNetworkServiceIface) ListNetworks(p *ListNetworksParams, opts ...CallOption) (*ListNetworksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworks", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworks", varargs...)
 	ret0, _ := ret[0].(*ListNetworksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNetworks indicates an expected call of ListNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworks(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworks(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworks), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworks), varargs...)
 }
 
 // ListNiciraNvpDeviceNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams, opts ...CallOption) (*ListNiciraNvpDeviceNetworksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworks", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworks", varargs...)
 	ret0, _ := ret[0].(*ListNiciraNvpDeviceNetworksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNiciraNvpDeviceNetworks indicates an expected call of ListNiciraNvpDeviceNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworks(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworks(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworks), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworks), varargs...)
 }
 
 // ListOpenDaylightControllers mocks base method.
-func (m *MockNetworkServiceIface) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
+func (m *MockNetworkServiceIface) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams, opts ...CallOption) (*ListOpenDaylightControllersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOpenDaylightControllers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOpenDaylightControllers", varargs...)
 	ret0, _ := ret[0].(*ListOpenDaylightControllersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListOpenDaylightControllers indicates an expected call of ListOpenDaylightControllers.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListOpenDaylightControllers(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListOpenDaylightControllers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenDaylightControllers", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListOpenDaylightControllers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenDaylightControllers", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListOpenDaylightControllers), varargs...)
 }
 
 // ListPaloAltoFirewallNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams, opts ...CallOption) (*ListPaloAltoFirewallNetworksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworks", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworks", varargs...)
 	ret0, _ := ret[0].(*ListPaloAltoFirewallNetworksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPaloAltoFirewallNetworks indicates an expected call of ListPaloAltoFirewallNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworks(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworks(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworks), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworks), varargs...)
 }
 
 // ListPhysicalNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+func (m *MockNetworkServiceIface) ListPhysicalNetworks(p *ListPhysicalNetworksParams, opts ...CallOption) (*ListPhysicalNetworksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPhysicalNetworks", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPhysicalNetworks", varargs...)
 	ret0, _ := ret[0].(*ListPhysicalNetworksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPhysicalNetworks indicates an expected call of ListPhysicalNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworks(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworks(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworks), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworks), varargs...)
 }
 
 // ListStorageNetworkIpRange mocks base method.
-func (m *MockNetworkServiceIface) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+func (m *MockNetworkServiceIface) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams, opts ...CallOption) (*ListStorageNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListStorageNetworkIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageNetworkIpRange", varargs...)
 	ret0, _ := ret[0].(*ListStorageNetworkIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListStorageNetworkIpRange indicates an expected call of ListStorageNetworkIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRange(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRange), varargs...)
 }
 
 // ListSupportedNetworkServices mocks base method.
-func (m *MockNetworkServiceIface) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+func (m *MockNetworkServiceIface) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams, opts ...CallOption) (*ListSupportedNetworkServicesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSupportedNetworkServices", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSupportedNetworkServices", varargs...)
 	ret0, _ := ret[0].(*ListSupportedNetworkServicesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSupportedNetworkServices indicates an expected call of ListSupportedNetworkServices.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServices(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServices(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServices", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServices), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServices", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServices), varargs...)
 }
 
 // NewAddNetworkServiceProviderParams mocks base method.
@@ -1221,121 +1356,161 @@ func (mr *MockNetworkServiceIfaceMockRecorder) NewUpdateStorageNetworkIpRangePar
 }
 
 // ReleasePublicIpRange mocks base method.
-func (m *MockNetworkServiceIface) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+func (m *MockNetworkServiceIface) ReleasePublicIpRange(p *ReleasePublicIpRangeParams, opts ...CallOption) (*ReleasePublicIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleasePublicIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleasePublicIpRange", varargs...)
 	ret0, _ := ret[0].(*ReleasePublicIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleasePublicIpRange indicates an expected call of ReleasePublicIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) ReleasePublicIpRange(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ReleasePublicIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePublicIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).ReleasePublicIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePublicIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).ReleasePublicIpRange), varargs...)
 }
 
 // RemoveNetworkPermissions mocks base method.
-func (m *MockNetworkServiceIface) RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error) {
+func (m *MockNetworkServiceIface) RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams, opts ...CallOption) (*RemoveNetworkPermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveNetworkPermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveNetworkPermissions", varargs...)
 	ret0, _ := ret[0].(*RemoveNetworkPermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveNetworkPermissions indicates an expected call of RemoveNetworkPermissions.
-func (mr *MockNetworkServiceIfaceMockRecorder) RemoveNetworkPermissions(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) RemoveNetworkPermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).RemoveNetworkPermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).RemoveNetworkPermissions), varargs...)
 }
 
 // ResetNetworkPermissions mocks base method.
-func (m *MockNetworkServiceIface) ResetNetworkPermissions(p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error) {
+func (m *MockNetworkServiceIface) ResetNetworkPermissions(p *ResetNetworkPermissionsParams, opts ...CallOption) (*ResetNetworkPermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetNetworkPermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetNetworkPermissions", varargs...)
 	ret0, _ := ret[0].(*ResetNetworkPermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResetNetworkPermissions indicates an expected call of ResetNetworkPermissions.
-func (mr *MockNetworkServiceIfaceMockRecorder) ResetNetworkPermissions(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) ResetNetworkPermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).ResetNetworkPermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).ResetNetworkPermissions), varargs...)
 }
 
 // RestartNetwork mocks base method.
-func (m *MockNetworkServiceIface) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
+func (m *MockNetworkServiceIface) RestartNetwork(p *RestartNetworkParams, opts ...CallOption) (*RestartNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestartNetwork", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestartNetwork", varargs...)
 	ret0, _ := ret[0].(*RestartNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RestartNetwork indicates an expected call of RestartNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) RestartNetwork(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) RestartNetwork(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).RestartNetwork), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).RestartNetwork), varargs...)
 }
 
 // UpdateNetwork mocks base method.
-func (m *MockNetworkServiceIface) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
+func (m *MockNetworkServiceIface) UpdateNetwork(p *UpdateNetworkParams, opts ...CallOption) (*UpdateNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNetwork", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNetwork", varargs...)
 	ret0, _ := ret[0].(*UpdateNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateNetwork indicates an expected call of UpdateNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetwork(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetwork(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetwork), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetwork), varargs...)
 }
 
 // UpdateNetworkServiceProvider mocks base method.
-func (m *MockNetworkServiceIface) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+func (m *MockNetworkServiceIface) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams, opts ...CallOption) (*UpdateNetworkServiceProviderResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNetworkServiceProvider", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNetworkServiceProvider", varargs...)
 	ret0, _ := ret[0].(*UpdateNetworkServiceProviderResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateNetworkServiceProvider indicates an expected call of UpdateNetworkServiceProvider.
-func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetworkServiceProvider(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetworkServiceProvider(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetworkServiceProvider), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetworkServiceProvider), varargs...)
 }
 
 // UpdatePhysicalNetwork mocks base method.
-func (m *MockNetworkServiceIface) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+func (m *MockNetworkServiceIface) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams, opts ...CallOption) (*UpdatePhysicalNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePhysicalNetwork", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePhysicalNetwork", varargs...)
 	ret0, _ := ret[0].(*UpdatePhysicalNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdatePhysicalNetwork indicates an expected call of UpdatePhysicalNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) UpdatePhysicalNetwork(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdatePhysicalNetwork(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdatePhysicalNetwork), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdatePhysicalNetwork), varargs...)
 }
 
 // UpdateStorageNetworkIpRange mocks base method.
-func (m *MockNetworkServiceIface) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
+func (m *MockNetworkServiceIface) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams, opts ...CallOption) (*UpdateStorageNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStorageNetworkIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStorageNetworkIpRange", varargs...)
 	ret0, _ := ret[0].(*UpdateStorageNetworkIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStorageNetworkIpRange indicates an expected call of UpdateStorageNetworkIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) UpdateStorageNetworkIpRange(p interface{}) *gomock.Call {
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateStorageNetworkIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateStorageNetworkIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateStorageNetworkIpRange), varargs...)
 }
@@ -21,93 +21,180 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type NicServiceIface interface {
-	AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNic(p *AddIpToNicParams, opts ...CallOption) (*AddIpToNicResponse, error)
 	NewAddIpToNicParams(nicid string) *AddIpToNicParams
-	ListNics(p *ListNicsParams) (*ListNicsResponse, error)
+	ListNics(p *ListNicsParams, opts ...CallOption) (*ListNicsResponse, error)
 	NewListNicsParams(virtualmachineid string) *ListNicsParams
-	RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNic(p *RemoveIpFromNicParams, opts ...CallOption) (*RemoveIpFromNicResponse, error)
 	NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
-	UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIp(p *UpdateVmNicIpParams, opts ...CallOption) (*UpdateVmNicIpResponse, error)
 	NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams
 }
 
 type AddIpToNicParams struct {
-	p map[string]interface{}
+	ipaddress optString
+	nicid     optString
 }
 
-func (p *AddIpToNicParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddIpToNicParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
+	if p.nicid.ok {
+		u.Set("nicid", p.nicid.v)
 	}
 	return u
 }
 
-func (p *AddIpToNicParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddIpToNicParams parses url.Values, for example taken from a raw API request,
+// into a new AddIpToNicParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddIpToNicParams(u url.Values) (*AddIpToNicParams, error) {
+	p := &AddIpToNicParams{}
+	if err := checkParamNames("addIpToNic", u, "ipaddress", "nicid"); err != nil {
+		return nil, err
 	}
-	p.p["ipaddress"] = v
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["nicid"]; found {
+		p.SetNicid(u.Get("nicid"))
+	}
+	return p, nil
+}
+
+// SetIpaddress sets the ipaddress param.
+func (p *AddIpToNicParams) SetIpaddress(v string) {
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *AddIpToNicParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *AddIpToNicParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetNicid sets the nicid param. This param is required.
 func (p *AddIpToNicParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicid"] = v
+	p.nicid = optString{v: v, ok: true}
 }
 
+// ResetNicid unsets the nicid param
 func (p *AddIpToNicParams) ResetNicid() {
-	if p.p != nil && p.p["nicid"] != nil {
-		delete(p.p, "nicid")
-	}
+	p.nicid = optString{}
 }
 
+// GetNicid returns the nicid param and if it is set
 func (p *AddIpToNicParams) GetNicid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.nicid.v, p.nicid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddIpToNicParams) Clone() *AddIpToNicParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddIpToNicParams) Equal(o *AddIpToNicParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.ipaddress == o.ipaddress &&
+		p.nicid == o.nicid
+}
+
+// serializedAddIpToNicParams is used to (un)marshal AddIpToNicParams using the API param names
+type serializedAddIpToNicParams struct {
+	Ipaddress *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Nicid     *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+}
+
+func (p *AddIpToNicParams) toSerialized() *serializedAddIpToNicParams {
+	s := &serializedAddIpToNicParams{}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.nicid.ok {
+		s.Nicid = &p.nicid.v
+	}
+	return s
+}
+
+func (p *AddIpToNicParams) fromSerialized(s *serializedAddIpToNicParams) {
+	*p = AddIpToNicParams{}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Nicid != nil {
+		p.SetNicid(*s.Nicid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddIpToNicParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddIpToNicParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddIpToNicParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddIpToNicParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddIpToNicParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddIpToNicParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["nicid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddIpToNicParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
 	p := &AddIpToNicParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
+	p.SetNicid(nicid)
 	return p
 }
 
-// Assigns secondary IP to NIC
-func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
-	resp, err := s.cs.newRequest("addIpToNic", p.toURLValues())
+// Assigns secondary IP to NIC.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: nicid.
+func (s *NicService) AddIpToNic(p *AddIpToNicParams, opts ...CallOption) (*AddIpToNicResponse, error) {
+	resp, err := s.cs.newRequest("addIpToNic", s.cs.encodeParams("addIpToNic", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +205,8 @@ func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -155,200 +242,324 @@ type AddIpToNicResponse struct {
 }
 
 type ListNicsParams struct {
-	p map[string]interface{}
+	fordisplay       optBool
+	keyword          optString
+	networkid        optString
+	nicid            optString
+	page             optInt
+	pagesize         optInt
+	virtualmachineid optString
 }
 
-func (p *ListNicsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNicsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
+	if p.nicid.ok {
+		u.Set("nicid", p.nicid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *ListNicsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNicsParams parses url.Values, for example taken from a raw API request,
+// into a new ListNicsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNicsParams(u url.Values) (*ListNicsParams, error) {
+	p := &ListNicsParams{}
+	if err := checkParamNames("listNics", u, "fordisplay", "keyword", "networkid", "nicid", "page", "pagesize", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
 	}
-	p.p["fordisplay"] = v
+	if _, found := u["nicid"]; found {
+		p.SetNicid(u.Get("nicid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetFordisplay sets the fordisplay param.
+func (p *ListNicsParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListNicsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListNicsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListNicsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNicsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNicsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListNicsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListNicsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListNicsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetNicid sets the nicid param.
 func (p *ListNicsParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicid"] = v
+	p.nicid = optString{v: v, ok: true}
 }
 
+// ResetNicid unsets the nicid param
 func (p *ListNicsParams) ResetNicid() {
-	if p.p != nil && p.p["nicid"] != nil {
-		delete(p.p, "nicid")
-	}
+	p.nicid = optString{}
 }
 
+// GetNicid returns the nicid param and if it is set
 func (p *ListNicsParams) GetNicid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nicid"].(string)
-	return value, ok
+	return p.nicid.v, p.nicid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNicsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNicsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNicsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNicsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNicsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNicsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *ListNicsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListNicsParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListNicsParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNicsParams) Clone() *ListNicsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNicsParams) Equal(o *ListNicsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.keyword == o.keyword &&
+		p.networkid == o.networkid &&
+		p.nicid == o.nicid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedListNicsParams is used to (un)marshal ListNicsParams using the API param names
+type serializedListNicsParams struct {
+	Fordisplay       *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Networkid        *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Nicid            *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *ListNicsParams) toSerialized() *serializedListNicsParams {
+	s := &serializedListNicsParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.nicid.ok {
+		s.Nicid = &p.nicid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *ListNicsParams) fromSerialized(s *serializedListNicsParams) {
+	*p = ListNicsParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Nicid != nil {
+		p.SetNicid(*s.Nicid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNicsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNicsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNicsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNicsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNicsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNicsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNicsParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewListNicsParams(virtualmachineid string) *ListNicsParams {
 	p := &ListNicsParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// list the vm nics  IP to NIC
-func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
-	resp, err := s.cs.newRequest("listNics", p.toURLValues())
+// list the vm nics IP to NIC.
+//
+// Required params: virtualmachineid.
+func (s *NicService) ListNics(p *ListNicsParams, opts ...CallOption) (*ListNicsResponse, error) {
+	resp, err := s.cs.newRequest("listNics", s.cs.encodeParams("listNics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -406,53 +617,133 @@ type Nic struct {
 }
 
 type RemoveIpFromNicParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RemoveIpFromNicParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveIpFromNicParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RemoveIpFromNicParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveIpFromNicParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveIpFromNicParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveIpFromNicParams(u url.Values) (*RemoveIpFromNicParams, error) {
+	p := &RemoveIpFromNicParams{}
+	if err := checkParamNames("removeIpFromNic", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RemoveIpFromNicParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RemoveIpFromNicParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveIpFromNicParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveIpFromNicParams) Clone() *RemoveIpFromNicParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveIpFromNicParams) Equal(o *RemoveIpFromNicParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRemoveIpFromNicParams is used to (un)marshal RemoveIpFromNicParams using the API param names
+type serializedRemoveIpFromNicParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RemoveIpFromNicParams) toSerialized() *serializedRemoveIpFromNicParams {
+	s := &serializedRemoveIpFromNicParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RemoveIpFromNicParams) fromSerialized(s *serializedRemoveIpFromNicParams) {
+	*p = RemoveIpFromNicParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveIpFromNicParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveIpFromNicParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveIpFromNicParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveIpFromNicParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveIpFromNicParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveIpFromNicParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveIpFromNicParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams {
 	p := &RemoveIpFromNicParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Removes secondary IP from the NIC.
-func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
-	resp, err := s.cs.newRequest("removeIpFromNic", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams, opts ...CallOption) (*RemoveIpFromNicResponse, error) {
+	resp, err := s.cs.newRequest("removeIpFromNic", s.cs.encodeParams("removeIpFromNic", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -463,8 +754,8 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -488,77 +779,163 @@ type RemoveIpFromNicResponse struct {
 }
 
 type UpdateVmNicIpParams struct {
-	p map[string]interface{}
+	ipaddress optString
+	nicid     optString
 }
 
-func (p *UpdateVmNicIpParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVmNicIpParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
+	if p.nicid.ok {
+		u.Set("nicid", p.nicid.v)
 	}
 	return u
 }
 
-func (p *UpdateVmNicIpParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVmNicIpParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVmNicIpParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVmNicIpParams(u url.Values) (*UpdateVmNicIpParams, error) {
+	p := &UpdateVmNicIpParams{}
+	if err := checkParamNames("updateVmNicIp", u, "ipaddress", "nicid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["nicid"]; found {
+		p.SetNicid(u.Get("nicid"))
 	}
-	p.p["ipaddress"] = v
+	return p, nil
 }
 
+// SetIpaddress sets the ipaddress param.
+func (p *UpdateVmNicIpParams) SetIpaddress(v string) {
+	p.ipaddress = optString{v: v, ok: true}
+}
+
+// ResetIpaddress unsets the ipaddress param
 func (p *UpdateVmNicIpParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *UpdateVmNicIpParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetNicid sets the nicid param. This param is required.
 func (p *UpdateVmNicIpParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicid"] = v
+	p.nicid = optString{v: v, ok: true}
 }
 
+// ResetNicid unsets the nicid param
 func (p *UpdateVmNicIpParams) ResetNicid() {
-	if p.p != nil && p.p["nicid"] != nil {
-		delete(p.p, "nicid")
-	}
+	p.nicid = optString{}
 }
 
+// GetNicid returns the nicid param and if it is set
 func (p *UpdateVmNicIpParams) GetNicid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.nicid.v, p.nicid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVmNicIpParams) Clone() *UpdateVmNicIpParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVmNicIpParams) Equal(o *UpdateVmNicIpParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["nicid"].(string)
-	return value, ok
+	return p.ipaddress == o.ipaddress &&
+		p.nicid == o.nicid
+}
+
+// serializedUpdateVmNicIpParams is used to (un)marshal UpdateVmNicIpParams using the API param names
+type serializedUpdateVmNicIpParams struct {
+	Ipaddress *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Nicid     *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+}
+
+func (p *UpdateVmNicIpParams) toSerialized() *serializedUpdateVmNicIpParams {
+	s := &serializedUpdateVmNicIpParams{}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.nicid.ok {
+		s.Nicid = &p.nicid.v
+	}
+	return s
+}
+
+func (p *UpdateVmNicIpParams) fromSerialized(s *serializedUpdateVmNicIpParams) {
+	*p = UpdateVmNicIpParams{}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Nicid != nil {
+		p.SetNicid(*s.Nicid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVmNicIpParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVmNicIpParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVmNicIpParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVmNicIpParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVmNicIpParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVmNicIpParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVmNicIpParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams {
 	p := &UpdateVmNicIpParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
+	p.SetNicid(nicid)
 	return p
 }
 
-// Update the default Ip of a VM Nic
-func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
-	resp, err := s.cs.newRequest("updateVmNicIp", p.toURLValues())
+// Update the default Ip of a VM Nic.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: nicid.
+func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams, opts ...CallOption) (*UpdateVmNicIpResponse, error) {
+	resp, err := s.cs.newRequest("updateVmNicIp", s.cs.encodeParams("updateVmNicIp", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -569,8 +946,8 @@ func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
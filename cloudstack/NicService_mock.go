@@ -53,33 +53,43 @@ func (m *MockNicServiceIface) EXPECT() *MockNicServiceIfaceMockRecorder {
 }
 
 // AddIpToNic mocks base method.
-func (m *MockNicServiceIface) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+func (m *MockNicServiceIface) AddIpToNic(p *AddIpToNicParams, opts ...CallOption) (*AddIpToNicResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddIpToNic", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddIpToNic", varargs...)
 	ret0, _ := ret[0].(*AddIpToNicResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddIpToNic indicates an expected call of AddIpToNic.
-func (mr *MockNicServiceIfaceMockRecorder) AddIpToNic(p interface{}) *gomock.Call {
+func (mr *MockNicServiceIfaceMockRecorder) AddIpToNic(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIpToNic", reflect.TypeOf((*MockNicServiceIface)(nil).AddIpToNic), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIpToNic", reflect.TypeOf((*MockNicServiceIface)(nil).AddIpToNic), varargs...)
 }
 
 // ListNics mocks base method.
-func (m *MockNicServiceIface) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
+func (m *MockNicServiceIface) ListNics(p *ListNicsParams, opts ...CallOption) (*ListNicsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNics", varargs...)
 	ret0, _ := ret[0].(*ListNicsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNics indicates an expected call of ListNics.
-func (mr *MockNicServiceIfaceMockRecorder) ListNics(p interface{}) *gomock.Call {
+func (mr *MockNicServiceIfaceMockRecorder) ListNics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNics", reflect.TypeOf((*MockNicServiceIface)(nil).ListNics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNics", reflect.TypeOf((*MockNicServiceIface)(nil).ListNics), varargs...)
 }
 
 // NewAddIpToNicParams mocks base method.
@@ -139,31 +149,41 @@ func (mr *MockNicServiceIfaceMockRecorder) NewUpdateVmNicIpParams(nicid interfac
 }
 
 // RemoveIpFromNic mocks base method.
-func (m *MockNicServiceIface) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+func (m *MockNicServiceIface) RemoveIpFromNic(p *RemoveIpFromNicParams, opts ...CallOption) (*RemoveIpFromNicResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveIpFromNic", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveIpFromNic", varargs...)
 	ret0, _ := ret[0].(*RemoveIpFromNicResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveIpFromNic indicates an expected call of RemoveIpFromNic.
-func (mr *MockNicServiceIfaceMockRecorder) RemoveIpFromNic(p interface{}) *gomock.Call {
+func (mr *MockNicServiceIfaceMockRecorder) RemoveIpFromNic(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIpFromNic", reflect.TypeOf((*MockNicServiceIface)(nil).RemoveIpFromNic), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIpFromNic", reflect.TypeOf((*MockNicServiceIface)(nil).RemoveIpFromNic), varargs...)
 }
 
 // UpdateVmNicIp mocks base method.
-func (m *MockNicServiceIface) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
+func (m *MockNicServiceIface) UpdateVmNicIp(p *UpdateVmNicIpParams, opts ...CallOption) (*UpdateVmNicIpResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVmNicIp", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVmNicIp", varargs...)
 	ret0, _ := ret[0].(*UpdateVmNicIpResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVmNicIp indicates an expected call of UpdateVmNicIp.
-func (mr *MockNicServiceIfaceMockRecorder) UpdateVmNicIp(p interface{}) *gomock.Call {
+func (mr *MockNicServiceIfaceMockRecorder) UpdateVmNicIp(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVmNicIp", reflect.TypeOf((*MockNicServiceIface)(nil).UpdateVmNicIp), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVmNicIp", reflect.TypeOf((*MockNicServiceIface)(nil).UpdateVmNicIp), varargs...)
 }
@@ -21,215 +21,333 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type NiciraNVPServiceIface interface {
-	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams, opts ...CallOption) (*AddNiciraNvpDeviceResponse, error)
 	NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams
-	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams, opts ...CallOption) (*DeleteNiciraNvpDeviceResponse, error)
 	NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams
-	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams, opts ...CallOption) (*ListNiciraNvpDevicesResponse, error)
 	NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams
 }
 
 type AddNiciraNvpDeviceParams struct {
-	p map[string]interface{}
+	hostname             optString
+	l2gatewayserviceuuid optString
+	l3gatewayserviceuuid optString
+	password             optString
+	physicalnetworkid    optString
+	transportzoneuuid    optString
+	username             optString
 }
 
-func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddNiciraNvpDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if p.hostname.ok {
+		u.Set("hostname", p.hostname.v)
 	}
-	if v, found := p.p["l2gatewayserviceuuid"]; found {
-		u.Set("l2gatewayserviceuuid", v.(string))
+	if p.l2gatewayserviceuuid.ok {
+		u.Set("l2gatewayserviceuuid", p.l2gatewayserviceuuid.v)
 	}
-	if v, found := p.p["l3gatewayserviceuuid"]; found {
-		u.Set("l3gatewayserviceuuid", v.(string))
+	if p.l3gatewayserviceuuid.ok {
+		u.Set("l3gatewayserviceuuid", p.l3gatewayserviceuuid.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["transportzoneuuid"]; found {
-		u.Set("transportzoneuuid", v.(string))
+	if p.transportzoneuuid.ok {
+		u.Set("transportzoneuuid", p.transportzoneuuid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddNiciraNvpDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new AddNiciraNvpDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddNiciraNvpDeviceParams(u url.Values) (*AddNiciraNvpDeviceParams, error) {
+	p := &AddNiciraNvpDeviceParams{}
+	if err := checkParamNames("addNiciraNvpDevice", u, "hostname", "l2gatewayserviceuuid", "l3gatewayserviceuuid", "password", "physicalnetworkid", "transportzoneuuid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostname"]; found {
+		p.SetHostname(u.Get("hostname"))
+	}
+	if _, found := u["l2gatewayserviceuuid"]; found {
+		p.SetL2gatewayserviceuuid(u.Get("l2gatewayserviceuuid"))
+	}
+	if _, found := u["l3gatewayserviceuuid"]; found {
+		p.SetL3gatewayserviceuuid(u.Get("l3gatewayserviceuuid"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["transportzoneuuid"]; found {
+		p.SetTransportzoneuuid(u.Get("transportzoneuuid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
 	}
-	p.p["hostname"] = v
+	return p, nil
 }
 
+// SetHostname sets the hostname param. This param is required.
+func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
+	p.hostname = optString{v: v, ok: true}
+}
+
+// ResetHostname unsets the hostname param
 func (p *AddNiciraNvpDeviceParams) ResetHostname() {
-	if p.p != nil && p.p["hostname"] != nil {
-		delete(p.p, "hostname")
-	}
+	p.hostname = optString{}
 }
 
+// GetHostname returns the hostname param and if it is set
 func (p *AddNiciraNvpDeviceParams) GetHostname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostname"].(string)
-	return value, ok
+	return p.hostname.v, p.hostname.ok
 }
 
+// SetL2gatewayserviceuuid sets the l2gatewayserviceuuid param.
 func (p *AddNiciraNvpDeviceParams) SetL2gatewayserviceuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["l2gatewayserviceuuid"] = v
+	p.l2gatewayserviceuuid = optString{v: v, ok: true}
 }
 
+// ResetL2gatewayserviceuuid unsets the l2gatewayserviceuuid param
 func (p *AddNiciraNvpDeviceParams) ResetL2gatewayserviceuuid() {
-	if p.p != nil && p.p["l2gatewayserviceuuid"] != nil {
-		delete(p.p, "l2gatewayserviceuuid")
-	}
+	p.l2gatewayserviceuuid = optString{}
 }
 
+// GetL2gatewayserviceuuid returns the l2gatewayserviceuuid param and if it is set
 func (p *AddNiciraNvpDeviceParams) GetL2gatewayserviceuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["l2gatewayserviceuuid"].(string)
-	return value, ok
+	return p.l2gatewayserviceuuid.v, p.l2gatewayserviceuuid.ok
 }
 
+// SetL3gatewayserviceuuid sets the l3gatewayserviceuuid param.
 func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["l3gatewayserviceuuid"] = v
+	p.l3gatewayserviceuuid = optString{v: v, ok: true}
 }
 
+// ResetL3gatewayserviceuuid unsets the l3gatewayserviceuuid param
 func (p *AddNiciraNvpDeviceParams) ResetL3gatewayserviceuuid() {
-	if p.p != nil && p.p["l3gatewayserviceuuid"] != nil {
-		delete(p.p, "l3gatewayserviceuuid")
-	}
+	p.l3gatewayserviceuuid = optString{}
 }
 
+// GetL3gatewayserviceuuid returns the l3gatewayserviceuuid param and if it is set
 func (p *AddNiciraNvpDeviceParams) GetL3gatewayserviceuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["l3gatewayserviceuuid"].(string)
-	return value, ok
+	return p.l3gatewayserviceuuid.v, p.l3gatewayserviceuuid.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddNiciraNvpDeviceParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddNiciraNvpDeviceParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddNiciraNvpDeviceParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddNiciraNvpDeviceParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddNiciraNvpDeviceParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetTransportzoneuuid sets the transportzoneuuid param. This param is required.
 func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["transportzoneuuid"] = v
+	p.transportzoneuuid = optString{v: v, ok: true}
 }
 
+// ResetTransportzoneuuid unsets the transportzoneuuid param
 func (p *AddNiciraNvpDeviceParams) ResetTransportzoneuuid() {
-	if p.p != nil && p.p["transportzoneuuid"] != nil {
-		delete(p.p, "transportzoneuuid")
-	}
+	p.transportzoneuuid = optString{}
 }
 
+// GetTransportzoneuuid returns the transportzoneuuid param and if it is set
 func (p *AddNiciraNvpDeviceParams) GetTransportzoneuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["transportzoneuuid"].(string)
-	return value, ok
+	return p.transportzoneuuid.v, p.transportzoneuuid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddNiciraNvpDeviceParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddNiciraNvpDeviceParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddNiciraNvpDeviceParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddNiciraNvpDeviceParams) Clone() *AddNiciraNvpDeviceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddNiciraNvpDeviceParams) Equal(o *AddNiciraNvpDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostname == o.hostname &&
+		p.l2gatewayserviceuuid == o.l2gatewayserviceuuid &&
+		p.l3gatewayserviceuuid == o.l3gatewayserviceuuid &&
+		p.password == o.password &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.transportzoneuuid == o.transportzoneuuid &&
+		p.username == o.username
+}
+
+// serializedAddNiciraNvpDeviceParams is used to (un)marshal AddNiciraNvpDeviceParams using the API param names
+type serializedAddNiciraNvpDeviceParams struct {
+	Hostname             *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	L2gatewayserviceuuid *string `json:"l2gatewayserviceuuid,omitempty" yaml:"l2gatewayserviceuuid,omitempty"`
+	L3gatewayserviceuuid *string `json:"l3gatewayserviceuuid,omitempty" yaml:"l3gatewayserviceuuid,omitempty"`
+	Password             *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid    *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Transportzoneuuid    *string `json:"transportzoneuuid,omitempty" yaml:"transportzoneuuid,omitempty"`
+	Username             *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddNiciraNvpDeviceParams) toSerialized() *serializedAddNiciraNvpDeviceParams {
+	s := &serializedAddNiciraNvpDeviceParams{}
+	if p.hostname.ok {
+		s.Hostname = &p.hostname.v
+	}
+	if p.l2gatewayserviceuuid.ok {
+		s.L2gatewayserviceuuid = &p.l2gatewayserviceuuid.v
+	}
+	if p.l3gatewayserviceuuid.ok {
+		s.L3gatewayserviceuuid = &p.l3gatewayserviceuuid.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.transportzoneuuid.ok {
+		s.Transportzoneuuid = &p.transportzoneuuid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddNiciraNvpDeviceParams) fromSerialized(s *serializedAddNiciraNvpDeviceParams) {
+	*p = AddNiciraNvpDeviceParams{}
+	if s.Hostname != nil {
+		p.SetHostname(*s.Hostname)
+	}
+	if s.L2gatewayserviceuuid != nil {
+		p.SetL2gatewayserviceuuid(*s.L2gatewayserviceuuid)
+	}
+	if s.L3gatewayserviceuuid != nil {
+		p.SetL3gatewayserviceuuid(*s.L3gatewayserviceuuid)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Transportzoneuuid != nil {
+		p.SetTransportzoneuuid(*s.Transportzoneuuid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddNiciraNvpDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddNiciraNvpDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddNiciraNvpDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddNiciraNvpDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddNiciraNvpDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddNiciraNvpDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddNiciraNvpDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams {
 	p := &AddNiciraNvpDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["transportzoneuuid"] = transportzoneuuid
-	p.p["username"] = username
+	p.SetHostname(hostname)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetTransportzoneuuid(transportzoneuuid)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds a Nicira NVP device
-func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNiciraNvpDevice", p.toURLValues())
+// Adds a Nicira NVP device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostname, password,
+// physicalnetworkid, transportzoneuuid, username.
+func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams, opts ...CallOption) (*AddNiciraNvpDeviceResponse, error) {
+	resp, err := s.cs.newRequest("addNiciraNvpDevice", s.cs.encodeParams("addNiciraNvpDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -240,8 +358,8 @@ func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*Add
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -276,53 +394,133 @@ type AddNiciraNvpDeviceResponse struct {
 }
 
 type DeleteNiciraNvpDeviceParams struct {
-	p map[string]interface{}
+	nvpdeviceid optString
 }
 
-func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteNiciraNvpDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["nvpdeviceid"]; found {
-		u.Set("nvpdeviceid", v.(string))
+	if p.nvpdeviceid.ok {
+		u.Set("nvpdeviceid", p.nvpdeviceid.v)
 	}
 	return u
 }
 
-func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteNiciraNvpDeviceParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteNiciraNvpDeviceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteNiciraNvpDeviceParams(u url.Values) (*DeleteNiciraNvpDeviceParams, error) {
+	p := &DeleteNiciraNvpDeviceParams{}
+	if err := checkParamNames("deleteNiciraNvpDevice", u, "nvpdeviceid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["nvpdeviceid"]; found {
+		p.SetNvpdeviceid(u.Get("nvpdeviceid"))
 	}
-	p.p["nvpdeviceid"] = v
+	return p, nil
+}
+
+// SetNvpdeviceid sets the nvpdeviceid param. This param is required.
+func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
+	p.nvpdeviceid = optString{v: v, ok: true}
 }
 
+// ResetNvpdeviceid unsets the nvpdeviceid param
 func (p *DeleteNiciraNvpDeviceParams) ResetNvpdeviceid() {
-	if p.p != nil && p.p["nvpdeviceid"] != nil {
-		delete(p.p, "nvpdeviceid")
-	}
+	p.nvpdeviceid = optString{}
 }
 
+// GetNvpdeviceid returns the nvpdeviceid param and if it is set
 func (p *DeleteNiciraNvpDeviceParams) GetNvpdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.nvpdeviceid.v, p.nvpdeviceid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteNiciraNvpDeviceParams) Clone() *DeleteNiciraNvpDeviceParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["nvpdeviceid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteNiciraNvpDeviceParams) Equal(o *DeleteNiciraNvpDeviceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.nvpdeviceid == o.nvpdeviceid
+}
+
+// serializedDeleteNiciraNvpDeviceParams is used to (un)marshal DeleteNiciraNvpDeviceParams using the API param names
+type serializedDeleteNiciraNvpDeviceParams struct {
+	Nvpdeviceid *string `json:"nvpdeviceid,omitempty" yaml:"nvpdeviceid,omitempty"`
+}
+
+func (p *DeleteNiciraNvpDeviceParams) toSerialized() *serializedDeleteNiciraNvpDeviceParams {
+	s := &serializedDeleteNiciraNvpDeviceParams{}
+	if p.nvpdeviceid.ok {
+		s.Nvpdeviceid = &p.nvpdeviceid.v
+	}
+	return s
+}
+
+func (p *DeleteNiciraNvpDeviceParams) fromSerialized(s *serializedDeleteNiciraNvpDeviceParams) {
+	*p = DeleteNiciraNvpDeviceParams{}
+	if s.Nvpdeviceid != nil {
+		p.SetNvpdeviceid(*s.Nvpdeviceid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteNiciraNvpDeviceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteNiciraNvpDeviceParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteNiciraNvpDeviceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteNiciraNvpDeviceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteNiciraNvpDeviceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteNiciraNvpDeviceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteNiciraNvpDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams {
 	p := &DeleteNiciraNvpDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["nvpdeviceid"] = nvpdeviceid
+	p.SetNvpdeviceid(nvpdeviceid)
 	return p
 }
 
-// delete a nicira nvp device
-func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", p.toURLValues())
+// delete a nicira nvp device.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: nvpdeviceid.
+func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams, opts ...CallOption) (*DeleteNiciraNvpDeviceResponse, error) {
+	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", s.cs.encodeParams("deleteNiciraNvpDevice", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -333,8 +531,8 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -358,150 +556,257 @@ type DeleteNiciraNvpDeviceResponse struct {
 }
 
 type ListNiciraNvpDevicesParams struct {
-	p map[string]interface{}
+	keyword           optString
+	nvpdeviceid       optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
 }
 
-func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListNiciraNvpDevicesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["nvpdeviceid"]; found {
-		u.Set("nvpdeviceid", v.(string))
+	if p.nvpdeviceid.ok {
+		u.Set("nvpdeviceid", p.nvpdeviceid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListNiciraNvpDevicesParams parses url.Values, for example taken from a raw API request,
+// into a new ListNiciraNvpDevicesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListNiciraNvpDevicesParams(u url.Values) (*ListNiciraNvpDevicesParams, error) {
+	p := &ListNiciraNvpDevicesParams{}
+	if err := checkParamNames("listNiciraNvpDevices", u, "keyword", "nvpdeviceid", "page", "pagesize", "physicalnetworkid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["nvpdeviceid"]; found {
+		p.SetNvpdeviceid(u.Get("nvpdeviceid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
 	}
-	p.p["keyword"] = v
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListNiciraNvpDevicesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListNiciraNvpDevicesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNvpdeviceid sets the nvpdeviceid param.
 func (p *ListNiciraNvpDevicesParams) SetNvpdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nvpdeviceid"] = v
+	p.nvpdeviceid = optString{v: v, ok: true}
 }
 
+// ResetNvpdeviceid unsets the nvpdeviceid param
 func (p *ListNiciraNvpDevicesParams) ResetNvpdeviceid() {
-	if p.p != nil && p.p["nvpdeviceid"] != nil {
-		delete(p.p, "nvpdeviceid")
-	}
+	p.nvpdeviceid = optString{}
 }
 
+// GetNvpdeviceid returns the nvpdeviceid param and if it is set
 func (p *ListNiciraNvpDevicesParams) GetNvpdeviceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nvpdeviceid"].(string)
-	return value, ok
+	return p.nvpdeviceid.v, p.nvpdeviceid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListNiciraNvpDevicesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListNiciraNvpDevicesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListNiciraNvpDevicesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListNiciraNvpDevicesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListNiciraNvpDevicesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListNiciraNvpDevicesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListNiciraNvpDevicesParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListNiciraNvpDevicesParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListNiciraNvpDevicesParams) Clone() *ListNiciraNvpDevicesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListNiciraNvpDevicesParams) Equal(o *ListNiciraNvpDevicesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.nvpdeviceid == o.nvpdeviceid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListNiciraNvpDevicesParams is used to (un)marshal ListNiciraNvpDevicesParams using the API param names
+type serializedListNiciraNvpDevicesParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nvpdeviceid       *string `json:"nvpdeviceid,omitempty" yaml:"nvpdeviceid,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListNiciraNvpDevicesParams) toSerialized() *serializedListNiciraNvpDevicesParams {
+	s := &serializedListNiciraNvpDevicesParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.nvpdeviceid.ok {
+		s.Nvpdeviceid = &p.nvpdeviceid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListNiciraNvpDevicesParams) fromSerialized(s *serializedListNiciraNvpDevicesParams) {
+	*p = ListNiciraNvpDevicesParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Nvpdeviceid != nil {
+		p.SetNvpdeviceid(*s.Nvpdeviceid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListNiciraNvpDevicesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListNiciraNvpDevicesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListNiciraNvpDevicesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListNiciraNvpDevicesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListNiciraNvpDevicesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListNiciraNvpDevicesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListNiciraNvpDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *NiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams {
 	p := &ListNiciraNvpDevicesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists Nicira NVP devices
-func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDevices", p.toURLValues())
+// Lists Nicira NVP devices.
+func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams, opts ...CallOption) (*ListNiciraNvpDevicesResponse, error) {
+	resp, err := s.cs.newRequest("listNiciraNvpDevices", s.cs.encodeParams("listNiciraNvpDevices", p), opts...)
 	if err != nil {
 		return nil, err
 	}
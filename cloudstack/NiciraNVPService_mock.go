@@ -53,48 +53,63 @@ func (m *MockNiciraNVPServiceIface) EXPECT() *MockNiciraNVPServiceIfaceMockRecor
 }
 
 // AddNiciraNvpDevice mocks base method.
-func (m *MockNiciraNVPServiceIface) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
+func (m *MockNiciraNVPServiceIface) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams, opts ...CallOption) (*AddNiciraNvpDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddNiciraNvpDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddNiciraNvpDevice", varargs...)
 	ret0, _ := ret[0].(*AddNiciraNvpDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddNiciraNvpDevice indicates an expected call of AddNiciraNvpDevice.
-func (mr *MockNiciraNVPServiceIfaceMockRecorder) AddNiciraNvpDevice(p interface{}) *gomock.Call {
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) AddNiciraNvpDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNiciraNvpDevice", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).AddNiciraNvpDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNiciraNvpDevice", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).AddNiciraNvpDevice), varargs...)
 }
 
 // DeleteNiciraNvpDevice mocks base method.
-func (m *MockNiciraNVPServiceIface) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
+func (m *MockNiciraNVPServiceIface) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams, opts ...CallOption) (*DeleteNiciraNvpDeviceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNiciraNvpDevice", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNiciraNvpDevice", varargs...)
 	ret0, _ := ret[0].(*DeleteNiciraNvpDeviceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNiciraNvpDevice indicates an expected call of DeleteNiciraNvpDevice.
-func (mr *MockNiciraNVPServiceIfaceMockRecorder) DeleteNiciraNvpDevice(p interface{}) *gomock.Call {
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) DeleteNiciraNvpDevice(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNiciraNvpDevice", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).DeleteNiciraNvpDevice), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNiciraNvpDevice", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).DeleteNiciraNvpDevice), varargs...)
 }
 
 // ListNiciraNvpDevices mocks base method.
-func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams, opts ...CallOption) (*ListNiciraNvpDevicesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNiciraNvpDevices", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDevices", varargs...)
 	ret0, _ := ret[0].(*ListNiciraNvpDevicesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNiciraNvpDevices indicates an expected call of ListNiciraNvpDevices.
-func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevices(p interface{}) *gomock.Call {
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevices(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevices", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevices), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevices", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevices), varargs...)
 }
 
 // NewAddNiciraNvpDeviceParams mocks base method.
@@ -0,0 +1,2702 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ObjectStoreServiceIface interface {
+	AddObjectStoragePool(p *AddObjectStoragePoolParams, opts ...CallOption) (*AddObjectStoragePoolResponse, error)
+	NewAddObjectStoragePoolParams(name string, provider string, url string) *AddObjectStoragePoolParams
+	CreateBucket(p *CreateBucketParams, opts ...CallOption) (*CreateBucketResponse, error)
+	NewCreateBucketParams(name string, objectstorageid string) *CreateBucketParams
+	DeleteBucket(p *DeleteBucketParams, opts ...CallOption) (*DeleteBucketResponse, error)
+	NewDeleteBucketParams(id string) *DeleteBucketParams
+	DeleteObjectStoragePool(p *DeleteObjectStoragePoolParams, opts ...CallOption) (*DeleteObjectStoragePoolResponse, error)
+	NewDeleteObjectStoragePoolParams(id string) *DeleteObjectStoragePoolParams
+	ListBuckets(p *ListBucketsParams, opts ...CallOption) (*ListBucketsResponse, error)
+	NewListBucketsParams() *ListBucketsParams
+	GetBucketID(name string, opts ...OptionFunc) (string, int, error)
+	GetBucketByName(name string, opts ...OptionFunc) (*Bucket, int, error)
+	GetBucketByID(id string, opts ...OptionFunc) (*Bucket, int, error)
+	ListObjectStoragePools(p *ListObjectStoragePoolsParams, opts ...CallOption) (*ListObjectStoragePoolsResponse, error)
+	NewListObjectStoragePoolsParams() *ListObjectStoragePoolsParams
+	GetObjectStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
+	GetObjectStoragePoolByName(name string, opts ...OptionFunc) (*ObjectStoragePool, int, error)
+	GetObjectStoragePoolByID(id string, opts ...OptionFunc) (*ObjectStoragePool, int, error)
+	UpdateBucket(p *UpdateBucketParams, opts ...CallOption) (*UpdateBucketResponse, error)
+	NewUpdateBucketParams(id string) *UpdateBucketParams
+	UpdateObjectStoragePool(p *UpdateObjectStoragePoolParams, opts ...CallOption) (*UpdateObjectStoragePoolResponse, error)
+	NewUpdateObjectStoragePoolParams(id string) *UpdateObjectStoragePoolParams
+}
+
+type AddObjectStoragePoolParams struct {
+	details  optStringMap
+	name     optString
+	provider optString
+	tags     optString
+	url      optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddObjectStoragePoolParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.details.ok {
+		m := p.details.v
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].key", i), k)
+			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
+		}
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
+	}
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
+	}
+	if p.url.ok {
+		u.Set("url", p.url.v)
+	}
+	return u
+}
+
+// ParseAddObjectStoragePoolParams parses url.Values, for example taken from a raw API request,
+// into a new AddObjectStoragePoolParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddObjectStoragePoolParams(u url.Values) (*AddObjectStoragePoolParams, error) {
+	p := &AddObjectStoragePoolParams{}
+	if err := checkParamNames("addObjectStoragePool", u, "details[]", "name", "provider", "tags", "url"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	return p, nil
+}
+
+// SetDetails sets the details param.
+func (p *AddObjectStoragePoolParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
+}
+
+// ResetDetails unsets the details param
+func (p *AddObjectStoragePoolParams) ResetDetails() {
+	p.details = optStringMap{}
+}
+
+// GetDetails returns the details param and if it is set
+func (p *AddObjectStoragePoolParams) GetDetails() (map[string]string, bool) {
+	return p.details.v, p.details.ok
+}
+
+// SetName sets the name param. This param is required.
+func (p *AddObjectStoragePoolParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *AddObjectStoragePoolParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *AddObjectStoragePoolParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetProvider sets the provider param. This param is required.
+func (p *AddObjectStoragePoolParams) SetProvider(v string) {
+	p.provider = optString{v: v, ok: true}
+}
+
+// ResetProvider unsets the provider param
+func (p *AddObjectStoragePoolParams) ResetProvider() {
+	p.provider = optString{}
+}
+
+// GetProvider returns the provider param and if it is set
+func (p *AddObjectStoragePoolParams) GetProvider() (string, bool) {
+	return p.provider.v, p.provider.ok
+}
+
+// SetTags sets the tags param.
+func (p *AddObjectStoragePoolParams) SetTags(v string) {
+	p.tags = optString{v: v, ok: true}
+}
+
+// ResetTags unsets the tags param
+func (p *AddObjectStoragePoolParams) ResetTags() {
+	p.tags = optString{}
+}
+
+// GetTags returns the tags param and if it is set
+func (p *AddObjectStoragePoolParams) GetTags() (string, bool) {
+	return p.tags.v, p.tags.ok
+}
+
+// SetUrl sets the url param. This param is required.
+func (p *AddObjectStoragePoolParams) SetUrl(v string) {
+	p.url = optString{v: v, ok: true}
+}
+
+// ResetUrl unsets the url param
+func (p *AddObjectStoragePoolParams) ResetUrl() {
+	p.url = optString{}
+}
+
+// GetUrl returns the url param and if it is set
+func (p *AddObjectStoragePoolParams) GetUrl() (string, bool) {
+	return p.url.v, p.url.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddObjectStoragePoolParams) Clone() *AddObjectStoragePoolParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddObjectStoragePoolParams) Equal(o *AddObjectStoragePoolParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.name == o.name &&
+		p.provider == o.provider &&
+		p.tags == o.tags &&
+		p.url == o.url
+}
+
+// serializedAddObjectStoragePoolParams is used to (un)marshal AddObjectStoragePoolParams using the API param names
+type serializedAddObjectStoragePoolParams struct {
+	Details  *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Name     *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Provider *string            `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Tags     *string            `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Url      *string            `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+func (p *AddObjectStoragePoolParams) toSerialized() *serializedAddObjectStoragePoolParams {
+	s := &serializedAddObjectStoragePoolParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	return s
+}
+
+func (p *AddObjectStoragePoolParams) fromSerialized(s *serializedAddObjectStoragePoolParams) {
+	*p = AddObjectStoragePoolParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddObjectStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddObjectStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddObjectStoragePoolParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddObjectStoragePoolParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddObjectStoragePoolParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddObjectStoragePoolParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new AddObjectStoragePoolParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewAddObjectStoragePoolParams(name string, provider string, url string) *AddObjectStoragePoolParams {
+	p := &AddObjectStoragePoolParams{}
+	p.SetName(name)
+	p.SetProvider(provider)
+	p.SetUrl(url)
+	return p
+}
+
+// Adds a object storage pool.
+//
+// Required params: name, provider, url.
+func (s *ObjectStoreService) AddObjectStoragePool(p *AddObjectStoragePoolParams, opts ...CallOption) (*AddObjectStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest("addObjectStoragePool", s.cs.encodeParams("addObjectStoragePool", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r AddObjectStoragePoolResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type AddObjectStoragePoolResponse struct {
+	Hasannotations bool   `json:"hasannotations"`
+	Id             string `json:"id"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Name           string `json:"name"`
+	Providername   string `json:"providername"`
+	Storagetotal   int64  `json:"storagetotal"`
+	Storageused    int64  `json:"storageused"`
+	Url            string `json:"url"`
+}
+
+type CreateBucketParams struct {
+	account         optString
+	domainid        optString
+	encryption      optBool
+	name            optString
+	objectlocking   optBool
+	objectstorageid optString
+	policy          optString
+	projectid       optString
+	quota           optInt
+	versioning      optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateBucketParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.encryption.ok {
+		u.Set("encryption", strconv.FormatBool(p.encryption.v))
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.objectlocking.ok {
+		u.Set("objectlocking", strconv.FormatBool(p.objectlocking.v))
+	}
+	if p.objectstorageid.ok {
+		u.Set("objectstorageid", p.objectstorageid.v)
+	}
+	if p.policy.ok {
+		u.Set("policy", p.policy.v)
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	if p.quota.ok {
+		u.Set("quota", strconv.Itoa(p.quota.v))
+	}
+	if p.versioning.ok {
+		u.Set("versioning", strconv.FormatBool(p.versioning.v))
+	}
+	return u
+}
+
+// ParseCreateBucketParams parses url.Values, for example taken from a raw API request,
+// into a new CreateBucketParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateBucketParams(u url.Values) (*CreateBucketParams, error) {
+	p := &CreateBucketParams{}
+	if err := checkParamNames("createBucket", u, "account", "domainid", "encryption", "name", "objectlocking", "objectstorageid", "policy", "projectid", "quota", "versioning"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["encryption"]; found {
+		v, err := strconv.ParseBool(u.Get("encryption"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param encryption: %v", err)
+		}
+		p.SetEncryption(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["objectlocking"]; found {
+		v, err := strconv.ParseBool(u.Get("objectlocking"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param objectlocking: %v", err)
+		}
+		p.SetObjectlocking(v)
+	}
+	if _, found := u["objectstorageid"]; found {
+		p.SetObjectstorageid(u.Get("objectstorageid"))
+	}
+	if _, found := u["policy"]; found {
+		p.SetPolicy(u.Get("policy"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["quota"]; found {
+		v, err := strconv.Atoi(u.Get("quota"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param quota: %v", err)
+		}
+		p.SetQuota(v)
+	}
+	if _, found := u["versioning"]; found {
+		v, err := strconv.ParseBool(u.Get("versioning"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param versioning: %v", err)
+		}
+		p.SetVersioning(v)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateBucketParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *CreateBucketParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *CreateBucketParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *CreateBucketParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *CreateBucketParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *CreateBucketParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetEncryption sets the encryption param.
+func (p *CreateBucketParams) SetEncryption(v bool) {
+	p.encryption = optBool{v: v, ok: true}
+}
+
+// ResetEncryption unsets the encryption param
+func (p *CreateBucketParams) ResetEncryption() {
+	p.encryption = optBool{}
+}
+
+// GetEncryption returns the encryption param and if it is set
+func (p *CreateBucketParams) GetEncryption() (bool, bool) {
+	return p.encryption.v, p.encryption.ok
+}
+
+// SetName sets the name param. This param is required.
+func (p *CreateBucketParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *CreateBucketParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *CreateBucketParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetObjectlocking sets the objectlocking param.
+func (p *CreateBucketParams) SetObjectlocking(v bool) {
+	p.objectlocking = optBool{v: v, ok: true}
+}
+
+// ResetObjectlocking unsets the objectlocking param
+func (p *CreateBucketParams) ResetObjectlocking() {
+	p.objectlocking = optBool{}
+}
+
+// GetObjectlocking returns the objectlocking param and if it is set
+func (p *CreateBucketParams) GetObjectlocking() (bool, bool) {
+	return p.objectlocking.v, p.objectlocking.ok
+}
+
+// SetObjectstorageid sets the objectstorageid param. This param is required.
+func (p *CreateBucketParams) SetObjectstorageid(v string) {
+	p.objectstorageid = optString{v: v, ok: true}
+}
+
+// ResetObjectstorageid unsets the objectstorageid param
+func (p *CreateBucketParams) ResetObjectstorageid() {
+	p.objectstorageid = optString{}
+}
+
+// GetObjectstorageid returns the objectstorageid param and if it is set
+func (p *CreateBucketParams) GetObjectstorageid() (string, bool) {
+	return p.objectstorageid.v, p.objectstorageid.ok
+}
+
+// SetPolicy sets the policy param.
+func (p *CreateBucketParams) SetPolicy(v string) {
+	p.policy = optString{v: v, ok: true}
+}
+
+// ResetPolicy unsets the policy param
+func (p *CreateBucketParams) ResetPolicy() {
+	p.policy = optString{}
+}
+
+// GetPolicy returns the policy param and if it is set
+func (p *CreateBucketParams) GetPolicy() (string, bool) {
+	return p.policy.v, p.policy.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *CreateBucketParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *CreateBucketParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *CreateBucketParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetQuota sets the quota param.
+func (p *CreateBucketParams) SetQuota(v int) {
+	p.quota = optInt{v: v, ok: true}
+}
+
+// ResetQuota unsets the quota param
+func (p *CreateBucketParams) ResetQuota() {
+	p.quota = optInt{}
+}
+
+// GetQuota returns the quota param and if it is set
+func (p *CreateBucketParams) GetQuota() (int, bool) {
+	return p.quota.v, p.quota.ok
+}
+
+// SetVersioning sets the versioning param.
+func (p *CreateBucketParams) SetVersioning(v bool) {
+	p.versioning = optBool{v: v, ok: true}
+}
+
+// ResetVersioning unsets the versioning param
+func (p *CreateBucketParams) ResetVersioning() {
+	p.versioning = optBool{}
+}
+
+// GetVersioning returns the versioning param and if it is set
+func (p *CreateBucketParams) GetVersioning() (bool, bool) {
+	return p.versioning.v, p.versioning.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateBucketParams) Clone() *CreateBucketParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateBucketParams) Equal(o *CreateBucketParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.encryption == o.encryption &&
+		p.name == o.name &&
+		p.objectlocking == o.objectlocking &&
+		p.objectstorageid == o.objectstorageid &&
+		p.policy == o.policy &&
+		p.projectid == o.projectid &&
+		p.quota == o.quota &&
+		p.versioning == o.versioning
+}
+
+// serializedCreateBucketParams is used to (un)marshal CreateBucketParams using the API param names
+type serializedCreateBucketParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Encryption      *bool   `json:"encryption,omitempty" yaml:"encryption,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Objectlocking   *bool   `json:"objectlocking,omitempty" yaml:"objectlocking,omitempty"`
+	Objectstorageid *string `json:"objectstorageid,omitempty" yaml:"objectstorageid,omitempty"`
+	Policy          *string `json:"policy,omitempty" yaml:"policy,omitempty"`
+	Projectid       *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Quota           *int    `json:"quota,omitempty" yaml:"quota,omitempty"`
+	Versioning      *bool   `json:"versioning,omitempty" yaml:"versioning,omitempty"`
+}
+
+func (p *CreateBucketParams) toSerialized() *serializedCreateBucketParams {
+	s := &serializedCreateBucketParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.encryption.ok {
+		s.Encryption = &p.encryption.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.objectlocking.ok {
+		s.Objectlocking = &p.objectlocking.v
+	}
+	if p.objectstorageid.ok {
+		s.Objectstorageid = &p.objectstorageid.v
+	}
+	if p.policy.ok {
+		s.Policy = &p.policy.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.quota.ok {
+		s.Quota = &p.quota.v
+	}
+	if p.versioning.ok {
+		s.Versioning = &p.versioning.v
+	}
+	return s
+}
+
+func (p *CreateBucketParams) fromSerialized(s *serializedCreateBucketParams) {
+	*p = CreateBucketParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Encryption != nil {
+		p.SetEncryption(*s.Encryption)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Objectlocking != nil {
+		p.SetObjectlocking(*s.Objectlocking)
+	}
+	if s.Objectstorageid != nil {
+		p.SetObjectstorageid(*s.Objectstorageid)
+	}
+	if s.Policy != nil {
+		p.SetPolicy(*s.Policy)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Quota != nil {
+		p.SetQuota(*s.Quota)
+	}
+	if s.Versioning != nil {
+		p.SetVersioning(*s.Versioning)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateBucketParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateBucketParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateBucketParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateBucketParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateBucketParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateBucketParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateBucketParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewCreateBucketParams(name string, objectstorageid string) *CreateBucketParams {
+	p := &CreateBucketParams{}
+	p.SetName(name)
+	p.SetObjectstorageid(objectstorageid)
+	return p
+}
+
+// Creates a bucket in the specified object storage pool.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: name, objectstorageid.
+func (s *ObjectStoreService) CreateBucket(p *CreateBucketParams, opts ...CallOption) (*CreateBucketResponse, error) {
+	resp, err := s.cs.newRequest("createBucket", s.cs.encodeParams("createBucket", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateBucketResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type CreateBucketResponse struct {
+	Accesskey       string `json:"accesskey"`
+	Account         string `json:"account"`
+	Created         string `json:"created"`
+	Domain          string `json:"domain"`
+	Domainid        string `json:"domainid"`
+	Encryption      bool   `json:"encryption"`
+	Id              string `json:"id"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Name            string `json:"name"`
+	Objectlocking   bool   `json:"objectlocking"`
+	Objectstorageid string `json:"objectstorageid"`
+	Objectstore     string `json:"objectstore"`
+	Policy          string `json:"policy"`
+	Project         string `json:"project"`
+	Projectid       string `json:"projectid"`
+	Provider        string `json:"provider"`
+	Quota           int    `json:"quota"`
+	Size            int64  `json:"size"`
+	State           string `json:"state"`
+	Tags            []Tags `json:"tags"`
+	Url             string `json:"url"`
+	Usersecretkey   string `json:"usersecretkey"`
+	Versioning      bool   `json:"versioning"`
+}
+
+type DeleteBucketParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteBucketParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseDeleteBucketParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteBucketParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteBucketParams(u url.Values) (*DeleteBucketParams, error) {
+	p := &DeleteBucketParams{}
+	if err := checkParamNames("deleteBucket", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteBucketParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteBucketParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteBucketParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteBucketParams) Clone() *DeleteBucketParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteBucketParams) Equal(o *DeleteBucketParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteBucketParams is used to (un)marshal DeleteBucketParams using the API param names
+type serializedDeleteBucketParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteBucketParams) toSerialized() *serializedDeleteBucketParams {
+	s := &serializedDeleteBucketParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteBucketParams) fromSerialized(s *serializedDeleteBucketParams) {
+	*p = DeleteBucketParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteBucketParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteBucketParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteBucketParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteBucketParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteBucketParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteBucketParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteBucketParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewDeleteBucketParams(id string) *DeleteBucketParams {
+	p := &DeleteBucketParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes an empty Bucket.
+//
+// Required params: id.
+func (s *ObjectStoreService) DeleteBucket(p *DeleteBucketParams, opts ...CallOption) (*DeleteBucketResponse, error) {
+	resp, err := s.cs.newRequest("deleteBucket", s.cs.encodeParams("deleteBucket", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBucketResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteBucketResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteBucketResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteBucketResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteObjectStoragePoolParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteObjectStoragePoolParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseDeleteObjectStoragePoolParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteObjectStoragePoolParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteObjectStoragePoolParams(u url.Values) (*DeleteObjectStoragePoolParams, error) {
+	p := &DeleteObjectStoragePoolParams{}
+	if err := checkParamNames("deleteObjectStoragePool", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteObjectStoragePoolParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteObjectStoragePoolParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteObjectStoragePoolParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteObjectStoragePoolParams) Clone() *DeleteObjectStoragePoolParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteObjectStoragePoolParams) Equal(o *DeleteObjectStoragePoolParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteObjectStoragePoolParams is used to (un)marshal DeleteObjectStoragePoolParams using the API param names
+type serializedDeleteObjectStoragePoolParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteObjectStoragePoolParams) toSerialized() *serializedDeleteObjectStoragePoolParams {
+	s := &serializedDeleteObjectStoragePoolParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteObjectStoragePoolParams) fromSerialized(s *serializedDeleteObjectStoragePoolParams) {
+	*p = DeleteObjectStoragePoolParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteObjectStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteObjectStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteObjectStoragePoolParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteObjectStoragePoolParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteObjectStoragePoolParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteObjectStoragePoolParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteObjectStoragePoolParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewDeleteObjectStoragePoolParams(id string) *DeleteObjectStoragePoolParams {
+	p := &DeleteObjectStoragePoolParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes an Object Storage Pool.
+//
+// Required params: id.
+func (s *ObjectStoreService) DeleteObjectStoragePool(p *DeleteObjectStoragePoolParams, opts ...CallOption) (*DeleteObjectStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest("deleteObjectStoragePool", s.cs.encodeParams("deleteObjectStoragePool", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteObjectStoragePoolResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteObjectStoragePoolResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteObjectStoragePoolResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteObjectStoragePoolResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ListBucketsParams struct {
+	account         optString
+	domainid        optString
+	id              optString
+	ids             optStrings
+	isrecursive     optBool
+	keyword         optString
+	listall         optBool
+	name            optString
+	objectstorageid optString
+	page            optInt
+	pagesize        optInt
+	projectid       optString
+	tags            optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListBucketsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
+	}
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.objectstorageid.ok {
+		u.Set("objectstorageid", p.objectstorageid.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	if p.tags.ok {
+		m := p.tags.v
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	return u
+}
+
+// ParseListBucketsParams parses url.Values, for example taken from a raw API request,
+// into a new ListBucketsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListBucketsParams(u url.Values) (*ListBucketsParams, error) {
+	p := &ListBucketsParams{}
+	if err := checkParamNames("listBuckets", u, "account", "domainid", "id", "ids", "isrecursive", "keyword", "listall", "name", "objectstorageid", "page", "pagesize", "projectid", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["objectstorageid"]; found {
+		p.SetObjectstorageid(u.Get("objectstorageid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListBucketsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *ListBucketsParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *ListBucketsParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *ListBucketsParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *ListBucketsParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *ListBucketsParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetId sets the id param.
+func (p *ListBucketsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *ListBucketsParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *ListBucketsParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetIds sets the ids param.
+func (p *ListBucketsParams) SetIds(v []string) {
+	p.ids = optStrings{v: v, ok: true}
+}
+
+// ResetIds unsets the ids param
+func (p *ListBucketsParams) ResetIds() {
+	p.ids = optStrings{}
+}
+
+// GetIds returns the ids param and if it is set
+func (p *ListBucketsParams) GetIds() ([]string, bool) {
+	return p.ids.v, p.ids.ok
+}
+
+// SetIsrecursive sets the isrecursive param.
+func (p *ListBucketsParams) SetIsrecursive(v bool) {
+	p.isrecursive = optBool{v: v, ok: true}
+}
+
+// ResetIsrecursive unsets the isrecursive param
+func (p *ListBucketsParams) ResetIsrecursive() {
+	p.isrecursive = optBool{}
+}
+
+// GetIsrecursive returns the isrecursive param and if it is set
+func (p *ListBucketsParams) GetIsrecursive() (bool, bool) {
+	return p.isrecursive.v, p.isrecursive.ok
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListBucketsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *ListBucketsParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *ListBucketsParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetListall sets the listall param.
+func (p *ListBucketsParams) SetListall(v bool) {
+	p.listall = optBool{v: v, ok: true}
+}
+
+// ResetListall unsets the listall param
+func (p *ListBucketsParams) ResetListall() {
+	p.listall = optBool{}
+}
+
+// GetListall returns the listall param and if it is set
+func (p *ListBucketsParams) GetListall() (bool, bool) {
+	return p.listall.v, p.listall.ok
+}
+
+// SetName sets the name param.
+func (p *ListBucketsParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *ListBucketsParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *ListBucketsParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetObjectstorageid sets the objectstorageid param.
+func (p *ListBucketsParams) SetObjectstorageid(v string) {
+	p.objectstorageid = optString{v: v, ok: true}
+}
+
+// ResetObjectstorageid unsets the objectstorageid param
+func (p *ListBucketsParams) ResetObjectstorageid() {
+	p.objectstorageid = optString{}
+}
+
+// GetObjectstorageid returns the objectstorageid param and if it is set
+func (p *ListBucketsParams) GetObjectstorageid() (string, bool) {
+	return p.objectstorageid.v, p.objectstorageid.ok
+}
+
+// SetPage sets the page param.
+func (p *ListBucketsParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *ListBucketsParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *ListBucketsParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *ListBucketsParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListBucketsParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListBucketsParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *ListBucketsParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *ListBucketsParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *ListBucketsParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetTags sets the tags param.
+func (p *ListBucketsParams) SetTags(v map[string]string) {
+	p.tags = optStringMap{v: v, ok: true}
+}
+
+// ResetTags unsets the tags param
+func (p *ListBucketsParams) ResetTags() {
+	p.tags = optStringMap{}
+}
+
+// GetTags returns the tags param and if it is set
+func (p *ListBucketsParams) GetTags() (map[string]string, bool) {
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListBucketsParams) Clone() *ListBucketsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListBucketsParams) Equal(o *ListBucketsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.objectstorageid == o.objectstorageid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListBucketsParams is used to (un)marshal ListBucketsParams using the API param names
+type serializedListBucketsParams struct {
+	Account         *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid        *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id              *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids             *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Isrecursive     *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword         *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall         *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name            *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Objectstorageid *string            `json:"objectstorageid,omitempty" yaml:"objectstorageid,omitempty"`
+	Page            *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid       *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags            *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListBucketsParams) toSerialized() *serializedListBucketsParams {
+	s := &serializedListBucketsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.objectstorageid.ok {
+		s.Objectstorageid = &p.objectstorageid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListBucketsParams) fromSerialized(s *serializedListBucketsParams) {
+	*p = ListBucketsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Objectstorageid != nil {
+		p.SetObjectstorageid(*s.Objectstorageid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListBucketsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListBucketsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListBucketsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListBucketsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListBucketsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListBucketsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListBucketsParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewListBucketsParams() *ListBucketsParams {
+	p := &ListBucketsParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ObjectStoreService) GetBucketID(name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListBucketsParams{}
+
+	p.SetName(name)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListBuckets(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.Buckets[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.Buckets {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ObjectStoreService) GetBucketByName(name string, opts ...OptionFunc) (*Bucket, int, error) {
+	id, count, err := s.GetBucketID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetBucketByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ObjectStoreService) GetBucketByID(id string, opts ...OptionFunc) (*Bucket, int, error) {
+	p := &ListBucketsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListBuckets(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.Buckets[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Bucket UUID: %s!", id)
+}
+
+// Lists all Buckets.
+func (s *ObjectStoreService) ListBuckets(p *ListBucketsParams, opts ...CallOption) (*ListBucketsResponse, error) {
+	resp, err := s.cs.newRequest("listBuckets", s.cs.encodeParams("listBuckets", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBucketsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBucketsResponse struct {
+	Count   int       `json:"count"`
+	Buckets []*Bucket `json:"bucket"`
+}
+
+type Bucket struct {
+	Accesskey       string `json:"accesskey"`
+	Account         string `json:"account"`
+	Created         string `json:"created"`
+	Domain          string `json:"domain"`
+	Domainid        string `json:"domainid"`
+	Encryption      bool   `json:"encryption"`
+	Id              string `json:"id"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Name            string `json:"name"`
+	Objectlocking   bool   `json:"objectlocking"`
+	Objectstorageid string `json:"objectstorageid"`
+	Objectstore     string `json:"objectstore"`
+	Policy          string `json:"policy"`
+	Project         string `json:"project"`
+	Projectid       string `json:"projectid"`
+	Provider        string `json:"provider"`
+	Quota           int    `json:"quota"`
+	Size            int64  `json:"size"`
+	State           string `json:"state"`
+	Tags            []Tags `json:"tags"`
+	Url             string `json:"url"`
+	Usersecretkey   string `json:"usersecretkey"`
+	Versioning      bool   `json:"versioning"`
+}
+
+type ListObjectStoragePoolsParams struct {
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	provider optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListObjectStoragePoolsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
+	}
+	return u
+}
+
+// ParseListObjectStoragePoolsParams parses url.Values, for example taken from a raw API request,
+// into a new ListObjectStoragePoolsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListObjectStoragePoolsParams(u url.Values) (*ListObjectStoragePoolsParams, error) {
+	p := &ListObjectStoragePoolsParams{}
+	if err := checkParamNames("listObjectStoragePools", u, "id", "keyword", "name", "page", "pagesize", "provider"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListObjectStoragePoolsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *ListObjectStoragePoolsParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *ListObjectStoragePoolsParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListObjectStoragePoolsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *ListObjectStoragePoolsParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *ListObjectStoragePoolsParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetName sets the name param.
+func (p *ListObjectStoragePoolsParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *ListObjectStoragePoolsParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *ListObjectStoragePoolsParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetPage sets the page param.
+func (p *ListObjectStoragePoolsParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *ListObjectStoragePoolsParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *ListObjectStoragePoolsParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *ListObjectStoragePoolsParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListObjectStoragePoolsParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListObjectStoragePoolsParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetProvider sets the provider param.
+func (p *ListObjectStoragePoolsParams) SetProvider(v string) {
+	p.provider = optString{v: v, ok: true}
+}
+
+// ResetProvider unsets the provider param
+func (p *ListObjectStoragePoolsParams) ResetProvider() {
+	p.provider = optString{}
+}
+
+// GetProvider returns the provider param and if it is set
+func (p *ListObjectStoragePoolsParams) GetProvider() (string, bool) {
+	return p.provider.v, p.provider.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListObjectStoragePoolsParams) Clone() *ListObjectStoragePoolsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListObjectStoragePoolsParams) Equal(o *ListObjectStoragePoolsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.provider == o.provider
+}
+
+// serializedListObjectStoragePoolsParams is used to (un)marshal ListObjectStoragePoolsParams using the API param names
+type serializedListObjectStoragePoolsParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+}
+
+func (p *ListObjectStoragePoolsParams) toSerialized() *serializedListObjectStoragePoolsParams {
+	s := &serializedListObjectStoragePoolsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	return s
+}
+
+func (p *ListObjectStoragePoolsParams) fromSerialized(s *serializedListObjectStoragePoolsParams) {
+	*p = ListObjectStoragePoolsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListObjectStoragePoolsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListObjectStoragePoolsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListObjectStoragePoolsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListObjectStoragePoolsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListObjectStoragePoolsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListObjectStoragePoolsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListObjectStoragePoolsParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewListObjectStoragePoolsParams() *ListObjectStoragePoolsParams {
+	p := &ListObjectStoragePoolsParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ObjectStoreService) GetObjectStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListObjectStoragePoolsParams{}
+
+	p.SetName(name)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListObjectStoragePools(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.ObjectStoragePools[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.ObjectStoragePools {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ObjectStoreService) GetObjectStoragePoolByName(name string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	id, count, err := s.GetObjectStoragePoolID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetObjectStoragePoolByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ObjectStoreService) GetObjectStoragePoolByID(id string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	p := &ListObjectStoragePoolsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListObjectStoragePools(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.ObjectStoragePools[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for ObjectStoragePool UUID: %s!", id)
+}
+
+// Lists object storage pools.
+func (s *ObjectStoreService) ListObjectStoragePools(p *ListObjectStoragePoolsParams, opts ...CallOption) (*ListObjectStoragePoolsResponse, error) {
+	resp, err := s.cs.newRequest("listObjectStoragePools", s.cs.encodeParams("listObjectStoragePools", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListObjectStoragePoolsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListObjectStoragePoolsResponse struct {
+	Count              int                  `json:"count"`
+	ObjectStoragePools []*ObjectStoragePool `json:"objectstore"`
+}
+
+type ObjectStoragePool struct {
+	Hasannotations bool   `json:"hasannotations"`
+	Id             string `json:"id"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Name           string `json:"name"`
+	Providername   string `json:"providername"`
+	Storagetotal   int64  `json:"storagetotal"`
+	Storageused    int64  `json:"storageused"`
+	Url            string `json:"url"`
+}
+
+type UpdateBucketParams struct {
+	encryption optBool
+	id         optString
+	policy     optString
+	quota      optInt
+	versioning optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateBucketParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.encryption.ok {
+		u.Set("encryption", strconv.FormatBool(p.encryption.v))
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.policy.ok {
+		u.Set("policy", p.policy.v)
+	}
+	if p.quota.ok {
+		u.Set("quota", strconv.Itoa(p.quota.v))
+	}
+	if p.versioning.ok {
+		u.Set("versioning", strconv.FormatBool(p.versioning.v))
+	}
+	return u
+}
+
+// ParseUpdateBucketParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateBucketParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateBucketParams(u url.Values) (*UpdateBucketParams, error) {
+	p := &UpdateBucketParams{}
+	if err := checkParamNames("updateBucket", u, "encryption", "id", "policy", "quota", "versioning"); err != nil {
+		return nil, err
+	}
+	if _, found := u["encryption"]; found {
+		v, err := strconv.ParseBool(u.Get("encryption"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param encryption: %v", err)
+		}
+		p.SetEncryption(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["policy"]; found {
+		p.SetPolicy(u.Get("policy"))
+	}
+	if _, found := u["quota"]; found {
+		v, err := strconv.Atoi(u.Get("quota"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param quota: %v", err)
+		}
+		p.SetQuota(v)
+	}
+	if _, found := u["versioning"]; found {
+		v, err := strconv.ParseBool(u.Get("versioning"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param versioning: %v", err)
+		}
+		p.SetVersioning(v)
+	}
+	return p, nil
+}
+
+// SetEncryption sets the encryption param.
+func (p *UpdateBucketParams) SetEncryption(v bool) {
+	p.encryption = optBool{v: v, ok: true}
+}
+
+// ResetEncryption unsets the encryption param
+func (p *UpdateBucketParams) ResetEncryption() {
+	p.encryption = optBool{}
+}
+
+// GetEncryption returns the encryption param and if it is set
+func (p *UpdateBucketParams) GetEncryption() (bool, bool) {
+	return p.encryption.v, p.encryption.ok
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpdateBucketParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *UpdateBucketParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *UpdateBucketParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetPolicy sets the policy param.
+func (p *UpdateBucketParams) SetPolicy(v string) {
+	p.policy = optString{v: v, ok: true}
+}
+
+// ResetPolicy unsets the policy param
+func (p *UpdateBucketParams) ResetPolicy() {
+	p.policy = optString{}
+}
+
+// GetPolicy returns the policy param and if it is set
+func (p *UpdateBucketParams) GetPolicy() (string, bool) {
+	return p.policy.v, p.policy.ok
+}
+
+// SetQuota sets the quota param.
+func (p *UpdateBucketParams) SetQuota(v int) {
+	p.quota = optInt{v: v, ok: true}
+}
+
+// ResetQuota unsets the quota param
+func (p *UpdateBucketParams) ResetQuota() {
+	p.quota = optInt{}
+}
+
+// GetQuota returns the quota param and if it is set
+func (p *UpdateBucketParams) GetQuota() (int, bool) {
+	return p.quota.v, p.quota.ok
+}
+
+// SetVersioning sets the versioning param.
+func (p *UpdateBucketParams) SetVersioning(v bool) {
+	p.versioning = optBool{v: v, ok: true}
+}
+
+// ResetVersioning unsets the versioning param
+func (p *UpdateBucketParams) ResetVersioning() {
+	p.versioning = optBool{}
+}
+
+// GetVersioning returns the versioning param and if it is set
+func (p *UpdateBucketParams) GetVersioning() (bool, bool) {
+	return p.versioning.v, p.versioning.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateBucketParams) Clone() *UpdateBucketParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateBucketParams) Equal(o *UpdateBucketParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.encryption == o.encryption &&
+		p.id == o.id &&
+		p.policy == o.policy &&
+		p.quota == o.quota &&
+		p.versioning == o.versioning
+}
+
+// serializedUpdateBucketParams is used to (un)marshal UpdateBucketParams using the API param names
+type serializedUpdateBucketParams struct {
+	Encryption *bool   `json:"encryption,omitempty" yaml:"encryption,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Policy     *string `json:"policy,omitempty" yaml:"policy,omitempty"`
+	Quota      *int    `json:"quota,omitempty" yaml:"quota,omitempty"`
+	Versioning *bool   `json:"versioning,omitempty" yaml:"versioning,omitempty"`
+}
+
+func (p *UpdateBucketParams) toSerialized() *serializedUpdateBucketParams {
+	s := &serializedUpdateBucketParams{}
+	if p.encryption.ok {
+		s.Encryption = &p.encryption.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.policy.ok {
+		s.Policy = &p.policy.v
+	}
+	if p.quota.ok {
+		s.Quota = &p.quota.v
+	}
+	if p.versioning.ok {
+		s.Versioning = &p.versioning.v
+	}
+	return s
+}
+
+func (p *UpdateBucketParams) fromSerialized(s *serializedUpdateBucketParams) {
+	*p = UpdateBucketParams{}
+	if s.Encryption != nil {
+		p.SetEncryption(*s.Encryption)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Policy != nil {
+		p.SetPolicy(*s.Policy)
+	}
+	if s.Quota != nil {
+		p.SetQuota(*s.Quota)
+	}
+	if s.Versioning != nil {
+		p.SetVersioning(*s.Versioning)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateBucketParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateBucketParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateBucketParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateBucketParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateBucketParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateBucketParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new UpdateBucketParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewUpdateBucketParams(id string) *UpdateBucketParams {
+	p := &UpdateBucketParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates Bucket properties.
+//
+// Required params: id.
+func (s *ObjectStoreService) UpdateBucket(p *UpdateBucketParams, opts ...CallOption) (*UpdateBucketResponse, error) {
+	resp, err := s.cs.newRequest("updateBucket", s.cs.encodeParams("updateBucket", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateBucketResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateBucketResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *UpdateBucketResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias UpdateBucketResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type UpdateObjectStoragePoolParams struct {
+	id   optString
+	name optString
+	url  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateObjectStoragePoolParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.url.ok {
+		u.Set("url", p.url.v)
+	}
+	return u
+}
+
+// ParseUpdateObjectStoragePoolParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateObjectStoragePoolParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateObjectStoragePoolParams(u url.Values) (*UpdateObjectStoragePoolParams, error) {
+	p := &UpdateObjectStoragePoolParams{}
+	if err := checkParamNames("updateObjectStoragePool", u, "id", "name", "url"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *UpdateObjectStoragePoolParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *UpdateObjectStoragePoolParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *UpdateObjectStoragePoolParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetName sets the name param.
+func (p *UpdateObjectStoragePoolParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *UpdateObjectStoragePoolParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *UpdateObjectStoragePoolParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetUrl sets the url param.
+func (p *UpdateObjectStoragePoolParams) SetUrl(v string) {
+	p.url = optString{v: v, ok: true}
+}
+
+// ResetUrl unsets the url param
+func (p *UpdateObjectStoragePoolParams) ResetUrl() {
+	p.url = optString{}
+}
+
+// GetUrl returns the url param and if it is set
+func (p *UpdateObjectStoragePoolParams) GetUrl() (string, bool) {
+	return p.url.v, p.url.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateObjectStoragePoolParams) Clone() *UpdateObjectStoragePoolParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateObjectStoragePoolParams) Equal(o *UpdateObjectStoragePoolParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.name == o.name &&
+		p.url == o.url
+}
+
+// serializedUpdateObjectStoragePoolParams is used to (un)marshal UpdateObjectStoragePoolParams using the API param names
+type serializedUpdateObjectStoragePoolParams struct {
+	Id   *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Url  *string `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+func (p *UpdateObjectStoragePoolParams) toSerialized() *serializedUpdateObjectStoragePoolParams {
+	s := &serializedUpdateObjectStoragePoolParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	return s
+}
+
+func (p *UpdateObjectStoragePoolParams) fromSerialized(s *serializedUpdateObjectStoragePoolParams) {
+	*p = UpdateObjectStoragePoolParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateObjectStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateObjectStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateObjectStoragePoolParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateObjectStoragePoolParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateObjectStoragePoolParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateObjectStoragePoolParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new UpdateObjectStoragePoolParams instance,
+// as then you are sure you have configured all required params
+func (s *ObjectStoreService) NewUpdateObjectStoragePoolParams(id string) *UpdateObjectStoragePoolParams {
+	p := &UpdateObjectStoragePoolParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates object storage pool.
+//
+// Required params: id.
+func (s *ObjectStoreService) UpdateObjectStoragePool(p *UpdateObjectStoragePoolParams, opts ...CallOption) (*UpdateObjectStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest("updateObjectStoragePool", s.cs.encodeParams("updateObjectStoragePool", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r UpdateObjectStoragePoolResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateObjectStoragePoolResponse struct {
+	Hasannotations bool   `json:"hasannotations"`
+	Id             string `json:"id"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Name           string `json:"name"`
+	Providername   string `json:"providername"`
+	Storagetotal   int64  `json:"storagetotal"`
+	Storageused    int64  `json:"storageused"`
+	Url            string `json:"url"`
+}
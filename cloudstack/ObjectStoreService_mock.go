@@ -0,0 +1,451 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./cloudstack/ObjectStoreService.go
+
+// Package cloudstack is a generated GoMock package.
+package cloudstack
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockObjectStoreServiceIface is a mock of ObjectStoreServiceIface interface.
+type MockObjectStoreServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStoreServiceIfaceMockRecorder
+}
+
+// MockObjectStoreServiceIfaceMockRecorder is the mock recorder for MockObjectStoreServiceIface.
+type MockObjectStoreServiceIfaceMockRecorder struct {
+	mock *MockObjectStoreServiceIface
+}
+
+// NewMockObjectStoreServiceIface creates a new mock instance.
+func NewMockObjectStoreServiceIface(ctrl *gomock.Controller) *MockObjectStoreServiceIface {
+	mock := &MockObjectStoreServiceIface{ctrl: ctrl}
+	mock.recorder = &MockObjectStoreServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStoreServiceIface) EXPECT() *MockObjectStoreServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// AddObjectStoragePool mocks base method.
+func (m *MockObjectStoreServiceIface) AddObjectStoragePool(p *AddObjectStoragePoolParams, opts ...CallOption) (*AddObjectStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddObjectStoragePool", varargs...)
+	ret0, _ := ret[0].(*AddObjectStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddObjectStoragePool indicates an expected call of AddObjectStoragePool.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) AddObjectStoragePool(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddObjectStoragePool", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).AddObjectStoragePool), varargs...)
+}
+
+// CreateBucket mocks base method.
+func (m *MockObjectStoreServiceIface) CreateBucket(p *CreateBucketParams, opts ...CallOption) (*CreateBucketResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateBucket", varargs...)
+	ret0, _ := ret[0].(*CreateBucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBucket indicates an expected call of CreateBucket.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) CreateBucket(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucket", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).CreateBucket), varargs...)
+}
+
+// DeleteBucket mocks base method.
+func (m *MockObjectStoreServiceIface) DeleteBucket(p *DeleteBucketParams, opts ...CallOption) (*DeleteBucketResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBucket", varargs...)
+	ret0, _ := ret[0].(*DeleteBucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBucket indicates an expected call of DeleteBucket.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) DeleteBucket(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).DeleteBucket), varargs...)
+}
+
+// DeleteObjectStoragePool mocks base method.
+func (m *MockObjectStoreServiceIface) DeleteObjectStoragePool(p *DeleteObjectStoragePoolParams, opts ...CallOption) (*DeleteObjectStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteObjectStoragePool", varargs...)
+	ret0, _ := ret[0].(*DeleteObjectStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteObjectStoragePool indicates an expected call of DeleteObjectStoragePool.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) DeleteObjectStoragePool(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStoragePool", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).DeleteObjectStoragePool), varargs...)
+}
+
+// GetBucketByID mocks base method.
+func (m *MockObjectStoreServiceIface) GetBucketByID(id string, opts ...OptionFunc) (*Bucket, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketByID", varargs...)
+	ret0, _ := ret[0].(*Bucket)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBucketByID indicates an expected call of GetBucketByID.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByID", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketByID), varargs...)
+}
+
+// GetBucketByName mocks base method.
+func (m *MockObjectStoreServiceIface) GetBucketByName(name string, opts ...OptionFunc) (*Bucket, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketByName", varargs...)
+	ret0, _ := ret[0].(*Bucket)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBucketByName indicates an expected call of GetBucketByName.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByName", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketByName), varargs...)
+}
+
+// GetBucketID mocks base method.
+func (m *MockObjectStoreServiceIface) GetBucketID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBucketID indicates an expected call of GetBucketID.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketID", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketID), varargs...)
+}
+
+// GetObjectStoragePoolByID mocks base method.
+func (m *MockObjectStoreServiceIface) GetObjectStoragePoolByID(id string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStoragePoolByID", varargs...)
+	ret0, _ := ret[0].(*ObjectStoragePool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObjectStoragePoolByID indicates an expected call of GetObjectStoragePoolByID.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetObjectStoragePoolByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolByID", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetObjectStoragePoolByID), varargs...)
+}
+
+// GetObjectStoragePoolByName mocks base method.
+func (m *MockObjectStoreServiceIface) GetObjectStoragePoolByName(name string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStoragePoolByName", varargs...)
+	ret0, _ := ret[0].(*ObjectStoragePool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObjectStoragePoolByName indicates an expected call of GetObjectStoragePoolByName.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetObjectStoragePoolByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolByName", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetObjectStoragePoolByName), varargs...)
+}
+
+// GetObjectStoragePoolID mocks base method.
+func (m *MockObjectStoreServiceIface) GetObjectStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStoragePoolID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObjectStoragePoolID indicates an expected call of GetObjectStoragePoolID.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetObjectStoragePoolID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolID", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetObjectStoragePoolID), varargs...)
+}
+
+// ListBuckets mocks base method.
+func (m *MockObjectStoreServiceIface) ListBuckets(p *ListBucketsParams, opts ...CallOption) (*ListBucketsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBuckets", varargs...)
+	ret0, _ := ret[0].(*ListBucketsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuckets indicates an expected call of ListBuckets.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) ListBuckets(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuckets", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListBuckets), varargs...)
+}
+
+// ListObjectStoragePools mocks base method.
+func (m *MockObjectStoreServiceIface) ListObjectStoragePools(p *ListObjectStoragePoolsParams, opts ...CallOption) (*ListObjectStoragePoolsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectStoragePools", varargs...)
+	ret0, _ := ret[0].(*ListObjectStoragePoolsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectStoragePools indicates an expected call of ListObjectStoragePools.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) ListObjectStoragePools(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectStoragePools", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListObjectStoragePools), varargs...)
+}
+
+// NewAddObjectStoragePoolParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewAddObjectStoragePoolParams(name, provider, url string) *AddObjectStoragePoolParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddObjectStoragePoolParams", name, provider, url)
+	ret0, _ := ret[0].(*AddObjectStoragePoolParams)
+	return ret0
+}
+
+// NewAddObjectStoragePoolParams indicates an expected call of NewAddObjectStoragePoolParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewAddObjectStoragePoolParams(name, provider, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddObjectStoragePoolParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewAddObjectStoragePoolParams), name, provider, url)
+}
+
+// NewCreateBucketParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewCreateBucketParams(name, objectstorageid string) *CreateBucketParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateBucketParams", name, objectstorageid)
+	ret0, _ := ret[0].(*CreateBucketParams)
+	return ret0
+}
+
+// NewCreateBucketParams indicates an expected call of NewCreateBucketParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewCreateBucketParams(name, objectstorageid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBucketParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewCreateBucketParams), name, objectstorageid)
+}
+
+// NewDeleteBucketParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewDeleteBucketParams(id string) *DeleteBucketParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteBucketParams", id)
+	ret0, _ := ret[0].(*DeleteBucketParams)
+	return ret0
+}
+
+// NewDeleteBucketParams indicates an expected call of NewDeleteBucketParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewDeleteBucketParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteBucketParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewDeleteBucketParams), id)
+}
+
+// NewDeleteObjectStoragePoolParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewDeleteObjectStoragePoolParams(id string) *DeleteObjectStoragePoolParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteObjectStoragePoolParams", id)
+	ret0, _ := ret[0].(*DeleteObjectStoragePoolParams)
+	return ret0
+}
+
+// NewDeleteObjectStoragePoolParams indicates an expected call of NewDeleteObjectStoragePoolParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewDeleteObjectStoragePoolParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteObjectStoragePoolParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewDeleteObjectStoragePoolParams), id)
+}
+
+// NewListBucketsParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewListBucketsParams() *ListBucketsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListBucketsParams")
+	ret0, _ := ret[0].(*ListBucketsParams)
+	return ret0
+}
+
+// NewListBucketsParams indicates an expected call of NewListBucketsParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewListBucketsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListBucketsParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewListBucketsParams))
+}
+
+// NewListObjectStoragePoolsParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewListObjectStoragePoolsParams() *ListObjectStoragePoolsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListObjectStoragePoolsParams")
+	ret0, _ := ret[0].(*ListObjectStoragePoolsParams)
+	return ret0
+}
+
+// NewListObjectStoragePoolsParams indicates an expected call of NewListObjectStoragePoolsParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewListObjectStoragePoolsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListObjectStoragePoolsParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewListObjectStoragePoolsParams))
+}
+
+// NewUpdateBucketParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewUpdateBucketParams(id string) *UpdateBucketParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateBucketParams", id)
+	ret0, _ := ret[0].(*UpdateBucketParams)
+	return ret0
+}
+
+// NewUpdateBucketParams indicates an expected call of NewUpdateBucketParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewUpdateBucketParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateBucketParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewUpdateBucketParams), id)
+}
+
+// NewUpdateObjectStoragePoolParams mocks base method.
+func (m *MockObjectStoreServiceIface) NewUpdateObjectStoragePoolParams(id string) *UpdateObjectStoragePoolParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateObjectStoragePoolParams", id)
+	ret0, _ := ret[0].(*UpdateObjectStoragePoolParams)
+	return ret0
+}
+
+// NewUpdateObjectStoragePoolParams indicates an expected call of NewUpdateObjectStoragePoolParams.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) NewUpdateObjectStoragePoolParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateObjectStoragePoolParams", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).NewUpdateObjectStoragePoolParams), id)
+}
+
+// UpdateBucket mocks base method.
+func (m *MockObjectStoreServiceIface) UpdateBucket(p *UpdateBucketParams, opts ...CallOption) (*UpdateBucketResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBucket", varargs...)
+	ret0, _ := ret[0].(*UpdateBucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBucket indicates an expected call of UpdateBucket.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) UpdateBucket(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBucket", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).UpdateBucket), varargs...)
+}
+
+// UpdateObjectStoragePool mocks base method.
+func (m *MockObjectStoreServiceIface) UpdateObjectStoragePool(p *UpdateObjectStoragePoolParams, opts ...CallOption) (*UpdateObjectStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateObjectStoragePool", varargs...)
+	ret0, _ := ret[0].(*UpdateObjectStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateObjectStoragePool indicates an expected call of UpdateObjectStoragePool.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) UpdateObjectStoragePool(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectStoragePool", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).UpdateObjectStoragePool), varargs...)
+}
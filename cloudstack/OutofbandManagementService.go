@@ -21,91 +21,179 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type OutofbandManagementServiceIface interface {
-	ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams, opts ...CallOption) (*ChangeOutOfBandManagementPasswordResponse, error)
 	NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams
-	ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error)
+	ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams, opts ...CallOption) (*OutOfBandManagementResponse, error)
 	NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) *ConfigureOutOfBandManagementParams
-	IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams, opts ...CallOption) (*IssueOutOfBandManagementPowerActionResponse, error)
 	NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) *IssueOutOfBandManagementPowerActionParams
 }
 
 type ChangeOutOfBandManagementPasswordParams struct {
-	p map[string]interface{}
+	hostid   optString
+	password optString
 }
 
-func (p *ChangeOutOfBandManagementPasswordParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ChangeOutOfBandManagementPasswordParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
 	return u
 }
 
-func (p *ChangeOutOfBandManagementPasswordParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseChangeOutOfBandManagementPasswordParams parses url.Values, for example taken from a raw API request,
+// into a new ChangeOutOfBandManagementPasswordParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseChangeOutOfBandManagementPasswordParams(u url.Values) (*ChangeOutOfBandManagementPasswordParams, error) {
+	p := &ChangeOutOfBandManagementPasswordParams{}
+	if err := checkParamNames("changeOutOfBandManagementPassword", u, "hostid", "password"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
 	}
-	p.p["hostid"] = v
+	return p, nil
 }
 
+// SetHostid sets the hostid param. This param is required.
+func (p *ChangeOutOfBandManagementPasswordParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
+}
+
+// ResetHostid unsets the hostid param
 func (p *ChangeOutOfBandManagementPasswordParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ChangeOutOfBandManagementPasswordParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetPassword sets the password param.
 func (p *ChangeOutOfBandManagementPasswordParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *ChangeOutOfBandManagementPasswordParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *ChangeOutOfBandManagementPasswordParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.password.v, p.password.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ChangeOutOfBandManagementPasswordParams) Clone() *ChangeOutOfBandManagementPasswordParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ChangeOutOfBandManagementPasswordParams) Equal(o *ChangeOutOfBandManagementPasswordParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid &&
+		p.password == o.password
+}
+
+// serializedChangeOutOfBandManagementPasswordParams is used to (un)marshal ChangeOutOfBandManagementPasswordParams using the API param names
+type serializedChangeOutOfBandManagementPasswordParams struct {
+	Hostid   *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+}
+
+func (p *ChangeOutOfBandManagementPasswordParams) toSerialized() *serializedChangeOutOfBandManagementPasswordParams {
+	s := &serializedChangeOutOfBandManagementPasswordParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	return s
+}
+
+func (p *ChangeOutOfBandManagementPasswordParams) fromSerialized(s *serializedChangeOutOfBandManagementPasswordParams) {
+	*p = ChangeOutOfBandManagementPasswordParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ChangeOutOfBandManagementPasswordParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ChangeOutOfBandManagementPasswordParams) UnmarshalJSON(b []byte) error {
+	var s serializedChangeOutOfBandManagementPasswordParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ChangeOutOfBandManagementPasswordParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ChangeOutOfBandManagementPasswordParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedChangeOutOfBandManagementPasswordParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ChangeOutOfBandManagementPasswordParams instance,
 // as then you are sure you have configured all required params
 func (s *OutofbandManagementService) NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams {
 	p := &ChangeOutOfBandManagementPasswordParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+	p.SetHostid(hostid)
 	return p
 }
 
-// Changes out-of-band management interface password on the host and updates the interface configuration in CloudStack if the operation succeeds, else reverts the old password
-func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
-	resp, err := s.cs.newRequest("changeOutOfBandManagementPassword", p.toURLValues())
+// Changes out-of-band management interface password on the host and updates the interface
+// configuration in CloudStack if the operation succeeds, else reverts the old password.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: hostid.
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams, opts ...CallOption) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	resp, err := s.cs.newRequest("changeOutOfBandManagementPassword", s.cs.encodeParams("changeOutOfBandManagementPassword", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,8 +204,8 @@ func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *Change
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -155,178 +243,287 @@ type ChangeOutOfBandManagementPasswordResponse struct {
 }
 
 type ConfigureOutOfBandManagementParams struct {
-	p map[string]interface{}
+	address  optString
+	driver   optString
+	hostid   optString
+	password optString
+	port     optString
+	username optString
 }
 
-func (p *ConfigureOutOfBandManagementParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ConfigureOutOfBandManagementParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["address"]; found {
-		u.Set("address", v.(string))
+	if p.address.ok {
+		u.Set("address", p.address.v)
 	}
-	if v, found := p.p["driver"]; found {
-		u.Set("driver", v.(string))
+	if p.driver.ok {
+		u.Set("driver", p.driver.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["port"]; found {
-		u.Set("port", v.(string))
+	if p.port.ok {
+		u.Set("port", p.port.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *ConfigureOutOfBandManagementParams) SetAddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseConfigureOutOfBandManagementParams parses url.Values, for example taken from a raw API request,
+// into a new ConfigureOutOfBandManagementParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseConfigureOutOfBandManagementParams(u url.Values) (*ConfigureOutOfBandManagementParams, error) {
+	p := &ConfigureOutOfBandManagementParams{}
+	if err := checkParamNames("configureOutOfBandManagement", u, "address", "driver", "hostid", "password", "port", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["address"]; found {
+		p.SetAddress(u.Get("address"))
+	}
+	if _, found := u["driver"]; found {
+		p.SetDriver(u.Get("driver"))
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["port"]; found {
+		p.SetPort(u.Get("port"))
 	}
-	p.p["address"] = v
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
 }
 
+// SetAddress sets the address param. This param is required.
+func (p *ConfigureOutOfBandManagementParams) SetAddress(v string) {
+	p.address = optString{v: v, ok: true}
+}
+
+// ResetAddress unsets the address param
 func (p *ConfigureOutOfBandManagementParams) ResetAddress() {
-	if p.p != nil && p.p["address"] != nil {
-		delete(p.p, "address")
-	}
+	p.address = optString{}
 }
 
+// GetAddress returns the address param and if it is set
 func (p *ConfigureOutOfBandManagementParams) GetAddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["address"].(string)
-	return value, ok
+	return p.address.v, p.address.ok
 }
 
+// SetDriver sets the driver param. This param is required.
 func (p *ConfigureOutOfBandManagementParams) SetDriver(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["driver"] = v
+	p.driver = optString{v: v, ok: true}
 }
 
+// ResetDriver unsets the driver param
 func (p *ConfigureOutOfBandManagementParams) ResetDriver() {
-	if p.p != nil && p.p["driver"] != nil {
-		delete(p.p, "driver")
-	}
+	p.driver = optString{}
 }
 
+// GetDriver returns the driver param and if it is set
 func (p *ConfigureOutOfBandManagementParams) GetDriver() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["driver"].(string)
-	return value, ok
+	return p.driver.v, p.driver.ok
 }
 
+// SetHostid sets the hostid param. This param is required.
 func (p *ConfigureOutOfBandManagementParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ConfigureOutOfBandManagementParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ConfigureOutOfBandManagementParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *ConfigureOutOfBandManagementParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *ConfigureOutOfBandManagementParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *ConfigureOutOfBandManagementParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPort sets the port param. This param is required.
 func (p *ConfigureOutOfBandManagementParams) SetPort(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["port"] = v
+	p.port = optString{v: v, ok: true}
 }
 
+// ResetPort unsets the port param
 func (p *ConfigureOutOfBandManagementParams) ResetPort() {
-	if p.p != nil && p.p["port"] != nil {
-		delete(p.p, "port")
-	}
+	p.port = optString{}
 }
 
+// GetPort returns the port param and if it is set
 func (p *ConfigureOutOfBandManagementParams) GetPort() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["port"].(string)
-	return value, ok
+	return p.port.v, p.port.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *ConfigureOutOfBandManagementParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *ConfigureOutOfBandManagementParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *ConfigureOutOfBandManagementParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ConfigureOutOfBandManagementParams) Clone() *ConfigureOutOfBandManagementParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ConfigureOutOfBandManagementParams) Equal(o *ConfigureOutOfBandManagementParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.address == o.address &&
+		p.driver == o.driver &&
+		p.hostid == o.hostid &&
+		p.password == o.password &&
+		p.port == o.port &&
+		p.username == o.username
+}
+
+// serializedConfigureOutOfBandManagementParams is used to (un)marshal ConfigureOutOfBandManagementParams using the API param names
+type serializedConfigureOutOfBandManagementParams struct {
+	Address  *string `json:"address,omitempty" yaml:"address,omitempty"`
+	Driver   *string `json:"driver,omitempty" yaml:"driver,omitempty"`
+	Hostid   *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Port     *string `json:"port,omitempty" yaml:"port,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *ConfigureOutOfBandManagementParams) toSerialized() *serializedConfigureOutOfBandManagementParams {
+	s := &serializedConfigureOutOfBandManagementParams{}
+	if p.address.ok {
+		s.Address = &p.address.v
+	}
+	if p.driver.ok {
+		s.Driver = &p.driver.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.port.ok {
+		s.Port = &p.port.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *ConfigureOutOfBandManagementParams) fromSerialized(s *serializedConfigureOutOfBandManagementParams) {
+	*p = ConfigureOutOfBandManagementParams{}
+	if s.Address != nil {
+		p.SetAddress(*s.Address)
+	}
+	if s.Driver != nil {
+		p.SetDriver(*s.Driver)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Port != nil {
+		p.SetPort(*s.Port)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ConfigureOutOfBandManagementParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ConfigureOutOfBandManagementParams) UnmarshalJSON(b []byte) error {
+	var s serializedConfigureOutOfBandManagementParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ConfigureOutOfBandManagementParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ConfigureOutOfBandManagementParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedConfigureOutOfBandManagementParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ConfigureOutOfBandManagementParams instance,
 // as then you are sure you have configured all required params
 func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) *ConfigureOutOfBandManagementParams {
 	p := &ConfigureOutOfBandManagementParams{}
-	p.p = make(map[string]interface{})
-	p.p["address"] = address
-	p.p["driver"] = driver
-	p.p["hostid"] = hostid
-	p.p["password"] = password
-	p.p["port"] = port
-	p.p["username"] = username
+	p.SetAddress(address)
+	p.SetDriver(driver)
+	p.SetHostid(hostid)
+	p.SetPassword(password)
+	p.SetPort(port)
+	p.SetUsername(username)
 	return p
 }
 
-// Configures a host's out-of-band management interface
-func (s *OutofbandManagementService) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
-	resp, err := s.cs.newRequest("configureOutOfBandManagement", p.toURLValues())
+// Configures a host's out-of-band management interface.
+//
+// Required params: address, driver, hostid, password, port, username.
+func (s *OutofbandManagementService) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams, opts ...CallOption) (*OutOfBandManagementResponse, error) {
+	resp, err := s.cs.newRequest("configureOutOfBandManagement", s.cs.encodeParams("configureOutOfBandManagement", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -356,103 +553,198 @@ type OutOfBandManagementResponse struct {
 }
 
 type IssueOutOfBandManagementPowerActionParams struct {
-	p map[string]interface{}
+	action  optString
+	hostid  optString
+	timeout optInt64
 }
 
-func (p *IssueOutOfBandManagementPowerActionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *IssueOutOfBandManagementPowerActionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if p.action.ok {
+		u.Set("action", p.action.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["timeout"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("timeout", vv)
+	if p.timeout.ok {
+		u.Set("timeout", strconv.FormatInt(p.timeout.v, 10))
 	}
 	return u
 }
 
-func (p *IssueOutOfBandManagementPowerActionParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseIssueOutOfBandManagementPowerActionParams parses url.Values, for example taken from a raw API request,
+// into a new IssueOutOfBandManagementPowerActionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseIssueOutOfBandManagementPowerActionParams(u url.Values) (*IssueOutOfBandManagementPowerActionParams, error) {
+	p := &IssueOutOfBandManagementPowerActionParams{}
+	if err := checkParamNames("issueOutOfBandManagementPowerAction", u, "action", "hostid", "timeout"); err != nil {
+		return nil, err
+	}
+	if _, found := u["action"]; found {
+		p.SetAction(u.Get("action"))
 	}
-	p.p["action"] = v
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["timeout"]; found {
+		v, err := strconv.ParseInt(u.Get("timeout"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param timeout: %v", err)
+		}
+		p.SetTimeout(v)
+	}
+	return p, nil
 }
 
+// SetAction sets the action param. This param is required.
+func (p *IssueOutOfBandManagementPowerActionParams) SetAction(v string) {
+	p.action = optString{v: v, ok: true}
+}
+
+// ResetAction unsets the action param
 func (p *IssueOutOfBandManagementPowerActionParams) ResetAction() {
-	if p.p != nil && p.p["action"] != nil {
-		delete(p.p, "action")
-	}
+	p.action = optString{}
 }
 
+// GetAction returns the action param and if it is set
 func (p *IssueOutOfBandManagementPowerActionParams) GetAction() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["action"].(string)
-	return value, ok
+	return p.action.v, p.action.ok
 }
 
+// SetHostid sets the hostid param. This param is required.
 func (p *IssueOutOfBandManagementPowerActionParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *IssueOutOfBandManagementPowerActionParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *IssueOutOfBandManagementPowerActionParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetTimeout sets the timeout param.
 func (p *IssueOutOfBandManagementPowerActionParams) SetTimeout(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timeout"] = v
+	p.timeout = optInt64{v: v, ok: true}
 }
 
+// ResetTimeout unsets the timeout param
 func (p *IssueOutOfBandManagementPowerActionParams) ResetTimeout() {
-	if p.p != nil && p.p["timeout"] != nil {
-		delete(p.p, "timeout")
-	}
+	p.timeout = optInt64{}
 }
 
+// GetTimeout returns the timeout param and if it is set
 func (p *IssueOutOfBandManagementPowerActionParams) GetTimeout() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.timeout.v, p.timeout.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *IssueOutOfBandManagementPowerActionParams) Clone() *IssueOutOfBandManagementPowerActionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *IssueOutOfBandManagementPowerActionParams) Equal(o *IssueOutOfBandManagementPowerActionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.action == o.action &&
+		p.hostid == o.hostid &&
+		p.timeout == o.timeout
+}
+
+// serializedIssueOutOfBandManagementPowerActionParams is used to (un)marshal IssueOutOfBandManagementPowerActionParams using the API param names
+type serializedIssueOutOfBandManagementPowerActionParams struct {
+	Action  *string `json:"action,omitempty" yaml:"action,omitempty"`
+	Hostid  *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Timeout *int64  `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+func (p *IssueOutOfBandManagementPowerActionParams) toSerialized() *serializedIssueOutOfBandManagementPowerActionParams {
+	s := &serializedIssueOutOfBandManagementPowerActionParams{}
+	if p.action.ok {
+		s.Action = &p.action.v
 	}
-	value, ok := p.p["timeout"].(int64)
-	return value, ok
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.timeout.ok {
+		s.Timeout = &p.timeout.v
+	}
+	return s
+}
+
+func (p *IssueOutOfBandManagementPowerActionParams) fromSerialized(s *serializedIssueOutOfBandManagementPowerActionParams) {
+	*p = IssueOutOfBandManagementPowerActionParams{}
+	if s.Action != nil {
+		p.SetAction(*s.Action)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Timeout != nil {
+		p.SetTimeout(*s.Timeout)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p IssueOutOfBandManagementPowerActionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *IssueOutOfBandManagementPowerActionParams) UnmarshalJSON(b []byte) error {
+	var s serializedIssueOutOfBandManagementPowerActionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p IssueOutOfBandManagementPowerActionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *IssueOutOfBandManagementPowerActionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedIssueOutOfBandManagementPowerActionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new IssueOutOfBandManagementPowerActionParams instance,
 // as then you are sure you have configured all required params
 func (s *OutofbandManagementService) NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) *IssueOutOfBandManagementPowerActionParams {
 	p := &IssueOutOfBandManagementPowerActionParams{}
-	p.p = make(map[string]interface{})
-	p.p["action"] = action
-	p.p["hostid"] = hostid
+	p.SetAction(action)
+	p.SetHostid(hostid)
 	return p
 }
 
-// Initiates the specified power action to the host's out-of-band management interface
-func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
-	resp, err := s.cs.newRequest("issueOutOfBandManagementPowerAction", p.toURLValues())
+// Initiates the specified power action to the host's out-of-band management interface.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: action, hostid.
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams, opts ...CallOption) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	resp, err := s.cs.newRequest("issueOutOfBandManagementPowerAction", s.cs.encodeParams("issueOutOfBandManagementPowerAction", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -463,8 +755,8 @@ func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *Issu
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
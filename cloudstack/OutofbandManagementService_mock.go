@@ -53,48 +53,63 @@ func (m *MockOutofbandManagementServiceIface) EXPECT() *MockOutofbandManagementS
 }
 
 // ChangeOutOfBandManagementPassword mocks base method.
-func (m *MockOutofbandManagementServiceIface) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
+func (m *MockOutofbandManagementServiceIface) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams, opts ...CallOption) (*ChangeOutOfBandManagementPasswordResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeOutOfBandManagementPassword", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeOutOfBandManagementPassword", varargs...)
 	ret0, _ := ret[0].(*ChangeOutOfBandManagementPasswordResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeOutOfBandManagementPassword indicates an expected call of ChangeOutOfBandManagementPassword.
-func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ChangeOutOfBandManagementPassword(p interface{}) *gomock.Call {
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ChangeOutOfBandManagementPassword(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOutOfBandManagementPassword", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ChangeOutOfBandManagementPassword), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOutOfBandManagementPassword", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ChangeOutOfBandManagementPassword), varargs...)
 }
 
 // ConfigureOutOfBandManagement mocks base method.
-func (m *MockOutofbandManagementServiceIface) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
+func (m *MockOutofbandManagementServiceIface) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams, opts ...CallOption) (*OutOfBandManagementResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigureOutOfBandManagement", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfigureOutOfBandManagement", varargs...)
 	ret0, _ := ret[0].(*OutOfBandManagementResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfigureOutOfBandManagement indicates an expected call of ConfigureOutOfBandManagement.
-func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ConfigureOutOfBandManagement(p interface{}) *gomock.Call {
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ConfigureOutOfBandManagement(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOutOfBandManagement", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ConfigureOutOfBandManagement), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOutOfBandManagement", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ConfigureOutOfBandManagement), varargs...)
 }
 
 // IssueOutOfBandManagementPowerAction mocks base method.
-func (m *MockOutofbandManagementServiceIface) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
+func (m *MockOutofbandManagementServiceIface) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams, opts ...CallOption) (*IssueOutOfBandManagementPowerActionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IssueOutOfBandManagementPowerAction", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IssueOutOfBandManagementPowerAction", varargs...)
 	ret0, _ := ret[0].(*IssueOutOfBandManagementPowerActionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IssueOutOfBandManagementPowerAction indicates an expected call of IssueOutOfBandManagementPowerAction.
-func (mr *MockOutofbandManagementServiceIfaceMockRecorder) IssueOutOfBandManagementPowerAction(p interface{}) *gomock.Call {
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) IssueOutOfBandManagementPowerAction(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueOutOfBandManagementPowerAction", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).IssueOutOfBandManagementPowerAction), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueOutOfBandManagementPowerAction", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).IssueOutOfBandManagementPowerAction), varargs...)
 }
 
 // NewChangeOutOfBandManagementPasswordParams mocks base method.
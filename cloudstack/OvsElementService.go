@@ -28,87 +28,176 @@ import (
 )
 
 type OvsElementServiceIface interface {
-	ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error)
+	ConfigureOvsElement(p *ConfigureOvsElementParams, opts ...CallOption) (*OvsElementResponse, error)
 	NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams
-	ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	ListOvsElements(p *ListOvsElementsParams, opts ...CallOption) (*ListOvsElementsResponse, error)
 	NewListOvsElementsParams() *ListOvsElementsParams
 	GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error)
 }
 
 type ConfigureOvsElementParams struct {
-	p map[string]interface{}
+	enabled optBool
+	id      optString
 }
 
-func (p *ConfigureOvsElementParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ConfigureOvsElementParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
+	if p.enabled.ok {
+		u.Set("enabled", strconv.FormatBool(p.enabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseConfigureOvsElementParams parses url.Values, for example taken from a raw API request,
+// into a new ConfigureOvsElementParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseConfigureOvsElementParams(u url.Values) (*ConfigureOvsElementParams, error) {
+	p := &ConfigureOvsElementParams{}
+	if err := checkParamNames("configureOvsElement", u, "enabled", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enabled"]; found {
+		v, err := strconv.ParseBool(u.Get("enabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabled: %v", err)
+		}
+		p.SetEnabled(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["enabled"] = v
+	return p, nil
 }
 
+// SetEnabled sets the enabled param. This param is required.
+func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
+	p.enabled = optBool{v: v, ok: true}
+}
+
+// ResetEnabled unsets the enabled param
 func (p *ConfigureOvsElementParams) ResetEnabled() {
-	if p.p != nil && p.p["enabled"] != nil {
-		delete(p.p, "enabled")
-	}
+	p.enabled = optBool{}
 }
 
+// GetEnabled returns the enabled param and if it is set
 func (p *ConfigureOvsElementParams) GetEnabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabled"].(bool)
-	return value, ok
+	return p.enabled.v, p.enabled.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ConfigureOvsElementParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ConfigureOvsElementParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ConfigureOvsElementParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ConfigureOvsElementParams) Clone() *ConfigureOvsElementParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ConfigureOvsElementParams) Equal(o *ConfigureOvsElementParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enabled == o.enabled &&
+		p.id == o.id
+}
+
+// serializedConfigureOvsElementParams is used to (un)marshal ConfigureOvsElementParams using the API param names
+type serializedConfigureOvsElementParams struct {
+	Enabled *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ConfigureOvsElementParams) toSerialized() *serializedConfigureOvsElementParams {
+	s := &serializedConfigureOvsElementParams{}
+	if p.enabled.ok {
+		s.Enabled = &p.enabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ConfigureOvsElementParams) fromSerialized(s *serializedConfigureOvsElementParams) {
+	*p = ConfigureOvsElementParams{}
+	if s.Enabled != nil {
+		p.SetEnabled(*s.Enabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ConfigureOvsElementParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ConfigureOvsElementParams) UnmarshalJSON(b []byte) error {
+	var s serializedConfigureOvsElementParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ConfigureOvsElementParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ConfigureOvsElementParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedConfigureOvsElementParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ConfigureOvsElementParams instance,
 // as then you are sure you have configured all required params
 func (s *OvsElementService) NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams {
 	p := &ConfigureOvsElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["enabled"] = enabled
-	p.p["id"] = id
+	p.SetEnabled(enabled)
+	p.SetId(id)
 	return p
 }
 
 // Configures an ovs element.
-func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
-	resp, err := s.cs.newRequest("configureOvsElement", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: enabled, id.
+func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams, opts ...CallOption) (*OvsElementResponse, error) {
+	resp, err := s.cs.newRequest("configureOvsElement", s.cs.encodeParams("configureOvsElement", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +208,8 @@ func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -155,178 +244,293 @@ type OvsElementResponse struct {
 }
 
 type ListOvsElementsParams struct {
-	p map[string]interface{}
+	enabled  optBool
+	id       optString
+	keyword  optString
+	nspid    optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListOvsElementsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListOvsElementsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
+	if p.enabled.ok {
+		u.Set("enabled", strconv.FormatBool(p.enabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
+	if p.nspid.ok {
+		u.Set("nspid", p.nspid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListOvsElementsParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListOvsElementsParams parses url.Values, for example taken from a raw API request,
+// into a new ListOvsElementsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListOvsElementsParams(u url.Values) (*ListOvsElementsParams, error) {
+	p := &ListOvsElementsParams{}
+	if err := checkParamNames("listOvsElements", u, "enabled", "id", "keyword", "nspid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enabled"]; found {
+		v, err := strconv.ParseBool(u.Get("enabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabled: %v", err)
+		}
+		p.SetEnabled(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["enabled"] = v
+	if _, found := u["nspid"]; found {
+		p.SetNspid(u.Get("nspid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetEnabled sets the enabled param.
+func (p *ListOvsElementsParams) SetEnabled(v bool) {
+	p.enabled = optBool{v: v, ok: true}
 }
 
+// ResetEnabled unsets the enabled param
 func (p *ListOvsElementsParams) ResetEnabled() {
-	if p.p != nil && p.p["enabled"] != nil {
-		delete(p.p, "enabled")
-	}
+	p.enabled = optBool{}
 }
 
+// GetEnabled returns the enabled param and if it is set
 func (p *ListOvsElementsParams) GetEnabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabled"].(bool)
-	return value, ok
+	return p.enabled.v, p.enabled.ok
 }
 
+// SetId sets the id param.
 func (p *ListOvsElementsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListOvsElementsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListOvsElementsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListOvsElementsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListOvsElementsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListOvsElementsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNspid sets the nspid param.
 func (p *ListOvsElementsParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nspid"] = v
+	p.nspid = optString{v: v, ok: true}
 }
 
+// ResetNspid unsets the nspid param
 func (p *ListOvsElementsParams) ResetNspid() {
-	if p.p != nil && p.p["nspid"] != nil {
-		delete(p.p, "nspid")
-	}
+	p.nspid = optString{}
 }
 
+// GetNspid returns the nspid param and if it is set
 func (p *ListOvsElementsParams) GetNspid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nspid"].(string)
-	return value, ok
+	return p.nspid.v, p.nspid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListOvsElementsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListOvsElementsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListOvsElementsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListOvsElementsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListOvsElementsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListOvsElementsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListOvsElementsParams) Clone() *ListOvsElementsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListOvsElementsParams) Equal(o *ListOvsElementsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enabled == o.enabled &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.nspid == o.nspid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListOvsElementsParams is used to (un)marshal ListOvsElementsParams using the API param names
+type serializedListOvsElementsParams struct {
+	Enabled  *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nspid    *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListOvsElementsParams) toSerialized() *serializedListOvsElementsParams {
+	s := &serializedListOvsElementsParams{}
+	if p.enabled.ok {
+		s.Enabled = &p.enabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.nspid.ok {
+		s.Nspid = &p.nspid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListOvsElementsParams) fromSerialized(s *serializedListOvsElementsParams) {
+	*p = ListOvsElementsParams{}
+	if s.Enabled != nil {
+		p.SetEnabled(*s.Enabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Nspid != nil {
+		p.SetNspid(*s.Nspid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListOvsElementsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListOvsElementsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListOvsElementsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListOvsElementsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListOvsElementsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListOvsElementsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListOvsElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *OvsElementService) NewListOvsElementsParams() *ListOvsElementsParams {
 	p := &ListOvsElementsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error) {
 	p := &ListOvsElementsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -355,8 +559,8 @@ func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*O
 }
 
 // Lists all available ovs elements.
-func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
-	resp, err := s.cs.newRequest("listOvsElements", p.toURLValues())
+func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams, opts ...CallOption) (*ListOvsElementsResponse, error) {
+	resp, err := s.cs.newRequest("listOvsElements", s.cs.encodeParams("listOvsElements", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,18 +53,23 @@ func (m *MockOvsElementServiceIface) EXPECT() *MockOvsElementServiceIfaceMockRec
 }
 
 // ConfigureOvsElement mocks base method.
-func (m *MockOvsElementServiceIface) ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
+func (m *MockOvsElementServiceIface) ConfigureOvsElement(p *ConfigureOvsElementParams, opts ...CallOption) (*OvsElementResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigureOvsElement", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfigureOvsElement", varargs...)
 	ret0, _ := ret[0].(*OvsElementResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfigureOvsElement indicates an expected call of ConfigureOvsElement.
-func (mr *MockOvsElementServiceIfaceMockRecorder) ConfigureOvsElement(p interface{}) *gomock.Call {
+func (mr *MockOvsElementServiceIfaceMockRecorder) ConfigureOvsElement(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOvsElement", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ConfigureOvsElement), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOvsElement", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ConfigureOvsElement), varargs...)
 }
 
 // GetOvsElementByID mocks base method.
@@ -89,18 +94,23 @@ func (mr *MockOvsElementServiceIfaceMockRecorder) GetOvsElementByID(id interface
 }
 
 // ListOvsElements mocks base method.
-func (m *MockOvsElementServiceIface) ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
+func (m *MockOvsElementServiceIface) ListOvsElements(p *ListOvsElementsParams, opts ...CallOption) (*ListOvsElementsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOvsElements", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOvsElements", varargs...)
 	ret0, _ := ret[0].(*ListOvsElementsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListOvsElements indicates an expected call of ListOvsElements.
-func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElements(p interface{}) *gomock.Call {
+func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElements(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElements", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElements), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElements", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElements), varargs...)
 }
 
 // NewConfigureOvsElementParams mocks base method.
@@ -28,218 +28,333 @@ import (
 )
 
 type PodServiceIface interface {
-	CreatePod(p *CreatePodParams) (*CreatePodResponse, error)
+	CreatePod(p *CreatePodParams, opts ...CallOption) (*CreatePodResponse, error)
 	NewCreatePodParams(name string, zoneid string) *CreatePodParams
-	DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePod(p *DedicatePodParams, opts ...CallOption) (*DedicatePodResponse, error)
 	NewDedicatePodParams(domainid string, podid string) *DedicatePodParams
-	DeletePod(p *DeletePodParams) (*DeletePodResponse, error)
+	DeletePod(p *DeletePodParams, opts ...CallOption) (*DeletePodResponse, error)
 	NewDeletePodParams(id string) *DeletePodParams
-	ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPods(p *ListDedicatedPodsParams, opts ...CallOption) (*ListDedicatedPodsResponse, error)
 	NewListDedicatedPodsParams() *ListDedicatedPodsParams
-	ListPods(p *ListPodsParams) (*ListPodsResponse, error)
+	ListPods(p *ListPodsParams, opts ...CallOption) (*ListPodsResponse, error)
 	NewListPodsParams() *ListPodsParams
 	GetPodID(name string, opts ...OptionFunc) (string, int, error)
 	GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error)
 	GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error)
-	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams, opts ...CallOption) (*ReleaseDedicatedPodResponse, error)
 	NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams
-	UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error)
+	UpdatePod(p *UpdatePodParams, opts ...CallOption) (*UpdatePodResponse, error)
 	NewUpdatePodParams(id string) *UpdatePodParams
 }
 
 type CreatePodParams struct {
-	p map[string]interface{}
+	allocationstate optString
+	endip           optString
+	gateway         optString
+	name            optString
+	netmask         optString
+	startip         optString
+	zoneid          optString
 }
 
-func (p *CreatePodParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreatePodParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreatePodParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreatePodParams parses url.Values, for example taken from a raw API request,
+// into a new CreatePodParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreatePodParams(u url.Values) (*CreatePodParams, error) {
+	p := &CreatePodParams{}
+	if err := checkParamNames("createPod", u, "allocationstate", "endip", "gateway", "name", "netmask", "startip", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *CreatePodParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *CreatePodParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *CreatePodParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetEndip sets the endip param.
 func (p *CreatePodParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
+	p.endip = optString{v: v, ok: true}
 }
 
+// ResetEndip unsets the endip param
 func (p *CreatePodParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *CreatePodParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetGateway sets the gateway param.
 func (p *CreatePodParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *CreatePodParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *CreatePodParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreatePodParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreatePodParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreatePodParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetmask sets the netmask param.
 func (p *CreatePodParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *CreatePodParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *CreatePodParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetStartip sets the startip param.
 func (p *CreatePodParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *CreatePodParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *CreatePodParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	return p.startip.v, p.startip.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreatePodParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreatePodParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreatePodParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreatePodParams) Clone() *CreatePodParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreatePodParams) Equal(o *CreatePodParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.endip == o.endip &&
+		p.gateway == o.gateway &&
+		p.name == o.name &&
+		p.netmask == o.netmask &&
+		p.startip == o.startip &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreatePodParams is used to (un)marshal CreatePodParams using the API param names
+type serializedCreatePodParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Endip           *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway         *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Netmask         *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Startip         *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreatePodParams) toSerialized() *serializedCreatePodParams {
+	s := &serializedCreatePodParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreatePodParams) fromSerialized(s *serializedCreatePodParams) {
+	*p = CreatePodParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreatePodParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreatePodParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreatePodParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreatePodParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreatePodParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreatePodParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreatePodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewCreatePodParams(name string, zoneid string) *CreatePodParams {
 	p := &CreatePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Creates a new Pod.
-func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
-	resp, err := s.cs.newRequest("createPod", p.toURLValues())
+//
+// Required params: name, zoneid.
+func (s *PodService) CreatePod(p *CreatePodParams, opts ...CallOption) (*CreatePodResponse, error) {
+	resp, err := s.cs.newRequest("createPod", s.cs.encodeParams("createPod", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -300,102 +415,194 @@ type CreatePodResponseCapacity struct {
 }
 
 type DedicatePodParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
+	podid    optString
 }
 
-func (p *DedicatePodParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DedicatePodParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
 	return u
 }
 
-func (p *DedicatePodParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDedicatePodParams parses url.Values, for example taken from a raw API request,
+// into a new DedicatePodParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDedicatePodParams(u url.Values) (*DedicatePodParams, error) {
+	p := &DedicatePodParams{}
+	if err := checkParamNames("dedicatePod", u, "account", "domainid", "podid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *DedicatePodParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *DedicatePodParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DedicatePodParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *DedicatePodParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DedicatePodParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DedicatePodParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetPodid sets the podid param. This param is required.
 func (p *DedicatePodParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *DedicatePodParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *DedicatePodParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.podid.v, p.podid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DedicatePodParams) Clone() *DedicatePodParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DedicatePodParams) Equal(o *DedicatePodParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.podid == o.podid
+}
+
+// serializedDedicatePodParams is used to (un)marshal DedicatePodParams using the API param names
+type serializedDedicatePodParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Podid    *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+}
+
+func (p *DedicatePodParams) toSerialized() *serializedDedicatePodParams {
+	s := &serializedDedicatePodParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	return s
+}
+
+func (p *DedicatePodParams) fromSerialized(s *serializedDedicatePodParams) {
+	*p = DedicatePodParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
 	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DedicatePodParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DedicatePodParams) UnmarshalJSON(b []byte) error {
+	var s serializedDedicatePodParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DedicatePodParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DedicatePodParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDedicatePodParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DedicatePodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewDedicatePodParams(domainid string, podid string) *DedicatePodParams {
 	p := &DedicatePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
-	p.p["podid"] = podid
+	p.SetDomainid(domainid)
+	p.SetPodid(podid)
 	return p
 }
 
 // Dedicates a Pod.
-func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePod", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: domainid, podid.
+func (s *PodService) DedicatePod(p *DedicatePodParams, opts ...CallOption) (*DedicatePodResponse, error) {
+	resp, err := s.cs.newRequest("dedicatePod", s.cs.encodeParams("dedicatePod", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -406,8 +613,8 @@ func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, er
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -440,53 +647,132 @@ type DedicatePodResponse struct {
 }
 
 type DeletePodParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeletePodParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeletePodParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeletePodParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeletePodParams parses url.Values, for example taken from a raw API request,
+// into a new DeletePodParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeletePodParams(u url.Values) (*DeletePodParams, error) {
+	p := &DeletePodParams{}
+	if err := checkParamNames("deletePod", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeletePodParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeletePodParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeletePodParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeletePodParams) Clone() *DeletePodParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeletePodParams) Equal(o *DeletePodParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id == o.id
+}
+
+// serializedDeletePodParams is used to (un)marshal DeletePodParams using the API param names
+type serializedDeletePodParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeletePodParams) toSerialized() *serializedDeletePodParams {
+	s := &serializedDeletePodParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeletePodParams) fromSerialized(s *serializedDeletePodParams) {
+	*p = DeletePodParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeletePodParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeletePodParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeletePodParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeletePodParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeletePodParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeletePodParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeletePodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewDeletePodParams(id string) *DeletePodParams {
 	p := &DeletePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a Pod.
-func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
-	resp, err := s.cs.newRequest("deletePod", p.toURLValues())
+//
+// Required params: id.
+func (s *PodService) DeletePod(p *DeletePodParams, opts ...CallOption) (*DeletePodResponse, error) {
+	resp, err := s.cs.newRequest("deletePod", s.cs.encodeParams("deletePod", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -534,198 +820,317 @@ func (r *DeletePodResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListDedicatedPodsParams struct {
-	p map[string]interface{}
+	account         optString
+	affinitygroupid optString
+	domainid        optString
+	keyword         optString
+	page            optInt
+	pagesize        optInt
+	podid           optString
 }
 
-func (p *ListDedicatedPodsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDedicatedPodsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if p.affinitygroupid.ok {
+		u.Set("affinitygroupid", p.affinitygroupid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
 	return u
 }
 
-func (p *ListDedicatedPodsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDedicatedPodsParams parses url.Values, for example taken from a raw API request,
+// into a new ListDedicatedPodsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDedicatedPodsParams(u url.Values) (*ListDedicatedPodsParams, error) {
+	p := &ListDedicatedPodsParams{}
+	if err := checkParamNames("listDedicatedPods", u, "account", "affinitygroupid", "domainid", "keyword", "page", "pagesize", "podid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["affinitygroupid"]; found {
+		p.SetAffinitygroupid(u.Get("affinitygroupid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListDedicatedPodsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListDedicatedPodsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListDedicatedPodsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAffinitygroupid sets the affinitygroupid param.
 func (p *ListDedicatedPodsParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
+	p.affinitygroupid = optString{v: v, ok: true}
 }
 
+// ResetAffinitygroupid unsets the affinitygroupid param
 func (p *ListDedicatedPodsParams) ResetAffinitygroupid() {
-	if p.p != nil && p.p["affinitygroupid"] != nil {
-		delete(p.p, "affinitygroupid")
-	}
+	p.affinitygroupid = optString{}
 }
 
+// GetAffinitygroupid returns the affinitygroupid param and if it is set
 func (p *ListDedicatedPodsParams) GetAffinitygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupid"].(string)
-	return value, ok
+	return p.affinitygroupid.v, p.affinitygroupid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListDedicatedPodsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListDedicatedPodsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListDedicatedPodsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDedicatedPodsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDedicatedPodsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDedicatedPodsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDedicatedPodsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDedicatedPodsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDedicatedPodsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDedicatedPodsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDedicatedPodsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDedicatedPodsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListDedicatedPodsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListDedicatedPodsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListDedicatedPodsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.podid.v, p.podid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDedicatedPodsParams) Clone() *ListDedicatedPodsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDedicatedPodsParams) Equal(o *ListDedicatedPodsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.affinitygroupid == o.affinitygroupid &&
+		p.domainid == o.domainid &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid
+}
+
+// serializedListDedicatedPodsParams is used to (un)marshal ListDedicatedPodsParams using the API param names
+type serializedListDedicatedPodsParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid           *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+}
+
+func (p *ListDedicatedPodsParams) toSerialized() *serializedListDedicatedPodsParams {
+	s := &serializedListDedicatedPodsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.affinitygroupid.ok {
+		s.Affinitygroupid = &p.affinitygroupid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	return s
+}
+
+func (p *ListDedicatedPodsParams) fromSerialized(s *serializedListDedicatedPodsParams) {
+	*p = ListDedicatedPodsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
 	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	if s.Affinitygroupid != nil {
+		p.SetAffinitygroupid(*s.Affinitygroupid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDedicatedPodsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDedicatedPodsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDedicatedPodsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDedicatedPodsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDedicatedPodsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDedicatedPodsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDedicatedPodsParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
 	p := &ListDedicatedPodsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists dedicated pods.
-func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedPods", p.toURLValues())
+func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams, opts ...CallOption) (*ListDedicatedPodsResponse, error) {
+	resp, err := s.cs.newRequest("listDedicatedPods", s.cs.encodeParams("listDedicatedPods", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -755,226 +1160,353 @@ type DedicatedPod struct {
 }
 
 type ListPodsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListPodsParams) toURLValues() url.Values {
+	allocationstate optString
+	id              optString
+	keyword         optString
+	name            optString
+	page            optInt
+	pagesize        optInt
+	showcapacities  optBool
+	zoneid          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPodsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
+	if p.showcapacities.ok {
+		u.Set("showcapacities", strconv.FormatBool(p.showcapacities.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListPodsParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPodsParams parses url.Values, for example taken from a raw API request,
+// into a new ListPodsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPodsParams(u url.Values) (*ListPodsParams, error) {
+	p := &ListPodsParams{}
+	if err := checkParamNames("listPods", u, "allocationstate", "id", "keyword", "name", "page", "pagesize", "showcapacities", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showcapacities"]; found {
+		v, err := strconv.ParseBool(u.Get("showcapacities"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showcapacities: %v", err)
+		}
+		p.SetShowcapacities(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *ListPodsParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *ListPodsParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *ListPodsParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetId sets the id param.
 func (p *ListPodsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListPodsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListPodsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListPodsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPodsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPodsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListPodsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListPodsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListPodsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPodsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPodsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPodsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPodsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPodsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPodsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetShowcapacities sets the showcapacities param.
 func (p *ListPodsParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
+	p.showcapacities = optBool{v: v, ok: true}
 }
 
+// ResetShowcapacities unsets the showcapacities param
 func (p *ListPodsParams) ResetShowcapacities() {
-	if p.p != nil && p.p["showcapacities"] != nil {
-		delete(p.p, "showcapacities")
-	}
+	p.showcapacities = optBool{}
 }
 
+// GetShowcapacities returns the showcapacities param and if it is set
 func (p *ListPodsParams) GetShowcapacities() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showcapacities"].(bool)
-	return value, ok
+	return p.showcapacities.v, p.showcapacities.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListPodsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListPodsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListPodsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPodsParams) Clone() *ListPodsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPodsParams) Equal(o *ListPodsParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return p.allocationstate == o.allocationstate &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showcapacities == o.showcapacities &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListPodsParams is used to (un)marshal ListPodsParams using the API param names
+type serializedListPodsParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showcapacities  *bool   `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListPodsParams) toSerialized() *serializedListPodsParams {
+	s := &serializedListPodsParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showcapacities.ok {
+		s.Showcapacities = &p.showcapacities.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListPodsParams) fromSerialized(s *serializedListPodsParams) {
+	*p = ListPodsParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showcapacities != nil {
+		p.SetShowcapacities(*s.Showcapacities)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPodsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPodsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPodsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPodsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPodsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPodsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPodsParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewListPodsParams() *ListPodsParams {
 	p := &ListPodsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPodsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1022,9 +1554,8 @@ func (s *PodService) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, e
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error) {
 	p := &ListPodsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1053,8 +1584,8 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 }
 
 // Lists all Pods.
-func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
-	resp, err := s.cs.newRequest("listPods", p.toURLValues())
+func (s *PodService) ListPods(p *ListPodsParams, opts ...CallOption) (*ListPodsResponse, error) {
+	resp, err := s.cs.newRequest("listPods", s.cs.encodeParams("listPods", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1116,53 +1647,133 @@ type PodCapacity struct {
 }
 
 type ReleaseDedicatedPodParams struct {
-	p map[string]interface{}
+	podid optString
 }
 
-func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleaseDedicatedPodParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
 	return u
 }
 
-func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleaseDedicatedPodParams parses url.Values, for example taken from a raw API request,
+// into a new ReleaseDedicatedPodParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleaseDedicatedPodParams(u url.Values) (*ReleaseDedicatedPodParams, error) {
+	p := &ReleaseDedicatedPodParams{}
+	if err := checkParamNames("releaseDedicatedPod", u, "podid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["podid"] = v
+	return p, nil
 }
 
+// SetPodid sets the podid param. This param is required.
+func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
+	p.podid = optString{v: v, ok: true}
+}
+
+// ResetPodid unsets the podid param
 func (p *ReleaseDedicatedPodParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ReleaseDedicatedPodParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.podid.v, p.podid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleaseDedicatedPodParams) Clone() *ReleaseDedicatedPodParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleaseDedicatedPodParams) Equal(o *ReleaseDedicatedPodParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.podid == o.podid
+}
+
+// serializedReleaseDedicatedPodParams is used to (un)marshal ReleaseDedicatedPodParams using the API param names
+type serializedReleaseDedicatedPodParams struct {
+	Podid *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+}
+
+func (p *ReleaseDedicatedPodParams) toSerialized() *serializedReleaseDedicatedPodParams {
+	s := &serializedReleaseDedicatedPodParams{}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
 	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ReleaseDedicatedPodParams) fromSerialized(s *serializedReleaseDedicatedPodParams) {
+	*p = ReleaseDedicatedPodParams{}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleaseDedicatedPodParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleaseDedicatedPodParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleaseDedicatedPodParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleaseDedicatedPodParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleaseDedicatedPodParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleaseDedicatedPodParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleaseDedicatedPodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams {
 	p := &ReleaseDedicatedPodParams{}
-	p.p = make(map[string]interface{})
-	p.p["podid"] = podid
+	p.SetPodid(podid)
 	return p
 }
 
-// Release the dedication for the pod
-func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedPod", p.toURLValues())
+// Release the dedication for the pod.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: podid.
+func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams, opts ...CallOption) (*ReleaseDedicatedPodResponse, error) {
+	resp, err := s.cs.newRequest("releaseDedicatedPod", s.cs.encodeParams("releaseDedicatedPod", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1173,8 +1784,8 @@ func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*Release
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1198,197 +1809,312 @@ type ReleaseDedicatedPodResponse struct {
 }
 
 type UpdatePodParams struct {
-	p map[string]interface{}
+	allocationstate optString
+	endip           optString
+	gateway         optString
+	id              optString
+	name            optString
+	netmask         optString
+	startip         optString
 }
 
-func (p *UpdatePodParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdatePodParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
 	return u
 }
 
-func (p *UpdatePodParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdatePodParams parses url.Values, for example taken from a raw API request,
+// into a new UpdatePodParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdatePodParams(u url.Values) (*UpdatePodParams, error) {
+	p := &UpdatePodParams{}
+	if err := checkParamNames("updatePod", u, "allocationstate", "endip", "gateway", "id", "name", "netmask", "startip"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["allocationstate"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
+	}
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *UpdatePodParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *UpdatePodParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *UpdatePodParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetEndip sets the endip param.
 func (p *UpdatePodParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
+	p.endip = optString{v: v, ok: true}
 }
 
+// ResetEndip unsets the endip param
 func (p *UpdatePodParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *UpdatePodParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetGateway sets the gateway param.
 func (p *UpdatePodParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *UpdatePodParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *UpdatePodParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdatePodParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdatePodParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdatePodParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdatePodParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdatePodParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdatePodParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetmask sets the netmask param.
 func (p *UpdatePodParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *UpdatePodParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *UpdatePodParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetStartip sets the startip param.
 func (p *UpdatePodParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *UpdatePodParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *UpdatePodParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.startip.v, p.startip.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdatePodParams) Clone() *UpdatePodParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdatePodParams) Equal(o *UpdatePodParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.endip == o.endip &&
+		p.gateway == o.gateway &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.netmask == o.netmask &&
+		p.startip == o.startip
+}
+
+// serializedUpdatePodParams is used to (un)marshal UpdatePodParams using the API param names
+type serializedUpdatePodParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Endip           *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway         *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Netmask         *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Startip         *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+}
+
+func (p *UpdatePodParams) toSerialized() *serializedUpdatePodParams {
+	s := &serializedUpdatePodParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	return s
+}
+
+func (p *UpdatePodParams) fromSerialized(s *serializedUpdatePodParams) {
+	*p = UpdatePodParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdatePodParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdatePodParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdatePodParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdatePodParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdatePodParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdatePodParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdatePodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
 	p := &UpdatePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a Pod.
-func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
-	resp, err := s.cs.newRequest("updatePod", p.toURLValues())
+//
+// Required params: id.
+func (s *PodService) UpdatePod(p *UpdatePodParams, opts ...CallOption) (*UpdatePodResponse, error) {
+	resp, err := s.cs.newRequest("updatePod", s.cs.encodeParams("updatePod", p), opts...)
 	if err != nil {
 		return nil, err
 	}
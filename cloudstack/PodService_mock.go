@@ -53,48 +53,63 @@ func (m *MockPodServiceIface) EXPECT() *MockPodServiceIfaceMockRecorder {
 }
 
 // CreatePod mocks base method.
-func (m *MockPodServiceIface) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
+func (m *MockPodServiceIface) CreatePod(p *CreatePodParams, opts ...CallOption) (*CreatePodResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePod", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePod", varargs...)
 	ret0, _ := ret[0].(*CreatePodResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePod indicates an expected call of CreatePod.
-func (mr *MockPodServiceIfaceMockRecorder) CreatePod(p interface{}) *gomock.Call {
+func (mr *MockPodServiceIfaceMockRecorder) CreatePod(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePod", reflect.TypeOf((*MockPodServiceIface)(nil).CreatePod), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePod", reflect.TypeOf((*MockPodServiceIface)(nil).CreatePod), varargs...)
 }
 
 // DedicatePod mocks base method.
-func (m *MockPodServiceIface) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
+func (m *MockPodServiceIface) DedicatePod(p *DedicatePodParams, opts ...CallOption) (*DedicatePodResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DedicatePod", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DedicatePod", varargs...)
 	ret0, _ := ret[0].(*DedicatePodResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DedicatePod indicates an expected call of DedicatePod.
-func (mr *MockPodServiceIfaceMockRecorder) DedicatePod(p interface{}) *gomock.Call {
+func (mr *MockPodServiceIfaceMockRecorder) DedicatePod(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePod", reflect.TypeOf((*MockPodServiceIface)(nil).DedicatePod), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePod", reflect.TypeOf((*MockPodServiceIface)(nil).DedicatePod), varargs...)
 }
 
 // DeletePod mocks base method.
-func (m *MockPodServiceIface) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
+func (m *MockPodServiceIface) DeletePod(p *DeletePodParams, opts ...CallOption) (*DeletePodResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePod", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePod", varargs...)
 	ret0, _ := ret[0].(*DeletePodResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeletePod indicates an expected call of DeletePod.
-func (mr *MockPodServiceIfaceMockRecorder) DeletePod(p interface{}) *gomock.Call {
+func (mr *MockPodServiceIfaceMockRecorder) DeletePod(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePod", reflect.TypeOf((*MockPodServiceIface)(nil).DeletePod), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePod", reflect.TypeOf((*MockPodServiceIface)(nil).DeletePod), varargs...)
 }
 
 // GetPodByID mocks base method.
@@ -161,33 +176,43 @@ func (mr *MockPodServiceIfaceMockRecorder) GetPodID(name interface{}, opts ...in
 }
 
 // ListDedicatedPods mocks base method.
-func (m *MockPodServiceIface) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+func (m *MockPodServiceIface) ListDedicatedPods(p *ListDedicatedPodsParams, opts ...CallOption) (*ListDedicatedPodsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDedicatedPods", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedPods", varargs...)
 	ret0, _ := ret[0].(*ListDedicatedPodsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDedicatedPods indicates an expected call of ListDedicatedPods.
-func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPods(p interface{}) *gomock.Call {
+func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPods(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPods), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPods), varargs...)
 }
 
 // ListPods mocks base method.
-func (m *MockPodServiceIface) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
+func (m *MockPodServiceIface) ListPods(p *ListPodsParams, opts ...CallOption) (*ListPodsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPods", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPods", varargs...)
 	ret0, _ := ret[0].(*ListPodsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPods indicates an expected call of ListPods.
-func (mr *MockPodServiceIfaceMockRecorder) ListPods(p interface{}) *gomock.Call {
+func (mr *MockPodServiceIfaceMockRecorder) ListPods(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListPods), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListPods), varargs...)
 }
 
 // NewCreatePodParams mocks base method.
@@ -289,31 +314,41 @@ func (mr *MockPodServiceIfaceMockRecorder) NewUpdatePodParams(id interface{}) *g
 }
 
 // ReleaseDedicatedPod mocks base method.
-func (m *MockPodServiceIface) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+func (m *MockPodServiceIface) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams, opts ...CallOption) (*ReleaseDedicatedPodResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseDedicatedPod", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseDedicatedPod", varargs...)
 	ret0, _ := ret[0].(*ReleaseDedicatedPodResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseDedicatedPod indicates an expected call of ReleaseDedicatedPod.
-func (mr *MockPodServiceIfaceMockRecorder) ReleaseDedicatedPod(p interface{}) *gomock.Call {
+func (mr *MockPodServiceIfaceMockRecorder) ReleaseDedicatedPod(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedPod", reflect.TypeOf((*MockPodServiceIface)(nil).ReleaseDedicatedPod), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedPod", reflect.TypeOf((*MockPodServiceIface)(nil).ReleaseDedicatedPod), varargs...)
 }
 
 // UpdatePod mocks base method.
-func (m *MockPodServiceIface) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
+func (m *MockPodServiceIface) UpdatePod(p *UpdatePodParams, opts ...CallOption) (*UpdatePodResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePod", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePod", varargs...)
 	ret0, _ := ret[0].(*UpdatePodResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdatePod indicates an expected call of UpdatePod.
-func (mr *MockPodServiceIfaceMockRecorder) UpdatePod(p interface{}) *gomock.Call {
+func (mr *MockPodServiceIfaceMockRecorder) UpdatePod(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePod", reflect.TypeOf((*MockPodServiceIface)(nil).UpdatePod), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePod", reflect.TypeOf((*MockPodServiceIface)(nil).UpdatePod), varargs...)
 }
@@ -28,392 +28,564 @@ import (
 )
 
 type PoolServiceIface interface {
-	CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	CreateStoragePool(p *CreateStoragePoolParams, opts ...CallOption) (*CreateStoragePoolResponse, error)
 	NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams
-	DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	DeleteStoragePool(p *DeleteStoragePoolParams, opts ...CallOption) (*DeleteStoragePoolResponse, error)
 	NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams
-	FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams, opts ...CallOption) (*FindStoragePoolsForMigrationResponse, error)
 	NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams
-	ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	ListStoragePools(p *ListStoragePoolsParams, opts ...CallOption) (*ListStoragePoolsResponse, error)
 	NewListStoragePoolsParams() *ListStoragePoolsParams
 	GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
 	GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error)
 	GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error)
-	SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error)
+	SyncStoragePool(p *SyncStoragePoolParams, opts ...CallOption) (*SyncStoragePoolResponse, error)
 	NewSyncStoragePoolParams(id string) *SyncStoragePoolParams
-	UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+	UpdateStoragePool(p *UpdateStoragePoolParams, opts ...CallOption) (*UpdateStoragePoolResponse, error)
 	NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams
 }
 
 type CreateStoragePoolParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateStoragePoolParams) toURLValues() url.Values {
+	capacitybytes optInt64
+	capacityiops  optInt64
+	clusterid     optString
+	details       optStringMap
+	hypervisor    optString
+	istagarule    optBool
+	managed       optBool
+	name          optString
+	podid         optString
+	provider      optString
+	scope         optString
+	tags          optString
+	url           optString
+	zoneid        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateStoragePoolParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["capacitybytes"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("capacitybytes", vv)
+	if p.capacitybytes.ok {
+		u.Set("capacitybytes", strconv.FormatInt(p.capacitybytes.v, 10))
 	}
-	if v, found := p.p["capacityiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("capacityiops", vv)
+	if p.capacityiops.ok {
+		u.Set("capacityiops", strconv.FormatInt(p.capacityiops.v, 10))
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["istagarule"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("istagarule", vv)
+	if p.istagarule.ok {
+		u.Set("istagarule", strconv.FormatBool(p.istagarule.v))
 	}
-	if v, found := p.p["managed"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("managed", vv)
+	if p.managed.ok {
+		u.Set("managed", strconv.FormatBool(p.managed.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
+	if p.provider.ok {
+		u.Set("provider", p.provider.v)
 	}
-	if v, found := p.p["scope"]; found {
-		u.Set("scope", v.(string))
+	if p.scope.ok {
+		u.Set("scope", p.scope.v)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateStoragePoolParams parses url.Values, for example taken from a raw API request,
+// into a new CreateStoragePoolParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateStoragePoolParams(u url.Values) (*CreateStoragePoolParams, error) {
+	p := &CreateStoragePoolParams{}
+	if err := checkParamNames("createStoragePool", u, "capacitybytes", "capacityiops", "clusterid", "details[]", "hypervisor", "istagarule", "managed", "name", "podid", "provider", "scope", "tags", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["capacitybytes"]; found {
+		v, err := strconv.ParseInt(u.Get("capacitybytes"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param capacitybytes: %v", err)
+		}
+		p.SetCapacitybytes(v)
+	}
+	if _, found := u["capacityiops"]; found {
+		v, err := strconv.ParseInt(u.Get("capacityiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param capacityiops: %v", err)
+		}
+		p.SetCapacityiops(v)
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
 	}
-	p.p["capacitybytes"] = v
+	if _, found := u["istagarule"]; found {
+		v, err := strconv.ParseBool(u.Get("istagarule"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param istagarule: %v", err)
+		}
+		p.SetIstagarule(v)
+	}
+	if _, found := u["managed"]; found {
+		v, err := strconv.ParseBool(u.Get("managed"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param managed: %v", err)
+		}
+		p.SetManaged(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["provider"]; found {
+		p.SetProvider(u.Get("provider"))
+	}
+	if _, found := u["scope"]; found {
+		p.SetScope(u.Get("scope"))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetCapacitybytes sets the capacitybytes param.
+func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
+	p.capacitybytes = optInt64{v: v, ok: true}
 }
 
+// ResetCapacitybytes unsets the capacitybytes param
 func (p *CreateStoragePoolParams) ResetCapacitybytes() {
-	if p.p != nil && p.p["capacitybytes"] != nil {
-		delete(p.p, "capacitybytes")
-	}
+	p.capacitybytes = optInt64{}
 }
 
+// GetCapacitybytes returns the capacitybytes param and if it is set
 func (p *CreateStoragePoolParams) GetCapacitybytes() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["capacitybytes"].(int64)
-	return value, ok
+	return p.capacitybytes.v, p.capacitybytes.ok
 }
 
+// SetCapacityiops sets the capacityiops param.
 func (p *CreateStoragePoolParams) SetCapacityiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["capacityiops"] = v
+	p.capacityiops = optInt64{v: v, ok: true}
 }
 
+// ResetCapacityiops unsets the capacityiops param
 func (p *CreateStoragePoolParams) ResetCapacityiops() {
-	if p.p != nil && p.p["capacityiops"] != nil {
-		delete(p.p, "capacityiops")
-	}
+	p.capacityiops = optInt64{}
 }
 
+// GetCapacityiops returns the capacityiops param and if it is set
 func (p *CreateStoragePoolParams) GetCapacityiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["capacityiops"].(int64)
-	return value, ok
+	return p.capacityiops.v, p.capacityiops.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *CreateStoragePoolParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *CreateStoragePoolParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *CreateStoragePoolParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDetails sets the details param.
 func (p *CreateStoragePoolParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *CreateStoragePoolParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *CreateStoragePoolParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *CreateStoragePoolParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *CreateStoragePoolParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *CreateStoragePoolParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetIstagarule sets the istagarule param.
 func (p *CreateStoragePoolParams) SetIstagarule(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["istagarule"] = v
+	p.istagarule = optBool{v: v, ok: true}
 }
 
+// ResetIstagarule unsets the istagarule param
 func (p *CreateStoragePoolParams) ResetIstagarule() {
-	if p.p != nil && p.p["istagarule"] != nil {
-		delete(p.p, "istagarule")
-	}
+	p.istagarule = optBool{}
 }
 
+// GetIstagarule returns the istagarule param and if it is set
 func (p *CreateStoragePoolParams) GetIstagarule() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["istagarule"].(bool)
-	return value, ok
+	return p.istagarule.v, p.istagarule.ok
 }
 
+// SetManaged sets the managed param.
 func (p *CreateStoragePoolParams) SetManaged(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["managed"] = v
+	p.managed = optBool{v: v, ok: true}
 }
 
+// ResetManaged unsets the managed param
 func (p *CreateStoragePoolParams) ResetManaged() {
-	if p.p != nil && p.p["managed"] != nil {
-		delete(p.p, "managed")
-	}
+	p.managed = optBool{}
 }
 
+// GetManaged returns the managed param and if it is set
 func (p *CreateStoragePoolParams) GetManaged() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["managed"].(bool)
-	return value, ok
+	return p.managed.v, p.managed.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateStoragePoolParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateStoragePoolParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateStoragePoolParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPodid sets the podid param.
 func (p *CreateStoragePoolParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *CreateStoragePoolParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *CreateStoragePoolParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProvider sets the provider param.
 func (p *CreateStoragePoolParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+	p.provider = optString{v: v, ok: true}
 }
 
+// ResetProvider unsets the provider param
 func (p *CreateStoragePoolParams) ResetProvider() {
-	if p.p != nil && p.p["provider"] != nil {
-		delete(p.p, "provider")
-	}
+	p.provider = optString{}
 }
 
+// GetProvider returns the provider param and if it is set
 func (p *CreateStoragePoolParams) GetProvider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provider"].(string)
-	return value, ok
+	return p.provider.v, p.provider.ok
 }
 
+// SetScope sets the scope param.
 func (p *CreateStoragePoolParams) SetScope(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scope"] = v
+	p.scope = optString{v: v, ok: true}
 }
 
+// ResetScope unsets the scope param
 func (p *CreateStoragePoolParams) ResetScope() {
-	if p.p != nil && p.p["scope"] != nil {
-		delete(p.p, "scope")
-	}
+	p.scope = optString{}
 }
 
+// GetScope returns the scope param and if it is set
 func (p *CreateStoragePoolParams) GetScope() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scope"].(string)
-	return value, ok
+	return p.scope.v, p.scope.ok
 }
 
+// SetTags sets the tags param.
 func (p *CreateStoragePoolParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optString{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreateStoragePoolParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optString{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreateStoragePoolParams) GetTags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *CreateStoragePoolParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *CreateStoragePoolParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *CreateStoragePoolParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreateStoragePoolParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateStoragePoolParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateStoragePoolParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateStoragePoolParams) Clone() *CreateStoragePoolParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateStoragePoolParams) Equal(o *CreateStoragePoolParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.capacitybytes == o.capacitybytes &&
+		p.capacityiops == o.capacityiops &&
+		p.clusterid == o.clusterid &&
+		p.details.equal(o.details) &&
+		p.hypervisor == o.hypervisor &&
+		p.istagarule == o.istagarule &&
+		p.managed == o.managed &&
+		p.name == o.name &&
+		p.podid == o.podid &&
+		p.provider == o.provider &&
+		p.scope == o.scope &&
+		p.tags == o.tags &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateStoragePoolParams is used to (un)marshal CreateStoragePoolParams using the API param names
+type serializedCreateStoragePoolParams struct {
+	Capacitybytes *int64             `json:"capacitybytes,omitempty" yaml:"capacitybytes,omitempty"`
+	Capacityiops  *int64             `json:"capacityiops,omitempty" yaml:"capacityiops,omitempty"`
+	Clusterid     *string            `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Details       *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Hypervisor    *string            `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Istagarule    *bool              `json:"istagarule,omitempty" yaml:"istagarule,omitempty"`
+	Managed       *bool              `json:"managed,omitempty" yaml:"managed,omitempty"`
+	Name          *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Podid         *string            `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Provider      *string            `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Scope         *string            `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Tags          *string            `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Url           *string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid        *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateStoragePoolParams) toSerialized() *serializedCreateStoragePoolParams {
+	s := &serializedCreateStoragePoolParams{}
+	if p.capacitybytes.ok {
+		s.Capacitybytes = &p.capacitybytes.v
+	}
+	if p.capacityiops.ok {
+		s.Capacityiops = &p.capacityiops.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.istagarule.ok {
+		s.Istagarule = &p.istagarule.v
+	}
+	if p.managed.ok {
+		s.Managed = &p.managed.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.provider.ok {
+		s.Provider = &p.provider.v
+	}
+	if p.scope.ok {
+		s.Scope = &p.scope.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateStoragePoolParams) fromSerialized(s *serializedCreateStoragePoolParams) {
+	*p = CreateStoragePoolParams{}
+	if s.Capacitybytes != nil {
+		p.SetCapacitybytes(*s.Capacitybytes)
+	}
+	if s.Capacityiops != nil {
+		p.SetCapacityiops(*s.Capacityiops)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Istagarule != nil {
+		p.SetIstagarule(*s.Istagarule)
+	}
+	if s.Managed != nil {
+		p.SetManaged(*s.Managed)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Provider != nil {
+		p.SetProvider(*s.Provider)
+	}
+	if s.Scope != nil {
+		p.SetScope(*s.Scope)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateStoragePoolParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateStoragePoolParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateStoragePoolParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateStoragePoolParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateStoragePoolParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams {
 	p := &CreateStoragePoolParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Creates a storage pool.
-func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("createStoragePool", p.toURLValues())
+//
+// Required params: name, url, zoneid.
+func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams, opts ...CallOption) (*CreateStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest("createStoragePool", s.cs.encodeParams("createStoragePool", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -463,78 +635,166 @@ type CreateStoragePoolResponse struct {
 }
 
 type DeleteStoragePoolParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *DeleteStoragePoolParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteStoragePoolParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteStoragePoolParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteStoragePoolParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteStoragePoolParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteStoragePoolParams(u url.Values) (*DeleteStoragePoolParams, error) {
+	p := &DeleteStoragePoolParams{}
+	if err := checkParamNames("deleteStoragePool", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["forced"] = v
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *DeleteStoragePoolParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *DeleteStoragePoolParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *DeleteStoragePoolParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteStoragePoolParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteStoragePoolParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteStoragePoolParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteStoragePoolParams) Clone() *DeleteStoragePoolParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteStoragePoolParams) Equal(o *DeleteStoragePoolParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedDeleteStoragePoolParams is used to (un)marshal DeleteStoragePoolParams using the API param names
+type serializedDeleteStoragePoolParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteStoragePoolParams) toSerialized() *serializedDeleteStoragePoolParams {
+	s := &serializedDeleteStoragePoolParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteStoragePoolParams) fromSerialized(s *serializedDeleteStoragePoolParams) {
+	*p = DeleteStoragePoolParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteStoragePoolParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteStoragePoolParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteStoragePoolParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteStoragePoolParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteStoragePoolParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams {
 	p := &DeleteStoragePoolParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a storage pool.
-func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("deleteStoragePool", p.toURLValues())
+//
+// Required params: id.
+func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams, opts ...CallOption) (*DeleteStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest("deleteStoragePool", s.cs.encodeParams("deleteStoragePool", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -582,127 +842,230 @@ func (r *DeleteStoragePoolResponse) UnmarshalJSON(b []byte) error {
 }
 
 type FindStoragePoolsForMigrationParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *FindStoragePoolsForMigrationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseFindStoragePoolsForMigrationParams parses url.Values, for example taken from a raw API request,
+// into a new FindStoragePoolsForMigrationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseFindStoragePoolsForMigrationParams(u url.Values) (*FindStoragePoolsForMigrationParams, error) {
+	p := &FindStoragePoolsForMigrationParams{}
+	if err := checkParamNames("findStoragePoolsForMigration", u, "id", "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["id"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *FindStoragePoolsForMigrationParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *FindStoragePoolsForMigrationParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *FindStoragePoolsForMigrationParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *FindStoragePoolsForMigrationParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *FindStoragePoolsForMigrationParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *FindStoragePoolsForMigrationParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *FindStoragePoolsForMigrationParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *FindStoragePoolsForMigrationParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *FindStoragePoolsForMigrationParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *FindStoragePoolsForMigrationParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *FindStoragePoolsForMigrationParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *FindStoragePoolsForMigrationParams) Clone() *FindStoragePoolsForMigrationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *FindStoragePoolsForMigrationParams) Equal(o *FindStoragePoolsForMigrationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedFindStoragePoolsForMigrationParams is used to (un)marshal FindStoragePoolsForMigrationParams using the API param names
+type serializedFindStoragePoolsForMigrationParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *FindStoragePoolsForMigrationParams) toSerialized() *serializedFindStoragePoolsForMigrationParams {
+	s := &serializedFindStoragePoolsForMigrationParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return s
+}
+
+func (p *FindStoragePoolsForMigrationParams) fromSerialized(s *serializedFindStoragePoolsForMigrationParams) {
+	*p = FindStoragePoolsForMigrationParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p FindStoragePoolsForMigrationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *FindStoragePoolsForMigrationParams) UnmarshalJSON(b []byte) error {
+	var s serializedFindStoragePoolsForMigrationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p FindStoragePoolsForMigrationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *FindStoragePoolsForMigrationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedFindStoragePoolsForMigrationParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new FindStoragePoolsForMigrationParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams {
 	p := &FindStoragePoolsForMigrationParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Lists storage pools available for migration of a volume.
-func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findStoragePoolsForMigration", p.toURLValues())
+//
+// Required params: id.
+func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams, opts ...CallOption) (*FindStoragePoolsForMigrationResponse, error) {
+	resp, err := s.cs.newRequest("findStoragePoolsForMigration", s.cs.encodeParams("findStoragePoolsForMigration", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -748,345 +1111,499 @@ type FindStoragePoolsForMigrationResponse struct {
 }
 
 type ListStoragePoolsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListStoragePoolsParams) toURLValues() url.Values {
+	clusterid optString
+	hostid    optString
+	id        optString
+	ipaddress optString
+	keyword   optString
+	name      optString
+	page      optInt
+	pagesize  optInt
+	path      optString
+	podid     optString
+	scope     optString
+	status    optString
+	zoneid    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListStoragePoolsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["path"]; found {
-		u.Set("path", v.(string))
+	if p.path.ok {
+		u.Set("path", p.path.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["scope"]; found {
-		u.Set("scope", v.(string))
+	if p.scope.ok {
+		u.Set("scope", p.scope.v)
 	}
-	if v, found := p.p["status"]; found {
-		u.Set("status", v.(string))
+	if p.status.ok {
+		u.Set("status", p.status.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListStoragePoolsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListStoragePoolsParams parses url.Values, for example taken from a raw API request,
+// into a new ListStoragePoolsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListStoragePoolsParams(u url.Values) (*ListStoragePoolsParams, error) {
+	p := &ListStoragePoolsParams{}
+	if err := checkParamNames("listStoragePools", u, "clusterid", "hostid", "id", "ipaddress", "keyword", "name", "page", "pagesize", "path", "podid", "scope", "status", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
 	}
-	p.p["clusterid"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["path"]; found {
+		p.SetPath(u.Get("path"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["scope"]; found {
+		p.SetScope(u.Get("scope"))
+	}
+	if _, found := u["status"]; found {
+		p.SetStatus(u.Get("status"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetClusterid sets the clusterid param.
+func (p *ListStoragePoolsParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
+}
+
+// ResetClusterid unsets the clusterid param
 func (p *ListStoragePoolsParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListStoragePoolsParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListStoragePoolsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListStoragePoolsParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListStoragePoolsParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param.
 func (p *ListStoragePoolsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListStoragePoolsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListStoragePoolsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *ListStoragePoolsParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *ListStoragePoolsParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *ListStoragePoolsParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListStoragePoolsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListStoragePoolsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListStoragePoolsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListStoragePoolsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListStoragePoolsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListStoragePoolsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListStoragePoolsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListStoragePoolsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListStoragePoolsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListStoragePoolsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListStoragePoolsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListStoragePoolsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPath sets the path param.
 func (p *ListStoragePoolsParams) SetPath(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["path"] = v
+	p.path = optString{v: v, ok: true}
 }
 
+// ResetPath unsets the path param
 func (p *ListStoragePoolsParams) ResetPath() {
-	if p.p != nil && p.p["path"] != nil {
-		delete(p.p, "path")
-	}
+	p.path = optString{}
 }
 
+// GetPath returns the path param and if it is set
 func (p *ListStoragePoolsParams) GetPath() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["path"].(string)
-	return value, ok
+	return p.path.v, p.path.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListStoragePoolsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListStoragePoolsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListStoragePoolsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetScope sets the scope param.
 func (p *ListStoragePoolsParams) SetScope(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scope"] = v
+	p.scope = optString{v: v, ok: true}
 }
 
+// ResetScope unsets the scope param
 func (p *ListStoragePoolsParams) ResetScope() {
-	if p.p != nil && p.p["scope"] != nil {
-		delete(p.p, "scope")
-	}
+	p.scope = optString{}
 }
 
+// GetScope returns the scope param and if it is set
 func (p *ListStoragePoolsParams) GetScope() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["scope"].(string)
-	return value, ok
+	return p.scope.v, p.scope.ok
 }
 
+// SetStatus sets the status param.
 func (p *ListStoragePoolsParams) SetStatus(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["status"] = v
+	p.status = optString{v: v, ok: true}
 }
 
+// ResetStatus unsets the status param
 func (p *ListStoragePoolsParams) ResetStatus() {
-	if p.p != nil && p.p["status"] != nil {
-		delete(p.p, "status")
-	}
+	p.status = optString{}
 }
 
+// GetStatus returns the status param and if it is set
 func (p *ListStoragePoolsParams) GetStatus() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["status"].(string)
-	return value, ok
+	return p.status.v, p.status.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListStoragePoolsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListStoragePoolsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListStoragePoolsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListStoragePoolsParams) Clone() *ListStoragePoolsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListStoragePoolsParams) Equal(o *ListStoragePoolsParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return p.clusterid == o.clusterid &&
+		p.hostid == o.hostid &&
+		p.id == o.id &&
+		p.ipaddress == o.ipaddress &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.path == o.path &&
+		p.podid == o.podid &&
+		p.scope == o.scope &&
+		p.status == o.status &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListStoragePoolsParams is used to (un)marshal ListStoragePoolsParams using the API param names
+type serializedListStoragePoolsParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Hostid    *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddress *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Keyword   *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page      *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Path      *string `json:"path,omitempty" yaml:"path,omitempty"`
+	Podid     *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Scope     *string `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Status    *string `json:"status,omitempty" yaml:"status,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListStoragePoolsParams) toSerialized() *serializedListStoragePoolsParams {
+	s := &serializedListStoragePoolsParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.path.ok {
+		s.Path = &p.path.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.scope.ok {
+		s.Scope = &p.scope.v
+	}
+	if p.status.ok {
+		s.Status = &p.status.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListStoragePoolsParams) fromSerialized(s *serializedListStoragePoolsParams) {
+	*p = ListStoragePoolsParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Path != nil {
+		p.SetPath(*s.Path)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Scope != nil {
+		p.SetScope(*s.Scope)
+	}
+	if s.Status != nil {
+		p.SetStatus(*s.Status)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListStoragePoolsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListStoragePoolsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListStoragePoolsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListStoragePoolsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListStoragePoolsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListStoragePoolsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListStoragePoolsParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewListStoragePoolsParams() *ListStoragePoolsParams {
 	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1134,9 +1651,8 @@ func (s *PoolService) GetStoragePoolByName(name string, opts ...OptionFunc) (*St
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error) {
 	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1165,8 +1681,8 @@ func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*Storag
 }
 
 // Lists storage pools.
-func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
-	resp, err := s.cs.newRequest("listStoragePools", p.toURLValues())
+func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams, opts ...CallOption) (*ListStoragePoolsResponse, error) {
+	resp, err := s.cs.newRequest("listStoragePools", s.cs.encodeParams("listStoragePools", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1217,53 +1733,134 @@ type StoragePool struct {
 }
 
 type SyncStoragePoolParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *SyncStoragePoolParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *SyncStoragePoolParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *SyncStoragePoolParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseSyncStoragePoolParams parses url.Values, for example taken from a raw API request,
+// into a new SyncStoragePoolParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseSyncStoragePoolParams(u url.Values) (*SyncStoragePoolParams, error) {
+	p := &SyncStoragePoolParams{}
+	if err := checkParamNames("syncStoragePool", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *SyncStoragePoolParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *SyncStoragePoolParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *SyncStoragePoolParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *SyncStoragePoolParams) Clone() *SyncStoragePoolParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *SyncStoragePoolParams) Equal(o *SyncStoragePoolParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id == o.id
+}
+
+// serializedSyncStoragePoolParams is used to (un)marshal SyncStoragePoolParams using the API param names
+type serializedSyncStoragePoolParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *SyncStoragePoolParams) toSerialized() *serializedSyncStoragePoolParams {
+	s := &serializedSyncStoragePoolParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *SyncStoragePoolParams) fromSerialized(s *serializedSyncStoragePoolParams) {
+	*p = SyncStoragePoolParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p SyncStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *SyncStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var s serializedSyncStoragePoolParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p SyncStoragePoolParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *SyncStoragePoolParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedSyncStoragePoolParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new SyncStoragePoolParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewSyncStoragePoolParams(id string) *SyncStoragePoolParams {
 	p := &SyncStoragePoolParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Sync storage pool with management server (currently supported for Datastore Cluster in VMware and syncs the datastores in it)
-func (s *PoolService) SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("syncStoragePool", p.toURLValues())
+// Sync storage pool with management server (currently supported for Datastore Cluster in VMware and
+// syncs the datastores in it).
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *PoolService) SyncStoragePool(p *SyncStoragePoolParams, opts ...CallOption) (*SyncStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest("syncStoragePool", s.cs.encodeParams("syncStoragePool", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1274,8 +1871,8 @@ func (s *PoolService) SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoo
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1329,253 +1926,395 @@ type SyncStoragePoolResponse struct {
 }
 
 type UpdateStoragePoolParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateStoragePoolParams) toURLValues() url.Values {
+	capacitybytes optInt64
+	capacityiops  optInt64
+	details       optStringMap
+	enabled       optBool
+	id            optString
+	istagarule    optBool
+	name          optString
+	tags          optStrings
+	url           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateStoragePoolParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["capacitybytes"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("capacitybytes", vv)
+	if p.capacitybytes.ok {
+		u.Set("capacitybytes", strconv.FormatInt(p.capacitybytes.v, 10))
 	}
-	if v, found := p.p["capacityiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("capacityiops", vv)
+	if p.capacityiops.ok {
+		u.Set("capacityiops", strconv.FormatInt(p.capacityiops.v, 10))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
+	if p.enabled.ok {
+		u.Set("enabled", strconv.FormatBool(p.enabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["istagarule"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("istagarule", vv)
+	if p.istagarule.ok {
+		u.Set("istagarule", strconv.FormatBool(p.istagarule.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["tags"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("tags", vv)
+	if p.tags.ok {
+		u.Set("tags", strings.Join(p.tags.v, ","))
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
 	return u
 }
 
-func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateStoragePoolParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateStoragePoolParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateStoragePoolParams(u url.Values) (*UpdateStoragePoolParams, error) {
+	p := &UpdateStoragePoolParams{}
+	if err := checkParamNames("updateStoragePool", u, "capacitybytes", "capacityiops", "details[]", "enabled", "id", "istagarule", "name", "tags", "url"); err != nil {
+		return nil, err
+	}
+	if _, found := u["capacitybytes"]; found {
+		v, err := strconv.ParseInt(u.Get("capacitybytes"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param capacitybytes: %v", err)
+		}
+		p.SetCapacitybytes(v)
 	}
-	p.p["capacitybytes"] = v
+	if _, found := u["capacityiops"]; found {
+		v, err := strconv.ParseInt(u.Get("capacityiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param capacityiops: %v", err)
+		}
+		p.SetCapacityiops(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["enabled"]; found {
+		v, err := strconv.ParseBool(u.Get("enabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabled: %v", err)
+		}
+		p.SetEnabled(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["istagarule"]; found {
+		v, err := strconv.ParseBool(u.Get("istagarule"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param istagarule: %v", err)
+		}
+		p.SetIstagarule(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(strings.Split(u.Get("tags"), ","))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	return p, nil
+}
+
+// SetCapacitybytes sets the capacitybytes param.
+func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
+	p.capacitybytes = optInt64{v: v, ok: true}
 }
 
+// ResetCapacitybytes unsets the capacitybytes param
 func (p *UpdateStoragePoolParams) ResetCapacitybytes() {
-	if p.p != nil && p.p["capacitybytes"] != nil {
-		delete(p.p, "capacitybytes")
-	}
+	p.capacitybytes = optInt64{}
 }
 
+// GetCapacitybytes returns the capacitybytes param and if it is set
 func (p *UpdateStoragePoolParams) GetCapacitybytes() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["capacitybytes"].(int64)
-	return value, ok
+	return p.capacitybytes.v, p.capacitybytes.ok
 }
 
+// SetCapacityiops sets the capacityiops param.
 func (p *UpdateStoragePoolParams) SetCapacityiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["capacityiops"] = v
+	p.capacityiops = optInt64{v: v, ok: true}
 }
 
+// ResetCapacityiops unsets the capacityiops param
 func (p *UpdateStoragePoolParams) ResetCapacityiops() {
-	if p.p != nil && p.p["capacityiops"] != nil {
-		delete(p.p, "capacityiops")
-	}
+	p.capacityiops = optInt64{}
 }
 
+// GetCapacityiops returns the capacityiops param and if it is set
 func (p *UpdateStoragePoolParams) GetCapacityiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["capacityiops"].(int64)
-	return value, ok
+	return p.capacityiops.v, p.capacityiops.ok
 }
 
+// SetDetails sets the details param.
 func (p *UpdateStoragePoolParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *UpdateStoragePoolParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *UpdateStoragePoolParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetEnabled sets the enabled param.
 func (p *UpdateStoragePoolParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enabled"] = v
+	p.enabled = optBool{v: v, ok: true}
 }
 
+// ResetEnabled unsets the enabled param
 func (p *UpdateStoragePoolParams) ResetEnabled() {
-	if p.p != nil && p.p["enabled"] != nil {
-		delete(p.p, "enabled")
-	}
+	p.enabled = optBool{}
 }
 
+// GetEnabled returns the enabled param and if it is set
 func (p *UpdateStoragePoolParams) GetEnabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabled"].(bool)
-	return value, ok
+	return p.enabled.v, p.enabled.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateStoragePoolParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateStoragePoolParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateStoragePoolParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIstagarule sets the istagarule param.
 func (p *UpdateStoragePoolParams) SetIstagarule(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["istagarule"] = v
+	p.istagarule = optBool{v: v, ok: true}
 }
 
+// ResetIstagarule unsets the istagarule param
 func (p *UpdateStoragePoolParams) ResetIstagarule() {
-	if p.p != nil && p.p["istagarule"] != nil {
-		delete(p.p, "istagarule")
-	}
+	p.istagarule = optBool{}
 }
 
+// GetIstagarule returns the istagarule param and if it is set
 func (p *UpdateStoragePoolParams) GetIstagarule() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["istagarule"].(bool)
-	return value, ok
+	return p.istagarule.v, p.istagarule.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateStoragePoolParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateStoragePoolParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateStoragePoolParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetTags sets the tags param.
 func (p *UpdateStoragePoolParams) SetTags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStrings{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *UpdateStoragePoolParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStrings{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *UpdateStoragePoolParams) GetTags() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].([]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetUrl sets the url param.
 func (p *UpdateStoragePoolParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *UpdateStoragePoolParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *UpdateStoragePoolParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.url.v, p.url.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateStoragePoolParams) Clone() *UpdateStoragePoolParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	c := *p
+	c.details = p.details.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateStoragePoolParams) Equal(o *UpdateStoragePoolParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.capacitybytes == o.capacitybytes &&
+		p.capacityiops == o.capacityiops &&
+		p.details.equal(o.details) &&
+		p.enabled == o.enabled &&
+		p.id == o.id &&
+		p.istagarule == o.istagarule &&
+		p.name == o.name &&
+		p.tags.equal(o.tags) &&
+		p.url == o.url
+}
+
+// serializedUpdateStoragePoolParams is used to (un)marshal UpdateStoragePoolParams using the API param names
+type serializedUpdateStoragePoolParams struct {
+	Capacitybytes *int64             `json:"capacitybytes,omitempty" yaml:"capacitybytes,omitempty"`
+	Capacityiops  *int64             `json:"capacityiops,omitempty" yaml:"capacityiops,omitempty"`
+	Details       *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Enabled       *bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id            *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Istagarule    *bool              `json:"istagarule,omitempty" yaml:"istagarule,omitempty"`
+	Name          *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Tags          *[]string          `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Url           *string            `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+func (p *UpdateStoragePoolParams) toSerialized() *serializedUpdateStoragePoolParams {
+	s := &serializedUpdateStoragePoolParams{}
+	if p.capacitybytes.ok {
+		s.Capacitybytes = &p.capacitybytes.v
+	}
+	if p.capacityiops.ok {
+		s.Capacityiops = &p.capacityiops.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.enabled.ok {
+		s.Enabled = &p.enabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.istagarule.ok {
+		s.Istagarule = &p.istagarule.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	return s
+}
+
+func (p *UpdateStoragePoolParams) fromSerialized(s *serializedUpdateStoragePoolParams) {
+	*p = UpdateStoragePoolParams{}
+	if s.Capacitybytes != nil {
+		p.SetCapacitybytes(*s.Capacitybytes)
+	}
+	if s.Capacityiops != nil {
+		p.SetCapacityiops(*s.Capacityiops)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Enabled != nil {
+		p.SetEnabled(*s.Enabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Istagarule != nil {
+		p.SetIstagarule(*s.Istagarule)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateStoragePoolParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateStoragePoolParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateStoragePoolParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateStoragePoolParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateStoragePoolParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams {
 	p := &UpdateStoragePoolParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a storage pool.
-func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("updateStoragePool", p.toURLValues())
+//
+// Required params: id.
+func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams, opts ...CallOption) (*UpdateStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest("updateStoragePool", s.cs.encodeParams("updateStoragePool", p), opts...)
 	if err != nil {
 		return nil, err
 	}
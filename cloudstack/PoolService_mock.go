@@ -53,48 +53,63 @@ func (m *MockPoolServiceIface) EXPECT() *MockPoolServiceIfaceMockRecorder {
 }
 
 // CreateStoragePool mocks base method.
-func (m *MockPoolServiceIface) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+func (m *MockPoolServiceIface) CreateStoragePool(p *CreateStoragePoolParams, opts ...CallOption) (*CreateStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateStoragePool", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateStoragePool", varargs...)
 	ret0, _ := ret[0].(*CreateStoragePoolResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateStoragePool indicates an expected call of CreateStoragePool.
-func (mr *MockPoolServiceIfaceMockRecorder) CreateStoragePool(p interface{}) *gomock.Call {
+func (mr *MockPoolServiceIfaceMockRecorder) CreateStoragePool(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).CreateStoragePool), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).CreateStoragePool), varargs...)
 }
 
 // DeleteStoragePool mocks base method.
-func (m *MockPoolServiceIface) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+func (m *MockPoolServiceIface) DeleteStoragePool(p *DeleteStoragePoolParams, opts ...CallOption) (*DeleteStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteStoragePool", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStoragePool", varargs...)
 	ret0, _ := ret[0].(*DeleteStoragePoolResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteStoragePool indicates an expected call of DeleteStoragePool.
-func (mr *MockPoolServiceIfaceMockRecorder) DeleteStoragePool(p interface{}) *gomock.Call {
+func (mr *MockPoolServiceIfaceMockRecorder) DeleteStoragePool(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).DeleteStoragePool), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).DeleteStoragePool), varargs...)
 }
 
 // FindStoragePoolsForMigration mocks base method.
-func (m *MockPoolServiceIface) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
+func (m *MockPoolServiceIface) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams, opts ...CallOption) (*FindStoragePoolsForMigrationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindStoragePoolsForMigration", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindStoragePoolsForMigration", varargs...)
 	ret0, _ := ret[0].(*FindStoragePoolsForMigrationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindStoragePoolsForMigration indicates an expected call of FindStoragePoolsForMigration.
-func (mr *MockPoolServiceIfaceMockRecorder) FindStoragePoolsForMigration(p interface{}) *gomock.Call {
+func (mr *MockPoolServiceIfaceMockRecorder) FindStoragePoolsForMigration(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStoragePoolsForMigration", reflect.TypeOf((*MockPoolServiceIface)(nil).FindStoragePoolsForMigration), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStoragePoolsForMigration", reflect.TypeOf((*MockPoolServiceIface)(nil).FindStoragePoolsForMigration), varargs...)
 }
 
 // GetStoragePoolByID mocks base method.
@@ -161,18 +176,23 @@ func (mr *MockPoolServiceIfaceMockRecorder) GetStoragePoolID(name interface{}, o
 }
 
 // ListStoragePools mocks base method.
-func (m *MockPoolServiceIface) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+func (m *MockPoolServiceIface) ListStoragePools(p *ListStoragePoolsParams, opts ...CallOption) (*ListStoragePoolsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListStoragePools", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePools", varargs...)
 	ret0, _ := ret[0].(*ListStoragePoolsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListStoragePools indicates an expected call of ListStoragePools.
-func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePools(p interface{}) *gomock.Call {
+func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePools(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePools", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePools), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePools", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePools), varargs...)
 }
 
 // NewCreateStoragePoolParams mocks base method.
@@ -260,31 +280,41 @@ func (mr *MockPoolServiceIfaceMockRecorder) NewUpdateStoragePoolParams(id interf
 }
 
 // SyncStoragePool mocks base method.
-func (m *MockPoolServiceIface) SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error) {
+func (m *MockPoolServiceIface) SyncStoragePool(p *SyncStoragePoolParams, opts ...CallOption) (*SyncStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncStoragePool", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncStoragePool", varargs...)
 	ret0, _ := ret[0].(*SyncStoragePoolResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SyncStoragePool indicates an expected call of SyncStoragePool.
-func (mr *MockPoolServiceIfaceMockRecorder) SyncStoragePool(p interface{}) *gomock.Call {
+func (mr *MockPoolServiceIfaceMockRecorder) SyncStoragePool(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).SyncStoragePool), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).SyncStoragePool), varargs...)
 }
 
 // UpdateStoragePool mocks base method.
-func (m *MockPoolServiceIface) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
+func (m *MockPoolServiceIface) UpdateStoragePool(p *UpdateStoragePoolParams, opts ...CallOption) (*UpdateStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStoragePool", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStoragePool", varargs...)
 	ret0, _ := ret[0].(*UpdateStoragePoolResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStoragePool indicates an expected call of UpdateStoragePool.
-func (mr *MockPoolServiceIfaceMockRecorder) UpdateStoragePool(p interface{}) *gomock.Call {
+func (mr *MockPoolServiceIfaceMockRecorder) UpdateStoragePool(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).UpdateStoragePool), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).UpdateStoragePool), varargs...)
 }
@@ -28,188 +28,302 @@ import (
 )
 
 type PortableIPServiceIface interface {
-	CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRange(p *CreatePortableIpRangeParams, opts ...CallOption) (*CreatePortableIpRangeResponse, error)
 	NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams
-	DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRange(p *DeletePortableIpRangeParams, opts ...CallOption) (*DeletePortableIpRangeResponse, error)
 	NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams
-	ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRanges(p *ListPortableIpRangesParams, opts ...CallOption) (*ListPortableIpRangesResponse, error)
 	NewListPortableIpRangesParams() *ListPortableIpRangesParams
 	GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error)
 }
 
 type CreatePortableIpRangeParams struct {
-	p map[string]interface{}
+	endip    optString
+	gateway  optString
+	netmask  optString
+	regionid optInt
+	startip  optString
+	vlan     optString
 }
 
-func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreatePortableIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
+	if p.regionid.ok {
+		u.Set("regionid", strconv.Itoa(p.regionid.v))
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
 	return u
 }
 
-func (p *CreatePortableIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreatePortableIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new CreatePortableIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreatePortableIpRangeParams(u url.Values) (*CreatePortableIpRangeParams, error) {
+	p := &CreatePortableIpRangeParams{}
+	if err := checkParamNames("createPortableIpRange", u, "endip", "gateway", "netmask", "regionid", "startip", "vlan"); err != nil {
+		return nil, err
+	}
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["regionid"]; found {
+		v, err := strconv.Atoi(u.Get("regionid"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param regionid: %v", err)
+		}
+		p.SetRegionid(v)
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
 	}
-	p.p["endip"] = v
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	return p, nil
 }
 
+// SetEndip sets the endip param. This param is required.
+func (p *CreatePortableIpRangeParams) SetEndip(v string) {
+	p.endip = optString{v: v, ok: true}
+}
+
+// ResetEndip unsets the endip param
 func (p *CreatePortableIpRangeParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *CreatePortableIpRangeParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetGateway sets the gateway param. This param is required.
 func (p *CreatePortableIpRangeParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *CreatePortableIpRangeParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *CreatePortableIpRangeParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetNetmask sets the netmask param. This param is required.
 func (p *CreatePortableIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *CreatePortableIpRangeParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *CreatePortableIpRangeParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetRegionid sets the regionid param. This param is required.
 func (p *CreatePortableIpRangeParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
+	p.regionid = optInt{v: v, ok: true}
 }
 
+// ResetRegionid unsets the regionid param
 func (p *CreatePortableIpRangeParams) ResetRegionid() {
-	if p.p != nil && p.p["regionid"] != nil {
-		delete(p.p, "regionid")
-	}
+	p.regionid = optInt{}
 }
 
+// GetRegionid returns the regionid param and if it is set
 func (p *CreatePortableIpRangeParams) GetRegionid() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["regionid"].(int)
-	return value, ok
+	return p.regionid.v, p.regionid.ok
 }
 
+// SetStartip sets the startip param. This param is required.
 func (p *CreatePortableIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *CreatePortableIpRangeParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *CreatePortableIpRangeParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	return p.startip.v, p.startip.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *CreatePortableIpRangeParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *CreatePortableIpRangeParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *CreatePortableIpRangeParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vlan.v, p.vlan.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreatePortableIpRangeParams) Clone() *CreatePortableIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreatePortableIpRangeParams) Equal(o *CreatePortableIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.endip == o.endip &&
+		p.gateway == o.gateway &&
+		p.netmask == o.netmask &&
+		p.regionid == o.regionid &&
+		p.startip == o.startip &&
+		p.vlan == o.vlan
+}
+
+// serializedCreatePortableIpRangeParams is used to (un)marshal CreatePortableIpRangeParams using the API param names
+type serializedCreatePortableIpRangeParams struct {
+	Endip    *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway  *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Netmask  *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Regionid *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+	Startip  *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Vlan     *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+func (p *CreatePortableIpRangeParams) toSerialized() *serializedCreatePortableIpRangeParams {
+	s := &serializedCreatePortableIpRangeParams{}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.regionid.ok {
+		s.Regionid = &p.regionid.v
+	}
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	return s
+}
+
+func (p *CreatePortableIpRangeParams) fromSerialized(s *serializedCreatePortableIpRangeParams) {
+	*p = CreatePortableIpRangeParams{}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Regionid != nil {
+		p.SetRegionid(*s.Regionid)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreatePortableIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreatePortableIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreatePortableIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreatePortableIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreatePortableIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreatePortableIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreatePortableIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams {
 	p := &CreatePortableIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["endip"] = endip
-	p.p["gateway"] = gateway
-	p.p["netmask"] = netmask
-	p.p["regionid"] = regionid
-	p.p["startip"] = startip
+	p.SetEndip(endip)
+	p.SetGateway(gateway)
+	p.SetNetmask(netmask)
+	p.SetRegionid(regionid)
+	p.SetStartip(startip)
 	return p
 }
 
-// adds a range of portable public IP's to a region
-func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createPortableIpRange", p.toURLValues())
+// adds a range of portable public IP's to a region.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: endip, gateway, netmask,
+// regionid, startip.
+func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams, opts ...CallOption) (*CreatePortableIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("createPortableIpRange", s.cs.encodeParams("createPortableIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -220,8 +334,8 @@ func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -269,53 +383,133 @@ type CreatePortableIpRangeResponsePortableipaddress struct {
 }
 
 type DeletePortableIpRangeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeletePortableIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeletePortableIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeletePortableIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new DeletePortableIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeletePortableIpRangeParams(u url.Values) (*DeletePortableIpRangeParams, error) {
+	p := &DeletePortableIpRangeParams{}
+	if err := checkParamNames("deletePortableIpRange", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeletePortableIpRangeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeletePortableIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeletePortableIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeletePortableIpRangeParams) Clone() *DeletePortableIpRangeParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeletePortableIpRangeParams) Equal(o *DeletePortableIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeletePortableIpRangeParams is used to (un)marshal DeletePortableIpRangeParams using the API param names
+type serializedDeletePortableIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeletePortableIpRangeParams) toSerialized() *serializedDeletePortableIpRangeParams {
+	s := &serializedDeletePortableIpRangeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeletePortableIpRangeParams) fromSerialized(s *serializedDeletePortableIpRangeParams) {
+	*p = DeletePortableIpRangeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeletePortableIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeletePortableIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeletePortableIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeletePortableIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeletePortableIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeletePortableIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeletePortableIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *PortableIPService) NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams {
 	p := &DeletePortableIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// deletes a range of portable public IP's associated with a region
-func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deletePortableIpRange", p.toURLValues())
+// deletes a range of portable public IP's associated with a region.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams, opts ...CallOption) (*DeletePortableIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("deletePortableIpRange", s.cs.encodeParams("deletePortableIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -326,8 +520,8 @@ func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -351,154 +545,263 @@ type DeletePortableIpRangeResponse struct {
 }
 
 type ListPortableIpRangesParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	regionid optInt
 }
 
-func (p *ListPortableIpRangesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPortableIpRangesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
+	if p.regionid.ok {
+		u.Set("regionid", strconv.Itoa(p.regionid.v))
 	}
 	return u
 }
 
-func (p *ListPortableIpRangesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPortableIpRangesParams parses url.Values, for example taken from a raw API request,
+// into a new ListPortableIpRangesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPortableIpRangesParams(u url.Values) (*ListPortableIpRangesParams, error) {
+	p := &ListPortableIpRangesParams{}
+	if err := checkParamNames("listPortableIpRanges", u, "id", "keyword", "page", "pagesize", "regionid"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["regionid"]; found {
+		v, err := strconv.Atoi(u.Get("regionid"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param regionid: %v", err)
+		}
+		p.SetRegionid(v)
+	}
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListPortableIpRangesParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListPortableIpRangesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListPortableIpRangesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListPortableIpRangesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPortableIpRangesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPortableIpRangesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPortableIpRangesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPortableIpRangesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPortableIpRangesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPortableIpRangesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPortableIpRangesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPortableIpRangesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetRegionid sets the regionid param.
 func (p *ListPortableIpRangesParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
+	p.regionid = optInt{v: v, ok: true}
 }
 
+// ResetRegionid unsets the regionid param
 func (p *ListPortableIpRangesParams) ResetRegionid() {
-	if p.p != nil && p.p["regionid"] != nil {
-		delete(p.p, "regionid")
-	}
+	p.regionid = optInt{}
 }
 
+// GetRegionid returns the regionid param and if it is set
 func (p *ListPortableIpRangesParams) GetRegionid() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.regionid.v, p.regionid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPortableIpRangesParams) Clone() *ListPortableIpRangesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPortableIpRangesParams) Equal(o *ListPortableIpRangesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.regionid == o.regionid
+}
+
+// serializedListPortableIpRangesParams is used to (un)marshal ListPortableIpRangesParams using the API param names
+type serializedListPortableIpRangesParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Regionid *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+}
+
+func (p *ListPortableIpRangesParams) toSerialized() *serializedListPortableIpRangesParams {
+	s := &serializedListPortableIpRangesParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.regionid.ok {
+		s.Regionid = &p.regionid.v
+	}
+	return s
+}
+
+func (p *ListPortableIpRangesParams) fromSerialized(s *serializedListPortableIpRangesParams) {
+	*p = ListPortableIpRangesParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Regionid != nil {
+		p.SetRegionid(*s.Regionid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPortableIpRangesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPortableIpRangesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPortableIpRangesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPortableIpRangesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPortableIpRangesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPortableIpRangesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["regionid"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPortableIpRangesParams instance,
 // as then you are sure you have configured all required params
 func (s *PortableIPService) NewListPortableIpRangesParams() *ListPortableIpRangesParams {
 	p := &ListPortableIpRangesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
 	p := &ListPortableIpRangesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -526,9 +829,9 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc
 	return nil, l.Count, fmt.Errorf("There is more then one result for PortableIpRange UUID: %s!", id)
 }
 
-// list portable IP ranges
-func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listPortableIpRanges", p.toURLValues())
+// list portable IP ranges.
+func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams, opts ...CallOption) (*ListPortableIpRangesResponse, error) {
+	resp, err := s.cs.newRequest("listPortableIpRanges", s.cs.encodeParams("listPortableIpRanges", p), opts...)
 	if err != nil {
 		return nil, err
 	}
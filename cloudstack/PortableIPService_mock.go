@@ -53,33 +53,43 @@ func (m *MockPortableIPServiceIface) EXPECT() *MockPortableIPServiceIfaceMockRec
 }
 
 // CreatePortableIpRange mocks base method.
-func (m *MockPortableIPServiceIface) CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
+func (m *MockPortableIPServiceIface) CreatePortableIpRange(p *CreatePortableIpRangeParams, opts ...CallOption) (*CreatePortableIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePortableIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePortableIpRange", varargs...)
 	ret0, _ := ret[0].(*CreatePortableIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePortableIpRange indicates an expected call of CreatePortableIpRange.
-func (mr *MockPortableIPServiceIfaceMockRecorder) CreatePortableIpRange(p interface{}) *gomock.Call {
+func (mr *MockPortableIPServiceIfaceMockRecorder) CreatePortableIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortableIpRange", reflect.TypeOf((*MockPortableIPServiceIface)(nil).CreatePortableIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortableIpRange", reflect.TypeOf((*MockPortableIPServiceIface)(nil).CreatePortableIpRange), varargs...)
 }
 
 // DeletePortableIpRange mocks base method.
-func (m *MockPortableIPServiceIface) DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
+func (m *MockPortableIPServiceIface) DeletePortableIpRange(p *DeletePortableIpRangeParams, opts ...CallOption) (*DeletePortableIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePortableIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePortableIpRange", varargs...)
 	ret0, _ := ret[0].(*DeletePortableIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeletePortableIpRange indicates an expected call of DeletePortableIpRange.
-func (mr *MockPortableIPServiceIfaceMockRecorder) DeletePortableIpRange(p interface{}) *gomock.Call {
+func (mr *MockPortableIPServiceIfaceMockRecorder) DeletePortableIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortableIpRange", reflect.TypeOf((*MockPortableIPServiceIface)(nil).DeletePortableIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortableIpRange", reflect.TypeOf((*MockPortableIPServiceIface)(nil).DeletePortableIpRange), varargs...)
 }
 
 // GetPortableIpRangeByID mocks base method.
@@ -104,18 +114,23 @@ func (mr *MockPortableIPServiceIfaceMockRecorder) GetPortableIpRangeByID(id inte
 }
 
 // ListPortableIpRanges mocks base method.
-func (m *MockPortableIPServiceIface) ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
+func (m *MockPortableIPServiceIface) ListPortableIpRanges(p *ListPortableIpRangesParams, opts ...CallOption) (*ListPortableIpRangesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPortableIpRanges", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPortableIpRanges", varargs...)
 	ret0, _ := ret[0].(*ListPortableIpRangesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPortableIpRanges indicates an expected call of ListPortableIpRanges.
-func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRanges(p interface{}) *gomock.Call {
+func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRanges(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRanges", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRanges), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRanges", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRanges), varargs...)
 }
 
 // NewCreatePortableIpRangeParams mocks base method.
@@ -28,94 +28,174 @@ import (
 )
 
 type ProjectServiceIface interface {
-	ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProject(p *ActivateProjectParams, opts ...CallOption) (*ActivateProjectResponse, error)
 	NewActivateProjectParams(id string) *ActivateProjectParams
-	AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProject(p *AddAccountToProjectParams, opts ...CallOption) (*AddAccountToProjectResponse, error)
 	NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams
-	AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error)
+	AddUserToProject(p *AddUserToProjectParams, opts ...CallOption) (*AddUserToProjectResponse, error)
 	NewAddUserToProjectParams(projectid string, username string) *AddUserToProjectParams
-	CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProject(p *CreateProjectParams, opts ...CallOption) (*CreateProjectResponse, error)
 	NewCreateProjectParams(displaytext string, name string) *CreateProjectParams
-	DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProject(p *DeleteAccountFromProjectParams, opts ...CallOption) (*DeleteAccountFromProjectResponse, error)
 	NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams
-	DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error)
+	DeleteUserFromProject(p *DeleteUserFromProjectParams, opts ...CallOption) (*DeleteUserFromProjectResponse, error)
 	NewDeleteUserFromProjectParams(projectid string, userid string) *DeleteUserFromProjectParams
-	DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProject(p *DeleteProjectParams, opts ...CallOption) (*DeleteProjectResponse, error)
 	NewDeleteProjectParams(id string) *DeleteProjectParams
-	DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitation(p *DeleteProjectInvitationParams, opts ...CallOption) (*DeleteProjectInvitationResponse, error)
 	NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams
-	ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitations(p *ListProjectInvitationsParams, opts ...CallOption) (*ListProjectInvitationsResponse, error)
 	NewListProjectInvitationsParams() *ListProjectInvitationsParams
 	GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
-	ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error)
+	ListProjects(p *ListProjectsParams, opts ...CallOption) (*ListProjectsResponse, error)
 	NewListProjectsParams() *ListProjectsParams
 	GetProjectID(name string, opts ...OptionFunc) (string, int, error)
 	GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error)
 	GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error)
-	SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProject(p *SuspendProjectParams, opts ...CallOption) (*SuspendProjectResponse, error)
 	NewSuspendProjectParams(id string) *SuspendProjectParams
-	UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProject(p *UpdateProjectParams, opts ...CallOption) (*UpdateProjectResponse, error)
 	NewUpdateProjectParams(id string) *UpdateProjectParams
-	UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitation(p *UpdateProjectInvitationParams, opts ...CallOption) (*UpdateProjectInvitationResponse, error)
 	NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams
-	ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error)
+	ListProjectRolePermissions(p *ListProjectRolePermissionsParams, opts ...CallOption) (*ListProjectRolePermissionsResponse, error)
 	NewListProjectRolePermissionsParams(projectid string) *ListProjectRolePermissionsParams
-	CreateProjectRolePermission(p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error)
+	CreateProjectRolePermission(p *CreateProjectRolePermissionParams, opts ...CallOption) (*CreateProjectRolePermissionResponse, error)
 	NewCreateProjectRolePermissionParams(permission string, projectid string, projectroleid string, rule string) *CreateProjectRolePermissionParams
-	UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error)
+	UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams, opts ...CallOption) (*UpdateProjectRolePermissionResponse, error)
 	NewUpdateProjectRolePermissionParams(projectid string, projectroleid string) *UpdateProjectRolePermissionParams
-	DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error)
+	DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams, opts ...CallOption) (*DeleteProjectRolePermissionResponse, error)
 	NewDeleteProjectRolePermissionParams(id string, projectid string) *DeleteProjectRolePermissionParams
 }
 
 type ActivateProjectParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ActivateProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ActivateProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ActivateProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseActivateProjectParams parses url.Values, for example taken from a raw API request,
+// into a new ActivateProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseActivateProjectParams(u url.Values) (*ActivateProjectParams, error) {
+	p := &ActivateProjectParams{}
+	if err := checkParamNames("activateProject", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *ActivateProjectParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ActivateProjectParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ActivateProjectParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ActivateProjectParams) Clone() *ActivateProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ActivateProjectParams) Equal(o *ActivateProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedActivateProjectParams is used to (un)marshal ActivateProjectParams using the API param names
+type serializedActivateProjectParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ActivateProjectParams) toSerialized() *serializedActivateProjectParams {
+	s := &serializedActivateProjectParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ActivateProjectParams) fromSerialized(s *serializedActivateProjectParams) {
+	*p = ActivateProjectParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ActivateProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ActivateProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedActivateProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ActivateProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ActivateProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedActivateProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ActivateProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectParams {
 	p := &ActivateProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Activates a project
-func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
-	resp, err := s.cs.newRequest("activateProject", p.toURLValues())
+// Activates a project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *ProjectService) ActivateProject(p *ActivateProjectParams, opts ...CallOption) (*ActivateProjectResponse, error) {
+	resp, err := s.cs.newRequest("activateProject", s.cs.encodeParams("activateProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -126,8 +206,8 @@ func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivatePro
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -200,149 +280,253 @@ type ActivateProjectResponse struct {
 }
 
 type AddAccountToProjectParams struct {
-	p map[string]interface{}
+	account       optString
+	email         optString
+	projectid     optString
+	projectroleid optString
+	roletype      optString
 }
 
-func (p *AddAccountToProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddAccountToProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["email"]; found {
-		u.Set("email", v.(string))
+	if p.email.ok {
+		u.Set("email", p.email.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["projectroleid"]; found {
-		u.Set("projectroleid", v.(string))
+	if p.projectroleid.ok {
+		u.Set("projectroleid", p.projectroleid.v)
 	}
-	if v, found := p.p["roletype"]; found {
-		u.Set("roletype", v.(string))
+	if p.roletype.ok {
+		u.Set("roletype", p.roletype.v)
 	}
 	return u
 }
 
-func (p *AddAccountToProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddAccountToProjectParams parses url.Values, for example taken from a raw API request,
+// into a new AddAccountToProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddAccountToProjectParams(u url.Values) (*AddAccountToProjectParams, error) {
+	p := &AddAccountToProjectParams{}
+	if err := checkParamNames("addAccountToProject", u, "account", "email", "projectid", "projectroleid", "roletype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["email"]; found {
+		p.SetEmail(u.Get("email"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	p.p["account"] = v
+	if _, found := u["projectroleid"]; found {
+		p.SetProjectroleid(u.Get("projectroleid"))
+	}
+	if _, found := u["roletype"]; found {
+		p.SetRoletype(u.Get("roletype"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *AddAccountToProjectParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *AddAccountToProjectParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *AddAccountToProjectParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetEmail sets the email param.
 func (p *AddAccountToProjectParams) SetEmail(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["email"] = v
+	p.email = optString{v: v, ok: true}
 }
 
+// ResetEmail unsets the email param
 func (p *AddAccountToProjectParams) ResetEmail() {
-	if p.p != nil && p.p["email"] != nil {
-		delete(p.p, "email")
-	}
+	p.email = optString{}
 }
 
+// GetEmail returns the email param and if it is set
 func (p *AddAccountToProjectParams) GetEmail() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["email"].(string)
-	return value, ok
+	return p.email.v, p.email.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *AddAccountToProjectParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *AddAccountToProjectParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *AddAccountToProjectParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProjectroleid sets the projectroleid param.
 func (p *AddAccountToProjectParams) SetProjectroleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectroleid"] = v
+	p.projectroleid = optString{v: v, ok: true}
 }
 
+// ResetProjectroleid unsets the projectroleid param
 func (p *AddAccountToProjectParams) ResetProjectroleid() {
-	if p.p != nil && p.p["projectroleid"] != nil {
-		delete(p.p, "projectroleid")
-	}
+	p.projectroleid = optString{}
 }
 
+// GetProjectroleid returns the projectroleid param and if it is set
 func (p *AddAccountToProjectParams) GetProjectroleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectroleid"].(string)
-	return value, ok
+	return p.projectroleid.v, p.projectroleid.ok
 }
 
+// SetRoletype sets the roletype param.
 func (p *AddAccountToProjectParams) SetRoletype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roletype"] = v
+	p.roletype = optString{v: v, ok: true}
 }
 
+// ResetRoletype unsets the roletype param
 func (p *AddAccountToProjectParams) ResetRoletype() {
-	if p.p != nil && p.p["roletype"] != nil {
-		delete(p.p, "roletype")
-	}
+	p.roletype = optString{}
 }
 
+// GetRoletype returns the roletype param and if it is set
 func (p *AddAccountToProjectParams) GetRoletype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.roletype.v, p.roletype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddAccountToProjectParams) Clone() *AddAccountToProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddAccountToProjectParams) Equal(o *AddAccountToProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.email == o.email &&
+		p.projectid == o.projectid &&
+		p.projectroleid == o.projectroleid &&
+		p.roletype == o.roletype
+}
+
+// serializedAddAccountToProjectParams is used to (un)marshal AddAccountToProjectParams using the API param names
+type serializedAddAccountToProjectParams struct {
+	Account       *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Email         *string `json:"email,omitempty" yaml:"email,omitempty"`
+	Projectid     *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Projectroleid *string `json:"projectroleid,omitempty" yaml:"projectroleid,omitempty"`
+	Roletype      *string `json:"roletype,omitempty" yaml:"roletype,omitempty"`
+}
+
+func (p *AddAccountToProjectParams) toSerialized() *serializedAddAccountToProjectParams {
+	s := &serializedAddAccountToProjectParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.email.ok {
+		s.Email = &p.email.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.projectroleid.ok {
+		s.Projectroleid = &p.projectroleid.v
+	}
+	if p.roletype.ok {
+		s.Roletype = &p.roletype.v
+	}
+	return s
+}
+
+func (p *AddAccountToProjectParams) fromSerialized(s *serializedAddAccountToProjectParams) {
+	*p = AddAccountToProjectParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Email != nil {
+		p.SetEmail(*s.Email)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Projectroleid != nil {
+		p.SetProjectroleid(*s.Projectroleid)
+	}
+	if s.Roletype != nil {
+		p.SetRoletype(*s.Roletype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddAccountToProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddAccountToProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddAccountToProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddAccountToProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddAccountToProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddAccountToProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["roletype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddAccountToProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams {
 	p := &AddAccountToProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
+	p.SetProjectid(projectid)
 	return p
 }
 
-// Adds account to a project
-func (s *ProjectService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
-	resp, err := s.cs.newRequest("addAccountToProject", p.toURLValues())
+// Adds account to a project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: projectid.
+func (s *ProjectService) AddAccountToProject(p *AddAccountToProjectParams, opts ...CallOption) (*AddAccountToProjectResponse, error) {
+	resp, err := s.cs.newRequest("addAccountToProject", s.cs.encodeParams("addAccountToProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,8 +537,8 @@ func (s *ProjectService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -378,150 +562,254 @@ type AddAccountToProjectResponse struct {
 }
 
 type AddUserToProjectParams struct {
-	p map[string]interface{}
+	email         optString
+	projectid     optString
+	projectroleid optString
+	roletype      optString
+	username      optString
 }
 
-func (p *AddUserToProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddUserToProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["email"]; found {
-		u.Set("email", v.(string))
+	if p.email.ok {
+		u.Set("email", p.email.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["projectroleid"]; found {
-		u.Set("projectroleid", v.(string))
+	if p.projectroleid.ok {
+		u.Set("projectroleid", p.projectroleid.v)
 	}
-	if v, found := p.p["roletype"]; found {
-		u.Set("roletype", v.(string))
+	if p.roletype.ok {
+		u.Set("roletype", p.roletype.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddUserToProjectParams) SetEmail(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddUserToProjectParams parses url.Values, for example taken from a raw API request,
+// into a new AddUserToProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddUserToProjectParams(u url.Values) (*AddUserToProjectParams, error) {
+	p := &AddUserToProjectParams{}
+	if err := checkParamNames("addUserToProject", u, "email", "projectid", "projectroleid", "roletype", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["email"]; found {
+		p.SetEmail(u.Get("email"))
 	}
-	p.p["email"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["projectroleid"]; found {
+		p.SetProjectroleid(u.Get("projectroleid"))
+	}
+	if _, found := u["roletype"]; found {
+		p.SetRoletype(u.Get("roletype"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
 }
 
+// SetEmail sets the email param.
+func (p *AddUserToProjectParams) SetEmail(v string) {
+	p.email = optString{v: v, ok: true}
+}
+
+// ResetEmail unsets the email param
 func (p *AddUserToProjectParams) ResetEmail() {
-	if p.p != nil && p.p["email"] != nil {
-		delete(p.p, "email")
-	}
+	p.email = optString{}
 }
 
+// GetEmail returns the email param and if it is set
 func (p *AddUserToProjectParams) GetEmail() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["email"].(string)
-	return value, ok
+	return p.email.v, p.email.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *AddUserToProjectParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *AddUserToProjectParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *AddUserToProjectParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProjectroleid sets the projectroleid param.
 func (p *AddUserToProjectParams) SetProjectroleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectroleid"] = v
+	p.projectroleid = optString{v: v, ok: true}
 }
 
+// ResetProjectroleid unsets the projectroleid param
 func (p *AddUserToProjectParams) ResetProjectroleid() {
-	if p.p != nil && p.p["projectroleid"] != nil {
-		delete(p.p, "projectroleid")
-	}
+	p.projectroleid = optString{}
 }
 
+// GetProjectroleid returns the projectroleid param and if it is set
 func (p *AddUserToProjectParams) GetProjectroleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectroleid"].(string)
-	return value, ok
+	return p.projectroleid.v, p.projectroleid.ok
 }
 
+// SetRoletype sets the roletype param.
 func (p *AddUserToProjectParams) SetRoletype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roletype"] = v
+	p.roletype = optString{v: v, ok: true}
 }
 
+// ResetRoletype unsets the roletype param
 func (p *AddUserToProjectParams) ResetRoletype() {
-	if p.p != nil && p.p["roletype"] != nil {
-		delete(p.p, "roletype")
-	}
+	p.roletype = optString{}
 }
 
+// GetRoletype returns the roletype param and if it is set
 func (p *AddUserToProjectParams) GetRoletype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roletype"].(string)
-	return value, ok
+	return p.roletype.v, p.roletype.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddUserToProjectParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddUserToProjectParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddUserToProjectParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddUserToProjectParams) Clone() *AddUserToProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddUserToProjectParams) Equal(o *AddUserToProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.email == o.email &&
+		p.projectid == o.projectid &&
+		p.projectroleid == o.projectroleid &&
+		p.roletype == o.roletype &&
+		p.username == o.username
+}
+
+// serializedAddUserToProjectParams is used to (un)marshal AddUserToProjectParams using the API param names
+type serializedAddUserToProjectParams struct {
+	Email         *string `json:"email,omitempty" yaml:"email,omitempty"`
+	Projectid     *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Projectroleid *string `json:"projectroleid,omitempty" yaml:"projectroleid,omitempty"`
+	Roletype      *string `json:"roletype,omitempty" yaml:"roletype,omitempty"`
+	Username      *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddUserToProjectParams) toSerialized() *serializedAddUserToProjectParams {
+	s := &serializedAddUserToProjectParams{}
+	if p.email.ok {
+		s.Email = &p.email.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.projectroleid.ok {
+		s.Projectroleid = &p.projectroleid.v
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if p.roletype.ok {
+		s.Roletype = &p.roletype.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddUserToProjectParams) fromSerialized(s *serializedAddUserToProjectParams) {
+	*p = AddUserToProjectParams{}
+	if s.Email != nil {
+		p.SetEmail(*s.Email)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Projectroleid != nil {
+		p.SetProjectroleid(*s.Projectroleid)
+	}
+	if s.Roletype != nil {
+		p.SetRoletype(*s.Roletype)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddUserToProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddUserToProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddUserToProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddUserToProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddUserToProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddUserToProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddUserToProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewAddUserToProjectParams(projectid string, username string) *AddUserToProjectParams {
 	p := &AddUserToProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
-	p.p["username"] = username
+	p.SetProjectid(projectid)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds user to a project
-func (s *ProjectService) AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
-	resp, err := s.cs.newRequest("addUserToProject", p.toURLValues())
+// Adds user to a project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: projectid, username.
+func (s *ProjectService) AddUserToProject(p *AddUserToProjectParams, opts ...CallOption) (*AddUserToProjectResponse, error) {
+	resp, err := s.cs.newRequest("addUserToProject", s.cs.encodeParams("addUserToProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -532,8 +820,8 @@ func (s *ProjectService) AddUserToProject(p *AddUserToProjectParams) (*AddUserTo
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -557,174 +845,284 @@ type AddUserToProjectResponse struct {
 }
 
 type CreateProjectParams struct {
-	p map[string]interface{}
+	account     optString
+	accountid   optString
+	displaytext optString
+	domainid    optString
+	name        optString
+	userid      optString
 }
 
-func (p *CreateProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *CreateProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateProjectParams parses url.Values, for example taken from a raw API request,
+// into a new CreateProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateProjectParams(u url.Values) (*CreateProjectParams, error) {
+	p := &CreateProjectParams{}
+	if err := checkParamNames("createProject", u, "account", "accountid", "displaytext", "domainid", "name", "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
 	}
-	p.p["account"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateProjectParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateProjectParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateProjectParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccountid sets the accountid param.
 func (p *CreateProjectParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
+	p.accountid = optString{v: v, ok: true}
 }
 
+// ResetAccountid unsets the accountid param
 func (p *CreateProjectParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *CreateProjectParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *CreateProjectParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateProjectParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateProjectParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateProjectParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateProjectParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateProjectParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateProjectParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateProjectParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateProjectParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetUserid sets the userid param.
 func (p *CreateProjectParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *CreateProjectParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *CreateProjectParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateProjectParams) Clone() *CreateProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateProjectParams) Equal(o *CreateProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accountid == o.accountid &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.userid == o.userid
+}
+
+// serializedCreateProjectParams is used to (un)marshal CreateProjectParams using the API param names
+type serializedCreateProjectParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountid   *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Userid      *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *CreateProjectParams) toSerialized() *serializedCreateProjectParams {
+	s := &serializedCreateProjectParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *CreateProjectParams) fromSerialized(s *serializedCreateProjectParams) {
+	*p = CreateProjectParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewCreateProjectParams(displaytext string, name string) *CreateProjectParams {
 	p := &CreateProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
 	return p
 }
 
-// Creates a project
-func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
-	resp, err := s.cs.newRequest("createProject", p.toURLValues())
+// Creates a project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: displaytext, name.
+func (s *ProjectService) CreateProject(p *CreateProjectParams, opts ...CallOption) (*CreateProjectResponse, error) {
+	resp, err := s.cs.newRequest("createProject", s.cs.encodeParams("createProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -735,8 +1133,8 @@ func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -809,78 +1207,164 @@ type CreateProjectResponse struct {
 }
 
 type DeleteAccountFromProjectParams struct {
-	p map[string]interface{}
+	account   optString
+	projectid optString
 }
 
-func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteAccountFromProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteAccountFromProjectParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteAccountFromProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteAccountFromProjectParams(u url.Values) (*DeleteAccountFromProjectParams, error) {
+	p := &DeleteAccountFromProjectParams{}
+	if err := checkParamNames("deleteAccountFromProject", u, "account", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param. This param is required.
+func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *DeleteAccountFromProjectParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DeleteAccountFromProjectParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *DeleteAccountFromProjectParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DeleteAccountFromProjectParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DeleteAccountFromProjectParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteAccountFromProjectParams) Clone() *DeleteAccountFromProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteAccountFromProjectParams) Equal(o *DeleteAccountFromProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.projectid == o.projectid
+}
+
+// serializedDeleteAccountFromProjectParams is used to (un)marshal DeleteAccountFromProjectParams using the API param names
+type serializedDeleteAccountFromProjectParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *DeleteAccountFromProjectParams) toSerialized() *serializedDeleteAccountFromProjectParams {
+	s := &serializedDeleteAccountFromProjectParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *DeleteAccountFromProjectParams) fromSerialized(s *serializedDeleteAccountFromProjectParams) {
+	*p = DeleteAccountFromProjectParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteAccountFromProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteAccountFromProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteAccountFromProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteAccountFromProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteAccountFromProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteAccountFromProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteAccountFromProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams {
 	p := &DeleteAccountFromProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["projectid"] = projectid
+	p.SetAccount(account)
+	p.SetProjectid(projectid)
 	return p
 }
 
-// Deletes account from the project
-func (s *ProjectService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccountFromProject", p.toURLValues())
+// Deletes account from the project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: account, projectid.
+func (s *ProjectService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams, opts ...CallOption) (*DeleteAccountFromProjectResponse, error) {
+	resp, err := s.cs.newRequest("deleteAccountFromProject", s.cs.encodeParams("deleteAccountFromProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -891,8 +1375,8 @@ func (s *ProjectService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -916,78 +1400,164 @@ type DeleteAccountFromProjectResponse struct {
 }
 
 type DeleteUserFromProjectParams struct {
-	p map[string]interface{}
+	projectid optString
+	userid    optString
 }
 
-func (p *DeleteUserFromProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteUserFromProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *DeleteUserFromProjectParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+// ParseDeleteUserFromProjectParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteUserFromProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteUserFromProjectParams(u url.Values) (*DeleteUserFromProjectParams, error) {
+	p := &DeleteUserFromProjectParams{}
+	if err := checkParamNames("deleteUserFromProject", u, "projectid", "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	return p, nil
+}
+
+// SetProjectid sets the projectid param. This param is required.
+func (p *DeleteUserFromProjectParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DeleteUserFromProjectParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DeleteUserFromProjectParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetUserid sets the userid param. This param is required.
 func (p *DeleteUserFromProjectParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *DeleteUserFromProjectParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *DeleteUserFromProjectParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteUserFromProjectParams) Clone() *DeleteUserFromProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteUserFromProjectParams) Equal(o *DeleteUserFromProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.projectid == o.projectid &&
+		p.userid == o.userid
+}
+
+// serializedDeleteUserFromProjectParams is used to (un)marshal DeleteUserFromProjectParams using the API param names
+type serializedDeleteUserFromProjectParams struct {
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Userid    *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *DeleteUserFromProjectParams) toSerialized() *serializedDeleteUserFromProjectParams {
+	s := &serializedDeleteUserFromProjectParams{}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *DeleteUserFromProjectParams) fromSerialized(s *serializedDeleteUserFromProjectParams) {
+	*p = DeleteUserFromProjectParams{}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteUserFromProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteUserFromProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteUserFromProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteUserFromProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteUserFromProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteUserFromProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteUserFromProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewDeleteUserFromProjectParams(projectid string, userid string) *DeleteUserFromProjectParams {
 	p := &DeleteUserFromProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
-	p.p["userid"] = userid
+	p.SetProjectid(projectid)
+	p.SetUserid(userid)
 	return p
 }
 
-// Deletes user from the project
-func (s *ProjectService) DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteUserFromProject", p.toURLValues())
+// Deletes user from the project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: projectid, userid.
+func (s *ProjectService) DeleteUserFromProject(p *DeleteUserFromProjectParams, opts ...CallOption) (*DeleteUserFromProjectResponse, error) {
+	resp, err := s.cs.newRequest("deleteUserFromProject", s.cs.encodeParams("deleteUserFromProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -998,8 +1568,8 @@ func (s *ProjectService) DeleteUserFromProject(p *DeleteUserFromProjectParams) (
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1023,78 +1593,167 @@ type DeleteUserFromProjectResponse struct {
 }
 
 type DeleteProjectParams struct {
-	p map[string]interface{}
+	cleanup optBool
+	id      optString
 }
 
-func (p *DeleteProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanup", vv)
+	if p.cleanup.ok {
+		u.Set("cleanup", strconv.FormatBool(p.cleanup.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteProjectParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteProjectParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteProjectParams(u url.Values) (*DeleteProjectParams, error) {
+	p := &DeleteProjectParams{}
+	if err := checkParamNames("deleteProject", u, "cleanup", "id"); err != nil {
+		return nil, err
 	}
-	p.p["cleanup"] = v
+	if _, found := u["cleanup"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanup: %v", err)
+		}
+		p.SetCleanup(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetCleanup sets the cleanup param.
+func (p *DeleteProjectParams) SetCleanup(v bool) {
+	p.cleanup = optBool{v: v, ok: true}
+}
+
+// ResetCleanup unsets the cleanup param
 func (p *DeleteProjectParams) ResetCleanup() {
-	if p.p != nil && p.p["cleanup"] != nil {
-		delete(p.p, "cleanup")
-	}
+	p.cleanup = optBool{}
 }
 
+// GetCleanup returns the cleanup param and if it is set
 func (p *DeleteProjectParams) GetCleanup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanup"].(bool)
-	return value, ok
+	return p.cleanup.v, p.cleanup.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteProjectParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteProjectParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteProjectParams) Clone() *DeleteProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteProjectParams) Equal(o *DeleteProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cleanup == o.cleanup &&
+		p.id == o.id
+}
+
+// serializedDeleteProjectParams is used to (un)marshal DeleteProjectParams using the API param names
+type serializedDeleteProjectParams struct {
+	Cleanup *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteProjectParams) toSerialized() *serializedDeleteProjectParams {
+	s := &serializedDeleteProjectParams{}
+	if p.cleanup.ok {
+		s.Cleanup = &p.cleanup.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteProjectParams) fromSerialized(s *serializedDeleteProjectParams) {
+	*p = DeleteProjectParams{}
+	if s.Cleanup != nil {
+		p.SetCleanup(*s.Cleanup)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams {
 	p := &DeleteProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a project
-func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteProject", p.toURLValues())
+// Deletes a project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *ProjectService) DeleteProject(p *DeleteProjectParams, opts ...CallOption) (*DeleteProjectResponse, error) {
+	resp, err := s.cs.newRequest("deleteProject", s.cs.encodeParams("deleteProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1105,8 +1764,8 @@ func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1130,53 +1789,133 @@ type DeleteProjectResponse struct {
 }
 
 type DeleteProjectInvitationParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteProjectInvitationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteProjectInvitationParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteProjectInvitationParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteProjectInvitationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteProjectInvitationParams(u url.Values) (*DeleteProjectInvitationParams, error) {
+	p := &DeleteProjectInvitationParams{}
+	if err := checkParamNames("deleteProjectInvitation", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteProjectInvitationParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteProjectInvitationParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteProjectInvitationParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteProjectInvitationParams) Clone() *DeleteProjectInvitationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteProjectInvitationParams) Equal(o *DeleteProjectInvitationParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id == o.id
+}
+
+// serializedDeleteProjectInvitationParams is used to (un)marshal DeleteProjectInvitationParams using the API param names
+type serializedDeleteProjectInvitationParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteProjectInvitationParams) toSerialized() *serializedDeleteProjectInvitationParams {
+	s := &serializedDeleteProjectInvitationParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteProjectInvitationParams) fromSerialized(s *serializedDeleteProjectInvitationParams) {
+	*p = DeleteProjectInvitationParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteProjectInvitationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteProjectInvitationParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteProjectInvitationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteProjectInvitationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteProjectInvitationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteProjectInvitationParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteProjectInvitationParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams {
 	p := &DeleteProjectInvitationParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes project invitation
-func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("deleteProjectInvitation", p.toURLValues())
+// Deletes project invitation.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams, opts ...CallOption) (*DeleteProjectInvitationResponse, error) {
+	resp, err := s.cs.newRequest("deleteProjectInvitation", s.cs.encodeParams("deleteProjectInvitation", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1187,8 +1926,8 @@ func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParam
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1212,324 +1951,481 @@ type DeleteProjectInvitationResponse struct {
 }
 
 type ListProjectInvitationsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListProjectInvitationsParams) toURLValues() url.Values {
+	account     optString
+	activeonly  optBool
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	state       optString
+	userid      optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListProjectInvitationsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["activeonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("activeonly", vv)
+	if p.activeonly.ok {
+		u.Set("activeonly", strconv.FormatBool(p.activeonly.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *ListProjectInvitationsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListProjectInvitationsParams parses url.Values, for example taken from a raw API request,
+// into a new ListProjectInvitationsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListProjectInvitationsParams(u url.Values) (*ListProjectInvitationsParams, error) {
+	p := &ListProjectInvitationsParams{}
+	if err := checkParamNames("listProjectInvitations", u, "account", "activeonly", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "state", "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["activeonly"]; found {
+		v, err := strconv.ParseBool(u.Get("activeonly"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param activeonly: %v", err)
+		}
+		p.SetActiveonly(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["account"] = v
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListProjectInvitationsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListProjectInvitationsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListProjectInvitationsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetActiveonly sets the activeonly param.
 func (p *ListProjectInvitationsParams) SetActiveonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["activeonly"] = v
+	p.activeonly = optBool{v: v, ok: true}
 }
 
+// ResetActiveonly unsets the activeonly param
 func (p *ListProjectInvitationsParams) ResetActiveonly() {
-	if p.p != nil && p.p["activeonly"] != nil {
-		delete(p.p, "activeonly")
-	}
+	p.activeonly = optBool{}
 }
 
+// GetActiveonly returns the activeonly param and if it is set
 func (p *ListProjectInvitationsParams) GetActiveonly() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["activeonly"].(bool)
-	return value, ok
+	return p.activeonly.v, p.activeonly.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListProjectInvitationsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListProjectInvitationsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListProjectInvitationsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListProjectInvitationsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListProjectInvitationsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListProjectInvitationsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListProjectInvitationsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListProjectInvitationsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListProjectInvitationsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListProjectInvitationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListProjectInvitationsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListProjectInvitationsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListProjectInvitationsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListProjectInvitationsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListProjectInvitationsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListProjectInvitationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListProjectInvitationsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListProjectInvitationsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListProjectInvitationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListProjectInvitationsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListProjectInvitationsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListProjectInvitationsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListProjectInvitationsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListProjectInvitationsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetState sets the state param.
 func (p *ListProjectInvitationsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListProjectInvitationsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListProjectInvitationsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetUserid sets the userid param.
 func (p *ListProjectInvitationsParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *ListProjectInvitationsParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *ListProjectInvitationsParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListProjectInvitationsParams) Clone() *ListProjectInvitationsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListProjectInvitationsParams) Equal(o *ListProjectInvitationsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.activeonly == o.activeonly &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.state == o.state &&
+		p.userid == o.userid
+}
+
+// serializedListProjectInvitationsParams is used to (un)marshal ListProjectInvitationsParams using the API param names
+type serializedListProjectInvitationsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Activeonly  *bool   `json:"activeonly,omitempty" yaml:"activeonly,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Userid      *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *ListProjectInvitationsParams) toSerialized() *serializedListProjectInvitationsParams {
+	s := &serializedListProjectInvitationsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.activeonly.ok {
+		s.Activeonly = &p.activeonly.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *ListProjectInvitationsParams) fromSerialized(s *serializedListProjectInvitationsParams) {
+	*p = ListProjectInvitationsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Activeonly != nil {
+		p.SetActiveonly(*s.Activeonly)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListProjectInvitationsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListProjectInvitationsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListProjectInvitationsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListProjectInvitationsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListProjectInvitationsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListProjectInvitationsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListProjectInvitationsParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewListProjectInvitationsParams() *ListProjectInvitationsParams {
 	p := &ListProjectInvitationsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
 	p := &ListProjectInvitationsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1557,9 +2453,9 @@ func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc)
 	return nil, l.Count, fmt.Errorf("There is more then one result for ProjectInvitation UUID: %s!", id)
 }
 
-// Lists project invitations and provides detailed information for listed invitations
-func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectInvitations", p.toURLValues())
+// Lists project invitations and provides detailed information for listed invitations.
+func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams, opts ...CallOption) (*ListProjectInvitationsResponse, error) {
+	resp, err := s.cs.newRequest("listProjectInvitations", s.cs.encodeParams("listProjectInvitations", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1592,401 +2488,579 @@ type ProjectInvitation struct {
 }
 
 type ListProjectsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListProjectsParams) toURLValues() url.Values {
+	account     optString
+	details     optStrings
+	displaytext optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	showicon    optBool
+	state       optString
+	tags        optStringMap
+	username    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListProjectsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *ListProjectsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListProjectsParams parses url.Values, for example taken from a raw API request,
+// into a new ListProjectsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListProjectsParams(u url.Values) (*ListProjectsParams, error) {
+	p := &ListProjectsParams{}
+	if err := checkParamNames("listProjects", u, "account", "details", "displaytext", "domainid", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "showicon", "state", "tags[]", "username"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListProjectsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListProjectsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListProjectsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDetails sets the details param.
 func (p *ListProjectsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStrings{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ListProjectsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListProjectsParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *ListProjectsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *ListProjectsParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *ListProjectsParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListProjectsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListProjectsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListProjectsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListProjectsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListProjectsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListProjectsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListProjectsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListProjectsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListProjectsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListProjectsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListProjectsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListProjectsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListProjectsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListProjectsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListProjectsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListProjectsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListProjectsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListProjectsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListProjectsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListProjectsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListProjectsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListProjectsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListProjectsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListProjectsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListProjectsParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListProjectsParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListProjectsParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetState sets the state param.
 func (p *ListProjectsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListProjectsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListProjectsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListProjectsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListProjectsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListProjectsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetUsername sets the username param.
 func (p *ListProjectsParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *ListProjectsParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *ListProjectsParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListProjectsParams) Clone() *ListProjectsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListProjectsParams) Equal(o *ListProjectsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.details.equal(o.details) &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showicon == o.showicon &&
+		p.state == o.state &&
+		p.tags.equal(o.tags) &&
+		p.username == o.username
+}
+
+// serializedListProjectsParams is used to (un)marshal ListProjectsParams using the API param names
+type serializedListProjectsParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Details     *[]string          `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showicon    *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	State       *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Username    *string            `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *ListProjectsParams) toSerialized() *serializedListProjectsParams {
+	s := &serializedListProjectsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *ListProjectsParams) fromSerialized(s *serializedListProjectsParams) {
+	*p = ListProjectsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListProjectsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListProjectsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListProjectsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListProjectsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListProjectsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListProjectsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListProjectsParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewListProjectsParams() *ListProjectsParams {
 	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2034,9 +3108,8 @@ func (s *ProjectService) GetProjectByName(name string, opts ...OptionFunc) (*Pro
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error) {
 	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2064,9 +3137,9 @@ func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project
 	return nil, l.Count, fmt.Errorf("There is more then one result for Project UUID: %s!", id)
 }
 
-// Lists projects and provides detailed information for listed projects
-func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
-	resp, err := s.cs.newRequest("listProjects", p.toURLValues())
+// Lists projects and provides detailed information for listed projects.
+func (s *ProjectService) ListProjects(p *ListProjectsParams, opts ...CallOption) (*ListProjectsResponse, error) {
+	resp, err := s.cs.newRequest("listProjects", s.cs.encodeParams("listProjects", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2136,53 +3209,133 @@ type Project struct {
 }
 
 type SuspendProjectParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *SuspendProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *SuspendProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *SuspendProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseSuspendProjectParams parses url.Values, for example taken from a raw API request,
+// into a new SuspendProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseSuspendProjectParams(u url.Values) (*SuspendProjectParams, error) {
+	p := &SuspendProjectParams{}
+	if err := checkParamNames("suspendProject", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *SuspendProjectParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *SuspendProjectParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *SuspendProjectParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *SuspendProjectParams) Clone() *SuspendProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *SuspendProjectParams) Equal(o *SuspendProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedSuspendProjectParams is used to (un)marshal SuspendProjectParams using the API param names
+type serializedSuspendProjectParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *SuspendProjectParams) toSerialized() *serializedSuspendProjectParams {
+	s := &serializedSuspendProjectParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *SuspendProjectParams) fromSerialized(s *serializedSuspendProjectParams) {
+	*p = SuspendProjectParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p SuspendProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *SuspendProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedSuspendProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p SuspendProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *SuspendProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedSuspendProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new SuspendProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParams {
 	p := &SuspendProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Suspends a project
-func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
-	resp, err := s.cs.newRequest("suspendProject", p.toURLValues())
+// Suspends a project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *ProjectService) SuspendProject(p *SuspendProjectParams, opts ...CallOption) (*SuspendProjectResponse, error) {
+	resp, err := s.cs.newRequest("suspendProject", s.cs.encodeParams("suspendProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2193,8 +3346,8 @@ func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjec
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2267,198 +3420,317 @@ type SuspendProjectResponse struct {
 }
 
 type UpdateProjectParams struct {
-	p map[string]interface{}
+	account     optString
+	displaytext optString
+	id          optString
+	name        optString
+	roletype    optString
+	swapowner   optBool
+	userid      optString
 }
 
-func (p *UpdateProjectParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["roletype"]; found {
-		u.Set("roletype", v.(string))
+	if p.roletype.ok {
+		u.Set("roletype", p.roletype.v)
 	}
-	if v, found := p.p["swapowner"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("swapowner", vv)
+	if p.swapowner.ok {
+		u.Set("swapowner", strconv.FormatBool(p.swapowner.v))
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *UpdateProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateProjectParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateProjectParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateProjectParams(u url.Values) (*UpdateProjectParams, error) {
+	p := &UpdateProjectParams{}
+	if err := checkParamNames("updateProject", u, "account", "displaytext", "id", "name", "roletype", "swapowner", "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["roletype"]; found {
+		p.SetRoletype(u.Get("roletype"))
 	}
-	p.p["account"] = v
+	if _, found := u["swapowner"]; found {
+		v, err := strconv.ParseBool(u.Get("swapowner"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param swapowner: %v", err)
+		}
+		p.SetSwapowner(v)
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *UpdateProjectParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *UpdateProjectParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UpdateProjectParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *UpdateProjectParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateProjectParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateProjectParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateProjectParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateProjectParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateProjectParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateProjectParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateProjectParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetRoletype sets the roletype param.
 func (p *UpdateProjectParams) SetRoletype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roletype"] = v
+	p.roletype = optString{v: v, ok: true}
 }
 
+// ResetRoletype unsets the roletype param
 func (p *UpdateProjectParams) ResetRoletype() {
-	if p.p != nil && p.p["roletype"] != nil {
-		delete(p.p, "roletype")
-	}
+	p.roletype = optString{}
 }
 
+// GetRoletype returns the roletype param and if it is set
 func (p *UpdateProjectParams) GetRoletype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roletype"].(string)
-	return value, ok
+	return p.roletype.v, p.roletype.ok
 }
 
+// SetSwapowner sets the swapowner param.
 func (p *UpdateProjectParams) SetSwapowner(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["swapowner"] = v
+	p.swapowner = optBool{v: v, ok: true}
 }
 
+// ResetSwapowner unsets the swapowner param
 func (p *UpdateProjectParams) ResetSwapowner() {
-	if p.p != nil && p.p["swapowner"] != nil {
-		delete(p.p, "swapowner")
-	}
+	p.swapowner = optBool{}
 }
 
+// GetSwapowner returns the swapowner param and if it is set
 func (p *UpdateProjectParams) GetSwapowner() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["swapowner"].(bool)
-	return value, ok
+	return p.swapowner.v, p.swapowner.ok
 }
 
+// SetUserid sets the userid param.
 func (p *UpdateProjectParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *UpdateProjectParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *UpdateProjectParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateProjectParams) Clone() *UpdateProjectParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateProjectParams) Equal(o *UpdateProjectParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.displaytext == o.displaytext &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.roletype == o.roletype &&
+		p.swapowner == o.swapowner &&
+		p.userid == o.userid
+}
+
+// serializedUpdateProjectParams is used to (un)marshal UpdateProjectParams using the API param names
+type serializedUpdateProjectParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Roletype    *string `json:"roletype,omitempty" yaml:"roletype,omitempty"`
+	Swapowner   *bool   `json:"swapowner,omitempty" yaml:"swapowner,omitempty"`
+	Userid      *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *UpdateProjectParams) toSerialized() *serializedUpdateProjectParams {
+	s := &serializedUpdateProjectParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.roletype.ok {
+		s.Roletype = &p.roletype.v
+	}
+	if p.swapowner.ok {
+		s.Swapowner = &p.swapowner.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *UpdateProjectParams) fromSerialized(s *serializedUpdateProjectParams) {
+	*p = UpdateProjectParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Roletype != nil {
+		p.SetRoletype(*s.Roletype)
+	}
+	if s.Swapowner != nil {
+		p.SetSwapowner(*s.Swapowner)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateProjectParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateProjectParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateProjectParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateProjectParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateProjectParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateProjectParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams {
 	p := &UpdateProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a project
-func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
-	resp, err := s.cs.newRequest("updateProject", p.toURLValues())
+// Updates a project.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *ProjectService) UpdateProject(p *UpdateProjectParams, opts ...CallOption) (*UpdateProjectResponse, error) {
+	resp, err := s.cs.newRequest("updateProject", s.cs.encodeParams("updateProject", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2469,8 +3741,8 @@ func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectRe
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2543,150 +3815,257 @@ type UpdateProjectResponse struct {
 }
 
 type UpdateProjectInvitationParams struct {
-	p map[string]interface{}
+	accept    optBool
+	account   optString
+	projectid optString
+	token     optString
+	userid    optString
 }
 
-func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateProjectInvitationParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accept"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("accept", vv)
+	if p.accept.ok {
+		u.Set("accept", strconv.FormatBool(p.accept.v))
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["token"]; found {
-		u.Set("token", v.(string))
+	if p.token.ok {
+		u.Set("token", p.token.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
 	return u
 }
 
-func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateProjectInvitationParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateProjectInvitationParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateProjectInvitationParams(u url.Values) (*UpdateProjectInvitationParams, error) {
+	p := &UpdateProjectInvitationParams{}
+	if err := checkParamNames("updateProjectInvitation", u, "accept", "account", "projectid", "token", "userid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accept"]; found {
+		v, err := strconv.ParseBool(u.Get("accept"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accept: %v", err)
+		}
+		p.SetAccept(v)
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	p.p["accept"] = v
+	if _, found := u["token"]; found {
+		p.SetToken(u.Get("token"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	return p, nil
+}
+
+// SetAccept sets the accept param.
+func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
+	p.accept = optBool{v: v, ok: true}
 }
 
+// ResetAccept unsets the accept param
 func (p *UpdateProjectInvitationParams) ResetAccept() {
-	if p.p != nil && p.p["accept"] != nil {
-		delete(p.p, "accept")
-	}
+	p.accept = optBool{}
 }
 
+// GetAccept returns the accept param and if it is set
 func (p *UpdateProjectInvitationParams) GetAccept() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accept"].(bool)
-	return value, ok
+	return p.accept.v, p.accept.ok
 }
 
+// SetAccount sets the account param.
 func (p *UpdateProjectInvitationParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *UpdateProjectInvitationParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UpdateProjectInvitationParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *UpdateProjectInvitationParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *UpdateProjectInvitationParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *UpdateProjectInvitationParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetToken sets the token param.
 func (p *UpdateProjectInvitationParams) SetToken(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["token"] = v
+	p.token = optString{v: v, ok: true}
 }
 
+// ResetToken unsets the token param
 func (p *UpdateProjectInvitationParams) ResetToken() {
-	if p.p != nil && p.p["token"] != nil {
-		delete(p.p, "token")
-	}
+	p.token = optString{}
 }
 
+// GetToken returns the token param and if it is set
 func (p *UpdateProjectInvitationParams) GetToken() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["token"].(string)
-	return value, ok
+	return p.token.v, p.token.ok
 }
 
+// SetUserid sets the userid param.
 func (p *UpdateProjectInvitationParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *UpdateProjectInvitationParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *UpdateProjectInvitationParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userid.v, p.userid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateProjectInvitationParams) Clone() *UpdateProjectInvitationParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateProjectInvitationParams) Equal(o *UpdateProjectInvitationParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accept == o.accept &&
+		p.account == o.account &&
+		p.projectid == o.projectid &&
+		p.token == o.token &&
+		p.userid == o.userid
+}
+
+// serializedUpdateProjectInvitationParams is used to (un)marshal UpdateProjectInvitationParams using the API param names
+type serializedUpdateProjectInvitationParams struct {
+	Accept    *bool   `json:"accept,omitempty" yaml:"accept,omitempty"`
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Token     *string `json:"token,omitempty" yaml:"token,omitempty"`
+	Userid    *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+func (p *UpdateProjectInvitationParams) toSerialized() *serializedUpdateProjectInvitationParams {
+	s := &serializedUpdateProjectInvitationParams{}
+	if p.accept.ok {
+		s.Accept = &p.accept.v
+	}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.token.ok {
+		s.Token = &p.token.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	return s
+}
+
+func (p *UpdateProjectInvitationParams) fromSerialized(s *serializedUpdateProjectInvitationParams) {
+	*p = UpdateProjectInvitationParams{}
+	if s.Accept != nil {
+		p.SetAccept(*s.Accept)
+	}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Token != nil {
+		p.SetToken(*s.Token)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateProjectInvitationParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateProjectInvitationParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateProjectInvitationParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateProjectInvitationParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateProjectInvitationParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateProjectInvitationParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateProjectInvitationParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams {
 	p := &UpdateProjectInvitationParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
+	p.SetProjectid(projectid)
 	return p
 }
 
-// Accepts or declines project invitation
-func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("updateProjectInvitation", p.toURLValues())
+// Accepts or declines project invitation.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: projectid.
+func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams, opts ...CallOption) (*UpdateProjectInvitationResponse, error) {
+	resp, err := s.cs.newRequest("updateProjectInvitation", s.cs.encodeParams("updateProjectInvitation", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2697,8 +4076,8 @@ func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParam
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2722,77 +4101,162 @@ type UpdateProjectInvitationResponse struct {
 }
 
 type ListProjectRolePermissionsParams struct {
-	p map[string]interface{}
+	projectid     optString
+	projectroleid optString
 }
 
-func (p *ListProjectRolePermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListProjectRolePermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["projectroleid"]; found {
-		u.Set("projectroleid", v.(string))
+	if p.projectroleid.ok {
+		u.Set("projectroleid", p.projectroleid.v)
 	}
 	return u
 }
 
-func (p *ListProjectRolePermissionsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListProjectRolePermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListProjectRolePermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListProjectRolePermissionsParams(u url.Values) (*ListProjectRolePermissionsParams, error) {
+	p := &ListProjectRolePermissionsParams{}
+	if err := checkParamNames("listProjectRolePermissions", u, "projectid", "projectroleid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	p.p["projectid"] = v
+	if _, found := u["projectroleid"]; found {
+		p.SetProjectroleid(u.Get("projectroleid"))
+	}
+	return p, nil
+}
+
+// SetProjectid sets the projectid param. This param is required.
+func (p *ListProjectRolePermissionsParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListProjectRolePermissionsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListProjectRolePermissionsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProjectroleid sets the projectroleid param.
 func (p *ListProjectRolePermissionsParams) SetProjectroleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectroleid"] = v
+	p.projectroleid = optString{v: v, ok: true}
 }
 
+// ResetProjectroleid unsets the projectroleid param
 func (p *ListProjectRolePermissionsParams) ResetProjectroleid() {
-	if p.p != nil && p.p["projectroleid"] != nil {
-		delete(p.p, "projectroleid")
-	}
+	p.projectroleid = optString{}
 }
 
+// GetProjectroleid returns the projectroleid param and if it is set
 func (p *ListProjectRolePermissionsParams) GetProjectroleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectroleid.v, p.projectroleid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListProjectRolePermissionsParams) Clone() *ListProjectRolePermissionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListProjectRolePermissionsParams) Equal(o *ListProjectRolePermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.projectid == o.projectid &&
+		p.projectroleid == o.projectroleid
+}
+
+// serializedListProjectRolePermissionsParams is used to (un)marshal ListProjectRolePermissionsParams using the API param names
+type serializedListProjectRolePermissionsParams struct {
+	Projectid     *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Projectroleid *string `json:"projectroleid,omitempty" yaml:"projectroleid,omitempty"`
+}
+
+func (p *ListProjectRolePermissionsParams) toSerialized() *serializedListProjectRolePermissionsParams {
+	s := &serializedListProjectRolePermissionsParams{}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.projectroleid.ok {
+		s.Projectroleid = &p.projectroleid.v
+	}
+	return s
+}
+
+func (p *ListProjectRolePermissionsParams) fromSerialized(s *serializedListProjectRolePermissionsParams) {
+	*p = ListProjectRolePermissionsParams{}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Projectroleid != nil {
+		p.SetProjectroleid(*s.Projectroleid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListProjectRolePermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListProjectRolePermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListProjectRolePermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListProjectRolePermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListProjectRolePermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListProjectRolePermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["projectroleid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListProjectRolePermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewListProjectRolePermissionsParams(projectid string) *ListProjectRolePermissionsParams {
 	p := &ListProjectRolePermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
+	p.SetProjectid(projectid)
 	return p
 }
 
-// Lists a project's project role permissions
-func (s *ProjectService) ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectRolePermissions", p.toURLValues())
+// Lists a project's project role permissions.
+//
+// Required params: projectid.
+func (s *ProjectService) ListProjectRolePermissions(p *ListProjectRolePermissionsParams, opts ...CallOption) (*ListProjectRolePermissionsResponse, error) {
+	resp, err := s.cs.newRequest("listProjectRolePermissions", s.cs.encodeParams("listProjectRolePermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2823,152 +4287,255 @@ type ProjectRolePermission struct {
 }
 
 type CreateProjectRolePermissionParams struct {
-	p map[string]interface{}
+	description   optString
+	permission    optString
+	projectid     optString
+	projectroleid optString
+	rule          optString
 }
 
-func (p *CreateProjectRolePermissionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateProjectRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["permission"]; found {
-		u.Set("permission", v.(string))
+	if p.permission.ok {
+		u.Set("permission", p.permission.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["projectroleid"]; found {
-		u.Set("projectroleid", v.(string))
+	if p.projectroleid.ok {
+		u.Set("projectroleid", p.projectroleid.v)
 	}
-	if v, found := p.p["rule"]; found {
-		u.Set("rule", v.(string))
+	if p.rule.ok {
+		u.Set("rule", p.rule.v)
 	}
 	return u
 }
 
-func (p *CreateProjectRolePermissionParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateProjectRolePermissionParams parses url.Values, for example taken from a raw API request,
+// into a new CreateProjectRolePermissionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateProjectRolePermissionParams(u url.Values) (*CreateProjectRolePermissionParams, error) {
+	p := &CreateProjectRolePermissionParams{}
+	if err := checkParamNames("createProjectRolePermission", u, "description", "permission", "projectid", "projectroleid", "rule"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
 	}
-	p.p["description"] = v
+	if _, found := u["permission"]; found {
+		p.SetPermission(u.Get("permission"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["projectroleid"]; found {
+		p.SetProjectroleid(u.Get("projectroleid"))
+	}
+	if _, found := u["rule"]; found {
+		p.SetRule(u.Get("rule"))
+	}
+	return p, nil
 }
 
+// SetDescription sets the description param.
+func (p *CreateProjectRolePermissionParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
 func (p *CreateProjectRolePermissionParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateProjectRolePermissionParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetPermission sets the permission param. This param is required.
 func (p *CreateProjectRolePermissionParams) SetPermission(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["permission"] = v
+	p.permission = optString{v: v, ok: true}
 }
 
+// ResetPermission unsets the permission param
 func (p *CreateProjectRolePermissionParams) ResetPermission() {
-	if p.p != nil && p.p["permission"] != nil {
-		delete(p.p, "permission")
-	}
+	p.permission = optString{}
 }
 
+// GetPermission returns the permission param and if it is set
 func (p *CreateProjectRolePermissionParams) GetPermission() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["permission"].(string)
-	return value, ok
+	return p.permission.v, p.permission.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *CreateProjectRolePermissionParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateProjectRolePermissionParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateProjectRolePermissionParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProjectroleid sets the projectroleid param. This param is required.
 func (p *CreateProjectRolePermissionParams) SetProjectroleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectroleid"] = v
+	p.projectroleid = optString{v: v, ok: true}
 }
 
+// ResetProjectroleid unsets the projectroleid param
 func (p *CreateProjectRolePermissionParams) ResetProjectroleid() {
-	if p.p != nil && p.p["projectroleid"] != nil {
-		delete(p.p, "projectroleid")
-	}
+	p.projectroleid = optString{}
 }
 
+// GetProjectroleid returns the projectroleid param and if it is set
 func (p *CreateProjectRolePermissionParams) GetProjectroleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectroleid"].(string)
-	return value, ok
+	return p.projectroleid.v, p.projectroleid.ok
 }
 
+// SetRule sets the rule param. This param is required.
 func (p *CreateProjectRolePermissionParams) SetRule(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["rule"] = v
+	p.rule = optString{v: v, ok: true}
 }
 
+// ResetRule unsets the rule param
 func (p *CreateProjectRolePermissionParams) ResetRule() {
-	if p.p != nil && p.p["rule"] != nil {
-		delete(p.p, "rule")
-	}
+	p.rule = optString{}
 }
 
+// GetRule returns the rule param and if it is set
 func (p *CreateProjectRolePermissionParams) GetRule() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.rule.v, p.rule.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateProjectRolePermissionParams) Clone() *CreateProjectRolePermissionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateProjectRolePermissionParams) Equal(o *CreateProjectRolePermissionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.permission == o.permission &&
+		p.projectid == o.projectid &&
+		p.projectroleid == o.projectroleid &&
+		p.rule == o.rule
+}
+
+// serializedCreateProjectRolePermissionParams is used to (un)marshal CreateProjectRolePermissionParams using the API param names
+type serializedCreateProjectRolePermissionParams struct {
+	Description   *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Permission    *string `json:"permission,omitempty" yaml:"permission,omitempty"`
+	Projectid     *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Projectroleid *string `json:"projectroleid,omitempty" yaml:"projectroleid,omitempty"`
+	Rule          *string `json:"rule,omitempty" yaml:"rule,omitempty"`
+}
+
+func (p *CreateProjectRolePermissionParams) toSerialized() *serializedCreateProjectRolePermissionParams {
+	s := &serializedCreateProjectRolePermissionParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.permission.ok {
+		s.Permission = &p.permission.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.projectroleid.ok {
+		s.Projectroleid = &p.projectroleid.v
+	}
+	if p.rule.ok {
+		s.Rule = &p.rule.v
+	}
+	return s
+}
+
+func (p *CreateProjectRolePermissionParams) fromSerialized(s *serializedCreateProjectRolePermissionParams) {
+	*p = CreateProjectRolePermissionParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Permission != nil {
+		p.SetPermission(*s.Permission)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Projectroleid != nil {
+		p.SetProjectroleid(*s.Projectroleid)
 	}
-	value, ok := p.p["rule"].(string)
-	return value, ok
+	if s.Rule != nil {
+		p.SetRule(*s.Rule)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateProjectRolePermissionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateProjectRolePermissionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateProjectRolePermissionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateProjectRolePermissionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateProjectRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewCreateProjectRolePermissionParams(permission string, projectid string, projectroleid string, rule string) *CreateProjectRolePermissionParams {
 	p := &CreateProjectRolePermissionParams{}
-	p.p = make(map[string]interface{})
-	p.p["permission"] = permission
-	p.p["projectid"] = projectid
-	p.p["projectroleid"] = projectroleid
-	p.p["rule"] = rule
+	p.SetPermission(permission)
+	p.SetProjectid(projectid)
+	p.SetProjectroleid(projectroleid)
+	p.SetRule(rule)
 	return p
 }
 
-// Adds API permissions to a project role
-func (s *ProjectService) CreateProjectRolePermission(p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("createProjectRolePermission", p.toURLValues())
+// Adds API permissions to a project role.
+//
+// Required params: permission, projectid, projectroleid, rule.
+func (s *ProjectService) CreateProjectRolePermission(p *CreateProjectRolePermissionParams, opts ...CallOption) (*CreateProjectRolePermissionResponse, error) {
+	resp, err := s.cs.newRequest("createProjectRolePermission", s.cs.encodeParams("createProjectRolePermission", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2994,151 +4561,254 @@ type CreateProjectRolePermissionResponse struct {
 }
 
 type UpdateProjectRolePermissionParams struct {
-	p map[string]interface{}
+	permission              optString
+	projectid               optString
+	projectroleid           optString
+	projectrolepermissionid optString
+	ruleorder               optStrings
 }
 
-func (p *UpdateProjectRolePermissionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateProjectRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["permission"]; found {
-		u.Set("permission", v.(string))
+	if p.permission.ok {
+		u.Set("permission", p.permission.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["projectroleid"]; found {
-		u.Set("projectroleid", v.(string))
+	if p.projectroleid.ok {
+		u.Set("projectroleid", p.projectroleid.v)
 	}
-	if v, found := p.p["projectrolepermissionid"]; found {
-		u.Set("projectrolepermissionid", v.(string))
+	if p.projectrolepermissionid.ok {
+		u.Set("projectrolepermissionid", p.projectrolepermissionid.v)
 	}
-	if v, found := p.p["ruleorder"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ruleorder", vv)
+	if p.ruleorder.ok {
+		u.Set("ruleorder", strings.Join(p.ruleorder.v, ","))
 	}
 	return u
 }
 
-func (p *UpdateProjectRolePermissionParams) SetPermission(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateProjectRolePermissionParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateProjectRolePermissionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateProjectRolePermissionParams(u url.Values) (*UpdateProjectRolePermissionParams, error) {
+	p := &UpdateProjectRolePermissionParams{}
+	if err := checkParamNames("updateProjectRolePermission", u, "permission", "projectid", "projectroleid", "projectrolepermissionid", "ruleorder"); err != nil {
+		return nil, err
+	}
+	if _, found := u["permission"]; found {
+		p.SetPermission(u.Get("permission"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["projectroleid"]; found {
+		p.SetProjectroleid(u.Get("projectroleid"))
 	}
-	p.p["permission"] = v
+	if _, found := u["projectrolepermissionid"]; found {
+		p.SetProjectrolepermissionid(u.Get("projectrolepermissionid"))
+	}
+	if _, found := u["ruleorder"]; found {
+		p.SetRuleorder(strings.Split(u.Get("ruleorder"), ","))
+	}
+	return p, nil
+}
+
+// SetPermission sets the permission param.
+func (p *UpdateProjectRolePermissionParams) SetPermission(v string) {
+	p.permission = optString{v: v, ok: true}
 }
 
+// ResetPermission unsets the permission param
 func (p *UpdateProjectRolePermissionParams) ResetPermission() {
-	if p.p != nil && p.p["permission"] != nil {
-		delete(p.p, "permission")
-	}
+	p.permission = optString{}
 }
 
+// GetPermission returns the permission param and if it is set
 func (p *UpdateProjectRolePermissionParams) GetPermission() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["permission"].(string)
-	return value, ok
+	return p.permission.v, p.permission.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *UpdateProjectRolePermissionParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *UpdateProjectRolePermissionParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *UpdateProjectRolePermissionParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProjectroleid sets the projectroleid param. This param is required.
 func (p *UpdateProjectRolePermissionParams) SetProjectroleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectroleid"] = v
+	p.projectroleid = optString{v: v, ok: true}
 }
 
+// ResetProjectroleid unsets the projectroleid param
 func (p *UpdateProjectRolePermissionParams) ResetProjectroleid() {
-	if p.p != nil && p.p["projectroleid"] != nil {
-		delete(p.p, "projectroleid")
-	}
+	p.projectroleid = optString{}
 }
 
+// GetProjectroleid returns the projectroleid param and if it is set
 func (p *UpdateProjectRolePermissionParams) GetProjectroleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectroleid"].(string)
-	return value, ok
+	return p.projectroleid.v, p.projectroleid.ok
 }
 
+// SetProjectrolepermissionid sets the projectrolepermissionid param.
 func (p *UpdateProjectRolePermissionParams) SetProjectrolepermissionid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectrolepermissionid"] = v
+	p.projectrolepermissionid = optString{v: v, ok: true}
 }
 
+// ResetProjectrolepermissionid unsets the projectrolepermissionid param
 func (p *UpdateProjectRolePermissionParams) ResetProjectrolepermissionid() {
-	if p.p != nil && p.p["projectrolepermissionid"] != nil {
-		delete(p.p, "projectrolepermissionid")
-	}
+	p.projectrolepermissionid = optString{}
 }
 
+// GetProjectrolepermissionid returns the projectrolepermissionid param and if it is set
 func (p *UpdateProjectRolePermissionParams) GetProjectrolepermissionid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectrolepermissionid"].(string)
-	return value, ok
+	return p.projectrolepermissionid.v, p.projectrolepermissionid.ok
 }
 
+// SetRuleorder sets the ruleorder param.
 func (p *UpdateProjectRolePermissionParams) SetRuleorder(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ruleorder"] = v
+	p.ruleorder = optStrings{v: v, ok: true}
 }
 
+// ResetRuleorder unsets the ruleorder param
 func (p *UpdateProjectRolePermissionParams) ResetRuleorder() {
-	if p.p != nil && p.p["ruleorder"] != nil {
-		delete(p.p, "ruleorder")
-	}
+	p.ruleorder = optStrings{}
 }
 
+// GetRuleorder returns the ruleorder param and if it is set
 func (p *UpdateProjectRolePermissionParams) GetRuleorder() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ruleorder.v, p.ruleorder.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateProjectRolePermissionParams) Clone() *UpdateProjectRolePermissionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ruleorder = p.ruleorder.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateProjectRolePermissionParams) Equal(o *UpdateProjectRolePermissionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.permission == o.permission &&
+		p.projectid == o.projectid &&
+		p.projectroleid == o.projectroleid &&
+		p.projectrolepermissionid == o.projectrolepermissionid &&
+		p.ruleorder.equal(o.ruleorder)
+}
+
+// serializedUpdateProjectRolePermissionParams is used to (un)marshal UpdateProjectRolePermissionParams using the API param names
+type serializedUpdateProjectRolePermissionParams struct {
+	Permission              *string   `json:"permission,omitempty" yaml:"permission,omitempty"`
+	Projectid               *string   `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Projectroleid           *string   `json:"projectroleid,omitempty" yaml:"projectroleid,omitempty"`
+	Projectrolepermissionid *string   `json:"projectrolepermissionid,omitempty" yaml:"projectrolepermissionid,omitempty"`
+	Ruleorder               *[]string `json:"ruleorder,omitempty" yaml:"ruleorder,omitempty"`
+}
+
+func (p *UpdateProjectRolePermissionParams) toSerialized() *serializedUpdateProjectRolePermissionParams {
+	s := &serializedUpdateProjectRolePermissionParams{}
+	if p.permission.ok {
+		s.Permission = &p.permission.v
 	}
-	value, ok := p.p["ruleorder"].([]string)
-	return value, ok
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.projectroleid.ok {
+		s.Projectroleid = &p.projectroleid.v
+	}
+	if p.projectrolepermissionid.ok {
+		s.Projectrolepermissionid = &p.projectrolepermissionid.v
+	}
+	if p.ruleorder.ok {
+		s.Ruleorder = &p.ruleorder.v
+	}
+	return s
+}
+
+func (p *UpdateProjectRolePermissionParams) fromSerialized(s *serializedUpdateProjectRolePermissionParams) {
+	*p = UpdateProjectRolePermissionParams{}
+	if s.Permission != nil {
+		p.SetPermission(*s.Permission)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Projectroleid != nil {
+		p.SetProjectroleid(*s.Projectroleid)
+	}
+	if s.Projectrolepermissionid != nil {
+		p.SetProjectrolepermissionid(*s.Projectrolepermissionid)
+	}
+	if s.Ruleorder != nil {
+		p.SetRuleorder(*s.Ruleorder)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateProjectRolePermissionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateProjectRolePermissionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateProjectRolePermissionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateProjectRolePermissionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateProjectRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewUpdateProjectRolePermissionParams(projectid string, projectroleid string) *UpdateProjectRolePermissionParams {
 	p := &UpdateProjectRolePermissionParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
-	p.p["projectroleid"] = projectroleid
+	p.SetProjectid(projectid)
+	p.SetProjectroleid(projectroleid)
 	return p
 }
 
-// Updates a project role permission and/or order
-func (s *ProjectService) UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("updateProjectRolePermission", p.toURLValues())
+// Updates a project role permission and/or order.
+//
+// Required params: projectid, projectroleid.
+func (s *ProjectService) UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams, opts ...CallOption) (*UpdateProjectRolePermissionResponse, error) {
+	resp, err := s.cs.newRequest("updateProjectRolePermission", s.cs.encodeParams("updateProjectRolePermission", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3186,78 +4856,163 @@ func (r *UpdateProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteProjectRolePermissionParams struct {
-	p map[string]interface{}
+	id        optString
+	projectid optString
 }
 
-func (p *DeleteProjectRolePermissionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteProjectRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *DeleteProjectRolePermissionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteProjectRolePermissionParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteProjectRolePermissionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteProjectRolePermissionParams(u url.Values) (*DeleteProjectRolePermissionParams, error) {
+	p := &DeleteProjectRolePermissionParams{}
+	if err := checkParamNames("deleteProjectRolePermission", u, "id", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteProjectRolePermissionParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteProjectRolePermissionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteProjectRolePermissionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetProjectid sets the projectid param. This param is required.
 func (p *DeleteProjectRolePermissionParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DeleteProjectRolePermissionParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DeleteProjectRolePermissionParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteProjectRolePermissionParams) Clone() *DeleteProjectRolePermissionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteProjectRolePermissionParams) Equal(o *DeleteProjectRolePermissionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.projectid == o.projectid
+}
+
+// serializedDeleteProjectRolePermissionParams is used to (un)marshal DeleteProjectRolePermissionParams using the API param names
+type serializedDeleteProjectRolePermissionParams struct {
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *DeleteProjectRolePermissionParams) toSerialized() *serializedDeleteProjectRolePermissionParams {
+	s := &serializedDeleteProjectRolePermissionParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *DeleteProjectRolePermissionParams) fromSerialized(s *serializedDeleteProjectRolePermissionParams) {
+	*p = DeleteProjectRolePermissionParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteProjectRolePermissionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteProjectRolePermissionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteProjectRolePermissionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteProjectRolePermissionParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteProjectRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewDeleteProjectRolePermissionParams(id string, projectid string) *DeleteProjectRolePermissionParams {
 	p := &DeleteProjectRolePermissionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["projectid"] = projectid
+	p.SetId(id)
+	p.SetProjectid(projectid)
 	return p
 }
 
-// Deletes a project role permission in the project
-func (s *ProjectService) DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("deleteProjectRolePermission", p.toURLValues())
+// Deletes a project role permission in the project.
+//
+// Required params: id, projectid.
+func (s *ProjectService) DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams, opts ...CallOption) (*DeleteProjectRolePermissionResponse, error) {
+	resp, err := s.cs.newRequest("deleteProjectRolePermission", s.cs.encodeParams("deleteProjectRolePermission", p), opts...)
 	if err != nil {
 		return nil, err
 	}
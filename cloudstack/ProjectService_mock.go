@@ -53,153 +53,203 @@ func (m *MockProjectServiceIface) EXPECT() *MockProjectServiceIfaceMockRecorder
 }
 
 // ActivateProject mocks base method.
-func (m *MockProjectServiceIface) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+func (m *MockProjectServiceIface) ActivateProject(p *ActivateProjectParams, opts ...CallOption) (*ActivateProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ActivateProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ActivateProject", varargs...)
 	ret0, _ := ret[0].(*ActivateProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ActivateProject indicates an expected call of ActivateProject.
-func (mr *MockProjectServiceIfaceMockRecorder) ActivateProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) ActivateProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).ActivateProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).ActivateProject), varargs...)
 }
 
 // AddAccountToProject mocks base method.
-func (m *MockProjectServiceIface) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+func (m *MockProjectServiceIface) AddAccountToProject(p *AddAccountToProjectParams, opts ...CallOption) (*AddAccountToProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddAccountToProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddAccountToProject", varargs...)
 	ret0, _ := ret[0].(*AddAccountToProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddAccountToProject indicates an expected call of AddAccountToProject.
-func (mr *MockProjectServiceIfaceMockRecorder) AddAccountToProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) AddAccountToProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountToProject", reflect.TypeOf((*MockProjectServiceIface)(nil).AddAccountToProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountToProject", reflect.TypeOf((*MockProjectServiceIface)(nil).AddAccountToProject), varargs...)
 }
 
 // AddUserToProject mocks base method.
-func (m *MockProjectServiceIface) AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
+func (m *MockProjectServiceIface) AddUserToProject(p *AddUserToProjectParams, opts ...CallOption) (*AddUserToProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserToProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddUserToProject", varargs...)
 	ret0, _ := ret[0].(*AddUserToProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddUserToProject indicates an expected call of AddUserToProject.
-func (mr *MockProjectServiceIfaceMockRecorder) AddUserToProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) AddUserToProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToProject", reflect.TypeOf((*MockProjectServiceIface)(nil).AddUserToProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToProject", reflect.TypeOf((*MockProjectServiceIface)(nil).AddUserToProject), varargs...)
 }
 
 // CreateProject mocks base method.
-func (m *MockProjectServiceIface) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
+func (m *MockProjectServiceIface) CreateProject(p *CreateProjectParams, opts ...CallOption) (*CreateProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateProject", varargs...)
 	ret0, _ := ret[0].(*CreateProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateProject indicates an expected call of CreateProject.
-func (mr *MockProjectServiceIfaceMockRecorder) CreateProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) CreateProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProject), varargs...)
 }
 
 // CreateProjectRolePermission mocks base method.
-func (m *MockProjectServiceIface) CreateProjectRolePermission(p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error) {
+func (m *MockProjectServiceIface) CreateProjectRolePermission(p *CreateProjectRolePermissionParams, opts ...CallOption) (*CreateProjectRolePermissionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProjectRolePermission", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateProjectRolePermission", varargs...)
 	ret0, _ := ret[0].(*CreateProjectRolePermissionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateProjectRolePermission indicates an expected call of CreateProjectRolePermission.
-func (mr *MockProjectServiceIfaceMockRecorder) CreateProjectRolePermission(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) CreateProjectRolePermission(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProjectRolePermission), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProjectRolePermission), varargs...)
 }
 
 // DeleteAccountFromProject mocks base method.
-func (m *MockProjectServiceIface) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+func (m *MockProjectServiceIface) DeleteAccountFromProject(p *DeleteAccountFromProjectParams, opts ...CallOption) (*DeleteAccountFromProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccountFromProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAccountFromProject", varargs...)
 	ret0, _ := ret[0].(*DeleteAccountFromProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAccountFromProject indicates an expected call of DeleteAccountFromProject.
-func (mr *MockProjectServiceIfaceMockRecorder) DeleteAccountFromProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteAccountFromProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountFromProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteAccountFromProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountFromProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteAccountFromProject), varargs...)
 }
 
 // DeleteProject mocks base method.
-func (m *MockProjectServiceIface) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+func (m *MockProjectServiceIface) DeleteProject(p *DeleteProjectParams, opts ...CallOption) (*DeleteProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProject", varargs...)
 	ret0, _ := ret[0].(*DeleteProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteProject indicates an expected call of DeleteProject.
-func (mr *MockProjectServiceIfaceMockRecorder) DeleteProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProject), varargs...)
 }
 
 // DeleteProjectInvitation mocks base method.
-func (m *MockProjectServiceIface) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+func (m *MockProjectServiceIface) DeleteProjectInvitation(p *DeleteProjectInvitationParams, opts ...CallOption) (*DeleteProjectInvitationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProjectInvitation", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProjectInvitation", varargs...)
 	ret0, _ := ret[0].(*DeleteProjectInvitationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteProjectInvitation indicates an expected call of DeleteProjectInvitation.
-func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectInvitation(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectInvitation(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectInvitation", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectInvitation), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectInvitation", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectInvitation), varargs...)
 }
 
 // DeleteProjectRolePermission mocks base method.
-func (m *MockProjectServiceIface) DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error) {
+func (m *MockProjectServiceIface) DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams, opts ...CallOption) (*DeleteProjectRolePermissionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProjectRolePermission", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProjectRolePermission", varargs...)
 	ret0, _ := ret[0].(*DeleteProjectRolePermissionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteProjectRolePermission indicates an expected call of DeleteProjectRolePermission.
-func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectRolePermission(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectRolePermission(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectRolePermission), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectRolePermission), varargs...)
 }
 
 // DeleteUserFromProject mocks base method.
-func (m *MockProjectServiceIface) DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
+func (m *MockProjectServiceIface) DeleteUserFromProject(p *DeleteUserFromProjectParams, opts ...CallOption) (*DeleteUserFromProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUserFromProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserFromProject", varargs...)
 	ret0, _ := ret[0].(*DeleteUserFromProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteUserFromProject indicates an expected call of DeleteUserFromProject.
-func (mr *MockProjectServiceIfaceMockRecorder) DeleteUserFromProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteUserFromProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserFromProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteUserFromProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserFromProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteUserFromProject), varargs...)
 }
 
 // GetProjectByID mocks base method.
@@ -287,48 +337,63 @@ func (mr *MockProjectServiceIfaceMockRecorder) GetProjectInvitationByID(id inter
 }
 
 // ListProjectInvitations mocks base method.
-func (m *MockProjectServiceIface) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+func (m *MockProjectServiceIface) ListProjectInvitations(p *ListProjectInvitationsParams, opts ...CallOption) (*ListProjectInvitationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjectInvitations", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectInvitations", varargs...)
 	ret0, _ := ret[0].(*ListProjectInvitationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProjectInvitations indicates an expected call of ListProjectInvitations.
-func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitations(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitations(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitations", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitations), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitations", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitations), varargs...)
 }
 
 // ListProjectRolePermissions mocks base method.
-func (m *MockProjectServiceIface) ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
+func (m *MockProjectServiceIface) ListProjectRolePermissions(p *ListProjectRolePermissionsParams, opts ...CallOption) (*ListProjectRolePermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjectRolePermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectRolePermissions", varargs...)
 	ret0, _ := ret[0].(*ListProjectRolePermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProjectRolePermissions indicates an expected call of ListProjectRolePermissions.
-func (mr *MockProjectServiceIfaceMockRecorder) ListProjectRolePermissions(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectRolePermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolePermissions", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectRolePermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolePermissions", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectRolePermissions), varargs...)
 }
 
 // ListProjects mocks base method.
-func (m *MockProjectServiceIface) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
+func (m *MockProjectServiceIface) ListProjects(p *ListProjectsParams, opts ...CallOption) (*ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjects", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjects", varargs...)
 	ret0, _ := ret[0].(*ListProjectsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProjects indicates an expected call of ListProjects.
-func (mr *MockProjectServiceIfaceMockRecorder) ListProjects(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjects(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjects), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjects), varargs...)
 }
 
 // NewActivateProjectParams mocks base method.
@@ -570,61 +635,81 @@ func (mr *MockProjectServiceIfaceMockRecorder) NewUpdateProjectRolePermissionPar
 }
 
 // SuspendProject mocks base method.
-func (m *MockProjectServiceIface) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+func (m *MockProjectServiceIface) SuspendProject(p *SuspendProjectParams, opts ...CallOption) (*SuspendProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SuspendProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendProject", varargs...)
 	ret0, _ := ret[0].(*SuspendProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SuspendProject indicates an expected call of SuspendProject.
-func (mr *MockProjectServiceIfaceMockRecorder) SuspendProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) SuspendProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProject", reflect.TypeOf((*MockProjectServiceIface)(nil).SuspendProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProject", reflect.TypeOf((*MockProjectServiceIface)(nil).SuspendProject), varargs...)
 }
 
 // UpdateProject mocks base method.
-func (m *MockProjectServiceIface) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+func (m *MockProjectServiceIface) UpdateProject(p *UpdateProjectParams, opts ...CallOption) (*UpdateProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProject", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProject", varargs...)
 	ret0, _ := ret[0].(*UpdateProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProject indicates an expected call of UpdateProject.
-func (mr *MockProjectServiceIfaceMockRecorder) UpdateProject(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProject(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProject), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProject), varargs...)
 }
 
 // UpdateProjectInvitation mocks base method.
-func (m *MockProjectServiceIface) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+func (m *MockProjectServiceIface) UpdateProjectInvitation(p *UpdateProjectInvitationParams, opts ...CallOption) (*UpdateProjectInvitationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProjectInvitation", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectInvitation", varargs...)
 	ret0, _ := ret[0].(*UpdateProjectInvitationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProjectInvitation indicates an expected call of UpdateProjectInvitation.
-func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectInvitation(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectInvitation(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectInvitation", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectInvitation), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectInvitation", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectInvitation), varargs...)
 }
 
 // UpdateProjectRolePermission mocks base method.
-func (m *MockProjectServiceIface) UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error) {
+func (m *MockProjectServiceIface) UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams, opts ...CallOption) (*UpdateProjectRolePermissionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProjectRolePermission", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProjectRolePermission", varargs...)
 	ret0, _ := ret[0].(*UpdateProjectRolePermissionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProjectRolePermission indicates an expected call of UpdateProjectRolePermission.
-func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectRolePermission(p interface{}) *gomock.Call {
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectRolePermission(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectRolePermission), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectRolePermission), varargs...)
 }
@@ -21,42 +21,3079 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
+	"strconv"
 )
 
 type QuotaServiceIface interface {
-	QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error)
+	QuotaBalance(p *QuotaBalanceParams, opts ...CallOption) (*QuotaBalanceResponse, error)
+	NewQuotaBalanceParams(account string, domainid string) *QuotaBalanceParams
+	QuotaCredits(p *QuotaCreditsParams, opts ...CallOption) (*QuotaCreditsResponse, error)
+	NewQuotaCreditsParams(account string, domainid string, value float64) *QuotaCreditsParams
+	QuotaEmailTemplateList(p *QuotaEmailTemplateListParams, opts ...CallOption) (*QuotaEmailTemplateListResponse, error)
+	NewQuotaEmailTemplateListParams() *QuotaEmailTemplateListParams
+	QuotaEmailTemplateUpdate(p *QuotaEmailTemplateUpdateParams, opts ...CallOption) (*QuotaEmailTemplateUpdateResponse, error)
+	NewQuotaEmailTemplateUpdateParams(templatebody string, templatesubject string, templatetype string) *QuotaEmailTemplateUpdateParams
+	QuotaIsEnabled(p *QuotaIsEnabledParams, opts ...CallOption) (*QuotaIsEnabledResponse, error)
 	NewQuotaIsEnabledParams() *QuotaIsEnabledParams
+	QuotaStatement(p *QuotaStatementParams, opts ...CallOption) (*QuotaStatementResponse, error)
+	NewQuotaStatementParams(account string, domainid string, enddate string, startdate string) *QuotaStatementParams
+	QuotaSummary(p *QuotaSummaryParams, opts ...CallOption) (*QuotaSummaryResponse, error)
+	NewQuotaSummaryParams() *QuotaSummaryParams
+	QuotaTariffCreate(p *QuotaTariffCreateParams, opts ...CallOption) (*QuotaTariffCreateResponse, error)
+	NewQuotaTariffCreateParams(name string, usagetype int, value float64) *QuotaTariffCreateParams
+	QuotaTariffDelete(p *QuotaTariffDeleteParams, opts ...CallOption) (*QuotaTariffDeleteResponse, error)
+	NewQuotaTariffDeleteParams(id string) *QuotaTariffDeleteParams
+	QuotaTariffList(p *QuotaTariffListParams, opts ...CallOption) (*QuotaTariffListResponse, error)
+	NewQuotaTariffListParams() *QuotaTariffListParams
+	QuotaTariffUpdate(p *QuotaTariffUpdateParams, opts ...CallOption) (*QuotaTariffUpdateResponse, error)
+	NewQuotaTariffUpdateParams(name string) *QuotaTariffUpdateParams
+	QuotaUpdate(p *QuotaUpdateParams, opts ...CallOption) (*QuotaUpdateResponse, error)
+	NewQuotaUpdateParams() *QuotaUpdateParams
+}
+
+type QuotaBalanceParams struct {
+	account   optString
+	accountid optString
+	domainid  optString
+	enddate   optString
+	startdate optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaBalanceParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
+	}
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
+	}
+	return u
+}
+
+// ParseQuotaBalanceParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaBalanceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaBalanceParams(u url.Values) (*QuotaBalanceParams, error) {
+	p := &QuotaBalanceParams{}
+	if err := checkParamNames("quotaBalance", u, "account", "accountid", "domainid", "enddate", "startdate"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param. This param is required.
+func (p *QuotaBalanceParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *QuotaBalanceParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *QuotaBalanceParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetAccountid sets the accountid param.
+func (p *QuotaBalanceParams) SetAccountid(v string) {
+	p.accountid = optString{v: v, ok: true}
+}
+
+// ResetAccountid unsets the accountid param
+func (p *QuotaBalanceParams) ResetAccountid() {
+	p.accountid = optString{}
+}
+
+// GetAccountid returns the accountid param and if it is set
+func (p *QuotaBalanceParams) GetAccountid() (string, bool) {
+	return p.accountid.v, p.accountid.ok
+}
+
+// SetDomainid sets the domainid param. This param is required.
+func (p *QuotaBalanceParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *QuotaBalanceParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *QuotaBalanceParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetEnddate sets the enddate param.
+func (p *QuotaBalanceParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
+func (p *QuotaBalanceParams) ResetEnddate() {
+	p.enddate = optString{}
+}
+
+// GetEnddate returns the enddate param and if it is set
+func (p *QuotaBalanceParams) GetEnddate() (string, bool) {
+	return p.enddate.v, p.enddate.ok
+}
+
+// SetStartdate sets the startdate param.
+func (p *QuotaBalanceParams) SetStartdate(v string) {
+	p.startdate = optString{v: v, ok: true}
+}
+
+// ResetStartdate unsets the startdate param
+func (p *QuotaBalanceParams) ResetStartdate() {
+	p.startdate = optString{}
+}
+
+// GetStartdate returns the startdate param and if it is set
+func (p *QuotaBalanceParams) GetStartdate() (string, bool) {
+	return p.startdate.v, p.startdate.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaBalanceParams) Clone() *QuotaBalanceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaBalanceParams) Equal(o *QuotaBalanceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accountid == o.accountid &&
+		p.domainid == o.domainid &&
+		p.enddate == o.enddate &&
+		p.startdate == o.startdate
+}
+
+// serializedQuotaBalanceParams is used to (un)marshal QuotaBalanceParams using the API param names
+type serializedQuotaBalanceParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enddate   *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Startdate *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+}
+
+func (p *QuotaBalanceParams) toSerialized() *serializedQuotaBalanceParams {
+	s := &serializedQuotaBalanceParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	return s
+}
+
+func (p *QuotaBalanceParams) fromSerialized(s *serializedQuotaBalanceParams) {
+	*p = QuotaBalanceParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaBalanceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaBalanceParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaBalanceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaBalanceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaBalanceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaBalanceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaBalanceParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaBalanceParams(account string, domainid string) *QuotaBalanceParams {
+	p := &QuotaBalanceParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	return p
+}
+
+// Create a quota balance statement.
+//
+// Required params: account, domainid.
+func (s *QuotaService) QuotaBalance(p *QuotaBalanceParams, opts ...CallOption) (*QuotaBalanceResponse, error) {
+	resp, err := s.cs.newRequest("quotaBalance", s.cs.encodeParams("quotaBalance", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r QuotaBalanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaBalanceResponse struct {
+	Account    string                        `json:"account"`
+	Accountid  string                        `json:"accountid"`
+	Credits    []QuotaBalanceResponseCredits `json:"credits"`
+	Currency   string                        `json:"currency"`
+	Domain     string                        `json:"domain"`
+	Enddate    string                        `json:"enddate"`
+	Endquota   float64                       `json:"endquota"`
+	JobID      string                        `json:"jobid"`
+	Jobstatus  int                           `json:"jobstatus"`
+	Startdate  string                        `json:"startdate"`
+	Startquota float64                       `json:"startquota"`
+}
+
+type QuotaBalanceResponseCredits struct {
+	Credits    float64 `json:"credits"`
+	Currency   string  `json:"currency"`
+	Updated_by string  `json:"updated_by"`
+	Updated_on string  `json:"updated_on"`
+}
+
+type QuotaCreditsParams struct {
+	account       optString
+	domainid      optString
+	min_balance   optFloat64
+	quota_enforce optBool
+	value         optFloat64
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaCreditsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.min_balance.ok {
+		u.Set("min_balance", strconv.FormatFloat(p.min_balance.v, 'f', -1, 64))
+	}
+	if p.quota_enforce.ok {
+		u.Set("quota_enforce", strconv.FormatBool(p.quota_enforce.v))
+	}
+	if p.value.ok {
+		u.Set("value", strconv.FormatFloat(p.value.v, 'f', -1, 64))
+	}
+	return u
+}
+
+// ParseQuotaCreditsParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaCreditsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaCreditsParams(u url.Values) (*QuotaCreditsParams, error) {
+	p := &QuotaCreditsParams{}
+	if err := checkParamNames("quotaCredits", u, "account", "domainid", "min_balance", "quota_enforce", "value"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["min_balance"]; found {
+		v, err := strconv.ParseFloat(u.Get("min_balance"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param min_balance: %v", err)
+		}
+		p.SetMin_balance(v)
+	}
+	if _, found := u["quota_enforce"]; found {
+		v, err := strconv.ParseBool(u.Get("quota_enforce"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param quota_enforce: %v", err)
+		}
+		p.SetQuota_enforce(v)
+	}
+	if _, found := u["value"]; found {
+		v, err := strconv.ParseFloat(u.Get("value"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param value: %v", err)
+		}
+		p.SetValue(v)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param. This param is required.
+func (p *QuotaCreditsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *QuotaCreditsParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *QuotaCreditsParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param. This param is required.
+func (p *QuotaCreditsParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *QuotaCreditsParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *QuotaCreditsParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetMin_balance sets the min_balance param.
+func (p *QuotaCreditsParams) SetMin_balance(v float64) {
+	p.min_balance = optFloat64{v: v, ok: true}
+}
+
+// ResetMin_balance unsets the min_balance param
+func (p *QuotaCreditsParams) ResetMin_balance() {
+	p.min_balance = optFloat64{}
+}
+
+// GetMin_balance returns the min_balance param and if it is set
+func (p *QuotaCreditsParams) GetMin_balance() (float64, bool) {
+	return p.min_balance.v, p.min_balance.ok
+}
+
+// SetQuota_enforce sets the quota_enforce param.
+func (p *QuotaCreditsParams) SetQuota_enforce(v bool) {
+	p.quota_enforce = optBool{v: v, ok: true}
+}
+
+// ResetQuota_enforce unsets the quota_enforce param
+func (p *QuotaCreditsParams) ResetQuota_enforce() {
+	p.quota_enforce = optBool{}
+}
+
+// GetQuota_enforce returns the quota_enforce param and if it is set
+func (p *QuotaCreditsParams) GetQuota_enforce() (bool, bool) {
+	return p.quota_enforce.v, p.quota_enforce.ok
+}
+
+// SetValue sets the value param. This param is required.
+func (p *QuotaCreditsParams) SetValue(v float64) {
+	p.value = optFloat64{v: v, ok: true}
+}
+
+// ResetValue unsets the value param
+func (p *QuotaCreditsParams) ResetValue() {
+	p.value = optFloat64{}
+}
+
+// GetValue returns the value param and if it is set
+func (p *QuotaCreditsParams) GetValue() (float64, bool) {
+	return p.value.v, p.value.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaCreditsParams) Clone() *QuotaCreditsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaCreditsParams) Equal(o *QuotaCreditsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.min_balance == o.min_balance &&
+		p.quota_enforce == o.quota_enforce &&
+		p.value == o.value
+}
+
+// serializedQuotaCreditsParams is used to (un)marshal QuotaCreditsParams using the API param names
+type serializedQuotaCreditsParams struct {
+	Account       *string  `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid      *string  `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Min_balance   *float64 `json:"min_balance,omitempty" yaml:"min_balance,omitempty"`
+	Quota_enforce *bool    `json:"quota_enforce,omitempty" yaml:"quota_enforce,omitempty"`
+	Value         *float64 `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+func (p *QuotaCreditsParams) toSerialized() *serializedQuotaCreditsParams {
+	s := &serializedQuotaCreditsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.min_balance.ok {
+		s.Min_balance = &p.min_balance.v
+	}
+	if p.quota_enforce.ok {
+		s.Quota_enforce = &p.quota_enforce.v
+	}
+	if p.value.ok {
+		s.Value = &p.value.v
+	}
+	return s
+}
+
+func (p *QuotaCreditsParams) fromSerialized(s *serializedQuotaCreditsParams) {
+	*p = QuotaCreditsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Min_balance != nil {
+		p.SetMin_balance(*s.Min_balance)
+	}
+	if s.Quota_enforce != nil {
+		p.SetQuota_enforce(*s.Quota_enforce)
+	}
+	if s.Value != nil {
+		p.SetValue(*s.Value)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaCreditsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaCreditsParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaCreditsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaCreditsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaCreditsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaCreditsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaCreditsParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaCreditsParams(account string, domainid string, value float64) *QuotaCreditsParams {
+	p := &QuotaCreditsParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	p.SetValue(value)
+	return p
+}
+
+// Add +-credits to an account.
+//
+// Required params: account, domainid, value.
+func (s *QuotaService) QuotaCredits(p *QuotaCreditsParams, opts ...CallOption) (*QuotaCreditsResponse, error) {
+	resp, err := s.cs.newRequest("quotaCredits", s.cs.encodeParams("quotaCredits", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r QuotaCreditsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaCreditsResponse struct {
+	Credits    float64 `json:"credits"`
+	Currency   string  `json:"currency"`
+	JobID      string  `json:"jobid"`
+	Jobstatus  int     `json:"jobstatus"`
+	Updated_by string  `json:"updated_by"`
+	Updated_on string  `json:"updated_on"`
+}
+
+type QuotaEmailTemplateListParams struct {
+	templatetype optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaEmailTemplateListParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.templatetype.ok {
+		u.Set("templatetype", p.templatetype.v)
+	}
+	return u
+}
+
+// ParseQuotaEmailTemplateListParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaEmailTemplateListParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaEmailTemplateListParams(u url.Values) (*QuotaEmailTemplateListParams, error) {
+	p := &QuotaEmailTemplateListParams{}
+	if err := checkParamNames("quotaEmailTemplateList", u, "templatetype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["templatetype"]; found {
+		p.SetTemplatetype(u.Get("templatetype"))
+	}
+	return p, nil
+}
+
+// SetTemplatetype sets the templatetype param.
+func (p *QuotaEmailTemplateListParams) SetTemplatetype(v string) {
+	p.templatetype = optString{v: v, ok: true}
+}
+
+// ResetTemplatetype unsets the templatetype param
+func (p *QuotaEmailTemplateListParams) ResetTemplatetype() {
+	p.templatetype = optString{}
+}
+
+// GetTemplatetype returns the templatetype param and if it is set
+func (p *QuotaEmailTemplateListParams) GetTemplatetype() (string, bool) {
+	return p.templatetype.v, p.templatetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaEmailTemplateListParams) Clone() *QuotaEmailTemplateListParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaEmailTemplateListParams) Equal(o *QuotaEmailTemplateListParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.templatetype == o.templatetype
+}
+
+// serializedQuotaEmailTemplateListParams is used to (un)marshal QuotaEmailTemplateListParams using the API param names
+type serializedQuotaEmailTemplateListParams struct {
+	Templatetype *string `json:"templatetype,omitempty" yaml:"templatetype,omitempty"`
+}
+
+func (p *QuotaEmailTemplateListParams) toSerialized() *serializedQuotaEmailTemplateListParams {
+	s := &serializedQuotaEmailTemplateListParams{}
+	if p.templatetype.ok {
+		s.Templatetype = &p.templatetype.v
+	}
+	return s
+}
+
+func (p *QuotaEmailTemplateListParams) fromSerialized(s *serializedQuotaEmailTemplateListParams) {
+	*p = QuotaEmailTemplateListParams{}
+	if s.Templatetype != nil {
+		p.SetTemplatetype(*s.Templatetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaEmailTemplateListParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaEmailTemplateListParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaEmailTemplateListParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaEmailTemplateListParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaEmailTemplateListParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaEmailTemplateListParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaEmailTemplateListParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaEmailTemplateListParams() *QuotaEmailTemplateListParams {
+	p := &QuotaEmailTemplateListParams{}
+	return p
+}
+
+// Lists all quota email templates.
+func (s *QuotaService) QuotaEmailTemplateList(p *QuotaEmailTemplateListParams, opts ...CallOption) (*QuotaEmailTemplateListResponse, error) {
+	resp, err := s.cs.newRequest("quotaEmailTemplateList", s.cs.encodeParams("quotaEmailTemplateList", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r QuotaEmailTemplateListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaEmailTemplateListResponse struct {
+	Count              int                   `json:"count"`
+	Quotaemailtemplate []*QuotaEmailTemplate `json:"quotaemailtemplate"`
+}
+
+type QuotaEmailTemplate struct {
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Last_updated    string `json:"last_updated"`
+	Locale          string `json:"locale"`
+	Templatebody    string `json:"templatebody"`
+	Templatesubject string `json:"templatesubject"`
+	Templatetype    string `json:"templatetype"`
+}
+
+type QuotaEmailTemplateUpdateParams struct {
+	locale          optString
+	templatebody    optString
+	templatesubject optString
+	templatetype    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaEmailTemplateUpdateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.locale.ok {
+		u.Set("locale", p.locale.v)
+	}
+	if p.templatebody.ok {
+		u.Set("templatebody", p.templatebody.v)
+	}
+	if p.templatesubject.ok {
+		u.Set("templatesubject", p.templatesubject.v)
+	}
+	if p.templatetype.ok {
+		u.Set("templatetype", p.templatetype.v)
+	}
+	return u
+}
+
+// ParseQuotaEmailTemplateUpdateParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaEmailTemplateUpdateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaEmailTemplateUpdateParams(u url.Values) (*QuotaEmailTemplateUpdateParams, error) {
+	p := &QuotaEmailTemplateUpdateParams{}
+	if err := checkParamNames("quotaEmailTemplateUpdate", u, "locale", "templatebody", "templatesubject", "templatetype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["locale"]; found {
+		p.SetLocale(u.Get("locale"))
+	}
+	if _, found := u["templatebody"]; found {
+		p.SetTemplatebody(u.Get("templatebody"))
+	}
+	if _, found := u["templatesubject"]; found {
+		p.SetTemplatesubject(u.Get("templatesubject"))
+	}
+	if _, found := u["templatetype"]; found {
+		p.SetTemplatetype(u.Get("templatetype"))
+	}
+	return p, nil
+}
+
+// SetLocale sets the locale param.
+func (p *QuotaEmailTemplateUpdateParams) SetLocale(v string) {
+	p.locale = optString{v: v, ok: true}
+}
+
+// ResetLocale unsets the locale param
+func (p *QuotaEmailTemplateUpdateParams) ResetLocale() {
+	p.locale = optString{}
+}
+
+// GetLocale returns the locale param and if it is set
+func (p *QuotaEmailTemplateUpdateParams) GetLocale() (string, bool) {
+	return p.locale.v, p.locale.ok
+}
+
+// SetTemplatebody sets the templatebody param. This param is required.
+func (p *QuotaEmailTemplateUpdateParams) SetTemplatebody(v string) {
+	p.templatebody = optString{v: v, ok: true}
+}
+
+// ResetTemplatebody unsets the templatebody param
+func (p *QuotaEmailTemplateUpdateParams) ResetTemplatebody() {
+	p.templatebody = optString{}
+}
+
+// GetTemplatebody returns the templatebody param and if it is set
+func (p *QuotaEmailTemplateUpdateParams) GetTemplatebody() (string, bool) {
+	return p.templatebody.v, p.templatebody.ok
+}
+
+// SetTemplatesubject sets the templatesubject param. This param is required.
+func (p *QuotaEmailTemplateUpdateParams) SetTemplatesubject(v string) {
+	p.templatesubject = optString{v: v, ok: true}
+}
+
+// ResetTemplatesubject unsets the templatesubject param
+func (p *QuotaEmailTemplateUpdateParams) ResetTemplatesubject() {
+	p.templatesubject = optString{}
+}
+
+// GetTemplatesubject returns the templatesubject param and if it is set
+func (p *QuotaEmailTemplateUpdateParams) GetTemplatesubject() (string, bool) {
+	return p.templatesubject.v, p.templatesubject.ok
+}
+
+// SetTemplatetype sets the templatetype param. This param is required.
+func (p *QuotaEmailTemplateUpdateParams) SetTemplatetype(v string) {
+	p.templatetype = optString{v: v, ok: true}
+}
+
+// ResetTemplatetype unsets the templatetype param
+func (p *QuotaEmailTemplateUpdateParams) ResetTemplatetype() {
+	p.templatetype = optString{}
+}
+
+// GetTemplatetype returns the templatetype param and if it is set
+func (p *QuotaEmailTemplateUpdateParams) GetTemplatetype() (string, bool) {
+	return p.templatetype.v, p.templatetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaEmailTemplateUpdateParams) Clone() *QuotaEmailTemplateUpdateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaEmailTemplateUpdateParams) Equal(o *QuotaEmailTemplateUpdateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.locale == o.locale &&
+		p.templatebody == o.templatebody &&
+		p.templatesubject == o.templatesubject &&
+		p.templatetype == o.templatetype
+}
+
+// serializedQuotaEmailTemplateUpdateParams is used to (un)marshal QuotaEmailTemplateUpdateParams using the API param names
+type serializedQuotaEmailTemplateUpdateParams struct {
+	Locale          *string `json:"locale,omitempty" yaml:"locale,omitempty"`
+	Templatebody    *string `json:"templatebody,omitempty" yaml:"templatebody,omitempty"`
+	Templatesubject *string `json:"templatesubject,omitempty" yaml:"templatesubject,omitempty"`
+	Templatetype    *string `json:"templatetype,omitempty" yaml:"templatetype,omitempty"`
+}
+
+func (p *QuotaEmailTemplateUpdateParams) toSerialized() *serializedQuotaEmailTemplateUpdateParams {
+	s := &serializedQuotaEmailTemplateUpdateParams{}
+	if p.locale.ok {
+		s.Locale = &p.locale.v
+	}
+	if p.templatebody.ok {
+		s.Templatebody = &p.templatebody.v
+	}
+	if p.templatesubject.ok {
+		s.Templatesubject = &p.templatesubject.v
+	}
+	if p.templatetype.ok {
+		s.Templatetype = &p.templatetype.v
+	}
+	return s
+}
+
+func (p *QuotaEmailTemplateUpdateParams) fromSerialized(s *serializedQuotaEmailTemplateUpdateParams) {
+	*p = QuotaEmailTemplateUpdateParams{}
+	if s.Locale != nil {
+		p.SetLocale(*s.Locale)
+	}
+	if s.Templatebody != nil {
+		p.SetTemplatebody(*s.Templatebody)
+	}
+	if s.Templatesubject != nil {
+		p.SetTemplatesubject(*s.Templatesubject)
+	}
+	if s.Templatetype != nil {
+		p.SetTemplatetype(*s.Templatetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaEmailTemplateUpdateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaEmailTemplateUpdateParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaEmailTemplateUpdateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaEmailTemplateUpdateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaEmailTemplateUpdateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaEmailTemplateUpdateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaEmailTemplateUpdateParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaEmailTemplateUpdateParams(templatebody string, templatesubject string, templatetype string) *QuotaEmailTemplateUpdateParams {
+	p := &QuotaEmailTemplateUpdateParams{}
+	p.SetTemplatebody(templatebody)
+	p.SetTemplatesubject(templatesubject)
+	p.SetTemplatetype(templatetype)
+	return p
+}
+
+// Updates existing email templates for quota alerts.
+//
+// Required params: templatebody, templatesubject, templatetype.
+func (s *QuotaService) QuotaEmailTemplateUpdate(p *QuotaEmailTemplateUpdateParams, opts ...CallOption) (*QuotaEmailTemplateUpdateResponse, error) {
+	resp, err := s.cs.newRequest("quotaEmailTemplateUpdate", s.cs.encodeParams("quotaEmailTemplateUpdate", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r QuotaEmailTemplateUpdateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaEmailTemplateUpdateResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *QuotaEmailTemplateUpdateResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias QuotaEmailTemplateUpdateResponse
+	return json.Unmarshal(b, (*alias)(r))
 }
 
 type QuotaIsEnabledParams struct {
-	p map[string]interface{}
 }
 
-func (p *QuotaIsEnabledParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaIsEnabledParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	return u
+}
+
+// ParseQuotaIsEnabledParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaIsEnabledParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaIsEnabledParams(u url.Values) (*QuotaIsEnabledParams, error) {
+	p := &QuotaIsEnabledParams{}
+	if err := checkParamNames("quotaIsEnabled", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaIsEnabledParams) Clone() *QuotaIsEnabledParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaIsEnabledParams) Equal(o *QuotaIsEnabledParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedQuotaIsEnabledParams is used to (un)marshal QuotaIsEnabledParams using the API param names
+type serializedQuotaIsEnabledParams struct {
+}
+
+func (p *QuotaIsEnabledParams) toSerialized() *serializedQuotaIsEnabledParams {
+	s := &serializedQuotaIsEnabledParams{}
+	return s
+}
+
+func (p *QuotaIsEnabledParams) fromSerialized(s *serializedQuotaIsEnabledParams) {
+	*p = QuotaIsEnabledParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaIsEnabledParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaIsEnabledParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaIsEnabledParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaIsEnabledParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaIsEnabledParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaIsEnabledParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaIsEnabledParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
+	p := &QuotaIsEnabledParams{}
+	return p
+}
+
+// Return true if the plugin is enabled.
+func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams, opts ...CallOption) (*QuotaIsEnabledResponse, error) {
+	resp, err := s.cs.newRequest("quotaIsEnabled", s.cs.encodeParams("quotaIsEnabled", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r QuotaIsEnabledResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaIsEnabledResponse struct {
+	Isenabled bool   `json:"isenabled"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+}
+
+type QuotaStatementParams struct {
+	account   optString
+	accountid optString
+	domainid  optString
+	enddate   optString
+	startdate optString
+	type_     optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaStatementParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
+	}
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
+	}
+	if p.type_.ok {
+		u.Set("type", strconv.Itoa(p.type_.v))
+	}
+	return u
+}
+
+// ParseQuotaStatementParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaStatementParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaStatementParams(u url.Values) (*QuotaStatementParams, error) {
+	p := &QuotaStatementParams{}
+	if err := checkParamNames("quotaStatement", u, "account", "accountid", "domainid", "enddate", "startdate", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["type"]; found {
+		v, err := strconv.Atoi(u.Get("type"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param type: %v", err)
+		}
+		p.SetType(v)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param. This param is required.
+func (p *QuotaStatementParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *QuotaStatementParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *QuotaStatementParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetAccountid sets the accountid param.
+func (p *QuotaStatementParams) SetAccountid(v string) {
+	p.accountid = optString{v: v, ok: true}
+}
+
+// ResetAccountid unsets the accountid param
+func (p *QuotaStatementParams) ResetAccountid() {
+	p.accountid = optString{}
+}
+
+// GetAccountid returns the accountid param and if it is set
+func (p *QuotaStatementParams) GetAccountid() (string, bool) {
+	return p.accountid.v, p.accountid.ok
+}
+
+// SetDomainid sets the domainid param. This param is required.
+func (p *QuotaStatementParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *QuotaStatementParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *QuotaStatementParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetEnddate sets the enddate param. This param is required.
+func (p *QuotaStatementParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
+func (p *QuotaStatementParams) ResetEnddate() {
+	p.enddate = optString{}
+}
+
+// GetEnddate returns the enddate param and if it is set
+func (p *QuotaStatementParams) GetEnddate() (string, bool) {
+	return p.enddate.v, p.enddate.ok
+}
+
+// SetStartdate sets the startdate param. This param is required.
+func (p *QuotaStatementParams) SetStartdate(v string) {
+	p.startdate = optString{v: v, ok: true}
+}
+
+// ResetStartdate unsets the startdate param
+func (p *QuotaStatementParams) ResetStartdate() {
+	p.startdate = optString{}
+}
+
+// GetStartdate returns the startdate param and if it is set
+func (p *QuotaStatementParams) GetStartdate() (string, bool) {
+	return p.startdate.v, p.startdate.ok
+}
+
+// SetType sets the type param.
+func (p *QuotaStatementParams) SetType(v int) {
+	p.type_ = optInt{v: v, ok: true}
+}
+
+// ResetType unsets the type param
+func (p *QuotaStatementParams) ResetType() {
+	p.type_ = optInt{}
+}
+
+// GetType returns the type param and if it is set
+func (p *QuotaStatementParams) GetType() (int, bool) {
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaStatementParams) Clone() *QuotaStatementParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaStatementParams) Equal(o *QuotaStatementParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accountid == o.accountid &&
+		p.domainid == o.domainid &&
+		p.enddate == o.enddate &&
+		p.startdate == o.startdate &&
+		p.type_ == o.type_
+}
+
+// serializedQuotaStatementParams is used to (un)marshal QuotaStatementParams using the API param names
+type serializedQuotaStatementParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enddate   *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Startdate *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *int    `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *QuotaStatementParams) toSerialized() *serializedQuotaStatementParams {
+	s := &serializedQuotaStatementParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *QuotaStatementParams) fromSerialized(s *serializedQuotaStatementParams) {
+	*p = QuotaStatementParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaStatementParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaStatementParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaStatementParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaStatementParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaStatementParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaStatementParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaStatementParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaStatementParams(account string, domainid string, enddate string, startdate string) *QuotaStatementParams {
+	p := &QuotaStatementParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	p.SetEnddate(enddate)
+	p.SetStartdate(startdate)
+	return p
+}
+
+// Create a quota statement.
+//
+// Required params: account, domainid, enddate, startdate.
+func (s *QuotaService) QuotaStatement(p *QuotaStatementParams, opts ...CallOption) (*QuotaStatementResponse, error) {
+	resp, err := s.cs.newRequest("quotaStatement", s.cs.encodeParams("quotaStatement", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r QuotaStatementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaStatementResponse struct {
+	Account    string                             `json:"account"`
+	Accountid  string                             `json:"accountid"`
+	Currency   string                             `json:"currency"`
+	Domain     string                             `json:"domain"`
+	Enddate    string                             `json:"enddate"`
+	JobID      string                             `json:"jobid"`
+	Jobstatus  int                                `json:"jobstatus"`
+	Quotausage []QuotaStatementResponseQuotausage `json:"quotausage"`
+	Startdate  string                             `json:"startdate"`
+	Totalquota float64                            `json:"totalquota"`
+}
+
+type QuotaStatementResponseQuotausage struct {
+	Account   string  `json:"account"`
+	Accountid string  `json:"accountid"`
+	Domain    string  `json:"domain"`
+	Name      string  `json:"name"`
+	Quota     float64 `json:"quota"`
+	Type      int     `json:"type"`
+	Unit      string  `json:"unit"`
+}
+
+type QuotaSummaryParams struct {
+	account  optString
+	domainid optString
+	keyword  optString
+	listall  optBool
+	page     optInt
+	pagesize optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaSummaryParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	return u
+}
+
+// ParseQuotaSummaryParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaSummaryParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaSummaryParams(u url.Values) (*QuotaSummaryParams, error) {
+	p := &QuotaSummaryParams{}
+	if err := checkParamNames("quotaSummary", u, "account", "domainid", "keyword", "listall", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *QuotaSummaryParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *QuotaSummaryParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *QuotaSummaryParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *QuotaSummaryParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *QuotaSummaryParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *QuotaSummaryParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetKeyword sets the keyword param.
+func (p *QuotaSummaryParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *QuotaSummaryParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *QuotaSummaryParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetListall sets the listall param.
+func (p *QuotaSummaryParams) SetListall(v bool) {
+	p.listall = optBool{v: v, ok: true}
+}
+
+// ResetListall unsets the listall param
+func (p *QuotaSummaryParams) ResetListall() {
+	p.listall = optBool{}
+}
+
+// GetListall returns the listall param and if it is set
+func (p *QuotaSummaryParams) GetListall() (bool, bool) {
+	return p.listall.v, p.listall.ok
+}
+
+// SetPage sets the page param.
+func (p *QuotaSummaryParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *QuotaSummaryParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *QuotaSummaryParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *QuotaSummaryParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *QuotaSummaryParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *QuotaSummaryParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaSummaryParams) Clone() *QuotaSummaryParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaSummaryParams) Equal(o *QuotaSummaryParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedQuotaSummaryParams is used to (un)marshal QuotaSummaryParams using the API param names
+type serializedQuotaSummaryParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall  *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *QuotaSummaryParams) toSerialized() *serializedQuotaSummaryParams {
+	s := &serializedQuotaSummaryParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *QuotaSummaryParams) fromSerialized(s *serializedQuotaSummaryParams) {
+	*p = QuotaSummaryParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaSummaryParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaSummaryParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaSummaryParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaSummaryParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaSummaryParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaSummaryParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaSummaryParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaSummaryParams() *QuotaSummaryParams {
+	p := &QuotaSummaryParams{}
+	return p
+}
+
+// Lists balance and quota usage for all accounts.
+func (s *QuotaService) QuotaSummary(p *QuotaSummaryParams, opts ...CallOption) (*QuotaSummaryResponse, error) {
+	resp, err := s.cs.newRequest("quotaSummary", s.cs.encodeParams("quotaSummary", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r QuotaSummaryResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaSummaryResponse struct {
+	Count   int             `json:"count"`
+	Summary []*QuotaSummary `json:"summary"`
+}
+
+type QuotaSummary struct {
+	Account      string  `json:"account"`
+	Accountid    string  `json:"accountid"`
+	Balance      float64 `json:"balance"`
+	Currency     string  `json:"currency"`
+	Domain       string  `json:"domain"`
+	Domainid     string  `json:"domainid"`
+	Enddate      string  `json:"enddate"`
+	JobID        string  `json:"jobid"`
+	Jobstatus    int     `json:"jobstatus"`
+	Projectid    string  `json:"projectid"`
+	Projectname  string  `json:"projectname"`
+	Quota        float64 `json:"quota"`
+	Quotaenabled bool    `json:"quotaenabled"`
+	Startdate    string  `json:"startdate"`
+	State        string  `json:"state"`
+}
+
+type QuotaTariffCreateParams struct {
+	activationrule optString
+	description    optString
+	enddate        optString
+	name           optString
+	startdate      optString
+	usagetype      optInt
+	value          optFloat64
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaTariffCreateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.activationrule.ok {
+		u.Set("activationrule", p.activationrule.v)
+	}
+	if p.description.ok {
+		u.Set("description", p.description.v)
+	}
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
+	}
+	if p.usagetype.ok {
+		u.Set("usagetype", strconv.Itoa(p.usagetype.v))
+	}
+	if p.value.ok {
+		u.Set("value", strconv.FormatFloat(p.value.v, 'f', -1, 64))
+	}
+	return u
+}
+
+// ParseQuotaTariffCreateParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaTariffCreateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaTariffCreateParams(u url.Values) (*QuotaTariffCreateParams, error) {
+	p := &QuotaTariffCreateParams{}
+	if err := checkParamNames("quotaTariffCreate", u, "activationrule", "description", "enddate", "name", "startdate", "usagetype", "value"); err != nil {
+		return nil, err
+	}
+	if _, found := u["activationrule"]; found {
+		p.SetActivationrule(u.Get("activationrule"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["usagetype"]; found {
+		v, err := strconv.Atoi(u.Get("usagetype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param usagetype: %v", err)
+		}
+		p.SetUsagetype(v)
+	}
+	if _, found := u["value"]; found {
+		v, err := strconv.ParseFloat(u.Get("value"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param value: %v", err)
+		}
+		p.SetValue(v)
+	}
+	return p, nil
+}
+
+// SetActivationrule sets the activationrule param.
+func (p *QuotaTariffCreateParams) SetActivationrule(v string) {
+	p.activationrule = optString{v: v, ok: true}
+}
+
+// ResetActivationrule unsets the activationrule param
+func (p *QuotaTariffCreateParams) ResetActivationrule() {
+	p.activationrule = optString{}
+}
+
+// GetActivationrule returns the activationrule param and if it is set
+func (p *QuotaTariffCreateParams) GetActivationrule() (string, bool) {
+	return p.activationrule.v, p.activationrule.ok
+}
+
+// SetDescription sets the description param.
+func (p *QuotaTariffCreateParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
+func (p *QuotaTariffCreateParams) ResetDescription() {
+	p.description = optString{}
+}
+
+// GetDescription returns the description param and if it is set
+func (p *QuotaTariffCreateParams) GetDescription() (string, bool) {
+	return p.description.v, p.description.ok
+}
+
+// SetEnddate sets the enddate param.
+func (p *QuotaTariffCreateParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
+func (p *QuotaTariffCreateParams) ResetEnddate() {
+	p.enddate = optString{}
+}
+
+// GetEnddate returns the enddate param and if it is set
+func (p *QuotaTariffCreateParams) GetEnddate() (string, bool) {
+	return p.enddate.v, p.enddate.ok
+}
+
+// SetName sets the name param. This param is required.
+func (p *QuotaTariffCreateParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *QuotaTariffCreateParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *QuotaTariffCreateParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetStartdate sets the startdate param.
+func (p *QuotaTariffCreateParams) SetStartdate(v string) {
+	p.startdate = optString{v: v, ok: true}
+}
+
+// ResetStartdate unsets the startdate param
+func (p *QuotaTariffCreateParams) ResetStartdate() {
+	p.startdate = optString{}
+}
+
+// GetStartdate returns the startdate param and if it is set
+func (p *QuotaTariffCreateParams) GetStartdate() (string, bool) {
+	return p.startdate.v, p.startdate.ok
+}
+
+// SetUsagetype sets the usagetype param. This param is required.
+func (p *QuotaTariffCreateParams) SetUsagetype(v int) {
+	p.usagetype = optInt{v: v, ok: true}
+}
+
+// ResetUsagetype unsets the usagetype param
+func (p *QuotaTariffCreateParams) ResetUsagetype() {
+	p.usagetype = optInt{}
+}
+
+// GetUsagetype returns the usagetype param and if it is set
+func (p *QuotaTariffCreateParams) GetUsagetype() (int, bool) {
+	return p.usagetype.v, p.usagetype.ok
+}
+
+// SetValue sets the value param. This param is required.
+func (p *QuotaTariffCreateParams) SetValue(v float64) {
+	p.value = optFloat64{v: v, ok: true}
+}
+
+// ResetValue unsets the value param
+func (p *QuotaTariffCreateParams) ResetValue() {
+	p.value = optFloat64{}
+}
+
+// GetValue returns the value param and if it is set
+func (p *QuotaTariffCreateParams) GetValue() (float64, bool) {
+	return p.value.v, p.value.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaTariffCreateParams) Clone() *QuotaTariffCreateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaTariffCreateParams) Equal(o *QuotaTariffCreateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.activationrule == o.activationrule &&
+		p.description == o.description &&
+		p.enddate == o.enddate &&
+		p.name == o.name &&
+		p.startdate == o.startdate &&
+		p.usagetype == o.usagetype &&
+		p.value == o.value
+}
+
+// serializedQuotaTariffCreateParams is used to (un)marshal QuotaTariffCreateParams using the API param names
+type serializedQuotaTariffCreateParams struct {
+	Activationrule *string  `json:"activationrule,omitempty" yaml:"activationrule,omitempty"`
+	Description    *string  `json:"description,omitempty" yaml:"description,omitempty"`
+	Enddate        *string  `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Name           *string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Startdate      *string  `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Usagetype      *int     `json:"usagetype,omitempty" yaml:"usagetype,omitempty"`
+	Value          *float64 `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+func (p *QuotaTariffCreateParams) toSerialized() *serializedQuotaTariffCreateParams {
+	s := &serializedQuotaTariffCreateParams{}
+	if p.activationrule.ok {
+		s.Activationrule = &p.activationrule.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.usagetype.ok {
+		s.Usagetype = &p.usagetype.v
+	}
+	if p.value.ok {
+		s.Value = &p.value.v
+	}
+	return s
+}
+
+func (p *QuotaTariffCreateParams) fromSerialized(s *serializedQuotaTariffCreateParams) {
+	*p = QuotaTariffCreateParams{}
+	if s.Activationrule != nil {
+		p.SetActivationrule(*s.Activationrule)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Usagetype != nil {
+		p.SetUsagetype(*s.Usagetype)
+	}
+	if s.Value != nil {
+		p.SetValue(*s.Value)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaTariffCreateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaTariffCreateParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaTariffCreateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaTariffCreateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaTariffCreateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaTariffCreateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaTariffCreateParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaTariffCreateParams(name string, usagetype int, value float64) *QuotaTariffCreateParams {
+	p := &QuotaTariffCreateParams{}
+	p.SetName(name)
+	p.SetUsagetype(usagetype)
+	p.SetValue(value)
+	return p
+}
+
+// Creates a quota tariff for a resource.
+//
+// Required params: name, usagetype, value.
+func (s *QuotaService) QuotaTariffCreate(p *QuotaTariffCreateParams, opts ...CallOption) (*QuotaTariffCreateResponse, error) {
+	resp, err := s.cs.newRequest("quotaTariffCreate", s.cs.encodeParams("quotaTariffCreate", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r QuotaTariffCreateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaTariffCreateResponse struct {
+	ActivationRule     string  `json:"activationRule"`
+	Currency           string  `json:"currency"`
+	Description        string  `json:"description"`
+	EffectiveDate      string  `json:"effectiveDate"`
+	EndDate            string  `json:"endDate"`
+	Id                 string  `json:"id"`
+	JobID              string  `json:"jobid"`
+	Jobstatus          int     `json:"jobstatus"`
+	Name               string  `json:"name"`
+	Removed            string  `json:"removed"`
+	TariffValue        float64 `json:"tariffValue"`
+	UsageDiscriminator string  `json:"usageDiscriminator"`
+	UsageName          string  `json:"usageName"`
+	UsageType          int     `json:"usageType"`
+	UsageUnit          string  `json:"usageUnit"`
+}
+
+type QuotaTariffDeleteParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaTariffDeleteParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseQuotaTariffDeleteParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaTariffDeleteParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaTariffDeleteParams(u url.Values) (*QuotaTariffDeleteParams, error) {
+	p := &QuotaTariffDeleteParams{}
+	if err := checkParamNames("quotaTariffDelete", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *QuotaTariffDeleteParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *QuotaTariffDeleteParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *QuotaTariffDeleteParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaTariffDeleteParams) Clone() *QuotaTariffDeleteParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaTariffDeleteParams) Equal(o *QuotaTariffDeleteParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedQuotaTariffDeleteParams is used to (un)marshal QuotaTariffDeleteParams using the API param names
+type serializedQuotaTariffDeleteParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *QuotaTariffDeleteParams) toSerialized() *serializedQuotaTariffDeleteParams {
+	s := &serializedQuotaTariffDeleteParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *QuotaTariffDeleteParams) fromSerialized(s *serializedQuotaTariffDeleteParams) {
+	*p = QuotaTariffDeleteParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaTariffDeleteParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaTariffDeleteParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaTariffDeleteParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaTariffDeleteParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaTariffDeleteParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaTariffDeleteParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaTariffDeleteParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaTariffDeleteParams(id string) *QuotaTariffDeleteParams {
+	p := &QuotaTariffDeleteParams{}
+	p.SetId(id)
+	return p
+}
+
+// Marks a quota tariff as removed.
+//
+// Required params: id.
+func (s *QuotaService) QuotaTariffDelete(p *QuotaTariffDeleteParams, opts ...CallOption) (*QuotaTariffDeleteResponse, error) {
+	resp, err := s.cs.newRequest("quotaTariffDelete", s.cs.encodeParams("quotaTariffDelete", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r QuotaTariffDeleteResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaTariffDeleteResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *QuotaTariffDeleteResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias QuotaTariffDeleteResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type QuotaTariffListParams struct {
+	enddate         optString
+	id              optString
+	keyword         optString
+	listall         optBool
+	listonlyremoved optBool
+	name            optString
+	page            optInt
+	pagesize        optInt
+	startdate       optString
+	usagetype       optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaTariffListParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
+	}
+	if p.listonlyremoved.ok {
+		u.Set("listonlyremoved", strconv.FormatBool(p.listonlyremoved.v))
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
+	}
+	if p.usagetype.ok {
+		u.Set("usagetype", strconv.Itoa(p.usagetype.v))
+	}
+	return u
+}
+
+// ParseQuotaTariffListParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaTariffListParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaTariffListParams(u url.Values) (*QuotaTariffListParams, error) {
+	p := &QuotaTariffListParams{}
+	if err := checkParamNames("quotaTariffList", u, "enddate", "id", "keyword", "listall", "listonlyremoved", "name", "page", "pagesize", "startdate", "usagetype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["listonlyremoved"]; found {
+		v, err := strconv.ParseBool(u.Get("listonlyremoved"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listonlyremoved: %v", err)
+		}
+		p.SetListonlyremoved(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["usagetype"]; found {
+		v, err := strconv.Atoi(u.Get("usagetype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param usagetype: %v", err)
+		}
+		p.SetUsagetype(v)
+	}
+	return p, nil
+}
+
+// SetEnddate sets the enddate param.
+func (p *QuotaTariffListParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
+func (p *QuotaTariffListParams) ResetEnddate() {
+	p.enddate = optString{}
+}
+
+// GetEnddate returns the enddate param and if it is set
+func (p *QuotaTariffListParams) GetEnddate() (string, bool) {
+	return p.enddate.v, p.enddate.ok
+}
+
+// SetId sets the id param.
+func (p *QuotaTariffListParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *QuotaTariffListParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *QuotaTariffListParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetKeyword sets the keyword param.
+func (p *QuotaTariffListParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *QuotaTariffListParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *QuotaTariffListParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetListall sets the listall param.
+func (p *QuotaTariffListParams) SetListall(v bool) {
+	p.listall = optBool{v: v, ok: true}
+}
+
+// ResetListall unsets the listall param
+func (p *QuotaTariffListParams) ResetListall() {
+	p.listall = optBool{}
+}
+
+// GetListall returns the listall param and if it is set
+func (p *QuotaTariffListParams) GetListall() (bool, bool) {
+	return p.listall.v, p.listall.ok
+}
+
+// SetListonlyremoved sets the listonlyremoved param.
+func (p *QuotaTariffListParams) SetListonlyremoved(v bool) {
+	p.listonlyremoved = optBool{v: v, ok: true}
+}
+
+// ResetListonlyremoved unsets the listonlyremoved param
+func (p *QuotaTariffListParams) ResetListonlyremoved() {
+	p.listonlyremoved = optBool{}
+}
+
+// GetListonlyremoved returns the listonlyremoved param and if it is set
+func (p *QuotaTariffListParams) GetListonlyremoved() (bool, bool) {
+	return p.listonlyremoved.v, p.listonlyremoved.ok
+}
+
+// SetName sets the name param.
+func (p *QuotaTariffListParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *QuotaTariffListParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *QuotaTariffListParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetPage sets the page param.
+func (p *QuotaTariffListParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *QuotaTariffListParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *QuotaTariffListParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *QuotaTariffListParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *QuotaTariffListParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *QuotaTariffListParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetStartdate sets the startdate param.
+func (p *QuotaTariffListParams) SetStartdate(v string) {
+	p.startdate = optString{v: v, ok: true}
+}
+
+// ResetStartdate unsets the startdate param
+func (p *QuotaTariffListParams) ResetStartdate() {
+	p.startdate = optString{}
+}
+
+// GetStartdate returns the startdate param and if it is set
+func (p *QuotaTariffListParams) GetStartdate() (string, bool) {
+	return p.startdate.v, p.startdate.ok
+}
+
+// SetUsagetype sets the usagetype param.
+func (p *QuotaTariffListParams) SetUsagetype(v int) {
+	p.usagetype = optInt{v: v, ok: true}
+}
+
+// ResetUsagetype unsets the usagetype param
+func (p *QuotaTariffListParams) ResetUsagetype() {
+	p.usagetype = optInt{}
+}
+
+// GetUsagetype returns the usagetype param and if it is set
+func (p *QuotaTariffListParams) GetUsagetype() (int, bool) {
+	return p.usagetype.v, p.usagetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaTariffListParams) Clone() *QuotaTariffListParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaTariffListParams) Equal(o *QuotaTariffListParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enddate == o.enddate &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.listonlyremoved == o.listonlyremoved &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.startdate == o.startdate &&
+		p.usagetype == o.usagetype
+}
+
+// serializedQuotaTariffListParams is used to (un)marshal QuotaTariffListParams using the API param names
+type serializedQuotaTariffListParams struct {
+	Enddate         *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall         *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Listonlyremoved *bool   `json:"listonlyremoved,omitempty" yaml:"listonlyremoved,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Startdate       *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Usagetype       *int    `json:"usagetype,omitempty" yaml:"usagetype,omitempty"`
+}
+
+func (p *QuotaTariffListParams) toSerialized() *serializedQuotaTariffListParams {
+	s := &serializedQuotaTariffListParams{}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.listonlyremoved.ok {
+		s.Listonlyremoved = &p.listonlyremoved.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.usagetype.ok {
+		s.Usagetype = &p.usagetype.v
+	}
+	return s
+}
+
+func (p *QuotaTariffListParams) fromSerialized(s *serializedQuotaTariffListParams) {
+	*p = QuotaTariffListParams{}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Listonlyremoved != nil {
+		p.SetListonlyremoved(*s.Listonlyremoved)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Usagetype != nil {
+		p.SetUsagetype(*s.Usagetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaTariffListParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaTariffListParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaTariffListParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaTariffListParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaTariffListParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaTariffListParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaTariffListParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaTariffListParams() *QuotaTariffListParams {
+	p := &QuotaTariffListParams{}
+	return p
+}
+
+// Lists all quota tariff plans.
+func (s *QuotaService) QuotaTariffList(p *QuotaTariffListParams, opts ...CallOption) (*QuotaTariffListResponse, error) {
+	resp, err := s.cs.newRequest("quotaTariffList", s.cs.encodeParams("quotaTariffList", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r QuotaTariffListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaTariffListResponse struct {
+	Count       int            `json:"count"`
+	Quotatariff []*QuotaTariff `json:"quotatariff"`
+}
+
+type QuotaTariff struct {
+	ActivationRule     string  `json:"activationRule"`
+	Currency           string  `json:"currency"`
+	Description        string  `json:"description"`
+	EffectiveDate      string  `json:"effectiveDate"`
+	EndDate            string  `json:"endDate"`
+	Id                 string  `json:"id"`
+	JobID              string  `json:"jobid"`
+	Jobstatus          int     `json:"jobstatus"`
+	Name               string  `json:"name"`
+	Removed            string  `json:"removed"`
+	TariffValue        float64 `json:"tariffValue"`
+	UsageDiscriminator string  `json:"usageDiscriminator"`
+	UsageName          string  `json:"usageName"`
+	UsageType          int     `json:"usageType"`
+	UsageUnit          string  `json:"usageUnit"`
+}
+
+type QuotaTariffUpdateParams struct {
+	activationrule optString
+	description    optString
+	enddate        optString
+	name           optString
+	startdate      optString
+	usagetype      optInt
+	value          optFloat64
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaTariffUpdateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
+	if p.activationrule.ok {
+		u.Set("activationrule", p.activationrule.v)
+	}
+	if p.description.ok {
+		u.Set("description", p.description.v)
+	}
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
+	}
+	if p.usagetype.ok {
+		u.Set("usagetype", strconv.Itoa(p.usagetype.v))
+	}
+	if p.value.ok {
+		u.Set("value", strconv.FormatFloat(p.value.v, 'f', -1, 64))
+	}
 	return u
 }
 
-// You should always use this function to get a new QuotaIsEnabledParams instance,
+// ParseQuotaTariffUpdateParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaTariffUpdateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaTariffUpdateParams(u url.Values) (*QuotaTariffUpdateParams, error) {
+	p := &QuotaTariffUpdateParams{}
+	if err := checkParamNames("quotaTariffUpdate", u, "activationrule", "description", "enddate", "name", "startdate", "usagetype", "value"); err != nil {
+		return nil, err
+	}
+	if _, found := u["activationrule"]; found {
+		p.SetActivationrule(u.Get("activationrule"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
+	}
+	if _, found := u["usagetype"]; found {
+		v, err := strconv.Atoi(u.Get("usagetype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param usagetype: %v", err)
+		}
+		p.SetUsagetype(v)
+	}
+	if _, found := u["value"]; found {
+		v, err := strconv.ParseFloat(u.Get("value"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param value: %v", err)
+		}
+		p.SetValue(v)
+	}
+	return p, nil
+}
+
+// SetActivationrule sets the activationrule param.
+func (p *QuotaTariffUpdateParams) SetActivationrule(v string) {
+	p.activationrule = optString{v: v, ok: true}
+}
+
+// ResetActivationrule unsets the activationrule param
+func (p *QuotaTariffUpdateParams) ResetActivationrule() {
+	p.activationrule = optString{}
+}
+
+// GetActivationrule returns the activationrule param and if it is set
+func (p *QuotaTariffUpdateParams) GetActivationrule() (string, bool) {
+	return p.activationrule.v, p.activationrule.ok
+}
+
+// SetDescription sets the description param.
+func (p *QuotaTariffUpdateParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
+func (p *QuotaTariffUpdateParams) ResetDescription() {
+	p.description = optString{}
+}
+
+// GetDescription returns the description param and if it is set
+func (p *QuotaTariffUpdateParams) GetDescription() (string, bool) {
+	return p.description.v, p.description.ok
+}
+
+// SetEnddate sets the enddate param.
+func (p *QuotaTariffUpdateParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
+func (p *QuotaTariffUpdateParams) ResetEnddate() {
+	p.enddate = optString{}
+}
+
+// GetEnddate returns the enddate param and if it is set
+func (p *QuotaTariffUpdateParams) GetEnddate() (string, bool) {
+	return p.enddate.v, p.enddate.ok
+}
+
+// SetName sets the name param. This param is required.
+func (p *QuotaTariffUpdateParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *QuotaTariffUpdateParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *QuotaTariffUpdateParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetStartdate sets the startdate param.
+func (p *QuotaTariffUpdateParams) SetStartdate(v string) {
+	p.startdate = optString{v: v, ok: true}
+}
+
+// ResetStartdate unsets the startdate param
+func (p *QuotaTariffUpdateParams) ResetStartdate() {
+	p.startdate = optString{}
+}
+
+// GetStartdate returns the startdate param and if it is set
+func (p *QuotaTariffUpdateParams) GetStartdate() (string, bool) {
+	return p.startdate.v, p.startdate.ok
+}
+
+// SetUsagetype sets the usagetype param.
+func (p *QuotaTariffUpdateParams) SetUsagetype(v int) {
+	p.usagetype = optInt{v: v, ok: true}
+}
+
+// ResetUsagetype unsets the usagetype param
+func (p *QuotaTariffUpdateParams) ResetUsagetype() {
+	p.usagetype = optInt{}
+}
+
+// GetUsagetype returns the usagetype param and if it is set
+func (p *QuotaTariffUpdateParams) GetUsagetype() (int, bool) {
+	return p.usagetype.v, p.usagetype.ok
+}
+
+// SetValue sets the value param.
+func (p *QuotaTariffUpdateParams) SetValue(v float64) {
+	p.value = optFloat64{v: v, ok: true}
+}
+
+// ResetValue unsets the value param
+func (p *QuotaTariffUpdateParams) ResetValue() {
+	p.value = optFloat64{}
+}
+
+// GetValue returns the value param and if it is set
+func (p *QuotaTariffUpdateParams) GetValue() (float64, bool) {
+	return p.value.v, p.value.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaTariffUpdateParams) Clone() *QuotaTariffUpdateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaTariffUpdateParams) Equal(o *QuotaTariffUpdateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.activationrule == o.activationrule &&
+		p.description == o.description &&
+		p.enddate == o.enddate &&
+		p.name == o.name &&
+		p.startdate == o.startdate &&
+		p.usagetype == o.usagetype &&
+		p.value == o.value
+}
+
+// serializedQuotaTariffUpdateParams is used to (un)marshal QuotaTariffUpdateParams using the API param names
+type serializedQuotaTariffUpdateParams struct {
+	Activationrule *string  `json:"activationrule,omitempty" yaml:"activationrule,omitempty"`
+	Description    *string  `json:"description,omitempty" yaml:"description,omitempty"`
+	Enddate        *string  `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Name           *string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Startdate      *string  `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Usagetype      *int     `json:"usagetype,omitempty" yaml:"usagetype,omitempty"`
+	Value          *float64 `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+func (p *QuotaTariffUpdateParams) toSerialized() *serializedQuotaTariffUpdateParams {
+	s := &serializedQuotaTariffUpdateParams{}
+	if p.activationrule.ok {
+		s.Activationrule = &p.activationrule.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.usagetype.ok {
+		s.Usagetype = &p.usagetype.v
+	}
+	if p.value.ok {
+		s.Value = &p.value.v
+	}
+	return s
+}
+
+func (p *QuotaTariffUpdateParams) fromSerialized(s *serializedQuotaTariffUpdateParams) {
+	*p = QuotaTariffUpdateParams{}
+	if s.Activationrule != nil {
+		p.SetActivationrule(*s.Activationrule)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Usagetype != nil {
+		p.SetUsagetype(*s.Usagetype)
+	}
+	if s.Value != nil {
+		p.SetValue(*s.Value)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaTariffUpdateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaTariffUpdateParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaTariffUpdateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaTariffUpdateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaTariffUpdateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaTariffUpdateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaTariffUpdateParams instance,
 // as then you are sure you have configured all required params
-func (s *QuotaService) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
-	p := &QuotaIsEnabledParams{}
-	p.p = make(map[string]interface{})
+func (s *QuotaService) NewQuotaTariffUpdateParams(name string) *QuotaTariffUpdateParams {
+	p := &QuotaTariffUpdateParams{}
+	p.SetName(name)
 	return p
 }
 
-// Return true if the plugin is enabled
-func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
-	resp, err := s.cs.newRequest("quotaIsEnabled", p.toURLValues())
+// Update the tariff plan for a resource.
+//
+// Required params: name.
+func (s *QuotaService) QuotaTariffUpdate(p *QuotaTariffUpdateParams, opts ...CallOption) (*QuotaTariffUpdateResponse, error) {
+	resp, err := s.cs.newRequest("quotaTariffUpdate", s.cs.encodeParams("quotaTariffUpdate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	var r QuotaIsEnabledResponse
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r QuotaTariffUpdateResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -64,8 +3101,137 @@ func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledR
 	return &r, nil
 }
 
-type QuotaIsEnabledResponse struct {
-	Isenabled bool   `json:"isenabled"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
+type QuotaTariffUpdateResponse struct {
+	ActivationRule     string  `json:"activationRule"`
+	Currency           string  `json:"currency"`
+	Description        string  `json:"description"`
+	EffectiveDate      string  `json:"effectiveDate"`
+	EndDate            string  `json:"endDate"`
+	Id                 string  `json:"id"`
+	JobID              string  `json:"jobid"`
+	Jobstatus          int     `json:"jobstatus"`
+	Name               string  `json:"name"`
+	Removed            string  `json:"removed"`
+	TariffValue        float64 `json:"tariffValue"`
+	UsageDiscriminator string  `json:"usageDiscriminator"`
+	UsageName          string  `json:"usageName"`
+	UsageType          int     `json:"usageType"`
+	UsageUnit          string  `json:"usageUnit"`
+}
+
+type QuotaUpdateParams struct {
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *QuotaUpdateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	return u
+}
+
+// ParseQuotaUpdateParams parses url.Values, for example taken from a raw API request,
+// into a new QuotaUpdateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseQuotaUpdateParams(u url.Values) (*QuotaUpdateParams, error) {
+	p := &QuotaUpdateParams{}
+	if err := checkParamNames("quotaUpdate", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *QuotaUpdateParams) Clone() *QuotaUpdateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *QuotaUpdateParams) Equal(o *QuotaUpdateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedQuotaUpdateParams is used to (un)marshal QuotaUpdateParams using the API param names
+type serializedQuotaUpdateParams struct {
+}
+
+func (p *QuotaUpdateParams) toSerialized() *serializedQuotaUpdateParams {
+	s := &serializedQuotaUpdateParams{}
+	return s
+}
+
+func (p *QuotaUpdateParams) fromSerialized(s *serializedQuotaUpdateParams) {
+	*p = QuotaUpdateParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p QuotaUpdateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *QuotaUpdateParams) UnmarshalJSON(b []byte) error {
+	var s serializedQuotaUpdateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p QuotaUpdateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *QuotaUpdateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedQuotaUpdateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new QuotaUpdateParams instance,
+// as then you are sure you have configured all required params
+func (s *QuotaService) NewQuotaUpdateParams() *QuotaUpdateParams {
+	p := &QuotaUpdateParams{}
+	return p
+}
+
+// Update quota calculations, alerts and statements.
+func (s *QuotaService) QuotaUpdate(p *QuotaUpdateParams, opts ...CallOption) (*QuotaUpdateResponse, error) {
+	resp, err := s.cs.newRequest("quotaUpdate", s.cs.encodeParams("quotaUpdate", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r QuotaUpdateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuotaUpdateResponse struct {
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Updated_on string `json:"updated_on"`
 }
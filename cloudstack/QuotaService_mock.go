@@ -52,6 +52,62 @@ func (m *MockQuotaServiceIface) EXPECT() *MockQuotaServiceIfaceMockRecorder {
 	return m.recorder
 }
 
+// NewQuotaBalanceParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaBalanceParams(account, domainid string) *QuotaBalanceParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaBalanceParams", account, domainid)
+	ret0, _ := ret[0].(*QuotaBalanceParams)
+	return ret0
+}
+
+// NewQuotaBalanceParams indicates an expected call of NewQuotaBalanceParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaBalanceParams(account, domainid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaBalanceParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaBalanceParams), account, domainid)
+}
+
+// NewQuotaCreditsParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaCreditsParams(account, domainid string, value float64) *QuotaCreditsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaCreditsParams", account, domainid, value)
+	ret0, _ := ret[0].(*QuotaCreditsParams)
+	return ret0
+}
+
+// NewQuotaCreditsParams indicates an expected call of NewQuotaCreditsParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaCreditsParams(account, domainid, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaCreditsParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaCreditsParams), account, domainid, value)
+}
+
+// NewQuotaEmailTemplateListParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaEmailTemplateListParams() *QuotaEmailTemplateListParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaEmailTemplateListParams")
+	ret0, _ := ret[0].(*QuotaEmailTemplateListParams)
+	return ret0
+}
+
+// NewQuotaEmailTemplateListParams indicates an expected call of NewQuotaEmailTemplateListParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaEmailTemplateListParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaEmailTemplateListParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaEmailTemplateListParams))
+}
+
+// NewQuotaEmailTemplateUpdateParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaEmailTemplateUpdateParams(templatebody, templatesubject, templatetype string) *QuotaEmailTemplateUpdateParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaEmailTemplateUpdateParams", templatebody, templatesubject, templatetype)
+	ret0, _ := ret[0].(*QuotaEmailTemplateUpdateParams)
+	return ret0
+}
+
+// NewQuotaEmailTemplateUpdateParams indicates an expected call of NewQuotaEmailTemplateUpdateParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaEmailTemplateUpdateParams(templatebody, templatesubject, templatetype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaEmailTemplateUpdateParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaEmailTemplateUpdateParams), templatebody, templatesubject, templatetype)
+}
+
 // NewQuotaIsEnabledParams mocks base method.
 func (m *MockQuotaServiceIface) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
 	m.ctrl.T.Helper()
@@ -66,17 +122,340 @@ func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaIsEnabledParams() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaIsEnabledParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaIsEnabledParams))
 }
 
+// NewQuotaStatementParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaStatementParams(account, domainid, enddate, startdate string) *QuotaStatementParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaStatementParams", account, domainid, enddate, startdate)
+	ret0, _ := ret[0].(*QuotaStatementParams)
+	return ret0
+}
+
+// NewQuotaStatementParams indicates an expected call of NewQuotaStatementParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaStatementParams(account, domainid, enddate, startdate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaStatementParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaStatementParams), account, domainid, enddate, startdate)
+}
+
+// NewQuotaSummaryParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaSummaryParams() *QuotaSummaryParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaSummaryParams")
+	ret0, _ := ret[0].(*QuotaSummaryParams)
+	return ret0
+}
+
+// NewQuotaSummaryParams indicates an expected call of NewQuotaSummaryParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaSummaryParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaSummaryParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaSummaryParams))
+}
+
+// NewQuotaTariffCreateParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaTariffCreateParams(name string, usagetype int, value float64) *QuotaTariffCreateParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaTariffCreateParams", name, usagetype, value)
+	ret0, _ := ret[0].(*QuotaTariffCreateParams)
+	return ret0
+}
+
+// NewQuotaTariffCreateParams indicates an expected call of NewQuotaTariffCreateParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaTariffCreateParams(name, usagetype, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaTariffCreateParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaTariffCreateParams), name, usagetype, value)
+}
+
+// NewQuotaTariffDeleteParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaTariffDeleteParams(id string) *QuotaTariffDeleteParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaTariffDeleteParams", id)
+	ret0, _ := ret[0].(*QuotaTariffDeleteParams)
+	return ret0
+}
+
+// NewQuotaTariffDeleteParams indicates an expected call of NewQuotaTariffDeleteParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaTariffDeleteParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaTariffDeleteParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaTariffDeleteParams), id)
+}
+
+// NewQuotaTariffListParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaTariffListParams() *QuotaTariffListParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaTariffListParams")
+	ret0, _ := ret[0].(*QuotaTariffListParams)
+	return ret0
+}
+
+// NewQuotaTariffListParams indicates an expected call of NewQuotaTariffListParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaTariffListParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaTariffListParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaTariffListParams))
+}
+
+// NewQuotaTariffUpdateParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaTariffUpdateParams(name string) *QuotaTariffUpdateParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaTariffUpdateParams", name)
+	ret0, _ := ret[0].(*QuotaTariffUpdateParams)
+	return ret0
+}
+
+// NewQuotaTariffUpdateParams indicates an expected call of NewQuotaTariffUpdateParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaTariffUpdateParams(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaTariffUpdateParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaTariffUpdateParams), name)
+}
+
+// NewQuotaUpdateParams mocks base method.
+func (m *MockQuotaServiceIface) NewQuotaUpdateParams() *QuotaUpdateParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQuotaUpdateParams")
+	ret0, _ := ret[0].(*QuotaUpdateParams)
+	return ret0
+}
+
+// NewQuotaUpdateParams indicates an expected call of NewQuotaUpdateParams.
+func (mr *MockQuotaServiceIfaceMockRecorder) NewQuotaUpdateParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQuotaUpdateParams", reflect.TypeOf((*MockQuotaServiceIface)(nil).NewQuotaUpdateParams))
+}
+
+// QuotaBalance mocks base method.
+func (m *MockQuotaServiceIface) QuotaBalance(p *QuotaBalanceParams, opts ...CallOption) (*QuotaBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaBalance", varargs...)
+	ret0, _ := ret[0].(*QuotaBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaBalance indicates an expected call of QuotaBalance.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaBalance(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaBalance", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaBalance), varargs...)
+}
+
+// QuotaCredits mocks base method.
+func (m *MockQuotaServiceIface) QuotaCredits(p *QuotaCreditsParams, opts ...CallOption) (*QuotaCreditsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaCredits", varargs...)
+	ret0, _ := ret[0].(*QuotaCreditsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaCredits indicates an expected call of QuotaCredits.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaCredits(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaCredits", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaCredits), varargs...)
+}
+
+// QuotaEmailTemplateList mocks base method.
+func (m *MockQuotaServiceIface) QuotaEmailTemplateList(p *QuotaEmailTemplateListParams, opts ...CallOption) (*QuotaEmailTemplateListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaEmailTemplateList", varargs...)
+	ret0, _ := ret[0].(*QuotaEmailTemplateListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaEmailTemplateList indicates an expected call of QuotaEmailTemplateList.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaEmailTemplateList(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaEmailTemplateList", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaEmailTemplateList), varargs...)
+}
+
+// QuotaEmailTemplateUpdate mocks base method.
+func (m *MockQuotaServiceIface) QuotaEmailTemplateUpdate(p *QuotaEmailTemplateUpdateParams, opts ...CallOption) (*QuotaEmailTemplateUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaEmailTemplateUpdate", varargs...)
+	ret0, _ := ret[0].(*QuotaEmailTemplateUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaEmailTemplateUpdate indicates an expected call of QuotaEmailTemplateUpdate.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaEmailTemplateUpdate(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaEmailTemplateUpdate", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaEmailTemplateUpdate), varargs...)
+}
+
 // QuotaIsEnabled mocks base method.
-func (m *MockQuotaServiceIface) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
+func (m *MockQuotaServiceIface) QuotaIsEnabled(p *QuotaIsEnabledParams, opts ...CallOption) (*QuotaIsEnabledResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QuotaIsEnabled", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaIsEnabled", varargs...)
 	ret0, _ := ret[0].(*QuotaIsEnabledResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QuotaIsEnabled indicates an expected call of QuotaIsEnabled.
-func (mr *MockQuotaServiceIfaceMockRecorder) QuotaIsEnabled(p interface{}) *gomock.Call {
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaIsEnabled(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaIsEnabled", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaIsEnabled), varargs...)
+}
+
+// QuotaStatement mocks base method.
+func (m *MockQuotaServiceIface) QuotaStatement(p *QuotaStatementParams, opts ...CallOption) (*QuotaStatementResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaStatement", varargs...)
+	ret0, _ := ret[0].(*QuotaStatementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaStatement indicates an expected call of QuotaStatement.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaStatement(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaStatement", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaStatement), varargs...)
+}
+
+// QuotaSummary mocks base method.
+func (m *MockQuotaServiceIface) QuotaSummary(p *QuotaSummaryParams, opts ...CallOption) (*QuotaSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaSummary", varargs...)
+	ret0, _ := ret[0].(*QuotaSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaSummary indicates an expected call of QuotaSummary.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaSummary(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaSummary", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaSummary), varargs...)
+}
+
+// QuotaTariffCreate mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffCreate(p *QuotaTariffCreateParams, opts ...CallOption) (*QuotaTariffCreateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaTariffCreate", varargs...)
+	ret0, _ := ret[0].(*QuotaTariffCreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffCreate indicates an expected call of QuotaTariffCreate.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffCreate(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffCreate", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffCreate), varargs...)
+}
+
+// QuotaTariffDelete mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffDelete(p *QuotaTariffDeleteParams, opts ...CallOption) (*QuotaTariffDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaTariffDelete", varargs...)
+	ret0, _ := ret[0].(*QuotaTariffDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffDelete indicates an expected call of QuotaTariffDelete.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffDelete(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffDelete", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffDelete), varargs...)
+}
+
+// QuotaTariffList mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffList(p *QuotaTariffListParams, opts ...CallOption) (*QuotaTariffListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaTariffList", varargs...)
+	ret0, _ := ret[0].(*QuotaTariffListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffList indicates an expected call of QuotaTariffList.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffList(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffList", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffList), varargs...)
+}
+
+// QuotaTariffUpdate mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffUpdate(p *QuotaTariffUpdateParams, opts ...CallOption) (*QuotaTariffUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaTariffUpdate", varargs...)
+	ret0, _ := ret[0].(*QuotaTariffUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffUpdate indicates an expected call of QuotaTariffUpdate.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffUpdate(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffUpdate", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffUpdate), varargs...)
+}
+
+// QuotaUpdate mocks base method.
+func (m *MockQuotaServiceIface) QuotaUpdate(p *QuotaUpdateParams, opts ...CallOption) (*QuotaUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotaUpdate", varargs...)
+	ret0, _ := ret[0].(*QuotaUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaUpdate indicates an expected call of QuotaUpdate.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaUpdate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaIsEnabled", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaIsEnabled), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaUpdate", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaUpdate), varargs...)
 }
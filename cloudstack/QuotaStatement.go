@@ -0,0 +1,86 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"fmt"
+	"time"
+)
+
+// quotaDateLayout is the layout of the dates accepted by the quota API commands
+const quotaDateLayout = "2006-01-02"
+
+// AccountQuotaStatement is the quota statement of a single account for a date range,
+// combining the quota usage with the balance and the credits added in that range
+type AccountQuotaStatement struct {
+	Account      string
+	Accountid    string
+	Domainid     string
+	Startdate    time.Time
+	Enddate      time.Time
+	Currency     string
+	StartBalance float64
+	EndBalance   float64
+	TotalQuota   float64
+	Usage        []QuotaStatementResponseQuotausage
+	Credits      []QuotaBalanceResponseCredits
+}
+
+// AccountQuotaStatement returns the quota statement of an account for the days from the
+// start date up to and including the end date. Only the dates of start and end are used.
+func (cs *CloudStackClient) AccountQuotaStatement(account, domainid string, start, end time.Time, opts ...CallOption) (*AccountQuotaStatement, error) {
+	if end.Before(start) {
+		return nil, fmt.Errorf("The end date %s is before the start date %s", end.Format(quotaDateLayout), start.Format(quotaDateLayout))
+	}
+	startdate, enddate := start.Format(quotaDateLayout), end.Format(quotaDateLayout)
+
+	sp := cs.Quota.NewQuotaStatementParams(account, domainid, enddate, startdate)
+	statement, err := cs.Quota.QuotaStatement(sp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	bp := cs.Quota.NewQuotaBalanceParams(account, domainid)
+	bp.SetStartdate(startdate)
+	bp.SetEnddate(enddate)
+	balance, err := cs.Quota.QuotaBalance(bp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := statement.Currency
+	if currency == "" {
+		currency = balance.Currency
+	}
+
+	return &AccountQuotaStatement{
+		Account:      account,
+		Accountid:    statement.Accountid,
+		Domainid:     domainid,
+		Startdate:    start,
+		Enddate:      end,
+		Currency:     currency,
+		StartBalance: balance.Startquota,
+		EndBalance:   balance.Endquota,
+		TotalQuota:   statement.Totalquota,
+		Usage:        statement.Quotausage,
+		Credits:      balance.Credits,
+	}, nil
+}
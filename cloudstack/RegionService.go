@@ -21,120 +21,215 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type RegionServiceIface interface {
-	AddRegion(p *AddRegionParams) (*AddRegionResponse, error)
+	AddRegion(p *AddRegionParams, opts ...CallOption) (*AddRegionResponse, error)
 	NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams
-	ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error)
+	ListRegions(p *ListRegionsParams, opts ...CallOption) (*ListRegionsResponse, error)
 	NewListRegionsParams() *ListRegionsParams
-	RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	RemoveRegion(p *RemoveRegionParams, opts ...CallOption) (*RemoveRegionResponse, error)
 	NewRemoveRegionParams(id int) *RemoveRegionParams
-	UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error)
+	UpdateRegion(p *UpdateRegionParams, opts ...CallOption) (*UpdateRegionResponse, error)
 	NewUpdateRegionParams(id int) *UpdateRegionParams
 }
 
 type AddRegionParams struct {
-	p map[string]interface{}
+	endpoint optString
+	id       optInt
+	name     optString
 }
 
-func (p *AddRegionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddRegionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["endpoint"]; found {
-		u.Set("endpoint", v.(string))
+	if p.endpoint.ok {
+		u.Set("endpoint", p.endpoint.v)
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.Itoa(p.id.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
 	return u
 }
 
-func (p *AddRegionParams) SetEndpoint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddRegionParams parses url.Values, for example taken from a raw API request,
+// into a new AddRegionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddRegionParams(u url.Values) (*AddRegionParams, error) {
+	p := &AddRegionParams{}
+	if err := checkParamNames("addRegion", u, "endpoint", "id", "name"); err != nil {
+		return nil, err
+	}
+	if _, found := u["endpoint"]; found {
+		p.SetEndpoint(u.Get("endpoint"))
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.Atoi(u.Get("id"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
 	}
-	p.p["endpoint"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	return p, nil
+}
+
+// SetEndpoint sets the endpoint param. This param is required.
+func (p *AddRegionParams) SetEndpoint(v string) {
+	p.endpoint = optString{v: v, ok: true}
 }
 
+// ResetEndpoint unsets the endpoint param
 func (p *AddRegionParams) ResetEndpoint() {
-	if p.p != nil && p.p["endpoint"] != nil {
-		delete(p.p, "endpoint")
-	}
+	p.endpoint = optString{}
 }
 
+// GetEndpoint returns the endpoint param and if it is set
 func (p *AddRegionParams) GetEndpoint() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endpoint"].(string)
-	return value, ok
+	return p.endpoint.v, p.endpoint.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *AddRegionParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optInt{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *AddRegionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt{}
 }
 
+// GetId returns the id param and if it is set
 func (p *AddRegionParams) GetId() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *AddRegionParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *AddRegionParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *AddRegionParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.name.v, p.name.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddRegionParams) Clone() *AddRegionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddRegionParams) Equal(o *AddRegionParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.endpoint == o.endpoint &&
+		p.id == o.id &&
+		p.name == o.name
+}
+
+// serializedAddRegionParams is used to (un)marshal AddRegionParams using the API param names
+type serializedAddRegionParams struct {
+	Endpoint *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Id       *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (p *AddRegionParams) toSerialized() *serializedAddRegionParams {
+	s := &serializedAddRegionParams{}
+	if p.endpoint.ok {
+		s.Endpoint = &p.endpoint.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	return s
+}
+
+func (p *AddRegionParams) fromSerialized(s *serializedAddRegionParams) {
+	*p = AddRegionParams{}
+	if s.Endpoint != nil {
+		p.SetEndpoint(*s.Endpoint)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddRegionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddRegionParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddRegionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddRegionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddRegionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddRegionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddRegionParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams {
 	p := &AddRegionParams{}
-	p.p = make(map[string]interface{})
-	p.p["endpoint"] = endpoint
-	p.p["id"] = id
-	p.p["name"] = name
+	p.SetEndpoint(endpoint)
+	p.SetId(id)
+	p.SetName(name)
 	return p
 }
 
-// Adds a Region
-func (s *RegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
-	resp, err := s.cs.newRequest("addRegion", p.toURLValues())
+// Adds a Region.
+//
+// Required params: endpoint, id, name.
+func (s *RegionService) AddRegion(p *AddRegionParams, opts ...CallOption) (*AddRegionResponse, error) {
+	resp, err := s.cs.newRequest("addRegion", s.cs.encodeParams("addRegion", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -158,151 +253,261 @@ type AddRegionResponse struct {
 }
 
 type ListRegionsParams struct {
-	p map[string]interface{}
+	id       optInt
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListRegionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListRegionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.Itoa(p.id.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListRegionsParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListRegionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListRegionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListRegionsParams(u url.Values) (*ListRegionsParams, error) {
+	p := &ListRegionsParams{}
+	if err := checkParamNames("listRegions", u, "id", "keyword", "name", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.Atoi(u.Get("id"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListRegionsParams) SetId(v int) {
+	p.id = optInt{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListRegionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListRegionsParams) GetId() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListRegionsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListRegionsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListRegionsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListRegionsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListRegionsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListRegionsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListRegionsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListRegionsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListRegionsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListRegionsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListRegionsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListRegionsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListRegionsParams) Clone() *ListRegionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListRegionsParams) Equal(o *ListRegionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListRegionsParams is used to (un)marshal ListRegionsParams using the API param names
+type serializedListRegionsParams struct {
+	Id       *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListRegionsParams) toSerialized() *serializedListRegionsParams {
+	s := &serializedListRegionsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return s
+}
+
+func (p *ListRegionsParams) fromSerialized(s *serializedListRegionsParams) {
+	*p = ListRegionsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListRegionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListRegionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListRegionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListRegionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListRegionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListRegionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListRegionsParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
 	p := &ListRegionsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists Regions
-func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
-	resp, err := s.cs.newRequest("listRegions", p.toURLValues())
+// Lists Regions.
+func (s *RegionService) ListRegions(p *ListRegionsParams, opts ...CallOption) (*ListRegionsResponse, error) {
+	resp, err := s.cs.newRequest("listRegions", s.cs.encodeParams("listRegions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -331,54 +536,136 @@ type Region struct {
 }
 
 type RemoveRegionParams struct {
-	p map[string]interface{}
+	id optInt
 }
 
-func (p *RemoveRegionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveRegionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.Itoa(p.id.v))
 	}
 	return u
 }
 
-func (p *RemoveRegionParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveRegionParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveRegionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveRegionParams(u url.Values) (*RemoveRegionParams, error) {
+	p := &RemoveRegionParams{}
+	if err := checkParamNames("removeRegion", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.Atoi(u.Get("id"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RemoveRegionParams) SetId(v int) {
+	p.id = optInt{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RemoveRegionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RemoveRegionParams) GetId() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveRegionParams) Clone() *RemoveRegionParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(int)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveRegionParams) Equal(o *RemoveRegionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRemoveRegionParams is used to (un)marshal RemoveRegionParams using the API param names
+type serializedRemoveRegionParams struct {
+	Id *int `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RemoveRegionParams) toSerialized() *serializedRemoveRegionParams {
+	s := &serializedRemoveRegionParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RemoveRegionParams) fromSerialized(s *serializedRemoveRegionParams) {
+	*p = RemoveRegionParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveRegionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveRegionParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveRegionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveRegionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveRegionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveRegionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveRegionParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
 	p := &RemoveRegionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Removes specified region
-func (s *RegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
-	resp, err := s.cs.newRequest("removeRegion", p.toURLValues())
+// Removes specified region.
+//
+// Required params: id.
+func (s *RegionService) RemoveRegion(p *RemoveRegionParams, opts ...CallOption) (*RemoveRegionResponse, error) {
+	resp, err := s.cs.newRequest("removeRegion", s.cs.encodeParams("removeRegion", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -426,102 +713,196 @@ func (r *RemoveRegionResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateRegionParams struct {
-	p map[string]interface{}
+	endpoint optString
+	id       optInt
+	name     optString
 }
 
-func (p *UpdateRegionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateRegionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["endpoint"]; found {
-		u.Set("endpoint", v.(string))
+	if p.endpoint.ok {
+		u.Set("endpoint", p.endpoint.v)
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.Itoa(p.id.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
 	return u
 }
 
-func (p *UpdateRegionParams) SetEndpoint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateRegionParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateRegionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateRegionParams(u url.Values) (*UpdateRegionParams, error) {
+	p := &UpdateRegionParams{}
+	if err := checkParamNames("updateRegion", u, "endpoint", "id", "name"); err != nil {
+		return nil, err
+	}
+	if _, found := u["endpoint"]; found {
+		p.SetEndpoint(u.Get("endpoint"))
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.Atoi(u.Get("id"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["endpoint"] = v
+	return p, nil
+}
+
+// SetEndpoint sets the endpoint param.
+func (p *UpdateRegionParams) SetEndpoint(v string) {
+	p.endpoint = optString{v: v, ok: true}
 }
 
+// ResetEndpoint unsets the endpoint param
 func (p *UpdateRegionParams) ResetEndpoint() {
-	if p.p != nil && p.p["endpoint"] != nil {
-		delete(p.p, "endpoint")
-	}
+	p.endpoint = optString{}
 }
 
+// GetEndpoint returns the endpoint param and if it is set
 func (p *UpdateRegionParams) GetEndpoint() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endpoint"].(string)
-	return value, ok
+	return p.endpoint.v, p.endpoint.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateRegionParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optInt{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateRegionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateRegionParams) GetId() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateRegionParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateRegionParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateRegionParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.name.v, p.name.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateRegionParams) Clone() *UpdateRegionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateRegionParams) Equal(o *UpdateRegionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.endpoint == o.endpoint &&
+		p.id == o.id &&
+		p.name == o.name
+}
+
+// serializedUpdateRegionParams is used to (un)marshal UpdateRegionParams using the API param names
+type serializedUpdateRegionParams struct {
+	Endpoint *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Id       *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+func (p *UpdateRegionParams) toSerialized() *serializedUpdateRegionParams {
+	s := &serializedUpdateRegionParams{}
+	if p.endpoint.ok {
+		s.Endpoint = &p.endpoint.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	return s
+}
+
+func (p *UpdateRegionParams) fromSerialized(s *serializedUpdateRegionParams) {
+	*p = UpdateRegionParams{}
+	if s.Endpoint != nil {
+		p.SetEndpoint(*s.Endpoint)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateRegionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateRegionParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateRegionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateRegionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateRegionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateRegionParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateRegionParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
 	p := &UpdateRegionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a region
-func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
-	resp, err := s.cs.newRequest("updateRegion", p.toURLValues())
+// Updates a region.
+//
+// Required params: id.
+func (s *RegionService) UpdateRegion(p *UpdateRegionParams, opts ...CallOption) (*UpdateRegionResponse, error) {
+	resp, err := s.cs.newRequest("updateRegion", s.cs.encodeParams("updateRegion", p), opts...)
 	if err != nil {
 		return nil, err
 	}
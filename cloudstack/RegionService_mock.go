@@ -53,33 +53,43 @@ func (m *MockRegionServiceIface) EXPECT() *MockRegionServiceIfaceMockRecorder {
 }
 
 // AddRegion mocks base method.
-func (m *MockRegionServiceIface) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
+func (m *MockRegionServiceIface) AddRegion(p *AddRegionParams, opts ...CallOption) (*AddRegionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddRegion", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddRegion", varargs...)
 	ret0, _ := ret[0].(*AddRegionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddRegion indicates an expected call of AddRegion.
-func (mr *MockRegionServiceIfaceMockRecorder) AddRegion(p interface{}) *gomock.Call {
+func (mr *MockRegionServiceIfaceMockRecorder) AddRegion(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).AddRegion), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).AddRegion), varargs...)
 }
 
 // ListRegions mocks base method.
-func (m *MockRegionServiceIface) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
+func (m *MockRegionServiceIface) ListRegions(p *ListRegionsParams, opts ...CallOption) (*ListRegionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRegions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegions", varargs...)
 	ret0, _ := ret[0].(*ListRegionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRegions indicates an expected call of ListRegions.
-func (mr *MockRegionServiceIfaceMockRecorder) ListRegions(p interface{}) *gomock.Call {
+func (mr *MockRegionServiceIfaceMockRecorder) ListRegions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegions), varargs...)
 }
 
 // NewAddRegionParams mocks base method.
@@ -139,31 +149,41 @@ func (mr *MockRegionServiceIfaceMockRecorder) NewUpdateRegionParams(id interface
 }
 
 // RemoveRegion mocks base method.
-func (m *MockRegionServiceIface) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
+func (m *MockRegionServiceIface) RemoveRegion(p *RemoveRegionParams, opts ...CallOption) (*RemoveRegionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveRegion", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveRegion", varargs...)
 	ret0, _ := ret[0].(*RemoveRegionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveRegion indicates an expected call of RemoveRegion.
-func (mr *MockRegionServiceIfaceMockRecorder) RemoveRegion(p interface{}) *gomock.Call {
+func (mr *MockRegionServiceIfaceMockRecorder) RemoveRegion(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).RemoveRegion), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).RemoveRegion), varargs...)
 }
 
 // UpdateRegion mocks base method.
-func (m *MockRegionServiceIface) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+func (m *MockRegionServiceIface) UpdateRegion(p *UpdateRegionParams, opts ...CallOption) (*UpdateRegionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRegion", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRegion", varargs...)
 	ret0, _ := ret[0].(*UpdateRegionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateRegion indicates an expected call of UpdateRegion.
-func (mr *MockRegionServiceIfaceMockRecorder) UpdateRegion(p interface{}) *gomock.Call {
+func (mr *MockRegionServiceIfaceMockRecorder) UpdateRegion(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).UpdateRegion), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).UpdateRegion), varargs...)
 }
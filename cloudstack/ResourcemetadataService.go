@@ -27,143 +27,248 @@ import (
 )
 
 type ResourcemetadataServiceIface interface {
-	AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetail(p *AddResourceDetailParams, opts ...CallOption) (*AddResourceDetailResponse, error)
 	NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams
-	GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error)
+	GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams, opts ...CallOption) (*GetVolumeSnapshotDetailsResponse, error)
 	NewGetVolumeSnapshotDetailsParams(snapshotid string) *GetVolumeSnapshotDetailsParams
-	ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	ListResourceDetails(p *ListResourceDetailsParams, opts ...CallOption) (*ListResourceDetailsResponse, error)
 	NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams
-	RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetail(p *RemoveResourceDetailParams, opts ...CallOption) (*RemoveResourceDetailResponse, error)
 	NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams
 }
 
 type AddResourceDetailParams struct {
-	p map[string]interface{}
+	details      optStringMap
+	fordisplay   optBool
+	resourceid   optString
+	resourcetype optString
 }
 
-func (p *AddResourceDetailParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddResourceDetailParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if p.resourceid.ok {
+		u.Set("resourceid", p.resourceid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if p.resourcetype.ok {
+		u.Set("resourcetype", p.resourcetype.v)
 	}
 	return u
 }
 
-func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddResourceDetailParams parses url.Values, for example taken from a raw API request,
+// into a new AddResourceDetailParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddResourceDetailParams(u url.Values) (*AddResourceDetailParams, error) {
+	p := &AddResourceDetailParams{}
+	if err := checkParamNames("addResourceDetail", u, "details[]", "fordisplay", "resourceid", "resourcetype"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
 	}
-	p.p["details"] = v
+	if _, found := u["resourceid"]; found {
+		p.SetResourceid(u.Get("resourceid"))
+	}
+	if _, found := u["resourcetype"]; found {
+		p.SetResourcetype(u.Get("resourcetype"))
+	}
+	return p, nil
+}
+
+// SetDetails sets the details param. This param is required.
+func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *AddResourceDetailParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *AddResourceDetailParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *AddResourceDetailParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *AddResourceDetailParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *AddResourceDetailParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetResourceid sets the resourceid param. This param is required.
 func (p *AddResourceDetailParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+	p.resourceid = optString{v: v, ok: true}
 }
 
+// ResetResourceid unsets the resourceid param
 func (p *AddResourceDetailParams) ResetResourceid() {
-	if p.p != nil && p.p["resourceid"] != nil {
-		delete(p.p, "resourceid")
-	}
+	p.resourceid = optString{}
 }
 
+// GetResourceid returns the resourceid param and if it is set
 func (p *AddResourceDetailParams) GetResourceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourceid"].(string)
-	return value, ok
+	return p.resourceid.v, p.resourceid.ok
 }
 
+// SetResourcetype sets the resourcetype param. This param is required.
 func (p *AddResourceDetailParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optString{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *AddResourceDetailParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optString{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *AddResourceDetailParams) GetResourcetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.resourcetype.v, p.resourcetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddResourceDetailParams) Clone() *AddResourceDetailParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddResourceDetailParams) Equal(o *AddResourceDetailParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.fordisplay == o.fordisplay &&
+		p.resourceid == o.resourceid &&
+		p.resourcetype == o.resourcetype
+}
+
+// serializedAddResourceDetailParams is used to (un)marshal AddResourceDetailParams using the API param names
+type serializedAddResourceDetailParams struct {
+	Details      *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Fordisplay   *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Resourceid   *string            `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *string            `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+func (p *AddResourceDetailParams) toSerialized() *serializedAddResourceDetailParams {
+	s := &serializedAddResourceDetailParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.resourceid.ok {
+		s.Resourceid = &p.resourceid.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	return s
+}
+
+func (p *AddResourceDetailParams) fromSerialized(s *serializedAddResourceDetailParams) {
+	*p = AddResourceDetailParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Resourceid != nil {
+		p.SetResourceid(*s.Resourceid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddResourceDetailParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddResourceDetailParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddResourceDetailParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["resourcetype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddResourceDetailParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddResourceDetailParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddResourceDetailParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddResourceDetailParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams {
 	p := &AddResourceDetailParams{}
-	p.p = make(map[string]interface{})
-	p.p["details"] = details
-	p.p["resourceid"] = resourceid
-	p.p["resourcetype"] = resourcetype
+	p.SetDetails(details)
+	p.SetResourceid(resourceid)
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
 // Adds detail for the Resource.
-func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("addResourceDetail", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: details, resourceid,
+// resourcetype.
+func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams, opts ...CallOption) (*AddResourceDetailResponse, error) {
+	resp, err := s.cs.newRequest("addResourceDetail", s.cs.encodeParams("addResourceDetail", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -174,8 +279,8 @@ func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -199,53 +304,132 @@ type AddResourceDetailResponse struct {
 }
 
 type GetVolumeSnapshotDetailsParams struct {
-	p map[string]interface{}
+	snapshotid optString
 }
 
-func (p *GetVolumeSnapshotDetailsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetVolumeSnapshotDetailsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["snapshotid"]; found {
-		u.Set("snapshotid", v.(string))
+	if p.snapshotid.ok {
+		u.Set("snapshotid", p.snapshotid.v)
 	}
 	return u
 }
 
-func (p *GetVolumeSnapshotDetailsParams) SetSnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetVolumeSnapshotDetailsParams parses url.Values, for example taken from a raw API request,
+// into a new GetVolumeSnapshotDetailsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetVolumeSnapshotDetailsParams(u url.Values) (*GetVolumeSnapshotDetailsParams, error) {
+	p := &GetVolumeSnapshotDetailsParams{}
+	if err := checkParamNames("getVolumeSnapshotDetails", u, "snapshotid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["snapshotid"]; found {
+		p.SetSnapshotid(u.Get("snapshotid"))
 	}
-	p.p["snapshotid"] = v
+	return p, nil
 }
 
+// SetSnapshotid sets the snapshotid param. This param is required.
+func (p *GetVolumeSnapshotDetailsParams) SetSnapshotid(v string) {
+	p.snapshotid = optString{v: v, ok: true}
+}
+
+// ResetSnapshotid unsets the snapshotid param
 func (p *GetVolumeSnapshotDetailsParams) ResetSnapshotid() {
-	if p.p != nil && p.p["snapshotid"] != nil {
-		delete(p.p, "snapshotid")
-	}
+	p.snapshotid = optString{}
 }
 
+// GetSnapshotid returns the snapshotid param and if it is set
 func (p *GetVolumeSnapshotDetailsParams) GetSnapshotid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.snapshotid.v, p.snapshotid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetVolumeSnapshotDetailsParams) Clone() *GetVolumeSnapshotDetailsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetVolumeSnapshotDetailsParams) Equal(o *GetVolumeSnapshotDetailsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.snapshotid == o.snapshotid
+}
+
+// serializedGetVolumeSnapshotDetailsParams is used to (un)marshal GetVolumeSnapshotDetailsParams using the API param names
+type serializedGetVolumeSnapshotDetailsParams struct {
+	Snapshotid *string `json:"snapshotid,omitempty" yaml:"snapshotid,omitempty"`
+}
+
+func (p *GetVolumeSnapshotDetailsParams) toSerialized() *serializedGetVolumeSnapshotDetailsParams {
+	s := &serializedGetVolumeSnapshotDetailsParams{}
+	if p.snapshotid.ok {
+		s.Snapshotid = &p.snapshotid.v
+	}
+	return s
+}
+
+func (p *GetVolumeSnapshotDetailsParams) fromSerialized(s *serializedGetVolumeSnapshotDetailsParams) {
+	*p = GetVolumeSnapshotDetailsParams{}
+	if s.Snapshotid != nil {
+		p.SetSnapshotid(*s.Snapshotid)
 	}
-	value, ok := p.p["snapshotid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetVolumeSnapshotDetailsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetVolumeSnapshotDetailsParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetVolumeSnapshotDetailsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetVolumeSnapshotDetailsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetVolumeSnapshotDetailsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetVolumeSnapshotDetailsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetVolumeSnapshotDetailsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewGetVolumeSnapshotDetailsParams(snapshotid string) *GetVolumeSnapshotDetailsParams {
 	p := &GetVolumeSnapshotDetailsParams{}
-	p.p = make(map[string]interface{})
-	p.p["snapshotid"] = snapshotid
+	p.SetSnapshotid(snapshotid)
 	return p
 }
 
-// Get Volume Snapshot Details
-func (s *ResourcemetadataService) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeSnapshotDetails", p.toURLValues())
+// Get Volume Snapshot Details.
+//
+// Required params: snapshotid.
+func (s *ResourcemetadataService) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams, opts ...CallOption) (*GetVolumeSnapshotDetailsResponse, error) {
+	resp, err := s.cs.newRequest("getVolumeSnapshotDetails", s.cs.encodeParams("getVolumeSnapshotDetails", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -265,346 +449,512 @@ type GetVolumeSnapshotDetailsResponse struct {
 }
 
 type ListResourceDetailsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListResourceDetailsParams) toURLValues() url.Values {
+	account      optString
+	domainid     optString
+	fordisplay   optBool
+	isrecursive  optBool
+	key          optString
+	keyword      optString
+	listall      optBool
+	page         optInt
+	pagesize     optInt
+	projectid    optString
+	resourceid   optString
+	resourcetype optString
+	value        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListResourceDetailsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
+	if p.key.ok {
+		u.Set("key", p.key.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if p.resourceid.ok {
+		u.Set("resourceid", p.resourceid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if p.resourcetype.ok {
+		u.Set("resourcetype", p.resourcetype.v)
 	}
-	if v, found := p.p["value"]; found {
-		u.Set("value", v.(string))
+	if p.value.ok {
+		u.Set("value", p.value.v)
 	}
 	return u
 }
 
-func (p *ListResourceDetailsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListResourceDetailsParams parses url.Values, for example taken from a raw API request,
+// into a new ListResourceDetailsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListResourceDetailsParams(u url.Values) (*ListResourceDetailsParams, error) {
+	p := &ListResourceDetailsParams{}
+	if err := checkParamNames("listResourceDetails", u, "account", "domainid", "fordisplay", "isrecursive", "key", "keyword", "listall", "page", "pagesize", "projectid", "resourceid", "resourcetype", "value"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["key"]; found {
+		p.SetKey(u.Get("key"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["resourceid"]; found {
+		p.SetResourceid(u.Get("resourceid"))
+	}
+	if _, found := u["resourcetype"]; found {
+		p.SetResourcetype(u.Get("resourcetype"))
+	}
+	if _, found := u["value"]; found {
+		p.SetValue(u.Get("value"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListResourceDetailsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListResourceDetailsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListResourceDetailsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListResourceDetailsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListResourceDetailsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListResourceDetailsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListResourceDetailsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListResourceDetailsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListResourceDetailsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListResourceDetailsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListResourceDetailsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListResourceDetailsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKey sets the key param.
 func (p *ListResourceDetailsParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["key"] = v
+	p.key = optString{v: v, ok: true}
 }
 
+// ResetKey unsets the key param
 func (p *ListResourceDetailsParams) ResetKey() {
-	if p.p != nil && p.p["key"] != nil {
-		delete(p.p, "key")
-	}
+	p.key = optString{}
 }
 
+// GetKey returns the key param and if it is set
 func (p *ListResourceDetailsParams) GetKey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["key"].(string)
-	return value, ok
+	return p.key.v, p.key.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListResourceDetailsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListResourceDetailsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListResourceDetailsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListResourceDetailsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListResourceDetailsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListResourceDetailsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListResourceDetailsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListResourceDetailsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListResourceDetailsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListResourceDetailsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListResourceDetailsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListResourceDetailsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListResourceDetailsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListResourceDetailsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListResourceDetailsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetResourceid sets the resourceid param.
 func (p *ListResourceDetailsParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+	p.resourceid = optString{v: v, ok: true}
 }
 
+// ResetResourceid unsets the resourceid param
 func (p *ListResourceDetailsParams) ResetResourceid() {
-	if p.p != nil && p.p["resourceid"] != nil {
-		delete(p.p, "resourceid")
-	}
+	p.resourceid = optString{}
 }
 
+// GetResourceid returns the resourceid param and if it is set
 func (p *ListResourceDetailsParams) GetResourceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourceid"].(string)
-	return value, ok
+	return p.resourceid.v, p.resourceid.ok
 }
 
+// SetResourcetype sets the resourcetype param. This param is required.
 func (p *ListResourceDetailsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optString{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *ListResourceDetailsParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optString{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *ListResourceDetailsParams) GetResourcetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcetype"].(string)
-	return value, ok
+	return p.resourcetype.v, p.resourcetype.ok
 }
 
+// SetValue sets the value param.
 func (p *ListResourceDetailsParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["value"] = v
+	p.value = optString{v: v, ok: true}
 }
 
+// ResetValue unsets the value param
 func (p *ListResourceDetailsParams) ResetValue() {
-	if p.p != nil && p.p["value"] != nil {
-		delete(p.p, "value")
-	}
+	p.value = optString{}
 }
 
+// GetValue returns the value param and if it is set
 func (p *ListResourceDetailsParams) GetValue() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.value.v, p.value.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListResourceDetailsParams) Clone() *ListResourceDetailsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListResourceDetailsParams) Equal(o *ListResourceDetailsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.isrecursive == o.isrecursive &&
+		p.key == o.key &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.resourceid == o.resourceid &&
+		p.resourcetype == o.resourcetype &&
+		p.value == o.value
+}
+
+// serializedListResourceDetailsParams is used to (un)marshal ListResourceDetailsParams using the API param names
+type serializedListResourceDetailsParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay   *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Isrecursive  *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Key          *string `json:"key,omitempty" yaml:"key,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourceid   *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *string `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Value        *string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+func (p *ListResourceDetailsParams) toSerialized() *serializedListResourceDetailsParams {
+	s := &serializedListResourceDetailsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.key.ok {
+		s.Key = &p.key.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.resourceid.ok {
+		s.Resourceid = &p.resourceid.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	if p.value.ok {
+		s.Value = &p.value.v
+	}
+	return s
+}
+
+func (p *ListResourceDetailsParams) fromSerialized(s *serializedListResourceDetailsParams) {
+	*p = ListResourceDetailsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Key != nil {
+		p.SetKey(*s.Key)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
 	}
-	value, ok := p.p["value"].(string)
-	return value, ok
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Resourceid != nil {
+		p.SetResourceid(*s.Resourceid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+	if s.Value != nil {
+		p.SetValue(*s.Value)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListResourceDetailsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListResourceDetailsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListResourceDetailsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListResourceDetailsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListResourceDetailsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListResourceDetailsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListResourceDetailsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams {
 	p := &ListResourceDetailsParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourcetype"] = resourcetype
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
-// List resource detail(s)
-func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceDetails", p.toURLValues())
+// List resource detail(s).
+//
+// Required params: resourcetype.
+func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams, opts ...CallOption) (*ListResourceDetailsResponse, error) {
+	resp, err := s.cs.newRequest("listResourceDetails", s.cs.encodeParams("listResourceDetails", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -638,102 +988,195 @@ type ResourceDetail struct {
 }
 
 type RemoveResourceDetailParams struct {
-	p map[string]interface{}
+	key          optString
+	resourceid   optString
+	resourcetype optString
 }
 
-func (p *RemoveResourceDetailParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveResourceDetailParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
+	if p.key.ok {
+		u.Set("key", p.key.v)
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if p.resourceid.ok {
+		u.Set("resourceid", p.resourceid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if p.resourcetype.ok {
+		u.Set("resourcetype", p.resourcetype.v)
 	}
 	return u
 }
 
-func (p *RemoveResourceDetailParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveResourceDetailParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveResourceDetailParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveResourceDetailParams(u url.Values) (*RemoveResourceDetailParams, error) {
+	p := &RemoveResourceDetailParams{}
+	if err := checkParamNames("removeResourceDetail", u, "key", "resourceid", "resourcetype"); err != nil {
+		return nil, err
 	}
-	p.p["key"] = v
+	if _, found := u["key"]; found {
+		p.SetKey(u.Get("key"))
+	}
+	if _, found := u["resourceid"]; found {
+		p.SetResourceid(u.Get("resourceid"))
+	}
+	if _, found := u["resourcetype"]; found {
+		p.SetResourcetype(u.Get("resourcetype"))
+	}
+	return p, nil
 }
 
+// SetKey sets the key param.
+func (p *RemoveResourceDetailParams) SetKey(v string) {
+	p.key = optString{v: v, ok: true}
+}
+
+// ResetKey unsets the key param
 func (p *RemoveResourceDetailParams) ResetKey() {
-	if p.p != nil && p.p["key"] != nil {
-		delete(p.p, "key")
-	}
+	p.key = optString{}
 }
 
+// GetKey returns the key param and if it is set
 func (p *RemoveResourceDetailParams) GetKey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["key"].(string)
-	return value, ok
+	return p.key.v, p.key.ok
 }
 
+// SetResourceid sets the resourceid param. This param is required.
 func (p *RemoveResourceDetailParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+	p.resourceid = optString{v: v, ok: true}
 }
 
+// ResetResourceid unsets the resourceid param
 func (p *RemoveResourceDetailParams) ResetResourceid() {
-	if p.p != nil && p.p["resourceid"] != nil {
-		delete(p.p, "resourceid")
-	}
+	p.resourceid = optString{}
 }
 
+// GetResourceid returns the resourceid param and if it is set
 func (p *RemoveResourceDetailParams) GetResourceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourceid"].(string)
-	return value, ok
+	return p.resourceid.v, p.resourceid.ok
 }
 
+// SetResourcetype sets the resourcetype param. This param is required.
 func (p *RemoveResourceDetailParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optString{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *RemoveResourceDetailParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optString{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *RemoveResourceDetailParams) GetResourcetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.resourcetype.v, p.resourcetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveResourceDetailParams) Clone() *RemoveResourceDetailParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveResourceDetailParams) Equal(o *RemoveResourceDetailParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.key == o.key &&
+		p.resourceid == o.resourceid &&
+		p.resourcetype == o.resourcetype
+}
+
+// serializedRemoveResourceDetailParams is used to (un)marshal RemoveResourceDetailParams using the API param names
+type serializedRemoveResourceDetailParams struct {
+	Key          *string `json:"key,omitempty" yaml:"key,omitempty"`
+	Resourceid   *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *string `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+func (p *RemoveResourceDetailParams) toSerialized() *serializedRemoveResourceDetailParams {
+	s := &serializedRemoveResourceDetailParams{}
+	if p.key.ok {
+		s.Key = &p.key.v
+	}
+	if p.resourceid.ok {
+		s.Resourceid = &p.resourceid.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	return s
+}
+
+func (p *RemoveResourceDetailParams) fromSerialized(s *serializedRemoveResourceDetailParams) {
+	*p = RemoveResourceDetailParams{}
+	if s.Key != nil {
+		p.SetKey(*s.Key)
+	}
+	if s.Resourceid != nil {
+		p.SetResourceid(*s.Resourceid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
 	}
-	value, ok := p.p["resourcetype"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveResourceDetailParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveResourceDetailParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveResourceDetailParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveResourceDetailParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveResourceDetailParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveResourceDetailParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveResourceDetailParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams {
 	p := &RemoveResourceDetailParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceid"] = resourceid
-	p.p["resourcetype"] = resourcetype
+	p.SetResourceid(resourceid)
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
 // Removes detail for the Resource.
-func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("removeResourceDetail", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: resourceid,
+// resourcetype.
+func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams, opts ...CallOption) (*RemoveResourceDetailResponse, error) {
+	resp, err := s.cs.newRequest("removeResourceDetail", s.cs.encodeParams("removeResourceDetail", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -744,8 +1187,8 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,48 +53,63 @@ func (m *MockResourcemetadataServiceIface) EXPECT() *MockResourcemetadataService
 }
 
 // AddResourceDetail mocks base method.
-func (m *MockResourcemetadataServiceIface) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+func (m *MockResourcemetadataServiceIface) AddResourceDetail(p *AddResourceDetailParams, opts ...CallOption) (*AddResourceDetailResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddResourceDetail", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddResourceDetail", varargs...)
 	ret0, _ := ret[0].(*AddResourceDetailResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddResourceDetail indicates an expected call of AddResourceDetail.
-func (mr *MockResourcemetadataServiceIfaceMockRecorder) AddResourceDetail(p interface{}) *gomock.Call {
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) AddResourceDetail(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResourceDetail", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).AddResourceDetail), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResourceDetail", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).AddResourceDetail), varargs...)
 }
 
 // GetVolumeSnapshotDetails mocks base method.
-func (m *MockResourcemetadataServiceIface) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
+func (m *MockResourcemetadataServiceIface) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams, opts ...CallOption) (*GetVolumeSnapshotDetailsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVolumeSnapshotDetails", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumeSnapshotDetails", varargs...)
 	ret0, _ := ret[0].(*GetVolumeSnapshotDetailsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVolumeSnapshotDetails indicates an expected call of GetVolumeSnapshotDetails.
-func (mr *MockResourcemetadataServiceIfaceMockRecorder) GetVolumeSnapshotDetails(p interface{}) *gomock.Call {
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) GetVolumeSnapshotDetails(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshotDetails", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).GetVolumeSnapshotDetails), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshotDetails", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).GetVolumeSnapshotDetails), varargs...)
 }
 
 // ListResourceDetails mocks base method.
-func (m *MockResourcemetadataServiceIface) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+func (m *MockResourcemetadataServiceIface) ListResourceDetails(p *ListResourceDetailsParams, opts ...CallOption) (*ListResourceDetailsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListResourceDetails", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceDetails", varargs...)
 	ret0, _ := ret[0].(*ListResourceDetailsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListResourceDetails indicates an expected call of ListResourceDetails.
-func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetails(p interface{}) *gomock.Call {
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetails(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetails", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetails), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetails", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetails), varargs...)
 }
 
 // NewAddResourceDetailParams mocks base method.
@@ -154,16 +169,21 @@ func (mr *MockResourcemetadataServiceIfaceMockRecorder) NewRemoveResourceDetailP
 }
 
 // RemoveResourceDetail mocks base method.
-func (m *MockResourcemetadataServiceIface) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+func (m *MockResourcemetadataServiceIface) RemoveResourceDetail(p *RemoveResourceDetailParams, opts ...CallOption) (*RemoveResourceDetailResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveResourceDetail", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveResourceDetail", varargs...)
 	ret0, _ := ret[0].(*RemoveResourceDetailResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveResourceDetail indicates an expected call of RemoveResourceDetail.
-func (mr *MockResourcemetadataServiceIfaceMockRecorder) RemoveResourceDetail(p interface{}) *gomock.Call {
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) RemoveResourceDetail(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResourceDetail", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).RemoveResourceDetail), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResourceDetail", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).RemoveResourceDetail), varargs...)
 }
@@ -28,38 +28,41 @@ import (
 )
 
 type ResourcetagsServiceIface interface {
-	CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTags(p *CreateTagsParams, opts ...CallOption) (*CreateTagsResponse, error)
 	NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams
-	DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTags(p *DeleteTagsParams, opts ...CallOption) (*DeleteTagsResponse, error)
 	NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams
-	ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListStorageTags(p *ListStorageTagsParams, opts ...CallOption) (*ListStorageTagsResponse, error)
 	NewListStorageTagsParams() *ListStorageTagsParams
 	GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error)
-	ListTags(p *ListTagsParams) (*ListTagsResponse, error)
+	ListTags(p *ListTagsParams, opts ...CallOption) (*ListTagsResponse, error)
 	NewListTagsParams() *ListTagsParams
 }
 
 type CreateTagsParams struct {
-	p map[string]interface{}
+	customer     optString
+	resourceids  optStrings
+	resourcetype optString
+	tags         optStringMap
 }
 
-func (p *CreateTagsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customer"]; found {
-		u.Set("customer", v.(string))
+	if p.customer.ok {
+		u.Set("customer", p.customer.v)
 	}
-	if v, found := p.p["resourceids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("resourceids", vv)
+	if p.resourceids.ok {
+		u.Set("resourceids", strings.Join(p.resourceids.v, ","))
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if p.resourcetype.ok {
+		u.Set("resourcetype", p.resourcetype.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
@@ -68,104 +71,203 @@ func (p *CreateTagsParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *CreateTagsParams) SetCustomer(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateTagsParams parses url.Values, for example taken from a raw API request,
+// into a new CreateTagsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateTagsParams(u url.Values) (*CreateTagsParams, error) {
+	p := &CreateTagsParams{}
+	if err := checkParamNames("createTags", u, "customer", "resourceids", "resourcetype", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customer"]; found {
+		p.SetCustomer(u.Get("customer"))
+	}
+	if _, found := u["resourceids"]; found {
+		p.SetResourceids(strings.Split(u.Get("resourceids"), ","))
+	}
+	if _, found := u["resourcetype"]; found {
+		p.SetResourcetype(u.Get("resourcetype"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
 	}
-	p.p["customer"] = v
+	return p, nil
 }
 
+// SetCustomer sets the customer param.
+func (p *CreateTagsParams) SetCustomer(v string) {
+	p.customer = optString{v: v, ok: true}
+}
+
+// ResetCustomer unsets the customer param
 func (p *CreateTagsParams) ResetCustomer() {
-	if p.p != nil && p.p["customer"] != nil {
-		delete(p.p, "customer")
-	}
+	p.customer = optString{}
 }
 
+// GetCustomer returns the customer param and if it is set
 func (p *CreateTagsParams) GetCustomer() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customer"].(string)
-	return value, ok
+	return p.customer.v, p.customer.ok
 }
 
+// SetResourceids sets the resourceids param. This param is required.
 func (p *CreateTagsParams) SetResourceids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceids"] = v
+	p.resourceids = optStrings{v: v, ok: true}
 }
 
+// ResetResourceids unsets the resourceids param
 func (p *CreateTagsParams) ResetResourceids() {
-	if p.p != nil && p.p["resourceids"] != nil {
-		delete(p.p, "resourceids")
-	}
+	p.resourceids = optStrings{}
 }
 
+// GetResourceids returns the resourceids param and if it is set
 func (p *CreateTagsParams) GetResourceids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourceids"].([]string)
-	return value, ok
+	return p.resourceids.v, p.resourceids.ok
 }
 
+// SetResourcetype sets the resourcetype param. This param is required.
 func (p *CreateTagsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optString{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *CreateTagsParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optString{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *CreateTagsParams) GetResourcetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcetype"].(string)
-	return value, ok
+	return p.resourcetype.v, p.resourcetype.ok
 }
 
+// SetTags sets the tags param. This param is required.
 func (p *CreateTagsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreateTagsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreateTagsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateTagsParams) Clone() *CreateTagsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.resourceids = p.resourceids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateTagsParams) Equal(o *CreateTagsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customer == o.customer &&
+		p.resourceids.equal(o.resourceids) &&
+		p.resourcetype == o.resourcetype &&
+		p.tags.equal(o.tags)
+}
+
+// serializedCreateTagsParams is used to (un)marshal CreateTagsParams using the API param names
+type serializedCreateTagsParams struct {
+	Customer     *string            `json:"customer,omitempty" yaml:"customer,omitempty"`
+	Resourceids  *[]string          `json:"resourceids,omitempty" yaml:"resourceids,omitempty"`
+	Resourcetype *string            `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Tags         *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *CreateTagsParams) toSerialized() *serializedCreateTagsParams {
+	s := &serializedCreateTagsParams{}
+	if p.customer.ok {
+		s.Customer = &p.customer.v
+	}
+	if p.resourceids.ok {
+		s.Resourceids = &p.resourceids.v
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *CreateTagsParams) fromSerialized(s *serializedCreateTagsParams) {
+	*p = CreateTagsParams{}
+	if s.Customer != nil {
+		p.SetCustomer(*s.Customer)
+	}
+	if s.Resourceids != nil {
+		p.SetResourceids(*s.Resourceids)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateTagsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateTagsParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateTagsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateTagsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateTagsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateTagsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams {
 	p := &CreateTagsParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceids"] = resourceids
-	p.p["resourcetype"] = resourcetype
-	p.p["tags"] = tags
+	p.SetResourceids(resourceids)
+	p.SetResourcetype(resourcetype)
+	p.SetTags(tags)
 	return p
 }
 
-// Creates resource tag(s)
-func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
-	resp, err := s.cs.newRequest("createTags", p.toURLValues())
+// Creates resource tag(s).
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: resourceids,
+// resourcetype, tags.
+func (s *ResourcetagsService) CreateTags(p *CreateTagsParams, opts ...CallOption) (*CreateTagsResponse, error) {
+	resp, err := s.cs.newRequest("createTags", s.cs.encodeParams("createTags", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -176,8 +278,8 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -201,23 +303,25 @@ type CreateTagsResponse struct {
 }
 
 type DeleteTagsParams struct {
-	p map[string]interface{}
+	resourceids  optStrings
+	resourcetype optString
+	tags         optStringMap
 }
 
-func (p *DeleteTagsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["resourceids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("resourceids", vv)
+	if p.resourceids.ok {
+		u.Set("resourceids", strings.Join(p.resourceids.v, ","))
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if p.resourcetype.ok {
+		u.Set("resourcetype", p.resourcetype.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			if m[k] != "" {
@@ -228,82 +332,176 @@ func (p *DeleteTagsParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *DeleteTagsParams) SetResourceids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteTagsParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteTagsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteTagsParams(u url.Values) (*DeleteTagsParams, error) {
+	p := &DeleteTagsParams{}
+	if err := checkParamNames("deleteTags", u, "resourceids", "resourcetype", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["resourceids"]; found {
+		p.SetResourceids(strings.Split(u.Get("resourceids"), ","))
+	}
+	if _, found := u["resourcetype"]; found {
+		p.SetResourcetype(u.Get("resourcetype"))
 	}
-	p.p["resourceids"] = v
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", true); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
+}
+
+// SetResourceids sets the resourceids param. This param is required.
+func (p *DeleteTagsParams) SetResourceids(v []string) {
+	p.resourceids = optStrings{v: v, ok: true}
 }
 
+// ResetResourceids unsets the resourceids param
 func (p *DeleteTagsParams) ResetResourceids() {
-	if p.p != nil && p.p["resourceids"] != nil {
-		delete(p.p, "resourceids")
-	}
+	p.resourceids = optStrings{}
 }
 
+// GetResourceids returns the resourceids param and if it is set
 func (p *DeleteTagsParams) GetResourceids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourceids"].([]string)
-	return value, ok
+	return p.resourceids.v, p.resourceids.ok
 }
 
+// SetResourcetype sets the resourcetype param. This param is required.
 func (p *DeleteTagsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optString{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *DeleteTagsParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optString{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *DeleteTagsParams) GetResourcetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcetype"].(string)
-	return value, ok
+	return p.resourcetype.v, p.resourcetype.ok
 }
 
+// SetTags sets the tags param.
 func (p *DeleteTagsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *DeleteTagsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *DeleteTagsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteTagsParams) Clone() *DeleteTagsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.resourceids = p.resourceids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteTagsParams) Equal(o *DeleteTagsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.resourceids.equal(o.resourceids) &&
+		p.resourcetype == o.resourcetype &&
+		p.tags.equal(o.tags)
+}
+
+// serializedDeleteTagsParams is used to (un)marshal DeleteTagsParams using the API param names
+type serializedDeleteTagsParams struct {
+	Resourceids  *[]string          `json:"resourceids,omitempty" yaml:"resourceids,omitempty"`
+	Resourcetype *string            `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Tags         *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *DeleteTagsParams) toSerialized() *serializedDeleteTagsParams {
+	s := &serializedDeleteTagsParams{}
+	if p.resourceids.ok {
+		s.Resourceids = &p.resourceids.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return s
+}
+
+func (p *DeleteTagsParams) fromSerialized(s *serializedDeleteTagsParams) {
+	*p = DeleteTagsParams{}
+	if s.Resourceids != nil {
+		p.SetResourceids(*s.Resourceids)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteTagsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteTagsParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteTagsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteTagsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteTagsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteTagsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams {
 	p := &DeleteTagsParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceids"] = resourceids
-	p.p["resourcetype"] = resourcetype
+	p.SetResourceids(resourceids)
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
-// Deleting resource tag(s)
-func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
-	resp, err := s.cs.newRequest("deleteTags", p.toURLValues())
+// Deleting resource tag(s).
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: resourceids,
+// resourcetype.
+func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams, opts ...CallOption) (*DeleteTagsResponse, error) {
+	resp, err := s.cs.newRequest("deleteTags", s.cs.encodeParams("deleteTags", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -314,8 +512,8 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -339,105 +537,199 @@ type DeleteTagsResponse struct {
 }
 
 type ListStorageTagsParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListStorageTagsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListStorageTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListStorageTagsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListStorageTagsParams parses url.Values, for example taken from a raw API request,
+// into a new ListStorageTagsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListStorageTagsParams(u url.Values) (*ListStorageTagsParams, error) {
+	p := &ListStorageTagsParams{}
+	if err := checkParamNames("listStorageTags", u, "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListStorageTagsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListStorageTagsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListStorageTagsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListStorageTagsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListStorageTagsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListStorageTagsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListStorageTagsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListStorageTagsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListStorageTagsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListStorageTagsParams) Clone() *ListStorageTagsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListStorageTagsParams) Equal(o *ListStorageTagsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListStorageTagsParams is used to (un)marshal ListStorageTagsParams using the API param names
+type serializedListStorageTagsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListStorageTagsParams) toSerialized() *serializedListStorageTagsParams {
+	s := &serializedListStorageTagsParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return s
+}
+
+func (p *ListStorageTagsParams) fromSerialized(s *serializedListStorageTagsParams) {
+	*p = ListStorageTagsParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListStorageTagsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListStorageTagsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListStorageTagsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListStorageTagsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListStorageTagsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListStorageTagsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListStorageTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewListStorageTagsParams() *ListStorageTagsParams {
 	p := &ListStorageTagsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStorageTagsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -468,9 +760,9 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// Lists storage tags
-func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
-	resp, err := s.cs.newRequest("listStorageTags", p.toURLValues())
+// Lists storage tags.
+func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams, opts ...CallOption) (*ListStorageTagsResponse, error) {
+	resp, err := s.cs.newRequest("listStorageTags", s.cs.encodeParams("listStorageTags", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -497,344 +789,505 @@ type StorageTag struct {
 }
 
 type ListTagsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListTagsParams) toURLValues() url.Values {
+	account      optString
+	customer     optString
+	domainid     optString
+	isrecursive  optBool
+	key          optString
+	keyword      optString
+	listall      optBool
+	page         optInt
+	pagesize     optInt
+	projectid    optString
+	resourceid   optString
+	resourcetype optString
+	value        optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["customer"]; found {
-		u.Set("customer", v.(string))
+	if p.customer.ok {
+		u.Set("customer", p.customer.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
+	if p.key.ok {
+		u.Set("key", p.key.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if p.resourceid.ok {
+		u.Set("resourceid", p.resourceid.v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if p.resourcetype.ok {
+		u.Set("resourcetype", p.resourcetype.v)
 	}
-	if v, found := p.p["value"]; found {
-		u.Set("value", v.(string))
+	if p.value.ok {
+		u.Set("value", p.value.v)
 	}
 	return u
 }
 
-func (p *ListTagsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListTagsParams parses url.Values, for example taken from a raw API request,
+// into a new ListTagsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListTagsParams(u url.Values) (*ListTagsParams, error) {
+	p := &ListTagsParams{}
+	if err := checkParamNames("listTags", u, "account", "customer", "domainid", "isrecursive", "key", "keyword", "listall", "page", "pagesize", "projectid", "resourceid", "resourcetype", "value"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["customer"]; found {
+		p.SetCustomer(u.Get("customer"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["key"]; found {
+		p.SetKey(u.Get("key"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["resourceid"]; found {
+		p.SetResourceid(u.Get("resourceid"))
+	}
+	if _, found := u["resourcetype"]; found {
+		p.SetResourcetype(u.Get("resourcetype"))
 	}
-	p.p["account"] = v
+	if _, found := u["value"]; found {
+		p.SetValue(u.Get("value"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListTagsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListTagsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListTagsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCustomer sets the customer param.
 func (p *ListTagsParams) SetCustomer(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customer"] = v
+	p.customer = optString{v: v, ok: true}
 }
 
+// ResetCustomer unsets the customer param
 func (p *ListTagsParams) ResetCustomer() {
-	if p.p != nil && p.p["customer"] != nil {
-		delete(p.p, "customer")
-	}
+	p.customer = optString{}
 }
 
+// GetCustomer returns the customer param and if it is set
 func (p *ListTagsParams) GetCustomer() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customer"].(string)
-	return value, ok
+	return p.customer.v, p.customer.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListTagsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListTagsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListTagsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListTagsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListTagsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListTagsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKey sets the key param.
 func (p *ListTagsParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["key"] = v
+	p.key = optString{v: v, ok: true}
 }
 
+// ResetKey unsets the key param
 func (p *ListTagsParams) ResetKey() {
-	if p.p != nil && p.p["key"] != nil {
-		delete(p.p, "key")
-	}
+	p.key = optString{}
 }
 
+// GetKey returns the key param and if it is set
 func (p *ListTagsParams) GetKey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["key"].(string)
-	return value, ok
+	return p.key.v, p.key.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListTagsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListTagsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListTagsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListTagsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListTagsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListTagsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListTagsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListTagsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListTagsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListTagsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListTagsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListTagsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListTagsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListTagsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListTagsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetResourceid sets the resourceid param.
 func (p *ListTagsParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+	p.resourceid = optString{v: v, ok: true}
 }
 
+// ResetResourceid unsets the resourceid param
 func (p *ListTagsParams) ResetResourceid() {
-	if p.p != nil && p.p["resourceid"] != nil {
-		delete(p.p, "resourceid")
-	}
+	p.resourceid = optString{}
 }
 
+// GetResourceid returns the resourceid param and if it is set
 func (p *ListTagsParams) GetResourceid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourceid"].(string)
-	return value, ok
+	return p.resourceid.v, p.resourceid.ok
 }
 
+// SetResourcetype sets the resourcetype param.
 func (p *ListTagsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
+	p.resourcetype = optString{v: v, ok: true}
 }
 
+// ResetResourcetype unsets the resourcetype param
 func (p *ListTagsParams) ResetResourcetype() {
-	if p.p != nil && p.p["resourcetype"] != nil {
-		delete(p.p, "resourcetype")
-	}
+	p.resourcetype = optString{}
 }
 
+// GetResourcetype returns the resourcetype param and if it is set
 func (p *ListTagsParams) GetResourcetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["resourcetype"].(string)
-	return value, ok
+	return p.resourcetype.v, p.resourcetype.ok
 }
 
+// SetValue sets the value param.
 func (p *ListTagsParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["value"] = v
+	p.value = optString{v: v, ok: true}
 }
 
+// ResetValue unsets the value param
 func (p *ListTagsParams) ResetValue() {
-	if p.p != nil && p.p["value"] != nil {
-		delete(p.p, "value")
-	}
+	p.value = optString{}
 }
 
+// GetValue returns the value param and if it is set
 func (p *ListTagsParams) GetValue() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.value.v, p.value.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListTagsParams) Clone() *ListTagsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListTagsParams) Equal(o *ListTagsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.customer == o.customer &&
+		p.domainid == o.domainid &&
+		p.isrecursive == o.isrecursive &&
+		p.key == o.key &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.resourceid == o.resourceid &&
+		p.resourcetype == o.resourcetype &&
+		p.value == o.value
+}
+
+// serializedListTagsParams is used to (un)marshal ListTagsParams using the API param names
+type serializedListTagsParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Customer     *string `json:"customer,omitempty" yaml:"customer,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isrecursive  *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Key          *string `json:"key,omitempty" yaml:"key,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourceid   *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *string `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Value        *string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+func (p *ListTagsParams) toSerialized() *serializedListTagsParams {
+	s := &serializedListTagsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.customer.ok {
+		s.Customer = &p.customer.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.key.ok {
+		s.Key = &p.key.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.resourceid.ok {
+		s.Resourceid = &p.resourceid.v
+	}
+	if p.resourcetype.ok {
+		s.Resourcetype = &p.resourcetype.v
+	}
+	if p.value.ok {
+		s.Value = &p.value.v
+	}
+	return s
+}
+
+func (p *ListTagsParams) fromSerialized(s *serializedListTagsParams) {
+	*p = ListTagsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Customer != nil {
+		p.SetCustomer(*s.Customer)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Key != nil {
+		p.SetKey(*s.Key)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Resourceid != nil {
+		p.SetResourceid(*s.Resourceid)
+	}
+	if s.Resourcetype != nil {
+		p.SetResourcetype(*s.Resourcetype)
+	}
+	if s.Value != nil {
+		p.SetValue(*s.Value)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListTagsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListTagsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListTagsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListTagsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListTagsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListTagsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["value"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewListTagsParams() *ListTagsParams {
 	p := &ListTagsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// List resource tag(s)
-func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
-	resp, err := s.cs.newRequest("listTags", p.toURLValues())
+// List resource tag(s).
+func (s *ResourcetagsService) ListTags(p *ListTagsParams, opts ...CallOption) (*ListTagsResponse, error) {
+	resp, err := s.cs.newRequest("listTags", s.cs.encodeParams("listTags", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +53,43 @@ func (m *MockResourcetagsServiceIface) EXPECT() *MockResourcetagsServiceIfaceMoc
 }
 
 // CreateTags mocks base method.
-func (m *MockResourcetagsServiceIface) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
+func (m *MockResourcetagsServiceIface) CreateTags(p *CreateTagsParams, opts ...CallOption) (*CreateTagsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTags", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTags", varargs...)
 	ret0, _ := ret[0].(*CreateTagsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTags indicates an expected call of CreateTags.
-func (mr *MockResourcetagsServiceIfaceMockRecorder) CreateTags(p interface{}) *gomock.Call {
+func (mr *MockResourcetagsServiceIfaceMockRecorder) CreateTags(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).CreateTags), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).CreateTags), varargs...)
 }
 
 // DeleteTags mocks base method.
-func (m *MockResourcetagsServiceIface) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
+func (m *MockResourcetagsServiceIface) DeleteTags(p *DeleteTagsParams, opts ...CallOption) (*DeleteTagsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTags", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTags", varargs...)
 	ret0, _ := ret[0].(*DeleteTagsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteTags indicates an expected call of DeleteTags.
-func (mr *MockResourcetagsServiceIfaceMockRecorder) DeleteTags(p interface{}) *gomock.Call {
+func (mr *MockResourcetagsServiceIfaceMockRecorder) DeleteTags(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).DeleteTags), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).DeleteTags), varargs...)
 }
 
 // GetStorageTagID mocks base method.
@@ -104,33 +114,43 @@ func (mr *MockResourcetagsServiceIfaceMockRecorder) GetStorageTagID(keyword inte
 }
 
 // ListStorageTags mocks base method.
-func (m *MockResourcetagsServiceIface) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+func (m *MockResourcetagsServiceIface) ListStorageTags(p *ListStorageTagsParams, opts ...CallOption) (*ListStorageTagsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListStorageTags", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageTags", varargs...)
 	ret0, _ := ret[0].(*ListStorageTagsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListStorageTags indicates an expected call of ListStorageTags.
-func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTags(p interface{}) *gomock.Call {
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTags(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTags), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTags), varargs...)
 }
 
 // ListTags mocks base method.
-func (m *MockResourcetagsServiceIface) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
+func (m *MockResourcetagsServiceIface) ListTags(p *ListTagsParams, opts ...CallOption) (*ListTagsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTags", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTags", varargs...)
 	ret0, _ := ret[0].(*ListTagsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTags indicates an expected call of ListTags.
-func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTags(p interface{}) *gomock.Call {
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTags(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTags), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTags), varargs...)
 }
 
 // NewCreateTagsParams mocks base method.
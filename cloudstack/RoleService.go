@@ -28,174 +28,280 @@ import (
 )
 
 type RoleServiceIface interface {
-	CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error)
+	CreateRole(p *CreateRoleParams, opts ...CallOption) (*CreateRoleResponse, error)
 	NewCreateRoleParams(name string) *CreateRoleParams
-	CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error)
+	CreateRolePermission(p *CreateRolePermissionParams, opts ...CallOption) (*CreateRolePermissionResponse, error)
 	NewCreateRolePermissionParams(permission string, roleid string, rule string) *CreateRolePermissionParams
-	DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error)
+	DeleteRole(p *DeleteRoleParams, opts ...CallOption) (*DeleteRoleResponse, error)
 	NewDeleteRoleParams(id string) *DeleteRoleParams
-	DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error)
+	DeleteRolePermission(p *DeleteRolePermissionParams, opts ...CallOption) (*DeleteRolePermissionResponse, error)
 	NewDeleteRolePermissionParams(id string) *DeleteRolePermissionParams
-	ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error)
+	ImportRole(p *ImportRoleParams, opts ...CallOption) (*ImportRoleResponse, error)
 	NewImportRoleParams(name string, rules map[string]string) *ImportRoleParams
-	ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
+	ListRolePermissions(p *ListRolePermissionsParams, opts ...CallOption) (*ListRolePermissionsResponse, error)
 	NewListRolePermissionsParams() *ListRolePermissionsParams
-	ListRoles(p *ListRolesParams) (*ListRolesResponse, error)
+	ListRoles(p *ListRolesParams, opts ...CallOption) (*ListRolesResponse, error)
 	NewListRolesParams() *ListRolesParams
 	GetRoleID(name string, opts ...OptionFunc) (string, int, error)
 	GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error)
 	GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error)
-	UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error)
+	UpdateRole(p *UpdateRoleParams, opts ...CallOption) (*UpdateRoleResponse, error)
 	NewUpdateRoleParams(id string) *UpdateRoleParams
-	UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error)
+	UpdateRolePermission(p *UpdateRolePermissionParams, opts ...CallOption) (*UpdateRolePermissionResponse, error)
 	NewUpdateRolePermissionParams(roleid string) *UpdateRolePermissionParams
 }
 
 type CreateRoleParams struct {
-	p map[string]interface{}
+	description optString
+	ispublic    optBool
+	name        optString
+	roleid      optString
+	type_       optString
 }
 
-func (p *CreateRoleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *CreateRoleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateRoleParams parses url.Values, for example taken from a raw API request,
+// into a new CreateRoleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateRoleParams(u url.Values) (*CreateRoleParams, error) {
+	p := &CreateRoleParams{}
+	if err := checkParamNames("createRole", u, "description", "ispublic", "name", "roleid", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["description"] = v
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
 }
 
+// SetDescription sets the description param.
+func (p *CreateRoleParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
 func (p *CreateRoleParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateRoleParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *CreateRoleParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *CreateRoleParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *CreateRoleParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateRoleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateRoleParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateRoleParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetRoleid sets the roleid param.
 func (p *CreateRoleParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roleid"] = v
+	p.roleid = optString{v: v, ok: true}
 }
 
+// ResetRoleid unsets the roleid param
 func (p *CreateRoleParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *CreateRoleParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	return p.roleid.v, p.roleid.ok
 }
 
+// SetType sets the type param.
 func (p *CreateRoleParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *CreateRoleParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *CreateRoleParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateRoleParams) Clone() *CreateRoleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateRoleParams) Equal(o *CreateRoleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.ispublic == o.ispublic &&
+		p.name == o.name &&
+		p.roleid == o.roleid &&
+		p.type_ == o.type_
+}
+
+// serializedCreateRoleParams is used to (un)marshal CreateRoleParams using the API param names
+type serializedCreateRoleParams struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Ispublic    *bool   `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Roleid      *string `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+	Type        *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *CreateRoleParams) toSerialized() *serializedCreateRoleParams {
+	s := &serializedCreateRoleParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *CreateRoleParams) fromSerialized(s *serializedCreateRoleParams) {
+	*p = CreateRoleParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateRoleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateRoleParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateRoleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateRoleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateRoleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateRoleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateRoleParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewCreateRoleParams(name string) *CreateRoleParams {
 	p := &CreateRoleParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
-// Creates a role
-func (s *RoleService) CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error) {
-	resp, err := s.cs.newRequest("createRole", p.toURLValues())
+// Creates a role.
+//
+// Required params: name.
+func (s *RoleService) CreateRole(p *CreateRoleParams, opts ...CallOption) (*CreateRoleResponse, error) {
+	resp, err := s.cs.newRequest("createRole", s.cs.encodeParams("createRole", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -223,127 +329,224 @@ type CreateRoleResponse struct {
 }
 
 type CreateRolePermissionParams struct {
-	p map[string]interface{}
+	description optString
+	permission  optString
+	roleid      optString
+	rule        optString
 }
 
-func (p *CreateRolePermissionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["permission"]; found {
-		u.Set("permission", v.(string))
+	if p.permission.ok {
+		u.Set("permission", p.permission.v)
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
-	if v, found := p.p["rule"]; found {
-		u.Set("rule", v.(string))
+	if p.rule.ok {
+		u.Set("rule", p.rule.v)
 	}
 	return u
 }
 
-func (p *CreateRolePermissionParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateRolePermissionParams parses url.Values, for example taken from a raw API request,
+// into a new CreateRolePermissionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateRolePermissionParams(u url.Values) (*CreateRolePermissionParams, error) {
+	p := &CreateRolePermissionParams{}
+	if err := checkParamNames("createRolePermission", u, "description", "permission", "roleid", "rule"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["permission"]; found {
+		p.SetPermission(u.Get("permission"))
+	}
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
 	}
-	p.p["description"] = v
+	if _, found := u["rule"]; found {
+		p.SetRule(u.Get("rule"))
+	}
+	return p, nil
+}
+
+// SetDescription sets the description param.
+func (p *CreateRolePermissionParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateRolePermissionParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateRolePermissionParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetPermission sets the permission param. This param is required.
 func (p *CreateRolePermissionParams) SetPermission(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["permission"] = v
+	p.permission = optString{v: v, ok: true}
 }
 
+// ResetPermission unsets the permission param
 func (p *CreateRolePermissionParams) ResetPermission() {
-	if p.p != nil && p.p["permission"] != nil {
-		delete(p.p, "permission")
-	}
+	p.permission = optString{}
 }
 
+// GetPermission returns the permission param and if it is set
 func (p *CreateRolePermissionParams) GetPermission() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["permission"].(string)
-	return value, ok
+	return p.permission.v, p.permission.ok
 }
 
+// SetRoleid sets the roleid param. This param is required.
 func (p *CreateRolePermissionParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roleid"] = v
+	p.roleid = optString{v: v, ok: true}
 }
 
+// ResetRoleid unsets the roleid param
 func (p *CreateRolePermissionParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *CreateRolePermissionParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	return p.roleid.v, p.roleid.ok
 }
 
+// SetRule sets the rule param. This param is required.
 func (p *CreateRolePermissionParams) SetRule(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["rule"] = v
+	p.rule = optString{v: v, ok: true}
 }
 
+// ResetRule unsets the rule param
 func (p *CreateRolePermissionParams) ResetRule() {
-	if p.p != nil && p.p["rule"] != nil {
-		delete(p.p, "rule")
-	}
+	p.rule = optString{}
 }
 
+// GetRule returns the rule param and if it is set
 func (p *CreateRolePermissionParams) GetRule() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.rule.v, p.rule.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateRolePermissionParams) Clone() *CreateRolePermissionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateRolePermissionParams) Equal(o *CreateRolePermissionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.permission == o.permission &&
+		p.roleid == o.roleid &&
+		p.rule == o.rule
+}
+
+// serializedCreateRolePermissionParams is used to (un)marshal CreateRolePermissionParams using the API param names
+type serializedCreateRolePermissionParams struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Permission  *string `json:"permission,omitempty" yaml:"permission,omitempty"`
+	Roleid      *string `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+	Rule        *string `json:"rule,omitempty" yaml:"rule,omitempty"`
+}
+
+func (p *CreateRolePermissionParams) toSerialized() *serializedCreateRolePermissionParams {
+	s := &serializedCreateRolePermissionParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.permission.ok {
+		s.Permission = &p.permission.v
 	}
-	value, ok := p.p["rule"].(string)
-	return value, ok
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
+	}
+	if p.rule.ok {
+		s.Rule = &p.rule.v
+	}
+	return s
+}
+
+func (p *CreateRolePermissionParams) fromSerialized(s *serializedCreateRolePermissionParams) {
+	*p = CreateRolePermissionParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Permission != nil {
+		p.SetPermission(*s.Permission)
+	}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+	if s.Rule != nil {
+		p.SetRule(*s.Rule)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateRolePermissionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateRolePermissionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateRolePermissionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateRolePermissionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewCreateRolePermissionParams(permission string, roleid string, rule string) *CreateRolePermissionParams {
 	p := &CreateRolePermissionParams{}
-	p.p = make(map[string]interface{})
-	p.p["permission"] = permission
-	p.p["roleid"] = roleid
-	p.p["rule"] = rule
+	p.SetPermission(permission)
+	p.SetRoleid(roleid)
+	p.SetRule(rule)
 	return p
 }
 
-// Adds an API permission to a role
-func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("createRolePermission", p.toURLValues())
+// Adds an API permission to a role.
+//
+// Required params: permission, roleid, rule.
+func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams, opts ...CallOption) (*CreateRolePermissionResponse, error) {
+	resp, err := s.cs.newRequest("createRolePermission", s.cs.encodeParams("createRolePermission", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -371,53 +574,132 @@ type CreateRolePermissionResponse struct {
 }
 
 type DeleteRoleParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteRoleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteRoleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteRoleParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteRoleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteRoleParams(u url.Values) (*DeleteRoleParams, error) {
+	p := &DeleteRoleParams{}
+	if err := checkParamNames("deleteRole", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteRoleParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteRoleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteRoleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteRoleParams) Clone() *DeleteRoleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteRoleParams) Equal(o *DeleteRoleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteRoleParams is used to (un)marshal DeleteRoleParams using the API param names
+type serializedDeleteRoleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteRoleParams) toSerialized() *serializedDeleteRoleParams {
+	s := &serializedDeleteRoleParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteRoleParams) fromSerialized(s *serializedDeleteRoleParams) {
+	*p = DeleteRoleParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteRoleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteRoleParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteRoleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteRoleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteRoleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteRoleParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteRoleParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewDeleteRoleParams(id string) *DeleteRoleParams {
 	p := &DeleteRoleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a role
-func (s *RoleService) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error) {
-	resp, err := s.cs.newRequest("deleteRole", p.toURLValues())
+// Deletes a role.
+//
+// Required params: id.
+func (s *RoleService) DeleteRole(p *DeleteRoleParams, opts ...CallOption) (*DeleteRoleResponse, error) {
+	resp, err := s.cs.newRequest("deleteRole", s.cs.encodeParams("deleteRole", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -465,53 +747,132 @@ func (r *DeleteRoleResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteRolePermissionParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteRolePermissionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteRolePermissionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteRolePermissionParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteRolePermissionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteRolePermissionParams(u url.Values) (*DeleteRolePermissionParams, error) {
+	p := &DeleteRolePermissionParams{}
+	if err := checkParamNames("deleteRolePermission", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteRolePermissionParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteRolePermissionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteRolePermissionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteRolePermissionParams) Clone() *DeleteRolePermissionParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteRolePermissionParams) Equal(o *DeleteRolePermissionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteRolePermissionParams is used to (un)marshal DeleteRolePermissionParams using the API param names
+type serializedDeleteRolePermissionParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteRolePermissionParams) toSerialized() *serializedDeleteRolePermissionParams {
+	s := &serializedDeleteRolePermissionParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteRolePermissionParams) fromSerialized(s *serializedDeleteRolePermissionParams) {
+	*p = DeleteRolePermissionParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteRolePermissionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteRolePermissionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteRolePermissionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteRolePermissionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewDeleteRolePermissionParams(id string) *DeleteRolePermissionParams {
 	p := &DeleteRolePermissionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a role permission
-func (s *RoleService) DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("deleteRolePermission", p.toURLValues())
+// Deletes a role permission.
+//
+// Required params: id.
+func (s *RoleService) DeleteRolePermission(p *DeleteRolePermissionParams, opts ...CallOption) (*DeleteRolePermissionResponse, error) {
+	resp, err := s.cs.newRequest("deleteRolePermission", s.cs.encodeParams("deleteRolePermission", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -559,180 +920,298 @@ func (r *DeleteRolePermissionResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ImportRoleParams struct {
-	p map[string]interface{}
+	description optString
+	forced      optBool
+	ispublic    optBool
+	name        optString
+	rules       optStringMap
+	type_       optString
 }
 
-func (p *ImportRoleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ImportRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["rules"]; found {
-		m := v.(map[string]string)
+	if p.rules.ok {
+		m := p.rules.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("rules[%d].key", i), k)
 			u.Set(fmt.Sprintf("rules[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *ImportRoleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseImportRoleParams parses url.Values, for example taken from a raw API request,
+// into a new ImportRoleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseImportRoleParams(u url.Values) (*ImportRoleParams, error) {
+	p := &ImportRoleParams{}
+	if err := checkParamNames("importRole", u, "description", "forced", "ispublic", "name", "rules[]", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
 	}
-	p.p["description"] = v
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if m, err := parseKeyValueMap(u, "rules", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetRules(m)
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
+}
+
+// SetDescription sets the description param.
+func (p *ImportRoleParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *ImportRoleParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *ImportRoleParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetForced sets the forced param.
 func (p *ImportRoleParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *ImportRoleParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *ImportRoleParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *ImportRoleParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *ImportRoleParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *ImportRoleParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *ImportRoleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ImportRoleParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ImportRoleParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetRules sets the rules param. This param is required.
 func (p *ImportRoleParams) SetRules(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["rules"] = v
+	p.rules = optStringMap{v: v, ok: true}
 }
 
+// ResetRules unsets the rules param
 func (p *ImportRoleParams) ResetRules() {
-	if p.p != nil && p.p["rules"] != nil {
-		delete(p.p, "rules")
-	}
+	p.rules = optStringMap{}
 }
 
+// GetRules returns the rules param and if it is set
 func (p *ImportRoleParams) GetRules() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["rules"].(map[string]string)
-	return value, ok
+	return p.rules.v, p.rules.ok
 }
 
+// SetType sets the type param.
 func (p *ImportRoleParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ImportRoleParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ImportRoleParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ImportRoleParams) Clone() *ImportRoleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.rules = p.rules.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ImportRoleParams) Equal(o *ImportRoleParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.forced == o.forced &&
+		p.ispublic == o.ispublic &&
+		p.name == o.name &&
+		p.rules.equal(o.rules) &&
+		p.type_ == o.type_
+}
+
+// serializedImportRoleParams is used to (un)marshal ImportRoleParams using the API param names
+type serializedImportRoleParams struct {
+	Description *string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Forced      *bool              `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Ispublic    *bool              `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Name        *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Rules       *map[string]string `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Type        *string            `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *ImportRoleParams) toSerialized() *serializedImportRoleParams {
+	s := &serializedImportRoleParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.rules.ok {
+		s.Rules = &p.rules.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *ImportRoleParams) fromSerialized(s *serializedImportRoleParams) {
+	*p = ImportRoleParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Rules != nil {
+		p.SetRules(*s.Rules)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ImportRoleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ImportRoleParams) UnmarshalJSON(b []byte) error {
+	var s serializedImportRoleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ImportRoleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ImportRoleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedImportRoleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ImportRoleParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewImportRoleParams(name string, rules map[string]string) *ImportRoleParams {
 	p := &ImportRoleParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["rules"] = rules
+	p.SetName(name)
+	p.SetRules(rules)
 	return p
 }
 
-// Imports a role based on provided map of rule permissions
-func (s *RoleService) ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error) {
-	resp, err := s.cs.newRequest("importRole", p.toURLValues())
+// Imports a role based on provided map of rule permissions.
+//
+// Required params: name, rules.
+func (s *RoleService) ImportRole(p *ImportRoleParams, opts ...CallOption) (*ImportRoleResponse, error) {
+	resp, err := s.cs.newRequest("importRole", s.cs.encodeParams("importRole", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -757,52 +1236,129 @@ type ImportRoleResponse struct {
 }
 
 type ListRolePermissionsParams struct {
-	p map[string]interface{}
+	roleid optString
 }
 
-func (p *ListRolePermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListRolePermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
 	return u
 }
 
-func (p *ListRolePermissionsParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListRolePermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListRolePermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListRolePermissionsParams(u url.Values) (*ListRolePermissionsParams, error) {
+	p := &ListRolePermissionsParams{}
+	if err := checkParamNames("listRolePermissions", u, "roleid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
 	}
-	p.p["roleid"] = v
+	return p, nil
 }
 
+// SetRoleid sets the roleid param.
+func (p *ListRolePermissionsParams) SetRoleid(v string) {
+	p.roleid = optString{v: v, ok: true}
+}
+
+// ResetRoleid unsets the roleid param
 func (p *ListRolePermissionsParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *ListRolePermissionsParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.roleid.v, p.roleid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListRolePermissionsParams) Clone() *ListRolePermissionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListRolePermissionsParams) Equal(o *ListRolePermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.roleid == o.roleid
+}
+
+// serializedListRolePermissionsParams is used to (un)marshal ListRolePermissionsParams using the API param names
+type serializedListRolePermissionsParams struct {
+	Roleid *string `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+}
+
+func (p *ListRolePermissionsParams) toSerialized() *serializedListRolePermissionsParams {
+	s := &serializedListRolePermissionsParams{}
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
 	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ListRolePermissionsParams) fromSerialized(s *serializedListRolePermissionsParams) {
+	*p = ListRolePermissionsParams{}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListRolePermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListRolePermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListRolePermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListRolePermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListRolePermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListRolePermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListRolePermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewListRolePermissionsParams() *ListRolePermissionsParams {
 	p := &ListRolePermissionsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists role permissions
-func (s *RoleService) ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listRolePermissions", p.toURLValues())
+// Lists role permissions.
+func (s *RoleService) ListRolePermissions(p *ListRolePermissionsParams, opts ...CallOption) (*ListRolePermissionsResponse, error) {
+	resp, err := s.cs.newRequest("listRolePermissions", s.cs.encodeParams("listRolePermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -832,177 +1388,289 @@ type RolePermission struct {
 }
 
 type ListRolesParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	name     optString
+	page     optInt
+	pagesize optInt
+	type_    optString
 }
 
-func (p *ListRolesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListRolesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *ListRolesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListRolesParams parses url.Values, for example taken from a raw API request,
+// into a new ListRolesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListRolesParams(u url.Values) (*ListRolesParams, error) {
+	p := &ListRolesParams{}
+	if err := checkParamNames("listRoles", u, "id", "keyword", "name", "page", "pagesize", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListRolesParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListRolesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListRolesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListRolesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListRolesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListRolesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListRolesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListRolesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListRolesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListRolesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListRolesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListRolesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListRolesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListRolesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListRolesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetType sets the type param.
 func (p *ListRolesParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListRolesParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListRolesParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListRolesParams) Clone() *ListRolesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListRolesParams) Equal(o *ListRolesParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.type_ == o.type_
+}
+
+// serializedListRolesParams is used to (un)marshal ListRolesParams using the API param names
+type serializedListRolesParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Type     *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *ListRolesParams) toSerialized() *serializedListRolesParams {
+	s := &serializedListRolesParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *ListRolesParams) fromSerialized(s *serializedListRolesParams) {
+	*p = ListRolesParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListRolesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListRolesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListRolesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListRolesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListRolesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListRolesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListRolesParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewListRolesParams() *ListRolesParams {
 	p := &ListRolesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRolesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1050,9 +1718,8 @@ func (s *RoleService) GetRoleByName(name string, opts ...OptionFunc) (*Role, int
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error) {
 	p := &ListRolesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1080,9 +1747,9 @@ func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, er
 	return nil, l.Count, fmt.Errorf("There is more then one result for Role UUID: %s!", id)
 }
 
-// Lists dynamic roles in CloudStack
-func (s *RoleService) ListRoles(p *ListRolesParams) (*ListRolesResponse, error) {
-	resp, err := s.cs.newRequest("listRoles", p.toURLValues())
+// Lists dynamic roles in CloudStack.
+func (s *RoleService) ListRoles(p *ListRolesParams, opts ...CallOption) (*ListRolesResponse, error) {
+	resp, err := s.cs.newRequest("listRoles", s.cs.encodeParams("listRoles", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1112,153 +1779,256 @@ type Role struct {
 }
 
 type UpdateRoleParams struct {
-	p map[string]interface{}
+	description optString
+	id          optString
+	ispublic    optBool
+	name        optString
+	type_       optString
 }
 
-func (p *UpdateRoleParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *UpdateRoleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateRoleParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateRoleParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateRoleParams(u url.Values) (*UpdateRoleParams, error) {
+	p := &UpdateRoleParams{}
+	if err := checkParamNames("updateRole", u, "description", "id", "ispublic", "name", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["description"] = v
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
 }
 
+// SetDescription sets the description param.
+func (p *UpdateRoleParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
+}
+
+// ResetDescription unsets the description param
 func (p *UpdateRoleParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *UpdateRoleParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateRoleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateRoleParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateRoleParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *UpdateRoleParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *UpdateRoleParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *UpdateRoleParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateRoleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateRoleParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateRoleParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetType sets the type param.
 func (p *UpdateRoleParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *UpdateRoleParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *UpdateRoleParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateRoleParams) Clone() *UpdateRoleParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateRoleParams) Equal(o *UpdateRoleParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.description == o.description &&
+		p.id == o.id &&
+		p.ispublic == o.ispublic &&
+		p.name == o.name &&
+		p.type_ == o.type_
+}
+
+// serializedUpdateRoleParams is used to (un)marshal UpdateRoleParams using the API param names
+type serializedUpdateRoleParams struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ispublic    *bool   `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type        *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *UpdateRoleParams) toSerialized() *serializedUpdateRoleParams {
+	s := &serializedUpdateRoleParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *UpdateRoleParams) fromSerialized(s *serializedUpdateRoleParams) {
+	*p = UpdateRoleParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateRoleParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateRoleParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateRoleParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateRoleParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateRoleParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateRoleParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateRoleParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewUpdateRoleParams(id string) *UpdateRoleParams {
 	p := &UpdateRoleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a role
-func (s *RoleService) UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error) {
-	resp, err := s.cs.newRequest("updateRole", p.toURLValues())
+// Updates a role.
+//
+// Required params: id.
+func (s *RoleService) UpdateRole(p *UpdateRoleParams, opts ...CallOption) (*UpdateRoleResponse, error) {
+	resp, err := s.cs.newRequest("updateRole", s.cs.encodeParams("updateRole", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1283,126 +2053,223 @@ type UpdateRoleResponse struct {
 }
 
 type UpdateRolePermissionParams struct {
-	p map[string]interface{}
+	permission optString
+	roleid     optString
+	ruleid     optString
+	ruleorder  optStrings
 }
 
-func (p *UpdateRolePermissionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["permission"]; found {
-		u.Set("permission", v.(string))
+	if p.permission.ok {
+		u.Set("permission", p.permission.v)
 	}
-	if v, found := p.p["roleid"]; found {
-		u.Set("roleid", v.(string))
+	if p.roleid.ok {
+		u.Set("roleid", p.roleid.v)
 	}
-	if v, found := p.p["ruleid"]; found {
-		u.Set("ruleid", v.(string))
+	if p.ruleid.ok {
+		u.Set("ruleid", p.ruleid.v)
 	}
-	if v, found := p.p["ruleorder"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ruleorder", vv)
+	if p.ruleorder.ok {
+		u.Set("ruleorder", strings.Join(p.ruleorder.v, ","))
 	}
 	return u
 }
 
-func (p *UpdateRolePermissionParams) SetPermission(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateRolePermissionParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateRolePermissionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateRolePermissionParams(u url.Values) (*UpdateRolePermissionParams, error) {
+	p := &UpdateRolePermissionParams{}
+	if err := checkParamNames("updateRolePermission", u, "permission", "roleid", "ruleid", "ruleorder"); err != nil {
+		return nil, err
 	}
-	p.p["permission"] = v
+	if _, found := u["permission"]; found {
+		p.SetPermission(u.Get("permission"))
+	}
+	if _, found := u["roleid"]; found {
+		p.SetRoleid(u.Get("roleid"))
+	}
+	if _, found := u["ruleid"]; found {
+		p.SetRuleid(u.Get("ruleid"))
+	}
+	if _, found := u["ruleorder"]; found {
+		p.SetRuleorder(strings.Split(u.Get("ruleorder"), ","))
+	}
+	return p, nil
+}
+
+// SetPermission sets the permission param.
+func (p *UpdateRolePermissionParams) SetPermission(v string) {
+	p.permission = optString{v: v, ok: true}
 }
 
+// ResetPermission unsets the permission param
 func (p *UpdateRolePermissionParams) ResetPermission() {
-	if p.p != nil && p.p["permission"] != nil {
-		delete(p.p, "permission")
-	}
+	p.permission = optString{}
 }
 
+// GetPermission returns the permission param and if it is set
 func (p *UpdateRolePermissionParams) GetPermission() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["permission"].(string)
-	return value, ok
+	return p.permission.v, p.permission.ok
 }
 
+// SetRoleid sets the roleid param. This param is required.
 func (p *UpdateRolePermissionParams) SetRoleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["roleid"] = v
+	p.roleid = optString{v: v, ok: true}
 }
 
+// ResetRoleid unsets the roleid param
 func (p *UpdateRolePermissionParams) ResetRoleid() {
-	if p.p != nil && p.p["roleid"] != nil {
-		delete(p.p, "roleid")
-	}
+	p.roleid = optString{}
 }
 
+// GetRoleid returns the roleid param and if it is set
 func (p *UpdateRolePermissionParams) GetRoleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["roleid"].(string)
-	return value, ok
+	return p.roleid.v, p.roleid.ok
 }
 
+// SetRuleid sets the ruleid param.
 func (p *UpdateRolePermissionParams) SetRuleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ruleid"] = v
+	p.ruleid = optString{v: v, ok: true}
 }
 
+// ResetRuleid unsets the ruleid param
 func (p *UpdateRolePermissionParams) ResetRuleid() {
-	if p.p != nil && p.p["ruleid"] != nil {
-		delete(p.p, "ruleid")
-	}
+	p.ruleid = optString{}
 }
 
+// GetRuleid returns the ruleid param and if it is set
 func (p *UpdateRolePermissionParams) GetRuleid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ruleid"].(string)
-	return value, ok
+	return p.ruleid.v, p.ruleid.ok
 }
 
+// SetRuleorder sets the ruleorder param.
 func (p *UpdateRolePermissionParams) SetRuleorder(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ruleorder"] = v
+	p.ruleorder = optStrings{v: v, ok: true}
 }
 
+// ResetRuleorder unsets the ruleorder param
 func (p *UpdateRolePermissionParams) ResetRuleorder() {
-	if p.p != nil && p.p["ruleorder"] != nil {
-		delete(p.p, "ruleorder")
-	}
+	p.ruleorder = optStrings{}
 }
 
+// GetRuleorder returns the ruleorder param and if it is set
 func (p *UpdateRolePermissionParams) GetRuleorder() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ruleorder.v, p.ruleorder.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateRolePermissionParams) Clone() *UpdateRolePermissionParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["ruleorder"].([]string)
-	return value, ok
+	c := *p
+	c.ruleorder = p.ruleorder.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateRolePermissionParams) Equal(o *UpdateRolePermissionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.permission == o.permission &&
+		p.roleid == o.roleid &&
+		p.ruleid == o.ruleid &&
+		p.ruleorder.equal(o.ruleorder)
+}
+
+// serializedUpdateRolePermissionParams is used to (un)marshal UpdateRolePermissionParams using the API param names
+type serializedUpdateRolePermissionParams struct {
+	Permission *string   `json:"permission,omitempty" yaml:"permission,omitempty"`
+	Roleid     *string   `json:"roleid,omitempty" yaml:"roleid,omitempty"`
+	Ruleid     *string   `json:"ruleid,omitempty" yaml:"ruleid,omitempty"`
+	Ruleorder  *[]string `json:"ruleorder,omitempty" yaml:"ruleorder,omitempty"`
+}
+
+func (p *UpdateRolePermissionParams) toSerialized() *serializedUpdateRolePermissionParams {
+	s := &serializedUpdateRolePermissionParams{}
+	if p.permission.ok {
+		s.Permission = &p.permission.v
+	}
+	if p.roleid.ok {
+		s.Roleid = &p.roleid.v
+	}
+	if p.ruleid.ok {
+		s.Ruleid = &p.ruleid.v
+	}
+	if p.ruleorder.ok {
+		s.Ruleorder = &p.ruleorder.v
+	}
+	return s
+}
+
+func (p *UpdateRolePermissionParams) fromSerialized(s *serializedUpdateRolePermissionParams) {
+	*p = UpdateRolePermissionParams{}
+	if s.Permission != nil {
+		p.SetPermission(*s.Permission)
+	}
+	if s.Roleid != nil {
+		p.SetRoleid(*s.Roleid)
+	}
+	if s.Ruleid != nil {
+		p.SetRuleid(*s.Ruleid)
+	}
+	if s.Ruleorder != nil {
+		p.SetRuleorder(*s.Ruleorder)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateRolePermissionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateRolePermissionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateRolePermissionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateRolePermissionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewUpdateRolePermissionParams(roleid string) *UpdateRolePermissionParams {
 	p := &UpdateRolePermissionParams{}
-	p.p = make(map[string]interface{})
-	p.p["roleid"] = roleid
+	p.SetRoleid(roleid)
 	return p
 }
 
-// Updates a role permission order
-func (s *RoleService) UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("updateRolePermission", p.toURLValues())
+// Updates a role permission order.
+//
+// Required params: roleid.
+func (s *RoleService) UpdateRolePermission(p *UpdateRolePermissionParams, opts ...CallOption) (*UpdateRolePermissionResponse, error) {
+	resp, err := s.cs.newRequest("updateRolePermission", s.cs.encodeParams("updateRolePermission", p), opts...)
 	if err != nil {
 		return nil, err
 	}
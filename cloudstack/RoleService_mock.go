@@ -53,63 +53,83 @@ func (m *MockRoleServiceIface) EXPECT() *MockRoleServiceIfaceMockRecorder {
 }
 
 // CreateRole mocks base method.
-func (m *MockRoleServiceIface) CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error) {
+func (m *MockRoleServiceIface) CreateRole(p *CreateRoleParams, opts ...CallOption) (*CreateRoleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRole", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRole", varargs...)
 	ret0, _ := ret[0].(*CreateRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateRole indicates an expected call of CreateRole.
-func (mr *MockRoleServiceIfaceMockRecorder) CreateRole(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) CreateRole(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRoleServiceIface)(nil).CreateRole), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRoleServiceIface)(nil).CreateRole), varargs...)
 }
 
 // CreateRolePermission mocks base method.
-func (m *MockRoleServiceIface) CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
+func (m *MockRoleServiceIface) CreateRolePermission(p *CreateRolePermissionParams, opts ...CallOption) (*CreateRolePermissionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRolePermission", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRolePermission", varargs...)
 	ret0, _ := ret[0].(*CreateRolePermissionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateRolePermission indicates an expected call of CreateRolePermission.
-func (mr *MockRoleServiceIfaceMockRecorder) CreateRolePermission(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) CreateRolePermission(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).CreateRolePermission), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).CreateRolePermission), varargs...)
 }
 
 // DeleteRole mocks base method.
-func (m *MockRoleServiceIface) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error) {
+func (m *MockRoleServiceIface) DeleteRole(p *DeleteRoleParams, opts ...CallOption) (*DeleteRoleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRole", varargs...)
 	ret0, _ := ret[0].(*DeleteRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockRoleServiceIfaceMockRecorder) DeleteRole(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) DeleteRole(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRoleServiceIface)(nil).DeleteRole), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRoleServiceIface)(nil).DeleteRole), varargs...)
 }
 
 // DeleteRolePermission mocks base method.
-func (m *MockRoleServiceIface) DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
+func (m *MockRoleServiceIface) DeleteRolePermission(p *DeleteRolePermissionParams, opts ...CallOption) (*DeleteRolePermissionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRolePermission", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRolePermission", varargs...)
 	ret0, _ := ret[0].(*DeleteRolePermissionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteRolePermission indicates an expected call of DeleteRolePermission.
-func (mr *MockRoleServiceIfaceMockRecorder) DeleteRolePermission(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) DeleteRolePermission(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).DeleteRolePermission), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).DeleteRolePermission), varargs...)
 }
 
 // GetRoleByID mocks base method.
@@ -176,48 +196,63 @@ func (mr *MockRoleServiceIfaceMockRecorder) GetRoleID(name interface{}, opts ...
 }
 
 // ImportRole mocks base method.
-func (m *MockRoleServiceIface) ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error) {
+func (m *MockRoleServiceIface) ImportRole(p *ImportRoleParams, opts ...CallOption) (*ImportRoleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImportRole", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportRole", varargs...)
 	ret0, _ := ret[0].(*ImportRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ImportRole indicates an expected call of ImportRole.
-func (mr *MockRoleServiceIfaceMockRecorder) ImportRole(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) ImportRole(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRole", reflect.TypeOf((*MockRoleServiceIface)(nil).ImportRole), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRole", reflect.TypeOf((*MockRoleServiceIface)(nil).ImportRole), varargs...)
 }
 
 // ListRolePermissions mocks base method.
-func (m *MockRoleServiceIface) ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
+func (m *MockRoleServiceIface) ListRolePermissions(p *ListRolePermissionsParams, opts ...CallOption) (*ListRolePermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRolePermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRolePermissions", varargs...)
 	ret0, _ := ret[0].(*ListRolePermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRolePermissions indicates an expected call of ListRolePermissions.
-func (mr *MockRoleServiceIfaceMockRecorder) ListRolePermissions(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) ListRolePermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissions", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolePermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissions", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolePermissions), varargs...)
 }
 
 // ListRoles mocks base method.
-func (m *MockRoleServiceIface) ListRoles(p *ListRolesParams) (*ListRolesResponse, error) {
+func (m *MockRoleServiceIface) ListRoles(p *ListRolesParams, opts ...CallOption) (*ListRolesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoles", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoles", varargs...)
 	ret0, _ := ret[0].(*ListRolesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRoles indicates an expected call of ListRoles.
-func (mr *MockRoleServiceIfaceMockRecorder) ListRoles(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) ListRoles(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRoles), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRoles), varargs...)
 }
 
 // NewCreateRoleParams mocks base method.
@@ -347,31 +382,41 @@ func (mr *MockRoleServiceIfaceMockRecorder) NewUpdateRolePermissionParams(roleid
 }
 
 // UpdateRole mocks base method.
-func (m *MockRoleServiceIface) UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error) {
+func (m *MockRoleServiceIface) UpdateRole(p *UpdateRoleParams, opts ...CallOption) (*UpdateRoleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRole", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRole", varargs...)
 	ret0, _ := ret[0].(*UpdateRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateRole indicates an expected call of UpdateRole.
-func (mr *MockRoleServiceIfaceMockRecorder) UpdateRole(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) UpdateRole(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockRoleServiceIface)(nil).UpdateRole), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockRoleServiceIface)(nil).UpdateRole), varargs...)
 }
 
 // UpdateRolePermission mocks base method.
-func (m *MockRoleServiceIface) UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
+func (m *MockRoleServiceIface) UpdateRolePermission(p *UpdateRolePermissionParams, opts ...CallOption) (*UpdateRolePermissionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRolePermission", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRolePermission", varargs...)
 	ret0, _ := ret[0].(*UpdateRolePermissionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateRolePermission indicates an expected call of UpdateRolePermission.
-func (mr *MockRoleServiceIfaceMockRecorder) UpdateRolePermission(p interface{}) *gomock.Call {
+func (mr *MockRoleServiceIfaceMockRecorder) UpdateRolePermission(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).UpdateRolePermission), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).UpdateRolePermission), varargs...)
 }
@@ -28,103 +28,188 @@ import (
 )
 
 type RouterServiceIface interface {
-	ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouter(p *ChangeServiceForRouterParams, opts ...CallOption) (*ChangeServiceForRouterResponse, error)
 	NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams
-	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams, opts ...CallOption) (*VirtualRouterElementResponse, error)
 	NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams
-	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams, opts ...CallOption) (*CreateVirtualRouterElementResponse, error)
 	NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams
-	DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouter(p *DestroyRouterParams, opts ...CallOption) (*DestroyRouterResponse, error)
 	NewDestroyRouterParams(id string) *DestroyRouterParams
-	ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListRouters(p *ListRoutersParams, opts ...CallOption) (*ListRoutersResponse, error)
 	NewListRoutersParams() *ListRoutersParams
 	GetRouterID(name string, opts ...OptionFunc) (string, int, error)
 	GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error)
 	GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error)
-	ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElements(p *ListVirtualRouterElementsParams, opts ...CallOption) (*ListVirtualRouterElementsResponse, error)
 	NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams
 	GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
-	RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouter(p *RebootRouterParams, opts ...CallOption) (*RebootRouterResponse, error)
 	NewRebootRouterParams(id string) *RebootRouterParams
-	StartRouter(p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouter(p *StartRouterParams, opts ...CallOption) (*StartRouterResponse, error)
 	NewStartRouterParams(id string) *StartRouterParams
-	StopRouter(p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouter(p *StopRouterParams, opts ...CallOption) (*StopRouterResponse, error)
 	NewStopRouterParams(id string) *StopRouterParams
 }
 
 type ChangeServiceForRouterParams struct {
-	p map[string]interface{}
+	id                optString
+	serviceofferingid optString
 }
 
-func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ChangeServiceForRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
 	return u
 }
 
-func (p *ChangeServiceForRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseChangeServiceForRouterParams parses url.Values, for example taken from a raw API request,
+// into a new ChangeServiceForRouterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseChangeServiceForRouterParams(u url.Values) (*ChangeServiceForRouterParams, error) {
+	p := &ChangeServiceForRouterParams{}
+	if err := checkParamNames("changeServiceForRouter", u, "id", "serviceofferingid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ChangeServiceForRouterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ChangeServiceForRouterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ChangeServiceForRouterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *ChangeServiceForRouterParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ChangeServiceForRouterParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ChangeServiceForRouterParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.serviceofferingid.v, p.serviceofferingid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ChangeServiceForRouterParams) Clone() *ChangeServiceForRouterParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ChangeServiceForRouterParams) Equal(o *ChangeServiceForRouterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.serviceofferingid == o.serviceofferingid
+}
+
+// serializedChangeServiceForRouterParams is used to (un)marshal ChangeServiceForRouterParams using the API param names
+type serializedChangeServiceForRouterParams struct {
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+}
+
+func (p *ChangeServiceForRouterParams) toSerialized() *serializedChangeServiceForRouterParams {
+	s := &serializedChangeServiceForRouterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	return s
+}
+
+func (p *ChangeServiceForRouterParams) fromSerialized(s *serializedChangeServiceForRouterParams) {
+	*p = ChangeServiceForRouterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ChangeServiceForRouterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ChangeServiceForRouterParams) UnmarshalJSON(b []byte) error {
+	var s serializedChangeServiceForRouterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ChangeServiceForRouterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ChangeServiceForRouterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedChangeServiceForRouterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ChangeServiceForRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams {
 	p := &ChangeServiceForRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Upgrades domain router to a new service offering
-func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForRouter", p.toURLValues())
+// Upgrades domain router to a new service offering.
+//
+// Required params: id, serviceofferingid.
+func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams, opts ...CallOption) (*ChangeServiceForRouterResponse, error) {
+	resp, err := s.cs.newRequest("changeServiceForRouter", s.cs.encodeParams("changeServiceForRouter", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -204,79 +289,168 @@ type ChangeServiceForRouterResponseHealthcheckresults struct {
 }
 
 type ConfigureVirtualRouterElementParams struct {
-	p map[string]interface{}
+	enabled optBool
+	id      optString
 }
 
-func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ConfigureVirtualRouterElementParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
+	if p.enabled.ok {
+		u.Set("enabled", strconv.FormatBool(p.enabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseConfigureVirtualRouterElementParams parses url.Values, for example taken from a raw API request,
+// into a new ConfigureVirtualRouterElementParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseConfigureVirtualRouterElementParams(u url.Values) (*ConfigureVirtualRouterElementParams, error) {
+	p := &ConfigureVirtualRouterElementParams{}
+	if err := checkParamNames("configureVirtualRouterElement", u, "enabled", "id"); err != nil {
+		return nil, err
 	}
-	p.p["enabled"] = v
+	if _, found := u["enabled"]; found {
+		v, err := strconv.ParseBool(u.Get("enabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabled: %v", err)
+		}
+		p.SetEnabled(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetEnabled sets the enabled param. This param is required.
+func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
+	p.enabled = optBool{v: v, ok: true}
 }
 
+// ResetEnabled unsets the enabled param
 func (p *ConfigureVirtualRouterElementParams) ResetEnabled() {
-	if p.p != nil && p.p["enabled"] != nil {
-		delete(p.p, "enabled")
-	}
+	p.enabled = optBool{}
 }
 
+// GetEnabled returns the enabled param and if it is set
 func (p *ConfigureVirtualRouterElementParams) GetEnabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabled"].(bool)
-	return value, ok
+	return p.enabled.v, p.enabled.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ConfigureVirtualRouterElementParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ConfigureVirtualRouterElementParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ConfigureVirtualRouterElementParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ConfigureVirtualRouterElementParams) Clone() *ConfigureVirtualRouterElementParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ConfigureVirtualRouterElementParams) Equal(o *ConfigureVirtualRouterElementParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enabled == o.enabled &&
+		p.id == o.id
+}
+
+// serializedConfigureVirtualRouterElementParams is used to (un)marshal ConfigureVirtualRouterElementParams using the API param names
+type serializedConfigureVirtualRouterElementParams struct {
+	Enabled *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ConfigureVirtualRouterElementParams) toSerialized() *serializedConfigureVirtualRouterElementParams {
+	s := &serializedConfigureVirtualRouterElementParams{}
+	if p.enabled.ok {
+		s.Enabled = &p.enabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ConfigureVirtualRouterElementParams) fromSerialized(s *serializedConfigureVirtualRouterElementParams) {
+	*p = ConfigureVirtualRouterElementParams{}
+	if s.Enabled != nil {
+		p.SetEnabled(*s.Enabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ConfigureVirtualRouterElementParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ConfigureVirtualRouterElementParams) UnmarshalJSON(b []byte) error {
+	var s serializedConfigureVirtualRouterElementParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ConfigureVirtualRouterElementParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ConfigureVirtualRouterElementParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedConfigureVirtualRouterElementParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ConfigureVirtualRouterElementParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams {
 	p := &ConfigureVirtualRouterElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["enabled"] = enabled
-	p.p["id"] = id
+	p.SetEnabled(enabled)
+	p.SetId(id)
 	return p
 }
 
 // Configures a virtual router element.
-func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("configureVirtualRouterElement", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: enabled, id.
+func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams, opts ...CallOption) (*VirtualRouterElementResponse, error) {
+	resp, err := s.cs.newRequest("configureVirtualRouterElement", s.cs.encodeParams("configureVirtualRouterElement", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +461,8 @@ func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterE
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -323,77 +497,163 @@ type VirtualRouterElementResponse struct {
 }
 
 type CreateVirtualRouterElementParams struct {
-	p map[string]interface{}
+	nspid        optString
+	providertype optString
 }
 
-func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVirtualRouterElementParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
+	if p.nspid.ok {
+		u.Set("nspid", p.nspid.v)
 	}
-	if v, found := p.p["providertype"]; found {
-		u.Set("providertype", v.(string))
+	if p.providertype.ok {
+		u.Set("providertype", p.providertype.v)
 	}
 	return u
 }
 
-func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVirtualRouterElementParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVirtualRouterElementParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVirtualRouterElementParams(u url.Values) (*CreateVirtualRouterElementParams, error) {
+	p := &CreateVirtualRouterElementParams{}
+	if err := checkParamNames("createVirtualRouterElement", u, "nspid", "providertype"); err != nil {
+		return nil, err
 	}
-	p.p["nspid"] = v
+	if _, found := u["nspid"]; found {
+		p.SetNspid(u.Get("nspid"))
+	}
+	if _, found := u["providertype"]; found {
+		p.SetProvidertype(u.Get("providertype"))
+	}
+	return p, nil
 }
 
+// SetNspid sets the nspid param. This param is required.
+func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
+	p.nspid = optString{v: v, ok: true}
+}
+
+// ResetNspid unsets the nspid param
 func (p *CreateVirtualRouterElementParams) ResetNspid() {
-	if p.p != nil && p.p["nspid"] != nil {
-		delete(p.p, "nspid")
-	}
+	p.nspid = optString{}
 }
 
+// GetNspid returns the nspid param and if it is set
 func (p *CreateVirtualRouterElementParams) GetNspid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nspid"].(string)
-	return value, ok
+	return p.nspid.v, p.nspid.ok
 }
 
+// SetProvidertype sets the providertype param.
 func (p *CreateVirtualRouterElementParams) SetProvidertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["providertype"] = v
+	p.providertype = optString{v: v, ok: true}
 }
 
+// ResetProvidertype unsets the providertype param
 func (p *CreateVirtualRouterElementParams) ResetProvidertype() {
-	if p.p != nil && p.p["providertype"] != nil {
-		delete(p.p, "providertype")
-	}
+	p.providertype = optString{}
 }
 
+// GetProvidertype returns the providertype param and if it is set
 func (p *CreateVirtualRouterElementParams) GetProvidertype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.providertype.v, p.providertype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVirtualRouterElementParams) Clone() *CreateVirtualRouterElementParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["providertype"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVirtualRouterElementParams) Equal(o *CreateVirtualRouterElementParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.nspid == o.nspid &&
+		p.providertype == o.providertype
+}
+
+// serializedCreateVirtualRouterElementParams is used to (un)marshal CreateVirtualRouterElementParams using the API param names
+type serializedCreateVirtualRouterElementParams struct {
+	Nspid        *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Providertype *string `json:"providertype,omitempty" yaml:"providertype,omitempty"`
+}
+
+func (p *CreateVirtualRouterElementParams) toSerialized() *serializedCreateVirtualRouterElementParams {
+	s := &serializedCreateVirtualRouterElementParams{}
+	if p.nspid.ok {
+		s.Nspid = &p.nspid.v
+	}
+	if p.providertype.ok {
+		s.Providertype = &p.providertype.v
+	}
+	return s
+}
+
+func (p *CreateVirtualRouterElementParams) fromSerialized(s *serializedCreateVirtualRouterElementParams) {
+	*p = CreateVirtualRouterElementParams{}
+	if s.Nspid != nil {
+		p.SetNspid(*s.Nspid)
+	}
+	if s.Providertype != nil {
+		p.SetProvidertype(*s.Providertype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVirtualRouterElementParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVirtualRouterElementParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVirtualRouterElementParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVirtualRouterElementParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVirtualRouterElementParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVirtualRouterElementParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateVirtualRouterElementParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams {
 	p := &CreateVirtualRouterElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["nspid"] = nspid
+	p.SetNspid(nspid)
 	return p
 }
 
 // Create a virtual router element.
-func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("createVirtualRouterElement", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: nspid.
+func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams, opts ...CallOption) (*CreateVirtualRouterElementResponse, error) {
+	resp, err := s.cs.newRequest("createVirtualRouterElement", s.cs.encodeParams("createVirtualRouterElement", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -404,8 +664,8 @@ func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElement
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -440,53 +700,133 @@ type CreateVirtualRouterElementResponse struct {
 }
 
 type DestroyRouterParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DestroyRouterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DestroyRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DestroyRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDestroyRouterParams parses url.Values, for example taken from a raw API request,
+// into a new DestroyRouterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDestroyRouterParams(u url.Values) (*DestroyRouterParams, error) {
+	p := &DestroyRouterParams{}
+	if err := checkParamNames("destroyRouter", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DestroyRouterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DestroyRouterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DestroyRouterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DestroyRouterParams) Clone() *DestroyRouterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DestroyRouterParams) Equal(o *DestroyRouterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDestroyRouterParams is used to (un)marshal DestroyRouterParams using the API param names
+type serializedDestroyRouterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DestroyRouterParams) toSerialized() *serializedDestroyRouterParams {
+	s := &serializedDestroyRouterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DestroyRouterParams) fromSerialized(s *serializedDestroyRouterParams) {
+	*p = DestroyRouterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DestroyRouterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DestroyRouterParams) UnmarshalJSON(b []byte) error {
+	var s serializedDestroyRouterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DestroyRouterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DestroyRouterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDestroyRouterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DestroyRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
 	p := &DestroyRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Destroys a router.
-func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
-	resp, err := s.cs.newRequest("destroyRouter", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *RouterService) DestroyRouter(p *DestroyRouterParams, opts ...CallOption) (*DestroyRouterResponse, error) {
+	resp, err := s.cs.newRequest("destroyRouter", s.cs.encodeParams("destroyRouter", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -497,8 +837,8 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -586,542 +926,759 @@ type DestroyRouterResponseHealthcheckresults struct {
 }
 
 type ListRoutersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListRoutersParams) toURLValues() url.Values {
+	account                 optString
+	clusterid               optString
+	domainid                optString
+	fetchhealthcheckresults optBool
+	forvpc                  optBool
+	healthchecksfailed      optBool
+	hostid                  optString
+	id                      optString
+	isrecursive             optBool
+	keyword                 optString
+	listall                 optBool
+	name                    optString
+	networkid               optString
+	page                    optInt
+	pagesize                optInt
+	podid                   optString
+	projectid               optString
+	state                   optString
+	version                 optString
+	vpcid                   optString
+	zoneid                  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListRoutersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fetchhealthcheckresults"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fetchhealthcheckresults", vv)
+	if p.fetchhealthcheckresults.ok {
+		u.Set("fetchhealthcheckresults", strconv.FormatBool(p.fetchhealthcheckresults.v))
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvpc", vv)
+	if p.forvpc.ok {
+		u.Set("forvpc", strconv.FormatBool(p.forvpc.v))
 	}
-	if v, found := p.p["healthchecksfailed"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("healthchecksfailed", vv)
+	if p.healthchecksfailed.ok {
+		u.Set("healthchecksfailed", strconv.FormatBool(p.healthchecksfailed.v))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["version"]; found {
-		u.Set("version", v.(string))
+	if p.version.ok {
+		u.Set("version", p.version.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListRoutersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListRoutersParams parses url.Values, for example taken from a raw API request,
+// into a new ListRoutersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListRoutersParams(u url.Values) (*ListRoutersParams, error) {
+	p := &ListRoutersParams{}
+	if err := checkParamNames("listRouters", u, "account", "clusterid", "domainid", "fetchhealthcheckresults", "forvpc", "healthchecksfailed", "hostid", "id", "isrecursive", "keyword", "listall", "name", "networkid", "page", "pagesize", "podid", "projectid", "state", "version", "vpcid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fetchhealthcheckresults"]; found {
+		v, err := strconv.ParseBool(u.Get("fetchhealthcheckresults"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fetchhealthcheckresults: %v", err)
+		}
+		p.SetFetchhealthcheckresults(v)
+	}
+	if _, found := u["forvpc"]; found {
+		v, err := strconv.ParseBool(u.Get("forvpc"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvpc: %v", err)
+		}
+		p.SetForvpc(v)
+	}
+	if _, found := u["healthchecksfailed"]; found {
+		v, err := strconv.ParseBool(u.Get("healthchecksfailed"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param healthchecksfailed: %v", err)
+		}
+		p.SetHealthchecksfailed(v)
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["account"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["version"]; found {
+		p.SetVersion(u.Get("version"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListRoutersParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListRoutersParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListRoutersParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ListRoutersParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListRoutersParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListRoutersParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListRoutersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListRoutersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListRoutersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFetchhealthcheckresults sets the fetchhealthcheckresults param.
 func (p *ListRoutersParams) SetFetchhealthcheckresults(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fetchhealthcheckresults"] = v
+	p.fetchhealthcheckresults = optBool{v: v, ok: true}
 }
 
+// ResetFetchhealthcheckresults unsets the fetchhealthcheckresults param
 func (p *ListRoutersParams) ResetFetchhealthcheckresults() {
-	if p.p != nil && p.p["fetchhealthcheckresults"] != nil {
-		delete(p.p, "fetchhealthcheckresults")
-	}
+	p.fetchhealthcheckresults = optBool{}
 }
 
+// GetFetchhealthcheckresults returns the fetchhealthcheckresults param and if it is set
 func (p *ListRoutersParams) GetFetchhealthcheckresults() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fetchhealthcheckresults"].(bool)
-	return value, ok
+	return p.fetchhealthcheckresults.v, p.fetchhealthcheckresults.ok
 }
 
+// SetForvpc sets the forvpc param.
 func (p *ListRoutersParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
+	p.forvpc = optBool{v: v, ok: true}
 }
 
+// ResetForvpc unsets the forvpc param
 func (p *ListRoutersParams) ResetForvpc() {
-	if p.p != nil && p.p["forvpc"] != nil {
-		delete(p.p, "forvpc")
-	}
+	p.forvpc = optBool{}
 }
 
+// GetForvpc returns the forvpc param and if it is set
 func (p *ListRoutersParams) GetForvpc() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvpc"].(bool)
-	return value, ok
+	return p.forvpc.v, p.forvpc.ok
 }
 
+// SetHealthchecksfailed sets the healthchecksfailed param.
 func (p *ListRoutersParams) SetHealthchecksfailed(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["healthchecksfailed"] = v
+	p.healthchecksfailed = optBool{v: v, ok: true}
 }
 
+// ResetHealthchecksfailed unsets the healthchecksfailed param
 func (p *ListRoutersParams) ResetHealthchecksfailed() {
-	if p.p != nil && p.p["healthchecksfailed"] != nil {
-		delete(p.p, "healthchecksfailed")
-	}
+	p.healthchecksfailed = optBool{}
 }
 
+// GetHealthchecksfailed returns the healthchecksfailed param and if it is set
 func (p *ListRoutersParams) GetHealthchecksfailed() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["healthchecksfailed"].(bool)
-	return value, ok
+	return p.healthchecksfailed.v, p.healthchecksfailed.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListRoutersParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListRoutersParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListRoutersParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param.
 func (p *ListRoutersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListRoutersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListRoutersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListRoutersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListRoutersParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListRoutersParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListRoutersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListRoutersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListRoutersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListRoutersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListRoutersParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListRoutersParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListRoutersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListRoutersParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListRoutersParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListRoutersParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListRoutersParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListRoutersParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListRoutersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListRoutersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListRoutersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListRoutersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListRoutersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListRoutersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListRoutersParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListRoutersParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListRoutersParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListRoutersParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListRoutersParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListRoutersParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetState sets the state param.
 func (p *ListRoutersParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListRoutersParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListRoutersParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetVersion sets the version param.
 func (p *ListRoutersParams) SetVersion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["version"] = v
+	p.version = optString{v: v, ok: true}
 }
 
+// ResetVersion unsets the version param
 func (p *ListRoutersParams) ResetVersion() {
-	if p.p != nil && p.p["version"] != nil {
-		delete(p.p, "version")
-	}
+	p.version = optString{}
 }
 
+// GetVersion returns the version param and if it is set
 func (p *ListRoutersParams) GetVersion() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["version"].(string)
-	return value, ok
+	return p.version.v, p.version.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListRoutersParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListRoutersParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListRoutersParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListRoutersParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListRoutersParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListRoutersParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListRoutersParams) Clone() *ListRoutersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListRoutersParams) Equal(o *ListRoutersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.clusterid == o.clusterid &&
+		p.domainid == o.domainid &&
+		p.fetchhealthcheckresults == o.fetchhealthcheckresults &&
+		p.forvpc == o.forvpc &&
+		p.healthchecksfailed == o.healthchecksfailed &&
+		p.hostid == o.hostid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.state == o.state &&
+		p.version == o.version &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListRoutersParams is used to (un)marshal ListRoutersParams using the API param names
+type serializedListRoutersParams struct {
+	Account                 *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid               *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid                *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fetchhealthcheckresults *bool   `json:"fetchhealthcheckresults,omitempty" yaml:"fetchhealthcheckresults,omitempty"`
+	Forvpc                  *bool   `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Healthchecksfailed      *bool   `json:"healthchecksfailed,omitempty" yaml:"healthchecksfailed,omitempty"`
+	Hostid                  *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id                      *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive             *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword                 *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                 *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name                    *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid               *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page                    *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                   *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid               *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State                   *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Version                 *string `json:"version,omitempty" yaml:"version,omitempty"`
+	Vpcid                   *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid                  *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListRoutersParams) toSerialized() *serializedListRoutersParams {
+	s := &serializedListRoutersParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fetchhealthcheckresults.ok {
+		s.Fetchhealthcheckresults = &p.fetchhealthcheckresults.v
+	}
+	if p.forvpc.ok {
+		s.Forvpc = &p.forvpc.v
+	}
+	if p.healthchecksfailed.ok {
+		s.Healthchecksfailed = &p.healthchecksfailed.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.version.ok {
+		s.Version = &p.version.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListRoutersParams) fromSerialized(s *serializedListRoutersParams) {
+	*p = ListRoutersParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fetchhealthcheckresults != nil {
+		p.SetFetchhealthcheckresults(*s.Fetchhealthcheckresults)
+	}
+	if s.Forvpc != nil {
+		p.SetForvpc(*s.Forvpc)
+	}
+	if s.Healthchecksfailed != nil {
+		p.SetHealthchecksfailed(*s.Healthchecksfailed)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Version != nil {
+		p.SetVersion(*s.Version)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListRoutersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListRoutersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListRoutersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListRoutersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListRoutersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListRoutersParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListRoutersParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewListRoutersParams() *ListRoutersParams {
 	p := &ListRoutersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRoutersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1169,9 +1726,8 @@ func (s *RouterService) GetRouterByName(name string, opts ...OptionFunc) (*Route
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error) {
 	p := &ListRoutersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1200,8 +1756,8 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 }
 
 // List routers.
-func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
-	resp, err := s.cs.newRequest("listRouters", p.toURLValues())
+func (s *RouterService) ListRouters(p *ListRoutersParams, opts ...CallOption) (*ListRoutersResponse, error) {
+	resp, err := s.cs.newRequest("listRouters", s.cs.encodeParams("listRouters", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1286,178 +1842,293 @@ type RouterHealthcheckresults struct {
 }
 
 type ListVirtualRouterElementsParams struct {
-	p map[string]interface{}
+	enabled  optBool
+	id       optString
+	keyword  optString
+	nspid    optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVirtualRouterElementsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
+	if p.enabled.ok {
+		u.Set("enabled", strconv.FormatBool(p.enabled.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
+	if p.nspid.ok {
+		u.Set("nspid", p.nspid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVirtualRouterElementsParams parses url.Values, for example taken from a raw API request,
+// into a new ListVirtualRouterElementsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVirtualRouterElementsParams(u url.Values) (*ListVirtualRouterElementsParams, error) {
+	p := &ListVirtualRouterElementsParams{}
+	if err := checkParamNames("listVirtualRouterElements", u, "enabled", "id", "keyword", "nspid", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enabled"]; found {
+		v, err := strconv.ParseBool(u.Get("enabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enabled: %v", err)
+		}
+		p.SetEnabled(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["nspid"]; found {
+		p.SetNspid(u.Get("nspid"))
 	}
-	p.p["enabled"] = v
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetEnabled sets the enabled param.
+func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
+	p.enabled = optBool{v: v, ok: true}
 }
 
+// ResetEnabled unsets the enabled param
 func (p *ListVirtualRouterElementsParams) ResetEnabled() {
-	if p.p != nil && p.p["enabled"] != nil {
-		delete(p.p, "enabled")
-	}
+	p.enabled = optBool{}
 }
 
+// GetEnabled returns the enabled param and if it is set
 func (p *ListVirtualRouterElementsParams) GetEnabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enabled"].(bool)
-	return value, ok
+	return p.enabled.v, p.enabled.ok
 }
 
+// SetId sets the id param.
 func (p *ListVirtualRouterElementsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVirtualRouterElementsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVirtualRouterElementsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVirtualRouterElementsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVirtualRouterElementsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVirtualRouterElementsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNspid sets the nspid param.
 func (p *ListVirtualRouterElementsParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nspid"] = v
+	p.nspid = optString{v: v, ok: true}
 }
 
+// ResetNspid unsets the nspid param
 func (p *ListVirtualRouterElementsParams) ResetNspid() {
-	if p.p != nil && p.p["nspid"] != nil {
-		delete(p.p, "nspid")
-	}
+	p.nspid = optString{}
 }
 
+// GetNspid returns the nspid param and if it is set
 func (p *ListVirtualRouterElementsParams) GetNspid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nspid"].(string)
-	return value, ok
+	return p.nspid.v, p.nspid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVirtualRouterElementsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVirtualRouterElementsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVirtualRouterElementsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVirtualRouterElementsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVirtualRouterElementsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVirtualRouterElementsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVirtualRouterElementsParams) Clone() *ListVirtualRouterElementsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVirtualRouterElementsParams) Equal(o *ListVirtualRouterElementsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enabled == o.enabled &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.nspid == o.nspid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListVirtualRouterElementsParams is used to (un)marshal ListVirtualRouterElementsParams using the API param names
+type serializedListVirtualRouterElementsParams struct {
+	Enabled  *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nspid    *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListVirtualRouterElementsParams) toSerialized() *serializedListVirtualRouterElementsParams {
+	s := &serializedListVirtualRouterElementsParams{}
+	if p.enabled.ok {
+		s.Enabled = &p.enabled.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.nspid.ok {
+		s.Nspid = &p.nspid.v
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListVirtualRouterElementsParams) fromSerialized(s *serializedListVirtualRouterElementsParams) {
+	*p = ListVirtualRouterElementsParams{}
+	if s.Enabled != nil {
+		p.SetEnabled(*s.Enabled)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Nspid != nil {
+		p.SetNspid(*s.Nspid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVirtualRouterElementsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVirtualRouterElementsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVirtualRouterElementsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVirtualRouterElementsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVirtualRouterElementsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVirtualRouterElementsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVirtualRouterElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams {
 	p := &ListVirtualRouterElementsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
 	p := &ListVirtualRouterElementsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1486,8 +2157,8 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 }
 
 // Lists all available virtual router elements.
-func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualRouterElements", p.toURLValues())
+func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams, opts ...CallOption) (*ListVirtualRouterElementsResponse, error) {
+	resp, err := s.cs.newRequest("listVirtualRouterElements", s.cs.encodeParams("listVirtualRouterElements", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1519,78 +2190,167 @@ type VirtualRouterElement struct {
 }
 
 type RebootRouterParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *RebootRouterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RebootRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RebootRouterParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRebootRouterParams parses url.Values, for example taken from a raw API request,
+// into a new RebootRouterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRebootRouterParams(u url.Values) (*RebootRouterParams, error) {
+	p := &RebootRouterParams{}
+	if err := checkParamNames("rebootRouter", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["forced"] = v
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *RebootRouterParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *RebootRouterParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *RebootRouterParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *RebootRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RebootRouterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RebootRouterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RebootRouterParams) Clone() *RebootRouterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RebootRouterParams) Equal(o *RebootRouterParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedRebootRouterParams is used to (un)marshal RebootRouterParams using the API param names
+type serializedRebootRouterParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RebootRouterParams) toSerialized() *serializedRebootRouterParams {
+	s := &serializedRebootRouterParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RebootRouterParams) fromSerialized(s *serializedRebootRouterParams) {
+	*p = RebootRouterParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RebootRouterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RebootRouterParams) UnmarshalJSON(b []byte) error {
+	var s serializedRebootRouterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RebootRouterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RebootRouterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRebootRouterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RebootRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
 	p := &RebootRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Starts a router.
-func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
-	resp, err := s.cs.newRequest("rebootRouter", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *RouterService) RebootRouter(p *RebootRouterParams, opts ...CallOption) (*RebootRouterResponse, error) {
+	resp, err := s.cs.newRequest("rebootRouter", s.cs.encodeParams("rebootRouter", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1601,8 +2361,8 @@ func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1690,53 +2450,133 @@ type RebootRouterResponseHealthcheckresults struct {
 }
 
 type StartRouterParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *StartRouterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StartRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StartRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStartRouterParams parses url.Values, for example taken from a raw API request,
+// into a new StartRouterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStartRouterParams(u url.Values) (*StartRouterParams, error) {
+	p := &StartRouterParams{}
+	if err := checkParamNames("startRouter", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *StartRouterParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *StartRouterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StartRouterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StartRouterParams) Clone() *StartRouterParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StartRouterParams) Equal(o *StartRouterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedStartRouterParams is used to (un)marshal StartRouterParams using the API param names
+type serializedStartRouterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StartRouterParams) toSerialized() *serializedStartRouterParams {
+	s := &serializedStartRouterParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *StartRouterParams) fromSerialized(s *serializedStartRouterParams) {
+	*p = StartRouterParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StartRouterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StartRouterParams) UnmarshalJSON(b []byte) error {
+	var s serializedStartRouterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StartRouterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StartRouterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStartRouterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StartRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
 	p := &StartRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Starts a router.
-func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
-	resp, err := s.cs.newRequest("startRouter", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *RouterService) StartRouter(p *StartRouterParams, opts ...CallOption) (*StartRouterResponse, error) {
+	resp, err := s.cs.newRequest("startRouter", s.cs.encodeParams("startRouter", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1747,8 +2587,8 @@ func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse,
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1836,78 +2676,167 @@ type StartRouterResponseHealthcheckresults struct {
 }
 
 type StopRouterParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *StopRouterParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StopRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StopRouterParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStopRouterParams parses url.Values, for example taken from a raw API request,
+// into a new StopRouterParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStopRouterParams(u url.Values) (*StopRouterParams, error) {
+	p := &StopRouterParams{}
+	if err := checkParamNames("stopRouter", u, "forced", "id"); err != nil {
+		return nil, err
 	}
-	p.p["forced"] = v
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *StopRouterParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *StopRouterParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *StopRouterParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *StopRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *StopRouterParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StopRouterParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StopRouterParams) Clone() *StopRouterParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StopRouterParams) Equal(o *StopRouterParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedStopRouterParams is used to (un)marshal StopRouterParams using the API param names
+type serializedStopRouterParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StopRouterParams) toSerialized() *serializedStopRouterParams {
+	s := &serializedStopRouterParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *StopRouterParams) fromSerialized(s *serializedStopRouterParams) {
+	*p = StopRouterParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StopRouterParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StopRouterParams) UnmarshalJSON(b []byte) error {
+	var s serializedStopRouterParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StopRouterParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StopRouterParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStopRouterParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StopRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
 	p := &StopRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Stops a router.
-func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
-	resp, err := s.cs.newRequest("stopRouter", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *RouterService) StopRouter(p *StopRouterParams, opts ...CallOption) (*StopRouterResponse, error) {
+	resp, err := s.cs.newRequest("stopRouter", s.cs.encodeParams("stopRouter", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1918,8 +2847,8 @@ func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, er
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,63 +53,83 @@ func (m *MockRouterServiceIface) EXPECT() *MockRouterServiceIfaceMockRecorder {
 }
 
 // ChangeServiceForRouter mocks base method.
-func (m *MockRouterServiceIface) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+func (m *MockRouterServiceIface) ChangeServiceForRouter(p *ChangeServiceForRouterParams, opts ...CallOption) (*ChangeServiceForRouterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeServiceForRouter", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeServiceForRouter", varargs...)
 	ret0, _ := ret[0].(*ChangeServiceForRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeServiceForRouter indicates an expected call of ChangeServiceForRouter.
-func (mr *MockRouterServiceIfaceMockRecorder) ChangeServiceForRouter(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) ChangeServiceForRouter(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).ChangeServiceForRouter), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).ChangeServiceForRouter), varargs...)
 }
 
 // ConfigureVirtualRouterElement mocks base method.
-func (m *MockRouterServiceIface) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
+func (m *MockRouterServiceIface) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams, opts ...CallOption) (*VirtualRouterElementResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigureVirtualRouterElement", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfigureVirtualRouterElement", varargs...)
 	ret0, _ := ret[0].(*VirtualRouterElementResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfigureVirtualRouterElement indicates an expected call of ConfigureVirtualRouterElement.
-func (mr *MockRouterServiceIfaceMockRecorder) ConfigureVirtualRouterElement(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) ConfigureVirtualRouterElement(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureVirtualRouterElement", reflect.TypeOf((*MockRouterServiceIface)(nil).ConfigureVirtualRouterElement), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureVirtualRouterElement", reflect.TypeOf((*MockRouterServiceIface)(nil).ConfigureVirtualRouterElement), varargs...)
 }
 
 // CreateVirtualRouterElement mocks base method.
-func (m *MockRouterServiceIface) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
+func (m *MockRouterServiceIface) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams, opts ...CallOption) (*CreateVirtualRouterElementResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVirtualRouterElement", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVirtualRouterElement", varargs...)
 	ret0, _ := ret[0].(*CreateVirtualRouterElementResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVirtualRouterElement indicates an expected call of CreateVirtualRouterElement.
-func (mr *MockRouterServiceIfaceMockRecorder) CreateVirtualRouterElement(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) CreateVirtualRouterElement(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualRouterElement", reflect.TypeOf((*MockRouterServiceIface)(nil).CreateVirtualRouterElement), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualRouterElement", reflect.TypeOf((*MockRouterServiceIface)(nil).CreateVirtualRouterElement), varargs...)
 }
 
 // DestroyRouter mocks base method.
-func (m *MockRouterServiceIface) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
+func (m *MockRouterServiceIface) DestroyRouter(p *DestroyRouterParams, opts ...CallOption) (*DestroyRouterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroyRouter", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DestroyRouter", varargs...)
 	ret0, _ := ret[0].(*DestroyRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DestroyRouter indicates an expected call of DestroyRouter.
-func (mr *MockRouterServiceIfaceMockRecorder) DestroyRouter(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) DestroyRouter(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).DestroyRouter), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).DestroyRouter), varargs...)
 }
 
 // GetRouterByID mocks base method.
@@ -197,33 +217,43 @@ func (mr *MockRouterServiceIfaceMockRecorder) GetVirtualRouterElementByID(id int
 }
 
 // ListRouters mocks base method.
-func (m *MockRouterServiceIface) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
+func (m *MockRouterServiceIface) ListRouters(p *ListRoutersParams, opts ...CallOption) (*ListRoutersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRouters", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRouters", varargs...)
 	ret0, _ := ret[0].(*ListRoutersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRouters indicates an expected call of ListRouters.
-func (mr *MockRouterServiceIfaceMockRecorder) ListRouters(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) ListRouters(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRouters", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRouters), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRouters", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRouters), varargs...)
 }
 
 // ListVirtualRouterElements mocks base method.
-func (m *MockRouterServiceIface) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+func (m *MockRouterServiceIface) ListVirtualRouterElements(p *ListVirtualRouterElementsParams, opts ...CallOption) (*ListVirtualRouterElementsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVirtualRouterElements", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualRouterElements", varargs...)
 	ret0, _ := ret[0].(*ListVirtualRouterElementsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVirtualRouterElements indicates an expected call of ListVirtualRouterElements.
-func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElements(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElements(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElements", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElements), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElements", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElements), varargs...)
 }
 
 // NewChangeServiceForRouterParams mocks base method.
@@ -353,46 +383,61 @@ func (mr *MockRouterServiceIfaceMockRecorder) NewStopRouterParams(id interface{}
 }
 
 // RebootRouter mocks base method.
-func (m *MockRouterServiceIface) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
+func (m *MockRouterServiceIface) RebootRouter(p *RebootRouterParams, opts ...CallOption) (*RebootRouterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RebootRouter", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebootRouter", varargs...)
 	ret0, _ := ret[0].(*RebootRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RebootRouter indicates an expected call of RebootRouter.
-func (mr *MockRouterServiceIfaceMockRecorder) RebootRouter(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) RebootRouter(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).RebootRouter), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).RebootRouter), varargs...)
 }
 
 // StartRouter mocks base method.
-func (m *MockRouterServiceIface) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
+func (m *MockRouterServiceIface) StartRouter(p *StartRouterParams, opts ...CallOption) (*StartRouterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartRouter", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartRouter", varargs...)
 	ret0, _ := ret[0].(*StartRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartRouter indicates an expected call of StartRouter.
-func (mr *MockRouterServiceIfaceMockRecorder) StartRouter(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) StartRouter(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).StartRouter), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).StartRouter), varargs...)
 }
 
 // StopRouter mocks base method.
-func (m *MockRouterServiceIface) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
+func (m *MockRouterServiceIface) StopRouter(p *StopRouterParams, opts ...CallOption) (*StopRouterResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopRouter", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopRouter", varargs...)
 	ret0, _ := ret[0].(*StopRouterResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StopRouter indicates an expected call of StopRouter.
-func (mr *MockRouterServiceIfaceMockRecorder) StopRouter(p interface{}) *gomock.Call {
+func (mr *MockRouterServiceIfaceMockRecorder) StopRouter(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).StopRouter), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).StopRouter), varargs...)
 }
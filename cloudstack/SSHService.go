@@ -28,141 +28,238 @@ import (
 )
 
 type SSHServiceIface interface {
-	CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPair(p *CreateSSHKeyPairParams, opts ...CallOption) (*CreateSSHKeyPairResponse, error)
 	NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams
-	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams, opts ...CallOption) (*DeleteSSHKeyPairResponse, error)
 	NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams
-	ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairs(p *ListSSHKeyPairsParams, opts ...CallOption) (*ListSSHKeyPairsResponse, error)
 	NewListSSHKeyPairsParams() *ListSSHKeyPairsParams
 	GetSSHKeyPairID(name string, opts ...OptionFunc) (string, int, error)
 	GetSSHKeyPairByName(name string, opts ...OptionFunc) (*SSHKeyPair, int, error)
 	GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPair, int, error)
-	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams, opts ...CallOption) (*RegisterSSHKeyPairResponse, error)
 	NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams
-	ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams, opts ...CallOption) (*ResetSSHKeyForVirtualMachineResponse, error)
 	NewResetSSHKeyForVirtualMachineParams(id string) *ResetSSHKeyForVirtualMachineParams
 }
 
 type CreateSSHKeyPairParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	name      optString
+	projectid optString
 }
 
-func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateSSHKeyPairParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *CreateSSHKeyPairParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateSSHKeyPairParams parses url.Values, for example taken from a raw API request,
+// into a new CreateSSHKeyPairParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateSSHKeyPairParams(u url.Values) (*CreateSSHKeyPairParams, error) {
+	p := &CreateSSHKeyPairParams{}
+	if err := checkParamNames("createSSHKeyPair", u, "account", "domainid", "name", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["account"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateSSHKeyPairParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateSSHKeyPairParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateSSHKeyPairParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateSSHKeyPairParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateSSHKeyPairParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateSSHKeyPairParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateSSHKeyPairParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateSSHKeyPairParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateSSHKeyPairParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateSSHKeyPairParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateSSHKeyPairParams) Clone() *CreateSSHKeyPairParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateSSHKeyPairParams) Equal(o *CreateSSHKeyPairParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.projectid == o.projectid
+}
+
+// serializedCreateSSHKeyPairParams is used to (un)marshal CreateSSHKeyPairParams using the API param names
+type serializedCreateSSHKeyPairParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *CreateSSHKeyPairParams) toSerialized() *serializedCreateSSHKeyPairParams {
+	s := &serializedCreateSSHKeyPairParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *CreateSSHKeyPairParams) fromSerialized(s *serializedCreateSSHKeyPairParams) {
+	*p = CreateSSHKeyPairParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateSSHKeyPairParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateSSHKeyPairParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateSSHKeyPairParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateSSHKeyPairParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams {
 	p := &CreateSSHKeyPairParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
-// Create a new keypair and returns the private key
-func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("createSSHKeyPair", p.toURLValues())
+// Create a new keypair and returns the private key.
+//
+// Required params: name.
+func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams, opts ...CallOption) (*CreateSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequest("createSSHKeyPair", s.cs.encodeParams("createSSHKeyPair", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -195,125 +292,222 @@ type CreateSSHKeyPairResponse struct {
 }
 
 type DeleteSSHKeyPairParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	name      optString
+	projectid optString
 }
 
-func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteSSHKeyPairParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteSSHKeyPairParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteSSHKeyPairParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteSSHKeyPairParams(u url.Values) (*DeleteSSHKeyPairParams, error) {
+	p := &DeleteSSHKeyPairParams{}
+	if err := checkParamNames("deleteSSHKeyPair", u, "account", "domainid", "name", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	p.p["account"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *DeleteSSHKeyPairParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DeleteSSHKeyPairParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *DeleteSSHKeyPairParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DeleteSSHKeyPairParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DeleteSSHKeyPairParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *DeleteSSHKeyPairParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *DeleteSSHKeyPairParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *DeleteSSHKeyPairParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *DeleteSSHKeyPairParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DeleteSSHKeyPairParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DeleteSSHKeyPairParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteSSHKeyPairParams) Clone() *DeleteSSHKeyPairParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteSSHKeyPairParams) Equal(o *DeleteSSHKeyPairParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.projectid == o.projectid
+}
+
+// serializedDeleteSSHKeyPairParams is used to (un)marshal DeleteSSHKeyPairParams using the API param names
+type serializedDeleteSSHKeyPairParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *DeleteSSHKeyPairParams) toSerialized() *serializedDeleteSSHKeyPairParams {
+	s := &serializedDeleteSSHKeyPairParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *DeleteSSHKeyPairParams) fromSerialized(s *serializedDeleteSSHKeyPairParams) {
+	*p = DeleteSSHKeyPairParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteSSHKeyPairParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteSSHKeyPairParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteSSHKeyPairParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteSSHKeyPairParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams {
 	p := &DeleteSSHKeyPairParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
-// Deletes a keypair by name
-func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("deleteSSHKeyPair", p.toURLValues())
+// Deletes a keypair by name.
+//
+// Required params: name.
+func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams, opts ...CallOption) (*DeleteSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequest("deleteSSHKeyPair", s.cs.encodeParams("deleteSSHKeyPair", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -361,299 +555,447 @@ func (r *DeleteSSHKeyPairResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListSSHKeyPairsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fingerprint optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSSHKeyPairsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fingerprint"]; found {
-		u.Set("fingerprint", v.(string))
+	if p.fingerprint.ok {
+		u.Set("fingerprint", p.fingerprint.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *ListSSHKeyPairsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSSHKeyPairsParams parses url.Values, for example taken from a raw API request,
+// into a new ListSSHKeyPairsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSSHKeyPairsParams(u url.Values) (*ListSSHKeyPairsParams, error) {
+	p := &ListSSHKeyPairsParams{}
+	if err := checkParamNames("listSSHKeyPairs", u, "account", "domainid", "fingerprint", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fingerprint"]; found {
+		p.SetFingerprint(u.Get("fingerprint"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListSSHKeyPairsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListSSHKeyPairsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListSSHKeyPairsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListSSHKeyPairsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListSSHKeyPairsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFingerprint sets the fingerprint param.
 func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fingerprint"] = v
+	p.fingerprint = optString{v: v, ok: true}
 }
 
+// ResetFingerprint unsets the fingerprint param
 func (p *ListSSHKeyPairsParams) ResetFingerprint() {
-	if p.p != nil && p.p["fingerprint"] != nil {
-		delete(p.p, "fingerprint")
-	}
+	p.fingerprint = optString{}
 }
 
+// GetFingerprint returns the fingerprint param and if it is set
 func (p *ListSSHKeyPairsParams) GetFingerprint() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fingerprint"].(string)
-	return value, ok
+	return p.fingerprint.v, p.fingerprint.ok
 }
 
+// SetId sets the id param.
 func (p *ListSSHKeyPairsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListSSHKeyPairsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListSSHKeyPairsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListSSHKeyPairsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListSSHKeyPairsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListSSHKeyPairsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSSHKeyPairsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListSSHKeyPairsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListSSHKeyPairsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListSSHKeyPairsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListSSHKeyPairsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListSSHKeyPairsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListSSHKeyPairsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSSHKeyPairsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSSHKeyPairsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSSHKeyPairsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSSHKeyPairsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSSHKeyPairsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListSSHKeyPairsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListSSHKeyPairsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSSHKeyPairsParams) Clone() *ListSSHKeyPairsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSSHKeyPairsParams) Equal(o *ListSSHKeyPairsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fingerprint == o.fingerprint &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid
+}
+
+// serializedListSSHKeyPairsParams is used to (un)marshal ListSSHKeyPairsParams using the API param names
+type serializedListSSHKeyPairsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *ListSSHKeyPairsParams) toSerialized() *serializedListSSHKeyPairsParams {
+	s := &serializedListSSHKeyPairsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fingerprint.ok {
+		s.Fingerprint = &p.fingerprint.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *ListSSHKeyPairsParams) fromSerialized(s *serializedListSSHKeyPairsParams) {
+	*p = ListSSHKeyPairsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fingerprint != nil {
+		p.SetFingerprint(*s.Fingerprint)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSSHKeyPairsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSSHKeyPairsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSSHKeyPairsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSSHKeyPairsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSSHKeyPairsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSSHKeyPairsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSSHKeyPairsParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
 	p := &ListSSHKeyPairsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SSHService) GetSSHKeyPairID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSSHKeyPairsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -701,9 +1043,8 @@ func (s *SSHService) GetSSHKeyPairByName(name string, opts ...OptionFunc) (*SSHK
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SSHService) GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
 	p := &ListSSHKeyPairsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -731,9 +1072,9 @@ func (s *SSHService) GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPa
 	return nil, l.Count, fmt.Errorf("There is more then one result for SSHKeyPair UUID: %s!", id)
 }
 
-// List registered keypairs
-func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
-	resp, err := s.cs.newRequest("listSSHKeyPairs", p.toURLValues())
+// List registered keypairs.
+func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams, opts ...CallOption) (*ListSSHKeyPairsResponse, error) {
+	resp, err := s.cs.newRequest("listSSHKeyPairs", s.cs.encodeParams("listSSHKeyPairs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -766,150 +1107,253 @@ type SSHKeyPair struct {
 }
 
 type RegisterSSHKeyPairParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	name      optString
+	projectid optString
+	publickey optString
 }
 
-func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RegisterSSHKeyPairParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["publickey"]; found {
-		u.Set("publickey", v.(string))
+	if p.publickey.ok {
+		u.Set("publickey", p.publickey.v)
 	}
 	return u
 }
 
-func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRegisterSSHKeyPairParams parses url.Values, for example taken from a raw API request,
+// into a new RegisterSSHKeyPairParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRegisterSSHKeyPairParams(u url.Values) (*RegisterSSHKeyPairParams, error) {
+	p := &RegisterSSHKeyPairParams{}
+	if err := checkParamNames("registerSSHKeyPair", u, "account", "domainid", "name", "projectid", "publickey"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["publickey"]; found {
+		p.SetPublickey(u.Get("publickey"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *RegisterSSHKeyPairParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *RegisterSSHKeyPairParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *RegisterSSHKeyPairParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *RegisterSSHKeyPairParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *RegisterSSHKeyPairParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *RegisterSSHKeyPairParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *RegisterSSHKeyPairParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *RegisterSSHKeyPairParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *RegisterSSHKeyPairParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *RegisterSSHKeyPairParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *RegisterSSHKeyPairParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetPublickey sets the publickey param. This param is required.
 func (p *RegisterSSHKeyPairParams) SetPublickey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publickey"] = v
+	p.publickey = optString{v: v, ok: true}
 }
 
+// ResetPublickey unsets the publickey param
 func (p *RegisterSSHKeyPairParams) ResetPublickey() {
-	if p.p != nil && p.p["publickey"] != nil {
-		delete(p.p, "publickey")
-	}
+	p.publickey = optString{}
 }
 
+// GetPublickey returns the publickey param and if it is set
 func (p *RegisterSSHKeyPairParams) GetPublickey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.publickey.v, p.publickey.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RegisterSSHKeyPairParams) Clone() *RegisterSSHKeyPairParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RegisterSSHKeyPairParams) Equal(o *RegisterSSHKeyPairParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.projectid == o.projectid &&
+		p.publickey == o.publickey
+}
+
+// serializedRegisterSSHKeyPairParams is used to (un)marshal RegisterSSHKeyPairParams using the API param names
+type serializedRegisterSSHKeyPairParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publickey *string `json:"publickey,omitempty" yaml:"publickey,omitempty"`
+}
+
+func (p *RegisterSSHKeyPairParams) toSerialized() *serializedRegisterSSHKeyPairParams {
+	s := &serializedRegisterSSHKeyPairParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.publickey.ok {
+		s.Publickey = &p.publickey.v
+	}
+	return s
+}
+
+func (p *RegisterSSHKeyPairParams) fromSerialized(s *serializedRegisterSSHKeyPairParams) {
+	*p = RegisterSSHKeyPairParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Publickey != nil {
+		p.SetPublickey(*s.Publickey)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RegisterSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RegisterSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	var s serializedRegisterSSHKeyPairParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["publickey"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RegisterSSHKeyPairParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RegisterSSHKeyPairParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRegisterSSHKeyPairParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RegisterSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams {
 	p := &RegisterSSHKeyPairParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["publickey"] = publickey
+	p.SetName(name)
+	p.SetPublickey(publickey)
 	return p
 }
 
-// Register a public key in a keypair under a certain name
-func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("registerSSHKeyPair", p.toURLValues())
+// Register a public key in a keypair under a certain name.
+//
+// Required params: name, publickey.
+func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams, opts ...CallOption) (*RegisterSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequest("registerSSHKeyPair", s.cs.encodeParams("registerSSHKeyPair", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -941,174 +1385,285 @@ type RegisterSSHKeyPairResponse struct {
 }
 
 type ResetSSHKeyForVirtualMachineParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	id        optString
+	keypair   optString
+	keypairs  optStrings
+	projectid optString
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResetSSHKeyForVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keypair"]; found {
-		u.Set("keypair", v.(string))
+	if p.keypair.ok {
+		u.Set("keypair", p.keypair.v)
 	}
-	if v, found := p.p["keypairs"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("keypairs", vv)
+	if p.keypairs.ok {
+		u.Set("keypairs", strings.Join(p.keypairs.v, ","))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseResetSSHKeyForVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new ResetSSHKeyForVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResetSSHKeyForVirtualMachineParams(u url.Values) (*ResetSSHKeyForVirtualMachineParams, error) {
+	p := &ResetSSHKeyForVirtualMachineParams{}
+	if err := checkParamNames("resetSSHKeyForVirtualMachine", u, "account", "domainid", "id", "keypair", "keypairs", "projectid"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keypair"]; found {
+		p.SetKeypair(u.Get("keypair"))
+	}
+	if _, found := u["keypairs"]; found {
+		p.SetKeypairs(strings.Split(u.Get("keypairs"), ","))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ResetSSHKeyForVirtualMachineParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ResetSSHKeyForVirtualMachineParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ResetSSHKeyForVirtualMachineParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ResetSSHKeyForVirtualMachineParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ResetSSHKeyForVirtualMachineParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ResetSSHKeyForVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ResetSSHKeyForVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ResetSSHKeyForVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeypair sets the keypair param.
 func (p *ResetSSHKeyForVirtualMachineParams) SetKeypair(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypair"] = v
+	p.keypair = optString{v: v, ok: true}
 }
 
+// ResetKeypair unsets the keypair param
 func (p *ResetSSHKeyForVirtualMachineParams) ResetKeypair() {
-	if p.p != nil && p.p["keypair"] != nil {
-		delete(p.p, "keypair")
-	}
+	p.keypair = optString{}
 }
 
+// GetKeypair returns the keypair param and if it is set
 func (p *ResetSSHKeyForVirtualMachineParams) GetKeypair() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keypair"].(string)
-	return value, ok
+	return p.keypair.v, p.keypair.ok
 }
 
+// SetKeypairs sets the keypairs param.
 func (p *ResetSSHKeyForVirtualMachineParams) SetKeypairs(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypairs"] = v
+	p.keypairs = optStrings{v: v, ok: true}
 }
 
+// ResetKeypairs unsets the keypairs param
 func (p *ResetSSHKeyForVirtualMachineParams) ResetKeypairs() {
-	if p.p != nil && p.p["keypairs"] != nil {
-		delete(p.p, "keypairs")
-	}
+	p.keypairs = optStrings{}
 }
 
+// GetKeypairs returns the keypairs param and if it is set
 func (p *ResetSSHKeyForVirtualMachineParams) GetKeypairs() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keypairs"].([]string)
-	return value, ok
+	return p.keypairs.v, p.keypairs.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ResetSSHKeyForVirtualMachineParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ResetSSHKeyForVirtualMachineParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ResetSSHKeyForVirtualMachineParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResetSSHKeyForVirtualMachineParams) Clone() *ResetSSHKeyForVirtualMachineParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	c := *p
+	c.keypairs = p.keypairs.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResetSSHKeyForVirtualMachineParams) Equal(o *ResetSSHKeyForVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.keypair == o.keypair &&
+		p.keypairs.equal(o.keypairs) &&
+		p.projectid == o.projectid
+}
+
+// serializedResetSSHKeyForVirtualMachineParams is used to (un)marshal ResetSSHKeyForVirtualMachineParams using the API param names
+type serializedResetSSHKeyForVirtualMachineParams struct {
+	Account   *string   `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string   `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Keypair   *string   `json:"keypair,omitempty" yaml:"keypair,omitempty"`
+	Keypairs  *[]string `json:"keypairs,omitempty" yaml:"keypairs,omitempty"`
+	Projectid *string   `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) toSerialized() *serializedResetSSHKeyForVirtualMachineParams {
+	s := &serializedResetSSHKeyForVirtualMachineParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keypair.ok {
+		s.Keypair = &p.keypair.v
+	}
+	if p.keypairs.ok {
+		s.Keypairs = &p.keypairs.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) fromSerialized(s *serializedResetSSHKeyForVirtualMachineParams) {
+	*p = ResetSSHKeyForVirtualMachineParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keypair != nil {
+		p.SetKeypair(*s.Keypair)
+	}
+	if s.Keypairs != nil {
+		p.SetKeypairs(*s.Keypairs)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResetSSHKeyForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResetSSHKeyForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedResetSSHKeyForVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResetSSHKeyForVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResetSSHKeyForVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResetSSHKeyForVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ResetSSHKeyForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string) *ResetSSHKeyForVirtualMachineParams {
 	p := &ResetSSHKeyForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Resets the SSH Key for virtual machine. The virtual machine must be in a "Stopped" state. [async]
-func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetSSHKeyForVirtualMachine", p.toURLValues())
+// Resets the SSH Key for virtual machine. The virtual machine must be in a "Stopped" state.
+// [async].
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams, opts ...CallOption) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("resetSSHKeyForVirtualMachine", s.cs.encodeParams("resetSSHKeyForVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1119,8 +1674,8 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
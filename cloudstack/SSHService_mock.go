@@ -53,33 +53,43 @@ func (m *MockSSHServiceIface) EXPECT() *MockSSHServiceIfaceMockRecorder {
 }
 
 // CreateSSHKeyPair mocks base method.
-func (m *MockSSHServiceIface) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
+func (m *MockSSHServiceIface) CreateSSHKeyPair(p *CreateSSHKeyPairParams, opts ...CallOption) (*CreateSSHKeyPairResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSSHKeyPair", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSSHKeyPair", varargs...)
 	ret0, _ := ret[0].(*CreateSSHKeyPairResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSSHKeyPair indicates an expected call of CreateSSHKeyPair.
-func (mr *MockSSHServiceIfaceMockRecorder) CreateSSHKeyPair(p interface{}) *gomock.Call {
+func (mr *MockSSHServiceIfaceMockRecorder) CreateSSHKeyPair(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).CreateSSHKeyPair), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).CreateSSHKeyPair), varargs...)
 }
 
 // DeleteSSHKeyPair mocks base method.
-func (m *MockSSHServiceIface) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
+func (m *MockSSHServiceIface) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams, opts ...CallOption) (*DeleteSSHKeyPairResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSSHKeyPair", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSSHKeyPair", varargs...)
 	ret0, _ := ret[0].(*DeleteSSHKeyPairResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteSSHKeyPair indicates an expected call of DeleteSSHKeyPair.
-func (mr *MockSSHServiceIfaceMockRecorder) DeleteSSHKeyPair(p interface{}) *gomock.Call {
+func (mr *MockSSHServiceIfaceMockRecorder) DeleteSSHKeyPair(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).DeleteSSHKeyPair), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).DeleteSSHKeyPair), varargs...)
 }
 
 // GetSSHKeyPairByID mocks base method.
@@ -146,18 +156,23 @@ func (mr *MockSSHServiceIfaceMockRecorder) GetSSHKeyPairID(name interface{}, opt
 }
 
 // ListSSHKeyPairs mocks base method.
-func (m *MockSSHServiceIface) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+func (m *MockSSHServiceIface) ListSSHKeyPairs(p *ListSSHKeyPairsParams, opts ...CallOption) (*ListSSHKeyPairsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSSHKeyPairs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSSHKeyPairs", varargs...)
 	ret0, _ := ret[0].(*ListSSHKeyPairsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSSHKeyPairs indicates an expected call of ListSSHKeyPairs.
-func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairs(p interface{}) *gomock.Call {
+func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairs", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairs", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairs), varargs...)
 }
 
 // NewCreateSSHKeyPairParams mocks base method.
@@ -231,31 +246,41 @@ func (mr *MockSSHServiceIfaceMockRecorder) NewResetSSHKeyForVirtualMachineParams
 }
 
 // RegisterSSHKeyPair mocks base method.
-func (m *MockSSHServiceIface) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+func (m *MockSSHServiceIface) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams, opts ...CallOption) (*RegisterSSHKeyPairResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterSSHKeyPair", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterSSHKeyPair", varargs...)
 	ret0, _ := ret[0].(*RegisterSSHKeyPairResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterSSHKeyPair indicates an expected call of RegisterSSHKeyPair.
-func (mr *MockSSHServiceIfaceMockRecorder) RegisterSSHKeyPair(p interface{}) *gomock.Call {
+func (mr *MockSSHServiceIfaceMockRecorder) RegisterSSHKeyPair(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).RegisterSSHKeyPair), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).RegisterSSHKeyPair), varargs...)
 }
 
 // ResetSSHKeyForVirtualMachine mocks base method.
-func (m *MockSSHServiceIface) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
+func (m *MockSSHServiceIface) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams, opts ...CallOption) (*ResetSSHKeyForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetSSHKeyForVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetSSHKeyForVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*ResetSSHKeyForVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResetSSHKeyForVirtualMachine indicates an expected call of ResetSSHKeyForVirtualMachine.
-func (mr *MockSSHServiceIfaceMockRecorder) ResetSSHKeyForVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockSSHServiceIfaceMockRecorder) ResetSSHKeyForVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetSSHKeyForVirtualMachine", reflect.TypeOf((*MockSSHServiceIface)(nil).ResetSSHKeyForVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetSSHKeyForVirtualMachine", reflect.TypeOf((*MockSSHServiceIface)(nil).ResetSSHKeyForVirtualMachine), varargs...)
 }
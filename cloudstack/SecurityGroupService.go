@@ -60,74 +60,81 @@ func convertAuthorizeSecurityGroupEgressResponse(b []byte) ([]byte, error) {
 }
 
 type SecurityGroupServiceIface interface {
-	AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams, opts ...CallOption) (*AuthorizeSecurityGroupEgressResponse, error)
 	NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams
-	AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams, opts ...CallOption) (*AuthorizeSecurityGroupIngressResponse, error)
 	NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams
-	CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error)
+	CreateSecurityGroup(p *CreateSecurityGroupParams, opts ...CallOption) (*CreateSecurityGroupResponse, error)
 	NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams
-	DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error)
+	DeleteSecurityGroup(p *DeleteSecurityGroupParams, opts ...CallOption) (*DeleteSecurityGroupResponse, error)
 	NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams
-	ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroups(p *ListSecurityGroupsParams, opts ...CallOption) (*ListSecurityGroupsResponse, error)
 	NewListSecurityGroupsParams() *ListSecurityGroupsParams
 	GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error)
 	GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error)
 	GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error)
-	RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams, opts ...CallOption) (*RevokeSecurityGroupEgressResponse, error)
 	NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams
-	RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams, opts ...CallOption) (*RevokeSecurityGroupIngressResponse, error)
 	NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams
 }
 
 type AuthorizeSecurityGroupEgressParams struct {
-	p map[string]interface{}
-}
-
-func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
+	account               optString
+	cidrlist              optStrings
+	domainid              optString
+	endport               optInt
+	icmpcode              optInt
+	icmptype              optInt
+	projectid             optString
+	protocol              optString
+	securitygroupid       optString
+	securitygroupname     optString
+	startport             optInt
+	usersecuritygrouplist optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AuthorizeSecurityGroupEgressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["securitygroupid"]; found {
-		u.Set("securitygroupid", v.(string))
+	if p.securitygroupid.ok {
+		u.Set("securitygroupid", p.securitygroupid.v)
 	}
-	if v, found := p.p["securitygroupname"]; found {
-		u.Set("securitygroupname", v.(string))
+	if p.securitygroupname.ok {
+		u.Set("securitygroupname", p.securitygroupname.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["usersecuritygrouplist"]; found {
-		m := v.(map[string]string)
+	if p.usersecuritygrouplist.ok {
+		m := p.usersecuritygrouplist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
 			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), m[k])
@@ -136,269 +143,423 @@ func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAuthorizeSecurityGroupEgressParams parses url.Values, for example taken from a raw API request,
+// into a new AuthorizeSecurityGroupEgressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAuthorizeSecurityGroupEgressParams(u url.Values) (*AuthorizeSecurityGroupEgressParams, error) {
+	p := &AuthorizeSecurityGroupEgressParams{}
+	if err := checkParamNames("authorizeSecurityGroupEgress", u, "account", "cidrlist", "domainid", "endport", "icmpcode", "icmptype", "projectid", "protocol", "securitygroupid", "securitygroupname", "startport", "usersecuritygrouplist[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
+	}
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
 	}
-	p.p["account"] = v
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["securitygroupid"]; found {
+		p.SetSecuritygroupid(u.Get("securitygroupid"))
+	}
+	if _, found := u["securitygroupname"]; found {
+		p.SetSecuritygroupname(u.Get("securitygroupname"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	if m, err := parseKeyValueMap(u, "usersecuritygrouplist", "account", "group", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetUsersecuritygrouplist(m)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *AuthorizeSecurityGroupEgressParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCidrlist sets the cidrlist param.
 func (p *AuthorizeSecurityGroupEgressParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *AuthorizeSecurityGroupEgressParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *AuthorizeSecurityGroupEgressParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *AuthorizeSecurityGroupEgressParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEndport sets the endport param.
 func (p *AuthorizeSecurityGroupEgressParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *AuthorizeSecurityGroupEgressParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *AuthorizeSecurityGroupEgressParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *AuthorizeSecurityGroupEgressParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *AuthorizeSecurityGroupEgressParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *AuthorizeSecurityGroupEgressParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *AuthorizeSecurityGroupEgressParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *AuthorizeSecurityGroupEgressParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetSecuritygroupid sets the securitygroupid param.
 func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupid"] = v
+	p.securitygroupid = optString{v: v, ok: true}
 }
 
+// ResetSecuritygroupid unsets the securitygroupid param
 func (p *AuthorizeSecurityGroupEgressParams) ResetSecuritygroupid() {
-	if p.p != nil && p.p["securitygroupid"] != nil {
-		delete(p.p, "securitygroupid")
-	}
+	p.securitygroupid = optString{}
 }
 
+// GetSecuritygroupid returns the securitygroupid param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetSecuritygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupid"].(string)
-	return value, ok
+	return p.securitygroupid.v, p.securitygroupid.ok
 }
 
+// SetSecuritygroupname sets the securitygroupname param.
 func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupname"] = v
+	p.securitygroupname = optString{v: v, ok: true}
 }
 
+// ResetSecuritygroupname unsets the securitygroupname param
 func (p *AuthorizeSecurityGroupEgressParams) ResetSecuritygroupname() {
-	if p.p != nil && p.p["securitygroupname"] != nil {
-		delete(p.p, "securitygroupname")
-	}
+	p.securitygroupname = optString{}
 }
 
+// GetSecuritygroupname returns the securitygroupname param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetSecuritygroupname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupname"].(string)
-	return value, ok
+	return p.securitygroupname.v, p.securitygroupname.ok
 }
 
+// SetStartport sets the startport param.
 func (p *AuthorizeSecurityGroupEgressParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *AuthorizeSecurityGroupEgressParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetUsersecuritygrouplist sets the usersecuritygrouplist param.
 func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usersecuritygrouplist"] = v
+	p.usersecuritygrouplist = optStringMap{v: v, ok: true}
 }
 
+// ResetUsersecuritygrouplist unsets the usersecuritygrouplist param
 func (p *AuthorizeSecurityGroupEgressParams) ResetUsersecuritygrouplist() {
-	if p.p != nil && p.p["usersecuritygrouplist"] != nil {
-		delete(p.p, "usersecuritygrouplist")
-	}
+	p.usersecuritygrouplist = optStringMap{}
 }
 
+// GetUsersecuritygrouplist returns the usersecuritygrouplist param and if it is set
 func (p *AuthorizeSecurityGroupEgressParams) GetUsersecuritygrouplist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.usersecuritygrouplist.v, p.usersecuritygrouplist.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AuthorizeSecurityGroupEgressParams) Clone() *AuthorizeSecurityGroupEgressParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["usersecuritygrouplist"].(map[string]string)
-	return value, ok
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	c.usersecuritygrouplist = p.usersecuritygrouplist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AuthorizeSecurityGroupEgressParams) Equal(o *AuthorizeSecurityGroupEgressParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.cidrlist.equal(o.cidrlist) &&
+		p.domainid == o.domainid &&
+		p.endport == o.endport &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.projectid == o.projectid &&
+		p.protocol == o.protocol &&
+		p.securitygroupid == o.securitygroupid &&
+		p.securitygroupname == o.securitygroupname &&
+		p.startport == o.startport &&
+		p.usersecuritygrouplist.equal(o.usersecuritygrouplist)
+}
+
+// serializedAuthorizeSecurityGroupEgressParams is used to (un)marshal AuthorizeSecurityGroupEgressParams using the API param names
+type serializedAuthorizeSecurityGroupEgressParams struct {
+	Account               *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist              *[]string          `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid              *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endport               *int               `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Icmpcode              *int               `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype              *int               `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Projectid             *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Protocol              *string            `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Securitygroupid       *string            `json:"securitygroupid,omitempty" yaml:"securitygroupid,omitempty"`
+	Securitygroupname     *string            `json:"securitygroupname,omitempty" yaml:"securitygroupname,omitempty"`
+	Startport             *int               `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Usersecuritygrouplist *map[string]string `json:"usersecuritygrouplist,omitempty" yaml:"usersecuritygrouplist,omitempty"`
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) toSerialized() *serializedAuthorizeSecurityGroupEgressParams {
+	s := &serializedAuthorizeSecurityGroupEgressParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.securitygroupid.ok {
+		s.Securitygroupid = &p.securitygroupid.v
+	}
+	if p.securitygroupname.ok {
+		s.Securitygroupname = &p.securitygroupname.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.usersecuritygrouplist.ok {
+		s.Usersecuritygrouplist = &p.usersecuritygrouplist.v
+	}
+	return s
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) fromSerialized(s *serializedAuthorizeSecurityGroupEgressParams) {
+	*p = AuthorizeSecurityGroupEgressParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Securitygroupid != nil {
+		p.SetSecuritygroupid(*s.Securitygroupid)
+	}
+	if s.Securitygroupname != nil {
+		p.SetSecuritygroupname(*s.Securitygroupname)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Usersecuritygrouplist != nil {
+		p.SetUsersecuritygrouplist(*s.Usersecuritygrouplist)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AuthorizeSecurityGroupEgressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AuthorizeSecurityGroupEgressParams) UnmarshalJSON(b []byte) error {
+	var s serializedAuthorizeSecurityGroupEgressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AuthorizeSecurityGroupEgressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AuthorizeSecurityGroupEgressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAuthorizeSecurityGroupEgressParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AuthorizeSecurityGroupEgressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams {
 	p := &AuthorizeSecurityGroupEgressParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Authorizes a particular egress rule for this security group
-func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupEgress", p.toURLValues())
+// Authorizes a particular egress rule for this security group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams, opts ...CallOption) (*AuthorizeSecurityGroupEgressResponse, error) {
+	resp, err := s.cs.newRequest("authorizeSecurityGroupEgress", s.cs.encodeParams("authorizeSecurityGroupEgress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -409,8 +570,8 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurity
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -452,54 +613,61 @@ type AuthorizeSecurityGroupEgressResponse struct {
 }
 
 type AuthorizeSecurityGroupIngressParams struct {
-	p map[string]interface{}
-}
-
-func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
+	account               optString
+	cidrlist              optStrings
+	domainid              optString
+	endport               optInt
+	icmpcode              optInt
+	icmptype              optInt
+	projectid             optString
+	protocol              optString
+	securitygroupid       optString
+	securitygroupname     optString
+	startport             optInt
+	usersecuritygrouplist optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AuthorizeSecurityGroupIngressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if p.cidrlist.ok {
+		u.Set("cidrlist", strings.Join(p.cidrlist.v, ","))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if p.endport.ok {
+		u.Set("endport", strconv.Itoa(p.endport.v))
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if p.icmpcode.ok {
+		u.Set("icmpcode", strconv.Itoa(p.icmpcode.v))
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if p.icmptype.ok {
+		u.Set("icmptype", strconv.Itoa(p.icmptype.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if p.protocol.ok {
+		u.Set("protocol", p.protocol.v)
 	}
-	if v, found := p.p["securitygroupid"]; found {
-		u.Set("securitygroupid", v.(string))
+	if p.securitygroupid.ok {
+		u.Set("securitygroupid", p.securitygroupid.v)
 	}
-	if v, found := p.p["securitygroupname"]; found {
-		u.Set("securitygroupname", v.(string))
+	if p.securitygroupname.ok {
+		u.Set("securitygroupname", p.securitygroupname.v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if p.startport.ok {
+		u.Set("startport", strconv.Itoa(p.startport.v))
 	}
-	if v, found := p.p["usersecuritygrouplist"]; found {
-		m := v.(map[string]string)
+	if p.usersecuritygrouplist.ok {
+		m := p.usersecuritygrouplist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
 			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), m[k])
@@ -508,269 +676,423 @@ func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAuthorizeSecurityGroupIngressParams parses url.Values, for example taken from a raw API request,
+// into a new AuthorizeSecurityGroupIngressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAuthorizeSecurityGroupIngressParams(u url.Values) (*AuthorizeSecurityGroupIngressParams, error) {
+	p := &AuthorizeSecurityGroupIngressParams{}
+	if err := checkParamNames("authorizeSecurityGroupIngress", u, "account", "cidrlist", "domainid", "endport", "icmpcode", "icmptype", "projectid", "protocol", "securitygroupid", "securitygroupname", "startport", "usersecuritygrouplist[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(strings.Split(u.Get("cidrlist"), ","))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["endport"]; found {
+		v, err := strconv.Atoi(u.Get("endport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param endport: %v", err)
+		}
+		p.SetEndport(v)
+	}
+	if _, found := u["icmpcode"]; found {
+		v, err := strconv.Atoi(u.Get("icmpcode"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmpcode: %v", err)
+		}
+		p.SetIcmpcode(v)
+	}
+	if _, found := u["icmptype"]; found {
+		v, err := strconv.Atoi(u.Get("icmptype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param icmptype: %v", err)
+		}
+		p.SetIcmptype(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["protocol"]; found {
+		p.SetProtocol(u.Get("protocol"))
+	}
+	if _, found := u["securitygroupid"]; found {
+		p.SetSecuritygroupid(u.Get("securitygroupid"))
+	}
+	if _, found := u["securitygroupname"]; found {
+		p.SetSecuritygroupname(u.Get("securitygroupname"))
+	}
+	if _, found := u["startport"]; found {
+		v, err := strconv.Atoi(u.Get("startport"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startport: %v", err)
+		}
+		p.SetStartport(v)
+	}
+	if m, err := parseKeyValueMap(u, "usersecuritygrouplist", "account", "group", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetUsersecuritygrouplist(m)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *AuthorizeSecurityGroupIngressParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCidrlist sets the cidrlist param.
 func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+	p.cidrlist = optStrings{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *AuthorizeSecurityGroupIngressParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optStrings{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetCidrlist() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].([]string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *AuthorizeSecurityGroupIngressParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEndport sets the endport param.
 func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.endport = optInt{v: v, ok: true}
 }
 
+// ResetEndport unsets the endport param
 func (p *AuthorizeSecurityGroupIngressParams) ResetEndport() {
-	if p.p != nil && p.p["endport"] != nil {
-		delete(p.p, "endport")
-	}
+	p.endport = optInt{}
 }
 
+// GetEndport returns the endport param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetEndport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endport"].(int)
-	return value, ok
+	return p.endport.v, p.endport.ok
 }
 
+// SetIcmpcode sets the icmpcode param.
 func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+	p.icmpcode = optInt{v: v, ok: true}
 }
 
+// ResetIcmpcode unsets the icmpcode param
 func (p *AuthorizeSecurityGroupIngressParams) ResetIcmpcode() {
-	if p.p != nil && p.p["icmpcode"] != nil {
-		delete(p.p, "icmpcode")
-	}
+	p.icmpcode = optInt{}
 }
 
+// GetIcmpcode returns the icmpcode param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetIcmpcode() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmpcode"].(int)
-	return value, ok
+	return p.icmpcode.v, p.icmpcode.ok
 }
 
+// SetIcmptype sets the icmptype param.
 func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+	p.icmptype = optInt{v: v, ok: true}
 }
 
+// ResetIcmptype unsets the icmptype param
 func (p *AuthorizeSecurityGroupIngressParams) ResetIcmptype() {
-	if p.p != nil && p.p["icmptype"] != nil {
-		delete(p.p, "icmptype")
-	}
+	p.icmptype = optInt{}
 }
 
+// GetIcmptype returns the icmptype param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetIcmptype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["icmptype"].(int)
-	return value, ok
+	return p.icmptype.v, p.icmptype.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *AuthorizeSecurityGroupIngressParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProtocol sets the protocol param.
 func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+	p.protocol = optString{v: v, ok: true}
 }
 
+// ResetProtocol unsets the protocol param
 func (p *AuthorizeSecurityGroupIngressParams) ResetProtocol() {
-	if p.p != nil && p.p["protocol"] != nil {
-		delete(p.p, "protocol")
-	}
+	p.protocol = optString{}
 }
 
+// GetProtocol returns the protocol param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetProtocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["protocol"].(string)
-	return value, ok
+	return p.protocol.v, p.protocol.ok
 }
 
+// SetSecuritygroupid sets the securitygroupid param.
 func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupid"] = v
+	p.securitygroupid = optString{v: v, ok: true}
 }
 
+// ResetSecuritygroupid unsets the securitygroupid param
 func (p *AuthorizeSecurityGroupIngressParams) ResetSecuritygroupid() {
-	if p.p != nil && p.p["securitygroupid"] != nil {
-		delete(p.p, "securitygroupid")
-	}
+	p.securitygroupid = optString{}
 }
 
+// GetSecuritygroupid returns the securitygroupid param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetSecuritygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupid"].(string)
-	return value, ok
+	return p.securitygroupid.v, p.securitygroupid.ok
 }
 
+// SetSecuritygroupname sets the securitygroupname param.
 func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupname"] = v
+	p.securitygroupname = optString{v: v, ok: true}
 }
 
+// ResetSecuritygroupname unsets the securitygroupname param
 func (p *AuthorizeSecurityGroupIngressParams) ResetSecuritygroupname() {
-	if p.p != nil && p.p["securitygroupname"] != nil {
-		delete(p.p, "securitygroupname")
-	}
+	p.securitygroupname = optString{}
 }
 
+// GetSecuritygroupname returns the securitygroupname param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetSecuritygroupname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupname"].(string)
-	return value, ok
+	return p.securitygroupname.v, p.securitygroupname.ok
 }
 
+// SetStartport sets the startport param.
 func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+	p.startport = optInt{v: v, ok: true}
 }
 
+// ResetStartport unsets the startport param
 func (p *AuthorizeSecurityGroupIngressParams) ResetStartport() {
-	if p.p != nil && p.p["startport"] != nil {
-		delete(p.p, "startport")
-	}
+	p.startport = optInt{}
 }
 
+// GetStartport returns the startport param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetStartport() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startport"].(int)
-	return value, ok
+	return p.startport.v, p.startport.ok
 }
 
+// SetUsersecuritygrouplist sets the usersecuritygrouplist param.
 func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usersecuritygrouplist"] = v
+	p.usersecuritygrouplist = optStringMap{v: v, ok: true}
 }
 
+// ResetUsersecuritygrouplist unsets the usersecuritygrouplist param
 func (p *AuthorizeSecurityGroupIngressParams) ResetUsersecuritygrouplist() {
-	if p.p != nil && p.p["usersecuritygrouplist"] != nil {
-		delete(p.p, "usersecuritygrouplist")
-	}
+	p.usersecuritygrouplist = optStringMap{}
 }
 
+// GetUsersecuritygrouplist returns the usersecuritygrouplist param and if it is set
 func (p *AuthorizeSecurityGroupIngressParams) GetUsersecuritygrouplist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.usersecuritygrouplist.v, p.usersecuritygrouplist.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AuthorizeSecurityGroupIngressParams) Clone() *AuthorizeSecurityGroupIngressParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.cidrlist = p.cidrlist.clone()
+	c.usersecuritygrouplist = p.usersecuritygrouplist.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AuthorizeSecurityGroupIngressParams) Equal(o *AuthorizeSecurityGroupIngressParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.cidrlist.equal(o.cidrlist) &&
+		p.domainid == o.domainid &&
+		p.endport == o.endport &&
+		p.icmpcode == o.icmpcode &&
+		p.icmptype == o.icmptype &&
+		p.projectid == o.projectid &&
+		p.protocol == o.protocol &&
+		p.securitygroupid == o.securitygroupid &&
+		p.securitygroupname == o.securitygroupname &&
+		p.startport == o.startport &&
+		p.usersecuritygrouplist.equal(o.usersecuritygrouplist)
+}
+
+// serializedAuthorizeSecurityGroupIngressParams is used to (un)marshal AuthorizeSecurityGroupIngressParams using the API param names
+type serializedAuthorizeSecurityGroupIngressParams struct {
+	Account               *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist              *[]string          `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid              *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endport               *int               `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Icmpcode              *int               `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype              *int               `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Projectid             *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Protocol              *string            `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Securitygroupid       *string            `json:"securitygroupid,omitempty" yaml:"securitygroupid,omitempty"`
+	Securitygroupname     *string            `json:"securitygroupname,omitempty" yaml:"securitygroupname,omitempty"`
+	Startport             *int               `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Usersecuritygrouplist *map[string]string `json:"usersecuritygrouplist,omitempty" yaml:"usersecuritygrouplist,omitempty"`
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) toSerialized() *serializedAuthorizeSecurityGroupIngressParams {
+	s := &serializedAuthorizeSecurityGroupIngressParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.endport.ok {
+		s.Endport = &p.endport.v
+	}
+	if p.icmpcode.ok {
+		s.Icmpcode = &p.icmpcode.v
+	}
+	if p.icmptype.ok {
+		s.Icmptype = &p.icmptype.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.protocol.ok {
+		s.Protocol = &p.protocol.v
+	}
+	if p.securitygroupid.ok {
+		s.Securitygroupid = &p.securitygroupid.v
+	}
+	if p.securitygroupname.ok {
+		s.Securitygroupname = &p.securitygroupname.v
+	}
+	if p.startport.ok {
+		s.Startport = &p.startport.v
+	}
+	if p.usersecuritygrouplist.ok {
+		s.Usersecuritygrouplist = &p.usersecuritygrouplist.v
+	}
+	return s
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) fromSerialized(s *serializedAuthorizeSecurityGroupIngressParams) {
+	*p = AuthorizeSecurityGroupIngressParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
 	}
-	value, ok := p.p["usersecuritygrouplist"].(map[string]string)
-	return value, ok
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Endport != nil {
+		p.SetEndport(*s.Endport)
+	}
+	if s.Icmpcode != nil {
+		p.SetIcmpcode(*s.Icmpcode)
+	}
+	if s.Icmptype != nil {
+		p.SetIcmptype(*s.Icmptype)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Protocol != nil {
+		p.SetProtocol(*s.Protocol)
+	}
+	if s.Securitygroupid != nil {
+		p.SetSecuritygroupid(*s.Securitygroupid)
+	}
+	if s.Securitygroupname != nil {
+		p.SetSecuritygroupname(*s.Securitygroupname)
+	}
+	if s.Startport != nil {
+		p.SetStartport(*s.Startport)
+	}
+	if s.Usersecuritygrouplist != nil {
+		p.SetUsersecuritygrouplist(*s.Usersecuritygrouplist)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AuthorizeSecurityGroupIngressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AuthorizeSecurityGroupIngressParams) UnmarshalJSON(b []byte) error {
+	var s serializedAuthorizeSecurityGroupIngressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AuthorizeSecurityGroupIngressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AuthorizeSecurityGroupIngressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAuthorizeSecurityGroupIngressParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AuthorizeSecurityGroupIngressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams {
 	p := &AuthorizeSecurityGroupIngressParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Authorizes a particular ingress rule for this security group
-func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupIngress", p.toURLValues())
+// Authorizes a particular ingress rule for this security group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams, opts ...CallOption) (*AuthorizeSecurityGroupIngressResponse, error) {
+	resp, err := s.cs.newRequest("authorizeSecurityGroupIngress", s.cs.encodeParams("authorizeSecurityGroupIngress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -781,8 +1103,8 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurit
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -824,149 +1146,252 @@ type AuthorizeSecurityGroupIngressResponse struct {
 }
 
 type CreateSecurityGroupParams struct {
-	p map[string]interface{}
+	account     optString
+	description optString
+	domainid    optString
+	name        optString
+	projectid   optString
 }
 
-func (p *CreateSecurityGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateSecurityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *CreateSecurityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateSecurityGroupParams parses url.Values, for example taken from a raw API request,
+// into a new CreateSecurityGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateSecurityGroupParams(u url.Values) (*CreateSecurityGroupParams, error) {
+	p := &CreateSecurityGroupParams{}
+	if err := checkParamNames("createSecurityGroup", u, "account", "description", "domainid", "name", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateSecurityGroupParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateSecurityGroupParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateSecurityGroupParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDescription sets the description param.
 func (p *CreateSecurityGroupParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateSecurityGroupParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateSecurityGroupParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateSecurityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateSecurityGroupParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *CreateSecurityGroupParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetName sets the name param. This param is required.
+func (p *CreateSecurityGroupParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *CreateSecurityGroupParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *CreateSecurityGroupParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *CreateSecurityGroupParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *CreateSecurityGroupParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *CreateSecurityGroupParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateSecurityGroupParams) Clone() *CreateSecurityGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateSecurityGroupParams) Equal(o *CreateSecurityGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
+	return p.account == o.account &&
+		p.description == o.description &&
+		p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.projectid == o.projectid
 }
 
-func (p *CreateSecurityGroupParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+// serializedCreateSecurityGroupParams is used to (un)marshal CreateSecurityGroupParams using the API param names
+type serializedCreateSecurityGroupParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
 }
 
-func (p *CreateSecurityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSecurityGroupParams) toSerialized() *serializedCreateSecurityGroupParams {
+	s := &serializedCreateSecurityGroupParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.description.ok {
+		s.Description = &p.description.v
 	}
-	p.p["name"] = v
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
 }
 
-func (p *CreateSecurityGroupParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
+func (p *CreateSecurityGroupParams) fromSerialized(s *serializedCreateSecurityGroupParams) {
+	*p = CreateSecurityGroupParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
 	}
 }
 
-func (p *CreateSecurityGroupParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateSecurityGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *CreateSecurityGroupParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateSecurityGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["projectid"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *CreateSecurityGroupParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateSecurityGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *CreateSecurityGroupParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateSecurityGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateSecurityGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateSecurityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams {
 	p := &CreateSecurityGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
-// Creates a security group
-func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createSecurityGroup", p.toURLValues())
+// Creates a security group.
+//
+// Required params: name.
+func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams, opts ...CallOption) (*CreateSecurityGroupResponse, error) {
+	resp, err := s.cs.newRequest("createSecurityGroup", s.cs.encodeParams("createSecurityGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1015,148 +1440,249 @@ type CreateSecurityGroupResponseRule struct {
 }
 
 type DeleteSecurityGroupParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	id        optString
+	name      optString
+	projectid optString
 }
 
-func (p *DeleteSecurityGroupParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteSecurityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *DeleteSecurityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteSecurityGroupParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteSecurityGroupParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteSecurityGroupParams(u url.Values) (*DeleteSecurityGroupParams, error) {
+	p := &DeleteSecurityGroupParams{}
+	if err := checkParamNames("deleteSecurityGroup", u, "account", "domainid", "id", "name", "projectid"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *DeleteSecurityGroupParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *DeleteSecurityGroupParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DeleteSecurityGroupParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *DeleteSecurityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DeleteSecurityGroupParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DeleteSecurityGroupParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *DeleteSecurityGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteSecurityGroupParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteSecurityGroupParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *DeleteSecurityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *DeleteSecurityGroupParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *DeleteSecurityGroupParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *DeleteSecurityGroupParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DeleteSecurityGroupParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DeleteSecurityGroupParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteSecurityGroupParams) Clone() *DeleteSecurityGroupParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteSecurityGroupParams) Equal(o *DeleteSecurityGroupParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.projectid == o.projectid
+}
+
+// serializedDeleteSecurityGroupParams is used to (un)marshal DeleteSecurityGroupParams using the API param names
+type serializedDeleteSecurityGroupParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *DeleteSecurityGroupParams) toSerialized() *serializedDeleteSecurityGroupParams {
+	s := &serializedDeleteSecurityGroupParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *DeleteSecurityGroupParams) fromSerialized(s *serializedDeleteSecurityGroupParams) {
+	*p = DeleteSecurityGroupParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteSecurityGroupParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteSecurityGroupParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteSecurityGroupParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteSecurityGroupParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteSecurityGroupParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteSecurityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams {
 	p := &DeleteSecurityGroupParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Deletes security group
-func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecurityGroup", p.toURLValues())
+// Deletes security group.
+func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams, opts ...CallOption) (*DeleteSecurityGroupResponse, error) {
+	resp, err := s.cs.newRequest("deleteSecurityGroup", s.cs.encodeParams("deleteSecurityGroup", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1204,327 +1730,484 @@ func (r *DeleteSecurityGroupResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListSecurityGroupsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSecurityGroupsParams) toURLValues() url.Values {
+	account           optString
+	domainid          optString
+	id                optString
+	isrecursive       optBool
+	keyword           optString
+	listall           optBool
+	page              optInt
+	pagesize          optInt
+	projectid         optString
+	securitygroupname optString
+	tags              optStringMap
+	virtualmachineid  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSecurityGroupsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["securitygroupname"]; found {
-		u.Set("securitygroupname", v.(string))
+	if p.securitygroupname.ok {
+		u.Set("securitygroupname", p.securitygroupname.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *ListSecurityGroupsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSecurityGroupsParams parses url.Values, for example taken from a raw API request,
+// into a new ListSecurityGroupsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSecurityGroupsParams(u url.Values) (*ListSecurityGroupsParams, error) {
+	p := &ListSecurityGroupsParams{}
+	if err := checkParamNames("listSecurityGroups", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "securitygroupname", "tags[]", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["account"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["securitygroupname"]; found {
+		p.SetSecuritygroupname(u.Get("securitygroupname"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListSecurityGroupsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListSecurityGroupsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListSecurityGroupsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListSecurityGroupsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListSecurityGroupsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListSecurityGroupsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListSecurityGroupsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListSecurityGroupsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListSecurityGroupsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListSecurityGroupsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListSecurityGroupsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListSecurityGroupsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListSecurityGroupsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSecurityGroupsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListSecurityGroupsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListSecurityGroupsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListSecurityGroupsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSecurityGroupsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSecurityGroupsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSecurityGroupsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSecurityGroupsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSecurityGroupsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSecurityGroupsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListSecurityGroupsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListSecurityGroupsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListSecurityGroupsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetSecuritygroupname sets the securitygroupname param.
 func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupname"] = v
+	p.securitygroupname = optString{v: v, ok: true}
 }
 
+// ResetSecuritygroupname unsets the securitygroupname param
 func (p *ListSecurityGroupsParams) ResetSecuritygroupname() {
-	if p.p != nil && p.p["securitygroupname"] != nil {
-		delete(p.p, "securitygroupname")
-	}
+	p.securitygroupname = optString{}
 }
 
+// GetSecuritygroupname returns the securitygroupname param and if it is set
 func (p *ListSecurityGroupsParams) GetSecuritygroupname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupname"].(string)
-	return value, ok
+	return p.securitygroupname.v, p.securitygroupname.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListSecurityGroupsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListSecurityGroupsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListSecurityGroupsParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListSecurityGroupsParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSecurityGroupsParams) Clone() *ListSecurityGroupsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSecurityGroupsParams) Equal(o *ListSecurityGroupsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.securitygroupname == o.securitygroupname &&
+		p.tags.equal(o.tags) &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedListSecurityGroupsParams is used to (un)marshal ListSecurityGroupsParams using the API param names
+type serializedListSecurityGroupsParams struct {
+	Account           *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive       *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page              *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid         *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Securitygroupname *string            `json:"securitygroupname,omitempty" yaml:"securitygroupname,omitempty"`
+	Tags              *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Virtualmachineid  *string            `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *ListSecurityGroupsParams) toSerialized() *serializedListSecurityGroupsParams {
+	s := &serializedListSecurityGroupsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.securitygroupname.ok {
+		s.Securitygroupname = &p.securitygroupname.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *ListSecurityGroupsParams) fromSerialized(s *serializedListSecurityGroupsParams) {
+	*p = ListSecurityGroupsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Securitygroupname != nil {
+		p.SetSecuritygroupname(*s.Securitygroupname)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSecurityGroupsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSecurityGroupsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSecurityGroupsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSecurityGroupsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSecurityGroupsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSecurityGroupsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSecurityGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewListSecurityGroupsParams() *ListSecurityGroupsParams {
 	p := &ListSecurityGroupsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecurityGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1572,9 +2255,8 @@ func (s *SecurityGroupService) GetSecurityGroupByName(name string, opts ...Optio
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
 	p := &ListSecurityGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1602,9 +2284,9 @@ func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFun
 	return nil, l.Count, fmt.Errorf("There is more then one result for SecurityGroup UUID: %s!", id)
 }
 
-// Lists security groups
-func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listSecurityGroups", p.toURLValues())
+// Lists security groups.
+func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams, opts ...CallOption) (*ListSecurityGroupsResponse, error) {
+	resp, err := s.cs.newRequest("listSecurityGroups", s.cs.encodeParams("listSecurityGroups", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1654,53 +2336,133 @@ type SecurityGroupRule struct {
 }
 
 type RevokeSecurityGroupEgressParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RevokeSecurityGroupEgressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRevokeSecurityGroupEgressParams parses url.Values, for example taken from a raw API request,
+// into a new RevokeSecurityGroupEgressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRevokeSecurityGroupEgressParams(u url.Values) (*RevokeSecurityGroupEgressParams, error) {
+	p := &RevokeSecurityGroupEgressParams{}
+	if err := checkParamNames("revokeSecurityGroupEgress", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RevokeSecurityGroupEgressParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RevokeSecurityGroupEgressParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RevokeSecurityGroupEgressParams) Clone() *RevokeSecurityGroupEgressParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RevokeSecurityGroupEgressParams) Equal(o *RevokeSecurityGroupEgressParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRevokeSecurityGroupEgressParams is used to (un)marshal RevokeSecurityGroupEgressParams using the API param names
+type serializedRevokeSecurityGroupEgressParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RevokeSecurityGroupEgressParams) toSerialized() *serializedRevokeSecurityGroupEgressParams {
+	s := &serializedRevokeSecurityGroupEgressParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RevokeSecurityGroupEgressParams) fromSerialized(s *serializedRevokeSecurityGroupEgressParams) {
+	*p = RevokeSecurityGroupEgressParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RevokeSecurityGroupEgressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RevokeSecurityGroupEgressParams) UnmarshalJSON(b []byte) error {
+	var s serializedRevokeSecurityGroupEgressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RevokeSecurityGroupEgressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RevokeSecurityGroupEgressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRevokeSecurityGroupEgressParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RevokeSecurityGroupEgressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams {
 	p := &RevokeSecurityGroupEgressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a particular egress rule from this security group
-func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupEgress", p.toURLValues())
+// Deletes a particular egress rule from this security group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams, opts ...CallOption) (*RevokeSecurityGroupEgressResponse, error) {
+	resp, err := s.cs.newRequest("revokeSecurityGroupEgress", s.cs.encodeParams("revokeSecurityGroupEgress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1711,8 +2473,8 @@ func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupE
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1736,53 +2498,133 @@ type RevokeSecurityGroupEgressResponse struct {
 }
 
 type RevokeSecurityGroupIngressParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RevokeSecurityGroupIngressParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRevokeSecurityGroupIngressParams parses url.Values, for example taken from a raw API request,
+// into a new RevokeSecurityGroupIngressParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRevokeSecurityGroupIngressParams(u url.Values) (*RevokeSecurityGroupIngressParams, error) {
+	p := &RevokeSecurityGroupIngressParams{}
+	if err := checkParamNames("revokeSecurityGroupIngress", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RevokeSecurityGroupIngressParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RevokeSecurityGroupIngressParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RevokeSecurityGroupIngressParams) Clone() *RevokeSecurityGroupIngressParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RevokeSecurityGroupIngressParams) Equal(o *RevokeSecurityGroupIngressParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRevokeSecurityGroupIngressParams is used to (un)marshal RevokeSecurityGroupIngressParams using the API param names
+type serializedRevokeSecurityGroupIngressParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RevokeSecurityGroupIngressParams) toSerialized() *serializedRevokeSecurityGroupIngressParams {
+	s := &serializedRevokeSecurityGroupIngressParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *RevokeSecurityGroupIngressParams) fromSerialized(s *serializedRevokeSecurityGroupIngressParams) {
+	*p = RevokeSecurityGroupIngressParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RevokeSecurityGroupIngressParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RevokeSecurityGroupIngressParams) UnmarshalJSON(b []byte) error {
+	var s serializedRevokeSecurityGroupIngressParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RevokeSecurityGroupIngressParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RevokeSecurityGroupIngressParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRevokeSecurityGroupIngressParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RevokeSecurityGroupIngressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams {
 	p := &RevokeSecurityGroupIngressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a particular ingress rule from this security group
-func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupIngress", p.toURLValues())
+// Deletes a particular ingress rule from this security group.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams, opts ...CallOption) (*RevokeSecurityGroupIngressResponse, error) {
+	resp, err := s.cs.newRequest("revokeSecurityGroupIngress", s.cs.encodeParams("revokeSecurityGroupIngress", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1793,8 +2635,8 @@ func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroup
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
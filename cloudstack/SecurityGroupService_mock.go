@@ -53,63 +53,83 @@ func (m *MockSecurityGroupServiceIface) EXPECT() *MockSecurityGroupServiceIfaceM
 }
 
 // AuthorizeSecurityGroupEgress mocks base method.
-func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
+func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams, opts ...CallOption) (*AuthorizeSecurityGroupEgressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgress", varargs...)
 	ret0, _ := ret[0].(*AuthorizeSecurityGroupEgressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthorizeSecurityGroupEgress indicates an expected call of AuthorizeSecurityGroupEgress.
-func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupEgress(p interface{}) *gomock.Call {
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupEgress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupEgress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupEgress), varargs...)
 }
 
 // AuthorizeSecurityGroupIngress mocks base method.
-func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
+func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams, opts ...CallOption) (*AuthorizeSecurityGroupIngressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupIngress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupIngress", varargs...)
 	ret0, _ := ret[0].(*AuthorizeSecurityGroupIngressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthorizeSecurityGroupIngress indicates an expected call of AuthorizeSecurityGroupIngress.
-func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupIngress(p interface{}) *gomock.Call {
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupIngress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupIngress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupIngress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupIngress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupIngress), varargs...)
 }
 
 // CreateSecurityGroup mocks base method.
-func (m *MockSecurityGroupServiceIface) CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
+func (m *MockSecurityGroupServiceIface) CreateSecurityGroup(p *CreateSecurityGroupParams, opts ...CallOption) (*CreateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecurityGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSecurityGroup", varargs...)
 	ret0, _ := ret[0].(*CreateSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSecurityGroup indicates an expected call of CreateSecurityGroup.
-func (mr *MockSecurityGroupServiceIfaceMockRecorder) CreateSecurityGroup(p interface{}) *gomock.Call {
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) CreateSecurityGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).CreateSecurityGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).CreateSecurityGroup), varargs...)
 }
 
 // DeleteSecurityGroup mocks base method.
-func (m *MockSecurityGroupServiceIface) DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
+func (m *MockSecurityGroupServiceIface) DeleteSecurityGroup(p *DeleteSecurityGroupParams, opts ...CallOption) (*DeleteSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSecurityGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", varargs...)
 	ret0, _ := ret[0].(*DeleteSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup.
-func (mr *MockSecurityGroupServiceIfaceMockRecorder) DeleteSecurityGroup(p interface{}) *gomock.Call {
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) DeleteSecurityGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).DeleteSecurityGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).DeleteSecurityGroup), varargs...)
 }
 
 // GetSecurityGroupByID mocks base method.
@@ -176,18 +196,23 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) GetSecurityGroupID(keyword
 }
 
 // ListSecurityGroups mocks base method.
-func (m *MockSecurityGroupServiceIface) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+func (m *MockSecurityGroupServiceIface) ListSecurityGroups(p *ListSecurityGroupsParams, opts ...CallOption) (*ListSecurityGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecurityGroups", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecurityGroups", varargs...)
 	ret0, _ := ret[0].(*ListSecurityGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSecurityGroups indicates an expected call of ListSecurityGroups.
-func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroups(p interface{}) *gomock.Call {
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroups(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroups", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroups), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroups", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroups), varargs...)
 }
 
 // NewAuthorizeSecurityGroupEgressParams mocks base method.
@@ -289,31 +314,41 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) NewRevokeSecurityGroupIngre
 }
 
 // RevokeSecurityGroupEgress mocks base method.
-func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
+func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams, opts ...CallOption) (*RevokeSecurityGroupEgressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeSecurityGroupEgress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupEgress", varargs...)
 	ret0, _ := ret[0].(*RevokeSecurityGroupEgressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RevokeSecurityGroupEgress indicates an expected call of RevokeSecurityGroupEgress.
-func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupEgress(p interface{}) *gomock.Call {
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupEgress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupEgress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupEgress), varargs...)
 }
 
 // RevokeSecurityGroupIngress mocks base method.
-func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
+func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams, opts ...CallOption) (*RevokeSecurityGroupIngressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeSecurityGroupIngress", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupIngress", varargs...)
 	ret0, _ := ret[0].(*RevokeSecurityGroupIngressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RevokeSecurityGroupIngress indicates an expected call of RevokeSecurityGroupIngress.
-func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupIngress(p interface{}) *gomock.Call {
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupIngress(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupIngress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupIngress), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupIngress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupIngress), varargs...)
 }
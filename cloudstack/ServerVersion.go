@@ -0,0 +1,232 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// serverVersion holds the server version targeted by the client. It is shared between a
+// client and the scoped clients created from it, so the version is only detected once.
+type serverVersion struct {
+	mu       sync.Mutex
+	detect   bool
+	version  string
+	target   string
+	resolved bool
+	fetch    callGroup
+}
+
+// WithServerVersion targets the given server version. API calls for commands or params
+// that are not available in that version return an *UnsupportedCommandError or an
+// *UnknownParamError before anything is sent.
+func WithServerVersion(version string) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.version = &serverVersion{version: version}
+	}
+}
+
+// WithServerVersionDetection detects the server version using listCapabilities before
+// the first API call, and targets that version from then on
+func WithServerVersionDetection() ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.version = &serverVersion{detect: true}
+	}
+}
+
+// DetectServerVersion calls listCapabilities and targets the returned server version
+func (cs *CloudStackClient) DetectServerVersion() (string, error) {
+	if cs.version == nil {
+		cs.version = &serverVersion{}
+	}
+	return cs.detectServerVersion()
+}
+
+// detectServerVersion calls listCapabilities, or waits for the listCapabilities call that
+// is already running
+func (cs *CloudStackClient) detectServerVersion() (string, error) {
+	v := cs.version
+	err := v.fetch.do(func() error {
+		r, err := cs.Configuration.ListCapabilities(cs.Configuration.NewListCapabilitiesParams())
+		if err != nil {
+			return fmt.Errorf("failed to detect the server version: %v", err)
+		}
+		if r.Capabilities == nil || r.Capabilities.Cloudstackversion == "" {
+			return fmt.Errorf("failed to detect the server version: no version returned")
+		}
+
+		v.mu.Lock()
+		v.detect = false
+		v.version = r.Capabilities.Cloudstackversion
+		v.resolved = false
+		v.mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.version, nil
+}
+
+// ServerVersion returns the targeted server version, or an empty string if no version
+// is targeted
+func (cs *CloudStackClient) ServerVersion() string {
+	if cs.version == nil {
+		return ""
+	}
+
+	cs.version.mu.Lock()
+	defer cs.version.mu.Unlock()
+
+	return cs.version.version
+}
+
+// targetVersion returns the supported version used for the version checks, which is the
+// newest supported version that is not newer than the targeted server version
+func (cs *CloudStackClient) targetVersion() (string, error) {
+	cs.version.mu.Lock()
+	detect := cs.version.detect
+	cs.version.mu.Unlock()
+
+	if detect {
+		if _, err := cs.detectServerVersion(); err != nil {
+			return "", err
+		}
+	}
+
+	cs.version.mu.Lock()
+	defer cs.version.mu.Unlock()
+
+	if !cs.version.resolved {
+		cs.version.target = ""
+		if cs.version.version != "" && len(SupportedServerVersions) > 0 {
+			cs.version.target = SupportedServerVersions[0]
+			for _, v := range SupportedServerVersions {
+				if CompareVersions(v, cs.version.version) <= 0 {
+					cs.version.target = v
+				}
+			}
+		}
+		cs.version.resolved = true
+	}
+
+	return cs.version.target, nil
+}
+
+// SupportsInVersion returns true if the given command and, if not empty, the given param
+// of that command are available in the given server version
+func SupportsInVersion(version, command, param string) bool {
+	command = strings.ToLower(command)
+
+	if versions, ok := commandVersions[command]; ok && !containsVersion(versions, version) {
+		return false
+	}
+	if param == "" {
+		return true
+	}
+
+	versions, ok := paramVersions[command][strings.ToLower(paramBaseName(param))]
+	return !ok || containsVersion(versions, version)
+}
+
+// checkServerVersion verifies the API call against the targeted server version
+func (cs *CloudStackClient) checkServerVersion(api string, params url.Values) error {
+	// The bootstrap calls are always allowed, as they are used for the detection itself
+	if cs.version == nil || isBootstrapCommand(api) {
+		return nil
+	}
+
+	target, err := cs.targetVersion()
+	if err != nil || target == "" {
+		return err
+	}
+
+	if !SupportsInVersion(target, api, "") {
+		return &UnsupportedCommandError{Command: api}
+	}
+
+	var unknown []string
+	for k := range params {
+		name := paramBaseName(k)
+		if !SupportsInVersion(target, api, name) && !containsVersion(unknown, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return &UnknownParamError{Command: api, Params: unknown}
+	}
+
+	return nil
+}
+
+func containsVersion(versions []string, version string) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareVersions compares two dotted version numbers, like 4.18.0.0, numerically. It
+// returns -1, 0 or 1 if a is older than, equal to or newer than b. Any non-numeric suffix,
+// like the `-mold` in 4.18.0.0-mold, is ignored.
+func CompareVersions(a, b string) int {
+	as, bs := versionParts(a), versionParts(b)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(v string) []int {
+	var parts []int
+	for _, s := range strings.Split(v, ".") {
+		digits := s
+		if i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+			digits = s[:i]
+		}
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
@@ -28,1211 +28,1668 @@ import (
 )
 
 type ServiceOfferingServiceIface interface {
-	CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	CreateServiceOffering(p *CreateServiceOfferingParams, opts ...CallOption) (*CreateServiceOfferingResponse, error)
 	NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams
-	DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	DeleteServiceOffering(p *DeleteServiceOfferingParams, opts ...CallOption) (*DeleteServiceOfferingResponse, error)
 	NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams
-	ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferings(p *ListServiceOfferingsParams, opts ...CallOption) (*ListServiceOfferingsResponse, error)
 	NewListServiceOfferingsParams() *ListServiceOfferingsParams
 	GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error)
 	GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error)
 	GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error)
-	UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+	UpdateServiceOffering(p *UpdateServiceOfferingParams, opts ...CallOption) (*UpdateServiceOfferingResponse, error)
 	NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams
 }
 
 type CreateServiceOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateServiceOfferingParams) toURLValues() url.Values {
+	bytesreadrate             optInt64
+	bytesreadratemax          optInt64
+	bytesreadratemaxlength    optInt64
+	byteswriterate            optInt64
+	byteswriteratemax         optInt64
+	byteswriteratemaxlength   optInt64
+	cachemode                 optString
+	cpunumber                 optInt
+	cpuspeed                  optInt
+	customized                optBool
+	customizediops            optBool
+	deploymentplanner         optString
+	diskofferingid            optString
+	diskofferingstrictness    optBool
+	displaytext               optString
+	domainid                  optStrings
+	dynamicscalingenabled     optBool
+	encryptroot               optBool
+	hosttags                  optString
+	hypervisorsnapshotreserve optInt
+	iopsreadrate              optInt64
+	iopsreadratemax           optInt64
+	iopsreadratemaxlength     optInt64
+	iopswriterate             optInt64
+	iopswriteratemax          optInt64
+	iopswriteratemaxlength    optInt64
+	issystem                  optBool
+	isvolatile                optBool
+	limitcpuuse               optBool
+	maxcpunumber              optInt
+	maxiops                   optInt64
+	maxmemory                 optInt
+	memory                    optInt
+	mincpunumber              optInt
+	miniops                   optInt64
+	minmemory                 optInt
+	name                      optString
+	networkrate               optInt
+	offerha                   optBool
+	provisioningtype          optString
+	rootdisksize              optInt64
+	serviceofferingdetails    optStringMap
+	storagepolicy             optString
+	storagetype               optString
+	systemvmtype              optString
+	tags                      optString
+	zoneid                    optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateServiceOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bytesreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadrate", vv)
+	if p.bytesreadrate.ok {
+		u.Set("bytesreadrate", strconv.FormatInt(p.bytesreadrate.v, 10))
 	}
-	if v, found := p.p["bytesreadratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadratemax", vv)
+	if p.bytesreadratemax.ok {
+		u.Set("bytesreadratemax", strconv.FormatInt(p.bytesreadratemax.v, 10))
 	}
-	if v, found := p.p["bytesreadratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("bytesreadratemaxlength", vv)
+	if p.bytesreadratemaxlength.ok {
+		u.Set("bytesreadratemaxlength", strconv.FormatInt(p.bytesreadratemaxlength.v, 10))
 	}
-	if v, found := p.p["byteswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriterate", vv)
+	if p.byteswriterate.ok {
+		u.Set("byteswriterate", strconv.FormatInt(p.byteswriterate.v, 10))
 	}
-	if v, found := p.p["byteswriteratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriteratemax", vv)
+	if p.byteswriteratemax.ok {
+		u.Set("byteswriteratemax", strconv.FormatInt(p.byteswriteratemax.v, 10))
 	}
-	if v, found := p.p["byteswriteratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("byteswriteratemaxlength", vv)
+	if p.byteswriteratemaxlength.ok {
+		u.Set("byteswriteratemaxlength", strconv.FormatInt(p.byteswriteratemaxlength.v, 10))
 	}
-	if v, found := p.p["cachemode"]; found {
-		u.Set("cachemode", v.(string))
+	if p.cachemode.ok {
+		u.Set("cachemode", p.cachemode.v)
 	}
-	if v, found := p.p["cpunumber"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("cpunumber", vv)
+	if p.cpunumber.ok {
+		u.Set("cpunumber", strconv.Itoa(p.cpunumber.v))
 	}
-	if v, found := p.p["cpuspeed"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("cpuspeed", vv)
+	if p.cpuspeed.ok {
+		u.Set("cpuspeed", strconv.Itoa(p.cpuspeed.v))
 	}
-	if v, found := p.p["customized"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("customized", vv)
+	if p.customized.ok {
+		u.Set("customized", strconv.FormatBool(p.customized.v))
 	}
-	if v, found := p.p["customizediops"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("customizediops", vv)
+	if p.customizediops.ok {
+		u.Set("customizediops", strconv.FormatBool(p.customizediops.v))
 	}
-	if v, found := p.p["deploymentplanner"]; found {
-		u.Set("deploymentplanner", v.(string))
+	if p.deploymentplanner.ok {
+		u.Set("deploymentplanner", p.deploymentplanner.v)
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["diskofferingstrictness"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("diskofferingstrictness", vv)
+	if p.diskofferingstrictness.ok {
+		u.Set("diskofferingstrictness", strconv.FormatBool(p.diskofferingstrictness.v))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("domainid", vv)
+	if p.domainid.ok {
+		u.Set("domainid", strings.Join(p.domainid.v, ","))
 	}
-	if v, found := p.p["dynamicscalingenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("dynamicscalingenabled", vv)
+	if p.dynamicscalingenabled.ok {
+		u.Set("dynamicscalingenabled", strconv.FormatBool(p.dynamicscalingenabled.v))
 	}
-	if v, found := p.p["encryptroot"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("encryptroot", vv)
+	if p.encryptroot.ok {
+		u.Set("encryptroot", strconv.FormatBool(p.encryptroot.v))
 	}
-	if v, found := p.p["hosttags"]; found {
-		u.Set("hosttags", v.(string))
+	if p.hosttags.ok {
+		u.Set("hosttags", p.hosttags.v)
 	}
-	if v, found := p.p["hypervisorsnapshotreserve"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("hypervisorsnapshotreserve", vv)
+	if p.hypervisorsnapshotreserve.ok {
+		u.Set("hypervisorsnapshotreserve", strconv.Itoa(p.hypervisorsnapshotreserve.v))
 	}
-	if v, found := p.p["iopsreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadrate", vv)
+	if p.iopsreadrate.ok {
+		u.Set("iopsreadrate", strconv.FormatInt(p.iopsreadrate.v, 10))
 	}
-	if v, found := p.p["iopsreadratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadratemax", vv)
+	if p.iopsreadratemax.ok {
+		u.Set("iopsreadratemax", strconv.FormatInt(p.iopsreadratemax.v, 10))
 	}
-	if v, found := p.p["iopsreadratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopsreadratemaxlength", vv)
+	if p.iopsreadratemaxlength.ok {
+		u.Set("iopsreadratemaxlength", strconv.FormatInt(p.iopsreadratemaxlength.v, 10))
 	}
-	if v, found := p.p["iopswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriterate", vv)
+	if p.iopswriterate.ok {
+		u.Set("iopswriterate", strconv.FormatInt(p.iopswriterate.v, 10))
 	}
-	if v, found := p.p["iopswriteratemax"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriteratemax", vv)
+	if p.iopswriteratemax.ok {
+		u.Set("iopswriteratemax", strconv.FormatInt(p.iopswriteratemax.v, 10))
 	}
-	if v, found := p.p["iopswriteratemaxlength"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("iopswriteratemaxlength", vv)
+	if p.iopswriteratemaxlength.ok {
+		u.Set("iopswriteratemaxlength", strconv.FormatInt(p.iopswriteratemaxlength.v, 10))
 	}
-	if v, found := p.p["issystem"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("issystem", vv)
+	if p.issystem.ok {
+		u.Set("issystem", strconv.FormatBool(p.issystem.v))
 	}
-	if v, found := p.p["isvolatile"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isvolatile", vv)
+	if p.isvolatile.ok {
+		u.Set("isvolatile", strconv.FormatBool(p.isvolatile.v))
 	}
-	if v, found := p.p["limitcpuuse"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("limitcpuuse", vv)
+	if p.limitcpuuse.ok {
+		u.Set("limitcpuuse", strconv.FormatBool(p.limitcpuuse.v))
 	}
-	if v, found := p.p["maxcpunumber"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxcpunumber", vv)
+	if p.maxcpunumber.ok {
+		u.Set("maxcpunumber", strconv.Itoa(p.maxcpunumber.v))
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
+	if p.maxiops.ok {
+		u.Set("maxiops", strconv.FormatInt(p.maxiops.v, 10))
 	}
-	if v, found := p.p["maxmemory"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxmemory", vv)
+	if p.maxmemory.ok {
+		u.Set("maxmemory", strconv.Itoa(p.maxmemory.v))
 	}
-	if v, found := p.p["memory"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("memory", vv)
+	if p.memory.ok {
+		u.Set("memory", strconv.Itoa(p.memory.v))
 	}
-	if v, found := p.p["mincpunumber"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("mincpunumber", vv)
+	if p.mincpunumber.ok {
+		u.Set("mincpunumber", strconv.Itoa(p.mincpunumber.v))
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
+	if p.miniops.ok {
+		u.Set("miniops", strconv.FormatInt(p.miniops.v, 10))
 	}
-	if v, found := p.p["minmemory"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("minmemory", vv)
+	if p.minmemory.ok {
+		u.Set("minmemory", strconv.Itoa(p.minmemory.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkrate"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("networkrate", vv)
+	if p.networkrate.ok {
+		u.Set("networkrate", strconv.Itoa(p.networkrate.v))
 	}
-	if v, found := p.p["offerha"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("offerha", vv)
+	if p.offerha.ok {
+		u.Set("offerha", strconv.FormatBool(p.offerha.v))
 	}
-	if v, found := p.p["provisioningtype"]; found {
-		u.Set("provisioningtype", v.(string))
+	if p.provisioningtype.ok {
+		u.Set("provisioningtype", p.provisioningtype.v)
 	}
-	if v, found := p.p["rootdisksize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("rootdisksize", vv)
+	if p.rootdisksize.ok {
+		u.Set("rootdisksize", strconv.FormatInt(p.rootdisksize.v, 10))
 	}
-	if v, found := p.p["serviceofferingdetails"]; found {
-		m := v.(map[string]string)
+	if p.serviceofferingdetails.ok {
+		m := p.serviceofferingdetails.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("serviceofferingdetails[%d].key", i), k)
 			u.Set(fmt.Sprintf("serviceofferingdetails[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["storagepolicy"]; found {
-		u.Set("storagepolicy", v.(string))
+	if p.storagepolicy.ok {
+		u.Set("storagepolicy", p.storagepolicy.v)
 	}
-	if v, found := p.p["storagetype"]; found {
-		u.Set("storagetype", v.(string))
+	if p.storagetype.ok {
+		u.Set("storagetype", p.storagetype.v)
 	}
-	if v, found := p.p["systemvmtype"]; found {
-		u.Set("systemvmtype", v.(string))
+	if p.systemvmtype.ok {
+		u.Set("systemvmtype", p.systemvmtype.v)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if p.tags.ok {
+		u.Set("tags", p.tags.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("zoneid", vv)
+	if p.zoneid.ok {
+		u.Set("zoneid", strings.Join(p.zoneid.v, ","))
 	}
 	return u
 }
 
-func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateServiceOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new CreateServiceOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateServiceOfferingParams(u url.Values) (*CreateServiceOfferingParams, error) {
+	p := &CreateServiceOfferingParams{}
+	if err := checkParamNames("createServiceOffering", u, "bytesreadrate", "bytesreadratemax", "bytesreadratemaxlength", "byteswriterate", "byteswriteratemax", "byteswriteratemaxlength", "cachemode", "cpunumber", "cpuspeed", "customized", "customizediops", "deploymentplanner", "diskofferingid", "diskofferingstrictness", "displaytext", "domainid", "dynamicscalingenabled", "encryptroot", "hosttags", "hypervisorsnapshotreserve", "iopsreadrate", "iopsreadratemax", "iopsreadratemaxlength", "iopswriterate", "iopswriteratemax", "iopswriteratemaxlength", "issystem", "isvolatile", "limitcpuuse", "maxcpunumber", "maxiops", "maxmemory", "memory", "mincpunumber", "miniops", "minmemory", "name", "networkrate", "offerha", "provisioningtype", "rootdisksize", "serviceofferingdetails[]", "storagepolicy", "storagetype", "systemvmtype", "tags", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bytesreadrate"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadrate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadrate: %v", err)
+		}
+		p.SetBytesreadrate(v)
+	}
+	if _, found := u["bytesreadratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadratemax: %v", err)
+		}
+		p.SetBytesreadratemax(v)
+	}
+	if _, found := u["bytesreadratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("bytesreadratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bytesreadratemaxlength: %v", err)
+		}
+		p.SetBytesreadratemaxlength(v)
+	}
+	if _, found := u["byteswriterate"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriterate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriterate: %v", err)
+		}
+		p.SetByteswriterate(v)
+	}
+	if _, found := u["byteswriteratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriteratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriteratemax: %v", err)
+		}
+		p.SetByteswriteratemax(v)
+	}
+	if _, found := u["byteswriteratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("byteswriteratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param byteswriteratemaxlength: %v", err)
+		}
+		p.SetByteswriteratemaxlength(v)
+	}
+	if _, found := u["cachemode"]; found {
+		p.SetCachemode(u.Get("cachemode"))
+	}
+	if _, found := u["cpunumber"]; found {
+		v, err := strconv.Atoi(u.Get("cpunumber"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cpunumber: %v", err)
+		}
+		p.SetCpunumber(v)
+	}
+	if _, found := u["cpuspeed"]; found {
+		v, err := strconv.Atoi(u.Get("cpuspeed"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cpuspeed: %v", err)
+		}
+		p.SetCpuspeed(v)
+	}
+	if _, found := u["customized"]; found {
+		v, err := strconv.ParseBool(u.Get("customized"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param customized: %v", err)
+		}
+		p.SetCustomized(v)
+	}
+	if _, found := u["customizediops"]; found {
+		v, err := strconv.ParseBool(u.Get("customizediops"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param customizediops: %v", err)
+		}
+		p.SetCustomizediops(v)
+	}
+	if _, found := u["deploymentplanner"]; found {
+		p.SetDeploymentplanner(u.Get("deploymentplanner"))
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["diskofferingstrictness"]; found {
+		v, err := strconv.ParseBool(u.Get("diskofferingstrictness"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param diskofferingstrictness: %v", err)
+		}
+		p.SetDiskofferingstrictness(v)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(strings.Split(u.Get("domainid"), ","))
+	}
+	if _, found := u["dynamicscalingenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("dynamicscalingenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param dynamicscalingenabled: %v", err)
+		}
+		p.SetDynamicscalingenabled(v)
+	}
+	if _, found := u["encryptroot"]; found {
+		v, err := strconv.ParseBool(u.Get("encryptroot"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param encryptroot: %v", err)
+		}
+		p.SetEncryptroot(v)
+	}
+	if _, found := u["hosttags"]; found {
+		p.SetHosttags(u.Get("hosttags"))
+	}
+	if _, found := u["hypervisorsnapshotreserve"]; found {
+		v, err := strconv.Atoi(u.Get("hypervisorsnapshotreserve"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param hypervisorsnapshotreserve: %v", err)
+		}
+		p.SetHypervisorsnapshotreserve(v)
+	}
+	if _, found := u["iopsreadrate"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadrate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadrate: %v", err)
+		}
+		p.SetIopsreadrate(v)
+	}
+	if _, found := u["iopsreadratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadratemax: %v", err)
+		}
+		p.SetIopsreadratemax(v)
+	}
+	if _, found := u["iopsreadratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("iopsreadratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopsreadratemaxlength: %v", err)
+		}
+		p.SetIopsreadratemaxlength(v)
+	}
+	if _, found := u["iopswriterate"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriterate"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriterate: %v", err)
+		}
+		p.SetIopswriterate(v)
+	}
+	if _, found := u["iopswriteratemax"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriteratemax"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriteratemax: %v", err)
+		}
+		p.SetIopswriteratemax(v)
+	}
+	if _, found := u["iopswriteratemaxlength"]; found {
+		v, err := strconv.ParseInt(u.Get("iopswriteratemaxlength"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iopswriteratemaxlength: %v", err)
+		}
+		p.SetIopswriteratemaxlength(v)
+	}
+	if _, found := u["issystem"]; found {
+		v, err := strconv.ParseBool(u.Get("issystem"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param issystem: %v", err)
+		}
+		p.SetIssystem(v)
+	}
+	if _, found := u["isvolatile"]; found {
+		v, err := strconv.ParseBool(u.Get("isvolatile"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isvolatile: %v", err)
+		}
+		p.SetIsvolatile(v)
+	}
+	if _, found := u["limitcpuuse"]; found {
+		v, err := strconv.ParseBool(u.Get("limitcpuuse"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param limitcpuuse: %v", err)
+		}
+		p.SetLimitcpuuse(v)
+	}
+	if _, found := u["maxcpunumber"]; found {
+		v, err := strconv.Atoi(u.Get("maxcpunumber"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxcpunumber: %v", err)
+		}
+		p.SetMaxcpunumber(v)
+	}
+	if _, found := u["maxiops"]; found {
+		v, err := strconv.ParseInt(u.Get("maxiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxiops: %v", err)
+		}
+		p.SetMaxiops(v)
+	}
+	if _, found := u["maxmemory"]; found {
+		v, err := strconv.Atoi(u.Get("maxmemory"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxmemory: %v", err)
+		}
+		p.SetMaxmemory(v)
+	}
+	if _, found := u["memory"]; found {
+		v, err := strconv.Atoi(u.Get("memory"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param memory: %v", err)
+		}
+		p.SetMemory(v)
+	}
+	if _, found := u["mincpunumber"]; found {
+		v, err := strconv.Atoi(u.Get("mincpunumber"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param mincpunumber: %v", err)
+		}
+		p.SetMincpunumber(v)
 	}
-	p.p["bytesreadrate"] = v
+	if _, found := u["miniops"]; found {
+		v, err := strconv.ParseInt(u.Get("miniops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param miniops: %v", err)
+		}
+		p.SetMiniops(v)
+	}
+	if _, found := u["minmemory"]; found {
+		v, err := strconv.Atoi(u.Get("minmemory"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param minmemory: %v", err)
+		}
+		p.SetMinmemory(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkrate"]; found {
+		v, err := strconv.Atoi(u.Get("networkrate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param networkrate: %v", err)
+		}
+		p.SetNetworkrate(v)
+	}
+	if _, found := u["offerha"]; found {
+		v, err := strconv.ParseBool(u.Get("offerha"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param offerha: %v", err)
+		}
+		p.SetOfferha(v)
+	}
+	if _, found := u["provisioningtype"]; found {
+		p.SetProvisioningtype(u.Get("provisioningtype"))
+	}
+	if _, found := u["rootdisksize"]; found {
+		v, err := strconv.ParseInt(u.Get("rootdisksize"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param rootdisksize: %v", err)
+		}
+		p.SetRootdisksize(v)
+	}
+	if m, err := parseKeyValueMap(u, "serviceofferingdetails", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetServiceofferingdetails(m)
+	}
+	if _, found := u["storagepolicy"]; found {
+		p.SetStoragepolicy(u.Get("storagepolicy"))
+	}
+	if _, found := u["storagetype"]; found {
+		p.SetStoragetype(u.Get("storagetype"))
+	}
+	if _, found := u["systemvmtype"]; found {
+		p.SetSystemvmtype(u.Get("systemvmtype"))
+	}
+	if _, found := u["tags"]; found {
+		p.SetTags(u.Get("tags"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(strings.Split(u.Get("zoneid"), ","))
+	}
+	return p, nil
 }
 
+// SetBytesreadrate sets the bytesreadrate param.
+func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
+	p.bytesreadrate = optInt64{v: v, ok: true}
+}
+
+// ResetBytesreadrate unsets the bytesreadrate param
 func (p *CreateServiceOfferingParams) ResetBytesreadrate() {
-	if p.p != nil && p.p["bytesreadrate"] != nil {
-		delete(p.p, "bytesreadrate")
-	}
+	p.bytesreadrate = optInt64{}
 }
 
+// GetBytesreadrate returns the bytesreadrate param and if it is set
 func (p *CreateServiceOfferingParams) GetBytesreadrate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadrate"].(int64)
-	return value, ok
+	return p.bytesreadrate.v, p.bytesreadrate.ok
 }
 
+// SetBytesreadratemax sets the bytesreadratemax param.
 func (p *CreateServiceOfferingParams) SetBytesreadratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadratemax"] = v
+	p.bytesreadratemax = optInt64{v: v, ok: true}
 }
 
+// ResetBytesreadratemax unsets the bytesreadratemax param
 func (p *CreateServiceOfferingParams) ResetBytesreadratemax() {
-	if p.p != nil && p.p["bytesreadratemax"] != nil {
-		delete(p.p, "bytesreadratemax")
-	}
+	p.bytesreadratemax = optInt64{}
 }
 
+// GetBytesreadratemax returns the bytesreadratemax param and if it is set
 func (p *CreateServiceOfferingParams) GetBytesreadratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadratemax"].(int64)
-	return value, ok
+	return p.bytesreadratemax.v, p.bytesreadratemax.ok
 }
 
+// SetBytesreadratemaxlength sets the bytesreadratemaxlength param.
 func (p *CreateServiceOfferingParams) SetBytesreadratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadratemaxlength"] = v
+	p.bytesreadratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetBytesreadratemaxlength unsets the bytesreadratemaxlength param
 func (p *CreateServiceOfferingParams) ResetBytesreadratemaxlength() {
-	if p.p != nil && p.p["bytesreadratemaxlength"] != nil {
-		delete(p.p, "bytesreadratemaxlength")
-	}
+	p.bytesreadratemaxlength = optInt64{}
 }
 
+// GetBytesreadratemaxlength returns the bytesreadratemaxlength param and if it is set
 func (p *CreateServiceOfferingParams) GetBytesreadratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bytesreadratemaxlength"].(int64)
-	return value, ok
+	return p.bytesreadratemaxlength.v, p.bytesreadratemaxlength.ok
 }
 
+// SetByteswriterate sets the byteswriterate param.
 func (p *CreateServiceOfferingParams) SetByteswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriterate"] = v
+	p.byteswriterate = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriterate unsets the byteswriterate param
 func (p *CreateServiceOfferingParams) ResetByteswriterate() {
-	if p.p != nil && p.p["byteswriterate"] != nil {
-		delete(p.p, "byteswriterate")
-	}
+	p.byteswriterate = optInt64{}
 }
 
+// GetByteswriterate returns the byteswriterate param and if it is set
 func (p *CreateServiceOfferingParams) GetByteswriterate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriterate"].(int64)
-	return value, ok
+	return p.byteswriterate.v, p.byteswriterate.ok
 }
 
+// SetByteswriteratemax sets the byteswriteratemax param.
 func (p *CreateServiceOfferingParams) SetByteswriteratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriteratemax"] = v
+	p.byteswriteratemax = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriteratemax unsets the byteswriteratemax param
 func (p *CreateServiceOfferingParams) ResetByteswriteratemax() {
-	if p.p != nil && p.p["byteswriteratemax"] != nil {
-		delete(p.p, "byteswriteratemax")
-	}
+	p.byteswriteratemax = optInt64{}
 }
 
+// GetByteswriteratemax returns the byteswriteratemax param and if it is set
 func (p *CreateServiceOfferingParams) GetByteswriteratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriteratemax"].(int64)
-	return value, ok
+	return p.byteswriteratemax.v, p.byteswriteratemax.ok
 }
 
+// SetByteswriteratemaxlength sets the byteswriteratemaxlength param.
 func (p *CreateServiceOfferingParams) SetByteswriteratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["byteswriteratemaxlength"] = v
+	p.byteswriteratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetByteswriteratemaxlength unsets the byteswriteratemaxlength param
 func (p *CreateServiceOfferingParams) ResetByteswriteratemaxlength() {
-	if p.p != nil && p.p["byteswriteratemaxlength"] != nil {
-		delete(p.p, "byteswriteratemaxlength")
-	}
+	p.byteswriteratemaxlength = optInt64{}
 }
 
+// GetByteswriteratemaxlength returns the byteswriteratemaxlength param and if it is set
 func (p *CreateServiceOfferingParams) GetByteswriteratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["byteswriteratemaxlength"].(int64)
-	return value, ok
+	return p.byteswriteratemaxlength.v, p.byteswriteratemaxlength.ok
 }
 
+// SetCachemode sets the cachemode param.
 func (p *CreateServiceOfferingParams) SetCachemode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cachemode"] = v
+	p.cachemode = optString{v: v, ok: true}
 }
 
+// ResetCachemode unsets the cachemode param
 func (p *CreateServiceOfferingParams) ResetCachemode() {
-	if p.p != nil && p.p["cachemode"] != nil {
-		delete(p.p, "cachemode")
-	}
+	p.cachemode = optString{}
 }
 
+// GetCachemode returns the cachemode param and if it is set
 func (p *CreateServiceOfferingParams) GetCachemode() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cachemode"].(string)
-	return value, ok
+	return p.cachemode.v, p.cachemode.ok
 }
 
+// SetCpunumber sets the cpunumber param.
 func (p *CreateServiceOfferingParams) SetCpunumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cpunumber"] = v
+	p.cpunumber = optInt{v: v, ok: true}
 }
 
+// ResetCpunumber unsets the cpunumber param
 func (p *CreateServiceOfferingParams) ResetCpunumber() {
-	if p.p != nil && p.p["cpunumber"] != nil {
-		delete(p.p, "cpunumber")
-	}
+	p.cpunumber = optInt{}
 }
 
+// GetCpunumber returns the cpunumber param and if it is set
 func (p *CreateServiceOfferingParams) GetCpunumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cpunumber"].(int)
-	return value, ok
+	return p.cpunumber.v, p.cpunumber.ok
 }
 
+// SetCpuspeed sets the cpuspeed param.
 func (p *CreateServiceOfferingParams) SetCpuspeed(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cpuspeed"] = v
+	p.cpuspeed = optInt{v: v, ok: true}
 }
 
+// ResetCpuspeed unsets the cpuspeed param
 func (p *CreateServiceOfferingParams) ResetCpuspeed() {
-	if p.p != nil && p.p["cpuspeed"] != nil {
-		delete(p.p, "cpuspeed")
-	}
+	p.cpuspeed = optInt{}
 }
 
+// GetCpuspeed returns the cpuspeed param and if it is set
 func (p *CreateServiceOfferingParams) GetCpuspeed() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cpuspeed"].(int)
-	return value, ok
+	return p.cpuspeed.v, p.cpuspeed.ok
 }
 
+// SetCustomized sets the customized param.
 func (p *CreateServiceOfferingParams) SetCustomized(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customized"] = v
+	p.customized = optBool{v: v, ok: true}
 }
 
+// ResetCustomized unsets the customized param
 func (p *CreateServiceOfferingParams) ResetCustomized() {
-	if p.p != nil && p.p["customized"] != nil {
-		delete(p.p, "customized")
-	}
+	p.customized = optBool{}
 }
 
+// GetCustomized returns the customized param and if it is set
 func (p *CreateServiceOfferingParams) GetCustomized() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customized"].(bool)
-	return value, ok
+	return p.customized.v, p.customized.ok
 }
 
+// SetCustomizediops sets the customizediops param.
 func (p *CreateServiceOfferingParams) SetCustomizediops(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customizediops"] = v
+	p.customizediops = optBool{v: v, ok: true}
 }
 
+// ResetCustomizediops unsets the customizediops param
 func (p *CreateServiceOfferingParams) ResetCustomizediops() {
-	if p.p != nil && p.p["customizediops"] != nil {
-		delete(p.p, "customizediops")
-	}
+	p.customizediops = optBool{}
 }
 
+// GetCustomizediops returns the customizediops param and if it is set
 func (p *CreateServiceOfferingParams) GetCustomizediops() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customizediops"].(bool)
-	return value, ok
+	return p.customizediops.v, p.customizediops.ok
 }
 
+// SetDeploymentplanner sets the deploymentplanner param.
 func (p *CreateServiceOfferingParams) SetDeploymentplanner(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deploymentplanner"] = v
+	p.deploymentplanner = optString{v: v, ok: true}
 }
 
+// ResetDeploymentplanner unsets the deploymentplanner param
 func (p *CreateServiceOfferingParams) ResetDeploymentplanner() {
-	if p.p != nil && p.p["deploymentplanner"] != nil {
-		delete(p.p, "deploymentplanner")
-	}
+	p.deploymentplanner = optString{}
 }
 
+// GetDeploymentplanner returns the deploymentplanner param and if it is set
 func (p *CreateServiceOfferingParams) GetDeploymentplanner() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["deploymentplanner"].(string)
-	return value, ok
+	return p.deploymentplanner.v, p.deploymentplanner.ok
 }
 
+// SetDiskofferingid sets the diskofferingid param.
 func (p *CreateServiceOfferingParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *CreateServiceOfferingParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *CreateServiceOfferingParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetDiskofferingstrictness sets the diskofferingstrictness param.
 func (p *CreateServiceOfferingParams) SetDiskofferingstrictness(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingstrictness"] = v
+	p.diskofferingstrictness = optBool{v: v, ok: true}
 }
 
+// ResetDiskofferingstrictness unsets the diskofferingstrictness param
 func (p *CreateServiceOfferingParams) ResetDiskofferingstrictness() {
-	if p.p != nil && p.p["diskofferingstrictness"] != nil {
-		delete(p.p, "diskofferingstrictness")
-	}
+	p.diskofferingstrictness = optBool{}
 }
 
+// GetDiskofferingstrictness returns the diskofferingstrictness param and if it is set
 func (p *CreateServiceOfferingParams) GetDiskofferingstrictness() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingstrictness"].(bool)
-	return value, ok
+	return p.diskofferingstrictness.v, p.diskofferingstrictness.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *CreateServiceOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateServiceOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateServiceOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateServiceOfferingParams) SetDomainid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optStrings{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateServiceOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optStrings{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateServiceOfferingParams) GetDomainid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].([]string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetDynamicscalingenabled sets the dynamicscalingenabled param.
 func (p *CreateServiceOfferingParams) SetDynamicscalingenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dynamicscalingenabled"] = v
+	p.dynamicscalingenabled = optBool{v: v, ok: true}
 }
 
+// ResetDynamicscalingenabled unsets the dynamicscalingenabled param
 func (p *CreateServiceOfferingParams) ResetDynamicscalingenabled() {
-	if p.p != nil && p.p["dynamicscalingenabled"] != nil {
-		delete(p.p, "dynamicscalingenabled")
-	}
+	p.dynamicscalingenabled = optBool{}
 }
 
+// GetDynamicscalingenabled returns the dynamicscalingenabled param and if it is set
 func (p *CreateServiceOfferingParams) GetDynamicscalingenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dynamicscalingenabled"].(bool)
-	return value, ok
+	return p.dynamicscalingenabled.v, p.dynamicscalingenabled.ok
 }
 
+// SetEncryptroot sets the encryptroot param.
 func (p *CreateServiceOfferingParams) SetEncryptroot(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["encryptroot"] = v
+	p.encryptroot = optBool{v: v, ok: true}
 }
 
+// ResetEncryptroot unsets the encryptroot param
 func (p *CreateServiceOfferingParams) ResetEncryptroot() {
-	if p.p != nil && p.p["encryptroot"] != nil {
-		delete(p.p, "encryptroot")
-	}
+	p.encryptroot = optBool{}
 }
 
+// GetEncryptroot returns the encryptroot param and if it is set
 func (p *CreateServiceOfferingParams) GetEncryptroot() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["encryptroot"].(bool)
-	return value, ok
+	return p.encryptroot.v, p.encryptroot.ok
 }
 
+// SetHosttags sets the hosttags param.
 func (p *CreateServiceOfferingParams) SetHosttags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hosttags"] = v
+	p.hosttags = optString{v: v, ok: true}
 }
 
+// ResetHosttags unsets the hosttags param
 func (p *CreateServiceOfferingParams) ResetHosttags() {
-	if p.p != nil && p.p["hosttags"] != nil {
-		delete(p.p, "hosttags")
-	}
+	p.hosttags = optString{}
 }
 
+// GetHosttags returns the hosttags param and if it is set
 func (p *CreateServiceOfferingParams) GetHosttags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hosttags"].(string)
-	return value, ok
+	return p.hosttags.v, p.hosttags.ok
 }
 
+// SetHypervisorsnapshotreserve sets the hypervisorsnapshotreserve param.
 func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserve(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisorsnapshotreserve"] = v
+	p.hypervisorsnapshotreserve = optInt{v: v, ok: true}
 }
 
+// ResetHypervisorsnapshotreserve unsets the hypervisorsnapshotreserve param
 func (p *CreateServiceOfferingParams) ResetHypervisorsnapshotreserve() {
-	if p.p != nil && p.p["hypervisorsnapshotreserve"] != nil {
-		delete(p.p, "hypervisorsnapshotreserve")
-	}
+	p.hypervisorsnapshotreserve = optInt{}
 }
 
+// GetHypervisorsnapshotreserve returns the hypervisorsnapshotreserve param and if it is set
 func (p *CreateServiceOfferingParams) GetHypervisorsnapshotreserve() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisorsnapshotreserve"].(int)
-	return value, ok
+	return p.hypervisorsnapshotreserve.v, p.hypervisorsnapshotreserve.ok
 }
 
+// SetIopsreadrate sets the iopsreadrate param.
 func (p *CreateServiceOfferingParams) SetIopsreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadrate"] = v
+	p.iopsreadrate = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadrate unsets the iopsreadrate param
 func (p *CreateServiceOfferingParams) ResetIopsreadrate() {
-	if p.p != nil && p.p["iopsreadrate"] != nil {
-		delete(p.p, "iopsreadrate")
-	}
+	p.iopsreadrate = optInt64{}
 }
 
+// GetIopsreadrate returns the iopsreadrate param and if it is set
 func (p *CreateServiceOfferingParams) GetIopsreadrate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadrate"].(int64)
-	return value, ok
+	return p.iopsreadrate.v, p.iopsreadrate.ok
 }
 
+// SetIopsreadratemax sets the iopsreadratemax param.
 func (p *CreateServiceOfferingParams) SetIopsreadratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadratemax"] = v
+	p.iopsreadratemax = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadratemax unsets the iopsreadratemax param
 func (p *CreateServiceOfferingParams) ResetIopsreadratemax() {
-	if p.p != nil && p.p["iopsreadratemax"] != nil {
-		delete(p.p, "iopsreadratemax")
-	}
+	p.iopsreadratemax = optInt64{}
 }
 
+// GetIopsreadratemax returns the iopsreadratemax param and if it is set
 func (p *CreateServiceOfferingParams) GetIopsreadratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadratemax"].(int64)
-	return value, ok
+	return p.iopsreadratemax.v, p.iopsreadratemax.ok
 }
 
+// SetIopsreadratemaxlength sets the iopsreadratemaxlength param.
 func (p *CreateServiceOfferingParams) SetIopsreadratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopsreadratemaxlength"] = v
+	p.iopsreadratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetIopsreadratemaxlength unsets the iopsreadratemaxlength param
 func (p *CreateServiceOfferingParams) ResetIopsreadratemaxlength() {
-	if p.p != nil && p.p["iopsreadratemaxlength"] != nil {
-		delete(p.p, "iopsreadratemaxlength")
-	}
+	p.iopsreadratemaxlength = optInt64{}
 }
 
+// GetIopsreadratemaxlength returns the iopsreadratemaxlength param and if it is set
 func (p *CreateServiceOfferingParams) GetIopsreadratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopsreadratemaxlength"].(int64)
-	return value, ok
+	return p.iopsreadratemaxlength.v, p.iopsreadratemaxlength.ok
 }
 
+// SetIopswriterate sets the iopswriterate param.
 func (p *CreateServiceOfferingParams) SetIopswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriterate"] = v
+	p.iopswriterate = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriterate unsets the iopswriterate param
 func (p *CreateServiceOfferingParams) ResetIopswriterate() {
-	if p.p != nil && p.p["iopswriterate"] != nil {
-		delete(p.p, "iopswriterate")
-	}
+	p.iopswriterate = optInt64{}
 }
 
+// GetIopswriterate returns the iopswriterate param and if it is set
 func (p *CreateServiceOfferingParams) GetIopswriterate() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriterate"].(int64)
-	return value, ok
+	return p.iopswriterate.v, p.iopswriterate.ok
 }
 
+// SetIopswriteratemax sets the iopswriteratemax param.
 func (p *CreateServiceOfferingParams) SetIopswriteratemax(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriteratemax"] = v
+	p.iopswriteratemax = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriteratemax unsets the iopswriteratemax param
 func (p *CreateServiceOfferingParams) ResetIopswriteratemax() {
-	if p.p != nil && p.p["iopswriteratemax"] != nil {
-		delete(p.p, "iopswriteratemax")
-	}
+	p.iopswriteratemax = optInt64{}
 }
 
+// GetIopswriteratemax returns the iopswriteratemax param and if it is set
 func (p *CreateServiceOfferingParams) GetIopswriteratemax() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriteratemax"].(int64)
-	return value, ok
+	return p.iopswriteratemax.v, p.iopswriteratemax.ok
 }
 
+// SetIopswriteratemaxlength sets the iopswriteratemaxlength param.
 func (p *CreateServiceOfferingParams) SetIopswriteratemaxlength(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iopswriteratemaxlength"] = v
+	p.iopswriteratemaxlength = optInt64{v: v, ok: true}
 }
 
+// ResetIopswriteratemaxlength unsets the iopswriteratemaxlength param
 func (p *CreateServiceOfferingParams) ResetIopswriteratemaxlength() {
-	if p.p != nil && p.p["iopswriteratemaxlength"] != nil {
-		delete(p.p, "iopswriteratemaxlength")
-	}
+	p.iopswriteratemaxlength = optInt64{}
 }
 
+// GetIopswriteratemaxlength returns the iopswriteratemaxlength param and if it is set
 func (p *CreateServiceOfferingParams) GetIopswriteratemaxlength() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iopswriteratemaxlength"].(int64)
-	return value, ok
+	return p.iopswriteratemaxlength.v, p.iopswriteratemaxlength.ok
 }
 
+// SetIssystem sets the issystem param.
 func (p *CreateServiceOfferingParams) SetIssystem(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["issystem"] = v
+	p.issystem = optBool{v: v, ok: true}
 }
 
+// ResetIssystem unsets the issystem param
 func (p *CreateServiceOfferingParams) ResetIssystem() {
-	if p.p != nil && p.p["issystem"] != nil {
-		delete(p.p, "issystem")
-	}
+	p.issystem = optBool{}
 }
 
+// GetIssystem returns the issystem param and if it is set
 func (p *CreateServiceOfferingParams) GetIssystem() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["issystem"].(bool)
-	return value, ok
+	return p.issystem.v, p.issystem.ok
 }
 
+// SetIsvolatile sets the isvolatile param.
 func (p *CreateServiceOfferingParams) SetIsvolatile(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isvolatile"] = v
+	p.isvolatile = optBool{v: v, ok: true}
 }
 
+// ResetIsvolatile unsets the isvolatile param
 func (p *CreateServiceOfferingParams) ResetIsvolatile() {
-	if p.p != nil && p.p["isvolatile"] != nil {
-		delete(p.p, "isvolatile")
-	}
+	p.isvolatile = optBool{}
 }
 
+// GetIsvolatile returns the isvolatile param and if it is set
 func (p *CreateServiceOfferingParams) GetIsvolatile() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isvolatile"].(bool)
-	return value, ok
+	return p.isvolatile.v, p.isvolatile.ok
 }
 
+// SetLimitcpuuse sets the limitcpuuse param.
 func (p *CreateServiceOfferingParams) SetLimitcpuuse(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["limitcpuuse"] = v
+	p.limitcpuuse = optBool{v: v, ok: true}
 }
 
+// ResetLimitcpuuse unsets the limitcpuuse param
 func (p *CreateServiceOfferingParams) ResetLimitcpuuse() {
-	if p.p != nil && p.p["limitcpuuse"] != nil {
-		delete(p.p, "limitcpuuse")
-	}
+	p.limitcpuuse = optBool{}
 }
 
+// GetLimitcpuuse returns the limitcpuuse param and if it is set
 func (p *CreateServiceOfferingParams) GetLimitcpuuse() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["limitcpuuse"].(bool)
-	return value, ok
+	return p.limitcpuuse.v, p.limitcpuuse.ok
 }
 
+// SetMaxcpunumber sets the maxcpunumber param.
 func (p *CreateServiceOfferingParams) SetMaxcpunumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxcpunumber"] = v
+	p.maxcpunumber = optInt{v: v, ok: true}
 }
 
+// ResetMaxcpunumber unsets the maxcpunumber param
 func (p *CreateServiceOfferingParams) ResetMaxcpunumber() {
-	if p.p != nil && p.p["maxcpunumber"] != nil {
-		delete(p.p, "maxcpunumber")
-	}
+	p.maxcpunumber = optInt{}
 }
 
+// GetMaxcpunumber returns the maxcpunumber param and if it is set
 func (p *CreateServiceOfferingParams) GetMaxcpunumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxcpunumber"].(int)
-	return value, ok
+	return p.maxcpunumber.v, p.maxcpunumber.ok
 }
 
+// SetMaxiops sets the maxiops param.
 func (p *CreateServiceOfferingParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
+	p.maxiops = optInt64{v: v, ok: true}
 }
 
+// ResetMaxiops unsets the maxiops param
 func (p *CreateServiceOfferingParams) ResetMaxiops() {
-	if p.p != nil && p.p["maxiops"] != nil {
-		delete(p.p, "maxiops")
-	}
+	p.maxiops = optInt64{}
 }
 
+// GetMaxiops returns the maxiops param and if it is set
 func (p *CreateServiceOfferingParams) GetMaxiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxiops"].(int64)
-	return value, ok
+	return p.maxiops.v, p.maxiops.ok
 }
 
+// SetMaxmemory sets the maxmemory param.
 func (p *CreateServiceOfferingParams) SetMaxmemory(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxmemory"] = v
+	p.maxmemory = optInt{v: v, ok: true}
 }
 
+// ResetMaxmemory unsets the maxmemory param
 func (p *CreateServiceOfferingParams) ResetMaxmemory() {
-	if p.p != nil && p.p["maxmemory"] != nil {
-		delete(p.p, "maxmemory")
-	}
+	p.maxmemory = optInt{}
 }
 
+// GetMaxmemory returns the maxmemory param and if it is set
 func (p *CreateServiceOfferingParams) GetMaxmemory() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxmemory"].(int)
-	return value, ok
+	return p.maxmemory.v, p.maxmemory.ok
 }
 
+// SetMemory sets the memory param.
 func (p *CreateServiceOfferingParams) SetMemory(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["memory"] = v
+	p.memory = optInt{v: v, ok: true}
 }
 
+// ResetMemory unsets the memory param
 func (p *CreateServiceOfferingParams) ResetMemory() {
-	if p.p != nil && p.p["memory"] != nil {
-		delete(p.p, "memory")
-	}
+	p.memory = optInt{}
 }
 
+// GetMemory returns the memory param and if it is set
 func (p *CreateServiceOfferingParams) GetMemory() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["memory"].(int)
-	return value, ok
+	return p.memory.v, p.memory.ok
 }
 
+// SetMincpunumber sets the mincpunumber param.
 func (p *CreateServiceOfferingParams) SetMincpunumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["mincpunumber"] = v
+	p.mincpunumber = optInt{v: v, ok: true}
 }
 
+// ResetMincpunumber unsets the mincpunumber param
 func (p *CreateServiceOfferingParams) ResetMincpunumber() {
-	if p.p != nil && p.p["mincpunumber"] != nil {
-		delete(p.p, "mincpunumber")
-	}
+	p.mincpunumber = optInt{}
 }
 
+// GetMincpunumber returns the mincpunumber param and if it is set
 func (p *CreateServiceOfferingParams) GetMincpunumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["mincpunumber"].(int)
-	return value, ok
+	return p.mincpunumber.v, p.mincpunumber.ok
 }
 
+// SetMiniops sets the miniops param.
 func (p *CreateServiceOfferingParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
+	p.miniops = optInt64{v: v, ok: true}
 }
 
+// ResetMiniops unsets the miniops param
 func (p *CreateServiceOfferingParams) ResetMiniops() {
-	if p.p != nil && p.p["miniops"] != nil {
-		delete(p.p, "miniops")
-	}
+	p.miniops = optInt64{}
 }
 
+// GetMiniops returns the miniops param and if it is set
 func (p *CreateServiceOfferingParams) GetMiniops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["miniops"].(int64)
-	return value, ok
+	return p.miniops.v, p.miniops.ok
 }
 
+// SetMinmemory sets the minmemory param.
 func (p *CreateServiceOfferingParams) SetMinmemory(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minmemory"] = v
+	p.minmemory = optInt{v: v, ok: true}
 }
 
+// ResetMinmemory unsets the minmemory param
 func (p *CreateServiceOfferingParams) ResetMinmemory() {
-	if p.p != nil && p.p["minmemory"] != nil {
-		delete(p.p, "minmemory")
-	}
+	p.minmemory = optInt{}
 }
 
+// GetMinmemory returns the minmemory param and if it is set
 func (p *CreateServiceOfferingParams) GetMinmemory() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["minmemory"].(int)
-	return value, ok
+	return p.minmemory.v, p.minmemory.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateServiceOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateServiceOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateServiceOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkrate sets the networkrate param.
 func (p *CreateServiceOfferingParams) SetNetworkrate(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkrate"] = v
+	p.networkrate = optInt{v: v, ok: true}
 }
 
+// ResetNetworkrate unsets the networkrate param
 func (p *CreateServiceOfferingParams) ResetNetworkrate() {
-	if p.p != nil && p.p["networkrate"] != nil {
-		delete(p.p, "networkrate")
-	}
+	p.networkrate = optInt{}
 }
 
+// GetNetworkrate returns the networkrate param and if it is set
 func (p *CreateServiceOfferingParams) GetNetworkrate() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkrate"].(int)
-	return value, ok
+	return p.networkrate.v, p.networkrate.ok
 }
 
+// SetOfferha sets the offerha param.
 func (p *CreateServiceOfferingParams) SetOfferha(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["offerha"] = v
+	p.offerha = optBool{v: v, ok: true}
 }
 
+// ResetOfferha unsets the offerha param
 func (p *CreateServiceOfferingParams) ResetOfferha() {
-	if p.p != nil && p.p["offerha"] != nil {
-		delete(p.p, "offerha")
-	}
+	p.offerha = optBool{}
 }
 
+// GetOfferha returns the offerha param and if it is set
 func (p *CreateServiceOfferingParams) GetOfferha() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["offerha"].(bool)
-	return value, ok
+	return p.offerha.v, p.offerha.ok
 }
 
+// SetProvisioningtype sets the provisioningtype param.
 func (p *CreateServiceOfferingParams) SetProvisioningtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provisioningtype"] = v
+	p.provisioningtype = optString{v: v, ok: true}
 }
 
+// ResetProvisioningtype unsets the provisioningtype param
 func (p *CreateServiceOfferingParams) ResetProvisioningtype() {
-	if p.p != nil && p.p["provisioningtype"] != nil {
-		delete(p.p, "provisioningtype")
-	}
+	p.provisioningtype = optString{}
 }
 
+// GetProvisioningtype returns the provisioningtype param and if it is set
 func (p *CreateServiceOfferingParams) GetProvisioningtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["provisioningtype"].(string)
-	return value, ok
+	return p.provisioningtype.v, p.provisioningtype.ok
 }
 
+// SetRootdisksize sets the rootdisksize param.
 func (p *CreateServiceOfferingParams) SetRootdisksize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["rootdisksize"] = v
+	p.rootdisksize = optInt64{v: v, ok: true}
 }
 
+// ResetRootdisksize unsets the rootdisksize param
 func (p *CreateServiceOfferingParams) ResetRootdisksize() {
-	if p.p != nil && p.p["rootdisksize"] != nil {
-		delete(p.p, "rootdisksize")
-	}
+	p.rootdisksize = optInt64{}
 }
 
+// GetRootdisksize returns the rootdisksize param and if it is set
 func (p *CreateServiceOfferingParams) GetRootdisksize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["rootdisksize"].(int64)
-	return value, ok
+	return p.rootdisksize.v, p.rootdisksize.ok
 }
 
+// SetServiceofferingdetails sets the serviceofferingdetails param.
 func (p *CreateServiceOfferingParams) SetServiceofferingdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingdetails"] = v
+	p.serviceofferingdetails = optStringMap{v: v, ok: true}
 }
 
+// ResetServiceofferingdetails unsets the serviceofferingdetails param
 func (p *CreateServiceOfferingParams) ResetServiceofferingdetails() {
-	if p.p != nil && p.p["serviceofferingdetails"] != nil {
-		delete(p.p, "serviceofferingdetails")
-	}
+	p.serviceofferingdetails = optStringMap{}
 }
 
+// GetServiceofferingdetails returns the serviceofferingdetails param and if it is set
 func (p *CreateServiceOfferingParams) GetServiceofferingdetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingdetails"].(map[string]string)
-	return value, ok
+	return p.serviceofferingdetails.v, p.serviceofferingdetails.ok
 }
 
+// SetStoragepolicy sets the storagepolicy param.
 func (p *CreateServiceOfferingParams) SetStoragepolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagepolicy"] = v
+	p.storagepolicy = optString{v: v, ok: true}
 }
 
+// ResetStoragepolicy unsets the storagepolicy param
 func (p *CreateServiceOfferingParams) ResetStoragepolicy() {
-	if p.p != nil && p.p["storagepolicy"] != nil {
-		delete(p.p, "storagepolicy")
-	}
+	p.storagepolicy = optString{}
 }
 
+// GetStoragepolicy returns the storagepolicy param and if it is set
 func (p *CreateServiceOfferingParams) GetStoragepolicy() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagepolicy"].(string)
-	return value, ok
+	return p.storagepolicy.v, p.storagepolicy.ok
 }
 
+// SetStoragetype sets the storagetype param.
 func (p *CreateServiceOfferingParams) SetStoragetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagetype"] = v
+	p.storagetype = optString{v: v, ok: true}
 }
 
+// ResetStoragetype unsets the storagetype param
 func (p *CreateServiceOfferingParams) ResetStoragetype() {
-	if p.p != nil && p.p["storagetype"] != nil {
-		delete(p.p, "storagetype")
-	}
+	p.storagetype = optString{}
 }
 
+// GetStoragetype returns the storagetype param and if it is set
 func (p *CreateServiceOfferingParams) GetStoragetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagetype"].(string)
-	return value, ok
+	return p.storagetype.v, p.storagetype.ok
 }
 
+// SetSystemvmtype sets the systemvmtype param.
 func (p *CreateServiceOfferingParams) SetSystemvmtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["systemvmtype"] = v
+	p.systemvmtype = optString{v: v, ok: true}
 }
 
+// ResetSystemvmtype unsets the systemvmtype param
 func (p *CreateServiceOfferingParams) ResetSystemvmtype() {
-	if p.p != nil && p.p["systemvmtype"] != nil {
-		delete(p.p, "systemvmtype")
-	}
+	p.systemvmtype = optString{}
 }
 
+// GetSystemvmtype returns the systemvmtype param and if it is set
 func (p *CreateServiceOfferingParams) GetSystemvmtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["systemvmtype"].(string)
-	return value, ok
+	return p.systemvmtype.v, p.systemvmtype.ok
 }
 
+// SetTags sets the tags param.
 func (p *CreateServiceOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optString{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreateServiceOfferingParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optString{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreateServiceOfferingParams) GetTags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateServiceOfferingParams) SetZoneid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optStrings{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateServiceOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optStrings{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateServiceOfferingParams) GetZoneid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateServiceOfferingParams) Clone() *CreateServiceOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.domainid = p.domainid.clone()
+	c.serviceofferingdetails = p.serviceofferingdetails.clone()
+	c.zoneid = p.zoneid.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateServiceOfferingParams) Equal(o *CreateServiceOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bytesreadrate == o.bytesreadrate &&
+		p.bytesreadratemax == o.bytesreadratemax &&
+		p.bytesreadratemaxlength == o.bytesreadratemaxlength &&
+		p.byteswriterate == o.byteswriterate &&
+		p.byteswriteratemax == o.byteswriteratemax &&
+		p.byteswriteratemaxlength == o.byteswriteratemaxlength &&
+		p.cachemode == o.cachemode &&
+		p.cpunumber == o.cpunumber &&
+		p.cpuspeed == o.cpuspeed &&
+		p.customized == o.customized &&
+		p.customizediops == o.customizediops &&
+		p.deploymentplanner == o.deploymentplanner &&
+		p.diskofferingid == o.diskofferingid &&
+		p.diskofferingstrictness == o.diskofferingstrictness &&
+		p.displaytext == o.displaytext &&
+		p.domainid.equal(o.domainid) &&
+		p.dynamicscalingenabled == o.dynamicscalingenabled &&
+		p.encryptroot == o.encryptroot &&
+		p.hosttags == o.hosttags &&
+		p.hypervisorsnapshotreserve == o.hypervisorsnapshotreserve &&
+		p.iopsreadrate == o.iopsreadrate &&
+		p.iopsreadratemax == o.iopsreadratemax &&
+		p.iopsreadratemaxlength == o.iopsreadratemaxlength &&
+		p.iopswriterate == o.iopswriterate &&
+		p.iopswriteratemax == o.iopswriteratemax &&
+		p.iopswriteratemaxlength == o.iopswriteratemaxlength &&
+		p.issystem == o.issystem &&
+		p.isvolatile == o.isvolatile &&
+		p.limitcpuuse == o.limitcpuuse &&
+		p.maxcpunumber == o.maxcpunumber &&
+		p.maxiops == o.maxiops &&
+		p.maxmemory == o.maxmemory &&
+		p.memory == o.memory &&
+		p.mincpunumber == o.mincpunumber &&
+		p.miniops == o.miniops &&
+		p.minmemory == o.minmemory &&
+		p.name == o.name &&
+		p.networkrate == o.networkrate &&
+		p.offerha == o.offerha &&
+		p.provisioningtype == o.provisioningtype &&
+		p.rootdisksize == o.rootdisksize &&
+		p.serviceofferingdetails.equal(o.serviceofferingdetails) &&
+		p.storagepolicy == o.storagepolicy &&
+		p.storagetype == o.storagetype &&
+		p.systemvmtype == o.systemvmtype &&
+		p.tags == o.tags &&
+		p.zoneid.equal(o.zoneid)
+}
+
+// serializedCreateServiceOfferingParams is used to (un)marshal CreateServiceOfferingParams using the API param names
+type serializedCreateServiceOfferingParams struct {
+	Bytesreadrate             *int64             `json:"bytesreadrate,omitempty" yaml:"bytesreadrate,omitempty"`
+	Bytesreadratemax          *int64             `json:"bytesreadratemax,omitempty" yaml:"bytesreadratemax,omitempty"`
+	Bytesreadratemaxlength    *int64             `json:"bytesreadratemaxlength,omitempty" yaml:"bytesreadratemaxlength,omitempty"`
+	Byteswriterate            *int64             `json:"byteswriterate,omitempty" yaml:"byteswriterate,omitempty"`
+	Byteswriteratemax         *int64             `json:"byteswriteratemax,omitempty" yaml:"byteswriteratemax,omitempty"`
+	Byteswriteratemaxlength   *int64             `json:"byteswriteratemaxlength,omitempty" yaml:"byteswriteratemaxlength,omitempty"`
+	Cachemode                 *string            `json:"cachemode,omitempty" yaml:"cachemode,omitempty"`
+	Cpunumber                 *int               `json:"cpunumber,omitempty" yaml:"cpunumber,omitempty"`
+	Cpuspeed                  *int               `json:"cpuspeed,omitempty" yaml:"cpuspeed,omitempty"`
+	Customized                *bool              `json:"customized,omitempty" yaml:"customized,omitempty"`
+	Customizediops            *bool              `json:"customizediops,omitempty" yaml:"customizediops,omitempty"`
+	Deploymentplanner         *string            `json:"deploymentplanner,omitempty" yaml:"deploymentplanner,omitempty"`
+	Diskofferingid            *string            `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Diskofferingstrictness    *bool              `json:"diskofferingstrictness,omitempty" yaml:"diskofferingstrictness,omitempty"`
+	Displaytext               *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid                  *[]string          `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Dynamicscalingenabled     *bool              `json:"dynamicscalingenabled,omitempty" yaml:"dynamicscalingenabled,omitempty"`
+	Encryptroot               *bool              `json:"encryptroot,omitempty" yaml:"encryptroot,omitempty"`
+	Hosttags                  *string            `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Hypervisorsnapshotreserve *int               `json:"hypervisorsnapshotreserve,omitempty" yaml:"hypervisorsnapshotreserve,omitempty"`
+	Iopsreadrate              *int64             `json:"iopsreadrate,omitempty" yaml:"iopsreadrate,omitempty"`
+	Iopsreadratemax           *int64             `json:"iopsreadratemax,omitempty" yaml:"iopsreadratemax,omitempty"`
+	Iopsreadratemaxlength     *int64             `json:"iopsreadratemaxlength,omitempty" yaml:"iopsreadratemaxlength,omitempty"`
+	Iopswriterate             *int64             `json:"iopswriterate,omitempty" yaml:"iopswriterate,omitempty"`
+	Iopswriteratemax          *int64             `json:"iopswriteratemax,omitempty" yaml:"iopswriteratemax,omitempty"`
+	Iopswriteratemaxlength    *int64             `json:"iopswriteratemaxlength,omitempty" yaml:"iopswriteratemaxlength,omitempty"`
+	Issystem                  *bool              `json:"issystem,omitempty" yaml:"issystem,omitempty"`
+	Isvolatile                *bool              `json:"isvolatile,omitempty" yaml:"isvolatile,omitempty"`
+	Limitcpuuse               *bool              `json:"limitcpuuse,omitempty" yaml:"limitcpuuse,omitempty"`
+	Maxcpunumber              *int               `json:"maxcpunumber,omitempty" yaml:"maxcpunumber,omitempty"`
+	Maxiops                   *int64             `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Maxmemory                 *int               `json:"maxmemory,omitempty" yaml:"maxmemory,omitempty"`
+	Memory                    *int               `json:"memory,omitempty" yaml:"memory,omitempty"`
+	Mincpunumber              *int               `json:"mincpunumber,omitempty" yaml:"mincpunumber,omitempty"`
+	Miniops                   *int64             `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Minmemory                 *int               `json:"minmemory,omitempty" yaml:"minmemory,omitempty"`
+	Name                      *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkrate               *int               `json:"networkrate,omitempty" yaml:"networkrate,omitempty"`
+	Offerha                   *bool              `json:"offerha,omitempty" yaml:"offerha,omitempty"`
+	Provisioningtype          *string            `json:"provisioningtype,omitempty" yaml:"provisioningtype,omitempty"`
+	Rootdisksize              *int64             `json:"rootdisksize,omitempty" yaml:"rootdisksize,omitempty"`
+	Serviceofferingdetails    *map[string]string `json:"serviceofferingdetails,omitempty" yaml:"serviceofferingdetails,omitempty"`
+	Storagepolicy             *string            `json:"storagepolicy,omitempty" yaml:"storagepolicy,omitempty"`
+	Storagetype               *string            `json:"storagetype,omitempty" yaml:"storagetype,omitempty"`
+	Systemvmtype              *string            `json:"systemvmtype,omitempty" yaml:"systemvmtype,omitempty"`
+	Tags                      *string            `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Zoneid                    *[]string          `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateServiceOfferingParams) toSerialized() *serializedCreateServiceOfferingParams {
+	s := &serializedCreateServiceOfferingParams{}
+	if p.bytesreadrate.ok {
+		s.Bytesreadrate = &p.bytesreadrate.v
+	}
+	if p.bytesreadratemax.ok {
+		s.Bytesreadratemax = &p.bytesreadratemax.v
+	}
+	if p.bytesreadratemaxlength.ok {
+		s.Bytesreadratemaxlength = &p.bytesreadratemaxlength.v
+	}
+	if p.byteswriterate.ok {
+		s.Byteswriterate = &p.byteswriterate.v
+	}
+	if p.byteswriteratemax.ok {
+		s.Byteswriteratemax = &p.byteswriteratemax.v
+	}
+	if p.byteswriteratemaxlength.ok {
+		s.Byteswriteratemaxlength = &p.byteswriteratemaxlength.v
+	}
+	if p.cachemode.ok {
+		s.Cachemode = &p.cachemode.v
+	}
+	if p.cpunumber.ok {
+		s.Cpunumber = &p.cpunumber.v
+	}
+	if p.cpuspeed.ok {
+		s.Cpuspeed = &p.cpuspeed.v
+	}
+	if p.customized.ok {
+		s.Customized = &p.customized.v
+	}
+	if p.customizediops.ok {
+		s.Customizediops = &p.customizediops.v
+	}
+	if p.deploymentplanner.ok {
+		s.Deploymentplanner = &p.deploymentplanner.v
+	}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.diskofferingstrictness.ok {
+		s.Diskofferingstrictness = &p.diskofferingstrictness.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.dynamicscalingenabled.ok {
+		s.Dynamicscalingenabled = &p.dynamicscalingenabled.v
+	}
+	if p.encryptroot.ok {
+		s.Encryptroot = &p.encryptroot.v
+	}
+	if p.hosttags.ok {
+		s.Hosttags = &p.hosttags.v
+	}
+	if p.hypervisorsnapshotreserve.ok {
+		s.Hypervisorsnapshotreserve = &p.hypervisorsnapshotreserve.v
+	}
+	if p.iopsreadrate.ok {
+		s.Iopsreadrate = &p.iopsreadrate.v
+	}
+	if p.iopsreadratemax.ok {
+		s.Iopsreadratemax = &p.iopsreadratemax.v
+	}
+	if p.iopsreadratemaxlength.ok {
+		s.Iopsreadratemaxlength = &p.iopsreadratemaxlength.v
+	}
+	if p.iopswriterate.ok {
+		s.Iopswriterate = &p.iopswriterate.v
+	}
+	if p.iopswriteratemax.ok {
+		s.Iopswriteratemax = &p.iopswriteratemax.v
+	}
+	if p.iopswriteratemaxlength.ok {
+		s.Iopswriteratemaxlength = &p.iopswriteratemaxlength.v
+	}
+	if p.issystem.ok {
+		s.Issystem = &p.issystem.v
+	}
+	if p.isvolatile.ok {
+		s.Isvolatile = &p.isvolatile.v
+	}
+	if p.limitcpuuse.ok {
+		s.Limitcpuuse = &p.limitcpuuse.v
+	}
+	if p.maxcpunumber.ok {
+		s.Maxcpunumber = &p.maxcpunumber.v
+	}
+	if p.maxiops.ok {
+		s.Maxiops = &p.maxiops.v
+	}
+	if p.maxmemory.ok {
+		s.Maxmemory = &p.maxmemory.v
+	}
+	if p.memory.ok {
+		s.Memory = &p.memory.v
+	}
+	if p.mincpunumber.ok {
+		s.Mincpunumber = &p.mincpunumber.v
+	}
+	if p.miniops.ok {
+		s.Miniops = &p.miniops.v
+	}
+	if p.minmemory.ok {
+		s.Minmemory = &p.minmemory.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
 	}
-	value, ok := p.p["zoneid"].([]string)
-	return value, ok
+	if p.networkrate.ok {
+		s.Networkrate = &p.networkrate.v
+	}
+	if p.offerha.ok {
+		s.Offerha = &p.offerha.v
+	}
+	if p.provisioningtype.ok {
+		s.Provisioningtype = &p.provisioningtype.v
+	}
+	if p.rootdisksize.ok {
+		s.Rootdisksize = &p.rootdisksize.v
+	}
+	if p.serviceofferingdetails.ok {
+		s.Serviceofferingdetails = &p.serviceofferingdetails.v
+	}
+	if p.storagepolicy.ok {
+		s.Storagepolicy = &p.storagepolicy.v
+	}
+	if p.storagetype.ok {
+		s.Storagetype = &p.storagetype.v
+	}
+	if p.systemvmtype.ok {
+		s.Systemvmtype = &p.systemvmtype.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateServiceOfferingParams) fromSerialized(s *serializedCreateServiceOfferingParams) {
+	*p = CreateServiceOfferingParams{}
+	if s.Bytesreadrate != nil {
+		p.SetBytesreadrate(*s.Bytesreadrate)
+	}
+	if s.Bytesreadratemax != nil {
+		p.SetBytesreadratemax(*s.Bytesreadratemax)
+	}
+	if s.Bytesreadratemaxlength != nil {
+		p.SetBytesreadratemaxlength(*s.Bytesreadratemaxlength)
+	}
+	if s.Byteswriterate != nil {
+		p.SetByteswriterate(*s.Byteswriterate)
+	}
+	if s.Byteswriteratemax != nil {
+		p.SetByteswriteratemax(*s.Byteswriteratemax)
+	}
+	if s.Byteswriteratemaxlength != nil {
+		p.SetByteswriteratemaxlength(*s.Byteswriteratemaxlength)
+	}
+	if s.Cachemode != nil {
+		p.SetCachemode(*s.Cachemode)
+	}
+	if s.Cpunumber != nil {
+		p.SetCpunumber(*s.Cpunumber)
+	}
+	if s.Cpuspeed != nil {
+		p.SetCpuspeed(*s.Cpuspeed)
+	}
+	if s.Customized != nil {
+		p.SetCustomized(*s.Customized)
+	}
+	if s.Customizediops != nil {
+		p.SetCustomizediops(*s.Customizediops)
+	}
+	if s.Deploymentplanner != nil {
+		p.SetDeploymentplanner(*s.Deploymentplanner)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Diskofferingstrictness != nil {
+		p.SetDiskofferingstrictness(*s.Diskofferingstrictness)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Dynamicscalingenabled != nil {
+		p.SetDynamicscalingenabled(*s.Dynamicscalingenabled)
+	}
+	if s.Encryptroot != nil {
+		p.SetEncryptroot(*s.Encryptroot)
+	}
+	if s.Hosttags != nil {
+		p.SetHosttags(*s.Hosttags)
+	}
+	if s.Hypervisorsnapshotreserve != nil {
+		p.SetHypervisorsnapshotreserve(*s.Hypervisorsnapshotreserve)
+	}
+	if s.Iopsreadrate != nil {
+		p.SetIopsreadrate(*s.Iopsreadrate)
+	}
+	if s.Iopsreadratemax != nil {
+		p.SetIopsreadratemax(*s.Iopsreadratemax)
+	}
+	if s.Iopsreadratemaxlength != nil {
+		p.SetIopsreadratemaxlength(*s.Iopsreadratemaxlength)
+	}
+	if s.Iopswriterate != nil {
+		p.SetIopswriterate(*s.Iopswriterate)
+	}
+	if s.Iopswriteratemax != nil {
+		p.SetIopswriteratemax(*s.Iopswriteratemax)
+	}
+	if s.Iopswriteratemaxlength != nil {
+		p.SetIopswriteratemaxlength(*s.Iopswriteratemaxlength)
+	}
+	if s.Issystem != nil {
+		p.SetIssystem(*s.Issystem)
+	}
+	if s.Isvolatile != nil {
+		p.SetIsvolatile(*s.Isvolatile)
+	}
+	if s.Limitcpuuse != nil {
+		p.SetLimitcpuuse(*s.Limitcpuuse)
+	}
+	if s.Maxcpunumber != nil {
+		p.SetMaxcpunumber(*s.Maxcpunumber)
+	}
+	if s.Maxiops != nil {
+		p.SetMaxiops(*s.Maxiops)
+	}
+	if s.Maxmemory != nil {
+		p.SetMaxmemory(*s.Maxmemory)
+	}
+	if s.Memory != nil {
+		p.SetMemory(*s.Memory)
+	}
+	if s.Mincpunumber != nil {
+		p.SetMincpunumber(*s.Mincpunumber)
+	}
+	if s.Miniops != nil {
+		p.SetMiniops(*s.Miniops)
+	}
+	if s.Minmemory != nil {
+		p.SetMinmemory(*s.Minmemory)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkrate != nil {
+		p.SetNetworkrate(*s.Networkrate)
+	}
+	if s.Offerha != nil {
+		p.SetOfferha(*s.Offerha)
+	}
+	if s.Provisioningtype != nil {
+		p.SetProvisioningtype(*s.Provisioningtype)
+	}
+	if s.Rootdisksize != nil {
+		p.SetRootdisksize(*s.Rootdisksize)
+	}
+	if s.Serviceofferingdetails != nil {
+		p.SetServiceofferingdetails(*s.Serviceofferingdetails)
+	}
+	if s.Storagepolicy != nil {
+		p.SetStoragepolicy(*s.Storagepolicy)
+	}
+	if s.Storagetype != nil {
+		p.SetStoragetype(*s.Storagetype)
+	}
+	if s.Systemvmtype != nil {
+		p.SetSystemvmtype(*s.Systemvmtype)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateServiceOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateServiceOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateServiceOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateServiceOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateServiceOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams {
 	p := &CreateServiceOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
 	return p
 }
 
 // Creates a service offering.
-func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createServiceOffering", p.toURLValues())
+//
+// Required params: displaytext, name.
+func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams, opts ...CallOption) (*CreateServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequest("createServiceOffering", s.cs.encodeParams("createServiceOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1305,54 +1762,133 @@ type CreateServiceOfferingResponse struct {
 	Zoneid                      string            `json:"zoneid"`
 }
 
-type DeleteServiceOfferingParams struct {
-	p map[string]interface{}
+type DeleteServiceOfferingParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteServiceOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseDeleteServiceOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteServiceOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteServiceOfferingParams(u url.Values) (*DeleteServiceOfferingParams, error) {
+	p := &DeleteServiceOfferingParams{}
+	if err := checkParamNames("deleteServiceOffering", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteServiceOfferingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteServiceOfferingParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteServiceOfferingParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteServiceOfferingParams) Clone() *DeleteServiceOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
 }
 
-func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteServiceOfferingParams) Equal(o *DeleteServiceOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	return p.id == o.id
+}
+
+// serializedDeleteServiceOfferingParams is used to (un)marshal DeleteServiceOfferingParams using the API param names
+type serializedDeleteServiceOfferingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteServiceOfferingParams) toSerialized() *serializedDeleteServiceOfferingParams {
+	s := &serializedDeleteServiceOfferingParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	return u
+	return s
 }
 
-func (p *DeleteServiceOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeleteServiceOfferingParams) fromSerialized(s *serializedDeleteServiceOfferingParams) {
+	*p = DeleteServiceOfferingParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	p.p["id"] = v
 }
 
-func (p *DeleteServiceOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteServiceOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *DeleteServiceOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteServiceOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteServiceOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteServiceOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteServiceOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams {
 	p := &DeleteServiceOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a service offering.
-func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteServiceOffering", p.toURLValues())
+//
+// Required params: id.
+func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams, opts ...CallOption) (*DeleteServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequest("deleteServiceOffering", s.cs.encodeParams("deleteServiceOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1400,496 +1936,707 @@ func (r *DeleteServiceOfferingResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListServiceOfferingsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListServiceOfferingsParams) toURLValues() url.Values {
+	account          optString
+	cpunumber        optInt
+	cpuspeed         optInt
+	domainid         optString
+	encryptroot      optBool
+	id               optString
+	isrecursive      optBool
+	issystem         optBool
+	keyword          optString
+	listall          optBool
+	memory           optInt
+	name             optString
+	page             optInt
+	pagesize         optInt
+	projectid        optString
+	storagetype      optString
+	systemvmtype     optString
+	virtualmachineid optString
+	zoneid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListServiceOfferingsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["cpunumber"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("cpunumber", vv)
+	if p.cpunumber.ok {
+		u.Set("cpunumber", strconv.Itoa(p.cpunumber.v))
 	}
-	if v, found := p.p["cpuspeed"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("cpuspeed", vv)
+	if p.cpuspeed.ok {
+		u.Set("cpuspeed", strconv.Itoa(p.cpuspeed.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["encryptroot"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("encryptroot", vv)
+	if p.encryptroot.ok {
+		u.Set("encryptroot", strconv.FormatBool(p.encryptroot.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["issystem"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("issystem", vv)
+	if p.issystem.ok {
+		u.Set("issystem", strconv.FormatBool(p.issystem.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["memory"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("memory", vv)
+	if p.memory.ok {
+		u.Set("memory", strconv.Itoa(p.memory.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["storagetype"]; found {
-		u.Set("storagetype", v.(string))
+	if p.storagetype.ok {
+		u.Set("storagetype", p.storagetype.v)
 	}
-	if v, found := p.p["systemvmtype"]; found {
-		u.Set("systemvmtype", v.(string))
+	if p.systemvmtype.ok {
+		u.Set("systemvmtype", p.systemvmtype.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListServiceOfferingsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListServiceOfferingsParams parses url.Values, for example taken from a raw API request,
+// into a new ListServiceOfferingsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListServiceOfferingsParams(u url.Values) (*ListServiceOfferingsParams, error) {
+	p := &ListServiceOfferingsParams{}
+	if err := checkParamNames("listServiceOfferings", u, "account", "cpunumber", "cpuspeed", "domainid", "encryptroot", "id", "isrecursive", "issystem", "keyword", "listall", "memory", "name", "page", "pagesize", "projectid", "storagetype", "systemvmtype", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["cpunumber"]; found {
+		v, err := strconv.Atoi(u.Get("cpunumber"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cpunumber: %v", err)
+		}
+		p.SetCpunumber(v)
+	}
+	if _, found := u["cpuspeed"]; found {
+		v, err := strconv.Atoi(u.Get("cpuspeed"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cpuspeed: %v", err)
+		}
+		p.SetCpuspeed(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["encryptroot"]; found {
+		v, err := strconv.ParseBool(u.Get("encryptroot"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param encryptroot: %v", err)
+		}
+		p.SetEncryptroot(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["issystem"]; found {
+		v, err := strconv.ParseBool(u.Get("issystem"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param issystem: %v", err)
+		}
+		p.SetIssystem(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
 	}
-	p.p["account"] = v
+	if _, found := u["memory"]; found {
+		v, err := strconv.Atoi(u.Get("memory"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param memory: %v", err)
+		}
+		p.SetMemory(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["storagetype"]; found {
+		p.SetStoragetype(u.Get("storagetype"))
+	}
+	if _, found := u["systemvmtype"]; found {
+		p.SetSystemvmtype(u.Get("systemvmtype"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListServiceOfferingsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListServiceOfferingsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListServiceOfferingsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCpunumber sets the cpunumber param.
 func (p *ListServiceOfferingsParams) SetCpunumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cpunumber"] = v
+	p.cpunumber = optInt{v: v, ok: true}
 }
 
+// ResetCpunumber unsets the cpunumber param
 func (p *ListServiceOfferingsParams) ResetCpunumber() {
-	if p.p != nil && p.p["cpunumber"] != nil {
-		delete(p.p, "cpunumber")
-	}
+	p.cpunumber = optInt{}
 }
 
+// GetCpunumber returns the cpunumber param and if it is set
 func (p *ListServiceOfferingsParams) GetCpunumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cpunumber"].(int)
-	return value, ok
+	return p.cpunumber.v, p.cpunumber.ok
 }
 
+// SetCpuspeed sets the cpuspeed param.
 func (p *ListServiceOfferingsParams) SetCpuspeed(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cpuspeed"] = v
+	p.cpuspeed = optInt{v: v, ok: true}
 }
 
+// ResetCpuspeed unsets the cpuspeed param
 func (p *ListServiceOfferingsParams) ResetCpuspeed() {
-	if p.p != nil && p.p["cpuspeed"] != nil {
-		delete(p.p, "cpuspeed")
-	}
+	p.cpuspeed = optInt{}
 }
 
+// GetCpuspeed returns the cpuspeed param and if it is set
 func (p *ListServiceOfferingsParams) GetCpuspeed() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cpuspeed"].(int)
-	return value, ok
+	return p.cpuspeed.v, p.cpuspeed.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListServiceOfferingsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListServiceOfferingsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListServiceOfferingsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEncryptroot sets the encryptroot param.
 func (p *ListServiceOfferingsParams) SetEncryptroot(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["encryptroot"] = v
+	p.encryptroot = optBool{v: v, ok: true}
 }
 
+// ResetEncryptroot unsets the encryptroot param
 func (p *ListServiceOfferingsParams) ResetEncryptroot() {
-	if p.p != nil && p.p["encryptroot"] != nil {
-		delete(p.p, "encryptroot")
-	}
+	p.encryptroot = optBool{}
 }
 
+// GetEncryptroot returns the encryptroot param and if it is set
 func (p *ListServiceOfferingsParams) GetEncryptroot() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["encryptroot"].(bool)
-	return value, ok
+	return p.encryptroot.v, p.encryptroot.ok
 }
 
+// SetId sets the id param.
 func (p *ListServiceOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListServiceOfferingsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListServiceOfferingsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListServiceOfferingsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListServiceOfferingsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListServiceOfferingsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetIssystem sets the issystem param.
 func (p *ListServiceOfferingsParams) SetIssystem(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["issystem"] = v
+	p.issystem = optBool{v: v, ok: true}
 }
 
+// ResetIssystem unsets the issystem param
 func (p *ListServiceOfferingsParams) ResetIssystem() {
-	if p.p != nil && p.p["issystem"] != nil {
-		delete(p.p, "issystem")
-	}
+	p.issystem = optBool{}
 }
 
+// GetIssystem returns the issystem param and if it is set
 func (p *ListServiceOfferingsParams) GetIssystem() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["issystem"].(bool)
-	return value, ok
+	return p.issystem.v, p.issystem.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListServiceOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListServiceOfferingsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListServiceOfferingsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListServiceOfferingsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListServiceOfferingsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListServiceOfferingsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetMemory sets the memory param.
 func (p *ListServiceOfferingsParams) SetMemory(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["memory"] = v
+	p.memory = optInt{v: v, ok: true}
 }
 
+// ResetMemory unsets the memory param
 func (p *ListServiceOfferingsParams) ResetMemory() {
-	if p.p != nil && p.p["memory"] != nil {
-		delete(p.p, "memory")
-	}
+	p.memory = optInt{}
 }
 
+// GetMemory returns the memory param and if it is set
 func (p *ListServiceOfferingsParams) GetMemory() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["memory"].(int)
-	return value, ok
+	return p.memory.v, p.memory.ok
 }
 
+// SetName sets the name param.
 func (p *ListServiceOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListServiceOfferingsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListServiceOfferingsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListServiceOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListServiceOfferingsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListServiceOfferingsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListServiceOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListServiceOfferingsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListServiceOfferingsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListServiceOfferingsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListServiceOfferingsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListServiceOfferingsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetStoragetype sets the storagetype param.
 func (p *ListServiceOfferingsParams) SetStoragetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagetype"] = v
+	p.storagetype = optString{v: v, ok: true}
 }
 
+// ResetStoragetype unsets the storagetype param
 func (p *ListServiceOfferingsParams) ResetStoragetype() {
-	if p.p != nil && p.p["storagetype"] != nil {
-		delete(p.p, "storagetype")
-	}
+	p.storagetype = optString{}
 }
 
+// GetStoragetype returns the storagetype param and if it is set
 func (p *ListServiceOfferingsParams) GetStoragetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagetype"].(string)
-	return value, ok
+	return p.storagetype.v, p.storagetype.ok
 }
 
+// SetSystemvmtype sets the systemvmtype param.
 func (p *ListServiceOfferingsParams) SetSystemvmtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["systemvmtype"] = v
+	p.systemvmtype = optString{v: v, ok: true}
 }
 
+// ResetSystemvmtype unsets the systemvmtype param
 func (p *ListServiceOfferingsParams) ResetSystemvmtype() {
-	if p.p != nil && p.p["systemvmtype"] != nil {
-		delete(p.p, "systemvmtype")
-	}
+	p.systemvmtype = optString{}
 }
 
+// GetSystemvmtype returns the systemvmtype param and if it is set
 func (p *ListServiceOfferingsParams) GetSystemvmtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["systemvmtype"].(string)
-	return value, ok
+	return p.systemvmtype.v, p.systemvmtype.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListServiceOfferingsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListServiceOfferingsParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListServiceOfferingsParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListServiceOfferingsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListServiceOfferingsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListServiceOfferingsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListServiceOfferingsParams) Clone() *ListServiceOfferingsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListServiceOfferingsParams) Equal(o *ListServiceOfferingsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.cpunumber == o.cpunumber &&
+		p.cpuspeed == o.cpuspeed &&
+		p.domainid == o.domainid &&
+		p.encryptroot == o.encryptroot &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.issystem == o.issystem &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.memory == o.memory &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.storagetype == o.storagetype &&
+		p.systemvmtype == o.systemvmtype &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListServiceOfferingsParams is used to (un)marshal ListServiceOfferingsParams using the API param names
+type serializedListServiceOfferingsParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Cpunumber        *int    `json:"cpunumber,omitempty" yaml:"cpunumber,omitempty"`
+	Cpuspeed         *int    `json:"cpuspeed,omitempty" yaml:"cpuspeed,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Encryptroot      *bool   `json:"encryptroot,omitempty" yaml:"encryptroot,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Issystem         *bool   `json:"issystem,omitempty" yaml:"issystem,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Memory           *int    `json:"memory,omitempty" yaml:"memory,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Storagetype      *string `json:"storagetype,omitempty" yaml:"storagetype,omitempty"`
+	Systemvmtype     *string `json:"systemvmtype,omitempty" yaml:"systemvmtype,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListServiceOfferingsParams) toSerialized() *serializedListServiceOfferingsParams {
+	s := &serializedListServiceOfferingsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.cpunumber.ok {
+		s.Cpunumber = &p.cpunumber.v
+	}
+	if p.cpuspeed.ok {
+		s.Cpuspeed = &p.cpuspeed.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.encryptroot.ok {
+		s.Encryptroot = &p.encryptroot.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.issystem.ok {
+		s.Issystem = &p.issystem.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.memory.ok {
+		s.Memory = &p.memory.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.storagetype.ok {
+		s.Storagetype = &p.storagetype.v
+	}
+	if p.systemvmtype.ok {
+		s.Systemvmtype = &p.systemvmtype.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListServiceOfferingsParams) fromSerialized(s *serializedListServiceOfferingsParams) {
+	*p = ListServiceOfferingsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Cpunumber != nil {
+		p.SetCpunumber(*s.Cpunumber)
+	}
+	if s.Cpuspeed != nil {
+		p.SetCpuspeed(*s.Cpuspeed)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Encryptroot != nil {
+		p.SetEncryptroot(*s.Encryptroot)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Issystem != nil {
+		p.SetIssystem(*s.Issystem)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Memory != nil {
+		p.SetMemory(*s.Memory)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Storagetype != nil {
+		p.SetStoragetype(*s.Storagetype)
+	}
+	if s.Systemvmtype != nil {
+		p.SetSystemvmtype(*s.Systemvmtype)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListServiceOfferingsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListServiceOfferingsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListServiceOfferingsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListServiceOfferingsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListServiceOfferingsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListServiceOfferingsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListServiceOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewListServiceOfferingsParams() *ListServiceOfferingsParams {
 	p := &ListServiceOfferingsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListServiceOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1937,9 +2684,8 @@ func (s *ServiceOfferingService) GetServiceOfferingByName(name string, opts ...O
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
 	p := &ListServiceOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1968,8 +2714,8 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 }
 
 // Lists all available service offerings.
-func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listServiceOfferings", p.toURLValues())
+func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams, opts ...CallOption) (*ListServiceOfferingsResponse, error) {
+	resp, err := s.cs.newRequest("listServiceOfferings", s.cs.encodeParams("listServiceOfferings", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2044,222 +2790,346 @@ type ServiceOffering struct {
 }
 
 type UpdateServiceOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
+	displaytext optString
+	domainid    optString
+	hosttags    optString
+	id          optString
+	name        optString
+	sortkey     optInt
+	storagetags optString
+	zoneid      optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateServiceOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hosttags"]; found {
-		u.Set("hosttags", v.(string))
+	if p.hosttags.ok {
+		u.Set("hosttags", p.hosttags.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if p.sortkey.ok {
+		u.Set("sortkey", strconv.Itoa(p.sortkey.v))
 	}
-	if v, found := p.p["storagetags"]; found {
-		u.Set("storagetags", v.(string))
+	if p.storagetags.ok {
+		u.Set("storagetags", p.storagetags.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateServiceOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateServiceOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateServiceOfferingParams(u url.Values) (*UpdateServiceOfferingParams, error) {
+	p := &UpdateServiceOfferingParams{}
+	if err := checkParamNames("updateServiceOffering", u, "displaytext", "domainid", "hosttags", "id", "name", "sortkey", "storagetags", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["hosttags"]; found {
+		p.SetHosttags(u.Get("hosttags"))
 	}
-	p.p["displaytext"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["sortkey"]; found {
+		v, err := strconv.Atoi(u.Get("sortkey"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sortkey: %v", err)
+		}
+		p.SetSortkey(v)
+	}
+	if _, found := u["storagetags"]; found {
+		p.SetStoragetags(u.Get("storagetags"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetDisplaytext sets the displaytext param.
+func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateServiceOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateServiceOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateServiceOfferingParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateServiceOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateServiceOfferingParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHosttags sets the hosttags param.
 func (p *UpdateServiceOfferingParams) SetHosttags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hosttags"] = v
+	p.hosttags = optString{v: v, ok: true}
 }
 
+// ResetHosttags unsets the hosttags param
 func (p *UpdateServiceOfferingParams) ResetHosttags() {
-	if p.p != nil && p.p["hosttags"] != nil {
-		delete(p.p, "hosttags")
-	}
+	p.hosttags = optString{}
 }
 
+// GetHosttags returns the hosttags param and if it is set
 func (p *UpdateServiceOfferingParams) GetHosttags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hosttags"].(string)
-	return value, ok
+	return p.hosttags.v, p.hosttags.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateServiceOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateServiceOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateServiceOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateServiceOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateServiceOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateServiceOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetSortkey sets the sortkey param.
 func (p *UpdateServiceOfferingParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
+	p.sortkey = optInt{v: v, ok: true}
 }
 
+// ResetSortkey unsets the sortkey param
 func (p *UpdateServiceOfferingParams) ResetSortkey() {
-	if p.p != nil && p.p["sortkey"] != nil {
-		delete(p.p, "sortkey")
-	}
+	p.sortkey = optInt{}
 }
 
+// GetSortkey returns the sortkey param and if it is set
 func (p *UpdateServiceOfferingParams) GetSortkey() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sortkey"].(int)
-	return value, ok
+	return p.sortkey.v, p.sortkey.ok
 }
 
+// SetStoragetags sets the storagetags param.
 func (p *UpdateServiceOfferingParams) SetStoragetags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagetags"] = v
+	p.storagetags = optString{v: v, ok: true}
 }
 
+// ResetStoragetags unsets the storagetags param
 func (p *UpdateServiceOfferingParams) ResetStoragetags() {
-	if p.p != nil && p.p["storagetags"] != nil {
-		delete(p.p, "storagetags")
-	}
+	p.storagetags = optString{}
 }
 
+// GetStoragetags returns the storagetags param and if it is set
 func (p *UpdateServiceOfferingParams) GetStoragetags() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storagetags"].(string)
-	return value, ok
+	return p.storagetags.v, p.storagetags.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *UpdateServiceOfferingParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *UpdateServiceOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *UpdateServiceOfferingParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateServiceOfferingParams) Clone() *UpdateServiceOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateServiceOfferingParams) Equal(o *UpdateServiceOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.hosttags == o.hosttags &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.sortkey == o.sortkey &&
+		p.storagetags == o.storagetags &&
+		p.zoneid == o.zoneid
+}
+
+// serializedUpdateServiceOfferingParams is used to (un)marshal UpdateServiceOfferingParams using the API param names
+type serializedUpdateServiceOfferingParams struct {
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hosttags    *string `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey     *int    `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+	Storagetags *string `json:"storagetags,omitempty" yaml:"storagetags,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *UpdateServiceOfferingParams) toSerialized() *serializedUpdateServiceOfferingParams {
+	s := &serializedUpdateServiceOfferingParams{}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hosttags.ok {
+		s.Hosttags = &p.hosttags.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.sortkey.ok {
+		s.Sortkey = &p.sortkey.v
+	}
+	if p.storagetags.ok {
+		s.Storagetags = &p.storagetags.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *UpdateServiceOfferingParams) fromSerialized(s *serializedUpdateServiceOfferingParams) {
+	*p = UpdateServiceOfferingParams{}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hosttags != nil {
+		p.SetHosttags(*s.Hosttags)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Sortkey != nil {
+		p.SetSortkey(*s.Sortkey)
+	}
+	if s.Storagetags != nil {
+		p.SetStoragetags(*s.Storagetags)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateServiceOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateServiceOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateServiceOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateServiceOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateServiceOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams {
 	p := &UpdateServiceOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a service offering.
-func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateServiceOffering", p.toURLValues())
+//
+// Required params: id.
+func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams, opts ...CallOption) (*UpdateServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequest("updateServiceOffering", s.cs.encodeParams("updateServiceOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +53,43 @@ func (m *MockServiceOfferingServiceIface) EXPECT() *MockServiceOfferingServiceIf
 }
 
 // CreateServiceOffering mocks base method.
-func (m *MockServiceOfferingServiceIface) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
+func (m *MockServiceOfferingServiceIface) CreateServiceOffering(p *CreateServiceOfferingParams, opts ...CallOption) (*CreateServiceOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateServiceOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceOffering", varargs...)
 	ret0, _ := ret[0].(*CreateServiceOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateServiceOffering indicates an expected call of CreateServiceOffering.
-func (mr *MockServiceOfferingServiceIfaceMockRecorder) CreateServiceOffering(p interface{}) *gomock.Call {
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) CreateServiceOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).CreateServiceOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).CreateServiceOffering), varargs...)
 }
 
 // DeleteServiceOffering mocks base method.
-func (m *MockServiceOfferingServiceIface) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
+func (m *MockServiceOfferingServiceIface) DeleteServiceOffering(p *DeleteServiceOfferingParams, opts ...CallOption) (*DeleteServiceOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteServiceOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceOffering", varargs...)
 	ret0, _ := ret[0].(*DeleteServiceOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteServiceOffering indicates an expected call of DeleteServiceOffering.
-func (mr *MockServiceOfferingServiceIfaceMockRecorder) DeleteServiceOffering(p interface{}) *gomock.Call {
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) DeleteServiceOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).DeleteServiceOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).DeleteServiceOffering), varargs...)
 }
 
 // GetServiceOfferingByID mocks base method.
@@ -146,18 +156,23 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) GetServiceOfferingID(name
 }
 
 // ListServiceOfferings mocks base method.
-func (m *MockServiceOfferingServiceIface) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+func (m *MockServiceOfferingServiceIface) ListServiceOfferings(p *ListServiceOfferingsParams, opts ...CallOption) (*ListServiceOfferingsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListServiceOfferings", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceOfferings", varargs...)
 	ret0, _ := ret[0].(*ListServiceOfferingsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListServiceOfferings indicates an expected call of ListServiceOfferings.
-func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferings(p interface{}) *gomock.Call {
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferings(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferings", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferings), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferings", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferings), varargs...)
 }
 
 // NewCreateServiceOfferingParams mocks base method.
@@ -217,16 +232,21 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) NewUpdateServiceOfferingP
 }
 
 // UpdateServiceOffering mocks base method.
-func (m *MockServiceOfferingServiceIface) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+func (m *MockServiceOfferingServiceIface) UpdateServiceOffering(p *UpdateServiceOfferingParams, opts ...CallOption) (*UpdateServiceOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateServiceOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceOffering", varargs...)
 	ret0, _ := ret[0].(*UpdateServiceOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateServiceOffering indicates an expected call of UpdateServiceOffering.
-func (mr *MockServiceOfferingServiceIfaceMockRecorder) UpdateServiceOffering(p interface{}) *gomock.Call {
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) UpdateServiceOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).UpdateServiceOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).UpdateServiceOffering), varargs...)
 }
@@ -28,308 +28,451 @@ import (
 )
 
 type SnapshotServiceIface interface {
-	CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshot(p *CreateSnapshotParams, opts ...CallOption) (*CreateSnapshotResponse, error)
 	NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams
-	CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error)
+	CreateSnapshotPolicy(p *CreateSnapshotPolicyParams, opts ...CallOption) (*CreateSnapshotPolicyResponse, error)
 	NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams
-	CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshot(p *CreateVMSnapshotParams, opts ...CallOption) (*CreateVMSnapshotResponse, error)
 	NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams
-	DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshot(p *DeleteSnapshotParams, opts ...CallOption) (*DeleteSnapshotResponse, error)
 	NewDeleteSnapshotParams(id string) *DeleteSnapshotParams
-	DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams, opts ...CallOption) (*DeleteSnapshotPoliciesResponse, error)
 	NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams
-	DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshot(p *DeleteVMSnapshotParams, opts ...CallOption) (*DeleteVMSnapshotResponse, error)
 	NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams
-	ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPolicies(p *ListSnapshotPoliciesParams, opts ...CallOption) (*ListSnapshotPoliciesResponse, error)
 	NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams
 	GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error)
-	ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListSnapshots(p *ListSnapshotsParams, opts ...CallOption) (*ListSnapshotsResponse, error)
 	NewListSnapshotsParams() *ListSnapshotsParams
 	GetSnapshotID(name string, opts ...OptionFunc) (string, int, error)
 	GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error)
 	GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error)
-	ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	ListVMSnapshot(p *ListVMSnapshotParams, opts ...CallOption) (*ListVMSnapshotResponse, error)
 	NewListVMSnapshotParams() *ListVMSnapshotParams
 	GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error)
-	RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshot(p *RevertSnapshotParams, opts ...CallOption) (*RevertSnapshotResponse, error)
 	NewRevertSnapshotParams(id string) *RevertSnapshotParams
-	RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshot(p *RevertToVMSnapshotParams, opts ...CallOption) (*RevertToVMSnapshotResponse, error)
 	NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams
-	UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams, opts ...CallOption) (*UpdateSnapshotPolicyResponse, error)
 	NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams
 }
 
 type CreateSnapshotParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateSnapshotParams) toURLValues() url.Values {
+	account      optString
+	asyncbackup  optBool
+	domainid     optString
+	locationtype optString
+	name         optString
+	policyid     optString
+	quiescevm    optBool
+	tags         optStringMap
+	volumeid     optString
+	zoneids      optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["asyncbackup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("asyncbackup", vv)
+	if p.asyncbackup.ok {
+		u.Set("asyncbackup", strconv.FormatBool(p.asyncbackup.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["locationtype"]; found {
-		u.Set("locationtype", v.(string))
+	if p.locationtype.ok {
+		u.Set("locationtype", p.locationtype.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["policyid"]; found {
-		u.Set("policyid", v.(string))
+	if p.policyid.ok {
+		u.Set("policyid", p.policyid.v)
 	}
-	if v, found := p.p["quiescevm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("quiescevm", vv)
+	if p.quiescevm.ok {
+		u.Set("quiescevm", strconv.FormatBool(p.quiescevm.v))
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
-	if v, found := p.p["zoneids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("zoneids", vv)
+	if p.zoneids.ok {
+		u.Set("zoneids", strings.Join(p.zoneids.v, ","))
 	}
 	return u
 }
 
-func (p *CreateSnapshotParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateSnapshotParams parses url.Values, for example taken from a raw API request,
+// into a new CreateSnapshotParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateSnapshotParams(u url.Values) (*CreateSnapshotParams, error) {
+	p := &CreateSnapshotParams{}
+	if err := checkParamNames("createSnapshot", u, "account", "asyncbackup", "domainid", "locationtype", "name", "policyid", "quiescevm", "tags[]", "volumeid", "zoneids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["asyncbackup"]; found {
+		v, err := strconv.ParseBool(u.Get("asyncbackup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param asyncbackup: %v", err)
+		}
+		p.SetAsyncbackup(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["locationtype"]; found {
+		p.SetLocationtype(u.Get("locationtype"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["policyid"]; found {
+		p.SetPolicyid(u.Get("policyid"))
+	}
+	if _, found := u["quiescevm"]; found {
+		v, err := strconv.ParseBool(u.Get("quiescevm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param quiescevm: %v", err)
+		}
+		p.SetQuiescevm(v)
 	}
-	p.p["account"] = v
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
+	}
+	if _, found := u["zoneids"]; found {
+		p.SetZoneids(strings.Split(u.Get("zoneids"), ","))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateSnapshotParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateSnapshotParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateSnapshotParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAsyncbackup sets the asyncbackup param.
 func (p *CreateSnapshotParams) SetAsyncbackup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["asyncbackup"] = v
+	p.asyncbackup = optBool{v: v, ok: true}
 }
 
+// ResetAsyncbackup unsets the asyncbackup param
 func (p *CreateSnapshotParams) ResetAsyncbackup() {
-	if p.p != nil && p.p["asyncbackup"] != nil {
-		delete(p.p, "asyncbackup")
-	}
+	p.asyncbackup = optBool{}
 }
 
+// GetAsyncbackup returns the asyncbackup param and if it is set
 func (p *CreateSnapshotParams) GetAsyncbackup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["asyncbackup"].(bool)
-	return value, ok
+	return p.asyncbackup.v, p.asyncbackup.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateSnapshotParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateSnapshotParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateSnapshotParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetLocationtype sets the locationtype param.
 func (p *CreateSnapshotParams) SetLocationtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["locationtype"] = v
+	p.locationtype = optString{v: v, ok: true}
 }
 
+// ResetLocationtype unsets the locationtype param
 func (p *CreateSnapshotParams) ResetLocationtype() {
-	if p.p != nil && p.p["locationtype"] != nil {
-		delete(p.p, "locationtype")
-	}
+	p.locationtype = optString{}
 }
 
+// GetLocationtype returns the locationtype param and if it is set
 func (p *CreateSnapshotParams) GetLocationtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["locationtype"].(string)
-	return value, ok
+	return p.locationtype.v, p.locationtype.ok
 }
 
+// SetName sets the name param.
 func (p *CreateSnapshotParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateSnapshotParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateSnapshotParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPolicyid sets the policyid param.
 func (p *CreateSnapshotParams) SetPolicyid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["policyid"] = v
+	p.policyid = optString{v: v, ok: true}
 }
 
+// ResetPolicyid unsets the policyid param
 func (p *CreateSnapshotParams) ResetPolicyid() {
-	if p.p != nil && p.p["policyid"] != nil {
-		delete(p.p, "policyid")
-	}
+	p.policyid = optString{}
 }
 
+// GetPolicyid returns the policyid param and if it is set
 func (p *CreateSnapshotParams) GetPolicyid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["policyid"].(string)
-	return value, ok
+	return p.policyid.v, p.policyid.ok
 }
 
+// SetQuiescevm sets the quiescevm param.
 func (p *CreateSnapshotParams) SetQuiescevm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiescevm"] = v
+	p.quiescevm = optBool{v: v, ok: true}
 }
 
+// ResetQuiescevm unsets the quiescevm param
 func (p *CreateSnapshotParams) ResetQuiescevm() {
-	if p.p != nil && p.p["quiescevm"] != nil {
-		delete(p.p, "quiescevm")
-	}
+	p.quiescevm = optBool{}
 }
 
+// GetQuiescevm returns the quiescevm param and if it is set
 func (p *CreateSnapshotParams) GetQuiescevm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["quiescevm"].(bool)
-	return value, ok
+	return p.quiescevm.v, p.quiescevm.ok
 }
 
+// SetTags sets the tags param.
 func (p *CreateSnapshotParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreateSnapshotParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreateSnapshotParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetVolumeid sets the volumeid param. This param is required.
 func (p *CreateSnapshotParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
+	p.volumeid = optString{v: v, ok: true}
 }
 
+// ResetVolumeid unsets the volumeid param
 func (p *CreateSnapshotParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *CreateSnapshotParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+	return p.volumeid.v, p.volumeid.ok
 }
 
+// SetZoneids sets the zoneids param.
 func (p *CreateSnapshotParams) SetZoneids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneids"] = v
+	p.zoneids = optStrings{v: v, ok: true}
 }
 
+// ResetZoneids unsets the zoneids param
 func (p *CreateSnapshotParams) ResetZoneids() {
-	if p.p != nil && p.p["zoneids"] != nil {
-		delete(p.p, "zoneids")
-	}
+	p.zoneids = optStrings{}
 }
 
+// GetZoneids returns the zoneids param and if it is set
 func (p *CreateSnapshotParams) GetZoneids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneids.v, p.zoneids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateSnapshotParams) Clone() *CreateSnapshotParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	c.zoneids = p.zoneids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateSnapshotParams) Equal(o *CreateSnapshotParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.asyncbackup == o.asyncbackup &&
+		p.domainid == o.domainid &&
+		p.locationtype == o.locationtype &&
+		p.name == o.name &&
+		p.policyid == o.policyid &&
+		p.quiescevm == o.quiescevm &&
+		p.tags.equal(o.tags) &&
+		p.volumeid == o.volumeid &&
+		p.zoneids.equal(o.zoneids)
+}
+
+// serializedCreateSnapshotParams is used to (un)marshal CreateSnapshotParams using the API param names
+type serializedCreateSnapshotParams struct {
+	Account      *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Asyncbackup  *bool              `json:"asyncbackup,omitempty" yaml:"asyncbackup,omitempty"`
+	Domainid     *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Locationtype *string            `json:"locationtype,omitempty" yaml:"locationtype,omitempty"`
+	Name         *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Policyid     *string            `json:"policyid,omitempty" yaml:"policyid,omitempty"`
+	Quiescevm    *bool              `json:"quiescevm,omitempty" yaml:"quiescevm,omitempty"`
+	Tags         *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Volumeid     *string            `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+	Zoneids      *[]string          `json:"zoneids,omitempty" yaml:"zoneids,omitempty"`
+}
+
+func (p *CreateSnapshotParams) toSerialized() *serializedCreateSnapshotParams {
+	s := &serializedCreateSnapshotParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.asyncbackup.ok {
+		s.Asyncbackup = &p.asyncbackup.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.locationtype.ok {
+		s.Locationtype = &p.locationtype.v
 	}
-	value, ok := p.p["zoneids"].([]string)
-	return value, ok
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.policyid.ok {
+		s.Policyid = &p.policyid.v
+	}
+	if p.quiescevm.ok {
+		s.Quiescevm = &p.quiescevm.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	if p.zoneids.ok {
+		s.Zoneids = &p.zoneids.v
+	}
+	return s
+}
+
+func (p *CreateSnapshotParams) fromSerialized(s *serializedCreateSnapshotParams) {
+	*p = CreateSnapshotParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Asyncbackup != nil {
+		p.SetAsyncbackup(*s.Asyncbackup)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Locationtype != nil {
+		p.SetLocationtype(*s.Locationtype)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Policyid != nil {
+		p.SetPolicyid(*s.Policyid)
+	}
+	if s.Quiescevm != nil {
+		p.SetQuiescevm(*s.Quiescevm)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+	if s.Zoneids != nil {
+		p.SetZoneids(*s.Zoneids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateSnapshotParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateSnapshotParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateSnapshotParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateSnapshotParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateSnapshotParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateSnapshotParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams {
 	p := &CreateSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
+	p.SetVolumeid(volumeid)
 	return p
 }
 
 // Creates an instant snapshot of a volume.
-func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshot", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: volumeid.
+func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams, opts ...CallOption) (*CreateSnapshotResponse, error) {
+	resp, err := s.cs.newRequest("createSnapshot", s.cs.encodeParams("createSnapshot", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -340,8 +483,8 @@ func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapsh
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -425,232 +568,362 @@ func (r *CreateSnapshotResponse) UnmarshalJSON(b []byte) error {
 }
 
 type CreateSnapshotPolicyParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
+	fordisplay   optBool
+	intervaltype optString
+	maxsnaps     optInt
+	schedule     optString
+	tags         optStringMap
+	timezone     optString
+	volumeid     optString
+	zoneids      optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateSnapshotPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
+	if p.intervaltype.ok {
+		u.Set("intervaltype", p.intervaltype.v)
 	}
-	if v, found := p.p["maxsnaps"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxsnaps", vv)
+	if p.maxsnaps.ok {
+		u.Set("maxsnaps", strconv.Itoa(p.maxsnaps.v))
 	}
-	if v, found := p.p["schedule"]; found {
-		u.Set("schedule", v.(string))
+	if p.schedule.ok {
+		u.Set("schedule", p.schedule.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
-	if v, found := p.p["zoneids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("zoneids", vv)
+	if p.zoneids.ok {
+		u.Set("zoneids", strings.Join(p.zoneids.v, ","))
 	}
 	return u
 }
 
-func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateSnapshotPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new CreateSnapshotPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateSnapshotPolicyParams(u url.Values) (*CreateSnapshotPolicyParams, error) {
+	p := &CreateSnapshotPolicyParams{}
+	if err := checkParamNames("createSnapshotPolicy", u, "fordisplay", "intervaltype", "maxsnaps", "schedule", "tags[]", "timezone", "volumeid", "zoneids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["intervaltype"]; found {
+		p.SetIntervaltype(u.Get("intervaltype"))
+	}
+	if _, found := u["maxsnaps"]; found {
+		v, err := strconv.Atoi(u.Get("maxsnaps"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxsnaps: %v", err)
+		}
+		p.SetMaxsnaps(v)
+	}
+	if _, found := u["schedule"]; found {
+		p.SetSchedule(u.Get("schedule"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
 	}
-	p.p["fordisplay"] = v
+	if _, found := u["zoneids"]; found {
+		p.SetZoneids(strings.Split(u.Get("zoneids"), ","))
+	}
+	return p, nil
+}
+
+// SetFordisplay sets the fordisplay param.
+func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateSnapshotPolicyParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateSnapshotPolicyParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIntervaltype sets the intervaltype param. This param is required.
 func (p *CreateSnapshotPolicyParams) SetIntervaltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["intervaltype"] = v
+	p.intervaltype = optString{v: v, ok: true}
 }
 
+// ResetIntervaltype unsets the intervaltype param
 func (p *CreateSnapshotPolicyParams) ResetIntervaltype() {
-	if p.p != nil && p.p["intervaltype"] != nil {
-		delete(p.p, "intervaltype")
-	}
+	p.intervaltype = optString{}
 }
 
+// GetIntervaltype returns the intervaltype param and if it is set
 func (p *CreateSnapshotPolicyParams) GetIntervaltype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["intervaltype"].(string)
-	return value, ok
+	return p.intervaltype.v, p.intervaltype.ok
 }
 
+// SetMaxsnaps sets the maxsnaps param. This param is required.
 func (p *CreateSnapshotPolicyParams) SetMaxsnaps(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxsnaps"] = v
+	p.maxsnaps = optInt{v: v, ok: true}
 }
 
+// ResetMaxsnaps unsets the maxsnaps param
 func (p *CreateSnapshotPolicyParams) ResetMaxsnaps() {
-	if p.p != nil && p.p["maxsnaps"] != nil {
-		delete(p.p, "maxsnaps")
-	}
+	p.maxsnaps = optInt{}
 }
 
+// GetMaxsnaps returns the maxsnaps param and if it is set
 func (p *CreateSnapshotPolicyParams) GetMaxsnaps() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxsnaps"].(int)
-	return value, ok
+	return p.maxsnaps.v, p.maxsnaps.ok
 }
 
+// SetSchedule sets the schedule param. This param is required.
 func (p *CreateSnapshotPolicyParams) SetSchedule(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["schedule"] = v
+	p.schedule = optString{v: v, ok: true}
 }
 
+// ResetSchedule unsets the schedule param
 func (p *CreateSnapshotPolicyParams) ResetSchedule() {
-	if p.p != nil && p.p["schedule"] != nil {
-		delete(p.p, "schedule")
-	}
+	p.schedule = optString{}
 }
 
+// GetSchedule returns the schedule param and if it is set
 func (p *CreateSnapshotPolicyParams) GetSchedule() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["schedule"].(string)
-	return value, ok
+	return p.schedule.v, p.schedule.ok
 }
 
+// SetTags sets the tags param.
 func (p *CreateSnapshotPolicyParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *CreateSnapshotPolicyParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *CreateSnapshotPolicyParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetTimezone sets the timezone param. This param is required.
 func (p *CreateSnapshotPolicyParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timezone"] = v
+	p.timezone = optString{v: v, ok: true}
 }
 
+// ResetTimezone unsets the timezone param
 func (p *CreateSnapshotPolicyParams) ResetTimezone() {
-	if p.p != nil && p.p["timezone"] != nil {
-		delete(p.p, "timezone")
-	}
+	p.timezone = optString{}
 }
 
+// GetTimezone returns the timezone param and if it is set
 func (p *CreateSnapshotPolicyParams) GetTimezone() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["timezone"].(string)
-	return value, ok
+	return p.timezone.v, p.timezone.ok
 }
 
+// SetVolumeid sets the volumeid param. This param is required.
 func (p *CreateSnapshotPolicyParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
+	p.volumeid = optString{v: v, ok: true}
 }
 
+// ResetVolumeid unsets the volumeid param
 func (p *CreateSnapshotPolicyParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *CreateSnapshotPolicyParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+	return p.volumeid.v, p.volumeid.ok
 }
 
+// SetZoneids sets the zoneids param.
 func (p *CreateSnapshotPolicyParams) SetZoneids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneids"] = v
+	p.zoneids = optStrings{v: v, ok: true}
 }
 
+// ResetZoneids unsets the zoneids param
 func (p *CreateSnapshotPolicyParams) ResetZoneids() {
-	if p.p != nil && p.p["zoneids"] != nil {
-		delete(p.p, "zoneids")
-	}
+	p.zoneids = optStrings{}
 }
 
+// GetZoneids returns the zoneids param and if it is set
 func (p *CreateSnapshotPolicyParams) GetZoneids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneids.v, p.zoneids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateSnapshotPolicyParams) Clone() *CreateSnapshotPolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	c.zoneids = p.zoneids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateSnapshotPolicyParams) Equal(o *CreateSnapshotPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.intervaltype == o.intervaltype &&
+		p.maxsnaps == o.maxsnaps &&
+		p.schedule == o.schedule &&
+		p.tags.equal(o.tags) &&
+		p.timezone == o.timezone &&
+		p.volumeid == o.volumeid &&
+		p.zoneids.equal(o.zoneids)
+}
+
+// serializedCreateSnapshotPolicyParams is used to (un)marshal CreateSnapshotPolicyParams using the API param names
+type serializedCreateSnapshotPolicyParams struct {
+	Fordisplay   *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Intervaltype *string            `json:"intervaltype,omitempty" yaml:"intervaltype,omitempty"`
+	Maxsnaps     *int               `json:"maxsnaps,omitempty" yaml:"maxsnaps,omitempty"`
+	Schedule     *string            `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	Tags         *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Timezone     *string            `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Volumeid     *string            `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+	Zoneids      *[]string          `json:"zoneids,omitempty" yaml:"zoneids,omitempty"`
+}
+
+func (p *CreateSnapshotPolicyParams) toSerialized() *serializedCreateSnapshotPolicyParams {
+	s := &serializedCreateSnapshotPolicyParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.intervaltype.ok {
+		s.Intervaltype = &p.intervaltype.v
+	}
+	if p.maxsnaps.ok {
+		s.Maxsnaps = &p.maxsnaps.v
+	}
+	if p.schedule.ok {
+		s.Schedule = &p.schedule.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	if p.zoneids.ok {
+		s.Zoneids = &p.zoneids.v
+	}
+	return s
+}
+
+func (p *CreateSnapshotPolicyParams) fromSerialized(s *serializedCreateSnapshotPolicyParams) {
+	*p = CreateSnapshotPolicyParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Intervaltype != nil {
+		p.SetIntervaltype(*s.Intervaltype)
+	}
+	if s.Maxsnaps != nil {
+		p.SetMaxsnaps(*s.Maxsnaps)
 	}
-	value, ok := p.p["zoneids"].([]string)
-	return value, ok
+	if s.Schedule != nil {
+		p.SetSchedule(*s.Schedule)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+	if s.Zoneids != nil {
+		p.SetZoneids(*s.Zoneids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateSnapshotPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateSnapshotPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateSnapshotPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateSnapshotPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateSnapshotPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateSnapshotPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateSnapshotPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams {
 	p := &CreateSnapshotPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["intervaltype"] = intervaltype
-	p.p["maxsnaps"] = maxsnaps
-	p.p["schedule"] = schedule
-	p.p["timezone"] = timezone
-	p.p["volumeid"] = volumeid
+	p.SetIntervaltype(intervaltype)
+	p.SetMaxsnaps(maxsnaps)
+	p.SetSchedule(schedule)
+	p.SetTimezone(timezone)
+	p.SetVolumeid(volumeid)
 	return p
 }
 
 // Creates a snapshot policy for the account.
-func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshotPolicy", p.toURLValues())
+//
+// Required params: intervaltype, maxsnaps, schedule, timezone, volumeid.
+func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams, opts ...CallOption) (*CreateSnapshotPolicyResponse, error) {
+	resp, err := s.cs.newRequest("createSnapshotPolicy", s.cs.encodeParams("createSnapshotPolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -679,151 +952,261 @@ type CreateSnapshotPolicyResponse struct {
 }
 
 type CreateVMSnapshotParams struct {
-	p map[string]interface{}
+	description      optString
+	name             optString
+	quiescevm        optBool
+	snapshotmemory   optBool
+	virtualmachineid optString
 }
 
-func (p *CreateVMSnapshotParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVMSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if p.description.ok {
+		u.Set("description", p.description.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["quiescevm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("quiescevm", vv)
+	if p.quiescevm.ok {
+		u.Set("quiescevm", strconv.FormatBool(p.quiescevm.v))
 	}
-	if v, found := p.p["snapshotmemory"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("snapshotmemory", vv)
+	if p.snapshotmemory.ok {
+		u.Set("snapshotmemory", strconv.FormatBool(p.snapshotmemory.v))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *CreateVMSnapshotParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVMSnapshotParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVMSnapshotParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVMSnapshotParams(u url.Values) (*CreateVMSnapshotParams, error) {
+	p := &CreateVMSnapshotParams{}
+	if err := checkParamNames("createVMSnapshot", u, "description", "name", "quiescevm", "snapshotmemory", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["description"]; found {
+		p.SetDescription(u.Get("description"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["quiescevm"]; found {
+		v, err := strconv.ParseBool(u.Get("quiescevm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param quiescevm: %v", err)
+		}
+		p.SetQuiescevm(v)
+	}
+	if _, found := u["snapshotmemory"]; found {
+		v, err := strconv.ParseBool(u.Get("snapshotmemory"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param snapshotmemory: %v", err)
+		}
+		p.SetSnapshotmemory(v)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
 	}
-	p.p["description"] = v
+	return p, nil
+}
+
+// SetDescription sets the description param.
+func (p *CreateVMSnapshotParams) SetDescription(v string) {
+	p.description = optString{v: v, ok: true}
 }
 
+// ResetDescription unsets the description param
 func (p *CreateVMSnapshotParams) ResetDescription() {
-	if p.p != nil && p.p["description"] != nil {
-		delete(p.p, "description")
-	}
+	p.description = optString{}
 }
 
+// GetDescription returns the description param and if it is set
 func (p *CreateVMSnapshotParams) GetDescription() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["description"].(string)
-	return value, ok
+	return p.description.v, p.description.ok
 }
 
+// SetName sets the name param.
 func (p *CreateVMSnapshotParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateVMSnapshotParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateVMSnapshotParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetQuiescevm sets the quiescevm param.
 func (p *CreateVMSnapshotParams) SetQuiescevm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiescevm"] = v
+	p.quiescevm = optBool{v: v, ok: true}
 }
 
+// ResetQuiescevm unsets the quiescevm param
 func (p *CreateVMSnapshotParams) ResetQuiescevm() {
-	if p.p != nil && p.p["quiescevm"] != nil {
-		delete(p.p, "quiescevm")
-	}
+	p.quiescevm = optBool{}
 }
 
+// GetQuiescevm returns the quiescevm param and if it is set
 func (p *CreateVMSnapshotParams) GetQuiescevm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["quiescevm"].(bool)
-	return value, ok
+	return p.quiescevm.v, p.quiescevm.ok
 }
 
+// SetSnapshotmemory sets the snapshotmemory param.
 func (p *CreateVMSnapshotParams) SetSnapshotmemory(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["snapshotmemory"] = v
+	p.snapshotmemory = optBool{v: v, ok: true}
 }
 
+// ResetSnapshotmemory unsets the snapshotmemory param
 func (p *CreateVMSnapshotParams) ResetSnapshotmemory() {
-	if p.p != nil && p.p["snapshotmemory"] != nil {
-		delete(p.p, "snapshotmemory")
-	}
+	p.snapshotmemory = optBool{}
 }
 
+// GetSnapshotmemory returns the snapshotmemory param and if it is set
 func (p *CreateVMSnapshotParams) GetSnapshotmemory() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["snapshotmemory"].(bool)
-	return value, ok
+	return p.snapshotmemory.v, p.snapshotmemory.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *CreateVMSnapshotParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *CreateVMSnapshotParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *CreateVMSnapshotParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVMSnapshotParams) Clone() *CreateVMSnapshotParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVMSnapshotParams) Equal(o *CreateVMSnapshotParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.description == o.description &&
+		p.name == o.name &&
+		p.quiescevm == o.quiescevm &&
+		p.snapshotmemory == o.snapshotmemory &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedCreateVMSnapshotParams is used to (un)marshal CreateVMSnapshotParams using the API param names
+type serializedCreateVMSnapshotParams struct {
+	Description      *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Quiescevm        *bool   `json:"quiescevm,omitempty" yaml:"quiescevm,omitempty"`
+	Snapshotmemory   *bool   `json:"snapshotmemory,omitempty" yaml:"snapshotmemory,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *CreateVMSnapshotParams) toSerialized() *serializedCreateVMSnapshotParams {
+	s := &serializedCreateVMSnapshotParams{}
+	if p.description.ok {
+		s.Description = &p.description.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.quiescevm.ok {
+		s.Quiescevm = &p.quiescevm.v
+	}
+	if p.snapshotmemory.ok {
+		s.Snapshotmemory = &p.snapshotmemory.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *CreateVMSnapshotParams) fromSerialized(s *serializedCreateVMSnapshotParams) {
+	*p = CreateVMSnapshotParams{}
+	if s.Description != nil {
+		p.SetDescription(*s.Description)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	if s.Quiescevm != nil {
+		p.SetQuiescevm(*s.Quiescevm)
+	}
+	if s.Snapshotmemory != nil {
+		p.SetSnapshotmemory(*s.Snapshotmemory)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVMSnapshotParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVMSnapshotParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVMSnapshotParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVMSnapshotParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams {
 	p := &CreateVMSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Creates snapshot for a vm.
-func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createVMSnapshot", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams, opts ...CallOption) (*CreateVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest("createVMSnapshot", s.cs.encodeParams("createVMSnapshot", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -834,8 +1217,8 @@ func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVM
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -884,77 +1267,163 @@ type CreateVMSnapshotResponse struct {
 }
 
 type DeleteSnapshotParams struct {
-	p map[string]interface{}
+	id     optString
+	zoneid optString
 }
 
-func (p *DeleteSnapshotParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *DeleteSnapshotParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteSnapshotParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteSnapshotParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteSnapshotParams(u url.Values) (*DeleteSnapshotParams, error) {
+	p := &DeleteSnapshotParams{}
+	if err := checkParamNames("deleteSnapshot", u, "id", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteSnapshotParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteSnapshotParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteSnapshotParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *DeleteSnapshotParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *DeleteSnapshotParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *DeleteSnapshotParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteSnapshotParams) Clone() *DeleteSnapshotParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new DeleteSnapshotParams instance,
-// as then you are sure you have configured all required params
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteSnapshotParams) Equal(o *DeleteSnapshotParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.zoneid == o.zoneid
+}
+
+// serializedDeleteSnapshotParams is used to (un)marshal DeleteSnapshotParams using the API param names
+type serializedDeleteSnapshotParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *DeleteSnapshotParams) toSerialized() *serializedDeleteSnapshotParams {
+	s := &serializedDeleteSnapshotParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *DeleteSnapshotParams) fromSerialized(s *serializedDeleteSnapshotParams) {
+	*p = DeleteSnapshotParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteSnapshotParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteSnapshotParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteSnapshotParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteSnapshotParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteSnapshotParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteSnapshotParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteSnapshotParams instance,
+// as then you are sure you have configured all required params
 func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotParams {
 	p := &DeleteSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a snapshot of a disk volume.
-func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshot", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams, opts ...CallOption) (*DeleteSnapshotResponse, error) {
+	resp, err := s.cs.newRequest("deleteSnapshot", s.cs.encodeParams("deleteSnapshot", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -965,8 +1434,8 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -990,77 +1459,160 @@ type DeleteSnapshotResponse struct {
 }
 
 type DeleteSnapshotPoliciesParams struct {
-	p map[string]interface{}
+	id  optString
+	ids optStrings
 }
 
-func (p *DeleteSnapshotPoliciesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteSnapshotPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
 	return u
 }
 
-func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteSnapshotPoliciesParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteSnapshotPoliciesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteSnapshotPoliciesParams(u url.Values) (*DeleteSnapshotPoliciesParams, error) {
+	p := &DeleteSnapshotPoliciesParams{}
+	if err := checkParamNames("deleteSnapshotPolicies", u, "id", "ids"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteSnapshotPoliciesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteSnapshotPoliciesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *DeleteSnapshotPoliciesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *DeleteSnapshotPoliciesParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *DeleteSnapshotPoliciesParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ids.v, p.ids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteSnapshotPoliciesParams) Clone() *DeleteSnapshotPoliciesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteSnapshotPoliciesParams) Equal(o *DeleteSnapshotPoliciesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.ids.equal(o.ids)
+}
+
+// serializedDeleteSnapshotPoliciesParams is used to (un)marshal DeleteSnapshotPoliciesParams using the API param names
+type serializedDeleteSnapshotPoliciesParams struct {
+	Id  *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids *[]string `json:"ids,omitempty" yaml:"ids,omitempty"`
+}
+
+func (p *DeleteSnapshotPoliciesParams) toSerialized() *serializedDeleteSnapshotPoliciesParams {
+	s := &serializedDeleteSnapshotPoliciesParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	return s
+}
+
+func (p *DeleteSnapshotPoliciesParams) fromSerialized(s *serializedDeleteSnapshotPoliciesParams) {
+	*p = DeleteSnapshotPoliciesParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteSnapshotPoliciesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteSnapshotPoliciesParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteSnapshotPoliciesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteSnapshotPoliciesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteSnapshotPoliciesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteSnapshotPoliciesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteSnapshotPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams {
 	p := &DeleteSnapshotPoliciesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Deletes snapshot policies for the account.
-func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshotPolicies", p.toURLValues())
+func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams, opts ...CallOption) (*DeleteSnapshotPoliciesResponse, error) {
+	resp, err := s.cs.newRequest("deleteSnapshotPolicies", s.cs.encodeParams("deleteSnapshotPolicies", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1108,53 +1660,133 @@ func (r *DeleteSnapshotPoliciesResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteVMSnapshotParams struct {
-	p map[string]interface{}
+	vmsnapshotid optString
 }
 
-func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVMSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
+	if p.vmsnapshotid.ok {
+		u.Set("vmsnapshotid", p.vmsnapshotid.v)
 	}
 	return u
 }
 
-func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteVMSnapshotParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVMSnapshotParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVMSnapshotParams(u url.Values) (*DeleteVMSnapshotParams, error) {
+	p := &DeleteVMSnapshotParams{}
+	if err := checkParamNames("deleteVMSnapshot", u, "vmsnapshotid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["vmsnapshotid"]; found {
+		p.SetVmsnapshotid(u.Get("vmsnapshotid"))
 	}
-	p.p["vmsnapshotid"] = v
+	return p, nil
 }
 
+// SetVmsnapshotid sets the vmsnapshotid param. This param is required.
+func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
+	p.vmsnapshotid = optString{v: v, ok: true}
+}
+
+// ResetVmsnapshotid unsets the vmsnapshotid param
 func (p *DeleteVMSnapshotParams) ResetVmsnapshotid() {
-	if p.p != nil && p.p["vmsnapshotid"] != nil {
-		delete(p.p, "vmsnapshotid")
-	}
+	p.vmsnapshotid = optString{}
 }
 
+// GetVmsnapshotid returns the vmsnapshotid param and if it is set
 func (p *DeleteVMSnapshotParams) GetVmsnapshotid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmsnapshotid.v, p.vmsnapshotid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVMSnapshotParams) Clone() *DeleteVMSnapshotParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["vmsnapshotid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVMSnapshotParams) Equal(o *DeleteVMSnapshotParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.vmsnapshotid == o.vmsnapshotid
+}
+
+// serializedDeleteVMSnapshotParams is used to (un)marshal DeleteVMSnapshotParams using the API param names
+type serializedDeleteVMSnapshotParams struct {
+	Vmsnapshotid *string `json:"vmsnapshotid,omitempty" yaml:"vmsnapshotid,omitempty"`
+}
+
+func (p *DeleteVMSnapshotParams) toSerialized() *serializedDeleteVMSnapshotParams {
+	s := &serializedDeleteVMSnapshotParams{}
+	if p.vmsnapshotid.ok {
+		s.Vmsnapshotid = &p.vmsnapshotid.v
+	}
+	return s
+}
+
+func (p *DeleteVMSnapshotParams) fromSerialized(s *serializedDeleteVMSnapshotParams) {
+	*p = DeleteVMSnapshotParams{}
+	if s.Vmsnapshotid != nil {
+		p.SetVmsnapshotid(*s.Vmsnapshotid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVMSnapshotParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVMSnapshotParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVMSnapshotParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVMSnapshotParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams {
 	p := &DeleteVMSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["vmsnapshotid"] = vmsnapshotid
+	p.SetVmsnapshotid(vmsnapshotid)
 	return p
 }
 
 // Deletes a vmsnapshot.
-func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteVMSnapshot", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: vmsnapshotid.
+func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams, opts ...CallOption) (*DeleteVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest("deleteVMSnapshot", s.cs.encodeParams("deleteVMSnapshot", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1165,8 +1797,8 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1190,178 +1822,293 @@ type DeleteVMSnapshotResponse struct {
 }
 
 type ListSnapshotPoliciesParams struct {
-	p map[string]interface{}
+	fordisplay optBool
+	id         optString
+	keyword    optString
+	page       optInt
+	pagesize   optInt
+	volumeid   optString
 }
 
-func (p *ListSnapshotPoliciesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSnapshotPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
 	return u
 }
 
-func (p *ListSnapshotPoliciesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSnapshotPoliciesParams parses url.Values, for example taken from a raw API request,
+// into a new ListSnapshotPoliciesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSnapshotPoliciesParams(u url.Values) (*ListSnapshotPoliciesParams, error) {
+	p := &ListSnapshotPoliciesParams{}
+	if err := checkParamNames("listSnapshotPolicies", u, "fordisplay", "id", "keyword", "page", "pagesize", "volumeid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
 	}
-	p.p["fordisplay"] = v
+	return p, nil
 }
 
+// SetFordisplay sets the fordisplay param.
+func (p *ListSnapshotPoliciesParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
+}
+
+// ResetFordisplay unsets the fordisplay param
 func (p *ListSnapshotPoliciesParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListSnapshotPoliciesParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListSnapshotPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListSnapshotPoliciesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListSnapshotPoliciesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListSnapshotPoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListSnapshotPoliciesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSnapshotPoliciesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSnapshotPoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSnapshotPoliciesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSnapshotPoliciesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSnapshotPoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSnapshotPoliciesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSnapshotPoliciesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetVolumeid sets the volumeid param.
 func (p *ListSnapshotPoliciesParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
+	p.volumeid = optString{v: v, ok: true}
 }
 
+// ResetVolumeid unsets the volumeid param
 func (p *ListSnapshotPoliciesParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *ListSnapshotPoliciesParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.volumeid.v, p.volumeid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSnapshotPoliciesParams) Clone() *ListSnapshotPoliciesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSnapshotPoliciesParams) Equal(o *ListSnapshotPoliciesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.volumeid == o.volumeid
+}
+
+// serializedListSnapshotPoliciesParams is used to (un)marshal ListSnapshotPoliciesParams using the API param names
+type serializedListSnapshotPoliciesParams struct {
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Volumeid   *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+func (p *ListSnapshotPoliciesParams) toSerialized() *serializedListSnapshotPoliciesParams {
+	s := &serializedListSnapshotPoliciesParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	return s
+}
+
+func (p *ListSnapshotPoliciesParams) fromSerialized(s *serializedListSnapshotPoliciesParams) {
+	*p = ListSnapshotPoliciesParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
 	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSnapshotPoliciesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSnapshotPoliciesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSnapshotPoliciesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSnapshotPoliciesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSnapshotPoliciesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSnapshotPoliciesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSnapshotPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams {
 	p := &ListSnapshotPoliciesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
 	p := &ListSnapshotPoliciesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1390,8 +2137,8 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (
 }
 
 // Lists snapshot policies.
-func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshotPolicies", p.toURLValues())
+func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams, opts ...CallOption) (*ListSnapshotPoliciesResponse, error) {
+	resp, err := s.cs.newRequest("listSnapshotPolicies", s.cs.encodeParams("listSnapshotPolicies", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1425,521 +2172,729 @@ type SnapshotPolicy struct {
 }
 
 type ListSnapshotsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSnapshotsParams) toURLValues() url.Values {
+	account      optString
+	domainid     optString
+	id           optString
+	ids          optStrings
+	imagestoreid optString
+	intervaltype optString
+	isrecursive  optBool
+	keyword      optString
+	listall      optBool
+	locationtype optString
+	name         optString
+	page         optInt
+	pagesize     optInt
+	projectid    optString
+	showunique   optBool
+	snapshottype optString
+	storageid    optString
+	tags         optStringMap
+	volumeid     optString
+	zoneid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSnapshotsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["imagestoreid"]; found {
-		u.Set("imagestoreid", v.(string))
+	if p.imagestoreid.ok {
+		u.Set("imagestoreid", p.imagestoreid.v)
 	}
-	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
+	if p.intervaltype.ok {
+		u.Set("intervaltype", p.intervaltype.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["locationtype"]; found {
-		u.Set("locationtype", v.(string))
+	if p.locationtype.ok {
+		u.Set("locationtype", p.locationtype.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["showunique"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showunique", vv)
+	if p.showunique.ok {
+		u.Set("showunique", strconv.FormatBool(p.showunique.v))
 	}
-	if v, found := p.p["snapshottype"]; found {
-		u.Set("snapshottype", v.(string))
+	if p.snapshottype.ok {
+		u.Set("snapshottype", p.snapshottype.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListSnapshotsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSnapshotsParams parses url.Values, for example taken from a raw API request,
+// into a new ListSnapshotsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSnapshotsParams(u url.Values) (*ListSnapshotsParams, error) {
+	p := &ListSnapshotsParams{}
+	if err := checkParamNames("listSnapshots", u, "account", "domainid", "id", "ids", "imagestoreid", "intervaltype", "isrecursive", "keyword", "listall", "locationtype", "name", "page", "pagesize", "projectid", "showunique", "snapshottype", "storageid", "tags[]", "volumeid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["imagestoreid"]; found {
+		p.SetImagestoreid(u.Get("imagestoreid"))
+	}
+	if _, found := u["intervaltype"]; found {
+		p.SetIntervaltype(u.Get("intervaltype"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["locationtype"]; found {
+		p.SetLocationtype(u.Get("locationtype"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["showunique"]; found {
+		v, err := strconv.ParseBool(u.Get("showunique"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showunique: %v", err)
+		}
+		p.SetShowunique(v)
+	}
+	if _, found := u["snapshottype"]; found {
+		p.SetSnapshottype(u.Get("snapshottype"))
 	}
-	p.p["account"] = v
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListSnapshotsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListSnapshotsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListSnapshotsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListSnapshotsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListSnapshotsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListSnapshotsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListSnapshotsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListSnapshotsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListSnapshotsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListSnapshotsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListSnapshotsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListSnapshotsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetImagestoreid sets the imagestoreid param.
 func (p *ListSnapshotsParams) SetImagestoreid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreid"] = v
+	p.imagestoreid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreid unsets the imagestoreid param
 func (p *ListSnapshotsParams) ResetImagestoreid() {
-	if p.p != nil && p.p["imagestoreid"] != nil {
-		delete(p.p, "imagestoreid")
-	}
+	p.imagestoreid = optString{}
 }
 
+// GetImagestoreid returns the imagestoreid param and if it is set
 func (p *ListSnapshotsParams) GetImagestoreid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreid"].(string)
-	return value, ok
+	return p.imagestoreid.v, p.imagestoreid.ok
 }
 
+// SetIntervaltype sets the intervaltype param.
 func (p *ListSnapshotsParams) SetIntervaltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["intervaltype"] = v
+	p.intervaltype = optString{v: v, ok: true}
 }
 
+// ResetIntervaltype unsets the intervaltype param
 func (p *ListSnapshotsParams) ResetIntervaltype() {
-	if p.p != nil && p.p["intervaltype"] != nil {
-		delete(p.p, "intervaltype")
-	}
+	p.intervaltype = optString{}
 }
 
+// GetIntervaltype returns the intervaltype param and if it is set
 func (p *ListSnapshotsParams) GetIntervaltype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["intervaltype"].(string)
-	return value, ok
+	return p.intervaltype.v, p.intervaltype.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListSnapshotsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListSnapshotsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListSnapshotsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListSnapshotsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListSnapshotsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSnapshotsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListSnapshotsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListSnapshotsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListSnapshotsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetLocationtype sets the locationtype param.
 func (p *ListSnapshotsParams) SetLocationtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["locationtype"] = v
+	p.locationtype = optString{v: v, ok: true}
 }
 
+// ResetLocationtype unsets the locationtype param
 func (p *ListSnapshotsParams) ResetLocationtype() {
-	if p.p != nil && p.p["locationtype"] != nil {
-		delete(p.p, "locationtype")
-	}
+	p.locationtype = optString{}
 }
 
+// GetLocationtype returns the locationtype param and if it is set
 func (p *ListSnapshotsParams) GetLocationtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["locationtype"].(string)
-	return value, ok
+	return p.locationtype.v, p.locationtype.ok
 }
 
+// SetName sets the name param.
 func (p *ListSnapshotsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListSnapshotsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListSnapshotsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSnapshotsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSnapshotsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSnapshotsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSnapshotsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSnapshotsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSnapshotsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListSnapshotsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListSnapshotsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListSnapshotsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetShowunique sets the showunique param.
 func (p *ListSnapshotsParams) SetShowunique(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showunique"] = v
+	p.showunique = optBool{v: v, ok: true}
 }
 
+// ResetShowunique unsets the showunique param
 func (p *ListSnapshotsParams) ResetShowunique() {
-	if p.p != nil && p.p["showunique"] != nil {
-		delete(p.p, "showunique")
-	}
+	p.showunique = optBool{}
 }
 
+// GetShowunique returns the showunique param and if it is set
 func (p *ListSnapshotsParams) GetShowunique() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showunique"].(bool)
-	return value, ok
+	return p.showunique.v, p.showunique.ok
 }
 
+// SetSnapshottype sets the snapshottype param.
 func (p *ListSnapshotsParams) SetSnapshottype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["snapshottype"] = v
+	p.snapshottype = optString{v: v, ok: true}
 }
 
+// ResetSnapshottype unsets the snapshottype param
 func (p *ListSnapshotsParams) ResetSnapshottype() {
-	if p.p != nil && p.p["snapshottype"] != nil {
-		delete(p.p, "snapshottype")
-	}
+	p.snapshottype = optString{}
 }
 
+// GetSnapshottype returns the snapshottype param and if it is set
 func (p *ListSnapshotsParams) GetSnapshottype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["snapshottype"].(string)
-	return value, ok
+	return p.snapshottype.v, p.snapshottype.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListSnapshotsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListSnapshotsParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListSnapshotsParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListSnapshotsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListSnapshotsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
+	p.tags = optStringMap{}
+}
+
+// GetTags returns the tags param and if it is set
+func (p *ListSnapshotsParams) GetTags() (map[string]string, bool) {
+	return p.tags.v, p.tags.ok
+}
+
+// SetVolumeid sets the volumeid param.
+func (p *ListSnapshotsParams) SetVolumeid(v string) {
+	p.volumeid = optString{v: v, ok: true}
+}
+
+// ResetVolumeid unsets the volumeid param
+func (p *ListSnapshotsParams) ResetVolumeid() {
+	p.volumeid = optString{}
+}
+
+// GetVolumeid returns the volumeid param and if it is set
+func (p *ListSnapshotsParams) GetVolumeid() (string, bool) {
+	return p.volumeid.v, p.volumeid.ok
+}
+
+// SetZoneid sets the zoneid param.
+func (p *ListSnapshotsParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *ListSnapshotsParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *ListSnapshotsParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSnapshotsParams) Clone() *ListSnapshotsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSnapshotsParams) Equal(o *ListSnapshotsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.imagestoreid == o.imagestoreid &&
+		p.intervaltype == o.intervaltype &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.locationtype == o.locationtype &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.showunique == o.showunique &&
+		p.snapshottype == o.snapshottype &&
+		p.storageid == o.storageid &&
+		p.tags.equal(o.tags) &&
+		p.volumeid == o.volumeid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListSnapshotsParams is used to (un)marshal ListSnapshotsParams using the API param names
+type serializedListSnapshotsParams struct {
+	Account      *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id           *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids          *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Imagestoreid *string            `json:"imagestoreid,omitempty" yaml:"imagestoreid,omitempty"`
+	Intervaltype *string            `json:"intervaltype,omitempty" yaml:"intervaltype,omitempty"`
+	Isrecursive  *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword      *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Locationtype *string            `json:"locationtype,omitempty" yaml:"locationtype,omitempty"`
+	Name         *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page         *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Showunique   *bool              `json:"showunique,omitempty" yaml:"showunique,omitempty"`
+	Snapshottype *string            `json:"snapshottype,omitempty" yaml:"snapshottype,omitempty"`
+	Storageid    *string            `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags         *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Volumeid     *string            `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+	Zoneid       *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListSnapshotsParams) toSerialized() *serializedListSnapshotsParams {
+	s := &serializedListSnapshotsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.imagestoreid.ok {
+		s.Imagestoreid = &p.imagestoreid.v
+	}
+	if p.intervaltype.ok {
+		s.Intervaltype = &p.intervaltype.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.locationtype.ok {
+		s.Locationtype = &p.locationtype.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.showunique.ok {
+		s.Showunique = &p.showunique.v
+	}
+	if p.snapshottype.ok {
+		s.Snapshottype = &p.snapshottype.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListSnapshotsParams) fromSerialized(s *serializedListSnapshotsParams) {
+	*p = ListSnapshotsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Imagestoreid != nil {
+		p.SetImagestoreid(*s.Imagestoreid)
+	}
+	if s.Intervaltype != nil {
+		p.SetIntervaltype(*s.Intervaltype)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Locationtype != nil {
+		p.SetLocationtype(*s.Locationtype)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Showunique != nil {
+		p.SetShowunique(*s.Showunique)
+	}
+	if s.Snapshottype != nil {
+		p.SetSnapshottype(*s.Snapshottype)
 	}
-}
-
-func (p *ListSnapshotsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
-}
-
-func (p *ListSnapshotsParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
 	}
-	p.p["volumeid"] = v
-}
-
-func (p *ListSnapshotsParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
 }
 
-func (p *ListSnapshotsParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSnapshotsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListSnapshotsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSnapshotsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSnapshotsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["zoneid"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListSnapshotsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSnapshotsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListSnapshotsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSnapshotsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSnapshotsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSnapshotsParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListSnapshotsParams() *ListSnapshotsParams {
 	p := &ListSnapshotsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSnapshotsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1987,9 +2942,8 @@ func (s *SnapshotService) GetSnapshotByName(name string, opts ...OptionFunc) (*S
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error) {
 	p := &ListSnapshotsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2018,8 +2972,8 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 }
 
 // Lists all available snapshots for the account.
-func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshots", p.toURLValues())
+func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams, opts ...CallOption) (*ListSnapshotsResponse, error) {
+	resp, err := s.cs.newRequest("listSnapshots", s.cs.encodeParams("listSnapshots", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2100,376 +3054,545 @@ func (r *Snapshot) UnmarshalJSON(b []byte) error {
 }
 
 type ListVMSnapshotParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVMSnapshotParams) toURLValues() url.Values {
+	account          optString
+	domainid         optString
+	isrecursive      optBool
+	keyword          optString
+	listall          optBool
+	name             optString
+	page             optInt
+	pagesize         optInt
+	projectid        optString
+	state            optString
+	tags             optStringMap
+	virtualmachineid optString
+	vmsnapshotid     optString
+	vmsnapshotids    optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVMSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
+	if p.vmsnapshotid.ok {
+		u.Set("vmsnapshotid", p.vmsnapshotid.v)
 	}
-	if v, found := p.p["vmsnapshotids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("vmsnapshotids", vv)
+	if p.vmsnapshotids.ok {
+		u.Set("vmsnapshotids", strings.Join(p.vmsnapshotids.v, ","))
 	}
 	return u
 }
 
-func (p *ListVMSnapshotParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVMSnapshotParams parses url.Values, for example taken from a raw API request,
+// into a new ListVMSnapshotParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVMSnapshotParams(u url.Values) (*ListVMSnapshotParams, error) {
+	p := &ListVMSnapshotParams{}
+	if err := checkParamNames("listVMSnapshot", u, "account", "domainid", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid", "state", "tags[]", "virtualmachineid", "vmsnapshotid", "vmsnapshotids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
 	}
-	p.p["account"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["vmsnapshotid"]; found {
+		p.SetVmsnapshotid(u.Get("vmsnapshotid"))
+	}
+	if _, found := u["vmsnapshotids"]; found {
+		p.SetVmsnapshotids(strings.Split(u.Get("vmsnapshotids"), ","))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVMSnapshotParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListVMSnapshotParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVMSnapshotParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVMSnapshotParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVMSnapshotParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVMSnapshotParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVMSnapshotParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVMSnapshotParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVMSnapshotParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVMSnapshotParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVMSnapshotParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVMSnapshotParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVMSnapshotParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVMSnapshotParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVMSnapshotParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListVMSnapshotParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVMSnapshotParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVMSnapshotParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVMSnapshotParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVMSnapshotParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVMSnapshotParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVMSnapshotParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVMSnapshotParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVMSnapshotParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVMSnapshotParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVMSnapshotParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVMSnapshotParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetState sets the state param.
 func (p *ListVMSnapshotParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListVMSnapshotParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListVMSnapshotParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListVMSnapshotParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListVMSnapshotParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListVMSnapshotParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListVMSnapshotParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListVMSnapshotParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListVMSnapshotParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetVmsnapshotid sets the vmsnapshotid param.
 func (p *ListVMSnapshotParams) SetVmsnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmsnapshotid"] = v
+	p.vmsnapshotid = optString{v: v, ok: true}
 }
 
+// ResetVmsnapshotid unsets the vmsnapshotid param
 func (p *ListVMSnapshotParams) ResetVmsnapshotid() {
-	if p.p != nil && p.p["vmsnapshotid"] != nil {
-		delete(p.p, "vmsnapshotid")
-	}
+	p.vmsnapshotid = optString{}
 }
 
+// GetVmsnapshotid returns the vmsnapshotid param and if it is set
 func (p *ListVMSnapshotParams) GetVmsnapshotid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vmsnapshotid"].(string)
-	return value, ok
+	return p.vmsnapshotid.v, p.vmsnapshotid.ok
 }
 
+// SetVmsnapshotids sets the vmsnapshotids param.
 func (p *ListVMSnapshotParams) SetVmsnapshotids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmsnapshotids"] = v
+	p.vmsnapshotids = optStrings{v: v, ok: true}
 }
 
+// ResetVmsnapshotids unsets the vmsnapshotids param
 func (p *ListVMSnapshotParams) ResetVmsnapshotids() {
-	if p.p != nil && p.p["vmsnapshotids"] != nil {
-		delete(p.p, "vmsnapshotids")
-	}
+	p.vmsnapshotids = optStrings{}
 }
 
+// GetVmsnapshotids returns the vmsnapshotids param and if it is set
 func (p *ListVMSnapshotParams) GetVmsnapshotids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmsnapshotids.v, p.vmsnapshotids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVMSnapshotParams) Clone() *ListVMSnapshotParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	c.vmsnapshotids = p.vmsnapshotids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVMSnapshotParams) Equal(o *ListVMSnapshotParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.state == o.state &&
+		p.tags.equal(o.tags) &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.vmsnapshotid == o.vmsnapshotid &&
+		p.vmsnapshotids.equal(o.vmsnapshotids)
+}
+
+// serializedListVMSnapshotParams is used to (un)marshal ListVMSnapshotParams using the API param names
+type serializedListVMSnapshotParams struct {
+	Account          *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isrecursive      *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State            *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags             *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Virtualmachineid *string            `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Vmsnapshotid     *string            `json:"vmsnapshotid,omitempty" yaml:"vmsnapshotid,omitempty"`
+	Vmsnapshotids    *[]string          `json:"vmsnapshotids,omitempty" yaml:"vmsnapshotids,omitempty"`
+}
+
+func (p *ListVMSnapshotParams) toSerialized() *serializedListVMSnapshotParams {
+	s := &serializedListVMSnapshotParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.vmsnapshotid.ok {
+		s.Vmsnapshotid = &p.vmsnapshotid.v
+	}
+	if p.vmsnapshotids.ok {
+		s.Vmsnapshotids = &p.vmsnapshotids.v
+	}
+	return s
+}
+
+func (p *ListVMSnapshotParams) fromSerialized(s *serializedListVMSnapshotParams) {
+	*p = ListVMSnapshotParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Vmsnapshotid != nil {
+		p.SetVmsnapshotid(*s.Vmsnapshotid)
+	}
+	if s.Vmsnapshotids != nil {
+		p.SetVmsnapshotids(*s.Vmsnapshotids)
 	}
-	value, ok := p.p["vmsnapshotids"].([]string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVMSnapshotParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVMSnapshotParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVMSnapshotParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVMSnapshotParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
 	p := &ListVMSnapshotParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVMSnapshotParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2500,9 +3623,9 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-// List virtual machine snapshot by conditions
-func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("listVMSnapshot", p.toURLValues())
+// List virtual machine snapshot by conditions.
+func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams, opts ...CallOption) (*ListVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest("listVMSnapshot", s.cs.encodeParams("listVMSnapshot", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2548,53 +3671,133 @@ type VMSnapshot struct {
 }
 
 type RevertSnapshotParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RevertSnapshotParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RevertSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RevertSnapshotParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRevertSnapshotParams parses url.Values, for example taken from a raw API request,
+// into a new RevertSnapshotParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRevertSnapshotParams(u url.Values) (*RevertSnapshotParams, error) {
+	p := &RevertSnapshotParams{}
+	if err := checkParamNames("revertSnapshot", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RevertSnapshotParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RevertSnapshotParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RevertSnapshotParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RevertSnapshotParams) Clone() *RevertSnapshotParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RevertSnapshotParams) Equal(o *RevertSnapshotParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRevertSnapshotParams is used to (un)marshal RevertSnapshotParams using the API param names
+type serializedRevertSnapshotParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RevertSnapshotParams) toSerialized() *serializedRevertSnapshotParams {
+	s := &serializedRevertSnapshotParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RevertSnapshotParams) fromSerialized(s *serializedRevertSnapshotParams) {
+	*p = RevertSnapshotParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RevertSnapshotParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RevertSnapshotParams) UnmarshalJSON(b []byte) error {
+	var s serializedRevertSnapshotParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RevertSnapshotParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RevertSnapshotParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRevertSnapshotParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RevertSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotParams {
 	p := &RevertSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// This is supposed to revert a volume snapshot. This command is only supported with KVM so far
-func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertSnapshot", p.toURLValues())
+// This is supposed to revert a volume snapshot. This command is only supported with KVM so far.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams, opts ...CallOption) (*RevertSnapshotResponse, error) {
+	resp, err := s.cs.newRequest("revertSnapshot", s.cs.encodeParams("revertSnapshot", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2605,8 +3808,8 @@ func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapsh
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2690,53 +3893,133 @@ func (r *RevertSnapshotResponse) UnmarshalJSON(b []byte) error {
 }
 
 type RevertToVMSnapshotParams struct {
-	p map[string]interface{}
+	vmsnapshotid optString
 }
 
-func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RevertToVMSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
+	if p.vmsnapshotid.ok {
+		u.Set("vmsnapshotid", p.vmsnapshotid.v)
 	}
 	return u
 }
 
-func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRevertToVMSnapshotParams parses url.Values, for example taken from a raw API request,
+// into a new RevertToVMSnapshotParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRevertToVMSnapshotParams(u url.Values) (*RevertToVMSnapshotParams, error) {
+	p := &RevertToVMSnapshotParams{}
+	if err := checkParamNames("revertToVMSnapshot", u, "vmsnapshotid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["vmsnapshotid"]; found {
+		p.SetVmsnapshotid(u.Get("vmsnapshotid"))
 	}
-	p.p["vmsnapshotid"] = v
+	return p, nil
+}
+
+// SetVmsnapshotid sets the vmsnapshotid param. This param is required.
+func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
+	p.vmsnapshotid = optString{v: v, ok: true}
 }
 
+// ResetVmsnapshotid unsets the vmsnapshotid param
 func (p *RevertToVMSnapshotParams) ResetVmsnapshotid() {
-	if p.p != nil && p.p["vmsnapshotid"] != nil {
-		delete(p.p, "vmsnapshotid")
-	}
+	p.vmsnapshotid = optString{}
 }
 
+// GetVmsnapshotid returns the vmsnapshotid param and if it is set
 func (p *RevertToVMSnapshotParams) GetVmsnapshotid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vmsnapshotid.v, p.vmsnapshotid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RevertToVMSnapshotParams) Clone() *RevertToVMSnapshotParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RevertToVMSnapshotParams) Equal(o *RevertToVMSnapshotParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.vmsnapshotid == o.vmsnapshotid
+}
+
+// serializedRevertToVMSnapshotParams is used to (un)marshal RevertToVMSnapshotParams using the API param names
+type serializedRevertToVMSnapshotParams struct {
+	Vmsnapshotid *string `json:"vmsnapshotid,omitempty" yaml:"vmsnapshotid,omitempty"`
+}
+
+func (p *RevertToVMSnapshotParams) toSerialized() *serializedRevertToVMSnapshotParams {
+	s := &serializedRevertToVMSnapshotParams{}
+	if p.vmsnapshotid.ok {
+		s.Vmsnapshotid = &p.vmsnapshotid.v
 	}
-	value, ok := p.p["vmsnapshotid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *RevertToVMSnapshotParams) fromSerialized(s *serializedRevertToVMSnapshotParams) {
+	*p = RevertToVMSnapshotParams{}
+	if s.Vmsnapshotid != nil {
+		p.SetVmsnapshotid(*s.Vmsnapshotid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RevertToVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RevertToVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var s serializedRevertToVMSnapshotParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RevertToVMSnapshotParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RevertToVMSnapshotParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRevertToVMSnapshotParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RevertToVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams {
 	p := &RevertToVMSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["vmsnapshotid"] = vmsnapshotid
+	p.SetVmsnapshotid(vmsnapshotid)
 	return p
 }
 
 // Revert VM from a vmsnapshot.
-func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertToVMSnapshot", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: vmsnapshotid.
+func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams, opts ...CallOption) (*RevertToVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest("revertToVMSnapshot", s.cs.encodeParams("revertToVMSnapshot", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2747,8 +4030,8 @@ func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*Reve
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2930,101 +4213,196 @@ func (r *RevertToVMSnapshotResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateSnapshotPolicyParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateSnapshotPolicyParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateSnapshotPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateSnapshotPolicyParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateSnapshotPolicyParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateSnapshotPolicyParams(u url.Values) (*UpdateSnapshotPolicyParams, error) {
+	p := &UpdateSnapshotPolicyParams{}
+	if err := checkParamNames("updateSnapshotPolicy", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["customid"] = v
+	return p, nil
 }
 
+// SetCustomid sets the customid param.
+func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
+}
+
+// ResetCustomid unsets the customid param
 func (p *UpdateSnapshotPolicyParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateSnapshotPolicyParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateSnapshotPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateSnapshotPolicyParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateSnapshotPolicyParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *UpdateSnapshotPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateSnapshotPolicyParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateSnapshotPolicyParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateSnapshotPolicyParams) Clone() *UpdateSnapshotPolicyParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateSnapshotPolicyParams) Equal(o *UpdateSnapshotPolicyParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateSnapshotPolicyParams is used to (un)marshal UpdateSnapshotPolicyParams using the API param names
+type serializedUpdateSnapshotPolicyParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateSnapshotPolicyParams) toSerialized() *serializedUpdateSnapshotPolicyParams {
+	s := &serializedUpdateSnapshotPolicyParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateSnapshotPolicyParams) fromSerialized(s *serializedUpdateSnapshotPolicyParams) {
+	*p = UpdateSnapshotPolicyParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateSnapshotPolicyParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateSnapshotPolicyParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateSnapshotPolicyParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateSnapshotPolicyParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateSnapshotPolicyParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateSnapshotPolicyParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateSnapshotPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams {
 	p := &UpdateSnapshotPolicyParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Updates the snapshot policy.
-func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateSnapshotPolicy", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams, opts ...CallOption) (*UpdateSnapshotPolicyResponse, error) {
+	resp, err := s.cs.newRequest("updateSnapshotPolicy", s.cs.encodeParams("updateSnapshotPolicy", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3035,8 +4413,8 @@ func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,93 +53,123 @@ func (m *MockSnapshotServiceIface) EXPECT() *MockSnapshotServiceIfaceMockRecorde
 }
 
 // CreateSnapshot mocks base method.
-func (m *MockSnapshotServiceIface) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+func (m *MockSnapshotServiceIface) CreateSnapshot(p *CreateSnapshotParams, opts ...CallOption) (*CreateSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSnapshot", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSnapshot", varargs...)
 	ret0, _ := ret[0].(*CreateSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSnapshot indicates an expected call of CreateSnapshot.
-func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshot(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshot(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshot), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshot), varargs...)
 }
 
 // CreateSnapshotPolicy mocks base method.
-func (m *MockSnapshotServiceIface) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
+func (m *MockSnapshotServiceIface) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams, opts ...CallOption) (*CreateSnapshotPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSnapshotPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSnapshotPolicy", varargs...)
 	ret0, _ := ret[0].(*CreateSnapshotPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSnapshotPolicy indicates an expected call of CreateSnapshotPolicy.
-func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotPolicy(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotPolicy", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotPolicy", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotPolicy), varargs...)
 }
 
 // CreateVMSnapshot mocks base method.
-func (m *MockSnapshotServiceIface) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
+func (m *MockSnapshotServiceIface) CreateVMSnapshot(p *CreateVMSnapshotParams, opts ...CallOption) (*CreateVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVMSnapshot", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVMSnapshot", varargs...)
 	ret0, _ := ret[0].(*CreateVMSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVMSnapshot indicates an expected call of CreateVMSnapshot.
-func (mr *MockSnapshotServiceIfaceMockRecorder) CreateVMSnapshot(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateVMSnapshot(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateVMSnapshot), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateVMSnapshot), varargs...)
 }
 
 // DeleteSnapshot mocks base method.
-func (m *MockSnapshotServiceIface) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+func (m *MockSnapshotServiceIface) DeleteSnapshot(p *DeleteSnapshotParams, opts ...CallOption) (*DeleteSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSnapshot", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSnapshot", varargs...)
 	ret0, _ := ret[0].(*DeleteSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteSnapshot indicates an expected call of DeleteSnapshot.
-func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshot(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshot(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshot), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshot), varargs...)
 }
 
 // DeleteSnapshotPolicies mocks base method.
-func (m *MockSnapshotServiceIface) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
+func (m *MockSnapshotServiceIface) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams, opts ...CallOption) (*DeleteSnapshotPoliciesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSnapshotPolicies", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSnapshotPolicies", varargs...)
 	ret0, _ := ret[0].(*DeleteSnapshotPoliciesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteSnapshotPolicies indicates an expected call of DeleteSnapshotPolicies.
-func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshotPolicies(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshotPolicies(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotPolicies", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshotPolicies), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotPolicies", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshotPolicies), varargs...)
 }
 
 // DeleteVMSnapshot mocks base method.
-func (m *MockSnapshotServiceIface) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
+func (m *MockSnapshotServiceIface) DeleteVMSnapshot(p *DeleteVMSnapshotParams, opts ...CallOption) (*DeleteVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVMSnapshot", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVMSnapshot", varargs...)
 	ret0, _ := ret[0].(*DeleteVMSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVMSnapshot indicates an expected call of DeleteVMSnapshot.
-func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteVMSnapshot(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteVMSnapshot(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteVMSnapshot), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteVMSnapshot), varargs...)
 }
 
 // GetSnapshotByID mocks base method.
@@ -248,48 +278,63 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) GetVMSnapshotID(name interface{}
 }
 
 // ListSnapshotPolicies mocks base method.
-func (m *MockSnapshotServiceIface) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
+func (m *MockSnapshotServiceIface) ListSnapshotPolicies(p *ListSnapshotPoliciesParams, opts ...CallOption) (*ListSnapshotPoliciesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSnapshotPolicies", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotPolicies", varargs...)
 	ret0, _ := ret[0].(*ListSnapshotPoliciesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSnapshotPolicies indicates an expected call of ListSnapshotPolicies.
-func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPolicies(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPolicies(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPolicies", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPolicies), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPolicies", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPolicies), varargs...)
 }
 
 // ListSnapshots mocks base method.
-func (m *MockSnapshotServiceIface) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+func (m *MockSnapshotServiceIface) ListSnapshots(p *ListSnapshotsParams, opts ...CallOption) (*ListSnapshotsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSnapshots", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshots", varargs...)
 	ret0, _ := ret[0].(*ListSnapshotsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSnapshots indicates an expected call of ListSnapshots.
-func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshots(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshots(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshots), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshots), varargs...)
 }
 
 // ListVMSnapshot mocks base method.
-func (m *MockSnapshotServiceIface) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+func (m *MockSnapshotServiceIface) ListVMSnapshot(p *ListVMSnapshotParams, opts ...CallOption) (*ListVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVMSnapshot", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMSnapshot", varargs...)
 	ret0, _ := ret[0].(*ListVMSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVMSnapshot indicates an expected call of ListVMSnapshot.
-func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshot(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshot(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshot), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshot), varargs...)
 }
 
 // NewCreateSnapshotParams mocks base method.
@@ -461,46 +506,61 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) NewUpdateSnapshotPolicyParams()
 }
 
 // RevertSnapshot mocks base method.
-func (m *MockSnapshotServiceIface) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+func (m *MockSnapshotServiceIface) RevertSnapshot(p *RevertSnapshotParams, opts ...CallOption) (*RevertSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevertSnapshot", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevertSnapshot", varargs...)
 	ret0, _ := ret[0].(*RevertSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RevertSnapshot indicates an expected call of RevertSnapshot.
-func (mr *MockSnapshotServiceIfaceMockRecorder) RevertSnapshot(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) RevertSnapshot(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertSnapshot), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertSnapshot), varargs...)
 }
 
 // RevertToVMSnapshot mocks base method.
-func (m *MockSnapshotServiceIface) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+func (m *MockSnapshotServiceIface) RevertToVMSnapshot(p *RevertToVMSnapshotParams, opts ...CallOption) (*RevertToVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevertToVMSnapshot", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevertToVMSnapshot", varargs...)
 	ret0, _ := ret[0].(*RevertToVMSnapshotResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RevertToVMSnapshot indicates an expected call of RevertToVMSnapshot.
-func (mr *MockSnapshotServiceIfaceMockRecorder) RevertToVMSnapshot(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) RevertToVMSnapshot(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertToVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertToVMSnapshot), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertToVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertToVMSnapshot), varargs...)
 }
 
 // UpdateSnapshotPolicy mocks base method.
-func (m *MockSnapshotServiceIface) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
+func (m *MockSnapshotServiceIface) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams, opts ...CallOption) (*UpdateSnapshotPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSnapshotPolicy", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSnapshotPolicy", varargs...)
 	ret0, _ := ret[0].(*UpdateSnapshotPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateSnapshotPolicy indicates an expected call of UpdateSnapshotPolicy.
-func (mr *MockSnapshotServiceIfaceMockRecorder) UpdateSnapshotPolicy(p interface{}) *gomock.Call {
+func (mr *MockSnapshotServiceIfaceMockRecorder) UpdateSnapshotPolicy(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicy", reflect.TypeOf((*MockSnapshotServiceIface)(nil).UpdateSnapshotPolicy), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicy", reflect.TypeOf((*MockSnapshotServiceIface)(nil).UpdateSnapshotPolicy), varargs...)
 }
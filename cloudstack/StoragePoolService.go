@@ -21,67 +21,148 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type StoragePoolServiceIface interface {
-	CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenance(p *CancelStorageMaintenanceParams, opts ...CallOption) (*CancelStorageMaintenanceResponse, error)
 	NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams
-	EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenance(p *EnableStorageMaintenanceParams, opts ...CallOption) (*EnableStorageMaintenanceResponse, error)
 	NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams
-	ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	ListStorageProviders(p *ListStorageProvidersParams, opts ...CallOption) (*ListStorageProvidersResponse, error)
 	NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams
 }
 
 type CancelStorageMaintenanceParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CancelStorageMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *CancelStorageMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCancelStorageMaintenanceParams parses url.Values, for example taken from a raw API request,
+// into a new CancelStorageMaintenanceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCancelStorageMaintenanceParams(u url.Values) (*CancelStorageMaintenanceParams, error) {
+	p := &CancelStorageMaintenanceParams{}
+	if err := checkParamNames("cancelStorageMaintenance", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *CancelStorageMaintenanceParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *CancelStorageMaintenanceParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *CancelStorageMaintenanceParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CancelStorageMaintenanceParams) Clone() *CancelStorageMaintenanceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CancelStorageMaintenanceParams) Equal(o *CancelStorageMaintenanceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedCancelStorageMaintenanceParams is used to (un)marshal CancelStorageMaintenanceParams using the API param names
+type serializedCancelStorageMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *CancelStorageMaintenanceParams) toSerialized() *serializedCancelStorageMaintenanceParams {
+	s := &serializedCancelStorageMaintenanceParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *CancelStorageMaintenanceParams) fromSerialized(s *serializedCancelStorageMaintenanceParams) {
+	*p = CancelStorageMaintenanceParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CancelStorageMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CancelStorageMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var s serializedCancelStorageMaintenanceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CancelStorageMaintenanceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CancelStorageMaintenanceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCancelStorageMaintenanceParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CancelStorageMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams {
 	p := &CancelStorageMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Cancels maintenance for primary storage
-func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelStorageMaintenance", p.toURLValues())
+// Cancels maintenance for primary storage.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams, opts ...CallOption) (*CancelStorageMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest("cancelStorageMaintenance", s.cs.encodeParams("cancelStorageMaintenance", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +173,8 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -147,53 +228,133 @@ type CancelStorageMaintenanceResponse struct {
 }
 
 type EnableStorageMaintenanceParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableStorageMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *EnableStorageMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableStorageMaintenanceParams parses url.Values, for example taken from a raw API request,
+// into a new EnableStorageMaintenanceParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableStorageMaintenanceParams(u url.Values) (*EnableStorageMaintenanceParams, error) {
+	p := &EnableStorageMaintenanceParams{}
+	if err := checkParamNames("enableStorageMaintenance", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *EnableStorageMaintenanceParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *EnableStorageMaintenanceParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *EnableStorageMaintenanceParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableStorageMaintenanceParams) Clone() *EnableStorageMaintenanceParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableStorageMaintenanceParams) Equal(o *EnableStorageMaintenanceParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedEnableStorageMaintenanceParams is used to (un)marshal EnableStorageMaintenanceParams using the API param names
+type serializedEnableStorageMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *EnableStorageMaintenanceParams) toSerialized() *serializedEnableStorageMaintenanceParams {
+	s := &serializedEnableStorageMaintenanceParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *EnableStorageMaintenanceParams) fromSerialized(s *serializedEnableStorageMaintenanceParams) {
+	*p = EnableStorageMaintenanceParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableStorageMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableStorageMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableStorageMaintenanceParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableStorageMaintenanceParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableStorageMaintenanceParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableStorageMaintenanceParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableStorageMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams {
 	p := &EnableStorageMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Puts storage pool into maintenance state
-func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("enableStorageMaintenance", p.toURLValues())
+// Puts storage pool into maintenance state.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams, opts ...CallOption) (*EnableStorageMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest("enableStorageMaintenance", s.cs.encodeParams("enableStorageMaintenance", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -204,8 +365,8 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -259,127 +420,230 @@ type EnableStorageMaintenanceResponse struct {
 }
 
 type ListStorageProvidersParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	type_    optString
 }
 
-func (p *ListStorageProvidersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListStorageProvidersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
 	return u
 }
 
-func (p *ListStorageProvidersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListStorageProvidersParams parses url.Values, for example taken from a raw API request,
+// into a new ListStorageProvidersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListStorageProvidersParams(u url.Values) (*ListStorageProvidersParams, error) {
+	p := &ListStorageProvidersParams{}
+	if err := checkParamNames("listStorageProviders", u, "keyword", "page", "pagesize", "type"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["keyword"] = v
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListStorageProvidersParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListStorageProvidersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListStorageProvidersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListStorageProvidersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListStorageProvidersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListStorageProvidersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListStorageProvidersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListStorageProvidersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListStorageProvidersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetType sets the type param. This param is required.
 func (p *ListStorageProvidersParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListStorageProvidersParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListStorageProvidersParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.type_.v, p.type_.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListStorageProvidersParams) Clone() *ListStorageProvidersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListStorageProvidersParams) Equal(o *ListStorageProvidersParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.type_ == o.type_
+}
+
+// serializedListStorageProvidersParams is used to (un)marshal ListStorageProvidersParams using the API param names
+type serializedListStorageProvidersParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Type     *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+func (p *ListStorageProvidersParams) toSerialized() *serializedListStorageProvidersParams {
+	s := &serializedListStorageProvidersParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	return s
+}
+
+func (p *ListStorageProvidersParams) fromSerialized(s *serializedListStorageProvidersParams) {
+	*p = ListStorageProvidersParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListStorageProvidersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListStorageProvidersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListStorageProvidersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListStorageProvidersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListStorageProvidersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListStorageProvidersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListStorageProvidersParams instance,
 // as then you are sure you have configured all required params
 func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams {
 	p := &ListStorageProvidersParams{}
-	p.p = make(map[string]interface{})
-	p.p["type"] = storagePoolType
+	p.SetType(storagePoolType)
 	return p
 }
 
 // Lists storage providers.
-func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listStorageProviders", p.toURLValues())
+//
+// Required params: type.
+func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams, opts ...CallOption) (*ListStorageProvidersResponse, error) {
+	resp, err := s.cs.newRequest("listStorageProviders", s.cs.encodeParams("listStorageProviders", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,48 +53,63 @@ func (m *MockStoragePoolServiceIface) EXPECT() *MockStoragePoolServiceIfaceMockR
 }
 
 // CancelStorageMaintenance mocks base method.
-func (m *MockStoragePoolServiceIface) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+func (m *MockStoragePoolServiceIface) CancelStorageMaintenance(p *CancelStorageMaintenanceParams, opts ...CallOption) (*CancelStorageMaintenanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelStorageMaintenance", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelStorageMaintenance", varargs...)
 	ret0, _ := ret[0].(*CancelStorageMaintenanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CancelStorageMaintenance indicates an expected call of CancelStorageMaintenance.
-func (mr *MockStoragePoolServiceIfaceMockRecorder) CancelStorageMaintenance(p interface{}) *gomock.Call {
+func (mr *MockStoragePoolServiceIfaceMockRecorder) CancelStorageMaintenance(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStorageMaintenance", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).CancelStorageMaintenance), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStorageMaintenance", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).CancelStorageMaintenance), varargs...)
 }
 
 // EnableStorageMaintenance mocks base method.
-func (m *MockStoragePoolServiceIface) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+func (m *MockStoragePoolServiceIface) EnableStorageMaintenance(p *EnableStorageMaintenanceParams, opts ...CallOption) (*EnableStorageMaintenanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableStorageMaintenance", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableStorageMaintenance", varargs...)
 	ret0, _ := ret[0].(*EnableStorageMaintenanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableStorageMaintenance indicates an expected call of EnableStorageMaintenance.
-func (mr *MockStoragePoolServiceIfaceMockRecorder) EnableStorageMaintenance(p interface{}) *gomock.Call {
+func (mr *MockStoragePoolServiceIfaceMockRecorder) EnableStorageMaintenance(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStorageMaintenance", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).EnableStorageMaintenance), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStorageMaintenance", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).EnableStorageMaintenance), varargs...)
 }
 
 // ListStorageProviders mocks base method.
-func (m *MockStoragePoolServiceIface) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+func (m *MockStoragePoolServiceIface) ListStorageProviders(p *ListStorageProvidersParams, opts ...CallOption) (*ListStorageProvidersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListStorageProviders", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageProviders", varargs...)
 	ret0, _ := ret[0].(*ListStorageProvidersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListStorageProviders indicates an expected call of ListStorageProviders.
-func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProviders(p interface{}) *gomock.Call {
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProviders(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProviders", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProviders), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProviders", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProviders), varargs...)
 }
 
 // NewCancelStorageMaintenanceParams mocks base method.
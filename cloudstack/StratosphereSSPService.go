@@ -26,182 +26,291 @@ import (
 )
 
 type StratosphereSSPServiceIface interface {
-	AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error)
+	AddStratosphereSsp(p *AddStratosphereSspParams, opts ...CallOption) (*AddStratosphereSspResponse, error)
 	NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams
-	DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error)
+	DeleteStratosphereSsp(p *DeleteStratosphereSspParams, opts ...CallOption) (*DeleteStratosphereSspResponse, error)
 	NewDeleteStratosphereSspParams(hostid string) *DeleteStratosphereSspParams
 }
 
 type AddStratosphereSspParams struct {
-	p map[string]interface{}
+	name       optString
+	password   optString
+	tenantuuid optString
+	url        optString
+	username   optString
+	zoneid     optString
 }
 
-func (p *AddStratosphereSspParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddStratosphereSspParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["tenantuuid"]; found {
-		u.Set("tenantuuid", v.(string))
+	if p.tenantuuid.ok {
+		u.Set("tenantuuid", p.tenantuuid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddStratosphereSspParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddStratosphereSspParams parses url.Values, for example taken from a raw API request,
+// into a new AddStratosphereSspParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddStratosphereSspParams(u url.Values) (*AddStratosphereSspParams, error) {
+	p := &AddStratosphereSspParams{}
+	if err := checkParamNames("addStratosphereSsp", u, "name", "password", "tenantuuid", "url", "username", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["tenantuuid"]; found {
+		p.SetTenantuuid(u.Get("tenantuuid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
 	}
-	p.p["name"] = v
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetName sets the name param. This param is required.
+func (p *AddStratosphereSspParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *AddStratosphereSspParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *AddStratosphereSspParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPassword sets the password param.
 func (p *AddStratosphereSspParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddStratosphereSspParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddStratosphereSspParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetTenantuuid sets the tenantuuid param.
 func (p *AddStratosphereSspParams) SetTenantuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tenantuuid"] = v
+	p.tenantuuid = optString{v: v, ok: true}
 }
 
+// ResetTenantuuid unsets the tenantuuid param
 func (p *AddStratosphereSspParams) ResetTenantuuid() {
-	if p.p != nil && p.p["tenantuuid"] != nil {
-		delete(p.p, "tenantuuid")
-	}
+	p.tenantuuid = optString{}
 }
 
+// GetTenantuuid returns the tenantuuid param and if it is set
 func (p *AddStratosphereSspParams) GetTenantuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tenantuuid"].(string)
-	return value, ok
+	return p.tenantuuid.v, p.tenantuuid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddStratosphereSspParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddStratosphereSspParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddStratosphereSspParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param.
 func (p *AddStratosphereSspParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddStratosphereSspParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddStratosphereSspParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.username.v, p.username.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *AddStratosphereSspParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddStratosphereSspParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddStratosphereSspParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddStratosphereSspParams) Clone() *AddStratosphereSspParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddStratosphereSspParams) Equal(o *AddStratosphereSspParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.name == o.name &&
+		p.password == o.password &&
+		p.tenantuuid == o.tenantuuid &&
+		p.url == o.url &&
+		p.username == o.username &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddStratosphereSspParams is used to (un)marshal AddStratosphereSspParams using the API param names
+type serializedAddStratosphereSspParams struct {
+	Name       *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Password   *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Tenantuuid *string `json:"tenantuuid,omitempty" yaml:"tenantuuid,omitempty"`
+	Url        *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username   *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid     *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddStratosphereSspParams) toSerialized() *serializedAddStratosphereSspParams {
+	s := &serializedAddStratosphereSspParams{}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.tenantuuid.ok {
+		s.Tenantuuid = &p.tenantuuid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddStratosphereSspParams) fromSerialized(s *serializedAddStratosphereSspParams) {
+	*p = AddStratosphereSspParams{}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Tenantuuid != nil {
+		p.SetTenantuuid(*s.Tenantuuid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddStratosphereSspParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddStratosphereSspParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddStratosphereSspParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddStratosphereSspParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddStratosphereSspParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddStratosphereSspParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddStratosphereSspParams instance,
 // as then you are sure you have configured all required params
 func (s *StratosphereSSPService) NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams {
 	p := &AddStratosphereSspParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Adds stratosphere ssp server
-func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
-	resp, err := s.cs.newRequest("addStratosphereSsp", p.toURLValues())
+// Adds stratosphere ssp server.
+//
+// Required params: name, url, zoneid.
+func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams, opts ...CallOption) (*AddStratosphereSspResponse, error) {
+	resp, err := s.cs.newRequest("addStratosphereSsp", s.cs.encodeParams("addStratosphereSsp", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -224,53 +333,132 @@ type AddStratosphereSspResponse struct {
 }
 
 type DeleteStratosphereSspParams struct {
-	p map[string]interface{}
+	hostid optString
 }
 
-func (p *DeleteStratosphereSspParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteStratosphereSspParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
 	return u
 }
 
-func (p *DeleteStratosphereSspParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteStratosphereSspParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteStratosphereSspParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteStratosphereSspParams(u url.Values) (*DeleteStratosphereSspParams, error) {
+	p := &DeleteStratosphereSspParams{}
+	if err := checkParamNames("deleteStratosphereSsp", u, "hostid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["hostid"] = v
+	return p, nil
 }
 
+// SetHostid sets the hostid param. This param is required.
+func (p *DeleteStratosphereSspParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
+}
+
+// ResetHostid unsets the hostid param
 func (p *DeleteStratosphereSspParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *DeleteStratosphereSspParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.hostid.v, p.hostid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteStratosphereSspParams) Clone() *DeleteStratosphereSspParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteStratosphereSspParams) Equal(o *DeleteStratosphereSspParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid
+}
+
+// serializedDeleteStratosphereSspParams is used to (un)marshal DeleteStratosphereSspParams using the API param names
+type serializedDeleteStratosphereSspParams struct {
+	Hostid *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+func (p *DeleteStratosphereSspParams) toSerialized() *serializedDeleteStratosphereSspParams {
+	s := &serializedDeleteStratosphereSspParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	return s
+}
+
+func (p *DeleteStratosphereSspParams) fromSerialized(s *serializedDeleteStratosphereSspParams) {
+	*p = DeleteStratosphereSspParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteStratosphereSspParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteStratosphereSspParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteStratosphereSspParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteStratosphereSspParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteStratosphereSspParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteStratosphereSspParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteStratosphereSspParams instance,
 // as then you are sure you have configured all required params
 func (s *StratosphereSSPService) NewDeleteStratosphereSspParams(hostid string) *DeleteStratosphereSspParams {
 	p := &DeleteStratosphereSspParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+	p.SetHostid(hostid)
 	return p
 }
 
-// Removes stratosphere ssp server
-func (s *StratosphereSSPService) DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
-	resp, err := s.cs.newRequest("deleteStratosphereSsp", p.toURLValues())
+// Removes stratosphere ssp server.
+//
+// Required params: hostid.
+func (s *StratosphereSSPService) DeleteStratosphereSsp(p *DeleteStratosphereSspParams, opts ...CallOption) (*DeleteStratosphereSspResponse, error) {
+	resp, err := s.cs.newRequest("deleteStratosphereSsp", s.cs.encodeParams("deleteStratosphereSsp", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,33 +53,43 @@ func (m *MockStratosphereSSPServiceIface) EXPECT() *MockStratosphereSSPServiceIf
 }
 
 // AddStratosphereSsp mocks base method.
-func (m *MockStratosphereSSPServiceIface) AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
+func (m *MockStratosphereSSPServiceIface) AddStratosphereSsp(p *AddStratosphereSspParams, opts ...CallOption) (*AddStratosphereSspResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddStratosphereSsp", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddStratosphereSsp", varargs...)
 	ret0, _ := ret[0].(*AddStratosphereSspResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddStratosphereSsp indicates an expected call of AddStratosphereSsp.
-func (mr *MockStratosphereSSPServiceIfaceMockRecorder) AddStratosphereSsp(p interface{}) *gomock.Call {
+func (mr *MockStratosphereSSPServiceIfaceMockRecorder) AddStratosphereSsp(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStratosphereSsp", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).AddStratosphereSsp), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStratosphereSsp", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).AddStratosphereSsp), varargs...)
 }
 
 // DeleteStratosphereSsp mocks base method.
-func (m *MockStratosphereSSPServiceIface) DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
+func (m *MockStratosphereSSPServiceIface) DeleteStratosphereSsp(p *DeleteStratosphereSspParams, opts ...CallOption) (*DeleteStratosphereSspResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteStratosphereSsp", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStratosphereSsp", varargs...)
 	ret0, _ := ret[0].(*DeleteStratosphereSspResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteStratosphereSsp indicates an expected call of DeleteStratosphereSsp.
-func (mr *MockStratosphereSSPServiceIfaceMockRecorder) DeleteStratosphereSsp(p interface{}) *gomock.Call {
+func (mr *MockStratosphereSSPServiceIfaceMockRecorder) DeleteStratosphereSsp(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStratosphereSsp", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).DeleteStratosphereSsp), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStratosphereSsp", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).DeleteStratosphereSsp), varargs...)
 }
 
 // NewAddStratosphereSspParams mocks base method.
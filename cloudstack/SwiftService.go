@@ -27,133 +27,230 @@ import (
 )
 
 type SwiftServiceIface interface {
-	AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error)
+	AddSwift(p *AddSwiftParams, opts ...CallOption) (*AddSwiftResponse, error)
 	NewAddSwiftParams(url string) *AddSwiftParams
-	ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	ListSwifts(p *ListSwiftsParams, opts ...CallOption) (*ListSwiftsResponse, error)
 	NewListSwiftsParams() *ListSwiftsParams
 	GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error)
 }
 
 type AddSwiftParams struct {
-	p map[string]interface{}
+	account  optString
+	key      optString
+	url      optString
+	username optString
 }
 
-func (p *AddSwiftParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddSwiftParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
+	if p.key.ok {
+		u.Set("key", p.key.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddSwiftParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddSwiftParams parses url.Values, for example taken from a raw API request,
+// into a new AddSwiftParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddSwiftParams(u url.Values) (*AddSwiftParams, error) {
+	p := &AddSwiftParams{}
+	if err := checkParamNames("addSwift", u, "account", "key", "url", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["key"]; found {
+		p.SetKey(u.Get("key"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *AddSwiftParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *AddSwiftParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *AddSwiftParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetKey sets the key param.
 func (p *AddSwiftParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["key"] = v
+	p.key = optString{v: v, ok: true}
 }
 
+// ResetKey unsets the key param
 func (p *AddSwiftParams) ResetKey() {
-	if p.p != nil && p.p["key"] != nil {
-		delete(p.p, "key")
-	}
+	p.key = optString{}
 }
 
+// GetKey returns the key param and if it is set
 func (p *AddSwiftParams) GetKey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["key"].(string)
-	return value, ok
+	return p.key.v, p.key.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddSwiftParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddSwiftParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddSwiftParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param.
 func (p *AddSwiftParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddSwiftParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddSwiftParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddSwiftParams) Clone() *AddSwiftParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddSwiftParams) Equal(o *AddSwiftParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.key == o.key &&
+		p.url == o.url &&
+		p.username == o.username
+}
+
+// serializedAddSwiftParams is used to (un)marshal AddSwiftParams using the API param names
+type serializedAddSwiftParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Key      *string `json:"key,omitempty" yaml:"key,omitempty"`
+	Url      *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddSwiftParams) toSerialized() *serializedAddSwiftParams {
+	s := &serializedAddSwiftParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.key.ok {
+		s.Key = &p.key.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddSwiftParams) fromSerialized(s *serializedAddSwiftParams) {
+	*p = AddSwiftParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Key != nil {
+		p.SetKey(*s.Key)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddSwiftParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddSwiftParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddSwiftParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddSwiftParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddSwiftParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddSwiftParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddSwiftParams instance,
 // as then you are sure you have configured all required params
 func (s *SwiftService) NewAddSwiftParams(url string) *AddSwiftParams {
 	p := &AddSwiftParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
+	p.SetUrl(url)
 	return p
 }
 
 // Adds Swift.
-func (s *SwiftService) AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error) {
-	resp, err := s.cs.newRequest("addSwift", p.toURLValues())
+//
+// Required params: url.
+func (s *SwiftService) AddSwift(p *AddSwiftParams, opts ...CallOption) (*AddSwiftResponse, error) {
+	resp, err := s.cs.newRequest("addSwift", s.cs.encodeParams("addSwift", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -184,130 +281,233 @@ type AddSwiftResponse struct {
 }
 
 type ListSwiftsParams struct {
-	p map[string]interface{}
+	id       optInt64
+	keyword  optString
+	page     optInt
+	pagesize optInt
 }
 
-func (p *ListSwiftsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSwiftsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.FormatInt(p.id.v, 10))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
 	return u
 }
 
-func (p *ListSwiftsParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSwiftsParams parses url.Values, for example taken from a raw API request,
+// into a new ListSwiftsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSwiftsParams(u url.Values) (*ListSwiftsParams, error) {
+	p := &ListSwiftsParams{}
+	if err := checkParamNames("listSwifts", u, "id", "keyword", "page", "pagesize"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		v, err := strconv.ParseInt(u.Get("id"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	p.p["id"] = v
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListSwiftsParams) SetId(v int64) {
+	p.id = optInt64{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListSwiftsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt64{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListSwiftsParams) GetId() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int64)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListSwiftsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListSwiftsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSwiftsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSwiftsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSwiftsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSwiftsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSwiftsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSwiftsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSwiftsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSwiftsParams) Clone() *ListSwiftsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSwiftsParams) Equal(o *ListSwiftsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize
+}
+
+// serializedListSwiftsParams is used to (un)marshal ListSwiftsParams using the API param names
+type serializedListSwiftsParams struct {
+	Id       *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+func (p *ListSwiftsParams) toSerialized() *serializedListSwiftsParams {
+	s := &serializedListSwiftsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	return s
+}
+
+func (p *ListSwiftsParams) fromSerialized(s *serializedListSwiftsParams) {
+	*p = ListSwiftsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSwiftsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSwiftsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSwiftsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSwiftsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSwiftsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSwiftsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSwiftsParams instance,
 // as then you are sure you have configured all required params
 func (s *SwiftService) NewListSwiftsParams() *ListSwiftsParams {
 	p := &ListSwiftsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSwiftsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -339,8 +539,8 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 }
 
 // List Swift.
-func (s *SwiftService) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
-	resp, err := s.cs.newRequest("listSwifts", p.toURLValues())
+func (s *SwiftService) ListSwifts(p *ListSwiftsParams, opts ...CallOption) (*ListSwiftsResponse, error) {
+	resp, err := s.cs.newRequest("listSwifts", s.cs.encodeParams("listSwifts", p), opts...)
 	if err != nil {
 		return nil, err
 	}
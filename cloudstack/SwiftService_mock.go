@@ -53,18 +53,23 @@ func (m *MockSwiftServiceIface) EXPECT() *MockSwiftServiceIfaceMockRecorder {
 }
 
 // AddSwift mocks base method.
-func (m *MockSwiftServiceIface) AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error) {
+func (m *MockSwiftServiceIface) AddSwift(p *AddSwiftParams, opts ...CallOption) (*AddSwiftResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSwift", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddSwift", varargs...)
 	ret0, _ := ret[0].(*AddSwiftResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddSwift indicates an expected call of AddSwift.
-func (mr *MockSwiftServiceIfaceMockRecorder) AddSwift(p interface{}) *gomock.Call {
+func (mr *MockSwiftServiceIfaceMockRecorder) AddSwift(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSwift", reflect.TypeOf((*MockSwiftServiceIface)(nil).AddSwift), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSwift", reflect.TypeOf((*MockSwiftServiceIface)(nil).AddSwift), varargs...)
 }
 
 // GetSwiftID mocks base method.
@@ -89,18 +94,23 @@ func (mr *MockSwiftServiceIfaceMockRecorder) GetSwiftID(keyword interface{}, opt
 }
 
 // ListSwifts mocks base method.
-func (m *MockSwiftServiceIface) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
+func (m *MockSwiftServiceIface) ListSwifts(p *ListSwiftsParams, opts ...CallOption) (*ListSwiftsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSwifts", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSwifts", varargs...)
 	ret0, _ := ret[0].(*ListSwiftsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSwifts indicates an expected call of ListSwifts.
-func (mr *MockSwiftServiceIfaceMockRecorder) ListSwifts(p interface{}) *gomock.Call {
+func (mr *MockSwiftServiceIfaceMockRecorder) ListSwifts(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwifts", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwifts), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwifts", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwifts), varargs...)
 }
 
 // NewAddSwiftParams mocks base method.
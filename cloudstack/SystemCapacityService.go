@@ -21,258 +21,396 @@ package cloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
 type SystemCapacityServiceIface interface {
-	ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error)
+	ListCapacity(p *ListCapacityParams, opts ...CallOption) (*ListCapacityResponse, error)
 	NewListCapacityParams() *ListCapacityParams
 }
 
 type ListCapacityParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListCapacityParams) toURLValues() url.Values {
+	clusterid   optString
+	fetchlatest optBool
+	keyword     optString
+	page        optInt
+	pagesize    optInt
+	podid       optString
+	sortby      optString
+	type_       optInt
+	zoneid      optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListCapacityParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["fetchlatest"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fetchlatest", vv)
+	if p.fetchlatest.ok {
+		u.Set("fetchlatest", strconv.FormatBool(p.fetchlatest.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["sortby"]; found {
-		u.Set("sortby", v.(string))
+	if p.sortby.ok {
+		u.Set("sortby", p.sortby.v)
 	}
-	if v, found := p.p["type"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("type", vv)
+	if p.type_.ok {
+		u.Set("type", strconv.Itoa(p.type_.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListCapacityParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListCapacityParams parses url.Values, for example taken from a raw API request,
+// into a new ListCapacityParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListCapacityParams(u url.Values) (*ListCapacityParams, error) {
+	p := &ListCapacityParams{}
+	if err := checkParamNames("listCapacity", u, "clusterid", "fetchlatest", "keyword", "page", "pagesize", "podid", "sortby", "type", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["fetchlatest"]; found {
+		v, err := strconv.ParseBool(u.Get("fetchlatest"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fetchlatest: %v", err)
+		}
+		p.SetFetchlatest(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["clusterid"] = v
+	if _, found := u["sortby"]; found {
+		p.SetSortby(u.Get("sortby"))
+	}
+	if _, found := u["type"]; found {
+		v, err := strconv.Atoi(u.Get("type"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param type: %v", err)
+		}
+		p.SetType(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetClusterid sets the clusterid param.
+func (p *ListCapacityParams) SetClusterid(v string) {
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListCapacityParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListCapacityParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetFetchlatest sets the fetchlatest param.
 func (p *ListCapacityParams) SetFetchlatest(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fetchlatest"] = v
+	p.fetchlatest = optBool{v: v, ok: true}
 }
 
+// ResetFetchlatest unsets the fetchlatest param
 func (p *ListCapacityParams) ResetFetchlatest() {
-	if p.p != nil && p.p["fetchlatest"] != nil {
-		delete(p.p, "fetchlatest")
-	}
+	p.fetchlatest = optBool{}
 }
 
+// GetFetchlatest returns the fetchlatest param and if it is set
 func (p *ListCapacityParams) GetFetchlatest() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fetchlatest"].(bool)
-	return value, ok
+	return p.fetchlatest.v, p.fetchlatest.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListCapacityParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListCapacityParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListCapacityParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListCapacityParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListCapacityParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListCapacityParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListCapacityParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListCapacityParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListCapacityParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListCapacityParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListCapacityParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListCapacityParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetSortby sets the sortby param.
 func (p *ListCapacityParams) SetSortby(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortby"] = v
+	p.sortby = optString{v: v, ok: true}
 }
 
+// ResetSortby unsets the sortby param
 func (p *ListCapacityParams) ResetSortby() {
-	if p.p != nil && p.p["sortby"] != nil {
-		delete(p.p, "sortby")
-	}
+	p.sortby = optString{}
 }
 
+// GetSortby returns the sortby param and if it is set
 func (p *ListCapacityParams) GetSortby() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sortby"].(string)
-	return value, ok
+	return p.sortby.v, p.sortby.ok
 }
 
+// SetType sets the type param.
 func (p *ListCapacityParams) SetType(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optInt{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListCapacityParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optInt{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListCapacityParams) GetType() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(int)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListCapacityParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListCapacityParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListCapacityParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListCapacityParams) Clone() *ListCapacityParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListCapacityParams) Equal(o *ListCapacityParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.clusterid == o.clusterid &&
+		p.fetchlatest == o.fetchlatest &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.sortby == o.sortby &&
+		p.type_ == o.type_ &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListCapacityParams is used to (un)marshal ListCapacityParams using the API param names
+type serializedListCapacityParams struct {
+	Clusterid   *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Fetchlatest *bool   `json:"fetchlatest,omitempty" yaml:"fetchlatest,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid       *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Sortby      *string `json:"sortby,omitempty" yaml:"sortby,omitempty"`
+	Type        *int    `json:"type,omitempty" yaml:"type,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListCapacityParams) toSerialized() *serializedListCapacityParams {
+	s := &serializedListCapacityParams{}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.fetchlatest.ok {
+		s.Fetchlatest = &p.fetchlatest.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.sortby.ok {
+		s.Sortby = &p.sortby.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListCapacityParams) fromSerialized(s *serializedListCapacityParams) {
+	*p = ListCapacityParams{}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Fetchlatest != nil {
+		p.SetFetchlatest(*s.Fetchlatest)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Sortby != nil {
+		p.SetSortby(*s.Sortby)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListCapacityParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListCapacityParams) UnmarshalJSON(b []byte) error {
+	var s serializedListCapacityParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListCapacityParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListCapacityParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListCapacityParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListCapacityParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemCapacityService) NewListCapacityParams() *ListCapacityParams {
 	p := &ListCapacityParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists all the system wide capacities.
-func (s *SystemCapacityService) ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error) {
-	resp, err := s.cs.newRequest("listCapacity", p.toURLValues())
+func (s *SystemCapacityService) ListCapacity(p *ListCapacityParams, opts ...CallOption) (*ListCapacityResponse, error) {
+	resp, err := s.cs.newRequest("listCapacity", s.cs.encodeParams("listCapacity", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,18 +53,23 @@ func (m *MockSystemCapacityServiceIface) EXPECT() *MockSystemCapacityServiceIfac
 }
 
 // ListCapacity mocks base method.
-func (m *MockSystemCapacityServiceIface) ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error) {
+func (m *MockSystemCapacityServiceIface) ListCapacity(p *ListCapacityParams, opts ...CallOption) (*ListCapacityResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCapacity", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCapacity", varargs...)
 	ret0, _ := ret[0].(*ListCapacityResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListCapacity indicates an expected call of ListCapacity.
-func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacity(p interface{}) *gomock.Call {
+func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacity(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacity", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacity), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacity", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacity), varargs...)
 }
 
 // NewListCapacityParams mocks base method.
@@ -28,129 +28,224 @@ import (
 )
 
 type SystemVMServiceIface interface {
-	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams, opts ...CallOption) (*ChangeServiceForSystemVmResponse, error)
 	NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams
-	DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVm(p *DestroySystemVmParams, opts ...CallOption) (*DestroySystemVmResponse, error)
 	NewDestroySystemVmParams(id string) *DestroySystemVmParams
-	ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	ListSystemVms(p *ListSystemVmsParams, opts ...CallOption) (*ListSystemVmsResponse, error)
 	NewListSystemVmsParams() *ListSystemVmsParams
 	GetSystemVmID(name string, opts ...OptionFunc) (string, int, error)
 	GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error)
 	GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error)
-	MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVm(p *MigrateSystemVmParams, opts ...CallOption) (*MigrateSystemVmResponse, error)
 	NewMigrateSystemVmParams(virtualmachineid string) *MigrateSystemVmParams
-	RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVm(p *RebootSystemVmParams, opts ...CallOption) (*RebootSystemVmResponse, error)
 	NewRebootSystemVmParams(id string) *RebootSystemVmParams
-	ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVm(p *ScaleSystemVmParams, opts ...CallOption) (*ScaleSystemVmResponse, error)
 	NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams
-	StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVm(p *StartSystemVmParams, opts ...CallOption) (*StartSystemVmResponse, error)
 	NewStartSystemVmParams(id string) *StartSystemVmParams
-	StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVm(p *StopSystemVmParams, opts ...CallOption) (*StopSystemVmResponse, error)
 	NewStopSystemVmParams(id string) *StopSystemVmParams
-	PatchSystemVm(p *PatchSystemVmParams) (*PatchSystemVmResponse, error)
+	PatchSystemVm(p *PatchSystemVmParams, opts ...CallOption) (*PatchSystemVmResponse, error)
 	NewPatchSystemVmParams() *PatchSystemVmParams
 }
 
 type ChangeServiceForSystemVmParams struct {
-	p map[string]interface{}
+	details           optStringMap
+	id                optString
+	serviceofferingid optString
 }
 
-func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ChangeServiceForSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
 	return u
 }
 
-func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseChangeServiceForSystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new ChangeServiceForSystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseChangeServiceForSystemVmParams(u url.Values) (*ChangeServiceForSystemVmParams, error) {
+	p := &ChangeServiceForSystemVmParams{}
+	if err := checkParamNames("changeServiceForSystemVm", u, "details[]", "id", "serviceofferingid"); err != nil {
+		return nil, err
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
 	}
-	p.p["details"] = v
+	return p, nil
 }
 
+// SetDetails sets the details param.
+func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
+}
+
+// ResetDetails unsets the details param
 func (p *ChangeServiceForSystemVmParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ChangeServiceForSystemVmParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ChangeServiceForSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ChangeServiceForSystemVmParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ChangeServiceForSystemVmParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *ChangeServiceForSystemVmParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ChangeServiceForSystemVmParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ChangeServiceForSystemVmParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.serviceofferingid.v, p.serviceofferingid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ChangeServiceForSystemVmParams) Clone() *ChangeServiceForSystemVmParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ChangeServiceForSystemVmParams) Equal(o *ChangeServiceForSystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.id == o.id &&
+		p.serviceofferingid == o.serviceofferingid
+}
+
+// serializedChangeServiceForSystemVmParams is used to (un)marshal ChangeServiceForSystemVmParams using the API param names
+type serializedChangeServiceForSystemVmParams struct {
+	Details           *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+}
+
+func (p *ChangeServiceForSystemVmParams) toSerialized() *serializedChangeServiceForSystemVmParams {
+	s := &serializedChangeServiceForSystemVmParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	return s
+}
+
+func (p *ChangeServiceForSystemVmParams) fromSerialized(s *serializedChangeServiceForSystemVmParams) {
+	*p = ChangeServiceForSystemVmParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ChangeServiceForSystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ChangeServiceForSystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedChangeServiceForSystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ChangeServiceForSystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ChangeServiceForSystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedChangeServiceForSystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ChangeServiceForSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams {
 	p := &ChangeServiceForSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
-func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForSystemVm", p.toURLValues())
+// Changes the service offering for a system vm (console proxy or secondary storage). The system vm
+// must be in a "Stopped" state for this command to take effect.
+//
+// Required params: id, serviceofferingid.
+func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams, opts ...CallOption) (*ChangeServiceForSystemVmResponse, error) {
+	resp, err := s.cs.newRequest("changeServiceForSystemVm", s.cs.encodeParams("changeServiceForSystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -207,53 +302,133 @@ type ChangeServiceForSystemVmResponse struct {
 }
 
 type DestroySystemVmParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DestroySystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DestroySystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DestroySystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDestroySystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new DestroySystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDestroySystemVmParams(u url.Values) (*DestroySystemVmParams, error) {
+	p := &DestroySystemVmParams{}
+	if err := checkParamNames("destroySystemVm", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DestroySystemVmParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DestroySystemVmParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DestroySystemVmParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DestroySystemVmParams) Clone() *DestroySystemVmParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DestroySystemVmParams) Equal(o *DestroySystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDestroySystemVmParams is used to (un)marshal DestroySystemVmParams using the API param names
+type serializedDestroySystemVmParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DestroySystemVmParams) toSerialized() *serializedDestroySystemVmParams {
+	s := &serializedDestroySystemVmParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DestroySystemVmParams) fromSerialized(s *serializedDestroySystemVmParams) {
+	*p = DestroySystemVmParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DestroySystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DestroySystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedDestroySystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DestroySystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DestroySystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDestroySystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DestroySystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmParams {
 	p := &DestroySystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Destroys a system virtual machine.
-func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
-	resp, err := s.cs.newRequest("destroySystemVm", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams, opts ...CallOption) (*DestroySystemVmResponse, error) {
+	resp, err := s.cs.newRequest("destroySystemVm", s.cs.encodeParams("destroySystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -264,8 +439,8 @@ func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySys
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -330,297 +505,439 @@ type DestroySystemVmResponse struct {
 }
 
 type ListSystemVmsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSystemVmsParams) toURLValues() url.Values {
+	hostid       optString
+	id           optString
+	keyword      optString
+	name         optString
+	page         optInt
+	pagesize     optInt
+	podid        optString
+	state        optString
+	storageid    optString
+	systemvmtype optString
+	zoneid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListSystemVmsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["systemvmtype"]; found {
-		u.Set("systemvmtype", v.(string))
+	if p.systemvmtype.ok {
+		u.Set("systemvmtype", p.systemvmtype.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListSystemVmsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListSystemVmsParams parses url.Values, for example taken from a raw API request,
+// into a new ListSystemVmsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListSystemVmsParams(u url.Values) (*ListSystemVmsParams, error) {
+	p := &ListSystemVmsParams{}
+	if err := checkParamNames("listSystemVms", u, "hostid", "id", "keyword", "name", "page", "pagesize", "podid", "state", "storageid", "systemvmtype", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
 	}
-	p.p["hostid"] = v
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["systemvmtype"]; found {
+		p.SetSystemvmtype(u.Get("systemvmtype"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetHostid sets the hostid param.
+func (p *ListSystemVmsParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListSystemVmsParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListSystemVmsParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param.
 func (p *ListSystemVmsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListSystemVmsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListSystemVmsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListSystemVmsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListSystemVmsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListSystemVmsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListSystemVmsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListSystemVmsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListSystemVmsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListSystemVmsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListSystemVmsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListSystemVmsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListSystemVmsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListSystemVmsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListSystemVmsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListSystemVmsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListSystemVmsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListSystemVmsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetState sets the state param.
 func (p *ListSystemVmsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListSystemVmsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListSystemVmsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListSystemVmsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListSystemVmsParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListSystemVmsParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetSystemvmtype sets the systemvmtype param.
 func (p *ListSystemVmsParams) SetSystemvmtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["systemvmtype"] = v
+	p.systemvmtype = optString{v: v, ok: true}
 }
 
+// ResetSystemvmtype unsets the systemvmtype param
 func (p *ListSystemVmsParams) ResetSystemvmtype() {
-	if p.p != nil && p.p["systemvmtype"] != nil {
-		delete(p.p, "systemvmtype")
-	}
+	p.systemvmtype = optString{}
 }
 
+// GetSystemvmtype returns the systemvmtype param and if it is set
 func (p *ListSystemVmsParams) GetSystemvmtype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["systemvmtype"].(string)
-	return value, ok
+	return p.systemvmtype.v, p.systemvmtype.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListSystemVmsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListSystemVmsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListSystemVmsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListSystemVmsParams) Clone() *ListSystemVmsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListSystemVmsParams) Equal(o *ListSystemVmsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.state == o.state &&
+		p.storageid == o.storageid &&
+		p.systemvmtype == o.systemvmtype &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListSystemVmsParams is used to (un)marshal ListSystemVmsParams using the API param names
+type serializedListSystemVmsParams struct {
+	Hostid       *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name         *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid        *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	State        *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid    *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Systemvmtype *string `json:"systemvmtype,omitempty" yaml:"systemvmtype,omitempty"`
+	Zoneid       *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListSystemVmsParams) toSerialized() *serializedListSystemVmsParams {
+	s := &serializedListSystemVmsParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.systemvmtype.ok {
+		s.Systemvmtype = &p.systemvmtype.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListSystemVmsParams) fromSerialized(s *serializedListSystemVmsParams) {
+	*p = ListSystemVmsParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Systemvmtype != nil {
+		p.SetSystemvmtype(*s.Systemvmtype)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListSystemVmsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListSystemVmsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListSystemVmsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListSystemVmsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListSystemVmsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListSystemVmsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListSystemVmsParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewListSystemVmsParams() *ListSystemVmsParams {
 	p := &ListSystemVmsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSystemVmsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -668,9 +985,8 @@ func (s *SystemVMService) GetSystemVmByName(name string, opts ...OptionFunc) (*S
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error) {
 	p := &ListSystemVmsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -699,8 +1015,8 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 }
 
 // List system virtual machines.
-func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
-	resp, err := s.cs.newRequest("listSystemVms", p.toURLValues())
+func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams, opts ...CallOption) (*ListSystemVmsResponse, error) {
+	resp, err := s.cs.newRequest("listSystemVms", s.cs.encodeParams("listSystemVms", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -762,126 +1078,227 @@ type SystemVm struct {
 }
 
 type MigrateSystemVmParams struct {
-	p map[string]interface{}
+	autoselect       optBool
+	hostid           optString
+	storageid        optString
+	virtualmachineid optString
 }
 
-func (p *MigrateSystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *MigrateSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["autoselect"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("autoselect", vv)
+	if p.autoselect.ok {
+		u.Set("autoselect", strconv.FormatBool(p.autoselect.v))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *MigrateSystemVmParams) SetAutoselect(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseMigrateSystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new MigrateSystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseMigrateSystemVmParams(u url.Values) (*MigrateSystemVmParams, error) {
+	p := &MigrateSystemVmParams{}
+	if err := checkParamNames("migrateSystemVm", u, "autoselect", "hostid", "storageid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["autoselect"]; found {
+		v, err := strconv.ParseBool(u.Get("autoselect"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param autoselect: %v", err)
+		}
+		p.SetAutoselect(v)
 	}
-	p.p["autoselect"] = v
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetAutoselect sets the autoselect param.
+func (p *MigrateSystemVmParams) SetAutoselect(v bool) {
+	p.autoselect = optBool{v: v, ok: true}
 }
 
+// ResetAutoselect unsets the autoselect param
 func (p *MigrateSystemVmParams) ResetAutoselect() {
-	if p.p != nil && p.p["autoselect"] != nil {
-		delete(p.p, "autoselect")
-	}
+	p.autoselect = optBool{}
 }
 
+// GetAutoselect returns the autoselect param and if it is set
 func (p *MigrateSystemVmParams) GetAutoselect() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoselect"].(bool)
-	return value, ok
+	return p.autoselect.v, p.autoselect.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *MigrateSystemVmParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *MigrateSystemVmParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *MigrateSystemVmParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *MigrateSystemVmParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *MigrateSystemVmParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *MigrateSystemVmParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *MigrateSystemVmParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *MigrateSystemVmParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *MigrateSystemVmParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *MigrateSystemVmParams) Clone() *MigrateSystemVmParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *MigrateSystemVmParams) Equal(o *MigrateSystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.autoselect == o.autoselect &&
+		p.hostid == o.hostid &&
+		p.storageid == o.storageid &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedMigrateSystemVmParams is used to (un)marshal MigrateSystemVmParams using the API param names
+type serializedMigrateSystemVmParams struct {
+	Autoselect       *bool   `json:"autoselect,omitempty" yaml:"autoselect,omitempty"`
+	Hostid           *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Storageid        *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *MigrateSystemVmParams) toSerialized() *serializedMigrateSystemVmParams {
+	s := &serializedMigrateSystemVmParams{}
+	if p.autoselect.ok {
+		s.Autoselect = &p.autoselect.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *MigrateSystemVmParams) fromSerialized(s *serializedMigrateSystemVmParams) {
+	*p = MigrateSystemVmParams{}
+	if s.Autoselect != nil {
+		p.SetAutoselect(*s.Autoselect)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p MigrateSystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *MigrateSystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedMigrateSystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p MigrateSystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *MigrateSystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedMigrateSystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new MigrateSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewMigrateSystemVmParams(virtualmachineid string) *MigrateSystemVmParams {
 	p := &MigrateSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Attempts Migration of a system virtual machine to the host specified.
-func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("migrateSystemVm", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams, opts ...CallOption) (*MigrateSystemVmResponse, error) {
+	resp, err := s.cs.newRequest("migrateSystemVm", s.cs.encodeParams("migrateSystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -892,8 +1309,8 @@ func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSys
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -958,78 +1375,167 @@ type MigrateSystemVmResponse struct {
 }
 
 type RebootSystemVmParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *RebootSystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RebootSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RebootSystemVmParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRebootSystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new RebootSystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRebootSystemVmParams(u url.Values) (*RebootSystemVmParams, error) {
+	p := &RebootSystemVmParams{}
+	if err := checkParamNames("rebootSystemVm", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["forced"] = v
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *RebootSystemVmParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *RebootSystemVmParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *RebootSystemVmParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *RebootSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RebootSystemVmParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RebootSystemVmParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RebootSystemVmParams) Clone() *RebootSystemVmParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RebootSystemVmParams) Equal(o *RebootSystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedRebootSystemVmParams is used to (un)marshal RebootSystemVmParams using the API param names
+type serializedRebootSystemVmParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RebootSystemVmParams) toSerialized() *serializedRebootSystemVmParams {
+	s := &serializedRebootSystemVmParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RebootSystemVmParams) fromSerialized(s *serializedRebootSystemVmParams) {
+	*p = RebootSystemVmParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RebootSystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RebootSystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedRebootSystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RebootSystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RebootSystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRebootSystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RebootSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmParams {
 	p := &RebootSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Reboots a system VM.
-func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("rebootSystemVm", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams, opts ...CallOption) (*RebootSystemVmResponse, error) {
+	resp, err := s.cs.newRequest("rebootSystemVm", s.cs.encodeParams("rebootSystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1040,8 +1546,8 @@ func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystem
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1106,105 +1612,201 @@ type RebootSystemVmResponse struct {
 }
 
 type ScaleSystemVmParams struct {
-	p map[string]interface{}
+	details           optStringMap
+	id                optString
+	serviceofferingid optString
 }
 
-func (p *ScaleSystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ScaleSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
 	return u
 }
 
-func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseScaleSystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new ScaleSystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseScaleSystemVmParams(u url.Values) (*ScaleSystemVmParams, error) {
+	p := &ScaleSystemVmParams{}
+	if err := checkParamNames("scaleSystemVm", u, "details[]", "id", "serviceofferingid"); err != nil {
+		return nil, err
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["details"] = v
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	return p, nil
+}
+
+// SetDetails sets the details param.
+func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ScaleSystemVmParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ScaleSystemVmParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ScaleSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ScaleSystemVmParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ScaleSystemVmParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *ScaleSystemVmParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ScaleSystemVmParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ScaleSystemVmParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.serviceofferingid.v, p.serviceofferingid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ScaleSystemVmParams) Clone() *ScaleSystemVmParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ScaleSystemVmParams) Equal(o *ScaleSystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.details.equal(o.details) &&
+		p.id == o.id &&
+		p.serviceofferingid == o.serviceofferingid
+}
+
+// serializedScaleSystemVmParams is used to (un)marshal ScaleSystemVmParams using the API param names
+type serializedScaleSystemVmParams struct {
+	Details           *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+}
+
+func (p *ScaleSystemVmParams) toSerialized() *serializedScaleSystemVmParams {
+	s := &serializedScaleSystemVmParams{}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	return s
+}
+
+func (p *ScaleSystemVmParams) fromSerialized(s *serializedScaleSystemVmParams) {
+	*p = ScaleSystemVmParams{}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ScaleSystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ScaleSystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedScaleSystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ScaleSystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ScaleSystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedScaleSystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ScaleSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams {
 	p := &ScaleSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
-func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("scaleSystemVm", p.toURLValues())
+// Scale the service offering for a system vm (console proxy or secondary storage). The system vm
+// must be in a "Stopped" state for this command to take effect.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, serviceofferingid.
+func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams, opts ...CallOption) (*ScaleSystemVmResponse, error) {
+	resp, err := s.cs.newRequest("scaleSystemVm", s.cs.encodeParams("scaleSystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1215,8 +1817,8 @@ func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmR
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1281,53 +1883,133 @@ type ScaleSystemVmResponse struct {
 }
 
 type StartSystemVmParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *StartSystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StartSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StartSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStartSystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new StartSystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStartSystemVmParams(u url.Values) (*StartSystemVmParams, error) {
+	p := &StartSystemVmParams{}
+	if err := checkParamNames("startSystemVm", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *StartSystemVmParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *StartSystemVmParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StartSystemVmParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StartSystemVmParams) Clone() *StartSystemVmParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StartSystemVmParams) Equal(o *StartSystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedStartSystemVmParams is used to (un)marshal StartSystemVmParams using the API param names
+type serializedStartSystemVmParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StartSystemVmParams) toSerialized() *serializedStartSystemVmParams {
+	s := &serializedStartSystemVmParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *StartSystemVmParams) fromSerialized(s *serializedStartSystemVmParams) {
+	*p = StartSystemVmParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StartSystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StartSystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedStartSystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StartSystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StartSystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStartSystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StartSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams {
 	p := &StartSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Starts a system virtual machine.
-func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("startSystemVm", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams, opts ...CallOption) (*StartSystemVmResponse, error) {
+	resp, err := s.cs.newRequest("startSystemVm", s.cs.encodeParams("startSystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1338,8 +2020,8 @@ func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmR
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1404,78 +2086,167 @@ type StartSystemVmResponse struct {
 }
 
 type StopSystemVmParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *StopSystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StopSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StopSystemVmParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStopSystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new StopSystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStopSystemVmParams(u url.Values) (*StopSystemVmParams, error) {
+	p := &StopSystemVmParams{}
+	if err := checkParamNames("stopSystemVm", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
 	}
-	p.p["forced"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *StopSystemVmParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *StopSystemVmParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *StopSystemVmParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *StopSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *StopSystemVmParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StopSystemVmParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StopSystemVmParams) Clone() *StopSystemVmParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StopSystemVmParams) Equal(o *StopSystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedStopSystemVmParams is used to (un)marshal StopSystemVmParams using the API param names
+type serializedStopSystemVmParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StopSystemVmParams) toSerialized() *serializedStopSystemVmParams {
+	s := &serializedStopSystemVmParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *StopSystemVmParams) fromSerialized(s *serializedStopSystemVmParams) {
+	*p = StopSystemVmParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StopSystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StopSystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedStopSystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StopSystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StopSystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStopSystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StopSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
 	p := &StopSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Stops a system VM.
-func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("stopSystemVm", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams, opts ...CallOption) (*StopSystemVmResponse, error) {
+	resp, err := s.cs.newRequest("stopSystemVm", s.cs.encodeParams("stopSystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1486,8 +2257,8 @@ func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResp
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1552,77 +2323,166 @@ type StopSystemVmResponse struct {
 }
 
 type PatchSystemVmParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *PatchSystemVmParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *PatchSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *PatchSystemVmParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParsePatchSystemVmParams parses url.Values, for example taken from a raw API request,
+// into a new PatchSystemVmParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParsePatchSystemVmParams(u url.Values) (*PatchSystemVmParams, error) {
+	p := &PatchSystemVmParams{}
+	if err := checkParamNames("patchSystemVm", u, "forced", "id"); err != nil {
+		return nil, err
 	}
-	p.p["forced"] = v
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetForced sets the forced param.
+func (p *PatchSystemVmParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
+}
+
+// ResetForced unsets the forced param
 func (p *PatchSystemVmParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *PatchSystemVmParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param.
 func (p *PatchSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *PatchSystemVmParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *PatchSystemVmParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *PatchSystemVmParams) Clone() *PatchSystemVmParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *PatchSystemVmParams) Equal(o *PatchSystemVmParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedPatchSystemVmParams is used to (un)marshal PatchSystemVmParams using the API param names
+type serializedPatchSystemVmParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *PatchSystemVmParams) toSerialized() *serializedPatchSystemVmParams {
+	s := &serializedPatchSystemVmParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *PatchSystemVmParams) fromSerialized(s *serializedPatchSystemVmParams) {
+	*p = PatchSystemVmParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p PatchSystemVmParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *PatchSystemVmParams) UnmarshalJSON(b []byte) error {
+	var s serializedPatchSystemVmParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p PatchSystemVmParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *PatchSystemVmParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedPatchSystemVmParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new PatchSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewPatchSystemVmParams() *PatchSystemVmParams {
 	p := &PatchSystemVmParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Attempts to live patch systemVMs - CPVM, SSVM
-func (s *SystemVMService) PatchSystemVm(p *PatchSystemVmParams) (*PatchSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("patchSystemVm", p.toURLValues())
+// Attempts to live patch systemVMs - CPVM, SSVM.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *SystemVMService) PatchSystemVm(p *PatchSystemVmParams, opts ...CallOption) (*PatchSystemVmResponse, error) {
+	resp, err := s.cs.newRequest("patchSystemVm", s.cs.encodeParams("patchSystemVm", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1633,8 +2493,8 @@ func (s *SystemVMService) PatchSystemVm(p *PatchSystemVmParams) (*PatchSystemVmR
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,33 +53,43 @@ func (m *MockSystemVMServiceIface) EXPECT() *MockSystemVMServiceIfaceMockRecorde
 }
 
 // ChangeServiceForSystemVm mocks base method.
-func (m *MockSystemVMServiceIface) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams, opts ...CallOption) (*ChangeServiceForSystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeServiceForSystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeServiceForSystemVm", varargs...)
 	ret0, _ := ret[0].(*ChangeServiceForSystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeServiceForSystemVm indicates an expected call of ChangeServiceForSystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) ChangeServiceForSystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) ChangeServiceForSystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ChangeServiceForSystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ChangeServiceForSystemVm), varargs...)
 }
 
 // DestroySystemVm mocks base method.
-func (m *MockSystemVMServiceIface) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) DestroySystemVm(p *DestroySystemVmParams, opts ...CallOption) (*DestroySystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroySystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DestroySystemVm", varargs...)
 	ret0, _ := ret[0].(*DestroySystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DestroySystemVm indicates an expected call of DestroySystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) DestroySystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) DestroySystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).DestroySystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).DestroySystemVm), varargs...)
 }
 
 // GetSystemVmByID mocks base method.
@@ -146,33 +156,43 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmID(name interface{},
 }
 
 // ListSystemVms mocks base method.
-func (m *MockSystemVMServiceIface) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+func (m *MockSystemVMServiceIface) ListSystemVms(p *ListSystemVmsParams, opts ...CallOption) (*ListSystemVmsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSystemVms", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVms", varargs...)
 	ret0, _ := ret[0].(*ListSystemVmsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListSystemVms indicates an expected call of ListSystemVms.
-func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVms(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVms(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVms", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVms), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVms", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVms), varargs...)
 }
 
 // MigrateSystemVm mocks base method.
-func (m *MockSystemVMServiceIface) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) MigrateSystemVm(p *MigrateSystemVmParams, opts ...CallOption) (*MigrateSystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateSystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateSystemVm", varargs...)
 	ret0, _ := ret[0].(*MigrateSystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MigrateSystemVm indicates an expected call of MigrateSystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) MigrateSystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) MigrateSystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).MigrateSystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).MigrateSystemVm), varargs...)
 }
 
 // NewChangeServiceForSystemVmParams mocks base method.
@@ -302,76 +322,101 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) NewStopSystemVmParams(id interfa
 }
 
 // PatchSystemVm mocks base method.
-func (m *MockSystemVMServiceIface) PatchSystemVm(p *PatchSystemVmParams) (*PatchSystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) PatchSystemVm(p *PatchSystemVmParams, opts ...CallOption) (*PatchSystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchSystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchSystemVm", varargs...)
 	ret0, _ := ret[0].(*PatchSystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PatchSystemVm indicates an expected call of PatchSystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) PatchSystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) PatchSystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).PatchSystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).PatchSystemVm), varargs...)
 }
 
 // RebootSystemVm mocks base method.
-func (m *MockSystemVMServiceIface) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) RebootSystemVm(p *RebootSystemVmParams, opts ...CallOption) (*RebootSystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RebootSystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebootSystemVm", varargs...)
 	ret0, _ := ret[0].(*RebootSystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RebootSystemVm indicates an expected call of RebootSystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) RebootSystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) RebootSystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).RebootSystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).RebootSystemVm), varargs...)
 }
 
 // ScaleSystemVm mocks base method.
-func (m *MockSystemVMServiceIface) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) ScaleSystemVm(p *ScaleSystemVmParams, opts ...CallOption) (*ScaleSystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ScaleSystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScaleSystemVm", varargs...)
 	ret0, _ := ret[0].(*ScaleSystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ScaleSystemVm indicates an expected call of ScaleSystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) ScaleSystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) ScaleSystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ScaleSystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ScaleSystemVm), varargs...)
 }
 
 // StartSystemVm mocks base method.
-func (m *MockSystemVMServiceIface) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) StartSystemVm(p *StartSystemVmParams, opts ...CallOption) (*StartSystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartSystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartSystemVm", varargs...)
 	ret0, _ := ret[0].(*StartSystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartSystemVm indicates an expected call of StartSystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) StartSystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) StartSystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StartSystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StartSystemVm), varargs...)
 }
 
 // StopSystemVm mocks base method.
-func (m *MockSystemVMServiceIface) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+func (m *MockSystemVMServiceIface) StopSystemVm(p *StopSystemVmParams, opts ...CallOption) (*StopSystemVmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopSystemVm", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopSystemVm", varargs...)
 	ret0, _ := ret[0].(*StopSystemVmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StopSystemVm indicates an expected call of StopSystemVm.
-func (mr *MockSystemVMServiceIfaceMockRecorder) StopSystemVm(p interface{}) *gomock.Call {
+func (mr *MockSystemVMServiceIfaceMockRecorder) StopSystemVm(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StopSystemVm), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StopSystemVm), varargs...)
 }
@@ -28,162 +28,260 @@ import (
 )
 
 type TemplateServiceIface interface {
-	CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplate(p *CopyTemplateParams, opts ...CallOption) (*CopyTemplateResponse, error)
 	NewCopyTemplateParams(id string) *CopyTemplateParams
-	CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplate(p *CreateTemplateParams, opts ...CallOption) (*CreateTemplateResponse, error)
 	NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams
-	DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplate(p *DeleteTemplateParams, opts ...CallOption) (*DeleteTemplateResponse, error)
 	NewDeleteTemplateParams(id string) *DeleteTemplateParams
-	ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplate(p *ExtractTemplateParams, opts ...CallOption) (*ExtractTemplateResponse, error)
 	NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams
-	GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+	GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams, opts ...CallOption) (*GetUploadParamsForTemplateResponse, error)
 	NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, zoneid string) *GetUploadParamsForTemplateParams
-	ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	ListTemplatePermissions(p *ListTemplatePermissionsParams, opts ...CallOption) (*ListTemplatePermissionsResponse, error)
 	NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams
 	GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
-	ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	ListTemplates(p *ListTemplatesParams, opts ...CallOption) (*ListTemplatesResponse, error)
 	NewListTemplatesParams(templatefilter string) *ListTemplatesParams
 	GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
 	GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
 	GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
-	PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	PrepareTemplate(p *PrepareTemplateParams, opts ...CallOption) (*PrepareTemplateResponse, error)
 	NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams
-	RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	RegisterTemplate(p *RegisterTemplateParams, opts ...CallOption) (*RegisterTemplateResponse, error)
 	NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, url string) *RegisterTemplateParams
-	UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	UpdateTemplate(p *UpdateTemplateParams, opts ...CallOption) (*UpdateTemplateResponse, error)
 	NewUpdateTemplateParams(id string) *UpdateTemplateParams
-	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams, opts ...CallOption) (*UpdateTemplatePermissionsResponse, error)
 	NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams
-	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams, opts ...CallOption) (*UpgradeRouterTemplateResponse, error)
 	NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams
-	ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error)
+	ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams, opts ...CallOption) (*ListTemplateDirectDownloadCertificatesResponse, error)
 	NewListTemplateDirectDownloadCertificatesParams() *ListTemplateDirectDownloadCertificatesParams
 	GetTemplateDirectDownloadCertificateByID(id string, opts ...OptionFunc) (*TemplateDirectDownloadCertificate, int, error)
-	ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error)
+	ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams, opts ...CallOption) (*ProvisionTemplateDirectDownloadCertificateResponse, error)
 	NewProvisionTemplateDirectDownloadCertificateParams(hostid string, id string) *ProvisionTemplateDirectDownloadCertificateParams
 }
 
 type CopyTemplateParams struct {
-	p map[string]interface{}
+	destzoneid   optString
+	destzoneids  optStrings
+	id           optString
+	sourcezoneid optString
 }
 
-func (p *CopyTemplateParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CopyTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["destzoneid"]; found {
-		u.Set("destzoneid", v.(string))
+	if p.destzoneid.ok {
+		u.Set("destzoneid", p.destzoneid.v)
 	}
-	if v, found := p.p["destzoneids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("destzoneids", vv)
+	if p.destzoneids.ok {
+		u.Set("destzoneids", strings.Join(p.destzoneids.v, ","))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["sourcezoneid"]; found {
-		u.Set("sourcezoneid", v.(string))
+	if p.sourcezoneid.ok {
+		u.Set("sourcezoneid", p.sourcezoneid.v)
 	}
 	return u
 }
 
-func (p *CopyTemplateParams) SetDestzoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCopyTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new CopyTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCopyTemplateParams(u url.Values) (*CopyTemplateParams, error) {
+	p := &CopyTemplateParams{}
+	if err := checkParamNames("copyTemplate", u, "destzoneid", "destzoneids", "id", "sourcezoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["destzoneid"]; found {
+		p.SetDestzoneid(u.Get("destzoneid"))
 	}
-	p.p["destzoneid"] = v
+	if _, found := u["destzoneids"]; found {
+		p.SetDestzoneids(strings.Split(u.Get("destzoneids"), ","))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["sourcezoneid"]; found {
+		p.SetSourcezoneid(u.Get("sourcezoneid"))
+	}
+	return p, nil
+}
+
+// SetDestzoneid sets the destzoneid param.
+func (p *CopyTemplateParams) SetDestzoneid(v string) {
+	p.destzoneid = optString{v: v, ok: true}
 }
 
+// ResetDestzoneid unsets the destzoneid param
 func (p *CopyTemplateParams) ResetDestzoneid() {
-	if p.p != nil && p.p["destzoneid"] != nil {
-		delete(p.p, "destzoneid")
-	}
+	p.destzoneid = optString{}
 }
 
+// GetDestzoneid returns the destzoneid param and if it is set
 func (p *CopyTemplateParams) GetDestzoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["destzoneid"].(string)
-	return value, ok
+	return p.destzoneid.v, p.destzoneid.ok
 }
 
+// SetDestzoneids sets the destzoneids param.
 func (p *CopyTemplateParams) SetDestzoneids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["destzoneids"] = v
+	p.destzoneids = optStrings{v: v, ok: true}
 }
 
+// ResetDestzoneids unsets the destzoneids param
 func (p *CopyTemplateParams) ResetDestzoneids() {
-	if p.p != nil && p.p["destzoneids"] != nil {
-		delete(p.p, "destzoneids")
-	}
+	p.destzoneids = optStrings{}
 }
 
+// GetDestzoneids returns the destzoneids param and if it is set
 func (p *CopyTemplateParams) GetDestzoneids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["destzoneids"].([]string)
-	return value, ok
+	return p.destzoneids.v, p.destzoneids.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *CopyTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *CopyTemplateParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *CopyTemplateParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetSourcezoneid sets the sourcezoneid param.
 func (p *CopyTemplateParams) SetSourcezoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcezoneid"] = v
+	p.sourcezoneid = optString{v: v, ok: true}
 }
 
+// ResetSourcezoneid unsets the sourcezoneid param
 func (p *CopyTemplateParams) ResetSourcezoneid() {
-	if p.p != nil && p.p["sourcezoneid"] != nil {
-		delete(p.p, "sourcezoneid")
-	}
+	p.sourcezoneid = optString{}
 }
 
+// GetSourcezoneid returns the sourcezoneid param and if it is set
 func (p *CopyTemplateParams) GetSourcezoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.sourcezoneid.v, p.sourcezoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CopyTemplateParams) Clone() *CopyTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.destzoneids = p.destzoneids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CopyTemplateParams) Equal(o *CopyTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.destzoneid == o.destzoneid &&
+		p.destzoneids.equal(o.destzoneids) &&
+		p.id == o.id &&
+		p.sourcezoneid == o.sourcezoneid
+}
+
+// serializedCopyTemplateParams is used to (un)marshal CopyTemplateParams using the API param names
+type serializedCopyTemplateParams struct {
+	Destzoneid   *string   `json:"destzoneid,omitempty" yaml:"destzoneid,omitempty"`
+	Destzoneids  *[]string `json:"destzoneids,omitempty" yaml:"destzoneids,omitempty"`
+	Id           *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Sourcezoneid *string   `json:"sourcezoneid,omitempty" yaml:"sourcezoneid,omitempty"`
+}
+
+func (p *CopyTemplateParams) toSerialized() *serializedCopyTemplateParams {
+	s := &serializedCopyTemplateParams{}
+	if p.destzoneid.ok {
+		s.Destzoneid = &p.destzoneid.v
+	}
+	if p.destzoneids.ok {
+		s.Destzoneids = &p.destzoneids.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["sourcezoneid"].(string)
-	return value, ok
+	if p.sourcezoneid.ok {
+		s.Sourcezoneid = &p.sourcezoneid.v
+	}
+	return s
+}
+
+func (p *CopyTemplateParams) fromSerialized(s *serializedCopyTemplateParams) {
+	*p = CopyTemplateParams{}
+	if s.Destzoneid != nil {
+		p.SetDestzoneid(*s.Destzoneid)
+	}
+	if s.Destzoneids != nil {
+		p.SetDestzoneids(*s.Destzoneids)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Sourcezoneid != nil {
+		p.SetSourcezoneid(*s.Sourcezoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CopyTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CopyTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedCopyTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CopyTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CopyTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCopyTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CopyTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewCopyTemplateParams(id string) *CopyTemplateParams {
 	p := &CopyTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Copies a template from one zone to another.
-func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
-	resp, err := s.cs.newRequest("copyTemplate", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *TemplateService) CopyTemplate(p *CopyTemplateParams, opts ...CallOption) (*CopyTemplateResponse, error) {
+	resp, err := s.cs.newRequest("copyTemplate", s.cs.encodeParams("copyTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -194,8 +292,8 @@ func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResp
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -301,521 +399,742 @@ func (r *CopyTemplateResponse) UnmarshalJSON(b []byte) error {
 }
 
 type CreateTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateTemplateParams) toURLValues() url.Values {
+	account               optString
+	bits                  optInt
+	details               optStringMap
+	displaytext           optString
+	domainid              optString
+	isdynamicallyscalable optBool
+	isfeatured            optBool
+	ispublic              optBool
+	name                  optString
+	ostypeid              optString
+	passwordenabled       optBool
+	projectid             optString
+	requireshvm           optBool
+	snapshotid            optString
+	sshkeyenabled         optBool
+	templatetag           optString
+	url                   optString
+	virtualmachineid      optString
+	volumeid              optString
+	zoneid                optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["bits"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("bits", vv)
+	if p.bits.ok {
+		u.Set("bits", strconv.Itoa(p.bits.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
+	if p.isdynamicallyscalable.ok {
+		u.Set("isdynamicallyscalable", strconv.FormatBool(p.isdynamicallyscalable.v))
 	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
+	if p.isfeatured.ok {
+		u.Set("isfeatured", strconv.FormatBool(p.isfeatured.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
+	if p.passwordenabled.ok {
+		u.Set("passwordenabled", strconv.FormatBool(p.passwordenabled.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["requireshvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("requireshvm", vv)
+	if p.requireshvm.ok {
+		u.Set("requireshvm", strconv.FormatBool(p.requireshvm.v))
 	}
-	if v, found := p.p["snapshotid"]; found {
-		u.Set("snapshotid", v.(string))
+	if p.snapshotid.ok {
+		u.Set("snapshotid", p.snapshotid.v)
 	}
-	if v, found := p.p["sshkeyenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sshkeyenabled", vv)
+	if p.sshkeyenabled.ok {
+		u.Set("sshkeyenabled", strconv.FormatBool(p.sshkeyenabled.v))
 	}
-	if v, found := p.p["templatetag"]; found {
-		u.Set("templatetag", v.(string))
+	if p.templatetag.ok {
+		u.Set("templatetag", p.templatetag.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateTemplateParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new CreateTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateTemplateParams(u url.Values) (*CreateTemplateParams, error) {
+	p := &CreateTemplateParams{}
+	if err := checkParamNames("createTemplate", u, "account", "bits", "details[]", "displaytext", "domainid", "isdynamicallyscalable", "isfeatured", "ispublic", "name", "ostypeid", "passwordenabled", "projectid", "requireshvm", "snapshotid", "sshkeyenabled", "templatetag", "url", "virtualmachineid", "volumeid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["bits"]; found {
+		v, err := strconv.Atoi(u.Get("bits"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bits: %v", err)
+		}
+		p.SetBits(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
 	}
-	p.p["account"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["isdynamicallyscalable"]; found {
+		v, err := strconv.ParseBool(u.Get("isdynamicallyscalable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdynamicallyscalable: %v", err)
+		}
+		p.SetIsdynamicallyscalable(v)
+	}
+	if _, found := u["isfeatured"]; found {
+		v, err := strconv.ParseBool(u.Get("isfeatured"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isfeatured: %v", err)
+		}
+		p.SetIsfeatured(v)
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	if _, found := u["passwordenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("passwordenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param passwordenabled: %v", err)
+		}
+		p.SetPasswordenabled(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["requireshvm"]; found {
+		v, err := strconv.ParseBool(u.Get("requireshvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param requireshvm: %v", err)
+		}
+		p.SetRequireshvm(v)
+	}
+	if _, found := u["snapshotid"]; found {
+		p.SetSnapshotid(u.Get("snapshotid"))
+	}
+	if _, found := u["sshkeyenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("sshkeyenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sshkeyenabled: %v", err)
+		}
+		p.SetSshkeyenabled(v)
+	}
+	if _, found := u["templatetag"]; found {
+		p.SetTemplatetag(u.Get("templatetag"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateTemplateParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateTemplateParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateTemplateParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetBits sets the bits param.
 func (p *CreateTemplateParams) SetBits(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bits"] = v
+	p.bits = optInt{v: v, ok: true}
 }
 
+// ResetBits unsets the bits param
 func (p *CreateTemplateParams) ResetBits() {
-	if p.p != nil && p.p["bits"] != nil {
-		delete(p.p, "bits")
-	}
+	p.bits = optInt{}
 }
 
+// GetBits returns the bits param and if it is set
 func (p *CreateTemplateParams) GetBits() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bits"].(int)
-	return value, ok
+	return p.bits.v, p.bits.ok
 }
 
+// SetDetails sets the details param.
 func (p *CreateTemplateParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *CreateTemplateParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *CreateTemplateParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *CreateTemplateParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateTemplateParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateTemplateParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateTemplateParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateTemplateParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateTemplateParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetIsdynamicallyscalable sets the isdynamicallyscalable param.
 func (p *CreateTemplateParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
+	p.isdynamicallyscalable = optBool{v: v, ok: true}
 }
 
+// ResetIsdynamicallyscalable unsets the isdynamicallyscalable param
 func (p *CreateTemplateParams) ResetIsdynamicallyscalable() {
-	if p.p != nil && p.p["isdynamicallyscalable"] != nil {
-		delete(p.p, "isdynamicallyscalable")
-	}
+	p.isdynamicallyscalable = optBool{}
 }
 
+// GetIsdynamicallyscalable returns the isdynamicallyscalable param and if it is set
 func (p *CreateTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdynamicallyscalable"].(bool)
-	return value, ok
+	return p.isdynamicallyscalable.v, p.isdynamicallyscalable.ok
 }
 
+// SetIsfeatured sets the isfeatured param.
 func (p *CreateTemplateParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
+	p.isfeatured = optBool{v: v, ok: true}
 }
 
+// ResetIsfeatured unsets the isfeatured param
 func (p *CreateTemplateParams) ResetIsfeatured() {
-	if p.p != nil && p.p["isfeatured"] != nil {
-		delete(p.p, "isfeatured")
-	}
+	p.isfeatured = optBool{}
 }
 
+// GetIsfeatured returns the isfeatured param and if it is set
 func (p *CreateTemplateParams) GetIsfeatured() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isfeatured"].(bool)
-	return value, ok
+	return p.isfeatured.v, p.isfeatured.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *CreateTemplateParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *CreateTemplateParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *CreateTemplateParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateTemplateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateTemplateParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateTemplateParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOstypeid sets the ostypeid param. This param is required.
 func (p *CreateTemplateParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *CreateTemplateParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *CreateTemplateParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetPasswordenabled sets the passwordenabled param.
 func (p *CreateTemplateParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["passwordenabled"] = v
+	p.passwordenabled = optBool{v: v, ok: true}
 }
 
+// ResetPasswordenabled unsets the passwordenabled param
 func (p *CreateTemplateParams) ResetPasswordenabled() {
-	if p.p != nil && p.p["passwordenabled"] != nil {
-		delete(p.p, "passwordenabled")
-	}
+	p.passwordenabled = optBool{}
 }
 
+// GetPasswordenabled returns the passwordenabled param and if it is set
 func (p *CreateTemplateParams) GetPasswordenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["passwordenabled"].(bool)
-	return value, ok
+	return p.passwordenabled.v, p.passwordenabled.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateTemplateParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateTemplateParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateTemplateParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRequireshvm sets the requireshvm param.
 func (p *CreateTemplateParams) SetRequireshvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["requireshvm"] = v
+	p.requireshvm = optBool{v: v, ok: true}
 }
 
+// ResetRequireshvm unsets the requireshvm param
 func (p *CreateTemplateParams) ResetRequireshvm() {
-	if p.p != nil && p.p["requireshvm"] != nil {
-		delete(p.p, "requireshvm")
-	}
+	p.requireshvm = optBool{}
 }
 
+// GetRequireshvm returns the requireshvm param and if it is set
 func (p *CreateTemplateParams) GetRequireshvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["requireshvm"].(bool)
-	return value, ok
+	return p.requireshvm.v, p.requireshvm.ok
 }
 
+// SetSnapshotid sets the snapshotid param.
 func (p *CreateTemplateParams) SetSnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["snapshotid"] = v
+	p.snapshotid = optString{v: v, ok: true}
 }
 
+// ResetSnapshotid unsets the snapshotid param
 func (p *CreateTemplateParams) ResetSnapshotid() {
-	if p.p != nil && p.p["snapshotid"] != nil {
-		delete(p.p, "snapshotid")
-	}
+	p.snapshotid = optString{}
 }
 
+// GetSnapshotid returns the snapshotid param and if it is set
 func (p *CreateTemplateParams) GetSnapshotid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["snapshotid"].(string)
-	return value, ok
+	return p.snapshotid.v, p.snapshotid.ok
 }
 
+// SetSshkeyenabled sets the sshkeyenabled param.
 func (p *CreateTemplateParams) SetSshkeyenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sshkeyenabled"] = v
+	p.sshkeyenabled = optBool{v: v, ok: true}
 }
 
+// ResetSshkeyenabled unsets the sshkeyenabled param
 func (p *CreateTemplateParams) ResetSshkeyenabled() {
-	if p.p != nil && p.p["sshkeyenabled"] != nil {
-		delete(p.p, "sshkeyenabled")
-	}
+	p.sshkeyenabled = optBool{}
 }
 
+// GetSshkeyenabled returns the sshkeyenabled param and if it is set
 func (p *CreateTemplateParams) GetSshkeyenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sshkeyenabled"].(bool)
-	return value, ok
+	return p.sshkeyenabled.v, p.sshkeyenabled.ok
 }
 
+// SetTemplatetag sets the templatetag param.
 func (p *CreateTemplateParams) SetTemplatetag(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templatetag"] = v
+	p.templatetag = optString{v: v, ok: true}
 }
 
+// ResetTemplatetag unsets the templatetag param
 func (p *CreateTemplateParams) ResetTemplatetag() {
-	if p.p != nil && p.p["templatetag"] != nil {
-		delete(p.p, "templatetag")
-	}
+	p.templatetag = optString{}
 }
 
+// GetTemplatetag returns the templatetag param and if it is set
 func (p *CreateTemplateParams) GetTemplatetag() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templatetag"].(string)
-	return value, ok
+	return p.templatetag.v, p.templatetag.ok
 }
 
+// SetUrl sets the url param.
 func (p *CreateTemplateParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *CreateTemplateParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *CreateTemplateParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *CreateTemplateParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *CreateTemplateParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *CreateTemplateParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetVolumeid sets the volumeid param.
 func (p *CreateTemplateParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
+	p.volumeid = optString{v: v, ok: true}
 }
 
+// ResetVolumeid unsets the volumeid param
 func (p *CreateTemplateParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *CreateTemplateParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+	return p.volumeid.v, p.volumeid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateTemplateParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateTemplateParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateTemplateParams) Clone() *CreateTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateTemplateParams) Equal(o *CreateTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.bits == o.bits &&
+		p.details.equal(o.details) &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.isdynamicallyscalable == o.isdynamicallyscalable &&
+		p.isfeatured == o.isfeatured &&
+		p.ispublic == o.ispublic &&
+		p.name == o.name &&
+		p.ostypeid == o.ostypeid &&
+		p.passwordenabled == o.passwordenabled &&
+		p.projectid == o.projectid &&
+		p.requireshvm == o.requireshvm &&
+		p.snapshotid == o.snapshotid &&
+		p.sshkeyenabled == o.sshkeyenabled &&
+		p.templatetag == o.templatetag &&
+		p.url == o.url &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.volumeid == o.volumeid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateTemplateParams is used to (un)marshal CreateTemplateParams using the API param names
+type serializedCreateTemplateParams struct {
+	Account               *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Bits                  *int               `json:"bits,omitempty" yaml:"bits,omitempty"`
+	Details               *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isdynamicallyscalable *bool              `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isfeatured            *bool              `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic              *bool              `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Name                  *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string            `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool              `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Projectid             *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Requireshvm           *bool              `json:"requireshvm,omitempty" yaml:"requireshvm,omitempty"`
+	Snapshotid            *string            `json:"snapshotid,omitempty" yaml:"snapshotid,omitempty"`
+	Sshkeyenabled         *bool              `json:"sshkeyenabled,omitempty" yaml:"sshkeyenabled,omitempty"`
+	Templatetag           *string            `json:"templatetag,omitempty" yaml:"templatetag,omitempty"`
+	Url                   *string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Virtualmachineid      *string            `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Volumeid              *string            `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+	Zoneid                *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateTemplateParams) toSerialized() *serializedCreateTemplateParams {
+	s := &serializedCreateTemplateParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.bits.ok {
+		s.Bits = &p.bits.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.isdynamicallyscalable.ok {
+		s.Isdynamicallyscalable = &p.isdynamicallyscalable.v
+	}
+	if p.isfeatured.ok {
+		s.Isfeatured = &p.isfeatured.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.passwordenabled.ok {
+		s.Passwordenabled = &p.passwordenabled.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.requireshvm.ok {
+		s.Requireshvm = &p.requireshvm.v
+	}
+	if p.snapshotid.ok {
+		s.Snapshotid = &p.snapshotid.v
+	}
+	if p.sshkeyenabled.ok {
+		s.Sshkeyenabled = &p.sshkeyenabled.v
+	}
+	if p.templatetag.ok {
+		s.Templatetag = &p.templatetag.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateTemplateParams) fromSerialized(s *serializedCreateTemplateParams) {
+	*p = CreateTemplateParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Bits != nil {
+		p.SetBits(*s.Bits)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Isdynamicallyscalable != nil {
+		p.SetIsdynamicallyscalable(*s.Isdynamicallyscalable)
+	}
+	if s.Isfeatured != nil {
+		p.SetIsfeatured(*s.Isfeatured)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+	if s.Passwordenabled != nil {
+		p.SetPasswordenabled(*s.Passwordenabled)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Requireshvm != nil {
+		p.SetRequireshvm(*s.Requireshvm)
+	}
+	if s.Snapshotid != nil {
+		p.SetSnapshotid(*s.Snapshotid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Sshkeyenabled != nil {
+		p.SetSshkeyenabled(*s.Sshkeyenabled)
+	}
+	if s.Templatetag != nil {
+		p.SetTemplatetag(*s.Templatetag)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams {
 	p := &CreateTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["ostypeid"] = ostypeid
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetOstypeid(ostypeid)
 	return p
 }
 
-// Creates a template of a virtual machine. The virtual machine must be in a STOPPED state. A template created from this command is automatically designated as a private template visible to the account that created it.
-func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("createTemplate", p.toURLValues())
+// Creates a template of a virtual machine. The virtual machine must be in a STOPPED state. A
+// template created from this command is automatically designated as a private template visible to
+// the account that created it.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: displaytext, name,
+// ostypeid.
+func (s *TemplateService) CreateTemplate(p *CreateTemplateParams, opts ...CallOption) (*CreateTemplateResponse, error) {
+	resp, err := s.cs.newRequest("createTemplate", s.cs.encodeParams("createTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -826,8 +1145,8 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -933,119 +1252,215 @@ func (r *CreateTemplateResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteTemplateParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
+	zoneid optString
 }
 
-func (p *DeleteTemplateParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *DeleteTemplateParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteTemplateParams(u url.Values) (*DeleteTemplateParams, error) {
+	p := &DeleteTemplateParams{}
+	if err := checkParamNames("deleteTemplate", u, "forced", "id", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["forced"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *DeleteTemplateParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *DeleteTemplateParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *DeleteTemplateParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DeleteTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteTemplateParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteTemplateParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *DeleteTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *DeleteTemplateParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *DeleteTemplateParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteTemplateParams) Clone() *DeleteTemplateParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new DeleteTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplateParams {
-	p := &DeleteTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteTemplateParams) Equal(o *DeleteTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id &&
+		p.zoneid == o.zoneid
 }
 
-// Deletes a template from the system. All virtual machines using the deleted template will not be affected.
-func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
-	resp, err := s.cs.newRequest("deleteTemplate", p.toURLValues())
-	if err != nil {
-		return nil, err
+// serializedDeleteTemplateParams is used to (un)marshal DeleteTemplateParams using the API param names
+type serializedDeleteTemplateParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *DeleteTemplateParams) toSerialized() *serializedDeleteTemplateParams {
+	s := &serializedDeleteTemplateParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
 
-	var r DeleteTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *DeleteTemplateParams) fromSerialized(s *serializedDeleteTemplateParams) {
+	*p = DeleteTemplateParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteTemplateParams instance,
+// as then you are sure you have configured all required params
+func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplateParams {
+	p := &DeleteTemplateParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a template from the system. All virtual machines using the deleted template will not be
+// affected.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams, opts ...CallOption) (*DeleteTemplateResponse, error) {
+	resp, err := s.cs.newRequest("deleteTemplate", s.cs.encodeParams("deleteTemplate", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
 		}
 
 		if err := json.Unmarshal(b, &r); err != nil {
@@ -1064,126 +1479,224 @@ type DeleteTemplateResponse struct {
 }
 
 type ExtractTemplateParams struct {
-	p map[string]interface{}
+	id     optString
+	mode   optString
+	url    optString
+	zoneid optString
 }
 
-func (p *ExtractTemplateParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ExtractTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["mode"]; found {
-		u.Set("mode", v.(string))
+	if p.mode.ok {
+		u.Set("mode", p.mode.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ExtractTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseExtractTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new ExtractTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseExtractTemplateParams(u url.Values) (*ExtractTemplateParams, error) {
+	p := &ExtractTemplateParams{}
+	if err := checkParamNames("extractTemplate", u, "id", "mode", "url", "zoneid"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["mode"]; found {
+		p.SetMode(u.Get("mode"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ExtractTemplateParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ExtractTemplateParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ExtractTemplateParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMode sets the mode param. This param is required.
 func (p *ExtractTemplateParams) SetMode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["mode"] = v
+	p.mode = optString{v: v, ok: true}
 }
 
+// ResetMode unsets the mode param
 func (p *ExtractTemplateParams) ResetMode() {
-	if p.p != nil && p.p["mode"] != nil {
-		delete(p.p, "mode")
-	}
+	p.mode = optString{}
 }
 
+// GetMode returns the mode param and if it is set
 func (p *ExtractTemplateParams) GetMode() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["mode"].(string)
-	return value, ok
+	return p.mode.v, p.mode.ok
 }
 
+// SetUrl sets the url param.
 func (p *ExtractTemplateParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *ExtractTemplateParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *ExtractTemplateParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ExtractTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ExtractTemplateParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ExtractTemplateParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ExtractTemplateParams) Clone() *ExtractTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ExtractTemplateParams) Equal(o *ExtractTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.mode == o.mode &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedExtractTemplateParams is used to (un)marshal ExtractTemplateParams using the API param names
+type serializedExtractTemplateParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Mode   *string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ExtractTemplateParams) toSerialized() *serializedExtractTemplateParams {
+	s := &serializedExtractTemplateParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.mode.ok {
+		s.Mode = &p.mode.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ExtractTemplateParams) fromSerialized(s *serializedExtractTemplateParams) {
+	*p = ExtractTemplateParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Mode != nil {
+		p.SetMode(*s.Mode)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ExtractTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ExtractTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedExtractTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ExtractTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ExtractTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedExtractTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ExtractTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams {
 	p := &ExtractTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["mode"] = mode
+	p.SetId(id)
+	p.SetMode(mode)
 	return p
 }
 
-// Extracts a template
-func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
-	resp, err := s.cs.newRequest("extractTemplate", p.toURLValues())
+// Extracts a template.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, mode.
+func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams, opts ...CallOption) (*ExtractTemplateResponse, error) {
+	resp, err := s.cs.newRequest("extractTemplate", s.cs.encodeParams("extractTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1194,8 +1707,8 @@ func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTem
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1236,574 +1749,812 @@ type ExtractTemplateResponse struct {
 }
 
 type GetUploadParamsForTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
+	account               optString
+	bits                  optInt
+	checksum              optString
+	deployasis            optBool
+	details               optStringMap
+	displaytext           optString
+	domainid              optString
+	format                optString
+	hypervisor            optString
+	isdynamicallyscalable optBool
+	isextractable         optBool
+	isfeatured            optBool
+	ispublic              optBool
+	isrouting             optBool
+	name                  optString
+	ostypeid              optString
+	passwordenabled       optBool
+	projectid             optString
+	requireshvm           optBool
+	sshkeyenabled         optBool
+	templatetag           optString
+	zoneid                optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetUploadParamsForTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["bits"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("bits", vv)
+	if p.bits.ok {
+		u.Set("bits", strconv.Itoa(p.bits.v))
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	if p.checksum.ok {
+		u.Set("checksum", p.checksum.v)
 	}
-	if v, found := p.p["deployasis"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("deployasis", vv)
+	if p.deployasis.ok {
+		u.Set("deployasis", strconv.FormatBool(p.deployasis.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if p.format.ok {
+		u.Set("format", p.format.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
+	if p.isdynamicallyscalable.ok {
+		u.Set("isdynamicallyscalable", strconv.FormatBool(p.isdynamicallyscalable.v))
 	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
+	if p.isextractable.ok {
+		u.Set("isextractable", strconv.FormatBool(p.isextractable.v))
 	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
+	if p.isfeatured.ok {
+		u.Set("isfeatured", strconv.FormatBool(p.isfeatured.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["isrouting"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrouting", vv)
+	if p.isrouting.ok {
+		u.Set("isrouting", strconv.FormatBool(p.isrouting.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
+	if p.passwordenabled.ok {
+		u.Set("passwordenabled", strconv.FormatBool(p.passwordenabled.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["requireshvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("requireshvm", vv)
+	if p.requireshvm.ok {
+		u.Set("requireshvm", strconv.FormatBool(p.requireshvm.v))
 	}
-	if v, found := p.p["sshkeyenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sshkeyenabled", vv)
+	if p.sshkeyenabled.ok {
+		u.Set("sshkeyenabled", strconv.FormatBool(p.sshkeyenabled.v))
 	}
-	if v, found := p.p["templatetag"]; found {
-		u.Set("templatetag", v.(string))
+	if p.templatetag.ok {
+		u.Set("templatetag", p.templatetag.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *GetUploadParamsForTemplateParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetUploadParamsForTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new GetUploadParamsForTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetUploadParamsForTemplateParams(u url.Values) (*GetUploadParamsForTemplateParams, error) {
+	p := &GetUploadParamsForTemplateParams{}
+	if err := checkParamNames("getUploadParamsForTemplate", u, "account", "bits", "checksum", "deployasis", "details[]", "displaytext", "domainid", "format", "hypervisor", "isdynamicallyscalable", "isextractable", "isfeatured", "ispublic", "isrouting", "name", "ostypeid", "passwordenabled", "projectid", "requireshvm", "sshkeyenabled", "templatetag", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["bits"]; found {
+		v, err := strconv.Atoi(u.Get("bits"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bits: %v", err)
+		}
+		p.SetBits(v)
+	}
+	if _, found := u["checksum"]; found {
+		p.SetChecksum(u.Get("checksum"))
 	}
-	p.p["account"] = v
+	if _, found := u["deployasis"]; found {
+		v, err := strconv.ParseBool(u.Get("deployasis"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param deployasis: %v", err)
+		}
+		p.SetDeployasis(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["format"]; found {
+		p.SetFormat(u.Get("format"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["isdynamicallyscalable"]; found {
+		v, err := strconv.ParseBool(u.Get("isdynamicallyscalable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdynamicallyscalable: %v", err)
+		}
+		p.SetIsdynamicallyscalable(v)
+	}
+	if _, found := u["isextractable"]; found {
+		v, err := strconv.ParseBool(u.Get("isextractable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isextractable: %v", err)
+		}
+		p.SetIsextractable(v)
+	}
+	if _, found := u["isfeatured"]; found {
+		v, err := strconv.ParseBool(u.Get("isfeatured"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isfeatured: %v", err)
+		}
+		p.SetIsfeatured(v)
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["isrouting"]; found {
+		v, err := strconv.ParseBool(u.Get("isrouting"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrouting: %v", err)
+		}
+		p.SetIsrouting(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	if _, found := u["passwordenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("passwordenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param passwordenabled: %v", err)
+		}
+		p.SetPasswordenabled(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["requireshvm"]; found {
+		v, err := strconv.ParseBool(u.Get("requireshvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param requireshvm: %v", err)
+		}
+		p.SetRequireshvm(v)
+	}
+	if _, found := u["sshkeyenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("sshkeyenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sshkeyenabled: %v", err)
+		}
+		p.SetSshkeyenabled(v)
+	}
+	if _, found := u["templatetag"]; found {
+		p.SetTemplatetag(u.Get("templatetag"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *GetUploadParamsForTemplateParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *GetUploadParamsForTemplateParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetBits sets the bits param.
 func (p *GetUploadParamsForTemplateParams) SetBits(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bits"] = v
+	p.bits = optInt{v: v, ok: true}
 }
 
+// ResetBits unsets the bits param
 func (p *GetUploadParamsForTemplateParams) ResetBits() {
-	if p.p != nil && p.p["bits"] != nil {
-		delete(p.p, "bits")
-	}
+	p.bits = optInt{}
 }
 
+// GetBits returns the bits param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetBits() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bits"].(int)
-	return value, ok
+	return p.bits.v, p.bits.ok
 }
 
+// SetChecksum sets the checksum param.
 func (p *GetUploadParamsForTemplateParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["checksum"] = v
+	p.checksum = optString{v: v, ok: true}
 }
 
+// ResetChecksum unsets the checksum param
 func (p *GetUploadParamsForTemplateParams) ResetChecksum() {
-	if p.p != nil && p.p["checksum"] != nil {
-		delete(p.p, "checksum")
-	}
+	p.checksum = optString{}
 }
 
+// GetChecksum returns the checksum param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetChecksum() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["checksum"].(string)
-	return value, ok
+	return p.checksum.v, p.checksum.ok
 }
 
+// SetDeployasis sets the deployasis param.
 func (p *GetUploadParamsForTemplateParams) SetDeployasis(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deployasis"] = v
+	p.deployasis = optBool{v: v, ok: true}
 }
 
+// ResetDeployasis unsets the deployasis param
 func (p *GetUploadParamsForTemplateParams) ResetDeployasis() {
-	if p.p != nil && p.p["deployasis"] != nil {
-		delete(p.p, "deployasis")
-	}
+	p.deployasis = optBool{}
 }
 
+// GetDeployasis returns the deployasis param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetDeployasis() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["deployasis"].(bool)
-	return value, ok
+	return p.deployasis.v, p.deployasis.ok
 }
 
+// SetDetails sets the details param.
 func (p *GetUploadParamsForTemplateParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *GetUploadParamsForTemplateParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *GetUploadParamsForTemplateParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *GetUploadParamsForTemplateParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *GetUploadParamsForTemplateParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *GetUploadParamsForTemplateParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFormat sets the format param. This param is required.
 func (p *GetUploadParamsForTemplateParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
+	p.format = optString{v: v, ok: true}
 }
 
+// ResetFormat unsets the format param
 func (p *GetUploadParamsForTemplateParams) ResetFormat() {
-	if p.p != nil && p.p["format"] != nil {
-		delete(p.p, "format")
-	}
+	p.format = optString{}
 }
 
+// GetFormat returns the format param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetFormat() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["format"].(string)
-	return value, ok
+	return p.format.v, p.format.ok
 }
 
+// SetHypervisor sets the hypervisor param. This param is required.
 func (p *GetUploadParamsForTemplateParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *GetUploadParamsForTemplateParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetIsdynamicallyscalable sets the isdynamicallyscalable param.
 func (p *GetUploadParamsForTemplateParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
+	p.isdynamicallyscalable = optBool{v: v, ok: true}
 }
 
+// ResetIsdynamicallyscalable unsets the isdynamicallyscalable param
 func (p *GetUploadParamsForTemplateParams) ResetIsdynamicallyscalable() {
-	if p.p != nil && p.p["isdynamicallyscalable"] != nil {
-		delete(p.p, "isdynamicallyscalable")
-	}
+	p.isdynamicallyscalable = optBool{}
 }
 
+// GetIsdynamicallyscalable returns the isdynamicallyscalable param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdynamicallyscalable"].(bool)
-	return value, ok
+	return p.isdynamicallyscalable.v, p.isdynamicallyscalable.ok
 }
 
+// SetIsextractable sets the isextractable param.
 func (p *GetUploadParamsForTemplateParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isextractable"] = v
+	p.isextractable = optBool{v: v, ok: true}
 }
 
+// ResetIsextractable unsets the isextractable param
 func (p *GetUploadParamsForTemplateParams) ResetIsextractable() {
-	if p.p != nil && p.p["isextractable"] != nil {
-		delete(p.p, "isextractable")
-	}
+	p.isextractable = optBool{}
 }
 
+// GetIsextractable returns the isextractable param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetIsextractable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isextractable"].(bool)
-	return value, ok
+	return p.isextractable.v, p.isextractable.ok
 }
 
+// SetIsfeatured sets the isfeatured param.
 func (p *GetUploadParamsForTemplateParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
+	p.isfeatured = optBool{v: v, ok: true}
 }
 
+// ResetIsfeatured unsets the isfeatured param
 func (p *GetUploadParamsForTemplateParams) ResetIsfeatured() {
-	if p.p != nil && p.p["isfeatured"] != nil {
-		delete(p.p, "isfeatured")
-	}
+	p.isfeatured = optBool{}
 }
 
+// GetIsfeatured returns the isfeatured param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetIsfeatured() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isfeatured"].(bool)
-	return value, ok
+	return p.isfeatured.v, p.isfeatured.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *GetUploadParamsForTemplateParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *GetUploadParamsForTemplateParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetIsrouting sets the isrouting param.
 func (p *GetUploadParamsForTemplateParams) SetIsrouting(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrouting"] = v
+	p.isrouting = optBool{v: v, ok: true}
 }
 
+// ResetIsrouting unsets the isrouting param
 func (p *GetUploadParamsForTemplateParams) ResetIsrouting() {
-	if p.p != nil && p.p["isrouting"] != nil {
-		delete(p.p, "isrouting")
-	}
+	p.isrouting = optBool{}
 }
 
+// GetIsrouting returns the isrouting param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetIsrouting() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrouting"].(bool)
-	return value, ok
+	return p.isrouting.v, p.isrouting.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *GetUploadParamsForTemplateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *GetUploadParamsForTemplateParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *GetUploadParamsForTemplateParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *GetUploadParamsForTemplateParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetPasswordenabled sets the passwordenabled param.
 func (p *GetUploadParamsForTemplateParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["passwordenabled"] = v
+	p.passwordenabled = optBool{v: v, ok: true}
 }
 
+// ResetPasswordenabled unsets the passwordenabled param
 func (p *GetUploadParamsForTemplateParams) ResetPasswordenabled() {
-	if p.p != nil && p.p["passwordenabled"] != nil {
-		delete(p.p, "passwordenabled")
-	}
+	p.passwordenabled = optBool{}
 }
 
+// GetPasswordenabled returns the passwordenabled param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetPasswordenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["passwordenabled"].(bool)
-	return value, ok
+	return p.passwordenabled.v, p.passwordenabled.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *GetUploadParamsForTemplateParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *GetUploadParamsForTemplateParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRequireshvm sets the requireshvm param.
 func (p *GetUploadParamsForTemplateParams) SetRequireshvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["requireshvm"] = v
+	p.requireshvm = optBool{v: v, ok: true}
 }
 
+// ResetRequireshvm unsets the requireshvm param
 func (p *GetUploadParamsForTemplateParams) ResetRequireshvm() {
-	if p.p != nil && p.p["requireshvm"] != nil {
-		delete(p.p, "requireshvm")
-	}
+	p.requireshvm = optBool{}
 }
 
+// GetRequireshvm returns the requireshvm param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetRequireshvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["requireshvm"].(bool)
-	return value, ok
+	return p.requireshvm.v, p.requireshvm.ok
 }
 
+// SetSshkeyenabled sets the sshkeyenabled param.
 func (p *GetUploadParamsForTemplateParams) SetSshkeyenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sshkeyenabled"] = v
+	p.sshkeyenabled = optBool{v: v, ok: true}
 }
 
+// ResetSshkeyenabled unsets the sshkeyenabled param
 func (p *GetUploadParamsForTemplateParams) ResetSshkeyenabled() {
-	if p.p != nil && p.p["sshkeyenabled"] != nil {
-		delete(p.p, "sshkeyenabled")
-	}
+	p.sshkeyenabled = optBool{}
 }
 
+// GetSshkeyenabled returns the sshkeyenabled param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetSshkeyenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sshkeyenabled"].(bool)
-	return value, ok
+	return p.sshkeyenabled.v, p.sshkeyenabled.ok
 }
 
+// SetTemplatetag sets the templatetag param.
 func (p *GetUploadParamsForTemplateParams) SetTemplatetag(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templatetag"] = v
+	p.templatetag = optString{v: v, ok: true}
 }
 
+// ResetTemplatetag unsets the templatetag param
 func (p *GetUploadParamsForTemplateParams) ResetTemplatetag() {
-	if p.p != nil && p.p["templatetag"] != nil {
-		delete(p.p, "templatetag")
-	}
+	p.templatetag = optString{}
 }
 
+// GetTemplatetag returns the templatetag param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetTemplatetag() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templatetag"].(string)
-	return value, ok
+	return p.templatetag.v, p.templatetag.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *GetUploadParamsForTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *GetUploadParamsForTemplateParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *GetUploadParamsForTemplateParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetUploadParamsForTemplateParams) Clone() *GetUploadParamsForTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetUploadParamsForTemplateParams) Equal(o *GetUploadParamsForTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.bits == o.bits &&
+		p.checksum == o.checksum &&
+		p.deployasis == o.deployasis &&
+		p.details.equal(o.details) &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.format == o.format &&
+		p.hypervisor == o.hypervisor &&
+		p.isdynamicallyscalable == o.isdynamicallyscalable &&
+		p.isextractable == o.isextractable &&
+		p.isfeatured == o.isfeatured &&
+		p.ispublic == o.ispublic &&
+		p.isrouting == o.isrouting &&
+		p.name == o.name &&
+		p.ostypeid == o.ostypeid &&
+		p.passwordenabled == o.passwordenabled &&
+		p.projectid == o.projectid &&
+		p.requireshvm == o.requireshvm &&
+		p.sshkeyenabled == o.sshkeyenabled &&
+		p.templatetag == o.templatetag &&
+		p.zoneid == o.zoneid
+}
+
+// serializedGetUploadParamsForTemplateParams is used to (un)marshal GetUploadParamsForTemplateParams using the API param names
+type serializedGetUploadParamsForTemplateParams struct {
+	Account               *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Bits                  *int               `json:"bits,omitempty" yaml:"bits,omitempty"`
+	Checksum              *string            `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Deployasis            *bool              `json:"deployasis,omitempty" yaml:"deployasis,omitempty"`
+	Details               *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Format                *string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Hypervisor            *string            `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Isdynamicallyscalable *bool              `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isextractable         *bool              `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured            *bool              `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic              *bool              `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Isrouting             *bool              `json:"isrouting,omitempty" yaml:"isrouting,omitempty"`
+	Name                  *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string            `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool              `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Projectid             *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Requireshvm           *bool              `json:"requireshvm,omitempty" yaml:"requireshvm,omitempty"`
+	Sshkeyenabled         *bool              `json:"sshkeyenabled,omitempty" yaml:"sshkeyenabled,omitempty"`
+	Templatetag           *string            `json:"templatetag,omitempty" yaml:"templatetag,omitempty"`
+	Zoneid                *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *GetUploadParamsForTemplateParams) toSerialized() *serializedGetUploadParamsForTemplateParams {
+	s := &serializedGetUploadParamsForTemplateParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.bits.ok {
+		s.Bits = &p.bits.v
+	}
+	if p.checksum.ok {
+		s.Checksum = &p.checksum.v
+	}
+	if p.deployasis.ok {
+		s.Deployasis = &p.deployasis.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.format.ok {
+		s.Format = &p.format.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.isdynamicallyscalable.ok {
+		s.Isdynamicallyscalable = &p.isdynamicallyscalable.v
+	}
+	if p.isextractable.ok {
+		s.Isextractable = &p.isextractable.v
+	}
+	if p.isfeatured.ok {
+		s.Isfeatured = &p.isfeatured.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.isrouting.ok {
+		s.Isrouting = &p.isrouting.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.passwordenabled.ok {
+		s.Passwordenabled = &p.passwordenabled.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.requireshvm.ok {
+		s.Requireshvm = &p.requireshvm.v
+	}
+	if p.sshkeyenabled.ok {
+		s.Sshkeyenabled = &p.sshkeyenabled.v
+	}
+	if p.templatetag.ok {
+		s.Templatetag = &p.templatetag.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *GetUploadParamsForTemplateParams) fromSerialized(s *serializedGetUploadParamsForTemplateParams) {
+	*p = GetUploadParamsForTemplateParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Bits != nil {
+		p.SetBits(*s.Bits)
+	}
+	if s.Checksum != nil {
+		p.SetChecksum(*s.Checksum)
+	}
+	if s.Deployasis != nil {
+		p.SetDeployasis(*s.Deployasis)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Format != nil {
+		p.SetFormat(*s.Format)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Isdynamicallyscalable != nil {
+		p.SetIsdynamicallyscalable(*s.Isdynamicallyscalable)
+	}
+	if s.Isextractable != nil {
+		p.SetIsextractable(*s.Isextractable)
+	}
+	if s.Isfeatured != nil {
+		p.SetIsfeatured(*s.Isfeatured)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Isrouting != nil {
+		p.SetIsrouting(*s.Isrouting)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+	if s.Passwordenabled != nil {
+		p.SetPasswordenabled(*s.Passwordenabled)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Requireshvm != nil {
+		p.SetRequireshvm(*s.Requireshvm)
+	}
+	if s.Sshkeyenabled != nil {
+		p.SetSshkeyenabled(*s.Sshkeyenabled)
+	}
+	if s.Templatetag != nil {
+		p.SetTemplatetag(*s.Templatetag)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetUploadParamsForTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetUploadParamsForTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetUploadParamsForTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetUploadParamsForTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetUploadParamsForTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetUploadParamsForTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetUploadParamsForTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, zoneid string) *GetUploadParamsForTemplateParams {
 	p := &GetUploadParamsForTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["format"] = format
-	p.p["hypervisor"] = hypervisor
-	p.p["name"] = name
-	p.p["zoneid"] = zoneid
+	p.SetDisplaytext(displaytext)
+	p.SetFormat(format)
+	p.SetHypervisor(hypervisor)
+	p.SetName(name)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // upload an existing template into the CloudStack cloud.
-func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForTemplate", p.toURLValues())
+//
+// Required params: displaytext, format, hypervisor, name, zoneid.
+func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams, opts ...CallOption) (*GetUploadParamsForTemplateResponse, error) {
+	resp, err := s.cs.newRequest("getUploadParamsForTemplate", s.cs.encodeParams("getUploadParamsForTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1830,56 +2581,132 @@ type GetUploadParamsForTemplateResponse struct {
 }
 
 type ListTemplatePermissionsParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListTemplatePermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ListTemplatePermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListTemplatePermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListTemplatePermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListTemplatePermissionsParams(u url.Values) (*ListTemplatePermissionsParams, error) {
+	p := &ListTemplatePermissionsParams{}
+	if err := checkParamNames("listTemplatePermissions", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ListTemplatePermissionsParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListTemplatePermissionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListTemplatePermissionsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListTemplatePermissionsParams) Clone() *ListTemplatePermissionsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListTemplatePermissionsParams) Equal(o *ListTemplatePermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedListTemplatePermissionsParams is used to (un)marshal ListTemplatePermissionsParams using the API param names
+type serializedListTemplatePermissionsParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ListTemplatePermissionsParams) toSerialized() *serializedListTemplatePermissionsParams {
+	s := &serializedListTemplatePermissionsParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ListTemplatePermissionsParams) fromSerialized(s *serializedListTemplatePermissionsParams) {
+	*p = ListTemplatePermissionsParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListTemplatePermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListTemplatePermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListTemplatePermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListTemplatePermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListTemplatePermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListTemplatePermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListTemplatePermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams {
 	p := &ListTemplatePermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
 	p := &ListTemplatePermissionsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1908,8 +2735,10 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 }
 
 // List template visibility and all accounts that have permissions to view this template.
-func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listTemplatePermissions", p.toURLValues())
+//
+// Required params: id.
+func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams, opts ...CallOption) (*ListTemplatePermissionsResponse, error) {
+	resp, err := s.cs.newRequest("listTemplatePermissions", s.cs.encodeParams("listTemplatePermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1938,624 +2767,865 @@ type TemplatePermission struct {
 }
 
 type ListTemplatesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListTemplatesParams) toURLValues() url.Values {
+	account          optString
+	details          optStrings
+	domainid         optString
+	hypervisor       optString
+	id               optString
+	ids              optStrings
+	imagestoreid     optString
+	isrecursive      optBool
+	isvnf            optBool
+	keyword          optString
+	listall          optBool
+	name             optString
+	page             optInt
+	pagesize         optInt
+	parenttemplateid optString
+	projectid        optString
+	showicon         optBool
+	showremoved      optBool
+	showunique       optBool
+	storageid        optString
+	tags             optStringMap
+	templatefilter   optString
+	templatetype     optString
+	zoneid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListTemplatesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["imagestoreid"]; found {
-		u.Set("imagestoreid", v.(string))
+	if p.imagestoreid.ok {
+		u.Set("imagestoreid", p.imagestoreid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["isvnf"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isvnf", vv)
+	if p.isvnf.ok {
+		u.Set("isvnf", strconv.FormatBool(p.isvnf.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["parenttemplateid"]; found {
-		u.Set("parenttemplateid", v.(string))
+	if p.parenttemplateid.ok {
+		u.Set("parenttemplateid", p.parenttemplateid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["showremoved"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showremoved", vv)
+	if p.showremoved.ok {
+		u.Set("showremoved", strconv.FormatBool(p.showremoved.v))
 	}
-	if v, found := p.p["showunique"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showunique", vv)
+	if p.showunique.ok {
+		u.Set("showunique", strconv.FormatBool(p.showunique.v))
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["templatefilter"]; found {
-		u.Set("templatefilter", v.(string))
+	if p.templatefilter.ok {
+		u.Set("templatefilter", p.templatefilter.v)
+	}
+	if p.templatetype.ok {
+		u.Set("templatetype", p.templatetype.v)
+	}
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
+	}
+	return u
+}
+
+// ParseListTemplatesParams parses url.Values, for example taken from a raw API request,
+// into a new ListTemplatesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListTemplatesParams(u url.Values) (*ListTemplatesParams, error) {
+	p := &ListTemplatesParams{}
+	if err := checkParamNames("listTemplates", u, "account", "details", "domainid", "hypervisor", "id", "ids", "imagestoreid", "isrecursive", "isvnf", "keyword", "listall", "name", "page", "pagesize", "parenttemplateid", "projectid", "showicon", "showremoved", "showunique", "storageid", "tags[]", "templatefilter", "templatetype", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["imagestoreid"]; found {
+		p.SetImagestoreid(u.Get("imagestoreid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["isvnf"]; found {
+		v, err := strconv.ParseBool(u.Get("isvnf"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isvnf: %v", err)
+		}
+		p.SetIsvnf(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["parenttemplateid"]; found {
+		p.SetParenttemplateid(u.Get("parenttemplateid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["showremoved"]; found {
+		v, err := strconv.ParseBool(u.Get("showremoved"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showremoved: %v", err)
+		}
+		p.SetShowremoved(v)
+	}
+	if _, found := u["showunique"]; found {
+		v, err := strconv.ParseBool(u.Get("showunique"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showunique: %v", err)
+		}
+		p.SetShowunique(v)
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
 	}
-	if v, found := p.p["templatetype"]; found {
-		u.Set("templatetype", v.(string))
+	if _, found := u["templatefilter"]; found {
+		p.SetTemplatefilter(u.Get("templatefilter"))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if _, found := u["templatetype"]; found {
+		p.SetTemplatetype(u.Get("templatetype"))
 	}
-	return u
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
 func (p *ListTemplatesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListTemplatesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListTemplatesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDetails sets the details param.
 func (p *ListTemplatesParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStrings{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ListTemplatesParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListTemplatesParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListTemplatesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListTemplatesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListTemplatesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListTemplatesParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListTemplatesParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListTemplatesParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListTemplatesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListTemplatesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListTemplatesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListTemplatesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListTemplatesParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListTemplatesParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetImagestoreid sets the imagestoreid param.
 func (p *ListTemplatesParams) SetImagestoreid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreid"] = v
+	p.imagestoreid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreid unsets the imagestoreid param
 func (p *ListTemplatesParams) ResetImagestoreid() {
-	if p.p != nil && p.p["imagestoreid"] != nil {
-		delete(p.p, "imagestoreid")
-	}
+	p.imagestoreid = optString{}
 }
 
+// GetImagestoreid returns the imagestoreid param and if it is set
 func (p *ListTemplatesParams) GetImagestoreid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreid"].(string)
-	return value, ok
+	return p.imagestoreid.v, p.imagestoreid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListTemplatesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListTemplatesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListTemplatesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetIsvnf sets the isvnf param.
 func (p *ListTemplatesParams) SetIsvnf(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isvnf"] = v
+	p.isvnf = optBool{v: v, ok: true}
 }
 
+// ResetIsvnf unsets the isvnf param
 func (p *ListTemplatesParams) ResetIsvnf() {
-	if p.p != nil && p.p["isvnf"] != nil {
-		delete(p.p, "isvnf")
-	}
+	p.isvnf = optBool{}
 }
 
+// GetIsvnf returns the isvnf param and if it is set
 func (p *ListTemplatesParams) GetIsvnf() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isvnf"].(bool)
-	return value, ok
+	return p.isvnf.v, p.isvnf.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListTemplatesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListTemplatesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListTemplatesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListTemplatesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListTemplatesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListTemplatesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListTemplatesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListTemplatesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListTemplatesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListTemplatesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListTemplatesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListTemplatesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListTemplatesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListTemplatesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListTemplatesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetParenttemplateid sets the parenttemplateid param.
 func (p *ListTemplatesParams) SetParenttemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["parenttemplateid"] = v
+	p.parenttemplateid = optString{v: v, ok: true}
 }
 
+// ResetParenttemplateid unsets the parenttemplateid param
 func (p *ListTemplatesParams) ResetParenttemplateid() {
-	if p.p != nil && p.p["parenttemplateid"] != nil {
-		delete(p.p, "parenttemplateid")
-	}
+	p.parenttemplateid = optString{}
 }
 
+// GetParenttemplateid returns the parenttemplateid param and if it is set
 func (p *ListTemplatesParams) GetParenttemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["parenttemplateid"].(string)
-	return value, ok
+	return p.parenttemplateid.v, p.parenttemplateid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListTemplatesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListTemplatesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListTemplatesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListTemplatesParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListTemplatesParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListTemplatesParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetShowremoved sets the showremoved param.
 func (p *ListTemplatesParams) SetShowremoved(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showremoved"] = v
+	p.showremoved = optBool{v: v, ok: true}
 }
 
+// ResetShowremoved unsets the showremoved param
 func (p *ListTemplatesParams) ResetShowremoved() {
-	if p.p != nil && p.p["showremoved"] != nil {
-		delete(p.p, "showremoved")
-	}
+	p.showremoved = optBool{}
 }
 
+// GetShowremoved returns the showremoved param and if it is set
 func (p *ListTemplatesParams) GetShowremoved() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showremoved"].(bool)
-	return value, ok
+	return p.showremoved.v, p.showremoved.ok
 }
 
+// SetShowunique sets the showunique param.
 func (p *ListTemplatesParams) SetShowunique(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showunique"] = v
+	p.showunique = optBool{v: v, ok: true}
 }
 
+// ResetShowunique unsets the showunique param
 func (p *ListTemplatesParams) ResetShowunique() {
-	if p.p != nil && p.p["showunique"] != nil {
-		delete(p.p, "showunique")
-	}
+	p.showunique = optBool{}
 }
 
+// GetShowunique returns the showunique param and if it is set
 func (p *ListTemplatesParams) GetShowunique() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showunique"].(bool)
-	return value, ok
+	return p.showunique.v, p.showunique.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListTemplatesParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListTemplatesParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListTemplatesParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListTemplatesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListTemplatesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListTemplatesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetTemplatefilter sets the templatefilter param. This param is required.
 func (p *ListTemplatesParams) SetTemplatefilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templatefilter"] = v
+	p.templatefilter = optString{v: v, ok: true}
 }
 
+// ResetTemplatefilter unsets the templatefilter param
 func (p *ListTemplatesParams) ResetTemplatefilter() {
-	if p.p != nil && p.p["templatefilter"] != nil {
-		delete(p.p, "templatefilter")
-	}
+	p.templatefilter = optString{}
 }
 
+// GetTemplatefilter returns the templatefilter param and if it is set
 func (p *ListTemplatesParams) GetTemplatefilter() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templatefilter"].(string)
-	return value, ok
+	return p.templatefilter.v, p.templatefilter.ok
 }
 
+// SetTemplatetype sets the templatetype param.
 func (p *ListTemplatesParams) SetTemplatetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templatetype"] = v
+	p.templatetype = optString{v: v, ok: true}
 }
 
+// ResetTemplatetype unsets the templatetype param
 func (p *ListTemplatesParams) ResetTemplatetype() {
-	if p.p != nil && p.p["templatetype"] != nil {
-		delete(p.p, "templatetype")
-	}
+	p.templatetype = optString{}
 }
 
+// GetTemplatetype returns the templatetype param and if it is set
 func (p *ListTemplatesParams) GetTemplatetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templatetype"].(string)
-	return value, ok
+	return p.templatetype.v, p.templatetype.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListTemplatesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListTemplatesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListTemplatesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListTemplatesParams) Clone() *ListTemplatesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListTemplatesParams) Equal(o *ListTemplatesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.details.equal(o.details) &&
+		p.domainid == o.domainid &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.imagestoreid == o.imagestoreid &&
+		p.isrecursive == o.isrecursive &&
+		p.isvnf == o.isvnf &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.parenttemplateid == o.parenttemplateid &&
+		p.projectid == o.projectid &&
+		p.showicon == o.showicon &&
+		p.showremoved == o.showremoved &&
+		p.showunique == o.showunique &&
+		p.storageid == o.storageid &&
+		p.tags.equal(o.tags) &&
+		p.templatefilter == o.templatefilter &&
+		p.templatetype == o.templatetype &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListTemplatesParams is used to (un)marshal ListTemplatesParams using the API param names
+type serializedListTemplatesParams struct {
+	Account          *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Details          *[]string          `json:"details,omitempty" yaml:"details,omitempty"`
+	Domainid         *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hypervisor       *string            `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id               *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids              *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Imagestoreid     *string            `json:"imagestoreid,omitempty" yaml:"imagestoreid,omitempty"`
+	Isrecursive      *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Isvnf            *bool              `json:"isvnf,omitempty" yaml:"isvnf,omitempty"`
+	Keyword          *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Parenttemplateid *string            `json:"parenttemplateid,omitempty" yaml:"parenttemplateid,omitempty"`
+	Projectid        *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Showicon         *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	Showremoved      *bool              `json:"showremoved,omitempty" yaml:"showremoved,omitempty"`
+	Showunique       *bool              `json:"showunique,omitempty" yaml:"showunique,omitempty"`
+	Storageid        *string            `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags             *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Templatefilter   *string            `json:"templatefilter,omitempty" yaml:"templatefilter,omitempty"`
+	Templatetype     *string            `json:"templatetype,omitempty" yaml:"templatetype,omitempty"`
+	Zoneid           *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListTemplatesParams) toSerialized() *serializedListTemplatesParams {
+	s := &serializedListTemplatesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.imagestoreid.ok {
+		s.Imagestoreid = &p.imagestoreid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.isvnf.ok {
+		s.Isvnf = &p.isvnf.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.parenttemplateid.ok {
+		s.Parenttemplateid = &p.parenttemplateid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.showremoved.ok {
+		s.Showremoved = &p.showremoved.v
+	}
+	if p.showunique.ok {
+		s.Showunique = &p.showunique.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.templatefilter.ok {
+		s.Templatefilter = &p.templatefilter.v
+	}
+	if p.templatetype.ok {
+		s.Templatetype = &p.templatetype.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListTemplatesParams) fromSerialized(s *serializedListTemplatesParams) {
+	*p = ListTemplatesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Imagestoreid != nil {
+		p.SetImagestoreid(*s.Imagestoreid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Isvnf != nil {
+		p.SetIsvnf(*s.Isvnf)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Parenttemplateid != nil {
+		p.SetParenttemplateid(*s.Parenttemplateid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.Showremoved != nil {
+		p.SetShowremoved(*s.Showremoved)
+	}
+	if s.Showunique != nil {
+		p.SetShowunique(*s.Showunique)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Templatefilter != nil {
+		p.SetTemplatefilter(*s.Templatefilter)
+	}
+	if s.Templatetype != nil {
+		p.SetTemplatetype(*s.Templatetype)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListTemplatesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListTemplatesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListTemplatesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListTemplatesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListTemplatesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListTemplatesParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListTemplatesParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewListTemplatesParams(templatefilter string) *ListTemplatesParams {
 	p := &ListTemplatesParams{}
-	p.p = make(map[string]interface{})
-	p.p["templatefilter"] = templatefilter
+	p.SetTemplatefilter(templatefilter)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTemplatesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
-	p.p["templatefilter"] = templatefilter
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetTemplatefilter(templatefilter)
+	p.SetZoneid(zoneid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2603,10 +3673,9 @@ func (s *TemplateService) GetTemplateByName(name string, templatefilter string,
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
 	p := &ListTemplatesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
-	p.p["templatefilter"] = templatefilter
+	p.SetId(id)
+	p.SetTemplatefilter(templatefilter)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2635,8 +3704,10 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 }
 
 // List all public, private, and privileged templates.
-func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
-	resp, err := s.cs.newRequest("listTemplates", p.toURLValues())
+//
+// Required params: templatefilter.
+func (s *TemplateService) ListTemplates(p *ListTemplatesParams, opts ...CallOption) (*ListTemplatesResponse, error) {
+	resp, err := s.cs.newRequest("listTemplates", s.cs.encodeParams("listTemplates", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2739,102 +3810,193 @@ func (r *Template) UnmarshalJSON(b []byte) error {
 }
 
 type PrepareTemplateParams struct {
-	p map[string]interface{}
+	storageid  optString
+	templateid optString
+	zoneid     optString
 }
 
-func (p *PrepareTemplateParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *PrepareTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *PrepareTemplateParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParsePrepareTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new PrepareTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParsePrepareTemplateParams(u url.Values) (*PrepareTemplateParams, error) {
+	p := &PrepareTemplateParams{}
+	if err := checkParamNames("prepareTemplate", u, "storageid", "templateid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
 	}
-	p.p["storageid"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetStorageid sets the storageid param.
+func (p *PrepareTemplateParams) SetStorageid(v string) {
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *PrepareTemplateParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *PrepareTemplateParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTemplateid sets the templateid param. This param is required.
 func (p *PrepareTemplateParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *PrepareTemplateParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *PrepareTemplateParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *PrepareTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *PrepareTemplateParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *PrepareTemplateParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *PrepareTemplateParams) Clone() *PrepareTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *PrepareTemplateParams) Equal(o *PrepareTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return p.storageid == o.storageid &&
+		p.templateid == o.templateid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedPrepareTemplateParams is used to (un)marshal PrepareTemplateParams using the API param names
+type serializedPrepareTemplateParams struct {
+	Storageid  *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Templateid *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Zoneid     *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *PrepareTemplateParams) toSerialized() *serializedPrepareTemplateParams {
+	s := &serializedPrepareTemplateParams{}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *PrepareTemplateParams) fromSerialized(s *serializedPrepareTemplateParams) {
+	*p = PrepareTemplateParams{}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p PrepareTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *PrepareTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedPrepareTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p PrepareTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *PrepareTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedPrepareTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new PrepareTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams {
 	p := &PrepareTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
+	p.SetTemplateid(templateid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// load template into primary storage
-func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
-	resp, err := s.cs.newRequest("prepareTemplate", p.toURLValues())
+// load template into primary storage.
+//
+// Required params: templateid, zoneid.
+func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams, opts ...CallOption) (*PrepareTemplateResponse, error) {
+	resp, err := s.cs.newRequest("prepareTemplate", s.cs.encodeParams("prepareTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2932,672 +4094,937 @@ func (r *PrepareTemplateResponse) UnmarshalJSON(b []byte) error {
 }
 
 type RegisterTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *RegisterTemplateParams) toURLValues() url.Values {
+	account               optString
+	bits                  optInt
+	checksum              optString
+	deployasis            optBool
+	details               optStringMap
+	directdownload        optBool
+	displaytext           optString
+	domainid              optString
+	format                optString
+	hypervisor            optString
+	isdynamicallyscalable optBool
+	isextractable         optBool
+	isfeatured            optBool
+	ispublic              optBool
+	isrouting             optBool
+	name                  optString
+	ostypeid              optString
+	passwordenabled       optBool
+	projectid             optString
+	requireshvm           optBool
+	sshkeyenabled         optBool
+	templatetag           optString
+	templatetype          optString
+	url                   optString
+	zoneid                optString
+	zoneids               optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RegisterTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["bits"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("bits", vv)
+	if p.bits.ok {
+		u.Set("bits", strconv.Itoa(p.bits.v))
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	if p.checksum.ok {
+		u.Set("checksum", p.checksum.v)
 	}
-	if v, found := p.p["deployasis"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("deployasis", vv)
+	if p.deployasis.ok {
+		u.Set("deployasis", strconv.FormatBool(p.deployasis.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for _, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[0].%s", k), m[k])
 		}
 	}
-	if v, found := p.p["directdownload"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("directdownload", vv)
+	if p.directdownload.ok {
+		u.Set("directdownload", strconv.FormatBool(p.directdownload.v))
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if p.format.ok {
+		u.Set("format", p.format.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
+	if p.isdynamicallyscalable.ok {
+		u.Set("isdynamicallyscalable", strconv.FormatBool(p.isdynamicallyscalable.v))
 	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
+	if p.isextractable.ok {
+		u.Set("isextractable", strconv.FormatBool(p.isextractable.v))
 	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
+	if p.isfeatured.ok {
+		u.Set("isfeatured", strconv.FormatBool(p.isfeatured.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["isrouting"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrouting", vv)
+	if p.isrouting.ok {
+		u.Set("isrouting", strconv.FormatBool(p.isrouting.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
+	if p.passwordenabled.ok {
+		u.Set("passwordenabled", strconv.FormatBool(p.passwordenabled.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["requireshvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("requireshvm", vv)
+	if p.requireshvm.ok {
+		u.Set("requireshvm", strconv.FormatBool(p.requireshvm.v))
 	}
-	if v, found := p.p["sshkeyenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sshkeyenabled", vv)
+	if p.sshkeyenabled.ok {
+		u.Set("sshkeyenabled", strconv.FormatBool(p.sshkeyenabled.v))
 	}
-	if v, found := p.p["templatetag"]; found {
-		u.Set("templatetag", v.(string))
+	if p.templatetag.ok {
+		u.Set("templatetag", p.templatetag.v)
 	}
-	if v, found := p.p["templatetype"]; found {
-		u.Set("templatetype", v.(string))
+	if p.templatetype.ok {
+		u.Set("templatetype", p.templatetype.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
-	if v, found := p.p["zoneids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("zoneids", vv)
+	if p.zoneids.ok {
+		u.Set("zoneids", strings.Join(p.zoneids.v, ","))
 	}
 	return u
 }
 
-func (p *RegisterTemplateParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRegisterTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new RegisterTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRegisterTemplateParams(u url.Values) (*RegisterTemplateParams, error) {
+	p := &RegisterTemplateParams{}
+	if err := checkParamNames("registerTemplate", u, "account", "bits", "checksum", "deployasis", "details[]", "directdownload", "displaytext", "domainid", "format", "hypervisor", "isdynamicallyscalable", "isextractable", "isfeatured", "ispublic", "isrouting", "name", "ostypeid", "passwordenabled", "projectid", "requireshvm", "sshkeyenabled", "templatetag", "templatetype", "url", "zoneid", "zoneids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["bits"]; found {
+		v, err := strconv.Atoi(u.Get("bits"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bits: %v", err)
+		}
+		p.SetBits(v)
+	}
+	if _, found := u["checksum"]; found {
+		p.SetChecksum(u.Get("checksum"))
+	}
+	if _, found := u["deployasis"]; found {
+		v, err := strconv.ParseBool(u.Get("deployasis"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param deployasis: %v", err)
+		}
+		p.SetDeployasis(v)
+	}
+	if m, err := parseIndexedMap(u, "details", true); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["directdownload"]; found {
+		v, err := strconv.ParseBool(u.Get("directdownload"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param directdownload: %v", err)
+		}
+		p.SetDirectdownload(v)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["format"]; found {
+		p.SetFormat(u.Get("format"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["isdynamicallyscalable"]; found {
+		v, err := strconv.ParseBool(u.Get("isdynamicallyscalable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdynamicallyscalable: %v", err)
+		}
+		p.SetIsdynamicallyscalable(v)
+	}
+	if _, found := u["isextractable"]; found {
+		v, err := strconv.ParseBool(u.Get("isextractable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isextractable: %v", err)
+		}
+		p.SetIsextractable(v)
+	}
+	if _, found := u["isfeatured"]; found {
+		v, err := strconv.ParseBool(u.Get("isfeatured"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isfeatured: %v", err)
+		}
+		p.SetIsfeatured(v)
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["isrouting"]; found {
+		v, err := strconv.ParseBool(u.Get("isrouting"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrouting: %v", err)
+		}
+		p.SetIsrouting(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
 	}
-	p.p["account"] = v
+	if _, found := u["passwordenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("passwordenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param passwordenabled: %v", err)
+		}
+		p.SetPasswordenabled(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["requireshvm"]; found {
+		v, err := strconv.ParseBool(u.Get("requireshvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param requireshvm: %v", err)
+		}
+		p.SetRequireshvm(v)
+	}
+	if _, found := u["sshkeyenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("sshkeyenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sshkeyenabled: %v", err)
+		}
+		p.SetSshkeyenabled(v)
+	}
+	if _, found := u["templatetag"]; found {
+		p.SetTemplatetag(u.Get("templatetag"))
+	}
+	if _, found := u["templatetype"]; found {
+		p.SetTemplatetype(u.Get("templatetype"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	if _, found := u["zoneids"]; found {
+		p.SetZoneids(strings.Split(u.Get("zoneids"), ","))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *RegisterTemplateParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *RegisterTemplateParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *RegisterTemplateParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetBits sets the bits param.
 func (p *RegisterTemplateParams) SetBits(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bits"] = v
+	p.bits = optInt{v: v, ok: true}
 }
 
+// ResetBits unsets the bits param
 func (p *RegisterTemplateParams) ResetBits() {
-	if p.p != nil && p.p["bits"] != nil {
-		delete(p.p, "bits")
-	}
+	p.bits = optInt{}
 }
 
+// GetBits returns the bits param and if it is set
 func (p *RegisterTemplateParams) GetBits() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bits"].(int)
-	return value, ok
+	return p.bits.v, p.bits.ok
 }
 
+// SetChecksum sets the checksum param.
 func (p *RegisterTemplateParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["checksum"] = v
+	p.checksum = optString{v: v, ok: true}
 }
 
+// ResetChecksum unsets the checksum param
 func (p *RegisterTemplateParams) ResetChecksum() {
-	if p.p != nil && p.p["checksum"] != nil {
-		delete(p.p, "checksum")
-	}
+	p.checksum = optString{}
 }
 
+// GetChecksum returns the checksum param and if it is set
 func (p *RegisterTemplateParams) GetChecksum() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["checksum"].(string)
-	return value, ok
+	return p.checksum.v, p.checksum.ok
 }
 
+// SetDeployasis sets the deployasis param.
 func (p *RegisterTemplateParams) SetDeployasis(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deployasis"] = v
+	p.deployasis = optBool{v: v, ok: true}
 }
 
+// ResetDeployasis unsets the deployasis param
 func (p *RegisterTemplateParams) ResetDeployasis() {
-	if p.p != nil && p.p["deployasis"] != nil {
-		delete(p.p, "deployasis")
-	}
+	p.deployasis = optBool{}
 }
 
+// GetDeployasis returns the deployasis param and if it is set
 func (p *RegisterTemplateParams) GetDeployasis() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["deployasis"].(bool)
-	return value, ok
+	return p.deployasis.v, p.deployasis.ok
 }
 
+// SetDetails sets the details param.
 func (p *RegisterTemplateParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *RegisterTemplateParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *RegisterTemplateParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDirectdownload sets the directdownload param.
 func (p *RegisterTemplateParams) SetDirectdownload(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["directdownload"] = v
+	p.directdownload = optBool{v: v, ok: true}
 }
 
+// ResetDirectdownload unsets the directdownload param
 func (p *RegisterTemplateParams) ResetDirectdownload() {
-	if p.p != nil && p.p["directdownload"] != nil {
-		delete(p.p, "directdownload")
-	}
+	p.directdownload = optBool{}
 }
 
+// GetDirectdownload returns the directdownload param and if it is set
 func (p *RegisterTemplateParams) GetDirectdownload() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["directdownload"].(bool)
-	return value, ok
+	return p.directdownload.v, p.directdownload.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *RegisterTemplateParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *RegisterTemplateParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *RegisterTemplateParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *RegisterTemplateParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *RegisterTemplateParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *RegisterTemplateParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFormat sets the format param. This param is required.
 func (p *RegisterTemplateParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
+	p.format = optString{v: v, ok: true}
 }
 
+// ResetFormat unsets the format param
 func (p *RegisterTemplateParams) ResetFormat() {
-	if p.p != nil && p.p["format"] != nil {
-		delete(p.p, "format")
-	}
+	p.format = optString{}
 }
 
+// GetFormat returns the format param and if it is set
 func (p *RegisterTemplateParams) GetFormat() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["format"].(string)
-	return value, ok
+	return p.format.v, p.format.ok
 }
 
+// SetHypervisor sets the hypervisor param. This param is required.
 func (p *RegisterTemplateParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *RegisterTemplateParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *RegisterTemplateParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetIsdynamicallyscalable sets the isdynamicallyscalable param.
 func (p *RegisterTemplateParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
+	p.isdynamicallyscalable = optBool{v: v, ok: true}
 }
 
+// ResetIsdynamicallyscalable unsets the isdynamicallyscalable param
 func (p *RegisterTemplateParams) ResetIsdynamicallyscalable() {
-	if p.p != nil && p.p["isdynamicallyscalable"] != nil {
-		delete(p.p, "isdynamicallyscalable")
-	}
+	p.isdynamicallyscalable = optBool{}
 }
 
+// GetIsdynamicallyscalable returns the isdynamicallyscalable param and if it is set
 func (p *RegisterTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdynamicallyscalable"].(bool)
-	return value, ok
+	return p.isdynamicallyscalable.v, p.isdynamicallyscalable.ok
 }
 
+// SetIsextractable sets the isextractable param.
 func (p *RegisterTemplateParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isextractable"] = v
+	p.isextractable = optBool{v: v, ok: true}
 }
 
+// ResetIsextractable unsets the isextractable param
 func (p *RegisterTemplateParams) ResetIsextractable() {
-	if p.p != nil && p.p["isextractable"] != nil {
-		delete(p.p, "isextractable")
-	}
+	p.isextractable = optBool{}
 }
 
+// GetIsextractable returns the isextractable param and if it is set
 func (p *RegisterTemplateParams) GetIsextractable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isextractable"].(bool)
-	return value, ok
+	return p.isextractable.v, p.isextractable.ok
 }
 
+// SetIsfeatured sets the isfeatured param.
 func (p *RegisterTemplateParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
+	p.isfeatured = optBool{v: v, ok: true}
 }
 
+// ResetIsfeatured unsets the isfeatured param
 func (p *RegisterTemplateParams) ResetIsfeatured() {
-	if p.p != nil && p.p["isfeatured"] != nil {
-		delete(p.p, "isfeatured")
-	}
+	p.isfeatured = optBool{}
 }
 
+// GetIsfeatured returns the isfeatured param and if it is set
 func (p *RegisterTemplateParams) GetIsfeatured() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isfeatured"].(bool)
-	return value, ok
+	return p.isfeatured.v, p.isfeatured.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *RegisterTemplateParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *RegisterTemplateParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *RegisterTemplateParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetIsrouting sets the isrouting param.
 func (p *RegisterTemplateParams) SetIsrouting(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrouting"] = v
+	p.isrouting = optBool{v: v, ok: true}
 }
 
+// ResetIsrouting unsets the isrouting param
 func (p *RegisterTemplateParams) ResetIsrouting() {
-	if p.p != nil && p.p["isrouting"] != nil {
-		delete(p.p, "isrouting")
-	}
+	p.isrouting = optBool{}
 }
 
+// GetIsrouting returns the isrouting param and if it is set
 func (p *RegisterTemplateParams) GetIsrouting() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrouting"].(bool)
-	return value, ok
+	return p.isrouting.v, p.isrouting.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *RegisterTemplateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *RegisterTemplateParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *RegisterTemplateParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *RegisterTemplateParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *RegisterTemplateParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *RegisterTemplateParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetPasswordenabled sets the passwordenabled param.
 func (p *RegisterTemplateParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["passwordenabled"] = v
+	p.passwordenabled = optBool{v: v, ok: true}
 }
 
+// ResetPasswordenabled unsets the passwordenabled param
 func (p *RegisterTemplateParams) ResetPasswordenabled() {
-	if p.p != nil && p.p["passwordenabled"] != nil {
-		delete(p.p, "passwordenabled")
-	}
+	p.passwordenabled = optBool{}
 }
 
+// GetPasswordenabled returns the passwordenabled param and if it is set
 func (p *RegisterTemplateParams) GetPasswordenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["passwordenabled"].(bool)
-	return value, ok
+	return p.passwordenabled.v, p.passwordenabled.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *RegisterTemplateParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *RegisterTemplateParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *RegisterTemplateParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRequireshvm sets the requireshvm param.
 func (p *RegisterTemplateParams) SetRequireshvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["requireshvm"] = v
+	p.requireshvm = optBool{v: v, ok: true}
 }
 
+// ResetRequireshvm unsets the requireshvm param
 func (p *RegisterTemplateParams) ResetRequireshvm() {
-	if p.p != nil && p.p["requireshvm"] != nil {
-		delete(p.p, "requireshvm")
-	}
+	p.requireshvm = optBool{}
 }
 
+// GetRequireshvm returns the requireshvm param and if it is set
 func (p *RegisterTemplateParams) GetRequireshvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["requireshvm"].(bool)
-	return value, ok
+	return p.requireshvm.v, p.requireshvm.ok
 }
 
+// SetSshkeyenabled sets the sshkeyenabled param.
 func (p *RegisterTemplateParams) SetSshkeyenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sshkeyenabled"] = v
+	p.sshkeyenabled = optBool{v: v, ok: true}
 }
 
+// ResetSshkeyenabled unsets the sshkeyenabled param
 func (p *RegisterTemplateParams) ResetSshkeyenabled() {
-	if p.p != nil && p.p["sshkeyenabled"] != nil {
-		delete(p.p, "sshkeyenabled")
-	}
+	p.sshkeyenabled = optBool{}
 }
 
+// GetSshkeyenabled returns the sshkeyenabled param and if it is set
 func (p *RegisterTemplateParams) GetSshkeyenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sshkeyenabled"].(bool)
-	return value, ok
+	return p.sshkeyenabled.v, p.sshkeyenabled.ok
 }
 
+// SetTemplatetag sets the templatetag param.
 func (p *RegisterTemplateParams) SetTemplatetag(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templatetag"] = v
+	p.templatetag = optString{v: v, ok: true}
 }
 
+// ResetTemplatetag unsets the templatetag param
 func (p *RegisterTemplateParams) ResetTemplatetag() {
-	if p.p != nil && p.p["templatetag"] != nil {
-		delete(p.p, "templatetag")
-	}
+	p.templatetag = optString{}
 }
 
+// GetTemplatetag returns the templatetag param and if it is set
 func (p *RegisterTemplateParams) GetTemplatetag() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templatetag"].(string)
-	return value, ok
+	return p.templatetag.v, p.templatetag.ok
 }
 
+// SetTemplatetype sets the templatetype param.
 func (p *RegisterTemplateParams) SetTemplatetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templatetype"] = v
+	p.templatetype = optString{v: v, ok: true}
 }
 
+// ResetTemplatetype unsets the templatetype param
 func (p *RegisterTemplateParams) ResetTemplatetype() {
-	if p.p != nil && p.p["templatetype"] != nil {
-		delete(p.p, "templatetype")
-	}
+	p.templatetype = optString{}
 }
 
+// GetTemplatetype returns the templatetype param and if it is set
 func (p *RegisterTemplateParams) GetTemplatetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templatetype"].(string)
-	return value, ok
+	return p.templatetype.v, p.templatetype.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *RegisterTemplateParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *RegisterTemplateParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *RegisterTemplateParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *RegisterTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *RegisterTemplateParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *RegisterTemplateParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return p.zoneid.v, p.zoneid.ok
 }
 
+// SetZoneids sets the zoneids param.
 func (p *RegisterTemplateParams) SetZoneids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneids"] = v
+	p.zoneids = optStrings{v: v, ok: true}
 }
 
+// ResetZoneids unsets the zoneids param
 func (p *RegisterTemplateParams) ResetZoneids() {
-	if p.p != nil && p.p["zoneids"] != nil {
-		delete(p.p, "zoneids")
-	}
+	p.zoneids = optStrings{}
 }
 
+// GetZoneids returns the zoneids param and if it is set
 func (p *RegisterTemplateParams) GetZoneids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneids.v, p.zoneids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RegisterTemplateParams) Clone() *RegisterTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.zoneids = p.zoneids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RegisterTemplateParams) Equal(o *RegisterTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.bits == o.bits &&
+		p.checksum == o.checksum &&
+		p.deployasis == o.deployasis &&
+		p.details.equal(o.details) &&
+		p.directdownload == o.directdownload &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.format == o.format &&
+		p.hypervisor == o.hypervisor &&
+		p.isdynamicallyscalable == o.isdynamicallyscalable &&
+		p.isextractable == o.isextractable &&
+		p.isfeatured == o.isfeatured &&
+		p.ispublic == o.ispublic &&
+		p.isrouting == o.isrouting &&
+		p.name == o.name &&
+		p.ostypeid == o.ostypeid &&
+		p.passwordenabled == o.passwordenabled &&
+		p.projectid == o.projectid &&
+		p.requireshvm == o.requireshvm &&
+		p.sshkeyenabled == o.sshkeyenabled &&
+		p.templatetag == o.templatetag &&
+		p.templatetype == o.templatetype &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid &&
+		p.zoneids.equal(o.zoneids)
+}
+
+// serializedRegisterTemplateParams is used to (un)marshal RegisterTemplateParams using the API param names
+type serializedRegisterTemplateParams struct {
+	Account               *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Bits                  *int               `json:"bits,omitempty" yaml:"bits,omitempty"`
+	Checksum              *string            `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Deployasis            *bool              `json:"deployasis,omitempty" yaml:"deployasis,omitempty"`
+	Details               *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Directdownload        *bool              `json:"directdownload,omitempty" yaml:"directdownload,omitempty"`
+	Displaytext           *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Format                *string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Hypervisor            *string            `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Isdynamicallyscalable *bool              `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isextractable         *bool              `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured            *bool              `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic              *bool              `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Isrouting             *bool              `json:"isrouting,omitempty" yaml:"isrouting,omitempty"`
+	Name                  *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string            `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool              `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Projectid             *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Requireshvm           *bool              `json:"requireshvm,omitempty" yaml:"requireshvm,omitempty"`
+	Sshkeyenabled         *bool              `json:"sshkeyenabled,omitempty" yaml:"sshkeyenabled,omitempty"`
+	Templatetag           *string            `json:"templatetag,omitempty" yaml:"templatetag,omitempty"`
+	Templatetype          *string            `json:"templatetype,omitempty" yaml:"templatetype,omitempty"`
+	Url                   *string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid                *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+	Zoneids               *[]string          `json:"zoneids,omitempty" yaml:"zoneids,omitempty"`
+}
+
+func (p *RegisterTemplateParams) toSerialized() *serializedRegisterTemplateParams {
+	s := &serializedRegisterTemplateParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.bits.ok {
+		s.Bits = &p.bits.v
+	}
+	if p.checksum.ok {
+		s.Checksum = &p.checksum.v
+	}
+	if p.deployasis.ok {
+		s.Deployasis = &p.deployasis.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.directdownload.ok {
+		s.Directdownload = &p.directdownload.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.format.ok {
+		s.Format = &p.format.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.isdynamicallyscalable.ok {
+		s.Isdynamicallyscalable = &p.isdynamicallyscalable.v
+	}
+	if p.isextractable.ok {
+		s.Isextractable = &p.isextractable.v
+	}
+	if p.isfeatured.ok {
+		s.Isfeatured = &p.isfeatured.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.isrouting.ok {
+		s.Isrouting = &p.isrouting.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.passwordenabled.ok {
+		s.Passwordenabled = &p.passwordenabled.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.requireshvm.ok {
+		s.Requireshvm = &p.requireshvm.v
+	}
+	if p.sshkeyenabled.ok {
+		s.Sshkeyenabled = &p.sshkeyenabled.v
+	}
+	if p.templatetag.ok {
+		s.Templatetag = &p.templatetag.v
+	}
+	if p.templatetype.ok {
+		s.Templatetype = &p.templatetype.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	if p.zoneids.ok {
+		s.Zoneids = &p.zoneids.v
+	}
+	return s
+}
+
+func (p *RegisterTemplateParams) fromSerialized(s *serializedRegisterTemplateParams) {
+	*p = RegisterTemplateParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Bits != nil {
+		p.SetBits(*s.Bits)
+	}
+	if s.Checksum != nil {
+		p.SetChecksum(*s.Checksum)
+	}
+	if s.Deployasis != nil {
+		p.SetDeployasis(*s.Deployasis)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Directdownload != nil {
+		p.SetDirectdownload(*s.Directdownload)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Format != nil {
+		p.SetFormat(*s.Format)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Isdynamicallyscalable != nil {
+		p.SetIsdynamicallyscalable(*s.Isdynamicallyscalable)
+	}
+	if s.Isextractable != nil {
+		p.SetIsextractable(*s.Isextractable)
+	}
+	if s.Isfeatured != nil {
+		p.SetIsfeatured(*s.Isfeatured)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Isrouting != nil {
+		p.SetIsrouting(*s.Isrouting)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+	if s.Passwordenabled != nil {
+		p.SetPasswordenabled(*s.Passwordenabled)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Requireshvm != nil {
+		p.SetRequireshvm(*s.Requireshvm)
+	}
+	if s.Sshkeyenabled != nil {
+		p.SetSshkeyenabled(*s.Sshkeyenabled)
+	}
+	if s.Templatetag != nil {
+		p.SetTemplatetag(*s.Templatetag)
+	}
+	if s.Templatetype != nil {
+		p.SetTemplatetype(*s.Templatetype)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+	if s.Zoneids != nil {
+		p.SetZoneids(*s.Zoneids)
 	}
-	value, ok := p.p["zoneids"].([]string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RegisterTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RegisterTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedRegisterTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RegisterTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RegisterTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRegisterTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RegisterTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, url string) *RegisterTemplateParams {
 	p := &RegisterTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["format"] = format
-	p.p["hypervisor"] = hypervisor
-	p.p["name"] = name
-	p.p["url"] = url
+	p.SetDisplaytext(displaytext)
+	p.SetFormat(format)
+	p.SetHypervisor(hypervisor)
+	p.SetName(name)
+	p.SetUrl(url)
 	return p
 }
 
 // Registers an existing template into the CloudStack cloud.
-func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("registerTemplate", p.toURLValues())
+//
+// Required params: displaytext, format, hypervisor, name, url.
+func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams, opts ...CallOption) (*RegisterTemplateResponse, error) {
+	resp, err := s.cs.newRequest("registerTemplate", s.cs.encodeParams("registerTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3700,400 +5127,590 @@ func (r *RegisterTemplate) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateTemplateParams) toURLValues() url.Values {
+	bootable              optBool
+	cleanupdetails        optBool
+	details               optStringMap
+	displaytext           optString
+	format                optString
+	id                    optString
+	isdynamicallyscalable optBool
+	isrouting             optBool
+	name                  optString
+	ostypeid              optString
+	passwordenabled       optBool
+	requireshvm           optBool
+	sortkey               optInt
+	sshkeyenabled         optBool
+	templatetype          optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootable", vv)
+	if p.bootable.ok {
+		u.Set("bootable", strconv.FormatBool(p.bootable.v))
 	}
-	if v, found := p.p["cleanupdetails"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanupdetails", vv)
+	if p.cleanupdetails.ok {
+		u.Set("cleanupdetails", strconv.FormatBool(p.cleanupdetails.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for _, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[0].%s", k), m[k])
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if p.format.ok {
+		u.Set("format", p.format.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
+	if p.isdynamicallyscalable.ok {
+		u.Set("isdynamicallyscalable", strconv.FormatBool(p.isdynamicallyscalable.v))
 	}
-	if v, found := p.p["isrouting"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrouting", vv)
+	if p.isrouting.ok {
+		u.Set("isrouting", strconv.FormatBool(p.isrouting.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
+	if p.passwordenabled.ok {
+		u.Set("passwordenabled", strconv.FormatBool(p.passwordenabled.v))
 	}
-	if v, found := p.p["requireshvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("requireshvm", vv)
+	if p.requireshvm.ok {
+		u.Set("requireshvm", strconv.FormatBool(p.requireshvm.v))
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if p.sortkey.ok {
+		u.Set("sortkey", strconv.Itoa(p.sortkey.v))
 	}
-	if v, found := p.p["sshkeyenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sshkeyenabled", vv)
+	if p.sshkeyenabled.ok {
+		u.Set("sshkeyenabled", strconv.FormatBool(p.sshkeyenabled.v))
 	}
-	if v, found := p.p["templatetype"]; found {
-		u.Set("templatetype", v.(string))
+	if p.templatetype.ok {
+		u.Set("templatetype", p.templatetype.v)
 	}
 	return u
 }
 
-func (p *UpdateTemplateParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateTemplateParams(u url.Values) (*UpdateTemplateParams, error) {
+	p := &UpdateTemplateParams{}
+	if err := checkParamNames("updateTemplate", u, "bootable", "cleanupdetails", "details[]", "displaytext", "format", "id", "isdynamicallyscalable", "isrouting", "name", "ostypeid", "passwordenabled", "requireshvm", "sortkey", "sshkeyenabled", "templatetype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bootable"]; found {
+		v, err := strconv.ParseBool(u.Get("bootable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bootable: %v", err)
+		}
+		p.SetBootable(v)
+	}
+	if _, found := u["cleanupdetails"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanupdetails"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanupdetails: %v", err)
+		}
+		p.SetCleanupdetails(v)
+	}
+	if m, err := parseIndexedMap(u, "details", true); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["format"]; found {
+		p.SetFormat(u.Get("format"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isdynamicallyscalable"]; found {
+		v, err := strconv.ParseBool(u.Get("isdynamicallyscalable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdynamicallyscalable: %v", err)
+		}
+		p.SetIsdynamicallyscalable(v)
+	}
+	if _, found := u["isrouting"]; found {
+		v, err := strconv.ParseBool(u.Get("isrouting"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrouting: %v", err)
+		}
+		p.SetIsrouting(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	if _, found := u["passwordenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("passwordenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param passwordenabled: %v", err)
+		}
+		p.SetPasswordenabled(v)
+	}
+	if _, found := u["requireshvm"]; found {
+		v, err := strconv.ParseBool(u.Get("requireshvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param requireshvm: %v", err)
+		}
+		p.SetRequireshvm(v)
+	}
+	if _, found := u["sortkey"]; found {
+		v, err := strconv.Atoi(u.Get("sortkey"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sortkey: %v", err)
+		}
+		p.SetSortkey(v)
+	}
+	if _, found := u["sshkeyenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("sshkeyenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sshkeyenabled: %v", err)
+		}
+		p.SetSshkeyenabled(v)
+	}
+	if _, found := u["templatetype"]; found {
+		p.SetTemplatetype(u.Get("templatetype"))
 	}
-	p.p["bootable"] = v
+	return p, nil
+}
+
+// SetBootable sets the bootable param.
+func (p *UpdateTemplateParams) SetBootable(v bool) {
+	p.bootable = optBool{v: v, ok: true}
 }
 
+// ResetBootable unsets the bootable param
 func (p *UpdateTemplateParams) ResetBootable() {
-	if p.p != nil && p.p["bootable"] != nil {
-		delete(p.p, "bootable")
-	}
+	p.bootable = optBool{}
 }
 
+// GetBootable returns the bootable param and if it is set
 func (p *UpdateTemplateParams) GetBootable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootable"].(bool)
-	return value, ok
+	return p.bootable.v, p.bootable.ok
 }
 
+// SetCleanupdetails sets the cleanupdetails param.
 func (p *UpdateTemplateParams) SetCleanupdetails(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cleanupdetails"] = v
+	p.cleanupdetails = optBool{v: v, ok: true}
 }
 
+// ResetCleanupdetails unsets the cleanupdetails param
 func (p *UpdateTemplateParams) ResetCleanupdetails() {
-	if p.p != nil && p.p["cleanupdetails"] != nil {
-		delete(p.p, "cleanupdetails")
-	}
+	p.cleanupdetails = optBool{}
 }
 
+// GetCleanupdetails returns the cleanupdetails param and if it is set
 func (p *UpdateTemplateParams) GetCleanupdetails() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanupdetails"].(bool)
-	return value, ok
+	return p.cleanupdetails.v, p.cleanupdetails.ok
 }
 
+// SetDetails sets the details param.
 func (p *UpdateTemplateParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *UpdateTemplateParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *UpdateTemplateParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *UpdateTemplateParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateTemplateParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateTemplateParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetFormat sets the format param.
 func (p *UpdateTemplateParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
+	p.format = optString{v: v, ok: true}
 }
 
+// ResetFormat unsets the format param
 func (p *UpdateTemplateParams) ResetFormat() {
-	if p.p != nil && p.p["format"] != nil {
-		delete(p.p, "format")
-	}
+	p.format = optString{}
 }
 
+// GetFormat returns the format param and if it is set
 func (p *UpdateTemplateParams) GetFormat() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["format"].(string)
-	return value, ok
+	return p.format.v, p.format.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateTemplateParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateTemplateParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsdynamicallyscalable sets the isdynamicallyscalable param.
 func (p *UpdateTemplateParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
+	p.isdynamicallyscalable = optBool{v: v, ok: true}
 }
 
+// ResetIsdynamicallyscalable unsets the isdynamicallyscalable param
 func (p *UpdateTemplateParams) ResetIsdynamicallyscalable() {
-	if p.p != nil && p.p["isdynamicallyscalable"] != nil {
-		delete(p.p, "isdynamicallyscalable")
-	}
+	p.isdynamicallyscalable = optBool{}
 }
 
+// GetIsdynamicallyscalable returns the isdynamicallyscalable param and if it is set
 func (p *UpdateTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdynamicallyscalable"].(bool)
-	return value, ok
+	return p.isdynamicallyscalable.v, p.isdynamicallyscalable.ok
 }
 
+// SetIsrouting sets the isrouting param.
 func (p *UpdateTemplateParams) SetIsrouting(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrouting"] = v
+	p.isrouting = optBool{v: v, ok: true}
 }
 
+// ResetIsrouting unsets the isrouting param
 func (p *UpdateTemplateParams) ResetIsrouting() {
-	if p.p != nil && p.p["isrouting"] != nil {
-		delete(p.p, "isrouting")
-	}
+	p.isrouting = optBool{}
 }
 
+// GetIsrouting returns the isrouting param and if it is set
 func (p *UpdateTemplateParams) GetIsrouting() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrouting"].(bool)
-	return value, ok
+	return p.isrouting.v, p.isrouting.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateTemplateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateTemplateParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateTemplateParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *UpdateTemplateParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *UpdateTemplateParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *UpdateTemplateParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetPasswordenabled sets the passwordenabled param.
 func (p *UpdateTemplateParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["passwordenabled"] = v
+	p.passwordenabled = optBool{v: v, ok: true}
 }
 
+// ResetPasswordenabled unsets the passwordenabled param
 func (p *UpdateTemplateParams) ResetPasswordenabled() {
-	if p.p != nil && p.p["passwordenabled"] != nil {
-		delete(p.p, "passwordenabled")
-	}
+	p.passwordenabled = optBool{}
 }
 
+// GetPasswordenabled returns the passwordenabled param and if it is set
 func (p *UpdateTemplateParams) GetPasswordenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["passwordenabled"].(bool)
-	return value, ok
+	return p.passwordenabled.v, p.passwordenabled.ok
 }
 
+// SetRequireshvm sets the requireshvm param.
 func (p *UpdateTemplateParams) SetRequireshvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["requireshvm"] = v
+	p.requireshvm = optBool{v: v, ok: true}
 }
 
+// ResetRequireshvm unsets the requireshvm param
 func (p *UpdateTemplateParams) ResetRequireshvm() {
-	if p.p != nil && p.p["requireshvm"] != nil {
-		delete(p.p, "requireshvm")
-	}
+	p.requireshvm = optBool{}
 }
 
+// GetRequireshvm returns the requireshvm param and if it is set
 func (p *UpdateTemplateParams) GetRequireshvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["requireshvm"].(bool)
-	return value, ok
+	return p.requireshvm.v, p.requireshvm.ok
 }
 
+// SetSortkey sets the sortkey param.
 func (p *UpdateTemplateParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
+	p.sortkey = optInt{v: v, ok: true}
 }
 
+// ResetSortkey unsets the sortkey param
 func (p *UpdateTemplateParams) ResetSortkey() {
-	if p.p != nil && p.p["sortkey"] != nil {
-		delete(p.p, "sortkey")
-	}
+	p.sortkey = optInt{}
 }
 
+// GetSortkey returns the sortkey param and if it is set
 func (p *UpdateTemplateParams) GetSortkey() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sortkey"].(int)
-	return value, ok
+	return p.sortkey.v, p.sortkey.ok
 }
 
+// SetSshkeyenabled sets the sshkeyenabled param.
 func (p *UpdateTemplateParams) SetSshkeyenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sshkeyenabled"] = v
+	p.sshkeyenabled = optBool{v: v, ok: true}
 }
 
+// ResetSshkeyenabled unsets the sshkeyenabled param
 func (p *UpdateTemplateParams) ResetSshkeyenabled() {
-	if p.p != nil && p.p["sshkeyenabled"] != nil {
-		delete(p.p, "sshkeyenabled")
-	}
+	p.sshkeyenabled = optBool{}
 }
 
+// GetSshkeyenabled returns the sshkeyenabled param and if it is set
 func (p *UpdateTemplateParams) GetSshkeyenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.sshkeyenabled.v, p.sshkeyenabled.ok
+}
+
+// SetTemplatetype sets the templatetype param.
+func (p *UpdateTemplateParams) SetTemplatetype(v string) {
+	p.templatetype = optString{v: v, ok: true}
+}
+
+// ResetTemplatetype unsets the templatetype param
+func (p *UpdateTemplateParams) ResetTemplatetype() {
+	p.templatetype = optString{}
+}
+
+// GetTemplatetype returns the templatetype param and if it is set
+func (p *UpdateTemplateParams) GetTemplatetype() (string, bool) {
+	return p.templatetype.v, p.templatetype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateTemplateParams) Clone() *UpdateTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateTemplateParams) Equal(o *UpdateTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bootable == o.bootable &&
+		p.cleanupdetails == o.cleanupdetails &&
+		p.details.equal(o.details) &&
+		p.displaytext == o.displaytext &&
+		p.format == o.format &&
+		p.id == o.id &&
+		p.isdynamicallyscalable == o.isdynamicallyscalable &&
+		p.isrouting == o.isrouting &&
+		p.name == o.name &&
+		p.ostypeid == o.ostypeid &&
+		p.passwordenabled == o.passwordenabled &&
+		p.requireshvm == o.requireshvm &&
+		p.sortkey == o.sortkey &&
+		p.sshkeyenabled == o.sshkeyenabled &&
+		p.templatetype == o.templatetype
+}
+
+// serializedUpdateTemplateParams is used to (un)marshal UpdateTemplateParams using the API param names
+type serializedUpdateTemplateParams struct {
+	Bootable              *bool              `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Cleanupdetails        *bool              `json:"cleanupdetails,omitempty" yaml:"cleanupdetails,omitempty"`
+	Details               *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Format                *string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Id                    *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdynamicallyscalable *bool              `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isrouting             *bool              `json:"isrouting,omitempty" yaml:"isrouting,omitempty"`
+	Name                  *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string            `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool              `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Requireshvm           *bool              `json:"requireshvm,omitempty" yaml:"requireshvm,omitempty"`
+	Sortkey               *int               `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+	Sshkeyenabled         *bool              `json:"sshkeyenabled,omitempty" yaml:"sshkeyenabled,omitempty"`
+	Templatetype          *string            `json:"templatetype,omitempty" yaml:"templatetype,omitempty"`
+}
+
+func (p *UpdateTemplateParams) toSerialized() *serializedUpdateTemplateParams {
+	s := &serializedUpdateTemplateParams{}
+	if p.bootable.ok {
+		s.Bootable = &p.bootable.v
 	}
-	value, ok := p.p["sshkeyenabled"].(bool)
-	return value, ok
+	if p.cleanupdetails.ok {
+		s.Cleanupdetails = &p.cleanupdetails.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.format.ok {
+		s.Format = &p.format.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isdynamicallyscalable.ok {
+		s.Isdynamicallyscalable = &p.isdynamicallyscalable.v
+	}
+	if p.isrouting.ok {
+		s.Isrouting = &p.isrouting.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.passwordenabled.ok {
+		s.Passwordenabled = &p.passwordenabled.v
+	}
+	if p.requireshvm.ok {
+		s.Requireshvm = &p.requireshvm.v
+	}
+	if p.sortkey.ok {
+		s.Sortkey = &p.sortkey.v
+	}
+	if p.sshkeyenabled.ok {
+		s.Sshkeyenabled = &p.sshkeyenabled.v
+	}
+	if p.templatetype.ok {
+		s.Templatetype = &p.templatetype.v
+	}
+	return s
+}
+
+func (p *UpdateTemplateParams) fromSerialized(s *serializedUpdateTemplateParams) {
+	*p = UpdateTemplateParams{}
+	if s.Bootable != nil {
+		p.SetBootable(*s.Bootable)
+	}
+	if s.Cleanupdetails != nil {
+		p.SetCleanupdetails(*s.Cleanupdetails)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Format != nil {
+		p.SetFormat(*s.Format)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isdynamicallyscalable != nil {
+		p.SetIsdynamicallyscalable(*s.Isdynamicallyscalable)
+	}
+	if s.Isrouting != nil {
+		p.SetIsrouting(*s.Isrouting)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+	if s.Passwordenabled != nil {
+		p.SetPasswordenabled(*s.Passwordenabled)
+	}
+	if s.Requireshvm != nil {
+		p.SetRequireshvm(*s.Requireshvm)
+	}
+	if s.Sortkey != nil {
+		p.SetSortkey(*s.Sortkey)
+	}
+	if s.Sshkeyenabled != nil {
+		p.SetSshkeyenabled(*s.Sshkeyenabled)
+	}
+	if s.Templatetype != nil {
+		p.SetTemplatetype(*s.Templatetype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *UpdateTemplateParams) SetTemplatetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["templatetype"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *UpdateTemplateParams) ResetTemplatetype() {
-	if p.p != nil && p.p["templatetype"] != nil {
-		delete(p.p, "templatetype")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *UpdateTemplateParams) GetTemplatetype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["templatetype"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplateParams {
 	p := &UpdateTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates attributes of a template.
-func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplate", p.toURLValues())
+//
+// Required params: id.
+func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams, opts ...CallOption) (*UpdateTemplateResponse, error) {
+	resp, err := s.cs.newRequest("updateTemplate", s.cs.encodeParams("updateTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4191,202 +5808,329 @@ func (r *UpdateTemplateResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateTemplatePermissionsParams struct {
-	p map[string]interface{}
+	accounts      optStrings
+	id            optString
+	isextractable optBool
+	isfeatured    optBool
+	ispublic      optBool
+	op            optString
+	projectids    optStrings
 }
 
-func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateTemplatePermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["accounts"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("accounts", vv)
+	if p.accounts.ok {
+		u.Set("accounts", strings.Join(p.accounts.v, ","))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
+	if p.isextractable.ok {
+		u.Set("isextractable", strconv.FormatBool(p.isextractable.v))
 	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
+	if p.isfeatured.ok {
+		u.Set("isfeatured", strconv.FormatBool(p.isfeatured.v))
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["op"]; found {
-		u.Set("op", v.(string))
+	if p.op.ok {
+		u.Set("op", p.op.v)
 	}
-	if v, found := p.p["projectids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("projectids", vv)
+	if p.projectids.ok {
+		u.Set("projectids", strings.Join(p.projectids.v, ","))
 	}
 	return u
 }
 
-func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateTemplatePermissionsParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateTemplatePermissionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateTemplatePermissionsParams(u url.Values) (*UpdateTemplatePermissionsParams, error) {
+	p := &UpdateTemplatePermissionsParams{}
+	if err := checkParamNames("updateTemplatePermissions", u, "accounts", "id", "isextractable", "isfeatured", "ispublic", "op", "projectids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["accounts"]; found {
+		p.SetAccounts(strings.Split(u.Get("accounts"), ","))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isextractable"]; found {
+		v, err := strconv.ParseBool(u.Get("isextractable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isextractable: %v", err)
+		}
+		p.SetIsextractable(v)
 	}
-	p.p["accounts"] = v
+	if _, found := u["isfeatured"]; found {
+		v, err := strconv.ParseBool(u.Get("isfeatured"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isfeatured: %v", err)
+		}
+		p.SetIsfeatured(v)
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["op"]; found {
+		p.SetOp(u.Get("op"))
+	}
+	if _, found := u["projectids"]; found {
+		p.SetProjectids(strings.Split(u.Get("projectids"), ","))
+	}
+	return p, nil
 }
 
+// SetAccounts sets the accounts param.
+func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
+	p.accounts = optStrings{v: v, ok: true}
+}
+
+// ResetAccounts unsets the accounts param
 func (p *UpdateTemplatePermissionsParams) ResetAccounts() {
-	if p.p != nil && p.p["accounts"] != nil {
-		delete(p.p, "accounts")
-	}
+	p.accounts = optStrings{}
 }
 
+// GetAccounts returns the accounts param and if it is set
 func (p *UpdateTemplatePermissionsParams) GetAccounts() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounts"].([]string)
-	return value, ok
+	return p.accounts.v, p.accounts.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateTemplatePermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateTemplatePermissionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateTemplatePermissionsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsextractable sets the isextractable param.
 func (p *UpdateTemplatePermissionsParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isextractable"] = v
+	p.isextractable = optBool{v: v, ok: true}
 }
 
+// ResetIsextractable unsets the isextractable param
 func (p *UpdateTemplatePermissionsParams) ResetIsextractable() {
-	if p.p != nil && p.p["isextractable"] != nil {
-		delete(p.p, "isextractable")
-	}
+	p.isextractable = optBool{}
 }
 
+// GetIsextractable returns the isextractable param and if it is set
 func (p *UpdateTemplatePermissionsParams) GetIsextractable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isextractable"].(bool)
-	return value, ok
+	return p.isextractable.v, p.isextractable.ok
 }
 
+// SetIsfeatured sets the isfeatured param.
 func (p *UpdateTemplatePermissionsParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
+	p.isfeatured = optBool{v: v, ok: true}
 }
 
+// ResetIsfeatured unsets the isfeatured param
 func (p *UpdateTemplatePermissionsParams) ResetIsfeatured() {
-	if p.p != nil && p.p["isfeatured"] != nil {
-		delete(p.p, "isfeatured")
-	}
+	p.isfeatured = optBool{}
 }
 
+// GetIsfeatured returns the isfeatured param and if it is set
 func (p *UpdateTemplatePermissionsParams) GetIsfeatured() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isfeatured"].(bool)
-	return value, ok
+	return p.isfeatured.v, p.isfeatured.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *UpdateTemplatePermissionsParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *UpdateTemplatePermissionsParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *UpdateTemplatePermissionsParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetOp sets the op param.
 func (p *UpdateTemplatePermissionsParams) SetOp(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["op"] = v
+	p.op = optString{v: v, ok: true}
 }
 
+// ResetOp unsets the op param
 func (p *UpdateTemplatePermissionsParams) ResetOp() {
-	if p.p != nil && p.p["op"] != nil {
-		delete(p.p, "op")
-	}
+	p.op = optString{}
 }
 
+// GetOp returns the op param and if it is set
 func (p *UpdateTemplatePermissionsParams) GetOp() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["op"].(string)
-	return value, ok
+	return p.op.v, p.op.ok
 }
 
+// SetProjectids sets the projectids param.
 func (p *UpdateTemplatePermissionsParams) SetProjectids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectids"] = v
+	p.projectids = optStrings{v: v, ok: true}
 }
 
+// ResetProjectids unsets the projectids param
 func (p *UpdateTemplatePermissionsParams) ResetProjectids() {
-	if p.p != nil && p.p["projectids"] != nil {
-		delete(p.p, "projectids")
-	}
+	p.projectids = optStrings{}
 }
 
+// GetProjectids returns the projectids param and if it is set
 func (p *UpdateTemplatePermissionsParams) GetProjectids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectids.v, p.projectids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateTemplatePermissionsParams) Clone() *UpdateTemplatePermissionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.accounts = p.accounts.clone()
+	c.projectids = p.projectids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateTemplatePermissionsParams) Equal(o *UpdateTemplatePermissionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.accounts.equal(o.accounts) &&
+		p.id == o.id &&
+		p.isextractable == o.isextractable &&
+		p.isfeatured == o.isfeatured &&
+		p.ispublic == o.ispublic &&
+		p.op == o.op &&
+		p.projectids.equal(o.projectids)
+}
+
+// serializedUpdateTemplatePermissionsParams is used to (un)marshal UpdateTemplatePermissionsParams using the API param names
+type serializedUpdateTemplatePermissionsParams struct {
+	Accounts      *[]string `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	Id            *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Isextractable *bool     `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured    *bool     `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic      *bool     `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Op            *string   `json:"op,omitempty" yaml:"op,omitempty"`
+	Projectids    *[]string `json:"projectids,omitempty" yaml:"projectids,omitempty"`
+}
+
+func (p *UpdateTemplatePermissionsParams) toSerialized() *serializedUpdateTemplatePermissionsParams {
+	s := &serializedUpdateTemplatePermissionsParams{}
+	if p.accounts.ok {
+		s.Accounts = &p.accounts.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isextractable.ok {
+		s.Isextractable = &p.isextractable.v
+	}
+	if p.isfeatured.ok {
+		s.Isfeatured = &p.isfeatured.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.op.ok {
+		s.Op = &p.op.v
+	}
+	if p.projectids.ok {
+		s.Projectids = &p.projectids.v
+	}
+	return s
+}
+
+func (p *UpdateTemplatePermissionsParams) fromSerialized(s *serializedUpdateTemplatePermissionsParams) {
+	*p = UpdateTemplatePermissionsParams{}
+	if s.Accounts != nil {
+		p.SetAccounts(*s.Accounts)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isextractable != nil {
+		p.SetIsextractable(*s.Isextractable)
+	}
+	if s.Isfeatured != nil {
+		p.SetIsfeatured(*s.Isfeatured)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Op != nil {
+		p.SetOp(*s.Op)
+	}
+	if s.Projectids != nil {
+		p.SetProjectids(*s.Projectids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateTemplatePermissionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateTemplatePermissionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateTemplatePermissionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateTemplatePermissionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateTemplatePermissionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateTemplatePermissionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["projectids"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateTemplatePermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams {
 	p := &UpdateTemplatePermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a template visibility permissions. A public template is visible to all accounts within the same domain. A private template is visible only to the owner of the template. A privileged template is a private template with account permissions added. Only accounts specified under the template permissions are visible to them.
-func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplatePermissions", p.toURLValues())
+// Updates a template visibility permissions. A public template is visible to all accounts within
+// the same domain. A private template is visible only to the owner of the template. A privileged
+// template is a private template with account permissions added. Only accounts specified under the
+// template permissions are visible to them.
+//
+// Required params: id.
+func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams, opts ...CallOption) (*UpdateTemplatePermissionsResponse, error) {
+	resp, err := s.cs.newRequest("updateTemplatePermissions", s.cs.encodeParams("updateTemplatePermissions", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4434,172 +6178,279 @@ func (r *UpdateTemplatePermissionsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpgradeRouterTemplateParams struct {
-	p map[string]interface{}
+	account   optString
+	clusterid optString
+	domainid  optString
+	id        optString
+	podid     optString
+	zoneid    optString
 }
 
-func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpgradeRouterTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpgradeRouterTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new UpgradeRouterTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpgradeRouterTemplateParams(u url.Values) (*UpgradeRouterTemplateParams, error) {
+	p := &UpgradeRouterTemplateParams{}
+	if err := checkParamNames("upgradeRouterTemplate", u, "account", "clusterid", "domainid", "id", "podid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *UpgradeRouterTemplateParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UpgradeRouterTemplateParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *UpgradeRouterTemplateParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *UpgradeRouterTemplateParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *UpgradeRouterTemplateParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpgradeRouterTemplateParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpgradeRouterTemplateParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpgradeRouterTemplateParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *UpgradeRouterTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpgradeRouterTemplateParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpgradeRouterTemplateParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetPodid sets the podid param.
 func (p *UpgradeRouterTemplateParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *UpgradeRouterTemplateParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *UpgradeRouterTemplateParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *UpgradeRouterTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *UpgradeRouterTemplateParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *UpgradeRouterTemplateParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpgradeRouterTemplateParams) Clone() *UpgradeRouterTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpgradeRouterTemplateParams) Equal(o *UpgradeRouterTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.clusterid == o.clusterid &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.podid == o.podid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedUpgradeRouterTemplateParams is used to (un)marshal UpgradeRouterTemplateParams using the API param names
+type serializedUpgradeRouterTemplateParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Podid     *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *UpgradeRouterTemplateParams) toSerialized() *serializedUpgradeRouterTemplateParams {
+	s := &serializedUpgradeRouterTemplateParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *UpgradeRouterTemplateParams) fromSerialized(s *serializedUpgradeRouterTemplateParams) {
+	*p = UpgradeRouterTemplateParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpgradeRouterTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpgradeRouterTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpgradeRouterTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpgradeRouterTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpgradeRouterTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpgradeRouterTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpgradeRouterTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams {
 	p := &UpgradeRouterTemplateParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Upgrades router to use newer template
-func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("upgradeRouterTemplate", p.toURLValues())
+// Upgrades router to use newer template.
+func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams, opts ...CallOption) (*UpgradeRouterTemplateResponse, error) {
+	resp, err := s.cs.newRequest("upgradeRouterTemplate", s.cs.encodeParams("upgradeRouterTemplate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4618,178 +6469,293 @@ type UpgradeRouterTemplateResponse struct {
 }
 
 type ListTemplateDirectDownloadCertificatesParams struct {
-	p map[string]interface{}
+	id        optString
+	keyword   optString
+	listhosts optBool
+	page      optInt
+	pagesize  optInt
+	zoneid    optString
 }
 
-func (p *ListTemplateDirectDownloadCertificatesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListTemplateDirectDownloadCertificatesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listhosts"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listhosts", vv)
+	if p.listhosts.ok {
+		u.Set("listhosts", strconv.FormatBool(p.listhosts.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListTemplateDirectDownloadCertificatesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListTemplateDirectDownloadCertificatesParams parses url.Values, for example taken from a raw API request,
+// into a new ListTemplateDirectDownloadCertificatesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListTemplateDirectDownloadCertificatesParams(u url.Values) (*ListTemplateDirectDownloadCertificatesParams, error) {
+	p := &ListTemplateDirectDownloadCertificatesParams{}
+	if err := checkParamNames("listTemplateDirectDownloadCertificates", u, "id", "keyword", "listhosts", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listhosts"]; found {
+		v, err := strconv.ParseBool(u.Get("listhosts"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listhosts: %v", err)
+		}
+		p.SetListhosts(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param.
+func (p *ListTemplateDirectDownloadCertificatesParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListTemplateDirectDownloadCertificatesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListTemplateDirectDownloadCertificatesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListTemplateDirectDownloadCertificatesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListTemplateDirectDownloadCertificatesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListTemplateDirectDownloadCertificatesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListhosts sets the listhosts param.
 func (p *ListTemplateDirectDownloadCertificatesParams) SetListhosts(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listhosts"] = v
+	p.listhosts = optBool{v: v, ok: true}
 }
 
+// ResetListhosts unsets the listhosts param
 func (p *ListTemplateDirectDownloadCertificatesParams) ResetListhosts() {
-	if p.p != nil && p.p["listhosts"] != nil {
-		delete(p.p, "listhosts")
-	}
+	p.listhosts = optBool{}
 }
 
+// GetListhosts returns the listhosts param and if it is set
 func (p *ListTemplateDirectDownloadCertificatesParams) GetListhosts() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listhosts"].(bool)
-	return value, ok
+	return p.listhosts.v, p.listhosts.ok
 }
 
+// SetPage sets the page param.
 func (p *ListTemplateDirectDownloadCertificatesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListTemplateDirectDownloadCertificatesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListTemplateDirectDownloadCertificatesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListTemplateDirectDownloadCertificatesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListTemplateDirectDownloadCertificatesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListTemplateDirectDownloadCertificatesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListTemplateDirectDownloadCertificatesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListTemplateDirectDownloadCertificatesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListTemplateDirectDownloadCertificatesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListTemplateDirectDownloadCertificatesParams) Clone() *ListTemplateDirectDownloadCertificatesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListTemplateDirectDownloadCertificatesParams) Equal(o *ListTemplateDirectDownloadCertificatesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.listhosts == o.listhosts &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListTemplateDirectDownloadCertificatesParams is used to (un)marshal ListTemplateDirectDownloadCertificatesParams using the API param names
+type serializedListTemplateDirectDownloadCertificatesParams struct {
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword   *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listhosts *bool   `json:"listhosts,omitempty" yaml:"listhosts,omitempty"`
+	Page      *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListTemplateDirectDownloadCertificatesParams) toSerialized() *serializedListTemplateDirectDownloadCertificatesParams {
+	s := &serializedListTemplateDirectDownloadCertificatesParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.listhosts.ok {
+		s.Listhosts = &p.listhosts.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListTemplateDirectDownloadCertificatesParams) fromSerialized(s *serializedListTemplateDirectDownloadCertificatesParams) {
+	*p = ListTemplateDirectDownloadCertificatesParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listhosts != nil {
+		p.SetListhosts(*s.Listhosts)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListTemplateDirectDownloadCertificatesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListTemplateDirectDownloadCertificatesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListTemplateDirectDownloadCertificatesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListTemplateDirectDownloadCertificatesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListTemplateDirectDownloadCertificatesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListTemplateDirectDownloadCertificatesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListTemplateDirectDownloadCertificatesParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewListTemplateDirectDownloadCertificatesParams() *ListTemplateDirectDownloadCertificatesParams {
 	p := &ListTemplateDirectDownloadCertificatesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateDirectDownloadCertificateByID(id string, opts ...OptionFunc) (*TemplateDirectDownloadCertificate, int, error) {
 	p := &ListTemplateDirectDownloadCertificatesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -4817,9 +6783,9 @@ func (s *TemplateService) GetTemplateDirectDownloadCertificateByID(id string, op
 	return nil, l.Count, fmt.Errorf("There is more then one result for TemplateDirectDownloadCertificate UUID: %s!", id)
 }
 
-// List the uploaded certificates for direct download templates
-func (s *TemplateService) ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error) {
-	resp, err := s.cs.newRequest("listTemplateDirectDownloadCertificates", p.toURLValues())
+// List the uploaded certificates for direct download templates.
+func (s *TemplateService) ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams, opts ...CallOption) (*ListTemplateDirectDownloadCertificatesResponse, error) {
+	resp, err := s.cs.newRequest("listTemplateDirectDownloadCertificates", s.cs.encodeParams("listTemplateDirectDownloadCertificates", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4854,78 +6820,163 @@ type TemplateDirectDownloadCertificate struct {
 }
 
 type ProvisionTemplateDirectDownloadCertificateParams struct {
-	p map[string]interface{}
+	hostid optString
+	id     optString
 }
 
-func (p *ProvisionTemplateDirectDownloadCertificateParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ProvisionTemplateDirectDownloadCertificateParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ProvisionTemplateDirectDownloadCertificateParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseProvisionTemplateDirectDownloadCertificateParams parses url.Values, for example taken from a raw API request,
+// into a new ProvisionTemplateDirectDownloadCertificateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseProvisionTemplateDirectDownloadCertificateParams(u url.Values) (*ProvisionTemplateDirectDownloadCertificateParams, error) {
+	p := &ProvisionTemplateDirectDownloadCertificateParams{}
+	if err := checkParamNames("provisionTemplateDirectDownloadCertificate", u, "hostid", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["hostid"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetHostid sets the hostid param. This param is required.
+func (p *ProvisionTemplateDirectDownloadCertificateParams) SetHostid(v string) {
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ProvisionTemplateDirectDownloadCertificateParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ProvisionTemplateDirectDownloadCertificateParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ProvisionTemplateDirectDownloadCertificateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ProvisionTemplateDirectDownloadCertificateParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ProvisionTemplateDirectDownloadCertificateParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ProvisionTemplateDirectDownloadCertificateParams) Clone() *ProvisionTemplateDirectDownloadCertificateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ProvisionTemplateDirectDownloadCertificateParams) Equal(o *ProvisionTemplateDirectDownloadCertificateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hostid == o.hostid &&
+		p.id == o.id
+}
+
+// serializedProvisionTemplateDirectDownloadCertificateParams is used to (un)marshal ProvisionTemplateDirectDownloadCertificateParams using the API param names
+type serializedProvisionTemplateDirectDownloadCertificateParams struct {
+	Hostid *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ProvisionTemplateDirectDownloadCertificateParams) toSerialized() *serializedProvisionTemplateDirectDownloadCertificateParams {
+	s := &serializedProvisionTemplateDirectDownloadCertificateParams{}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ProvisionTemplateDirectDownloadCertificateParams) fromSerialized(s *serializedProvisionTemplateDirectDownloadCertificateParams) {
+	*p = ProvisionTemplateDirectDownloadCertificateParams{}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ProvisionTemplateDirectDownloadCertificateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ProvisionTemplateDirectDownloadCertificateParams) UnmarshalJSON(b []byte) error {
+	var s serializedProvisionTemplateDirectDownloadCertificateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ProvisionTemplateDirectDownloadCertificateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ProvisionTemplateDirectDownloadCertificateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedProvisionTemplateDirectDownloadCertificateParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ProvisionTemplateDirectDownloadCertificateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewProvisionTemplateDirectDownloadCertificateParams(hostid string, id string) *ProvisionTemplateDirectDownloadCertificateParams {
 	p := &ProvisionTemplateDirectDownloadCertificateParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
-	p.p["id"] = id
+	p.SetHostid(hostid)
+	p.SetId(id)
 	return p
 }
 
-// Provisions a host with a direct download certificate
-func (s *TemplateService) ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
-	resp, err := s.cs.newRequest("provisionTemplateDirectDownloadCertificate", p.toURLValues())
+// Provisions a host with a direct download certificate.
+//
+// Required params: hostid, id.
+func (s *TemplateService) ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams, opts ...CallOption) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
+	resp, err := s.cs.newRequest("provisionTemplateDirectDownloadCertificate", s.cs.encodeParams("provisionTemplateDirectDownloadCertificate", p), opts...)
 	if err != nil {
 		return nil, err
 	}
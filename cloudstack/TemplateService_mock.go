@@ -53,63 +53,83 @@ func (m *MockTemplateServiceIface) EXPECT() *MockTemplateServiceIfaceMockRecorde
 }
 
 // CopyTemplate mocks base method.
-func (m *MockTemplateServiceIface) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+func (m *MockTemplateServiceIface) CopyTemplate(p *CopyTemplateParams, opts ...CallOption) (*CopyTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CopyTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CopyTemplate", varargs...)
 	ret0, _ := ret[0].(*CopyTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CopyTemplate indicates an expected call of CopyTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) CopyTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) CopyTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).CopyTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).CopyTemplate), varargs...)
 }
 
 // CreateTemplate mocks base method.
-func (m *MockTemplateServiceIface) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
+func (m *MockTemplateServiceIface) CreateTemplate(p *CreateTemplateParams, opts ...CallOption) (*CreateTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTemplate", varargs...)
 	ret0, _ := ret[0].(*CreateTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTemplate indicates an expected call of CreateTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) CreateTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) CreateTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).CreateTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).CreateTemplate), varargs...)
 }
 
 // DeleteTemplate mocks base method.
-func (m *MockTemplateServiceIface) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+func (m *MockTemplateServiceIface) DeleteTemplate(p *DeleteTemplateParams, opts ...CallOption) (*DeleteTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTemplate", varargs...)
 	ret0, _ := ret[0].(*DeleteTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteTemplate indicates an expected call of DeleteTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) DeleteTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) DeleteTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).DeleteTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).DeleteTemplate), varargs...)
 }
 
 // ExtractTemplate mocks base method.
-func (m *MockTemplateServiceIface) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+func (m *MockTemplateServiceIface) ExtractTemplate(p *ExtractTemplateParams, opts ...CallOption) (*ExtractTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExtractTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExtractTemplate", varargs...)
 	ret0, _ := ret[0].(*ExtractTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExtractTemplate indicates an expected call of ExtractTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) ExtractTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) ExtractTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).ExtractTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).ExtractTemplate), varargs...)
 }
 
 // GetTemplateByID mocks base method.
@@ -218,63 +238,83 @@ func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplatePermissionByID(id int
 }
 
 // GetUploadParamsForTemplate mocks base method.
-func (m *MockTemplateServiceIface) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
+func (m *MockTemplateServiceIface) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams, opts ...CallOption) (*GetUploadParamsForTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUploadParamsForTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUploadParamsForTemplate", varargs...)
 	ret0, _ := ret[0].(*GetUploadParamsForTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUploadParamsForTemplate indicates an expected call of GetUploadParamsForTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) GetUploadParamsForTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) GetUploadParamsForTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetUploadParamsForTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetUploadParamsForTemplate), varargs...)
 }
 
 // ListTemplateDirectDownloadCertificates mocks base method.
-func (m *MockTemplateServiceIface) ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error) {
+func (m *MockTemplateServiceIface) ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams, opts ...CallOption) (*ListTemplateDirectDownloadCertificatesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTemplateDirectDownloadCertificates", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplateDirectDownloadCertificates", varargs...)
 	ret0, _ := ret[0].(*ListTemplateDirectDownloadCertificatesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTemplateDirectDownloadCertificates indicates an expected call of ListTemplateDirectDownloadCertificates.
-func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplateDirectDownloadCertificates(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplateDirectDownloadCertificates(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificates", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplateDirectDownloadCertificates), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificates", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplateDirectDownloadCertificates), varargs...)
 }
 
 // ListTemplatePermissions mocks base method.
-func (m *MockTemplateServiceIface) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+func (m *MockTemplateServiceIface) ListTemplatePermissions(p *ListTemplatePermissionsParams, opts ...CallOption) (*ListTemplatePermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTemplatePermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplatePermissions", varargs...)
 	ret0, _ := ret[0].(*ListTemplatePermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTemplatePermissions indicates an expected call of ListTemplatePermissions.
-func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatePermissions(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatePermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatePermissions", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatePermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatePermissions", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatePermissions), varargs...)
 }
 
 // ListTemplates mocks base method.
-func (m *MockTemplateServiceIface) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+func (m *MockTemplateServiceIface) ListTemplates(p *ListTemplatesParams, opts ...CallOption) (*ListTemplatesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTemplates", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplates", varargs...)
 	ret0, _ := ret[0].(*ListTemplatesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTemplates indicates an expected call of ListTemplates.
-func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplates(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplates(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplates), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplates), varargs...)
 }
 
 // NewCopyTemplateParams mocks base method.
@@ -474,91 +514,121 @@ func (mr *MockTemplateServiceIfaceMockRecorder) NewUpgradeRouterTemplateParams()
 }
 
 // PrepareTemplate mocks base method.
-func (m *MockTemplateServiceIface) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+func (m *MockTemplateServiceIface) PrepareTemplate(p *PrepareTemplateParams, opts ...CallOption) (*PrepareTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PrepareTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PrepareTemplate", varargs...)
 	ret0, _ := ret[0].(*PrepareTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PrepareTemplate indicates an expected call of PrepareTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) PrepareTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) PrepareTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).PrepareTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).PrepareTemplate), varargs...)
 }
 
 // ProvisionTemplateDirectDownloadCertificate mocks base method.
-func (m *MockTemplateServiceIface) ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
+func (m *MockTemplateServiceIface) ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams, opts ...CallOption) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProvisionTemplateDirectDownloadCertificate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProvisionTemplateDirectDownloadCertificate", varargs...)
 	ret0, _ := ret[0].(*ProvisionTemplateDirectDownloadCertificateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ProvisionTemplateDirectDownloadCertificate indicates an expected call of ProvisionTemplateDirectDownloadCertificate.
-func (mr *MockTemplateServiceIfaceMockRecorder) ProvisionTemplateDirectDownloadCertificate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) ProvisionTemplateDirectDownloadCertificate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionTemplateDirectDownloadCertificate", reflect.TypeOf((*MockTemplateServiceIface)(nil).ProvisionTemplateDirectDownloadCertificate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionTemplateDirectDownloadCertificate", reflect.TypeOf((*MockTemplateServiceIface)(nil).ProvisionTemplateDirectDownloadCertificate), varargs...)
 }
 
 // RegisterTemplate mocks base method.
-func (m *MockTemplateServiceIface) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+func (m *MockTemplateServiceIface) RegisterTemplate(p *RegisterTemplateParams, opts ...CallOption) (*RegisterTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterTemplate", varargs...)
 	ret0, _ := ret[0].(*RegisterTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterTemplate indicates an expected call of RegisterTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) RegisterTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) RegisterTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).RegisterTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).RegisterTemplate), varargs...)
 }
 
 // UpdateTemplate mocks base method.
-func (m *MockTemplateServiceIface) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+func (m *MockTemplateServiceIface) UpdateTemplate(p *UpdateTemplateParams, opts ...CallOption) (*UpdateTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTemplate", varargs...)
 	ret0, _ := ret[0].(*UpdateTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTemplate indicates an expected call of UpdateTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) UpdateTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) UpdateTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpdateTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpdateTemplate), varargs...)
 }
 
 // UpdateTemplatePermissions mocks base method.
-func (m *MockTemplateServiceIface) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+func (m *MockTemplateServiceIface) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams, opts ...CallOption) (*UpdateTemplatePermissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTemplatePermissions", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTemplatePermissions", varargs...)
 	ret0, _ := ret[0].(*UpdateTemplatePermissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTemplatePermissions indicates an expected call of UpdateTemplatePermissions.
-func (mr *MockTemplateServiceIfaceMockRecorder) UpdateTemplatePermissions(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) UpdateTemplatePermissions(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplatePermissions", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpdateTemplatePermissions), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplatePermissions", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpdateTemplatePermissions), varargs...)
 }
 
 // UpgradeRouterTemplate mocks base method.
-func (m *MockTemplateServiceIface) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+func (m *MockTemplateServiceIface) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams, opts ...CallOption) (*UpgradeRouterTemplateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeRouterTemplate", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpgradeRouterTemplate", varargs...)
 	ret0, _ := ret[0].(*UpgradeRouterTemplateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpgradeRouterTemplate indicates an expected call of UpgradeRouterTemplate.
-func (mr *MockTemplateServiceIfaceMockRecorder) UpgradeRouterTemplate(p interface{}) *gomock.Call {
+func (mr *MockTemplateServiceIfaceMockRecorder) UpgradeRouterTemplate(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeRouterTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpgradeRouterTemplate), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeRouterTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpgradeRouterTemplate), varargs...)
 }
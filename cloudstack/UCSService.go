@@ -28,170 +28,273 @@ import (
 )
 
 type UCSServiceIface interface {
-	AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error)
+	AddUcsManager(p *AddUcsManagerParams, opts ...CallOption) (*AddUcsManagerResponse, error)
 	NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams
-	AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams, opts ...CallOption) (*AssociateUcsProfileToBladeResponse, error)
 	NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams
-	DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error)
+	DeleteUcsManager(p *DeleteUcsManagerParams, opts ...CallOption) (*DeleteUcsManagerResponse, error)
 	NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams
-	ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	ListUcsBlades(p *ListUcsBladesParams, opts ...CallOption) (*ListUcsBladesResponse, error)
 	NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams
-	ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	ListUcsManagers(p *ListUcsManagersParams, opts ...CallOption) (*ListUcsManagersResponse, error)
 	NewListUcsManagersParams() *ListUcsManagersParams
 	GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error)
 	GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error)
 	GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error)
-	ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	ListUcsProfiles(p *ListUcsProfilesParams, opts ...CallOption) (*ListUcsProfilesResponse, error)
 	NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams
 }
 
 type AddUcsManagerParams struct {
-	p map[string]interface{}
+	name     optString
+	password optString
+	url      optString
+	username optString
+	zoneid   optString
 }
 
-func (p *AddUcsManagerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddUcsManagerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddUcsManagerParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddUcsManagerParams parses url.Values, for example taken from a raw API request,
+// into a new AddUcsManagerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddUcsManagerParams(u url.Values) (*AddUcsManagerParams, error) {
+	p := &AddUcsManagerParams{}
+	if err := checkParamNames("addUcsManager", u, "name", "password", "url", "username", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
 	}
-	p.p["name"] = v
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetName sets the name param.
+func (p *AddUcsManagerParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *AddUcsManagerParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *AddUcsManagerParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddUcsManagerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddUcsManagerParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddUcsManagerParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddUcsManagerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddUcsManagerParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddUcsManagerParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddUcsManagerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddUcsManagerParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddUcsManagerParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.username.v, p.username.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *AddUcsManagerParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddUcsManagerParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddUcsManagerParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddUcsManagerParams) Clone() *AddUcsManagerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddUcsManagerParams) Equal(o *AddUcsManagerParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.name == o.name &&
+		p.password == o.password &&
+		p.url == o.url &&
+		p.username == o.username &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddUcsManagerParams is used to (un)marshal AddUcsManagerParams using the API param names
+type serializedAddUcsManagerParams struct {
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Url      *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddUcsManagerParams) toSerialized() *serializedAddUcsManagerParams {
+	s := &serializedAddUcsManagerParams{}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddUcsManagerParams) fromSerialized(s *serializedAddUcsManagerParams) {
+	*p = AddUcsManagerParams{}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddUcsManagerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddUcsManagerParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddUcsManagerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddUcsManagerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddUcsManagerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddUcsManagerParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddUcsManagerParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams {
 	p := &AddUcsManagerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["url"] = url
-	p.p["username"] = username
-	p.p["zoneid"] = zoneid
+	p.SetPassword(password)
+	p.SetUrl(url)
+	p.SetUsername(username)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Adds a Ucs manager
-func (s *UCSService) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("addUcsManager", p.toURLValues())
+// Adds a Ucs manager.
+//
+// Required params: password, url, username, zoneid.
+func (s *UCSService) AddUcsManager(p *AddUcsManagerParams, opts ...CallOption) (*AddUcsManagerResponse, error) {
+	resp, err := s.cs.newRequest("addUcsManager", s.cs.encodeParams("addUcsManager", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -214,103 +317,196 @@ type AddUcsManagerResponse struct {
 }
 
 type AssociateUcsProfileToBladeParams struct {
-	p map[string]interface{}
+	bladeid      optString
+	profiledn    optString
+	ucsmanagerid optString
 }
 
-func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AssociateUcsProfileToBladeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bladeid"]; found {
-		u.Set("bladeid", v.(string))
+	if p.bladeid.ok {
+		u.Set("bladeid", p.bladeid.v)
 	}
-	if v, found := p.p["profiledn"]; found {
-		u.Set("profiledn", v.(string))
+	if p.profiledn.ok {
+		u.Set("profiledn", p.profiledn.v)
 	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
+	if p.ucsmanagerid.ok {
+		u.Set("ucsmanagerid", p.ucsmanagerid.v)
 	}
 	return u
 }
 
-func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAssociateUcsProfileToBladeParams parses url.Values, for example taken from a raw API request,
+// into a new AssociateUcsProfileToBladeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAssociateUcsProfileToBladeParams(u url.Values) (*AssociateUcsProfileToBladeParams, error) {
+	p := &AssociateUcsProfileToBladeParams{}
+	if err := checkParamNames("associateUcsProfileToBlade", u, "bladeid", "profiledn", "ucsmanagerid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bladeid"]; found {
+		p.SetBladeid(u.Get("bladeid"))
+	}
+	if _, found := u["profiledn"]; found {
+		p.SetProfiledn(u.Get("profiledn"))
+	}
+	if _, found := u["ucsmanagerid"]; found {
+		p.SetUcsmanagerid(u.Get("ucsmanagerid"))
 	}
-	p.p["bladeid"] = v
+	return p, nil
 }
 
+// SetBladeid sets the bladeid param. This param is required.
+func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
+	p.bladeid = optString{v: v, ok: true}
+}
+
+// ResetBladeid unsets the bladeid param
 func (p *AssociateUcsProfileToBladeParams) ResetBladeid() {
-	if p.p != nil && p.p["bladeid"] != nil {
-		delete(p.p, "bladeid")
-	}
+	p.bladeid = optString{}
 }
 
+// GetBladeid returns the bladeid param and if it is set
 func (p *AssociateUcsProfileToBladeParams) GetBladeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bladeid"].(string)
-	return value, ok
+	return p.bladeid.v, p.bladeid.ok
 }
 
+// SetProfiledn sets the profiledn param. This param is required.
 func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["profiledn"] = v
+	p.profiledn = optString{v: v, ok: true}
 }
 
+// ResetProfiledn unsets the profiledn param
 func (p *AssociateUcsProfileToBladeParams) ResetProfiledn() {
-	if p.p != nil && p.p["profiledn"] != nil {
-		delete(p.p, "profiledn")
-	}
+	p.profiledn = optString{}
 }
 
+// GetProfiledn returns the profiledn param and if it is set
 func (p *AssociateUcsProfileToBladeParams) GetProfiledn() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["profiledn"].(string)
-	return value, ok
+	return p.profiledn.v, p.profiledn.ok
 }
 
+// SetUcsmanagerid sets the ucsmanagerid param. This param is required.
 func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
+	p.ucsmanagerid = optString{v: v, ok: true}
 }
 
+// ResetUcsmanagerid unsets the ucsmanagerid param
 func (p *AssociateUcsProfileToBladeParams) ResetUcsmanagerid() {
-	if p.p != nil && p.p["ucsmanagerid"] != nil {
-		delete(p.p, "ucsmanagerid")
-	}
+	p.ucsmanagerid = optString{}
 }
 
+// GetUcsmanagerid returns the ucsmanagerid param and if it is set
 func (p *AssociateUcsProfileToBladeParams) GetUcsmanagerid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ucsmanagerid.v, p.ucsmanagerid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AssociateUcsProfileToBladeParams) Clone() *AssociateUcsProfileToBladeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AssociateUcsProfileToBladeParams) Equal(o *AssociateUcsProfileToBladeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bladeid == o.bladeid &&
+		p.profiledn == o.profiledn &&
+		p.ucsmanagerid == o.ucsmanagerid
+}
+
+// serializedAssociateUcsProfileToBladeParams is used to (un)marshal AssociateUcsProfileToBladeParams using the API param names
+type serializedAssociateUcsProfileToBladeParams struct {
+	Bladeid      *string `json:"bladeid,omitempty" yaml:"bladeid,omitempty"`
+	Profiledn    *string `json:"profiledn,omitempty" yaml:"profiledn,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+func (p *AssociateUcsProfileToBladeParams) toSerialized() *serializedAssociateUcsProfileToBladeParams {
+	s := &serializedAssociateUcsProfileToBladeParams{}
+	if p.bladeid.ok {
+		s.Bladeid = &p.bladeid.v
+	}
+	if p.profiledn.ok {
+		s.Profiledn = &p.profiledn.v
+	}
+	if p.ucsmanagerid.ok {
+		s.Ucsmanagerid = &p.ucsmanagerid.v
+	}
+	return s
+}
+
+func (p *AssociateUcsProfileToBladeParams) fromSerialized(s *serializedAssociateUcsProfileToBladeParams) {
+	*p = AssociateUcsProfileToBladeParams{}
+	if s.Bladeid != nil {
+		p.SetBladeid(*s.Bladeid)
+	}
+	if s.Profiledn != nil {
+		p.SetProfiledn(*s.Profiledn)
+	}
+	if s.Ucsmanagerid != nil {
+		p.SetUcsmanagerid(*s.Ucsmanagerid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AssociateUcsProfileToBladeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AssociateUcsProfileToBladeParams) UnmarshalJSON(b []byte) error {
+	var s serializedAssociateUcsProfileToBladeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["ucsmanagerid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AssociateUcsProfileToBladeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AssociateUcsProfileToBladeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAssociateUcsProfileToBladeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AssociateUcsProfileToBladeParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams {
 	p := &AssociateUcsProfileToBladeParams{}
-	p.p = make(map[string]interface{})
-	p.p["bladeid"] = bladeid
-	p.p["profiledn"] = profiledn
-	p.p["ucsmanagerid"] = ucsmanagerid
+	p.SetBladeid(bladeid)
+	p.SetProfiledn(profiledn)
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
-// associate a profile to a blade
-func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
-	resp, err := s.cs.newRequest("associateUcsProfileToBlade", p.toURLValues())
+// associate a profile to a blade.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: bladeid, profiledn,
+// ucsmanagerid.
+func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams, opts ...CallOption) (*AssociateUcsProfileToBladeResponse, error) {
+	resp, err := s.cs.newRequest("associateUcsProfileToBlade", s.cs.encodeParams("associateUcsProfileToBlade", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -321,8 +517,8 @@ func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladePar
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -354,53 +550,132 @@ type AssociateUcsProfileToBladeResponse struct {
 }
 
 type DeleteUcsManagerParams struct {
-	p map[string]interface{}
+	ucsmanagerid optString
 }
 
-func (p *DeleteUcsManagerParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteUcsManagerParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
+	if p.ucsmanagerid.ok {
+		u.Set("ucsmanagerid", p.ucsmanagerid.v)
 	}
 	return u
 }
 
-func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteUcsManagerParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteUcsManagerParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteUcsManagerParams(u url.Values) (*DeleteUcsManagerParams, error) {
+	p := &DeleteUcsManagerParams{}
+	if err := checkParamNames("deleteUcsManager", u, "ucsmanagerid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["ucsmanagerid"]; found {
+		p.SetUcsmanagerid(u.Get("ucsmanagerid"))
 	}
-	p.p["ucsmanagerid"] = v
+	return p, nil
 }
 
+// SetUcsmanagerid sets the ucsmanagerid param. This param is required.
+func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
+	p.ucsmanagerid = optString{v: v, ok: true}
+}
+
+// ResetUcsmanagerid unsets the ucsmanagerid param
 func (p *DeleteUcsManagerParams) ResetUcsmanagerid() {
-	if p.p != nil && p.p["ucsmanagerid"] != nil {
-		delete(p.p, "ucsmanagerid")
-	}
+	p.ucsmanagerid = optString{}
 }
 
+// GetUcsmanagerid returns the ucsmanagerid param and if it is set
 func (p *DeleteUcsManagerParams) GetUcsmanagerid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ucsmanagerid.v, p.ucsmanagerid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteUcsManagerParams) Clone() *DeleteUcsManagerParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteUcsManagerParams) Equal(o *DeleteUcsManagerParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["ucsmanagerid"].(string)
-	return value, ok
+	return p.ucsmanagerid == o.ucsmanagerid
+}
+
+// serializedDeleteUcsManagerParams is used to (un)marshal DeleteUcsManagerParams using the API param names
+type serializedDeleteUcsManagerParams struct {
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+func (p *DeleteUcsManagerParams) toSerialized() *serializedDeleteUcsManagerParams {
+	s := &serializedDeleteUcsManagerParams{}
+	if p.ucsmanagerid.ok {
+		s.Ucsmanagerid = &p.ucsmanagerid.v
+	}
+	return s
+}
+
+func (p *DeleteUcsManagerParams) fromSerialized(s *serializedDeleteUcsManagerParams) {
+	*p = DeleteUcsManagerParams{}
+	if s.Ucsmanagerid != nil {
+		p.SetUcsmanagerid(*s.Ucsmanagerid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteUcsManagerParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteUcsManagerParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteUcsManagerParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteUcsManagerParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteUcsManagerParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteUcsManagerParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteUcsManagerParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams {
 	p := &DeleteUcsManagerParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
-// Delete a Ucs manager
-func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("deleteUcsManager", p.toURLValues())
+// Delete a Ucs manager.
+//
+// Required params: ucsmanagerid.
+func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams, opts ...CallOption) (*DeleteUcsManagerResponse, error) {
+	resp, err := s.cs.newRequest("deleteUcsManager", s.cs.encodeParams("deleteUcsManager", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -448,127 +723,230 @@ func (r *DeleteUcsManagerResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListUcsBladesParams struct {
-	p map[string]interface{}
+	keyword      optString
+	page         optInt
+	pagesize     optInt
+	ucsmanagerid optString
 }
 
-func (p *ListUcsBladesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUcsBladesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
+	if p.ucsmanagerid.ok {
+		u.Set("ucsmanagerid", p.ucsmanagerid.v)
 	}
 	return u
 }
 
-func (p *ListUcsBladesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListUcsBladesParams parses url.Values, for example taken from a raw API request,
+// into a new ListUcsBladesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUcsBladesParams(u url.Values) (*ListUcsBladesParams, error) {
+	p := &ListUcsBladesParams{}
+	if err := checkParamNames("listUcsBlades", u, "keyword", "page", "pagesize", "ucsmanagerid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["keyword"] = v
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["ucsmanagerid"]; found {
+		p.SetUcsmanagerid(u.Get("ucsmanagerid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListUcsBladesParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListUcsBladesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListUcsBladesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListUcsBladesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListUcsBladesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListUcsBladesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListUcsBladesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListUcsBladesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListUcsBladesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetUcsmanagerid sets the ucsmanagerid param. This param is required.
 func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
+	p.ucsmanagerid = optString{v: v, ok: true}
 }
 
+// ResetUcsmanagerid unsets the ucsmanagerid param
 func (p *ListUcsBladesParams) ResetUcsmanagerid() {
-	if p.p != nil && p.p["ucsmanagerid"] != nil {
-		delete(p.p, "ucsmanagerid")
-	}
+	p.ucsmanagerid = optString{}
 }
 
+// GetUcsmanagerid returns the ucsmanagerid param and if it is set
 func (p *ListUcsBladesParams) GetUcsmanagerid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ucsmanagerid.v, p.ucsmanagerid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUcsBladesParams) Clone() *ListUcsBladesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUcsBladesParams) Equal(o *ListUcsBladesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.ucsmanagerid == o.ucsmanagerid
+}
+
+// serializedListUcsBladesParams is used to (un)marshal ListUcsBladesParams using the API param names
+type serializedListUcsBladesParams struct {
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+func (p *ListUcsBladesParams) toSerialized() *serializedListUcsBladesParams {
+	s := &serializedListUcsBladesParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["ucsmanagerid"].(string)
-	return value, ok
+	if p.ucsmanagerid.ok {
+		s.Ucsmanagerid = &p.ucsmanagerid.v
+	}
+	return s
+}
+
+func (p *ListUcsBladesParams) fromSerialized(s *serializedListUcsBladesParams) {
+	*p = ListUcsBladesParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Ucsmanagerid != nil {
+		p.SetUcsmanagerid(*s.Ucsmanagerid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUcsBladesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUcsBladesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUcsBladesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUcsBladesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUcsBladesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUcsBladesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListUcsBladesParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams {
 	p := &ListUcsBladesParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
-// List ucs blades
-func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsBlades", p.toURLValues())
+// List ucs blades.
+//
+// Required params: ucsmanagerid.
+func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams, opts ...CallOption) (*ListUcsBladesResponse, error) {
+	resp, err := s.cs.newRequest("listUcsBlades", s.cs.encodeParams("listUcsBlades", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -597,153 +975,259 @@ type UcsBlade struct {
 }
 
 type ListUcsManagersParams struct {
-	p map[string]interface{}
+	id       optString
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	zoneid   optString
 }
 
-func (p *ListUcsManagersParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUcsManagersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListUcsManagersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListUcsManagersParams parses url.Values, for example taken from a raw API request,
+// into a new ListUcsManagersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUcsManagersParams(u url.Values) (*ListUcsManagersParams, error) {
+	p := &ListUcsManagersParams{}
+	if err := checkParamNames("listUcsManagers", u, "id", "keyword", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["id"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param.
+func (p *ListUcsManagersParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ListUcsManagersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListUcsManagersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListUcsManagersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListUcsManagersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListUcsManagersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListUcsManagersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListUcsManagersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListUcsManagersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListUcsManagersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListUcsManagersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListUcsManagersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListUcsManagersParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListUcsManagersParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListUcsManagersParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUcsManagersParams) Clone() *ListUcsManagersParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUcsManagersParams) Equal(o *ListUcsManagersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListUcsManagersParams is used to (un)marshal ListUcsManagersParams using the API param names
+type serializedListUcsManagersParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListUcsManagersParams) toSerialized() *serializedListUcsManagersParams {
+	s := &serializedListUcsManagersParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListUcsManagersParams) fromSerialized(s *serializedListUcsManagersParams) {
+	*p = ListUcsManagersParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUcsManagersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUcsManagersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUcsManagersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUcsManagersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUcsManagersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUcsManagersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListUcsManagersParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsManagersParams() *ListUcsManagersParams {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -791,9 +1275,8 @@ func (s *UCSService) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsM
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error) {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -821,9 +1304,9 @@ func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManag
 	return nil, l.Count, fmt.Errorf("There is more then one result for UcsManager UUID: %s!", id)
 }
 
-// List ucs manager
-func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
-	resp, err := s.cs.newRequest("listUcsManagers", p.toURLValues())
+// List ucs manager.
+func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams, opts ...CallOption) (*ListUcsManagersResponse, error) {
+	resp, err := s.cs.newRequest("listUcsManagers", s.cs.encodeParams("listUcsManagers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -851,127 +1334,230 @@ type UcsManager struct {
 }
 
 type ListUcsProfilesParams struct {
-	p map[string]interface{}
+	keyword      optString
+	page         optInt
+	pagesize     optInt
+	ucsmanagerid optString
 }
 
-func (p *ListUcsProfilesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUcsProfilesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
+	if p.ucsmanagerid.ok {
+		u.Set("ucsmanagerid", p.ucsmanagerid.v)
 	}
 	return u
 }
 
-func (p *ListUcsProfilesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListUcsProfilesParams parses url.Values, for example taken from a raw API request,
+// into a new ListUcsProfilesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUcsProfilesParams(u url.Values) (*ListUcsProfilesParams, error) {
+	p := &ListUcsProfilesParams{}
+	if err := checkParamNames("listUcsProfiles", u, "keyword", "page", "pagesize", "ucsmanagerid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["keyword"] = v
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["ucsmanagerid"]; found {
+		p.SetUcsmanagerid(u.Get("ucsmanagerid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListUcsProfilesParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListUcsProfilesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListUcsProfilesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListUcsProfilesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListUcsProfilesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListUcsProfilesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListUcsProfilesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListUcsProfilesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListUcsProfilesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetUcsmanagerid sets the ucsmanagerid param. This param is required.
 func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
+	p.ucsmanagerid = optString{v: v, ok: true}
 }
 
+// ResetUcsmanagerid unsets the ucsmanagerid param
 func (p *ListUcsProfilesParams) ResetUcsmanagerid() {
-	if p.p != nil && p.p["ucsmanagerid"] != nil {
-		delete(p.p, "ucsmanagerid")
-	}
+	p.ucsmanagerid = optString{}
 }
 
+// GetUcsmanagerid returns the ucsmanagerid param and if it is set
 func (p *ListUcsProfilesParams) GetUcsmanagerid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.ucsmanagerid.v, p.ucsmanagerid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUcsProfilesParams) Clone() *ListUcsProfilesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUcsProfilesParams) Equal(o *ListUcsProfilesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.ucsmanagerid == o.ucsmanagerid
+}
+
+// serializedListUcsProfilesParams is used to (un)marshal ListUcsProfilesParams using the API param names
+type serializedListUcsProfilesParams struct {
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+func (p *ListUcsProfilesParams) toSerialized() *serializedListUcsProfilesParams {
+	s := &serializedListUcsProfilesParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.ucsmanagerid.ok {
+		s.Ucsmanagerid = &p.ucsmanagerid.v
 	}
-	value, ok := p.p["ucsmanagerid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ListUcsProfilesParams) fromSerialized(s *serializedListUcsProfilesParams) {
+	*p = ListUcsProfilesParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Ucsmanagerid != nil {
+		p.SetUcsmanagerid(*s.Ucsmanagerid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUcsProfilesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUcsProfilesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUcsProfilesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUcsProfilesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUcsProfilesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUcsProfilesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListUcsProfilesParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams {
 	p := &ListUcsProfilesParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
-// List profile in ucs manager
-func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsProfiles", p.toURLValues())
+// List profile in ucs manager.
+//
+// Required params: ucsmanagerid.
+func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams, opts ...CallOption) (*ListUcsProfilesResponse, error) {
+	resp, err := s.cs.newRequest("listUcsProfiles", s.cs.encodeParams("listUcsProfiles", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,48 +53,63 @@ func (m *MockUCSServiceIface) EXPECT() *MockUCSServiceIfaceMockRecorder {
 }
 
 // AddUcsManager mocks base method.
-func (m *MockUCSServiceIface) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
+func (m *MockUCSServiceIface) AddUcsManager(p *AddUcsManagerParams, opts ...CallOption) (*AddUcsManagerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUcsManager", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddUcsManager", varargs...)
 	ret0, _ := ret[0].(*AddUcsManagerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddUcsManager indicates an expected call of AddUcsManager.
-func (mr *MockUCSServiceIfaceMockRecorder) AddUcsManager(p interface{}) *gomock.Call {
+func (mr *MockUCSServiceIfaceMockRecorder) AddUcsManager(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUcsManager", reflect.TypeOf((*MockUCSServiceIface)(nil).AddUcsManager), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUcsManager", reflect.TypeOf((*MockUCSServiceIface)(nil).AddUcsManager), varargs...)
 }
 
 // AssociateUcsProfileToBlade mocks base method.
-func (m *MockUCSServiceIface) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
+func (m *MockUCSServiceIface) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams, opts ...CallOption) (*AssociateUcsProfileToBladeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssociateUcsProfileToBlade", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssociateUcsProfileToBlade", varargs...)
 	ret0, _ := ret[0].(*AssociateUcsProfileToBladeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssociateUcsProfileToBlade indicates an expected call of AssociateUcsProfileToBlade.
-func (mr *MockUCSServiceIfaceMockRecorder) AssociateUcsProfileToBlade(p interface{}) *gomock.Call {
+func (mr *MockUCSServiceIfaceMockRecorder) AssociateUcsProfileToBlade(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateUcsProfileToBlade", reflect.TypeOf((*MockUCSServiceIface)(nil).AssociateUcsProfileToBlade), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateUcsProfileToBlade", reflect.TypeOf((*MockUCSServiceIface)(nil).AssociateUcsProfileToBlade), varargs...)
 }
 
 // DeleteUcsManager mocks base method.
-func (m *MockUCSServiceIface) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
+func (m *MockUCSServiceIface) DeleteUcsManager(p *DeleteUcsManagerParams, opts ...CallOption) (*DeleteUcsManagerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUcsManager", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUcsManager", varargs...)
 	ret0, _ := ret[0].(*DeleteUcsManagerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteUcsManager indicates an expected call of DeleteUcsManager.
-func (mr *MockUCSServiceIfaceMockRecorder) DeleteUcsManager(p interface{}) *gomock.Call {
+func (mr *MockUCSServiceIfaceMockRecorder) DeleteUcsManager(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUcsManager", reflect.TypeOf((*MockUCSServiceIface)(nil).DeleteUcsManager), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUcsManager", reflect.TypeOf((*MockUCSServiceIface)(nil).DeleteUcsManager), varargs...)
 }
 
 // GetUcsManagerByID mocks base method.
@@ -161,48 +176,63 @@ func (mr *MockUCSServiceIfaceMockRecorder) GetUcsManagerID(keyword interface{},
 }
 
 // ListUcsBlades mocks base method.
-func (m *MockUCSServiceIface) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+func (m *MockUCSServiceIface) ListUcsBlades(p *ListUcsBladesParams, opts ...CallOption) (*ListUcsBladesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUcsBlades", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsBlades", varargs...)
 	ret0, _ := ret[0].(*ListUcsBladesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUcsBlades indicates an expected call of ListUcsBlades.
-func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBlades(p interface{}) *gomock.Call {
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBlades(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBlades", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBlades), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBlades", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBlades), varargs...)
 }
 
 // ListUcsManagers mocks base method.
-func (m *MockUCSServiceIface) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
+func (m *MockUCSServiceIface) ListUcsManagers(p *ListUcsManagersParams, opts ...CallOption) (*ListUcsManagersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUcsManagers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsManagers", varargs...)
 	ret0, _ := ret[0].(*ListUcsManagersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUcsManagers indicates an expected call of ListUcsManagers.
-func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagers(p interface{}) *gomock.Call {
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagers", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagers", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagers), varargs...)
 }
 
 // ListUcsProfiles mocks base method.
-func (m *MockUCSServiceIface) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
+func (m *MockUCSServiceIface) ListUcsProfiles(p *ListUcsProfilesParams, opts ...CallOption) (*ListUcsProfilesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUcsProfiles", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsProfiles", varargs...)
 	ret0, _ := ret[0].(*ListUcsProfilesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUcsProfiles indicates an expected call of ListUcsProfiles.
-func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfiles(p interface{}) *gomock.Call {
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfiles(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfiles", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfiles), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfiles", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfiles), varargs...)
 }
 
 // NewAddUcsManagerParams mocks base method.
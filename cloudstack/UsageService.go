@@ -27,156 +27,253 @@ import (
 )
 
 type UsageServiceIface interface {
-	AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error)
+	AddTrafficMonitor(p *AddTrafficMonitorParams, opts ...CallOption) (*AddTrafficMonitorResponse, error)
 	NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams
-	AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficType(p *AddTrafficTypeParams, opts ...CallOption) (*AddTrafficTypeResponse, error)
 	NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams
-	DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error)
+	DeleteTrafficMonitor(p *DeleteTrafficMonitorParams, opts ...CallOption) (*DeleteTrafficMonitorResponse, error)
 	NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams
-	DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficType(p *DeleteTrafficTypeParams, opts ...CallOption) (*DeleteTrafficTypeResponse, error)
 	NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams
-	GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error)
+	GenerateUsageRecords(p *GenerateUsageRecordsParams, opts ...CallOption) (*GenerateUsageRecordsResponse, error)
 	NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams
-	ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitors(p *ListTrafficMonitorsParams, opts ...CallOption) (*ListTrafficMonitorsResponse, error)
 	NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams
-	ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams, opts ...CallOption) (*ListTrafficTypeImplementorsResponse, error)
 	NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams
-	ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListTrafficTypes(p *ListTrafficTypesParams, opts ...CallOption) (*ListTrafficTypesResponse, error)
 	NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams
 	GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
-	ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	ListUsageRecords(p *ListUsageRecordsParams, opts ...CallOption) (*ListUsageRecordsResponse, error)
 	NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams
-	ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	ListUsageTypes(p *ListUsageTypesParams, opts ...CallOption) (*ListUsageTypesResponse, error)
 	NewListUsageTypesParams() *ListUsageTypesParams
-	RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error)
+	RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams, opts ...CallOption) (*RemoveRawUsageRecordsResponse, error)
 	NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUsageRecordsParams
-	UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficType(p *UpdateTrafficTypeParams, opts ...CallOption) (*UpdateTrafficTypeResponse, error)
 	NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams
-	ListUsageServerMetrics(p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error)
+	ListUsageServerMetrics(p *ListUsageServerMetricsParams, opts ...CallOption) (*ListUsageServerMetricsResponse, error)
 	NewListUsageServerMetricsParams() *ListUsageServerMetricsParams
 }
 
 type AddTrafficMonitorParams struct {
-	p map[string]interface{}
+	excludezones optString
+	includezones optString
+	url          optString
+	zoneid       optString
 }
 
-func (p *AddTrafficMonitorParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddTrafficMonitorParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["excludezones"]; found {
-		u.Set("excludezones", v.(string))
+	if p.excludezones.ok {
+		u.Set("excludezones", p.excludezones.v)
 	}
-	if v, found := p.p["includezones"]; found {
-		u.Set("includezones", v.(string))
+	if p.includezones.ok {
+		u.Set("includezones", p.includezones.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddTrafficMonitorParams parses url.Values, for example taken from a raw API request,
+// into a new AddTrafficMonitorParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddTrafficMonitorParams(u url.Values) (*AddTrafficMonitorParams, error) {
+	p := &AddTrafficMonitorParams{}
+	if err := checkParamNames("addTrafficMonitor", u, "excludezones", "includezones", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["excludezones"]; found {
+		p.SetExcludezones(u.Get("excludezones"))
+	}
+	if _, found := u["includezones"]; found {
+		p.SetIncludezones(u.Get("includezones"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["excludezones"] = v
+	return p, nil
 }
 
+// SetExcludezones sets the excludezones param.
+func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
+	p.excludezones = optString{v: v, ok: true}
+}
+
+// ResetExcludezones unsets the excludezones param
 func (p *AddTrafficMonitorParams) ResetExcludezones() {
-	if p.p != nil && p.p["excludezones"] != nil {
-		delete(p.p, "excludezones")
-	}
+	p.excludezones = optString{}
 }
 
+// GetExcludezones returns the excludezones param and if it is set
 func (p *AddTrafficMonitorParams) GetExcludezones() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["excludezones"].(string)
-	return value, ok
+	return p.excludezones.v, p.excludezones.ok
 }
 
+// SetIncludezones sets the includezones param.
 func (p *AddTrafficMonitorParams) SetIncludezones(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["includezones"] = v
+	p.includezones = optString{v: v, ok: true}
 }
 
+// ResetIncludezones unsets the includezones param
 func (p *AddTrafficMonitorParams) ResetIncludezones() {
-	if p.p != nil && p.p["includezones"] != nil {
-		delete(p.p, "includezones")
-	}
+	p.includezones = optString{}
 }
 
+// GetIncludezones returns the includezones param and if it is set
 func (p *AddTrafficMonitorParams) GetIncludezones() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["includezones"].(string)
-	return value, ok
+	return p.includezones.v, p.includezones.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *AddTrafficMonitorParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *AddTrafficMonitorParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *AddTrafficMonitorParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *AddTrafficMonitorParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *AddTrafficMonitorParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *AddTrafficMonitorParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddTrafficMonitorParams) Clone() *AddTrafficMonitorParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddTrafficMonitorParams) Equal(o *AddTrafficMonitorParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.excludezones == o.excludezones &&
+		p.includezones == o.includezones &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedAddTrafficMonitorParams is used to (un)marshal AddTrafficMonitorParams using the API param names
+type serializedAddTrafficMonitorParams struct {
+	Excludezones *string `json:"excludezones,omitempty" yaml:"excludezones,omitempty"`
+	Includezones *string `json:"includezones,omitempty" yaml:"includezones,omitempty"`
+	Url          *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid       *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *AddTrafficMonitorParams) toSerialized() *serializedAddTrafficMonitorParams {
+	s := &serializedAddTrafficMonitorParams{}
+	if p.excludezones.ok {
+		s.Excludezones = &p.excludezones.v
+	}
+	if p.includezones.ok {
+		s.Includezones = &p.includezones.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *AddTrafficMonitorParams) fromSerialized(s *serializedAddTrafficMonitorParams) {
+	*p = AddTrafficMonitorParams{}
+	if s.Excludezones != nil {
+		p.SetExcludezones(*s.Excludezones)
+	}
+	if s.Includezones != nil {
+		p.SetIncludezones(*s.Includezones)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddTrafficMonitorParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddTrafficMonitorParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddTrafficMonitorParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddTrafficMonitorParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddTrafficMonitorParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddTrafficMonitorParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddTrafficMonitorParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams {
 	p := &AddTrafficMonitorParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Adds Traffic Monitor Host for Direct Network Usage
-func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficMonitor", p.toURLValues())
+// Adds Traffic Monitor Host for Direct Network Usage.
+//
+// Required params: url, zoneid.
+func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams, opts ...CallOption) (*AddTrafficMonitorResponse, error) {
+	resp, err := s.cs.newRequest("addTrafficMonitor", s.cs.encodeParams("addTrafficMonitor", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -200,246 +297,375 @@ type AddTrafficMonitorResponse struct {
 }
 
 type AddTrafficTypeParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddTrafficTypeParams) toURLValues() url.Values {
+	hypervnetworklabel optString
+	isolationmethod    optString
+	kvmnetworklabel    optString
+	ovm3networklabel   optString
+	physicalnetworkid  optString
+	traffictype        optString
+	vlan               optString
+	vmwarenetworklabel optString
+	xennetworklabel    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddTrafficTypeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hypervnetworklabel"]; found {
-		u.Set("hypervnetworklabel", v.(string))
+	if p.hypervnetworklabel.ok {
+		u.Set("hypervnetworklabel", p.hypervnetworklabel.v)
 	}
-	if v, found := p.p["isolationmethod"]; found {
-		u.Set("isolationmethod", v.(string))
+	if p.isolationmethod.ok {
+		u.Set("isolationmethod", p.isolationmethod.v)
 	}
-	if v, found := p.p["kvmnetworklabel"]; found {
-		u.Set("kvmnetworklabel", v.(string))
+	if p.kvmnetworklabel.ok {
+		u.Set("kvmnetworklabel", p.kvmnetworklabel.v)
 	}
-	if v, found := p.p["ovm3networklabel"]; found {
-		u.Set("ovm3networklabel", v.(string))
+	if p.ovm3networklabel.ok {
+		u.Set("ovm3networklabel", p.ovm3networklabel.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["vmwarenetworklabel"]; found {
-		u.Set("vmwarenetworklabel", v.(string))
+	if p.vmwarenetworklabel.ok {
+		u.Set("vmwarenetworklabel", p.vmwarenetworklabel.v)
 	}
-	if v, found := p.p["xennetworklabel"]; found {
-		u.Set("xennetworklabel", v.(string))
+	if p.xennetworklabel.ok {
+		u.Set("xennetworklabel", p.xennetworklabel.v)
 	}
 	return u
 }
 
-func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddTrafficTypeParams parses url.Values, for example taken from a raw API request,
+// into a new AddTrafficTypeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddTrafficTypeParams(u url.Values) (*AddTrafficTypeParams, error) {
+	p := &AddTrafficTypeParams{}
+	if err := checkParamNames("addTrafficType", u, "hypervnetworklabel", "isolationmethod", "kvmnetworklabel", "ovm3networklabel", "physicalnetworkid", "traffictype", "vlan", "vmwarenetworklabel", "xennetworklabel"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hypervnetworklabel"]; found {
+		p.SetHypervnetworklabel(u.Get("hypervnetworklabel"))
+	}
+	if _, found := u["isolationmethod"]; found {
+		p.SetIsolationmethod(u.Get("isolationmethod"))
+	}
+	if _, found := u["kvmnetworklabel"]; found {
+		p.SetKvmnetworklabel(u.Get("kvmnetworklabel"))
+	}
+	if _, found := u["ovm3networklabel"]; found {
+		p.SetOvm3networklabel(u.Get("ovm3networklabel"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
 	}
-	p.p["hypervnetworklabel"] = v
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["vmwarenetworklabel"]; found {
+		p.SetVmwarenetworklabel(u.Get("vmwarenetworklabel"))
+	}
+	if _, found := u["xennetworklabel"]; found {
+		p.SetXennetworklabel(u.Get("xennetworklabel"))
+	}
+	return p, nil
 }
 
+// SetHypervnetworklabel sets the hypervnetworklabel param.
+func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
+	p.hypervnetworklabel = optString{v: v, ok: true}
+}
+
+// ResetHypervnetworklabel unsets the hypervnetworklabel param
 func (p *AddTrafficTypeParams) ResetHypervnetworklabel() {
-	if p.p != nil && p.p["hypervnetworklabel"] != nil {
-		delete(p.p, "hypervnetworklabel")
-	}
+	p.hypervnetworklabel = optString{}
 }
 
+// GetHypervnetworklabel returns the hypervnetworklabel param and if it is set
 func (p *AddTrafficTypeParams) GetHypervnetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervnetworklabel"].(string)
-	return value, ok
+	return p.hypervnetworklabel.v, p.hypervnetworklabel.ok
 }
 
+// SetIsolationmethod sets the isolationmethod param.
 func (p *AddTrafficTypeParams) SetIsolationmethod(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isolationmethod"] = v
+	p.isolationmethod = optString{v: v, ok: true}
 }
 
+// ResetIsolationmethod unsets the isolationmethod param
 func (p *AddTrafficTypeParams) ResetIsolationmethod() {
-	if p.p != nil && p.p["isolationmethod"] != nil {
-		delete(p.p, "isolationmethod")
-	}
+	p.isolationmethod = optString{}
 }
 
+// GetIsolationmethod returns the isolationmethod param and if it is set
 func (p *AddTrafficTypeParams) GetIsolationmethod() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isolationmethod"].(string)
-	return value, ok
+	return p.isolationmethod.v, p.isolationmethod.ok
 }
 
+// SetKvmnetworklabel sets the kvmnetworklabel param.
 func (p *AddTrafficTypeParams) SetKvmnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["kvmnetworklabel"] = v
+	p.kvmnetworklabel = optString{v: v, ok: true}
 }
 
+// ResetKvmnetworklabel unsets the kvmnetworklabel param
 func (p *AddTrafficTypeParams) ResetKvmnetworklabel() {
-	if p.p != nil && p.p["kvmnetworklabel"] != nil {
-		delete(p.p, "kvmnetworklabel")
-	}
+	p.kvmnetworklabel = optString{}
 }
 
+// GetKvmnetworklabel returns the kvmnetworklabel param and if it is set
 func (p *AddTrafficTypeParams) GetKvmnetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["kvmnetworklabel"].(string)
-	return value, ok
+	return p.kvmnetworklabel.v, p.kvmnetworklabel.ok
 }
 
+// SetOvm3networklabel sets the ovm3networklabel param.
 func (p *AddTrafficTypeParams) SetOvm3networklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ovm3networklabel"] = v
+	p.ovm3networklabel = optString{v: v, ok: true}
 }
 
+// ResetOvm3networklabel unsets the ovm3networklabel param
 func (p *AddTrafficTypeParams) ResetOvm3networklabel() {
-	if p.p != nil && p.p["ovm3networklabel"] != nil {
-		delete(p.p, "ovm3networklabel")
-	}
+	p.ovm3networklabel = optString{}
 }
 
+// GetOvm3networklabel returns the ovm3networklabel param and if it is set
 func (p *AddTrafficTypeParams) GetOvm3networklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ovm3networklabel"].(string)
-	return value, ok
+	return p.ovm3networklabel.v, p.ovm3networklabel.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *AddTrafficTypeParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *AddTrafficTypeParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *AddTrafficTypeParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetTraffictype sets the traffictype param. This param is required.
 func (p *AddTrafficTypeParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *AddTrafficTypeParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *AddTrafficTypeParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	return p.traffictype.v, p.traffictype.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *AddTrafficTypeParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *AddTrafficTypeParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *AddTrafficTypeParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetVmwarenetworklabel sets the vmwarenetworklabel param.
 func (p *AddTrafficTypeParams) SetVmwarenetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmwarenetworklabel"] = v
+	p.vmwarenetworklabel = optString{v: v, ok: true}
 }
 
+// ResetVmwarenetworklabel unsets the vmwarenetworklabel param
 func (p *AddTrafficTypeParams) ResetVmwarenetworklabel() {
-	if p.p != nil && p.p["vmwarenetworklabel"] != nil {
-		delete(p.p, "vmwarenetworklabel")
-	}
+	p.vmwarenetworklabel = optString{}
 }
 
+// GetVmwarenetworklabel returns the vmwarenetworklabel param and if it is set
 func (p *AddTrafficTypeParams) GetVmwarenetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vmwarenetworklabel"].(string)
-	return value, ok
+	return p.vmwarenetworklabel.v, p.vmwarenetworklabel.ok
 }
 
+// SetXennetworklabel sets the xennetworklabel param.
 func (p *AddTrafficTypeParams) SetXennetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["xennetworklabel"] = v
+	p.xennetworklabel = optString{v: v, ok: true}
 }
 
+// ResetXennetworklabel unsets the xennetworklabel param
 func (p *AddTrafficTypeParams) ResetXennetworklabel() {
-	if p.p != nil && p.p["xennetworklabel"] != nil {
-		delete(p.p, "xennetworklabel")
-	}
+	p.xennetworklabel = optString{}
 }
 
+// GetXennetworklabel returns the xennetworklabel param and if it is set
 func (p *AddTrafficTypeParams) GetXennetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.xennetworklabel.v, p.xennetworklabel.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddTrafficTypeParams) Clone() *AddTrafficTypeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddTrafficTypeParams) Equal(o *AddTrafficTypeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hypervnetworklabel == o.hypervnetworklabel &&
+		p.isolationmethod == o.isolationmethod &&
+		p.kvmnetworklabel == o.kvmnetworklabel &&
+		p.ovm3networklabel == o.ovm3networklabel &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.traffictype == o.traffictype &&
+		p.vlan == o.vlan &&
+		p.vmwarenetworklabel == o.vmwarenetworklabel &&
+		p.xennetworklabel == o.xennetworklabel
+}
+
+// serializedAddTrafficTypeParams is used to (un)marshal AddTrafficTypeParams using the API param names
+type serializedAddTrafficTypeParams struct {
+	Hypervnetworklabel *string `json:"hypervnetworklabel,omitempty" yaml:"hypervnetworklabel,omitempty"`
+	Isolationmethod    *string `json:"isolationmethod,omitempty" yaml:"isolationmethod,omitempty"`
+	Kvmnetworklabel    *string `json:"kvmnetworklabel,omitempty" yaml:"kvmnetworklabel,omitempty"`
+	Ovm3networklabel   *string `json:"ovm3networklabel,omitempty" yaml:"ovm3networklabel,omitempty"`
+	Physicalnetworkid  *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Traffictype        *string `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+	Vlan               *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vmwarenetworklabel *string `json:"vmwarenetworklabel,omitempty" yaml:"vmwarenetworklabel,omitempty"`
+	Xennetworklabel    *string `json:"xennetworklabel,omitempty" yaml:"xennetworklabel,omitempty"`
+}
+
+func (p *AddTrafficTypeParams) toSerialized() *serializedAddTrafficTypeParams {
+	s := &serializedAddTrafficTypeParams{}
+	if p.hypervnetworklabel.ok {
+		s.Hypervnetworklabel = &p.hypervnetworklabel.v
+	}
+	if p.isolationmethod.ok {
+		s.Isolationmethod = &p.isolationmethod.v
+	}
+	if p.kvmnetworklabel.ok {
+		s.Kvmnetworklabel = &p.kvmnetworklabel.v
+	}
+	if p.ovm3networklabel.ok {
+		s.Ovm3networklabel = &p.ovm3networklabel.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.vmwarenetworklabel.ok {
+		s.Vmwarenetworklabel = &p.vmwarenetworklabel.v
+	}
+	if p.xennetworklabel.ok {
+		s.Xennetworklabel = &p.xennetworklabel.v
 	}
-	value, ok := p.p["xennetworklabel"].(string)
-	return value, ok
+	return s
+}
+
+func (p *AddTrafficTypeParams) fromSerialized(s *serializedAddTrafficTypeParams) {
+	*p = AddTrafficTypeParams{}
+	if s.Hypervnetworklabel != nil {
+		p.SetHypervnetworklabel(*s.Hypervnetworklabel)
+	}
+	if s.Isolationmethod != nil {
+		p.SetIsolationmethod(*s.Isolationmethod)
+	}
+	if s.Kvmnetworklabel != nil {
+		p.SetKvmnetworklabel(*s.Kvmnetworklabel)
+	}
+	if s.Ovm3networklabel != nil {
+		p.SetOvm3networklabel(*s.Ovm3networklabel)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Vmwarenetworklabel != nil {
+		p.SetVmwarenetworklabel(*s.Vmwarenetworklabel)
+	}
+	if s.Xennetworklabel != nil {
+		p.SetXennetworklabel(*s.Xennetworklabel)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddTrafficTypeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddTrafficTypeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddTrafficTypeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddTrafficTypeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddTrafficTypeParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams {
 	p := &AddTrafficTypeParams{}
-	p.p = make(map[string]interface{})
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["traffictype"] = traffictype
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetTraffictype(traffictype)
 	return p
 }
 
-// Adds traffic type to a physical network
-func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficType", p.toURLValues())
+// Adds traffic type to a physical network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: physicalnetworkid,
+// traffictype.
+func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams, opts ...CallOption) (*AddTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequest("addTrafficType", s.cs.encodeParams("addTrafficType", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -450,8 +676,8 @@ func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeR
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -486,53 +712,132 @@ type AddTrafficTypeResponse struct {
 }
 
 type DeleteTrafficMonitorParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteTrafficMonitorParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteTrafficMonitorParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteTrafficMonitorParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteTrafficMonitorParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteTrafficMonitorParams(u url.Values) (*DeleteTrafficMonitorParams, error) {
+	p := &DeleteTrafficMonitorParams{}
+	if err := checkParamNames("deleteTrafficMonitor", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteTrafficMonitorParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteTrafficMonitorParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteTrafficMonitorParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteTrafficMonitorParams) Clone() *DeleteTrafficMonitorParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteTrafficMonitorParams) Equal(o *DeleteTrafficMonitorParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteTrafficMonitorParams is used to (un)marshal DeleteTrafficMonitorParams using the API param names
+type serializedDeleteTrafficMonitorParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteTrafficMonitorParams) toSerialized() *serializedDeleteTrafficMonitorParams {
+	s := &serializedDeleteTrafficMonitorParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteTrafficMonitorParams) fromSerialized(s *serializedDeleteTrafficMonitorParams) {
+	*p = DeleteTrafficMonitorParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteTrafficMonitorParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteTrafficMonitorParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteTrafficMonitorParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteTrafficMonitorParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteTrafficMonitorParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteTrafficMonitorParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteTrafficMonitorParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams {
 	p := &DeleteTrafficMonitorParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes an traffic monitor host.
-func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficMonitor", p.toURLValues())
+//
+// Required params: id.
+func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams, opts ...CallOption) (*DeleteTrafficMonitorResponse, error) {
+	resp, err := s.cs.newRequest("deleteTrafficMonitor", s.cs.encodeParams("deleteTrafficMonitor", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -580,53 +885,133 @@ func (r *DeleteTrafficMonitorResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DeleteTrafficTypeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteTrafficTypeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteTrafficTypeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteTrafficTypeParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteTrafficTypeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteTrafficTypeParams(u url.Values) (*DeleteTrafficTypeParams, error) {
+	p := &DeleteTrafficTypeParams{}
+	if err := checkParamNames("deleteTrafficType", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteTrafficTypeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteTrafficTypeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteTrafficTypeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteTrafficTypeParams) Clone() *DeleteTrafficTypeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteTrafficTypeParams) Equal(o *DeleteTrafficTypeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteTrafficTypeParams is used to (un)marshal DeleteTrafficTypeParams using the API param names
+type serializedDeleteTrafficTypeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteTrafficTypeParams) toSerialized() *serializedDeleteTrafficTypeParams {
+	s := &serializedDeleteTrafficTypeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteTrafficTypeParams) fromSerialized(s *serializedDeleteTrafficTypeParams) {
+	*p = DeleteTrafficTypeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteTrafficTypeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteTrafficTypeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteTrafficTypeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteTrafficTypeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteTrafficTypeParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams {
 	p := &DeleteTrafficTypeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes traffic type of a physical network
-func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficType", p.toURLValues())
+// Deletes traffic type of a physical network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams, opts ...CallOption) (*DeleteTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequest("deleteTrafficType", s.cs.encodeParams("deleteTrafficType", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -637,8 +1022,8 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -662,102 +1047,194 @@ type DeleteTrafficTypeResponse struct {
 }
 
 type GenerateUsageRecordsParams struct {
-	p map[string]interface{}
+	domainid  optString
+	enddate   optString
+	startdate optString
 }
 
-func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GenerateUsageRecordsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
 	return u
 }
 
-func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGenerateUsageRecordsParams parses url.Values, for example taken from a raw API request,
+// into a new GenerateUsageRecordsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGenerateUsageRecordsParams(u url.Values) (*GenerateUsageRecordsParams, error) {
+	p := &GenerateUsageRecordsParams{}
+	if err := checkParamNames("generateUsageRecords", u, "domainid", "enddate", "startdate"); err != nil {
+		return nil, err
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
 	}
-	p.p["domainid"] = v
+	return p, nil
 }
 
+// SetDomainid sets the domainid param.
+func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
 func (p *GenerateUsageRecordsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *GenerateUsageRecordsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEnddate sets the enddate param. This param is required.
 func (p *GenerateUsageRecordsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
+	p.enddate = optString{v: v, ok: true}
 }
 
+// ResetEnddate unsets the enddate param
 func (p *GenerateUsageRecordsParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *GenerateUsageRecordsParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetStartdate sets the startdate param. This param is required.
 func (p *GenerateUsageRecordsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *GenerateUsageRecordsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *GenerateUsageRecordsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.startdate.v, p.startdate.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GenerateUsageRecordsParams) Clone() *GenerateUsageRecordsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GenerateUsageRecordsParams) Equal(o *GenerateUsageRecordsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.domainid == o.domainid &&
+		p.enddate == o.enddate &&
+		p.startdate == o.startdate
+}
+
+// serializedGenerateUsageRecordsParams is used to (un)marshal GenerateUsageRecordsParams using the API param names
+type serializedGenerateUsageRecordsParams struct {
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enddate   *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Startdate *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+}
+
+func (p *GenerateUsageRecordsParams) toSerialized() *serializedGenerateUsageRecordsParams {
+	s := &serializedGenerateUsageRecordsParams{}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	return s
+}
+
+func (p *GenerateUsageRecordsParams) fromSerialized(s *serializedGenerateUsageRecordsParams) {
+	*p = GenerateUsageRecordsParams{}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GenerateUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GenerateUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	var s serializedGenerateUsageRecordsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GenerateUsageRecordsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GenerateUsageRecordsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGenerateUsageRecordsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GenerateUsageRecordsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams {
 	p := &GenerateUsageRecordsParams{}
-	p.p = make(map[string]interface{})
-	p.p["enddate"] = enddate
-	p.p["startdate"] = startdate
+	p.SetEnddate(enddate)
+	p.SetStartdate(startdate)
 	return p
 }
 
-// Generates usage records. This will generate records only if there any records to be generated, i.e if the scheduled usage job was not run or failed
-func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("generateUsageRecords", p.toURLValues())
+// Generates usage records. This will generate records only if there any records to be generated,
+// i.e if the scheduled usage job was not run or failed.
+//
+// Required params: enddate, startdate.
+func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams, opts ...CallOption) (*GenerateUsageRecordsResponse, error) {
+	resp, err := s.cs.newRequest("generateUsageRecords", s.cs.encodeParams("generateUsageRecords", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -805,127 +1282,230 @@ func (r *GenerateUsageRecordsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListTrafficMonitorsParams struct {
-	p map[string]interface{}
+	keyword  optString
+	page     optInt
+	pagesize optInt
+	zoneid   optString
 }
 
-func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListTrafficMonitorsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListTrafficMonitorsParams parses url.Values, for example taken from a raw API request,
+// into a new ListTrafficMonitorsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListTrafficMonitorsParams(u url.Values) (*ListTrafficMonitorsParams, error) {
+	p := &ListTrafficMonitorsParams{}
+	if err := checkParamNames("listTrafficMonitors", u, "keyword", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["keyword"] = v
+	return p, nil
 }
 
+// SetKeyword sets the keyword param.
+func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
 func (p *ListTrafficMonitorsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListTrafficMonitorsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListTrafficMonitorsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListTrafficMonitorsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListTrafficMonitorsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListTrafficMonitorsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListTrafficMonitorsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListTrafficMonitorsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *ListTrafficMonitorsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListTrafficMonitorsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListTrafficMonitorsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListTrafficMonitorsParams) Clone() *ListTrafficMonitorsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListTrafficMonitorsParams) Equal(o *ListTrafficMonitorsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListTrafficMonitorsParams is used to (un)marshal ListTrafficMonitorsParams using the API param names
+type serializedListTrafficMonitorsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListTrafficMonitorsParams) toSerialized() *serializedListTrafficMonitorsParams {
+	s := &serializedListTrafficMonitorsParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListTrafficMonitorsParams) fromSerialized(s *serializedListTrafficMonitorsParams) {
+	*p = ListTrafficMonitorsParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListTrafficMonitorsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListTrafficMonitorsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListTrafficMonitorsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListTrafficMonitorsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListTrafficMonitorsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListTrafficMonitorsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListTrafficMonitorsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams {
 	p := &ListTrafficMonitorsParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // List traffic monitor Hosts.
-func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficMonitors", p.toURLValues())
+//
+// Required params: zoneid.
+func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams, opts ...CallOption) (*ListTrafficMonitorsResponse, error) {
+	resp, err := s.cs.newRequest("listTrafficMonitors", s.cs.encodeParams("listTrafficMonitors", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -954,126 +1534,228 @@ type TrafficMonitor struct {
 }
 
 type ListTrafficTypeImplementorsParams struct {
-	p map[string]interface{}
+	keyword     optString
+	page        optInt
+	pagesize    optInt
+	traffictype optString
 }
 
-func (p *ListTrafficTypeImplementorsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListTrafficTypeImplementorsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if p.traffictype.ok {
+		u.Set("traffictype", p.traffictype.v)
 	}
 	return u
 }
 
-func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListTrafficTypeImplementorsParams parses url.Values, for example taken from a raw API request,
+// into a new ListTrafficTypeImplementorsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListTrafficTypeImplementorsParams(u url.Values) (*ListTrafficTypeImplementorsParams, error) {
+	p := &ListTrafficTypeImplementorsParams{}
+	if err := checkParamNames("listTrafficTypeImplementors", u, "keyword", "page", "pagesize", "traffictype"); err != nil {
+		return nil, err
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["traffictype"]; found {
+		p.SetTraffictype(u.Get("traffictype"))
 	}
-	p.p["keyword"] = v
+	return p, nil
 }
 
+// SetKeyword sets the keyword param.
+func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
 func (p *ListTrafficTypeImplementorsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListTrafficTypeImplementorsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListTrafficTypeImplementorsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListTrafficTypeImplementorsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListTrafficTypeImplementorsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListTrafficTypeImplementorsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListTrafficTypeImplementorsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListTrafficTypeImplementorsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetTraffictype sets the traffictype param.
 func (p *ListTrafficTypeImplementorsParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
+	p.traffictype = optString{v: v, ok: true}
 }
 
+// ResetTraffictype unsets the traffictype param
 func (p *ListTrafficTypeImplementorsParams) ResetTraffictype() {
-	if p.p != nil && p.p["traffictype"] != nil {
-		delete(p.p, "traffictype")
-	}
+	p.traffictype = optString{}
 }
 
+// GetTraffictype returns the traffictype param and if it is set
 func (p *ListTrafficTypeImplementorsParams) GetTraffictype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.traffictype.v, p.traffictype.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListTrafficTypeImplementorsParams) Clone() *ListTrafficTypeImplementorsParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["traffictype"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListTrafficTypeImplementorsParams) Equal(o *ListTrafficTypeImplementorsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.traffictype == o.traffictype
+}
+
+// serializedListTrafficTypeImplementorsParams is used to (un)marshal ListTrafficTypeImplementorsParams using the API param names
+type serializedListTrafficTypeImplementorsParams struct {
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Traffictype *string `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+func (p *ListTrafficTypeImplementorsParams) toSerialized() *serializedListTrafficTypeImplementorsParams {
+	s := &serializedListTrafficTypeImplementorsParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.traffictype.ok {
+		s.Traffictype = &p.traffictype.v
+	}
+	return s
+}
+
+func (p *ListTrafficTypeImplementorsParams) fromSerialized(s *serializedListTrafficTypeImplementorsParams) {
+	*p = ListTrafficTypeImplementorsParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Traffictype != nil {
+		p.SetTraffictype(*s.Traffictype)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListTrafficTypeImplementorsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListTrafficTypeImplementorsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListTrafficTypeImplementorsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListTrafficTypeImplementorsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListTrafficTypeImplementorsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListTrafficTypeImplementorsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListTrafficTypeImplementorsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams {
 	p := &ListTrafficTypeImplementorsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists implementors of implementor of a network traffic type or implementors of all network traffic types
-func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypeImplementors", p.toURLValues())
+// Lists implementors of implementor of a network traffic type or implementors of all network
+// traffic types.
+func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams, opts ...CallOption) (*ListTrafficTypeImplementorsResponse, error) {
+	resp, err := s.cs.newRequest("listTrafficTypeImplementors", s.cs.encodeParams("listTrafficTypeImplementors", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1099,131 +1781,231 @@ type TrafficTypeImplementor struct {
 }
 
 type ListTrafficTypesParams struct {
-	p map[string]interface{}
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
 }
 
-func (p *ListTrafficTypesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListTrafficTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
 	return u
 }
 
-func (p *ListTrafficTypesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListTrafficTypesParams parses url.Values, for example taken from a raw API request,
+// into a new ListTrafficTypesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListTrafficTypesParams(u url.Values) (*ListTrafficTypesParams, error) {
+	p := &ListTrafficTypesParams{}
+	if err := checkParamNames("listTrafficTypes", u, "keyword", "page", "pagesize", "physicalnetworkid"); err != nil {
+		return nil, err
 	}
-	p.p["keyword"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	return p, nil
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListTrafficTypesParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListTrafficTypesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListTrafficTypesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListTrafficTypesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListTrafficTypesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListTrafficTypesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListTrafficTypesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListTrafficTypesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListTrafficTypesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *ListTrafficTypesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListTrafficTypesParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListTrafficTypesParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListTrafficTypesParams) Clone() *ListTrafficTypesParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListTrafficTypesParams) Equal(o *ListTrafficTypesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid
+}
+
+// serializedListTrafficTypesParams is used to (un)marshal ListTrafficTypesParams using the API param names
+type serializedListTrafficTypesParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+func (p *ListTrafficTypesParams) toSerialized() *serializedListTrafficTypesParams {
+	s := &serializedListTrafficTypesParams{}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	return s
+}
+
+func (p *ListTrafficTypesParams) fromSerialized(s *serializedListTrafficTypesParams) {
+	*p = ListTrafficTypesParams{}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListTrafficTypesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListTrafficTypesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListTrafficTypesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListTrafficTypesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListTrafficTypesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListTrafficTypesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListTrafficTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams {
 	p := &ListTrafficTypesParams{}
-	p.p = make(map[string]interface{})
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetPhysicalnetworkid(physicalnetworkid)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTrafficTypesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetKeyword(keyword)
+	p.SetPhysicalnetworkid(physicalnetworkid)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1255,8 +2037,10 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 }
 
 // Lists traffic types of a given physical network.
-func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypes", p.toURLValues())
+//
+// Required params: physicalnetworkid.
+func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams, opts ...CallOption) (*ListTrafficTypesResponse, error) {
+	resp, err := s.cs.newRequest("listTrafficTypes", s.cs.encodeParams("listTrafficTypes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1287,372 +2071,547 @@ type TrafficType struct {
 }
 
 type ListUsageRecordsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUsageRecordsParams) toURLValues() url.Values {
+	account     optString
+	accountid   optString
+	domainid    optString
+	enddate     optString
+	includetags optBool
+	isrecursive optBool
+	keyword     optString
+	oldformat   optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	startdate   optString
+	type_       optInt64
+	usageid     optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUsageRecordsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.accountid.ok {
+		u.Set("accountid", p.accountid.v)
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.includetags.ok {
+		u.Set("includetags", strconv.FormatBool(p.includetags.v))
 	}
-	if v, found := p.p["includetags"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("includetags", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.oldformat.ok {
+		u.Set("oldformat", strconv.FormatBool(p.oldformat.v))
 	}
-	if v, found := p.p["oldformat"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("oldformat", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
+	}
+	if p.type_.ok {
+		u.Set("type", strconv.FormatInt(p.type_.v, 10))
+	}
+	if p.usageid.ok {
+		u.Set("usageid", p.usageid.v)
+	}
+	return u
+}
+
+// ParseListUsageRecordsParams parses url.Values, for example taken from a raw API request,
+// into a new ListUsageRecordsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUsageRecordsParams(u url.Values) (*ListUsageRecordsParams, error) {
+	p := &ListUsageRecordsParams{}
+	if err := checkParamNames("listUsageRecords", u, "account", "accountid", "domainid", "enddate", "includetags", "isrecursive", "keyword", "oldformat", "page", "pagesize", "projectid", "startdate", "type", "usageid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["accountid"]; found {
+		p.SetAccountid(u.Get("accountid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["includetags"]; found {
+		v, err := strconv.ParseBool(u.Get("includetags"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param includetags: %v", err)
+		}
+		p.SetIncludetags(v)
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["oldformat"]; found {
+		v, err := strconv.ParseBool(u.Get("oldformat"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param oldformat: %v", err)
+		}
+		p.SetOldformat(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
 	}
-	if v, found := p.p["type"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("type", vv)
+	if _, found := u["type"]; found {
+		v, err := strconv.ParseInt(u.Get("type"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param type: %v", err)
+		}
+		p.SetType(v)
 	}
-	if v, found := p.p["usageid"]; found {
-		u.Set("usageid", v.(string))
+	if _, found := u["usageid"]; found {
+		p.SetUsageid(u.Get("usageid"))
 	}
-	return u
+	return p, nil
 }
 
+// SetAccount sets the account param.
 func (p *ListUsageRecordsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListUsageRecordsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListUsageRecordsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccountid sets the accountid param.
 func (p *ListUsageRecordsParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
+	p.accountid = optString{v: v, ok: true}
 }
 
+// ResetAccountid unsets the accountid param
 func (p *ListUsageRecordsParams) ResetAccountid() {
-	if p.p != nil && p.p["accountid"] != nil {
-		delete(p.p, "accountid")
-	}
+	p.accountid = optString{}
 }
 
+// GetAccountid returns the accountid param and if it is set
 func (p *ListUsageRecordsParams) GetAccountid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accountid"].(string)
-	return value, ok
+	return p.accountid.v, p.accountid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListUsageRecordsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListUsageRecordsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListUsageRecordsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEnddate sets the enddate param. This param is required.
 func (p *ListUsageRecordsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
+	p.enddate = optString{v: v, ok: true}
 }
 
+// ResetEnddate unsets the enddate param
 func (p *ListUsageRecordsParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *ListUsageRecordsParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetIncludetags sets the includetags param.
 func (p *ListUsageRecordsParams) SetIncludetags(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["includetags"] = v
+	p.includetags = optBool{v: v, ok: true}
 }
 
+// ResetIncludetags unsets the includetags param
 func (p *ListUsageRecordsParams) ResetIncludetags() {
-	if p.p != nil && p.p["includetags"] != nil {
-		delete(p.p, "includetags")
-	}
+	p.includetags = optBool{}
 }
 
+// GetIncludetags returns the includetags param and if it is set
 func (p *ListUsageRecordsParams) GetIncludetags() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["includetags"].(bool)
-	return value, ok
+	return p.includetags.v, p.includetags.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListUsageRecordsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListUsageRecordsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListUsageRecordsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListUsageRecordsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListUsageRecordsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListUsageRecordsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetOldformat sets the oldformat param.
 func (p *ListUsageRecordsParams) SetOldformat(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["oldformat"] = v
+	p.oldformat = optBool{v: v, ok: true}
 }
 
+// ResetOldformat unsets the oldformat param
 func (p *ListUsageRecordsParams) ResetOldformat() {
-	if p.p != nil && p.p["oldformat"] != nil {
-		delete(p.p, "oldformat")
-	}
+	p.oldformat = optBool{}
 }
 
+// GetOldformat returns the oldformat param and if it is set
 func (p *ListUsageRecordsParams) GetOldformat() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["oldformat"].(bool)
-	return value, ok
+	return p.oldformat.v, p.oldformat.ok
 }
 
+// SetPage sets the page param.
 func (p *ListUsageRecordsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListUsageRecordsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListUsageRecordsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListUsageRecordsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListUsageRecordsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListUsageRecordsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListUsageRecordsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListUsageRecordsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListUsageRecordsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetStartdate sets the startdate param. This param is required.
 func (p *ListUsageRecordsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *ListUsageRecordsParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *ListUsageRecordsParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	return p.startdate.v, p.startdate.ok
 }
 
+// SetType sets the type param.
 func (p *ListUsageRecordsParams) SetType(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optInt64{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListUsageRecordsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optInt64{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListUsageRecordsParams) GetType() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(int64)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetUsageid sets the usageid param.
 func (p *ListUsageRecordsParams) SetUsageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usageid"] = v
+	p.usageid = optString{v: v, ok: true}
 }
 
+// ResetUsageid unsets the usageid param
 func (p *ListUsageRecordsParams) ResetUsageid() {
-	if p.p != nil && p.p["usageid"] != nil {
-		delete(p.p, "usageid")
-	}
+	p.usageid = optString{}
 }
 
+// GetUsageid returns the usageid param and if it is set
 func (p *ListUsageRecordsParams) GetUsageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.usageid.v, p.usageid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUsageRecordsParams) Clone() *ListUsageRecordsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUsageRecordsParams) Equal(o *ListUsageRecordsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accountid == o.accountid &&
+		p.domainid == o.domainid &&
+		p.enddate == o.enddate &&
+		p.includetags == o.includetags &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.oldformat == o.oldformat &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.startdate == o.startdate &&
+		p.type_ == o.type_ &&
+		p.usageid == o.usageid
+}
+
+// serializedListUsageRecordsParams is used to (un)marshal ListUsageRecordsParams using the API param names
+type serializedListUsageRecordsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountid   *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enddate     *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Includetags *bool   `json:"includetags,omitempty" yaml:"includetags,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Oldformat   *bool   `json:"oldformat,omitempty" yaml:"oldformat,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Startdate   *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type        *int64  `json:"type,omitempty" yaml:"type,omitempty"`
+	Usageid     *string `json:"usageid,omitempty" yaml:"usageid,omitempty"`
+}
+
+func (p *ListUsageRecordsParams) toSerialized() *serializedListUsageRecordsParams {
+	s := &serializedListUsageRecordsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accountid.ok {
+		s.Accountid = &p.accountid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.includetags.ok {
+		s.Includetags = &p.includetags.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.oldformat.ok {
+		s.Oldformat = &p.oldformat.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
 	}
-	value, ok := p.p["usageid"].(string)
-	return value, ok
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.usageid.ok {
+		s.Usageid = &p.usageid.v
+	}
+	return s
+}
+
+func (p *ListUsageRecordsParams) fromSerialized(s *serializedListUsageRecordsParams) {
+	*p = ListUsageRecordsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accountid != nil {
+		p.SetAccountid(*s.Accountid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Includetags != nil {
+		p.SetIncludetags(*s.Includetags)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Oldformat != nil {
+		p.SetOldformat(*s.Oldformat)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Usageid != nil {
+		p.SetUsageid(*s.Usageid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUsageRecordsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUsageRecordsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUsageRecordsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUsageRecordsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListUsageRecordsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams {
 	p := &ListUsageRecordsParams{}
-	p.p = make(map[string]interface{})
-	p.p["enddate"] = enddate
-	p.p["startdate"] = startdate
+	p.SetEnddate(enddate)
+	p.SetStartdate(startdate)
 	return p
 }
 
-// Lists usage records for accounts
-func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("listUsageRecords", p.toURLValues())
+// Lists usage records for accounts.
+//
+// Required params: enddate, startdate.
+func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams, opts ...CallOption) (*ListUsageRecordsResponse, error) {
+	resp, err := s.cs.newRequest("listUsageRecords", s.cs.encodeParams("listUsageRecords", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1738,28 +2697,100 @@ func (r *UsageRecord) UnmarshalJSON(b []byte) error {
 }
 
 type ListUsageTypesParams struct {
-	p map[string]interface{}
 }
 
-func (p *ListUsageTypesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUsageTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseListUsageTypesParams parses url.Values, for example taken from a raw API request,
+// into a new ListUsageTypesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUsageTypesParams(u url.Values) (*ListUsageTypesParams, error) {
+	p := &ListUsageTypesParams{}
+	if err := checkParamNames("listUsageTypes", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUsageTypesParams) Clone() *ListUsageTypesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUsageTypesParams) Equal(o *ListUsageTypesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedListUsageTypesParams is used to (un)marshal ListUsageTypesParams using the API param names
+type serializedListUsageTypesParams struct {
+}
+
+func (p *ListUsageTypesParams) toSerialized() *serializedListUsageTypesParams {
+	s := &serializedListUsageTypesParams{}
+	return s
+}
+
+func (p *ListUsageTypesParams) fromSerialized(s *serializedListUsageTypesParams) {
+	*p = ListUsageTypesParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUsageTypesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUsageTypesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUsageTypesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUsageTypesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUsageTypesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUsageTypesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new ListUsageTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
 	p := &ListUsageTypesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// List Usage Types
-func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
-	resp, err := s.cs.newRequest("listUsageTypes", p.toURLValues())
+// List Usage Types.
+func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams, opts ...CallOption) (*ListUsageTypesResponse, error) {
+	resp, err := s.cs.newRequest("listUsageTypes", s.cs.encodeParams("listUsageTypes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1785,54 +2816,136 @@ type UsageType struct {
 }
 
 type RemoveRawUsageRecordsParams struct {
-	p map[string]interface{}
+	interval optInt
 }
 
-func (p *RemoveRawUsageRecordsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveRawUsageRecordsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["interval"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("interval", vv)
+	if p.interval.ok {
+		u.Set("interval", strconv.Itoa(p.interval.v))
 	}
 	return u
 }
 
-func (p *RemoveRawUsageRecordsParams) SetInterval(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveRawUsageRecordsParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveRawUsageRecordsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveRawUsageRecordsParams(u url.Values) (*RemoveRawUsageRecordsParams, error) {
+	p := &RemoveRawUsageRecordsParams{}
+	if err := checkParamNames("removeRawUsageRecords", u, "interval"); err != nil {
+		return nil, err
+	}
+	if _, found := u["interval"]; found {
+		v, err := strconv.Atoi(u.Get("interval"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param interval: %v", err)
+		}
+		p.SetInterval(v)
 	}
-	p.p["interval"] = v
+	return p, nil
+}
+
+// SetInterval sets the interval param. This param is required.
+func (p *RemoveRawUsageRecordsParams) SetInterval(v int) {
+	p.interval = optInt{v: v, ok: true}
 }
 
+// ResetInterval unsets the interval param
 func (p *RemoveRawUsageRecordsParams) ResetInterval() {
-	if p.p != nil && p.p["interval"] != nil {
-		delete(p.p, "interval")
-	}
+	p.interval = optInt{}
 }
 
+// GetInterval returns the interval param and if it is set
 func (p *RemoveRawUsageRecordsParams) GetInterval() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.interval.v, p.interval.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveRawUsageRecordsParams) Clone() *RemoveRawUsageRecordsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveRawUsageRecordsParams) Equal(o *RemoveRawUsageRecordsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.interval == o.interval
+}
+
+// serializedRemoveRawUsageRecordsParams is used to (un)marshal RemoveRawUsageRecordsParams using the API param names
+type serializedRemoveRawUsageRecordsParams struct {
+	Interval *int `json:"interval,omitempty" yaml:"interval,omitempty"`
+}
+
+func (p *RemoveRawUsageRecordsParams) toSerialized() *serializedRemoveRawUsageRecordsParams {
+	s := &serializedRemoveRawUsageRecordsParams{}
+	if p.interval.ok {
+		s.Interval = &p.interval.v
+	}
+	return s
+}
+
+func (p *RemoveRawUsageRecordsParams) fromSerialized(s *serializedRemoveRawUsageRecordsParams) {
+	*p = RemoveRawUsageRecordsParams{}
+	if s.Interval != nil {
+		p.SetInterval(*s.Interval)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveRawUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveRawUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveRawUsageRecordsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveRawUsageRecordsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveRawUsageRecordsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveRawUsageRecordsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["interval"].(int)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveRawUsageRecordsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUsageRecordsParams {
 	p := &RemoveRawUsageRecordsParams{}
-	p.p = make(map[string]interface{})
-	p.p["interval"] = interval
+	p.SetInterval(interval)
 	return p
 }
 
-// Safely removes raw records from cloud_usage table
-func (s *UsageService) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("removeRawUsageRecords", p.toURLValues())
+// Safely removes raw records from cloud_usage table.
+//
+// Required params: interval.
+func (s *UsageService) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams, opts ...CallOption) (*RemoveRawUsageRecordsResponse, error) {
+	resp, err := s.cs.newRequest("removeRawUsageRecords", s.cs.encodeParams("removeRawUsageRecords", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1880,173 +2993,283 @@ func (r *RemoveRawUsageRecordsResponse) UnmarshalJSON(b []byte) error {
 }
 
 type UpdateTrafficTypeParams struct {
-	p map[string]interface{}
+	hypervnetworklabel optString
+	id                 optString
+	kvmnetworklabel    optString
+	ovm3networklabel   optString
+	vmwarenetworklabel optString
+	xennetworklabel    optString
 }
 
-func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateTrafficTypeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["hypervnetworklabel"]; found {
-		u.Set("hypervnetworklabel", v.(string))
+	if p.hypervnetworklabel.ok {
+		u.Set("hypervnetworklabel", p.hypervnetworklabel.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["kvmnetworklabel"]; found {
-		u.Set("kvmnetworklabel", v.(string))
+	if p.kvmnetworklabel.ok {
+		u.Set("kvmnetworklabel", p.kvmnetworklabel.v)
 	}
-	if v, found := p.p["ovm3networklabel"]; found {
-		u.Set("ovm3networklabel", v.(string))
+	if p.ovm3networklabel.ok {
+		u.Set("ovm3networklabel", p.ovm3networklabel.v)
 	}
-	if v, found := p.p["vmwarenetworklabel"]; found {
-		u.Set("vmwarenetworklabel", v.(string))
+	if p.vmwarenetworklabel.ok {
+		u.Set("vmwarenetworklabel", p.vmwarenetworklabel.v)
 	}
-	if v, found := p.p["xennetworklabel"]; found {
-		u.Set("xennetworklabel", v.(string))
+	if p.xennetworklabel.ok {
+		u.Set("xennetworklabel", p.xennetworklabel.v)
 	}
 	return u
 }
 
-func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateTrafficTypeParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateTrafficTypeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateTrafficTypeParams(u url.Values) (*UpdateTrafficTypeParams, error) {
+	p := &UpdateTrafficTypeParams{}
+	if err := checkParamNames("updateTrafficType", u, "hypervnetworklabel", "id", "kvmnetworklabel", "ovm3networklabel", "vmwarenetworklabel", "xennetworklabel"); err != nil {
+		return nil, err
+	}
+	if _, found := u["hypervnetworklabel"]; found {
+		p.SetHypervnetworklabel(u.Get("hypervnetworklabel"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["hypervnetworklabel"] = v
+	if _, found := u["kvmnetworklabel"]; found {
+		p.SetKvmnetworklabel(u.Get("kvmnetworklabel"))
+	}
+	if _, found := u["ovm3networklabel"]; found {
+		p.SetOvm3networklabel(u.Get("ovm3networklabel"))
+	}
+	if _, found := u["vmwarenetworklabel"]; found {
+		p.SetVmwarenetworklabel(u.Get("vmwarenetworklabel"))
+	}
+	if _, found := u["xennetworklabel"]; found {
+		p.SetXennetworklabel(u.Get("xennetworklabel"))
+	}
+	return p, nil
 }
 
+// SetHypervnetworklabel sets the hypervnetworklabel param.
+func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
+	p.hypervnetworklabel = optString{v: v, ok: true}
+}
+
+// ResetHypervnetworklabel unsets the hypervnetworklabel param
 func (p *UpdateTrafficTypeParams) ResetHypervnetworklabel() {
-	if p.p != nil && p.p["hypervnetworklabel"] != nil {
-		delete(p.p, "hypervnetworklabel")
-	}
+	p.hypervnetworklabel = optString{}
 }
 
+// GetHypervnetworklabel returns the hypervnetworklabel param and if it is set
 func (p *UpdateTrafficTypeParams) GetHypervnetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervnetworklabel"].(string)
-	return value, ok
+	return p.hypervnetworklabel.v, p.hypervnetworklabel.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateTrafficTypeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateTrafficTypeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateTrafficTypeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKvmnetworklabel sets the kvmnetworklabel param.
 func (p *UpdateTrafficTypeParams) SetKvmnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["kvmnetworklabel"] = v
+	p.kvmnetworklabel = optString{v: v, ok: true}
 }
 
+// ResetKvmnetworklabel unsets the kvmnetworklabel param
 func (p *UpdateTrafficTypeParams) ResetKvmnetworklabel() {
-	if p.p != nil && p.p["kvmnetworklabel"] != nil {
-		delete(p.p, "kvmnetworklabel")
-	}
+	p.kvmnetworklabel = optString{}
 }
 
+// GetKvmnetworklabel returns the kvmnetworklabel param and if it is set
 func (p *UpdateTrafficTypeParams) GetKvmnetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["kvmnetworklabel"].(string)
-	return value, ok
+	return p.kvmnetworklabel.v, p.kvmnetworklabel.ok
 }
 
+// SetOvm3networklabel sets the ovm3networklabel param.
 func (p *UpdateTrafficTypeParams) SetOvm3networklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ovm3networklabel"] = v
+	p.ovm3networklabel = optString{v: v, ok: true}
 }
 
+// ResetOvm3networklabel unsets the ovm3networklabel param
 func (p *UpdateTrafficTypeParams) ResetOvm3networklabel() {
-	if p.p != nil && p.p["ovm3networklabel"] != nil {
-		delete(p.p, "ovm3networklabel")
-	}
+	p.ovm3networklabel = optString{}
 }
 
+// GetOvm3networklabel returns the ovm3networklabel param and if it is set
 func (p *UpdateTrafficTypeParams) GetOvm3networklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ovm3networklabel"].(string)
-	return value, ok
+	return p.ovm3networklabel.v, p.ovm3networklabel.ok
 }
 
+// SetVmwarenetworklabel sets the vmwarenetworklabel param.
 func (p *UpdateTrafficTypeParams) SetVmwarenetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmwarenetworklabel"] = v
+	p.vmwarenetworklabel = optString{v: v, ok: true}
 }
 
+// ResetVmwarenetworklabel unsets the vmwarenetworklabel param
 func (p *UpdateTrafficTypeParams) ResetVmwarenetworklabel() {
-	if p.p != nil && p.p["vmwarenetworklabel"] != nil {
-		delete(p.p, "vmwarenetworklabel")
-	}
+	p.vmwarenetworklabel = optString{}
 }
 
+// GetVmwarenetworklabel returns the vmwarenetworklabel param and if it is set
 func (p *UpdateTrafficTypeParams) GetVmwarenetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vmwarenetworklabel"].(string)
-	return value, ok
+	return p.vmwarenetworklabel.v, p.vmwarenetworklabel.ok
 }
 
+// SetXennetworklabel sets the xennetworklabel param.
 func (p *UpdateTrafficTypeParams) SetXennetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["xennetworklabel"] = v
+	p.xennetworklabel = optString{v: v, ok: true}
 }
 
+// ResetXennetworklabel unsets the xennetworklabel param
 func (p *UpdateTrafficTypeParams) ResetXennetworklabel() {
-	if p.p != nil && p.p["xennetworklabel"] != nil {
-		delete(p.p, "xennetworklabel")
-	}
+	p.xennetworklabel = optString{}
 }
 
+// GetXennetworklabel returns the xennetworklabel param and if it is set
 func (p *UpdateTrafficTypeParams) GetXennetworklabel() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.xennetworklabel.v, p.xennetworklabel.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateTrafficTypeParams) Clone() *UpdateTrafficTypeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateTrafficTypeParams) Equal(o *UpdateTrafficTypeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.hypervnetworklabel == o.hypervnetworklabel &&
+		p.id == o.id &&
+		p.kvmnetworklabel == o.kvmnetworklabel &&
+		p.ovm3networklabel == o.ovm3networklabel &&
+		p.vmwarenetworklabel == o.vmwarenetworklabel &&
+		p.xennetworklabel == o.xennetworklabel
+}
+
+// serializedUpdateTrafficTypeParams is used to (un)marshal UpdateTrafficTypeParams using the API param names
+type serializedUpdateTrafficTypeParams struct {
+	Hypervnetworklabel *string `json:"hypervnetworklabel,omitempty" yaml:"hypervnetworklabel,omitempty"`
+	Id                 *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Kvmnetworklabel    *string `json:"kvmnetworklabel,omitempty" yaml:"kvmnetworklabel,omitempty"`
+	Ovm3networklabel   *string `json:"ovm3networklabel,omitempty" yaml:"ovm3networklabel,omitempty"`
+	Vmwarenetworklabel *string `json:"vmwarenetworklabel,omitempty" yaml:"vmwarenetworklabel,omitempty"`
+	Xennetworklabel    *string `json:"xennetworklabel,omitempty" yaml:"xennetworklabel,omitempty"`
+}
+
+func (p *UpdateTrafficTypeParams) toSerialized() *serializedUpdateTrafficTypeParams {
+	s := &serializedUpdateTrafficTypeParams{}
+	if p.hypervnetworklabel.ok {
+		s.Hypervnetworklabel = &p.hypervnetworklabel.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.kvmnetworklabel.ok {
+		s.Kvmnetworklabel = &p.kvmnetworklabel.v
+	}
+	if p.ovm3networklabel.ok {
+		s.Ovm3networklabel = &p.ovm3networklabel.v
+	}
+	if p.vmwarenetworklabel.ok {
+		s.Vmwarenetworklabel = &p.vmwarenetworklabel.v
+	}
+	if p.xennetworklabel.ok {
+		s.Xennetworklabel = &p.xennetworklabel.v
+	}
+	return s
+}
+
+func (p *UpdateTrafficTypeParams) fromSerialized(s *serializedUpdateTrafficTypeParams) {
+	*p = UpdateTrafficTypeParams{}
+	if s.Hypervnetworklabel != nil {
+		p.SetHypervnetworklabel(*s.Hypervnetworklabel)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Kvmnetworklabel != nil {
+		p.SetKvmnetworklabel(*s.Kvmnetworklabel)
+	}
+	if s.Ovm3networklabel != nil {
+		p.SetOvm3networklabel(*s.Ovm3networklabel)
+	}
+	if s.Vmwarenetworklabel != nil {
+		p.SetVmwarenetworklabel(*s.Vmwarenetworklabel)
+	}
+	if s.Xennetworklabel != nil {
+		p.SetXennetworklabel(*s.Xennetworklabel)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateTrafficTypeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateTrafficTypeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateTrafficTypeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateTrafficTypeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["xennetworklabel"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateTrafficTypeParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams {
 	p := &UpdateTrafficTypeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates traffic type of a physical network
-func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("updateTrafficType", p.toURLValues())
+// Updates traffic type of a physical network.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams, opts ...CallOption) (*UpdateTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequest("updateTrafficType", s.cs.encodeParams("updateTrafficType", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2057,8 +3280,8 @@ func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTra
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2093,28 +3316,100 @@ type UpdateTrafficTypeResponse struct {
 }
 
 type ListUsageServerMetricsParams struct {
-	p map[string]interface{}
 }
 
-func (p *ListUsageServerMetricsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUsageServerMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseListUsageServerMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListUsageServerMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUsageServerMetricsParams(u url.Values) (*ListUsageServerMetricsParams, error) {
+	p := &ListUsageServerMetricsParams{}
+	if err := checkParamNames("listUsageServerMetrics", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUsageServerMetricsParams) Clone() *ListUsageServerMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUsageServerMetricsParams) Equal(o *ListUsageServerMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedListUsageServerMetricsParams is used to (un)marshal ListUsageServerMetricsParams using the API param names
+type serializedListUsageServerMetricsParams struct {
+}
+
+func (p *ListUsageServerMetricsParams) toSerialized() *serializedListUsageServerMetricsParams {
+	s := &serializedListUsageServerMetricsParams{}
+	return s
+}
+
+func (p *ListUsageServerMetricsParams) fromSerialized(s *serializedListUsageServerMetricsParams) {
+	*p = ListUsageServerMetricsParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUsageServerMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUsageServerMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUsageServerMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUsageServerMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUsageServerMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUsageServerMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new ListUsageServerMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListUsageServerMetricsParams() *ListUsageServerMetricsParams {
 	p := &ListUsageServerMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists Usage Server metrics
-func (s *UsageService) ListUsageServerMetrics(p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listUsageServerMetrics", p.toURLValues())
+// Lists Usage Server metrics.
+func (s *UsageService) ListUsageServerMetrics(p *ListUsageServerMetricsParams, opts ...CallOption) (*ListUsageServerMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listUsageServerMetrics", s.cs.encodeParams("listUsageServerMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
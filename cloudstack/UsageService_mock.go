@@ -53,78 +53,103 @@ func (m *MockUsageServiceIface) EXPECT() *MockUsageServiceIfaceMockRecorder {
 }
 
 // AddTrafficMonitor mocks base method.
-func (m *MockUsageServiceIface) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
+func (m *MockUsageServiceIface) AddTrafficMonitor(p *AddTrafficMonitorParams, opts ...CallOption) (*AddTrafficMonitorResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTrafficMonitor", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTrafficMonitor", varargs...)
 	ret0, _ := ret[0].(*AddTrafficMonitorResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddTrafficMonitor indicates an expected call of AddTrafficMonitor.
-func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficMonitor(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficMonitor(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficMonitor", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficMonitor), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficMonitor", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficMonitor), varargs...)
 }
 
 // AddTrafficType mocks base method.
-func (m *MockUsageServiceIface) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
+func (m *MockUsageServiceIface) AddTrafficType(p *AddTrafficTypeParams, opts ...CallOption) (*AddTrafficTypeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTrafficType", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTrafficType", varargs...)
 	ret0, _ := ret[0].(*AddTrafficTypeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddTrafficType indicates an expected call of AddTrafficType.
-func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficType(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficType(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficType), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficType), varargs...)
 }
 
 // DeleteTrafficMonitor mocks base method.
-func (m *MockUsageServiceIface) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
+func (m *MockUsageServiceIface) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams, opts ...CallOption) (*DeleteTrafficMonitorResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrafficMonitor", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTrafficMonitor", varargs...)
 	ret0, _ := ret[0].(*DeleteTrafficMonitorResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteTrafficMonitor indicates an expected call of DeleteTrafficMonitor.
-func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficMonitor(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficMonitor(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficMonitor", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficMonitor), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficMonitor", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficMonitor), varargs...)
 }
 
 // DeleteTrafficType mocks base method.
-func (m *MockUsageServiceIface) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
+func (m *MockUsageServiceIface) DeleteTrafficType(p *DeleteTrafficTypeParams, opts ...CallOption) (*DeleteTrafficTypeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrafficType", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTrafficType", varargs...)
 	ret0, _ := ret[0].(*DeleteTrafficTypeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteTrafficType indicates an expected call of DeleteTrafficType.
-func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficType(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficType(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficType), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficType), varargs...)
 }
 
 // GenerateUsageRecords mocks base method.
-func (m *MockUsageServiceIface) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
+func (m *MockUsageServiceIface) GenerateUsageRecords(p *GenerateUsageRecordsParams, opts ...CallOption) (*GenerateUsageRecordsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateUsageRecords", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateUsageRecords", varargs...)
 	ret0, _ := ret[0].(*GenerateUsageRecordsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateUsageRecords indicates an expected call of GenerateUsageRecords.
-func (mr *MockUsageServiceIfaceMockRecorder) GenerateUsageRecords(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) GenerateUsageRecords(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).GenerateUsageRecords), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).GenerateUsageRecords), varargs...)
 }
 
 // GetTrafficTypeID mocks base method.
@@ -149,93 +174,123 @@ func (mr *MockUsageServiceIfaceMockRecorder) GetTrafficTypeID(keyword, physicaln
 }
 
 // ListTrafficMonitors mocks base method.
-func (m *MockUsageServiceIface) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+func (m *MockUsageServiceIface) ListTrafficMonitors(p *ListTrafficMonitorsParams, opts ...CallOption) (*ListTrafficMonitorsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTrafficMonitors", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficMonitors", varargs...)
 	ret0, _ := ret[0].(*ListTrafficMonitorsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTrafficMonitors indicates an expected call of ListTrafficMonitors.
-func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitors(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitors(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitors", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitors), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitors", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitors), varargs...)
 }
 
 // ListTrafficTypeImplementors mocks base method.
-func (m *MockUsageServiceIface) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
+func (m *MockUsageServiceIface) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams, opts ...CallOption) (*ListTrafficTypeImplementorsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTrafficTypeImplementors", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypeImplementors", varargs...)
 	ret0, _ := ret[0].(*ListTrafficTypeImplementorsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTrafficTypeImplementors indicates an expected call of ListTrafficTypeImplementors.
-func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementors(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementors(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementors", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementors), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementors", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementors), varargs...)
 }
 
 // ListTrafficTypes mocks base method.
-func (m *MockUsageServiceIface) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+func (m *MockUsageServiceIface) ListTrafficTypes(p *ListTrafficTypesParams, opts ...CallOption) (*ListTrafficTypesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTrafficTypes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypes", varargs...)
 	ret0, _ := ret[0].(*ListTrafficTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTrafficTypes indicates an expected call of ListTrafficTypes.
-func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypes(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypes", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypes", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypes), varargs...)
 }
 
 // ListUsageRecords mocks base method.
-func (m *MockUsageServiceIface) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+func (m *MockUsageServiceIface) ListUsageRecords(p *ListUsageRecordsParams, opts ...CallOption) (*ListUsageRecordsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsageRecords", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageRecords", varargs...)
 	ret0, _ := ret[0].(*ListUsageRecordsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsageRecords indicates an expected call of ListUsageRecords.
-func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecords(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecords(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecords), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecords), varargs...)
 }
 
 // ListUsageServerMetrics mocks base method.
-func (m *MockUsageServiceIface) ListUsageServerMetrics(p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error) {
+func (m *MockUsageServiceIface) ListUsageServerMetrics(p *ListUsageServerMetricsParams, opts ...CallOption) (*ListUsageServerMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsageServerMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageServerMetrics", varargs...)
 	ret0, _ := ret[0].(*ListUsageServerMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsageServerMetrics indicates an expected call of ListUsageServerMetrics.
-func (mr *MockUsageServiceIfaceMockRecorder) ListUsageServerMetrics(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageServerMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageServerMetrics", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageServerMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageServerMetrics", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageServerMetrics), varargs...)
 }
 
 // ListUsageTypes mocks base method.
-func (m *MockUsageServiceIface) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
+func (m *MockUsageServiceIface) ListUsageTypes(p *ListUsageTypesParams, opts ...CallOption) (*ListUsageTypesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsageTypes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageTypes", varargs...)
 	ret0, _ := ret[0].(*ListUsageTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsageTypes indicates an expected call of ListUsageTypes.
-func (mr *MockUsageServiceIfaceMockRecorder) ListUsageTypes(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageTypes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageTypes", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageTypes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageTypes", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageTypes), varargs...)
 }
 
 // NewAddTrafficMonitorParams mocks base method.
@@ -421,31 +476,41 @@ func (mr *MockUsageServiceIfaceMockRecorder) NewUpdateTrafficTypeParams(id inter
 }
 
 // RemoveRawUsageRecords mocks base method.
-func (m *MockUsageServiceIface) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
+func (m *MockUsageServiceIface) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams, opts ...CallOption) (*RemoveRawUsageRecordsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveRawUsageRecords", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveRawUsageRecords", varargs...)
 	ret0, _ := ret[0].(*RemoveRawUsageRecordsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveRawUsageRecords indicates an expected call of RemoveRawUsageRecords.
-func (mr *MockUsageServiceIfaceMockRecorder) RemoveRawUsageRecords(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) RemoveRawUsageRecords(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRawUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).RemoveRawUsageRecords), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRawUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).RemoveRawUsageRecords), varargs...)
 }
 
 // UpdateTrafficType mocks base method.
-func (m *MockUsageServiceIface) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
+func (m *MockUsageServiceIface) UpdateTrafficType(p *UpdateTrafficTypeParams, opts ...CallOption) (*UpdateTrafficTypeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTrafficType", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTrafficType", varargs...)
 	ret0, _ := ret[0].(*UpdateTrafficTypeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTrafficType indicates an expected call of UpdateTrafficType.
-func (mr *MockUsageServiceIfaceMockRecorder) UpdateTrafficType(p interface{}) *gomock.Call {
+func (mr *MockUsageServiceIfaceMockRecorder) UpdateTrafficType(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).UpdateTrafficType), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).UpdateTrafficType), varargs...)
 }
@@ -0,0 +1,309 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// DefaultUserDataMaxLength is the default value of the `vm.userdata.max.length` setting
+const DefaultUserDataMaxLength = 32768
+
+// Content types of the userdata parts understood by cloud-init
+const (
+	UserDataCloudConfig = "text/cloud-config"
+	UserDataShellScript = "text/x-shellscript"
+	UserDataIncludeURL  = "text/x-include-url"
+)
+
+const userDataBoundary = "MIMEBOUNDARY"
+
+// UserDataPart is a single part of a (multipart) cloud-init userdata archive
+type UserDataPart struct {
+	ContentType string
+	Filename    string
+	Content     string
+}
+
+// UserdataSetter is an interface that every type that can set userdata must implement
+type UserdataSetter interface {
+	SetUserdata(string)
+}
+
+// UserDataBuilder combines cloud-config YAML, shell scripts and include files into
+// the base64 encoded userdata expected by DeployVirtualMachine and UpdateVirtualMachine.
+// A single part is used as is, while multiple parts are combined into a multipart MIME
+// archive which cloud-init will unpack.
+type UserDataBuilder struct {
+	parts     []UserDataPart
+	gzip      bool
+	maxLength int
+}
+
+// NewUserDataBuilder returns a new builder validating against DefaultUserDataMaxLength
+func NewUserDataBuilder() *UserDataBuilder {
+	return &UserDataBuilder{maxLength: DefaultUserDataMaxLength}
+}
+
+// AddCloudConfig adds a cloud-config YAML document, adding the required
+// `#cloud-config` header when it is missing.
+func (b *UserDataBuilder) AddCloudConfig(config string) *UserDataBuilder {
+	if !strings.HasPrefix(config, "#cloud-config") {
+		config = "#cloud-config\n" + config
+	}
+	return b.AddPart(UserDataCloudConfig, "cloud-config.yaml", config)
+}
+
+// AddShellScript adds a shell script, adding a `#!/bin/sh` header when it is missing.
+func (b *UserDataBuilder) AddShellScript(filename string, script string) *UserDataBuilder {
+	if !strings.HasPrefix(script, "#!") {
+		script = "#!/bin/sh\n" + script
+	}
+	return b.AddPart(UserDataShellScript, filename, script)
+}
+
+// AddIncludeURLs adds an include file, which makes cloud-init fetch and process the URLs.
+func (b *UserDataBuilder) AddIncludeURLs(urls ...string) *UserDataBuilder {
+	return b.AddPart(UserDataIncludeURL, "include.txt", "#include\n"+strings.Join(urls, "\n")+"\n")
+}
+
+// AddPart adds a part with a custom content type
+func (b *UserDataBuilder) AddPart(contentType string, filename string, content string) *UserDataBuilder {
+	b.parts = append(b.parts, UserDataPart{ContentType: contentType, Filename: filename, Content: content})
+	return b
+}
+
+// SetGzip sets if the userdata should be gzip compressed before it is base64 encoded
+func (b *UserDataBuilder) SetGzip(v bool) *UserDataBuilder {
+	b.gzip = v
+	return b
+}
+
+// SetMaxLength sets the max length of the base64 encoded userdata, which should
+// match the `vm.userdata.max.length` setting of the server. See GetUserDataMaxLength.
+func (b *UserDataBuilder) SetMaxLength(v int) *UserDataBuilder {
+	b.maxLength = v
+	return b
+}
+
+// Parts returns the parts added to the builder
+func (b *UserDataBuilder) Parts() []UserDataPart {
+	return b.parts
+}
+
+// Build returns the base64 encoded userdata. An error is returned when no parts
+// are added, or when the encoded userdata exceeds the max length.
+func (b *UserDataBuilder) Build() (string, error) {
+	var data []byte
+	switch len(b.parts) {
+	case 0:
+		return "", fmt.Errorf("Userdata must contain at least one part")
+	case 1:
+		data = []byte(b.parts[0].Content)
+	default:
+		var err error
+		if data, err = b.multipart(); err != nil {
+			return "", err
+		}
+	}
+
+	if b.gzip {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return "", err
+		}
+		if err := zw.Close(); err != nil {
+			return "", err
+		}
+		data = buf.Bytes()
+	}
+
+	userdata := base64.StdEncoding.EncodeToString(data)
+	if b.maxLength > 0 && len(userdata) > b.maxLength {
+		return "", fmt.Errorf("Userdata of %d bytes exceeds the max length of %d bytes", len(userdata), b.maxLength)
+	}
+
+	return userdata, nil
+}
+
+// Apply builds the userdata and sets it on params like DeployVirtualMachineParams,
+// UpdateVirtualMachineParams or ResetUserDataForVirtualMachineParams.
+func (b *UserDataBuilder) Apply(p UserdataSetter) error {
+	userdata, err := b.Build()
+	if err != nil {
+		return err
+	}
+	p.SetUserdata(userdata)
+	return nil
+}
+
+func (b *UserDataBuilder) multipart() ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	// Use a fixed boundary so the same parts always give the same userdata,
+	// unless the boundary happens to be part of the content.
+	boundary := userDataBoundary
+	for _, part := range b.parts {
+		if strings.Contains(part.Content, boundary) {
+			boundary = mw.Boundary()
+			break
+		}
+	}
+	if err := mw.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", boundary)
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n\r\n")
+
+	for _, part := range b.parts {
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Type", part.ContentType+`; charset="utf-8"`)
+		h.Set("MIME-Version", "1.0")
+		h.Set("Content-Transfer-Encoding", "7bit")
+		if part.Filename != "" {
+			h.Set("Content-Disposition", "attachment; filename="+strconv.Quote(part.Filename))
+		}
+
+		w, err := mw.CreatePart(h)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(w, part.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ParseUserData decodes base64 encoded userdata, like the userdata returned by
+// UserService.GetVirtualMachineUserData, back into its parts. Gzip compressed
+// userdata and multipart MIME archives are unpacked.
+func ParseUserData(userdata string) ([]UserDataPart, error) {
+	data, err := base64.StdEncoding.DecodeString(userdata)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the gzip magic header
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = ioutil.ReadAll(zr); err != nil {
+			return nil, err
+		}
+	}
+
+	if !bytes.HasPrefix(data, []byte("Content-Type: multipart/")) {
+		return []UserDataPart{{ContentType: detectUserDataType(string(data)), Content: string(data)}}, nil
+	}
+
+	r := textproto.NewReader(bufio.NewReader(bytes.NewReader(data)))
+	header, err := r.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	_, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []UserDataPart
+	mr := multipart.NewReader(r.R, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := ioutil.ReadAll(p)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType, _, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		if err != nil {
+			contentType = detectUserDataType(string(content))
+		}
+		parts = append(parts, UserDataPart{
+			ContentType: contentType,
+			Filename:    p.FileName(),
+			Content:     string(content),
+		})
+	}
+
+	return parts, nil
+}
+
+// detectUserDataType detects the content type of a userdata part using the same
+// header lines cloud-init looks for.
+func detectUserDataType(content string) string {
+	switch {
+	case strings.HasPrefix(content, "#cloud-config"):
+		return UserDataCloudConfig
+	case strings.HasPrefix(content, "#!"):
+		return UserDataShellScript
+	case strings.HasPrefix(content, "#include"):
+		return UserDataIncludeURL
+	default:
+		return "text/plain"
+	}
+}
+
+// GetUserDataMaxLength returns the max userdata length configured on the server
+// using the `vm.userdata.max.length` setting.
+func (cs *CloudStackClient) GetUserDataMaxLength(opts ...CallOption) (int, error) {
+	p := cs.Configuration.NewListConfigurationsParams()
+	p.SetName("vm.userdata.max.length")
+
+	l, err := cs.Configuration.ListConfigurations(p, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, c := range l.Configurations {
+		if c.Name == "vm.userdata.max.length" {
+			return strconv.Atoi(c.Value)
+		}
+	}
+	return DefaultUserDataMaxLength, nil
+}
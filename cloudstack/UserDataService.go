@@ -0,0 +1,1453 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type UserDataServiceIface interface {
+	DeleteUserData(p *DeleteUserDataParams, opts ...CallOption) (*DeleteUserDataResponse, error)
+	NewDeleteUserDataParams(id string) *DeleteUserDataParams
+	LinkUserDataToTemplate(p *LinkUserDataToTemplateParams, opts ...CallOption) (*LinkUserDataToTemplateResponse, error)
+	NewLinkUserDataToTemplateParams() *LinkUserDataToTemplateParams
+	ListUserData(p *ListUserDataParams, opts ...CallOption) (*ListUserDataResponse, error)
+	NewListUserDataParams() *ListUserDataParams
+	GetUserDataID(name string, opts ...OptionFunc) (string, int, error)
+	GetUserDataByName(name string, opts ...OptionFunc) (*UserData, int, error)
+	GetUserDataByID(id string, opts ...OptionFunc) (*UserData, int, error)
+	RegisterUserData(p *RegisterUserDataParams, opts ...CallOption) (*RegisterUserDataResponse, error)
+	NewRegisterUserDataParams(name string, userdata string) *RegisterUserDataParams
+}
+
+type DeleteUserDataParams struct {
+	account   optString
+	domainid  optString
+	id        optString
+	projectid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteUserDataParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	return u
+}
+
+// ParseDeleteUserDataParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteUserDataParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteUserDataParams(u url.Values) (*DeleteUserDataParams, error) {
+	p := &DeleteUserDataParams{}
+	if err := checkParamNames("deleteUserData", u, "account", "domainid", "id", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *DeleteUserDataParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *DeleteUserDataParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *DeleteUserDataParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *DeleteUserDataParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *DeleteUserDataParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *DeleteUserDataParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteUserDataParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteUserDataParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteUserDataParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *DeleteUserDataParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *DeleteUserDataParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *DeleteUserDataParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteUserDataParams) Clone() *DeleteUserDataParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteUserDataParams) Equal(o *DeleteUserDataParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.projectid == o.projectid
+}
+
+// serializedDeleteUserDataParams is used to (un)marshal DeleteUserDataParams using the API param names
+type serializedDeleteUserDataParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *DeleteUserDataParams) toSerialized() *serializedDeleteUserDataParams {
+	s := &serializedDeleteUserDataParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *DeleteUserDataParams) fromSerialized(s *serializedDeleteUserDataParams) {
+	*p = DeleteUserDataParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteUserDataParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteUserDataParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteUserDataParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteUserDataParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteUserDataParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteUserDataParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeleteUserDataParams instance,
+// as then you are sure you have configured all required params
+func (s *UserDataService) NewDeleteUserDataParams(id string) *DeleteUserDataParams {
+	p := &DeleteUserDataParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a userdata.
+//
+// Required params: id.
+func (s *UserDataService) DeleteUserData(p *DeleteUserDataParams, opts ...CallOption) (*DeleteUserDataResponse, error) {
+	resp, err := s.cs.newRequest("deleteUserData", s.cs.encodeParams("deleteUserData", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteUserDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteUserDataResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteUserDataResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteUserDataResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type LinkUserDataToTemplateParams struct {
+	isoid          optString
+	templateid     optString
+	userdataid     optString
+	userdatapolicy optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LinkUserDataToTemplateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.isoid.ok {
+		u.Set("isoid", p.isoid.v)
+	}
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
+	}
+	if p.userdataid.ok {
+		u.Set("userdataid", p.userdataid.v)
+	}
+	if p.userdatapolicy.ok {
+		u.Set("userdatapolicy", p.userdatapolicy.v)
+	}
+	return u
+}
+
+// ParseLinkUserDataToTemplateParams parses url.Values, for example taken from a raw API request,
+// into a new LinkUserDataToTemplateParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLinkUserDataToTemplateParams(u url.Values) (*LinkUserDataToTemplateParams, error) {
+	p := &LinkUserDataToTemplateParams{}
+	if err := checkParamNames("linkUserDataToTemplate", u, "isoid", "templateid", "userdataid", "userdatapolicy"); err != nil {
+		return nil, err
+	}
+	if _, found := u["isoid"]; found {
+		p.SetIsoid(u.Get("isoid"))
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["userdataid"]; found {
+		p.SetUserdataid(u.Get("userdataid"))
+	}
+	if _, found := u["userdatapolicy"]; found {
+		p.SetUserdatapolicy(u.Get("userdatapolicy"))
+	}
+	return p, nil
+}
+
+// SetIsoid sets the isoid param.
+func (p *LinkUserDataToTemplateParams) SetIsoid(v string) {
+	p.isoid = optString{v: v, ok: true}
+}
+
+// ResetIsoid unsets the isoid param
+func (p *LinkUserDataToTemplateParams) ResetIsoid() {
+	p.isoid = optString{}
+}
+
+// GetIsoid returns the isoid param and if it is set
+func (p *LinkUserDataToTemplateParams) GetIsoid() (string, bool) {
+	return p.isoid.v, p.isoid.ok
+}
+
+// SetTemplateid sets the templateid param.
+func (p *LinkUserDataToTemplateParams) SetTemplateid(v string) {
+	p.templateid = optString{v: v, ok: true}
+}
+
+// ResetTemplateid unsets the templateid param
+func (p *LinkUserDataToTemplateParams) ResetTemplateid() {
+	p.templateid = optString{}
+}
+
+// GetTemplateid returns the templateid param and if it is set
+func (p *LinkUserDataToTemplateParams) GetTemplateid() (string, bool) {
+	return p.templateid.v, p.templateid.ok
+}
+
+// SetUserdataid sets the userdataid param.
+func (p *LinkUserDataToTemplateParams) SetUserdataid(v string) {
+	p.userdataid = optString{v: v, ok: true}
+}
+
+// ResetUserdataid unsets the userdataid param
+func (p *LinkUserDataToTemplateParams) ResetUserdataid() {
+	p.userdataid = optString{}
+}
+
+// GetUserdataid returns the userdataid param and if it is set
+func (p *LinkUserDataToTemplateParams) GetUserdataid() (string, bool) {
+	return p.userdataid.v, p.userdataid.ok
+}
+
+// SetUserdatapolicy sets the userdatapolicy param.
+func (p *LinkUserDataToTemplateParams) SetUserdatapolicy(v string) {
+	p.userdatapolicy = optString{v: v, ok: true}
+}
+
+// ResetUserdatapolicy unsets the userdatapolicy param
+func (p *LinkUserDataToTemplateParams) ResetUserdatapolicy() {
+	p.userdatapolicy = optString{}
+}
+
+// GetUserdatapolicy returns the userdatapolicy param and if it is set
+func (p *LinkUserDataToTemplateParams) GetUserdatapolicy() (string, bool) {
+	return p.userdatapolicy.v, p.userdatapolicy.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *LinkUserDataToTemplateParams) Clone() *LinkUserDataToTemplateParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LinkUserDataToTemplateParams) Equal(o *LinkUserDataToTemplateParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.isoid == o.isoid &&
+		p.templateid == o.templateid &&
+		p.userdataid == o.userdataid &&
+		p.userdatapolicy == o.userdatapolicy
+}
+
+// serializedLinkUserDataToTemplateParams is used to (un)marshal LinkUserDataToTemplateParams using the API param names
+type serializedLinkUserDataToTemplateParams struct {
+	Isoid          *string `json:"isoid,omitempty" yaml:"isoid,omitempty"`
+	Templateid     *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Userdataid     *string `json:"userdataid,omitempty" yaml:"userdataid,omitempty"`
+	Userdatapolicy *string `json:"userdatapolicy,omitempty" yaml:"userdatapolicy,omitempty"`
+}
+
+func (p *LinkUserDataToTemplateParams) toSerialized() *serializedLinkUserDataToTemplateParams {
+	s := &serializedLinkUserDataToTemplateParams{}
+	if p.isoid.ok {
+		s.Isoid = &p.isoid.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.userdataid.ok {
+		s.Userdataid = &p.userdataid.v
+	}
+	if p.userdatapolicy.ok {
+		s.Userdatapolicy = &p.userdatapolicy.v
+	}
+	return s
+}
+
+func (p *LinkUserDataToTemplateParams) fromSerialized(s *serializedLinkUserDataToTemplateParams) {
+	*p = LinkUserDataToTemplateParams{}
+	if s.Isoid != nil {
+		p.SetIsoid(*s.Isoid)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Userdataid != nil {
+		p.SetUserdataid(*s.Userdataid)
+	}
+	if s.Userdatapolicy != nil {
+		p.SetUserdatapolicy(*s.Userdatapolicy)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LinkUserDataToTemplateParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LinkUserDataToTemplateParams) UnmarshalJSON(b []byte) error {
+	var s serializedLinkUserDataToTemplateParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LinkUserDataToTemplateParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LinkUserDataToTemplateParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLinkUserDataToTemplateParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new LinkUserDataToTemplateParams instance,
+// as then you are sure you have configured all required params
+func (s *UserDataService) NewLinkUserDataToTemplateParams() *LinkUserDataToTemplateParams {
+	p := &LinkUserDataToTemplateParams{}
+	return p
+}
+
+// Link or unlink a userdata to a template.
+func (s *UserDataService) LinkUserDataToTemplate(p *LinkUserDataToTemplateParams, opts ...CallOption) (*LinkUserDataToTemplateResponse, error) {
+	resp, err := s.cs.newRequest("linkUserDataToTemplate", s.cs.encodeParams("linkUserDataToTemplate", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r LinkUserDataToTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type LinkUserDataToTemplateResponse struct {
+	Account               string              `json:"account"`
+	Accountid             string              `json:"accountid"`
+	Bits                  int                 `json:"bits"`
+	Bootable              bool                `json:"bootable"`
+	Checksum              string              `json:"checksum"`
+	Childtemplates        []interface{}       `json:"childtemplates"`
+	Created               string              `json:"created"`
+	CrossZones            bool                `json:"crossZones"`
+	Deployasis            bool                `json:"deployasis"`
+	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
+	Details               map[string]string   `json:"details"`
+	Directdownload        bool                `json:"directdownload"`
+	Displaytext           string              `json:"displaytext"`
+	Domain                string              `json:"domain"`
+	Domainid              string              `json:"domainid"`
+	Downloaddetails       []map[string]string `json:"downloaddetails"`
+	Format                string              `json:"format"`
+	Hasannotations        bool                `json:"hasannotations"`
+	Hostid                string              `json:"hostid"`
+	Hostname              string              `json:"hostname"`
+	Hypervisor            string              `json:"hypervisor"`
+	Icon                  interface{}         `json:"icon"`
+	Id                    string              `json:"id"`
+	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
+	Isextractable         bool                `json:"isextractable"`
+	Isfeatured            bool                `json:"isfeatured"`
+	Ispublic              bool                `json:"ispublic"`
+	Isready               bool                `json:"isready"`
+	JobID                 string              `json:"jobid"`
+	Jobstatus             int                 `json:"jobstatus"`
+	Name                  string              `json:"name"`
+	Ostypeid              string              `json:"ostypeid"`
+	Ostypename            string              `json:"ostypename"`
+	Parenttemplateid      string              `json:"parenttemplateid"`
+	Passwordenabled       bool                `json:"passwordenabled"`
+	Physicalsize          int64               `json:"physicalsize"`
+	Project               string              `json:"project"`
+	Projectid             string              `json:"projectid"`
+	Removed               string              `json:"removed"`
+	Requireshvm           bool                `json:"requireshvm"`
+	Size                  int64               `json:"size"`
+	Sourcetemplateid      string              `json:"sourcetemplateid"`
+	Sshkeyenabled         bool                `json:"sshkeyenabled"`
+	Status                string              `json:"status"`
+	Tags                  []Tags              `json:"tags"`
+	Templatetag           string              `json:"templatetag"`
+	Templatetype          string              `json:"templatetype"`
+	Url                   string              `json:"url"`
+	Userdataid            string              `json:"userdataid"`
+	Userdataname          string              `json:"userdataname"`
+	Userdataparams        string              `json:"userdataparams"`
+	Userdatapolicy        string              `json:"userdatapolicy"`
+	Zoneid                string              `json:"zoneid"`
+	Zonename              string              `json:"zonename"`
+}
+
+func (r *LinkUserDataToTemplateResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias LinkUserDataToTemplateResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ListUserDataParams struct {
+	account     optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	name        optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUserDataParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	return u
+}
+
+// ParseListUserDataParams parses url.Values, for example taken from a raw API request,
+// into a new ListUserDataParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUserDataParams(u url.Values) (*ListUserDataParams, error) {
+	p := &ListUserDataParams{}
+	if err := checkParamNames("listUserData", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListUserDataParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *ListUserDataParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *ListUserDataParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *ListUserDataParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *ListUserDataParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *ListUserDataParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetId sets the id param.
+func (p *ListUserDataParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *ListUserDataParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *ListUserDataParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetIsrecursive sets the isrecursive param.
+func (p *ListUserDataParams) SetIsrecursive(v bool) {
+	p.isrecursive = optBool{v: v, ok: true}
+}
+
+// ResetIsrecursive unsets the isrecursive param
+func (p *ListUserDataParams) ResetIsrecursive() {
+	p.isrecursive = optBool{}
+}
+
+// GetIsrecursive returns the isrecursive param and if it is set
+func (p *ListUserDataParams) GetIsrecursive() (bool, bool) {
+	return p.isrecursive.v, p.isrecursive.ok
+}
+
+// SetKeyword sets the keyword param.
+func (p *ListUserDataParams) SetKeyword(v string) {
+	p.keyword = optString{v: v, ok: true}
+}
+
+// ResetKeyword unsets the keyword param
+func (p *ListUserDataParams) ResetKeyword() {
+	p.keyword = optString{}
+}
+
+// GetKeyword returns the keyword param and if it is set
+func (p *ListUserDataParams) GetKeyword() (string, bool) {
+	return p.keyword.v, p.keyword.ok
+}
+
+// SetListall sets the listall param.
+func (p *ListUserDataParams) SetListall(v bool) {
+	p.listall = optBool{v: v, ok: true}
+}
+
+// ResetListall unsets the listall param
+func (p *ListUserDataParams) ResetListall() {
+	p.listall = optBool{}
+}
+
+// GetListall returns the listall param and if it is set
+func (p *ListUserDataParams) GetListall() (bool, bool) {
+	return p.listall.v, p.listall.ok
+}
+
+// SetName sets the name param.
+func (p *ListUserDataParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *ListUserDataParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *ListUserDataParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetPage sets the page param.
+func (p *ListUserDataParams) SetPage(v int) {
+	p.page = optInt{v: v, ok: true}
+}
+
+// ResetPage unsets the page param
+func (p *ListUserDataParams) ResetPage() {
+	p.page = optInt{}
+}
+
+// GetPage returns the page param and if it is set
+func (p *ListUserDataParams) GetPage() (int, bool) {
+	return p.page.v, p.page.ok
+}
+
+// SetPagesize sets the pagesize param.
+func (p *ListUserDataParams) SetPagesize(v int) {
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListUserDataParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListUserDataParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *ListUserDataParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *ListUserDataParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *ListUserDataParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUserDataParams) Clone() *ListUserDataParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUserDataParams) Equal(o *ListUserDataParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid
+}
+
+// serializedListUserDataParams is used to (un)marshal ListUserDataParams using the API param names
+type serializedListUserDataParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *ListUserDataParams) toSerialized() *serializedListUserDataParams {
+	s := &serializedListUserDataParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *ListUserDataParams) fromSerialized(s *serializedListUserDataParams) {
+	*p = ListUserDataParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUserDataParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUserDataParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUserDataParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUserDataParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUserDataParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUserDataParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListUserDataParams instance,
+// as then you are sure you have configured all required params
+func (s *UserDataService) NewListUserDataParams() *ListUserDataParams {
+	p := &ListUserDataParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *UserDataService) GetUserDataID(name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListUserDataParams{}
+
+	p.SetName(name)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListUserData(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.UserData[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.UserData {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *UserDataService) GetUserDataByName(name string, opts ...OptionFunc) (*UserData, int, error) {
+	id, count, err := s.GetUserDataID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetUserDataByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *UserDataService) GetUserDataByID(id string, opts ...OptionFunc) (*UserData, int, error) {
+	p := &ListUserDataParams{}
+
+	p.SetId(id)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListUserData(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.UserData[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for UserData UUID: %s!", id)
+}
+
+// List registered userdatas.
+func (s *UserDataService) ListUserData(p *ListUserDataParams, opts ...CallOption) (*ListUserDataResponse, error) {
+	resp, err := s.cs.newRequest("listUserData", s.cs.encodeParams("listUserData", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListUserDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListUserDataResponse struct {
+	Count    int         `json:"count"`
+	UserData []*UserData `json:"userdata"`
+}
+
+type UserData struct {
+	Account        string `json:"account"`
+	Accountid      string `json:"accountid"`
+	Domain         string `json:"domain"`
+	Domainid       string `json:"domainid"`
+	Hasannotations bool   `json:"hasannotations"`
+	Id             string `json:"id"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Name           string `json:"name"`
+	Params         string `json:"params"`
+	Project        string `json:"project"`
+	Projectid      string `json:"projectid"`
+	Userdata       string `json:"userdata"`
+}
+
+type RegisterUserDataParams struct {
+	account   optString
+	domainid  optString
+	name      optString
+	params    optString
+	projectid optString
+	userdata  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RegisterUserDataParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.params.ok {
+		u.Set("params", p.params.v)
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	if p.userdata.ok {
+		u.Set("userdata", p.userdata.v)
+	}
+	return u
+}
+
+// ParseRegisterUserDataParams parses url.Values, for example taken from a raw API request,
+// into a new RegisterUserDataParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRegisterUserDataParams(u url.Values) (*RegisterUserDataParams, error) {
+	p := &RegisterUserDataParams{}
+	if err := checkParamNames("registerUserData", u, "account", "domainid", "name", "params", "projectid", "userdata"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["params"]; found {
+		p.SetParams(u.Get("params"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["userdata"]; found {
+		p.SetUserdata(u.Get("userdata"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *RegisterUserDataParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *RegisterUserDataParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *RegisterUserDataParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *RegisterUserDataParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *RegisterUserDataParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *RegisterUserDataParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetName sets the name param. This param is required.
+func (p *RegisterUserDataParams) SetName(v string) {
+	p.name = optString{v: v, ok: true}
+}
+
+// ResetName unsets the name param
+func (p *RegisterUserDataParams) ResetName() {
+	p.name = optString{}
+}
+
+// GetName returns the name param and if it is set
+func (p *RegisterUserDataParams) GetName() (string, bool) {
+	return p.name.v, p.name.ok
+}
+
+// SetParams sets the params param.
+func (p *RegisterUserDataParams) SetParams(v string) {
+	p.params = optString{v: v, ok: true}
+}
+
+// ResetParams unsets the params param
+func (p *RegisterUserDataParams) ResetParams() {
+	p.params = optString{}
+}
+
+// GetParams returns the params param and if it is set
+func (p *RegisterUserDataParams) GetParams() (string, bool) {
+	return p.params.v, p.params.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *RegisterUserDataParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *RegisterUserDataParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *RegisterUserDataParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetUserdata sets the userdata param. This param is required.
+func (p *RegisterUserDataParams) SetUserdata(v string) {
+	p.userdata = optString{v: v, ok: true}
+}
+
+// ResetUserdata unsets the userdata param
+func (p *RegisterUserDataParams) ResetUserdata() {
+	p.userdata = optString{}
+}
+
+// GetUserdata returns the userdata param and if it is set
+func (p *RegisterUserDataParams) GetUserdata() (string, bool) {
+	return p.userdata.v, p.userdata.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RegisterUserDataParams) Clone() *RegisterUserDataParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RegisterUserDataParams) Equal(o *RegisterUserDataParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.name == o.name &&
+		p.params == o.params &&
+		p.projectid == o.projectid &&
+		p.userdata == o.userdata
+}
+
+// serializedRegisterUserDataParams is used to (un)marshal RegisterUserDataParams using the API param names
+type serializedRegisterUserDataParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Params    *string `json:"params,omitempty" yaml:"params,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Userdata  *string `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+}
+
+func (p *RegisterUserDataParams) toSerialized() *serializedRegisterUserDataParams {
+	s := &serializedRegisterUserDataParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.params.ok {
+		s.Params = &p.params.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	return s
+}
+
+func (p *RegisterUserDataParams) fromSerialized(s *serializedRegisterUserDataParams) {
+	*p = RegisterUserDataParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Params != nil {
+		p.SetParams(*s.Params)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RegisterUserDataParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RegisterUserDataParams) UnmarshalJSON(b []byte) error {
+	var s serializedRegisterUserDataParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RegisterUserDataParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RegisterUserDataParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRegisterUserDataParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new RegisterUserDataParams instance,
+// as then you are sure you have configured all required params
+func (s *UserDataService) NewRegisterUserDataParams(name string, userdata string) *RegisterUserDataParams {
+	p := &RegisterUserDataParams{}
+	p.SetName(name)
+	p.SetUserdata(userdata)
+	return p
+}
+
+// Register a new userdata.
+//
+// Required params: name, userdata.
+func (s *UserDataService) RegisterUserData(p *RegisterUserDataParams, opts ...CallOption) (*RegisterUserDataResponse, error) {
+	resp, err := s.cs.newPostRequest("registerUserData", s.cs.encodeParams("registerUserData", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r RegisterUserDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type RegisterUserDataResponse struct {
+	Account        string `json:"account"`
+	Accountid      string `json:"accountid"`
+	Domain         string `json:"domain"`
+	Domainid       string `json:"domainid"`
+	Hasannotations bool   `json:"hasannotations"`
+	Id             string `json:"id"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Name           string `json:"name"`
+	Params         string `json:"params"`
+	Project        string `json:"project"`
+	Projectid      string `json:"projectid"`
+	Userdata       string `json:"userdata"`
+}
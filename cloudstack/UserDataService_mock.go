@@ -0,0 +1,252 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./cloudstack/UserDataService.go
+
+// Package cloudstack is a generated GoMock package.
+package cloudstack
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUserDataServiceIface is a mock of UserDataServiceIface interface.
+type MockUserDataServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDataServiceIfaceMockRecorder
+}
+
+// MockUserDataServiceIfaceMockRecorder is the mock recorder for MockUserDataServiceIface.
+type MockUserDataServiceIfaceMockRecorder struct {
+	mock *MockUserDataServiceIface
+}
+
+// NewMockUserDataServiceIface creates a new mock instance.
+func NewMockUserDataServiceIface(ctrl *gomock.Controller) *MockUserDataServiceIface {
+	mock := &MockUserDataServiceIface{ctrl: ctrl}
+	mock.recorder = &MockUserDataServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataServiceIface) EXPECT() *MockUserDataServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteUserData mocks base method.
+func (m *MockUserDataServiceIface) DeleteUserData(p *DeleteUserDataParams, opts ...CallOption) (*DeleteUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserData", varargs...)
+	ret0, _ := ret[0].(*DeleteUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockUserDataServiceIfaceMockRecorder) DeleteUserData(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockUserDataServiceIface)(nil).DeleteUserData), varargs...)
+}
+
+// GetUserDataByID mocks base method.
+func (m *MockUserDataServiceIface) GetUserDataByID(id string, opts ...OptionFunc) (*UserData, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataByID", varargs...)
+	ret0, _ := ret[0].(*UserData)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataByID indicates an expected call of GetUserDataByID.
+func (mr *MockUserDataServiceIfaceMockRecorder) GetUserDataByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataByID", reflect.TypeOf((*MockUserDataServiceIface)(nil).GetUserDataByID), varargs...)
+}
+
+// GetUserDataByName mocks base method.
+func (m *MockUserDataServiceIface) GetUserDataByName(name string, opts ...OptionFunc) (*UserData, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataByName", varargs...)
+	ret0, _ := ret[0].(*UserData)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataByName indicates an expected call of GetUserDataByName.
+func (mr *MockUserDataServiceIfaceMockRecorder) GetUserDataByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataByName", reflect.TypeOf((*MockUserDataServiceIface)(nil).GetUserDataByName), varargs...)
+}
+
+// GetUserDataID mocks base method.
+func (m *MockUserDataServiceIface) GetUserDataID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataID indicates an expected call of GetUserDataID.
+func (mr *MockUserDataServiceIfaceMockRecorder) GetUserDataID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataID", reflect.TypeOf((*MockUserDataServiceIface)(nil).GetUserDataID), varargs...)
+}
+
+// LinkUserDataToTemplate mocks base method.
+func (m *MockUserDataServiceIface) LinkUserDataToTemplate(p *LinkUserDataToTemplateParams, opts ...CallOption) (*LinkUserDataToTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LinkUserDataToTemplate", varargs...)
+	ret0, _ := ret[0].(*LinkUserDataToTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkUserDataToTemplate indicates an expected call of LinkUserDataToTemplate.
+func (mr *MockUserDataServiceIfaceMockRecorder) LinkUserDataToTemplate(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkUserDataToTemplate", reflect.TypeOf((*MockUserDataServiceIface)(nil).LinkUserDataToTemplate), varargs...)
+}
+
+// ListUserData mocks base method.
+func (m *MockUserDataServiceIface) ListUserData(p *ListUserDataParams, opts ...CallOption) (*ListUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUserData", varargs...)
+	ret0, _ := ret[0].(*ListUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserData indicates an expected call of ListUserData.
+func (mr *MockUserDataServiceIfaceMockRecorder) ListUserData(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserData", reflect.TypeOf((*MockUserDataServiceIface)(nil).ListUserData), varargs...)
+}
+
+// NewDeleteUserDataParams mocks base method.
+func (m *MockUserDataServiceIface) NewDeleteUserDataParams(id string) *DeleteUserDataParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteUserDataParams", id)
+	ret0, _ := ret[0].(*DeleteUserDataParams)
+	return ret0
+}
+
+// NewDeleteUserDataParams indicates an expected call of NewDeleteUserDataParams.
+func (mr *MockUserDataServiceIfaceMockRecorder) NewDeleteUserDataParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteUserDataParams", reflect.TypeOf((*MockUserDataServiceIface)(nil).NewDeleteUserDataParams), id)
+}
+
+// NewLinkUserDataToTemplateParams mocks base method.
+func (m *MockUserDataServiceIface) NewLinkUserDataToTemplateParams() *LinkUserDataToTemplateParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewLinkUserDataToTemplateParams")
+	ret0, _ := ret[0].(*LinkUserDataToTemplateParams)
+	return ret0
+}
+
+// NewLinkUserDataToTemplateParams indicates an expected call of NewLinkUserDataToTemplateParams.
+func (mr *MockUserDataServiceIfaceMockRecorder) NewLinkUserDataToTemplateParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewLinkUserDataToTemplateParams", reflect.TypeOf((*MockUserDataServiceIface)(nil).NewLinkUserDataToTemplateParams))
+}
+
+// NewListUserDataParams mocks base method.
+func (m *MockUserDataServiceIface) NewListUserDataParams() *ListUserDataParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListUserDataParams")
+	ret0, _ := ret[0].(*ListUserDataParams)
+	return ret0
+}
+
+// NewListUserDataParams indicates an expected call of NewListUserDataParams.
+func (mr *MockUserDataServiceIfaceMockRecorder) NewListUserDataParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListUserDataParams", reflect.TypeOf((*MockUserDataServiceIface)(nil).NewListUserDataParams))
+}
+
+// NewRegisterUserDataParams mocks base method.
+func (m *MockUserDataServiceIface) NewRegisterUserDataParams(name, userdata string) *RegisterUserDataParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRegisterUserDataParams", name, userdata)
+	ret0, _ := ret[0].(*RegisterUserDataParams)
+	return ret0
+}
+
+// NewRegisterUserDataParams indicates an expected call of NewRegisterUserDataParams.
+func (mr *MockUserDataServiceIfaceMockRecorder) NewRegisterUserDataParams(name, userdata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRegisterUserDataParams", reflect.TypeOf((*MockUserDataServiceIface)(nil).NewRegisterUserDataParams), name, userdata)
+}
+
+// RegisterUserData mocks base method.
+func (m *MockUserDataServiceIface) RegisterUserData(p *RegisterUserDataParams, opts ...CallOption) (*RegisterUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterUserData", varargs...)
+	ret0, _ := ret[0].(*RegisterUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterUserData indicates an expected call of RegisterUserData.
+func (mr *MockUserDataServiceIfaceMockRecorder) RegisterUserData(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserData", reflect.TypeOf((*MockUserDataServiceIface)(nil).RegisterUserData), varargs...)
+}
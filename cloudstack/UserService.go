@@ -28,276 +28,403 @@ import (
 )
 
 type UserServiceIface interface {
-	CreateUser(p *CreateUserParams) (*CreateUserResponse, error)
+	CreateUser(p *CreateUserParams, opts ...CallOption) (*CreateUserResponse, error)
 	NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams
-	DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error)
+	DeleteUser(p *DeleteUserParams, opts ...CallOption) (*DeleteUserResponse, error)
 	NewDeleteUserParams(id string) *DeleteUserParams
-	DisableUser(p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUser(p *DisableUserParams, opts ...CallOption) (*DisableUserResponse, error)
 	NewDisableUserParams(id string) *DisableUserParams
-	EnableUser(p *EnableUserParams) (*EnableUserResponse, error)
+	EnableUser(p *EnableUserParams, opts ...CallOption) (*EnableUserResponse, error)
 	NewEnableUserParams(id string) *EnableUserParams
-	GetUser(p *GetUserParams) (*GetUserResponse, error)
+	GetUser(p *GetUserParams, opts ...CallOption) (*GetUserResponse, error)
 	NewGetUserParams(userapikey string) *GetUserParams
-	GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error)
+	GetUserKeys(p *GetUserKeysParams, opts ...CallOption) (*GetUserKeysResponse, error)
 	NewGetUserKeysParams(id string) *GetUserKeysParams
-	GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams, opts ...CallOption) (*GetVirtualMachineUserDataResponse, error)
 	NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams
-	ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
+	ListUsers(p *ListUsersParams, opts ...CallOption) (*ListUsersResponse, error)
 	NewListUsersParams() *ListUsersParams
 	GetUserByID(id string, opts ...OptionFunc) (*User, int, error)
-	LockUser(p *LockUserParams) (*LockUserResponse, error)
+	LockUser(p *LockUserParams, opts ...CallOption) (*LockUserResponse, error)
 	NewLockUserParams(id string) *LockUserParams
-	RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	RegisterUserKeys(p *RegisterUserKeysParams, opts ...CallOption) (*RegisterUserKeysResponse, error)
 	NewRegisterUserKeysParams(id string) *RegisterUserKeysParams
-	UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error)
+	UpdateUser(p *UpdateUserParams, opts ...CallOption) (*UpdateUserResponse, error)
 	NewUpdateUserParams(id string) *UpdateUserParams
 }
 
 type CreateUserParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateUserParams) toURLValues() url.Values {
+	account   optString
+	domainid  optString
+	email     optString
+	firstname optString
+	lastname  optString
+	password  optString
+	timezone  optString
+	userid    optString
+	username  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["email"]; found {
-		u.Set("email", v.(string))
+	if p.email.ok {
+		u.Set("email", p.email.v)
 	}
-	if v, found := p.p["firstname"]; found {
-		u.Set("firstname", v.(string))
+	if p.firstname.ok {
+		u.Set("firstname", p.firstname.v)
 	}
-	if v, found := p.p["lastname"]; found {
-		u.Set("lastname", v.(string))
+	if p.lastname.ok {
+		u.Set("lastname", p.lastname.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *CreateUserParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateUserParams parses url.Values, for example taken from a raw API request,
+// into a new CreateUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateUserParams(u url.Values) (*CreateUserParams, error) {
+	p := &CreateUserParams{}
+	if err := checkParamNames("createUser", u, "account", "domainid", "email", "firstname", "lastname", "password", "timezone", "userid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["email"]; found {
+		p.SetEmail(u.Get("email"))
 	}
-	p.p["account"] = v
+	if _, found := u["firstname"]; found {
+		p.SetFirstname(u.Get("firstname"))
+	}
+	if _, found := u["lastname"]; found {
+		p.SetLastname(u.Get("lastname"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param. This param is required.
+func (p *CreateUserParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateUserParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateUserParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateUserParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateUserParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateUserParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEmail sets the email param. This param is required.
 func (p *CreateUserParams) SetEmail(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["email"] = v
+	p.email = optString{v: v, ok: true}
 }
 
+// ResetEmail unsets the email param
 func (p *CreateUserParams) ResetEmail() {
-	if p.p != nil && p.p["email"] != nil {
-		delete(p.p, "email")
-	}
+	p.email = optString{}
 }
 
+// GetEmail returns the email param and if it is set
 func (p *CreateUserParams) GetEmail() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["email"].(string)
-	return value, ok
+	return p.email.v, p.email.ok
 }
 
+// SetFirstname sets the firstname param. This param is required.
 func (p *CreateUserParams) SetFirstname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["firstname"] = v
+	p.firstname = optString{v: v, ok: true}
 }
 
+// ResetFirstname unsets the firstname param
 func (p *CreateUserParams) ResetFirstname() {
-	if p.p != nil && p.p["firstname"] != nil {
-		delete(p.p, "firstname")
-	}
+	p.firstname = optString{}
 }
 
+// GetFirstname returns the firstname param and if it is set
 func (p *CreateUserParams) GetFirstname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["firstname"].(string)
-	return value, ok
+	return p.firstname.v, p.firstname.ok
 }
 
+// SetLastname sets the lastname param. This param is required.
 func (p *CreateUserParams) SetLastname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lastname"] = v
+	p.lastname = optString{v: v, ok: true}
 }
 
+// ResetLastname unsets the lastname param
 func (p *CreateUserParams) ResetLastname() {
-	if p.p != nil && p.p["lastname"] != nil {
-		delete(p.p, "lastname")
-	}
+	p.lastname = optString{}
 }
 
+// GetLastname returns the lastname param and if it is set
 func (p *CreateUserParams) GetLastname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lastname"].(string)
-	return value, ok
+	return p.lastname.v, p.lastname.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *CreateUserParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *CreateUserParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *CreateUserParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetTimezone sets the timezone param.
 func (p *CreateUserParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timezone"] = v
+	p.timezone = optString{v: v, ok: true}
 }
 
+// ResetTimezone unsets the timezone param
 func (p *CreateUserParams) ResetTimezone() {
-	if p.p != nil && p.p["timezone"] != nil {
-		delete(p.p, "timezone")
-	}
+	p.timezone = optString{}
 }
 
+// GetTimezone returns the timezone param and if it is set
 func (p *CreateUserParams) GetTimezone() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["timezone"].(string)
-	return value, ok
+	return p.timezone.v, p.timezone.ok
 }
 
+// SetUserid sets the userid param.
 func (p *CreateUserParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *CreateUserParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *CreateUserParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	return p.userid.v, p.userid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *CreateUserParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *CreateUserParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *CreateUserParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateUserParams) Clone() *CreateUserParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateUserParams) Equal(o *CreateUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.email == o.email &&
+		p.firstname == o.firstname &&
+		p.lastname == o.lastname &&
+		p.password == o.password &&
+		p.timezone == o.timezone &&
+		p.userid == o.userid &&
+		p.username == o.username
+}
+
+// serializedCreateUserParams is used to (un)marshal CreateUserParams using the API param names
+type serializedCreateUserParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Email     *string `json:"email,omitempty" yaml:"email,omitempty"`
+	Firstname *string `json:"firstname,omitempty" yaml:"firstname,omitempty"`
+	Lastname  *string `json:"lastname,omitempty" yaml:"lastname,omitempty"`
+	Password  *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Timezone  *string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userid    *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Username  *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *CreateUserParams) toSerialized() *serializedCreateUserParams {
+	s := &serializedCreateUserParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.email.ok {
+		s.Email = &p.email.v
+	}
+	if p.firstname.ok {
+		s.Firstname = &p.firstname.v
+	}
+	if p.lastname.ok {
+		s.Lastname = &p.lastname.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *CreateUserParams) fromSerialized(s *serializedCreateUserParams) {
+	*p = CreateUserParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if s.Email != nil {
+		p.SetEmail(*s.Email)
+	}
+	if s.Firstname != nil {
+		p.SetFirstname(*s.Firstname)
+	}
+	if s.Lastname != nil {
+		p.SetLastname(*s.Lastname)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams {
 	p := &CreateUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["email"] = email
-	p.p["firstname"] = firstname
-	p.p["lastname"] = lastname
-	p.p["password"] = password
-	p.p["username"] = username
+	p.SetAccount(account)
+	p.SetEmail(email)
+	p.SetFirstname(firstname)
+	p.SetLastname(lastname)
+	p.SetPassword(password)
+	p.SetUsername(username)
 	return p
 }
 
-// Creates a user for an account that already exists
-func (s *UserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, error) {
-	resp, err := s.cs.newPostRequest("createUser", p.toURLValues())
+// Creates a user for an account that already exists.
+//
+// Required params: account, email, firstname, lastname, password, username.
+func (s *UserService) CreateUser(p *CreateUserParams, opts ...CallOption) (*CreateUserResponse, error) {
+	resp, err := s.cs.newPostRequest("createUser", s.cs.encodeParams("createUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -344,53 +471,132 @@ type CreateUserResponse struct {
 }
 
 type DeleteUserParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteUserParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteUserParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteUserParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteUserParams(u url.Values) (*DeleteUserParams, error) {
+	p := &DeleteUserParams{}
+	if err := checkParamNames("deleteUser", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteUserParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteUserParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteUserParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteUserParams) Clone() *DeleteUserParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteUserParams) Equal(o *DeleteUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteUserParams is used to (un)marshal DeleteUserParams using the API param names
+type serializedDeleteUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteUserParams) toSerialized() *serializedDeleteUserParams {
+	s := &serializedDeleteUserParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteUserParams) fromSerialized(s *serializedDeleteUserParams) {
+	*p = DeleteUserParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewDeleteUserParams(id string) *DeleteUserParams {
 	p := &DeleteUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a user for an account
-func (s *UserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
-	resp, err := s.cs.newRequest("deleteUser", p.toURLValues())
+// Deletes a user for an account.
+//
+// Required params: id.
+func (s *UserService) DeleteUser(p *DeleteUserParams, opts ...CallOption) (*DeleteUserResponse, error) {
+	resp, err := s.cs.newRequest("deleteUser", s.cs.encodeParams("deleteUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -438,53 +644,133 @@ func (r *DeleteUserResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DisableUserParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DisableUserParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DisableUserParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableUserParams parses url.Values, for example taken from a raw API request,
+// into a new DisableUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableUserParams(u url.Values) (*DisableUserParams, error) {
+	p := &DisableUserParams{}
+	if err := checkParamNames("disableUser", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DisableUserParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DisableUserParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DisableUserParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableUserParams) Clone() *DisableUserParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableUserParams) Equal(o *DisableUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDisableUserParams is used to (un)marshal DisableUserParams using the API param names
+type serializedDisableUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DisableUserParams) toSerialized() *serializedDisableUserParams {
+	s := &serializedDisableUserParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DisableUserParams) fromSerialized(s *serializedDisableUserParams) {
+	*p = DisableUserParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewDisableUserParams(id string) *DisableUserParams {
 	p := &DisableUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Disables a user account
-func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
-	resp, err := s.cs.newRequest("disableUser", p.toURLValues())
+// Disables a user account.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *UserService) DisableUser(p *DisableUserParams, opts ...CallOption) (*DisableUserResponse, error) {
+	resp, err := s.cs.newRequest("disableUser", s.cs.encodeParams("disableUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -495,8 +781,8 @@ func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, e
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -547,53 +833,132 @@ type DisableUserResponse struct {
 }
 
 type EnableUserParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *EnableUserParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *EnableUserParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableUserParams parses url.Values, for example taken from a raw API request,
+// into a new EnableUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableUserParams(u url.Values) (*EnableUserParams, error) {
+	p := &EnableUserParams{}
+	if err := checkParamNames("enableUser", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *EnableUserParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *EnableUserParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *EnableUserParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableUserParams) Clone() *EnableUserParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableUserParams) Equal(o *EnableUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedEnableUserParams is used to (un)marshal EnableUserParams using the API param names
+type serializedEnableUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *EnableUserParams) toSerialized() *serializedEnableUserParams {
+	s := &serializedEnableUserParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *EnableUserParams) fromSerialized(s *serializedEnableUserParams) {
+	*p = EnableUserParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewEnableUserParams(id string) *EnableUserParams {
 	p := &EnableUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Enables a user account
-func (s *UserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
-	resp, err := s.cs.newRequest("enableUser", p.toURLValues())
+// Enables a user account.
+//
+// Required params: id.
+func (s *UserService) EnableUser(p *EnableUserParams, opts ...CallOption) (*EnableUserResponse, error) {
+	resp, err := s.cs.newRequest("enableUser", s.cs.encodeParams("enableUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -640,53 +1005,132 @@ type EnableUserResponse struct {
 }
 
 type GetUserParams struct {
-	p map[string]interface{}
+	userapikey optString
 }
 
-func (p *GetUserParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["userapikey"]; found {
-		u.Set("userapikey", v.(string))
+	if p.userapikey.ok {
+		u.Set("userapikey", p.userapikey.v)
 	}
 	return u
 }
 
-func (p *GetUserParams) SetUserapikey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetUserParams parses url.Values, for example taken from a raw API request,
+// into a new GetUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetUserParams(u url.Values) (*GetUserParams, error) {
+	p := &GetUserParams{}
+	if err := checkParamNames("getUser", u, "userapikey"); err != nil {
+		return nil, err
+	}
+	if _, found := u["userapikey"]; found {
+		p.SetUserapikey(u.Get("userapikey"))
 	}
-	p.p["userapikey"] = v
+	return p, nil
+}
+
+// SetUserapikey sets the userapikey param. This param is required.
+func (p *GetUserParams) SetUserapikey(v string) {
+	p.userapikey = optString{v: v, ok: true}
 }
 
+// ResetUserapikey unsets the userapikey param
 func (p *GetUserParams) ResetUserapikey() {
-	if p.p != nil && p.p["userapikey"] != nil {
-		delete(p.p, "userapikey")
-	}
+	p.userapikey = optString{}
 }
 
+// GetUserapikey returns the userapikey param and if it is set
 func (p *GetUserParams) GetUserapikey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userapikey.v, p.userapikey.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetUserParams) Clone() *GetUserParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetUserParams) Equal(o *GetUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.userapikey == o.userapikey
+}
+
+// serializedGetUserParams is used to (un)marshal GetUserParams using the API param names
+type serializedGetUserParams struct {
+	Userapikey *string `json:"userapikey,omitempty" yaml:"userapikey,omitempty"`
+}
+
+func (p *GetUserParams) toSerialized() *serializedGetUserParams {
+	s := &serializedGetUserParams{}
+	if p.userapikey.ok {
+		s.Userapikey = &p.userapikey.v
+	}
+	return s
+}
+
+func (p *GetUserParams) fromSerialized(s *serializedGetUserParams) {
+	*p = GetUserParams{}
+	if s.Userapikey != nil {
+		p.SetUserapikey(*s.Userapikey)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["userapikey"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewGetUserParams(userapikey string) *GetUserParams {
 	p := &GetUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["userapikey"] = userapikey
+	p.SetUserapikey(userapikey)
 	return p
 }
 
-// Find user account by API key
-func (s *UserService) GetUser(p *GetUserParams) (*GetUserResponse, error) {
-	resp, err := s.cs.newRequest("getUser", p.toURLValues())
+// Find user account by API key.
+//
+// Required params: userapikey.
+func (s *UserService) GetUser(p *GetUserParams, opts ...CallOption) (*GetUserResponse, error) {
+	resp, err := s.cs.newRequest("getUser", s.cs.encodeParams("getUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -729,53 +1173,132 @@ type GetUserResponse struct {
 }
 
 type GetUserKeysParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *GetUserKeysParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetUserKeysParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *GetUserKeysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetUserKeysParams parses url.Values, for example taken from a raw API request,
+// into a new GetUserKeysParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetUserKeysParams(u url.Values) (*GetUserKeysParams, error) {
+	p := &GetUserKeysParams{}
+	if err := checkParamNames("getUserKeys", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *GetUserKeysParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *GetUserKeysParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *GetUserKeysParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetUserKeysParams) Clone() *GetUserKeysParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetUserKeysParams) Equal(o *GetUserKeysParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedGetUserKeysParams is used to (un)marshal GetUserKeysParams using the API param names
+type serializedGetUserKeysParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *GetUserKeysParams) toSerialized() *serializedGetUserKeysParams {
+	s := &serializedGetUserKeysParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *GetUserKeysParams) fromSerialized(s *serializedGetUserKeysParams) {
+	*p = GetUserKeysParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetUserKeysParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetUserKeysParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetUserKeysParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetUserKeysParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetUserKeysParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetUserKeysParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetUserKeysParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewGetUserKeysParams(id string) *GetUserKeysParams {
 	p := &GetUserKeysParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// This command allows the user to query the seceret and API keys for the account
-func (s *UserService) GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error) {
-	resp, err := s.cs.newRequest("getUserKeys", p.toURLValues())
+// This command allows the user to query the seceret and API keys for the account.
+//
+// Required params: id.
+func (s *UserService) GetUserKeys(p *GetUserKeysParams, opts ...CallOption) (*GetUserKeysResponse, error) {
+	resp, err := s.cs.newRequest("getUserKeys", s.cs.encodeParams("getUserKeys", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -800,53 +1323,132 @@ type GetUserKeysResponse struct {
 }
 
 type GetVirtualMachineUserDataParams struct {
-	p map[string]interface{}
+	virtualmachineid optString
 }
 
-func (p *GetVirtualMachineUserDataParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetVirtualMachineUserDataParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *GetVirtualMachineUserDataParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+// ParseGetVirtualMachineUserDataParams parses url.Values, for example taken from a raw API request,
+// into a new GetVirtualMachineUserDataParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetVirtualMachineUserDataParams(u url.Values) (*GetVirtualMachineUserDataParams, error) {
+	p := &GetVirtualMachineUserDataParams{}
+	if err := checkParamNames("getVirtualMachineUserData", u, "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *GetVirtualMachineUserDataParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *GetVirtualMachineUserDataParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *GetVirtualMachineUserDataParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetVirtualMachineUserDataParams) Clone() *GetVirtualMachineUserDataParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetVirtualMachineUserDataParams) Equal(o *GetVirtualMachineUserDataParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedGetVirtualMachineUserDataParams is used to (un)marshal GetVirtualMachineUserDataParams using the API param names
+type serializedGetVirtualMachineUserDataParams struct {
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *GetVirtualMachineUserDataParams) toSerialized() *serializedGetVirtualMachineUserDataParams {
+	s := &serializedGetVirtualMachineUserDataParams{}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *GetVirtualMachineUserDataParams) fromSerialized(s *serializedGetVirtualMachineUserDataParams) {
+	*p = GetVirtualMachineUserDataParams{}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetVirtualMachineUserDataParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetVirtualMachineUserDataParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetVirtualMachineUserDataParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetVirtualMachineUserDataParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetVirtualMachineUserDataParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetVirtualMachineUserDataParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetVirtualMachineUserDataParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams {
 	p := &GetVirtualMachineUserDataParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Returns user data associated with the VM
-func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
-	resp, err := s.cs.newRequest("getVirtualMachineUserData", p.toURLValues())
+// Returns user data associated with the VM.
+//
+// Required params: virtualmachineid.
+func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams, opts ...CallOption) (*GetVirtualMachineUserDataResponse, error) {
+	resp, err := s.cs.newRequest("getVirtualMachineUserData", s.cs.encodeParams("getVirtualMachineUserData", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -871,325 +1473,485 @@ type GetVirtualMachineUserDataResponse struct {
 }
 
 type ListUsersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUsersParams) toURLValues() url.Values {
+	account     optString
+	accounttype optInt
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	showicon    optBool
+	state       optString
+	username    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListUsersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("accounttype", vv)
+	if p.accounttype.ok {
+		u.Set("accounttype", strconv.Itoa(p.accounttype.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *ListUsersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListUsersParams parses url.Values, for example taken from a raw API request,
+// into a new ListUsersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListUsersParams(u url.Values) (*ListUsersParams, error) {
+	p := &ListUsersParams{}
+	if err := checkParamNames("listUsers", u, "account", "accounttype", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "showicon", "state", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["accounttype"]; found {
+		v, err := strconv.Atoi(u.Get("accounttype"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accounttype: %v", err)
+		}
+		p.SetAccounttype(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListUsersParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListUsersParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListUsersParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccounttype sets the accounttype param.
 func (p *ListUsersParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounttype"] = v
+	p.accounttype = optInt{v: v, ok: true}
 }
 
+// ResetAccounttype unsets the accounttype param
 func (p *ListUsersParams) ResetAccounttype() {
-	if p.p != nil && p.p["accounttype"] != nil {
-		delete(p.p, "accounttype")
-	}
+	p.accounttype = optInt{}
 }
 
+// GetAccounttype returns the accounttype param and if it is set
 func (p *ListUsersParams) GetAccounttype() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accounttype"].(int)
-	return value, ok
+	return p.accounttype.v, p.accounttype.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListUsersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListUsersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListUsersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListUsersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListUsersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListUsersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListUsersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListUsersParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListUsersParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListUsersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListUsersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListUsersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListUsersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListUsersParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListUsersParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListUsersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListUsersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListUsersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListUsersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListUsersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListUsersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListUsersParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListUsersParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListUsersParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetState sets the state param.
 func (p *ListUsersParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListUsersParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListUsersParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetUsername sets the username param.
 func (p *ListUsersParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *ListUsersParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *ListUsersParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListUsersParams) Clone() *ListUsersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListUsersParams) Equal(o *ListUsersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accounttype == o.accounttype &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showicon == o.showicon &&
+		p.state == o.state &&
+		p.username == o.username
+}
+
+// serializedListUsersParams is used to (un)marshal ListUsersParams using the API param names
+type serializedListUsersParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Accounttype *int    `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showicon    *bool   `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Username    *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *ListUsersParams) toSerialized() *serializedListUsersParams {
+	s := &serializedListUsersParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accounttype.ok {
+		s.Accounttype = &p.accounttype.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *ListUsersParams) fromSerialized(s *serializedListUsersParams) {
+	*p = ListUsersParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accounttype != nil {
+		p.SetAccounttype(*s.Accounttype)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListUsersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListUsersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListUsersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListUsersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListUsersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListUsersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewListUsersParams() *ListUsersParams {
 	p := &ListUsersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, error) {
 	p := &ListUsersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1217,9 +1979,9 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 	return nil, l.Count, fmt.Errorf("There is more then one result for User UUID: %s!", id)
 }
 
-// Lists user accounts
-func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
-	resp, err := s.cs.newRequest("listUsers", p.toURLValues())
+// Lists user accounts.
+func (s *UserService) ListUsers(p *ListUsersParams, opts ...CallOption) (*ListUsersResponse, error) {
+	resp, err := s.cs.newRequest("listUsers", s.cs.encodeParams("listUsers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1267,53 +2029,132 @@ type User struct {
 }
 
 type LockUserParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *LockUserParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *LockUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *LockUserParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseLockUserParams parses url.Values, for example taken from a raw API request,
+// into a new LockUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseLockUserParams(u url.Values) (*LockUserParams, error) {
+	p := &LockUserParams{}
+	if err := checkParamNames("lockUser", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *LockUserParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *LockUserParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *LockUserParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *LockUserParams) Clone() *LockUserParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *LockUserParams) Equal(o *LockUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedLockUserParams is used to (un)marshal LockUserParams using the API param names
+type serializedLockUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *LockUserParams) toSerialized() *serializedLockUserParams {
+	s := &serializedLockUserParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *LockUserParams) fromSerialized(s *serializedLockUserParams) {
+	*p = LockUserParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p LockUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *LockUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedLockUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p LockUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *LockUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedLockUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new LockUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewLockUserParams(id string) *LockUserParams {
 	p := &LockUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Locks a user account
-func (s *UserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
-	resp, err := s.cs.newRequest("lockUser", p.toURLValues())
+// Locks a user account.
+//
+// Required params: id.
+func (s *UserService) LockUser(p *LockUserParams, opts ...CallOption) (*LockUserResponse, error) {
+	resp, err := s.cs.newRequest("lockUser", s.cs.encodeParams("lockUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1360,53 +2201,136 @@ type LockUserResponse struct {
 }
 
 type RegisterUserKeysParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RegisterUserKeysParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RegisterUserKeysParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RegisterUserKeysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRegisterUserKeysParams parses url.Values, for example taken from a raw API request,
+// into a new RegisterUserKeysParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRegisterUserKeysParams(u url.Values) (*RegisterUserKeysParams, error) {
+	p := &RegisterUserKeysParams{}
+	if err := checkParamNames("registerUserKeys", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RegisterUserKeysParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RegisterUserKeysParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RegisterUserKeysParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RegisterUserKeysParams) Clone() *RegisterUserKeysParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RegisterUserKeysParams) Equal(o *RegisterUserKeysParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRegisterUserKeysParams is used to (un)marshal RegisterUserKeysParams using the API param names
+type serializedRegisterUserKeysParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RegisterUserKeysParams) toSerialized() *serializedRegisterUserKeysParams {
+	s := &serializedRegisterUserKeysParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RegisterUserKeysParams) fromSerialized(s *serializedRegisterUserKeysParams) {
+	*p = RegisterUserKeysParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RegisterUserKeysParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RegisterUserKeysParams) UnmarshalJSON(b []byte) error {
+	var s serializedRegisterUserKeysParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RegisterUserKeysParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RegisterUserKeysParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRegisterUserKeysParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RegisterUserKeysParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewRegisterUserKeysParams(id string) *RegisterUserKeysParams {
 	p := &RegisterUserKeysParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// This command allows a user to register for the developer API, returning a secret key and an API key. This request is made through the integration API port, so it is a privileged command and must be made on behalf of a user. It is up to the implementer just how the username and password are entered, and then how that translates to an integration API request. Both secret key and API key should be returned to the user
-func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
-	resp, err := s.cs.newRequest("registerUserKeys", p.toURLValues())
+// This command allows a user to register for the developer API, returning a secret key and an API
+// key. This request is made through the integration API port, so it is a privileged command and
+// must be made on behalf of a user. It is up to the implementer just how the username and password
+// are entered, and then how that translates to an integration API request. Both secret key and API
+// key should be returned to the user.
+//
+// Required params: id.
+func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams, opts ...CallOption) (*RegisterUserKeysResponse, error) {
+	resp, err := s.cs.newRequest("registerUserKeys", s.cs.encodeParams("registerUserKeys", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1431,294 +2355,436 @@ type RegisterUserKeysResponse struct {
 }
 
 type UpdateUserParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateUserParams) toURLValues() url.Values {
+	currentpassword optString
+	email           optString
+	firstname       optString
+	id              optString
+	lastname        optString
+	mandate2fa      optBool
+	password        optString
+	timezone        optString
+	userapikey      optString
+	username        optString
+	usersecretkey   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["currentpassword"]; found {
-		u.Set("currentpassword", v.(string))
+	if p.currentpassword.ok {
+		u.Set("currentpassword", p.currentpassword.v)
 	}
-	if v, found := p.p["email"]; found {
-		u.Set("email", v.(string))
+	if p.email.ok {
+		u.Set("email", p.email.v)
 	}
-	if v, found := p.p["firstname"]; found {
-		u.Set("firstname", v.(string))
+	if p.firstname.ok {
+		u.Set("firstname", p.firstname.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["lastname"]; found {
-		u.Set("lastname", v.(string))
+	if p.lastname.ok {
+		u.Set("lastname", p.lastname.v)
 	}
-	if v, found := p.p["mandate2fa"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("mandate2fa", vv)
+	if p.mandate2fa.ok {
+		u.Set("mandate2fa", strconv.FormatBool(p.mandate2fa.v))
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if p.timezone.ok {
+		u.Set("timezone", p.timezone.v)
 	}
-	if v, found := p.p["userapikey"]; found {
-		u.Set("userapikey", v.(string))
+	if p.userapikey.ok {
+		u.Set("userapikey", p.userapikey.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
-	if v, found := p.p["usersecretkey"]; found {
-		u.Set("usersecretkey", v.(string))
+	if p.usersecretkey.ok {
+		u.Set("usersecretkey", p.usersecretkey.v)
 	}
 	return u
 }
 
-func (p *UpdateUserParams) SetCurrentpassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateUserParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateUserParams(u url.Values) (*UpdateUserParams, error) {
+	p := &UpdateUserParams{}
+	if err := checkParamNames("updateUser", u, "currentpassword", "email", "firstname", "id", "lastname", "mandate2fa", "password", "timezone", "userapikey", "username", "usersecretkey"); err != nil {
+		return nil, err
+	}
+	if _, found := u["currentpassword"]; found {
+		p.SetCurrentpassword(u.Get("currentpassword"))
 	}
-	p.p["currentpassword"] = v
+	if _, found := u["email"]; found {
+		p.SetEmail(u.Get("email"))
+	}
+	if _, found := u["firstname"]; found {
+		p.SetFirstname(u.Get("firstname"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["lastname"]; found {
+		p.SetLastname(u.Get("lastname"))
+	}
+	if _, found := u["mandate2fa"]; found {
+		v, err := strconv.ParseBool(u.Get("mandate2fa"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param mandate2fa: %v", err)
+		}
+		p.SetMandate2fa(v)
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["timezone"]; found {
+		p.SetTimezone(u.Get("timezone"))
+	}
+	if _, found := u["userapikey"]; found {
+		p.SetUserapikey(u.Get("userapikey"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	if _, found := u["usersecretkey"]; found {
+		p.SetUsersecretkey(u.Get("usersecretkey"))
+	}
+	return p, nil
+}
+
+// SetCurrentpassword sets the currentpassword param.
+func (p *UpdateUserParams) SetCurrentpassword(v string) {
+	p.currentpassword = optString{v: v, ok: true}
 }
 
+// ResetCurrentpassword unsets the currentpassword param
 func (p *UpdateUserParams) ResetCurrentpassword() {
-	if p.p != nil && p.p["currentpassword"] != nil {
-		delete(p.p, "currentpassword")
-	}
+	p.currentpassword = optString{}
 }
 
+// GetCurrentpassword returns the currentpassword param and if it is set
 func (p *UpdateUserParams) GetCurrentpassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["currentpassword"].(string)
-	return value, ok
+	return p.currentpassword.v, p.currentpassword.ok
 }
 
+// SetEmail sets the email param.
 func (p *UpdateUserParams) SetEmail(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["email"] = v
+	p.email = optString{v: v, ok: true}
 }
 
+// ResetEmail unsets the email param
 func (p *UpdateUserParams) ResetEmail() {
-	if p.p != nil && p.p["email"] != nil {
-		delete(p.p, "email")
-	}
+	p.email = optString{}
 }
 
+// GetEmail returns the email param and if it is set
 func (p *UpdateUserParams) GetEmail() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["email"].(string)
-	return value, ok
+	return p.email.v, p.email.ok
 }
 
+// SetFirstname sets the firstname param.
 func (p *UpdateUserParams) SetFirstname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["firstname"] = v
+	p.firstname = optString{v: v, ok: true}
 }
 
+// ResetFirstname unsets the firstname param
 func (p *UpdateUserParams) ResetFirstname() {
-	if p.p != nil && p.p["firstname"] != nil {
-		delete(p.p, "firstname")
-	}
+	p.firstname = optString{}
 }
 
+// GetFirstname returns the firstname param and if it is set
 func (p *UpdateUserParams) GetFirstname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["firstname"].(string)
-	return value, ok
+	return p.firstname.v, p.firstname.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateUserParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateUserParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateUserParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetLastname sets the lastname param.
 func (p *UpdateUserParams) SetLastname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lastname"] = v
+	p.lastname = optString{v: v, ok: true}
 }
 
+// ResetLastname unsets the lastname param
 func (p *UpdateUserParams) ResetLastname() {
-	if p.p != nil && p.p["lastname"] != nil {
-		delete(p.p, "lastname")
-	}
+	p.lastname = optString{}
 }
 
+// GetLastname returns the lastname param and if it is set
 func (p *UpdateUserParams) GetLastname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["lastname"].(string)
-	return value, ok
+	return p.lastname.v, p.lastname.ok
 }
 
+// SetMandate2fa sets the mandate2fa param.
 func (p *UpdateUserParams) SetMandate2fa(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["mandate2fa"] = v
+	p.mandate2fa = optBool{v: v, ok: true}
 }
 
+// ResetMandate2fa unsets the mandate2fa param
 func (p *UpdateUserParams) ResetMandate2fa() {
-	if p.p != nil && p.p["mandate2fa"] != nil {
-		delete(p.p, "mandate2fa")
-	}
+	p.mandate2fa = optBool{}
 }
 
+// GetMandate2fa returns the mandate2fa param and if it is set
 func (p *UpdateUserParams) GetMandate2fa() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["mandate2fa"].(bool)
-	return value, ok
+	return p.mandate2fa.v, p.mandate2fa.ok
 }
 
+// SetPassword sets the password param.
 func (p *UpdateUserParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *UpdateUserParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *UpdateUserParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetTimezone sets the timezone param.
 func (p *UpdateUserParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timezone"] = v
+	p.timezone = optString{v: v, ok: true}
 }
 
+// ResetTimezone unsets the timezone param
 func (p *UpdateUserParams) ResetTimezone() {
-	if p.p != nil && p.p["timezone"] != nil {
-		delete(p.p, "timezone")
-	}
+	p.timezone = optString{}
 }
 
+// GetTimezone returns the timezone param and if it is set
 func (p *UpdateUserParams) GetTimezone() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["timezone"].(string)
-	return value, ok
+	return p.timezone.v, p.timezone.ok
 }
 
+// SetUserapikey sets the userapikey param.
 func (p *UpdateUserParams) SetUserapikey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userapikey"] = v
+	p.userapikey = optString{v: v, ok: true}
 }
 
+// ResetUserapikey unsets the userapikey param
 func (p *UpdateUserParams) ResetUserapikey() {
-	if p.p != nil && p.p["userapikey"] != nil {
-		delete(p.p, "userapikey")
-	}
+	p.userapikey = optString{}
 }
 
+// GetUserapikey returns the userapikey param and if it is set
 func (p *UpdateUserParams) GetUserapikey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userapikey"].(string)
-	return value, ok
+	return p.userapikey.v, p.userapikey.ok
 }
 
+// SetUsername sets the username param.
 func (p *UpdateUserParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *UpdateUserParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *UpdateUserParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	return p.username.v, p.username.ok
 }
 
+// SetUsersecretkey sets the usersecretkey param.
 func (p *UpdateUserParams) SetUsersecretkey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usersecretkey"] = v
+	p.usersecretkey = optString{v: v, ok: true}
 }
 
+// ResetUsersecretkey unsets the usersecretkey param
 func (p *UpdateUserParams) ResetUsersecretkey() {
-	if p.p != nil && p.p["usersecretkey"] != nil {
-		delete(p.p, "usersecretkey")
-	}
+	p.usersecretkey = optString{}
 }
 
+// GetUsersecretkey returns the usersecretkey param and if it is set
 func (p *UpdateUserParams) GetUsersecretkey() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.usersecretkey.v, p.usersecretkey.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateUserParams) Clone() *UpdateUserParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateUserParams) Equal(o *UpdateUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.currentpassword == o.currentpassword &&
+		p.email == o.email &&
+		p.firstname == o.firstname &&
+		p.id == o.id &&
+		p.lastname == o.lastname &&
+		p.mandate2fa == o.mandate2fa &&
+		p.password == o.password &&
+		p.timezone == o.timezone &&
+		p.userapikey == o.userapikey &&
+		p.username == o.username &&
+		p.usersecretkey == o.usersecretkey
+}
+
+// serializedUpdateUserParams is used to (un)marshal UpdateUserParams using the API param names
+type serializedUpdateUserParams struct {
+	Currentpassword *string `json:"currentpassword,omitempty" yaml:"currentpassword,omitempty"`
+	Email           *string `json:"email,omitempty" yaml:"email,omitempty"`
+	Firstname       *string `json:"firstname,omitempty" yaml:"firstname,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Lastname        *string `json:"lastname,omitempty" yaml:"lastname,omitempty"`
+	Mandate2fa      *bool   `json:"mandate2fa,omitempty" yaml:"mandate2fa,omitempty"`
+	Password        *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Timezone        *string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userapikey      *string `json:"userapikey,omitempty" yaml:"userapikey,omitempty"`
+	Username        *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Usersecretkey   *string `json:"usersecretkey,omitempty" yaml:"usersecretkey,omitempty"`
+}
+
+func (p *UpdateUserParams) toSerialized() *serializedUpdateUserParams {
+	s := &serializedUpdateUserParams{}
+	if p.currentpassword.ok {
+		s.Currentpassword = &p.currentpassword.v
+	}
+	if p.email.ok {
+		s.Email = &p.email.v
+	}
+	if p.firstname.ok {
+		s.Firstname = &p.firstname.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.lastname.ok {
+		s.Lastname = &p.lastname.v
+	}
+	if p.mandate2fa.ok {
+		s.Mandate2fa = &p.mandate2fa.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.timezone.ok {
+		s.Timezone = &p.timezone.v
 	}
-	value, ok := p.p["usersecretkey"].(string)
-	return value, ok
+	if p.userapikey.ok {
+		s.Userapikey = &p.userapikey.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	if p.usersecretkey.ok {
+		s.Usersecretkey = &p.usersecretkey.v
+	}
+	return s
+}
+
+func (p *UpdateUserParams) fromSerialized(s *serializedUpdateUserParams) {
+	*p = UpdateUserParams{}
+	if s.Currentpassword != nil {
+		p.SetCurrentpassword(*s.Currentpassword)
+	}
+	if s.Email != nil {
+		p.SetEmail(*s.Email)
+	}
+	if s.Firstname != nil {
+		p.SetFirstname(*s.Firstname)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Lastname != nil {
+		p.SetLastname(*s.Lastname)
+	}
+	if s.Mandate2fa != nil {
+		p.SetMandate2fa(*s.Mandate2fa)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Timezone != nil {
+		p.SetTimezone(*s.Timezone)
+	}
+	if s.Userapikey != nil {
+		p.SetUserapikey(*s.Userapikey)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+	if s.Usersecretkey != nil {
+		p.SetUsersecretkey(*s.Usersecretkey)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewUpdateUserParams(id string) *UpdateUserParams {
 	p := &UpdateUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a user account
-func (s *UserService) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
-	resp, err := s.cs.newPostRequest("updateUser", p.toURLValues())
+// Updates a user account.
+//
+// Required params: id.
+func (s *UserService) UpdateUser(p *UpdateUserParams, opts ...CallOption) (*UpdateUserResponse, error) {
+	resp, err := s.cs.newPostRequest("updateUser", s.cs.encodeParams("updateUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,78 +53,103 @@ func (m *MockUserServiceIface) EXPECT() *MockUserServiceIfaceMockRecorder {
 }
 
 // CreateUser mocks base method.
-func (m *MockUserServiceIface) CreateUser(p *CreateUserParams) (*CreateUserResponse, error) {
+func (m *MockUserServiceIface) CreateUser(p *CreateUserParams, opts ...CallOption) (*CreateUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateUser", varargs...)
 	ret0, _ := ret[0].(*CreateUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateUser indicates an expected call of CreateUser.
-func (mr *MockUserServiceIfaceMockRecorder) CreateUser(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) CreateUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserServiceIface)(nil).CreateUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserServiceIface)(nil).CreateUser), varargs...)
 }
 
 // DeleteUser mocks base method.
-func (m *MockUserServiceIface) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
+func (m *MockUserServiceIface) DeleteUser(p *DeleteUserParams, opts ...CallOption) (*DeleteUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUser", varargs...)
 	ret0, _ := ret[0].(*DeleteUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockUserServiceIfaceMockRecorder) DeleteUser(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) DeleteUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserServiceIface)(nil).DeleteUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserServiceIface)(nil).DeleteUser), varargs...)
 }
 
 // DisableUser mocks base method.
-func (m *MockUserServiceIface) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
+func (m *MockUserServiceIface) DisableUser(p *DisableUserParams, opts ...CallOption) (*DisableUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableUser", varargs...)
 	ret0, _ := ret[0].(*DisableUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableUser indicates an expected call of DisableUser.
-func (mr *MockUserServiceIfaceMockRecorder) DisableUser(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) DisableUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUser", reflect.TypeOf((*MockUserServiceIface)(nil).DisableUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUser", reflect.TypeOf((*MockUserServiceIface)(nil).DisableUser), varargs...)
 }
 
 // EnableUser mocks base method.
-func (m *MockUserServiceIface) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
+func (m *MockUserServiceIface) EnableUser(p *EnableUserParams, opts ...CallOption) (*EnableUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableUser", varargs...)
 	ret0, _ := ret[0].(*EnableUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableUser indicates an expected call of EnableUser.
-func (mr *MockUserServiceIfaceMockRecorder) EnableUser(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) EnableUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUser", reflect.TypeOf((*MockUserServiceIface)(nil).EnableUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUser", reflect.TypeOf((*MockUserServiceIface)(nil).EnableUser), varargs...)
 }
 
 // GetUser mocks base method.
-func (m *MockUserServiceIface) GetUser(p *GetUserParams) (*GetUserResponse, error) {
+func (m *MockUserServiceIface) GetUser(p *GetUserParams, opts ...CallOption) (*GetUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUser", varargs...)
 	ret0, _ := ret[0].(*GetUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUser indicates an expected call of GetUser.
-func (mr *MockUserServiceIfaceMockRecorder) GetUser(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) GetUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserServiceIface)(nil).GetUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserServiceIface)(nil).GetUser), varargs...)
 }
 
 // GetUserByID mocks base method.
@@ -149,63 +174,83 @@ func (mr *MockUserServiceIfaceMockRecorder) GetUserByID(id interface{}, opts ...
 }
 
 // GetUserKeys mocks base method.
-func (m *MockUserServiceIface) GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error) {
+func (m *MockUserServiceIface) GetUserKeys(p *GetUserKeysParams, opts ...CallOption) (*GetUserKeysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserKeys", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserKeys", varargs...)
 	ret0, _ := ret[0].(*GetUserKeysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserKeys indicates an expected call of GetUserKeys.
-func (mr *MockUserServiceIfaceMockRecorder) GetUserKeys(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) GetUserKeys(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserKeys", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserKeys), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserKeys", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserKeys), varargs...)
 }
 
 // GetVirtualMachineUserData mocks base method.
-func (m *MockUserServiceIface) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+func (m *MockUserServiceIface) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams, opts ...CallOption) (*GetVirtualMachineUserDataResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVirtualMachineUserData", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachineUserData", varargs...)
 	ret0, _ := ret[0].(*GetVirtualMachineUserDataResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVirtualMachineUserData indicates an expected call of GetVirtualMachineUserData.
-func (mr *MockUserServiceIfaceMockRecorder) GetVirtualMachineUserData(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) GetVirtualMachineUserData(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineUserData", reflect.TypeOf((*MockUserServiceIface)(nil).GetVirtualMachineUserData), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineUserData", reflect.TypeOf((*MockUserServiceIface)(nil).GetVirtualMachineUserData), varargs...)
 }
 
 // ListUsers mocks base method.
-func (m *MockUserServiceIface) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
+func (m *MockUserServiceIface) ListUsers(p *ListUsersParams, opts ...CallOption) (*ListUsersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
 	ret0, _ := ret[0].(*ListUsersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockUserServiceIfaceMockRecorder) ListUsers(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) ListUsers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsers), varargs...)
 }
 
 // LockUser mocks base method.
-func (m *MockUserServiceIface) LockUser(p *LockUserParams) (*LockUserResponse, error) {
+func (m *MockUserServiceIface) LockUser(p *LockUserParams, opts ...CallOption) (*LockUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LockUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LockUser", varargs...)
 	ret0, _ := ret[0].(*LockUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LockUser indicates an expected call of LockUser.
-func (mr *MockUserServiceIfaceMockRecorder) LockUser(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) LockUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockUserServiceIface)(nil).LockUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockUserServiceIface)(nil).LockUser), varargs...)
 }
 
 // NewCreateUserParams mocks base method.
@@ -363,31 +408,41 @@ func (mr *MockUserServiceIfaceMockRecorder) NewUpdateUserParams(id interface{})
 }
 
 // RegisterUserKeys mocks base method.
-func (m *MockUserServiceIface) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
+func (m *MockUserServiceIface) RegisterUserKeys(p *RegisterUserKeysParams, opts ...CallOption) (*RegisterUserKeysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterUserKeys", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterUserKeys", varargs...)
 	ret0, _ := ret[0].(*RegisterUserKeysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterUserKeys indicates an expected call of RegisterUserKeys.
-func (mr *MockUserServiceIfaceMockRecorder) RegisterUserKeys(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) RegisterUserKeys(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserKeys", reflect.TypeOf((*MockUserServiceIface)(nil).RegisterUserKeys), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserKeys", reflect.TypeOf((*MockUserServiceIface)(nil).RegisterUserKeys), varargs...)
 }
 
 // UpdateUser mocks base method.
-func (m *MockUserServiceIface) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
+func (m *MockUserServiceIface) UpdateUser(p *UpdateUserParams, opts ...CallOption) (*UpdateUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUser", varargs...)
 	ret0, _ := ret[0].(*UpdateUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockUserServiceIfaceMockRecorder) UpdateUser(p interface{}) *gomock.Call {
+func (mr *MockUserServiceIfaceMockRecorder) UpdateUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserServiceIface)(nil).UpdateUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserServiceIface)(nil).UpdateUser), varargs...)
 }
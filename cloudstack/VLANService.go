@@ -28,483 +28,668 @@ import (
 )
 
 type VLANServiceIface interface {
-	CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	CreateVlanIpRange(p *CreateVlanIpRangeParams, opts ...CallOption) (*CreateVlanIpRangeResponse, error)
 	NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams
-	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams, opts ...CallOption) (*DedicateGuestVlanRangeResponse, error)
 	NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams
-	DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRange(p *DeleteVlanIpRangeParams, opts ...CallOption) (*DeleteVlanIpRangeResponse, error)
 	NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams
-	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams, opts ...CallOption) (*ListDedicatedGuestVlanRangesResponse, error)
 	NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams
 	GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
-	ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRanges(p *ListVlanIpRangesParams, opts ...CallOption) (*ListVlanIpRangesResponse, error)
 	NewListVlanIpRangesParams() *ListVlanIpRangesParams
 	GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
-	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams, opts ...CallOption) (*ReleaseDedicatedGuestVlanRangeResponse, error)
 	NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams
-	ListGuestVlans(p *ListGuestVlansParams) (*ListGuestVlansResponse, error)
+	ListGuestVlans(p *ListGuestVlansParams, opts ...CallOption) (*ListGuestVlansResponse, error)
 	NewListGuestVlansParams() *ListGuestVlansParams
-	UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error)
+	UpdateVlanIpRange(p *UpdateVlanIpRangeParams, opts ...CallOption) (*UpdateVlanIpRangeResponse, error)
 	NewUpdateVlanIpRangeParams(id string) *UpdateVlanIpRangeParams
 }
 
 type CreateVlanIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
+	account           optString
+	domainid          optString
+	endip             optString
+	endipv6           optString
+	forsystemvms      optBool
+	forvirtualnetwork optBool
+	gateway           optString
+	ip6cidr           optString
+	ip6gateway        optString
+	netmask           optString
+	networkid         optString
+	physicalnetworkid optString
+	podid             optString
+	projectid         optString
+	startip           optString
+	startipv6         optString
+	vlan              optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVlanIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["endipv6"]; found {
-		u.Set("endipv6", v.(string))
+	if p.endipv6.ok {
+		u.Set("endipv6", p.endipv6.v)
 	}
-	if v, found := p.p["forsystemvms"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forsystemvms", vv)
+	if p.forsystemvms.ok {
+		u.Set("forsystemvms", strconv.FormatBool(p.forsystemvms.v))
 	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
+	if p.forvirtualnetwork.ok {
+		u.Set("forvirtualnetwork", strconv.FormatBool(p.forvirtualnetwork.v))
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["ip6cidr"]; found {
-		u.Set("ip6cidr", v.(string))
+	if p.ip6cidr.ok {
+		u.Set("ip6cidr", p.ip6cidr.v)
 	}
-	if v, found := p.p["ip6gateway"]; found {
-		u.Set("ip6gateway", v.(string))
+	if p.ip6gateway.ok {
+		u.Set("ip6gateway", p.ip6gateway.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
-	if v, found := p.p["startipv6"]; found {
-		u.Set("startipv6", v.(string))
+	if p.startipv6.ok {
+		u.Set("startipv6", p.startipv6.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateVlanIpRangeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVlanIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVlanIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVlanIpRangeParams(u url.Values) (*CreateVlanIpRangeParams, error) {
+	p := &CreateVlanIpRangeParams{}
+	if err := checkParamNames("createVlanIpRange", u, "account", "domainid", "endip", "endipv6", "forsystemvms", "forvirtualnetwork", "gateway", "ip6cidr", "ip6gateway", "netmask", "networkid", "physicalnetworkid", "podid", "projectid", "startip", "startipv6", "vlan", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["endipv6"]; found {
+		p.SetEndipv6(u.Get("endipv6"))
+	}
+	if _, found := u["forsystemvms"]; found {
+		v, err := strconv.ParseBool(u.Get("forsystemvms"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forsystemvms: %v", err)
+		}
+		p.SetForsystemvms(v)
+	}
+	if _, found := u["forvirtualnetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("forvirtualnetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvirtualnetwork: %v", err)
+		}
+		p.SetForvirtualnetwork(v)
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
 	}
-	p.p["account"] = v
+	if _, found := u["ip6cidr"]; found {
+		p.SetIp6cidr(u.Get("ip6cidr"))
+	}
+	if _, found := u["ip6gateway"]; found {
+		p.SetIp6gateway(u.Get("ip6gateway"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
+	}
+	if _, found := u["startipv6"]; found {
+		p.SetStartipv6(u.Get("startipv6"))
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateVlanIpRangeParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateVlanIpRangeParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateVlanIpRangeParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateVlanIpRangeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateVlanIpRangeParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateVlanIpRangeParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEndip sets the endip param.
 func (p *CreateVlanIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
+	p.endip = optString{v: v, ok: true}
 }
 
+// ResetEndip unsets the endip param
 func (p *CreateVlanIpRangeParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *CreateVlanIpRangeParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetEndipv6 sets the endipv6 param.
 func (p *CreateVlanIpRangeParams) SetEndipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endipv6"] = v
+	p.endipv6 = optString{v: v, ok: true}
 }
 
+// ResetEndipv6 unsets the endipv6 param
 func (p *CreateVlanIpRangeParams) ResetEndipv6() {
-	if p.p != nil && p.p["endipv6"] != nil {
-		delete(p.p, "endipv6")
-	}
+	p.endipv6 = optString{}
 }
 
+// GetEndipv6 returns the endipv6 param and if it is set
 func (p *CreateVlanIpRangeParams) GetEndipv6() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endipv6"].(string)
-	return value, ok
+	return p.endipv6.v, p.endipv6.ok
 }
 
+// SetForsystemvms sets the forsystemvms param.
 func (p *CreateVlanIpRangeParams) SetForsystemvms(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forsystemvms"] = v
+	p.forsystemvms = optBool{v: v, ok: true}
 }
 
+// ResetForsystemvms unsets the forsystemvms param
 func (p *CreateVlanIpRangeParams) ResetForsystemvms() {
-	if p.p != nil && p.p["forsystemvms"] != nil {
-		delete(p.p, "forsystemvms")
-	}
+	p.forsystemvms = optBool{}
 }
 
+// GetForsystemvms returns the forsystemvms param and if it is set
 func (p *CreateVlanIpRangeParams) GetForsystemvms() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forsystemvms"].(bool)
-	return value, ok
+	return p.forsystemvms.v, p.forsystemvms.ok
 }
 
+// SetForvirtualnetwork sets the forvirtualnetwork param.
 func (p *CreateVlanIpRangeParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvirtualnetwork"] = v
+	p.forvirtualnetwork = optBool{v: v, ok: true}
 }
 
+// ResetForvirtualnetwork unsets the forvirtualnetwork param
 func (p *CreateVlanIpRangeParams) ResetForvirtualnetwork() {
-	if p.p != nil && p.p["forvirtualnetwork"] != nil {
-		delete(p.p, "forvirtualnetwork")
-	}
+	p.forvirtualnetwork = optBool{}
 }
 
+// GetForvirtualnetwork returns the forvirtualnetwork param and if it is set
 func (p *CreateVlanIpRangeParams) GetForvirtualnetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvirtualnetwork"].(bool)
-	return value, ok
+	return p.forvirtualnetwork.v, p.forvirtualnetwork.ok
 }
 
+// SetGateway sets the gateway param.
 func (p *CreateVlanIpRangeParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *CreateVlanIpRangeParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *CreateVlanIpRangeParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetIp6cidr sets the ip6cidr param.
 func (p *CreateVlanIpRangeParams) SetIp6cidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6cidr"] = v
+	p.ip6cidr = optString{v: v, ok: true}
 }
 
+// ResetIp6cidr unsets the ip6cidr param
 func (p *CreateVlanIpRangeParams) ResetIp6cidr() {
-	if p.p != nil && p.p["ip6cidr"] != nil {
-		delete(p.p, "ip6cidr")
-	}
+	p.ip6cidr = optString{}
 }
 
+// GetIp6cidr returns the ip6cidr param and if it is set
 func (p *CreateVlanIpRangeParams) GetIp6cidr() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6cidr"].(string)
-	return value, ok
+	return p.ip6cidr.v, p.ip6cidr.ok
 }
 
+// SetIp6gateway sets the ip6gateway param.
 func (p *CreateVlanIpRangeParams) SetIp6gateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6gateway"] = v
+	p.ip6gateway = optString{v: v, ok: true}
 }
 
+// ResetIp6gateway unsets the ip6gateway param
 func (p *CreateVlanIpRangeParams) ResetIp6gateway() {
-	if p.p != nil && p.p["ip6gateway"] != nil {
-		delete(p.p, "ip6gateway")
-	}
+	p.ip6gateway = optString{}
 }
 
+// GetIp6gateway returns the ip6gateway param and if it is set
 func (p *CreateVlanIpRangeParams) GetIp6gateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6gateway"].(string)
-	return value, ok
+	return p.ip6gateway.v, p.ip6gateway.ok
 }
 
+// SetNetmask sets the netmask param.
 func (p *CreateVlanIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *CreateVlanIpRangeParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *CreateVlanIpRangeParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *CreateVlanIpRangeParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *CreateVlanIpRangeParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *CreateVlanIpRangeParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *CreateVlanIpRangeParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *CreateVlanIpRangeParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *CreateVlanIpRangeParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetPodid sets the podid param.
 func (p *CreateVlanIpRangeParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *CreateVlanIpRangeParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *CreateVlanIpRangeParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateVlanIpRangeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateVlanIpRangeParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateVlanIpRangeParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetStartip sets the startip param.
 func (p *CreateVlanIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *CreateVlanIpRangeParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *CreateVlanIpRangeParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	return p.startip.v, p.startip.ok
 }
 
+// SetStartipv6 sets the startipv6 param.
 func (p *CreateVlanIpRangeParams) SetStartipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startipv6"] = v
+	p.startipv6 = optString{v: v, ok: true}
 }
 
+// ResetStartipv6 unsets the startipv6 param
 func (p *CreateVlanIpRangeParams) ResetStartipv6() {
-	if p.p != nil && p.p["startipv6"] != nil {
-		delete(p.p, "startipv6")
-	}
+	p.startipv6 = optString{}
 }
 
+// GetStartipv6 returns the startipv6 param and if it is set
 func (p *CreateVlanIpRangeParams) GetStartipv6() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startipv6"].(string)
-	return value, ok
+	return p.startipv6.v, p.startipv6.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *CreateVlanIpRangeParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *CreateVlanIpRangeParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *CreateVlanIpRangeParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateVlanIpRangeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateVlanIpRangeParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateVlanIpRangeParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVlanIpRangeParams) Clone() *CreateVlanIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVlanIpRangeParams) Equal(o *CreateVlanIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.endip == o.endip &&
+		p.endipv6 == o.endipv6 &&
+		p.forsystemvms == o.forsystemvms &&
+		p.forvirtualnetwork == o.forvirtualnetwork &&
+		p.gateway == o.gateway &&
+		p.ip6cidr == o.ip6cidr &&
+		p.ip6gateway == o.ip6gateway &&
+		p.netmask == o.netmask &&
+		p.networkid == o.networkid &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.startip == o.startip &&
+		p.startipv6 == o.startipv6 &&
+		p.vlan == o.vlan &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateVlanIpRangeParams is used to (un)marshal CreateVlanIpRangeParams using the API param names
+type serializedCreateVlanIpRangeParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endip             *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Endipv6           *string `json:"endipv6,omitempty" yaml:"endipv6,omitempty"`
+	Forsystemvms      *bool   `json:"forsystemvms,omitempty" yaml:"forsystemvms,omitempty"`
+	Forvirtualnetwork *bool   `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Gateway           *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Ip6cidr           *string `json:"ip6cidr,omitempty" yaml:"ip6cidr,omitempty"`
+	Ip6gateway        *string `json:"ip6gateway,omitempty" yaml:"ip6gateway,omitempty"`
+	Netmask           *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Networkid         *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Startip           *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Startipv6         *string `json:"startipv6,omitempty" yaml:"startipv6,omitempty"`
+	Vlan              *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateVlanIpRangeParams) toSerialized() *serializedCreateVlanIpRangeParams {
+	s := &serializedCreateVlanIpRangeParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.endipv6.ok {
+		s.Endipv6 = &p.endipv6.v
+	}
+	if p.forsystemvms.ok {
+		s.Forsystemvms = &p.forsystemvms.v
+	}
+	if p.forvirtualnetwork.ok {
+		s.Forvirtualnetwork = &p.forvirtualnetwork.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.ip6cidr.ok {
+		s.Ip6cidr = &p.ip6cidr.v
+	}
+	if p.ip6gateway.ok {
+		s.Ip6gateway = &p.ip6gateway.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	if p.startipv6.ok {
+		s.Startipv6 = &p.startipv6.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateVlanIpRangeParams) fromSerialized(s *serializedCreateVlanIpRangeParams) {
+	*p = CreateVlanIpRangeParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Endipv6 != nil {
+		p.SetEndipv6(*s.Endipv6)
+	}
+	if s.Forsystemvms != nil {
+		p.SetForsystemvms(*s.Forsystemvms)
+	}
+	if s.Forvirtualnetwork != nil {
+		p.SetForvirtualnetwork(*s.Forvirtualnetwork)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Ip6cidr != nil {
+		p.SetIp6cidr(*s.Ip6cidr)
+	}
+	if s.Ip6gateway != nil {
+		p.SetIp6gateway(*s.Ip6gateway)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+	if s.Startipv6 != nil {
+		p.SetStartipv6(*s.Startipv6)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVlanIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVlanIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVlanIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVlanIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateVlanIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
 	p := &CreateVlanIpRangeParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Creates a VLAN IP range.
-func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createVlanIpRange", p.toURLValues())
+func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams, opts ...CallOption) (*CreateVlanIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("createVlanIpRange", s.cs.encodeParams("createVlanIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -551,150 +736,253 @@ type CreateVlanIpRangeResponse struct {
 }
 
 type DedicateGuestVlanRangeParams struct {
-	p map[string]interface{}
+	account           optString
+	domainid          optString
+	physicalnetworkid optString
+	projectid         optString
+	vlanrange         optString
 }
 
-func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DedicateGuestVlanRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["vlanrange"]; found {
-		u.Set("vlanrange", v.(string))
+	if p.vlanrange.ok {
+		u.Set("vlanrange", p.vlanrange.v)
 	}
 	return u
 }
 
-func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDedicateGuestVlanRangeParams parses url.Values, for example taken from a raw API request,
+// into a new DedicateGuestVlanRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDedicateGuestVlanRangeParams(u url.Values) (*DedicateGuestVlanRangeParams, error) {
+	p := &DedicateGuestVlanRangeParams{}
+	if err := checkParamNames("dedicateGuestVlanRange", u, "account", "domainid", "physicalnetworkid", "projectid", "vlanrange"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["vlanrange"]; found {
+		p.SetVlanrange(u.Get("vlanrange"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *DedicateGuestVlanRangeParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DedicateGuestVlanRangeParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *DedicateGuestVlanRangeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DedicateGuestVlanRangeParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DedicateGuestVlanRangeParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param. This param is required.
 func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *DedicateGuestVlanRangeParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *DedicateGuestVlanRangeParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *DedicateGuestVlanRangeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DedicateGuestVlanRangeParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DedicateGuestVlanRangeParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVlanrange sets the vlanrange param. This param is required.
 func (p *DedicateGuestVlanRangeParams) SetVlanrange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlanrange"] = v
+	p.vlanrange = optString{v: v, ok: true}
 }
 
+// ResetVlanrange unsets the vlanrange param
 func (p *DedicateGuestVlanRangeParams) ResetVlanrange() {
-	if p.p != nil && p.p["vlanrange"] != nil {
-		delete(p.p, "vlanrange")
-	}
+	p.vlanrange = optString{}
 }
 
+// GetVlanrange returns the vlanrange param and if it is set
 func (p *DedicateGuestVlanRangeParams) GetVlanrange() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vlanrange.v, p.vlanrange.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DedicateGuestVlanRangeParams) Clone() *DedicateGuestVlanRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DedicateGuestVlanRangeParams) Equal(o *DedicateGuestVlanRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.projectid == o.projectid &&
+		p.vlanrange == o.vlanrange
+}
+
+// serializedDedicateGuestVlanRangeParams is used to (un)marshal DedicateGuestVlanRangeParams using the API param names
+type serializedDedicateGuestVlanRangeParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vlanrange         *string `json:"vlanrange,omitempty" yaml:"vlanrange,omitempty"`
+}
+
+func (p *DedicateGuestVlanRangeParams) toSerialized() *serializedDedicateGuestVlanRangeParams {
+	s := &serializedDedicateGuestVlanRangeParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.vlanrange.ok {
+		s.Vlanrange = &p.vlanrange.v
+	}
+	return s
+}
+
+func (p *DedicateGuestVlanRangeParams) fromSerialized(s *serializedDedicateGuestVlanRangeParams) {
+	*p = DedicateGuestVlanRangeParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Vlanrange != nil {
+		p.SetVlanrange(*s.Vlanrange)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DedicateGuestVlanRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DedicateGuestVlanRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDedicateGuestVlanRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DedicateGuestVlanRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DedicateGuestVlanRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDedicateGuestVlanRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["vlanrange"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DedicateGuestVlanRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams {
 	p := &DedicateGuestVlanRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["vlanrange"] = vlanrange
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetVlanrange(vlanrange)
 	return p
 }
 
-// Dedicates a guest vlan range to an account
-func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicateGuestVlanRange", p.toURLValues())
+// Dedicates a guest vlan range to an account.
+//
+// Required params: physicalnetworkid, vlanrange.
+func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams, opts ...CallOption) (*DedicateGuestVlanRangeResponse, error) {
+	resp, err := s.cs.newRequest("dedicateGuestVlanRange", s.cs.encodeParams("dedicateGuestVlanRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -726,53 +1014,132 @@ type DedicateGuestVlanRangeResponse struct {
 }
 
 type DeleteVlanIpRangeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVlanIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteVlanIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteVlanIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVlanIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVlanIpRangeParams(u url.Values) (*DeleteVlanIpRangeParams, error) {
+	p := &DeleteVlanIpRangeParams{}
+	if err := checkParamNames("deleteVlanIpRange", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteVlanIpRangeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteVlanIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteVlanIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVlanIpRangeParams) Clone() *DeleteVlanIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVlanIpRangeParams) Equal(o *DeleteVlanIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteVlanIpRangeParams is used to (un)marshal DeleteVlanIpRangeParams using the API param names
+type serializedDeleteVlanIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteVlanIpRangeParams) toSerialized() *serializedDeleteVlanIpRangeParams {
+	s := &serializedDeleteVlanIpRangeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteVlanIpRangeParams) fromSerialized(s *serializedDeleteVlanIpRangeParams) {
+	*p = DeleteVlanIpRangeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVlanIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVlanIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVlanIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVlanIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVlanIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams {
 	p := &DeleteVlanIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Creates a VLAN IP range.
-func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVlanIpRange", p.toURLValues())
+//
+// Required params: id.
+func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams, opts ...CallOption) (*DeleteVlanIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("deleteVlanIpRange", s.cs.encodeParams("deleteVlanIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -820,273 +1187,409 @@ func (r *DeleteVlanIpRangeResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListDedicatedGuestVlanRangesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
+	account           optString
+	domainid          optString
+	guestvlanrange    optString
+	id                optString
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
+	projectid         optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDedicatedGuestVlanRangesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["guestvlanrange"]; found {
-		u.Set("guestvlanrange", v.(string))
+	if p.guestvlanrange.ok {
+		u.Set("guestvlanrange", p.guestvlanrange.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDedicatedGuestVlanRangesParams parses url.Values, for example taken from a raw API request,
+// into a new ListDedicatedGuestVlanRangesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDedicatedGuestVlanRangesParams(u url.Values) (*ListDedicatedGuestVlanRangesParams, error) {
+	p := &ListDedicatedGuestVlanRangesParams{}
+	if err := checkParamNames("listDedicatedGuestVlanRanges", u, "account", "domainid", "guestvlanrange", "id", "keyword", "page", "pagesize", "physicalnetworkid", "projectid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["guestvlanrange"]; found {
+		p.SetGuestvlanrange(u.Get("guestvlanrange"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	p.p["account"] = v
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListDedicatedGuestVlanRangesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListDedicatedGuestVlanRangesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListDedicatedGuestVlanRangesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetGuestvlanrange sets the guestvlanrange param.
 func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestvlanrange"] = v
+	p.guestvlanrange = optString{v: v, ok: true}
 }
 
+// ResetGuestvlanrange unsets the guestvlanrange param
 func (p *ListDedicatedGuestVlanRangesParams) ResetGuestvlanrange() {
-	if p.p != nil && p.p["guestvlanrange"] != nil {
-		delete(p.p, "guestvlanrange")
-	}
+	p.guestvlanrange = optString{}
 }
 
+// GetGuestvlanrange returns the guestvlanrange param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetGuestvlanrange() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestvlanrange"].(string)
-	return value, ok
+	return p.guestvlanrange.v, p.guestvlanrange.ok
 }
 
+// SetId sets the id param.
 func (p *ListDedicatedGuestVlanRangesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListDedicatedGuestVlanRangesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDedicatedGuestVlanRangesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDedicatedGuestVlanRangesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDedicatedGuestVlanRangesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDedicatedGuestVlanRangesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDedicatedGuestVlanRangesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDedicatedGuestVlanRangesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListDedicatedGuestVlanRangesParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListDedicatedGuestVlanRangesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListDedicatedGuestVlanRangesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListDedicatedGuestVlanRangesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListDedicatedGuestVlanRangesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListDedicatedGuestVlanRangesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDedicatedGuestVlanRangesParams) Clone() *ListDedicatedGuestVlanRangesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDedicatedGuestVlanRangesParams) Equal(o *ListDedicatedGuestVlanRangesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.guestvlanrange == o.guestvlanrange &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.projectid == o.projectid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListDedicatedGuestVlanRangesParams is used to (un)marshal ListDedicatedGuestVlanRangesParams using the API param names
+type serializedListDedicatedGuestVlanRangesParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Guestvlanrange    *string `json:"guestvlanrange,omitempty" yaml:"guestvlanrange,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) toSerialized() *serializedListDedicatedGuestVlanRangesParams {
+	s := &serializedListDedicatedGuestVlanRangesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.guestvlanrange.ok {
+		s.Guestvlanrange = &p.guestvlanrange.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) fromSerialized(s *serializedListDedicatedGuestVlanRangesParams) {
+	*p = ListDedicatedGuestVlanRangesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Guestvlanrange != nil {
+		p.SetGuestvlanrange(*s.Guestvlanrange)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDedicatedGuestVlanRangesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDedicatedGuestVlanRangesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDedicatedGuestVlanRangesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDedicatedGuestVlanRangesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDedicatedGuestVlanRangesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDedicatedGuestVlanRangesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDedicatedGuestVlanRangesParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams {
 	p := &ListDedicatedGuestVlanRangesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
 	p := &ListDedicatedGuestVlanRangesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1114,9 +1617,9 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 	return nil, l.Count, fmt.Errorf("There is more then one result for DedicatedGuestVlanRange UUID: %s!", id)
 }
 
-// Lists dedicated guest vlan ranges
-func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", p.toURLValues())
+// Lists dedicated guest vlan ranges.
+func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams, opts ...CallOption) (*ListDedicatedGuestVlanRangesResponse, error) {
+	resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", s.cs.encodeParams("listDedicatedGuestVlanRanges", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1149,346 +1652,503 @@ type DedicatedGuestVlanRange struct {
 }
 
 type ListVlanIpRangesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVlanIpRangesParams) toURLValues() url.Values {
+	account           optString
+	domainid          optString
+	forvirtualnetwork optBool
+	id                optString
+	keyword           optString
+	networkid         optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
+	podid             optString
+	projectid         optString
+	vlan              optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVlanIpRangesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
+	if p.forvirtualnetwork.ok {
+		u.Set("forvirtualnetwork", strconv.FormatBool(p.forvirtualnetwork.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListVlanIpRangesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVlanIpRangesParams parses url.Values, for example taken from a raw API request,
+// into a new ListVlanIpRangesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVlanIpRangesParams(u url.Values) (*ListVlanIpRangesParams, error) {
+	p := &ListVlanIpRangesParams{}
+	if err := checkParamNames("listVlanIpRanges", u, "account", "domainid", "forvirtualnetwork", "id", "keyword", "networkid", "page", "pagesize", "physicalnetworkid", "podid", "projectid", "vlan", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["forvirtualnetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("forvirtualnetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvirtualnetwork: %v", err)
+		}
+		p.SetForvirtualnetwork(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVlanIpRangesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListVlanIpRangesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVlanIpRangesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVlanIpRangesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVlanIpRangesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVlanIpRangesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetForvirtualnetwork sets the forvirtualnetwork param.
 func (p *ListVlanIpRangesParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvirtualnetwork"] = v
+	p.forvirtualnetwork = optBool{v: v, ok: true}
 }
 
+// ResetForvirtualnetwork unsets the forvirtualnetwork param
 func (p *ListVlanIpRangesParams) ResetForvirtualnetwork() {
-	if p.p != nil && p.p["forvirtualnetwork"] != nil {
-		delete(p.p, "forvirtualnetwork")
-	}
+	p.forvirtualnetwork = optBool{}
 }
 
+// GetForvirtualnetwork returns the forvirtualnetwork param and if it is set
 func (p *ListVlanIpRangesParams) GetForvirtualnetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvirtualnetwork"].(bool)
-	return value, ok
+	return p.forvirtualnetwork.v, p.forvirtualnetwork.ok
 }
 
+// SetId sets the id param.
 func (p *ListVlanIpRangesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVlanIpRangesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVlanIpRangesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVlanIpRangesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVlanIpRangesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVlanIpRangesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListVlanIpRangesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListVlanIpRangesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListVlanIpRangesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVlanIpRangesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVlanIpRangesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVlanIpRangesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVlanIpRangesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVlanIpRangesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVlanIpRangesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListVlanIpRangesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListVlanIpRangesParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListVlanIpRangesParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListVlanIpRangesParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListVlanIpRangesParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListVlanIpRangesParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVlanIpRangesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVlanIpRangesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVlanIpRangesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *ListVlanIpRangesParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *ListVlanIpRangesParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *ListVlanIpRangesParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListVlanIpRangesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListVlanIpRangesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListVlanIpRangesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVlanIpRangesParams) Clone() *ListVlanIpRangesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVlanIpRangesParams) Equal(o *ListVlanIpRangesParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.forvirtualnetwork == o.forvirtualnetwork &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.vlan == o.vlan &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListVlanIpRangesParams is used to (un)marshal ListVlanIpRangesParams using the API param names
+type serializedListVlanIpRangesParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvirtualnetwork *bool   `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Networkid         *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vlan              *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListVlanIpRangesParams) toSerialized() *serializedListVlanIpRangesParams {
+	s := &serializedListVlanIpRangesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.forvirtualnetwork.ok {
+		s.Forvirtualnetwork = &p.forvirtualnetwork.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListVlanIpRangesParams) fromSerialized(s *serializedListVlanIpRangesParams) {
+	*p = ListVlanIpRangesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Forvirtualnetwork != nil {
+		p.SetForvirtualnetwork(*s.Forvirtualnetwork)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVlanIpRangesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVlanIpRangesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVlanIpRangesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVlanIpRangesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVlanIpRangesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVlanIpRangesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVlanIpRangesParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
 	p := &ListVlanIpRangesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
 	p := &ListVlanIpRangesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1517,8 +2177,8 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 }
 
 // Lists all VLAN IP ranges.
-func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listVlanIpRanges", p.toURLValues())
+func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams, opts ...CallOption) (*ListVlanIpRangesResponse, error) {
+	resp, err := s.cs.newRequest("listVlanIpRanges", s.cs.encodeParams("listVlanIpRanges", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1566,53 +2226,133 @@ type VlanIpRange struct {
 }
 
 type ReleaseDedicatedGuestVlanRangeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleaseDedicatedGuestVlanRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleaseDedicatedGuestVlanRangeParams parses url.Values, for example taken from a raw API request,
+// into a new ReleaseDedicatedGuestVlanRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleaseDedicatedGuestVlanRangeParams(u url.Values) (*ReleaseDedicatedGuestVlanRangeParams, error) {
+	p := &ReleaseDedicatedGuestVlanRangeParams{}
+	if err := checkParamNames("releaseDedicatedGuestVlanRange", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ReleaseDedicatedGuestVlanRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ReleaseDedicatedGuestVlanRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleaseDedicatedGuestVlanRangeParams) Clone() *ReleaseDedicatedGuestVlanRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleaseDedicatedGuestVlanRangeParams) Equal(o *ReleaseDedicatedGuestVlanRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id == o.id
+}
+
+// serializedReleaseDedicatedGuestVlanRangeParams is used to (un)marshal ReleaseDedicatedGuestVlanRangeParams using the API param names
+type serializedReleaseDedicatedGuestVlanRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ReleaseDedicatedGuestVlanRangeParams) toSerialized() *serializedReleaseDedicatedGuestVlanRangeParams {
+	s := &serializedReleaseDedicatedGuestVlanRangeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ReleaseDedicatedGuestVlanRangeParams) fromSerialized(s *serializedReleaseDedicatedGuestVlanRangeParams) {
+	*p = ReleaseDedicatedGuestVlanRangeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleaseDedicatedGuestVlanRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleaseDedicatedGuestVlanRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleaseDedicatedGuestVlanRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleaseDedicatedGuestVlanRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleaseDedicatedGuestVlanRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleaseDedicatedGuestVlanRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleaseDedicatedGuestVlanRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams {
 	p := &ReleaseDedicatedGuestVlanRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Releases a dedicated guest vlan range to the system
-func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedGuestVlanRange", p.toURLValues())
+// Releases a dedicated guest vlan range to the system.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams, opts ...CallOption) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	resp, err := s.cs.newRequest("releaseDedicatedGuestVlanRange", s.cs.encodeParams("releaseDedicatedGuestVlanRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1623,8 +2363,8 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1648,224 +2388,355 @@ type ReleaseDedicatedGuestVlanRangeResponse struct {
 }
 
 type ListGuestVlansParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListGuestVlansParams) toURLValues() url.Values {
+	allocatedonly     optBool
+	id                optInt64
+	keyword           optString
+	page              optInt
+	pagesize          optInt
+	physicalnetworkid optString
+	vnet              optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListGuestVlansParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocatedonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("allocatedonly", vv)
+	if p.allocatedonly.ok {
+		u.Set("allocatedonly", strconv.FormatBool(p.allocatedonly.v))
 	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
+	if p.id.ok {
+		u.Set("id", strconv.FormatInt(p.id.v, 10))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["vnet"]; found {
-		u.Set("vnet", v.(string))
+	if p.vnet.ok {
+		u.Set("vnet", p.vnet.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListGuestVlansParams) SetAllocatedonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListGuestVlansParams parses url.Values, for example taken from a raw API request,
+// into a new ListGuestVlansParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListGuestVlansParams(u url.Values) (*ListGuestVlansParams, error) {
+	p := &ListGuestVlansParams{}
+	if err := checkParamNames("listGuestVlans", u, "allocatedonly", "id", "keyword", "page", "pagesize", "physicalnetworkid", "vnet", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocatedonly"]; found {
+		v, err := strconv.ParseBool(u.Get("allocatedonly"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param allocatedonly: %v", err)
+		}
+		p.SetAllocatedonly(v)
 	}
-	p.p["allocatedonly"] = v
+	if _, found := u["id"]; found {
+		v, err := strconv.ParseInt(u.Get("id"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param id: %v", err)
+		}
+		p.SetId(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["vnet"]; found {
+		p.SetVnet(u.Get("vnet"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAllocatedonly sets the allocatedonly param.
+func (p *ListGuestVlansParams) SetAllocatedonly(v bool) {
+	p.allocatedonly = optBool{v: v, ok: true}
 }
 
+// ResetAllocatedonly unsets the allocatedonly param
 func (p *ListGuestVlansParams) ResetAllocatedonly() {
-	if p.p != nil && p.p["allocatedonly"] != nil {
-		delete(p.p, "allocatedonly")
-	}
+	p.allocatedonly = optBool{}
 }
 
+// GetAllocatedonly returns the allocatedonly param and if it is set
 func (p *ListGuestVlansParams) GetAllocatedonly() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocatedonly"].(bool)
-	return value, ok
+	return p.allocatedonly.v, p.allocatedonly.ok
 }
 
+// SetId sets the id param.
 func (p *ListGuestVlansParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optInt64{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListGuestVlansParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optInt64{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListGuestVlansParams) GetId() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(int64)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListGuestVlansParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListGuestVlansParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListGuestVlansParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListGuestVlansParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListGuestVlansParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListGuestVlansParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListGuestVlansParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListGuestVlansParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListGuestVlansParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *ListGuestVlansParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *ListGuestVlansParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *ListGuestVlansParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetVnet sets the vnet param.
 func (p *ListGuestVlansParams) SetVnet(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vnet"] = v
+	p.vnet = optString{v: v, ok: true}
 }
 
+// ResetVnet unsets the vnet param
 func (p *ListGuestVlansParams) ResetVnet() {
-	if p.p != nil && p.p["vnet"] != nil {
-		delete(p.p, "vnet")
-	}
+	p.vnet = optString{}
 }
 
+// GetVnet returns the vnet param and if it is set
 func (p *ListGuestVlansParams) GetVnet() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vnet"].(string)
-	return value, ok
+	return p.vnet.v, p.vnet.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListGuestVlansParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListGuestVlansParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListGuestVlansParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListGuestVlansParams) Clone() *ListGuestVlansParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListGuestVlansParams) Equal(o *ListGuestVlansParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocatedonly == o.allocatedonly &&
+		p.id == o.id &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.vnet == o.vnet &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListGuestVlansParams is used to (un)marshal ListGuestVlansParams using the API param names
+type serializedListGuestVlansParams struct {
+	Allocatedonly     *bool   `json:"allocatedonly,omitempty" yaml:"allocatedonly,omitempty"`
+	Id                *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Vnet              *string `json:"vnet,omitempty" yaml:"vnet,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListGuestVlansParams) toSerialized() *serializedListGuestVlansParams {
+	s := &serializedListGuestVlansParams{}
+	if p.allocatedonly.ok {
+		s.Allocatedonly = &p.allocatedonly.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.vnet.ok {
+		s.Vnet = &p.vnet.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListGuestVlansParams) fromSerialized(s *serializedListGuestVlansParams) {
+	*p = ListGuestVlansParams{}
+	if s.Allocatedonly != nil {
+		p.SetAllocatedonly(*s.Allocatedonly)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Vnet != nil {
+		p.SetVnet(*s.Vnet)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListGuestVlansParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListGuestVlansParams) UnmarshalJSON(b []byte) error {
+	var s serializedListGuestVlansParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListGuestVlansParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListGuestVlansParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListGuestVlansParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListGuestVlansParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewListGuestVlansParams() *ListGuestVlansParams {
 	p := &ListGuestVlansParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Lists all guest vlans
-func (s *VLANService) ListGuestVlans(p *ListGuestVlansParams) (*ListGuestVlansResponse, error) {
-	resp, err := s.cs.newRequest("listGuestVlans", p.toURLValues())
+// Lists all guest vlans.
+func (s *VLANService) ListGuestVlans(p *ListGuestVlansParams, opts ...CallOption) (*ListGuestVlansResponse, error) {
+	resp, err := s.cs.newRequest("listGuestVlans", s.cs.encodeParams("listGuestVlans", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1904,270 +2775,406 @@ type GuestVlan struct {
 }
 
 type UpdateVlanIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateVlanIpRangeParams) toURLValues() url.Values {
+	endip        optString
+	endipv6      optString
+	forsystemvms optBool
+	gateway      optString
+	id           optString
+	ip6cidr      optString
+	ip6gateway   optString
+	netmask      optString
+	startip      optString
+	startipv6    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVlanIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if p.endip.ok {
+		u.Set("endip", p.endip.v)
 	}
-	if v, found := p.p["endipv6"]; found {
-		u.Set("endipv6", v.(string))
+	if p.endipv6.ok {
+		u.Set("endipv6", p.endipv6.v)
 	}
-	if v, found := p.p["forsystemvms"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forsystemvms", vv)
+	if p.forsystemvms.ok {
+		u.Set("forsystemvms", strconv.FormatBool(p.forsystemvms.v))
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ip6cidr"]; found {
-		u.Set("ip6cidr", v.(string))
+	if p.ip6cidr.ok {
+		u.Set("ip6cidr", p.ip6cidr.v)
 	}
-	if v, found := p.p["ip6gateway"]; found {
-		u.Set("ip6gateway", v.(string))
+	if p.ip6gateway.ok {
+		u.Set("ip6gateway", p.ip6gateway.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if p.startip.ok {
+		u.Set("startip", p.startip.v)
 	}
-	if v, found := p.p["startipv6"]; found {
-		u.Set("startipv6", v.(string))
+	if p.startipv6.ok {
+		u.Set("startipv6", p.startipv6.v)
 	}
 	return u
 }
 
-func (p *UpdateVlanIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVlanIpRangeParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVlanIpRangeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVlanIpRangeParams(u url.Values) (*UpdateVlanIpRangeParams, error) {
+	p := &UpdateVlanIpRangeParams{}
+	if err := checkParamNames("updateVlanIpRange", u, "endip", "endipv6", "forsystemvms", "gateway", "id", "ip6cidr", "ip6gateway", "netmask", "startip", "startipv6"); err != nil {
+		return nil, err
 	}
-	p.p["endip"] = v
+	if _, found := u["endip"]; found {
+		p.SetEndip(u.Get("endip"))
+	}
+	if _, found := u["endipv6"]; found {
+		p.SetEndipv6(u.Get("endipv6"))
+	}
+	if _, found := u["forsystemvms"]; found {
+		v, err := strconv.ParseBool(u.Get("forsystemvms"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forsystemvms: %v", err)
+		}
+		p.SetForsystemvms(v)
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ip6cidr"]; found {
+		p.SetIp6cidr(u.Get("ip6cidr"))
+	}
+	if _, found := u["ip6gateway"]; found {
+		p.SetIp6gateway(u.Get("ip6gateway"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["startip"]; found {
+		p.SetStartip(u.Get("startip"))
+	}
+	if _, found := u["startipv6"]; found {
+		p.SetStartipv6(u.Get("startipv6"))
+	}
+	return p, nil
+}
+
+// SetEndip sets the endip param.
+func (p *UpdateVlanIpRangeParams) SetEndip(v string) {
+	p.endip = optString{v: v, ok: true}
 }
 
+// ResetEndip unsets the endip param
 func (p *UpdateVlanIpRangeParams) ResetEndip() {
-	if p.p != nil && p.p["endip"] != nil {
-		delete(p.p, "endip")
-	}
+	p.endip = optString{}
 }
 
+// GetEndip returns the endip param and if it is set
 func (p *UpdateVlanIpRangeParams) GetEndip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endip"].(string)
-	return value, ok
+	return p.endip.v, p.endip.ok
 }
 
+// SetEndipv6 sets the endipv6 param.
 func (p *UpdateVlanIpRangeParams) SetEndipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endipv6"] = v
+	p.endipv6 = optString{v: v, ok: true}
 }
 
+// ResetEndipv6 unsets the endipv6 param
 func (p *UpdateVlanIpRangeParams) ResetEndipv6() {
-	if p.p != nil && p.p["endipv6"] != nil {
-		delete(p.p, "endipv6")
-	}
+	p.endipv6 = optString{}
 }
 
+// GetEndipv6 returns the endipv6 param and if it is set
 func (p *UpdateVlanIpRangeParams) GetEndipv6() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["endipv6"].(string)
-	return value, ok
+	return p.endipv6.v, p.endipv6.ok
 }
 
+// SetForsystemvms sets the forsystemvms param.
 func (p *UpdateVlanIpRangeParams) SetForsystemvms(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forsystemvms"] = v
+	p.forsystemvms = optBool{v: v, ok: true}
 }
 
+// ResetForsystemvms unsets the forsystemvms param
 func (p *UpdateVlanIpRangeParams) ResetForsystemvms() {
-	if p.p != nil && p.p["forsystemvms"] != nil {
-		delete(p.p, "forsystemvms")
-	}
+	p.forsystemvms = optBool{}
 }
 
+// GetForsystemvms returns the forsystemvms param and if it is set
 func (p *UpdateVlanIpRangeParams) GetForsystemvms() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forsystemvms"].(bool)
-	return value, ok
+	return p.forsystemvms.v, p.forsystemvms.ok
 }
 
+// SetGateway sets the gateway param.
 func (p *UpdateVlanIpRangeParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *UpdateVlanIpRangeParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *UpdateVlanIpRangeParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVlanIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVlanIpRangeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVlanIpRangeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIp6cidr sets the ip6cidr param.
 func (p *UpdateVlanIpRangeParams) SetIp6cidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6cidr"] = v
+	p.ip6cidr = optString{v: v, ok: true}
 }
 
+// ResetIp6cidr unsets the ip6cidr param
 func (p *UpdateVlanIpRangeParams) ResetIp6cidr() {
-	if p.p != nil && p.p["ip6cidr"] != nil {
-		delete(p.p, "ip6cidr")
-	}
+	p.ip6cidr = optString{}
 }
 
+// GetIp6cidr returns the ip6cidr param and if it is set
 func (p *UpdateVlanIpRangeParams) GetIp6cidr() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6cidr"].(string)
-	return value, ok
+	return p.ip6cidr.v, p.ip6cidr.ok
 }
 
+// SetIp6gateway sets the ip6gateway param.
 func (p *UpdateVlanIpRangeParams) SetIp6gateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6gateway"] = v
+	p.ip6gateway = optString{v: v, ok: true}
 }
 
+// ResetIp6gateway unsets the ip6gateway param
 func (p *UpdateVlanIpRangeParams) ResetIp6gateway() {
-	if p.p != nil && p.p["ip6gateway"] != nil {
-		delete(p.p, "ip6gateway")
-	}
+	p.ip6gateway = optString{}
 }
 
+// GetIp6gateway returns the ip6gateway param and if it is set
 func (p *UpdateVlanIpRangeParams) GetIp6gateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6gateway"].(string)
-	return value, ok
+	return p.ip6gateway.v, p.ip6gateway.ok
 }
 
+// SetNetmask sets the netmask param.
 func (p *UpdateVlanIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *UpdateVlanIpRangeParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *UpdateVlanIpRangeParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetStartip sets the startip param.
 func (p *UpdateVlanIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
+	p.startip = optString{v: v, ok: true}
 }
 
+// ResetStartip unsets the startip param
 func (p *UpdateVlanIpRangeParams) ResetStartip() {
-	if p.p != nil && p.p["startip"] != nil {
-		delete(p.p, "startip")
-	}
+	p.startip = optString{}
 }
 
+// GetStartip returns the startip param and if it is set
 func (p *UpdateVlanIpRangeParams) GetStartip() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startip"].(string)
-	return value, ok
+	return p.startip.v, p.startip.ok
 }
 
+// SetStartipv6 sets the startipv6 param.
 func (p *UpdateVlanIpRangeParams) SetStartipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startipv6"] = v
+	p.startipv6 = optString{v: v, ok: true}
 }
 
+// ResetStartipv6 unsets the startipv6 param
 func (p *UpdateVlanIpRangeParams) ResetStartipv6() {
-	if p.p != nil && p.p["startipv6"] != nil {
-		delete(p.p, "startipv6")
-	}
+	p.startipv6 = optString{}
 }
 
+// GetStartipv6 returns the startipv6 param and if it is set
 func (p *UpdateVlanIpRangeParams) GetStartipv6() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.startipv6.v, p.startipv6.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVlanIpRangeParams) Clone() *UpdateVlanIpRangeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVlanIpRangeParams) Equal(o *UpdateVlanIpRangeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.endip == o.endip &&
+		p.endipv6 == o.endipv6 &&
+		p.forsystemvms == o.forsystemvms &&
+		p.gateway == o.gateway &&
+		p.id == o.id &&
+		p.ip6cidr == o.ip6cidr &&
+		p.ip6gateway == o.ip6gateway &&
+		p.netmask == o.netmask &&
+		p.startip == o.startip &&
+		p.startipv6 == o.startipv6
+}
+
+// serializedUpdateVlanIpRangeParams is used to (un)marshal UpdateVlanIpRangeParams using the API param names
+type serializedUpdateVlanIpRangeParams struct {
+	Endip        *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Endipv6      *string `json:"endipv6,omitempty" yaml:"endipv6,omitempty"`
+	Forsystemvms *bool   `json:"forsystemvms,omitempty" yaml:"forsystemvms,omitempty"`
+	Gateway      *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ip6cidr      *string `json:"ip6cidr,omitempty" yaml:"ip6cidr,omitempty"`
+	Ip6gateway   *string `json:"ip6gateway,omitempty" yaml:"ip6gateway,omitempty"`
+	Netmask      *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Startip      *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Startipv6    *string `json:"startipv6,omitempty" yaml:"startipv6,omitempty"`
+}
+
+func (p *UpdateVlanIpRangeParams) toSerialized() *serializedUpdateVlanIpRangeParams {
+	s := &serializedUpdateVlanIpRangeParams{}
+	if p.endip.ok {
+		s.Endip = &p.endip.v
+	}
+	if p.endipv6.ok {
+		s.Endipv6 = &p.endipv6.v
+	}
+	if p.forsystemvms.ok {
+		s.Forsystemvms = &p.forsystemvms.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ip6cidr.ok {
+		s.Ip6cidr = &p.ip6cidr.v
+	}
+	if p.ip6gateway.ok {
+		s.Ip6gateway = &p.ip6gateway.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.startip.ok {
+		s.Startip = &p.startip.v
+	}
+	if p.startipv6.ok {
+		s.Startipv6 = &p.startipv6.v
+	}
+	return s
+}
+
+func (p *UpdateVlanIpRangeParams) fromSerialized(s *serializedUpdateVlanIpRangeParams) {
+	*p = UpdateVlanIpRangeParams{}
+	if s.Endip != nil {
+		p.SetEndip(*s.Endip)
+	}
+	if s.Endipv6 != nil {
+		p.SetEndipv6(*s.Endipv6)
+	}
+	if s.Forsystemvms != nil {
+		p.SetForsystemvms(*s.Forsystemvms)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ip6cidr != nil {
+		p.SetIp6cidr(*s.Ip6cidr)
+	}
+	if s.Ip6gateway != nil {
+		p.SetIp6gateway(*s.Ip6gateway)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Startip != nil {
+		p.SetStartip(*s.Startip)
+	}
+	if s.Startipv6 != nil {
+		p.SetStartipv6(*s.Startipv6)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVlanIpRangeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVlanIpRangeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVlanIpRangeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVlanIpRangeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["startipv6"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVlanIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewUpdateVlanIpRangeParams(id string) *UpdateVlanIpRangeParams {
 	p := &UpdateVlanIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a VLAN IP range.
-func (s *VLANService) UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("updateVlanIpRange", p.toURLValues())
+//
+// Required params: id.
+func (s *VLANService) UpdateVlanIpRange(p *UpdateVlanIpRangeParams, opts ...CallOption) (*UpdateVlanIpRangeResponse, error) {
+	resp, err := s.cs.newRequest("updateVlanIpRange", s.cs.encodeParams("updateVlanIpRange", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,48 +53,63 @@ func (m *MockVLANServiceIface) EXPECT() *MockVLANServiceIfaceMockRecorder {
 }
 
 // CreateVlanIpRange mocks base method.
-func (m *MockVLANServiceIface) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+func (m *MockVLANServiceIface) CreateVlanIpRange(p *CreateVlanIpRangeParams, opts ...CallOption) (*CreateVlanIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVlanIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVlanIpRange", varargs...)
 	ret0, _ := ret[0].(*CreateVlanIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVlanIpRange indicates an expected call of CreateVlanIpRange.
-func (mr *MockVLANServiceIfaceMockRecorder) CreateVlanIpRange(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) CreateVlanIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).CreateVlanIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).CreateVlanIpRange), varargs...)
 }
 
 // DedicateGuestVlanRange mocks base method.
-func (m *MockVLANServiceIface) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+func (m *MockVLANServiceIface) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams, opts ...CallOption) (*DedicateGuestVlanRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DedicateGuestVlanRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DedicateGuestVlanRange", varargs...)
 	ret0, _ := ret[0].(*DedicateGuestVlanRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DedicateGuestVlanRange indicates an expected call of DedicateGuestVlanRange.
-func (mr *MockVLANServiceIfaceMockRecorder) DedicateGuestVlanRange(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) DedicateGuestVlanRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateGuestVlanRange", reflect.TypeOf((*MockVLANServiceIface)(nil).DedicateGuestVlanRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateGuestVlanRange", reflect.TypeOf((*MockVLANServiceIface)(nil).DedicateGuestVlanRange), varargs...)
 }
 
 // DeleteVlanIpRange mocks base method.
-func (m *MockVLANServiceIface) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+func (m *MockVLANServiceIface) DeleteVlanIpRange(p *DeleteVlanIpRangeParams, opts ...CallOption) (*DeleteVlanIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVlanIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVlanIpRange", varargs...)
 	ret0, _ := ret[0].(*DeleteVlanIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVlanIpRange indicates an expected call of DeleteVlanIpRange.
-func (mr *MockVLANServiceIfaceMockRecorder) DeleteVlanIpRange(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) DeleteVlanIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).DeleteVlanIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).DeleteVlanIpRange), varargs...)
 }
 
 // GetDedicatedGuestVlanRangeByID mocks base method.
@@ -140,48 +155,63 @@ func (mr *MockVLANServiceIfaceMockRecorder) GetVlanIpRangeByID(id interface{}, o
 }
 
 // ListDedicatedGuestVlanRanges mocks base method.
-func (m *MockVLANServiceIface) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+func (m *MockVLANServiceIface) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams, opts ...CallOption) (*ListDedicatedGuestVlanRangesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDedicatedGuestVlanRanges", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedGuestVlanRanges", varargs...)
 	ret0, _ := ret[0].(*ListDedicatedGuestVlanRangesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDedicatedGuestVlanRanges indicates an expected call of ListDedicatedGuestVlanRanges.
-func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRanges(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRanges(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRanges", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRanges), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRanges", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRanges), varargs...)
 }
 
 // ListGuestVlans mocks base method.
-func (m *MockVLANServiceIface) ListGuestVlans(p *ListGuestVlansParams) (*ListGuestVlansResponse, error) {
+func (m *MockVLANServiceIface) ListGuestVlans(p *ListGuestVlansParams, opts ...CallOption) (*ListGuestVlansResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListGuestVlans", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestVlans", varargs...)
 	ret0, _ := ret[0].(*ListGuestVlansResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListGuestVlans indicates an expected call of ListGuestVlans.
-func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlans(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlans(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlans", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlans), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlans", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlans), varargs...)
 }
 
 // ListVlanIpRanges mocks base method.
-func (m *MockVLANServiceIface) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+func (m *MockVLANServiceIface) ListVlanIpRanges(p *ListVlanIpRangesParams, opts ...CallOption) (*ListVlanIpRangesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVlanIpRanges", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVlanIpRanges", varargs...)
 	ret0, _ := ret[0].(*ListVlanIpRangesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVlanIpRanges indicates an expected call of ListVlanIpRanges.
-func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRanges(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRanges(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRanges", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRanges), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRanges", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRanges), varargs...)
 }
 
 // NewCreateVlanIpRangeParams mocks base method.
@@ -297,31 +327,41 @@ func (mr *MockVLANServiceIfaceMockRecorder) NewUpdateVlanIpRangeParams(id interf
 }
 
 // ReleaseDedicatedGuestVlanRange mocks base method.
-func (m *MockVLANServiceIface) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+func (m *MockVLANServiceIface) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams, opts ...CallOption) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseDedicatedGuestVlanRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseDedicatedGuestVlanRange", varargs...)
 	ret0, _ := ret[0].(*ReleaseDedicatedGuestVlanRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseDedicatedGuestVlanRange indicates an expected call of ReleaseDedicatedGuestVlanRange.
-func (mr *MockVLANServiceIfaceMockRecorder) ReleaseDedicatedGuestVlanRange(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) ReleaseDedicatedGuestVlanRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedGuestVlanRange", reflect.TypeOf((*MockVLANServiceIface)(nil).ReleaseDedicatedGuestVlanRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedGuestVlanRange", reflect.TypeOf((*MockVLANServiceIface)(nil).ReleaseDedicatedGuestVlanRange), varargs...)
 }
 
 // UpdateVlanIpRange mocks base method.
-func (m *MockVLANServiceIface) UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error) {
+func (m *MockVLANServiceIface) UpdateVlanIpRange(p *UpdateVlanIpRangeParams, opts ...CallOption) (*UpdateVlanIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVlanIpRange", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVlanIpRange", varargs...)
 	ret0, _ := ret[0].(*UpdateVlanIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVlanIpRange indicates an expected call of UpdateVlanIpRange.
-func (mr *MockVLANServiceIfaceMockRecorder) UpdateVlanIpRange(p interface{}) *gomock.Call {
+func (mr *MockVLANServiceIfaceMockRecorder) UpdateVlanIpRange(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).UpdateVlanIpRange), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).UpdateVlanIpRange), varargs...)
 }
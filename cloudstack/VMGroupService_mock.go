@@ -53,33 +53,43 @@ func (m *MockVMGroupServiceIface) EXPECT() *MockVMGroupServiceIfaceMockRecorder
 }
 
 // CreateInstanceGroup mocks base method.
-func (m *MockVMGroupServiceIface) CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
+func (m *MockVMGroupServiceIface) CreateInstanceGroup(p *CreateInstanceGroupParams, opts ...CallOption) (*CreateInstanceGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInstanceGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateInstanceGroup", varargs...)
 	ret0, _ := ret[0].(*CreateInstanceGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateInstanceGroup indicates an expected call of CreateInstanceGroup.
-func (mr *MockVMGroupServiceIfaceMockRecorder) CreateInstanceGroup(p interface{}) *gomock.Call {
+func (mr *MockVMGroupServiceIfaceMockRecorder) CreateInstanceGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).CreateInstanceGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).CreateInstanceGroup), varargs...)
 }
 
 // DeleteInstanceGroup mocks base method.
-func (m *MockVMGroupServiceIface) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
+func (m *MockVMGroupServiceIface) DeleteInstanceGroup(p *DeleteInstanceGroupParams, opts ...CallOption) (*DeleteInstanceGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteInstanceGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteInstanceGroup", varargs...)
 	ret0, _ := ret[0].(*DeleteInstanceGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteInstanceGroup indicates an expected call of DeleteInstanceGroup.
-func (mr *MockVMGroupServiceIfaceMockRecorder) DeleteInstanceGroup(p interface{}) *gomock.Call {
+func (mr *MockVMGroupServiceIfaceMockRecorder) DeleteInstanceGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).DeleteInstanceGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).DeleteInstanceGroup), varargs...)
 }
 
 // GetInstanceGroupByID mocks base method.
@@ -146,18 +156,23 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) GetInstanceGroupID(name interface
 }
 
 // ListInstanceGroups mocks base method.
-func (m *MockVMGroupServiceIface) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+func (m *MockVMGroupServiceIface) ListInstanceGroups(p *ListInstanceGroupsParams, opts ...CallOption) (*ListInstanceGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListInstanceGroups", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInstanceGroups", varargs...)
 	ret0, _ := ret[0].(*ListInstanceGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListInstanceGroups indicates an expected call of ListInstanceGroups.
-func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroups(p interface{}) *gomock.Call {
+func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroups(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroups", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroups), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroups", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroups), varargs...)
 }
 
 // NewCreateInstanceGroupParams mocks base method.
@@ -217,16 +232,21 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) NewUpdateInstanceGroupParams(id i
 }
 
 // UpdateInstanceGroup mocks base method.
-func (m *MockVMGroupServiceIface) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+func (m *MockVMGroupServiceIface) UpdateInstanceGroup(p *UpdateInstanceGroupParams, opts ...CallOption) (*UpdateInstanceGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateInstanceGroup", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateInstanceGroup", varargs...)
 	ret0, _ := ret[0].(*UpdateInstanceGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateInstanceGroup indicates an expected call of UpdateInstanceGroup.
-func (mr *MockVMGroupServiceIfaceMockRecorder) UpdateInstanceGroup(p interface{}) *gomock.Call {
+func (mr *MockVMGroupServiceIfaceMockRecorder) UpdateInstanceGroup(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).UpdateInstanceGroup), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).UpdateInstanceGroup), varargs...)
 }
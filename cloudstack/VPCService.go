@@ -28,339 +28,486 @@ import (
 )
 
 type VPCServiceIface interface {
-	CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGateway(p *CreatePrivateGatewayParams, opts ...CallOption) (*CreatePrivateGatewayResponse, error)
 	NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vpcid string) *CreatePrivateGatewayParams
-	CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRoute(p *CreateStaticRouteParams, opts ...CallOption) (*CreateStaticRouteResponse, error)
 	NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams
-	CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPC(p *CreateVPCParams, opts ...CallOption) (*CreateVPCResponse, error)
 	NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams
-	CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOffering(p *CreateVPCOfferingParams, opts ...CallOption) (*CreateVPCOfferingResponse, error)
 	NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams
-	DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGateway(p *DeletePrivateGatewayParams, opts ...CallOption) (*DeletePrivateGatewayResponse, error)
 	NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams
-	DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRoute(p *DeleteStaticRouteParams, opts ...CallOption) (*DeleteStaticRouteResponse, error)
 	NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams
-	DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPC(p *DeleteVPCParams, opts ...CallOption) (*DeleteVPCResponse, error)
 	NewDeleteVPCParams(id string) *DeleteVPCParams
-	DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOffering(p *DeleteVPCOfferingParams, opts ...CallOption) (*DeleteVPCOfferingResponse, error)
 	NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams
-	ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGateways(p *ListPrivateGatewaysParams, opts ...CallOption) (*ListPrivateGatewaysResponse, error)
 	NewListPrivateGatewaysParams() *ListPrivateGatewaysParams
 	GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
-	ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListStaticRoutes(p *ListStaticRoutesParams, opts ...CallOption) (*ListStaticRoutesResponse, error)
 	NewListStaticRoutesParams() *ListStaticRoutesParams
 	GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error)
-	ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferings(p *ListVPCOfferingsParams, opts ...CallOption) (*ListVPCOfferingsResponse, error)
 	NewListVPCOfferingsParams() *ListVPCOfferingsParams
 	GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error)
 	GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error)
 	GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error)
-	ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error)
+	ListVPCs(p *ListVPCsParams, opts ...CallOption) (*ListVPCsResponse, error)
 	NewListVPCsParams() *ListVPCsParams
 	GetVPCID(name string, opts ...OptionFunc) (string, int, error)
 	GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error)
 	GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error)
-	RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPC(p *RestartVPCParams, opts ...CallOption) (*RestartVPCResponse, error)
 	NewRestartVPCParams(id string) *RestartVPCParams
-	UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPC(p *UpdateVPCParams, opts ...CallOption) (*UpdateVPCResponse, error)
 	NewUpdateVPCParams(id string) *UpdateVPCParams
-	UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOffering(p *UpdateVPCOfferingParams, opts ...CallOption) (*UpdateVPCOfferingResponse, error)
 	NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams
 }
 
 type CreatePrivateGatewayParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
+	aclid                  optString
+	associatednetworkid    optString
+	bypassvlanoverlapcheck optBool
+	gateway                optString
+	ipaddress              optString
+	netmask                optString
+	networkofferingid      optString
+	physicalnetworkid      optString
+	sourcenatsupported     optBool
+	vlan                   optString
+	vpcid                  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreatePrivateGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if p.aclid.ok {
+		u.Set("aclid", p.aclid.v)
 	}
-	if v, found := p.p["associatednetworkid"]; found {
-		u.Set("associatednetworkid", v.(string))
+	if p.associatednetworkid.ok {
+		u.Set("associatednetworkid", p.associatednetworkid.v)
 	}
-	if v, found := p.p["bypassvlanoverlapcheck"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bypassvlanoverlapcheck", vv)
+	if p.bypassvlanoverlapcheck.ok {
+		u.Set("bypassvlanoverlapcheck", strconv.FormatBool(p.bypassvlanoverlapcheck.v))
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if p.netmask.ok {
+		u.Set("netmask", p.netmask.v)
 	}
-	if v, found := p.p["networkofferingid"]; found {
-		u.Set("networkofferingid", v.(string))
+	if p.networkofferingid.ok {
+		u.Set("networkofferingid", p.networkofferingid.v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if p.physicalnetworkid.ok {
+		u.Set("physicalnetworkid", p.physicalnetworkid.v)
 	}
-	if v, found := p.p["sourcenatsupported"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sourcenatsupported", vv)
+	if p.sourcenatsupported.ok {
+		u.Set("sourcenatsupported", strconv.FormatBool(p.sourcenatsupported.v))
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *CreatePrivateGatewayParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreatePrivateGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new CreatePrivateGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreatePrivateGatewayParams(u url.Values) (*CreatePrivateGatewayParams, error) {
+	p := &CreatePrivateGatewayParams{}
+	if err := checkParamNames("createPrivateGateway", u, "aclid", "associatednetworkid", "bypassvlanoverlapcheck", "gateway", "ipaddress", "netmask", "networkofferingid", "physicalnetworkid", "sourcenatsupported", "vlan", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["aclid"]; found {
+		p.SetAclid(u.Get("aclid"))
+	}
+	if _, found := u["associatednetworkid"]; found {
+		p.SetAssociatednetworkid(u.Get("associatednetworkid"))
+	}
+	if _, found := u["bypassvlanoverlapcheck"]; found {
+		v, err := strconv.ParseBool(u.Get("bypassvlanoverlapcheck"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bypassvlanoverlapcheck: %v", err)
+		}
+		p.SetBypassvlanoverlapcheck(v)
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["netmask"]; found {
+		p.SetNetmask(u.Get("netmask"))
+	}
+	if _, found := u["networkofferingid"]; found {
+		p.SetNetworkofferingid(u.Get("networkofferingid"))
+	}
+	if _, found := u["physicalnetworkid"]; found {
+		p.SetPhysicalnetworkid(u.Get("physicalnetworkid"))
+	}
+	if _, found := u["sourcenatsupported"]; found {
+		v, err := strconv.ParseBool(u.Get("sourcenatsupported"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sourcenatsupported: %v", err)
+		}
+		p.SetSourcenatsupported(v)
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
 	}
-	p.p["aclid"] = v
+	return p, nil
 }
 
+// SetAclid sets the aclid param.
+func (p *CreatePrivateGatewayParams) SetAclid(v string) {
+	p.aclid = optString{v: v, ok: true}
+}
+
+// ResetAclid unsets the aclid param
 func (p *CreatePrivateGatewayParams) ResetAclid() {
-	if p.p != nil && p.p["aclid"] != nil {
-		delete(p.p, "aclid")
-	}
+	p.aclid = optString{}
 }
 
+// GetAclid returns the aclid param and if it is set
 func (p *CreatePrivateGatewayParams) GetAclid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["aclid"].(string)
-	return value, ok
+	return p.aclid.v, p.aclid.ok
 }
 
+// SetAssociatednetworkid sets the associatednetworkid param.
 func (p *CreatePrivateGatewayParams) SetAssociatednetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["associatednetworkid"] = v
+	p.associatednetworkid = optString{v: v, ok: true}
 }
 
+// ResetAssociatednetworkid unsets the associatednetworkid param
 func (p *CreatePrivateGatewayParams) ResetAssociatednetworkid() {
-	if p.p != nil && p.p["associatednetworkid"] != nil {
-		delete(p.p, "associatednetworkid")
-	}
+	p.associatednetworkid = optString{}
 }
 
+// GetAssociatednetworkid returns the associatednetworkid param and if it is set
 func (p *CreatePrivateGatewayParams) GetAssociatednetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["associatednetworkid"].(string)
-	return value, ok
+	return p.associatednetworkid.v, p.associatednetworkid.ok
 }
 
+// SetBypassvlanoverlapcheck sets the bypassvlanoverlapcheck param.
 func (p *CreatePrivateGatewayParams) SetBypassvlanoverlapcheck(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bypassvlanoverlapcheck"] = v
+	p.bypassvlanoverlapcheck = optBool{v: v, ok: true}
 }
 
+// ResetBypassvlanoverlapcheck unsets the bypassvlanoverlapcheck param
 func (p *CreatePrivateGatewayParams) ResetBypassvlanoverlapcheck() {
-	if p.p != nil && p.p["bypassvlanoverlapcheck"] != nil {
-		delete(p.p, "bypassvlanoverlapcheck")
-	}
+	p.bypassvlanoverlapcheck = optBool{}
 }
 
+// GetBypassvlanoverlapcheck returns the bypassvlanoverlapcheck param and if it is set
 func (p *CreatePrivateGatewayParams) GetBypassvlanoverlapcheck() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bypassvlanoverlapcheck"].(bool)
-	return value, ok
+	return p.bypassvlanoverlapcheck.v, p.bypassvlanoverlapcheck.ok
 }
 
+// SetGateway sets the gateway param. This param is required.
 func (p *CreatePrivateGatewayParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *CreatePrivateGatewayParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *CreatePrivateGatewayParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetIpaddress sets the ipaddress param. This param is required.
 func (p *CreatePrivateGatewayParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *CreatePrivateGatewayParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *CreatePrivateGatewayParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetNetmask sets the netmask param. This param is required.
 func (p *CreatePrivateGatewayParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+	p.netmask = optString{v: v, ok: true}
 }
 
+// ResetNetmask unsets the netmask param
 func (p *CreatePrivateGatewayParams) ResetNetmask() {
-	if p.p != nil && p.p["netmask"] != nil {
-		delete(p.p, "netmask")
-	}
+	p.netmask = optString{}
 }
 
+// GetNetmask returns the netmask param and if it is set
 func (p *CreatePrivateGatewayParams) GetNetmask() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["netmask"].(string)
-	return value, ok
+	return p.netmask.v, p.netmask.ok
 }
 
+// SetNetworkofferingid sets the networkofferingid param.
 func (p *CreatePrivateGatewayParams) SetNetworkofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkofferingid"] = v
+	p.networkofferingid = optString{v: v, ok: true}
 }
 
+// ResetNetworkofferingid unsets the networkofferingid param
 func (p *CreatePrivateGatewayParams) ResetNetworkofferingid() {
-	if p.p != nil && p.p["networkofferingid"] != nil {
-		delete(p.p, "networkofferingid")
-	}
+	p.networkofferingid = optString{}
 }
 
+// GetNetworkofferingid returns the networkofferingid param and if it is set
 func (p *CreatePrivateGatewayParams) GetNetworkofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkofferingid"].(string)
-	return value, ok
+	return p.networkofferingid.v, p.networkofferingid.ok
 }
 
+// SetPhysicalnetworkid sets the physicalnetworkid param.
 func (p *CreatePrivateGatewayParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+	p.physicalnetworkid = optString{v: v, ok: true}
 }
 
+// ResetPhysicalnetworkid unsets the physicalnetworkid param
 func (p *CreatePrivateGatewayParams) ResetPhysicalnetworkid() {
-	if p.p != nil && p.p["physicalnetworkid"] != nil {
-		delete(p.p, "physicalnetworkid")
-	}
+	p.physicalnetworkid = optString{}
 }
 
+// GetPhysicalnetworkid returns the physicalnetworkid param and if it is set
 func (p *CreatePrivateGatewayParams) GetPhysicalnetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["physicalnetworkid"].(string)
-	return value, ok
+	return p.physicalnetworkid.v, p.physicalnetworkid.ok
 }
 
+// SetSourcenatsupported sets the sourcenatsupported param.
 func (p *CreatePrivateGatewayParams) SetSourcenatsupported(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcenatsupported"] = v
+	p.sourcenatsupported = optBool{v: v, ok: true}
 }
 
+// ResetSourcenatsupported unsets the sourcenatsupported param
 func (p *CreatePrivateGatewayParams) ResetSourcenatsupported() {
-	if p.p != nil && p.p["sourcenatsupported"] != nil {
-		delete(p.p, "sourcenatsupported")
-	}
+	p.sourcenatsupported = optBool{}
 }
 
+// GetSourcenatsupported returns the sourcenatsupported param and if it is set
 func (p *CreatePrivateGatewayParams) GetSourcenatsupported() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourcenatsupported"].(bool)
-	return value, ok
+	return p.sourcenatsupported.v, p.sourcenatsupported.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *CreatePrivateGatewayParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *CreatePrivateGatewayParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *CreatePrivateGatewayParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetVpcid sets the vpcid param. This param is required.
 func (p *CreatePrivateGatewayParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *CreatePrivateGatewayParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *CreatePrivateGatewayParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreatePrivateGatewayParams) Clone() *CreatePrivateGatewayParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreatePrivateGatewayParams) Equal(o *CreatePrivateGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.aclid == o.aclid &&
+		p.associatednetworkid == o.associatednetworkid &&
+		p.bypassvlanoverlapcheck == o.bypassvlanoverlapcheck &&
+		p.gateway == o.gateway &&
+		p.ipaddress == o.ipaddress &&
+		p.netmask == o.netmask &&
+		p.networkofferingid == o.networkofferingid &&
+		p.physicalnetworkid == o.physicalnetworkid &&
+		p.sourcenatsupported == o.sourcenatsupported &&
+		p.vlan == o.vlan &&
+		p.vpcid == o.vpcid
+}
+
+// serializedCreatePrivateGatewayParams is used to (un)marshal CreatePrivateGatewayParams using the API param names
+type serializedCreatePrivateGatewayParams struct {
+	Aclid                  *string `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Associatednetworkid    *string `json:"associatednetworkid,omitempty" yaml:"associatednetworkid,omitempty"`
+	Bypassvlanoverlapcheck *bool   `json:"bypassvlanoverlapcheck,omitempty" yaml:"bypassvlanoverlapcheck,omitempty"`
+	Gateway                *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Ipaddress              *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Netmask                *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Networkofferingid      *string `json:"networkofferingid,omitempty" yaml:"networkofferingid,omitempty"`
+	Physicalnetworkid      *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Sourcenatsupported     *bool   `json:"sourcenatsupported,omitempty" yaml:"sourcenatsupported,omitempty"`
+	Vlan                   *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vpcid                  *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *CreatePrivateGatewayParams) toSerialized() *serializedCreatePrivateGatewayParams {
+	s := &serializedCreatePrivateGatewayParams{}
+	if p.aclid.ok {
+		s.Aclid = &p.aclid.v
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	if p.associatednetworkid.ok {
+		s.Associatednetworkid = &p.associatednetworkid.v
+	}
+	if p.bypassvlanoverlapcheck.ok {
+		s.Bypassvlanoverlapcheck = &p.bypassvlanoverlapcheck.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.netmask.ok {
+		s.Netmask = &p.netmask.v
+	}
+	if p.networkofferingid.ok {
+		s.Networkofferingid = &p.networkofferingid.v
+	}
+	if p.physicalnetworkid.ok {
+		s.Physicalnetworkid = &p.physicalnetworkid.v
+	}
+	if p.sourcenatsupported.ok {
+		s.Sourcenatsupported = &p.sourcenatsupported.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	return s
+}
+
+func (p *CreatePrivateGatewayParams) fromSerialized(s *serializedCreatePrivateGatewayParams) {
+	*p = CreatePrivateGatewayParams{}
+	if s.Aclid != nil {
+		p.SetAclid(*s.Aclid)
+	}
+	if s.Associatednetworkid != nil {
+		p.SetAssociatednetworkid(*s.Associatednetworkid)
+	}
+	if s.Bypassvlanoverlapcheck != nil {
+		p.SetBypassvlanoverlapcheck(*s.Bypassvlanoverlapcheck)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Netmask != nil {
+		p.SetNetmask(*s.Netmask)
+	}
+	if s.Networkofferingid != nil {
+		p.SetNetworkofferingid(*s.Networkofferingid)
+	}
+	if s.Physicalnetworkid != nil {
+		p.SetPhysicalnetworkid(*s.Physicalnetworkid)
+	}
+	if s.Sourcenatsupported != nil {
+		p.SetSourcenatsupported(*s.Sourcenatsupported)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreatePrivateGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreatePrivateGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreatePrivateGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreatePrivateGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreatePrivateGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreatePrivateGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreatePrivateGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vpcid string) *CreatePrivateGatewayParams {
 	p := &CreatePrivateGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["gateway"] = gateway
-	p.p["ipaddress"] = ipaddress
-	p.p["netmask"] = netmask
-	p.p["vpcid"] = vpcid
+	p.SetGateway(gateway)
+	p.SetIpaddress(ipaddress)
+	p.SetNetmask(netmask)
+	p.SetVpcid(vpcid)
 	return p
 }
 
-// Creates a private gateway
-func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createPrivateGateway", p.toURLValues())
+// Creates a private gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: gateway, ipaddress,
+// netmask, vpcid.
+func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams, opts ...CallOption) (*CreatePrivateGatewayResponse, error) {
+	resp, err := s.cs.newRequest("createPrivateGateway", s.cs.encodeParams("createPrivateGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -371,8 +518,8 @@ func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*Creat
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -421,78 +568,164 @@ type CreatePrivateGatewayResponse struct {
 }
 
 type CreateStaticRouteParams struct {
-	p map[string]interface{}
+	cidr      optString
+	gatewayid optString
 }
 
-func (p *CreateStaticRouteParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateStaticRouteParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cidr"]; found {
-		u.Set("cidr", v.(string))
+	if p.cidr.ok {
+		u.Set("cidr", p.cidr.v)
 	}
-	if v, found := p.p["gatewayid"]; found {
-		u.Set("gatewayid", v.(string))
+	if p.gatewayid.ok {
+		u.Set("gatewayid", p.gatewayid.v)
 	}
 	return u
 }
 
-func (p *CreateStaticRouteParams) SetCidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateStaticRouteParams parses url.Values, for example taken from a raw API request,
+// into a new CreateStaticRouteParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateStaticRouteParams(u url.Values) (*CreateStaticRouteParams, error) {
+	p := &CreateStaticRouteParams{}
+	if err := checkParamNames("createStaticRoute", u, "cidr", "gatewayid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cidr"]; found {
+		p.SetCidr(u.Get("cidr"))
 	}
-	p.p["cidr"] = v
+	if _, found := u["gatewayid"]; found {
+		p.SetGatewayid(u.Get("gatewayid"))
+	}
+	return p, nil
+}
+
+// SetCidr sets the cidr param. This param is required.
+func (p *CreateStaticRouteParams) SetCidr(v string) {
+	p.cidr = optString{v: v, ok: true}
 }
 
+// ResetCidr unsets the cidr param
 func (p *CreateStaticRouteParams) ResetCidr() {
-	if p.p != nil && p.p["cidr"] != nil {
-		delete(p.p, "cidr")
-	}
+	p.cidr = optString{}
 }
 
+// GetCidr returns the cidr param and if it is set
 func (p *CreateStaticRouteParams) GetCidr() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidr"].(string)
-	return value, ok
+	return p.cidr.v, p.cidr.ok
 }
 
+// SetGatewayid sets the gatewayid param. This param is required.
 func (p *CreateStaticRouteParams) SetGatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gatewayid"] = v
+	p.gatewayid = optString{v: v, ok: true}
 }
 
+// ResetGatewayid unsets the gatewayid param
 func (p *CreateStaticRouteParams) ResetGatewayid() {
-	if p.p != nil && p.p["gatewayid"] != nil {
-		delete(p.p, "gatewayid")
-	}
+	p.gatewayid = optString{}
 }
 
+// GetGatewayid returns the gatewayid param and if it is set
 func (p *CreateStaticRouteParams) GetGatewayid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.gatewayid.v, p.gatewayid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateStaticRouteParams) Clone() *CreateStaticRouteParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateStaticRouteParams) Equal(o *CreateStaticRouteParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cidr == o.cidr &&
+		p.gatewayid == o.gatewayid
+}
+
+// serializedCreateStaticRouteParams is used to (un)marshal CreateStaticRouteParams using the API param names
+type serializedCreateStaticRouteParams struct {
+	Cidr      *string `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+	Gatewayid *string `json:"gatewayid,omitempty" yaml:"gatewayid,omitempty"`
+}
+
+func (p *CreateStaticRouteParams) toSerialized() *serializedCreateStaticRouteParams {
+	s := &serializedCreateStaticRouteParams{}
+	if p.cidr.ok {
+		s.Cidr = &p.cidr.v
+	}
+	if p.gatewayid.ok {
+		s.Gatewayid = &p.gatewayid.v
+	}
+	return s
+}
+
+func (p *CreateStaticRouteParams) fromSerialized(s *serializedCreateStaticRouteParams) {
+	*p = CreateStaticRouteParams{}
+	if s.Cidr != nil {
+		p.SetCidr(*s.Cidr)
+	}
+	if s.Gatewayid != nil {
+		p.SetGatewayid(*s.Gatewayid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateStaticRouteParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateStaticRouteParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateStaticRouteParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateStaticRouteParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateStaticRouteParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateStaticRouteParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["gatewayid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateStaticRouteParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams {
 	p := &CreateStaticRouteParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidr"] = cidr
-	p.p["gatewayid"] = gatewayid
+	p.SetCidr(cidr)
+	p.SetGatewayid(gatewayid)
 	return p
 }
 
-// Creates a static route
-func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("createStaticRoute", p.toURLValues())
+// Creates a static route.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: cidr, gatewayid.
+func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams, opts ...CallOption) (*CreateStaticRouteResponse, error) {
+	resp, err := s.cs.newRequest("createStaticRoute", s.cs.encodeParams("createStaticRoute", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -503,8 +736,8 @@ func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStati
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -542,482 +775,668 @@ type CreateStaticRouteResponse struct {
 }
 
 type CreateVPCParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVPCParams) toURLValues() url.Values {
+	account            optString
+	cidr               optString
+	displaytext        optString
+	dns1               optString
+	dns2               optString
+	domainid           optString
+	fordisplay         optBool
+	ip6dns1            optString
+	ip6dns2            optString
+	name               optString
+	networkdomain      optString
+	projectid          optString
+	publicmtu          optInt
+	sourcenatipaddress optString
+	start              optBool
+	vpcofferingid      optString
+	zoneid             optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["cidr"]; found {
-		u.Set("cidr", v.(string))
+	if p.cidr.ok {
+		u.Set("cidr", p.cidr.v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["dns1"]; found {
-		u.Set("dns1", v.(string))
+	if p.dns1.ok {
+		u.Set("dns1", p.dns1.v)
 	}
-	if v, found := p.p["dns2"]; found {
-		u.Set("dns2", v.(string))
+	if p.dns2.ok {
+		u.Set("dns2", p.dns2.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["ip6dns1"]; found {
-		u.Set("ip6dns1", v.(string))
+	if p.ip6dns1.ok {
+		u.Set("ip6dns1", p.ip6dns1.v)
 	}
-	if v, found := p.p["ip6dns2"]; found {
-		u.Set("ip6dns2", v.(string))
+	if p.ip6dns2.ok {
+		u.Set("ip6dns2", p.ip6dns2.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if p.networkdomain.ok {
+		u.Set("networkdomain", p.networkdomain.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["publicmtu"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicmtu", vv)
+	if p.publicmtu.ok {
+		u.Set("publicmtu", strconv.Itoa(p.publicmtu.v))
 	}
-	if v, found := p.p["sourcenatipaddress"]; found {
-		u.Set("sourcenatipaddress", v.(string))
+	if p.sourcenatipaddress.ok {
+		u.Set("sourcenatipaddress", p.sourcenatipaddress.v)
 	}
-	if v, found := p.p["start"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("start", vv)
+	if p.start.ok {
+		u.Set("start", strconv.FormatBool(p.start.v))
 	}
-	if v, found := p.p["vpcofferingid"]; found {
-		u.Set("vpcofferingid", v.(string))
+	if p.vpcofferingid.ok {
+		u.Set("vpcofferingid", p.vpcofferingid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateVPCParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVPCParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVPCParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVPCParams(u url.Values) (*CreateVPCParams, error) {
+	p := &CreateVPCParams{}
+	if err := checkParamNames("createVPC", u, "account", "cidr", "displaytext", "dns1", "dns2", "domainid", "fordisplay", "ip6dns1", "ip6dns2", "name", "networkdomain", "projectid", "publicmtu", "sourcenatipaddress", "start", "vpcofferingid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["cidr"]; found {
+		p.SetCidr(u.Get("cidr"))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["dns1"]; found {
+		p.SetDns1(u.Get("dns1"))
+	}
+	if _, found := u["dns2"]; found {
+		p.SetDns2(u.Get("dns2"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["ip6dns1"]; found {
+		p.SetIp6dns1(u.Get("ip6dns1"))
+	}
+	if _, found := u["ip6dns2"]; found {
+		p.SetIp6dns2(u.Get("ip6dns2"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkdomain"]; found {
+		p.SetNetworkdomain(u.Get("networkdomain"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["publicmtu"]; found {
+		v, err := strconv.Atoi(u.Get("publicmtu"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param publicmtu: %v", err)
+		}
+		p.SetPublicmtu(v)
+	}
+	if _, found := u["sourcenatipaddress"]; found {
+		p.SetSourcenatipaddress(u.Get("sourcenatipaddress"))
+	}
+	if _, found := u["start"]; found {
+		v, err := strconv.ParseBool(u.Get("start"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param start: %v", err)
+		}
+		p.SetStart(v)
+	}
+	if _, found := u["vpcofferingid"]; found {
+		p.SetVpcofferingid(u.Get("vpcofferingid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *CreateVPCParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *CreateVPCParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateVPCParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCidr sets the cidr param. This param is required.
 func (p *CreateVPCParams) SetCidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidr"] = v
+	p.cidr = optString{v: v, ok: true}
 }
 
+// ResetCidr unsets the cidr param
 func (p *CreateVPCParams) ResetCidr() {
-	if p.p != nil && p.p["cidr"] != nil {
-		delete(p.p, "cidr")
-	}
+	p.cidr = optString{}
 }
 
+// GetCidr returns the cidr param and if it is set
 func (p *CreateVPCParams) GetCidr() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidr"].(string)
-	return value, ok
+	return p.cidr.v, p.cidr.ok
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
 func (p *CreateVPCParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateVPCParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateVPCParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDns1 sets the dns1 param.
 func (p *CreateVPCParams) SetDns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns1"] = v
+	p.dns1 = optString{v: v, ok: true}
 }
 
+// ResetDns1 unsets the dns1 param
 func (p *CreateVPCParams) ResetDns1() {
-	if p.p != nil && p.p["dns1"] != nil {
-		delete(p.p, "dns1")
-	}
+	p.dns1 = optString{}
 }
 
+// GetDns1 returns the dns1 param and if it is set
 func (p *CreateVPCParams) GetDns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns1"].(string)
-	return value, ok
+	return p.dns1.v, p.dns1.ok
 }
 
+// SetDns2 sets the dns2 param.
 func (p *CreateVPCParams) SetDns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns2"] = v
+	p.dns2 = optString{v: v, ok: true}
 }
 
+// ResetDns2 unsets the dns2 param
 func (p *CreateVPCParams) ResetDns2() {
-	if p.p != nil && p.p["dns2"] != nil {
-		delete(p.p, "dns2")
-	}
+	p.dns2 = optString{}
 }
 
+// GetDns2 returns the dns2 param and if it is set
 func (p *CreateVPCParams) GetDns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns2"].(string)
-	return value, ok
+	return p.dns2.v, p.dns2.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateVPCParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateVPCParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateVPCParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateVPCParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateVPCParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateVPCParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIp6dns1 sets the ip6dns1 param.
 func (p *CreateVPCParams) SetIp6dns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns1"] = v
+	p.ip6dns1 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns1 unsets the ip6dns1 param
 func (p *CreateVPCParams) ResetIp6dns1() {
-	if p.p != nil && p.p["ip6dns1"] != nil {
-		delete(p.p, "ip6dns1")
-	}
+	p.ip6dns1 = optString{}
 }
 
+// GetIp6dns1 returns the ip6dns1 param and if it is set
 func (p *CreateVPCParams) GetIp6dns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns1"].(string)
-	return value, ok
+	return p.ip6dns1.v, p.ip6dns1.ok
 }
 
+// SetIp6dns2 sets the ip6dns2 param.
 func (p *CreateVPCParams) SetIp6dns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns2"] = v
+	p.ip6dns2 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns2 unsets the ip6dns2 param
 func (p *CreateVPCParams) ResetIp6dns2() {
-	if p.p != nil && p.p["ip6dns2"] != nil {
-		delete(p.p, "ip6dns2")
-	}
+	p.ip6dns2 = optString{}
 }
 
+// GetIp6dns2 returns the ip6dns2 param and if it is set
 func (p *CreateVPCParams) GetIp6dns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns2"].(string)
-	return value, ok
+	return p.ip6dns2.v, p.ip6dns2.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateVPCParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateVPCParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateVPCParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkdomain sets the networkdomain param.
 func (p *CreateVPCParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+	p.networkdomain = optString{v: v, ok: true}
 }
 
+// ResetNetworkdomain unsets the networkdomain param
 func (p *CreateVPCParams) ResetNetworkdomain() {
-	if p.p != nil && p.p["networkdomain"] != nil {
-		delete(p.p, "networkdomain")
-	}
+	p.networkdomain = optString{}
 }
 
+// GetNetworkdomain returns the networkdomain param and if it is set
 func (p *CreateVPCParams) GetNetworkdomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkdomain"].(string)
-	return value, ok
+	return p.networkdomain.v, p.networkdomain.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateVPCParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateVPCParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateVPCParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetPublicmtu sets the publicmtu param.
 func (p *CreateVPCParams) SetPublicmtu(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicmtu"] = v
+	p.publicmtu = optInt{v: v, ok: true}
 }
 
+// ResetPublicmtu unsets the publicmtu param
 func (p *CreateVPCParams) ResetPublicmtu() {
-	if p.p != nil && p.p["publicmtu"] != nil {
-		delete(p.p, "publicmtu")
-	}
+	p.publicmtu = optInt{}
 }
 
+// GetPublicmtu returns the publicmtu param and if it is set
 func (p *CreateVPCParams) GetPublicmtu() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicmtu"].(int)
-	return value, ok
+	return p.publicmtu.v, p.publicmtu.ok
 }
 
+// SetSourcenatipaddress sets the sourcenatipaddress param.
 func (p *CreateVPCParams) SetSourcenatipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcenatipaddress"] = v
+	p.sourcenatipaddress = optString{v: v, ok: true}
 }
 
+// ResetSourcenatipaddress unsets the sourcenatipaddress param
 func (p *CreateVPCParams) ResetSourcenatipaddress() {
-	if p.p != nil && p.p["sourcenatipaddress"] != nil {
-		delete(p.p, "sourcenatipaddress")
-	}
+	p.sourcenatipaddress = optString{}
 }
 
+// GetSourcenatipaddress returns the sourcenatipaddress param and if it is set
 func (p *CreateVPCParams) GetSourcenatipaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sourcenatipaddress"].(string)
-	return value, ok
+	return p.sourcenatipaddress.v, p.sourcenatipaddress.ok
 }
 
+// SetStart sets the start param.
 func (p *CreateVPCParams) SetStart(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["start"] = v
+	p.start = optBool{v: v, ok: true}
 }
 
+// ResetStart unsets the start param
 func (p *CreateVPCParams) ResetStart() {
-	if p.p != nil && p.p["start"] != nil {
-		delete(p.p, "start")
-	}
+	p.start = optBool{}
 }
 
+// GetStart returns the start param and if it is set
 func (p *CreateVPCParams) GetStart() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["start"].(bool)
-	return value, ok
+	return p.start.v, p.start.ok
 }
 
+// SetVpcofferingid sets the vpcofferingid param. This param is required.
 func (p *CreateVPCParams) SetVpcofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcofferingid"] = v
+	p.vpcofferingid = optString{v: v, ok: true}
 }
 
+// ResetVpcofferingid unsets the vpcofferingid param
 func (p *CreateVPCParams) ResetVpcofferingid() {
-	if p.p != nil && p.p["vpcofferingid"] != nil {
-		delete(p.p, "vpcofferingid")
-	}
+	p.vpcofferingid = optString{}
 }
 
+// GetVpcofferingid returns the vpcofferingid param and if it is set
 func (p *CreateVPCParams) GetVpcofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcofferingid"].(string)
-	return value, ok
+	return p.vpcofferingid.v, p.vpcofferingid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *CreateVPCParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateVPCParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateVPCParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVPCParams) Clone() *CreateVPCParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVPCParams) Equal(o *CreateVPCParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return p.account == o.account &&
+		p.cidr == o.cidr &&
+		p.displaytext == o.displaytext &&
+		p.dns1 == o.dns1 &&
+		p.dns2 == o.dns2 &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.ip6dns1 == o.ip6dns1 &&
+		p.ip6dns2 == o.ip6dns2 &&
+		p.name == o.name &&
+		p.networkdomain == o.networkdomain &&
+		p.projectid == o.projectid &&
+		p.publicmtu == o.publicmtu &&
+		p.sourcenatipaddress == o.sourcenatipaddress &&
+		p.start == o.start &&
+		p.vpcofferingid == o.vpcofferingid &&
+		p.zoneid == o.zoneid
 }
 
-// You should always use this function to get a new CreateVPCParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams {
-	p := &CreateVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidr"] = cidr
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["vpcofferingid"] = vpcofferingid
-	p.p["zoneid"] = zoneid
-	return p
+// serializedCreateVPCParams is used to (un)marshal CreateVPCParams using the API param names
+type serializedCreateVPCParams struct {
+	Account            *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidr               *string `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+	Displaytext        *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Dns1               *string `json:"dns1,omitempty" yaml:"dns1,omitempty"`
+	Dns2               *string `json:"dns2,omitempty" yaml:"dns2,omitempty"`
+	Domainid           *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay         *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Ip6dns1            *string `json:"ip6dns1,omitempty" yaml:"ip6dns1,omitempty"`
+	Ip6dns2            *string `json:"ip6dns2,omitempty" yaml:"ip6dns2,omitempty"`
+	Name               *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain      *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Projectid          *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publicmtu          *int    `json:"publicmtu,omitempty" yaml:"publicmtu,omitempty"`
+	Sourcenatipaddress *string `json:"sourcenatipaddress,omitempty" yaml:"sourcenatipaddress,omitempty"`
+	Start              *bool   `json:"start,omitempty" yaml:"start,omitempty"`
+	Vpcofferingid      *string `json:"vpcofferingid,omitempty" yaml:"vpcofferingid,omitempty"`
+	Zoneid             *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-// Creates a VPC
-func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
-	resp, err := s.cs.newRequest("createVPC", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *CreateVPCParams) toSerialized() *serializedCreateVPCParams {
+	s := &serializedCreateVPCParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
 	}
-
-	var r CreateVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	if p.cidr.ok {
+		s.Cidr = &p.cidr.v
 	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
 	}
-
-	return &r, nil
-}
-
-type CreateVPCResponse struct {
-	Account              string                     `json:"account"`
-	Cidr                 string                     `json:"cidr"`
-	Created              string                     `json:"created"`
-	Displaytext          string                     `json:"displaytext"`
-	Distributedvpcrouter bool                       `json:"distributedvpcrouter"`
+	if p.dns1.ok {
+		s.Dns1 = &p.dns1.v
+	}
+	if p.dns2.ok {
+		s.Dns2 = &p.dns2.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.ip6dns1.ok {
+		s.Ip6dns1 = &p.ip6dns1.v
+	}
+	if p.ip6dns2.ok {
+		s.Ip6dns2 = &p.ip6dns2.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkdomain.ok {
+		s.Networkdomain = &p.networkdomain.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.publicmtu.ok {
+		s.Publicmtu = &p.publicmtu.v
+	}
+	if p.sourcenatipaddress.ok {
+		s.Sourcenatipaddress = &p.sourcenatipaddress.v
+	}
+	if p.start.ok {
+		s.Start = &p.start.v
+	}
+	if p.vpcofferingid.ok {
+		s.Vpcofferingid = &p.vpcofferingid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateVPCParams) fromSerialized(s *serializedCreateVPCParams) {
+	*p = CreateVPCParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Cidr != nil {
+		p.SetCidr(*s.Cidr)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Dns1 != nil {
+		p.SetDns1(*s.Dns1)
+	}
+	if s.Dns2 != nil {
+		p.SetDns2(*s.Dns2)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Ip6dns1 != nil {
+		p.SetIp6dns1(*s.Ip6dns1)
+	}
+	if s.Ip6dns2 != nil {
+		p.SetIp6dns2(*s.Ip6dns2)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkdomain != nil {
+		p.SetNetworkdomain(*s.Networkdomain)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Publicmtu != nil {
+		p.SetPublicmtu(*s.Publicmtu)
+	}
+	if s.Sourcenatipaddress != nil {
+		p.SetSourcenatipaddress(*s.Sourcenatipaddress)
+	}
+	if s.Start != nil {
+		p.SetStart(*s.Start)
+	}
+	if s.Vpcofferingid != nil {
+		p.SetVpcofferingid(*s.Vpcofferingid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVPCParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVPCParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVPCParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVPCParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVPCParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVPCParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateVPCParams instance,
+// as then you are sure you have configured all required params
+func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams {
+	p := &CreateVPCParams{}
+	p.SetCidr(cidr)
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetVpcofferingid(vpcofferingid)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Creates a VPC.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: cidr, displaytext, name,
+// vpcofferingid, zoneid.
+func (s *VPCService) CreateVPC(p *CreateVPCParams, opts ...CallOption) (*CreateVPCResponse, error) {
+	resp, err := s.cs.newRequest("createVPC", s.cs.encodeParams("createVPC", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+type CreateVPCResponse struct {
+	Account              string                     `json:"account"`
+	Cidr                 string                     `json:"cidr"`
+	Created              string                     `json:"created"`
+	Displaytext          string                     `json:"displaytext"`
+	Distributedvpcrouter bool                       `json:"distributedvpcrouter"`
 	Dns1                 string                     `json:"dns1"`
 	Dns2                 string                     `json:"dns2"`
 	Domain               string                     `json:"domain"`
@@ -1072,283 +1491,427 @@ type CreateVPCResponseServiceCapability struct {
 }
 
 type CreateVPCOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVPCOfferingParams) toURLValues() url.Values {
+	displaytext           optString
+	domainid              optStrings
+	enable                optBool
+	internetprotocol      optString
+	name                  optString
+	servicecapabilitylist optStringMap
+	serviceofferingid     optString
+	serviceproviderlist   optStringMap
+	supportedservices     optStrings
+	zoneid                optStrings
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVPCOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("domainid", vv)
+	if p.domainid.ok {
+		u.Set("domainid", strings.Join(p.domainid.v, ","))
 	}
-	if v, found := p.p["enable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enable", vv)
+	if p.enable.ok {
+		u.Set("enable", strconv.FormatBool(p.enable.v))
 	}
-	if v, found := p.p["internetprotocol"]; found {
-		u.Set("internetprotocol", v.(string))
+	if p.internetprotocol.ok {
+		u.Set("internetprotocol", p.internetprotocol.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["servicecapabilitylist"]; found {
-		m := v.(map[string]string)
+	if p.servicecapabilitylist.ok {
+		m := p.servicecapabilitylist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
 			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["serviceproviderlist"]; found {
-		m := v.(map[string]string)
+	if p.serviceproviderlist.ok {
+		m := p.serviceproviderlist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), k)
 			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), m[k])
 		}
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("supportedservices", vv)
+	if p.supportedservices.ok {
+		u.Set("supportedservices", strings.Join(p.supportedservices.v, ","))
 	}
-	if v, found := p.p["zoneid"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("zoneid", vv)
+	if p.zoneid.ok {
+		u.Set("zoneid", strings.Join(p.zoneid.v, ","))
 	}
 	return u
 }
 
-func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVPCOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVPCOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVPCOfferingParams(u url.Values) (*CreateVPCOfferingParams, error) {
+	p := &CreateVPCOfferingParams{}
+	if err := checkParamNames("createVPCOffering", u, "displaytext", "domainid", "enable", "internetprotocol", "name", "servicecapabilitylist[]", "serviceofferingid", "serviceproviderlist[]", "supportedservices", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(strings.Split(u.Get("domainid"), ","))
 	}
-	p.p["displaytext"] = v
+	if _, found := u["enable"]; found {
+		v, err := strconv.ParseBool(u.Get("enable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param enable: %v", err)
+		}
+		p.SetEnable(v)
+	}
+	if _, found := u["internetprotocol"]; found {
+		p.SetInternetprotocol(u.Get("internetprotocol"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if m, err := parseKeyValueMap(u, "servicecapabilitylist", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetServicecapabilitylist(m)
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if m, err := parseKeyValueMap(u, "serviceproviderlist", "service", "provider", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetServiceproviderlist(m)
+	}
+	if _, found := u["supportedservices"]; found {
+		p.SetSupportedservices(strings.Split(u.Get("supportedservices"), ","))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(strings.Split(u.Get("zoneid"), ","))
+	}
+	return p, nil
 }
 
+// SetDisplaytext sets the displaytext param. This param is required.
+func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
+	p.displaytext = optString{v: v, ok: true}
+}
+
+// ResetDisplaytext unsets the displaytext param
 func (p *CreateVPCOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *CreateVPCOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateVPCOfferingParams) SetDomainid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optStrings{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateVPCOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optStrings{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateVPCOfferingParams) GetDomainid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].([]string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetEnable sets the enable param.
 func (p *CreateVPCOfferingParams) SetEnable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enable"] = v
+	p.enable = optBool{v: v, ok: true}
 }
 
+// ResetEnable unsets the enable param
 func (p *CreateVPCOfferingParams) ResetEnable() {
-	if p.p != nil && p.p["enable"] != nil {
-		delete(p.p, "enable")
-	}
+	p.enable = optBool{}
 }
 
+// GetEnable returns the enable param and if it is set
 func (p *CreateVPCOfferingParams) GetEnable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enable"].(bool)
-	return value, ok
+	return p.enable.v, p.enable.ok
 }
 
+// SetInternetprotocol sets the internetprotocol param.
 func (p *CreateVPCOfferingParams) SetInternetprotocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internetprotocol"] = v
+	p.internetprotocol = optString{v: v, ok: true}
 }
 
+// ResetInternetprotocol unsets the internetprotocol param
 func (p *CreateVPCOfferingParams) ResetInternetprotocol() {
-	if p.p != nil && p.p["internetprotocol"] != nil {
-		delete(p.p, "internetprotocol")
-	}
+	p.internetprotocol = optString{}
 }
 
+// GetInternetprotocol returns the internetprotocol param and if it is set
 func (p *CreateVPCOfferingParams) GetInternetprotocol() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["internetprotocol"].(string)
-	return value, ok
+	return p.internetprotocol.v, p.internetprotocol.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateVPCOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateVPCOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateVPCOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetServicecapabilitylist sets the servicecapabilitylist param.
 func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["servicecapabilitylist"] = v
+	p.servicecapabilitylist = optStringMap{v: v, ok: true}
 }
 
+// ResetServicecapabilitylist unsets the servicecapabilitylist param
 func (p *CreateVPCOfferingParams) ResetServicecapabilitylist() {
-	if p.p != nil && p.p["servicecapabilitylist"] != nil {
-		delete(p.p, "servicecapabilitylist")
-	}
+	p.servicecapabilitylist = optStringMap{}
 }
 
+// GetServicecapabilitylist returns the servicecapabilitylist param and if it is set
 func (p *CreateVPCOfferingParams) GetServicecapabilitylist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["servicecapabilitylist"].(map[string]string)
-	return value, ok
+	return p.servicecapabilitylist.v, p.servicecapabilitylist.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param.
 func (p *CreateVPCOfferingParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *CreateVPCOfferingParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *CreateVPCOfferingParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetServiceproviderlist sets the serviceproviderlist param.
 func (p *CreateVPCOfferingParams) SetServiceproviderlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceproviderlist"] = v
+	p.serviceproviderlist = optStringMap{v: v, ok: true}
 }
 
+// ResetServiceproviderlist unsets the serviceproviderlist param
 func (p *CreateVPCOfferingParams) ResetServiceproviderlist() {
-	if p.p != nil && p.p["serviceproviderlist"] != nil {
-		delete(p.p, "serviceproviderlist")
-	}
+	p.serviceproviderlist = optStringMap{}
 }
 
+// GetServiceproviderlist returns the serviceproviderlist param and if it is set
 func (p *CreateVPCOfferingParams) GetServiceproviderlist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceproviderlist"].(map[string]string)
-	return value, ok
+	return p.serviceproviderlist.v, p.serviceproviderlist.ok
 }
 
+// SetSupportedservices sets the supportedservices param. This param is required.
 func (p *CreateVPCOfferingParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
+	p.supportedservices = optStrings{v: v, ok: true}
 }
 
+// ResetSupportedservices unsets the supportedservices param
 func (p *CreateVPCOfferingParams) ResetSupportedservices() {
-	if p.p != nil && p.p["supportedservices"] != nil {
-		delete(p.p, "supportedservices")
-	}
+	p.supportedservices = optStrings{}
 }
 
+// GetSupportedservices returns the supportedservices param and if it is set
 func (p *CreateVPCOfferingParams) GetSupportedservices() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["supportedservices"].([]string)
-	return value, ok
+	return p.supportedservices.v, p.supportedservices.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateVPCOfferingParams) SetZoneid(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optStrings{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateVPCOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optStrings{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateVPCOfferingParams) GetZoneid() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVPCOfferingParams) Clone() *CreateVPCOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.domainid = p.domainid.clone()
+	c.servicecapabilitylist = p.servicecapabilitylist.clone()
+	c.serviceproviderlist = p.serviceproviderlist.clone()
+	c.supportedservices = p.supportedservices.clone()
+	c.zoneid = p.zoneid.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVPCOfferingParams) Equal(o *CreateVPCOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.displaytext == o.displaytext &&
+		p.domainid.equal(o.domainid) &&
+		p.enable == o.enable &&
+		p.internetprotocol == o.internetprotocol &&
+		p.name == o.name &&
+		p.servicecapabilitylist.equal(o.servicecapabilitylist) &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.serviceproviderlist.equal(o.serviceproviderlist) &&
+		p.supportedservices.equal(o.supportedservices) &&
+		p.zoneid.equal(o.zoneid)
+}
+
+// serializedCreateVPCOfferingParams is used to (un)marshal CreateVPCOfferingParams using the API param names
+type serializedCreateVPCOfferingParams struct {
+	Displaytext           *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *[]string          `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enable                *bool              `json:"enable,omitempty" yaml:"enable,omitempty"`
+	Internetprotocol      *string            `json:"internetprotocol,omitempty" yaml:"internetprotocol,omitempty"`
+	Name                  *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Servicecapabilitylist *map[string]string `json:"servicecapabilitylist,omitempty" yaml:"servicecapabilitylist,omitempty"`
+	Serviceofferingid     *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Serviceproviderlist   *map[string]string `json:"serviceproviderlist,omitempty" yaml:"serviceproviderlist,omitempty"`
+	Supportedservices     *[]string          `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Zoneid                *[]string          `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateVPCOfferingParams) toSerialized() *serializedCreateVPCOfferingParams {
+	s := &serializedCreateVPCOfferingParams{}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.enable.ok {
+		s.Enable = &p.enable.v
+	}
+	if p.internetprotocol.ok {
+		s.Internetprotocol = &p.internetprotocol.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.servicecapabilitylist.ok {
+		s.Servicecapabilitylist = &p.servicecapabilitylist.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.serviceproviderlist.ok {
+		s.Serviceproviderlist = &p.serviceproviderlist.v
 	}
-	value, ok := p.p["zoneid"].([]string)
-	return value, ok
+	if p.supportedservices.ok {
+		s.Supportedservices = &p.supportedservices.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateVPCOfferingParams) fromSerialized(s *serializedCreateVPCOfferingParams) {
+	*p = CreateVPCOfferingParams{}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Enable != nil {
+		p.SetEnable(*s.Enable)
+	}
+	if s.Internetprotocol != nil {
+		p.SetInternetprotocol(*s.Internetprotocol)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Servicecapabilitylist != nil {
+		p.SetServicecapabilitylist(*s.Servicecapabilitylist)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Serviceproviderlist != nil {
+		p.SetServiceproviderlist(*s.Serviceproviderlist)
+	}
+	if s.Supportedservices != nil {
+		p.SetSupportedservices(*s.Supportedservices)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVPCOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVPCOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVPCOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVPCOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateVPCOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams {
 	p := &CreateVPCOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["supportedservices"] = supportedservices
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetSupportedservices(supportedservices)
 	return p
 }
 
-// Creates VPC offering
-func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createVPCOffering", p.toURLValues())
+// Creates VPC offering.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: displaytext, name,
+// supportedservices.
+func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams, opts ...CallOption) (*CreateVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequest("createVPCOffering", s.cs.encodeParams("createVPCOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1359,8 +1922,8 @@ func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOf
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1423,53 +1986,133 @@ type CreateVPCOfferingResponseServiceCapability struct {
 }
 
 type DeletePrivateGatewayParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeletePrivateGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeletePrivateGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeletePrivateGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new DeletePrivateGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeletePrivateGatewayParams(u url.Values) (*DeletePrivateGatewayParams, error) {
+	p := &DeletePrivateGatewayParams{}
+	if err := checkParamNames("deletePrivateGateway", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeletePrivateGatewayParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeletePrivateGatewayParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeletePrivateGatewayParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeletePrivateGatewayParams) Clone() *DeletePrivateGatewayParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeletePrivateGatewayParams) Equal(o *DeletePrivateGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeletePrivateGatewayParams is used to (un)marshal DeletePrivateGatewayParams using the API param names
+type serializedDeletePrivateGatewayParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeletePrivateGatewayParams) toSerialized() *serializedDeletePrivateGatewayParams {
+	s := &serializedDeletePrivateGatewayParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeletePrivateGatewayParams) fromSerialized(s *serializedDeletePrivateGatewayParams) {
+	*p = DeletePrivateGatewayParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeletePrivateGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeletePrivateGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeletePrivateGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeletePrivateGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeletePrivateGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeletePrivateGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeletePrivateGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams {
 	p := &DeletePrivateGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a Private gateway
-func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deletePrivateGateway", p.toURLValues())
+// Deletes a Private gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams, opts ...CallOption) (*DeletePrivateGatewayResponse, error) {
+	resp, err := s.cs.newRequest("deletePrivateGateway", s.cs.encodeParams("deletePrivateGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1480,8 +2123,8 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1505,53 +2148,133 @@ type DeletePrivateGatewayResponse struct {
 }
 
 type DeleteStaticRouteParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteStaticRouteParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteStaticRouteParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteStaticRouteParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteStaticRouteParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteStaticRouteParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteStaticRouteParams(u url.Values) (*DeleteStaticRouteParams, error) {
+	p := &DeleteStaticRouteParams{}
+	if err := checkParamNames("deleteStaticRoute", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteStaticRouteParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteStaticRouteParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteStaticRouteParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteStaticRouteParams) Clone() *DeleteStaticRouteParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteStaticRouteParams) Equal(o *DeleteStaticRouteParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteStaticRouteParams is used to (un)marshal DeleteStaticRouteParams using the API param names
+type serializedDeleteStaticRouteParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteStaticRouteParams) toSerialized() *serializedDeleteStaticRouteParams {
+	s := &serializedDeleteStaticRouteParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteStaticRouteParams) fromSerialized(s *serializedDeleteStaticRouteParams) {
+	*p = DeleteStaticRouteParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteStaticRouteParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteStaticRouteParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteStaticRouteParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteStaticRouteParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteStaticRouteParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteStaticRouteParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteStaticRouteParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams {
 	p := &DeleteStaticRouteParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a static route
-func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("deleteStaticRoute", p.toURLValues())
+// Deletes a static route.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams, opts ...CallOption) (*DeleteStaticRouteResponse, error) {
+	resp, err := s.cs.newRequest("deleteStaticRoute", s.cs.encodeParams("deleteStaticRoute", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1562,8 +2285,8 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1587,53 +2310,133 @@ type DeleteStaticRouteResponse struct {
 }
 
 type DeleteVPCParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteVPCParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteVPCParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteVPCParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVPCParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVPCParams(u url.Values) (*DeleteVPCParams, error) {
+	p := &DeleteVPCParams{}
+	if err := checkParamNames("deleteVPC", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteVPCParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteVPCParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteVPCParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVPCParams) Clone() *DeleteVPCParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVPCParams) Equal(o *DeleteVPCParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteVPCParams is used to (un)marshal DeleteVPCParams using the API param names
+type serializedDeleteVPCParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteVPCParams) toSerialized() *serializedDeleteVPCParams {
+	s := &serializedDeleteVPCParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteVPCParams) fromSerialized(s *serializedDeleteVPCParams) {
+	*p = DeleteVPCParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVPCParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVPCParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVPCParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVPCParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVPCParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVPCParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVPCParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
 	p := &DeleteVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes a VPC
-func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPC", p.toURLValues())
+// Deletes a VPC.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPCService) DeleteVPC(p *DeleteVPCParams, opts ...CallOption) (*DeleteVPCResponse, error) {
+	resp, err := s.cs.newRequest("deleteVPC", s.cs.encodeParams("deleteVPC", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1644,8 +2447,8 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1661,61 +2464,141 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 	return &r, nil
 }
 
-type DeleteVPCResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+type DeleteVPCResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteVPCOfferingParams struct {
+	id optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVPCOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	return u
+}
+
+// ParseDeleteVPCOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVPCOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVPCOfferingParams(u url.Values) (*DeleteVPCOfferingParams, error) {
+	p := &DeleteVPCOfferingParams{}
+	if err := checkParamNames("deleteVPCOffering", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteVPCOfferingParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *DeleteVPCOfferingParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *DeleteVPCOfferingParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVPCOfferingParams) Clone() *DeleteVPCOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVPCOfferingParams) Equal(o *DeleteVPCOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
 }
 
-type DeleteVPCOfferingParams struct {
-	p map[string]interface{}
+// serializedDeleteVPCOfferingParams is used to (un)marshal DeleteVPCOfferingParams using the API param names
+type serializedDeleteVPCOfferingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+func (p *DeleteVPCOfferingParams) toSerialized() *serializedDeleteVPCOfferingParams {
+	s := &serializedDeleteVPCOfferingParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	return u
+	return s
 }
 
-func (p *DeleteVPCOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeleteVPCOfferingParams) fromSerialized(s *serializedDeleteVPCOfferingParams) {
+	*p = DeleteVPCOfferingParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	p.p["id"] = v
 }
 
-func (p *DeleteVPCOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVPCOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *DeleteVPCOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVPCOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVPCOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVPCOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVPCOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams {
 	p := &DeleteVPCOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Deletes VPC offering
-func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPCOffering", p.toURLValues())
+// Deletes VPC offering.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams, opts ...CallOption) (*DeleteVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequest("deleteVPCOffering", s.cs.encodeParams("deleteVPCOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1726,8 +2609,8 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1751,347 +2634,507 @@ type DeleteVPCOfferingResponse struct {
 }
 
 type ListPrivateGatewaysParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	id          optString
+	ipaddress   optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	state       optString
+	vlan        optString
+	vpcid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListPrivateGatewaysParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if p.vlan.ok {
+		u.Set("vlan", p.vlan.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *ListPrivateGatewaysParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListPrivateGatewaysParams parses url.Values, for example taken from a raw API request,
+// into a new ListPrivateGatewaysParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListPrivateGatewaysParams(u url.Values) (*ListPrivateGatewaysParams, error) {
+	p := &ListPrivateGatewaysParams{}
+	if err := checkParamNames("listPrivateGateways", u, "account", "domainid", "id", "ipaddress", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "state", "vlan", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	p.p["account"] = v
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["vlan"]; found {
+		p.SetVlan(u.Get("vlan"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListPrivateGatewaysParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListPrivateGatewaysParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListPrivateGatewaysParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListPrivateGatewaysParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListPrivateGatewaysParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListPrivateGatewaysParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListPrivateGatewaysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListPrivateGatewaysParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListPrivateGatewaysParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *ListPrivateGatewaysParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *ListPrivateGatewaysParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *ListPrivateGatewaysParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListPrivateGatewaysParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListPrivateGatewaysParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListPrivateGatewaysParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListPrivateGatewaysParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListPrivateGatewaysParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListPrivateGatewaysParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListPrivateGatewaysParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListPrivateGatewaysParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListPrivateGatewaysParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListPrivateGatewaysParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListPrivateGatewaysParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListPrivateGatewaysParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListPrivateGatewaysParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListPrivateGatewaysParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListPrivateGatewaysParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListPrivateGatewaysParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListPrivateGatewaysParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListPrivateGatewaysParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetState sets the state param.
 func (p *ListPrivateGatewaysParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListPrivateGatewaysParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListPrivateGatewaysParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetVlan sets the vlan param.
 func (p *ListPrivateGatewaysParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
+	p.vlan = optString{v: v, ok: true}
 }
 
+// ResetVlan unsets the vlan param
 func (p *ListPrivateGatewaysParams) ResetVlan() {
-	if p.p != nil && p.p["vlan"] != nil {
-		delete(p.p, "vlan")
-	}
+	p.vlan = optString{}
 }
 
+// GetVlan returns the vlan param and if it is set
 func (p *ListPrivateGatewaysParams) GetVlan() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vlan"].(string)
-	return value, ok
+	return p.vlan.v, p.vlan.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListPrivateGatewaysParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListPrivateGatewaysParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListPrivateGatewaysParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListPrivateGatewaysParams) Clone() *ListPrivateGatewaysParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListPrivateGatewaysParams) Equal(o *ListPrivateGatewaysParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.ipaddress == o.ipaddress &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.state == o.state &&
+		p.vlan == o.vlan &&
+		p.vpcid == o.vpcid
+}
+
+// serializedListPrivateGatewaysParams is used to (un)marshal ListPrivateGatewaysParams using the API param names
+type serializedListPrivateGatewaysParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddress   *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Vlan        *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *ListPrivateGatewaysParams) toSerialized() *serializedListPrivateGatewaysParams {
+	s := &serializedListPrivateGatewaysParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.vlan.ok {
+		s.Vlan = &p.vlan.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	return s
+}
+
+func (p *ListPrivateGatewaysParams) fromSerialized(s *serializedListPrivateGatewaysParams) {
+	*p = ListPrivateGatewaysParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Vlan != nil {
+		p.SetVlan(*s.Vlan)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListPrivateGatewaysParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListPrivateGatewaysParams) UnmarshalJSON(b []byte) error {
+	var s serializedListPrivateGatewaysParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListPrivateGatewaysParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListPrivateGatewaysParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListPrivateGatewaysParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListPrivateGatewaysParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
 	p := &ListPrivateGatewaysParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
 	p := &ListPrivateGatewaysParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2119,9 +3162,9 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 	return nil, l.Count, fmt.Errorf("There is more then one result for PrivateGateway UUID: %s!", id)
 }
 
-// List private gateways
-func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listPrivateGateways", p.toURLValues())
+// List private gateways.
+func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams, opts ...CallOption) (*ListPrivateGatewaysResponse, error) {
+	resp, err := s.cs.newRequest("listPrivateGateways", s.cs.encodeParams("listPrivateGateways", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2167,351 +3210,514 @@ type PrivateGateway struct {
 }
 
 type ListStaticRoutesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListStaticRoutesParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	gatewayid   optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	state       optString
+	tags        optStringMap
+	vpcid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListStaticRoutesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["gatewayid"]; found {
-		u.Set("gatewayid", v.(string))
+	if p.gatewayid.ok {
+		u.Set("gatewayid", p.gatewayid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *ListStaticRoutesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListStaticRoutesParams parses url.Values, for example taken from a raw API request,
+// into a new ListStaticRoutesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListStaticRoutesParams(u url.Values) (*ListStaticRoutesParams, error) {
+	p := &ListStaticRoutesParams{}
+	if err := checkParamNames("listStaticRoutes", u, "account", "domainid", "gatewayid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "state", "tags[]", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["gatewayid"]; found {
+		p.SetGatewayid(u.Get("gatewayid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
 	}
-	p.p["account"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListStaticRoutesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListStaticRoutesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListStaticRoutesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListStaticRoutesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListStaticRoutesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListStaticRoutesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetGatewayid sets the gatewayid param.
 func (p *ListStaticRoutesParams) SetGatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gatewayid"] = v
+	p.gatewayid = optString{v: v, ok: true}
 }
 
+// ResetGatewayid unsets the gatewayid param
 func (p *ListStaticRoutesParams) ResetGatewayid() {
-	if p.p != nil && p.p["gatewayid"] != nil {
-		delete(p.p, "gatewayid")
-	}
+	p.gatewayid = optString{}
 }
 
+// GetGatewayid returns the gatewayid param and if it is set
 func (p *ListStaticRoutesParams) GetGatewayid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gatewayid"].(string)
-	return value, ok
+	return p.gatewayid.v, p.gatewayid.ok
 }
 
+// SetId sets the id param.
 func (p *ListStaticRoutesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListStaticRoutesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListStaticRoutesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListStaticRoutesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListStaticRoutesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListStaticRoutesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListStaticRoutesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListStaticRoutesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListStaticRoutesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListStaticRoutesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListStaticRoutesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListStaticRoutesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListStaticRoutesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListStaticRoutesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListStaticRoutesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListStaticRoutesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListStaticRoutesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListStaticRoutesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListStaticRoutesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListStaticRoutesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListStaticRoutesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetState sets the state param.
 func (p *ListStaticRoutesParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListStaticRoutesParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListStaticRoutesParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListStaticRoutesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListStaticRoutesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListStaticRoutesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListStaticRoutesParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListStaticRoutesParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListStaticRoutesParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListStaticRoutesParams) Clone() *ListStaticRoutesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListStaticRoutesParams) Equal(o *ListStaticRoutesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.gatewayid == o.gatewayid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.state == o.state &&
+		p.tags.equal(o.tags) &&
+		p.vpcid == o.vpcid
+}
+
+// serializedListStaticRoutesParams is used to (un)marshal ListStaticRoutesParams using the API param names
+type serializedListStaticRoutesParams struct {
+	Account     *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Gatewayid   *string            `json:"gatewayid,omitempty" yaml:"gatewayid,omitempty"`
+	Id          *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vpcid       *string            `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *ListStaticRoutesParams) toSerialized() *serializedListStaticRoutesParams {
+	s := &serializedListStaticRoutesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.gatewayid.ok {
+		s.Gatewayid = &p.gatewayid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	return s
+}
+
+func (p *ListStaticRoutesParams) fromSerialized(s *serializedListStaticRoutesParams) {
+	*p = ListStaticRoutesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Gatewayid != nil {
+		p.SetGatewayid(*s.Gatewayid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListStaticRoutesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListStaticRoutesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListStaticRoutesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListStaticRoutesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListStaticRoutesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListStaticRoutesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListStaticRoutesParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
 	p := &ListStaticRoutesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error) {
 	p := &ListStaticRoutesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2539,9 +3745,9 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 	return nil, l.Count, fmt.Errorf("There is more then one result for StaticRoute UUID: %s!", id)
 }
 
-// Lists all static routes
-func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
-	resp, err := s.cs.newRequest("listStaticRoutes", p.toURLValues())
+// Lists all static routes.
+func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams, opts ...CallOption) (*ListStaticRoutesResponse, error) {
+	resp, err := s.cs.newRequest("listStaticRoutes", s.cs.encodeParams("listStaticRoutes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2576,299 +3782,444 @@ type StaticRoute struct {
 }
 
 type ListVPCOfferingsParams struct {
-	p map[string]interface{}
+	displaytext       optString
+	domainid          optString
+	id                optString
+	isdefault         optBool
+	keyword           optString
+	name              optString
+	page              optInt
+	pagesize          optInt
+	state             optString
+	supportedservices optStrings
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVPCOfferingsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.isdefault.ok {
+		u.Set("isdefault", strconv.FormatBool(p.isdefault.v))
+	}
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
+	}
+	if p.name.ok {
+		u.Set("name", p.name.v)
+	}
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
+	}
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
+	}
+	if p.state.ok {
+		u.Set("state", p.state.v)
+	}
+	if p.supportedservices.ok {
+		u.Set("supportedservices", strings.Join(p.supportedservices.v, ","))
+	}
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
+	}
+	return u
 }
 
-func (p *ListVPCOfferingsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// ParseListVPCOfferingsParams parses url.Values, for example taken from a raw API request,
+// into a new ListVPCOfferingsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVPCOfferingsParams(u url.Values) (*ListVPCOfferingsParams, error) {
+	p := &ListVPCOfferingsParams{}
+	if err := checkParamNames("listVPCOfferings", u, "displaytext", "domainid", "id", "isdefault", "keyword", "name", "page", "pagesize", "state", "supportedservices", "zoneid"); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	if v, found := p.p["isdefault"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdefault", vv)
+	if _, found := u["isdefault"]; found {
+		v, err := strconv.ParseBool(u.Get("isdefault"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdefault: %v", err)
+		}
+		p.SetIsdefault(v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("supportedservices", vv)
+	if _, found := u["supportedservices"]; found {
+		p.SetSupportedservices(strings.Split(u.Get("supportedservices"), ","))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	return u
+	return p, nil
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *ListVPCOfferingsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *ListVPCOfferingsParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *ListVPCOfferingsParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVPCOfferingsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVPCOfferingsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVPCOfferingsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListVPCOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVPCOfferingsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVPCOfferingsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsdefault sets the isdefault param.
 func (p *ListVPCOfferingsParams) SetIsdefault(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdefault"] = v
+	p.isdefault = optBool{v: v, ok: true}
 }
 
+// ResetIsdefault unsets the isdefault param
 func (p *ListVPCOfferingsParams) ResetIsdefault() {
-	if p.p != nil && p.p["isdefault"] != nil {
-		delete(p.p, "isdefault")
-	}
+	p.isdefault = optBool{}
 }
 
+// GetIsdefault returns the isdefault param and if it is set
 func (p *ListVPCOfferingsParams) GetIsdefault() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdefault"].(bool)
-	return value, ok
+	return p.isdefault.v, p.isdefault.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVPCOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVPCOfferingsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVPCOfferingsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListVPCOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVPCOfferingsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVPCOfferingsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVPCOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVPCOfferingsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVPCOfferingsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVPCOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVPCOfferingsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVPCOfferingsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetState sets the state param.
 func (p *ListVPCOfferingsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListVPCOfferingsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListVPCOfferingsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetSupportedservices sets the supportedservices param.
 func (p *ListVPCOfferingsParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
+	p.supportedservices = optStrings{v: v, ok: true}
 }
 
+// ResetSupportedservices unsets the supportedservices param
 func (p *ListVPCOfferingsParams) ResetSupportedservices() {
-	if p.p != nil && p.p["supportedservices"] != nil {
-		delete(p.p, "supportedservices")
-	}
+	p.supportedservices = optStrings{}
 }
 
+// GetSupportedservices returns the supportedservices param and if it is set
 func (p *ListVPCOfferingsParams) GetSupportedservices() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["supportedservices"].([]string)
-	return value, ok
+	return p.supportedservices.v, p.supportedservices.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListVPCOfferingsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListVPCOfferingsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListVPCOfferingsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVPCOfferingsParams) Clone() *ListVPCOfferingsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.supportedservices = p.supportedservices.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVPCOfferingsParams) Equal(o *ListVPCOfferingsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isdefault == o.isdefault &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.state == o.state &&
+		p.supportedservices.equal(o.supportedservices) &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListVPCOfferingsParams is used to (un)marshal ListVPCOfferingsParams using the API param names
+type serializedListVPCOfferingsParams struct {
+	Displaytext       *string   `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid          *string   `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id                *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdefault         *bool     `json:"isdefault,omitempty" yaml:"isdefault,omitempty"`
+	Keyword           *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name              *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	State             *string   `json:"state,omitempty" yaml:"state,omitempty"`
+	Supportedservices *[]string `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Zoneid            *string   `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListVPCOfferingsParams) toSerialized() *serializedListVPCOfferingsParams {
+	s := &serializedListVPCOfferingsParams{}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isdefault.ok {
+		s.Isdefault = &p.isdefault.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.supportedservices.ok {
+		s.Supportedservices = &p.supportedservices.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListVPCOfferingsParams) fromSerialized(s *serializedListVPCOfferingsParams) {
+	*p = ListVPCOfferingsParams{}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isdefault != nil {
+		p.SetIsdefault(*s.Isdefault)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Supportedservices != nil {
+		p.SetSupportedservices(*s.Supportedservices)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVPCOfferingsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVPCOfferingsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVPCOfferingsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVPCOfferingsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVPCOfferingsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVPCOfferingsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVPCOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
 	p := &ListVPCOfferingsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2916,9 +4267,8 @@ func (s *VPCService) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPC
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error) {
 	p := &ListVPCOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2946,9 +4296,9 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 	return nil, l.Count, fmt.Errorf("There is more then one result for VPCOffering UUID: %s!", id)
 }
 
-// Lists VPC offerings
-func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCOfferings", p.toURLValues())
+// Lists VPC offerings.
+func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams, opts ...CallOption) (*ListVPCOfferingsResponse, error) {
+	resp, err := s.cs.newRequest("listVPCOfferings", s.cs.encodeParams("listVPCOfferings", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3008,523 +4358,737 @@ type VPCOfferingServiceCapability struct {
 }
 
 type ListVPCsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVPCsParams) toURLValues() url.Values {
+	account           optString
+	cidr              optString
+	displaytext       optString
+	domainid          optString
+	fordisplay        optBool
+	id                optString
+	isrecursive       optBool
+	keyword           optString
+	listall           optBool
+	name              optString
+	page              optInt
+	pagesize          optInt
+	projectid         optString
+	restartrequired   optBool
+	showicon          optBool
+	state             optString
+	supportedservices optStrings
+	tags              optStringMap
+	vpcofferingid     optString
+	zoneid            optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVPCsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["cidr"]; found {
-		u.Set("cidr", v.(string))
+	if p.cidr.ok {
+		u.Set("cidr", p.cidr.v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["restartrequired"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("restartrequired", vv)
+	if p.restartrequired.ok {
+		u.Set("restartrequired", strconv.FormatBool(p.restartrequired.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("supportedservices", vv)
+	if p.supportedservices.ok {
+		u.Set("supportedservices", strings.Join(p.supportedservices.v, ","))
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["vpcofferingid"]; found {
-		u.Set("vpcofferingid", v.(string))
+	if p.vpcofferingid.ok {
+		u.Set("vpcofferingid", p.vpcofferingid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListVPCsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVPCsParams parses url.Values, for example taken from a raw API request,
+// into a new ListVPCsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVPCsParams(u url.Values) (*ListVPCsParams, error) {
+	p := &ListVPCsParams{}
+	if err := checkParamNames("listVPCs", u, "account", "cidr", "displaytext", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "name", "page", "pagesize", "projectid", "restartrequired", "showicon", "state", "supportedservices", "tags[]", "vpcofferingid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["cidr"]; found {
+		p.SetCidr(u.Get("cidr"))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["restartrequired"]; found {
+		v, err := strconv.ParseBool(u.Get("restartrequired"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param restartrequired: %v", err)
+		}
+		p.SetRestartrequired(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["supportedservices"]; found {
+		p.SetSupportedservices(strings.Split(u.Get("supportedservices"), ","))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["vpcofferingid"]; found {
+		p.SetVpcofferingid(u.Get("vpcofferingid"))
 	}
-	p.p["account"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVPCsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListVPCsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVPCsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCidr sets the cidr param.
 func (p *ListVPCsParams) SetCidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidr"] = v
+	p.cidr = optString{v: v, ok: true}
 }
 
+// ResetCidr unsets the cidr param
 func (p *ListVPCsParams) ResetCidr() {
-	if p.p != nil && p.p["cidr"] != nil {
-		delete(p.p, "cidr")
-	}
+	p.cidr = optString{}
 }
 
+// GetCidr returns the cidr param and if it is set
 func (p *ListVPCsParams) GetCidr() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidr"].(string)
-	return value, ok
+	return p.cidr.v, p.cidr.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *ListVPCsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *ListVPCsParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *ListVPCsParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVPCsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVPCsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVPCsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListVPCsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListVPCsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListVPCsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListVPCsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVPCsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVPCsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVPCsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVPCsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVPCsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVPCsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVPCsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVPCsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVPCsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVPCsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVPCsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListVPCsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVPCsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVPCsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVPCsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVPCsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVPCsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVPCsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVPCsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVPCsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVPCsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVPCsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVPCsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetRestartrequired sets the restartrequired param.
+func (p *ListVPCsParams) SetRestartrequired(v bool) {
+	p.restartrequired = optBool{v: v, ok: true}
+}
+
+// ResetRestartrequired unsets the restartrequired param
+func (p *ListVPCsParams) ResetRestartrequired() {
+	p.restartrequired = optBool{}
+}
+
+// GetRestartrequired returns the restartrequired param and if it is set
+func (p *ListVPCsParams) GetRestartrequired() (bool, bool) {
+	return p.restartrequired.v, p.restartrequired.ok
+}
+
+// SetShowicon sets the showicon param.
+func (p *ListVPCsParams) SetShowicon(v bool) {
+	p.showicon = optBool{v: v, ok: true}
+}
+
+// ResetShowicon unsets the showicon param
+func (p *ListVPCsParams) ResetShowicon() {
+	p.showicon = optBool{}
+}
+
+// GetShowicon returns the showicon param and if it is set
+func (p *ListVPCsParams) GetShowicon() (bool, bool) {
+	return p.showicon.v, p.showicon.ok
+}
+
+// SetState sets the state param.
+func (p *ListVPCsParams) SetState(v string) {
+	p.state = optString{v: v, ok: true}
+}
+
+// ResetState unsets the state param
+func (p *ListVPCsParams) ResetState() {
+	p.state = optString{}
+}
+
+// GetState returns the state param and if it is set
+func (p *ListVPCsParams) GetState() (string, bool) {
+	return p.state.v, p.state.ok
+}
+
+// SetSupportedservices sets the supportedservices param.
+func (p *ListVPCsParams) SetSupportedservices(v []string) {
+	p.supportedservices = optStrings{v: v, ok: true}
+}
+
+// ResetSupportedservices unsets the supportedservices param
+func (p *ListVPCsParams) ResetSupportedservices() {
+	p.supportedservices = optStrings{}
+}
+
+// GetSupportedservices returns the supportedservices param and if it is set
+func (p *ListVPCsParams) GetSupportedservices() ([]string, bool) {
+	return p.supportedservices.v, p.supportedservices.ok
+}
+
+// SetTags sets the tags param.
+func (p *ListVPCsParams) SetTags(v map[string]string) {
+	p.tags = optStringMap{v: v, ok: true}
+}
+
+// ResetTags unsets the tags param
+func (p *ListVPCsParams) ResetTags() {
+	p.tags = optStringMap{}
+}
+
+// GetTags returns the tags param and if it is set
+func (p *ListVPCsParams) GetTags() (map[string]string, bool) {
+	return p.tags.v, p.tags.ok
+}
+
+// SetVpcofferingid sets the vpcofferingid param.
+func (p *ListVPCsParams) SetVpcofferingid(v string) {
+	p.vpcofferingid = optString{v: v, ok: true}
+}
+
+// ResetVpcofferingid unsets the vpcofferingid param
+func (p *ListVPCsParams) ResetVpcofferingid() {
+	p.vpcofferingid = optString{}
+}
+
+// GetVpcofferingid returns the vpcofferingid param and if it is set
+func (p *ListVPCsParams) GetVpcofferingid() (string, bool) {
+	return p.vpcofferingid.v, p.vpcofferingid.ok
+}
+
+// SetZoneid sets the zoneid param.
+func (p *ListVPCsParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *ListVPCsParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *ListVPCsParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVPCsParams) Clone() *ListVPCsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.supportedservices = p.supportedservices.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVPCsParams) Equal(o *ListVPCsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.cidr == o.cidr &&
+		p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.restartrequired == o.restartrequired &&
+		p.showicon == o.showicon &&
+		p.state == o.state &&
+		p.supportedservices.equal(o.supportedservices) &&
+		p.tags.equal(o.tags) &&
+		p.vpcofferingid == o.vpcofferingid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListVPCsParams is used to (un)marshal ListVPCsParams using the API param names
+type serializedListVPCsParams struct {
+	Account           *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidr              *string            `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+	Displaytext       *string            `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid          *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay        *bool              `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive       *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name              *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid         *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Restartrequired   *bool              `json:"restartrequired,omitempty" yaml:"restartrequired,omitempty"`
+	Showicon          *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	State             *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Supportedservices *[]string          `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags              *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vpcofferingid     *string            `json:"vpcofferingid,omitempty" yaml:"vpcofferingid,omitempty"`
+	Zoneid            *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListVPCsParams) toSerialized() *serializedListVPCsParams {
+	s := &serializedListVPCsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.cidr.ok {
+		s.Cidr = &p.cidr.v
+	}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.restartrequired.ok {
+		s.Restartrequired = &p.restartrequired.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.supportedservices.ok {
+		s.Supportedservices = &p.supportedservices.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.vpcofferingid.ok {
+		s.Vpcofferingid = &p.vpcofferingid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return s
 }
 
-func (p *ListVPCsParams) SetRestartrequired(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) fromSerialized(s *serializedListVPCsParams) {
+	*p = ListVPCsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
 	}
-	p.p["restartrequired"] = v
-}
-
-func (p *ListVPCsParams) ResetRestartrequired() {
-	if p.p != nil && p.p["restartrequired"] != nil {
-		delete(p.p, "restartrequired")
+	if s.Cidr != nil {
+		p.SetCidr(*s.Cidr)
 	}
-}
-
-func (p *ListVPCsParams) GetRestartrequired() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
 	}
-	value, ok := p.p["restartrequired"].(bool)
-	return value, ok
-}
-
-func (p *ListVPCsParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
 	}
-	p.p["showicon"] = v
-}
-
-func (p *ListVPCsParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
 	}
-}
-
-func (p *ListVPCsParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
-}
-
-func (p *ListVPCsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
 	}
-	p.p["state"] = v
-}
-
-func (p *ListVPCsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
 	}
-}
-
-func (p *ListVPCsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
 	}
-	value, ok := p.p["state"].(string)
-	return value, ok
-}
-
-func (p *ListVPCsParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Name != nil {
+		p.SetName(*s.Name)
 	}
-	p.p["supportedservices"] = v
-}
-
-func (p *ListVPCsParams) ResetSupportedservices() {
-	if p.p != nil && p.p["supportedservices"] != nil {
-		delete(p.p, "supportedservices")
+	if s.Page != nil {
+		p.SetPage(*s.Page)
 	}
-}
-
-func (p *ListVPCsParams) GetSupportedservices() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
 	}
-	value, ok := p.p["supportedservices"].([]string)
-	return value, ok
-}
-
-func (p *ListVPCsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
 	}
-	p.p["tags"] = v
-}
-
-func (p *ListVPCsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
+	if s.Restartrequired != nil {
+		p.SetRestartrequired(*s.Restartrequired)
 	}
-}
-
-func (p *ListVPCsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
-}
-
-func (p *ListVPCsParams) SetVpcofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.State != nil {
+		p.SetState(*s.State)
 	}
-	p.p["vpcofferingid"] = v
-}
-
-func (p *ListVPCsParams) ResetVpcofferingid() {
-	if p.p != nil && p.p["vpcofferingid"] != nil {
-		delete(p.p, "vpcofferingid")
+	if s.Supportedservices != nil {
+		p.SetSupportedservices(*s.Supportedservices)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Vpcofferingid != nil {
+		p.SetVpcofferingid(*s.Vpcofferingid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
 }
 
-func (p *ListVPCsParams) GetVpcofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcofferingid"].(string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVPCsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListVPCsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVPCsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVPCsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["zoneid"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListVPCsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVPCsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListVPCsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVPCsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVPCsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVPCsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListVPCsParams() *ListVPCsParams {
 	p := &ListVPCsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3572,9 +5136,8 @@ func (s *VPCService) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, e
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error) {
 	p := &ListVPCsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3602,9 +5165,9 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 	return nil, l.Count, fmt.Errorf("There is more then one result for VPC UUID: %s!", id)
 }
 
-// Lists VPCs
-func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCs", p.toURLValues())
+// Lists VPCs.
+func (s *VPCService) ListVPCs(p *ListVPCsParams, opts ...CallOption) (*ListVPCsResponse, error) {
+	resp, err := s.cs.newRequest("listVPCs", s.cs.encodeParams("listVPCs", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3682,128 +5245,235 @@ type VPCServiceInternalCapability struct {
 }
 
 type RestartVPCParams struct {
-	p map[string]interface{}
+	cleanup       optBool
+	id            optString
+	livepatch     optBool
+	makeredundant optBool
 }
 
-func (p *RestartVPCParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RestartVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanup", vv)
+	if p.cleanup.ok {
+		u.Set("cleanup", strconv.FormatBool(p.cleanup.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["livepatch"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("livepatch", vv)
+	if p.livepatch.ok {
+		u.Set("livepatch", strconv.FormatBool(p.livepatch.v))
 	}
-	if v, found := p.p["makeredundant"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("makeredundant", vv)
+	if p.makeredundant.ok {
+		u.Set("makeredundant", strconv.FormatBool(p.makeredundant.v))
 	}
 	return u
 }
 
-func (p *RestartVPCParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRestartVPCParams parses url.Values, for example taken from a raw API request,
+// into a new RestartVPCParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRestartVPCParams(u url.Values) (*RestartVPCParams, error) {
+	p := &RestartVPCParams{}
+	if err := checkParamNames("restartVPC", u, "cleanup", "id", "livepatch", "makeredundant"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cleanup"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanup: %v", err)
+		}
+		p.SetCleanup(v)
 	}
-	p.p["cleanup"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["livepatch"]; found {
+		v, err := strconv.ParseBool(u.Get("livepatch"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param livepatch: %v", err)
+		}
+		p.SetLivepatch(v)
+	}
+	if _, found := u["makeredundant"]; found {
+		v, err := strconv.ParseBool(u.Get("makeredundant"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param makeredundant: %v", err)
+		}
+		p.SetMakeredundant(v)
+	}
+	return p, nil
+}
+
+// SetCleanup sets the cleanup param.
+func (p *RestartVPCParams) SetCleanup(v bool) {
+	p.cleanup = optBool{v: v, ok: true}
 }
 
+// ResetCleanup unsets the cleanup param
 func (p *RestartVPCParams) ResetCleanup() {
-	if p.p != nil && p.p["cleanup"] != nil {
-		delete(p.p, "cleanup")
-	}
+	p.cleanup = optBool{}
 }
 
+// GetCleanup returns the cleanup param and if it is set
 func (p *RestartVPCParams) GetCleanup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanup"].(bool)
-	return value, ok
+	return p.cleanup.v, p.cleanup.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *RestartVPCParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RestartVPCParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RestartVPCParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetLivepatch sets the livepatch param.
 func (p *RestartVPCParams) SetLivepatch(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["livepatch"] = v
+	p.livepatch = optBool{v: v, ok: true}
 }
 
+// ResetLivepatch unsets the livepatch param
 func (p *RestartVPCParams) ResetLivepatch() {
-	if p.p != nil && p.p["livepatch"] != nil {
-		delete(p.p, "livepatch")
-	}
+	p.livepatch = optBool{}
 }
 
+// GetLivepatch returns the livepatch param and if it is set
 func (p *RestartVPCParams) GetLivepatch() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["livepatch"].(bool)
-	return value, ok
+	return p.livepatch.v, p.livepatch.ok
 }
 
+// SetMakeredundant sets the makeredundant param.
 func (p *RestartVPCParams) SetMakeredundant(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["makeredundant"] = v
+	p.makeredundant = optBool{v: v, ok: true}
 }
 
+// ResetMakeredundant unsets the makeredundant param
 func (p *RestartVPCParams) ResetMakeredundant() {
-	if p.p != nil && p.p["makeredundant"] != nil {
-		delete(p.p, "makeredundant")
-	}
+	p.makeredundant = optBool{}
 }
 
+// GetMakeredundant returns the makeredundant param and if it is set
 func (p *RestartVPCParams) GetMakeredundant() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.makeredundant.v, p.makeredundant.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RestartVPCParams) Clone() *RestartVPCParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RestartVPCParams) Equal(o *RestartVPCParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cleanup == o.cleanup &&
+		p.id == o.id &&
+		p.livepatch == o.livepatch &&
+		p.makeredundant == o.makeredundant
+}
+
+// serializedRestartVPCParams is used to (un)marshal RestartVPCParams using the API param names
+type serializedRestartVPCParams struct {
+	Cleanup       *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Livepatch     *bool   `json:"livepatch,omitempty" yaml:"livepatch,omitempty"`
+	Makeredundant *bool   `json:"makeredundant,omitempty" yaml:"makeredundant,omitempty"`
+}
+
+func (p *RestartVPCParams) toSerialized() *serializedRestartVPCParams {
+	s := &serializedRestartVPCParams{}
+	if p.cleanup.ok {
+		s.Cleanup = &p.cleanup.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.livepatch.ok {
+		s.Livepatch = &p.livepatch.v
 	}
-	value, ok := p.p["makeredundant"].(bool)
-	return value, ok
+	if p.makeredundant.ok {
+		s.Makeredundant = &p.makeredundant.v
+	}
+	return s
+}
+
+func (p *RestartVPCParams) fromSerialized(s *serializedRestartVPCParams) {
+	*p = RestartVPCParams{}
+	if s.Cleanup != nil {
+		p.SetCleanup(*s.Cleanup)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Livepatch != nil {
+		p.SetLivepatch(*s.Livepatch)
+	}
+	if s.Makeredundant != nil {
+		p.SetMakeredundant(*s.Makeredundant)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RestartVPCParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RestartVPCParams) UnmarshalJSON(b []byte) error {
+	var s serializedRestartVPCParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RestartVPCParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RestartVPCParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRestartVPCParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RestartVPCParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
 	p := &RestartVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Restarts a VPC
-func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
-	resp, err := s.cs.newRequest("restartVPC", p.toURLValues())
+// Restarts a VPC.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPCService) RestartVPC(p *RestartVPCParams, opts ...CallOption) (*RestartVPCResponse, error) {
+	resp, err := s.cs.newRequest("restartVPC", s.cs.encodeParams("restartVPC", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3814,8 +5484,8 @@ func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3839,199 +5509,321 @@ type RestartVPCResponse struct {
 }
 
 type UpdateVPCParams struct {
-	p map[string]interface{}
+	customid           optString
+	displaytext        optString
+	fordisplay         optBool
+	id                 optString
+	name               optString
+	publicmtu          optInt
+	sourcenatipaddress optString
 }
 
-func (p *UpdateVPCParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["publicmtu"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicmtu", vv)
+	if p.publicmtu.ok {
+		u.Set("publicmtu", strconv.Itoa(p.publicmtu.v))
 	}
-	if v, found := p.p["sourcenatipaddress"]; found {
-		u.Set("sourcenatipaddress", v.(string))
+	if p.sourcenatipaddress.ok {
+		u.Set("sourcenatipaddress", p.sourcenatipaddress.v)
 	}
 	return u
 }
 
-func (p *UpdateVPCParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVPCParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVPCParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVPCParams(u url.Values) (*UpdateVPCParams, error) {
+	p := &UpdateVPCParams{}
+	if err := checkParamNames("updateVPC", u, "customid", "displaytext", "fordisplay", "id", "name", "publicmtu", "sourcenatipaddress"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["publicmtu"]; found {
+		v, err := strconv.Atoi(u.Get("publicmtu"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param publicmtu: %v", err)
+		}
+		p.SetPublicmtu(v)
+	}
+	if _, found := u["sourcenatipaddress"]; found {
+		p.SetSourcenatipaddress(u.Get("sourcenatipaddress"))
 	}
-	p.p["customid"] = v
+	return p, nil
 }
 
+// SetCustomid sets the customid param.
+func (p *UpdateVPCParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
+}
+
+// ResetCustomid unsets the customid param
 func (p *UpdateVPCParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateVPCParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDisplaytext sets the displaytext param.
 func (p *UpdateVPCParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateVPCParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateVPCParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateVPCParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateVPCParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateVPCParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVPCParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVPCParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVPCParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateVPCParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateVPCParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateVPCParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPublicmtu sets the publicmtu param.
 func (p *UpdateVPCParams) SetPublicmtu(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicmtu"] = v
+	p.publicmtu = optInt{v: v, ok: true}
 }
 
+// ResetPublicmtu unsets the publicmtu param
 func (p *UpdateVPCParams) ResetPublicmtu() {
-	if p.p != nil && p.p["publicmtu"] != nil {
-		delete(p.p, "publicmtu")
-	}
+	p.publicmtu = optInt{}
 }
 
+// GetPublicmtu returns the publicmtu param and if it is set
 func (p *UpdateVPCParams) GetPublicmtu() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["publicmtu"].(int)
-	return value, ok
+	return p.publicmtu.v, p.publicmtu.ok
 }
 
+// SetSourcenatipaddress sets the sourcenatipaddress param.
 func (p *UpdateVPCParams) SetSourcenatipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcenatipaddress"] = v
+	p.sourcenatipaddress = optString{v: v, ok: true}
 }
 
+// ResetSourcenatipaddress unsets the sourcenatipaddress param
 func (p *UpdateVPCParams) ResetSourcenatipaddress() {
-	if p.p != nil && p.p["sourcenatipaddress"] != nil {
-		delete(p.p, "sourcenatipaddress")
-	}
+	p.sourcenatipaddress = optString{}
 }
 
+// GetSourcenatipaddress returns the sourcenatipaddress param and if it is set
 func (p *UpdateVPCParams) GetSourcenatipaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.sourcenatipaddress.v, p.sourcenatipaddress.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVPCParams) Clone() *UpdateVPCParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVPCParams) Equal(o *UpdateVPCParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.displaytext == o.displaytext &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.publicmtu == o.publicmtu &&
+		p.sourcenatipaddress == o.sourcenatipaddress
+}
+
+// serializedUpdateVPCParams is used to (un)marshal UpdateVPCParams using the API param names
+type serializedUpdateVPCParams struct {
+	Customid           *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Displaytext        *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Fordisplay         *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                 *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name               *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Publicmtu          *int    `json:"publicmtu,omitempty" yaml:"publicmtu,omitempty"`
+	Sourcenatipaddress *string `json:"sourcenatipaddress,omitempty" yaml:"sourcenatipaddress,omitempty"`
+}
+
+func (p *UpdateVPCParams) toSerialized() *serializedUpdateVPCParams {
+	s := &serializedUpdateVPCParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
 	}
-	value, ok := p.p["sourcenatipaddress"].(string)
-	return value, ok
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.publicmtu.ok {
+		s.Publicmtu = &p.publicmtu.v
+	}
+	if p.sourcenatipaddress.ok {
+		s.Sourcenatipaddress = &p.sourcenatipaddress.v
+	}
+	return s
+}
+
+func (p *UpdateVPCParams) fromSerialized(s *serializedUpdateVPCParams) {
+	*p = UpdateVPCParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Publicmtu != nil {
+		p.SetPublicmtu(*s.Publicmtu)
+	}
+	if s.Sourcenatipaddress != nil {
+		p.SetSourcenatipaddress(*s.Sourcenatipaddress)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVPCParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVPCParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVPCParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVPCParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVPCParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVPCParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVPCParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
 	p := &UpdateVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates a VPC
-func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
-	resp, err := s.cs.newRequest("updateVPC", p.toURLValues())
+// Updates a VPC.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPCService) UpdateVPC(p *UpdateVPCParams, opts ...CallOption) (*UpdateVPCResponse, error) {
+	resp, err := s.cs.newRequest("updateVPC", s.cs.encodeParams("updateVPC", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4042,8 +5834,8 @@ func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4124,198 +5916,317 @@ type UpdateVPCResponseServiceCapability struct {
 }
 
 type UpdateVPCOfferingParams struct {
-	p map[string]interface{}
+	displaytext optString
+	domainid    optString
+	id          optString
+	name        optString
+	sortkey     optInt
+	state       optString
+	zoneid      optString
 }
 
-func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVPCOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if p.displaytext.ok {
+		u.Set("displaytext", p.displaytext.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if p.sortkey.ok {
+		u.Set("sortkey", strconv.Itoa(p.sortkey.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVPCOfferingParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVPCOfferingParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVPCOfferingParams(u url.Values) (*UpdateVPCOfferingParams, error) {
+	p := &UpdateVPCOfferingParams{}
+	if err := checkParamNames("updateVPCOffering", u, "displaytext", "domainid", "id", "name", "sortkey", "state", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["displaytext"]; found {
+		p.SetDisplaytext(u.Get("displaytext"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["displaytext"] = v
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["sortkey"]; found {
+		v, err := strconv.Atoi(u.Get("sortkey"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sortkey: %v", err)
+		}
+		p.SetSortkey(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetDisplaytext sets the displaytext param.
+func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
+	p.displaytext = optString{v: v, ok: true}
 }
 
+// ResetDisplaytext unsets the displaytext param
 func (p *UpdateVPCOfferingParams) ResetDisplaytext() {
-	if p.p != nil && p.p["displaytext"] != nil {
-		delete(p.p, "displaytext")
-	}
+	p.displaytext = optString{}
 }
 
+// GetDisplaytext returns the displaytext param and if it is set
 func (p *UpdateVPCOfferingParams) GetDisplaytext() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displaytext"].(string)
-	return value, ok
+	return p.displaytext.v, p.displaytext.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateVPCOfferingParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateVPCOfferingParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateVPCOfferingParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVPCOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVPCOfferingParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVPCOfferingParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateVPCOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateVPCOfferingParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateVPCOfferingParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetSortkey sets the sortkey param.
 func (p *UpdateVPCOfferingParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
+	p.sortkey = optInt{v: v, ok: true}
 }
 
+// ResetSortkey unsets the sortkey param
 func (p *UpdateVPCOfferingParams) ResetSortkey() {
-	if p.p != nil && p.p["sortkey"] != nil {
-		delete(p.p, "sortkey")
-	}
+	p.sortkey = optInt{}
 }
 
+// GetSortkey returns the sortkey param and if it is set
 func (p *UpdateVPCOfferingParams) GetSortkey() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["sortkey"].(int)
-	return value, ok
+	return p.sortkey.v, p.sortkey.ok
 }
 
+// SetState sets the state param.
 func (p *UpdateVPCOfferingParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *UpdateVPCOfferingParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *UpdateVPCOfferingParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *UpdateVPCOfferingParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *UpdateVPCOfferingParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *UpdateVPCOfferingParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVPCOfferingParams) Clone() *UpdateVPCOfferingParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVPCOfferingParams) Equal(o *UpdateVPCOfferingParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.displaytext == o.displaytext &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.sortkey == o.sortkey &&
+		p.state == o.state &&
+		p.zoneid == o.zoneid
+}
+
+// serializedUpdateVPCOfferingParams is used to (un)marshal UpdateVPCOfferingParams using the API param names
+type serializedUpdateVPCOfferingParams struct {
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey     *int    `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *UpdateVPCOfferingParams) toSerialized() *serializedUpdateVPCOfferingParams {
+	s := &serializedUpdateVPCOfferingParams{}
+	if p.displaytext.ok {
+		s.Displaytext = &p.displaytext.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.sortkey.ok {
+		s.Sortkey = &p.sortkey.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *UpdateVPCOfferingParams) fromSerialized(s *serializedUpdateVPCOfferingParams) {
+	*p = UpdateVPCOfferingParams{}
+	if s.Displaytext != nil {
+		p.SetDisplaytext(*s.Displaytext)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Sortkey != nil {
+		p.SetSortkey(*s.Sortkey)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVPCOfferingParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVPCOfferingParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVPCOfferingParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVPCOfferingParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVPCOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams {
 	p := &UpdateVPCOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates VPC offering
-func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateVPCOffering", p.toURLValues())
+// Updates VPC offering.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams, opts ...CallOption) (*UpdateVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequest("updateVPCOffering", s.cs.encodeParams("updateVPCOffering", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4326,8 +6237,8 @@ func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOf
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -53,123 +53,163 @@ func (m *MockVPCServiceIface) EXPECT() *MockVPCServiceIfaceMockRecorder {
 }
 
 // CreatePrivateGateway mocks base method.
-func (m *MockVPCServiceIface) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+func (m *MockVPCServiceIface) CreatePrivateGateway(p *CreatePrivateGatewayParams, opts ...CallOption) (*CreatePrivateGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePrivateGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePrivateGateway", varargs...)
 	ret0, _ := ret[0].(*CreatePrivateGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePrivateGateway indicates an expected call of CreatePrivateGateway.
-func (mr *MockVPCServiceIfaceMockRecorder) CreatePrivateGateway(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) CreatePrivateGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateGateway", reflect.TypeOf((*MockVPCServiceIface)(nil).CreatePrivateGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateGateway", reflect.TypeOf((*MockVPCServiceIface)(nil).CreatePrivateGateway), varargs...)
 }
 
 // CreateStaticRoute mocks base method.
-func (m *MockVPCServiceIface) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+func (m *MockVPCServiceIface) CreateStaticRoute(p *CreateStaticRouteParams, opts ...CallOption) (*CreateStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateStaticRoute", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateStaticRoute", varargs...)
 	ret0, _ := ret[0].(*CreateStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateStaticRoute indicates an expected call of CreateStaticRoute.
-func (mr *MockVPCServiceIfaceMockRecorder) CreateStaticRoute(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) CreateStaticRoute(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaticRoute", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateStaticRoute), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaticRoute", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateStaticRoute), varargs...)
 }
 
 // CreateVPC mocks base method.
-func (m *MockVPCServiceIface) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
+func (m *MockVPCServiceIface) CreateVPC(p *CreateVPCParams, opts ...CallOption) (*CreateVPCResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVPC", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVPC", varargs...)
 	ret0, _ := ret[0].(*CreateVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVPC indicates an expected call of CreateVPC.
-func (mr *MockVPCServiceIfaceMockRecorder) CreateVPC(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) CreateVPC(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPC), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPC), varargs...)
 }
 
 // CreateVPCOffering mocks base method.
-func (m *MockVPCServiceIface) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+func (m *MockVPCServiceIface) CreateVPCOffering(p *CreateVPCOfferingParams, opts ...CallOption) (*CreateVPCOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVPCOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVPCOffering", varargs...)
 	ret0, _ := ret[0].(*CreateVPCOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVPCOffering indicates an expected call of CreateVPCOffering.
-func (mr *MockVPCServiceIfaceMockRecorder) CreateVPCOffering(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) CreateVPCOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPCOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPCOffering), varargs...)
 }
 
 // DeletePrivateGateway mocks base method.
-func (m *MockVPCServiceIface) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+func (m *MockVPCServiceIface) DeletePrivateGateway(p *DeletePrivateGatewayParams, opts ...CallOption) (*DeletePrivateGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePrivateGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePrivateGateway", varargs...)
 	ret0, _ := ret[0].(*DeletePrivateGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeletePrivateGateway indicates an expected call of DeletePrivateGateway.
-func (mr *MockVPCServiceIfaceMockRecorder) DeletePrivateGateway(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) DeletePrivateGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateGateway", reflect.TypeOf((*MockVPCServiceIface)(nil).DeletePrivateGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateGateway", reflect.TypeOf((*MockVPCServiceIface)(nil).DeletePrivateGateway), varargs...)
 }
 
 // DeleteStaticRoute mocks base method.
-func (m *MockVPCServiceIface) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+func (m *MockVPCServiceIface) DeleteStaticRoute(p *DeleteStaticRouteParams, opts ...CallOption) (*DeleteStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteStaticRoute", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStaticRoute", varargs...)
 	ret0, _ := ret[0].(*DeleteStaticRouteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteStaticRoute indicates an expected call of DeleteStaticRoute.
-func (mr *MockVPCServiceIfaceMockRecorder) DeleteStaticRoute(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteStaticRoute(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaticRoute", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteStaticRoute), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaticRoute", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteStaticRoute), varargs...)
 }
 
 // DeleteVPC mocks base method.
-func (m *MockVPCServiceIface) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+func (m *MockVPCServiceIface) DeleteVPC(p *DeleteVPCParams, opts ...CallOption) (*DeleteVPCResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVPC", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVPC", varargs...)
 	ret0, _ := ret[0].(*DeleteVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVPC indicates an expected call of DeleteVPC.
-func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPC(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPC(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPC), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPC), varargs...)
 }
 
 // DeleteVPCOffering mocks base method.
-func (m *MockVPCServiceIface) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+func (m *MockVPCServiceIface) DeleteVPCOffering(p *DeleteVPCOfferingParams, opts ...CallOption) (*DeleteVPCOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVPCOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVPCOffering", varargs...)
 	ret0, _ := ret[0].(*DeleteVPCOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVPCOffering indicates an expected call of DeleteVPCOffering.
-func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPCOffering(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPCOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPCOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPCOffering), varargs...)
 }
 
 // GetPrivateGatewayByID mocks base method.
@@ -341,63 +381,83 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetVPCOfferingID(name interface{}, op
 }
 
 // ListPrivateGateways mocks base method.
-func (m *MockVPCServiceIface) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+func (m *MockVPCServiceIface) ListPrivateGateways(p *ListPrivateGatewaysParams, opts ...CallOption) (*ListPrivateGatewaysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPrivateGateways", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPrivateGateways", varargs...)
 	ret0, _ := ret[0].(*ListPrivateGatewaysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPrivateGateways indicates an expected call of ListPrivateGateways.
-func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGateways(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGateways(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGateways", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGateways), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGateways", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGateways), varargs...)
 }
 
 // ListStaticRoutes mocks base method.
-func (m *MockVPCServiceIface) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+func (m *MockVPCServiceIface) ListStaticRoutes(p *ListStaticRoutesParams, opts ...CallOption) (*ListStaticRoutesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListStaticRoutes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStaticRoutes", varargs...)
 	ret0, _ := ret[0].(*ListStaticRoutesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListStaticRoutes indicates an expected call of ListStaticRoutes.
-func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutes(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutes", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutes", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutes), varargs...)
 }
 
 // ListVPCOfferings mocks base method.
-func (m *MockVPCServiceIface) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+func (m *MockVPCServiceIface) ListVPCOfferings(p *ListVPCOfferingsParams, opts ...CallOption) (*ListVPCOfferingsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVPCOfferings", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCOfferings", varargs...)
 	ret0, _ := ret[0].(*ListVPCOfferingsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVPCOfferings indicates an expected call of ListVPCOfferings.
-func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferings(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferings(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferings", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferings), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferings", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferings), varargs...)
 }
 
 // ListVPCs mocks base method.
-func (m *MockVPCServiceIface) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
+func (m *MockVPCServiceIface) ListVPCs(p *ListVPCsParams, opts ...CallOption) (*ListVPCsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVPCs", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCs", varargs...)
 	ret0, _ := ret[0].(*ListVPCsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVPCs indicates an expected call of ListVPCs.
-func (mr *MockVPCServiceIfaceMockRecorder) ListVPCs(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCs(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCs", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCs), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCs", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCs), varargs...)
 }
 
 // NewCreatePrivateGatewayParams mocks base method.
@@ -611,46 +671,61 @@ func (mr *MockVPCServiceIfaceMockRecorder) NewUpdateVPCParams(id interface{}) *g
 }
 
 // RestartVPC mocks base method.
-func (m *MockVPCServiceIface) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
+func (m *MockVPCServiceIface) RestartVPC(p *RestartVPCParams, opts ...CallOption) (*RestartVPCResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestartVPC", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestartVPC", varargs...)
 	ret0, _ := ret[0].(*RestartVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RestartVPC indicates an expected call of RestartVPC.
-func (mr *MockVPCServiceIfaceMockRecorder) RestartVPC(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) RestartVPC(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).RestartVPC), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).RestartVPC), varargs...)
 }
 
 // UpdateVPC mocks base method.
-func (m *MockVPCServiceIface) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+func (m *MockVPCServiceIface) UpdateVPC(p *UpdateVPCParams, opts ...CallOption) (*UpdateVPCResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVPC", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVPC", varargs...)
 	ret0, _ := ret[0].(*UpdateVPCResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVPC indicates an expected call of UpdateVPC.
-func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPC(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPC(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPC), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPC), varargs...)
 }
 
 // UpdateVPCOffering mocks base method.
-func (m *MockVPCServiceIface) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+func (m *MockVPCServiceIface) UpdateVPCOffering(p *UpdateVPCOfferingParams, opts ...CallOption) (*UpdateVPCOfferingResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVPCOffering", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVPCOffering", varargs...)
 	ret0, _ := ret[0].(*UpdateVPCOfferingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVPCOffering indicates an expected call of UpdateVPCOffering.
-func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPCOffering(p interface{}) *gomock.Call {
+func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPCOffering(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPCOffering), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPCOffering), varargs...)
 }
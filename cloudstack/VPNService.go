@@ -28,200 +28,304 @@ import (
 )
 
 type VPNServiceIface interface {
-	AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUser(p *AddVpnUserParams, opts ...CallOption) (*AddVpnUserResponse, error)
 	NewAddVpnUserParams(password string, username string) *AddVpnUserParams
-	CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams, opts ...CallOption) (*CreateRemoteAccessVpnResponse, error)
 	NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams
-	CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnection(p *CreateVpnConnectionParams, opts ...CallOption) (*CreateVpnConnectionResponse, error)
 	NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams
-	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams, opts ...CallOption) (*CreateVpnCustomerGatewayResponse, error)
 	NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams
-	CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGateway(p *CreateVpnGatewayParams, opts ...CallOption) (*CreateVpnGatewayResponse, error)
 	NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams
-	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams, opts ...CallOption) (*DeleteRemoteAccessVpnResponse, error)
 	NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams
-	DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnection(p *DeleteVpnConnectionParams, opts ...CallOption) (*DeleteVpnConnectionResponse, error)
 	NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams
-	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams, opts ...CallOption) (*DeleteVpnCustomerGatewayResponse, error)
 	NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams
-	DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGateway(p *DeleteVpnGatewayParams, opts ...CallOption) (*DeleteVpnGatewayResponse, error)
 	NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams
-	ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams, opts ...CallOption) (*ListRemoteAccessVpnsResponse, error)
 	NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams
 	GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
-	ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnConnections(p *ListVpnConnectionsParams, opts ...CallOption) (*ListVpnConnectionsResponse, error)
 	NewListVpnConnectionsParams() *ListVpnConnectionsParams
 	GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error)
-	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams, opts ...CallOption) (*ListVpnCustomerGatewaysResponse, error)
 	NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams
 	GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error)
 	GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
 	GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
-	ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnGateways(p *ListVpnGatewaysParams, opts ...CallOption) (*ListVpnGatewaysResponse, error)
 	NewListVpnGatewaysParams() *ListVpnGatewaysParams
 	GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error)
-	ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	ListVpnUsers(p *ListVpnUsersParams, opts ...CallOption) (*ListVpnUsersResponse, error)
 	NewListVpnUsersParams() *ListVpnUsersParams
 	GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error)
-	RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUser(p *RemoveVpnUserParams, opts ...CallOption) (*RemoveVpnUserResponse, error)
 	NewRemoveVpnUserParams(username string) *RemoveVpnUserParams
-	ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnection(p *ResetVpnConnectionParams, opts ...CallOption) (*ResetVpnConnectionResponse, error)
 	NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams
-	UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams, opts ...CallOption) (*UpdateRemoteAccessVpnResponse, error)
 	NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams
-	UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnection(p *UpdateVpnConnectionParams, opts ...CallOption) (*UpdateVpnConnectionResponse, error)
 	NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams
-	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams, opts ...CallOption) (*UpdateVpnCustomerGatewayResponse, error)
 	NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams
-	UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGateway(p *UpdateVpnGatewayParams, opts ...CallOption) (*UpdateVpnGatewayResponse, error)
 	NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams
 }
 
 type AddVpnUserParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	password  optString
+	projectid optString
+	username  optString
 }
 
-func (p *AddVpnUserParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddVpnUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *AddVpnUserParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddVpnUserParams parses url.Values, for example taken from a raw API request,
+// into a new AddVpnUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddVpnUserParams(u url.Values) (*AddVpnUserParams, error) {
+	p := &AddVpnUserParams{}
+	if err := checkParamNames("addVpnUser", u, "account", "domainid", "password", "projectid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *AddVpnUserParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *AddVpnUserParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *AddVpnUserParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *AddVpnUserParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *AddVpnUserParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *AddVpnUserParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetPassword sets the password param. This param is required.
 func (p *AddVpnUserParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *AddVpnUserParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *AddVpnUserParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *AddVpnUserParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *AddVpnUserParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *AddVpnUserParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *AddVpnUserParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *AddVpnUserParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *AddVpnUserParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddVpnUserParams) Clone() *AddVpnUserParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddVpnUserParams) Equal(o *AddVpnUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.password == o.password &&
+		p.projectid == o.projectid &&
+		p.username == o.username
+}
+
+// serializedAddVpnUserParams is used to (un)marshal AddVpnUserParams using the API param names
+type serializedAddVpnUserParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Password  *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Username  *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *AddVpnUserParams) toSerialized() *serializedAddVpnUserParams {
+	s := &serializedAddVpnUserParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *AddVpnUserParams) fromSerialized(s *serializedAddVpnUserParams) {
+	*p = AddVpnUserParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddVpnUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddVpnUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddVpnUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddVpnUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddVpnUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddVpnUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddVpnUserParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddVpnUserParams {
 	p := &AddVpnUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["username"] = username
+	p.SetPassword(password)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds vpn users
-func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
-	resp, err := s.cs.newPostRequest("addVpnUser", p.toURLValues())
+// Adds vpn users.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: password, username.
+func (s *VPNService) AddVpnUser(p *AddVpnUserParams, opts ...CallOption) (*AddVpnUserResponse, error) {
+	resp, err := s.cs.newPostRequest("addVpnUser", s.cs.encodeParams("addVpnUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -232,8 +336,8 @@ func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -268,175 +372,291 @@ type AddVpnUserResponse struct {
 }
 
 type CreateRemoteAccessVpnParams struct {
-	p map[string]interface{}
+	account      optString
+	domainid     optString
+	fordisplay   optBool
+	iprange      optString
+	openfirewall optBool
+	publicipid   optString
 }
 
-func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateRemoteAccessVpnParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["iprange"]; found {
-		u.Set("iprange", v.(string))
+	if p.iprange.ok {
+		u.Set("iprange", p.iprange.v)
 	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
+	if p.openfirewall.ok {
+		u.Set("openfirewall", strconv.FormatBool(p.openfirewall.v))
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if p.publicipid.ok {
+		u.Set("publicipid", p.publicipid.v)
 	}
 	return u
 }
 
-func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateRemoteAccessVpnParams parses url.Values, for example taken from a raw API request,
+// into a new CreateRemoteAccessVpnParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateRemoteAccessVpnParams(u url.Values) (*CreateRemoteAccessVpnParams, error) {
+	p := &CreateRemoteAccessVpnParams{}
+	if err := checkParamNames("createRemoteAccessVpn", u, "account", "domainid", "fordisplay", "iprange", "openfirewall", "publicipid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
 	}
-	p.p["account"] = v
+	if _, found := u["iprange"]; found {
+		p.SetIprange(u.Get("iprange"))
+	}
+	if _, found := u["openfirewall"]; found {
+		v, err := strconv.ParseBool(u.Get("openfirewall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param openfirewall: %v", err)
+		}
+		p.SetOpenfirewall(v)
+	}
+	if _, found := u["publicipid"]; found {
+		p.SetPublicipid(u.Get("publicipid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateRemoteAccessVpnParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateRemoteAccessVpnParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateRemoteAccessVpnParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateRemoteAccessVpnParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateRemoteAccessVpnParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *CreateRemoteAccessVpnParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateRemoteAccessVpnParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateRemoteAccessVpnParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetIprange sets the iprange param.
 func (p *CreateRemoteAccessVpnParams) SetIprange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iprange"] = v
+	p.iprange = optString{v: v, ok: true}
 }
 
+// ResetIprange unsets the iprange param
 func (p *CreateRemoteAccessVpnParams) ResetIprange() {
-	if p.p != nil && p.p["iprange"] != nil {
-		delete(p.p, "iprange")
-	}
+	p.iprange = optString{}
 }
 
+// GetIprange returns the iprange param and if it is set
 func (p *CreateRemoteAccessVpnParams) GetIprange() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iprange"].(string)
-	return value, ok
+	return p.iprange.v, p.iprange.ok
 }
 
+// SetOpenfirewall sets the openfirewall param.
 func (p *CreateRemoteAccessVpnParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["openfirewall"] = v
+	p.openfirewall = optBool{v: v, ok: true}
 }
 
+// ResetOpenfirewall unsets the openfirewall param
 func (p *CreateRemoteAccessVpnParams) ResetOpenfirewall() {
-	if p.p != nil && p.p["openfirewall"] != nil {
-		delete(p.p, "openfirewall")
-	}
+	p.openfirewall = optBool{}
 }
 
+// GetOpenfirewall returns the openfirewall param and if it is set
 func (p *CreateRemoteAccessVpnParams) GetOpenfirewall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["openfirewall"].(bool)
-	return value, ok
+	return p.openfirewall.v, p.openfirewall.ok
 }
 
+// SetPublicipid sets the publicipid param. This param is required.
 func (p *CreateRemoteAccessVpnParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicipid"] = v
+	p.publicipid = optString{v: v, ok: true}
 }
 
+// ResetPublicipid unsets the publicipid param
 func (p *CreateRemoteAccessVpnParams) ResetPublicipid() {
-	if p.p != nil && p.p["publicipid"] != nil {
-		delete(p.p, "publicipid")
-	}
+	p.publicipid = optString{}
 }
 
+// GetPublicipid returns the publicipid param and if it is set
 func (p *CreateRemoteAccessVpnParams) GetPublicipid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.publicipid.v, p.publicipid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateRemoteAccessVpnParams) Clone() *CreateRemoteAccessVpnParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateRemoteAccessVpnParams) Equal(o *CreateRemoteAccessVpnParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.iprange == o.iprange &&
+		p.openfirewall == o.openfirewall &&
+		p.publicipid == o.publicipid
+}
+
+// serializedCreateRemoteAccessVpnParams is used to (un)marshal CreateRemoteAccessVpnParams using the API param names
+type serializedCreateRemoteAccessVpnParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay   *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Iprange      *string `json:"iprange,omitempty" yaml:"iprange,omitempty"`
+	Openfirewall *bool   `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Publicipid   *string `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+}
+
+func (p *CreateRemoteAccessVpnParams) toSerialized() *serializedCreateRemoteAccessVpnParams {
+	s := &serializedCreateRemoteAccessVpnParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.iprange.ok {
+		s.Iprange = &p.iprange.v
+	}
+	if p.openfirewall.ok {
+		s.Openfirewall = &p.openfirewall.v
+	}
+	if p.publicipid.ok {
+		s.Publicipid = &p.publicipid.v
+	}
+	return s
+}
+
+func (p *CreateRemoteAccessVpnParams) fromSerialized(s *serializedCreateRemoteAccessVpnParams) {
+	*p = CreateRemoteAccessVpnParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Iprange != nil {
+		p.SetIprange(*s.Iprange)
+	}
+	if s.Openfirewall != nil {
+		p.SetOpenfirewall(*s.Openfirewall)
+	}
+	if s.Publicipid != nil {
+		p.SetPublicipid(*s.Publicipid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateRemoteAccessVpnParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateRemoteAccessVpnParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateRemoteAccessVpnParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateRemoteAccessVpnParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["publicipid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams {
 	p := &CreateRemoteAccessVpnParams{}
-	p.p = make(map[string]interface{})
-	p.p["publicipid"] = publicipid
+	p.SetPublicipid(publicipid)
 	return p
 }
 
-// Creates a l2tp/ipsec remote access vpn
-func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("createRemoteAccessVpn", p.toURLValues())
+// Creates a l2tp/ipsec remote access vpn.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: publicipid.
+func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams, opts ...CallOption) (*CreateRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequest("createRemoteAccessVpn", s.cs.encodeParams("createRemoteAccessVpn", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -447,8 +667,8 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -487,128 +707,233 @@ type CreateRemoteAccessVpnResponse struct {
 }
 
 type CreateVpnConnectionParams struct {
-	p map[string]interface{}
+	fordisplay           optBool
+	passive              optBool
+	s2scustomergatewayid optString
+	s2svpngatewayid      optString
 }
 
-func (p *CreateVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["passive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passive", vv)
+	if p.passive.ok {
+		u.Set("passive", strconv.FormatBool(p.passive.v))
 	}
-	if v, found := p.p["s2scustomergatewayid"]; found {
-		u.Set("s2scustomergatewayid", v.(string))
+	if p.s2scustomergatewayid.ok {
+		u.Set("s2scustomergatewayid", p.s2scustomergatewayid.v)
 	}
-	if v, found := p.p["s2svpngatewayid"]; found {
-		u.Set("s2svpngatewayid", v.(string))
+	if p.s2svpngatewayid.ok {
+		u.Set("s2svpngatewayid", p.s2svpngatewayid.v)
 	}
 	return u
 }
 
-func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVpnConnectionParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVpnConnectionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVpnConnectionParams(u url.Values) (*CreateVpnConnectionParams, error) {
+	p := &CreateVpnConnectionParams{}
+	if err := checkParamNames("createVpnConnection", u, "fordisplay", "passive", "s2scustomergatewayid", "s2svpngatewayid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
 	}
-	p.p["fordisplay"] = v
+	if _, found := u["passive"]; found {
+		v, err := strconv.ParseBool(u.Get("passive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param passive: %v", err)
+		}
+		p.SetPassive(v)
+	}
+	if _, found := u["s2scustomergatewayid"]; found {
+		p.SetS2scustomergatewayid(u.Get("s2scustomergatewayid"))
+	}
+	if _, found := u["s2svpngatewayid"]; found {
+		p.SetS2svpngatewayid(u.Get("s2svpngatewayid"))
+	}
+	return p, nil
+}
+
+// SetFordisplay sets the fordisplay param.
+func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateVpnConnectionParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateVpnConnectionParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetPassive sets the passive param.
 func (p *CreateVpnConnectionParams) SetPassive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["passive"] = v
+	p.passive = optBool{v: v, ok: true}
 }
 
+// ResetPassive unsets the passive param
 func (p *CreateVpnConnectionParams) ResetPassive() {
-	if p.p != nil && p.p["passive"] != nil {
-		delete(p.p, "passive")
-	}
+	p.passive = optBool{}
 }
 
+// GetPassive returns the passive param and if it is set
 func (p *CreateVpnConnectionParams) GetPassive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["passive"].(bool)
-	return value, ok
+	return p.passive.v, p.passive.ok
 }
 
+// SetS2scustomergatewayid sets the s2scustomergatewayid param. This param is required.
 func (p *CreateVpnConnectionParams) SetS2scustomergatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["s2scustomergatewayid"] = v
+	p.s2scustomergatewayid = optString{v: v, ok: true}
 }
 
+// ResetS2scustomergatewayid unsets the s2scustomergatewayid param
 func (p *CreateVpnConnectionParams) ResetS2scustomergatewayid() {
-	if p.p != nil && p.p["s2scustomergatewayid"] != nil {
-		delete(p.p, "s2scustomergatewayid")
-	}
+	p.s2scustomergatewayid = optString{}
 }
 
+// GetS2scustomergatewayid returns the s2scustomergatewayid param and if it is set
 func (p *CreateVpnConnectionParams) GetS2scustomergatewayid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["s2scustomergatewayid"].(string)
-	return value, ok
+	return p.s2scustomergatewayid.v, p.s2scustomergatewayid.ok
 }
 
+// SetS2svpngatewayid sets the s2svpngatewayid param. This param is required.
 func (p *CreateVpnConnectionParams) SetS2svpngatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["s2svpngatewayid"] = v
+	p.s2svpngatewayid = optString{v: v, ok: true}
 }
 
+// ResetS2svpngatewayid unsets the s2svpngatewayid param
 func (p *CreateVpnConnectionParams) ResetS2svpngatewayid() {
-	if p.p != nil && p.p["s2svpngatewayid"] != nil {
-		delete(p.p, "s2svpngatewayid")
-	}
+	p.s2svpngatewayid = optString{}
 }
 
+// GetS2svpngatewayid returns the s2svpngatewayid param and if it is set
 func (p *CreateVpnConnectionParams) GetS2svpngatewayid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.s2svpngatewayid.v, p.s2svpngatewayid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVpnConnectionParams) Clone() *CreateVpnConnectionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVpnConnectionParams) Equal(o *CreateVpnConnectionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.passive == o.passive &&
+		p.s2scustomergatewayid == o.s2scustomergatewayid &&
+		p.s2svpngatewayid == o.s2svpngatewayid
+}
+
+// serializedCreateVpnConnectionParams is used to (un)marshal CreateVpnConnectionParams using the API param names
+type serializedCreateVpnConnectionParams struct {
+	Fordisplay           *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Passive              *bool   `json:"passive,omitempty" yaml:"passive,omitempty"`
+	S2scustomergatewayid *string `json:"s2scustomergatewayid,omitempty" yaml:"s2scustomergatewayid,omitempty"`
+	S2svpngatewayid      *string `json:"s2svpngatewayid,omitempty" yaml:"s2svpngatewayid,omitempty"`
+}
+
+func (p *CreateVpnConnectionParams) toSerialized() *serializedCreateVpnConnectionParams {
+	s := &serializedCreateVpnConnectionParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.passive.ok {
+		s.Passive = &p.passive.v
+	}
+	if p.s2scustomergatewayid.ok {
+		s.S2scustomergatewayid = &p.s2scustomergatewayid.v
+	}
+	if p.s2svpngatewayid.ok {
+		s.S2svpngatewayid = &p.s2svpngatewayid.v
+	}
+	return s
+}
+
+func (p *CreateVpnConnectionParams) fromSerialized(s *serializedCreateVpnConnectionParams) {
+	*p = CreateVpnConnectionParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Passive != nil {
+		p.SetPassive(*s.Passive)
+	}
+	if s.S2scustomergatewayid != nil {
+		p.SetS2scustomergatewayid(*s.S2scustomergatewayid)
+	}
+	if s.S2svpngatewayid != nil {
+		p.SetS2svpngatewayid(*s.S2svpngatewayid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVpnConnectionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVpnConnectionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVpnConnectionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVpnConnectionParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["s2svpngatewayid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams {
 	p := &CreateVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["s2scustomergatewayid"] = s2scustomergatewayid
-	p.p["s2svpngatewayid"] = s2svpngatewayid
+	p.SetS2scustomergatewayid(s2scustomergatewayid)
+	p.SetS2svpngatewayid(s2svpngatewayid)
 	return p
 }
 
-// Create site to site vpn connection
-func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("createVpnConnection", p.toURLValues())
+// Create site to site vpn connection.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: s2scustomergatewayid,
+// s2svpngatewayid.
+func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams, opts ...CallOption) (*CreateVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest("createVpnConnection", s.cs.encodeParams("createVpnConnection", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -619,8 +944,8 @@ func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateV
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -672,398 +997,578 @@ type CreateVpnConnectionResponse struct {
 }
 
 type CreateVpnCustomerGatewayParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
+	account          optString
+	cidrlist         optString
+	domainid         optString
+	dpd              optBool
+	esplifetime      optInt64
+	esppolicy        optString
+	forceencap       optBool
+	gateway          optString
+	ikelifetime      optInt64
+	ikepolicy        optString
+	ikeversion       optString
+	ipsecpsk         optString
+	name             optString
+	projectid        optString
+	splitconnections optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVpnCustomerGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		u.Set("cidrlist", v.(string))
+	if p.cidrlist.ok {
+		u.Set("cidrlist", p.cidrlist.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["dpd"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("dpd", vv)
+	if p.dpd.ok {
+		u.Set("dpd", strconv.FormatBool(p.dpd.v))
 	}
-	if v, found := p.p["esplifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("esplifetime", vv)
+	if p.esplifetime.ok {
+		u.Set("esplifetime", strconv.FormatInt(p.esplifetime.v, 10))
 	}
-	if v, found := p.p["esppolicy"]; found {
-		u.Set("esppolicy", v.(string))
+	if p.esppolicy.ok {
+		u.Set("esppolicy", p.esppolicy.v)
 	}
-	if v, found := p.p["forceencap"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forceencap", vv)
+	if p.forceencap.ok {
+		u.Set("forceencap", strconv.FormatBool(p.forceencap.v))
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["ikelifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("ikelifetime", vv)
+	if p.ikelifetime.ok {
+		u.Set("ikelifetime", strconv.FormatInt(p.ikelifetime.v, 10))
 	}
-	if v, found := p.p["ikepolicy"]; found {
-		u.Set("ikepolicy", v.(string))
+	if p.ikepolicy.ok {
+		u.Set("ikepolicy", p.ikepolicy.v)
 	}
-	if v, found := p.p["ikeversion"]; found {
-		u.Set("ikeversion", v.(string))
+	if p.ikeversion.ok {
+		u.Set("ikeversion", p.ikeversion.v)
 	}
-	if v, found := p.p["ipsecpsk"]; found {
-		u.Set("ipsecpsk", v.(string))
+	if p.ipsecpsk.ok {
+		u.Set("ipsecpsk", p.ipsecpsk.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["splitconnections"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("splitconnections", vv)
+	if p.splitconnections.ok {
+		u.Set("splitconnections", strconv.FormatBool(p.splitconnections.v))
 	}
 	return u
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVpnCustomerGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVpnCustomerGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVpnCustomerGatewayParams(u url.Values) (*CreateVpnCustomerGatewayParams, error) {
+	p := &CreateVpnCustomerGatewayParams{}
+	if err := checkParamNames("createVpnCustomerGateway", u, "account", "cidrlist", "domainid", "dpd", "esplifetime", "esppolicy", "forceencap", "gateway", "ikelifetime", "ikepolicy", "ikeversion", "ipsecpsk", "name", "projectid", "splitconnections"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(u.Get("cidrlist"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["dpd"]; found {
+		v, err := strconv.ParseBool(u.Get("dpd"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param dpd: %v", err)
+		}
+		p.SetDpd(v)
+	}
+	if _, found := u["esplifetime"]; found {
+		v, err := strconv.ParseInt(u.Get("esplifetime"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param esplifetime: %v", err)
+		}
+		p.SetEsplifetime(v)
+	}
+	if _, found := u["esppolicy"]; found {
+		p.SetEsppolicy(u.Get("esppolicy"))
+	}
+	if _, found := u["forceencap"]; found {
+		v, err := strconv.ParseBool(u.Get("forceencap"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forceencap: %v", err)
+		}
+		p.SetForceencap(v)
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["ikelifetime"]; found {
+		v, err := strconv.ParseInt(u.Get("ikelifetime"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ikelifetime: %v", err)
+		}
+		p.SetIkelifetime(v)
+	}
+	if _, found := u["ikepolicy"]; found {
+		p.SetIkepolicy(u.Get("ikepolicy"))
+	}
+	if _, found := u["ikeversion"]; found {
+		p.SetIkeversion(u.Get("ikeversion"))
 	}
-	p.p["account"] = v
+	if _, found := u["ipsecpsk"]; found {
+		p.SetIpsecpsk(u.Get("ipsecpsk"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["splitconnections"]; found {
+		v, err := strconv.ParseBool(u.Get("splitconnections"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param splitconnections: %v", err)
+		}
+		p.SetSplitconnections(v)
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateVpnCustomerGatewayParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCidrlist sets the cidrlist param. This param is required.
 func (p *CreateVpnCustomerGatewayParams) SetCidrlist(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+	p.cidrlist = optString{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *CreateVpnCustomerGatewayParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optString{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetCidrlist() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].(string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateVpnCustomerGatewayParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateVpnCustomerGatewayParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetDpd sets the dpd param.
 func (p *CreateVpnCustomerGatewayParams) SetDpd(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dpd"] = v
+	p.dpd = optBool{v: v, ok: true}
 }
 
+// ResetDpd unsets the dpd param
 func (p *CreateVpnCustomerGatewayParams) ResetDpd() {
-	if p.p != nil && p.p["dpd"] != nil {
-		delete(p.p, "dpd")
-	}
+	p.dpd = optBool{}
 }
 
+// GetDpd returns the dpd param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetDpd() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dpd"].(bool)
-	return value, ok
+	return p.dpd.v, p.dpd.ok
 }
 
+// SetEsplifetime sets the esplifetime param.
 func (p *CreateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["esplifetime"] = v
+	p.esplifetime = optInt64{v: v, ok: true}
 }
 
+// ResetEsplifetime unsets the esplifetime param
 func (p *CreateVpnCustomerGatewayParams) ResetEsplifetime() {
-	if p.p != nil && p.p["esplifetime"] != nil {
-		delete(p.p, "esplifetime")
-	}
+	p.esplifetime = optInt64{}
 }
 
+// GetEsplifetime returns the esplifetime param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetEsplifetime() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["esplifetime"].(int64)
-	return value, ok
+	return p.esplifetime.v, p.esplifetime.ok
 }
 
+// SetEsppolicy sets the esppolicy param. This param is required.
 func (p *CreateVpnCustomerGatewayParams) SetEsppolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["esppolicy"] = v
+	p.esppolicy = optString{v: v, ok: true}
 }
 
+// ResetEsppolicy unsets the esppolicy param
 func (p *CreateVpnCustomerGatewayParams) ResetEsppolicy() {
-	if p.p != nil && p.p["esppolicy"] != nil {
-		delete(p.p, "esppolicy")
-	}
+	p.esppolicy = optString{}
 }
 
+// GetEsppolicy returns the esppolicy param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetEsppolicy() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["esppolicy"].(string)
-	return value, ok
+	return p.esppolicy.v, p.esppolicy.ok
 }
 
+// SetForceencap sets the forceencap param.
 func (p *CreateVpnCustomerGatewayParams) SetForceencap(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forceencap"] = v
+	p.forceencap = optBool{v: v, ok: true}
 }
 
+// ResetForceencap unsets the forceencap param
 func (p *CreateVpnCustomerGatewayParams) ResetForceencap() {
-	if p.p != nil && p.p["forceencap"] != nil {
-		delete(p.p, "forceencap")
-	}
+	p.forceencap = optBool{}
 }
 
+// GetForceencap returns the forceencap param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetForceencap() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forceencap"].(bool)
-	return value, ok
+	return p.forceencap.v, p.forceencap.ok
 }
 
+// SetGateway sets the gateway param. This param is required.
 func (p *CreateVpnCustomerGatewayParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *CreateVpnCustomerGatewayParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetIkelifetime sets the ikelifetime param.
 func (p *CreateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ikelifetime"] = v
+	p.ikelifetime = optInt64{v: v, ok: true}
 }
 
+// ResetIkelifetime unsets the ikelifetime param
 func (p *CreateVpnCustomerGatewayParams) ResetIkelifetime() {
-	if p.p != nil && p.p["ikelifetime"] != nil {
-		delete(p.p, "ikelifetime")
-	}
+	p.ikelifetime = optInt64{}
 }
 
+// GetIkelifetime returns the ikelifetime param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetIkelifetime() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ikelifetime"].(int64)
-	return value, ok
+	return p.ikelifetime.v, p.ikelifetime.ok
 }
 
+// SetIkepolicy sets the ikepolicy param. This param is required.
 func (p *CreateVpnCustomerGatewayParams) SetIkepolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ikepolicy"] = v
+	p.ikepolicy = optString{v: v, ok: true}
 }
 
+// ResetIkepolicy unsets the ikepolicy param
 func (p *CreateVpnCustomerGatewayParams) ResetIkepolicy() {
-	if p.p != nil && p.p["ikepolicy"] != nil {
-		delete(p.p, "ikepolicy")
-	}
+	p.ikepolicy = optString{}
 }
 
+// GetIkepolicy returns the ikepolicy param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetIkepolicy() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ikepolicy"].(string)
-	return value, ok
+	return p.ikepolicy.v, p.ikepolicy.ok
 }
 
+// SetIkeversion sets the ikeversion param.
 func (p *CreateVpnCustomerGatewayParams) SetIkeversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ikeversion"] = v
+	p.ikeversion = optString{v: v, ok: true}
 }
 
+// ResetIkeversion unsets the ikeversion param
 func (p *CreateVpnCustomerGatewayParams) ResetIkeversion() {
-	if p.p != nil && p.p["ikeversion"] != nil {
-		delete(p.p, "ikeversion")
-	}
+	p.ikeversion = optString{}
 }
 
+// GetIkeversion returns the ikeversion param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetIkeversion() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ikeversion"].(string)
-	return value, ok
+	return p.ikeversion.v, p.ikeversion.ok
 }
 
+// SetIpsecpsk sets the ipsecpsk param. This param is required.
 func (p *CreateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipsecpsk"] = v
+	p.ipsecpsk = optString{v: v, ok: true}
 }
 
+// ResetIpsecpsk unsets the ipsecpsk param
 func (p *CreateVpnCustomerGatewayParams) ResetIpsecpsk() {
-	if p.p != nil && p.p["ipsecpsk"] != nil {
-		delete(p.p, "ipsecpsk")
-	}
+	p.ipsecpsk = optString{}
 }
 
+// GetIpsecpsk returns the ipsecpsk param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetIpsecpsk() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipsecpsk"].(string)
-	return value, ok
+	return p.ipsecpsk.v, p.ipsecpsk.ok
 }
 
+// SetName sets the name param.
 func (p *CreateVpnCustomerGatewayParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateVpnCustomerGatewayParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateVpnCustomerGatewayParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateVpnCustomerGatewayParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetSplitconnections sets the splitconnections param.
 func (p *CreateVpnCustomerGatewayParams) SetSplitconnections(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["splitconnections"] = v
+	p.splitconnections = optBool{v: v, ok: true}
 }
 
+// ResetSplitconnections unsets the splitconnections param
 func (p *CreateVpnCustomerGatewayParams) ResetSplitconnections() {
-	if p.p != nil && p.p["splitconnections"] != nil {
-		delete(p.p, "splitconnections")
-	}
+	p.splitconnections = optBool{}
 }
 
+// GetSplitconnections returns the splitconnections param and if it is set
 func (p *CreateVpnCustomerGatewayParams) GetSplitconnections() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.splitconnections.v, p.splitconnections.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVpnCustomerGatewayParams) Clone() *CreateVpnCustomerGatewayParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["splitconnections"].(bool)
-	return value, ok
+	c := *p
+	return &c
 }
 
-// You should always use this function to get a new CreateVpnCustomerGatewayParams instance,
-// as then you are sure you have configured all required params
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVpnCustomerGatewayParams) Equal(o *CreateVpnCustomerGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.cidrlist == o.cidrlist &&
+		p.domainid == o.domainid &&
+		p.dpd == o.dpd &&
+		p.esplifetime == o.esplifetime &&
+		p.esppolicy == o.esppolicy &&
+		p.forceencap == o.forceencap &&
+		p.gateway == o.gateway &&
+		p.ikelifetime == o.ikelifetime &&
+		p.ikepolicy == o.ikepolicy &&
+		p.ikeversion == o.ikeversion &&
+		p.ipsecpsk == o.ipsecpsk &&
+		p.name == o.name &&
+		p.projectid == o.projectid &&
+		p.splitconnections == o.splitconnections
+}
+
+// serializedCreateVpnCustomerGatewayParams is used to (un)marshal CreateVpnCustomerGatewayParams using the API param names
+type serializedCreateVpnCustomerGatewayParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist         *string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Dpd              *bool   `json:"dpd,omitempty" yaml:"dpd,omitempty"`
+	Esplifetime      *int64  `json:"esplifetime,omitempty" yaml:"esplifetime,omitempty"`
+	Esppolicy        *string `json:"esppolicy,omitempty" yaml:"esppolicy,omitempty"`
+	Forceencap       *bool   `json:"forceencap,omitempty" yaml:"forceencap,omitempty"`
+	Gateway          *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Ikelifetime      *int64  `json:"ikelifetime,omitempty" yaml:"ikelifetime,omitempty"`
+	Ikepolicy        *string `json:"ikepolicy,omitempty" yaml:"ikepolicy,omitempty"`
+	Ikeversion       *string `json:"ikeversion,omitempty" yaml:"ikeversion,omitempty"`
+	Ipsecpsk         *string `json:"ipsecpsk,omitempty" yaml:"ipsecpsk,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Splitconnections *bool   `json:"splitconnections,omitempty" yaml:"splitconnections,omitempty"`
+}
+
+func (p *CreateVpnCustomerGatewayParams) toSerialized() *serializedCreateVpnCustomerGatewayParams {
+	s := &serializedCreateVpnCustomerGatewayParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.dpd.ok {
+		s.Dpd = &p.dpd.v
+	}
+	if p.esplifetime.ok {
+		s.Esplifetime = &p.esplifetime.v
+	}
+	if p.esppolicy.ok {
+		s.Esppolicy = &p.esppolicy.v
+	}
+	if p.forceencap.ok {
+		s.Forceencap = &p.forceencap.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.ikelifetime.ok {
+		s.Ikelifetime = &p.ikelifetime.v
+	}
+	if p.ikepolicy.ok {
+		s.Ikepolicy = &p.ikepolicy.v
+	}
+	if p.ikeversion.ok {
+		s.Ikeversion = &p.ikeversion.v
+	}
+	if p.ipsecpsk.ok {
+		s.Ipsecpsk = &p.ipsecpsk.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.splitconnections.ok {
+		s.Splitconnections = &p.splitconnections.v
+	}
+	return s
+}
+
+func (p *CreateVpnCustomerGatewayParams) fromSerialized(s *serializedCreateVpnCustomerGatewayParams) {
+	*p = CreateVpnCustomerGatewayParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Dpd != nil {
+		p.SetDpd(*s.Dpd)
+	}
+	if s.Esplifetime != nil {
+		p.SetEsplifetime(*s.Esplifetime)
+	}
+	if s.Esppolicy != nil {
+		p.SetEsppolicy(*s.Esppolicy)
+	}
+	if s.Forceencap != nil {
+		p.SetForceencap(*s.Forceencap)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
+	}
+	if s.Ikelifetime != nil {
+		p.SetIkelifetime(*s.Ikelifetime)
+	}
+	if s.Ikepolicy != nil {
+		p.SetIkepolicy(*s.Ikepolicy)
+	}
+	if s.Ikeversion != nil {
+		p.SetIkeversion(*s.Ikeversion)
+	}
+	if s.Ipsecpsk != nil {
+		p.SetIpsecpsk(*s.Ipsecpsk)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Splitconnections != nil {
+		p.SetSplitconnections(*s.Splitconnections)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVpnCustomerGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVpnCustomerGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVpnCustomerGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVpnCustomerGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new CreateVpnCustomerGatewayParams instance,
+// as then you are sure you have configured all required params
 func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams {
 	p := &CreateVpnCustomerGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidrlist"] = cidrlist
-	p.p["esppolicy"] = esppolicy
-	p.p["gateway"] = gateway
-	p.p["ikepolicy"] = ikepolicy
-	p.p["ipsecpsk"] = ipsecpsk
+	p.SetCidrlist(cidrlist)
+	p.SetEsppolicy(esppolicy)
+	p.SetGateway(gateway)
+	p.SetIkepolicy(ikepolicy)
+	p.SetIpsecpsk(ipsecpsk)
 	return p
 }
 
-// Creates site to site vpn customer gateway
-func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnCustomerGateway", p.toURLValues())
+// Creates site to site vpn customer gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: cidrlist, esppolicy,
+// gateway, ikepolicy, ipsecpsk.
+func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams, opts ...CallOption) (*CreateVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequest("createVpnCustomerGateway", s.cs.encodeParams("createVpnCustomerGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1074,8 +1579,8 @@ func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1123,78 +1628,167 @@ type CreateVpnCustomerGatewayResponse struct {
 }
 
 type CreateVpnGatewayParams struct {
-	p map[string]interface{}
+	fordisplay optBool
+	vpcid      optString
 }
 
-func (p *CreateVpnGatewayParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVpnGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVpnGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVpnGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVpnGatewayParams(u url.Values) (*CreateVpnGatewayParams, error) {
+	p := &CreateVpnGatewayParams{}
+	if err := checkParamNames("createVpnGateway", u, "fordisplay", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
 	}
-	p.p["fordisplay"] = v
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	return p, nil
 }
 
+// SetFordisplay sets the fordisplay param.
+func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
+	p.fordisplay = optBool{v: v, ok: true}
+}
+
+// ResetFordisplay unsets the fordisplay param
 func (p *CreateVpnGatewayParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *CreateVpnGatewayParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetVpcid sets the vpcid param. This param is required.
 func (p *CreateVpnGatewayParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *CreateVpnGatewayParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *CreateVpnGatewayParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVpnGatewayParams) Clone() *CreateVpnGatewayParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVpnGatewayParams) Equal(o *CreateVpnGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.fordisplay == o.fordisplay &&
+		p.vpcid == o.vpcid
+}
+
+// serializedCreateVpnGatewayParams is used to (un)marshal CreateVpnGatewayParams using the API param names
+type serializedCreateVpnGatewayParams struct {
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Vpcid      *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *CreateVpnGatewayParams) toSerialized() *serializedCreateVpnGatewayParams {
+	s := &serializedCreateVpnGatewayParams{}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	return s
+}
+
+func (p *CreateVpnGatewayParams) fromSerialized(s *serializedCreateVpnGatewayParams) {
+	*p = CreateVpnGatewayParams{}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVpnGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVpnGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVpnGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVpnGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateVpnGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams {
 	p := &CreateVpnGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["vpcid"] = vpcid
+	p.SetVpcid(vpcid)
 	return p
 }
 
-// Creates site to site vpn local gateway
-func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnGateway", p.toURLValues())
+// Creates site to site vpn local gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: vpcid.
+func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams, opts ...CallOption) (*CreateVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequest("createVpnGateway", s.cs.encodeParams("createVpnGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1205,8 +1799,8 @@ func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGate
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1244,53 +1838,133 @@ type CreateVpnGatewayResponse struct {
 }
 
 type DeleteRemoteAccessVpnParams struct {
-	p map[string]interface{}
+	publicipid optString
 }
 
-func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteRemoteAccessVpnParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if p.publicipid.ok {
+		u.Set("publicipid", p.publicipid.v)
 	}
 	return u
 }
 
-func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteRemoteAccessVpnParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteRemoteAccessVpnParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteRemoteAccessVpnParams(u url.Values) (*DeleteRemoteAccessVpnParams, error) {
+	p := &DeleteRemoteAccessVpnParams{}
+	if err := checkParamNames("deleteRemoteAccessVpn", u, "publicipid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["publicipid"]; found {
+		p.SetPublicipid(u.Get("publicipid"))
 	}
-	p.p["publicipid"] = v
+	return p, nil
+}
+
+// SetPublicipid sets the publicipid param. This param is required.
+func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
+	p.publicipid = optString{v: v, ok: true}
 }
 
+// ResetPublicipid unsets the publicipid param
 func (p *DeleteRemoteAccessVpnParams) ResetPublicipid() {
-	if p.p != nil && p.p["publicipid"] != nil {
-		delete(p.p, "publicipid")
-	}
+	p.publicipid = optString{}
 }
 
+// GetPublicipid returns the publicipid param and if it is set
 func (p *DeleteRemoteAccessVpnParams) GetPublicipid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.publicipid.v, p.publicipid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteRemoteAccessVpnParams) Clone() *DeleteRemoteAccessVpnParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteRemoteAccessVpnParams) Equal(o *DeleteRemoteAccessVpnParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.publicipid == o.publicipid
+}
+
+// serializedDeleteRemoteAccessVpnParams is used to (un)marshal DeleteRemoteAccessVpnParams using the API param names
+type serializedDeleteRemoteAccessVpnParams struct {
+	Publicipid *string `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+}
+
+func (p *DeleteRemoteAccessVpnParams) toSerialized() *serializedDeleteRemoteAccessVpnParams {
+	s := &serializedDeleteRemoteAccessVpnParams{}
+	if p.publicipid.ok {
+		s.Publicipid = &p.publicipid.v
 	}
-	value, ok := p.p["publicipid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *DeleteRemoteAccessVpnParams) fromSerialized(s *serializedDeleteRemoteAccessVpnParams) {
+	*p = DeleteRemoteAccessVpnParams{}
+	if s.Publicipid != nil {
+		p.SetPublicipid(*s.Publicipid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteRemoteAccessVpnParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteRemoteAccessVpnParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteRemoteAccessVpnParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteRemoteAccessVpnParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams {
 	p := &DeleteRemoteAccessVpnParams{}
-	p.p = make(map[string]interface{})
-	p.p["publicipid"] = publicipid
+	p.SetPublicipid(publicipid)
 	return p
 }
 
-// Destroys a l2tp/ipsec remote access vpn
-func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("deleteRemoteAccessVpn", p.toURLValues())
+// Destroys a l2tp/ipsec remote access vpn.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: publicipid.
+func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams, opts ...CallOption) (*DeleteRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequest("deleteRemoteAccessVpn", s.cs.encodeParams("deleteRemoteAccessVpn", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1301,8 +1975,8 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1326,53 +2000,133 @@ type DeleteRemoteAccessVpnResponse struct {
 }
 
 type DeleteVpnConnectionParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteVpnConnectionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteVpnConnectionParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVpnConnectionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVpnConnectionParams(u url.Values) (*DeleteVpnConnectionParams, error) {
+	p := &DeleteVpnConnectionParams{}
+	if err := checkParamNames("deleteVpnConnection", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteVpnConnectionParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteVpnConnectionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteVpnConnectionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVpnConnectionParams) Clone() *DeleteVpnConnectionParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVpnConnectionParams) Equal(o *DeleteVpnConnectionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteVpnConnectionParams is used to (un)marshal DeleteVpnConnectionParams using the API param names
+type serializedDeleteVpnConnectionParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteVpnConnectionParams) toSerialized() *serializedDeleteVpnConnectionParams {
+	s := &serializedDeleteVpnConnectionParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteVpnConnectionParams) fromSerialized(s *serializedDeleteVpnConnectionParams) {
+	*p = DeleteVpnConnectionParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVpnConnectionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVpnConnectionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVpnConnectionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVpnConnectionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams {
 	p := &DeleteVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Delete site to site vpn connection
-func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnConnection", p.toURLValues())
+// Delete site to site vpn connection.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams, opts ...CallOption) (*DeleteVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest("deleteVpnConnection", s.cs.encodeParams("deleteVpnConnection", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1383,8 +2137,8 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1408,53 +2162,133 @@ type DeleteVpnConnectionResponse struct {
 }
 
 type DeleteVpnCustomerGatewayParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVpnCustomerGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteVpnCustomerGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVpnCustomerGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVpnCustomerGatewayParams(u url.Values) (*DeleteVpnCustomerGatewayParams, error) {
+	p := &DeleteVpnCustomerGatewayParams{}
+	if err := checkParamNames("deleteVpnCustomerGateway", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteVpnCustomerGatewayParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteVpnCustomerGatewayParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVpnCustomerGatewayParams) Clone() *DeleteVpnCustomerGatewayParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVpnCustomerGatewayParams) Equal(o *DeleteVpnCustomerGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteVpnCustomerGatewayParams is used to (un)marshal DeleteVpnCustomerGatewayParams using the API param names
+type serializedDeleteVpnCustomerGatewayParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteVpnCustomerGatewayParams) toSerialized() *serializedDeleteVpnCustomerGatewayParams {
+	s := &serializedDeleteVpnCustomerGatewayParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteVpnCustomerGatewayParams) fromSerialized(s *serializedDeleteVpnCustomerGatewayParams) {
+	*p = DeleteVpnCustomerGatewayParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVpnCustomerGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVpnCustomerGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVpnCustomerGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVpnCustomerGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams {
 	p := &DeleteVpnCustomerGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Delete site to site vpn customer gateway
-func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnCustomerGateway", p.toURLValues())
+// Delete site to site vpn customer gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams, opts ...CallOption) (*DeleteVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequest("deleteVpnCustomerGateway", s.cs.encodeParams("deleteVpnCustomerGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1465,8 +2299,8 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1490,53 +2324,133 @@ type DeleteVpnCustomerGatewayResponse struct {
 }
 
 type DeleteVpnGatewayParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVpnGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteVpnGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteVpnGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVpnGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVpnGatewayParams(u url.Values) (*DeleteVpnGatewayParams, error) {
+	p := &DeleteVpnGatewayParams{}
+	if err := checkParamNames("deleteVpnGateway", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteVpnGatewayParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteVpnGatewayParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteVpnGatewayParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVpnGatewayParams) Clone() *DeleteVpnGatewayParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVpnGatewayParams) Equal(o *DeleteVpnGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteVpnGatewayParams is used to (un)marshal DeleteVpnGatewayParams using the API param names
+type serializedDeleteVpnGatewayParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteVpnGatewayParams) toSerialized() *serializedDeleteVpnGatewayParams {
+	s := &serializedDeleteVpnGatewayParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteVpnGatewayParams) fromSerialized(s *serializedDeleteVpnGatewayParams) {
+	*p = DeleteVpnGatewayParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVpnGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVpnGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVpnGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVpnGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVpnGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams {
 	p := &DeleteVpnGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Delete site to site vpn gateway
-func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnGateway", p.toURLValues())
+// Delete site to site vpn gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams, opts ...CallOption) (*DeleteVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequest("deleteVpnGateway", s.cs.encodeParams("deleteVpnGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1547,8 +2461,8 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1572,324 +2486,481 @@ type DeleteVpnGatewayResponse struct {
 }
 
 type ListRemoteAccessVpnsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	networkid   optString
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	publicipid  optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListRemoteAccessVpnsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if p.publicipid.ok {
+		u.Set("publicipid", p.publicipid.v)
 	}
 	return u
 }
 
-func (p *ListRemoteAccessVpnsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListRemoteAccessVpnsParams parses url.Values, for example taken from a raw API request,
+// into a new ListRemoteAccessVpnsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListRemoteAccessVpnsParams(u url.Values) (*ListRemoteAccessVpnsParams, error) {
+	p := &ListRemoteAccessVpnsParams{}
+	if err := checkParamNames("listRemoteAccessVpns", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "networkid", "page", "pagesize", "projectid", "publicipid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["publicipid"]; found {
+		p.SetPublicipid(u.Get("publicipid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListRemoteAccessVpnsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListRemoteAccessVpnsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListRemoteAccessVpnsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListRemoteAccessVpnsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListRemoteAccessVpnsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListRemoteAccessVpnsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListRemoteAccessVpnsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListRemoteAccessVpnsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListRemoteAccessVpnsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListRemoteAccessVpnsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListRemoteAccessVpnsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListRemoteAccessVpnsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListRemoteAccessVpnsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListRemoteAccessVpnsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListRemoteAccessVpnsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListRemoteAccessVpnsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListRemoteAccessVpnsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListRemoteAccessVpnsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListRemoteAccessVpnsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListRemoteAccessVpnsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.pagesize = optInt{v: v, ok: true}
+}
+
+// ResetPagesize unsets the pagesize param
+func (p *ListRemoteAccessVpnsParams) ResetPagesize() {
+	p.pagesize = optInt{}
+}
+
+// GetPagesize returns the pagesize param and if it is set
+func (p *ListRemoteAccessVpnsParams) GetPagesize() (int, bool) {
+	return p.pagesize.v, p.pagesize.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *ListRemoteAccessVpnsParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *ListRemoteAccessVpnsParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetPublicipid sets the publicipid param.
+func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
+	p.publicipid = optString{v: v, ok: true}
+}
+
+// ResetPublicipid unsets the publicipid param
+func (p *ListRemoteAccessVpnsParams) ResetPublicipid() {
+	p.publicipid = optString{}
+}
+
+// GetPublicipid returns the publicipid param and if it is set
+func (p *ListRemoteAccessVpnsParams) GetPublicipid() (string, bool) {
+	return p.publicipid.v, p.publicipid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListRemoteAccessVpnsParams) Clone() *ListRemoteAccessVpnsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListRemoteAccessVpnsParams) Equal(o *ListRemoteAccessVpnsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.publicipid == o.publicipid
+}
+
+// serializedListRemoteAccessVpnsParams is used to (un)marshal ListRemoteAccessVpnsParams using the API param names
+type serializedListRemoteAccessVpnsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publicipid  *string `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+}
+
+func (p *ListRemoteAccessVpnsParams) toSerialized() *serializedListRemoteAccessVpnsParams {
+	s := &serializedListRemoteAccessVpnsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.publicipid.ok {
+		s.Publicipid = &p.publicipid.v
+	}
+	return s
+}
+
+func (p *ListRemoteAccessVpnsParams) fromSerialized(s *serializedListRemoteAccessVpnsParams) {
+	*p = ListRemoteAccessVpnsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
 	}
-	p.p["pagesize"] = v
-}
-
-func (p *ListRemoteAccessVpnsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
+	if s.Page != nil {
+		p.SetPage(*s.Page)
 	}
-}
-
-func (p *ListRemoteAccessVpnsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
 	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
-}
-
-func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
 	}
-	p.p["projectid"] = v
-}
-
-func (p *ListRemoteAccessVpnsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
+	if s.Publicipid != nil {
+		p.SetPublicipid(*s.Publicipid)
 	}
 }
 
-func (p *ListRemoteAccessVpnsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListRemoteAccessVpnsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
 }
 
-func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListRemoteAccessVpnsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListRemoteAccessVpnsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["publicipid"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ListRemoteAccessVpnsParams) ResetPublicipid() {
-	if p.p != nil && p.p["publicipid"] != nil {
-		delete(p.p, "publicipid")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListRemoteAccessVpnsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ListRemoteAccessVpnsParams) GetPublicipid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListRemoteAccessVpnsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListRemoteAccessVpnsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["publicipid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListRemoteAccessVpnsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams {
 	p := &ListRemoteAccessVpnsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
 	p := &ListRemoteAccessVpnsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1917,9 +2988,9 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 	return nil, l.Count, fmt.Errorf("There is more then one result for RemoteAccessVpn UUID: %s!", id)
 }
 
-// Lists remote access vpns
-func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
-	resp, err := s.cs.newRequest("listRemoteAccessVpns", p.toURLValues())
+// Lists remote access vpns.
+func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams, opts ...CallOption) (*ListRemoteAccessVpnsResponse, error) {
+	resp, err := s.cs.newRequest("listRemoteAccessVpns", s.cs.encodeParams("listRemoteAccessVpns", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1955,300 +3026,451 @@ type RemoteAccessVpn struct {
 }
 
 type ListVpnConnectionsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVpnConnectionsParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	vpcid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVpnConnectionsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *ListVpnConnectionsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVpnConnectionsParams parses url.Values, for example taken from a raw API request,
+// into a new ListVpnConnectionsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVpnConnectionsParams(u url.Values) (*ListVpnConnectionsParams, error) {
+	p := &ListVpnConnectionsParams{}
+	if err := checkParamNames("listVpnConnections", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
 	}
-	p.p["account"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListVpnConnectionsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListVpnConnectionsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVpnConnectionsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVpnConnectionsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVpnConnectionsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVpnConnectionsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListVpnConnectionsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListVpnConnectionsParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListVpnConnectionsParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListVpnConnectionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVpnConnectionsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVpnConnectionsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVpnConnectionsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVpnConnectionsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVpnConnectionsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVpnConnectionsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVpnConnectionsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVpnConnectionsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVpnConnectionsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVpnConnectionsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVpnConnectionsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVpnConnectionsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVpnConnectionsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVpnConnectionsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVpnConnectionsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVpnConnectionsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVpnConnectionsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVpnConnectionsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVpnConnectionsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVpnConnectionsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListVpnConnectionsParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListVpnConnectionsParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListVpnConnectionsParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVpnConnectionsParams) Clone() *ListVpnConnectionsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVpnConnectionsParams) Equal(o *ListVpnConnectionsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.vpcid == o.vpcid
+}
+
+// serializedListVpnConnectionsParams is used to (un)marshal ListVpnConnectionsParams using the API param names
+type serializedListVpnConnectionsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *ListVpnConnectionsParams) toSerialized() *serializedListVpnConnectionsParams {
+	s := &serializedListVpnConnectionsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	return s
+}
+
+func (p *ListVpnConnectionsParams) fromSerialized(s *serializedListVpnConnectionsParams) {
+	*p = ListVpnConnectionsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVpnConnectionsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVpnConnectionsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVpnConnectionsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVpnConnectionsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVpnConnectionsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVpnConnectionsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVpnConnectionsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
 	p := &ListVpnConnectionsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error) {
 	p := &ListVpnConnectionsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2276,9 +3498,9 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 	return nil, l.Count, fmt.Errorf("There is more then one result for VpnConnection UUID: %s!", id)
 }
 
-// Lists site to site vpn connection gateways
-func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
-	resp, err := s.cs.newRequest("listVpnConnections", p.toURLValues())
+// Lists site to site vpn connection gateways.
+func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams, opts ...CallOption) (*ListVpnConnectionsResponse, error) {
+	resp, err := s.cs.newRequest("listVpnConnections", s.cs.encodeParams("listVpnConnections", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2327,251 +3549,387 @@ type VpnConnection struct {
 }
 
 type ListVpnCustomerGatewaysParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVpnCustomerGatewaysParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
 	return u
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVpnCustomerGatewaysParams parses url.Values, for example taken from a raw API request,
+// into a new ListVpnCustomerGatewaysParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVpnCustomerGatewaysParams(u url.Values) (*ListVpnCustomerGatewaysParams, error) {
+	p := &ListVpnCustomerGatewaysParams{}
+	if err := checkParamNames("listVpnCustomerGateways", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListVpnCustomerGatewaysParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVpnCustomerGatewaysParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVpnCustomerGatewaysParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListVpnCustomerGatewaysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVpnCustomerGatewaysParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVpnCustomerGatewaysParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVpnCustomerGatewaysParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVpnCustomerGatewaysParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVpnCustomerGatewaysParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVpnCustomerGatewaysParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVpnCustomerGatewaysParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVpnCustomerGatewaysParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVpnCustomerGatewaysParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVpnCustomerGatewaysParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVpnCustomerGatewaysParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVpnCustomerGatewaysParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVpnCustomerGatewaysParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVpnCustomerGatewaysParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.projectid.v, p.projectid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVpnCustomerGatewaysParams) Clone() *ListVpnCustomerGatewaysParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVpnCustomerGatewaysParams) Equal(o *ListVpnCustomerGatewaysParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid
+}
+
+// serializedListVpnCustomerGatewaysParams is used to (un)marshal ListVpnCustomerGatewaysParams using the API param names
+type serializedListVpnCustomerGatewaysParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+func (p *ListVpnCustomerGatewaysParams) toSerialized() *serializedListVpnCustomerGatewaysParams {
+	s := &serializedListVpnCustomerGatewaysParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	return s
+}
+
+func (p *ListVpnCustomerGatewaysParams) fromSerialized(s *serializedListVpnCustomerGatewaysParams) {
+	*p = ListVpnCustomerGatewaysParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
 	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVpnCustomerGatewaysParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVpnCustomerGatewaysParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVpnCustomerGatewaysParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVpnCustomerGatewaysParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVpnCustomerGatewaysParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVpnCustomerGatewaysParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVpnCustomerGatewaysParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams {
 	p := &ListVpnCustomerGatewaysParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVpnCustomerGatewaysParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2619,9 +3977,8 @@ func (s *VPNService) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
 	p := &ListVpnCustomerGatewaysParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2649,9 +4006,9 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 	return nil, l.Count, fmt.Errorf("There is more then one result for VpnCustomerGateway UUID: %s!", id)
 }
 
-// Lists site to site vpn customer gateways
-func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnCustomerGateways", p.toURLValues())
+// Lists site to site vpn customer gateways.
+func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams, opts ...CallOption) (*ListVpnCustomerGatewaysResponse, error) {
+	resp, err := s.cs.newRequest("listVpnCustomerGateways", s.cs.encodeParams("listVpnCustomerGateways", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2696,300 +4053,451 @@ type VpnCustomerGateway struct {
 }
 
 type ListVpnGatewaysParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVpnGatewaysParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	fordisplay  optBool
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	vpcid       optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVpnGatewaysParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
 	return u
 }
 
-func (p *ListVpnGatewaysParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVpnGatewaysParams parses url.Values, for example taken from a raw API request,
+// into a new ListVpnGatewaysParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVpnGatewaysParams(u url.Values) (*ListVpnGatewaysParams, error) {
+	p := &ListVpnGatewaysParams{}
+	if err := checkParamNames("listVpnGateways", u, "account", "domainid", "fordisplay", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "vpcid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
 	}
-	p.p["account"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListVpnGatewaysParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListVpnGatewaysParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVpnGatewaysParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVpnGatewaysParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVpnGatewaysParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVpnGatewaysParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *ListVpnGatewaysParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *ListVpnGatewaysParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *ListVpnGatewaysParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param.
 func (p *ListVpnGatewaysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVpnGatewaysParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVpnGatewaysParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVpnGatewaysParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVpnGatewaysParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVpnGatewaysParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVpnGatewaysParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVpnGatewaysParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVpnGatewaysParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVpnGatewaysParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVpnGatewaysParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVpnGatewaysParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVpnGatewaysParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVpnGatewaysParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVpnGatewaysParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVpnGatewaysParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVpnGatewaysParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVpnGatewaysParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVpnGatewaysParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVpnGatewaysParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVpnGatewaysParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListVpnGatewaysParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListVpnGatewaysParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListVpnGatewaysParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.vpcid.v, p.vpcid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVpnGatewaysParams) Clone() *ListVpnGatewaysParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVpnGatewaysParams) Equal(o *ListVpnGatewaysParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.vpcid == o.vpcid
+}
+
+// serializedListVpnGatewaysParams is used to (un)marshal ListVpnGatewaysParams using the API param names
+type serializedListVpnGatewaysParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+func (p *ListVpnGatewaysParams) toSerialized() *serializedListVpnGatewaysParams {
+	s := &serializedListVpnGatewaysParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	return s
+}
+
+func (p *ListVpnGatewaysParams) fromSerialized(s *serializedListVpnGatewaysParams) {
+	*p = ListVpnGatewaysParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVpnGatewaysParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVpnGatewaysParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVpnGatewaysParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVpnGatewaysParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVpnGatewaysParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVpnGatewaysParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVpnGatewaysParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
 	p := &ListVpnGatewaysParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
 	p := &ListVpnGatewaysParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3017,9 +4525,9 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 	return nil, l.Count, fmt.Errorf("There is more then one result for VpnGateway UUID: %s!", id)
 }
 
-// Lists site 2 site vpn gateways
-func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnGateways", p.toURLValues())
+// Lists site 2 site vpn gateways.
+func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams, opts ...CallOption) (*ListVpnGatewaysResponse, error) {
+	resp, err := s.cs.newRequest("listVpnGateways", s.cs.encodeParams("listVpnGateways", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3054,275 +4562,417 @@ type VpnGateway struct {
 }
 
 type ListVpnUsersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVpnUsersParams) toURLValues() url.Values {
+	account     optString
+	domainid    optString
+	id          optString
+	isrecursive optBool
+	keyword     optString
+	listall     optBool
+	page        optInt
+	pagesize    optInt
+	projectid   optString
+	username    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVpnUsersParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *ListVpnUsersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVpnUsersParams parses url.Values, for example taken from a raw API request,
+// into a new ListVpnUsersParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVpnUsersParams(u url.Values) (*ListVpnUsersParams, error) {
+	p := &ListVpnUsersParams{}
+	if err := checkParamNames("listVpnUsers", u, "account", "domainid", "id", "isrecursive", "keyword", "listall", "page", "pagesize", "projectid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ListVpnUsersParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ListVpnUsersParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVpnUsersParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVpnUsersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVpnUsersParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVpnUsersParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListVpnUsersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVpnUsersParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVpnUsersParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVpnUsersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVpnUsersParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVpnUsersParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVpnUsersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVpnUsersParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVpnUsersParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVpnUsersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVpnUsersParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVpnUsersParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVpnUsersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVpnUsersParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVpnUsersParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVpnUsersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVpnUsersParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVpnUsersParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVpnUsersParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVpnUsersParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVpnUsersParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetUsername sets the username param.
 func (p *ListVpnUsersParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *ListVpnUsersParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *ListVpnUsersParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVpnUsersParams) Clone() *ListVpnUsersParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVpnUsersParams) Equal(o *ListVpnUsersParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.projectid == o.projectid &&
+		p.username == o.username
+}
+
+// serializedListVpnUsersParams is used to (un)marshal ListVpnUsersParams using the API param names
+type serializedListVpnUsersParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Username    *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *ListVpnUsersParams) toSerialized() *serializedListVpnUsersParams {
+	s := &serializedListVpnUsersParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *ListVpnUsersParams) fromSerialized(s *serializedListVpnUsersParams) {
+	*p = ListVpnUsersParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVpnUsersParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVpnUsersParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVpnUsersParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVpnUsersParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVpnUsersParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVpnUsersParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVpnUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnUsersParams() *ListVpnUsersParams {
 	p := &ListVpnUsersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
 	p := &ListVpnUsersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3350,9 +5000,9 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 	return nil, l.Count, fmt.Errorf("There is more then one result for VpnUser UUID: %s!", id)
 }
 
-// Lists vpn users
-func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
-	resp, err := s.cs.newRequest("listVpnUsers", p.toURLValues())
+// Lists vpn users.
+func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams, opts ...CallOption) (*ListVpnUsersResponse, error) {
+	resp, err := s.cs.newRequest("listVpnUsers", s.cs.encodeParams("listVpnUsers", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3384,125 +5034,223 @@ type VpnUser struct {
 }
 
 type RemoveVpnUserParams struct {
-	p map[string]interface{}
+	account   optString
+	domainid  optString
+	projectid optString
+	username  optString
 }
 
-func (p *RemoveVpnUserParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveVpnUserParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if p.username.ok {
+		u.Set("username", p.username.v)
 	}
 	return u
 }
 
-func (p *RemoveVpnUserParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveVpnUserParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveVpnUserParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveVpnUserParams(u url.Values) (*RemoveVpnUserParams, error) {
+	p := &RemoveVpnUserParams{}
+	if err := checkParamNames("removeVpnUser", u, "account", "domainid", "projectid", "username"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["username"]; found {
+		p.SetUsername(u.Get("username"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *RemoveVpnUserParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *RemoveVpnUserParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *RemoveVpnUserParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *RemoveVpnUserParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *RemoveVpnUserParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *RemoveVpnUserParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *RemoveVpnUserParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *RemoveVpnUserParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *RemoveVpnUserParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetUsername sets the username param. This param is required.
 func (p *RemoveVpnUserParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+	p.username = optString{v: v, ok: true}
 }
 
+// ResetUsername unsets the username param
 func (p *RemoveVpnUserParams) ResetUsername() {
-	if p.p != nil && p.p["username"] != nil {
-		delete(p.p, "username")
-	}
+	p.username = optString{}
 }
 
+// GetUsername returns the username param and if it is set
 func (p *RemoveVpnUserParams) GetUsername() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.username.v, p.username.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveVpnUserParams) Clone() *RemoveVpnUserParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveVpnUserParams) Equal(o *RemoveVpnUserParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.projectid == o.projectid &&
+		p.username == o.username
+}
+
+// serializedRemoveVpnUserParams is used to (un)marshal RemoveVpnUserParams using the API param names
+type serializedRemoveVpnUserParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Username  *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+func (p *RemoveVpnUserParams) toSerialized() *serializedRemoveVpnUserParams {
+	s := &serializedRemoveVpnUserParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.username.ok {
+		s.Username = &p.username.v
+	}
+	return s
+}
+
+func (p *RemoveVpnUserParams) fromSerialized(s *serializedRemoveVpnUserParams) {
+	*p = RemoveVpnUserParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Username != nil {
+		p.SetUsername(*s.Username)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveVpnUserParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveVpnUserParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveVpnUserParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveVpnUserParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveVpnUserParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveVpnUserParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["username"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveVpnUserParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParams {
 	p := &RemoveVpnUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["username"] = username
+	p.SetUsername(username)
 	return p
 }
 
-// Removes vpn user
-func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("removeVpnUser", p.toURLValues())
+// Removes vpn user.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: username.
+func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams, opts ...CallOption) (*RemoveVpnUserResponse, error) {
+	resp, err := s.cs.newRequest("removeVpnUser", s.cs.encodeParams("removeVpnUser", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3513,8 +5261,8 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3538,101 +5286,193 @@ type RemoveVpnUserResponse struct {
 }
 
 type ResetVpnConnectionParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
+	id       optString
 }
 
-func (p *ResetVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResetVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ResetVpnConnectionParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseResetVpnConnectionParams parses url.Values, for example taken from a raw API request,
+// into a new ResetVpnConnectionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResetVpnConnectionParams(u url.Values) (*ResetVpnConnectionParams, error) {
+	p := &ResetVpnConnectionParams{}
+	if err := checkParamNames("resetVpnConnection", u, "account", "domainid", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *ResetVpnConnectionParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *ResetVpnConnectionParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ResetVpnConnectionParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ResetVpnConnectionParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ResetVpnConnectionParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ResetVpnConnectionParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ResetVpnConnectionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ResetVpnConnectionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ResetVpnConnectionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResetVpnConnectionParams) Clone() *ResetVpnConnectionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResetVpnConnectionParams) Equal(o *ResetVpnConnectionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id
+}
+
+// serializedResetVpnConnectionParams is used to (un)marshal ResetVpnConnectionParams using the API param names
+type serializedResetVpnConnectionParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ResetVpnConnectionParams) toSerialized() *serializedResetVpnConnectionParams {
+	s := &serializedResetVpnConnectionParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ResetVpnConnectionParams) fromSerialized(s *serializedResetVpnConnectionParams) {
+	*p = ResetVpnConnectionParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResetVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResetVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var s serializedResetVpnConnectionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResetVpnConnectionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResetVpnConnectionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResetVpnConnectionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ResetVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams {
 	p := &ResetVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Reset site to site vpn connection
-func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("resetVpnConnection", p.toURLValues())
+// Reset site to site vpn connection.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams, opts ...CallOption) (*ResetVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest("resetVpnConnection", s.cs.encodeParams("resetVpnConnection", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3643,8 +5483,8 @@ func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnC
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3696,102 +5536,197 @@ type ResetVpnConnectionResponse struct {
 }
 
 type UpdateRemoteAccessVpnParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateRemoteAccessVpnParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
+// ParseUpdateRemoteAccessVpnParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateRemoteAccessVpnParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateRemoteAccessVpnParams(u url.Values) (*UpdateRemoteAccessVpnParams, error) {
+	p := &UpdateRemoteAccessVpnParams{}
+	if err := checkParamNames("updateRemoteAccessVpn", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
 func (p *UpdateRemoteAccessVpnParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateRemoteAccessVpnParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateRemoteAccessVpnParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateRemoteAccessVpnParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateRemoteAccessVpnParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateRemoteAccessVpnParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateRemoteAccessVpnParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateRemoteAccessVpnParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateRemoteAccessVpnParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateRemoteAccessVpnParams) Clone() *UpdateRemoteAccessVpnParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateRemoteAccessVpnParams) Equal(o *UpdateRemoteAccessVpnParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateRemoteAccessVpnParams is used to (un)marshal UpdateRemoteAccessVpnParams using the API param names
+type serializedUpdateRemoteAccessVpnParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateRemoteAccessVpnParams) toSerialized() *serializedUpdateRemoteAccessVpnParams {
+	s := &serializedUpdateRemoteAccessVpnParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateRemoteAccessVpnParams) fromSerialized(s *serializedUpdateRemoteAccessVpnParams) {
+	*p = UpdateRemoteAccessVpnParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateRemoteAccessVpnParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateRemoteAccessVpnParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateRemoteAccessVpnParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateRemoteAccessVpnParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams {
 	p := &UpdateRemoteAccessVpnParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates remote access vpn
-func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("updateRemoteAccessVpn", p.toURLValues())
+// Updates remote access vpn.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams, opts ...CallOption) (*UpdateRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequest("updateRemoteAccessVpn", s.cs.encodeParams("updateRemoteAccessVpn", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3802,8 +5737,8 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3842,102 +5777,197 @@ type UpdateRemoteAccessVpnResponse struct {
 }
 
 type UpdateVpnConnectionParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVpnConnectionParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVpnConnectionParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVpnConnectionParams(u url.Values) (*UpdateVpnConnectionParams, error) {
+	p := &UpdateVpnConnectionParams{}
+	if err := checkParamNames("updateVpnConnection", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
 	}
-	p.p["customid"] = v
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetCustomid sets the customid param.
+func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
+}
+
+// ResetCustomid unsets the customid param
 func (p *UpdateVpnConnectionParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateVpnConnectionParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateVpnConnectionParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateVpnConnectionParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateVpnConnectionParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVpnConnectionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVpnConnectionParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVpnConnectionParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVpnConnectionParams) Clone() *UpdateVpnConnectionParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVpnConnectionParams) Equal(o *UpdateVpnConnectionParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateVpnConnectionParams is used to (un)marshal UpdateVpnConnectionParams using the API param names
+type serializedUpdateVpnConnectionParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateVpnConnectionParams) toSerialized() *serializedUpdateVpnConnectionParams {
+	s := &serializedUpdateVpnConnectionParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *UpdateVpnConnectionParams) fromSerialized(s *serializedUpdateVpnConnectionParams) {
+	*p = UpdateVpnConnectionParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVpnConnectionParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVpnConnectionParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVpnConnectionParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVpnConnectionParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams {
 	p := &UpdateVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates site to site vpn connection
-func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnConnection", p.toURLValues())
+// Updates site to site vpn connection.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams, opts ...CallOption) (*UpdateVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest("updateVpnConnection", s.cs.encodeParams("updateVpnConnection", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3948,8 +5978,8 @@ func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateV
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4001,399 +6031,579 @@ type UpdateVpnConnectionResponse struct {
 }
 
 type UpdateVpnCustomerGatewayParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
+	account          optString
+	cidrlist         optString
+	domainid         optString
+	dpd              optBool
+	esplifetime      optInt64
+	esppolicy        optString
+	forceencap       optBool
+	gateway          optString
+	id               optString
+	ikelifetime      optInt64
+	ikepolicy        optString
+	ikeversion       optString
+	ipsecpsk         optString
+	name             optString
+	splitconnections optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVpnCustomerGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		u.Set("cidrlist", v.(string))
+	if p.cidrlist.ok {
+		u.Set("cidrlist", p.cidrlist.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["dpd"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("dpd", vv)
+	if p.dpd.ok {
+		u.Set("dpd", strconv.FormatBool(p.dpd.v))
 	}
-	if v, found := p.p["esplifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("esplifetime", vv)
+	if p.esplifetime.ok {
+		u.Set("esplifetime", strconv.FormatInt(p.esplifetime.v, 10))
 	}
-	if v, found := p.p["esppolicy"]; found {
-		u.Set("esppolicy", v.(string))
+	if p.esppolicy.ok {
+		u.Set("esppolicy", p.esppolicy.v)
 	}
-	if v, found := p.p["forceencap"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forceencap", vv)
+	if p.forceencap.ok {
+		u.Set("forceencap", strconv.FormatBool(p.forceencap.v))
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if p.gateway.ok {
+		u.Set("gateway", p.gateway.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ikelifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("ikelifetime", vv)
+	if p.ikelifetime.ok {
+		u.Set("ikelifetime", strconv.FormatInt(p.ikelifetime.v, 10))
 	}
-	if v, found := p.p["ikepolicy"]; found {
-		u.Set("ikepolicy", v.(string))
+	if p.ikepolicy.ok {
+		u.Set("ikepolicy", p.ikepolicy.v)
 	}
-	if v, found := p.p["ikeversion"]; found {
-		u.Set("ikeversion", v.(string))
+	if p.ikeversion.ok {
+		u.Set("ikeversion", p.ikeversion.v)
 	}
-	if v, found := p.p["ipsecpsk"]; found {
-		u.Set("ipsecpsk", v.(string))
+	if p.ipsecpsk.ok {
+		u.Set("ipsecpsk", p.ipsecpsk.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["splitconnections"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("splitconnections", vv)
+	if p.splitconnections.ok {
+		u.Set("splitconnections", strconv.FormatBool(p.splitconnections.v))
 	}
 	return u
 }
 
-func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVpnCustomerGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVpnCustomerGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVpnCustomerGatewayParams(u url.Values) (*UpdateVpnCustomerGatewayParams, error) {
+	p := &UpdateVpnCustomerGatewayParams{}
+	if err := checkParamNames("updateVpnCustomerGateway", u, "account", "cidrlist", "domainid", "dpd", "esplifetime", "esppolicy", "forceencap", "gateway", "id", "ikelifetime", "ikepolicy", "ikeversion", "ipsecpsk", "name", "splitconnections"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["cidrlist"]; found {
+		p.SetCidrlist(u.Get("cidrlist"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["dpd"]; found {
+		v, err := strconv.ParseBool(u.Get("dpd"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param dpd: %v", err)
+		}
+		p.SetDpd(v)
+	}
+	if _, found := u["esplifetime"]; found {
+		v, err := strconv.ParseInt(u.Get("esplifetime"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param esplifetime: %v", err)
+		}
+		p.SetEsplifetime(v)
 	}
-	p.p["account"] = v
+	if _, found := u["esppolicy"]; found {
+		p.SetEsppolicy(u.Get("esppolicy"))
+	}
+	if _, found := u["forceencap"]; found {
+		v, err := strconv.ParseBool(u.Get("forceencap"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forceencap: %v", err)
+		}
+		p.SetForceencap(v)
+	}
+	if _, found := u["gateway"]; found {
+		p.SetGateway(u.Get("gateway"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ikelifetime"]; found {
+		v, err := strconv.ParseInt(u.Get("ikelifetime"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ikelifetime: %v", err)
+		}
+		p.SetIkelifetime(v)
+	}
+	if _, found := u["ikepolicy"]; found {
+		p.SetIkepolicy(u.Get("ikepolicy"))
+	}
+	if _, found := u["ikeversion"]; found {
+		p.SetIkeversion(u.Get("ikeversion"))
+	}
+	if _, found := u["ipsecpsk"]; found {
+		p.SetIpsecpsk(u.Get("ipsecpsk"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["splitconnections"]; found {
+		v, err := strconv.ParseBool(u.Get("splitconnections"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param splitconnections: %v", err)
+		}
+		p.SetSplitconnections(v)
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *UpdateVpnCustomerGatewayParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCidrlist sets the cidrlist param. This param is required.
 func (p *UpdateVpnCustomerGatewayParams) SetCidrlist(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+	p.cidrlist = optString{v: v, ok: true}
 }
 
+// ResetCidrlist unsets the cidrlist param
 func (p *UpdateVpnCustomerGatewayParams) ResetCidrlist() {
-	if p.p != nil && p.p["cidrlist"] != nil {
-		delete(p.p, "cidrlist")
-	}
+	p.cidrlist = optString{}
 }
 
+// GetCidrlist returns the cidrlist param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetCidrlist() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cidrlist"].(string)
-	return value, ok
+	return p.cidrlist.v, p.cidrlist.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UpdateVpnCustomerGatewayParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UpdateVpnCustomerGatewayParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetDpd sets the dpd param.
 func (p *UpdateVpnCustomerGatewayParams) SetDpd(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dpd"] = v
+	p.dpd = optBool{v: v, ok: true}
 }
 
+// ResetDpd unsets the dpd param
 func (p *UpdateVpnCustomerGatewayParams) ResetDpd() {
-	if p.p != nil && p.p["dpd"] != nil {
-		delete(p.p, "dpd")
-	}
+	p.dpd = optBool{}
 }
 
+// GetDpd returns the dpd param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetDpd() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dpd"].(bool)
-	return value, ok
+	return p.dpd.v, p.dpd.ok
 }
 
+// SetEsplifetime sets the esplifetime param.
 func (p *UpdateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["esplifetime"] = v
+	p.esplifetime = optInt64{v: v, ok: true}
 }
 
+// ResetEsplifetime unsets the esplifetime param
 func (p *UpdateVpnCustomerGatewayParams) ResetEsplifetime() {
-	if p.p != nil && p.p["esplifetime"] != nil {
-		delete(p.p, "esplifetime")
-	}
+	p.esplifetime = optInt64{}
 }
 
+// GetEsplifetime returns the esplifetime param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetEsplifetime() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["esplifetime"].(int64)
-	return value, ok
+	return p.esplifetime.v, p.esplifetime.ok
 }
 
+// SetEsppolicy sets the esppolicy param. This param is required.
 func (p *UpdateVpnCustomerGatewayParams) SetEsppolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["esppolicy"] = v
+	p.esppolicy = optString{v: v, ok: true}
 }
 
+// ResetEsppolicy unsets the esppolicy param
 func (p *UpdateVpnCustomerGatewayParams) ResetEsppolicy() {
-	if p.p != nil && p.p["esppolicy"] != nil {
-		delete(p.p, "esppolicy")
-	}
+	p.esppolicy = optString{}
 }
 
+// GetEsppolicy returns the esppolicy param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetEsppolicy() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["esppolicy"].(string)
-	return value, ok
+	return p.esppolicy.v, p.esppolicy.ok
 }
 
+// SetForceencap sets the forceencap param.
 func (p *UpdateVpnCustomerGatewayParams) SetForceencap(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forceencap"] = v
+	p.forceencap = optBool{v: v, ok: true}
 }
 
+// ResetForceencap unsets the forceencap param
 func (p *UpdateVpnCustomerGatewayParams) ResetForceencap() {
-	if p.p != nil && p.p["forceencap"] != nil {
-		delete(p.p, "forceencap")
-	}
+	p.forceencap = optBool{}
 }
 
+// GetForceencap returns the forceencap param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetForceencap() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forceencap"].(bool)
-	return value, ok
+	return p.forceencap.v, p.forceencap.ok
 }
 
+// SetGateway sets the gateway param. This param is required.
 func (p *UpdateVpnCustomerGatewayParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+	p.gateway = optString{v: v, ok: true}
 }
 
+// ResetGateway unsets the gateway param
 func (p *UpdateVpnCustomerGatewayParams) ResetGateway() {
-	if p.p != nil && p.p["gateway"] != nil {
-		delete(p.p, "gateway")
-	}
+	p.gateway = optString{}
 }
 
+// GetGateway returns the gateway param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetGateway() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["gateway"].(string)
-	return value, ok
+	return p.gateway.v, p.gateway.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVpnCustomerGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVpnCustomerGatewayParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIkelifetime sets the ikelifetime param.
 func (p *UpdateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ikelifetime"] = v
+	p.ikelifetime = optInt64{v: v, ok: true}
 }
 
+// ResetIkelifetime unsets the ikelifetime param
 func (p *UpdateVpnCustomerGatewayParams) ResetIkelifetime() {
-	if p.p != nil && p.p["ikelifetime"] != nil {
-		delete(p.p, "ikelifetime")
-	}
+	p.ikelifetime = optInt64{}
 }
 
+// GetIkelifetime returns the ikelifetime param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetIkelifetime() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ikelifetime"].(int64)
-	return value, ok
+	return p.ikelifetime.v, p.ikelifetime.ok
 }
 
+// SetIkepolicy sets the ikepolicy param. This param is required.
 func (p *UpdateVpnCustomerGatewayParams) SetIkepolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ikepolicy"] = v
+	p.ikepolicy = optString{v: v, ok: true}
 }
 
+// ResetIkepolicy unsets the ikepolicy param
 func (p *UpdateVpnCustomerGatewayParams) ResetIkepolicy() {
-	if p.p != nil && p.p["ikepolicy"] != nil {
-		delete(p.p, "ikepolicy")
-	}
+	p.ikepolicy = optString{}
 }
 
+// GetIkepolicy returns the ikepolicy param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetIkepolicy() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ikepolicy"].(string)
-	return value, ok
+	return p.ikepolicy.v, p.ikepolicy.ok
 }
 
+// SetIkeversion sets the ikeversion param.
 func (p *UpdateVpnCustomerGatewayParams) SetIkeversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ikeversion"] = v
+	p.ikeversion = optString{v: v, ok: true}
 }
 
+// ResetIkeversion unsets the ikeversion param
 func (p *UpdateVpnCustomerGatewayParams) ResetIkeversion() {
-	if p.p != nil && p.p["ikeversion"] != nil {
-		delete(p.p, "ikeversion")
-	}
+	p.ikeversion = optString{}
 }
 
+// GetIkeversion returns the ikeversion param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetIkeversion() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ikeversion"].(string)
-	return value, ok
+	return p.ikeversion.v, p.ikeversion.ok
 }
 
+// SetIpsecpsk sets the ipsecpsk param. This param is required.
 func (p *UpdateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipsecpsk"] = v
+	p.ipsecpsk = optString{v: v, ok: true}
 }
 
+// ResetIpsecpsk unsets the ipsecpsk param
 func (p *UpdateVpnCustomerGatewayParams) ResetIpsecpsk() {
-	if p.p != nil && p.p["ipsecpsk"] != nil {
-		delete(p.p, "ipsecpsk")
-	}
+	p.ipsecpsk = optString{}
 }
 
+// GetIpsecpsk returns the ipsecpsk param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetIpsecpsk() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipsecpsk"].(string)
-	return value, ok
+	return p.ipsecpsk.v, p.ipsecpsk.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateVpnCustomerGatewayParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateVpnCustomerGatewayParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetSplitconnections sets the splitconnections param.
 func (p *UpdateVpnCustomerGatewayParams) SetSplitconnections(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["splitconnections"] = v
+	p.splitconnections = optBool{v: v, ok: true}
 }
 
+// ResetSplitconnections unsets the splitconnections param
 func (p *UpdateVpnCustomerGatewayParams) ResetSplitconnections() {
-	if p.p != nil && p.p["splitconnections"] != nil {
-		delete(p.p, "splitconnections")
-	}
+	p.splitconnections = optBool{}
 }
 
+// GetSplitconnections returns the splitconnections param and if it is set
 func (p *UpdateVpnCustomerGatewayParams) GetSplitconnections() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.splitconnections.v, p.splitconnections.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVpnCustomerGatewayParams) Clone() *UpdateVpnCustomerGatewayParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVpnCustomerGatewayParams) Equal(o *UpdateVpnCustomerGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.cidrlist == o.cidrlist &&
+		p.domainid == o.domainid &&
+		p.dpd == o.dpd &&
+		p.esplifetime == o.esplifetime &&
+		p.esppolicy == o.esppolicy &&
+		p.forceencap == o.forceencap &&
+		p.gateway == o.gateway &&
+		p.id == o.id &&
+		p.ikelifetime == o.ikelifetime &&
+		p.ikepolicy == o.ikepolicy &&
+		p.ikeversion == o.ikeversion &&
+		p.ipsecpsk == o.ipsecpsk &&
+		p.name == o.name &&
+		p.splitconnections == o.splitconnections
+}
+
+// serializedUpdateVpnCustomerGatewayParams is used to (un)marshal UpdateVpnCustomerGatewayParams using the API param names
+type serializedUpdateVpnCustomerGatewayParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist         *string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Dpd              *bool   `json:"dpd,omitempty" yaml:"dpd,omitempty"`
+	Esplifetime      *int64  `json:"esplifetime,omitempty" yaml:"esplifetime,omitempty"`
+	Esppolicy        *string `json:"esppolicy,omitempty" yaml:"esppolicy,omitempty"`
+	Forceencap       *bool   `json:"forceencap,omitempty" yaml:"forceencap,omitempty"`
+	Gateway          *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ikelifetime      *int64  `json:"ikelifetime,omitempty" yaml:"ikelifetime,omitempty"`
+	Ikepolicy        *string `json:"ikepolicy,omitempty" yaml:"ikepolicy,omitempty"`
+	Ikeversion       *string `json:"ikeversion,omitempty" yaml:"ikeversion,omitempty"`
+	Ipsecpsk         *string `json:"ipsecpsk,omitempty" yaml:"ipsecpsk,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Splitconnections *bool   `json:"splitconnections,omitempty" yaml:"splitconnections,omitempty"`
+}
+
+func (p *UpdateVpnCustomerGatewayParams) toSerialized() *serializedUpdateVpnCustomerGatewayParams {
+	s := &serializedUpdateVpnCustomerGatewayParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.cidrlist.ok {
+		s.Cidrlist = &p.cidrlist.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.dpd.ok {
+		s.Dpd = &p.dpd.v
+	}
+	if p.esplifetime.ok {
+		s.Esplifetime = &p.esplifetime.v
+	}
+	if p.esppolicy.ok {
+		s.Esppolicy = &p.esppolicy.v
+	}
+	if p.forceencap.ok {
+		s.Forceencap = &p.forceencap.v
+	}
+	if p.gateway.ok {
+		s.Gateway = &p.gateway.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ikelifetime.ok {
+		s.Ikelifetime = &p.ikelifetime.v
+	}
+	if p.ikepolicy.ok {
+		s.Ikepolicy = &p.ikepolicy.v
+	}
+	if p.ikeversion.ok {
+		s.Ikeversion = &p.ikeversion.v
+	}
+	if p.ipsecpsk.ok {
+		s.Ipsecpsk = &p.ipsecpsk.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.splitconnections.ok {
+		s.Splitconnections = &p.splitconnections.v
+	}
+	return s
+}
+
+func (p *UpdateVpnCustomerGatewayParams) fromSerialized(s *serializedUpdateVpnCustomerGatewayParams) {
+	*p = UpdateVpnCustomerGatewayParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Cidrlist != nil {
+		p.SetCidrlist(*s.Cidrlist)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Dpd != nil {
+		p.SetDpd(*s.Dpd)
+	}
+	if s.Esplifetime != nil {
+		p.SetEsplifetime(*s.Esplifetime)
+	}
+	if s.Esppolicy != nil {
+		p.SetEsppolicy(*s.Esppolicy)
+	}
+	if s.Forceencap != nil {
+		p.SetForceencap(*s.Forceencap)
+	}
+	if s.Gateway != nil {
+		p.SetGateway(*s.Gateway)
 	}
-	value, ok := p.p["splitconnections"].(bool)
-	return value, ok
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ikelifetime != nil {
+		p.SetIkelifetime(*s.Ikelifetime)
+	}
+	if s.Ikepolicy != nil {
+		p.SetIkepolicy(*s.Ikepolicy)
+	}
+	if s.Ikeversion != nil {
+		p.SetIkeversion(*s.Ikeversion)
+	}
+	if s.Ipsecpsk != nil {
+		p.SetIpsecpsk(*s.Ipsecpsk)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Splitconnections != nil {
+		p.SetSplitconnections(*s.Splitconnections)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVpnCustomerGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVpnCustomerGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVpnCustomerGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVpnCustomerGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams {
 	p := &UpdateVpnCustomerGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidrlist"] = cidrlist
-	p.p["esppolicy"] = esppolicy
-	p.p["gateway"] = gateway
-	p.p["id"] = id
-	p.p["ikepolicy"] = ikepolicy
-	p.p["ipsecpsk"] = ipsecpsk
+	p.SetCidrlist(cidrlist)
+	p.SetEsppolicy(esppolicy)
+	p.SetGateway(gateway)
+	p.SetId(id)
+	p.SetIkepolicy(ikepolicy)
+	p.SetIpsecpsk(ipsecpsk)
 	return p
 }
 
-// Update site to site vpn customer gateway
-func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnCustomerGateway", p.toURLValues())
+// Update site to site vpn customer gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: cidrlist, esppolicy,
+// gateway, id, ikepolicy, ipsecpsk.
+func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams, opts ...CallOption) (*UpdateVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequest("updateVpnCustomerGateway", s.cs.encodeParams("updateVpnCustomerGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4404,8 +6614,8 @@ func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4453,102 +6663,197 @@ type UpdateVpnCustomerGatewayResponse struct {
 }
 
 type UpdateVpnGatewayParams struct {
-	p map[string]interface{}
+	customid   optString
+	fordisplay optBool
+	id         optString
 }
 
-func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVpnGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if p.fordisplay.ok {
+		u.Set("fordisplay", strconv.FormatBool(p.fordisplay.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVpnGatewayParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVpnGatewayParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVpnGatewayParams(u url.Values) (*UpdateVpnGatewayParams, error) {
+	p := &UpdateVpnGatewayParams{}
+	if err := checkParamNames("updateVpnGateway", u, "customid", "fordisplay", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["fordisplay"]; found {
+		v, err := strconv.ParseBool(u.Get("fordisplay"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param fordisplay: %v", err)
+		}
+		p.SetFordisplay(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["customid"] = v
+	return p, nil
+}
+
+// SetCustomid sets the customid param.
+func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateVpnGatewayParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateVpnGatewayParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetFordisplay sets the fordisplay param.
 func (p *UpdateVpnGatewayParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.fordisplay = optBool{v: v, ok: true}
 }
 
+// ResetFordisplay unsets the fordisplay param
 func (p *UpdateVpnGatewayParams) ResetFordisplay() {
-	if p.p != nil && p.p["fordisplay"] != nil {
-		delete(p.p, "fordisplay")
-	}
+	p.fordisplay = optBool{}
 }
 
+// GetFordisplay returns the fordisplay param and if it is set
 func (p *UpdateVpnGatewayParams) GetFordisplay() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["fordisplay"].(bool)
-	return value, ok
+	return p.fordisplay.v, p.fordisplay.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVpnGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVpnGatewayParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVpnGatewayParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVpnGatewayParams) Clone() *UpdateVpnGatewayParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVpnGatewayParams) Equal(o *UpdateVpnGatewayParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.customid == o.customid &&
+		p.fordisplay == o.fordisplay &&
+		p.id == o.id
+}
+
+// serializedUpdateVpnGatewayParams is used to (un)marshal UpdateVpnGatewayParams using the API param names
+type serializedUpdateVpnGatewayParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *UpdateVpnGatewayParams) toSerialized() *serializedUpdateVpnGatewayParams {
+	s := &serializedUpdateVpnGatewayParams{}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.fordisplay.ok {
+		s.Fordisplay = &p.fordisplay.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return s
+}
+
+func (p *UpdateVpnGatewayParams) fromSerialized(s *serializedUpdateVpnGatewayParams) {
+	*p = UpdateVpnGatewayParams{}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Fordisplay != nil {
+		p.SetFordisplay(*s.Fordisplay)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVpnGatewayParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVpnGatewayParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVpnGatewayParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVpnGatewayParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVpnGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams {
 	p := &UpdateVpnGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates site to site vpn local gateway
-func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnGateway", p.toURLValues())
+// Updates site to site vpn local gateway.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams, opts ...CallOption) (*UpdateVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequest("updateVpnGateway", s.cs.encodeParams("updateVpnGateway", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4559,8 +6864,8 @@ func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGate
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
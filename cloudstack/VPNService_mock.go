@@ -53,138 +53,183 @@ func (m *MockVPNServiceIface) EXPECT() *MockVPNServiceIfaceMockRecorder {
 }
 
 // AddVpnUser mocks base method.
-func (m *MockVPNServiceIface) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+func (m *MockVPNServiceIface) AddVpnUser(p *AddVpnUserParams, opts ...CallOption) (*AddVpnUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddVpnUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddVpnUser", varargs...)
 	ret0, _ := ret[0].(*AddVpnUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddVpnUser indicates an expected call of AddVpnUser.
-func (mr *MockVPNServiceIfaceMockRecorder) AddVpnUser(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) AddVpnUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpnUser", reflect.TypeOf((*MockVPNServiceIface)(nil).AddVpnUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpnUser", reflect.TypeOf((*MockVPNServiceIface)(nil).AddVpnUser), varargs...)
 }
 
 // CreateRemoteAccessVpn mocks base method.
-func (m *MockVPNServiceIface) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
+func (m *MockVPNServiceIface) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams, opts ...CallOption) (*CreateRemoteAccessVpnResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRemoteAccessVpn", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRemoteAccessVpn", varargs...)
 	ret0, _ := ret[0].(*CreateRemoteAccessVpnResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateRemoteAccessVpn indicates an expected call of CreateRemoteAccessVpn.
-func (mr *MockVPNServiceIfaceMockRecorder) CreateRemoteAccessVpn(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) CreateRemoteAccessVpn(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateRemoteAccessVpn), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateRemoteAccessVpn), varargs...)
 }
 
 // CreateVpnConnection mocks base method.
-func (m *MockVPNServiceIface) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+func (m *MockVPNServiceIface) CreateVpnConnection(p *CreateVpnConnectionParams, opts ...CallOption) (*CreateVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVpnConnection", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVpnConnection", varargs...)
 	ret0, _ := ret[0].(*CreateVpnConnectionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVpnConnection indicates an expected call of CreateVpnConnection.
-func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnConnection(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnConnection(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnConnection), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnConnection), varargs...)
 }
 
 // CreateVpnCustomerGateway mocks base method.
-func (m *MockVPNServiceIface) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+func (m *MockVPNServiceIface) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams, opts ...CallOption) (*CreateVpnCustomerGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVpnCustomerGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVpnCustomerGateway", varargs...)
 	ret0, _ := ret[0].(*CreateVpnCustomerGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVpnCustomerGateway indicates an expected call of CreateVpnCustomerGateway.
-func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnCustomerGateway(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnCustomerGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnCustomerGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnCustomerGateway), varargs...)
 }
 
 // CreateVpnGateway mocks base method.
-func (m *MockVPNServiceIface) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+func (m *MockVPNServiceIface) CreateVpnGateway(p *CreateVpnGatewayParams, opts ...CallOption) (*CreateVpnGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVpnGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVpnGateway", varargs...)
 	ret0, _ := ret[0].(*CreateVpnGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVpnGateway indicates an expected call of CreateVpnGateway.
-func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnGateway(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnGateway), varargs...)
 }
 
 // DeleteRemoteAccessVpn mocks base method.
-func (m *MockVPNServiceIface) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
+func (m *MockVPNServiceIface) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams, opts ...CallOption) (*DeleteRemoteAccessVpnResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRemoteAccessVpn", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRemoteAccessVpn", varargs...)
 	ret0, _ := ret[0].(*DeleteRemoteAccessVpnResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteRemoteAccessVpn indicates an expected call of DeleteRemoteAccessVpn.
-func (mr *MockVPNServiceIfaceMockRecorder) DeleteRemoteAccessVpn(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteRemoteAccessVpn(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteRemoteAccessVpn), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteRemoteAccessVpn), varargs...)
 }
 
 // DeleteVpnConnection mocks base method.
-func (m *MockVPNServiceIface) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+func (m *MockVPNServiceIface) DeleteVpnConnection(p *DeleteVpnConnectionParams, opts ...CallOption) (*DeleteVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVpnConnection", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVpnConnection", varargs...)
 	ret0, _ := ret[0].(*DeleteVpnConnectionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVpnConnection indicates an expected call of DeleteVpnConnection.
-func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnConnection(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnConnection(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnConnection), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnConnection), varargs...)
 }
 
 // DeleteVpnCustomerGateway mocks base method.
-func (m *MockVPNServiceIface) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+func (m *MockVPNServiceIface) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams, opts ...CallOption) (*DeleteVpnCustomerGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVpnCustomerGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVpnCustomerGateway", varargs...)
 	ret0, _ := ret[0].(*DeleteVpnCustomerGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVpnCustomerGateway indicates an expected call of DeleteVpnCustomerGateway.
-func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnCustomerGateway(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnCustomerGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnCustomerGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnCustomerGateway), varargs...)
 }
 
 // DeleteVpnGateway mocks base method.
-func (m *MockVPNServiceIface) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+func (m *MockVPNServiceIface) DeleteVpnGateway(p *DeleteVpnGatewayParams, opts ...CallOption) (*DeleteVpnGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVpnGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVpnGateway", varargs...)
 	ret0, _ := ret[0].(*DeleteVpnGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVpnGateway indicates an expected call of DeleteVpnGateway.
-func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnGateway(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnGateway), varargs...)
 }
 
 // GetRemoteAccessVpnByID mocks base method.
@@ -335,78 +380,103 @@ func (mr *MockVPNServiceIfaceMockRecorder) GetVpnUserByID(id interface{}, opts .
 }
 
 // ListRemoteAccessVpns mocks base method.
-func (m *MockVPNServiceIface) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+func (m *MockVPNServiceIface) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams, opts ...CallOption) (*ListRemoteAccessVpnsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRemoteAccessVpns", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRemoteAccessVpns", varargs...)
 	ret0, _ := ret[0].(*ListRemoteAccessVpnsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRemoteAccessVpns indicates an expected call of ListRemoteAccessVpns.
-func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpns(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpns(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpns", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpns), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpns", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpns), varargs...)
 }
 
 // ListVpnConnections mocks base method.
-func (m *MockVPNServiceIface) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+func (m *MockVPNServiceIface) ListVpnConnections(p *ListVpnConnectionsParams, opts ...CallOption) (*ListVpnConnectionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnConnections", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnConnections", varargs...)
 	ret0, _ := ret[0].(*ListVpnConnectionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVpnConnections indicates an expected call of ListVpnConnections.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnections(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnections(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnections", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnections), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnections", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnections), varargs...)
 }
 
 // ListVpnCustomerGateways mocks base method.
-func (m *MockVPNServiceIface) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+func (m *MockVPNServiceIface) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams, opts ...CallOption) (*ListVpnCustomerGatewaysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnCustomerGateways", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnCustomerGateways", varargs...)
 	ret0, _ := ret[0].(*ListVpnCustomerGatewaysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVpnCustomerGateways indicates an expected call of ListVpnCustomerGateways.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGateways(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGateways(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGateways), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGateways), varargs...)
 }
 
 // ListVpnGateways mocks base method.
-func (m *MockVPNServiceIface) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+func (m *MockVPNServiceIface) ListVpnGateways(p *ListVpnGatewaysParams, opts ...CallOption) (*ListVpnGatewaysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnGateways", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnGateways", varargs...)
 	ret0, _ := ret[0].(*ListVpnGatewaysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVpnGateways indicates an expected call of ListVpnGateways.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGateways(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGateways(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGateways), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGateways), varargs...)
 }
 
 // ListVpnUsers mocks base method.
-func (m *MockVPNServiceIface) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+func (m *MockVPNServiceIface) ListVpnUsers(p *ListVpnUsersParams, opts ...CallOption) (*ListVpnUsersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnUsers", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnUsers", varargs...)
 	ret0, _ := ret[0].(*ListVpnUsersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVpnUsers indicates an expected call of ListVpnUsers.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsers(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsers(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsers", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsers), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsers", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsers), varargs...)
 }
 
 // NewAddVpnUserParams mocks base method.
@@ -690,91 +760,121 @@ func (mr *MockVPNServiceIfaceMockRecorder) NewUpdateVpnGatewayParams(id interfac
 }
 
 // RemoveVpnUser mocks base method.
-func (m *MockVPNServiceIface) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+func (m *MockVPNServiceIface) RemoveVpnUser(p *RemoveVpnUserParams, opts ...CallOption) (*RemoveVpnUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveVpnUser", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveVpnUser", varargs...)
 	ret0, _ := ret[0].(*RemoveVpnUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveVpnUser indicates an expected call of RemoveVpnUser.
-func (mr *MockVPNServiceIfaceMockRecorder) RemoveVpnUser(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) RemoveVpnUser(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVpnUser", reflect.TypeOf((*MockVPNServiceIface)(nil).RemoveVpnUser), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVpnUser", reflect.TypeOf((*MockVPNServiceIface)(nil).RemoveVpnUser), varargs...)
 }
 
 // ResetVpnConnection mocks base method.
-func (m *MockVPNServiceIface) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+func (m *MockVPNServiceIface) ResetVpnConnection(p *ResetVpnConnectionParams, opts ...CallOption) (*ResetVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetVpnConnection", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetVpnConnection", varargs...)
 	ret0, _ := ret[0].(*ResetVpnConnectionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResetVpnConnection indicates an expected call of ResetVpnConnection.
-func (mr *MockVPNServiceIfaceMockRecorder) ResetVpnConnection(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) ResetVpnConnection(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).ResetVpnConnection), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).ResetVpnConnection), varargs...)
 }
 
 // UpdateRemoteAccessVpn mocks base method.
-func (m *MockVPNServiceIface) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
+func (m *MockVPNServiceIface) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams, opts ...CallOption) (*UpdateRemoteAccessVpnResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRemoteAccessVpn", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRemoteAccessVpn", varargs...)
 	ret0, _ := ret[0].(*UpdateRemoteAccessVpnResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateRemoteAccessVpn indicates an expected call of UpdateRemoteAccessVpn.
-func (mr *MockVPNServiceIfaceMockRecorder) UpdateRemoteAccessVpn(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateRemoteAccessVpn(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateRemoteAccessVpn), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateRemoteAccessVpn), varargs...)
 }
 
 // UpdateVpnConnection mocks base method.
-func (m *MockVPNServiceIface) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+func (m *MockVPNServiceIface) UpdateVpnConnection(p *UpdateVpnConnectionParams, opts ...CallOption) (*UpdateVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVpnConnection", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVpnConnection", varargs...)
 	ret0, _ := ret[0].(*UpdateVpnConnectionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVpnConnection indicates an expected call of UpdateVpnConnection.
-func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnConnection(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnConnection(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnConnection), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnConnection), varargs...)
 }
 
 // UpdateVpnCustomerGateway mocks base method.
-func (m *MockVPNServiceIface) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
+func (m *MockVPNServiceIface) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams, opts ...CallOption) (*UpdateVpnCustomerGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVpnCustomerGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVpnCustomerGateway", varargs...)
 	ret0, _ := ret[0].(*UpdateVpnCustomerGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVpnCustomerGateway indicates an expected call of UpdateVpnCustomerGateway.
-func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnCustomerGateway(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnCustomerGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnCustomerGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnCustomerGateway), varargs...)
 }
 
 // UpdateVpnGateway mocks base method.
-func (m *MockVPNServiceIface) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+func (m *MockVPNServiceIface) UpdateVpnGateway(p *UpdateVpnGatewayParams, opts ...CallOption) (*UpdateVpnGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVpnGateway", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVpnGateway", varargs...)
 	ret0, _ := ret[0].(*UpdateVpnGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVpnGateway indicates an expected call of UpdateVpnGateway.
-func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnGateway(p interface{}) *gomock.Call {
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnGateway(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnGateway), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnGateway), varargs...)
 }
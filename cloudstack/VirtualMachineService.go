@@ -28,57 +28,59 @@ import (
 )
 
 type VirtualMachineServiceIface interface {
-	AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachine(p *AddNicToVirtualMachineParams, opts ...CallOption) (*AddNicToVirtualMachineResponse, error)
 	NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams
-	AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	AssignVirtualMachine(p *AssignVirtualMachineParams, opts ...CallOption) (*AssignVirtualMachineResponse, error)
 	NewAssignVirtualMachineParams(virtualmachineid string) *AssignVirtualMachineParams
-	ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams, opts ...CallOption) (*ChangeServiceForVirtualMachineResponse, error)
 	NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams
-	CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservations(p *CleanVMReservationsParams, opts ...CallOption) (*CleanVMReservationsResponse, error)
 	NewCleanVMReservationsParams() *CleanVMReservationsParams
-	DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachine(p *DeployVirtualMachineParams, opts ...CallOption) (*DeployVirtualMachineResponse, error)
 	NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams
-	DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachine(p *DestroyVirtualMachineParams, opts ...CallOption) (*DestroyVirtualMachineResponse, error)
 	NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams
-	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams, opts ...CallOption) (*ExpungeVirtualMachineResponse, error)
 	NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams
-	GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	GetVMPassword(p *GetVMPasswordParams, opts ...CallOption) (*GetVMPasswordResponse, error)
 	NewGetVMPasswordParams(id string) *GetVMPasswordParams
-	ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachines(p *ListVirtualMachinesParams, opts ...CallOption) (*ListVirtualMachinesResponse, error)
 	NewListVirtualMachinesParams() *ListVirtualMachinesParams
 	GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error)
 	GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error)
 	GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
-	ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams, opts ...CallOption) (*ListVirtualMachinesMetricsResponse, error)
 	NewListVirtualMachinesMetricsParams() *ListVirtualMachinesMetricsParams
 	GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error)
 	GetVirtualMachinesMetricByName(name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
 	GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
-	MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachine(p *MigrateVirtualMachineParams, opts ...CallOption) (*MigrateVirtualMachineResponse, error)
 	NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams
-	MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams, opts ...CallOption) (*MigrateVirtualMachineWithVolumeResponse, error)
 	NewMigrateVirtualMachineWithVolumeParams(virtualmachineid string) *MigrateVirtualMachineWithVolumeParams
-	RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachine(p *RebootVirtualMachineParams, opts ...CallOption) (*RebootVirtualMachineResponse, error)
 	NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams
-	RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachine(p *RecoverVirtualMachineParams, opts ...CallOption) (*RecoverVirtualMachineResponse, error)
 	NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams
-	RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams, opts ...CallOption) (*RemoveNicFromVirtualMachineResponse, error)
 	NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams
-	ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams, opts ...CallOption) (*ResetPasswordForVirtualMachineResponse, error)
 	NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams
-	RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	ResetUserDataForVirtualMachine(p *ResetUserDataForVirtualMachineParams, opts ...CallOption) (*ResetUserDataForVirtualMachineResponse, error)
+	NewResetUserDataForVirtualMachineParams(id string) *ResetUserDataForVirtualMachineParams
+	RestoreVirtualMachine(p *RestoreVirtualMachineParams, opts ...CallOption) (*RestoreVirtualMachineResponse, error)
 	NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams
-	ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachine(p *ScaleVirtualMachineParams, opts ...CallOption) (*ScaleVirtualMachineResponse, error)
 	NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams
-	StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachine(p *StartVirtualMachineParams, opts ...CallOption) (*StartVirtualMachineResponse, error)
 	NewStartVirtualMachineParams(id string) *StartVirtualMachineParams
-	StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachine(p *StopVirtualMachineParams, opts ...CallOption) (*StopVirtualMachineResponse, error)
 	NewStopVirtualMachineParams(id string) *StopVirtualMachineParams
-	UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams, opts ...CallOption) (*UpdateDefaultNicForVirtualMachineResponse, error)
 	NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams
-	UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachine(p *UpdateVirtualMachineParams, opts ...CallOption) (*UpdateVirtualMachineResponse, error)
 	NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams
-	ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error)
+	ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams, opts ...CallOption) (*ListVirtualMachinesUsageHistoryResponse, error)
 	NewListVirtualMachinesUsageHistoryParams() *ListVirtualMachinesUsageHistoryParams
 	GetVirtualMachinesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error)
 	GetVirtualMachinesUsageHistoryByName(name string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error)
@@ -86,154 +88,262 @@ type VirtualMachineServiceIface interface {
 }
 
 type AddNicToVirtualMachineParams struct {
-	p map[string]interface{}
+	dhcpoptions      optStringMap
+	ipaddress        optString
+	macaddress       optString
+	networkid        optString
+	virtualmachineid optString
 }
 
-func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AddNicToVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["dhcpoptions"]; found {
-		m := v.(map[string]string)
+	if p.dhcpoptions.ok {
+		m := p.dhcpoptions.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("dhcpoptions[%d].key", i), k)
 			u.Set(fmt.Sprintf("dhcpoptions[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["macaddress"]; found {
-		u.Set("macaddress", v.(string))
+	if p.macaddress.ok {
+		u.Set("macaddress", p.macaddress.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *AddNicToVirtualMachineParams) SetDhcpoptions(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAddNicToVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new AddNicToVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAddNicToVirtualMachineParams(u url.Values) (*AddNicToVirtualMachineParams, error) {
+	p := &AddNicToVirtualMachineParams{}
+	if err := checkParamNames("addNicToVirtualMachine", u, "dhcpoptions[]", "ipaddress", "macaddress", "networkid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if m, err := parseKeyValueMap(u, "dhcpoptions", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDhcpoptions(m)
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if _, found := u["macaddress"]; found {
+		p.SetMacaddress(u.Get("macaddress"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
 	}
-	p.p["dhcpoptions"] = v
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetDhcpoptions sets the dhcpoptions param.
+func (p *AddNicToVirtualMachineParams) SetDhcpoptions(v map[string]string) {
+	p.dhcpoptions = optStringMap{v: v, ok: true}
 }
 
+// ResetDhcpoptions unsets the dhcpoptions param
 func (p *AddNicToVirtualMachineParams) ResetDhcpoptions() {
-	if p.p != nil && p.p["dhcpoptions"] != nil {
-		delete(p.p, "dhcpoptions")
-	}
+	p.dhcpoptions = optStringMap{}
 }
 
+// GetDhcpoptions returns the dhcpoptions param and if it is set
 func (p *AddNicToVirtualMachineParams) GetDhcpoptions() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dhcpoptions"].(map[string]string)
-	return value, ok
+	return p.dhcpoptions.v, p.dhcpoptions.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *AddNicToVirtualMachineParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *AddNicToVirtualMachineParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *AddNicToVirtualMachineParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetMacaddress sets the macaddress param.
 func (p *AddNicToVirtualMachineParams) SetMacaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["macaddress"] = v
+	p.macaddress = optString{v: v, ok: true}
 }
 
+// ResetMacaddress unsets the macaddress param
 func (p *AddNicToVirtualMachineParams) ResetMacaddress() {
-	if p.p != nil && p.p["macaddress"] != nil {
-		delete(p.p, "macaddress")
-	}
+	p.macaddress = optString{}
 }
 
+// GetMacaddress returns the macaddress param and if it is set
 func (p *AddNicToVirtualMachineParams) GetMacaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["macaddress"].(string)
-	return value, ok
+	return p.macaddress.v, p.macaddress.ok
 }
 
+// SetNetworkid sets the networkid param. This param is required.
 func (p *AddNicToVirtualMachineParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *AddNicToVirtualMachineParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *AddNicToVirtualMachineParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *AddNicToVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *AddNicToVirtualMachineParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *AddNicToVirtualMachineParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AddNicToVirtualMachineParams) Clone() *AddNicToVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.dhcpoptions = p.dhcpoptions.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AddNicToVirtualMachineParams) Equal(o *AddNicToVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.dhcpoptions.equal(o.dhcpoptions) &&
+		p.ipaddress == o.ipaddress &&
+		p.macaddress == o.macaddress &&
+		p.networkid == o.networkid &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedAddNicToVirtualMachineParams is used to (un)marshal AddNicToVirtualMachineParams using the API param names
+type serializedAddNicToVirtualMachineParams struct {
+	Dhcpoptions      *map[string]string `json:"dhcpoptions,omitempty" yaml:"dhcpoptions,omitempty"`
+	Ipaddress        *string            `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Macaddress       *string            `json:"macaddress,omitempty" yaml:"macaddress,omitempty"`
+	Networkid        *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Virtualmachineid *string            `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *AddNicToVirtualMachineParams) toSerialized() *serializedAddNicToVirtualMachineParams {
+	s := &serializedAddNicToVirtualMachineParams{}
+	if p.dhcpoptions.ok {
+		s.Dhcpoptions = &p.dhcpoptions.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.macaddress.ok {
+		s.Macaddress = &p.macaddress.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *AddNicToVirtualMachineParams) fromSerialized(s *serializedAddNicToVirtualMachineParams) {
+	*p = AddNicToVirtualMachineParams{}
+	if s.Dhcpoptions != nil {
+		p.SetDhcpoptions(*s.Dhcpoptions)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Macaddress != nil {
+		p.SetMacaddress(*s.Macaddress)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AddNicToVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AddNicToVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedAddNicToVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AddNicToVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AddNicToVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAddNicToVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AddNicToVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams {
 	p := &AddNicToVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetNetworkid(networkid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Adds VM to specified network by creating a NIC
-func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("addNicToVirtualMachine", p.toURLValues())
+// Adds VM to specified network by creating a NIC.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: networkid,
+// virtualmachineid.
+func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams, opts ...CallOption) (*AddNicToVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("addNicToVirtualMachine", s.cs.encodeParams("addNicToVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -244,8 +354,8 @@ func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachine
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -427,175 +537,287 @@ func (r *AddNicToVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type AssignVirtualMachineParams struct {
-	p map[string]interface{}
+	account          optString
+	domainid         optString
+	networkids       optStrings
+	projectid        optString
+	securitygroupids optStrings
+	virtualmachineid optString
 }
 
-func (p *AssignVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AssignVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["networkids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("networkids", vv)
+	if p.networkids.ok {
+		u.Set("networkids", strings.Join(p.networkids.v, ","))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["securitygroupids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("securitygroupids", vv)
+	if p.securitygroupids.ok {
+		u.Set("securitygroupids", strings.Join(p.securitygroupids.v, ","))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *AssignVirtualMachineParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAssignVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new AssignVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAssignVirtualMachineParams(u url.Values) (*AssignVirtualMachineParams, error) {
+	p := &AssignVirtualMachineParams{}
+	if err := checkParamNames("assignVirtualMachine", u, "account", "domainid", "networkids", "projectid", "securitygroupids", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
 	}
-	p.p["account"] = v
+	if _, found := u["networkids"]; found {
+		p.SetNetworkids(strings.Split(u.Get("networkids"), ","))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["securitygroupids"]; found {
+		p.SetSecuritygroupids(strings.Split(u.Get("securitygroupids"), ","))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *AssignVirtualMachineParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *AssignVirtualMachineParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *AssignVirtualMachineParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *AssignVirtualMachineParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *AssignVirtualMachineParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *AssignVirtualMachineParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetNetworkids sets the networkids param.
 func (p *AssignVirtualMachineParams) SetNetworkids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkids"] = v
+	p.networkids = optStrings{v: v, ok: true}
 }
 
+// ResetNetworkids unsets the networkids param
 func (p *AssignVirtualMachineParams) ResetNetworkids() {
-	if p.p != nil && p.p["networkids"] != nil {
-		delete(p.p, "networkids")
-	}
+	p.networkids = optStrings{}
 }
 
+// GetNetworkids returns the networkids param and if it is set
 func (p *AssignVirtualMachineParams) GetNetworkids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkids"].([]string)
-	return value, ok
+	return p.networkids.v, p.networkids.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *AssignVirtualMachineParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *AssignVirtualMachineParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *AssignVirtualMachineParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetSecuritygroupids sets the securitygroupids param.
 func (p *AssignVirtualMachineParams) SetSecuritygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupids"] = v
+	p.securitygroupids = optStrings{v: v, ok: true}
 }
 
+// ResetSecuritygroupids unsets the securitygroupids param
 func (p *AssignVirtualMachineParams) ResetSecuritygroupids() {
-	if p.p != nil && p.p["securitygroupids"] != nil {
-		delete(p.p, "securitygroupids")
-	}
+	p.securitygroupids = optStrings{}
 }
 
+// GetSecuritygroupids returns the securitygroupids param and if it is set
 func (p *AssignVirtualMachineParams) GetSecuritygroupids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupids"].([]string)
-	return value, ok
+	return p.securitygroupids.v, p.securitygroupids.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *AssignVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *AssignVirtualMachineParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *AssignVirtualMachineParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AssignVirtualMachineParams) Clone() *AssignVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.networkids = p.networkids.clone()
+	c.securitygroupids = p.securitygroupids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AssignVirtualMachineParams) Equal(o *AssignVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.networkids.equal(o.networkids) &&
+		p.projectid == o.projectid &&
+		p.securitygroupids.equal(o.securitygroupids) &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedAssignVirtualMachineParams is used to (un)marshal AssignVirtualMachineParams using the API param names
+type serializedAssignVirtualMachineParams struct {
+	Account          *string   `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string   `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Networkids       *[]string `json:"networkids,omitempty" yaml:"networkids,omitempty"`
+	Projectid        *string   `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Securitygroupids *[]string `json:"securitygroupids,omitempty" yaml:"securitygroupids,omitempty"`
+	Virtualmachineid *string   `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *AssignVirtualMachineParams) toSerialized() *serializedAssignVirtualMachineParams {
+	s := &serializedAssignVirtualMachineParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.networkids.ok {
+		s.Networkids = &p.networkids.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.securitygroupids.ok {
+		s.Securitygroupids = &p.securitygroupids.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *AssignVirtualMachineParams) fromSerialized(s *serializedAssignVirtualMachineParams) {
+	*p = AssignVirtualMachineParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Networkids != nil {
+		p.SetNetworkids(*s.Networkids)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Securitygroupids != nil {
+		p.SetSecuritygroupids(*s.Securitygroupids)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AssignVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AssignVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedAssignVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AssignVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AssignVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAssignVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AssignVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewAssignVirtualMachineParams(virtualmachineid string) *AssignVirtualMachineParams {
 	p := &AssignVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Change ownership of a VM from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign a VM from any account to any other account in any domain. A domain administrator can reassign a VM to any account in the same domain.
-func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("assignVirtualMachine", p.toURLValues())
+// Change ownership of a VM from one account to another. This API is available for Basic zones with
+// security groups and Advanced zones with guest networks. A root administrator can reassign a VM
+// from any account to any other account in any domain. A domain administrator can reassign a VM to
+// any account in the same domain.
+//
+// Required params: virtualmachineid.
+func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams, opts ...CallOption) (*AssignVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("assignVirtualMachine", s.cs.encodeParams("assignVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -769,205 +991,336 @@ func (r *AssignVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ChangeServiceForVirtualMachineParams struct {
-	p map[string]interface{}
+	automigrate       optBool
+	details           optStringMap
+	id                optString
+	maxiops           optInt64
+	miniops           optInt64
+	serviceofferingid optString
+	shrinkok          optBool
 }
 
-func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ChangeServiceForVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["automigrate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("automigrate", vv)
+	if p.automigrate.ok {
+		u.Set("automigrate", strconv.FormatBool(p.automigrate.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
+	if p.maxiops.ok {
+		u.Set("maxiops", strconv.FormatInt(p.maxiops.v, 10))
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
+	if p.miniops.ok {
+		u.Set("miniops", strconv.FormatInt(p.miniops.v, 10))
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["shrinkok"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("shrinkok", vv)
+	if p.shrinkok.ok {
+		u.Set("shrinkok", strconv.FormatBool(p.shrinkok.v))
 	}
 	return u
 }
 
-func (p *ChangeServiceForVirtualMachineParams) SetAutomigrate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseChangeServiceForVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new ChangeServiceForVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseChangeServiceForVirtualMachineParams(u url.Values) (*ChangeServiceForVirtualMachineParams, error) {
+	p := &ChangeServiceForVirtualMachineParams{}
+	if err := checkParamNames("changeServiceForVirtualMachine", u, "automigrate", "details[]", "id", "maxiops", "miniops", "serviceofferingid", "shrinkok"); err != nil {
+		return nil, err
+	}
+	if _, found := u["automigrate"]; found {
+		v, err := strconv.ParseBool(u.Get("automigrate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param automigrate: %v", err)
+		}
+		p.SetAutomigrate(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["maxiops"]; found {
+		v, err := strconv.ParseInt(u.Get("maxiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxiops: %v", err)
+		}
+		p.SetMaxiops(v)
+	}
+	if _, found := u["miniops"]; found {
+		v, err := strconv.ParseInt(u.Get("miniops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param miniops: %v", err)
+		}
+		p.SetMiniops(v)
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["shrinkok"]; found {
+		v, err := strconv.ParseBool(u.Get("shrinkok"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param shrinkok: %v", err)
+		}
+		p.SetShrinkok(v)
 	}
-	p.p["automigrate"] = v
+	return p, nil
+}
+
+// SetAutomigrate sets the automigrate param.
+func (p *ChangeServiceForVirtualMachineParams) SetAutomigrate(v bool) {
+	p.automigrate = optBool{v: v, ok: true}
 }
 
+// ResetAutomigrate unsets the automigrate param
 func (p *ChangeServiceForVirtualMachineParams) ResetAutomigrate() {
-	if p.p != nil && p.p["automigrate"] != nil {
-		delete(p.p, "automigrate")
-	}
+	p.automigrate = optBool{}
 }
 
+// GetAutomigrate returns the automigrate param and if it is set
 func (p *ChangeServiceForVirtualMachineParams) GetAutomigrate() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["automigrate"].(bool)
-	return value, ok
+	return p.automigrate.v, p.automigrate.ok
 }
 
+// SetDetails sets the details param.
 func (p *ChangeServiceForVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ChangeServiceForVirtualMachineParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ChangeServiceForVirtualMachineParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ChangeServiceForVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ChangeServiceForVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ChangeServiceForVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMaxiops sets the maxiops param.
 func (p *ChangeServiceForVirtualMachineParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
+	p.maxiops = optInt64{v: v, ok: true}
 }
 
+// ResetMaxiops unsets the maxiops param
 func (p *ChangeServiceForVirtualMachineParams) ResetMaxiops() {
-	if p.p != nil && p.p["maxiops"] != nil {
-		delete(p.p, "maxiops")
-	}
+	p.maxiops = optInt64{}
 }
 
+// GetMaxiops returns the maxiops param and if it is set
 func (p *ChangeServiceForVirtualMachineParams) GetMaxiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxiops"].(int64)
-	return value, ok
+	return p.maxiops.v, p.maxiops.ok
 }
 
+// SetMiniops sets the miniops param.
 func (p *ChangeServiceForVirtualMachineParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
+	p.miniops = optInt64{v: v, ok: true}
 }
 
+// ResetMiniops unsets the miniops param
 func (p *ChangeServiceForVirtualMachineParams) ResetMiniops() {
-	if p.p != nil && p.p["miniops"] != nil {
-		delete(p.p, "miniops")
-	}
+	p.miniops = optInt64{}
 }
 
+// GetMiniops returns the miniops param and if it is set
 func (p *ChangeServiceForVirtualMachineParams) GetMiniops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["miniops"].(int64)
-	return value, ok
+	return p.miniops.v, p.miniops.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ChangeServiceForVirtualMachineParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ChangeServiceForVirtualMachineParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetShrinkok sets the shrinkok param.
 func (p *ChangeServiceForVirtualMachineParams) SetShrinkok(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["shrinkok"] = v
+	p.shrinkok = optBool{v: v, ok: true}
 }
 
+// ResetShrinkok unsets the shrinkok param
 func (p *ChangeServiceForVirtualMachineParams) ResetShrinkok() {
-	if p.p != nil && p.p["shrinkok"] != nil {
-		delete(p.p, "shrinkok")
-	}
+	p.shrinkok = optBool{}
 }
 
+// GetShrinkok returns the shrinkok param and if it is set
 func (p *ChangeServiceForVirtualMachineParams) GetShrinkok() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.shrinkok.v, p.shrinkok.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ChangeServiceForVirtualMachineParams) Clone() *ChangeServiceForVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ChangeServiceForVirtualMachineParams) Equal(o *ChangeServiceForVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.automigrate == o.automigrate &&
+		p.details.equal(o.details) &&
+		p.id == o.id &&
+		p.maxiops == o.maxiops &&
+		p.miniops == o.miniops &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.shrinkok == o.shrinkok
+}
+
+// serializedChangeServiceForVirtualMachineParams is used to (un)marshal ChangeServiceForVirtualMachineParams using the API param names
+type serializedChangeServiceForVirtualMachineParams struct {
+	Automigrate       *bool              `json:"automigrate,omitempty" yaml:"automigrate,omitempty"`
+	Details           *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Maxiops           *int64             `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops           *int64             `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Serviceofferingid *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Shrinkok          *bool              `json:"shrinkok,omitempty" yaml:"shrinkok,omitempty"`
+}
+
+func (p *ChangeServiceForVirtualMachineParams) toSerialized() *serializedChangeServiceForVirtualMachineParams {
+	s := &serializedChangeServiceForVirtualMachineParams{}
+	if p.automigrate.ok {
+		s.Automigrate = &p.automigrate.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.maxiops.ok {
+		s.Maxiops = &p.maxiops.v
+	}
+	if p.miniops.ok {
+		s.Miniops = &p.miniops.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.shrinkok.ok {
+		s.Shrinkok = &p.shrinkok.v
+	}
+	return s
+}
+
+func (p *ChangeServiceForVirtualMachineParams) fromSerialized(s *serializedChangeServiceForVirtualMachineParams) {
+	*p = ChangeServiceForVirtualMachineParams{}
+	if s.Automigrate != nil {
+		p.SetAutomigrate(*s.Automigrate)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Maxiops != nil {
+		p.SetMaxiops(*s.Maxiops)
+	}
+	if s.Miniops != nil {
+		p.SetMiniops(*s.Miniops)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Shrinkok != nil {
+		p.SetShrinkok(*s.Shrinkok)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ChangeServiceForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ChangeServiceForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedChangeServiceForVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ChangeServiceForVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ChangeServiceForVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedChangeServiceForVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["shrinkok"].(bool)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ChangeServiceForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams {
 	p := &ChangeServiceForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// (This API is deprecated, use scaleVirtualMachine API)Changes the service offering for a virtual machine. The virtual machine must be in a "Stopped" state for this command to take effect.
-func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForVirtualMachine", p.toURLValues())
+// (This API is deprecated, use scaleVirtualMachine API)Changes the service offering for a virtual
+// machine. The virtual machine must be in a "Stopped" state for this command to take effect.
+//
+// Required params: id, serviceofferingid.
+func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams, opts ...CallOption) (*ChangeServiceForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("changeServiceForVirtualMachine", s.cs.encodeParams("changeServiceForVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1141,28 +1494,103 @@ func (r *ChangeServiceForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type CleanVMReservationsParams struct {
-	p map[string]interface{}
 }
 
-func (p *CleanVMReservationsParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CleanVMReservationsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
 	return u
 }
 
+// ParseCleanVMReservationsParams parses url.Values, for example taken from a raw API request,
+// into a new CleanVMReservationsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCleanVMReservationsParams(u url.Values) (*CleanVMReservationsParams, error) {
+	p := &CleanVMReservationsParams{}
+	if err := checkParamNames("cleanVMReservations", u); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Clone returns a deep copy of the params
+func (p *CleanVMReservationsParams) Clone() *CleanVMReservationsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CleanVMReservationsParams) Equal(o *CleanVMReservationsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return true
+}
+
+// serializedCleanVMReservationsParams is used to (un)marshal CleanVMReservationsParams using the API param names
+type serializedCleanVMReservationsParams struct {
+}
+
+func (p *CleanVMReservationsParams) toSerialized() *serializedCleanVMReservationsParams {
+	s := &serializedCleanVMReservationsParams{}
+	return s
+}
+
+func (p *CleanVMReservationsParams) fromSerialized(s *serializedCleanVMReservationsParams) {
+	*p = CleanVMReservationsParams{}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CleanVMReservationsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CleanVMReservationsParams) UnmarshalJSON(b []byte) error {
+	var s serializedCleanVMReservationsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CleanVMReservationsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CleanVMReservationsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCleanVMReservationsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
 // You should always use this function to get a new CleanVMReservationsParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservationsParams {
 	p := &CleanVMReservationsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Cleanups VM reservations in the database.
-func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
-	resp, err := s.cs.newRequest("cleanVMReservations", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams, opts ...CallOption) (*CleanVMReservationsResponse, error) {
+	resp, err := s.cs.newRequest("cleanVMReservations", s.cs.encodeParams("cleanVMReservations", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1173,8 +1601,8 @@ func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1198,1367 +1626,1778 @@ type CleanVMReservationsResponse struct {
 }
 
 type DeployVirtualMachineParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeployVirtualMachineParams) toURLValues() url.Values {
+	account                    optString
+	affinitygroupids           optStrings
+	affinitygroupnames         optStrings
+	bootintosetup              optBool
+	bootmode                   optString
+	boottype                   optString
+	clusterid                  optString
+	copyimagetags              optBool
+	customid                   optString
+	datadiskofferinglist       optStringMap
+	deploymentplanner          optString
+	details                    optStringMap
+	dhcpoptionsnetworklist     optStringMaps
+	diskofferingid             optString
+	displayname                optString
+	displayvm                  optBool
+	domainid                   optString
+	dynamicscalingenabled      optBool
+	extraconfig                optString
+	group                      optString
+	hostid                     optString
+	hypervisor                 optString
+	iodriverpolicy             optString
+	iothreadsenabled           optBool
+	ip6address                 optString
+	ipaddress                  optString
+	iptonetworklist            optStringMaps
+	keyboard                   optString
+	keypair                    optString
+	keypairs                   optStrings
+	macaddress                 optString
+	name                       optString
+	networkids                 optStrings
+	nicmultiqueuenumber        optInt
+	nicnetworklist             optStringMaps
+	nicpackedvirtqueuesenabled optBool
+	overridediskofferingid     optString
+	password                   optString
+	podid                      optString
+	projectid                  optString
+	properties                 optStringMap
+	rootdisksize               optInt64
+	securitygroupids           optStrings
+	securitygroupnames         optStrings
+	serviceofferingid          optString
+	size                       optInt64
+	startvm                    optBool
+	templateid                 optString
+	userdata                   optString
+	userdatadetails            optStringMap
+	userdataid                 optString
+	zoneid                     optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeployVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["affinitygroupids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("affinitygroupids", vv)
+	if p.affinitygroupids.ok {
+		u.Set("affinitygroupids", strings.Join(p.affinitygroupids.v, ","))
 	}
-	if v, found := p.p["affinitygroupnames"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("affinitygroupnames", vv)
+	if p.affinitygroupnames.ok {
+		u.Set("affinitygroupnames", strings.Join(p.affinitygroupnames.v, ","))
 	}
-	if v, found := p.p["bootintosetup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootintosetup", vv)
+	if p.bootintosetup.ok {
+		u.Set("bootintosetup", strconv.FormatBool(p.bootintosetup.v))
 	}
-	if v, found := p.p["bootmode"]; found {
-		u.Set("bootmode", v.(string))
+	if p.bootmode.ok {
+		u.Set("bootmode", p.bootmode.v)
 	}
-	if v, found := p.p["boottype"]; found {
-		u.Set("boottype", v.(string))
+	if p.boottype.ok {
+		u.Set("boottype", p.boottype.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["copyimagetags"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("copyimagetags", vv)
+	if p.copyimagetags.ok {
+		u.Set("copyimagetags", strconv.FormatBool(p.copyimagetags.v))
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["datadiskofferinglist"]; found {
-		m := v.(map[string]string)
+	if p.datadiskofferinglist.ok {
+		m := p.datadiskofferinglist.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("datadiskofferinglist[%d].key", i), k)
 			u.Set(fmt.Sprintf("datadiskofferinglist[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["deploymentplanner"]; found {
-		u.Set("deploymentplanner", v.(string))
+	if p.deploymentplanner.ok {
+		u.Set("deploymentplanner", p.deploymentplanner.v)
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["dhcpoptionsnetworklist"]; found {
-		l := v.([]map[string]string)
-		for i, m := range l {
+	if p.dhcpoptionsnetworklist.ok {
+		for i, m := range p.dhcpoptionsnetworklist.v {
 			for key, val := range m {
 				u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].%s", i, key), val)
 			}
 		}
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["displayname"]; found {
-		u.Set("displayname", v.(string))
+	if p.displayname.ok {
+		u.Set("displayname", p.displayname.v)
 	}
-	if v, found := p.p["displayvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvm", vv)
+	if p.displayvm.ok {
+		u.Set("displayvm", strconv.FormatBool(p.displayvm.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["dynamicscalingenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("dynamicscalingenabled", vv)
+	if p.dynamicscalingenabled.ok {
+		u.Set("dynamicscalingenabled", strconv.FormatBool(p.dynamicscalingenabled.v))
 	}
-	if v, found := p.p["extraconfig"]; found {
-		u.Set("extraconfig", v.(string))
+	if p.extraconfig.ok {
+		u.Set("extraconfig", p.extraconfig.v)
 	}
-	if v, found := p.p["group"]; found {
-		u.Set("group", v.(string))
+	if p.group.ok {
+		u.Set("group", p.group.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["iodriverpolicy"]; found {
-		u.Set("iodriverpolicy", v.(string))
+	if p.iodriverpolicy.ok {
+		u.Set("iodriverpolicy", p.iodriverpolicy.v)
 	}
-	if v, found := p.p["iothreadsenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("iothreadsenabled", vv)
+	if p.iothreadsenabled.ok {
+		u.Set("iothreadsenabled", strconv.FormatBool(p.iothreadsenabled.v))
 	}
-	if v, found := p.p["ip6address"]; found {
-		u.Set("ip6address", v.(string))
+	if p.ip6address.ok {
+		u.Set("ip6address", p.ip6address.v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if p.ipaddress.ok {
+		u.Set("ipaddress", p.ipaddress.v)
 	}
-	if v, found := p.p["iptonetworklist"]; found {
-		l := v.([]map[string]string)
-		for i, m := range l {
+	if p.iptonetworklist.ok {
+		for i, m := range p.iptonetworklist.v {
 			for key, val := range m {
 				u.Set(fmt.Sprintf("iptonetworklist[%d].%s", i, key), val)
 			}
 		}
 	}
-	if v, found := p.p["keyboard"]; found {
-		u.Set("keyboard", v.(string))
+	if p.keyboard.ok {
+		u.Set("keyboard", p.keyboard.v)
 	}
-	if v, found := p.p["keypair"]; found {
-		u.Set("keypair", v.(string))
+	if p.keypair.ok {
+		u.Set("keypair", p.keypair.v)
 	}
-	if v, found := p.p["keypairs"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("keypairs", vv)
+	if p.keypairs.ok {
+		u.Set("keypairs", strings.Join(p.keypairs.v, ","))
 	}
-	if v, found := p.p["macaddress"]; found {
-		u.Set("macaddress", v.(string))
+	if p.macaddress.ok {
+		u.Set("macaddress", p.macaddress.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("networkids", vv)
+	if p.networkids.ok {
+		u.Set("networkids", strings.Join(p.networkids.v, ","))
 	}
-	if v, found := p.p["nicmultiqueuenumber"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("nicmultiqueuenumber", vv)
+	if p.nicmultiqueuenumber.ok {
+		u.Set("nicmultiqueuenumber", strconv.Itoa(p.nicmultiqueuenumber.v))
 	}
-	if v, found := p.p["nicnetworklist"]; found {
-		l := v.([]map[string]string)
-		for i, m := range l {
+	if p.nicnetworklist.ok {
+		for i, m := range p.nicnetworklist.v {
 			for key, val := range m {
 				u.Set(fmt.Sprintf("nicnetworklist[%d].%s", i, key), val)
 			}
 		}
 	}
-	if v, found := p.p["nicpackedvirtqueuesenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("nicpackedvirtqueuesenabled", vv)
+	if p.nicpackedvirtqueuesenabled.ok {
+		u.Set("nicpackedvirtqueuesenabled", strconv.FormatBool(p.nicpackedvirtqueuesenabled.v))
 	}
-	if v, found := p.p["overridediskofferingid"]; found {
-		u.Set("overridediskofferingid", v.(string))
+	if p.overridediskofferingid.ok {
+		u.Set("overridediskofferingid", p.overridediskofferingid.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["properties"]; found {
-		m := v.(map[string]string)
+	if p.properties.ok {
+		m := p.properties.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("properties[%d].key", i), k)
 			u.Set(fmt.Sprintf("properties[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["rootdisksize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("rootdisksize", vv)
+	if p.rootdisksize.ok {
+		u.Set("rootdisksize", strconv.FormatInt(p.rootdisksize.v, 10))
 	}
-	if v, found := p.p["securitygroupids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("securitygroupids", vv)
+	if p.securitygroupids.ok {
+		u.Set("securitygroupids", strings.Join(p.securitygroupids.v, ","))
 	}
-	if v, found := p.p["securitygroupnames"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("securitygroupnames", vv)
+	if p.securitygroupnames.ok {
+		u.Set("securitygroupnames", strings.Join(p.securitygroupnames.v, ","))
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
+	if p.size.ok {
+		u.Set("size", strconv.FormatInt(p.size.v, 10))
 	}
-	if v, found := p.p["startvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("startvm", vv)
+	if p.startvm.ok {
+		u.Set("startvm", strconv.FormatBool(p.startvm.v))
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["userdata"]; found {
-		u.Set("userdata", v.(string))
+	if p.userdata.ok {
+		u.Set("userdata", p.userdata.v)
 	}
-	if v, found := p.p["userdatadetails"]; found {
-		m := v.(map[string]string)
+	if p.userdatadetails.ok {
+		m := p.userdatadetails.v
 		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("userdatadetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("userdatadetails[%d].value", i), m[k])
+			u.Set(fmt.Sprintf("userdatadetails[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["userdataid"]; found {
-		u.Set("userdataid", v.(string))
+	if p.userdataid.ok {
+		u.Set("userdataid", p.userdataid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *DeployVirtualMachineParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeployVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new DeployVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeployVirtualMachineParams(u url.Values) (*DeployVirtualMachineParams, error) {
+	p := &DeployVirtualMachineParams{}
+	if err := checkParamNames("deployVirtualMachine", u, "account", "affinitygroupids", "affinitygroupnames", "bootintosetup", "bootmode", "boottype", "clusterid", "copyimagetags", "customid", "datadiskofferinglist[]", "deploymentplanner", "details[]", "dhcpoptionsnetworklist[]", "diskofferingid", "displayname", "displayvm", "domainid", "dynamicscalingenabled", "extraconfig", "group", "hostid", "hypervisor", "iodriverpolicy", "iothreadsenabled", "ip6address", "ipaddress", "iptonetworklist[]", "keyboard", "keypair", "keypairs", "macaddress", "name", "networkids", "nicmultiqueuenumber", "nicnetworklist[]", "nicpackedvirtqueuesenabled", "overridediskofferingid", "password", "podid", "projectid", "properties[]", "rootdisksize", "securitygroupids", "securitygroupnames", "serviceofferingid", "size", "startvm", "templateid", "userdata", "userdatadetails[]", "userdataid", "zoneid"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
-}
-
-func (p *DeployVirtualMachineParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-}
-
-func (p *DeployVirtualMachineParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["affinitygroupids"]; found {
+		p.SetAffinitygroupids(strings.Split(u.Get("affinitygroupids"), ","))
 	}
-	value, ok := p.p["account"].(string)
-	return value, ok
-}
-
-func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["affinitygroupnames"]; found {
+		p.SetAffinitygroupnames(strings.Split(u.Get("affinitygroupnames"), ","))
 	}
-	p.p["affinitygroupids"] = v
-}
-
-func (p *DeployVirtualMachineParams) ResetAffinitygroupids() {
-	if p.p != nil && p.p["affinitygroupids"] != nil {
-		delete(p.p, "affinitygroupids")
+	if _, found := u["bootintosetup"]; found {
+		v, err := strconv.ParseBool(u.Get("bootintosetup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bootintosetup: %v", err)
+		}
+		p.SetBootintosetup(v)
 	}
-}
-
-func (p *DeployVirtualMachineParams) GetAffinitygroupids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["bootmode"]; found {
+		p.SetBootmode(u.Get("bootmode"))
 	}
-	value, ok := p.p["affinitygroupids"].([]string)
-	return value, ok
-}
-
-func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["boottype"]; found {
+		p.SetBoottype(u.Get("boottype"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["copyimagetags"]; found {
+		v, err := strconv.ParseBool(u.Get("copyimagetags"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param copyimagetags: %v", err)
+		}
+		p.SetCopyimagetags(v)
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if m, err := parseKeyValueMap(u, "datadiskofferinglist", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDatadiskofferinglist(m)
+	}
+	if _, found := u["deploymentplanner"]; found {
+		p.SetDeploymentplanner(u.Get("deploymentplanner"))
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if l, err := parseIndexedValues(u, "dhcpoptionsnetworklist"); err != nil {
+		return nil, err
+	} else if len(l) > 0 {
+		p.SetDhcpoptionsnetworklist(l)
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["displayname"]; found {
+		p.SetDisplayname(u.Get("displayname"))
+	}
+	if _, found := u["displayvm"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvm: %v", err)
+		}
+		p.SetDisplayvm(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["dynamicscalingenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("dynamicscalingenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param dynamicscalingenabled: %v", err)
+		}
+		p.SetDynamicscalingenabled(v)
+	}
+	if _, found := u["extraconfig"]; found {
+		p.SetExtraconfig(u.Get("extraconfig"))
+	}
+	if _, found := u["group"]; found {
+		p.SetGroup(u.Get("group"))
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["iodriverpolicy"]; found {
+		p.SetIodriverpolicy(u.Get("iodriverpolicy"))
+	}
+	if _, found := u["iothreadsenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("iothreadsenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param iothreadsenabled: %v", err)
+		}
+		p.SetIothreadsenabled(v)
+	}
+	if _, found := u["ip6address"]; found {
+		p.SetIp6address(u.Get("ip6address"))
+	}
+	if _, found := u["ipaddress"]; found {
+		p.SetIpaddress(u.Get("ipaddress"))
+	}
+	if l, err := parseIndexedValues(u, "iptonetworklist"); err != nil {
+		return nil, err
+	} else if len(l) > 0 {
+		p.SetIptonetworklist(l)
+	}
+	if _, found := u["keyboard"]; found {
+		p.SetKeyboard(u.Get("keyboard"))
+	}
+	if _, found := u["keypair"]; found {
+		p.SetKeypair(u.Get("keypair"))
+	}
+	if _, found := u["keypairs"]; found {
+		p.SetKeypairs(strings.Split(u.Get("keypairs"), ","))
+	}
+	if _, found := u["macaddress"]; found {
+		p.SetMacaddress(u.Get("macaddress"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkids"]; found {
+		p.SetNetworkids(strings.Split(u.Get("networkids"), ","))
+	}
+	if _, found := u["nicmultiqueuenumber"]; found {
+		v, err := strconv.Atoi(u.Get("nicmultiqueuenumber"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param nicmultiqueuenumber: %v", err)
+		}
+		p.SetNicmultiqueuenumber(v)
+	}
+	if l, err := parseIndexedValues(u, "nicnetworklist"); err != nil {
+		return nil, err
+	} else if len(l) > 0 {
+		p.SetNicnetworklist(l)
+	}
+	if _, found := u["nicpackedvirtqueuesenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("nicpackedvirtqueuesenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param nicpackedvirtqueuesenabled: %v", err)
+		}
+		p.SetNicpackedvirtqueuesenabled(v)
+	}
+	if _, found := u["overridediskofferingid"]; found {
+		p.SetOverridediskofferingid(u.Get("overridediskofferingid"))
+	}
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if m, err := parseKeyValueMap(u, "properties", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetProperties(m)
+	}
+	if _, found := u["rootdisksize"]; found {
+		v, err := strconv.ParseInt(u.Get("rootdisksize"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param rootdisksize: %v", err)
+		}
+		p.SetRootdisksize(v)
+	}
+	if _, found := u["securitygroupids"]; found {
+		p.SetSecuritygroupids(strings.Split(u.Get("securitygroupids"), ","))
+	}
+	if _, found := u["securitygroupnames"]; found {
+		p.SetSecuritygroupnames(strings.Split(u.Get("securitygroupnames"), ","))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["size"]; found {
+		v, err := strconv.ParseInt(u.Get("size"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param size: %v", err)
+		}
+		p.SetSize(v)
+	}
+	if _, found := u["startvm"]; found {
+		v, err := strconv.ParseBool(u.Get("startvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param startvm: %v", err)
+		}
+		p.SetStartvm(v)
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["userdata"]; found {
+		p.SetUserdata(u.Get("userdata"))
+	}
+	if m, err := parseIndexedMap(u, "userdatadetails", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetUserdatadetails(m)
+	}
+	if _, found := u["userdataid"]; found {
+		p.SetUserdataid(u.Get("userdataid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["affinitygroupnames"] = v
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *DeployVirtualMachineParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *DeployVirtualMachineParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *DeployVirtualMachineParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetAffinitygroupids sets the affinitygroupids param.
+func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
+	p.affinitygroupids = optStrings{v: v, ok: true}
+}
+
+// ResetAffinitygroupids unsets the affinitygroupids param
+func (p *DeployVirtualMachineParams) ResetAffinitygroupids() {
+	p.affinitygroupids = optStrings{}
+}
+
+// GetAffinitygroupids returns the affinitygroupids param and if it is set
+func (p *DeployVirtualMachineParams) GetAffinitygroupids() ([]string, bool) {
+	return p.affinitygroupids.v, p.affinitygroupids.ok
+}
+
+// SetAffinitygroupnames sets the affinitygroupnames param.
+func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
+	p.affinitygroupnames = optStrings{v: v, ok: true}
 }
 
+// ResetAffinitygroupnames unsets the affinitygroupnames param
 func (p *DeployVirtualMachineParams) ResetAffinitygroupnames() {
-	if p.p != nil && p.p["affinitygroupnames"] != nil {
-		delete(p.p, "affinitygroupnames")
-	}
+	p.affinitygroupnames = optStrings{}
 }
 
+// GetAffinitygroupnames returns the affinitygroupnames param and if it is set
 func (p *DeployVirtualMachineParams) GetAffinitygroupnames() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupnames"].([]string)
-	return value, ok
+	return p.affinitygroupnames.v, p.affinitygroupnames.ok
 }
 
+// SetBootintosetup sets the bootintosetup param.
 func (p *DeployVirtualMachineParams) SetBootintosetup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bootintosetup"] = v
+	p.bootintosetup = optBool{v: v, ok: true}
 }
 
+// ResetBootintosetup unsets the bootintosetup param
 func (p *DeployVirtualMachineParams) ResetBootintosetup() {
-	if p.p != nil && p.p["bootintosetup"] != nil {
-		delete(p.p, "bootintosetup")
-	}
+	p.bootintosetup = optBool{}
 }
 
+// GetBootintosetup returns the bootintosetup param and if it is set
 func (p *DeployVirtualMachineParams) GetBootintosetup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootintosetup"].(bool)
-	return value, ok
+	return p.bootintosetup.v, p.bootintosetup.ok
 }
 
+// SetBootmode sets the bootmode param.
 func (p *DeployVirtualMachineParams) SetBootmode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bootmode"] = v
+	p.bootmode = optString{v: v, ok: true}
 }
 
+// ResetBootmode unsets the bootmode param
 func (p *DeployVirtualMachineParams) ResetBootmode() {
-	if p.p != nil && p.p["bootmode"] != nil {
-		delete(p.p, "bootmode")
-	}
+	p.bootmode = optString{}
 }
 
+// GetBootmode returns the bootmode param and if it is set
 func (p *DeployVirtualMachineParams) GetBootmode() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootmode"].(string)
-	return value, ok
+	return p.bootmode.v, p.bootmode.ok
 }
 
+// SetBoottype sets the boottype param.
 func (p *DeployVirtualMachineParams) SetBoottype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["boottype"] = v
+	p.boottype = optString{v: v, ok: true}
 }
 
+// ResetBoottype unsets the boottype param
 func (p *DeployVirtualMachineParams) ResetBoottype() {
-	if p.p != nil && p.p["boottype"] != nil {
-		delete(p.p, "boottype")
-	}
+	p.boottype = optString{}
 }
 
+// GetBoottype returns the boottype param and if it is set
 func (p *DeployVirtualMachineParams) GetBoottype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["boottype"].(string)
-	return value, ok
+	return p.boottype.v, p.boottype.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *DeployVirtualMachineParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *DeployVirtualMachineParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *DeployVirtualMachineParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetCopyimagetags sets the copyimagetags param.
 func (p *DeployVirtualMachineParams) SetCopyimagetags(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["copyimagetags"] = v
+	p.copyimagetags = optBool{v: v, ok: true}
 }
 
+// ResetCopyimagetags unsets the copyimagetags param
 func (p *DeployVirtualMachineParams) ResetCopyimagetags() {
-	if p.p != nil && p.p["copyimagetags"] != nil {
-		delete(p.p, "copyimagetags")
-	}
+	p.copyimagetags = optBool{}
 }
 
+// GetCopyimagetags returns the copyimagetags param and if it is set
 func (p *DeployVirtualMachineParams) GetCopyimagetags() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["copyimagetags"].(bool)
-	return value, ok
+	return p.copyimagetags.v, p.copyimagetags.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *DeployVirtualMachineParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *DeployVirtualMachineParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *DeployVirtualMachineParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDatadiskofferinglist sets the datadiskofferinglist param.
 func (p *DeployVirtualMachineParams) SetDatadiskofferinglist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["datadiskofferinglist"] = v
+	p.datadiskofferinglist = optStringMap{v: v, ok: true}
 }
 
+// ResetDatadiskofferinglist unsets the datadiskofferinglist param
 func (p *DeployVirtualMachineParams) ResetDatadiskofferinglist() {
-	if p.p != nil && p.p["datadiskofferinglist"] != nil {
-		delete(p.p, "datadiskofferinglist")
-	}
+	p.datadiskofferinglist = optStringMap{}
 }
 
+// GetDatadiskofferinglist returns the datadiskofferinglist param and if it is set
 func (p *DeployVirtualMachineParams) GetDatadiskofferinglist() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["datadiskofferinglist"].(map[string]string)
-	return value, ok
+	return p.datadiskofferinglist.v, p.datadiskofferinglist.ok
 }
 
+// SetDeploymentplanner sets the deploymentplanner param.
 func (p *DeployVirtualMachineParams) SetDeploymentplanner(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deploymentplanner"] = v
+	p.deploymentplanner = optString{v: v, ok: true}
 }
 
+// ResetDeploymentplanner unsets the deploymentplanner param
 func (p *DeployVirtualMachineParams) ResetDeploymentplanner() {
-	if p.p != nil && p.p["deploymentplanner"] != nil {
-		delete(p.p, "deploymentplanner")
-	}
+	p.deploymentplanner = optString{}
 }
 
+// GetDeploymentplanner returns the deploymentplanner param and if it is set
 func (p *DeployVirtualMachineParams) GetDeploymentplanner() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["deploymentplanner"].(string)
-	return value, ok
+	return p.deploymentplanner.v, p.deploymentplanner.ok
 }
 
+// SetDetails sets the details param.
 func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *DeployVirtualMachineParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *DeployVirtualMachineParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDhcpoptionsnetworklist sets the dhcpoptionsnetworklist param.
 func (p *DeployVirtualMachineParams) SetDhcpoptionsnetworklist(v []map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dhcpoptionsnetworklist"] = v
+	p.dhcpoptionsnetworklist = optStringMaps{v: v, ok: true}
 }
 
+// ResetDhcpoptionsnetworklist unsets the dhcpoptionsnetworklist param
 func (p *DeployVirtualMachineParams) ResetDhcpoptionsnetworklist() {
-	if p.p != nil && p.p["dhcpoptionsnetworklist"] != nil {
-		delete(p.p, "dhcpoptionsnetworklist")
-	}
+	p.dhcpoptionsnetworklist = optStringMaps{}
 }
 
+// GetDhcpoptionsnetworklist returns the dhcpoptionsnetworklist param and if it is set
 func (p *DeployVirtualMachineParams) GetDhcpoptionsnetworklist() ([]map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dhcpoptionsnetworklist"].([]map[string]string)
-	return value, ok
+	return p.dhcpoptionsnetworklist.v, p.dhcpoptionsnetworklist.ok
 }
 
+// AddDhcpoptionsnetworklist adds an item to the dhcpoptionsnetworklist param
 func (p *DeployVirtualMachineParams) AddDhcpoptionsnetworklist(item map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	val, found := p.p["dhcpoptionsnetworklist"]
-	if !found {
-		p.p["dhcpoptionsnetworklist"] = []map[string]string{}
-		val = p.p["dhcpoptionsnetworklist"]
-	}
-	l := val.([]map[string]string)
-	l = append(l, item)
-	p.p["dhcpoptionsnetworklist"] = l
+	p.dhcpoptionsnetworklist.v = append(p.dhcpoptionsnetworklist.v, item)
+	p.dhcpoptionsnetworklist.ok = true
 }
 
+// SetDiskofferingid sets the diskofferingid param.
 func (p *DeployVirtualMachineParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *DeployVirtualMachineParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *DeployVirtualMachineParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetDisplayname sets the displayname param.
 func (p *DeployVirtualMachineParams) SetDisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayname"] = v
+	p.displayname = optString{v: v, ok: true}
 }
 
+// ResetDisplayname unsets the displayname param
 func (p *DeployVirtualMachineParams) ResetDisplayname() {
-	if p.p != nil && p.p["displayname"] != nil {
-		delete(p.p, "displayname")
-	}
+	p.displayname = optString{}
 }
 
+// GetDisplayname returns the displayname param and if it is set
 func (p *DeployVirtualMachineParams) GetDisplayname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayname"].(string)
-	return value, ok
+	return p.displayname.v, p.displayname.ok
 }
 
+// SetDisplayvm sets the displayvm param.
 func (p *DeployVirtualMachineParams) SetDisplayvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvm"] = v
+	p.displayvm = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvm unsets the displayvm param
 func (p *DeployVirtualMachineParams) ResetDisplayvm() {
-	if p.p != nil && p.p["displayvm"] != nil {
-		delete(p.p, "displayvm")
-	}
+	p.displayvm = optBool{}
 }
 
+// GetDisplayvm returns the displayvm param and if it is set
 func (p *DeployVirtualMachineParams) GetDisplayvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvm"].(bool)
-	return value, ok
+	return p.displayvm.v, p.displayvm.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *DeployVirtualMachineParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DeployVirtualMachineParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DeployVirtualMachineParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetDynamicscalingenabled sets the dynamicscalingenabled param.
 func (p *DeployVirtualMachineParams) SetDynamicscalingenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dynamicscalingenabled"] = v
+	p.dynamicscalingenabled = optBool{v: v, ok: true}
 }
 
+// ResetDynamicscalingenabled unsets the dynamicscalingenabled param
 func (p *DeployVirtualMachineParams) ResetDynamicscalingenabled() {
-	if p.p != nil && p.p["dynamicscalingenabled"] != nil {
-		delete(p.p, "dynamicscalingenabled")
-	}
+	p.dynamicscalingenabled = optBool{}
 }
 
+// GetDynamicscalingenabled returns the dynamicscalingenabled param and if it is set
 func (p *DeployVirtualMachineParams) GetDynamicscalingenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dynamicscalingenabled"].(bool)
-	return value, ok
+	return p.dynamicscalingenabled.v, p.dynamicscalingenabled.ok
 }
 
+// SetExtraconfig sets the extraconfig param.
 func (p *DeployVirtualMachineParams) SetExtraconfig(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["extraconfig"] = v
+	p.extraconfig = optString{v: v, ok: true}
 }
 
+// ResetExtraconfig unsets the extraconfig param
 func (p *DeployVirtualMachineParams) ResetExtraconfig() {
-	if p.p != nil && p.p["extraconfig"] != nil {
-		delete(p.p, "extraconfig")
-	}
+	p.extraconfig = optString{}
 }
 
+// GetExtraconfig returns the extraconfig param and if it is set
 func (p *DeployVirtualMachineParams) GetExtraconfig() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["extraconfig"].(string)
-	return value, ok
+	return p.extraconfig.v, p.extraconfig.ok
 }
 
+// SetGroup sets the group param.
 func (p *DeployVirtualMachineParams) SetGroup(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["group"] = v
+	p.group = optString{v: v, ok: true}
 }
 
+// ResetGroup unsets the group param
 func (p *DeployVirtualMachineParams) ResetGroup() {
-	if p.p != nil && p.p["group"] != nil {
-		delete(p.p, "group")
-	}
+	p.group = optString{}
 }
 
+// GetGroup returns the group param and if it is set
 func (p *DeployVirtualMachineParams) GetGroup() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["group"].(string)
-	return value, ok
+	return p.group.v, p.group.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *DeployVirtualMachineParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *DeployVirtualMachineParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *DeployVirtualMachineParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *DeployVirtualMachineParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *DeployVirtualMachineParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *DeployVirtualMachineParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetIodriverpolicy sets the iodriverpolicy param.
 func (p *DeployVirtualMachineParams) SetIodriverpolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iodriverpolicy"] = v
+	p.iodriverpolicy = optString{v: v, ok: true}
 }
 
+// ResetIodriverpolicy unsets the iodriverpolicy param
 func (p *DeployVirtualMachineParams) ResetIodriverpolicy() {
-	if p.p != nil && p.p["iodriverpolicy"] != nil {
-		delete(p.p, "iodriverpolicy")
-	}
+	p.iodriverpolicy = optString{}
 }
 
+// GetIodriverpolicy returns the iodriverpolicy param and if it is set
 func (p *DeployVirtualMachineParams) GetIodriverpolicy() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iodriverpolicy"].(string)
-	return value, ok
+	return p.iodriverpolicy.v, p.iodriverpolicy.ok
 }
 
+// SetIothreadsenabled sets the iothreadsenabled param.
 func (p *DeployVirtualMachineParams) SetIothreadsenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iothreadsenabled"] = v
+	p.iothreadsenabled = optBool{v: v, ok: true}
 }
 
+// ResetIothreadsenabled unsets the iothreadsenabled param
 func (p *DeployVirtualMachineParams) ResetIothreadsenabled() {
-	if p.p != nil && p.p["iothreadsenabled"] != nil {
-		delete(p.p, "iothreadsenabled")
-	}
+	p.iothreadsenabled = optBool{}
 }
 
+// GetIothreadsenabled returns the iothreadsenabled param and if it is set
 func (p *DeployVirtualMachineParams) GetIothreadsenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iothreadsenabled"].(bool)
-	return value, ok
+	return p.iothreadsenabled.v, p.iothreadsenabled.ok
 }
 
+// SetIp6address sets the ip6address param.
 func (p *DeployVirtualMachineParams) SetIp6address(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6address"] = v
+	p.ip6address = optString{v: v, ok: true}
 }
 
+// ResetIp6address unsets the ip6address param
 func (p *DeployVirtualMachineParams) ResetIp6address() {
-	if p.p != nil && p.p["ip6address"] != nil {
-		delete(p.p, "ip6address")
-	}
+	p.ip6address = optString{}
 }
 
+// GetIp6address returns the ip6address param and if it is set
 func (p *DeployVirtualMachineParams) GetIp6address() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6address"].(string)
-	return value, ok
+	return p.ip6address.v, p.ip6address.ok
 }
 
+// SetIpaddress sets the ipaddress param.
 func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
+	p.ipaddress = optString{v: v, ok: true}
 }
 
+// ResetIpaddress unsets the ipaddress param
 func (p *DeployVirtualMachineParams) ResetIpaddress() {
-	if p.p != nil && p.p["ipaddress"] != nil {
-		delete(p.p, "ipaddress")
-	}
+	p.ipaddress = optString{}
 }
 
+// GetIpaddress returns the ipaddress param and if it is set
 func (p *DeployVirtualMachineParams) GetIpaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ipaddress"].(string)
-	return value, ok
+	return p.ipaddress.v, p.ipaddress.ok
 }
 
+// SetIptonetworklist sets the iptonetworklist param.
 func (p *DeployVirtualMachineParams) SetIptonetworklist(v []map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["iptonetworklist"] = v
+	p.iptonetworklist = optStringMaps{v: v, ok: true}
 }
 
+// ResetIptonetworklist unsets the iptonetworklist param
 func (p *DeployVirtualMachineParams) ResetIptonetworklist() {
-	if p.p != nil && p.p["iptonetworklist"] != nil {
-		delete(p.p, "iptonetworklist")
-	}
+	p.iptonetworklist = optStringMaps{}
 }
 
+// GetIptonetworklist returns the iptonetworklist param and if it is set
 func (p *DeployVirtualMachineParams) GetIptonetworklist() ([]map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["iptonetworklist"].([]map[string]string)
-	return value, ok
+	return p.iptonetworklist.v, p.iptonetworklist.ok
 }
 
+// AddIptonetworklist adds an item to the iptonetworklist param
 func (p *DeployVirtualMachineParams) AddIptonetworklist(item map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	val, found := p.p["iptonetworklist"]
-	if !found {
-		p.p["iptonetworklist"] = []map[string]string{}
-		val = p.p["iptonetworklist"]
-	}
-	l := val.([]map[string]string)
-	l = append(l, item)
-	p.p["iptonetworklist"] = l
+	p.iptonetworklist.v = append(p.iptonetworklist.v, item)
+	p.iptonetworklist.ok = true
 }
 
+// SetKeyboard sets the keyboard param.
 func (p *DeployVirtualMachineParams) SetKeyboard(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyboard"] = v
+	p.keyboard = optString{v: v, ok: true}
 }
 
+// ResetKeyboard unsets the keyboard param
 func (p *DeployVirtualMachineParams) ResetKeyboard() {
-	if p.p != nil && p.p["keyboard"] != nil {
-		delete(p.p, "keyboard")
-	}
+	p.keyboard = optString{}
 }
 
+// GetKeyboard returns the keyboard param and if it is set
 func (p *DeployVirtualMachineParams) GetKeyboard() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyboard"].(string)
-	return value, ok
+	return p.keyboard.v, p.keyboard.ok
 }
 
+// SetKeypair sets the keypair param.
 func (p *DeployVirtualMachineParams) SetKeypair(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypair"] = v
+	p.keypair = optString{v: v, ok: true}
 }
 
+// ResetKeypair unsets the keypair param
 func (p *DeployVirtualMachineParams) ResetKeypair() {
-	if p.p != nil && p.p["keypair"] != nil {
-		delete(p.p, "keypair")
-	}
+	p.keypair = optString{}
 }
 
+// GetKeypair returns the keypair param and if it is set
 func (p *DeployVirtualMachineParams) GetKeypair() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keypair"].(string)
-	return value, ok
+	return p.keypair.v, p.keypair.ok
 }
 
+// SetKeypairs sets the keypairs param.
 func (p *DeployVirtualMachineParams) SetKeypairs(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypairs"] = v
+	p.keypairs = optStrings{v: v, ok: true}
 }
 
+// ResetKeypairs unsets the keypairs param
 func (p *DeployVirtualMachineParams) ResetKeypairs() {
-	if p.p != nil && p.p["keypairs"] != nil {
-		delete(p.p, "keypairs")
-	}
+	p.keypairs = optStrings{}
 }
 
+// GetKeypairs returns the keypairs param and if it is set
 func (p *DeployVirtualMachineParams) GetKeypairs() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keypairs"].([]string)
-	return value, ok
+	return p.keypairs.v, p.keypairs.ok
 }
 
+// SetMacaddress sets the macaddress param.
 func (p *DeployVirtualMachineParams) SetMacaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["macaddress"] = v
+	p.macaddress = optString{v: v, ok: true}
 }
 
+// ResetMacaddress unsets the macaddress param
 func (p *DeployVirtualMachineParams) ResetMacaddress() {
-	if p.p != nil && p.p["macaddress"] != nil {
-		delete(p.p, "macaddress")
-	}
+	p.macaddress = optString{}
 }
 
+// GetMacaddress returns the macaddress param and if it is set
 func (p *DeployVirtualMachineParams) GetMacaddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["macaddress"].(string)
-	return value, ok
+	return p.macaddress.v, p.macaddress.ok
 }
 
+// SetName sets the name param.
 func (p *DeployVirtualMachineParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *DeployVirtualMachineParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *DeployVirtualMachineParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkids sets the networkids param.
 func (p *DeployVirtualMachineParams) SetNetworkids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkids"] = v
+	p.networkids = optStrings{v: v, ok: true}
 }
 
+// ResetNetworkids unsets the networkids param
 func (p *DeployVirtualMachineParams) ResetNetworkids() {
-	if p.p != nil && p.p["networkids"] != nil {
-		delete(p.p, "networkids")
-	}
+	p.networkids = optStrings{}
 }
 
+// GetNetworkids returns the networkids param and if it is set
 func (p *DeployVirtualMachineParams) GetNetworkids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkids"].([]string)
-	return value, ok
+	return p.networkids.v, p.networkids.ok
 }
 
+// SetNicmultiqueuenumber sets the nicmultiqueuenumber param.
 func (p *DeployVirtualMachineParams) SetNicmultiqueuenumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicmultiqueuenumber"] = v
+	p.nicmultiqueuenumber = optInt{v: v, ok: true}
 }
 
+// ResetNicmultiqueuenumber unsets the nicmultiqueuenumber param
 func (p *DeployVirtualMachineParams) ResetNicmultiqueuenumber() {
-	if p.p != nil && p.p["nicmultiqueuenumber"] != nil {
-		delete(p.p, "nicmultiqueuenumber")
-	}
+	p.nicmultiqueuenumber = optInt{}
 }
 
+// GetNicmultiqueuenumber returns the nicmultiqueuenumber param and if it is set
 func (p *DeployVirtualMachineParams) GetNicmultiqueuenumber() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nicmultiqueuenumber"].(int)
-	return value, ok
+	return p.nicmultiqueuenumber.v, p.nicmultiqueuenumber.ok
 }
 
+// SetNicnetworklist sets the nicnetworklist param.
 func (p *DeployVirtualMachineParams) SetNicnetworklist(v []map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicnetworklist"] = v
+	p.nicnetworklist = optStringMaps{v: v, ok: true}
 }
 
+// ResetNicnetworklist unsets the nicnetworklist param
 func (p *DeployVirtualMachineParams) ResetNicnetworklist() {
-	if p.p != nil && p.p["nicnetworklist"] != nil {
-		delete(p.p, "nicnetworklist")
-	}
+	p.nicnetworklist = optStringMaps{}
 }
 
+// GetNicnetworklist returns the nicnetworklist param and if it is set
 func (p *DeployVirtualMachineParams) GetNicnetworklist() ([]map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nicnetworklist"].([]map[string]string)
-	return value, ok
+	return p.nicnetworklist.v, p.nicnetworklist.ok
 }
 
+// AddNicnetworklist adds an item to the nicnetworklist param
 func (p *DeployVirtualMachineParams) AddNicnetworklist(item map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	val, found := p.p["nicnetworklist"]
-	if !found {
-		p.p["nicnetworklist"] = []map[string]string{}
-		val = p.p["nicnetworklist"]
-	}
-	l := val.([]map[string]string)
-	l = append(l, item)
-	p.p["nicnetworklist"] = l
+	p.nicnetworklist.v = append(p.nicnetworklist.v, item)
+	p.nicnetworklist.ok = true
 }
 
+// SetNicpackedvirtqueuesenabled sets the nicpackedvirtqueuesenabled param.
 func (p *DeployVirtualMachineParams) SetNicpackedvirtqueuesenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicpackedvirtqueuesenabled"] = v
+	p.nicpackedvirtqueuesenabled = optBool{v: v, ok: true}
 }
 
+// ResetNicpackedvirtqueuesenabled unsets the nicpackedvirtqueuesenabled param
 func (p *DeployVirtualMachineParams) ResetNicpackedvirtqueuesenabled() {
-	if p.p != nil && p.p["nicpackedvirtqueuesenabled"] != nil {
-		delete(p.p, "nicpackedvirtqueuesenabled")
-	}
+	p.nicpackedvirtqueuesenabled = optBool{}
 }
 
+// GetNicpackedvirtqueuesenabled returns the nicpackedvirtqueuesenabled param and if it is set
 func (p *DeployVirtualMachineParams) GetNicpackedvirtqueuesenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nicpackedvirtqueuesenabled"].(bool)
-	return value, ok
+	return p.nicpackedvirtqueuesenabled.v, p.nicpackedvirtqueuesenabled.ok
 }
 
+// SetOverridediskofferingid sets the overridediskofferingid param.
 func (p *DeployVirtualMachineParams) SetOverridediskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["overridediskofferingid"] = v
+	p.overridediskofferingid = optString{v: v, ok: true}
 }
 
+// ResetOverridediskofferingid unsets the overridediskofferingid param
 func (p *DeployVirtualMachineParams) ResetOverridediskofferingid() {
-	if p.p != nil && p.p["overridediskofferingid"] != nil {
-		delete(p.p, "overridediskofferingid")
-	}
+	p.overridediskofferingid = optString{}
 }
 
+// GetOverridediskofferingid returns the overridediskofferingid param and if it is set
 func (p *DeployVirtualMachineParams) GetOverridediskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["overridediskofferingid"].(string)
-	return value, ok
+	return p.overridediskofferingid.v, p.overridediskofferingid.ok
 }
 
+// SetPassword sets the password param.
 func (p *DeployVirtualMachineParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *DeployVirtualMachineParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *DeployVirtualMachineParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	return p.password.v, p.password.ok
 }
 
+// SetPodid sets the podid param.
 func (p *DeployVirtualMachineParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *DeployVirtualMachineParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *DeployVirtualMachineParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *DeployVirtualMachineParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *DeployVirtualMachineParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *DeployVirtualMachineParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetProperties sets the properties param.
 func (p *DeployVirtualMachineParams) SetProperties(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["properties"] = v
+	p.properties = optStringMap{v: v, ok: true}
 }
 
+// ResetProperties unsets the properties param
 func (p *DeployVirtualMachineParams) ResetProperties() {
-	if p.p != nil && p.p["properties"] != nil {
-		delete(p.p, "properties")
-	}
+	p.properties = optStringMap{}
 }
 
+// GetProperties returns the properties param and if it is set
 func (p *DeployVirtualMachineParams) GetProperties() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["properties"].(map[string]string)
-	return value, ok
+	return p.properties.v, p.properties.ok
 }
 
+// SetRootdisksize sets the rootdisksize param.
 func (p *DeployVirtualMachineParams) SetRootdisksize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["rootdisksize"] = v
+	p.rootdisksize = optInt64{v: v, ok: true}
 }
 
+// ResetRootdisksize unsets the rootdisksize param
 func (p *DeployVirtualMachineParams) ResetRootdisksize() {
-	if p.p != nil && p.p["rootdisksize"] != nil {
-		delete(p.p, "rootdisksize")
-	}
+	p.rootdisksize = optInt64{}
 }
 
+// GetRootdisksize returns the rootdisksize param and if it is set
 func (p *DeployVirtualMachineParams) GetRootdisksize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["rootdisksize"].(int64)
-	return value, ok
+	return p.rootdisksize.v, p.rootdisksize.ok
 }
 
+// SetSecuritygroupids sets the securitygroupids param.
 func (p *DeployVirtualMachineParams) SetSecuritygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupids"] = v
+	p.securitygroupids = optStrings{v: v, ok: true}
 }
 
+// ResetSecuritygroupids unsets the securitygroupids param
 func (p *DeployVirtualMachineParams) ResetSecuritygroupids() {
-	if p.p != nil && p.p["securitygroupids"] != nil {
-		delete(p.p, "securitygroupids")
-	}
+	p.securitygroupids = optStrings{}
 }
 
+// GetSecuritygroupids returns the securitygroupids param and if it is set
 func (p *DeployVirtualMachineParams) GetSecuritygroupids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupids"].([]string)
-	return value, ok
+	return p.securitygroupids.v, p.securitygroupids.ok
 }
 
+// SetSecuritygroupnames sets the securitygroupnames param.
 func (p *DeployVirtualMachineParams) SetSecuritygroupnames(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupnames"] = v
+	p.securitygroupnames = optStrings{v: v, ok: true}
 }
 
+// ResetSecuritygroupnames unsets the securitygroupnames param
 func (p *DeployVirtualMachineParams) ResetSecuritygroupnames() {
-	if p.p != nil && p.p["securitygroupnames"] != nil {
-		delete(p.p, "securitygroupnames")
-	}
+	p.securitygroupnames = optStrings{}
 }
 
+// GetSecuritygroupnames returns the securitygroupnames param and if it is set
 func (p *DeployVirtualMachineParams) GetSecuritygroupnames() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupnames"].([]string)
-	return value, ok
+	return p.securitygroupnames.v, p.securitygroupnames.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *DeployVirtualMachineParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *DeployVirtualMachineParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *DeployVirtualMachineParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetSize sets the size param.
 func (p *DeployVirtualMachineParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["size"] = v
+	p.size = optInt64{v: v, ok: true}
 }
 
+// ResetSize unsets the size param
 func (p *DeployVirtualMachineParams) ResetSize() {
-	if p.p != nil && p.p["size"] != nil {
-		delete(p.p, "size")
-	}
+	p.size = optInt64{}
 }
 
+// GetSize returns the size param and if it is set
 func (p *DeployVirtualMachineParams) GetSize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["size"].(int64)
-	return value, ok
+	return p.size.v, p.size.ok
 }
 
+// SetStartvm sets the startvm param.
 func (p *DeployVirtualMachineParams) SetStartvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startvm"] = v
+	p.startvm = optBool{v: v, ok: true}
 }
 
+// ResetStartvm unsets the startvm param
 func (p *DeployVirtualMachineParams) ResetStartvm() {
-	if p.p != nil && p.p["startvm"] != nil {
-		delete(p.p, "startvm")
-	}
+	p.startvm = optBool{}
 }
 
+// GetStartvm returns the startvm param and if it is set
 func (p *DeployVirtualMachineParams) GetStartvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["startvm"].(bool)
-	return value, ok
+	return p.startvm.v, p.startvm.ok
 }
 
+// SetTemplateid sets the templateid param. This param is required.
 func (p *DeployVirtualMachineParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *DeployVirtualMachineParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *DeployVirtualMachineParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetUserdata sets the userdata param.
 func (p *DeployVirtualMachineParams) SetUserdata(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
+	p.userdata = optString{v: v, ok: true}
 }
 
+// ResetUserdata unsets the userdata param
 func (p *DeployVirtualMachineParams) ResetUserdata() {
-	if p.p != nil && p.p["userdata"] != nil {
-		delete(p.p, "userdata")
-	}
+	p.userdata = optString{}
 }
 
+// GetUserdata returns the userdata param and if it is set
 func (p *DeployVirtualMachineParams) GetUserdata() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdata"].(string)
-	return value, ok
+	return p.userdata.v, p.userdata.ok
 }
 
+// SetUserdatadetails sets the userdatadetails param.
 func (p *DeployVirtualMachineParams) SetUserdatadetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdatadetails"] = v
+	p.userdatadetails = optStringMap{v: v, ok: true}
 }
 
+// ResetUserdatadetails unsets the userdatadetails param
 func (p *DeployVirtualMachineParams) ResetUserdatadetails() {
-	if p.p != nil && p.p["userdatadetails"] != nil {
-		delete(p.p, "userdatadetails")
-	}
+	p.userdatadetails = optStringMap{}
 }
 
+// GetUserdatadetails returns the userdatadetails param and if it is set
 func (p *DeployVirtualMachineParams) GetUserdatadetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdatadetails"].(map[string]string)
-	return value, ok
+	return p.userdatadetails.v, p.userdatadetails.ok
 }
 
+// SetUserdataid sets the userdataid param.
 func (p *DeployVirtualMachineParams) SetUserdataid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdataid"] = v
+	p.userdataid = optString{v: v, ok: true}
 }
 
+// ResetUserdataid unsets the userdataid param
 func (p *DeployVirtualMachineParams) ResetUserdataid() {
-	if p.p != nil && p.p["userdataid"] != nil {
-		delete(p.p, "userdataid")
-	}
+	p.userdataid = optString{}
 }
 
+// GetUserdataid returns the userdataid param and if it is set
 func (p *DeployVirtualMachineParams) GetUserdataid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdataid"].(string)
-	return value, ok
+	return p.userdataid.v, p.userdataid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *DeployVirtualMachineParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *DeployVirtualMachineParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *DeployVirtualMachineParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeployVirtualMachineParams) Clone() *DeployVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.affinitygroupids = p.affinitygroupids.clone()
+	c.affinitygroupnames = p.affinitygroupnames.clone()
+	c.datadiskofferinglist = p.datadiskofferinglist.clone()
+	c.details = p.details.clone()
+	c.dhcpoptionsnetworklist = p.dhcpoptionsnetworklist.clone()
+	c.iptonetworklist = p.iptonetworklist.clone()
+	c.keypairs = p.keypairs.clone()
+	c.networkids = p.networkids.clone()
+	c.nicnetworklist = p.nicnetworklist.clone()
+	c.properties = p.properties.clone()
+	c.securitygroupids = p.securitygroupids.clone()
+	c.securitygroupnames = p.securitygroupnames.clone()
+	c.userdatadetails = p.userdatadetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeployVirtualMachineParams) Equal(o *DeployVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.affinitygroupids.equal(o.affinitygroupids) &&
+		p.affinitygroupnames.equal(o.affinitygroupnames) &&
+		p.bootintosetup == o.bootintosetup &&
+		p.bootmode == o.bootmode &&
+		p.boottype == o.boottype &&
+		p.clusterid == o.clusterid &&
+		p.copyimagetags == o.copyimagetags &&
+		p.customid == o.customid &&
+		p.datadiskofferinglist.equal(o.datadiskofferinglist) &&
+		p.deploymentplanner == o.deploymentplanner &&
+		p.details.equal(o.details) &&
+		p.dhcpoptionsnetworklist.equal(o.dhcpoptionsnetworklist) &&
+		p.diskofferingid == o.diskofferingid &&
+		p.displayname == o.displayname &&
+		p.displayvm == o.displayvm &&
+		p.domainid == o.domainid &&
+		p.dynamicscalingenabled == o.dynamicscalingenabled &&
+		p.extraconfig == o.extraconfig &&
+		p.group == o.group &&
+		p.hostid == o.hostid &&
+		p.hypervisor == o.hypervisor &&
+		p.iodriverpolicy == o.iodriverpolicy &&
+		p.iothreadsenabled == o.iothreadsenabled &&
+		p.ip6address == o.ip6address &&
+		p.ipaddress == o.ipaddress &&
+		p.iptonetworklist.equal(o.iptonetworklist) &&
+		p.keyboard == o.keyboard &&
+		p.keypair == o.keypair &&
+		p.keypairs.equal(o.keypairs) &&
+		p.macaddress == o.macaddress &&
+		p.name == o.name &&
+		p.networkids.equal(o.networkids) &&
+		p.nicmultiqueuenumber == o.nicmultiqueuenumber &&
+		p.nicnetworklist.equal(o.nicnetworklist) &&
+		p.nicpackedvirtqueuesenabled == o.nicpackedvirtqueuesenabled &&
+		p.overridediskofferingid == o.overridediskofferingid &&
+		p.password == o.password &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.properties.equal(o.properties) &&
+		p.rootdisksize == o.rootdisksize &&
+		p.securitygroupids.equal(o.securitygroupids) &&
+		p.securitygroupnames.equal(o.securitygroupnames) &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.size == o.size &&
+		p.startvm == o.startvm &&
+		p.templateid == o.templateid &&
+		p.userdata == o.userdata &&
+		p.userdatadetails.equal(o.userdatadetails) &&
+		p.userdataid == o.userdataid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedDeployVirtualMachineParams is used to (un)marshal DeployVirtualMachineParams using the API param names
+type serializedDeployVirtualMachineParams struct {
+	Account                    *string              `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupids           *[]string            `json:"affinitygroupids,omitempty" yaml:"affinitygroupids,omitempty"`
+	Affinitygroupnames         *[]string            `json:"affinitygroupnames,omitempty" yaml:"affinitygroupnames,omitempty"`
+	Bootintosetup              *bool                `json:"bootintosetup,omitempty" yaml:"bootintosetup,omitempty"`
+	Bootmode                   *string              `json:"bootmode,omitempty" yaml:"bootmode,omitempty"`
+	Boottype                   *string              `json:"boottype,omitempty" yaml:"boottype,omitempty"`
+	Clusterid                  *string              `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Copyimagetags              *bool                `json:"copyimagetags,omitempty" yaml:"copyimagetags,omitempty"`
+	Customid                   *string              `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Datadiskofferinglist       *map[string]string   `json:"datadiskofferinglist,omitempty" yaml:"datadiskofferinglist,omitempty"`
+	Deploymentplanner          *string              `json:"deploymentplanner,omitempty" yaml:"deploymentplanner,omitempty"`
+	Details                    *map[string]string   `json:"details,omitempty" yaml:"details,omitempty"`
+	Dhcpoptionsnetworklist     *[]map[string]string `json:"dhcpoptionsnetworklist,omitempty" yaml:"dhcpoptionsnetworklist,omitempty"`
+	Diskofferingid             *string              `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Displayname                *string              `json:"displayname,omitempty" yaml:"displayname,omitempty"`
+	Displayvm                  *bool                `json:"displayvm,omitempty" yaml:"displayvm,omitempty"`
+	Domainid                   *string              `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Dynamicscalingenabled      *bool                `json:"dynamicscalingenabled,omitempty" yaml:"dynamicscalingenabled,omitempty"`
+	Extraconfig                *string              `json:"extraconfig,omitempty" yaml:"extraconfig,omitempty"`
+	Group                      *string              `json:"group,omitempty" yaml:"group,omitempty"`
+	Hostid                     *string              `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Hypervisor                 *string              `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Iodriverpolicy             *string              `json:"iodriverpolicy,omitempty" yaml:"iodriverpolicy,omitempty"`
+	Iothreadsenabled           *bool                `json:"iothreadsenabled,omitempty" yaml:"iothreadsenabled,omitempty"`
+	Ip6address                 *string              `json:"ip6address,omitempty" yaml:"ip6address,omitempty"`
+	Ipaddress                  *string              `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Iptonetworklist            *[]map[string]string `json:"iptonetworklist,omitempty" yaml:"iptonetworklist,omitempty"`
+	Keyboard                   *string              `json:"keyboard,omitempty" yaml:"keyboard,omitempty"`
+	Keypair                    *string              `json:"keypair,omitempty" yaml:"keypair,omitempty"`
+	Keypairs                   *[]string            `json:"keypairs,omitempty" yaml:"keypairs,omitempty"`
+	Macaddress                 *string              `json:"macaddress,omitempty" yaml:"macaddress,omitempty"`
+	Name                       *string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkids                 *[]string            `json:"networkids,omitempty" yaml:"networkids,omitempty"`
+	Nicmultiqueuenumber        *int                 `json:"nicmultiqueuenumber,omitempty" yaml:"nicmultiqueuenumber,omitempty"`
+	Nicnetworklist             *[]map[string]string `json:"nicnetworklist,omitempty" yaml:"nicnetworklist,omitempty"`
+	Nicpackedvirtqueuesenabled *bool                `json:"nicpackedvirtqueuesenabled,omitempty" yaml:"nicpackedvirtqueuesenabled,omitempty"`
+	Overridediskofferingid     *string              `json:"overridediskofferingid,omitempty" yaml:"overridediskofferingid,omitempty"`
+	Password                   *string              `json:"password,omitempty" yaml:"password,omitempty"`
+	Podid                      *string              `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid                  *string              `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Properties                 *map[string]string   `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Rootdisksize               *int64               `json:"rootdisksize,omitempty" yaml:"rootdisksize,omitempty"`
+	Securitygroupids           *[]string            `json:"securitygroupids,omitempty" yaml:"securitygroupids,omitempty"`
+	Securitygroupnames         *[]string            `json:"securitygroupnames,omitempty" yaml:"securitygroupnames,omitempty"`
+	Serviceofferingid          *string              `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Size                       *int64               `json:"size,omitempty" yaml:"size,omitempty"`
+	Startvm                    *bool                `json:"startvm,omitempty" yaml:"startvm,omitempty"`
+	Templateid                 *string              `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Userdata                   *string              `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Userdatadetails            *map[string]string   `json:"userdatadetails,omitempty" yaml:"userdatadetails,omitempty"`
+	Userdataid                 *string              `json:"userdataid,omitempty" yaml:"userdataid,omitempty"`
+	Zoneid                     *string              `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *DeployVirtualMachineParams) toSerialized() *serializedDeployVirtualMachineParams {
+	s := &serializedDeployVirtualMachineParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.affinitygroupids.ok {
+		s.Affinitygroupids = &p.affinitygroupids.v
+	}
+	if p.affinitygroupnames.ok {
+		s.Affinitygroupnames = &p.affinitygroupnames.v
+	}
+	if p.bootintosetup.ok {
+		s.Bootintosetup = &p.bootintosetup.v
+	}
+	if p.bootmode.ok {
+		s.Bootmode = &p.bootmode.v
+	}
+	if p.boottype.ok {
+		s.Boottype = &p.boottype.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.copyimagetags.ok {
+		s.Copyimagetags = &p.copyimagetags.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.datadiskofferinglist.ok {
+		s.Datadiskofferinglist = &p.datadiskofferinglist.v
+	}
+	if p.deploymentplanner.ok {
+		s.Deploymentplanner = &p.deploymentplanner.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.dhcpoptionsnetworklist.ok {
+		s.Dhcpoptionsnetworklist = &p.dhcpoptionsnetworklist.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
-}
-
-// You should always use this function to get a new DeployVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams {
-	p := &DeployVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["serviceofferingid"] = serviceofferingid
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
-	return p
-}
-
-// Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
-func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("deployVirtualMachine", p.toURLValues())
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.displayname.ok {
+		s.Displayname = &p.displayname.v
+	}
+	if p.displayvm.ok {
+		s.Displayvm = &p.displayvm.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.dynamicscalingenabled.ok {
+		s.Dynamicscalingenabled = &p.dynamicscalingenabled.v
+	}
+	if p.extraconfig.ok {
+		s.Extraconfig = &p.extraconfig.v
+	}
+	if p.group.ok {
+		s.Group = &p.group.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.iodriverpolicy.ok {
+		s.Iodriverpolicy = &p.iodriverpolicy.v
+	}
+	if p.iothreadsenabled.ok {
+		s.Iothreadsenabled = &p.iothreadsenabled.v
+	}
+	if p.ip6address.ok {
+		s.Ip6address = &p.ip6address.v
+	}
+	if p.ipaddress.ok {
+		s.Ipaddress = &p.ipaddress.v
+	}
+	if p.iptonetworklist.ok {
+		s.Iptonetworklist = &p.iptonetworklist.v
+	}
+	if p.keyboard.ok {
+		s.Keyboard = &p.keyboard.v
+	}
+	if p.keypair.ok {
+		s.Keypair = &p.keypair.v
+	}
+	if p.keypairs.ok {
+		s.Keypairs = &p.keypairs.v
+	}
+	if p.macaddress.ok {
+		s.Macaddress = &p.macaddress.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkids.ok {
+		s.Networkids = &p.networkids.v
+	}
+	if p.nicmultiqueuenumber.ok {
+		s.Nicmultiqueuenumber = &p.nicmultiqueuenumber.v
+	}
+	if p.nicnetworklist.ok {
+		s.Nicnetworklist = &p.nicnetworklist.v
+	}
+	if p.nicpackedvirtqueuesenabled.ok {
+		s.Nicpackedvirtqueuesenabled = &p.nicpackedvirtqueuesenabled.v
+	}
+	if p.overridediskofferingid.ok {
+		s.Overridediskofferingid = &p.overridediskofferingid.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.properties.ok {
+		s.Properties = &p.properties.v
+	}
+	if p.rootdisksize.ok {
+		s.Rootdisksize = &p.rootdisksize.v
+	}
+	if p.securitygroupids.ok {
+		s.Securitygroupids = &p.securitygroupids.v
+	}
+	if p.securitygroupnames.ok {
+		s.Securitygroupnames = &p.securitygroupnames.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.size.ok {
+		s.Size = &p.size.v
+	}
+	if p.startvm.ok {
+		s.Startvm = &p.startvm.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	if p.userdatadetails.ok {
+		s.Userdatadetails = &p.userdatadetails.v
+	}
+	if p.userdataid.ok {
+		s.Userdataid = &p.userdataid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *DeployVirtualMachineParams) fromSerialized(s *serializedDeployVirtualMachineParams) {
+	*p = DeployVirtualMachineParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Affinitygroupids != nil {
+		p.SetAffinitygroupids(*s.Affinitygroupids)
+	}
+	if s.Affinitygroupnames != nil {
+		p.SetAffinitygroupnames(*s.Affinitygroupnames)
+	}
+	if s.Bootintosetup != nil {
+		p.SetBootintosetup(*s.Bootintosetup)
+	}
+	if s.Bootmode != nil {
+		p.SetBootmode(*s.Bootmode)
+	}
+	if s.Boottype != nil {
+		p.SetBoottype(*s.Boottype)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Copyimagetags != nil {
+		p.SetCopyimagetags(*s.Copyimagetags)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Datadiskofferinglist != nil {
+		p.SetDatadiskofferinglist(*s.Datadiskofferinglist)
+	}
+	if s.Deploymentplanner != nil {
+		p.SetDeploymentplanner(*s.Deploymentplanner)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Dhcpoptionsnetworklist != nil {
+		p.SetDhcpoptionsnetworklist(*s.Dhcpoptionsnetworklist)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Displayname != nil {
+		p.SetDisplayname(*s.Displayname)
+	}
+	if s.Displayvm != nil {
+		p.SetDisplayvm(*s.Displayvm)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Dynamicscalingenabled != nil {
+		p.SetDynamicscalingenabled(*s.Dynamicscalingenabled)
+	}
+	if s.Extraconfig != nil {
+		p.SetExtraconfig(*s.Extraconfig)
+	}
+	if s.Group != nil {
+		p.SetGroup(*s.Group)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Iodriverpolicy != nil {
+		p.SetIodriverpolicy(*s.Iodriverpolicy)
+	}
+	if s.Iothreadsenabled != nil {
+		p.SetIothreadsenabled(*s.Iothreadsenabled)
+	}
+	if s.Ip6address != nil {
+		p.SetIp6address(*s.Ip6address)
+	}
+	if s.Ipaddress != nil {
+		p.SetIpaddress(*s.Ipaddress)
+	}
+	if s.Iptonetworklist != nil {
+		p.SetIptonetworklist(*s.Iptonetworklist)
+	}
+	if s.Keyboard != nil {
+		p.SetKeyboard(*s.Keyboard)
+	}
+	if s.Keypair != nil {
+		p.SetKeypair(*s.Keypair)
+	}
+	if s.Keypairs != nil {
+		p.SetKeypairs(*s.Keypairs)
+	}
+	if s.Macaddress != nil {
+		p.SetMacaddress(*s.Macaddress)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkids != nil {
+		p.SetNetworkids(*s.Networkids)
+	}
+	if s.Nicmultiqueuenumber != nil {
+		p.SetNicmultiqueuenumber(*s.Nicmultiqueuenumber)
+	}
+	if s.Nicnetworklist != nil {
+		p.SetNicnetworklist(*s.Nicnetworklist)
+	}
+	if s.Nicpackedvirtqueuesenabled != nil {
+		p.SetNicpackedvirtqueuesenabled(*s.Nicpackedvirtqueuesenabled)
+	}
+	if s.Overridediskofferingid != nil {
+		p.SetOverridediskofferingid(*s.Overridediskofferingid)
+	}
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Properties != nil {
+		p.SetProperties(*s.Properties)
+	}
+	if s.Rootdisksize != nil {
+		p.SetRootdisksize(*s.Rootdisksize)
+	}
+	if s.Securitygroupids != nil {
+		p.SetSecuritygroupids(*s.Securitygroupids)
+	}
+	if s.Securitygroupnames != nil {
+		p.SetSecuritygroupnames(*s.Securitygroupnames)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Size != nil {
+		p.SetSize(*s.Size)
+	}
+	if s.Startvm != nil {
+		p.SetStartvm(*s.Startvm)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+	if s.Userdatadetails != nil {
+		p.SetUserdatadetails(*s.Userdatadetails)
+	}
+	if s.Userdataid != nil {
+		p.SetUserdataid(*s.Userdataid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeployVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeployVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeployVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeployVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeployVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeployVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new DeployVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams {
+	p := &DeployVirtualMachineParams{}
+	p.SetServiceofferingid(serviceofferingid)
+	p.SetTemplateid(templateid)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Creates and automatically starts a virtual machine based on a service offering, disk offering,
+// and template.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: serviceofferingid,
+// templateid, zoneid.
+func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams, opts ...CallOption) (*DeployVirtualMachineResponse, error) {
+	resp, err := s.cs.newPostRequest("deployVirtualMachine", s.cs.encodeParams("deployVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2569,8 +3408,8 @@ func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachinePara
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2752,103 +3591,198 @@ func (r *DeployVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DestroyVirtualMachineParams struct {
-	p map[string]interface{}
+	expunge   optBool
+	id        optString
+	volumeids optStrings
 }
 
-func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DestroyVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["expunge"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("expunge", vv)
+	if p.expunge.ok {
+		u.Set("expunge", strconv.FormatBool(p.expunge.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["volumeids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("volumeids", vv)
+	if p.volumeids.ok {
+		u.Set("volumeids", strings.Join(p.volumeids.v, ","))
 	}
 	return u
 }
 
-func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDestroyVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new DestroyVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDestroyVirtualMachineParams(u url.Values) (*DestroyVirtualMachineParams, error) {
+	p := &DestroyVirtualMachineParams{}
+	if err := checkParamNames("destroyVirtualMachine", u, "expunge", "id", "volumeids"); err != nil {
+		return nil, err
+	}
+	if _, found := u["expunge"]; found {
+		v, err := strconv.ParseBool(u.Get("expunge"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param expunge: %v", err)
+		}
+		p.SetExpunge(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["expunge"] = v
+	if _, found := u["volumeids"]; found {
+		p.SetVolumeids(strings.Split(u.Get("volumeids"), ","))
+	}
+	return p, nil
+}
+
+// SetExpunge sets the expunge param.
+func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
+	p.expunge = optBool{v: v, ok: true}
 }
 
+// ResetExpunge unsets the expunge param
 func (p *DestroyVirtualMachineParams) ResetExpunge() {
-	if p.p != nil && p.p["expunge"] != nil {
-		delete(p.p, "expunge")
-	}
+	p.expunge = optBool{}
 }
 
+// GetExpunge returns the expunge param and if it is set
 func (p *DestroyVirtualMachineParams) GetExpunge() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["expunge"].(bool)
-	return value, ok
+	return p.expunge.v, p.expunge.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DestroyVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DestroyVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DestroyVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetVolumeids sets the volumeids param.
 func (p *DestroyVirtualMachineParams) SetVolumeids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeids"] = v
+	p.volumeids = optStrings{v: v, ok: true}
 }
 
+// ResetVolumeids unsets the volumeids param
 func (p *DestroyVirtualMachineParams) ResetVolumeids() {
-	if p.p != nil && p.p["volumeids"] != nil {
-		delete(p.p, "volumeids")
-	}
+	p.volumeids = optStrings{}
 }
 
+// GetVolumeids returns the volumeids param and if it is set
 func (p *DestroyVirtualMachineParams) GetVolumeids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.volumeids.v, p.volumeids.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DestroyVirtualMachineParams) Clone() *DestroyVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.volumeids = p.volumeids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DestroyVirtualMachineParams) Equal(o *DestroyVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.expunge == o.expunge &&
+		p.id == o.id &&
+		p.volumeids.equal(o.volumeids)
+}
+
+// serializedDestroyVirtualMachineParams is used to (un)marshal DestroyVirtualMachineParams using the API param names
+type serializedDestroyVirtualMachineParams struct {
+	Expunge   *bool     `json:"expunge,omitempty" yaml:"expunge,omitempty"`
+	Id        *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Volumeids *[]string `json:"volumeids,omitempty" yaml:"volumeids,omitempty"`
+}
+
+func (p *DestroyVirtualMachineParams) toSerialized() *serializedDestroyVirtualMachineParams {
+	s := &serializedDestroyVirtualMachineParams{}
+	if p.expunge.ok {
+		s.Expunge = &p.expunge.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.volumeids.ok {
+		s.Volumeids = &p.volumeids.v
+	}
+	return s
+}
+
+func (p *DestroyVirtualMachineParams) fromSerialized(s *serializedDestroyVirtualMachineParams) {
+	*p = DestroyVirtualMachineParams{}
+	if s.Expunge != nil {
+		p.SetExpunge(*s.Expunge)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Volumeids != nil {
+		p.SetVolumeids(*s.Volumeids)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DestroyVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DestroyVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedDestroyVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DestroyVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DestroyVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDestroyVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["volumeids"].([]string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DestroyVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams {
 	p := &DestroyVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Destroys a virtual machine. Once destroyed, only the administrator can recover it.
-func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("destroyVirtualMachine", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams, opts ...CallOption) (*DestroyVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("destroyVirtualMachine", s.cs.encodeParams("destroyVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2859,8 +3793,8 @@ func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachinePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3042,53 +3976,133 @@ func (r *DestroyVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ExpungeVirtualMachineParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ExpungeVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *ExpungeVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseExpungeVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new ExpungeVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseExpungeVirtualMachineParams(u url.Values) (*ExpungeVirtualMachineParams, error) {
+	p := &ExpungeVirtualMachineParams{}
+	if err := checkParamNames("expungeVirtualMachine", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ExpungeVirtualMachineParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ExpungeVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ExpungeVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ExpungeVirtualMachineParams) Clone() *ExpungeVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ExpungeVirtualMachineParams) Equal(o *ExpungeVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedExpungeVirtualMachineParams is used to (un)marshal ExpungeVirtualMachineParams using the API param names
+type serializedExpungeVirtualMachineParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *ExpungeVirtualMachineParams) toSerialized() *serializedExpungeVirtualMachineParams {
+	s := &serializedExpungeVirtualMachineParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *ExpungeVirtualMachineParams) fromSerialized(s *serializedExpungeVirtualMachineParams) {
+	*p = ExpungeVirtualMachineParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ExpungeVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ExpungeVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedExpungeVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ExpungeVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ExpungeVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedExpungeVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ExpungeVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams {
 	p := &ExpungeVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Expunge a virtual machine. Once expunged, it cannot be recoverd.
-func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("expungeVirtualMachine", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams, opts ...CallOption) (*ExpungeVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("expungeVirtualMachine", s.cs.encodeParams("expungeVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3099,8 +4113,8 @@ func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachinePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3124,53 +4138,132 @@ type ExpungeVirtualMachineResponse struct {
 }
 
 type GetVMPasswordParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *GetVMPasswordParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetVMPasswordParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *GetVMPasswordParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetVMPasswordParams parses url.Values, for example taken from a raw API request,
+// into a new GetVMPasswordParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetVMPasswordParams(u url.Values) (*GetVMPasswordParams, error) {
+	p := &GetVMPasswordParams{}
+	if err := checkParamNames("getVMPassword", u, "id"); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *GetVMPasswordParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *GetVMPasswordParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *GetVMPasswordParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetVMPasswordParams) Clone() *GetVMPasswordParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetVMPasswordParams) Equal(o *GetVMPasswordParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedGetVMPasswordParams is used to (un)marshal GetVMPasswordParams using the API param names
+type serializedGetVMPasswordParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *GetVMPasswordParams) toSerialized() *serializedGetVMPasswordParams {
+	s := &serializedGetVMPasswordParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *GetVMPasswordParams) fromSerialized(s *serializedGetVMPasswordParams) {
+	*p = GetVMPasswordParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetVMPasswordParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetVMPasswordParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetVMPasswordParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetVMPasswordParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetVMPasswordParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetVMPasswordParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetVMPasswordParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPasswordParams {
 	p := &GetVMPasswordParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Returns an encrypted password for the VM
-func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
-	resp, err := s.cs.newRequest("getVMPassword", p.toURLValues())
+// Returns an encrypted password for the VM.
+//
+// Required params: id.
+func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams, opts ...CallOption) (*GetVMPasswordResponse, error) {
+	resp, err := s.cs.newRequest("getVMPassword", s.cs.encodeParams("getVMPassword", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3190,1072 +4283,1420 @@ type GetVMPasswordResponse struct {
 }
 
 type ListVirtualMachinesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVirtualMachinesParams) toURLValues() url.Values {
+	account                   optString
+	accumulate                optBool
+	affinitygroupid           optString
+	autoscalevmgroupid        optString
+	backupofferingid          optString
+	clusterid                 optString
+	details                   optStrings
+	displayvm                 optBool
+	domainid                  optString
+	forvirtualnetwork         optBool
+	groupid                   optString
+	haenable                  optBool
+	hostid                    optString
+	hypervisor                optString
+	id                        optString
+	ids                       optStrings
+	isoid                     optString
+	isrecursive               optBool
+	isvnf                     optBool
+	keypair                   optString
+	keyword                   optString
+	listall                   optBool
+	name                      optString
+	networkid                 optString
+	page                      optInt
+	pagesize                  optInt
+	podid                     optString
+	projectid                 optString
+	retrieveonlyresourcecount optBool
+	securitygroupid           optString
+	serviceofferingid         optString
+	showicon                  optBool
+	state                     optString
+	storageid                 optString
+	tags                      optStringMap
+	templateid                optString
+	userdata                  optBool
+	userid                    optString
+	vpcid                     optString
+	zoneid                    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVirtualMachinesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accumulate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("accumulate", vv)
+	if p.accumulate.ok {
+		u.Set("accumulate", strconv.FormatBool(p.accumulate.v))
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if p.affinitygroupid.ok {
+		u.Set("affinitygroupid", p.affinitygroupid.v)
 	}
-	if v, found := p.p["autoscalevmgroupid"]; found {
-		u.Set("autoscalevmgroupid", v.(string))
+	if p.autoscalevmgroupid.ok {
+		u.Set("autoscalevmgroupid", p.autoscalevmgroupid.v)
 	}
-	if v, found := p.p["backupofferingid"]; found {
-		u.Set("backupofferingid", v.(string))
+	if p.backupofferingid.ok {
+		u.Set("backupofferingid", p.backupofferingid.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["displayvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvm", vv)
+	if p.displayvm.ok {
+		u.Set("displayvm", strconv.FormatBool(p.displayvm.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
+	if p.forvirtualnetwork.ok {
+		u.Set("forvirtualnetwork", strconv.FormatBool(p.forvirtualnetwork.v))
 	}
-	if v, found := p.p["groupid"]; found {
-		u.Set("groupid", v.(string))
+	if p.groupid.ok {
+		u.Set("groupid", p.groupid.v)
 	}
-	if v, found := p.p["haenable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("haenable", vv)
+	if p.haenable.ok {
+		u.Set("haenable", strconv.FormatBool(p.haenable.v))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["isoid"]; found {
-		u.Set("isoid", v.(string))
+	if p.isoid.ok {
+		u.Set("isoid", p.isoid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["isvnf"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isvnf", vv)
+	if p.isvnf.ok {
+		u.Set("isvnf", strconv.FormatBool(p.isvnf.v))
 	}
-	if v, found := p.p["keypair"]; found {
-		u.Set("keypair", v.(string))
+	if p.keypair.ok {
+		u.Set("keypair", p.keypair.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["retrieveonlyresourcecount"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("retrieveonlyresourcecount", vv)
+	if p.retrieveonlyresourcecount.ok {
+		u.Set("retrieveonlyresourcecount", strconv.FormatBool(p.retrieveonlyresourcecount.v))
 	}
-	if v, found := p.p["securitygroupid"]; found {
-		u.Set("securitygroupid", v.(string))
+	if p.securitygroupid.ok {
+		u.Set("securitygroupid", p.securitygroupid.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["userdata"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("userdata", vv)
+	if p.userdata.ok {
+		u.Set("userdata", strconv.FormatBool(p.userdata.v))
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListVirtualMachinesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVirtualMachinesParams parses url.Values, for example taken from a raw API request,
+// into a new ListVirtualMachinesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVirtualMachinesParams(u url.Values) (*ListVirtualMachinesParams, error) {
+	p := &ListVirtualMachinesParams{}
+	if err := checkParamNames("listVirtualMachines", u, "account", "accumulate", "affinitygroupid", "autoscalevmgroupid", "backupofferingid", "clusterid", "details", "displayvm", "domainid", "forvirtualnetwork", "groupid", "haenable", "hostid", "hypervisor", "id", "ids", "isoid", "isrecursive", "isvnf", "keypair", "keyword", "listall", "name", "networkid", "page", "pagesize", "podid", "projectid", "retrieveonlyresourcecount", "securitygroupid", "serviceofferingid", "showicon", "state", "storageid", "tags[]", "templateid", "userdata", "userid", "vpcid", "zoneid"); err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
-}
-
-func (p *ListVirtualMachinesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-}
-
-func (p *ListVirtualMachinesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["accumulate"]; found {
+		v, err := strconv.ParseBool(u.Get("accumulate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accumulate: %v", err)
+		}
+		p.SetAccumulate(v)
 	}
-	value, ok := p.p["account"].(string)
-	return value, ok
-}
-
-func (p *ListVirtualMachinesParams) SetAccumulate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["affinitygroupid"]; found {
+		p.SetAffinitygroupid(u.Get("affinitygroupid"))
 	}
-	p.p["accumulate"] = v
-}
-
-func (p *ListVirtualMachinesParams) ResetAccumulate() {
-	if p.p != nil && p.p["accumulate"] != nil {
-		delete(p.p, "accumulate")
+	if _, found := u["autoscalevmgroupid"]; found {
+		p.SetAutoscalevmgroupid(u.Get("autoscalevmgroupid"))
 	}
-}
-
-func (p *ListVirtualMachinesParams) GetAccumulate() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["backupofferingid"]; found {
+		p.SetBackupofferingid(u.Get("backupofferingid"))
 	}
-	value, ok := p.p["accumulate"].(bool)
-	return value, ok
-}
-
-func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
 	}
-	p.p["affinitygroupid"] = v
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["displayvm"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvm: %v", err)
+		}
+		p.SetDisplayvm(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["forvirtualnetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("forvirtualnetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvirtualnetwork: %v", err)
+		}
+		p.SetForvirtualnetwork(v)
+	}
+	if _, found := u["groupid"]; found {
+		p.SetGroupid(u.Get("groupid"))
+	}
+	if _, found := u["haenable"]; found {
+		v, err := strconv.ParseBool(u.Get("haenable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param haenable: %v", err)
+		}
+		p.SetHaenable(v)
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["isoid"]; found {
+		p.SetIsoid(u.Get("isoid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["isvnf"]; found {
+		v, err := strconv.ParseBool(u.Get("isvnf"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isvnf: %v", err)
+		}
+		p.SetIsvnf(v)
+	}
+	if _, found := u["keypair"]; found {
+		p.SetKeypair(u.Get("keypair"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["retrieveonlyresourcecount"]; found {
+		v, err := strconv.ParseBool(u.Get("retrieveonlyresourcecount"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param retrieveonlyresourcecount: %v", err)
+		}
+		p.SetRetrieveonlyresourcecount(v)
+	}
+	if _, found := u["securitygroupid"]; found {
+		p.SetSecuritygroupid(u.Get("securitygroupid"))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["userdata"]; found {
+		v, err := strconv.ParseBool(u.Get("userdata"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param userdata: %v", err)
+		}
+		p.SetUserdata(v)
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVirtualMachinesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *ListVirtualMachinesParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *ListVirtualMachinesParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetAccumulate sets the accumulate param.
+func (p *ListVirtualMachinesParams) SetAccumulate(v bool) {
+	p.accumulate = optBool{v: v, ok: true}
+}
+
+// ResetAccumulate unsets the accumulate param
+func (p *ListVirtualMachinesParams) ResetAccumulate() {
+	p.accumulate = optBool{}
 }
 
+// GetAccumulate returns the accumulate param and if it is set
+func (p *ListVirtualMachinesParams) GetAccumulate() (bool, bool) {
+	return p.accumulate.v, p.accumulate.ok
+}
+
+// SetAffinitygroupid sets the affinitygroupid param.
+func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
+	p.affinitygroupid = optString{v: v, ok: true}
+}
+
+// ResetAffinitygroupid unsets the affinitygroupid param
 func (p *ListVirtualMachinesParams) ResetAffinitygroupid() {
-	if p.p != nil && p.p["affinitygroupid"] != nil {
-		delete(p.p, "affinitygroupid")
-	}
+	p.affinitygroupid = optString{}
 }
 
+// GetAffinitygroupid returns the affinitygroupid param and if it is set
 func (p *ListVirtualMachinesParams) GetAffinitygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupid"].(string)
-	return value, ok
+	return p.affinitygroupid.v, p.affinitygroupid.ok
 }
 
+// SetAutoscalevmgroupid sets the autoscalevmgroupid param.
 func (p *ListVirtualMachinesParams) SetAutoscalevmgroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["autoscalevmgroupid"] = v
+	p.autoscalevmgroupid = optString{v: v, ok: true}
 }
 
+// ResetAutoscalevmgroupid unsets the autoscalevmgroupid param
 func (p *ListVirtualMachinesParams) ResetAutoscalevmgroupid() {
-	if p.p != nil && p.p["autoscalevmgroupid"] != nil {
-		delete(p.p, "autoscalevmgroupid")
-	}
+	p.autoscalevmgroupid = optString{}
 }
 
+// GetAutoscalevmgroupid returns the autoscalevmgroupid param and if it is set
 func (p *ListVirtualMachinesParams) GetAutoscalevmgroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoscalevmgroupid"].(string)
-	return value, ok
+	return p.autoscalevmgroupid.v, p.autoscalevmgroupid.ok
 }
 
+// SetBackupofferingid sets the backupofferingid param.
 func (p *ListVirtualMachinesParams) SetBackupofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["backupofferingid"] = v
+	p.backupofferingid = optString{v: v, ok: true}
 }
 
+// ResetBackupofferingid unsets the backupofferingid param
 func (p *ListVirtualMachinesParams) ResetBackupofferingid() {
-	if p.p != nil && p.p["backupofferingid"] != nil {
-		delete(p.p, "backupofferingid")
-	}
+	p.backupofferingid = optString{}
 }
 
+// GetBackupofferingid returns the backupofferingid param and if it is set
 func (p *ListVirtualMachinesParams) GetBackupofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["backupofferingid"].(string)
-	return value, ok
+	return p.backupofferingid.v, p.backupofferingid.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ListVirtualMachinesParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListVirtualMachinesParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListVirtualMachinesParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDetails sets the details param.
 func (p *ListVirtualMachinesParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStrings{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ListVirtualMachinesParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListVirtualMachinesParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplayvm sets the displayvm param.
 func (p *ListVirtualMachinesParams) SetDisplayvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvm"] = v
+	p.displayvm = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvm unsets the displayvm param
 func (p *ListVirtualMachinesParams) ResetDisplayvm() {
-	if p.p != nil && p.p["displayvm"] != nil {
-		delete(p.p, "displayvm")
-	}
+	p.displayvm = optBool{}
 }
 
+// GetDisplayvm returns the displayvm param and if it is set
 func (p *ListVirtualMachinesParams) GetDisplayvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvm"].(bool)
-	return value, ok
+	return p.displayvm.v, p.displayvm.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVirtualMachinesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVirtualMachinesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVirtualMachinesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetForvirtualnetwork sets the forvirtualnetwork param.
 func (p *ListVirtualMachinesParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvirtualnetwork"] = v
+	p.forvirtualnetwork = optBool{v: v, ok: true}
 }
 
+// ResetForvirtualnetwork unsets the forvirtualnetwork param
 func (p *ListVirtualMachinesParams) ResetForvirtualnetwork() {
-	if p.p != nil && p.p["forvirtualnetwork"] != nil {
-		delete(p.p, "forvirtualnetwork")
-	}
+	p.forvirtualnetwork = optBool{}
 }
 
+// GetForvirtualnetwork returns the forvirtualnetwork param and if it is set
 func (p *ListVirtualMachinesParams) GetForvirtualnetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvirtualnetwork"].(bool)
-	return value, ok
+	return p.forvirtualnetwork.v, p.forvirtualnetwork.ok
 }
 
+// SetGroupid sets the groupid param.
 func (p *ListVirtualMachinesParams) SetGroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["groupid"] = v
+	p.groupid = optString{v: v, ok: true}
 }
 
+// ResetGroupid unsets the groupid param
 func (p *ListVirtualMachinesParams) ResetGroupid() {
-	if p.p != nil && p.p["groupid"] != nil {
-		delete(p.p, "groupid")
-	}
+	p.groupid = optString{}
 }
 
+// GetGroupid returns the groupid param and if it is set
 func (p *ListVirtualMachinesParams) GetGroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["groupid"].(string)
-	return value, ok
+	return p.groupid.v, p.groupid.ok
 }
 
+// SetHaenable sets the haenable param.
 func (p *ListVirtualMachinesParams) SetHaenable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["haenable"] = v
+	p.haenable = optBool{v: v, ok: true}
 }
 
+// ResetHaenable unsets the haenable param
 func (p *ListVirtualMachinesParams) ResetHaenable() {
-	if p.p != nil && p.p["haenable"] != nil {
-		delete(p.p, "haenable")
-	}
+	p.haenable = optBool{}
 }
 
+// GetHaenable returns the haenable param and if it is set
 func (p *ListVirtualMachinesParams) GetHaenable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["haenable"].(bool)
-	return value, ok
+	return p.haenable.v, p.haenable.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListVirtualMachinesParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListVirtualMachinesParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListVirtualMachinesParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListVirtualMachinesParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListVirtualMachinesParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListVirtualMachinesParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListVirtualMachinesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVirtualMachinesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVirtualMachinesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListVirtualMachinesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListVirtualMachinesParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListVirtualMachinesParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetIsoid sets the isoid param.
 func (p *ListVirtualMachinesParams) SetIsoid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isoid"] = v
+	p.isoid = optString{v: v, ok: true}
 }
 
+// ResetIsoid unsets the isoid param
 func (p *ListVirtualMachinesParams) ResetIsoid() {
-	if p.p != nil && p.p["isoid"] != nil {
-		delete(p.p, "isoid")
-	}
+	p.isoid = optString{}
 }
 
+// GetIsoid returns the isoid param and if it is set
 func (p *ListVirtualMachinesParams) GetIsoid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isoid"].(string)
-	return value, ok
+	return p.isoid.v, p.isoid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVirtualMachinesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVirtualMachinesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVirtualMachinesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetIsvnf sets the isvnf param.
 func (p *ListVirtualMachinesParams) SetIsvnf(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isvnf"] = v
+	p.isvnf = optBool{v: v, ok: true}
 }
 
+// ResetIsvnf unsets the isvnf param
 func (p *ListVirtualMachinesParams) ResetIsvnf() {
-	if p.p != nil && p.p["isvnf"] != nil {
-		delete(p.p, "isvnf")
-	}
+	p.isvnf = optBool{}
 }
 
+// GetIsvnf returns the isvnf param and if it is set
 func (p *ListVirtualMachinesParams) GetIsvnf() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isvnf"].(bool)
-	return value, ok
+	return p.isvnf.v, p.isvnf.ok
 }
 
+// SetKeypair sets the keypair param.
 func (p *ListVirtualMachinesParams) SetKeypair(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypair"] = v
+	p.keypair = optString{v: v, ok: true}
 }
 
+// ResetKeypair unsets the keypair param
 func (p *ListVirtualMachinesParams) ResetKeypair() {
-	if p.p != nil && p.p["keypair"] != nil {
-		delete(p.p, "keypair")
-	}
+	p.keypair = optString{}
 }
 
+// GetKeypair returns the keypair param and if it is set
 func (p *ListVirtualMachinesParams) GetKeypair() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keypair"].(string)
-	return value, ok
+	return p.keypair.v, p.keypair.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVirtualMachinesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVirtualMachinesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVirtualMachinesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVirtualMachinesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVirtualMachinesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVirtualMachinesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListVirtualMachinesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVirtualMachinesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVirtualMachinesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListVirtualMachinesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListVirtualMachinesParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListVirtualMachinesParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVirtualMachinesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVirtualMachinesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVirtualMachinesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVirtualMachinesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVirtualMachinesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVirtualMachinesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListVirtualMachinesParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListVirtualMachinesParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListVirtualMachinesParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVirtualMachinesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVirtualMachinesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVirtualMachinesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRetrieveonlyresourcecount sets the retrieveonlyresourcecount param.
 func (p *ListVirtualMachinesParams) SetRetrieveonlyresourcecount(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["retrieveonlyresourcecount"] = v
+	p.retrieveonlyresourcecount = optBool{v: v, ok: true}
 }
 
+// ResetRetrieveonlyresourcecount unsets the retrieveonlyresourcecount param
 func (p *ListVirtualMachinesParams) ResetRetrieveonlyresourcecount() {
-	if p.p != nil && p.p["retrieveonlyresourcecount"] != nil {
-		delete(p.p, "retrieveonlyresourcecount")
-	}
+	p.retrieveonlyresourcecount = optBool{}
 }
 
+// GetRetrieveonlyresourcecount returns the retrieveonlyresourcecount param and if it is set
 func (p *ListVirtualMachinesParams) GetRetrieveonlyresourcecount() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["retrieveonlyresourcecount"].(bool)
-	return value, ok
+	return p.retrieveonlyresourcecount.v, p.retrieveonlyresourcecount.ok
 }
 
+// SetSecuritygroupid sets the securitygroupid param.
 func (p *ListVirtualMachinesParams) SetSecuritygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupid"] = v
+	p.securitygroupid = optString{v: v, ok: true}
 }
 
+// ResetSecuritygroupid unsets the securitygroupid param
 func (p *ListVirtualMachinesParams) ResetSecuritygroupid() {
-	if p.p != nil && p.p["securitygroupid"] != nil {
-		delete(p.p, "securitygroupid")
-	}
+	p.securitygroupid = optString{}
 }
 
+// GetSecuritygroupid returns the securitygroupid param and if it is set
 func (p *ListVirtualMachinesParams) GetSecuritygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupid"].(string)
-	return value, ok
+	return p.securitygroupid.v, p.securitygroupid.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param.
 func (p *ListVirtualMachinesParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ListVirtualMachinesParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ListVirtualMachinesParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListVirtualMachinesParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListVirtualMachinesParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListVirtualMachinesParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetState sets the state param.
 func (p *ListVirtualMachinesParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListVirtualMachinesParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListVirtualMachinesParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListVirtualMachinesParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListVirtualMachinesParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListVirtualMachinesParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListVirtualMachinesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListVirtualMachinesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListVirtualMachinesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetTemplateid sets the templateid param.
 func (p *ListVirtualMachinesParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *ListVirtualMachinesParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *ListVirtualMachinesParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetUserdata sets the userdata param.
 func (p *ListVirtualMachinesParams) SetUserdata(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
+	p.userdata = optBool{v: v, ok: true}
 }
 
+// ResetUserdata unsets the userdata param
 func (p *ListVirtualMachinesParams) ResetUserdata() {
-	if p.p != nil && p.p["userdata"] != nil {
-		delete(p.p, "userdata")
-	}
+	p.userdata = optBool{}
 }
 
+// GetUserdata returns the userdata param and if it is set
 func (p *ListVirtualMachinesParams) GetUserdata() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdata"].(bool)
-	return value, ok
+	return p.userdata.v, p.userdata.ok
 }
 
+// SetUserid sets the userid param.
 func (p *ListVirtualMachinesParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *ListVirtualMachinesParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *ListVirtualMachinesParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	return p.userid.v, p.userid.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListVirtualMachinesParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListVirtualMachinesParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListVirtualMachinesParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListVirtualMachinesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListVirtualMachinesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListVirtualMachinesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVirtualMachinesParams) Clone() *ListVirtualMachinesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVirtualMachinesParams) Equal(o *ListVirtualMachinesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accumulate == o.accumulate &&
+		p.affinitygroupid == o.affinitygroupid &&
+		p.autoscalevmgroupid == o.autoscalevmgroupid &&
+		p.backupofferingid == o.backupofferingid &&
+		p.clusterid == o.clusterid &&
+		p.details.equal(o.details) &&
+		p.displayvm == o.displayvm &&
+		p.domainid == o.domainid &&
+		p.forvirtualnetwork == o.forvirtualnetwork &&
+		p.groupid == o.groupid &&
+		p.haenable == o.haenable &&
+		p.hostid == o.hostid &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.isoid == o.isoid &&
+		p.isrecursive == o.isrecursive &&
+		p.isvnf == o.isvnf &&
+		p.keypair == o.keypair &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.retrieveonlyresourcecount == o.retrieveonlyresourcecount &&
+		p.securitygroupid == o.securitygroupid &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.showicon == o.showicon &&
+		p.state == o.state &&
+		p.storageid == o.storageid &&
+		p.tags.equal(o.tags) &&
+		p.templateid == o.templateid &&
+		p.userdata == o.userdata &&
+		p.userid == o.userid &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListVirtualMachinesParams is used to (un)marshal ListVirtualMachinesParams using the API param names
+type serializedListVirtualMachinesParams struct {
+	Account                   *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Accumulate                *bool              `json:"accumulate,omitempty" yaml:"accumulate,omitempty"`
+	Affinitygroupid           *string            `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Autoscalevmgroupid        *string            `json:"autoscalevmgroupid,omitempty" yaml:"autoscalevmgroupid,omitempty"`
+	Backupofferingid          *string            `json:"backupofferingid,omitempty" yaml:"backupofferingid,omitempty"`
+	Clusterid                 *string            `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Details                   *[]string          `json:"details,omitempty" yaml:"details,omitempty"`
+	Displayvm                 *bool              `json:"displayvm,omitempty" yaml:"displayvm,omitempty"`
+	Domainid                  *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvirtualnetwork         *bool              `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Groupid                   *string            `json:"groupid,omitempty" yaml:"groupid,omitempty"`
+	Haenable                  *bool              `json:"haenable,omitempty" yaml:"haenable,omitempty"`
+	Hostid                    *string            `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Hypervisor                *string            `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id                        *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids                       *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Isoid                     *string            `json:"isoid,omitempty" yaml:"isoid,omitempty"`
+	Isrecursive               *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Isvnf                     *bool              `json:"isvnf,omitempty" yaml:"isvnf,omitempty"`
+	Keypair                   *string            `json:"keypair,omitempty" yaml:"keypair,omitempty"`
+	Keyword                   *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                   *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name                      *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid                 *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page                      *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                  *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                     *string            `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid                 *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Retrieveonlyresourcecount *bool              `json:"retrieveonlyresourcecount,omitempty" yaml:"retrieveonlyresourcecount,omitempty"`
+	Securitygroupid           *string            `json:"securitygroupid,omitempty" yaml:"securitygroupid,omitempty"`
+	Serviceofferingid         *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Showicon                  *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	State                     *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid                 *string            `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags                      *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Templateid                *string            `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Userdata                  *bool              `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Userid                    *string            `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Vpcid                     *string            `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid                    *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListVirtualMachinesParams) toSerialized() *serializedListVirtualMachinesParams {
+	s := &serializedListVirtualMachinesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accumulate.ok {
+		s.Accumulate = &p.accumulate.v
+	}
+	if p.affinitygroupid.ok {
+		s.Affinitygroupid = &p.affinitygroupid.v
+	}
+	if p.autoscalevmgroupid.ok {
+		s.Autoscalevmgroupid = &p.autoscalevmgroupid.v
+	}
+	if p.backupofferingid.ok {
+		s.Backupofferingid = &p.backupofferingid.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displayvm.ok {
+		s.Displayvm = &p.displayvm.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.forvirtualnetwork.ok {
+		s.Forvirtualnetwork = &p.forvirtualnetwork.v
+	}
+	if p.groupid.ok {
+		s.Groupid = &p.groupid.v
+	}
+	if p.haenable.ok {
+		s.Haenable = &p.haenable.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
-}
-
-// You should always use this function to get a new ListVirtualMachinesParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachinesParams {
-	p := &ListVirtualMachinesParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error) {
-	p := &ListVirtualMachinesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
-
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return "", -1, err
-		}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
 	}
-
-	l, err := s.ListVirtualMachines(p)
-	if err != nil {
-		return "", -1, err
+	if p.id.ok {
+		s.Id = &p.id.v
 	}
-
-	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	if p.ids.ok {
+		s.Ids = &p.ids.v
 	}
-
-	if l.Count == 1 {
-		return l.VirtualMachines[0].Id, l.Count, nil
+	if p.isoid.ok {
+		s.Isoid = &p.isoid.v
 	}
-
-	if l.Count > 1 {
-		for _, v := range l.VirtualMachines {
-			if v.Name == name {
-				return v.Id, l.Count, nil
-			}
-		}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VirtualMachineService) GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
-	id, count, err := s.GetVirtualMachineID(name, opts...)
-	if err != nil {
-		return nil, count, err
+	if p.isvnf.ok {
+		s.Isvnf = &p.isvnf.v
 	}
-
-	r, count, err := s.GetVirtualMachineByID(id, opts...)
-	if err != nil {
-		return nil, count, err
+	if p.keypair.ok {
+		s.Keypair = &p.keypair.v
 	}
-	return r, count, nil
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
-	p := &ListVirtualMachinesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
-		}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
 	}
-
-	l, err := s.ListVirtualMachines(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.retrieveonlyresourcecount.ok {
+		s.Retrieveonlyresourcecount = &p.retrieveonlyresourcecount.v
+	}
+	if p.securitygroupid.ok {
+		s.Securitygroupid = &p.securitygroupid.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListVirtualMachinesParams) fromSerialized(s *serializedListVirtualMachinesParams) {
+	*p = ListVirtualMachinesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accumulate != nil {
+		p.SetAccumulate(*s.Accumulate)
+	}
+	if s.Affinitygroupid != nil {
+		p.SetAffinitygroupid(*s.Affinitygroupid)
+	}
+	if s.Autoscalevmgroupid != nil {
+		p.SetAutoscalevmgroupid(*s.Autoscalevmgroupid)
+	}
+	if s.Backupofferingid != nil {
+		p.SetBackupofferingid(*s.Backupofferingid)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displayvm != nil {
+		p.SetDisplayvm(*s.Displayvm)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Forvirtualnetwork != nil {
+		p.SetForvirtualnetwork(*s.Forvirtualnetwork)
+	}
+	if s.Groupid != nil {
+		p.SetGroupid(*s.Groupid)
+	}
+	if s.Haenable != nil {
+		p.SetHaenable(*s.Haenable)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Isoid != nil {
+		p.SetIsoid(*s.Isoid)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Isvnf != nil {
+		p.SetIsvnf(*s.Isvnf)
+	}
+	if s.Keypair != nil {
+		p.SetKeypair(*s.Keypair)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Retrieveonlyresourcecount != nil {
+		p.SetRetrieveonlyresourcecount(*s.Retrieveonlyresourcecount)
+	}
+	if s.Securitygroupid != nil {
+		p.SetSecuritygroupid(*s.Securitygroupid)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVirtualMachinesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVirtualMachinesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVirtualMachinesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVirtualMachinesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVirtualMachinesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVirtualMachinesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ListVirtualMachinesParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachinesParams {
+	p := &ListVirtualMachinesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListVirtualMachinesParams{}
+
+	p.SetName(name)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListVirtualMachines(p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.VirtualMachines[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.VirtualMachines {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VirtualMachineService) GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	id, count, err := s.GetVirtualMachineID(name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetVirtualMachineByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	p := &ListVirtualMachinesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListVirtualMachines(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
@@ -4271,8 +5712,8 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 }
 
 // List the virtual machines owned by the account.
-func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachines", p.toURLValues())
+func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams, opts ...CallOption) (*ListVirtualMachinesResponse, error) {
+	resp, err := s.cs.newRequest("listVirtualMachines", s.cs.encodeParams("listVirtualMachines", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4451,1009 +5892,1358 @@ func (r *VirtualMachine) UnmarshalJSON(b []byte) error {
 }
 
 type ListVirtualMachinesMetricsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVirtualMachinesMetricsParams) toURLValues() url.Values {
+	account                   optString
+	accumulate                optBool
+	affinitygroupid           optString
+	autoscalevmgroupid        optString
+	backupofferingid          optString
+	clusterid                 optString
+	details                   optStrings
+	displayvm                 optBool
+	domainid                  optString
+	forvirtualnetwork         optBool
+	groupid                   optString
+	haenable                  optBool
+	hostid                    optString
+	hypervisor                optString
+	id                        optString
+	ids                       optStrings
+	isoid                     optString
+	isrecursive               optBool
+	isvnf                     optBool
+	keypair                   optString
+	keyword                   optString
+	listall                   optBool
+	name                      optString
+	networkid                 optString
+	page                      optInt
+	pagesize                  optInt
+	podid                     optString
+	projectid                 optString
+	retrieveonlyresourcecount optBool
+	securitygroupid           optString
+	serviceofferingid         optString
+	showicon                  optBool
+	state                     optString
+	storageid                 optString
+	tags                      optStringMap
+	templateid                optString
+	userdata                  optBool
+	userid                    optString
+	vpcid                     optString
+	zoneid                    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVirtualMachinesMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["accumulate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("accumulate", vv)
+	if p.accumulate.ok {
+		u.Set("accumulate", strconv.FormatBool(p.accumulate.v))
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if p.affinitygroupid.ok {
+		u.Set("affinitygroupid", p.affinitygroupid.v)
 	}
-	if v, found := p.p["autoscalevmgroupid"]; found {
-		u.Set("autoscalevmgroupid", v.(string))
+	if p.autoscalevmgroupid.ok {
+		u.Set("autoscalevmgroupid", p.autoscalevmgroupid.v)
 	}
-	if v, found := p.p["backupofferingid"]; found {
-		u.Set("backupofferingid", v.(string))
+	if p.backupofferingid.ok {
+		u.Set("backupofferingid", p.backupofferingid.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
+	if p.details.ok {
+		u.Set("details", strings.Join(p.details.v, ","))
 	}
-	if v, found := p.p["displayvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvm", vv)
+	if p.displayvm.ok {
+		u.Set("displayvm", strconv.FormatBool(p.displayvm.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
+	if p.forvirtualnetwork.ok {
+		u.Set("forvirtualnetwork", strconv.FormatBool(p.forvirtualnetwork.v))
 	}
-	if v, found := p.p["groupid"]; found {
-		u.Set("groupid", v.(string))
+	if p.groupid.ok {
+		u.Set("groupid", p.groupid.v)
 	}
-	if v, found := p.p["haenable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("haenable", vv)
+	if p.haenable.ok {
+		u.Set("haenable", strconv.FormatBool(p.haenable.v))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if p.hypervisor.ok {
+		u.Set("hypervisor", p.hypervisor.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["isoid"]; found {
-		u.Set("isoid", v.(string))
+	if p.isoid.ok {
+		u.Set("isoid", p.isoid.v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["isvnf"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isvnf", vv)
+	if p.isvnf.ok {
+		u.Set("isvnf", strconv.FormatBool(p.isvnf.v))
 	}
-	if v, found := p.p["keypair"]; found {
-		u.Set("keypair", v.(string))
+	if p.keypair.ok {
+		u.Set("keypair", p.keypair.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if p.networkid.ok {
+		u.Set("networkid", p.networkid.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["retrieveonlyresourcecount"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("retrieveonlyresourcecount", vv)
+	if p.retrieveonlyresourcecount.ok {
+		u.Set("retrieveonlyresourcecount", strconv.FormatBool(p.retrieveonlyresourcecount.v))
 	}
-	if v, found := p.p["securitygroupid"]; found {
-		u.Set("securitygroupid", v.(string))
+	if p.securitygroupid.ok {
+		u.Set("securitygroupid", p.securitygroupid.v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
 	}
-	if v, found := p.p["userdata"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("userdata", vv)
+	if p.userdata.ok {
+		u.Set("userdata", strconv.FormatBool(p.userdata.v))
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if p.userid.ok {
+		u.Set("userid", p.userid.v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if p.vpcid.ok {
+		u.Set("vpcid", p.vpcid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListVirtualMachinesMetricsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVirtualMachinesMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListVirtualMachinesMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVirtualMachinesMetricsParams(u url.Values) (*ListVirtualMachinesMetricsParams, error) {
+	p := &ListVirtualMachinesMetricsParams{}
+	if err := checkParamNames("listVirtualMachinesMetrics", u, "account", "accumulate", "affinitygroupid", "autoscalevmgroupid", "backupofferingid", "clusterid", "details", "displayvm", "domainid", "forvirtualnetwork", "groupid", "haenable", "hostid", "hypervisor", "id", "ids", "isoid", "isrecursive", "isvnf", "keypair", "keyword", "listall", "name", "networkid", "page", "pagesize", "podid", "projectid", "retrieveonlyresourcecount", "securitygroupid", "serviceofferingid", "showicon", "state", "storageid", "tags[]", "templateid", "userdata", "userid", "vpcid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["accumulate"]; found {
+		v, err := strconv.ParseBool(u.Get("accumulate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param accumulate: %v", err)
+		}
+		p.SetAccumulate(v)
+	}
+	if _, found := u["affinitygroupid"]; found {
+		p.SetAffinitygroupid(u.Get("affinitygroupid"))
+	}
+	if _, found := u["autoscalevmgroupid"]; found {
+		p.SetAutoscalevmgroupid(u.Get("autoscalevmgroupid"))
+	}
+	if _, found := u["backupofferingid"]; found {
+		p.SetBackupofferingid(u.Get("backupofferingid"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["details"]; found {
+		p.SetDetails(strings.Split(u.Get("details"), ","))
+	}
+	if _, found := u["displayvm"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvm: %v", err)
+		}
+		p.SetDisplayvm(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["forvirtualnetwork"]; found {
+		v, err := strconv.ParseBool(u.Get("forvirtualnetwork"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forvirtualnetwork: %v", err)
+		}
+		p.SetForvirtualnetwork(v)
+	}
+	if _, found := u["groupid"]; found {
+		p.SetGroupid(u.Get("groupid"))
+	}
+	if _, found := u["haenable"]; found {
+		v, err := strconv.ParseBool(u.Get("haenable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param haenable: %v", err)
+		}
+		p.SetHaenable(v)
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["hypervisor"]; found {
+		p.SetHypervisor(u.Get("hypervisor"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["isoid"]; found {
+		p.SetIsoid(u.Get("isoid"))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["isvnf"]; found {
+		v, err := strconv.ParseBool(u.Get("isvnf"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isvnf: %v", err)
+		}
+		p.SetIsvnf(v)
+	}
+	if _, found := u["keypair"]; found {
+		p.SetKeypair(u.Get("keypair"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networkid"]; found {
+		p.SetNetworkid(u.Get("networkid"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["retrieveonlyresourcecount"]; found {
+		v, err := strconv.ParseBool(u.Get("retrieveonlyresourcecount"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param retrieveonlyresourcecount: %v", err)
+		}
+		p.SetRetrieveonlyresourcecount(v)
+	}
+	if _, found := u["securitygroupid"]; found {
+		p.SetSecuritygroupid(u.Get("securitygroupid"))
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
 	}
-	p.p["account"] = v
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["userdata"]; found {
+		v, err := strconv.ParseBool(u.Get("userdata"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param userdata: %v", err)
+		}
+		p.SetUserdata(v)
+	}
+	if _, found := u["userid"]; found {
+		p.SetUserid(u.Get("userid"))
+	}
+	if _, found := u["vpcid"]; found {
+		p.SetVpcid(u.Get("vpcid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVirtualMachinesMetricsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListVirtualMachinesMetricsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAccumulate sets the accumulate param.
 func (p *ListVirtualMachinesMetricsParams) SetAccumulate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accumulate"] = v
+	p.accumulate = optBool{v: v, ok: true}
 }
 
+// ResetAccumulate unsets the accumulate param
 func (p *ListVirtualMachinesMetricsParams) ResetAccumulate() {
-	if p.p != nil && p.p["accumulate"] != nil {
-		delete(p.p, "accumulate")
-	}
+	p.accumulate = optBool{}
 }
 
+// GetAccumulate returns the accumulate param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetAccumulate() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["accumulate"].(bool)
-	return value, ok
+	return p.accumulate.v, p.accumulate.ok
 }
 
+// SetAffinitygroupid sets the affinitygroupid param.
 func (p *ListVirtualMachinesMetricsParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
+	p.affinitygroupid = optString{v: v, ok: true}
 }
 
+// ResetAffinitygroupid unsets the affinitygroupid param
 func (p *ListVirtualMachinesMetricsParams) ResetAffinitygroupid() {
-	if p.p != nil && p.p["affinitygroupid"] != nil {
-		delete(p.p, "affinitygroupid")
-	}
+	p.affinitygroupid = optString{}
 }
 
+// GetAffinitygroupid returns the affinitygroupid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetAffinitygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupid"].(string)
-	return value, ok
+	return p.affinitygroupid.v, p.affinitygroupid.ok
 }
 
+// SetAutoscalevmgroupid sets the autoscalevmgroupid param.
 func (p *ListVirtualMachinesMetricsParams) SetAutoscalevmgroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["autoscalevmgroupid"] = v
+	p.autoscalevmgroupid = optString{v: v, ok: true}
 }
 
+// ResetAutoscalevmgroupid unsets the autoscalevmgroupid param
 func (p *ListVirtualMachinesMetricsParams) ResetAutoscalevmgroupid() {
-	if p.p != nil && p.p["autoscalevmgroupid"] != nil {
-		delete(p.p, "autoscalevmgroupid")
-	}
+	p.autoscalevmgroupid = optString{}
 }
 
+// GetAutoscalevmgroupid returns the autoscalevmgroupid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetAutoscalevmgroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoscalevmgroupid"].(string)
-	return value, ok
+	return p.autoscalevmgroupid.v, p.autoscalevmgroupid.ok
 }
 
+// SetBackupofferingid sets the backupofferingid param.
 func (p *ListVirtualMachinesMetricsParams) SetBackupofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["backupofferingid"] = v
+	p.backupofferingid = optString{v: v, ok: true}
 }
 
+// ResetBackupofferingid unsets the backupofferingid param
 func (p *ListVirtualMachinesMetricsParams) ResetBackupofferingid() {
-	if p.p != nil && p.p["backupofferingid"] != nil {
-		delete(p.p, "backupofferingid")
-	}
+	p.backupofferingid = optString{}
 }
 
+// GetBackupofferingid returns the backupofferingid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetBackupofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["backupofferingid"].(string)
-	return value, ok
+	return p.backupofferingid.v, p.backupofferingid.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ListVirtualMachinesMetricsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListVirtualMachinesMetricsParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDetails sets the details param.
 func (p *ListVirtualMachinesMetricsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStrings{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ListVirtualMachinesMetricsParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStrings{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetDetails() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].([]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDisplayvm sets the displayvm param.
 func (p *ListVirtualMachinesMetricsParams) SetDisplayvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvm"] = v
+	p.displayvm = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvm unsets the displayvm param
 func (p *ListVirtualMachinesMetricsParams) ResetDisplayvm() {
-	if p.p != nil && p.p["displayvm"] != nil {
-		delete(p.p, "displayvm")
-	}
+	p.displayvm = optBool{}
 }
 
+// GetDisplayvm returns the displayvm param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetDisplayvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvm"].(bool)
-	return value, ok
+	return p.displayvm.v, p.displayvm.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVirtualMachinesMetricsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVirtualMachinesMetricsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetForvirtualnetwork sets the forvirtualnetwork param.
 func (p *ListVirtualMachinesMetricsParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvirtualnetwork"] = v
+	p.forvirtualnetwork = optBool{v: v, ok: true}
 }
 
+// ResetForvirtualnetwork unsets the forvirtualnetwork param
 func (p *ListVirtualMachinesMetricsParams) ResetForvirtualnetwork() {
-	if p.p != nil && p.p["forvirtualnetwork"] != nil {
-		delete(p.p, "forvirtualnetwork")
-	}
+	p.forvirtualnetwork = optBool{}
 }
 
+// GetForvirtualnetwork returns the forvirtualnetwork param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetForvirtualnetwork() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forvirtualnetwork"].(bool)
-	return value, ok
+	return p.forvirtualnetwork.v, p.forvirtualnetwork.ok
 }
 
+// SetGroupid sets the groupid param.
 func (p *ListVirtualMachinesMetricsParams) SetGroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["groupid"] = v
+	p.groupid = optString{v: v, ok: true}
 }
 
+// ResetGroupid unsets the groupid param
 func (p *ListVirtualMachinesMetricsParams) ResetGroupid() {
-	if p.p != nil && p.p["groupid"] != nil {
-		delete(p.p, "groupid")
-	}
+	p.groupid = optString{}
 }
 
+// GetGroupid returns the groupid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetGroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["groupid"].(string)
-	return value, ok
+	return p.groupid.v, p.groupid.ok
 }
 
+// SetHaenable sets the haenable param.
 func (p *ListVirtualMachinesMetricsParams) SetHaenable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["haenable"] = v
+	p.haenable = optBool{v: v, ok: true}
 }
 
+// ResetHaenable unsets the haenable param
 func (p *ListVirtualMachinesMetricsParams) ResetHaenable() {
-	if p.p != nil && p.p["haenable"] != nil {
-		delete(p.p, "haenable")
-	}
+	p.haenable = optBool{}
 }
 
+// GetHaenable returns the haenable param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetHaenable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["haenable"].(bool)
-	return value, ok
+	return p.haenable.v, p.haenable.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListVirtualMachinesMetricsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListVirtualMachinesMetricsParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetHypervisor sets the hypervisor param.
 func (p *ListVirtualMachinesMetricsParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+	p.hypervisor = optString{v: v, ok: true}
 }
 
+// ResetHypervisor unsets the hypervisor param
 func (p *ListVirtualMachinesMetricsParams) ResetHypervisor() {
-	if p.p != nil && p.p["hypervisor"] != nil {
-		delete(p.p, "hypervisor")
-	}
+	p.hypervisor = optString{}
 }
 
+// GetHypervisor returns the hypervisor param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetHypervisor() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hypervisor"].(string)
-	return value, ok
+	return p.hypervisor.v, p.hypervisor.ok
 }
 
+// SetId sets the id param.
 func (p *ListVirtualMachinesMetricsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVirtualMachinesMetricsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListVirtualMachinesMetricsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListVirtualMachinesMetricsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetIsoid sets the isoid param.
 func (p *ListVirtualMachinesMetricsParams) SetIsoid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isoid"] = v
+	p.isoid = optString{v: v, ok: true}
 }
 
+// ResetIsoid unsets the isoid param
 func (p *ListVirtualMachinesMetricsParams) ResetIsoid() {
-	if p.p != nil && p.p["isoid"] != nil {
-		delete(p.p, "isoid")
-	}
+	p.isoid = optString{}
 }
 
+// GetIsoid returns the isoid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetIsoid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isoid"].(string)
-	return value, ok
+	return p.isoid.v, p.isoid.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVirtualMachinesMetricsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVirtualMachinesMetricsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetIsvnf sets the isvnf param.
 func (p *ListVirtualMachinesMetricsParams) SetIsvnf(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isvnf"] = v
+	p.isvnf = optBool{v: v, ok: true}
 }
 
+// ResetIsvnf unsets the isvnf param
 func (p *ListVirtualMachinesMetricsParams) ResetIsvnf() {
-	if p.p != nil && p.p["isvnf"] != nil {
-		delete(p.p, "isvnf")
-	}
+	p.isvnf = optBool{}
 }
 
+// GetIsvnf returns the isvnf param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetIsvnf() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isvnf"].(bool)
-	return value, ok
+	return p.isvnf.v, p.isvnf.ok
 }
 
+// SetKeypair sets the keypair param.
 func (p *ListVirtualMachinesMetricsParams) SetKeypair(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypair"] = v
+	p.keypair = optString{v: v, ok: true}
 }
 
+// ResetKeypair unsets the keypair param
 func (p *ListVirtualMachinesMetricsParams) ResetKeypair() {
-	if p.p != nil && p.p["keypair"] != nil {
-		delete(p.p, "keypair")
-	}
+	p.keypair = optString{}
 }
 
+// GetKeypair returns the keypair param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetKeypair() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keypair"].(string)
-	return value, ok
+	return p.keypair.v, p.keypair.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVirtualMachinesMetricsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVirtualMachinesMetricsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVirtualMachinesMetricsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVirtualMachinesMetricsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetName sets the name param.
 func (p *ListVirtualMachinesMetricsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVirtualMachinesMetricsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworkid sets the networkid param.
 func (p *ListVirtualMachinesMetricsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+	p.networkid = optString{v: v, ok: true}
 }
 
+// ResetNetworkid unsets the networkid param
 func (p *ListVirtualMachinesMetricsParams) ResetNetworkid() {
-	if p.p != nil && p.p["networkid"] != nil {
-		delete(p.p, "networkid")
-	}
+	p.networkid = optString{}
 }
 
+// GetNetworkid returns the networkid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetNetworkid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networkid"].(string)
-	return value, ok
+	return p.networkid.v, p.networkid.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVirtualMachinesMetricsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVirtualMachinesMetricsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVirtualMachinesMetricsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVirtualMachinesMetricsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListVirtualMachinesMetricsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListVirtualMachinesMetricsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVirtualMachinesMetricsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVirtualMachinesMetricsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRetrieveonlyresourcecount sets the retrieveonlyresourcecount param.
 func (p *ListVirtualMachinesMetricsParams) SetRetrieveonlyresourcecount(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["retrieveonlyresourcecount"] = v
+	p.retrieveonlyresourcecount = optBool{v: v, ok: true}
 }
 
+// ResetRetrieveonlyresourcecount unsets the retrieveonlyresourcecount param
 func (p *ListVirtualMachinesMetricsParams) ResetRetrieveonlyresourcecount() {
-	if p.p != nil && p.p["retrieveonlyresourcecount"] != nil {
-		delete(p.p, "retrieveonlyresourcecount")
-	}
+	p.retrieveonlyresourcecount = optBool{}
 }
 
+// GetRetrieveonlyresourcecount returns the retrieveonlyresourcecount param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetRetrieveonlyresourcecount() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["retrieveonlyresourcecount"].(bool)
-	return value, ok
+	return p.retrieveonlyresourcecount.v, p.retrieveonlyresourcecount.ok
 }
 
+// SetSecuritygroupid sets the securitygroupid param.
 func (p *ListVirtualMachinesMetricsParams) SetSecuritygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupid"] = v
+	p.securitygroupid = optString{v: v, ok: true}
 }
 
+// ResetSecuritygroupid unsets the securitygroupid param
 func (p *ListVirtualMachinesMetricsParams) ResetSecuritygroupid() {
-	if p.p != nil && p.p["securitygroupid"] != nil {
-		delete(p.p, "securitygroupid")
-	}
+	p.securitygroupid = optString{}
 }
 
+// GetSecuritygroupid returns the securitygroupid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetSecuritygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupid"].(string)
-	return value, ok
+	return p.securitygroupid.v, p.securitygroupid.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param.
 func (p *ListVirtualMachinesMetricsParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ListVirtualMachinesMetricsParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListVirtualMachinesMetricsParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListVirtualMachinesMetricsParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetState sets the state param.
 func (p *ListVirtualMachinesMetricsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListVirtualMachinesMetricsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListVirtualMachinesMetricsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListVirtualMachinesMetricsParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListVirtualMachinesMetricsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListVirtualMachinesMetricsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetTemplateid sets the templateid param.
 func (p *ListVirtualMachinesMetricsParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+	p.templateid = optString{v: v, ok: true}
 }
 
+// ResetTemplateid unsets the templateid param
 func (p *ListVirtualMachinesMetricsParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
-	}
+	p.templateid = optString{}
 }
 
+// GetTemplateid returns the templateid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return p.templateid.v, p.templateid.ok
 }
 
+// SetUserdata sets the userdata param.
 func (p *ListVirtualMachinesMetricsParams) SetUserdata(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
+	p.userdata = optBool{v: v, ok: true}
 }
 
+// ResetUserdata unsets the userdata param
 func (p *ListVirtualMachinesMetricsParams) ResetUserdata() {
-	if p.p != nil && p.p["userdata"] != nil {
-		delete(p.p, "userdata")
-	}
+	p.userdata = optBool{}
 }
 
+// GetUserdata returns the userdata param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetUserdata() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdata"].(bool)
-	return value, ok
+	return p.userdata.v, p.userdata.ok
 }
 
+// SetUserid sets the userid param.
 func (p *ListVirtualMachinesMetricsParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
+	p.userid = optString{v: v, ok: true}
 }
 
+// ResetUserid unsets the userid param
 func (p *ListVirtualMachinesMetricsParams) ResetUserid() {
-	if p.p != nil && p.p["userid"] != nil {
-		delete(p.p, "userid")
-	}
+	p.userid = optString{}
 }
 
+// GetUserid returns the userid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetUserid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userid"].(string)
-	return value, ok
+	return p.userid.v, p.userid.ok
 }
 
+// SetVpcid sets the vpcid param.
 func (p *ListVirtualMachinesMetricsParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+	p.vpcid = optString{v: v, ok: true}
 }
 
+// ResetVpcid unsets the vpcid param
 func (p *ListVirtualMachinesMetricsParams) ResetVpcid() {
-	if p.p != nil && p.p["vpcid"] != nil {
-		delete(p.p, "vpcid")
-	}
+	p.vpcid = optString{}
 }
 
+// GetVpcid returns the vpcid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetVpcid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["vpcid"].(string)
-	return value, ok
+	return p.vpcid.v, p.vpcid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListVirtualMachinesMetricsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListVirtualMachinesMetricsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListVirtualMachinesMetricsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVirtualMachinesMetricsParams) Clone() *ListVirtualMachinesMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVirtualMachinesMetricsParams) Equal(o *ListVirtualMachinesMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.accumulate == o.accumulate &&
+		p.affinitygroupid == o.affinitygroupid &&
+		p.autoscalevmgroupid == o.autoscalevmgroupid &&
+		p.backupofferingid == o.backupofferingid &&
+		p.clusterid == o.clusterid &&
+		p.details.equal(o.details) &&
+		p.displayvm == o.displayvm &&
+		p.domainid == o.domainid &&
+		p.forvirtualnetwork == o.forvirtualnetwork &&
+		p.groupid == o.groupid &&
+		p.haenable == o.haenable &&
+		p.hostid == o.hostid &&
+		p.hypervisor == o.hypervisor &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.isoid == o.isoid &&
+		p.isrecursive == o.isrecursive &&
+		p.isvnf == o.isvnf &&
+		p.keypair == o.keypair &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.name == o.name &&
+		p.networkid == o.networkid &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.retrieveonlyresourcecount == o.retrieveonlyresourcecount &&
+		p.securitygroupid == o.securitygroupid &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.showicon == o.showicon &&
+		p.state == o.state &&
+		p.storageid == o.storageid &&
+		p.tags.equal(o.tags) &&
+		p.templateid == o.templateid &&
+		p.userdata == o.userdata &&
+		p.userid == o.userid &&
+		p.vpcid == o.vpcid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListVirtualMachinesMetricsParams is used to (un)marshal ListVirtualMachinesMetricsParams using the API param names
+type serializedListVirtualMachinesMetricsParams struct {
+	Account                   *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Accumulate                *bool              `json:"accumulate,omitempty" yaml:"accumulate,omitempty"`
+	Affinitygroupid           *string            `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Autoscalevmgroupid        *string            `json:"autoscalevmgroupid,omitempty" yaml:"autoscalevmgroupid,omitempty"`
+	Backupofferingid          *string            `json:"backupofferingid,omitempty" yaml:"backupofferingid,omitempty"`
+	Clusterid                 *string            `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Details                   *[]string          `json:"details,omitempty" yaml:"details,omitempty"`
+	Displayvm                 *bool              `json:"displayvm,omitempty" yaml:"displayvm,omitempty"`
+	Domainid                  *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvirtualnetwork         *bool              `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Groupid                   *string            `json:"groupid,omitempty" yaml:"groupid,omitempty"`
+	Haenable                  *bool              `json:"haenable,omitempty" yaml:"haenable,omitempty"`
+	Hostid                    *string            `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Hypervisor                *string            `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id                        *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids                       *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Isoid                     *string            `json:"isoid,omitempty" yaml:"isoid,omitempty"`
+	Isrecursive               *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Isvnf                     *bool              `json:"isvnf,omitempty" yaml:"isvnf,omitempty"`
+	Keypair                   *string            `json:"keypair,omitempty" yaml:"keypair,omitempty"`
+	Keyword                   *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                   *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name                      *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid                 *string            `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page                      *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                  *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                     *string            `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid                 *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Retrieveonlyresourcecount *bool              `json:"retrieveonlyresourcecount,omitempty" yaml:"retrieveonlyresourcecount,omitempty"`
+	Securitygroupid           *string            `json:"securitygroupid,omitempty" yaml:"securitygroupid,omitempty"`
+	Serviceofferingid         *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Showicon                  *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	State                     *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid                 *string            `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags                      *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Templateid                *string            `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Userdata                  *bool              `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Userid                    *string            `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Vpcid                     *string            `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid                    *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListVirtualMachinesMetricsParams) toSerialized() *serializedListVirtualMachinesMetricsParams {
+	s := &serializedListVirtualMachinesMetricsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.accumulate.ok {
+		s.Accumulate = &p.accumulate.v
+	}
+	if p.affinitygroupid.ok {
+		s.Affinitygroupid = &p.affinitygroupid.v
+	}
+	if p.autoscalevmgroupid.ok {
+		s.Autoscalevmgroupid = &p.autoscalevmgroupid.v
+	}
+	if p.backupofferingid.ok {
+		s.Backupofferingid = &p.backupofferingid.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.displayvm.ok {
+		s.Displayvm = &p.displayvm.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.forvirtualnetwork.ok {
+		s.Forvirtualnetwork = &p.forvirtualnetwork.v
+	}
+	if p.groupid.ok {
+		s.Groupid = &p.groupid.v
+	}
+	if p.haenable.ok {
+		s.Haenable = &p.haenable.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.hypervisor.ok {
+		s.Hypervisor = &p.hypervisor.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.isoid.ok {
+		s.Isoid = &p.isoid.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.isvnf.ok {
+		s.Isvnf = &p.isvnf.v
+	}
+	if p.keypair.ok {
+		s.Keypair = &p.keypair.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networkid.ok {
+		s.Networkid = &p.networkid.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.retrieveonlyresourcecount.ok {
+		s.Retrieveonlyresourcecount = &p.retrieveonlyresourcecount.v
+	}
+	if p.securitygroupid.ok {
+		s.Securitygroupid = &p.securitygroupid.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	if p.userid.ok {
+		s.Userid = &p.userid.v
+	}
+	if p.vpcid.ok {
+		s.Vpcid = &p.vpcid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListVirtualMachinesMetricsParams) fromSerialized(s *serializedListVirtualMachinesMetricsParams) {
+	*p = ListVirtualMachinesMetricsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Accumulate != nil {
+		p.SetAccumulate(*s.Accumulate)
+	}
+	if s.Affinitygroupid != nil {
+		p.SetAffinitygroupid(*s.Affinitygroupid)
+	}
+	if s.Autoscalevmgroupid != nil {
+		p.SetAutoscalevmgroupid(*s.Autoscalevmgroupid)
+	}
+	if s.Backupofferingid != nil {
+		p.SetBackupofferingid(*s.Backupofferingid)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Displayvm != nil {
+		p.SetDisplayvm(*s.Displayvm)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Forvirtualnetwork != nil {
+		p.SetForvirtualnetwork(*s.Forvirtualnetwork)
+	}
+	if s.Groupid != nil {
+		p.SetGroupid(*s.Groupid)
+	}
+	if s.Haenable != nil {
+		p.SetHaenable(*s.Haenable)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Hypervisor != nil {
+		p.SetHypervisor(*s.Hypervisor)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Isoid != nil {
+		p.SetIsoid(*s.Isoid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Isvnf != nil {
+		p.SetIsvnf(*s.Isvnf)
+	}
+	if s.Keypair != nil {
+		p.SetKeypair(*s.Keypair)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networkid != nil {
+		p.SetNetworkid(*s.Networkid)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Retrieveonlyresourcecount != nil {
+		p.SetRetrieveonlyresourcecount(*s.Retrieveonlyresourcecount)
+	}
+	if s.Securitygroupid != nil {
+		p.SetSecuritygroupid(*s.Securitygroupid)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+	if s.Userid != nil {
+		p.SetUserid(*s.Userid)
+	}
+	if s.Vpcid != nil {
+		p.SetVpcid(*s.Vpcid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVirtualMachinesMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVirtualMachinesMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVirtualMachinesMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVirtualMachinesMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVirtualMachinesMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVirtualMachinesMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVirtualMachinesMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewListVirtualMachinesMetricsParams() *ListVirtualMachinesMetricsParams {
 	p := &ListVirtualMachinesMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5501,9 +7291,8 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByName(name string, opts
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
 	p := &ListVirtualMachinesMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -5531,9 +7320,9 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...
 	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualMachinesMetric UUID: %s!", id)
 }
 
-// Lists VM metrics
-func (s *VirtualMachineService) ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachinesMetrics", p.toURLValues())
+// Lists VM metrics.
+func (s *VirtualMachineService) ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams, opts ...CallOption) (*ListVirtualMachinesMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listVirtualMachinesMetrics", s.cs.encodeParams("listVirtualMachinesMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5720,126 +7509,228 @@ func (r *VirtualMachinesMetric) UnmarshalJSON(b []byte) error {
 }
 
 type MigrateVirtualMachineParams struct {
-	p map[string]interface{}
+	autoselect       optBool
+	hostid           optString
+	storageid        optString
+	virtualmachineid optString
 }
 
-func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *MigrateVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["autoselect"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("autoselect", vv)
+	if p.autoselect.ok {
+		u.Set("autoselect", strconv.FormatBool(p.autoselect.v))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *MigrateVirtualMachineParams) SetAutoselect(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseMigrateVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new MigrateVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseMigrateVirtualMachineParams(u url.Values) (*MigrateVirtualMachineParams, error) {
+	p := &MigrateVirtualMachineParams{}
+	if err := checkParamNames("migrateVirtualMachine", u, "autoselect", "hostid", "storageid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["autoselect"]; found {
+		v, err := strconv.ParseBool(u.Get("autoselect"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param autoselect: %v", err)
+		}
+		p.SetAutoselect(v)
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
 	}
-	p.p["autoselect"] = v
+	return p, nil
 }
 
+// SetAutoselect sets the autoselect param.
+func (p *MigrateVirtualMachineParams) SetAutoselect(v bool) {
+	p.autoselect = optBool{v: v, ok: true}
+}
+
+// ResetAutoselect unsets the autoselect param
 func (p *MigrateVirtualMachineParams) ResetAutoselect() {
-	if p.p != nil && p.p["autoselect"] != nil {
-		delete(p.p, "autoselect")
-	}
+	p.autoselect = optBool{}
 }
 
+// GetAutoselect returns the autoselect param and if it is set
 func (p *MigrateVirtualMachineParams) GetAutoselect() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoselect"].(bool)
-	return value, ok
+	return p.autoselect.v, p.autoselect.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *MigrateVirtualMachineParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *MigrateVirtualMachineParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *MigrateVirtualMachineParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *MigrateVirtualMachineParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *MigrateVirtualMachineParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *MigrateVirtualMachineParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *MigrateVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *MigrateVirtualMachineParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *MigrateVirtualMachineParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *MigrateVirtualMachineParams) Clone() *MigrateVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *MigrateVirtualMachineParams) Equal(o *MigrateVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.autoselect == o.autoselect &&
+		p.hostid == o.hostid &&
+		p.storageid == o.storageid &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedMigrateVirtualMachineParams is used to (un)marshal MigrateVirtualMachineParams using the API param names
+type serializedMigrateVirtualMachineParams struct {
+	Autoselect       *bool   `json:"autoselect,omitempty" yaml:"autoselect,omitempty"`
+	Hostid           *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Storageid        *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *MigrateVirtualMachineParams) toSerialized() *serializedMigrateVirtualMachineParams {
+	s := &serializedMigrateVirtualMachineParams{}
+	if p.autoselect.ok {
+		s.Autoselect = &p.autoselect.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *MigrateVirtualMachineParams) fromSerialized(s *serializedMigrateVirtualMachineParams) {
+	*p = MigrateVirtualMachineParams{}
+	if s.Autoselect != nil {
+		p.SetAutoselect(*s.Autoselect)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p MigrateVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *MigrateVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedMigrateVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p MigrateVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *MigrateVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedMigrateVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new MigrateVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams {
 	p := &MigrateVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Attempts Migration of a VM to a different host or Root volume of the vm to a different storage pool
-func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachine", p.toURLValues())
+// Attempts Migration of a VM to a different host or Root volume of the vm to a different storage
+// pool.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams, opts ...CallOption) (*MigrateVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("migrateVirtualMachine", s.cs.encodeParams("migrateVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5850,8 +7741,8 @@ func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachinePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6033,157 +7924,252 @@ func (r *MigrateVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type MigrateVirtualMachineWithVolumeParams struct {
-	p map[string]interface{}
+	autoselect       optBool
+	hostid           optString
+	migrateto        optStringMaps
+	virtualmachineid optString
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *MigrateVirtualMachineWithVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["autoselect"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("autoselect", vv)
+	if p.autoselect.ok {
+		u.Set("autoselect", strconv.FormatBool(p.autoselect.v))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["migrateto"]; found {
-		l := v.([]map[string]string)
-		for i, m := range l {
+	if p.migrateto.ok {
+		for i, m := range p.migrateto.v {
 			for key, val := range m {
 				u.Set(fmt.Sprintf("migrateto[%d].%s", i, key), val)
 			}
 		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) SetAutoselect(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseMigrateVirtualMachineWithVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new MigrateVirtualMachineWithVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseMigrateVirtualMachineWithVolumeParams(u url.Values) (*MigrateVirtualMachineWithVolumeParams, error) {
+	p := &MigrateVirtualMachineWithVolumeParams{}
+	if err := checkParamNames("migrateVirtualMachineWithVolume", u, "autoselect", "hostid", "migrateto[]", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["autoselect"]; found {
+		v, err := strconv.ParseBool(u.Get("autoselect"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param autoselect: %v", err)
+		}
+		p.SetAutoselect(v)
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["autoselect"] = v
+	if l, err := parseIndexedValues(u, "migrateto"); err != nil {
+		return nil, err
+	} else if len(l) > 0 {
+		p.SetMigrateto(l)
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetAutoselect sets the autoselect param.
+func (p *MigrateVirtualMachineWithVolumeParams) SetAutoselect(v bool) {
+	p.autoselect = optBool{v: v, ok: true}
 }
 
+// ResetAutoselect unsets the autoselect param
 func (p *MigrateVirtualMachineWithVolumeParams) ResetAutoselect() {
-	if p.p != nil && p.p["autoselect"] != nil {
-		delete(p.p, "autoselect")
-	}
+	p.autoselect = optBool{}
 }
 
+// GetAutoselect returns the autoselect param and if it is set
 func (p *MigrateVirtualMachineWithVolumeParams) GetAutoselect() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["autoselect"].(bool)
-	return value, ok
+	return p.autoselect.v, p.autoselect.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *MigrateVirtualMachineWithVolumeParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *MigrateVirtualMachineWithVolumeParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *MigrateVirtualMachineWithVolumeParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetMigrateto sets the migrateto param.
 func (p *MigrateVirtualMachineWithVolumeParams) SetMigrateto(v []map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["migrateto"] = v
+	p.migrateto = optStringMaps{v: v, ok: true}
 }
 
+// ResetMigrateto unsets the migrateto param
 func (p *MigrateVirtualMachineWithVolumeParams) ResetMigrateto() {
-	if p.p != nil && p.p["migrateto"] != nil {
-		delete(p.p, "migrateto")
-	}
+	p.migrateto = optStringMaps{}
 }
 
+// GetMigrateto returns the migrateto param and if it is set
 func (p *MigrateVirtualMachineWithVolumeParams) GetMigrateto() ([]map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["migrateto"].([]map[string]string)
-	return value, ok
+	return p.migrateto.v, p.migrateto.ok
 }
 
+// AddMigrateto adds an item to the migrateto param
 func (p *MigrateVirtualMachineWithVolumeParams) AddMigrateto(item map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	val, found := p.p["migrateto"]
-	if !found {
-		p.p["migrateto"] = []map[string]string{}
-		val = p.p["migrateto"]
-	}
-	l := val.([]map[string]string)
-	l = append(l, item)
-	p.p["migrateto"] = l
+	p.migrateto.v = append(p.migrateto.v, item)
+	p.migrateto.ok = true
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *MigrateVirtualMachineWithVolumeParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *MigrateVirtualMachineWithVolumeParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
-// You should always use this function to get a new MigrateVirtualMachineWithVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(virtualmachineid string) *MigrateVirtualMachineWithVolumeParams {
-	p := &MigrateVirtualMachineWithVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
-	return p
+// Clone returns a deep copy of the params
+func (p *MigrateVirtualMachineWithVolumeParams) Clone() *MigrateVirtualMachineWithVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.migrateto = p.migrateto.clone()
+	return &c
 }
 
-// Attempts Migration of a VM with its volumes to a different host
-func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachineWithVolume", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Equal reports whether p and o hold exactly the same param values
+func (p *MigrateVirtualMachineWithVolumeParams) Equal(o *MigrateVirtualMachineWithVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
+	return p.autoselect == o.autoselect &&
+		p.hostid == o.hostid &&
+		p.migrateto.equal(o.migrateto) &&
+		p.virtualmachineid == o.virtualmachineid
+}
 
-	var r MigrateVirtualMachineWithVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+// serializedMigrateVirtualMachineWithVolumeParams is used to (un)marshal MigrateVirtualMachineWithVolumeParams using the API param names
+type serializedMigrateVirtualMachineWithVolumeParams struct {
+	Autoselect       *bool                `json:"autoselect,omitempty" yaml:"autoselect,omitempty"`
+	Hostid           *string              `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Migrateto        *[]map[string]string `json:"migrateto,omitempty" yaml:"migrateto,omitempty"`
+	Virtualmachineid *string              `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) toSerialized() *serializedMigrateVirtualMachineWithVolumeParams {
+	s := &serializedMigrateVirtualMachineWithVolumeParams{}
+	if p.autoselect.ok {
+		s.Autoselect = &p.autoselect.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.migrateto.ok {
+		s.Migrateto = &p.migrateto.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) fromSerialized(s *serializedMigrateVirtualMachineWithVolumeParams) {
+	*p = MigrateVirtualMachineWithVolumeParams{}
+	if s.Autoselect != nil {
+		p.SetAutoselect(*s.Autoselect)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Migrateto != nil {
+		p.SetMigrateto(*s.Migrateto)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p MigrateVirtualMachineWithVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *MigrateVirtualMachineWithVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedMigrateVirtualMachineWithVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p MigrateVirtualMachineWithVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *MigrateVirtualMachineWithVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedMigrateVirtualMachineWithVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new MigrateVirtualMachineWithVolumeParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(virtualmachineid string) *MigrateVirtualMachineWithVolumeParams {
+	p := &MigrateVirtualMachineWithVolumeParams{}
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Attempts Migration of a VM with its volumes to a different host.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams, opts ...CallOption) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	resp, err := s.cs.newRequest("migrateVirtualMachineWithVolume", s.cs.encodeParams("migrateVirtualMachineWithVolume", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVirtualMachineWithVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6365,103 +8351,201 @@ func (r *MigrateVirtualMachineWithVolumeResponse) UnmarshalJSON(b []byte) error
 }
 
 type RebootVirtualMachineParams struct {
-	p map[string]interface{}
+	bootintosetup optBool
+	forced        optBool
+	id            optString
 }
 
-func (p *RebootVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RebootVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bootintosetup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootintosetup", vv)
+	if p.bootintosetup.ok {
+		u.Set("bootintosetup", strconv.FormatBool(p.bootintosetup.v))
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RebootVirtualMachineParams) SetBootintosetup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRebootVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new RebootVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRebootVirtualMachineParams(u url.Values) (*RebootVirtualMachineParams, error) {
+	p := &RebootVirtualMachineParams{}
+	if err := checkParamNames("rebootVirtualMachine", u, "bootintosetup", "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bootintosetup"]; found {
+		v, err := strconv.ParseBool(u.Get("bootintosetup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bootintosetup: %v", err)
+		}
+		p.SetBootintosetup(v)
 	}
-	p.p["bootintosetup"] = v
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
 }
 
+// SetBootintosetup sets the bootintosetup param.
+func (p *RebootVirtualMachineParams) SetBootintosetup(v bool) {
+	p.bootintosetup = optBool{v: v, ok: true}
+}
+
+// ResetBootintosetup unsets the bootintosetup param
 func (p *RebootVirtualMachineParams) ResetBootintosetup() {
-	if p.p != nil && p.p["bootintosetup"] != nil {
-		delete(p.p, "bootintosetup")
-	}
+	p.bootintosetup = optBool{}
 }
 
+// GetBootintosetup returns the bootintosetup param and if it is set
 func (p *RebootVirtualMachineParams) GetBootintosetup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootintosetup"].(bool)
-	return value, ok
+	return p.bootintosetup.v, p.bootintosetup.ok
 }
 
+// SetForced sets the forced param.
 func (p *RebootVirtualMachineParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *RebootVirtualMachineParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *RebootVirtualMachineParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *RebootVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RebootVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RebootVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RebootVirtualMachineParams) Clone() *RebootVirtualMachineParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RebootVirtualMachineParams) Equal(o *RebootVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bootintosetup == o.bootintosetup &&
+		p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedRebootVirtualMachineParams is used to (un)marshal RebootVirtualMachineParams using the API param names
+type serializedRebootVirtualMachineParams struct {
+	Bootintosetup *bool   `json:"bootintosetup,omitempty" yaml:"bootintosetup,omitempty"`
+	Forced        *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RebootVirtualMachineParams) toSerialized() *serializedRebootVirtualMachineParams {
+	s := &serializedRebootVirtualMachineParams{}
+	if p.bootintosetup.ok {
+		s.Bootintosetup = &p.bootintosetup.v
+	}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RebootVirtualMachineParams) fromSerialized(s *serializedRebootVirtualMachineParams) {
+	*p = RebootVirtualMachineParams{}
+	if s.Bootintosetup != nil {
+		p.SetBootintosetup(*s.Bootintosetup)
+	}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RebootVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RebootVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedRebootVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RebootVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RebootVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRebootVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RebootVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams {
 	p := &RebootVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Reboots a virtual machine.
-func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("rebootVirtualMachine", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams, opts ...CallOption) (*RebootVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("rebootVirtualMachine", s.cs.encodeParams("rebootVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6472,8 +8556,8 @@ func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachinePara
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -6655,53 +8739,132 @@ func (r *RebootVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type RecoverVirtualMachineParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RecoverVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RecoverVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRecoverVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new RecoverVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRecoverVirtualMachineParams(u url.Values) (*RecoverVirtualMachineParams, error) {
+	p := &RecoverVirtualMachineParams{}
+	if err := checkParamNames("recoverVirtualMachine", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *RecoverVirtualMachineParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *RecoverVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RecoverVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RecoverVirtualMachineParams) Clone() *RecoverVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RecoverVirtualMachineParams) Equal(o *RecoverVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRecoverVirtualMachineParams is used to (un)marshal RecoverVirtualMachineParams using the API param names
+type serializedRecoverVirtualMachineParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RecoverVirtualMachineParams) toSerialized() *serializedRecoverVirtualMachineParams {
+	s := &serializedRecoverVirtualMachineParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RecoverVirtualMachineParams) fromSerialized(s *serializedRecoverVirtualMachineParams) {
+	*p = RecoverVirtualMachineParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RecoverVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RecoverVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedRecoverVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RecoverVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RecoverVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRecoverVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RecoverVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams {
 	p := &RecoverVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Recovers a virtual machine.
-func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("recoverVirtualMachine", p.toURLValues())
+//
+// Required params: id.
+func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams, opts ...CallOption) (*RecoverVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("recoverVirtualMachine", s.cs.encodeParams("recoverVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6875,78 +9038,164 @@ func (r *RecoverVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type RemoveNicFromVirtualMachineParams struct {
-	p map[string]interface{}
+	nicid            optString
+	virtualmachineid optString
 }
 
-func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RemoveNicFromVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
+	if p.nicid.ok {
+		u.Set("nicid", p.nicid.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRemoveNicFromVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new RemoveNicFromVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRemoveNicFromVirtualMachineParams(u url.Values) (*RemoveNicFromVirtualMachineParams, error) {
+	p := &RemoveNicFromVirtualMachineParams{}
+	if err := checkParamNames("removeNicFromVirtualMachine", u, "nicid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["nicid"]; found {
+		p.SetNicid(u.Get("nicid"))
 	}
-	p.p["nicid"] = v
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetNicid sets the nicid param. This param is required.
+func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
+	p.nicid = optString{v: v, ok: true}
 }
 
+// ResetNicid unsets the nicid param
 func (p *RemoveNicFromVirtualMachineParams) ResetNicid() {
-	if p.p != nil && p.p["nicid"] != nil {
-		delete(p.p, "nicid")
-	}
+	p.nicid = optString{}
 }
 
+// GetNicid returns the nicid param and if it is set
 func (p *RemoveNicFromVirtualMachineParams) GetNicid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["nicid"].(string)
-	return value, ok
+	return p.nicid.v, p.nicid.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *RemoveNicFromVirtualMachineParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *RemoveNicFromVirtualMachineParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RemoveNicFromVirtualMachineParams) Clone() *RemoveNicFromVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RemoveNicFromVirtualMachineParams) Equal(o *RemoveNicFromVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.nicid == o.nicid &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedRemoveNicFromVirtualMachineParams is used to (un)marshal RemoveNicFromVirtualMachineParams using the API param names
+type serializedRemoveNicFromVirtualMachineParams struct {
+	Nicid            *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *RemoveNicFromVirtualMachineParams) toSerialized() *serializedRemoveNicFromVirtualMachineParams {
+	s := &serializedRemoveNicFromVirtualMachineParams{}
+	if p.nicid.ok {
+		s.Nicid = &p.nicid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *RemoveNicFromVirtualMachineParams) fromSerialized(s *serializedRemoveNicFromVirtualMachineParams) {
+	*p = RemoveNicFromVirtualMachineParams{}
+	if s.Nicid != nil {
+		p.SetNicid(*s.Nicid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RemoveNicFromVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RemoveNicFromVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedRemoveNicFromVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RemoveNicFromVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RemoveNicFromVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRemoveNicFromVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RemoveNicFromVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams {
 	p := &RemoveNicFromVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetNicid(nicid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Removes VM from specified network by deleting a NIC
-func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("removeNicFromVirtualMachine", p.toURLValues())
+// Removes VM from specified network by deleting a NIC.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: nicid, virtualmachineid.
+func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams, opts ...CallOption) (*RemoveNicFromVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("removeNicFromVirtualMachine", s.cs.encodeParams("removeNicFromVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6957,8 +9206,8 @@ func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirt
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7140,77 +9389,164 @@ func (r *RemoveNicFromVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ResetPasswordForVirtualMachineParams struct {
-	p map[string]interface{}
+	id       optString
+	password optString
 }
 
-func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResetPasswordForVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if p.password.ok {
+		u.Set("password", p.password.v)
 	}
 	return u
 }
 
-func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseResetPasswordForVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new ResetPasswordForVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResetPasswordForVirtualMachineParams(u url.Values) (*ResetPasswordForVirtualMachineParams, error) {
+	p := &ResetPasswordForVirtualMachineParams{}
+	if err := checkParamNames("resetPasswordForVirtualMachine", u, "id", "password"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	if _, found := u["password"]; found {
+		p.SetPassword(u.Get("password"))
+	}
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ResetPasswordForVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ResetPasswordForVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetPassword sets the password param.
 func (p *ResetPasswordForVirtualMachineParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+	p.password = optString{v: v, ok: true}
 }
 
+// ResetPassword unsets the password param
 func (p *ResetPasswordForVirtualMachineParams) ResetPassword() {
-	if p.p != nil && p.p["password"] != nil {
-		delete(p.p, "password")
-	}
+	p.password = optString{}
 }
 
+// GetPassword returns the password param and if it is set
 func (p *ResetPasswordForVirtualMachineParams) GetPassword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.password.v, p.password.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResetPasswordForVirtualMachineParams) Clone() *ResetPasswordForVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResetPasswordForVirtualMachineParams) Equal(o *ResetPasswordForVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.password == o.password
+}
+
+// serializedResetPasswordForVirtualMachineParams is used to (un)marshal ResetPasswordForVirtualMachineParams using the API param names
+type serializedResetPasswordForVirtualMachineParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+}
+
+func (p *ResetPasswordForVirtualMachineParams) toSerialized() *serializedResetPasswordForVirtualMachineParams {
+	s := &serializedResetPasswordForVirtualMachineParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.password.ok {
+		s.Password = &p.password.v
+	}
+	return s
+}
+
+func (p *ResetPasswordForVirtualMachineParams) fromSerialized(s *serializedResetPasswordForVirtualMachineParams) {
+	*p = ResetPasswordForVirtualMachineParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["password"].(string)
-	return value, ok
+	if s.Password != nil {
+		p.SetPassword(*s.Password)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResetPasswordForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResetPasswordForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedResetPasswordForVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResetPasswordForVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResetPasswordForVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResetPasswordForVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ResetPasswordForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams {
 	p := &ResetPasswordForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the template must already support this feature for this command to take effect. [async]
-func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetPasswordForVirtualMachine", p.toURLValues())
+// Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the
+// template must already support this feature for this command to take effect. [async].
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams, opts ...CallOption) (*ResetPasswordForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("resetPasswordForVirtualMachine", s.cs.encodeParams("resetPasswordForVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7221,8 +9557,8 @@ func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordF
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7399,82 +9735,657 @@ func (r *ResetPasswordForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 		}
 	}
 
-	type alias ResetPasswordForVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	type alias ResetPasswordForVirtualMachineResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ResetUserDataForVirtualMachineParams struct {
+	account         optString
+	domainid        optString
+	id              optString
+	projectid       optString
+	userdata        optString
+	userdatadetails optStringMap
+	userdataid      optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResetUserDataForVirtualMachineParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.account.ok {
+		u.Set("account", p.account.v)
+	}
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
+	}
+	if p.id.ok {
+		u.Set("id", p.id.v)
+	}
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
+	}
+	if p.userdata.ok {
+		u.Set("userdata", p.userdata.v)
+	}
+	if p.userdatadetails.ok {
+		m := p.userdatadetails.v
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("userdatadetails[%d].%s", i, k), m[k])
+		}
+	}
+	if p.userdataid.ok {
+		u.Set("userdataid", p.userdataid.v)
+	}
+	return u
+}
+
+// ParseResetUserDataForVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new ResetUserDataForVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResetUserDataForVirtualMachineParams(u url.Values) (*ResetUserDataForVirtualMachineParams, error) {
+	p := &ResetUserDataForVirtualMachineParams{}
+	if err := checkParamNames("resetUserDataForVirtualMachine", u, "account", "domainid", "id", "projectid", "userdata", "userdatadetails[]", "userdataid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["userdata"]; found {
+		p.SetUserdata(u.Get("userdata"))
+	}
+	if m, err := parseIndexedMap(u, "userdatadetails", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetUserdatadetails(m)
+	}
+	if _, found := u["userdataid"]; found {
+		p.SetUserdataid(u.Get("userdataid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ResetUserDataForVirtualMachineParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
+func (p *ResetUserDataForVirtualMachineParams) ResetAccount() {
+	p.account = optString{}
+}
+
+// GetAccount returns the account param and if it is set
+func (p *ResetUserDataForVirtualMachineParams) GetAccount() (string, bool) {
+	return p.account.v, p.account.ok
+}
+
+// SetDomainid sets the domainid param.
+func (p *ResetUserDataForVirtualMachineParams) SetDomainid(v string) {
+	p.domainid = optString{v: v, ok: true}
+}
+
+// ResetDomainid unsets the domainid param
+func (p *ResetUserDataForVirtualMachineParams) ResetDomainid() {
+	p.domainid = optString{}
+}
+
+// GetDomainid returns the domainid param and if it is set
+func (p *ResetUserDataForVirtualMachineParams) GetDomainid() (string, bool) {
+	return p.domainid.v, p.domainid.ok
+}
+
+// SetId sets the id param. This param is required.
+func (p *ResetUserDataForVirtualMachineParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
+func (p *ResetUserDataForVirtualMachineParams) ResetId() {
+	p.id = optString{}
+}
+
+// GetId returns the id param and if it is set
+func (p *ResetUserDataForVirtualMachineParams) GetId() (string, bool) {
+	return p.id.v, p.id.ok
+}
+
+// SetProjectid sets the projectid param.
+func (p *ResetUserDataForVirtualMachineParams) SetProjectid(v string) {
+	p.projectid = optString{v: v, ok: true}
+}
+
+// ResetProjectid unsets the projectid param
+func (p *ResetUserDataForVirtualMachineParams) ResetProjectid() {
+	p.projectid = optString{}
+}
+
+// GetProjectid returns the projectid param and if it is set
+func (p *ResetUserDataForVirtualMachineParams) GetProjectid() (string, bool) {
+	return p.projectid.v, p.projectid.ok
+}
+
+// SetUserdata sets the userdata param.
+func (p *ResetUserDataForVirtualMachineParams) SetUserdata(v string) {
+	p.userdata = optString{v: v, ok: true}
+}
+
+// ResetUserdata unsets the userdata param
+func (p *ResetUserDataForVirtualMachineParams) ResetUserdata() {
+	p.userdata = optString{}
+}
+
+// GetUserdata returns the userdata param and if it is set
+func (p *ResetUserDataForVirtualMachineParams) GetUserdata() (string, bool) {
+	return p.userdata.v, p.userdata.ok
+}
+
+// SetUserdatadetails sets the userdatadetails param.
+func (p *ResetUserDataForVirtualMachineParams) SetUserdatadetails(v map[string]string) {
+	p.userdatadetails = optStringMap{v: v, ok: true}
+}
+
+// ResetUserdatadetails unsets the userdatadetails param
+func (p *ResetUserDataForVirtualMachineParams) ResetUserdatadetails() {
+	p.userdatadetails = optStringMap{}
+}
+
+// GetUserdatadetails returns the userdatadetails param and if it is set
+func (p *ResetUserDataForVirtualMachineParams) GetUserdatadetails() (map[string]string, bool) {
+	return p.userdatadetails.v, p.userdatadetails.ok
+}
+
+// SetUserdataid sets the userdataid param.
+func (p *ResetUserDataForVirtualMachineParams) SetUserdataid(v string) {
+	p.userdataid = optString{v: v, ok: true}
+}
+
+// ResetUserdataid unsets the userdataid param
+func (p *ResetUserDataForVirtualMachineParams) ResetUserdataid() {
+	p.userdataid = optString{}
+}
+
+// GetUserdataid returns the userdataid param and if it is set
+func (p *ResetUserDataForVirtualMachineParams) GetUserdataid() (string, bool) {
+	return p.userdataid.v, p.userdataid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResetUserDataForVirtualMachineParams) Clone() *ResetUserDataForVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.userdatadetails = p.userdatadetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResetUserDataForVirtualMachineParams) Equal(o *ResetUserDataForVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.projectid == o.projectid &&
+		p.userdata == o.userdata &&
+		p.userdatadetails.equal(o.userdatadetails) &&
+		p.userdataid == o.userdataid
+}
+
+// serializedResetUserDataForVirtualMachineParams is used to (un)marshal ResetUserDataForVirtualMachineParams using the API param names
+type serializedResetUserDataForVirtualMachineParams struct {
+	Account         *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid        *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id              *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Projectid       *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Userdata        *string            `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Userdatadetails *map[string]string `json:"userdatadetails,omitempty" yaml:"userdatadetails,omitempty"`
+	Userdataid      *string            `json:"userdataid,omitempty" yaml:"userdataid,omitempty"`
+}
+
+func (p *ResetUserDataForVirtualMachineParams) toSerialized() *serializedResetUserDataForVirtualMachineParams {
+	s := &serializedResetUserDataForVirtualMachineParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	if p.userdatadetails.ok {
+		s.Userdatadetails = &p.userdatadetails.v
+	}
+	if p.userdataid.ok {
+		s.Userdataid = &p.userdataid.v
+	}
+	return s
+}
+
+func (p *ResetUserDataForVirtualMachineParams) fromSerialized(s *serializedResetUserDataForVirtualMachineParams) {
+	*p = ResetUserDataForVirtualMachineParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+	if s.Userdatadetails != nil {
+		p.SetUserdatadetails(*s.Userdatadetails)
+	}
+	if s.Userdataid != nil {
+		p.SetUserdataid(*s.Userdataid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResetUserDataForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResetUserDataForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedResetUserDataForVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResetUserDataForVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResetUserDataForVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResetUserDataForVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// You should always use this function to get a new ResetUserDataForVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewResetUserDataForVirtualMachineParams(id string) *ResetUserDataForVirtualMachineParams {
+	p := &ResetUserDataForVirtualMachineParams{}
+	p.SetId(id)
+	return p
+}
+
+// Resets the UserData for virtual machine. The virtual machine must be in a "Stopped" state.
+//
+// Required params: id.
+func (s *VirtualMachineService) ResetUserDataForVirtualMachine(p *ResetUserDataForVirtualMachineParams, opts ...CallOption) (*ResetUserDataForVirtualMachineResponse, error) {
+	resp, err := s.cs.newPostRequest("resetUserDataForVirtualMachine", s.cs.encodeParams("resetUserDataForVirtualMachine", p), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r ResetUserDataForVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ResetUserDataForVirtualMachineResponse struct {
+	Account               string                                                `json:"account"`
+	Affinitygroup         []ResetUserDataForVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
+	Autoscalevmgroupid    string                                                `json:"autoscalevmgroupid"`
+	Autoscalevmgroupname  string                                                `json:"autoscalevmgroupname"`
+	Backupofferingid      string                                                `json:"backupofferingid"`
+	Backupofferingname    string                                                `json:"backupofferingname"`
+	Bootmode              string                                                `json:"bootmode"`
+	Boottype              string                                                `json:"boottype"`
+	Cpunumber             int                                                   `json:"cpunumber"`
+	Cpuspeed              int                                                   `json:"cpuspeed"`
+	Cpuused               string                                                `json:"cpuused"`
+	Created               string                                                `json:"created"`
+	Details               map[string]string                                     `json:"details"`
+	Diskioread            int64                                                 `json:"diskioread"`
+	Diskiowrite           int64                                                 `json:"diskiowrite"`
+	Diskkbsread           int64                                                 `json:"diskkbsread"`
+	Diskkbswrite          int64                                                 `json:"diskkbswrite"`
+	Diskofferingid        string                                                `json:"diskofferingid"`
+	Diskofferingname      string                                                `json:"diskofferingname"`
+	Displayname           string                                                `json:"displayname"`
+	Displayvm             bool                                                  `json:"displayvm"`
+	Domain                string                                                `json:"domain"`
+	Domainid              string                                                `json:"domainid"`
+	Forvirtualnetwork     bool                                                  `json:"forvirtualnetwork"`
+	Group                 string                                                `json:"group"`
+	Groupid               string                                                `json:"groupid"`
+	Guestosid             string                                                `json:"guestosid"`
+	Haenable              bool                                                  `json:"haenable"`
+	Hasannotations        bool                                                  `json:"hasannotations"`
+	Hostcontrolstate      string                                                `json:"hostcontrolstate"`
+	Hostid                string                                                `json:"hostid"`
+	Hostname              string                                                `json:"hostname"`
+	Hypervisor            string                                                `json:"hypervisor"`
+	Icon                  interface{}                                           `json:"icon"`
+	Id                    string                                                `json:"id"`
+	Instancename          string                                                `json:"instancename"`
+	Isdynamicallyscalable bool                                                  `json:"isdynamicallyscalable"`
+	Isodisplaytext        string                                                `json:"isodisplaytext"`
+	Isoid                 string                                                `json:"isoid"`
+	Isoname               string                                                `json:"isoname"`
+	JobID                 string                                                `json:"jobid"`
+	Jobstatus             int                                                   `json:"jobstatus"`
+	Keypairs              string                                                `json:"keypairs"`
+	Lastupdated           string                                                `json:"lastupdated"`
+	Memory                int                                                   `json:"memory"`
+	Memoryintfreekbs      int64                                                 `json:"memoryintfreekbs"`
+	Memorykbs             int64                                                 `json:"memorykbs"`
+	Memorytargetkbs       int64                                                 `json:"memorytargetkbs"`
+	Name                  string                                                `json:"name"`
+	Networkkbsread        int64                                                 `json:"networkkbsread"`
+	Networkkbswrite       int64                                                 `json:"networkkbswrite"`
+	Nic                   []Nic                                                 `json:"nic"`
+	Osdisplayname         string                                                `json:"osdisplayname"`
+	Ostypeid              string                                                `json:"ostypeid"`
+	Password              string                                                `json:"password"`
+	Passwordenabled       bool                                                  `json:"passwordenabled"`
+	Pooltype              string                                                `json:"pooltype"`
+	Project               string                                                `json:"project"`
+	Projectid             string                                                `json:"projectid"`
+	Publicip              string                                                `json:"publicip"`
+	Publicipid            string                                                `json:"publicipid"`
+	Readonlydetails       string                                                `json:"readonlydetails"`
+	Receivedbytes         int64                                                 `json:"receivedbytes"`
+	Rootdeviceid          int64                                                 `json:"rootdeviceid"`
+	Rootdevicetype        string                                                `json:"rootdevicetype"`
+	Securitygroup         []ResetUserDataForVirtualMachineResponseSecuritygroup `json:"securitygroup"`
+	Sentbytes             int64                                                 `json:"sentbytes"`
+	Serviceofferingid     string                                                `json:"serviceofferingid"`
+	Serviceofferingname   string                                                `json:"serviceofferingname"`
+	Servicestate          string                                                `json:"servicestate"`
+	State                 string                                                `json:"state"`
+	Tags                  []Tags                                                `json:"tags"`
+	Templatedisplaytext   string                                                `json:"templatedisplaytext"`
+	Templateid            string                                                `json:"templateid"`
+	Templatename          string                                                `json:"templatename"`
+	Templatetype          string                                                `json:"templatetype"`
+	Userdata              string                                                `json:"userdata"`
+	Userdatadetails       string                                                `json:"userdatadetails"`
+	Userdataid            string                                                `json:"userdataid"`
+	Userdataname          string                                                `json:"userdataname"`
+	Userdatapolicy        string                                                `json:"userdatapolicy"`
+	Userid                string                                                `json:"userid"`
+	Username              string                                                `json:"username"`
+	Vgpu                  string                                                `json:"vgpu"`
+	Vnfdetails            map[string]string                                     `json:"vnfdetails"`
+	Vnfnics               []string                                              `json:"vnfnics"`
+	Zoneid                string                                                `json:"zoneid"`
+	Zonename              string                                                `json:"zonename"`
+}
+
+type ResetUserDataForVirtualMachineResponseSecuritygroup struct {
+	Account             string                                                    `json:"account"`
+	Description         string                                                    `json:"description"`
+	Domain              string                                                    `json:"domain"`
+	Domainid            string                                                    `json:"domainid"`
+	Egressrule          []ResetUserDataForVirtualMachineResponseSecuritygroupRule `json:"egressrule"`
+	Id                  string                                                    `json:"id"`
+	Ingressrule         []ResetUserDataForVirtualMachineResponseSecuritygroupRule `json:"ingressrule"`
+	Name                string                                                    `json:"name"`
+	Project             string                                                    `json:"project"`
+	Projectid           string                                                    `json:"projectid"`
+	Tags                []Tags                                                    `json:"tags"`
+	Virtualmachinecount int                                                       `json:"virtualmachinecount"`
+	Virtualmachineids   []interface{}                                             `json:"virtualmachineids"`
+}
+
+type ResetUserDataForVirtualMachineResponseSecuritygroupRule struct {
+	Account           string `json:"account"`
+	Cidr              string `json:"cidr"`
+	Endport           int    `json:"endport"`
+	Icmpcode          int    `json:"icmpcode"`
+	Icmptype          int    `json:"icmptype"`
+	Protocol          string `json:"protocol"`
+	Ruleid            string `json:"ruleid"`
+	Securitygroupname string `json:"securitygroupname"`
+	Startport         int    `json:"startport"`
+	Tags              []Tags `json:"tags"`
+}
+
+type ResetUserDataForVirtualMachineResponseAffinitygroup struct {
+	Account           string   `json:"account"`
+	Description       string   `json:"description"`
+	Domain            string   `json:"domain"`
+	Domainid          string   `json:"domainid"`
+	Id                string   `json:"id"`
+	Name              string   `json:"name"`
+	Project           string   `json:"project"`
+	Projectid         string   `json:"projectid"`
+	Type              string   `json:"type"`
+	VirtualmachineIds []string `json:"virtualmachineIds"`
+}
+
+func (r *ResetUserDataForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias ResetUserDataForVirtualMachineResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type RestoreVirtualMachineParams struct {
+	templateid       optString
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RestoreVirtualMachineParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.templateid.ok {
+		u.Set("templateid", p.templateid.v)
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseRestoreVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new RestoreVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRestoreVirtualMachineParams(u url.Values) (*RestoreVirtualMachineParams, error) {
+	p := &RestoreVirtualMachineParams{}
+	if err := checkParamNames("restoreVirtualMachine", u, "templateid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["templateid"]; found {
+		p.SetTemplateid(u.Get("templateid"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetTemplateid sets the templateid param.
+func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
+	p.templateid = optString{v: v, ok: true}
+}
+
+// ResetTemplateid unsets the templateid param
+func (p *RestoreVirtualMachineParams) ResetTemplateid() {
+	p.templateid = optString{}
+}
+
+// GetTemplateid returns the templateid param and if it is set
+func (p *RestoreVirtualMachineParams) GetTemplateid() (string, bool) {
+	return p.templateid.v, p.templateid.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
-type RestoreVirtualMachineParams struct {
-	p map[string]interface{}
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *RestoreVirtualMachineParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
 }
 
-func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *RestoreVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
-func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Clone returns a deep copy of the params
+func (p *RestoreVirtualMachineParams) Clone() *RestoreVirtualMachineParams {
+	if p == nil {
+		return nil
 	}
-	p.p["templateid"] = v
+	c := *p
+	return &c
 }
 
-func (p *RestoreVirtualMachineParams) ResetTemplateid() {
-	if p.p != nil && p.p["templateid"] != nil {
-		delete(p.p, "templateid")
+// Equal reports whether p and o hold exactly the same param values
+func (p *RestoreVirtualMachineParams) Equal(o *RestoreVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
+	return p.templateid == o.templateid &&
+		p.virtualmachineid == o.virtualmachineid
 }
 
-func (p *RestoreVirtualMachineParams) GetTemplateid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// serializedRestoreVirtualMachineParams is used to (un)marshal RestoreVirtualMachineParams using the API param names
+type serializedRestoreVirtualMachineParams struct {
+	Templateid       *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *RestoreVirtualMachineParams) toSerialized() *serializedRestoreVirtualMachineParams {
+	s := &serializedRestoreVirtualMachineParams{}
+	if p.templateid.ok {
+		s.Templateid = &p.templateid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
 	}
-	value, ok := p.p["templateid"].(string)
-	return value, ok
+	return s
 }
 
-func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RestoreVirtualMachineParams) fromSerialized(s *serializedRestoreVirtualMachineParams) {
+	*p = RestoreVirtualMachineParams{}
+	if s.Templateid != nil {
+		p.SetTemplateid(*s.Templateid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
 	}
-	p.p["virtualmachineid"] = v
 }
 
-func (p *RestoreVirtualMachineParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RestoreVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RestoreVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedRestoreVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *RestoreVirtualMachineParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RestoreVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RestoreVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRestoreVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RestoreVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams {
 	p := &RestoreVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Restore a VM to original template/ISO or new template/ISO
-func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("restoreVirtualMachine", p.toURLValues())
+// Restore a VM to original template/ISO or new template/ISO.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: virtualmachineid.
+func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams, opts ...CallOption) (*RestoreVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("restoreVirtualMachine", s.cs.encodeParams("restoreVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7485,8 +10396,8 @@ func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachinePa
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7668,205 +10579,338 @@ func (r *RestoreVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ScaleVirtualMachineParams struct {
-	p map[string]interface{}
+	automigrate       optBool
+	details           optStringMap
+	id                optString
+	maxiops           optInt64
+	miniops           optInt64
+	serviceofferingid optString
+	shrinkok          optBool
 }
 
-func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ScaleVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["automigrate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("automigrate", vv)
+	if p.automigrate.ok {
+		u.Set("automigrate", strconv.FormatBool(p.automigrate.v))
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
+	if p.maxiops.ok {
+		u.Set("maxiops", strconv.FormatInt(p.maxiops.v, 10))
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
+	if p.miniops.ok {
+		u.Set("miniops", strconv.FormatInt(p.miniops.v, 10))
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if p.serviceofferingid.ok {
+		u.Set("serviceofferingid", p.serviceofferingid.v)
 	}
-	if v, found := p.p["shrinkok"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("shrinkok", vv)
+	if p.shrinkok.ok {
+		u.Set("shrinkok", strconv.FormatBool(p.shrinkok.v))
 	}
 	return u
 }
 
-func (p *ScaleVirtualMachineParams) SetAutomigrate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseScaleVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new ScaleVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseScaleVirtualMachineParams(u url.Values) (*ScaleVirtualMachineParams, error) {
+	p := &ScaleVirtualMachineParams{}
+	if err := checkParamNames("scaleVirtualMachine", u, "automigrate", "details[]", "id", "maxiops", "miniops", "serviceofferingid", "shrinkok"); err != nil {
+		return nil, err
 	}
-	p.p["automigrate"] = v
+	if _, found := u["automigrate"]; found {
+		v, err := strconv.ParseBool(u.Get("automigrate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param automigrate: %v", err)
+		}
+		p.SetAutomigrate(v)
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["maxiops"]; found {
+		v, err := strconv.ParseInt(u.Get("maxiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxiops: %v", err)
+		}
+		p.SetMaxiops(v)
+	}
+	if _, found := u["miniops"]; found {
+		v, err := strconv.ParseInt(u.Get("miniops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param miniops: %v", err)
+		}
+		p.SetMiniops(v)
+	}
+	if _, found := u["serviceofferingid"]; found {
+		p.SetServiceofferingid(u.Get("serviceofferingid"))
+	}
+	if _, found := u["shrinkok"]; found {
+		v, err := strconv.ParseBool(u.Get("shrinkok"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param shrinkok: %v", err)
+		}
+		p.SetShrinkok(v)
+	}
+	return p, nil
 }
 
+// SetAutomigrate sets the automigrate param.
+func (p *ScaleVirtualMachineParams) SetAutomigrate(v bool) {
+	p.automigrate = optBool{v: v, ok: true}
+}
+
+// ResetAutomigrate unsets the automigrate param
 func (p *ScaleVirtualMachineParams) ResetAutomigrate() {
-	if p.p != nil && p.p["automigrate"] != nil {
-		delete(p.p, "automigrate")
-	}
+	p.automigrate = optBool{}
 }
 
+// GetAutomigrate returns the automigrate param and if it is set
 func (p *ScaleVirtualMachineParams) GetAutomigrate() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["automigrate"].(bool)
-	return value, ok
+	return p.automigrate.v, p.automigrate.ok
 }
 
+// SetDetails sets the details param.
 func (p *ScaleVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *ScaleVirtualMachineParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *ScaleVirtualMachineParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ScaleVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ScaleVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ScaleVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMaxiops sets the maxiops param.
 func (p *ScaleVirtualMachineParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
+	p.maxiops = optInt64{v: v, ok: true}
 }
 
+// ResetMaxiops unsets the maxiops param
 func (p *ScaleVirtualMachineParams) ResetMaxiops() {
-	if p.p != nil && p.p["maxiops"] != nil {
-		delete(p.p, "maxiops")
-	}
+	p.maxiops = optInt64{}
 }
 
+// GetMaxiops returns the maxiops param and if it is set
 func (p *ScaleVirtualMachineParams) GetMaxiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxiops"].(int64)
-	return value, ok
+	return p.maxiops.v, p.maxiops.ok
 }
 
+// SetMiniops sets the miniops param.
 func (p *ScaleVirtualMachineParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
+	p.miniops = optInt64{v: v, ok: true}
 }
 
+// ResetMiniops unsets the miniops param
 func (p *ScaleVirtualMachineParams) ResetMiniops() {
-	if p.p != nil && p.p["miniops"] != nil {
-		delete(p.p, "miniops")
-	}
+	p.miniops = optInt64{}
 }
 
+// GetMiniops returns the miniops param and if it is set
 func (p *ScaleVirtualMachineParams) GetMiniops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["miniops"].(int64)
-	return value, ok
+	return p.miniops.v, p.miniops.ok
 }
 
+// SetServiceofferingid sets the serviceofferingid param. This param is required.
 func (p *ScaleVirtualMachineParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.serviceofferingid = optString{v: v, ok: true}
 }
 
+// ResetServiceofferingid unsets the serviceofferingid param
 func (p *ScaleVirtualMachineParams) ResetServiceofferingid() {
-	if p.p != nil && p.p["serviceofferingid"] != nil {
-		delete(p.p, "serviceofferingid")
-	}
+	p.serviceofferingid = optString{}
 }
 
+// GetServiceofferingid returns the serviceofferingid param and if it is set
 func (p *ScaleVirtualMachineParams) GetServiceofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["serviceofferingid"].(string)
-	return value, ok
+	return p.serviceofferingid.v, p.serviceofferingid.ok
 }
 
+// SetShrinkok sets the shrinkok param.
 func (p *ScaleVirtualMachineParams) SetShrinkok(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["shrinkok"] = v
+	p.shrinkok = optBool{v: v, ok: true}
 }
 
+// ResetShrinkok unsets the shrinkok param
 func (p *ScaleVirtualMachineParams) ResetShrinkok() {
-	if p.p != nil && p.p["shrinkok"] != nil {
-		delete(p.p, "shrinkok")
-	}
+	p.shrinkok = optBool{}
 }
 
+// GetShrinkok returns the shrinkok param and if it is set
 func (p *ScaleVirtualMachineParams) GetShrinkok() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.shrinkok.v, p.shrinkok.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ScaleVirtualMachineParams) Clone() *ScaleVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ScaleVirtualMachineParams) Equal(o *ScaleVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.automigrate == o.automigrate &&
+		p.details.equal(o.details) &&
+		p.id == o.id &&
+		p.maxiops == o.maxiops &&
+		p.miniops == o.miniops &&
+		p.serviceofferingid == o.serviceofferingid &&
+		p.shrinkok == o.shrinkok
+}
+
+// serializedScaleVirtualMachineParams is used to (un)marshal ScaleVirtualMachineParams using the API param names
+type serializedScaleVirtualMachineParams struct {
+	Automigrate       *bool              `json:"automigrate,omitempty" yaml:"automigrate,omitempty"`
+	Details           *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Maxiops           *int64             `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops           *int64             `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Serviceofferingid *string            `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Shrinkok          *bool              `json:"shrinkok,omitempty" yaml:"shrinkok,omitempty"`
+}
+
+func (p *ScaleVirtualMachineParams) toSerialized() *serializedScaleVirtualMachineParams {
+	s := &serializedScaleVirtualMachineParams{}
+	if p.automigrate.ok {
+		s.Automigrate = &p.automigrate.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.maxiops.ok {
+		s.Maxiops = &p.maxiops.v
+	}
+	if p.miniops.ok {
+		s.Miniops = &p.miniops.v
+	}
+	if p.serviceofferingid.ok {
+		s.Serviceofferingid = &p.serviceofferingid.v
+	}
+	if p.shrinkok.ok {
+		s.Shrinkok = &p.shrinkok.v
+	}
+	return s
+}
+
+func (p *ScaleVirtualMachineParams) fromSerialized(s *serializedScaleVirtualMachineParams) {
+	*p = ScaleVirtualMachineParams{}
+	if s.Automigrate != nil {
+		p.SetAutomigrate(*s.Automigrate)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Maxiops != nil {
+		p.SetMaxiops(*s.Maxiops)
+	}
+	if s.Miniops != nil {
+		p.SetMiniops(*s.Miniops)
+	}
+	if s.Serviceofferingid != nil {
+		p.SetServiceofferingid(*s.Serviceofferingid)
+	}
+	if s.Shrinkok != nil {
+		p.SetShrinkok(*s.Shrinkok)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ScaleVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ScaleVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedScaleVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ScaleVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ScaleVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedScaleVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["shrinkok"].(bool)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ScaleVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams {
 	p := &ScaleVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Scales the virtual machine to a new service offering. This command also considers the volume size in the service offering or disk offering linked to the new service offering and apply all characteristics to the root volume.
-func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("scaleVirtualMachine", p.toURLValues())
+// Scales the virtual machine to a new service offering. This command also considers the volume size
+// in the service offering or disk offering linked to the new service offering and apply all
+// characteristics to the root volume.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, serviceofferingid.
+func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams, opts ...CallOption) (*ScaleVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("scaleVirtualMachine", s.cs.encodeParams("scaleVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7877,8 +10921,8 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -7902,199 +10946,321 @@ type ScaleVirtualMachineResponse struct {
 }
 
 type StartVirtualMachineParams struct {
-	p map[string]interface{}
+	bootintosetup     optBool
+	clusterid         optString
+	considerlasthost  optBool
+	deploymentplanner optString
+	hostid            optString
+	id                optString
+	podid             optString
 }
 
-func (p *StartVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StartVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["bootintosetup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootintosetup", vv)
+	if p.bootintosetup.ok {
+		u.Set("bootintosetup", strconv.FormatBool(p.bootintosetup.v))
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["considerlasthost"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("considerlasthost", vv)
+	if p.considerlasthost.ok {
+		u.Set("considerlasthost", strconv.FormatBool(p.considerlasthost.v))
 	}
-	if v, found := p.p["deploymentplanner"]; found {
-		u.Set("deploymentplanner", v.(string))
+	if p.deploymentplanner.ok {
+		u.Set("deploymentplanner", p.deploymentplanner.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
 	return u
 }
 
-func (p *StartVirtualMachineParams) SetBootintosetup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStartVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new StartVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStartVirtualMachineParams(u url.Values) (*StartVirtualMachineParams, error) {
+	p := &StartVirtualMachineParams{}
+	if err := checkParamNames("startVirtualMachine", u, "bootintosetup", "clusterid", "considerlasthost", "deploymentplanner", "hostid", "id", "podid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["bootintosetup"]; found {
+		v, err := strconv.ParseBool(u.Get("bootintosetup"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param bootintosetup: %v", err)
+		}
+		p.SetBootintosetup(v)
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["considerlasthost"]; found {
+		v, err := strconv.ParseBool(u.Get("considerlasthost"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param considerlasthost: %v", err)
+		}
+		p.SetConsiderlasthost(v)
+	}
+	if _, found := u["deploymentplanner"]; found {
+		p.SetDeploymentplanner(u.Get("deploymentplanner"))
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
 	}
-	p.p["bootintosetup"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	return p, nil
+}
+
+// SetBootintosetup sets the bootintosetup param.
+func (p *StartVirtualMachineParams) SetBootintosetup(v bool) {
+	p.bootintosetup = optBool{v: v, ok: true}
 }
 
+// ResetBootintosetup unsets the bootintosetup param
 func (p *StartVirtualMachineParams) ResetBootintosetup() {
-	if p.p != nil && p.p["bootintosetup"] != nil {
-		delete(p.p, "bootintosetup")
-	}
+	p.bootintosetup = optBool{}
 }
 
+// GetBootintosetup returns the bootintosetup param and if it is set
 func (p *StartVirtualMachineParams) GetBootintosetup() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["bootintosetup"].(bool)
-	return value, ok
+	return p.bootintosetup.v, p.bootintosetup.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *StartVirtualMachineParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *StartVirtualMachineParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *StartVirtualMachineParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetConsiderlasthost sets the considerlasthost param.
 func (p *StartVirtualMachineParams) SetConsiderlasthost(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["considerlasthost"] = v
+	p.considerlasthost = optBool{v: v, ok: true}
 }
 
+// ResetConsiderlasthost unsets the considerlasthost param
 func (p *StartVirtualMachineParams) ResetConsiderlasthost() {
-	if p.p != nil && p.p["considerlasthost"] != nil {
-		delete(p.p, "considerlasthost")
-	}
+	p.considerlasthost = optBool{}
 }
 
+// GetConsiderlasthost returns the considerlasthost param and if it is set
 func (p *StartVirtualMachineParams) GetConsiderlasthost() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["considerlasthost"].(bool)
-	return value, ok
+	return p.considerlasthost.v, p.considerlasthost.ok
 }
 
+// SetDeploymentplanner sets the deploymentplanner param.
 func (p *StartVirtualMachineParams) SetDeploymentplanner(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deploymentplanner"] = v
+	p.deploymentplanner = optString{v: v, ok: true}
 }
 
+// ResetDeploymentplanner unsets the deploymentplanner param
 func (p *StartVirtualMachineParams) ResetDeploymentplanner() {
-	if p.p != nil && p.p["deploymentplanner"] != nil {
-		delete(p.p, "deploymentplanner")
-	}
+	p.deploymentplanner = optString{}
 }
 
+// GetDeploymentplanner returns the deploymentplanner param and if it is set
 func (p *StartVirtualMachineParams) GetDeploymentplanner() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["deploymentplanner"].(string)
-	return value, ok
+	return p.deploymentplanner.v, p.deploymentplanner.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *StartVirtualMachineParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *StartVirtualMachineParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *StartVirtualMachineParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *StartVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *StartVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StartVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetPodid sets the podid param.
 func (p *StartVirtualMachineParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *StartVirtualMachineParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *StartVirtualMachineParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.podid.v, p.podid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StartVirtualMachineParams) Clone() *StartVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StartVirtualMachineParams) Equal(o *StartVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.bootintosetup == o.bootintosetup &&
+		p.clusterid == o.clusterid &&
+		p.considerlasthost == o.considerlasthost &&
+		p.deploymentplanner == o.deploymentplanner &&
+		p.hostid == o.hostid &&
+		p.id == o.id &&
+		p.podid == o.podid
+}
+
+// serializedStartVirtualMachineParams is used to (un)marshal StartVirtualMachineParams using the API param names
+type serializedStartVirtualMachineParams struct {
+	Bootintosetup     *bool   `json:"bootintosetup,omitempty" yaml:"bootintosetup,omitempty"`
+	Clusterid         *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Considerlasthost  *bool   `json:"considerlasthost,omitempty" yaml:"considerlasthost,omitempty"`
+	Deploymentplanner *string `json:"deploymentplanner,omitempty" yaml:"deploymentplanner,omitempty"`
+	Hostid            *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+}
+
+func (p *StartVirtualMachineParams) toSerialized() *serializedStartVirtualMachineParams {
+	s := &serializedStartVirtualMachineParams{}
+	if p.bootintosetup.ok {
+		s.Bootintosetup = &p.bootintosetup.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.considerlasthost.ok {
+		s.Considerlasthost = &p.considerlasthost.v
+	}
+	if p.deploymentplanner.ok {
+		s.Deploymentplanner = &p.deploymentplanner.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
 	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *StartVirtualMachineParams) fromSerialized(s *serializedStartVirtualMachineParams) {
+	*p = StartVirtualMachineParams{}
+	if s.Bootintosetup != nil {
+		p.SetBootintosetup(*s.Bootintosetup)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Considerlasthost != nil {
+		p.SetConsiderlasthost(*s.Considerlasthost)
+	}
+	if s.Deploymentplanner != nil {
+		p.SetDeploymentplanner(*s.Deploymentplanner)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StartVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StartVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedStartVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StartVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StartVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStartVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StartVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVirtualMachineParams {
 	p := &StartVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Starts a virtual machine.
-func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("startVirtualMachine", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams, opts ...CallOption) (*StartVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("startVirtualMachine", s.cs.encodeParams("startVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8105,8 +11271,8 @@ func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -8288,78 +11454,167 @@ func (r *StartVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type StopVirtualMachineParams struct {
-	p map[string]interface{}
+	forced optBool
+	id     optString
 }
 
-func (p *StopVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *StopVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if p.forced.ok {
+		u.Set("forced", strconv.FormatBool(p.forced.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *StopVirtualMachineParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseStopVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new StopVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseStopVirtualMachineParams(u url.Values) (*StopVirtualMachineParams, error) {
+	p := &StopVirtualMachineParams{}
+	if err := checkParamNames("stopVirtualMachine", u, "forced", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["forced"]; found {
+		v, err := strconv.ParseBool(u.Get("forced"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param forced: %v", err)
+		}
+		p.SetForced(v)
 	}
-	p.p["forced"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetForced sets the forced param.
+func (p *StopVirtualMachineParams) SetForced(v bool) {
+	p.forced = optBool{v: v, ok: true}
 }
 
+// ResetForced unsets the forced param
 func (p *StopVirtualMachineParams) ResetForced() {
-	if p.p != nil && p.p["forced"] != nil {
-		delete(p.p, "forced")
-	}
+	p.forced = optBool{}
 }
 
+// GetForced returns the forced param and if it is set
 func (p *StopVirtualMachineParams) GetForced() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["forced"].(bool)
-	return value, ok
+	return p.forced.v, p.forced.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *StopVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *StopVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *StopVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *StopVirtualMachineParams) Clone() *StopVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *StopVirtualMachineParams) Equal(o *StopVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.forced == o.forced &&
+		p.id == o.id
+}
+
+// serializedStopVirtualMachineParams is used to (un)marshal StopVirtualMachineParams using the API param names
+type serializedStopVirtualMachineParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *StopVirtualMachineParams) toSerialized() *serializedStopVirtualMachineParams {
+	s := &serializedStopVirtualMachineParams{}
+	if p.forced.ok {
+		s.Forced = &p.forced.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *StopVirtualMachineParams) fromSerialized(s *serializedStopVirtualMachineParams) {
+	*p = StopVirtualMachineParams{}
+	if s.Forced != nil {
+		p.SetForced(*s.Forced)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p StopVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *StopVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedStopVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p StopVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *StopVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedStopVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new StopVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirtualMachineParams {
 	p := &StopVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Stops a virtual machine.
-func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("stopVirtualMachine", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams, opts ...CallOption) (*StopVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("stopVirtualMachine", s.cs.encodeParams("stopVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8370,8 +11625,8 @@ func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams)
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -8552,79 +11807,165 @@ func (r *StopVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	return json.Unmarshal(b, (*alias)(r))
 }
 
-type UpdateDefaultNicForVirtualMachineParams struct {
-	p map[string]interface{}
+type UpdateDefaultNicForVirtualMachineParams struct {
+	nicid            optString
+	virtualmachineid optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateDefaultNicForVirtualMachineParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p == nil {
+		return u
+	}
+	if p.nicid.ok {
+		u.Set("nicid", p.nicid.v)
+	}
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
+	}
+	return u
+}
+
+// ParseUpdateDefaultNicForVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateDefaultNicForVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateDefaultNicForVirtualMachineParams(u url.Values) (*UpdateDefaultNicForVirtualMachineParams, error) {
+	p := &UpdateDefaultNicForVirtualMachineParams{}
+	if err := checkParamNames("updateDefaultNicForVirtualMachine", u, "nicid", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["nicid"]; found {
+		p.SetNicid(u.Get("nicid"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	return p, nil
+}
+
+// SetNicid sets the nicid param. This param is required.
+func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
+	p.nicid = optString{v: v, ok: true}
+}
+
+// ResetNicid unsets the nicid param
+func (p *UpdateDefaultNicForVirtualMachineParams) ResetNicid() {
+	p.nicid = optString{}
+}
+
+// GetNicid returns the nicid param and if it is set
+func (p *UpdateDefaultNicForVirtualMachineParams) GetNicid() (string, bool) {
+	return p.nicid.v, p.nicid.ok
+}
+
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
+func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.virtualmachineid = optString{v: v, ok: true}
+}
+
+// ResetVirtualmachineid unsets the virtualmachineid param
+func (p *UpdateDefaultNicForVirtualMachineParams) ResetVirtualmachineid() {
+	p.virtualmachineid = optString{}
+}
+
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
+func (p *UpdateDefaultNicForVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
+// Clone returns a deep copy of the params
+func (p *UpdateDefaultNicForVirtualMachineParams) Clone() *UpdateDefaultNicForVirtualMachineParams {
+	if p == nil {
+		return nil
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
+	c := *p
+	return &c
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateDefaultNicForVirtualMachineParams) Equal(o *UpdateDefaultNicForVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
 	}
-	p.p["nicid"] = v
+	return p.nicid == o.nicid &&
+		p.virtualmachineid == o.virtualmachineid
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) ResetNicid() {
-	if p.p != nil && p.p["nicid"] != nil {
-		delete(p.p, "nicid")
-	}
+// serializedUpdateDefaultNicForVirtualMachineParams is used to (un)marshal UpdateDefaultNicForVirtualMachineParams using the API param names
+type serializedUpdateDefaultNicForVirtualMachineParams struct {
+	Nicid            *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) GetNicid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateDefaultNicForVirtualMachineParams) toSerialized() *serializedUpdateDefaultNicForVirtualMachineParams {
+	s := &serializedUpdateDefaultNicForVirtualMachineParams{}
+	if p.nicid.ok {
+		s.Nicid = &p.nicid.v
 	}
-	value, ok := p.p["nicid"].(string)
-	return value, ok
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateDefaultNicForVirtualMachineParams) fromSerialized(s *serializedUpdateDefaultNicForVirtualMachineParams) {
+	*p = UpdateDefaultNicForVirtualMachineParams{}
+	if s.Nicid != nil {
+		p.SetNicid(*s.Nicid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
 	}
-	p.p["virtualmachineid"] = v
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateDefaultNicForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateDefaultNicForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateDefaultNicForVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateDefaultNicForVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateDefaultNicForVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateDefaultNicForVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateDefaultNicForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams {
 	p := &UpdateDefaultNicForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetNicid(nicid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Changes the default NIC on a VM
-func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateDefaultNicForVirtualMachine", p.toURLValues())
+// Changes the default NIC on a VM.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: nicid, virtualmachineid.
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams, opts ...CallOption) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest("updateDefaultNicForVirtualMachine", s.cs.encodeParams("updateDefaultNicForVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8635,8 +11976,8 @@ func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefau
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -8818,517 +12159,717 @@ func (r *UpdateDefaultNicForVirtualMachineResponse) UnmarshalJSON(b []byte) erro
 }
 
 type UpdateVirtualMachineParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
+	cleanupdetails         optBool
+	customid               optString
+	details                optStringMap
+	dhcpoptionsnetworklist optStringMaps
+	displayname            optString
+	displayvm              optBool
+	extraconfig            optString
+	group                  optString
+	haenable               optBool
+	id                     optString
+	instancename           optString
+	isdynamicallyscalable  optBool
+	name                   optString
+	ostypeid               optString
+	securitygroupids       optStrings
+	securitygroupnames     optStrings
+	userdata               optString
+	userdatadetails        optStringMap
+	userdataid             optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["cleanupdetails"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanupdetails", vv)
+	if p.cleanupdetails.ok {
+		u.Set("cleanupdetails", strconv.FormatBool(p.cleanupdetails.v))
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["dhcpoptionsnetworklist"]; found {
-		l := v.([]map[string]string)
-		for i, m := range l {
+	if p.dhcpoptionsnetworklist.ok {
+		for i, m := range p.dhcpoptionsnetworklist.v {
 			for key, val := range m {
 				u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].%s", i, key), val)
 			}
 		}
 	}
-	if v, found := p.p["displayname"]; found {
-		u.Set("displayname", v.(string))
+	if p.displayname.ok {
+		u.Set("displayname", p.displayname.v)
 	}
-	if v, found := p.p["displayvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvm", vv)
+	if p.displayvm.ok {
+		u.Set("displayvm", strconv.FormatBool(p.displayvm.v))
 	}
-	if v, found := p.p["extraconfig"]; found {
-		u.Set("extraconfig", v.(string))
+	if p.extraconfig.ok {
+		u.Set("extraconfig", p.extraconfig.v)
 	}
-	if v, found := p.p["group"]; found {
-		u.Set("group", v.(string))
+	if p.group.ok {
+		u.Set("group", p.group.v)
 	}
-	if v, found := p.p["haenable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("haenable", vv)
+	if p.haenable.ok {
+		u.Set("haenable", strconv.FormatBool(p.haenable.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["instancename"]; found {
-		u.Set("instancename", v.(string))
+	if p.instancename.ok {
+		u.Set("instancename", p.instancename.v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
+	if p.isdynamicallyscalable.ok {
+		u.Set("isdynamicallyscalable", strconv.FormatBool(p.isdynamicallyscalable.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if p.ostypeid.ok {
+		u.Set("ostypeid", p.ostypeid.v)
 	}
-	if v, found := p.p["securitygroupids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("securitygroupids", vv)
+	if p.securitygroupids.ok {
+		u.Set("securitygroupids", strings.Join(p.securitygroupids.v, ","))
 	}
-	if v, found := p.p["securitygroupnames"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("securitygroupnames", vv)
+	if p.securitygroupnames.ok {
+		u.Set("securitygroupnames", strings.Join(p.securitygroupnames.v, ","))
 	}
-	if v, found := p.p["userdata"]; found {
-		u.Set("userdata", v.(string))
+	if p.userdata.ok {
+		u.Set("userdata", p.userdata.v)
 	}
-	if v, found := p.p["userdatadetails"]; found {
-		m := v.(map[string]string)
+	if p.userdatadetails.ok {
+		m := p.userdatadetails.v
 		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("userdatadetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("userdatadetails[%d].value", i), m[k])
+			u.Set(fmt.Sprintf("userdatadetails[%d].%s", i, k), m[k])
 		}
 	}
-	if v, found := p.p["userdataid"]; found {
-		u.Set("userdataid", v.(string))
+	if p.userdataid.ok {
+		u.Set("userdataid", p.userdataid.v)
 	}
 	return u
 }
 
-func (p *UpdateVirtualMachineParams) SetCleanupdetails(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVirtualMachineParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVirtualMachineParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVirtualMachineParams(u url.Values) (*UpdateVirtualMachineParams, error) {
+	p := &UpdateVirtualMachineParams{}
+	if err := checkParamNames("updateVirtualMachine", u, "cleanupdetails", "customid", "details[]", "dhcpoptionsnetworklist[]", "displayname", "displayvm", "extraconfig", "group", "haenable", "id", "instancename", "isdynamicallyscalable", "name", "ostypeid", "securitygroupids", "securitygroupnames", "userdata", "userdatadetails[]", "userdataid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["cleanupdetails"]; found {
+		v, err := strconv.ParseBool(u.Get("cleanupdetails"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param cleanupdetails: %v", err)
+		}
+		p.SetCleanupdetails(v)
 	}
-	p.p["cleanupdetails"] = v
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if m, err := parseIndexedMap(u, "details", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if l, err := parseIndexedValues(u, "dhcpoptionsnetworklist"); err != nil {
+		return nil, err
+	} else if len(l) > 0 {
+		p.SetDhcpoptionsnetworklist(l)
+	}
+	if _, found := u["displayname"]; found {
+		p.SetDisplayname(u.Get("displayname"))
+	}
+	if _, found := u["displayvm"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvm"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvm: %v", err)
+		}
+		p.SetDisplayvm(v)
+	}
+	if _, found := u["extraconfig"]; found {
+		p.SetExtraconfig(u.Get("extraconfig"))
+	}
+	if _, found := u["group"]; found {
+		p.SetGroup(u.Get("group"))
+	}
+	if _, found := u["haenable"]; found {
+		v, err := strconv.ParseBool(u.Get("haenable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param haenable: %v", err)
+		}
+		p.SetHaenable(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["instancename"]; found {
+		p.SetInstancename(u.Get("instancename"))
+	}
+	if _, found := u["isdynamicallyscalable"]; found {
+		v, err := strconv.ParseBool(u.Get("isdynamicallyscalable"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isdynamicallyscalable: %v", err)
+		}
+		p.SetIsdynamicallyscalable(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["ostypeid"]; found {
+		p.SetOstypeid(u.Get("ostypeid"))
+	}
+	if _, found := u["securitygroupids"]; found {
+		p.SetSecuritygroupids(strings.Split(u.Get("securitygroupids"), ","))
+	}
+	if _, found := u["securitygroupnames"]; found {
+		p.SetSecuritygroupnames(strings.Split(u.Get("securitygroupnames"), ","))
+	}
+	if _, found := u["userdata"]; found {
+		p.SetUserdata(u.Get("userdata"))
+	}
+	if m, err := parseIndexedMap(u, "userdatadetails", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetUserdatadetails(m)
+	}
+	if _, found := u["userdataid"]; found {
+		p.SetUserdataid(u.Get("userdataid"))
+	}
+	return p, nil
+}
+
+// SetCleanupdetails sets the cleanupdetails param.
+func (p *UpdateVirtualMachineParams) SetCleanupdetails(v bool) {
+	p.cleanupdetails = optBool{v: v, ok: true}
 }
 
+// ResetCleanupdetails unsets the cleanupdetails param
 func (p *UpdateVirtualMachineParams) ResetCleanupdetails() {
-	if p.p != nil && p.p["cleanupdetails"] != nil {
-		delete(p.p, "cleanupdetails")
-	}
+	p.cleanupdetails = optBool{}
 }
 
+// GetCleanupdetails returns the cleanupdetails param and if it is set
 func (p *UpdateVirtualMachineParams) GetCleanupdetails() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["cleanupdetails"].(bool)
-	return value, ok
+	return p.cleanupdetails.v, p.cleanupdetails.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *UpdateVirtualMachineParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateVirtualMachineParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateVirtualMachineParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDetails sets the details param.
 func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *UpdateVirtualMachineParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *UpdateVirtualMachineParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDhcpoptionsnetworklist sets the dhcpoptionsnetworklist param.
 func (p *UpdateVirtualMachineParams) SetDhcpoptionsnetworklist(v []map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dhcpoptionsnetworklist"] = v
+	p.dhcpoptionsnetworklist = optStringMaps{v: v, ok: true}
 }
 
+// ResetDhcpoptionsnetworklist unsets the dhcpoptionsnetworklist param
 func (p *UpdateVirtualMachineParams) ResetDhcpoptionsnetworklist() {
-	if p.p != nil && p.p["dhcpoptionsnetworklist"] != nil {
-		delete(p.p, "dhcpoptionsnetworklist")
-	}
+	p.dhcpoptionsnetworklist = optStringMaps{}
 }
 
+// GetDhcpoptionsnetworklist returns the dhcpoptionsnetworklist param and if it is set
 func (p *UpdateVirtualMachineParams) GetDhcpoptionsnetworklist() ([]map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dhcpoptionsnetworklist"].([]map[string]string)
-	return value, ok
+	return p.dhcpoptionsnetworklist.v, p.dhcpoptionsnetworklist.ok
 }
 
+// AddDhcpoptionsnetworklist adds an item to the dhcpoptionsnetworklist param
 func (p *UpdateVirtualMachineParams) AddDhcpoptionsnetworklist(item map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	val, found := p.p["dhcpoptionsnetworklist"]
-	if !found {
-		p.p["dhcpoptionsnetworklist"] = []map[string]string{}
-		val = p.p["dhcpoptionsnetworklist"]
-	}
-	l := val.([]map[string]string)
-	l = append(l, item)
-	p.p["dhcpoptionsnetworklist"] = l
+	p.dhcpoptionsnetworklist.v = append(p.dhcpoptionsnetworklist.v, item)
+	p.dhcpoptionsnetworklist.ok = true
 }
 
+// SetDisplayname sets the displayname param.
 func (p *UpdateVirtualMachineParams) SetDisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayname"] = v
+	p.displayname = optString{v: v, ok: true}
 }
 
+// ResetDisplayname unsets the displayname param
 func (p *UpdateVirtualMachineParams) ResetDisplayname() {
-	if p.p != nil && p.p["displayname"] != nil {
-		delete(p.p, "displayname")
-	}
+	p.displayname = optString{}
 }
 
+// GetDisplayname returns the displayname param and if it is set
 func (p *UpdateVirtualMachineParams) GetDisplayname() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayname"].(string)
-	return value, ok
+	return p.displayname.v, p.displayname.ok
 }
 
+// SetDisplayvm sets the displayvm param.
 func (p *UpdateVirtualMachineParams) SetDisplayvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvm"] = v
+	p.displayvm = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvm unsets the displayvm param
 func (p *UpdateVirtualMachineParams) ResetDisplayvm() {
-	if p.p != nil && p.p["displayvm"] != nil {
-		delete(p.p, "displayvm")
-	}
+	p.displayvm = optBool{}
 }
 
+// GetDisplayvm returns the displayvm param and if it is set
 func (p *UpdateVirtualMachineParams) GetDisplayvm() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvm"].(bool)
-	return value, ok
+	return p.displayvm.v, p.displayvm.ok
 }
 
+// SetExtraconfig sets the extraconfig param.
 func (p *UpdateVirtualMachineParams) SetExtraconfig(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["extraconfig"] = v
+	p.extraconfig = optString{v: v, ok: true}
 }
 
+// ResetExtraconfig unsets the extraconfig param
 func (p *UpdateVirtualMachineParams) ResetExtraconfig() {
-	if p.p != nil && p.p["extraconfig"] != nil {
-		delete(p.p, "extraconfig")
-	}
+	p.extraconfig = optString{}
 }
 
+// GetExtraconfig returns the extraconfig param and if it is set
 func (p *UpdateVirtualMachineParams) GetExtraconfig() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["extraconfig"].(string)
-	return value, ok
+	return p.extraconfig.v, p.extraconfig.ok
 }
 
+// SetGroup sets the group param.
 func (p *UpdateVirtualMachineParams) SetGroup(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["group"] = v
+	p.group = optString{v: v, ok: true}
 }
 
+// ResetGroup unsets the group param
 func (p *UpdateVirtualMachineParams) ResetGroup() {
-	if p.p != nil && p.p["group"] != nil {
-		delete(p.p, "group")
-	}
+	p.group = optString{}
 }
 
+// GetGroup returns the group param and if it is set
 func (p *UpdateVirtualMachineParams) GetGroup() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["group"].(string)
-	return value, ok
+	return p.group.v, p.group.ok
 }
 
+// SetHaenable sets the haenable param.
 func (p *UpdateVirtualMachineParams) SetHaenable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["haenable"] = v
+	p.haenable = optBool{v: v, ok: true}
 }
 
+// ResetHaenable unsets the haenable param
 func (p *UpdateVirtualMachineParams) ResetHaenable() {
-	if p.p != nil && p.p["haenable"] != nil {
-		delete(p.p, "haenable")
-	}
+	p.haenable = optBool{}
 }
 
+// GetHaenable returns the haenable param and if it is set
 func (p *UpdateVirtualMachineParams) GetHaenable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["haenable"].(bool)
-	return value, ok
+	return p.haenable.v, p.haenable.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVirtualMachineParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVirtualMachineParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetInstancename sets the instancename param.
 func (p *UpdateVirtualMachineParams) SetInstancename(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["instancename"] = v
+	p.instancename = optString{v: v, ok: true}
 }
 
+// ResetInstancename unsets the instancename param
 func (p *UpdateVirtualMachineParams) ResetInstancename() {
-	if p.p != nil && p.p["instancename"] != nil {
-		delete(p.p, "instancename")
-	}
+	p.instancename = optString{}
 }
 
+// GetInstancename returns the instancename param and if it is set
 func (p *UpdateVirtualMachineParams) GetInstancename() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["instancename"].(string)
-	return value, ok
+	return p.instancename.v, p.instancename.ok
 }
 
+// SetIsdynamicallyscalable sets the isdynamicallyscalable param.
 func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
+	p.isdynamicallyscalable = optBool{v: v, ok: true}
 }
 
+// ResetIsdynamicallyscalable unsets the isdynamicallyscalable param
 func (p *UpdateVirtualMachineParams) ResetIsdynamicallyscalable() {
-	if p.p != nil && p.p["isdynamicallyscalable"] != nil {
-		delete(p.p, "isdynamicallyscalable")
-	}
+	p.isdynamicallyscalable = optBool{}
 }
 
+// GetIsdynamicallyscalable returns the isdynamicallyscalable param and if it is set
 func (p *UpdateVirtualMachineParams) GetIsdynamicallyscalable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isdynamicallyscalable"].(bool)
-	return value, ok
+	return p.isdynamicallyscalable.v, p.isdynamicallyscalable.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateVirtualMachineParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateVirtualMachineParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateVirtualMachineParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetOstypeid sets the ostypeid param.
 func (p *UpdateVirtualMachineParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+	p.ostypeid = optString{v: v, ok: true}
 }
 
+// ResetOstypeid unsets the ostypeid param
 func (p *UpdateVirtualMachineParams) ResetOstypeid() {
-	if p.p != nil && p.p["ostypeid"] != nil {
-		delete(p.p, "ostypeid")
-	}
+	p.ostypeid = optString{}
 }
 
+// GetOstypeid returns the ostypeid param and if it is set
 func (p *UpdateVirtualMachineParams) GetOstypeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ostypeid"].(string)
-	return value, ok
+	return p.ostypeid.v, p.ostypeid.ok
 }
 
+// SetSecuritygroupids sets the securitygroupids param.
 func (p *UpdateVirtualMachineParams) SetSecuritygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupids"] = v
+	p.securitygroupids = optStrings{v: v, ok: true}
 }
 
+// ResetSecuritygroupids unsets the securitygroupids param
 func (p *UpdateVirtualMachineParams) ResetSecuritygroupids() {
-	if p.p != nil && p.p["securitygroupids"] != nil {
-		delete(p.p, "securitygroupids")
-	}
+	p.securitygroupids = optStrings{}
 }
 
+// GetSecuritygroupids returns the securitygroupids param and if it is set
 func (p *UpdateVirtualMachineParams) GetSecuritygroupids() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupids"].([]string)
-	return value, ok
+	return p.securitygroupids.v, p.securitygroupids.ok
 }
 
+// SetSecuritygroupnames sets the securitygroupnames param.
 func (p *UpdateVirtualMachineParams) SetSecuritygroupnames(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupnames"] = v
+	p.securitygroupnames = optStrings{v: v, ok: true}
 }
 
+// ResetSecuritygroupnames unsets the securitygroupnames param
 func (p *UpdateVirtualMachineParams) ResetSecuritygroupnames() {
-	if p.p != nil && p.p["securitygroupnames"] != nil {
-		delete(p.p, "securitygroupnames")
-	}
+	p.securitygroupnames = optStrings{}
 }
 
+// GetSecuritygroupnames returns the securitygroupnames param and if it is set
 func (p *UpdateVirtualMachineParams) GetSecuritygroupnames() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["securitygroupnames"].([]string)
-	return value, ok
+	return p.securitygroupnames.v, p.securitygroupnames.ok
 }
 
+// SetUserdata sets the userdata param.
 func (p *UpdateVirtualMachineParams) SetUserdata(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
+	p.userdata = optString{v: v, ok: true}
 }
 
+// ResetUserdata unsets the userdata param
 func (p *UpdateVirtualMachineParams) ResetUserdata() {
-	if p.p != nil && p.p["userdata"] != nil {
-		delete(p.p, "userdata")
-	}
+	p.userdata = optString{}
 }
 
+// GetUserdata returns the userdata param and if it is set
 func (p *UpdateVirtualMachineParams) GetUserdata() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdata"].(string)
-	return value, ok
+	return p.userdata.v, p.userdata.ok
 }
 
+// SetUserdatadetails sets the userdatadetails param.
 func (p *UpdateVirtualMachineParams) SetUserdatadetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdatadetails"] = v
+	p.userdatadetails = optStringMap{v: v, ok: true}
 }
 
+// ResetUserdatadetails unsets the userdatadetails param
 func (p *UpdateVirtualMachineParams) ResetUserdatadetails() {
-	if p.p != nil && p.p["userdatadetails"] != nil {
-		delete(p.p, "userdatadetails")
-	}
+	p.userdatadetails = optStringMap{}
 }
 
+// GetUserdatadetails returns the userdatadetails param and if it is set
 func (p *UpdateVirtualMachineParams) GetUserdatadetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["userdatadetails"].(map[string]string)
-	return value, ok
+	return p.userdatadetails.v, p.userdatadetails.ok
 }
 
+// SetUserdataid sets the userdataid param.
 func (p *UpdateVirtualMachineParams) SetUserdataid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdataid"] = v
+	p.userdataid = optString{v: v, ok: true}
 }
 
+// ResetUserdataid unsets the userdataid param
 func (p *UpdateVirtualMachineParams) ResetUserdataid() {
-	if p.p != nil && p.p["userdataid"] != nil {
-		delete(p.p, "userdataid")
-	}
+	p.userdataid = optString{}
 }
 
+// GetUserdataid returns the userdataid param and if it is set
 func (p *UpdateVirtualMachineParams) GetUserdataid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.userdataid.v, p.userdataid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVirtualMachineParams) Clone() *UpdateVirtualMachineParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.dhcpoptionsnetworklist = p.dhcpoptionsnetworklist.clone()
+	c.securitygroupids = p.securitygroupids.clone()
+	c.securitygroupnames = p.securitygroupnames.clone()
+	c.userdatadetails = p.userdatadetails.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVirtualMachineParams) Equal(o *UpdateVirtualMachineParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.cleanupdetails == o.cleanupdetails &&
+		p.customid == o.customid &&
+		p.details.equal(o.details) &&
+		p.dhcpoptionsnetworklist.equal(o.dhcpoptionsnetworklist) &&
+		p.displayname == o.displayname &&
+		p.displayvm == o.displayvm &&
+		p.extraconfig == o.extraconfig &&
+		p.group == o.group &&
+		p.haenable == o.haenable &&
+		p.id == o.id &&
+		p.instancename == o.instancename &&
+		p.isdynamicallyscalable == o.isdynamicallyscalable &&
+		p.name == o.name &&
+		p.ostypeid == o.ostypeid &&
+		p.securitygroupids.equal(o.securitygroupids) &&
+		p.securitygroupnames.equal(o.securitygroupnames) &&
+		p.userdata == o.userdata &&
+		p.userdatadetails.equal(o.userdatadetails) &&
+		p.userdataid == o.userdataid
+}
+
+// serializedUpdateVirtualMachineParams is used to (un)marshal UpdateVirtualMachineParams using the API param names
+type serializedUpdateVirtualMachineParams struct {
+	Cleanupdetails         *bool                `json:"cleanupdetails,omitempty" yaml:"cleanupdetails,omitempty"`
+	Customid               *string              `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Details                *map[string]string   `json:"details,omitempty" yaml:"details,omitempty"`
+	Dhcpoptionsnetworklist *[]map[string]string `json:"dhcpoptionsnetworklist,omitempty" yaml:"dhcpoptionsnetworklist,omitempty"`
+	Displayname            *string              `json:"displayname,omitempty" yaml:"displayname,omitempty"`
+	Displayvm              *bool                `json:"displayvm,omitempty" yaml:"displayvm,omitempty"`
+	Extraconfig            *string              `json:"extraconfig,omitempty" yaml:"extraconfig,omitempty"`
+	Group                  *string              `json:"group,omitempty" yaml:"group,omitempty"`
+	Haenable               *bool                `json:"haenable,omitempty" yaml:"haenable,omitempty"`
+	Id                     *string              `json:"id,omitempty" yaml:"id,omitempty"`
+	Instancename           *string              `json:"instancename,omitempty" yaml:"instancename,omitempty"`
+	Isdynamicallyscalable  *bool                `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Name                   *string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid               *string              `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Securitygroupids       *[]string            `json:"securitygroupids,omitempty" yaml:"securitygroupids,omitempty"`
+	Securitygroupnames     *[]string            `json:"securitygroupnames,omitempty" yaml:"securitygroupnames,omitempty"`
+	Userdata               *string              `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Userdatadetails        *map[string]string   `json:"userdatadetails,omitempty" yaml:"userdatadetails,omitempty"`
+	Userdataid             *string              `json:"userdataid,omitempty" yaml:"userdataid,omitempty"`
+}
+
+func (p *UpdateVirtualMachineParams) toSerialized() *serializedUpdateVirtualMachineParams {
+	s := &serializedUpdateVirtualMachineParams{}
+	if p.cleanupdetails.ok {
+		s.Cleanupdetails = &p.cleanupdetails.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.dhcpoptionsnetworklist.ok {
+		s.Dhcpoptionsnetworklist = &p.dhcpoptionsnetworklist.v
+	}
+	if p.displayname.ok {
+		s.Displayname = &p.displayname.v
+	}
+	if p.displayvm.ok {
+		s.Displayvm = &p.displayvm.v
 	}
-	value, ok := p.p["userdataid"].(string)
-	return value, ok
+	if p.extraconfig.ok {
+		s.Extraconfig = &p.extraconfig.v
+	}
+	if p.group.ok {
+		s.Group = &p.group.v
+	}
+	if p.haenable.ok {
+		s.Haenable = &p.haenable.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.instancename.ok {
+		s.Instancename = &p.instancename.v
+	}
+	if p.isdynamicallyscalable.ok {
+		s.Isdynamicallyscalable = &p.isdynamicallyscalable.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.ostypeid.ok {
+		s.Ostypeid = &p.ostypeid.v
+	}
+	if p.securitygroupids.ok {
+		s.Securitygroupids = &p.securitygroupids.v
+	}
+	if p.securitygroupnames.ok {
+		s.Securitygroupnames = &p.securitygroupnames.v
+	}
+	if p.userdata.ok {
+		s.Userdata = &p.userdata.v
+	}
+	if p.userdatadetails.ok {
+		s.Userdatadetails = &p.userdatadetails.v
+	}
+	if p.userdataid.ok {
+		s.Userdataid = &p.userdataid.v
+	}
+	return s
+}
+
+func (p *UpdateVirtualMachineParams) fromSerialized(s *serializedUpdateVirtualMachineParams) {
+	*p = UpdateVirtualMachineParams{}
+	if s.Cleanupdetails != nil {
+		p.SetCleanupdetails(*s.Cleanupdetails)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Dhcpoptionsnetworklist != nil {
+		p.SetDhcpoptionsnetworklist(*s.Dhcpoptionsnetworklist)
+	}
+	if s.Displayname != nil {
+		p.SetDisplayname(*s.Displayname)
+	}
+	if s.Displayvm != nil {
+		p.SetDisplayvm(*s.Displayvm)
+	}
+	if s.Extraconfig != nil {
+		p.SetExtraconfig(*s.Extraconfig)
+	}
+	if s.Group != nil {
+		p.SetGroup(*s.Group)
+	}
+	if s.Haenable != nil {
+		p.SetHaenable(*s.Haenable)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Instancename != nil {
+		p.SetInstancename(*s.Instancename)
+	}
+	if s.Isdynamicallyscalable != nil {
+		p.SetIsdynamicallyscalable(*s.Isdynamicallyscalable)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Ostypeid != nil {
+		p.SetOstypeid(*s.Ostypeid)
+	}
+	if s.Securitygroupids != nil {
+		p.SetSecuritygroupids(*s.Securitygroupids)
+	}
+	if s.Securitygroupnames != nil {
+		p.SetSecuritygroupnames(*s.Securitygroupnames)
+	}
+	if s.Userdata != nil {
+		p.SetUserdata(*s.Userdata)
+	}
+	if s.Userdatadetails != nil {
+		p.SetUserdatadetails(*s.Userdatadetails)
+	}
+	if s.Userdataid != nil {
+		p.SetUserdataid(*s.Userdataid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVirtualMachineParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVirtualMachineParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVirtualMachineParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVirtualMachineParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams {
 	p := &UpdateVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Updates properties of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped. Therefore, stop the VM manually before issuing this call.
-func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVirtualMachine", p.toURLValues())
+// Updates properties of a virtual machine. The VM has to be stopped and restarted for the new
+// properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped.
+// Therefore, stop the VM manually before issuing this call.
+//
+// Required params: id.
+func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams, opts ...CallOption) (*UpdateVirtualMachineResponse, error) {
+	resp, err := s.cs.newPostRequest("updateVirtualMachine", s.cs.encodeParams("updateVirtualMachine", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9502,226 +13043,350 @@ func (r *UpdateVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 }
 
 type ListVirtualMachinesUsageHistoryParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVirtualMachinesUsageHistoryParams) toURLValues() url.Values {
+	enddate   optString
+	id        optString
+	ids       optStrings
+	keyword   optString
+	name      optString
+	page      optInt
+	pagesize  optInt
+	startdate optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVirtualMachinesUsageHistoryParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if p.enddate.ok {
+		u.Set("enddate", p.enddate.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if p.startdate.ok {
+		u.Set("startdate", p.startdate.v)
 	}
 	return u
 }
 
-func (p *ListVirtualMachinesUsageHistoryParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVirtualMachinesUsageHistoryParams parses url.Values, for example taken from a raw API request,
+// into a new ListVirtualMachinesUsageHistoryParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVirtualMachinesUsageHistoryParams(u url.Values) (*ListVirtualMachinesUsageHistoryParams, error) {
+	p := &ListVirtualMachinesUsageHistoryParams{}
+	if err := checkParamNames("listVirtualMachinesUsageHistory", u, "enddate", "id", "ids", "keyword", "name", "page", "pagesize", "startdate"); err != nil {
+		return nil, err
+	}
+	if _, found := u["enddate"]; found {
+		p.SetEnddate(u.Get("enddate"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["startdate"]; found {
+		p.SetStartdate(u.Get("startdate"))
 	}
-	p.p["enddate"] = v
+	return p, nil
 }
 
+// SetEnddate sets the enddate param.
+func (p *ListVirtualMachinesUsageHistoryParams) SetEnddate(v string) {
+	p.enddate = optString{v: v, ok: true}
+}
+
+// ResetEnddate unsets the enddate param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetEnddate() {
-	if p.p != nil && p.p["enddate"] != nil {
-		delete(p.p, "enddate")
-	}
+	p.enddate = optString{}
 }
 
+// GetEnddate returns the enddate param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetEnddate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["enddate"].(string)
-	return value, ok
+	return p.enddate.v, p.enddate.ok
 }
 
+// SetId sets the id param.
 func (p *ListVirtualMachinesUsageHistoryParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListVirtualMachinesUsageHistoryParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVirtualMachinesUsageHistoryParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListVirtualMachinesUsageHistoryParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVirtualMachinesUsageHistoryParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVirtualMachinesUsageHistoryParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetStartdate sets the startdate param.
 func (p *ListVirtualMachinesUsageHistoryParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+	p.startdate = optString{v: v, ok: true}
 }
 
+// ResetStartdate unsets the startdate param
 func (p *ListVirtualMachinesUsageHistoryParams) ResetStartdate() {
-	if p.p != nil && p.p["startdate"] != nil {
-		delete(p.p, "startdate")
-	}
+	p.startdate = optString{}
 }
 
+// GetStartdate returns the startdate param and if it is set
 func (p *ListVirtualMachinesUsageHistoryParams) GetStartdate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.startdate.v, p.startdate.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVirtualMachinesUsageHistoryParams) Clone() *ListVirtualMachinesUsageHistoryParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVirtualMachinesUsageHistoryParams) Equal(o *ListVirtualMachinesUsageHistoryParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.enddate == o.enddate &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.startdate == o.startdate
+}
+
+// serializedListVirtualMachinesUsageHistoryParams is used to (un)marshal ListVirtualMachinesUsageHistoryParams using the API param names
+type serializedListVirtualMachinesUsageHistoryParams struct {
+	Enddate   *string   `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Id        *string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids       *[]string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Keyword   *string   `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name      *string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Page      *int      `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int      `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Startdate *string   `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+}
+
+func (p *ListVirtualMachinesUsageHistoryParams) toSerialized() *serializedListVirtualMachinesUsageHistoryParams {
+	s := &serializedListVirtualMachinesUsageHistoryParams{}
+	if p.enddate.ok {
+		s.Enddate = &p.enddate.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.startdate.ok {
+		s.Startdate = &p.startdate.v
+	}
+	return s
+}
+
+func (p *ListVirtualMachinesUsageHistoryParams) fromSerialized(s *serializedListVirtualMachinesUsageHistoryParams) {
+	*p = ListVirtualMachinesUsageHistoryParams{}
+	if s.Enddate != nil {
+		p.SetEnddate(*s.Enddate)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Startdate != nil {
+		p.SetStartdate(*s.Startdate)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVirtualMachinesUsageHistoryParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVirtualMachinesUsageHistoryParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVirtualMachinesUsageHistoryParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVirtualMachinesUsageHistoryParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVirtualMachinesUsageHistoryParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVirtualMachinesUsageHistoryParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["startdate"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVirtualMachinesUsageHistoryParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewListVirtualMachinesUsageHistoryParams() *ListVirtualMachinesUsageHistoryParams {
 	p := &ListVirtualMachinesUsageHistoryParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesUsageHistoryParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -9769,9 +13434,8 @@ func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByName(name string
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByID(id string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
 	p := &ListVirtualMachinesUsageHistoryParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -9799,9 +13463,9 @@ func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByID(id string, op
 	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualMachinesUsageHistory UUID: %s!", id)
 }
 
-// Lists VM stats
-func (s *VirtualMachineService) ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachinesUsageHistory", p.toURLValues())
+// Lists VM stats.
+func (s *VirtualMachineService) ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams, opts ...CallOption) (*ListVirtualMachinesUsageHistoryResponse, error) {
+	resp, err := s.cs.newRequest("listVirtualMachinesUsageHistory", s.cs.encodeParams("listVirtualMachinesUsageHistory", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,123 +53,163 @@ func (m *MockVirtualMachineServiceIface) EXPECT() *MockVirtualMachineServiceIfac
 }
 
 // AddNicToVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams, opts ...CallOption) (*AddNicToVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddNicToVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddNicToVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*AddNicToVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddNicToVirtualMachine indicates an expected call of AddNicToVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) AddNicToVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AddNicToVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNicToVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AddNicToVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNicToVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AddNicToVirtualMachine), varargs...)
 }
 
 // AssignVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) AssignVirtualMachine(p *AssignVirtualMachineParams, opts ...CallOption) (*AssignVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssignVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*AssignVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssignVirtualMachine indicates an expected call of AssignVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) AssignVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AssignVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AssignVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AssignVirtualMachine), varargs...)
 }
 
 // ChangeServiceForVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams, opts ...CallOption) (*ChangeServiceForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeServiceForVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeServiceForVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*ChangeServiceForVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeServiceForVirtualMachine indicates an expected call of ChangeServiceForVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ChangeServiceForVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ChangeServiceForVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ChangeServiceForVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ChangeServiceForVirtualMachine), varargs...)
 }
 
 // CleanVMReservations mocks base method.
-func (m *MockVirtualMachineServiceIface) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+func (m *MockVirtualMachineServiceIface) CleanVMReservations(p *CleanVMReservationsParams, opts ...CallOption) (*CleanVMReservationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CleanVMReservations", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CleanVMReservations", varargs...)
 	ret0, _ := ret[0].(*CleanVMReservationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CleanVMReservations indicates an expected call of CleanVMReservations.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) CleanVMReservations(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) CleanVMReservations(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanVMReservations", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CleanVMReservations), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanVMReservations", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CleanVMReservations), varargs...)
 }
 
 // DeployVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) DeployVirtualMachine(p *DeployVirtualMachineParams, opts ...CallOption) (*DeployVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*DeployVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeployVirtualMachine indicates an expected call of DeployVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeployVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeployVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeployVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeployVirtualMachine), varargs...)
 }
 
 // DestroyVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) DestroyVirtualMachine(p *DestroyVirtualMachineParams, opts ...CallOption) (*DestroyVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroyVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DestroyVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*DestroyVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DestroyVirtualMachine indicates an expected call of DestroyVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) DestroyVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DestroyVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DestroyVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DestroyVirtualMachine), varargs...)
 }
 
 // ExpungeVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams, opts ...CallOption) (*ExpungeVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExpungeVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExpungeVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*ExpungeVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExpungeVirtualMachine indicates an expected call of ExpungeVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ExpungeVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ExpungeVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ExpungeVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ExpungeVirtualMachine), varargs...)
 }
 
 // GetVMPassword mocks base method.
-func (m *MockVirtualMachineServiceIface) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+func (m *MockVirtualMachineServiceIface) GetVMPassword(p *GetVMPasswordParams, opts ...CallOption) (*GetVMPasswordResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVMPassword", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVMPassword", varargs...)
 	ret0, _ := ret[0].(*GetVMPasswordResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVMPassword indicates an expected call of GetVMPassword.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVMPassword(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVMPassword(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMPassword", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVMPassword), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMPassword", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVMPassword), varargs...)
 }
 
 // GetVirtualMachineByID mocks base method.
@@ -362,78 +402,103 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesUsageHis
 }
 
 // ListVirtualMachines mocks base method.
-func (m *MockVirtualMachineServiceIface) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+func (m *MockVirtualMachineServiceIface) ListVirtualMachines(p *ListVirtualMachinesParams, opts ...CallOption) (*ListVirtualMachinesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVirtualMachines", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachines", varargs...)
 	ret0, _ := ret[0].(*ListVirtualMachinesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVirtualMachines indicates an expected call of ListVirtualMachines.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachines(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachines(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachines", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachines), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachines", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachines), varargs...)
 }
 
 // ListVirtualMachinesMetrics mocks base method.
-func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams, opts ...CallOption) (*ListVirtualMachinesMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVirtualMachinesMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetrics", varargs...)
 	ret0, _ := ret[0].(*ListVirtualMachinesMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVirtualMachinesMetrics indicates an expected call of ListVirtualMachinesMetrics.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetrics(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetrics", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetrics", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetrics), varargs...)
 }
 
 // ListVirtualMachinesUsageHistory mocks base method.
-func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error) {
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams, opts ...CallOption) (*ListVirtualMachinesUsageHistoryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistory", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistory", varargs...)
 	ret0, _ := ret[0].(*ListVirtualMachinesUsageHistoryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVirtualMachinesUsageHistory indicates an expected call of ListVirtualMachinesUsageHistory.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistory(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistory(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistory", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistory), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistory", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistory), varargs...)
 }
 
 // MigrateVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachine(p *MigrateVirtualMachineParams, opts ...CallOption) (*MigrateVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*MigrateVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MigrateVirtualMachine indicates an expected call of MigrateVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachine), varargs...)
 }
 
 // MigrateVirtualMachineWithVolume mocks base method.
-func (m *MockVirtualMachineServiceIface) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams, opts ...CallOption) (*MigrateVirtualMachineWithVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateVirtualMachineWithVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateVirtualMachineWithVolume", varargs...)
 	ret0, _ := ret[0].(*MigrateVirtualMachineWithVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MigrateVirtualMachineWithVolume indicates an expected call of MigrateVirtualMachineWithVolume.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineWithVolume(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineWithVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineWithVolume", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineWithVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineWithVolume", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineWithVolume), varargs...)
 }
 
 // NewAddNicToVirtualMachineParams mocks base method.
@@ -674,6 +739,20 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewResetPasswordForVirtual
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewResetPasswordForVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewResetPasswordForVirtualMachineParams), id)
 }
 
+// NewResetUserDataForVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewResetUserDataForVirtualMachineParams(id string) *ResetUserDataForVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewResetUserDataForVirtualMachineParams", id)
+	ret0, _ := ret[0].(*ResetUserDataForVirtualMachineParams)
+	return ret0
+}
+
+// NewResetUserDataForVirtualMachineParams indicates an expected call of NewResetUserDataForVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewResetUserDataForVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewResetUserDataForVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewResetUserDataForVirtualMachineParams), id)
+}
+
 // NewRestoreVirtualMachineParams mocks base method.
 func (m *MockVirtualMachineServiceIface) NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams {
 	m.ctrl.T.Helper()
@@ -759,151 +838,221 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewUpdateVirtualMachinePar
 }
 
 // RebootVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) RebootVirtualMachine(p *RebootVirtualMachineParams, opts ...CallOption) (*RebootVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RebootVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebootVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*RebootVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RebootVirtualMachine indicates an expected call of RebootVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) RebootVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RebootVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RebootVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RebootVirtualMachine), varargs...)
 }
 
 // RecoverVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) RecoverVirtualMachine(p *RecoverVirtualMachineParams, opts ...CallOption) (*RecoverVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecoverVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecoverVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*RecoverVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RecoverVirtualMachine indicates an expected call of RecoverVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) RecoverVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RecoverVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RecoverVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RecoverVirtualMachine), varargs...)
 }
 
 // RemoveNicFromVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams, opts ...CallOption) (*RemoveNicFromVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveNicFromVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveNicFromVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*RemoveNicFromVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveNicFromVirtualMachine indicates an expected call of RemoveNicFromVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveNicFromVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveNicFromVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNicFromVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveNicFromVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNicFromVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveNicFromVirtualMachine), varargs...)
 }
 
 // ResetPasswordForVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams, opts ...CallOption) (*ResetPasswordForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetPasswordForVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPasswordForVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*ResetPasswordForVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResetPasswordForVirtualMachine indicates an expected call of ResetPasswordForVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetPasswordForVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetPasswordForVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetPasswordForVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetPasswordForVirtualMachine), varargs...)
+}
+
+// ResetUserDataForVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) ResetUserDataForVirtualMachine(p *ResetUserDataForVirtualMachineParams, opts ...CallOption) (*ResetUserDataForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetUserDataForVirtualMachine", varargs...)
+	ret0, _ := ret[0].(*ResetUserDataForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetUserDataForVirtualMachine indicates an expected call of ResetUserDataForVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetUserDataForVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUserDataForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetUserDataForVirtualMachine), varargs...)
 }
 
 // RestoreVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) RestoreVirtualMachine(p *RestoreVirtualMachineParams, opts ...CallOption) (*RestoreVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RestoreVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*RestoreVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RestoreVirtualMachine indicates an expected call of RestoreVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) RestoreVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RestoreVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RestoreVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RestoreVirtualMachine), varargs...)
 }
 
 // ScaleVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) ScaleVirtualMachine(p *ScaleVirtualMachineParams, opts ...CallOption) (*ScaleVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ScaleVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScaleVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*ScaleVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ScaleVirtualMachine indicates an expected call of ScaleVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ScaleVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ScaleVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ScaleVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ScaleVirtualMachine), varargs...)
 }
 
 // StartVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) StartVirtualMachine(p *StartVirtualMachineParams, opts ...CallOption) (*StartVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*StartVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartVirtualMachine indicates an expected call of StartVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) StartVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StartVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StartVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StartVirtualMachine), varargs...)
 }
 
 // StopVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) StopVirtualMachine(p *StopVirtualMachineParams, opts ...CallOption) (*StopVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*StopVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StopVirtualMachine indicates an expected call of StopVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) StopVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StopVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StopVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StopVirtualMachine), varargs...)
 }
 
 // UpdateDefaultNicForVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams, opts ...CallOption) (*UpdateDefaultNicForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDefaultNicForVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDefaultNicForVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*UpdateDefaultNicForVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateDefaultNicForVirtualMachine indicates an expected call of UpdateDefaultNicForVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateDefaultNicForVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateDefaultNicForVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultNicForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateDefaultNicForVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultNicForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateDefaultNicForVirtualMachine), varargs...)
 }
 
 // UpdateVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+func (m *MockVirtualMachineServiceIface) UpdateVirtualMachine(p *UpdateVirtualMachineParams, opts ...CallOption) (*UpdateVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVirtualMachine", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVirtualMachine", varargs...)
 	ret0, _ := ret[0].(*UpdateVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVirtualMachine indicates an expected call of UpdateVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateVirtualMachine(p interface{}) *gomock.Call {
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateVirtualMachine(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateVirtualMachine), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateVirtualMachine), varargs...)
 }
@@ -28,148 +28,243 @@ import (
 )
 
 type VolumeServiceIface interface {
-	AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolume(p *AttachVolumeParams, opts ...CallOption) (*AttachVolumeResponse, error)
 	NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams
-	CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolume(p *CreateVolumeParams, opts ...CallOption) (*CreateVolumeResponse, error)
 	NewCreateVolumeParams() *CreateVolumeParams
-	DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	DeleteVolume(p *DeleteVolumeParams, opts ...CallOption) (*DeleteVolumeResponse, error)
 	NewDeleteVolumeParams(id string) *DeleteVolumeParams
-	DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error)
+	DestroyVolume(p *DestroyVolumeParams, opts ...CallOption) (*DestroyVolumeResponse, error)
 	NewDestroyVolumeParams(id string) *DestroyVolumeParams
-	DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolume(p *DetachVolumeParams, opts ...CallOption) (*DetachVolumeResponse, error)
 	NewDetachVolumeParams() *DetachVolumeParams
-	ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolume(p *ExtractVolumeParams, opts ...CallOption) (*ExtractVolumeResponse, error)
 	NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams
-	GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error)
+	GetPathForVolume(p *GetPathForVolumeParams, opts ...CallOption) (*GetPathForVolumeResponse, error)
 	NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams
-	GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error)
+	GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams, opts ...CallOption) (*GetSolidFireVolumeSizeResponse, error)
 	NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams
-	GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams, opts ...CallOption) (*GetUploadParamsForVolumeResponse, error)
 	NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams
-	GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error)
+	GetVolumeiScsiName(p *GetVolumeiScsiNameParams, opts ...CallOption) (*GetVolumeiScsiNameResponse, error)
 	NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams
-	ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error)
+	ListVolumes(p *ListVolumesParams, opts ...CallOption) (*ListVolumesResponse, error)
 	NewListVolumesParams() *ListVolumesParams
 	GetVolumeID(name string, opts ...OptionFunc) (string, int, error)
 	GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error)
 	GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error)
-	ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error)
+	ListVolumesMetrics(p *ListVolumesMetricsParams, opts ...CallOption) (*ListVolumesMetricsResponse, error)
 	NewListVolumesMetricsParams() *ListVolumesMetricsParams
 	GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error)
 	GetVolumesMetricByName(name string, opts ...OptionFunc) (*VolumesMetric, int, error)
 	GetVolumesMetricByID(id string, opts ...OptionFunc) (*VolumesMetric, int, error)
-	MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolume(p *MigrateVolumeParams, opts ...CallOption) (*MigrateVolumeResponse, error)
 	NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams
-	RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeResponse, error)
+	RecoverVolume(p *RecoverVolumeParams, opts ...CallOption) (*RecoverVolumeResponse, error)
 	NewRecoverVolumeParams(id string) *RecoverVolumeParams
-	ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolume(p *ResizeVolumeParams, opts ...CallOption) (*ResizeVolumeResponse, error)
 	NewResizeVolumeParams(id string) *ResizeVolumeParams
-	UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolume(p *UpdateVolumeParams, opts ...CallOption) (*UpdateVolumeResponse, error)
 	NewUpdateVolumeParams() *UpdateVolumeParams
-	UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolume(p *UploadVolumeParams, opts ...CallOption) (*UploadVolumeResponse, error)
 	NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams
-	ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error)
+	ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams, opts ...CallOption) (*ChangeOfferingForVolumeResponse, error)
 	NewChangeOfferingForVolumeParams(diskofferingid string, id string) *ChangeOfferingForVolumeParams
 }
 
 type AttachVolumeParams struct {
-	p map[string]interface{}
+	deviceid         optInt64
+	id               optString
+	virtualmachineid optString
 }
 
-func (p *AttachVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *AttachVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["deviceid"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("deviceid", vv)
+	if p.deviceid.ok {
+		u.Set("deviceid", strconv.FormatInt(p.deviceid.v, 10))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *AttachVolumeParams) SetDeviceid(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseAttachVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new AttachVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseAttachVolumeParams(u url.Values) (*AttachVolumeParams, error) {
+	p := &AttachVolumeParams{}
+	if err := checkParamNames("attachVolume", u, "deviceid", "id", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["deviceid"]; found {
+		v, err := strconv.ParseInt(u.Get("deviceid"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param deviceid: %v", err)
+		}
+		p.SetDeviceid(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
 	}
-	p.p["deviceid"] = v
+	return p, nil
 }
 
+// SetDeviceid sets the deviceid param.
+func (p *AttachVolumeParams) SetDeviceid(v int64) {
+	p.deviceid = optInt64{v: v, ok: true}
+}
+
+// ResetDeviceid unsets the deviceid param
 func (p *AttachVolumeParams) ResetDeviceid() {
-	if p.p != nil && p.p["deviceid"] != nil {
-		delete(p.p, "deviceid")
-	}
+	p.deviceid = optInt64{}
 }
 
+// GetDeviceid returns the deviceid param and if it is set
 func (p *AttachVolumeParams) GetDeviceid() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["deviceid"].(int64)
-	return value, ok
+	return p.deviceid.v, p.deviceid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *AttachVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *AttachVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *AttachVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param. This param is required.
 func (p *AttachVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *AttachVolumeParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *AttachVolumeParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *AttachVolumeParams) Clone() *AttachVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *AttachVolumeParams) Equal(o *AttachVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.deviceid == o.deviceid &&
+		p.id == o.id &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedAttachVolumeParams is used to (un)marshal AttachVolumeParams using the API param names
+type serializedAttachVolumeParams struct {
+	Deviceid         *int64  `json:"deviceid,omitempty" yaml:"deviceid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *AttachVolumeParams) toSerialized() *serializedAttachVolumeParams {
+	s := &serializedAttachVolumeParams{}
+	if p.deviceid.ok {
+		s.Deviceid = &p.deviceid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *AttachVolumeParams) fromSerialized(s *serializedAttachVolumeParams) {
+	*p = AttachVolumeParams{}
+	if s.Deviceid != nil {
+		p.SetDeviceid(*s.Deviceid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p AttachVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *AttachVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedAttachVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p AttachVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *AttachVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedAttachVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new AttachVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams {
 	p := &AttachVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetId(id)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Attaches a disk volume to a virtual machine.
-func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("attachVolume", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, virtualmachineid.
+func (s *VolumeService) AttachVolume(p *AttachVolumeParams, opts ...CallOption) (*AttachVolumeResponse, error) {
+	resp, err := s.cs.newRequest("attachVolume", s.cs.encodeParams("attachVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -180,8 +275,8 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -274,344 +369,509 @@ type AttachVolumeResponse struct {
 }
 
 type CreateVolumeParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVolumeParams) toURLValues() url.Values {
+	account          optString
+	customid         optString
+	diskofferingid   optString
+	displayvolume    optBool
+	domainid         optString
+	maxiops          optInt64
+	miniops          optInt64
+	name             optString
+	projectid        optString
+	size             optInt64
+	snapshotid       optString
+	virtualmachineid optString
+	zoneid           optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["displayvolume"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvolume", vv)
+	if p.displayvolume.ok {
+		u.Set("displayvolume", strconv.FormatBool(p.displayvolume.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
+	if p.maxiops.ok {
+		u.Set("maxiops", strconv.FormatInt(p.maxiops.v, 10))
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
+	if p.miniops.ok {
+		u.Set("miniops", strconv.FormatInt(p.miniops.v, 10))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
+	if p.size.ok {
+		u.Set("size", strconv.FormatInt(p.size.v, 10))
 	}
-	if v, found := p.p["snapshotid"]; found {
-		u.Set("snapshotid", v.(string))
+	if p.snapshotid.ok {
+		u.Set("snapshotid", p.snapshotid.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *CreateVolumeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new CreateVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateVolumeParams(u url.Values) (*CreateVolumeParams, error) {
+	p := &CreateVolumeParams{}
+	if err := checkParamNames("createVolume", u, "account", "customid", "diskofferingid", "displayvolume", "domainid", "maxiops", "miniops", "name", "projectid", "size", "snapshotid", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["displayvolume"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvolume"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvolume: %v", err)
+		}
+		p.SetDisplayvolume(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["maxiops"]; found {
+		v, err := strconv.ParseInt(u.Get("maxiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxiops: %v", err)
+		}
+		p.SetMaxiops(v)
+	}
+	if _, found := u["miniops"]; found {
+		v, err := strconv.ParseInt(u.Get("miniops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param miniops: %v", err)
+		}
+		p.SetMiniops(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["size"]; found {
+		v, err := strconv.ParseInt(u.Get("size"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param size: %v", err)
+		}
+		p.SetSize(v)
+	}
+	if _, found := u["snapshotid"]; found {
+		p.SetSnapshotid(u.Get("snapshotid"))
 	}
-	p.p["account"] = v
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *CreateVolumeParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *CreateVolumeParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *CreateVolumeParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *CreateVolumeParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *CreateVolumeParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *CreateVolumeParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDiskofferingid sets the diskofferingid param.
 func (p *CreateVolumeParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *CreateVolumeParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *CreateVolumeParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetDisplayvolume sets the displayvolume param.
 func (p *CreateVolumeParams) SetDisplayvolume(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvolume"] = v
+	p.displayvolume = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvolume unsets the displayvolume param
 func (p *CreateVolumeParams) ResetDisplayvolume() {
-	if p.p != nil && p.p["displayvolume"] != nil {
-		delete(p.p, "displayvolume")
-	}
+	p.displayvolume = optBool{}
 }
 
+// GetDisplayvolume returns the displayvolume param and if it is set
 func (p *CreateVolumeParams) GetDisplayvolume() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvolume"].(bool)
-	return value, ok
+	return p.displayvolume.v, p.displayvolume.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateVolumeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateVolumeParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateVolumeParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetMaxiops sets the maxiops param.
 func (p *CreateVolumeParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
+	p.maxiops = optInt64{v: v, ok: true}
 }
 
+// ResetMaxiops unsets the maxiops param
 func (p *CreateVolumeParams) ResetMaxiops() {
-	if p.p != nil && p.p["maxiops"] != nil {
-		delete(p.p, "maxiops")
-	}
+	p.maxiops = optInt64{}
 }
 
+// GetMaxiops returns the maxiops param and if it is set
 func (p *CreateVolumeParams) GetMaxiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxiops"].(int64)
-	return value, ok
+	return p.maxiops.v, p.maxiops.ok
 }
 
+// SetMiniops sets the miniops param.
 func (p *CreateVolumeParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
+	p.miniops = optInt64{v: v, ok: true}
 }
 
+// ResetMiniops unsets the miniops param
 func (p *CreateVolumeParams) ResetMiniops() {
-	if p.p != nil && p.p["miniops"] != nil {
-		delete(p.p, "miniops")
-	}
+	p.miniops = optInt64{}
 }
 
+// GetMiniops returns the miniops param and if it is set
 func (p *CreateVolumeParams) GetMiniops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["miniops"].(int64)
-	return value, ok
+	return p.miniops.v, p.miniops.ok
 }
 
+// SetName sets the name param.
 func (p *CreateVolumeParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateVolumeParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateVolumeParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *CreateVolumeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *CreateVolumeParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *CreateVolumeParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetSize sets the size param.
 func (p *CreateVolumeParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["size"] = v
+	p.size = optInt64{v: v, ok: true}
 }
 
+// ResetSize unsets the size param
 func (p *CreateVolumeParams) ResetSize() {
-	if p.p != nil && p.p["size"] != nil {
-		delete(p.p, "size")
-	}
+	p.size = optInt64{}
 }
 
+// GetSize returns the size param and if it is set
 func (p *CreateVolumeParams) GetSize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["size"].(int64)
-	return value, ok
+	return p.size.v, p.size.ok
 }
 
+// SetSnapshotid sets the snapshotid param.
 func (p *CreateVolumeParams) SetSnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["snapshotid"] = v
+	p.snapshotid = optString{v: v, ok: true}
 }
 
+// ResetSnapshotid unsets the snapshotid param
 func (p *CreateVolumeParams) ResetSnapshotid() {
-	if p.p != nil && p.p["snapshotid"] != nil {
-		delete(p.p, "snapshotid")
-	}
+	p.snapshotid = optString{}
 }
 
+// GetSnapshotid returns the snapshotid param and if it is set
 func (p *CreateVolumeParams) GetSnapshotid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["snapshotid"].(string)
-	return value, ok
+	return p.snapshotid.v, p.snapshotid.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *CreateVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *CreateVolumeParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *CreateVolumeParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *CreateVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *CreateVolumeParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *CreateVolumeParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateVolumeParams) Clone() *CreateVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateVolumeParams) Equal(o *CreateVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.customid == o.customid &&
+		p.diskofferingid == o.diskofferingid &&
+		p.displayvolume == o.displayvolume &&
+		p.domainid == o.domainid &&
+		p.maxiops == o.maxiops &&
+		p.miniops == o.miniops &&
+		p.name == o.name &&
+		p.projectid == o.projectid &&
+		p.size == o.size &&
+		p.snapshotid == o.snapshotid &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedCreateVolumeParams is used to (un)marshal CreateVolumeParams using the API param names
+type serializedCreateVolumeParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Customid         *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Diskofferingid   *string `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Displayvolume    *bool   `json:"displayvolume,omitempty" yaml:"displayvolume,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Maxiops          *int64  `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops          *int64  `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Size             *int64  `json:"size,omitempty" yaml:"size,omitempty"`
+	Snapshotid       *string `json:"snapshotid,omitempty" yaml:"snapshotid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *CreateVolumeParams) toSerialized() *serializedCreateVolumeParams {
+	s := &serializedCreateVolumeParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.displayvolume.ok {
+		s.Displayvolume = &p.displayvolume.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.maxiops.ok {
+		s.Maxiops = &p.maxiops.v
+	}
+	if p.miniops.ok {
+		s.Miniops = &p.miniops.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.size.ok {
+		s.Size = &p.size.v
+	}
+	if p.snapshotid.ok {
+		s.Snapshotid = &p.snapshotid.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *CreateVolumeParams) fromSerialized(s *serializedCreateVolumeParams) {
+	*p = CreateVolumeParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Displayvolume != nil {
+		p.SetDisplayvolume(*s.Displayvolume)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Maxiops != nil {
+		p.SetMaxiops(*s.Maxiops)
+	}
+	if s.Miniops != nil {
+		p.SetMiniops(*s.Miniops)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Size != nil {
+		p.SetSize(*s.Size)
+	}
+	if s.Snapshotid != nil {
+		p.SetSnapshotid(*s.Snapshotid)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewCreateVolumeParams() *CreateVolumeParams {
 	p := &CreateVolumeParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it.
-func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("createVolume", p.toURLValues())
+// Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual
+// machine to make use of it.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *VolumeService) CreateVolume(p *CreateVolumeParams, opts ...CallOption) (*CreateVolumeResponse, error) {
+	resp, err := s.cs.newRequest("createVolume", s.cs.encodeParams("createVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -622,8 +882,8 @@ func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -716,53 +976,132 @@ type CreateVolumeResponse struct {
 }
 
 type DeleteVolumeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteVolumeParams(u url.Values) (*DeleteVolumeParams, error) {
+	p := &DeleteVolumeParams{}
+	if err := checkParamNames("deleteVolume", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *DeleteVolumeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *DeleteVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteVolumeParams) Clone() *DeleteVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteVolumeParams) Equal(o *DeleteVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteVolumeParams is used to (un)marshal DeleteVolumeParams using the API param names
+type serializedDeleteVolumeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteVolumeParams) toSerialized() *serializedDeleteVolumeParams {
+	s := &serializedDeleteVolumeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteVolumeParams) fromSerialized(s *serializedDeleteVolumeParams) {
+	*p = DeleteVolumeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
 	p := &DeleteVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a detached disk volume.
-func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVolume", p.toURLValues())
+//
+// Required params: id.
+func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams, opts ...CallOption) (*DeleteVolumeResponse, error) {
+	resp, err := s.cs.newRequest("deleteVolume", s.cs.encodeParams("deleteVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -810,78 +1149,167 @@ func (r *DeleteVolumeResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DestroyVolumeParams struct {
-	p map[string]interface{}
+	expunge optBool
+	id      optString
 }
 
-func (p *DestroyVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DestroyVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["expunge"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("expunge", vv)
+	if p.expunge.ok {
+		u.Set("expunge", strconv.FormatBool(p.expunge.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DestroyVolumeParams) SetExpunge(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDestroyVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new DestroyVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDestroyVolumeParams(u url.Values) (*DestroyVolumeParams, error) {
+	p := &DestroyVolumeParams{}
+	if err := checkParamNames("destroyVolume", u, "expunge", "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["expunge"]; found {
+		v, err := strconv.ParseBool(u.Get("expunge"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param expunge: %v", err)
+		}
+		p.SetExpunge(v)
 	}
-	p.p["expunge"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	return p, nil
+}
+
+// SetExpunge sets the expunge param.
+func (p *DestroyVolumeParams) SetExpunge(v bool) {
+	p.expunge = optBool{v: v, ok: true}
 }
 
+// ResetExpunge unsets the expunge param
 func (p *DestroyVolumeParams) ResetExpunge() {
-	if p.p != nil && p.p["expunge"] != nil {
-		delete(p.p, "expunge")
-	}
+	p.expunge = optBool{}
 }
 
+// GetExpunge returns the expunge param and if it is set
 func (p *DestroyVolumeParams) GetExpunge() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["expunge"].(bool)
-	return value, ok
+	return p.expunge.v, p.expunge.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *DestroyVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DestroyVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DestroyVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DestroyVolumeParams) Clone() *DestroyVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DestroyVolumeParams) Equal(o *DestroyVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.expunge == o.expunge &&
+		p.id == o.id
+}
+
+// serializedDestroyVolumeParams is used to (un)marshal DestroyVolumeParams using the API param names
+type serializedDestroyVolumeParams struct {
+	Expunge *bool   `json:"expunge,omitempty" yaml:"expunge,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DestroyVolumeParams) toSerialized() *serializedDestroyVolumeParams {
+	s := &serializedDestroyVolumeParams{}
+	if p.expunge.ok {
+		s.Expunge = &p.expunge.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DestroyVolumeParams) fromSerialized(s *serializedDestroyVolumeParams) {
+	*p = DestroyVolumeParams{}
+	if s.Expunge != nil {
+		p.SetExpunge(*s.Expunge)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DestroyVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DestroyVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDestroyVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DestroyVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DestroyVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDestroyVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DestroyVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewDestroyVolumeParams(id string) *DestroyVolumeParams {
 	p := &DestroyVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Destroys a Volume.
-func (s *VolumeService) DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
-	resp, err := s.cs.newRequest("destroyVolume", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VolumeService) DestroyVolume(p *DestroyVolumeParams, opts ...CallOption) (*DestroyVolumeResponse, error) {
+	resp, err := s.cs.newRequest("destroyVolume", s.cs.encodeParams("destroyVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -892,8 +1320,8 @@ func (s *VolumeService) DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeRes
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -986,101 +1414,196 @@ type DestroyVolumeResponse struct {
 }
 
 type DetachVolumeParams struct {
-	p map[string]interface{}
+	deviceid         optInt64
+	id               optString
+	virtualmachineid optString
 }
 
-func (p *DetachVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DetachVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["deviceid"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("deviceid", vv)
+	if p.deviceid.ok {
+		u.Set("deviceid", strconv.FormatInt(p.deviceid.v, 10))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
 	return u
 }
 
-func (p *DetachVolumeParams) SetDeviceid(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDetachVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new DetachVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDetachVolumeParams(u url.Values) (*DetachVolumeParams, error) {
+	p := &DetachVolumeParams{}
+	if err := checkParamNames("detachVolume", u, "deviceid", "id", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["deviceid"]; found {
+		v, err := strconv.ParseInt(u.Get("deviceid"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param deviceid: %v", err)
+		}
+		p.SetDeviceid(v)
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
 	}
-	p.p["deviceid"] = v
+	return p, nil
 }
 
+// SetDeviceid sets the deviceid param.
+func (p *DetachVolumeParams) SetDeviceid(v int64) {
+	p.deviceid = optInt64{v: v, ok: true}
+}
+
+// ResetDeviceid unsets the deviceid param
 func (p *DetachVolumeParams) ResetDeviceid() {
-	if p.p != nil && p.p["deviceid"] != nil {
-		delete(p.p, "deviceid")
-	}
+	p.deviceid = optInt64{}
 }
 
+// GetDeviceid returns the deviceid param and if it is set
 func (p *DetachVolumeParams) GetDeviceid() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["deviceid"].(int64)
-	return value, ok
+	return p.deviceid.v, p.deviceid.ok
 }
 
+// SetId sets the id param.
 func (p *DetachVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DetachVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DetachVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *DetachVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *DetachVolumeParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *DetachVolumeParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.virtualmachineid.v, p.virtualmachineid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DetachVolumeParams) Clone() *DetachVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DetachVolumeParams) Equal(o *DetachVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.deviceid == o.deviceid &&
+		p.id == o.id &&
+		p.virtualmachineid == o.virtualmachineid
+}
+
+// serializedDetachVolumeParams is used to (un)marshal DetachVolumeParams using the API param names
+type serializedDetachVolumeParams struct {
+	Deviceid         *int64  `json:"deviceid,omitempty" yaml:"deviceid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+func (p *DetachVolumeParams) toSerialized() *serializedDetachVolumeParams {
+	s := &serializedDetachVolumeParams{}
+	if p.deviceid.ok {
+		s.Deviceid = &p.deviceid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	return s
+}
+
+func (p *DetachVolumeParams) fromSerialized(s *serializedDetachVolumeParams) {
+	*p = DetachVolumeParams{}
+	if s.Deviceid != nil {
+		p.SetDeviceid(*s.Deviceid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
 	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DetachVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DetachVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedDetachVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DetachVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DetachVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDetachVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DetachVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewDetachVolumeParams() *DetachVolumeParams {
 	p := &DetachVolumeParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Detaches a disk volume from a virtual machine.
-func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("detachVolume", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *VolumeService) DetachVolume(p *DetachVolumeParams, opts ...CallOption) (*DetachVolumeResponse, error) {
+	resp, err := s.cs.newRequest("detachVolume", s.cs.encodeParams("detachVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1091,8 +1614,8 @@ func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1185,127 +1708,225 @@ type DetachVolumeResponse struct {
 }
 
 type ExtractVolumeParams struct {
-	p map[string]interface{}
+	id     optString
+	mode   optString
+	url    optString
+	zoneid optString
 }
 
-func (p *ExtractVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ExtractVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["mode"]; found {
-		u.Set("mode", v.(string))
+	if p.mode.ok {
+		u.Set("mode", p.mode.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ExtractVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseExtractVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new ExtractVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseExtractVolumeParams(u url.Values) (*ExtractVolumeParams, error) {
+	p := &ExtractVolumeParams{}
+	if err := checkParamNames("extractVolume", u, "id", "mode", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["mode"]; found {
+		p.SetMode(u.Get("mode"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["id"] = v
+	return p, nil
 }
 
+// SetId sets the id param. This param is required.
+func (p *ExtractVolumeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
+}
+
+// ResetId unsets the id param
 func (p *ExtractVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ExtractVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMode sets the mode param. This param is required.
 func (p *ExtractVolumeParams) SetMode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["mode"] = v
+	p.mode = optString{v: v, ok: true}
 }
 
+// ResetMode unsets the mode param
 func (p *ExtractVolumeParams) ResetMode() {
-	if p.p != nil && p.p["mode"] != nil {
-		delete(p.p, "mode")
-	}
+	p.mode = optString{}
 }
 
+// GetMode returns the mode param and if it is set
 func (p *ExtractVolumeParams) GetMode() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["mode"].(string)
-	return value, ok
+	return p.mode.v, p.mode.ok
 }
 
+// SetUrl sets the url param.
 func (p *ExtractVolumeParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *ExtractVolumeParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *ExtractVolumeParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *ExtractVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ExtractVolumeParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ExtractVolumeParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ExtractVolumeParams) Clone() *ExtractVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ExtractVolumeParams) Equal(o *ExtractVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id &&
+		p.mode == o.mode &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedExtractVolumeParams is used to (un)marshal ExtractVolumeParams using the API param names
+type serializedExtractVolumeParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Mode   *string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ExtractVolumeParams) toSerialized() *serializedExtractVolumeParams {
+	s := &serializedExtractVolumeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.mode.ok {
+		s.Mode = &p.mode.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ExtractVolumeParams) fromSerialized(s *serializedExtractVolumeParams) {
+	*p = ExtractVolumeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Mode != nil {
+		p.SetMode(*s.Mode)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ExtractVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ExtractVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedExtractVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ExtractVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ExtractVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedExtractVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ExtractVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams {
 	p := &ExtractVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["mode"] = mode
-	p.p["zoneid"] = zoneid
+	p.SetId(id)
+	p.SetMode(mode)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Extracts volume
-func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
-	resp, err := s.cs.newRequest("extractVolume", p.toURLValues())
+// Extracts volume.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id, mode, zoneid.
+func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams, opts ...CallOption) (*ExtractVolumeResponse, error) {
+	resp, err := s.cs.newRequest("extractVolume", s.cs.encodeParams("extractVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1316,8 +1937,8 @@ func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeRes
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1358,53 +1979,132 @@ type ExtractVolumeResponse struct {
 }
 
 type GetPathForVolumeParams struct {
-	p map[string]interface{}
+	volumeid optString
 }
 
-func (p *GetPathForVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetPathForVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
 	return u
 }
 
-func (p *GetPathForVolumeParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetPathForVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new GetPathForVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetPathForVolumeParams(u url.Values) (*GetPathForVolumeParams, error) {
+	p := &GetPathForVolumeParams{}
+	if err := checkParamNames("getPathForVolume", u, "volumeid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
 	}
-	p.p["volumeid"] = v
+	return p, nil
 }
 
+// SetVolumeid sets the volumeid param. This param is required.
+func (p *GetPathForVolumeParams) SetVolumeid(v string) {
+	p.volumeid = optString{v: v, ok: true}
+}
+
+// ResetVolumeid unsets the volumeid param
 func (p *GetPathForVolumeParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *GetPathForVolumeParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.volumeid.v, p.volumeid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetPathForVolumeParams) Clone() *GetPathForVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetPathForVolumeParams) Equal(o *GetPathForVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.volumeid == o.volumeid
+}
+
+// serializedGetPathForVolumeParams is used to (un)marshal GetPathForVolumeParams using the API param names
+type serializedGetPathForVolumeParams struct {
+	Volumeid *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+func (p *GetPathForVolumeParams) toSerialized() *serializedGetPathForVolumeParams {
+	s := &serializedGetPathForVolumeParams{}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
 	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *GetPathForVolumeParams) fromSerialized(s *serializedGetPathForVolumeParams) {
+	*p = GetPathForVolumeParams{}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetPathForVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetPathForVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetPathForVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetPathForVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetPathForVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetPathForVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetPathForVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams {
 	p := &GetPathForVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
+	p.SetVolumeid(volumeid)
 	return p
 }
 
-// Get the path associated with the provided volume UUID
-func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getPathForVolume", p.toURLValues())
+// Get the path associated with the provided volume UUID.
+//
+// Required params: volumeid.
+func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams, opts ...CallOption) (*GetPathForVolumeResponse, error) {
+	resp, err := s.cs.newRequest("getPathForVolume", s.cs.encodeParams("getPathForVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1427,53 +2127,132 @@ type GetPathForVolumeResponse struct {
 }
 
 type GetSolidFireVolumeSizeParams struct {
-	p map[string]interface{}
+	volumeid optString
 }
 
-func (p *GetSolidFireVolumeSizeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetSolidFireVolumeSizeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
 	return u
 }
 
-func (p *GetSolidFireVolumeSizeParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetSolidFireVolumeSizeParams parses url.Values, for example taken from a raw API request,
+// into a new GetSolidFireVolumeSizeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetSolidFireVolumeSizeParams(u url.Values) (*GetSolidFireVolumeSizeParams, error) {
+	p := &GetSolidFireVolumeSizeParams{}
+	if err := checkParamNames("getSolidFireVolumeSize", u, "volumeid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
 	}
-	p.p["volumeid"] = v
+	return p, nil
 }
 
+// SetVolumeid sets the volumeid param. This param is required.
+func (p *GetSolidFireVolumeSizeParams) SetVolumeid(v string) {
+	p.volumeid = optString{v: v, ok: true}
+}
+
+// ResetVolumeid unsets the volumeid param
 func (p *GetSolidFireVolumeSizeParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *GetSolidFireVolumeSizeParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.volumeid.v, p.volumeid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetSolidFireVolumeSizeParams) Clone() *GetSolidFireVolumeSizeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetSolidFireVolumeSizeParams) Equal(o *GetSolidFireVolumeSizeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.volumeid == o.volumeid
+}
+
+// serializedGetSolidFireVolumeSizeParams is used to (un)marshal GetSolidFireVolumeSizeParams using the API param names
+type serializedGetSolidFireVolumeSizeParams struct {
+	Volumeid *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+func (p *GetSolidFireVolumeSizeParams) toSerialized() *serializedGetSolidFireVolumeSizeParams {
+	s := &serializedGetSolidFireVolumeSizeParams{}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
 	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *GetSolidFireVolumeSizeParams) fromSerialized(s *serializedGetSolidFireVolumeSizeParams) {
+	*p = GetSolidFireVolumeSizeParams{}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetSolidFireVolumeSizeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetSolidFireVolumeSizeParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetSolidFireVolumeSizeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetSolidFireVolumeSizeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetSolidFireVolumeSizeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetSolidFireVolumeSizeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetSolidFireVolumeSizeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams {
 	p := &GetSolidFireVolumeSizeParams{}
-	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
+	p.SetVolumeid(volumeid)
 	return p
 }
 
-// Get the SF volume size including Hypervisor Snapshot Reserve
-func (s *VolumeService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireVolumeSize", p.toURLValues())
+// Get the SF volume size including Hypervisor Snapshot Reserve.
+//
+// Required params: volumeid.
+func (s *VolumeService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams, opts ...CallOption) (*GetSolidFireVolumeSizeResponse, error) {
+	resp, err := s.cs.newRequest("getSolidFireVolumeSize", s.cs.encodeParams("getSolidFireVolumeSize", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1493,247 +2272,374 @@ type GetSolidFireVolumeSizeResponse struct {
 }
 
 type GetUploadParamsForVolumeParams struct {
-	p map[string]interface{}
-}
-
-func (p *GetUploadParamsForVolumeParams) toURLValues() url.Values {
+	account        optString
+	checksum       optString
+	diskofferingid optString
+	domainid       optString
+	format         optString
+	imagestoreuuid optString
+	name           optString
+	projectid      optString
+	zoneid         optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetUploadParamsForVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	if p.checksum.ok {
+		u.Set("checksum", p.checksum.v)
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if p.format.ok {
+		u.Set("format", p.format.v)
 	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
+	if p.imagestoreuuid.ok {
+		u.Set("imagestoreuuid", p.imagestoreuuid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *GetUploadParamsForVolumeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetUploadParamsForVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new GetUploadParamsForVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetUploadParamsForVolumeParams(u url.Values) (*GetUploadParamsForVolumeParams, error) {
+	p := &GetUploadParamsForVolumeParams{}
+	if err := checkParamNames("getUploadParamsForVolume", u, "account", "checksum", "diskofferingid", "domainid", "format", "imagestoreuuid", "name", "projectid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["checksum"]; found {
+		p.SetChecksum(u.Get("checksum"))
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["format"]; found {
+		p.SetFormat(u.Get("format"))
 	}
-	p.p["account"] = v
+	if _, found := u["imagestoreuuid"]; found {
+		p.SetImagestoreuuid(u.Get("imagestoreuuid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *GetUploadParamsForVolumeParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *GetUploadParamsForVolumeParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetChecksum sets the checksum param.
 func (p *GetUploadParamsForVolumeParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["checksum"] = v
+	p.checksum = optString{v: v, ok: true}
 }
 
+// ResetChecksum unsets the checksum param
 func (p *GetUploadParamsForVolumeParams) ResetChecksum() {
-	if p.p != nil && p.p["checksum"] != nil {
-		delete(p.p, "checksum")
-	}
+	p.checksum = optString{}
 }
 
+// GetChecksum returns the checksum param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetChecksum() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["checksum"].(string)
-	return value, ok
+	return p.checksum.v, p.checksum.ok
 }
 
+// SetDiskofferingid sets the diskofferingid param.
 func (p *GetUploadParamsForVolumeParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *GetUploadParamsForVolumeParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *GetUploadParamsForVolumeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *GetUploadParamsForVolumeParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFormat sets the format param. This param is required.
 func (p *GetUploadParamsForVolumeParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
+	p.format = optString{v: v, ok: true}
 }
 
+// ResetFormat unsets the format param
 func (p *GetUploadParamsForVolumeParams) ResetFormat() {
-	if p.p != nil && p.p["format"] != nil {
-		delete(p.p, "format")
-	}
+	p.format = optString{}
 }
 
+// GetFormat returns the format param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetFormat() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["format"].(string)
-	return value, ok
+	return p.format.v, p.format.ok
 }
 
+// SetImagestoreuuid sets the imagestoreuuid param.
 func (p *GetUploadParamsForVolumeParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreuuid"] = v
+	p.imagestoreuuid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreuuid unsets the imagestoreuuid param
 func (p *GetUploadParamsForVolumeParams) ResetImagestoreuuid() {
-	if p.p != nil && p.p["imagestoreuuid"] != nil {
-		delete(p.p, "imagestoreuuid")
-	}
+	p.imagestoreuuid = optString{}
 }
 
+// GetImagestoreuuid returns the imagestoreuuid param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetImagestoreuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreuuid"].(string)
-	return value, ok
+	return p.imagestoreuuid.v, p.imagestoreuuid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *GetUploadParamsForVolumeParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *GetUploadParamsForVolumeParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *GetUploadParamsForVolumeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *GetUploadParamsForVolumeParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *GetUploadParamsForVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *GetUploadParamsForVolumeParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *GetUploadParamsForVolumeParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetUploadParamsForVolumeParams) Clone() *GetUploadParamsForVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetUploadParamsForVolumeParams) Equal(o *GetUploadParamsForVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.checksum == o.checksum &&
+		p.diskofferingid == o.diskofferingid &&
+		p.domainid == o.domainid &&
+		p.format == o.format &&
+		p.imagestoreuuid == o.imagestoreuuid &&
+		p.name == o.name &&
+		p.projectid == o.projectid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedGetUploadParamsForVolumeParams is used to (un)marshal GetUploadParamsForVolumeParams using the API param names
+type serializedGetUploadParamsForVolumeParams struct {
+	Account        *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Checksum       *string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Diskofferingid *string `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Domainid       *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Format         *string `json:"format,omitempty" yaml:"format,omitempty"`
+	Imagestoreuuid *string `json:"imagestoreuuid,omitempty" yaml:"imagestoreuuid,omitempty"`
+	Name           *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid      *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Zoneid         *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *GetUploadParamsForVolumeParams) toSerialized() *serializedGetUploadParamsForVolumeParams {
+	s := &serializedGetUploadParamsForVolumeParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.checksum.ok {
+		s.Checksum = &p.checksum.v
+	}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.format.ok {
+		s.Format = &p.format.v
+	}
+	if p.imagestoreuuid.ok {
+		s.Imagestoreuuid = &p.imagestoreuuid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *GetUploadParamsForVolumeParams) fromSerialized(s *serializedGetUploadParamsForVolumeParams) {
+	*p = GetUploadParamsForVolumeParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Checksum != nil {
+		p.SetChecksum(*s.Checksum)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Format != nil {
+		p.SetFormat(*s.Format)
+	}
+	if s.Imagestoreuuid != nil {
+		p.SetImagestoreuuid(*s.Imagestoreuuid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetUploadParamsForVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetUploadParamsForVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetUploadParamsForVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetUploadParamsForVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetUploadParamsForVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetUploadParamsForVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetUploadParamsForVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams {
 	p := &GetUploadParamsForVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["format"] = format
-	p.p["name"] = name
-	p.p["zoneid"] = zoneid
+	p.SetFormat(format)
+	p.SetName(name)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Upload a data disk to the cloudstack cloud.
-func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForVolume", p.toURLValues())
+//
+// Required params: format, name, zoneid.
+func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams, opts ...CallOption) (*GetUploadParamsForVolumeResponse, error) {
+	resp, err := s.cs.newRequest("getUploadParamsForVolume", s.cs.encodeParams("getUploadParamsForVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1760,53 +2666,132 @@ type GetUploadParamsForVolumeResponse struct {
 }
 
 type GetVolumeiScsiNameParams struct {
-	p map[string]interface{}
+	volumeid optString
 }
 
-func (p *GetVolumeiScsiNameParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *GetVolumeiScsiNameParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
 	return u
 }
 
-func (p *GetVolumeiScsiNameParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseGetVolumeiScsiNameParams parses url.Values, for example taken from a raw API request,
+// into a new GetVolumeiScsiNameParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseGetVolumeiScsiNameParams(u url.Values) (*GetVolumeiScsiNameParams, error) {
+	p := &GetVolumeiScsiNameParams{}
+	if err := checkParamNames("getVolumeiScsiName", u, "volumeid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
 	}
-	p.p["volumeid"] = v
+	return p, nil
+}
+
+// SetVolumeid sets the volumeid param. This param is required.
+func (p *GetVolumeiScsiNameParams) SetVolumeid(v string) {
+	p.volumeid = optString{v: v, ok: true}
 }
 
+// ResetVolumeid unsets the volumeid param
 func (p *GetVolumeiScsiNameParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *GetVolumeiScsiNameParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.volumeid.v, p.volumeid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *GetVolumeiScsiNameParams) Clone() *GetVolumeiScsiNameParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *GetVolumeiScsiNameParams) Equal(o *GetVolumeiScsiNameParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.volumeid == o.volumeid
+}
+
+// serializedGetVolumeiScsiNameParams is used to (un)marshal GetVolumeiScsiNameParams using the API param names
+type serializedGetVolumeiScsiNameParams struct {
+	Volumeid *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+func (p *GetVolumeiScsiNameParams) toSerialized() *serializedGetVolumeiScsiNameParams {
+	s := &serializedGetVolumeiScsiNameParams{}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	return s
+}
+
+func (p *GetVolumeiScsiNameParams) fromSerialized(s *serializedGetVolumeiScsiNameParams) {
+	*p = GetVolumeiScsiNameParams{}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
 	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p GetVolumeiScsiNameParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *GetVolumeiScsiNameParams) UnmarshalJSON(b []byte) error {
+	var s serializedGetVolumeiScsiNameParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p GetVolumeiScsiNameParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *GetVolumeiScsiNameParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedGetVolumeiScsiNameParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new GetVolumeiScsiNameParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams {
 	p := &GetVolumeiScsiNameParams{}
-	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
+	p.SetVolumeid(volumeid)
 	return p
 }
 
-// Get Volume's iSCSI Name
-func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeiScsiName", p.toURLValues())
+// Get Volume's iSCSI Name.
+//
+// Required params: volumeid.
+func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams, opts ...CallOption) (*GetVolumeiScsiNameResponse, error) {
+	resp, err := s.cs.newRequest("getVolumeiScsiName", s.cs.encodeParams("getVolumeiScsiName", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1826,619 +2811,857 @@ type GetVolumeiScsiNameResponse struct {
 }
 
 type ListVolumesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVolumesParams) toURLValues() url.Values {
+	account                   optString
+	clusterid                 optString
+	diskofferingid            optString
+	displayvolume             optBool
+	domainid                  optString
+	hostid                    optString
+	id                        optString
+	ids                       optStrings
+	isrecursive               optBool
+	keyword                   optString
+	listall                   optBool
+	listsystemvms             optBool
+	name                      optString
+	page                      optInt
+	pagesize                  optInt
+	podid                     optString
+	projectid                 optString
+	retrieveonlyresourcecount optBool
+	state                     optString
+	storageid                 optString
+	tags                      optStringMap
+	type_                     optString
+	virtualmachineid          optString
+	zoneid                    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVolumesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["displayvolume"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvolume", vv)
+	if p.displayvolume.ok {
+		u.Set("displayvolume", strconv.FormatBool(p.displayvolume.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["listsystemvms"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listsystemvms", vv)
+	if p.listsystemvms.ok {
+		u.Set("listsystemvms", strconv.FormatBool(p.listsystemvms.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["retrieveonlyresourcecount"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("retrieveonlyresourcecount", vv)
+	if p.retrieveonlyresourcecount.ok {
+		u.Set("retrieveonlyresourcecount", strconv.FormatBool(p.retrieveonlyresourcecount.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListVolumesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVolumesParams parses url.Values, for example taken from a raw API request,
+// into a new ListVolumesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVolumesParams(u url.Values) (*ListVolumesParams, error) {
+	p := &ListVolumesParams{}
+	if err := checkParamNames("listVolumes", u, "account", "clusterid", "diskofferingid", "displayvolume", "domainid", "hostid", "id", "ids", "isrecursive", "keyword", "listall", "listsystemvms", "name", "page", "pagesize", "podid", "projectid", "retrieveonlyresourcecount", "state", "storageid", "tags[]", "type", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["displayvolume"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvolume"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvolume: %v", err)
+		}
+		p.SetDisplayvolume(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["listsystemvms"]; found {
+		v, err := strconv.ParseBool(u.Get("listsystemvms"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listsystemvms: %v", err)
+		}
+		p.SetListsystemvms(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
 	}
-	p.p["account"] = v
+	if _, found := u["retrieveonlyresourcecount"]; found {
+		v, err := strconv.ParseBool(u.Get("retrieveonlyresourcecount"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param retrieveonlyresourcecount: %v", err)
+		}
+		p.SetRetrieveonlyresourcecount(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVolumesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListVolumesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVolumesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ListVolumesParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListVolumesParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListVolumesParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDiskofferingid sets the diskofferingid param.
 func (p *ListVolumesParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *ListVolumesParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *ListVolumesParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetDisplayvolume sets the displayvolume param.
 func (p *ListVolumesParams) SetDisplayvolume(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvolume"] = v
+	p.displayvolume = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvolume unsets the displayvolume param
 func (p *ListVolumesParams) ResetDisplayvolume() {
-	if p.p != nil && p.p["displayvolume"] != nil {
-		delete(p.p, "displayvolume")
-	}
+	p.displayvolume = optBool{}
 }
 
+// GetDisplayvolume returns the displayvolume param and if it is set
 func (p *ListVolumesParams) GetDisplayvolume() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvolume"].(bool)
-	return value, ok
+	return p.displayvolume.v, p.displayvolume.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVolumesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVolumesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVolumesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListVolumesParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListVolumesParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListVolumesParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param.
 func (p *ListVolumesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVolumesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVolumesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListVolumesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListVolumesParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListVolumesParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVolumesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVolumesParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVolumesParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVolumesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVolumesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVolumesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVolumesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVolumesParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVolumesParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetListsystemvms sets the listsystemvms param.
 func (p *ListVolumesParams) SetListsystemvms(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listsystemvms"] = v
+	p.listsystemvms = optBool{v: v, ok: true}
 }
 
+// ResetListsystemvms unsets the listsystemvms param
 func (p *ListVolumesParams) ResetListsystemvms() {
-	if p.p != nil && p.p["listsystemvms"] != nil {
-		delete(p.p, "listsystemvms")
-	}
+	p.listsystemvms = optBool{}
 }
 
+// GetListsystemvms returns the listsystemvms param and if it is set
 func (p *ListVolumesParams) GetListsystemvms() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listsystemvms"].(bool)
-	return value, ok
+	return p.listsystemvms.v, p.listsystemvms.ok
 }
 
+// SetName sets the name param.
 func (p *ListVolumesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVolumesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVolumesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVolumesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVolumesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVolumesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVolumesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVolumesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVolumesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListVolumesParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListVolumesParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListVolumesParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVolumesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVolumesParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVolumesParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRetrieveonlyresourcecount sets the retrieveonlyresourcecount param.
 func (p *ListVolumesParams) SetRetrieveonlyresourcecount(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["retrieveonlyresourcecount"] = v
+	p.retrieveonlyresourcecount = optBool{v: v, ok: true}
 }
 
+// ResetRetrieveonlyresourcecount unsets the retrieveonlyresourcecount param
 func (p *ListVolumesParams) ResetRetrieveonlyresourcecount() {
-	if p.p != nil && p.p["retrieveonlyresourcecount"] != nil {
-		delete(p.p, "retrieveonlyresourcecount")
-	}
+	p.retrieveonlyresourcecount = optBool{}
 }
 
+// GetRetrieveonlyresourcecount returns the retrieveonlyresourcecount param and if it is set
 func (p *ListVolumesParams) GetRetrieveonlyresourcecount() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["retrieveonlyresourcecount"].(bool)
-	return value, ok
+	return p.retrieveonlyresourcecount.v, p.retrieveonlyresourcecount.ok
 }
 
+// SetState sets the state param.
 func (p *ListVolumesParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListVolumesParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListVolumesParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListVolumesParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListVolumesParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListVolumesParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListVolumesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListVolumesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListVolumesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetType sets the type param.
 func (p *ListVolumesParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListVolumesParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListVolumesParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListVolumesParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListVolumesParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListVolumesParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListVolumesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListVolumesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListVolumesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVolumesParams) Clone() *ListVolumesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVolumesParams) Equal(o *ListVolumesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.clusterid == o.clusterid &&
+		p.diskofferingid == o.diskofferingid &&
+		p.displayvolume == o.displayvolume &&
+		p.domainid == o.domainid &&
+		p.hostid == o.hostid &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.listsystemvms == o.listsystemvms &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.retrieveonlyresourcecount == o.retrieveonlyresourcecount &&
+		p.state == o.state &&
+		p.storageid == o.storageid &&
+		p.tags.equal(o.tags) &&
+		p.type_ == o.type_ &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListVolumesParams is used to (un)marshal ListVolumesParams using the API param names
+type serializedListVolumesParams struct {
+	Account                   *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid                 *string            `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Diskofferingid            *string            `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Displayvolume             *bool              `json:"displayvolume,omitempty" yaml:"displayvolume,omitempty"`
+	Domainid                  *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostid                    *string            `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id                        *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids                       *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Isrecursive               *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword                   *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                   *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Listsystemvms             *bool              `json:"listsystemvms,omitempty" yaml:"listsystemvms,omitempty"`
+	Name                      *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page                      *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                  *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                     *string            `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid                 *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Retrieveonlyresourcecount *bool              `json:"retrieveonlyresourcecount,omitempty" yaml:"retrieveonlyresourcecount,omitempty"`
+	State                     *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid                 *string            `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags                      *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Type                      *string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid          *string            `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid                    *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListVolumesParams) toSerialized() *serializedListVolumesParams {
+	s := &serializedListVolumesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.displayvolume.ok {
+		s.Displayvolume = &p.displayvolume.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.listsystemvms.ok {
+		s.Listsystemvms = &p.listsystemvms.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.retrieveonlyresourcecount.ok {
+		s.Retrieveonlyresourcecount = &p.retrieveonlyresourcecount.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListVolumesParams) fromSerialized(s *serializedListVolumesParams) {
+	*p = ListVolumesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Displayvolume != nil {
+		p.SetDisplayvolume(*s.Displayvolume)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Listsystemvms != nil {
+		p.SetListsystemvms(*s.Listsystemvms)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Retrieveonlyresourcecount != nil {
+		p.SetRetrieveonlyresourcecount(*s.Retrieveonlyresourcecount)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVolumesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVolumesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVolumesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVolumesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVolumesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVolumesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVolumesParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewListVolumesParams() *ListVolumesParams {
 	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2486,9 +3709,8 @@ func (s *VolumeService) GetVolumeByName(name string, opts ...OptionFunc) (*Volum
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error) {
 	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2517,8 +3739,8 @@ func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, i
 }
 
 // Lists all volumes.
-func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
-	resp, err := s.cs.newRequest("listVolumes", p.toURLValues())
+func (s *VolumeService) ListVolumes(p *ListVolumesParams, opts ...CallOption) (*ListVolumesResponse, error) {
+	resp, err := s.cs.newRequest("listVolumes", s.cs.encodeParams("listVolumes", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2608,619 +3830,857 @@ type Volume struct {
 }
 
 type ListVolumesMetricsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVolumesMetricsParams) toURLValues() url.Values {
+	account                   optString
+	clusterid                 optString
+	diskofferingid            optString
+	displayvolume             optBool
+	domainid                  optString
+	hostid                    optString
+	id                        optString
+	ids                       optStrings
+	isrecursive               optBool
+	keyword                   optString
+	listall                   optBool
+	listsystemvms             optBool
+	name                      optString
+	page                      optInt
+	pagesize                  optInt
+	podid                     optString
+	projectid                 optString
+	retrieveonlyresourcecount optBool
+	state                     optString
+	storageid                 optString
+	tags                      optStringMap
+	type_                     optString
+	virtualmachineid          optString
+	zoneid                    optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListVolumesMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if p.clusterid.ok {
+		u.Set("clusterid", p.clusterid.v)
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["displayvolume"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvolume", vv)
+	if p.displayvolume.ok {
+		u.Set("displayvolume", strconv.FormatBool(p.displayvolume.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if p.hostid.ok {
+		u.Set("hostid", p.hostid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if p.isrecursive.ok {
+		u.Set("isrecursive", strconv.FormatBool(p.isrecursive.v))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if p.listall.ok {
+		u.Set("listall", strconv.FormatBool(p.listall.v))
 	}
-	if v, found := p.p["listsystemvms"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listsystemvms", vv)
+	if p.listsystemvms.ok {
+		u.Set("listsystemvms", strconv.FormatBool(p.listsystemvms.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if p.podid.ok {
+		u.Set("podid", p.podid.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["retrieveonlyresourcecount"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("retrieveonlyresourcecount", vv)
+	if p.retrieveonlyresourcecount.ok {
+		u.Set("retrieveonlyresourcecount", strconv.FormatBool(p.retrieveonlyresourcecount.v))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if p.type_.ok {
+		u.Set("type", p.type_.v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if p.virtualmachineid.ok {
+		u.Set("virtualmachineid", p.virtualmachineid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListVolumesMetricsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListVolumesMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListVolumesMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListVolumesMetricsParams(u url.Values) (*ListVolumesMetricsParams, error) {
+	p := &ListVolumesMetricsParams{}
+	if err := checkParamNames("listVolumesMetrics", u, "account", "clusterid", "diskofferingid", "displayvolume", "domainid", "hostid", "id", "ids", "isrecursive", "keyword", "listall", "listsystemvms", "name", "page", "pagesize", "podid", "projectid", "retrieveonlyresourcecount", "state", "storageid", "tags[]", "type", "virtualmachineid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["clusterid"]; found {
+		p.SetClusterid(u.Get("clusterid"))
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["displayvolume"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvolume"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvolume: %v", err)
+		}
+		p.SetDisplayvolume(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["hostid"]; found {
+		p.SetHostid(u.Get("hostid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
 	}
-	p.p["account"] = v
+	if _, found := u["isrecursive"]; found {
+		v, err := strconv.ParseBool(u.Get("isrecursive"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isrecursive: %v", err)
+		}
+		p.SetIsrecursive(v)
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["listall"]; found {
+		v, err := strconv.ParseBool(u.Get("listall"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listall: %v", err)
+		}
+		p.SetListall(v)
+	}
+	if _, found := u["listsystemvms"]; found {
+		v, err := strconv.ParseBool(u.Get("listsystemvms"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param listsystemvms: %v", err)
+		}
+		p.SetListsystemvms(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["podid"]; found {
+		p.SetPodid(u.Get("podid"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["retrieveonlyresourcecount"]; found {
+		v, err := strconv.ParseBool(u.Get("retrieveonlyresourcecount"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param retrieveonlyresourcecount: %v", err)
+		}
+		p.SetRetrieveonlyresourcecount(v)
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	if _, found := u["type"]; found {
+		p.SetType(u.Get("type"))
+	}
+	if _, found := u["virtualmachineid"]; found {
+		p.SetVirtualmachineid(u.Get("virtualmachineid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListVolumesMetricsParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListVolumesMetricsParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListVolumesMetricsParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetClusterid sets the clusterid param.
 func (p *ListVolumesMetricsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+	p.clusterid = optString{v: v, ok: true}
 }
 
+// ResetClusterid unsets the clusterid param
 func (p *ListVolumesMetricsParams) ResetClusterid() {
-	if p.p != nil && p.p["clusterid"] != nil {
-		delete(p.p, "clusterid")
-	}
+	p.clusterid = optString{}
 }
 
+// GetClusterid returns the clusterid param and if it is set
 func (p *ListVolumesMetricsParams) GetClusterid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["clusterid"].(string)
-	return value, ok
+	return p.clusterid.v, p.clusterid.ok
 }
 
+// SetDiskofferingid sets the diskofferingid param.
 func (p *ListVolumesMetricsParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *ListVolumesMetricsParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *ListVolumesMetricsParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetDisplayvolume sets the displayvolume param.
 func (p *ListVolumesMetricsParams) SetDisplayvolume(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvolume"] = v
+	p.displayvolume = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvolume unsets the displayvolume param
 func (p *ListVolumesMetricsParams) ResetDisplayvolume() {
-	if p.p != nil && p.p["displayvolume"] != nil {
-		delete(p.p, "displayvolume")
-	}
+	p.displayvolume = optBool{}
 }
 
+// GetDisplayvolume returns the displayvolume param and if it is set
 func (p *ListVolumesMetricsParams) GetDisplayvolume() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvolume"].(bool)
-	return value, ok
+	return p.displayvolume.v, p.displayvolume.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListVolumesMetricsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListVolumesMetricsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListVolumesMetricsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetHostid sets the hostid param.
 func (p *ListVolumesMetricsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+	p.hostid = optString{v: v, ok: true}
 }
 
+// ResetHostid unsets the hostid param
 func (p *ListVolumesMetricsParams) ResetHostid() {
-	if p.p != nil && p.p["hostid"] != nil {
-		delete(p.p, "hostid")
-	}
+	p.hostid = optString{}
 }
 
+// GetHostid returns the hostid param and if it is set
 func (p *ListVolumesMetricsParams) GetHostid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["hostid"].(string)
-	return value, ok
+	return p.hostid.v, p.hostid.ok
 }
 
+// SetId sets the id param.
 func (p *ListVolumesMetricsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListVolumesMetricsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListVolumesMetricsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListVolumesMetricsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListVolumesMetricsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListVolumesMetricsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetIsrecursive sets the isrecursive param.
 func (p *ListVolumesMetricsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.isrecursive = optBool{v: v, ok: true}
 }
 
+// ResetIsrecursive unsets the isrecursive param
 func (p *ListVolumesMetricsParams) ResetIsrecursive() {
-	if p.p != nil && p.p["isrecursive"] != nil {
-		delete(p.p, "isrecursive")
-	}
+	p.isrecursive = optBool{}
 }
 
+// GetIsrecursive returns the isrecursive param and if it is set
 func (p *ListVolumesMetricsParams) GetIsrecursive() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isrecursive"].(bool)
-	return value, ok
+	return p.isrecursive.v, p.isrecursive.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListVolumesMetricsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListVolumesMetricsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListVolumesMetricsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetListall sets the listall param.
 func (p *ListVolumesMetricsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+	p.listall = optBool{v: v, ok: true}
 }
 
+// ResetListall unsets the listall param
 func (p *ListVolumesMetricsParams) ResetListall() {
-	if p.p != nil && p.p["listall"] != nil {
-		delete(p.p, "listall")
-	}
+	p.listall = optBool{}
 }
 
+// GetListall returns the listall param and if it is set
 func (p *ListVolumesMetricsParams) GetListall() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listall"].(bool)
-	return value, ok
+	return p.listall.v, p.listall.ok
 }
 
+// SetListsystemvms sets the listsystemvms param.
 func (p *ListVolumesMetricsParams) SetListsystemvms(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listsystemvms"] = v
+	p.listsystemvms = optBool{v: v, ok: true}
 }
 
+// ResetListsystemvms unsets the listsystemvms param
 func (p *ListVolumesMetricsParams) ResetListsystemvms() {
-	if p.p != nil && p.p["listsystemvms"] != nil {
-		delete(p.p, "listsystemvms")
-	}
+	p.listsystemvms = optBool{}
 }
 
+// GetListsystemvms returns the listsystemvms param and if it is set
 func (p *ListVolumesMetricsParams) GetListsystemvms() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["listsystemvms"].(bool)
-	return value, ok
+	return p.listsystemvms.v, p.listsystemvms.ok
 }
 
+// SetName sets the name param.
 func (p *ListVolumesMetricsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListVolumesMetricsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListVolumesMetricsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPage sets the page param.
 func (p *ListVolumesMetricsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListVolumesMetricsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListVolumesMetricsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListVolumesMetricsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListVolumesMetricsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListVolumesMetricsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetPodid sets the podid param.
 func (p *ListVolumesMetricsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+	p.podid = optString{v: v, ok: true}
 }
 
+// ResetPodid unsets the podid param
 func (p *ListVolumesMetricsParams) ResetPodid() {
-	if p.p != nil && p.p["podid"] != nil {
-		delete(p.p, "podid")
-	}
+	p.podid = optString{}
 }
 
+// GetPodid returns the podid param and if it is set
 func (p *ListVolumesMetricsParams) GetPodid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["podid"].(string)
-	return value, ok
+	return p.podid.v, p.podid.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *ListVolumesMetricsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *ListVolumesMetricsParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *ListVolumesMetricsParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetRetrieveonlyresourcecount sets the retrieveonlyresourcecount param.
 func (p *ListVolumesMetricsParams) SetRetrieveonlyresourcecount(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["retrieveonlyresourcecount"] = v
+	p.retrieveonlyresourcecount = optBool{v: v, ok: true}
 }
 
+// ResetRetrieveonlyresourcecount unsets the retrieveonlyresourcecount param
 func (p *ListVolumesMetricsParams) ResetRetrieveonlyresourcecount() {
-	if p.p != nil && p.p["retrieveonlyresourcecount"] != nil {
-		delete(p.p, "retrieveonlyresourcecount")
-	}
+	p.retrieveonlyresourcecount = optBool{}
 }
 
+// GetRetrieveonlyresourcecount returns the retrieveonlyresourcecount param and if it is set
 func (p *ListVolumesMetricsParams) GetRetrieveonlyresourcecount() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["retrieveonlyresourcecount"].(bool)
-	return value, ok
+	return p.retrieveonlyresourcecount.v, p.retrieveonlyresourcecount.ok
 }
 
+// SetState sets the state param.
 func (p *ListVolumesMetricsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *ListVolumesMetricsParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *ListVolumesMetricsParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *ListVolumesMetricsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *ListVolumesMetricsParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *ListVolumesMetricsParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListVolumesMetricsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListVolumesMetricsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListVolumesMetricsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	return p.tags.v, p.tags.ok
 }
 
+// SetType sets the type param.
 func (p *ListVolumesMetricsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+	p.type_ = optString{v: v, ok: true}
 }
 
+// ResetType unsets the type param
 func (p *ListVolumesMetricsParams) ResetType() {
-	if p.p != nil && p.p["type"] != nil {
-		delete(p.p, "type")
-	}
+	p.type_ = optString{}
 }
 
+// GetType returns the type param and if it is set
 func (p *ListVolumesMetricsParams) GetType() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["type"].(string)
-	return value, ok
+	return p.type_.v, p.type_.ok
 }
 
+// SetVirtualmachineid sets the virtualmachineid param.
 func (p *ListVolumesMetricsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+	p.virtualmachineid = optString{v: v, ok: true}
 }
 
+// ResetVirtualmachineid unsets the virtualmachineid param
 func (p *ListVolumesMetricsParams) ResetVirtualmachineid() {
-	if p.p != nil && p.p["virtualmachineid"] != nil {
-		delete(p.p, "virtualmachineid")
-	}
+	p.virtualmachineid = optString{}
 }
 
+// GetVirtualmachineid returns the virtualmachineid param and if it is set
 func (p *ListVolumesMetricsParams) GetVirtualmachineid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["virtualmachineid"].(string)
-	return value, ok
+	return p.virtualmachineid.v, p.virtualmachineid.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListVolumesMetricsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListVolumesMetricsParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListVolumesMetricsParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListVolumesMetricsParams) Clone() *ListVolumesMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListVolumesMetricsParams) Equal(o *ListVolumesMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.clusterid == o.clusterid &&
+		p.diskofferingid == o.diskofferingid &&
+		p.displayvolume == o.displayvolume &&
+		p.domainid == o.domainid &&
+		p.hostid == o.hostid &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.isrecursive == o.isrecursive &&
+		p.keyword == o.keyword &&
+		p.listall == o.listall &&
+		p.listsystemvms == o.listsystemvms &&
+		p.name == o.name &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.podid == o.podid &&
+		p.projectid == o.projectid &&
+		p.retrieveonlyresourcecount == o.retrieveonlyresourcecount &&
+		p.state == o.state &&
+		p.storageid == o.storageid &&
+		p.tags.equal(o.tags) &&
+		p.type_ == o.type_ &&
+		p.virtualmachineid == o.virtualmachineid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListVolumesMetricsParams is used to (un)marshal ListVolumesMetricsParams using the API param names
+type serializedListVolumesMetricsParams struct {
+	Account                   *string            `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid                 *string            `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Diskofferingid            *string            `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Displayvolume             *bool              `json:"displayvolume,omitempty" yaml:"displayvolume,omitempty"`
+	Domainid                  *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostid                    *string            `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id                        *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids                       *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Isrecursive               *bool              `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword                   *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                   *bool              `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Listsystemvms             *bool              `json:"listsystemvms,omitempty" yaml:"listsystemvms,omitempty"`
+	Name                      *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Page                      *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                  *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid                     *string            `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid                 *string            `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Retrieveonlyresourcecount *bool              `json:"retrieveonlyresourcecount,omitempty" yaml:"retrieveonlyresourcecount,omitempty"`
+	State                     *string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid                 *string            `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags                      *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Type                      *string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid          *string            `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid                    *string            `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListVolumesMetricsParams) toSerialized() *serializedListVolumesMetricsParams {
+	s := &serializedListVolumesMetricsParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.clusterid.ok {
+		s.Clusterid = &p.clusterid.v
+	}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.displayvolume.ok {
+		s.Displayvolume = &p.displayvolume.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.hostid.ok {
+		s.Hostid = &p.hostid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.isrecursive.ok {
+		s.Isrecursive = &p.isrecursive.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.listall.ok {
+		s.Listall = &p.listall.v
+	}
+	if p.listsystemvms.ok {
+		s.Listsystemvms = &p.listsystemvms.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.podid.ok {
+		s.Podid = &p.podid.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.retrieveonlyresourcecount.ok {
+		s.Retrieveonlyresourcecount = &p.retrieveonlyresourcecount.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	if p.type_.ok {
+		s.Type = &p.type_.v
+	}
+	if p.virtualmachineid.ok {
+		s.Virtualmachineid = &p.virtualmachineid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ListVolumesMetricsParams) fromSerialized(s *serializedListVolumesMetricsParams) {
+	*p = ListVolumesMetricsParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Clusterid != nil {
+		p.SetClusterid(*s.Clusterid)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Displayvolume != nil {
+		p.SetDisplayvolume(*s.Displayvolume)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Hostid != nil {
+		p.SetHostid(*s.Hostid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Isrecursive != nil {
+		p.SetIsrecursive(*s.Isrecursive)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Listall != nil {
+		p.SetListall(*s.Listall)
+	}
+	if s.Listsystemvms != nil {
+		p.SetListsystemvms(*s.Listsystemvms)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Podid != nil {
+		p.SetPodid(*s.Podid)
+	}
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Retrieveonlyresourcecount != nil {
+		p.SetRetrieveonlyresourcecount(*s.Retrieveonlyresourcecount)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+	if s.Type != nil {
+		p.SetType(*s.Type)
+	}
+	if s.Virtualmachineid != nil {
+		p.SetVirtualmachineid(*s.Virtualmachineid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListVolumesMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListVolumesMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListVolumesMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListVolumesMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListVolumesMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListVolumesMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListVolumesMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewListVolumesMetricsParams() *ListVolumesMetricsParams {
 	p := &ListVolumesMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVolumesMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3268,9 +4728,8 @@ func (s *VolumeService) GetVolumesMetricByName(name string, opts ...OptionFunc)
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesMetricByID(id string, opts ...OptionFunc) (*VolumesMetric, int, error) {
 	p := &ListVolumesMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -3298,9 +4757,9 @@ func (s *VolumeService) GetVolumesMetricByID(id string, opts ...OptionFunc) (*Vo
 	return nil, l.Count, fmt.Errorf("There is more then one result for VolumesMetric UUID: %s!", id)
 }
 
-// Lists volume metrics
-func (s *VolumeService) ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listVolumesMetrics", p.toURLValues())
+// Lists volume metrics.
+func (s *VolumeService) ListVolumesMetrics(p *ListVolumesMetricsParams, opts ...CallOption) (*ListVolumesMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listVolumesMetrics", s.cs.encodeParams("listVolumesMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3392,127 +4851,228 @@ type VolumesMetric struct {
 }
 
 type MigrateVolumeParams struct {
-	p map[string]interface{}
+	livemigrate       optBool
+	newdiskofferingid optString
+	storageid         optString
+	volumeid          optString
 }
 
-func (p *MigrateVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *MigrateVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["livemigrate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("livemigrate", vv)
+	if p.livemigrate.ok {
+		u.Set("livemigrate", strconv.FormatBool(p.livemigrate.v))
 	}
-	if v, found := p.p["newdiskofferingid"]; found {
-		u.Set("newdiskofferingid", v.(string))
+	if p.newdiskofferingid.ok {
+		u.Set("newdiskofferingid", p.newdiskofferingid.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if p.volumeid.ok {
+		u.Set("volumeid", p.volumeid.v)
 	}
 	return u
 }
 
-func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseMigrateVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new MigrateVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseMigrateVolumeParams(u url.Values) (*MigrateVolumeParams, error) {
+	p := &MigrateVolumeParams{}
+	if err := checkParamNames("migrateVolume", u, "livemigrate", "newdiskofferingid", "storageid", "volumeid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["livemigrate"]; found {
+		v, err := strconv.ParseBool(u.Get("livemigrate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param livemigrate: %v", err)
+		}
+		p.SetLivemigrate(v)
+	}
+	if _, found := u["newdiskofferingid"]; found {
+		p.SetNewdiskofferingid(u.Get("newdiskofferingid"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
 	}
-	p.p["livemigrate"] = v
+	if _, found := u["volumeid"]; found {
+		p.SetVolumeid(u.Get("volumeid"))
+	}
+	return p, nil
+}
+
+// SetLivemigrate sets the livemigrate param.
+func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
+	p.livemigrate = optBool{v: v, ok: true}
 }
 
+// ResetLivemigrate unsets the livemigrate param
 func (p *MigrateVolumeParams) ResetLivemigrate() {
-	if p.p != nil && p.p["livemigrate"] != nil {
-		delete(p.p, "livemigrate")
-	}
+	p.livemigrate = optBool{}
 }
 
+// GetLivemigrate returns the livemigrate param and if it is set
 func (p *MigrateVolumeParams) GetLivemigrate() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["livemigrate"].(bool)
-	return value, ok
+	return p.livemigrate.v, p.livemigrate.ok
 }
 
+// SetNewdiskofferingid sets the newdiskofferingid param.
 func (p *MigrateVolumeParams) SetNewdiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["newdiskofferingid"] = v
+	p.newdiskofferingid = optString{v: v, ok: true}
 }
 
+// ResetNewdiskofferingid unsets the newdiskofferingid param
 func (p *MigrateVolumeParams) ResetNewdiskofferingid() {
-	if p.p != nil && p.p["newdiskofferingid"] != nil {
-		delete(p.p, "newdiskofferingid")
-	}
+	p.newdiskofferingid = optString{}
 }
 
+// GetNewdiskofferingid returns the newdiskofferingid param and if it is set
 func (p *MigrateVolumeParams) GetNewdiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["newdiskofferingid"].(string)
-	return value, ok
+	return p.newdiskofferingid.v, p.newdiskofferingid.ok
 }
 
+// SetStorageid sets the storageid param. This param is required.
 func (p *MigrateVolumeParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *MigrateVolumeParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *MigrateVolumeParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	return p.storageid.v, p.storageid.ok
 }
 
+// SetVolumeid sets the volumeid param. This param is required.
 func (p *MigrateVolumeParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
+	p.volumeid = optString{v: v, ok: true}
 }
 
+// ResetVolumeid unsets the volumeid param
 func (p *MigrateVolumeParams) ResetVolumeid() {
-	if p.p != nil && p.p["volumeid"] != nil {
-		delete(p.p, "volumeid")
-	}
+	p.volumeid = optString{}
 }
 
+// GetVolumeid returns the volumeid param and if it is set
 func (p *MigrateVolumeParams) GetVolumeid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.volumeid.v, p.volumeid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *MigrateVolumeParams) Clone() *MigrateVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *MigrateVolumeParams) Equal(o *MigrateVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.livemigrate == o.livemigrate &&
+		p.newdiskofferingid == o.newdiskofferingid &&
+		p.storageid == o.storageid &&
+		p.volumeid == o.volumeid
+}
+
+// serializedMigrateVolumeParams is used to (un)marshal MigrateVolumeParams using the API param names
+type serializedMigrateVolumeParams struct {
+	Livemigrate       *bool   `json:"livemigrate,omitempty" yaml:"livemigrate,omitempty"`
+	Newdiskofferingid *string `json:"newdiskofferingid,omitempty" yaml:"newdiskofferingid,omitempty"`
+	Storageid         *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Volumeid          *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+func (p *MigrateVolumeParams) toSerialized() *serializedMigrateVolumeParams {
+	s := &serializedMigrateVolumeParams{}
+	if p.livemigrate.ok {
+		s.Livemigrate = &p.livemigrate.v
+	}
+	if p.newdiskofferingid.ok {
+		s.Newdiskofferingid = &p.newdiskofferingid.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	if p.volumeid.ok {
+		s.Volumeid = &p.volumeid.v
+	}
+	return s
+}
+
+func (p *MigrateVolumeParams) fromSerialized(s *serializedMigrateVolumeParams) {
+	*p = MigrateVolumeParams{}
+	if s.Livemigrate != nil {
+		p.SetLivemigrate(*s.Livemigrate)
+	}
+	if s.Newdiskofferingid != nil {
+		p.SetNewdiskofferingid(*s.Newdiskofferingid)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+	if s.Volumeid != nil {
+		p.SetVolumeid(*s.Volumeid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p MigrateVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *MigrateVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedMigrateVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p MigrateVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *MigrateVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedMigrateVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["volumeid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new MigrateVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams {
 	p := &MigrateVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["storageid"] = storageid
-	p.p["volumeid"] = volumeid
+	p.SetStorageid(storageid)
+	p.SetVolumeid(volumeid)
 	return p
 }
 
-// Migrate volume
-func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVolume", p.toURLValues())
+// Migrate volume.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: storageid, volumeid.
+func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams, opts ...CallOption) (*MigrateVolumeResponse, error) {
+	resp, err := s.cs.newRequest("migrateVolume", s.cs.encodeParams("migrateVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3523,8 +5083,8 @@ func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeRes
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3617,53 +5177,132 @@ type MigrateVolumeResponse struct {
 }
 
 type RecoverVolumeParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *RecoverVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *RecoverVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *RecoverVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseRecoverVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new RecoverVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseRecoverVolumeParams(u url.Values) (*RecoverVolumeParams, error) {
+	p := &RecoverVolumeParams{}
+	if err := checkParamNames("recoverVolume", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *RecoverVolumeParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *RecoverVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *RecoverVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *RecoverVolumeParams) Clone() *RecoverVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *RecoverVolumeParams) Equal(o *RecoverVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedRecoverVolumeParams is used to (un)marshal RecoverVolumeParams using the API param names
+type serializedRecoverVolumeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *RecoverVolumeParams) toSerialized() *serializedRecoverVolumeParams {
+	s := &serializedRecoverVolumeParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *RecoverVolumeParams) fromSerialized(s *serializedRecoverVolumeParams) {
+	*p = RecoverVolumeParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p RecoverVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *RecoverVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedRecoverVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p RecoverVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *RecoverVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedRecoverVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new RecoverVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewRecoverVolumeParams(id string) *RecoverVolumeParams {
 	p := &RecoverVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Recovers a Destroy volume.
-func (s *VolumeService) RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeResponse, error) {
-	resp, err := s.cs.newRequest("recoverVolume", p.toURLValues())
+//
+// Required params: id.
+func (s *VolumeService) RecoverVolume(p *RecoverVolumeParams, opts ...CallOption) (*RecoverVolumeResponse, error) {
+	resp, err := s.cs.newRequest("recoverVolume", s.cs.encodeParams("recoverVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3748,177 +5387,299 @@ type RecoverVolumeResponse struct {
 }
 
 type ResizeVolumeParams struct {
-	p map[string]interface{}
+	diskofferingid optString
+	id             optString
+	maxiops        optInt64
+	miniops        optInt64
+	shrinkok       optBool
+	size           optInt64
 }
 
-func (p *ResizeVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ResizeVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
+	if p.maxiops.ok {
+		u.Set("maxiops", strconv.FormatInt(p.maxiops.v, 10))
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
+	if p.miniops.ok {
+		u.Set("miniops", strconv.FormatInt(p.miniops.v, 10))
 	}
-	if v, found := p.p["shrinkok"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("shrinkok", vv)
+	if p.shrinkok.ok {
+		u.Set("shrinkok", strconv.FormatBool(p.shrinkok.v))
 	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
+	if p.size.ok {
+		u.Set("size", strconv.FormatInt(p.size.v, 10))
 	}
 	return u
 }
 
-func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseResizeVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new ResizeVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseResizeVolumeParams(u url.Values) (*ResizeVolumeParams, error) {
+	p := &ResizeVolumeParams{}
+	if err := checkParamNames("resizeVolume", u, "diskofferingid", "id", "maxiops", "miniops", "shrinkok", "size"); err != nil {
+		return nil, err
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
 	}
-	p.p["diskofferingid"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["maxiops"]; found {
+		v, err := strconv.ParseInt(u.Get("maxiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxiops: %v", err)
+		}
+		p.SetMaxiops(v)
+	}
+	if _, found := u["miniops"]; found {
+		v, err := strconv.ParseInt(u.Get("miniops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param miniops: %v", err)
+		}
+		p.SetMiniops(v)
+	}
+	if _, found := u["shrinkok"]; found {
+		v, err := strconv.ParseBool(u.Get("shrinkok"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param shrinkok: %v", err)
+		}
+		p.SetShrinkok(v)
+	}
+	if _, found := u["size"]; found {
+		v, err := strconv.ParseInt(u.Get("size"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param size: %v", err)
+		}
+		p.SetSize(v)
+	}
+	return p, nil
+}
+
+// SetDiskofferingid sets the diskofferingid param.
+func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *ResizeVolumeParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *ResizeVolumeParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ResizeVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ResizeVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ResizeVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMaxiops sets the maxiops param.
 func (p *ResizeVolumeParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
+	p.maxiops = optInt64{v: v, ok: true}
 }
 
+// ResetMaxiops unsets the maxiops param
 func (p *ResizeVolumeParams) ResetMaxiops() {
-	if p.p != nil && p.p["maxiops"] != nil {
-		delete(p.p, "maxiops")
-	}
+	p.maxiops = optInt64{}
 }
 
+// GetMaxiops returns the maxiops param and if it is set
 func (p *ResizeVolumeParams) GetMaxiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxiops"].(int64)
-	return value, ok
+	return p.maxiops.v, p.maxiops.ok
 }
 
+// SetMiniops sets the miniops param.
 func (p *ResizeVolumeParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
+	p.miniops = optInt64{v: v, ok: true}
 }
 
+// ResetMiniops unsets the miniops param
 func (p *ResizeVolumeParams) ResetMiniops() {
-	if p.p != nil && p.p["miniops"] != nil {
-		delete(p.p, "miniops")
-	}
+	p.miniops = optInt64{}
 }
 
+// GetMiniops returns the miniops param and if it is set
 func (p *ResizeVolumeParams) GetMiniops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["miniops"].(int64)
-	return value, ok
+	return p.miniops.v, p.miniops.ok
 }
 
+// SetShrinkok sets the shrinkok param.
 func (p *ResizeVolumeParams) SetShrinkok(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["shrinkok"] = v
+	p.shrinkok = optBool{v: v, ok: true}
 }
 
+// ResetShrinkok unsets the shrinkok param
 func (p *ResizeVolumeParams) ResetShrinkok() {
-	if p.p != nil && p.p["shrinkok"] != nil {
-		delete(p.p, "shrinkok")
-	}
+	p.shrinkok = optBool{}
 }
 
+// GetShrinkok returns the shrinkok param and if it is set
 func (p *ResizeVolumeParams) GetShrinkok() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["shrinkok"].(bool)
-	return value, ok
+	return p.shrinkok.v, p.shrinkok.ok
 }
 
+// SetSize sets the size param.
 func (p *ResizeVolumeParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.size = optInt64{v: v, ok: true}
+}
+
+// ResetSize unsets the size param
+func (p *ResizeVolumeParams) ResetSize() {
+	p.size = optInt64{}
+}
+
+// GetSize returns the size param and if it is set
+func (p *ResizeVolumeParams) GetSize() (int64, bool) {
+	return p.size.v, p.size.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ResizeVolumeParams) Clone() *ResizeVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ResizeVolumeParams) Equal(o *ResizeVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.diskofferingid == o.diskofferingid &&
+		p.id == o.id &&
+		p.maxiops == o.maxiops &&
+		p.miniops == o.miniops &&
+		p.shrinkok == o.shrinkok &&
+		p.size == o.size
+}
+
+// serializedResizeVolumeParams is used to (un)marshal ResizeVolumeParams using the API param names
+type serializedResizeVolumeParams struct {
+	Diskofferingid *string `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Id             *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Maxiops        *int64  `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops        *int64  `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Shrinkok       *bool   `json:"shrinkok,omitempty" yaml:"shrinkok,omitempty"`
+	Size           *int64  `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+func (p *ResizeVolumeParams) toSerialized() *serializedResizeVolumeParams {
+	s := &serializedResizeVolumeParams{}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.maxiops.ok {
+		s.Maxiops = &p.maxiops.v
+	}
+	if p.miniops.ok {
+		s.Miniops = &p.miniops.v
+	}
+	if p.shrinkok.ok {
+		s.Shrinkok = &p.shrinkok.v
+	}
+	if p.size.ok {
+		s.Size = &p.size.v
+	}
+	return s
+}
+
+func (p *ResizeVolumeParams) fromSerialized(s *serializedResizeVolumeParams) {
+	*p = ResizeVolumeParams{}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Maxiops != nil {
+		p.SetMaxiops(*s.Maxiops)
+	}
+	if s.Miniops != nil {
+		p.SetMiniops(*s.Miniops)
+	}
+	if s.Shrinkok != nil {
+		p.SetShrinkok(*s.Shrinkok)
+	}
+	if s.Size != nil {
+		p.SetSize(*s.Size)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ResizeVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ResizeVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedResizeVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	p.p["size"] = v
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *ResizeVolumeParams) ResetSize() {
-	if p.p != nil && p.p["size"] != nil {
-		delete(p.p, "size")
-	}
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ResizeVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
 }
 
-func (p *ResizeVolumeParams) GetSize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ResizeVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedResizeVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["size"].(int64)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ResizeVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewResizeVolumeParams(id string) *ResizeVolumeParams {
 	p := &ResizeVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-// Resizes a volume
-func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
-	resp, err := s.cs.newRequest("resizeVolume", p.toURLValues())
+// Resizes a volume.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: id.
+func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams, opts ...CallOption) (*ResizeVolumeResponse, error) {
+	resp, err := s.cs.newRequest("resizeVolume", s.cs.encodeParams("resizeVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3929,8 +5690,8 @@ func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4023,221 +5784,346 @@ type ResizeVolumeResponse struct {
 }
 
 type UpdateVolumeParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateVolumeParams) toURLValues() url.Values {
+	chaininfo     optString
+	customid      optString
+	displayvolume optBool
+	id            optString
+	name          optString
+	path          optString
+	state         optString
+	storageid     optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["chaininfo"]; found {
-		u.Set("chaininfo", v.(string))
+	if p.chaininfo.ok {
+		u.Set("chaininfo", p.chaininfo.v)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if p.customid.ok {
+		u.Set("customid", p.customid.v)
 	}
-	if v, found := p.p["displayvolume"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvolume", vv)
+	if p.displayvolume.ok {
+		u.Set("displayvolume", strconv.FormatBool(p.displayvolume.v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["path"]; found {
-		u.Set("path", v.(string))
+	if p.path.ok {
+		u.Set("path", p.path.v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if p.state.ok {
+		u.Set("state", p.state.v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if p.storageid.ok {
+		u.Set("storageid", p.storageid.v)
 	}
 	return u
 }
 
-func (p *UpdateVolumeParams) SetChaininfo(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateVolumeParams(u url.Values) (*UpdateVolumeParams, error) {
+	p := &UpdateVolumeParams{}
+	if err := checkParamNames("updateVolume", u, "chaininfo", "customid", "displayvolume", "id", "name", "path", "state", "storageid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["chaininfo"]; found {
+		p.SetChaininfo(u.Get("chaininfo"))
+	}
+	if _, found := u["customid"]; found {
+		p.SetCustomid(u.Get("customid"))
+	}
+	if _, found := u["displayvolume"]; found {
+		v, err := strconv.ParseBool(u.Get("displayvolume"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param displayvolume: %v", err)
+		}
+		p.SetDisplayvolume(v)
 	}
-	p.p["chaininfo"] = v
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["path"]; found {
+		p.SetPath(u.Get("path"))
+	}
+	if _, found := u["state"]; found {
+		p.SetState(u.Get("state"))
+	}
+	if _, found := u["storageid"]; found {
+		p.SetStorageid(u.Get("storageid"))
+	}
+	return p, nil
+}
+
+// SetChaininfo sets the chaininfo param.
+func (p *UpdateVolumeParams) SetChaininfo(v string) {
+	p.chaininfo = optString{v: v, ok: true}
 }
 
+// ResetChaininfo unsets the chaininfo param
 func (p *UpdateVolumeParams) ResetChaininfo() {
-	if p.p != nil && p.p["chaininfo"] != nil {
-		delete(p.p, "chaininfo")
-	}
+	p.chaininfo = optString{}
 }
 
+// GetChaininfo returns the chaininfo param and if it is set
 func (p *UpdateVolumeParams) GetChaininfo() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["chaininfo"].(string)
-	return value, ok
+	return p.chaininfo.v, p.chaininfo.ok
 }
 
+// SetCustomid sets the customid param.
 func (p *UpdateVolumeParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.customid = optString{v: v, ok: true}
 }
 
+// ResetCustomid unsets the customid param
 func (p *UpdateVolumeParams) ResetCustomid() {
-	if p.p != nil && p.p["customid"] != nil {
-		delete(p.p, "customid")
-	}
+	p.customid = optString{}
 }
 
+// GetCustomid returns the customid param and if it is set
 func (p *UpdateVolumeParams) GetCustomid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["customid"].(string)
-	return value, ok
+	return p.customid.v, p.customid.ok
 }
 
+// SetDisplayvolume sets the displayvolume param.
 func (p *UpdateVolumeParams) SetDisplayvolume(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvolume"] = v
+	p.displayvolume = optBool{v: v, ok: true}
 }
 
+// ResetDisplayvolume unsets the displayvolume param
 func (p *UpdateVolumeParams) ResetDisplayvolume() {
-	if p.p != nil && p.p["displayvolume"] != nil {
-		delete(p.p, "displayvolume")
-	}
+	p.displayvolume = optBool{}
 }
 
+// GetDisplayvolume returns the displayvolume param and if it is set
 func (p *UpdateVolumeParams) GetDisplayvolume() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["displayvolume"].(bool)
-	return value, ok
+	return p.displayvolume.v, p.displayvolume.ok
 }
 
+// SetId sets the id param.
 func (p *UpdateVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateVolumeParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateVolumeParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateVolumeParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetPath sets the path param.
 func (p *UpdateVolumeParams) SetPath(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["path"] = v
+	p.path = optString{v: v, ok: true}
 }
 
+// ResetPath unsets the path param
 func (p *UpdateVolumeParams) ResetPath() {
-	if p.p != nil && p.p["path"] != nil {
-		delete(p.p, "path")
-	}
+	p.path = optString{}
 }
 
+// GetPath returns the path param and if it is set
 func (p *UpdateVolumeParams) GetPath() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["path"].(string)
-	return value, ok
+	return p.path.v, p.path.ok
 }
 
+// SetState sets the state param.
 func (p *UpdateVolumeParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+	p.state = optString{v: v, ok: true}
 }
 
+// ResetState unsets the state param
 func (p *UpdateVolumeParams) ResetState() {
-	if p.p != nil && p.p["state"] != nil {
-		delete(p.p, "state")
-	}
+	p.state = optString{}
 }
 
+// GetState returns the state param and if it is set
 func (p *UpdateVolumeParams) GetState() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["state"].(string)
-	return value, ok
+	return p.state.v, p.state.ok
 }
 
+// SetStorageid sets the storageid param.
 func (p *UpdateVolumeParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
+	p.storageid = optString{v: v, ok: true}
 }
 
+// ResetStorageid unsets the storageid param
 func (p *UpdateVolumeParams) ResetStorageid() {
-	if p.p != nil && p.p["storageid"] != nil {
-		delete(p.p, "storageid")
-	}
+	p.storageid = optString{}
 }
 
+// GetStorageid returns the storageid param and if it is set
 func (p *UpdateVolumeParams) GetStorageid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.storageid.v, p.storageid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateVolumeParams) Clone() *UpdateVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateVolumeParams) Equal(o *UpdateVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.chaininfo == o.chaininfo &&
+		p.customid == o.customid &&
+		p.displayvolume == o.displayvolume &&
+		p.id == o.id &&
+		p.name == o.name &&
+		p.path == o.path &&
+		p.state == o.state &&
+		p.storageid == o.storageid
+}
+
+// serializedUpdateVolumeParams is used to (un)marshal UpdateVolumeParams using the API param names
+type serializedUpdateVolumeParams struct {
+	Chaininfo     *string `json:"chaininfo,omitempty" yaml:"chaininfo,omitempty"`
+	Customid      *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Displayvolume *bool   `json:"displayvolume,omitempty" yaml:"displayvolume,omitempty"`
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name          *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Path          *string `json:"path,omitempty" yaml:"path,omitempty"`
+	State         *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid     *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+}
+
+func (p *UpdateVolumeParams) toSerialized() *serializedUpdateVolumeParams {
+	s := &serializedUpdateVolumeParams{}
+	if p.chaininfo.ok {
+		s.Chaininfo = &p.chaininfo.v
+	}
+	if p.customid.ok {
+		s.Customid = &p.customid.v
+	}
+	if p.displayvolume.ok {
+		s.Displayvolume = &p.displayvolume.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.path.ok {
+		s.Path = &p.path.v
+	}
+	if p.state.ok {
+		s.State = &p.state.v
+	}
+	if p.storageid.ok {
+		s.Storageid = &p.storageid.v
+	}
+	return s
+}
+
+func (p *UpdateVolumeParams) fromSerialized(s *serializedUpdateVolumeParams) {
+	*p = UpdateVolumeParams{}
+	if s.Chaininfo != nil {
+		p.SetChaininfo(*s.Chaininfo)
+	}
+	if s.Customid != nil {
+		p.SetCustomid(*s.Customid)
+	}
+	if s.Displayvolume != nil {
+		p.SetDisplayvolume(*s.Displayvolume)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Path != nil {
+		p.SetPath(*s.Path)
+	}
+	if s.State != nil {
+		p.SetState(*s.State)
+	}
+	if s.Storageid != nil {
+		p.SetStorageid(*s.Storageid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["storageid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
 	p := &UpdateVolumeParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Updates the volume.
-func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("updateVolume", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult).
+func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams, opts ...CallOption) (*UpdateVolumeResponse, error) {
+	resp, err := s.cs.newRequest("updateVolume", s.cs.encodeParams("updateVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4248,8 +6134,8 @@ func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4342,272 +6228,407 @@ type UpdateVolumeResponse struct {
 }
 
 type UploadVolumeParams struct {
-	p map[string]interface{}
-}
-
-func (p *UploadVolumeParams) toURLValues() url.Values {
+	account        optString
+	checksum       optString
+	diskofferingid optString
+	domainid       optString
+	format         optString
+	imagestoreuuid optString
+	name           optString
+	projectid      optString
+	url            optString
+	zoneid         optString
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UploadVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	if p.checksum.ok {
+		u.Set("checksum", p.checksum.v)
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if p.format.ok {
+		u.Set("format", p.format.v)
 	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
+	if p.imagestoreuuid.ok {
+		u.Set("imagestoreuuid", p.imagestoreuuid.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if p.projectid.ok {
+		u.Set("projectid", p.projectid.v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if p.url.ok {
+		u.Set("url", p.url.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *UploadVolumeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUploadVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new UploadVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUploadVolumeParams(u url.Values) (*UploadVolumeParams, error) {
+	p := &UploadVolumeParams{}
+	if err := checkParamNames("uploadVolume", u, "account", "checksum", "diskofferingid", "domainid", "format", "imagestoreuuid", "name", "projectid", "url", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["checksum"]; found {
+		p.SetChecksum(u.Get("checksum"))
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["format"]; found {
+		p.SetFormat(u.Get("format"))
 	}
-	p.p["account"] = v
+	if _, found := u["imagestoreuuid"]; found {
+		p.SetImagestoreuuid(u.Get("imagestoreuuid"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["projectid"]; found {
+		p.SetProjectid(u.Get("projectid"))
+	}
+	if _, found := u["url"]; found {
+		p.SetUrl(u.Get("url"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *UploadVolumeParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *UploadVolumeParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *UploadVolumeParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetChecksum sets the checksum param.
 func (p *UploadVolumeParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["checksum"] = v
+	p.checksum = optString{v: v, ok: true}
 }
 
+// ResetChecksum unsets the checksum param
 func (p *UploadVolumeParams) ResetChecksum() {
-	if p.p != nil && p.p["checksum"] != nil {
-		delete(p.p, "checksum")
-	}
+	p.checksum = optString{}
 }
 
+// GetChecksum returns the checksum param and if it is set
 func (p *UploadVolumeParams) GetChecksum() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["checksum"].(string)
-	return value, ok
+	return p.checksum.v, p.checksum.ok
 }
 
+// SetDiskofferingid sets the diskofferingid param.
 func (p *UploadVolumeParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *UploadVolumeParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *UploadVolumeParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *UploadVolumeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *UploadVolumeParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *UploadVolumeParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetFormat sets the format param. This param is required.
 func (p *UploadVolumeParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
+	p.format = optString{v: v, ok: true}
 }
 
+// ResetFormat unsets the format param
 func (p *UploadVolumeParams) ResetFormat() {
-	if p.p != nil && p.p["format"] != nil {
-		delete(p.p, "format")
-	}
+	p.format = optString{}
 }
 
+// GetFormat returns the format param and if it is set
 func (p *UploadVolumeParams) GetFormat() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["format"].(string)
-	return value, ok
+	return p.format.v, p.format.ok
 }
 
+// SetImagestoreuuid sets the imagestoreuuid param.
 func (p *UploadVolumeParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["imagestoreuuid"] = v
+	p.imagestoreuuid = optString{v: v, ok: true}
 }
 
+// ResetImagestoreuuid unsets the imagestoreuuid param
 func (p *UploadVolumeParams) ResetImagestoreuuid() {
-	if p.p != nil && p.p["imagestoreuuid"] != nil {
-		delete(p.p, "imagestoreuuid")
-	}
+	p.imagestoreuuid = optString{}
 }
 
+// GetImagestoreuuid returns the imagestoreuuid param and if it is set
 func (p *UploadVolumeParams) GetImagestoreuuid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["imagestoreuuid"].(string)
-	return value, ok
+	return p.imagestoreuuid.v, p.imagestoreuuid.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *UploadVolumeParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UploadVolumeParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UploadVolumeParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetProjectid sets the projectid param.
 func (p *UploadVolumeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+	p.projectid = optString{v: v, ok: true}
 }
 
+// ResetProjectid unsets the projectid param
 func (p *UploadVolumeParams) ResetProjectid() {
-	if p.p != nil && p.p["projectid"] != nil {
-		delete(p.p, "projectid")
-	}
+	p.projectid = optString{}
 }
 
+// GetProjectid returns the projectid param and if it is set
 func (p *UploadVolumeParams) GetProjectid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["projectid"].(string)
-	return value, ok
+	return p.projectid.v, p.projectid.ok
 }
 
+// SetUrl sets the url param. This param is required.
 func (p *UploadVolumeParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+	p.url = optString{v: v, ok: true}
 }
 
+// ResetUrl unsets the url param
 func (p *UploadVolumeParams) ResetUrl() {
-	if p.p != nil && p.p["url"] != nil {
-		delete(p.p, "url")
-	}
+	p.url = optString{}
 }
 
+// GetUrl returns the url param and if it is set
 func (p *UploadVolumeParams) GetUrl() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["url"].(string)
-	return value, ok
+	return p.url.v, p.url.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *UploadVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *UploadVolumeParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *UploadVolumeParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UploadVolumeParams) Clone() *UploadVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UploadVolumeParams) Equal(o *UploadVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.checksum == o.checksum &&
+		p.diskofferingid == o.diskofferingid &&
+		p.domainid == o.domainid &&
+		p.format == o.format &&
+		p.imagestoreuuid == o.imagestoreuuid &&
+		p.name == o.name &&
+		p.projectid == o.projectid &&
+		p.url == o.url &&
+		p.zoneid == o.zoneid
+}
+
+// serializedUploadVolumeParams is used to (un)marshal UploadVolumeParams using the API param names
+type serializedUploadVolumeParams struct {
+	Account        *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Checksum       *string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Diskofferingid *string `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Domainid       *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Format         *string `json:"format,omitempty" yaml:"format,omitempty"`
+	Imagestoreuuid *string `json:"imagestoreuuid,omitempty" yaml:"imagestoreuuid,omitempty"`
+	Name           *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid      *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Url            *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid         *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *UploadVolumeParams) toSerialized() *serializedUploadVolumeParams {
+	s := &serializedUploadVolumeParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.checksum.ok {
+		s.Checksum = &p.checksum.v
+	}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.format.ok {
+		s.Format = &p.format.v
+	}
+	if p.imagestoreuuid.ok {
+		s.Imagestoreuuid = &p.imagestoreuuid.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.projectid.ok {
+		s.Projectid = &p.projectid.v
+	}
+	if p.url.ok {
+		s.Url = &p.url.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *UploadVolumeParams) fromSerialized(s *serializedUploadVolumeParams) {
+	*p = UploadVolumeParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Checksum != nil {
+		p.SetChecksum(*s.Checksum)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Format != nil {
+		p.SetFormat(*s.Format)
+	}
+	if s.Imagestoreuuid != nil {
+		p.SetImagestoreuuid(*s.Imagestoreuuid)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	if s.Projectid != nil {
+		p.SetProjectid(*s.Projectid)
+	}
+	if s.Url != nil {
+		p.SetUrl(*s.Url)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UploadVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UploadVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedUploadVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UploadVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UploadVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUploadVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UploadVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams {
 	p := &UploadVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["format"] = format
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetFormat(format)
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Uploads a data disk.
-func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
-	resp, err := s.cs.newRequest("uploadVolume", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: format, name, url,
+// zoneid.
+func (s *VolumeService) UploadVolume(p *UploadVolumeParams, opts ...CallOption) (*UploadVolumeResponse, error) {
+	resp, err := s.cs.newRequest("uploadVolume", s.cs.encodeParams("uploadVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4618,8 +6639,8 @@ func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeRespon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4712,203 +6733,335 @@ type UploadVolumeResponse struct {
 }
 
 type ChangeOfferingForVolumeParams struct {
-	p map[string]interface{}
+	automigrate    optBool
+	diskofferingid optString
+	id             optString
+	maxiops        optInt64
+	miniops        optInt64
+	shrinkok       optBool
+	size           optInt64
 }
 
-func (p *ChangeOfferingForVolumeParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ChangeOfferingForVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["automigrate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("automigrate", vv)
+	if p.automigrate.ok {
+		u.Set("automigrate", strconv.FormatBool(p.automigrate.v))
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if p.diskofferingid.ok {
+		u.Set("diskofferingid", p.diskofferingid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
+	if p.maxiops.ok {
+		u.Set("maxiops", strconv.FormatInt(p.maxiops.v, 10))
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
+	if p.miniops.ok {
+		u.Set("miniops", strconv.FormatInt(p.miniops.v, 10))
 	}
-	if v, found := p.p["shrinkok"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("shrinkok", vv)
+	if p.shrinkok.ok {
+		u.Set("shrinkok", strconv.FormatBool(p.shrinkok.v))
 	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
+	if p.size.ok {
+		u.Set("size", strconv.FormatInt(p.size.v, 10))
 	}
 	return u
 }
 
-func (p *ChangeOfferingForVolumeParams) SetAutomigrate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseChangeOfferingForVolumeParams parses url.Values, for example taken from a raw API request,
+// into a new ChangeOfferingForVolumeParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseChangeOfferingForVolumeParams(u url.Values) (*ChangeOfferingForVolumeParams, error) {
+	p := &ChangeOfferingForVolumeParams{}
+	if err := checkParamNames("changeOfferingForVolume", u, "automigrate", "diskofferingid", "id", "maxiops", "miniops", "shrinkok", "size"); err != nil {
+		return nil, err
+	}
+	if _, found := u["automigrate"]; found {
+		v, err := strconv.ParseBool(u.Get("automigrate"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param automigrate: %v", err)
+		}
+		p.SetAutomigrate(v)
+	}
+	if _, found := u["diskofferingid"]; found {
+		p.SetDiskofferingid(u.Get("diskofferingid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["maxiops"]; found {
+		v, err := strconv.ParseInt(u.Get("maxiops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param maxiops: %v", err)
+		}
+		p.SetMaxiops(v)
+	}
+	if _, found := u["miniops"]; found {
+		v, err := strconv.ParseInt(u.Get("miniops"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param miniops: %v", err)
+		}
+		p.SetMiniops(v)
+	}
+	if _, found := u["shrinkok"]; found {
+		v, err := strconv.ParseBool(u.Get("shrinkok"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param shrinkok: %v", err)
+		}
+		p.SetShrinkok(v)
+	}
+	if _, found := u["size"]; found {
+		v, err := strconv.ParseInt(u.Get("size"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param size: %v", err)
+		}
+		p.SetSize(v)
 	}
-	p.p["automigrate"] = v
+	return p, nil
 }
 
+// SetAutomigrate sets the automigrate param.
+func (p *ChangeOfferingForVolumeParams) SetAutomigrate(v bool) {
+	p.automigrate = optBool{v: v, ok: true}
+}
+
+// ResetAutomigrate unsets the automigrate param
 func (p *ChangeOfferingForVolumeParams) ResetAutomigrate() {
-	if p.p != nil && p.p["automigrate"] != nil {
-		delete(p.p, "automigrate")
-	}
+	p.automigrate = optBool{}
 }
 
+// GetAutomigrate returns the automigrate param and if it is set
 func (p *ChangeOfferingForVolumeParams) GetAutomigrate() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["automigrate"].(bool)
-	return value, ok
+	return p.automigrate.v, p.automigrate.ok
 }
 
+// SetDiskofferingid sets the diskofferingid param. This param is required.
 func (p *ChangeOfferingForVolumeParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+	p.diskofferingid = optString{v: v, ok: true}
 }
 
+// ResetDiskofferingid unsets the diskofferingid param
 func (p *ChangeOfferingForVolumeParams) ResetDiskofferingid() {
-	if p.p != nil && p.p["diskofferingid"] != nil {
-		delete(p.p, "diskofferingid")
-	}
+	p.diskofferingid = optString{}
 }
 
+// GetDiskofferingid returns the diskofferingid param and if it is set
 func (p *ChangeOfferingForVolumeParams) GetDiskofferingid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["diskofferingid"].(string)
-	return value, ok
+	return p.diskofferingid.v, p.diskofferingid.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *ChangeOfferingForVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ChangeOfferingForVolumeParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ChangeOfferingForVolumeParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetMaxiops sets the maxiops param.
 func (p *ChangeOfferingForVolumeParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
+	p.maxiops = optInt64{v: v, ok: true}
 }
 
+// ResetMaxiops unsets the maxiops param
 func (p *ChangeOfferingForVolumeParams) ResetMaxiops() {
-	if p.p != nil && p.p["maxiops"] != nil {
-		delete(p.p, "maxiops")
-	}
+	p.maxiops = optInt64{}
 }
 
+// GetMaxiops returns the maxiops param and if it is set
 func (p *ChangeOfferingForVolumeParams) GetMaxiops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["maxiops"].(int64)
-	return value, ok
+	return p.maxiops.v, p.maxiops.ok
 }
 
+// SetMiniops sets the miniops param.
 func (p *ChangeOfferingForVolumeParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
+	p.miniops = optInt64{v: v, ok: true}
 }
 
+// ResetMiniops unsets the miniops param
 func (p *ChangeOfferingForVolumeParams) ResetMiniops() {
-	if p.p != nil && p.p["miniops"] != nil {
-		delete(p.p, "miniops")
-	}
+	p.miniops = optInt64{}
 }
 
+// GetMiniops returns the miniops param and if it is set
 func (p *ChangeOfferingForVolumeParams) GetMiniops() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["miniops"].(int64)
-	return value, ok
+	return p.miniops.v, p.miniops.ok
 }
 
+// SetShrinkok sets the shrinkok param.
 func (p *ChangeOfferingForVolumeParams) SetShrinkok(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["shrinkok"] = v
+	p.shrinkok = optBool{v: v, ok: true}
 }
 
+// ResetShrinkok unsets the shrinkok param
 func (p *ChangeOfferingForVolumeParams) ResetShrinkok() {
-	if p.p != nil && p.p["shrinkok"] != nil {
-		delete(p.p, "shrinkok")
-	}
+	p.shrinkok = optBool{}
 }
 
+// GetShrinkok returns the shrinkok param and if it is set
 func (p *ChangeOfferingForVolumeParams) GetShrinkok() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["shrinkok"].(bool)
-	return value, ok
+	return p.shrinkok.v, p.shrinkok.ok
 }
 
+// SetSize sets the size param.
 func (p *ChangeOfferingForVolumeParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["size"] = v
+	p.size = optInt64{v: v, ok: true}
 }
 
+// ResetSize unsets the size param
 func (p *ChangeOfferingForVolumeParams) ResetSize() {
-	if p.p != nil && p.p["size"] != nil {
-		delete(p.p, "size")
-	}
+	p.size = optInt64{}
 }
 
+// GetSize returns the size param and if it is set
 func (p *ChangeOfferingForVolumeParams) GetSize() (int64, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.size.v, p.size.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ChangeOfferingForVolumeParams) Clone() *ChangeOfferingForVolumeParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ChangeOfferingForVolumeParams) Equal(o *ChangeOfferingForVolumeParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.automigrate == o.automigrate &&
+		p.diskofferingid == o.diskofferingid &&
+		p.id == o.id &&
+		p.maxiops == o.maxiops &&
+		p.miniops == o.miniops &&
+		p.shrinkok == o.shrinkok &&
+		p.size == o.size
+}
+
+// serializedChangeOfferingForVolumeParams is used to (un)marshal ChangeOfferingForVolumeParams using the API param names
+type serializedChangeOfferingForVolumeParams struct {
+	Automigrate    *bool   `json:"automigrate,omitempty" yaml:"automigrate,omitempty"`
+	Diskofferingid *string `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Id             *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Maxiops        *int64  `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops        *int64  `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Shrinkok       *bool   `json:"shrinkok,omitempty" yaml:"shrinkok,omitempty"`
+	Size           *int64  `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+func (p *ChangeOfferingForVolumeParams) toSerialized() *serializedChangeOfferingForVolumeParams {
+	s := &serializedChangeOfferingForVolumeParams{}
+	if p.automigrate.ok {
+		s.Automigrate = &p.automigrate.v
+	}
+	if p.diskofferingid.ok {
+		s.Diskofferingid = &p.diskofferingid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.maxiops.ok {
+		s.Maxiops = &p.maxiops.v
+	}
+	if p.miniops.ok {
+		s.Miniops = &p.miniops.v
+	}
+	if p.shrinkok.ok {
+		s.Shrinkok = &p.shrinkok.v
+	}
+	if p.size.ok {
+		s.Size = &p.size.v
+	}
+	return s
+}
+
+func (p *ChangeOfferingForVolumeParams) fromSerialized(s *serializedChangeOfferingForVolumeParams) {
+	*p = ChangeOfferingForVolumeParams{}
+	if s.Automigrate != nil {
+		p.SetAutomigrate(*s.Automigrate)
+	}
+	if s.Diskofferingid != nil {
+		p.SetDiskofferingid(*s.Diskofferingid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Maxiops != nil {
+		p.SetMaxiops(*s.Maxiops)
 	}
-	value, ok := p.p["size"].(int64)
-	return value, ok
+	if s.Miniops != nil {
+		p.SetMiniops(*s.Miniops)
+	}
+	if s.Shrinkok != nil {
+		p.SetShrinkok(*s.Shrinkok)
+	}
+	if s.Size != nil {
+		p.SetSize(*s.Size)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ChangeOfferingForVolumeParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ChangeOfferingForVolumeParams) UnmarshalJSON(b []byte) error {
+	var s serializedChangeOfferingForVolumeParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ChangeOfferingForVolumeParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ChangeOfferingForVolumeParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedChangeOfferingForVolumeParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ChangeOfferingForVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewChangeOfferingForVolumeParams(diskofferingid string, id string) *ChangeOfferingForVolumeParams {
 	p := &ChangeOfferingForVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["diskofferingid"] = diskofferingid
-	p.p["id"] = id
+	p.SetDiskofferingid(diskofferingid)
+	p.SetId(id)
 	return p
 }
 
-// Change disk offering of the volume and also an option to auto migrate if required to apply the new disk offering
-func (s *VolumeService) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("changeOfferingForVolume", p.toURLValues())
+// Change disk offering of the volume and also an option to auto migrate if required to apply the
+// new disk offering.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: diskofferingid, id.
+func (s *VolumeService) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams, opts ...CallOption) (*ChangeOfferingForVolumeResponse, error) {
+	resp, err := s.cs.newRequest("changeOfferingForVolume", s.cs.encodeParams("changeOfferingForVolume", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4919,8 +7072,8 @@ func (s *VolumeService) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
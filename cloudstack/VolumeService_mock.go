@@ -53,153 +53,203 @@ func (m *MockVolumeServiceIface) EXPECT() *MockVolumeServiceIfaceMockRecorder {
 }
 
 // AttachVolume mocks base method.
-func (m *MockVolumeServiceIface) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
+func (m *MockVolumeServiceIface) AttachVolume(p *AttachVolumeParams, opts ...CallOption) (*AttachVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AttachVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttachVolume", varargs...)
 	ret0, _ := ret[0].(*AttachVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AttachVolume indicates an expected call of AttachVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) AttachVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) AttachVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).AttachVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).AttachVolume), varargs...)
 }
 
 // ChangeOfferingForVolume mocks base method.
-func (m *MockVolumeServiceIface) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error) {
+func (m *MockVolumeServiceIface) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams, opts ...CallOption) (*ChangeOfferingForVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeOfferingForVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeOfferingForVolume", varargs...)
 	ret0, _ := ret[0].(*ChangeOfferingForVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeOfferingForVolume indicates an expected call of ChangeOfferingForVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) ChangeOfferingForVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) ChangeOfferingForVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOfferingForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ChangeOfferingForVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOfferingForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ChangeOfferingForVolume), varargs...)
 }
 
 // CreateVolume mocks base method.
-func (m *MockVolumeServiceIface) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+func (m *MockVolumeServiceIface) CreateVolume(p *CreateVolumeParams, opts ...CallOption) (*CreateVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVolume", varargs...)
 	ret0, _ := ret[0].(*CreateVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVolume indicates an expected call of CreateVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) CreateVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) CreateVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).CreateVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).CreateVolume), varargs...)
 }
 
 // DeleteVolume mocks base method.
-func (m *MockVolumeServiceIface) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+func (m *MockVolumeServiceIface) DeleteVolume(p *DeleteVolumeParams, opts ...CallOption) (*DeleteVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVolume", varargs...)
 	ret0, _ := ret[0].(*DeleteVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVolume indicates an expected call of DeleteVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) DeleteVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) DeleteVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DeleteVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DeleteVolume), varargs...)
 }
 
 // DestroyVolume mocks base method.
-func (m *MockVolumeServiceIface) DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
+func (m *MockVolumeServiceIface) DestroyVolume(p *DestroyVolumeParams, opts ...CallOption) (*DestroyVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroyVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DestroyVolume", varargs...)
 	ret0, _ := ret[0].(*DestroyVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DestroyVolume indicates an expected call of DestroyVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) DestroyVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) DestroyVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DestroyVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DestroyVolume), varargs...)
 }
 
 // DetachVolume mocks base method.
-func (m *MockVolumeServiceIface) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+func (m *MockVolumeServiceIface) DetachVolume(p *DetachVolumeParams, opts ...CallOption) (*DetachVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DetachVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DetachVolume", varargs...)
 	ret0, _ := ret[0].(*DetachVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DetachVolume indicates an expected call of DetachVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) DetachVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) DetachVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DetachVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DetachVolume), varargs...)
 }
 
 // ExtractVolume mocks base method.
-func (m *MockVolumeServiceIface) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+func (m *MockVolumeServiceIface) ExtractVolume(p *ExtractVolumeParams, opts ...CallOption) (*ExtractVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExtractVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExtractVolume", varargs...)
 	ret0, _ := ret[0].(*ExtractVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExtractVolume indicates an expected call of ExtractVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) ExtractVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) ExtractVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ExtractVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ExtractVolume), varargs...)
 }
 
 // GetPathForVolume mocks base method.
-func (m *MockVolumeServiceIface) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
+func (m *MockVolumeServiceIface) GetPathForVolume(p *GetPathForVolumeParams, opts ...CallOption) (*GetPathForVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPathForVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPathForVolume", varargs...)
 	ret0, _ := ret[0].(*GetPathForVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPathForVolume indicates an expected call of GetPathForVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetPathForVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) GetPathForVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetPathForVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetPathForVolume), varargs...)
 }
 
 // GetSolidFireVolumeSize mocks base method.
-func (m *MockVolumeServiceIface) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
+func (m *MockVolumeServiceIface) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams, opts ...CallOption) (*GetSolidFireVolumeSizeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSolidFireVolumeSize", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSolidFireVolumeSize", varargs...)
 	ret0, _ := ret[0].(*GetSolidFireVolumeSizeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSolidFireVolumeSize indicates an expected call of GetSolidFireVolumeSize.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetSolidFireVolumeSize(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) GetSolidFireVolumeSize(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireVolumeSize", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetSolidFireVolumeSize), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireVolumeSize", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetSolidFireVolumeSize), varargs...)
 }
 
 // GetUploadParamsForVolume mocks base method.
-func (m *MockVolumeServiceIface) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+func (m *MockVolumeServiceIface) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams, opts ...CallOption) (*GetUploadParamsForVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUploadParamsForVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUploadParamsForVolume", varargs...)
 	ret0, _ := ret[0].(*GetUploadParamsForVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUploadParamsForVolume indicates an expected call of GetUploadParamsForVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetUploadParamsForVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) GetUploadParamsForVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetUploadParamsForVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetUploadParamsForVolume), varargs...)
 }
 
 // GetVolumeByID mocks base method.
@@ -266,18 +316,23 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeID(name interface{}, opts
 }
 
 // GetVolumeiScsiName mocks base method.
-func (m *MockVolumeServiceIface) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
+func (m *MockVolumeServiceIface) GetVolumeiScsiName(p *GetVolumeiScsiNameParams, opts ...CallOption) (*GetVolumeiScsiNameResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVolumeiScsiName", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumeiScsiName", varargs...)
 	ret0, _ := ret[0].(*GetVolumeiScsiNameResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVolumeiScsiName indicates an expected call of GetVolumeiScsiName.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeiScsiName(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeiScsiName(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeiScsiName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeiScsiName), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeiScsiName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeiScsiName), varargs...)
 }
 
 // GetVolumesMetricByID mocks base method.
@@ -344,48 +399,63 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricID(name interface{
 }
 
 // ListVolumes mocks base method.
-func (m *MockVolumeServiceIface) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
+func (m *MockVolumeServiceIface) ListVolumes(p *ListVolumesParams, opts ...CallOption) (*ListVolumesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVolumes", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumes", varargs...)
 	ret0, _ := ret[0].(*ListVolumesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVolumes indicates an expected call of ListVolumes.
-func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumes(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumes(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumes), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumes), varargs...)
 }
 
 // ListVolumesMetrics mocks base method.
-func (m *MockVolumeServiceIface) ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+func (m *MockVolumeServiceIface) ListVolumesMetrics(p *ListVolumesMetricsParams, opts ...CallOption) (*ListVolumesMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVolumesMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesMetrics", varargs...)
 	ret0, _ := ret[0].(*ListVolumesMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVolumesMetrics indicates an expected call of ListVolumesMetrics.
-func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetrics(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetrics", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetrics", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetrics), varargs...)
 }
 
 // MigrateVolume mocks base method.
-func (m *MockVolumeServiceIface) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+func (m *MockVolumeServiceIface) MigrateVolume(p *MigrateVolumeParams, opts ...CallOption) (*MigrateVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateVolume", varargs...)
 	ret0, _ := ret[0].(*MigrateVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MigrateVolume indicates an expected call of MigrateVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) MigrateVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) MigrateVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).MigrateVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).MigrateVolume), varargs...)
 }
 
 // NewAttachVolumeParams mocks base method.
@@ -641,61 +711,81 @@ func (mr *MockVolumeServiceIfaceMockRecorder) NewUploadVolumeParams(format, name
 }
 
 // RecoverVolume mocks base method.
-func (m *MockVolumeServiceIface) RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeResponse, error) {
+func (m *MockVolumeServiceIface) RecoverVolume(p *RecoverVolumeParams, opts ...CallOption) (*RecoverVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecoverVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecoverVolume", varargs...)
 	ret0, _ := ret[0].(*RecoverVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RecoverVolume indicates an expected call of RecoverVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) RecoverVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) RecoverVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).RecoverVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).RecoverVolume), varargs...)
 }
 
 // ResizeVolume mocks base method.
-func (m *MockVolumeServiceIface) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+func (m *MockVolumeServiceIface) ResizeVolume(p *ResizeVolumeParams, opts ...CallOption) (*ResizeVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResizeVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResizeVolume", varargs...)
 	ret0, _ := ret[0].(*ResizeVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResizeVolume indicates an expected call of ResizeVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) ResizeVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) ResizeVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ResizeVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ResizeVolume), varargs...)
 }
 
 // UpdateVolume mocks base method.
-func (m *MockVolumeServiceIface) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+func (m *MockVolumeServiceIface) UpdateVolume(p *UpdateVolumeParams, opts ...CallOption) (*UpdateVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVolume", varargs...)
 	ret0, _ := ret[0].(*UpdateVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateVolume indicates an expected call of UpdateVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) UpdateVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) UpdateVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).UpdateVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).UpdateVolume), varargs...)
 }
 
 // UploadVolume mocks base method.
-func (m *MockVolumeServiceIface) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+func (m *MockVolumeServiceIface) UploadVolume(p *UploadVolumeParams, opts ...CallOption) (*UploadVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadVolume", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadVolume", varargs...)
 	ret0, _ := ret[0].(*UploadVolumeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadVolume indicates an expected call of UploadVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) UploadVolume(p interface{}) *gomock.Call {
+func (mr *MockVolumeServiceIfaceMockRecorder) UploadVolume(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).UploadVolume), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).UploadVolume), varargs...)
 }
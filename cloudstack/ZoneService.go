@@ -28,428 +28,600 @@ import (
 )
 
 type ZoneServiceIface interface {
-	CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error)
+	CreateZone(p *CreateZoneParams, opts ...CallOption) (*CreateZoneResponse, error)
 	NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams
-	DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZone(p *DedicateZoneParams, opts ...CallOption) (*DedicateZoneResponse, error)
 	NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams
-	DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	DeleteZone(p *DeleteZoneParams, opts ...CallOption) (*DeleteZoneResponse, error)
 	NewDeleteZoneParams(id string) *DeleteZoneParams
-	DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams, opts ...CallOption) (*DisableOutOfBandManagementForZoneResponse, error)
 	NewDisableOutOfBandManagementForZoneParams(zoneid string) *DisableOutOfBandManagementForZoneParams
-	EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams, opts ...CallOption) (*EnableOutOfBandManagementForZoneResponse, error)
 	NewEnableOutOfBandManagementForZoneParams(zoneid string) *EnableOutOfBandManagementForZoneParams
-	DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error)
+	DisableHAForZone(p *DisableHAForZoneParams, opts ...CallOption) (*DisableHAForZoneResponse, error)
 	NewDisableHAForZoneParams(zoneid string) *DisableHAForZoneParams
-	EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error)
+	EnableHAForZone(p *EnableHAForZoneParams, opts ...CallOption) (*EnableHAForZoneResponse, error)
 	NewEnableHAForZoneParams(zoneid string) *EnableHAForZoneParams
-	ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZones(p *ListDedicatedZonesParams, opts ...CallOption) (*ListDedicatedZonesResponse, error)
 	NewListDedicatedZonesParams() *ListDedicatedZonesParams
-	ListZones(p *ListZonesParams) (*ListZonesResponse, error)
+	ListZones(p *ListZonesParams, opts ...CallOption) (*ListZonesResponse, error)
 	NewListZonesParams() *ListZonesParams
 	GetZoneID(name string, opts ...OptionFunc) (string, int, error)
 	GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error)
 	GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error)
-	ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error)
+	ListZonesMetrics(p *ListZonesMetricsParams, opts ...CallOption) (*ListZonesMetricsResponse, error)
 	NewListZonesMetricsParams() *ListZonesMetricsParams
 	GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error)
 	GetZonesMetricByName(name string, opts ...OptionFunc) (*ZonesMetric, int, error)
 	GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error)
-	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams, opts ...CallOption) (*ReleaseDedicatedZoneResponse, error)
 	NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams
-	UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error)
+	UpdateZone(p *UpdateZoneParams, opts ...CallOption) (*UpdateZoneResponse, error)
 	NewUpdateZoneParams(id string) *UpdateZoneParams
 }
 
 type CreateZoneParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateZoneParams) toURLValues() url.Values {
+	allocationstate      optString
+	dns1                 optString
+	dns2                 optString
+	domain               optString
+	domainid             optString
+	guestcidraddress     optString
+	internaldns1         optString
+	internaldns2         optString
+	ip6dns1              optString
+	ip6dns2              optString
+	isedge               optBool
+	localstorageenabled  optBool
+	name                 optString
+	networktype          optString
+	securitygroupenabled optBool
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *CreateZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["dns1"]; found {
-		u.Set("dns1", v.(string))
+	if p.dns1.ok {
+		u.Set("dns1", p.dns1.v)
 	}
-	if v, found := p.p["dns2"]; found {
-		u.Set("dns2", v.(string))
+	if p.dns2.ok {
+		u.Set("dns2", p.dns2.v)
 	}
-	if v, found := p.p["domain"]; found {
-		u.Set("domain", v.(string))
+	if p.domain.ok {
+		u.Set("domain", p.domain.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["guestcidraddress"]; found {
-		u.Set("guestcidraddress", v.(string))
+	if p.guestcidraddress.ok {
+		u.Set("guestcidraddress", p.guestcidraddress.v)
 	}
-	if v, found := p.p["internaldns1"]; found {
-		u.Set("internaldns1", v.(string))
+	if p.internaldns1.ok {
+		u.Set("internaldns1", p.internaldns1.v)
 	}
-	if v, found := p.p["internaldns2"]; found {
-		u.Set("internaldns2", v.(string))
+	if p.internaldns2.ok {
+		u.Set("internaldns2", p.internaldns2.v)
 	}
-	if v, found := p.p["ip6dns1"]; found {
-		u.Set("ip6dns1", v.(string))
+	if p.ip6dns1.ok {
+		u.Set("ip6dns1", p.ip6dns1.v)
 	}
-	if v, found := p.p["ip6dns2"]; found {
-		u.Set("ip6dns2", v.(string))
+	if p.ip6dns2.ok {
+		u.Set("ip6dns2", p.ip6dns2.v)
 	}
-	if v, found := p.p["isedge"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isedge", vv)
+	if p.isedge.ok {
+		u.Set("isedge", strconv.FormatBool(p.isedge.v))
 	}
-	if v, found := p.p["localstorageenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("localstorageenabled", vv)
+	if p.localstorageenabled.ok {
+		u.Set("localstorageenabled", strconv.FormatBool(p.localstorageenabled.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networktype"]; found {
-		u.Set("networktype", v.(string))
+	if p.networktype.ok {
+		u.Set("networktype", p.networktype.v)
 	}
-	if v, found := p.p["securitygroupenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("securitygroupenabled", vv)
+	if p.securitygroupenabled.ok {
+		u.Set("securitygroupenabled", strconv.FormatBool(p.securitygroupenabled.v))
 	}
 	return u
 }
 
-func (p *CreateZoneParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseCreateZoneParams parses url.Values, for example taken from a raw API request,
+// into a new CreateZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseCreateZoneParams(u url.Values) (*CreateZoneParams, error) {
+	p := &CreateZoneParams{}
+	if err := checkParamNames("createZone", u, "allocationstate", "dns1", "dns2", "domain", "domainid", "guestcidraddress", "internaldns1", "internaldns2", "ip6dns1", "ip6dns2", "isedge", "localstorageenabled", "name", "networktype", "securitygroupenabled"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if _, found := u["dns1"]; found {
+		p.SetDns1(u.Get("dns1"))
+	}
+	if _, found := u["dns2"]; found {
+		p.SetDns2(u.Get("dns2"))
+	}
+	if _, found := u["domain"]; found {
+		p.SetDomain(u.Get("domain"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["guestcidraddress"]; found {
+		p.SetGuestcidraddress(u.Get("guestcidraddress"))
+	}
+	if _, found := u["internaldns1"]; found {
+		p.SetInternaldns1(u.Get("internaldns1"))
+	}
+	if _, found := u["internaldns2"]; found {
+		p.SetInternaldns2(u.Get("internaldns2"))
+	}
+	if _, found := u["ip6dns1"]; found {
+		p.SetIp6dns1(u.Get("ip6dns1"))
+	}
+	if _, found := u["ip6dns2"]; found {
+		p.SetIp6dns2(u.Get("ip6dns2"))
+	}
+	if _, found := u["isedge"]; found {
+		v, err := strconv.ParseBool(u.Get("isedge"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param isedge: %v", err)
+		}
+		p.SetIsedge(v)
+	}
+	if _, found := u["localstorageenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("localstorageenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param localstorageenabled: %v", err)
+		}
+		p.SetLocalstorageenabled(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networktype"]; found {
+		p.SetNetworktype(u.Get("networktype"))
+	}
+	if _, found := u["securitygroupenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("securitygroupenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param securitygroupenabled: %v", err)
+		}
+		p.SetSecuritygroupenabled(v)
 	}
-	p.p["allocationstate"] = v
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *CreateZoneParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *CreateZoneParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *CreateZoneParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetDns1 sets the dns1 param. This param is required.
 func (p *CreateZoneParams) SetDns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns1"] = v
+	p.dns1 = optString{v: v, ok: true}
 }
 
+// ResetDns1 unsets the dns1 param
 func (p *CreateZoneParams) ResetDns1() {
-	if p.p != nil && p.p["dns1"] != nil {
-		delete(p.p, "dns1")
-	}
+	p.dns1 = optString{}
 }
 
+// GetDns1 returns the dns1 param and if it is set
 func (p *CreateZoneParams) GetDns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns1"].(string)
-	return value, ok
+	return p.dns1.v, p.dns1.ok
 }
 
+// SetDns2 sets the dns2 param.
 func (p *CreateZoneParams) SetDns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns2"] = v
+	p.dns2 = optString{v: v, ok: true}
 }
 
+// ResetDns2 unsets the dns2 param
 func (p *CreateZoneParams) ResetDns2() {
-	if p.p != nil && p.p["dns2"] != nil {
-		delete(p.p, "dns2")
-	}
+	p.dns2 = optString{}
 }
 
+// GetDns2 returns the dns2 param and if it is set
 func (p *CreateZoneParams) GetDns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns2"].(string)
-	return value, ok
+	return p.dns2.v, p.dns2.ok
 }
 
+// SetDomain sets the domain param.
 func (p *CreateZoneParams) SetDomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domain"] = v
+	p.domain = optString{v: v, ok: true}
 }
 
+// ResetDomain unsets the domain param
 func (p *CreateZoneParams) ResetDomain() {
-	if p.p != nil && p.p["domain"] != nil {
-		delete(p.p, "domain")
-	}
+	p.domain = optString{}
 }
 
+// GetDomain returns the domain param and if it is set
 func (p *CreateZoneParams) GetDomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domain"].(string)
-	return value, ok
+	return p.domain.v, p.domain.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *CreateZoneParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *CreateZoneParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *CreateZoneParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetGuestcidraddress sets the guestcidraddress param.
 func (p *CreateZoneParams) SetGuestcidraddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestcidraddress"] = v
+	p.guestcidraddress = optString{v: v, ok: true}
 }
 
+// ResetGuestcidraddress unsets the guestcidraddress param
 func (p *CreateZoneParams) ResetGuestcidraddress() {
-	if p.p != nil && p.p["guestcidraddress"] != nil {
-		delete(p.p, "guestcidraddress")
-	}
+	p.guestcidraddress = optString{}
 }
 
+// GetGuestcidraddress returns the guestcidraddress param and if it is set
 func (p *CreateZoneParams) GetGuestcidraddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestcidraddress"].(string)
-	return value, ok
+	return p.guestcidraddress.v, p.guestcidraddress.ok
 }
 
+// SetInternaldns1 sets the internaldns1 param. This param is required.
 func (p *CreateZoneParams) SetInternaldns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internaldns1"] = v
+	p.internaldns1 = optString{v: v, ok: true}
 }
 
+// ResetInternaldns1 unsets the internaldns1 param
 func (p *CreateZoneParams) ResetInternaldns1() {
-	if p.p != nil && p.p["internaldns1"] != nil {
-		delete(p.p, "internaldns1")
-	}
+	p.internaldns1 = optString{}
 }
 
+// GetInternaldns1 returns the internaldns1 param and if it is set
 func (p *CreateZoneParams) GetInternaldns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["internaldns1"].(string)
-	return value, ok
+	return p.internaldns1.v, p.internaldns1.ok
 }
 
+// SetInternaldns2 sets the internaldns2 param.
 func (p *CreateZoneParams) SetInternaldns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internaldns2"] = v
+	p.internaldns2 = optString{v: v, ok: true}
 }
 
+// ResetInternaldns2 unsets the internaldns2 param
 func (p *CreateZoneParams) ResetInternaldns2() {
-	if p.p != nil && p.p["internaldns2"] != nil {
-		delete(p.p, "internaldns2")
-	}
+	p.internaldns2 = optString{}
 }
 
+// GetInternaldns2 returns the internaldns2 param and if it is set
 func (p *CreateZoneParams) GetInternaldns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["internaldns2"].(string)
-	return value, ok
+	return p.internaldns2.v, p.internaldns2.ok
 }
 
+// SetIp6dns1 sets the ip6dns1 param.
 func (p *CreateZoneParams) SetIp6dns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns1"] = v
+	p.ip6dns1 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns1 unsets the ip6dns1 param
 func (p *CreateZoneParams) ResetIp6dns1() {
-	if p.p != nil && p.p["ip6dns1"] != nil {
-		delete(p.p, "ip6dns1")
-	}
+	p.ip6dns1 = optString{}
 }
 
+// GetIp6dns1 returns the ip6dns1 param and if it is set
 func (p *CreateZoneParams) GetIp6dns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns1"].(string)
-	return value, ok
+	return p.ip6dns1.v, p.ip6dns1.ok
 }
 
+// SetIp6dns2 sets the ip6dns2 param.
 func (p *CreateZoneParams) SetIp6dns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns2"] = v
+	p.ip6dns2 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns2 unsets the ip6dns2 param
 func (p *CreateZoneParams) ResetIp6dns2() {
-	if p.p != nil && p.p["ip6dns2"] != nil {
-		delete(p.p, "ip6dns2")
-	}
+	p.ip6dns2 = optString{}
 }
 
+// GetIp6dns2 returns the ip6dns2 param and if it is set
 func (p *CreateZoneParams) GetIp6dns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns2"].(string)
-	return value, ok
+	return p.ip6dns2.v, p.ip6dns2.ok
 }
 
+// SetIsedge sets the isedge param.
 func (p *CreateZoneParams) SetIsedge(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isedge"] = v
+	p.isedge = optBool{v: v, ok: true}
 }
 
+// ResetIsedge unsets the isedge param
 func (p *CreateZoneParams) ResetIsedge() {
-	if p.p != nil && p.p["isedge"] != nil {
-		delete(p.p, "isedge")
-	}
+	p.isedge = optBool{}
 }
 
+// GetIsedge returns the isedge param and if it is set
 func (p *CreateZoneParams) GetIsedge() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["isedge"].(bool)
-	return value, ok
+	return p.isedge.v, p.isedge.ok
 }
 
+// SetLocalstorageenabled sets the localstorageenabled param.
 func (p *CreateZoneParams) SetLocalstorageenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["localstorageenabled"] = v
+	p.localstorageenabled = optBool{v: v, ok: true}
 }
 
+// ResetLocalstorageenabled unsets the localstorageenabled param
 func (p *CreateZoneParams) ResetLocalstorageenabled() {
-	if p.p != nil && p.p["localstorageenabled"] != nil {
-		delete(p.p, "localstorageenabled")
-	}
+	p.localstorageenabled = optBool{}
 }
 
+// GetLocalstorageenabled returns the localstorageenabled param and if it is set
 func (p *CreateZoneParams) GetLocalstorageenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["localstorageenabled"].(bool)
-	return value, ok
+	return p.localstorageenabled.v, p.localstorageenabled.ok
 }
 
+// SetName sets the name param. This param is required.
 func (p *CreateZoneParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *CreateZoneParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *CreateZoneParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworktype sets the networktype param. This param is required.
 func (p *CreateZoneParams) SetNetworktype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networktype"] = v
+	p.networktype = optString{v: v, ok: true}
 }
 
+// ResetNetworktype unsets the networktype param
 func (p *CreateZoneParams) ResetNetworktype() {
-	if p.p != nil && p.p["networktype"] != nil {
-		delete(p.p, "networktype")
-	}
+	p.networktype = optString{}
 }
 
+// GetNetworktype returns the networktype param and if it is set
 func (p *CreateZoneParams) GetNetworktype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networktype"].(string)
-	return value, ok
+	return p.networktype.v, p.networktype.ok
 }
 
+// SetSecuritygroupenabled sets the securitygroupenabled param.
 func (p *CreateZoneParams) SetSecuritygroupenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupenabled"] = v
+	p.securitygroupenabled = optBool{v: v, ok: true}
 }
 
+// ResetSecuritygroupenabled unsets the securitygroupenabled param
 func (p *CreateZoneParams) ResetSecuritygroupenabled() {
-	if p.p != nil && p.p["securitygroupenabled"] != nil {
-		delete(p.p, "securitygroupenabled")
-	}
+	p.securitygroupenabled = optBool{}
 }
 
+// GetSecuritygroupenabled returns the securitygroupenabled param and if it is set
 func (p *CreateZoneParams) GetSecuritygroupenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.securitygroupenabled.v, p.securitygroupenabled.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *CreateZoneParams) Clone() *CreateZoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *CreateZoneParams) Equal(o *CreateZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.dns1 == o.dns1 &&
+		p.dns2 == o.dns2 &&
+		p.domain == o.domain &&
+		p.domainid == o.domainid &&
+		p.guestcidraddress == o.guestcidraddress &&
+		p.internaldns1 == o.internaldns1 &&
+		p.internaldns2 == o.internaldns2 &&
+		p.ip6dns1 == o.ip6dns1 &&
+		p.ip6dns2 == o.ip6dns2 &&
+		p.isedge == o.isedge &&
+		p.localstorageenabled == o.localstorageenabled &&
+		p.name == o.name &&
+		p.networktype == o.networktype &&
+		p.securitygroupenabled == o.securitygroupenabled
+}
+
+// serializedCreateZoneParams is used to (un)marshal CreateZoneParams using the API param names
+type serializedCreateZoneParams struct {
+	Allocationstate      *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Dns1                 *string `json:"dns1,omitempty" yaml:"dns1,omitempty"`
+	Dns2                 *string `json:"dns2,omitempty" yaml:"dns2,omitempty"`
+	Domain               *string `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Domainid             *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Guestcidraddress     *string `json:"guestcidraddress,omitempty" yaml:"guestcidraddress,omitempty"`
+	Internaldns1         *string `json:"internaldns1,omitempty" yaml:"internaldns1,omitempty"`
+	Internaldns2         *string `json:"internaldns2,omitempty" yaml:"internaldns2,omitempty"`
+	Ip6dns1              *string `json:"ip6dns1,omitempty" yaml:"ip6dns1,omitempty"`
+	Ip6dns2              *string `json:"ip6dns2,omitempty" yaml:"ip6dns2,omitempty"`
+	Isedge               *bool   `json:"isedge,omitempty" yaml:"isedge,omitempty"`
+	Localstorageenabled  *bool   `json:"localstorageenabled,omitempty" yaml:"localstorageenabled,omitempty"`
+	Name                 *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networktype          *string `json:"networktype,omitempty" yaml:"networktype,omitempty"`
+	Securitygroupenabled *bool   `json:"securitygroupenabled,omitempty" yaml:"securitygroupenabled,omitempty"`
+}
+
+func (p *CreateZoneParams) toSerialized() *serializedCreateZoneParams {
+	s := &serializedCreateZoneParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.dns1.ok {
+		s.Dns1 = &p.dns1.v
+	}
+	if p.dns2.ok {
+		s.Dns2 = &p.dns2.v
+	}
+	if p.domain.ok {
+		s.Domain = &p.domain.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.guestcidraddress.ok {
+		s.Guestcidraddress = &p.guestcidraddress.v
+	}
+	if p.internaldns1.ok {
+		s.Internaldns1 = &p.internaldns1.v
+	}
+	if p.internaldns2.ok {
+		s.Internaldns2 = &p.internaldns2.v
+	}
+	if p.ip6dns1.ok {
+		s.Ip6dns1 = &p.ip6dns1.v
+	}
+	if p.ip6dns2.ok {
+		s.Ip6dns2 = &p.ip6dns2.v
+	}
+	if p.isedge.ok {
+		s.Isedge = &p.isedge.v
+	}
+	if p.localstorageenabled.ok {
+		s.Localstorageenabled = &p.localstorageenabled.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networktype.ok {
+		s.Networktype = &p.networktype.v
+	}
+	if p.securitygroupenabled.ok {
+		s.Securitygroupenabled = &p.securitygroupenabled.v
+	}
+	return s
+}
+
+func (p *CreateZoneParams) fromSerialized(s *serializedCreateZoneParams) {
+	*p = CreateZoneParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Dns1 != nil {
+		p.SetDns1(*s.Dns1)
+	}
+	if s.Dns2 != nil {
+		p.SetDns2(*s.Dns2)
+	}
+	if s.Domain != nil {
+		p.SetDomain(*s.Domain)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Guestcidraddress != nil {
+		p.SetGuestcidraddress(*s.Guestcidraddress)
+	}
+	if s.Internaldns1 != nil {
+		p.SetInternaldns1(*s.Internaldns1)
+	}
+	if s.Internaldns2 != nil {
+		p.SetInternaldns2(*s.Internaldns2)
+	}
+	if s.Ip6dns1 != nil {
+		p.SetIp6dns1(*s.Ip6dns1)
+	}
+	if s.Ip6dns2 != nil {
+		p.SetIp6dns2(*s.Ip6dns2)
+	}
+	if s.Isedge != nil {
+		p.SetIsedge(*s.Isedge)
+	}
+	if s.Localstorageenabled != nil {
+		p.SetLocalstorageenabled(*s.Localstorageenabled)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networktype != nil {
+		p.SetNetworktype(*s.Networktype)
+	}
+	if s.Securitygroupenabled != nil {
+		p.SetSecuritygroupenabled(*s.Securitygroupenabled)
 	}
-	value, ok := p.p["securitygroupenabled"].(bool)
-	return value, ok
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p CreateZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *CreateZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedCreateZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p CreateZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *CreateZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedCreateZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new CreateZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams {
 	p := &CreateZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["dns1"] = dns1
-	p.p["internaldns1"] = internaldns1
-	p.p["name"] = name
-	p.p["networktype"] = networktype
+	p.SetDns1(dns1)
+	p.SetInternaldns1(internaldns1)
+	p.SetName(name)
+	p.SetNetworktype(networktype)
 	return p
 }
 
 // Creates a Zone.
-func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
-	resp, err := s.cs.newRequest("createZone", p.toURLValues())
+//
+// Required params: dns1, internaldns1, name, networktype.
+func (s *ZoneService) CreateZone(p *CreateZoneParams, opts ...CallOption) (*CreateZoneResponse, error) {
+	resp, err := s.cs.newRequest("createZone", s.cs.encodeParams("createZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -516,102 +688,194 @@ type CreateZoneResponseCapacity struct {
 }
 
 type DedicateZoneParams struct {
-	p map[string]interface{}
+	account  optString
+	domainid optString
+	zoneid   optString
 }
 
-func (p *DedicateZoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DedicateZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *DedicateZoneParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDedicateZoneParams parses url.Values, for example taken from a raw API request,
+// into a new DedicateZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDedicateZoneParams(u url.Values) (*DedicateZoneParams, error) {
+	p := &DedicateZoneParams{}
+	if err := checkParamNames("dedicateZone", u, "account", "domainid", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["account"] = v
+	return p, nil
 }
 
+// SetAccount sets the account param.
+func (p *DedicateZoneParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
+}
+
+// ResetAccount unsets the account param
 func (p *DedicateZoneParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *DedicateZoneParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetDomainid sets the domainid param. This param is required.
 func (p *DedicateZoneParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *DedicateZoneParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *DedicateZoneParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetZoneid sets the zoneid param. This param is required.
 func (p *DedicateZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *DedicateZoneParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *DedicateZoneParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DedicateZoneParams) Clone() *DedicateZoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DedicateZoneParams) Equal(o *DedicateZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.domainid == o.domainid &&
+		p.zoneid == o.zoneid
+}
+
+// serializedDedicateZoneParams is used to (un)marshal DedicateZoneParams using the API param names
+type serializedDedicateZoneParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *DedicateZoneParams) toSerialized() *serializedDedicateZoneParams {
+	s := &serializedDedicateZoneParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *DedicateZoneParams) fromSerialized(s *serializedDedicateZoneParams) {
+	*p = DedicateZoneParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DedicateZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DedicateZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedDedicateZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DedicateZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DedicateZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDedicateZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DedicateZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams {
 	p := &DedicateZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
-	p.p["zoneid"] = zoneid
+	p.SetDomainid(domainid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Dedicates a zones.
-func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
-	resp, err := s.cs.newRequest("dedicateZone", p.toURLValues())
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: domainid, zoneid.
+func (s *ZoneService) DedicateZone(p *DedicateZoneParams, opts ...CallOption) (*DedicateZoneResponse, error) {
+	resp, err := s.cs.newRequest("dedicateZone", s.cs.encodeParams("dedicateZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -622,8 +886,8 @@ func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -656,53 +920,132 @@ type DedicateZoneResponse struct {
 }
 
 type DeleteZoneParams struct {
-	p map[string]interface{}
+	id optString
 }
 
-func (p *DeleteZoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DeleteZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
 	return u
 }
 
-func (p *DeleteZoneParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDeleteZoneParams parses url.Values, for example taken from a raw API request,
+// into a new DeleteZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDeleteZoneParams(u url.Values) (*DeleteZoneParams, error) {
+	p := &DeleteZoneParams{}
+	if err := checkParamNames("deleteZone", u, "id"); err != nil {
+		return nil, err
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
 	}
-	p.p["id"] = v
+	return p, nil
+}
+
+// SetId sets the id param. This param is required.
+func (p *DeleteZoneParams) SetId(v string) {
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *DeleteZoneParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *DeleteZoneParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.id.v, p.id.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DeleteZoneParams) Clone() *DeleteZoneParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DeleteZoneParams) Equal(o *DeleteZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.id == o.id
+}
+
+// serializedDeleteZoneParams is used to (un)marshal DeleteZoneParams using the API param names
+type serializedDeleteZoneParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+func (p *DeleteZoneParams) toSerialized() *serializedDeleteZoneParams {
+	s := &serializedDeleteZoneParams{}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	return s
+}
+
+func (p *DeleteZoneParams) fromSerialized(s *serializedDeleteZoneParams) {
+	*p = DeleteZoneParams{}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DeleteZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DeleteZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedDeleteZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DeleteZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DeleteZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDeleteZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DeleteZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
 	p := &DeleteZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a Zone.
-func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
-	resp, err := s.cs.newRequest("deleteZone", p.toURLValues())
+//
+// Required params: id.
+func (s *ZoneService) DeleteZone(p *DeleteZoneParams, opts ...CallOption) (*DeleteZoneResponse, error) {
+	resp, err := s.cs.newRequest("deleteZone", s.cs.encodeParams("deleteZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -750,53 +1093,133 @@ func (r *DeleteZoneResponse) UnmarshalJSON(b []byte) error {
 }
 
 type DisableOutOfBandManagementForZoneParams struct {
-	p map[string]interface{}
+	zoneid optString
 }
 
-func (p *DisableOutOfBandManagementForZoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableOutOfBandManagementForZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *DisableOutOfBandManagementForZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableOutOfBandManagementForZoneParams parses url.Values, for example taken from a raw API request,
+// into a new DisableOutOfBandManagementForZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableOutOfBandManagementForZoneParams(u url.Values) (*DisableOutOfBandManagementForZoneParams, error) {
+	p := &DisableOutOfBandManagementForZoneParams{}
+	if err := checkParamNames("disableOutOfBandManagementForZone", u, "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["zoneid"] = v
+	return p, nil
 }
 
+// SetZoneid sets the zoneid param. This param is required.
+func (p *DisableOutOfBandManagementForZoneParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
 func (p *DisableOutOfBandManagementForZoneParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *DisableOutOfBandManagementForZoneParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableOutOfBandManagementForZoneParams) Clone() *DisableOutOfBandManagementForZoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableOutOfBandManagementForZoneParams) Equal(o *DisableOutOfBandManagementForZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.zoneid == o.zoneid
+}
+
+// serializedDisableOutOfBandManagementForZoneParams is used to (un)marshal DisableOutOfBandManagementForZoneParams using the API param names
+type serializedDisableOutOfBandManagementForZoneParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *DisableOutOfBandManagementForZoneParams) toSerialized() *serializedDisableOutOfBandManagementForZoneParams {
+	s := &serializedDisableOutOfBandManagementForZoneParams{}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *DisableOutOfBandManagementForZoneParams) fromSerialized(s *serializedDisableOutOfBandManagementForZoneParams) {
+	*p = DisableOutOfBandManagementForZoneParams{}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableOutOfBandManagementForZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableOutOfBandManagementForZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableOutOfBandManagementForZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableOutOfBandManagementForZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableOutOfBandManagementForZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableOutOfBandManagementForZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableOutOfBandManagementForZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewDisableOutOfBandManagementForZoneParams(zoneid string) *DisableOutOfBandManagementForZoneParams {
 	p := &DisableOutOfBandManagementForZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Disables out-of-band management for a zone
-func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForZone", p.toURLValues())
+// Disables out-of-band management for a zone.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: zoneid.
+func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams, opts ...CallOption) (*DisableOutOfBandManagementForZoneResponse, error) {
+	resp, err := s.cs.newRequest("disableOutOfBandManagementForZone", s.cs.encodeParams("disableOutOfBandManagementForZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -807,8 +1230,8 @@ func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManag
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -846,53 +1269,133 @@ type DisableOutOfBandManagementForZoneResponse struct {
 }
 
 type EnableOutOfBandManagementForZoneParams struct {
-	p map[string]interface{}
+	zoneid optString
 }
 
-func (p *EnableOutOfBandManagementForZoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableOutOfBandManagementForZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *EnableOutOfBandManagementForZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableOutOfBandManagementForZoneParams parses url.Values, for example taken from a raw API request,
+// into a new EnableOutOfBandManagementForZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableOutOfBandManagementForZoneParams(u url.Values) (*EnableOutOfBandManagementForZoneParams, error) {
+	p := &EnableOutOfBandManagementForZoneParams{}
+	if err := checkParamNames("enableOutOfBandManagementForZone", u, "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["zoneid"] = v
+	return p, nil
 }
 
+// SetZoneid sets the zoneid param. This param is required.
+func (p *EnableOutOfBandManagementForZoneParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
 func (p *EnableOutOfBandManagementForZoneParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *EnableOutOfBandManagementForZoneParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableOutOfBandManagementForZoneParams) Clone() *EnableOutOfBandManagementForZoneParams {
+	if p == nil {
+		return nil
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableOutOfBandManagementForZoneParams) Equal(o *EnableOutOfBandManagementForZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.zoneid == o.zoneid
+}
+
+// serializedEnableOutOfBandManagementForZoneParams is used to (un)marshal EnableOutOfBandManagementForZoneParams using the API param names
+type serializedEnableOutOfBandManagementForZoneParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *EnableOutOfBandManagementForZoneParams) toSerialized() *serializedEnableOutOfBandManagementForZoneParams {
+	s := &serializedEnableOutOfBandManagementForZoneParams{}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *EnableOutOfBandManagementForZoneParams) fromSerialized(s *serializedEnableOutOfBandManagementForZoneParams) {
+	*p = EnableOutOfBandManagementForZoneParams{}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableOutOfBandManagementForZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableOutOfBandManagementForZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableOutOfBandManagementForZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableOutOfBandManagementForZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableOutOfBandManagementForZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableOutOfBandManagementForZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableOutOfBandManagementForZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewEnableOutOfBandManagementForZoneParams(zoneid string) *EnableOutOfBandManagementForZoneParams {
 	p := &EnableOutOfBandManagementForZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Enables out-of-band management for a zone
-func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForZone", p.toURLValues())
+// Enables out-of-band management for a zone.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: zoneid.
+func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams, opts ...CallOption) (*EnableOutOfBandManagementForZoneResponse, error) {
+	resp, err := s.cs.newRequest("enableOutOfBandManagementForZone", s.cs.encodeParams("enableOutOfBandManagementForZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -903,8 +1406,8 @@ func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagem
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -942,53 +1445,133 @@ type EnableOutOfBandManagementForZoneResponse struct {
 }
 
 type DisableHAForZoneParams struct {
-	p map[string]interface{}
+	zoneid optString
 }
 
-func (p *DisableHAForZoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *DisableHAForZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *DisableHAForZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseDisableHAForZoneParams parses url.Values, for example taken from a raw API request,
+// into a new DisableHAForZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseDisableHAForZoneParams(u url.Values) (*DisableHAForZoneParams, error) {
+	p := &DisableHAForZoneParams{}
+	if err := checkParamNames("disableHAForZone", u, "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetZoneid sets the zoneid param. This param is required.
+func (p *DisableHAForZoneParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
+}
+
+// ResetZoneid unsets the zoneid param
+func (p *DisableHAForZoneParams) ResetZoneid() {
+	p.zoneid = optString{}
+}
+
+// GetZoneid returns the zoneid param and if it is set
+func (p *DisableHAForZoneParams) GetZoneid() (string, bool) {
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *DisableHAForZoneParams) Clone() *DisableHAForZoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *DisableHAForZoneParams) Equal(o *DisableHAForZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.zoneid == o.zoneid
+}
+
+// serializedDisableHAForZoneParams is used to (un)marshal DisableHAForZoneParams using the API param names
+type serializedDisableHAForZoneParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *DisableHAForZoneParams) toSerialized() *serializedDisableHAForZoneParams {
+	s := &serializedDisableHAForZoneParams{}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *DisableHAForZoneParams) fromSerialized(s *serializedDisableHAForZoneParams) {
+	*p = DisableHAForZoneParams{}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
 	}
-	p.p["zoneid"] = v
 }
 
-func (p *DisableHAForZoneParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p DisableHAForZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *DisableHAForZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedDisableHAForZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
 	}
+	p.fromSerialized(&s)
+	return nil
 }
 
-func (p *DisableHAForZoneParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p DisableHAForZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *DisableHAForZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedDisableHAForZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new DisableHAForZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewDisableHAForZoneParams(zoneid string) *DisableHAForZoneParams {
 	p := &DisableHAForZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Disables HA for a zone
-func (s *ZoneService) DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
-	resp, err := s.cs.newRequest("disableHAForZone", p.toURLValues())
+// Disables HA for a zone.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: zoneid.
+func (s *ZoneService) DisableHAForZone(p *DisableHAForZoneParams, opts ...CallOption) (*DisableHAForZoneResponse, error) {
+	resp, err := s.cs.newRequest("disableHAForZone", s.cs.encodeParams("disableHAForZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -999,8 +1582,8 @@ func (s *ZoneService) DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAFor
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1024,53 +1607,133 @@ type DisableHAForZoneResponse struct {
 }
 
 type EnableHAForZoneParams struct {
-	p map[string]interface{}
+	zoneid optString
 }
 
-func (p *EnableHAForZoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *EnableHAForZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *EnableHAForZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseEnableHAForZoneParams parses url.Values, for example taken from a raw API request,
+// into a new EnableHAForZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseEnableHAForZoneParams(u url.Values) (*EnableHAForZoneParams, error) {
+	p := &EnableHAForZoneParams{}
+	if err := checkParamNames("enableHAForZone", u, "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
 	}
-	p.p["zoneid"] = v
+	return p, nil
+}
+
+// SetZoneid sets the zoneid param. This param is required.
+func (p *EnableHAForZoneParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *EnableHAForZoneParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *EnableHAForZoneParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *EnableHAForZoneParams) Clone() *EnableHAForZoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *EnableHAForZoneParams) Equal(o *EnableHAForZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.zoneid == o.zoneid
+}
+
+// serializedEnableHAForZoneParams is used to (un)marshal EnableHAForZoneParams using the API param names
+type serializedEnableHAForZoneParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *EnableHAForZoneParams) toSerialized() *serializedEnableHAForZoneParams {
+	s := &serializedEnableHAForZoneParams{}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *EnableHAForZoneParams) fromSerialized(s *serializedEnableHAForZoneParams) {
+	*p = EnableHAForZoneParams{}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p EnableHAForZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *EnableHAForZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedEnableHAForZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p EnableHAForZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *EnableHAForZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedEnableHAForZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new EnableHAForZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewEnableHAForZoneParams(zoneid string) *EnableHAForZoneParams {
 	p := &EnableHAForZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Enables HA for a zone
-func (s *ZoneService) EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
-	resp, err := s.cs.newRequest("enableHAForZone", p.toURLValues())
+// Enables HA for a zone.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: zoneid.
+func (s *ZoneService) EnableHAForZone(p *EnableHAForZoneParams, opts ...CallOption) (*EnableHAForZoneResponse, error) {
+	resp, err := s.cs.newRequest("enableHAForZone", s.cs.encodeParams("enableHAForZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1081,8 +1744,8 @@ func (s *ZoneService) EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZon
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1106,198 +1769,317 @@ type EnableHAForZoneResponse struct {
 }
 
 type ListDedicatedZonesParams struct {
-	p map[string]interface{}
+	account         optString
+	affinitygroupid optString
+	domainid        optString
+	keyword         optString
+	page            optInt
+	pagesize        optInt
+	zoneid          optString
 }
 
-func (p *ListDedicatedZonesParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListDedicatedZonesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if p.account.ok {
+		u.Set("account", p.account.v)
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if p.affinitygroupid.ok {
+		u.Set("affinitygroupid", p.affinitygroupid.v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ListDedicatedZonesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListDedicatedZonesParams parses url.Values, for example taken from a raw API request,
+// into a new ListDedicatedZonesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListDedicatedZonesParams(u url.Values) (*ListDedicatedZonesParams, error) {
+	p := &ListDedicatedZonesParams{}
+	if err := checkParamNames("listDedicatedZones", u, "account", "affinitygroupid", "domainid", "keyword", "page", "pagesize", "zoneid"); err != nil {
+		return nil, err
+	}
+	if _, found := u["account"]; found {
+		p.SetAccount(u.Get("account"))
 	}
-	p.p["account"] = v
+	if _, found := u["affinitygroupid"]; found {
+		p.SetAffinitygroupid(u.Get("affinitygroupid"))
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetAccount sets the account param.
+func (p *ListDedicatedZonesParams) SetAccount(v string) {
+	p.account = optString{v: v, ok: true}
 }
 
+// ResetAccount unsets the account param
 func (p *ListDedicatedZonesParams) ResetAccount() {
-	if p.p != nil && p.p["account"] != nil {
-		delete(p.p, "account")
-	}
+	p.account = optString{}
 }
 
+// GetAccount returns the account param and if it is set
 func (p *ListDedicatedZonesParams) GetAccount() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["account"].(string)
-	return value, ok
+	return p.account.v, p.account.ok
 }
 
+// SetAffinitygroupid sets the affinitygroupid param.
 func (p *ListDedicatedZonesParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
+	p.affinitygroupid = optString{v: v, ok: true}
 }
 
+// ResetAffinitygroupid unsets the affinitygroupid param
 func (p *ListDedicatedZonesParams) ResetAffinitygroupid() {
-	if p.p != nil && p.p["affinitygroupid"] != nil {
-		delete(p.p, "affinitygroupid")
-	}
+	p.affinitygroupid = optString{}
 }
 
+// GetAffinitygroupid returns the affinitygroupid param and if it is set
 func (p *ListDedicatedZonesParams) GetAffinitygroupid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["affinitygroupid"].(string)
-	return value, ok
+	return p.affinitygroupid.v, p.affinitygroupid.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListDedicatedZonesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListDedicatedZonesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListDedicatedZonesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListDedicatedZonesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListDedicatedZonesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListDedicatedZonesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetPage sets the page param.
 func (p *ListDedicatedZonesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListDedicatedZonesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListDedicatedZonesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListDedicatedZonesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListDedicatedZonesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListDedicatedZonesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetZoneid sets the zoneid param.
 func (p *ListDedicatedZonesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ListDedicatedZonesParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ListDedicatedZonesParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListDedicatedZonesParams) Clone() *ListDedicatedZonesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListDedicatedZonesParams) Equal(o *ListDedicatedZonesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.account == o.account &&
+		p.affinitygroupid == o.affinitygroupid &&
+		p.domainid == o.domainid &&
+		p.keyword == o.keyword &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.zoneid == o.zoneid
+}
+
+// serializedListDedicatedZonesParams is used to (un)marshal ListDedicatedZonesParams using the API param names
+type serializedListDedicatedZonesParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ListDedicatedZonesParams) toSerialized() *serializedListDedicatedZonesParams {
+	s := &serializedListDedicatedZonesParams{}
+	if p.account.ok {
+		s.Account = &p.account.v
+	}
+	if p.affinitygroupid.ok {
+		s.Affinitygroupid = &p.affinitygroupid.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	return s
+}
+
+func (p *ListDedicatedZonesParams) fromSerialized(s *serializedListDedicatedZonesParams) {
+	*p = ListDedicatedZonesParams{}
+	if s.Account != nil {
+		p.SetAccount(*s.Account)
+	}
+	if s.Affinitygroupid != nil {
+		p.SetAffinitygroupid(*s.Affinitygroupid)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListDedicatedZonesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListDedicatedZonesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListDedicatedZonesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListDedicatedZonesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListDedicatedZonesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListDedicatedZonesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListDedicatedZonesParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
 	p := &ListDedicatedZonesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // List dedicated zones.
-func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedZones", p.toURLValues())
+func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams, opts ...CallOption) (*ListDedicatedZonesResponse, error) {
+	resp, err := s.cs.newRequest("listDedicatedZones", s.cs.encodeParams("listDedicatedZones", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1327,55 +2109,61 @@ type DedicatedZone struct {
 }
 
 type ListZonesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListZonesParams) toURLValues() url.Values {
+	available      optBool
+	domainid       optString
+	id             optString
+	ids            optStrings
+	keyword        optString
+	name           optString
+	networktype    optString
+	page           optInt
+	pagesize       optInt
+	showcapacities optBool
+	showicon       optBool
+	tags           optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListZonesParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["available"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("available", vv)
+	if p.available.ok {
+		u.Set("available", strconv.FormatBool(p.available.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networktype"]; found {
-		u.Set("networktype", v.(string))
+	if p.networktype.ok {
+		u.Set("networktype", p.networktype.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
+	if p.showcapacities.ok {
+		u.Set("showcapacities", strconv.FormatBool(p.showcapacities.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
@@ -1384,272 +2172,426 @@ func (p *ListZonesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListZonesParams) SetAvailable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseListZonesParams parses url.Values, for example taken from a raw API request,
+// into a new ListZonesParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListZonesParams(u url.Values) (*ListZonesParams, error) {
+	p := &ListZonesParams{}
+	if err := checkParamNames("listZones", u, "available", "domainid", "id", "ids", "keyword", "name", "networktype", "page", "pagesize", "showcapacities", "showicon", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["available"]; found {
+		v, err := strconv.ParseBool(u.Get("available"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param available: %v", err)
+		}
+		p.SetAvailable(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
 	}
-	p.p["available"] = v
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networktype"]; found {
+		p.SetNetworktype(u.Get("networktype"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showcapacities"]; found {
+		v, err := strconv.ParseBool(u.Get("showcapacities"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showcapacities: %v", err)
+		}
+		p.SetShowcapacities(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
+}
+
+// SetAvailable sets the available param.
+func (p *ListZonesParams) SetAvailable(v bool) {
+	p.available = optBool{v: v, ok: true}
 }
 
+// ResetAvailable unsets the available param
 func (p *ListZonesParams) ResetAvailable() {
-	if p.p != nil && p.p["available"] != nil {
-		delete(p.p, "available")
-	}
+	p.available = optBool{}
 }
 
+// GetAvailable returns the available param and if it is set
 func (p *ListZonesParams) GetAvailable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["available"].(bool)
-	return value, ok
+	return p.available.v, p.available.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListZonesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListZonesParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListZonesParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListZonesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListZonesParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListZonesParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListZonesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListZonesParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListZonesParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListZonesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListZonesParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListZonesParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListZonesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListZonesParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListZonesParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworktype sets the networktype param.
 func (p *ListZonesParams) SetNetworktype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networktype"] = v
+	p.networktype = optString{v: v, ok: true}
 }
 
+// ResetNetworktype unsets the networktype param
 func (p *ListZonesParams) ResetNetworktype() {
-	if p.p != nil && p.p["networktype"] != nil {
-		delete(p.p, "networktype")
-	}
+	p.networktype = optString{}
 }
 
+// GetNetworktype returns the networktype param and if it is set
 func (p *ListZonesParams) GetNetworktype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networktype"].(string)
-	return value, ok
+	return p.networktype.v, p.networktype.ok
 }
 
+// SetPage sets the page param.
 func (p *ListZonesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListZonesParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListZonesParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListZonesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListZonesParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListZonesParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetShowcapacities sets the showcapacities param.
 func (p *ListZonesParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
+	p.showcapacities = optBool{v: v, ok: true}
 }
 
+// ResetShowcapacities unsets the showcapacities param
 func (p *ListZonesParams) ResetShowcapacities() {
-	if p.p != nil && p.p["showcapacities"] != nil {
-		delete(p.p, "showcapacities")
-	}
+	p.showcapacities = optBool{}
 }
 
+// GetShowcapacities returns the showcapacities param and if it is set
 func (p *ListZonesParams) GetShowcapacities() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showcapacities"].(bool)
-	return value, ok
+	return p.showcapacities.v, p.showcapacities.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListZonesParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListZonesParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListZonesParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListZonesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListZonesParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListZonesParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListZonesParams) Clone() *ListZonesParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListZonesParams) Equal(o *ListZonesParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.available == o.available &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.networktype == o.networktype &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showcapacities == o.showcapacities &&
+		p.showicon == o.showicon &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListZonesParams is used to (un)marshal ListZonesParams using the API param names
+type serializedListZonesParams struct {
+	Available      *bool              `json:"available,omitempty" yaml:"available,omitempty"`
+	Domainid       *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id             *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids            *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Keyword        *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name           *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networktype    *string            `json:"networktype,omitempty" yaml:"networktype,omitempty"`
+	Page           *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showcapacities *bool              `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Showicon       *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	Tags           *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListZonesParams) toSerialized() *serializedListZonesParams {
+	s := &serializedListZonesParams{}
+	if p.available.ok {
+		s.Available = &p.available.v
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networktype.ok {
+		s.Networktype = &p.networktype.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showcapacities.ok {
+		s.Showcapacities = &p.showcapacities.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListZonesParams) fromSerialized(s *serializedListZonesParams) {
+	*p = ListZonesParams{}
+	if s.Available != nil {
+		p.SetAvailable(*s.Available)
+	}
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networktype != nil {
+		p.SetNetworktype(*s.Networktype)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showcapacities != nil {
+		p.SetShowcapacities(*s.Showcapacities)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListZonesParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListZonesParams) UnmarshalJSON(b []byte) error {
+	var s serializedListZonesParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListZonesParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListZonesParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListZonesParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListZonesParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewListZonesParams() *ListZonesParams {
 	p := &ListZonesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListZonesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1697,9 +2639,8 @@ func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
 	p := &ListZonesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -1727,9 +2668,9 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 	return nil, l.Count, fmt.Errorf("There is more then one result for Zone UUID: %s!", id)
 }
 
-// Lists zones
-func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
-	resp, err := s.cs.newRequest("listZones", p.toURLValues())
+// Lists zones.
+func (s *ZoneService) ListZones(p *ListZonesParams, opts ...CallOption) (*ListZonesResponse, error) {
+	resp, err := s.cs.newRequest("listZones", s.cs.encodeParams("listZones", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1797,329 +2738,489 @@ type ZoneCapacity struct {
 }
 
 type ListZonesMetricsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListZonesMetricsParams) toURLValues() url.Values {
+	available      optBool
+	domainid       optString
+	id             optString
+	ids            optStrings
+	keyword        optString
+	name           optString
+	networktype    optString
+	page           optInt
+	pagesize       optInt
+	showcapacities optBool
+	showicon       optBool
+	tags           optStringMap
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ListZonesMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["available"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("available", vv)
+	if p.available.ok {
+		u.Set("available", strconv.FormatBool(p.available.v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if p.domainid.ok {
+		u.Set("domainid", p.domainid.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+	if p.ids.ok {
+		u.Set("ids", strings.Join(p.ids.v, ","))
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if p.keyword.ok {
+		u.Set("keyword", p.keyword.v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["networktype"]; found {
-		u.Set("networktype", v.(string))
+	if p.networktype.ok {
+		u.Set("networktype", p.networktype.v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if p.page.ok {
+		u.Set("page", strconv.Itoa(p.page.v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if p.pagesize.ok {
+		u.Set("pagesize", strconv.Itoa(p.pagesize.v))
 	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
+	if p.showcapacities.ok {
+		u.Set("showcapacities", strconv.FormatBool(p.showcapacities.v))
 	}
-	if v, found := p.p["showicon"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showicon", vv)
+	if p.showicon.ok {
+		u.Set("showicon", strconv.FormatBool(p.showicon.v))
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
+	if p.tags.ok {
+		m := p.tags.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
 		}
 	}
-	return u
+	return u
+}
+
+// ParseListZonesMetricsParams parses url.Values, for example taken from a raw API request,
+// into a new ListZonesMetricsParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseListZonesMetricsParams(u url.Values) (*ListZonesMetricsParams, error) {
+	p := &ListZonesMetricsParams{}
+	if err := checkParamNames("listZonesMetrics", u, "available", "domainid", "id", "ids", "keyword", "name", "networktype", "page", "pagesize", "showcapacities", "showicon", "tags[]"); err != nil {
+		return nil, err
+	}
+	if _, found := u["available"]; found {
+		v, err := strconv.ParseBool(u.Get("available"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param available: %v", err)
+		}
+		p.SetAvailable(v)
+	}
+	if _, found := u["domainid"]; found {
+		p.SetDomainid(u.Get("domainid"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["ids"]; found {
+		p.SetIds(strings.Split(u.Get("ids"), ","))
+	}
+	if _, found := u["keyword"]; found {
+		p.SetKeyword(u.Get("keyword"))
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["networktype"]; found {
+		p.SetNetworktype(u.Get("networktype"))
+	}
+	if _, found := u["page"]; found {
+		v, err := strconv.Atoi(u.Get("page"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param page: %v", err)
+		}
+		p.SetPage(v)
+	}
+	if _, found := u["pagesize"]; found {
+		v, err := strconv.Atoi(u.Get("pagesize"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param pagesize: %v", err)
+		}
+		p.SetPagesize(v)
+	}
+	if _, found := u["showcapacities"]; found {
+		v, err := strconv.ParseBool(u.Get("showcapacities"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showcapacities: %v", err)
+		}
+		p.SetShowcapacities(v)
+	}
+	if _, found := u["showicon"]; found {
+		v, err := strconv.ParseBool(u.Get("showicon"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param showicon: %v", err)
+		}
+		p.SetShowicon(v)
+	}
+	if m, err := parseKeyValueMap(u, "tags", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetTags(m)
+	}
+	return p, nil
 }
 
+// SetAvailable sets the available param.
 func (p *ListZonesMetricsParams) SetAvailable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["available"] = v
+	p.available = optBool{v: v, ok: true}
 }
 
+// ResetAvailable unsets the available param
 func (p *ListZonesMetricsParams) ResetAvailable() {
-	if p.p != nil && p.p["available"] != nil {
-		delete(p.p, "available")
-	}
+	p.available = optBool{}
 }
 
+// GetAvailable returns the available param and if it is set
 func (p *ListZonesMetricsParams) GetAvailable() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["available"].(bool)
-	return value, ok
+	return p.available.v, p.available.ok
 }
 
+// SetDomainid sets the domainid param.
 func (p *ListZonesMetricsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.domainid = optString{v: v, ok: true}
 }
 
+// ResetDomainid unsets the domainid param
 func (p *ListZonesMetricsParams) ResetDomainid() {
-	if p.p != nil && p.p["domainid"] != nil {
-		delete(p.p, "domainid")
-	}
+	p.domainid = optString{}
 }
 
+// GetDomainid returns the domainid param and if it is set
 func (p *ListZonesMetricsParams) GetDomainid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domainid"].(string)
-	return value, ok
+	return p.domainid.v, p.domainid.ok
 }
 
+// SetId sets the id param.
 func (p *ListZonesMetricsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *ListZonesMetricsParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *ListZonesMetricsParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetIds sets the ids param.
 func (p *ListZonesMetricsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+	p.ids = optStrings{v: v, ok: true}
 }
 
+// ResetIds unsets the ids param
 func (p *ListZonesMetricsParams) ResetIds() {
-	if p.p != nil && p.p["ids"] != nil {
-		delete(p.p, "ids")
-	}
+	p.ids = optStrings{}
 }
 
+// GetIds returns the ids param and if it is set
 func (p *ListZonesMetricsParams) GetIds() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ids"].([]string)
-	return value, ok
+	return p.ids.v, p.ids.ok
 }
 
+// SetKeyword sets the keyword param.
 func (p *ListZonesMetricsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+	p.keyword = optString{v: v, ok: true}
 }
 
+// ResetKeyword unsets the keyword param
 func (p *ListZonesMetricsParams) ResetKeyword() {
-	if p.p != nil && p.p["keyword"] != nil {
-		delete(p.p, "keyword")
-	}
+	p.keyword = optString{}
 }
 
+// GetKeyword returns the keyword param and if it is set
 func (p *ListZonesMetricsParams) GetKeyword() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["keyword"].(string)
-	return value, ok
+	return p.keyword.v, p.keyword.ok
 }
 
+// SetName sets the name param.
 func (p *ListZonesMetricsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *ListZonesMetricsParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *ListZonesMetricsParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetNetworktype sets the networktype param.
 func (p *ListZonesMetricsParams) SetNetworktype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networktype"] = v
+	p.networktype = optString{v: v, ok: true}
 }
 
+// ResetNetworktype unsets the networktype param
 func (p *ListZonesMetricsParams) ResetNetworktype() {
-	if p.p != nil && p.p["networktype"] != nil {
-		delete(p.p, "networktype")
-	}
+	p.networktype = optString{}
 }
 
+// GetNetworktype returns the networktype param and if it is set
 func (p *ListZonesMetricsParams) GetNetworktype() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["networktype"].(string)
-	return value, ok
+	return p.networktype.v, p.networktype.ok
 }
 
+// SetPage sets the page param.
 func (p *ListZonesMetricsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.page = optInt{v: v, ok: true}
 }
 
+// ResetPage unsets the page param
 func (p *ListZonesMetricsParams) ResetPage() {
-	if p.p != nil && p.p["page"] != nil {
-		delete(p.p, "page")
-	}
+	p.page = optInt{}
 }
 
+// GetPage returns the page param and if it is set
 func (p *ListZonesMetricsParams) GetPage() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["page"].(int)
-	return value, ok
+	return p.page.v, p.page.ok
 }
 
+// SetPagesize sets the pagesize param.
 func (p *ListZonesMetricsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+	p.pagesize = optInt{v: v, ok: true}
 }
 
+// ResetPagesize unsets the pagesize param
 func (p *ListZonesMetricsParams) ResetPagesize() {
-	if p.p != nil && p.p["pagesize"] != nil {
-		delete(p.p, "pagesize")
-	}
+	p.pagesize = optInt{}
 }
 
+// GetPagesize returns the pagesize param and if it is set
 func (p *ListZonesMetricsParams) GetPagesize() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["pagesize"].(int)
-	return value, ok
+	return p.pagesize.v, p.pagesize.ok
 }
 
+// SetShowcapacities sets the showcapacities param.
 func (p *ListZonesMetricsParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
+	p.showcapacities = optBool{v: v, ok: true}
 }
 
+// ResetShowcapacities unsets the showcapacities param
 func (p *ListZonesMetricsParams) ResetShowcapacities() {
-	if p.p != nil && p.p["showcapacities"] != nil {
-		delete(p.p, "showcapacities")
-	}
+	p.showcapacities = optBool{}
 }
 
+// GetShowcapacities returns the showcapacities param and if it is set
 func (p *ListZonesMetricsParams) GetShowcapacities() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showcapacities"].(bool)
-	return value, ok
+	return p.showcapacities.v, p.showcapacities.ok
 }
 
+// SetShowicon sets the showicon param.
 func (p *ListZonesMetricsParams) SetShowicon(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showicon"] = v
+	p.showicon = optBool{v: v, ok: true}
 }
 
+// ResetShowicon unsets the showicon param
 func (p *ListZonesMetricsParams) ResetShowicon() {
-	if p.p != nil && p.p["showicon"] != nil {
-		delete(p.p, "showicon")
-	}
+	p.showicon = optBool{}
 }
 
+// GetShowicon returns the showicon param and if it is set
 func (p *ListZonesMetricsParams) GetShowicon() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["showicon"].(bool)
-	return value, ok
+	return p.showicon.v, p.showicon.ok
 }
 
+// SetTags sets the tags param.
 func (p *ListZonesMetricsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+	p.tags = optStringMap{v: v, ok: true}
 }
 
+// ResetTags unsets the tags param
 func (p *ListZonesMetricsParams) ResetTags() {
-	if p.p != nil && p.p["tags"] != nil {
-		delete(p.p, "tags")
-	}
+	p.tags = optStringMap{}
 }
 
+// GetTags returns the tags param and if it is set
 func (p *ListZonesMetricsParams) GetTags() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.tags.v, p.tags.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ListZonesMetricsParams) Clone() *ListZonesMetricsParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.ids = p.ids.clone()
+	c.tags = p.tags.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ListZonesMetricsParams) Equal(o *ListZonesMetricsParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.available == o.available &&
+		p.domainid == o.domainid &&
+		p.id == o.id &&
+		p.ids.equal(o.ids) &&
+		p.keyword == o.keyword &&
+		p.name == o.name &&
+		p.networktype == o.networktype &&
+		p.page == o.page &&
+		p.pagesize == o.pagesize &&
+		p.showcapacities == o.showcapacities &&
+		p.showicon == o.showicon &&
+		p.tags.equal(o.tags)
+}
+
+// serializedListZonesMetricsParams is used to (un)marshal ListZonesMetricsParams using the API param names
+type serializedListZonesMetricsParams struct {
+	Available      *bool              `json:"available,omitempty" yaml:"available,omitempty"`
+	Domainid       *string            `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id             *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids            *[]string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Keyword        *string            `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name           *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Networktype    *string            `json:"networktype,omitempty" yaml:"networktype,omitempty"`
+	Page           *int               `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int               `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showcapacities *bool              `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Showicon       *bool              `json:"showicon,omitempty" yaml:"showicon,omitempty"`
+	Tags           *map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+func (p *ListZonesMetricsParams) toSerialized() *serializedListZonesMetricsParams {
+	s := &serializedListZonesMetricsParams{}
+	if p.available.ok {
+		s.Available = &p.available.v
+	}
+	if p.domainid.ok {
+		s.Domainid = &p.domainid.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.ids.ok {
+		s.Ids = &p.ids.v
+	}
+	if p.keyword.ok {
+		s.Keyword = &p.keyword.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.networktype.ok {
+		s.Networktype = &p.networktype.v
+	}
+	if p.page.ok {
+		s.Page = &p.page.v
+	}
+	if p.pagesize.ok {
+		s.Pagesize = &p.pagesize.v
+	}
+	if p.showcapacities.ok {
+		s.Showcapacities = &p.showcapacities.v
+	}
+	if p.showicon.ok {
+		s.Showicon = &p.showicon.v
+	}
+	if p.tags.ok {
+		s.Tags = &p.tags.v
+	}
+	return s
+}
+
+func (p *ListZonesMetricsParams) fromSerialized(s *serializedListZonesMetricsParams) {
+	*p = ListZonesMetricsParams{}
+	if s.Available != nil {
+		p.SetAvailable(*s.Available)
 	}
-	value, ok := p.p["tags"].(map[string]string)
-	return value, ok
+	if s.Domainid != nil {
+		p.SetDomainid(*s.Domainid)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Ids != nil {
+		p.SetIds(*s.Ids)
+	}
+	if s.Keyword != nil {
+		p.SetKeyword(*s.Keyword)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Networktype != nil {
+		p.SetNetworktype(*s.Networktype)
+	}
+	if s.Page != nil {
+		p.SetPage(*s.Page)
+	}
+	if s.Pagesize != nil {
+		p.SetPagesize(*s.Pagesize)
+	}
+	if s.Showcapacities != nil {
+		p.SetShowcapacities(*s.Showcapacities)
+	}
+	if s.Showicon != nil {
+		p.SetShowicon(*s.Showicon)
+	}
+	if s.Tags != nil {
+		p.SetTags(*s.Tags)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ListZonesMetricsParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ListZonesMetricsParams) UnmarshalJSON(b []byte) error {
+	var s serializedListZonesMetricsParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ListZonesMetricsParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ListZonesMetricsParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedListZonesMetricsParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ListZonesMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewListZonesMetricsParams() *ListZonesMetricsParams {
 	p := &ListZonesMetricsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListZonesMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2167,9 +3268,8 @@ func (s *ZoneService) GetZonesMetricByName(name string, opts ...OptionFunc) (*Zo
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
 	p := &ListZonesMetricsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
 
 	for _, fn := range append(s.cs.options, opts...) {
 		if err := fn(s.cs, p); err != nil {
@@ -2197,9 +3297,9 @@ func (s *ZoneService) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesM
 	return nil, l.Count, fmt.Errorf("There is more then one result for ZonesMetric UUID: %s!", id)
 }
 
-// Lists zone metrics
-func (s *ZoneService) ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listZonesMetrics", p.toURLValues())
+// Lists zone metrics.
+func (s *ZoneService) ListZonesMetrics(p *ListZonesMetricsParams, opts ...CallOption) (*ListZonesMetricsResponse, error) {
+	resp, err := s.cs.newRequest("listZonesMetrics", s.cs.encodeParams("listZonesMetrics", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2285,53 +3385,133 @@ type ZonesMetricCapacity struct {
 }
 
 type ReleaseDedicatedZoneParams struct {
-	p map[string]interface{}
+	zoneid optString
 }
 
-func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *ReleaseDedicatedZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if p.zoneid.ok {
+		u.Set("zoneid", p.zoneid.v)
 	}
 	return u
 }
 
-func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseReleaseDedicatedZoneParams parses url.Values, for example taken from a raw API request,
+// into a new ReleaseDedicatedZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseReleaseDedicatedZoneParams(u url.Values) (*ReleaseDedicatedZoneParams, error) {
+	p := &ReleaseDedicatedZoneParams{}
+	if err := checkParamNames("releaseDedicatedZone", u, "zoneid"); err != nil {
+		return nil, err
 	}
-	p.p["zoneid"] = v
+	if _, found := u["zoneid"]; found {
+		p.SetZoneid(u.Get("zoneid"))
+	}
+	return p, nil
+}
+
+// SetZoneid sets the zoneid param. This param is required.
+func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
+	p.zoneid = optString{v: v, ok: true}
 }
 
+// ResetZoneid unsets the zoneid param
 func (p *ReleaseDedicatedZoneParams) ResetZoneid() {
-	if p.p != nil && p.p["zoneid"] != nil {
-		delete(p.p, "zoneid")
-	}
+	p.zoneid = optString{}
 }
 
+// GetZoneid returns the zoneid param and if it is set
 func (p *ReleaseDedicatedZoneParams) GetZoneid() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.zoneid.v, p.zoneid.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *ReleaseDedicatedZoneParams) Clone() *ReleaseDedicatedZoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *ReleaseDedicatedZoneParams) Equal(o *ReleaseDedicatedZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.zoneid == o.zoneid
+}
+
+// serializedReleaseDedicatedZoneParams is used to (un)marshal ReleaseDedicatedZoneParams using the API param names
+type serializedReleaseDedicatedZoneParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+func (p *ReleaseDedicatedZoneParams) toSerialized() *serializedReleaseDedicatedZoneParams {
+	s := &serializedReleaseDedicatedZoneParams{}
+	if p.zoneid.ok {
+		s.Zoneid = &p.zoneid.v
+	}
+	return s
+}
+
+func (p *ReleaseDedicatedZoneParams) fromSerialized(s *serializedReleaseDedicatedZoneParams) {
+	*p = ReleaseDedicatedZoneParams{}
+	if s.Zoneid != nil {
+		p.SetZoneid(*s.Zoneid)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p ReleaseDedicatedZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *ReleaseDedicatedZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedReleaseDedicatedZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p ReleaseDedicatedZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *ReleaseDedicatedZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedReleaseDedicatedZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
 	}
-	value, ok := p.p["zoneid"].(string)
-	return value, ok
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new ReleaseDedicatedZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams {
 	p := &ReleaseDedicatedZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Release dedication of zone
-func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedZone", p.toURLValues())
+// Release dedication of zone.
+//
+// This is an async command. An async client waits for the job to finish, otherwise only the job ID
+// is returned (see NewAsyncClient and GetAsyncJobResult). Required params: zoneid.
+func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams, opts ...CallOption) (*ReleaseDedicatedZoneResponse, error) {
+	resp, err := s.cs.newRequest("releaseDedicatedZone", s.cs.encodeParams("releaseDedicatedZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2342,8 +3522,8 @@ func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*Rele
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if o := s.cs.newCallOptions(opts); o.async {
+		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2367,445 +3547,632 @@ type ReleaseDedicatedZoneResponse struct {
 }
 
 type UpdateZoneParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateZoneParams) toURLValues() url.Values {
+	allocationstate     optString
+	details             optStringMap
+	dhcpprovider        optString
+	dns1                optString
+	dns2                optString
+	dnssearchorder      optStrings
+	domain              optString
+	guestcidraddress    optString
+	id                  optString
+	internaldns1        optString
+	internaldns2        optString
+	ip6dns1             optString
+	ip6dns2             optString
+	ispublic            optBool
+	localstorageenabled optBool
+	name                optString
+	sortkey             optInt
+}
+
+// ToURLValues encodes all set params the same way they are sent to the API
+func (p *UpdateZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
+	if p == nil {
 		return u
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if p.allocationstate.ok {
+		u.Set("allocationstate", p.allocationstate.v)
 	}
-	if v, found := p.p["details"]; found {
-		m := v.(map[string]string)
+	if p.details.ok {
+		m := p.details.v
 		for i, k := range getSortedKeysFromMap(m) {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), m[k])
 		}
 	}
-	if v, found := p.p["dhcpprovider"]; found {
-		u.Set("dhcpprovider", v.(string))
+	if p.dhcpprovider.ok {
+		u.Set("dhcpprovider", p.dhcpprovider.v)
 	}
-	if v, found := p.p["dns1"]; found {
-		u.Set("dns1", v.(string))
+	if p.dns1.ok {
+		u.Set("dns1", p.dns1.v)
 	}
-	if v, found := p.p["dns2"]; found {
-		u.Set("dns2", v.(string))
+	if p.dns2.ok {
+		u.Set("dns2", p.dns2.v)
 	}
-	if v, found := p.p["dnssearchorder"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("dnssearchorder", vv)
+	if p.dnssearchorder.ok {
+		u.Set("dnssearchorder", strings.Join(p.dnssearchorder.v, ","))
 	}
-	if v, found := p.p["domain"]; found {
-		u.Set("domain", v.(string))
+	if p.domain.ok {
+		u.Set("domain", p.domain.v)
 	}
-	if v, found := p.p["guestcidraddress"]; found {
-		u.Set("guestcidraddress", v.(string))
+	if p.guestcidraddress.ok {
+		u.Set("guestcidraddress", p.guestcidraddress.v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if p.id.ok {
+		u.Set("id", p.id.v)
 	}
-	if v, found := p.p["internaldns1"]; found {
-		u.Set("internaldns1", v.(string))
+	if p.internaldns1.ok {
+		u.Set("internaldns1", p.internaldns1.v)
 	}
-	if v, found := p.p["internaldns2"]; found {
-		u.Set("internaldns2", v.(string))
+	if p.internaldns2.ok {
+		u.Set("internaldns2", p.internaldns2.v)
 	}
-	if v, found := p.p["ip6dns1"]; found {
-		u.Set("ip6dns1", v.(string))
+	if p.ip6dns1.ok {
+		u.Set("ip6dns1", p.ip6dns1.v)
 	}
-	if v, found := p.p["ip6dns2"]; found {
-		u.Set("ip6dns2", v.(string))
+	if p.ip6dns2.ok {
+		u.Set("ip6dns2", p.ip6dns2.v)
 	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
+	if p.ispublic.ok {
+		u.Set("ispublic", strconv.FormatBool(p.ispublic.v))
 	}
-	if v, found := p.p["localstorageenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("localstorageenabled", vv)
+	if p.localstorageenabled.ok {
+		u.Set("localstorageenabled", strconv.FormatBool(p.localstorageenabled.v))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if p.name.ok {
+		u.Set("name", p.name.v)
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if p.sortkey.ok {
+		u.Set("sortkey", strconv.Itoa(p.sortkey.v))
 	}
 	return u
 }
 
-func (p *UpdateZoneParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ParseUpdateZoneParams parses url.Values, for example taken from a raw API request,
+// into a new UpdateZoneParams instance. Encoding the returned params gives back the
+// original values, except for the generic request params like the signature,
+// when the values use the canonical encoding of the map params. Map params in another
+// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.
+func ParseUpdateZoneParams(u url.Values) (*UpdateZoneParams, error) {
+	p := &UpdateZoneParams{}
+	if err := checkParamNames("updateZone", u, "allocationstate", "details[]", "dhcpprovider", "dns1", "dns2", "dnssearchorder", "domain", "guestcidraddress", "id", "internaldns1", "internaldns2", "ip6dns1", "ip6dns2", "ispublic", "localstorageenabled", "name", "sortkey"); err != nil {
+		return nil, err
+	}
+	if _, found := u["allocationstate"]; found {
+		p.SetAllocationstate(u.Get("allocationstate"))
+	}
+	if m, err := parseKeyValueMap(u, "details", "key", "value", false); err != nil {
+		return nil, err
+	} else if len(m) > 0 {
+		p.SetDetails(m)
+	}
+	if _, found := u["dhcpprovider"]; found {
+		p.SetDhcpprovider(u.Get("dhcpprovider"))
+	}
+	if _, found := u["dns1"]; found {
+		p.SetDns1(u.Get("dns1"))
+	}
+	if _, found := u["dns2"]; found {
+		p.SetDns2(u.Get("dns2"))
+	}
+	if _, found := u["dnssearchorder"]; found {
+		p.SetDnssearchorder(strings.Split(u.Get("dnssearchorder"), ","))
+	}
+	if _, found := u["domain"]; found {
+		p.SetDomain(u.Get("domain"))
+	}
+	if _, found := u["guestcidraddress"]; found {
+		p.SetGuestcidraddress(u.Get("guestcidraddress"))
+	}
+	if _, found := u["id"]; found {
+		p.SetId(u.Get("id"))
+	}
+	if _, found := u["internaldns1"]; found {
+		p.SetInternaldns1(u.Get("internaldns1"))
+	}
+	if _, found := u["internaldns2"]; found {
+		p.SetInternaldns2(u.Get("internaldns2"))
+	}
+	if _, found := u["ip6dns1"]; found {
+		p.SetIp6dns1(u.Get("ip6dns1"))
+	}
+	if _, found := u["ip6dns2"]; found {
+		p.SetIp6dns2(u.Get("ip6dns2"))
+	}
+	if _, found := u["ispublic"]; found {
+		v, err := strconv.ParseBool(u.Get("ispublic"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param ispublic: %v", err)
+		}
+		p.SetIspublic(v)
+	}
+	if _, found := u["localstorageenabled"]; found {
+		v, err := strconv.ParseBool(u.Get("localstorageenabled"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param localstorageenabled: %v", err)
+		}
+		p.SetLocalstorageenabled(v)
+	}
+	if _, found := u["name"]; found {
+		p.SetName(u.Get("name"))
+	}
+	if _, found := u["sortkey"]; found {
+		v, err := strconv.Atoi(u.Get("sortkey"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for param sortkey: %v", err)
+		}
+		p.SetSortkey(v)
 	}
-	p.p["allocationstate"] = v
+	return p, nil
+}
+
+// SetAllocationstate sets the allocationstate param.
+func (p *UpdateZoneParams) SetAllocationstate(v string) {
+	p.allocationstate = optString{v: v, ok: true}
 }
 
+// ResetAllocationstate unsets the allocationstate param
 func (p *UpdateZoneParams) ResetAllocationstate() {
-	if p.p != nil && p.p["allocationstate"] != nil {
-		delete(p.p, "allocationstate")
-	}
+	p.allocationstate = optString{}
 }
 
+// GetAllocationstate returns the allocationstate param and if it is set
 func (p *UpdateZoneParams) GetAllocationstate() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["allocationstate"].(string)
-	return value, ok
+	return p.allocationstate.v, p.allocationstate.ok
 }
 
+// SetDetails sets the details param.
 func (p *UpdateZoneParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+	p.details = optStringMap{v: v, ok: true}
 }
 
+// ResetDetails unsets the details param
 func (p *UpdateZoneParams) ResetDetails() {
-	if p.p != nil && p.p["details"] != nil {
-		delete(p.p, "details")
-	}
+	p.details = optStringMap{}
 }
 
+// GetDetails returns the details param and if it is set
 func (p *UpdateZoneParams) GetDetails() (map[string]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["details"].(map[string]string)
-	return value, ok
+	return p.details.v, p.details.ok
 }
 
+// SetDhcpprovider sets the dhcpprovider param.
 func (p *UpdateZoneParams) SetDhcpprovider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dhcpprovider"] = v
+	p.dhcpprovider = optString{v: v, ok: true}
 }
 
+// ResetDhcpprovider unsets the dhcpprovider param
 func (p *UpdateZoneParams) ResetDhcpprovider() {
-	if p.p != nil && p.p["dhcpprovider"] != nil {
-		delete(p.p, "dhcpprovider")
-	}
+	p.dhcpprovider = optString{}
 }
 
+// GetDhcpprovider returns the dhcpprovider param and if it is set
 func (p *UpdateZoneParams) GetDhcpprovider() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dhcpprovider"].(string)
-	return value, ok
+	return p.dhcpprovider.v, p.dhcpprovider.ok
 }
 
+// SetDns1 sets the dns1 param.
 func (p *UpdateZoneParams) SetDns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns1"] = v
+	p.dns1 = optString{v: v, ok: true}
 }
 
+// ResetDns1 unsets the dns1 param
 func (p *UpdateZoneParams) ResetDns1() {
-	if p.p != nil && p.p["dns1"] != nil {
-		delete(p.p, "dns1")
-	}
+	p.dns1 = optString{}
 }
 
+// GetDns1 returns the dns1 param and if it is set
 func (p *UpdateZoneParams) GetDns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns1"].(string)
-	return value, ok
+	return p.dns1.v, p.dns1.ok
 }
 
+// SetDns2 sets the dns2 param.
 func (p *UpdateZoneParams) SetDns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns2"] = v
+	p.dns2 = optString{v: v, ok: true}
 }
 
+// ResetDns2 unsets the dns2 param
 func (p *UpdateZoneParams) ResetDns2() {
-	if p.p != nil && p.p["dns2"] != nil {
-		delete(p.p, "dns2")
-	}
+	p.dns2 = optString{}
 }
 
+// GetDns2 returns the dns2 param and if it is set
 func (p *UpdateZoneParams) GetDns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dns2"].(string)
-	return value, ok
+	return p.dns2.v, p.dns2.ok
 }
 
+// SetDnssearchorder sets the dnssearchorder param.
 func (p *UpdateZoneParams) SetDnssearchorder(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dnssearchorder"] = v
+	p.dnssearchorder = optStrings{v: v, ok: true}
 }
 
+// ResetDnssearchorder unsets the dnssearchorder param
 func (p *UpdateZoneParams) ResetDnssearchorder() {
-	if p.p != nil && p.p["dnssearchorder"] != nil {
-		delete(p.p, "dnssearchorder")
-	}
+	p.dnssearchorder = optStrings{}
 }
 
+// GetDnssearchorder returns the dnssearchorder param and if it is set
 func (p *UpdateZoneParams) GetDnssearchorder() ([]string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["dnssearchorder"].([]string)
-	return value, ok
+	return p.dnssearchorder.v, p.dnssearchorder.ok
 }
 
+// SetDomain sets the domain param.
 func (p *UpdateZoneParams) SetDomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domain"] = v
+	p.domain = optString{v: v, ok: true}
 }
 
+// ResetDomain unsets the domain param
 func (p *UpdateZoneParams) ResetDomain() {
-	if p.p != nil && p.p["domain"] != nil {
-		delete(p.p, "domain")
-	}
+	p.domain = optString{}
 }
 
+// GetDomain returns the domain param and if it is set
 func (p *UpdateZoneParams) GetDomain() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["domain"].(string)
-	return value, ok
+	return p.domain.v, p.domain.ok
 }
 
+// SetGuestcidraddress sets the guestcidraddress param.
 func (p *UpdateZoneParams) SetGuestcidraddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestcidraddress"] = v
+	p.guestcidraddress = optString{v: v, ok: true}
 }
 
+// ResetGuestcidraddress unsets the guestcidraddress param
 func (p *UpdateZoneParams) ResetGuestcidraddress() {
-	if p.p != nil && p.p["guestcidraddress"] != nil {
-		delete(p.p, "guestcidraddress")
-	}
+	p.guestcidraddress = optString{}
 }
 
+// GetGuestcidraddress returns the guestcidraddress param and if it is set
 func (p *UpdateZoneParams) GetGuestcidraddress() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["guestcidraddress"].(string)
-	return value, ok
+	return p.guestcidraddress.v, p.guestcidraddress.ok
 }
 
+// SetId sets the id param. This param is required.
 func (p *UpdateZoneParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.id = optString{v: v, ok: true}
 }
 
+// ResetId unsets the id param
 func (p *UpdateZoneParams) ResetId() {
-	if p.p != nil && p.p["id"] != nil {
-		delete(p.p, "id")
-	}
+	p.id = optString{}
 }
 
+// GetId returns the id param and if it is set
 func (p *UpdateZoneParams) GetId() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["id"].(string)
-	return value, ok
+	return p.id.v, p.id.ok
 }
 
+// SetInternaldns1 sets the internaldns1 param.
 func (p *UpdateZoneParams) SetInternaldns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internaldns1"] = v
+	p.internaldns1 = optString{v: v, ok: true}
 }
 
+// ResetInternaldns1 unsets the internaldns1 param
 func (p *UpdateZoneParams) ResetInternaldns1() {
-	if p.p != nil && p.p["internaldns1"] != nil {
-		delete(p.p, "internaldns1")
-	}
+	p.internaldns1 = optString{}
 }
 
+// GetInternaldns1 returns the internaldns1 param and if it is set
 func (p *UpdateZoneParams) GetInternaldns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["internaldns1"].(string)
-	return value, ok
+	return p.internaldns1.v, p.internaldns1.ok
 }
 
+// SetInternaldns2 sets the internaldns2 param.
 func (p *UpdateZoneParams) SetInternaldns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internaldns2"] = v
+	p.internaldns2 = optString{v: v, ok: true}
 }
 
+// ResetInternaldns2 unsets the internaldns2 param
 func (p *UpdateZoneParams) ResetInternaldns2() {
-	if p.p != nil && p.p["internaldns2"] != nil {
-		delete(p.p, "internaldns2")
-	}
+	p.internaldns2 = optString{}
 }
 
+// GetInternaldns2 returns the internaldns2 param and if it is set
 func (p *UpdateZoneParams) GetInternaldns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["internaldns2"].(string)
-	return value, ok
+	return p.internaldns2.v, p.internaldns2.ok
 }
 
+// SetIp6dns1 sets the ip6dns1 param.
 func (p *UpdateZoneParams) SetIp6dns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns1"] = v
+	p.ip6dns1 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns1 unsets the ip6dns1 param
 func (p *UpdateZoneParams) ResetIp6dns1() {
-	if p.p != nil && p.p["ip6dns1"] != nil {
-		delete(p.p, "ip6dns1")
-	}
+	p.ip6dns1 = optString{}
 }
 
+// GetIp6dns1 returns the ip6dns1 param and if it is set
 func (p *UpdateZoneParams) GetIp6dns1() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns1"].(string)
-	return value, ok
+	return p.ip6dns1.v, p.ip6dns1.ok
 }
 
+// SetIp6dns2 sets the ip6dns2 param.
 func (p *UpdateZoneParams) SetIp6dns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns2"] = v
+	p.ip6dns2 = optString{v: v, ok: true}
 }
 
+// ResetIp6dns2 unsets the ip6dns2 param
 func (p *UpdateZoneParams) ResetIp6dns2() {
-	if p.p != nil && p.p["ip6dns2"] != nil {
-		delete(p.p, "ip6dns2")
-	}
+	p.ip6dns2 = optString{}
 }
 
+// GetIp6dns2 returns the ip6dns2 param and if it is set
 func (p *UpdateZoneParams) GetIp6dns2() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ip6dns2"].(string)
-	return value, ok
+	return p.ip6dns2.v, p.ip6dns2.ok
 }
 
+// SetIspublic sets the ispublic param.
 func (p *UpdateZoneParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+	p.ispublic = optBool{v: v, ok: true}
 }
 
+// ResetIspublic unsets the ispublic param
 func (p *UpdateZoneParams) ResetIspublic() {
-	if p.p != nil && p.p["ispublic"] != nil {
-		delete(p.p, "ispublic")
-	}
+	p.ispublic = optBool{}
 }
 
+// GetIspublic returns the ispublic param and if it is set
 func (p *UpdateZoneParams) GetIspublic() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["ispublic"].(bool)
-	return value, ok
+	return p.ispublic.v, p.ispublic.ok
 }
 
+// SetLocalstorageenabled sets the localstorageenabled param.
 func (p *UpdateZoneParams) SetLocalstorageenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["localstorageenabled"] = v
+	p.localstorageenabled = optBool{v: v, ok: true}
 }
 
+// ResetLocalstorageenabled unsets the localstorageenabled param
 func (p *UpdateZoneParams) ResetLocalstorageenabled() {
-	if p.p != nil && p.p["localstorageenabled"] != nil {
-		delete(p.p, "localstorageenabled")
-	}
+	p.localstorageenabled = optBool{}
 }
 
+// GetLocalstorageenabled returns the localstorageenabled param and if it is set
 func (p *UpdateZoneParams) GetLocalstorageenabled() (bool, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["localstorageenabled"].(bool)
-	return value, ok
+	return p.localstorageenabled.v, p.localstorageenabled.ok
 }
 
+// SetName sets the name param.
 func (p *UpdateZoneParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.name = optString{v: v, ok: true}
 }
 
+// ResetName unsets the name param
 func (p *UpdateZoneParams) ResetName() {
-	if p.p != nil && p.p["name"] != nil {
-		delete(p.p, "name")
-	}
+	p.name = optString{}
 }
 
+// GetName returns the name param and if it is set
 func (p *UpdateZoneParams) GetName() (string, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	value, ok := p.p["name"].(string)
-	return value, ok
+	return p.name.v, p.name.ok
 }
 
+// SetSortkey sets the sortkey param.
 func (p *UpdateZoneParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
+	p.sortkey = optInt{v: v, ok: true}
 }
 
+// ResetSortkey unsets the sortkey param
 func (p *UpdateZoneParams) ResetSortkey() {
-	if p.p != nil && p.p["sortkey"] != nil {
-		delete(p.p, "sortkey")
-	}
+	p.sortkey = optInt{}
 }
 
+// GetSortkey returns the sortkey param and if it is set
 func (p *UpdateZoneParams) GetSortkey() (int, bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	return p.sortkey.v, p.sortkey.ok
+}
+
+// Clone returns a deep copy of the params
+func (p *UpdateZoneParams) Clone() *UpdateZoneParams {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.details = p.details.clone()
+	c.dnssearchorder = p.dnssearchorder.clone()
+	return &c
+}
+
+// Equal reports whether p and o hold exactly the same param values
+func (p *UpdateZoneParams) Equal(o *UpdateZoneParams) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return p.allocationstate == o.allocationstate &&
+		p.details.equal(o.details) &&
+		p.dhcpprovider == o.dhcpprovider &&
+		p.dns1 == o.dns1 &&
+		p.dns2 == o.dns2 &&
+		p.dnssearchorder.equal(o.dnssearchorder) &&
+		p.domain == o.domain &&
+		p.guestcidraddress == o.guestcidraddress &&
+		p.id == o.id &&
+		p.internaldns1 == o.internaldns1 &&
+		p.internaldns2 == o.internaldns2 &&
+		p.ip6dns1 == o.ip6dns1 &&
+		p.ip6dns2 == o.ip6dns2 &&
+		p.ispublic == o.ispublic &&
+		p.localstorageenabled == o.localstorageenabled &&
+		p.name == o.name &&
+		p.sortkey == o.sortkey
+}
+
+// serializedUpdateZoneParams is used to (un)marshal UpdateZoneParams using the API param names
+type serializedUpdateZoneParams struct {
+	Allocationstate     *string            `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Details             *map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Dhcpprovider        *string            `json:"dhcpprovider,omitempty" yaml:"dhcpprovider,omitempty"`
+	Dns1                *string            `json:"dns1,omitempty" yaml:"dns1,omitempty"`
+	Dns2                *string            `json:"dns2,omitempty" yaml:"dns2,omitempty"`
+	Dnssearchorder      *[]string          `json:"dnssearchorder,omitempty" yaml:"dnssearchorder,omitempty"`
+	Domain              *string            `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Guestcidraddress    *string            `json:"guestcidraddress,omitempty" yaml:"guestcidraddress,omitempty"`
+	Id                  *string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Internaldns1        *string            `json:"internaldns1,omitempty" yaml:"internaldns1,omitempty"`
+	Internaldns2        *string            `json:"internaldns2,omitempty" yaml:"internaldns2,omitempty"`
+	Ip6dns1             *string            `json:"ip6dns1,omitempty" yaml:"ip6dns1,omitempty"`
+	Ip6dns2             *string            `json:"ip6dns2,omitempty" yaml:"ip6dns2,omitempty"`
+	Ispublic            *bool              `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Localstorageenabled *bool              `json:"localstorageenabled,omitempty" yaml:"localstorageenabled,omitempty"`
+	Name                *string            `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey             *int               `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+}
+
+func (p *UpdateZoneParams) toSerialized() *serializedUpdateZoneParams {
+	s := &serializedUpdateZoneParams{}
+	if p.allocationstate.ok {
+		s.Allocationstate = &p.allocationstate.v
+	}
+	if p.details.ok {
+		s.Details = &p.details.v
+	}
+	if p.dhcpprovider.ok {
+		s.Dhcpprovider = &p.dhcpprovider.v
+	}
+	if p.dns1.ok {
+		s.Dns1 = &p.dns1.v
+	}
+	if p.dns2.ok {
+		s.Dns2 = &p.dns2.v
+	}
+	if p.dnssearchorder.ok {
+		s.Dnssearchorder = &p.dnssearchorder.v
+	}
+	if p.domain.ok {
+		s.Domain = &p.domain.v
+	}
+	if p.guestcidraddress.ok {
+		s.Guestcidraddress = &p.guestcidraddress.v
+	}
+	if p.id.ok {
+		s.Id = &p.id.v
+	}
+	if p.internaldns1.ok {
+		s.Internaldns1 = &p.internaldns1.v
+	}
+	if p.internaldns2.ok {
+		s.Internaldns2 = &p.internaldns2.v
+	}
+	if p.ip6dns1.ok {
+		s.Ip6dns1 = &p.ip6dns1.v
+	}
+	if p.ip6dns2.ok {
+		s.Ip6dns2 = &p.ip6dns2.v
+	}
+	if p.ispublic.ok {
+		s.Ispublic = &p.ispublic.v
+	}
+	if p.localstorageenabled.ok {
+		s.Localstorageenabled = &p.localstorageenabled.v
+	}
+	if p.name.ok {
+		s.Name = &p.name.v
+	}
+	if p.sortkey.ok {
+		s.Sortkey = &p.sortkey.v
 	}
-	value, ok := p.p["sortkey"].(int)
-	return value, ok
+	return s
+}
+
+func (p *UpdateZoneParams) fromSerialized(s *serializedUpdateZoneParams) {
+	*p = UpdateZoneParams{}
+	if s.Allocationstate != nil {
+		p.SetAllocationstate(*s.Allocationstate)
+	}
+	if s.Details != nil {
+		p.SetDetails(*s.Details)
+	}
+	if s.Dhcpprovider != nil {
+		p.SetDhcpprovider(*s.Dhcpprovider)
+	}
+	if s.Dns1 != nil {
+		p.SetDns1(*s.Dns1)
+	}
+	if s.Dns2 != nil {
+		p.SetDns2(*s.Dns2)
+	}
+	if s.Dnssearchorder != nil {
+		p.SetDnssearchorder(*s.Dnssearchorder)
+	}
+	if s.Domain != nil {
+		p.SetDomain(*s.Domain)
+	}
+	if s.Guestcidraddress != nil {
+		p.SetGuestcidraddress(*s.Guestcidraddress)
+	}
+	if s.Id != nil {
+		p.SetId(*s.Id)
+	}
+	if s.Internaldns1 != nil {
+		p.SetInternaldns1(*s.Internaldns1)
+	}
+	if s.Internaldns2 != nil {
+		p.SetInternaldns2(*s.Internaldns2)
+	}
+	if s.Ip6dns1 != nil {
+		p.SetIp6dns1(*s.Ip6dns1)
+	}
+	if s.Ip6dns2 != nil {
+		p.SetIp6dns2(*s.Ip6dns2)
+	}
+	if s.Ispublic != nil {
+		p.SetIspublic(*s.Ispublic)
+	}
+	if s.Localstorageenabled != nil {
+		p.SetLocalstorageenabled(*s.Localstorageenabled)
+	}
+	if s.Name != nil {
+		p.SetName(*s.Name)
+	}
+	if s.Sortkey != nil {
+		p.SetSortkey(*s.Sortkey)
+	}
+}
+
+// MarshalJSON encodes all set params as a JSON object keyed by the API param names
+func (p UpdateZoneParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toSerialized())
+}
+
+// UnmarshalJSON replaces all params with the ones found in the JSON object
+func (p *UpdateZoneParams) UnmarshalJSON(b []byte) error {
+	var s serializedUpdateZoneParams
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
+}
+
+// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names
+func (p UpdateZoneParams) MarshalYAML() (interface{}, error) {
+	return p.toSerialized(), nil
+}
+
+// UnmarshalYAML replaces all params with the ones found in the YAML mapping
+func (p *UpdateZoneParams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s serializedUpdateZoneParams
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	p.fromSerialized(&s)
+	return nil
 }
 
 // You should always use this function to get a new UpdateZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
 	p := &UpdateZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a Zone.
-func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
-	resp, err := s.cs.newRequest("updateZone", p.toURLValues())
+//
+// Required params: id.
+func (s *ZoneService) UpdateZone(p *UpdateZoneParams, opts ...CallOption) (*UpdateZoneResponse, error) {
+	resp, err := s.cs.newRequest("updateZone", s.cs.encodeParams("updateZone", p), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,108 +53,143 @@ func (m *MockZoneServiceIface) EXPECT() *MockZoneServiceIfaceMockRecorder {
 }
 
 // CreateZone mocks base method.
-func (m *MockZoneServiceIface) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
+func (m *MockZoneServiceIface) CreateZone(p *CreateZoneParams, opts ...CallOption) (*CreateZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateZone", varargs...)
 	ret0, _ := ret[0].(*CreateZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateZone indicates an expected call of CreateZone.
-func (mr *MockZoneServiceIfaceMockRecorder) CreateZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) CreateZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateZone), varargs...)
 }
 
 // DedicateZone mocks base method.
-func (m *MockZoneServiceIface) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
+func (m *MockZoneServiceIface) DedicateZone(p *DedicateZoneParams, opts ...CallOption) (*DedicateZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DedicateZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DedicateZone", varargs...)
 	ret0, _ := ret[0].(*DedicateZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DedicateZone indicates an expected call of DedicateZone.
-func (mr *MockZoneServiceIfaceMockRecorder) DedicateZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) DedicateZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateZone), varargs...)
 }
 
 // DeleteZone mocks base method.
-func (m *MockZoneServiceIface) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+func (m *MockZoneServiceIface) DeleteZone(p *DeleteZoneParams, opts ...CallOption) (*DeleteZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteZone", varargs...)
 	ret0, _ := ret[0].(*DeleteZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteZone indicates an expected call of DeleteZone.
-func (mr *MockZoneServiceIfaceMockRecorder) DeleteZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) DeleteZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteZone), varargs...)
 }
 
 // DisableHAForZone mocks base method.
-func (m *MockZoneServiceIface) DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
+func (m *MockZoneServiceIface) DisableHAForZone(p *DisableHAForZoneParams, opts ...CallOption) (*DisableHAForZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableHAForZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableHAForZone", varargs...)
 	ret0, _ := ret[0].(*DisableHAForZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableHAForZone indicates an expected call of DisableHAForZone.
-func (mr *MockZoneServiceIfaceMockRecorder) DisableHAForZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) DisableHAForZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableHAForZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableHAForZone), varargs...)
 }
 
 // DisableOutOfBandManagementForZone mocks base method.
-func (m *MockZoneServiceIface) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
+func (m *MockZoneServiceIface) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams, opts ...CallOption) (*DisableOutOfBandManagementForZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForZone", varargs...)
 	ret0, _ := ret[0].(*DisableOutOfBandManagementForZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DisableOutOfBandManagementForZone indicates an expected call of DisableOutOfBandManagementForZone.
-func (mr *MockZoneServiceIfaceMockRecorder) DisableOutOfBandManagementForZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) DisableOutOfBandManagementForZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableOutOfBandManagementForZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableOutOfBandManagementForZone), varargs...)
 }
 
 // EnableHAForZone mocks base method.
-func (m *MockZoneServiceIface) EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
+func (m *MockZoneServiceIface) EnableHAForZone(p *EnableHAForZoneParams, opts ...CallOption) (*EnableHAForZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableHAForZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableHAForZone", varargs...)
 	ret0, _ := ret[0].(*EnableHAForZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableHAForZone indicates an expected call of EnableHAForZone.
-func (mr *MockZoneServiceIfaceMockRecorder) EnableHAForZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) EnableHAForZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableHAForZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableHAForZone), varargs...)
 }
 
 // EnableOutOfBandManagementForZone mocks base method.
-func (m *MockZoneServiceIface) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
+func (m *MockZoneServiceIface) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams, opts ...CallOption) (*EnableOutOfBandManagementForZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForZone", varargs...)
 	ret0, _ := ret[0].(*EnableOutOfBandManagementForZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EnableOutOfBandManagementForZone indicates an expected call of EnableOutOfBandManagementForZone.
-func (mr *MockZoneServiceIfaceMockRecorder) EnableOutOfBandManagementForZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) EnableOutOfBandManagementForZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableOutOfBandManagementForZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableOutOfBandManagementForZone), varargs...)
 }
 
 // GetZoneByID mocks base method.
@@ -284,48 +319,63 @@ func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricID(name interface{}, o
 }
 
 // ListDedicatedZones mocks base method.
-func (m *MockZoneServiceIface) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+func (m *MockZoneServiceIface) ListDedicatedZones(p *ListDedicatedZonesParams, opts ...CallOption) (*ListDedicatedZonesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDedicatedZones", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedZones", varargs...)
 	ret0, _ := ret[0].(*ListDedicatedZonesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDedicatedZones indicates an expected call of ListDedicatedZones.
-func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZones(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZones(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZones), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZones), varargs...)
 }
 
 // ListZones mocks base method.
-func (m *MockZoneServiceIface) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+func (m *MockZoneServiceIface) ListZones(p *ListZonesParams, opts ...CallOption) (*ListZonesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListZones", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListZones", varargs...)
 	ret0, _ := ret[0].(*ListZonesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListZones indicates an expected call of ListZones.
-func (mr *MockZoneServiceIfaceMockRecorder) ListZones(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) ListZones(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZones), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZones), varargs...)
 }
 
 // ListZonesMetrics mocks base method.
-func (m *MockZoneServiceIface) ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+func (m *MockZoneServiceIface) ListZonesMetrics(p *ListZonesMetricsParams, opts ...CallOption) (*ListZonesMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListZonesMetrics", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListZonesMetrics", varargs...)
 	ret0, _ := ret[0].(*ListZonesMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListZonesMetrics indicates an expected call of ListZonesMetrics.
-func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetrics(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetrics(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetrics", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetrics), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetrics", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetrics), varargs...)
 }
 
 // NewCreateZoneParams mocks base method.
@@ -497,31 +547,41 @@ func (mr *MockZoneServiceIfaceMockRecorder) NewUpdateZoneParams(id interface{})
 }
 
 // ReleaseDedicatedZone mocks base method.
-func (m *MockZoneServiceIface) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+func (m *MockZoneServiceIface) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams, opts ...CallOption) (*ReleaseDedicatedZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseDedicatedZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseDedicatedZone", varargs...)
 	ret0, _ := ret[0].(*ReleaseDedicatedZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseDedicatedZone indicates an expected call of ReleaseDedicatedZone.
-func (mr *MockZoneServiceIfaceMockRecorder) ReleaseDedicatedZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) ReleaseDedicatedZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedZone", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseDedicatedZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedZone", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseDedicatedZone), varargs...)
 }
 
 // UpdateZone mocks base method.
-func (m *MockZoneServiceIface) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+func (m *MockZoneServiceIface) UpdateZone(p *UpdateZoneParams, opts ...CallOption) (*UpdateZoneResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateZone", p)
+	varargs := []interface{}{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateZone", varargs...)
 	ret0, _ := ret[0].(*UpdateZoneResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateZone indicates an expected call of UpdateZone.
-func (mr *MockZoneServiceIfaceMockRecorder) UpdateZone(p interface{}) *gomock.Call {
+func (mr *MockZoneServiceIfaceMockRecorder) UpdateZone(p interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateZone), p)
+	varargs := append([]interface{}{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateZone), varargs...)
 }
@@ -21,6 +21,7 @@ package cloudstack
 
 import (
 	"bytes"
+	"compress/gzip"
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/tls"
@@ -91,13 +92,18 @@ func (c *UUID) UnmarshalJSON(data []byte) error {
 type CloudStackClient struct {
 	HTTPGETOnly bool // If `true` only use HTTP GET calls
 
-	client  *http.Client // The http client for communicating
-	baseURL string       // The base URL of the API
-	apiKey  string       // Api key
-	secret  string       // Secret key
-	async   bool         // Wait for async calls to finish
-	options []OptionFunc // A list of option functions to apply to all API calls
-	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+	client       *http.Client   // The http client for communicating
+	baseURL      string         // The base URL of the API
+	apiKey       string         // Api key
+	secret       string         // Secret key
+	async        bool           // Wait for async calls to finish
+	options      []OptionFunc   // A list of option functions to apply to all API calls
+	timeout      int64          // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+	maxGETLength int            // Max URL length of GET calls before switching to POST; defaults to 4096 bytes
+	gzipRequests bool           // Gzip compress the body of POST calls
+	scope        *clientScope   // The project, domain or account all API calls are bound to
+	discovery    *apiDiscovery  // The cached API capabilities of the server, if discovery is enabled
+	version      *serverVersion // The targeted server version, if any
 
 	APIDiscovery        APIDiscoveryServiceIface
 	Account             AccountServiceIface
@@ -108,6 +114,7 @@ type CloudStackClient struct {
 	Asyncjob            AsyncjobServiceIface
 	Authentication      AuthenticationServiceIface
 	AutoScale           AutoScaleServiceIface
+	Backup              BackupServiceIface
 	Baremetal           BaremetalServiceIface
 	BigSwitchBCF        BigSwitchBCFServiceIface
 	BrocadeVCS          BrocadeVCSServiceIface
@@ -139,6 +146,7 @@ type CloudStackClient struct {
 	Network             NetworkServiceIface
 	Nic                 NicServiceIface
 	NiciraNVP           NiciraNVPServiceIface
+	ObjectStore         ObjectStoreServiceIface
 	OutofbandManagement OutofbandManagementServiceIface
 	OvsElement          OvsElementServiceIface
 	Pod                 PodServiceIface
@@ -163,6 +171,7 @@ type CloudStackClient struct {
 	Template            TemplateServiceIface
 	UCS                 UCSServiceIface
 	Usage               UsageServiceIface
+	UserData            UserDataServiceIface
 	User                UserServiceIface
 	VLAN                VLANServiceIface
 	VMGroup             VMGroupServiceIface
@@ -194,12 +203,15 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 			},
 			Timeout: time.Duration(60 * time.Second),
 		},
-		baseURL: apiurl,
-		apiKey:  apikey,
-		secret:  secret,
-		async:   async,
-		options: []OptionFunc{},
-		timeout: 300,
+		baseURL:      apiurl,
+		apiKey:       apikey,
+		secret:       secret,
+		async:        async,
+		options:      []OptionFunc{},
+		timeout:      300,
+		maxGETLength: DefaultMaxGETLength,
+		discovery:    &apiDiscovery{},
+		version:      &serverVersion{},
 	}
 
 	for _, fn := range options {
@@ -215,6 +227,7 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.Asyncjob = NewAsyncjobService(cs)
 	cs.Authentication = NewAuthenticationService(cs)
 	cs.AutoScale = NewAutoScaleService(cs)
+	cs.Backup = NewBackupService(cs)
 	cs.Baremetal = NewBaremetalService(cs)
 	cs.BigSwitchBCF = NewBigSwitchBCFService(cs)
 	cs.BrocadeVCS = NewBrocadeVCSService(cs)
@@ -246,6 +259,7 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.Network = NewNetworkService(cs)
 	cs.Nic = NewNicService(cs)
 	cs.NiciraNVP = NewNiciraNVPService(cs)
+	cs.ObjectStore = NewObjectStoreService(cs)
 	cs.OutofbandManagement = NewOutofbandManagementService(cs)
 	cs.OvsElement = NewOvsElementService(cs)
 	cs.Pod = NewPodService(cs)
@@ -270,6 +284,7 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.Template = NewTemplateService(cs)
 	cs.UCS = NewUCSService(cs)
 	cs.Usage = NewUsageService(cs)
+	cs.UserData = NewUserDataService(cs)
 	cs.User = NewUserService(cs)
 	cs.VLAN = NewVLANService(cs)
 	cs.VMGroup = NewVMGroupService(cs)
@@ -284,7 +299,14 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 
 // Creates a new mock client for communicating with CloudStack
 func newMockClient(ctrl *gomock.Controller) *CloudStackClient {
-	cs := &CloudStackClient{}
+	cs := &CloudStackClient{
+		async:        true,
+		options:      []OptionFunc{},
+		timeout:      300,
+		maxGETLength: DefaultMaxGETLength,
+		discovery:    &apiDiscovery{},
+		version:      &serverVersion{},
+	}
 
 	cs.APIDiscovery = NewMockAPIDiscoveryServiceIface(ctrl)
 	cs.Account = NewMockAccountServiceIface(ctrl)
@@ -295,6 +317,7 @@ func newMockClient(ctrl *gomock.Controller) *CloudStackClient {
 	cs.Asyncjob = NewMockAsyncjobServiceIface(ctrl)
 	cs.Authentication = NewMockAuthenticationServiceIface(ctrl)
 	cs.AutoScale = NewMockAutoScaleServiceIface(ctrl)
+	cs.Backup = NewMockBackupServiceIface(ctrl)
 	cs.Baremetal = NewMockBaremetalServiceIface(ctrl)
 	cs.BigSwitchBCF = NewMockBigSwitchBCFServiceIface(ctrl)
 	cs.BrocadeVCS = NewMockBrocadeVCSServiceIface(ctrl)
@@ -326,6 +349,7 @@ func newMockClient(ctrl *gomock.Controller) *CloudStackClient {
 	cs.Network = NewMockNetworkServiceIface(ctrl)
 	cs.Nic = NewMockNicServiceIface(ctrl)
 	cs.NiciraNVP = NewMockNiciraNVPServiceIface(ctrl)
+	cs.ObjectStore = NewMockObjectStoreServiceIface(ctrl)
 	cs.OutofbandManagement = NewMockOutofbandManagementServiceIface(ctrl)
 	cs.OvsElement = NewMockOvsElementServiceIface(ctrl)
 	cs.Pod = NewMockPodServiceIface(ctrl)
@@ -350,6 +374,7 @@ func newMockClient(ctrl *gomock.Controller) *CloudStackClient {
 	cs.Template = NewMockTemplateServiceIface(ctrl)
 	cs.UCS = NewMockUCSServiceIface(ctrl)
 	cs.Usage = NewMockUsageServiceIface(ctrl)
+	cs.UserData = NewMockUserDataServiceIface(ctrl)
 	cs.User = NewMockUserServiceIface(ctrl)
 	cs.VLAN = NewMockVLANServiceIface(ctrl)
 	cs.VMGroup = NewMockVMGroupServiceIface(ctrl)
@@ -405,17 +430,279 @@ func (cs *CloudStackClient) DefaultOptions(options ...OptionFunc) {
 	}
 }
 
+// clientScope holds the params that are added to every API call that accepts them
+type clientScope struct {
+	projectid string
+	domainid  string
+	account   string
+}
+
+// ForProject returns a new client bound to the given project name or ID. The new client
+// shares the HTTP transport with this client, but adds the `projectid` param to every
+// API call that accepts it, unless the param is already set explicitly.
+func (cs *CloudStackClient) ForProject(project string) (*CloudStackClient, error) {
+	if !IsID(project) {
+		id, _, err := cs.Project.GetProjectID(project)
+		if err != nil {
+			return nil, err
+		}
+		project = id
+	}
+	return cs.newScopedClient(&clientScope{projectid: project}), nil
+}
+
+// ForDomain returns a new client bound to the given domain name or ID. The new client
+// shares the HTTP transport with this client, but adds the `domainid` param to every
+// API call that accepts it, unless the param is already set explicitly.
+func (cs *CloudStackClient) ForDomain(domain string) (*CloudStackClient, error) {
+	if !IsID(domain) {
+		id, _, err := cs.Domain.GetDomainID(domain)
+		if err != nil {
+			return nil, err
+		}
+		domain = id
+	}
+	return cs.newScopedClient(&clientScope{domainid: domain}), nil
+}
+
+// ForAccount returns a new client bound to the given account in the given domain name
+// or ID. The new client shares the HTTP transport with this client, but adds the
+// `domainid` and `account` params to every API call that accepts them, unless the
+// params are already set explicitly.
+func (cs *CloudStackClient) ForAccount(domain string, account string) (*CloudStackClient, error) {
+	if !IsID(domain) {
+		id, _, err := cs.Domain.GetDomainID(domain)
+		if err != nil {
+			return nil, err
+		}
+		domain = id
+	}
+	return cs.newScopedClient(&clientScope{domainid: domain, account: account}), nil
+}
+
+// Creates a copy of the client bound to the given scope. The copy gets its own services
+// and options, so changing them does not affect the original client.
+func (cs *CloudStackClient) newScopedClient(scope *clientScope) *CloudStackClient {
+	c := *cs
+	client := *cs.client
+	c.client = &client
+	c.options = append([]OptionFunc{}, cs.options...)
+	c.scope = scope
+
+	c.APIDiscovery = NewAPIDiscoveryService(&c)
+	c.Account = NewAccountService(&c)
+	c.Address = NewAddressService(&c)
+	c.AffinityGroup = NewAffinityGroupService(&c)
+	c.Alert = NewAlertService(&c)
+	c.Annotation = NewAnnotationService(&c)
+	c.Asyncjob = NewAsyncjobService(&c)
+	c.Authentication = NewAuthenticationService(&c)
+	c.AutoScale = NewAutoScaleService(&c)
+	c.Backup = NewBackupService(&c)
+	c.Baremetal = NewBaremetalService(&c)
+	c.BigSwitchBCF = NewBigSwitchBCFService(&c)
+	c.BrocadeVCS = NewBrocadeVCSService(&c)
+	c.Certificate = NewCertificateService(&c)
+	c.CloudIdentifier = NewCloudIdentifierService(&c)
+	c.Cluster = NewClusterService(&c)
+	c.Configuration = NewConfigurationService(&c)
+	c.ConsoleEndpoint = NewConsoleEndpointService(&c)
+	c.Custom = NewCustomService(&c)
+	c.DiskOffering = NewDiskOfferingService(&c)
+	c.Domain = NewDomainService(&c)
+	c.Event = NewEventService(&c)
+	c.Firewall = NewFirewallService(&c)
+	c.GuestOS = NewGuestOSService(&c)
+	c.Host = NewHostService(&c)
+	c.Hypervisor = NewHypervisorService(&c)
+	c.ISO = NewISOService(&c)
+	c.ImageStore = NewImageStoreService(&c)
+	c.InfrastructureUsage = NewInfrastructureUsageService(&c)
+	c.InternalLB = NewInternalLBService(&c)
+	c.Kubernetes = NewKubernetesService(&c)
+	c.LDAP = NewLDAPService(&c)
+	c.Limit = NewLimitService(&c)
+	c.LoadBalancer = NewLoadBalancerService(&c)
+	c.NAT = NewNATService(&c)
+	c.NetworkACL = NewNetworkACLService(&c)
+	c.NetworkDevice = NewNetworkDeviceService(&c)
+	c.NetworkOffering = NewNetworkOfferingService(&c)
+	c.Network = NewNetworkService(&c)
+	c.Nic = NewNicService(&c)
+	c.NiciraNVP = NewNiciraNVPService(&c)
+	c.ObjectStore = NewObjectStoreService(&c)
+	c.OutofbandManagement = NewOutofbandManagementService(&c)
+	c.OvsElement = NewOvsElementService(&c)
+	c.Pod = NewPodService(&c)
+	c.Pool = NewPoolService(&c)
+	c.PortableIP = NewPortableIPService(&c)
+	c.Project = NewProjectService(&c)
+	c.Quota = NewQuotaService(&c)
+	c.Region = NewRegionService(&c)
+	c.Resourcemetadata = NewResourcemetadataService(&c)
+	c.Resourcetags = NewResourcetagsService(&c)
+	c.Role = NewRoleService(&c)
+	c.Router = NewRouterService(&c)
+	c.SSH = NewSSHService(&c)
+	c.SecurityGroup = NewSecurityGroupService(&c)
+	c.ServiceOffering = NewServiceOfferingService(&c)
+	c.Snapshot = NewSnapshotService(&c)
+	c.StoragePool = NewStoragePoolService(&c)
+	c.StratosphereSSP = NewStratosphereSSPService(&c)
+	c.Swift = NewSwiftService(&c)
+	c.SystemCapacity = NewSystemCapacityService(&c)
+	c.SystemVM = NewSystemVMService(&c)
+	c.Template = NewTemplateService(&c)
+	c.UCS = NewUCSService(&c)
+	c.Usage = NewUsageService(&c)
+	c.UserData = NewUserDataService(&c)
+	c.User = NewUserService(&c)
+	c.VLAN = NewVLANService(&c)
+	c.VMGroup = NewVMGroupService(&c)
+	c.VPC = NewVPCService(&c)
+	c.VPN = NewVPNService(&c)
+	c.VirtualMachine = NewVirtualMachineService(&c)
+	c.Volume = NewVolumeService(&c)
+	c.Zone = NewZoneService(&c)
+
+	return &c
+}
+
+// Encodes the params of an API call, adding the params of the scope the client is bound
+// to when the API call accepts them, they are not set explicitly and they refer to the
+// scope of the call (see unscopedParams).
+func (cs *CloudStackClient) encodeParams(api string, p interface{ ToURLValues() url.Values }) url.Values {
+	u := p.ToURLValues()
+	if cs.scope == nil {
+		return u
+	}
+
+	unscoped := unscopedParams[strings.ToLower(api)]
+	setDefault := func(name, value string) {
+		if _, found := u[name]; !found && value != "" && !unscoped[name] {
+			u.Set(name, value)
+		}
+	}
+	if _, ok := p.(ProjectIDSetter); ok {
+		setDefault("projectid", cs.scope.projectid)
+	}
+	if _, ok := p.(DomainIDSetter); ok {
+		setDefault("domainid", cs.scope.domainid)
+	}
+	if _, ok := p.(AccountSetter); ok {
+		setDefault("account", cs.scope.account)
+	}
+
+	return u
+}
+
 var AsyncTimeoutErr = errors.New("Timeout while waiting for async job to finish")
 
+// CallOption can be passed to the API call functions to set options for that single call
+type CallOption func(*callOptions)
+
+// callOptions holds the options of a single API call
+type callOptions struct {
+	httpTimeout  time.Duration // Timeout of the HTTP request(s), overriding the client timeout
+	async        bool          // Wait for async calls to finish
+	asyncTimeout int64         // Max waiting timeout in seconds for async jobs to finish
+	retry        *RetryPolicy  // Policy used to retry failed HTTP requests
+	params       url.Values    // Additional raw params added to the request
+	endpoint     string        // The base URL of the API, overriding the client base URL
+}
+
+// RetryPolicy defines how failed API calls are retried. Only calls that didn't get a
+// response, or that got a 502, 503 or 504 response from a proxy in front of the API,
+// are retried. As a failed call may still have been executed by the API, only use a
+// retry policy for idempotent API calls.
+type RetryPolicy struct {
+	MaxAttempts int           // Max number of attempts, including the first one
+	Backoff     time.Duration // Waiting time before the first retry, doubled for every next retry
+}
+
+// Returns the options for a single API call, using the client settings as defaults
+func (cs *CloudStackClient) newCallOptions(opts []CallOption) *callOptions {
+	o := &callOptions{
+		async:        cs.async,
+		asyncTimeout: cs.timeout,
+	}
+	for _, fn := range opts {
+		fn(o)
+	}
+	return o
+}
+
+// Returns the options that also apply when polling for the result of an async job
+func (o *callOptions) asyncJobOptions() []CallOption {
+	return []CallOption{func(jo *callOptions) {
+		jo.httpTimeout = o.httpTimeout
+		jo.retry = o.retry
+		jo.endpoint = o.endpoint
+	}}
+}
+
+// WithCallTimeout sets the timeout of the HTTP request(s) of a single API call
+func WithCallTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.httpTimeout = timeout
+	}
+}
+
+// WithCallWait sets if a single async API call should wait for the async job to finish,
+// overriding the behaviour of the client (see NewClient and NewAsyncClient).
+func WithCallWait(wait bool) CallOption {
+	return func(o *callOptions) {
+		o.async = wait
+	}
+}
+
+// WithCallAsyncTimeout sets the max time in seconds a single async API call waits for
+// the async job to finish.
+func WithCallAsyncTimeout(timeout int64) CallOption {
+	return func(o *callOptions) {
+		if timeout != 0 {
+			o.asyncTimeout = timeout
+		}
+	}
+}
+
+// WithCallRetry sets the policy used to retry a single API call when it fails
+func WithCallRetry(policy RetryPolicy) CallOption {
+	return func(o *callOptions) {
+		o.retry = &policy
+	}
+}
+
+// WithCallParams adds raw params to a single API call, for example to pass params
+// that are not (yet) supported by the generated params types.
+func WithCallParams(params url.Values) CallOption {
+	return func(o *callOptions) {
+		if o.params == nil {
+			o.params = url.Values{}
+		}
+		for k, v := range params {
+			o.params[k] = v
+		}
+	}
+}
+
+// WithCallEndpoint sends a single API call to the given API URL instead of the URL
+// the client was created with.
+func WithCallEndpoint(apiurl string) CallOption {
+	return func(o *callOptions) {
+		o.endpoint = apiurl
+	}
+}
+
 // A helper function that you can use to get the result of a running async job. If the job is not finished within the configured
-// timeout, the async job returns a AsyncTimeoutErr.
-func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {
+// timeout, the async job returns a AsyncTimeoutErr. Any call options are applied to every call made to check the job status.
+func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64, opts ...CallOption) (json.RawMessage, error) {
 	var timer time.Duration
 	currentTime := time.Now().Unix()
 
 	for {
 		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)
-		r, err := cs.Asyncjob.QueryAsyncJobResult(p)
+		r, err := cs.Asyncjob.QueryAsyncJobResult(p, opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -451,21 +738,34 @@ func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json
 // Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if
 // no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS
 // error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {
-	return cs.newRawRequest(api, false, params)
+func (cs *CloudStackClient) newRequest(api string, params url.Values, opts ...CallOption) (json.RawMessage, error) {
+	return cs.newRawRequest(api, false, params, opts...)
 }
 
 // Execute the request against a CS API using POST. Will return the raw JSON data returned by the API and
 // nil if no error occurred. If the API returns an error the result will be nil and the HTTP error code
 // and CS error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CloudStackClient) newPostRequest(api string, params url.Values) (json.RawMessage, error) {
-	return cs.newRawRequest(api, true, params)
+func (cs *CloudStackClient) newPostRequest(api string, params url.Values, opts ...CallOption) (json.RawMessage, error) {
+	return cs.newRawRequest(api, true, params, opts...)
 }
 
 // Execute a raw request against a CS API. Will return the raw JSON data returned by the API and nil if
 // no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS
 // error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Values) (json.RawMessage, error) {
+func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Values, opts ...CallOption) (json.RawMessage, error) {
+	o := cs.newCallOptions(opts)
+	for k, v := range o.params {
+		params[k] = v
+	}
+
+	// Check if the server supports the API call before sending it
+	if err := cs.checkCapabilities(api, params); err != nil {
+		return nil, err
+	}
+	if err := cs.checkServerVersion(api, params); err != nil {
+		return nil, err
+	}
+
 	params.Set("apiKey", cs.apiKey)
 	params.Set("command", api)
 	params.Set("response", "json")
@@ -482,23 +782,50 @@ func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Valu
 	mac.Write([]byte(s2))
 	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
 
-	var err error
-	var resp *http.Response
-	if !cs.HTTPGETOnly && post {
-		// The deployVirtualMachine API should be called using a POST call
-		// so we don't have to worry about the userdata size
+	baseURL := cs.baseURL
+	if o.endpoint != "" {
+		baseURL = o.endpoint
+	}
 
-		// Add the unescaped signature to the POST params
-		params.Set("signature", signature)
+	client := cs.client
+	if o.httpTimeout > 0 {
+		c := *cs.client
+		c.Timeout = o.httpTimeout
+		client = &c
+	}
 
-		// Make a POST call
-		resp, err = cs.client.PostForm(cs.baseURL, params)
-	} else {
-		// Create the final URL before we issue the request
-		url := cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
+	// Create the final URL before we issue the request
+	u := baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
 
-		// Make a GET call
-		resp, err = cs.client.Get(url)
+	// Switch to a POST call when the URL is too long to be safely used in a GET call
+	if cs.maxGETLength > 0 && len(u) > cs.maxGETLength {
+		post = true
+	}
+
+	var err error
+	var resp *http.Response
+	for attempt := 1; ; attempt++ {
+		if !cs.HTTPGETOnly && post {
+			// The deployVirtualMachine API should be called using a POST call
+			// so we don't have to worry about the userdata size
+
+			// Add the unescaped signature to the POST params
+			params.Set("signature", signature)
+
+			// Make a POST call
+			resp, err = cs.postForm(client, baseURL, params)
+		} else {
+			// Make a GET call
+			resp, err = client.Get(u)
+		}
+
+		if !o.retry.shouldRetry(attempt, resp, err) {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		time.Sleep(o.retry.Backoff << uint(attempt-1))
 	}
 	if err != nil {
 		return nil, err
@@ -526,6 +853,47 @@ func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Valu
 	return b, nil
 }
 
+// Returns true if another attempt should be made after the given (failed) attempt
+func (rp *RetryPolicy) shouldRetry(attempt int, resp *http.Response, err error) bool {
+	if rp == nil || attempt >= rp.MaxAttempts {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Execute a POST call with the form encoded params as body, which is gzip compressed
+// when the client is configured to do so.
+func (cs *CloudStackClient) postForm(client *http.Client, baseURL string, params url.Values) (*http.Response, error) {
+	if !cs.gzipRequests {
+		return client.PostForm(baseURL, params)
+	}
+
+	var body bytes.Buffer
+	zw := gzip.NewWriter(&body)
+	if _, err := zw.Write([]byte(params.Encode())); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", baseURL, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Content-Encoding", "gzip")
+
+	return client.Do(req)
+}
+
 // Custom version of net/url Encode that only URL escapes values
 // Unmodified portions here remain under BSD license of The Go Authors: https://go.googlesource.com/go/+/master/LICENSE
 func EncodeValues(v url.Values) string {
@@ -597,6 +965,341 @@ func getSortedKeysFromMap(m map[string]string) (keys []string) {
 	return keys
 }
 
+// requestParamNames contains the lower cased names of the params that are part
+// of every request, and so are not specific to any API command.
+var requestParamNames = map[string]bool{
+	"apikey":           true,
+	"command":          true,
+	"expires":          true,
+	"response":         true,
+	"sessionkey":       true,
+	"signature":        true,
+	"signatureversion": true,
+}
+
+// SupportedServerVersions contains the server versions the client was generated for, in
+// increasing order
+var SupportedServerVersions = []string{
+	"4.18.0.0",
+	"4.19.0.0",
+}
+
+// commandVersions contains the server versions the API commands are available in, for the
+// commands that are not available in all supported versions
+var commandVersions = map[string][]string{
+	"addobjectstoragepool":    {"4.19.0.0"},
+	"createbucket":            {"4.19.0.0"},
+	"deletebucket":            {"4.19.0.0"},
+	"deleteobjectstoragepool": {"4.19.0.0"},
+	"listbuckets":             {"4.19.0.0"},
+	"listobjectstoragepools":  {"4.19.0.0"},
+	"updatebucket":            {"4.19.0.0"},
+	"updateobjectstoragepool": {"4.19.0.0"},
+}
+
+// paramVersions contains the server versions the params are available in, for the params
+// that are not available in all versions the API command is available in
+var paramVersions = map[string]map[string][]string{
+	"listtemplates": {
+		"isvnf": {"4.19.0.0"},
+	},
+	"listvirtualmachines": {
+		"isvnf": {"4.19.0.0"},
+	},
+	"listvirtualmachinesmetrics": {
+		"isvnf": {"4.19.0.0"},
+	},
+}
+
+// postCommands contains the lower cased names of the API commands that require
+// a POST call for security or size purposes.
+var postCommands = map[string]bool{
+	"addvpnuser":                       true,
+	"createuser":                       true,
+	"deployvirtualmachine":             true,
+	"login":                            true,
+	"registeruserdata":                 true,
+	"resetuserdataforvirtualmachine":   true,
+	"setupusertwofactorauthentication": true,
+	"updateuser":                       true,
+	"updatevirtualmachine":             true,
+	"validateusertwofactorauthenticationcode": true,
+}
+
+// unscopedParams contains the params of the API commands, by their lower cased name, that
+// are not set by a scoped client, as they do not refer to the scope of the call. For example
+// the domainid of createDomain is the ID of the new domain.
+var unscopedParams = map[string]map[string]bool{
+	"createdomain": {
+		"domainid": true,
+	},
+	"linkdomaintoldap": {
+		"domainid": true,
+	},
+	"movedomain": {
+		"domainid": true,
+	},
+}
+
+// checkParamNames returns an error if u contains a param unknown to the API command,
+// or a param with more than one value. The names of map params end in [], as those
+// params are only accepted in their indexed form, like name[0].key=value.
+func checkParamNames(api string, u url.Values, names ...string) error {
+	for k, vs := range u {
+		name, indexed := k, ""
+		if i := strings.Index(k, "["); i > 0 {
+			name, indexed = k[:i], "[]"
+		}
+		if requestParamNames[strings.ToLower(name)] {
+			continue
+		}
+
+		known, other := false, false
+		for _, n := range names {
+			switch n {
+			case name + indexed:
+				known = true
+			case name, name + "[]":
+				other = true
+			}
+		}
+		if !known {
+			if other && indexed == "" {
+				return fmt.Errorf("Param %s of %s must be indexed, as in %s[0].key=value", k, api, k)
+			}
+			if other {
+				return fmt.Errorf("Param %s of %s cannot be indexed", k, api)
+			}
+			return fmt.Errorf("Unknown param for %s: %s", api, k)
+		}
+		if len(vs) > 1 {
+			return fmt.Errorf("Param %s has more than one value", k)
+		}
+	}
+	return nil
+}
+
+// parseIndexedValues parses indexed params like name[0].key=value and returns
+// the fields found for each index, ordered by increasing index. The indexes
+// must start at 0 and cannot have gaps, so encoding the returned values gives
+// back the same params.
+func parseIndexedValues(u url.Values, name string) ([]map[string]string, error) {
+	entries := make(map[int]map[string]string)
+	prefix := name + "["
+	for k := range u {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(k, prefix)
+		i := strings.Index(rest, "].")
+		if i < 0 || i+2 == len(rest) || strings.ContainsAny(rest[i+2:], "[]") {
+			return nil, fmt.Errorf("Invalid indexed param: %s", k)
+		}
+		idx, err := strconv.Atoi(rest[:i])
+		if err != nil || idx < 0 || strconv.Itoa(idx) != rest[:i] {
+			return nil, fmt.Errorf("Invalid index for param: %s", k)
+		}
+
+		if entries[idx] == nil {
+			entries[idx] = make(map[string]string)
+		}
+		entries[idx][rest[i+2:]] = u.Get(k)
+	}
+
+	l := make([]map[string]string, len(entries))
+	for idx, entry := range entries {
+		if idx >= len(l) {
+			return nil, fmt.Errorf("Param %s has index %d, but misses a lower index", name, idx)
+		}
+		l[idx] = entry
+	}
+	return l, nil
+}
+
+// parseIndexedMap parses a map param encoded as name[i].key=value. When the zero
+// index is required all entries use index 0. Otherwise the entries of all indexes are
+// merged, so both the canonical encoding with a single entry per index in sorted order
+// and encodings with several entries per index, as sent by the UI and cmk, are accepted.
+func parseIndexedMap(u url.Values, name string, zeroIndex bool) (map[string]string, error) {
+	l, err := parseIndexedValues(u, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if zeroIndex {
+		if len(l) > 1 {
+			return nil, fmt.Errorf("Param %s must only use index 0", name)
+		}
+		if len(l) == 1 {
+			return l[0], nil
+		}
+		return map[string]string{}, nil
+	}
+
+	m := make(map[string]string)
+	for _, entry := range l {
+		for k, v := range entry {
+			if _, found := m[k]; found {
+				return nil, fmt.Errorf("Param %s has duplicate key: %s", name, k)
+			}
+			m[k] = v
+		}
+	}
+	return m, nil
+}
+
+// parseKeyValueMap parses a map param encoded as name[i].keyField=key and name[i].valueField=value.
+// The keys must be unique, but can be in any order. The map is encoded with the keys in sorted
+// order. When empty values are omitted, an empty valueField cannot be encoded and is rejected.
+func parseKeyValueMap(u url.Values, name, keyField, valueField string, omitEmpty bool) (map[string]string, error) {
+	l, err := parseIndexedValues(u, name)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	for i, entry := range l {
+		k, found := entry[keyField]
+		if !found {
+			return nil, fmt.Errorf("Param %s[%d] is missing the %s field", name, i, keyField)
+		}
+		v, found := entry[valueField]
+		switch {
+		case !found && !omitEmpty:
+			return nil, fmt.Errorf("Param %s[%d] is missing the %s field", name, i, valueField)
+		case found && v == "" && omitEmpty:
+			return nil, fmt.Errorf("Param %s[%d].%s cannot be empty, omit it instead", name, i, valueField)
+		case len(entry) > 2 || (!found && len(entry) > 1):
+			return nil, fmt.Errorf("Param %s[%d] only supports the %s and %s fields", name, i, keyField, valueField)
+		}
+		if _, dup := m[k]; dup {
+			return nil, fmt.Errorf("Param %s has duplicate key: %s", name, k)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// optString holds an optional param value of type string
+type optString struct {
+	v  string
+	ok bool
+}
+
+// optUUID holds an optional param value of type UUID
+type optUUID struct {
+	v  UUID
+	ok bool
+}
+
+// optBool holds an optional param value of type bool
+type optBool struct {
+	v  bool
+	ok bool
+}
+
+// optInt holds an optional param value of type int
+type optInt struct {
+	v  int
+	ok bool
+}
+
+// optInt64 holds an optional param value of type int64
+type optInt64 struct {
+	v  int64
+	ok bool
+}
+
+// optFloat64 holds an optional param value of type float64
+type optFloat64 struct {
+	v  float64
+	ok bool
+}
+
+// optStrings holds an optional param value of type []string
+type optStrings struct {
+	v  []string
+	ok bool
+}
+
+func (o optStrings) clone() optStrings {
+	if o.v != nil {
+		o.v = append([]string{}, o.v...)
+	}
+	return o
+}
+
+func (o optStrings) equal(x optStrings) bool {
+	if o.ok != x.ok || len(o.v) != len(x.v) {
+		return false
+	}
+	for i := range o.v {
+		if o.v[i] != x.v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// optStringMap holds an optional param value of type map[string]string
+type optStringMap struct {
+	v  map[string]string
+	ok bool
+}
+
+func (o optStringMap) clone() optStringMap {
+	if o.v != nil {
+		m := make(map[string]string, len(o.v))
+		for k, v := range o.v {
+			m[k] = v
+		}
+		o.v = m
+	}
+	return o
+}
+
+func (o optStringMap) equal(x optStringMap) bool {
+	if o.ok != x.ok || len(o.v) != len(x.v) {
+		return false
+	}
+	for k, v := range o.v {
+		if xv, found := x.v[k]; !found || xv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// optStringMaps holds an optional param value of type []map[string]string
+type optStringMaps struct {
+	v  []map[string]string
+	ok bool
+}
+
+func (o optStringMaps) clone() optStringMaps {
+	if o.v != nil {
+		l := make([]map[string]string, len(o.v))
+		for i, item := range o.v {
+			l[i] = optStringMap{v: item}.clone().v
+		}
+		o.v = l
+	}
+	return o
+}
+
+func (o optStringMaps) equal(x optStringMaps) bool {
+	if o.ok != x.ok || len(o.v) != len(x.v) {
+		return false
+	}
+	for i := range o.v {
+		if !(optStringMap{v: o.v[i]}).equal(optStringMap{v: x.v[i]}) {
+			return false
+		}
+	}
+	return true
+}
+
 // WithAsyncTimeout takes a custom timeout to be used by the CloudStackClient
 func WithAsyncTimeout(timeout int64) ClientOption {
 	return func(cs *CloudStackClient) {
@@ -634,6 +1337,27 @@ func WithDomain(domain string) OptionFunc {
 	}
 }
 
+// DefaultMaxGETLength is the default max URL length of GET calls. Longer requests
+// are sent using a POST call, as many proxies reject URLs beyond this length.
+const DefaultMaxGETLength = 4096
+
+// WithMaxGETLength sets the max URL length of GET calls. API calls that would result
+// in a longer URL are automatically sent using a POST call. A length of 0 disables
+// the automatic switch, so only the API calls that require it use POST.
+func WithMaxGETLength(length int) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.maxGETLength = length
+	}
+}
+
+// WithGzipRequests enables gzip compression of the body of POST calls. Only enable
+// this when the API server (or a proxy in front of it) accepts compressed requests.
+func WithGzipRequests(enabled bool) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.gzipRequests = enabled
+	}
+}
+
 // WithHTTPClient takes a custom HTTP client to be used by the CloudStackClient
 func WithHTTPClient(client *http.Client) ClientOption {
 	return func(cs *CloudStackClient) {
@@ -666,6 +1390,11 @@ func WithListall(listall bool) OptionFunc {
 	}
 }
 
+// AccountSetter is an interface that every type that can set an account must implement
+type AccountSetter interface {
+	SetAccount(string)
+}
+
 // ProjectIDSetter is an interface that every type that can set a project ID must implement
 type ProjectIDSetter interface {
 	SetProjectid(string)
@@ -814,6 +1543,14 @@ func NewAutoScaleService(cs *CloudStackClient) AutoScaleServiceIface {
 	return &AutoScaleService{cs: cs}
 }
 
+type BackupService struct {
+	cs *CloudStackClient
+}
+
+func NewBackupService(cs *CloudStackClient) BackupServiceIface {
+	return &BackupService{cs: cs}
+}
+
 type BaremetalService struct {
 	cs *CloudStackClient
 }
@@ -1062,6 +1799,14 @@ func NewNiciraNVPService(cs *CloudStackClient) NiciraNVPServiceIface {
 	return &NiciraNVPService{cs: cs}
 }
 
+type ObjectStoreService struct {
+	cs *CloudStackClient
+}
+
+func NewObjectStoreService(cs *CloudStackClient) ObjectStoreServiceIface {
+	return &ObjectStoreService{cs: cs}
+}
+
 type OutofbandManagementService struct {
 	cs *CloudStackClient
 }
@@ -1254,6 +1999,14 @@ func NewUsageService(cs *CloudStackClient) UsageServiceIface {
 	return &UsageService{cs: cs}
 }
 
+type UserDataService struct {
+	cs *CloudStackClient
+}
+
+func NewUserDataService(cs *CloudStackClient) UserDataServiceIface {
+	return &UserDataService{cs: cs}
+}
+
 type UserService struct {
 	cs *CloudStackClient
 }
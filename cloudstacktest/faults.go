@@ -0,0 +1,377 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstacktest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+type faultKind int
+
+const (
+	faultLatency faultKind = iota
+	faultConnectionReset
+	faultHTTPError
+	faultMalformedJSON
+	faultPendingJob
+	faultFailedJob
+)
+
+// Fault describes what goes wrong with an API call
+type Fault struct {
+	kind    faultKind
+	latency time.Duration
+	status  int
+	text    string
+}
+
+// Latency delays the API call before it is sent to the backend. Unlike the other
+// faults, the API call is still sent after the delay.
+func Latency(d time.Duration) Fault {
+	return Fault{kind: faultLatency, latency: d}
+}
+
+// ConnectionReset fails the API call with a connection reset error
+func ConnectionReset() Fault {
+	return Fault{kind: faultConnectionReset}
+}
+
+// HTTPError responds to the API call with the given HTTP status code, for example
+// 503 or 431, and an API error response
+func HTTPError(status int) Fault {
+	return Fault{kind: faultHTTPError, status: status, text: http.StatusText(status)}
+}
+
+// MalformedJSON responds to the API call with a truncated JSON response
+func MalformedJSON() Fault {
+	return Fault{kind: faultMalformedJSON}
+}
+
+// PendingJob responds to queryAsyncJobResult calls as if the job is still running
+func PendingJob() Fault {
+	return Fault{kind: faultPendingJob}
+}
+
+// FailedJob responds to queryAsyncJobResult calls as if the job failed with the given error
+func FailedJob(text string) Fault {
+	return Fault{kind: faultFailedJob, text: text}
+}
+
+func (f Fault) isJobFault() bool {
+	return f.kind == faultPendingJob || f.kind == faultFailedJob
+}
+
+// FaultRule selects the API calls a fault is injected into
+type FaultRule struct {
+	// Command is the API command the rule applies to, or all commands if empty. For
+	// PendingJob and FailedJob faults, this can also be the command that started the
+	// async job, for example `deployVirtualMachine`.
+	Command string
+
+	// Calls selects the matching API calls by number, starting at 1. If empty, all
+	// matching API calls are selected.
+	Calls []int
+
+	// Probability selects the API calls with the given probability, between 0 and 1.
+	// If 0, all API calls selected by Calls are used.
+	Probability float64
+
+	// Fault is injected into the selected API calls
+	Fault Fault
+
+	calls int
+}
+
+// FaultTransport is an http.RoundTripper that injects faults into API calls, before
+// passing them on to its base transport. It can be used with any backend, by passing
+// the result of its Client method to cloudstack.WithHTTPClient. The zero value is a
+// transport without rules, that can be added using AddRule.
+type FaultTransport struct {
+	// Base is the transport used to send API calls, defaults to http.DefaultTransport
+	Base http.RoundTripper
+
+	mu    sync.Mutex
+	rules []*FaultRule
+	rand  *rand.Rand
+	jobs  map[string]string
+}
+
+// NewFaultTransport returns a new FaultTransport using the given base transport and rules
+func NewFaultTransport(base http.RoundTripper, rules ...*FaultRule) *FaultTransport {
+	return &FaultTransport{
+		Base:  base,
+		rules: rules,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		jobs:  make(map[string]string),
+	}
+}
+
+// AddRule adds a new rule. Rules are evaluated in the order they were added.
+func (t *FaultTransport) AddRule(r *FaultRule) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rules = append(t.rules, r)
+}
+
+// Seed seeds the random number generator used for probability based rules, to make
+// the injected faults reproducible
+func (t *FaultTransport) Seed(seed int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rand = rand.New(rand.NewSource(seed))
+}
+
+// init initializes the fields of a zero value FaultTransport, t.mu must be held
+func (t *FaultTransport) init() {
+	if t.rand == nil {
+		t.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	if t.jobs == nil {
+		t.jobs = make(map[string]string)
+	}
+}
+
+// Client returns a new HTTP client using the transport
+func (t *FaultTransport) Client() *http.Client {
+	return &http.Client{Transport: t, Timeout: 60 * time.Second}
+}
+
+// RoundTrip implements http.RoundTripper. The request of the caller is not modified.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, form, err := requestForm(req)
+	if err != nil {
+		return nil, err
+	}
+	command := form.Get("command")
+
+	fault, latency := t.selectFault(command, form.Get("jobid"))
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if fault != nil {
+		return faultResponse(req, command, form.Get("jobid"), fault)
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.recordJob(command, resp)
+	return resp, nil
+}
+
+// selectFault returns the first selected fault that fails the API call, and the total
+// latency of all selected latency faults
+func (t *FaultTransport) selectFault(command, jobid string) (*Fault, time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.init()
+
+	origin := ""
+	if strings.EqualFold(command, "queryAsyncJobResult") {
+		origin = t.jobs[jobid]
+	}
+
+	// Every matching rule counts the call, even if an earlier rule already failed it
+	var fault *Fault
+	var latency time.Duration
+	for _, r := range t.rules {
+		if r.Fault.isJobFault() && !strings.EqualFold(command, "queryAsyncJobResult") {
+			continue
+		}
+		if r.Command != "" && !strings.EqualFold(r.Command, command) &&
+			!(r.Fault.isJobFault() && strings.EqualFold(r.Command, origin)) {
+			continue
+		}
+
+		r.calls++
+		if fault != nil || !r.selected(t.rand) {
+			continue
+		}
+
+		if r.Fault.kind == faultLatency {
+			latency += r.Fault.latency
+			continue
+		}
+
+		f := r.Fault
+		fault = &f
+	}
+
+	return fault, latency
+}
+
+func (r *FaultRule) selected(rnd *rand.Rand) bool {
+	if len(r.Calls) > 0 {
+		found := false
+		for _, n := range r.Calls {
+			found = found || n == r.calls
+		}
+		if !found {
+			return false
+		}
+	}
+	return r.Probability <= 0 || rnd.Float64() < r.Probability
+}
+
+// recordJob remembers which command started an async job, so job faults can be
+// selected by that command
+func (t *FaultTransport) recordJob(command string, resp *http.Response) {
+	if resp.StatusCode != http.StatusOK || strings.EqualFold(command, "queryAsyncJobResult") {
+		return
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return
+	}
+
+	var r map[string]struct {
+		JobID string `json:"jobid"`
+	}
+	if json.Unmarshal(b, &r) != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.init()
+
+	for _, v := range r {
+		if v.JobID != "" {
+			t.jobs[v.JobID] = command
+		}
+	}
+}
+
+// requestForm returns the params of an API call, from either the URL or the (possibly
+// gzipped) body of a POST call. As the body can only be read once, a POST call is
+// returned as a clone of the request with a new body, leaving the original request as is.
+func requestForm(req *http.Request) (*http.Request, url.Values, error) {
+	form := req.URL.Query()
+	if req.Body == nil || req.Method != http.MethodPost {
+		return req, form, nil
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = ioutil.NopCloser(bytes.NewReader(b))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), nil
+	}
+	clone.ContentLength = int64(len(b))
+
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, nil, err
+		}
+		if b, err = ioutil.ReadAll(zr); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	body, err := url.ParseQuery(string(b))
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range body {
+		form[k] = append(form[k], v...)
+	}
+	return clone, form, nil
+}
+
+func faultResponse(req *http.Request, command, jobid string, f *Fault) (*http.Response, error) {
+	key := strings.ToLower(command) + "response"
+
+	var status int
+	var body string
+	switch f.kind {
+	case faultConnectionReset:
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	case faultHTTPError:
+		status = f.status
+		b, _ := json.Marshal(map[string]interface{}{key: map[string]interface{}{
+			"errorcode":   f.status,
+			"cserrorcode": 9999,
+			"errortext":   f.text,
+		}})
+		body = string(b)
+	case faultMalformedJSON:
+		status = http.StatusOK
+		body = fmt.Sprintf(`{"%s":{"count":1,`, key)
+	case faultPendingJob, faultFailedJob:
+		status = http.StatusOK
+		r := map[string]interface{}{"jobid": jobid, "jobstatus": jobPending, "jobresultcode": 0}
+		if f.kind == faultFailedJob {
+			r["jobstatus"] = jobFailed
+			r["jobresultcode"] = 530
+			r["jobresulttype"] = "object"
+			r["jobresult"] = map[string]interface{}{"errorcode": 530, "errortext": f.text}
+		}
+		b, _ := json.Marshal(map[string]interface{}{key: r})
+		body = string(b)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,195 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstacktest
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Async job statuses as returned by queryAsyncJobResult
+const (
+	jobPending   = 0
+	jobSucceeded = 1
+	jobFailed    = 2
+)
+
+// job is an async job that runs when its delay has passed
+type job struct {
+	id      string
+	command string
+	created string
+	readyAt time.Time
+	status  int
+	result  interface{}
+	run     func() (interface{}, error)
+}
+
+// startJob registers a new async job and returns the response of the API call
+// that started it
+func (s *Server) startJob(command, objectID string, run func() (interface{}, error)) interface{} {
+	j := &job{
+		id:      newID(),
+		command: command,
+		created: s.timestamp(),
+		readyAt: s.now().Add(s.jobDelay),
+		run:     run,
+	}
+	s.jobs = append(s.jobs, j)
+
+	r := map[string]interface{}{"jobid": j.id}
+	if objectID != "" {
+		r["id"] = objectID
+	}
+	return r
+}
+
+// processJobs runs all pending jobs that are ready, in the order they were started
+func (s *Server) processJobs() {
+	now := s.now()
+	for _, j := range s.jobs {
+		if j.status != jobPending || now.Before(j.readyAt) {
+			continue
+		}
+
+		result, err := j.run()
+		if err != nil {
+			code := 530
+			if e, ok := err.(*apiError); ok {
+				code = e.code
+			}
+			j.status = jobFailed
+			j.result = map[string]interface{}{"errorcode": code, "errortext": err.Error()}
+			continue
+		}
+
+		j.status = jobSucceeded
+		j.result = result
+	}
+}
+
+func (s *Server) queryAsyncJobResult(r *request) (interface{}, error) {
+	id, err := r.required("jobid")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, j := range s.jobs {
+		if j.id == id {
+			resp := map[string]interface{}{
+				"jobid":         j.id,
+				"cmd":           j.command,
+				"created":       j.created,
+				"jobstatus":     j.status,
+				"jobresultcode": 0,
+			}
+			if j.status != jobPending {
+				resp["jobresulttype"] = "object"
+				resp["jobresult"] = j.result
+			}
+			if j.status == jobFailed {
+				resp["jobresultcode"] = 530
+			}
+			return resp, nil
+		}
+	}
+
+	return nil, errorf(431, "Unable to find a job with id %s", id)
+}
+
+// request holds the params of a single API call
+type request struct {
+	*Server
+	command string
+	form    url.Values
+}
+
+func (r *request) get(name string) string {
+	return r.form.Get(name)
+}
+
+func (r *request) required(name string) (string, error) {
+	v := r.form.Get(name)
+	if v == "" {
+		return "", errorf(431, "Unable to execute API command %s due to missing parameter %s", strings.ToLower(r.command), name)
+	}
+	return v, nil
+}
+
+func (r *request) bool(name string) bool {
+	b, _ := strconv.ParseBool(r.form.Get(name))
+	return b
+}
+
+// indexedMap returns the values of an indexed map param, for example the values of
+// `tags[0].key=k&tags[0].value=v` are returned as []map[string]string{{"key": "k", "value": "v"}}
+func (r *request) indexedMap(name string) []map[string]string {
+	var result []map[string]string
+	for i := 0; ; i++ {
+		prefix := fmt.Sprintf("%s[%d].", name, i)
+		m := make(map[string]string)
+		for k, v := range r.form {
+			if strings.HasPrefix(k, prefix) && len(v) > 0 {
+				m[strings.TrimPrefix(k, prefix)] = v[0]
+			}
+		}
+		if len(m) == 0 {
+			return result
+		}
+		result = append(result, m)
+	}
+}
+
+// list returns the response of a list API call
+func list(key string, items interface{}, count int) interface{} {
+	if count == 0 {
+		return map[string]interface{}{"count": 0}
+	}
+	return map[string]interface{}{"count": count, key: items}
+}
+
+// matches returns true if the value matches the filter, an empty filter matches everything
+func matches(filter, value string) bool {
+	return filter == "" || strings.EqualFold(filter, value)
+}
+
+// matchesKeyword returns true if the name contains the keyword, ignoring case
+func matchesKeyword(keyword, name string) bool {
+	return keyword == "" || strings.Contains(strings.ToLower(name), strings.ToLower(keyword))
+}
+
+// matchesIDs returns true if the ID matches the `id` or `ids` filters of the request
+func (r *request) matchesIDs(id string) bool {
+	if !matches(r.get("id"), id) {
+		return false
+	}
+	if ids := r.get("ids"); ids != "" {
+		for _, v := range strings.Split(ids, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), id) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,1025 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstacktest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+// Resource types used by the tag API calls
+const (
+	resourceTypeVM        = "UserVm"
+	resourceTypeVolume    = "Volume"
+	resourceTypeNetwork   = "Network"
+	resourceTypeIPAddress = "PublicIpAddress"
+	resourceTypeTemplate  = "Template"
+)
+
+func (s *Server) registerHandlers() {
+	s.handlers = map[string]handlerFunc{
+		"queryasyncjobresult":   s.queryAsyncJobResult,
+		"listzones":             s.listZones,
+		"listserviceofferings":  s.listServiceOfferings,
+		"listdiskofferings":     s.listDiskOfferings,
+		"listtemplates":         s.listTemplates,
+		"deployvirtualmachine":  s.deployVirtualMachine,
+		"listvirtualmachines":   s.listVirtualMachines,
+		"startvirtualmachine":   s.startVirtualMachine,
+		"stopvirtualmachine":    s.stopVirtualMachine,
+		"rebootvirtualmachine":  s.rebootVirtualMachine,
+		"destroyvirtualmachine": s.destroyVirtualMachine,
+		"expungevirtualmachine": s.expungeVirtualMachine,
+		"createvolume":          s.createVolume,
+		"listvolumes":           s.listVolumes,
+		"attachvolume":          s.attachVolume,
+		"detachvolume":          s.detachVolume,
+		"deletevolume":          s.deleteVolume,
+		"createnetwork":         s.createNetwork,
+		"listnetworks":          s.listNetworks,
+		"deletenetwork":         s.deleteNetwork,
+		"associateipaddress":    s.associateIpAddress,
+		"listpublicipaddresses": s.listPublicIpAddresses,
+		"disassociateipaddress": s.disassociateIpAddress,
+		"createtags":            s.createTags,
+		"listtags":              s.listTags,
+		"deletetags":            s.deleteTags,
+	}
+}
+
+// AddZone adds a new zone to the simulator
+func (s *Server) AddZone(name string) *cloudstack.Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z := &cloudstack.Zone{
+		Id:              newID(),
+		Name:            name,
+		Allocationstate: "Enabled",
+		Networktype:     "Advanced",
+	}
+	s.zones = append(s.zones, z)
+
+	c := *z
+	return &c
+}
+
+// AddServiceOffering adds a new service offering to the simulator
+func (s *Server) AddServiceOffering(name string, cpunumber, memory int) *cloudstack.ServiceOffering {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &cloudstack.ServiceOffering{
+		Id:          newID(),
+		Name:        name,
+		Displaytext: name,
+		Cpunumber:   cpunumber,
+		Memory:      memory,
+		Created:     s.timestamp(),
+	}
+	s.serviceOfferings = append(s.serviceOfferings, o)
+
+	c := *o
+	return &c
+}
+
+// AddDiskOffering adds a new disk offering to the simulator, with the size in GB
+func (s *Server) AddDiskOffering(name string, disksize int64) *cloudstack.DiskOffering {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &cloudstack.DiskOffering{
+		Id:          newID(),
+		Name:        name,
+		Displaytext: name,
+		Disksize:    disksize,
+		Created:     s.timestamp(),
+	}
+	s.diskOfferings = append(s.diskOfferings, o)
+
+	c := *o
+	return &c
+}
+
+// AddTemplate adds a new, ready to use template to the given zone of the simulator
+func (s *Server) AddTemplate(name, zoneid string) *cloudstack.Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := &cloudstack.Template{
+		Id:           newID(),
+		Name:         name,
+		Displaytext:  name,
+		Zoneid:       zoneid,
+		Zonename:     s.zoneName(zoneid),
+		Isready:      true,
+		Ispublic:     true,
+		Isfeatured:   true,
+		Templatetype: "USER",
+		Size:         10 << 30,
+		Created:      s.timestamp(),
+	}
+	s.templates = append(s.templates, t)
+
+	c := *t
+	return &c
+}
+
+// VirtualMachine returns a copy of the current state of a virtual machine
+func (s *Server) VirtualMachine(id string) (*cloudstack.VirtualMachine, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processJobs()
+	if vm := s.findVM(id); vm != nil {
+		return s.renderVM(vm), true
+	}
+	return nil, false
+}
+
+// VirtualMachines returns a copy of the current state of all virtual machines
+func (s *Server) VirtualMachines() []*cloudstack.VirtualMachine {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processJobs()
+	vms := make([]*cloudstack.VirtualMachine, 0, len(s.vms))
+	for _, vm := range s.vms {
+		vms = append(vms, s.renderVM(vm))
+	}
+	return vms
+}
+
+// Volumes returns a copy of the current state of all volumes
+func (s *Server) Volumes() []*cloudstack.Volume {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processJobs()
+	volumes := make([]*cloudstack.Volume, 0, len(s.volumes))
+	for _, v := range s.volumes {
+		volumes = append(volumes, s.renderVolume(v))
+	}
+	return volumes
+}
+
+func (s *Server) zoneName(id string) string {
+	for _, z := range s.zones {
+		if z.Id == id {
+			return z.Name
+		}
+	}
+	return ""
+}
+
+func (s *Server) findVM(id string) *cloudstack.VirtualMachine {
+	for _, vm := range s.vms {
+		if vm.Id == id {
+			return vm
+		}
+	}
+	return nil
+}
+
+func (s *Server) findVolume(id string) *cloudstack.Volume {
+	for _, v := range s.volumes {
+		if v.Id == id {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *Server) findNetwork(id string) *cloudstack.Network {
+	for _, n := range s.networks {
+		if n.Id == id {
+			return n
+		}
+	}
+	return nil
+}
+
+func (s *Server) findIP(id string) *cloudstack.PublicIpAddress {
+	for _, ip := range s.ips {
+		if ip.Id == id {
+			return ip
+		}
+	}
+	return nil
+}
+
+// tagsFor returns the tags of a resource
+func (s *Server) tagsFor(resourceType, id string) []cloudstack.Tags {
+	var tags []cloudstack.Tags
+	for _, t := range s.tags {
+		if t.Resourcetype == resourceType && t.Resourceid == id {
+			tags = append(tags, cloudstack.Tags{Key: t.Key, Value: t.Value, Resourceid: id, Resourcetype: resourceType})
+		}
+	}
+	return tags
+}
+
+// matchesTags returns true if the resource has all tags given in the `tags` filter
+func (r *request) matchesTags(resourceType, id string) bool {
+	for _, filter := range r.indexedMap("tags") {
+		found := false
+		for _, t := range r.tagsFor(resourceType, id) {
+			if t.Key == filter["key"] && matches(filter["value"], t.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) renderVM(vm *cloudstack.VirtualMachine) *cloudstack.VirtualMachine {
+	c := *vm
+	c.Nic = append([]cloudstack.Nic{}, vm.Nic...)
+	c.Tags = s.tagsFor(resourceTypeVM, vm.Id)
+	return &c
+}
+
+func (s *Server) renderVolume(v *cloudstack.Volume) *cloudstack.Volume {
+	c := *v
+	c.Tags = s.tagsFor(resourceTypeVolume, v.Id)
+	return &c
+}
+
+func (s *Server) renderNetwork(n *cloudstack.Network) *cloudstack.Network {
+	c := *n
+	c.Tags = s.tagsFor(resourceTypeNetwork, n.Id)
+	return &c
+}
+
+func (s *Server) renderIP(ip *cloudstack.PublicIpAddress) *cloudstack.PublicIpAddress {
+	c := *ip
+	c.Tags = s.tagsFor(resourceTypeIPAddress, ip.Id)
+	return &c
+}
+
+func (s *Server) listZones(r *request) (interface{}, error) {
+	var zones []*cloudstack.Zone
+	for _, z := range s.zones {
+		if r.matchesIDs(z.Id) && matches(r.get("name"), z.Name) && matchesKeyword(r.get("keyword"), z.Name) {
+			c := *z
+			zones = append(zones, &c)
+		}
+	}
+	return list("zone", zones, len(zones)), nil
+}
+
+func (s *Server) listServiceOfferings(r *request) (interface{}, error) {
+	var offerings []*cloudstack.ServiceOffering
+	for _, o := range s.serviceOfferings {
+		if r.matchesIDs(o.Id) && matches(r.get("name"), o.Name) && matchesKeyword(r.get("keyword"), o.Name) {
+			c := *o
+			offerings = append(offerings, &c)
+		}
+	}
+	return list("serviceoffering", offerings, len(offerings)), nil
+}
+
+func (s *Server) listDiskOfferings(r *request) (interface{}, error) {
+	var offerings []*cloudstack.DiskOffering
+	for _, o := range s.diskOfferings {
+		if r.matchesIDs(o.Id) && matches(r.get("name"), o.Name) && matchesKeyword(r.get("keyword"), o.Name) {
+			c := *o
+			offerings = append(offerings, &c)
+		}
+	}
+	return list("diskoffering", offerings, len(offerings)), nil
+}
+
+func (s *Server) listTemplates(r *request) (interface{}, error) {
+	if _, err := r.required("templatefilter"); err != nil {
+		return nil, err
+	}
+
+	var templates []*cloudstack.Template
+	for _, t := range s.templates {
+		if r.matchesIDs(t.Id) && matches(r.get("name"), t.Name) && matchesKeyword(r.get("keyword"), t.Name) &&
+			matches(r.get("zoneid"), t.Zoneid) && r.matchesTags(resourceTypeTemplate, t.Id) {
+			c := *t
+			c.Tags = s.tagsFor(resourceTypeTemplate, t.Id)
+			templates = append(templates, &c)
+		}
+	}
+	return list("template", templates, len(templates)), nil
+}
+
+func (s *Server) deployVirtualMachine(r *request) (interface{}, error) {
+	offeringID, err := r.required("serviceofferingid")
+	if err != nil {
+		return nil, err
+	}
+	templateID, err := r.required("templateid")
+	if err != nil {
+		return nil, err
+	}
+	zoneID, err := r.required("zoneid")
+	if err != nil {
+		return nil, err
+	}
+
+	var offering *cloudstack.ServiceOffering
+	for _, o := range s.serviceOfferings {
+		if o.Id == offeringID {
+			offering = o
+		}
+	}
+	if offering == nil {
+		return nil, errorf(431, "Unable to find service offering: %s", offeringID)
+	}
+
+	var template *cloudstack.Template
+	for _, t := range s.templates {
+		if t.Id == templateID {
+			template = t
+		}
+	}
+	if template == nil {
+		return nil, errorf(431, "Unable to use template %s", templateID)
+	}
+
+	zoneName := s.zoneName(zoneID)
+	if zoneName == "" {
+		return nil, errorf(431, "Unable to find zone by id %s", zoneID)
+	}
+
+	vm := &cloudstack.VirtualMachine{
+		Id:                  newID(),
+		Name:                r.get("name"),
+		Displayname:         r.get("displayname"),
+		State:               "Starting",
+		Zoneid:              zoneID,
+		Zonename:            zoneName,
+		Serviceofferingid:   offering.Id,
+		Serviceofferingname: offering.Name,
+		Cpunumber:           offering.Cpunumber,
+		Memory:              offering.Memory,
+		Templateid:          template.Id,
+		Templatename:        template.Name,
+		Templatetype:        template.Templatetype,
+		Created:             s.timestamp(),
+	}
+	if vm.Name == "" {
+		vm.Name = "VM-" + vm.Id
+	}
+	if vm.Displayname == "" {
+		vm.Displayname = vm.Name
+	}
+
+	if ids := r.get("networkids"); ids != "" {
+		for i, id := range strings.Split(ids, ",") {
+			n := s.findNetwork(strings.TrimSpace(id))
+			if n == nil {
+				return nil, errorf(431, "Unable to find network by id %s", id)
+			}
+			vm.Nic = append(vm.Nic, cloudstack.Nic{
+				Id:          newID(),
+				Networkid:   n.Id,
+				Networkname: n.Name,
+				Ipaddress:   s.nextAddress("10.1.1."),
+				Isdefault:   i == 0,
+			})
+			n.State = "Implemented"
+		}
+	}
+
+	s.vms = append(s.vms, vm)
+	s.volumes = append(s.volumes, &cloudstack.Volume{
+		Id:               newID(),
+		Name:             "ROOT-" + vm.Id,
+		Type:             "ROOT",
+		State:            "Ready",
+		Size:             template.Size,
+		Zoneid:           zoneID,
+		Zonename:         zoneName,
+		Virtualmachineid: vm.Id,
+		Vmname:           vm.Name,
+		Templateid:       template.Id,
+		Templatename:     template.Name,
+		Created:          s.timestamp(),
+	})
+
+	if id := r.get("diskofferingid"); id != "" {
+		if _, err := s.newDataVolume("DATA-"+vm.Id, zoneID, id, r.get("size"), vm); err != nil {
+			return nil, err
+		}
+	}
+
+	startVM := r.get("startvm") == "" || r.bool("startvm")
+	if !startVM {
+		vm.State = "Stopped"
+	}
+
+	return s.startJob(r.command, vm.Id, func() (interface{}, error) {
+		if startVM && vm.State == "Starting" {
+			vm.State = "Running"
+		}
+		return map[string]interface{}{"virtualmachine": s.renderVM(vm)}, nil
+	}), nil
+}
+
+func (s *Server) nextAddress(prefix string) string {
+	s.nextIP++
+	return fmt.Sprintf("%s%d", prefix, s.nextIP%250+2)
+}
+
+func (s *Server) listVirtualMachines(r *request) (interface{}, error) {
+	var vms []*cloudstack.VirtualMachine
+	for _, vm := range s.vms {
+		if r.matchesIDs(vm.Id) && matches(r.get("name"), vm.Name) && matchesKeyword(r.get("keyword"), vm.Name) &&
+			matches(r.get("zoneid"), vm.Zoneid) && matches(r.get("state"), vm.State) && r.matchesTags(resourceTypeVM, vm.Id) {
+			vms = append(vms, s.renderVM(vm))
+		}
+	}
+	return list("virtualmachine", vms, len(vms)), nil
+}
+
+// vmOperation starts an async job for an operation on an existing virtual machine
+func (s *Server) vmOperation(r *request, from []string, transition, to string) (interface{}, error) {
+	id, err := r.required("id")
+	if err != nil {
+		return nil, err
+	}
+
+	vm := s.findVM(id)
+	if vm == nil {
+		return nil, errorf(431, "Unable to find virtual machine with id %s", id)
+	}
+
+	allowed := false
+	for _, state := range from {
+		allowed = allowed || vm.State == state
+	}
+	if !allowed {
+		return nil, errorf(431, "Unable to execute %s on virtual machine %s in state %s", r.command, id, vm.State)
+	}
+
+	if transition != "" {
+		vm.State = transition
+	}
+
+	return s.startJob(r.command, vm.Id, func() (interface{}, error) {
+		vm.State = to
+		return map[string]interface{}{"virtualmachine": s.renderVM(vm)}, nil
+	}), nil
+}
+
+func (s *Server) startVirtualMachine(r *request) (interface{}, error) {
+	return s.vmOperation(r, []string{"Stopped"}, "Starting", "Running")
+}
+
+func (s *Server) stopVirtualMachine(r *request) (interface{}, error) {
+	return s.vmOperation(r, []string{"Running"}, "Stopping", "Stopped")
+}
+
+func (s *Server) rebootVirtualMachine(r *request) (interface{}, error) {
+	return s.vmOperation(r, []string{"Running"}, "", "Running")
+}
+
+func (s *Server) destroyVirtualMachine(r *request) (interface{}, error) {
+	resp, err := s.vmOperation(r, []string{"Running", "Stopped", "Starting", "Error"}, "Destroying", "Destroyed")
+	if err != nil || !r.bool("expunge") {
+		return resp, err
+	}
+
+	// Expunge the virtual machine once it is destroyed
+	j := s.jobs[len(s.jobs)-1]
+	run := j.run
+	j.run = func() (interface{}, error) {
+		result, err := run()
+		if err == nil {
+			s.removeVM(r.get("id"))
+		}
+		return result, err
+	}
+	return resp, nil
+}
+
+func (s *Server) expungeVirtualMachine(r *request) (interface{}, error) {
+	id, err := r.required("id")
+	if err != nil {
+		return nil, err
+	}
+
+	vm := s.findVM(id)
+	if vm == nil {
+		return nil, errorf(431, "Unable to find virtual machine with id %s", id)
+	}
+	if vm.State != "Destroyed" {
+		return nil, errorf(431, "Unable to expunge virtual machine %s in state %s", id, vm.State)
+	}
+
+	return s.startJob(r.command, "", func() (interface{}, error) {
+		s.removeVM(id)
+		return map[string]interface{}{"success": true}, nil
+	}), nil
+}
+
+// removeVM removes a virtual machine, its root volume and its tags, and detaches
+// any data volumes
+func (s *Server) removeVM(id string) {
+	vms := s.vms[:0]
+	for _, vm := range s.vms {
+		if vm.Id != id {
+			vms = append(vms, vm)
+		}
+	}
+	s.vms = vms
+
+	volumes := s.volumes[:0]
+	for _, v := range s.volumes {
+		if v.Virtualmachineid == id {
+			if v.Type == "ROOT" {
+				continue
+			}
+			v.Virtualmachineid, v.Vmname, v.Attached, v.Deviceid = "", "", "", 0
+		}
+		volumes = append(volumes, v)
+	}
+	s.volumes = volumes
+
+	s.removeTags(resourceTypeVM, id, nil)
+}
+
+func (s *Server) newDataVolume(name, zoneID, offeringID, size string, vm *cloudstack.VirtualMachine) (*cloudstack.Volume, error) {
+	var offering *cloudstack.DiskOffering
+	for _, o := range s.diskOfferings {
+		if o.Id == offeringID {
+			offering = o
+		}
+	}
+	if offering == nil {
+		return nil, errorf(431, "Unable to find disk offering by id %s", offeringID)
+	}
+
+	gb := offering.Disksize
+	if size != "" {
+		var err error
+		if gb, err = strconv.ParseInt(size, 10, 64); err != nil {
+			return nil, errorf(431, "Unable to verify parameter size: %v", err)
+		}
+	}
+
+	v := &cloudstack.Volume{
+		Id:               newID(),
+		Name:             name,
+		Type:             "DATADISK",
+		State:            "Allocated",
+		Size:             gb << 30,
+		Zoneid:           zoneID,
+		Zonename:         s.zoneName(zoneID),
+		Diskofferingid:   offering.Id,
+		Diskofferingname: offering.Name,
+		Created:          s.timestamp(),
+	}
+	if vm != nil {
+		s.attach(v, vm)
+	}
+	s.volumes = append(s.volumes, v)
+
+	return v, nil
+}
+
+func (s *Server) attach(v *cloudstack.Volume, vm *cloudstack.VirtualMachine) {
+	var deviceID int64 = 1
+	for _, other := range s.volumes {
+		if other.Virtualmachineid == vm.Id && other.Deviceid >= deviceID {
+			deviceID = other.Deviceid + 1
+		}
+	}
+
+	v.State = "Ready"
+	v.Virtualmachineid = vm.Id
+	v.Vmname = vm.Name
+	v.Deviceid = deviceID
+	v.Attached = s.timestamp()
+}
+
+func (s *Server) createVolume(r *request) (interface{}, error) {
+	name, err := r.required("name")
+	if err != nil {
+		return nil, err
+	}
+	zoneID, err := r.required("zoneid")
+	if err != nil {
+		return nil, err
+	}
+	offeringID, err := r.required("diskofferingid")
+	if err != nil {
+		return nil, err
+	}
+
+	if s.zoneName(zoneID) == "" {
+		return nil, errorf(431, "Unable to find zone by id %s", zoneID)
+	}
+
+	v, err := s.newDataVolume(name, zoneID, offeringID, r.get("size"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.startJob(r.command, v.Id, func() (interface{}, error) {
+		return map[string]interface{}{"volume": s.renderVolume(v)}, nil
+	}), nil
+}
+
+func (s *Server) listVolumes(r *request) (interface{}, error) {
+	var volumes []*cloudstack.Volume
+	for _, v := range s.volumes {
+		if r.matchesIDs(v.Id) && matches(r.get("name"), v.Name) && matchesKeyword(r.get("keyword"), v.Name) &&
+			matches(r.get("zoneid"), v.Zoneid) && matches(r.get("virtualmachineid"), v.Virtualmachineid) &&
+			matches(r.get("type"), v.Type) && r.matchesTags(resourceTypeVolume, v.Id) {
+			volumes = append(volumes, s.renderVolume(v))
+		}
+	}
+	return list("volume", volumes, len(volumes)), nil
+}
+
+func (s *Server) attachVolume(r *request) (interface{}, error) {
+	id, err := r.required("id")
+	if err != nil {
+		return nil, err
+	}
+	vmID, err := r.required("virtualmachineid")
+	if err != nil {
+		return nil, err
+	}
+
+	v := s.findVolume(id)
+	if v == nil {
+		return nil, errorf(431, "Unable to find volume with id %s", id)
+	}
+	if v.Virtualmachineid != "" {
+		return nil, errorf(431, "Volume %s is already attached to virtual machine %s", id, v.Virtualmachineid)
+	}
+
+	vm := s.findVM(vmID)
+	if vm == nil || vm.State == "Destroyed" {
+		return nil, errorf(431, "Unable to find virtual machine with id %s", vmID)
+	}
+
+	return s.startJob(r.command, v.Id, func() (interface{}, error) {
+		s.attach(v, vm)
+		return map[string]interface{}{"volume": s.renderVolume(v)}, nil
+	}), nil
+}
+
+func (s *Server) detachVolume(r *request) (interface{}, error) {
+	id, err := r.required("id")
+	if err != nil {
+		return nil, err
+	}
+
+	v := s.findVolume(id)
+	if v == nil {
+		return nil, errorf(431, "Unable to find volume with id %s", id)
+	}
+	if v.Virtualmachineid == "" {
+		return nil, errorf(431, "Volume %s is not attached to a virtual machine", id)
+	}
+	if v.Type == "ROOT" {
+		return nil, errorf(431, "Unable to detach the ROOT volume %s", id)
+	}
+
+	return s.startJob(r.command, v.Id, func() (interface{}, error) {
+		v.Virtualmachineid, v.Vmname, v.Attached, v.Deviceid = "", "", "", 0
+		return map[string]interface{}{"volume": s.renderVolume(v)}, nil
+	}), nil
+}
+
+func (s *Server) deleteVolume(r *request) (interface{}, error) {
+	id, err := r.required("id")
+	if err != nil {
+		return nil, err
+	}
+
+	v := s.findVolume(id)
+	if v == nil {
+		return nil, errorf(431, "Unable to find volume with id %s", id)
+	}
+	if v.Virtualmachineid != "" {
+		return nil, errorf(431, "Please specify a volume that is not attached to any VM")
+	}
+
+	volumes := s.volumes[:0]
+	for _, other := range s.volumes {
+		if other != v {
+			volumes = append(volumes, other)
+		}
+	}
+	s.volumes = volumes
+	s.removeTags(resourceTypeVolume, id, nil)
+
+	return map[string]interface{}{"success": true}, nil
+}
+
+func (s *Server) createNetwork(r *request) (interface{}, error) {
+	name, err := r.required("name")
+	if err != nil {
+		return nil, err
+	}
+	offeringID, err := r.required("networkofferingid")
+	if err != nil {
+		return nil, err
+	}
+	zoneID, err := r.required("zoneid")
+	if err != nil {
+		return nil, err
+	}
+
+	zoneName := s.zoneName(zoneID)
+	if zoneName == "" {
+		return nil, errorf(431, "Unable to find zone by id %s", zoneID)
+	}
+
+	n := &cloudstack.Network{
+		Id:                newID(),
+		Name:              name,
+		Displaytext:       r.get("displaytext"),
+		Networkofferingid: offeringID,
+		State:             "Allocated",
+		Type:              "Isolated",
+		Zoneid:            zoneID,
+		Zonename:          zoneName,
+		Gateway:           r.get("gateway"),
+		Created:           s.timestamp(),
+	}
+	if n.Displaytext == "" {
+		n.Displaytext = name
+	}
+	if n.Gateway == "" {
+		n.Gateway = "10.1.1.1"
+	}
+	n.Cidr = n.Gateway[:strings.LastIndex(n.Gateway, ".")] + ".0/24"
+	s.networks = append(s.networks, n)
+
+	return map[string]interface{}{"network": s.renderNetwork(n)}, nil
+}
+
+func (s *Server) listNetworks(r *request) (interface{}, error) {
+	var networks []*cloudstack.Network
+	for _, n := range s.networks {
+		if r.matchesIDs(n.Id) && matches(r.get("name"), n.Name) && matchesKeyword(r.get("keyword"), n.Name) &&
+			matches(r.get("zoneid"), n.Zoneid) && r.matchesTags(resourceTypeNetwork, n.Id) {
+			networks = append(networks, s.renderNetwork(n))
+		}
+	}
+	return list("network", networks, len(networks)), nil
+}
+
+func (s *Server) deleteNetwork(r *request) (interface{}, error) {
+	id, err := r.required("id")
+	if err != nil {
+		return nil, err
+	}
+
+	n := s.findNetwork(id)
+	if n == nil {
+		return nil, errorf(431, "Unable to find network with id %s", id)
+	}
+
+	return s.startJob(r.command, "", func() (interface{}, error) {
+		for _, vm := range s.vms {
+			for _, nic := range vm.Nic {
+				if nic.Networkid == id && vm.State != "Destroyed" {
+					return nil, errorf(530, "Unable to delete network %s, it is used by virtual machine %s", id, vm.Id)
+				}
+			}
+		}
+
+		networks := s.networks[:0]
+		for _, other := range s.networks {
+			if other != n {
+				networks = append(networks, other)
+			}
+		}
+		s.networks = networks
+		s.removeTags(resourceTypeNetwork, id, nil)
+
+		return map[string]interface{}{"success": true}, nil
+	}), nil
+}
+
+func (s *Server) associateIpAddress(r *request) (interface{}, error) {
+	zoneID := r.get("zoneid")
+	networkID := r.get("networkid")
+
+	var network *cloudstack.Network
+	if networkID != "" {
+		if network = s.findNetwork(networkID); network == nil {
+			return nil, errorf(431, "Unable to find network with id %s", networkID)
+		}
+		zoneID = network.Zoneid
+	}
+
+	zoneName := s.zoneName(zoneID)
+	if zoneName == "" {
+		return nil, errorf(431, "Unable to find zone by id %s", zoneID)
+	}
+
+	ip := &cloudstack.PublicIpAddress{
+		Id:                newID(),
+		Ipaddress:         s.nextAddress("198.51.100."),
+		State:             "Allocating",
+		Zoneid:            zoneID,
+		Zonename:          zoneName,
+		Forvirtualnetwork: true,
+	}
+	if network != nil {
+		ip.Associatednetworkid = network.Id
+		ip.Associatednetworkname = network.Name
+	}
+	s.ips = append(s.ips, ip)
+
+	return s.startJob(r.command, ip.Id, func() (interface{}, error) {
+		ip.State = "Allocated"
+		return map[string]interface{}{"ipaddress": s.renderIP(ip)}, nil
+	}), nil
+}
+
+func (s *Server) listPublicIpAddresses(r *request) (interface{}, error) {
+	var ips []*cloudstack.PublicIpAddress
+	for _, ip := range s.ips {
+		if r.matchesIDs(ip.Id) && matches(r.get("ipaddress"), ip.Ipaddress) && matches(r.get("zoneid"), ip.Zoneid) &&
+			matches(r.get("associatednetworkid"), ip.Associatednetworkid) && r.matchesTags(resourceTypeIPAddress, ip.Id) {
+			ips = append(ips, s.renderIP(ip))
+		}
+	}
+	return list("publicipaddress", ips, len(ips)), nil
+}
+
+func (s *Server) disassociateIpAddress(r *request) (interface{}, error) {
+	id, err := r.required("id")
+	if err != nil {
+		return nil, err
+	}
+
+	ip := s.findIP(id)
+	if ip == nil {
+		return nil, errorf(431, "Unable to find ip address with id %s", id)
+	}
+	ip.State = "Releasing"
+
+	return s.startJob(r.command, "", func() (interface{}, error) {
+		ips := s.ips[:0]
+		for _, other := range s.ips {
+			if other != ip {
+				ips = append(ips, other)
+			}
+		}
+		s.ips = ips
+		s.removeTags(resourceTypeIPAddress, id, nil)
+
+		return map[string]interface{}{"success": true}, nil
+	}), nil
+}
+
+// resourceExists returns true if a resource of the given type exists
+func (s *Server) resourceExists(resourceType, id string) bool {
+	switch strings.ToLower(resourceType) {
+	case strings.ToLower(resourceTypeVM):
+		return s.findVM(id) != nil
+	case strings.ToLower(resourceTypeVolume):
+		return s.findVolume(id) != nil
+	case strings.ToLower(resourceTypeNetwork):
+		return s.findNetwork(id) != nil
+	case strings.ToLower(resourceTypeIPAddress):
+		return s.findIP(id) != nil
+	case strings.ToLower(resourceTypeTemplate):
+		for _, t := range s.templates {
+			if t.Id == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// canonicalResourceType returns the resource type as used in responses
+func canonicalResourceType(resourceType string) string {
+	for _, t := range []string{resourceTypeVM, resourceTypeVolume, resourceTypeNetwork, resourceTypeIPAddress, resourceTypeTemplate} {
+		if strings.EqualFold(t, resourceType) {
+			return t
+		}
+	}
+	return resourceType
+}
+
+func (s *Server) createTags(r *request) (interface{}, error) {
+	ids, err := r.required("resourceids")
+	if err != nil {
+		return nil, err
+	}
+	resourceType, err := r.required("resourcetype")
+	if err != nil {
+		return nil, err
+	}
+	resourceType = canonicalResourceType(resourceType)
+
+	tags := r.indexedMap("tags")
+	if len(tags) == 0 {
+		return nil, errorf(431, "Unable to execute API command createtags due to missing parameter tags")
+	}
+
+	resourceIDs := strings.Split(ids, ",")
+	for _, id := range resourceIDs {
+		if !s.resourceExists(resourceType, id) {
+			return nil, errorf(431, "Unable to find resource by id %s and type %s", id, resourceType)
+		}
+	}
+
+	return s.startJob(r.command, "", func() (interface{}, error) {
+		for _, id := range resourceIDs {
+			for _, t := range tags {
+				s.removeTags(resourceType, id, map[string]string{"key": t["key"]})
+				s.tags = append(s.tags, &cloudstack.Tag{
+					Key:          t["key"],
+					Value:        t["value"],
+					Resourceid:   id,
+					Resourcetype: resourceType,
+				})
+			}
+		}
+		return map[string]interface{}{"success": true}, nil
+	}), nil
+}
+
+func (s *Server) listTags(r *request) (interface{}, error) {
+	var tags []*cloudstack.Tag
+	for _, t := range s.tags {
+		if matches(r.get("resourceid"), t.Resourceid) && matches(r.get("resourcetype"), t.Resourcetype) &&
+			matches(r.get("key"), t.Key) && matches(r.get("value"), t.Value) {
+			c := *t
+			tags = append(tags, &c)
+		}
+	}
+	return list("tag", tags, len(tags)), nil
+}
+
+func (s *Server) deleteTags(r *request) (interface{}, error) {
+	ids, err := r.required("resourceids")
+	if err != nil {
+		return nil, err
+	}
+	resourceType, err := r.required("resourcetype")
+	if err != nil {
+		return nil, err
+	}
+	resourceType = canonicalResourceType(resourceType)
+
+	filters := r.indexedMap("tags")
+	resourceIDs := strings.Split(ids, ",")
+
+	return s.startJob(r.command, "", func() (interface{}, error) {
+		for _, id := range resourceIDs {
+			if len(filters) == 0 {
+				s.removeTags(resourceType, id, nil)
+			}
+			for _, f := range filters {
+				s.removeTags(resourceType, id, f)
+			}
+		}
+		return map[string]interface{}{"success": true}, nil
+	}), nil
+}
+
+// removeTags removes the tags of a resource matching the key and value of the
+// filter, or all tags of the resource if the filter is nil
+func (s *Server) removeTags(resourceType, id string, filter map[string]string) {
+	tags := s.tags[:0]
+	for _, t := range s.tags {
+		if t.Resourcetype == resourceType && t.Resourceid == id &&
+			matches(filter["key"], t.Key) && matches(filter["value"], t.Value) {
+			continue
+		}
+		tags = append(tags, t)
+	}
+	s.tags = tags
+}
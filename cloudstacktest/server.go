@@ -0,0 +1,253 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package cloudstacktest provides a stateful, in-memory CloudStack API simulator
+// for tests. It runs an httptest server that keeps real state for zones, offerings,
+// templates, virtual machines, volumes, networks, public IP addresses, tags and async
+// jobs, and verifies the signature of every request. The FaultTransport can be used
+// with the simulator, or any other backend, to inject faults into API calls.
+//
+// A typical test seeds the simulator and points a client at it:
+//
+//	sim := cloudstacktest.NewServer()
+//	defer sim.Close()
+//
+//	zone := sim.AddZone("zone1")
+//	offering := sim.AddServiceOffering("small", 1, 1024)
+//	template := sim.AddTemplate("centos", zone.Id)
+//
+//	cs := sim.Client()
+//	p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering.Id, template.Id, zone.Id)
+//	vm, err := cs.VirtualMachine.DeployVirtualMachine(p)
+package cloudstacktest
+
+import (
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+const (
+	// DefaultAPIKey is the API key accepted by a new simulator
+	DefaultAPIKey = "simulator-api-key"
+	// DefaultSecretKey is the secret key accepted by a new simulator
+	DefaultSecretKey = "simulator-secret-key"
+)
+
+// Server is a stateful CloudStack API simulator
+type Server struct {
+	*httptest.Server
+
+	APIKey    string
+	SecretKey string
+
+	mu       sync.Mutex
+	jobDelay time.Duration
+	now      func() time.Time
+	commands []string
+	handlers map[string]handlerFunc
+
+	zones            []*cloudstack.Zone
+	serviceOfferings []*cloudstack.ServiceOffering
+	diskOfferings    []*cloudstack.DiskOffering
+	templates        []*cloudstack.Template
+	vms              []*cloudstack.VirtualMachine
+	volumes          []*cloudstack.Volume
+	networks         []*cloudstack.Network
+	ips              []*cloudstack.PublicIpAddress
+	tags             []*cloudstack.Tag
+	jobs             []*job
+	nextIP           int
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithCredentials sets the API and secret key accepted by the simulator
+func WithCredentials(apiKey, secretKey string) Option {
+	return func(s *Server) {
+		s.APIKey = apiKey
+		s.SecretKey = secretKey
+	}
+}
+
+// WithJobDelay sets the time it takes for an async job to finish. By default jobs
+// finish as soon as their result is queried.
+func WithJobDelay(d time.Duration) Option {
+	return func(s *Server) {
+		s.jobDelay = d
+	}
+}
+
+// NewServer starts and returns a new, empty simulator. The caller should call
+// Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		APIKey:    DefaultAPIKey,
+		SecretKey: DefaultSecretKey,
+		now:       time.Now,
+	}
+	for _, fn := range opts {
+		fn(s)
+	}
+	s.registerHandlers()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a new async client for the simulator
+func (s *Server) Client(options ...cloudstack.ClientOption) *cloudstack.CloudStackClient {
+	return cloudstack.NewAsyncClient(s.URL, s.APIKey, s.SecretKey, false, options...)
+}
+
+// Commands returns the names of all API commands received by the simulator, in order
+func (s *Server) Commands() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.commands...)
+}
+
+// apiError is returned by handlers to send an API error response
+type apiError struct {
+	code int
+	text string
+}
+
+func (e *apiError) Error() string {
+	return e.text
+}
+
+func errorf(code int, format string, args ...interface{}) *apiError {
+	return &apiError{code: code, text: fmt.Sprintf(format, args...)}
+}
+
+type handlerFunc func(r *request) (interface{}, error)
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		body, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = body
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	command := r.Form.Get("command")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.commands = append(s.commands, command)
+	s.processJobs()
+
+	if !s.verifySignature(r) {
+		writeError(w, command, errorf(401, "unable to verify user credentials and/or request signature"))
+		return
+	}
+
+	handler, ok := s.handlers[strings.ToLower(command)]
+	if !ok {
+		writeError(w, command, errorf(432, "The given command does not exist or it is not available for user"))
+		return
+	}
+
+	result, err := handler(&request{Server: s, command: command, form: r.Form})
+	if err != nil {
+		e, ok := err.(*apiError)
+		if !ok {
+			e = errorf(530, "%v", err)
+		}
+		writeError(w, command, e)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, command, result)
+}
+
+// verifySignature verifies the request signature the same way the API does
+func (s *Server) verifySignature(r *http.Request) bool {
+	params := make(url.Values, len(r.Form))
+	for k, v := range r.Form {
+		if k != "signature" {
+			params[k] = v
+		}
+	}
+	if r.Form.Get("apiKey") != s.APIKey {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.SecretKey))
+	mac.Write([]byte(strings.ToLower(cloudstack.EncodeValues(params))))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(r.Form.Get("signature")))
+}
+
+func writeResponse(w http.ResponseWriter, status int, command string, result interface{}) {
+	b, err := json.Marshal(map[string]interface{}{strings.ToLower(command) + "response": result})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+func writeError(w http.ResponseWriter, command string, e *apiError) {
+	writeResponse(w, e.code, command, map[string]interface{}{
+		"errorcode":   e.code,
+		"cserrorcode": 9999,
+		"errortext":   e.text,
+	})
+}
+
+// newID returns a new random UUID
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// timestamp returns the current time in the format used by the API
+func (s *Server) timestamp() string {
+	return s.now().Format("2006-01-02T15:04:05-0700")
+}
@@ -0,0 +1,18566 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+// groups returns all command groups, one for each service
+func groups() []*group {
+	return []*group{
+		{
+			name: "apidiscovery",
+			commands: []func() *command{
+				newListApisCommand,
+			},
+		},
+		{
+			name: "account",
+			commands: []func() *command{
+				newCreateAccountCommand,
+				newDeleteAccountCommand,
+				newDisableAccountCommand,
+				newEnableAccountCommand,
+				newGetSolidFireAccountIdCommand,
+				newListAccountsCommand,
+				newListProjectAccountsCommand,
+				newLockAccountCommand,
+				newMarkDefaultZoneForAccountCommand,
+				newUpdateAccountCommand,
+			},
+		},
+		{
+			name: "address",
+			commands: []func() *command{
+				newAssociateIpAddressCommand,
+				newDisassociateIpAddressCommand,
+				newListPublicIpAddressesCommand,
+				newUpdateIpAddressCommand,
+				newReleaseIpAddressCommand,
+			},
+		},
+		{
+			name: "affinitygroup",
+			commands: []func() *command{
+				newCreateAffinityGroupCommand,
+				newDeleteAffinityGroupCommand,
+				newListAffinityGroupTypesCommand,
+				newListAffinityGroupsCommand,
+				newUpdateVMAffinityGroupCommand,
+			},
+		},
+		{
+			name: "alert",
+			commands: []func() *command{
+				newArchiveAlertsCommand,
+				newDeleteAlertsCommand,
+				newGenerateAlertCommand,
+				newListAlertsCommand,
+			},
+		},
+		{
+			name: "annotation",
+			commands: []func() *command{
+				newAddAnnotationCommand,
+				newListAnnotationsCommand,
+				newRemoveAnnotationCommand,
+				newUpdateAnnotationVisibilityCommand,
+			},
+		},
+		{
+			name: "asyncjob",
+			commands: []func() *command{
+				newListAsyncJobsCommand,
+				newQueryAsyncJobResultCommand,
+			},
+		},
+		{
+			name: "authentication",
+			commands: []func() *command{
+				newLoginCommand,
+				newLogoutCommand,
+			},
+		},
+		{
+			name: "autoscale",
+			commands: []func() *command{
+				newCreateAutoScalePolicyCommand,
+				newCreateAutoScaleVmGroupCommand,
+				newCreateAutoScaleVmProfileCommand,
+				newCreateConditionCommand,
+				newCreateCounterCommand,
+				newDeleteAutoScalePolicyCommand,
+				newDeleteAutoScaleVmGroupCommand,
+				newDeleteAutoScaleVmProfileCommand,
+				newDeleteConditionCommand,
+				newDeleteCounterCommand,
+				newDisableAutoScaleVmGroupCommand,
+				newEnableAutoScaleVmGroupCommand,
+				newListAutoScalePoliciesCommand,
+				newListAutoScaleVmGroupsCommand,
+				newListAutoScaleVmProfilesCommand,
+				newListConditionsCommand,
+				newListCountersCommand,
+				newUpdateAutoScalePolicyCommand,
+				newUpdateAutoScaleVmGroupCommand,
+				newUpdateAutoScaleVmProfileCommand,
+			},
+		},
+		{
+			name: "backup",
+			commands: []func() *command{
+				newAssignVirtualMachineToBackupOfferingCommand,
+				newCreateBackupCommand,
+				newCreateBackupScheduleCommand,
+				newDeleteBackupCommand,
+				newDeleteBackupOfferingCommand,
+				newDeleteBackupScheduleCommand,
+				newImportBackupOfferingCommand,
+				newListBackupOfferingsCommand,
+				newListBackupProviderOfferingsCommand,
+				newListBackupProvidersCommand,
+				newListBackupScheduleCommand,
+				newListBackupsCommand,
+				newRemoveVirtualMachineFromBackupOfferingCommand,
+				newRestoreBackupCommand,
+				newRestoreVolumeFromBackupAndAttachToVMCommand,
+				newUpdateBackupOfferingCommand,
+				newUpdateBackupScheduleCommand,
+			},
+		},
+		{
+			name: "baremetal",
+			commands: []func() *command{
+				newAddBaremetalDhcpCommand,
+				newAddBaremetalPxeKickStartServerCommand,
+				newAddBaremetalPxePingServerCommand,
+				newAddBaremetalRctCommand,
+				newDeleteBaremetalRctCommand,
+				newListBaremetalDhcpCommand,
+				newListBaremetalPxeServersCommand,
+				newListBaremetalRctCommand,
+				newNotifyBaremetalProvisionDoneCommand,
+			},
+		},
+		{
+			name: "bigswitchbcf",
+			commands: []func() *command{
+				newAddBigSwitchBcfDeviceCommand,
+				newDeleteBigSwitchBcfDeviceCommand,
+				newListBigSwitchBcfDevicesCommand,
+			},
+		},
+		{
+			name: "brocadevcs",
+			commands: []func() *command{
+				newAddBrocadeVcsDeviceCommand,
+				newDeleteBrocadeVcsDeviceCommand,
+				newListBrocadeVcsDeviceNetworksCommand,
+				newListBrocadeVcsDevicesCommand,
+			},
+		},
+		{
+			name: "certificate",
+			commands: []func() *command{
+				newUploadCustomCertificateCommand,
+			},
+		},
+		{
+			name: "cloudidentifier",
+			commands: []func() *command{
+				newGetCloudIdentifierCommand,
+			},
+		},
+		{
+			name: "cluster",
+			commands: []func() *command{
+				newAddClusterCommand,
+				newDedicateClusterCommand,
+				newDeleteClusterCommand,
+				newDisableOutOfBandManagementForClusterCommand,
+				newEnableOutOfBandManagementForClusterCommand,
+				newEnableHAForClusterCommand,
+				newDisableHAForClusterCommand,
+				newListClustersCommand,
+				newListClustersMetricsCommand,
+				newListDedicatedClustersCommand,
+				newReleaseDedicatedClusterCommand,
+				newUpdateClusterCommand,
+			},
+		},
+		{
+			name: "configuration",
+			commands: []func() *command{
+				newListCapabilitiesCommand,
+				newListConfigurationsCommand,
+				newListDeploymentPlannersCommand,
+				newUpdateConfigurationCommand,
+				newResetConfigurationCommand,
+			},
+		},
+		{
+			name: "consoleendpoint",
+			commands: []func() *command{
+				newCreateConsoleEndpointCommand,
+			},
+		},
+		{
+			name: "diskoffering",
+			commands: []func() *command{
+				newCreateDiskOfferingCommand,
+				newDeleteDiskOfferingCommand,
+				newListDiskOfferingsCommand,
+				newUpdateDiskOfferingCommand,
+			},
+		},
+		{
+			name: "domain",
+			commands: []func() *command{
+				newCreateDomainCommand,
+				newDeleteDomainCommand,
+				newListDomainChildrenCommand,
+				newListDomainsCommand,
+				newUpdateDomainCommand,
+			},
+		},
+		{
+			name: "event",
+			commands: []func() *command{
+				newArchiveEventsCommand,
+				newDeleteEventsCommand,
+				newListEventTypesCommand,
+				newListEventsCommand,
+			},
+		},
+		{
+			name: "firewall",
+			commands: []func() *command{
+				newAddPaloAltoFirewallCommand,
+				newConfigurePaloAltoFirewallCommand,
+				newCreateEgressFirewallRuleCommand,
+				newCreateFirewallRuleCommand,
+				newCreatePortForwardingRuleCommand,
+				newDeleteEgressFirewallRuleCommand,
+				newDeleteFirewallRuleCommand,
+				newDeletePaloAltoFirewallCommand,
+				newDeletePortForwardingRuleCommand,
+				newListEgressFirewallRulesCommand,
+				newListFirewallRulesCommand,
+				newListPaloAltoFirewallsCommand,
+				newListPortForwardingRulesCommand,
+				newUpdateEgressFirewallRuleCommand,
+				newUpdateFirewallRuleCommand,
+				newUpdatePortForwardingRuleCommand,
+				newListIpv6FirewallRulesCommand,
+				newCreateIpv6FirewallRuleCommand,
+				newUpdateIpv6FirewallRuleCommand,
+				newDeleteIpv6FirewallRuleCommand,
+			},
+		},
+		{
+			name: "guestos",
+			commands: []func() *command{
+				newAddGuestOsCommand,
+				newAddGuestOsMappingCommand,
+				newListGuestOsMappingCommand,
+				newListOsCategoriesCommand,
+				newListOsTypesCommand,
+				newRemoveGuestOsCommand,
+				newRemoveGuestOsMappingCommand,
+				newUpdateGuestOsCommand,
+				newUpdateGuestOsMappingCommand,
+			},
+		},
+		{
+			name: "host",
+			commands: []func() *command{
+				newAddBaremetalHostCommand,
+				newAddGloboDnsHostCommand,
+				newAddHostCommand,
+				newAddSecondaryStorageCommand,
+				newCancelHostMaintenanceCommand,
+				newConfigureHAForHostCommand,
+				newEnableHAForHostCommand,
+				newDedicateHostCommand,
+				newDeleteHostCommand,
+				newDisableOutOfBandManagementForHostCommand,
+				newEnableOutOfBandManagementForHostCommand,
+				newFindHostsForMigrationCommand,
+				newListDedicatedHostsCommand,
+				newListHostTagsCommand,
+				newListHostsCommand,
+				newListHostsMetricsCommand,
+				newPrepareHostForMaintenanceCommand,
+				newReconnectHostCommand,
+				newReleaseDedicatedHostCommand,
+				newReleaseHostReservationCommand,
+				newUpdateHostCommand,
+				newUpdateHostPasswordCommand,
+			},
+		},
+		{
+			name: "hypervisor",
+			commands: []func() *command{
+				newListHypervisorCapabilitiesCommand,
+				newListHypervisorsCommand,
+				newUpdateHypervisorCapabilitiesCommand,
+			},
+		},
+		{
+			name: "iso",
+			commands: []func() *command{
+				newAttachIsoCommand,
+				newCopyIsoCommand,
+				newDeleteIsoCommand,
+				newDetachIsoCommand,
+				newExtractIsoCommand,
+				newListIsoPermissionsCommand,
+				newListIsosCommand,
+				newRegisterIsoCommand,
+				newUpdateIsoCommand,
+				newUpdateIsoPermissionsCommand,
+			},
+		},
+		{
+			name: "imagestore",
+			commands: []func() *command{
+				newAddImageStoreCommand,
+				newAddImageStoreS3Command,
+				newCreateSecondaryStagingStoreCommand,
+				newDeleteImageStoreCommand,
+				newDeleteSecondaryStagingStoreCommand,
+				newListImageStoresCommand,
+				newListSecondaryStagingStoresCommand,
+				newUpdateCloudToUseObjectStoreCommand,
+			},
+		},
+		{
+			name: "infrastructureusage",
+			commands: []func() *command{
+				newListManagementServersMetricsCommand,
+				newListDbMetricsCommand,
+			},
+		},
+		{
+			name: "internallb",
+			commands: []func() *command{
+				newConfigureInternalLoadBalancerElementCommand,
+				newCreateInternalLoadBalancerElementCommand,
+				newListInternalLoadBalancerElementsCommand,
+				newListInternalLoadBalancerVMsCommand,
+				newStartInternalLoadBalancerVMCommand,
+				newStopInternalLoadBalancerVMCommand,
+			},
+		},
+		{
+			name: "kubernetes",
+			commands: []func() *command{
+				newAddKubernetesSupportedVersionCommand,
+				newCreateKubernetesClusterCommand,
+				newDeleteKubernetesClusterCommand,
+				newDeleteKubernetesSupportedVersionCommand,
+				newGetKubernetesClusterConfigCommand,
+				newListKubernetesClustersCommand,
+				newListKubernetesSupportedVersionsCommand,
+				newScaleKubernetesClusterCommand,
+				newStartKubernetesClusterCommand,
+				newStopKubernetesClusterCommand,
+				newUpdateKubernetesSupportedVersionCommand,
+				newUpgradeKubernetesClusterCommand,
+				newAddVirtualMachinesToKubernetesClusterCommand,
+				newRemoveVirtualMachinesFromKubernetesClusterCommand,
+			},
+		},
+		{
+			name: "ldap",
+			commands: []func() *command{
+				newAddLdapConfigurationCommand,
+				newDeleteLdapConfigurationCommand,
+				newImportLdapUsersCommand,
+				newLdapConfigCommand,
+				newLdapCreateAccountCommand,
+				newLdapRemoveCommand,
+				newLinkDomainToLdapCommand,
+				newListLdapConfigurationsCommand,
+				newListLdapUsersCommand,
+				newSearchLdapCommand,
+			},
+		},
+		{
+			name: "limit",
+			commands: []func() *command{
+				newGetApiLimitCommand,
+				newListResourceLimitsCommand,
+				newResetApiLimitCommand,
+				newUpdateResourceCountCommand,
+				newUpdateResourceLimitCommand,
+			},
+		},
+		{
+			name: "loadbalancer",
+			commands: []func() *command{
+				newAddNetscalerLoadBalancerCommand,
+				newAssignCertToLoadBalancerCommand,
+				newAssignToGlobalLoadBalancerRuleCommand,
+				newAssignToLoadBalancerRuleCommand,
+				newConfigureNetscalerLoadBalancerCommand,
+				newCreateGlobalLoadBalancerRuleCommand,
+				newCreateLBHealthCheckPolicyCommand,
+				newCreateLBStickinessPolicyCommand,
+				newCreateLoadBalancerCommand,
+				newCreateLoadBalancerRuleCommand,
+				newDeleteGlobalLoadBalancerRuleCommand,
+				newDeleteLBHealthCheckPolicyCommand,
+				newDeleteLBStickinessPolicyCommand,
+				newDeleteLoadBalancerCommand,
+				newDeleteLoadBalancerRuleCommand,
+				newDeleteNetscalerLoadBalancerCommand,
+				newDeleteSslCertCommand,
+				newListGlobalLoadBalancerRulesCommand,
+				newListLBHealthCheckPoliciesCommand,
+				newListLBStickinessPoliciesCommand,
+				newListLoadBalancerRuleInstancesCommand,
+				newListLoadBalancerRulesCommand,
+				newListLoadBalancersCommand,
+				newListNetscalerLoadBalancersCommand,
+				newListSslCertsCommand,
+				newRemoveCertFromLoadBalancerCommand,
+				newRemoveFromGlobalLoadBalancerRuleCommand,
+				newRemoveFromLoadBalancerRuleCommand,
+				newUpdateGlobalLoadBalancerRuleCommand,
+				newUpdateLBHealthCheckPolicyCommand,
+				newUpdateLBStickinessPolicyCommand,
+				newUpdateLoadBalancerCommand,
+				newUpdateLoadBalancerRuleCommand,
+				newUploadSslCertCommand,
+			},
+		},
+		{
+			name: "nat",
+			commands: []func() *command{
+				newCreateIpForwardingRuleCommand,
+				newDeleteIpForwardingRuleCommand,
+				newDisableStaticNatCommand,
+				newEnableStaticNatCommand,
+				newListIpForwardingRulesCommand,
+			},
+		},
+		{
+			name: "networkacl",
+			commands: []func() *command{
+				newCreateNetworkACLCommand,
+				newCreateNetworkACLListCommand,
+				newDeleteNetworkACLCommand,
+				newDeleteNetworkACLListCommand,
+				newListNetworkACLListsCommand,
+				newListNetworkACLsCommand,
+				newReplaceNetworkACLListCommand,
+				newUpdateNetworkACLItemCommand,
+				newUpdateNetworkACLListCommand,
+			},
+		},
+		{
+			name: "networkdevice",
+			commands: []func() *command{
+				newAddNetworkDeviceCommand,
+				newDeleteNetworkDeviceCommand,
+				newListNetworkDeviceCommand,
+			},
+		},
+		{
+			name: "networkoffering",
+			commands: []func() *command{
+				newCreateNetworkOfferingCommand,
+				newDeleteNetworkOfferingCommand,
+				newListNetworkOfferingsCommand,
+				newUpdateNetworkOfferingCommand,
+			},
+		},
+		{
+			name: "network",
+			commands: []func() *command{
+				newAddNetworkServiceProviderCommand,
+				newAddOpenDaylightControllerCommand,
+				newCreateNetworkCommand,
+				newCreatePhysicalNetworkCommand,
+				newCreateServiceInstanceCommand,
+				newCreateStorageNetworkIpRangeCommand,
+				newDedicatePublicIpRangeCommand,
+				newDeleteNetworkCommand,
+				newDeleteNetworkServiceProviderCommand,
+				newDeleteOpenDaylightControllerCommand,
+				newDeletePhysicalNetworkCommand,
+				newDeleteStorageNetworkIpRangeCommand,
+				newListNetscalerLoadBalancerNetworksCommand,
+				newListNetworkIsolationMethodsCommand,
+				newListNetworkServiceProvidersCommand,
+				newListNetworksCommand,
+				newListNiciraNvpDeviceNetworksCommand,
+				newListOpenDaylightControllersCommand,
+				newListPaloAltoFirewallNetworksCommand,
+				newListPhysicalNetworksCommand,
+				newListStorageNetworkIpRangeCommand,
+				newListSupportedNetworkServicesCommand,
+				newReleasePublicIpRangeCommand,
+				newRestartNetworkCommand,
+				newUpdateNetworkCommand,
+				newUpdateNetworkServiceProviderCommand,
+				newUpdatePhysicalNetworkCommand,
+				newUpdateStorageNetworkIpRangeCommand,
+				newDeleteGuestNetworkIpv6PrefixCommand,
+				newCreateGuestNetworkIpv6PrefixCommand,
+				newListGuestNetworkIpv6PrefixesCommand,
+				newCreateNetworkPermissionsCommand,
+				newResetNetworkPermissionsCommand,
+				newListNetworkPermissionsCommand,
+				newRemoveNetworkPermissionsCommand,
+			},
+		},
+		{
+			name: "nic",
+			commands: []func() *command{
+				newAddIpToNicCommand,
+				newListNicsCommand,
+				newRemoveIpFromNicCommand,
+				newUpdateVmNicIpCommand,
+			},
+		},
+		{
+			name: "niciranvp",
+			commands: []func() *command{
+				newAddNiciraNvpDeviceCommand,
+				newDeleteNiciraNvpDeviceCommand,
+				newListNiciraNvpDevicesCommand,
+			},
+		},
+		{
+			name: "objectstore",
+			commands: []func() *command{
+				newAddObjectStoragePoolCommand,
+				newCreateBucketCommand,
+				newDeleteBucketCommand,
+				newDeleteObjectStoragePoolCommand,
+				newListBucketsCommand,
+				newListObjectStoragePoolsCommand,
+				newUpdateBucketCommand,
+				newUpdateObjectStoragePoolCommand,
+			},
+		},
+		{
+			name: "outofbandmanagement",
+			commands: []func() *command{
+				newChangeOutOfBandManagementPasswordCommand,
+				newConfigureOutOfBandManagementCommand,
+				newIssueOutOfBandManagementPowerActionCommand,
+			},
+		},
+		{
+			name: "ovselement",
+			commands: []func() *command{
+				newConfigureOvsElementCommand,
+				newListOvsElementsCommand,
+			},
+		},
+		{
+			name: "pod",
+			commands: []func() *command{
+				newCreatePodCommand,
+				newDedicatePodCommand,
+				newDeletePodCommand,
+				newListDedicatedPodsCommand,
+				newListPodsCommand,
+				newReleaseDedicatedPodCommand,
+				newUpdatePodCommand,
+			},
+		},
+		{
+			name: "pool",
+			commands: []func() *command{
+				newCreateStoragePoolCommand,
+				newDeleteStoragePoolCommand,
+				newFindStoragePoolsForMigrationCommand,
+				newListStoragePoolsCommand,
+				newSyncStoragePoolCommand,
+				newUpdateStoragePoolCommand,
+			},
+		},
+		{
+			name: "portableip",
+			commands: []func() *command{
+				newCreatePortableIpRangeCommand,
+				newDeletePortableIpRangeCommand,
+				newListPortableIpRangesCommand,
+			},
+		},
+		{
+			name: "project",
+			commands: []func() *command{
+				newActivateProjectCommand,
+				newAddAccountToProjectCommand,
+				newAddUserToProjectCommand,
+				newCreateProjectCommand,
+				newDeleteAccountFromProjectCommand,
+				newDeleteUserFromProjectCommand,
+				newDeleteProjectCommand,
+				newDeleteProjectInvitationCommand,
+				newListProjectInvitationsCommand,
+				newListProjectsCommand,
+				newSuspendProjectCommand,
+				newUpdateProjectCommand,
+				newUpdateProjectInvitationCommand,
+				newListProjectRolePermissionsCommand,
+				newCreateProjectRolePermissionCommand,
+				newUpdateProjectRolePermissionCommand,
+				newDeleteProjectRolePermissionCommand,
+			},
+		},
+		{
+			name: "quota",
+			commands: []func() *command{
+				newQuotaBalanceCommand,
+				newQuotaCreditsCommand,
+				newQuotaEmailTemplateListCommand,
+				newQuotaEmailTemplateUpdateCommand,
+				newQuotaIsEnabledCommand,
+				newQuotaStatementCommand,
+				newQuotaSummaryCommand,
+				newQuotaTariffCreateCommand,
+				newQuotaTariffDeleteCommand,
+				newQuotaTariffListCommand,
+				newQuotaTariffUpdateCommand,
+				newQuotaUpdateCommand,
+			},
+		},
+		{
+			name: "region",
+			commands: []func() *command{
+				newAddRegionCommand,
+				newListRegionsCommand,
+				newRemoveRegionCommand,
+				newUpdateRegionCommand,
+			},
+		},
+		{
+			name: "resourcemetadata",
+			commands: []func() *command{
+				newAddResourceDetailCommand,
+				newGetVolumeSnapshotDetailsCommand,
+				newListResourceDetailsCommand,
+				newRemoveResourceDetailCommand,
+			},
+		},
+		{
+			name: "resourcetags",
+			commands: []func() *command{
+				newCreateTagsCommand,
+				newDeleteTagsCommand,
+				newListStorageTagsCommand,
+				newListTagsCommand,
+			},
+		},
+		{
+			name: "role",
+			commands: []func() *command{
+				newCreateRoleCommand,
+				newCreateRolePermissionCommand,
+				newDeleteRoleCommand,
+				newDeleteRolePermissionCommand,
+				newImportRoleCommand,
+				newListRolePermissionsCommand,
+				newListRolesCommand,
+				newUpdateRoleCommand,
+				newUpdateRolePermissionCommand,
+			},
+		},
+		{
+			name: "router",
+			commands: []func() *command{
+				newChangeServiceForRouterCommand,
+				newConfigureVirtualRouterElementCommand,
+				newCreateVirtualRouterElementCommand,
+				newDestroyRouterCommand,
+				newListRoutersCommand,
+				newListVirtualRouterElementsCommand,
+				newRebootRouterCommand,
+				newStartRouterCommand,
+				newStopRouterCommand,
+			},
+		},
+		{
+			name: "ssh",
+			commands: []func() *command{
+				newCreateSSHKeyPairCommand,
+				newDeleteSSHKeyPairCommand,
+				newListSSHKeyPairsCommand,
+				newRegisterSSHKeyPairCommand,
+				newResetSSHKeyForVirtualMachineCommand,
+			},
+		},
+		{
+			name: "securitygroup",
+			commands: []func() *command{
+				newAuthorizeSecurityGroupEgressCommand,
+				newAuthorizeSecurityGroupIngressCommand,
+				newCreateSecurityGroupCommand,
+				newDeleteSecurityGroupCommand,
+				newListSecurityGroupsCommand,
+				newRevokeSecurityGroupEgressCommand,
+				newRevokeSecurityGroupIngressCommand,
+			},
+		},
+		{
+			name: "serviceoffering",
+			commands: []func() *command{
+				newCreateServiceOfferingCommand,
+				newDeleteServiceOfferingCommand,
+				newListServiceOfferingsCommand,
+				newUpdateServiceOfferingCommand,
+			},
+		},
+		{
+			name: "snapshot",
+			commands: []func() *command{
+				newCreateSnapshotCommand,
+				newCreateSnapshotPolicyCommand,
+				newCreateVMSnapshotCommand,
+				newDeleteSnapshotCommand,
+				newDeleteSnapshotPoliciesCommand,
+				newDeleteVMSnapshotCommand,
+				newListSnapshotPoliciesCommand,
+				newListSnapshotsCommand,
+				newListVMSnapshotCommand,
+				newRevertSnapshotCommand,
+				newRevertToVMSnapshotCommand,
+				newUpdateSnapshotPolicyCommand,
+			},
+		},
+		{
+			name: "storagepool",
+			commands: []func() *command{
+				newCancelStorageMaintenanceCommand,
+				newEnableStorageMaintenanceCommand,
+				newListStorageProvidersCommand,
+			},
+		},
+		{
+			name: "stratospheressp",
+			commands: []func() *command{
+				newAddStratosphereSspCommand,
+				newDeleteStratosphereSspCommand,
+			},
+		},
+		{
+			name: "swift",
+			commands: []func() *command{
+				newAddSwiftCommand,
+				newListSwiftsCommand,
+			},
+		},
+		{
+			name: "systemcapacity",
+			commands: []func() *command{
+				newListCapacityCommand,
+			},
+		},
+		{
+			name: "systemvm",
+			commands: []func() *command{
+				newChangeServiceForSystemVmCommand,
+				newDestroySystemVmCommand,
+				newListSystemVmsCommand,
+				newMigrateSystemVmCommand,
+				newRebootSystemVmCommand,
+				newScaleSystemVmCommand,
+				newStartSystemVmCommand,
+				newStopSystemVmCommand,
+				newPatchSystemVmCommand,
+			},
+		},
+		{
+			name: "template",
+			commands: []func() *command{
+				newCopyTemplateCommand,
+				newCreateTemplateCommand,
+				newDeleteTemplateCommand,
+				newExtractTemplateCommand,
+				newGetUploadParamsForTemplateCommand,
+				newListTemplatePermissionsCommand,
+				newListTemplatesCommand,
+				newPrepareTemplateCommand,
+				newRegisterTemplateCommand,
+				newUpdateTemplateCommand,
+				newUpdateTemplatePermissionsCommand,
+				newUpgradeRouterTemplateCommand,
+				newListTemplateDirectDownloadCertificatesCommand,
+				newProvisionTemplateDirectDownloadCertificateCommand,
+			},
+		},
+		{
+			name: "ucs",
+			commands: []func() *command{
+				newAddUcsManagerCommand,
+				newAssociateUcsProfileToBladeCommand,
+				newDeleteUcsManagerCommand,
+				newListUcsBladesCommand,
+				newListUcsManagersCommand,
+				newListUcsProfilesCommand,
+			},
+		},
+		{
+			name: "usage",
+			commands: []func() *command{
+				newAddTrafficMonitorCommand,
+				newAddTrafficTypeCommand,
+				newDeleteTrafficMonitorCommand,
+				newDeleteTrafficTypeCommand,
+				newGenerateUsageRecordsCommand,
+				newListTrafficMonitorsCommand,
+				newListTrafficTypeImplementorsCommand,
+				newListTrafficTypesCommand,
+				newListUsageRecordsCommand,
+				newListUsageTypesCommand,
+				newRemoveRawUsageRecordsCommand,
+				newUpdateTrafficTypeCommand,
+				newListUsageServerMetricsCommand,
+			},
+		},
+		{
+			name: "userdata",
+			commands: []func() *command{
+				newDeleteUserDataCommand,
+				newLinkUserDataToTemplateCommand,
+				newListUserDataCommand,
+				newRegisterUserDataCommand,
+			},
+		},
+		{
+			name: "user",
+			commands: []func() *command{
+				newCreateUserCommand,
+				newDeleteUserCommand,
+				newDisableUserCommand,
+				newEnableUserCommand,
+				newGetUserCommand,
+				newGetUserKeysCommand,
+				newGetVirtualMachineUserDataCommand,
+				newListUsersCommand,
+				newLockUserCommand,
+				newRegisterUserKeysCommand,
+				newUpdateUserCommand,
+			},
+		},
+		{
+			name: "vlan",
+			commands: []func() *command{
+				newCreateVlanIpRangeCommand,
+				newDedicateGuestVlanRangeCommand,
+				newDeleteVlanIpRangeCommand,
+				newListDedicatedGuestVlanRangesCommand,
+				newListVlanIpRangesCommand,
+				newReleaseDedicatedGuestVlanRangeCommand,
+				newListGuestVlansCommand,
+				newUpdateVlanIpRangeCommand,
+			},
+		},
+		{
+			name: "vmgroup",
+			commands: []func() *command{
+				newCreateInstanceGroupCommand,
+				newDeleteInstanceGroupCommand,
+				newListInstanceGroupsCommand,
+				newUpdateInstanceGroupCommand,
+			},
+		},
+		{
+			name: "vpc",
+			commands: []func() *command{
+				newCreatePrivateGatewayCommand,
+				newCreateStaticRouteCommand,
+				newCreateVPCCommand,
+				newCreateVPCOfferingCommand,
+				newDeletePrivateGatewayCommand,
+				newDeleteStaticRouteCommand,
+				newDeleteVPCCommand,
+				newDeleteVPCOfferingCommand,
+				newListPrivateGatewaysCommand,
+				newListStaticRoutesCommand,
+				newListVPCOfferingsCommand,
+				newListVPCsCommand,
+				newRestartVPCCommand,
+				newUpdateVPCCommand,
+				newUpdateVPCOfferingCommand,
+			},
+		},
+		{
+			name: "vpn",
+			commands: []func() *command{
+				newAddVpnUserCommand,
+				newCreateRemoteAccessVpnCommand,
+				newCreateVpnConnectionCommand,
+				newCreateVpnCustomerGatewayCommand,
+				newCreateVpnGatewayCommand,
+				newDeleteRemoteAccessVpnCommand,
+				newDeleteVpnConnectionCommand,
+				newDeleteVpnCustomerGatewayCommand,
+				newDeleteVpnGatewayCommand,
+				newListRemoteAccessVpnsCommand,
+				newListVpnConnectionsCommand,
+				newListVpnCustomerGatewaysCommand,
+				newListVpnGatewaysCommand,
+				newListVpnUsersCommand,
+				newRemoveVpnUserCommand,
+				newResetVpnConnectionCommand,
+				newUpdateRemoteAccessVpnCommand,
+				newUpdateVpnConnectionCommand,
+				newUpdateVpnCustomerGatewayCommand,
+				newUpdateVpnGatewayCommand,
+			},
+		},
+		{
+			name: "virtualmachine",
+			commands: []func() *command{
+				newAddNicToVirtualMachineCommand,
+				newAssignVirtualMachineCommand,
+				newChangeServiceForVirtualMachineCommand,
+				newCleanVMReservationsCommand,
+				newDeployVirtualMachineCommand,
+				newDestroyVirtualMachineCommand,
+				newExpungeVirtualMachineCommand,
+				newGetVMPasswordCommand,
+				newListVirtualMachinesCommand,
+				newListVirtualMachinesMetricsCommand,
+				newMigrateVirtualMachineCommand,
+				newMigrateVirtualMachineWithVolumeCommand,
+				newRebootVirtualMachineCommand,
+				newRecoverVirtualMachineCommand,
+				newRemoveNicFromVirtualMachineCommand,
+				newResetPasswordForVirtualMachineCommand,
+				newResetUserDataForVirtualMachineCommand,
+				newRestoreVirtualMachineCommand,
+				newScaleVirtualMachineCommand,
+				newStartVirtualMachineCommand,
+				newStopVirtualMachineCommand,
+				newUpdateDefaultNicForVirtualMachineCommand,
+				newUpdateVirtualMachineCommand,
+				newListVirtualMachinesUsageHistoryCommand,
+			},
+		},
+		{
+			name: "volume",
+			commands: []func() *command{
+				newAttachVolumeCommand,
+				newCreateVolumeCommand,
+				newDeleteVolumeCommand,
+				newDestroyVolumeCommand,
+				newDetachVolumeCommand,
+				newExtractVolumeCommand,
+				newGetPathForVolumeCommand,
+				newGetSolidFireVolumeSizeCommand,
+				newGetUploadParamsForVolumeCommand,
+				newGetVolumeiScsiNameCommand,
+				newListVolumesCommand,
+				newListVolumesMetricsCommand,
+				newMigrateVolumeCommand,
+				newRecoverVolumeCommand,
+				newResizeVolumeCommand,
+				newUpdateVolumeCommand,
+				newUploadVolumeCommand,
+				newChangeOfferingForVolumeCommand,
+			},
+		},
+		{
+			name: "zone",
+			commands: []func() *command{
+				newCreateZoneCommand,
+				newDedicateZoneCommand,
+				newDeleteZoneCommand,
+				newDisableOutOfBandManagementForZoneCommand,
+				newEnableOutOfBandManagementForZoneCommand,
+				newDisableHAForZoneCommand,
+				newEnableHAForZoneCommand,
+				newListDedicatedZonesCommand,
+				newListZonesCommand,
+				newListZonesMetricsCommand,
+				newReleaseDedicatedZoneCommand,
+				newUpdateZoneCommand,
+			},
+		},
+	}
+}
+
+// resolvers contains the helpers used to resolve names to IDs, by the name of the ID param
+var resolvers = map[string]resolver{
+	"accountid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Account.GetAccountID(name)
+	},
+	"affinitygroupid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.AffinityGroup.GetAffinityGroupID(name)
+	},
+	"alertid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Alert.GetAlertID(name)
+	},
+	"autoscalepolicyid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.AutoScale.GetAutoScalePolicyID(name)
+	},
+	"autoscalevmgroupid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.AutoScale.GetAutoScaleVmGroupID(name)
+	},
+	"counterid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.AutoScale.GetCounterID(name)
+	},
+	"backupofferingid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Backup.GetBackupOfferingID(name)
+	},
+	"clusterid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Cluster.GetClusterID(name)
+	},
+	"clustersmetricid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Cluster.GetClustersMetricID(name)
+	},
+	"diskofferingid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.DiskOffering.GetDiskOfferingID(name)
+	},
+	"domainchildrenid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Domain.GetDomainChildrenID(name)
+	},
+	"domainid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Domain.GetDomainID(name)
+	},
+	"oscategoryid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.GuestOS.GetOsCategoryID(name)
+	},
+	"ostypeid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.GuestOS.GetOsTypeID(name)
+	},
+	"hosttagid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Host.GetHostTagID(name)
+	},
+	"hostid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Host.GetHostID(name)
+	},
+	"hostsmetricid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Host.GetHostsMetricID(name)
+	},
+	"imagestoreid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.ImageStore.GetImageStoreID(name)
+	},
+	"secondarystagingstoreid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.ImageStore.GetSecondaryStagingStoreID(name)
+	},
+	"managementserversmetricid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.InfrastructureUsage.GetManagementServersMetricID(name)
+	},
+	"internalloadbalancervmid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.InternalLB.GetInternalLoadBalancerVMID(name)
+	},
+	"kubernetesclusterid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Kubernetes.GetKubernetesClusterID(name)
+	},
+	"kubernetessupportedversionid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Kubernetes.GetKubernetesSupportedVersionID(name)
+	},
+	"globalloadbalancerruleid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.LoadBalancer.GetGlobalLoadBalancerRuleID(name)
+	},
+	"loadbalancerruleid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.LoadBalancer.GetLoadBalancerRuleID(name)
+	},
+	"loadbalancerid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.LoadBalancer.GetLoadBalancerID(name)
+	},
+	"networkacllistid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.NetworkACL.GetNetworkACLListID(name)
+	},
+	"networkofferingid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.NetworkOffering.GetNetworkOfferingID(name)
+	},
+	"networkserviceproviderid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Network.GetNetworkServiceProviderID(name)
+	},
+	"networkid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Network.GetNetworkID(name)
+	},
+	"physicalnetworkid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Network.GetPhysicalNetworkID(name)
+	},
+	"bucketid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.ObjectStore.GetBucketID(name)
+	},
+	"objectstoragepoolid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.ObjectStore.GetObjectStoragePoolID(name)
+	},
+	"podid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Pod.GetPodID(name)
+	},
+	"storagepoolid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Pool.GetStoragePoolID(name)
+	},
+	"projectid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Project.GetProjectID(name)
+	},
+	"storagetagid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Resourcetags.GetStorageTagID(name)
+	},
+	"roleid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Role.GetRoleID(name)
+	},
+	"routerid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Router.GetRouterID(name)
+	},
+	"sshkeypairid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.SSH.GetSSHKeyPairID(name)
+	},
+	"securitygroupid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.SecurityGroup.GetSecurityGroupID(name)
+	},
+	"serviceofferingid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.ServiceOffering.GetServiceOfferingID(name)
+	},
+	"snapshotid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Snapshot.GetSnapshotID(name)
+	},
+	"vmsnapshotid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Snapshot.GetVMSnapshotID(name)
+	},
+	"swiftid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Swift.GetSwiftID(name)
+	},
+	"systemvmid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.SystemVM.GetSystemVmID(name)
+	},
+	"ucsmanagerid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.UCS.GetUcsManagerID(name)
+	},
+	"userdataid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.UserData.GetUserDataID(name)
+	},
+	"instancegroupid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.VMGroup.GetInstanceGroupID(name)
+	},
+	"vpcofferingid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.VPC.GetVPCOfferingID(name)
+	},
+	"vpcid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.VPC.GetVPCID(name)
+	},
+	"vpncustomergatewayid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.VPN.GetVpnCustomerGatewayID(name)
+	},
+	"virtualmachineid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.VirtualMachine.GetVirtualMachineID(name)
+	},
+	"virtualmachinesmetricid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.VirtualMachine.GetVirtualMachinesMetricID(name)
+	},
+	"virtualmachinesusagehistoryid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.VirtualMachine.GetVirtualMachinesUsageHistoryID(name)
+	},
+	"volumeid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Volume.GetVolumeID(name)
+	},
+	"volumesmetricid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Volume.GetVolumesMetricID(name)
+	},
+	"zoneid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Zone.GetZoneID(name)
+	},
+	"zonesmetricid": func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {
+		return cs.Zone.GetZonesMetricID(name)
+	},
+}
+
+func newListApisCommand() *command {
+	c := newCommand("listApis", "lists all available apis on the server, provided by the Api Discovery plugin", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.APIDiscovery.NewListApisParams()
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.APIDiscovery.ListApis(p, opts...)
+	}
+	return c
+}
+
+func newCreateAccountCommand() *command {
+	c := newCommand("createAccount", "Creates an account", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accountdetails", &mapValue{}, "", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("accounttype", &intValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("email", &stringValue{}, "", true)
+	c.flag("firstname", &stringValue{}, "", true)
+	c.flag("lastname", &stringValue{}, "", true)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("roleid", &stringValue{}, "", false)
+	c.flag("timezone", &stringValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewCreateAccountParams(c.string("email"), c.string("firstname"), c.string("lastname"), c.string("password"), c.string("username"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accountdetails") {
+			p.SetAccountdetails(c.stringMap("accountdetails"))
+		}
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("accounttype") {
+			p.SetAccounttype(c.int("accounttype"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		if c.isSet("roleid") {
+			p.SetRoleid(c.string("roleid"))
+		}
+		if c.isSet("timezone") {
+			p.SetTimezone(c.string("timezone"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.Account.CreateAccount(p, opts...)
+	}
+	return c
+}
+
+func newDeleteAccountCommand() *command {
+	c := newCommand("deleteAccount", "Deletes a account, and all users associated with this account", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewDeleteAccountParams(c.string("id"))
+		return cs.Account.DeleteAccount(p, opts...)
+	}
+	return c
+}
+
+func newDisableAccountCommand() *command {
+	c := newCommand("disableAccount", "Disables an account", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("lock", &boolValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewDisableAccountParams(c.bool("lock"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		return cs.Account.DisableAccount(p, opts...)
+	}
+	return c
+}
+
+func newEnableAccountCommand() *command {
+	c := newCommand("enableAccount", "Enables an account", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewEnableAccountParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		return cs.Account.EnableAccount(p, opts...)
+	}
+	return c
+}
+
+func newGetSolidFireAccountIdCommand() *command {
+	c := newCommand("getSolidFireAccountId", "Get SolidFire Account ID", false)
+	c.flag("accountid", &stringValue{}, "", true)
+	c.flag("storageid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewGetSolidFireAccountIdParams(c.string("accountid"), c.string("storageid"))
+		return cs.Account.GetSolidFireAccountId(p, opts...)
+	}
+	return c
+}
+
+func newListAccountsCommand() *command {
+	c := newCommand("listAccounts", "Lists accounts and provides detailed account information for listed accounts", false)
+	c.flag("accounttype", &intValue{}, "", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("iscleanuprequired", &boolValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewListAccountsParams()
+		if c.isSet("accounttype") {
+			p.SetAccounttype(c.int("accounttype"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("iscleanuprequired") {
+			p.SetIscleanuprequired(c.bool("iscleanuprequired"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		return cs.Account.ListAccounts(p, opts...)
+	}
+	return c
+}
+
+func newListProjectAccountsCommand() *command {
+	c := newCommand("listProjectAccounts", "Lists project's accounts", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("projectroleid", &stringValue{}, "", false)
+	c.flag("role", &stringValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewListProjectAccountsParams(c.string("projectid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectroleid") {
+			p.SetProjectroleid(c.string("projectroleid"))
+		}
+		if c.isSet("role") {
+			p.SetRole(c.string("role"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.Account.ListProjectAccounts(p, opts...)
+	}
+	return c
+}
+
+func newLockAccountCommand() *command {
+	c := newCommand("lockAccount", "This deprecated function used to locks an account. Look for the API DisableAccount instead", false)
+	c.flag("account", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewLockAccountParams(c.string("account"), c.string("domainid"))
+		return cs.Account.LockAccount(p, opts...)
+	}
+	return c
+}
+
+func newMarkDefaultZoneForAccountCommand() *command {
+	c := newCommand("markDefaultZoneForAccount", "Marks a default zone for this account", true)
+	c.flag("account", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewMarkDefaultZoneForAccountParams(c.string("account"), c.string("domainid"), c.string("zoneid"))
+		return cs.Account.MarkDefaultZoneForAccount(p, opts...)
+	}
+	return c
+}
+
+func newUpdateAccountCommand() *command {
+	c := newCommand("updateAccount", "Updates account information for the authenticated user", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accountdetails", &mapValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.flag("newname", &stringValue{}, "", false)
+	c.flag("roleid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Account.NewUpdateAccountParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accountdetails") {
+			p.SetAccountdetails(c.stringMap("accountdetails"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		if c.isSet("newname") {
+			p.SetNewname(c.string("newname"))
+		}
+		if c.isSet("roleid") {
+			p.SetRoleid(c.string("roleid"))
+		}
+		return cs.Account.UpdateAccount(p, opts...)
+	}
+	return c
+}
+
+func newAssociateIpAddressCommand() *command {
+	c := newCommand("associateIpAddress", "Acquires and associates a public IP to an account.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("isportable", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("regionid", &intValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Address.NewAssociateIpAddressParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		if c.isSet("isportable") {
+			p.SetIsportable(c.bool("isportable"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("regionid") {
+			p.SetRegionid(c.int("regionid"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Address.AssociateIpAddress(p, opts...)
+	}
+	return c
+}
+
+func newDisassociateIpAddressCommand() *command {
+	c := newCommand("disassociateIpAddress", "Disassociates an IP address from the account.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Address.NewDisassociateIpAddressParams(c.string("id"))
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		return cs.Address.DisassociateIpAddress(p, opts...)
+	}
+	return c
+}
+
+func newListPublicIpAddressesCommand() *command {
+	c := newCommand("listPublicIpAddresses", "Lists all public ip addresses", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("allocatedonly", &boolValue{}, "", false)
+	c.flag("associatednetworkid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("forloadbalancing", &boolValue{}, "", false)
+	c.flag("forvirtualnetwork", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("issourcenat", &boolValue{}, "", false)
+	c.flag("isstaticnat", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("retrieveonlyresourcecount", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("vlanid", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Address.NewListPublicIpAddressesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("allocatedonly") {
+			p.SetAllocatedonly(c.bool("allocatedonly"))
+		}
+		if c.isSet("associatednetworkid") {
+			p.SetAssociatednetworkid(c.string("associatednetworkid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("forloadbalancing") {
+			p.SetForloadbalancing(c.bool("forloadbalancing"))
+		}
+		if c.isSet("forvirtualnetwork") {
+			p.SetForvirtualnetwork(c.bool("forvirtualnetwork"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("issourcenat") {
+			p.SetIssourcenat(c.bool("issourcenat"))
+		}
+		if c.isSet("isstaticnat") {
+			p.SetIsstaticnat(c.bool("isstaticnat"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("retrieveonlyresourcecount") {
+			p.SetRetrieveonlyresourcecount(c.bool("retrieveonlyresourcecount"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("vlanid") {
+			p.SetVlanid(c.string("vlanid"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Address.ListPublicIpAddresses(p, opts...)
+	}
+	return c
+}
+
+func newUpdateIpAddressCommand() *command {
+	c := newCommand("updateIpAddress", "Updates an IP address", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Address.NewUpdateIpAddressParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.Address.UpdateIpAddress(p, opts...)
+	}
+	return c
+}
+
+func newReleaseIpAddressCommand() *command {
+	c := newCommand("releaseIpAddress", "Releases an IP address from the account.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Address.NewReleaseIpAddressParams(c.string("id"))
+		return cs.Address.ReleaseIpAddress(p, opts...)
+	}
+	return c
+}
+
+func newCreateAffinityGroupCommand() *command {
+	c := newCommand("createAffinityGroup", "Creates an affinity/anti-affinity group", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AffinityGroup.NewCreateAffinityGroupParams(c.string("name"), c.string("type"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.AffinityGroup.CreateAffinityGroup(p, opts...)
+	}
+	return c
+}
+
+func newDeleteAffinityGroupCommand() *command {
+	c := newCommand("deleteAffinityGroup", "Deletes affinity group", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AffinityGroup.NewDeleteAffinityGroupParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.AffinityGroup.DeleteAffinityGroup(p, opts...)
+	}
+	return c
+}
+
+func newListAffinityGroupTypesCommand() *command {
+	c := newCommand("listAffinityGroupTypes", "Lists affinity group types available", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AffinityGroup.NewListAffinityGroupTypesParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.AffinityGroup.ListAffinityGroupTypes(p, opts...)
+	}
+	return c
+}
+
+func newListAffinityGroupsCommand() *command {
+	c := newCommand("listAffinityGroups", "Lists affinity groups", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AffinityGroup.NewListAffinityGroupsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		return cs.AffinityGroup.ListAffinityGroups(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVMAffinityGroupCommand() *command {
+	c := newCommand("updateVMAffinityGroup", "Updates the affinity/anti-affinity group associations of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect.", true)
+	c.flag("affinitygroupids", &stringsValue{}, "", false)
+	c.flag("affinitygroupnames", &stringsValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AffinityGroup.NewUpdateVMAffinityGroupParams(c.string("id"))
+		if c.isSet("affinitygroupids") {
+			p.SetAffinitygroupids(c.strings("affinitygroupids"))
+		}
+		if c.isSet("affinitygroupnames") {
+			p.SetAffinitygroupnames(c.strings("affinitygroupnames"))
+		}
+		return cs.AffinityGroup.UpdateVMAffinityGroup(p, opts...)
+	}
+	return c
+}
+
+func newArchiveAlertsCommand() *command {
+	c := newCommand("archiveAlerts", "Archive one or more alerts.", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Alert.NewArchiveAlertsParams()
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Alert.ArchiveAlerts(p, opts...)
+	}
+	return c
+}
+
+func newDeleteAlertsCommand() *command {
+	c := newCommand("deleteAlerts", "Delete one or more alerts.", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Alert.NewDeleteAlertsParams()
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Alert.DeleteAlerts(p, opts...)
+	}
+	return c
+}
+
+func newGenerateAlertCommand() *command {
+	c := newCommand("generateAlert", "Generates an alert", true)
+	c.flag("description", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("type", &intValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Alert.NewGenerateAlertParams(c.string("description"), c.string("name"), c.int("type"))
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Alert.GenerateAlert(p, opts...)
+	}
+	return c
+}
+
+func newListAlertsCommand() *command {
+	c := newCommand("listAlerts", "Lists all alerts.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Alert.NewListAlertsParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Alert.ListAlerts(p, opts...)
+	}
+	return c
+}
+
+func newAddAnnotationCommand() *command {
+	c := newCommand("addAnnotation", "add an annotation.", false)
+	c.flag("adminsonly", &boolValue{}, "", false)
+	c.flag("annotation", &stringValue{}, "", false)
+	c.flag("entityid", &stringValue{}, "", false)
+	c.flag("entitytype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Annotation.NewAddAnnotationParams()
+		if c.isSet("adminsonly") {
+			p.SetAdminsonly(c.bool("adminsonly"))
+		}
+		if c.isSet("annotation") {
+			p.SetAnnotation(c.string("annotation"))
+		}
+		if c.isSet("entityid") {
+			p.SetEntityid(c.string("entityid"))
+		}
+		if c.isSet("entitytype") {
+			p.SetEntitytype(c.string("entitytype"))
+		}
+		return cs.Annotation.AddAnnotation(p, opts...)
+	}
+	return c
+}
+
+func newListAnnotationsCommand() *command {
+	c := newCommand("listAnnotations", "Lists annotations.", false)
+	c.flag("annotationfilter", &stringValue{}, "", false)
+	c.flag("entityid", &stringValue{}, "", false)
+	c.flag("entitytype", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Annotation.NewListAnnotationsParams()
+		if c.isSet("annotationfilter") {
+			p.SetAnnotationfilter(c.string("annotationfilter"))
+		}
+		if c.isSet("entityid") {
+			p.SetEntityid(c.string("entityid"))
+		}
+		if c.isSet("entitytype") {
+			p.SetEntitytype(c.string("entitytype"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.Annotation.ListAnnotations(p, opts...)
+	}
+	return c
+}
+
+func newRemoveAnnotationCommand() *command {
+	c := newCommand("removeAnnotation", "remove an annotation.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Annotation.NewRemoveAnnotationParams(c.string("id"))
+		return cs.Annotation.RemoveAnnotation(p, opts...)
+	}
+	return c
+}
+
+func newUpdateAnnotationVisibilityCommand() *command {
+	c := newCommand("updateAnnotationVisibility", "update an annotation visibility.", false)
+	c.flag("adminsonly", &boolValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Annotation.NewUpdateAnnotationVisibilityParams(c.bool("adminsonly"), c.string("id"))
+		return cs.Annotation.UpdateAnnotationVisibility(p, opts...)
+	}
+	return c
+}
+
+func newListAsyncJobsCommand() *command {
+	c := newCommand("listAsyncJobs", "Lists all pending asynchronous jobs for the account.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("managementserverid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Asyncjob.NewListAsyncJobsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("managementserverid") {
+			p.SetManagementserverid(c.uuid("managementserverid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		return cs.Asyncjob.ListAsyncJobs(p, opts...)
+	}
+	return c
+}
+
+func newQueryAsyncJobResultCommand() *command {
+	c := newCommand("queryAsyncJobResult", "Retrieves the current status of asynchronous job.", false)
+	c.flag("jobid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Asyncjob.NewQueryAsyncJobResultParams(c.string("jobid"))
+		return cs.Asyncjob.QueryAsyncJobResult(p, opts...)
+	}
+	return c
+}
+
+func newLoginCommand() *command {
+	c := newCommand("login", "Logs a user into the CloudStack. A successful login attempt will generate a JSESSIONID cookie value that can be passed in subsequent Query command calls until the \"logout\" command has been issued or the session has expired.", false)
+	c.flag("domain", &stringValue{}, "", false)
+	c.flag("domainId", &int64Value{}, "", false)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Authentication.NewLoginParams(c.string("password"), c.string("username"))
+		if c.isSet("domain") {
+			p.SetDomain(c.string("domain"))
+		}
+		if c.isSet("domainId") {
+			p.SetDomainId(c.int64("domainId"))
+		}
+		return cs.Authentication.Login(p, opts...)
+	}
+	return c
+}
+
+func newLogoutCommand() *command {
+	c := newCommand("logout", "Logs out the user", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Authentication.NewLogoutParams()
+		return cs.Authentication.Logout(p, opts...)
+	}
+	return c
+}
+
+func newCreateAutoScalePolicyCommand() *command {
+	c := newCommand("createAutoScalePolicy", "Creates an autoscale policy for a provision or deprovision action, the action is taken when the all the conditions evaluates to true for the specified duration. The policy is in effect once it is attached to a autscale vm group.", true)
+	c.flag("action", &stringValue{}, "", true)
+	c.flag("conditionids", &stringsValue{}, "", true)
+	c.flag("duration", &intValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("quiettime", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewCreateAutoScalePolicyParams(c.string("action"), c.strings("conditionids"), c.int("duration"))
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("quiettime") {
+			p.SetQuiettime(c.int("quiettime"))
+		}
+		return cs.AutoScale.CreateAutoScalePolicy(p, opts...)
+	}
+	return c
+}
+
+func newCreateAutoScaleVmGroupCommand() *command {
+	c := newCommand("createAutoScaleVmGroup", "Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.", true)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("interval", &intValue{}, "", false)
+	c.flag("lbruleid", &stringValue{}, "", true)
+	c.flag("maxmembers", &intValue{}, "", true)
+	c.flag("minmembers", &intValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("scaledownpolicyids", &stringsValue{}, "", true)
+	c.flag("scaleuppolicyids", &stringsValue{}, "", true)
+	c.flag("vmprofileid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewCreateAutoScaleVmGroupParams(c.string("lbruleid"), c.int("maxmembers"), c.int("minmembers"), c.strings("scaledownpolicyids"), c.strings("scaleuppolicyids"), c.string("vmprofileid"))
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("interval") {
+			p.SetInterval(c.int("interval"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.AutoScale.CreateAutoScaleVmGroup(p, opts...)
+	}
+	return c
+}
+
+func newCreateAutoScaleVmProfileCommand() *command {
+	c := newCommand("createAutoScaleVmProfile", "Creates a profile that contains information about the virtual machine which will be provisioned automatically by autoscale feature.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("autoscaleuserid", &stringValue{}, "", false)
+	c.flag("counterparam", &mapValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("expungevmgraceperiod", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("otherdeployparams", &mapValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.flag("templateid", &stringValue{}, "", true)
+	c.flag("userdata", &stringValue{}, "", false)
+	c.flag("userdatadetails", &mapValue{}, "", false)
+	c.flag("userdataid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewCreateAutoScaleVmProfileParams(c.string("serviceofferingid"), c.string("templateid"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("autoscaleuserid") {
+			p.SetAutoscaleuserid(c.string("autoscaleuserid"))
+		}
+		if c.isSet("counterparam") {
+			p.SetCounterparam(c.stringMap("counterparam"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("expungevmgraceperiod") {
+			p.SetExpungevmgraceperiod(c.int("expungevmgraceperiod"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("otherdeployparams") {
+			p.SetOtherdeployparams(c.stringMap("otherdeployparams"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("userdata") {
+			p.SetUserdata(c.string("userdata"))
+		}
+		if c.isSet("userdatadetails") {
+			p.SetUserdatadetails(c.stringMap("userdatadetails"))
+		}
+		if c.isSet("userdataid") {
+			p.SetUserdataid(c.string("userdataid"))
+		}
+		return cs.AutoScale.CreateAutoScaleVmProfile(p, opts...)
+	}
+	return c
+}
+
+func newCreateConditionCommand() *command {
+	c := newCommand("createCondition", "Creates a condition for VM auto scaling", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("counterid", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("relationaloperator", &stringValue{}, "", true)
+	c.flag("threshold", &int64Value{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewCreateConditionParams(c.string("counterid"), c.string("relationaloperator"), c.int64("threshold"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.AutoScale.CreateCondition(p, opts...)
+	}
+	return c
+}
+
+func newCreateCounterCommand() *command {
+	c := newCommand("createCounter", "Adds metric counter for VM auto scaling", true)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("provider", &stringValue{}, "", true)
+	c.flag("source", &stringValue{}, "", true)
+	c.flag("value", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewCreateCounterParams(c.string("name"), c.string("provider"), c.string("source"), c.string("value"))
+		return cs.AutoScale.CreateCounter(p, opts...)
+	}
+	return c
+}
+
+func newDeleteAutoScalePolicyCommand() *command {
+	c := newCommand("deleteAutoScalePolicy", "Deletes a autoscale policy.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewDeleteAutoScalePolicyParams(c.string("id"))
+		return cs.AutoScale.DeleteAutoScalePolicy(p, opts...)
+	}
+	return c
+}
+
+func newDeleteAutoScaleVmGroupCommand() *command {
+	c := newCommand("deleteAutoScaleVmGroup", "Deletes a autoscale vm group.", true)
+	c.flag("cleanup", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewDeleteAutoScaleVmGroupParams(c.string("id"))
+		if c.isSet("cleanup") {
+			p.SetCleanup(c.bool("cleanup"))
+		}
+		return cs.AutoScale.DeleteAutoScaleVmGroup(p, opts...)
+	}
+	return c
+}
+
+func newDeleteAutoScaleVmProfileCommand() *command {
+	c := newCommand("deleteAutoScaleVmProfile", "Deletes a autoscale vm profile.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewDeleteAutoScaleVmProfileParams(c.string("id"))
+		return cs.AutoScale.DeleteAutoScaleVmProfile(p, opts...)
+	}
+	return c
+}
+
+func newDeleteConditionCommand() *command {
+	c := newCommand("deleteCondition", "Removes a condition for VM auto scaling", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewDeleteConditionParams(c.string("id"))
+		return cs.AutoScale.DeleteCondition(p, opts...)
+	}
+	return c
+}
+
+func newDeleteCounterCommand() *command {
+	c := newCommand("deleteCounter", "Deletes a counter for VM auto scaling", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewDeleteCounterParams(c.string("id"))
+		return cs.AutoScale.DeleteCounter(p, opts...)
+	}
+	return c
+}
+
+func newDisableAutoScaleVmGroupCommand() *command {
+	c := newCommand("disableAutoScaleVmGroup", "Disables an AutoScale Vm Group", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewDisableAutoScaleVmGroupParams(c.string("id"))
+		return cs.AutoScale.DisableAutoScaleVmGroup(p, opts...)
+	}
+	return c
+}
+
+func newEnableAutoScaleVmGroupCommand() *command {
+	c := newCommand("enableAutoScaleVmGroup", "Enables an AutoScale Vm Group", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewEnableAutoScaleVmGroupParams(c.string("id"))
+		return cs.AutoScale.EnableAutoScaleVmGroup(p, opts...)
+	}
+	return c
+}
+
+func newListAutoScalePoliciesCommand() *command {
+	c := newCommand("listAutoScalePolicies", "Lists autoscale policies.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("action", &stringValue{}, "", false)
+	c.flag("conditionid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("vmgroupid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewListAutoScalePoliciesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("action") {
+			p.SetAction(c.string("action"))
+		}
+		if c.isSet("conditionid") {
+			p.SetConditionid(c.string("conditionid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("vmgroupid") {
+			p.SetVmgroupid(c.string("vmgroupid"))
+		}
+		return cs.AutoScale.ListAutoScalePolicies(p, opts...)
+	}
+	return c
+}
+
+func newListAutoScaleVmGroupsCommand() *command {
+	c := newCommand("listAutoScaleVmGroups", "Lists autoscale vm groups.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("lbruleid", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("policyid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("vmprofileid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewListAutoScaleVmGroupsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("lbruleid") {
+			p.SetLbruleid(c.string("lbruleid"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("policyid") {
+			p.SetPolicyid(c.string("policyid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("vmprofileid") {
+			p.SetVmprofileid(c.string("vmprofileid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.AutoScale.ListAutoScaleVmGroups(p, opts...)
+	}
+	return c
+}
+
+func newListAutoScaleVmProfilesCommand() *command {
+	c := newCommand("listAutoScaleVmProfiles", "Lists autoscale vm profiles.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("otherdeployparams", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", false)
+	c.flag("templateid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewListAutoScaleVmProfilesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("otherdeployparams") {
+			p.SetOtherdeployparams(c.string("otherdeployparams"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("serviceofferingid") {
+			p.SetServiceofferingid(c.string("serviceofferingid"))
+		}
+		if c.isSet("templateid") {
+			p.SetTemplateid(c.string("templateid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.AutoScale.ListAutoScaleVmProfiles(p, opts...)
+	}
+	return c
+}
+
+func newListConditionsCommand() *command {
+	c := newCommand("listConditions", "List Conditions for VM auto scaling", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("counterid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("policyid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewListConditionsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("counterid") {
+			p.SetCounterid(c.string("counterid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("policyid") {
+			p.SetPolicyid(c.string("policyid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.AutoScale.ListConditions(p, opts...)
+	}
+	return c
+}
+
+func newListCountersCommand() *command {
+	c := newCommand("listCounters", "List the counters for VM auto scaling", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", false)
+	c.flag("source", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewListCountersParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("provider") {
+			p.SetProvider(c.string("provider"))
+		}
+		if c.isSet("source") {
+			p.SetSource(c.string("source"))
+		}
+		return cs.AutoScale.ListCounters(p, opts...)
+	}
+	return c
+}
+
+func newUpdateAutoScalePolicyCommand() *command {
+	c := newCommand("updateAutoScalePolicy", "Updates an existing autoscale policy.", true)
+	c.flag("conditionids", &stringsValue{}, "", false)
+	c.flag("duration", &intValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("quiettime", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewUpdateAutoScalePolicyParams(c.string("id"))
+		if c.isSet("conditionids") {
+			p.SetConditionids(c.strings("conditionids"))
+		}
+		if c.isSet("duration") {
+			p.SetDuration(c.int("duration"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("quiettime") {
+			p.SetQuiettime(c.int("quiettime"))
+		}
+		return cs.AutoScale.UpdateAutoScalePolicy(p, opts...)
+	}
+	return c
+}
+
+func newUpdateAutoScaleVmGroupCommand() *command {
+	c := newCommand("updateAutoScaleVmGroup", "Updates an existing autoscale vm group.", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("interval", &intValue{}, "", false)
+	c.flag("maxmembers", &intValue{}, "", false)
+	c.flag("minmembers", &intValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("scaledownpolicyids", &stringsValue{}, "", false)
+	c.flag("scaleuppolicyids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewUpdateAutoScaleVmGroupParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("interval") {
+			p.SetInterval(c.int("interval"))
+		}
+		if c.isSet("maxmembers") {
+			p.SetMaxmembers(c.int("maxmembers"))
+		}
+		if c.isSet("minmembers") {
+			p.SetMinmembers(c.int("minmembers"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("scaledownpolicyids") {
+			p.SetScaledownpolicyids(c.strings("scaledownpolicyids"))
+		}
+		if c.isSet("scaleuppolicyids") {
+			p.SetScaleuppolicyids(c.strings("scaleuppolicyids"))
+		}
+		return cs.AutoScale.UpdateAutoScaleVmGroup(p, opts...)
+	}
+	return c
+}
+
+func newUpdateAutoScaleVmProfileCommand() *command {
+	c := newCommand("updateAutoScaleVmProfile", "Updates an existing autoscale vm profile.", true)
+	c.flag("autoscaleuserid", &stringValue{}, "", false)
+	c.flag("counterparam", &mapValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("expungevmgraceperiod", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("otherdeployparams", &mapValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", false)
+	c.flag("templateid", &stringValue{}, "", false)
+	c.flag("userdata", &stringValue{}, "", false)
+	c.flag("userdatadetails", &mapValue{}, "", false)
+	c.flag("userdataid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.AutoScale.NewUpdateAutoScaleVmProfileParams(c.string("id"))
+		if c.isSet("autoscaleuserid") {
+			p.SetAutoscaleuserid(c.string("autoscaleuserid"))
+		}
+		if c.isSet("counterparam") {
+			p.SetCounterparam(c.stringMap("counterparam"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("expungevmgraceperiod") {
+			p.SetExpungevmgraceperiod(c.int("expungevmgraceperiod"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("otherdeployparams") {
+			p.SetOtherdeployparams(c.stringMap("otherdeployparams"))
+		}
+		if c.isSet("serviceofferingid") {
+			p.SetServiceofferingid(c.string("serviceofferingid"))
+		}
+		if c.isSet("templateid") {
+			p.SetTemplateid(c.string("templateid"))
+		}
+		if c.isSet("userdata") {
+			p.SetUserdata(c.string("userdata"))
+		}
+		if c.isSet("userdatadetails") {
+			p.SetUserdatadetails(c.stringMap("userdatadetails"))
+		}
+		if c.isSet("userdataid") {
+			p.SetUserdataid(c.string("userdataid"))
+		}
+		return cs.AutoScale.UpdateAutoScaleVmProfile(p, opts...)
+	}
+	return c
+}
+
+func newAssignVirtualMachineToBackupOfferingCommand() *command {
+	c := newCommand("assignVirtualMachineToBackupOffering", "Assigns a VM to a backup offering", true)
+	c.flag("backupofferingid", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewAssignVirtualMachineToBackupOfferingParams(c.string("backupofferingid"), c.string("virtualmachineid"))
+		return cs.Backup.AssignVirtualMachineToBackupOffering(p, opts...)
+	}
+	return c
+}
+
+func newCreateBackupCommand() *command {
+	c := newCommand("createBackup", "Create VM backup", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewCreateBackupParams(c.string("virtualmachineid"))
+		return cs.Backup.CreateBackup(p, opts...)
+	}
+	return c
+}
+
+func newCreateBackupScheduleCommand() *command {
+	c := newCommand("createBackupSchedule", "Creates a user-defined VM backup schedule", false)
+	c.flag("intervaltype", &stringValue{}, "", true)
+	c.flag("schedule", &stringValue{}, "", true)
+	c.flag("timezone", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewCreateBackupScheduleParams(c.string("intervaltype"), c.string("schedule"), c.string("timezone"), c.string("virtualmachineid"))
+		return cs.Backup.CreateBackupSchedule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteBackupCommand() *command {
+	c := newCommand("deleteBackup", "Delete VM backup", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewDeleteBackupParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.Backup.DeleteBackup(p, opts...)
+	}
+	return c
+}
+
+func newDeleteBackupOfferingCommand() *command {
+	c := newCommand("deleteBackupOffering", "Deletes a backup offering", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewDeleteBackupOfferingParams(c.string("id"))
+		return cs.Backup.DeleteBackupOffering(p, opts...)
+	}
+	return c
+}
+
+func newDeleteBackupScheduleCommand() *command {
+	c := newCommand("deleteBackupSchedule", "Deletes the backup schedule of a VM", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewDeleteBackupScheduleParams(c.string("virtualmachineid"))
+		return cs.Backup.DeleteBackupSchedule(p, opts...)
+	}
+	return c
+}
+
+func newImportBackupOfferingCommand() *command {
+	c := newCommand("importBackupOffering", "Imports a backup offering using a backup provider", true)
+	c.flag("allowuserdrivenbackups", &boolValue{}, "", true)
+	c.flag("description", &stringValue{}, "", true)
+	c.flag("externalid", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewImportBackupOfferingParams(c.bool("allowuserdrivenbackups"), c.string("description"), c.string("externalid"), c.string("name"), c.string("zoneid"))
+		return cs.Backup.ImportBackupOffering(p, opts...)
+	}
+	return c
+}
+
+func newListBackupOfferingsCommand() *command {
+	c := newCommand("listBackupOfferings", "Lists backup offerings", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewListBackupOfferingsParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Backup.ListBackupOfferings(p, opts...)
+	}
+	return c
+}
+
+func newListBackupProviderOfferingsCommand() *command {
+	c := newCommand("listBackupProviderOfferings", "Lists external backup offerings of the provider", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewListBackupProviderOfferingsParams(c.string("zoneid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Backup.ListBackupProviderOfferings(p, opts...)
+	}
+	return c
+}
+
+func newListBackupProvidersCommand() *command {
+	c := newCommand("listBackupProviders", "Lists Backup and Recovery providers", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewListBackupProvidersParams()
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.Backup.ListBackupProviders(p, opts...)
+	}
+	return c
+}
+
+func newListBackupScheduleCommand() *command {
+	c := newCommand("listBackupSchedule", "List backup schedule of a VM", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewListBackupScheduleParams(c.string("virtualmachineid"))
+		return cs.Backup.ListBackupSchedule(p, opts...)
+	}
+	return c
+}
+
+func newListBackupsCommand() *command {
+	c := newCommand("listBackups", "Lists VM backups", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewListBackupsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Backup.ListBackups(p, opts...)
+	}
+	return c
+}
+
+func newRemoveVirtualMachineFromBackupOfferingCommand() *command {
+	c := newCommand("removeVirtualMachineFromBackupOffering", "Removes a VM from any existing backup offering", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewRemoveVirtualMachineFromBackupOfferingParams(c.string("virtualmachineid"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.Backup.RemoveVirtualMachineFromBackupOffering(p, opts...)
+	}
+	return c
+}
+
+func newRestoreBackupCommand() *command {
+	c := newCommand("restoreBackup", "Restores an existing stopped or deleted VM using a VM backup", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewRestoreBackupParams(c.string("id"))
+		return cs.Backup.RestoreBackup(p, opts...)
+	}
+	return c
+}
+
+func newRestoreVolumeFromBackupAndAttachToVMCommand() *command {
+	c := newCommand("restoreVolumeFromBackupAndAttachToVM", "Restore and attach a backed up volume to VM", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.flag("volumeid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewRestoreVolumeFromBackupAndAttachToVMParams(c.string("id"), c.string("virtualmachineid"), c.string("volumeid"))
+		return cs.Backup.RestoreVolumeFromBackupAndAttachToVM(p, opts...)
+	}
+	return c
+}
+
+func newUpdateBackupOfferingCommand() *command {
+	c := newCommand("updateBackupOffering", "Updates a backup offering.", false)
+	c.flag("allowuserdrivenbackups", &boolValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewUpdateBackupOfferingParams(c.string("id"))
+		if c.isSet("allowuserdrivenbackups") {
+			p.SetAllowuserdrivenbackups(c.bool("allowuserdrivenbackups"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.Backup.UpdateBackupOffering(p, opts...)
+	}
+	return c
+}
+
+func newUpdateBackupScheduleCommand() *command {
+	c := newCommand("updateBackupSchedule", "Updates a user-defined VM backup schedule", false)
+	c.flag("intervaltype", &stringValue{}, "", true)
+	c.flag("schedule", &stringValue{}, "", true)
+	c.flag("timezone", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Backup.NewUpdateBackupScheduleParams(c.string("intervaltype"), c.string("schedule"), c.string("timezone"), c.string("virtualmachineid"))
+		return cs.Backup.UpdateBackupSchedule(p, opts...)
+	}
+	return c
+}
+
+func newAddBaremetalDhcpCommand() *command {
+	c := newCommand("addBaremetalDhcp", "adds a baremetal dhcp server", true)
+	c.flag("dhcpservertype", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewAddBaremetalDhcpParams(c.string("dhcpservertype"), c.string("password"), c.string("physicalnetworkid"), c.string("url"), c.string("username"))
+		return cs.Baremetal.AddBaremetalDhcp(p, opts...)
+	}
+	return c
+}
+
+func newAddBaremetalPxeKickStartServerCommand() *command {
+	c := newCommand("addBaremetalPxeKickStartServer", "add a baremetal pxe server", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("pxeservertype", &stringValue{}, "", true)
+	c.flag("tftpdir", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewAddBaremetalPxeKickStartServerParams(c.string("password"), c.string("physicalnetworkid"), c.string("pxeservertype"), c.string("tftpdir"), c.string("url"), c.string("username"))
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		return cs.Baremetal.AddBaremetalPxeKickStartServer(p, opts...)
+	}
+	return c
+}
+
+func newAddBaremetalPxePingServerCommand() *command {
+	c := newCommand("addBaremetalPxePingServer", "add a baremetal ping pxe server", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("pingcifspassword", &stringValue{}, "", false)
+	c.flag("pingcifsusername", &stringValue{}, "", false)
+	c.flag("pingdir", &stringValue{}, "", true)
+	c.flag("pingstorageserverip", &stringValue{}, "", true)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("pxeservertype", &stringValue{}, "", true)
+	c.flag("tftpdir", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewAddBaremetalPxePingServerParams(c.string("password"), c.string("physicalnetworkid"), c.string("pingdir"), c.string("pingstorageserverip"), c.string("pxeservertype"), c.string("tftpdir"), c.string("url"), c.string("username"))
+		if c.isSet("pingcifspassword") {
+			p.SetPingcifspassword(c.string("pingcifspassword"))
+		}
+		if c.isSet("pingcifsusername") {
+			p.SetPingcifsusername(c.string("pingcifsusername"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		return cs.Baremetal.AddBaremetalPxePingServer(p, opts...)
+	}
+	return c
+}
+
+func newAddBaremetalRctCommand() *command {
+	c := newCommand("addBaremetalRct", "adds baremetal rack configuration text", true)
+	c.flag("baremetalrcturl", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewAddBaremetalRctParams(c.string("baremetalrcturl"))
+		return cs.Baremetal.AddBaremetalRct(p, opts...)
+	}
+	return c
+}
+
+func newDeleteBaremetalRctCommand() *command {
+	c := newCommand("deleteBaremetalRct", "deletes baremetal rack configuration text", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewDeleteBaremetalRctParams(c.string("id"))
+		return cs.Baremetal.DeleteBaremetalRct(p, opts...)
+	}
+	return c
+}
+
+func newListBaremetalDhcpCommand() *command {
+	c := newCommand("listBaremetalDhcp", "list baremetal dhcp servers", false)
+	c.flag("dhcpservertype", &stringValue{}, "", false)
+	c.flag("id", &int64Value{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewListBaremetalDhcpParams(c.string("physicalnetworkid"))
+		if c.isSet("dhcpservertype") {
+			p.SetDhcpservertype(c.string("dhcpservertype"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.int64("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Baremetal.ListBaremetalDhcp(p, opts...)
+	}
+	return c
+}
+
+func newListBaremetalPxeServersCommand() *command {
+	c := newCommand("listBaremetalPxeServers", "list baremetal pxe server", false)
+	c.flag("id", &int64Value{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewListBaremetalPxeServersParams(c.string("physicalnetworkid"))
+		if c.isSet("id") {
+			p.SetId(c.int64("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Baremetal.ListBaremetalPxeServers(p, opts...)
+	}
+	return c
+}
+
+func newListBaremetalRctCommand() *command {
+	c := newCommand("listBaremetalRct", "list baremetal rack configuration", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewListBaremetalRctParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Baremetal.ListBaremetalRct(p, opts...)
+	}
+	return c
+}
+
+func newNotifyBaremetalProvisionDoneCommand() *command {
+	c := newCommand("notifyBaremetalProvisionDone", "Notify provision has been done on a host. This api is for baremetal virtual router service, not for end user", true)
+	c.flag("mac", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Baremetal.NewNotifyBaremetalProvisionDoneParams(c.string("mac"))
+		return cs.Baremetal.NotifyBaremetalProvisionDone(p, opts...)
+	}
+	return c
+}
+
+func newAddBigSwitchBcfDeviceCommand() *command {
+	c := newCommand("addBigSwitchBcfDevice", "Adds a BigSwitch BCF Controller device", true)
+	c.flag("hostname", &stringValue{}, "", true)
+	c.flag("nat", &boolValue{}, "", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.BigSwitchBCF.NewAddBigSwitchBcfDeviceParams(c.string("hostname"), c.bool("nat"), c.string("password"), c.string("physicalnetworkid"), c.string("username"))
+		return cs.BigSwitchBCF.AddBigSwitchBcfDevice(p, opts...)
+	}
+	return c
+}
+
+func newDeleteBigSwitchBcfDeviceCommand() *command {
+	c := newCommand("deleteBigSwitchBcfDevice", "delete a BigSwitch BCF Controller device", true)
+	c.flag("bcfdeviceid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.BigSwitchBCF.NewDeleteBigSwitchBcfDeviceParams(c.string("bcfdeviceid"))
+		return cs.BigSwitchBCF.DeleteBigSwitchBcfDevice(p, opts...)
+	}
+	return c
+}
+
+func newListBigSwitchBcfDevicesCommand() *command {
+	c := newCommand("listBigSwitchBcfDevices", "Lists BigSwitch BCF Controller devices", false)
+	c.flag("bcfdeviceid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.BigSwitchBCF.NewListBigSwitchBcfDevicesParams()
+		if c.isSet("bcfdeviceid") {
+			p.SetBcfdeviceid(c.string("bcfdeviceid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		return cs.BigSwitchBCF.ListBigSwitchBcfDevices(p, opts...)
+	}
+	return c
+}
+
+func newAddBrocadeVcsDeviceCommand() *command {
+	c := newCommand("addBrocadeVcsDevice", "Adds a Brocade VCS Switch", true)
+	c.flag("hostname", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.BrocadeVCS.NewAddBrocadeVcsDeviceParams(c.string("hostname"), c.string("password"), c.string("physicalnetworkid"), c.string("username"))
+		return cs.BrocadeVCS.AddBrocadeVcsDevice(p, opts...)
+	}
+	return c
+}
+
+func newDeleteBrocadeVcsDeviceCommand() *command {
+	c := newCommand("deleteBrocadeVcsDevice", "delete a Brocade VCS Switch", true)
+	c.flag("vcsdeviceid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.BrocadeVCS.NewDeleteBrocadeVcsDeviceParams(c.string("vcsdeviceid"))
+		return cs.BrocadeVCS.DeleteBrocadeVcsDevice(p, opts...)
+	}
+	return c
+}
+
+func newListBrocadeVcsDeviceNetworksCommand() *command {
+	c := newCommand("listBrocadeVcsDeviceNetworks", "lists network that are using a brocade vcs switch", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("vcsdeviceid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.BrocadeVCS.NewListBrocadeVcsDeviceNetworksParams(c.string("vcsdeviceid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.BrocadeVCS.ListBrocadeVcsDeviceNetworks(p, opts...)
+	}
+	return c
+}
+
+func newListBrocadeVcsDevicesCommand() *command {
+	c := newCommand("listBrocadeVcsDevices", "Lists Brocade VCS Switches", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("vcsdeviceid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.BrocadeVCS.NewListBrocadeVcsDevicesParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("vcsdeviceid") {
+			p.SetVcsdeviceid(c.string("vcsdeviceid"))
+		}
+		return cs.BrocadeVCS.ListBrocadeVcsDevices(p, opts...)
+	}
+	return c
+}
+
+func newUploadCustomCertificateCommand() *command {
+	c := newCommand("uploadCustomCertificate", "Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a single certificate signed by a known CA. Can also be used, through multiple calls, to upload a chain of certificates from CA to the custom certificate itself.", true)
+	c.flag("certificate", &stringValue{}, "", true)
+	c.flag("domainsuffix", &stringValue{}, "", true)
+	c.flag("id", &intValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("privatekey", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Certificate.NewUploadCustomCertificateParams(c.string("certificate"), c.string("domainsuffix"))
+		if c.isSet("id") {
+			p.SetId(c.int("id"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("privatekey") {
+			p.SetPrivatekey(c.string("privatekey"))
+		}
+		return cs.Certificate.UploadCustomCertificate(p, opts...)
+	}
+	return c
+}
+
+func newGetCloudIdentifierCommand() *command {
+	c := newCommand("getCloudIdentifier", "Retrieves a cloud identifier.", false)
+	c.flag("userid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.CloudIdentifier.NewGetCloudIdentifierParams(c.string("userid"))
+		return cs.CloudIdentifier.GetCloudIdentifier(p, opts...)
+	}
+	return c
+}
+
+func newAddClusterCommand() *command {
+	c := newCommand("addCluster", "Adds a new cluster", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("clustername", &stringValue{}, "", true)
+	c.flag("clustertype", &stringValue{}, "", true)
+	c.flag("guestvswitchname", &stringValue{}, "", false)
+	c.flag("guestvswitchtype", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", true)
+	c.flag("ovm3cluster", &stringValue{}, "", false)
+	c.flag("ovm3pool", &stringValue{}, "", false)
+	c.flag("ovm3vip", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", true)
+	c.flag("publicvswitchname", &stringValue{}, "", false)
+	c.flag("publicvswitchtype", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", false)
+	c.flag("vsmipaddress", &stringValue{}, "", false)
+	c.flag("vsmpassword", &stringValue{}, "", false)
+	c.flag("vsmusername", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewAddClusterParams(c.string("clustername"), c.string("clustertype"), c.string("hypervisor"), c.string("podid"), c.string("zoneid"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("guestvswitchname") {
+			p.SetGuestvswitchname(c.string("guestvswitchname"))
+		}
+		if c.isSet("guestvswitchtype") {
+			p.SetGuestvswitchtype(c.string("guestvswitchtype"))
+		}
+		if c.isSet("ovm3cluster") {
+			p.SetOvm3cluster(c.string("ovm3cluster"))
+		}
+		if c.isSet("ovm3pool") {
+			p.SetOvm3pool(c.string("ovm3pool"))
+		}
+		if c.isSet("ovm3vip") {
+			p.SetOvm3vip(c.string("ovm3vip"))
+		}
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		if c.isSet("publicvswitchname") {
+			p.SetPublicvswitchname(c.string("publicvswitchname"))
+		}
+		if c.isSet("publicvswitchtype") {
+			p.SetPublicvswitchtype(c.string("publicvswitchtype"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		if c.isSet("vsmipaddress") {
+			p.SetVsmipaddress(c.string("vsmipaddress"))
+		}
+		if c.isSet("vsmpassword") {
+			p.SetVsmpassword(c.string("vsmpassword"))
+		}
+		if c.isSet("vsmusername") {
+			p.SetVsmusername(c.string("vsmusername"))
+		}
+		return cs.Cluster.AddCluster(p, opts...)
+	}
+	return c
+}
+
+func newDedicateClusterCommand() *command {
+	c := newCommand("dedicateCluster", "Dedicate an existing cluster", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewDedicateClusterParams(c.string("clusterid"), c.string("domainid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		return cs.Cluster.DedicateCluster(p, opts...)
+	}
+	return c
+}
+
+func newDeleteClusterCommand() *command {
+	c := newCommand("deleteCluster", "Deletes a cluster.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewDeleteClusterParams(c.string("id"))
+		return cs.Cluster.DeleteCluster(p, opts...)
+	}
+	return c
+}
+
+func newDisableOutOfBandManagementForClusterCommand() *command {
+	c := newCommand("disableOutOfBandManagementForCluster", "Disables out-of-band management for a cluster", true)
+	c.flag("clusterid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewDisableOutOfBandManagementForClusterParams(c.string("clusterid"))
+		return cs.Cluster.DisableOutOfBandManagementForCluster(p, opts...)
+	}
+	return c
+}
+
+func newEnableOutOfBandManagementForClusterCommand() *command {
+	c := newCommand("enableOutOfBandManagementForCluster", "Enables out-of-band management for a cluster", true)
+	c.flag("clusterid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewEnableOutOfBandManagementForClusterParams(c.string("clusterid"))
+		return cs.Cluster.EnableOutOfBandManagementForCluster(p, opts...)
+	}
+	return c
+}
+
+func newEnableHAForClusterCommand() *command {
+	c := newCommand("enableHAForCluster", "Enables HA cluster-wide", true)
+	c.flag("clusterid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewEnableHAForClusterParams(c.string("clusterid"))
+		return cs.Cluster.EnableHAForCluster(p, opts...)
+	}
+	return c
+}
+
+func newDisableHAForClusterCommand() *command {
+	c := newCommand("disableHAForCluster", "Disables HA cluster-wide", true)
+	c.flag("clusterid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewDisableHAForClusterParams(c.string("clusterid"))
+		return cs.Cluster.DisableHAForCluster(p, opts...)
+	}
+	return c
+}
+
+func newListClustersCommand() *command {
+	c := newCommand("listClusters", "Lists clusters.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("clustertype", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("managedstate", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("showcapacities", &boolValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewListClustersParams()
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("clustertype") {
+			p.SetClustertype(c.string("clustertype"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("managedstate") {
+			p.SetManagedstate(c.string("managedstate"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("showcapacities") {
+			p.SetShowcapacities(c.bool("showcapacities"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Cluster.ListClusters(p, opts...)
+	}
+	return c
+}
+
+func newListClustersMetricsCommand() *command {
+	c := newCommand("listClustersMetrics", "Lists clusters metrics", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("clustertype", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("managedstate", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("showcapacities", &boolValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewListClustersMetricsParams()
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("clustertype") {
+			p.SetClustertype(c.string("clustertype"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("managedstate") {
+			p.SetManagedstate(c.string("managedstate"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("showcapacities") {
+			p.SetShowcapacities(c.bool("showcapacities"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Cluster.ListClustersMetrics(p, opts...)
+	}
+	return c
+}
+
+func newListDedicatedClustersCommand() *command {
+	c := newCommand("listDedicatedClusters", "Lists dedicated clusters.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("affinitygroupid", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewListDedicatedClustersParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("affinitygroupid") {
+			p.SetAffinitygroupid(c.string("affinitygroupid"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Cluster.ListDedicatedClusters(p, opts...)
+	}
+	return c
+}
+
+func newReleaseDedicatedClusterCommand() *command {
+	c := newCommand("releaseDedicatedCluster", "Release the dedication for cluster", true)
+	c.flag("clusterid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewReleaseDedicatedClusterParams(c.string("clusterid"))
+		return cs.Cluster.ReleaseDedicatedCluster(p, opts...)
+	}
+	return c
+}
+
+func newUpdateClusterCommand() *command {
+	c := newCommand("updateCluster", "Updates an existing cluster", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("clustername", &stringValue{}, "", false)
+	c.flag("clustertype", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("managedstate", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Cluster.NewUpdateClusterParams(c.string("id"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("clustername") {
+			p.SetClustername(c.string("clustername"))
+		}
+		if c.isSet("clustertype") {
+			p.SetClustertype(c.string("clustertype"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("managedstate") {
+			p.SetManagedstate(c.string("managedstate"))
+		}
+		return cs.Cluster.UpdateCluster(p, opts...)
+	}
+	return c
+}
+
+func newListCapabilitiesCommand() *command {
+	c := newCommand("listCapabilities", "Lists capabilities", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Configuration.NewListCapabilitiesParams()
+		return cs.Configuration.ListCapabilities(p, opts...)
+	}
+	return c
+}
+
+func newListConfigurationsCommand() *command {
+	c := newCommand("listConfigurations", "Lists all configurations.", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("category", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("group", &stringValue{}, "", false)
+	c.flag("imagestoreuuid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("parent", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("subgroup", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Configuration.NewListConfigurationsParams()
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("category") {
+			p.SetCategory(c.string("category"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("group") {
+			p.SetGroup(c.string("group"))
+		}
+		if c.isSet("imagestoreuuid") {
+			p.SetImagestoreuuid(c.string("imagestoreuuid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("parent") {
+			p.SetParent(c.string("parent"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("subgroup") {
+			p.SetSubgroup(c.string("subgroup"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Configuration.ListConfigurations(p, opts...)
+	}
+	return c
+}
+
+func newListDeploymentPlannersCommand() *command {
+	c := newCommand("listDeploymentPlanners", "Lists all DeploymentPlanners available.", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Configuration.NewListDeploymentPlannersParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Configuration.ListDeploymentPlanners(p, opts...)
+	}
+	return c
+}
+
+func newUpdateConfigurationCommand() *command {
+	c := newCommand("updateConfiguration", "Updates a configuration.", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("imagestoreuuid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("value", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Configuration.NewUpdateConfigurationParams(c.string("name"))
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("imagestoreuuid") {
+			p.SetImagestoreuuid(c.string("imagestoreuuid"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("value") {
+			p.SetValue(c.string("value"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Configuration.UpdateConfiguration(p, opts...)
+	}
+	return c
+}
+
+func newResetConfigurationCommand() *command {
+	c := newCommand("resetConfiguration", "Resets a configuration. The configuration will be set to default value for global setting, and removed from account_details or domain_details for Account/Domain settings", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("imagestoreid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Configuration.NewResetConfigurationParams(c.string("name"))
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("imagestoreid") {
+			p.SetImagestoreid(c.string("imagestoreid"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Configuration.ResetConfiguration(p, opts...)
+	}
+	return c
+}
+
+func newCreateConsoleEndpointCommand() *command {
+	c := newCommand("createConsoleEndpoint", "Create a console endpoint to connect to a VM console", false)
+	c.flag("token", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ConsoleEndpoint.NewCreateConsoleEndpointParams(c.string("virtualmachineid"))
+		if c.isSet("token") {
+			p.SetToken(c.string("token"))
+		}
+		return cs.ConsoleEndpoint.CreateConsoleEndpoint(p, opts...)
+	}
+	return c
+}
+
+func newCreateDiskOfferingCommand() *command {
+	c := newCommand("createDiskOffering", "Creates a disk offering.", false)
+	c.flag("bytesreadrate", &int64Value{}, "", false)
+	c.flag("bytesreadratemax", &int64Value{}, "", false)
+	c.flag("bytesreadratemaxlength", &int64Value{}, "", false)
+	c.flag("byteswriterate", &int64Value{}, "", false)
+	c.flag("byteswriteratemax", &int64Value{}, "", false)
+	c.flag("byteswriteratemaxlength", &int64Value{}, "", false)
+	c.flag("cachemode", &stringValue{}, "", false)
+	c.flag("customized", &boolValue{}, "", false)
+	c.flag("customizediops", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("disksize", &int64Value{}, "", false)
+	c.flag("disksizestrictness", &boolValue{}, "", false)
+	c.flag("displayoffering", &boolValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringsValue{}, "", false)
+	c.flag("encrypt", &boolValue{}, "", false)
+	c.flag("hypervisorsnapshotreserve", &intValue{}, "", false)
+	c.flag("iopsreadrate", &int64Value{}, "", false)
+	c.flag("iopsreadratemax", &int64Value{}, "", false)
+	c.flag("iopsreadratemaxlength", &int64Value{}, "", false)
+	c.flag("iopswriterate", &int64Value{}, "", false)
+	c.flag("iopswriteratemax", &int64Value{}, "", false)
+	c.flag("iopswriteratemaxlength", &int64Value{}, "", false)
+	c.flag("maxiops", &int64Value{}, "", false)
+	c.flag("miniops", &int64Value{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("provisioningtype", &stringValue{}, "", false)
+	c.flag("storagepolicy", &stringValue{}, "", false)
+	c.flag("storagetype", &stringValue{}, "", false)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("zoneid", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.DiskOffering.NewCreateDiskOfferingParams(c.string("displaytext"), c.string("name"))
+		if c.isSet("bytesreadrate") {
+			p.SetBytesreadrate(c.int64("bytesreadrate"))
+		}
+		if c.isSet("bytesreadratemax") {
+			p.SetBytesreadratemax(c.int64("bytesreadratemax"))
+		}
+		if c.isSet("bytesreadratemaxlength") {
+			p.SetBytesreadratemaxlength(c.int64("bytesreadratemaxlength"))
+		}
+		if c.isSet("byteswriterate") {
+			p.SetByteswriterate(c.int64("byteswriterate"))
+		}
+		if c.isSet("byteswriteratemax") {
+			p.SetByteswriteratemax(c.int64("byteswriteratemax"))
+		}
+		if c.isSet("byteswriteratemaxlength") {
+			p.SetByteswriteratemaxlength(c.int64("byteswriteratemaxlength"))
+		}
+		if c.isSet("cachemode") {
+			p.SetCachemode(c.string("cachemode"))
+		}
+		if c.isSet("customized") {
+			p.SetCustomized(c.bool("customized"))
+		}
+		if c.isSet("customizediops") {
+			p.SetCustomizediops(c.bool("customizediops"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("disksize") {
+			p.SetDisksize(c.int64("disksize"))
+		}
+		if c.isSet("disksizestrictness") {
+			p.SetDisksizestrictness(c.bool("disksizestrictness"))
+		}
+		if c.isSet("displayoffering") {
+			p.SetDisplayoffering(c.bool("displayoffering"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.strings("domainid"))
+		}
+		if c.isSet("encrypt") {
+			p.SetEncrypt(c.bool("encrypt"))
+		}
+		if c.isSet("hypervisorsnapshotreserve") {
+			p.SetHypervisorsnapshotreserve(c.int("hypervisorsnapshotreserve"))
+		}
+		if c.isSet("iopsreadrate") {
+			p.SetIopsreadrate(c.int64("iopsreadrate"))
+		}
+		if c.isSet("iopsreadratemax") {
+			p.SetIopsreadratemax(c.int64("iopsreadratemax"))
+		}
+		if c.isSet("iopsreadratemaxlength") {
+			p.SetIopsreadratemaxlength(c.int64("iopsreadratemaxlength"))
+		}
+		if c.isSet("iopswriterate") {
+			p.SetIopswriterate(c.int64("iopswriterate"))
+		}
+		if c.isSet("iopswriteratemax") {
+			p.SetIopswriteratemax(c.int64("iopswriteratemax"))
+		}
+		if c.isSet("iopswriteratemaxlength") {
+			p.SetIopswriteratemaxlength(c.int64("iopswriteratemaxlength"))
+		}
+		if c.isSet("maxiops") {
+			p.SetMaxiops(c.int64("maxiops"))
+		}
+		if c.isSet("miniops") {
+			p.SetMiniops(c.int64("miniops"))
+		}
+		if c.isSet("provisioningtype") {
+			p.SetProvisioningtype(c.string("provisioningtype"))
+		}
+		if c.isSet("storagepolicy") {
+			p.SetStoragepolicy(c.string("storagepolicy"))
+		}
+		if c.isSet("storagetype") {
+			p.SetStoragetype(c.string("storagetype"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.strings("zoneid"))
+		}
+		return cs.DiskOffering.CreateDiskOffering(p, opts...)
+	}
+	return c
+}
+
+func newDeleteDiskOfferingCommand() *command {
+	c := newCommand("deleteDiskOffering", "Updates a disk offering.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.DiskOffering.NewDeleteDiskOfferingParams(c.string("id"))
+		return cs.DiskOffering.DeleteDiskOffering(p, opts...)
+	}
+	return c
+}
+
+func newListDiskOfferingsCommand() *command {
+	c := newCommand("listDiskOfferings", "Lists all available disk offerings.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("encrypt", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("storagetype", &stringValue{}, "", false)
+	c.flag("volumeid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.DiskOffering.NewListDiskOfferingsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("encrypt") {
+			p.SetEncrypt(c.bool("encrypt"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("storagetype") {
+			p.SetStoragetype(c.string("storagetype"))
+		}
+		if c.isSet("volumeid") {
+			p.SetVolumeid(c.string("volumeid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.DiskOffering.ListDiskOfferings(p, opts...)
+	}
+	return c
+}
+
+func newUpdateDiskOfferingCommand() *command {
+	c := newCommand("updateDiskOffering", "Updates a disk offering.", false)
+	c.flag("bytesreadrate", &int64Value{}, "", false)
+	c.flag("bytesreadratemax", &int64Value{}, "", false)
+	c.flag("bytesreadratemaxlength", &int64Value{}, "", false)
+	c.flag("byteswriterate", &int64Value{}, "", false)
+	c.flag("byteswriteratemax", &int64Value{}, "", false)
+	c.flag("byteswriteratemaxlength", &int64Value{}, "", false)
+	c.flag("cachemode", &stringValue{}, "", false)
+	c.flag("displayoffering", &boolValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("iopsreadrate", &int64Value{}, "", false)
+	c.flag("iopsreadratemax", &int64Value{}, "", false)
+	c.flag("iopsreadratemaxlength", &int64Value{}, "", false)
+	c.flag("iopswriterate", &int64Value{}, "", false)
+	c.flag("iopswriteratemax", &int64Value{}, "", false)
+	c.flag("iopswriteratemaxlength", &int64Value{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("sortkey", &intValue{}, "", false)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.DiskOffering.NewUpdateDiskOfferingParams(c.string("id"))
+		if c.isSet("bytesreadrate") {
+			p.SetBytesreadrate(c.int64("bytesreadrate"))
+		}
+		if c.isSet("bytesreadratemax") {
+			p.SetBytesreadratemax(c.int64("bytesreadratemax"))
+		}
+		if c.isSet("bytesreadratemaxlength") {
+			p.SetBytesreadratemaxlength(c.int64("bytesreadratemaxlength"))
+		}
+		if c.isSet("byteswriterate") {
+			p.SetByteswriterate(c.int64("byteswriterate"))
+		}
+		if c.isSet("byteswriteratemax") {
+			p.SetByteswriteratemax(c.int64("byteswriteratemax"))
+		}
+		if c.isSet("byteswriteratemaxlength") {
+			p.SetByteswriteratemaxlength(c.int64("byteswriteratemaxlength"))
+		}
+		if c.isSet("cachemode") {
+			p.SetCachemode(c.string("cachemode"))
+		}
+		if c.isSet("displayoffering") {
+			p.SetDisplayoffering(c.bool("displayoffering"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("iopsreadrate") {
+			p.SetIopsreadrate(c.int64("iopsreadrate"))
+		}
+		if c.isSet("iopsreadratemax") {
+			p.SetIopsreadratemax(c.int64("iopsreadratemax"))
+		}
+		if c.isSet("iopsreadratemaxlength") {
+			p.SetIopsreadratemaxlength(c.int64("iopsreadratemaxlength"))
+		}
+		if c.isSet("iopswriterate") {
+			p.SetIopswriterate(c.int64("iopswriterate"))
+		}
+		if c.isSet("iopswriteratemax") {
+			p.SetIopswriteratemax(c.int64("iopswriteratemax"))
+		}
+		if c.isSet("iopswriteratemaxlength") {
+			p.SetIopswriteratemaxlength(c.int64("iopswriteratemaxlength"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("sortkey") {
+			p.SetSortkey(c.int("sortkey"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.DiskOffering.UpdateDiskOffering(p, opts...)
+	}
+	return c
+}
+
+func newCreateDomainCommand() *command {
+	c := newCommand("createDomain", "Creates a domain", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.flag("parentdomainid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Domain.NewCreateDomainParams(c.string("name"))
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		if c.isSet("parentdomainid") {
+			p.SetParentdomainid(c.string("parentdomainid"))
+		}
+		return cs.Domain.CreateDomain(p, opts...)
+	}
+	return c
+}
+
+func newDeleteDomainCommand() *command {
+	c := newCommand("deleteDomain", "Deletes a specified domain", true)
+	c.flag("cleanup", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Domain.NewDeleteDomainParams(c.string("id"))
+		if c.isSet("cleanup") {
+			p.SetCleanup(c.bool("cleanup"))
+		}
+		return cs.Domain.DeleteDomain(p, opts...)
+	}
+	return c
+}
+
+func newListDomainChildrenCommand() *command {
+	c := newCommand("listDomainChildren", "Lists all children domains belonging to a specified domain", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Domain.NewListDomainChildrenParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		return cs.Domain.ListDomainChildren(p, opts...)
+	}
+	return c
+}
+
+func newListDomainsCommand() *command {
+	c := newCommand("listDomains", "Lists domains and provides detailed information for listed domains", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("level", &intValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Domain.NewListDomainsParams()
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("level") {
+			p.SetLevel(c.int("level"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		return cs.Domain.ListDomains(p, opts...)
+	}
+	return c
+}
+
+func newUpdateDomainCommand() *command {
+	c := newCommand("updateDomain", "Updates a domain with a new name", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Domain.NewUpdateDomainParams(c.string("id"))
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		return cs.Domain.UpdateDomain(p, opts...)
+	}
+	return c
+}
+
+func newArchiveEventsCommand() *command {
+	c := newCommand("archiveEvents", "Archive one or more events.", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Event.NewArchiveEventsParams()
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Event.ArchiveEvents(p, opts...)
+	}
+	return c
+}
+
+func newDeleteEventsCommand() *command {
+	c := newCommand("deleteEvents", "Delete one or more events.", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Event.NewDeleteEventsParams()
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Event.DeleteEvents(p, opts...)
+	}
+	return c
+}
+
+func newListEventTypesCommand() *command {
+	c := newCommand("listEventTypes", "List Event Types", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Event.NewListEventTypesParams()
+		return cs.Event.ListEventTypes(p, opts...)
+	}
+	return c
+}
+
+func newListEventsCommand() *command {
+	c := newCommand("listEvents", "A command to list events.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("archived", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("duration", &intValue{}, "", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("entrytime", &intValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("level", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("resourceid", &stringValue{}, "", false)
+	c.flag("resourcetype", &stringValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("startid", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Event.NewListEventsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("archived") {
+			p.SetArchived(c.bool("archived"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("duration") {
+			p.SetDuration(c.int("duration"))
+		}
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("entrytime") {
+			p.SetEntrytime(c.int("entrytime"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("level") {
+			p.SetLevel(c.string("level"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("resourceid") {
+			p.SetResourceid(c.string("resourceid"))
+		}
+		if c.isSet("resourcetype") {
+			p.SetResourcetype(c.string("resourcetype"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		if c.isSet("startid") {
+			p.SetStartid(c.string("startid"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Event.ListEvents(p, opts...)
+	}
+	return c
+}
+
+func newAddPaloAltoFirewallCommand() *command {
+	c := newCommand("addPaloAltoFirewall", "Adds a Palo Alto firewall device", true)
+	c.flag("networkdevicetype", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewAddPaloAltoFirewallParams(c.string("networkdevicetype"), c.string("password"), c.string("physicalnetworkid"), c.string("url"), c.string("username"))
+		return cs.Firewall.AddPaloAltoFirewall(p, opts...)
+	}
+	return c
+}
+
+func newConfigurePaloAltoFirewallCommand() *command {
+	c := newCommand("configurePaloAltoFirewall", "Configures a Palo Alto firewall device", true)
+	c.flag("fwdevicecapacity", &int64Value{}, "", false)
+	c.flag("fwdeviceid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewConfigurePaloAltoFirewallParams(c.string("fwdeviceid"))
+		if c.isSet("fwdevicecapacity") {
+			p.SetFwdevicecapacity(c.int64("fwdevicecapacity"))
+		}
+		return cs.Firewall.ConfigurePaloAltoFirewall(p, opts...)
+	}
+	return c
+}
+
+func newCreateEgressFirewallRuleCommand() *command {
+	c := newCommand("createEgressFirewallRule", "Creates a egress firewall rule for a given network", true)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("destcidrlist", &stringsValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.flag("protocol", &stringValue{}, "", true)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewCreateEgressFirewallRuleParams(c.string("networkid"), c.string("protocol"))
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("destcidrlist") {
+			p.SetDestcidrlist(c.strings("destcidrlist"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Firewall.CreateEgressFirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newCreateFirewallRuleCommand() *command {
+	c := newCommand("createFirewallRule", "Creates a firewall rule for a given IP address", true)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("ipaddressid", &stringValue{}, "", true)
+	c.flag("protocol", &stringValue{}, "", true)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewCreateFirewallRuleParams(c.string("ipaddressid"), c.string("protocol"))
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Firewall.CreateFirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newCreatePortForwardingRuleCommand() *command {
+	c := newCommand("createPortForwardingRule", "Creates a port forwarding rule", true)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("ipaddressid", &stringValue{}, "", true)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("openfirewall", &boolValue{}, "", false)
+	c.flag("privateendport", &intValue{}, "", false)
+	c.flag("privateport", &intValue{}, "", true)
+	c.flag("protocol", &stringValue{}, "", true)
+	c.flag("publicendport", &intValue{}, "", false)
+	c.flag("publicport", &intValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.flag("vmguestip", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewCreatePortForwardingRuleParams(c.string("ipaddressid"), c.int("privateport"), c.string("protocol"), c.int("publicport"), c.string("virtualmachineid"))
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("openfirewall") {
+			p.SetOpenfirewall(c.bool("openfirewall"))
+		}
+		if c.isSet("privateendport") {
+			p.SetPrivateendport(c.int("privateendport"))
+		}
+		if c.isSet("publicendport") {
+			p.SetPublicendport(c.int("publicendport"))
+		}
+		if c.isSet("vmguestip") {
+			p.SetVmguestip(c.string("vmguestip"))
+		}
+		return cs.Firewall.CreatePortForwardingRule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteEgressFirewallRuleCommand() *command {
+	c := newCommand("deleteEgressFirewallRule", "Deletes an egress firewall rule", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewDeleteEgressFirewallRuleParams(c.string("id"))
+		return cs.Firewall.DeleteEgressFirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteFirewallRuleCommand() *command {
+	c := newCommand("deleteFirewallRule", "Deletes a firewall rule", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewDeleteFirewallRuleParams(c.string("id"))
+		return cs.Firewall.DeleteFirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newDeletePaloAltoFirewallCommand() *command {
+	c := newCommand("deletePaloAltoFirewall", "delete a Palo Alto firewall device", true)
+	c.flag("fwdeviceid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewDeletePaloAltoFirewallParams(c.string("fwdeviceid"))
+		return cs.Firewall.DeletePaloAltoFirewall(p, opts...)
+	}
+	return c
+}
+
+func newDeletePortForwardingRuleCommand() *command {
+	c := newCommand("deletePortForwardingRule", "Deletes a port forwarding rule", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewDeletePortForwardingRuleParams(c.string("id"))
+		return cs.Firewall.DeletePortForwardingRule(p, opts...)
+	}
+	return c
+}
+
+func newListEgressFirewallRulesCommand() *command {
+	c := newCommand("listEgressFirewallRules", "Lists all egress firewall rules for network ID.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ipaddressid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewListEgressFirewallRulesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ipaddressid") {
+			p.SetIpaddressid(c.string("ipaddressid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.Firewall.ListEgressFirewallRules(p, opts...)
+	}
+	return c
+}
+
+func newListFirewallRulesCommand() *command {
+	c := newCommand("listFirewallRules", "Lists all firewall rules for an IP address.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ipaddressid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewListFirewallRulesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ipaddressid") {
+			p.SetIpaddressid(c.string("ipaddressid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.Firewall.ListFirewallRules(p, opts...)
+	}
+	return c
+}
+
+func newListPaloAltoFirewallsCommand() *command {
+	c := newCommand("listPaloAltoFirewalls", "lists Palo Alto firewall devices in a physical network", false)
+	c.flag("fwdeviceid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewListPaloAltoFirewallsParams()
+		if c.isSet("fwdeviceid") {
+			p.SetFwdeviceid(c.string("fwdeviceid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		return cs.Firewall.ListPaloAltoFirewalls(p, opts...)
+	}
+	return c
+}
+
+func newListPortForwardingRulesCommand() *command {
+	c := newCommand("listPortForwardingRules", "Lists all port forwarding rules for an IP address.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ipaddressid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewListPortForwardingRulesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ipaddressid") {
+			p.SetIpaddressid(c.string("ipaddressid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.Firewall.ListPortForwardingRules(p, opts...)
+	}
+	return c
+}
+
+func newUpdateEgressFirewallRuleCommand() *command {
+	c := newCommand("updateEgressFirewallRule", "Updates egress firewall rule", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewUpdateEgressFirewallRuleParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.Firewall.UpdateEgressFirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newUpdateFirewallRuleCommand() *command {
+	c := newCommand("updateFirewallRule", "Updates firewall rule", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewUpdateFirewallRuleParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.Firewall.UpdateFirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newUpdatePortForwardingRuleCommand() *command {
+	c := newCommand("updatePortForwardingRule", "Updates a port forwarding rule. Only the private port and the virtual machine can be updated.", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("privateendport", &intValue{}, "", false)
+	c.flag("privateport", &intValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("vmguestip", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewUpdatePortForwardingRuleParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("privateendport") {
+			p.SetPrivateendport(c.int("privateendport"))
+		}
+		if c.isSet("privateport") {
+			p.SetPrivateport(c.int("privateport"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("vmguestip") {
+			p.SetVmguestip(c.string("vmguestip"))
+		}
+		return cs.Firewall.UpdatePortForwardingRule(p, opts...)
+	}
+	return c
+}
+
+func newListIpv6FirewallRulesCommand() *command {
+	c := newCommand("listIpv6FirewallRules", "Lists all IPv6 firewall rules", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewListIpv6FirewallRulesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		return cs.Firewall.ListIpv6FirewallRules(p, opts...)
+	}
+	return c
+}
+
+func newCreateIpv6FirewallRuleCommand() *command {
+	c := newCommand("createIpv6FirewallRule", "Creates an Ipv6 firewall rule in the given network (the network has to belong to VPC)", true)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("destcidrlist", &stringsValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.flag("protocol", &stringValue{}, "", true)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewCreateIpv6FirewallRuleParams(c.string("networkid"), c.string("protocol"))
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("destcidrlist") {
+			p.SetDestcidrlist(c.strings("destcidrlist"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		return cs.Firewall.CreateIpv6FirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newUpdateIpv6FirewallRuleCommand() *command {
+	c := newCommand("updateIpv6FirewallRule", "Updates Ipv6 firewall rule with specified ID", true)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewUpdateIpv6FirewallRuleParams(c.string("id"))
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		return cs.Firewall.UpdateIpv6FirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteIpv6FirewallRuleCommand() *command {
+	c := newCommand("deleteIpv6FirewallRule", "Deletes a IPv6 firewall rule", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Firewall.NewDeleteIpv6FirewallRuleParams(c.string("id"))
+		return cs.Firewall.DeleteIpv6FirewallRule(p, opts...)
+	}
+	return c
+}
+
+func newAddGuestOsCommand() *command {
+	c := newCommand("addGuestOs", "Add a new guest OS type", true)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("forDisplay", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("oscategoryid", &stringValue{}, "", true)
+	c.flag("osdisplayname", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewAddGuestOsParams(c.string("oscategoryid"), c.string("osdisplayname"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("forDisplay") {
+			p.SetForDisplay(c.bool("forDisplay"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.GuestOS.AddGuestOs(p, opts...)
+	}
+	return c
+}
+
+func newAddGuestOsMappingCommand() *command {
+	c := newCommand("addGuestOsMapping", "Adds a guest OS name to hypervisor OS name mapping", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", true)
+	c.flag("hypervisorversion", &stringValue{}, "", true)
+	c.flag("osdisplayname", &stringValue{}, "", false)
+	c.flag("osmappingcheckenabled", &boolValue{}, "", false)
+	c.flag("osnameforhypervisor", &stringValue{}, "", true)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewAddGuestOsMappingParams(c.string("hypervisor"), c.string("hypervisorversion"), c.string("osnameforhypervisor"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		if c.isSet("osdisplayname") {
+			p.SetOsdisplayname(c.string("osdisplayname"))
+		}
+		if c.isSet("osmappingcheckenabled") {
+			p.SetOsmappingcheckenabled(c.bool("osmappingcheckenabled"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		return cs.GuestOS.AddGuestOsMapping(p, opts...)
+	}
+	return c
+}
+
+func newListGuestOsMappingCommand() *command {
+	c := newCommand("listGuestOsMapping", "Lists all available OS mappings for given hypervisor", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("hypervisorversion", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("osdisplayname", &stringValue{}, "", false)
+	c.flag("osnameforhypervisor", &stringValue{}, "", false)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewListGuestOsMappingParams()
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("hypervisorversion") {
+			p.SetHypervisorversion(c.string("hypervisorversion"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("osdisplayname") {
+			p.SetOsdisplayname(c.string("osdisplayname"))
+		}
+		if c.isSet("osnameforhypervisor") {
+			p.SetOsnameforhypervisor(c.string("osnameforhypervisor"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.GuestOS.ListGuestOsMapping(p, opts...)
+	}
+	return c
+}
+
+func newListOsCategoriesCommand() *command {
+	c := newCommand("listOsCategories", "Lists all supported OS categories for this cloud.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewListOsCategoriesParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.GuestOS.ListOsCategories(p, opts...)
+	}
+	return c
+}
+
+func newListOsTypesCommand() *command {
+	c := newCommand("listOsTypes", "Lists all supported OS types for this cloud.", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("oscategoryid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewListOsTypesParams()
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("oscategoryid") {
+			p.SetOscategoryid(c.string("oscategoryid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.GuestOS.ListOsTypes(p, opts...)
+	}
+	return c
+}
+
+func newRemoveGuestOsCommand() *command {
+	c := newCommand("removeGuestOs", "Removes a Guest OS from listing.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewRemoveGuestOsParams(c.string("id"))
+		return cs.GuestOS.RemoveGuestOs(p, opts...)
+	}
+	return c
+}
+
+func newRemoveGuestOsMappingCommand() *command {
+	c := newCommand("removeGuestOsMapping", "Removes a Guest OS Mapping.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewRemoveGuestOsMappingParams(c.string("id"))
+		return cs.GuestOS.RemoveGuestOsMapping(p, opts...)
+	}
+	return c
+}
+
+func newUpdateGuestOsCommand() *command {
+	c := newCommand("updateGuestOs", "Updates the information about Guest OS", true)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("forDisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("osdisplayname", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewUpdateGuestOsParams(c.string("id"), c.string("osdisplayname"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("forDisplay") {
+			p.SetForDisplay(c.bool("forDisplay"))
+		}
+		return cs.GuestOS.UpdateGuestOs(p, opts...)
+	}
+	return c
+}
+
+func newUpdateGuestOsMappingCommand() *command {
+	c := newCommand("updateGuestOsMapping", "Updates the information about Guest OS to Hypervisor specific name mapping", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("osmappingcheckenabled", &boolValue{}, "", false)
+	c.flag("osnameforhypervisor", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.GuestOS.NewUpdateGuestOsMappingParams(c.string("id"), c.string("osnameforhypervisor"))
+		if c.isSet("osmappingcheckenabled") {
+			p.SetOsmappingcheckenabled(c.bool("osmappingcheckenabled"))
+		}
+		return cs.GuestOS.UpdateGuestOsMapping(p, opts...)
+	}
+	return c
+}
+
+func newAddBaremetalHostCommand() *command {
+	c := newCommand("addBaremetalHost", "add a baremetal host", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("clustername", &stringValue{}, "", false)
+	c.flag("hosttags", &stringsValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", true)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewAddBaremetalHostParams(c.string("hypervisor"), c.string("podid"), c.string("url"), c.string("zoneid"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("clustername") {
+			p.SetClustername(c.string("clustername"))
+		}
+		if c.isSet("hosttags") {
+			p.SetHosttags(c.strings("hosttags"))
+		}
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		return cs.Host.AddBaremetalHost(p, opts...)
+	}
+	return c
+}
+
+func newAddGloboDnsHostCommand() *command {
+	c := newCommand("addGloboDnsHost", "Adds the GloboDNS external host", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewAddGloboDnsHostParams(c.string("password"), c.string("physicalnetworkid"), c.string("url"), c.string("username"))
+		return cs.Host.AddGloboDnsHost(p, opts...)
+	}
+	return c
+}
+
+func newAddHostCommand() *command {
+	c := newCommand("addHost", "Adds a new host.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("clustername", &stringValue{}, "", false)
+	c.flag("hosttags", &stringsValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewAddHostParams(c.string("hypervisor"), c.string("podid"), c.string("url"), c.string("zoneid"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("clustername") {
+			p.SetClustername(c.string("clustername"))
+		}
+		if c.isSet("hosttags") {
+			p.SetHosttags(c.strings("hosttags"))
+		}
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		return cs.Host.AddHost(p, opts...)
+	}
+	return c
+}
+
+func newAddSecondaryStorageCommand() *command {
+	c := newCommand("addSecondaryStorage", "Adds secondary storage.", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewAddSecondaryStorageParams(c.string("url"))
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Host.AddSecondaryStorage(p, opts...)
+	}
+	return c
+}
+
+func newCancelHostMaintenanceCommand() *command {
+	c := newCommand("cancelHostMaintenance", "Cancels host maintenance.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewCancelHostMaintenanceParams(c.string("id"))
+		return cs.Host.CancelHostMaintenance(p, opts...)
+	}
+	return c
+}
+
+func newConfigureHAForHostCommand() *command {
+	c := newCommand("configureHAForHost", "Configures HA for a host", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.flag("provider", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewConfigureHAForHostParams(c.string("hostid"), c.string("provider"))
+		return cs.Host.ConfigureHAForHost(p, opts...)
+	}
+	return c
+}
+
+func newEnableHAForHostCommand() *command {
+	c := newCommand("enableHAForHost", "Enables HA for a host", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewEnableHAForHostParams(c.string("hostid"))
+		return cs.Host.EnableHAForHost(p, opts...)
+	}
+	return c
+}
+
+func newDedicateHostCommand() *command {
+	c := newCommand("dedicateHost", "Dedicates a host.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewDedicateHostParams(c.string("domainid"), c.string("hostid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		return cs.Host.DedicateHost(p, opts...)
+	}
+	return c
+}
+
+func newDeleteHostCommand() *command {
+	c := newCommand("deleteHost", "Deletes a host.", false)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("forcedestroylocalstorage", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewDeleteHostParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		if c.isSet("forcedestroylocalstorage") {
+			p.SetForcedestroylocalstorage(c.bool("forcedestroylocalstorage"))
+		}
+		return cs.Host.DeleteHost(p, opts...)
+	}
+	return c
+}
+
+func newDisableOutOfBandManagementForHostCommand() *command {
+	c := newCommand("disableOutOfBandManagementForHost", "Disables out-of-band management for a host", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewDisableOutOfBandManagementForHostParams(c.string("hostid"))
+		return cs.Host.DisableOutOfBandManagementForHost(p, opts...)
+	}
+	return c
+}
+
+func newEnableOutOfBandManagementForHostCommand() *command {
+	c := newCommand("enableOutOfBandManagementForHost", "Enables out-of-band management for a host", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewEnableOutOfBandManagementForHostParams(c.string("hostid"))
+		return cs.Host.EnableOutOfBandManagementForHost(p, opts...)
+	}
+	return c
+}
+
+func newFindHostsForMigrationCommand() *command {
+	c := newCommand("findHostsForMigration", "Find hosts suitable for migrating a virtual machine.", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewFindHostsForMigrationParams(c.string("virtualmachineid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Host.FindHostsForMigration(p, opts...)
+	}
+	return c
+}
+
+func newListDedicatedHostsCommand() *command {
+	c := newCommand("listDedicatedHosts", "Lists dedicated hosts.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("affinitygroupid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewListDedicatedHostsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("affinitygroupid") {
+			p.SetAffinitygroupid(c.string("affinitygroupid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Host.ListDedicatedHosts(p, opts...)
+	}
+	return c
+}
+
+func newListHostTagsCommand() *command {
+	c := newCommand("listHostTags", "Lists host tags", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewListHostTagsParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Host.ListHostTags(p, opts...)
+	}
+	return c
+}
+
+func newListHostsCommand() *command {
+	c := newCommand("listHosts", "Lists hosts.", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("hahost", &boolValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("outofbandmanagementenabled", &boolValue{}, "", false)
+	c.flag("outofbandmanagementpowerstate", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("resourcestate", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewListHostsParams()
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("hahost") {
+			p.SetHahost(c.bool("hahost"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("outofbandmanagementenabled") {
+			p.SetOutofbandmanagementenabled(c.bool("outofbandmanagementenabled"))
+		}
+		if c.isSet("outofbandmanagementpowerstate") {
+			p.SetOutofbandmanagementpowerstate(c.string("outofbandmanagementpowerstate"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("resourcestate") {
+			p.SetResourcestate(c.string("resourcestate"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Host.ListHosts(p, opts...)
+	}
+	return c
+}
+
+func newListHostsMetricsCommand() *command {
+	c := newCommand("listHostsMetrics", "Lists hosts metrics", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("hahost", &boolValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("outofbandmanagementenabled", &boolValue{}, "", false)
+	c.flag("outofbandmanagementpowerstate", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("resourcestate", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewListHostsMetricsParams()
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("hahost") {
+			p.SetHahost(c.bool("hahost"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("outofbandmanagementenabled") {
+			p.SetOutofbandmanagementenabled(c.bool("outofbandmanagementenabled"))
+		}
+		if c.isSet("outofbandmanagementpowerstate") {
+			p.SetOutofbandmanagementpowerstate(c.string("outofbandmanagementpowerstate"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("resourcestate") {
+			p.SetResourcestate(c.string("resourcestate"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Host.ListHostsMetrics(p, opts...)
+	}
+	return c
+}
+
+func newPrepareHostForMaintenanceCommand() *command {
+	c := newCommand("prepareHostForMaintenance", "Prepares a host for maintenance.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewPrepareHostForMaintenanceParams(c.string("id"))
+		return cs.Host.PrepareHostForMaintenance(p, opts...)
+	}
+	return c
+}
+
+func newReconnectHostCommand() *command {
+	c := newCommand("reconnectHost", "Reconnects a host.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewReconnectHostParams(c.string("id"))
+		return cs.Host.ReconnectHost(p, opts...)
+	}
+	return c
+}
+
+func newReleaseDedicatedHostCommand() *command {
+	c := newCommand("releaseDedicatedHost", "Release the dedication for host", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewReleaseDedicatedHostParams(c.string("hostid"))
+		return cs.Host.ReleaseDedicatedHost(p, opts...)
+	}
+	return c
+}
+
+func newReleaseHostReservationCommand() *command {
+	c := newCommand("releaseHostReservation", "Releases host reservation.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewReleaseHostReservationParams(c.string("id"))
+		return cs.Host.ReleaseHostReservation(p, opts...)
+	}
+	return c
+}
+
+func newUpdateHostCommand() *command {
+	c := newCommand("updateHost", "Updates a host.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("annotation", &stringValue{}, "", false)
+	c.flag("hosttags", &stringsValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("istagarule", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("oscategoryid", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewUpdateHostParams(c.string("id"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("annotation") {
+			p.SetAnnotation(c.string("annotation"))
+		}
+		if c.isSet("hosttags") {
+			p.SetHosttags(c.strings("hosttags"))
+		}
+		if c.isSet("istagarule") {
+			p.SetIstagarule(c.bool("istagarule"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("oscategoryid") {
+			p.SetOscategoryid(c.string("oscategoryid"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		return cs.Host.UpdateHost(p, opts...)
+	}
+	return c
+}
+
+func newUpdateHostPasswordCommand() *command {
+	c := newCommand("updateHostPassword", "Update password of a host/pool on management server.", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("update_passwd_on_host", &boolValue{}, "", false)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Host.NewUpdateHostPasswordParams(c.string("password"), c.string("username"))
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("update_passwd_on_host") {
+			p.SetUpdate_passwd_on_host(c.bool("update_passwd_on_host"))
+		}
+		return cs.Host.UpdateHostPassword(p, opts...)
+	}
+	return c
+}
+
+func newListHypervisorCapabilitiesCommand() *command {
+	c := newCommand("listHypervisorCapabilities", "Lists all hypervisor capabilities.", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Hypervisor.NewListHypervisorCapabilitiesParams()
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Hypervisor.ListHypervisorCapabilities(p, opts...)
+	}
+	return c
+}
+
+func newListHypervisorsCommand() *command {
+	c := newCommand("listHypervisors", "List hypervisors", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Hypervisor.NewListHypervisorsParams()
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Hypervisor.ListHypervisors(p, opts...)
+	}
+	return c
+}
+
+func newUpdateHypervisorCapabilitiesCommand() *command {
+	c := newCommand("updateHypervisorCapabilities", "Updates a hypervisor capabilities.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("maxdatavolumeslimit", &intValue{}, "", false)
+	c.flag("maxguestslimit", &int64Value{}, "", false)
+	c.flag("maxhostspercluster", &intValue{}, "", false)
+	c.flag("securitygroupenabled", &boolValue{}, "", false)
+	c.flag("storagemotionenabled", &boolValue{}, "", false)
+	c.flag("vmsnapshotenabled", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Hypervisor.NewUpdateHypervisorCapabilitiesParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("maxdatavolumeslimit") {
+			p.SetMaxdatavolumeslimit(c.int("maxdatavolumeslimit"))
+		}
+		if c.isSet("maxguestslimit") {
+			p.SetMaxguestslimit(c.int64("maxguestslimit"))
+		}
+		if c.isSet("maxhostspercluster") {
+			p.SetMaxhostspercluster(c.int("maxhostspercluster"))
+		}
+		if c.isSet("securitygroupenabled") {
+			p.SetSecuritygroupenabled(c.bool("securitygroupenabled"))
+		}
+		if c.isSet("storagemotionenabled") {
+			p.SetStoragemotionenabled(c.bool("storagemotionenabled"))
+		}
+		if c.isSet("vmsnapshotenabled") {
+			p.SetVmsnapshotenabled(c.bool("vmsnapshotenabled"))
+		}
+		return cs.Hypervisor.UpdateHypervisorCapabilities(p, opts...)
+	}
+	return c
+}
+
+func newAttachIsoCommand() *command {
+	c := newCommand("attachIso", "Attaches an ISO to a virtual machine.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewAttachIsoParams(c.string("id"), c.string("virtualmachineid"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.ISO.AttachIso(p, opts...)
+	}
+	return c
+}
+
+func newCopyIsoCommand() *command {
+	c := newCommand("copyIso", "Copies an iso from one zone to another.", true)
+	c.flag("destzoneid", &stringValue{}, "", false)
+	c.flag("destzoneids", &stringsValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("sourcezoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewCopyIsoParams(c.string("id"))
+		if c.isSet("destzoneid") {
+			p.SetDestzoneid(c.string("destzoneid"))
+		}
+		if c.isSet("destzoneids") {
+			p.SetDestzoneids(c.strings("destzoneids"))
+		}
+		if c.isSet("sourcezoneid") {
+			p.SetSourcezoneid(c.string("sourcezoneid"))
+		}
+		return cs.ISO.CopyIso(p, opts...)
+	}
+	return c
+}
+
+func newDeleteIsoCommand() *command {
+	c := newCommand("deleteIso", "Deletes an ISO file.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewDeleteIsoParams(c.string("id"))
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ISO.DeleteIso(p, opts...)
+	}
+	return c
+}
+
+func newDetachIsoCommand() *command {
+	c := newCommand("detachIso", "Detaches any ISO file (if any) currently attached to a virtual machine.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewDetachIsoParams(c.string("virtualmachineid"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.ISO.DetachIso(p, opts...)
+	}
+	return c
+}
+
+func newExtractIsoCommand() *command {
+	c := newCommand("extractIso", "Extracts an ISO", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("mode", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewExtractIsoParams(c.string("id"), c.string("mode"))
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ISO.ExtractIso(p, opts...)
+	}
+	return c
+}
+
+func newListIsoPermissionsCommand() *command {
+	c := newCommand("listIsoPermissions", "List iso visibility and all accounts that have permissions to view this iso.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewListIsoPermissionsParams(c.string("id"))
+		return cs.ISO.ListIsoPermissions(p, opts...)
+	}
+	return c
+}
+
+func newListIsosCommand() *command {
+	c := newCommand("listIsos", "Lists all available ISO files.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("bootable", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("imagestoreid", &stringValue{}, "", false)
+	c.flag("isofilter", &stringValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("isready", &boolValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("showremoved", &boolValue{}, "", false)
+	c.flag("showunique", &boolValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewListIsosParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("bootable") {
+			p.SetBootable(c.bool("bootable"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("imagestoreid") {
+			p.SetImagestoreid(c.string("imagestoreid"))
+		}
+		if c.isSet("isofilter") {
+			p.SetIsofilter(c.string("isofilter"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("isready") {
+			p.SetIsready(c.bool("isready"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("showremoved") {
+			p.SetShowremoved(c.bool("showremoved"))
+		}
+		if c.isSet("showunique") {
+			p.SetShowunique(c.bool("showunique"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ISO.ListIsos(p, opts...)
+	}
+	return c
+}
+
+func newRegisterIsoCommand() *command {
+	c := newCommand("registerIso", "Registers an existing ISO into the CloudStack Cloud.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("bootable", &boolValue{}, "", false)
+	c.flag("checksum", &stringValue{}, "", false)
+	c.flag("directdownload", &boolValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("imagestoreuuid", &stringValue{}, "", false)
+	c.flag("isdynamicallyscalable", &boolValue{}, "", false)
+	c.flag("isextractable", &boolValue{}, "", false)
+	c.flag("isfeatured", &boolValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.flag("passwordenabled", &boolValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewRegisterIsoParams(c.string("displaytext"), c.string("name"), c.string("url"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("bootable") {
+			p.SetBootable(c.bool("bootable"))
+		}
+		if c.isSet("checksum") {
+			p.SetChecksum(c.string("checksum"))
+		}
+		if c.isSet("directdownload") {
+			p.SetDirectdownload(c.bool("directdownload"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("imagestoreuuid") {
+			p.SetImagestoreuuid(c.string("imagestoreuuid"))
+		}
+		if c.isSet("isdynamicallyscalable") {
+			p.SetIsdynamicallyscalable(c.bool("isdynamicallyscalable"))
+		}
+		if c.isSet("isextractable") {
+			p.SetIsextractable(c.bool("isextractable"))
+		}
+		if c.isSet("isfeatured") {
+			p.SetIsfeatured(c.bool("isfeatured"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		if c.isSet("passwordenabled") {
+			p.SetPasswordenabled(c.bool("passwordenabled"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.ISO.RegisterIso(p, opts...)
+	}
+	return c
+}
+
+func newUpdateIsoCommand() *command {
+	c := newCommand("updateIso", "Updates an ISO file.", false)
+	c.flag("bootable", &boolValue{}, "", false)
+	c.flag("cleanupdetails", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("format", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("isdynamicallyscalable", &boolValue{}, "", false)
+	c.flag("isrouting", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.flag("passwordenabled", &boolValue{}, "", false)
+	c.flag("requireshvm", &boolValue{}, "", false)
+	c.flag("sortkey", &intValue{}, "", false)
+	c.flag("sshkeyenabled", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewUpdateIsoParams(c.string("id"))
+		if c.isSet("bootable") {
+			p.SetBootable(c.bool("bootable"))
+		}
+		if c.isSet("cleanupdetails") {
+			p.SetCleanupdetails(c.bool("cleanupdetails"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("format") {
+			p.SetFormat(c.string("format"))
+		}
+		if c.isSet("isdynamicallyscalable") {
+			p.SetIsdynamicallyscalable(c.bool("isdynamicallyscalable"))
+		}
+		if c.isSet("isrouting") {
+			p.SetIsrouting(c.bool("isrouting"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		if c.isSet("passwordenabled") {
+			p.SetPasswordenabled(c.bool("passwordenabled"))
+		}
+		if c.isSet("requireshvm") {
+			p.SetRequireshvm(c.bool("requireshvm"))
+		}
+		if c.isSet("sortkey") {
+			p.SetSortkey(c.int("sortkey"))
+		}
+		if c.isSet("sshkeyenabled") {
+			p.SetSshkeyenabled(c.bool("sshkeyenabled"))
+		}
+		return cs.ISO.UpdateIso(p, opts...)
+	}
+	return c
+}
+
+func newUpdateIsoPermissionsCommand() *command {
+	c := newCommand("updateIsoPermissions", "Updates ISO permissions", false)
+	c.flag("accounts", &stringsValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("isextractable", &boolValue{}, "", false)
+	c.flag("isfeatured", &boolValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("op", &stringValue{}, "", false)
+	c.flag("projectids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ISO.NewUpdateIsoPermissionsParams(c.string("id"))
+		if c.isSet("accounts") {
+			p.SetAccounts(c.strings("accounts"))
+		}
+		if c.isSet("isextractable") {
+			p.SetIsextractable(c.bool("isextractable"))
+		}
+		if c.isSet("isfeatured") {
+			p.SetIsfeatured(c.bool("isfeatured"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("op") {
+			p.SetOp(c.string("op"))
+		}
+		if c.isSet("projectids") {
+			p.SetProjectids(c.strings("projectids"))
+		}
+		return cs.ISO.UpdateIsoPermissions(p, opts...)
+	}
+	return c
+}
+
+func newAddImageStoreCommand() *command {
+	c := newCommand("addImageStore", "Adds backup image store.", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewAddImageStoreParams(c.string("provider"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ImageStore.AddImageStore(p, opts...)
+	}
+	return c
+}
+
+func newAddImageStoreS3Command() *command {
+	c := newCommand("addImageStoreS3", "Adds S3 Image Store", false)
+	c.flag("accesskey", &stringValue{}, "", true)
+	c.flag("bucket", &stringValue{}, "", true)
+	c.flag("connectiontimeout", &intValue{}, "", false)
+	c.flag("connectionttl", &intValue{}, "", false)
+	c.flag("endpoint", &stringValue{}, "", true)
+	c.flag("maxerrorretry", &intValue{}, "", false)
+	c.flag("s3signer", &stringValue{}, "", false)
+	c.flag("secretkey", &stringValue{}, "", true)
+	c.flag("sockettimeout", &intValue{}, "", false)
+	c.flag("usehttps", &boolValue{}, "", false)
+	c.flag("usetcpkeepalive", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewAddImageStoreS3Params(c.string("accesskey"), c.string("bucket"), c.string("endpoint"), c.string("secretkey"))
+		if c.isSet("connectiontimeout") {
+			p.SetConnectiontimeout(c.int("connectiontimeout"))
+		}
+		if c.isSet("connectionttl") {
+			p.SetConnectionttl(c.int("connectionttl"))
+		}
+		if c.isSet("maxerrorretry") {
+			p.SetMaxerrorretry(c.int("maxerrorretry"))
+		}
+		if c.isSet("s3signer") {
+			p.SetS3signer(c.string("s3signer"))
+		}
+		if c.isSet("sockettimeout") {
+			p.SetSockettimeout(c.int("sockettimeout"))
+		}
+		if c.isSet("usehttps") {
+			p.SetUsehttps(c.bool("usehttps"))
+		}
+		if c.isSet("usetcpkeepalive") {
+			p.SetUsetcpkeepalive(c.bool("usetcpkeepalive"))
+		}
+		return cs.ImageStore.AddImageStoreS3(p, opts...)
+	}
+	return c
+}
+
+func newCreateSecondaryStagingStoreCommand() *command {
+	c := newCommand("createSecondaryStagingStore", "create secondary staging store.", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", false)
+	c.flag("scope", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewCreateSecondaryStagingStoreParams(c.string("url"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("provider") {
+			p.SetProvider(c.string("provider"))
+		}
+		if c.isSet("scope") {
+			p.SetScope(c.string("scope"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ImageStore.CreateSecondaryStagingStore(p, opts...)
+	}
+	return c
+}
+
+func newDeleteImageStoreCommand() *command {
+	c := newCommand("deleteImageStore", "Deletes an image store or Secondary Storage.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewDeleteImageStoreParams(c.string("id"))
+		return cs.ImageStore.DeleteImageStore(p, opts...)
+	}
+	return c
+}
+
+func newDeleteSecondaryStagingStoreCommand() *command {
+	c := newCommand("deleteSecondaryStagingStore", "Deletes a secondary staging store .", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewDeleteSecondaryStagingStoreParams(c.string("id"))
+		return cs.ImageStore.DeleteSecondaryStagingStore(p, opts...)
+	}
+	return c
+}
+
+func newListImageStoresCommand() *command {
+	c := newCommand("listImageStores", "Lists image stores.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", false)
+	c.flag("readonly", &boolValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewListImageStoresParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("provider") {
+			p.SetProvider(c.string("provider"))
+		}
+		if c.isSet("readonly") {
+			p.SetReadonly(c.bool("readonly"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ImageStore.ListImageStores(p, opts...)
+	}
+	return c
+}
+
+func newListSecondaryStagingStoresCommand() *command {
+	c := newCommand("listSecondaryStagingStores", "Lists secondary staging stores.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewListSecondaryStagingStoresParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("provider") {
+			p.SetProvider(c.string("provider"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ImageStore.ListSecondaryStagingStores(p, opts...)
+	}
+	return c
+}
+
+func newUpdateCloudToUseObjectStoreCommand() *command {
+	c := newCommand("updateCloudToUseObjectStore", "Migrate current NFS secondary storages to use object store.", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ImageStore.NewUpdateCloudToUseObjectStoreParams(c.string("provider"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		return cs.ImageStore.UpdateCloudToUseObjectStore(p, opts...)
+	}
+	return c
+}
+
+func newListManagementServersMetricsCommand() *command {
+	c := newCommand("listManagementServersMetrics", "Lists Management Server metrics", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("system", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InfrastructureUsage.NewListManagementServersMetricsParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("system") {
+			p.SetSystem(c.bool("system"))
+		}
+		return cs.InfrastructureUsage.ListManagementServersMetrics(p, opts...)
+	}
+	return c
+}
+
+func newListDbMetricsCommand() *command {
+	c := newCommand("listDbMetrics", "list the db hosts and statistics", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InfrastructureUsage.NewListDbMetricsParams()
+		return cs.InfrastructureUsage.ListDbMetrics(p, opts...)
+	}
+	return c
+}
+
+func newConfigureInternalLoadBalancerElementCommand() *command {
+	c := newCommand("configureInternalLoadBalancerElement", "Configures an Internal Load Balancer element.", true)
+	c.flag("enabled", &boolValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InternalLB.NewConfigureInternalLoadBalancerElementParams(c.bool("enabled"), c.string("id"))
+		return cs.InternalLB.ConfigureInternalLoadBalancerElement(p, opts...)
+	}
+	return c
+}
+
+func newCreateInternalLoadBalancerElementCommand() *command {
+	c := newCommand("createInternalLoadBalancerElement", "Create an Internal Load Balancer element.", true)
+	c.flag("nspid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InternalLB.NewCreateInternalLoadBalancerElementParams(c.string("nspid"))
+		return cs.InternalLB.CreateInternalLoadBalancerElement(p, opts...)
+	}
+	return c
+}
+
+func newListInternalLoadBalancerElementsCommand() *command {
+	c := newCommand("listInternalLoadBalancerElements", "Lists all available Internal Load Balancer elements.", false)
+	c.flag("enabled", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("nspid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InternalLB.NewListInternalLoadBalancerElementsParams()
+		if c.isSet("enabled") {
+			p.SetEnabled(c.bool("enabled"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("nspid") {
+			p.SetNspid(c.string("nspid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.InternalLB.ListInternalLoadBalancerElements(p, opts...)
+	}
+	return c
+}
+
+func newListInternalLoadBalancerVMsCommand() *command {
+	c := newCommand("listInternalLoadBalancerVMs", "List internal LB VMs.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fetchhealthcheckresults", &boolValue{}, "", false)
+	c.flag("forvpc", &boolValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InternalLB.NewListInternalLoadBalancerVMsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fetchhealthcheckresults") {
+			p.SetFetchhealthcheckresults(c.bool("fetchhealthcheckresults"))
+		}
+		if c.isSet("forvpc") {
+			p.SetForvpc(c.bool("forvpc"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.InternalLB.ListInternalLoadBalancerVMs(p, opts...)
+	}
+	return c
+}
+
+func newStartInternalLoadBalancerVMCommand() *command {
+	c := newCommand("startInternalLoadBalancerVM", "Starts an existing internal lb vm.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InternalLB.NewStartInternalLoadBalancerVMParams(c.string("id"))
+		return cs.InternalLB.StartInternalLoadBalancerVM(p, opts...)
+	}
+	return c
+}
+
+func newStopInternalLoadBalancerVMCommand() *command {
+	c := newCommand("stopInternalLoadBalancerVM", "Stops an Internal LB vm.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.InternalLB.NewStopInternalLoadBalancerVMParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.InternalLB.StopInternalLoadBalancerVM(p, opts...)
+	}
+	return c
+}
+
+func newAddKubernetesSupportedVersionCommand() *command {
+	c := newCommand("addKubernetesSupportedVersion", "Add a supported Kubernetes version", false)
+	c.flag("checksum", &stringValue{}, "", false)
+	c.flag("directdownload", &boolValue{}, "", false)
+	c.flag("mincpunumber", &intValue{}, "", true)
+	c.flag("minmemory", &intValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("semanticversion", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewAddKubernetesSupportedVersionParams(c.int("mincpunumber"), c.int("minmemory"), c.string("semanticversion"))
+		if c.isSet("checksum") {
+			p.SetChecksum(c.string("checksum"))
+		}
+		if c.isSet("directdownload") {
+			p.SetDirectdownload(c.bool("directdownload"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Kubernetes.AddKubernetesSupportedVersion(p, opts...)
+	}
+	return c
+}
+
+func newCreateKubernetesClusterCommand() *command {
+	c := newCommand("createKubernetesCluster", "Creates a Kubernetes cluster", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("clustertype", &stringValue{}, "", false)
+	c.flag("controlnodes", &int64Value{}, "", false)
+	c.flag("description", &stringValue{}, "", true)
+	c.flag("dockerregistrypassword", &stringValue{}, "", false)
+	c.flag("dockerregistryurl", &stringValue{}, "", false)
+	c.flag("dockerregistryusername", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("externalloadbalanceripaddress", &stringValue{}, "", false)
+	c.flag("keypair", &stringValue{}, "", false)
+	c.flag("kubernetesversionid", &stringValue{}, "", true)
+	c.flag("masternodes", &int64Value{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("noderootdisksize", &int64Value{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.flag("size", &int64Value{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewCreateKubernetesClusterParams(c.string("description"), c.string("kubernetesversionid"), c.string("name"), c.string("serviceofferingid"), c.int64("size"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("clustertype") {
+			p.SetClustertype(c.string("clustertype"))
+		}
+		if c.isSet("controlnodes") {
+			p.SetControlnodes(c.int64("controlnodes"))
+		}
+		if c.isSet("dockerregistrypassword") {
+			p.SetDockerregistrypassword(c.string("dockerregistrypassword"))
+		}
+		if c.isSet("dockerregistryurl") {
+			p.SetDockerregistryurl(c.string("dockerregistryurl"))
+		}
+		if c.isSet("dockerregistryusername") {
+			p.SetDockerregistryusername(c.string("dockerregistryusername"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("externalloadbalanceripaddress") {
+			p.SetExternalloadbalanceripaddress(c.string("externalloadbalanceripaddress"))
+		}
+		if c.isSet("keypair") {
+			p.SetKeypair(c.string("keypair"))
+		}
+		if c.isSet("masternodes") {
+			p.SetMasternodes(c.int64("masternodes"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("noderootdisksize") {
+			p.SetNoderootdisksize(c.int64("noderootdisksize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.Kubernetes.CreateKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newDeleteKubernetesClusterCommand() *command {
+	c := newCommand("deleteKubernetesCluster", "Deletes a Kubernetes cluster", true)
+	c.flag("cleanup", &boolValue{}, "", false)
+	c.flag("expunge", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewDeleteKubernetesClusterParams(c.string("id"))
+		if c.isSet("cleanup") {
+			p.SetCleanup(c.bool("cleanup"))
+		}
+		if c.isSet("expunge") {
+			p.SetExpunge(c.bool("expunge"))
+		}
+		return cs.Kubernetes.DeleteKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newDeleteKubernetesSupportedVersionCommand() *command {
+	c := newCommand("deleteKubernetesSupportedVersion", "Deletes a Kubernetes cluster", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewDeleteKubernetesSupportedVersionParams(c.string("id"))
+		return cs.Kubernetes.DeleteKubernetesSupportedVersion(p, opts...)
+	}
+	return c
+}
+
+func newGetKubernetesClusterConfigCommand() *command {
+	c := newCommand("getKubernetesClusterConfig", "Get Kubernetes cluster config", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewGetKubernetesClusterConfigParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		return cs.Kubernetes.GetKubernetesClusterConfig(p, opts...)
+	}
+	return c
+}
+
+func newListKubernetesClustersCommand() *command {
+	c := newCommand("listKubernetesClusters", "Lists Kubernetes clusters", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("clustertype", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewListKubernetesClustersParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("clustertype") {
+			p.SetClustertype(c.string("clustertype"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		return cs.Kubernetes.ListKubernetesClusters(p, opts...)
+	}
+	return c
+}
+
+func newListKubernetesSupportedVersionsCommand() *command {
+	c := newCommand("listKubernetesSupportedVersions", "Lists supported Kubernetes version", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("minimumkubernetesversionid", &stringValue{}, "", false)
+	c.flag("minimumsemanticversion", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewListKubernetesSupportedVersionsParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("minimumkubernetesversionid") {
+			p.SetMinimumkubernetesversionid(c.string("minimumkubernetesversionid"))
+		}
+		if c.isSet("minimumsemanticversion") {
+			p.SetMinimumsemanticversion(c.string("minimumsemanticversion"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Kubernetes.ListKubernetesSupportedVersions(p, opts...)
+	}
+	return c
+}
+
+func newScaleKubernetesClusterCommand() *command {
+	c := newCommand("scaleKubernetesCluster", "Scales a created, running or stopped CloudManaged Kubernetes cluster", true)
+	c.flag("autoscalingenabled", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("maxsize", &int64Value{}, "", false)
+	c.flag("minsize", &int64Value{}, "", false)
+	c.flag("nodeids", &stringsValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", false)
+	c.flag("size", &int64Value{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewScaleKubernetesClusterParams(c.string("id"))
+		if c.isSet("autoscalingenabled") {
+			p.SetAutoscalingenabled(c.bool("autoscalingenabled"))
+		}
+		if c.isSet("maxsize") {
+			p.SetMaxsize(c.int64("maxsize"))
+		}
+		if c.isSet("minsize") {
+			p.SetMinsize(c.int64("minsize"))
+		}
+		if c.isSet("nodeids") {
+			p.SetNodeids(c.strings("nodeids"))
+		}
+		if c.isSet("serviceofferingid") {
+			p.SetServiceofferingid(c.string("serviceofferingid"))
+		}
+		if c.isSet("size") {
+			p.SetSize(c.int64("size"))
+		}
+		return cs.Kubernetes.ScaleKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newStartKubernetesClusterCommand() *command {
+	c := newCommand("startKubernetesCluster", "Starts a stopped CloudManaged Kubernetes cluster", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewStartKubernetesClusterParams(c.string("id"))
+		return cs.Kubernetes.StartKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newStopKubernetesClusterCommand() *command {
+	c := newCommand("stopKubernetesCluster", "Stops a running CloudManaged Kubernetes cluster", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewStopKubernetesClusterParams(c.string("id"))
+		return cs.Kubernetes.StopKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newUpdateKubernetesSupportedVersionCommand() *command {
+	c := newCommand("updateKubernetesSupportedVersion", "Update a supported Kubernetes version", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("state", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewUpdateKubernetesSupportedVersionParams(c.string("id"), c.string("state"))
+		return cs.Kubernetes.UpdateKubernetesSupportedVersion(p, opts...)
+	}
+	return c
+}
+
+func newUpgradeKubernetesClusterCommand() *command {
+	c := newCommand("upgradeKubernetesCluster", "Upgrades a running CloudManaged Kubernetes cluster", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("kubernetesversionid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewUpgradeKubernetesClusterParams(c.string("id"), c.string("kubernetesversionid"))
+		return cs.Kubernetes.UpgradeKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newAddVirtualMachinesToKubernetesClusterCommand() *command {
+	c := newCommand("addVirtualMachinesToKubernetesCluster", "Add VMs to an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes clusters.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("iscontrolnode", &boolValue{}, "", false)
+	c.flag("virtualmachineids", &stringsValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewAddVirtualMachinesToKubernetesClusterParams(c.string("id"), c.strings("virtualmachineids"))
+		if c.isSet("iscontrolnode") {
+			p.SetIscontrolnode(c.bool("iscontrolnode"))
+		}
+		return cs.Kubernetes.AddVirtualMachinesToKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newRemoveVirtualMachinesFromKubernetesClusterCommand() *command {
+	c := newCommand("removeVirtualMachinesFromKubernetesCluster", "Remove VMs from an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes clusters.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("virtualmachineids", &stringsValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Kubernetes.NewRemoveVirtualMachinesFromKubernetesClusterParams(c.string("id"), c.strings("virtualmachineids"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Kubernetes.RemoveVirtualMachinesFromKubernetesCluster(p, opts...)
+	}
+	return c
+}
+
+func newAddLdapConfigurationCommand() *command {
+	c := newCommand("addLdapConfiguration", "Add a new Ldap Configuration", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hostname", &stringValue{}, "", true)
+	c.flag("port", &intValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewAddLdapConfigurationParams(c.string("hostname"), c.int("port"))
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		return cs.LDAP.AddLdapConfiguration(p, opts...)
+	}
+	return c
+}
+
+func newDeleteLdapConfigurationCommand() *command {
+	c := newCommand("deleteLdapConfiguration", "Remove an Ldap Configuration", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hostname", &stringValue{}, "", true)
+	c.flag("port", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewDeleteLdapConfigurationParams(c.string("hostname"))
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("port") {
+			p.SetPort(c.int("port"))
+		}
+		return cs.LDAP.DeleteLdapConfiguration(p, opts...)
+	}
+	return c
+}
+
+func newImportLdapUsersCommand() *command {
+	c := newCommand("importLdapUsers", "Import LDAP users", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accountdetails", &mapValue{}, "", false)
+	c.flag("accounttype", &intValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("group", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("roleid", &stringValue{}, "", false)
+	c.flag("timezone", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewImportLdapUsersParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accountdetails") {
+			p.SetAccountdetails(c.stringMap("accountdetails"))
+		}
+		if c.isSet("accounttype") {
+			p.SetAccounttype(c.int("accounttype"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("group") {
+			p.SetGroup(c.string("group"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("roleid") {
+			p.SetRoleid(c.string("roleid"))
+		}
+		if c.isSet("timezone") {
+			p.SetTimezone(c.string("timezone"))
+		}
+		return cs.LDAP.ImportLdapUsers(p, opts...)
+	}
+	return c
+}
+
+func newLdapConfigCommand() *command {
+	c := newCommand("ldapConfig", "(Deprecated, use addLdapConfiguration) Configure the LDAP context for this site.", false)
+	c.flag("binddn", &stringValue{}, "", false)
+	c.flag("bindpass", &stringValue{}, "", false)
+	c.flag("hostname", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("port", &intValue{}, "", false)
+	c.flag("queryfilter", &stringValue{}, "", false)
+	c.flag("searchbase", &stringValue{}, "", false)
+	c.flag("ssl", &boolValue{}, "", false)
+	c.flag("truststore", &stringValue{}, "", false)
+	c.flag("truststorepass", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewLdapConfigParams()
+		if c.isSet("binddn") {
+			p.SetBinddn(c.string("binddn"))
+		}
+		if c.isSet("bindpass") {
+			p.SetBindpass(c.string("bindpass"))
+		}
+		if c.isSet("hostname") {
+			p.SetHostname(c.string("hostname"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("port") {
+			p.SetPort(c.int("port"))
+		}
+		if c.isSet("queryfilter") {
+			p.SetQueryfilter(c.string("queryfilter"))
+		}
+		if c.isSet("searchbase") {
+			p.SetSearchbase(c.string("searchbase"))
+		}
+		if c.isSet("ssl") {
+			p.SetSsl(c.bool("ssl"))
+		}
+		if c.isSet("truststore") {
+			p.SetTruststore(c.string("truststore"))
+		}
+		if c.isSet("truststorepass") {
+			p.SetTruststorepass(c.string("truststorepass"))
+		}
+		return cs.LDAP.LdapConfig(p, opts...)
+	}
+	return c
+}
+
+func newLdapCreateAccountCommand() *command {
+	c := newCommand("ldapCreateAccount", "Creates an account from an LDAP user", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accountdetails", &mapValue{}, "", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("accounttype", &intValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.flag("roleid", &stringValue{}, "", false)
+	c.flag("timezone", &stringValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewLdapCreateAccountParams(c.string("username"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accountdetails") {
+			p.SetAccountdetails(c.stringMap("accountdetails"))
+		}
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("accounttype") {
+			p.SetAccounttype(c.int("accounttype"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		if c.isSet("roleid") {
+			p.SetRoleid(c.string("roleid"))
+		}
+		if c.isSet("timezone") {
+			p.SetTimezone(c.string("timezone"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.LDAP.LdapCreateAccount(p, opts...)
+	}
+	return c
+}
+
+func newLdapRemoveCommand() *command {
+	c := newCommand("ldapRemove", "(Deprecated , use deleteLdapConfiguration) Remove the LDAP context for this site.", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewLdapRemoveParams()
+		return cs.LDAP.LdapRemove(p, opts...)
+	}
+	return c
+}
+
+func newLinkDomainToLdapCommand() *command {
+	c := newCommand("linkDomainToLdap", "link an existing cloudstack domain to group or OU in ldap", false)
+	c.flag("accounttype", &intValue{}, "", true)
+	c.flag("admin", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("ldapdomain", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewLinkDomainToLdapParams(c.int("accounttype"), c.string("domainid"), c.string("type"))
+		if c.isSet("admin") {
+			p.SetAdmin(c.string("admin"))
+		}
+		if c.isSet("ldapdomain") {
+			p.SetLdapdomain(c.string("ldapdomain"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.LDAP.LinkDomainToLdap(p, opts...)
+	}
+	return c
+}
+
+func newListLdapConfigurationsCommand() *command {
+	c := newCommand("listLdapConfigurations", "Lists all LDAP configurations", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hostname", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("port", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewListLdapConfigurationsParams()
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("hostname") {
+			p.SetHostname(c.string("hostname"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("port") {
+			p.SetPort(c.int("port"))
+		}
+		return cs.LDAP.ListLdapConfigurations(p, opts...)
+	}
+	return c
+}
+
+func newListLdapUsersCommand() *command {
+	c := newCommand("listLdapUsers", "Lists LDAP Users according to the specifications from the user request.", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listtype", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("userfilter", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewListLdapUsersParams()
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listtype") {
+			p.SetListtype(c.string("listtype"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("userfilter") {
+			p.SetUserfilter(c.string("userfilter"))
+		}
+		return cs.LDAP.ListLdapUsers(p, opts...)
+	}
+	return c
+}
+
+func newSearchLdapCommand() *command {
+	c := newCommand("searchLdap", "Searches LDAP based on the username attribute", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("query", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LDAP.NewSearchLdapParams(c.string("query"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.LDAP.SearchLdap(p, opts...)
+	}
+	return c
+}
+
+func newGetApiLimitCommand() *command {
+	c := newCommand("getApiLimit", "Get API limit count for the caller", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Limit.NewGetApiLimitParams()
+		return cs.Limit.GetApiLimit(p, opts...)
+	}
+	return c
+}
+
+func newListResourceLimitsCommand() *command {
+	c := newCommand("listResourceLimits", "Lists resource limits.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &int64Value{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("resourcetype", &intValue{}, "", false)
+	c.flag("resourcetypename", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Limit.NewListResourceLimitsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.int64("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("resourcetype") {
+			p.SetResourcetype(c.int("resourcetype"))
+		}
+		if c.isSet("resourcetypename") {
+			p.SetResourcetypename(c.string("resourcetypename"))
+		}
+		return cs.Limit.ListResourceLimits(p, opts...)
+	}
+	return c
+}
+
+func newResetApiLimitCommand() *command {
+	c := newCommand("resetApiLimit", "Reset api count", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Limit.NewResetApiLimitParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		return cs.Limit.ResetApiLimit(p, opts...)
+	}
+	return c
+}
+
+func newUpdateResourceCountCommand() *command {
+	c := newCommand("updateResourceCount", "Recalculate and update resource count for an account or domain.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("resourcetype", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Limit.NewUpdateResourceCountParams(c.string("domainid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("resourcetype") {
+			p.SetResourcetype(c.int("resourcetype"))
+		}
+		return cs.Limit.UpdateResourceCount(p, opts...)
+	}
+	return c
+}
+
+func newUpdateResourceLimitCommand() *command {
+	c := newCommand("updateResourceLimit", "Updates resource limits for an account or domain.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("max", &int64Value{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("resourcetype", &intValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Limit.NewUpdateResourceLimitParams(c.int("resourcetype"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("max") {
+			p.SetMax(c.int64("max"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.Limit.UpdateResourceLimit(p, opts...)
+	}
+	return c
+}
+
+func newAddNetscalerLoadBalancerCommand() *command {
+	c := newCommand("addNetscalerLoadBalancer", "Adds a netscaler load balancer device", true)
+	c.flag("gslbprovider", &boolValue{}, "", false)
+	c.flag("gslbproviderprivateip", &stringValue{}, "", false)
+	c.flag("gslbproviderpublicip", &stringValue{}, "", false)
+	c.flag("isexclusivegslbprovider", &boolValue{}, "", false)
+	c.flag("networkdevicetype", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewAddNetscalerLoadBalancerParams(c.string("networkdevicetype"), c.string("password"), c.string("physicalnetworkid"), c.string("url"), c.string("username"))
+		if c.isSet("gslbprovider") {
+			p.SetGslbprovider(c.bool("gslbprovider"))
+		}
+		if c.isSet("gslbproviderprivateip") {
+			p.SetGslbproviderprivateip(c.string("gslbproviderprivateip"))
+		}
+		if c.isSet("gslbproviderpublicip") {
+			p.SetGslbproviderpublicip(c.string("gslbproviderpublicip"))
+		}
+		if c.isSet("isexclusivegslbprovider") {
+			p.SetIsexclusivegslbprovider(c.bool("isexclusivegslbprovider"))
+		}
+		return cs.LoadBalancer.AddNetscalerLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newAssignCertToLoadBalancerCommand() *command {
+	c := newCommand("assignCertToLoadBalancer", "Assigns a certificate to a load balancer rule", true)
+	c.flag("certid", &stringValue{}, "", true)
+	c.flag("lbruleid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewAssignCertToLoadBalancerParams(c.string("certid"), c.string("lbruleid"))
+		return cs.LoadBalancer.AssignCertToLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newAssignToGlobalLoadBalancerRuleCommand() *command {
+	c := newCommand("assignToGlobalLoadBalancerRule", "Assign load balancer rule or list of load balancer rules to a global load balancer rules.", true)
+	c.flag("gslblbruleweightsmap", &mapValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("loadbalancerrulelist", &stringsValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewAssignToGlobalLoadBalancerRuleParams(c.string("id"), c.strings("loadbalancerrulelist"))
+		if c.isSet("gslblbruleweightsmap") {
+			p.SetGslblbruleweightsmap(c.stringMap("gslblbruleweightsmap"))
+		}
+		return cs.LoadBalancer.AssignToGlobalLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newAssignToLoadBalancerRuleCommand() *command {
+	c := newCommand("assignToLoadBalancerRule", "Assigns virtual machine or a list of virtual machines to a load balancer rule.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("virtualmachineids", &stringsValue{}, "", false)
+	c.flag("vmidipmap", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewAssignToLoadBalancerRuleParams(c.string("id"))
+		if c.isSet("virtualmachineids") {
+			p.SetVirtualmachineids(c.strings("virtualmachineids"))
+		}
+		if c.isSet("vmidipmap") {
+			p.SetVmidipmap(c.stringMap("vmidipmap"))
+		}
+		return cs.LoadBalancer.AssignToLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newConfigureNetscalerLoadBalancerCommand() *command {
+	c := newCommand("configureNetscalerLoadBalancer", "configures a netscaler load balancer device", true)
+	c.flag("inline", &boolValue{}, "", false)
+	c.flag("lbdevicecapacity", &int64Value{}, "", false)
+	c.flag("lbdevicededicated", &boolValue{}, "", false)
+	c.flag("lbdeviceid", &stringValue{}, "", true)
+	c.flag("podids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewConfigureNetscalerLoadBalancerParams(c.string("lbdeviceid"))
+		if c.isSet("inline") {
+			p.SetInline(c.bool("inline"))
+		}
+		if c.isSet("lbdevicecapacity") {
+			p.SetLbdevicecapacity(c.int64("lbdevicecapacity"))
+		}
+		if c.isSet("lbdevicededicated") {
+			p.SetLbdevicededicated(c.bool("lbdevicededicated"))
+		}
+		if c.isSet("podids") {
+			p.SetPodids(c.strings("podids"))
+		}
+		return cs.LoadBalancer.ConfigureNetscalerLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newCreateGlobalLoadBalancerRuleCommand() *command {
+	c := newCommand("createGlobalLoadBalancerRule", "Creates a global load balancer rule", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("gslbdomainname", &stringValue{}, "", true)
+	c.flag("gslblbmethod", &stringValue{}, "", false)
+	c.flag("gslbservicetype", &stringValue{}, "", true)
+	c.flag("gslbstickysessionmethodname", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("regionid", &intValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewCreateGlobalLoadBalancerRuleParams(c.string("gslbdomainname"), c.string("gslbservicetype"), c.string("name"), c.int("regionid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("gslblbmethod") {
+			p.SetGslblbmethod(c.string("gslblbmethod"))
+		}
+		if c.isSet("gslbstickysessionmethodname") {
+			p.SetGslbstickysessionmethodname(c.string("gslbstickysessionmethodname"))
+		}
+		return cs.LoadBalancer.CreateGlobalLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newCreateLBHealthCheckPolicyCommand() *command {
+	c := newCommand("createLBHealthCheckPolicy", "Creates a load balancer health check policy", true)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("healthythreshold", &intValue{}, "", false)
+	c.flag("intervaltime", &intValue{}, "", false)
+	c.flag("lbruleid", &stringValue{}, "", true)
+	c.flag("pingpath", &stringValue{}, "", false)
+	c.flag("responsetimeout", &intValue{}, "", false)
+	c.flag("unhealthythreshold", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewCreateLBHealthCheckPolicyParams(c.string("lbruleid"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("healthythreshold") {
+			p.SetHealthythreshold(c.int("healthythreshold"))
+		}
+		if c.isSet("intervaltime") {
+			p.SetIntervaltime(c.int("intervaltime"))
+		}
+		if c.isSet("pingpath") {
+			p.SetPingpath(c.string("pingpath"))
+		}
+		if c.isSet("responsetimeout") {
+			p.SetResponsetimeout(c.int("responsetimeout"))
+		}
+		if c.isSet("unhealthythreshold") {
+			p.SetUnhealthythreshold(c.int("unhealthythreshold"))
+		}
+		return cs.LoadBalancer.CreateLBHealthCheckPolicy(p, opts...)
+	}
+	return c
+}
+
+func newCreateLBStickinessPolicyCommand() *command {
+	c := newCommand("createLBStickinessPolicy", "Creates a load balancer stickiness policy", true)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("lbruleid", &stringValue{}, "", true)
+	c.flag("methodname", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("param", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewCreateLBStickinessPolicyParams(c.string("lbruleid"), c.string("methodname"), c.string("name"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("param") {
+			p.SetParam(c.stringMap("param"))
+		}
+		return cs.LoadBalancer.CreateLBStickinessPolicy(p, opts...)
+	}
+	return c
+}
+
+func newCreateLoadBalancerCommand() *command {
+	c := newCommand("createLoadBalancer", "Creates an internal load balancer", true)
+	c.flag("algorithm", &stringValue{}, "", true)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("instanceport", &intValue{}, "", true)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.flag("scheme", &stringValue{}, "", true)
+	c.flag("sourceipaddress", &stringValue{}, "", false)
+	c.flag("sourceipaddressnetworkid", &stringValue{}, "", true)
+	c.flag("sourceport", &intValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewCreateLoadBalancerParams(c.string("algorithm"), c.int("instanceport"), c.string("name"), c.string("networkid"), c.string("scheme"), c.string("sourceipaddressnetworkid"), c.int("sourceport"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("sourceipaddress") {
+			p.SetSourceipaddress(c.string("sourceipaddress"))
+		}
+		return cs.LoadBalancer.CreateLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newCreateLoadBalancerRuleCommand() *command {
+	c := newCommand("createLoadBalancerRule", "Creates a load balancer rule", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("algorithm", &stringValue{}, "", true)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("openfirewall", &boolValue{}, "", false)
+	c.flag("privateport", &intValue{}, "", true)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("publicipid", &stringValue{}, "", false)
+	c.flag("publicport", &intValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewCreateLoadBalancerRuleParams(c.string("algorithm"), c.string("name"), c.int("privateport"), c.int("publicport"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("openfirewall") {
+			p.SetOpenfirewall(c.bool("openfirewall"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("publicipid") {
+			p.SetPublicipid(c.string("publicipid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.LoadBalancer.CreateLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteGlobalLoadBalancerRuleCommand() *command {
+	c := newCommand("deleteGlobalLoadBalancerRule", "Deletes a global load balancer rule.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewDeleteGlobalLoadBalancerRuleParams(c.string("id"))
+		return cs.LoadBalancer.DeleteGlobalLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteLBHealthCheckPolicyCommand() *command {
+	c := newCommand("deleteLBHealthCheckPolicy", "Deletes a load balancer health check policy.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewDeleteLBHealthCheckPolicyParams(c.string("id"))
+		return cs.LoadBalancer.DeleteLBHealthCheckPolicy(p, opts...)
+	}
+	return c
+}
+
+func newDeleteLBStickinessPolicyCommand() *command {
+	c := newCommand("deleteLBStickinessPolicy", "Deletes a load balancer stickiness policy.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewDeleteLBStickinessPolicyParams(c.string("id"))
+		return cs.LoadBalancer.DeleteLBStickinessPolicy(p, opts...)
+	}
+	return c
+}
+
+func newDeleteLoadBalancerCommand() *command {
+	c := newCommand("deleteLoadBalancer", "Deletes an internal load balancer", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewDeleteLoadBalancerParams(c.string("id"))
+		return cs.LoadBalancer.DeleteLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newDeleteLoadBalancerRuleCommand() *command {
+	c := newCommand("deleteLoadBalancerRule", "Deletes a load balancer rule.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewDeleteLoadBalancerRuleParams(c.string("id"))
+		return cs.LoadBalancer.DeleteLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNetscalerLoadBalancerCommand() *command {
+	c := newCommand("deleteNetscalerLoadBalancer", "delete a netscaler load balancer device", true)
+	c.flag("lbdeviceid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewDeleteNetscalerLoadBalancerParams(c.string("lbdeviceid"))
+		return cs.LoadBalancer.DeleteNetscalerLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newDeleteSslCertCommand() *command {
+	c := newCommand("deleteSslCert", "Delete a certificate to CloudStack", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewDeleteSslCertParams(c.string("id"))
+		return cs.LoadBalancer.DeleteSslCert(p, opts...)
+	}
+	return c
+}
+
+func newListGlobalLoadBalancerRulesCommand() *command {
+	c := newCommand("listGlobalLoadBalancerRules", "Lists load balancer rules.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("regionid", &intValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListGlobalLoadBalancerRulesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("regionid") {
+			p.SetRegionid(c.int("regionid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.LoadBalancer.ListGlobalLoadBalancerRules(p, opts...)
+	}
+	return c
+}
+
+func newListLBHealthCheckPoliciesCommand() *command {
+	c := newCommand("listLBHealthCheckPolicies", "Lists load balancer health check policies.", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("lbruleid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListLBHealthCheckPoliciesParams()
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("lbruleid") {
+			p.SetLbruleid(c.string("lbruleid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.LoadBalancer.ListLBHealthCheckPolicies(p, opts...)
+	}
+	return c
+}
+
+func newListLBStickinessPoliciesCommand() *command {
+	c := newCommand("listLBStickinessPolicies", "Lists load balancer stickiness policies.", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("lbruleid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListLBStickinessPoliciesParams()
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("lbruleid") {
+			p.SetLbruleid(c.string("lbruleid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.LoadBalancer.ListLBStickinessPolicies(p, opts...)
+	}
+	return c
+}
+
+func newListLoadBalancerRuleInstancesCommand() *command {
+	c := newCommand("listLoadBalancerRuleInstances", "List all virtual machine instances that are assigned to a load balancer rule.", false)
+	c.flag("applied", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("lbvmips", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListLoadBalancerRuleInstancesParams(c.string("id"))
+		if c.isSet("applied") {
+			p.SetApplied(c.bool("applied"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("lbvmips") {
+			p.SetLbvmips(c.bool("lbvmips"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.LoadBalancer.ListLoadBalancerRuleInstances(p, opts...)
+	}
+	return c
+}
+
+func newListLoadBalancerRulesCommand() *command {
+	c := newCommand("listLoadBalancerRules", "Lists load balancer rules.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("publicipid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListLoadBalancerRulesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("publicipid") {
+			p.SetPublicipid(c.string("publicipid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.LoadBalancer.ListLoadBalancerRules(p, opts...)
+	}
+	return c
+}
+
+func newListLoadBalancersCommand() *command {
+	c := newCommand("listLoadBalancers", "Lists internal load balancers", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("scheme", &stringValue{}, "", false)
+	c.flag("sourceipaddress", &stringValue{}, "", false)
+	c.flag("sourceipaddressnetworkid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListLoadBalancersParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("scheme") {
+			p.SetScheme(c.string("scheme"))
+		}
+		if c.isSet("sourceipaddress") {
+			p.SetSourceipaddress(c.string("sourceipaddress"))
+		}
+		if c.isSet("sourceipaddressnetworkid") {
+			p.SetSourceipaddressnetworkid(c.string("sourceipaddressnetworkid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.LoadBalancer.ListLoadBalancers(p, opts...)
+	}
+	return c
+}
+
+func newListNetscalerLoadBalancersCommand() *command {
+	c := newCommand("listNetscalerLoadBalancers", "lists netscaler load balancer devices", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("lbdeviceid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListNetscalerLoadBalancersParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("lbdeviceid") {
+			p.SetLbdeviceid(c.string("lbdeviceid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		return cs.LoadBalancer.ListNetscalerLoadBalancers(p, opts...)
+	}
+	return c
+}
+
+func newListSslCertsCommand() *command {
+	c := newCommand("listSslCerts", "Lists SSL certificates", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("certid", &stringValue{}, "", false)
+	c.flag("lbruleid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewListSslCertsParams()
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("certid") {
+			p.SetCertid(c.string("certid"))
+		}
+		if c.isSet("lbruleid") {
+			p.SetLbruleid(c.string("lbruleid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.LoadBalancer.ListSslCerts(p, opts...)
+	}
+	return c
+}
+
+func newRemoveCertFromLoadBalancerCommand() *command {
+	c := newCommand("removeCertFromLoadBalancer", "Removes a certificate from a load balancer rule", true)
+	c.flag("lbruleid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewRemoveCertFromLoadBalancerParams(c.string("lbruleid"))
+		return cs.LoadBalancer.RemoveCertFromLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newRemoveFromGlobalLoadBalancerRuleCommand() *command {
+	c := newCommand("removeFromGlobalLoadBalancerRule", "Removes a load balancer rule association with global load balancer rule", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("loadbalancerrulelist", &stringsValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewRemoveFromGlobalLoadBalancerRuleParams(c.string("id"), c.strings("loadbalancerrulelist"))
+		return cs.LoadBalancer.RemoveFromGlobalLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newRemoveFromLoadBalancerRuleCommand() *command {
+	c := newCommand("removeFromLoadBalancerRule", "Removes a virtual machine or a list of virtual machines from a load balancer rule.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("virtualmachineids", &stringsValue{}, "", false)
+	c.flag("vmidipmap", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewRemoveFromLoadBalancerRuleParams(c.string("id"))
+		if c.isSet("virtualmachineids") {
+			p.SetVirtualmachineids(c.strings("virtualmachineids"))
+		}
+		if c.isSet("vmidipmap") {
+			p.SetVmidipmap(c.stringMap("vmidipmap"))
+		}
+		return cs.LoadBalancer.RemoveFromLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newUpdateGlobalLoadBalancerRuleCommand() *command {
+	c := newCommand("updateGlobalLoadBalancerRule", "update global load balancer rules.", true)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("gslblbmethod", &stringValue{}, "", false)
+	c.flag("gslbstickysessionmethodname", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewUpdateGlobalLoadBalancerRuleParams(c.string("id"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("gslblbmethod") {
+			p.SetGslblbmethod(c.string("gslblbmethod"))
+		}
+		if c.isSet("gslbstickysessionmethodname") {
+			p.SetGslbstickysessionmethodname(c.string("gslbstickysessionmethodname"))
+		}
+		return cs.LoadBalancer.UpdateGlobalLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newUpdateLBHealthCheckPolicyCommand() *command {
+	c := newCommand("updateLBHealthCheckPolicy", "Updates load balancer health check policy", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewUpdateLBHealthCheckPolicyParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.LoadBalancer.UpdateLBHealthCheckPolicy(p, opts...)
+	}
+	return c
+}
+
+func newUpdateLBStickinessPolicyCommand() *command {
+	c := newCommand("updateLBStickinessPolicy", "Updates load balancer stickiness policy", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewUpdateLBStickinessPolicyParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.LoadBalancer.UpdateLBStickinessPolicy(p, opts...)
+	}
+	return c
+}
+
+func newUpdateLoadBalancerCommand() *command {
+	c := newCommand("updateLoadBalancer", "Updates an internal load balancer", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewUpdateLoadBalancerParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.LoadBalancer.UpdateLoadBalancer(p, opts...)
+	}
+	return c
+}
+
+func newUpdateLoadBalancerRuleCommand() *command {
+	c := newCommand("updateLoadBalancerRule", "Updates load balancer", true)
+	c.flag("algorithm", &stringValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewUpdateLoadBalancerRuleParams(c.string("id"))
+		if c.isSet("algorithm") {
+			p.SetAlgorithm(c.string("algorithm"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		return cs.LoadBalancer.UpdateLoadBalancerRule(p, opts...)
+	}
+	return c
+}
+
+func newUploadSslCertCommand() *command {
+	c := newCommand("uploadSslCert", "Upload a certificate to CloudStack", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("certchain", &stringValue{}, "", false)
+	c.flag("certificate", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("enabledrevocationcheck", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", false)
+	c.flag("privatekey", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.LoadBalancer.NewUploadSslCertParams(c.string("certificate"), c.string("name"), c.string("privatekey"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("certchain") {
+			p.SetCertchain(c.string("certchain"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("enabledrevocationcheck") {
+			p.SetEnabledrevocationcheck(c.bool("enabledrevocationcheck"))
+		}
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.LoadBalancer.UploadSslCert(p, opts...)
+	}
+	return c
+}
+
+func newCreateIpForwardingRuleCommand() *command {
+	c := newCommand("createIpForwardingRule", "Creates an IP forwarding rule", true)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("ipaddressid", &stringValue{}, "", true)
+	c.flag("openfirewall", &boolValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", true)
+	c.flag("startport", &intValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NAT.NewCreateIpForwardingRuleParams(c.string("ipaddressid"), c.string("protocol"), c.int("startport"))
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("openfirewall") {
+			p.SetOpenfirewall(c.bool("openfirewall"))
+		}
+		return cs.NAT.CreateIpForwardingRule(p, opts...)
+	}
+	return c
+}
+
+func newDeleteIpForwardingRuleCommand() *command {
+	c := newCommand("deleteIpForwardingRule", "Deletes an IP forwarding rule", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NAT.NewDeleteIpForwardingRuleParams(c.string("id"))
+		return cs.NAT.DeleteIpForwardingRule(p, opts...)
+	}
+	return c
+}
+
+func newDisableStaticNatCommand() *command {
+	c := newCommand("disableStaticNat", "Disables static rule for given IP address", true)
+	c.flag("ipaddressid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NAT.NewDisableStaticNatParams(c.string("ipaddressid"))
+		return cs.NAT.DisableStaticNat(p, opts...)
+	}
+	return c
+}
+
+func newEnableStaticNatCommand() *command {
+	c := newCommand("enableStaticNat", "Enables static NAT for given IP address", false)
+	c.flag("ipaddressid", &stringValue{}, "", true)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.flag("vmguestip", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NAT.NewEnableStaticNatParams(c.string("ipaddressid"), c.string("virtualmachineid"))
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("vmguestip") {
+			p.SetVmguestip(c.string("vmguestip"))
+		}
+		return cs.NAT.EnableStaticNat(p, opts...)
+	}
+	return c
+}
+
+func newListIpForwardingRulesCommand() *command {
+	c := newCommand("listIpForwardingRules", "List the IP forwarding rules", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ipaddressid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NAT.NewListIpForwardingRulesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ipaddressid") {
+			p.SetIpaddressid(c.string("ipaddressid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		return cs.NAT.ListIpForwardingRules(p, opts...)
+	}
+	return c
+}
+
+func newCreateNetworkACLCommand() *command {
+	c := newCommand("createNetworkACL", "Creates a ACL rule in the given network (the network has to belong to VPC)", true)
+	c.flag("aclid", &stringValue{}, "", false)
+	c.flag("action", &stringValue{}, "", false)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("number", &intValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", true)
+	c.flag("reason", &stringValue{}, "", false)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewCreateNetworkACLParams(c.string("protocol"))
+		if c.isSet("aclid") {
+			p.SetAclid(c.string("aclid"))
+		}
+		if c.isSet("action") {
+			p.SetAction(c.string("action"))
+		}
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("number") {
+			p.SetNumber(c.int("number"))
+		}
+		if c.isSet("reason") {
+			p.SetReason(c.string("reason"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		return cs.NetworkACL.CreateNetworkACL(p, opts...)
+	}
+	return c
+}
+
+func newCreateNetworkACLListCommand() *command {
+	c := newCommand("createNetworkACLList", "Creates a network ACL. If no VPC is given, then it creates a global ACL that can be used by everyone.", true)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("vpcid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewCreateNetworkACLListParams(c.string("name"), c.string("vpcid"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.NetworkACL.CreateNetworkACLList(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNetworkACLCommand() *command {
+	c := newCommand("deleteNetworkACL", "Deletes a network ACL", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewDeleteNetworkACLParams(c.string("id"))
+		return cs.NetworkACL.DeleteNetworkACL(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNetworkACLListCommand() *command {
+	c := newCommand("deleteNetworkACLList", "Deletes a network ACL", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewDeleteNetworkACLListParams(c.string("id"))
+		return cs.NetworkACL.DeleteNetworkACLList(p, opts...)
+	}
+	return c
+}
+
+func newListNetworkACLListsCommand() *command {
+	c := newCommand("listNetworkACLLists", "Lists all network ACLs", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewListNetworkACLListsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		return cs.NetworkACL.ListNetworkACLLists(p, opts...)
+	}
+	return c
+}
+
+func newListNetworkACLsCommand() *command {
+	c := newCommand("listNetworkACLs", "Lists all network ACL items", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("aclid", &stringValue{}, "", false)
+	c.flag("action", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewListNetworkACLsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("aclid") {
+			p.SetAclid(c.string("aclid"))
+		}
+		if c.isSet("action") {
+			p.SetAction(c.string("action"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		return cs.NetworkACL.ListNetworkACLs(p, opts...)
+	}
+	return c
+}
+
+func newReplaceNetworkACLListCommand() *command {
+	c := newCommand("replaceNetworkACLList", "Replaces ACL associated with a network or private gateway", true)
+	c.flag("aclid", &stringValue{}, "", true)
+	c.flag("gatewayid", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewReplaceNetworkACLListParams(c.string("aclid"))
+		if c.isSet("gatewayid") {
+			p.SetGatewayid(c.string("gatewayid"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		return cs.NetworkACL.ReplaceNetworkACLList(p, opts...)
+	}
+	return c
+}
+
+func newUpdateNetworkACLItemCommand() *command {
+	c := newCommand("updateNetworkACLItem", "Updates ACL item with specified ID", true)
+	c.flag("action", &stringValue{}, "", false)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("number", &intValue{}, "", false)
+	c.flag("partialupgrade", &boolValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("reason", &stringValue{}, "", false)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewUpdateNetworkACLItemParams(c.string("id"))
+		if c.isSet("action") {
+			p.SetAction(c.string("action"))
+		}
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("number") {
+			p.SetNumber(c.int("number"))
+		}
+		if c.isSet("partialupgrade") {
+			p.SetPartialupgrade(c.bool("partialupgrade"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("reason") {
+			p.SetReason(c.string("reason"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		return cs.NetworkACL.UpdateNetworkACLItem(p, opts...)
+	}
+	return c
+}
+
+func newUpdateNetworkACLListCommand() *command {
+	c := newCommand("updateNetworkACLList", "Updates network ACL list", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkACL.NewUpdateNetworkACLListParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.NetworkACL.UpdateNetworkACLList(p, opts...)
+	}
+	return c
+}
+
+func newAddNetworkDeviceCommand() *command {
+	c := newCommand("addNetworkDevice", "Adds a network device of one of the following types: ExternalDhcp, ExternalFirewall, ExternalLoadBalancer, PxeServer", false)
+	c.flag("networkdeviceparameterlist", &mapValue{}, "", false)
+	c.flag("networkdevicetype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkDevice.NewAddNetworkDeviceParams()
+		if c.isSet("networkdeviceparameterlist") {
+			p.SetNetworkdeviceparameterlist(c.stringMap("networkdeviceparameterlist"))
+		}
+		if c.isSet("networkdevicetype") {
+			p.SetNetworkdevicetype(c.string("networkdevicetype"))
+		}
+		return cs.NetworkDevice.AddNetworkDevice(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNetworkDeviceCommand() *command {
+	c := newCommand("deleteNetworkDevice", "Deletes network device.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkDevice.NewDeleteNetworkDeviceParams(c.string("id"))
+		return cs.NetworkDevice.DeleteNetworkDevice(p, opts...)
+	}
+	return c
+}
+
+func newListNetworkDeviceCommand() *command {
+	c := newCommand("listNetworkDevice", "List network devices", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("networkdeviceparameterlist", &mapValue{}, "", false)
+	c.flag("networkdevicetype", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkDevice.NewListNetworkDeviceParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("networkdeviceparameterlist") {
+			p.SetNetworkdeviceparameterlist(c.stringMap("networkdeviceparameterlist"))
+		}
+		if c.isSet("networkdevicetype") {
+			p.SetNetworkdevicetype(c.string("networkdevicetype"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.NetworkDevice.ListNetworkDevice(p, opts...)
+	}
+	return c
+}
+
+func newCreateNetworkOfferingCommand() *command {
+	c := newCommand("createNetworkOffering", "Creates a network offering.", false)
+	c.flag("availability", &stringValue{}, "", false)
+	c.flag("conservemode", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringsValue{}, "", false)
+	c.flag("egressdefaultpolicy", &boolValue{}, "", false)
+	c.flag("enable", &boolValue{}, "", false)
+	c.flag("fortungsten", &boolValue{}, "", false)
+	c.flag("forvpc", &boolValue{}, "", false)
+	c.flag("guestiptype", &stringValue{}, "", true)
+	c.flag("internetprotocol", &stringValue{}, "", false)
+	c.flag("ispersistent", &boolValue{}, "", false)
+	c.flag("keepaliveenabled", &boolValue{}, "", false)
+	c.flag("maxconnections", &intValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkrate", &intValue{}, "", false)
+	c.flag("servicecapabilitylist", &mapValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", false)
+	c.flag("serviceproviderlist", &mapValue{}, "", false)
+	c.flag("specifyipranges", &boolValue{}, "", false)
+	c.flag("specifyvlan", &boolValue{}, "", false)
+	c.flag("supportedservices", &stringsValue{}, "", false)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", true)
+	c.flag("zoneid", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkOffering.NewCreateNetworkOfferingParams(c.string("displaytext"), c.string("guestiptype"), c.string("name"), c.string("traffictype"))
+		if c.isSet("availability") {
+			p.SetAvailability(c.string("availability"))
+		}
+		if c.isSet("conservemode") {
+			p.SetConservemode(c.bool("conservemode"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.strings("domainid"))
+		}
+		if c.isSet("egressdefaultpolicy") {
+			p.SetEgressdefaultpolicy(c.bool("egressdefaultpolicy"))
+		}
+		if c.isSet("enable") {
+			p.SetEnable(c.bool("enable"))
+		}
+		if c.isSet("fortungsten") {
+			p.SetFortungsten(c.bool("fortungsten"))
+		}
+		if c.isSet("forvpc") {
+			p.SetForvpc(c.bool("forvpc"))
+		}
+		if c.isSet("internetprotocol") {
+			p.SetInternetprotocol(c.string("internetprotocol"))
+		}
+		if c.isSet("ispersistent") {
+			p.SetIspersistent(c.bool("ispersistent"))
+		}
+		if c.isSet("keepaliveenabled") {
+			p.SetKeepaliveenabled(c.bool("keepaliveenabled"))
+		}
+		if c.isSet("maxconnections") {
+			p.SetMaxconnections(c.int("maxconnections"))
+		}
+		if c.isSet("networkrate") {
+			p.SetNetworkrate(c.int("networkrate"))
+		}
+		if c.isSet("servicecapabilitylist") {
+			p.SetServicecapabilitylist(c.stringMap("servicecapabilitylist"))
+		}
+		if c.isSet("serviceofferingid") {
+			p.SetServiceofferingid(c.string("serviceofferingid"))
+		}
+		if c.isSet("serviceproviderlist") {
+			p.SetServiceproviderlist(c.stringMap("serviceproviderlist"))
+		}
+		if c.isSet("specifyipranges") {
+			p.SetSpecifyipranges(c.bool("specifyipranges"))
+		}
+		if c.isSet("specifyvlan") {
+			p.SetSpecifyvlan(c.bool("specifyvlan"))
+		}
+		if c.isSet("supportedservices") {
+			p.SetSupportedservices(c.strings("supportedservices"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.strings("zoneid"))
+		}
+		return cs.NetworkOffering.CreateNetworkOffering(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNetworkOfferingCommand() *command {
+	c := newCommand("deleteNetworkOffering", "Deletes a network offering.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkOffering.NewDeleteNetworkOfferingParams(c.string("id"))
+		return cs.NetworkOffering.DeleteNetworkOffering(p, opts...)
+	}
+	return c
+}
+
+func newListNetworkOfferingsCommand() *command {
+	c := newCommand("listNetworkOfferings", "Lists all available network offerings.", false)
+	c.flag("availability", &stringValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("forvpc", &boolValue{}, "", false)
+	c.flag("guestiptype", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isdefault", &boolValue{}, "", false)
+	c.flag("istagged", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("sourcenatsupported", &boolValue{}, "", false)
+	c.flag("specifyipranges", &boolValue{}, "", false)
+	c.flag("specifyvlan", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("supportedservices", &stringsValue{}, "", false)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkOffering.NewListNetworkOfferingsParams()
+		if c.isSet("availability") {
+			p.SetAvailability(c.string("availability"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("forvpc") {
+			p.SetForvpc(c.bool("forvpc"))
+		}
+		if c.isSet("guestiptype") {
+			p.SetGuestiptype(c.string("guestiptype"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isdefault") {
+			p.SetIsdefault(c.bool("isdefault"))
+		}
+		if c.isSet("istagged") {
+			p.SetIstagged(c.bool("istagged"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("sourcenatsupported") {
+			p.SetSourcenatsupported(c.bool("sourcenatsupported"))
+		}
+		if c.isSet("specifyipranges") {
+			p.SetSpecifyipranges(c.bool("specifyipranges"))
+		}
+		if c.isSet("specifyvlan") {
+			p.SetSpecifyvlan(c.bool("specifyvlan"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("supportedservices") {
+			p.SetSupportedservices(c.strings("supportedservices"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.NetworkOffering.ListNetworkOfferings(p, opts...)
+	}
+	return c
+}
+
+func newUpdateNetworkOfferingCommand() *command {
+	c := newCommand("updateNetworkOffering", "Updates a network offering.", false)
+	c.flag("availability", &stringValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keepaliveenabled", &boolValue{}, "", false)
+	c.flag("maxconnections", &intValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("sortkey", &intValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NetworkOffering.NewUpdateNetworkOfferingParams()
+		if c.isSet("availability") {
+			p.SetAvailability(c.string("availability"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keepaliveenabled") {
+			p.SetKeepaliveenabled(c.bool("keepaliveenabled"))
+		}
+		if c.isSet("maxconnections") {
+			p.SetMaxconnections(c.int("maxconnections"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("sortkey") {
+			p.SetSortkey(c.int("sortkey"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.NetworkOffering.UpdateNetworkOffering(p, opts...)
+	}
+	return c
+}
+
+func newAddNetworkServiceProviderCommand() *command {
+	c := newCommand("addNetworkServiceProvider", "Adds a network serviceProvider to a physical network", true)
+	c.flag("destinationphysicalnetworkid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("servicelist", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewAddNetworkServiceProviderParams(c.string("name"), c.string("physicalnetworkid"))
+		if c.isSet("destinationphysicalnetworkid") {
+			p.SetDestinationphysicalnetworkid(c.string("destinationphysicalnetworkid"))
+		}
+		if c.isSet("servicelist") {
+			p.SetServicelist(c.strings("servicelist"))
+		}
+		return cs.Network.AddNetworkServiceProvider(p, opts...)
+	}
+	return c
+}
+
+func newAddOpenDaylightControllerCommand() *command {
+	c := newCommand("addOpenDaylightController", "Adds an OpenDyalight controler", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewAddOpenDaylightControllerParams(c.string("password"), c.string("physicalnetworkid"), c.string("url"), c.string("username"))
+		return cs.Network.AddOpenDaylightController(p, opts...)
+	}
+	return c
+}
+
+func newCreateNetworkCommand() *command {
+	c := newCommand("createNetwork", "Creates a network", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("aclid", &stringValue{}, "", false)
+	c.flag("acltype", &stringValue{}, "", false)
+	c.flag("associatednetworkid", &stringValue{}, "", false)
+	c.flag("bypassvlanoverlapcheck", &boolValue{}, "", false)
+	c.flag("displaynetwork", &boolValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("dns1", &stringValue{}, "", false)
+	c.flag("dns2", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("endip", &stringValue{}, "", false)
+	c.flag("endipv6", &stringValue{}, "", false)
+	c.flag("externalid", &stringValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", false)
+	c.flag("hideipaddressusage", &boolValue{}, "", false)
+	c.flag("ip6cidr", &stringValue{}, "", false)
+	c.flag("ip6dns1", &stringValue{}, "", false)
+	c.flag("ip6dns2", &stringValue{}, "", false)
+	c.flag("ip6gateway", &stringValue{}, "", false)
+	c.flag("isolatedpvlan", &stringValue{}, "", false)
+	c.flag("isolatedpvlantype", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("netmask", &stringValue{}, "", false)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.flag("networkofferingid", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("privatemtu", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("publicmtu", &intValue{}, "", false)
+	c.flag("routerip", &stringValue{}, "", false)
+	c.flag("routeripv6", &stringValue{}, "", false)
+	c.flag("sourcenatipaddress", &stringValue{}, "", false)
+	c.flag("startip", &stringValue{}, "", false)
+	c.flag("startipv6", &stringValue{}, "", false)
+	c.flag("subdomainaccess", &boolValue{}, "", false)
+	c.flag("tungstenvirtualrouteruuid", &stringValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewCreateNetworkParams(c.string("name"), c.string("networkofferingid"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("aclid") {
+			p.SetAclid(c.string("aclid"))
+		}
+		if c.isSet("acltype") {
+			p.SetAcltype(c.string("acltype"))
+		}
+		if c.isSet("associatednetworkid") {
+			p.SetAssociatednetworkid(c.string("associatednetworkid"))
+		}
+		if c.isSet("bypassvlanoverlapcheck") {
+			p.SetBypassvlanoverlapcheck(c.bool("bypassvlanoverlapcheck"))
+		}
+		if c.isSet("displaynetwork") {
+			p.SetDisplaynetwork(c.bool("displaynetwork"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("dns1") {
+			p.SetDns1(c.string("dns1"))
+		}
+		if c.isSet("dns2") {
+			p.SetDns2(c.string("dns2"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("endip") {
+			p.SetEndip(c.string("endip"))
+		}
+		if c.isSet("endipv6") {
+			p.SetEndipv6(c.string("endipv6"))
+		}
+		if c.isSet("externalid") {
+			p.SetExternalid(c.string("externalid"))
+		}
+		if c.isSet("gateway") {
+			p.SetGateway(c.string("gateway"))
+		}
+		if c.isSet("hideipaddressusage") {
+			p.SetHideipaddressusage(c.bool("hideipaddressusage"))
+		}
+		if c.isSet("ip6cidr") {
+			p.SetIp6cidr(c.string("ip6cidr"))
+		}
+		if c.isSet("ip6dns1") {
+			p.SetIp6dns1(c.string("ip6dns1"))
+		}
+		if c.isSet("ip6dns2") {
+			p.SetIp6dns2(c.string("ip6dns2"))
+		}
+		if c.isSet("ip6gateway") {
+			p.SetIp6gateway(c.string("ip6gateway"))
+		}
+		if c.isSet("isolatedpvlan") {
+			p.SetIsolatedpvlan(c.string("isolatedpvlan"))
+		}
+		if c.isSet("isolatedpvlantype") {
+			p.SetIsolatedpvlantype(c.string("isolatedpvlantype"))
+		}
+		if c.isSet("netmask") {
+			p.SetNetmask(c.string("netmask"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("privatemtu") {
+			p.SetPrivatemtu(c.int("privatemtu"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("publicmtu") {
+			p.SetPublicmtu(c.int("publicmtu"))
+		}
+		if c.isSet("routerip") {
+			p.SetRouterip(c.string("routerip"))
+		}
+		if c.isSet("routeripv6") {
+			p.SetRouteripv6(c.string("routeripv6"))
+		}
+		if c.isSet("sourcenatipaddress") {
+			p.SetSourcenatipaddress(c.string("sourcenatipaddress"))
+		}
+		if c.isSet("startip") {
+			p.SetStartip(c.string("startip"))
+		}
+		if c.isSet("startipv6") {
+			p.SetStartipv6(c.string("startipv6"))
+		}
+		if c.isSet("subdomainaccess") {
+			p.SetSubdomainaccess(c.bool("subdomainaccess"))
+		}
+		if c.isSet("tungstenvirtualrouteruuid") {
+			p.SetTungstenvirtualrouteruuid(c.string("tungstenvirtualrouteruuid"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		return cs.Network.CreateNetwork(p, opts...)
+	}
+	return c
+}
+
+func newCreatePhysicalNetworkCommand() *command {
+	c := newCommand("createPhysicalNetwork", "Creates a physical network", true)
+	c.flag("broadcastdomainrange", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("isolationmethods", &stringsValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkspeed", &stringValue{}, "", false)
+	c.flag("tags", &stringsValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewCreatePhysicalNetworkParams(c.string("name"), c.string("zoneid"))
+		if c.isSet("broadcastdomainrange") {
+			p.SetBroadcastdomainrange(c.string("broadcastdomainrange"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("isolationmethods") {
+			p.SetIsolationmethods(c.strings("isolationmethods"))
+		}
+		if c.isSet("networkspeed") {
+			p.SetNetworkspeed(c.string("networkspeed"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.strings("tags"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		return cs.Network.CreatePhysicalNetwork(p, opts...)
+	}
+	return c
+}
+
+func newCreateServiceInstanceCommand() *command {
+	c := newCommand("createServiceInstance", "Creates a system virtual-machine that implements network services", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("leftnetworkid", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("rightnetworkid", &stringValue{}, "", true)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.flag("templateid", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewCreateServiceInstanceParams(c.string("leftnetworkid"), c.string("name"), c.string("rightnetworkid"), c.string("serviceofferingid"), c.string("templateid"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.Network.CreateServiceInstance(p, opts...)
+	}
+	return c
+}
+
+func newCreateStorageNetworkIpRangeCommand() *command {
+	c := newCommand("createStorageNetworkIpRange", "Creates a Storage network IP range.", true)
+	c.flag("endip", &stringValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", true)
+	c.flag("netmask", &stringValue{}, "", true)
+	c.flag("podid", &stringValue{}, "", true)
+	c.flag("startip", &stringValue{}, "", true)
+	c.flag("vlan", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewCreateStorageNetworkIpRangeParams(c.string("gateway"), c.string("netmask"), c.string("podid"), c.string("startip"))
+		if c.isSet("endip") {
+			p.SetEndip(c.string("endip"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.int("vlan"))
+		}
+		return cs.Network.CreateStorageNetworkIpRange(p, opts...)
+	}
+	return c
+}
+
+func newDedicatePublicIpRangeCommand() *command {
+	c := newCommand("dedicatePublicIpRange", "Dedicates a Public IP range to an account", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewDedicatePublicIpRangeParams(c.string("domainid"), c.string("id"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.Network.DedicatePublicIpRange(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNetworkCommand() *command {
+	c := newCommand("deleteNetwork", "Deletes a network", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewDeleteNetworkParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.Network.DeleteNetwork(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNetworkServiceProviderCommand() *command {
+	c := newCommand("deleteNetworkServiceProvider", "Deletes a Network Service Provider.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewDeleteNetworkServiceProviderParams(c.string("id"))
+		return cs.Network.DeleteNetworkServiceProvider(p, opts...)
+	}
+	return c
+}
+
+func newDeleteOpenDaylightControllerCommand() *command {
+	c := newCommand("deleteOpenDaylightController", "Removes an OpenDyalight controler", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewDeleteOpenDaylightControllerParams(c.string("id"))
+		return cs.Network.DeleteOpenDaylightController(p, opts...)
+	}
+	return c
+}
+
+func newDeletePhysicalNetworkCommand() *command {
+	c := newCommand("deletePhysicalNetwork", "Deletes a Physical Network.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewDeletePhysicalNetworkParams(c.string("id"))
+		return cs.Network.DeletePhysicalNetwork(p, opts...)
+	}
+	return c
+}
+
+func newDeleteStorageNetworkIpRangeCommand() *command {
+	c := newCommand("deleteStorageNetworkIpRange", "Deletes a storage network IP Range.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewDeleteStorageNetworkIpRangeParams(c.string("id"))
+		return cs.Network.DeleteStorageNetworkIpRange(p, opts...)
+	}
+	return c
+}
+
+func newListNetscalerLoadBalancerNetworksCommand() *command {
+	c := newCommand("listNetscalerLoadBalancerNetworks", "lists network that are using a netscaler load balancer device", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("lbdeviceid", &stringValue{}, "", true)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListNetscalerLoadBalancerNetworksParams(c.string("lbdeviceid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Network.ListNetscalerLoadBalancerNetworks(p, opts...)
+	}
+	return c
+}
+
+func newListNetworkIsolationMethodsCommand() *command {
+	c := newCommand("listNetworkIsolationMethods", "Lists supported methods of network isolation", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListNetworkIsolationMethodsParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Network.ListNetworkIsolationMethods(p, opts...)
+	}
+	return c
+}
+
+func newListNetworkServiceProvidersCommand() *command {
+	c := newCommand("listNetworkServiceProviders", "Lists network serviceproviders for a given physical network.", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListNetworkServiceProvidersParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		return cs.Network.ListNetworkServiceProviders(p, opts...)
+	}
+	return c
+}
+
+func newListNetworksCommand() *command {
+	c := newCommand("listNetworks", "Lists all available networks.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("acltype", &stringValue{}, "", false)
+	c.flag("associatednetworkid", &stringValue{}, "", false)
+	c.flag("canusefordeploy", &boolValue{}, "", false)
+	c.flag("displaynetwork", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("forvpc", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("issystem", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkfilter", &stringValue{}, "", false)
+	c.flag("networkofferingid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("restartrequired", &boolValue{}, "", false)
+	c.flag("retrieveonlyresourcecount", &boolValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("specifyipranges", &boolValue{}, "", false)
+	c.flag("supportedservices", &stringsValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListNetworksParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("acltype") {
+			p.SetAcltype(c.string("acltype"))
+		}
+		if c.isSet("associatednetworkid") {
+			p.SetAssociatednetworkid(c.string("associatednetworkid"))
+		}
+		if c.isSet("canusefordeploy") {
+			p.SetCanusefordeploy(c.bool("canusefordeploy"))
+		}
+		if c.isSet("displaynetwork") {
+			p.SetDisplaynetwork(c.bool("displaynetwork"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("forvpc") {
+			p.SetForvpc(c.bool("forvpc"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("issystem") {
+			p.SetIssystem(c.bool("issystem"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkfilter") {
+			p.SetNetworkfilter(c.string("networkfilter"))
+		}
+		if c.isSet("networkofferingid") {
+			p.SetNetworkofferingid(c.string("networkofferingid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("restartrequired") {
+			p.SetRestartrequired(c.bool("restartrequired"))
+		}
+		if c.isSet("retrieveonlyresourcecount") {
+			p.SetRetrieveonlyresourcecount(c.bool("retrieveonlyresourcecount"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("specifyipranges") {
+			p.SetSpecifyipranges(c.bool("specifyipranges"))
+		}
+		if c.isSet("supportedservices") {
+			p.SetSupportedservices(c.strings("supportedservices"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Network.ListNetworks(p, opts...)
+	}
+	return c
+}
+
+func newListNiciraNvpDeviceNetworksCommand() *command {
+	c := newCommand("listNiciraNvpDeviceNetworks", "lists network that are using a nicira nvp device", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("nvpdeviceid", &stringValue{}, "", true)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListNiciraNvpDeviceNetworksParams(c.string("nvpdeviceid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Network.ListNiciraNvpDeviceNetworks(p, opts...)
+	}
+	return c
+}
+
+func newListOpenDaylightControllersCommand() *command {
+	c := newCommand("listOpenDaylightControllers", "Lists OpenDyalight controllers", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListOpenDaylightControllersParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		return cs.Network.ListOpenDaylightControllers(p, opts...)
+	}
+	return c
+}
+
+func newListPaloAltoFirewallNetworksCommand() *command {
+	c := newCommand("listPaloAltoFirewallNetworks", "lists network that are using Palo Alto firewall device", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("lbdeviceid", &stringValue{}, "", true)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListPaloAltoFirewallNetworksParams(c.string("lbdeviceid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Network.ListPaloAltoFirewallNetworks(p, opts...)
+	}
+	return c
+}
+
+func newListPhysicalNetworksCommand() *command {
+	c := newCommand("listPhysicalNetworks", "Lists physical networks", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListPhysicalNetworksParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Network.ListPhysicalNetworks(p, opts...)
+	}
+	return c
+}
+
+func newListStorageNetworkIpRangeCommand() *command {
+	c := newCommand("listStorageNetworkIpRange", "List a storage network IP range.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListStorageNetworkIpRangeParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Network.ListStorageNetworkIpRange(p, opts...)
+	}
+	return c
+}
+
+func newListSupportedNetworkServicesCommand() *command {
+	c := newCommand("listSupportedNetworkServices", "Lists all network services provided by CloudStack or for the given Provider.", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", false)
+	c.flag("service", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListSupportedNetworkServicesParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("provider") {
+			p.SetProvider(c.string("provider"))
+		}
+		if c.isSet("service") {
+			p.SetService(c.string("service"))
+		}
+		return cs.Network.ListSupportedNetworkServices(p, opts...)
+	}
+	return c
+}
+
+func newReleasePublicIpRangeCommand() *command {
+	c := newCommand("releasePublicIpRange", "Releases a Public IP range back to the system pool", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewReleasePublicIpRangeParams(c.string("id"))
+		return cs.Network.ReleasePublicIpRange(p, opts...)
+	}
+	return c
+}
+
+func newRestartNetworkCommand() *command {
+	c := newCommand("restartNetwork", "Restarts the network; includes 1) restarting network elements - virtual routers, DHCP servers 2) reapplying all public IPs 3) reapplying loadBalancing/portForwarding rules", true)
+	c.flag("cleanup", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("livepatch", &boolValue{}, "", false)
+	c.flag("makeredundant", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewRestartNetworkParams(c.string("id"))
+		if c.isSet("cleanup") {
+			p.SetCleanup(c.bool("cleanup"))
+		}
+		if c.isSet("livepatch") {
+			p.SetLivepatch(c.bool("livepatch"))
+		}
+		if c.isSet("makeredundant") {
+			p.SetMakeredundant(c.bool("makeredundant"))
+		}
+		return cs.Network.RestartNetwork(p, opts...)
+	}
+	return c
+}
+
+func newUpdateNetworkCommand() *command {
+	c := newCommand("updateNetwork", "Updates a network", true)
+	c.flag("changecidr", &boolValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("displaynetwork", &boolValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("dns1", &stringValue{}, "", false)
+	c.flag("dns2", &stringValue{}, "", false)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("guestvmcidr", &stringValue{}, "", false)
+	c.flag("hideipaddressusage", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("ip6dns1", &stringValue{}, "", false)
+	c.flag("ip6dns2", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.flag("networkofferingid", &stringValue{}, "", false)
+	c.flag("privatemtu", &intValue{}, "", false)
+	c.flag("publicmtu", &intValue{}, "", false)
+	c.flag("sourcenatipaddress", &stringValue{}, "", false)
+	c.flag("updateinsequence", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewUpdateNetworkParams(c.string("id"))
+		if c.isSet("changecidr") {
+			p.SetChangecidr(c.bool("changecidr"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("displaynetwork") {
+			p.SetDisplaynetwork(c.bool("displaynetwork"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("dns1") {
+			p.SetDns1(c.string("dns1"))
+		}
+		if c.isSet("dns2") {
+			p.SetDns2(c.string("dns2"))
+		}
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		if c.isSet("guestvmcidr") {
+			p.SetGuestvmcidr(c.string("guestvmcidr"))
+		}
+		if c.isSet("hideipaddressusage") {
+			p.SetHideipaddressusage(c.bool("hideipaddressusage"))
+		}
+		if c.isSet("ip6dns1") {
+			p.SetIp6dns1(c.string("ip6dns1"))
+		}
+		if c.isSet("ip6dns2") {
+			p.SetIp6dns2(c.string("ip6dns2"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		if c.isSet("networkofferingid") {
+			p.SetNetworkofferingid(c.string("networkofferingid"))
+		}
+		if c.isSet("privatemtu") {
+			p.SetPrivatemtu(c.int("privatemtu"))
+		}
+		if c.isSet("publicmtu") {
+			p.SetPublicmtu(c.int("publicmtu"))
+		}
+		if c.isSet("sourcenatipaddress") {
+			p.SetSourcenatipaddress(c.string("sourcenatipaddress"))
+		}
+		if c.isSet("updateinsequence") {
+			p.SetUpdateinsequence(c.bool("updateinsequence"))
+		}
+		return cs.Network.UpdateNetwork(p, opts...)
+	}
+	return c
+}
+
+func newUpdateNetworkServiceProviderCommand() *command {
+	c := newCommand("updateNetworkServiceProvider", "Updates a network serviceProvider of a physical network", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("servicelist", &stringsValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewUpdateNetworkServiceProviderParams(c.string("id"))
+		if c.isSet("servicelist") {
+			p.SetServicelist(c.strings("servicelist"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		return cs.Network.UpdateNetworkServiceProvider(p, opts...)
+	}
+	return c
+}
+
+func newUpdatePhysicalNetworkCommand() *command {
+	c := newCommand("updatePhysicalNetwork", "Updates a physical network", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("networkspeed", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("tags", &stringsValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewUpdatePhysicalNetworkParams(c.string("id"))
+		if c.isSet("networkspeed") {
+			p.SetNetworkspeed(c.string("networkspeed"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.strings("tags"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		return cs.Network.UpdatePhysicalNetwork(p, opts...)
+	}
+	return c
+}
+
+func newUpdateStorageNetworkIpRangeCommand() *command {
+	c := newCommand("updateStorageNetworkIpRange", "Update a Storage network IP range, only allowed when no IPs in this range have been allocated.", true)
+	c.flag("endip", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("netmask", &stringValue{}, "", false)
+	c.flag("startip", &stringValue{}, "", false)
+	c.flag("vlan", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewUpdateStorageNetworkIpRangeParams(c.string("id"))
+		if c.isSet("endip") {
+			p.SetEndip(c.string("endip"))
+		}
+		if c.isSet("netmask") {
+			p.SetNetmask(c.string("netmask"))
+		}
+		if c.isSet("startip") {
+			p.SetStartip(c.string("startip"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.int("vlan"))
+		}
+		return cs.Network.UpdateStorageNetworkIpRange(p, opts...)
+	}
+	return c
+}
+
+func newDeleteGuestNetworkIpv6PrefixCommand() *command {
+	c := newCommand("deleteGuestNetworkIpv6Prefix", "Deletes an existing guest network IPv6 prefix.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewDeleteGuestNetworkIpv6PrefixParams(c.string("id"))
+		return cs.Network.DeleteGuestNetworkIpv6Prefix(p, opts...)
+	}
+	return c
+}
+
+func newCreateGuestNetworkIpv6PrefixCommand() *command {
+	c := newCommand("createGuestNetworkIpv6Prefix", "Creates a guest network IPv6 prefix.", true)
+	c.flag("prefix", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewCreateGuestNetworkIpv6PrefixParams(c.string("prefix"), c.string("zoneid"))
+		return cs.Network.CreateGuestNetworkIpv6Prefix(p, opts...)
+	}
+	return c
+}
+
+func newListGuestNetworkIpv6PrefixesCommand() *command {
+	c := newCommand("listGuestNetworkIpv6Prefixes", "Lists guest network IPv6 prefixes", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListGuestNetworkIpv6PrefixesParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Network.ListGuestNetworkIpv6Prefixes(p, opts...)
+	}
+	return c
+}
+
+func newCreateNetworkPermissionsCommand() *command {
+	c := newCommand("createNetworkPermissions", "Updates network permissions.", false)
+	c.flag("accountids", &stringsValue{}, "", false)
+	c.flag("accounts", &stringsValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.flag("projectids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewCreateNetworkPermissionsParams(c.string("networkid"))
+		if c.isSet("accountids") {
+			p.SetAccountids(c.strings("accountids"))
+		}
+		if c.isSet("accounts") {
+			p.SetAccounts(c.strings("accounts"))
+		}
+		if c.isSet("projectids") {
+			p.SetProjectids(c.strings("projectids"))
+		}
+		return cs.Network.CreateNetworkPermissions(p, opts...)
+	}
+	return c
+}
+
+func newResetNetworkPermissionsCommand() *command {
+	c := newCommand("resetNetworkPermissions", "Resets network permissions.", false)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewResetNetworkPermissionsParams(c.string("networkid"))
+		return cs.Network.ResetNetworkPermissions(p, opts...)
+	}
+	return c
+}
+
+func newListNetworkPermissionsCommand() *command {
+	c := newCommand("listNetworkPermissions", "List network visibility and all accounts that have permissions to view this network.", false)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewListNetworkPermissionsParams(c.string("networkid"))
+		return cs.Network.ListNetworkPermissions(p, opts...)
+	}
+	return c
+}
+
+func newRemoveNetworkPermissionsCommand() *command {
+	c := newCommand("removeNetworkPermissions", "Removes network permissions.", false)
+	c.flag("accountids", &stringsValue{}, "", false)
+	c.flag("accounts", &stringsValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.flag("projectids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Network.NewRemoveNetworkPermissionsParams(c.string("networkid"))
+		if c.isSet("accountids") {
+			p.SetAccountids(c.strings("accountids"))
+		}
+		if c.isSet("accounts") {
+			p.SetAccounts(c.strings("accounts"))
+		}
+		if c.isSet("projectids") {
+			p.SetProjectids(c.strings("projectids"))
+		}
+		return cs.Network.RemoveNetworkPermissions(p, opts...)
+	}
+	return c
+}
+
+func newAddIpToNicCommand() *command {
+	c := newCommand("addIpToNic", "Assigns secondary IP to NIC", true)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("nicid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Nic.NewAddIpToNicParams(c.string("nicid"))
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		return cs.Nic.AddIpToNic(p, opts...)
+	}
+	return c
+}
+
+func newListNicsCommand() *command {
+	c := newCommand("listNics", "list the vm nics IP to NIC", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("nicid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Nic.NewListNicsParams(c.string("virtualmachineid"))
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("nicid") {
+			p.SetNicid(c.string("nicid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Nic.ListNics(p, opts...)
+	}
+	return c
+}
+
+func newRemoveIpFromNicCommand() *command {
+	c := newCommand("removeIpFromNic", "Removes secondary IP from the NIC.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Nic.NewRemoveIpFromNicParams(c.string("id"))
+		return cs.Nic.RemoveIpFromNic(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVmNicIpCommand() *command {
+	c := newCommand("updateVmNicIp", "Update the default Ip of a VM Nic", true)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("nicid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Nic.NewUpdateVmNicIpParams(c.string("nicid"))
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		return cs.Nic.UpdateVmNicIp(p, opts...)
+	}
+	return c
+}
+
+func newAddNiciraNvpDeviceCommand() *command {
+	c := newCommand("addNiciraNvpDevice", "Adds a Nicira NVP device", true)
+	c.flag("hostname", &stringValue{}, "", true)
+	c.flag("l2gatewayserviceuuid", &stringValue{}, "", false)
+	c.flag("l3gatewayserviceuuid", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("transportzoneuuid", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NiciraNVP.NewAddNiciraNvpDeviceParams(c.string("hostname"), c.string("password"), c.string("physicalnetworkid"), c.string("transportzoneuuid"), c.string("username"))
+		if c.isSet("l2gatewayserviceuuid") {
+			p.SetL2gatewayserviceuuid(c.string("l2gatewayserviceuuid"))
+		}
+		if c.isSet("l3gatewayserviceuuid") {
+			p.SetL3gatewayserviceuuid(c.string("l3gatewayserviceuuid"))
+		}
+		return cs.NiciraNVP.AddNiciraNvpDevice(p, opts...)
+	}
+	return c
+}
+
+func newDeleteNiciraNvpDeviceCommand() *command {
+	c := newCommand("deleteNiciraNvpDevice", "delete a nicira nvp device", true)
+	c.flag("nvpdeviceid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NiciraNVP.NewDeleteNiciraNvpDeviceParams(c.string("nvpdeviceid"))
+		return cs.NiciraNVP.DeleteNiciraNvpDevice(p, opts...)
+	}
+	return c
+}
+
+func newListNiciraNvpDevicesCommand() *command {
+	c := newCommand("listNiciraNvpDevices", "Lists Nicira NVP devices", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("nvpdeviceid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.NiciraNVP.NewListNiciraNvpDevicesParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("nvpdeviceid") {
+			p.SetNvpdeviceid(c.string("nvpdeviceid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		return cs.NiciraNVP.ListNiciraNvpDevices(p, opts...)
+	}
+	return c
+}
+
+func newAddObjectStoragePoolCommand() *command {
+	c := newCommand("addObjectStoragePool", "Adds a object storage pool", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("provider", &stringValue{}, "", true)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewAddObjectStoragePoolParams(c.string("name"), c.string("provider"), c.string("url"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		return cs.ObjectStore.AddObjectStoragePool(p, opts...)
+	}
+	return c
+}
+
+func newCreateBucketCommand() *command {
+	c := newCommand("createBucket", "Creates a bucket in the specified object storage pool.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("encryption", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("objectlocking", &boolValue{}, "", false)
+	c.flag("objectstorageid", &stringValue{}, "", true)
+	c.flag("policy", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("quota", &intValue{}, "", false)
+	c.flag("versioning", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewCreateBucketParams(c.string("name"), c.string("objectstorageid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("encryption") {
+			p.SetEncryption(c.bool("encryption"))
+		}
+		if c.isSet("objectlocking") {
+			p.SetObjectlocking(c.bool("objectlocking"))
+		}
+		if c.isSet("policy") {
+			p.SetPolicy(c.string("policy"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("quota") {
+			p.SetQuota(c.int("quota"))
+		}
+		if c.isSet("versioning") {
+			p.SetVersioning(c.bool("versioning"))
+		}
+		return cs.ObjectStore.CreateBucket(p, opts...)
+	}
+	return c
+}
+
+func newDeleteBucketCommand() *command {
+	c := newCommand("deleteBucket", "Deletes an empty Bucket.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewDeleteBucketParams(c.string("id"))
+		return cs.ObjectStore.DeleteBucket(p, opts...)
+	}
+	return c
+}
+
+func newDeleteObjectStoragePoolCommand() *command {
+	c := newCommand("deleteObjectStoragePool", "Deletes an Object Storage Pool", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewDeleteObjectStoragePoolParams(c.string("id"))
+		return cs.ObjectStore.DeleteObjectStoragePool(p, opts...)
+	}
+	return c
+}
+
+func newListBucketsCommand() *command {
+	c := newCommand("listBuckets", "Lists all Buckets.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("objectstorageid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewListBucketsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("objectstorageid") {
+			p.SetObjectstorageid(c.string("objectstorageid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.ObjectStore.ListBuckets(p, opts...)
+	}
+	return c
+}
+
+func newListObjectStoragePoolsCommand() *command {
+	c := newCommand("listObjectStoragePools", "Lists object storage pools.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewListObjectStoragePoolsParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("provider") {
+			p.SetProvider(c.string("provider"))
+		}
+		return cs.ObjectStore.ListObjectStoragePools(p, opts...)
+	}
+	return c
+}
+
+func newUpdateBucketCommand() *command {
+	c := newCommand("updateBucket", "Updates Bucket properties", false)
+	c.flag("encryption", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("policy", &stringValue{}, "", false)
+	c.flag("quota", &intValue{}, "", false)
+	c.flag("versioning", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewUpdateBucketParams(c.string("id"))
+		if c.isSet("encryption") {
+			p.SetEncryption(c.bool("encryption"))
+		}
+		if c.isSet("policy") {
+			p.SetPolicy(c.string("policy"))
+		}
+		if c.isSet("quota") {
+			p.SetQuota(c.int("quota"))
+		}
+		if c.isSet("versioning") {
+			p.SetVersioning(c.bool("versioning"))
+		}
+		return cs.ObjectStore.UpdateBucket(p, opts...)
+	}
+	return c
+}
+
+func newUpdateObjectStoragePoolCommand() *command {
+	c := newCommand("updateObjectStoragePool", "Updates object storage pool", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ObjectStore.NewUpdateObjectStoragePoolParams(c.string("id"))
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		return cs.ObjectStore.UpdateObjectStoragePool(p, opts...)
+	}
+	return c
+}
+
+func newChangeOutOfBandManagementPasswordCommand() *command {
+	c := newCommand("changeOutOfBandManagementPassword", "Changes out-of-band management interface password on the host and updates the interface configuration in CloudStack if the operation succeeds, else reverts the old password", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.OutofbandManagement.NewChangeOutOfBandManagementPasswordParams(c.string("hostid"))
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		return cs.OutofbandManagement.ChangeOutOfBandManagementPassword(p, opts...)
+	}
+	return c
+}
+
+func newConfigureOutOfBandManagementCommand() *command {
+	c := newCommand("configureOutOfBandManagement", "Configures a host's out-of-band management interface", false)
+	c.flag("address", &stringValue{}, "", true)
+	c.flag("driver", &stringValue{}, "", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("port", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.OutofbandManagement.NewConfigureOutOfBandManagementParams(c.string("address"), c.string("driver"), c.string("hostid"), c.string("password"), c.string("port"), c.string("username"))
+		return cs.OutofbandManagement.ConfigureOutOfBandManagement(p, opts...)
+	}
+	return c
+}
+
+func newIssueOutOfBandManagementPowerActionCommand() *command {
+	c := newCommand("issueOutOfBandManagementPowerAction", "Initiates the specified power action to the host's out-of-band management interface", true)
+	c.flag("action", &stringValue{}, "", true)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.flag("timeout", &int64Value{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.OutofbandManagement.NewIssueOutOfBandManagementPowerActionParams(c.string("action"), c.string("hostid"))
+		if c.isSet("timeout") {
+			p.SetTimeout(c.int64("timeout"))
+		}
+		return cs.OutofbandManagement.IssueOutOfBandManagementPowerAction(p, opts...)
+	}
+	return c
+}
+
+func newConfigureOvsElementCommand() *command {
+	c := newCommand("configureOvsElement", "Configures an ovs element.", true)
+	c.flag("enabled", &boolValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.OvsElement.NewConfigureOvsElementParams(c.bool("enabled"), c.string("id"))
+		return cs.OvsElement.ConfigureOvsElement(p, opts...)
+	}
+	return c
+}
+
+func newListOvsElementsCommand() *command {
+	c := newCommand("listOvsElements", "Lists all available ovs elements.", false)
+	c.flag("enabled", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("nspid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.OvsElement.NewListOvsElementsParams()
+		if c.isSet("enabled") {
+			p.SetEnabled(c.bool("enabled"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("nspid") {
+			p.SetNspid(c.string("nspid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.OvsElement.ListOvsElements(p, opts...)
+	}
+	return c
+}
+
+func newCreatePodCommand() *command {
+	c := newCommand("createPod", "Creates a new Pod.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("endip", &stringValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("netmask", &stringValue{}, "", false)
+	c.flag("startip", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pod.NewCreatePodParams(c.string("name"), c.string("zoneid"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("endip") {
+			p.SetEndip(c.string("endip"))
+		}
+		if c.isSet("gateway") {
+			p.SetGateway(c.string("gateway"))
+		}
+		if c.isSet("netmask") {
+			p.SetNetmask(c.string("netmask"))
+		}
+		if c.isSet("startip") {
+			p.SetStartip(c.string("startip"))
+		}
+		return cs.Pod.CreatePod(p, opts...)
+	}
+	return c
+}
+
+func newDedicatePodCommand() *command {
+	c := newCommand("dedicatePod", "Dedicates a Pod.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("podid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pod.NewDedicatePodParams(c.string("domainid"), c.string("podid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		return cs.Pod.DedicatePod(p, opts...)
+	}
+	return c
+}
+
+func newDeletePodCommand() *command {
+	c := newCommand("deletePod", "Deletes a Pod.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pod.NewDeletePodParams(c.string("id"))
+		return cs.Pod.DeletePod(p, opts...)
+	}
+	return c
+}
+
+func newListDedicatedPodsCommand() *command {
+	c := newCommand("listDedicatedPods", "Lists dedicated pods.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("affinitygroupid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pod.NewListDedicatedPodsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("affinitygroupid") {
+			p.SetAffinitygroupid(c.string("affinitygroupid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		return cs.Pod.ListDedicatedPods(p, opts...)
+	}
+	return c
+}
+
+func newListPodsCommand() *command {
+	c := newCommand("listPods", "Lists all Pods.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showcapacities", &boolValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pod.NewListPodsParams()
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showcapacities") {
+			p.SetShowcapacities(c.bool("showcapacities"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Pod.ListPods(p, opts...)
+	}
+	return c
+}
+
+func newReleaseDedicatedPodCommand() *command {
+	c := newCommand("releaseDedicatedPod", "Release the dedication for the pod", true)
+	c.flag("podid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pod.NewReleaseDedicatedPodParams(c.string("podid"))
+		return cs.Pod.ReleaseDedicatedPod(p, opts...)
+	}
+	return c
+}
+
+func newUpdatePodCommand() *command {
+	c := newCommand("updatePod", "Updates a Pod.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("endip", &stringValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("netmask", &stringValue{}, "", false)
+	c.flag("startip", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pod.NewUpdatePodParams(c.string("id"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("endip") {
+			p.SetEndip(c.string("endip"))
+		}
+		if c.isSet("gateway") {
+			p.SetGateway(c.string("gateway"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("netmask") {
+			p.SetNetmask(c.string("netmask"))
+		}
+		if c.isSet("startip") {
+			p.SetStartip(c.string("startip"))
+		}
+		return cs.Pod.UpdatePod(p, opts...)
+	}
+	return c
+}
+
+func newCreateStoragePoolCommand() *command {
+	c := newCommand("createStoragePool", "Creates a storage pool.", false)
+	c.flag("capacitybytes", &int64Value{}, "", false)
+	c.flag("capacityiops", &int64Value{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("istagarule", &boolValue{}, "", false)
+	c.flag("managed", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("provider", &stringValue{}, "", false)
+	c.flag("scope", &stringValue{}, "", false)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pool.NewCreateStoragePoolParams(c.string("name"), c.string("url"), c.string("zoneid"))
+		if c.isSet("capacitybytes") {
+			p.SetCapacitybytes(c.int64("capacitybytes"))
+		}
+		if c.isSet("capacityiops") {
+			p.SetCapacityiops(c.int64("capacityiops"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("istagarule") {
+			p.SetIstagarule(c.bool("istagarule"))
+		}
+		if c.isSet("managed") {
+			p.SetManaged(c.bool("managed"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("provider") {
+			p.SetProvider(c.string("provider"))
+		}
+		if c.isSet("scope") {
+			p.SetScope(c.string("scope"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		return cs.Pool.CreateStoragePool(p, opts...)
+	}
+	return c
+}
+
+func newDeleteStoragePoolCommand() *command {
+	c := newCommand("deleteStoragePool", "Deletes a storage pool.", false)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pool.NewDeleteStoragePoolParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.Pool.DeleteStoragePool(p, opts...)
+	}
+	return c
+}
+
+func newFindStoragePoolsForMigrationCommand() *command {
+	c := newCommand("findStoragePoolsForMigration", "Lists storage pools available for migration of a volume.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pool.NewFindStoragePoolsForMigrationParams(c.string("id"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Pool.FindStoragePoolsForMigration(p, opts...)
+	}
+	return c
+}
+
+func newListStoragePoolsCommand() *command {
+	c := newCommand("listStoragePools", "Lists storage pools.", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("path", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("scope", &stringValue{}, "", false)
+	c.flag("status", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pool.NewListStoragePoolsParams()
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("path") {
+			p.SetPath(c.string("path"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("scope") {
+			p.SetScope(c.string("scope"))
+		}
+		if c.isSet("status") {
+			p.SetStatus(c.string("status"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Pool.ListStoragePools(p, opts...)
+	}
+	return c
+}
+
+func newSyncStoragePoolCommand() *command {
+	c := newCommand("syncStoragePool", "Sync storage pool with management server (currently supported for Datastore Cluster in VMware and syncs the datastores in it)", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pool.NewSyncStoragePoolParams(c.string("id"))
+		return cs.Pool.SyncStoragePool(p, opts...)
+	}
+	return c
+}
+
+func newUpdateStoragePoolCommand() *command {
+	c := newCommand("updateStoragePool", "Updates a storage pool.", false)
+	c.flag("capacitybytes", &int64Value{}, "", false)
+	c.flag("capacityiops", &int64Value{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("enabled", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("istagarule", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("tags", &stringsValue{}, "", false)
+	c.flag("url", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Pool.NewUpdateStoragePoolParams(c.string("id"))
+		if c.isSet("capacitybytes") {
+			p.SetCapacitybytes(c.int64("capacitybytes"))
+		}
+		if c.isSet("capacityiops") {
+			p.SetCapacityiops(c.int64("capacityiops"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("enabled") {
+			p.SetEnabled(c.bool("enabled"))
+		}
+		if c.isSet("istagarule") {
+			p.SetIstagarule(c.bool("istagarule"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.strings("tags"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		return cs.Pool.UpdateStoragePool(p, opts...)
+	}
+	return c
+}
+
+func newCreatePortableIpRangeCommand() *command {
+	c := newCommand("createPortableIpRange", "adds a range of portable public IP's to a region", true)
+	c.flag("endip", &stringValue{}, "", true)
+	c.flag("gateway", &stringValue{}, "", true)
+	c.flag("netmask", &stringValue{}, "", true)
+	c.flag("regionid", &intValue{}, "", true)
+	c.flag("startip", &stringValue{}, "", true)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.PortableIP.NewCreatePortableIpRangeParams(c.string("endip"), c.string("gateway"), c.string("netmask"), c.int("regionid"), c.string("startip"))
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		return cs.PortableIP.CreatePortableIpRange(p, opts...)
+	}
+	return c
+}
+
+func newDeletePortableIpRangeCommand() *command {
+	c := newCommand("deletePortableIpRange", "deletes a range of portable public IP's associated with a region", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.PortableIP.NewDeletePortableIpRangeParams(c.string("id"))
+		return cs.PortableIP.DeletePortableIpRange(p, opts...)
+	}
+	return c
+}
+
+func newListPortableIpRangesCommand() *command {
+	c := newCommand("listPortableIpRanges", "list portable IP ranges", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("regionid", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.PortableIP.NewListPortableIpRangesParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("regionid") {
+			p.SetRegionid(c.int("regionid"))
+		}
+		return cs.PortableIP.ListPortableIpRanges(p, opts...)
+	}
+	return c
+}
+
+func newActivateProjectCommand() *command {
+	c := newCommand("activateProject", "Activates a project", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewActivateProjectParams(c.string("id"))
+		return cs.Project.ActivateProject(p, opts...)
+	}
+	return c
+}
+
+func newAddAccountToProjectCommand() *command {
+	c := newCommand("addAccountToProject", "Adds account to a project", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("email", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("projectroleid", &stringValue{}, "", false)
+	c.flag("roletype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewAddAccountToProjectParams(c.string("projectid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("email") {
+			p.SetEmail(c.string("email"))
+		}
+		if c.isSet("projectroleid") {
+			p.SetProjectroleid(c.string("projectroleid"))
+		}
+		if c.isSet("roletype") {
+			p.SetRoletype(c.string("roletype"))
+		}
+		return cs.Project.AddAccountToProject(p, opts...)
+	}
+	return c
+}
+
+func newAddUserToProjectCommand() *command {
+	c := newCommand("addUserToProject", "Adds user to a project", true)
+	c.flag("email", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("projectroleid", &stringValue{}, "", false)
+	c.flag("roletype", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewAddUserToProjectParams(c.string("projectid"), c.string("username"))
+		if c.isSet("email") {
+			p.SetEmail(c.string("email"))
+		}
+		if c.isSet("projectroleid") {
+			p.SetProjectroleid(c.string("projectroleid"))
+		}
+		if c.isSet("roletype") {
+			p.SetRoletype(c.string("roletype"))
+		}
+		return cs.Project.AddUserToProject(p, opts...)
+	}
+	return c
+}
+
+func newCreateProjectCommand() *command {
+	c := newCommand("createProject", "Creates a project", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("userid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewCreateProjectParams(c.string("displaytext"), c.string("name"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.Project.CreateProject(p, opts...)
+	}
+	return c
+}
+
+func newDeleteAccountFromProjectCommand() *command {
+	c := newCommand("deleteAccountFromProject", "Deletes account from the project", true)
+	c.flag("account", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewDeleteAccountFromProjectParams(c.string("account"), c.string("projectid"))
+		return cs.Project.DeleteAccountFromProject(p, opts...)
+	}
+	return c
+}
+
+func newDeleteUserFromProjectCommand() *command {
+	c := newCommand("deleteUserFromProject", "Deletes user from the project", true)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("userid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewDeleteUserFromProjectParams(c.string("projectid"), c.string("userid"))
+		return cs.Project.DeleteUserFromProject(p, opts...)
+	}
+	return c
+}
+
+func newDeleteProjectCommand() *command {
+	c := newCommand("deleteProject", "Deletes a project", true)
+	c.flag("cleanup", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewDeleteProjectParams(c.string("id"))
+		if c.isSet("cleanup") {
+			p.SetCleanup(c.bool("cleanup"))
+		}
+		return cs.Project.DeleteProject(p, opts...)
+	}
+	return c
+}
+
+func newDeleteProjectInvitationCommand() *command {
+	c := newCommand("deleteProjectInvitation", "Deletes project invitation", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewDeleteProjectInvitationParams(c.string("id"))
+		return cs.Project.DeleteProjectInvitation(p, opts...)
+	}
+	return c
+}
+
+func newListProjectInvitationsCommand() *command {
+	c := newCommand("listProjectInvitations", "Lists project invitations and provides detailed information for listed invitations", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("activeonly", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewListProjectInvitationsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("activeonly") {
+			p.SetActiveonly(c.bool("activeonly"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.Project.ListProjectInvitations(p, opts...)
+	}
+	return c
+}
+
+func newListProjectsCommand() *command {
+	c := newCommand("listProjects", "Lists projects and provides detailed information for listed projects", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("username", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewListProjectsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		return cs.Project.ListProjects(p, opts...)
+	}
+	return c
+}
+
+func newSuspendProjectCommand() *command {
+	c := newCommand("suspendProject", "Suspends a project", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewSuspendProjectParams(c.string("id"))
+		return cs.Project.SuspendProject(p, opts...)
+	}
+	return c
+}
+
+func newUpdateProjectCommand() *command {
+	c := newCommand("updateProject", "Updates a project", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("roletype", &stringValue{}, "", false)
+	c.flag("swapowner", &boolValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewUpdateProjectParams(c.string("id"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("roletype") {
+			p.SetRoletype(c.string("roletype"))
+		}
+		if c.isSet("swapowner") {
+			p.SetSwapowner(c.bool("swapowner"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.Project.UpdateProject(p, opts...)
+	}
+	return c
+}
+
+func newUpdateProjectInvitationCommand() *command {
+	c := newCommand("updateProjectInvitation", "Accepts or declines project invitation", true)
+	c.flag("accept", &boolValue{}, "", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("token", &stringValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewUpdateProjectInvitationParams(c.string("projectid"))
+		if c.isSet("accept") {
+			p.SetAccept(c.bool("accept"))
+		}
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("token") {
+			p.SetToken(c.string("token"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.Project.UpdateProjectInvitation(p, opts...)
+	}
+	return c
+}
+
+func newListProjectRolePermissionsCommand() *command {
+	c := newCommand("listProjectRolePermissions", "Lists a project's project role permissions", false)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("projectroleid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewListProjectRolePermissionsParams(c.string("projectid"))
+		if c.isSet("projectroleid") {
+			p.SetProjectroleid(c.string("projectroleid"))
+		}
+		return cs.Project.ListProjectRolePermissions(p, opts...)
+	}
+	return c
+}
+
+func newCreateProjectRolePermissionCommand() *command {
+	c := newCommand("createProjectRolePermission", "Adds API permissions to a project role", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("permission", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("projectroleid", &stringValue{}, "", true)
+	c.flag("rule", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewCreateProjectRolePermissionParams(c.string("permission"), c.string("projectid"), c.string("projectroleid"), c.string("rule"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		return cs.Project.CreateProjectRolePermission(p, opts...)
+	}
+	return c
+}
+
+func newUpdateProjectRolePermissionCommand() *command {
+	c := newCommand("updateProjectRolePermission", "Updates a project role permission and/or order", false)
+	c.flag("permission", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.flag("projectroleid", &stringValue{}, "", true)
+	c.flag("projectrolepermissionid", &stringValue{}, "", false)
+	c.flag("ruleorder", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewUpdateProjectRolePermissionParams(c.string("projectid"), c.string("projectroleid"))
+		if c.isSet("permission") {
+			p.SetPermission(c.string("permission"))
+		}
+		if c.isSet("projectrolepermissionid") {
+			p.SetProjectrolepermissionid(c.string("projectrolepermissionid"))
+		}
+		if c.isSet("ruleorder") {
+			p.SetRuleorder(c.strings("ruleorder"))
+		}
+		return cs.Project.UpdateProjectRolePermission(p, opts...)
+	}
+	return c
+}
+
+func newDeleteProjectRolePermissionCommand() *command {
+	c := newCommand("deleteProjectRolePermission", "Deletes a project role permission in the project", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Project.NewDeleteProjectRolePermissionParams(c.string("id"), c.string("projectid"))
+		return cs.Project.DeleteProjectRolePermission(p, opts...)
+	}
+	return c
+}
+
+func newQuotaBalanceCommand() *command {
+	c := newCommand("quotaBalance", "Create a quota balance statement", false)
+	c.flag("account", &stringValue{}, "", true)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaBalanceParams(c.string("account"), c.string("domainid"))
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		return cs.Quota.QuotaBalance(p, opts...)
+	}
+	return c
+}
+
+func newQuotaCreditsCommand() *command {
+	c := newCommand("quotaCredits", "Add +-credits to an account", false)
+	c.flag("account", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("min_balance", &float64Value{}, "", false)
+	c.flag("quota_enforce", &boolValue{}, "", false)
+	c.flag("value", &float64Value{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaCreditsParams(c.string("account"), c.string("domainid"), c.float64("value"))
+		if c.isSet("min_balance") {
+			p.SetMin_balance(c.float64("min_balance"))
+		}
+		if c.isSet("quota_enforce") {
+			p.SetQuota_enforce(c.bool("quota_enforce"))
+		}
+		return cs.Quota.QuotaCredits(p, opts...)
+	}
+	return c
+}
+
+func newQuotaEmailTemplateListCommand() *command {
+	c := newCommand("quotaEmailTemplateList", "Lists all quota email templates", false)
+	c.flag("templatetype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaEmailTemplateListParams()
+		if c.isSet("templatetype") {
+			p.SetTemplatetype(c.string("templatetype"))
+		}
+		return cs.Quota.QuotaEmailTemplateList(p, opts...)
+	}
+	return c
+}
+
+func newQuotaEmailTemplateUpdateCommand() *command {
+	c := newCommand("quotaEmailTemplateUpdate", "Updates existing email templates for quota alerts", false)
+	c.flag("locale", &stringValue{}, "", false)
+	c.flag("templatebody", &stringValue{}, "", true)
+	c.flag("templatesubject", &stringValue{}, "", true)
+	c.flag("templatetype", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaEmailTemplateUpdateParams(c.string("templatebody"), c.string("templatesubject"), c.string("templatetype"))
+		if c.isSet("locale") {
+			p.SetLocale(c.string("locale"))
+		}
+		return cs.Quota.QuotaEmailTemplateUpdate(p, opts...)
+	}
+	return c
+}
+
+func newQuotaIsEnabledCommand() *command {
+	c := newCommand("quotaIsEnabled", "Return true if the plugin is enabled", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaIsEnabledParams()
+		return cs.Quota.QuotaIsEnabled(p, opts...)
+	}
+	return c
+}
+
+func newQuotaStatementCommand() *command {
+	c := newCommand("quotaStatement", "Create a quota statement", false)
+	c.flag("account", &stringValue{}, "", true)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("enddate", &stringValue{}, "", true)
+	c.flag("startdate", &stringValue{}, "", true)
+	c.flag("type", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaStatementParams(c.string("account"), c.string("domainid"), c.string("enddate"), c.string("startdate"))
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.int("type"))
+		}
+		return cs.Quota.QuotaStatement(p, opts...)
+	}
+	return c
+}
+
+func newQuotaSummaryCommand() *command {
+	c := newCommand("quotaSummary", "Lists balance and quota usage for all accounts", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaSummaryParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Quota.QuotaSummary(p, opts...)
+	}
+	return c
+}
+
+func newQuotaTariffCreateCommand() *command {
+	c := newCommand("quotaTariffCreate", "Creates a quota tariff for a resource.", false)
+	c.flag("activationrule", &stringValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("usagetype", &intValue{}, "", true)
+	c.flag("value", &float64Value{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaTariffCreateParams(c.string("name"), c.int("usagetype"), c.float64("value"))
+		if c.isSet("activationrule") {
+			p.SetActivationrule(c.string("activationrule"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		return cs.Quota.QuotaTariffCreate(p, opts...)
+	}
+	return c
+}
+
+func newQuotaTariffDeleteCommand() *command {
+	c := newCommand("quotaTariffDelete", "Marks a quota tariff as removed.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaTariffDeleteParams(c.string("id"))
+		return cs.Quota.QuotaTariffDelete(p, opts...)
+	}
+	return c
+}
+
+func newQuotaTariffListCommand() *command {
+	c := newCommand("quotaTariffList", "Lists all quota tariff plans", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("listonlyremoved", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("usagetype", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaTariffListParams()
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("listonlyremoved") {
+			p.SetListonlyremoved(c.bool("listonlyremoved"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		if c.isSet("usagetype") {
+			p.SetUsagetype(c.int("usagetype"))
+		}
+		return cs.Quota.QuotaTariffList(p, opts...)
+	}
+	return c
+}
+
+func newQuotaTariffUpdateCommand() *command {
+	c := newCommand("quotaTariffUpdate", "Update the tariff plan for a resource", false)
+	c.flag("activationrule", &stringValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.flag("usagetype", &intValue{}, "", false)
+	c.flag("value", &float64Value{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaTariffUpdateParams(c.string("name"))
+		if c.isSet("activationrule") {
+			p.SetActivationrule(c.string("activationrule"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		if c.isSet("usagetype") {
+			p.SetUsagetype(c.int("usagetype"))
+		}
+		if c.isSet("value") {
+			p.SetValue(c.float64("value"))
+		}
+		return cs.Quota.QuotaTariffUpdate(p, opts...)
+	}
+	return c
+}
+
+func newQuotaUpdateCommand() *command {
+	c := newCommand("quotaUpdate", "Update quota calculations, alerts and statements", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Quota.NewQuotaUpdateParams()
+		return cs.Quota.QuotaUpdate(p, opts...)
+	}
+	return c
+}
+
+func newAddRegionCommand() *command {
+	c := newCommand("addRegion", "Adds a Region", false)
+	c.flag("endpoint", &stringValue{}, "", true)
+	c.flag("id", &intValue{}, "", true)
+	c.flag("name", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Region.NewAddRegionParams(c.string("endpoint"), c.int("id"), c.string("name"))
+		return cs.Region.AddRegion(p, opts...)
+	}
+	return c
+}
+
+func newListRegionsCommand() *command {
+	c := newCommand("listRegions", "Lists Regions", false)
+	c.flag("id", &intValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Region.NewListRegionsParams()
+		if c.isSet("id") {
+			p.SetId(c.int("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Region.ListRegions(p, opts...)
+	}
+	return c
+}
+
+func newRemoveRegionCommand() *command {
+	c := newCommand("removeRegion", "Removes specified region", false)
+	c.flag("id", &intValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Region.NewRemoveRegionParams(c.int("id"))
+		return cs.Region.RemoveRegion(p, opts...)
+	}
+	return c
+}
+
+func newUpdateRegionCommand() *command {
+	c := newCommand("updateRegion", "Updates a region", false)
+	c.flag("endpoint", &stringValue{}, "", false)
+	c.flag("id", &intValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Region.NewUpdateRegionParams(c.int("id"))
+		if c.isSet("endpoint") {
+			p.SetEndpoint(c.string("endpoint"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.Region.UpdateRegion(p, opts...)
+	}
+	return c
+}
+
+func newAddResourceDetailCommand() *command {
+	c := newCommand("addResourceDetail", "Adds detail for the Resource.", true)
+	c.flag("details", &mapValue{}, "", true)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("resourceid", &stringValue{}, "", true)
+	c.flag("resourcetype", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcemetadata.NewAddResourceDetailParams(c.stringMap("details"), c.string("resourceid"), c.string("resourcetype"))
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.Resourcemetadata.AddResourceDetail(p, opts...)
+	}
+	return c
+}
+
+func newGetVolumeSnapshotDetailsCommand() *command {
+	c := newCommand("getVolumeSnapshotDetails", "Get Volume Snapshot Details", false)
+	c.flag("snapshotid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcemetadata.NewGetVolumeSnapshotDetailsParams(c.string("snapshotid"))
+		return cs.Resourcemetadata.GetVolumeSnapshotDetails(p, opts...)
+	}
+	return c
+}
+
+func newListResourceDetailsCommand() *command {
+	c := newCommand("listResourceDetails", "List resource detail(s)", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("key", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("resourceid", &stringValue{}, "", false)
+	c.flag("resourcetype", &stringValue{}, "", true)
+	c.flag("value", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcemetadata.NewListResourceDetailsParams(c.string("resourcetype"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("key") {
+			p.SetKey(c.string("key"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("resourceid") {
+			p.SetResourceid(c.string("resourceid"))
+		}
+		if c.isSet("value") {
+			p.SetValue(c.string("value"))
+		}
+		return cs.Resourcemetadata.ListResourceDetails(p, opts...)
+	}
+	return c
+}
+
+func newRemoveResourceDetailCommand() *command {
+	c := newCommand("removeResourceDetail", "Removes detail for the Resource.", true)
+	c.flag("key", &stringValue{}, "", false)
+	c.flag("resourceid", &stringValue{}, "", true)
+	c.flag("resourcetype", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcemetadata.NewRemoveResourceDetailParams(c.string("resourceid"), c.string("resourcetype"))
+		if c.isSet("key") {
+			p.SetKey(c.string("key"))
+		}
+		return cs.Resourcemetadata.RemoveResourceDetail(p, opts...)
+	}
+	return c
+}
+
+func newCreateTagsCommand() *command {
+	c := newCommand("createTags", "Creates resource tag(s)", true)
+	c.flag("customer", &stringValue{}, "", false)
+	c.flag("resourceids", &stringsValue{}, "", true)
+	c.flag("resourcetype", &stringValue{}, "", true)
+	c.flag("tags", &mapValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcetags.NewCreateTagsParams(c.strings("resourceids"), c.string("resourcetype"), c.stringMap("tags"))
+		if c.isSet("customer") {
+			p.SetCustomer(c.string("customer"))
+		}
+		return cs.Resourcetags.CreateTags(p, opts...)
+	}
+	return c
+}
+
+func newDeleteTagsCommand() *command {
+	c := newCommand("deleteTags", "Deleting resource tag(s)", true)
+	c.flag("resourceids", &stringsValue{}, "", true)
+	c.flag("resourcetype", &stringValue{}, "", true)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcetags.NewDeleteTagsParams(c.strings("resourceids"), c.string("resourcetype"))
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.Resourcetags.DeleteTags(p, opts...)
+	}
+	return c
+}
+
+func newListStorageTagsCommand() *command {
+	c := newCommand("listStorageTags", "Lists storage tags", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcetags.NewListStorageTagsParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Resourcetags.ListStorageTags(p, opts...)
+	}
+	return c
+}
+
+func newListTagsCommand() *command {
+	c := newCommand("listTags", "List resource tag(s)", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("customer", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("key", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("resourceid", &stringValue{}, "", false)
+	c.flag("resourcetype", &stringValue{}, "", false)
+	c.flag("value", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Resourcetags.NewListTagsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("customer") {
+			p.SetCustomer(c.string("customer"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("key") {
+			p.SetKey(c.string("key"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("resourceid") {
+			p.SetResourceid(c.string("resourceid"))
+		}
+		if c.isSet("resourcetype") {
+			p.SetResourcetype(c.string("resourcetype"))
+		}
+		if c.isSet("value") {
+			p.SetValue(c.string("value"))
+		}
+		return cs.Resourcetags.ListTags(p, opts...)
+	}
+	return c
+}
+
+func newCreateRoleCommand() *command {
+	c := newCommand("createRole", "Creates a role", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("roleid", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewCreateRoleParams(c.string("name"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("roleid") {
+			p.SetRoleid(c.string("roleid"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Role.CreateRole(p, opts...)
+	}
+	return c
+}
+
+func newCreateRolePermissionCommand() *command {
+	c := newCommand("createRolePermission", "Adds an API permission to a role", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("permission", &stringValue{}, "", true)
+	c.flag("roleid", &stringValue{}, "", true)
+	c.flag("rule", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewCreateRolePermissionParams(c.string("permission"), c.string("roleid"), c.string("rule"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		return cs.Role.CreateRolePermission(p, opts...)
+	}
+	return c
+}
+
+func newDeleteRoleCommand() *command {
+	c := newCommand("deleteRole", "Deletes a role", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewDeleteRoleParams(c.string("id"))
+		return cs.Role.DeleteRole(p, opts...)
+	}
+	return c
+}
+
+func newDeleteRolePermissionCommand() *command {
+	c := newCommand("deleteRolePermission", "Deletes a role permission", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewDeleteRolePermissionParams(c.string("id"))
+		return cs.Role.DeleteRolePermission(p, opts...)
+	}
+	return c
+}
+
+func newImportRoleCommand() *command {
+	c := newCommand("importRole", "Imports a role based on provided map of rule permissions", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("rules", &mapValue{}, "", true)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewImportRoleParams(c.string("name"), c.stringMap("rules"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Role.ImportRole(p, opts...)
+	}
+	return c
+}
+
+func newListRolePermissionsCommand() *command {
+	c := newCommand("listRolePermissions", "Lists role permissions", false)
+	c.flag("roleid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewListRolePermissionsParams()
+		if c.isSet("roleid") {
+			p.SetRoleid(c.string("roleid"))
+		}
+		return cs.Role.ListRolePermissions(p, opts...)
+	}
+	return c
+}
+
+func newListRolesCommand() *command {
+	c := newCommand("listRoles", "Lists dynamic roles in CloudStack", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewListRolesParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Role.ListRoles(p, opts...)
+	}
+	return c
+}
+
+func newUpdateRoleCommand() *command {
+	c := newCommand("updateRole", "Updates a role", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewUpdateRoleParams(c.string("id"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		return cs.Role.UpdateRole(p, opts...)
+	}
+	return c
+}
+
+func newUpdateRolePermissionCommand() *command {
+	c := newCommand("updateRolePermission", "Updates a role permission order", false)
+	c.flag("permission", &stringValue{}, "", false)
+	c.flag("roleid", &stringValue{}, "", true)
+	c.flag("ruleid", &stringValue{}, "", false)
+	c.flag("ruleorder", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Role.NewUpdateRolePermissionParams(c.string("roleid"))
+		if c.isSet("permission") {
+			p.SetPermission(c.string("permission"))
+		}
+		if c.isSet("ruleid") {
+			p.SetRuleid(c.string("ruleid"))
+		}
+		if c.isSet("ruleorder") {
+			p.SetRuleorder(c.strings("ruleorder"))
+		}
+		return cs.Role.UpdateRolePermission(p, opts...)
+	}
+	return c
+}
+
+func newChangeServiceForRouterCommand() *command {
+	c := newCommand("changeServiceForRouter", "Upgrades domain router to a new service offering", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewChangeServiceForRouterParams(c.string("id"), c.string("serviceofferingid"))
+		return cs.Router.ChangeServiceForRouter(p, opts...)
+	}
+	return c
+}
+
+func newConfigureVirtualRouterElementCommand() *command {
+	c := newCommand("configureVirtualRouterElement", "Configures a virtual router element.", true)
+	c.flag("enabled", &boolValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewConfigureVirtualRouterElementParams(c.bool("enabled"), c.string("id"))
+		return cs.Router.ConfigureVirtualRouterElement(p, opts...)
+	}
+	return c
+}
+
+func newCreateVirtualRouterElementCommand() *command {
+	c := newCommand("createVirtualRouterElement", "Create a virtual router element.", true)
+	c.flag("nspid", &stringValue{}, "", true)
+	c.flag("providertype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewCreateVirtualRouterElementParams(c.string("nspid"))
+		if c.isSet("providertype") {
+			p.SetProvidertype(c.string("providertype"))
+		}
+		return cs.Router.CreateVirtualRouterElement(p, opts...)
+	}
+	return c
+}
+
+func newDestroyRouterCommand() *command {
+	c := newCommand("destroyRouter", "Destroys a router.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewDestroyRouterParams(c.string("id"))
+		return cs.Router.DestroyRouter(p, opts...)
+	}
+	return c
+}
+
+func newListRoutersCommand() *command {
+	c := newCommand("listRouters", "List routers.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fetchhealthcheckresults", &boolValue{}, "", false)
+	c.flag("forvpc", &boolValue{}, "", false)
+	c.flag("healthchecksfailed", &boolValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("version", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewListRoutersParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fetchhealthcheckresults") {
+			p.SetFetchhealthcheckresults(c.bool("fetchhealthcheckresults"))
+		}
+		if c.isSet("forvpc") {
+			p.SetForvpc(c.bool("forvpc"))
+		}
+		if c.isSet("healthchecksfailed") {
+			p.SetHealthchecksfailed(c.bool("healthchecksfailed"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("version") {
+			p.SetVersion(c.string("version"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Router.ListRouters(p, opts...)
+	}
+	return c
+}
+
+func newListVirtualRouterElementsCommand() *command {
+	c := newCommand("listVirtualRouterElements", "Lists all available virtual router elements.", false)
+	c.flag("enabled", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("nspid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewListVirtualRouterElementsParams()
+		if c.isSet("enabled") {
+			p.SetEnabled(c.bool("enabled"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("nspid") {
+			p.SetNspid(c.string("nspid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Router.ListVirtualRouterElements(p, opts...)
+	}
+	return c
+}
+
+func newRebootRouterCommand() *command {
+	c := newCommand("rebootRouter", "Starts a router.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewRebootRouterParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.Router.RebootRouter(p, opts...)
+	}
+	return c
+}
+
+func newStartRouterCommand() *command {
+	c := newCommand("startRouter", "Starts a router.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewStartRouterParams(c.string("id"))
+		return cs.Router.StartRouter(p, opts...)
+	}
+	return c
+}
+
+func newStopRouterCommand() *command {
+	c := newCommand("stopRouter", "Stops a router.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Router.NewStopRouterParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.Router.StopRouter(p, opts...)
+	}
+	return c
+}
+
+func newCreateSSHKeyPairCommand() *command {
+	c := newCommand("createSSHKeyPair", "Create a new keypair and returns the private key", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SSH.NewCreateSSHKeyPairParams(c.string("name"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.SSH.CreateSSHKeyPair(p, opts...)
+	}
+	return c
+}
+
+func newDeleteSSHKeyPairCommand() *command {
+	c := newCommand("deleteSSHKeyPair", "Deletes a keypair by name", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SSH.NewDeleteSSHKeyPairParams(c.string("name"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.SSH.DeleteSSHKeyPair(p, opts...)
+	}
+	return c
+}
+
+func newListSSHKeyPairsCommand() *command {
+	c := newCommand("listSSHKeyPairs", "List registered keypairs", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fingerprint", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SSH.NewListSSHKeyPairsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fingerprint") {
+			p.SetFingerprint(c.string("fingerprint"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.SSH.ListSSHKeyPairs(p, opts...)
+	}
+	return c
+}
+
+func newRegisterSSHKeyPairCommand() *command {
+	c := newCommand("registerSSHKeyPair", "Register a public key in a keypair under a certain name", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("publickey", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SSH.NewRegisterSSHKeyPairParams(c.string("name"), c.string("publickey"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.SSH.RegisterSSHKeyPair(p, opts...)
+	}
+	return c
+}
+
+func newResetSSHKeyForVirtualMachineCommand() *command {
+	c := newCommand("resetSSHKeyForVirtualMachine", "Resets the SSH Key for virtual machine. The virtual machine must be in a \"Stopped\" state. [async]", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("keypair", &stringValue{}, "", false)
+	c.flag("keypairs", &stringsValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SSH.NewResetSSHKeyForVirtualMachineParams(c.string("id"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("keypair") {
+			p.SetKeypair(c.string("keypair"))
+		}
+		if c.isSet("keypairs") {
+			p.SetKeypairs(c.strings("keypairs"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.SSH.ResetSSHKeyForVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newAuthorizeSecurityGroupEgressCommand() *command {
+	c := newCommand("authorizeSecurityGroupEgress", "Authorizes a particular egress rule for this security group", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("securitygroupid", &stringValue{}, "", false)
+	c.flag("securitygroupname", &stringValue{}, "", false)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("usersecuritygrouplist", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SecurityGroup.NewAuthorizeSecurityGroupEgressParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("securitygroupid") {
+			p.SetSecuritygroupid(c.string("securitygroupid"))
+		}
+		if c.isSet("securitygroupname") {
+			p.SetSecuritygroupname(c.string("securitygroupname"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("usersecuritygrouplist") {
+			p.SetUsersecuritygrouplist(c.stringMap("usersecuritygrouplist"))
+		}
+		return cs.SecurityGroup.AuthorizeSecurityGroupEgress(p, opts...)
+	}
+	return c
+}
+
+func newAuthorizeSecurityGroupIngressCommand() *command {
+	c := newCommand("authorizeSecurityGroupIngress", "Authorizes a particular ingress rule for this security group", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("cidrlist", &stringsValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("endport", &intValue{}, "", false)
+	c.flag("icmpcode", &intValue{}, "", false)
+	c.flag("icmptype", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("protocol", &stringValue{}, "", false)
+	c.flag("securitygroupid", &stringValue{}, "", false)
+	c.flag("securitygroupname", &stringValue{}, "", false)
+	c.flag("startport", &intValue{}, "", false)
+	c.flag("usersecuritygrouplist", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SecurityGroup.NewAuthorizeSecurityGroupIngressParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("cidrlist") {
+			p.SetCidrlist(c.strings("cidrlist"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("endport") {
+			p.SetEndport(c.int("endport"))
+		}
+		if c.isSet("icmpcode") {
+			p.SetIcmpcode(c.int("icmpcode"))
+		}
+		if c.isSet("icmptype") {
+			p.SetIcmptype(c.int("icmptype"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("protocol") {
+			p.SetProtocol(c.string("protocol"))
+		}
+		if c.isSet("securitygroupid") {
+			p.SetSecuritygroupid(c.string("securitygroupid"))
+		}
+		if c.isSet("securitygroupname") {
+			p.SetSecuritygroupname(c.string("securitygroupname"))
+		}
+		if c.isSet("startport") {
+			p.SetStartport(c.int("startport"))
+		}
+		if c.isSet("usersecuritygrouplist") {
+			p.SetUsersecuritygrouplist(c.stringMap("usersecuritygrouplist"))
+		}
+		return cs.SecurityGroup.AuthorizeSecurityGroupIngress(p, opts...)
+	}
+	return c
+}
+
+func newCreateSecurityGroupCommand() *command {
+	c := newCommand("createSecurityGroup", "Creates a security group", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SecurityGroup.NewCreateSecurityGroupParams(c.string("name"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.SecurityGroup.CreateSecurityGroup(p, opts...)
+	}
+	return c
+}
+
+func newDeleteSecurityGroupCommand() *command {
+	c := newCommand("deleteSecurityGroup", "Deletes security group", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SecurityGroup.NewDeleteSecurityGroupParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.SecurityGroup.DeleteSecurityGroup(p, opts...)
+	}
+	return c
+}
+
+func newListSecurityGroupsCommand() *command {
+	c := newCommand("listSecurityGroups", "Lists security groups", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("securitygroupname", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SecurityGroup.NewListSecurityGroupsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("securitygroupname") {
+			p.SetSecuritygroupname(c.string("securitygroupname"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		return cs.SecurityGroup.ListSecurityGroups(p, opts...)
+	}
+	return c
+}
+
+func newRevokeSecurityGroupEgressCommand() *command {
+	c := newCommand("revokeSecurityGroupEgress", "Deletes a particular egress rule from this security group", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SecurityGroup.NewRevokeSecurityGroupEgressParams(c.string("id"))
+		return cs.SecurityGroup.RevokeSecurityGroupEgress(p, opts...)
+	}
+	return c
+}
+
+func newRevokeSecurityGroupIngressCommand() *command {
+	c := newCommand("revokeSecurityGroupIngress", "Deletes a particular ingress rule from this security group", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SecurityGroup.NewRevokeSecurityGroupIngressParams(c.string("id"))
+		return cs.SecurityGroup.RevokeSecurityGroupIngress(p, opts...)
+	}
+	return c
+}
+
+func newCreateServiceOfferingCommand() *command {
+	c := newCommand("createServiceOffering", "Creates a service offering.", false)
+	c.flag("bytesreadrate", &int64Value{}, "", false)
+	c.flag("bytesreadratemax", &int64Value{}, "", false)
+	c.flag("bytesreadratemaxlength", &int64Value{}, "", false)
+	c.flag("byteswriterate", &int64Value{}, "", false)
+	c.flag("byteswriteratemax", &int64Value{}, "", false)
+	c.flag("byteswriteratemaxlength", &int64Value{}, "", false)
+	c.flag("cachemode", &stringValue{}, "", false)
+	c.flag("cpunumber", &intValue{}, "", false)
+	c.flag("cpuspeed", &intValue{}, "", false)
+	c.flag("customized", &boolValue{}, "", false)
+	c.flag("customizediops", &boolValue{}, "", false)
+	c.flag("deploymentplanner", &stringValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("diskofferingstrictness", &boolValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringsValue{}, "", false)
+	c.flag("dynamicscalingenabled", &boolValue{}, "", false)
+	c.flag("encryptroot", &boolValue{}, "", false)
+	c.flag("hosttags", &stringValue{}, "", false)
+	c.flag("hypervisorsnapshotreserve", &intValue{}, "", false)
+	c.flag("iopsreadrate", &int64Value{}, "", false)
+	c.flag("iopsreadratemax", &int64Value{}, "", false)
+	c.flag("iopsreadratemaxlength", &int64Value{}, "", false)
+	c.flag("iopswriterate", &int64Value{}, "", false)
+	c.flag("iopswriteratemax", &int64Value{}, "", false)
+	c.flag("iopswriteratemaxlength", &int64Value{}, "", false)
+	c.flag("issystem", &boolValue{}, "", false)
+	c.flag("isvolatile", &boolValue{}, "", false)
+	c.flag("limitcpuuse", &boolValue{}, "", false)
+	c.flag("maxcpunumber", &intValue{}, "", false)
+	c.flag("maxiops", &int64Value{}, "", false)
+	c.flag("maxmemory", &intValue{}, "", false)
+	c.flag("memory", &intValue{}, "", false)
+	c.flag("mincpunumber", &intValue{}, "", false)
+	c.flag("miniops", &int64Value{}, "", false)
+	c.flag("minmemory", &intValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkrate", &intValue{}, "", false)
+	c.flag("offerha", &boolValue{}, "", false)
+	c.flag("provisioningtype", &stringValue{}, "", false)
+	c.flag("rootdisksize", &int64Value{}, "", false)
+	c.flag("serviceofferingdetails", &mapValue{}, "", false)
+	c.flag("storagepolicy", &stringValue{}, "", false)
+	c.flag("storagetype", &stringValue{}, "", false)
+	c.flag("systemvmtype", &stringValue{}, "", false)
+	c.flag("tags", &stringValue{}, "", false)
+	c.flag("zoneid", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ServiceOffering.NewCreateServiceOfferingParams(c.string("displaytext"), c.string("name"))
+		if c.isSet("bytesreadrate") {
+			p.SetBytesreadrate(c.int64("bytesreadrate"))
+		}
+		if c.isSet("bytesreadratemax") {
+			p.SetBytesreadratemax(c.int64("bytesreadratemax"))
+		}
+		if c.isSet("bytesreadratemaxlength") {
+			p.SetBytesreadratemaxlength(c.int64("bytesreadratemaxlength"))
+		}
+		if c.isSet("byteswriterate") {
+			p.SetByteswriterate(c.int64("byteswriterate"))
+		}
+		if c.isSet("byteswriteratemax") {
+			p.SetByteswriteratemax(c.int64("byteswriteratemax"))
+		}
+		if c.isSet("byteswriteratemaxlength") {
+			p.SetByteswriteratemaxlength(c.int64("byteswriteratemaxlength"))
+		}
+		if c.isSet("cachemode") {
+			p.SetCachemode(c.string("cachemode"))
+		}
+		if c.isSet("cpunumber") {
+			p.SetCpunumber(c.int("cpunumber"))
+		}
+		if c.isSet("cpuspeed") {
+			p.SetCpuspeed(c.int("cpuspeed"))
+		}
+		if c.isSet("customized") {
+			p.SetCustomized(c.bool("customized"))
+		}
+		if c.isSet("customizediops") {
+			p.SetCustomizediops(c.bool("customizediops"))
+		}
+		if c.isSet("deploymentplanner") {
+			p.SetDeploymentplanner(c.string("deploymentplanner"))
+		}
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("diskofferingstrictness") {
+			p.SetDiskofferingstrictness(c.bool("diskofferingstrictness"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.strings("domainid"))
+		}
+		if c.isSet("dynamicscalingenabled") {
+			p.SetDynamicscalingenabled(c.bool("dynamicscalingenabled"))
+		}
+		if c.isSet("encryptroot") {
+			p.SetEncryptroot(c.bool("encryptroot"))
+		}
+		if c.isSet("hosttags") {
+			p.SetHosttags(c.string("hosttags"))
+		}
+		if c.isSet("hypervisorsnapshotreserve") {
+			p.SetHypervisorsnapshotreserve(c.int("hypervisorsnapshotreserve"))
+		}
+		if c.isSet("iopsreadrate") {
+			p.SetIopsreadrate(c.int64("iopsreadrate"))
+		}
+		if c.isSet("iopsreadratemax") {
+			p.SetIopsreadratemax(c.int64("iopsreadratemax"))
+		}
+		if c.isSet("iopsreadratemaxlength") {
+			p.SetIopsreadratemaxlength(c.int64("iopsreadratemaxlength"))
+		}
+		if c.isSet("iopswriterate") {
+			p.SetIopswriterate(c.int64("iopswriterate"))
+		}
+		if c.isSet("iopswriteratemax") {
+			p.SetIopswriteratemax(c.int64("iopswriteratemax"))
+		}
+		if c.isSet("iopswriteratemaxlength") {
+			p.SetIopswriteratemaxlength(c.int64("iopswriteratemaxlength"))
+		}
+		if c.isSet("issystem") {
+			p.SetIssystem(c.bool("issystem"))
+		}
+		if c.isSet("isvolatile") {
+			p.SetIsvolatile(c.bool("isvolatile"))
+		}
+		if c.isSet("limitcpuuse") {
+			p.SetLimitcpuuse(c.bool("limitcpuuse"))
+		}
+		if c.isSet("maxcpunumber") {
+			p.SetMaxcpunumber(c.int("maxcpunumber"))
+		}
+		if c.isSet("maxiops") {
+			p.SetMaxiops(c.int64("maxiops"))
+		}
+		if c.isSet("maxmemory") {
+			p.SetMaxmemory(c.int("maxmemory"))
+		}
+		if c.isSet("memory") {
+			p.SetMemory(c.int("memory"))
+		}
+		if c.isSet("mincpunumber") {
+			p.SetMincpunumber(c.int("mincpunumber"))
+		}
+		if c.isSet("miniops") {
+			p.SetMiniops(c.int64("miniops"))
+		}
+		if c.isSet("minmemory") {
+			p.SetMinmemory(c.int("minmemory"))
+		}
+		if c.isSet("networkrate") {
+			p.SetNetworkrate(c.int("networkrate"))
+		}
+		if c.isSet("offerha") {
+			p.SetOfferha(c.bool("offerha"))
+		}
+		if c.isSet("provisioningtype") {
+			p.SetProvisioningtype(c.string("provisioningtype"))
+		}
+		if c.isSet("rootdisksize") {
+			p.SetRootdisksize(c.int64("rootdisksize"))
+		}
+		if c.isSet("serviceofferingdetails") {
+			p.SetServiceofferingdetails(c.stringMap("serviceofferingdetails"))
+		}
+		if c.isSet("storagepolicy") {
+			p.SetStoragepolicy(c.string("storagepolicy"))
+		}
+		if c.isSet("storagetype") {
+			p.SetStoragetype(c.string("storagetype"))
+		}
+		if c.isSet("systemvmtype") {
+			p.SetSystemvmtype(c.string("systemvmtype"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.string("tags"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.strings("zoneid"))
+		}
+		return cs.ServiceOffering.CreateServiceOffering(p, opts...)
+	}
+	return c
+}
+
+func newDeleteServiceOfferingCommand() *command {
+	c := newCommand("deleteServiceOffering", "Deletes a service offering.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ServiceOffering.NewDeleteServiceOfferingParams(c.string("id"))
+		return cs.ServiceOffering.DeleteServiceOffering(p, opts...)
+	}
+	return c
+}
+
+func newListServiceOfferingsCommand() *command {
+	c := newCommand("listServiceOfferings", "Lists all available service offerings.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("cpunumber", &intValue{}, "", false)
+	c.flag("cpuspeed", &intValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("encryptroot", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("issystem", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("memory", &intValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("storagetype", &stringValue{}, "", false)
+	c.flag("systemvmtype", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ServiceOffering.NewListServiceOfferingsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("cpunumber") {
+			p.SetCpunumber(c.int("cpunumber"))
+		}
+		if c.isSet("cpuspeed") {
+			p.SetCpuspeed(c.int("cpuspeed"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("encryptroot") {
+			p.SetEncryptroot(c.bool("encryptroot"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("issystem") {
+			p.SetIssystem(c.bool("issystem"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("memory") {
+			p.SetMemory(c.int("memory"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("storagetype") {
+			p.SetStoragetype(c.string("storagetype"))
+		}
+		if c.isSet("systemvmtype") {
+			p.SetSystemvmtype(c.string("systemvmtype"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ServiceOffering.ListServiceOfferings(p, opts...)
+	}
+	return c
+}
+
+func newUpdateServiceOfferingCommand() *command {
+	c := newCommand("updateServiceOffering", "Updates a service offering.", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hosttags", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("sortkey", &intValue{}, "", false)
+	c.flag("storagetags", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.ServiceOffering.NewUpdateServiceOfferingParams(c.string("id"))
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("hosttags") {
+			p.SetHosttags(c.string("hosttags"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("sortkey") {
+			p.SetSortkey(c.int("sortkey"))
+		}
+		if c.isSet("storagetags") {
+			p.SetStoragetags(c.string("storagetags"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.ServiceOffering.UpdateServiceOffering(p, opts...)
+	}
+	return c
+}
+
+func newCreateSnapshotCommand() *command {
+	c := newCommand("createSnapshot", "Creates an instant snapshot of a volume.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("asyncbackup", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("locationtype", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("policyid", &stringValue{}, "", false)
+	c.flag("quiescevm", &boolValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("volumeid", &stringValue{}, "", true)
+	c.flag("zoneids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewCreateSnapshotParams(c.string("volumeid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("asyncbackup") {
+			p.SetAsyncbackup(c.bool("asyncbackup"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("locationtype") {
+			p.SetLocationtype(c.string("locationtype"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("policyid") {
+			p.SetPolicyid(c.string("policyid"))
+		}
+		if c.isSet("quiescevm") {
+			p.SetQuiescevm(c.bool("quiescevm"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("zoneids") {
+			p.SetZoneids(c.strings("zoneids"))
+		}
+		return cs.Snapshot.CreateSnapshot(p, opts...)
+	}
+	return c
+}
+
+func newCreateSnapshotPolicyCommand() *command {
+	c := newCommand("createSnapshotPolicy", "Creates a snapshot policy for the account.", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("intervaltype", &stringValue{}, "", true)
+	c.flag("maxsnaps", &intValue{}, "", true)
+	c.flag("schedule", &stringValue{}, "", true)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("timezone", &stringValue{}, "", true)
+	c.flag("volumeid", &stringValue{}, "", true)
+	c.flag("zoneids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewCreateSnapshotPolicyParams(c.string("intervaltype"), c.int("maxsnaps"), c.string("schedule"), c.string("timezone"), c.string("volumeid"))
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("zoneids") {
+			p.SetZoneids(c.strings("zoneids"))
+		}
+		return cs.Snapshot.CreateSnapshotPolicy(p, opts...)
+	}
+	return c
+}
+
+func newCreateVMSnapshotCommand() *command {
+	c := newCommand("createVMSnapshot", "Creates snapshot for a vm.", true)
+	c.flag("description", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("quiescevm", &boolValue{}, "", false)
+	c.flag("snapshotmemory", &boolValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewCreateVMSnapshotParams(c.string("virtualmachineid"))
+		if c.isSet("description") {
+			p.SetDescription(c.string("description"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("quiescevm") {
+			p.SetQuiescevm(c.bool("quiescevm"))
+		}
+		if c.isSet("snapshotmemory") {
+			p.SetSnapshotmemory(c.bool("snapshotmemory"))
+		}
+		return cs.Snapshot.CreateVMSnapshot(p, opts...)
+	}
+	return c
+}
+
+func newDeleteSnapshotCommand() *command {
+	c := newCommand("deleteSnapshot", "Deletes a snapshot of a disk volume.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewDeleteSnapshotParams(c.string("id"))
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Snapshot.DeleteSnapshot(p, opts...)
+	}
+	return c
+}
+
+func newDeleteSnapshotPoliciesCommand() *command {
+	c := newCommand("deleteSnapshotPolicies", "Deletes snapshot policies for the account.", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewDeleteSnapshotPoliciesParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		return cs.Snapshot.DeleteSnapshotPolicies(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVMSnapshotCommand() *command {
+	c := newCommand("deleteVMSnapshot", "Deletes a vmsnapshot.", true)
+	c.flag("vmsnapshotid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewDeleteVMSnapshotParams(c.string("vmsnapshotid"))
+		return cs.Snapshot.DeleteVMSnapshot(p, opts...)
+	}
+	return c
+}
+
+func newListSnapshotPoliciesCommand() *command {
+	c := newCommand("listSnapshotPolicies", "Lists snapshot policies.", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("volumeid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewListSnapshotPoliciesParams()
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("volumeid") {
+			p.SetVolumeid(c.string("volumeid"))
+		}
+		return cs.Snapshot.ListSnapshotPolicies(p, opts...)
+	}
+	return c
+}
+
+func newListSnapshotsCommand() *command {
+	c := newCommand("listSnapshots", "Lists all available snapshots for the account.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("imagestoreid", &stringValue{}, "", false)
+	c.flag("intervaltype", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("locationtype", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("showunique", &boolValue{}, "", false)
+	c.flag("snapshottype", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("volumeid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewListSnapshotsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("imagestoreid") {
+			p.SetImagestoreid(c.string("imagestoreid"))
+		}
+		if c.isSet("intervaltype") {
+			p.SetIntervaltype(c.string("intervaltype"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("locationtype") {
+			p.SetLocationtype(c.string("locationtype"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("showunique") {
+			p.SetShowunique(c.bool("showunique"))
+		}
+		if c.isSet("snapshottype") {
+			p.SetSnapshottype(c.string("snapshottype"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("volumeid") {
+			p.SetVolumeid(c.string("volumeid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Snapshot.ListSnapshots(p, opts...)
+	}
+	return c
+}
+
+func newListVMSnapshotCommand() *command {
+	c := newCommand("listVMSnapshot", "List virtual machine snapshot by conditions", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("vmsnapshotid", &stringValue{}, "", false)
+	c.flag("vmsnapshotids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewListVMSnapshotParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("vmsnapshotid") {
+			p.SetVmsnapshotid(c.string("vmsnapshotid"))
+		}
+		if c.isSet("vmsnapshotids") {
+			p.SetVmsnapshotids(c.strings("vmsnapshotids"))
+		}
+		return cs.Snapshot.ListVMSnapshot(p, opts...)
+	}
+	return c
+}
+
+func newRevertSnapshotCommand() *command {
+	c := newCommand("revertSnapshot", "This is supposed to revert a volume snapshot. This command is only supported with KVM so far", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewRevertSnapshotParams(c.string("id"))
+		return cs.Snapshot.RevertSnapshot(p, opts...)
+	}
+	return c
+}
+
+func newRevertToVMSnapshotCommand() *command {
+	c := newCommand("revertToVMSnapshot", "Revert VM from a vmsnapshot.", true)
+	c.flag("vmsnapshotid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewRevertToVMSnapshotParams(c.string("vmsnapshotid"))
+		return cs.Snapshot.RevertToVMSnapshot(p, opts...)
+	}
+	return c
+}
+
+func newUpdateSnapshotPolicyCommand() *command {
+	c := newCommand("updateSnapshotPolicy", "Updates the snapshot policy.", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Snapshot.NewUpdateSnapshotPolicyParams()
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		return cs.Snapshot.UpdateSnapshotPolicy(p, opts...)
+	}
+	return c
+}
+
+func newCancelStorageMaintenanceCommand() *command {
+	c := newCommand("cancelStorageMaintenance", "Cancels maintenance for primary storage", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.StoragePool.NewCancelStorageMaintenanceParams(c.string("id"))
+		return cs.StoragePool.CancelStorageMaintenance(p, opts...)
+	}
+	return c
+}
+
+func newEnableStorageMaintenanceCommand() *command {
+	c := newCommand("enableStorageMaintenance", "Puts storage pool into maintenance state", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.StoragePool.NewEnableStorageMaintenanceParams(c.string("id"))
+		return cs.StoragePool.EnableStorageMaintenance(p, opts...)
+	}
+	return c
+}
+
+func newListStorageProvidersCommand() *command {
+	c := newCommand("listStorageProviders", "Lists storage providers.", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("type", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.StoragePool.NewListStorageProvidersParams(c.string("type"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.StoragePool.ListStorageProviders(p, opts...)
+	}
+	return c
+}
+
+func newAddStratosphereSspCommand() *command {
+	c := newCommand("addStratosphereSsp", "Adds stratosphere ssp server", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", false)
+	c.flag("tenantuuid", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.StratosphereSSP.NewAddStratosphereSspParams(c.string("name"), c.string("url"), c.string("zoneid"))
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		if c.isSet("tenantuuid") {
+			p.SetTenantuuid(c.string("tenantuuid"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		return cs.StratosphereSSP.AddStratosphereSsp(p, opts...)
+	}
+	return c
+}
+
+func newDeleteStratosphereSspCommand() *command {
+	c := newCommand("deleteStratosphereSsp", "Removes stratosphere ssp server", false)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.StratosphereSSP.NewDeleteStratosphereSspParams(c.string("hostid"))
+		return cs.StratosphereSSP.DeleteStratosphereSsp(p, opts...)
+	}
+	return c
+}
+
+func newAddSwiftCommand() *command {
+	c := newCommand("addSwift", "Adds Swift.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("key", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Swift.NewAddSwiftParams(c.string("url"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("key") {
+			p.SetKey(c.string("key"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		return cs.Swift.AddSwift(p, opts...)
+	}
+	return c
+}
+
+func newListSwiftsCommand() *command {
+	c := newCommand("listSwifts", "List Swift.", false)
+	c.flag("id", &int64Value{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Swift.NewListSwiftsParams()
+		if c.isSet("id") {
+			p.SetId(c.int64("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Swift.ListSwifts(p, opts...)
+	}
+	return c
+}
+
+func newListCapacityCommand() *command {
+	c := newCommand("listCapacity", "Lists all the system wide capacities.", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("fetchlatest", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("sortby", &stringValue{}, "", false)
+	c.flag("type", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemCapacity.NewListCapacityParams()
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("fetchlatest") {
+			p.SetFetchlatest(c.bool("fetchlatest"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("sortby") {
+			p.SetSortby(c.string("sortby"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.int("type"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.SystemCapacity.ListCapacity(p, opts...)
+	}
+	return c
+}
+
+func newChangeServiceForSystemVmCommand() *command {
+	c := newCommand("changeServiceForSystemVm", "Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a \"Stopped\" state for this command to take effect.", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewChangeServiceForSystemVmParams(c.string("id"), c.string("serviceofferingid"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		return cs.SystemVM.ChangeServiceForSystemVm(p, opts...)
+	}
+	return c
+}
+
+func newDestroySystemVmCommand() *command {
+	c := newCommand("destroySystemVm", "Destroys a system virtual machine.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewDestroySystemVmParams(c.string("id"))
+		return cs.SystemVM.DestroySystemVm(p, opts...)
+	}
+	return c
+}
+
+func newListSystemVmsCommand() *command {
+	c := newCommand("listSystemVms", "List system virtual machines.", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("systemvmtype", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewListSystemVmsParams()
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("systemvmtype") {
+			p.SetSystemvmtype(c.string("systemvmtype"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.SystemVM.ListSystemVms(p, opts...)
+	}
+	return c
+}
+
+func newMigrateSystemVmCommand() *command {
+	c := newCommand("migrateSystemVm", "Attempts Migration of a system virtual machine to the host specified.", true)
+	c.flag("autoselect", &boolValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewMigrateSystemVmParams(c.string("virtualmachineid"))
+		if c.isSet("autoselect") {
+			p.SetAutoselect(c.bool("autoselect"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		return cs.SystemVM.MigrateSystemVm(p, opts...)
+	}
+	return c
+}
+
+func newRebootSystemVmCommand() *command {
+	c := newCommand("rebootSystemVm", "Reboots a system VM.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewRebootSystemVmParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.SystemVM.RebootSystemVm(p, opts...)
+	}
+	return c
+}
+
+func newScaleSystemVmCommand() *command {
+	c := newCommand("scaleSystemVm", "Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a \"Stopped\" state for this command to take effect.", true)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewScaleSystemVmParams(c.string("id"), c.string("serviceofferingid"))
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		return cs.SystemVM.ScaleSystemVm(p, opts...)
+	}
+	return c
+}
+
+func newStartSystemVmCommand() *command {
+	c := newCommand("startSystemVm", "Starts a system virtual machine.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewStartSystemVmParams(c.string("id"))
+		return cs.SystemVM.StartSystemVm(p, opts...)
+	}
+	return c
+}
+
+func newStopSystemVmCommand() *command {
+	c := newCommand("stopSystemVm", "Stops a system VM.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewStopSystemVmParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.SystemVM.StopSystemVm(p, opts...)
+	}
+	return c
+}
+
+func newPatchSystemVmCommand() *command {
+	c := newCommand("patchSystemVm", "Attempts to live patch systemVMs - CPVM, SSVM", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.SystemVM.NewPatchSystemVmParams()
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		return cs.SystemVM.PatchSystemVm(p, opts...)
+	}
+	return c
+}
+
+func newCopyTemplateCommand() *command {
+	c := newCommand("copyTemplate", "Copies a template from one zone to another.", true)
+	c.flag("destzoneid", &stringValue{}, "", false)
+	c.flag("destzoneids", &stringsValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("sourcezoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewCopyTemplateParams(c.string("id"))
+		if c.isSet("destzoneid") {
+			p.SetDestzoneid(c.string("destzoneid"))
+		}
+		if c.isSet("destzoneids") {
+			p.SetDestzoneids(c.strings("destzoneids"))
+		}
+		if c.isSet("sourcezoneid") {
+			p.SetSourcezoneid(c.string("sourcezoneid"))
+		}
+		return cs.Template.CopyTemplate(p, opts...)
+	}
+	return c
+}
+
+func newCreateTemplateCommand() *command {
+	c := newCommand("createTemplate", "Creates a template of a virtual machine. The virtual machine must be in a STOPPED state. A template created from this command is automatically designated as a private template visible to the account that created it.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("bits", &intValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("isdynamicallyscalable", &boolValue{}, "", false)
+	c.flag("isfeatured", &boolValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("ostypeid", &stringValue{}, "", true)
+	c.flag("passwordenabled", &boolValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("requireshvm", &boolValue{}, "", false)
+	c.flag("snapshotid", &stringValue{}, "", false)
+	c.flag("sshkeyenabled", &boolValue{}, "", false)
+	c.flag("templatetag", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("volumeid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewCreateTemplateParams(c.string("displaytext"), c.string("name"), c.string("ostypeid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("bits") {
+			p.SetBits(c.int("bits"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("isdynamicallyscalable") {
+			p.SetIsdynamicallyscalable(c.bool("isdynamicallyscalable"))
+		}
+		if c.isSet("isfeatured") {
+			p.SetIsfeatured(c.bool("isfeatured"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("passwordenabled") {
+			p.SetPasswordenabled(c.bool("passwordenabled"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("requireshvm") {
+			p.SetRequireshvm(c.bool("requireshvm"))
+		}
+		if c.isSet("snapshotid") {
+			p.SetSnapshotid(c.string("snapshotid"))
+		}
+		if c.isSet("sshkeyenabled") {
+			p.SetSshkeyenabled(c.bool("sshkeyenabled"))
+		}
+		if c.isSet("templatetag") {
+			p.SetTemplatetag(c.string("templatetag"))
+		}
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("volumeid") {
+			p.SetVolumeid(c.string("volumeid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Template.CreateTemplate(p, opts...)
+	}
+	return c
+}
+
+func newDeleteTemplateCommand() *command {
+	c := newCommand("deleteTemplate", "Deletes a template from the system. All virtual machines using the deleted template will not be affected.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewDeleteTemplateParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Template.DeleteTemplate(p, opts...)
+	}
+	return c
+}
+
+func newExtractTemplateCommand() *command {
+	c := newCommand("extractTemplate", "Extracts a template", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("mode", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewExtractTemplateParams(c.string("id"), c.string("mode"))
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Template.ExtractTemplate(p, opts...)
+	}
+	return c
+}
+
+func newGetUploadParamsForTemplateCommand() *command {
+	c := newCommand("getUploadParamsForTemplate", "upload an existing template into the CloudStack cloud.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("bits", &intValue{}, "", false)
+	c.flag("checksum", &stringValue{}, "", false)
+	c.flag("deployasis", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("format", &stringValue{}, "", true)
+	c.flag("hypervisor", &stringValue{}, "", true)
+	c.flag("isdynamicallyscalable", &boolValue{}, "", false)
+	c.flag("isextractable", &boolValue{}, "", false)
+	c.flag("isfeatured", &boolValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("isrouting", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.flag("passwordenabled", &boolValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("requireshvm", &boolValue{}, "", false)
+	c.flag("sshkeyenabled", &boolValue{}, "", false)
+	c.flag("templatetag", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewGetUploadParamsForTemplateParams(c.string("displaytext"), c.string("format"), c.string("hypervisor"), c.string("name"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("bits") {
+			p.SetBits(c.int("bits"))
+		}
+		if c.isSet("checksum") {
+			p.SetChecksum(c.string("checksum"))
+		}
+		if c.isSet("deployasis") {
+			p.SetDeployasis(c.bool("deployasis"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("isdynamicallyscalable") {
+			p.SetIsdynamicallyscalable(c.bool("isdynamicallyscalable"))
+		}
+		if c.isSet("isextractable") {
+			p.SetIsextractable(c.bool("isextractable"))
+		}
+		if c.isSet("isfeatured") {
+			p.SetIsfeatured(c.bool("isfeatured"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("isrouting") {
+			p.SetIsrouting(c.bool("isrouting"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		if c.isSet("passwordenabled") {
+			p.SetPasswordenabled(c.bool("passwordenabled"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("requireshvm") {
+			p.SetRequireshvm(c.bool("requireshvm"))
+		}
+		if c.isSet("sshkeyenabled") {
+			p.SetSshkeyenabled(c.bool("sshkeyenabled"))
+		}
+		if c.isSet("templatetag") {
+			p.SetTemplatetag(c.string("templatetag"))
+		}
+		return cs.Template.GetUploadParamsForTemplate(p, opts...)
+	}
+	return c
+}
+
+func newListTemplatePermissionsCommand() *command {
+	c := newCommand("listTemplatePermissions", "List template visibility and all accounts that have permissions to view this template.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewListTemplatePermissionsParams(c.string("id"))
+		return cs.Template.ListTemplatePermissions(p, opts...)
+	}
+	return c
+}
+
+func newListTemplatesCommand() *command {
+	c := newCommand("listTemplates", "List all public, private, and privileged templates.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("imagestoreid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("isvnf", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("parenttemplateid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("showremoved", &boolValue{}, "", false)
+	c.flag("showunique", &boolValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("templatefilter", &stringValue{}, "", true)
+	c.flag("templatetype", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewListTemplatesParams(c.string("templatefilter"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("imagestoreid") {
+			p.SetImagestoreid(c.string("imagestoreid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("isvnf") {
+			p.SetIsvnf(c.bool("isvnf"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("parenttemplateid") {
+			p.SetParenttemplateid(c.string("parenttemplateid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("showremoved") {
+			p.SetShowremoved(c.bool("showremoved"))
+		}
+		if c.isSet("showunique") {
+			p.SetShowunique(c.bool("showunique"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("templatetype") {
+			p.SetTemplatetype(c.string("templatetype"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Template.ListTemplates(p, opts...)
+	}
+	return c
+}
+
+func newPrepareTemplateCommand() *command {
+	c := newCommand("prepareTemplate", "load template into primary storage", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("templateid", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewPrepareTemplateParams(c.string("templateid"), c.string("zoneid"))
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		return cs.Template.PrepareTemplate(p, opts...)
+	}
+	return c
+}
+
+func newRegisterTemplateCommand() *command {
+	c := newCommand("registerTemplate", "Registers an existing template into the CloudStack cloud.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("bits", &intValue{}, "", false)
+	c.flag("checksum", &stringValue{}, "", false)
+	c.flag("deployasis", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("directdownload", &boolValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("format", &stringValue{}, "", true)
+	c.flag("hypervisor", &stringValue{}, "", true)
+	c.flag("isdynamicallyscalable", &boolValue{}, "", false)
+	c.flag("isextractable", &boolValue{}, "", false)
+	c.flag("isfeatured", &boolValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("isrouting", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.flag("passwordenabled", &boolValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("requireshvm", &boolValue{}, "", false)
+	c.flag("sshkeyenabled", &boolValue{}, "", false)
+	c.flag("templatetag", &stringValue{}, "", false)
+	c.flag("templatetype", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.flag("zoneids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewRegisterTemplateParams(c.string("displaytext"), c.string("format"), c.string("hypervisor"), c.string("name"), c.string("url"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("bits") {
+			p.SetBits(c.int("bits"))
+		}
+		if c.isSet("checksum") {
+			p.SetChecksum(c.string("checksum"))
+		}
+		if c.isSet("deployasis") {
+			p.SetDeployasis(c.bool("deployasis"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("directdownload") {
+			p.SetDirectdownload(c.bool("directdownload"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("isdynamicallyscalable") {
+			p.SetIsdynamicallyscalable(c.bool("isdynamicallyscalable"))
+		}
+		if c.isSet("isextractable") {
+			p.SetIsextractable(c.bool("isextractable"))
+		}
+		if c.isSet("isfeatured") {
+			p.SetIsfeatured(c.bool("isfeatured"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("isrouting") {
+			p.SetIsrouting(c.bool("isrouting"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		if c.isSet("passwordenabled") {
+			p.SetPasswordenabled(c.bool("passwordenabled"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("requireshvm") {
+			p.SetRequireshvm(c.bool("requireshvm"))
+		}
+		if c.isSet("sshkeyenabled") {
+			p.SetSshkeyenabled(c.bool("sshkeyenabled"))
+		}
+		if c.isSet("templatetag") {
+			p.SetTemplatetag(c.string("templatetag"))
+		}
+		if c.isSet("templatetype") {
+			p.SetTemplatetype(c.string("templatetype"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		if c.isSet("zoneids") {
+			p.SetZoneids(c.strings("zoneids"))
+		}
+		return cs.Template.RegisterTemplate(p, opts...)
+	}
+	return c
+}
+
+func newUpdateTemplateCommand() *command {
+	c := newCommand("updateTemplate", "Updates attributes of a template.", false)
+	c.flag("bootable", &boolValue{}, "", false)
+	c.flag("cleanupdetails", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("format", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("isdynamicallyscalable", &boolValue{}, "", false)
+	c.flag("isrouting", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.flag("passwordenabled", &boolValue{}, "", false)
+	c.flag("requireshvm", &boolValue{}, "", false)
+	c.flag("sortkey", &intValue{}, "", false)
+	c.flag("sshkeyenabled", &boolValue{}, "", false)
+	c.flag("templatetype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewUpdateTemplateParams(c.string("id"))
+		if c.isSet("bootable") {
+			p.SetBootable(c.bool("bootable"))
+		}
+		if c.isSet("cleanupdetails") {
+			p.SetCleanupdetails(c.bool("cleanupdetails"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("format") {
+			p.SetFormat(c.string("format"))
+		}
+		if c.isSet("isdynamicallyscalable") {
+			p.SetIsdynamicallyscalable(c.bool("isdynamicallyscalable"))
+		}
+		if c.isSet("isrouting") {
+			p.SetIsrouting(c.bool("isrouting"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		if c.isSet("passwordenabled") {
+			p.SetPasswordenabled(c.bool("passwordenabled"))
+		}
+		if c.isSet("requireshvm") {
+			p.SetRequireshvm(c.bool("requireshvm"))
+		}
+		if c.isSet("sortkey") {
+			p.SetSortkey(c.int("sortkey"))
+		}
+		if c.isSet("sshkeyenabled") {
+			p.SetSshkeyenabled(c.bool("sshkeyenabled"))
+		}
+		if c.isSet("templatetype") {
+			p.SetTemplatetype(c.string("templatetype"))
+		}
+		return cs.Template.UpdateTemplate(p, opts...)
+	}
+	return c
+}
+
+func newUpdateTemplatePermissionsCommand() *command {
+	c := newCommand("updateTemplatePermissions", "Updates a template visibility permissions. A public template is visible to all accounts within the same domain. A private template is visible only to the owner of the template. A privileged template is a private template with account permissions added. Only accounts specified under the template permissions are visible to them.", false)
+	c.flag("accounts", &stringsValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("isextractable", &boolValue{}, "", false)
+	c.flag("isfeatured", &boolValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("op", &stringValue{}, "", false)
+	c.flag("projectids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewUpdateTemplatePermissionsParams(c.string("id"))
+		if c.isSet("accounts") {
+			p.SetAccounts(c.strings("accounts"))
+		}
+		if c.isSet("isextractable") {
+			p.SetIsextractable(c.bool("isextractable"))
+		}
+		if c.isSet("isfeatured") {
+			p.SetIsfeatured(c.bool("isfeatured"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("op") {
+			p.SetOp(c.string("op"))
+		}
+		if c.isSet("projectids") {
+			p.SetProjectids(c.strings("projectids"))
+		}
+		return cs.Template.UpdateTemplatePermissions(p, opts...)
+	}
+	return c
+}
+
+func newUpgradeRouterTemplateCommand() *command {
+	c := newCommand("upgradeRouterTemplate", "Upgrades router to use newer template", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewUpgradeRouterTemplateParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Template.UpgradeRouterTemplate(p, opts...)
+	}
+	return c
+}
+
+func newListTemplateDirectDownloadCertificatesCommand() *command {
+	c := newCommand("listTemplateDirectDownloadCertificates", "List the uploaded certificates for direct download templates", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listhosts", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewListTemplateDirectDownloadCertificatesParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listhosts") {
+			p.SetListhosts(c.bool("listhosts"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Template.ListTemplateDirectDownloadCertificates(p, opts...)
+	}
+	return c
+}
+
+func newProvisionTemplateDirectDownloadCertificateCommand() *command {
+	c := newCommand("provisionTemplateDirectDownloadCertificate", "Provisions a host with a direct download certificate", false)
+	c.flag("hostid", &stringValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Template.NewProvisionTemplateDirectDownloadCertificateParams(c.string("hostid"), c.string("id"))
+		return cs.Template.ProvisionTemplateDirectDownloadCertificate(p, opts...)
+	}
+	return c
+}
+
+func newAddUcsManagerCommand() *command {
+	c := newCommand("addUcsManager", "Adds a Ucs manager", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("username", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UCS.NewAddUcsManagerParams(c.string("password"), c.string("url"), c.string("username"), c.string("zoneid"))
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.UCS.AddUcsManager(p, opts...)
+	}
+	return c
+}
+
+func newAssociateUcsProfileToBladeCommand() *command {
+	c := newCommand("associateUcsProfileToBlade", "associate a profile to a blade", true)
+	c.flag("bladeid", &stringValue{}, "", true)
+	c.flag("profiledn", &stringValue{}, "", true)
+	c.flag("ucsmanagerid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UCS.NewAssociateUcsProfileToBladeParams(c.string("bladeid"), c.string("profiledn"), c.string("ucsmanagerid"))
+		return cs.UCS.AssociateUcsProfileToBlade(p, opts...)
+	}
+	return c
+}
+
+func newDeleteUcsManagerCommand() *command {
+	c := newCommand("deleteUcsManager", "Delete a Ucs manager", false)
+	c.flag("ucsmanagerid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UCS.NewDeleteUcsManagerParams(c.string("ucsmanagerid"))
+		return cs.UCS.DeleteUcsManager(p, opts...)
+	}
+	return c
+}
+
+func newListUcsBladesCommand() *command {
+	c := newCommand("listUcsBlades", "List ucs blades", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("ucsmanagerid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UCS.NewListUcsBladesParams(c.string("ucsmanagerid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.UCS.ListUcsBlades(p, opts...)
+	}
+	return c
+}
+
+func newListUcsManagersCommand() *command {
+	c := newCommand("listUcsManagers", "List ucs manager", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UCS.NewListUcsManagersParams()
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.UCS.ListUcsManagers(p, opts...)
+	}
+	return c
+}
+
+func newListUcsProfilesCommand() *command {
+	c := newCommand("listUcsProfiles", "List profile in ucs manager", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("ucsmanagerid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UCS.NewListUcsProfilesParams(c.string("ucsmanagerid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.UCS.ListUcsProfiles(p, opts...)
+	}
+	return c
+}
+
+func newAddTrafficMonitorCommand() *command {
+	c := newCommand("addTrafficMonitor", "Adds Traffic Monitor Host for Direct Network Usage", false)
+	c.flag("excludezones", &stringValue{}, "", false)
+	c.flag("includezones", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewAddTrafficMonitorParams(c.string("url"), c.string("zoneid"))
+		if c.isSet("excludezones") {
+			p.SetExcludezones(c.string("excludezones"))
+		}
+		if c.isSet("includezones") {
+			p.SetIncludezones(c.string("includezones"))
+		}
+		return cs.Usage.AddTrafficMonitor(p, opts...)
+	}
+	return c
+}
+
+func newAddTrafficTypeCommand() *command {
+	c := newCommand("addTrafficType", "Adds traffic type to a physical network", true)
+	c.flag("hypervnetworklabel", &stringValue{}, "", false)
+	c.flag("isolationmethod", &stringValue{}, "", false)
+	c.flag("kvmnetworklabel", &stringValue{}, "", false)
+	c.flag("ovm3networklabel", &stringValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("traffictype", &stringValue{}, "", true)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("vmwarenetworklabel", &stringValue{}, "", false)
+	c.flag("xennetworklabel", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewAddTrafficTypeParams(c.string("physicalnetworkid"), c.string("traffictype"))
+		if c.isSet("hypervnetworklabel") {
+			p.SetHypervnetworklabel(c.string("hypervnetworklabel"))
+		}
+		if c.isSet("isolationmethod") {
+			p.SetIsolationmethod(c.string("isolationmethod"))
+		}
+		if c.isSet("kvmnetworklabel") {
+			p.SetKvmnetworklabel(c.string("kvmnetworklabel"))
+		}
+		if c.isSet("ovm3networklabel") {
+			p.SetOvm3networklabel(c.string("ovm3networklabel"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		if c.isSet("vmwarenetworklabel") {
+			p.SetVmwarenetworklabel(c.string("vmwarenetworklabel"))
+		}
+		if c.isSet("xennetworklabel") {
+			p.SetXennetworklabel(c.string("xennetworklabel"))
+		}
+		return cs.Usage.AddTrafficType(p, opts...)
+	}
+	return c
+}
+
+func newDeleteTrafficMonitorCommand() *command {
+	c := newCommand("deleteTrafficMonitor", "Deletes an traffic monitor host.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewDeleteTrafficMonitorParams(c.string("id"))
+		return cs.Usage.DeleteTrafficMonitor(p, opts...)
+	}
+	return c
+}
+
+func newDeleteTrafficTypeCommand() *command {
+	c := newCommand("deleteTrafficType", "Deletes traffic type of a physical network", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewDeleteTrafficTypeParams(c.string("id"))
+		return cs.Usage.DeleteTrafficType(p, opts...)
+	}
+	return c
+}
+
+func newGenerateUsageRecordsCommand() *command {
+	c := newCommand("generateUsageRecords", "Generates usage records. This will generate records only if there any records to be generated, i.e if the scheduled usage job was not run or failed", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("enddate", &stringValue{}, "", true)
+	c.flag("startdate", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewGenerateUsageRecordsParams(c.string("enddate"), c.string("startdate"))
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		return cs.Usage.GenerateUsageRecords(p, opts...)
+	}
+	return c
+}
+
+func newListTrafficMonitorsCommand() *command {
+	c := newCommand("listTrafficMonitors", "List traffic monitor Hosts.", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewListTrafficMonitorsParams(c.string("zoneid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Usage.ListTrafficMonitors(p, opts...)
+	}
+	return c
+}
+
+func newListTrafficTypeImplementorsCommand() *command {
+	c := newCommand("listTrafficTypeImplementors", "Lists implementors of implementor of a network traffic type or implementors of all network traffic types", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("traffictype", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewListTrafficTypeImplementorsParams()
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("traffictype") {
+			p.SetTraffictype(c.string("traffictype"))
+		}
+		return cs.Usage.ListTrafficTypeImplementors(p, opts...)
+	}
+	return c
+}
+
+func newListTrafficTypesCommand() *command {
+	c := newCommand("listTrafficTypes", "Lists traffic types of a given physical network.", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewListTrafficTypesParams(c.string("physicalnetworkid"))
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		return cs.Usage.ListTrafficTypes(p, opts...)
+	}
+	return c
+}
+
+func newListUsageRecordsCommand() *command {
+	c := newCommand("listUsageRecords", "Lists usage records for accounts", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accountid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("enddate", &stringValue{}, "", true)
+	c.flag("includetags", &boolValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("oldformat", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", true)
+	c.flag("type", &int64Value{}, "", false)
+	c.flag("usageid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewListUsageRecordsParams(c.string("enddate"), c.string("startdate"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accountid") {
+			p.SetAccountid(c.string("accountid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("includetags") {
+			p.SetIncludetags(c.bool("includetags"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("oldformat") {
+			p.SetOldformat(c.bool("oldformat"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.int64("type"))
+		}
+		if c.isSet("usageid") {
+			p.SetUsageid(c.string("usageid"))
+		}
+		return cs.Usage.ListUsageRecords(p, opts...)
+	}
+	return c
+}
+
+func newListUsageTypesCommand() *command {
+	c := newCommand("listUsageTypes", "List Usage Types", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewListUsageTypesParams()
+		return cs.Usage.ListUsageTypes(p, opts...)
+	}
+	return c
+}
+
+func newRemoveRawUsageRecordsCommand() *command {
+	c := newCommand("removeRawUsageRecords", "Safely removes raw records from cloud_usage table", false)
+	c.flag("interval", &intValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewRemoveRawUsageRecordsParams(c.int("interval"))
+		return cs.Usage.RemoveRawUsageRecords(p, opts...)
+	}
+	return c
+}
+
+func newUpdateTrafficTypeCommand() *command {
+	c := newCommand("updateTrafficType", "Updates traffic type of a physical network", true)
+	c.flag("hypervnetworklabel", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("kvmnetworklabel", &stringValue{}, "", false)
+	c.flag("ovm3networklabel", &stringValue{}, "", false)
+	c.flag("vmwarenetworklabel", &stringValue{}, "", false)
+	c.flag("xennetworklabel", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewUpdateTrafficTypeParams(c.string("id"))
+		if c.isSet("hypervnetworklabel") {
+			p.SetHypervnetworklabel(c.string("hypervnetworklabel"))
+		}
+		if c.isSet("kvmnetworklabel") {
+			p.SetKvmnetworklabel(c.string("kvmnetworklabel"))
+		}
+		if c.isSet("ovm3networklabel") {
+			p.SetOvm3networklabel(c.string("ovm3networklabel"))
+		}
+		if c.isSet("vmwarenetworklabel") {
+			p.SetVmwarenetworklabel(c.string("vmwarenetworklabel"))
+		}
+		if c.isSet("xennetworklabel") {
+			p.SetXennetworklabel(c.string("xennetworklabel"))
+		}
+		return cs.Usage.UpdateTrafficType(p, opts...)
+	}
+	return c
+}
+
+func newListUsageServerMetricsCommand() *command {
+	c := newCommand("listUsageServerMetrics", "Lists Usage Server metrics", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Usage.NewListUsageServerMetricsParams()
+		return cs.Usage.ListUsageServerMetrics(p, opts...)
+	}
+	return c
+}
+
+func newDeleteUserDataCommand() *command {
+	c := newCommand("deleteUserData", "Deletes a userdata", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UserData.NewDeleteUserDataParams(c.string("id"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.UserData.DeleteUserData(p, opts...)
+	}
+	return c
+}
+
+func newLinkUserDataToTemplateCommand() *command {
+	c := newCommand("linkUserDataToTemplate", "Link or unlink a userdata to a template.", false)
+	c.flag("isoid", &stringValue{}, "", false)
+	c.flag("templateid", &stringValue{}, "", false)
+	c.flag("userdataid", &stringValue{}, "", false)
+	c.flag("userdatapolicy", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UserData.NewLinkUserDataToTemplateParams()
+		if c.isSet("isoid") {
+			p.SetIsoid(c.string("isoid"))
+		}
+		if c.isSet("templateid") {
+			p.SetTemplateid(c.string("templateid"))
+		}
+		if c.isSet("userdataid") {
+			p.SetUserdataid(c.string("userdataid"))
+		}
+		if c.isSet("userdatapolicy") {
+			p.SetUserdatapolicy(c.string("userdatapolicy"))
+		}
+		return cs.UserData.LinkUserDataToTemplate(p, opts...)
+	}
+	return c
+}
+
+func newListUserDataCommand() *command {
+	c := newCommand("listUserData", "List registered userdatas", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UserData.NewListUserDataParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.UserData.ListUserData(p, opts...)
+	}
+	return c
+}
+
+func newRegisterUserDataCommand() *command {
+	c := newCommand("registerUserData", "Register a new userdata.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("params", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("userdata", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.UserData.NewRegisterUserDataParams(c.string("name"), c.string("userdata"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("params") {
+			p.SetParams(c.string("params"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.UserData.RegisterUserData(p, opts...)
+	}
+	return c
+}
+
+func newCreateUserCommand() *command {
+	c := newCommand("createUser", "Creates a user for an account that already exists", false)
+	c.flag("account", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("email", &stringValue{}, "", true)
+	c.flag("firstname", &stringValue{}, "", true)
+	c.flag("lastname", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("timezone", &stringValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewCreateUserParams(c.string("account"), c.string("email"), c.string("firstname"), c.string("lastname"), c.string("password"), c.string("username"))
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("timezone") {
+			p.SetTimezone(c.string("timezone"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		return cs.User.CreateUser(p, opts...)
+	}
+	return c
+}
+
+func newDeleteUserCommand() *command {
+	c := newCommand("deleteUser", "Deletes a user for an account", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewDeleteUserParams(c.string("id"))
+		return cs.User.DeleteUser(p, opts...)
+	}
+	return c
+}
+
+func newDisableUserCommand() *command {
+	c := newCommand("disableUser", "Disables a user account", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewDisableUserParams(c.string("id"))
+		return cs.User.DisableUser(p, opts...)
+	}
+	return c
+}
+
+func newEnableUserCommand() *command {
+	c := newCommand("enableUser", "Enables a user account", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewEnableUserParams(c.string("id"))
+		return cs.User.EnableUser(p, opts...)
+	}
+	return c
+}
+
+func newGetUserCommand() *command {
+	c := newCommand("getUser", "Find user account by API key", false)
+	c.flag("userapikey", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewGetUserParams(c.string("userapikey"))
+		return cs.User.GetUser(p, opts...)
+	}
+	return c
+}
+
+func newGetUserKeysCommand() *command {
+	c := newCommand("getUserKeys", "This command allows the user to query the seceret and API keys for the account", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewGetUserKeysParams(c.string("id"))
+		return cs.User.GetUserKeys(p, opts...)
+	}
+	return c
+}
+
+func newGetVirtualMachineUserDataCommand() *command {
+	c := newCommand("getVirtualMachineUserData", "Returns user data associated with the VM", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewGetVirtualMachineUserDataParams(c.string("virtualmachineid"))
+		return cs.User.GetVirtualMachineUserData(p, opts...)
+	}
+	return c
+}
+
+func newListUsersCommand() *command {
+	c := newCommand("listUsers", "Lists user accounts", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accounttype", &intValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewListUsersParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accounttype") {
+			p.SetAccounttype(c.int("accounttype"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		return cs.User.ListUsers(p, opts...)
+	}
+	return c
+}
+
+func newLockUserCommand() *command {
+	c := newCommand("lockUser", "Locks a user account", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewLockUserParams(c.string("id"))
+		return cs.User.LockUser(p, opts...)
+	}
+	return c
+}
+
+func newRegisterUserKeysCommand() *command {
+	c := newCommand("registerUserKeys", "This command allows a user to register for the developer API, returning a secret key and an API key. This request is made through the integration API port, so it is a privileged command and must be made on behalf of a user. It is up to the implementer just how the username and password are entered, and then how that translates to an integration API request. Both secret key and API key should be returned to the user", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewRegisterUserKeysParams(c.string("id"))
+		return cs.User.RegisterUserKeys(p, opts...)
+	}
+	return c
+}
+
+func newUpdateUserCommand() *command {
+	c := newCommand("updateUser", "Updates a user account", false)
+	c.flag("currentpassword", &stringValue{}, "", false)
+	c.flag("email", &stringValue{}, "", false)
+	c.flag("firstname", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("lastname", &stringValue{}, "", false)
+	c.flag("mandate2fa", &boolValue{}, "", false)
+	c.flag("password", &stringValue{}, "", false)
+	c.flag("timezone", &stringValue{}, "", false)
+	c.flag("userapikey", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", false)
+	c.flag("usersecretkey", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.User.NewUpdateUserParams(c.string("id"))
+		if c.isSet("currentpassword") {
+			p.SetCurrentpassword(c.string("currentpassword"))
+		}
+		if c.isSet("email") {
+			p.SetEmail(c.string("email"))
+		}
+		if c.isSet("firstname") {
+			p.SetFirstname(c.string("firstname"))
+		}
+		if c.isSet("lastname") {
+			p.SetLastname(c.string("lastname"))
+		}
+		if c.isSet("mandate2fa") {
+			p.SetMandate2fa(c.bool("mandate2fa"))
+		}
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		if c.isSet("timezone") {
+			p.SetTimezone(c.string("timezone"))
+		}
+		if c.isSet("userapikey") {
+			p.SetUserapikey(c.string("userapikey"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		if c.isSet("usersecretkey") {
+			p.SetUsersecretkey(c.string("usersecretkey"))
+		}
+		return cs.User.UpdateUser(p, opts...)
+	}
+	return c
+}
+
+func newCreateVlanIpRangeCommand() *command {
+	c := newCommand("createVlanIpRange", "Creates a VLAN IP range.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("endip", &stringValue{}, "", false)
+	c.flag("endipv6", &stringValue{}, "", false)
+	c.flag("forsystemvms", &boolValue{}, "", false)
+	c.flag("forvirtualnetwork", &boolValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", false)
+	c.flag("ip6cidr", &stringValue{}, "", false)
+	c.flag("ip6gateway", &stringValue{}, "", false)
+	c.flag("netmask", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("startip", &stringValue{}, "", false)
+	c.flag("startipv6", &stringValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewCreateVlanIpRangeParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("endip") {
+			p.SetEndip(c.string("endip"))
+		}
+		if c.isSet("endipv6") {
+			p.SetEndipv6(c.string("endipv6"))
+		}
+		if c.isSet("forsystemvms") {
+			p.SetForsystemvms(c.bool("forsystemvms"))
+		}
+		if c.isSet("forvirtualnetwork") {
+			p.SetForvirtualnetwork(c.bool("forvirtualnetwork"))
+		}
+		if c.isSet("gateway") {
+			p.SetGateway(c.string("gateway"))
+		}
+		if c.isSet("ip6cidr") {
+			p.SetIp6cidr(c.string("ip6cidr"))
+		}
+		if c.isSet("ip6gateway") {
+			p.SetIp6gateway(c.string("ip6gateway"))
+		}
+		if c.isSet("netmask") {
+			p.SetNetmask(c.string("netmask"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("startip") {
+			p.SetStartip(c.string("startip"))
+		}
+		if c.isSet("startipv6") {
+			p.SetStartipv6(c.string("startipv6"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VLAN.CreateVlanIpRange(p, opts...)
+	}
+	return c
+}
+
+func newDedicateGuestVlanRangeCommand() *command {
+	c := newCommand("dedicateGuestVlanRange", "Dedicates a guest vlan range to an account", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("vlanrange", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewDedicateGuestVlanRangeParams(c.string("physicalnetworkid"), c.string("vlanrange"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.VLAN.DedicateGuestVlanRange(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVlanIpRangeCommand() *command {
+	c := newCommand("deleteVlanIpRange", "Creates a VLAN IP range.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewDeleteVlanIpRangeParams(c.string("id"))
+		return cs.VLAN.DeleteVlanIpRange(p, opts...)
+	}
+	return c
+}
+
+func newListDedicatedGuestVlanRangesCommand() *command {
+	c := newCommand("listDedicatedGuestVlanRanges", "Lists dedicated guest vlan ranges", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("guestvlanrange", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewListDedicatedGuestVlanRangesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("guestvlanrange") {
+			p.SetGuestvlanrange(c.string("guestvlanrange"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VLAN.ListDedicatedGuestVlanRanges(p, opts...)
+	}
+	return c
+}
+
+func newListVlanIpRangesCommand() *command {
+	c := newCommand("listVlanIpRanges", "Lists all VLAN IP ranges.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("forvirtualnetwork", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewListVlanIpRangesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("forvirtualnetwork") {
+			p.SetForvirtualnetwork(c.bool("forvirtualnetwork"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VLAN.ListVlanIpRanges(p, opts...)
+	}
+	return c
+}
+
+func newReleaseDedicatedGuestVlanRangeCommand() *command {
+	c := newCommand("releaseDedicatedGuestVlanRange", "Releases a dedicated guest vlan range to the system", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewReleaseDedicatedGuestVlanRangeParams(c.string("id"))
+		return cs.VLAN.ReleaseDedicatedGuestVlanRange(p, opts...)
+	}
+	return c
+}
+
+func newListGuestVlansCommand() *command {
+	c := newCommand("listGuestVlans", "Lists all guest vlans", false)
+	c.flag("allocatedonly", &boolValue{}, "", false)
+	c.flag("id", &int64Value{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("vnet", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewListGuestVlansParams()
+		if c.isSet("allocatedonly") {
+			p.SetAllocatedonly(c.bool("allocatedonly"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.int64("id"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("vnet") {
+			p.SetVnet(c.string("vnet"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VLAN.ListGuestVlans(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVlanIpRangeCommand() *command {
+	c := newCommand("updateVlanIpRange", "Updates a VLAN IP range.", false)
+	c.flag("endip", &stringValue{}, "", false)
+	c.flag("endipv6", &stringValue{}, "", false)
+	c.flag("forsystemvms", &boolValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("ip6cidr", &stringValue{}, "", false)
+	c.flag("ip6gateway", &stringValue{}, "", false)
+	c.flag("netmask", &stringValue{}, "", false)
+	c.flag("startip", &stringValue{}, "", false)
+	c.flag("startipv6", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VLAN.NewUpdateVlanIpRangeParams(c.string("id"))
+		if c.isSet("endip") {
+			p.SetEndip(c.string("endip"))
+		}
+		if c.isSet("endipv6") {
+			p.SetEndipv6(c.string("endipv6"))
+		}
+		if c.isSet("forsystemvms") {
+			p.SetForsystemvms(c.bool("forsystemvms"))
+		}
+		if c.isSet("gateway") {
+			p.SetGateway(c.string("gateway"))
+		}
+		if c.isSet("ip6cidr") {
+			p.SetIp6cidr(c.string("ip6cidr"))
+		}
+		if c.isSet("ip6gateway") {
+			p.SetIp6gateway(c.string("ip6gateway"))
+		}
+		if c.isSet("netmask") {
+			p.SetNetmask(c.string("netmask"))
+		}
+		if c.isSet("startip") {
+			p.SetStartip(c.string("startip"))
+		}
+		if c.isSet("startipv6") {
+			p.SetStartipv6(c.string("startipv6"))
+		}
+		return cs.VLAN.UpdateVlanIpRange(p, opts...)
+	}
+	return c
+}
+
+func newCreateInstanceGroupCommand() *command {
+	c := newCommand("createInstanceGroup", "Creates a vm group", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VMGroup.NewCreateInstanceGroupParams(c.string("name"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.VMGroup.CreateInstanceGroup(p, opts...)
+	}
+	return c
+}
+
+func newDeleteInstanceGroupCommand() *command {
+	c := newCommand("deleteInstanceGroup", "Deletes a vm group", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VMGroup.NewDeleteInstanceGroupParams(c.string("id"))
+		return cs.VMGroup.DeleteInstanceGroup(p, opts...)
+	}
+	return c
+}
+
+func newListInstanceGroupsCommand() *command {
+	c := newCommand("listInstanceGroups", "Lists vm groups", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VMGroup.NewListInstanceGroupsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.VMGroup.ListInstanceGroups(p, opts...)
+	}
+	return c
+}
+
+func newUpdateInstanceGroupCommand() *command {
+	c := newCommand("updateInstanceGroup", "Updates a vm group", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VMGroup.NewUpdateInstanceGroupParams(c.string("id"))
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		return cs.VMGroup.UpdateInstanceGroup(p, opts...)
+	}
+	return c
+}
+
+func newCreatePrivateGatewayCommand() *command {
+	c := newCommand("createPrivateGateway", "Creates a private gateway", true)
+	c.flag("aclid", &stringValue{}, "", false)
+	c.flag("associatednetworkid", &stringValue{}, "", false)
+	c.flag("bypassvlanoverlapcheck", &boolValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", true)
+	c.flag("ipaddress", &stringValue{}, "", true)
+	c.flag("netmask", &stringValue{}, "", true)
+	c.flag("networkofferingid", &stringValue{}, "", false)
+	c.flag("physicalnetworkid", &stringValue{}, "", false)
+	c.flag("sourcenatsupported", &boolValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewCreatePrivateGatewayParams(c.string("gateway"), c.string("ipaddress"), c.string("netmask"), c.string("vpcid"))
+		if c.isSet("aclid") {
+			p.SetAclid(c.string("aclid"))
+		}
+		if c.isSet("associatednetworkid") {
+			p.SetAssociatednetworkid(c.string("associatednetworkid"))
+		}
+		if c.isSet("bypassvlanoverlapcheck") {
+			p.SetBypassvlanoverlapcheck(c.bool("bypassvlanoverlapcheck"))
+		}
+		if c.isSet("networkofferingid") {
+			p.SetNetworkofferingid(c.string("networkofferingid"))
+		}
+		if c.isSet("physicalnetworkid") {
+			p.SetPhysicalnetworkid(c.string("physicalnetworkid"))
+		}
+		if c.isSet("sourcenatsupported") {
+			p.SetSourcenatsupported(c.bool("sourcenatsupported"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		return cs.VPC.CreatePrivateGateway(p, opts...)
+	}
+	return c
+}
+
+func newCreateStaticRouteCommand() *command {
+	c := newCommand("createStaticRoute", "Creates a static route", true)
+	c.flag("cidr", &stringValue{}, "", true)
+	c.flag("gatewayid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewCreateStaticRouteParams(c.string("cidr"), c.string("gatewayid"))
+		return cs.VPC.CreateStaticRoute(p, opts...)
+	}
+	return c
+}
+
+func newCreateVPCCommand() *command {
+	c := newCommand("createVPC", "Creates a VPC", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("cidr", &stringValue{}, "", true)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("dns1", &stringValue{}, "", false)
+	c.flag("dns2", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("ip6dns1", &stringValue{}, "", false)
+	c.flag("ip6dns2", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networkdomain", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("publicmtu", &intValue{}, "", false)
+	c.flag("sourcenatipaddress", &stringValue{}, "", false)
+	c.flag("start", &boolValue{}, "", false)
+	c.flag("vpcofferingid", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewCreateVPCParams(c.string("cidr"), c.string("displaytext"), c.string("name"), c.string("vpcofferingid"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("dns1") {
+			p.SetDns1(c.string("dns1"))
+		}
+		if c.isSet("dns2") {
+			p.SetDns2(c.string("dns2"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("ip6dns1") {
+			p.SetIp6dns1(c.string("ip6dns1"))
+		}
+		if c.isSet("ip6dns2") {
+			p.SetIp6dns2(c.string("ip6dns2"))
+		}
+		if c.isSet("networkdomain") {
+			p.SetNetworkdomain(c.string("networkdomain"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("publicmtu") {
+			p.SetPublicmtu(c.int("publicmtu"))
+		}
+		if c.isSet("sourcenatipaddress") {
+			p.SetSourcenatipaddress(c.string("sourcenatipaddress"))
+		}
+		if c.isSet("start") {
+			p.SetStart(c.bool("start"))
+		}
+		return cs.VPC.CreateVPC(p, opts...)
+	}
+	return c
+}
+
+func newCreateVPCOfferingCommand() *command {
+	c := newCommand("createVPCOffering", "Creates VPC offering", true)
+	c.flag("displaytext", &stringValue{}, "", true)
+	c.flag("domainid", &stringsValue{}, "", false)
+	c.flag("enable", &boolValue{}, "", false)
+	c.flag("internetprotocol", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("servicecapabilitylist", &mapValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", false)
+	c.flag("serviceproviderlist", &mapValue{}, "", false)
+	c.flag("supportedservices", &stringsValue{}, "", true)
+	c.flag("zoneid", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewCreateVPCOfferingParams(c.string("displaytext"), c.string("name"), c.strings("supportedservices"))
+		if c.isSet("domainid") {
+			p.SetDomainid(c.strings("domainid"))
+		}
+		if c.isSet("enable") {
+			p.SetEnable(c.bool("enable"))
+		}
+		if c.isSet("internetprotocol") {
+			p.SetInternetprotocol(c.string("internetprotocol"))
+		}
+		if c.isSet("servicecapabilitylist") {
+			p.SetServicecapabilitylist(c.stringMap("servicecapabilitylist"))
+		}
+		if c.isSet("serviceofferingid") {
+			p.SetServiceofferingid(c.string("serviceofferingid"))
+		}
+		if c.isSet("serviceproviderlist") {
+			p.SetServiceproviderlist(c.stringMap("serviceproviderlist"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.strings("zoneid"))
+		}
+		return cs.VPC.CreateVPCOffering(p, opts...)
+	}
+	return c
+}
+
+func newDeletePrivateGatewayCommand() *command {
+	c := newCommand("deletePrivateGateway", "Deletes a Private gateway", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewDeletePrivateGatewayParams(c.string("id"))
+		return cs.VPC.DeletePrivateGateway(p, opts...)
+	}
+	return c
+}
+
+func newDeleteStaticRouteCommand() *command {
+	c := newCommand("deleteStaticRoute", "Deletes a static route", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewDeleteStaticRouteParams(c.string("id"))
+		return cs.VPC.DeleteStaticRoute(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVPCCommand() *command {
+	c := newCommand("deleteVPC", "Deletes a VPC", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewDeleteVPCParams(c.string("id"))
+		return cs.VPC.DeleteVPC(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVPCOfferingCommand() *command {
+	c := newCommand("deleteVPCOffering", "Deletes VPC offering", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewDeleteVPCOfferingParams(c.string("id"))
+		return cs.VPC.DeleteVPCOffering(p, opts...)
+	}
+	return c
+}
+
+func newListPrivateGatewaysCommand() *command {
+	c := newCommand("listPrivateGateways", "List private gateways", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("vlan", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewListPrivateGatewaysParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("vlan") {
+			p.SetVlan(c.string("vlan"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		return cs.VPC.ListPrivateGateways(p, opts...)
+	}
+	return c
+}
+
+func newListStaticRoutesCommand() *command {
+	c := newCommand("listStaticRoutes", "Lists all static routes", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("gatewayid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewListStaticRoutesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("gatewayid") {
+			p.SetGatewayid(c.string("gatewayid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		return cs.VPC.ListStaticRoutes(p, opts...)
+	}
+	return c
+}
+
+func newListVPCOfferingsCommand() *command {
+	c := newCommand("listVPCOfferings", "Lists VPC offerings", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isdefault", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("supportedservices", &stringsValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewListVPCOfferingsParams()
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isdefault") {
+			p.SetIsdefault(c.bool("isdefault"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("supportedservices") {
+			p.SetSupportedservices(c.strings("supportedservices"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VPC.ListVPCOfferings(p, opts...)
+	}
+	return c
+}
+
+func newListVPCsCommand() *command {
+	c := newCommand("listVPCs", "Lists VPCs", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("cidr", &stringValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("restartrequired", &boolValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("supportedservices", &stringsValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("vpcofferingid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewListVPCsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("cidr") {
+			p.SetCidr(c.string("cidr"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("restartrequired") {
+			p.SetRestartrequired(c.bool("restartrequired"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("supportedservices") {
+			p.SetSupportedservices(c.strings("supportedservices"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("vpcofferingid") {
+			p.SetVpcofferingid(c.string("vpcofferingid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VPC.ListVPCs(p, opts...)
+	}
+	return c
+}
+
+func newRestartVPCCommand() *command {
+	c := newCommand("restartVPC", "Restarts a VPC", true)
+	c.flag("cleanup", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("livepatch", &boolValue{}, "", false)
+	c.flag("makeredundant", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewRestartVPCParams(c.string("id"))
+		if c.isSet("cleanup") {
+			p.SetCleanup(c.bool("cleanup"))
+		}
+		if c.isSet("livepatch") {
+			p.SetLivepatch(c.bool("livepatch"))
+		}
+		if c.isSet("makeredundant") {
+			p.SetMakeredundant(c.bool("makeredundant"))
+		}
+		return cs.VPC.RestartVPC(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVPCCommand() *command {
+	c := newCommand("updateVPC", "Updates a VPC", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("publicmtu", &intValue{}, "", false)
+	c.flag("sourcenatipaddress", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewUpdateVPCParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("publicmtu") {
+			p.SetPublicmtu(c.int("publicmtu"))
+		}
+		if c.isSet("sourcenatipaddress") {
+			p.SetSourcenatipaddress(c.string("sourcenatipaddress"))
+		}
+		return cs.VPC.UpdateVPC(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVPCOfferingCommand() *command {
+	c := newCommand("updateVPCOffering", "Updates VPC offering", true)
+	c.flag("displaytext", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("sortkey", &intValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPC.NewUpdateVPCOfferingParams(c.string("id"))
+		if c.isSet("displaytext") {
+			p.SetDisplaytext(c.string("displaytext"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("sortkey") {
+			p.SetSortkey(c.int("sortkey"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VPC.UpdateVPCOffering(p, opts...)
+	}
+	return c
+}
+
+func newAddVpnUserCommand() *command {
+	c := newCommand("addVpnUser", "Adds vpn users", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewAddVpnUserParams(c.string("password"), c.string("username"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.VPN.AddVpnUser(p, opts...)
+	}
+	return c
+}
+
+func newCreateRemoteAccessVpnCommand() *command {
+	c := newCommand("createRemoteAccessVpn", "Creates a l2tp/ipsec remote access vpn", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("iprange", &stringValue{}, "", false)
+	c.flag("openfirewall", &boolValue{}, "", false)
+	c.flag("publicipid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewCreateRemoteAccessVpnParams(c.string("publicipid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("iprange") {
+			p.SetIprange(c.string("iprange"))
+		}
+		if c.isSet("openfirewall") {
+			p.SetOpenfirewall(c.bool("openfirewall"))
+		}
+		return cs.VPN.CreateRemoteAccessVpn(p, opts...)
+	}
+	return c
+}
+
+func newCreateVpnConnectionCommand() *command {
+	c := newCommand("createVpnConnection", "Create site to site vpn connection", true)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("passive", &boolValue{}, "", false)
+	c.flag("s2scustomergatewayid", &stringValue{}, "", true)
+	c.flag("s2svpngatewayid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewCreateVpnConnectionParams(c.string("s2scustomergatewayid"), c.string("s2svpngatewayid"))
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("passive") {
+			p.SetPassive(c.bool("passive"))
+		}
+		return cs.VPN.CreateVpnConnection(p, opts...)
+	}
+	return c
+}
+
+func newCreateVpnCustomerGatewayCommand() *command {
+	c := newCommand("createVpnCustomerGateway", "Creates site to site vpn customer gateway", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("cidrlist", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("dpd", &boolValue{}, "", false)
+	c.flag("esplifetime", &int64Value{}, "", false)
+	c.flag("esppolicy", &stringValue{}, "", true)
+	c.flag("forceencap", &boolValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", true)
+	c.flag("ikelifetime", &int64Value{}, "", false)
+	c.flag("ikepolicy", &stringValue{}, "", true)
+	c.flag("ikeversion", &stringValue{}, "", false)
+	c.flag("ipsecpsk", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("splitconnections", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewCreateVpnCustomerGatewayParams(c.string("cidrlist"), c.string("esppolicy"), c.string("gateway"), c.string("ikepolicy"), c.string("ipsecpsk"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("dpd") {
+			p.SetDpd(c.bool("dpd"))
+		}
+		if c.isSet("esplifetime") {
+			p.SetEsplifetime(c.int64("esplifetime"))
+		}
+		if c.isSet("forceencap") {
+			p.SetForceencap(c.bool("forceencap"))
+		}
+		if c.isSet("ikelifetime") {
+			p.SetIkelifetime(c.int64("ikelifetime"))
+		}
+		if c.isSet("ikeversion") {
+			p.SetIkeversion(c.string("ikeversion"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("splitconnections") {
+			p.SetSplitconnections(c.bool("splitconnections"))
+		}
+		return cs.VPN.CreateVpnCustomerGateway(p, opts...)
+	}
+	return c
+}
+
+func newCreateVpnGatewayCommand() *command {
+	c := newCommand("createVpnGateway", "Creates site to site vpn local gateway", true)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewCreateVpnGatewayParams(c.string("vpcid"))
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.VPN.CreateVpnGateway(p, opts...)
+	}
+	return c
+}
+
+func newDeleteRemoteAccessVpnCommand() *command {
+	c := newCommand("deleteRemoteAccessVpn", "Destroys a l2tp/ipsec remote access vpn", true)
+	c.flag("publicipid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewDeleteRemoteAccessVpnParams(c.string("publicipid"))
+		return cs.VPN.DeleteRemoteAccessVpn(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVpnConnectionCommand() *command {
+	c := newCommand("deleteVpnConnection", "Delete site to site vpn connection", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewDeleteVpnConnectionParams(c.string("id"))
+		return cs.VPN.DeleteVpnConnection(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVpnCustomerGatewayCommand() *command {
+	c := newCommand("deleteVpnCustomerGateway", "Delete site to site vpn customer gateway", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewDeleteVpnCustomerGatewayParams(c.string("id"))
+		return cs.VPN.DeleteVpnCustomerGateway(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVpnGatewayCommand() *command {
+	c := newCommand("deleteVpnGateway", "Delete site to site vpn gateway", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewDeleteVpnGatewayParams(c.string("id"))
+		return cs.VPN.DeleteVpnGateway(p, opts...)
+	}
+	return c
+}
+
+func newListRemoteAccessVpnsCommand() *command {
+	c := newCommand("listRemoteAccessVpns", "Lists remote access vpns", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("publicipid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewListRemoteAccessVpnsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("publicipid") {
+			p.SetPublicipid(c.string("publicipid"))
+		}
+		return cs.VPN.ListRemoteAccessVpns(p, opts...)
+	}
+	return c
+}
+
+func newListVpnConnectionsCommand() *command {
+	c := newCommand("listVpnConnections", "Lists site to site vpn connection gateways", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewListVpnConnectionsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		return cs.VPN.ListVpnConnections(p, opts...)
+	}
+	return c
+}
+
+func newListVpnCustomerGatewaysCommand() *command {
+	c := newCommand("listVpnCustomerGateways", "Lists site to site vpn customer gateways", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewListVpnCustomerGatewaysParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.VPN.ListVpnCustomerGateways(p, opts...)
+	}
+	return c
+}
+
+func newListVpnGatewaysCommand() *command {
+	c := newCommand("listVpnGateways", "Lists site 2 site vpn gateways", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewListVpnGatewaysParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		return cs.VPN.ListVpnGateways(p, opts...)
+	}
+	return c
+}
+
+func newListVpnUsersCommand() *command {
+	c := newCommand("listVpnUsers", "Lists vpn users", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewListVpnUsersParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("username") {
+			p.SetUsername(c.string("username"))
+		}
+		return cs.VPN.ListVpnUsers(p, opts...)
+	}
+	return c
+}
+
+func newRemoveVpnUserCommand() *command {
+	c := newCommand("removeVpnUser", "Removes vpn user", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("username", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewRemoveVpnUserParams(c.string("username"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.VPN.RemoveVpnUser(p, opts...)
+	}
+	return c
+}
+
+func newResetVpnConnectionCommand() *command {
+	c := newCommand("resetVpnConnection", "Reset site to site vpn connection", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewResetVpnConnectionParams(c.string("id"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		return cs.VPN.ResetVpnConnection(p, opts...)
+	}
+	return c
+}
+
+func newUpdateRemoteAccessVpnCommand() *command {
+	c := newCommand("updateRemoteAccessVpn", "Updates remote access vpn", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewUpdateRemoteAccessVpnParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.VPN.UpdateRemoteAccessVpn(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVpnConnectionCommand() *command {
+	c := newCommand("updateVpnConnection", "Updates site to site vpn connection", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewUpdateVpnConnectionParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.VPN.UpdateVpnConnection(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVpnCustomerGatewayCommand() *command {
+	c := newCommand("updateVpnCustomerGateway", "Update site to site vpn customer gateway", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("cidrlist", &stringValue{}, "", true)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("dpd", &boolValue{}, "", false)
+	c.flag("esplifetime", &int64Value{}, "", false)
+	c.flag("esppolicy", &stringValue{}, "", true)
+	c.flag("forceencap", &boolValue{}, "", false)
+	c.flag("gateway", &stringValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("ikelifetime", &int64Value{}, "", false)
+	c.flag("ikepolicy", &stringValue{}, "", true)
+	c.flag("ikeversion", &stringValue{}, "", false)
+	c.flag("ipsecpsk", &stringValue{}, "", true)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("splitconnections", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewUpdateVpnCustomerGatewayParams(c.string("cidrlist"), c.string("esppolicy"), c.string("gateway"), c.string("id"), c.string("ikepolicy"), c.string("ipsecpsk"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("dpd") {
+			p.SetDpd(c.bool("dpd"))
+		}
+		if c.isSet("esplifetime") {
+			p.SetEsplifetime(c.int64("esplifetime"))
+		}
+		if c.isSet("forceencap") {
+			p.SetForceencap(c.bool("forceencap"))
+		}
+		if c.isSet("ikelifetime") {
+			p.SetIkelifetime(c.int64("ikelifetime"))
+		}
+		if c.isSet("ikeversion") {
+			p.SetIkeversion(c.string("ikeversion"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("splitconnections") {
+			p.SetSplitconnections(c.bool("splitconnections"))
+		}
+		return cs.VPN.UpdateVpnCustomerGateway(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVpnGatewayCommand() *command {
+	c := newCommand("updateVpnGateway", "Updates site to site vpn local gateway", true)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("fordisplay", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VPN.NewUpdateVpnGatewayParams(c.string("id"))
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("fordisplay") {
+			p.SetFordisplay(c.bool("fordisplay"))
+		}
+		return cs.VPN.UpdateVpnGateway(p, opts...)
+	}
+	return c
+}
+
+func newAddNicToVirtualMachineCommand() *command {
+	c := newCommand("addNicToVirtualMachine", "Adds VM to specified network by creating a NIC", true)
+	c.flag("dhcpoptions", &mapValue{}, "", false)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("macaddress", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewAddNicToVirtualMachineParams(c.string("networkid"), c.string("virtualmachineid"))
+		if c.isSet("dhcpoptions") {
+			p.SetDhcpoptions(c.stringMap("dhcpoptions"))
+		}
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		if c.isSet("macaddress") {
+			p.SetMacaddress(c.string("macaddress"))
+		}
+		return cs.VirtualMachine.AddNicToVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newAssignVirtualMachineCommand() *command {
+	c := newCommand("assignVirtualMachine", "Change ownership of a VM from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign a VM from any account to any other account in any domain. A domain administrator can reassign a VM to any account in the same domain.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("networkids", &stringsValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("securitygroupids", &stringsValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewAssignVirtualMachineParams(c.string("virtualmachineid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("networkids") {
+			p.SetNetworkids(c.strings("networkids"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("securitygroupids") {
+			p.SetSecuritygroupids(c.strings("securitygroupids"))
+		}
+		return cs.VirtualMachine.AssignVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newChangeServiceForVirtualMachineCommand() *command {
+	c := newCommand("changeServiceForVirtualMachine", "(This API is deprecated, use scaleVirtualMachine API)Changes the service offering for a virtual machine. The virtual machine must be in a \"Stopped\" state for this command to take effect.", false)
+	c.flag("automigrate", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("maxiops", &int64Value{}, "", false)
+	c.flag("miniops", &int64Value{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.flag("shrinkok", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewChangeServiceForVirtualMachineParams(c.string("id"), c.string("serviceofferingid"))
+		if c.isSet("automigrate") {
+			p.SetAutomigrate(c.bool("automigrate"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("maxiops") {
+			p.SetMaxiops(c.int64("maxiops"))
+		}
+		if c.isSet("miniops") {
+			p.SetMiniops(c.int64("miniops"))
+		}
+		if c.isSet("shrinkok") {
+			p.SetShrinkok(c.bool("shrinkok"))
+		}
+		return cs.VirtualMachine.ChangeServiceForVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newCleanVMReservationsCommand() *command {
+	c := newCommand("cleanVMReservations", "Cleanups VM reservations in the database.", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewCleanVMReservationsParams()
+		return cs.VirtualMachine.CleanVMReservations(p, opts...)
+	}
+	return c
+}
+
+func newDeployVirtualMachineCommand() *command {
+	c := newCommand("deployVirtualMachine", "Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("affinitygroupids", &stringsValue{}, "", false)
+	c.flag("affinitygroupnames", &stringsValue{}, "", false)
+	c.flag("bootintosetup", &boolValue{}, "", false)
+	c.flag("bootmode", &stringValue{}, "", false)
+	c.flag("boottype", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("copyimagetags", &boolValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("datadiskofferinglist", &mapValue{}, "", false)
+	c.flag("deploymentplanner", &stringValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("dhcpoptionsnetworklist", &mapsValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("displayname", &stringValue{}, "", false)
+	c.flag("displayvm", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("dynamicscalingenabled", &boolValue{}, "", false)
+	c.flag("extraconfig", &stringValue{}, "", false)
+	c.flag("group", &stringValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("iodriverpolicy", &stringValue{}, "", false)
+	c.flag("iothreadsenabled", &boolValue{}, "", false)
+	c.flag("ip6address", &stringValue{}, "", false)
+	c.flag("ipaddress", &stringValue{}, "", false)
+	c.flag("iptonetworklist", &mapsValue{}, "", false)
+	c.flag("keyboard", &stringValue{}, "", false)
+	c.flag("keypair", &stringValue{}, "", false)
+	c.flag("keypairs", &stringsValue{}, "", false)
+	c.flag("macaddress", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkids", &stringsValue{}, "", false)
+	c.flag("nicmultiqueuenumber", &intValue{}, "", false)
+	c.flag("nicnetworklist", &mapsValue{}, "", false)
+	c.flag("nicpackedvirtqueuesenabled", &boolValue{}, "", false)
+	c.flag("overridediskofferingid", &stringValue{}, "", false)
+	c.flag("password", &stringValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("properties", &mapValue{}, "", false)
+	c.flag("rootdisksize", &int64Value{}, "", false)
+	c.flag("securitygroupids", &stringsValue{}, "", false)
+	c.flag("securitygroupnames", &stringsValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.flag("size", &int64Value{}, "", false)
+	c.flag("startvm", &boolValue{}, "", false)
+	c.flag("templateid", &stringValue{}, "", true)
+	c.flag("userdata", &stringValue{}, "", false)
+	c.flag("userdatadetails", &mapValue{}, "", false)
+	c.flag("userdataid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewDeployVirtualMachineParams(c.string("serviceofferingid"), c.string("templateid"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("affinitygroupids") {
+			p.SetAffinitygroupids(c.strings("affinitygroupids"))
+		}
+		if c.isSet("affinitygroupnames") {
+			p.SetAffinitygroupnames(c.strings("affinitygroupnames"))
+		}
+		if c.isSet("bootintosetup") {
+			p.SetBootintosetup(c.bool("bootintosetup"))
+		}
+		if c.isSet("bootmode") {
+			p.SetBootmode(c.string("bootmode"))
+		}
+		if c.isSet("boottype") {
+			p.SetBoottype(c.string("boottype"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("copyimagetags") {
+			p.SetCopyimagetags(c.bool("copyimagetags"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("datadiskofferinglist") {
+			p.SetDatadiskofferinglist(c.stringMap("datadiskofferinglist"))
+		}
+		if c.isSet("deploymentplanner") {
+			p.SetDeploymentplanner(c.string("deploymentplanner"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("dhcpoptionsnetworklist") {
+			p.SetDhcpoptionsnetworklist(c.stringMaps("dhcpoptionsnetworklist"))
+		}
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("displayname") {
+			p.SetDisplayname(c.string("displayname"))
+		}
+		if c.isSet("displayvm") {
+			p.SetDisplayvm(c.bool("displayvm"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("dynamicscalingenabled") {
+			p.SetDynamicscalingenabled(c.bool("dynamicscalingenabled"))
+		}
+		if c.isSet("extraconfig") {
+			p.SetExtraconfig(c.string("extraconfig"))
+		}
+		if c.isSet("group") {
+			p.SetGroup(c.string("group"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("iodriverpolicy") {
+			p.SetIodriverpolicy(c.string("iodriverpolicy"))
+		}
+		if c.isSet("iothreadsenabled") {
+			p.SetIothreadsenabled(c.bool("iothreadsenabled"))
+		}
+		if c.isSet("ip6address") {
+			p.SetIp6address(c.string("ip6address"))
+		}
+		if c.isSet("ipaddress") {
+			p.SetIpaddress(c.string("ipaddress"))
+		}
+		if c.isSet("iptonetworklist") {
+			p.SetIptonetworklist(c.stringMaps("iptonetworklist"))
+		}
+		if c.isSet("keyboard") {
+			p.SetKeyboard(c.string("keyboard"))
+		}
+		if c.isSet("keypair") {
+			p.SetKeypair(c.string("keypair"))
+		}
+		if c.isSet("keypairs") {
+			p.SetKeypairs(c.strings("keypairs"))
+		}
+		if c.isSet("macaddress") {
+			p.SetMacaddress(c.string("macaddress"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkids") {
+			p.SetNetworkids(c.strings("networkids"))
+		}
+		if c.isSet("nicmultiqueuenumber") {
+			p.SetNicmultiqueuenumber(c.int("nicmultiqueuenumber"))
+		}
+		if c.isSet("nicnetworklist") {
+			p.SetNicnetworklist(c.stringMaps("nicnetworklist"))
+		}
+		if c.isSet("nicpackedvirtqueuesenabled") {
+			p.SetNicpackedvirtqueuesenabled(c.bool("nicpackedvirtqueuesenabled"))
+		}
+		if c.isSet("overridediskofferingid") {
+			p.SetOverridediskofferingid(c.string("overridediskofferingid"))
+		}
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("properties") {
+			p.SetProperties(c.stringMap("properties"))
+		}
+		if c.isSet("rootdisksize") {
+			p.SetRootdisksize(c.int64("rootdisksize"))
+		}
+		if c.isSet("securitygroupids") {
+			p.SetSecuritygroupids(c.strings("securitygroupids"))
+		}
+		if c.isSet("securitygroupnames") {
+			p.SetSecuritygroupnames(c.strings("securitygroupnames"))
+		}
+		if c.isSet("size") {
+			p.SetSize(c.int64("size"))
+		}
+		if c.isSet("startvm") {
+			p.SetStartvm(c.bool("startvm"))
+		}
+		if c.isSet("userdata") {
+			p.SetUserdata(c.string("userdata"))
+		}
+		if c.isSet("userdatadetails") {
+			p.SetUserdatadetails(c.stringMap("userdatadetails"))
+		}
+		if c.isSet("userdataid") {
+			p.SetUserdataid(c.string("userdataid"))
+		}
+		return cs.VirtualMachine.DeployVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newDestroyVirtualMachineCommand() *command {
+	c := newCommand("destroyVirtualMachine", "Destroys a virtual machine. Once destroyed, only the administrator can recover it.", true)
+	c.flag("expunge", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("volumeids", &stringsValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewDestroyVirtualMachineParams(c.string("id"))
+		if c.isSet("expunge") {
+			p.SetExpunge(c.bool("expunge"))
+		}
+		if c.isSet("volumeids") {
+			p.SetVolumeids(c.strings("volumeids"))
+		}
+		return cs.VirtualMachine.DestroyVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newExpungeVirtualMachineCommand() *command {
+	c := newCommand("expungeVirtualMachine", "Expunge a virtual machine. Once expunged, it cannot be recoverd.", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewExpungeVirtualMachineParams(c.string("id"))
+		return cs.VirtualMachine.ExpungeVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newGetVMPasswordCommand() *command {
+	c := newCommand("getVMPassword", "Returns an encrypted password for the VM", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewGetVMPasswordParams(c.string("id"))
+		return cs.VirtualMachine.GetVMPassword(p, opts...)
+	}
+	return c
+}
+
+func newListVirtualMachinesCommand() *command {
+	c := newCommand("listVirtualMachines", "List the virtual machines owned by the account.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accumulate", &boolValue{}, "", false)
+	c.flag("affinitygroupid", &stringValue{}, "", false)
+	c.flag("autoscalevmgroupid", &stringValue{}, "", false)
+	c.flag("backupofferingid", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("displayvm", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("forvirtualnetwork", &boolValue{}, "", false)
+	c.flag("groupid", &stringValue{}, "", false)
+	c.flag("haenable", &boolValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("isoid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("isvnf", &boolValue{}, "", false)
+	c.flag("keypair", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("retrieveonlyresourcecount", &boolValue{}, "", false)
+	c.flag("securitygroupid", &stringValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("templateid", &stringValue{}, "", false)
+	c.flag("userdata", &boolValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewListVirtualMachinesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accumulate") {
+			p.SetAccumulate(c.bool("accumulate"))
+		}
+		if c.isSet("affinitygroupid") {
+			p.SetAffinitygroupid(c.string("affinitygroupid"))
+		}
+		if c.isSet("autoscalevmgroupid") {
+			p.SetAutoscalevmgroupid(c.string("autoscalevmgroupid"))
+		}
+		if c.isSet("backupofferingid") {
+			p.SetBackupofferingid(c.string("backupofferingid"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("displayvm") {
+			p.SetDisplayvm(c.bool("displayvm"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("forvirtualnetwork") {
+			p.SetForvirtualnetwork(c.bool("forvirtualnetwork"))
+		}
+		if c.isSet("groupid") {
+			p.SetGroupid(c.string("groupid"))
+		}
+		if c.isSet("haenable") {
+			p.SetHaenable(c.bool("haenable"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("isoid") {
+			p.SetIsoid(c.string("isoid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("isvnf") {
+			p.SetIsvnf(c.bool("isvnf"))
+		}
+		if c.isSet("keypair") {
+			p.SetKeypair(c.string("keypair"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("retrieveonlyresourcecount") {
+			p.SetRetrieveonlyresourcecount(c.bool("retrieveonlyresourcecount"))
+		}
+		if c.isSet("securitygroupid") {
+			p.SetSecuritygroupid(c.string("securitygroupid"))
+		}
+		if c.isSet("serviceofferingid") {
+			p.SetServiceofferingid(c.string("serviceofferingid"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("templateid") {
+			p.SetTemplateid(c.string("templateid"))
+		}
+		if c.isSet("userdata") {
+			p.SetUserdata(c.bool("userdata"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VirtualMachine.ListVirtualMachines(p, opts...)
+	}
+	return c
+}
+
+func newListVirtualMachinesMetricsCommand() *command {
+	c := newCommand("listVirtualMachinesMetrics", "Lists VM metrics", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("accumulate", &boolValue{}, "", false)
+	c.flag("affinitygroupid", &stringValue{}, "", false)
+	c.flag("autoscalevmgroupid", &stringValue{}, "", false)
+	c.flag("backupofferingid", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("details", &stringsValue{}, "", false)
+	c.flag("displayvm", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("forvirtualnetwork", &boolValue{}, "", false)
+	c.flag("groupid", &stringValue{}, "", false)
+	c.flag("haenable", &boolValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("hypervisor", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("isoid", &stringValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("isvnf", &boolValue{}, "", false)
+	c.flag("keypair", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networkid", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("retrieveonlyresourcecount", &boolValue{}, "", false)
+	c.flag("securitygroupid", &stringValue{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("templateid", &stringValue{}, "", false)
+	c.flag("userdata", &boolValue{}, "", false)
+	c.flag("userid", &stringValue{}, "", false)
+	c.flag("vpcid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewListVirtualMachinesMetricsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("accumulate") {
+			p.SetAccumulate(c.bool("accumulate"))
+		}
+		if c.isSet("affinitygroupid") {
+			p.SetAffinitygroupid(c.string("affinitygroupid"))
+		}
+		if c.isSet("autoscalevmgroupid") {
+			p.SetAutoscalevmgroupid(c.string("autoscalevmgroupid"))
+		}
+		if c.isSet("backupofferingid") {
+			p.SetBackupofferingid(c.string("backupofferingid"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.strings("details"))
+		}
+		if c.isSet("displayvm") {
+			p.SetDisplayvm(c.bool("displayvm"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("forvirtualnetwork") {
+			p.SetForvirtualnetwork(c.bool("forvirtualnetwork"))
+		}
+		if c.isSet("groupid") {
+			p.SetGroupid(c.string("groupid"))
+		}
+		if c.isSet("haenable") {
+			p.SetHaenable(c.bool("haenable"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("hypervisor") {
+			p.SetHypervisor(c.string("hypervisor"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("isoid") {
+			p.SetIsoid(c.string("isoid"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("isvnf") {
+			p.SetIsvnf(c.bool("isvnf"))
+		}
+		if c.isSet("keypair") {
+			p.SetKeypair(c.string("keypair"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networkid") {
+			p.SetNetworkid(c.string("networkid"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("retrieveonlyresourcecount") {
+			p.SetRetrieveonlyresourcecount(c.bool("retrieveonlyresourcecount"))
+		}
+		if c.isSet("securitygroupid") {
+			p.SetSecuritygroupid(c.string("securitygroupid"))
+		}
+		if c.isSet("serviceofferingid") {
+			p.SetServiceofferingid(c.string("serviceofferingid"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("templateid") {
+			p.SetTemplateid(c.string("templateid"))
+		}
+		if c.isSet("userdata") {
+			p.SetUserdata(c.bool("userdata"))
+		}
+		if c.isSet("userid") {
+			p.SetUserid(c.string("userid"))
+		}
+		if c.isSet("vpcid") {
+			p.SetVpcid(c.string("vpcid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.VirtualMachine.ListVirtualMachinesMetrics(p, opts...)
+	}
+	return c
+}
+
+func newMigrateVirtualMachineCommand() *command {
+	c := newCommand("migrateVirtualMachine", "Attempts Migration of a VM to a different host or Root volume of the vm to a different storage pool", true)
+	c.flag("autoselect", &boolValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewMigrateVirtualMachineParams(c.string("virtualmachineid"))
+		if c.isSet("autoselect") {
+			p.SetAutoselect(c.bool("autoselect"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		return cs.VirtualMachine.MigrateVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newMigrateVirtualMachineWithVolumeCommand() *command {
+	c := newCommand("migrateVirtualMachineWithVolume", "Attempts Migration of a VM with its volumes to a different host", true)
+	c.flag("autoselect", &boolValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("migrateto", &mapsValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewMigrateVirtualMachineWithVolumeParams(c.string("virtualmachineid"))
+		if c.isSet("autoselect") {
+			p.SetAutoselect(c.bool("autoselect"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("migrateto") {
+			p.SetMigrateto(c.stringMaps("migrateto"))
+		}
+		return cs.VirtualMachine.MigrateVirtualMachineWithVolume(p, opts...)
+	}
+	return c
+}
+
+func newRebootVirtualMachineCommand() *command {
+	c := newCommand("rebootVirtualMachine", "Reboots a virtual machine.", true)
+	c.flag("bootintosetup", &boolValue{}, "", false)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewRebootVirtualMachineParams(c.string("id"))
+		if c.isSet("bootintosetup") {
+			p.SetBootintosetup(c.bool("bootintosetup"))
+		}
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.VirtualMachine.RebootVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newRecoverVirtualMachineCommand() *command {
+	c := newCommand("recoverVirtualMachine", "Recovers a virtual machine.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewRecoverVirtualMachineParams(c.string("id"))
+		return cs.VirtualMachine.RecoverVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newRemoveNicFromVirtualMachineCommand() *command {
+	c := newCommand("removeNicFromVirtualMachine", "Removes VM from specified network by deleting a NIC", true)
+	c.flag("nicid", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewRemoveNicFromVirtualMachineParams(c.string("nicid"), c.string("virtualmachineid"))
+		return cs.VirtualMachine.RemoveNicFromVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newResetPasswordForVirtualMachineCommand() *command {
+	c := newCommand("resetPasswordForVirtualMachine", "Resets the password for virtual machine. The virtual machine must be in a \"Stopped\" state and the template must already support this feature for this command to take effect. [async]", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("password", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewResetPasswordForVirtualMachineParams(c.string("id"))
+		if c.isSet("password") {
+			p.SetPassword(c.string("password"))
+		}
+		return cs.VirtualMachine.ResetPasswordForVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newResetUserDataForVirtualMachineCommand() *command {
+	c := newCommand("resetUserDataForVirtualMachine", "Resets the UserData for virtual machine. The virtual machine must be in a \"Stopped\" state.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("userdata", &stringValue{}, "", false)
+	c.flag("userdatadetails", &mapValue{}, "", false)
+	c.flag("userdataid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewResetUserDataForVirtualMachineParams(c.string("id"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("userdata") {
+			p.SetUserdata(c.string("userdata"))
+		}
+		if c.isSet("userdatadetails") {
+			p.SetUserdatadetails(c.stringMap("userdatadetails"))
+		}
+		if c.isSet("userdataid") {
+			p.SetUserdataid(c.string("userdataid"))
+		}
+		return cs.VirtualMachine.ResetUserDataForVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newRestoreVirtualMachineCommand() *command {
+	c := newCommand("restoreVirtualMachine", "Restore a VM to original template/ISO or new template/ISO", true)
+	c.flag("templateid", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewRestoreVirtualMachineParams(c.string("virtualmachineid"))
+		if c.isSet("templateid") {
+			p.SetTemplateid(c.string("templateid"))
+		}
+		return cs.VirtualMachine.RestoreVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newScaleVirtualMachineCommand() *command {
+	c := newCommand("scaleVirtualMachine", "Scales the virtual machine to a new service offering. This command also considers the volume size in the service offering or disk offering linked to the new service offering and apply all characteristics to the root volume.", true)
+	c.flag("automigrate", &boolValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("maxiops", &int64Value{}, "", false)
+	c.flag("miniops", &int64Value{}, "", false)
+	c.flag("serviceofferingid", &stringValue{}, "", true)
+	c.flag("shrinkok", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewScaleVirtualMachineParams(c.string("id"), c.string("serviceofferingid"))
+		if c.isSet("automigrate") {
+			p.SetAutomigrate(c.bool("automigrate"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("maxiops") {
+			p.SetMaxiops(c.int64("maxiops"))
+		}
+		if c.isSet("miniops") {
+			p.SetMiniops(c.int64("miniops"))
+		}
+		if c.isSet("shrinkok") {
+			p.SetShrinkok(c.bool("shrinkok"))
+		}
+		return cs.VirtualMachine.ScaleVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newStartVirtualMachineCommand() *command {
+	c := newCommand("startVirtualMachine", "Starts a virtual machine.", true)
+	c.flag("bootintosetup", &boolValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("considerlasthost", &boolValue{}, "", false)
+	c.flag("deploymentplanner", &stringValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("podid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewStartVirtualMachineParams(c.string("id"))
+		if c.isSet("bootintosetup") {
+			p.SetBootintosetup(c.bool("bootintosetup"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("considerlasthost") {
+			p.SetConsiderlasthost(c.bool("considerlasthost"))
+		}
+		if c.isSet("deploymentplanner") {
+			p.SetDeploymentplanner(c.string("deploymentplanner"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		return cs.VirtualMachine.StartVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newStopVirtualMachineCommand() *command {
+	c := newCommand("stopVirtualMachine", "Stops a virtual machine.", true)
+	c.flag("forced", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewStopVirtualMachineParams(c.string("id"))
+		if c.isSet("forced") {
+			p.SetForced(c.bool("forced"))
+		}
+		return cs.VirtualMachine.StopVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newUpdateDefaultNicForVirtualMachineCommand() *command {
+	c := newCommand("updateDefaultNicForVirtualMachine", "Changes the default NIC on a VM", true)
+	c.flag("nicid", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewUpdateDefaultNicForVirtualMachineParams(c.string("nicid"), c.string("virtualmachineid"))
+		return cs.VirtualMachine.UpdateDefaultNicForVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVirtualMachineCommand() *command {
+	c := newCommand("updateVirtualMachine", "Updates properties of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped. Therefore, stop the VM manually before issuing this call.", false)
+	c.flag("cleanupdetails", &boolValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("dhcpoptionsnetworklist", &mapsValue{}, "", false)
+	c.flag("displayname", &stringValue{}, "", false)
+	c.flag("displayvm", &boolValue{}, "", false)
+	c.flag("extraconfig", &stringValue{}, "", false)
+	c.flag("group", &stringValue{}, "", false)
+	c.flag("haenable", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("instancename", &stringValue{}, "", false)
+	c.flag("isdynamicallyscalable", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("ostypeid", &stringValue{}, "", false)
+	c.flag("securitygroupids", &stringsValue{}, "", false)
+	c.flag("securitygroupnames", &stringsValue{}, "", false)
+	c.flag("userdata", &stringValue{}, "", false)
+	c.flag("userdatadetails", &mapValue{}, "", false)
+	c.flag("userdataid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewUpdateVirtualMachineParams(c.string("id"))
+		if c.isSet("cleanupdetails") {
+			p.SetCleanupdetails(c.bool("cleanupdetails"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("dhcpoptionsnetworklist") {
+			p.SetDhcpoptionsnetworklist(c.stringMaps("dhcpoptionsnetworklist"))
+		}
+		if c.isSet("displayname") {
+			p.SetDisplayname(c.string("displayname"))
+		}
+		if c.isSet("displayvm") {
+			p.SetDisplayvm(c.bool("displayvm"))
+		}
+		if c.isSet("extraconfig") {
+			p.SetExtraconfig(c.string("extraconfig"))
+		}
+		if c.isSet("group") {
+			p.SetGroup(c.string("group"))
+		}
+		if c.isSet("haenable") {
+			p.SetHaenable(c.bool("haenable"))
+		}
+		if c.isSet("instancename") {
+			p.SetInstancename(c.string("instancename"))
+		}
+		if c.isSet("isdynamicallyscalable") {
+			p.SetIsdynamicallyscalable(c.bool("isdynamicallyscalable"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("ostypeid") {
+			p.SetOstypeid(c.string("ostypeid"))
+		}
+		if c.isSet("securitygroupids") {
+			p.SetSecuritygroupids(c.strings("securitygroupids"))
+		}
+		if c.isSet("securitygroupnames") {
+			p.SetSecuritygroupnames(c.strings("securitygroupnames"))
+		}
+		if c.isSet("userdata") {
+			p.SetUserdata(c.string("userdata"))
+		}
+		if c.isSet("userdatadetails") {
+			p.SetUserdatadetails(c.stringMap("userdatadetails"))
+		}
+		if c.isSet("userdataid") {
+			p.SetUserdataid(c.string("userdataid"))
+		}
+		return cs.VirtualMachine.UpdateVirtualMachine(p, opts...)
+	}
+	return c
+}
+
+func newListVirtualMachinesUsageHistoryCommand() *command {
+	c := newCommand("listVirtualMachinesUsageHistory", "Lists VM stats", false)
+	c.flag("enddate", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("startdate", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.VirtualMachine.NewListVirtualMachinesUsageHistoryParams()
+		if c.isSet("enddate") {
+			p.SetEnddate(c.string("enddate"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("startdate") {
+			p.SetStartdate(c.string("startdate"))
+		}
+		return cs.VirtualMachine.ListVirtualMachinesUsageHistory(p, opts...)
+	}
+	return c
+}
+
+func newAttachVolumeCommand() *command {
+	c := newCommand("attachVolume", "Attaches a disk volume to a virtual machine.", true)
+	c.flag("deviceid", &int64Value{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("virtualmachineid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewAttachVolumeParams(c.string("id"), c.string("virtualmachineid"))
+		if c.isSet("deviceid") {
+			p.SetDeviceid(c.int64("deviceid"))
+		}
+		return cs.Volume.AttachVolume(p, opts...)
+	}
+	return c
+}
+
+func newCreateVolumeCommand() *command {
+	c := newCommand("createVolume", "Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("displayvolume", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("maxiops", &int64Value{}, "", false)
+	c.flag("miniops", &int64Value{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("size", &int64Value{}, "", false)
+	c.flag("snapshotid", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewCreateVolumeParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("displayvolume") {
+			p.SetDisplayvolume(c.bool("displayvolume"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("maxiops") {
+			p.SetMaxiops(c.int64("maxiops"))
+		}
+		if c.isSet("miniops") {
+			p.SetMiniops(c.int64("miniops"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("size") {
+			p.SetSize(c.int64("size"))
+		}
+		if c.isSet("snapshotid") {
+			p.SetSnapshotid(c.string("snapshotid"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Volume.CreateVolume(p, opts...)
+	}
+	return c
+}
+
+func newDeleteVolumeCommand() *command {
+	c := newCommand("deleteVolume", "Deletes a detached disk volume.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewDeleteVolumeParams(c.string("id"))
+		return cs.Volume.DeleteVolume(p, opts...)
+	}
+	return c
+}
+
+func newDestroyVolumeCommand() *command {
+	c := newCommand("destroyVolume", "Destroys a Volume.", true)
+	c.flag("expunge", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewDestroyVolumeParams(c.string("id"))
+		if c.isSet("expunge") {
+			p.SetExpunge(c.bool("expunge"))
+		}
+		return cs.Volume.DestroyVolume(p, opts...)
+	}
+	return c
+}
+
+func newDetachVolumeCommand() *command {
+	c := newCommand("detachVolume", "Detaches a disk volume from a virtual machine.", true)
+	c.flag("deviceid", &int64Value{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewDetachVolumeParams()
+		if c.isSet("deviceid") {
+			p.SetDeviceid(c.int64("deviceid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		return cs.Volume.DetachVolume(p, opts...)
+	}
+	return c
+}
+
+func newExtractVolumeCommand() *command {
+	c := newCommand("extractVolume", "Extracts volume", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("mode", &stringValue{}, "", true)
+	c.flag("url", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewExtractVolumeParams(c.string("id"), c.string("mode"), c.string("zoneid"))
+		if c.isSet("url") {
+			p.SetUrl(c.string("url"))
+		}
+		return cs.Volume.ExtractVolume(p, opts...)
+	}
+	return c
+}
+
+func newGetPathForVolumeCommand() *command {
+	c := newCommand("getPathForVolume", "Get the path associated with the provided volume UUID", false)
+	c.flag("volumeid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewGetPathForVolumeParams(c.string("volumeid"))
+		return cs.Volume.GetPathForVolume(p, opts...)
+	}
+	return c
+}
+
+func newGetSolidFireVolumeSizeCommand() *command {
+	c := newCommand("getSolidFireVolumeSize", "Get the SF volume size including Hypervisor Snapshot Reserve", false)
+	c.flag("volumeid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewGetSolidFireVolumeSizeParams(c.string("volumeid"))
+		return cs.Volume.GetSolidFireVolumeSize(p, opts...)
+	}
+	return c
+}
+
+func newGetUploadParamsForVolumeCommand() *command {
+	c := newCommand("getUploadParamsForVolume", "Upload a data disk to the cloudstack cloud.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("checksum", &stringValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("format", &stringValue{}, "", true)
+	c.flag("imagestoreuuid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewGetUploadParamsForVolumeParams(c.string("format"), c.string("name"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("checksum") {
+			p.SetChecksum(c.string("checksum"))
+		}
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("imagestoreuuid") {
+			p.SetImagestoreuuid(c.string("imagestoreuuid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.Volume.GetUploadParamsForVolume(p, opts...)
+	}
+	return c
+}
+
+func newGetVolumeiScsiNameCommand() *command {
+	c := newCommand("getVolumeiScsiName", "Get Volume's iSCSI Name", false)
+	c.flag("volumeid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewGetVolumeiScsiNameParams(c.string("volumeid"))
+		return cs.Volume.GetVolumeiScsiName(p, opts...)
+	}
+	return c
+}
+
+func newListVolumesCommand() *command {
+	c := newCommand("listVolumes", "Lists all volumes.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("displayvolume", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("listsystemvms", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("retrieveonlyresourcecount", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewListVolumesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("displayvolume") {
+			p.SetDisplayvolume(c.bool("displayvolume"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("listsystemvms") {
+			p.SetListsystemvms(c.bool("listsystemvms"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("retrieveonlyresourcecount") {
+			p.SetRetrieveonlyresourcecount(c.bool("retrieveonlyresourcecount"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Volume.ListVolumes(p, opts...)
+	}
+	return c
+}
+
+func newListVolumesMetricsCommand() *command {
+	c := newCommand("listVolumesMetrics", "Lists volume metrics", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("clusterid", &stringValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("displayvolume", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("hostid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("isrecursive", &boolValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("listall", &boolValue{}, "", false)
+	c.flag("listsystemvms", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("podid", &stringValue{}, "", false)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("retrieveonlyresourcecount", &boolValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.flag("type", &stringValue{}, "", false)
+	c.flag("virtualmachineid", &stringValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewListVolumesMetricsParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("clusterid") {
+			p.SetClusterid(c.string("clusterid"))
+		}
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("displayvolume") {
+			p.SetDisplayvolume(c.bool("displayvolume"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("hostid") {
+			p.SetHostid(c.string("hostid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("isrecursive") {
+			p.SetIsrecursive(c.bool("isrecursive"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("listall") {
+			p.SetListall(c.bool("listall"))
+		}
+		if c.isSet("listsystemvms") {
+			p.SetListsystemvms(c.bool("listsystemvms"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("podid") {
+			p.SetPodid(c.string("podid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		if c.isSet("retrieveonlyresourcecount") {
+			p.SetRetrieveonlyresourcecount(c.bool("retrieveonlyresourcecount"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		if c.isSet("type") {
+			p.SetType(c.string("type"))
+		}
+		if c.isSet("virtualmachineid") {
+			p.SetVirtualmachineid(c.string("virtualmachineid"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Volume.ListVolumesMetrics(p, opts...)
+	}
+	return c
+}
+
+func newMigrateVolumeCommand() *command {
+	c := newCommand("migrateVolume", "Migrate volume", true)
+	c.flag("livemigrate", &boolValue{}, "", false)
+	c.flag("newdiskofferingid", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", true)
+	c.flag("volumeid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewMigrateVolumeParams(c.string("storageid"), c.string("volumeid"))
+		if c.isSet("livemigrate") {
+			p.SetLivemigrate(c.bool("livemigrate"))
+		}
+		if c.isSet("newdiskofferingid") {
+			p.SetNewdiskofferingid(c.string("newdiskofferingid"))
+		}
+		return cs.Volume.MigrateVolume(p, opts...)
+	}
+	return c
+}
+
+func newRecoverVolumeCommand() *command {
+	c := newCommand("recoverVolume", "Recovers a Destroy volume.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewRecoverVolumeParams(c.string("id"))
+		return cs.Volume.RecoverVolume(p, opts...)
+	}
+	return c
+}
+
+func newResizeVolumeCommand() *command {
+	c := newCommand("resizeVolume", "Resizes a volume", true)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("maxiops", &int64Value{}, "", false)
+	c.flag("miniops", &int64Value{}, "", false)
+	c.flag("shrinkok", &boolValue{}, "", false)
+	c.flag("size", &int64Value{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewResizeVolumeParams(c.string("id"))
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("maxiops") {
+			p.SetMaxiops(c.int64("maxiops"))
+		}
+		if c.isSet("miniops") {
+			p.SetMiniops(c.int64("miniops"))
+		}
+		if c.isSet("shrinkok") {
+			p.SetShrinkok(c.bool("shrinkok"))
+		}
+		if c.isSet("size") {
+			p.SetSize(c.int64("size"))
+		}
+		return cs.Volume.ResizeVolume(p, opts...)
+	}
+	return c
+}
+
+func newUpdateVolumeCommand() *command {
+	c := newCommand("updateVolume", "Updates the volume.", true)
+	c.flag("chaininfo", &stringValue{}, "", false)
+	c.flag("customid", &stringValue{}, "", false)
+	c.flag("displayvolume", &boolValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("path", &stringValue{}, "", false)
+	c.flag("state", &stringValue{}, "", false)
+	c.flag("storageid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewUpdateVolumeParams()
+		if c.isSet("chaininfo") {
+			p.SetChaininfo(c.string("chaininfo"))
+		}
+		if c.isSet("customid") {
+			p.SetCustomid(c.string("customid"))
+		}
+		if c.isSet("displayvolume") {
+			p.SetDisplayvolume(c.bool("displayvolume"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("path") {
+			p.SetPath(c.string("path"))
+		}
+		if c.isSet("state") {
+			p.SetState(c.string("state"))
+		}
+		if c.isSet("storageid") {
+			p.SetStorageid(c.string("storageid"))
+		}
+		return cs.Volume.UpdateVolume(p, opts...)
+	}
+	return c
+}
+
+func newUploadVolumeCommand() *command {
+	c := newCommand("uploadVolume", "Uploads a data disk.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("checksum", &stringValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("format", &stringValue{}, "", true)
+	c.flag("imagestoreuuid", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("projectid", &stringValue{}, "", false)
+	c.flag("url", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewUploadVolumeParams(c.string("format"), c.string("name"), c.string("url"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("checksum") {
+			p.SetChecksum(c.string("checksum"))
+		}
+		if c.isSet("diskofferingid") {
+			p.SetDiskofferingid(c.string("diskofferingid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("imagestoreuuid") {
+			p.SetImagestoreuuid(c.string("imagestoreuuid"))
+		}
+		if c.isSet("projectid") {
+			p.SetProjectid(c.string("projectid"))
+		}
+		return cs.Volume.UploadVolume(p, opts...)
+	}
+	return c
+}
+
+func newChangeOfferingForVolumeCommand() *command {
+	c := newCommand("changeOfferingForVolume", "Change disk offering of the volume and also an option to auto migrate if required to apply the new disk offering", true)
+	c.flag("automigrate", &boolValue{}, "", false)
+	c.flag("diskofferingid", &stringValue{}, "", true)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("maxiops", &int64Value{}, "", false)
+	c.flag("miniops", &int64Value{}, "", false)
+	c.flag("shrinkok", &boolValue{}, "", false)
+	c.flag("size", &int64Value{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Volume.NewChangeOfferingForVolumeParams(c.string("diskofferingid"), c.string("id"))
+		if c.isSet("automigrate") {
+			p.SetAutomigrate(c.bool("automigrate"))
+		}
+		if c.isSet("maxiops") {
+			p.SetMaxiops(c.int64("maxiops"))
+		}
+		if c.isSet("miniops") {
+			p.SetMiniops(c.int64("miniops"))
+		}
+		if c.isSet("shrinkok") {
+			p.SetShrinkok(c.bool("shrinkok"))
+		}
+		if c.isSet("size") {
+			p.SetSize(c.int64("size"))
+		}
+		return cs.Volume.ChangeOfferingForVolume(p, opts...)
+	}
+	return c
+}
+
+func newCreateZoneCommand() *command {
+	c := newCommand("createZone", "Creates a Zone.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("dns1", &stringValue{}, "", true)
+	c.flag("dns2", &stringValue{}, "", false)
+	c.flag("domain", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("guestcidraddress", &stringValue{}, "", false)
+	c.flag("internaldns1", &stringValue{}, "", true)
+	c.flag("internaldns2", &stringValue{}, "", false)
+	c.flag("ip6dns1", &stringValue{}, "", false)
+	c.flag("ip6dns2", &stringValue{}, "", false)
+	c.flag("isedge", &boolValue{}, "", false)
+	c.flag("localstorageenabled", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", true)
+	c.flag("networktype", &stringValue{}, "", true)
+	c.flag("securitygroupenabled", &boolValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewCreateZoneParams(c.string("dns1"), c.string("internaldns1"), c.string("name"), c.string("networktype"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("dns2") {
+			p.SetDns2(c.string("dns2"))
+		}
+		if c.isSet("domain") {
+			p.SetDomain(c.string("domain"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("guestcidraddress") {
+			p.SetGuestcidraddress(c.string("guestcidraddress"))
+		}
+		if c.isSet("internaldns2") {
+			p.SetInternaldns2(c.string("internaldns2"))
+		}
+		if c.isSet("ip6dns1") {
+			p.SetIp6dns1(c.string("ip6dns1"))
+		}
+		if c.isSet("ip6dns2") {
+			p.SetIp6dns2(c.string("ip6dns2"))
+		}
+		if c.isSet("isedge") {
+			p.SetIsedge(c.bool("isedge"))
+		}
+		if c.isSet("localstorageenabled") {
+			p.SetLocalstorageenabled(c.bool("localstorageenabled"))
+		}
+		if c.isSet("securitygroupenabled") {
+			p.SetSecuritygroupenabled(c.bool("securitygroupenabled"))
+		}
+		return cs.Zone.CreateZone(p, opts...)
+	}
+	return c
+}
+
+func newDedicateZoneCommand() *command {
+	c := newCommand("dedicateZone", "Dedicates a zones.", true)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewDedicateZoneParams(c.string("domainid"), c.string("zoneid"))
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		return cs.Zone.DedicateZone(p, opts...)
+	}
+	return c
+}
+
+func newDeleteZoneCommand() *command {
+	c := newCommand("deleteZone", "Deletes a Zone.", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewDeleteZoneParams(c.string("id"))
+		return cs.Zone.DeleteZone(p, opts...)
+	}
+	return c
+}
+
+func newDisableOutOfBandManagementForZoneCommand() *command {
+	c := newCommand("disableOutOfBandManagementForZone", "Disables out-of-band management for a zone", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewDisableOutOfBandManagementForZoneParams(c.string("zoneid"))
+		return cs.Zone.DisableOutOfBandManagementForZone(p, opts...)
+	}
+	return c
+}
+
+func newEnableOutOfBandManagementForZoneCommand() *command {
+	c := newCommand("enableOutOfBandManagementForZone", "Enables out-of-band management for a zone", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewEnableOutOfBandManagementForZoneParams(c.string("zoneid"))
+		return cs.Zone.EnableOutOfBandManagementForZone(p, opts...)
+	}
+	return c
+}
+
+func newDisableHAForZoneCommand() *command {
+	c := newCommand("disableHAForZone", "Disables HA for a zone", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewDisableHAForZoneParams(c.string("zoneid"))
+		return cs.Zone.DisableHAForZone(p, opts...)
+	}
+	return c
+}
+
+func newEnableHAForZoneCommand() *command {
+	c := newCommand("enableHAForZone", "Enables HA for a zone", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewEnableHAForZoneParams(c.string("zoneid"))
+		return cs.Zone.EnableHAForZone(p, opts...)
+	}
+	return c
+}
+
+func newListDedicatedZonesCommand() *command {
+	c := newCommand("listDedicatedZones", "List dedicated zones.", false)
+	c.flag("account", &stringValue{}, "", false)
+	c.flag("affinitygroupid", &stringValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("zoneid", &stringValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewListDedicatedZonesParams()
+		if c.isSet("account") {
+			p.SetAccount(c.string("account"))
+		}
+		if c.isSet("affinitygroupid") {
+			p.SetAffinitygroupid(c.string("affinitygroupid"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("zoneid") {
+			p.SetZoneid(c.string("zoneid"))
+		}
+		return cs.Zone.ListDedicatedZones(p, opts...)
+	}
+	return c
+}
+
+func newListZonesCommand() *command {
+	c := newCommand("listZones", "Lists zones", false)
+	c.flag("available", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networktype", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showcapacities", &boolValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewListZonesParams()
+		if c.isSet("available") {
+			p.SetAvailable(c.bool("available"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networktype") {
+			p.SetNetworktype(c.string("networktype"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showcapacities") {
+			p.SetShowcapacities(c.bool("showcapacities"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.Zone.ListZones(p, opts...)
+	}
+	return c
+}
+
+func newListZonesMetricsCommand() *command {
+	c := newCommand("listZonesMetrics", "Lists zone metrics", false)
+	c.flag("available", &boolValue{}, "", false)
+	c.flag("domainid", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", false)
+	c.flag("ids", &stringsValue{}, "", false)
+	c.flag("keyword", &stringValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("networktype", &stringValue{}, "", false)
+	c.flag("page", &intValue{}, "", false)
+	c.flag("pagesize", &intValue{}, "", false)
+	c.flag("showcapacities", &boolValue{}, "", false)
+	c.flag("showicon", &boolValue{}, "", false)
+	c.flag("tags", &mapValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewListZonesMetricsParams()
+		if c.isSet("available") {
+			p.SetAvailable(c.bool("available"))
+		}
+		if c.isSet("domainid") {
+			p.SetDomainid(c.string("domainid"))
+		}
+		if c.isSet("id") {
+			p.SetId(c.string("id"))
+		}
+		if c.isSet("ids") {
+			p.SetIds(c.strings("ids"))
+		}
+		if c.isSet("keyword") {
+			p.SetKeyword(c.string("keyword"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("networktype") {
+			p.SetNetworktype(c.string("networktype"))
+		}
+		if c.isSet("page") {
+			p.SetPage(c.int("page"))
+		}
+		if c.isSet("pagesize") {
+			p.SetPagesize(c.int("pagesize"))
+		}
+		if c.isSet("showcapacities") {
+			p.SetShowcapacities(c.bool("showcapacities"))
+		}
+		if c.isSet("showicon") {
+			p.SetShowicon(c.bool("showicon"))
+		}
+		if c.isSet("tags") {
+			p.SetTags(c.stringMap("tags"))
+		}
+		return cs.Zone.ListZonesMetrics(p, opts...)
+	}
+	return c
+}
+
+func newReleaseDedicatedZoneCommand() *command {
+	c := newCommand("releaseDedicatedZone", "Release dedication of zone", true)
+	c.flag("zoneid", &stringValue{}, "", true)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewReleaseDedicatedZoneParams(c.string("zoneid"))
+		return cs.Zone.ReleaseDedicatedZone(p, opts...)
+	}
+	return c
+}
+
+func newUpdateZoneCommand() *command {
+	c := newCommand("updateZone", "Updates a Zone.", false)
+	c.flag("allocationstate", &stringValue{}, "", false)
+	c.flag("details", &mapValue{}, "", false)
+	c.flag("dhcpprovider", &stringValue{}, "", false)
+	c.flag("dns1", &stringValue{}, "", false)
+	c.flag("dns2", &stringValue{}, "", false)
+	c.flag("dnssearchorder", &stringsValue{}, "", false)
+	c.flag("domain", &stringValue{}, "", false)
+	c.flag("guestcidraddress", &stringValue{}, "", false)
+	c.flag("id", &stringValue{}, "", true)
+	c.flag("internaldns1", &stringValue{}, "", false)
+	c.flag("internaldns2", &stringValue{}, "", false)
+	c.flag("ip6dns1", &stringValue{}, "", false)
+	c.flag("ip6dns2", &stringValue{}, "", false)
+	c.flag("ispublic", &boolValue{}, "", false)
+	c.flag("localstorageenabled", &boolValue{}, "", false)
+	c.flag("name", &stringValue{}, "", false)
+	c.flag("sortkey", &intValue{}, "", false)
+	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {
+		p := cs.Zone.NewUpdateZoneParams(c.string("id"))
+		if c.isSet("allocationstate") {
+			p.SetAllocationstate(c.string("allocationstate"))
+		}
+		if c.isSet("details") {
+			p.SetDetails(c.stringMap("details"))
+		}
+		if c.isSet("dhcpprovider") {
+			p.SetDhcpprovider(c.string("dhcpprovider"))
+		}
+		if c.isSet("dns1") {
+			p.SetDns1(c.string("dns1"))
+		}
+		if c.isSet("dns2") {
+			p.SetDns2(c.string("dns2"))
+		}
+		if c.isSet("dnssearchorder") {
+			p.SetDnssearchorder(c.strings("dnssearchorder"))
+		}
+		if c.isSet("domain") {
+			p.SetDomain(c.string("domain"))
+		}
+		if c.isSet("guestcidraddress") {
+			p.SetGuestcidraddress(c.string("guestcidraddress"))
+		}
+		if c.isSet("internaldns1") {
+			p.SetInternaldns1(c.string("internaldns1"))
+		}
+		if c.isSet("internaldns2") {
+			p.SetInternaldns2(c.string("internaldns2"))
+		}
+		if c.isSet("ip6dns1") {
+			p.SetIp6dns1(c.string("ip6dns1"))
+		}
+		if c.isSet("ip6dns2") {
+			p.SetIp6dns2(c.string("ip6dns2"))
+		}
+		if c.isSet("ispublic") {
+			p.SetIspublic(c.bool("ispublic"))
+		}
+		if c.isSet("localstorageenabled") {
+			p.SetLocalstorageenabled(c.bool("localstorageenabled"))
+		}
+		if c.isSet("name") {
+			p.SetName(c.string("name"))
+		}
+		if c.isSet("sortkey") {
+			p.SetSortkey(c.int("sortkey"))
+		}
+		return cs.Zone.UpdateZone(p, opts...)
+	}
+	return c
+}
@@ -0,0 +1,160 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+// stringValue is used for string and UUID params
+type stringValue struct{ v string }
+
+func (f *stringValue) String() string     { return f.v }
+func (f *stringValue) Set(s string) error { f.v = s; return nil }
+
+type boolValue struct{ v bool }
+
+func (f *boolValue) String() string   { return strconv.FormatBool(f.v) }
+func (f *boolValue) IsBoolFlag() bool { return true }
+func (f *boolValue) Set(s string) (err error) {
+	f.v, err = strconv.ParseBool(s)
+	return err
+}
+
+type intValue struct{ v int }
+
+func (f *intValue) String() string { return strconv.Itoa(f.v) }
+func (f *intValue) Set(s string) (err error) {
+	f.v, err = strconv.Atoi(s)
+	return err
+}
+
+type int64Value struct{ v int64 }
+
+func (f *int64Value) String() string { return strconv.FormatInt(f.v, 10) }
+func (f *int64Value) Set(s string) (err error) {
+	f.v, err = strconv.ParseInt(s, 10, 64)
+	return err
+}
+
+type float64Value struct{ v float64 }
+
+func (f *float64Value) String() string { return strconv.FormatFloat(f.v, 'f', -1, 64) }
+func (f *float64Value) Set(s string) (err error) {
+	f.v, err = strconv.ParseFloat(s, 64)
+	return err
+}
+
+// stringsValue is used for list params, as a comma separated list or by repeating the flag
+type stringsValue struct{ v []string }
+
+func (f *stringsValue) String() string { return strings.Join(f.v, ",") }
+func (f *stringsValue) Set(s string) error {
+	f.v = append(f.v, strings.Split(s, ",")...)
+	return nil
+}
+
+// mapValue is used for map params, as key=value pairs by repeating the flag
+type mapValue struct{ v map[string]string }
+
+func (f *mapValue) String() string { return formatMap(f.v) }
+func (f *mapValue) Set(s string) error {
+	if f.v == nil {
+		f.v = make(map[string]string)
+	}
+	i := strings.Index(s, "=")
+	if i < 1 {
+		return fmt.Errorf("expected key=value, got %q", s)
+	}
+	f.v[s[:i]] = s[i+1:]
+	return nil
+}
+
+// mapsValue is used for list of map params, every flag adds an item with comma
+// separated key=value pairs, as in --nicnetworklist networkid=x,ip=10.0.0.1
+type mapsValue struct{ v []map[string]string }
+
+func (f *mapsValue) String() string {
+	items := make([]string, len(f.v))
+	for i, m := range f.v {
+		items[i] = formatMap(m)
+	}
+	return strings.Join(items, " ")
+}
+func (f *mapsValue) Set(s string) error {
+	m := make(map[string]string)
+	for _, kv := range strings.Split(s, ",") {
+		i := strings.Index(kv, "=")
+		if i < 1 {
+			return fmt.Errorf("expected key=value, got %q", kv)
+		}
+		m[kv[:i]] = kv[i+1:]
+	}
+	f.v = append(f.v, m)
+	return nil
+}
+
+func formatMap(m map[string]string) string {
+	var kvs []string
+	for k, v := range m {
+		kvs = append(kvs, k+"="+v)
+	}
+	return strings.Join(kvs, ",")
+}
+
+func (c *command) string(name string) string {
+	return c.values[name].(*stringValue).v
+}
+
+func (c *command) uuid(name string) cloudstack.UUID {
+	return cloudstack.UUID(c.values[name].(*stringValue).v)
+}
+
+func (c *command) bool(name string) bool {
+	return c.values[name].(*boolValue).v
+}
+
+func (c *command) int(name string) int {
+	return c.values[name].(*intValue).v
+}
+
+func (c *command) int64(name string) int64 {
+	return c.values[name].(*int64Value).v
+}
+
+func (c *command) float64(name string) float64 {
+	return c.values[name].(*float64Value).v
+}
+
+func (c *command) strings(name string) []string {
+	return c.values[name].(*stringsValue).v
+}
+
+func (c *command) stringMap(name string) map[string]string {
+	return c.values[name].(*mapValue).v
+}
+
+func (c *command) stringMaps(name string) []map[string]string {
+	return c.values[name].(*mapsValue).v
+}
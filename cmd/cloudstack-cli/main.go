@@ -0,0 +1,265 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Command cloudstack-cli calls any CloudStack API command from the command line. Every
+// service of the cloudstack package is a command group and every API command of the
+// service a subcommand, with a flag for every param:
+//
+//	cloudstack-cli [global flags] <group> <command> [flags]
+//	cloudstack-cli virtualmachine deployVirtualMachine --zoneid zone1 --serviceofferingid small --templateid 6a2b...
+//
+// The commands are generated together with the cloudstack package (see commands.go).
+// Required flags are enforced, async commands wait for their job to finish (unless
+// --no-wait is used) and values of ID flags like --zoneid that are not a UUID are
+// resolved as names using the Get*ID helpers of the cloudstack package.
+//
+// The URL and keys are read from the CS_API_URL, CS_API_KEY and CS_SECRET_KEY
+// environment variables, unless they are given as global flags. The output is written
+// as JSON, YAML, a table or CSV.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+// group is a group of commands, one for each service of the cloudstack package
+type group struct {
+	name     string
+	commands []func() *command
+}
+
+// command is a single API command
+type command struct {
+	name        string
+	description string
+	async       bool
+	flags       *flag.FlagSet
+	values      map[string]flag.Value
+	required    []string
+	run         func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error)
+}
+
+// resolver returns the ID of a resource by its name
+type resolver func(cs *cloudstack.CloudStackClient, name string) (string, int, error)
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+var errUsage = errors.New("usage")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if err != errUsage {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// run runs the CLI with the given arguments, writing the output to stdout and the usage
+// and help texts to stderr
+func run(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("cloudstack-cli", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	apiURL := fs.String("url", os.Getenv("CS_API_URL"), "URL of the CloudStack API, defaults to $CS_API_URL")
+	apiKey := fs.String("api-key", os.Getenv("CS_API_KEY"), "API key, defaults to $CS_API_KEY")
+	secretKey := fs.String("secret-key", os.Getenv("CS_SECRET_KEY"), "secret key, defaults to $CS_SECRET_KEY")
+	verifySSL := fs.Bool("verify-ssl", true, "verify the SSL certificate of the API")
+	output := fs.String("output", "json", "output format: json, yaml, table or csv")
+	asyncTimeout := fs.Int64("async-timeout", 300, "max number of seconds to wait for async jobs to finish")
+	noWait := fs.Bool("no-wait", false, "do not wait for async jobs to finish, but return the job ID")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: cloudstack-cli [global flags] <group> <command> [flags]\n\nGlobal flags:\n")
+		fs.PrintDefaults()
+		fmt.Fprintf(stderr, "\nGroups:\n")
+		for _, g := range groups() {
+			fmt.Fprintf(stderr, "  %s\n", g.name)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return errUsage
+		}
+		return err
+	}
+
+	format, err := newFormatter(*output)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+	g := findGroup(fs.Arg(0))
+	if g == nil {
+		return fmt.Errorf("unknown group %q, run cloudstack-cli -h to list all groups", fs.Arg(0))
+	}
+	if fs.NArg() == 1 {
+		printCommands(stderr, g)
+		return errUsage
+	}
+	c := g.find(fs.Arg(1))
+	if c == nil {
+		return fmt.Errorf("unknown command %q, run cloudstack-cli %s to list all commands", fs.Arg(1), g.name)
+	}
+
+	c.flags.SetOutput(stderr)
+	if err := c.flags.Parse(fs.Args()[2:]); err != nil {
+		if err == flag.ErrHelp {
+			return errUsage
+		}
+		return err
+	}
+	if err := c.checkRequired(); err != nil {
+		return err
+	}
+
+	if *apiURL == "" {
+		return errors.New("no API URL, use --url or set $CS_API_URL")
+	}
+	cs := cloudstack.NewAsyncClient(*apiURL, *apiKey, *secretKey, *verifySSL)
+	cs.AsyncTimeout(*asyncTimeout)
+
+	if err := c.resolve(cs); err != nil {
+		return err
+	}
+
+	r, err := c.run(cs, cloudstack.WithCallWait(!*noWait))
+	if err != nil {
+		return err
+	}
+	return format(stdout, r)
+}
+
+func findGroup(name string) *group {
+	for _, g := range groups() {
+		if strings.EqualFold(g.name, name) {
+			return g
+		}
+	}
+	return nil
+}
+
+// find returns the command with the given name, ignoring case
+func (g *group) find(name string) *command {
+	for _, fn := range g.commands {
+		if c := fn(); strings.EqualFold(c.name, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+func printCommands(w io.Writer, g *group) {
+	fmt.Fprintf(w, "Usage: cloudstack-cli [global flags] %s <command> [flags]\n\nCommands:\n", g.name)
+	for _, fn := range g.commands {
+		c := fn()
+		async := ""
+		if c.async {
+			async = " (async)"
+		}
+		fmt.Fprintf(w, "  %-40s %s%s\n", c.name, c.description, async)
+	}
+}
+
+func newCommand(name, description string, async bool) *command {
+	c := &command{
+		name:        name,
+		description: description,
+		async:       async,
+		flags:       flag.NewFlagSet(name, flag.ContinueOnError),
+		values:      make(map[string]flag.Value),
+	}
+	c.flags.Usage = func() {
+		fmt.Fprintf(c.flags.Output(), "Usage: cloudstack-cli [global flags] <group> %s [flags]\n\n%s\n\nFlags:\n", c.name, c.description)
+		c.flags.PrintDefaults()
+	}
+	return c
+}
+
+// flag adds a flag for a param of the command
+func (c *command) flag(name string, v flag.Value, usage string, required bool) {
+	if required {
+		usage = "(required) " + usage
+		c.required = append(c.required, name)
+	}
+	c.values[name] = v
+	c.flags.Var(v, name, usage)
+}
+
+// isSet returns true if the flag with the given name is set
+func (c *command) isSet(name string) bool {
+	set := false
+	c.flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+func (c *command) checkRequired() error {
+	var missing []string
+	for _, name := range c.required {
+		if !c.isSet(name) {
+			missing = append(missing, "--"+name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required flag(s) for %s: %s", c.name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// resolve replaces the values of ID flags that are not a UUID by the ID of the resource
+// with that name, if there is a helper to look it up
+func (c *command) resolve(cs *cloudstack.CloudStackClient) error {
+	names := make([]string, 0, len(c.values))
+	for name := range c.values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v, ok := c.values[name].(*stringValue)
+		if !ok || !c.isSet(name) || uuidRegex.MatchString(v.v) {
+			continue
+		}
+		fn, ok := resolvers[name]
+		if !ok {
+			continue
+		}
+		id, _, err := fn(cs, v.v)
+		if err != nil {
+			return fmt.Errorf("failed to resolve --%s %q: %v", name, v.v, err)
+		}
+		v.v = id
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstacktest"
+)
+
+func runCLI(t *testing.T, sim *cloudstacktest.Server, args ...string) (string, error) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"--url", sim.URL, "--api-key", sim.APIKey, "--secret-key", sim.SecretKey}, args...)
+	err := run(args, &stdout, &stderr)
+	return stdout.String(), err
+}
+
+func TestCLI(t *testing.T) {
+	sim := cloudstacktest.NewServer()
+	defer sim.Close()
+
+	zone := sim.AddZone("zone1")
+	sim.AddZone("zone2")
+	offering := sim.AddServiceOffering("small", 1, 1024)
+	template := sim.AddTemplate("centos", zone.Id)
+
+	t.Run("DeployResolvesNames", func(t *testing.T) {
+		out, err := runCLI(t, sim, "virtualmachine", "deployVirtualMachine",
+			"--zoneid", "zone1", "--serviceofferingid", "small", "--templateid", template.Id, "--name", "vm1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var vm struct {
+			Id                string `json:"id"`
+			Zoneid            string `json:"zoneid"`
+			Serviceofferingid string `json:"serviceofferingid"`
+			State             string `json:"state"`
+		}
+		if err := json.Unmarshal([]byte(out), &vm); err != nil {
+			t.Fatalf("Failed to parse the output: %v\n%s", err, out)
+		}
+		if vm.Zoneid != zone.Id || vm.Serviceofferingid != offering.Id {
+			t.Errorf("Expected the zone and offering IDs, got %s and %s", vm.Zoneid, vm.Serviceofferingid)
+		}
+		if vm.State != "Running" {
+			t.Errorf("Expected the job to be waited on, got state %q", vm.State)
+		}
+	})
+
+	t.Run("RequiredFlags", func(t *testing.T) {
+		_, err := runCLI(t, sim, "virtualmachine", "deployVirtualMachine", "--zoneid", "zone1")
+		if err == nil || !strings.Contains(err.Error(), "--serviceofferingid, --templateid") {
+			t.Errorf("Expected an error about the missing flags, got: %v", err)
+		}
+	})
+
+	t.Run("UnknownName", func(t *testing.T) {
+		_, err := runCLI(t, sim, "virtualmachine", "deployVirtualMachine",
+			"--zoneid", "zone3", "--serviceofferingid", offering.Id, "--templateid", template.Id)
+		if err == nil || !strings.Contains(err.Error(), `failed to resolve --zoneid "zone3"`) {
+			t.Errorf("Expected an error about the unknown zone, got: %v", err)
+		}
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		out, err := runCLI(t, sim, "--output", "csv", "zone", "listZones")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		if len(lines) != 3 {
+			t.Fatalf("Expected a header and 2 rows, got:\n%s", out)
+		}
+		if !strings.HasPrefix(lines[0], "id,name,") || !strings.HasPrefix(lines[1], zone.Id+",zone1,") {
+			t.Errorf("Expected the id and name columns first, got:\n%s", out)
+		}
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		out, err := runCLI(t, sim, "--output", "table", "zone", "listZones", "--name", "zone2")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		if len(lines) != 2 || !strings.HasPrefix(lines[0], "ID ") || !strings.Contains(lines[1], "zone2") {
+			t.Errorf("Expected a table with a single zone, got:\n%s", out)
+		}
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		out, err := runCLI(t, sim, "--output", "yaml", "zone", "listZones", "--name", "zone1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(out, "count: 1") || !strings.Contains(out, "name: zone1") {
+			t.Errorf("Expected the zone as YAML, got:\n%s", out)
+		}
+	})
+
+	t.Run("NoWait", func(t *testing.T) {
+		out, err := runCLI(t, sim, "--no-wait", "virtualmachine", "deployVirtualMachine",
+			"--zoneid", zone.Id, "--serviceofferingid", offering.Id, "--templateid", template.Id)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(out, `"jobid": "`) {
+			t.Errorf("Expected the job ID, got:\n%s", out)
+		}
+	})
+
+	t.Run("UnknownCommand", func(t *testing.T) {
+		if _, err := runCLI(t, sim, "zone", "listZoness"); err == nil {
+			t.Error("Expected an error for an unknown command")
+		}
+	})
+}
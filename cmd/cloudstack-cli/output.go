@@ -0,0 +1,237 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// formatter writes the response of a command
+type formatter func(w io.Writer, r interface{}) error
+
+func newFormatter(format string) (formatter, error) {
+	switch format {
+	case "json":
+		return writeJSON, nil
+	case "yaml":
+		return writeYAML, nil
+	case "table":
+		return writeTable, nil
+	case "csv":
+		return writeCSV, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q, use json, yaml, table or csv", format)
+	}
+}
+
+func writeJSON(w io.Writer, r interface{}) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+func writeYAML(w io.Writer, r interface{}) error {
+	v, err := toGeneric(r)
+	if err != nil {
+		return err
+	}
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func writeTable(w io.Writer, r interface{}) error {
+	columns, rows, err := toRows(r)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, row := range append([][]string{upper(columns)}, rows...) {
+		for i, v := range row {
+			if i > 0 {
+				fmt.Fprint(tw, "\t")
+			}
+			fmt.Fprint(tw, v)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+func writeCSV(w io.Writer, r interface{}) error {
+	columns, rows, err := toRows(r)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// toGeneric converts a response to maps, slices and values, using its JSON encoding
+func toGeneric(r interface{}) (interface{}, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	return v, json.Unmarshal(b, &v)
+}
+
+// toRows converts a response to rows with a column for every field with a scalar value.
+// List responses have a row per listed object, other responses a single row.
+func toRows(r interface{}) ([]string, [][]string, error) {
+	v, err := toGeneric(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var objects []map[string]interface{}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if items, ok := listItems(v); ok {
+			for _, item := range items {
+				if o, ok := item.(map[string]interface{}); ok {
+					objects = append(objects, o)
+				}
+			}
+		} else {
+			objects = append(objects, v)
+		}
+	case nil:
+	default:
+		return nil, nil, fmt.Errorf("cannot write a %T as rows", v)
+	}
+
+	found := make(map[string]bool)
+	for _, o := range objects {
+		for k, v := range o {
+			if isScalar(v) {
+				found[k] = true
+			}
+		}
+	}
+	columns := make([]string, 0, len(found))
+	for k := range found {
+		columns = append(columns, k)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		if ri, rj := columnRank(columns[i]), columnRank(columns[j]); ri != rj {
+			return ri < rj
+		}
+		return columns[i] < columns[j]
+	})
+
+	rows := make([][]string, len(objects))
+	for i, o := range objects {
+		rows[i] = make([]string, len(columns))
+		for j, c := range columns {
+			rows[i][j] = formatScalar(o[c])
+		}
+	}
+	return columns, rows, nil
+}
+
+// listItems returns the listed objects of a list response, which has a count and a
+// single list of objects
+func listItems(v map[string]interface{}) ([]interface{}, bool) {
+	if _, ok := v["count"]; !ok {
+		return nil, false
+	}
+	if len(v) != 2 {
+		return nil, false
+	}
+	for k, f := range v {
+		if k == "count" {
+			continue
+		}
+		// Empty lists are encoded as null
+		if f == nil {
+			return nil, true
+		}
+		items, ok := f.([]interface{})
+		return items, ok
+	}
+	return nil, false
+}
+
+// columnRank makes sure the id and name columns are shown first
+func columnRank(c string) int {
+	switch c {
+	case "id":
+		return 0
+	case "name":
+		return 1
+	default:
+		return 2
+	}
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case string, float64, bool, nil:
+		return true
+	default:
+		return false
+	}
+}
+
+func formatScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func upper(columns []string) []string {
+	u := make([]string, len(columns))
+	for i, c := range columns {
+		u[i] = strings.ToUpper(c)
+	}
+	return u
+}
@@ -38,3 +38,33 @@ func Test_Mock(t *testing.T) {
 
 	cs.Host.GetHostByID("host-id")
 }
+
+// destroyAndWait only depends on the client interface, so it can be tested using a mock
+func destroyAndWait(cs cloudstack.CloudStackClientIface, id string) error {
+	p := cs.VirtualMachineService().NewDestroyVirtualMachineParams(id)
+	r, err := cs.VirtualMachineService().DestroyVirtualMachine(p, cloudstack.WithCallWait(false))
+	if err != nil {
+		return err
+	}
+
+	_, err = cs.GetAsyncJobResult(r.JobID, 300)
+	return err
+}
+
+func Test_MockClientIface(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	cs := cloudstack.NewMockCloudStackClientIface(mockCtrl)
+	vms := cloudstack.NewMockVirtualMachineServiceIface(mockCtrl)
+
+	p := &cloudstack.DestroyVirtualMachineParams{}
+	p.SetId("vm-id")
+
+	cs.EXPECT().VirtualMachineService().AnyTimes().Return(vms)
+	vms.EXPECT().NewDestroyVirtualMachineParams("vm-id").Return(p)
+	vms.EXPECT().DestroyVirtualMachine(p, gomock.Any()).Return(&cloudstack.DestroyVirtualMachineResponse{JobID: "job-id"}, nil)
+	cs.EXPECT().GetAsyncJobResult("job-id", int64(300)).Return(nil, nil)
+
+	if err := destroyAndWait(cs, "vm-id"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,244 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+)
+
+// cliFlagTypes maps the Go types of params to the flag value used for them by the CLI,
+// and the command method used to get the typed value. Params of other types have no flag.
+var cliFlagTypes = map[string][2]string{
+	"string":              {"stringValue", "string"},
+	"UUID":                {"stringValue", "uuid"},
+	"bool":                {"boolValue", "bool"},
+	"int":                 {"intValue", "int"},
+	"int64":               {"int64Value", "int64"},
+	"float64":             {"float64Value", "float64"},
+	"[]string":            {"stringsValue", "strings"},
+	"map[string]string":   {"mapValue", "stringMap"},
+	"[]map[string]string": {"mapsValue", "stringMaps"},
+}
+
+func (as *allServices) WriteCLI() error {
+	outdir, err := cliDir()
+	if err != nil {
+		log.Fatalf("Failed to get CLI dir: %s", err)
+	}
+
+	code, err := as.CLI()
+	if err != nil {
+		return err
+	}
+
+	file := path.Join(outdir, "commands.go")
+	return ioutil.WriteFile(file, code, 0644)
+}
+
+// CLI generates the commands of the cloudstack-cli command, with a command group for
+// every service and a command for every API
+func (as *allServices) CLI() ([]byte, error) {
+	var buf bytes.Buffer
+	p := func(format string, args ...interface{}) {
+		_, err := fmt.Fprintf(&buf, format, args...)
+		if err != nil {
+			panic(err)
+		}
+	}
+	pn := func(format string, args ...interface{}) {
+		p(format+"\n", args...)
+	}
+	pn("//")
+	pn("// Licensed to the Apache Software Foundation (ASF) under one")
+	pn("// or more contributor license agreements.  See the NOTICE file")
+	pn("// distributed with this work for additional information")
+	pn("// regarding copyright ownership.  The ASF licenses this file")
+	pn("// to you under the Apache License, Version 2.0 (the")
+	pn("// \"License\"); you may not use this file except in compliance")
+	pn("// with the License.  You may obtain a copy of the License at")
+	pn("//")
+	pn("//   http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing,")
+	pn("// software distributed under the License is distributed on an")
+	pn("// \"AS IS\" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY")
+	pn("// KIND, either express or implied.  See the License for the")
+	pn("// specific language governing permissions and limitations")
+	pn("// under the License.")
+	pn("//")
+	pn("")
+	pn("package main")
+	pn("")
+	pn("import (")
+	pn("	\"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack\"")
+	pn(")")
+	pn("")
+
+	pn("// groups returns all command groups, one for each service")
+	pn("func groups() []*group {")
+	pn("	return []*group{")
+	for _, s := range as.services {
+		var commands []string
+		for _, a := range s.apis {
+			if isCLICommand(a) {
+				commands = append(commands, "new"+capitalize(a.Name)+"Command")
+			}
+		}
+		if len(commands) == 0 {
+			continue
+		}
+		pn("		{")
+		pn("			name: %q,", strings.ToLower(strings.TrimSuffix(s.name, "Service")))
+		pn("			commands: []func() *command{")
+		for _, c := range commands {
+			pn("				%s,", c)
+		}
+		pn("			},")
+		pn("		},")
+	}
+	pn("	}")
+	pn("}")
+	pn("")
+
+	pn("// resolvers contains the helpers used to resolve names to IDs, by the name of the ID param")
+	pn("var resolvers = map[string]resolver{")
+	found := make(map[string]bool)
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			singular, ok := nameOnlyIDHelper(a)
+			if !ok || found[strings.ToLower(singular)+"id"] {
+				continue
+			}
+			found[strings.ToLower(singular)+"id"] = true
+
+			pn("	%q: func(cs *cloudstack.CloudStackClient, name string) (string, int, error) {", strings.ToLower(singular)+"id")
+			pn("		return cs.%s.Get%sID(name)", strings.TrimSuffix(s.name, "Service"), singular)
+			pn("	},")
+		}
+	}
+	pn("}")
+	pn("")
+
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			if isCLICommand(a) {
+				s.generateCLICommand(a, p, pn)
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// isCLICommand returns true if the CLI has flags for all required params of the API
+func isCLICommand(a *API) bool {
+	for _, ap := range a.Params {
+		if _, ok := cliFlagTypes[mapType(a.Name, ap.Name, ap.Type)]; !ok && (ap.Required || isRequiredParam(a, ap)) {
+			return false
+		}
+	}
+	return true
+}
+
+// nameOnlyIDHelper returns the resource name of the Get*ID helper generated for a list
+// API, if that helper only needs a name (see generateHelperFuncs)
+func nameOnlyIDHelper(a *API) (string, bool) {
+	if !strings.HasPrefix(a.Name, "list") {
+		return "", false
+	}
+	if _, found := hasNameOrKeywordParamField(a.Name, a.Params); !found || !hasIDAndNameResponseField(a.Name, a.Response) {
+		return "", false
+	}
+	for _, ap := range a.Params {
+		if ap.Required || isRequiredParam(a, ap) {
+			return "", false
+		}
+	}
+
+	if len(overrides.command(a.Name).IDHelperParams) > 0 {
+		return "", false
+	}
+	return parseSingular(strings.TrimPrefix(a.Name, "list")), true
+}
+
+func (s *service) generateCLICommand(a *API, p, pn func(format string, args ...interface{})) {
+	n := capitalize(a.Name)
+	field := strings.TrimSuffix(s.name, "Service")
+
+	pn("func new%sCommand() *command {", n)
+	pn("	c := newCommand(%q, %q, %t)", a.Name, sanitizeDescription(a.Description), a.Isasync)
+
+	var required, optional []*APIParam
+	found := make(map[string]bool)
+	for _, ap := range a.Params {
+		ft, ok := cliFlagTypes[mapType(a.Name, ap.Name, ap.Type)]
+		if !ok || found[ap.Name] {
+			continue
+		}
+		found[ap.Name] = true
+
+		req := ap.Required || isRequiredParam(a, ap)
+		pn("	c.flag(%q, &%s{}, %q, %t)", ap.Name, ft[0], sanitizeDescription(ap.Description), req)
+		if req {
+			required = append(required, ap)
+		} else {
+			optional = append(optional, ap)
+		}
+	}
+
+	pn("	c.run = func(cs *cloudstack.CloudStackClient, opts ...cloudstack.CallOption) (interface{}, error) {")
+	p("		p := cs.%s.New%sParams(", field, n)
+	for i, ap := range required {
+		if i > 0 {
+			p(", ")
+		}
+		p("c.%s(%q)", cliFlagTypes[mapType(a.Name, ap.Name, ap.Type)][1], ap.Name)
+	}
+	pn(")")
+	for _, ap := range optional {
+		pn("		if c.isSet(%q) {", ap.Name)
+		pn("			p.Set%s(c.%s(%q))", capitalize(ap.Name), cliFlagTypes[mapType(a.Name, ap.Name, ap.Type)][1], ap.Name)
+		pn("		}")
+	}
+	pn("		return cs.%s.%s(p, opts...)", field, n)
+	pn("	}")
+	pn("	return c")
+	pn("}")
+	pn("")
+}
+
+func cliDir() (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	outdir := path.Join(wd, "cmd", "cloudstack-cli")
+
+	if err := os.MkdirAll(outdir, 0755); err != nil {
+		return "", fmt.Errorf("Failed to Mkdir %s: %v", outdir, err)
+	}
+	return outdir, nil
+}
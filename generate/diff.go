@@ -0,0 +1,390 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+// apiChange describes a single change of a command, param or response field
+type apiChange struct {
+	Kind     string `json:"kind"`
+	Command  string `json:"command"`
+	Name     string `json:"name,omitempty"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+// goChange describes a change of the generated Go API
+type goChange struct {
+	Service  string `json:"service"`
+	Kind     string `json:"kind"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+// apiDiff holds all changes between two listApis outputs. Breaking counts the breaking
+// changes of both the API and the generated Go API.
+type apiDiff struct {
+	Old       string      `json:"old"`
+	New       string      `json:"new"`
+	Changes   []apiChange `json:"changes"`
+	GoChanges []goChange  `json:"go_changes"`
+	Breaking  int         `json:"breaking"`
+}
+
+// runDiff implements the diff subcommand, which compares two listApis outputs
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	format := fs.String("format", "markdown", "output format, either markdown or json")
+	out := fs.String("out", "", "file to write the report to, defaults to stdout")
+	failOnBreaking := fs.Bool("fail-on-breaking", false, "exit with status 2 if there are breaking changes")
+	overridesFile := fs.String("overrides", "generate/overrides.yaml", "path to the file with the overrides for the quirks of the API")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: generate diff [flags] old-listApis.json new-listApis.json\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	var err error
+	if overrides, err = loadOverrides(*overridesFile); err != nil {
+		return err
+	}
+
+	d, err := diffAPIFiles(fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	var report []byte
+	switch *format {
+	case "markdown":
+		report = d.markdown()
+	case "json":
+		if report, err = json.MarshalIndent(d, "", "  "); err != nil {
+			return err
+		}
+		report = append(report, '\n')
+	default:
+		return fmt.Errorf("Unknown format: %s", *format)
+	}
+
+	if *out != "" {
+		err = ioutil.WriteFile(*out, report, 0644)
+	} else {
+		_, err = os.Stdout.Write(report)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *failOnBreaking && d.Breaking > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
+
+func diffAPIFiles(oldFile, newFile string) (*apiDiff, error) {
+	oldAPIs, err := getAPIInfo(oldFile)
+	if err != nil {
+		return nil, err
+	}
+	newAPIs, err := getAPIInfo(newFile)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &apiDiff{Old: oldFile, New: newFile, Changes: []apiChange{}, GoChanges: []goChange{}}
+
+	services := make(map[string]string)
+	for sn, apis := range layout {
+		for _, api := range apis {
+			services[api] = sn
+		}
+	}
+
+	for _, name := range unionKeys(oldAPIs, newAPIs) {
+		oldAPI, newAPI := oldAPIs[name], newAPIs[name]
+		sn := services[name]
+
+		switch {
+		case oldAPI == nil:
+			d.add(apiChange{Kind: "command-added", Command: name})
+			if sn == "" {
+				d.addGo(goChange{Kind: "not-generated", New: name + " has no mapping in layout.go"})
+			} else {
+				d.addGo(goChange{Service: sn, Kind: "method-added", New: methodSignature(name)})
+			}
+		case newAPI == nil:
+			d.add(apiChange{Kind: "command-removed", Command: name, Breaking: true})
+			if sn != "" {
+				d.addGo(goChange{Service: sn, Kind: "method-removed", Old: methodSignature(name), Breaking: true})
+			}
+		default:
+			d.diffAPI(oldAPI, newAPI)
+			if sn != "" {
+				d.diffGoAPI(&service{name: sn}, oldAPI, newAPI)
+			}
+		}
+	}
+
+	return d, nil
+}
+
+func (d *apiDiff) add(c apiChange) {
+	if c.Breaking {
+		d.Breaking++
+	}
+	d.Changes = append(d.Changes, c)
+}
+
+func (d *apiDiff) addGo(c goChange) {
+	if c.Breaking {
+		d.Breaking++
+	}
+	d.GoChanges = append(d.GoChanges, c)
+}
+
+func (d *apiDiff) diffAPI(oldAPI, newAPI *API) {
+	name := newAPI.Name
+
+	if oldAPI.Isasync != newAPI.Isasync {
+		d.add(apiChange{Kind: "async-changed", Command: name,
+			Old: fmt.Sprint(oldAPI.Isasync), New: fmt.Sprint(newAPI.Isasync), Breaking: true})
+	}
+
+	oldParams, newParams := paramsByName(oldAPI.Params), paramsByName(newAPI.Params)
+	for _, pn := range unionKeys(oldParams, newParams) {
+		op, np := oldParams[pn], newParams[pn]
+		switch {
+		case op == nil:
+			d.add(apiChange{Kind: "param-added", Command: name, Name: pn, New: paramDescription(np), Breaking: np.Required})
+		case np == nil:
+			d.add(apiChange{Kind: "param-removed", Command: name, Name: pn, Old: paramDescription(op), Breaking: true})
+		default:
+			if op.Type != np.Type {
+				d.add(apiChange{Kind: "param-retyped", Command: name, Name: pn, Old: op.Type, New: np.Type, Breaking: true})
+			}
+			if !op.Required && np.Required {
+				d.add(apiChange{Kind: "param-required", Command: name, Name: pn, Breaking: true})
+			}
+			if op.Required && !np.Required {
+				d.add(apiChange{Kind: "param-optional", Command: name, Name: pn})
+			}
+		}
+	}
+
+	oldFields, newFields := responseFields(oldAPI.Response, ""), responseFields(newAPI.Response, "")
+	for _, fn := range unionKeys(oldFields, newFields) {
+		of, nf := oldFields[fn], newFields[fn]
+		switch {
+		case of == nil:
+			d.add(apiChange{Kind: "response-added", Command: name, Name: fn, New: nf.Type})
+		case nf == nil:
+			d.add(apiChange{Kind: "response-removed", Command: name, Name: fn, Old: of.Type, Breaking: true})
+		case of.Type != nf.Type:
+			d.add(apiChange{Kind: "response-retyped", Command: name, Name: fn, Old: of.Type, New: nf.Type, Breaking: true})
+		}
+	}
+}
+
+// diffGoAPI reports the changes of the constructor and setters of the params type
+func (d *apiDiff) diffGoAPI(s *service, oldAPI, newAPI *API) {
+	oldCtor, newCtor := constructorSignature(s, oldAPI), constructorSignature(s, newAPI)
+	if oldCtor != newCtor {
+		d.addGo(goChange{Service: s.name, Kind: "constructor-changed", Old: oldCtor, New: newCtor, Breaking: true})
+	}
+
+	oldSetters, newSetters := setterSignatures(oldAPI), setterSignatures(newAPI)
+	for _, pn := range unionKeys(oldSetters, newSetters) {
+		oldSig, newSig := oldSetters[pn], newSetters[pn]
+		switch {
+		case oldSig == "":
+			d.addGo(goChange{Service: s.name, Kind: "setter-added", New: newSig})
+		case newSig == "":
+			d.addGo(goChange{Service: s.name, Kind: "setter-removed", Old: oldSig, Breaking: true})
+		case oldSig != newSig:
+			d.addGo(goChange{Service: s.name, Kind: "setter-changed", Old: oldSig, New: newSig, Breaking: true})
+		}
+	}
+}
+
+func methodSignature(name string) string {
+	n := capitalize(name)
+	return fmt.Sprintf("%s(p *%sParams, opts ...CallOption) (*%sResponse, error)", n, n, strings.TrimPrefix(n, "Configure"))
+}
+
+// constructorSignature returns the signature of the New...Params function, the same way
+// it is generated by generateNewParamTypeFunc
+func constructorSignature(s *service, a *API) string {
+	params := APIParams{}
+	for _, ap := range a.Params {
+		params = append(params, ap)
+	}
+	sort.Sort(params)
+
+	var args []string
+	for _, ap := range params {
+		if ap.Required || isRequiredParam(a, ap) {
+			args = append(args, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), mapType(a.Name, ap.Name, ap.Type)))
+		}
+	}
+	return fmt.Sprintf("New%sParams(%s)", capitalize(a.Name), strings.Join(args, ", "))
+}
+
+func setterSignatures(a *API) map[string]string {
+	setters := make(map[string]string)
+	for _, ap := range a.Params {
+		if _, found := setters[ap.Name]; !found {
+			setters[ap.Name] = fmt.Sprintf("(*%sParams).Set%s(v %s)",
+				capitalize(a.Name), capitalize(ap.Name), mapType(a.Name, ap.Name, ap.Type))
+		}
+	}
+	return setters
+}
+
+func paramsByName(params APIParams) map[string]*APIParam {
+	m := make(map[string]*APIParam)
+	for _, ap := range params {
+		if _, found := m[ap.Name]; !found {
+			m[ap.Name] = ap
+		}
+	}
+	return m
+}
+
+func paramDescription(ap *APIParam) string {
+	if ap.Required {
+		return ap.Type + ", required"
+	}
+	return ap.Type
+}
+
+// responseFields flattens the response fields, using dotted paths for nested fields
+func responseFields(fields APIResponses, prefix string) map[string]*APIResponse {
+	m := make(map[string]*APIResponse)
+	for _, f := range fields {
+		name := prefix + f.Name
+		if _, found := m[name]; found {
+			continue
+		}
+		m[name] = f
+		for k, v := range responseFields(f.Response, name+".") {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// unionKeys returns the sorted keys of two maps with the same key type
+func unionKeys(maps ...interface{}) []string {
+	seen := make(map[string]bool)
+	for _, m := range maps {
+		switch t := m.(type) {
+		case map[string]*API:
+			for k := range t {
+				seen[k] = true
+			}
+		case map[string]*APIParam:
+			for k := range t {
+				seen[k] = true
+			}
+		case map[string]*APIResponse:
+			for k := range t {
+				seen[k] = true
+			}
+		case map[string]string:
+			for k := range t {
+				seen[k] = true
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (d *apiDiff) markdown() []byte {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+	yesNo := func(b bool) string {
+		if b {
+			return "**yes**"
+		}
+		return "no"
+	}
+	code := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		return "`" + s + "`"
+	}
+
+	pn("# API changes")
+	pn("")
+	pn("Comparing `%s` to `%s`: %d API change(s) and %d Go API change(s), %d breaking.",
+		d.Old, d.New, len(d.Changes), len(d.GoChanges), d.Breaking)
+	pn("")
+	pn("## API")
+	pn("")
+	if len(d.Changes) == 0 {
+		pn("No changes.")
+	} else {
+		pn("| Command | Change | Name | Old | New | Breaking |")
+		pn("|---|---|---|---|---|---|")
+		for _, c := range d.Changes {
+			pn("| %s | %s | %s | %s | %s | %s |", code(c.Command), c.Kind, code(c.Name), c.Old, c.New, yesNo(c.Breaking))
+		}
+	}
+	pn("")
+	pn("## Go API")
+	pn("")
+	if len(d.GoChanges) == 0 {
+		pn("No changes.")
+	} else {
+		pn("| Service | Change | Old | New | Breaking |")
+		pn("|---|---|---|---|---|")
+		for _, c := range d.GoChanges {
+			pn("| %s | %s | %s | %s | %s |", c.Service, c.Kind, code(c.Old), code(c.New), yesNo(c.Breaking))
+		}
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,144 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDiffAPIFiles(t *testing.T) {
+	defer useOverrides(t, "overrides.yaml")()
+
+	dir, err := ioutil.TempDir("", "diff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeAPIs := func(name string, apis ...*API) string {
+		b, err := json.Marshal(map[string]interface{}{"count": len(apis), "api": apis})
+		if err != nil {
+			t.Fatal(err)
+		}
+		file := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(file, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	deleteZone := func(idType string, idRequired bool, params ...*APIParam) *API {
+		return &API{
+			Name:     "deleteZone",
+			Params:   append(APIParams{{Name: "id", Type: idType, Required: idRequired}}, params...),
+			Response: APIResponses{{Name: "displaytext", Type: "string"}, {Name: "success", Type: "boolean"}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		old, new  []*API
+		changes   []string
+		goChanges []string
+		breaking  int
+	}{
+		{
+			name: "no changes",
+			old:  []*API{deleteZone("uuid", true)},
+			new:  []*API{deleteZone("uuid", true)},
+		},
+		{
+			name:      "optional param added",
+			old:       []*API{deleteZone("uuid", true)},
+			new:       []*API{deleteZone("uuid", true, &APIParam{Name: "cleanup", Type: "boolean"})},
+			changes:   []string{"param-added"},
+			goChanges: []string{"setter-added"},
+		},
+		{
+			name:      "required param made optional",
+			old:       []*API{deleteZone("uuid", true)},
+			new:       []*API{deleteZone("uuid", false)},
+			changes:   []string{"param-optional"},
+			goChanges: []string{"constructor-changed"},
+			breaking:  1,
+		},
+		{
+			name:      "param retyped",
+			old:       []*API{deleteZone("uuid", true)},
+			new:       []*API{deleteZone("long", true)},
+			changes:   []string{"param-retyped"},
+			goChanges: []string{"constructor-changed", "setter-changed"},
+			breaking:  3,
+		},
+		{
+			name:      "command removed",
+			old:       []*API{deleteZone("uuid", true)},
+			new:       []*API{},
+			changes:   []string{"command-removed"},
+			goChanges: []string{"method-removed"},
+			breaking:  2,
+		},
+		{
+			name:      "unmapped command added",
+			old:       []*API{},
+			new:       []*API{{Name: "frobnicateWidget"}},
+			changes:   []string{"command-added"},
+			goChanges: []string{"not-generated"},
+		},
+		{
+			name:     "response field removed",
+			old:      []*API{deleteZone("uuid", true)},
+			new:      []*API{{Name: "deleteZone", Params: deleteZone("uuid", true).Params, Response: APIResponses{{Name: "success", Type: "boolean"}}}},
+			changes:  []string{"response-removed"},
+			breaking: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := diffAPIFiles(writeAPIs("old.json", tt.old...), writeAPIs("new.json", tt.new...))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var changes, goChanges []string
+			for _, c := range d.Changes {
+				changes = append(changes, c.Kind)
+			}
+			for _, c := range d.GoChanges {
+				goChanges = append(goChanges, c.Kind)
+			}
+			if !reflect.DeepEqual(changes, tt.changes) {
+				t.Errorf("expected the API changes %v, got %v", tt.changes, changes)
+			}
+			if !reflect.DeepEqual(goChanges, tt.goChanges) {
+				t.Errorf("expected the Go API changes %v, got %v", tt.goChanges, goChanges)
+			}
+			if d.Breaking != tt.breaking {
+				t.Errorf("expected %d breaking changes, got %d", tt.breaking, d.Breaking)
+			}
+		})
+	}
+}
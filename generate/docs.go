@@ -0,0 +1,145 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// commentWidth is the max width of the generated doc comments, excluding the indent
+const commentWidth = 100
+
+// sanitizeDescription turns a description from the API metadata into a single line of
+// text that can be used in a comment, by removing control characters and HTML line
+// breaks and collapsing all whitespace
+func sanitizeDescription(s string) string {
+	s = strings.NewReplacer("<br>", " ", "<br/>", " ", "<br />", " ").Replace(s)
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return ' '
+		case !unicode.IsPrint(r):
+			return -1
+		default:
+			return r
+		}
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// sentence returns a sanitized description that ends with a period
+func sentence(s string) string {
+	s = sanitizeDescription(s)
+	if s != "" && !strings.HasSuffix(s, ".") {
+		s += "."
+	}
+	return s
+}
+
+// wrapComment wraps text into comment lines prefixed with the indent. Paragraphs are
+// separated by an empty line, and words longer than the width are never broken.
+func wrapComment(indent string, paragraphs ...string) []string {
+	var lines []string
+	for _, p := range paragraphs {
+		words := strings.Fields(p)
+		if len(words) == 0 {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, indent+"//")
+		}
+
+		line := indent + "//"
+		for _, w := range words {
+			if len(line) > len(indent)+2 && len(line)+1+len(w) > len(indent)+commentWidth {
+				lines = append(lines, line)
+				line = indent + "//"
+			}
+			line += " " + w
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// printComment prints a wrapped comment using the given print function
+func printComment(pn func(format string, args ...interface{}), indent string, paragraphs ...string) {
+	for _, line := range wrapComment(indent, paragraphs...) {
+		pn("%s", line)
+	}
+}
+
+// hasParamDescriptions returns true if any param in the API info has a description. The
+// listApis output of a management server always has them, so without any description the
+// doc comments are generated from incomplete metadata.
+func hasParamDescriptions(ai map[string]*API) bool {
+	for _, a := range ai {
+		for _, ap := range a.Params {
+			if strings.TrimSpace(ap.Description) != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// apiComment returns the paragraphs of the doc comment of the method calling an API
+func apiComment(a *API) []string {
+	paragraphs := []string{sentence(a.Description)}
+
+	var notes []string
+	if a.Isasync {
+		notes = append(notes, "This is an async command. An async client waits for the job to finish, "+
+			"otherwise only the job ID is returned (see NewAsyncClient and GetAsyncJobResult).")
+	}
+	var required []string
+	for _, ap := range a.Params {
+		if ap.Required || isRequiredParam(a, ap) {
+			required = append(required, ap.Name)
+		}
+	}
+	if len(required) > 0 {
+		notes = append(notes, fmt.Sprintf("Required params: %s.", strings.Join(required, ", ")))
+	}
+	if a.Since != "" {
+		notes = append(notes, fmt.Sprintf("Available since CloudStack %s.", sanitizeDescription(a.Since)))
+	}
+
+	return append(paragraphs, strings.Join(notes, " "))
+}
+
+// setterComment returns the doc comment of the setter of a param
+func setterComment(a *API, ap *APIParam) string {
+	c := fmt.Sprintf("Set%s sets the %s param", capitalize(ap.Name), ap.Name)
+	if desc := sentence(ap.Description); desc != "" {
+		c += ": " + desc
+	} else {
+		c += "."
+	}
+	if ap.Required || isRequiredParam(a, ap) {
+		c += " This param is required."
+	}
+	if ap.Since != "" {
+		c += fmt.Sprintf(" Available since CloudStack %s.", sanitizeDescription(ap.Since))
+	}
+	return c
+}
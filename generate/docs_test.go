@@ -0,0 +1,215 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeDescription(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"  Lists   zones ", "Lists zones"},
+		{"the ID of the zone.<br>Only for admins<br/>and<br />domain admins", "the ID of the zone. Only for admins and domain admins"},
+		{"line\nbreak\ttab", "line break tab"},
+		{"control\x00\x1bchars", "controlchars"},
+	}
+
+	for _, tt := range tests {
+		if got := sanitizeDescription(tt.in); got != tt.want {
+			t.Errorf("sanitizeDescription(%q) = %q, expected %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWrapComment(t *testing.T) {
+	long := strings.Repeat("word ", 30)
+	lines := wrapComment("\t", long, "", "Second paragraph.")
+	for _, l := range lines {
+		if len(l) > 1+commentWidth {
+			t.Errorf("expected lines of at most %d characters, got %d: %q", commentWidth, len(l)-1, l)
+		}
+		if !strings.HasPrefix(l, "\t//") {
+			t.Errorf("expected the indent and comment prefix, got %q", l)
+		}
+	}
+	if n := len(lines); n < 4 || lines[n-2] != "\t//" || lines[n-1] != "\t// Second paragraph." {
+		t.Errorf("expected two paragraphs separated by an empty comment line, got %q", lines)
+	}
+
+	if got := wrapComment("", "a "+strings.Repeat("x", commentWidth+10)); len(got) != 2 {
+		t.Errorf("expected long words to be put on their own line, got %q", got)
+	}
+}
+
+func TestAPIComment(t *testing.T) {
+	a := &API{
+		Name:        "createBucket",
+		Description: "Creates a bucket",
+		Isasync:     true,
+		Since:       "4.19.0",
+		Params: APIParams{
+			{Name: "name", Type: "string", Required: true},
+			{Name: "objectstorageid", Type: "uuid", Required: true},
+			{Name: "quota", Type: "integer"},
+		},
+	}
+
+	got := apiComment(a)
+	if got[0] != "Creates a bucket." {
+		t.Errorf("expected the description as the first paragraph, got %q", got[0])
+	}
+	for _, want := range []string{
+		"This is an async command.",
+		"Required params: name, objectstorageid.",
+		"Available since CloudStack 4.19.0.",
+	} {
+		if !strings.Contains(got[1], want) {
+			t.Errorf("expected the notes to contain %q, got %q", want, got[1])
+		}
+	}
+
+	if got := apiComment(&API{Name: "listZones"}); !reflect.DeepEqual(got, []string{"", ""}) {
+		t.Errorf("expected an empty comment without description, got %q", got)
+	}
+}
+
+func TestSetterComment(t *testing.T) {
+	a := &API{Name: "createBucket", Params: APIParams{
+		{Name: "name", Type: "string", Required: true, Description: "the name of the bucket"},
+		{Name: "quota", Type: "integer", Since: "4.19.1"},
+	}}
+
+	tests := []struct {
+		param *APIParam
+		want  string
+	}{
+		{a.Params[0], "SetName sets the name param: the name of the bucket. This param is required."},
+		{a.Params[1], "SetQuota sets the quota param. Available since CloudStack 4.19.1."},
+	}
+
+	for _, tt := range tests {
+		if got := setterComment(a, tt.param); got != tt.want {
+			t.Errorf("setterComment(%s) = %q, expected %q", tt.param.Name, got, tt.want)
+		}
+	}
+}
+
+func TestGeneratedDocComments(t *testing.T) {
+	defer useOverrides(t, "overrides.yaml")()
+
+	s := &service{name: "ObjectStoreService", apis: []*API{{
+		Name:        "createBucket",
+		Description: "Creates a bucket",
+		Since:       "4.19.0",
+		Params: APIParams{
+			{Name: "name", Type: "string", Required: true, Description: "the name of the bucket"},
+			{Name: "quota", Type: "integer", Description: "bucket quota in GB", Since: "4.19.1"},
+		},
+		Response: APIResponses{
+			{Name: "id", Type: "string", Description: "ID of the bucket"},
+			{Name: "name", Type: "string", Description: "name of the bucket"},
+		},
+	}}}
+
+	code, err := s.GenerateCode()
+	if err != nil {
+		t.Fatalf("failed to generate the code: %v", err)
+	}
+
+	for _, want := range []string{
+		"// Creates a bucket.\n//\n// Required params: name. Available since CloudStack 4.19.0.\nfunc (s *ObjectStoreService) CreateBucket(",
+		"// SetName sets the name param: the name of the bucket. This param is required.\nfunc (p *CreateBucketParams) SetName(",
+		"// SetQuota sets the quota param: bucket quota in GB. Available since CloudStack 4.19.1.\nfunc (p *CreateBucketParams) SetQuota(",
+		"\t// ID of the bucket.\n\tId ",
+		"\t// name of the bucket.\n\tName ",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("expected the generated code to contain %q", want)
+		}
+	}
+}
+
+func TestHasParamDescriptions(t *testing.T) {
+	tests := []struct {
+		name string
+		ai   map[string]*API
+		want bool
+	}{
+		{"no APIs", map[string]*API{}, false},
+		{"no descriptions", map[string]*API{"listZones": {Params: APIParams{{Name: "id", Description: " "}}}}, false},
+		{"descriptions", map[string]*API{
+			"listZones":   {Params: APIParams{{Name: "id"}}},
+			"deleteZones": {Params: APIParams{{Name: "id", Description: "the ID of the zone"}}},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		if got := hasParamDescriptions(tt.ai); got != tt.want {
+			t.Errorf("%s: hasParamDescriptions() = %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestGeneratedSetterComments checks that every generated setter is documented with the
+// description and since notes of its param in the API targets of the Makefile, so the
+// committed code cannot lose the docs of the listApis metadata it is generated from
+func TestGeneratedSetterComments(t *testing.T) {
+	defer useOverrides(t, "overrides.yaml")()
+	ai, _ := makefileAPIInfo(t)
+
+	checked := 0
+	for sn, apis := range layout {
+		b, err := ioutil.ReadFile("../cloudstack/" + sn + ".go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		code := string(b)
+
+		for _, name := range apis {
+			a, ok := ai[name]
+			if !ok {
+				continue
+			}
+			found := make(map[string]bool)
+			for _, ap := range a.Params {
+				if found[ap.Name] {
+					continue
+				}
+				found[ap.Name] = true
+
+				comment := strings.Join(wrapComment("", setterComment(a, ap)), "\n")
+				setter := comment + "\nfunc (p *" + capitalize(a.Name+"Params") + ") Set" + capitalize(ap.Name) + "("
+				if !strings.Contains(code, setter) {
+					t.Errorf("%s: the setter of the %s param of %s is not documented as:\n%s", sn, ap.Name, name, comment)
+				}
+				checked++
+			}
+		}
+	}
+	if checked == 0 {
+		t.Errorf("expected the setters of the API info to be checked")
+	}
+}
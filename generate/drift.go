@@ -0,0 +1,318 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// layoutOptions sets how drift between layout.go and the API info is handled
+type layoutOptions struct {
+	strict    bool   // fail on commands missing from layout.go or from the API info
+	autoGroup bool   // generate the commands missing from layout.go in their proposed service
+	report    string // file to write the proposed layout.go changes to
+}
+
+// layoutProposal proposes a service for a command that is missing from layout.go
+type layoutProposal struct {
+	command string
+	service string
+	isNew   bool   // the service is not in layout.go yet
+	reason  string // why the service was chosen
+}
+
+// staleCommand is a command in layout.go that is missing from the API info
+type staleCommand struct {
+	service string
+	command string
+}
+
+// layoutDrift holds the differences between layout.go and the API info
+type layoutDrift struct {
+	missing []*layoutProposal
+	stale   []*staleCommand
+}
+
+func (d *layoutDrift) hasDrift() bool {
+	return len(d.missing) > 0 || len(d.stale) > 0
+}
+
+// checkLayout compares the layout with the API info, and proposes a service for every
+// command missing from the layout
+func checkLayout(l apiInfo, ai map[string]*API) *layoutDrift {
+	d := &layoutDrift{}
+
+	mapped := make(map[string]bool)
+	for sn, apis := range l {
+		for _, api := range apis {
+			mapped[api] = true
+			if _, ok := ai[api]; !ok {
+				d.stale = append(d.stale, &staleCommand{service: sn, command: api})
+			}
+		}
+	}
+	sort.Slice(d.stale, func(i, j int) bool {
+		if d.stale[i].service != d.stale[j].service {
+			return d.stale[i].service < d.stale[j].service
+		}
+		return d.stale[i].command < d.stale[j].command
+	})
+
+	var missing []string
+	for api := range ai {
+		if !mapped[api] {
+			missing = append(missing, api)
+		}
+	}
+	// Handle the shortest nouns first, so a new service proposed for createBucket
+	// is also proposed for createBucketPolicy
+	sort.Slice(missing, func(i, j int) bool {
+		wi, wj := len(nounWords(missing[i])), len(nounWords(missing[j]))
+		if wi != wj {
+			return wi < wj
+		}
+		return missing[i] < missing[j]
+	})
+
+	idx := newNounIndex(l)
+	for _, api := range missing {
+		p := idx.propose(api)
+		d.missing = append(d.missing, p)
+		idx.add(nounWords(api), p.service)
+	}
+	sort.Slice(d.missing, func(i, j int) bool {
+		return d.missing[i].command < d.missing[j].command
+	})
+
+	return d
+}
+
+// apply returns a copy of the layout with the proposed services of the missing commands
+func (d *layoutDrift) apply(l apiInfo) apiInfo {
+	applied := make(apiInfo, len(l))
+	for sn, apis := range l {
+		applied[sn] = append([]string{}, apis...)
+	}
+	for _, p := range d.missing {
+		applied[p.service] = append(applied[p.service], p.command)
+	}
+	return applied
+}
+
+func (d *layoutDrift) log(autoGroup bool) {
+	for _, p := range d.missing {
+		if autoGroup {
+			log.Printf("Api missing in layout: %s, generated in %s", p.command, p.service)
+		} else {
+			log.Printf("Api missing in layout: %s, proposed service: %s", p.command, p.service)
+		}
+	}
+	if len(d.missing) > 0 {
+		log.Printf("%d API(s) have no mapping in layout.go", len(d.missing))
+	}
+
+	for _, s := range d.stale {
+		log.Printf("Api in layout not found in the API info: %s (%s)", s.command, s.service)
+	}
+	if len(d.stale) > 0 {
+		log.Printf("%d API(s) in layout.go are not found in the API info", len(d.stale))
+	}
+}
+
+func (d *layoutDrift) error() error {
+	return fmt.Errorf("layout.go does not match the API info: %d API(s) have no mapping in layout.go "+
+		"and %d API(s) in layout.go are not found, use --layout-report to get the proposed changes",
+		len(d.missing), len(d.stale))
+}
+
+// markdown returns a report with the proposed changes of layout.go
+func (d *layoutDrift) markdown() []byte {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+
+	pn("# Proposed layout.go changes")
+	pn("")
+	pn("%d API(s) have no mapping in layout.go, %d API(s) in layout.go are not found in the API info.",
+		len(d.missing), len(d.stale))
+
+	added := make(map[string][]*layoutProposal)
+	var existing, created []string
+	for _, p := range d.missing {
+		if len(added[p.service]) == 0 {
+			if p.isNew {
+				created = append(created, p.service)
+			} else {
+				existing = append(existing, p.service)
+			}
+		}
+		added[p.service] = append(added[p.service], p)
+	}
+	sort.Strings(existing)
+	sort.Strings(created)
+
+	if len(existing) > 0 {
+		pn("")
+		pn("## Add to existing services")
+		for _, sn := range existing {
+			pn("")
+			pn("### %s", sn)
+			pn("")
+			for _, p := range added[sn] {
+				pn("- `%s`: %s", p.command, p.reason)
+			}
+		}
+	}
+
+	if len(created) > 0 {
+		pn("")
+		pn("## Add new services")
+		pn("")
+		pn("```go")
+		for _, sn := range created {
+			pn("\t%q: {", sn)
+			for _, p := range added[sn] {
+				pn("\t\t%q,", p.command)
+			}
+			pn("\t},")
+		}
+		pn("```")
+	}
+
+	if len(d.stale) > 0 {
+		pn("")
+		pn("## Remove")
+		pn("")
+		for _, s := range d.stale {
+			pn("- `%s` from %s", s.command, s.service)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func (d *layoutDrift) writeReport(file string) error {
+	return ioutil.WriteFile(file, d.markdown(), 0644)
+}
+
+// nounIndex maps the nouns of the commands in the layout to the services they are in
+type nounIndex struct {
+	nouns    map[string]map[string]int
+	services map[string]string
+}
+
+func newNounIndex(l apiInfo) *nounIndex {
+	idx := &nounIndex{
+		nouns:    make(map[string]map[string]int),
+		services: make(map[string]string),
+	}
+	for sn, apis := range l {
+		idx.services[normalizeNoun([]string{strings.TrimSuffix(sn, "Service")})] = sn
+		for _, api := range apis {
+			idx.add(nounWords(api), sn)
+		}
+	}
+	return idx
+}
+
+func (idx *nounIndex) add(words []string, service string) {
+	n := normalizeNoun(words)
+	if idx.nouns[n] == nil {
+		idx.nouns[n] = make(map[string]int)
+	}
+	idx.nouns[n][service]++
+}
+
+// lookup returns the service most commands with the noun are in
+func (idx *nounIndex) lookup(words []string) (string, bool) {
+	best, count := "", 0
+	for sn, c := range idx.nouns[normalizeNoun(words)] {
+		if c > count || (c == count && sn < best) {
+			best, count = sn, c
+		}
+	}
+	return best, count > 0
+}
+
+// propose proposes a service for a command, based on the noun of the command. The
+// service of other commands with the same noun is used, or else the service of the
+// longest leading part of the noun. Otherwise a new service is proposed.
+func (idx *nounIndex) propose(api string) *layoutProposal {
+	words := nounWords(api)
+	noun := strings.Join(words, "")
+
+	if sn, ok := idx.lookup(words); ok {
+		if noun == "" {
+			return &layoutProposal{command: api, service: sn, reason: "no noun, like other commands of the service"}
+		}
+		return &layoutProposal{command: api, service: sn, reason: fmt.Sprintf("same noun (%s) as other commands", noun)}
+	}
+	for k := len(words) - 1; k > 0; k-- {
+		part := strings.Join(words[:k], "")
+		if sn, ok := idx.lookup(words[:k]); ok {
+			return &layoutProposal{command: api, service: sn, reason: fmt.Sprintf("noun starts with %s", part)}
+		}
+		if sn, ok := idx.services[normalizeNoun(words[:k])]; ok {
+			return &layoutProposal{command: api, service: sn, reason: fmt.Sprintf("noun starts with %s", part)}
+		}
+	}
+
+	sn := parseSingular(noun) + "Service"
+	if noun == "" {
+		sn = capitalize(api) + "Service"
+	}
+	idx.services[normalizeNoun(words)] = sn
+	return &layoutProposal{command: api, service: sn, isNew: true, reason: "new noun"}
+}
+
+// nounWords returns the words of the noun of a command, which is the command without the
+// leading verb, as in createVPCOffering which has the words VPC and Offering
+func nounWords(api string) []string {
+	i := strings.IndexFunc(api, unicode.IsUpper)
+	if i < 0 {
+		return nil
+	}
+	noun := []rune(api[i:])
+
+	var words []string
+	start := 0
+	for j := 1; j < len(noun); j++ {
+		lowerToUpper := unicode.IsLower(noun[j-1]) && unicode.IsUpper(noun[j])
+		acronymEnd := unicode.IsUpper(noun[j-1]) && unicode.IsUpper(noun[j]) && j+1 < len(noun) && unicode.IsLower(noun[j+1])
+		if lowerToUpper || acronymEnd {
+			words = append(words, string(noun[start:j]))
+			start = j
+		}
+	}
+	return append(words, string(noun[start:]))
+}
+
+// normalizeNoun returns the lower cased singular form of a noun, so listZones and
+// createZone have the same noun
+func normalizeNoun(words []string) string {
+	return strings.ToLower(parseSingular(strings.Join(words, "")))
+}
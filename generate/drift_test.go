@@ -0,0 +1,167 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckLayout(t *testing.T) {
+	layout := apiInfo{
+		"ZoneService":           {"listZones", "createZone"},
+		"VPCService":            {"createVPCOffering"},
+		"VirtualMachineService": {"deployVirtualMachine"},
+	}
+
+	tests := []struct {
+		name    string
+		apis    []string
+		missing map[string]string // command -> proposed service
+		isNew   []string
+		stale   []string
+	}{
+		{
+			name: "no drift",
+			apis: []string{"listZones", "createZone", "createVPCOffering", "deployVirtualMachine"},
+		},
+		{
+			name:  "stale commands",
+			apis:  []string{"listZones", "createVPCOffering"},
+			stale: []string{"VirtualMachineService/deployVirtualMachine", "ZoneService/createZone"},
+		},
+		{
+			name: "same noun",
+			apis: []string{"listZones", "createZone", "deleteZone", "createVPCOffering", "deployVirtualMachine"},
+			missing: map[string]string{
+				"deleteZone": "ZoneService",
+			},
+		},
+		{
+			name: "leading part of the noun",
+			apis: []string{"listZones", "createZone", "createVPCOffering", "deployVirtualMachine", "updateVPCOfferingPolicy"},
+			missing: map[string]string{
+				"updateVPCOfferingPolicy": "VPCService",
+			},
+		},
+		{
+			name: "new service shared by the commands of a new noun",
+			apis: []string{"listZones", "createZone", "createVPCOffering", "deployVirtualMachine",
+				"createBucket", "listBuckets", "createBucketPolicy"},
+			missing: map[string]string{
+				"createBucket":       "BucketService",
+				"listBuckets":        "BucketService",
+				"createBucketPolicy": "BucketService",
+			},
+			isNew: []string{"createBucket"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ai := make(map[string]*API)
+			for _, name := range tt.apis {
+				ai[name] = &API{Name: name}
+			}
+
+			d := checkLayout(layout, ai)
+			if d.hasDrift() != (len(tt.missing) > 0 || len(tt.stale) > 0) {
+				t.Errorf("hasDrift() = %v", d.hasDrift())
+			}
+
+			missing := make(map[string]string)
+			var isNew []string
+			for _, p := range d.missing {
+				missing[p.command] = p.service
+				if p.isNew {
+					isNew = append(isNew, p.command)
+				}
+			}
+			if len(missing) == 0 {
+				missing = nil
+			}
+			if !reflect.DeepEqual(missing, tt.missing) {
+				t.Errorf("expected missing commands %v, got %v", tt.missing, missing)
+			}
+			if !reflect.DeepEqual(isNew, tt.isNew) {
+				t.Errorf("expected new services for %v, got %v", tt.isNew, isNew)
+			}
+
+			var stale []string
+			for _, s := range d.stale {
+				stale = append(stale, s.service+"/"+s.command)
+			}
+			if !reflect.DeepEqual(stale, tt.stale) {
+				t.Errorf("expected stale commands %v, got %v", tt.stale, stale)
+			}
+
+			applied := d.apply(layout)
+			for command, service := range tt.missing {
+				if !contains(applied[service], command) {
+					t.Errorf("expected %s to be applied to %s, got %v", command, service, applied[service])
+				}
+			}
+			if len(layout["ZoneService"]) != 2 {
+				t.Errorf("expected apply to leave the layout unchanged, got %v", layout["ZoneService"])
+			}
+		})
+	}
+}
+
+func TestLayoutDriftMarkdown(t *testing.T) {
+	d := &layoutDrift{
+		missing: []*layoutProposal{
+			{command: "createBucket", service: "BucketService", isNew: true, reason: "new noun"},
+			{command: "deleteZone", service: "ZoneService", reason: "same noun (Zone) as other commands"},
+		},
+		stale: []*staleCommand{{service: "ZoneService", command: "createZone"}},
+	}
+
+	report := string(d.markdown())
+	for _, want := range []string{
+		"2 API(s) have no mapping in layout.go, 1 API(s) in layout.go are not found in the API info.",
+		"### ZoneService\n\n- `deleteZone`: same noun (Zone) as other commands",
+		"\t\"BucketService\": {\n\t\t\"createBucket\",\n\t},",
+		"## Remove\n\n- `createZone` from ZoneService",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected the report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
+
+func TestNounWords(t *testing.T) {
+	tests := []struct {
+		api  string
+		want []string
+	}{
+		{"listZones", []string{"Zones"}},
+		{"createVPCOffering", []string{"VPC", "Offering"}},
+		{"addIpToNic", []string{"Ip", "To", "Nic"}},
+		{"login", nil},
+	}
+
+	for _, tt := range tests {
+		if got := nounWords(tt.api); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("nounWords(%q) = %q, expected %q", tt.api, got, tt.want)
+		}
+	}
+}
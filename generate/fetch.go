@@ -0,0 +1,178 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// fetchAPIs calls listApis on a live management server and saves the normalised
+// output as a versioned artifact in dir. It returns the path of the saved file and the
+// version of the server, which is empty if it cannot be determined.
+func fetchAPIs(apiURL, apiKey, secret string, verifySSL bool, dir string) (string, string, error) {
+	client := &http.Client{
+		Timeout: 5 * time.Minute,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: !verifySSL},
+		},
+	}
+
+	apis, err := callAPI(client, apiURL, apiKey, secret, "listApis")
+	if err != nil {
+		return "", "", err
+	}
+
+	normalised, err := normaliseAPIs(apis)
+	if err != nil {
+		return "", "", err
+	}
+
+	var version string
+	if caps, err := callAPI(client, apiURL, apiKey, secret, "listCapabilities"); err == nil {
+		var c struct {
+			Capability struct {
+				Version string `json:"cloudstackversion"`
+			} `json:"capability"`
+		}
+		if err := json.Unmarshal(caps, &c); err == nil && c.Capability.Version != "" {
+			version = c.Capability.Version
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", "", fmt.Errorf("Failed to Mkdir %s: %v", dir, err)
+	}
+
+	name := "unknown"
+	if version != "" {
+		name = safeVersion(version)
+	}
+	file := path.Join(dir, "listApis-"+name+".json")
+	if err := ioutil.WriteFile(file, normalised, 0644); err != nil {
+		return "", "", err
+	}
+
+	return file, version, nil
+}
+
+// callAPI makes a signed GET call and returns the unwrapped response
+func callAPI(client *http.Client, apiURL, apiKey, secret, command string) (json.RawMessage, error) {
+	params := url.Values{}
+	params.Set("apiKey", apiKey)
+	params.Set("command", command)
+	params.Set("response", "json")
+
+	// Sign the call the same way the generated client does
+	query := strings.Replace(params.Encode(), "+", "%20", -1)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.ToLower(query)))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	resp, err := client.Get(apiURL + "?" + query + "&signature=" + url.QueryEscape(signature))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(b, &wrapper); err != nil {
+		return nil, fmt.Errorf("Failed to parse the %s response: %v", command, err)
+	}
+
+	raw, ok := wrapper[strings.ToLower(command)+"response"]
+	if !ok {
+		return nil, fmt.Errorf("Unexpected %s response: %s", command, string(b))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s failed with status %d: %s", command, resp.StatusCode, string(raw))
+	}
+
+	return raw, nil
+}
+
+// normaliseAPIs sorts all APIs, params and response fields by name and indents the
+// result, so the saved output of different calls or releases can be diffed
+func normaliseAPIs(raw json.RawMessage) ([]byte, error) {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+
+	sortByName(v)
+
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// sortByName recursively sorts all lists of named objects by their name
+func sortByName(v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for _, child := range t {
+			sortByName(child)
+		}
+	case []interface{}:
+		for _, child := range t {
+			sortByName(child)
+		}
+		sort.SliceStable(t, func(i, j int) bool {
+			return objectName(t[i]) < objectName(t[j])
+		})
+	}
+}
+
+func objectName(v interface{}) string {
+	if m, ok := v.(map[string]interface{}); ok {
+		if name, ok := m["name"].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+var unsafeVersionChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func safeVersion(version string) string {
+	return unsafeVersionChars.ReplaceAllString(version, "_")
+}
@@ -0,0 +1,340 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// exampleDate is used as the value of all date fields of the example responses
+const exampleDate = "2023-01-02T03:04:05+0000"
+
+// exampleUUID returns a UUID that is unique for the given key, but the same on every run
+func exampleUUID(key string) string {
+	h := sha1.Sum([]byte(key))
+	return fmt.Sprintf("%x-%x-%x-%x-%x", h[0:4], h[4:6], h[6:8], h[8:10], h[10:16])
+}
+
+// writeFixtures writes the example responses of all APIs of the service, in the same
+// format as the captured responses in test/testdata
+func (s *service) writeFixtures(testdir string) error {
+	dir := path.Join(testdir, "testdata", "generated")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("Failed to Mkdir %s: %v", dir, err)
+	}
+
+	fixtures := make(map[string]interface{})
+	for _, a := range s.apis {
+		fixtures[a.Name] = exampleResponse(a)
+	}
+	b, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(dir, s.name+".json"), append(b, '\n'), 0644)
+}
+
+// exampleResponse returns an example response of an API. The response of an async API
+// contains the result of the finished job.
+func exampleResponse(a *API) map[string]interface{} {
+	o := overrides.command(a.Name)
+	noun := strings.ToLower(parseSingular(capitalize(strings.TrimPrefix(a.Name, "list"))))
+	result := exampleResult(a)
+
+	var body interface{}
+	switch {
+	case a.Isasync:
+		// The job result of an async API wraps the returned object in an extra object,
+		// unless the API only returns if it succeeded
+		jobresult := result
+		if !isSuccessOnlyResponse(a.Response) {
+			jobresult = map[string]interface{}{noun: result}
+		}
+		body = map[string]interface{}{
+			"jobid":         exampleUUID(a.Name + "/jobid"),
+			"jobstatus":     1,
+			"jobresultcode": 0,
+			"jobresulttype": "object",
+			"jobresult":     jobresult,
+		}
+	case o.RawValueResponse:
+		body = map[string]interface{}{noun: result}
+	case o.NestedResponse != "":
+		body = map[string]interface{}{o.NestedResponse: result}
+	default:
+		body = result
+	}
+	return map[string]interface{}{strings.ToLower(a.Name) + "response": body}
+}
+
+// exampleResult returns the example object that is decoded into the response type of an API
+func exampleResult(a *API) interface{} {
+	o := overrides.command(a.Name)
+	obj := exampleObject(a.Name, a.Name, a.Response, a.Isasync)
+
+	switch {
+	case o.SingleObject != nil:
+		return map[string]interface{}{o.SingleObject.Key: obj}
+	case strings.HasPrefix(a.Name, "list") || o.ListResponseKey != "":
+		key := o.ListResponseKey
+		if key == "" {
+			key = strings.ToLower(parseSingular(capitalize(strings.TrimPrefix(a.Name, "list"))))
+		}
+		return map[string]interface{}{"count": 1, key: []interface{}{obj}}
+	default:
+		return obj
+	}
+}
+
+// exampleObject returns an example object with a value for every response field, using
+// the same types as the generated response structs (see recusiveGenerateResponseType)
+func exampleObject(aName, key string, resp APIResponses, async bool) map[string]interface{} {
+	obj := make(map[string]interface{})
+	for _, r := range resp {
+		if r.Name == "" {
+			continue
+		}
+		if _, found := obj[r.Name]; found {
+			continue
+		}
+
+		f := overrides.responseField(r.Name)
+		switch {
+		case len(f.ListFields) > 0:
+			item := make(map[string]interface{})
+			for _, name := range f.ListFields {
+				item[name] = exampleValue(key+"/"+r.Name+"/"+name, name, "string", "string")
+			}
+			obj[r.Name] = []interface{}{item}
+		case r.Response != nil:
+			obj[r.Name] = []interface{}{exampleObject(aName, key+"/"+r.Name, r.Response, false)}
+		case f.StringBool:
+			if async || key != aName {
+				obj[r.Name] = true
+			} else {
+				// Sync calls return the field as a string
+				obj[r.Name] = "true"
+			}
+		case f.NumberString:
+			obj[r.Name] = exampleUUID(key + "/" + r.Name)
+		default:
+			obj[r.Name] = exampleValue(key+"/"+r.Name, r.Name, r.Type, mapType(aName, r.Name, r.Type))
+		}
+	}
+	return obj
+}
+
+func exampleValue(key, name, apiType, goType string) interface{} {
+	switch goType {
+	case "string":
+		switch {
+		case apiType == "date":
+			return exampleDate
+		case name == "id" || strings.HasSuffix(name, "id"):
+			return exampleUUID(key)
+		default:
+			return name
+		}
+	case "UUID":
+		return exampleUUID(key)
+	case "bool":
+		return true
+	case "int", "int64":
+		return 1
+	case "float64":
+		return 1.5
+	case "[]string":
+		return []string{name}
+	case "[]int64":
+		return []int{1}
+	case "[]float64":
+		return []float64{1.5}
+	case "map[string]string":
+		return map[string]string{"key": "value"}
+	case "[]map[string]string":
+		return []map[string]string{{"key": "value"}}
+	case "[]interface{}":
+		return []interface{}{}
+	}
+
+	switch {
+	case strings.HasPrefix(goType, "[]*"):
+		return []interface{}{map[string]interface{}{"id": exampleUUID(key + "/id")}}
+	case strings.HasPrefix(goType, "*"):
+		return map[string]interface{}{"id": exampleUUID(key + "/id")}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// exampleParam returns a Go literal with an example value for a param, and the exact
+// url.Values that value is expected to be encoded as (see generateConvertCode)
+func exampleParam(a *API, ap *APIParam) (string, map[string]string) {
+	name := ap.Name
+	switch mapType(a.Name, ap.Name, ap.Type) {
+	case "UUID":
+		id := exampleUUID(a.Name + "/" + name)
+		return fmt.Sprintf("%q", id), map[string]string{name: id}
+	case "bool":
+		return "true", map[string]string{name: "true"}
+	case "int":
+		return "1", map[string]string{name: "1"}
+	case "int64":
+		return "2", map[string]string{name: "2"}
+	case "float64":
+		return "1.5", map[string]string{name: "1.5"}
+	case "[]string":
+		return fmt.Sprintf("[]string{%q, %q}", name+"1", name+"2"), map[string]string{name: name + "1," + name + "2"}
+	case "[]map[string]string":
+		return `[]map[string]string{{"key1": "value1"}, {"key2": "value2"}}`, map[string]string{
+			name + "[0].key1": "value1",
+			name + "[1].key2": "value2",
+		}
+	case "map[string]string":
+		u := make(map[string]string)
+		keyField, valueField, zeroIndex := mapEncoding(a.Name, name)
+		for i, k := range []string{"key1", "key2"} {
+			v := strings.Replace(k, "key", "value", 1)
+			switch {
+			case keyField == "" && zeroIndex:
+				u[fmt.Sprintf("%s[0].%s", name, k)] = v
+			case keyField == "":
+				u[fmt.Sprintf("%s[%d].%s", name, i, k)] = v
+			default:
+				u[fmt.Sprintf("%s[%d].%s", name, i, keyField)] = k
+				u[fmt.Sprintf("%s[%d].%s", name, i, valueField)] = v
+			}
+		}
+		return `map[string]string{"key1": "value1", "key2": "value2"}`, u
+	default:
+		return fmt.Sprintf("%q", name), map[string]string{name: name}
+	}
+}
+
+// generateFixtureTest generates a test that sets all params of an API and checks their
+// encoding, and then checks the example response is decoded and async APIs wait for the
+// result of their job
+func (s *service) generateFixtureTest(a *API) {
+	p, pn := s.p, s.pn
+	n := capitalize(a.Name)
+	field := strings.TrimSuffix(s.name, "Service")
+
+	pn("	t.Run(\"%s\", func(t *testing.T) {", n)
+	if a.Isasync {
+		pn("		defer server.checkCommands(t, %q, \"queryAsyncJobResult\")", a.Name)
+	} else {
+		pn("		defer server.checkCommands(t, %q)", a.Name)
+	}
+	pn("")
+
+	expected := make(map[string]string)
+	var optional []*APIParam
+	found := make(map[string]bool)
+	p("		p := client.%s.New%sParams(", field, n)
+	for _, ap := range a.Params {
+		if found[ap.Name] {
+			continue
+		}
+		found[ap.Name] = true
+
+		v, u := exampleParam(a, ap)
+		for k, ev := range u {
+			expected[k] = ev
+		}
+		if ap.Required || isRequiredParam(a, ap) {
+			p("%s, ", v)
+		} else {
+			optional = append(optional, ap)
+		}
+	}
+	pn(")")
+	for _, ap := range optional {
+		v, _ := exampleParam(a, ap)
+		pn("		p.Set%s(%s)", capitalize(ap.Name), v)
+	}
+	pn("")
+
+	keys := make([]string, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pn("		expected := url.Values{")
+	for _, k := range keys {
+		pn("			%q: {%q},", k, expected[k])
+	}
+	pn("		}")
+	pn("		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {")
+	pn("			t.Errorf(\"Expected the params to be encoded as %%v, got %%v\", expected, u)")
+	pn("		}")
+	pn("")
+
+	pn("		r, err := client.%s.%s(p)", field, n)
+	pn("		if err != nil {")
+	pn("			t.Fatalf(\"Failed to decode the response: %%v\", err)")
+	pn("		}")
+
+	o := overrides.command(a.Name)
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	isList := strings.HasPrefix(a.Name, "list") || o.ListResponseKey != ""
+	switch {
+	case o.SingleObject != nil:
+		pn("		_ = r")
+	case isList:
+		lf := o.listField(ln)
+		pn("		if r.Count != 1 || len(r.%s) != 1 {", lf)
+		pn("			t.Fatalf(\"Expected a single listed object, got %%d\", len(r.%s))", lf)
+		pn("		}")
+		if hasIDAndNameResponseField(a.Name, a.Response) {
+			pn("		if r.%s[0].Id != %q {", lf, exampleUUID(a.Name+"/id"))
+			pn("			t.Errorf(\"Failed to decode the ID of the listed object, got %%q\", r.%s[0].Id)", lf)
+			pn("		}")
+		}
+	case isSuccessOnlyResponse(a.Response):
+		pn("		if !r.Success {")
+		pn("			t.Errorf(\"Failed to decode the success field\")")
+		pn("		}")
+	case hasIDResponseField(a.Name, a.Response):
+		pn("		if r.Id != %q {", exampleUUID(a.Name+"/id"))
+		pn("			t.Errorf(\"Failed to decode the ID, got %%q\", r.Id)")
+		pn("		}")
+	default:
+		pn("		_ = r")
+	}
+
+	pn("	})")
+	pn("")
+}
+
+func hasIDResponseField(aName string, resp APIResponses) bool {
+	for _, r := range resp {
+		if r.Name == "id" && mapType(aName, r.Name, r.Type) == "string" && r.Response == nil {
+			return true
+		}
+	}
+	return false
+}
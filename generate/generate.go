@@ -25,6 +25,7 @@ import (
 	"flag"
 	"fmt"
 	"go/format"
+	"go/token"
 	"io/ioutil"
 	"log"
 	"os"
@@ -37,83 +38,6 @@ import (
 
 const pkg = "cloudstack"
 
-// detailsRequireKeyValue is a prefilled map with a list of details
-// that need to be encoded using an explicit key and a value entry.
-var detailsRequireKeyValue = map[string]bool{
-	"addGuestOs":                  true,
-	"addImageStore":               true,
-	"addResourceDetail":           true,
-	"createSecondaryStagingStore": true,
-	"updateCloudToUseObjectStore": true,
-	"updateGuestOs":               true,
-	"updateZone":                  true,
-}
-
-// detailsRequireZeroIndex is a prefilled map with a list of details
-// that need to be encoded using zero indexing
-var detailsRequireZeroIndex = map[string]bool{
-	"registerTemplate": true,
-	"updateTemplate":   true,
-	"createAccount":    true,
-	"updateAccount":    true,
-}
-
-// requiresPost is a prefilled set of API names that require POST
-// for security or size purposes
-var requiresPostMethod = map[string]bool{
-	"login":                            true,
-	"deployVirtualMachine":             true,
-	"updateVirtualMachine":             true,
-	"createUser":                       true,
-	"updateUser":                       true,
-	"addVpnUser":                       true,
-	"registerUserData":                 true,
-	"setupUserTwoFactorAuthentication": true,
-	"validateUserTwoFactorAuthenticationCode": true,
-}
-
-var mapRequireList = map[string]map[string]bool{
-	"deployVirtualMachine": map[string]bool{
-		"dhcpoptionsnetworklist": true,
-		"iptonetworklist":        true,
-		"nicnetworklist":         true,
-	},
-	"updateVirtualMachine": map[string]bool{
-		"dhcpoptionsnetworklist": true,
-	},
-	"migrateVirtualMachineWithVolume": map[string]bool{
-		"migrateto": true,
-	},
-}
-
-// nestedResponse is a prefilled map with the list of endpoints
-// that responses fields are nested in a parent object. The map value
-// gives the object field name.
-var nestedResponse = map[string]string{
-	"getUploadParamsForTemplate": "getuploadparams",
-	"getUploadParamsForVolume":   "getuploadparams",
-	"createRole":                 "role",
-	"createRolePermission":       "rolepermission",
-	"getCloudIdentifier":         "cloudidentifier",
-	"getKubernetesClusterConfig": "clusterconfig",
-	"getPathForVolume":           "apipathforvolume",
-	"createConsoleEndpoint":      "consoleendpoint",
-}
-
-// longToStringConvertedParams is a prefilled map with the list of
-// response fields that migrated from long to string within
-// the current major baseline. This fields will be parsed from
-// json as string and then fallback on long.
-var longToStringConvertedParams = map[string]bool{
-	"managementserverid": true,
-}
-
-// customResponseStructTypes maps the API call to a custom struct name
-// This is to change the struct type name to something other than the API name
-var customResponseStructTypes = map[string]string{
-	"findHostsForMigration": "HostForMigration",
-}
-
 // We prefill this one value to make sure it is not
 // created twice, as this is also a top level type.
 var typeNames = map[string]bool{"Nic": true}
@@ -122,14 +46,7 @@ type apiInfo map[string][]string
 
 type allServices struct {
 	services services
-}
-
-type apiInfoNotFoundError struct {
-	api string
-}
-
-func (e *apiInfoNotFoundError) Error() string {
-	return fmt.Sprintf("Could not find API details for: %s", e.api)
+	versions *versionInfo
 }
 
 type generateError struct {
@@ -196,6 +113,7 @@ type API struct {
 	Name        string       `json:"name"`
 	Description string       `json:"description"`
 	Isasync     bool         `json:"isasync"`
+	Since       string       `json:"since"`
 	Params      APIParams    `json:"params"`
 	Response    APIResponses `json:"response"`
 }
@@ -206,6 +124,7 @@ type APIParam struct {
 	Description string `json:"description"`
 	Type        string `json:"type"`
 	Required    bool   `json:"required"`
+	Since       string `json:"since"`
 }
 
 // APIResponse represents a API response
@@ -235,18 +154,79 @@ func (s APIResponses) Swap(i, j int) {
 }
 
 func main() {
-	listApis := flag.String("api", "listApis.json", "path to the saved JSON output of listApis")
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	listApis := flag.String("api", "listApis.json", "comma separated paths to the saved JSON output of listApis, optionally prefixed with the server version as in 4.18.0.0=listApis.json")
+	apiURL := flag.String("url", "", "URL of a management server to fetch the listApis output from, which is added to the --api targets when --api is set")
+	apiKey := flag.String("api-key", os.Getenv("CS_API_KEY"), "API key used with --url, defaults to $CS_API_KEY")
+	secretKey := flag.String("secret-key", os.Getenv("CS_SECRET_KEY"), "secret key used with --url, defaults to $CS_SECRET_KEY")
+	verifySSL := flag.Bool("verify-ssl", true, "verify the SSL certificate of the server used with --url")
+	saveDir := flag.String("save-dir", "generate", "directory to save the listApis output fetched from --url in")
+	overridesFile := flag.String("overrides", "generate/overrides.yaml", "path to the file with the overrides for the quirks of the API")
+	strict := flag.Bool("strict", false, "fail if layout.go is missing commands from the API info, or the other way around")
+	autoGroup := flag.Bool("auto-group", false, "generate commands missing from layout.go in the service proposed by their noun")
+	layoutReport := flag.String("layout-report", "", "path to write a report with the proposed layout.go changes to")
+	openAPI := flag.String("openapi", "", "path to write an OpenAPI 3 specification of all APIs to, as YAML for .yaml and .yml files and as JSON otherwise")
 	flag.Parse()
 
-	as, errors, err := getAllServices(*listApis)
+	var err error
+	if overrides, err = loadOverrides(*overridesFile); err != nil {
+		log.Fatal(err)
+	}
+
+	if *apiURL != "" {
+		file, version, err := fetchAPIs(*apiURL, *apiKey, *secretKey, *verifySSL, *saveDir)
+		if err != nil {
+			log.Fatalf("Failed to fetch the listApis output: %v", err)
+		}
+		log.Printf("Saved the listApis output to %s", file)
+
+		apiSet := false
+		flag.Visit(func(f *flag.Flag) {
+			apiSet = apiSet || f.Name == "api"
+		})
+		switch {
+		case !apiSet:
+			*listApis = file
+		case version == "":
+			log.Fatalf("Unable to determine the server version of %s, add it to --api as version=file", file)
+		default:
+			*listApis = addTarget(*listApis, version, file)
+		}
+	}
+
+	as, errors, err := getAllServices(*listApis, &layoutOptions{
+		strict:    *strict,
+		autoGroup: *autoGroup,
+		report:    *layoutReport,
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	if *openAPI != "" {
+		if err = as.WriteOpenAPI(*openAPI); err != nil {
+			log.Fatalf("Failed to write the OpenAPI specification: %v", err)
+		}
+	}
+
 	if err = as.WriteGeneralCode(); err != nil {
 		log.Fatal(err)
 	}
 
+	if err = as.WriteClientInterface(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err = as.WriteCLI(); err != nil {
+		log.Fatal(err)
+	}
+
 	for _, s := range as.services {
 		if err = s.WriteGeneratedCode(); err != nil {
 			errors = append(errors, &generateError{s, err})
@@ -271,6 +251,15 @@ func main() {
 		errors = append(errors, &goimportError{string(out), err})
 	}
 
+	clidir, err := cliDir()
+	if err != nil {
+		log.Fatal(err)
+	}
+	out, err = exec.Command("goimports", "-w", clidir).CombinedOutput()
+	if err != nil {
+		errors = append(errors, &goimportError{string(out), err})
+	}
+
 	if len(errors) > 0 {
 		log.Printf("%d API(s) failed to generate:", len(errors))
 		for _, ce := range errors {
@@ -295,6 +284,98 @@ func (as *allServices) WriteGeneralCode() error {
 	return ioutil.WriteFile(file, code, 0644)
 }
 
+func (as *allServices) WriteClientInterface() error {
+	outdir, err := sourceDir()
+	if err != nil {
+		log.Fatalf("Failed to get source dir: %s", err)
+	}
+
+	code, err := as.ClientInterface()
+	if err != nil {
+		return err
+	}
+
+	file := path.Join(outdir, "CloudStackClient.go")
+	return ioutil.WriteFile(file, code, 0644)
+}
+
+// ClientInterface generates an interface describing the full CloudStackClient, so
+// code using the client can accept a mock instead
+func (as *allServices) ClientInterface() ([]byte, error) {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		_, err := fmt.Fprintf(&buf, format+"\n", args...)
+		if err != nil {
+			panic(err)
+		}
+	}
+	pn("//")
+	pn("// Licensed to the Apache Software Foundation (ASF) under one")
+	pn("// or more contributor license agreements.  See the NOTICE file")
+	pn("// distributed with this work for additional information")
+	pn("// regarding copyright ownership.  The ASF licenses this file")
+	pn("// to you under the Apache License, Version 2.0 (the")
+	pn("// \"License\"); you may not use this file except in compliance")
+	pn("// with the License.  You may obtain a copy of the License at")
+	pn("//")
+	pn("//   http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing,")
+	pn("// software distributed under the License is distributed on an")
+	pn("// \"AS IS\" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY")
+	pn("// KIND, either express or implied.  See the License for the")
+	pn("// specific language governing permissions and limitations")
+	pn("// under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+	pn("import (")
+	pn("	\"encoding/json\"")
+	pn("	\"time\"")
+	pn(")")
+	pn("")
+	pn("// CloudStackClientIface describes all methods of the CloudStackClient. Code that accepts")
+	pn("// this interface instead of a *CloudStackClient can be tested using a MockCloudStackClientIface.")
+	pn("// The services are available through accessor methods, for example VirtualMachineService().")
+	pn("// Scoped clients are created using the ForProject, ForDomain and ForAccount methods of the")
+	pn("// concrete client.")
+	pn("type CloudStackClientIface interface {")
+	pn("	AsyncTimeout(timeoutInSeconds int64)")
+	pn("	Timeout(timeout time.Duration)")
+	pn("	DefaultOptions(options ...OptionFunc)")
+	pn("	GetAsyncJobResult(jobid string, timeout int64, opts ...CallOption) (json.RawMessage, error)")
+	pn("	DiscoverAPIs() (*APICapabilities, error)")
+	pn("	APICapabilities() (*APICapabilities, error)")
+	pn("	Supports(command, param string) bool")
+	pn("	DetectServerVersion() (string, error)")
+	pn("	ServerVersion() string")
+	pn("	LatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error)")
+	pn("	RestoreFromLatestBackup(virtualmachineid string, opts ...CallOption) (*Backup, error)")
+	pn("	AccountQuotaStatement(account, domainid string, start, end time.Time, opts ...CallOption) (*AccountQuotaStatement, error)")
+	pn("	GetUserDataMaxLength(opts ...CallOption) (int, error)")
+	pn("")
+	for _, s := range as.services {
+		pn("	%s() %sIface", s.name, s.name)
+	}
+	pn("}")
+	pn("")
+	pn("var _ CloudStackClientIface = &CloudStackClient{}")
+	for _, s := range as.services {
+		pn("")
+		pn("// %s returns the %s of the client", s.name, s.name)
+		pn("func (cs *CloudStackClient) %s() %sIface {", s.name, s.name)
+		pn("	return cs.%s", strings.TrimSuffix(s.name, "Service"))
+		pn("}")
+	}
+
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	return clean, err
+}
+
 func (as *allServices) GeneralCode() ([]byte, error) {
 	// Buffer the output in memory, for gofmt'ing later in the defer.
 	var buf bytes.Buffer
@@ -378,13 +459,18 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("type CloudStackClient struct {")
 	pn("	HTTPGETOnly bool // If `true` only use HTTP GET calls")
 	pn("")
-	pn("	client  *http.Client // The http client for communicating")
-	pn("	baseURL string       // The base URL of the API")
-	pn("	apiKey  string       // Api key")
-	pn("	secret  string       // Secret key")
-	pn("	async   bool         // Wait for async calls to finish")
-	pn("	options []OptionFunc // A list of option functions to apply to all API calls")
-	pn("	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
+	pn("	client       *http.Client // The http client for communicating")
+	pn("	baseURL      string       // The base URL of the API")
+	pn("	apiKey       string       // Api key")
+	pn("	secret       string       // Secret key")
+	pn("	async        bool         // Wait for async calls to finish")
+	pn("	options      []OptionFunc // A list of option functions to apply to all API calls")
+	pn("	timeout      int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
+	pn("	maxGETLength int          // Max URL length of GET calls before switching to POST; defaults to 4096 bytes")
+	pn("	gzipRequests bool         // Gzip compress the body of POST calls")
+	pn("	scope        *clientScope // The project, domain or account all API calls are bound to")
+	pn("	discovery    *apiDiscovery // The cached API capabilities of the server, if discovery is enabled")
+	pn("	version      *serverVersion // The targeted server version, if any")
 	pn("")
 	for _, s := range as.services {
 		pn("  %s %sIface", strings.TrimSuffix(s.name, "Service"), s.name)
@@ -416,8 +502,11 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("		apiKey:  apikey,")
 	pn("		secret:  secret,")
 	pn("		async:   async,")
-	pn("		options: []OptionFunc{},")
-	pn("		timeout: 300,")
+	pn("		options:      []OptionFunc{},")
+	pn("		timeout:      300,")
+	pn("		maxGETLength: DefaultMaxGETLength,")
+	pn("		discovery:    &apiDiscovery{},")
+	pn("		version:      &serverVersion{},")
 	pn("	}")
 	pn("")
 	pn("	for _, fn := range options {")
@@ -433,7 +522,14 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("")
 	pn("// Creates a new mock client for communicating with CloudStack")
 	pn("func newMockClient(ctrl *gomock.Controller) *CloudStackClient {")
-	pn("	cs := &CloudStackClient{}")
+	pn("	cs := &CloudStackClient{")
+	pn("		async:        true,")
+	pn("		options:      []OptionFunc{},")
+	pn("		timeout:      300,")
+	pn("		maxGETLength: DefaultMaxGETLength,")
+	pn("		discovery:    &apiDiscovery{},")
+	pn("		version:      &serverVersion{},")
+	pn("	}")
 	pn("")
 	for _, s := range as.services {
 		pn("	cs.%s = NewMock%sIface(ctrl)", strings.TrimSuffix(s.name, "Service"), s.name)
@@ -486,17 +582,207 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	}")
 	pn("}")
 	pn("")
+	pn("// clientScope holds the params that are added to every API call that accepts them")
+	pn("type clientScope struct {")
+	pn("	projectid string")
+	pn("	domainid  string")
+	pn("	account   string")
+	pn("}")
+	pn("")
+	pn("// ForProject returns a new client bound to the given project name or ID. The new client")
+	pn("// shares the HTTP transport with this client, but adds the `projectid` param to every")
+	pn("// API call that accepts it, unless the param is already set explicitly.")
+	pn("func (cs *CloudStackClient) ForProject(project string) (*CloudStackClient, error) {")
+	pn("	if !IsID(project) {")
+	pn("		id, _, err := cs.Project.GetProjectID(project)")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		project = id")
+	pn("	}")
+	pn("	return cs.newScopedClient(&clientScope{projectid: project}), nil")
+	pn("}")
+	pn("")
+	pn("// ForDomain returns a new client bound to the given domain name or ID. The new client")
+	pn("// shares the HTTP transport with this client, but adds the `domainid` param to every")
+	pn("// API call that accepts it, unless the param is already set explicitly.")
+	pn("func (cs *CloudStackClient) ForDomain(domain string) (*CloudStackClient, error) {")
+	pn("	if !IsID(domain) {")
+	pn("		id, _, err := cs.Domain.GetDomainID(domain)")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		domain = id")
+	pn("	}")
+	pn("	return cs.newScopedClient(&clientScope{domainid: domain}), nil")
+	pn("}")
+	pn("")
+	pn("// ForAccount returns a new client bound to the given account in the given domain name")
+	pn("// or ID. The new client shares the HTTP transport with this client, but adds the")
+	pn("// `domainid` and `account` params to every API call that accepts them, unless the")
+	pn("// params are already set explicitly.")
+	pn("func (cs *CloudStackClient) ForAccount(domain string, account string) (*CloudStackClient, error) {")
+	pn("	if !IsID(domain) {")
+	pn("		id, _, err := cs.Domain.GetDomainID(domain)")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		domain = id")
+	pn("	}")
+	pn("	return cs.newScopedClient(&clientScope{domainid: domain, account: account}), nil")
+	pn("}")
+	pn("")
+	pn("// Creates a copy of the client bound to the given scope. The copy gets its own services")
+	pn("// and options, so changing them does not affect the original client.")
+	pn("func (cs *CloudStackClient) newScopedClient(scope *clientScope) *CloudStackClient {")
+	pn("	c := *cs")
+	pn("	client := *cs.client")
+	pn("	c.client = &client")
+	pn("	c.options = append([]OptionFunc{}, cs.options...)")
+	pn("	c.scope = scope")
+	pn("")
+	for _, s := range as.services {
+		pn("	c.%s = New%s(&c)", strings.TrimSuffix(s.name, "Service"), s.name)
+	}
+	pn("")
+	pn("	return &c")
+	pn("}")
+	pn("")
+	pn("// Encodes the params of an API call, adding the params of the scope the client is bound")
+	pn("// to when the API call accepts them, they are not set explicitly and they refer to the")
+	pn("// scope of the call (see unscopedParams).")
+	pn("func (cs *CloudStackClient) encodeParams(api string, p interface{ ToURLValues() url.Values }) url.Values {")
+	pn("	u := p.ToURLValues()")
+	pn("	if cs.scope == nil {")
+	pn("		return u")
+	pn("	}")
+	pn("")
+	pn("	unscoped := unscopedParams[strings.ToLower(api)]")
+	pn("	setDefault := func(name, value string) {")
+	pn("		if _, found := u[name]; !found && value != \"\" && !unscoped[name] {")
+	pn("			u.Set(name, value)")
+	pn("		}")
+	pn("	}")
+	pn("	if _, ok := p.(ProjectIDSetter); ok {")
+	pn("		setDefault(\"projectid\", cs.scope.projectid)")
+	pn("	}")
+	pn("	if _, ok := p.(DomainIDSetter); ok {")
+	pn("		setDefault(\"domainid\", cs.scope.domainid)")
+	pn("	}")
+	pn("	if _, ok := p.(AccountSetter); ok {")
+	pn("		setDefault(\"account\", cs.scope.account)")
+	pn("	}")
+	pn("")
+	pn("	return u")
+	pn("}")
+	pn("")
 	pn("var AsyncTimeoutErr = errors.New(\"Timeout while waiting for async job to finish\")")
 	pn("")
+	pn("// CallOption can be passed to the API call functions to set options for that single call")
+	pn("type CallOption func(*callOptions)")
+	pn("")
+	pn("// callOptions holds the options of a single API call")
+	pn("type callOptions struct {")
+	pn("	httpTimeout  time.Duration // Timeout of the HTTP request(s), overriding the client timeout")
+	pn("	async        bool          // Wait for async calls to finish")
+	pn("	asyncTimeout int64         // Max waiting timeout in seconds for async jobs to finish")
+	pn("	retry        *RetryPolicy  // Policy used to retry failed HTTP requests")
+	pn("	params       url.Values    // Additional raw params added to the request")
+	pn("	endpoint     string        // The base URL of the API, overriding the client base URL")
+	pn("}")
+	pn("")
+	pn("// RetryPolicy defines how failed API calls are retried. Only calls that didn't get a")
+	pn("// response, or that got a 502, 503 or 504 response from a proxy in front of the API,")
+	pn("// are retried. As a failed call may still have been executed by the API, only use a")
+	pn("// retry policy for idempotent API calls.")
+	pn("type RetryPolicy struct {")
+	pn("	MaxAttempts int           // Max number of attempts, including the first one")
+	pn("	Backoff     time.Duration // Waiting time before the first retry, doubled for every next retry")
+	pn("}")
+	pn("")
+	pn("// Returns the options for a single API call, using the client settings as defaults")
+	pn("func (cs *CloudStackClient) newCallOptions(opts []CallOption) *callOptions {")
+	pn("	o := &callOptions{")
+	pn("		async:        cs.async,")
+	pn("		asyncTimeout: cs.timeout,")
+	pn("	}")
+	pn("	for _, fn := range opts {")
+	pn("		fn(o)")
+	pn("	}")
+	pn("	return o")
+	pn("}")
+	pn("")
+	pn("// Returns the options that also apply when polling for the result of an async job")
+	pn("func (o *callOptions) asyncJobOptions() []CallOption {")
+	pn("	return []CallOption{func(jo *callOptions) {")
+	pn("		jo.httpTimeout = o.httpTimeout")
+	pn("		jo.retry = o.retry")
+	pn("		jo.endpoint = o.endpoint")
+	pn("	}}")
+	pn("}")
+	pn("")
+	pn("// WithCallTimeout sets the timeout of the HTTP request(s) of a single API call")
+	pn("func WithCallTimeout(timeout time.Duration) CallOption {")
+	pn("	return func(o *callOptions) {")
+	pn("		o.httpTimeout = timeout")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithCallWait sets if a single async API call should wait for the async job to finish,")
+	pn("// overriding the behaviour of the client (see NewClient and NewAsyncClient).")
+	pn("func WithCallWait(wait bool) CallOption {")
+	pn("	return func(o *callOptions) {")
+	pn("		o.async = wait")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithCallAsyncTimeout sets the max time in seconds a single async API call waits for")
+	pn("// the async job to finish.")
+	pn("func WithCallAsyncTimeout(timeout int64) CallOption {")
+	pn("	return func(o *callOptions) {")
+	pn("		if timeout != 0 {")
+	pn("			o.asyncTimeout = timeout")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithCallRetry sets the policy used to retry a single API call when it fails")
+	pn("func WithCallRetry(policy RetryPolicy) CallOption {")
+	pn("	return func(o *callOptions) {")
+	pn("		o.retry = &policy")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithCallParams adds raw params to a single API call, for example to pass params")
+	pn("// that are not (yet) supported by the generated params types.")
+	pn("func WithCallParams(params url.Values) CallOption {")
+	pn("	return func(o *callOptions) {")
+	pn("		if o.params == nil {")
+	pn("			o.params = url.Values{}")
+	pn("		}")
+	pn("		for k, v := range params {")
+	pn("			o.params[k] = v")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithCallEndpoint sends a single API call to the given API URL instead of the URL")
+	pn("// the client was created with.")
+	pn("func WithCallEndpoint(apiurl string) CallOption {")
+	pn("	return func(o *callOptions) {")
+	pn("		o.endpoint = apiurl")
+	pn("	}")
+	pn("}")
+	pn("")
 	pn("// A helper function that you can use to get the result of a running async job. If the job is not finished within the configured")
-	pn("// timeout, the async job returns a AsyncTimeoutErr.")
-	pn("func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("// timeout, the async job returns a AsyncTimeoutErr. Any call options are applied to every call made to check the job status.")
+	pn("func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64, opts ...CallOption) (json.RawMessage, error) {")
 	pn("	var timer time.Duration")
 	pn("	currentTime := time.Now().Unix()")
 	pn("")
-	pn("		for {")
+	pn("	for {")
 	pn("		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)")
-	pn("		r, err := cs.Asyncjob.QueryAsyncJobResult(p)")
+	pn("		r, err := cs.Asyncjob.QueryAsyncJobResult(p, opts...)")
 	pn("		if err != nil {")
 	pn("			return nil, err")
 	pn("		}")
@@ -532,21 +818,34 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if")
 	pn("// no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS")
 	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {")
-	pn("		return cs.newRawRequest(api, false, params)")
+	pn("func (cs *CloudStackClient) newRequest(api string, params url.Values, opts ...CallOption) (json.RawMessage, error) {")
+	pn("	return cs.newRawRequest(api, false, params, opts...)")
 	pn("}")
 	pn("")
 	pn("// Execute the request against a CS API using POST. Will return the raw JSON data returned by the API and")
 	pn("// nil if no error occurred. If the API returns an error the result will be nil and the HTTP error code")
 	pn("// and CS error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CloudStackClient) newPostRequest(api string, params url.Values) (json.RawMessage, error) {")
-	pn("		return cs.newRawRequest(api, true, params)")
+	pn("func (cs *CloudStackClient) newPostRequest(api string, params url.Values, opts ...CallOption) (json.RawMessage, error) {")
+	pn("	return cs.newRawRequest(api, true, params, opts...)")
 	pn("}")
 	pn("")
 	pn("// Execute a raw request against a CS API. Will return the raw JSON data returned by the API and nil if")
 	pn("// no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS")
 	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Values) (json.RawMessage, error) {")
+	pn("func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Values, opts ...CallOption) (json.RawMessage, error) {")
+	pn("	o := cs.newCallOptions(opts)")
+	pn("	for k, v := range o.params {")
+	pn("		params[k] = v")
+	pn("	}")
+	pn("")
+	pn("	// Check if the server supports the API call before sending it")
+	pn("	if err := cs.checkCapabilities(api, params); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	if err := cs.checkServerVersion(api, params); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
 	pn("	params.Set(\"apiKey\", cs.apiKey)")
 	pn("	params.Set(\"command\", api)")
 	pn("	params.Set(\"response\", \"json\")")
@@ -563,23 +862,50 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	mac.Write([]byte(s2))")
 	pn("	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))")
 	pn("")
-	pn("	var err error")
-	pn("	var resp *http.Response")
-	pn("	if !cs.HTTPGETOnly && post {")
-	pn("		// The deployVirtualMachine API should be called using a POST call")
-	pn("  	// so we don't have to worry about the userdata size")
+	pn("	baseURL := cs.baseURL")
+	pn("	if o.endpoint != \"\" {")
+	pn("		baseURL = o.endpoint")
+	pn("	}")
 	pn("")
-	pn("		// Add the unescaped signature to the POST params")
-	pn("		params.Set(\"signature\", signature)")
+	pn("	client := cs.client")
+	pn("	if o.httpTimeout > 0 {")
+	pn("		c := *cs.client")
+	pn("		c.Timeout = o.httpTimeout")
+	pn("		client = &c")
+	pn("	}")
 	pn("")
-	pn("		// Make a POST call")
-	pn("		resp, err = cs.client.PostForm(cs.baseURL, params)")
-	pn("	} else {")
-	pn("		// Create the final URL before we issue the request")
-	pn("		url := cs.baseURL + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
+	pn("	// Create the final URL before we issue the request")
+	pn("	u := baseURL + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
 	pn("")
-	pn("		// Make a GET call")
-	pn("		resp, err = cs.client.Get(url)")
+	pn("	// Switch to a POST call when the URL is too long to be safely used in a GET call")
+	pn("	if cs.maxGETLength > 0 && len(u) > cs.maxGETLength {")
+	pn("		post = true")
+	pn("	}")
+	pn("")
+	pn("	var err error")
+	pn("	var resp *http.Response")
+	pn("	for attempt := 1; ; attempt++ {")
+	pn("		if !cs.HTTPGETOnly && post {")
+	pn("			// The deployVirtualMachine API should be called using a POST call")
+	pn("			// so we don't have to worry about the userdata size")
+	pn("")
+	pn("			// Add the unescaped signature to the POST params")
+	pn("			params.Set(\"signature\", signature)")
+	pn("")
+	pn("			// Make a POST call")
+	pn("			resp, err = cs.postForm(client, baseURL, params)")
+	pn("		} else {")
+	pn("			// Make a GET call")
+	pn("			resp, err = client.Get(u)")
+	pn("		}")
+	pn("")
+	pn("		if !o.retry.shouldRetry(attempt, resp, err) {")
+	pn("			break")
+	pn("		}")
+	pn("		if resp != nil {")
+	pn("			resp.Body.Close()")
+	pn("		}")
+	pn("		time.Sleep(o.retry.Backoff << uint(attempt-1))")
 	pn("	}")
 	pn("	if err != nil {")
 	pn("		return nil, err")
@@ -607,6 +933,46 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	return b, nil")
 	pn("}")
 	pn("")
+	pn("// Returns true if another attempt should be made after the given (failed) attempt")
+	pn("func (rp *RetryPolicy) shouldRetry(attempt int, resp *http.Response, err error) bool {")
+	pn("	if rp == nil || attempt >= rp.MaxAttempts {")
+	pn("		return false")
+	pn("	}")
+	pn("	if err != nil {")
+	pn("		return true")
+	pn("	}")
+	pn("	switch resp.StatusCode {")
+	pn("	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:")
+	pn("		return true")
+	pn("	}")
+	pn("	return false")
+	pn("}")
+	pn("")
+	pn("// Execute a POST call with the form encoded params as body, which is gzip compressed")
+	pn("// when the client is configured to do so.")
+	pn("func (cs *CloudStackClient) postForm(client *http.Client, baseURL string, params url.Values) (*http.Response, error) {")
+	pn("	if !cs.gzipRequests {")
+	pn("		return client.PostForm(baseURL, params)")
+	pn("	}")
+	pn("")
+	pn("	var body bytes.Buffer")
+	pn("	zw := gzip.NewWriter(&body)")
+	pn("	if _, err := zw.Write([]byte(params.Encode())); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	if err := zw.Close(); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	req, err := http.NewRequest(\"POST\", baseURL, &body)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	req.Header.Set(\"Content-Type\", \"application/x-www-form-urlencoded\")")
+	pn("	req.Header.Set(\"Content-Encoding\", \"gzip\")")
+	pn("")
+	pn("	return client.Do(req)")
+	pn("}")
 	pn("// Custom version of net/url Encode that only URL escapes values")
 	pn("// Unmodified portions here remain under BSD license of The Go Authors: https://go.googlesource.com/go/+/master/LICENSE")
 	pn("func EncodeValues(v url.Values) string {")
@@ -678,6 +1044,195 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	return keys")
 	pn("}")
 	pn("")
+	pn("// requestParamNames contains the lower cased names of the params that are part")
+	pn("// of every request, and so are not specific to any API command.")
+	pn("var requestParamNames = map[string]bool{")
+	pn("	\"apikey\":           true,")
+	pn("	\"command\":          true,")
+	pn("	\"expires\":          true,")
+	pn("	\"response\":         true,")
+	pn("	\"sessionkey\":       true,")
+	pn("	\"signature\":        true,")
+	pn("	\"signatureversion\": true,")
+	pn("}")
+	pn("")
+	as.versions.generateCode(pn)
+	pn("// postCommands contains the lower cased names of the API commands that require")
+	pn("// a POST call for security or size purposes.")
+	pn("var postCommands = map[string]bool{")
+	var post []string
+	for n, c := range overrides.Commands {
+		if c.Post {
+			post = append(post, strings.ToLower(n))
+		}
+	}
+	sort.Strings(post)
+	for _, n := range post {
+		pn("	%q: true,", n)
+	}
+	pn("}")
+	pn("")
+	pn("// unscopedParams contains the params of the API commands, by their lower cased name, that")
+	pn("// are not set by a scoped client, as they do not refer to the scope of the call. For example")
+	pn("// the domainid of createDomain is the ID of the new domain.")
+	pn("var unscopedParams = map[string]map[string]bool{")
+	var unscoped []string
+	for n, c := range overrides.Commands {
+		if len(c.UnscopedParams) > 0 {
+			unscoped = append(unscoped, n)
+		}
+	}
+	sort.Strings(unscoped)
+	for _, n := range unscoped {
+		pn("	%q: {", strings.ToLower(n))
+		for _, p := range overrides.Commands[n].UnscopedParams {
+			pn("		%q: true,", p)
+		}
+		pn("	},")
+	}
+	pn("}")
+	pn("")
+	pn("// checkParamNames returns an error if u contains a param unknown to the API command,")
+	pn("// or a param with more than one value. The names of map params end in [], as those")
+	pn("// params are only accepted in their indexed form, like name[0].key=value.")
+	pn("func checkParamNames(api string, u url.Values, names ...string) error {")
+	pn("	for k, vs := range u {")
+	pn("		name, indexed := k, \"\"")
+	pn("		if i := strings.Index(k, \"[\"); i > 0 {")
+	pn("			name, indexed = k[:i], \"[]\"")
+	pn("		}")
+	pn("		if requestParamNames[strings.ToLower(name)] {")
+	pn("			continue")
+	pn("		}")
+	pn("")
+	pn("		known, other := false, false")
+	pn("		for _, n := range names {")
+	pn("			switch n {")
+	pn("			case name + indexed:")
+	pn("				known = true")
+	pn("			case name, name + \"[]\":")
+	pn("				other = true")
+	pn("			}")
+	pn("		}")
+	pn("		if !known {")
+	pn("			if other && indexed == \"\" {")
+	pn("				return fmt.Errorf(\"Param %%s of %%s must be indexed, as in %%s[0].key=value\", k, api, k)")
+	pn("			}")
+	pn("			if other {")
+	pn("				return fmt.Errorf(\"Param %%s of %%s cannot be indexed\", k, api)")
+	pn("			}")
+	pn("			return fmt.Errorf(\"Unknown param for %%s: %%s\", api, k)")
+	pn("		}")
+	pn("		if len(vs) > 1 {")
+	pn("			return fmt.Errorf(\"Param %%s has more than one value\", k)")
+	pn("		}")
+	pn("	}")
+	pn("	return nil")
+	pn("}")
+	pn("")
+	pn("// parseIndexedValues parses indexed params like name[0].key=value and returns")
+	pn("// the fields found for each index, ordered by increasing index. The indexes")
+	pn("// must start at 0 and cannot have gaps, so encoding the returned values gives")
+	pn("// back the same params.")
+	pn("func parseIndexedValues(u url.Values, name string) ([]map[string]string, error) {")
+	pn("	entries := make(map[int]map[string]string)")
+	pn("	prefix := name + \"[\"")
+	pn("	for k := range u {")
+	pn("		if !strings.HasPrefix(k, prefix) {")
+	pn("			continue")
+	pn("		}")
+	pn("")
+	pn("		rest := strings.TrimPrefix(k, prefix)")
+	pn("		i := strings.Index(rest, \"].\")")
+	pn("		if i < 0 || i+2 == len(rest) || strings.ContainsAny(rest[i+2:], \"[]\") {")
+	pn("			return nil, fmt.Errorf(\"Invalid indexed param: %%s\", k)")
+	pn("		}")
+	pn("		idx, err := strconv.Atoi(rest[:i])")
+	pn("		if err != nil || idx < 0 || strconv.Itoa(idx) != rest[:i] {")
+	pn("			return nil, fmt.Errorf(\"Invalid index for param: %%s\", k)")
+	pn("		}")
+	pn("")
+	pn("		if entries[idx] == nil {")
+	pn("			entries[idx] = make(map[string]string)")
+	pn("		}")
+	pn("		entries[idx][rest[i+2:]] = u.Get(k)")
+	pn("	}")
+	pn("")
+	pn("	l := make([]map[string]string, len(entries))")
+	pn("	for idx, entry := range entries {")
+	pn("		if idx >= len(l) {")
+	pn("			return nil, fmt.Errorf(\"Param %%s has index %%d, but misses a lower index\", name, idx)")
+	pn("		}")
+	pn("		l[idx] = entry")
+	pn("	}")
+	pn("	return l, nil")
+	pn("}")
+	pn("")
+	pn("// parseIndexedMap parses a map param encoded as name[i].key=value. When the zero")
+	pn("// index is required all entries use index 0. Otherwise the entries of all indexes are")
+	pn("// merged, so both the canonical encoding with a single entry per index in sorted order")
+	pn("// and encodings with several entries per index, as sent by the UI and cmk, are accepted.")
+	pn("func parseIndexedMap(u url.Values, name string, zeroIndex bool) (map[string]string, error) {")
+	pn("	l, err := parseIndexedValues(u, name)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	if zeroIndex {")
+	pn("		if len(l) > 1 {")
+	pn("			return nil, fmt.Errorf(\"Param %%s must only use index 0\", name)")
+	pn("		}")
+	pn("		if len(l) == 1 {")
+	pn("			return l[0], nil")
+	pn("		}")
+	pn("		return map[string]string{}, nil")
+	pn("	}")
+	pn("")
+	pn("	m := make(map[string]string)")
+	pn("	for _, entry := range l {")
+	pn("		for k, v := range entry {")
+	pn("			if _, found := m[k]; found {")
+	pn("				return nil, fmt.Errorf(\"Param %%s has duplicate key: %%s\", name, k)")
+	pn("			}")
+	pn("			m[k] = v")
+	pn("		}")
+	pn("	}")
+	pn("	return m, nil")
+	pn("}")
+	pn("")
+	pn("// parseKeyValueMap parses a map param encoded as name[i].keyField=key and name[i].valueField=value.")
+	pn("// The keys must be unique, but can be in any order. The map is encoded with the keys in sorted")
+	pn("// order. When empty values are omitted, an empty valueField cannot be encoded and is rejected.")
+	pn("func parseKeyValueMap(u url.Values, name, keyField, valueField string, omitEmpty bool) (map[string]string, error) {")
+	pn("	l, err := parseIndexedValues(u, name)")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	m := make(map[string]string)")
+	pn("	for i, entry := range l {")
+	pn("		k, found := entry[keyField]")
+	pn("		if !found {")
+	pn("			return nil, fmt.Errorf(\"Param %%s[%%d] is missing the %%s field\", name, i, keyField)")
+	pn("		}")
+	pn("		v, found := entry[valueField]")
+	pn("		switch {")
+	pn("		case !found && !omitEmpty:")
+	pn("			return nil, fmt.Errorf(\"Param %%s[%%d] is missing the %%s field\", name, i, valueField)")
+	pn("		case found && v == \"\" && omitEmpty:")
+	pn("			return nil, fmt.Errorf(\"Param %%s[%%d].%%s cannot be empty, omit it instead\", name, i, valueField)")
+	pn("		case len(entry) > 2 || (!found && len(entry) > 1):")
+	pn("			return nil, fmt.Errorf(\"Param %%s[%%d] only supports the %%s and %%s fields\", name, i, keyField, valueField)")
+	pn("		}")
+	pn("		if _, dup := m[k]; dup {")
+	pn("			return nil, fmt.Errorf(\"Param %%s has duplicate key: %%s\", name, k)")
+	pn("		}")
+	pn("		m[k] = v")
+	pn("	}")
+	pn("	return m, nil")
+	pn("}")
+	pn("")
+	generateOptionalTypes(pn)
 	pn("// WithAsyncTimeout takes a custom timeout to be used by the CloudStackClient")
 	pn("func WithAsyncTimeout(timeout int64) ClientOption {")
 	pn("	return func(cs *CloudStackClient) {")
@@ -715,6 +1270,27 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	}")
 	pn("}")
 	pn("")
+	pn("// DefaultMaxGETLength is the default max URL length of GET calls. Longer requests")
+	pn("// are sent using a POST call, as many proxies reject URLs beyond this length.")
+	pn("const DefaultMaxGETLength = 4096")
+	pn("")
+	pn("// WithMaxGETLength sets the max URL length of GET calls. API calls that would result")
+	pn("// in a longer URL are automatically sent using a POST call. A length of 0 disables")
+	pn("// the automatic switch, so only the API calls that require it use POST.")
+	pn("func WithMaxGETLength(length int) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.maxGETLength = length")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// WithGzipRequests enables gzip compression of the body of POST calls. Only enable")
+	pn("// this when the API server (or a proxy in front of it) accepts compressed requests.")
+	pn("func WithGzipRequests(enabled bool) ClientOption {")
+	pn("	return func(cs *CloudStackClient) {")
+	pn("		cs.gzipRequests = enabled")
+	pn("	}")
+	pn("}")
+	pn("")
 	pn("// WithHTTPClient takes a custom HTTP client to be used by the CloudStackClient")
 	pn("func WithHTTPClient(client *http.Client) ClientOption {")
 	pn("	return func(cs *CloudStackClient) {")
@@ -746,6 +1322,11 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("		return nil")
 	pn("	}")
 	pn("}")
+	pn("// AccountSetter is an interface that every type that can set an account must implement")
+	pn("type AccountSetter interface {")
+	pn("	SetAccount(string)")
+	pn("}")
+	pn("")
 	pn("// ProjectIDSetter is an interface that every type that can set a project ID must implement")
 	pn("type ProjectIDSetter interface {")
 	pn("	SetProjectid(string)")
@@ -859,6 +1440,10 @@ func (s *service) WriteGeneratedCode() error {
 		testdir, err := testDir()
 		file := path.Join(testdir, s.name+"_test.go")
 		ioutil.WriteFile(file, tests, 0644)
+
+		if err := s.writeFixtures(testdir); err != nil {
+			return err
+		}
 	}
 
 	file := path.Join(outdir, s.name+".go")
@@ -991,9 +1576,20 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("	p map[string]interface{}")
 		pn("}")
 		pn("")
-		pn("func (p *CustomServiceParams) toURLValues() url.Values {")
+		pn("// NewCustomServiceParams returns a new, empty CustomServiceParams instance")
+		pn("func (s *CustomService) NewCustomServiceParams() *CustomServiceParams {")
+		pn("	return &CustomServiceParams{p: make(map[string]interface{})}")
+		pn("}")
+		pn("")
+		pn("// ToURLValues encodes the params in the format expected by the API. Lists are")
+		pn("// joined with a comma and maps are encoded as indexed params sorted by key, so the")
+		pn("// same params always result in the same encoding:")
+		pn("//")
+		pn("//	map[string]string:   name[0].key1=value1&name[1].key2=value2")
+		pn("//	[]map[string]string: name[0].field1=value1&name[0].field2=value2")
+		pn("func (p *CustomServiceParams) ToURLValues() url.Values {")
 		pn("	u := url.Values{}")
-		pn("	if p.p == nil {")
+		pn("	if p == nil || p.p == nil {")
 		pn("		return u")
 		pn("	}")
 		pn("")
@@ -1006,16 +1602,26 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("		case int64:")
 		pn("			vv := strconv.FormatInt(t, 10)")
 		pn("			u.Set(k, vv)")
+		pn("		case float64:")
+		pn("			u.Set(k, strconv.FormatFloat(t, 'f', -1, 64))")
 		pn("		case string:")
 		pn("			u.Set(k, t)")
+		pn("		case UUID:")
+		pn("			u.Set(k, string(t))")
 		pn("		case []string:")
-		pn("			u.Set(k, strings.Join(t, \", \"))")
+		pn("			u.Set(k, strings.Join(t, \",\"))")
 		pn("		case map[string]string:")
-		pn("			i := 0")
-		pn("			for kk, vv := range t {")
-		pn("				u.Set(fmt.Sprintf(\"%%s[%%d].%%s\", k, i, kk), vv)")
-		pn("				i++")
+		pn("			for i, kk := range getSortedKeysFromMap(t) {")
+		pn("				u.Set(fmt.Sprintf(\"%%s[%%d].%%s\", k, i, kk), t[kk])")
+		pn("			}")
+		pn("		case []map[string]string:")
+		pn("			for i, m := range t {")
+		pn("				for _, kk := range getSortedKeysFromMap(m) {")
+		pn("					u.Set(fmt.Sprintf(\"%%s[%%d].%%s\", k, i, kk), m[kk])")
+		pn("				}")
 		pn("			}")
+		pn("		default:")
+		pn("			u.Set(k, fmt.Sprint(t))")
 		pn("		}")
 		pn("	}")
 		pn("")
@@ -1028,6 +1634,17 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("	}")
 		pn("	p.p[param] = v")
 		pn("}")
+		pn("")
+		pn("// SetKeyValueParam sets a map param that is encoded using separate key and value")
+		pn("// fields, for example `tags[0].key=k&tags[0].value=v`")
+		pn("func (p *CustomServiceParams) SetKeyValueParam(param string, v map[string]string) {")
+		pn("	m := make([]map[string]string, 0, len(v))")
+		pn("	for _, k := range getSortedKeysFromMap(v) {")
+		pn("		m = append(m, map[string]string{\"key\": k, \"value\": v[k]})")
+		pn("	}")
+		pn("	p.SetParam(param, m)")
+		pn("}")
+		pn("")
 		pn("func (p *CustomServiceParams) GetParam(param string) (interface{}, bool) {")
 		pn("	if p.p == nil {")
 		pn("		p.p = make(map[string]interface{})")
@@ -1036,22 +1653,112 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("	return value, ok")
 		pn("}")
 		pn("")
-		pn("func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {")
-		pn("	resp, err := s.cs.newRequest(api, p.toURLValues())")
+		pn("func (p *CustomServiceParams) ResetParam(param string) {")
+		pn("	if p.p != nil {")
+		pn("		delete(p.p, param)")
+		pn("	}")
+		pn("}")
+		pn("")
+		pn("func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {")
+		pn("	resp, err := s.cs.newRequest(api, p.ToURLValues(), opts...)")
 		pn("	if err != nil {")
 		pn("		return err")
 		pn("	}")
 		pn("")
 		pn("	return json.Unmarshal(resp, result)")
 		pn("}")
-		pn("func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, result interface{}) error {")
-		pn("	resp, err := s.cs.newPostRequest(api, p.toURLValues())")
+		pn("")
+		pn("func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {")
+		pn("	resp, err := s.cs.newPostRequest(api, p.ToURLValues(), opts...)")
 		pn("	if err != nil {")
 		pn("		return err")
 		pn("	}")
 		pn("")
 		pn("	return json.Unmarshal(resp, result)")
 		pn("}")
+		pn("")
+		pn("// RawRequest calls any API command using the cached listApis metadata of the server.")
+		pn("// The command and params are validated before anything is sent, commands that require")
+		pn("// it are sent using a POST call and async commands are handled the same way as the")
+		pn("// generated API calls. It returns the raw result of the command, which has the same shape")
+		pn("// for sync calls and (waited for) async calls: an object is returned without the object")
+		pn("// CloudStack wraps it in, like {\"virtualmachine\": {...}}, and other results, like lists")
+		pn("// and success responses, are returned as is.")
+		pn("func (s *CustomService) RawRequest(api string, p *CustomServiceParams, opts ...CallOption) (json.RawMessage, error) {")
+		pn("	caps, err := s.cs.APICapabilities()")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	u := p.ToURLValues()")
+		pn("	if err := caps.Validate(api, u); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	var resp json.RawMessage")
+		pn("	if postCommands[strings.ToLower(api)] {")
+		pn("		resp, err = s.cs.newPostRequest(api, u, opts...)")
+		pn("	} else {")
+		pn("		resp, err = s.cs.newRequest(api, u, opts...)")
+		pn("	}")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	// If we have a async client, we need to wait for the async result")
+		pn("	if a, _ := caps.API(api); a.Isasync {")
+		pn("		if o := s.cs.newCallOptions(opts); o.async {")
+		pn("			var r struct {")
+		pn("				JobID string `json:\"jobid\"`")
+		pn("			}")
+		pn("			if err := json.Unmarshal(resp, &r); err != nil {")
+		pn("				return nil, err")
+		pn("			}")
+		pn("")
+		pn("			b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)")
+		pn("			if err != nil {")
+		pn("				if err == AsyncTimeoutErr {")
+		pn("					return resp, err")
+		pn("				}")
+		pn("				return nil, err")
+		pn("			}")
+		pn("")
+		pn("			return rawResult(b), nil")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	return rawResult(resp), nil")
+		pn("}")
+		pn("")
+		pn("// rawResult removes the object CloudStack wraps a returned object in, which is an object")
+		pn("// with the name of the returned object as its only key. Other results are returned as is.")
+		pn("func rawResult(b json.RawMessage) json.RawMessage {")
+		pn("	var m map[string]json.RawMessage")
+		pn("	if err := json.Unmarshal(b, &m); err != nil || len(m) != 1 {")
+		pn("		return b")
+		pn("	}")
+		pn("	for _, v := range m {")
+		pn("		var o map[string]json.RawMessage")
+		pn("		if err := json.Unmarshal(v, &o); err == nil && o != nil {")
+		pn("			return v")
+		pn("		}")
+		pn("	}")
+		pn("	return b")
+		pn("}")
+		pn("")
+		pn("// Request works the same as RawRequest, but unmarshals the result into the given value")
+		pn("func (s *CustomService) Request(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error {")
+		pn("	resp, err := s.RawRequest(api, p, opts...)")
+		pn("	if resp == nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	if err := json.Unmarshal(resp, result); err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+		pn("	return err")
+		pn("}")
 	}
 
 	s.generateInterfaceType()
@@ -1059,7 +1766,10 @@ func (s *service) GenerateCode() ([]byte, error) {
 	for _, a := range s.apis {
 		s.generateParamType(a)
 		s.generateToURLValuesFunc(a)
+		s.generateParseFunc(a)
 		s.generateParamGettersAndSettersFunc(a)
+		s.generateCloneAndEqualFuncs(a)
+		s.generateSerializationFuncs(a)
 		s.generateNewParamTypeFunc(a)
 		s.generateHelperFuncs(a)
 		s.generateNewAPICallFunc(a)
@@ -1124,6 +1834,22 @@ func (s *service) GenerateTestCode() ([]byte, error) {
 		s.generateAPITest(a)
 	}
 	pn("}")
+	pn("")
+
+	pn("func Test%sFixtures(t *testing.T) {", s.name)
+	pn("	response, err := readData(\"generated/%s\")", s.name)
+	pn("	if err != nil {")
+	pn("		t.Fatalf(\"Failed to read the generated fixtures: %%v\", err)")
+	pn("	}")
+	pn("	server := newFixtureServer(response)")
+	pn("	client := cloudstack.NewAsyncClient(server.URL, \"APIKEY\", \"SECRETKEY\", true)")
+	pn("	defer server.Close()")
+	pn("")
+
+	for _, a := range s.apis {
+		s.generateFixtureTest(a)
+	}
+	pn("}")
 
 	clean, err := format.Source(buf.Bytes())
 	if err != nil {
@@ -1150,7 +1876,7 @@ func (s *service) generateAPITest(a *API) {
 	}
 	pn(")")
 	idPresent := false
-	if !(strings.HasPrefix(a.Name, "list") || a.Name == "registerTemplate" || a.Name == "findHostsForMigration") {
+	if !(strings.HasPrefix(a.Name, "list") || overrides.command(a.Name).ListResponseKey != "") {
 		for _, ap := range a.Response {
 			if ap.Name == "id" && ap.Type == "string" {
 				pn("		r, err := client.%s.%s(p)", strings.TrimSuffix(s.name, "Service"), capitalize(a.Name))
@@ -1179,7 +1905,7 @@ func getDefaultValueForType(aName string, pName string, pType string) string {
 	switch pType {
 	case "boolean":
 		return "true"
-	case "short", "int", "integer", "long", "float", "double":
+	case "short", "int", "integer", "long", "float", "double", "bigdecimal":
 		return "0"
 	case "list":
 		return "[]string{}"
@@ -1193,8 +1919,15 @@ func getDefaultValueForType(aName string, pName string, pType string) string {
 func (s *service) generateParamType(a *API) {
 	pn := s.pn
 
+	found := make(map[string]bool)
+
 	pn("type %s struct {", capitalize(a.Name+"Params"))
-	pn("	p map[string]interface{}")
+	for _, ap := range a.Params {
+		if !found[ap.Name] {
+			pn("	%s %s", paramFieldName(ap.Name), optionalTypeName(mapType(a.Name, ap.Name, ap.Type)))
+			found[ap.Name] = true
+		}
+	}
 	pn("}\n")
 }
 
@@ -1202,11 +1935,18 @@ func (s *service) generateInterfaceType() {
 	p, pn := s.p, s.pn
 
 	pn("type %sIface interface {", capitalize(s.name))
+	if s.name == "CustomService" {
+		pn("	CustomRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error")
+		pn("	CustomPostRequest(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error")
+		pn("	RawRequest(api string, p *CustomServiceParams, opts ...CallOption) (json.RawMessage, error)")
+		pn("	Request(api string, p *CustomServiceParams, result interface{}, opts ...CallOption) error")
+		pn("	NewCustomServiceParams() *CustomServiceParams")
+	}
 	for _, api := range s.apis {
 		n := capitalize(api.Name)
 		tn := capitalize(api.Name + "Params")
 		// API Calls
-		pn("	%s(p *%s) (*%s, error)", n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
+		pn("	%s(p *%s, opts ...CallOption) (*%s, error)", n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
 
 		// NewParam funcs
 		p("New%s(", tn)
@@ -1237,11 +1977,8 @@ func (s *service) generateInterfaceType() {
 						p("%s %s, ", s.parseParamName(ap.Name), mapType(api.Name, ap.Name, ap.Type))
 					}
 				}
-				if parseSingular(ln) == "Iso" {
-					p("isofilter string, ")
-				}
-				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-					p("zoneid string, ")
+				for _, hp := range overrides.command(api.Name).IDHelperParams {
+					p("%s string, ", hp)
 				}
 				pn("opts ...OptionFunc) (string, int, error)")
 
@@ -1252,11 +1989,8 @@ func (s *service) generateInterfaceType() {
 							p("%s %s, ", s.parseParamName(ap.Name), mapType(api.Name, ap.Name, ap.Type))
 						}
 					}
-					if parseSingular(ln) == "Iso" {
-						p("isofilter string, ")
-					}
-					if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-						p("zoneid string, ")
+					for _, hp := range overrides.command(api.Name).IDHelperParams {
+						p("%s string, ", hp)
 					}
 					pn("opts ...OptionFunc) (*%s, int, error)", parseSingular(ln))
 				}
@@ -1272,11 +2006,7 @@ func (s *service) generateInterfaceType() {
 						p("%s %s, ", ap.Name, mapType(api.Name, ap.Name, ap.Type))
 					}
 				}
-				if ln == "LoadBalancerRuleInstances" {
-					pn("opts ...OptionFunc) (*VirtualMachine, int, error)")
-				} else {
-					pn("opts ...OptionFunc) (*%s, int, error)", parseSingular(ln))
-				}
+				pn("opts ...OptionFunc) (*%s, int, error)", overrides.command(api.Name).itemType(ln))
 			}
 		}
 	}
@@ -1286,93 +2016,201 @@ func (s *service) generateInterfaceType() {
 func (s *service) generateToURLValuesFunc(a *API) {
 	pn := s.pn
 
-	pn("func (p *%s) toURLValues() url.Values {", capitalize(a.Name+"Params"))
+	found := make(map[string]bool)
+
+	pn("// ToURLValues encodes all set params the same way they are sent to the API")
+	pn("func (p *%s) ToURLValues() url.Values {", capitalize(a.Name+"Params"))
 	pn("	u := url.Values{}")
-	pn("	if p.p == nil {")
+	pn("	if p == nil {")
 	pn("		return u")
 	pn("	}")
 	for _, ap := range a.Params {
-		pn("	if v, found := p.p[\"%s\"]; found {", ap.Name)
-		s.generateConvertCode(a.Name, ap.Name, mapType(a.Name, ap.Name, ap.Type))
-		pn("	}")
+		if !found[ap.Name] {
+			f := paramFieldName(ap.Name)
+			pn("	if p.%s.ok {", f)
+			s.generateConvertCode(a.Name, ap.Name, "p."+f+".v", mapType(a.Name, ap.Name, ap.Type))
+			pn("	}")
+			found[ap.Name] = true
+		}
 	}
 	pn("	return u")
 	pn("}")
 	pn("")
 }
 
-func (s *service) generateConvertCode(cmd, name, typ string) {
+func (s *service) generateConvertCode(cmd, name, v, typ string) {
 	pn := s.pn
 
 	switch typ {
-	case "string", "UUID":
-		pn("u.Set(\"%s\", v.(string))", name)
+	case "string":
+		pn("u.Set(\"%s\", %s)", name, v)
+	case "UUID":
+		pn("u.Set(\"%s\", string(%s))", name, v)
 	case "int":
-		pn("vv := strconv.Itoa(v.(int))")
-		pn("u.Set(\"%s\", vv)", name)
+		pn("u.Set(\"%s\", strconv.Itoa(%s))", name, v)
 	case "int64":
-		pn("vv := strconv.FormatInt(v.(int64), 10)")
-		pn("u.Set(\"%s\", vv)", name)
+		pn("u.Set(\"%s\", strconv.FormatInt(%s, 10))", name, v)
+	case "float64":
+		pn("u.Set(\"%s\", strconv.FormatFloat(%s, 'f', -1, 64))", name, v)
 	case "bool":
-		pn("vv := strconv.FormatBool(v.(bool))")
-		pn("u.Set(\"%s\", vv)", name)
+		pn("u.Set(\"%s\", strconv.FormatBool(%s))", name, v)
 	case "[]string":
-		pn("vv := strings.Join(v.([]string), \",\")")
-		pn("u.Set(\"%s\", vv)", name)
+		pn("u.Set(\"%s\", strings.Join(%s, \",\"))", name, v)
 	case "[]map[string]string":
-		pn("l := v.([]map[string]string)")
-		pn("for i, m := range l {")
+		pn("for i, m := range %s {", v)
 		pn("  for key, val := range m {")
 		pn("	  u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, key), val)", name)
 		pn("  }")
 		pn("}")
 	case "map[string]string":
-		pn("m := v.(map[string]string)")
-		zeroIndex := detailsRequireZeroIndex[cmd]
-		if zeroIndex {
+		keyField, valueField, zeroIndex := mapEncoding(cmd, name)
+		pn("m := %s", v)
+		if keyField == "" && zeroIndex {
 			pn("for _, k := range getSortedKeysFromMap(m) {")
 		} else {
 			pn("for i, k := range getSortedKeysFromMap(m) {")
 		}
-		switch name {
-		case "details":
-			if detailsRequireKeyValue[cmd] {
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
-			} else {
-				if zeroIndex {
-					pn("	u.Set(fmt.Sprintf(\"%s[0].%%s\", k), m[k])", name)
-				} else {
-					pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
-				}
-			}
-		case "serviceproviderlist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].service\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].provider\", i), m[k])", name)
-		case "usersecuritygrouplist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].account\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].group\", i), m[k])", name)
-		case "tags":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
-			if cmd == "deleteTags" {
+		switch {
+		case keyField == "" && zeroIndex:
+			pn("	u.Set(fmt.Sprintf(\"%s[0].%%s\", k), m[k])", name)
+		case keyField == "":
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
+		default:
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), k)", name, keyField)
+			if overrides.command(cmd).OmitEmptyValues {
 				pn("	if m[k] != \"\" {")
-				pn("		u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
+				pn("		u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), m[k])", name, valueField)
 				pn("	}")
 			} else {
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
-			}
-		default:
-			if zeroIndex && !detailsRequireKeyValue[cmd] {
-				pn("	u.Set(fmt.Sprintf(\"%s[0].%%s\", k), m[k])", name)
-			} else {
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
+				pn("	u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), m[k])", name, valueField)
 			}
 		}
 		pn("}")
 	}
 }
 
+// mapEncoding returns how the entries of a map param are encoded. When a key field is
+// returned, each entry is encoded as name[i].keyField=key and name[i].valueField=value.
+// Otherwise each entry is encoded as name[i].key=value, where i is always 0 when the
+// zero index is required.
+func mapEncoding(cmd, name string) (keyField string, valueField string, zeroIndex bool) {
+	c := overrides.command(cmd)
+	zeroIndex = c.DetailsZeroIndex
+
+	if name == "details" && c.DetailsKeyValue {
+		return "key", "value", zeroIndex
+	}
+	if m, ok := overrides.mapParam(cmd, name); ok {
+		if m.Indexed {
+			return "", "", zeroIndex
+		}
+		return m.KeyField, m.ValueField, zeroIndex
+	}
+	if zeroIndex && !c.DetailsKeyValue {
+		return "", "", zeroIndex
+	}
+	return "key", "value", zeroIndex
+}
+
+func (s *service) generateParseFunc(a *API) {
+	pn := s.pn
+	tn := capitalize(a.Name + "Params")
+	found := make(map[string]bool)
+
+	params := APIParams{}
+	for _, ap := range a.Params {
+		if !found[ap.Name] {
+			params = append(params, ap)
+			found[ap.Name] = true
+		}
+	}
+
+	pn("// Parse%s parses url.Values, for example taken from a raw API request,", tn)
+	pn("// into a new %s instance. Encoding the returned params gives back the", tn)
+	pn("// original values, except for the generic request params like the signature,")
+	pn("// when the values use the canonical encoding of the map params. Map params in another")
+	pn("// encoding, like details[0].a=1&details[0].b=2, are accepted but encoded canonically.")
+	pn("func Parse%s(u url.Values) (*%s, error) {", tn, tn)
+	pn("	p := &%s{}", tn)
+	var names []string
+	for _, ap := range params {
+		switch mapType(a.Name, ap.Name, ap.Type) {
+		case "map[string]string", "[]map[string]string":
+			names = append(names, fmt.Sprintf("%q", ap.Name+"[]"))
+		default:
+			names = append(names, fmt.Sprintf("%q", ap.Name))
+		}
+	}
+	if len(names) > 0 {
+		pn("	if err := checkParamNames(\"%s\", u, %s); err != nil {", a.Name, strings.Join(names, ", "))
+	} else {
+		pn("	if err := checkParamNames(\"%s\", u); err != nil {", a.Name)
+	}
+	pn("		return nil, err")
+	pn("	}")
+	for _, ap := range params {
+		s.generateParseCode(a.Name, ap.Name, mapType(a.Name, ap.Name, ap.Type))
+	}
+	pn("	return p, nil")
+	pn("}")
+	pn("")
+}
+
+func (s *service) generateParseCode(cmd, name, typ string) {
+	pn := s.pn
+	n := capitalize(name)
+
+	parseValue := func(format string) {
+		pn("	if _, found := u[\"%s\"]; found {", name)
+		pn("		v, err := "+format, fmt.Sprintf("u.Get(%q)", name))
+		pn("		if err != nil {")
+		pn("			return nil, fmt.Errorf(\"Invalid value for param %s: %%v\", err)", name)
+		pn("		}")
+		pn("		p.Set%s(v)", n)
+		pn("	}")
+	}
+
+	switch typ {
+	case "string":
+		pn("	if _, found := u[\"%s\"]; found {", name)
+		pn("		p.Set%s(u.Get(\"%s\"))", n, name)
+		pn("	}")
+	case "UUID":
+		pn("	if _, found := u[\"%s\"]; found {", name)
+		pn("		p.Set%s(UUID(u.Get(\"%s\")))", n, name)
+		pn("	}")
+	case "int":
+		parseValue("strconv.Atoi(%s)")
+	case "int64":
+		parseValue("strconv.ParseInt(%s, 10, 64)")
+	case "float64":
+		parseValue("strconv.ParseFloat(%s, 64)")
+	case "bool":
+		parseValue("strconv.ParseBool(%s)")
+	case "[]string":
+		pn("	if _, found := u[\"%s\"]; found {", name)
+		pn("		p.Set%s(strings.Split(u.Get(\"%s\"), \",\"))", n, name)
+		pn("	}")
+	case "[]map[string]string":
+		pn("	if l, err := parseIndexedValues(u, \"%s\"); err != nil {", name)
+		pn("		return nil, err")
+		pn("	} else if len(l) > 0 {")
+		pn("		p.Set%s(l)", n)
+		pn("	}")
+	case "map[string]string":
+		keyField, valueField, zeroIndex := mapEncoding(cmd, name)
+		if keyField == "" {
+			pn("	if m, err := parseIndexedMap(u, \"%s\", %t); err != nil {", name, zeroIndex)
+		} else {
+			pn("	if m, err := parseKeyValueMap(u, \"%s\", \"%s\", \"%s\", %t); err != nil {", name, keyField, valueField, overrides.command(cmd).OmitEmptyValues)
+		}
+		pn("		return nil, err")
+		pn("	} else if len(m) > 0 {")
+		pn("		p.Set%s(m)", n)
+		pn("	}")
+	}
+}
+
 func (s *service) parseParamName(name string) string {
 	if name != "type" {
 		return name
@@ -1386,43 +2224,32 @@ func (s *service) generateParamGettersAndSettersFunc(a *API) {
 
 	for _, ap := range a.Params {
 		if !found[ap.Name] {
-			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapType(a.Name, ap.Name, ap.Type))
-			pn("	if p.p == nil {")
-			pn("		p.p = make(map[string]interface{})")
-			pn("	}")
-			pn("	p.p[\"%s\"] = v", ap.Name)
+			f := paramFieldName(ap.Name)
+			typ := mapType(a.Name, ap.Name, ap.Type)
+
+			printComment(pn, "", setterComment(a, ap))
+			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), typ)
+			pn("	p.%s = %s{v: v, ok: true}", f, optionalTypeName(typ))
 			pn("}")
 			pn("")
 
+			pn("// Reset%s unsets the %s param", capitalize(ap.Name), ap.Name)
 			pn("func (p *%s) Reset%s() {", capitalize(a.Name+"Params"), capitalize(ap.Name))
-			pn("	if p.p != nil && p.p[\"%s\"] != nil {", ap.Name)
-			pn("		delete(p.p, \"%s\")", ap.Name)
-			pn("	}")
+			pn("	p.%s = %s{}", f, optionalTypeName(typ))
 			pn("}")
 			pn("")
 
-			pn("func (p *%s) Get%s() (%s, bool) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapType(a.Name, ap.Name, ap.Type))
-			pn("	if p.p == nil {")
-			pn("		p.p = make(map[string]interface{})")
-			pn("	}")
-			pn("	value, ok := p.p[\"%s\"].(%s)", ap.Name, mapType(a.Name, ap.Name, ap.Type))
-			pn("	return value, ok")
+			pn("// Get%s returns the %s param and if it is set", capitalize(ap.Name), ap.Name)
+			pn("func (p *%s) Get%s() (%s, bool) {", capitalize(a.Name+"Params"), capitalize(ap.Name), typ)
+			pn("	return p.%s.v, p.%s.ok", f, f)
 			pn("}")
 			pn("")
 
-			if mapRequireList[a.Name] != nil && mapRequireList[a.Name][ap.Name] {
+			if overrides.command(a.Name).isMapListParam(ap.Name) {
+				pn("// Add%s adds an item to the %s param", capitalize(ap.Name), ap.Name)
 				pn("func (p *%s) Add%s(item map[string]string) {", capitalize(a.Name+"Params"), capitalize(ap.Name))
-				pn("	if p.p == nil {")
-				pn("		p.p = make(map[string]interface{})")
-				pn("	}")
-				pn("	val, found := p.p[\"%s\"]", ap.Name)
-				pn("	if !found {")
-				pn("	  p.p[\"%s\"] = []map[string]string{}", ap.Name)
-				pn("	  val = p.p[\"%s\"]", ap.Name)
-				pn("	}")
-				pn("	l := val.([]map[string]string)")
-				pn("	l = append(l, item)")
-				pn("	p.p[\"%s\"] = l", ap.Name)
+				pn("	p.%s.v = append(p.%s.v, item)", f, f)
+				pn("	p.%s.ok = true", f)
 				pn("}")
 				pn("")
 			}
@@ -1431,6 +2258,133 @@ func (s *service) generateParamGettersAndSettersFunc(a *API) {
 	}
 }
 
+func (s *service) generateCloneAndEqualFuncs(a *API) {
+	pn := s.pn
+	tn := capitalize(a.Name + "Params")
+	found := make(map[string]bool)
+
+	params := APIParams{}
+	for _, ap := range a.Params {
+		if !found[ap.Name] {
+			params = append(params, ap)
+			found[ap.Name] = true
+		}
+	}
+
+	pn("// Clone returns a deep copy of the params")
+	pn("func (p *%s) Clone() *%s {", tn, tn)
+	pn("	if p == nil {")
+	pn("		return nil")
+	pn("	}")
+	pn("	c := *p")
+	for _, ap := range params {
+		if !isComparableType(mapType(a.Name, ap.Name, ap.Type)) {
+			pn("	c.%s = p.%s.clone()", paramFieldName(ap.Name), paramFieldName(ap.Name))
+		}
+	}
+	pn("	return &c")
+	pn("}")
+	pn("")
+
+	var checks []string
+	for _, ap := range params {
+		f := paramFieldName(ap.Name)
+		if isComparableType(mapType(a.Name, ap.Name, ap.Type)) {
+			checks = append(checks, fmt.Sprintf("p.%s == o.%s", f, f))
+		} else {
+			checks = append(checks, fmt.Sprintf("p.%s.equal(o.%s)", f, f))
+		}
+	}
+	if len(checks) == 0 {
+		checks = append(checks, "true")
+	}
+
+	pn("// Equal reports whether p and o hold exactly the same param values")
+	pn("func (p *%s) Equal(o *%s) bool {", tn, tn)
+	pn("	if p == nil || o == nil {")
+	pn("		return p == o")
+	pn("	}")
+	pn("	return %s", strings.Join(checks, " &&\n"))
+	pn("}")
+	pn("")
+}
+
+func (s *service) generateSerializationFuncs(a *API) {
+	pn := s.pn
+	tn := capitalize(a.Name + "Params")
+	sn := "serialized" + tn
+	found := make(map[string]bool)
+
+	params := APIParams{}
+	for _, ap := range a.Params {
+		if !found[ap.Name] {
+			params = append(params, ap)
+			found[ap.Name] = true
+		}
+	}
+
+	pn("// %s is used to (un)marshal %s using the API param names", sn, tn)
+	pn("type %s struct {", sn)
+	for _, ap := range params {
+		pn("	%s *%s `json:\"%s,omitempty\" yaml:\"%s,omitempty\"`", capitalize(ap.Name), mapType(a.Name, ap.Name, ap.Type), ap.Name, ap.Name)
+	}
+	pn("}")
+	pn("")
+
+	pn("func (p *%s) toSerialized() *%s {", tn, sn)
+	pn("	s := &%s{}", sn)
+	for _, ap := range params {
+		f := paramFieldName(ap.Name)
+		pn("	if p.%s.ok {", f)
+		pn("		s.%s = &p.%s.v", capitalize(ap.Name), f)
+		pn("	}")
+	}
+	pn("	return s")
+	pn("}")
+	pn("")
+
+	pn("func (p *%s) fromSerialized(s *%s) {", tn, sn)
+	pn("	*p = %s{}", tn)
+	for _, ap := range params {
+		pn("	if s.%s != nil {", capitalize(ap.Name))
+		pn("		p.Set%s(*s.%s)", capitalize(ap.Name), capitalize(ap.Name))
+		pn("	}")
+	}
+	pn("}")
+	pn("")
+
+	pn("// MarshalJSON encodes all set params as a JSON object keyed by the API param names")
+	pn("func (p %s) MarshalJSON() ([]byte, error) {", tn)
+	pn("	return json.Marshal(p.toSerialized())")
+	pn("}")
+	pn("")
+	pn("// UnmarshalJSON replaces all params with the ones found in the JSON object")
+	pn("func (p *%s) UnmarshalJSON(b []byte) error {", tn)
+	pn("	var s %s", sn)
+	pn("	if err := json.Unmarshal(b, &s); err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("	p.fromSerialized(&s)")
+	pn("	return nil")
+	pn("}")
+	pn("")
+	pn("// MarshalYAML encodes all set params as a YAML mapping keyed by the API param names")
+	pn("func (p %s) MarshalYAML() (interface{}, error) {", tn)
+	pn("	return p.toSerialized(), nil")
+	pn("}")
+	pn("")
+	pn("// UnmarshalYAML replaces all params with the ones found in the YAML mapping")
+	pn("func (p *%s) UnmarshalYAML(unmarshal func(interface{}) error) error {", tn)
+	pn("	var s %s", sn)
+	pn("	if err := unmarshal(&s); err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("	p.fromSerialized(&s)")
+	pn("	return nil")
+	pn("}")
+	pn("")
+}
+
 func (s *service) generateNewParamTypeFunc(a *API) {
 	p, pn := s.p, s.pn
 	tn := capitalize(a.Name + "Params")
@@ -1450,10 +2404,9 @@ func (s *service) generateNewParamTypeFunc(a *API) {
 
 	// Generate the function body
 	pn("	p := &%s{}", tn)
-	pn("	p.p = make(map[string]interface{})")
 	sort.Sort(rp)
 	for _, ap := range rp {
-		pn("	p.p[\"%s\"] = %s", ap.Name, s.parseParamName(ap.Name))
+		pn("	p.Set%s(%s)", capitalize(ap.Name), s.parseParamName(ap.Name))
 	}
 	pn("	return p")
 	pn("}")
@@ -1483,29 +2436,22 @@ func (s *service) generateHelperFuncs(a *API) {
 					p("%s %s, ", s.parseParamName(ap.Name), mapType(a.Name, ap.Name, ap.Type))
 				}
 			}
-			if parseSingular(ln) == "Iso" {
-				p("isofilter string, ")
-			}
-			if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-				p("zoneid string, ")
+			for _, hp := range overrides.command(a.Name).IDHelperParams {
+				p("%s string, ", hp)
 			}
 			pn("opts ...OptionFunc) (string, int, error) {")
 
 			// Generate the function body
 			pn("	p := &List%sParams{}", ln)
-			pn("	p.p = make(map[string]interface{})")
 			pn("")
-			pn("	p.p[\"%s\"] = %s", v, v)
+			pn("	p.Set%s(%s)", capitalize(v), v)
 			for _, ap := range a.Params {
 				if ap.Required || isRequiredParam(a, ap) {
-					pn("	p.p[\"%s\"] = %s", ap.Name, s.parseParamName(ap.Name))
+					pn("	p.Set%s(%s)", capitalize(ap.Name), s.parseParamName(ap.Name))
 				}
 			}
-			if parseSingular(ln) == "Iso" {
-				pn("	p.p[\"isofilter\"] = isofilter")
-			}
-			if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-				pn("	p.p[\"zoneid\"] = zoneid")
+			for _, hp := range overrides.command(a.Name).IDHelperParams {
+				pn("	p.Set%s(%s)", capitalize(hp), hp)
 			}
 			pn("")
 			pn("	for _, fn := range append(s.cs.options, opts...) {")
@@ -1519,7 +2465,7 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("		return \"\", -1, err")
 			pn("	}")
 			pn("")
-			if ln == "AffinityGroups" {
+			if overrides.command(a.Name).CountListedItems {
 				pn("	// This is needed because of a bug with the listAffinityGroup call. It reports the")
 				pn("	// number of VirtualMachines in the groups as being the number of groups found.")
 				pn("	l.Count = len(l.%s)", ln)
@@ -1553,11 +2499,8 @@ func (s *service) generateHelperFuncs(a *API) {
 						p("%s %s, ", s.parseParamName(ap.Name), mapType(a.Name, ap.Name, ap.Type))
 					}
 				}
-				if parseSingular(ln) == "Iso" {
-					p("isofilter string, ")
-				}
-				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-					p("zoneid string, ")
+				for _, hp := range overrides.command(a.Name).IDHelperParams {
+					p("%s string, ", hp)
 				}
 				pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
 
@@ -1568,11 +2511,8 @@ func (s *service) generateHelperFuncs(a *API) {
 						p("%s, ", s.parseParamName(ap.Name))
 					}
 				}
-				if parseSingular(ln) == "Iso" {
-					p("isofilter, ")
-				}
-				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-					p("zoneid, ")
+				for _, hp := range overrides.command(a.Name).IDHelperParams {
+					p("%s, ", hp)
 				}
 				pn("opts...)")
 				pn("  if err != nil {")
@@ -1606,20 +2546,15 @@ func (s *service) generateHelperFuncs(a *API) {
 					p("%s %s, ", ap.Name, mapType(a.Name, ap.Name, ap.Type))
 				}
 			}
-			if ln == "LoadBalancerRuleInstances" {
-				pn("opts ...OptionFunc) (*VirtualMachine, int, error) {")
-			} else {
-				pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
-			}
+			pn("opts ...OptionFunc) (*%s, int, error) {", overrides.command(a.Name).itemType(ln))
 
 			// Generate the function body
 			pn("	p := &List%sParams{}", ln)
-			pn("	p.p = make(map[string]interface{})")
 			pn("")
-			pn("	p.p[\"id\"] = id")
+			pn("	p.SetId(id)")
 			for _, ap := range a.Params {
 				if ap.Required && s.parseParamName(ap.Name) != "id" {
-					pn("	p.p[\"%s\"] = %s", ap.Name, s.parseParamName(ap.Name))
+					pn("	p.Set%s(%s)", capitalize(ap.Name), s.parseParamName(ap.Name))
 				}
 			}
 			pn("")
@@ -1639,7 +2574,7 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("		return nil, -1, err")
 			pn("	}")
 			pn("")
-			if ln == "AffinityGroups" {
+			if overrides.command(a.Name).CountListedItems {
 				pn("	// This is needed because of a bug with the listAffinityGroup call. It reports the")
 				pn("	// number of VirtualMachines in the groups as being the number of groups found.")
 				pn("	l.Count = len(l.%s)", ln)
@@ -1702,8 +2637,8 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	n := capitalize(a.Name)
 
 	// Generate the function signature
-	pn("// %s", a.Description)
-	pn("func (s *%s) %s(p *%s) (*%s, error) {", s.name, n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
+	printComment(pn, "", apiComment(a)...)
+	pn("func (s *%s) %s(p *%s, opts ...CallOption) (*%s, error) {", s.name, n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
 
 	// Generate the function body
 	if n == "QueryAsyncJobResult" {
@@ -1712,58 +2647,24 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		pn("")
 		pn("	// We should be able to retry on failure as this call is idempotent")
 		pn("	for i := 0; i < 3; i++ {")
-		pn("		resp, err = s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
+		pn("		resp, err = s.cs.newRequest(\"%s\", s.cs.encodeParams(\"%s\", p), opts...)", a.Name, a.Name)
 		pn("		if err == nil {")
 		pn("			break")
 		pn("		}")
 		pn("		time.Sleep(500 * time.Millisecond)")
 		pn("	}")
 	} else {
-		if requiresPostMethod[a.Name] {
-			pn("	resp, err := s.cs.newPostRequest(\"%s\", p.toURLValues())", a.Name)
+		if overrides.command(a.Name).Post {
+			pn("	resp, err := s.cs.newPostRequest(\"%s\", s.cs.encodeParams(\"%s\", p), opts...)", a.Name, a.Name)
 		} else {
-			pn("	resp, err := s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
+			pn("	resp, err := s.cs.newRequest(\"%s\", s.cs.encodeParams(\"%s\", p), opts...)", a.Name, a.Name)
 		}
 	}
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
 	pn("")
-	switch n {
-	case
-		"AddCluster",
-		"AddImageStore",
-		"CreateAccount",
-		"CreateDomain",
-		"UpdateDomain",
-		"CreateNetwork",
-		"CreateStoragePool",
-		"CreateNetworkOffering",
-		"CreateVlanIpRange",
-		"UpdateNetworkOffering",
-		"UpdateServiceOffering",
-		"UpdateConfiguration",
-		"UpdateCluster",
-		"UpdateVlanIpRange",
-		"CreatePod",
-		"CreateSSHKeyPair",
-		"CreateSecurityGroup",
-		"CreateServiceOffering",
-		"CreateUser",
-		"CreateZone",
-		"DedicateGuestVlanRange",
-		"EnableUser",
-		"GetVirtualMachineUserData",
-		"LockUser",
-		"RegisterSSHKeyPair",
-		"RegisterUserKeys",
-		"GetUserKeys",
-		"AddAnnotation",
-		"RemoveAnnotation",
-		"AddKubernetesSupportedVersion",
-		"CreateDiskOffering",
-		"AddHost",
-		"RegisterIso":
+	if overrides.command(a.Name).RawValueResponse {
 		pn("	if resp, err = getRawValue(resp); err != nil {")
 		pn("		return nil, err")
 		pn("	}")
@@ -1777,7 +2678,7 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		pn("")
 	}
 
-	if field, isNested := nestedResponse[a.Name]; isNested {
+	if field := overrides.command(a.Name).NestedResponse; field != "" {
 		pn("	var nested struct {")
 		pn("			Response %sResponse `json:\"%s\"`", strings.TrimPrefix(n, "Configure"), field)
 		pn("	}")
@@ -1794,8 +2695,8 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	pn("")
 	if a.Isasync {
 		pn("	// If we have a async client, we need to wait for the async result")
-		pn("	if s.cs.async {")
-		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("	if o := s.cs.newCallOptions(opts); o.async {")
+		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, o.asyncTimeout, o.asyncJobOptions()...)")
 		pn("		if err != nil {")
 		pn("			if err == AsyncTimeoutErr {")
 		pn("				return &r, err")
@@ -1842,19 +2743,25 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	pn("")
 }
 
+// isSuccessOnlyResponse returns true if the response has all the success fields (see
+// overrides.yaml), so it only reports the success of the command
 func isSuccessOnlyResponse(resp APIResponses) bool {
-	success := false
-	displaytext := false
-
-	for _, r := range resp {
-		if r.Name == "displaytext" {
-			displaytext = true
+	if len(overrides.SuccessFields) == 0 {
+		return false
+	}
+	for _, name := range overrides.SuccessFields {
+		found := false
+		for _, r := range resp {
+			if r.Name == name {
+				found = true
+				break
+			}
 		}
-		if r.Name == "success" {
-			success = true
+		if !found {
+			return false
 		}
 	}
-	return displaytext && success
+	return true
 }
 
 func (s *service) generateResponseType(a *API) {
@@ -1862,46 +2769,35 @@ func (s *service) generateResponseType(a *API) {
 	tn := capitalize(strings.TrimPrefix(a.Name, "configure") + "Response")
 	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
 
-	// If this is a 'list' response, we need an separate list struct. There seem to be other
-	// types of responses that also need a separate list struct, so checking on exact matches
-	// for those once.
-	if strings.HasPrefix(a.Name, "list") || a.Name == "registerTemplate" || a.Name == "findHostsForMigration" {
+	// If this is a 'list' response, we need an separate list struct. Other types of responses
+	// that also need a separate list struct have a listResponseKey override.
+	o := overrides.command(a.Name)
+	if strings.HasPrefix(a.Name, "list") || o.ListResponseKey != "" {
 		pn("type %s struct {", tn)
 
-		// This nasty check is for some specific response that do not behave consistent
-		switch a.Name {
-		case "listAsyncJobs":
-			pn("	Count int `json:\"count\"`")
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "asyncjobs")
-		case "listCapabilities":
-			pn("    %s *%s `json:\"%s\"`", ln, parseSingular(ln), "capability")
-		case "listEgressFirewallRules":
-			pn("	Count int `json:\"count\"`")
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "firewallrule")
-		case "listLoadBalancerRuleInstances":
-			pn("	Count int `json:\"count\"`")
-			pn("	LBRuleVMIDIPs []*%s `json:\"%s\"`", parseSingular(ln), "lbrulevmidip")
-			pn("	LoadBalancerRuleInstances []*VirtualMachine `json:\"%s\"`", strings.ToLower(parseSingular(ln)))
-		case "listVirtualMachinesMetrics":
-			pn("	Count int `json:\"count\"`")
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "virtualmachine")
-		case "listManagementServersMetrics":
-			pn("	Count int `json:\"count\"`")
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "managementserver")
-		case "listDbMetrics":
-			pn("	%s %s `json:\"%s\"`", ln, parseSingular(ln), "dbMetrics")
-		case "registerTemplate":
-			pn("	Count int `json:\"count\"`")
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "template")
-		case "listDomainChildren":
+		key := o.ListResponseKey
+		if key == "" {
+			key = strings.ToLower(parseSingular(ln))
+		}
+
+		switch {
+		case o.SingleObject != nil:
+			// The response holds a single object instead of a list
+			if o.SingleObject.Pointer {
+				pn("	%s *%s `json:\"%s\"`", ln, parseSingular(ln), o.SingleObject.Key)
+			} else {
+				pn("	%s %s `json:\"%s\"`", ln, parseSingular(ln), o.SingleObject.Key)
+			}
+		case o.ResponseType != "":
+			// The listed objects have their own type, named after the response field
 			pn("	Count int `json:\"count\"`")
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "domain")
-		case "findHostsForMigration":
-			pn(" Count int `json:\"count\"`")
-			pn(" Host []*%s `json:\"%s\"`", customResponseStructTypes[a.Name], "host")
+			pn("	%s []*%s `json:\"%s\"`", o.listField(ln), o.ResponseType, key)
 		default:
 			pn("	Count int `json:\"count\"`")
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), strings.ToLower(parseSingular(ln)))
+			pn("	%s []*%s `json:\"%s\"`", o.listField(ln), parseSingular(ln), key)
+		}
+		for _, f := range sortedMapKeys(o.ExtraListFields) {
+			pn("	%s []*%s `json:\"%s\"`", f, o.ExtraListFields[f].GoType, o.ExtraListFields[f].Key)
 		}
 		pn("}")
 		pn("")
@@ -1919,22 +2815,26 @@ func (s *service) generateResponseType(a *API) {
 		pn("		return err")
 		pn("	}")
 		pn("")
-		pn("	if success, ok := m[\"success\"].(string); ok {")
-		pn("		m[\"success\"] = success == \"true\"")
-		pn("		b, err = json.Marshal(m)")
-		pn("		if err != nil {")
-		pn("			return err")
-		pn("		}")
-		pn("	}")
-		pn("")
-		pn("	if ostypeid, ok := m[\"ostypeid\"].(float64); ok {")
-		pn("		m[\"ostypeid\"] = strconv.Itoa(int(ostypeid))")
-		pn("		b, err = json.Marshal(m)")
-		pn("		if err != nil {")
-		pn("			return err")
-		pn("		}")
-		pn("	}")
-		pn("")
+		for _, name := range sortedResponseFields(func(f *ResponseFieldOverride) bool { return f.StringBool }) {
+			pn("	if %s, ok := m[\"%s\"].(string); ok {", name, name)
+			pn("		m[\"%s\"] = %s == \"true\"", name, name)
+			pn("		b, err = json.Marshal(m)")
+			pn("		if err != nil {")
+			pn("			return err")
+			pn("		}")
+			pn("	}")
+			pn("")
+		}
+		for _, name := range sortedResponseFields(func(f *ResponseFieldOverride) bool { return f.NumberString }) {
+			pn("	if %s, ok := m[\"%s\"].(float64); ok {", name, name)
+			pn("		m[\"%s\"] = strconv.Itoa(int(%s))", name, name)
+			pn("		b, err = json.Marshal(m)")
+			pn("		if err != nil {")
+			pn("			return err")
+			pn("		}")
+			pn("	}")
+			pn("")
+		}
 		pn("	type alias %s", tn)
 		pn("	return json.Unmarshal(b, (*alias)(r))")
 		pn("}")
@@ -1942,6 +2842,18 @@ func (s *service) generateResponseType(a *API) {
 	}
 }
 
+// sortedResponseFields returns the sorted names of the response field overrides matching f
+func sortedResponseFields(f func(*ResponseFieldOverride) bool) []string {
+	var names []string
+	for name, r := range overrides.ResponseFields {
+		if f(r) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 func parseSingular(n string) string {
 	if strings.HasSuffix(n, "ies") {
 		return strings.TrimSuffix(n, "ies") + "y"
@@ -1957,8 +2869,8 @@ func (s *service) recusiveGenerateResponseType(aName string, tn string, resp API
 	customMarshal := false
 	found := make(map[string]bool)
 
-	if val, ok := customResponseStructTypes[aName]; ok {
-		tn = val
+	if rt := overrides.command(aName).ResponseType; rt != "" {
+		tn = rt
 	}
 
 	pn("type %s struct {", tn)
@@ -1967,30 +2879,33 @@ func (s *service) recusiveGenerateResponseType(aName string, tn string, resp API
 		if r.Name == "" {
 			continue
 		}
-		if r.Name == "secondaryip" {
+		if fields := overrides.responseField(r.Name).ListFields; len(fields) > 0 {
 			pn("%s []struct {", capitalize(r.Name))
-			pn("	Id string `json:\"id\"`")
-			pn("	Ipaddress string `json:\"ipaddress\"`")
+			for _, f := range fields {
+				pn("	%s string `json:\"%s\"`", capitalize(f), f)
+			}
 			pn("} `json:\"%s\"`", r.Name)
 			continue
 		}
 		if r.Response != nil {
 			sort.Sort(r.Response)
 			typeName, create := getUniqueTypeName(tn, r.Name)
+			printComment(pn, "", sentence(r.Description))
 			pn("%s []%s `json:\"%s\"`", capitalize(r.Name), typeName, r.Name)
 			if create {
 				defer s.recusiveGenerateResponseType(aName, typeName, r.Response, false)
 			}
 		} else {
 			if !found[r.Name] {
-				switch r.Name {
-				case "success":
-					// This case is because the response field is different for sync and async calls :(
+				printComment(pn, "", sentence(r.Description))
+				switch f := overrides.responseField(r.Name); {
+				case f.StringBool:
+					// The response field is different for sync and async calls :(
 					pn("%s bool `json:\"%s\"`", capitalize(r.Name), r.Name)
 					if !async {
 						customMarshal = true
 					}
-				case "ostypeid":
+				case f.NumberString:
 					// This case is needed for backwards compatibility.
 					pn("%s string `json:\"%s\"`", capitalize(r.Name), r.Name)
 					customMarshal = true
@@ -2009,17 +2924,13 @@ func (s *service) recusiveGenerateResponseType(aName string, tn string, resp API
 }
 
 func getUniqueTypeName(prefix, name string) (string, bool) {
-	// We have special cases for [in|e]gressrules, nics and tags as the exact
-	// sames types are used used in multiple different locations.
-	switch {
-	case strings.HasSuffix(name, "gressrule"):
-		name = "rule"
-	case strings.HasSuffix(name, "nic"):
-		prefix = ""
-		name = "nic"
-	case strings.HasSuffix(name, "tags"):
-		prefix = ""
-		name = "tags"
+	// Some nested response fields, like [in|e]gressrules, nics and tags, use the
+	// exact same types in multiple different locations (see sharedTypes in overrides.yaml).
+	if shared, global, ok := overrides.sharedType(name); ok {
+		name = shared
+		if global {
+			prefix = ""
+		}
 	}
 
 	tn := prefix + capitalize(name)
@@ -2029,49 +2940,60 @@ func getUniqueTypeName(prefix, name string) (string, bool) {
 	}
 
 	// Return here as this means the type already exists.
-	if name == "rule" || name == "nic" || name == "tags" {
+	if overrides.isSharedType(name) {
 		return tn, false
 	}
 
 	return getUniqueTypeName(prefix, name+"Internal")
 }
 
-func logMissingApis(ai map[string]*API, as *allServices) {
-	asMap := make(map[string]*API)
-	for _, svc := range as.services {
-		for _, api := range svc.apis {
-			asMap[api.Name] = api
-		}
+func getAllServices(listApis string, lo *layoutOptions) (*allServices, []error, error) {
+	targets, err := parseTargets(listApis)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for apiName, _ := range ai {
-		_, found := asMap[apiName]
-		if !found {
-			log.Printf("Api missing in layout: %s", apiName)
+	// Get a map with all API info, merged for all targeted server versions
+	ai, vi, err := mergeAPIInfo(targets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	overrides.checkAPIs(ai)
+	if !hasParamDescriptions(ai) {
+		log.Printf("The API info has no param descriptions, so the generated setters and CLI flags are " +
+			"not documented. Use the listApis output of a management server (see --url).")
+	}
+
+	// Check if the layout matches the API info in both directions
+	drift := checkLayout(layout, ai)
+	if lo.report != "" {
+		if err := drift.writeReport(lo.report); err != nil {
+			return nil, nil, err
 		}
 	}
-}
+	if lo.strict && drift.hasDrift() {
+		drift.log(false)
+		return nil, nil, drift.error()
+	}
+	drift.log(lo.autoGroup)
 
-func getAllServices(listApis string) (*allServices, []error, error) {
-	// Get a map with all API info
-	ai, err := getAPIInfo(listApis)
-	if err != nil {
-		return nil, nil, err
+	l := layout
+	if lo.autoGroup {
+		l = drift.apply(layout)
 	}
 
 	// Generate a complete set of services with their methods (APIs)
-	as := &allServices{}
+	as := &allServices{versions: vi}
 	errors := []error{}
-	for sn, apis := range layout {
+	for sn, apis := range l {
 		typeNames[sn] = true
 		s := &service{name: sn}
 		for _, api := range apis {
-			a, found := ai[api]
-			if !found {
-				errors = append(errors, &apiInfoNotFoundError{api})
-				continue
+			// Commands missing from the API info are logged as drift
+			if a, found := ai[api]; found {
+				s.apis = append(s.apis, a)
 			}
-			s.apis = append(s.apis, a)
 		}
 		for _, apis := range s.apis {
 			sort.Sort(apis.Params)
@@ -2083,8 +3005,6 @@ func getAllServices(listApis string) (*allServices, []error, error) {
 	as.services = append(as.services, &service{name: "CustomService"})
 	sort.Sort(as.services)
 
-	logMissingApis(ai, as)
-
 	return as, errors, nil
 }
 
@@ -2138,44 +3058,30 @@ func testDir() (string, error) {
 }
 
 func isRequiredParam(api *API, apiParam *APIParam) bool {
-	if params, ok := requiredParams[api.Name]; ok {
-		for _, param := range params {
-			if param == apiParam.Name {
-				return true
-			}
-		}
-	}
-	return false
+	return contains(overrides.command(api.Name).RequiredParams, apiParam.Name)
 }
 
 func mapType(aName string, pName string, pType string) string {
-	if _, ok := longToStringConvertedParams[pName]; ok {
-		pType = "UUID"
+	if typ, ok := overrides.goType(pName, pType); ok {
+		return typ
+	}
+	if typ, ok := overrides.apiType(pType); ok {
+		return typ
 	}
 
 	switch pType {
-	case "UUID":
-		return "UUID"
 	case "boolean":
 		return "bool"
 	case "short", "int", "integer":
 		return "int"
 	case "long":
 		return "int64"
-	case "float", "double":
+	case "float", "double", "bigdecimal":
 		return "float64"
 	case "list":
-		if pName == "downloaddetails" || pName == "owner" {
-			return "[]map[string]string"
-		} else if pName == "network" {
-			return "[]*Network"
-		}
-		if pName == "virtualmachines" {
-			return "[]*VirtualMachine"
-		}
 		return "[]string"
 	case "map":
-		if mapRequireList[aName] != nil && mapRequireList[aName][pName] {
+		if overrides.command(aName).isMapListParam(pName) {
 			return "[]map[string]string"
 		}
 		return "map[string]string"
@@ -2191,20 +3097,126 @@ func mapType(aName string, pName string, pType string) string {
 		return "interface{}"
 	case "responseobject":
 		return "json.RawMessage"
-	case "uservmresponse":
-		// This is a really specific anomaly of the API
-		return "*VirtualMachine"
-	case "outofbandmanagementresponse":
-		return "OutOfBandManagementResponse"
-	case "hostharesponse":
-		return "HAForHostResponse"
-	case "consoleendpointwebsocketresponse":
-		return "map[string]interface{}"
 	default:
 		return "string"
 	}
 }
 
+// optionalTypes lists the generated types used to store optional param values,
+// keyed by the Go type of the value they hold. Types that are not comparable
+// need a generated clone and equal method so params can be copied and compared.
+var optionalTypes = []struct {
+	goType     string
+	name       string
+	comparable bool
+}{
+	{"string", "optString", true},
+	{"UUID", "optUUID", true},
+	{"bool", "optBool", true},
+	{"int", "optInt", true},
+	{"int64", "optInt64", true},
+	{"float64", "optFloat64", true},
+	{"[]string", "optStrings", false},
+	{"map[string]string", "optStringMap", false},
+	{"[]map[string]string", "optStringMaps", false},
+}
+
+func optionalTypeName(typ string) string {
+	for _, ot := range optionalTypes {
+		if ot.goType == typ {
+			return ot.name
+		}
+	}
+	panic(fmt.Sprintf("No optional type defined for param type: %s", typ))
+}
+
+func isComparableType(typ string) bool {
+	for _, ot := range optionalTypes {
+		if ot.goType == typ {
+			return ot.comparable
+		}
+	}
+	return false
+}
+
+// paramFieldName returns the name of the params struct field holding
+// the value of the named param, taking care of reserved Go keywords.
+func paramFieldName(name string) string {
+	if token.IsKeyword(name) {
+		return name + "_"
+	}
+	return name
+}
+
+func generateOptionalTypes(pn func(format string, args ...interface{})) {
+	for _, ot := range optionalTypes {
+		pn("// %s holds an optional param value of type %s", ot.name, ot.goType)
+		pn("type %s struct {", ot.name)
+		pn("	v  %s", ot.goType)
+		pn("	ok bool")
+		pn("}")
+		pn("")
+		if ot.comparable {
+			continue
+		}
+
+		pn("func (o %s) clone() %s {", ot.name, ot.name)
+		switch ot.goType {
+		case "[]string":
+			pn("	if o.v != nil {")
+			pn("		o.v = append([]string{}, o.v...)")
+			pn("	}")
+		case "map[string]string":
+			pn("	if o.v != nil {")
+			pn("		m := make(map[string]string, len(o.v))")
+			pn("		for k, v := range o.v {")
+			pn("			m[k] = v")
+			pn("		}")
+			pn("		o.v = m")
+			pn("	}")
+		case "[]map[string]string":
+			pn("	if o.v != nil {")
+			pn("		l := make([]map[string]string, len(o.v))")
+			pn("		for i, item := range o.v {")
+			pn("			l[i] = optStringMap{v: item}.clone().v")
+			pn("		}")
+			pn("		o.v = l")
+			pn("	}")
+		}
+		pn("	return o")
+		pn("}")
+		pn("")
+
+		pn("func (o %s) equal(x %s) bool {", ot.name, ot.name)
+		pn("	if o.ok != x.ok || len(o.v) != len(x.v) {")
+		pn("		return false")
+		pn("	}")
+		switch ot.goType {
+		case "[]string":
+			pn("	for i := range o.v {")
+			pn("		if o.v[i] != x.v[i] {")
+			pn("			return false")
+			pn("		}")
+			pn("	}")
+		case "map[string]string":
+			pn("	for k, v := range o.v {")
+			pn("		if xv, found := x.v[k]; !found || xv != v {")
+			pn("			return false")
+			pn("		}")
+			pn("	}")
+		case "[]map[string]string":
+			pn("	for i := range o.v {")
+			pn("		if !(optStringMap{v: o.v[i]}).equal(optStringMap{v: x.v[i]}) {")
+			pn("			return false")
+			pn("		}")
+			pn("	}")
+		}
+		pn("	return true")
+		pn("}")
+		pn("")
+	}
+}
+
 func capitalize(s string) string {
 	if s == "jobid" {
 		return "JobID"
@@ -110,6 +110,7 @@ var layout = apiInfo{
 		"recoverVirtualMachine",
 		"removeNicFromVirtualMachine",
 		"resetPasswordForVirtualMachine",
+		"resetUserDataForVirtualMachine",
 		"restoreVirtualMachine",
 		"scaleVirtualMachine",
 		"startVirtualMachine",
@@ -484,7 +485,18 @@ var layout = apiInfo{
 		"revokeSecurityGroupIngress",
 	},
 	"QuotaService": {
+		"quotaBalance",
+		"quotaCredits",
+		"quotaEmailTemplateList",
+		"quotaEmailTemplateUpdate",
 		"quotaIsEnabled",
+		"quotaStatement",
+		"quotaSummary",
+		"quotaTariffCreate",
+		"quotaTariffDelete",
+		"quotaTariffList",
+		"quotaTariffUpdate",
+		"quotaUpdate",
 	},
 	"PodService": {
 		"createPod",
@@ -728,4 +740,39 @@ var layout = apiInfo{
 	"ConsoleEndpointService": {
 		"createConsoleEndpoint",
 	},
+	"BackupService": {
+		"assignVirtualMachineToBackupOffering",
+		"createBackup",
+		"createBackupSchedule",
+		"deleteBackup",
+		"deleteBackupOffering",
+		"deleteBackupSchedule",
+		"importBackupOffering",
+		"listBackupOfferings",
+		"listBackupProviderOfferings",
+		"listBackupProviders",
+		"listBackupSchedule",
+		"listBackups",
+		"removeVirtualMachineFromBackupOffering",
+		"restoreBackup",
+		"restoreVolumeFromBackupAndAttachToVM",
+		"updateBackupOffering",
+		"updateBackupSchedule",
+	},
+	"UserDataService": {
+		"deleteUserData",
+		"linkUserDataToTemplate",
+		"listUserData",
+		"registerUserData",
+	},
+	"ObjectStoreService": {
+		"addObjectStoragePool",
+		"createBucket",
+		"deleteBucket",
+		"deleteObjectStoragePool",
+		"listBuckets",
+		"listObjectStoragePools",
+		"updateBucket",
+		"updateObjectStoragePool",
+	},
 }
@@ -0,0 +1,322 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// object is a shorthand for the JSON objects of the OpenAPI document
+type object = map[string]interface{}
+
+// WriteOpenAPI writes an OpenAPI 3 document describing all APIs to the given file, as
+// YAML if the file has a .yaml or .yml extension and as JSON otherwise
+func (as *allServices) WriteOpenAPI(file string) error {
+	doc := as.OpenAPI()
+
+	var b []byte
+	var err error
+	switch path.Ext(file) {
+	case ".yaml", ".yml":
+		b, err = yaml.Marshal(doc)
+	default:
+		b, err = json.MarshalIndent(doc, "", "  ")
+		b = append(b, '\n')
+	}
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, b, 0644)
+}
+
+// OpenAPI returns an OpenAPI 3 document with one operation per API command. As every command
+// is called on the same endpoint, which OpenAPI cannot describe, every command gets its own
+// /<command> path and the x-cloudstack-command extension. The services from layout.go are
+// used as tags, and params use the same type mapping as the Go code.
+func (as *allServices) OpenAPI() object {
+	version := "unknown"
+	if len(as.versions.versions) > 0 {
+		version = strings.Join(as.versions.versions, ", ")
+	}
+
+	schemas := object{
+		"ErrorResponse": object{
+			"type":        "object",
+			"description": "The response of a failed API call",
+			"properties": object{
+				"errorcode":   object{"type": "integer"},
+				"cserrorcode": object{"type": "integer"},
+				"errortext":   object{"type": "string"},
+			},
+		},
+		"AsyncJobResponse": object{
+			"type":        "object",
+			"description": "The response of an async API call, use queryAsyncJobResult to get the result of the job",
+			"properties": object{
+				"jobid": object{"type": "string", "format": "uuid"},
+				"id":    object{"type": "string", "format": "uuid", "description": "the ID of the created or changed object, if any"},
+			},
+		},
+		"AsyncJobResult": object{
+			"type":        "object",
+			"description": "The status and result of an async job, as returned by queryAsyncJobResult",
+			"properties": object{
+				"jobid":         object{"type": "string", "format": "uuid"},
+				"cmd":           object{"type": "string"},
+				"jobstatus":     object{"type": "integer", "description": "0 if the job is pending, 1 if it succeeded and 2 if it failed"},
+				"jobresultcode": object{"type": "integer"},
+				"jobresulttype": object{"type": "string"},
+				"jobresult":     object{"type": "object", "description": "the result of the async API call, or an ErrorResponse if the job failed"},
+			},
+		},
+	}
+
+	var tags []interface{}
+	paths := object{}
+	for _, s := range as.services {
+		if len(s.apis) == 0 {
+			continue
+		}
+		tags = append(tags, object{"name": s.name})
+
+		for _, a := range s.apis {
+			paths["/"+a.Name] = as.openAPIPath(s, a, schemas)
+		}
+	}
+
+	return object{
+		"openapi": "3.0.3",
+		"info": object{
+			"title":   "CloudStack API",
+			"version": version,
+			"description": "Every API command is called on the same endpoint, using the `command` query param. " +
+				"The paths are named after the commands, but every call is made on the server URL itself, using " +
+				"the command from the `x-cloudstack-command` extension of the operation. " +
+				"Calls are signed using the `apiKey` and `signature` query params.",
+		},
+		"servers": []interface{}{
+			object{"url": "http://localhost:8080/client/api"},
+		},
+		"tags":  tags,
+		"paths": paths,
+		"components": object{
+			"schemas": schemas,
+			"securitySchemes": object{
+				"apiKey":    object{"type": "apiKey", "in": "query", "name": "apiKey"},
+				"signature": object{"type": "apiKey", "in": "query", "name": "signature"},
+			},
+		},
+		"security": []interface{}{
+			object{"apiKey": []string{}, "signature": []string{}},
+		},
+	}
+}
+
+func (as *allServices) openAPIPath(s *service, a *API, schemas object) object {
+	params := []interface{}{
+		object{"name": "command", "in": "query", "required": true, "schema": object{"type": "string", "enum": []string{a.Name}}},
+		object{"name": "response", "in": "query", "required": true, "schema": object{"type": "string", "enum": []string{"json"}}},
+	}
+
+	found := make(map[string]bool)
+	for _, ap := range a.Params {
+		if found[ap.Name] {
+			continue
+		}
+		found[ap.Name] = true
+
+		param := object{
+			"name":        ap.Name,
+			"in":          "query",
+			"description": ap.Description,
+			"required":    ap.Required || isRequiredParam(a, ap),
+			"schema":      paramSchema(mapType(a.Name, ap.Name, ap.Type)),
+		}
+		if strings.HasPrefix(mapType(a.Name, ap.Name, ap.Type), "[]") {
+			param["style"] = "form"
+			param["explode"] = false
+		}
+		params = append(params, param)
+	}
+
+	tn := capitalize(strings.TrimPrefix(a.Name, "configure") + "Response")
+	schemas[tn] = responseSchema(a)
+
+	result := object{"$ref": "#/components/schemas/" + tn}
+	if a.Isasync {
+		result = object{"$ref": "#/components/schemas/AsyncJobResponse"}
+	}
+
+	key := strings.ToLower(a.Name) + "response"
+	op := object{
+		"x-cloudstack-command": a.Name,
+		"operationId":          a.Name,
+		"summary":              a.Description,
+		"tags":                 []string{s.name},
+		"parameters":           params,
+		"responses": object{
+			"200": object{
+				"description": "successful operation",
+				"content": object{"application/json": object{"schema": object{
+					"type":       "object",
+					"properties": object{key: result},
+				}}},
+			},
+			"default": object{
+				"description": "failed operation",
+				"content": object{"application/json": object{"schema": object{
+					"type":       "object",
+					"properties": object{key: object{"$ref": "#/components/schemas/ErrorResponse"}},
+				}}},
+			},
+		},
+	}
+	if a.Isasync {
+		op["x-async"] = true
+		op["x-async-result"] = object{"$ref": "#/components/schemas/" + tn}
+	}
+
+	method := "get"
+	if overrides.command(a.Name).Post {
+		method = "post"
+	}
+	return object{method: op}
+}
+
+// paramSchema returns the schema of a param, based on the Go type returned by mapType
+func paramSchema(typ string) object {
+	switch typ {
+	case "UUID":
+		return object{"type": "string", "format": "uuid"}
+	case "bool":
+		return object{"type": "boolean"}
+	case "int":
+		return object{"type": "integer", "format": "int32"}
+	case "int64":
+		return object{"type": "integer", "format": "int64"}
+	case "float64":
+		return object{"type": "number", "format": "double"}
+	case "[]string":
+		return object{"type": "array", "items": object{"type": "string"}}
+	case "map[string]string":
+		return object{"type": "object", "additionalProperties": object{"type": "string"},
+			"description": "encoded as indexed params, like name[0].key=value"}
+	case "[]map[string]string":
+		return object{"type": "array", "items": object{"type": "object", "additionalProperties": object{"type": "string"}},
+			"description": "encoded as indexed params, like name[0].key=value"}
+	default:
+		return object{"type": "string"}
+	}
+}
+
+// responseSchema returns the schema of the response of an API command. For list commands
+// this is the list wrapper, and for async commands the result of the job.
+func responseSchema(a *API) object {
+	o := overrides.command(a.Name)
+	item := responseFieldsSchema(a.Response)
+	if desc := strings.TrimSpace(a.Description); desc != "" {
+		item["description"] = desc
+	}
+
+	switch {
+	case o.SingleObject != nil:
+		return object{"type": "object", "properties": object{o.SingleObject.Key: item}}
+	case strings.HasPrefix(a.Name, "list") || o.ListResponseKey != "":
+		key := o.ListResponseKey
+		if key == "" {
+			key = strings.ToLower(parseSingular(capitalize(strings.TrimPrefix(a.Name, "list"))))
+		}
+		properties := object{
+			"count": object{"type": "integer"},
+			key:     object{"type": "array", "items": item},
+		}
+		for _, f := range o.ExtraListFields {
+			properties[f.Key] = object{"type": "array", "items": object{"type": "object"}}
+		}
+		return object{"type": "object", "properties": properties}
+	case isSuccessOnlyResponse(a.Response):
+		return item
+	case a.Isasync || o.RawValueResponse:
+		// The object is wrapped in another object with a single, object specific, key
+		return object{"type": "object", "maxProperties": 1, "additionalProperties": item}
+	case o.NestedResponse != "":
+		return object{"type": "object", "properties": object{o.NestedResponse: item}}
+	default:
+		return item
+	}
+}
+
+func responseFieldsSchema(fields APIResponses) object {
+	properties := object{}
+	sorted := append(APIResponses{}, fields...)
+	sort.Sort(sorted)
+	for _, f := range sorted {
+		if _, found := properties[f.Name]; !found {
+			properties[f.Name] = responseFieldSchema(f)
+		}
+	}
+	return object{"type": "object", "properties": properties}
+}
+
+func responseFieldSchema(f *APIResponse) object {
+	var schema object
+	if len(f.Response) > 0 {
+		schema = responseFieldsSchema(f.Response)
+		switch strings.ToLower(f.Type) {
+		case "list", "set":
+			schema = object{"type": "array", "items": schema}
+		}
+	} else {
+		switch strings.ToLower(f.Type) {
+		case "boolean":
+			schema = object{"type": "boolean"}
+		case "integer", "int", "short":
+			schema = object{"type": "integer", "format": "int32"}
+		case "long":
+			schema = object{"type": "integer", "format": "int64"}
+		case "float", "double", "bigdecimal":
+			schema = object{"type": "number", "format": "double"}
+		case "date":
+			schema = object{"type": "string", "format": "date-time"}
+		case "uuid":
+			schema = object{"type": "string", "format": "uuid"}
+		case "map":
+			schema = object{"type": "object", "additionalProperties": object{}}
+		case "list", "set":
+			schema = object{"type": "array", "items": object{}}
+		case "responseobject", "object":
+			schema = object{"type": "object"}
+		default:
+			schema = object{"type": "string"}
+		}
+	}
+
+	if f.Description != "" {
+		schema["description"] = f.Description
+	}
+	return schema
+}
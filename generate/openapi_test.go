@@ -0,0 +1,368 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// testServices returns a small set of services, covering sync, async, list and POST commands
+func testServices() *allServices {
+	return &allServices{
+		versions: &versionInfo{versions: []string{"4.18.0.0", "4.19.0.0"}},
+		services: services{
+			{name: "ZoneService", apis: []*API{{
+				Name:        "listZones",
+				Description: "Lists zones",
+				Params: APIParams{
+					{Name: "id", Type: "uuid", Description: "the ID of the zone"},
+					{Name: "page", Type: "integer"},
+					{Name: "tags", Type: "map"},
+				},
+				Response: APIResponses{
+					{Name: "id", Type: "string"},
+					{Name: "tags", Type: "set", Response: APIResponses{{Name: "key", Type: "string"}}},
+				},
+			}}},
+			{name: "VirtualMachineService", apis: []*API{{
+				Name:        "deployVirtualMachine",
+				Description: "Creates and automatically starts a virtual machine",
+				Isasync:     true,
+				Params: APIParams{
+					{Name: "zoneid", Type: "uuid", Required: true},
+					{Name: "nicnetworklist", Type: "map"},
+					{Name: "securitygroupids", Type: "list"},
+				},
+				Response: APIResponses{{Name: "id", Type: "string"}},
+			}, {
+				Name:     "destroyVirtualMachine",
+				Isasync:  true,
+				Params:   APIParams{{Name: "id", Type: "uuid", Required: true}},
+				Response: APIResponses{{Name: "displaytext", Type: "string"}, {Name: "success", Type: "boolean"}},
+			}}},
+		},
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	defer useOverrides(t, "overrides.yaml")()
+
+	b, err := json.Marshal(testServices().OpenAPI())
+	if err != nil {
+		t.Fatalf("failed to marshal the OpenAPI document: %v", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("failed to unmarshal the OpenAPI document: %v", err)
+	}
+
+	for _, err := range validateOpenAPI(doc) {
+		t.Errorf("invalid OpenAPI document: %v", err)
+	}
+
+	paths := doc["paths"].(map[string]interface{})
+	for path, command := range map[string]string{
+		"/listZones":             "listZones",
+		"/deployVirtualMachine":  "deployVirtualMachine",
+		"/destroyVirtualMachine": "destroyVirtualMachine",
+	} {
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			t.Errorf("expected path %s, got %v", path, paths)
+			continue
+		}
+		for _, op := range item {
+			if c := op.(map[string]interface{})["x-cloudstack-command"]; c != command {
+				t.Errorf("expected path %s to call %s, got %v", path, command, c)
+			}
+		}
+	}
+	if _, ok := paths["/deployVirtualMachine"].(map[string]interface{})["post"]; !ok {
+		t.Errorf("expected deployVirtualMachine to use POST")
+	}
+}
+
+func TestValidateOpenAPI(t *testing.T) {
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"openapi": "3.0.3",
+			"info":    map[string]interface{}{"title": "test", "version": "1"},
+			"tags":    []interface{}{map[string]interface{}{"name": "ZoneService"}},
+			"paths": map[string]interface{}{
+				"/listZones": map[string]interface{}{
+					"get": map[string]interface{}{
+						"operationId": "listZones",
+						"tags":        []interface{}{"ZoneService"},
+						"parameters": []interface{}{
+							map[string]interface{}{"name": "id", "in": "query", "schema": map[string]interface{}{"type": "string"}},
+						},
+						"responses": map[string]interface{}{
+							"200": map[string]interface{}{
+								"description": "successful operation",
+								"content": map[string]interface{}{"application/json": map[string]interface{}{
+									"schema": map[string]interface{}{"$ref": "#/components/schemas/Zone"},
+								}},
+							},
+						},
+					},
+				},
+			},
+			"components": map[string]interface{}{
+				"schemas": map[string]interface{}{"Zone": map[string]interface{}{"type": "object"}},
+			},
+		}
+	}
+	if errs := validateOpenAPI(valid()); len(errs) > 0 {
+		t.Fatalf("expected a valid document, got %v", errs)
+	}
+
+	op := func(doc map[string]interface{}) map[string]interface{} {
+		return doc["paths"].(map[string]interface{})["/listZones"].(map[string]interface{})["get"].(map[string]interface{})
+	}
+	for name, change := range map[string]func(doc map[string]interface{}){
+		"query in path": func(doc map[string]interface{}) {
+			paths := doc["paths"].(map[string]interface{})
+			paths["/?command=listZones"] = paths["/listZones"]
+			delete(paths, "/listZones")
+		},
+		"relative path": func(doc map[string]interface{}) {
+			paths := doc["paths"].(map[string]interface{})
+			paths["listZones"] = paths["/listZones"]
+			delete(paths, "/listZones")
+		},
+		"unknown method": func(doc map[string]interface{}) {
+			item := doc["paths"].(map[string]interface{})["/listZones"].(map[string]interface{})
+			item["fetch"] = item["get"]
+		},
+		"unknown field": func(doc map[string]interface{}) {
+			op(doc)["command"] = "listZones"
+		},
+		"duplicate operationId": func(doc map[string]interface{}) {
+			doc["paths"].(map[string]interface{})["/listZones2"] = doc["paths"].(map[string]interface{})["/listZones"]
+		},
+		"no responses": func(doc map[string]interface{}) {
+			delete(op(doc), "responses")
+		},
+		"invalid param location": func(doc map[string]interface{}) {
+			op(doc)["parameters"] = []interface{}{map[string]interface{}{"name": "id", "in": "body"}}
+		},
+		"unknown reference": func(doc map[string]interface{}) {
+			delete(doc["components"].(map[string]interface{})["schemas"].(map[string]interface{}), "Zone")
+		},
+		"undeclared tag": func(doc map[string]interface{}) {
+			op(doc)["tags"] = []interface{}{"HostService"}
+		},
+		"wrong version": func(doc map[string]interface{}) {
+			doc["openapi"] = "2.0"
+		},
+	} {
+		doc := valid()
+		change(doc)
+		if errs := validateOpenAPI(doc); len(errs) == 0 {
+			t.Errorf("%s: expected the document to be invalid", name)
+		}
+	}
+}
+
+var (
+	openAPIMethods = map[string]bool{
+		"get": true, "put": true, "post": true, "delete": true, "options": true, "head": true, "patch": true, "trace": true,
+	}
+	openAPIPathFields = map[string]bool{
+		"$ref": true, "summary": true, "description": true, "servers": true, "parameters": true,
+	}
+	openAPIOperationFields = map[string]bool{
+		"tags": true, "summary": true, "description": true, "externalDocs": true, "operationId": true,
+		"parameters": true, "requestBody": true, "responses": true, "callbacks": true, "deprecated": true,
+		"security": true, "servers": true,
+	}
+	openAPIParamLocations = map[string]bool{"query": true, "header": true, "path": true, "cookie": true}
+	openAPIResponseCode   = regexp.MustCompile(`^(default|[1-5](\d\d|XX))$`)
+	openAPIPathTemplate   = regexp.MustCompile(`\{([^{}/]+)\}`)
+)
+
+// validateOpenAPI validates a decoded OpenAPI 3.0 document against the rules of the
+// specification for the document, its paths, operations and params, and checks that
+// every reference can be resolved
+func validateOpenAPI(doc map[string]interface{}) []error {
+	var errs []error
+	errorf := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if v, _ := doc["openapi"].(string); !strings.HasPrefix(v, "3.0.") {
+		errorf("openapi: expected version 3.0.x, got %q", v)
+	}
+	info, _ := doc["info"].(map[string]interface{})
+	for _, f := range []string{"title", "version"} {
+		if v, _ := info[f].(string); v == "" {
+			errorf("info.%s: required", f)
+		}
+	}
+
+	tags := make(map[string]bool)
+	list, _ := doc["tags"].([]interface{})
+	for _, tag := range list {
+		name, _ := tag.(map[string]interface{})["name"].(string)
+		tags[name] = true
+	}
+
+	paths, ok := doc["paths"].(map[string]interface{})
+	if !ok {
+		errorf("paths: required")
+	}
+	operationIDs := make(map[string]string)
+	for path, v := range paths {
+		if !strings.HasPrefix(path, "/") || strings.ContainsAny(path, "?# ") {
+			errorf("paths.%s: a path must start with / and cannot contain a query or fragment", path)
+		}
+		item, _ := v.(map[string]interface{})
+		for method, v := range item {
+			if openAPIPathFields[method] || strings.HasPrefix(method, "x-") {
+				continue
+			}
+			if !openAPIMethods[method] {
+				errorf("paths.%s.%s: unknown field", path, method)
+				continue
+			}
+			at := fmt.Sprintf("paths.%s.%s", path, method)
+			op, _ := v.(map[string]interface{})
+
+			for f := range op {
+				if !openAPIOperationFields[f] && !strings.HasPrefix(f, "x-") {
+					errorf("%s.%s: unknown field", at, f)
+				}
+			}
+			if id, ok := op["operationId"].(string); ok {
+				if other, found := operationIDs[id]; found {
+					errorf("%s.operationId: %s is also used by %s", at, id, other)
+				}
+				operationIDs[id] = at
+			}
+			opTags, _ := op["tags"].([]interface{})
+			for _, tag := range opTags {
+				if name, _ := tag.(string); !tags[name] {
+					errorf("%s.tags: tag %v is not declared", at, tag)
+				}
+			}
+
+			params := make(map[string]bool)
+			opParams, _ := op["parameters"].([]interface{})
+			for i, p := range opParams {
+				param, _ := p.(map[string]interface{})
+				name, _ := param["name"].(string)
+				in, _ := param["in"].(string)
+				if name == "" || !openAPIParamLocations[in] {
+					errorf("%s.parameters[%d]: a name and a valid location are required", at, i)
+					continue
+				}
+				if params[in+"/"+name] {
+					errorf("%s.parameters[%d]: duplicate param %s", at, i, name)
+				}
+				params[in+"/"+name] = true
+				if in == "path" && param["required"] != true {
+					errorf("%s.parameters[%d]: path param %s must be required", at, i, name)
+				}
+				if _, ok := param["schema"]; !ok {
+					if _, ok := param["content"]; !ok {
+						errorf("%s.parameters[%d]: a schema or content is required", at, i)
+					}
+				}
+			}
+			for _, m := range openAPIPathTemplate.FindAllStringSubmatch(path, -1) {
+				if !params["path/"+m[1]] {
+					errorf("%s: path param %s is not declared", at, m[1])
+				}
+			}
+
+			responses, _ := op["responses"].(map[string]interface{})
+			if len(responses) == 0 {
+				errorf("%s.responses: at least one response is required", at)
+			}
+			for code, r := range responses {
+				if !openAPIResponseCode.MatchString(code) {
+					errorf("%s.responses.%s: invalid status code", at, code)
+				}
+				if d, _ := r.(map[string]interface{})["description"].(string); d == "" {
+					if _, ref := r.(map[string]interface{})["$ref"]; !ref {
+						errorf("%s.responses.%s.description: required", at, code)
+					}
+				}
+			}
+		}
+	}
+
+	var checkRefs func(at string, v interface{})
+	checkRefs = func(at string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, e := range v {
+				if ref, ok := e.(string); ok && k == "$ref" {
+					if !resolveRef(doc, ref) {
+						errorf("%s: unresolved reference %s", at, ref)
+					}
+					continue
+				}
+				checkRefs(at+"."+k, e)
+			}
+		case []interface{}:
+			for i, e := range v {
+				checkRefs(fmt.Sprintf("%s[%d]", at, i), e)
+			}
+		}
+	}
+	checkRefs("", doc)
+
+	return errs
+}
+
+// resolveRef returns true if the local reference points to a value in the document
+func resolveRef(doc map[string]interface{}, ref string) bool {
+	if !strings.HasPrefix(ref, "#/") {
+		return false
+	}
+	var v interface{} = doc
+	for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if v, ok = m[strings.NewReplacer("~1", "/", "~0", "~").Replace(key)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// useOverrides loads the overrides file for a test, and returns a func restoring the
+// overrides that were used before
+func useOverrides(t *testing.T, file string) func() {
+	o, err := loadOverrides(file)
+	if err != nil {
+		t.Fatalf("failed to load %s: %v", file, err)
+	}
+	previous := overrides
+	overrides = o
+	return func() { overrides = previous }
+}
@@ -0,0 +1,344 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// overrides holds the quirks of the API, loaded from the overrides file (see overrides.yaml)
+var overrides = &Overrides{}
+
+// Overrides contains the quirks of the API that cannot be derived from the listApis output
+type Overrides struct {
+	Types          map[string]*TypeOverride          `yaml:"types"`
+	APITypes       map[string]string                 `yaml:"apiTypes"`
+	MapParams      map[string]*MapParamOverride      `yaml:"mapParams"`
+	ResponseFields map[string]*ResponseFieldOverride `yaml:"responseFields"`
+	SuccessFields  []string                          `yaml:"successFields"`
+	SharedTypes    map[string]*SharedTypeOverride    `yaml:"sharedTypes"`
+	Commands       map[string]*CommandOverride       `yaml:"commands"`
+}
+
+// TypeOverride sets the Go type of params and response fields with a specific name
+type TypeOverride struct {
+	GoType  string `yaml:"goType"`
+	APIType string `yaml:"apiType"`
+}
+
+// MapParamOverride sets how the entries of a map param are encoded
+type MapParamOverride struct {
+	Indexed    bool   `yaml:"indexed"`
+	KeyField   string `yaml:"keyField"`
+	ValueField string `yaml:"valueField"`
+}
+
+// ResponseFieldOverride contains the quirks of response fields with a specific name
+type ResponseFieldOverride struct {
+	StringBool   bool     `yaml:"stringBool"`
+	NumberString bool     `yaml:"numberString"`
+	ListFields   []string `yaml:"listFields"`
+}
+
+// SharedTypeOverride makes the nested response fields with a specific name suffix use
+// the same Go type
+type SharedTypeOverride struct {
+	Name   string `yaml:"name"`
+	Global bool   `yaml:"global"`
+}
+
+// CommandOverride contains the quirks of a single API command
+type CommandOverride struct {
+	Post             bool     `yaml:"post"`
+	RequiredParams   []string `yaml:"requiredParams"`
+	MapListParams    []string `yaml:"mapListParams"`
+	DetailsKeyValue  bool     `yaml:"detailsKeyValue"`
+	DetailsZeroIndex bool     `yaml:"detailsZeroIndex"`
+	RawValueResponse bool     `yaml:"rawValueResponse"`
+	NestedResponse   string   `yaml:"nestedResponse"`
+	ListResponseKey  string   `yaml:"listResponseKey"`
+	ResponseType     string   `yaml:"responseType"`
+	IDHelperParams   []string `yaml:"idHelperParams"`
+	CountListedItems bool     `yaml:"countListedItems"`
+	OmitEmptyValues  bool     `yaml:"omitEmptyValues"`
+	UnscopedParams   []string `yaml:"unscopedParams"`
+
+	ListResponseField string                             `yaml:"listResponseField"`
+	ExtraListFields   map[string]*ExtraListFieldOverride `yaml:"extraListFields"`
+	SingleObject      *SingleObjectOverride              `yaml:"singleObject"`
+
+	MapParams map[string]*MapParamOverride `yaml:"mapParams"`
+}
+
+// ExtraListFieldOverride adds a field with another list of objects to a list response
+type ExtraListFieldOverride struct {
+	Key    string `yaml:"key"`
+	GoType string `yaml:"goType"`
+}
+
+// SingleObjectOverride makes a list command return a single object instead of a list
+type SingleObjectOverride struct {
+	Key     string `yaml:"key"`
+	Pointer bool   `yaml:"pointer"`
+}
+
+// overrideGoTypes are the Go types that can be used in type overrides
+var overrideGoTypes = map[string]bool{
+	"string":              true,
+	"UUID":                true,
+	"bool":                true,
+	"int":                 true,
+	"int64":               true,
+	"float64":             true,
+	"[]string":            true,
+	"map[string]string":   true,
+	"[]map[string]string": true,
+	"[]*Network":          true,
+	"[]*VirtualMachine":   true,
+}
+
+// loadOverrides reads and validates the overrides file, first against the schema in
+// overrides.schema.json and then against the API types known by the generator
+func loadOverrides(file string) (*Overrides, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return parseOverrides(file, b)
+}
+
+func parseOverrides(file string, b []byte) (*Overrides, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("Failed to parse %s: %v", file, err)
+	}
+	if err := validateSchema(overridesSchema, doc); err != nil {
+		return nil, fmt.Errorf("Invalid overrides in %s: %v", file, err)
+	}
+
+	o := &Overrides{}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(o); err != nil {
+		return nil, fmt.Errorf("Failed to parse %s: %v", file, err)
+	}
+	if err := o.validate(); err != nil {
+		return nil, fmt.Errorf("Invalid overrides in %s: %v", file, err)
+	}
+	return o, nil
+}
+
+func (o *Overrides) validate() error {
+	for name, t := range o.Types {
+		if t == nil {
+			return fmt.Errorf("type %s: no goType", name)
+		}
+		if !overrideGoTypes[t.GoType] {
+			return fmt.Errorf("type %s: unknown goType %q", name, t.GoType)
+		}
+	}
+	for apiType, goType := range o.APITypes {
+		if _, err := parser.ParseExpr(goType); err != nil {
+			return fmt.Errorf("API type %s: invalid Go type %q", apiType, goType)
+		}
+	}
+	for name, c := range o.Commands {
+		if c == nil {
+			return fmt.Errorf("command %s: no overrides", name)
+		}
+		for p := range c.MapParams {
+			if !token.IsIdentifier(p) {
+				return fmt.Errorf("command %s: invalid param name %q", name, p)
+			}
+		}
+		if c.ResponseType != "" && (!token.IsIdentifier(c.ResponseType) || !token.IsExported(c.ResponseType)) {
+			return fmt.Errorf("command %s: responseType %q is not an exported Go identifier", name, c.ResponseType)
+		}
+		if c.NestedResponse != "" && c.RawValueResponse {
+			return fmt.Errorf("command %s: nestedResponse and rawValueResponse cannot be combined", name)
+		}
+		if c.SingleObject != nil && (c.ListResponseKey != "" || c.ListResponseField != "" || len(c.ExtraListFields) > 0) {
+			return fmt.Errorf("command %s: singleObject cannot be combined with list response overrides", name)
+		}
+		if c.ListResponseField != "" && (!token.IsIdentifier(c.ListResponseField) || !token.IsExported(c.ListResponseField)) {
+			return fmt.Errorf("command %s: listResponseField %q is not an exported Go identifier", name, c.ListResponseField)
+		}
+		for f, e := range c.ExtraListFields {
+			if !token.IsIdentifier(f) || !token.IsExported(f) {
+				return fmt.Errorf("command %s: extra list field %q is not an exported Go identifier", name, f)
+			}
+			if !token.IsIdentifier(e.GoType) || !token.IsExported(e.GoType) {
+				return fmt.Errorf("command %s: goType %q of extra list field %s is not an exported Go identifier", name, e.GoType, f)
+			}
+		}
+		for _, p := range append(append(c.IDHelperParams, c.UnscopedParams...), append(c.RequiredParams, c.MapListParams...)...) {
+			if !token.IsIdentifier(p) {
+				return fmt.Errorf("command %s: invalid param name %q", name, p)
+			}
+		}
+	}
+	return nil
+}
+
+// checkAPIs logs the overrides for commands and params that are missing from the API info,
+// as they may be meant for another server version
+func (o *Overrides) checkAPIs(ai map[string]*API) {
+	var names []string
+	for name := range o.Commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		a, ok := ai[name]
+		if !ok {
+			log.Printf("Overrides for unknown API: %s", name)
+			continue
+		}
+		c := o.Commands[name]
+		for _, p := range append(append(c.RequiredParams, c.MapListParams...), c.UnscopedParams...) {
+			if !hasParam(a, p) {
+				log.Printf("Overrides for unknown param of %s: %s", name, p)
+			}
+		}
+	}
+}
+
+func hasParam(a *API, name string) bool {
+	for _, ap := range a.Params {
+		if ap.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// command returns the overrides of an API command, which are empty if it has none
+func (o *Overrides) command(name string) *CommandOverride {
+	if c, ok := o.Commands[name]; ok {
+		return c
+	}
+	return &CommandOverride{}
+}
+
+// goType returns the Go type set for a param or response field with the given name and
+// API type, if any
+func (o *Overrides) goType(name, apiType string) (string, bool) {
+	t, ok := o.Types[name]
+	if !ok || (t.APIType != "" && t.APIType != apiType) {
+		return "", false
+	}
+	return t.GoType, true
+}
+
+// apiType returns the Go type set for an API type, if any
+func (o *Overrides) apiType(apiType string) (string, bool) {
+	t, ok := o.APITypes[apiType]
+	return t, ok
+}
+
+// mapParam returns how the entries of a map param of a command are encoded, if set
+func (o *Overrides) mapParam(cmd, name string) (*MapParamOverride, bool) {
+	if m, ok := o.command(cmd).MapParams[name]; ok {
+		return m, true
+	}
+	m, ok := o.MapParams[name]
+	return m, ok
+}
+
+// responseField returns the quirks of response fields with the given name, which are
+// empty if there are none
+func (o *Overrides) responseField(name string) *ResponseFieldOverride {
+	if f, ok := o.ResponseFields[name]; ok {
+		return f
+	}
+	return &ResponseFieldOverride{}
+}
+
+// sharedType returns the name of the Go type shared by nested response fields with the
+// given name, and if it is shared by all responses
+func (o *Overrides) sharedType(name string) (string, bool, bool) {
+	var suffixes []string
+	for suffix := range o.SharedTypes {
+		suffixes = append(suffixes, suffix)
+	}
+	sort.Strings(suffixes)
+
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(name, suffix) {
+			t := o.SharedTypes[suffix]
+			return t.Name, t.Global, true
+		}
+	}
+	return "", false, false
+}
+
+// isSharedType returns true if the given name is the name of a shared Go type
+func (o *Overrides) isSharedType(name string) bool {
+	for _, t := range o.SharedTypes {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// listField returns the name of the field with the listed objects of a list response, where
+// ln is the name of the listed resource
+func (c *CommandOverride) listField(ln string) string {
+	switch {
+	case c.ListResponseField != "":
+		return c.ListResponseField
+	case c.ResponseType != "":
+		return capitalize(c.ListResponseKey)
+	}
+	return ln
+}
+
+// itemType returns the Go type of the objects in the ln field of a list response
+func (c *CommandOverride) itemType(ln string) string {
+	if f, ok := c.ExtraListFields[ln]; ok {
+		return f.GoType
+	}
+	return parseSingular(ln)
+}
+
+// isMapListParam returns true if the map param is a list of maps
+func (c *CommandOverride) isMapListParam(name string) bool {
+	return contains(c.MapListParams, name)
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadOverrides(t *testing.T) {
+	o, err := loadOverrides("overrides.yaml")
+	if err != nil {
+		t.Fatalf("failed to load overrides.yaml: %v", err)
+	}
+	if !o.command("deleteTags").OmitEmptyValues {
+		t.Errorf("expected deleteTags to omit empty values")
+	}
+	if !contains(o.command("createDomain").UnscopedParams, "domainid") {
+		t.Errorf("expected the domainid of createDomain to be unscoped")
+	}
+	if o.command("unknownCommand") == nil {
+		t.Errorf("expected empty overrides for an unknown command")
+	}
+}
+
+func TestParseOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name: "no overrides",
+			yaml: "{}",
+		},
+		{
+			name:    "empty",
+			yaml:    "",
+			wantErr: "must be of type object",
+		},
+		{
+			name: "valid",
+			yaml: `
+types:
+  size: {goType: int64}
+mapParams:
+  tags: {keyField: key, valueField: value}
+responseFields:
+  success: {stringBool: true}
+commands:
+  deployVirtualMachine:
+    post: true
+    mapParams:
+      details: {indexed: true}
+    unscopedParams: [domainid]
+  listCapabilities:
+    singleObject: {key: capability, pointer: true}
+  listLoadBalancerRuleInstances:
+    listResponseKey: lbrulevmidip
+    listResponseField: LBRuleVMIDIPs
+    extraListFields:
+      LoadBalancerRuleInstances: {key: loadbalancerruleinstance, goType: VirtualMachine}
+`,
+		},
+		{
+			name:    "invalid YAML",
+			yaml:    "types: [",
+			wantErr: "Failed to parse",
+		},
+		{
+			name:    "unknown section",
+			yaml:    "quirks: {}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "unknown command key",
+			yaml:    "commands: {listZones: {postt: true}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "wrong value type",
+			yaml:    `commands: {listZones: {post: "true"}}`,
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "empty command",
+			yaml:    "commands: {listZones: {}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "unknown Go type",
+			yaml:    "types: {size: {goType: uint8}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "incomplete map param",
+			yaml:    "mapParams: {tags: {keyField: key}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "mixed map param encodings",
+			yaml:    "mapParams: {tags: {indexed: true, keyField: key, valueField: value}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "unknown unscoped param",
+			yaml:    "commands: {createDomain: {unscopedParams: [zoneid]}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "duplicate names",
+			yaml:    "commands: {listZones: {requiredParams: [id, id]}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "response type is not exported",
+			yaml:    "commands: {listZones: {responseType: zone}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "nested raw value response",
+			yaml:    "commands: {listZones: {nestedResponse: zone, rawValueResponse: true}}",
+			wantErr: "nestedResponse and rawValueResponse cannot be combined",
+		},
+		{
+			name:    "single object list response",
+			yaml:    "commands: {listCapabilities: {singleObject: {key: capability}, listResponseKey: capability}}",
+			wantErr: "singleObject cannot be combined with list response overrides",
+		},
+		{
+			name:    "single object without key",
+			yaml:    "commands: {listCapabilities: {singleObject: {pointer: true}}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "extra list field is not exported",
+			yaml:    "commands: {listZones: {extraListFields: {vms: {key: virtualmachine, goType: VirtualMachine}}}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "extra list field without Go type",
+			yaml:    "commands: {listZones: {extraListFields: {VMs: {key: virtualmachine}}}}",
+			wantErr: "Invalid overrides",
+		},
+		{
+			name:    "invalid API type",
+			yaml:    "apiTypes: {zoneresponse: \"map[string\"}",
+			wantErr: "Invalid overrides",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseOverrides("test.yaml", []byte(tt.yaml))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %q", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected an error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMapEncoding(t *testing.T) {
+	o, err := parseOverrides("test.yaml", []byte(`
+mapParams:
+  details: {indexed: true}
+  tags: {keyField: key, valueField: value}
+  serviceproviderlist: {keyField: service, valueField: provider}
+commands:
+  createNetworkOffering:
+    detailsKeyValue: true
+  updateVirtualMachine:
+    detailsZeroIndex: true
+  addAnnotation:
+    detailsZeroIndex: true
+    detailsKeyValue: true
+  updateZone:
+    mapParams:
+      tags: {indexed: true}
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	previous := overrides
+	overrides = o
+	defer func() { overrides = previous }()
+
+	tests := []struct {
+		cmd, name          string
+		keyField, valField string
+		zeroIndex          bool
+	}{
+		{"deployVirtualMachine", "details", "", "", false},
+		{"createNetworkOffering", "details", "key", "value", false},
+		{"updateVirtualMachine", "details", "", "", true},
+		{"addAnnotation", "details", "key", "value", true},
+		{"createTags", "tags", "key", "value", false},
+		{"updateZone", "tags", "", "", false},
+		{"createNetworkOffering", "serviceproviderlist", "service", "provider", false},
+		{"createNetwork", "other", "key", "value", false},
+		{"updateVirtualMachine", "other", "", "", true},
+	}
+
+	for _, tt := range tests {
+		keyField, valField, zeroIndex := mapEncoding(tt.cmd, tt.name)
+		if keyField != tt.keyField || valField != tt.valField || zeroIndex != tt.zeroIndex {
+			t.Errorf("mapEncoding(%q, %q) = %q, %q, %v, expected %q, %q, %v", tt.cmd, tt.name,
+				keyField, valField, zeroIndex, tt.keyField, tt.valField, tt.zeroIndex)
+		}
+	}
+}
@@ -1,69 +0,0 @@
-//
-// Licensed to the Apache Software Foundation (ASF) under one
-// or more contributor license agreements.  See the NOTICE file
-// distributed with this work for additional information
-// regarding copyright ownership.  The ASF licenses this file
-// to you under the Apache License, Version 2.0 (the
-// "License"); you may not use this file except in compliance
-// with the License.  You may obtain a copy of the License at
-//
-//   http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing,
-// software distributed under the License is distributed on an
-// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
-// KIND, either express or implied.  See the License for the
-// specific language governing permissions and limitations
-// under the License.
-//
-
-package main
-
-// This map contains the API commands and the parameters which needs to be made
-// made required to ensure backward compatibility with the older versions of
-// the CloudStack API.
-
-var requiredParams = map[string][]string{
-	"createDiskOffering": {
-		"displaytext",
-	},
-	"createKubernetesCluster": {
-		"description",
-		"kubernetesversionid",
-		"serviceofferingid",
-		"size",
-	},
-	"createNetworkACLList": {
-		"vpcid",
-	},
-	"createNetworkOffering": {
-		"displaytext",
-	},
-	"createProject": {
-		"displaytext",
-	},
-	"createServiceOffering": {
-		"displaytext",
-	},
-	"createTemplate": {
-		"displaytext",
-	},
-	"createVPC": {
-		"displaytext",
-	},
-	"createVPCOffering": {
-		"displaytext",
-	},
-	"disassociateIpAddress": {
-		"id",
-	},
-	"getUploadParamsForTemplate": {
-		"displaytext",
-	},
-	"registerIso": {
-		"displaytext",
-	},
-	"registerTemplate": {
-		"displaytext",
-	},
-}
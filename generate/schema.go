@@ -0,0 +1,281 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//go:embed overrides.schema.json
+var overridesSchemaJSON []byte
+
+// overridesSchema is the JSON schema the overrides file is validated against
+var overridesSchema = mustParseSchema(overridesSchemaJSON)
+
+// schema is a JSON schema. Only the keywords needed to describe the overrides file
+// are supported, using any other keyword is an error.
+type schema struct {
+	Schema               string             `json:"$schema"`
+	ID                   string             `json:"$id"`
+	Title                string             `json:"title"`
+	Description          string             `json:"description"`
+	Ref                  string             `json:"$ref"`
+	Defs                 map[string]*schema `json:"$defs"`
+	Type                 string             `json:"type"`
+	Enum                 []interface{}      `json:"enum"`
+	Pattern              string             `json:"pattern"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties *schema            `json:"additionalProperties"`
+	PropertyNames        *schema            `json:"propertyNames"`
+	Required             []string           `json:"required"`
+	MinProperties        int                `json:"minProperties"`
+	Items                *schema            `json:"items"`
+	MinItems             int                `json:"minItems"`
+	UniqueItems          bool               `json:"uniqueItems"`
+	OneOf                []*schema          `json:"oneOf"`
+
+	// never is set for the false schema, which does not match any value
+	never bool
+	root  *schema
+	re    *regexp.Regexp
+}
+
+func (s *schema) UnmarshalJSON(b []byte) error {
+	var v bool
+	if err := json.Unmarshal(b, &v); err == nil {
+		s.never = !v
+		return nil
+	}
+
+	type alias schema
+	dec := json.NewDecoder(strings.NewReader(string(b)))
+	dec.DisallowUnknownFields()
+	return dec.Decode((*alias)(s))
+}
+
+func mustParseSchema(b []byte) *schema {
+	s := &schema{}
+	if err := json.Unmarshal(b, s); err != nil {
+		log.Fatalf("Failed to parse the overrides schema: %v", err)
+	}
+	if err := s.prepare(s); err != nil {
+		log.Fatalf("Invalid overrides schema: %v", err)
+	}
+	return s
+}
+
+// prepare compiles the patterns and checks the references of the schema
+func (s *schema) prepare(root *schema) error {
+	if s == nil {
+		return nil
+	}
+	s.root = root
+
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return err
+		}
+		s.re = re
+	}
+	if s.Ref != "" {
+		if _, ok := root.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")]; !ok {
+			return fmt.Errorf("unknown reference %s", s.Ref)
+		}
+	}
+
+	subs := []*schema{s.AdditionalProperties, s.PropertyNames, s.Items}
+	subs = append(subs, s.OneOf...)
+	for _, sub := range s.Defs {
+		subs = append(subs, sub)
+	}
+	for _, sub := range s.Properties {
+		subs = append(subs, sub)
+	}
+	for _, sub := range subs {
+		if err := sub.prepare(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSchema returns an error describing the first value in doc that does not match
+// the schema. The doc is a decoded YAML or JSON document.
+func validateSchema(s *schema, doc interface{}) error {
+	return s.validate("", doc)
+}
+
+func (s *schema) validate(path string, v interface{}) error {
+	at := path
+	if at == "" {
+		at = "the document"
+	}
+
+	if s.never {
+		return fmt.Errorf("%s is not allowed", at)
+	}
+	if s.Ref != "" {
+		if err := s.root.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")].validate(path, v); err != nil {
+			return err
+		}
+	}
+
+	if s.Type != "" && schemaType(v) != s.Type && !(s.Type == "number" && schemaType(v) == "integer") {
+		return fmt.Errorf("%s must be of type %s, got %s", at, s.Type, schemaType(v))
+	}
+	if len(s.Enum) > 0 {
+		found := false
+		for _, e := range s.Enum {
+			if sameType(e, v) && fmt.Sprint(e) == fmt.Sprint(v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s must be one of %v, got %#v", at, s.Enum, v)
+		}
+	}
+	if str, ok := v.(string); ok && s.re != nil && !s.re.MatchString(str) {
+		return fmt.Errorf("%s must match %s, got %q", at, s.Pattern, str)
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if err := s.validateObject(path, v); err != nil {
+			return err
+		}
+	case []interface{}:
+		if len(v) < s.MinItems {
+			return fmt.Errorf("%s must have at least %d items", at, s.MinItems)
+		}
+		seen := make(map[string]bool)
+		for i, item := range v {
+			if s.UniqueItems {
+				if seen[fmt.Sprint(item)] {
+					return fmt.Errorf("%s has duplicate item %v", at, item)
+				}
+				seen[fmt.Sprint(item)] = true
+			}
+			if s.Items != nil {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if len(s.OneOf) > 0 {
+		matches := 0
+		for _, sub := range s.OneOf {
+			if sub.validate(path, v) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("%s must match exactly one of the allowed forms, it matches %d", at, matches)
+		}
+	}
+	return nil
+}
+
+func (s *schema) validateObject(path string, v map[string]interface{}) error {
+	at := path
+	if at == "" {
+		at = "the document"
+	}
+
+	if len(v) < s.MinProperties {
+		return fmt.Errorf("%s must have at least %d keys", at, s.MinProperties)
+	}
+	for _, r := range s.Required {
+		if _, ok := v[r]; !ok {
+			return fmt.Errorf("%s is missing the required key %s", at, r)
+		}
+	}
+
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		if s.PropertyNames != nil {
+			if err := s.PropertyNames.validate(p, k); err != nil {
+				return err
+			}
+		}
+		if sub, ok := s.Properties[k]; ok {
+			if err := sub.validate(p, v[k]); err != nil {
+				return err
+			}
+			continue
+		}
+		if s.AdditionalProperties != nil {
+			if s.AdditionalProperties.never {
+				return fmt.Errorf("%s has unknown key %s", at, k)
+			}
+			if err := s.AdditionalProperties.validate(p, v[k]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// schemaType returns the JSON schema type of a decoded value
+func schemaType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case int, int64, uint64:
+		return "integer"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// sameType returns true if both values have the same JSON schema type, where an integer
+// is also a number
+func sameType(a, b interface{}) bool {
+	ta, tb := schemaType(a), schemaType(b)
+	return ta == tb || (ta == "number" && tb == "integer") || (ta == "integer" && tb == "number")
+}
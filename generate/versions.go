@@ -0,0 +1,302 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// apiTarget is the listApis output of a single server version
+type apiTarget struct {
+	version string
+	file    string
+}
+
+// versionInfo holds the server versions the API commands and params are available in.
+// Commands and params available in all versions are left out.
+type versionInfo struct {
+	versions []string
+	commands map[string][]string
+	params   map[string]map[string][]string
+}
+
+var versionFileRegex = regexp.MustCompile(`^listApis-(.+)\.json$`)
+
+// parseTargets parses a comma separated list of listApis files. Every file can be
+// prefixed with its server version, as in `4.18.0.0=listApis.json`. Otherwise the
+// version is taken from the file name, as in `listApis-4.18.0.0.json`.
+func parseTargets(list string) ([]*apiTarget, error) {
+	var targets []*apiTarget
+	for _, entry := range strings.Split(list, ",") {
+		t := &apiTarget{file: entry}
+		if i := strings.Index(entry, "="); i > 0 {
+			t.version, t.file = entry[:i], entry[i+1:]
+		} else if m := versionFileRegex.FindStringSubmatch(path.Base(entry)); m != nil {
+			t.version = m[1]
+		}
+		targets = append(targets, t)
+	}
+
+	if len(targets) > 1 {
+		for _, t := range targets {
+			if t.version == "" {
+				return nil, fmt.Errorf("Unable to determine the server version of %s, use version=file", t.file)
+			}
+		}
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		return compareVersions(targets[i].version, targets[j].version) < 0
+	})
+	return targets, nil
+}
+
+// addTarget adds a listApis file of a server version to a comma separated list of targets,
+// replacing the target of the same version if there is one
+func addTarget(list, version, file string) string {
+	var entries []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry == "" || strings.HasPrefix(entry, version+"=") {
+			continue
+		}
+		if m := versionFileRegex.FindStringSubmatch(path.Base(entry)); m != nil && m[1] == version && !strings.Contains(entry, "=") {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return strings.Join(append(entries, version+"="+file), ",")
+}
+
+// mergeAPIInfo merges the listApis output of all targets. Every command uses the definition
+// of the newest version that has it, extended with the params and response fields that are
+// only available in older versions. A param is only required if it is required in every
+// version that has the command.
+func mergeAPIInfo(targets []*apiTarget) (map[string]*API, *versionInfo, error) {
+	vi := &versionInfo{
+		commands: make(map[string][]string),
+		params:   make(map[string]map[string][]string),
+	}
+
+	var infos []map[string]*API
+	for _, t := range targets {
+		ai, err := getAPIInfo(t.file)
+		if err != nil {
+			return nil, nil, err
+		}
+		infos = append(infos, ai)
+		if t.version != "" {
+			vi.versions = append(vi.versions, t.version)
+		}
+	}
+
+	merged := make(map[string]*API)
+	for i := len(infos) - 1; i >= 0; i-- {
+		for name, api := range infos[i] {
+			m, found := merged[name]
+			if !found {
+				c := *api
+				c.Params = append(APIParams{}, api.Params...)
+				c.Response = append(APIResponses{}, api.Response...)
+				merged[name] = &c
+				continue
+			}
+			for _, ap := range api.Params {
+				if findParam(m.Params, ap.Name) == nil {
+					m.Params = append(m.Params, ap)
+				}
+			}
+			for _, ar := range api.Response {
+				if findResponse(m.Response, ar.Name) == nil {
+					m.Response = append(m.Response, ar)
+				}
+			}
+		}
+	}
+
+	if len(infos) < 2 {
+		return merged, vi, nil
+	}
+
+	for name, m := range merged {
+		var versions []string
+		for i, ai := range infos {
+			if _, found := ai[name]; found {
+				versions = append(versions, targets[i].version)
+			}
+		}
+		if len(versions) < len(infos) {
+			vi.commands[name] = versions
+		}
+
+		for i, ap := range m.Params {
+			var paramVersions []string
+			required := true
+			for j, ai := range infos {
+				api, found := ai[name]
+				if !found {
+					continue
+				}
+				p := findParam(api.Params, ap.Name)
+				if p == nil {
+					required = false
+					continue
+				}
+				paramVersions = append(paramVersions, targets[j].version)
+				required = required && p.Required
+			}
+
+			if ap.Required != required {
+				c := *ap
+				c.Required = required
+				m.Params[i] = &c
+			}
+			if len(paramVersions) < len(versions) {
+				if vi.params[name] == nil {
+					vi.params[name] = make(map[string][]string)
+				}
+				vi.params[name][ap.Name] = paramVersions
+			}
+		}
+	}
+
+	return merged, vi, nil
+}
+
+func findParam(params APIParams, name string) *APIParam {
+	for _, ap := range params {
+		if ap.Name == name {
+			return ap
+		}
+	}
+	return nil
+}
+
+func findResponse(fields APIResponses, name string) *APIResponse {
+	for _, ar := range fields {
+		if ar.Name == name {
+			return ar
+		}
+	}
+	return nil
+}
+
+// compareVersions compares two dotted version numbers, like 4.18.0.0, numerically.
+// Any non-numeric suffix, like the `-mold` in 4.18.0.0-mold, is ignored.
+func compareVersions(a, b string) int {
+	as, bs := versionParts(a), versionParts(b)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(v string) []int {
+	var parts []int
+	for _, s := range strings.Split(v, ".") {
+		digits := s
+		if i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+			digits = s[:i]
+		}
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+// generateCode generates the version tables used by the client to gate API calls
+func (vi *versionInfo) generateCode(pn func(format string, args ...interface{})) {
+	pn("// SupportedServerVersions contains the server versions the client was generated for, in")
+	pn("// increasing order")
+	pn("var SupportedServerVersions = []string{")
+	for _, v := range vi.versions {
+		pn("	%q,", v)
+	}
+	pn("}")
+	pn("")
+	pn("// commandVersions contains the server versions the API commands are available in, for the")
+	pn("// commands that are not available in all supported versions")
+	pn("var commandVersions = map[string][]string{")
+	for _, name := range sortedMapKeys(vi.commands) {
+		pn("	%q: %s,", strings.ToLower(name), stringSliceLiteral(vi.commands[name]))
+	}
+	pn("}")
+	pn("")
+	pn("// paramVersions contains the server versions the params are available in, for the params")
+	pn("// that are not available in all versions the API command is available in")
+	pn("var paramVersions = map[string]map[string][]string{")
+	for _, name := range sortedMapKeys(vi.params) {
+		pn("	%q: {", strings.ToLower(name))
+		for _, param := range sortedMapKeys(vi.params[name]) {
+			pn("		%q: %s,", strings.ToLower(param), stringSliceLiteral(vi.params[name][param]))
+		}
+		pn("	},")
+	}
+	pn("}")
+	pn("")
+}
+
+func stringSliceLiteral(s []string) string {
+	quoted := make([]string, len(s))
+	for i, v := range s {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "{" + strings.Join(quoted, ", ") + "}"
+}
+
+// sortedMapKeys returns the sorted keys of a map with string keys
+func sortedMapKeys(m interface{}) []string {
+	var keys []string
+	switch t := m.(type) {
+	case map[string][]string:
+		for k := range t {
+			keys = append(keys, k)
+		}
+	case map[string]map[string][]string:
+		for k := range t {
+			keys = append(keys, k)
+		}
+	case map[string]*ExtraListFieldOverride:
+		for k := range t {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
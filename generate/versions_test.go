@@ -0,0 +1,293 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestParseTargets(t *testing.T) {
+	tests := []struct {
+		list    string
+		want    []apiTarget
+		wantErr bool
+	}{
+		{
+			list: "listApis.json",
+			want: []apiTarget{{file: "listApis.json"}},
+		},
+		{
+			list: "generate/listApis-4.18.0.0.json",
+			want: []apiTarget{{version: "4.18.0.0", file: "generate/listApis-4.18.0.0.json"}},
+		},
+		{
+			list: "4.19.0.0=b.json,4.18.0.0=a.json",
+			want: []apiTarget{{version: "4.18.0.0", file: "a.json"}, {version: "4.19.0.0", file: "b.json"}},
+		},
+		{
+			list: "4.9.0.0=a.json,listApis-4.18.0.0.json",
+			want: []apiTarget{{version: "4.9.0.0", file: "a.json"}, {version: "4.18.0.0", file: "listApis-4.18.0.0.json"}},
+		},
+		{
+			list:    "4.18.0.0=a.json,b.json",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		targets, err := parseTargets(tt.list)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseTargets(%q): unexpected error: %v", tt.list, err)
+			continue
+		}
+		var got []apiTarget
+		for _, target := range targets {
+			got = append(got, *target)
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTargets(%q) = %+v, expected %+v", tt.list, got, tt.want)
+		}
+	}
+}
+
+func TestAddTarget(t *testing.T) {
+	tests := []struct {
+		list, version, file string
+		want                string
+	}{
+		{
+			"4.18.0.0=a.json,4.19.0.0=b.json", "4.20.0.0", "c.json",
+			"4.18.0.0=a.json,4.19.0.0=b.json,4.20.0.0=c.json",
+		},
+		{
+			"4.18.0.0=a.json,4.19.0.0=b.json", "4.19.0.0", "c.json",
+			"4.18.0.0=a.json,4.19.0.0=c.json",
+		},
+		{
+			"listApis-4.18.0.0.json,listApis-4.19.0.0.json", "4.18.0.0", "c.json",
+			"listApis-4.19.0.0.json,4.18.0.0=c.json",
+		},
+		{
+			"", "4.19.0.0", "c.json",
+			"4.19.0.0=c.json",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := addTarget(tt.list, tt.version, tt.file); got != tt.want {
+			t.Errorf("addTarget(%q, %q, %q) = %q, expected %q", tt.list, tt.version, tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"4.18.0.0", "4.18.0.0", 0},
+		{"4.18.0.0", "4.19.0.0", -1},
+		{"4.19.0.0", "4.18.1.0", 1},
+		{"4.9.0.0", "4.18.0.0", -1},
+		{"4.18", "4.18.0.0", 0},
+		{"4.18.0.1", "4.18", 1},
+		{"4.18.0.0-mold", "4.18.0.0", 0},
+		{"", "4.18.0.0", -1},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMergeAPIInfo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listapis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeAPIs := func(version string, apis ...*API) *apiTarget {
+		b, err := json.Marshal(map[string]interface{}{"count": len(apis), "api": apis})
+		if err != nil {
+			t.Fatal(err)
+		}
+		file := filepath.Join(dir, "listApis-"+version+".json")
+		if err := ioutil.WriteFile(file, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return &apiTarget{version: version, file: file}
+	}
+
+	targets := []*apiTarget{
+		writeAPIs("4.18.0.0",
+			&API{Name: "listZones", Params: APIParams{
+				{Name: "id", Type: "uuid"},
+				{Name: "name", Type: "string", Required: true},
+				{Name: "legacy", Type: "boolean"},
+			}, Response: APIResponses{{Name: "id", Type: "string"}, {Name: "legacy", Type: "boolean"}}},
+			&API{Name: "removedCommand"},
+		),
+		writeAPIs("4.19.0.0",
+			&API{Name: "listZones", Description: "Lists zones", Params: APIParams{
+				{Name: "id", Type: "uuid", Required: true},
+				{Name: "name", Type: "string", Required: true},
+				{Name: "isvnf", Type: "boolean"},
+			}, Response: APIResponses{{Name: "id", Type: "string"}}},
+			&API{Name: "createBucket", Params: APIParams{{Name: "name", Type: "string", Required: true}}},
+		),
+	}
+
+	merged, vi, err := mergeAPIInfo(targets)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(merged) != 3 {
+		t.Errorf("expected 3 merged commands, got %d", len(merged))
+	}
+
+	zones := merged["listZones"]
+	if zones.Description != "Lists zones" {
+		t.Errorf("expected the definition of the newest version, got description %q", zones.Description)
+	}
+	required := make(map[string]bool)
+	for _, p := range zones.Params {
+		required[p.Name] = p.Required
+	}
+	wantRequired := map[string]bool{"id": false, "name": true, "isvnf": false, "legacy": false}
+	if !reflect.DeepEqual(required, wantRequired) {
+		t.Errorf("expected the params %v, got %v", wantRequired, required)
+	}
+	if findResponse(zones.Response, "legacy") == nil {
+		t.Errorf("expected the response fields of older versions to be merged")
+	}
+
+	// The merge must not modify the API info of the targets
+	if p := findParam(merged["createBucket"].Params, "name"); p == nil || !p.Required {
+		t.Errorf("expected createBucket to keep its required name param, got %+v", p)
+	}
+
+	if want := []string{"4.18.0.0", "4.19.0.0"}; !reflect.DeepEqual(vi.versions, want) {
+		t.Errorf("expected the versions %v, got %v", want, vi.versions)
+	}
+	wantCommands := map[string][]string{
+		"createBucket":   {"4.19.0.0"},
+		"removedCommand": {"4.18.0.0"},
+	}
+	if !reflect.DeepEqual(vi.commands, wantCommands) {
+		t.Errorf("expected the command versions %v, got %v", wantCommands, vi.commands)
+	}
+	wantParams := map[string]map[string][]string{
+		"listZones": {"isvnf": {"4.19.0.0"}, "legacy": {"4.18.0.0"}},
+	}
+	if !reflect.DeepEqual(vi.params, wantParams) {
+		t.Errorf("expected the param versions %v, got %v", wantParams, vi.params)
+	}
+}
+
+func TestMergeAPIInfoSingleTarget(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listapis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "listApis.json")
+	b := `{"count":1,"api":[{"name":"listZones","params":[{"name":"id","type":"uuid","required":true}]}]}`
+	if err := ioutil.WriteFile(file, []byte(b), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, vi, err := mergeAPIInfo([]*apiTarget{{file: file}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p := findParam(merged["listZones"].Params, "id"); p == nil || !p.Required {
+		t.Errorf("expected the required id param, got %+v", p)
+	}
+	if len(vi.versions) != 0 || len(vi.commands) != 0 || len(vi.params) != 0 {
+		t.Errorf("expected no version tables, got %+v", vi)
+	}
+
+	if _, _, err := mergeAPIInfo([]*apiTarget{{file: filepath.Join(dir, "missing.json")}}); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}
+
+// makefileAPIInfo returns the merged API info of the API targets in the Makefile, which
+// the committed code is generated from
+func makefileAPIInfo(t *testing.T) (map[string]*API, *versionInfo) {
+	makefile, err := ioutil.ReadFile("../Makefile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := regexp.MustCompile(`(?m)^API=(.+)$`).FindSubmatch(makefile)
+	if m == nil {
+		t.Fatal("no API targets found in the Makefile")
+	}
+
+	targets, err := parseTargets(strings.ReplaceAll(string(m[1]), "generate/", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ai, vi, err := mergeAPIInfo(targets)
+	if err != nil {
+		t.Fatalf("failed to merge the API info of %s: %v", m[1], err)
+	}
+	return ai, vi
+}
+
+// TestGeneratedVersionTables checks that the version tables in cloudstack.go are generated
+// from the listApis files of the API targets in the Makefile
+func TestGeneratedVersionTables(t *testing.T) {
+	_, vi := makefileAPIInfo(t)
+
+	var buf bytes.Buffer
+	buf.WriteString("package cloudstack\n\n")
+	vi.generateCode(func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	})
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("failed to format the version tables: %v", err)
+	}
+	tables := strings.TrimPrefix(string(code), "package cloudstack\n\n")
+
+	generated, err := ioutil.ReadFile("../cloudstack/cloudstack.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(generated), tables) {
+		t.Errorf("the version tables in cloudstack.go do not match the API targets in the Makefile, run make code:\n%s", tables)
+	}
+}
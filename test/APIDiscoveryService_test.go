@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -48,3 +50,36 @@ func TestAPIDiscoveryService(t *testing.T) {
 	t.Run("ListApis", testlistApis)
 
 }
+
+func TestAPIDiscoveryServiceFixtures(t *testing.T) {
+	response, err := readData("generated/APIDiscoveryService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ListApis", func(t *testing.T) {
+		defer server.checkCommands(t, "listApis")
+
+		p := client.APIDiscovery.NewListApisParams()
+		p.SetName("name")
+
+		expected := url.Values{
+			"name": {"name"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.APIDiscovery.ListApis(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Apis) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Apis))
+		}
+	})
+
+}
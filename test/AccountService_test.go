@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -174,3 +176,319 @@ func TestAccountService(t *testing.T) {
 	t.Run("UpdateAccount", testupdateAccount)
 
 }
+
+func TestAccountServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AccountService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "createAccount")
+
+		p := client.Account.NewCreateAccountParams("email", "firstname", "lastname", "password", "username")
+		p.SetAccount("account")
+		p.SetAccountdetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetAccountid("accountid")
+		p.SetAccounttype(1)
+		p.SetDomainid("domainid")
+		p.SetNetworkdomain("networkdomain")
+		p.SetRoleid("roleid")
+		p.SetTimezone("timezone")
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"account":                {"account"},
+			"accountdetails[0].key1": {"value1"},
+			"accountdetails[0].key2": {"value2"},
+			"accountid":              {"accountid"},
+			"accounttype":            {"1"},
+			"domainid":               {"domainid"},
+			"email":                  {"email"},
+			"firstname":              {"firstname"},
+			"lastname":               {"lastname"},
+			"networkdomain":          {"networkdomain"},
+			"password":               {"password"},
+			"roleid":                 {"roleid"},
+			"timezone":               {"timezone"},
+			"userid":                 {"userid"},
+			"username":               {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.CreateAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b44ae4bb-2272-416e-83a0-586b7b35d918" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteAccount", "queryAsyncJobResult")
+
+		p := client.Account.NewDeleteAccountParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.DeleteAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "disableAccount", "queryAsyncJobResult")
+
+		p := client.Account.NewDisableAccountParams(true)
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"id":       {"id"},
+			"lock":     {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.DisableAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "bb0a4f47-8e6b-fa36-fc7f-a4d0e58296d5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("EnableAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "enableAccount")
+
+		p := client.Account.NewEnableAccountParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"id":       {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.EnableAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "ff0573dd-407b-a069-ccb8-5a2f603cfc93" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("GetSolidFireAccountId", func(t *testing.T) {
+		defer server.checkCommands(t, "getSolidFireAccountId")
+
+		p := client.Account.NewGetSolidFireAccountIdParams("accountid", "storageid")
+
+		expected := url.Values{
+			"accountid": {"accountid"},
+			"storageid": {"storageid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.GetSolidFireAccountId(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListAccounts", func(t *testing.T) {
+		defer server.checkCommands(t, "listAccounts")
+
+		p := client.Account.NewListAccountsParams()
+		p.SetAccounttype(1)
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIscleanuprequired(true)
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowicon(true)
+		p.SetState("state")
+
+		expected := url.Values{
+			"accounttype":       {"1"},
+			"details":           {"details1,details2"},
+			"domainid":          {"domainid"},
+			"id":                {"id"},
+			"iscleanuprequired": {"true"},
+			"isrecursive":       {"true"},
+			"keyword":           {"keyword"},
+			"listall":           {"true"},
+			"name":              {"name"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"showicon":          {"true"},
+			"state":             {"state"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.ListAccounts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Accounts) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Accounts))
+		}
+		if r.Accounts[0].Id != "8a94f120-59e6-616f-6a16-2ab9a1e5bd8e" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Accounts[0].Id)
+		}
+	})
+
+	t.Run("ListProjectAccounts", func(t *testing.T) {
+		defer server.checkCommands(t, "listProjectAccounts")
+
+		p := client.Account.NewListProjectAccountsParams("projectid")
+		p.SetAccount("account")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectroleid("projectroleid")
+		p.SetRole("role")
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"keyword":       {"keyword"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"projectroleid": {"projectroleid"},
+			"role":          {"role"},
+			"userid":        {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.ListProjectAccounts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ProjectAccounts) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ProjectAccounts))
+		}
+		if r.ProjectAccounts[0].Id != "82554cc6-eb9d-e6fb-ad41-c46adc53fb7e" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.ProjectAccounts[0].Id)
+		}
+	})
+
+	t.Run("LockAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "lockAccount")
+
+		p := client.Account.NewLockAccountParams("account", "domainid")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.LockAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "6217f168-8cd6-1edc-2564-23df17642a8c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("MarkDefaultZoneForAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "markDefaultZoneForAccount", "queryAsyncJobResult")
+
+		p := client.Account.NewMarkDefaultZoneForAccountParams("account", "domainid", "zoneid")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.MarkDefaultZoneForAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f73922f4-d939-71ad-d159-a14c512ff418" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "updateAccount")
+
+		p := client.Account.NewUpdateAccountParams()
+		p.SetAccount("account")
+		p.SetAccountdetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetNetworkdomain("networkdomain")
+		p.SetNewname("newname")
+		p.SetRoleid("roleid")
+
+		expected := url.Values{
+			"account":                {"account"},
+			"accountdetails[0].key1": {"value1"},
+			"accountdetails[0].key2": {"value2"},
+			"domainid":               {"domainid"},
+			"id":                     {"id"},
+			"networkdomain":          {"networkdomain"},
+			"newname":                {"newname"},
+			"roleid":                 {"roleid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Account.UpdateAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "51768e3f-8459-b95a-6025-7d5da54ae571" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
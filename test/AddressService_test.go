@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -102,3 +104,196 @@ func TestAddressService(t *testing.T) {
 	t.Run("ReleaseIpAddress", testreleaseIpAddress)
 
 }
+
+func TestAddressServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AddressService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AssociateIpAddress", func(t *testing.T) {
+		defer server.checkCommands(t, "associateIpAddress", "queryAsyncJobResult")
+
+		p := client.Address.NewAssociateIpAddressParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetIpaddress("ipaddress")
+		p.SetIsportable(true)
+		p.SetNetworkid("networkid")
+		p.SetProjectid("projectid")
+		p.SetRegionid(1)
+		p.SetVpcid("vpcid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":    {"account"},
+			"domainid":   {"domainid"},
+			"fordisplay": {"true"},
+			"ipaddress":  {"ipaddress"},
+			"isportable": {"true"},
+			"networkid":  {"networkid"},
+			"projectid":  {"projectid"},
+			"regionid":   {"1"},
+			"vpcid":      {"vpcid"},
+			"zoneid":     {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Address.AssociateIpAddress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0b3da2b8-ca85-2e02-b0dc-b4d20c6b9f52" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DisassociateIpAddress", func(t *testing.T) {
+		defer server.checkCommands(t, "disassociateIpAddress", "queryAsyncJobResult")
+
+		p := client.Address.NewDisassociateIpAddressParams("id")
+		p.SetIpaddress("ipaddress")
+
+		expected := url.Values{
+			"id":        {"id"},
+			"ipaddress": {"ipaddress"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Address.DisassociateIpAddress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListPublicIpAddresses", func(t *testing.T) {
+		defer server.checkCommands(t, "listPublicIpAddresses")
+
+		p := client.Address.NewListPublicIpAddressesParams()
+		p.SetAccount("account")
+		p.SetAllocatedonly(true)
+		p.SetAssociatednetworkid("associatednetworkid")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetForloadbalancing(true)
+		p.SetForvirtualnetwork(true)
+		p.SetId("id")
+		p.SetIpaddress("ipaddress")
+		p.SetIsrecursive(true)
+		p.SetIssourcenat(true)
+		p.SetIsstaticnat(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetProjectid("projectid")
+		p.SetRetrieveonlyresourcecount(true)
+		p.SetState("state")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetVlanid("vlanid")
+		p.SetVpcid("vpcid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                   {"account"},
+			"allocatedonly":             {"true"},
+			"associatednetworkid":       {"associatednetworkid"},
+			"domainid":                  {"domainid"},
+			"fordisplay":                {"true"},
+			"forloadbalancing":          {"true"},
+			"forvirtualnetwork":         {"true"},
+			"id":                        {"id"},
+			"ipaddress":                 {"ipaddress"},
+			"isrecursive":               {"true"},
+			"issourcenat":               {"true"},
+			"isstaticnat":               {"true"},
+			"keyword":                   {"keyword"},
+			"listall":                   {"true"},
+			"networkid":                 {"networkid"},
+			"page":                      {"1"},
+			"pagesize":                  {"1"},
+			"physicalnetworkid":         {"physicalnetworkid"},
+			"projectid":                 {"projectid"},
+			"retrieveonlyresourcecount": {"true"},
+			"state":                     {"state"},
+			"tags[0].key":               {"key1"},
+			"tags[0].value":             {"value1"},
+			"tags[1].key":               {"key2"},
+			"tags[1].value":             {"value2"},
+			"vlanid":                    {"vlanid"},
+			"vpcid":                     {"vpcid"},
+			"zoneid":                    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Address.ListPublicIpAddresses(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.PublicIpAddresses) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.PublicIpAddresses))
+		}
+	})
+
+	t.Run("UpdateIpAddress", func(t *testing.T) {
+		defer server.checkCommands(t, "updateIpAddress", "queryAsyncJobResult")
+
+		p := client.Address.NewUpdateIpAddressParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Address.UpdateIpAddress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "656308cd-37d1-882a-18db-4cd1796c2624" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ReleaseIpAddress", func(t *testing.T) {
+		defer server.checkCommands(t, "releaseIpAddress")
+
+		p := client.Address.NewReleaseIpAddressParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Address.ReleaseIpAddress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -102,3 +104,172 @@ func TestAffinityGroupService(t *testing.T) {
 	t.Run("UpdateVMAffinityGroup", testupdateVMAffinityGroup)
 
 }
+
+func TestAffinityGroupServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AffinityGroupService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateAffinityGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "createAffinityGroup", "queryAsyncJobResult")
+
+		p := client.AffinityGroup.NewCreateAffinityGroupParams("name", "type")
+		p.SetAccount("account")
+		p.SetDescription("description")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"description": {"description"},
+			"domainid":    {"domainid"},
+			"name":        {"name"},
+			"projectid":   {"projectid"},
+			"type":        {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AffinityGroup.CreateAffinityGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "83a8c057-ae5a-c84b-076c-56b32db78f6a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteAffinityGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteAffinityGroup", "queryAsyncJobResult")
+
+		p := client.AffinityGroup.NewDeleteAffinityGroupParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetName("name")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"id":        {"id"},
+			"name":      {"name"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AffinityGroup.DeleteAffinityGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListAffinityGroupTypes", func(t *testing.T) {
+		defer server.checkCommands(t, "listAffinityGroupTypes")
+
+		p := client.AffinityGroup.NewListAffinityGroupTypesParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AffinityGroup.ListAffinityGroupTypes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.AffinityGroupTypes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.AffinityGroupTypes))
+		}
+	})
+
+	t.Run("ListAffinityGroups", func(t *testing.T) {
+		defer server.checkCommands(t, "listAffinityGroups")
+
+		p := client.AffinityGroup.NewListAffinityGroupsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetType("type")
+		p.SetVirtualmachineid("virtualmachineid")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"domainid":         {"domainid"},
+			"id":               {"id"},
+			"isrecursive":      {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"name":             {"name"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"projectid":        {"projectid"},
+			"type":             {"type"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AffinityGroup.ListAffinityGroups(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.AffinityGroups) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.AffinityGroups))
+		}
+		if r.AffinityGroups[0].Id != "4cbfcd8b-bf49-3e45-0462-97e23a8eb012" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.AffinityGroups[0].Id)
+		}
+	})
+
+	t.Run("UpdateVMAffinityGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVMAffinityGroup", "queryAsyncJobResult")
+
+		p := client.AffinityGroup.NewUpdateVMAffinityGroupParams("id")
+		p.SetAffinitygroupids([]string{"affinitygroupids1", "affinitygroupids2"})
+		p.SetAffinitygroupnames([]string{"affinitygroupnames1", "affinitygroupnames2"})
+
+		expected := url.Values{
+			"affinitygroupids":   {"affinitygroupids1,affinitygroupids2"},
+			"affinitygroupnames": {"affinitygroupnames1,affinitygroupnames2"},
+			"id":                 {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AffinityGroup.UpdateVMAffinityGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0abbe013-c89f-3131-b603-c0d9d4198952" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -84,3 +86,132 @@ func TestAlertService(t *testing.T) {
 	t.Run("ListAlerts", testlistAlerts)
 
 }
+
+func TestAlertServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AlertService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ArchiveAlerts", func(t *testing.T) {
+		defer server.checkCommands(t, "archiveAlerts")
+
+		p := client.Alert.NewArchiveAlertsParams()
+		p.SetEnddate("enddate")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetStartdate("startdate")
+		p.SetType("type")
+
+		expected := url.Values{
+			"enddate":   {"enddate"},
+			"ids":       {"ids1,ids2"},
+			"startdate": {"startdate"},
+			"type":      {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Alert.ArchiveAlerts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteAlerts", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteAlerts")
+
+		p := client.Alert.NewDeleteAlertsParams()
+		p.SetEnddate("enddate")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetStartdate("startdate")
+		p.SetType("type")
+
+		expected := url.Values{
+			"enddate":   {"enddate"},
+			"ids":       {"ids1,ids2"},
+			"startdate": {"startdate"},
+			"type":      {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Alert.DeleteAlerts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("GenerateAlert", func(t *testing.T) {
+		defer server.checkCommands(t, "generateAlert", "queryAsyncJobResult")
+
+		p := client.Alert.NewGenerateAlertParams("description", "name", 1)
+		p.SetPodid("podid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"description": {"description"},
+			"name":        {"name"},
+			"podid":       {"podid"},
+			"type":        {"1"},
+			"zoneid":      {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Alert.GenerateAlert(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListAlerts", func(t *testing.T) {
+		defer server.checkCommands(t, "listAlerts")
+
+		p := client.Alert.NewListAlertsParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetType("type")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"type":     {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Alert.ListAlerts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Alerts) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Alerts))
+		}
+		if r.Alerts[0].Id != "e0c41471-d9e5-5738-2f30-c383c4a20889" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Alerts[0].Id)
+		}
+	})
+
+}
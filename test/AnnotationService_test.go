@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -93,3 +95,121 @@ func TestAnnotationService(t *testing.T) {
 	t.Run("UpdateAnnotationVisibility", testupdateAnnotationVisibility)
 
 }
+
+func TestAnnotationServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AnnotationService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddAnnotation", func(t *testing.T) {
+		defer server.checkCommands(t, "addAnnotation")
+
+		p := client.Annotation.NewAddAnnotationParams()
+		p.SetAdminsonly(true)
+		p.SetAnnotation("annotation")
+		p.SetEntityid("entityid")
+		p.SetEntitytype("entitytype")
+
+		expected := url.Values{
+			"adminsonly": {"true"},
+			"annotation": {"annotation"},
+			"entityid":   {"entityid"},
+			"entitytype": {"entitytype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Annotation.AddAnnotation(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9ee80ef0-c7b8-43c7-941a-7c46b34204ee" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListAnnotations", func(t *testing.T) {
+		defer server.checkCommands(t, "listAnnotations")
+
+		p := client.Annotation.NewListAnnotationsParams()
+		p.SetAnnotationfilter("annotationfilter")
+		p.SetEntityid("entityid")
+		p.SetEntitytype("entitytype")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"annotationfilter": {"annotationfilter"},
+			"entityid":         {"entityid"},
+			"entitytype":       {"entitytype"},
+			"id":               {"id"},
+			"keyword":          {"keyword"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"userid":           {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Annotation.ListAnnotations(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Annotations) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Annotations))
+		}
+	})
+
+	t.Run("RemoveAnnotation", func(t *testing.T) {
+		defer server.checkCommands(t, "removeAnnotation")
+
+		p := client.Annotation.NewRemoveAnnotationParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Annotation.RemoveAnnotation(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0040efed-cd37-198f-d12d-7bcf2a7fff9e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateAnnotationVisibility", func(t *testing.T) {
+		defer server.checkCommands(t, "updateAnnotationVisibility")
+
+		p := client.Annotation.NewUpdateAnnotationVisibilityParams(true, "id")
+
+		expected := url.Values{
+			"adminsonly": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Annotation.UpdateAnnotationVisibility(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7cbe1289-3833-c995-ab6b-25112c59fe79" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
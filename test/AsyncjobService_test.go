@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -60,3 +62,71 @@ func TestAsyncjobService(t *testing.T) {
 	t.Run("QueryAsyncJobResult", testqueryAsyncJobResult)
 
 }
+
+func TestAsyncjobServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AsyncjobService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ListAsyncJobs", func(t *testing.T) {
+		defer server.checkCommands(t, "listAsyncJobs")
+
+		p := client.Asyncjob.NewListAsyncJobsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetManagementserverid("1e964186-5d6f-8a5d-1f40-7a7d2eb974f7")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetStartdate("startdate")
+
+		expected := url.Values{
+			"account":            {"account"},
+			"domainid":           {"domainid"},
+			"isrecursive":        {"true"},
+			"keyword":            {"keyword"},
+			"listall":            {"true"},
+			"managementserverid": {"1e964186-5d6f-8a5d-1f40-7a7d2eb974f7"},
+			"page":               {"1"},
+			"pagesize":           {"1"},
+			"startdate":          {"startdate"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Asyncjob.ListAsyncJobs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.AsyncJobs) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.AsyncJobs))
+		}
+	})
+
+	t.Run("QueryAsyncJobResult", func(t *testing.T) {
+		defer server.checkCommands(t, "queryAsyncJobResult")
+
+		p := client.Asyncjob.NewQueryAsyncJobResultParams("jobid")
+
+		expected := url.Values{
+			"jobid": {"jobid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Asyncjob.QueryAsyncJobResult(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
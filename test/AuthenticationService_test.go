@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -60,3 +62,55 @@ func TestAuthenticationService(t *testing.T) {
 	t.Run("Logout", testlogout)
 
 }
+
+func TestAuthenticationServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AuthenticationService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("Login", func(t *testing.T) {
+		defer server.checkCommands(t, "login")
+
+		p := client.Authentication.NewLoginParams("password", "username")
+		p.SetDomain("domain")
+		p.SetDomainId(2)
+
+		expected := url.Values{
+			"domain":   {"domain"},
+			"domainId": {"2"},
+			"password": {"password"},
+			"username": {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Authentication.Login(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("Logout", func(t *testing.T) {
+		defer server.checkCommands(t, "logout")
+
+		p := client.Authentication.NewLogoutParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Authentication.Logout(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -306,3 +308,676 @@ func TestAutoScaleService(t *testing.T) {
 	t.Run("UpdateAutoScaleVmProfile", testupdateAutoScaleVmProfile)
 
 }
+
+func TestAutoScaleServiceFixtures(t *testing.T) {
+	response, err := readData("generated/AutoScaleService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateAutoScalePolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "createAutoScalePolicy", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewCreateAutoScalePolicyParams("action", []string{"conditionids1", "conditionids2"}, 1)
+		p.SetName("name")
+		p.SetQuiettime(1)
+
+		expected := url.Values{
+			"action":       {"action"},
+			"conditionids": {"conditionids1,conditionids2"},
+			"duration":     {"1"},
+			"name":         {"name"},
+			"quiettime":    {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.CreateAutoScalePolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cbdb2ad0-df8e-e77e-d0b0-441a735e0f6d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateAutoScaleVmGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "createAutoScaleVmGroup", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewCreateAutoScaleVmGroupParams("lbruleid", 1, 1, []string{"scaledownpolicyids1", "scaledownpolicyids2"}, []string{"scaleuppolicyids1", "scaleuppolicyids2"}, "vmprofileid")
+		p.SetFordisplay(true)
+		p.SetInterval(1)
+		p.SetName("name")
+
+		expected := url.Values{
+			"fordisplay":         {"true"},
+			"interval":           {"1"},
+			"lbruleid":           {"lbruleid"},
+			"maxmembers":         {"1"},
+			"minmembers":         {"1"},
+			"name":               {"name"},
+			"scaledownpolicyids": {"scaledownpolicyids1,scaledownpolicyids2"},
+			"scaleuppolicyids":   {"scaleuppolicyids1,scaleuppolicyids2"},
+			"vmprofileid":        {"vmprofileid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.CreateAutoScaleVmGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "32c4b6c9-d89f-428f-76b5-1ce2f3ef3c4e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateAutoScaleVmProfile", func(t *testing.T) {
+		defer server.checkCommands(t, "createAutoScaleVmProfile", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewCreateAutoScaleVmProfileParams("serviceofferingid", "templateid", "zoneid")
+		p.SetAccount("account")
+		p.SetAutoscaleuserid("autoscaleuserid")
+		p.SetCounterparam(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDomainid("domainid")
+		p.SetExpungevmgraceperiod(1)
+		p.SetFordisplay(true)
+		p.SetOtherdeployparams(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetProjectid("projectid")
+		p.SetUserdata("userdata")
+		p.SetUserdatadetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetUserdataid("userdataid")
+
+		expected := url.Values{
+			"account":                    {"account"},
+			"autoscaleuserid":            {"autoscaleuserid"},
+			"counterparam[0].key":        {"key1"},
+			"counterparam[0].value":      {"value1"},
+			"counterparam[1].key":        {"key2"},
+			"counterparam[1].value":      {"value2"},
+			"domainid":                   {"domainid"},
+			"expungevmgraceperiod":       {"1"},
+			"fordisplay":                 {"true"},
+			"otherdeployparams[0].key":   {"key1"},
+			"otherdeployparams[0].value": {"value1"},
+			"otherdeployparams[1].key":   {"key2"},
+			"otherdeployparams[1].value": {"value2"},
+			"projectid":                  {"projectid"},
+			"serviceofferingid":          {"serviceofferingid"},
+			"templateid":                 {"templateid"},
+			"userdata":                   {"userdata"},
+			"userdatadetails[0].key":     {"key1"},
+			"userdatadetails[0].value":   {"value1"},
+			"userdatadetails[1].key":     {"key2"},
+			"userdatadetails[1].value":   {"value2"},
+			"userdataid":                 {"userdataid"},
+			"zoneid":                     {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.CreateAutoScaleVmProfile(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d8d70d78-0c96-dbee-cae4-61f755bc333b" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateCondition", func(t *testing.T) {
+		defer server.checkCommands(t, "createCondition", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewCreateConditionParams("counterid", "relationaloperator", 2)
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":            {"account"},
+			"counterid":          {"counterid"},
+			"domainid":           {"domainid"},
+			"projectid":          {"projectid"},
+			"relationaloperator": {"relationaloperator"},
+			"threshold":          {"2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.CreateCondition(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "692f239f-5c9c-074a-32c2-95492ab6e8eb" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateCounter", func(t *testing.T) {
+		defer server.checkCommands(t, "createCounter", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewCreateCounterParams("name", "provider", "source", "value")
+
+		expected := url.Values{
+			"name":     {"name"},
+			"provider": {"provider"},
+			"source":   {"source"},
+			"value":    {"value"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.CreateCounter(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "687f82f9-d5f7-8a3e-637d-5d09ada4ee83" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteAutoScalePolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteAutoScalePolicy", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewDeleteAutoScalePolicyParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.DeleteAutoScalePolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteAutoScaleVmGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteAutoScaleVmGroup", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewDeleteAutoScaleVmGroupParams("id")
+		p.SetCleanup(true)
+
+		expected := url.Values{
+			"cleanup": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.DeleteAutoScaleVmGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteAutoScaleVmProfile", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteAutoScaleVmProfile", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewDeleteAutoScaleVmProfileParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.DeleteAutoScaleVmProfile(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteCondition", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteCondition", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewDeleteConditionParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.DeleteCondition(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteCounter", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteCounter", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewDeleteCounterParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.DeleteCounter(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableAutoScaleVmGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "disableAutoScaleVmGroup", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewDisableAutoScaleVmGroupParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.DisableAutoScaleVmGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9fa1ba9b-abed-db0e-a1c2-57898ed862d6" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("EnableAutoScaleVmGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "enableAutoScaleVmGroup", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewEnableAutoScaleVmGroupParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.EnableAutoScaleVmGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7b73f2cb-1603-3405-c2be-a19fb2023e4a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListAutoScalePolicies", func(t *testing.T) {
+		defer server.checkCommands(t, "listAutoScalePolicies")
+
+		p := client.AutoScale.NewListAutoScalePoliciesParams()
+		p.SetAccount("account")
+		p.SetAction("action")
+		p.SetConditionid("conditionid")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetVmgroupid("vmgroupid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"action":      {"action"},
+			"conditionid": {"conditionid"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"vmgroupid":   {"vmgroupid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.ListAutoScalePolicies(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.AutoScalePolicies) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.AutoScalePolicies))
+		}
+		if r.AutoScalePolicies[0].Id != "2c691f36-95bb-b806-a42f-1634bc0a054f" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.AutoScalePolicies[0].Id)
+		}
+	})
+
+	t.Run("ListAutoScaleVmGroups", func(t *testing.T) {
+		defer server.checkCommands(t, "listAutoScaleVmGroups")
+
+		p := client.AutoScale.NewListAutoScaleVmGroupsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetLbruleid("lbruleid")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPolicyid("policyid")
+		p.SetProjectid("projectid")
+		p.SetVmprofileid("vmprofileid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"fordisplay":  {"true"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"lbruleid":    {"lbruleid"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"policyid":    {"policyid"},
+			"projectid":   {"projectid"},
+			"vmprofileid": {"vmprofileid"},
+			"zoneid":      {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.ListAutoScaleVmGroups(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.AutoScaleVmGroups) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.AutoScaleVmGroups))
+		}
+		if r.AutoScaleVmGroups[0].Id != "085285b9-a0c7-1f4b-d76a-a9725520d18f" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.AutoScaleVmGroups[0].Id)
+		}
+	})
+
+	t.Run("ListAutoScaleVmProfiles", func(t *testing.T) {
+		defer server.checkCommands(t, "listAutoScaleVmProfiles")
+
+		p := client.AutoScale.NewListAutoScaleVmProfilesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetOtherdeployparams("otherdeployparams")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetServiceofferingid("serviceofferingid")
+		p.SetTemplateid("templateid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"domainid":          {"domainid"},
+			"fordisplay":        {"true"},
+			"id":                {"id"},
+			"isrecursive":       {"true"},
+			"keyword":           {"keyword"},
+			"listall":           {"true"},
+			"otherdeployparams": {"otherdeployparams"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"projectid":         {"projectid"},
+			"serviceofferingid": {"serviceofferingid"},
+			"templateid":        {"templateid"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.ListAutoScaleVmProfiles(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.AutoScaleVmProfiles) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.AutoScaleVmProfiles))
+		}
+	})
+
+	t.Run("ListConditions", func(t *testing.T) {
+		defer server.checkCommands(t, "listConditions")
+
+		p := client.AutoScale.NewListConditionsParams()
+		p.SetAccount("account")
+		p.SetCounterid("counterid")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPolicyid("policyid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"counterid":   {"counterid"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"policyid":    {"policyid"},
+			"projectid":   {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.ListConditions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Conditions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Conditions))
+		}
+	})
+
+	t.Run("ListCounters", func(t *testing.T) {
+		defer server.checkCommands(t, "listCounters")
+
+		p := client.AutoScale.NewListCountersParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProvider("provider")
+		p.SetSource("source")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"provider": {"provider"},
+			"source":   {"source"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.ListCounters(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Counters) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Counters))
+		}
+		if r.Counters[0].Id != "8277ad4b-22f8-43e4-c64c-8b14b1e57871" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Counters[0].Id)
+		}
+	})
+
+	t.Run("UpdateAutoScalePolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "updateAutoScalePolicy", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewUpdateAutoScalePolicyParams("id")
+		p.SetConditionids([]string{"conditionids1", "conditionids2"})
+		p.SetDuration(1)
+		p.SetName("name")
+		p.SetQuiettime(1)
+
+		expected := url.Values{
+			"conditionids": {"conditionids1,conditionids2"},
+			"duration":     {"1"},
+			"id":           {"id"},
+			"name":         {"name"},
+			"quiettime":    {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.UpdateAutoScalePolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9b6a7124-be76-6e9d-1c03-228a7f77377e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateAutoScaleVmGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "updateAutoScaleVmGroup", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewUpdateAutoScaleVmGroupParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+		p.SetInterval(1)
+		p.SetMaxmembers(1)
+		p.SetMinmembers(1)
+		p.SetName("name")
+		p.SetScaledownpolicyids([]string{"scaledownpolicyids1", "scaledownpolicyids2"})
+		p.SetScaleuppolicyids([]string{"scaleuppolicyids1", "scaleuppolicyids2"})
+
+		expected := url.Values{
+			"customid":           {"customid"},
+			"fordisplay":         {"true"},
+			"id":                 {"id"},
+			"interval":           {"1"},
+			"maxmembers":         {"1"},
+			"minmembers":         {"1"},
+			"name":               {"name"},
+			"scaledownpolicyids": {"scaledownpolicyids1,scaledownpolicyids2"},
+			"scaleuppolicyids":   {"scaleuppolicyids1,scaleuppolicyids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.UpdateAutoScaleVmGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "1c85f345-6060-c925-e166-389a600522c4" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateAutoScaleVmProfile", func(t *testing.T) {
+		defer server.checkCommands(t, "updateAutoScaleVmProfile", "queryAsyncJobResult")
+
+		p := client.AutoScale.NewUpdateAutoScaleVmProfileParams("id")
+		p.SetAutoscaleuserid("autoscaleuserid")
+		p.SetCounterparam(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetCustomid("customid")
+		p.SetExpungevmgraceperiod(1)
+		p.SetFordisplay(true)
+		p.SetOtherdeployparams(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetServiceofferingid("serviceofferingid")
+		p.SetTemplateid("templateid")
+		p.SetUserdata("userdata")
+		p.SetUserdatadetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetUserdataid("userdataid")
+
+		expected := url.Values{
+			"autoscaleuserid":            {"autoscaleuserid"},
+			"counterparam[0].key":        {"key1"},
+			"counterparam[0].value":      {"value1"},
+			"counterparam[1].key":        {"key2"},
+			"counterparam[1].value":      {"value2"},
+			"customid":                   {"customid"},
+			"expungevmgraceperiod":       {"1"},
+			"fordisplay":                 {"true"},
+			"id":                         {"id"},
+			"otherdeployparams[0].key":   {"key1"},
+			"otherdeployparams[0].value": {"value1"},
+			"otherdeployparams[1].key":   {"key2"},
+			"otherdeployparams[1].value": {"value2"},
+			"serviceofferingid":          {"serviceofferingid"},
+			"templateid":                 {"templateid"},
+			"userdata":                   {"userdata"},
+			"userdatadetails[0].key":     {"key1"},
+			"userdatadetails[0].value":   {"value1"},
+			"userdatadetails[1].key":     {"key2"},
+			"userdatadetails[1].value":   {"value2"},
+			"userdataid":                 {"userdataid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.AutoScale.UpdateAutoScaleVmProfile(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "68520ec5-4b58-e84d-90f6-83361382310f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
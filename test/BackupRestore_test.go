@@ -0,0 +1,121 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+func TestRestoreFromLatestBackup(t *testing.T) {
+	server := newFixtureServer(map[string]json.RawMessage{
+		"listBackups": json.RawMessage(`{"listbackupsresponse": {"count": 3, "backup": [
+			{"id": "backup-1", "virtualmachineid": "vm-1", "status": "BackedUp", "created": "2023-01-02T03:04:05+0000"},
+			{"id": "backup-2", "virtualmachineid": "vm-1", "status": "BackedUp", "created": "2023-01-03T01:00:00+0100"},
+			{"id": "backup-3", "virtualmachineid": "vm-1", "status": "Error", "created": "2023-01-04T03:04:05+0000"}
+		]}}`),
+		"restoreBackup": json.RawMessage(`{"restorebackupresponse": {"jobid": "job-1", "jobstatus": 1,
+			"jobresulttype": "object", "jobresult": {"success": true}}}`),
+	})
+	defer server.Close()
+
+	// The sync client should still wait for the restore job to finish
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	b, err := client.RestoreFromLatestBackup("vm-1")
+	if err != nil {
+		t.Fatalf("Failed to restore from the latest backup: %v", err)
+	}
+	if b.Id != "backup-2" {
+		t.Errorf("Expected the VM to be restored from backup-2, got %s", b.Id)
+	}
+	server.checkCommands(t, "listBackups", "restoreBackup", "queryAsyncJobResult")
+
+	// The options of the caller are not modified, even if their slice has spare capacity
+	marker := cloudstack.WithCallParams(nil)
+	opts := append(make([]cloudstack.CallOption, 0, 2), cloudstack.WithCallTimeout(time.Minute), marker)[:1]
+	if _, err := client.RestoreFromLatestBackup("vm-1", opts...); err != nil {
+		t.Fatalf("Failed to restore from the latest backup: %v", err)
+	}
+	if spare := opts[:2][1]; reflect.ValueOf(spare).Pointer() != reflect.ValueOf(marker).Pointer() {
+		t.Errorf("Expected the spare capacity of the options to be left alone")
+	}
+}
+
+func TestRestoreFromLatestBackupWithoutBackups(t *testing.T) {
+	server := newFixtureServer(map[string]json.RawMessage{
+		"listBackups": json.RawMessage(`{"listbackupsresponse": {"count": 1, "backup": [
+			{"id": "backup-1", "virtualmachineid": "vm-1", "status": "Failed", "created": "2023-01-02T03:04:05+0000"}
+		]}}`),
+	})
+	defer server.Close()
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	_, err := client.RestoreFromLatestBackup("vm-1")
+	var noBackup *cloudstack.NoBackupError
+	if !errors.As(err, &noBackup) {
+		t.Fatalf("Expected a NoBackupError, got %v", err)
+	}
+	server.checkCommands(t, "listBackups")
+}
+
+func TestLatestBackupListsAllPages(t *testing.T) {
+	const total = 750
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		pages = append(pages, q.Get("page"))
+		page, _ := strconv.Atoi(q.Get("page"))
+		size, _ := strconv.Atoi(q.Get("pagesize"))
+
+		var backups []map[string]string
+		for i := (page - 1) * size; i < page*size && i < total; i++ {
+			backups = append(backups, map[string]string{
+				"id":      fmt.Sprintf("backup-%d", i),
+				"status":  "BackedUp",
+				"created": fmt.Sprintf("2023-01-01T00:%02d:%02d+0000", i/60%60, i%60),
+			})
+		}
+		b, _ := json.Marshal(map[string]interface{}{"listbackupsresponse": map[string]interface{}{"count": total, "backup": backups}})
+		w.Write(b)
+	}))
+	defer server.Close()
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	b, err := client.LatestBackup("vm-1")
+	if err != nil {
+		t.Fatalf("Failed to get the latest backup: %v", err)
+	}
+	if b.Id != fmt.Sprintf("backup-%d", total-1) {
+		t.Errorf("Expected the newest backup on the last page, got %s", b.Id)
+	}
+	if len(pages) != 2 || pages[0] != "1" || pages[1] != "2" {
+		t.Errorf("Expected pages 1 and 2 to be listed, got %v", pages)
+	}
+}
@@ -0,0 +1,680 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+func TestBackupService(t *testing.T) {
+	service := "BackupService"
+	response, err := readData(service)
+	if err != nil {
+		t.Skipf("Skipping test as %v", err)
+	}
+	server := CreateTestServer(t, response)
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	testassignVirtualMachineToBackupOffering := func(t *testing.T) {
+		if _, ok := response["assignVirtualMachineToBackupOffering"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewAssignVirtualMachineToBackupOfferingParams("backupofferingid", "virtualmachineid")
+		_, err := client.Backup.AssignVirtualMachineToBackupOffering(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("AssignVirtualMachineToBackupOffering", testassignVirtualMachineToBackupOffering)
+
+	testcreateBackup := func(t *testing.T) {
+		if _, ok := response["createBackup"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewCreateBackupParams("virtualmachineid")
+		_, err := client.Backup.CreateBackup(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("CreateBackup", testcreateBackup)
+
+	testcreateBackupSchedule := func(t *testing.T) {
+		if _, ok := response["createBackupSchedule"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewCreateBackupScheduleParams("intervaltype", "schedule", "timezone", "virtualmachineid")
+		_, err := client.Backup.CreateBackupSchedule(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("CreateBackupSchedule", testcreateBackupSchedule)
+
+	testdeleteBackup := func(t *testing.T) {
+		if _, ok := response["deleteBackup"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewDeleteBackupParams("id")
+		_, err := client.Backup.DeleteBackup(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("DeleteBackup", testdeleteBackup)
+
+	testdeleteBackupOffering := func(t *testing.T) {
+		if _, ok := response["deleteBackupOffering"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewDeleteBackupOfferingParams("id")
+		_, err := client.Backup.DeleteBackupOffering(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("DeleteBackupOffering", testdeleteBackupOffering)
+
+	testdeleteBackupSchedule := func(t *testing.T) {
+		if _, ok := response["deleteBackupSchedule"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewDeleteBackupScheduleParams("virtualmachineid")
+		_, err := client.Backup.DeleteBackupSchedule(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("DeleteBackupSchedule", testdeleteBackupSchedule)
+
+	testimportBackupOffering := func(t *testing.T) {
+		if _, ok := response["importBackupOffering"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewImportBackupOfferingParams(true, "description", "externalid", "name", "zoneid")
+		r, err := client.Backup.ImportBackupOffering(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("ImportBackupOffering", testimportBackupOffering)
+
+	testlistBackupOfferings := func(t *testing.T) {
+		if _, ok := response["listBackupOfferings"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewListBackupOfferingsParams()
+		_, err := client.Backup.ListBackupOfferings(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListBackupOfferings", testlistBackupOfferings)
+
+	testlistBackupProviderOfferings := func(t *testing.T) {
+		if _, ok := response["listBackupProviderOfferings"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewListBackupProviderOfferingsParams("zoneid")
+		_, err := client.Backup.ListBackupProviderOfferings(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListBackupProviderOfferings", testlistBackupProviderOfferings)
+
+	testlistBackupProviders := func(t *testing.T) {
+		if _, ok := response["listBackupProviders"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewListBackupProvidersParams()
+		_, err := client.Backup.ListBackupProviders(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListBackupProviders", testlistBackupProviders)
+
+	testlistBackupSchedule := func(t *testing.T) {
+		if _, ok := response["listBackupSchedule"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewListBackupScheduleParams("virtualmachineid")
+		_, err := client.Backup.ListBackupSchedule(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListBackupSchedule", testlistBackupSchedule)
+
+	testlistBackups := func(t *testing.T) {
+		if _, ok := response["listBackups"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewListBackupsParams()
+		_, err := client.Backup.ListBackups(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListBackups", testlistBackups)
+
+	testremoveVirtualMachineFromBackupOffering := func(t *testing.T) {
+		if _, ok := response["removeVirtualMachineFromBackupOffering"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewRemoveVirtualMachineFromBackupOfferingParams("virtualmachineid")
+		_, err := client.Backup.RemoveVirtualMachineFromBackupOffering(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("RemoveVirtualMachineFromBackupOffering", testremoveVirtualMachineFromBackupOffering)
+
+	testrestoreBackup := func(t *testing.T) {
+		if _, ok := response["restoreBackup"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewRestoreBackupParams("id")
+		_, err := client.Backup.RestoreBackup(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("RestoreBackup", testrestoreBackup)
+
+	testrestoreVolumeFromBackupAndAttachToVM := func(t *testing.T) {
+		if _, ok := response["restoreVolumeFromBackupAndAttachToVM"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewRestoreVolumeFromBackupAndAttachToVMParams("id", "virtualmachineid", "volumeid")
+		_, err := client.Backup.RestoreVolumeFromBackupAndAttachToVM(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("RestoreVolumeFromBackupAndAttachToVM", testrestoreVolumeFromBackupAndAttachToVM)
+
+	testupdateBackupOffering := func(t *testing.T) {
+		if _, ok := response["updateBackupOffering"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewUpdateBackupOfferingParams("id")
+		r, err := client.Backup.UpdateBackupOffering(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("UpdateBackupOffering", testupdateBackupOffering)
+
+	testupdateBackupSchedule := func(t *testing.T) {
+		if _, ok := response["updateBackupSchedule"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Backup.NewUpdateBackupScheduleParams("intervaltype", "schedule", "timezone", "virtualmachineid")
+		_, err := client.Backup.UpdateBackupSchedule(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("UpdateBackupSchedule", testupdateBackupSchedule)
+
+}
+
+func TestBackupServiceFixtures(t *testing.T) {
+	response, err := readData("generated/BackupService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AssignVirtualMachineToBackupOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "assignVirtualMachineToBackupOffering", "queryAsyncJobResult")
+
+		p := client.Backup.NewAssignVirtualMachineToBackupOfferingParams("backupofferingid", "virtualmachineid")
+
+		expected := url.Values{
+			"backupofferingid": {"backupofferingid"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.AssignVirtualMachineToBackupOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("CreateBackup", func(t *testing.T) {
+		defer server.checkCommands(t, "createBackup", "queryAsyncJobResult")
+
+		p := client.Backup.NewCreateBackupParams("virtualmachineid")
+
+		expected := url.Values{
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.CreateBackup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("CreateBackupSchedule", func(t *testing.T) {
+		defer server.checkCommands(t, "createBackupSchedule")
+
+		p := client.Backup.NewCreateBackupScheduleParams("intervaltype", "schedule", "timezone", "virtualmachineid")
+
+		expected := url.Values{
+			"intervaltype":     {"intervaltype"},
+			"schedule":         {"schedule"},
+			"timezone":         {"timezone"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.CreateBackupSchedule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DeleteBackup", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteBackup", "queryAsyncJobResult")
+
+		p := client.Backup.NewDeleteBackupParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.DeleteBackup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteBackupOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteBackupOffering")
+
+		p := client.Backup.NewDeleteBackupOfferingParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.DeleteBackupOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteBackupSchedule", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteBackupSchedule")
+
+		p := client.Backup.NewDeleteBackupScheduleParams("virtualmachineid")
+
+		expected := url.Values{
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.DeleteBackupSchedule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ImportBackupOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "importBackupOffering", "queryAsyncJobResult")
+
+		p := client.Backup.NewImportBackupOfferingParams(true, "description", "externalid", "name", "zoneid")
+
+		expected := url.Values{
+			"allowuserdrivenbackups": {"true"},
+			"description":            {"description"},
+			"externalid":             {"externalid"},
+			"name":                   {"name"},
+			"zoneid":                 {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.ImportBackupOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8852a50c-47dc-c043-ec45-336e1fa6e135" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListBackupOfferings", func(t *testing.T) {
+		defer server.checkCommands(t, "listBackupOfferings")
+
+		p := client.Backup.NewListBackupOfferingsParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.ListBackupOfferings(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BackupOfferings) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BackupOfferings))
+		}
+		if r.BackupOfferings[0].Id != "65b19522-4586-44a8-64f7-95fbec872ad7" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.BackupOfferings[0].Id)
+		}
+	})
+
+	t.Run("ListBackupProviderOfferings", func(t *testing.T) {
+		defer server.checkCommands(t, "listBackupProviderOfferings")
+
+		p := client.Backup.NewListBackupProviderOfferingsParams("zoneid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.ListBackupProviderOfferings(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BackupProviderOfferings) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BackupProviderOfferings))
+		}
+		if r.BackupProviderOfferings[0].Id != "1fd4f1ce-107d-feaa-918d-49218a04e196" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.BackupProviderOfferings[0].Id)
+		}
+	})
+
+	t.Run("ListBackupProviders", func(t *testing.T) {
+		defer server.checkCommands(t, "listBackupProviders")
+
+		p := client.Backup.NewListBackupProvidersParams()
+		p.SetName("name")
+
+		expected := url.Values{
+			"name": {"name"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.ListBackupProviders(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BackupProviders) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BackupProviders))
+		}
+	})
+
+	t.Run("ListBackupSchedule", func(t *testing.T) {
+		defer server.checkCommands(t, "listBackupSchedule")
+
+		p := client.Backup.NewListBackupScheduleParams("virtualmachineid")
+
+		expected := url.Values{
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.ListBackupSchedule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BackupSchedule) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BackupSchedule))
+		}
+	})
+
+	t.Run("ListBackups", func(t *testing.T) {
+		defer server.checkCommands(t, "listBackups")
+
+		p := client.Backup.NewListBackupsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"domainid":         {"domainid"},
+			"id":               {"id"},
+			"isrecursive":      {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"projectid":        {"projectid"},
+			"virtualmachineid": {"virtualmachineid"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.ListBackups(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Backups) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Backups))
+		}
+	})
+
+	t.Run("RemoveVirtualMachineFromBackupOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "removeVirtualMachineFromBackupOffering", "queryAsyncJobResult")
+
+		p := client.Backup.NewRemoveVirtualMachineFromBackupOfferingParams("virtualmachineid")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced":           {"true"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.RemoveVirtualMachineFromBackupOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RestoreBackup", func(t *testing.T) {
+		defer server.checkCommands(t, "restoreBackup", "queryAsyncJobResult")
+
+		p := client.Backup.NewRestoreBackupParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.RestoreBackup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RestoreVolumeFromBackupAndAttachToVM", func(t *testing.T) {
+		defer server.checkCommands(t, "restoreVolumeFromBackupAndAttachToVM", "queryAsyncJobResult")
+
+		p := client.Backup.NewRestoreVolumeFromBackupAndAttachToVMParams("id", "virtualmachineid", "volumeid")
+
+		expected := url.Values{
+			"id":               {"id"},
+			"virtualmachineid": {"virtualmachineid"},
+			"volumeid":         {"volumeid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.RestoreVolumeFromBackupAndAttachToVM(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateBackupOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "updateBackupOffering")
+
+		p := client.Backup.NewUpdateBackupOfferingParams("id")
+		p.SetAllowuserdrivenbackups(true)
+		p.SetDescription("description")
+		p.SetName("name")
+
+		expected := url.Values{
+			"allowuserdrivenbackups": {"true"},
+			"description":            {"description"},
+			"id":                     {"id"},
+			"name":                   {"name"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.UpdateBackupOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f085b3fa-8be4-48c8-c2bf-88abec8a5b85" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateBackupSchedule", func(t *testing.T) {
+		defer server.checkCommands(t, "updateBackupSchedule")
+
+		p := client.Backup.NewUpdateBackupScheduleParams("intervaltype", "schedule", "timezone", "virtualmachineid")
+
+		expected := url.Values{
+			"intervaltype":     {"intervaltype"},
+			"schedule":         {"schedule"},
+			"timezone":         {"timezone"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Backup.UpdateBackupSchedule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -156,3 +158,250 @@ func TestBaremetalService(t *testing.T) {
 	t.Run("NotifyBaremetalProvisionDone", testnotifyBaremetalProvisionDone)
 
 }
+
+func TestBaremetalServiceFixtures(t *testing.T) {
+	response, err := readData("generated/BaremetalService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddBaremetalDhcp", func(t *testing.T) {
+		defer server.checkCommands(t, "addBaremetalDhcp", "queryAsyncJobResult")
+
+		p := client.Baremetal.NewAddBaremetalDhcpParams("dhcpservertype", "password", "physicalnetworkid", "url", "username")
+
+		expected := url.Values{
+			"dhcpservertype":    {"dhcpservertype"},
+			"password":          {"password"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"url":               {"url"},
+			"username":          {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.AddBaremetalDhcp(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d643c672-f00e-75d3-5a09-8beb8f49ea0c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddBaremetalPxeKickStartServer", func(t *testing.T) {
+		defer server.checkCommands(t, "addBaremetalPxeKickStartServer", "queryAsyncJobResult")
+
+		p := client.Baremetal.NewAddBaremetalPxeKickStartServerParams("password", "physicalnetworkid", "pxeservertype", "tftpdir", "url", "username")
+		p.SetPodid("podid")
+
+		expected := url.Values{
+			"password":          {"password"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"podid":             {"podid"},
+			"pxeservertype":     {"pxeservertype"},
+			"tftpdir":           {"tftpdir"},
+			"url":               {"url"},
+			"username":          {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.AddBaremetalPxeKickStartServer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "80b6830e-c9d1-168e-5ad6-613f6a354a78" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddBaremetalPxePingServer", func(t *testing.T) {
+		defer server.checkCommands(t, "addBaremetalPxePingServer", "queryAsyncJobResult")
+
+		p := client.Baremetal.NewAddBaremetalPxePingServerParams("password", "physicalnetworkid", "pingdir", "pingstorageserverip", "pxeservertype", "tftpdir", "url", "username")
+		p.SetPingcifspassword("pingcifspassword")
+		p.SetPingcifsusername("pingcifsusername")
+		p.SetPodid("podid")
+
+		expected := url.Values{
+			"password":            {"password"},
+			"physicalnetworkid":   {"physicalnetworkid"},
+			"pingcifspassword":    {"pingcifspassword"},
+			"pingcifsusername":    {"pingcifsusername"},
+			"pingdir":             {"pingdir"},
+			"pingstorageserverip": {"pingstorageserverip"},
+			"podid":               {"podid"},
+			"pxeservertype":       {"pxeservertype"},
+			"tftpdir":             {"tftpdir"},
+			"url":                 {"url"},
+			"username":            {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.AddBaremetalPxePingServer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c831281d-6bc9-c1a3-5b77-a8400e66ddbd" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddBaremetalRct", func(t *testing.T) {
+		defer server.checkCommands(t, "addBaremetalRct", "queryAsyncJobResult")
+
+		p := client.Baremetal.NewAddBaremetalRctParams("baremetalrcturl")
+
+		expected := url.Values{
+			"baremetalrcturl": {"baremetalrcturl"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.AddBaremetalRct(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "a328f0f0-0ef1-8855-89c8-46fb84bee174" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteBaremetalRct", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteBaremetalRct", "queryAsyncJobResult")
+
+		p := client.Baremetal.NewDeleteBaremetalRctParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.DeleteBaremetalRct(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListBaremetalDhcp", func(t *testing.T) {
+		defer server.checkCommands(t, "listBaremetalDhcp")
+
+		p := client.Baremetal.NewListBaremetalDhcpParams("physicalnetworkid")
+		p.SetDhcpservertype("dhcpservertype")
+		p.SetId(2)
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"dhcpservertype":    {"dhcpservertype"},
+			"id":                {"2"},
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.ListBaremetalDhcp(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BaremetalDhcp) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BaremetalDhcp))
+		}
+	})
+
+	t.Run("ListBaremetalPxeServers", func(t *testing.T) {
+		defer server.checkCommands(t, "listBaremetalPxeServers")
+
+		p := client.Baremetal.NewListBaremetalPxeServersParams("physicalnetworkid")
+		p.SetId(2)
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"id":                {"2"},
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.ListBaremetalPxeServers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BaremetalPxeServers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BaremetalPxeServers))
+		}
+	})
+
+	t.Run("ListBaremetalRct", func(t *testing.T) {
+		defer server.checkCommands(t, "listBaremetalRct")
+
+		p := client.Baremetal.NewListBaremetalRctParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.ListBaremetalRct(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BaremetalRct) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BaremetalRct))
+		}
+	})
+
+	t.Run("NotifyBaremetalProvisionDone", func(t *testing.T) {
+		defer server.checkCommands(t, "notifyBaremetalProvisionDone", "queryAsyncJobResult")
+
+		p := client.Baremetal.NewNotifyBaremetalProvisionDoneParams("mac")
+
+		expected := url.Values{
+			"mac": {"mac"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Baremetal.NotifyBaremetalProvisionDone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
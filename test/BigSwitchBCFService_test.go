@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -72,3 +74,88 @@ func TestBigSwitchBCFService(t *testing.T) {
 	t.Run("ListBigSwitchBcfDevices", testlistBigSwitchBcfDevices)
 
 }
+
+func TestBigSwitchBCFServiceFixtures(t *testing.T) {
+	response, err := readData("generated/BigSwitchBCFService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddBigSwitchBcfDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "addBigSwitchBcfDevice", "queryAsyncJobResult")
+
+		p := client.BigSwitchBCF.NewAddBigSwitchBcfDeviceParams("hostname", true, "password", "physicalnetworkid", "username")
+
+		expected := url.Values{
+			"hostname":          {"hostname"},
+			"nat":               {"true"},
+			"password":          {"password"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"username":          {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.BigSwitchBCF.AddBigSwitchBcfDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DeleteBigSwitchBcfDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteBigSwitchBcfDevice", "queryAsyncJobResult")
+
+		p := client.BigSwitchBCF.NewDeleteBigSwitchBcfDeviceParams("bcfdeviceid")
+
+		expected := url.Values{
+			"bcfdeviceid": {"bcfdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.BigSwitchBCF.DeleteBigSwitchBcfDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListBigSwitchBcfDevices", func(t *testing.T) {
+		defer server.checkCommands(t, "listBigSwitchBcfDevices")
+
+		p := client.BigSwitchBCF.NewListBigSwitchBcfDevicesParams()
+		p.SetBcfdeviceid("bcfdeviceid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+
+		expected := url.Values{
+			"bcfdeviceid":       {"bcfdeviceid"},
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.BigSwitchBCF.ListBigSwitchBcfDevices(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BigSwitchBcfDevices) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BigSwitchBcfDevices))
+		}
+	})
+
+}
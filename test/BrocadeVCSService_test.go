@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -84,3 +86,117 @@ func TestBrocadeVCSService(t *testing.T) {
 	t.Run("ListBrocadeVcsDevices", testlistBrocadeVcsDevices)
 
 }
+
+func TestBrocadeVCSServiceFixtures(t *testing.T) {
+	response, err := readData("generated/BrocadeVCSService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddBrocadeVcsDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "addBrocadeVcsDevice", "queryAsyncJobResult")
+
+		p := client.BrocadeVCS.NewAddBrocadeVcsDeviceParams("hostname", "password", "physicalnetworkid", "username")
+
+		expected := url.Values{
+			"hostname":          {"hostname"},
+			"password":          {"password"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"username":          {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.BrocadeVCS.AddBrocadeVcsDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DeleteBrocadeVcsDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteBrocadeVcsDevice", "queryAsyncJobResult")
+
+		p := client.BrocadeVCS.NewDeleteBrocadeVcsDeviceParams("vcsdeviceid")
+
+		expected := url.Values{
+			"vcsdeviceid": {"vcsdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.BrocadeVCS.DeleteBrocadeVcsDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListBrocadeVcsDeviceNetworks", func(t *testing.T) {
+		defer server.checkCommands(t, "listBrocadeVcsDeviceNetworks")
+
+		p := client.BrocadeVCS.NewListBrocadeVcsDeviceNetworksParams("vcsdeviceid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":     {"keyword"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"vcsdeviceid": {"vcsdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.BrocadeVCS.ListBrocadeVcsDeviceNetworks(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BrocadeVcsDeviceNetworks) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BrocadeVcsDeviceNetworks))
+		}
+		if r.BrocadeVcsDeviceNetworks[0].Id != "c2f519bf-e824-4c67-d7e9-5bfe3ed34be9" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.BrocadeVcsDeviceNetworks[0].Id)
+		}
+	})
+
+	t.Run("ListBrocadeVcsDevices", func(t *testing.T) {
+		defer server.checkCommands(t, "listBrocadeVcsDevices")
+
+		p := client.BrocadeVCS.NewListBrocadeVcsDevicesParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetVcsdeviceid("vcsdeviceid")
+
+		expected := url.Values{
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"vcsdeviceid":       {"vcsdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.BrocadeVCS.ListBrocadeVcsDevices(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.BrocadeVcsDevices) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.BrocadeVcsDevices))
+		}
+	})
+
+}
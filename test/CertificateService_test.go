@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -48,3 +50,40 @@ func TestCertificateService(t *testing.T) {
 	t.Run("UploadCustomCertificate", testuploadCustomCertificate)
 
 }
+
+func TestCertificateServiceFixtures(t *testing.T) {
+	response, err := readData("generated/CertificateService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("UploadCustomCertificate", func(t *testing.T) {
+		defer server.checkCommands(t, "uploadCustomCertificate", "queryAsyncJobResult")
+
+		p := client.Certificate.NewUploadCustomCertificateParams("certificate", "domainsuffix")
+		p.SetId(1)
+		p.SetName("name")
+		p.SetPrivatekey("privatekey")
+
+		expected := url.Values{
+			"certificate":  {"certificate"},
+			"domainsuffix": {"domainsuffix"},
+			"id":           {"1"},
+			"name":         {"name"},
+			"privatekey":   {"privatekey"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Certificate.UploadCustomCertificate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
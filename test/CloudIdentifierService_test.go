@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -48,3 +50,33 @@ func TestCloudIdentifierService(t *testing.T) {
 	t.Run("GetCloudIdentifier", testgetCloudIdentifier)
 
 }
+
+func TestCloudIdentifierServiceFixtures(t *testing.T) {
+	response, err := readData("generated/CloudIdentifierService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("GetCloudIdentifier", func(t *testing.T) {
+		defer server.checkCommands(t, "getCloudIdentifier")
+
+		p := client.CloudIdentifier.NewGetCloudIdentifierParams("userid")
+
+		expected := url.Values{
+			"userid": {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.CloudIdentifier.GetCloudIdentifier(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
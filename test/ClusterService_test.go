@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -189,3 +191,372 @@ func TestClusterService(t *testing.T) {
 	t.Run("UpdateCluster", testupdateCluster)
 
 }
+
+func TestClusterServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ClusterService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "addCluster")
+
+		p := client.Cluster.NewAddClusterParams("clustername", "clustertype", "hypervisor", "podid", "zoneid")
+		p.SetAllocationstate("allocationstate")
+		p.SetGuestvswitchname("guestvswitchname")
+		p.SetGuestvswitchtype("guestvswitchtype")
+		p.SetOvm3cluster("ovm3cluster")
+		p.SetOvm3pool("ovm3pool")
+		p.SetOvm3vip("ovm3vip")
+		p.SetPassword("password")
+		p.SetPublicvswitchname("publicvswitchname")
+		p.SetPublicvswitchtype("publicvswitchtype")
+		p.SetUrl("url")
+		p.SetUsername("username")
+		p.SetVsmipaddress("vsmipaddress")
+		p.SetVsmpassword("vsmpassword")
+		p.SetVsmusername("vsmusername")
+
+		expected := url.Values{
+			"allocationstate":   {"allocationstate"},
+			"clustername":       {"clustername"},
+			"clustertype":       {"clustertype"},
+			"guestvswitchname":  {"guestvswitchname"},
+			"guestvswitchtype":  {"guestvswitchtype"},
+			"hypervisor":        {"hypervisor"},
+			"ovm3cluster":       {"ovm3cluster"},
+			"ovm3pool":          {"ovm3pool"},
+			"ovm3vip":           {"ovm3vip"},
+			"password":          {"password"},
+			"podid":             {"podid"},
+			"publicvswitchname": {"publicvswitchname"},
+			"publicvswitchtype": {"publicvswitchtype"},
+			"url":               {"url"},
+			"username":          {"username"},
+			"vsmipaddress":      {"vsmipaddress"},
+			"vsmpassword":       {"vsmpassword"},
+			"vsmusername":       {"vsmusername"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.AddCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "93eee6b8-64aa-d6fc-2c74-53862d7561a4" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DedicateCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "dedicateCluster", "queryAsyncJobResult")
+
+		p := client.Cluster.NewDedicateClusterParams("clusterid", "domainid")
+		p.SetAccount("account")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"clusterid": {"clusterid"},
+			"domainid":  {"domainid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.DedicateCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "a5a54fcf-8d37-2576-d1ed-bd4165c3e7ff" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteCluster")
+
+		p := client.Cluster.NewDeleteClusterParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.DeleteCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableOutOfBandManagementForCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "disableOutOfBandManagementForCluster", "queryAsyncJobResult")
+
+		p := client.Cluster.NewDisableOutOfBandManagementForClusterParams("clusterid")
+
+		expected := url.Values{
+			"clusterid": {"clusterid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.DisableOutOfBandManagementForCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("EnableOutOfBandManagementForCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "enableOutOfBandManagementForCluster", "queryAsyncJobResult")
+
+		p := client.Cluster.NewEnableOutOfBandManagementForClusterParams("clusterid")
+
+		expected := url.Values{
+			"clusterid": {"clusterid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.EnableOutOfBandManagementForCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("EnableHAForCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "enableHAForCluster", "queryAsyncJobResult")
+
+		p := client.Cluster.NewEnableHAForClusterParams("clusterid")
+
+		expected := url.Values{
+			"clusterid": {"clusterid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.EnableHAForCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableHAForCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "disableHAForCluster", "queryAsyncJobResult")
+
+		p := client.Cluster.NewDisableHAForClusterParams("clusterid")
+
+		expected := url.Values{
+			"clusterid": {"clusterid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.DisableHAForCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListClusters", func(t *testing.T) {
+		defer server.checkCommands(t, "listClusters")
+
+		p := client.Cluster.NewListClustersParams()
+		p.SetAllocationstate("allocationstate")
+		p.SetClustertype("clustertype")
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetManagedstate("managedstate")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetShowcapacities(true)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"clustertype":     {"clustertype"},
+			"hypervisor":      {"hypervisor"},
+			"id":              {"id"},
+			"keyword":         {"keyword"},
+			"managedstate":    {"managedstate"},
+			"name":            {"name"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+			"podid":           {"podid"},
+			"showcapacities":  {"true"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.ListClusters(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Clusters) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Clusters))
+		}
+		if r.Clusters[0].Id != "786b2dc5-9945-1756-7a47-28e5d8439ffd" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Clusters[0].Id)
+		}
+	})
+
+	t.Run("ListClustersMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listClustersMetrics")
+
+		p := client.Cluster.NewListClustersMetricsParams()
+		p.SetAllocationstate("allocationstate")
+		p.SetClustertype("clustertype")
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetManagedstate("managedstate")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetShowcapacities(true)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"clustertype":     {"clustertype"},
+			"hypervisor":      {"hypervisor"},
+			"id":              {"id"},
+			"keyword":         {"keyword"},
+			"managedstate":    {"managedstate"},
+			"name":            {"name"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+			"podid":           {"podid"},
+			"showcapacities":  {"true"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.ListClustersMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ClustersMetrics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ClustersMetrics))
+		}
+		if r.ClustersMetrics[0].Id != "812909a9-02da-7492-868d-2acdd6fec7cf" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.ClustersMetrics[0].Id)
+		}
+	})
+
+	t.Run("ListDedicatedClusters", func(t *testing.T) {
+		defer server.checkCommands(t, "listDedicatedClusters")
+
+		p := client.Cluster.NewListDedicatedClustersParams()
+		p.SetAccount("account")
+		p.SetAffinitygroupid("affinitygroupid")
+		p.SetClusterid("clusterid")
+		p.SetDomainid("domainid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"account":         {"account"},
+			"affinitygroupid": {"affinitygroupid"},
+			"clusterid":       {"clusterid"},
+			"domainid":        {"domainid"},
+			"keyword":         {"keyword"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.ListDedicatedClusters(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DedicatedClusters) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DedicatedClusters))
+		}
+	})
+
+	t.Run("ReleaseDedicatedCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "releaseDedicatedCluster", "queryAsyncJobResult")
+
+		p := client.Cluster.NewReleaseDedicatedClusterParams("clusterid")
+
+		expected := url.Values{
+			"clusterid": {"clusterid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.ReleaseDedicatedCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "updateCluster")
+
+		p := client.Cluster.NewUpdateClusterParams("id")
+		p.SetAllocationstate("allocationstate")
+		p.SetClustername("clustername")
+		p.SetClustertype("clustertype")
+		p.SetHypervisor("hypervisor")
+		p.SetManagedstate("managedstate")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"clustername":     {"clustername"},
+			"clustertype":     {"clustertype"},
+			"hypervisor":      {"hypervisor"},
+			"id":              {"id"},
+			"managedstate":    {"managedstate"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Cluster.UpdateCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "6a1e5a72-b190-d99a-3780-da4ff4bb22e3" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -96,3 +98,169 @@ func TestConfigurationService(t *testing.T) {
 	t.Run("ResetConfiguration", testresetConfiguration)
 
 }
+
+func TestConfigurationServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ConfigurationService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ListCapabilities", func(t *testing.T) {
+		defer server.checkCommands(t, "listCapabilities")
+
+		p := client.Configuration.NewListCapabilitiesParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Configuration.ListCapabilities(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListConfigurations", func(t *testing.T) {
+		defer server.checkCommands(t, "listConfigurations")
+
+		p := client.Configuration.NewListConfigurationsParams()
+		p.SetAccountid("accountid")
+		p.SetCategory("category")
+		p.SetClusterid("clusterid")
+		p.SetDomainid("domainid")
+		p.SetGroup("group")
+		p.SetImagestoreuuid("imagestoreuuid")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetParent("parent")
+		p.SetStorageid("storageid")
+		p.SetSubgroup("subgroup")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"accountid":      {"accountid"},
+			"category":       {"category"},
+			"clusterid":      {"clusterid"},
+			"domainid":       {"domainid"},
+			"group":          {"group"},
+			"imagestoreuuid": {"imagestoreuuid"},
+			"keyword":        {"keyword"},
+			"name":           {"name"},
+			"page":           {"1"},
+			"pagesize":       {"1"},
+			"parent":         {"parent"},
+			"storageid":      {"storageid"},
+			"subgroup":       {"subgroup"},
+			"zoneid":         {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Configuration.ListConfigurations(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Configurations) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Configurations))
+		}
+	})
+
+	t.Run("ListDeploymentPlanners", func(t *testing.T) {
+		defer server.checkCommands(t, "listDeploymentPlanners")
+
+		p := client.Configuration.NewListDeploymentPlannersParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Configuration.ListDeploymentPlanners(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DeploymentPlanners) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DeploymentPlanners))
+		}
+	})
+
+	t.Run("UpdateConfiguration", func(t *testing.T) {
+		defer server.checkCommands(t, "updateConfiguration")
+
+		p := client.Configuration.NewUpdateConfigurationParams("name")
+		p.SetAccountid("accountid")
+		p.SetClusterid("clusterid")
+		p.SetDomainid("domainid")
+		p.SetImagestoreuuid("imagestoreuuid")
+		p.SetStorageid("storageid")
+		p.SetValue("value")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"accountid":      {"accountid"},
+			"clusterid":      {"clusterid"},
+			"domainid":       {"domainid"},
+			"imagestoreuuid": {"imagestoreuuid"},
+			"name":           {"name"},
+			"storageid":      {"storageid"},
+			"value":          {"value"},
+			"zoneid":         {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Configuration.UpdateConfiguration(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ResetConfiguration", func(t *testing.T) {
+		defer server.checkCommands(t, "resetConfiguration")
+
+		p := client.Configuration.NewResetConfigurationParams("name")
+		p.SetAccountid("accountid")
+		p.SetClusterid("clusterid")
+		p.SetDomainid("domainid")
+		p.SetImagestoreid("imagestoreid")
+		p.SetStorageid("storageid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"accountid":    {"accountid"},
+			"clusterid":    {"clusterid"},
+			"domainid":     {"domainid"},
+			"imagestoreid": {"imagestoreid"},
+			"name":         {"name"},
+			"storageid":    {"storageid"},
+			"zoneid":       {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Configuration.ResetConfiguration(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
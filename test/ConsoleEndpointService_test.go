@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -48,3 +50,35 @@ func TestConsoleEndpointService(t *testing.T) {
 	t.Run("CreateConsoleEndpoint", testcreateConsoleEndpoint)
 
 }
+
+func TestConsoleEndpointServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ConsoleEndpointService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateConsoleEndpoint", func(t *testing.T) {
+		defer server.checkCommands(t, "createConsoleEndpoint")
+
+		p := client.ConsoleEndpoint.NewCreateConsoleEndpointParams("virtualmachineid")
+		p.SetToken("token")
+
+		expected := url.Values{
+			"token":            {"token"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ConsoleEndpoint.CreateConsoleEndpoint(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -90,3 +92,230 @@ func TestDiskOfferingService(t *testing.T) {
 	t.Run("UpdateDiskOffering", testupdateDiskOffering)
 
 }
+
+func TestDiskOfferingServiceFixtures(t *testing.T) {
+	response, err := readData("generated/DiskOfferingService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateDiskOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "createDiskOffering")
+
+		p := client.DiskOffering.NewCreateDiskOfferingParams("displaytext", "name")
+		p.SetBytesreadrate(2)
+		p.SetBytesreadratemax(2)
+		p.SetBytesreadratemaxlength(2)
+		p.SetByteswriterate(2)
+		p.SetByteswriteratemax(2)
+		p.SetByteswriteratemaxlength(2)
+		p.SetCachemode("cachemode")
+		p.SetCustomized(true)
+		p.SetCustomizediops(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDisksize(2)
+		p.SetDisksizestrictness(true)
+		p.SetDisplayoffering(true)
+		p.SetDomainid([]string{"domainid1", "domainid2"})
+		p.SetEncrypt(true)
+		p.SetHypervisorsnapshotreserve(1)
+		p.SetIopsreadrate(2)
+		p.SetIopsreadratemax(2)
+		p.SetIopsreadratemaxlength(2)
+		p.SetIopswriterate(2)
+		p.SetIopswriteratemax(2)
+		p.SetIopswriteratemaxlength(2)
+		p.SetMaxiops(2)
+		p.SetMiniops(2)
+		p.SetProvisioningtype("provisioningtype")
+		p.SetStoragepolicy("storagepolicy")
+		p.SetStoragetype("storagetype")
+		p.SetTags("tags")
+		p.SetZoneid([]string{"zoneid1", "zoneid2"})
+
+		expected := url.Values{
+			"bytesreadrate":             {"2"},
+			"bytesreadratemax":          {"2"},
+			"bytesreadratemaxlength":    {"2"},
+			"byteswriterate":            {"2"},
+			"byteswriteratemax":         {"2"},
+			"byteswriteratemaxlength":   {"2"},
+			"cachemode":                 {"cachemode"},
+			"customized":                {"true"},
+			"customizediops":            {"true"},
+			"details[0].key1":           {"value1"},
+			"details[1].key2":           {"value2"},
+			"disksize":                  {"2"},
+			"disksizestrictness":        {"true"},
+			"displayoffering":           {"true"},
+			"displaytext":               {"displaytext"},
+			"domainid":                  {"domainid1,domainid2"},
+			"encrypt":                   {"true"},
+			"hypervisorsnapshotreserve": {"1"},
+			"iopsreadrate":              {"2"},
+			"iopsreadratemax":           {"2"},
+			"iopsreadratemaxlength":     {"2"},
+			"iopswriterate":             {"2"},
+			"iopswriteratemax":          {"2"},
+			"iopswriteratemaxlength":    {"2"},
+			"maxiops":                   {"2"},
+			"miniops":                   {"2"},
+			"name":                      {"name"},
+			"provisioningtype":          {"provisioningtype"},
+			"storagepolicy":             {"storagepolicy"},
+			"storagetype":               {"storagetype"},
+			"tags":                      {"tags"},
+			"zoneid":                    {"zoneid1,zoneid2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.DiskOffering.CreateDiskOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "62bde813-3907-dbff-5842-da3f422db9dd" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteDiskOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteDiskOffering")
+
+		p := client.DiskOffering.NewDeleteDiskOfferingParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.DiskOffering.DeleteDiskOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListDiskOfferings", func(t *testing.T) {
+		defer server.checkCommands(t, "listDiskOfferings")
+
+		p := client.DiskOffering.NewListDiskOfferingsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetEncrypt(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetStorageid("storageid")
+		p.SetStoragetype("storagetype")
+		p.SetVolumeid("volumeid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"encrypt":     {"true"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"storageid":   {"storageid"},
+			"storagetype": {"storagetype"},
+			"volumeid":    {"volumeid"},
+			"zoneid":      {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.DiskOffering.ListDiskOfferings(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DiskOfferings) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DiskOfferings))
+		}
+		if r.DiskOfferings[0].Id != "b61ee278-596f-c2af-f064-d94428b1e3b6" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.DiskOfferings[0].Id)
+		}
+	})
+
+	t.Run("UpdateDiskOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "updateDiskOffering")
+
+		p := client.DiskOffering.NewUpdateDiskOfferingParams("id")
+		p.SetBytesreadrate(2)
+		p.SetBytesreadratemax(2)
+		p.SetBytesreadratemaxlength(2)
+		p.SetByteswriterate(2)
+		p.SetByteswriteratemax(2)
+		p.SetByteswriteratemaxlength(2)
+		p.SetCachemode("cachemode")
+		p.SetDisplayoffering(true)
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetIopsreadrate(2)
+		p.SetIopsreadratemax(2)
+		p.SetIopsreadratemaxlength(2)
+		p.SetIopswriterate(2)
+		p.SetIopswriteratemax(2)
+		p.SetIopswriteratemaxlength(2)
+		p.SetName("name")
+		p.SetSortkey(1)
+		p.SetTags("tags")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"bytesreadrate":           {"2"},
+			"bytesreadratemax":        {"2"},
+			"bytesreadratemaxlength":  {"2"},
+			"byteswriterate":          {"2"},
+			"byteswriteratemax":       {"2"},
+			"byteswriteratemaxlength": {"2"},
+			"cachemode":               {"cachemode"},
+			"displayoffering":         {"true"},
+			"displaytext":             {"displaytext"},
+			"domainid":                {"domainid"},
+			"id":                      {"id"},
+			"iopsreadrate":            {"2"},
+			"iopsreadratemax":         {"2"},
+			"iopsreadratemaxlength":   {"2"},
+			"iopswriterate":           {"2"},
+			"iopswriteratemax":        {"2"},
+			"iopswriteratemaxlength":  {"2"},
+			"name":                    {"name"},
+			"sortkey":                 {"1"},
+			"tags":                    {"tags"},
+			"zoneid":                  {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.DiskOffering.UpdateDiskOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7188d361-ab64-13ea-56c3-9a59b59c12b8" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -102,3 +104,169 @@ func TestDomainService(t *testing.T) {
 	t.Run("UpdateDomain", testupdateDomain)
 
 }
+
+func TestDomainServiceFixtures(t *testing.T) {
+	response, err := readData("generated/DomainService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateDomain", func(t *testing.T) {
+		defer server.checkCommands(t, "createDomain")
+
+		p := client.Domain.NewCreateDomainParams("name")
+		p.SetDomainid("domainid")
+		p.SetNetworkdomain("networkdomain")
+		p.SetParentdomainid("parentdomainid")
+
+		expected := url.Values{
+			"domainid":       {"domainid"},
+			"name":           {"name"},
+			"networkdomain":  {"networkdomain"},
+			"parentdomainid": {"parentdomainid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Domain.CreateDomain(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d65467ee-02ce-2601-aefc-82de44b6ca64" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteDomain", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteDomain", "queryAsyncJobResult")
+
+		p := client.Domain.NewDeleteDomainParams("id")
+		p.SetCleanup(true)
+
+		expected := url.Values{
+			"cleanup": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Domain.DeleteDomain(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListDomainChildren", func(t *testing.T) {
+		defer server.checkCommands(t, "listDomainChildren")
+
+		p := client.Domain.NewListDomainChildrenParams()
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowicon(true)
+
+		expected := url.Values{
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"showicon":    {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Domain.ListDomainChildren(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DomainChildren) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DomainChildren))
+		}
+		if r.DomainChildren[0].Id != "a3124443-9fdf-bcb4-d54e-4ada5a8e97dd" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.DomainChildren[0].Id)
+		}
+	})
+
+	t.Run("ListDomains", func(t *testing.T) {
+		defer server.checkCommands(t, "listDomains")
+
+		p := client.Domain.NewListDomainsParams()
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetLevel(1)
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowicon(true)
+
+		expected := url.Values{
+			"details":  {"details1,details2"},
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"level":    {"1"},
+			"listall":  {"true"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"showicon": {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Domain.ListDomains(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Domains) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Domains))
+		}
+		if r.Domains[0].Id != "16582237-7bf3-7031-1944-cd36ebba6a93" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Domains[0].Id)
+		}
+	})
+
+	t.Run("UpdateDomain", func(t *testing.T) {
+		defer server.checkCommands(t, "updateDomain")
+
+		p := client.Domain.NewUpdateDomainParams("id")
+		p.SetName("name")
+		p.SetNetworkdomain("networkdomain")
+
+		expected := url.Values{
+			"id":            {"id"},
+			"name":          {"name"},
+			"networkdomain": {"networkdomain"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Domain.UpdateDomain(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b3bff96a-a01c-fcc1-586f-0269c7f5abb9" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
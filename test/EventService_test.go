@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -84,3 +86,147 @@ func TestEventService(t *testing.T) {
 	t.Run("ListEvents", testlistEvents)
 
 }
+
+func TestEventServiceFixtures(t *testing.T) {
+	response, err := readData("generated/EventService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ArchiveEvents", func(t *testing.T) {
+		defer server.checkCommands(t, "archiveEvents")
+
+		p := client.Event.NewArchiveEventsParams()
+		p.SetEnddate("enddate")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetStartdate("startdate")
+		p.SetType("type")
+
+		expected := url.Values{
+			"enddate":   {"enddate"},
+			"ids":       {"ids1,ids2"},
+			"startdate": {"startdate"},
+			"type":      {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Event.ArchiveEvents(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteEvents", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteEvents")
+
+		p := client.Event.NewDeleteEventsParams()
+		p.SetEnddate("enddate")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetStartdate("startdate")
+		p.SetType("type")
+
+		expected := url.Values{
+			"enddate":   {"enddate"},
+			"ids":       {"ids1,ids2"},
+			"startdate": {"startdate"},
+			"type":      {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Event.DeleteEvents(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListEventTypes", func(t *testing.T) {
+		defer server.checkCommands(t, "listEventTypes")
+
+		p := client.Event.NewListEventTypesParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Event.ListEventTypes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.EventTypes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.EventTypes))
+		}
+	})
+
+	t.Run("ListEvents", func(t *testing.T) {
+		defer server.checkCommands(t, "listEvents")
+
+		p := client.Event.NewListEventsParams()
+		p.SetAccount("account")
+		p.SetArchived(true)
+		p.SetDomainid("domainid")
+		p.SetDuration(1)
+		p.SetEnddate("enddate")
+		p.SetEntrytime(1)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetLevel("level")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetResourceid("resourceid")
+		p.SetResourcetype("resourcetype")
+		p.SetStartdate("startdate")
+		p.SetStartid("startid")
+		p.SetType("type")
+
+		expected := url.Values{
+			"account":      {"account"},
+			"archived":     {"true"},
+			"domainid":     {"domainid"},
+			"duration":     {"1"},
+			"enddate":      {"enddate"},
+			"entrytime":    {"1"},
+			"id":           {"id"},
+			"isrecursive":  {"true"},
+			"keyword":      {"keyword"},
+			"level":        {"level"},
+			"listall":      {"true"},
+			"page":         {"1"},
+			"pagesize":     {"1"},
+			"projectid":    {"projectid"},
+			"resourceid":   {"resourceid"},
+			"resourcetype": {"resourcetype"},
+			"startdate":    {"startdate"},
+			"startid":      {"startid"},
+			"type":         {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Event.ListEvents(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Events) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Events))
+		}
+	})
+
+}
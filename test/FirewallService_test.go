@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -300,3 +302,662 @@ func TestFirewallService(t *testing.T) {
 	t.Run("DeleteIpv6FirewallRule", testdeleteIpv6FirewallRule)
 
 }
+
+func TestFirewallServiceFixtures(t *testing.T) {
+	response, err := readData("generated/FirewallService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddPaloAltoFirewall", func(t *testing.T) {
+		defer server.checkCommands(t, "addPaloAltoFirewall", "queryAsyncJobResult")
+
+		p := client.Firewall.NewAddPaloAltoFirewallParams("networkdevicetype", "password", "physicalnetworkid", "url", "username")
+
+		expected := url.Values{
+			"networkdevicetype": {"networkdevicetype"},
+			"password":          {"password"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"url":               {"url"},
+			"username":          {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.AddPaloAltoFirewall(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ConfigurePaloAltoFirewall", func(t *testing.T) {
+		defer server.checkCommands(t, "configurePaloAltoFirewall", "queryAsyncJobResult")
+
+		p := client.Firewall.NewConfigurePaloAltoFirewallParams("fwdeviceid")
+		p.SetFwdevicecapacity(2)
+
+		expected := url.Values{
+			"fwdevicecapacity": {"2"},
+			"fwdeviceid":       {"fwdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.ConfigurePaloAltoFirewall(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("CreateEgressFirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "createEgressFirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewCreateEgressFirewallRuleParams("networkid", "protocol")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetDestcidrlist([]string{"destcidrlist1", "destcidrlist2"})
+		p.SetEndport(1)
+		p.SetFordisplay(true)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetStartport(1)
+		p.SetType("type")
+
+		expected := url.Values{
+			"cidrlist":     {"cidrlist1,cidrlist2"},
+			"destcidrlist": {"destcidrlist1,destcidrlist2"},
+			"endport":      {"1"},
+			"fordisplay":   {"true"},
+			"icmpcode":     {"1"},
+			"icmptype":     {"1"},
+			"networkid":    {"networkid"},
+			"protocol":     {"protocol"},
+			"startport":    {"1"},
+			"type":         {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.CreateEgressFirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "3e246720-644e-6c8d-af0f-e158841fbf7d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateFirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "createFirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewCreateFirewallRuleParams("ipaddressid", "protocol")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetEndport(1)
+		p.SetFordisplay(true)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetStartport(1)
+		p.SetType("type")
+
+		expected := url.Values{
+			"cidrlist":    {"cidrlist1,cidrlist2"},
+			"endport":     {"1"},
+			"fordisplay":  {"true"},
+			"icmpcode":    {"1"},
+			"icmptype":    {"1"},
+			"ipaddressid": {"ipaddressid"},
+			"protocol":    {"protocol"},
+			"startport":   {"1"},
+			"type":        {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.CreateFirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cecbeff1-4ab5-9353-6994-3170fcf5b3fe" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreatePortForwardingRule", func(t *testing.T) {
+		defer server.checkCommands(t, "createPortForwardingRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewCreatePortForwardingRuleParams("ipaddressid", 1, "protocol", 1, "virtualmachineid")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetFordisplay(true)
+		p.SetNetworkid("networkid")
+		p.SetOpenfirewall(true)
+		p.SetPrivateendport(1)
+		p.SetPublicendport(1)
+		p.SetVmguestip("vmguestip")
+
+		expected := url.Values{
+			"cidrlist":         {"cidrlist1,cidrlist2"},
+			"fordisplay":       {"true"},
+			"ipaddressid":      {"ipaddressid"},
+			"networkid":        {"networkid"},
+			"openfirewall":     {"true"},
+			"privateendport":   {"1"},
+			"privateport":      {"1"},
+			"protocol":         {"protocol"},
+			"publicendport":    {"1"},
+			"publicport":       {"1"},
+			"virtualmachineid": {"virtualmachineid"},
+			"vmguestip":        {"vmguestip"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.CreatePortForwardingRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "45fe20bf-daf1-9fa6-8be8-247643c983bb" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteEgressFirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteEgressFirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewDeleteEgressFirewallRuleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.DeleteEgressFirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteFirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteFirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewDeleteFirewallRuleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.DeleteFirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeletePaloAltoFirewall", func(t *testing.T) {
+		defer server.checkCommands(t, "deletePaloAltoFirewall", "queryAsyncJobResult")
+
+		p := client.Firewall.NewDeletePaloAltoFirewallParams("fwdeviceid")
+
+		expected := url.Values{
+			"fwdeviceid": {"fwdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.DeletePaloAltoFirewall(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeletePortForwardingRule", func(t *testing.T) {
+		defer server.checkCommands(t, "deletePortForwardingRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewDeletePortForwardingRuleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.DeletePortForwardingRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListEgressFirewallRules", func(t *testing.T) {
+		defer server.checkCommands(t, "listEgressFirewallRules")
+
+		p := client.Firewall.NewListEgressFirewallRulesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIpaddressid("ipaddressid")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"fordisplay":    {"true"},
+			"id":            {"id"},
+			"ipaddressid":   {"ipaddressid"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"networkid":     {"networkid"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.ListEgressFirewallRules(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.EgressFirewallRules) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.EgressFirewallRules))
+		}
+	})
+
+	t.Run("ListFirewallRules", func(t *testing.T) {
+		defer server.checkCommands(t, "listFirewallRules")
+
+		p := client.Firewall.NewListFirewallRulesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIpaddressid("ipaddressid")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"fordisplay":    {"true"},
+			"id":            {"id"},
+			"ipaddressid":   {"ipaddressid"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"networkid":     {"networkid"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.ListFirewallRules(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.FirewallRules) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.FirewallRules))
+		}
+	})
+
+	t.Run("ListPaloAltoFirewalls", func(t *testing.T) {
+		defer server.checkCommands(t, "listPaloAltoFirewalls")
+
+		p := client.Firewall.NewListPaloAltoFirewallsParams()
+		p.SetFwdeviceid("fwdeviceid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+
+		expected := url.Values{
+			"fwdeviceid":        {"fwdeviceid"},
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.ListPaloAltoFirewalls(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.PaloAltoFirewalls) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.PaloAltoFirewalls))
+		}
+	})
+
+	t.Run("ListPortForwardingRules", func(t *testing.T) {
+		defer server.checkCommands(t, "listPortForwardingRules")
+
+		p := client.Firewall.NewListPortForwardingRulesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIpaddressid("ipaddressid")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"fordisplay":    {"true"},
+			"id":            {"id"},
+			"ipaddressid":   {"ipaddressid"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"networkid":     {"networkid"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.ListPortForwardingRules(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.PortForwardingRules) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.PortForwardingRules))
+		}
+	})
+
+	t.Run("UpdateEgressFirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "updateEgressFirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewUpdateEgressFirewallRuleParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.UpdateEgressFirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d855039a-32dd-82bc-3375-44536dd902a7" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateFirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "updateFirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewUpdateFirewallRuleParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.UpdateFirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "2c3ae785-2c57-49e7-b239-9f5002bcd231" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdatePortForwardingRule", func(t *testing.T) {
+		defer server.checkCommands(t, "updatePortForwardingRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewUpdatePortForwardingRuleParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+		p.SetPrivateendport(1)
+		p.SetPrivateport(1)
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetVmguestip("vmguestip")
+
+		expected := url.Values{
+			"customid":         {"customid"},
+			"fordisplay":       {"true"},
+			"id":               {"id"},
+			"privateendport":   {"1"},
+			"privateport":      {"1"},
+			"virtualmachineid": {"virtualmachineid"},
+			"vmguestip":        {"vmguestip"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.UpdatePortForwardingRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "ee233be0-d637-37a2-edb3-a45714f64848" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListIpv6FirewallRules", func(t *testing.T) {
+		defer server.checkCommands(t, "listIpv6FirewallRules")
+
+		p := client.Firewall.NewListIpv6FirewallRulesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetTraffictype("traffictype")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"fordisplay":    {"true"},
+			"id":            {"id"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"networkid":     {"networkid"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"traffictype":   {"traffictype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.ListIpv6FirewallRules(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Ipv6FirewallRules) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Ipv6FirewallRules))
+		}
+	})
+
+	t.Run("CreateIpv6FirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "createIpv6FirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewCreateIpv6FirewallRuleParams("networkid", "protocol")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetDestcidrlist([]string{"destcidrlist1", "destcidrlist2"})
+		p.SetEndport(1)
+		p.SetFordisplay(true)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetStartport(1)
+		p.SetTraffictype("traffictype")
+
+		expected := url.Values{
+			"cidrlist":     {"cidrlist1,cidrlist2"},
+			"destcidrlist": {"destcidrlist1,destcidrlist2"},
+			"endport":      {"1"},
+			"fordisplay":   {"true"},
+			"icmpcode":     {"1"},
+			"icmptype":     {"1"},
+			"networkid":    {"networkid"},
+			"protocol":     {"protocol"},
+			"startport":    {"1"},
+			"traffictype":  {"traffictype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.CreateIpv6FirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c7992665-132b-fe56-4f6e-cb5509663b95" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateIpv6FirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "updateIpv6FirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewUpdateIpv6FirewallRuleParams("id")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetCustomid("customid")
+		p.SetEndport(1)
+		p.SetFordisplay(true)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetProtocol("protocol")
+		p.SetStartport(1)
+		p.SetTraffictype("traffictype")
+
+		expected := url.Values{
+			"cidrlist":    {"cidrlist1,cidrlist2"},
+			"customid":    {"customid"},
+			"endport":     {"1"},
+			"fordisplay":  {"true"},
+			"icmpcode":    {"1"},
+			"icmptype":    {"1"},
+			"id":          {"id"},
+			"protocol":    {"protocol"},
+			"startport":   {"1"},
+			"traffictype": {"traffictype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.UpdateIpv6FirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b74444d7-7ee2-8d2b-9e2c-a4b78cb38002" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteIpv6FirewallRule", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteIpv6FirewallRule", "queryAsyncJobResult")
+
+		p := client.Firewall.NewDeleteIpv6FirewallRuleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Firewall.DeleteIpv6FirewallRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -156,3 +158,279 @@ func TestGuestOSService(t *testing.T) {
 	t.Run("UpdateGuestOsMapping", testupdateGuestOsMapping)
 
 }
+
+func TestGuestOSServiceFixtures(t *testing.T) {
+	response, err := readData("generated/GuestOSService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddGuestOs", func(t *testing.T) {
+		defer server.checkCommands(t, "addGuestOs", "queryAsyncJobResult")
+
+		p := client.GuestOS.NewAddGuestOsParams("oscategoryid", "osdisplayname")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetForDisplay(true)
+		p.SetName("name")
+
+		expected := url.Values{
+			"details[0].key":   {"key1"},
+			"details[0].value": {"value1"},
+			"details[1].key":   {"key2"},
+			"details[1].value": {"value2"},
+			"forDisplay":       {"true"},
+			"name":             {"name"},
+			"oscategoryid":     {"oscategoryid"},
+			"osdisplayname":    {"osdisplayname"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.AddGuestOs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "6f20e8a5-208a-bdcf-8d9e-0fa485003ac7" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddGuestOsMapping", func(t *testing.T) {
+		defer server.checkCommands(t, "addGuestOsMapping", "queryAsyncJobResult")
+
+		p := client.GuestOS.NewAddGuestOsMappingParams("hypervisor", "hypervisorversion", "osnameforhypervisor")
+		p.SetForced(true)
+		p.SetOsdisplayname("osdisplayname")
+		p.SetOsmappingcheckenabled(true)
+		p.SetOstypeid("ostypeid")
+
+		expected := url.Values{
+			"forced":                {"true"},
+			"hypervisor":            {"hypervisor"},
+			"hypervisorversion":     {"hypervisorversion"},
+			"osdisplayname":         {"osdisplayname"},
+			"osmappingcheckenabled": {"true"},
+			"osnameforhypervisor":   {"osnameforhypervisor"},
+			"ostypeid":              {"ostypeid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.AddGuestOsMapping(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b587bdb8-e133-ab85-93c8-4e3052ccb8db" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListGuestOsMapping", func(t *testing.T) {
+		defer server.checkCommands(t, "listGuestOsMapping")
+
+		p := client.GuestOS.NewListGuestOsMappingParams()
+		p.SetHypervisor("hypervisor")
+		p.SetHypervisorversion("hypervisorversion")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetOsdisplayname("osdisplayname")
+		p.SetOsnameforhypervisor("osnameforhypervisor")
+		p.SetOstypeid("ostypeid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"hypervisor":          {"hypervisor"},
+			"hypervisorversion":   {"hypervisorversion"},
+			"id":                  {"id"},
+			"keyword":             {"keyword"},
+			"osdisplayname":       {"osdisplayname"},
+			"osnameforhypervisor": {"osnameforhypervisor"},
+			"ostypeid":            {"ostypeid"},
+			"page":                {"1"},
+			"pagesize":            {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.ListGuestOsMapping(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.GuestOsMapping) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.GuestOsMapping))
+		}
+	})
+
+	t.Run("ListOsCategories", func(t *testing.T) {
+		defer server.checkCommands(t, "listOsCategories")
+
+		p := client.GuestOS.NewListOsCategoriesParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.ListOsCategories(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.OsCategories) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.OsCategories))
+		}
+		if r.OsCategories[0].Id != "950ad8bc-7255-435b-9374-550eabbbbd4d" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.OsCategories[0].Id)
+		}
+	})
+
+	t.Run("ListOsTypes", func(t *testing.T) {
+		defer server.checkCommands(t, "listOsTypes")
+
+		p := client.GuestOS.NewListOsTypesParams()
+		p.SetDescription("description")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetOscategoryid("oscategoryid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"description":  {"description"},
+			"fordisplay":   {"true"},
+			"id":           {"id"},
+			"keyword":      {"keyword"},
+			"oscategoryid": {"oscategoryid"},
+			"page":         {"1"},
+			"pagesize":     {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.ListOsTypes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.OsTypes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.OsTypes))
+		}
+		if r.OsTypes[0].Id != "fd3ba0e2-4afa-afc8-463a-b9c2f345c0e0" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.OsTypes[0].Id)
+		}
+	})
+
+	t.Run("RemoveGuestOs", func(t *testing.T) {
+		defer server.checkCommands(t, "removeGuestOs", "queryAsyncJobResult")
+
+		p := client.GuestOS.NewRemoveGuestOsParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.RemoveGuestOs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RemoveGuestOsMapping", func(t *testing.T) {
+		defer server.checkCommands(t, "removeGuestOsMapping", "queryAsyncJobResult")
+
+		p := client.GuestOS.NewRemoveGuestOsMappingParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.RemoveGuestOsMapping(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateGuestOs", func(t *testing.T) {
+		defer server.checkCommands(t, "updateGuestOs", "queryAsyncJobResult")
+
+		p := client.GuestOS.NewUpdateGuestOsParams("id", "osdisplayname")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetForDisplay(true)
+
+		expected := url.Values{
+			"details[0].key":   {"key1"},
+			"details[0].value": {"value1"},
+			"details[1].key":   {"key2"},
+			"details[1].value": {"value2"},
+			"forDisplay":       {"true"},
+			"id":               {"id"},
+			"osdisplayname":    {"osdisplayname"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.UpdateGuestOs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0043c1b8-d465-e192-9dcc-e6ba9c2fcef7" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateGuestOsMapping", func(t *testing.T) {
+		defer server.checkCommands(t, "updateGuestOsMapping", "queryAsyncJobResult")
+
+		p := client.GuestOS.NewUpdateGuestOsMappingParams("id", "osnameforhypervisor")
+		p.SetOsmappingcheckenabled(true)
+
+		expected := url.Values{
+			"id":                    {"id"},
+			"osmappingcheckenabled": {"true"},
+			"osnameforhypervisor":   {"osnameforhypervisor"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.GuestOS.UpdateGuestOsMapping(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8dc1ab1b-8507-4e3d-0aaf-236cc3011e7d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
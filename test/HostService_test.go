@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -324,3 +326,636 @@ func TestHostService(t *testing.T) {
 	t.Run("UpdateHostPassword", testupdateHostPassword)
 
 }
+
+func TestHostServiceFixtures(t *testing.T) {
+	response, err := readData("generated/HostService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddBaremetalHost", func(t *testing.T) {
+		defer server.checkCommands(t, "addBaremetalHost")
+
+		p := client.Host.NewAddBaremetalHostParams("hypervisor", "podid", "url", "zoneid")
+		p.SetAllocationstate("allocationstate")
+		p.SetClusterid("clusterid")
+		p.SetClustername("clustername")
+		p.SetHosttags([]string{"hosttags1", "hosttags2"})
+		p.SetIpaddress("ipaddress")
+		p.SetPassword("password")
+		p.SetUsername("username")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"clusterid":       {"clusterid"},
+			"clustername":     {"clustername"},
+			"hosttags":        {"hosttags1,hosttags2"},
+			"hypervisor":      {"hypervisor"},
+			"ipaddress":       {"ipaddress"},
+			"password":        {"password"},
+			"podid":           {"podid"},
+			"url":             {"url"},
+			"username":        {"username"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.AddBaremetalHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "42b5602e-0b64-e2bc-42a0-fd6c14d96c6f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddGloboDnsHost", func(t *testing.T) {
+		defer server.checkCommands(t, "addGloboDnsHost", "queryAsyncJobResult")
+
+		p := client.Host.NewAddGloboDnsHostParams("password", "physicalnetworkid", "url", "username")
+
+		expected := url.Values{
+			"password":          {"password"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"url":               {"url"},
+			"username":          {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.AddGloboDnsHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("AddHost", func(t *testing.T) {
+		defer server.checkCommands(t, "addHost")
+
+		p := client.Host.NewAddHostParams("hypervisor", "podid", "url", "zoneid")
+		p.SetAllocationstate("allocationstate")
+		p.SetClusterid("clusterid")
+		p.SetClustername("clustername")
+		p.SetHosttags([]string{"hosttags1", "hosttags2"})
+		p.SetPassword("password")
+		p.SetUsername("username")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"clusterid":       {"clusterid"},
+			"clustername":     {"clustername"},
+			"hosttags":        {"hosttags1,hosttags2"},
+			"hypervisor":      {"hypervisor"},
+			"password":        {"password"},
+			"podid":           {"podid"},
+			"url":             {"url"},
+			"username":        {"username"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.AddHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cc170c06-3aed-d396-34be-128284985671" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddSecondaryStorage", func(t *testing.T) {
+		defer server.checkCommands(t, "addSecondaryStorage")
+
+		p := client.Host.NewAddSecondaryStorageParams("url")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"url":    {"url"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.AddSecondaryStorage(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "85df9581-cd3d-c815-2bbb-4d62f69525a8" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CancelHostMaintenance", func(t *testing.T) {
+		defer server.checkCommands(t, "cancelHostMaintenance", "queryAsyncJobResult")
+
+		p := client.Host.NewCancelHostMaintenanceParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.CancelHostMaintenance(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f3803e04-7bb4-785e-f2cb-c30213168516" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ConfigureHAForHost", func(t *testing.T) {
+		defer server.checkCommands(t, "configureHAForHost", "queryAsyncJobResult")
+
+		p := client.Host.NewConfigureHAForHostParams("hostid", "provider")
+
+		expected := url.Values{
+			"hostid":   {"hostid"},
+			"provider": {"provider"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ConfigureHAForHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("EnableHAForHost", func(t *testing.T) {
+		defer server.checkCommands(t, "enableHAForHost", "queryAsyncJobResult")
+
+		p := client.Host.NewEnableHAForHostParams("hostid")
+
+		expected := url.Values{
+			"hostid": {"hostid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.EnableHAForHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DedicateHost", func(t *testing.T) {
+		defer server.checkCommands(t, "dedicateHost", "queryAsyncJobResult")
+
+		p := client.Host.NewDedicateHostParams("domainid", "hostid")
+		p.SetAccount("account")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"hostid":   {"hostid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.DedicateHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "4557d946-526e-a786-f41a-55930c402583" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteHost", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteHost")
+
+		p := client.Host.NewDeleteHostParams("id")
+		p.SetForced(true)
+		p.SetForcedestroylocalstorage(true)
+
+		expected := url.Values{
+			"forced":                   {"true"},
+			"forcedestroylocalstorage": {"true"},
+			"id":                       {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.DeleteHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableOutOfBandManagementForHost", func(t *testing.T) {
+		defer server.checkCommands(t, "disableOutOfBandManagementForHost", "queryAsyncJobResult")
+
+		p := client.Host.NewDisableOutOfBandManagementForHostParams("hostid")
+
+		expected := url.Values{
+			"hostid": {"hostid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.DisableOutOfBandManagementForHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("EnableOutOfBandManagementForHost", func(t *testing.T) {
+		defer server.checkCommands(t, "enableOutOfBandManagementForHost", "queryAsyncJobResult")
+
+		p := client.Host.NewEnableOutOfBandManagementForHostParams("hostid")
+
+		expected := url.Values{
+			"hostid": {"hostid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.EnableOutOfBandManagementForHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("FindHostsForMigration", func(t *testing.T) {
+		defer server.checkCommands(t, "findHostsForMigration")
+
+		p := client.Host.NewFindHostsForMigrationParams("virtualmachineid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":          {"keyword"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.FindHostsForMigration(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Host) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Host))
+		}
+		if r.Host[0].Id != "45188c3a-9b44-f63e-f7b4-47e80a729a07" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Host[0].Id)
+		}
+	})
+
+	t.Run("ListDedicatedHosts", func(t *testing.T) {
+		defer server.checkCommands(t, "listDedicatedHosts")
+
+		p := client.Host.NewListDedicatedHostsParams()
+		p.SetAccount("account")
+		p.SetAffinitygroupid("affinitygroupid")
+		p.SetDomainid("domainid")
+		p.SetHostid("hostid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"account":         {"account"},
+			"affinitygroupid": {"affinitygroupid"},
+			"domainid":        {"domainid"},
+			"hostid":          {"hostid"},
+			"keyword":         {"keyword"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ListDedicatedHosts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DedicatedHosts) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DedicatedHosts))
+		}
+	})
+
+	t.Run("ListHostTags", func(t *testing.T) {
+		defer server.checkCommands(t, "listHostTags")
+
+		p := client.Host.NewListHostTagsParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ListHostTags(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.HostTags) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.HostTags))
+		}
+		if r.HostTags[0].Id != "b8895be1-570d-21e5-a9e2-8f4ef20ad7f0" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.HostTags[0].Id)
+		}
+	})
+
+	t.Run("ListHosts", func(t *testing.T) {
+		defer server.checkCommands(t, "listHosts")
+
+		p := client.Host.NewListHostsParams()
+		p.SetClusterid("clusterid")
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetHahost(true)
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetOutofbandmanagementenabled(true)
+		p.SetOutofbandmanagementpowerstate("outofbandmanagementpowerstate")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetResourcestate("resourcestate")
+		p.SetState("state")
+		p.SetType("type")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"clusterid":                     {"clusterid"},
+			"details":                       {"details1,details2"},
+			"hahost":                        {"true"},
+			"hypervisor":                    {"hypervisor"},
+			"id":                            {"id"},
+			"keyword":                       {"keyword"},
+			"name":                          {"name"},
+			"outofbandmanagementenabled":    {"true"},
+			"outofbandmanagementpowerstate": {"outofbandmanagementpowerstate"},
+			"page":                          {"1"},
+			"pagesize":                      {"1"},
+			"podid":                         {"podid"},
+			"resourcestate":                 {"resourcestate"},
+			"state":                         {"state"},
+			"type":                          {"type"},
+			"virtualmachineid":              {"virtualmachineid"},
+			"zoneid":                        {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ListHosts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Hosts) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Hosts))
+		}
+		if r.Hosts[0].Id != "f125a787-0b1a-b24b-041c-d5ee05a0779c" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Hosts[0].Id)
+		}
+	})
+
+	t.Run("ListHostsMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listHostsMetrics")
+
+		p := client.Host.NewListHostsMetricsParams()
+		p.SetClusterid("clusterid")
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetHahost(true)
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetOutofbandmanagementenabled(true)
+		p.SetOutofbandmanagementpowerstate("outofbandmanagementpowerstate")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetResourcestate("resourcestate")
+		p.SetState("state")
+		p.SetType("type")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"clusterid":                     {"clusterid"},
+			"details":                       {"details1,details2"},
+			"hahost":                        {"true"},
+			"hypervisor":                    {"hypervisor"},
+			"id":                            {"id"},
+			"keyword":                       {"keyword"},
+			"name":                          {"name"},
+			"outofbandmanagementenabled":    {"true"},
+			"outofbandmanagementpowerstate": {"outofbandmanagementpowerstate"},
+			"page":                          {"1"},
+			"pagesize":                      {"1"},
+			"podid":                         {"podid"},
+			"resourcestate":                 {"resourcestate"},
+			"state":                         {"state"},
+			"type":                          {"type"},
+			"virtualmachineid":              {"virtualmachineid"},
+			"zoneid":                        {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ListHostsMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.HostsMetrics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.HostsMetrics))
+		}
+		if r.HostsMetrics[0].Id != "62d8878a-aa72-935b-d595-fdaf3bf698df" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.HostsMetrics[0].Id)
+		}
+	})
+
+	t.Run("PrepareHostForMaintenance", func(t *testing.T) {
+		defer server.checkCommands(t, "prepareHostForMaintenance", "queryAsyncJobResult")
+
+		p := client.Host.NewPrepareHostForMaintenanceParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.PrepareHostForMaintenance(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "4093013b-3ca5-103a-3701-87aafe8d1ebe" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ReconnectHost", func(t *testing.T) {
+		defer server.checkCommands(t, "reconnectHost", "queryAsyncJobResult")
+
+		p := client.Host.NewReconnectHostParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ReconnectHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "1abdd89f-1e81-e96f-87f3-003c0ce251d5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ReleaseDedicatedHost", func(t *testing.T) {
+		defer server.checkCommands(t, "releaseDedicatedHost", "queryAsyncJobResult")
+
+		p := client.Host.NewReleaseDedicatedHostParams("hostid")
+
+		expected := url.Values{
+			"hostid": {"hostid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ReleaseDedicatedHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ReleaseHostReservation", func(t *testing.T) {
+		defer server.checkCommands(t, "releaseHostReservation", "queryAsyncJobResult")
+
+		p := client.Host.NewReleaseHostReservationParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.ReleaseHostReservation(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateHost", func(t *testing.T) {
+		defer server.checkCommands(t, "updateHost")
+
+		p := client.Host.NewUpdateHostParams("id")
+		p.SetAllocationstate("allocationstate")
+		p.SetAnnotation("annotation")
+		p.SetHosttags([]string{"hosttags1", "hosttags2"})
+		p.SetIstagarule(true)
+		p.SetName("name")
+		p.SetOscategoryid("oscategoryid")
+		p.SetUrl("url")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"annotation":      {"annotation"},
+			"hosttags":        {"hosttags1,hosttags2"},
+			"id":              {"id"},
+			"istagarule":      {"true"},
+			"name":            {"name"},
+			"oscategoryid":    {"oscategoryid"},
+			"url":             {"url"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.UpdateHost(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "55eb7dd4-0288-98b7-2295-0555f4a36a51" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateHostPassword", func(t *testing.T) {
+		defer server.checkCommands(t, "updateHostPassword")
+
+		p := client.Host.NewUpdateHostPasswordParams("password", "username")
+		p.SetClusterid("clusterid")
+		p.SetHostid("hostid")
+		p.SetUpdate_passwd_on_host(true)
+
+		expected := url.Values{
+			"clusterid":             {"clusterid"},
+			"hostid":                {"hostid"},
+			"password":              {"password"},
+			"update_passwd_on_host": {"true"},
+			"username":              {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Host.UpdateHostPassword(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -75,3 +77,100 @@ func TestHypervisorService(t *testing.T) {
 	t.Run("UpdateHypervisorCapabilities", testupdateHypervisorCapabilities)
 
 }
+
+func TestHypervisorServiceFixtures(t *testing.T) {
+	response, err := readData("generated/HypervisorService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ListHypervisorCapabilities", func(t *testing.T) {
+		defer server.checkCommands(t, "listHypervisorCapabilities")
+
+		p := client.Hypervisor.NewListHypervisorCapabilitiesParams()
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"hypervisor": {"hypervisor"},
+			"id":         {"id"},
+			"keyword":    {"keyword"},
+			"page":       {"1"},
+			"pagesize":   {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Hypervisor.ListHypervisorCapabilities(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.HypervisorCapabilities) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.HypervisorCapabilities))
+		}
+	})
+
+	t.Run("ListHypervisors", func(t *testing.T) {
+		defer server.checkCommands(t, "listHypervisors")
+
+		p := client.Hypervisor.NewListHypervisorsParams()
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Hypervisor.ListHypervisors(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Hypervisors) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Hypervisors))
+		}
+	})
+
+	t.Run("UpdateHypervisorCapabilities", func(t *testing.T) {
+		defer server.checkCommands(t, "updateHypervisorCapabilities")
+
+		p := client.Hypervisor.NewUpdateHypervisorCapabilitiesParams()
+		p.SetId("id")
+		p.SetMaxdatavolumeslimit(1)
+		p.SetMaxguestslimit(2)
+		p.SetMaxhostspercluster(1)
+		p.SetSecuritygroupenabled(true)
+		p.SetStoragemotionenabled(true)
+		p.SetVmsnapshotenabled(true)
+
+		expected := url.Values{
+			"id":                   {"id"},
+			"maxdatavolumeslimit":  {"1"},
+			"maxguestslimit":       {"2"},
+			"maxhostspercluster":   {"1"},
+			"securitygroupenabled": {"true"},
+			"storagemotionenabled": {"true"},
+			"vmsnapshotenabled":    {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Hypervisor.UpdateHypervisorCapabilities(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c388d210-017e-df06-8d16-f50dca90dbb6" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -174,3 +176,359 @@ func TestISOService(t *testing.T) {
 	t.Run("UpdateIsoPermissions", testupdateIsoPermissions)
 
 }
+
+func TestISOServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ISOService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AttachIso", func(t *testing.T) {
+		defer server.checkCommands(t, "attachIso", "queryAsyncJobResult")
+
+		p := client.ISO.NewAttachIsoParams("id", "virtualmachineid")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced":           {"true"},
+			"id":               {"id"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.AttachIso(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cf9bdc6a-2ceb-69ed-852c-b4928750ee93" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CopyIso", func(t *testing.T) {
+		defer server.checkCommands(t, "copyIso", "queryAsyncJobResult")
+
+		p := client.ISO.NewCopyIsoParams("id")
+		p.SetDestzoneid("destzoneid")
+		p.SetDestzoneids([]string{"destzoneids1", "destzoneids2"})
+		p.SetSourcezoneid("sourcezoneid")
+
+		expected := url.Values{
+			"destzoneid":   {"destzoneid"},
+			"destzoneids":  {"destzoneids1,destzoneids2"},
+			"id":           {"id"},
+			"sourcezoneid": {"sourcezoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.CopyIso(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "1cba8548-4332-13c4-abf4-de9f64f3c30f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteIso", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteIso", "queryAsyncJobResult")
+
+		p := client.ISO.NewDeleteIsoParams("id")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":     {"id"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.DeleteIso(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DetachIso", func(t *testing.T) {
+		defer server.checkCommands(t, "detachIso", "queryAsyncJobResult")
+
+		p := client.ISO.NewDetachIsoParams("virtualmachineid")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced":           {"true"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.DetachIso(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7c41093e-2f39-612b-be51-79e92252a7bb" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ExtractIso", func(t *testing.T) {
+		defer server.checkCommands(t, "extractIso", "queryAsyncJobResult")
+
+		p := client.ISO.NewExtractIsoParams("id", "mode")
+		p.SetUrl("url")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":     {"id"},
+			"mode":   {"mode"},
+			"url":    {"url"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.ExtractIso(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "603d75af-367a-5f1a-8095-9c414aa90018" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListIsoPermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "listIsoPermissions")
+
+		p := client.ISO.NewListIsoPermissionsParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.ListIsoPermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.IsoPermissions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.IsoPermissions))
+		}
+	})
+
+	t.Run("ListIsos", func(t *testing.T) {
+		defer server.checkCommands(t, "listIsos")
+
+		p := client.ISO.NewListIsosParams()
+		p.SetAccount("account")
+		p.SetBootable(true)
+		p.SetDomainid("domainid")
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetImagestoreid("imagestoreid")
+		p.SetIsofilter("isofilter")
+		p.SetIspublic(true)
+		p.SetIsready(true)
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetShowicon(true)
+		p.SetShowremoved(true)
+		p.SetShowunique(true)
+		p.SetStorageid("storageid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"bootable":      {"true"},
+			"domainid":      {"domainid"},
+			"hypervisor":    {"hypervisor"},
+			"id":            {"id"},
+			"imagestoreid":  {"imagestoreid"},
+			"isofilter":     {"isofilter"},
+			"ispublic":      {"true"},
+			"isready":       {"true"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"name":          {"name"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"showicon":      {"true"},
+			"showremoved":   {"true"},
+			"showunique":    {"true"},
+			"storageid":     {"storageid"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"zoneid":        {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.ListIsos(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Isos) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Isos))
+		}
+		if r.Isos[0].Id != "0be02d72-bbf5-e18d-0f65-b7d318d67efc" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Isos[0].Id)
+		}
+	})
+
+	t.Run("RegisterIso", func(t *testing.T) {
+		defer server.checkCommands(t, "registerIso")
+
+		p := client.ISO.NewRegisterIsoParams("displaytext", "name", "url", "zoneid")
+		p.SetAccount("account")
+		p.SetBootable(true)
+		p.SetChecksum("checksum")
+		p.SetDirectdownload(true)
+		p.SetDomainid("domainid")
+		p.SetImagestoreuuid("imagestoreuuid")
+		p.SetIsdynamicallyscalable(true)
+		p.SetIsextractable(true)
+		p.SetIsfeatured(true)
+		p.SetIspublic(true)
+		p.SetOstypeid("ostypeid")
+		p.SetPasswordenabled(true)
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":               {"account"},
+			"bootable":              {"true"},
+			"checksum":              {"checksum"},
+			"directdownload":        {"true"},
+			"displaytext":           {"displaytext"},
+			"domainid":              {"domainid"},
+			"imagestoreuuid":        {"imagestoreuuid"},
+			"isdynamicallyscalable": {"true"},
+			"isextractable":         {"true"},
+			"isfeatured":            {"true"},
+			"ispublic":              {"true"},
+			"name":                  {"name"},
+			"ostypeid":              {"ostypeid"},
+			"passwordenabled":       {"true"},
+			"projectid":             {"projectid"},
+			"url":                   {"url"},
+			"zoneid":                {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.RegisterIso(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "afb3b86b-530a-69af-2a2a-011e40eb2df6" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateIso", func(t *testing.T) {
+		defer server.checkCommands(t, "updateIso")
+
+		p := client.ISO.NewUpdateIsoParams("id")
+		p.SetBootable(true)
+		p.SetCleanupdetails(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDisplaytext("displaytext")
+		p.SetFormat("format")
+		p.SetIsdynamicallyscalable(true)
+		p.SetIsrouting(true)
+		p.SetName("name")
+		p.SetOstypeid("ostypeid")
+		p.SetPasswordenabled(true)
+		p.SetRequireshvm(true)
+		p.SetSortkey(1)
+		p.SetSshkeyenabled(true)
+
+		expected := url.Values{
+			"bootable":              {"true"},
+			"cleanupdetails":        {"true"},
+			"details[0].key1":       {"value1"},
+			"details[1].key2":       {"value2"},
+			"displaytext":           {"displaytext"},
+			"format":                {"format"},
+			"id":                    {"id"},
+			"isdynamicallyscalable": {"true"},
+			"isrouting":             {"true"},
+			"name":                  {"name"},
+			"ostypeid":              {"ostypeid"},
+			"passwordenabled":       {"true"},
+			"requireshvm":           {"true"},
+			"sortkey":               {"1"},
+			"sshkeyenabled":         {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.UpdateIso(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "a7623bf6-ff43-f883-7a39-af584a57cc5b" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateIsoPermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "updateIsoPermissions")
+
+		p := client.ISO.NewUpdateIsoPermissionsParams("id")
+		p.SetAccounts([]string{"accounts1", "accounts2"})
+		p.SetIsextractable(true)
+		p.SetIsfeatured(true)
+		p.SetIspublic(true)
+		p.SetOp("op")
+		p.SetProjectids([]string{"projectids1", "projectids2"})
+
+		expected := url.Values{
+			"accounts":      {"accounts1,accounts2"},
+			"id":            {"id"},
+			"isextractable": {"true"},
+			"isfeatured":    {"true"},
+			"ispublic":      {"true"},
+			"op":            {"op"},
+			"projectids":    {"projectids1,projectids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ISO.UpdateIsoPermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
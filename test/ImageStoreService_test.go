@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -144,3 +146,268 @@ func TestImageStoreService(t *testing.T) {
 	t.Run("UpdateCloudToUseObjectStore", testupdateCloudToUseObjectStore)
 
 }
+
+func TestImageStoreServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ImageStoreService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddImageStore", func(t *testing.T) {
+		defer server.checkCommands(t, "addImageStore")
+
+		p := client.ImageStore.NewAddImageStoreParams("provider")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetName("name")
+		p.SetUrl("url")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"details[0].key":   {"key1"},
+			"details[0].value": {"value1"},
+			"details[1].key":   {"key2"},
+			"details[1].value": {"value2"},
+			"name":             {"name"},
+			"provider":         {"provider"},
+			"url":              {"url"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.AddImageStore(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "faa856a0-4df9-60eb-1e7a-1b485ce9a96f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddImageStoreS3", func(t *testing.T) {
+		defer server.checkCommands(t, "addImageStoreS3")
+
+		p := client.ImageStore.NewAddImageStoreS3Params("accesskey", "bucket", "endpoint", "secretkey")
+		p.SetConnectiontimeout(1)
+		p.SetConnectionttl(1)
+		p.SetMaxerrorretry(1)
+		p.SetS3signer("s3signer")
+		p.SetSockettimeout(1)
+		p.SetUsehttps(true)
+		p.SetUsetcpkeepalive(true)
+
+		expected := url.Values{
+			"accesskey":         {"accesskey"},
+			"bucket":            {"bucket"},
+			"connectiontimeout": {"1"},
+			"connectionttl":     {"1"},
+			"endpoint":          {"endpoint"},
+			"maxerrorretry":     {"1"},
+			"s3signer":          {"s3signer"},
+			"secretkey":         {"secretkey"},
+			"sockettimeout":     {"1"},
+			"usehttps":          {"true"},
+			"usetcpkeepalive":   {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.AddImageStoreS3(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0d6c46af-3a6c-c3a9-26d6-958ca4251829" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateSecondaryStagingStore", func(t *testing.T) {
+		defer server.checkCommands(t, "createSecondaryStagingStore")
+
+		p := client.ImageStore.NewCreateSecondaryStagingStoreParams("url")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetProvider("provider")
+		p.SetScope("scope")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"details[0].key":   {"key1"},
+			"details[0].value": {"value1"},
+			"details[1].key":   {"key2"},
+			"details[1].value": {"value2"},
+			"provider":         {"provider"},
+			"scope":            {"scope"},
+			"url":              {"url"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.CreateSecondaryStagingStore(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "78495971-d31d-86f8-a917-f72af5ef7ec9" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteImageStore", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteImageStore")
+
+		p := client.ImageStore.NewDeleteImageStoreParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.DeleteImageStore(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteSecondaryStagingStore", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteSecondaryStagingStore")
+
+		p := client.ImageStore.NewDeleteSecondaryStagingStoreParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.DeleteSecondaryStagingStore(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListImageStores", func(t *testing.T) {
+		defer server.checkCommands(t, "listImageStores")
+
+		p := client.ImageStore.NewListImageStoresParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProtocol("protocol")
+		p.SetProvider("provider")
+		p.SetReadonly(true)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"protocol": {"protocol"},
+			"provider": {"provider"},
+			"readonly": {"true"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.ListImageStores(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ImageStores) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ImageStores))
+		}
+		if r.ImageStores[0].Id != "69fc9a71-2c10-ea1a-473c-bb472f038317" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.ImageStores[0].Id)
+		}
+	})
+
+	t.Run("ListSecondaryStagingStores", func(t *testing.T) {
+		defer server.checkCommands(t, "listSecondaryStagingStores")
+
+		p := client.ImageStore.NewListSecondaryStagingStoresParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProtocol("protocol")
+		p.SetProvider("provider")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"protocol": {"protocol"},
+			"provider": {"provider"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.ListSecondaryStagingStores(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.SecondaryStagingStores) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.SecondaryStagingStores))
+		}
+		if r.SecondaryStagingStores[0].Id != "539da469-8b59-1658-726d-caac22f3b865" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.SecondaryStagingStores[0].Id)
+		}
+	})
+
+	t.Run("UpdateCloudToUseObjectStore", func(t *testing.T) {
+		defer server.checkCommands(t, "updateCloudToUseObjectStore")
+
+		p := client.ImageStore.NewUpdateCloudToUseObjectStoreParams("provider")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetName("name")
+		p.SetUrl("url")
+
+		expected := url.Values{
+			"details[0].key":   {"key1"},
+			"details[0].value": {"value1"},
+			"details[1].key":   {"key2"},
+			"details[1].value": {"value2"},
+			"name":             {"name"},
+			"provider":         {"provider"},
+			"url":              {"url"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ImageStore.UpdateCloudToUseObjectStore(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e002d268-cdbc-c3a3-7396-5d167260ec26" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -60,3 +62,66 @@ func TestInfrastructureUsageService(t *testing.T) {
 	t.Run("ListDbMetrics", testlistDbMetrics)
 
 }
+
+func TestInfrastructureUsageServiceFixtures(t *testing.T) {
+	response, err := readData("generated/InfrastructureUsageService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ListManagementServersMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listManagementServersMetrics")
+
+		p := client.InfrastructureUsage.NewListManagementServersMetricsParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetSystem(true)
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"system":   {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InfrastructureUsage.ListManagementServersMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ManagementServersMetrics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ManagementServersMetrics))
+		}
+		if r.ManagementServersMetrics[0].Id != "46580036-8697-1f40-7829-82a4453c4231" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.ManagementServersMetrics[0].Id)
+		}
+	})
+
+	t.Run("ListDbMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listDbMetrics")
+
+		p := client.InfrastructureUsage.NewListDbMetricsParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InfrastructureUsage.ListDbMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -120,3 +122,192 @@ func TestInternalLBService(t *testing.T) {
 	t.Run("StopInternalLoadBalancerVM", teststopInternalLoadBalancerVM)
 
 }
+
+func TestInternalLBServiceFixtures(t *testing.T) {
+	response, err := readData("generated/InternalLBService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ConfigureInternalLoadBalancerElement", func(t *testing.T) {
+		defer server.checkCommands(t, "configureInternalLoadBalancerElement", "queryAsyncJobResult")
+
+		p := client.InternalLB.NewConfigureInternalLoadBalancerElementParams(true, "id")
+
+		expected := url.Values{
+			"enabled": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InternalLB.ConfigureInternalLoadBalancerElement(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "dc07e91c-8914-f2c9-8acc-612085da5a5d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateInternalLoadBalancerElement", func(t *testing.T) {
+		defer server.checkCommands(t, "createInternalLoadBalancerElement", "queryAsyncJobResult")
+
+		p := client.InternalLB.NewCreateInternalLoadBalancerElementParams("nspid")
+
+		expected := url.Values{
+			"nspid": {"nspid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InternalLB.CreateInternalLoadBalancerElement(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f72c70d6-e1d3-c91c-53e7-28fb4c155677" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListInternalLoadBalancerElements", func(t *testing.T) {
+		defer server.checkCommands(t, "listInternalLoadBalancerElements")
+
+		p := client.InternalLB.NewListInternalLoadBalancerElementsParams()
+		p.SetEnabled(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetNspid("nspid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"enabled":  {"true"},
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"nspid":    {"nspid"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InternalLB.ListInternalLoadBalancerElements(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.InternalLoadBalancerElements) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.InternalLoadBalancerElements))
+		}
+	})
+
+	t.Run("ListInternalLoadBalancerVMs", func(t *testing.T) {
+		defer server.checkCommands(t, "listInternalLoadBalancerVMs")
+
+		p := client.InternalLB.NewListInternalLoadBalancerVMsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFetchhealthcheckresults(true)
+		p.SetForvpc(true)
+		p.SetHostid("hostid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetState("state")
+		p.SetVpcid("vpcid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                 {"account"},
+			"domainid":                {"domainid"},
+			"fetchhealthcheckresults": {"true"},
+			"forvpc":                  {"true"},
+			"hostid":                  {"hostid"},
+			"id":                      {"id"},
+			"isrecursive":             {"true"},
+			"keyword":                 {"keyword"},
+			"listall":                 {"true"},
+			"name":                    {"name"},
+			"networkid":               {"networkid"},
+			"page":                    {"1"},
+			"pagesize":                {"1"},
+			"podid":                   {"podid"},
+			"projectid":               {"projectid"},
+			"state":                   {"state"},
+			"vpcid":                   {"vpcid"},
+			"zoneid":                  {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InternalLB.ListInternalLoadBalancerVMs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.InternalLoadBalancerVMs) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.InternalLoadBalancerVMs))
+		}
+		if r.InternalLoadBalancerVMs[0].Id != "907164f5-6fbd-fd52-ca26-996827a9464c" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.InternalLoadBalancerVMs[0].Id)
+		}
+	})
+
+	t.Run("StartInternalLoadBalancerVM", func(t *testing.T) {
+		defer server.checkCommands(t, "startInternalLoadBalancerVM", "queryAsyncJobResult")
+
+		p := client.InternalLB.NewStartInternalLoadBalancerVMParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InternalLB.StartInternalLoadBalancerVM(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "77fed90e-b41c-f1ab-d34e-51c14d5d5e67" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StopInternalLoadBalancerVM", func(t *testing.T) {
+		defer server.checkCommands(t, "stopInternalLoadBalancerVM", "queryAsyncJobResult")
+
+		p := client.InternalLB.NewStopInternalLoadBalancerVMParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.InternalLB.StopInternalLoadBalancerVM(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "a756b23c-be3e-57c1-a511-e726fc45a0fb" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -228,3 +230,422 @@ func TestKubernetesService(t *testing.T) {
 	t.Run("RemoveVirtualMachinesFromKubernetesCluster", testremoveVirtualMachinesFromKubernetesCluster)
 
 }
+
+func TestKubernetesServiceFixtures(t *testing.T) {
+	response, err := readData("generated/KubernetesService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddKubernetesSupportedVersion", func(t *testing.T) {
+		defer server.checkCommands(t, "addKubernetesSupportedVersion")
+
+		p := client.Kubernetes.NewAddKubernetesSupportedVersionParams(1, 1, "semanticversion")
+		p.SetChecksum("checksum")
+		p.SetDirectdownload(true)
+		p.SetName("name")
+		p.SetUrl("url")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"checksum":        {"checksum"},
+			"directdownload":  {"true"},
+			"mincpunumber":    {"1"},
+			"minmemory":       {"1"},
+			"name":            {"name"},
+			"semanticversion": {"semanticversion"},
+			"url":             {"url"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.AddKubernetesSupportedVersion(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "6cc61c03-e386-126d-911f-ce40b43c083d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "createKubernetesCluster", "queryAsyncJobResult")
+
+		p := client.Kubernetes.NewCreateKubernetesClusterParams("description", "kubernetesversionid", "name", "serviceofferingid", 2, "zoneid")
+		p.SetAccount("account")
+		p.SetClustertype("clustertype")
+		p.SetControlnodes(2)
+		p.SetDockerregistrypassword("dockerregistrypassword")
+		p.SetDockerregistryurl("dockerregistryurl")
+		p.SetDockerregistryusername("dockerregistryusername")
+		p.SetDomainid("domainid")
+		p.SetExternalloadbalanceripaddress("externalloadbalanceripaddress")
+		p.SetKeypair("keypair")
+		p.SetMasternodes(2)
+		p.SetNetworkid("networkid")
+		p.SetNoderootdisksize(2)
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":                       {"account"},
+			"clustertype":                   {"clustertype"},
+			"controlnodes":                  {"2"},
+			"description":                   {"description"},
+			"dockerregistrypassword":        {"dockerregistrypassword"},
+			"dockerregistryurl":             {"dockerregistryurl"},
+			"dockerregistryusername":        {"dockerregistryusername"},
+			"domainid":                      {"domainid"},
+			"externalloadbalanceripaddress": {"externalloadbalanceripaddress"},
+			"keypair":                       {"keypair"},
+			"kubernetesversionid":           {"kubernetesversionid"},
+			"masternodes":                   {"2"},
+			"name":                          {"name"},
+			"networkid":                     {"networkid"},
+			"noderootdisksize":              {"2"},
+			"projectid":                     {"projectid"},
+			"serviceofferingid":             {"serviceofferingid"},
+			"size":                          {"2"},
+			"zoneid":                        {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.CreateKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cf7662c3-a9c3-7b9d-d62f-754b23b68b54" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteKubernetesCluster", "queryAsyncJobResult")
+
+		p := client.Kubernetes.NewDeleteKubernetesClusterParams("id")
+		p.SetCleanup(true)
+		p.SetExpunge(true)
+
+		expected := url.Values{
+			"cleanup": {"true"},
+			"expunge": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.DeleteKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteKubernetesSupportedVersion", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteKubernetesSupportedVersion", "queryAsyncJobResult")
+
+		p := client.Kubernetes.NewDeleteKubernetesSupportedVersionParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.DeleteKubernetesSupportedVersion(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("GetKubernetesClusterConfig", func(t *testing.T) {
+		defer server.checkCommands(t, "getKubernetesClusterConfig")
+
+		p := client.Kubernetes.NewGetKubernetesClusterConfigParams()
+		p.SetId("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.GetKubernetesClusterConfig(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8622c10d-02e8-73c7-5aac-04c1670bbba0" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListKubernetesClusters", func(t *testing.T) {
+		defer server.checkCommands(t, "listKubernetesClusters")
+
+		p := client.Kubernetes.NewListKubernetesClustersParams()
+		p.SetAccount("account")
+		p.SetClustertype("clustertype")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetState("state")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"clustertype": {"clustertype"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"state":       {"state"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.ListKubernetesClusters(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.KubernetesClusters) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.KubernetesClusters))
+		}
+		if r.KubernetesClusters[0].Id != "94af41e3-c150-fad1-fe60-6cd142dd046a" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.KubernetesClusters[0].Id)
+		}
+	})
+
+	t.Run("ListKubernetesSupportedVersions", func(t *testing.T) {
+		defer server.checkCommands(t, "listKubernetesSupportedVersions")
+
+		p := client.Kubernetes.NewListKubernetesSupportedVersionsParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetMinimumkubernetesversionid("minimumkubernetesversionid")
+		p.SetMinimumsemanticversion("minimumsemanticversion")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":                         {"id"},
+			"keyword":                    {"keyword"},
+			"minimumkubernetesversionid": {"minimumkubernetesversionid"},
+			"minimumsemanticversion":     {"minimumsemanticversion"},
+			"page":                       {"1"},
+			"pagesize":                   {"1"},
+			"zoneid":                     {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.ListKubernetesSupportedVersions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.KubernetesSupportedVersions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.KubernetesSupportedVersions))
+		}
+		if r.KubernetesSupportedVersions[0].Id != "9292a0b7-d05f-789d-16c0-c47c3a6085ff" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.KubernetesSupportedVersions[0].Id)
+		}
+	})
+
+	t.Run("ScaleKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "scaleKubernetesCluster", "queryAsyncJobResult")
+
+		p := client.Kubernetes.NewScaleKubernetesClusterParams("id")
+		p.SetAutoscalingenabled(true)
+		p.SetMaxsize(2)
+		p.SetMinsize(2)
+		p.SetNodeids([]string{"nodeids1", "nodeids2"})
+		p.SetServiceofferingid("serviceofferingid")
+		p.SetSize(2)
+
+		expected := url.Values{
+			"autoscalingenabled": {"true"},
+			"id":                 {"id"},
+			"maxsize":            {"2"},
+			"minsize":            {"2"},
+			"nodeids":            {"nodeids1,nodeids2"},
+			"serviceofferingid":  {"serviceofferingid"},
+			"size":               {"2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.ScaleKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "59acdbf4-e924-df2e-eca5-ecaa238ab1e2" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StartKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "startKubernetesCluster", "queryAsyncJobResult")
+
+		p := client.Kubernetes.NewStartKubernetesClusterParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.StartKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "aa16b50c-5cf5-3bc8-d80c-dd58b3c3b5ac" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StopKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "stopKubernetesCluster", "queryAsyncJobResult")
+
+		p := client.Kubernetes.NewStopKubernetesClusterParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.StopKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateKubernetesSupportedVersion", func(t *testing.T) {
+		defer server.checkCommands(t, "updateKubernetesSupportedVersion")
+
+		p := client.Kubernetes.NewUpdateKubernetesSupportedVersionParams("id", "state")
+
+		expected := url.Values{
+			"id":    {"id"},
+			"state": {"state"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.UpdateKubernetesSupportedVersion(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "194afef8-3f37-95ba-2c03-b502586a2b9b" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpgradeKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "upgradeKubernetesCluster", "queryAsyncJobResult")
+
+		p := client.Kubernetes.NewUpgradeKubernetesClusterParams("id", "kubernetesversionid")
+
+		expected := url.Values{
+			"id":                  {"id"},
+			"kubernetesversionid": {"kubernetesversionid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.UpgradeKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "2d070dfa-0abc-d7cd-4ac2-2ee26754f392" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddVirtualMachinesToKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "addVirtualMachinesToKubernetesCluster")
+
+		p := client.Kubernetes.NewAddVirtualMachinesToKubernetesClusterParams("id", []string{"virtualmachineids1", "virtualmachineids2"})
+		p.SetIscontrolnode(true)
+
+		expected := url.Values{
+			"id":                {"id"},
+			"iscontrolnode":     {"true"},
+			"virtualmachineids": {"virtualmachineids1,virtualmachineids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.AddVirtualMachinesToKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RemoveVirtualMachinesFromKubernetesCluster", func(t *testing.T) {
+		defer server.checkCommands(t, "removeVirtualMachinesFromKubernetesCluster")
+
+		p := client.Kubernetes.NewRemoveVirtualMachinesFromKubernetesClusterParams("id", []string{"virtualmachineids1", "virtualmachineids2"})
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"id":                {"id"},
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"virtualmachineids": {"virtualmachineids1,virtualmachineids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Kubernetes.RemoveVirtualMachinesFromKubernetesCluster(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -159,3 +161,315 @@ func TestLDAPService(t *testing.T) {
 	t.Run("SearchLdap", testsearchLdap)
 
 }
+
+func TestLDAPServiceFixtures(t *testing.T) {
+	response, err := readData("generated/LDAPService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddLdapConfiguration", func(t *testing.T) {
+		defer server.checkCommands(t, "addLdapConfiguration")
+
+		p := client.LDAP.NewAddLdapConfigurationParams("hostname", 1)
+		p.SetDomainid("domainid")
+
+		expected := url.Values{
+			"domainid": {"domainid"},
+			"hostname": {"hostname"},
+			"port":     {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.AddLdapConfiguration(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DeleteLdapConfiguration", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteLdapConfiguration")
+
+		p := client.LDAP.NewDeleteLdapConfigurationParams("hostname")
+		p.SetDomainid("domainid")
+		p.SetPort(1)
+
+		expected := url.Values{
+			"domainid": {"domainid"},
+			"hostname": {"hostname"},
+			"port":     {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.DeleteLdapConfiguration(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ImportLdapUsers", func(t *testing.T) {
+		defer server.checkCommands(t, "importLdapUsers")
+
+		p := client.LDAP.NewImportLdapUsersParams()
+		p.SetAccount("account")
+		p.SetAccountdetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetAccounttype(1)
+		p.SetDomainid("domainid")
+		p.SetGroup("group")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetRoleid("roleid")
+		p.SetTimezone("timezone")
+
+		expected := url.Values{
+			"account":                 {"account"},
+			"accountdetails[0].key":   {"key1"},
+			"accountdetails[0].value": {"value1"},
+			"accountdetails[1].key":   {"key2"},
+			"accountdetails[1].value": {"value2"},
+			"accounttype":             {"1"},
+			"domainid":                {"domainid"},
+			"group":                   {"group"},
+			"keyword":                 {"keyword"},
+			"page":                    {"1"},
+			"pagesize":                {"1"},
+			"roleid":                  {"roleid"},
+			"timezone":                {"timezone"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.ImportLdapUsers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("LdapConfig", func(t *testing.T) {
+		defer server.checkCommands(t, "ldapConfig")
+
+		p := client.LDAP.NewLdapConfigParams()
+		p.SetBinddn("binddn")
+		p.SetBindpass("bindpass")
+		p.SetHostname("hostname")
+		p.SetListall(true)
+		p.SetPort(1)
+		p.SetQueryfilter("queryfilter")
+		p.SetSearchbase("searchbase")
+		p.SetSsl(true)
+		p.SetTruststore("truststore")
+		p.SetTruststorepass("truststorepass")
+
+		expected := url.Values{
+			"binddn":         {"binddn"},
+			"bindpass":       {"bindpass"},
+			"hostname":       {"hostname"},
+			"listall":        {"true"},
+			"port":           {"1"},
+			"queryfilter":    {"queryfilter"},
+			"searchbase":     {"searchbase"},
+			"ssl":            {"true"},
+			"truststore":     {"truststore"},
+			"truststorepass": {"truststorepass"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.LdapConfig(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("LdapCreateAccount", func(t *testing.T) {
+		defer server.checkCommands(t, "ldapCreateAccount")
+
+		p := client.LDAP.NewLdapCreateAccountParams("username")
+		p.SetAccount("account")
+		p.SetAccountdetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetAccountid("accountid")
+		p.SetAccounttype(1)
+		p.SetDomainid("domainid")
+		p.SetNetworkdomain("networkdomain")
+		p.SetRoleid("roleid")
+		p.SetTimezone("timezone")
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"account":                 {"account"},
+			"accountdetails[0].key":   {"key1"},
+			"accountdetails[0].value": {"value1"},
+			"accountdetails[1].key":   {"key2"},
+			"accountdetails[1].value": {"value2"},
+			"accountid":               {"accountid"},
+			"accounttype":             {"1"},
+			"domainid":                {"domainid"},
+			"networkdomain":           {"networkdomain"},
+			"roleid":                  {"roleid"},
+			"timezone":                {"timezone"},
+			"userid":                  {"userid"},
+			"username":                {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.LdapCreateAccount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "321ed7ec-b4bb-48cc-e717-3c1e60b03351" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("LdapRemove", func(t *testing.T) {
+		defer server.checkCommands(t, "ldapRemove")
+
+		p := client.LDAP.NewLdapRemoveParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.LdapRemove(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("LinkDomainToLdap", func(t *testing.T) {
+		defer server.checkCommands(t, "linkDomainToLdap")
+
+		p := client.LDAP.NewLinkDomainToLdapParams(1, "domainid", "type")
+		p.SetAdmin("admin")
+		p.SetLdapdomain("ldapdomain")
+		p.SetName("name")
+
+		expected := url.Values{
+			"accounttype": {"1"},
+			"admin":       {"admin"},
+			"domainid":    {"domainid"},
+			"ldapdomain":  {"ldapdomain"},
+			"name":        {"name"},
+			"type":        {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.LinkDomainToLdap(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListLdapConfigurations", func(t *testing.T) {
+		defer server.checkCommands(t, "listLdapConfigurations")
+
+		p := client.LDAP.NewListLdapConfigurationsParams()
+		p.SetDomainid("domainid")
+		p.SetHostname("hostname")
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPort(1)
+
+		expected := url.Values{
+			"domainid": {"domainid"},
+			"hostname": {"hostname"},
+			"keyword":  {"keyword"},
+			"listall":  {"true"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"port":     {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.ListLdapConfigurations(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.LdapConfigurations) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.LdapConfigurations))
+		}
+	})
+
+	t.Run("ListLdapUsers", func(t *testing.T) {
+		defer server.checkCommands(t, "listLdapUsers")
+
+		p := client.LDAP.NewListLdapUsersParams()
+		p.SetDomainid("domainid")
+		p.SetKeyword("keyword")
+		p.SetListtype("listtype")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetUserfilter("userfilter")
+
+		expected := url.Values{
+			"domainid":   {"domainid"},
+			"keyword":    {"keyword"},
+			"listtype":   {"listtype"},
+			"page":       {"1"},
+			"pagesize":   {"1"},
+			"userfilter": {"userfilter"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.ListLdapUsers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.LdapUsers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.LdapUsers))
+		}
+	})
+
+	t.Run("SearchLdap", func(t *testing.T) {
+		defer server.checkCommands(t, "searchLdap")
+
+		p := client.LDAP.NewSearchLdapParams("query")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"query":    {"query"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LDAP.SearchLdap(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -96,3 +98,147 @@ func TestLimitService(t *testing.T) {
 	t.Run("UpdateResourceLimit", testupdateResourceLimit)
 
 }
+
+func TestLimitServiceFixtures(t *testing.T) {
+	response, err := readData("generated/LimitService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("GetApiLimit", func(t *testing.T) {
+		defer server.checkCommands(t, "getApiLimit")
+
+		p := client.Limit.NewGetApiLimitParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Limit.GetApiLimit(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListResourceLimits", func(t *testing.T) {
+		defer server.checkCommands(t, "listResourceLimits")
+
+		p := client.Limit.NewListResourceLimitsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId(2)
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetResourcetype(1)
+		p.SetResourcetypename("resourcetypename")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"domainid":         {"domainid"},
+			"id":               {"2"},
+			"isrecursive":      {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"projectid":        {"projectid"},
+			"resourcetype":     {"1"},
+			"resourcetypename": {"resourcetypename"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Limit.ListResourceLimits(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ResourceLimits) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ResourceLimits))
+		}
+	})
+
+	t.Run("ResetApiLimit", func(t *testing.T) {
+		defer server.checkCommands(t, "resetApiLimit")
+
+		p := client.Limit.NewResetApiLimitParams()
+		p.SetAccount("account")
+
+		expected := url.Values{
+			"account": {"account"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Limit.ResetApiLimit(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateResourceCount", func(t *testing.T) {
+		defer server.checkCommands(t, "updateResourceCount")
+
+		p := client.Limit.NewUpdateResourceCountParams("domainid")
+		p.SetAccount("account")
+		p.SetProjectid("projectid")
+		p.SetResourcetype(1)
+
+		expected := url.Values{
+			"account":      {"account"},
+			"domainid":     {"domainid"},
+			"projectid":    {"projectid"},
+			"resourcetype": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Limit.UpdateResourceCount(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("UpdateResourceLimit", func(t *testing.T) {
+		defer server.checkCommands(t, "updateResourceLimit")
+
+		p := client.Limit.NewUpdateResourceLimitParams(1)
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetMax(2)
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":      {"account"},
+			"domainid":     {"domainid"},
+			"max":          {"2"},
+			"projectid":    {"projectid"},
+			"resourcetype": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Limit.UpdateResourceLimit(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -465,3 +467,1027 @@ func TestLoadBalancerService(t *testing.T) {
 	t.Run("UploadSslCert", testuploadSslCert)
 
 }
+
+func TestLoadBalancerServiceFixtures(t *testing.T) {
+	response, err := readData("generated/LoadBalancerService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddNetscalerLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "addNetscalerLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewAddNetscalerLoadBalancerParams("networkdevicetype", "password", "physicalnetworkid", "url", "username")
+		p.SetGslbprovider(true)
+		p.SetGslbproviderprivateip("gslbproviderprivateip")
+		p.SetGslbproviderpublicip("gslbproviderpublicip")
+		p.SetIsexclusivegslbprovider(true)
+
+		expected := url.Values{
+			"gslbprovider":            {"true"},
+			"gslbproviderprivateip":   {"gslbproviderprivateip"},
+			"gslbproviderpublicip":    {"gslbproviderpublicip"},
+			"isexclusivegslbprovider": {"true"},
+			"networkdevicetype":       {"networkdevicetype"},
+			"password":                {"password"},
+			"physicalnetworkid":       {"physicalnetworkid"},
+			"url":                     {"url"},
+			"username":                {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.AddNetscalerLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("AssignCertToLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "assignCertToLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewAssignCertToLoadBalancerParams("certid", "lbruleid")
+
+		expected := url.Values{
+			"certid":   {"certid"},
+			"lbruleid": {"lbruleid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.AssignCertToLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("AssignToGlobalLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "assignToGlobalLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewAssignToGlobalLoadBalancerRuleParams("id", []string{"loadbalancerrulelist1", "loadbalancerrulelist2"})
+		p.SetGslblbruleweightsmap(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"gslblbruleweightsmap[0].key":   {"key1"},
+			"gslblbruleweightsmap[0].value": {"value1"},
+			"gslblbruleweightsmap[1].key":   {"key2"},
+			"gslblbruleweightsmap[1].value": {"value2"},
+			"id":                            {"id"},
+			"loadbalancerrulelist":          {"loadbalancerrulelist1,loadbalancerrulelist2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.AssignToGlobalLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("AssignToLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "assignToLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewAssignToLoadBalancerRuleParams("id")
+		p.SetVirtualmachineids([]string{"virtualmachineids1", "virtualmachineids2"})
+		p.SetVmidipmap(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"id":                 {"id"},
+			"virtualmachineids":  {"virtualmachineids1,virtualmachineids2"},
+			"vmidipmap[0].key":   {"key1"},
+			"vmidipmap[0].value": {"value1"},
+			"vmidipmap[1].key":   {"key2"},
+			"vmidipmap[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.AssignToLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ConfigureNetscalerLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "configureNetscalerLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewConfigureNetscalerLoadBalancerParams("lbdeviceid")
+		p.SetInline(true)
+		p.SetLbdevicecapacity(2)
+		p.SetLbdevicededicated(true)
+		p.SetPodids([]string{"podids1", "podids2"})
+
+		expected := url.Values{
+			"inline":            {"true"},
+			"lbdevicecapacity":  {"2"},
+			"lbdevicededicated": {"true"},
+			"lbdeviceid":        {"lbdeviceid"},
+			"podids":            {"podids1,podids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ConfigureNetscalerLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("CreateGlobalLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "createGlobalLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewCreateGlobalLoadBalancerRuleParams("gslbdomainname", "gslbservicetype", "name", 1)
+		p.SetAccount("account")
+		p.SetDescription("description")
+		p.SetDomainid("domainid")
+		p.SetGslblbmethod("gslblbmethod")
+		p.SetGslbstickysessionmethodname("gslbstickysessionmethodname")
+
+		expected := url.Values{
+			"account":                     {"account"},
+			"description":                 {"description"},
+			"domainid":                    {"domainid"},
+			"gslbdomainname":              {"gslbdomainname"},
+			"gslblbmethod":                {"gslblbmethod"},
+			"gslbservicetype":             {"gslbservicetype"},
+			"gslbstickysessionmethodname": {"gslbstickysessionmethodname"},
+			"name":                        {"name"},
+			"regionid":                    {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.CreateGlobalLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "14f560d5-8985-2544-8d00-99d52bcbbbfd" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateLBHealthCheckPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "createLBHealthCheckPolicy", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewCreateLBHealthCheckPolicyParams("lbruleid")
+		p.SetDescription("description")
+		p.SetFordisplay(true)
+		p.SetHealthythreshold(1)
+		p.SetIntervaltime(1)
+		p.SetPingpath("pingpath")
+		p.SetResponsetimeout(1)
+		p.SetUnhealthythreshold(1)
+
+		expected := url.Values{
+			"description":        {"description"},
+			"fordisplay":         {"true"},
+			"healthythreshold":   {"1"},
+			"intervaltime":       {"1"},
+			"lbruleid":           {"lbruleid"},
+			"pingpath":           {"pingpath"},
+			"responsetimeout":    {"1"},
+			"unhealthythreshold": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.CreateLBHealthCheckPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("CreateLBStickinessPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "createLBStickinessPolicy", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewCreateLBStickinessPolicyParams("lbruleid", "methodname", "name")
+		p.SetDescription("description")
+		p.SetFordisplay(true)
+		p.SetParam(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"description":    {"description"},
+			"fordisplay":     {"true"},
+			"lbruleid":       {"lbruleid"},
+			"methodname":     {"methodname"},
+			"name":           {"name"},
+			"param[0].key":   {"key1"},
+			"param[0].value": {"value1"},
+			"param[1].key":   {"key2"},
+			"param[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.CreateLBStickinessPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("CreateLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "createLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewCreateLoadBalancerParams("algorithm", 1, "name", "networkid", "scheme", "sourceipaddressnetworkid", 1)
+		p.SetDescription("description")
+		p.SetFordisplay(true)
+		p.SetSourceipaddress("sourceipaddress")
+
+		expected := url.Values{
+			"algorithm":                {"algorithm"},
+			"description":              {"description"},
+			"fordisplay":               {"true"},
+			"instanceport":             {"1"},
+			"name":                     {"name"},
+			"networkid":                {"networkid"},
+			"scheme":                   {"scheme"},
+			"sourceipaddress":          {"sourceipaddress"},
+			"sourceipaddressnetworkid": {"sourceipaddressnetworkid"},
+			"sourceport":               {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.CreateLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e234670f-381c-398f-f6fe-746d735227f5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "createLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewCreateLoadBalancerRuleParams("algorithm", "name", 1, 1)
+		p.SetAccount("account")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetDescription("description")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetNetworkid("networkid")
+		p.SetOpenfirewall(true)
+		p.SetProtocol("protocol")
+		p.SetPublicipid("publicipid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":      {"account"},
+			"algorithm":    {"algorithm"},
+			"cidrlist":     {"cidrlist1,cidrlist2"},
+			"description":  {"description"},
+			"domainid":     {"domainid"},
+			"fordisplay":   {"true"},
+			"name":         {"name"},
+			"networkid":    {"networkid"},
+			"openfirewall": {"true"},
+			"privateport":  {"1"},
+			"protocol":     {"protocol"},
+			"publicipid":   {"publicipid"},
+			"publicport":   {"1"},
+			"zoneid":       {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.CreateLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "05a989ee-7f90-224f-434e-7a5213b85451" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteGlobalLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteGlobalLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewDeleteGlobalLoadBalancerRuleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.DeleteGlobalLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteLBHealthCheckPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteLBHealthCheckPolicy", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewDeleteLBHealthCheckPolicyParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.DeleteLBHealthCheckPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteLBStickinessPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteLBStickinessPolicy", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewDeleteLBStickinessPolicyParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.DeleteLBStickinessPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewDeleteLoadBalancerParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.DeleteLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewDeleteLoadBalancerRuleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.DeleteLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteNetscalerLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNetscalerLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewDeleteNetscalerLoadBalancerParams("lbdeviceid")
+
+		expected := url.Values{
+			"lbdeviceid": {"lbdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.DeleteNetscalerLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteSslCert", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteSslCert")
+
+		p := client.LoadBalancer.NewDeleteSslCertParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.DeleteSslCert(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListGlobalLoadBalancerRules", func(t *testing.T) {
+		defer server.checkCommands(t, "listGlobalLoadBalancerRules")
+
+		p := client.LoadBalancer.NewListGlobalLoadBalancerRulesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetRegionid(1)
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"id":            {"id"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"regionid":      {"1"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListGlobalLoadBalancerRules(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.GlobalLoadBalancerRules) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.GlobalLoadBalancerRules))
+		}
+		if r.GlobalLoadBalancerRules[0].Id != "a7474a28-44d4-3981-9b27-32a2146b23b2" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.GlobalLoadBalancerRules[0].Id)
+		}
+	})
+
+	t.Run("ListLBHealthCheckPolicies", func(t *testing.T) {
+		defer server.checkCommands(t, "listLBHealthCheckPolicies")
+
+		p := client.LoadBalancer.NewListLBHealthCheckPoliciesParams()
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetLbruleid("lbruleid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"fordisplay": {"true"},
+			"id":         {"id"},
+			"keyword":    {"keyword"},
+			"lbruleid":   {"lbruleid"},
+			"page":       {"1"},
+			"pagesize":   {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListLBHealthCheckPolicies(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.LBHealthCheckPolicies) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.LBHealthCheckPolicies))
+		}
+	})
+
+	t.Run("ListLBStickinessPolicies", func(t *testing.T) {
+		defer server.checkCommands(t, "listLBStickinessPolicies")
+
+		p := client.LoadBalancer.NewListLBStickinessPoliciesParams()
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetLbruleid("lbruleid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"fordisplay": {"true"},
+			"id":         {"id"},
+			"keyword":    {"keyword"},
+			"lbruleid":   {"lbruleid"},
+			"page":       {"1"},
+			"pagesize":   {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListLBStickinessPolicies(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.LBStickinessPolicies) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.LBStickinessPolicies))
+		}
+	})
+
+	t.Run("ListLoadBalancerRuleInstances", func(t *testing.T) {
+		defer server.checkCommands(t, "listLoadBalancerRuleInstances")
+
+		p := client.LoadBalancer.NewListLoadBalancerRuleInstancesParams("id")
+		p.SetApplied(true)
+		p.SetKeyword("keyword")
+		p.SetLbvmips(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"applied":  {"true"},
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"lbvmips":  {"true"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListLoadBalancerRuleInstances(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.LBRuleVMIDIPs) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.LBRuleVMIDIPs))
+		}
+	})
+
+	t.Run("ListLoadBalancerRules", func(t *testing.T) {
+		defer server.checkCommands(t, "listLoadBalancerRules")
+
+		p := client.LoadBalancer.NewListLoadBalancerRulesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetPublicipid("publicipid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"domainid":         {"domainid"},
+			"fordisplay":       {"true"},
+			"id":               {"id"},
+			"isrecursive":      {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"name":             {"name"},
+			"networkid":        {"networkid"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"projectid":        {"projectid"},
+			"publicipid":       {"publicipid"},
+			"tags[0].key":      {"key1"},
+			"tags[0].value":    {"value1"},
+			"tags[1].key":      {"key2"},
+			"tags[1].value":    {"value2"},
+			"virtualmachineid": {"virtualmachineid"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListLoadBalancerRules(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.LoadBalancerRules) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.LoadBalancerRules))
+		}
+		if r.LoadBalancerRules[0].Id != "1918df6b-bef9-19bd-8759-884ef04dc470" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.LoadBalancerRules[0].Id)
+		}
+	})
+
+	t.Run("ListLoadBalancers", func(t *testing.T) {
+		defer server.checkCommands(t, "listLoadBalancers")
+
+		p := client.LoadBalancer.NewListLoadBalancersParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetScheme("scheme")
+		p.SetSourceipaddress("sourceipaddress")
+		p.SetSourceipaddressnetworkid("sourceipaddressnetworkid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":                  {"account"},
+			"domainid":                 {"domainid"},
+			"fordisplay":               {"true"},
+			"id":                       {"id"},
+			"isrecursive":              {"true"},
+			"keyword":                  {"keyword"},
+			"listall":                  {"true"},
+			"name":                     {"name"},
+			"networkid":                {"networkid"},
+			"page":                     {"1"},
+			"pagesize":                 {"1"},
+			"projectid":                {"projectid"},
+			"scheme":                   {"scheme"},
+			"sourceipaddress":          {"sourceipaddress"},
+			"sourceipaddressnetworkid": {"sourceipaddressnetworkid"},
+			"tags[0].key":              {"key1"},
+			"tags[0].value":            {"value1"},
+			"tags[1].key":              {"key2"},
+			"tags[1].value":            {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListLoadBalancers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.LoadBalancers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.LoadBalancers))
+		}
+		if r.LoadBalancers[0].Id != "03da6f83-76d1-efbc-3d8d-608e2834eb21" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.LoadBalancers[0].Id)
+		}
+	})
+
+	t.Run("ListNetscalerLoadBalancers", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetscalerLoadBalancers")
+
+		p := client.LoadBalancer.NewListNetscalerLoadBalancersParams()
+		p.SetKeyword("keyword")
+		p.SetLbdeviceid("lbdeviceid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+
+		expected := url.Values{
+			"keyword":           {"keyword"},
+			"lbdeviceid":        {"lbdeviceid"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListNetscalerLoadBalancers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetscalerLoadBalancers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetscalerLoadBalancers))
+		}
+	})
+
+	t.Run("ListSslCerts", func(t *testing.T) {
+		defer server.checkCommands(t, "listSslCerts")
+
+		p := client.LoadBalancer.NewListSslCertsParams()
+		p.SetAccountid("accountid")
+		p.SetCertid("certid")
+		p.SetLbruleid("lbruleid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"accountid": {"accountid"},
+			"certid":    {"certid"},
+			"lbruleid":  {"lbruleid"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.ListSslCerts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.SslCerts) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.SslCerts))
+		}
+		if r.SslCerts[0].Id != "d2fce250-ed03-71d2-41a0-bb34db79b1aa" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.SslCerts[0].Id)
+		}
+	})
+
+	t.Run("RemoveCertFromLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "removeCertFromLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewRemoveCertFromLoadBalancerParams("lbruleid")
+
+		expected := url.Values{
+			"lbruleid": {"lbruleid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.RemoveCertFromLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RemoveFromGlobalLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "removeFromGlobalLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewRemoveFromGlobalLoadBalancerRuleParams("id", []string{"loadbalancerrulelist1", "loadbalancerrulelist2"})
+
+		expected := url.Values{
+			"id":                   {"id"},
+			"loadbalancerrulelist": {"loadbalancerrulelist1,loadbalancerrulelist2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.RemoveFromGlobalLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RemoveFromLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "removeFromLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewRemoveFromLoadBalancerRuleParams("id")
+		p.SetVirtualmachineids([]string{"virtualmachineids1", "virtualmachineids2"})
+		p.SetVmidipmap(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"id":                 {"id"},
+			"virtualmachineids":  {"virtualmachineids1,virtualmachineids2"},
+			"vmidipmap[0].key":   {"key1"},
+			"vmidipmap[0].value": {"value1"},
+			"vmidipmap[1].key":   {"key2"},
+			"vmidipmap[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.RemoveFromLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateGlobalLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "updateGlobalLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewUpdateGlobalLoadBalancerRuleParams("id")
+		p.SetDescription("description")
+		p.SetGslblbmethod("gslblbmethod")
+		p.SetGslbstickysessionmethodname("gslbstickysessionmethodname")
+
+		expected := url.Values{
+			"description":                 {"description"},
+			"gslblbmethod":                {"gslblbmethod"},
+			"gslbstickysessionmethodname": {"gslbstickysessionmethodname"},
+			"id":                          {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.UpdateGlobalLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d7d9b474-4fb9-fc20-465f-95e93cf96dec" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateLBHealthCheckPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "updateLBHealthCheckPolicy", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewUpdateLBHealthCheckPolicyParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.UpdateLBHealthCheckPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("UpdateLBStickinessPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "updateLBStickinessPolicy", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewUpdateLBStickinessPolicyParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.UpdateLBStickinessPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("UpdateLoadBalancer", func(t *testing.T) {
+		defer server.checkCommands(t, "updateLoadBalancer", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewUpdateLoadBalancerParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.UpdateLoadBalancer(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "af16a8db-4c09-b648-7c24-e39beb1c8778" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateLoadBalancerRule", func(t *testing.T) {
+		defer server.checkCommands(t, "updateLoadBalancerRule", "queryAsyncJobResult")
+
+		p := client.LoadBalancer.NewUpdateLoadBalancerRuleParams("id")
+		p.SetAlgorithm("algorithm")
+		p.SetCustomid("customid")
+		p.SetDescription("description")
+		p.SetFordisplay(true)
+		p.SetName("name")
+		p.SetProtocol("protocol")
+
+		expected := url.Values{
+			"algorithm":   {"algorithm"},
+			"customid":    {"customid"},
+			"description": {"description"},
+			"fordisplay":  {"true"},
+			"id":          {"id"},
+			"name":        {"name"},
+			"protocol":    {"protocol"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.UpdateLoadBalancerRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8e95bf98-1028-6d29-3ac4-dbbe965daa2c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UploadSslCert", func(t *testing.T) {
+		defer server.checkCommands(t, "uploadSslCert")
+
+		p := client.LoadBalancer.NewUploadSslCertParams("certificate", "name", "privatekey")
+		p.SetAccount("account")
+		p.SetCertchain("certchain")
+		p.SetDomainid("domainid")
+		p.SetEnabledrevocationcheck(true)
+		p.SetPassword("password")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":                {"account"},
+			"certchain":              {"certchain"},
+			"certificate":            {"certificate"},
+			"domainid":               {"domainid"},
+			"enabledrevocationcheck": {"true"},
+			"name":                   {"name"},
+			"password":               {"password"},
+			"privatekey":             {"privatekey"},
+			"projectid":              {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.LoadBalancer.UploadSslCert(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "519c2547-e144-aeba-db5d-a6f3dde0bb84" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -99,3 +101,153 @@ func TestNATService(t *testing.T) {
 	t.Run("ListIpForwardingRules", testlistIpForwardingRules)
 
 }
+
+func TestNATServiceFixtures(t *testing.T) {
+	response, err := readData("generated/NATService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateIpForwardingRule", func(t *testing.T) {
+		defer server.checkCommands(t, "createIpForwardingRule", "queryAsyncJobResult")
+
+		p := client.NAT.NewCreateIpForwardingRuleParams("ipaddressid", "protocol", 1)
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetEndport(1)
+		p.SetOpenfirewall(true)
+
+		expected := url.Values{
+			"cidrlist":     {"cidrlist1,cidrlist2"},
+			"endport":      {"1"},
+			"ipaddressid":  {"ipaddressid"},
+			"openfirewall": {"true"},
+			"protocol":     {"protocol"},
+			"startport":    {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NAT.CreateIpForwardingRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9872e9e0-1099-c035-31d0-2728e48ed521" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteIpForwardingRule", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteIpForwardingRule", "queryAsyncJobResult")
+
+		p := client.NAT.NewDeleteIpForwardingRuleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NAT.DeleteIpForwardingRule(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableStaticNat", func(t *testing.T) {
+		defer server.checkCommands(t, "disableStaticNat", "queryAsyncJobResult")
+
+		p := client.NAT.NewDisableStaticNatParams("ipaddressid")
+
+		expected := url.Values{
+			"ipaddressid": {"ipaddressid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NAT.DisableStaticNat(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("EnableStaticNat", func(t *testing.T) {
+		defer server.checkCommands(t, "enableStaticNat")
+
+		p := client.NAT.NewEnableStaticNatParams("ipaddressid", "virtualmachineid")
+		p.SetNetworkid("networkid")
+		p.SetVmguestip("vmguestip")
+
+		expected := url.Values{
+			"ipaddressid":      {"ipaddressid"},
+			"networkid":        {"networkid"},
+			"virtualmachineid": {"virtualmachineid"},
+			"vmguestip":        {"vmguestip"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NAT.EnableStaticNat(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListIpForwardingRules", func(t *testing.T) {
+		defer server.checkCommands(t, "listIpForwardingRules")
+
+		p := client.NAT.NewListIpForwardingRulesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIpaddressid("ipaddressid")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetVirtualmachineid("virtualmachineid")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"domainid":         {"domainid"},
+			"id":               {"id"},
+			"ipaddressid":      {"ipaddressid"},
+			"isrecursive":      {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"projectid":        {"projectid"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NAT.ListIpForwardingRules(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.IpForwardingRules) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.IpForwardingRules))
+		}
+	})
+
+}
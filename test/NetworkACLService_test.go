@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -153,3 +155,332 @@ func TestNetworkACLService(t *testing.T) {
 	t.Run("UpdateNetworkACLList", testupdateNetworkACLList)
 
 }
+
+func TestNetworkACLServiceFixtures(t *testing.T) {
+	response, err := readData("generated/NetworkACLService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateNetworkACL", func(t *testing.T) {
+		defer server.checkCommands(t, "createNetworkACL", "queryAsyncJobResult")
+
+		p := client.NetworkACL.NewCreateNetworkACLParams("protocol")
+		p.SetAclid("aclid")
+		p.SetAction("action")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetEndport(1)
+		p.SetFordisplay(true)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetNetworkid("networkid")
+		p.SetNumber(1)
+		p.SetReason("reason")
+		p.SetStartport(1)
+		p.SetTraffictype("traffictype")
+
+		expected := url.Values{
+			"aclid":       {"aclid"},
+			"action":      {"action"},
+			"cidrlist":    {"cidrlist1,cidrlist2"},
+			"endport":     {"1"},
+			"fordisplay":  {"true"},
+			"icmpcode":    {"1"},
+			"icmptype":    {"1"},
+			"networkid":   {"networkid"},
+			"number":      {"1"},
+			"protocol":    {"protocol"},
+			"reason":      {"reason"},
+			"startport":   {"1"},
+			"traffictype": {"traffictype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.CreateNetworkACL(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "3c677db3-d6b9-c225-3032-45d4f1cb6e2a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateNetworkACLList", func(t *testing.T) {
+		defer server.checkCommands(t, "createNetworkACLList", "queryAsyncJobResult")
+
+		p := client.NetworkACL.NewCreateNetworkACLListParams("name", "vpcid")
+		p.SetDescription("description")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"description": {"description"},
+			"fordisplay":  {"true"},
+			"name":        {"name"},
+			"vpcid":       {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.CreateNetworkACLList(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "3545e580-04cc-455c-f41e-e52d29055193" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteNetworkACL", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNetworkACL", "queryAsyncJobResult")
+
+		p := client.NetworkACL.NewDeleteNetworkACLParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.DeleteNetworkACL(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteNetworkACLList", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNetworkACLList", "queryAsyncJobResult")
+
+		p := client.NetworkACL.NewDeleteNetworkACLListParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.DeleteNetworkACLList(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListNetworkACLLists", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworkACLLists")
+
+		p := client.NetworkACL.NewListNetworkACLListsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetVpcid("vpcid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"fordisplay":  {"true"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"networkid":   {"networkid"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"vpcid":       {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.ListNetworkACLLists(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetworkACLLists) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetworkACLLists))
+		}
+		if r.NetworkACLLists[0].Id != "10c16698-e7c0-b689-ad35-623f989db861" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.NetworkACLLists[0].Id)
+		}
+	})
+
+	t.Run("ListNetworkACLs", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworkACLs")
+
+		p := client.NetworkACL.NewListNetworkACLsParams()
+		p.SetAccount("account")
+		p.SetAclid("aclid")
+		p.SetAction("action")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetProtocol("protocol")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetTraffictype("traffictype")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"aclid":         {"aclid"},
+			"action":        {"action"},
+			"domainid":      {"domainid"},
+			"fordisplay":    {"true"},
+			"id":            {"id"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"networkid":     {"networkid"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"protocol":      {"protocol"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"traffictype":   {"traffictype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.ListNetworkACLs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetworkACLs) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetworkACLs))
+		}
+	})
+
+	t.Run("ReplaceNetworkACLList", func(t *testing.T) {
+		defer server.checkCommands(t, "replaceNetworkACLList", "queryAsyncJobResult")
+
+		p := client.NetworkACL.NewReplaceNetworkACLListParams("aclid")
+		p.SetGatewayid("gatewayid")
+		p.SetNetworkid("networkid")
+
+		expected := url.Values{
+			"aclid":     {"aclid"},
+			"gatewayid": {"gatewayid"},
+			"networkid": {"networkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.ReplaceNetworkACLList(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateNetworkACLItem", func(t *testing.T) {
+		defer server.checkCommands(t, "updateNetworkACLItem", "queryAsyncJobResult")
+
+		p := client.NetworkACL.NewUpdateNetworkACLItemParams("id")
+		p.SetAction("action")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetCustomid("customid")
+		p.SetEndport(1)
+		p.SetFordisplay(true)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetNumber(1)
+		p.SetPartialupgrade(true)
+		p.SetProtocol("protocol")
+		p.SetReason("reason")
+		p.SetStartport(1)
+		p.SetTraffictype("traffictype")
+
+		expected := url.Values{
+			"action":         {"action"},
+			"cidrlist":       {"cidrlist1,cidrlist2"},
+			"customid":       {"customid"},
+			"endport":        {"1"},
+			"fordisplay":     {"true"},
+			"icmpcode":       {"1"},
+			"icmptype":       {"1"},
+			"id":             {"id"},
+			"number":         {"1"},
+			"partialupgrade": {"true"},
+			"protocol":       {"protocol"},
+			"reason":         {"reason"},
+			"startport":      {"1"},
+			"traffictype":    {"traffictype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.UpdateNetworkACLItem(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f2ca7dd3-4e91-504b-0fb3-5cce5dc8837f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateNetworkACLList", func(t *testing.T) {
+		defer server.checkCommands(t, "updateNetworkACLList", "queryAsyncJobResult")
+
+		p := client.NetworkACL.NewUpdateNetworkACLListParams("id")
+		p.SetCustomid("customid")
+		p.SetDescription("description")
+		p.SetFordisplay(true)
+		p.SetName("name")
+
+		expected := url.Values{
+			"customid":    {"customid"},
+			"description": {"description"},
+			"fordisplay":  {"true"},
+			"id":          {"id"},
+			"name":        {"name"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkACL.UpdateNetworkACLList(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
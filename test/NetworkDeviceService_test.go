@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -75,3 +77,95 @@ func TestNetworkDeviceService(t *testing.T) {
 	t.Run("ListNetworkDevice", testlistNetworkDevice)
 
 }
+
+func TestNetworkDeviceServiceFixtures(t *testing.T) {
+	response, err := readData("generated/NetworkDeviceService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddNetworkDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "addNetworkDevice")
+
+		p := client.NetworkDevice.NewAddNetworkDeviceParams()
+		p.SetNetworkdeviceparameterlist(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetNetworkdevicetype("networkdevicetype")
+
+		expected := url.Values{
+			"networkdeviceparameterlist[0].key":   {"key1"},
+			"networkdeviceparameterlist[0].value": {"value1"},
+			"networkdeviceparameterlist[1].key":   {"key2"},
+			"networkdeviceparameterlist[1].value": {"value2"},
+			"networkdevicetype":                   {"networkdevicetype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkDevice.AddNetworkDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "eb969386-ecd0-4a5a-fd9b-083b9c2e765e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteNetworkDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNetworkDevice")
+
+		p := client.NetworkDevice.NewDeleteNetworkDeviceParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkDevice.DeleteNetworkDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListNetworkDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworkDevice")
+
+		p := client.NetworkDevice.NewListNetworkDeviceParams()
+		p.SetKeyword("keyword")
+		p.SetNetworkdeviceparameterlist(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetNetworkdevicetype("networkdevicetype")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":                             {"keyword"},
+			"networkdeviceparameterlist[0].key":   {"key1"},
+			"networkdeviceparameterlist[0].value": {"value1"},
+			"networkdeviceparameterlist[1].key":   {"key2"},
+			"networkdeviceparameterlist[1].value": {"value2"},
+			"networkdevicetype":                   {"networkdevicetype"},
+			"page":                                {"1"},
+			"pagesize":                            {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkDevice.ListNetworkDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetworkDevice) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetworkDevice))
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -90,3 +92,215 @@ func TestNetworkOfferingService(t *testing.T) {
 	t.Run("UpdateNetworkOffering", testupdateNetworkOffering)
 
 }
+
+func TestNetworkOfferingServiceFixtures(t *testing.T) {
+	response, err := readData("generated/NetworkOfferingService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateNetworkOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "createNetworkOffering")
+
+		p := client.NetworkOffering.NewCreateNetworkOfferingParams("displaytext", "guestiptype", "name", "traffictype")
+		p.SetAvailability("availability")
+		p.SetConservemode(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDomainid([]string{"domainid1", "domainid2"})
+		p.SetEgressdefaultpolicy(true)
+		p.SetEnable(true)
+		p.SetFortungsten(true)
+		p.SetForvpc(true)
+		p.SetInternetprotocol("internetprotocol")
+		p.SetIspersistent(true)
+		p.SetKeepaliveenabled(true)
+		p.SetMaxconnections(1)
+		p.SetNetworkrate(1)
+		p.SetServicecapabilitylist(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetServiceofferingid("serviceofferingid")
+		p.SetServiceproviderlist(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetSpecifyipranges(true)
+		p.SetSpecifyvlan(true)
+		p.SetSupportedservices([]string{"supportedservices1", "supportedservices2"})
+		p.SetTags("tags")
+		p.SetZoneid([]string{"zoneid1", "zoneid2"})
+
+		expected := url.Values{
+			"availability":                    {"availability"},
+			"conservemode":                    {"true"},
+			"details[0].key1":                 {"value1"},
+			"details[1].key2":                 {"value2"},
+			"displaytext":                     {"displaytext"},
+			"domainid":                        {"domainid1,domainid2"},
+			"egressdefaultpolicy":             {"true"},
+			"enable":                          {"true"},
+			"fortungsten":                     {"true"},
+			"forvpc":                          {"true"},
+			"guestiptype":                     {"guestiptype"},
+			"internetprotocol":                {"internetprotocol"},
+			"ispersistent":                    {"true"},
+			"keepaliveenabled":                {"true"},
+			"maxconnections":                  {"1"},
+			"name":                            {"name"},
+			"networkrate":                     {"1"},
+			"servicecapabilitylist[0].key":    {"key1"},
+			"servicecapabilitylist[0].value":  {"value1"},
+			"servicecapabilitylist[1].key":    {"key2"},
+			"servicecapabilitylist[1].value":  {"value2"},
+			"serviceofferingid":               {"serviceofferingid"},
+			"serviceproviderlist[0].provider": {"value1"},
+			"serviceproviderlist[0].service":  {"key1"},
+			"serviceproviderlist[1].provider": {"value2"},
+			"serviceproviderlist[1].service":  {"key2"},
+			"specifyipranges":                 {"true"},
+			"specifyvlan":                     {"true"},
+			"supportedservices":               {"supportedservices1,supportedservices2"},
+			"tags":                            {"tags"},
+			"traffictype":                     {"traffictype"},
+			"zoneid":                          {"zoneid1,zoneid2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkOffering.CreateNetworkOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "4a52a65f-a3ab-0a85-df89-3064d37ded3a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteNetworkOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNetworkOffering")
+
+		p := client.NetworkOffering.NewDeleteNetworkOfferingParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkOffering.DeleteNetworkOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListNetworkOfferings", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworkOfferings")
+
+		p := client.NetworkOffering.NewListNetworkOfferingsParams()
+		p.SetAvailability("availability")
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetForvpc(true)
+		p.SetGuestiptype("guestiptype")
+		p.SetId("id")
+		p.SetIsdefault(true)
+		p.SetIstagged(true)
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetSourcenatsupported(true)
+		p.SetSpecifyipranges(true)
+		p.SetSpecifyvlan(true)
+		p.SetState("state")
+		p.SetSupportedservices([]string{"supportedservices1", "supportedservices2"})
+		p.SetTags("tags")
+		p.SetTraffictype("traffictype")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"availability":       {"availability"},
+			"displaytext":        {"displaytext"},
+			"domainid":           {"domainid"},
+			"forvpc":             {"true"},
+			"guestiptype":        {"guestiptype"},
+			"id":                 {"id"},
+			"isdefault":          {"true"},
+			"istagged":           {"true"},
+			"keyword":            {"keyword"},
+			"name":               {"name"},
+			"networkid":          {"networkid"},
+			"page":               {"1"},
+			"pagesize":           {"1"},
+			"sourcenatsupported": {"true"},
+			"specifyipranges":    {"true"},
+			"specifyvlan":        {"true"},
+			"state":              {"state"},
+			"supportedservices":  {"supportedservices1,supportedservices2"},
+			"tags":               {"tags"},
+			"traffictype":        {"traffictype"},
+			"zoneid":             {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkOffering.ListNetworkOfferings(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetworkOfferings) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetworkOfferings))
+		}
+		if r.NetworkOfferings[0].Id != "230432e7-8c29-583c-143a-28f2c241bd1b" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.NetworkOfferings[0].Id)
+		}
+	})
+
+	t.Run("UpdateNetworkOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "updateNetworkOffering")
+
+		p := client.NetworkOffering.NewUpdateNetworkOfferingParams()
+		p.SetAvailability("availability")
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetKeepaliveenabled(true)
+		p.SetMaxconnections(1)
+		p.SetName("name")
+		p.SetSortkey(1)
+		p.SetState("state")
+		p.SetTags("tags")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"availability":     {"availability"},
+			"displaytext":      {"displaytext"},
+			"domainid":         {"domainid"},
+			"id":               {"id"},
+			"keepaliveenabled": {"true"},
+			"maxconnections":   {"1"},
+			"name":             {"name"},
+			"sortkey":          {"1"},
+			"state":            {"state"},
+			"tags":             {"tags"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NetworkOffering.UpdateNetworkOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "89a14889-b336-6fb7-4adf-ffb9b9567260" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -495,3 +497,1102 @@ func TestNetworkService(t *testing.T) {
 	t.Run("RemoveNetworkPermissions", testremoveNetworkPermissions)
 
 }
+
+func TestNetworkServiceFixtures(t *testing.T) {
+	response, err := readData("generated/NetworkService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddNetworkServiceProvider", func(t *testing.T) {
+		defer server.checkCommands(t, "addNetworkServiceProvider", "queryAsyncJobResult")
+
+		p := client.Network.NewAddNetworkServiceProviderParams("name", "physicalnetworkid")
+		p.SetDestinationphysicalnetworkid("destinationphysicalnetworkid")
+		p.SetServicelist([]string{"servicelist1", "servicelist2"})
+
+		expected := url.Values{
+			"destinationphysicalnetworkid": {"destinationphysicalnetworkid"},
+			"name":                         {"name"},
+			"physicalnetworkid":            {"physicalnetworkid"},
+			"servicelist":                  {"servicelist1,servicelist2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.AddNetworkServiceProvider(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "941739e7-f6d7-37bd-7d13-a0891adfe341" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddOpenDaylightController", func(t *testing.T) {
+		defer server.checkCommands(t, "addOpenDaylightController", "queryAsyncJobResult")
+
+		p := client.Network.NewAddOpenDaylightControllerParams("password", "physicalnetworkid", "url", "username")
+
+		expected := url.Values{
+			"password":          {"password"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"url":               {"url"},
+			"username":          {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.AddOpenDaylightController(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8dea82dc-c686-4296-115b-18df3b050664" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateNetwork", func(t *testing.T) {
+		defer server.checkCommands(t, "createNetwork")
+
+		p := client.Network.NewCreateNetworkParams("name", "networkofferingid", "zoneid")
+		p.SetAccount("account")
+		p.SetAclid("aclid")
+		p.SetAcltype("acltype")
+		p.SetAssociatednetworkid("associatednetworkid")
+		p.SetBypassvlanoverlapcheck(true)
+		p.SetDisplaynetwork(true)
+		p.SetDisplaytext("displaytext")
+		p.SetDns1("dns1")
+		p.SetDns2("dns2")
+		p.SetDomainid("domainid")
+		p.SetEndip("endip")
+		p.SetEndipv6("endipv6")
+		p.SetExternalid("externalid")
+		p.SetGateway("gateway")
+		p.SetHideipaddressusage(true)
+		p.SetIp6cidr("ip6cidr")
+		p.SetIp6dns1("ip6dns1")
+		p.SetIp6dns2("ip6dns2")
+		p.SetIp6gateway("ip6gateway")
+		p.SetIsolatedpvlan("isolatedpvlan")
+		p.SetIsolatedpvlantype("isolatedpvlantype")
+		p.SetNetmask("netmask")
+		p.SetNetworkdomain("networkdomain")
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetPrivatemtu(1)
+		p.SetProjectid("projectid")
+		p.SetPublicmtu(1)
+		p.SetRouterip("routerip")
+		p.SetRouteripv6("routeripv6")
+		p.SetSourcenatipaddress("sourcenatipaddress")
+		p.SetStartip("startip")
+		p.SetStartipv6("startipv6")
+		p.SetSubdomainaccess(true)
+		p.SetTungstenvirtualrouteruuid("tungstenvirtualrouteruuid")
+		p.SetVlan("vlan")
+		p.SetVpcid("vpcid")
+
+		expected := url.Values{
+			"account":                   {"account"},
+			"aclid":                     {"aclid"},
+			"acltype":                   {"acltype"},
+			"associatednetworkid":       {"associatednetworkid"},
+			"bypassvlanoverlapcheck":    {"true"},
+			"displaynetwork":            {"true"},
+			"displaytext":               {"displaytext"},
+			"dns1":                      {"dns1"},
+			"dns2":                      {"dns2"},
+			"domainid":                  {"domainid"},
+			"endip":                     {"endip"},
+			"endipv6":                   {"endipv6"},
+			"externalid":                {"externalid"},
+			"gateway":                   {"gateway"},
+			"hideipaddressusage":        {"true"},
+			"ip6cidr":                   {"ip6cidr"},
+			"ip6dns1":                   {"ip6dns1"},
+			"ip6dns2":                   {"ip6dns2"},
+			"ip6gateway":                {"ip6gateway"},
+			"isolatedpvlan":             {"isolatedpvlan"},
+			"isolatedpvlantype":         {"isolatedpvlantype"},
+			"name":                      {"name"},
+			"netmask":                   {"netmask"},
+			"networkdomain":             {"networkdomain"},
+			"networkofferingid":         {"networkofferingid"},
+			"physicalnetworkid":         {"physicalnetworkid"},
+			"privatemtu":                {"1"},
+			"projectid":                 {"projectid"},
+			"publicmtu":                 {"1"},
+			"routerip":                  {"routerip"},
+			"routeripv6":                {"routeripv6"},
+			"sourcenatipaddress":        {"sourcenatipaddress"},
+			"startip":                   {"startip"},
+			"startipv6":                 {"startipv6"},
+			"subdomainaccess":           {"true"},
+			"tungstenvirtualrouteruuid": {"tungstenvirtualrouteruuid"},
+			"vlan":                      {"vlan"},
+			"vpcid":                     {"vpcid"},
+			"zoneid":                    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.CreateNetwork(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "4abbaabc-7f62-acb4-c971-95726986673f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreatePhysicalNetwork", func(t *testing.T) {
+		defer server.checkCommands(t, "createPhysicalNetwork", "queryAsyncJobResult")
+
+		p := client.Network.NewCreatePhysicalNetworkParams("name", "zoneid")
+		p.SetBroadcastdomainrange("broadcastdomainrange")
+		p.SetDomainid("domainid")
+		p.SetIsolationmethods([]string{"isolationmethods1", "isolationmethods2"})
+		p.SetNetworkspeed("networkspeed")
+		p.SetTags([]string{"tags1", "tags2"})
+		p.SetVlan("vlan")
+
+		expected := url.Values{
+			"broadcastdomainrange": {"broadcastdomainrange"},
+			"domainid":             {"domainid"},
+			"isolationmethods":     {"isolationmethods1,isolationmethods2"},
+			"name":                 {"name"},
+			"networkspeed":         {"networkspeed"},
+			"tags":                 {"tags1,tags2"},
+			"vlan":                 {"vlan"},
+			"zoneid":               {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.CreatePhysicalNetwork(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7b8cbea4-094f-a7ce-bbf6-1cbdd2ce0bf2" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateServiceInstance", func(t *testing.T) {
+		defer server.checkCommands(t, "createServiceInstance", "queryAsyncJobResult")
+
+		p := client.Network.NewCreateServiceInstanceParams("leftnetworkid", "name", "rightnetworkid", "serviceofferingid", "templateid", "zoneid")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"domainid":          {"domainid"},
+			"leftnetworkid":     {"leftnetworkid"},
+			"name":              {"name"},
+			"projectid":         {"projectid"},
+			"rightnetworkid":    {"rightnetworkid"},
+			"serviceofferingid": {"serviceofferingid"},
+			"templateid":        {"templateid"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.CreateServiceInstance(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0d8cf59a-4ce2-7305-81a5-d5d1928022fc" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateStorageNetworkIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "createStorageNetworkIpRange", "queryAsyncJobResult")
+
+		p := client.Network.NewCreateStorageNetworkIpRangeParams("gateway", "netmask", "podid", "startip")
+		p.SetEndip("endip")
+		p.SetVlan(1)
+
+		expected := url.Values{
+			"endip":   {"endip"},
+			"gateway": {"gateway"},
+			"netmask": {"netmask"},
+			"podid":   {"podid"},
+			"startip": {"startip"},
+			"vlan":    {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.CreateStorageNetworkIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d842848c-1596-38db-1435-6fca85701e87" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DedicatePublicIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "dedicatePublicIpRange")
+
+		p := client.Network.NewDedicatePublicIpRangeParams("domainid", "id")
+		p.SetAccount("account")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"id":        {"id"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.DedicatePublicIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9aaa359b-076f-85cb-447e-887d94c6f5d5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteNetwork", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNetwork", "queryAsyncJobResult")
+
+		p := client.Network.NewDeleteNetworkParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.DeleteNetwork(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteNetworkServiceProvider", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNetworkServiceProvider", "queryAsyncJobResult")
+
+		p := client.Network.NewDeleteNetworkServiceProviderParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.DeleteNetworkServiceProvider(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteOpenDaylightController", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteOpenDaylightController", "queryAsyncJobResult")
+
+		p := client.Network.NewDeleteOpenDaylightControllerParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.DeleteOpenDaylightController(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7c8583aa-46b7-3f1f-c3be-c9a7420d642b" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeletePhysicalNetwork", func(t *testing.T) {
+		defer server.checkCommands(t, "deletePhysicalNetwork", "queryAsyncJobResult")
+
+		p := client.Network.NewDeletePhysicalNetworkParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.DeletePhysicalNetwork(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteStorageNetworkIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteStorageNetworkIpRange", "queryAsyncJobResult")
+
+		p := client.Network.NewDeleteStorageNetworkIpRangeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.DeleteStorageNetworkIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListNetscalerLoadBalancerNetworks", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetscalerLoadBalancerNetworks")
+
+		p := client.Network.NewListNetscalerLoadBalancerNetworksParams("lbdeviceid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":    {"keyword"},
+			"lbdeviceid": {"lbdeviceid"},
+			"page":       {"1"},
+			"pagesize":   {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListNetscalerLoadBalancerNetworks(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetscalerLoadBalancerNetworks) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetscalerLoadBalancerNetworks))
+		}
+		if r.NetscalerLoadBalancerNetworks[0].Id != "50868d7a-71b9-13ce-8849-cf61881ff2c9" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.NetscalerLoadBalancerNetworks[0].Id)
+		}
+	})
+
+	t.Run("ListNetworkIsolationMethods", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworkIsolationMethods")
+
+		p := client.Network.NewListNetworkIsolationMethodsParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListNetworkIsolationMethods(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetworkIsolationMethods) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetworkIsolationMethods))
+		}
+	})
+
+	t.Run("ListNetworkServiceProviders", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworkServiceProviders")
+
+		p := client.Network.NewListNetworkServiceProvidersParams()
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetState("state")
+
+		expected := url.Values{
+			"keyword":           {"keyword"},
+			"name":              {"name"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"state":             {"state"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListNetworkServiceProviders(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetworkServiceProviders) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetworkServiceProviders))
+		}
+		if r.NetworkServiceProviders[0].Id != "4d34d59c-5fd3-2fa8-c565-96791ab4b8b7" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.NetworkServiceProviders[0].Id)
+		}
+	})
+
+	t.Run("ListNetworks", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworks")
+
+		p := client.Network.NewListNetworksParams()
+		p.SetAccount("account")
+		p.SetAcltype("acltype")
+		p.SetAssociatednetworkid("associatednetworkid")
+		p.SetCanusefordeploy(true)
+		p.SetDisplaynetwork(true)
+		p.SetDomainid("domainid")
+		p.SetForvpc(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetIssystem(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkfilter("networkfilter")
+		p.SetNetworkofferingid("networkofferingid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetProjectid("projectid")
+		p.SetRestartrequired(true)
+		p.SetRetrieveonlyresourcecount(true)
+		p.SetShowicon(true)
+		p.SetSpecifyipranges(true)
+		p.SetSupportedservices([]string{"supportedservices1", "supportedservices2"})
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetTraffictype("traffictype")
+		p.SetType("type")
+		p.SetVlan("vlan")
+		p.SetVpcid("vpcid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                   {"account"},
+			"acltype":                   {"acltype"},
+			"associatednetworkid":       {"associatednetworkid"},
+			"canusefordeploy":           {"true"},
+			"displaynetwork":            {"true"},
+			"domainid":                  {"domainid"},
+			"forvpc":                    {"true"},
+			"id":                        {"id"},
+			"isrecursive":               {"true"},
+			"issystem":                  {"true"},
+			"keyword":                   {"keyword"},
+			"listall":                   {"true"},
+			"networkfilter":             {"networkfilter"},
+			"networkofferingid":         {"networkofferingid"},
+			"page":                      {"1"},
+			"pagesize":                  {"1"},
+			"physicalnetworkid":         {"physicalnetworkid"},
+			"projectid":                 {"projectid"},
+			"restartrequired":           {"true"},
+			"retrieveonlyresourcecount": {"true"},
+			"showicon":                  {"true"},
+			"specifyipranges":           {"true"},
+			"supportedservices":         {"supportedservices1,supportedservices2"},
+			"tags[0].key":               {"key1"},
+			"tags[0].value":             {"value1"},
+			"tags[1].key":               {"key2"},
+			"tags[1].value":             {"value2"},
+			"traffictype":               {"traffictype"},
+			"type":                      {"type"},
+			"vlan":                      {"vlan"},
+			"vpcid":                     {"vpcid"},
+			"zoneid":                    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListNetworks(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Networks) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Networks))
+		}
+		if r.Networks[0].Id != "1bf31743-d16a-92da-888d-7aa85b4852ad" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Networks[0].Id)
+		}
+	})
+
+	t.Run("ListNiciraNvpDeviceNetworks", func(t *testing.T) {
+		defer server.checkCommands(t, "listNiciraNvpDeviceNetworks")
+
+		p := client.Network.NewListNiciraNvpDeviceNetworksParams("nvpdeviceid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":     {"keyword"},
+			"nvpdeviceid": {"nvpdeviceid"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListNiciraNvpDeviceNetworks(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NiciraNvpDeviceNetworks) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NiciraNvpDeviceNetworks))
+		}
+		if r.NiciraNvpDeviceNetworks[0].Id != "e7b2262b-c8d9-ba3e-2996-18057709ea07" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.NiciraNvpDeviceNetworks[0].Id)
+		}
+	})
+
+	t.Run("ListOpenDaylightControllers", func(t *testing.T) {
+		defer server.checkCommands(t, "listOpenDaylightControllers")
+
+		p := client.Network.NewListOpenDaylightControllersParams()
+		p.SetId("id")
+		p.SetPhysicalnetworkid("physicalnetworkid")
+
+		expected := url.Values{
+			"id":                {"id"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListOpenDaylightControllers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.OpenDaylightControllers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.OpenDaylightControllers))
+		}
+		if r.OpenDaylightControllers[0].Id != "b3a54fee-2b42-82e2-7b13-6ec1856b3e49" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.OpenDaylightControllers[0].Id)
+		}
+	})
+
+	t.Run("ListPaloAltoFirewallNetworks", func(t *testing.T) {
+		defer server.checkCommands(t, "listPaloAltoFirewallNetworks")
+
+		p := client.Network.NewListPaloAltoFirewallNetworksParams("lbdeviceid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":    {"keyword"},
+			"lbdeviceid": {"lbdeviceid"},
+			"page":       {"1"},
+			"pagesize":   {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListPaloAltoFirewallNetworks(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.PaloAltoFirewallNetworks) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.PaloAltoFirewallNetworks))
+		}
+		if r.PaloAltoFirewallNetworks[0].Id != "2abfb73a-f5a4-eb81-7704-e12b5fe3cc68" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.PaloAltoFirewallNetworks[0].Id)
+		}
+	})
+
+	t.Run("ListPhysicalNetworks", func(t *testing.T) {
+		defer server.checkCommands(t, "listPhysicalNetworks")
+
+		p := client.Network.NewListPhysicalNetworksParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListPhysicalNetworks(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.PhysicalNetworks) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.PhysicalNetworks))
+		}
+		if r.PhysicalNetworks[0].Id != "a8381cb2-e54d-4285-73a0-81cdeb8c3093" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.PhysicalNetworks[0].Id)
+		}
+	})
+
+	t.Run("ListStorageNetworkIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "listStorageNetworkIpRange")
+
+		p := client.Network.NewListStorageNetworkIpRangeParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"podid":    {"podid"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListStorageNetworkIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.StorageNetworkIpRange) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.StorageNetworkIpRange))
+		}
+	})
+
+	t.Run("ListSupportedNetworkServices", func(t *testing.T) {
+		defer server.checkCommands(t, "listSupportedNetworkServices")
+
+		p := client.Network.NewListSupportedNetworkServicesParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProvider("provider")
+		p.SetService("service")
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"provider": {"provider"},
+			"service":  {"service"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListSupportedNetworkServices(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.SupportedNetworkServices) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.SupportedNetworkServices))
+		}
+	})
+
+	t.Run("ReleasePublicIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "releasePublicIpRange")
+
+		p := client.Network.NewReleasePublicIpRangeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ReleasePublicIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RestartNetwork", func(t *testing.T) {
+		defer server.checkCommands(t, "restartNetwork", "queryAsyncJobResult")
+
+		p := client.Network.NewRestartNetworkParams("id")
+		p.SetCleanup(true)
+		p.SetLivepatch(true)
+		p.SetMakeredundant(true)
+
+		expected := url.Values{
+			"cleanup":       {"true"},
+			"id":            {"id"},
+			"livepatch":     {"true"},
+			"makeredundant": {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.RestartNetwork(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateNetwork", func(t *testing.T) {
+		defer server.checkCommands(t, "updateNetwork", "queryAsyncJobResult")
+
+		p := client.Network.NewUpdateNetworkParams("id")
+		p.SetChangecidr(true)
+		p.SetCustomid("customid")
+		p.SetDisplaynetwork(true)
+		p.SetDisplaytext("displaytext")
+		p.SetDns1("dns1")
+		p.SetDns2("dns2")
+		p.SetForced(true)
+		p.SetGuestvmcidr("guestvmcidr")
+		p.SetHideipaddressusage(true)
+		p.SetIp6dns1("ip6dns1")
+		p.SetIp6dns2("ip6dns2")
+		p.SetName("name")
+		p.SetNetworkdomain("networkdomain")
+		p.SetNetworkofferingid("networkofferingid")
+		p.SetPrivatemtu(1)
+		p.SetPublicmtu(1)
+		p.SetSourcenatipaddress("sourcenatipaddress")
+		p.SetUpdateinsequence(true)
+
+		expected := url.Values{
+			"changecidr":         {"true"},
+			"customid":           {"customid"},
+			"displaynetwork":     {"true"},
+			"displaytext":        {"displaytext"},
+			"dns1":               {"dns1"},
+			"dns2":               {"dns2"},
+			"forced":             {"true"},
+			"guestvmcidr":        {"guestvmcidr"},
+			"hideipaddressusage": {"true"},
+			"id":                 {"id"},
+			"ip6dns1":            {"ip6dns1"},
+			"ip6dns2":            {"ip6dns2"},
+			"name":               {"name"},
+			"networkdomain":      {"networkdomain"},
+			"networkofferingid":  {"networkofferingid"},
+			"privatemtu":         {"1"},
+			"publicmtu":          {"1"},
+			"sourcenatipaddress": {"sourcenatipaddress"},
+			"updateinsequence":   {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.UpdateNetwork(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "45261ac1-46f5-a2fe-d3a1-0c7cc3751a49" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateNetworkServiceProvider", func(t *testing.T) {
+		defer server.checkCommands(t, "updateNetworkServiceProvider", "queryAsyncJobResult")
+
+		p := client.Network.NewUpdateNetworkServiceProviderParams("id")
+		p.SetServicelist([]string{"servicelist1", "servicelist2"})
+		p.SetState("state")
+
+		expected := url.Values{
+			"id":          {"id"},
+			"servicelist": {"servicelist1,servicelist2"},
+			"state":       {"state"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.UpdateNetworkServiceProvider(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "17a485f2-eee5-d7fa-c785-a2d597b50980" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdatePhysicalNetwork", func(t *testing.T) {
+		defer server.checkCommands(t, "updatePhysicalNetwork", "queryAsyncJobResult")
+
+		p := client.Network.NewUpdatePhysicalNetworkParams("id")
+		p.SetNetworkspeed("networkspeed")
+		p.SetState("state")
+		p.SetTags([]string{"tags1", "tags2"})
+		p.SetVlan("vlan")
+
+		expected := url.Values{
+			"id":           {"id"},
+			"networkspeed": {"networkspeed"},
+			"state":        {"state"},
+			"tags":         {"tags1,tags2"},
+			"vlan":         {"vlan"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.UpdatePhysicalNetwork(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "03d8f8f1-beb6-d4ca-6b2d-a76329ad7a0f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateStorageNetworkIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "updateStorageNetworkIpRange", "queryAsyncJobResult")
+
+		p := client.Network.NewUpdateStorageNetworkIpRangeParams("id")
+		p.SetEndip("endip")
+		p.SetNetmask("netmask")
+		p.SetStartip("startip")
+		p.SetVlan(1)
+
+		expected := url.Values{
+			"endip":   {"endip"},
+			"id":      {"id"},
+			"netmask": {"netmask"},
+			"startip": {"startip"},
+			"vlan":    {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.UpdateStorageNetworkIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "3341f097-6937-bd22-961c-fd600e9a03d6" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteGuestNetworkIpv6Prefix", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteGuestNetworkIpv6Prefix", "queryAsyncJobResult")
+
+		p := client.Network.NewDeleteGuestNetworkIpv6PrefixParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.DeleteGuestNetworkIpv6Prefix(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("CreateGuestNetworkIpv6Prefix", func(t *testing.T) {
+		defer server.checkCommands(t, "createGuestNetworkIpv6Prefix", "queryAsyncJobResult")
+
+		p := client.Network.NewCreateGuestNetworkIpv6PrefixParams("prefix", "zoneid")
+
+		expected := url.Values{
+			"prefix": {"prefix"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.CreateGuestNetworkIpv6Prefix(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "a87cdff0-20bb-0f8a-5c04-80e9dc174bef" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListGuestNetworkIpv6Prefixes", func(t *testing.T) {
+		defer server.checkCommands(t, "listGuestNetworkIpv6Prefixes")
+
+		p := client.Network.NewListGuestNetworkIpv6PrefixesParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListGuestNetworkIpv6Prefixes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.GuestNetworkIpv6Prefixes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.GuestNetworkIpv6Prefixes))
+		}
+	})
+
+	t.Run("CreateNetworkPermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "createNetworkPermissions")
+
+		p := client.Network.NewCreateNetworkPermissionsParams("networkid")
+		p.SetAccountids([]string{"accountids1", "accountids2"})
+		p.SetAccounts([]string{"accounts1", "accounts2"})
+		p.SetProjectids([]string{"projectids1", "projectids2"})
+
+		expected := url.Values{
+			"accountids": {"accountids1,accountids2"},
+			"accounts":   {"accounts1,accounts2"},
+			"networkid":  {"networkid"},
+			"projectids": {"projectids1,projectids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.CreateNetworkPermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ResetNetworkPermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "resetNetworkPermissions")
+
+		p := client.Network.NewResetNetworkPermissionsParams("networkid")
+
+		expected := url.Values{
+			"networkid": {"networkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ResetNetworkPermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListNetworkPermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "listNetworkPermissions")
+
+		p := client.Network.NewListNetworkPermissionsParams("networkid")
+
+		expected := url.Values{
+			"networkid": {"networkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.ListNetworkPermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NetworkPermissions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NetworkPermissions))
+		}
+	})
+
+	t.Run("RemoveNetworkPermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "removeNetworkPermissions")
+
+		p := client.Network.NewRemoveNetworkPermissionsParams("networkid")
+		p.SetAccountids([]string{"accountids1", "accountids2"})
+		p.SetAccounts([]string{"accounts1", "accounts2"})
+		p.SetProjectids([]string{"projectids1", "projectids2"})
+
+		expected := url.Values{
+			"accountids": {"accountids1,accountids2"},
+			"accounts":   {"accounts1,accounts2"},
+			"networkid":  {"networkid"},
+			"projectids": {"projectids1,projectids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Network.RemoveNetworkPermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -90,3 +92,114 @@ func TestNicService(t *testing.T) {
 	t.Run("UpdateVmNicIp", testupdateVmNicIp)
 
 }
+
+func TestNicServiceFixtures(t *testing.T) {
+	response, err := readData("generated/NicService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddIpToNic", func(t *testing.T) {
+		defer server.checkCommands(t, "addIpToNic", "queryAsyncJobResult")
+
+		p := client.Nic.NewAddIpToNicParams("nicid")
+		p.SetIpaddress("ipaddress")
+
+		expected := url.Values{
+			"ipaddress": {"ipaddress"},
+			"nicid":     {"nicid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Nic.AddIpToNic(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "407c2605-c51f-a614-8b19-4eee88a74bd3" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListNics", func(t *testing.T) {
+		defer server.checkCommands(t, "listNics")
+
+		p := client.Nic.NewListNicsParams("virtualmachineid")
+		p.SetFordisplay(true)
+		p.SetKeyword("keyword")
+		p.SetNetworkid("networkid")
+		p.SetNicid("nicid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"fordisplay":       {"true"},
+			"keyword":          {"keyword"},
+			"networkid":        {"networkid"},
+			"nicid":            {"nicid"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Nic.ListNics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Nics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Nics))
+		}
+	})
+
+	t.Run("RemoveIpFromNic", func(t *testing.T) {
+		defer server.checkCommands(t, "removeIpFromNic", "queryAsyncJobResult")
+
+		p := client.Nic.NewRemoveIpFromNicParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Nic.RemoveIpFromNic(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateVmNicIp", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVmNicIp", "queryAsyncJobResult")
+
+		p := client.Nic.NewUpdateVmNicIpParams("nicid")
+		p.SetIpaddress("ipaddress")
+
+		expected := url.Values{
+			"ipaddress": {"ipaddress"},
+			"nicid":     {"nicid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Nic.UpdateVmNicIp(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "3ba88bf0-692e-6d5a-e5c3-5ae7f1fee6b6" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -72,3 +74,92 @@ func TestNiciraNVPService(t *testing.T) {
 	t.Run("ListNiciraNvpDevices", testlistNiciraNvpDevices)
 
 }
+
+func TestNiciraNVPServiceFixtures(t *testing.T) {
+	response, err := readData("generated/NiciraNVPService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddNiciraNvpDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "addNiciraNvpDevice", "queryAsyncJobResult")
+
+		p := client.NiciraNVP.NewAddNiciraNvpDeviceParams("hostname", "password", "physicalnetworkid", "transportzoneuuid", "username")
+		p.SetL2gatewayserviceuuid("l2gatewayserviceuuid")
+		p.SetL3gatewayserviceuuid("l3gatewayserviceuuid")
+
+		expected := url.Values{
+			"hostname":             {"hostname"},
+			"l2gatewayserviceuuid": {"l2gatewayserviceuuid"},
+			"l3gatewayserviceuuid": {"l3gatewayserviceuuid"},
+			"password":             {"password"},
+			"physicalnetworkid":    {"physicalnetworkid"},
+			"transportzoneuuid":    {"transportzoneuuid"},
+			"username":             {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NiciraNVP.AddNiciraNvpDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DeleteNiciraNvpDevice", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteNiciraNvpDevice", "queryAsyncJobResult")
+
+		p := client.NiciraNVP.NewDeleteNiciraNvpDeviceParams("nvpdeviceid")
+
+		expected := url.Values{
+			"nvpdeviceid": {"nvpdeviceid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NiciraNVP.DeleteNiciraNvpDevice(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListNiciraNvpDevices", func(t *testing.T) {
+		defer server.checkCommands(t, "listNiciraNvpDevices")
+
+		p := client.NiciraNVP.NewListNiciraNvpDevicesParams()
+		p.SetKeyword("keyword")
+		p.SetNvpdeviceid("nvpdeviceid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+
+		expected := url.Values{
+			"keyword":           {"keyword"},
+			"nvpdeviceid":       {"nvpdeviceid"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.NiciraNVP.ListNiciraNvpDevices(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.NiciraNvpDevices) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.NiciraNvpDevices))
+		}
+	})
+
+}
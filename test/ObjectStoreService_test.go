@@ -0,0 +1,407 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+func TestObjectStoreService(t *testing.T) {
+	service := "ObjectStoreService"
+	response, err := readData(service)
+	if err != nil {
+		t.Skipf("Skipping test as %v", err)
+	}
+	server := CreateTestServer(t, response)
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	testaddObjectStoragePool := func(t *testing.T) {
+		if _, ok := response["addObjectStoragePool"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewAddObjectStoragePoolParams("name", "provider", "url")
+		r, err := client.ObjectStore.AddObjectStoragePool(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("AddObjectStoragePool", testaddObjectStoragePool)
+
+	testcreateBucket := func(t *testing.T) {
+		if _, ok := response["createBucket"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewCreateBucketParams("name", "objectstorageid")
+		r, err := client.ObjectStore.CreateBucket(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("CreateBucket", testcreateBucket)
+
+	testdeleteBucket := func(t *testing.T) {
+		if _, ok := response["deleteBucket"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewDeleteBucketParams("id")
+		_, err := client.ObjectStore.DeleteBucket(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("DeleteBucket", testdeleteBucket)
+
+	testdeleteObjectStoragePool := func(t *testing.T) {
+		if _, ok := response["deleteObjectStoragePool"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewDeleteObjectStoragePoolParams("id")
+		_, err := client.ObjectStore.DeleteObjectStoragePool(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("DeleteObjectStoragePool", testdeleteObjectStoragePool)
+
+	testlistBuckets := func(t *testing.T) {
+		if _, ok := response["listBuckets"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewListBucketsParams()
+		_, err := client.ObjectStore.ListBuckets(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListBuckets", testlistBuckets)
+
+	testlistObjectStoragePools := func(t *testing.T) {
+		if _, ok := response["listObjectStoragePools"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewListObjectStoragePoolsParams()
+		_, err := client.ObjectStore.ListObjectStoragePools(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListObjectStoragePools", testlistObjectStoragePools)
+
+	testupdateBucket := func(t *testing.T) {
+		if _, ok := response["updateBucket"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewUpdateBucketParams("id")
+		_, err := client.ObjectStore.UpdateBucket(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("UpdateBucket", testupdateBucket)
+
+	testupdateObjectStoragePool := func(t *testing.T) {
+		if _, ok := response["updateObjectStoragePool"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.ObjectStore.NewUpdateObjectStoragePoolParams("id")
+		r, err := client.ObjectStore.UpdateObjectStoragePool(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("UpdateObjectStoragePool", testupdateObjectStoragePool)
+
+}
+
+func TestObjectStoreServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ObjectStoreService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddObjectStoragePool", func(t *testing.T) {
+		defer server.checkCommands(t, "addObjectStoragePool")
+
+		p := client.ObjectStore.NewAddObjectStoragePoolParams("name", "provider", "url")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetTags("tags")
+
+		expected := url.Values{
+			"details[0].key":   {"key1"},
+			"details[0].value": {"value1"},
+			"details[1].key":   {"key2"},
+			"details[1].value": {"value2"},
+			"name":             {"name"},
+			"provider":         {"provider"},
+			"tags":             {"tags"},
+			"url":              {"url"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.AddObjectStoragePool(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "1e1fb167-fde5-b2c8-e512-985e6bd7a86d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateBucket", func(t *testing.T) {
+		defer server.checkCommands(t, "createBucket", "queryAsyncJobResult")
+
+		p := client.ObjectStore.NewCreateBucketParams("name", "objectstorageid")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetEncryption(true)
+		p.SetObjectlocking(true)
+		p.SetPolicy("policy")
+		p.SetProjectid("projectid")
+		p.SetQuota(1)
+		p.SetVersioning(true)
+
+		expected := url.Values{
+			"account":         {"account"},
+			"domainid":        {"domainid"},
+			"encryption":      {"true"},
+			"name":            {"name"},
+			"objectlocking":   {"true"},
+			"objectstorageid": {"objectstorageid"},
+			"policy":          {"policy"},
+			"projectid":       {"projectid"},
+			"quota":           {"1"},
+			"versioning":      {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.CreateBucket(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cb4574de-4610-f43c-3cbe-a69fea70228a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteBucket", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteBucket")
+
+		p := client.ObjectStore.NewDeleteBucketParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.DeleteBucket(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteObjectStoragePool", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteObjectStoragePool")
+
+		p := client.ObjectStore.NewDeleteObjectStoragePoolParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.DeleteObjectStoragePool(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListBuckets", func(t *testing.T) {
+		defer server.checkCommands(t, "listBuckets")
+
+		p := client.ObjectStore.NewListBucketsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetObjectstorageid("objectstorageid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":         {"account"},
+			"domainid":        {"domainid"},
+			"id":              {"id"},
+			"ids":             {"ids1,ids2"},
+			"isrecursive":     {"true"},
+			"keyword":         {"keyword"},
+			"listall":         {"true"},
+			"name":            {"name"},
+			"objectstorageid": {"objectstorageid"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+			"projectid":       {"projectid"},
+			"tags[0].key":     {"key1"},
+			"tags[0].value":   {"value1"},
+			"tags[1].key":     {"key2"},
+			"tags[1].value":   {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.ListBuckets(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Buckets) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Buckets))
+		}
+		if r.Buckets[0].Id != "abb228a2-cc2a-ac42-c173-5cc04bfaabe5" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Buckets[0].Id)
+		}
+	})
+
+	t.Run("ListObjectStoragePools", func(t *testing.T) {
+		defer server.checkCommands(t, "listObjectStoragePools")
+
+		p := client.ObjectStore.NewListObjectStoragePoolsParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProvider("provider")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"provider": {"provider"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.ListObjectStoragePools(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ObjectStoragePools) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ObjectStoragePools))
+		}
+		if r.ObjectStoragePools[0].Id != "f22a7ee6-cedd-f7a3-3f3f-a6c3ebd43793" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.ObjectStoragePools[0].Id)
+		}
+	})
+
+	t.Run("UpdateBucket", func(t *testing.T) {
+		defer server.checkCommands(t, "updateBucket")
+
+		p := client.ObjectStore.NewUpdateBucketParams("id")
+		p.SetEncryption(true)
+		p.SetPolicy("policy")
+		p.SetQuota(1)
+		p.SetVersioning(true)
+
+		expected := url.Values{
+			"encryption": {"true"},
+			"id":         {"id"},
+			"policy":     {"policy"},
+			"quota":      {"1"},
+			"versioning": {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.UpdateBucket(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateObjectStoragePool", func(t *testing.T) {
+		defer server.checkCommands(t, "updateObjectStoragePool")
+
+		p := client.ObjectStore.NewUpdateObjectStoragePoolParams("id")
+		p.SetName("name")
+		p.SetUrl("url")
+
+		expected := url.Values{
+			"id":   {"id"},
+			"name": {"name"},
+			"url":  {"url"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ObjectStore.UpdateObjectStoragePool(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0838d3c3-8f7e-e783-f79e-32c2ca0f442d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
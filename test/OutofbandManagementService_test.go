@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -72,3 +74,81 @@ func TestOutofbandManagementService(t *testing.T) {
 	t.Run("IssueOutOfBandManagementPowerAction", testissueOutOfBandManagementPowerAction)
 
 }
+
+func TestOutofbandManagementServiceFixtures(t *testing.T) {
+	response, err := readData("generated/OutofbandManagementService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ChangeOutOfBandManagementPassword", func(t *testing.T) {
+		defer server.checkCommands(t, "changeOutOfBandManagementPassword", "queryAsyncJobResult")
+
+		p := client.OutofbandManagement.NewChangeOutOfBandManagementPasswordParams("hostid")
+		p.SetPassword("password")
+
+		expected := url.Values{
+			"hostid":   {"hostid"},
+			"password": {"password"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.OutofbandManagement.ChangeOutOfBandManagementPassword(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ConfigureOutOfBandManagement", func(t *testing.T) {
+		defer server.checkCommands(t, "configureOutOfBandManagement")
+
+		p := client.OutofbandManagement.NewConfigureOutOfBandManagementParams("address", "driver", "hostid", "password", "port", "username")
+
+		expected := url.Values{
+			"address":  {"address"},
+			"driver":   {"driver"},
+			"hostid":   {"hostid"},
+			"password": {"password"},
+			"port":     {"port"},
+			"username": {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.OutofbandManagement.ConfigureOutOfBandManagement(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("IssueOutOfBandManagementPowerAction", func(t *testing.T) {
+		defer server.checkCommands(t, "issueOutOfBandManagementPowerAction", "queryAsyncJobResult")
+
+		p := client.OutofbandManagement.NewIssueOutOfBandManagementPowerActionParams("action", "hostid")
+		p.SetTimeout(2)
+
+		expected := url.Values{
+			"action":  {"action"},
+			"hostid":  {"hostid"},
+			"timeout": {"2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.OutofbandManagement.IssueOutOfBandManagementPowerAction(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -63,3 +65,68 @@ func TestOvsElementService(t *testing.T) {
 	t.Run("ListOvsElements", testlistOvsElements)
 
 }
+
+func TestOvsElementServiceFixtures(t *testing.T) {
+	response, err := readData("generated/OvsElementService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ConfigureOvsElement", func(t *testing.T) {
+		defer server.checkCommands(t, "configureOvsElement", "queryAsyncJobResult")
+
+		p := client.OvsElement.NewConfigureOvsElementParams(true, "id")
+
+		expected := url.Values{
+			"enabled": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.OvsElement.ConfigureOvsElement(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cbb630f0-84c4-4b43-dd44-4cd5df831026" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListOvsElements", func(t *testing.T) {
+		defer server.checkCommands(t, "listOvsElements")
+
+		p := client.OvsElement.NewListOvsElementsParams()
+		p.SetEnabled(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetNspid("nspid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"enabled":  {"true"},
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"nspid":    {"nspid"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.OvsElement.ListOvsElements(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.OvsElements) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.OvsElements))
+		}
+	})
+
+}
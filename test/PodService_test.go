@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -129,3 +131,218 @@ func TestPodService(t *testing.T) {
 	t.Run("UpdatePod", testupdatePod)
 
 }
+
+func TestPodServiceFixtures(t *testing.T) {
+	response, err := readData("generated/PodService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreatePod", func(t *testing.T) {
+		defer server.checkCommands(t, "createPod")
+
+		p := client.Pod.NewCreatePodParams("name", "zoneid")
+		p.SetAllocationstate("allocationstate")
+		p.SetEndip("endip")
+		p.SetGateway("gateway")
+		p.SetNetmask("netmask")
+		p.SetStartip("startip")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"endip":           {"endip"},
+			"gateway":         {"gateway"},
+			"name":            {"name"},
+			"netmask":         {"netmask"},
+			"startip":         {"startip"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pod.CreatePod(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f03a015b-ccb6-d333-b6a3-75372682a666" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DedicatePod", func(t *testing.T) {
+		defer server.checkCommands(t, "dedicatePod", "queryAsyncJobResult")
+
+		p := client.Pod.NewDedicatePodParams("domainid", "podid")
+		p.SetAccount("account")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"podid":    {"podid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pod.DedicatePod(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "366dbca7-81d3-9226-1f0e-d60f972cf950" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeletePod", func(t *testing.T) {
+		defer server.checkCommands(t, "deletePod")
+
+		p := client.Pod.NewDeletePodParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pod.DeletePod(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListDedicatedPods", func(t *testing.T) {
+		defer server.checkCommands(t, "listDedicatedPods")
+
+		p := client.Pod.NewListDedicatedPodsParams()
+		p.SetAccount("account")
+		p.SetAffinitygroupid("affinitygroupid")
+		p.SetDomainid("domainid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+
+		expected := url.Values{
+			"account":         {"account"},
+			"affinitygroupid": {"affinitygroupid"},
+			"domainid":        {"domainid"},
+			"keyword":         {"keyword"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+			"podid":           {"podid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pod.ListDedicatedPods(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DedicatedPods) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DedicatedPods))
+		}
+	})
+
+	t.Run("ListPods", func(t *testing.T) {
+		defer server.checkCommands(t, "listPods")
+
+		p := client.Pod.NewListPodsParams()
+		p.SetAllocationstate("allocationstate")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowcapacities(true)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"id":              {"id"},
+			"keyword":         {"keyword"},
+			"name":            {"name"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+			"showcapacities":  {"true"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pod.ListPods(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Pods) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Pods))
+		}
+		if r.Pods[0].Id != "2f266bb5-f796-8f8c-113b-13e07cbbd5e4" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Pods[0].Id)
+		}
+	})
+
+	t.Run("ReleaseDedicatedPod", func(t *testing.T) {
+		defer server.checkCommands(t, "releaseDedicatedPod", "queryAsyncJobResult")
+
+		p := client.Pod.NewReleaseDedicatedPodParams("podid")
+
+		expected := url.Values{
+			"podid": {"podid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pod.ReleaseDedicatedPod(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdatePod", func(t *testing.T) {
+		defer server.checkCommands(t, "updatePod")
+
+		p := client.Pod.NewUpdatePodParams("id")
+		p.SetAllocationstate("allocationstate")
+		p.SetEndip("endip")
+		p.SetGateway("gateway")
+		p.SetName("name")
+		p.SetNetmask("netmask")
+		p.SetStartip("startip")
+
+		expected := url.Values{
+			"allocationstate": {"allocationstate"},
+			"endip":           {"endip"},
+			"gateway":         {"gateway"},
+			"id":              {"id"},
+			"name":            {"name"},
+			"netmask":         {"netmask"},
+			"startip":         {"startip"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pod.UpdatePod(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0184b7b3-98ed-ec53-11f8-011b0a9f64ed" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -120,3 +122,218 @@ func TestPoolService(t *testing.T) {
 	t.Run("UpdateStoragePool", testupdateStoragePool)
 
 }
+
+func TestPoolServiceFixtures(t *testing.T) {
+	response, err := readData("generated/PoolService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateStoragePool", func(t *testing.T) {
+		defer server.checkCommands(t, "createStoragePool")
+
+		p := client.Pool.NewCreateStoragePoolParams("name", "url", "zoneid")
+		p.SetCapacitybytes(2)
+		p.SetCapacityiops(2)
+		p.SetClusterid("clusterid")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetHypervisor("hypervisor")
+		p.SetIstagarule(true)
+		p.SetManaged(true)
+		p.SetPodid("podid")
+		p.SetProvider("provider")
+		p.SetScope("scope")
+		p.SetTags("tags")
+
+		expected := url.Values{
+			"capacitybytes":   {"2"},
+			"capacityiops":    {"2"},
+			"clusterid":       {"clusterid"},
+			"details[0].key1": {"value1"},
+			"details[1].key2": {"value2"},
+			"hypervisor":      {"hypervisor"},
+			"istagarule":      {"true"},
+			"managed":         {"true"},
+			"name":            {"name"},
+			"podid":           {"podid"},
+			"provider":        {"provider"},
+			"scope":           {"scope"},
+			"tags":            {"tags"},
+			"url":             {"url"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pool.CreateStoragePool(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d2976644-03b8-e6cd-4fbc-c81dc78e3134" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteStoragePool", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteStoragePool")
+
+		p := client.Pool.NewDeleteStoragePoolParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pool.DeleteStoragePool(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("FindStoragePoolsForMigration", func(t *testing.T) {
+		defer server.checkCommands(t, "findStoragePoolsForMigration")
+
+		p := client.Pool.NewFindStoragePoolsForMigrationParams("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pool.FindStoragePoolsForMigration(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b1fac5a5-2b1a-d79d-ef37-428a200e05e1" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListStoragePools", func(t *testing.T) {
+		defer server.checkCommands(t, "listStoragePools")
+
+		p := client.Pool.NewListStoragePoolsParams()
+		p.SetClusterid("clusterid")
+		p.SetHostid("hostid")
+		p.SetId("id")
+		p.SetIpaddress("ipaddress")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPath("path")
+		p.SetPodid("podid")
+		p.SetScope("scope")
+		p.SetStatus("status")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"clusterid": {"clusterid"},
+			"hostid":    {"hostid"},
+			"id":        {"id"},
+			"ipaddress": {"ipaddress"},
+			"keyword":   {"keyword"},
+			"name":      {"name"},
+			"page":      {"1"},
+			"pagesize":  {"1"},
+			"path":      {"path"},
+			"podid":     {"podid"},
+			"scope":     {"scope"},
+			"status":    {"status"},
+			"zoneid":    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pool.ListStoragePools(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.StoragePools) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.StoragePools))
+		}
+		if r.StoragePools[0].Id != "b7d2f477-d632-daa4-03c4-a96e15f32b39" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.StoragePools[0].Id)
+		}
+	})
+
+	t.Run("SyncStoragePool", func(t *testing.T) {
+		defer server.checkCommands(t, "syncStoragePool", "queryAsyncJobResult")
+
+		p := client.Pool.NewSyncStoragePoolParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pool.SyncStoragePool(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d42c5431-825e-59fd-f16e-3facebf1ba0a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateStoragePool", func(t *testing.T) {
+		defer server.checkCommands(t, "updateStoragePool")
+
+		p := client.Pool.NewUpdateStoragePoolParams("id")
+		p.SetCapacitybytes(2)
+		p.SetCapacityiops(2)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetEnabled(true)
+		p.SetIstagarule(true)
+		p.SetName("name")
+		p.SetTags([]string{"tags1", "tags2"})
+		p.SetUrl("url")
+
+		expected := url.Values{
+			"capacitybytes":   {"2"},
+			"capacityiops":    {"2"},
+			"details[0].key1": {"value1"},
+			"details[1].key2": {"value2"},
+			"enabled":         {"true"},
+			"id":              {"id"},
+			"istagarule":      {"true"},
+			"name":            {"name"},
+			"tags":            {"tags1,tags2"},
+			"url":             {"url"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Pool.UpdateStoragePool(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "0ca6d5b2-e68e-a321-7be4-e4de18e57a70" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -75,3 +77,92 @@ func TestPortableIPService(t *testing.T) {
 	t.Run("ListPortableIpRanges", testlistPortableIpRanges)
 
 }
+
+func TestPortableIPServiceFixtures(t *testing.T) {
+	response, err := readData("generated/PortableIPService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreatePortableIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "createPortableIpRange", "queryAsyncJobResult")
+
+		p := client.PortableIP.NewCreatePortableIpRangeParams("endip", "gateway", "netmask", 1, "startip")
+		p.SetVlan("vlan")
+
+		expected := url.Values{
+			"endip":    {"endip"},
+			"gateway":  {"gateway"},
+			"netmask":  {"netmask"},
+			"regionid": {"1"},
+			"startip":  {"startip"},
+			"vlan":     {"vlan"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.PortableIP.CreatePortableIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "dc925f17-abb3-56a5-a4ab-3ccdd44127fc" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeletePortableIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "deletePortableIpRange", "queryAsyncJobResult")
+
+		p := client.PortableIP.NewDeletePortableIpRangeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.PortableIP.DeletePortableIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListPortableIpRanges", func(t *testing.T) {
+		defer server.checkCommands(t, "listPortableIpRanges")
+
+		p := client.PortableIP.NewListPortableIpRangesParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetRegionid(1)
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"regionid": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.PortableIP.ListPortableIpRanges(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.PortableIpRanges) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.PortableIpRanges))
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -255,3 +257,492 @@ func TestProjectService(t *testing.T) {
 	t.Run("DeleteProjectRolePermission", testdeleteProjectRolePermission)
 
 }
+
+func TestProjectServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ProjectService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ActivateProject", func(t *testing.T) {
+		defer server.checkCommands(t, "activateProject", "queryAsyncJobResult")
+
+		p := client.Project.NewActivateProjectParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.ActivateProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "99bbfe3c-3e4f-03b8-9682-10345e329ba8" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddAccountToProject", func(t *testing.T) {
+		defer server.checkCommands(t, "addAccountToProject", "queryAsyncJobResult")
+
+		p := client.Project.NewAddAccountToProjectParams("projectid")
+		p.SetAccount("account")
+		p.SetEmail("email")
+		p.SetProjectroleid("projectroleid")
+		p.SetRoletype("roletype")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"email":         {"email"},
+			"projectid":     {"projectid"},
+			"projectroleid": {"projectroleid"},
+			"roletype":      {"roletype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.AddAccountToProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("AddUserToProject", func(t *testing.T) {
+		defer server.checkCommands(t, "addUserToProject", "queryAsyncJobResult")
+
+		p := client.Project.NewAddUserToProjectParams("projectid", "username")
+		p.SetEmail("email")
+		p.SetProjectroleid("projectroleid")
+		p.SetRoletype("roletype")
+
+		expected := url.Values{
+			"email":         {"email"},
+			"projectid":     {"projectid"},
+			"projectroleid": {"projectroleid"},
+			"roletype":      {"roletype"},
+			"username":      {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.AddUserToProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("CreateProject", func(t *testing.T) {
+		defer server.checkCommands(t, "createProject", "queryAsyncJobResult")
+
+		p := client.Project.NewCreateProjectParams("displaytext", "name")
+		p.SetAccount("account")
+		p.SetAccountid("accountid")
+		p.SetDomainid("domainid")
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"accountid":   {"accountid"},
+			"displaytext": {"displaytext"},
+			"domainid":    {"domainid"},
+			"name":        {"name"},
+			"userid":      {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.CreateProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e9ea268b-dea2-4b9a-f620-90bc557a7733" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteAccountFromProject", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteAccountFromProject", "queryAsyncJobResult")
+
+		p := client.Project.NewDeleteAccountFromProjectParams("account", "projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.DeleteAccountFromProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteUserFromProject", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteUserFromProject", "queryAsyncJobResult")
+
+		p := client.Project.NewDeleteUserFromProjectParams("projectid", "userid")
+
+		expected := url.Values{
+			"projectid": {"projectid"},
+			"userid":    {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.DeleteUserFromProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteProject", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteProject", "queryAsyncJobResult")
+
+		p := client.Project.NewDeleteProjectParams("id")
+		p.SetCleanup(true)
+
+		expected := url.Values{
+			"cleanup": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.DeleteProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteProjectInvitation", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteProjectInvitation", "queryAsyncJobResult")
+
+		p := client.Project.NewDeleteProjectInvitationParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.DeleteProjectInvitation(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListProjectInvitations", func(t *testing.T) {
+		defer server.checkCommands(t, "listProjectInvitations")
+
+		p := client.Project.NewListProjectInvitationsParams()
+		p.SetAccount("account")
+		p.SetActiveonly(true)
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetState("state")
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"activeonly":  {"true"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"state":       {"state"},
+			"userid":      {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.ListProjectInvitations(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ProjectInvitations) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ProjectInvitations))
+		}
+	})
+
+	t.Run("ListProjects", func(t *testing.T) {
+		defer server.checkCommands(t, "listProjects")
+
+		p := client.Project.NewListProjectsParams()
+		p.SetAccount("account")
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowicon(true)
+		p.SetState("state")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetUsername("username")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"details":       {"details1,details2"},
+			"displaytext":   {"displaytext"},
+			"domainid":      {"domainid"},
+			"id":            {"id"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"name":          {"name"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"showicon":      {"true"},
+			"state":         {"state"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"username":      {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.ListProjects(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Projects) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Projects))
+		}
+		if r.Projects[0].Id != "34869d71-d81e-e980-b2d2-ab09b5bf05f6" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Projects[0].Id)
+		}
+	})
+
+	t.Run("SuspendProject", func(t *testing.T) {
+		defer server.checkCommands(t, "suspendProject", "queryAsyncJobResult")
+
+		p := client.Project.NewSuspendProjectParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.SuspendProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e0d65269-49ba-ac4a-c71b-53424615188e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateProject", func(t *testing.T) {
+		defer server.checkCommands(t, "updateProject", "queryAsyncJobResult")
+
+		p := client.Project.NewUpdateProjectParams("id")
+		p.SetAccount("account")
+		p.SetDisplaytext("displaytext")
+		p.SetName("name")
+		p.SetRoletype("roletype")
+		p.SetSwapowner(true)
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"displaytext": {"displaytext"},
+			"id":          {"id"},
+			"name":        {"name"},
+			"roletype":    {"roletype"},
+			"swapowner":   {"true"},
+			"userid":      {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.UpdateProject(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f590198d-3dbf-6e0b-d5b4-abc739cd465d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateProjectInvitation", func(t *testing.T) {
+		defer server.checkCommands(t, "updateProjectInvitation", "queryAsyncJobResult")
+
+		p := client.Project.NewUpdateProjectInvitationParams("projectid")
+		p.SetAccept(true)
+		p.SetAccount("account")
+		p.SetToken("token")
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"accept":    {"true"},
+			"account":   {"account"},
+			"projectid": {"projectid"},
+			"token":     {"token"},
+			"userid":    {"userid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.UpdateProjectInvitation(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListProjectRolePermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "listProjectRolePermissions")
+
+		p := client.Project.NewListProjectRolePermissionsParams("projectid")
+		p.SetProjectroleid("projectroleid")
+
+		expected := url.Values{
+			"projectid":     {"projectid"},
+			"projectroleid": {"projectroleid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.ListProjectRolePermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ProjectRolePermissions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ProjectRolePermissions))
+		}
+	})
+
+	t.Run("CreateProjectRolePermission", func(t *testing.T) {
+		defer server.checkCommands(t, "createProjectRolePermission")
+
+		p := client.Project.NewCreateProjectRolePermissionParams("permission", "projectid", "projectroleid", "rule")
+		p.SetDescription("description")
+
+		expected := url.Values{
+			"description":   {"description"},
+			"permission":    {"permission"},
+			"projectid":     {"projectid"},
+			"projectroleid": {"projectroleid"},
+			"rule":          {"rule"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.CreateProjectRolePermission(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "831fdf34-178d-5d05-8503-04601714e068" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateProjectRolePermission", func(t *testing.T) {
+		defer server.checkCommands(t, "updateProjectRolePermission")
+
+		p := client.Project.NewUpdateProjectRolePermissionParams("projectid", "projectroleid")
+		p.SetPermission("permission")
+		p.SetProjectrolepermissionid("projectrolepermissionid")
+		p.SetRuleorder([]string{"ruleorder1", "ruleorder2"})
+
+		expected := url.Values{
+			"permission":              {"permission"},
+			"projectid":               {"projectid"},
+			"projectroleid":           {"projectroleid"},
+			"projectrolepermissionid": {"projectrolepermissionid"},
+			"ruleorder":               {"ruleorder1,ruleorder2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.UpdateProjectRolePermission(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteProjectRolePermission", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteProjectRolePermission")
+
+		p := client.Project.NewDeleteProjectRolePermissionParams("id", "projectid")
+
+		expected := url.Values{
+			"id":        {"id"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Project.DeleteProjectRolePermission(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
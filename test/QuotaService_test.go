@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -35,6 +37,54 @@ func TestQuotaService(t *testing.T) {
 	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
 	defer server.Close()
 
+	testquotaBalance := func(t *testing.T) {
+		if _, ok := response["quotaBalance"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaBalanceParams("account", "domainid")
+		_, err := client.Quota.QuotaBalance(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaBalance", testquotaBalance)
+
+	testquotaCredits := func(t *testing.T) {
+		if _, ok := response["quotaCredits"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaCreditsParams("account", "domainid", 0)
+		_, err := client.Quota.QuotaCredits(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaCredits", testquotaCredits)
+
+	testquotaEmailTemplateList := func(t *testing.T) {
+		if _, ok := response["quotaEmailTemplateList"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaEmailTemplateListParams()
+		_, err := client.Quota.QuotaEmailTemplateList(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaEmailTemplateList", testquotaEmailTemplateList)
+
+	testquotaEmailTemplateUpdate := func(t *testing.T) {
+		if _, ok := response["quotaEmailTemplateUpdate"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaEmailTemplateUpdateParams("templatebody", "templatesubject", "templatetype")
+		_, err := client.Quota.QuotaEmailTemplateUpdate(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaEmailTemplateUpdate", testquotaEmailTemplateUpdate)
+
 	testquotaIsEnabled := func(t *testing.T) {
 		if _, ok := response["quotaIsEnabled"]; !ok {
 			t.Skipf("Skipping as no json response is provided in testdata")
@@ -47,4 +97,423 @@ func TestQuotaService(t *testing.T) {
 	}
 	t.Run("QuotaIsEnabled", testquotaIsEnabled)
 
+	testquotaStatement := func(t *testing.T) {
+		if _, ok := response["quotaStatement"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaStatementParams("account", "domainid", "enddate", "startdate")
+		_, err := client.Quota.QuotaStatement(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaStatement", testquotaStatement)
+
+	testquotaSummary := func(t *testing.T) {
+		if _, ok := response["quotaSummary"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaSummaryParams()
+		_, err := client.Quota.QuotaSummary(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaSummary", testquotaSummary)
+
+	testquotaTariffCreate := func(t *testing.T) {
+		if _, ok := response["quotaTariffCreate"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaTariffCreateParams("name", 0, 0)
+		r, err := client.Quota.QuotaTariffCreate(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("QuotaTariffCreate", testquotaTariffCreate)
+
+	testquotaTariffDelete := func(t *testing.T) {
+		if _, ok := response["quotaTariffDelete"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaTariffDeleteParams("id")
+		_, err := client.Quota.QuotaTariffDelete(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaTariffDelete", testquotaTariffDelete)
+
+	testquotaTariffList := func(t *testing.T) {
+		if _, ok := response["quotaTariffList"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaTariffListParams()
+		_, err := client.Quota.QuotaTariffList(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaTariffList", testquotaTariffList)
+
+	testquotaTariffUpdate := func(t *testing.T) {
+		if _, ok := response["quotaTariffUpdate"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaTariffUpdateParams("name")
+		r, err := client.Quota.QuotaTariffUpdate(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("QuotaTariffUpdate", testquotaTariffUpdate)
+
+	testquotaUpdate := func(t *testing.T) {
+		if _, ok := response["quotaUpdate"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.Quota.NewQuotaUpdateParams()
+		_, err := client.Quota.QuotaUpdate(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("QuotaUpdate", testquotaUpdate)
+
+}
+
+func TestQuotaServiceFixtures(t *testing.T) {
+	response, err := readData("generated/QuotaService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("QuotaBalance", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaBalance")
+
+		p := client.Quota.NewQuotaBalanceParams("account", "domainid")
+		p.SetAccountid("accountid")
+		p.SetEnddate("enddate")
+		p.SetStartdate("startdate")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"accountid": {"accountid"},
+			"domainid":  {"domainid"},
+			"enddate":   {"enddate"},
+			"startdate": {"startdate"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaBalance(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("QuotaCredits", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaCredits")
+
+		p := client.Quota.NewQuotaCreditsParams("account", "domainid", 1.5)
+		p.SetMin_balance(1.5)
+		p.SetQuota_enforce(true)
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"min_balance":   {"1.5"},
+			"quota_enforce": {"true"},
+			"value":         {"1.5"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaCredits(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("QuotaEmailTemplateList", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaEmailTemplateList")
+
+		p := client.Quota.NewQuotaEmailTemplateListParams()
+		p.SetTemplatetype("templatetype")
+
+		expected := url.Values{
+			"templatetype": {"templatetype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaEmailTemplateList(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Quotaemailtemplate) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Quotaemailtemplate))
+		}
+	})
+
+	t.Run("QuotaEmailTemplateUpdate", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaEmailTemplateUpdate")
+
+		p := client.Quota.NewQuotaEmailTemplateUpdateParams("templatebody", "templatesubject", "templatetype")
+		p.SetLocale("locale")
+
+		expected := url.Values{
+			"locale":          {"locale"},
+			"templatebody":    {"templatebody"},
+			"templatesubject": {"templatesubject"},
+			"templatetype":    {"templatetype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaEmailTemplateUpdate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("QuotaIsEnabled", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaIsEnabled")
+
+		p := client.Quota.NewQuotaIsEnabledParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaIsEnabled(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("QuotaStatement", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaStatement")
+
+		p := client.Quota.NewQuotaStatementParams("account", "domainid", "enddate", "startdate")
+		p.SetAccountid("accountid")
+		p.SetType(1)
+
+		expected := url.Values{
+			"account":   {"account"},
+			"accountid": {"accountid"},
+			"domainid":  {"domainid"},
+			"enddate":   {"enddate"},
+			"startdate": {"startdate"},
+			"type":      {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaStatement(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("QuotaSummary", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaSummary")
+
+		p := client.Quota.NewQuotaSummaryParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"keyword":  {"keyword"},
+			"listall":  {"true"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaSummary(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Summary) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Summary))
+		}
+	})
+
+	t.Run("QuotaTariffCreate", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaTariffCreate")
+
+		p := client.Quota.NewQuotaTariffCreateParams("name", 1, 1.5)
+		p.SetActivationrule("activationrule")
+		p.SetDescription("description")
+		p.SetEnddate("enddate")
+		p.SetStartdate("startdate")
+
+		expected := url.Values{
+			"activationrule": {"activationrule"},
+			"description":    {"description"},
+			"enddate":        {"enddate"},
+			"name":           {"name"},
+			"startdate":      {"startdate"},
+			"usagetype":      {"1"},
+			"value":          {"1.5"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaTariffCreate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e3a4703d-49e1-61d8-80dc-b67be8609d41" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("QuotaTariffDelete", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaTariffDelete")
+
+		p := client.Quota.NewQuotaTariffDeleteParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaTariffDelete(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("QuotaTariffList", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaTariffList")
+
+		p := client.Quota.NewQuotaTariffListParams()
+		p.SetEnddate("enddate")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetListonlyremoved(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetStartdate("startdate")
+		p.SetUsagetype(1)
+
+		expected := url.Values{
+			"enddate":         {"enddate"},
+			"id":              {"id"},
+			"keyword":         {"keyword"},
+			"listall":         {"true"},
+			"listonlyremoved": {"true"},
+			"name":            {"name"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+			"startdate":       {"startdate"},
+			"usagetype":       {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaTariffList(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Quotatariff) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Quotatariff))
+		}
+		if r.Quotatariff[0].Id != "060f6210-a742-0c25-525d-1b3e29cb2956" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Quotatariff[0].Id)
+		}
+	})
+
+	t.Run("QuotaTariffUpdate", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaTariffUpdate")
+
+		p := client.Quota.NewQuotaTariffUpdateParams("name")
+		p.SetActivationrule("activationrule")
+		p.SetDescription("description")
+		p.SetEnddate("enddate")
+		p.SetStartdate("startdate")
+		p.SetUsagetype(1)
+		p.SetValue(1.5)
+
+		expected := url.Values{
+			"activationrule": {"activationrule"},
+			"description":    {"description"},
+			"enddate":        {"enddate"},
+			"name":           {"name"},
+			"startdate":      {"startdate"},
+			"usagetype":      {"1"},
+			"value":          {"1.5"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaTariffUpdate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8d414ec9-057c-e6ef-6d13-1ed1b4677763" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("QuotaUpdate", func(t *testing.T) {
+		defer server.checkCommands(t, "quotaUpdate")
+
+		p := client.Quota.NewQuotaUpdateParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Quota.QuotaUpdate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
 }
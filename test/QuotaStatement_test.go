@@ -0,0 +1,78 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+func TestAccountQuotaStatement(t *testing.T) {
+	responses := map[string]string{
+		"quotaStatement": `{"quotastatementresponse": {"statement": {"account": "admin", "accountid": "account-1",
+			"currency": "$", "totalquota": 12.5, "quotausage": [
+				{"type": 1, "name": "RUNNING_VM", "unit": "Compute*Month", "quota": 10},
+				{"type": 6, "name": "VOLUME", "unit": "GB*Month", "quota": 2.5}
+			]}}}`,
+		"quotaBalance": `{"quotabalanceresponse": {"balance": {"account": "admin", "startquota": 100,
+			"endquota": 137.5, "currency": "$", "credits": [{"credits": 50, "updated_on": "2023-01-15T10:00:00+0000"}]}}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("startdate") != "2023-01-01" || r.FormValue("enddate") != "2023-01-31" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"errorresponse": {"errorcode": 431, "errortext": "Unexpected date range %s - %s"}}`,
+				r.FormValue("startdate"), r.FormValue("enddate"))
+			return
+		}
+		fmt.Fprintln(w, responses[r.FormValue("command")])
+	}))
+	defer server.Close()
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 31, 23, 59, 0, 0, time.UTC)
+	s, err := client.AccountQuotaStatement("admin", "domain-1", start, end)
+	if err != nil {
+		t.Fatalf("Failed to get the quota statement: %v", err)
+	}
+
+	if s.Accountid != "account-1" || s.Currency != "$" {
+		t.Errorf("Unexpected account %q or currency %q", s.Accountid, s.Currency)
+	}
+	if s.StartBalance != 100 || s.EndBalance != 137.5 || s.TotalQuota != 12.5 {
+		t.Errorf("Unexpected balances %v - %v or total quota %v", s.StartBalance, s.EndBalance, s.TotalQuota)
+	}
+	if len(s.Usage) != 2 || s.Usage[1].Name != "VOLUME" || s.Usage[1].Quota != 2.5 {
+		t.Errorf("Unexpected quota usage %+v", s.Usage)
+	}
+	if len(s.Credits) != 1 || s.Credits[0].Credits != 50 {
+		t.Errorf("Unexpected credits %+v", s.Credits)
+	}
+
+	if _, err := client.AccountQuotaStatement("admin", "domain-1", end, start); err == nil {
+		t.Errorf("Expected an error for an end date before the start date")
+	}
+}
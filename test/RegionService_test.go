@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -84,3 +86,109 @@ func TestRegionService(t *testing.T) {
 	t.Run("UpdateRegion", testupdateRegion)
 
 }
+
+func TestRegionServiceFixtures(t *testing.T) {
+	response, err := readData("generated/RegionService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddRegion", func(t *testing.T) {
+		defer server.checkCommands(t, "addRegion")
+
+		p := client.Region.NewAddRegionParams("endpoint", 1, "name")
+
+		expected := url.Values{
+			"endpoint": {"endpoint"},
+			"id":       {"1"},
+			"name":     {"name"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Region.AddRegion(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListRegions", func(t *testing.T) {
+		defer server.checkCommands(t, "listRegions")
+
+		p := client.Region.NewListRegionsParams()
+		p.SetId(1)
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"id":       {"1"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Region.ListRegions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Regions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Regions))
+		}
+	})
+
+	t.Run("RemoveRegion", func(t *testing.T) {
+		defer server.checkCommands(t, "removeRegion")
+
+		p := client.Region.NewRemoveRegionParams(1)
+
+		expected := url.Values{
+			"id": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Region.RemoveRegion(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateRegion", func(t *testing.T) {
+		defer server.checkCommands(t, "updateRegion")
+
+		p := client.Region.NewUpdateRegionParams(1)
+		p.SetEndpoint("endpoint")
+		p.SetName("name")
+
+		expected := url.Values{
+			"endpoint": {"endpoint"},
+			"id":       {"1"},
+			"name":     {"name"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Region.UpdateRegion(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -84,3 +86,130 @@ func TestResourcemetadataService(t *testing.T) {
 	t.Run("RemoveResourceDetail", testremoveResourceDetail)
 
 }
+
+func TestResourcemetadataServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ResourcemetadataService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddResourceDetail", func(t *testing.T) {
+		defer server.checkCommands(t, "addResourceDetail", "queryAsyncJobResult")
+
+		p := client.Resourcemetadata.NewAddResourceDetailParams(map[string]string{"key1": "value1", "key2": "value2"}, "resourceid", "resourcetype")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"details[0].key":   {"key1"},
+			"details[0].value": {"value1"},
+			"details[1].key":   {"key2"},
+			"details[1].value": {"value2"},
+			"fordisplay":       {"true"},
+			"resourceid":       {"resourceid"},
+			"resourcetype":     {"resourcetype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcemetadata.AddResourceDetail(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("GetVolumeSnapshotDetails", func(t *testing.T) {
+		defer server.checkCommands(t, "getVolumeSnapshotDetails")
+
+		p := client.Resourcemetadata.NewGetVolumeSnapshotDetailsParams("snapshotid")
+
+		expected := url.Values{
+			"snapshotid": {"snapshotid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcemetadata.GetVolumeSnapshotDetails(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListResourceDetails", func(t *testing.T) {
+		defer server.checkCommands(t, "listResourceDetails")
+
+		p := client.Resourcemetadata.NewListResourceDetailsParams("resourcetype")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetIsrecursive(true)
+		p.SetKey("key")
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetResourceid("resourceid")
+		p.SetValue("value")
+
+		expected := url.Values{
+			"account":      {"account"},
+			"domainid":     {"domainid"},
+			"fordisplay":   {"true"},
+			"isrecursive":  {"true"},
+			"key":          {"key"},
+			"keyword":      {"keyword"},
+			"listall":      {"true"},
+			"page":         {"1"},
+			"pagesize":     {"1"},
+			"projectid":    {"projectid"},
+			"resourceid":   {"resourceid"},
+			"resourcetype": {"resourcetype"},
+			"value":        {"value"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcemetadata.ListResourceDetails(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ResourceDetails) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ResourceDetails))
+		}
+	})
+
+	t.Run("RemoveResourceDetail", func(t *testing.T) {
+		defer server.checkCommands(t, "removeResourceDetail", "queryAsyncJobResult")
+
+		p := client.Resourcemetadata.NewRemoveResourceDetailParams("resourceid", "resourcetype")
+		p.SetKey("key")
+
+		expected := url.Values{
+			"key":          {"key"},
+			"resourceid":   {"resourceid"},
+			"resourcetype": {"resourcetype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcemetadata.RemoveResourceDetail(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -84,3 +86,144 @@ func TestResourcetagsService(t *testing.T) {
 	t.Run("ListTags", testlistTags)
 
 }
+
+func TestResourcetagsServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ResourcetagsService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateTags", func(t *testing.T) {
+		defer server.checkCommands(t, "createTags", "queryAsyncJobResult")
+
+		p := client.Resourcetags.NewCreateTagsParams([]string{"resourceids1", "resourceids2"}, "resourcetype", map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetCustomer("customer")
+
+		expected := url.Values{
+			"customer":      {"customer"},
+			"resourceids":   {"resourceids1,resourceids2"},
+			"resourcetype":  {"resourcetype"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcetags.CreateTags(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteTags", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteTags", "queryAsyncJobResult")
+
+		p := client.Resourcetags.NewDeleteTagsParams([]string{"resourceids1", "resourceids2"}, "resourcetype")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"resourceids":   {"resourceids1,resourceids2"},
+			"resourcetype":  {"resourcetype"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcetags.DeleteTags(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListStorageTags", func(t *testing.T) {
+		defer server.checkCommands(t, "listStorageTags")
+
+		p := client.Resourcetags.NewListStorageTagsParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcetags.ListStorageTags(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.StorageTags) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.StorageTags))
+		}
+		if r.StorageTags[0].Id != "57cd8e3f-a9f5-0503-bcfc-44ed71c2f6e4" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.StorageTags[0].Id)
+		}
+	})
+
+	t.Run("ListTags", func(t *testing.T) {
+		defer server.checkCommands(t, "listTags")
+
+		p := client.Resourcetags.NewListTagsParams()
+		p.SetAccount("account")
+		p.SetCustomer("customer")
+		p.SetDomainid("domainid")
+		p.SetIsrecursive(true)
+		p.SetKey("key")
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetResourceid("resourceid")
+		p.SetResourcetype("resourcetype")
+		p.SetValue("value")
+
+		expected := url.Values{
+			"account":      {"account"},
+			"customer":     {"customer"},
+			"domainid":     {"domainid"},
+			"isrecursive":  {"true"},
+			"key":          {"key"},
+			"keyword":      {"keyword"},
+			"listall":      {"true"},
+			"page":         {"1"},
+			"pagesize":     {"1"},
+			"projectid":    {"projectid"},
+			"resourceid":   {"resourceid"},
+			"resourcetype": {"resourcetype"},
+			"value":        {"value"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Resourcetags.ListTags(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Tags) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Tags))
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -156,3 +158,256 @@ func TestRoleService(t *testing.T) {
 	t.Run("UpdateRolePermission", testupdateRolePermission)
 
 }
+
+func TestRoleServiceFixtures(t *testing.T) {
+	response, err := readData("generated/RoleService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateRole", func(t *testing.T) {
+		defer server.checkCommands(t, "createRole")
+
+		p := client.Role.NewCreateRoleParams("name")
+		p.SetDescription("description")
+		p.SetIspublic(true)
+		p.SetRoleid("roleid")
+		p.SetType("type")
+
+		expected := url.Values{
+			"description": {"description"},
+			"ispublic":    {"true"},
+			"name":        {"name"},
+			"roleid":      {"roleid"},
+			"type":        {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.CreateRole(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d4c35a77-02fa-cb2c-df2c-667e42feb458" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateRolePermission", func(t *testing.T) {
+		defer server.checkCommands(t, "createRolePermission")
+
+		p := client.Role.NewCreateRolePermissionParams("permission", "roleid", "rule")
+		p.SetDescription("description")
+
+		expected := url.Values{
+			"description": {"description"},
+			"permission":  {"permission"},
+			"roleid":      {"roleid"},
+			"rule":        {"rule"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.CreateRolePermission(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e8420b86-bec0-5e81-fe5e-e8e19ea86cfa" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteRole", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteRole")
+
+		p := client.Role.NewDeleteRoleParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.DeleteRole(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteRolePermission", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteRolePermission")
+
+		p := client.Role.NewDeleteRolePermissionParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.DeleteRolePermission(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ImportRole", func(t *testing.T) {
+		defer server.checkCommands(t, "importRole")
+
+		p := client.Role.NewImportRoleParams("name", map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDescription("description")
+		p.SetForced(true)
+		p.SetIspublic(true)
+		p.SetType("type")
+
+		expected := url.Values{
+			"description":    {"description"},
+			"forced":         {"true"},
+			"ispublic":       {"true"},
+			"name":           {"name"},
+			"rules[0].key":   {"key1"},
+			"rules[0].value": {"value1"},
+			"rules[1].key":   {"key2"},
+			"rules[1].value": {"value2"},
+			"type":           {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.ImportRole(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "1858f4e6-cac4-bf20-2a90-34d0e33f4d68" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListRolePermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "listRolePermissions")
+
+		p := client.Role.NewListRolePermissionsParams()
+		p.SetRoleid("roleid")
+
+		expected := url.Values{
+			"roleid": {"roleid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.ListRolePermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.RolePermissions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.RolePermissions))
+		}
+	})
+
+	t.Run("ListRoles", func(t *testing.T) {
+		defer server.checkCommands(t, "listRoles")
+
+		p := client.Role.NewListRolesParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetType("type")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"name":     {"name"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"type":     {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.ListRoles(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Roles) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Roles))
+		}
+		if r.Roles[0].Id != "b6d0d8c2-38f6-781d-3da4-1bbeb86d4425" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Roles[0].Id)
+		}
+	})
+
+	t.Run("UpdateRole", func(t *testing.T) {
+		defer server.checkCommands(t, "updateRole")
+
+		p := client.Role.NewUpdateRoleParams("id")
+		p.SetDescription("description")
+		p.SetIspublic(true)
+		p.SetName("name")
+		p.SetType("type")
+
+		expected := url.Values{
+			"description": {"description"},
+			"id":          {"id"},
+			"ispublic":    {"true"},
+			"name":        {"name"},
+			"type":        {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.UpdateRole(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7946593a-b804-22fc-8f8b-4ddb5903952f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateRolePermission", func(t *testing.T) {
+		defer server.checkCommands(t, "updateRolePermission")
+
+		p := client.Role.NewUpdateRolePermissionParams("roleid")
+		p.SetPermission("permission")
+		p.SetRuleid("ruleid")
+		p.SetRuleorder([]string{"ruleorder1", "ruleorder2"})
+
+		expected := url.Values{
+			"permission": {"permission"},
+			"roleid":     {"roleid"},
+			"ruleid":     {"ruleid"},
+			"ruleorder":  {"ruleorder1,ruleorder2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Role.UpdateRolePermission(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
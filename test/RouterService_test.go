@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -165,3 +167,266 @@ func TestRouterService(t *testing.T) {
 	t.Run("StopRouter", teststopRouter)
 
 }
+
+func TestRouterServiceFixtures(t *testing.T) {
+	response, err := readData("generated/RouterService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ChangeServiceForRouter", func(t *testing.T) {
+		defer server.checkCommands(t, "changeServiceForRouter")
+
+		p := client.Router.NewChangeServiceForRouterParams("id", "serviceofferingid")
+
+		expected := url.Values{
+			"id":                {"id"},
+			"serviceofferingid": {"serviceofferingid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.ChangeServiceForRouter(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "43222849-51cf-4cc1-d092-25ab461a7065" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ConfigureVirtualRouterElement", func(t *testing.T) {
+		defer server.checkCommands(t, "configureVirtualRouterElement", "queryAsyncJobResult")
+
+		p := client.Router.NewConfigureVirtualRouterElementParams(true, "id")
+
+		expected := url.Values{
+			"enabled": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.ConfigureVirtualRouterElement(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c2688352-94f0-a09f-935d-b9c9454fee7a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVirtualRouterElement", func(t *testing.T) {
+		defer server.checkCommands(t, "createVirtualRouterElement", "queryAsyncJobResult")
+
+		p := client.Router.NewCreateVirtualRouterElementParams("nspid")
+		p.SetProvidertype("providertype")
+
+		expected := url.Values{
+			"nspid":        {"nspid"},
+			"providertype": {"providertype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.CreateVirtualRouterElement(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "2e9a9476-677b-14a0-15c4-0ec5b7de6ad8" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DestroyRouter", func(t *testing.T) {
+		defer server.checkCommands(t, "destroyRouter", "queryAsyncJobResult")
+
+		p := client.Router.NewDestroyRouterParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.DestroyRouter(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "1629b614-6d04-a51c-dc9b-0738c5a55e5c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListRouters", func(t *testing.T) {
+		defer server.checkCommands(t, "listRouters")
+
+		p := client.Router.NewListRoutersParams()
+		p.SetAccount("account")
+		p.SetClusterid("clusterid")
+		p.SetDomainid("domainid")
+		p.SetFetchhealthcheckresults(true)
+		p.SetForvpc(true)
+		p.SetHealthchecksfailed(true)
+		p.SetHostid("hostid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetState("state")
+		p.SetVersion("version")
+		p.SetVpcid("vpcid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                 {"account"},
+			"clusterid":               {"clusterid"},
+			"domainid":                {"domainid"},
+			"fetchhealthcheckresults": {"true"},
+			"forvpc":                  {"true"},
+			"healthchecksfailed":      {"true"},
+			"hostid":                  {"hostid"},
+			"id":                      {"id"},
+			"isrecursive":             {"true"},
+			"keyword":                 {"keyword"},
+			"listall":                 {"true"},
+			"name":                    {"name"},
+			"networkid":               {"networkid"},
+			"page":                    {"1"},
+			"pagesize":                {"1"},
+			"podid":                   {"podid"},
+			"projectid":               {"projectid"},
+			"state":                   {"state"},
+			"version":                 {"version"},
+			"vpcid":                   {"vpcid"},
+			"zoneid":                  {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.ListRouters(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Routers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Routers))
+		}
+		if r.Routers[0].Id != "d10030a0-0d76-e79a-38fe-71031b808003" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Routers[0].Id)
+		}
+	})
+
+	t.Run("ListVirtualRouterElements", func(t *testing.T) {
+		defer server.checkCommands(t, "listVirtualRouterElements")
+
+		p := client.Router.NewListVirtualRouterElementsParams()
+		p.SetEnabled(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetNspid("nspid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"enabled":  {"true"},
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"nspid":    {"nspid"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.ListVirtualRouterElements(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VirtualRouterElements) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VirtualRouterElements))
+		}
+	})
+
+	t.Run("RebootRouter", func(t *testing.T) {
+		defer server.checkCommands(t, "rebootRouter", "queryAsyncJobResult")
+
+		p := client.Router.NewRebootRouterParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.RebootRouter(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8b10ede8-7bbb-19bd-2a44-a93e467da855" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StartRouter", func(t *testing.T) {
+		defer server.checkCommands(t, "startRouter", "queryAsyncJobResult")
+
+		p := client.Router.NewStartRouterParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.StartRouter(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "923387b7-8414-8b91-16d0-0e5959914889" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StopRouter", func(t *testing.T) {
+		defer server.checkCommands(t, "stopRouter", "queryAsyncJobResult")
+
+		p := client.Router.NewStopRouterParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Router.StopRouter(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "5d9d6563-b7e1-a78a-9b27-a9126c0b9779" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -105,3 +107,172 @@ func TestSSHService(t *testing.T) {
 	t.Run("ResetSSHKeyForVirtualMachine", testresetSSHKeyForVirtualMachine)
 
 }
+
+func TestSSHServiceFixtures(t *testing.T) {
+	response, err := readData("generated/SSHService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateSSHKeyPair", func(t *testing.T) {
+		defer server.checkCommands(t, "createSSHKeyPair")
+
+		p := client.SSH.NewCreateSSHKeyPairParams("name")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"name":      {"name"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SSH.CreateSSHKeyPair(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "fba25caa-339b-8c51-d757-56f95be85eb3" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteSSHKeyPair", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteSSHKeyPair")
+
+		p := client.SSH.NewDeleteSSHKeyPairParams("name")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"name":      {"name"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SSH.DeleteSSHKeyPair(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListSSHKeyPairs", func(t *testing.T) {
+		defer server.checkCommands(t, "listSSHKeyPairs")
+
+		p := client.SSH.NewListSSHKeyPairsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFingerprint("fingerprint")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"fingerprint": {"fingerprint"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SSH.ListSSHKeyPairs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.SSHKeyPairs) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.SSHKeyPairs))
+		}
+		if r.SSHKeyPairs[0].Id != "4acd2f61-8eac-f702-0b99-a0ce92b51a26" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.SSHKeyPairs[0].Id)
+		}
+	})
+
+	t.Run("RegisterSSHKeyPair", func(t *testing.T) {
+		defer server.checkCommands(t, "registerSSHKeyPair")
+
+		p := client.SSH.NewRegisterSSHKeyPairParams("name", "publickey")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"name":      {"name"},
+			"projectid": {"projectid"},
+			"publickey": {"publickey"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SSH.RegisterSSHKeyPair(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b7ac4d32-5365-096c-557c-ec09ffc8a30c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ResetSSHKeyForVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "resetSSHKeyForVirtualMachine", "queryAsyncJobResult")
+
+		p := client.SSH.NewResetSSHKeyForVirtualMachineParams("id")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetKeypair("keypair")
+		p.SetKeypairs([]string{"keypairs1", "keypairs2"})
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"id":        {"id"},
+			"keypair":   {"keypair"},
+			"keypairs":  {"keypairs1,keypairs2"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SSH.ResetSSHKeyForVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "68a0cf83-9114-baca-92d3-40434ece8706" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -123,3 +125,255 @@ func TestSecurityGroupService(t *testing.T) {
 	t.Run("RevokeSecurityGroupIngress", testrevokeSecurityGroupIngress)
 
 }
+
+func TestSecurityGroupServiceFixtures(t *testing.T) {
+	response, err := readData("generated/SecurityGroupService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AuthorizeSecurityGroupEgress", func(t *testing.T) {
+		defer server.checkCommands(t, "authorizeSecurityGroupEgress", "queryAsyncJobResult")
+
+		p := client.SecurityGroup.NewAuthorizeSecurityGroupEgressParams()
+		p.SetAccount("account")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetDomainid("domainid")
+		p.SetEndport(1)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetProjectid("projectid")
+		p.SetProtocol("protocol")
+		p.SetSecuritygroupid("securitygroupid")
+		p.SetSecuritygroupname("securitygroupname")
+		p.SetStartport(1)
+		p.SetUsersecuritygrouplist(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":                          {"account"},
+			"cidrlist":                         {"cidrlist1,cidrlist2"},
+			"domainid":                         {"domainid"},
+			"endport":                          {"1"},
+			"icmpcode":                         {"1"},
+			"icmptype":                         {"1"},
+			"projectid":                        {"projectid"},
+			"protocol":                         {"protocol"},
+			"securitygroupid":                  {"securitygroupid"},
+			"securitygroupname":                {"securitygroupname"},
+			"startport":                        {"1"},
+			"usersecuritygrouplist[0].account": {"key1"},
+			"usersecuritygrouplist[0].group":   {"value1"},
+			"usersecuritygrouplist[1].account": {"key2"},
+			"usersecuritygrouplist[1].group":   {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SecurityGroup.AuthorizeSecurityGroupEgress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("AuthorizeSecurityGroupIngress", func(t *testing.T) {
+		defer server.checkCommands(t, "authorizeSecurityGroupIngress", "queryAsyncJobResult")
+
+		p := client.SecurityGroup.NewAuthorizeSecurityGroupIngressParams()
+		p.SetAccount("account")
+		p.SetCidrlist([]string{"cidrlist1", "cidrlist2"})
+		p.SetDomainid("domainid")
+		p.SetEndport(1)
+		p.SetIcmpcode(1)
+		p.SetIcmptype(1)
+		p.SetProjectid("projectid")
+		p.SetProtocol("protocol")
+		p.SetSecuritygroupid("securitygroupid")
+		p.SetSecuritygroupname("securitygroupname")
+		p.SetStartport(1)
+		p.SetUsersecuritygrouplist(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"account":                          {"account"},
+			"cidrlist":                         {"cidrlist1,cidrlist2"},
+			"domainid":                         {"domainid"},
+			"endport":                          {"1"},
+			"icmpcode":                         {"1"},
+			"icmptype":                         {"1"},
+			"projectid":                        {"projectid"},
+			"protocol":                         {"protocol"},
+			"securitygroupid":                  {"securitygroupid"},
+			"securitygroupname":                {"securitygroupname"},
+			"startport":                        {"1"},
+			"usersecuritygrouplist[0].account": {"key1"},
+			"usersecuritygrouplist[0].group":   {"value1"},
+			"usersecuritygrouplist[1].account": {"key2"},
+			"usersecuritygrouplist[1].group":   {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SecurityGroup.AuthorizeSecurityGroupIngress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("CreateSecurityGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "createSecurityGroup")
+
+		p := client.SecurityGroup.NewCreateSecurityGroupParams("name")
+		p.SetAccount("account")
+		p.SetDescription("description")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"description": {"description"},
+			"domainid":    {"domainid"},
+			"name":        {"name"},
+			"projectid":   {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SecurityGroup.CreateSecurityGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "22f14ae8-a03b-274f-87c9-a9c261ee3f97" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteSecurityGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteSecurityGroup")
+
+		p := client.SecurityGroup.NewDeleteSecurityGroupParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetName("name")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"id":        {"id"},
+			"name":      {"name"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SecurityGroup.DeleteSecurityGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListSecurityGroups", func(t *testing.T) {
+		defer server.checkCommands(t, "listSecurityGroups")
+
+		p := client.SecurityGroup.NewListSecurityGroupsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetSecuritygroupname("securitygroupname")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetVirtualmachineid("virtualmachineid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"domainid":          {"domainid"},
+			"id":                {"id"},
+			"isrecursive":       {"true"},
+			"keyword":           {"keyword"},
+			"listall":           {"true"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"projectid":         {"projectid"},
+			"securitygroupname": {"securitygroupname"},
+			"tags[0].key":       {"key1"},
+			"tags[0].value":     {"value1"},
+			"tags[1].key":       {"key2"},
+			"tags[1].value":     {"value2"},
+			"virtualmachineid":  {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SecurityGroup.ListSecurityGroups(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.SecurityGroups) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.SecurityGroups))
+		}
+		if r.SecurityGroups[0].Id != "5cadd84a-4cbe-eefe-00d4-3f986b0fa9a2" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.SecurityGroups[0].Id)
+		}
+	})
+
+	t.Run("RevokeSecurityGroupEgress", func(t *testing.T) {
+		defer server.checkCommands(t, "revokeSecurityGroupEgress", "queryAsyncJobResult")
+
+		p := client.SecurityGroup.NewRevokeSecurityGroupEgressParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SecurityGroup.RevokeSecurityGroupEgress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("RevokeSecurityGroupIngress", func(t *testing.T) {
+		defer server.checkCommands(t, "revokeSecurityGroupIngress", "queryAsyncJobResult")
+
+		p := client.SecurityGroup.NewRevokeSecurityGroupIngressParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SecurityGroup.RevokeSecurityGroupIngress(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -90,3 +92,246 @@ func TestServiceOfferingService(t *testing.T) {
 	t.Run("UpdateServiceOffering", testupdateServiceOffering)
 
 }
+
+func TestServiceOfferingServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ServiceOfferingService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateServiceOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "createServiceOffering")
+
+		p := client.ServiceOffering.NewCreateServiceOfferingParams("displaytext", "name")
+		p.SetBytesreadrate(2)
+		p.SetBytesreadratemax(2)
+		p.SetBytesreadratemaxlength(2)
+		p.SetByteswriterate(2)
+		p.SetByteswriteratemax(2)
+		p.SetByteswriteratemaxlength(2)
+		p.SetCachemode("cachemode")
+		p.SetCpunumber(1)
+		p.SetCpuspeed(1)
+		p.SetCustomized(true)
+		p.SetCustomizediops(true)
+		p.SetDeploymentplanner("deploymentplanner")
+		p.SetDiskofferingid("diskofferingid")
+		p.SetDiskofferingstrictness(true)
+		p.SetDomainid([]string{"domainid1", "domainid2"})
+		p.SetDynamicscalingenabled(true)
+		p.SetEncryptroot(true)
+		p.SetHosttags("hosttags")
+		p.SetHypervisorsnapshotreserve(1)
+		p.SetIopsreadrate(2)
+		p.SetIopsreadratemax(2)
+		p.SetIopsreadratemaxlength(2)
+		p.SetIopswriterate(2)
+		p.SetIopswriteratemax(2)
+		p.SetIopswriteratemaxlength(2)
+		p.SetIssystem(true)
+		p.SetIsvolatile(true)
+		p.SetLimitcpuuse(true)
+		p.SetMaxcpunumber(1)
+		p.SetMaxiops(2)
+		p.SetMaxmemory(1)
+		p.SetMemory(1)
+		p.SetMincpunumber(1)
+		p.SetMiniops(2)
+		p.SetMinmemory(1)
+		p.SetNetworkrate(1)
+		p.SetOfferha(true)
+		p.SetProvisioningtype("provisioningtype")
+		p.SetRootdisksize(2)
+		p.SetServiceofferingdetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetStoragepolicy("storagepolicy")
+		p.SetStoragetype("storagetype")
+		p.SetSystemvmtype("systemvmtype")
+		p.SetTags("tags")
+		p.SetZoneid([]string{"zoneid1", "zoneid2"})
+
+		expected := url.Values{
+			"bytesreadrate":                   {"2"},
+			"bytesreadratemax":                {"2"},
+			"bytesreadratemaxlength":          {"2"},
+			"byteswriterate":                  {"2"},
+			"byteswriteratemax":               {"2"},
+			"byteswriteratemaxlength":         {"2"},
+			"cachemode":                       {"cachemode"},
+			"cpunumber":                       {"1"},
+			"cpuspeed":                        {"1"},
+			"customized":                      {"true"},
+			"customizediops":                  {"true"},
+			"deploymentplanner":               {"deploymentplanner"},
+			"diskofferingid":                  {"diskofferingid"},
+			"diskofferingstrictness":          {"true"},
+			"displaytext":                     {"displaytext"},
+			"domainid":                        {"domainid1,domainid2"},
+			"dynamicscalingenabled":           {"true"},
+			"encryptroot":                     {"true"},
+			"hosttags":                        {"hosttags"},
+			"hypervisorsnapshotreserve":       {"1"},
+			"iopsreadrate":                    {"2"},
+			"iopsreadratemax":                 {"2"},
+			"iopsreadratemaxlength":           {"2"},
+			"iopswriterate":                   {"2"},
+			"iopswriteratemax":                {"2"},
+			"iopswriteratemaxlength":          {"2"},
+			"issystem":                        {"true"},
+			"isvolatile":                      {"true"},
+			"limitcpuuse":                     {"true"},
+			"maxcpunumber":                    {"1"},
+			"maxiops":                         {"2"},
+			"maxmemory":                       {"1"},
+			"memory":                          {"1"},
+			"mincpunumber":                    {"1"},
+			"miniops":                         {"2"},
+			"minmemory":                       {"1"},
+			"name":                            {"name"},
+			"networkrate":                     {"1"},
+			"offerha":                         {"true"},
+			"provisioningtype":                {"provisioningtype"},
+			"rootdisksize":                    {"2"},
+			"serviceofferingdetails[0].key":   {"key1"},
+			"serviceofferingdetails[0].value": {"value1"},
+			"serviceofferingdetails[1].key":   {"key2"},
+			"serviceofferingdetails[1].value": {"value2"},
+			"storagepolicy":                   {"storagepolicy"},
+			"storagetype":                     {"storagetype"},
+			"systemvmtype":                    {"systemvmtype"},
+			"tags":                            {"tags"},
+			"zoneid":                          {"zoneid1,zoneid2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ServiceOffering.CreateServiceOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "51a0b0a8-03d6-e9b0-337a-fd1fb73ab6e8" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteServiceOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteServiceOffering")
+
+		p := client.ServiceOffering.NewDeleteServiceOfferingParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ServiceOffering.DeleteServiceOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListServiceOfferings", func(t *testing.T) {
+		defer server.checkCommands(t, "listServiceOfferings")
+
+		p := client.ServiceOffering.NewListServiceOfferingsParams()
+		p.SetAccount("account")
+		p.SetCpunumber(1)
+		p.SetCpuspeed(1)
+		p.SetDomainid("domainid")
+		p.SetEncryptroot(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetIssystem(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetMemory(1)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetStoragetype("storagetype")
+		p.SetSystemvmtype("systemvmtype")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"cpunumber":        {"1"},
+			"cpuspeed":         {"1"},
+			"domainid":         {"domainid"},
+			"encryptroot":      {"true"},
+			"id":               {"id"},
+			"isrecursive":      {"true"},
+			"issystem":         {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"memory":           {"1"},
+			"name":             {"name"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"projectid":        {"projectid"},
+			"storagetype":      {"storagetype"},
+			"systemvmtype":     {"systemvmtype"},
+			"virtualmachineid": {"virtualmachineid"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ServiceOffering.ListServiceOfferings(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ServiceOfferings) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ServiceOfferings))
+		}
+		if r.ServiceOfferings[0].Id != "c5542a65-98e6-c8e6-16f8-589884012a34" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.ServiceOfferings[0].Id)
+		}
+	})
+
+	t.Run("UpdateServiceOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "updateServiceOffering")
+
+		p := client.ServiceOffering.NewUpdateServiceOfferingParams("id")
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetHosttags("hosttags")
+		p.SetName("name")
+		p.SetSortkey(1)
+		p.SetStoragetags("storagetags")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"displaytext": {"displaytext"},
+			"domainid":    {"domainid"},
+			"hosttags":    {"hosttags"},
+			"id":          {"id"},
+			"name":        {"name"},
+			"sortkey":     {"1"},
+			"storagetags": {"storagetags"},
+			"zoneid":      {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.ServiceOffering.UpdateServiceOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e48a6a5e-f88b-3ff3-ed75-f79a7e4aa040" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -0,0 +1,272 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstacktest"
+)
+
+func TestSimulator(t *testing.T) {
+	sim := cloudstacktest.NewServer()
+	defer sim.Close()
+
+	zone := sim.AddZone("zone1")
+	offering := sim.AddServiceOffering("small", 2, 2048)
+	diskOffering := sim.AddDiskOffering("data", 20)
+	template := sim.AddTemplate("centos", zone.Id)
+
+	cs := sim.Client()
+
+	zoneID, _, err := cs.Zone.GetZoneID("zone1")
+	if err != nil || zoneID != zone.Id {
+		t.Fatalf("expected zone %s, got %s: %v", zone.Id, zoneID, err)
+	}
+
+	n, err := cs.Network.CreateNetwork(cs.Network.NewCreateNetworkParams("net1", "offering-1", zone.Id))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering.Id, template.Id, zone.Id)
+	p.SetName("vm1")
+	p.SetNetworkids([]string{n.Id})
+	p.SetDiskofferingid(diskOffering.Id)
+	vm, err := cs.VirtualMachine.DeployVirtualMachine(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vm.State != "Running" || vm.Cpunumber != 2 || len(vm.Nic) != 1 || vm.Nic[0].Networkid != n.Id {
+		t.Errorf("expected a running VM with one NIC, got %+v", vm)
+	}
+
+	volumes, err := cs.Volume.ListVolumes(cs.Volume.NewListVolumesParams())
+	if err != nil || volumes.Count != 2 {
+		t.Fatalf("expected a ROOT and DATA volume, got %v: %v", volumes, err)
+	}
+
+	tp := cs.Resourcetags.NewCreateTagsParams([]string{vm.Id}, "UserVm", map[string]string{"env": "test"})
+	if _, err := cs.Resourcetags.CreateTags(tp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lp := cs.VirtualMachine.NewListVirtualMachinesParams()
+	lp.SetTags(map[string]string{"env": "test"})
+	vms, err := cs.VirtualMachine.ListVirtualMachines(lp)
+	if err != nil || vms.Count != 1 || len(vms.VirtualMachines[0].Tags) != 1 {
+		t.Fatalf("expected one tagged VM, got %+v: %v", vms, err)
+	}
+
+	ip, err := cs.Address.AssociateIpAddress(func() *cloudstack.AssociateIpAddressParams {
+		ap := cs.Address.NewAssociateIpAddressParams()
+		ap.SetNetworkid(n.Id)
+		return ap
+	}())
+	if err != nil || ip.State != "Allocated" || ip.Associatednetworkid != n.Id {
+		t.Fatalf("expected an allocated IP address, got %+v: %v", ip, err)
+	}
+
+	if _, err := cs.Network.DeleteNetwork(cs.Network.NewDeleteNetworkParams(n.Id)); err == nil {
+		t.Errorf("expected an error deleting a network in use")
+	}
+
+	if _, err := cs.VirtualMachine.DestroyVirtualMachine(cs.VirtualMachine.NewDestroyVirtualMachineParams(vm.Id)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ := sim.VirtualMachine(vm.Id); got.State != "Destroyed" {
+		t.Errorf("expected the VM to be destroyed, got %s", got.State)
+	}
+
+	if _, err := cs.VirtualMachine.ExpungeVirtualMachine(cs.VirtualMachine.NewExpungeVirtualMachineParams(vm.Id)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sim.VirtualMachines()) != 0 || len(sim.Volumes()) != 1 {
+		t.Errorf("expected the VM and its ROOT volume to be removed")
+	}
+}
+
+func TestSimulatorAsyncJobs(t *testing.T) {
+	sim := cloudstacktest.NewServer(cloudstacktest.WithJobDelay(time.Hour))
+	defer sim.Close()
+
+	zone := sim.AddZone("zone1")
+	offering := sim.AddServiceOffering("small", 1, 1024)
+	template := sim.AddTemplate("centos", zone.Id)
+
+	cs := sim.Client()
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering.Id, template.Id, zone.Id)
+	vm, err := cs.VirtualMachine.DeployVirtualMachine(p, cloudstack.WithCallWait(false))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r, err := cs.Asyncjob.QueryAsyncJobResult(cs.Asyncjob.NewQueryAsyncJobResultParams(vm.JobID))
+	if err != nil || r.Jobstatus != 0 {
+		t.Fatalf("expected a pending job, got %+v: %v", r, err)
+	}
+	if got, _ := sim.VirtualMachine(vm.Id); got.State != "Starting" {
+		t.Errorf("expected the VM to be starting, got %s", got.State)
+	}
+}
+
+func TestSimulatorSignature(t *testing.T) {
+	sim := cloudstacktest.NewServer()
+	defer sim.Close()
+
+	cs := cloudstack.NewClient(sim.URL, sim.APIKey, "wrong-secret", false)
+	_, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	if err == nil || !strings.Contains(err.Error(), "signature") {
+		t.Errorf("expected a signature error, got %v", err)
+	}
+
+	cs = sim.Client(cloudstack.WithMaxGETLength(1), cloudstack.WithGzipRequests(true))
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Errorf("unexpected error for a gzipped POST call: %v", err)
+	}
+}
+
+func TestFaultTransport(t *testing.T) {
+	sim := cloudstacktest.NewServer()
+	defer sim.Close()
+
+	zone := sim.AddZone("zone1")
+	offering := sim.AddServiceOffering("small", 1, 1024)
+	template := sim.AddTemplate("centos", zone.Id)
+
+	ft := cloudstacktest.NewFaultTransport(nil,
+		&cloudstacktest.FaultRule{Command: "listZones", Calls: []int{1, 2}, Fault: cloudstacktest.HTTPError(503)},
+		&cloudstacktest.FaultRule{Command: "listTemplates", Calls: []int{1}, Fault: cloudstacktest.ConnectionReset()},
+		&cloudstacktest.FaultRule{Command: "listTemplates", Calls: []int{2}, Fault: cloudstacktest.MalformedJSON()},
+		&cloudstacktest.FaultRule{Command: "listServiceOfferings", Fault: cloudstacktest.Latency(50 * time.Millisecond)},
+		&cloudstacktest.FaultRule{Command: "deployVirtualMachine", Calls: []int{1}, Fault: cloudstacktest.FailedJob("out of capacity")},
+		&cloudstacktest.FaultRule{Command: "deployVirtualMachine", Calls: []int{2}, Fault: cloudstacktest.PendingJob()},
+	)
+	cs := sim.Client(cloudstack.WithHTTPClient(ft.Client()))
+
+	zp := cs.Zone.NewListZonesParams()
+	if _, err := cs.Zone.ListZones(zp); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("expected a 503 error, got %v", err)
+	}
+	retry := cloudstack.WithCallRetry(cloudstack.RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond})
+	if _, err := cs.Zone.ListZones(zp, retry); err != nil {
+		t.Errorf("expected the retry to succeed, got %v", err)
+	}
+
+	tp := cs.Template.NewListTemplatesParams("all")
+	if _, err := cs.Template.ListTemplates(tp); err == nil || !strings.Contains(err.Error(), "connection reset") {
+		t.Errorf("expected a connection reset error, got %v", err)
+	}
+	if _, err := cs.Template.ListTemplates(tp); err == nil {
+		t.Errorf("expected an error for malformed JSON")
+	}
+	if r, err := cs.Template.ListTemplates(tp); err != nil || r.Count != 1 {
+		t.Errorf("expected the third call to succeed, got %v: %v", r, err)
+	}
+
+	start := time.Now()
+	if _, err := cs.ServiceOffering.ListServiceOfferings(cs.ServiceOffering.NewListServiceOfferingsParams()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if time.Since(start) < 50*time.Millisecond {
+		t.Errorf("expected the call to be delayed")
+	}
+
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering.Id, template.Id, zone.Id)
+	if _, err := cs.VirtualMachine.DeployVirtualMachine(p); err == nil || !strings.Contains(err.Error(), "out of capacity") {
+		t.Errorf("expected a failed job, got %v", err)
+	}
+
+	vm, err := cs.VirtualMachine.DeployVirtualMachine(p, cloudstack.WithCallWait(false))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r, err := cs.Asyncjob.QueryAsyncJobResult(cs.Asyncjob.NewQueryAsyncJobResultParams(vm.JobID))
+	if err != nil || r.Jobstatus != 0 {
+		t.Errorf("expected a pending job, got %+v: %v", r, err)
+	}
+}
+
+func TestFaultTransportWithTestServer(t *testing.T) {
+	server := CreateTestServer(t, map[string]json.RawMessage{
+		"listZones": json.RawMessage(`{"listzonesresponse":{"count":1,"zone":[{"id":"zone-1","name":"zone1"}]}}`),
+	})
+	defer server.Close()
+
+	ft := cloudstacktest.NewFaultTransport(nil, &cloudstacktest.FaultRule{Probability: 0.5, Fault: cloudstacktest.HTTPError(431)})
+	ft.Seed(1)
+	cs := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithHTTPClient(ft.Client()))
+
+	failed := 0
+	for i := 0; i < 100; i++ {
+		if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+			failed++
+		}
+	}
+	if failed == 0 || failed == 100 {
+		t.Errorf("expected about half of the calls to fail, got %d", failed)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestFaultTransportZeroValue(t *testing.T) {
+	var sent string
+	ft := &cloudstacktest.FaultTransport{Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		sent = string(b)
+		body := `{"deployvirtualmachineresponse":{"jobid":"job-1"}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})}
+	ft.AddRule(&cloudstacktest.FaultRule{Command: "deployVirtualMachine", Probability: 0.5, Fault: cloudstacktest.Latency(time.Millisecond)})
+
+	req, err := http.NewRequest(http.MethodPost, "http://localhost/client/api", strings.NewReader("command=deployVirtualMachine&zoneid=zone1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body := req.Body
+
+	resp, err := ft.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if sent != "command=deployVirtualMachine&zoneid=zone1" {
+		t.Errorf("expected the complete body to be sent, got %q", sent)
+	}
+	if req.Body != body {
+		t.Errorf("expected the body of the request to be left as is")
+	}
+}
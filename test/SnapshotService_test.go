@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -198,3 +200,407 @@ func TestSnapshotService(t *testing.T) {
 	t.Run("UpdateSnapshotPolicy", testupdateSnapshotPolicy)
 
 }
+
+func TestSnapshotServiceFixtures(t *testing.T) {
+	response, err := readData("generated/SnapshotService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateSnapshot", func(t *testing.T) {
+		defer server.checkCommands(t, "createSnapshot", "queryAsyncJobResult")
+
+		p := client.Snapshot.NewCreateSnapshotParams("volumeid")
+		p.SetAccount("account")
+		p.SetAsyncbackup(true)
+		p.SetDomainid("domainid")
+		p.SetLocationtype("locationtype")
+		p.SetName("name")
+		p.SetPolicyid("policyid")
+		p.SetQuiescevm(true)
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetZoneids([]string{"zoneids1", "zoneids2"})
+
+		expected := url.Values{
+			"account":       {"account"},
+			"asyncbackup":   {"true"},
+			"domainid":      {"domainid"},
+			"locationtype":  {"locationtype"},
+			"name":          {"name"},
+			"policyid":      {"policyid"},
+			"quiescevm":     {"true"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"volumeid":      {"volumeid"},
+			"zoneids":       {"zoneids1,zoneids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.CreateSnapshot(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "2233ab29-85a8-a257-e4d6-9aa0072b6274" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateSnapshotPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "createSnapshotPolicy")
+
+		p := client.Snapshot.NewCreateSnapshotPolicyParams("intervaltype", 1, "schedule", "timezone", "volumeid")
+		p.SetFordisplay(true)
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetZoneids([]string{"zoneids1", "zoneids2"})
+
+		expected := url.Values{
+			"fordisplay":    {"true"},
+			"intervaltype":  {"intervaltype"},
+			"maxsnaps":      {"1"},
+			"schedule":      {"schedule"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"timezone":      {"timezone"},
+			"volumeid":      {"volumeid"},
+			"zoneids":       {"zoneids1,zoneids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.CreateSnapshotPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d5c72c9f-1d9e-9b39-3aba-ba6b230e3165" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVMSnapshot", func(t *testing.T) {
+		defer server.checkCommands(t, "createVMSnapshot", "queryAsyncJobResult")
+
+		p := client.Snapshot.NewCreateVMSnapshotParams("virtualmachineid")
+		p.SetDescription("description")
+		p.SetName("name")
+		p.SetQuiescevm(true)
+		p.SetSnapshotmemory(true)
+
+		expected := url.Values{
+			"description":      {"description"},
+			"name":             {"name"},
+			"quiescevm":        {"true"},
+			"snapshotmemory":   {"true"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.CreateVMSnapshot(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c6923c1c-6c2a-2d8f-9bb2-2af1682f6d9d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteSnapshot", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteSnapshot", "queryAsyncJobResult")
+
+		p := client.Snapshot.NewDeleteSnapshotParams("id")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":     {"id"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.DeleteSnapshot(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteSnapshotPolicies", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteSnapshotPolicies")
+
+		p := client.Snapshot.NewDeleteSnapshotPoliciesParams()
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+
+		expected := url.Values{
+			"id":  {"id"},
+			"ids": {"ids1,ids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.DeleteSnapshotPolicies(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteVMSnapshot", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVMSnapshot", "queryAsyncJobResult")
+
+		p := client.Snapshot.NewDeleteVMSnapshotParams("vmsnapshotid")
+
+		expected := url.Values{
+			"vmsnapshotid": {"vmsnapshotid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.DeleteVMSnapshot(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListSnapshotPolicies", func(t *testing.T) {
+		defer server.checkCommands(t, "listSnapshotPolicies")
+
+		p := client.Snapshot.NewListSnapshotPoliciesParams()
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetVolumeid("volumeid")
+
+		expected := url.Values{
+			"fordisplay": {"true"},
+			"id":         {"id"},
+			"keyword":    {"keyword"},
+			"page":       {"1"},
+			"pagesize":   {"1"},
+			"volumeid":   {"volumeid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.ListSnapshotPolicies(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.SnapshotPolicies) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.SnapshotPolicies))
+		}
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		defer server.checkCommands(t, "listSnapshots")
+
+		p := client.Snapshot.NewListSnapshotsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetImagestoreid("imagestoreid")
+		p.SetIntervaltype("intervaltype")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetLocationtype("locationtype")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetShowunique(true)
+		p.SetSnapshottype("snapshottype")
+		p.SetStorageid("storageid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetVolumeid("volumeid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"id":            {"id"},
+			"ids":           {"ids1,ids2"},
+			"imagestoreid":  {"imagestoreid"},
+			"intervaltype":  {"intervaltype"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"locationtype":  {"locationtype"},
+			"name":          {"name"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"showunique":    {"true"},
+			"snapshottype":  {"snapshottype"},
+			"storageid":     {"storageid"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"volumeid":      {"volumeid"},
+			"zoneid":        {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.ListSnapshots(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Snapshots) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Snapshots))
+		}
+		if r.Snapshots[0].Id != "b81121f5-8998-b597-3d34-7d48245575de" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Snapshots[0].Id)
+		}
+	})
+
+	t.Run("ListVMSnapshot", func(t *testing.T) {
+		defer server.checkCommands(t, "listVMSnapshot")
+
+		p := client.Snapshot.NewListVMSnapshotParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetState("state")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetVmsnapshotid("vmsnapshotid")
+		p.SetVmsnapshotids([]string{"vmsnapshotids1", "vmsnapshotids2"})
+
+		expected := url.Values{
+			"account":          {"account"},
+			"domainid":         {"domainid"},
+			"isrecursive":      {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"name":             {"name"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"projectid":        {"projectid"},
+			"state":            {"state"},
+			"tags[0].key":      {"key1"},
+			"tags[0].value":    {"value1"},
+			"tags[1].key":      {"key2"},
+			"tags[1].value":    {"value2"},
+			"virtualmachineid": {"virtualmachineid"},
+			"vmsnapshotid":     {"vmsnapshotid"},
+			"vmsnapshotids":    {"vmsnapshotids1,vmsnapshotids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.ListVMSnapshot(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VMSnapshot) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VMSnapshot))
+		}
+		if r.VMSnapshot[0].Id != "2ca30ef4-d6f5-2d26-432b-db4a6d68d482" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VMSnapshot[0].Id)
+		}
+	})
+
+	t.Run("RevertSnapshot", func(t *testing.T) {
+		defer server.checkCommands(t, "revertSnapshot", "queryAsyncJobResult")
+
+		p := client.Snapshot.NewRevertSnapshotParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.RevertSnapshot(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9887264e-9b5e-e603-df62-dd83d3a032e1" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RevertToVMSnapshot", func(t *testing.T) {
+		defer server.checkCommands(t, "revertToVMSnapshot", "queryAsyncJobResult")
+
+		p := client.Snapshot.NewRevertToVMSnapshotParams("vmsnapshotid")
+
+		expected := url.Values{
+			"vmsnapshotid": {"vmsnapshotid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.RevertToVMSnapshot(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cf07d4be-f912-cf8f-bab5-6cdd06a67ae6" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateSnapshotPolicy", func(t *testing.T) {
+		defer server.checkCommands(t, "updateSnapshotPolicy", "queryAsyncJobResult")
+
+		p := client.Snapshot.NewUpdateSnapshotPolicyParams()
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Snapshot.UpdateSnapshotPolicy(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "ffedd75d-e5e9-4301-2e63-61c275878397" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
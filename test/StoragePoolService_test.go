@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -78,3 +80,83 @@ func TestStoragePoolService(t *testing.T) {
 	t.Run("ListStorageProviders", testlistStorageProviders)
 
 }
+
+func TestStoragePoolServiceFixtures(t *testing.T) {
+	response, err := readData("generated/StoragePoolService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CancelStorageMaintenance", func(t *testing.T) {
+		defer server.checkCommands(t, "cancelStorageMaintenance", "queryAsyncJobResult")
+
+		p := client.StoragePool.NewCancelStorageMaintenanceParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.StoragePool.CancelStorageMaintenance(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f971785f-229e-8fa9-5767-e616b2a75eaa" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("EnableStorageMaintenance", func(t *testing.T) {
+		defer server.checkCommands(t, "enableStorageMaintenance", "queryAsyncJobResult")
+
+		p := client.StoragePool.NewEnableStorageMaintenanceParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.StoragePool.EnableStorageMaintenance(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "5281047d-fb17-f8bc-07a2-f864606eff5a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListStorageProviders", func(t *testing.T) {
+		defer server.checkCommands(t, "listStorageProviders")
+
+		p := client.StoragePool.NewListStorageProvidersParams("type")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"type":     {"type"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.StoragePool.ListStorageProviders(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.StorageProviders) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.StorageProviders))
+		}
+	})
+
+}
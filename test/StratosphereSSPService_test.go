@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -60,3 +62,62 @@ func TestStratosphereSSPService(t *testing.T) {
 	t.Run("DeleteStratosphereSsp", testdeleteStratosphereSsp)
 
 }
+
+func TestStratosphereSSPServiceFixtures(t *testing.T) {
+	response, err := readData("generated/StratosphereSSPService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddStratosphereSsp", func(t *testing.T) {
+		defer server.checkCommands(t, "addStratosphereSsp")
+
+		p := client.StratosphereSSP.NewAddStratosphereSspParams("name", "url", "zoneid")
+		p.SetPassword("password")
+		p.SetTenantuuid("tenantuuid")
+		p.SetUsername("username")
+
+		expected := url.Values{
+			"name":       {"name"},
+			"password":   {"password"},
+			"tenantuuid": {"tenantuuid"},
+			"url":        {"url"},
+			"username":   {"username"},
+			"zoneid":     {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.StratosphereSSP.AddStratosphereSsp(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DeleteStratosphereSsp", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteStratosphereSsp")
+
+		p := client.StratosphereSSP.NewDeleteStratosphereSspParams("hostid")
+
+		expected := url.Values{
+			"hostid": {"hostid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.StratosphereSSP.DeleteStratosphereSsp(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -63,3 +65,72 @@ func TestSwiftService(t *testing.T) {
 	t.Run("ListSwifts", testlistSwifts)
 
 }
+
+func TestSwiftServiceFixtures(t *testing.T) {
+	response, err := readData("generated/SwiftService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddSwift", func(t *testing.T) {
+		defer server.checkCommands(t, "addSwift")
+
+		p := client.Swift.NewAddSwiftParams("url")
+		p.SetAccount("account")
+		p.SetKey("key")
+		p.SetUsername("username")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"key":      {"key"},
+			"url":      {"url"},
+			"username": {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Swift.AddSwift(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e1c95c34-5e12-d6c1-e8be-3dd82c30706e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListSwifts", func(t *testing.T) {
+		defer server.checkCommands(t, "listSwifts")
+
+		p := client.Swift.NewListSwiftsParams()
+		p.SetId(2)
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"id":       {"2"},
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Swift.ListSwifts(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Swifts) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Swifts))
+		}
+		if r.Swifts[0].Id != "c9b02b26-1831-1a90-51c2-5311cfd9e781" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Swifts[0].Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -48,3 +50,52 @@ func TestSystemCapacityService(t *testing.T) {
 	t.Run("ListCapacity", testlistCapacity)
 
 }
+
+func TestSystemCapacityServiceFixtures(t *testing.T) {
+	response, err := readData("generated/SystemCapacityService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ListCapacity", func(t *testing.T) {
+		defer server.checkCommands(t, "listCapacity")
+
+		p := client.SystemCapacity.NewListCapacityParams()
+		p.SetClusterid("clusterid")
+		p.SetFetchlatest(true)
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetSortby("sortby")
+		p.SetType(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"clusterid":   {"clusterid"},
+			"fetchlatest": {"true"},
+			"keyword":     {"keyword"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"podid":       {"podid"},
+			"sortby":      {"sortby"},
+			"type":        {"1"},
+			"zoneid":      {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemCapacity.ListCapacity(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Capacity) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Capacity))
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -165,3 +167,248 @@ func TestSystemVMService(t *testing.T) {
 	t.Run("PatchSystemVm", testpatchSystemVm)
 
 }
+
+func TestSystemVMServiceFixtures(t *testing.T) {
+	response, err := readData("generated/SystemVMService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("ChangeServiceForSystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "changeServiceForSystemVm")
+
+		p := client.SystemVM.NewChangeServiceForSystemVmParams("id", "serviceofferingid")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"details[0].key1":   {"value1"},
+			"details[1].key2":   {"value2"},
+			"id":                {"id"},
+			"serviceofferingid": {"serviceofferingid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.ChangeServiceForSystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "6815d32f-b25c-2b41-affe-11023c7a7bb8" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DestroySystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "destroySystemVm", "queryAsyncJobResult")
+
+		p := client.SystemVM.NewDestroySystemVmParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.DestroySystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "696ee416-f8f5-4bf1-3516-2cf8eb8882a7" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListSystemVms", func(t *testing.T) {
+		defer server.checkCommands(t, "listSystemVms")
+
+		p := client.SystemVM.NewListSystemVmsParams()
+		p.SetHostid("hostid")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetState("state")
+		p.SetStorageid("storageid")
+		p.SetSystemvmtype("systemvmtype")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"hostid":       {"hostid"},
+			"id":           {"id"},
+			"keyword":      {"keyword"},
+			"name":         {"name"},
+			"page":         {"1"},
+			"pagesize":     {"1"},
+			"podid":        {"podid"},
+			"state":        {"state"},
+			"storageid":    {"storageid"},
+			"systemvmtype": {"systemvmtype"},
+			"zoneid":       {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.ListSystemVms(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.SystemVms) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.SystemVms))
+		}
+		if r.SystemVms[0].Id != "fcef75b2-d2cf-9afa-78b4-d3393b7392a2" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.SystemVms[0].Id)
+		}
+	})
+
+	t.Run("MigrateSystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "migrateSystemVm", "queryAsyncJobResult")
+
+		p := client.SystemVM.NewMigrateSystemVmParams("virtualmachineid")
+		p.SetAutoselect(true)
+		p.SetHostid("hostid")
+		p.SetStorageid("storageid")
+
+		expected := url.Values{
+			"autoselect":       {"true"},
+			"hostid":           {"hostid"},
+			"storageid":        {"storageid"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.MigrateSystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "ab880b23-6d38-f3e6-e7e2-0a634480fa6f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RebootSystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "rebootSystemVm", "queryAsyncJobResult")
+
+		p := client.SystemVM.NewRebootSystemVmParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.RebootSystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "6bcea9e2-35d1-4455-3c6b-e5cb7db2296e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ScaleSystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "scaleSystemVm", "queryAsyncJobResult")
+
+		p := client.SystemVM.NewScaleSystemVmParams("id", "serviceofferingid")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"details[0].key1":   {"value1"},
+			"details[1].key2":   {"value2"},
+			"id":                {"id"},
+			"serviceofferingid": {"serviceofferingid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.ScaleSystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "e599981f-cb1c-2e80-743c-cc0b90b10946" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StartSystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "startSystemVm", "queryAsyncJobResult")
+
+		p := client.SystemVM.NewStartSystemVmParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.StartSystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "3e24e874-397e-8721-35cc-49237a92ce8d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StopSystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "stopSystemVm", "queryAsyncJobResult")
+
+		p := client.SystemVM.NewStopSystemVmParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.StopSystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "047cc49c-c7ea-f0ed-39b7-abf4302aeea9" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("PatchSystemVm", func(t *testing.T) {
+		defer server.checkCommands(t, "patchSystemVm", "queryAsyncJobResult")
+
+		p := client.SystemVM.NewPatchSystemVmParams()
+		p.SetForced(true)
+		p.SetId("id")
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.SystemVM.PatchSystemVm(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+}
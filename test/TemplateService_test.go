@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -219,3 +221,564 @@ func TestTemplateService(t *testing.T) {
 	t.Run("ProvisionTemplateDirectDownloadCertificate", testprovisionTemplateDirectDownloadCertificate)
 
 }
+
+func TestTemplateServiceFixtures(t *testing.T) {
+	response, err := readData("generated/TemplateService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CopyTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "copyTemplate", "queryAsyncJobResult")
+
+		p := client.Template.NewCopyTemplateParams("id")
+		p.SetDestzoneid("destzoneid")
+		p.SetDestzoneids([]string{"destzoneids1", "destzoneids2"})
+		p.SetSourcezoneid("sourcezoneid")
+
+		expected := url.Values{
+			"destzoneid":   {"destzoneid"},
+			"destzoneids":  {"destzoneids1,destzoneids2"},
+			"id":           {"id"},
+			"sourcezoneid": {"sourcezoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.CopyTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7e42d2e3-650d-02b9-e138-33b1ec71d3c5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "createTemplate", "queryAsyncJobResult")
+
+		p := client.Template.NewCreateTemplateParams("displaytext", "name", "ostypeid")
+		p.SetAccount("account")
+		p.SetBits(1)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDomainid("domainid")
+		p.SetIsdynamicallyscalable(true)
+		p.SetIsfeatured(true)
+		p.SetIspublic(true)
+		p.SetPasswordenabled(true)
+		p.SetProjectid("projectid")
+		p.SetRequireshvm(true)
+		p.SetSnapshotid("snapshotid")
+		p.SetSshkeyenabled(true)
+		p.SetTemplatetag("templatetag")
+		p.SetUrl("url")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetVolumeid("volumeid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":               {"account"},
+			"bits":                  {"1"},
+			"details[0].key1":       {"value1"},
+			"details[1].key2":       {"value2"},
+			"displaytext":           {"displaytext"},
+			"domainid":              {"domainid"},
+			"isdynamicallyscalable": {"true"},
+			"isfeatured":            {"true"},
+			"ispublic":              {"true"},
+			"name":                  {"name"},
+			"ostypeid":              {"ostypeid"},
+			"passwordenabled":       {"true"},
+			"projectid":             {"projectid"},
+			"requireshvm":           {"true"},
+			"snapshotid":            {"snapshotid"},
+			"sshkeyenabled":         {"true"},
+			"templatetag":           {"templatetag"},
+			"url":                   {"url"},
+			"virtualmachineid":      {"virtualmachineid"},
+			"volumeid":              {"volumeid"},
+			"zoneid":                {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.CreateTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "588d6cc5-8951-9282-7d62-88c63b0c7231" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteTemplate", "queryAsyncJobResult")
+
+		p := client.Template.NewDeleteTemplateParams("id")
+		p.SetForced(true)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.DeleteTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ExtractTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "extractTemplate", "queryAsyncJobResult")
+
+		p := client.Template.NewExtractTemplateParams("id", "mode")
+		p.SetUrl("url")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":     {"id"},
+			"mode":   {"mode"},
+			"url":    {"url"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.ExtractTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "bbab1e52-f381-b718-1968-428b1fe72365" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("GetUploadParamsForTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "getUploadParamsForTemplate")
+
+		p := client.Template.NewGetUploadParamsForTemplateParams("displaytext", "format", "hypervisor", "name", "zoneid")
+		p.SetAccount("account")
+		p.SetBits(1)
+		p.SetChecksum("checksum")
+		p.SetDeployasis(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDomainid("domainid")
+		p.SetIsdynamicallyscalable(true)
+		p.SetIsextractable(true)
+		p.SetIsfeatured(true)
+		p.SetIspublic(true)
+		p.SetIsrouting(true)
+		p.SetOstypeid("ostypeid")
+		p.SetPasswordenabled(true)
+		p.SetProjectid("projectid")
+		p.SetRequireshvm(true)
+		p.SetSshkeyenabled(true)
+		p.SetTemplatetag("templatetag")
+
+		expected := url.Values{
+			"account":               {"account"},
+			"bits":                  {"1"},
+			"checksum":              {"checksum"},
+			"deployasis":            {"true"},
+			"details[0].key1":       {"value1"},
+			"details[1].key2":       {"value2"},
+			"displaytext":           {"displaytext"},
+			"domainid":              {"domainid"},
+			"format":                {"format"},
+			"hypervisor":            {"hypervisor"},
+			"isdynamicallyscalable": {"true"},
+			"isextractable":         {"true"},
+			"isfeatured":            {"true"},
+			"ispublic":              {"true"},
+			"isrouting":             {"true"},
+			"name":                  {"name"},
+			"ostypeid":              {"ostypeid"},
+			"passwordenabled":       {"true"},
+			"projectid":             {"projectid"},
+			"requireshvm":           {"true"},
+			"sshkeyenabled":         {"true"},
+			"templatetag":           {"templatetag"},
+			"zoneid":                {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.GetUploadParamsForTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "323d2bb9-dd24-2e11-ff22-0edccaedd215" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListTemplatePermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "listTemplatePermissions")
+
+		p := client.Template.NewListTemplatePermissionsParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.ListTemplatePermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.TemplatePermissions) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.TemplatePermissions))
+		}
+	})
+
+	t.Run("ListTemplates", func(t *testing.T) {
+		defer server.checkCommands(t, "listTemplates")
+
+		p := client.Template.NewListTemplatesParams("templatefilter")
+		p.SetAccount("account")
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetDomainid("domainid")
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetImagestoreid("imagestoreid")
+		p.SetIsrecursive(true)
+		p.SetIsvnf(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetParenttemplateid("parenttemplateid")
+		p.SetProjectid("projectid")
+		p.SetShowicon(true)
+		p.SetShowremoved(true)
+		p.SetShowunique(true)
+		p.SetStorageid("storageid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetTemplatetype("templatetype")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"details":          {"details1,details2"},
+			"domainid":         {"domainid"},
+			"hypervisor":       {"hypervisor"},
+			"id":               {"id"},
+			"ids":              {"ids1,ids2"},
+			"imagestoreid":     {"imagestoreid"},
+			"isrecursive":      {"true"},
+			"isvnf":            {"true"},
+			"keyword":          {"keyword"},
+			"listall":          {"true"},
+			"name":             {"name"},
+			"page":             {"1"},
+			"pagesize":         {"1"},
+			"parenttemplateid": {"parenttemplateid"},
+			"projectid":        {"projectid"},
+			"showicon":         {"true"},
+			"showremoved":      {"true"},
+			"showunique":       {"true"},
+			"storageid":        {"storageid"},
+			"tags[0].key":      {"key1"},
+			"tags[0].value":    {"value1"},
+			"tags[1].key":      {"key2"},
+			"tags[1].value":    {"value2"},
+			"templatefilter":   {"templatefilter"},
+			"templatetype":     {"templatetype"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.ListTemplates(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Templates) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Templates))
+		}
+		if r.Templates[0].Id != "d32241c5-c00b-89d1-ba07-9f5cfadb8643" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Templates[0].Id)
+		}
+	})
+
+	t.Run("PrepareTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "prepareTemplate")
+
+		p := client.Template.NewPrepareTemplateParams("templateid", "zoneid")
+		p.SetStorageid("storageid")
+
+		expected := url.Values{
+			"storageid":  {"storageid"},
+			"templateid": {"templateid"},
+			"zoneid":     {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.PrepareTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "5e50bb04-e4fe-4823-d8c0-c9e61d54631c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RegisterTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "registerTemplate")
+
+		p := client.Template.NewRegisterTemplateParams("displaytext", "format", "hypervisor", "name", "url")
+		p.SetAccount("account")
+		p.SetBits(1)
+		p.SetChecksum("checksum")
+		p.SetDeployasis(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDirectdownload(true)
+		p.SetDomainid("domainid")
+		p.SetIsdynamicallyscalable(true)
+		p.SetIsextractable(true)
+		p.SetIsfeatured(true)
+		p.SetIspublic(true)
+		p.SetIsrouting(true)
+		p.SetOstypeid("ostypeid")
+		p.SetPasswordenabled(true)
+		p.SetProjectid("projectid")
+		p.SetRequireshvm(true)
+		p.SetSshkeyenabled(true)
+		p.SetTemplatetag("templatetag")
+		p.SetTemplatetype("templatetype")
+		p.SetZoneid("zoneid")
+		p.SetZoneids([]string{"zoneids1", "zoneids2"})
+
+		expected := url.Values{
+			"account":               {"account"},
+			"bits":                  {"1"},
+			"checksum":              {"checksum"},
+			"deployasis":            {"true"},
+			"details[0].key1":       {"value1"},
+			"details[0].key2":       {"value2"},
+			"directdownload":        {"true"},
+			"displaytext":           {"displaytext"},
+			"domainid":              {"domainid"},
+			"format":                {"format"},
+			"hypervisor":            {"hypervisor"},
+			"isdynamicallyscalable": {"true"},
+			"isextractable":         {"true"},
+			"isfeatured":            {"true"},
+			"ispublic":              {"true"},
+			"isrouting":             {"true"},
+			"name":                  {"name"},
+			"ostypeid":              {"ostypeid"},
+			"passwordenabled":       {"true"},
+			"projectid":             {"projectid"},
+			"requireshvm":           {"true"},
+			"sshkeyenabled":         {"true"},
+			"templatetag":           {"templatetag"},
+			"templatetype":          {"templatetype"},
+			"url":                   {"url"},
+			"zoneid":                {"zoneid"},
+			"zoneids":               {"zoneids1,zoneids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.RegisterTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.RegisterTemplate) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.RegisterTemplate))
+		}
+		if r.RegisterTemplate[0].Id != "27fc119e-b952-c5a8-2a0a-34242f3dd06c" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.RegisterTemplate[0].Id)
+		}
+	})
+
+	t.Run("UpdateTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "updateTemplate")
+
+		p := client.Template.NewUpdateTemplateParams("id")
+		p.SetBootable(true)
+		p.SetCleanupdetails(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDisplaytext("displaytext")
+		p.SetFormat("format")
+		p.SetIsdynamicallyscalable(true)
+		p.SetIsrouting(true)
+		p.SetName("name")
+		p.SetOstypeid("ostypeid")
+		p.SetPasswordenabled(true)
+		p.SetRequireshvm(true)
+		p.SetSortkey(1)
+		p.SetSshkeyenabled(true)
+		p.SetTemplatetype("templatetype")
+
+		expected := url.Values{
+			"bootable":              {"true"},
+			"cleanupdetails":        {"true"},
+			"details[0].key1":       {"value1"},
+			"details[0].key2":       {"value2"},
+			"displaytext":           {"displaytext"},
+			"format":                {"format"},
+			"id":                    {"id"},
+			"isdynamicallyscalable": {"true"},
+			"isrouting":             {"true"},
+			"name":                  {"name"},
+			"ostypeid":              {"ostypeid"},
+			"passwordenabled":       {"true"},
+			"requireshvm":           {"true"},
+			"sortkey":               {"1"},
+			"sshkeyenabled":         {"true"},
+			"templatetype":          {"templatetype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.UpdateTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "788e76e5-db46-c1a4-ea6b-cb180549b602" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateTemplatePermissions", func(t *testing.T) {
+		defer server.checkCommands(t, "updateTemplatePermissions")
+
+		p := client.Template.NewUpdateTemplatePermissionsParams("id")
+		p.SetAccounts([]string{"accounts1", "accounts2"})
+		p.SetIsextractable(true)
+		p.SetIsfeatured(true)
+		p.SetIspublic(true)
+		p.SetOp("op")
+		p.SetProjectids([]string{"projectids1", "projectids2"})
+
+		expected := url.Values{
+			"accounts":      {"accounts1,accounts2"},
+			"id":            {"id"},
+			"isextractable": {"true"},
+			"isfeatured":    {"true"},
+			"ispublic":      {"true"},
+			"op":            {"op"},
+			"projectids":    {"projectids1,projectids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.UpdateTemplatePermissions(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpgradeRouterTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "upgradeRouterTemplate")
+
+		p := client.Template.NewUpgradeRouterTemplateParams()
+		p.SetAccount("account")
+		p.SetClusterid("clusterid")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetPodid("podid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"clusterid": {"clusterid"},
+			"domainid":  {"domainid"},
+			"id":        {"id"},
+			"podid":     {"podid"},
+			"zoneid":    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.UpgradeRouterTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListTemplateDirectDownloadCertificates", func(t *testing.T) {
+		defer server.checkCommands(t, "listTemplateDirectDownloadCertificates")
+
+		p := client.Template.NewListTemplateDirectDownloadCertificatesParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetListhosts(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":        {"id"},
+			"keyword":   {"keyword"},
+			"listhosts": {"true"},
+			"page":      {"1"},
+			"pagesize":  {"1"},
+			"zoneid":    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.ListTemplateDirectDownloadCertificates(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.TemplateDirectDownloadCertificates) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.TemplateDirectDownloadCertificates))
+		}
+	})
+
+	t.Run("ProvisionTemplateDirectDownloadCertificate", func(t *testing.T) {
+		defer server.checkCommands(t, "provisionTemplateDirectDownloadCertificate")
+
+		p := client.Template.NewProvisionTemplateDirectDownloadCertificateParams("hostid", "id")
+
+		expected := url.Values{
+			"hostid": {"hostid"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Template.ProvisionTemplateDirectDownloadCertificate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+}
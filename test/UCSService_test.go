@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -114,3 +116,171 @@ func TestUCSService(t *testing.T) {
 	t.Run("ListUcsProfiles", testlistUcsProfiles)
 
 }
+
+func TestUCSServiceFixtures(t *testing.T) {
+	response, err := readData("generated/UCSService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddUcsManager", func(t *testing.T) {
+		defer server.checkCommands(t, "addUcsManager")
+
+		p := client.UCS.NewAddUcsManagerParams("password", "url", "username", "zoneid")
+		p.SetName("name")
+
+		expected := url.Values{
+			"name":     {"name"},
+			"password": {"password"},
+			"url":      {"url"},
+			"username": {"username"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UCS.AddUcsManager(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "06f00c35-647e-a2d0-fc54-71f188cc4063" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AssociateUcsProfileToBlade", func(t *testing.T) {
+		defer server.checkCommands(t, "associateUcsProfileToBlade", "queryAsyncJobResult")
+
+		p := client.UCS.NewAssociateUcsProfileToBladeParams("bladeid", "profiledn", "ucsmanagerid")
+
+		expected := url.Values{
+			"bladeid":      {"bladeid"},
+			"profiledn":    {"profiledn"},
+			"ucsmanagerid": {"ucsmanagerid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UCS.AssociateUcsProfileToBlade(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "68a755b2-6b79-446e-27b6-f0e07eed4ace" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteUcsManager", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteUcsManager")
+
+		p := client.UCS.NewDeleteUcsManagerParams("ucsmanagerid")
+
+		expected := url.Values{
+			"ucsmanagerid": {"ucsmanagerid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UCS.DeleteUcsManager(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListUcsBlades", func(t *testing.T) {
+		defer server.checkCommands(t, "listUcsBlades")
+
+		p := client.UCS.NewListUcsBladesParams("ucsmanagerid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":      {"keyword"},
+			"page":         {"1"},
+			"pagesize":     {"1"},
+			"ucsmanagerid": {"ucsmanagerid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UCS.ListUcsBlades(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.UcsBlades) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.UcsBlades))
+		}
+	})
+
+	t.Run("ListUcsManagers", func(t *testing.T) {
+		defer server.checkCommands(t, "listUcsManagers")
+
+		p := client.UCS.NewListUcsManagersParams()
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UCS.ListUcsManagers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.UcsManagers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.UcsManagers))
+		}
+		if r.UcsManagers[0].Id != "2a83c259-590f-a2ef-5a13-b2c49abdeca8" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.UcsManagers[0].Id)
+		}
+	})
+
+	t.Run("ListUcsProfiles", func(t *testing.T) {
+		defer server.checkCommands(t, "listUcsProfiles")
+
+		p := client.UCS.NewListUcsProfilesParams("ucsmanagerid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":      {"keyword"},
+			"page":         {"1"},
+			"pagesize":     {"1"},
+			"ucsmanagerid": {"ucsmanagerid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UCS.ListUcsProfiles(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.UcsProfiles) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.UcsProfiles))
+		}
+	})
+
+}
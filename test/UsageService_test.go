@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -201,3 +203,363 @@ func TestUsageService(t *testing.T) {
 	t.Run("ListUsageServerMetrics", testlistUsageServerMetrics)
 
 }
+
+func TestUsageServiceFixtures(t *testing.T) {
+	response, err := readData("generated/UsageService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddTrafficMonitor", func(t *testing.T) {
+		defer server.checkCommands(t, "addTrafficMonitor")
+
+		p := client.Usage.NewAddTrafficMonitorParams("url", "zoneid")
+		p.SetExcludezones("excludezones")
+		p.SetIncludezones("includezones")
+
+		expected := url.Values{
+			"excludezones": {"excludezones"},
+			"includezones": {"includezones"},
+			"url":          {"url"},
+			"zoneid":       {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.AddTrafficMonitor(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8daa9f2e-b7fe-5b62-8e35-8a30dd3e9998" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AddTrafficType", func(t *testing.T) {
+		defer server.checkCommands(t, "addTrafficType", "queryAsyncJobResult")
+
+		p := client.Usage.NewAddTrafficTypeParams("physicalnetworkid", "traffictype")
+		p.SetHypervnetworklabel("hypervnetworklabel")
+		p.SetIsolationmethod("isolationmethod")
+		p.SetKvmnetworklabel("kvmnetworklabel")
+		p.SetOvm3networklabel("ovm3networklabel")
+		p.SetVlan("vlan")
+		p.SetVmwarenetworklabel("vmwarenetworklabel")
+		p.SetXennetworklabel("xennetworklabel")
+
+		expected := url.Values{
+			"hypervnetworklabel": {"hypervnetworklabel"},
+			"isolationmethod":    {"isolationmethod"},
+			"kvmnetworklabel":    {"kvmnetworklabel"},
+			"ovm3networklabel":   {"ovm3networklabel"},
+			"physicalnetworkid":  {"physicalnetworkid"},
+			"traffictype":        {"traffictype"},
+			"vlan":               {"vlan"},
+			"vmwarenetworklabel": {"vmwarenetworklabel"},
+			"xennetworklabel":    {"xennetworklabel"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.AddTrafficType(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "fe53d39e-8bce-2968-b86f-891d6eacf891" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteTrafficMonitor", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteTrafficMonitor")
+
+		p := client.Usage.NewDeleteTrafficMonitorParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.DeleteTrafficMonitor(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteTrafficType", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteTrafficType", "queryAsyncJobResult")
+
+		p := client.Usage.NewDeleteTrafficTypeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.DeleteTrafficType(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("GenerateUsageRecords", func(t *testing.T) {
+		defer server.checkCommands(t, "generateUsageRecords")
+
+		p := client.Usage.NewGenerateUsageRecordsParams("enddate", "startdate")
+		p.SetDomainid("domainid")
+
+		expected := url.Values{
+			"domainid":  {"domainid"},
+			"enddate":   {"enddate"},
+			"startdate": {"startdate"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.GenerateUsageRecords(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListTrafficMonitors", func(t *testing.T) {
+		defer server.checkCommands(t, "listTrafficMonitors")
+
+		p := client.Usage.NewListTrafficMonitorsParams("zoneid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":  {"keyword"},
+			"page":     {"1"},
+			"pagesize": {"1"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.ListTrafficMonitors(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.TrafficMonitors) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.TrafficMonitors))
+		}
+	})
+
+	t.Run("ListTrafficTypeImplementors", func(t *testing.T) {
+		defer server.checkCommands(t, "listTrafficTypeImplementors")
+
+		p := client.Usage.NewListTrafficTypeImplementorsParams()
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetTraffictype("traffictype")
+
+		expected := url.Values{
+			"keyword":     {"keyword"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"traffictype": {"traffictype"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.ListTrafficTypeImplementors(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.TrafficTypeImplementors) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.TrafficTypeImplementors))
+		}
+	})
+
+	t.Run("ListTrafficTypes", func(t *testing.T) {
+		defer server.checkCommands(t, "listTrafficTypes")
+
+		p := client.Usage.NewListTrafficTypesParams("physicalnetworkid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+
+		expected := url.Values{
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.ListTrafficTypes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.TrafficTypes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.TrafficTypes))
+		}
+		if r.TrafficTypes[0].Id != "870aea6e-7532-e369-963a-95d3729e1503" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.TrafficTypes[0].Id)
+		}
+	})
+
+	t.Run("ListUsageRecords", func(t *testing.T) {
+		defer server.checkCommands(t, "listUsageRecords")
+
+		p := client.Usage.NewListUsageRecordsParams("enddate", "startdate")
+		p.SetAccount("account")
+		p.SetAccountid("accountid")
+		p.SetDomainid("domainid")
+		p.SetIncludetags(true)
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetOldformat(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetType(2)
+		p.SetUsageid("usageid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"accountid":   {"accountid"},
+			"domainid":    {"domainid"},
+			"enddate":     {"enddate"},
+			"includetags": {"true"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"oldformat":   {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"startdate":   {"startdate"},
+			"type":        {"2"},
+			"usageid":     {"usageid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.ListUsageRecords(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.UsageRecords) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.UsageRecords))
+		}
+	})
+
+	t.Run("ListUsageTypes", func(t *testing.T) {
+		defer server.checkCommands(t, "listUsageTypes")
+
+		p := client.Usage.NewListUsageTypesParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.ListUsageTypes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.UsageTypes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.UsageTypes))
+		}
+	})
+
+	t.Run("RemoveRawUsageRecords", func(t *testing.T) {
+		defer server.checkCommands(t, "removeRawUsageRecords")
+
+		p := client.Usage.NewRemoveRawUsageRecordsParams(1)
+
+		expected := url.Values{
+			"interval": {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.RemoveRawUsageRecords(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateTrafficType", func(t *testing.T) {
+		defer server.checkCommands(t, "updateTrafficType", "queryAsyncJobResult")
+
+		p := client.Usage.NewUpdateTrafficTypeParams("id")
+		p.SetHypervnetworklabel("hypervnetworklabel")
+		p.SetKvmnetworklabel("kvmnetworklabel")
+		p.SetOvm3networklabel("ovm3networklabel")
+		p.SetVmwarenetworklabel("vmwarenetworklabel")
+		p.SetXennetworklabel("xennetworklabel")
+
+		expected := url.Values{
+			"hypervnetworklabel": {"hypervnetworklabel"},
+			"id":                 {"id"},
+			"kvmnetworklabel":    {"kvmnetworklabel"},
+			"ovm3networklabel":   {"ovm3networklabel"},
+			"vmwarenetworklabel": {"vmwarenetworklabel"},
+			"xennetworklabel":    {"xennetworklabel"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.UpdateTrafficType(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b34f35e7-05af-6581-c02e-848cb1cce0c1" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListUsageServerMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listUsageServerMetrics")
+
+		p := client.Usage.NewListUsageServerMetricsParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Usage.ListUsageServerMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.UsageServerMetrics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.UsageServerMetrics))
+		}
+	})
+
+}
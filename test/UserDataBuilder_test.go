@@ -0,0 +1,101 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+func TestUserDataBuilder(t *testing.T) {
+	client := cloudstack.NewClient(CS_API_URL, CS_API_KEY, CS_SECRET_KEY, true)
+
+	single := cloudstack.NewUserDataBuilder().AddCloudConfig("packages:\n  - nginx\n")
+	p := client.VirtualMachine.NewDeployVirtualMachineParams("serviceofferingid", "templateid", "zoneid")
+	if err := single.Apply(p); err != nil {
+		t.Fatalf("failed to build single part userdata: %v", err)
+	}
+	userdata, _ := p.GetUserdata()
+	decoded, _ := base64.StdEncoding.DecodeString(userdata)
+	if string(decoded) != "#cloud-config\npackages:\n  - nginx\n" {
+		t.Errorf("unexpected single part userdata: %q", decoded)
+	}
+
+	for _, gzip := range []bool{false, true} {
+		b := cloudstack.NewUserDataBuilder().
+			AddCloudConfig("#cloud-config\npackages:\n  - nginx\n").
+			AddShellScript("setup.sh", "echo hello\n").
+			AddIncludeURLs("https://example.com/extra.yaml").
+			SetGzip(gzip)
+
+		userdata, err := b.Build()
+		if err != nil {
+			t.Fatalf("failed to build multipart userdata: %v", err)
+		}
+
+		parts, err := cloudstack.ParseUserData(userdata)
+		if err != nil {
+			t.Fatalf("failed to parse multipart userdata: %v", err)
+		}
+		if len(parts) != 3 {
+			t.Fatalf("expected 3 parts, got %d", len(parts))
+		}
+		for i, part := range b.Parts() {
+			if parts[i] != part {
+				t.Errorf("part %d: expected %+v, got %+v", i, part, parts[i])
+			}
+		}
+	}
+
+	large := cloudstack.NewUserDataBuilder().AddShellScript("large.sh", strings.Repeat("echo hello\n", 1000)).SetMaxLength(1024)
+	if _, err := large.Build(); err == nil {
+		t.Errorf("expected an error for userdata exceeding the max length")
+	}
+	if _, err := large.SetGzip(true).Build(); err != nil {
+		t.Errorf("expected compressed userdata to fit within the max length: %v", err)
+	}
+
+	if _, err := cloudstack.NewUserDataBuilder().Build(); err == nil {
+		t.Errorf("expected an error for userdata without any parts")
+	}
+}
+
+func TestGetUserDataMaxLength(t *testing.T) {
+	server := newFixtureServer(map[string]json.RawMessage{
+		"listConfigurations": json.RawMessage(`{"listconfigurationsresponse": {"count": 1, "configuration": [
+			{"name": "vm.userdata.max.length", "value": "1048576"}
+		]}}`),
+	})
+	defer server.Close()
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	length, err := client.GetUserDataMaxLength()
+	if err != nil {
+		t.Fatalf("Failed to get the max userdata length: %v", err)
+	}
+	if length != 1048576 {
+		t.Errorf("Expected a max userdata length of 1048576, got %d", length)
+	}
+	server.checkCommands(t, "listConfigurations")
+}
@@ -0,0 +1,233 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
+)
+
+func TestUserDataService(t *testing.T) {
+	service := "UserDataService"
+	response, err := readData(service)
+	if err != nil {
+		t.Skipf("Skipping test as %v", err)
+	}
+	server := CreateTestServer(t, response)
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	testdeleteUserData := func(t *testing.T) {
+		if _, ok := response["deleteUserData"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.UserData.NewDeleteUserDataParams("id")
+		_, err := client.UserData.DeleteUserData(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("DeleteUserData", testdeleteUserData)
+
+	testlinkUserDataToTemplate := func(t *testing.T) {
+		if _, ok := response["linkUserDataToTemplate"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.UserData.NewLinkUserDataToTemplateParams()
+		r, err := client.UserData.LinkUserDataToTemplate(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("LinkUserDataToTemplate", testlinkUserDataToTemplate)
+
+	testlistUserData := func(t *testing.T) {
+		if _, ok := response["listUserData"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.UserData.NewListUserDataParams()
+		_, err := client.UserData.ListUserData(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+	}
+	t.Run("ListUserData", testlistUserData)
+
+	testregisterUserData := func(t *testing.T) {
+		if _, ok := response["registerUserData"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.UserData.NewRegisterUserDataParams("name", "userdata")
+		r, err := client.UserData.RegisterUserData(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("RegisterUserData", testregisterUserData)
+
+}
+
+func TestUserDataServiceFixtures(t *testing.T) {
+	response, err := readData("generated/UserDataService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("DeleteUserData", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteUserData")
+
+		p := client.UserData.NewDeleteUserDataParams("id")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"id":        {"id"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UserData.DeleteUserData(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("LinkUserDataToTemplate", func(t *testing.T) {
+		defer server.checkCommands(t, "linkUserDataToTemplate")
+
+		p := client.UserData.NewLinkUserDataToTemplateParams()
+		p.SetIsoid("isoid")
+		p.SetTemplateid("templateid")
+		p.SetUserdataid("userdataid")
+		p.SetUserdatapolicy("userdatapolicy")
+
+		expected := url.Values{
+			"isoid":          {"isoid"},
+			"templateid":     {"templateid"},
+			"userdataid":     {"userdataid"},
+			"userdatapolicy": {"userdatapolicy"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UserData.LinkUserDataToTemplate(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f40366b5-3cac-86c1-fb79-8da00840bc67" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListUserData", func(t *testing.T) {
+		defer server.checkCommands(t, "listUserData")
+
+		p := client.UserData.NewListUserDataParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UserData.ListUserData(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.UserData) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.UserData))
+		}
+		if r.UserData[0].Id != "750dbcec-93e4-b7f2-df20-293395e9f18b" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.UserData[0].Id)
+		}
+	})
+
+	t.Run("RegisterUserData", func(t *testing.T) {
+		defer server.checkCommands(t, "registerUserData")
+
+		p := client.UserData.NewRegisterUserDataParams("name", "userdata")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetParams("params")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"name":      {"name"},
+			"params":    {"params"},
+			"projectid": {"projectid"},
+			"userdata":  {"userdata"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.UserData.RegisterUserData(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "3f20e3c4-11f9-214b-be78-9bb5fae1ee7a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -186,3 +188,293 @@ func TestUserService(t *testing.T) {
 	t.Run("UpdateUser", testupdateUser)
 
 }
+
+func TestUserServiceFixtures(t *testing.T) {
+	response, err := readData("generated/UserService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateUser", func(t *testing.T) {
+		defer server.checkCommands(t, "createUser")
+
+		p := client.User.NewCreateUserParams("account", "email", "firstname", "lastname", "password", "username")
+		p.SetDomainid("domainid")
+		p.SetTimezone("timezone")
+		p.SetUserid("userid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"email":     {"email"},
+			"firstname": {"firstname"},
+			"lastname":  {"lastname"},
+			"password":  {"password"},
+			"timezone":  {"timezone"},
+			"userid":    {"userid"},
+			"username":  {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.CreateUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "30bf3a2a-d788-efd9-c0db-864dc4e5a997" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteUser", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteUser")
+
+		p := client.User.NewDeleteUserParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.DeleteUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableUser", func(t *testing.T) {
+		defer server.checkCommands(t, "disableUser", "queryAsyncJobResult")
+
+		p := client.User.NewDisableUserParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.DisableUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f26b13b5-7186-dc71-2d1e-c87afcd99824" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("EnableUser", func(t *testing.T) {
+		defer server.checkCommands(t, "enableUser")
+
+		p := client.User.NewEnableUserParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.EnableUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cfbec0d6-15e6-9656-447d-3c2090f556b5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("GetUser", func(t *testing.T) {
+		defer server.checkCommands(t, "getUser")
+
+		p := client.User.NewGetUserParams("userapikey")
+
+		expected := url.Values{
+			"userapikey": {"userapikey"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.GetUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c7fa9e18-b7eb-4534-bb82-c9626b72d8bf" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("GetUserKeys", func(t *testing.T) {
+		defer server.checkCommands(t, "getUserKeys")
+
+		p := client.User.NewGetUserKeysParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.GetUserKeys(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("GetVirtualMachineUserData", func(t *testing.T) {
+		defer server.checkCommands(t, "getVirtualMachineUserData")
+
+		p := client.User.NewGetVirtualMachineUserDataParams("virtualmachineid")
+
+		expected := url.Values{
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.GetVirtualMachineUserData(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListUsers", func(t *testing.T) {
+		defer server.checkCommands(t, "listUsers")
+
+		p := client.User.NewListUsersParams()
+		p.SetAccount("account")
+		p.SetAccounttype(1)
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowicon(true)
+		p.SetState("state")
+		p.SetUsername("username")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"accounttype": {"1"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"showicon":    {"true"},
+			"state":       {"state"},
+			"username":    {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.ListUsers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Users) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Users))
+		}
+	})
+
+	t.Run("LockUser", func(t *testing.T) {
+		defer server.checkCommands(t, "lockUser")
+
+		p := client.User.NewLockUserParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.LockUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f0aab77c-0bc6-178a-43a9-7498fdebd20d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RegisterUserKeys", func(t *testing.T) {
+		defer server.checkCommands(t, "registerUserKeys")
+
+		p := client.User.NewRegisterUserKeysParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.RegisterUserKeys(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("UpdateUser", func(t *testing.T) {
+		defer server.checkCommands(t, "updateUser")
+
+		p := client.User.NewUpdateUserParams("id")
+		p.SetCurrentpassword("currentpassword")
+		p.SetEmail("email")
+		p.SetFirstname("firstname")
+		p.SetLastname("lastname")
+		p.SetMandate2fa(true)
+		p.SetPassword("password")
+		p.SetTimezone("timezone")
+		p.SetUserapikey("userapikey")
+		p.SetUsername("username")
+		p.SetUsersecretkey("usersecretkey")
+
+		expected := url.Values{
+			"currentpassword": {"currentpassword"},
+			"email":           {"email"},
+			"firstname":       {"firstname"},
+			"id":              {"id"},
+			"lastname":        {"lastname"},
+			"mandate2fa":      {"true"},
+			"password":        {"password"},
+			"timezone":        {"timezone"},
+			"userapikey":      {"userapikey"},
+			"username":        {"username"},
+			"usersecretkey":   {"usersecretkey"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.User.UpdateUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "4d7a4f24-bb5e-be82-0fae-c73c7c5a9858" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -141,3 +143,301 @@ func TestVLANService(t *testing.T) {
 	t.Run("UpdateVlanIpRange", testupdateVlanIpRange)
 
 }
+
+func TestVLANServiceFixtures(t *testing.T) {
+	response, err := readData("generated/VLANService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateVlanIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "createVlanIpRange")
+
+		p := client.VLAN.NewCreateVlanIpRangeParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetEndip("endip")
+		p.SetEndipv6("endipv6")
+		p.SetForsystemvms(true)
+		p.SetForvirtualnetwork(true)
+		p.SetGateway("gateway")
+		p.SetIp6cidr("ip6cidr")
+		p.SetIp6gateway("ip6gateway")
+		p.SetNetmask("netmask")
+		p.SetNetworkid("networkid")
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetStartip("startip")
+		p.SetStartipv6("startipv6")
+		p.SetVlan("vlan")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"domainid":          {"domainid"},
+			"endip":             {"endip"},
+			"endipv6":           {"endipv6"},
+			"forsystemvms":      {"true"},
+			"forvirtualnetwork": {"true"},
+			"gateway":           {"gateway"},
+			"ip6cidr":           {"ip6cidr"},
+			"ip6gateway":        {"ip6gateway"},
+			"netmask":           {"netmask"},
+			"networkid":         {"networkid"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"podid":             {"podid"},
+			"projectid":         {"projectid"},
+			"startip":           {"startip"},
+			"startipv6":         {"startipv6"},
+			"vlan":              {"vlan"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.CreateVlanIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "bf94a74e-efbe-7eb2-585f-1c21fc61279f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DedicateGuestVlanRange", func(t *testing.T) {
+		defer server.checkCommands(t, "dedicateGuestVlanRange")
+
+		p := client.VLAN.NewDedicateGuestVlanRangeParams("physicalnetworkid", "vlanrange")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"domainid":          {"domainid"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"projectid":         {"projectid"},
+			"vlanrange":         {"vlanrange"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.DedicateGuestVlanRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d9e81c86-bfb9-1bba-09c1-e5a89a799e8b" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteVlanIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVlanIpRange")
+
+		p := client.VLAN.NewDeleteVlanIpRangeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.DeleteVlanIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListDedicatedGuestVlanRanges", func(t *testing.T) {
+		defer server.checkCommands(t, "listDedicatedGuestVlanRanges")
+
+		p := client.VLAN.NewListDedicatedGuestVlanRangesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetGuestvlanrange("guestvlanrange")
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetProjectid("projectid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"domainid":          {"domainid"},
+			"guestvlanrange":    {"guestvlanrange"},
+			"id":                {"id"},
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"projectid":         {"projectid"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.ListDedicatedGuestVlanRanges(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DedicatedGuestVlanRanges) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DedicatedGuestVlanRanges))
+		}
+	})
+
+	t.Run("ListVlanIpRanges", func(t *testing.T) {
+		defer server.checkCommands(t, "listVlanIpRanges")
+
+		p := client.VLAN.NewListVlanIpRangesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetForvirtualnetwork(true)
+		p.SetId("id")
+		p.SetKeyword("keyword")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetVlan("vlan")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"domainid":          {"domainid"},
+			"forvirtualnetwork": {"true"},
+			"id":                {"id"},
+			"keyword":           {"keyword"},
+			"networkid":         {"networkid"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"podid":             {"podid"},
+			"projectid":         {"projectid"},
+			"vlan":              {"vlan"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.ListVlanIpRanges(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VlanIpRanges) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VlanIpRanges))
+		}
+	})
+
+	t.Run("ReleaseDedicatedGuestVlanRange", func(t *testing.T) {
+		defer server.checkCommands(t, "releaseDedicatedGuestVlanRange", "queryAsyncJobResult")
+
+		p := client.VLAN.NewReleaseDedicatedGuestVlanRangeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.ReleaseDedicatedGuestVlanRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListGuestVlans", func(t *testing.T) {
+		defer server.checkCommands(t, "listGuestVlans")
+
+		p := client.VLAN.NewListGuestVlansParams()
+		p.SetAllocatedonly(true)
+		p.SetId(2)
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetVnet("vnet")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"allocatedonly":     {"true"},
+			"id":                {"2"},
+			"keyword":           {"keyword"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"physicalnetworkid": {"physicalnetworkid"},
+			"vnet":              {"vnet"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.ListGuestVlans(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.GuestVlans) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.GuestVlans))
+		}
+	})
+
+	t.Run("UpdateVlanIpRange", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVlanIpRange")
+
+		p := client.VLAN.NewUpdateVlanIpRangeParams("id")
+		p.SetEndip("endip")
+		p.SetEndipv6("endipv6")
+		p.SetForsystemvms(true)
+		p.SetGateway("gateway")
+		p.SetIp6cidr("ip6cidr")
+		p.SetIp6gateway("ip6gateway")
+		p.SetNetmask("netmask")
+		p.SetStartip("startip")
+		p.SetStartipv6("startipv6")
+
+		expected := url.Values{
+			"endip":        {"endip"},
+			"endipv6":      {"endipv6"},
+			"forsystemvms": {"true"},
+			"gateway":      {"gateway"},
+			"id":           {"id"},
+			"ip6cidr":      {"ip6cidr"},
+			"ip6gateway":   {"ip6gateway"},
+			"netmask":      {"netmask"},
+			"startip":      {"startip"},
+			"startipv6":    {"startipv6"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VLAN.UpdateVlanIpRange(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "62eebcbb-7f88-6136-0113-bdf66bf52a64" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
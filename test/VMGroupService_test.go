@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -90,3 +92,128 @@ func TestVMGroupService(t *testing.T) {
 	t.Run("UpdateInstanceGroup", testupdateInstanceGroup)
 
 }
+
+func TestVMGroupServiceFixtures(t *testing.T) {
+	response, err := readData("generated/VMGroupService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateInstanceGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "createInstanceGroup")
+
+		p := client.VMGroup.NewCreateInstanceGroupParams("name")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"name":      {"name"},
+			"projectid": {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VMGroup.CreateInstanceGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "ae074cc5-5cf4-7cfa-0928-91ef55aeac24" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteInstanceGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteInstanceGroup")
+
+		p := client.VMGroup.NewDeleteInstanceGroupParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VMGroup.DeleteInstanceGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListInstanceGroups", func(t *testing.T) {
+		defer server.checkCommands(t, "listInstanceGroups")
+
+		p := client.VMGroup.NewListInstanceGroupsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"name":        {"name"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VMGroup.ListInstanceGroups(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.InstanceGroups) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.InstanceGroups))
+		}
+		if r.InstanceGroups[0].Id != "b9746b1c-269b-d8c7-555f-96ee027b2c0b" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.InstanceGroups[0].Id)
+		}
+	})
+
+	t.Run("UpdateInstanceGroup", func(t *testing.T) {
+		defer server.checkCommands(t, "updateInstanceGroup")
+
+		p := client.VMGroup.NewUpdateInstanceGroupParams("id")
+		p.SetName("name")
+
+		expected := url.Values{
+			"id":   {"id"},
+			"name": {"name"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VMGroup.UpdateInstanceGroup(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "97b669d0-e652-4f86-3d45-82b5fde8d6d7" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -234,3 +236,549 @@ func TestVPCService(t *testing.T) {
 	t.Run("UpdateVPCOffering", testupdateVPCOffering)
 
 }
+
+func TestVPCServiceFixtures(t *testing.T) {
+	response, err := readData("generated/VPCService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreatePrivateGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "createPrivateGateway", "queryAsyncJobResult")
+
+		p := client.VPC.NewCreatePrivateGatewayParams("gateway", "ipaddress", "netmask", "vpcid")
+		p.SetAclid("aclid")
+		p.SetAssociatednetworkid("associatednetworkid")
+		p.SetBypassvlanoverlapcheck(true)
+		p.SetNetworkofferingid("networkofferingid")
+		p.SetPhysicalnetworkid("physicalnetworkid")
+		p.SetSourcenatsupported(true)
+		p.SetVlan("vlan")
+
+		expected := url.Values{
+			"aclid":                  {"aclid"},
+			"associatednetworkid":    {"associatednetworkid"},
+			"bypassvlanoverlapcheck": {"true"},
+			"gateway":                {"gateway"},
+			"ipaddress":              {"ipaddress"},
+			"netmask":                {"netmask"},
+			"networkofferingid":      {"networkofferingid"},
+			"physicalnetworkid":      {"physicalnetworkid"},
+			"sourcenatsupported":     {"true"},
+			"vlan":                   {"vlan"},
+			"vpcid":                  {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.CreatePrivateGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "abf5b29f-5f10-4c38-bc24-da933a7956f8" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateStaticRoute", func(t *testing.T) {
+		defer server.checkCommands(t, "createStaticRoute", "queryAsyncJobResult")
+
+		p := client.VPC.NewCreateStaticRouteParams("cidr", "gatewayid")
+
+		expected := url.Values{
+			"cidr":      {"cidr"},
+			"gatewayid": {"gatewayid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.CreateStaticRoute(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "019efca1-d0d0-ab2b-c0d3-e06c70494366" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVPC", func(t *testing.T) {
+		defer server.checkCommands(t, "createVPC", "queryAsyncJobResult")
+
+		p := client.VPC.NewCreateVPCParams("cidr", "displaytext", "name", "vpcofferingid", "zoneid")
+		p.SetAccount("account")
+		p.SetDns1("dns1")
+		p.SetDns2("dns2")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetIp6dns1("ip6dns1")
+		p.SetIp6dns2("ip6dns2")
+		p.SetNetworkdomain("networkdomain")
+		p.SetProjectid("projectid")
+		p.SetPublicmtu(1)
+		p.SetSourcenatipaddress("sourcenatipaddress")
+		p.SetStart(true)
+
+		expected := url.Values{
+			"account":            {"account"},
+			"cidr":               {"cidr"},
+			"displaytext":        {"displaytext"},
+			"dns1":               {"dns1"},
+			"dns2":               {"dns2"},
+			"domainid":           {"domainid"},
+			"fordisplay":         {"true"},
+			"ip6dns1":            {"ip6dns1"},
+			"ip6dns2":            {"ip6dns2"},
+			"name":               {"name"},
+			"networkdomain":      {"networkdomain"},
+			"projectid":          {"projectid"},
+			"publicmtu":          {"1"},
+			"sourcenatipaddress": {"sourcenatipaddress"},
+			"start":              {"true"},
+			"vpcofferingid":      {"vpcofferingid"},
+			"zoneid":             {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.CreateVPC(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9a119571-630c-7e53-75b8-8950db5bd9b5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVPCOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "createVPCOffering", "queryAsyncJobResult")
+
+		p := client.VPC.NewCreateVPCOfferingParams("displaytext", "name", []string{"supportedservices1", "supportedservices2"})
+		p.SetDomainid([]string{"domainid1", "domainid2"})
+		p.SetEnable(true)
+		p.SetInternetprotocol("internetprotocol")
+		p.SetServicecapabilitylist(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetServiceofferingid("serviceofferingid")
+		p.SetServiceproviderlist(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetZoneid([]string{"zoneid1", "zoneid2"})
+
+		expected := url.Values{
+			"displaytext":                     {"displaytext"},
+			"domainid":                        {"domainid1,domainid2"},
+			"enable":                          {"true"},
+			"internetprotocol":                {"internetprotocol"},
+			"name":                            {"name"},
+			"servicecapabilitylist[0].key":    {"key1"},
+			"servicecapabilitylist[0].value":  {"value1"},
+			"servicecapabilitylist[1].key":    {"key2"},
+			"servicecapabilitylist[1].value":  {"value2"},
+			"serviceofferingid":               {"serviceofferingid"},
+			"serviceproviderlist[0].provider": {"value1"},
+			"serviceproviderlist[0].service":  {"key1"},
+			"serviceproviderlist[1].provider": {"value2"},
+			"serviceproviderlist[1].service":  {"key2"},
+			"supportedservices":               {"supportedservices1,supportedservices2"},
+			"zoneid":                          {"zoneid1,zoneid2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.CreateVPCOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7f73020a-f455-1f06-644d-4cdd6d92b1ca" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeletePrivateGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "deletePrivateGateway", "queryAsyncJobResult")
+
+		p := client.VPC.NewDeletePrivateGatewayParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.DeletePrivateGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteStaticRoute", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteStaticRoute", "queryAsyncJobResult")
+
+		p := client.VPC.NewDeleteStaticRouteParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.DeleteStaticRoute(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteVPC", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVPC", "queryAsyncJobResult")
+
+		p := client.VPC.NewDeleteVPCParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.DeleteVPC(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteVPCOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVPCOffering", "queryAsyncJobResult")
+
+		p := client.VPC.NewDeleteVPCOfferingParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.DeleteVPCOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListPrivateGateways", func(t *testing.T) {
+		defer server.checkCommands(t, "listPrivateGateways")
+
+		p := client.VPC.NewListPrivateGatewaysParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIpaddress("ipaddress")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetState("state")
+		p.SetVlan("vlan")
+		p.SetVpcid("vpcid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"ipaddress":   {"ipaddress"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"state":       {"state"},
+			"vlan":        {"vlan"},
+			"vpcid":       {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.ListPrivateGateways(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.PrivateGateways) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.PrivateGateways))
+		}
+	})
+
+	t.Run("ListStaticRoutes", func(t *testing.T) {
+		defer server.checkCommands(t, "listStaticRoutes")
+
+		p := client.VPC.NewListStaticRoutesParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetGatewayid("gatewayid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetState("state")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetVpcid("vpcid")
+
+		expected := url.Values{
+			"account":       {"account"},
+			"domainid":      {"domainid"},
+			"gatewayid":     {"gatewayid"},
+			"id":            {"id"},
+			"isrecursive":   {"true"},
+			"keyword":       {"keyword"},
+			"listall":       {"true"},
+			"page":          {"1"},
+			"pagesize":      {"1"},
+			"projectid":     {"projectid"},
+			"state":         {"state"},
+			"tags[0].key":   {"key1"},
+			"tags[0].value": {"value1"},
+			"tags[1].key":   {"key2"},
+			"tags[1].value": {"value2"},
+			"vpcid":         {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.ListStaticRoutes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.StaticRoutes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.StaticRoutes))
+		}
+	})
+
+	t.Run("ListVPCOfferings", func(t *testing.T) {
+		defer server.checkCommands(t, "listVPCOfferings")
+
+		p := client.VPC.NewListVPCOfferingsParams()
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsdefault(true)
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetState("state")
+		p.SetSupportedservices([]string{"supportedservices1", "supportedservices2"})
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"displaytext":       {"displaytext"},
+			"domainid":          {"domainid"},
+			"id":                {"id"},
+			"isdefault":         {"true"},
+			"keyword":           {"keyword"},
+			"name":              {"name"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"state":             {"state"},
+			"supportedservices": {"supportedservices1,supportedservices2"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.ListVPCOfferings(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VPCOfferings) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VPCOfferings))
+		}
+		if r.VPCOfferings[0].Id != "0c78e43f-85ea-c9ef-98df-ae6e5f7ec3b9" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VPCOfferings[0].Id)
+		}
+	})
+
+	t.Run("ListVPCs", func(t *testing.T) {
+		defer server.checkCommands(t, "listVPCs")
+
+		p := client.VPC.NewListVPCsParams()
+		p.SetAccount("account")
+		p.SetCidr("cidr")
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetRestartrequired(true)
+		p.SetShowicon(true)
+		p.SetState("state")
+		p.SetSupportedservices([]string{"supportedservices1", "supportedservices2"})
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetVpcofferingid("vpcofferingid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":           {"account"},
+			"cidr":              {"cidr"},
+			"displaytext":       {"displaytext"},
+			"domainid":          {"domainid"},
+			"fordisplay":        {"true"},
+			"id":                {"id"},
+			"isrecursive":       {"true"},
+			"keyword":           {"keyword"},
+			"listall":           {"true"},
+			"name":              {"name"},
+			"page":              {"1"},
+			"pagesize":          {"1"},
+			"projectid":         {"projectid"},
+			"restartrequired":   {"true"},
+			"showicon":          {"true"},
+			"state":             {"state"},
+			"supportedservices": {"supportedservices1,supportedservices2"},
+			"tags[0].key":       {"key1"},
+			"tags[0].value":     {"value1"},
+			"tags[1].key":       {"key2"},
+			"tags[1].value":     {"value2"},
+			"vpcofferingid":     {"vpcofferingid"},
+			"zoneid":            {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.ListVPCs(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VPCs) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VPCs))
+		}
+		if r.VPCs[0].Id != "b9f64d9f-de66-c9b7-a457-c53fe25958ca" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VPCs[0].Id)
+		}
+	})
+
+	t.Run("RestartVPC", func(t *testing.T) {
+		defer server.checkCommands(t, "restartVPC", "queryAsyncJobResult")
+
+		p := client.VPC.NewRestartVPCParams("id")
+		p.SetCleanup(true)
+		p.SetLivepatch(true)
+		p.SetMakeredundant(true)
+
+		expected := url.Values{
+			"cleanup":       {"true"},
+			"id":            {"id"},
+			"livepatch":     {"true"},
+			"makeredundant": {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.RestartVPC(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateVPC", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVPC", "queryAsyncJobResult")
+
+		p := client.VPC.NewUpdateVPCParams("id")
+		p.SetCustomid("customid")
+		p.SetDisplaytext("displaytext")
+		p.SetFordisplay(true)
+		p.SetName("name")
+		p.SetPublicmtu(1)
+		p.SetSourcenatipaddress("sourcenatipaddress")
+
+		expected := url.Values{
+			"customid":           {"customid"},
+			"displaytext":        {"displaytext"},
+			"fordisplay":         {"true"},
+			"id":                 {"id"},
+			"name":               {"name"},
+			"publicmtu":          {"1"},
+			"sourcenatipaddress": {"sourcenatipaddress"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.UpdateVPC(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "27e05e36-b7a6-23cb-d2ca-fc1b7a6affb5" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateVPCOffering", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVPCOffering", "queryAsyncJobResult")
+
+		p := client.VPC.NewUpdateVPCOfferingParams("id")
+		p.SetDisplaytext("displaytext")
+		p.SetDomainid("domainid")
+		p.SetName("name")
+		p.SetSortkey(1)
+		p.SetState("state")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"displaytext": {"displaytext"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"name":        {"name"},
+			"sortkey":     {"1"},
+			"state":       {"state"},
+			"zoneid":      {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPC.UpdateVPCOffering(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d5d06922-b21b-fe32-b001-5dbf6d2312b2" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -306,3 +308,631 @@ func TestVPNService(t *testing.T) {
 	t.Run("UpdateVpnGateway", testupdateVpnGateway)
 
 }
+
+func TestVPNServiceFixtures(t *testing.T) {
+	response, err := readData("generated/VPNService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddVpnUser", func(t *testing.T) {
+		defer server.checkCommands(t, "addVpnUser", "queryAsyncJobResult")
+
+		p := client.VPN.NewAddVpnUserParams("password", "username")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"password":  {"password"},
+			"projectid": {"projectid"},
+			"username":  {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.AddVpnUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "8eb2d613-82db-0af0-5889-52b3568b8d4c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateRemoteAccessVpn", func(t *testing.T) {
+		defer server.checkCommands(t, "createRemoteAccessVpn", "queryAsyncJobResult")
+
+		p := client.VPN.NewCreateRemoteAccessVpnParams("publicipid")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetIprange("iprange")
+		p.SetOpenfirewall(true)
+
+		expected := url.Values{
+			"account":      {"account"},
+			"domainid":     {"domainid"},
+			"fordisplay":   {"true"},
+			"iprange":      {"iprange"},
+			"openfirewall": {"true"},
+			"publicipid":   {"publicipid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.CreateRemoteAccessVpn(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "4585d041-680d-29ce-7c87-e431c8ffa6ec" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVpnConnection", func(t *testing.T) {
+		defer server.checkCommands(t, "createVpnConnection", "queryAsyncJobResult")
+
+		p := client.VPN.NewCreateVpnConnectionParams("s2scustomergatewayid", "s2svpngatewayid")
+		p.SetFordisplay(true)
+		p.SetPassive(true)
+
+		expected := url.Values{
+			"fordisplay":           {"true"},
+			"passive":              {"true"},
+			"s2scustomergatewayid": {"s2scustomergatewayid"},
+			"s2svpngatewayid":      {"s2svpngatewayid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.CreateVpnConnection(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cb5ea694-b204-75e8-e152-4df4f293d555" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVpnCustomerGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "createVpnCustomerGateway", "queryAsyncJobResult")
+
+		p := client.VPN.NewCreateVpnCustomerGatewayParams("cidrlist", "esppolicy", "gateway", "ikepolicy", "ipsecpsk")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetDpd(true)
+		p.SetEsplifetime(2)
+		p.SetForceencap(true)
+		p.SetIkelifetime(2)
+		p.SetIkeversion("ikeversion")
+		p.SetName("name")
+		p.SetProjectid("projectid")
+		p.SetSplitconnections(true)
+
+		expected := url.Values{
+			"account":          {"account"},
+			"cidrlist":         {"cidrlist"},
+			"domainid":         {"domainid"},
+			"dpd":              {"true"},
+			"esplifetime":      {"2"},
+			"esppolicy":        {"esppolicy"},
+			"forceencap":       {"true"},
+			"gateway":          {"gateway"},
+			"ikelifetime":      {"2"},
+			"ikepolicy":        {"ikepolicy"},
+			"ikeversion":       {"ikeversion"},
+			"ipsecpsk":         {"ipsecpsk"},
+			"name":             {"name"},
+			"projectid":        {"projectid"},
+			"splitconnections": {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.CreateVpnCustomerGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "fb60a5aa-0f78-9235-cc04-adac9270e807" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVpnGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "createVpnGateway", "queryAsyncJobResult")
+
+		p := client.VPN.NewCreateVpnGatewayParams("vpcid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"fordisplay": {"true"},
+			"vpcid":      {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.CreateVpnGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "60de10d4-caf4-e3c9-7b2f-cc478f949ba7" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteRemoteAccessVpn", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteRemoteAccessVpn", "queryAsyncJobResult")
+
+		p := client.VPN.NewDeleteRemoteAccessVpnParams("publicipid")
+
+		expected := url.Values{
+			"publicipid": {"publicipid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.DeleteRemoteAccessVpn(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteVpnConnection", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVpnConnection", "queryAsyncJobResult")
+
+		p := client.VPN.NewDeleteVpnConnectionParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.DeleteVpnConnection(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteVpnCustomerGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVpnCustomerGateway", "queryAsyncJobResult")
+
+		p := client.VPN.NewDeleteVpnCustomerGatewayParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.DeleteVpnCustomerGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeleteVpnGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVpnGateway", "queryAsyncJobResult")
+
+		p := client.VPN.NewDeleteVpnGatewayParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.DeleteVpnGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListRemoteAccessVpns", func(t *testing.T) {
+		defer server.checkCommands(t, "listRemoteAccessVpns")
+
+		p := client.VPN.NewListRemoteAccessVpnsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetPublicipid("publicipid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"fordisplay":  {"true"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"networkid":   {"networkid"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"publicipid":  {"publicipid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.ListRemoteAccessVpns(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.RemoteAccessVpns) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.RemoteAccessVpns))
+		}
+	})
+
+	t.Run("ListVpnConnections", func(t *testing.T) {
+		defer server.checkCommands(t, "listVpnConnections")
+
+		p := client.VPN.NewListVpnConnectionsParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetVpcid("vpcid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"fordisplay":  {"true"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"vpcid":       {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.ListVpnConnections(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VpnConnections) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VpnConnections))
+		}
+	})
+
+	t.Run("ListVpnCustomerGateways", func(t *testing.T) {
+		defer server.checkCommands(t, "listVpnCustomerGateways")
+
+		p := client.VPN.NewListVpnCustomerGatewaysParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.ListVpnCustomerGateways(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VpnCustomerGateways) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VpnCustomerGateways))
+		}
+		if r.VpnCustomerGateways[0].Id != "5cdefb70-4d1a-df20-7340-53cb49ecaef4" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VpnCustomerGateways[0].Id)
+		}
+	})
+
+	t.Run("ListVpnGateways", func(t *testing.T) {
+		defer server.checkCommands(t, "listVpnGateways")
+
+		p := client.VPN.NewListVpnGatewaysParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetFordisplay(true)
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetVpcid("vpcid")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"fordisplay":  {"true"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"vpcid":       {"vpcid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.ListVpnGateways(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VpnGateways) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VpnGateways))
+		}
+	})
+
+	t.Run("ListVpnUsers", func(t *testing.T) {
+		defer server.checkCommands(t, "listVpnUsers")
+
+		p := client.VPN.NewListVpnUsersParams()
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetProjectid("projectid")
+		p.SetUsername("username")
+
+		expected := url.Values{
+			"account":     {"account"},
+			"domainid":    {"domainid"},
+			"id":          {"id"},
+			"isrecursive": {"true"},
+			"keyword":     {"keyword"},
+			"listall":     {"true"},
+			"page":        {"1"},
+			"pagesize":    {"1"},
+			"projectid":   {"projectid"},
+			"username":    {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.ListVpnUsers(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VpnUsers) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VpnUsers))
+		}
+	})
+
+	t.Run("RemoveVpnUser", func(t *testing.T) {
+		defer server.checkCommands(t, "removeVpnUser", "queryAsyncJobResult")
+
+		p := client.VPN.NewRemoveVpnUserParams("username")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":   {"account"},
+			"domainid":  {"domainid"},
+			"projectid": {"projectid"},
+			"username":  {"username"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.RemoveVpnUser(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ResetVpnConnection", func(t *testing.T) {
+		defer server.checkCommands(t, "resetVpnConnection", "queryAsyncJobResult")
+
+		p := client.VPN.NewResetVpnConnectionParams("id")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"id":       {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.ResetVpnConnection(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "437cf848-b47c-0f8f-b8a0-5286a14a48ca" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateRemoteAccessVpn", func(t *testing.T) {
+		defer server.checkCommands(t, "updateRemoteAccessVpn", "queryAsyncJobResult")
+
+		p := client.VPN.NewUpdateRemoteAccessVpnParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.UpdateRemoteAccessVpn(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f45b833f-1313-aef3-c9d5-6759049f5b61" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateVpnConnection", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVpnConnection", "queryAsyncJobResult")
+
+		p := client.VPN.NewUpdateVpnConnectionParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.UpdateVpnConnection(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "b4d99ac0-f705-b941-5d7b-24238f1d5528" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateVpnCustomerGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVpnCustomerGateway", "queryAsyncJobResult")
+
+		p := client.VPN.NewUpdateVpnCustomerGatewayParams("cidrlist", "esppolicy", "gateway", "id", "ikepolicy", "ipsecpsk")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetDpd(true)
+		p.SetEsplifetime(2)
+		p.SetForceencap(true)
+		p.SetIkelifetime(2)
+		p.SetIkeversion("ikeversion")
+		p.SetName("name")
+		p.SetSplitconnections(true)
+
+		expected := url.Values{
+			"account":          {"account"},
+			"cidrlist":         {"cidrlist"},
+			"domainid":         {"domainid"},
+			"dpd":              {"true"},
+			"esplifetime":      {"2"},
+			"esppolicy":        {"esppolicy"},
+			"forceencap":       {"true"},
+			"gateway":          {"gateway"},
+			"id":               {"id"},
+			"ikelifetime":      {"2"},
+			"ikepolicy":        {"ikepolicy"},
+			"ikeversion":       {"ikeversion"},
+			"ipsecpsk":         {"ipsecpsk"},
+			"name":             {"name"},
+			"splitconnections": {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.UpdateVpnCustomerGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "2bce19ab-7256-b6fd-0628-353b6b652bc2" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateVpnGateway", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVpnGateway", "queryAsyncJobResult")
+
+		p := client.VPN.NewUpdateVpnGatewayParams("id")
+		p.SetCustomid("customid")
+		p.SetFordisplay(true)
+
+		expected := url.Values{
+			"customid":   {"customid"},
+			"fordisplay": {"true"},
+			"id":         {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VPN.UpdateVpnGateway(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "36636cac-e044-b385-8687-84ad4f04c9b7" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
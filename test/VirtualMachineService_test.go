@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -260,6 +262,21 @@ func TestVirtualMachineService(t *testing.T) {
 	}
 	t.Run("ResetPasswordForVirtualMachine", testresetPasswordForVirtualMachine)
 
+	testresetUserDataForVirtualMachine := func(t *testing.T) {
+		if _, ok := response["resetUserDataForVirtualMachine"]; !ok {
+			t.Skipf("Skipping as no json response is provided in testdata")
+		}
+		p := client.VirtualMachine.NewResetUserDataForVirtualMachineParams("id")
+		r, err := client.VirtualMachine.ResetUserDataForVirtualMachine(p)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+		if r.Id == "" {
+			t.Errorf("Failed to parse response. ID not found")
+		}
+	}
+	t.Run("ResetUserDataForVirtualMachine", testresetUserDataForVirtualMachine)
+
 	testrestoreVirtualMachine := func(t *testing.T) {
 		if _, ok := response["restoreVirtualMachine"]; !ok {
 			t.Skipf("Skipping as no json response is provided in testdata")
@@ -360,3 +377,950 @@ func TestVirtualMachineService(t *testing.T) {
 	t.Run("ListVirtualMachinesUsageHistory", testlistVirtualMachinesUsageHistory)
 
 }
+
+func TestVirtualMachineServiceFixtures(t *testing.T) {
+	response, err := readData("generated/VirtualMachineService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AddNicToVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "addNicToVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewAddNicToVirtualMachineParams("networkid", "virtualmachineid")
+		p.SetDhcpoptions(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetIpaddress("ipaddress")
+		p.SetMacaddress("macaddress")
+
+		expected := url.Values{
+			"dhcpoptions[0].key":   {"key1"},
+			"dhcpoptions[0].value": {"value1"},
+			"dhcpoptions[1].key":   {"key2"},
+			"dhcpoptions[1].value": {"value2"},
+			"ipaddress":            {"ipaddress"},
+			"macaddress":           {"macaddress"},
+			"networkid":            {"networkid"},
+			"virtualmachineid":     {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.AddNicToVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "aaddf65a-63de-20e1-1283-83026687cc9c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("AssignVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "assignVirtualMachine")
+
+		p := client.VirtualMachine.NewAssignVirtualMachineParams("virtualmachineid")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetNetworkids([]string{"networkids1", "networkids2"})
+		p.SetProjectid("projectid")
+		p.SetSecuritygroupids([]string{"securitygroupids1", "securitygroupids2"})
+
+		expected := url.Values{
+			"account":          {"account"},
+			"domainid":         {"domainid"},
+			"networkids":       {"networkids1,networkids2"},
+			"projectid":        {"projectid"},
+			"securitygroupids": {"securitygroupids1,securitygroupids2"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.AssignVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "35b2a431-3955-4e4e-1046-6344602a0888" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ChangeServiceForVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "changeServiceForVirtualMachine")
+
+		p := client.VirtualMachine.NewChangeServiceForVirtualMachineParams("id", "serviceofferingid")
+		p.SetAutomigrate(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetMaxiops(2)
+		p.SetMiniops(2)
+		p.SetShrinkok(true)
+
+		expected := url.Values{
+			"automigrate":       {"true"},
+			"details[0].key1":   {"value1"},
+			"details[1].key2":   {"value2"},
+			"id":                {"id"},
+			"maxiops":           {"2"},
+			"miniops":           {"2"},
+			"serviceofferingid": {"serviceofferingid"},
+			"shrinkok":          {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ChangeServiceForVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "800c9dbc-2b7d-6639-9955-8752367a195c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CleanVMReservations", func(t *testing.T) {
+		defer server.checkCommands(t, "cleanVMReservations", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewCleanVMReservationsParams()
+
+		expected := url.Values{}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.CleanVMReservations(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DeployVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "deployVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewDeployVirtualMachineParams("serviceofferingid", "templateid", "zoneid")
+		p.SetAccount("account")
+		p.SetAffinitygroupids([]string{"affinitygroupids1", "affinitygroupids2"})
+		p.SetAffinitygroupnames([]string{"affinitygroupnames1", "affinitygroupnames2"})
+		p.SetBootintosetup(true)
+		p.SetBootmode("bootmode")
+		p.SetBoottype("boottype")
+		p.SetClusterid("clusterid")
+		p.SetCopyimagetags(true)
+		p.SetCustomid("customid")
+		p.SetDatadiskofferinglist(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDeploymentplanner("deploymentplanner")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDhcpoptionsnetworklist([]map[string]string{{"key1": "value1"}, {"key2": "value2"}})
+		p.SetDiskofferingid("diskofferingid")
+		p.SetDisplayname("displayname")
+		p.SetDisplayvm(true)
+		p.SetDomainid("domainid")
+		p.SetDynamicscalingenabled(true)
+		p.SetExtraconfig("extraconfig")
+		p.SetGroup("group")
+		p.SetHostid("hostid")
+		p.SetHypervisor("hypervisor")
+		p.SetIodriverpolicy("iodriverpolicy")
+		p.SetIothreadsenabled(true)
+		p.SetIp6address("ip6address")
+		p.SetIpaddress("ipaddress")
+		p.SetIptonetworklist([]map[string]string{{"key1": "value1"}, {"key2": "value2"}})
+		p.SetKeyboard("keyboard")
+		p.SetKeypair("keypair")
+		p.SetKeypairs([]string{"keypairs1", "keypairs2"})
+		p.SetMacaddress("macaddress")
+		p.SetName("name")
+		p.SetNetworkids([]string{"networkids1", "networkids2"})
+		p.SetNicmultiqueuenumber(1)
+		p.SetNicnetworklist([]map[string]string{{"key1": "value1"}, {"key2": "value2"}})
+		p.SetNicpackedvirtqueuesenabled(true)
+		p.SetOverridediskofferingid("overridediskofferingid")
+		p.SetPassword("password")
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetProperties(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetRootdisksize(2)
+		p.SetSecuritygroupids([]string{"securitygroupids1", "securitygroupids2"})
+		p.SetSecuritygroupnames([]string{"securitygroupnames1", "securitygroupnames2"})
+		p.SetSize(2)
+		p.SetStartvm(true)
+		p.SetUserdata("userdata")
+		p.SetUserdatadetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetUserdataid("userdataid")
+
+		expected := url.Values{
+			"account":                        {"account"},
+			"affinitygroupids":               {"affinitygroupids1,affinitygroupids2"},
+			"affinitygroupnames":             {"affinitygroupnames1,affinitygroupnames2"},
+			"bootintosetup":                  {"true"},
+			"bootmode":                       {"bootmode"},
+			"boottype":                       {"boottype"},
+			"clusterid":                      {"clusterid"},
+			"copyimagetags":                  {"true"},
+			"customid":                       {"customid"},
+			"datadiskofferinglist[0].key":    {"key1"},
+			"datadiskofferinglist[0].value":  {"value1"},
+			"datadiskofferinglist[1].key":    {"key2"},
+			"datadiskofferinglist[1].value":  {"value2"},
+			"deploymentplanner":              {"deploymentplanner"},
+			"details[0].key1":                {"value1"},
+			"details[1].key2":                {"value2"},
+			"dhcpoptionsnetworklist[0].key1": {"value1"},
+			"dhcpoptionsnetworklist[1].key2": {"value2"},
+			"diskofferingid":                 {"diskofferingid"},
+			"displayname":                    {"displayname"},
+			"displayvm":                      {"true"},
+			"domainid":                       {"domainid"},
+			"dynamicscalingenabled":          {"true"},
+			"extraconfig":                    {"extraconfig"},
+			"group":                          {"group"},
+			"hostid":                         {"hostid"},
+			"hypervisor":                     {"hypervisor"},
+			"iodriverpolicy":                 {"iodriverpolicy"},
+			"iothreadsenabled":               {"true"},
+			"ip6address":                     {"ip6address"},
+			"ipaddress":                      {"ipaddress"},
+			"iptonetworklist[0].key1":        {"value1"},
+			"iptonetworklist[1].key2":        {"value2"},
+			"keyboard":                       {"keyboard"},
+			"keypair":                        {"keypair"},
+			"keypairs":                       {"keypairs1,keypairs2"},
+			"macaddress":                     {"macaddress"},
+			"name":                           {"name"},
+			"networkids":                     {"networkids1,networkids2"},
+			"nicmultiqueuenumber":            {"1"},
+			"nicnetworklist[0].key1":         {"value1"},
+			"nicnetworklist[1].key2":         {"value2"},
+			"nicpackedvirtqueuesenabled":     {"true"},
+			"overridediskofferingid":         {"overridediskofferingid"},
+			"password":                       {"password"},
+			"podid":                          {"podid"},
+			"projectid":                      {"projectid"},
+			"properties[0].key":              {"key1"},
+			"properties[0].value":            {"value1"},
+			"properties[1].key":              {"key2"},
+			"properties[1].value":            {"value2"},
+			"rootdisksize":                   {"2"},
+			"securitygroupids":               {"securitygroupids1,securitygroupids2"},
+			"securitygroupnames":             {"securitygroupnames1,securitygroupnames2"},
+			"serviceofferingid":              {"serviceofferingid"},
+			"size":                           {"2"},
+			"startvm":                        {"true"},
+			"templateid":                     {"templateid"},
+			"userdata":                       {"userdata"},
+			"userdatadetails[0].key1":        {"value1"},
+			"userdatadetails[1].key2":        {"value2"},
+			"userdataid":                     {"userdataid"},
+			"zoneid":                         {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.DeployVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "cd57f898-5648-7bb8-7897-561082bc89fc" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DestroyVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "destroyVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewDestroyVirtualMachineParams("id")
+		p.SetExpunge(true)
+		p.SetVolumeids([]string{"volumeids1", "volumeids2"})
+
+		expected := url.Values{
+			"expunge":   {"true"},
+			"id":        {"id"},
+			"volumeids": {"volumeids1,volumeids2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.DestroyVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "fd0d34c9-94d3-5df2-e806-5cc9b9ac1665" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ExpungeVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "expungeVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewExpungeVirtualMachineParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ExpungeVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("GetVMPassword", func(t *testing.T) {
+		defer server.checkCommands(t, "getVMPassword")
+
+		p := client.VirtualMachine.NewGetVMPasswordParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.GetVMPassword(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListVirtualMachines", func(t *testing.T) {
+		defer server.checkCommands(t, "listVirtualMachines")
+
+		p := client.VirtualMachine.NewListVirtualMachinesParams()
+		p.SetAccount("account")
+		p.SetAccumulate(true)
+		p.SetAffinitygroupid("affinitygroupid")
+		p.SetAutoscalevmgroupid("autoscalevmgroupid")
+		p.SetBackupofferingid("backupofferingid")
+		p.SetClusterid("clusterid")
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetDisplayvm(true)
+		p.SetDomainid("domainid")
+		p.SetForvirtualnetwork(true)
+		p.SetGroupid("groupid")
+		p.SetHaenable(true)
+		p.SetHostid("hostid")
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetIsoid("isoid")
+		p.SetIsrecursive(true)
+		p.SetIsvnf(true)
+		p.SetKeypair("keypair")
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetRetrieveonlyresourcecount(true)
+		p.SetSecuritygroupid("securitygroupid")
+		p.SetServiceofferingid("serviceofferingid")
+		p.SetShowicon(true)
+		p.SetState("state")
+		p.SetStorageid("storageid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetTemplateid("templateid")
+		p.SetUserdata(true)
+		p.SetUserid("userid")
+		p.SetVpcid("vpcid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                   {"account"},
+			"accumulate":                {"true"},
+			"affinitygroupid":           {"affinitygroupid"},
+			"autoscalevmgroupid":        {"autoscalevmgroupid"},
+			"backupofferingid":          {"backupofferingid"},
+			"clusterid":                 {"clusterid"},
+			"details":                   {"details1,details2"},
+			"displayvm":                 {"true"},
+			"domainid":                  {"domainid"},
+			"forvirtualnetwork":         {"true"},
+			"groupid":                   {"groupid"},
+			"haenable":                  {"true"},
+			"hostid":                    {"hostid"},
+			"hypervisor":                {"hypervisor"},
+			"id":                        {"id"},
+			"ids":                       {"ids1,ids2"},
+			"isoid":                     {"isoid"},
+			"isrecursive":               {"true"},
+			"isvnf":                     {"true"},
+			"keypair":                   {"keypair"},
+			"keyword":                   {"keyword"},
+			"listall":                   {"true"},
+			"name":                      {"name"},
+			"networkid":                 {"networkid"},
+			"page":                      {"1"},
+			"pagesize":                  {"1"},
+			"podid":                     {"podid"},
+			"projectid":                 {"projectid"},
+			"retrieveonlyresourcecount": {"true"},
+			"securitygroupid":           {"securitygroupid"},
+			"serviceofferingid":         {"serviceofferingid"},
+			"showicon":                  {"true"},
+			"state":                     {"state"},
+			"storageid":                 {"storageid"},
+			"tags[0].key":               {"key1"},
+			"tags[0].value":             {"value1"},
+			"tags[1].key":               {"key2"},
+			"tags[1].value":             {"value2"},
+			"templateid":                {"templateid"},
+			"userdata":                  {"true"},
+			"userid":                    {"userid"},
+			"vpcid":                     {"vpcid"},
+			"zoneid":                    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ListVirtualMachines(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VirtualMachines) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VirtualMachines))
+		}
+		if r.VirtualMachines[0].Id != "7a647880-7549-ca71-c372-07b35f30c869" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VirtualMachines[0].Id)
+		}
+	})
+
+	t.Run("ListVirtualMachinesMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listVirtualMachinesMetrics")
+
+		p := client.VirtualMachine.NewListVirtualMachinesMetricsParams()
+		p.SetAccount("account")
+		p.SetAccumulate(true)
+		p.SetAffinitygroupid("affinitygroupid")
+		p.SetAutoscalevmgroupid("autoscalevmgroupid")
+		p.SetBackupofferingid("backupofferingid")
+		p.SetClusterid("clusterid")
+		p.SetDetails([]string{"details1", "details2"})
+		p.SetDisplayvm(true)
+		p.SetDomainid("domainid")
+		p.SetForvirtualnetwork(true)
+		p.SetGroupid("groupid")
+		p.SetHaenable(true)
+		p.SetHostid("hostid")
+		p.SetHypervisor("hypervisor")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetIsoid("isoid")
+		p.SetIsrecursive(true)
+		p.SetIsvnf(true)
+		p.SetKeypair("keypair")
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetName("name")
+		p.SetNetworkid("networkid")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetRetrieveonlyresourcecount(true)
+		p.SetSecuritygroupid("securitygroupid")
+		p.SetServiceofferingid("serviceofferingid")
+		p.SetShowicon(true)
+		p.SetState("state")
+		p.SetStorageid("storageid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetTemplateid("templateid")
+		p.SetUserdata(true)
+		p.SetUserid("userid")
+		p.SetVpcid("vpcid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                   {"account"},
+			"accumulate":                {"true"},
+			"affinitygroupid":           {"affinitygroupid"},
+			"autoscalevmgroupid":        {"autoscalevmgroupid"},
+			"backupofferingid":          {"backupofferingid"},
+			"clusterid":                 {"clusterid"},
+			"details":                   {"details1,details2"},
+			"displayvm":                 {"true"},
+			"domainid":                  {"domainid"},
+			"forvirtualnetwork":         {"true"},
+			"groupid":                   {"groupid"},
+			"haenable":                  {"true"},
+			"hostid":                    {"hostid"},
+			"hypervisor":                {"hypervisor"},
+			"id":                        {"id"},
+			"ids":                       {"ids1,ids2"},
+			"isoid":                     {"isoid"},
+			"isrecursive":               {"true"},
+			"isvnf":                     {"true"},
+			"keypair":                   {"keypair"},
+			"keyword":                   {"keyword"},
+			"listall":                   {"true"},
+			"name":                      {"name"},
+			"networkid":                 {"networkid"},
+			"page":                      {"1"},
+			"pagesize":                  {"1"},
+			"podid":                     {"podid"},
+			"projectid":                 {"projectid"},
+			"retrieveonlyresourcecount": {"true"},
+			"securitygroupid":           {"securitygroupid"},
+			"serviceofferingid":         {"serviceofferingid"},
+			"showicon":                  {"true"},
+			"state":                     {"state"},
+			"storageid":                 {"storageid"},
+			"tags[0].key":               {"key1"},
+			"tags[0].value":             {"value1"},
+			"tags[1].key":               {"key2"},
+			"tags[1].value":             {"value2"},
+			"templateid":                {"templateid"},
+			"userdata":                  {"true"},
+			"userid":                    {"userid"},
+			"vpcid":                     {"vpcid"},
+			"zoneid":                    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ListVirtualMachinesMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VirtualMachinesMetrics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VirtualMachinesMetrics))
+		}
+		if r.VirtualMachinesMetrics[0].Id != "c071c4c0-0ea5-94df-e4dc-378142e0255c" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VirtualMachinesMetrics[0].Id)
+		}
+	})
+
+	t.Run("MigrateVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "migrateVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewMigrateVirtualMachineParams("virtualmachineid")
+		p.SetAutoselect(true)
+		p.SetHostid("hostid")
+		p.SetStorageid("storageid")
+
+		expected := url.Values{
+			"autoselect":       {"true"},
+			"hostid":           {"hostid"},
+			"storageid":        {"storageid"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.MigrateVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9b0aa3a5-50c9-8865-268f-733af558e0f9" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("MigrateVirtualMachineWithVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "migrateVirtualMachineWithVolume", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewMigrateVirtualMachineWithVolumeParams("virtualmachineid")
+		p.SetAutoselect(true)
+		p.SetHostid("hostid")
+		p.SetMigrateto([]map[string]string{{"key1": "value1"}, {"key2": "value2"}})
+
+		expected := url.Values{
+			"autoselect":        {"true"},
+			"hostid":            {"hostid"},
+			"migrateto[0].key1": {"value1"},
+			"migrateto[1].key2": {"value2"},
+			"virtualmachineid":  {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.MigrateVirtualMachineWithVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "20b9a22b-73f2-202b-effa-ab4ffee73022" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RebootVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "rebootVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewRebootVirtualMachineParams("id")
+		p.SetBootintosetup(true)
+		p.SetForced(true)
+
+		expected := url.Values{
+			"bootintosetup": {"true"},
+			"forced":        {"true"},
+			"id":            {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.RebootVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "65fcf266-700c-080b-b12d-ffef869527e4" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RecoverVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "recoverVirtualMachine")
+
+		p := client.VirtualMachine.NewRecoverVirtualMachineParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.RecoverVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "7b1140f8-c09c-f766-c5cf-d9402f48dcc0" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RemoveNicFromVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "removeNicFromVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewRemoveNicFromVirtualMachineParams("nicid", "virtualmachineid")
+
+		expected := url.Values{
+			"nicid":            {"nicid"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.RemoveNicFromVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d3091373-c7fb-38ce-cc15-2c28041d9c7a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ResetPasswordForVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "resetPasswordForVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewResetPasswordForVirtualMachineParams("id")
+		p.SetPassword("password")
+
+		expected := url.Values{
+			"id":       {"id"},
+			"password": {"password"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ResetPasswordForVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d39df6a9-2d7e-9984-4ac3-d3aa0250a630" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ResetUserDataForVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "resetUserDataForVirtualMachine")
+
+		p := client.VirtualMachine.NewResetUserDataForVirtualMachineParams("id")
+		p.SetAccount("account")
+		p.SetDomainid("domainid")
+		p.SetProjectid("projectid")
+		p.SetUserdata("userdata")
+		p.SetUserdatadetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetUserdataid("userdataid")
+
+		expected := url.Values{
+			"account":                 {"account"},
+			"domainid":                {"domainid"},
+			"id":                      {"id"},
+			"projectid":               {"projectid"},
+			"userdata":                {"userdata"},
+			"userdatadetails[0].key1": {"value1"},
+			"userdatadetails[1].key2": {"value2"},
+			"userdataid":              {"userdataid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ResetUserDataForVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f747c729-ab37-d42b-254c-bd64d0349192" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RestoreVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "restoreVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewRestoreVirtualMachineParams("virtualmachineid")
+		p.SetTemplateid("templateid")
+
+		expected := url.Values{
+			"templateid":       {"templateid"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.RestoreVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "6e1c5bcf-a049-2b72-2148-3c9afca26009" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ScaleVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "scaleVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewScaleVirtualMachineParams("id", "serviceofferingid")
+		p.SetAutomigrate(true)
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetMaxiops(2)
+		p.SetMiniops(2)
+		p.SetShrinkok(true)
+
+		expected := url.Values{
+			"automigrate":       {"true"},
+			"details[0].key1":   {"value1"},
+			"details[1].key2":   {"value2"},
+			"id":                {"id"},
+			"maxiops":           {"2"},
+			"miniops":           {"2"},
+			"serviceofferingid": {"serviceofferingid"},
+			"shrinkok":          {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ScaleVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("StartVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "startVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewStartVirtualMachineParams("id")
+		p.SetBootintosetup(true)
+		p.SetClusterid("clusterid")
+		p.SetConsiderlasthost(true)
+		p.SetDeploymentplanner("deploymentplanner")
+		p.SetHostid("hostid")
+		p.SetPodid("podid")
+
+		expected := url.Values{
+			"bootintosetup":     {"true"},
+			"clusterid":         {"clusterid"},
+			"considerlasthost":  {"true"},
+			"deploymentplanner": {"deploymentplanner"},
+			"hostid":            {"hostid"},
+			"id":                {"id"},
+			"podid":             {"podid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.StartVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "33e94a6e-722f-5536-1524-25e26ef83781" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("StopVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "stopVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewStopVirtualMachineParams("id")
+		p.SetForced(true)
+
+		expected := url.Values{
+			"forced": {"true"},
+			"id":     {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.StopVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "af448e1a-fd84-babe-d13d-e6b33af23d8d" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateDefaultNicForVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "updateDefaultNicForVirtualMachine", "queryAsyncJobResult")
+
+		p := client.VirtualMachine.NewUpdateDefaultNicForVirtualMachineParams("nicid", "virtualmachineid")
+
+		expected := url.Values{
+			"nicid":            {"nicid"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.UpdateDefaultNicForVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "aa5f94c5-8001-9efb-299a-d98ac33c0a1a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateVirtualMachine", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVirtualMachine")
+
+		p := client.VirtualMachine.NewUpdateVirtualMachineParams("id")
+		p.SetCleanupdetails(true)
+		p.SetCustomid("customid")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDhcpoptionsnetworklist([]map[string]string{{"key1": "value1"}, {"key2": "value2"}})
+		p.SetDisplayname("displayname")
+		p.SetDisplayvm(true)
+		p.SetExtraconfig("extraconfig")
+		p.SetGroup("group")
+		p.SetHaenable(true)
+		p.SetInstancename("instancename")
+		p.SetIsdynamicallyscalable(true)
+		p.SetName("name")
+		p.SetOstypeid("ostypeid")
+		p.SetSecuritygroupids([]string{"securitygroupids1", "securitygroupids2"})
+		p.SetSecuritygroupnames([]string{"securitygroupnames1", "securitygroupnames2"})
+		p.SetUserdata("userdata")
+		p.SetUserdatadetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetUserdataid("userdataid")
+
+		expected := url.Values{
+			"cleanupdetails":                 {"true"},
+			"customid":                       {"customid"},
+			"details[0].key1":                {"value1"},
+			"details[1].key2":                {"value2"},
+			"dhcpoptionsnetworklist[0].key1": {"value1"},
+			"dhcpoptionsnetworklist[1].key2": {"value2"},
+			"displayname":                    {"displayname"},
+			"displayvm":                      {"true"},
+			"extraconfig":                    {"extraconfig"},
+			"group":                          {"group"},
+			"haenable":                       {"true"},
+			"id":                             {"id"},
+			"instancename":                   {"instancename"},
+			"isdynamicallyscalable":          {"true"},
+			"name":                           {"name"},
+			"ostypeid":                       {"ostypeid"},
+			"securitygroupids":               {"securitygroupids1,securitygroupids2"},
+			"securitygroupnames":             {"securitygroupnames1,securitygroupnames2"},
+			"userdata":                       {"userdata"},
+			"userdatadetails[0].key1":        {"value1"},
+			"userdatadetails[1].key2":        {"value2"},
+			"userdataid":                     {"userdataid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.UpdateVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "d2a22755-6450-77e7-8143-86dfff8e60aa" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ListVirtualMachinesUsageHistory", func(t *testing.T) {
+		defer server.checkCommands(t, "listVirtualMachinesUsageHistory")
+
+		p := client.VirtualMachine.NewListVirtualMachinesUsageHistoryParams()
+		p.SetEnddate("enddate")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetStartdate("startdate")
+
+		expected := url.Values{
+			"enddate":   {"enddate"},
+			"id":        {"id"},
+			"ids":       {"ids1,ids2"},
+			"keyword":   {"keyword"},
+			"name":      {"name"},
+			"page":      {"1"},
+			"pagesize":  {"1"},
+			"startdate": {"startdate"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.VirtualMachine.ListVirtualMachinesUsageHistory(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VirtualMachinesUsageHistory) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VirtualMachinesUsageHistory))
+		}
+		if r.VirtualMachinesUsageHistory[0].Id != "e2585889-718c-0f26-b148-04260df75966" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VirtualMachinesUsageHistory[0].Id)
+		}
+	})
+
+}
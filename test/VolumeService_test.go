@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -285,3 +287,601 @@ func TestVolumeService(t *testing.T) {
 	t.Run("ChangeOfferingForVolume", testchangeOfferingForVolume)
 
 }
+
+func TestVolumeServiceFixtures(t *testing.T) {
+	response, err := readData("generated/VolumeService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("AttachVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "attachVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewAttachVolumeParams("id", "virtualmachineid")
+		p.SetDeviceid(2)
+
+		expected := url.Values{
+			"deviceid":         {"2"},
+			"id":               {"id"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.AttachVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c5972e65-1873-6879-7fb2-233e7c06870f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("CreateVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "createVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewCreateVolumeParams()
+		p.SetAccount("account")
+		p.SetCustomid("customid")
+		p.SetDiskofferingid("diskofferingid")
+		p.SetDisplayvolume(true)
+		p.SetDomainid("domainid")
+		p.SetMaxiops(2)
+		p.SetMiniops(2)
+		p.SetName("name")
+		p.SetProjectid("projectid")
+		p.SetSize(2)
+		p.SetSnapshotid("snapshotid")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":          {"account"},
+			"customid":         {"customid"},
+			"diskofferingid":   {"diskofferingid"},
+			"displayvolume":    {"true"},
+			"domainid":         {"domainid"},
+			"maxiops":          {"2"},
+			"miniops":          {"2"},
+			"name":             {"name"},
+			"projectid":        {"projectid"},
+			"size":             {"2"},
+			"snapshotid":       {"snapshotid"},
+			"virtualmachineid": {"virtualmachineid"},
+			"zoneid":           {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.CreateVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f73a1961-d8e2-66bc-5101-dd6fc55d6399" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteVolume")
+
+		p := client.Volume.NewDeleteVolumeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.DeleteVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DestroyVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "destroyVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewDestroyVolumeParams("id")
+		p.SetExpunge(true)
+
+		expected := url.Values{
+			"expunge": {"true"},
+			"id":      {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.DestroyVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "169a84ae-35f7-f1d8-37fa-c8f41afe898e" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DetachVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "detachVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewDetachVolumeParams()
+		p.SetDeviceid(2)
+		p.SetId("id")
+		p.SetVirtualmachineid("virtualmachineid")
+
+		expected := url.Values{
+			"deviceid":         {"2"},
+			"id":               {"id"},
+			"virtualmachineid": {"virtualmachineid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.DetachVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "070696c0-aee3-54d9-efbb-7d30056bad40" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ExtractVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "extractVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewExtractVolumeParams("id", "mode", "zoneid")
+		p.SetUrl("url")
+
+		expected := url.Values{
+			"id":     {"id"},
+			"mode":   {"mode"},
+			"url":    {"url"},
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.ExtractVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f792be20-59f7-d6fc-15be-8cfd11c0ee2f" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("GetPathForVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "getPathForVolume")
+
+		p := client.Volume.NewGetPathForVolumeParams("volumeid")
+
+		expected := url.Values{
+			"volumeid": {"volumeid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.GetPathForVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("GetSolidFireVolumeSize", func(t *testing.T) {
+		defer server.checkCommands(t, "getSolidFireVolumeSize")
+
+		p := client.Volume.NewGetSolidFireVolumeSizeParams("volumeid")
+
+		expected := url.Values{
+			"volumeid": {"volumeid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.GetSolidFireVolumeSize(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("GetUploadParamsForVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "getUploadParamsForVolume")
+
+		p := client.Volume.NewGetUploadParamsForVolumeParams("format", "name", "zoneid")
+		p.SetAccount("account")
+		p.SetChecksum("checksum")
+		p.SetDiskofferingid("diskofferingid")
+		p.SetDomainid("domainid")
+		p.SetImagestoreuuid("imagestoreuuid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":        {"account"},
+			"checksum":       {"checksum"},
+			"diskofferingid": {"diskofferingid"},
+			"domainid":       {"domainid"},
+			"format":         {"format"},
+			"imagestoreuuid": {"imagestoreuuid"},
+			"name":           {"name"},
+			"projectid":      {"projectid"},
+			"zoneid":         {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.GetUploadParamsForVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "c16140ec-506e-f6aa-21cd-d677148e6b64" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("GetVolumeiScsiName", func(t *testing.T) {
+		defer server.checkCommands(t, "getVolumeiScsiName")
+
+		p := client.Volume.NewGetVolumeiScsiNameParams("volumeid")
+
+		expected := url.Values{
+			"volumeid": {"volumeid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.GetVolumeiScsiName(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("ListVolumes", func(t *testing.T) {
+		defer server.checkCommands(t, "listVolumes")
+
+		p := client.Volume.NewListVolumesParams()
+		p.SetAccount("account")
+		p.SetClusterid("clusterid")
+		p.SetDiskofferingid("diskofferingid")
+		p.SetDisplayvolume(true)
+		p.SetDomainid("domainid")
+		p.SetHostid("hostid")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetListsystemvms(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetRetrieveonlyresourcecount(true)
+		p.SetState("state")
+		p.SetStorageid("storageid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetType("type")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                   {"account"},
+			"clusterid":                 {"clusterid"},
+			"diskofferingid":            {"diskofferingid"},
+			"displayvolume":             {"true"},
+			"domainid":                  {"domainid"},
+			"hostid":                    {"hostid"},
+			"id":                        {"id"},
+			"ids":                       {"ids1,ids2"},
+			"isrecursive":               {"true"},
+			"keyword":                   {"keyword"},
+			"listall":                   {"true"},
+			"listsystemvms":             {"true"},
+			"name":                      {"name"},
+			"page":                      {"1"},
+			"pagesize":                  {"1"},
+			"podid":                     {"podid"},
+			"projectid":                 {"projectid"},
+			"retrieveonlyresourcecount": {"true"},
+			"state":                     {"state"},
+			"storageid":                 {"storageid"},
+			"tags[0].key":               {"key1"},
+			"tags[0].value":             {"value1"},
+			"tags[1].key":               {"key2"},
+			"tags[1].value":             {"value2"},
+			"type":                      {"type"},
+			"virtualmachineid":          {"virtualmachineid"},
+			"zoneid":                    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.ListVolumes(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Volumes) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Volumes))
+		}
+		if r.Volumes[0].Id != "559f6c19-b02d-43e1-293d-cf25de146cf0" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Volumes[0].Id)
+		}
+	})
+
+	t.Run("ListVolumesMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listVolumesMetrics")
+
+		p := client.Volume.NewListVolumesMetricsParams()
+		p.SetAccount("account")
+		p.SetClusterid("clusterid")
+		p.SetDiskofferingid("diskofferingid")
+		p.SetDisplayvolume(true)
+		p.SetDomainid("domainid")
+		p.SetHostid("hostid")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetIsrecursive(true)
+		p.SetKeyword("keyword")
+		p.SetListall(true)
+		p.SetListsystemvms(true)
+		p.SetName("name")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetPodid("podid")
+		p.SetProjectid("projectid")
+		p.SetRetrieveonlyresourcecount(true)
+		p.SetState("state")
+		p.SetStorageid("storageid")
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetType("type")
+		p.SetVirtualmachineid("virtualmachineid")
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":                   {"account"},
+			"clusterid":                 {"clusterid"},
+			"diskofferingid":            {"diskofferingid"},
+			"displayvolume":             {"true"},
+			"domainid":                  {"domainid"},
+			"hostid":                    {"hostid"},
+			"id":                        {"id"},
+			"ids":                       {"ids1,ids2"},
+			"isrecursive":               {"true"},
+			"keyword":                   {"keyword"},
+			"listall":                   {"true"},
+			"listsystemvms":             {"true"},
+			"name":                      {"name"},
+			"page":                      {"1"},
+			"pagesize":                  {"1"},
+			"podid":                     {"podid"},
+			"projectid":                 {"projectid"},
+			"retrieveonlyresourcecount": {"true"},
+			"state":                     {"state"},
+			"storageid":                 {"storageid"},
+			"tags[0].key":               {"key1"},
+			"tags[0].value":             {"value1"},
+			"tags[1].key":               {"key2"},
+			"tags[1].value":             {"value2"},
+			"type":                      {"type"},
+			"virtualmachineid":          {"virtualmachineid"},
+			"zoneid":                    {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.ListVolumesMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.VolumesMetrics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.VolumesMetrics))
+		}
+		if r.VolumesMetrics[0].Id != "7fa61542-63d4-4b72-2205-3604eec024f0" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.VolumesMetrics[0].Id)
+		}
+	})
+
+	t.Run("MigrateVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "migrateVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewMigrateVolumeParams("storageid", "volumeid")
+		p.SetLivemigrate(true)
+		p.SetNewdiskofferingid("newdiskofferingid")
+
+		expected := url.Values{
+			"livemigrate":       {"true"},
+			"newdiskofferingid": {"newdiskofferingid"},
+			"storageid":         {"storageid"},
+			"volumeid":          {"volumeid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.MigrateVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "22e87619-d81b-8cb4-e755-70622f4e7a85" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("RecoverVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "recoverVolume")
+
+		p := client.Volume.NewRecoverVolumeParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.RecoverVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "2b02d14d-2fec-0fdd-9b98-e56b24fa0a66" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ResizeVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "resizeVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewResizeVolumeParams("id")
+		p.SetDiskofferingid("diskofferingid")
+		p.SetMaxiops(2)
+		p.SetMiniops(2)
+		p.SetShrinkok(true)
+		p.SetSize(2)
+
+		expected := url.Values{
+			"diskofferingid": {"diskofferingid"},
+			"id":             {"id"},
+			"maxiops":        {"2"},
+			"miniops":        {"2"},
+			"shrinkok":       {"true"},
+			"size":           {"2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.ResizeVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "a132bb01-6731-0c60-9a7c-d295032daf85" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UpdateVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "updateVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewUpdateVolumeParams()
+		p.SetChaininfo("chaininfo")
+		p.SetCustomid("customid")
+		p.SetDisplayvolume(true)
+		p.SetId("id")
+		p.SetName("name")
+		p.SetPath("path")
+		p.SetState("state")
+		p.SetStorageid("storageid")
+
+		expected := url.Values{
+			"chaininfo":     {"chaininfo"},
+			"customid":      {"customid"},
+			"displayvolume": {"true"},
+			"id":            {"id"},
+			"name":          {"name"},
+			"path":          {"path"},
+			"state":         {"state"},
+			"storageid":     {"storageid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.UpdateVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "9ee8c67b-e831-8864-d88b-24054a9c0766" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("UploadVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "uploadVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewUploadVolumeParams("format", "name", "url", "zoneid")
+		p.SetAccount("account")
+		p.SetChecksum("checksum")
+		p.SetDiskofferingid("diskofferingid")
+		p.SetDomainid("domainid")
+		p.SetImagestoreuuid("imagestoreuuid")
+		p.SetProjectid("projectid")
+
+		expected := url.Values{
+			"account":        {"account"},
+			"checksum":       {"checksum"},
+			"diskofferingid": {"diskofferingid"},
+			"domainid":       {"domainid"},
+			"format":         {"format"},
+			"imagestoreuuid": {"imagestoreuuid"},
+			"name":           {"name"},
+			"projectid":      {"projectid"},
+			"url":            {"url"},
+			"zoneid":         {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.UploadVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "ea2591af-8b73-cf57-da10-5ac97ae5ec7c" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("ChangeOfferingForVolume", func(t *testing.T) {
+		defer server.checkCommands(t, "changeOfferingForVolume", "queryAsyncJobResult")
+
+		p := client.Volume.NewChangeOfferingForVolumeParams("diskofferingid", "id")
+		p.SetAutomigrate(true)
+		p.SetMaxiops(2)
+		p.SetMiniops(2)
+		p.SetShrinkok(true)
+		p.SetSize(2)
+
+		expected := url.Values{
+			"automigrate":    {"true"},
+			"diskofferingid": {"diskofferingid"},
+			"id":             {"id"},
+			"maxiops":        {"2"},
+			"miniops":        {"2"},
+			"shrinkok":       {"true"},
+			"size":           {"2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Volume.ChangeOfferingForVolume(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "df2fe904-df57-96da-3da5-9faf9ba9c7d9" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,6 +20,8 @@
 package test
 
 import (
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
@@ -189,3 +191,396 @@ func TestZoneService(t *testing.T) {
 	t.Run("UpdateZone", testupdateZone)
 
 }
+
+func TestZoneServiceFixtures(t *testing.T) {
+	response, err := readData("generated/ZoneService")
+	if err != nil {
+		t.Fatalf("Failed to read the generated fixtures: %v", err)
+	}
+	server := newFixtureServer(response)
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	defer server.Close()
+
+	t.Run("CreateZone", func(t *testing.T) {
+		defer server.checkCommands(t, "createZone")
+
+		p := client.Zone.NewCreateZoneParams("dns1", "internaldns1", "name", "networktype")
+		p.SetAllocationstate("allocationstate")
+		p.SetDns2("dns2")
+		p.SetDomain("domain")
+		p.SetDomainid("domainid")
+		p.SetGuestcidraddress("guestcidraddress")
+		p.SetInternaldns2("internaldns2")
+		p.SetIp6dns1("ip6dns1")
+		p.SetIp6dns2("ip6dns2")
+		p.SetIsedge(true)
+		p.SetLocalstorageenabled(true)
+		p.SetSecuritygroupenabled(true)
+
+		expected := url.Values{
+			"allocationstate":      {"allocationstate"},
+			"dns1":                 {"dns1"},
+			"dns2":                 {"dns2"},
+			"domain":               {"domain"},
+			"domainid":             {"domainid"},
+			"guestcidraddress":     {"guestcidraddress"},
+			"internaldns1":         {"internaldns1"},
+			"internaldns2":         {"internaldns2"},
+			"ip6dns1":              {"ip6dns1"},
+			"ip6dns2":              {"ip6dns2"},
+			"isedge":               {"true"},
+			"localstorageenabled":  {"true"},
+			"name":                 {"name"},
+			"networktype":          {"networktype"},
+			"securitygroupenabled": {"true"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.CreateZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "ff1d05a0-346e-b3b8-f939-07020b95b3e2" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DedicateZone", func(t *testing.T) {
+		defer server.checkCommands(t, "dedicateZone", "queryAsyncJobResult")
+
+		p := client.Zone.NewDedicateZoneParams("domainid", "zoneid")
+		p.SetAccount("account")
+
+		expected := url.Values{
+			"account":  {"account"},
+			"domainid": {"domainid"},
+			"zoneid":   {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.DedicateZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "42d8ec6e-1ef2-2c7b-ae77-8fcd43b7618a" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+	t.Run("DeleteZone", func(t *testing.T) {
+		defer server.checkCommands(t, "deleteZone")
+
+		p := client.Zone.NewDeleteZoneParams("id")
+
+		expected := url.Values{
+			"id": {"id"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.DeleteZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("DisableOutOfBandManagementForZone", func(t *testing.T) {
+		defer server.checkCommands(t, "disableOutOfBandManagementForZone", "queryAsyncJobResult")
+
+		p := client.Zone.NewDisableOutOfBandManagementForZoneParams("zoneid")
+
+		expected := url.Values{
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.DisableOutOfBandManagementForZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("EnableOutOfBandManagementForZone", func(t *testing.T) {
+		defer server.checkCommands(t, "enableOutOfBandManagementForZone", "queryAsyncJobResult")
+
+		p := client.Zone.NewEnableOutOfBandManagementForZoneParams("zoneid")
+
+		expected := url.Values{
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.EnableOutOfBandManagementForZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		_ = r
+	})
+
+	t.Run("DisableHAForZone", func(t *testing.T) {
+		defer server.checkCommands(t, "disableHAForZone", "queryAsyncJobResult")
+
+		p := client.Zone.NewDisableHAForZoneParams("zoneid")
+
+		expected := url.Values{
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.DisableHAForZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("EnableHAForZone", func(t *testing.T) {
+		defer server.checkCommands(t, "enableHAForZone", "queryAsyncJobResult")
+
+		p := client.Zone.NewEnableHAForZoneParams("zoneid")
+
+		expected := url.Values{
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.EnableHAForZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("ListDedicatedZones", func(t *testing.T) {
+		defer server.checkCommands(t, "listDedicatedZones")
+
+		p := client.Zone.NewListDedicatedZonesParams()
+		p.SetAccount("account")
+		p.SetAffinitygroupid("affinitygroupid")
+		p.SetDomainid("domainid")
+		p.SetKeyword("keyword")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetZoneid("zoneid")
+
+		expected := url.Values{
+			"account":         {"account"},
+			"affinitygroupid": {"affinitygroupid"},
+			"domainid":        {"domainid"},
+			"keyword":         {"keyword"},
+			"page":            {"1"},
+			"pagesize":        {"1"},
+			"zoneid":          {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.ListDedicatedZones(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.DedicatedZones) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.DedicatedZones))
+		}
+	})
+
+	t.Run("ListZones", func(t *testing.T) {
+		defer server.checkCommands(t, "listZones")
+
+		p := client.Zone.NewListZonesParams()
+		p.SetAvailable(true)
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetNetworktype("networktype")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowcapacities(true)
+		p.SetShowicon(true)
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"available":      {"true"},
+			"domainid":       {"domainid"},
+			"id":             {"id"},
+			"ids":            {"ids1,ids2"},
+			"keyword":        {"keyword"},
+			"name":           {"name"},
+			"networktype":    {"networktype"},
+			"page":           {"1"},
+			"pagesize":       {"1"},
+			"showcapacities": {"true"},
+			"showicon":       {"true"},
+			"tags[0].key":    {"key1"},
+			"tags[0].value":  {"value1"},
+			"tags[1].key":    {"key2"},
+			"tags[1].value":  {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.ListZones(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.Zones) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.Zones))
+		}
+		if r.Zones[0].Id != "42177872-f60d-3540-4811-bbce6e8cb25d" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.Zones[0].Id)
+		}
+	})
+
+	t.Run("ListZonesMetrics", func(t *testing.T) {
+		defer server.checkCommands(t, "listZonesMetrics")
+
+		p := client.Zone.NewListZonesMetricsParams()
+		p.SetAvailable(true)
+		p.SetDomainid("domainid")
+		p.SetId("id")
+		p.SetIds([]string{"ids1", "ids2"})
+		p.SetKeyword("keyword")
+		p.SetName("name")
+		p.SetNetworktype("networktype")
+		p.SetPage(1)
+		p.SetPagesize(1)
+		p.SetShowcapacities(true)
+		p.SetShowicon(true)
+		p.SetTags(map[string]string{"key1": "value1", "key2": "value2"})
+
+		expected := url.Values{
+			"available":      {"true"},
+			"domainid":       {"domainid"},
+			"id":             {"id"},
+			"ids":            {"ids1,ids2"},
+			"keyword":        {"keyword"},
+			"name":           {"name"},
+			"networktype":    {"networktype"},
+			"page":           {"1"},
+			"pagesize":       {"1"},
+			"showcapacities": {"true"},
+			"showicon":       {"true"},
+			"tags[0].key":    {"key1"},
+			"tags[0].value":  {"value1"},
+			"tags[1].key":    {"key2"},
+			"tags[1].value":  {"value2"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.ListZonesMetrics(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Count != 1 || len(r.ZonesMetrics) != 1 {
+			t.Fatalf("Expected a single listed object, got %d", len(r.ZonesMetrics))
+		}
+		if r.ZonesMetrics[0].Id != "8e977cae-8fed-e109-b42e-0993c246b3d9" {
+			t.Errorf("Failed to decode the ID of the listed object, got %q", r.ZonesMetrics[0].Id)
+		}
+	})
+
+	t.Run("ReleaseDedicatedZone", func(t *testing.T) {
+		defer server.checkCommands(t, "releaseDedicatedZone", "queryAsyncJobResult")
+
+		p := client.Zone.NewReleaseDedicatedZoneParams("zoneid")
+
+		expected := url.Values{
+			"zoneid": {"zoneid"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.ReleaseDedicatedZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if !r.Success {
+			t.Errorf("Failed to decode the success field")
+		}
+	})
+
+	t.Run("UpdateZone", func(t *testing.T) {
+		defer server.checkCommands(t, "updateZone")
+
+		p := client.Zone.NewUpdateZoneParams("id")
+		p.SetAllocationstate("allocationstate")
+		p.SetDetails(map[string]string{"key1": "value1", "key2": "value2"})
+		p.SetDhcpprovider("dhcpprovider")
+		p.SetDns1("dns1")
+		p.SetDns2("dns2")
+		p.SetDnssearchorder([]string{"dnssearchorder1", "dnssearchorder2"})
+		p.SetDomain("domain")
+		p.SetGuestcidraddress("guestcidraddress")
+		p.SetInternaldns1("internaldns1")
+		p.SetInternaldns2("internaldns2")
+		p.SetIp6dns1("ip6dns1")
+		p.SetIp6dns2("ip6dns2")
+		p.SetIspublic(true)
+		p.SetLocalstorageenabled(true)
+		p.SetName("name")
+		p.SetSortkey(1)
+
+		expected := url.Values{
+			"allocationstate":     {"allocationstate"},
+			"details[0].key":      {"key1"},
+			"details[0].value":    {"value1"},
+			"details[1].key":      {"key2"},
+			"details[1].value":    {"value2"},
+			"dhcpprovider":        {"dhcpprovider"},
+			"dns1":                {"dns1"},
+			"dns2":                {"dns2"},
+			"dnssearchorder":      {"dnssearchorder1,dnssearchorder2"},
+			"domain":              {"domain"},
+			"guestcidraddress":    {"guestcidraddress"},
+			"id":                  {"id"},
+			"internaldns1":        {"internaldns1"},
+			"internaldns2":        {"internaldns2"},
+			"ip6dns1":             {"ip6dns1"},
+			"ip6dns2":             {"ip6dns2"},
+			"ispublic":            {"true"},
+			"localstorageenabled": {"true"},
+			"name":                {"name"},
+			"sortkey":             {"1"},
+		}
+		if u := p.ToURLValues(); !reflect.DeepEqual(u, expected) {
+			t.Errorf("Expected the params to be encoded as %v, got %v", expected, u)
+		}
+
+		r, err := client.Zone.UpdateZone(p)
+		if err != nil {
+			t.Fatalf("Failed to decode the response: %v", err)
+		}
+		if r.Id != "f80e8c15-e479-6cf2-44c2-1e62a9070384" {
+			t.Errorf("Failed to decode the ID, got %q", r.Id)
+		}
+	})
+
+}
@@ -20,18 +20,24 @@
 package test
 
 import (
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ablecloud-team/ablestack-mold-go/v2/cloudstack"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -76,6 +82,66 @@ func CreateTestServer(t *testing.T, responses map[string]json.RawMessage) *httpt
 	}))
 }
 
+// fixtureServer serves the generated fixtures the same way a management server would. The
+// response of an async command only contains the job ID, and the job result is returned
+// when the job is queried. All received commands are recorded.
+type fixtureServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	commands []string
+	jobs     map[string]json.RawMessage
+}
+
+func newFixtureServer(responses map[string]json.RawMessage) *fixtureServer {
+	s := &fixtureServer{jobs: make(map[string]json.RawMessage)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		command := r.FormValue("command")
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.commands = append(s.commands, command)
+
+		if job, ok := s.jobs[r.FormValue("jobid")]; ok && command == "queryAsyncJobResult" {
+			fmt.Fprintf(w, `{"queryasyncjobresultresponse":%s}`, job)
+			return
+		}
+
+		response, ok := responses[command]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"errorresponse":{"errorcode":431,"errortext":"Unknown command %s"}}`, command)
+			return
+		}
+
+		var job struct {
+			JobID     string          `json:"jobid"`
+			Jobresult json.RawMessage `json:"jobresult"`
+		}
+		rawJob, _ := getRawValue(response)
+		if err := json.Unmarshal(rawJob, &job); err != nil || job.JobID == "" || job.Jobresult == nil {
+			fmt.Fprintln(w, string(response))
+			return
+		}
+
+		s.jobs[job.JobID] = rawJob
+		fmt.Fprintf(w, `{"%sresponse":{"jobid":%q}}`, strings.ToLower(command), job.JobID)
+	}))
+	return s
+}
+
+// checkCommands checks the server received exactly the given commands since the last check
+func (s *fixtureServer) checkCommands(t *testing.T, expected ...string) {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !reflect.DeepEqual(s.commands, expected) {
+		t.Errorf("Expected the commands %v, got %v", expected, s.commands)
+	}
+	s.commands = nil
+}
+
 func getRawValue(b json.RawMessage) (json.RawMessage, error) {
 	var m map[string]json.RawMessage
 	if err := json.Unmarshal(b, &m); err != nil {
@@ -166,3 +232,885 @@ func TestUUID(t *testing.T) {
 		t.Errorf("missing expected error when serializing bool into UUID")
 	}
 }
+
+func TestParamsCloneAndEqual(t *testing.T) {
+	client := cloudstack.NewClient(CS_API_URL, CS_API_KEY, CS_SECRET_KEY, true)
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams("serviceofferingid", "templateid", "zoneid")
+	p.SetDetails(map[string]string{"cpuNumber": "2"})
+	p.AddNicnetworklist(map[string]string{"nic": "nic-1", "network": "network-1"})
+	p.SetStartvm(false)
+
+	c := p.Clone()
+	if !p.Equal(c) {
+		t.Errorf("expected cloned params to be equal to the original params")
+	}
+
+	details, _ := c.GetDetails()
+	details["cpuNumber"] = "4"
+	c.AddNicnetworklist(map[string]string{"nic": "nic-2", "network": "network-2"})
+
+	if v, _ := p.GetDetails(); v["cpuNumber"] != "2" {
+		t.Errorf("changing the cloned details modified the original params")
+	}
+	if v, _ := p.GetNicnetworklist(); len(v) != 1 {
+		t.Errorf("changing the cloned nicnetworklist modified the original params")
+	}
+	if p.Equal(c) {
+		t.Errorf("expected modified clone to differ from the original params")
+	}
+
+	c = p.Clone()
+	c.ResetStartvm()
+	if p.Equal(c) {
+		t.Errorf("expected params with a reset value to differ from the original params")
+	}
+	if _, ok := c.GetStartvm(); ok {
+		t.Errorf("expected startvm to be unset after a reset")
+	}
+}
+
+func TestParamsSerialization(t *testing.T) {
+	client := cloudstack.NewClient(CS_API_URL, CS_API_KEY, CS_SECRET_KEY, true)
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams("serviceofferingid", "templateid", "zoneid")
+	p.SetDetails(map[string]string{"cpuNumber": "2"})
+	p.AddNicnetworklist(map[string]string{"nic": "nic-1", "network": "network-1"})
+	p.SetSize(20)
+	p.SetStartvm(false)
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("failed to marshal params to JSON: %v", err)
+	}
+	expected := `{"details":{"cpuNumber":"2"},"nicnetworklist":[{"network":"network-1","nic":"nic-1"}],` +
+		`"serviceofferingid":"serviceofferingid","size":20,"startvm":false,"templateid":"templateid","zoneid":"zoneid"}`
+	if string(b) != expected {
+		t.Errorf("unexpected JSON encoding:\n%s\nexpected:\n%s", b, expected)
+	}
+
+	pj := &cloudstack.DeployVirtualMachineParams{}
+	if err := json.Unmarshal(b, pj); err != nil {
+		t.Fatalf("failed to unmarshal params from JSON: %v", err)
+	}
+	if !p.Equal(pj) {
+		t.Errorf("params decoded from JSON differ from the original params")
+	}
+
+	y, err := yaml.Marshal(p)
+	if err != nil {
+		t.Fatalf("failed to marshal params to YAML: %v", err)
+	}
+	py := &cloudstack.DeployVirtualMachineParams{}
+	if err := yaml.Unmarshal(y, py); err != nil {
+		t.Fatalf("failed to unmarshal params from YAML: %v", err)
+	}
+	if !p.Equal(py) {
+		t.Errorf("params decoded from YAML differ from the original params:\n%s", y)
+	}
+}
+
+func TestParamsSerializationByValue(t *testing.T) {
+	client := cloudstack.NewClient(CS_API_URL, CS_API_KEY, CS_SECRET_KEY, true)
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams("serviceofferingid", "templateid", "zoneid")
+	p.SetStartvm(false)
+
+	// A job spec holding the params by value, as when persisting them in a job queue
+	type jobSpec struct {
+		Command string                                `json:"command" yaml:"command"`
+		Params  cloudstack.DeployVirtualMachineParams `json:"params" yaml:"params"`
+	}
+	spec := jobSpec{Command: "deployVirtualMachine", Params: *p}
+
+	b, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("failed to marshal the job spec to JSON: %v", err)
+	}
+	expected := `{"command":"deployVirtualMachine","params":{"serviceofferingid":"serviceofferingid",` +
+		`"startvm":false,"templateid":"templateid","zoneid":"zoneid"}}`
+	if string(b) != expected {
+		t.Errorf("unexpected JSON encoding:\n%s\nexpected:\n%s", b, expected)
+	}
+
+	var sj jobSpec
+	if err := json.Unmarshal(b, &sj); err != nil {
+		t.Fatalf("failed to unmarshal the job spec from JSON: %v", err)
+	}
+	if !p.Equal(&sj.Params) {
+		t.Errorf("params decoded from JSON differ from the original params")
+	}
+
+	y, err := yaml.Marshal(spec)
+	if err != nil {
+		t.Fatalf("failed to marshal the job spec to YAML: %v", err)
+	}
+	var sy jobSpec
+	if err := yaml.Unmarshal(y, &sy); err != nil {
+		t.Fatalf("failed to unmarshal the job spec from YAML: %v", err)
+	}
+	if !p.Equal(&sy.Params) {
+		t.Errorf("params decoded from YAML differ from the original params:\n%s", y)
+	}
+}
+
+func TestParamsParseURLValues(t *testing.T) {
+	client := cloudstack.NewClient(CS_API_URL, CS_API_KEY, CS_SECRET_KEY, true)
+
+	deploy := client.VirtualMachine.NewDeployVirtualMachineParams("serviceofferingid", "templateid", "zoneid")
+	deploy.SetDetails(map[string]string{"cpuNumber": "2", "memory": "2048"})
+	deploy.AddNicnetworklist(map[string]string{"nic": "nic-1", "network": "network-1"})
+	deploy.AddNicnetworklist(map[string]string{"nic": "nic-2", "network": "network-2"})
+	deploy.SetAffinitygroupids([]string{"group-1", "group-2"})
+	deploy.SetStartvm(false)
+
+	u := deploy.ToURLValues()
+	parsed, err := cloudstack.ParseDeployVirtualMachineParams(u)
+	if err != nil {
+		t.Fatalf("failed to parse deployVirtualMachine values: %v", err)
+	}
+	if !deploy.Equal(parsed) {
+		t.Errorf("parsed deployVirtualMachine params differ from the original params")
+	}
+	if cloudstack.EncodeValues(parsed.ToURLValues()) != cloudstack.EncodeValues(u) {
+		t.Errorf("encoding the parsed params gives %v, expected %v", parsed.ToURLValues(), u)
+	}
+
+	register := client.Template.NewRegisterTemplateParams("displaytext", "format", "hypervisor", "name", "url")
+	register.SetDetails(map[string]string{"keyboard": "us", "rootDiskController": "scsi"})
+
+	u = register.ToURLValues()
+	if u.Get("details[0].keyboard") != "us" || u.Get("details[0].rootDiskController") != "scsi" {
+		t.Errorf("expected zero indexed details, got %v", u)
+	}
+	parsedRegister, err := cloudstack.ParseRegisterTemplateParams(u)
+	if err != nil {
+		t.Fatalf("failed to parse registerTemplate values: %v", err)
+	}
+	if cloudstack.EncodeValues(parsedRegister.ToURLValues()) != cloudstack.EncodeValues(u) {
+		t.Errorf("encoding the parsed params gives %v, expected %v", parsedRegister.ToURLValues(), u)
+	}
+
+	tags := client.Resourcetags.NewCreateTagsParams([]string{"vm-1"}, "UserVm", map[string]string{"env": "prod", "team": "web"})
+	u = tags.ToURLValues()
+	u.Set("command", "createTags")
+	u.Set("apiKey", "APIKEY")
+	u.Set("signature", "SIGNATURE")
+
+	parsedTags, err := cloudstack.ParseCreateTagsParams(u)
+	if err != nil {
+		t.Fatalf("failed to parse createTags values: %v", err)
+	}
+	if !tags.Equal(parsedTags) {
+		t.Errorf("parsed createTags params differ from the original params")
+	}
+
+	u.Set("unknown", "value")
+	if _, err := cloudstack.ParseCreateTagsParams(u); err == nil {
+		t.Errorf("expected an error when parsing an unknown param")
+	}
+
+	if _, err := cloudstack.ParseListVirtualMachinesParams(url.Values{"page": {"one"}}); err == nil {
+		t.Errorf("expected an error when parsing an invalid integer value")
+	}
+}
+
+func TestParamsParseURLValuesErrors(t *testing.T) {
+	deploy := func(u url.Values) error {
+		_, err := cloudstack.ParseDeployVirtualMachineParams(u)
+		return err
+	}
+	register := func(u url.Values) error {
+		_, err := cloudstack.ParseRegisterTemplateParams(u)
+		return err
+	}
+	createTags := func(u url.Values) error {
+		_, err := cloudstack.ParseCreateTagsParams(u)
+		return err
+	}
+	deleteTags := func(u url.Values) error {
+		_, err := cloudstack.ParseDeleteTagsParams(u)
+		return err
+	}
+
+	for _, c := range []struct {
+		name  string
+		parse func(url.Values) error
+		query string
+	}{
+		{"unindexed map", deploy, "details=x"},
+		{"indexed string", deploy, "name[0].a=x"},
+		{"missing field", deploy, "details[0]=x"},
+		{"empty field", deploy, "details[0].=x"},
+		{"nested field", deploy, "details[0].a[1]=x"},
+		{"invalid index", deploy, "details[a].b=x"},
+		{"padded index", deploy, "details[00].b=x"},
+		{"negative index", deploy, "details[-1].b=x"},
+		{"gapped indexes", deploy, "details[0].a=1&details[2].b=2"},
+		{"duplicate keys", deploy, "details[0].a=1&details[1].a=2"},
+		{"duplicate grouped keys", deploy, "details[0].a=1&details[0].b=2&details[1].b=3"},
+		{"gapped list", deploy, "nicnetworklist[1].nic=n&nicnetworklist[1].network=n"},
+		{"non-zero index", register, "details[0].a=1&details[1].b=2"},
+		{"duplicate tag keys", createTags, "tags[0].key=a&tags[0].value=1&tags[1].key=a&tags[1].value=2"},
+		{"missing tag key", createTags, "tags[0].value=1"},
+		{"missing tag value", createTags, "tags[0].key=a"},
+		{"unknown tag field", createTags, "tags[0].key=a&tags[0].value=1&tags[0].other=2"},
+		{"empty omitted value", deleteTags, "tags[0].key=a&tags[0].value="},
+	} {
+		u, err := url.ParseQuery(c.query)
+		if err != nil {
+			t.Fatalf("%s: invalid query: %v", c.name, err)
+		}
+		if err := c.parse(u); err == nil {
+			t.Errorf("%s: expected an error when parsing %s", c.name, c.query)
+		}
+	}
+
+	// Every value that is accepted gives back the same params when encoded
+	for _, c := range []struct {
+		name  string
+		parse func(url.Values) (url.Values, error)
+		query string
+	}{
+		{"details", func(u url.Values) (url.Values, error) {
+			p, err := cloudstack.ParseDeployVirtualMachineParams(u)
+			if err != nil {
+				return nil, err
+			}
+			return p.ToURLValues(), nil
+		}, "details[0].a=1&details[1].b=2&nicnetworklist[0].nic=n&nicnetworklist[1].network=m"},
+		{"zero index", func(u url.Values) (url.Values, error) {
+			p, err := cloudstack.ParseRegisterTemplateParams(u)
+			if err != nil {
+				return nil, err
+			}
+			return p.ToURLValues(), nil
+		}, "details[0].a=1&details[0].b=2"},
+		{"omitted value", func(u url.Values) (url.Values, error) {
+			p, err := cloudstack.ParseDeleteTagsParams(u)
+			if err != nil {
+				return nil, err
+			}
+			return p.ToURLValues(), nil
+		}, "tags[0].key=a&tags[1].key=b&tags[1].value=2"},
+	} {
+		u, err := url.ParseQuery(c.query)
+		if err != nil {
+			t.Fatalf("%s: invalid query: %v", c.name, err)
+		}
+		encoded, err := c.parse(u)
+		if err != nil {
+			t.Errorf("%s: failed to parse %s: %v", c.name, c.query, err)
+			continue
+		}
+		if cloudstack.EncodeValues(encoded) != cloudstack.EncodeValues(u) {
+			t.Errorf("%s: encoding the parsed params gives %v, expected %v", c.name, encoded, u)
+		}
+	}
+}
+
+func TestParamsParseNonCanonicalMaps(t *testing.T) {
+	// The UI and cmk send several details per index, and tags in the order given by the user
+	for _, c := range []struct {
+		name      string
+		parse     func(url.Values) (url.Values, error)
+		query     string
+		canonical string
+	}{
+		{"grouped details", func(u url.Values) (url.Values, error) {
+			p, err := cloudstack.ParseDeployVirtualMachineParams(u)
+			if err != nil {
+				return nil, err
+			}
+			if details, _ := p.GetDetails(); details["cpuNumber"] != "2" || details["memory"] != "2048" {
+				t.Errorf("grouped details: expected the details to be merged, got %v", details)
+			}
+			return p.ToURLValues(), nil
+		}, "details[0].cpuNumber=2&details[0].memory=2048", "details[0].cpuNumber=2&details[1].memory=2048"},
+		{"grouped and out of order details", func(u url.Values) (url.Values, error) {
+			p, err := cloudstack.ParseUpdateVirtualMachineParams(u)
+			if err != nil {
+				return nil, err
+			}
+			return p.ToURLValues(), nil
+		}, "details[0].c=3&details[0].b=2&details[1].a=1&id=vm-1", "details[0].a=1&details[1].b=2&details[2].c=3&id=vm-1"},
+		{"out of order tags", func(u url.Values) (url.Values, error) {
+			p, err := cloudstack.ParseCreateTagsParams(u)
+			if err != nil {
+				return nil, err
+			}
+			return p.ToURLValues(), nil
+		}, "tags[0].key=b&tags[0].value=1&tags[1].key=a&tags[1].value=2",
+			"tags[0].key=a&tags[0].value=2&tags[1].key=b&tags[1].value=1"},
+	} {
+		u, err := url.ParseQuery(c.query)
+		if err != nil {
+			t.Fatalf("%s: invalid query: %v", c.name, err)
+		}
+		encoded, err := c.parse(u)
+		if err != nil {
+			t.Errorf("%s: failed to parse %s: %v", c.name, c.query, err)
+			continue
+		}
+		canonical, err := url.ParseQuery(c.canonical)
+		if err != nil {
+			t.Fatalf("%s: invalid query: %v", c.name, err)
+		}
+		if cloudstack.EncodeValues(encoded) != cloudstack.EncodeValues(canonical) {
+			t.Errorf("%s: encoding the parsed params gives %v, expected %v", c.name, encoded, canonical)
+		}
+	}
+}
+
+func TestAutomaticPostAndGzipRequests(t *testing.T) {
+	var method, encoding string
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		encoding = r.Header.Get("Content-Encoding")
+		if encoding == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Errorf("failed to read the gzip request body: %v", err)
+				return
+			}
+			r.Body = ioutil.NopCloser(zr)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse the request: %v", err)
+			return
+		}
+		form = r.Form
+		fmt.Fprintln(w, `{"listvirtualmachinesresponse":{"count":0}}`)
+	}))
+	defer server.Close()
+
+	ids := make([]string, 200)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("00000000-0000-0000-0000-%012d", i)
+	}
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	p := client.VirtualMachine.NewListVirtualMachinesParams()
+	if _, err := client.VirtualMachine.ListVirtualMachines(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if method != http.MethodGet {
+		t.Errorf("expected a short request to use GET, got %s", method)
+	}
+
+	p.SetIds(ids)
+	if _, err := client.VirtualMachine.ListVirtualMachines(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if method != http.MethodPost || encoding != "" {
+		t.Errorf("expected a long request to use an uncompressed POST, got %s (%q)", method, encoding)
+	}
+	if form.Get("ids") != strings.Join(ids, ",") {
+		t.Errorf("unexpected ids in POST body: %s", form.Get("ids"))
+	}
+
+	client = cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithMaxGETLength(0))
+	if _, err := client.VirtualMachine.ListVirtualMachines(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if method != http.MethodGet {
+		t.Errorf("expected GET when the automatic switch is disabled, got %s", method)
+	}
+
+	client = cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithGzipRequests(true))
+	if _, err := client.VirtualMachine.ListVirtualMachines(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if method != http.MethodPost || encoding != "gzip" {
+		t.Errorf("expected a gzip compressed POST, got %s (%q)", method, encoding)
+	}
+	if form.Get("ids") != strings.Join(ids, ",") || form.Get("signature") == "" {
+		t.Errorf("unexpected values in gzip POST body: %v", form)
+	}
+}
+
+func TestScopedClients(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		form = r.URL.Query()
+		if form.Get("command") == "createDomain" {
+			fmt.Fprintln(w, `{"createdomainresponse":{"domain":{"name":"child"}}}`)
+			return
+		}
+		fmt.Fprintln(w, `{"listresponse":{"count":0}}`)
+	}))
+	defer server.Close()
+
+	projectID := "1c3e4b7a-2f7f-4f5e-9d1b-1d2c3e4f5a6b"
+	domainID := "7a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	projectClient, err := client.ForProject(projectID)
+	if err != nil {
+		t.Fatalf("failed to create project client: %v", err)
+	}
+	accountClient, err := client.ForAccount(domainID, "tenant")
+	if err != nil {
+		t.Fatalf("failed to create account client: %v", err)
+	}
+
+	if _, err := projectClient.VirtualMachine.ListVirtualMachines(projectClient.VirtualMachine.NewListVirtualMachinesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.Get("projectid") != projectID {
+		t.Errorf("expected projectid %s, got %v", projectID, form)
+	}
+
+	if _, err := projectClient.Zone.ListZones(projectClient.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := form["projectid"]; found {
+		t.Errorf("expected no projectid for an API call not accepting it, got %v", form)
+	}
+
+	p := accountClient.VirtualMachine.NewListVirtualMachinesParams()
+	if _, err := accountClient.VirtualMachine.ListVirtualMachines(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.Get("domainid") != domainID || form.Get("account") != "tenant" {
+		t.Errorf("expected domainid %s and account tenant, got %v", domainID, form)
+	}
+	if _, found := p.GetAccount(); found {
+		t.Errorf("expected the scoped client to leave the params untouched")
+	}
+
+	p.SetAccount("other")
+	if _, err := accountClient.VirtualMachine.ListVirtualMachines(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.Get("account") != "other" {
+		t.Errorf("expected an explicitly set account to take precedence, got %v", form)
+	}
+
+	// The domainid of createDomain is the ID of the new domain, not the scope of the call
+	if _, err := accountClient.Domain.CreateDomain(accountClient.Domain.NewCreateDomainParams("child")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.Get("command") != "createDomain" {
+		t.Fatalf("expected createDomain to be called, got %v", form)
+	}
+	if _, found := form["domainid"]; found {
+		t.Errorf("expected no domainid for createDomain, got %v", form)
+	}
+
+	if _, err := client.VirtualMachine.ListVirtualMachines(client.VirtualMachine.NewListVirtualMachinesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := form["projectid"]; found {
+		t.Errorf("expected the original client to stay unscoped, got %v", form)
+	}
+}
+
+func TestCallOptions(t *testing.T) {
+	var commands []string
+	var form url.Values
+	failures := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		form = r.URL.Query()
+		commands = append(commands, form.Get("command"))
+		switch form.Get("command") {
+		case "destroyVirtualMachine":
+			fmt.Fprintln(w, `{"destroyvirtualmachineresponse":{"jobid":"job-1"}}`)
+		case "queryAsyncJobResult":
+			fmt.Fprintln(w, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":1,`+
+				`"jobresult":{"virtualmachine":{"id":"vm-1","state":"Destroyed"}}}}`)
+		default:
+			fmt.Fprintln(w, `{"listresponse":{"count":0}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient("http://localhost:1/client/api", "APIKEY", "SECRETKEY", true)
+	p := client.VirtualMachine.NewDestroyVirtualMachineParams("vm-1")
+
+	r, err := client.VirtualMachine.DestroyVirtualMachine(p, cloudstack.WithCallEndpoint(server.URL), cloudstack.WithCallWait(false))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.JobID != "job-1" || r.State != "" || len(commands) != 1 {
+		t.Errorf("expected the call to return without waiting, got %+v after %v", r, commands)
+	}
+
+	commands = nil
+	r, err = client.VirtualMachine.DestroyVirtualMachine(p, cloudstack.WithCallEndpoint(server.URL), cloudstack.WithCallAsyncTimeout(10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.State != "Destroyed" || len(commands) != 2 || commands[1] != "queryAsyncJobResult" {
+		t.Errorf("expected the call to wait for the async job, got %+v after %v", r, commands)
+	}
+
+	syncClient := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	commands = nil
+	if r, err = syncClient.VirtualMachine.DestroyVirtualMachine(p, cloudstack.WithCallWait(true)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.State != "Destroyed" || len(commands) != 2 {
+		t.Errorf("expected the sync client to wait for the async job, got %+v after %v", r, commands)
+	}
+
+	lp := syncClient.VirtualMachine.NewListVirtualMachinesParams()
+	if _, err := syncClient.VirtualMachine.ListVirtualMachines(lp, cloudstack.WithCallParams(url.Values{"extra": {"value"}})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if form.Get("extra") != "value" {
+		t.Errorf("expected the extra param to be added, got %v", form)
+	}
+
+	failures = 2
+	if _, err := syncClient.VirtualMachine.ListVirtualMachines(lp); err == nil {
+		t.Errorf("expected an error without a retry policy")
+	}
+	failures = 2
+	retry := cloudstack.WithCallRetry(cloudstack.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond})
+	if _, err := syncClient.VirtualMachine.ListVirtualMachines(lp, retry); err != nil {
+		t.Errorf("expected the call to succeed after retrying: %v", err)
+	}
+}
+
+func TestAPIDiscovery(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.URL.Query().Get("command"))
+		switch r.URL.Query().Get("command") {
+		case "listApis":
+			fmt.Fprintln(w, `{"listapisresponse":{"count":1,"api":[{"name":"listZones","isasync":false,`+
+				`"params":[{"name":"id"},{"name":"name"},{"name":"tags"}]}]}}`)
+		default:
+			fmt.Fprintln(w, `{"listzonesresponse":{"count":0}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithAPIDiscovery(cloudstack.UnknownParamFail))
+
+	p := client.Zone.NewListZonesParams()
+	p.SetName("zone1")
+	p.SetTags(map[string]string{"key": "value"})
+	if _, err := client.Zone.ListZones(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := client.Network.ListNetworks(client.Network.NewListNetworksParams())
+	if e, ok := err.(*cloudstack.UnsupportedCommandError); !ok || e.Command != "listNetworks" {
+		t.Errorf("expected an unsupported command error, got %v", err)
+	}
+
+	p.SetKeyword("zone")
+	_, err = client.Zone.ListZones(p)
+	if e, ok := err.(*cloudstack.UnknownParamError); !ok || len(e.Params) != 1 || e.Params[0] != "keyword" {
+		t.Errorf("expected an unknown param error, got %v", err)
+	}
+
+	if !client.Supports("listZones", "") || !client.Supports("listzones", "name") {
+		t.Errorf("expected listZones and its name param to be supported")
+	}
+	if client.Supports("listZones", "keyword") || client.Supports("listNetworks", "") {
+		t.Errorf("expected the keyword param and listNetworks to be unsupported")
+	}
+
+	scoped, err := client.ForProject("9c11e19b-6e28-4a4c-8c0e-e0a6a9b8f3a1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !scoped.Supports("listZones", "id") {
+		t.Errorf("expected scoped clients to share the discovered capabilities")
+	}
+
+	expected := []string{"listApis", "listZones"}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("expected commands %v to be sent, got %v", expected, commands)
+	}
+
+	var warnings []*cloudstack.UnknownParamError
+	warn := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithAPIDiscovery(cloudstack.UnknownParamWarn),
+		cloudstack.WithUnknownParamHandler(func(e *cloudstack.UnknownParamError) {
+			warnings = append(warnings, e)
+		}))
+	if _, err := warn.Zone.ListZones(p); err != nil {
+		t.Fatalf("expected the call to be sent with a warning, got %v", err)
+	}
+	if len(warnings) != 1 || warnings[0].Command != "listZones" || !reflect.DeepEqual(warnings[0].Params, []string{"keyword"}) {
+		t.Errorf("expected a warning for the keyword param, got %v", warnings)
+	}
+}
+
+func TestCustomServiceRequest(t *testing.T) {
+	var form url.Values
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		form = r.Form
+		methods = append(methods, r.Method)
+		switch form.Get("command") {
+		case "listApis":
+			fmt.Fprintln(w, `{"listapisresponse":{"count":4,"api":[`+
+				`{"name":"createWidget","isasync":true,"params":[{"name":"name","type":"string","required":true},`+
+				`{"name":"size","type":"integer"},{"name":"tags","type":"map"},{"name":"ids","type":"list"}]},`+
+				`{"name":"updateWidget","isasync":false,"params":[]},`+
+				`{"name":"listWidgets","isasync":false,"params":[]},`+
+				`{"name":"deployVirtualMachine","isasync":false,"params":[{"name":"zoneid","type":"uuid"}]}]}}`)
+		case "updateWidget":
+			fmt.Fprintln(w, `{"updatewidgetresponse":{"widget":{"id":"widget-1","name":"w1"}}}`)
+		case "listWidgets":
+			fmt.Fprintln(w, `{"listwidgetsresponse":{"count":1,"widget":[{"id":"widget-1","name":"w1"}]}}`)
+		case "createWidget":
+			fmt.Fprintln(w, `{"createwidgetresponse":{"jobid":"job-1"}}`)
+		case "queryAsyncJobResult":
+			fmt.Fprintln(w, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":1,`+
+				`"jobresult":{"widget":{"id":"widget-1","name":"w1"}}}}`)
+		default:
+			fmt.Fprintln(w, `{"deployvirtualmachineresponse":{"id":"vm-1"}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := client.Custom.NewCustomServiceParams()
+	p.SetParam("name", "w1")
+	p.SetParam("size", 10)
+	p.SetParam("ids", []string{"a", "b"})
+	p.SetKeyValueParam("tags", map[string]string{"b": "2", "a": "1"})
+
+	u := p.ToURLValues()
+	expected := url.Values{
+		"name":          {"w1"},
+		"size":          {"10"},
+		"ids":           {"a,b"},
+		"tags[0].key":   {"a"},
+		"tags[0].value": {"1"},
+		"tags[1].key":   {"b"},
+		"tags[1].value": {"2"},
+	}
+	if !reflect.DeepEqual(u, expected) {
+		t.Errorf("expected %v, got %v", expected, u)
+	}
+
+	var widget struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := client.Custom.Request("createWidget", p, &widget); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if widget.ID != "widget-1" || widget.Name != "w1" {
+		t.Errorf("expected the async job result, got %+v", widget)
+	}
+
+	// Sync calls return the same shape as async calls
+	sync, err := client.Custom.RawRequest("updateWidget", client.Custom.NewCustomServiceParams())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	async, err := client.Custom.RawRequest("createWidget", p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(sync) != string(async) {
+		t.Errorf("expected the sync result %s to equal the async result %s", sync, async)
+	}
+
+	list, err := client.Custom.RawRequest("listWidgets", client.Custom.NewCustomServiceParams())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(list) != `{"count":1,"widget":[{"id":"widget-1","name":"w1"}]}` {
+		t.Errorf("expected lists to be returned as is, got %s", list)
+	}
+
+	p.SetParam("size", "large")
+	if _, err := client.Custom.RawRequest("createWidget", p); err == nil {
+		t.Errorf("expected an error for an invalid integer value")
+	}
+
+	p.ResetParam("name")
+	p.SetParam("size", 10)
+	if _, err := client.Custom.RawRequest("createWidget", p); err == nil {
+		t.Errorf("expected an error for a missing required param")
+	}
+
+	p.SetParam("name", "w1")
+	p.SetParam("color", "red")
+	if _, err := client.Custom.RawRequest("createWidget", p); err == nil {
+		t.Errorf("expected an error for an unknown param")
+	}
+
+	if _, err := client.Custom.RawRequest("deleteWidget", p); err == nil {
+		t.Errorf("expected an error for an unknown command")
+	}
+
+	methods = nil
+	vp := client.Custom.NewCustomServiceParams()
+	vp.SetParam("zoneid", "zone-1")
+	if _, err := client.Custom.RawRequest("deployVirtualMachine", vp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(methods) != 1 || methods[0] != http.MethodPost {
+		t.Errorf("expected a single POST call, got %v", methods)
+	}
+}
+
+func TestServerVersion(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.URL.Query().Get("command"))
+		switch r.URL.Query().Get("command") {
+		case "listCapabilities":
+			fmt.Fprintln(w, `{"listcapabilitiesresponse":{"capability":{"cloudstackversion":"4.19.0.0-mold"}}}`)
+		default:
+			fmt.Fprintln(w, `{"listzonesresponse":{"count":0}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithServerVersionDetection())
+	for i := 0; i < 2; i++ {
+		if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if v := client.ServerVersion(); v != "4.19.0.0-mold" {
+		t.Errorf("expected the detected version, got %s", v)
+	}
+	expected := []string{"listCapabilities", "listZones", "listZones"}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("expected commands %v to be sent, got %v", expected, commands)
+	}
+
+	for _, c := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"4.18.0.0", "4.19.0.0", -1},
+		{"4.19.0.0-mold", "4.19", 0},
+		{"4.20.1", "4.9.3.1", 1},
+	} {
+		if got := cloudstack.CompareVersions(c.a, c.b); got != c.expected {
+			t.Errorf("expected CompareVersions(%s, %s) to be %d, got %d", c.a, c.b, c.expected, got)
+		}
+	}
+
+	for _, v := range cloudstack.SupportedServerVersions {
+		if !cloudstack.SupportsInVersion(v, "listZones", "name") {
+			t.Errorf("expected listZones to be supported in %s", v)
+		}
+	}
+}
+
+func TestServerVersionGating(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.URL.Query().Get("command"))
+		fmt.Fprintln(w, `{"listvirtualmachinesresponse":{"count":0}}`)
+	}))
+	defer server.Close()
+
+	if cloudstack.SupportsInVersion("4.18.0.0", "createBucket", "") {
+		t.Errorf("expected createBucket not to be supported in 4.18.0.0")
+	}
+	if !cloudstack.SupportsInVersion("4.19.0.0", "createBucket", "") {
+		t.Errorf("expected createBucket to be supported in 4.19.0.0")
+	}
+	if cloudstack.SupportsInVersion("4.18.0.0", "listVirtualMachines", "isvnf") {
+		t.Errorf("expected listVirtualMachines to not support isvnf in 4.18.0.0")
+	}
+
+	// A 4.18.1.0 server is checked against the 4.18.0.0 API
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithServerVersion("4.18.1.0"))
+
+	_, err := client.ObjectStore.CreateBucket(client.ObjectStore.NewCreateBucketParams("bucket1", "store1"))
+	if _, ok := err.(*cloudstack.UnsupportedCommandError); !ok {
+		t.Errorf("expected an UnsupportedCommandError, got %v", err)
+	}
+
+	p := client.VirtualMachine.NewListVirtualMachinesParams()
+	p.SetIsvnf(true)
+	_, err = client.VirtualMachine.ListVirtualMachines(p)
+	if e, ok := err.(*cloudstack.UnknownParamError); !ok || !reflect.DeepEqual(e.Params, []string{"isvnf"}) {
+		t.Errorf("expected an UnknownParamError for isvnf, got %v", err)
+	}
+
+	if _, err := client.VirtualMachine.ListVirtualMachines(client.VirtualMachine.NewListVirtualMachinesParams()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(commands, []string{"listVirtualMachines"}) {
+		t.Errorf("expected only the supported call to be sent, got %v", commands)
+	}
+
+	client = cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithServerVersion("4.19.0.0"))
+	if _, err := client.VirtualMachine.ListVirtualMachines(p); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAPIDiscoveryWithServerVersionDetection(t *testing.T) {
+	var mu sync.Mutex
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		commands = append(commands, r.URL.Query().Get("command"))
+		mu.Unlock()
+
+		switch r.URL.Query().Get("command") {
+		case "listApis":
+			// Give concurrent calls the time to wait for the running discovery
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprintln(w, `{"listapisresponse":{"count":1,"api":[{"name":"listZones","isasync":false,`+
+				`"params":[{"name":"id"},{"name":"name"}]}]}}`)
+		case "listCapabilities":
+			fmt.Fprintln(w, `{"listcapabilitiesresponse":{"capability":{"cloudstackversion":"4.19.0.0"}}}`)
+		default:
+			fmt.Fprintln(w, `{"listzonesresponse":{"count":0}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithAPIDiscovery(cloudstack.UnknownParamFail), cloudstack.WithServerVersionDetection())
+
+	errs := make(chan error)
+	for i := 0; i < 5; i++ {
+		go func() {
+			_, err := client.Zone.ListZones(client.Zone.NewListZonesParams())
+			errs <- err
+		}()
+	}
+	for i := 0; i < 5; i++ {
+		select {
+		case err := <-errs:
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout while calling listZones with API discovery and version detection")
+		}
+	}
+
+	if v := client.ServerVersion(); v != "4.19.0.0" {
+		t.Errorf("expected the detected version, got %s", v)
+	}
+
+	counts := make(map[string]int)
+	for _, c := range commands {
+		counts[c]++
+	}
+	expected := map[string]int{"listApis": 1, "listCapabilities": 1, "listZones": 5}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected commands %v to be sent, got %v", expected, counts)
+	}
+}